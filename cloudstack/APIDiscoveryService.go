@@ -20,12 +20,14 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
 
 type APIDiscoveryServiceIface interface {
 	ListApis(p *ListApisParams) (*ListApisResponse, error)
+	ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error)
 	NewListApisParams() *ListApisParams
 }
 
@@ -44,6 +46,43 @@ func (p *ListApisParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listApis API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListApisParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listApis", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listApis API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListApisParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"name": 255},
+	}.validate("listApis", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listApis API, before the request is signed
+func (p *ListApisParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListApisParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListApisParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listApis", b, &p.p, map[string]paramDecoder{
+		"name": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListApisParams) Clone() *ListApisParams {
+	return &ListApisParams{p: cloneParams(p.p)}
+}
+
 func (p *ListApisParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,13 +114,22 @@ func (s *APIDiscoveryService) NewListApisParams() *ListApisParams {
 
 // Lists all available APIs on the server, provided by the API Discovery plugin
 func (s *APIDiscoveryService) ListApis(p *ListApisParams) (*ListApisResponse, error) {
-	resp, err := s.cs.newRequest("listApis", p.toURLValues())
+	return s.ListApisWithContext(context.Background(), p)
+}
+
+// ListApisWithContext is like ListApis, but honours the cancellation and deadline of ctx
+func (s *APIDiscoveryService) ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listApis", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListApisResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listApis", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -94,31 +142,49 @@ type ListApisResponse struct {
 }
 
 type Api struct {
-	Description string        `json:"description"`
-	Isasync     bool          `json:"isasync"`
-	JobID       string        `json:"jobid"`
-	Jobstatus   int           `json:"jobstatus"`
-	Name        string        `json:"name"`
-	Params      []ApiParams   `json:"params"`
-	Related     string        `json:"related"`
-	Response    []ApiResponse `json:"response"`
-	Since       string        `json:"since"`
-	Type        string        `json:"type"`
+	Description string                     `json:"description"`
+	Isasync     FlexBool                   `json:"isasync"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Params      []ApiParams                `json:"params"`
+	Related     string                     `json:"related"`
+	Response    []ApiResponse              `json:"response"`
+	Since       string                     `json:"since"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Api) UnmarshalJSON(b []byte) error {
+	type alias Api
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ApiResponse struct {
-	Description string        `json:"description"`
-	Name        string        `json:"name"`
-	Response    []interface{} `json:"response"`
-	Type        string        `json:"type"`
+	Description string                     `json:"description"`
+	Name        string                     `json:"name"`
+	Response    []interface{}              `json:"response"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ApiResponse) UnmarshalJSON(b []byte) error {
+	type alias ApiResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ApiParams struct {
-	Description string `json:"description"`
-	Length      int    `json:"length"`
-	Name        string `json:"name"`
-	Related     string `json:"related"`
-	Required    bool   `json:"required"`
-	Since       string `json:"since"`
-	Type        string `json:"type"`
+	Description string                     `json:"description"`
+	Length      FlexInt                    `json:"length"`
+	Name        string                     `json:"name"`
+	Related     string                     `json:"related"`
+	Required    FlexBool                   `json:"required"`
+	Since       string                     `json:"since"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ApiParams) UnmarshalJSON(b []byte) error {
+	type alias ApiParams
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
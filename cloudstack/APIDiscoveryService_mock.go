@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockAPIDiscoveryServiceIfaceMockRecorder) ListApis(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApis", reflect.TypeOf((*MockAPIDiscoveryServiceIface)(nil).ListApis), p)
 }
 
+// ListApisWithContext mocks base method.
+func (m *MockAPIDiscoveryServiceIface) ListApisWithContext(ctx context.Context, p *ListApisParams) (*ListApisResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListApisWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListApisResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListApisWithContext indicates an expected call of ListApisWithContext.
+func (mr *MockAPIDiscoveryServiceIfaceMockRecorder) ListApisWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListApisWithContext", reflect.TypeOf((*MockAPIDiscoveryServiceIface)(nil).ListApisWithContext), ctx, p)
+}
+
 // NewListApisParams mocks base method.
 func (m *MockAPIDiscoveryServiceIface) NewListApisParams() *ListApisParams {
 	m.ctrl.T.Helper()
@@ -20,17 +20,26 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type ASNumberRangeServiceIface interface {
 	CreateASNRange(p *CreateASNRangeParams) (*CreateASNRangeResponse, error)
+	CreateASNRangeWithContext(ctx context.Context, p *CreateASNRangeParams) (*CreateASNRangeResponse, error)
 	NewCreateASNRangeParams(endasn int64, startasn int64, zoneid string) *CreateASNRangeParams
 	DeleteASNRange(p *DeleteASNRangeParams) (*DeleteASNRangeResponse, error)
+	DeleteASNRangeWithContext(ctx context.Context, p *DeleteASNRangeParams) (*DeleteASNRangeResponse, error)
 	NewDeleteASNRangeParams(id string) *DeleteASNRangeParams
 	ListASNRanges(p *ListASNRangesParams) (*ListASNRangesResponse, error)
+	ListASNRangesWithContext(ctx context.Context, p *ListASNRangesParams) (*ListASNRangesResponse, error)
+	ListASNRangesAll(p *ListASNRangesParams, opts ...PageOption) ([]*ASNRange, error)
+	ListASNRangesAllWithContext(ctx context.Context, p *ListASNRangesParams, opts ...PageOption) ([]*ASNRange, error)
+	ListASNRangesIter(p *ListASNRangesParams, opts ...PageOption) iter.Seq2[*ASNRange, error]
+	ListASNRangesIterWithContext(ctx context.Context, p *ListASNRangesParams, opts ...PageOption) iter.Seq2[*ASNRange, error]
 	NewListASNRangesParams() *ListASNRangesParams
 }
 
@@ -57,6 +66,46 @@ func (p *CreateASNRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createASNRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateASNRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createASNRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createASNRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateASNRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"endasn", "startasn", "zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("createASNRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createASNRange API, before the request is signed
+func (p *CreateASNRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateASNRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateASNRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createASNRange", b, &p.p, map[string]paramDecoder{
+		"endasn":   decodeParam[int64],
+		"startasn": decodeParam[int64],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateASNRangeParams) Clone() *CreateASNRangeParams {
+	return &CreateASNRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateASNRangeParams) SetEndasn(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -133,13 +182,22 @@ func (s *ASNumberRangeService) NewCreateASNRangeParams(endasn int64, startasn in
 
 // Creates a range of Autonomous Systems for BGP Dynamic Routing
 func (s *ASNumberRangeService) CreateASNRange(p *CreateASNRangeParams) (*CreateASNRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("createASNRange", p.toURLValues())
+	return s.CreateASNRangeWithContext(context.Background(), p)
+}
+
+// CreateASNRangeWithContext is like CreateASNRange, but honours the cancellation and deadline of ctx
+func (s *ASNumberRangeService) CreateASNRangeWithContext(ctx context.Context, p *CreateASNRangeParams) (*CreateASNRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createASNRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateASNRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createASNRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -147,13 +205,19 @@ func (s *ASNumberRangeService) CreateASNRange(p *CreateASNRangeParams) (*CreateA
 }
 
 type CreateASNRangeResponse struct {
-	Created   string `json:"created"`
-	Endasn    int64  `json:"endasn"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Startasn  int64  `json:"startasn"`
-	Zoneid    string `json:"zoneid"`
+	Created   Time                       `json:"created"`
+	Endasn    FlexInt64                  `json:"endasn"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Startasn  FlexInt64                  `json:"startasn"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateASNRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateASNRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteASNRangeParams struct {
@@ -171,6 +235,44 @@ func (p *DeleteASNRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteASNRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteASNRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteASNRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteASNRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteASNRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteASNRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteASNRange API, before the request is signed
+func (p *DeleteASNRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteASNRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteASNRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteASNRange", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteASNRangeParams) Clone() *DeleteASNRangeParams {
+	return &DeleteASNRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteASNRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -203,13 +305,22 @@ func (s *ASNumberRangeService) NewDeleteASNRangeParams(id string) *DeleteASNRang
 
 // deletes a range of Autonomous Systems for BGP Dynamic Routing
 func (s *ASNumberRangeService) DeleteASNRange(p *DeleteASNRangeParams) (*DeleteASNRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteASNRange", p.toURLValues())
+	return s.DeleteASNRangeWithContext(context.Background(), p)
+}
+
+// DeleteASNRangeWithContext is like DeleteASNRange, but honours the cancellation and deadline of ctx
+func (s *ASNumberRangeService) DeleteASNRangeWithContext(ctx context.Context, p *DeleteASNRangeParams) (*DeleteASNRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteASNRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteASNRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteASNRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -217,10 +328,11 @@ func (s *ASNumberRangeService) DeleteASNRange(p *DeleteASNRangeParams) (*DeleteA
 }
 
 type DeleteASNRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteASNRangeResponse) UnmarshalJSON(b []byte) error {
@@ -247,7 +359,7 @@ func (r *DeleteASNRangeResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteASNRangeResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListASNRangesParams struct {
@@ -276,6 +388,46 @@ func (p *ListASNRangesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listASNRanges API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListASNRangesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listASNRanges", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listASNRanges API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListASNRangesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "zoneid": 255},
+	}.validate("listASNRanges", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listASNRanges API, before the request is signed
+func (p *ListASNRangesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListASNRangesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListASNRangesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listASNRanges", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListASNRangesParams) Clone() *ListASNRangesParams {
+	return &ListASNRangesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListASNRangesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -370,30 +522,73 @@ func (s *ASNumberRangeService) NewListASNRangesParams() *ListASNRangesParams {
 
 // List Autonomous Systems Number Ranges
 func (s *ASNumberRangeService) ListASNRanges(p *ListASNRangesParams) (*ListASNRangesResponse, error) {
-	resp, err := s.cs.newRequest("listASNRanges", p.toURLValues())
+	return s.ListASNRangesWithContext(context.Background(), p)
+}
+
+// ListASNRangesWithContext is like ListASNRanges, but honours the cancellation and deadline of ctx
+func (s *ASNumberRangeService) ListASNRangesWithContext(ctx context.Context, p *ListASNRangesParams) (*ListASNRangesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listASNRanges", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListASNRangesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listASNRanges", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListASNRangesAll returns all ASNRanges matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ASNumberRangeService) ListASNRangesAll(p *ListASNRangesParams, opts ...PageOption) ([]*ASNRange, error) {
+	return s.ListASNRangesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListASNRangesAllWithContext is like ListASNRangesAll, but honours the cancellation and deadline of ctx
+func (s *ASNumberRangeService) ListASNRangesAllWithContext(ctx context.Context, p *ListASNRangesParams, opts ...PageOption) ([]*ASNRange, error) {
+	return collectPages(s.ListASNRangesIterWithContext(ctx, p, opts...))
+}
+
+// ListASNRangesIter returns an iterator over all ASNRanges matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ASNumberRangeService) ListASNRangesIter(p *ListASNRangesParams, opts ...PageOption) iter.Seq2[*ASNRange, error] {
+	return s.ListASNRangesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListASNRangesIterWithContext is like ListASNRangesIter, but honours the cancellation and deadline of ctx
+func (s *ASNumberRangeService) ListASNRangesIterWithContext(ctx context.Context, p *ListASNRangesParams, opts ...PageOption) iter.Seq2[*ASNRange, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ASNRange, int, error) {
+		l, err := s.ListASNRangesWithContext(ctx, &ListASNRangesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ASNRanges, l.Count, nil
+	}, opts...)
+}
+
 type ListASNRangesResponse struct {
 	Count     int         `json:"count"`
 	ASNRanges []*ASNRange `json:"asnumberrange"`
 }
 
 type ASNRange struct {
-	Created   string `json:"created"`
-	Endasn    int64  `json:"endasn"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Startasn  int64  `json:"startasn"`
-	Zoneid    string `json:"zoneid"`
+	Created   Time                       `json:"created"`
+	Endasn    FlexInt64                  `json:"endasn"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Startasn  FlexInt64                  `json:"startasn"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ASNRange) UnmarshalJSON(b []byte) error {
+	type alias ASNRange
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
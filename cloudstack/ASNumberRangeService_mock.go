@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockASNumberRangeServiceIfaceMockRecorder) CreateASNRange(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateASNRange", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).CreateASNRange), p)
 }
 
+// CreateASNRangeWithContext mocks base method.
+func (m *MockASNumberRangeServiceIface) CreateASNRangeWithContext(ctx context.Context, p *CreateASNRangeParams) (*CreateASNRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateASNRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateASNRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateASNRangeWithContext indicates an expected call of CreateASNRangeWithContext.
+func (mr *MockASNumberRangeServiceIfaceMockRecorder) CreateASNRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateASNRangeWithContext", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).CreateASNRangeWithContext), ctx, p)
+}
+
 // DeleteASNRange mocks base method.
 func (m *MockASNumberRangeServiceIface) DeleteASNRange(p *DeleteASNRangeParams) (*DeleteASNRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockASNumberRangeServiceIfaceMockRecorder) DeleteASNRange(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteASNRange", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).DeleteASNRange), p)
 }
 
+// DeleteASNRangeWithContext mocks base method.
+func (m *MockASNumberRangeServiceIface) DeleteASNRangeWithContext(ctx context.Context, p *DeleteASNRangeParams) (*DeleteASNRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteASNRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteASNRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteASNRangeWithContext indicates an expected call of DeleteASNRangeWithContext.
+func (mr *MockASNumberRangeServiceIfaceMockRecorder) DeleteASNRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteASNRangeWithContext", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).DeleteASNRangeWithContext), ctx, p)
+}
+
 // ListASNRanges mocks base method.
 func (m *MockASNumberRangeServiceIface) ListASNRanges(p *ListASNRangesParams) (*ListASNRangesResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,99 @@ func (mr *MockASNumberRangeServiceIfaceMockRecorder) ListASNRanges(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNRanges", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).ListASNRanges), p)
 }
 
+// ListASNRangesAll mocks base method.
+func (m *MockASNumberRangeServiceIface) ListASNRangesAll(p *ListASNRangesParams, opts ...PageOption) ([]*ASNRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNRangesAll", varargs...)
+	ret0, _ := ret[0].([]*ASNRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListASNRangesAll indicates an expected call of ListASNRangesAll.
+func (mr *MockASNumberRangeServiceIfaceMockRecorder) ListASNRangesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNRangesAll", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).ListASNRangesAll), varargs...)
+}
+
+// ListASNRangesAllWithContext mocks base method.
+func (m *MockASNumberRangeServiceIface) ListASNRangesAllWithContext(ctx context.Context, p *ListASNRangesParams, opts ...PageOption) ([]*ASNRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNRangesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ASNRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListASNRangesAllWithContext indicates an expected call of ListASNRangesAllWithContext.
+func (mr *MockASNumberRangeServiceIfaceMockRecorder) ListASNRangesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNRangesAllWithContext", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).ListASNRangesAllWithContext), varargs...)
+}
+
+// ListASNRangesIter mocks base method.
+func (m *MockASNumberRangeServiceIface) ListASNRangesIter(p *ListASNRangesParams, opts ...PageOption) iter.Seq2[*ASNRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNRangesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ASNRange, error])
+	return ret0
+}
+
+// ListASNRangesIter indicates an expected call of ListASNRangesIter.
+func (mr *MockASNumberRangeServiceIfaceMockRecorder) ListASNRangesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNRangesIter", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).ListASNRangesIter), varargs...)
+}
+
+// ListASNRangesIterWithContext mocks base method.
+func (m *MockASNumberRangeServiceIface) ListASNRangesIterWithContext(ctx context.Context, p *ListASNRangesParams, opts ...PageOption) iter.Seq2[*ASNRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNRangesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ASNRange, error])
+	return ret0
+}
+
+// ListASNRangesIterWithContext indicates an expected call of ListASNRangesIterWithContext.
+func (mr *MockASNumberRangeServiceIfaceMockRecorder) ListASNRangesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNRangesIterWithContext", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).ListASNRangesIterWithContext), varargs...)
+}
+
+// ListASNRangesWithContext mocks base method.
+func (m *MockASNumberRangeServiceIface) ListASNRangesWithContext(ctx context.Context, p *ListASNRangesParams) (*ListASNRangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListASNRangesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListASNRangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListASNRangesWithContext indicates an expected call of ListASNRangesWithContext.
+func (mr *MockASNumberRangeServiceIfaceMockRecorder) ListASNRangesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNRangesWithContext", reflect.TypeOf((*MockASNumberRangeServiceIface)(nil).ListASNRangesWithContext), ctx, p)
+}
+
 // NewCreateASNRangeParams mocks base method.
 func (m *MockASNumberRangeServiceIface) NewCreateASNRangeParams(endasn, startasn int64, zoneid string) *CreateASNRangeParams {
 	m.ctrl.T.Helper()
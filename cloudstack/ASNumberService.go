@@ -20,15 +20,23 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type ASNumberServiceIface interface {
 	ListASNumbers(p *ListASNumbersParams) (*ListASNumbersResponse, error)
+	ListASNumbersWithContext(ctx context.Context, p *ListASNumbersParams) (*ListASNumbersResponse, error)
+	ListASNumbersAll(p *ListASNumbersParams, opts ...PageOption) ([]*ASNumber, error)
+	ListASNumbersAllWithContext(ctx context.Context, p *ListASNumbersParams, opts ...PageOption) ([]*ASNumber, error)
+	ListASNumbersIter(p *ListASNumbersParams, opts ...PageOption) iter.Seq2[*ASNumber, error]
+	ListASNumbersIterWithContext(ctx context.Context, p *ListASNumbersParams, opts ...PageOption) iter.Seq2[*ASNumber, error]
 	NewListASNumbersParams() *ListASNumbersParams
 	ReleaseASNumber(p *ReleaseASNumberParams) (*ReleaseASNumberResponse, error)
+	ReleaseASNumberWithContext(ctx context.Context, p *ReleaseASNumberParams) (*ReleaseASNumberResponse, error)
 	NewReleaseASNumberParams(asnumber int64, zoneid string) *ReleaseASNumberParams
 }
 
@@ -81,6 +89,53 @@ func (p *ListASNumbersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listASNumbers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListASNumbersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listASNumbers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listASNumbers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListASNumbersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "asnrangeid": 255, "domainid": 255, "keyword": 255, "networkid": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listASNumbers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listASNumbers API, before the request is signed
+func (p *ListASNumbersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListASNumbersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListASNumbersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listASNumbers", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"asnrangeid":  decodeParam[string],
+		"asnumber":    decodeParam[int],
+		"domainid":    decodeParam[string],
+		"isallocated": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"vpcid":       decodeParam[string],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListASNumbersParams) Clone() *ListASNumbersParams {
+	return &ListASNumbersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListASNumbersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -322,44 +377,87 @@ func (s *ASNumberService) NewListASNumbersParams() *ListASNumbersParams {
 
 // List Autonomous Systems Numbers
 func (s *ASNumberService) ListASNumbers(p *ListASNumbersParams) (*ListASNumbersResponse, error) {
-	resp, err := s.cs.newRequest("listASNumbers", p.toURLValues())
+	return s.ListASNumbersWithContext(context.Background(), p)
+}
+
+// ListASNumbersWithContext is like ListASNumbers, but honours the cancellation and deadline of ctx
+func (s *ASNumberService) ListASNumbersWithContext(ctx context.Context, p *ListASNumbersParams) (*ListASNumbersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listASNumbers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListASNumbersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listASNumbers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListASNumbersAll returns all ASNumbers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ASNumberService) ListASNumbersAll(p *ListASNumbersParams, opts ...PageOption) ([]*ASNumber, error) {
+	return s.ListASNumbersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListASNumbersAllWithContext is like ListASNumbersAll, but honours the cancellation and deadline of ctx
+func (s *ASNumberService) ListASNumbersAllWithContext(ctx context.Context, p *ListASNumbersParams, opts ...PageOption) ([]*ASNumber, error) {
+	return collectPages(s.ListASNumbersIterWithContext(ctx, p, opts...))
+}
+
+// ListASNumbersIter returns an iterator over all ASNumbers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ASNumberService) ListASNumbersIter(p *ListASNumbersParams, opts ...PageOption) iter.Seq2[*ASNumber, error] {
+	return s.ListASNumbersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListASNumbersIterWithContext is like ListASNumbersIter, but honours the cancellation and deadline of ctx
+func (s *ASNumberService) ListASNumbersIterWithContext(ctx context.Context, p *ListASNumbersParams, opts ...PageOption) iter.Seq2[*ASNumber, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ASNumber, int, error) {
+		l, err := s.ListASNumbersWithContext(ctx, &ListASNumbersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ASNumbers, l.Count, nil
+	}, opts...)
+}
+
 type ListASNumbersResponse struct {
 	Count     int         `json:"count"`
 	ASNumbers []*ASNumber `json:"asnumber"`
 }
 
 type ASNumber struct {
-	Account               string `json:"account"`
-	Accountid             string `json:"accountid"`
-	Allocated             string `json:"allocated"`
-	Allocationstate       string `json:"allocationstate"`
-	Asnrange              string `json:"asnrange"`
-	Asnrangeid            string `json:"asnrangeid"`
-	Asnumber              int64  `json:"asnumber"`
-	Associatednetworkid   string `json:"associatednetworkid"`
-	Associatednetworkname string `json:"associatednetworkname"`
-	Created               string `json:"created"`
-	Domain                string `json:"domain"`
-	Domainid              string `json:"domainid"`
-	Id                    string `json:"id"`
-	JobID                 string `json:"jobid"`
-	Jobstatus             int    `json:"jobstatus"`
-	Vpcid                 string `json:"vpcid"`
-	Vpcname               string `json:"vpcname"`
-	Zoneid                string `json:"zoneid"`
-	Zonename              string `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Allocated             string                     `json:"allocated"`
+	Allocationstate       string                     `json:"allocationstate"`
+	Asnrange              string                     `json:"asnrange"`
+	Asnrangeid            string                     `json:"asnrangeid"`
+	Asnumber              FlexInt64                  `json:"asnumber"`
+	Associatednetworkid   string                     `json:"associatednetworkid"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Created               Time                       `json:"created"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Id                    string                     `json:"id"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Vpcid                 string                     `json:"vpcid"`
+	Vpcname               string                     `json:"vpcname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ASNumber) UnmarshalJSON(b []byte) error {
+	type alias ASNumber
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseASNumberParams struct {
@@ -381,6 +479,45 @@ func (p *ReleaseASNumberParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseASNumber API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseASNumberParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseASNumber", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseASNumber API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseASNumberParams) Validate() error {
+	return paramRules{
+		required: []string{"asnumber", "zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("releaseASNumber", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseASNumber API, before the request is signed
+func (p *ReleaseASNumberParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseASNumberParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseASNumberParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseASNumber", b, &p.p, map[string]paramDecoder{
+		"asnumber": decodeParam[int64],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseASNumberParams) Clone() *ReleaseASNumberParams {
+	return &ReleaseASNumberParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseASNumberParams) SetAsnumber(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -435,13 +572,22 @@ func (s *ASNumberService) NewReleaseASNumberParams(asnumber int64, zoneid string
 
 // Releases an AS Number back to the pool
 func (s *ASNumberService) ReleaseASNumber(p *ReleaseASNumberParams) (*ReleaseASNumberResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseASNumber", p.toURLValues())
+	return s.ReleaseASNumberWithContext(context.Background(), p)
+}
+
+// ReleaseASNumberWithContext is like ReleaseASNumber, but honours the cancellation and deadline of ctx
+func (s *ASNumberService) ReleaseASNumberWithContext(ctx context.Context, p *ReleaseASNumberParams) (*ReleaseASNumberResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseASNumber", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseASNumberResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseASNumber", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -449,10 +595,11 @@ func (s *ASNumberService) ReleaseASNumber(p *ReleaseASNumberParams) (*ReleaseASN
 }
 
 type ReleaseASNumberResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ReleaseASNumberResponse) UnmarshalJSON(b []byte) error {
@@ -479,5 +626,5 @@ func (r *ReleaseASNumberResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ReleaseASNumberResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,99 @@ func (mr *MockASNumberServiceIfaceMockRecorder) ListASNumbers(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNumbers", reflect.TypeOf((*MockASNumberServiceIface)(nil).ListASNumbers), p)
 }
 
+// ListASNumbersAll mocks base method.
+func (m *MockASNumberServiceIface) ListASNumbersAll(p *ListASNumbersParams, opts ...PageOption) ([]*ASNumber, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNumbersAll", varargs...)
+	ret0, _ := ret[0].([]*ASNumber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListASNumbersAll indicates an expected call of ListASNumbersAll.
+func (mr *MockASNumberServiceIfaceMockRecorder) ListASNumbersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNumbersAll", reflect.TypeOf((*MockASNumberServiceIface)(nil).ListASNumbersAll), varargs...)
+}
+
+// ListASNumbersAllWithContext mocks base method.
+func (m *MockASNumberServiceIface) ListASNumbersAllWithContext(ctx context.Context, p *ListASNumbersParams, opts ...PageOption) ([]*ASNumber, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNumbersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ASNumber)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListASNumbersAllWithContext indicates an expected call of ListASNumbersAllWithContext.
+func (mr *MockASNumberServiceIfaceMockRecorder) ListASNumbersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNumbersAllWithContext", reflect.TypeOf((*MockASNumberServiceIface)(nil).ListASNumbersAllWithContext), varargs...)
+}
+
+// ListASNumbersIter mocks base method.
+func (m *MockASNumberServiceIface) ListASNumbersIter(p *ListASNumbersParams, opts ...PageOption) iter.Seq2[*ASNumber, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNumbersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ASNumber, error])
+	return ret0
+}
+
+// ListASNumbersIter indicates an expected call of ListASNumbersIter.
+func (mr *MockASNumberServiceIfaceMockRecorder) ListASNumbersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNumbersIter", reflect.TypeOf((*MockASNumberServiceIface)(nil).ListASNumbersIter), varargs...)
+}
+
+// ListASNumbersIterWithContext mocks base method.
+func (m *MockASNumberServiceIface) ListASNumbersIterWithContext(ctx context.Context, p *ListASNumbersParams, opts ...PageOption) iter.Seq2[*ASNumber, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListASNumbersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ASNumber, error])
+	return ret0
+}
+
+// ListASNumbersIterWithContext indicates an expected call of ListASNumbersIterWithContext.
+func (mr *MockASNumberServiceIfaceMockRecorder) ListASNumbersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNumbersIterWithContext", reflect.TypeOf((*MockASNumberServiceIface)(nil).ListASNumbersIterWithContext), varargs...)
+}
+
+// ListASNumbersWithContext mocks base method.
+func (m *MockASNumberServiceIface) ListASNumbersWithContext(ctx context.Context, p *ListASNumbersParams) (*ListASNumbersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListASNumbersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListASNumbersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListASNumbersWithContext indicates an expected call of ListASNumbersWithContext.
+func (mr *MockASNumberServiceIfaceMockRecorder) ListASNumbersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListASNumbersWithContext", reflect.TypeOf((*MockASNumberServiceIface)(nil).ListASNumbersWithContext), ctx, p)
+}
+
 // NewListASNumbersParams mocks base method.
 func (m *MockASNumberServiceIface) NewListASNumbersParams() *ListASNumbersParams {
 	m.ctrl.T.Helper()
@@ -115,3 +210,18 @@ func (mr *MockASNumberServiceIfaceMockRecorder) ReleaseASNumber(p any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseASNumber", reflect.TypeOf((*MockASNumberServiceIface)(nil).ReleaseASNumber), p)
 }
+
+// ReleaseASNumberWithContext mocks base method.
+func (m *MockASNumberServiceIface) ReleaseASNumberWithContext(ctx context.Context, p *ReleaseASNumberParams) (*ReleaseASNumberResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseASNumberWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseASNumberResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseASNumberWithContext indicates an expected call of ReleaseASNumberWithContext.
+func (mr *MockASNumberServiceIfaceMockRecorder) ReleaseASNumberWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseASNumberWithContext", reflect.TypeOf((*MockASNumberServiceIface)(nil).ReleaseASNumberWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,30 +31,56 @@ import (
 
 type AccountServiceIface interface {
 	CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error)
+	CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error)
 	NewCreateAccountParams(email string, firstname string, lastname string, password string, username string) *CreateAccountParams
 	DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error)
+	DeleteAccountAsync(ctx context.Context, p *DeleteAccountParams) (*Job, error)
 	NewDeleteAccountParams(id string) *DeleteAccountParams
 	DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error)
+	DisableAccountAsync(ctx context.Context, p *DisableAccountParams) (*Job, error)
 	NewDisableAccountParams(lock bool) *DisableAccountParams
 	EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error)
+	EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error)
 	NewEnableAccountParams() *EnableAccountParams
 	IsAccountAllowedToCreateOfferingsWithTags(p *IsAccountAllowedToCreateOfferingsWithTagsParams) (*IsAccountAllowedToCreateOfferingsWithTagsResponse, error)
+	IsAccountAllowedToCreateOfferingsWithTagsWithContext(ctx context.Context, p *IsAccountAllowedToCreateOfferingsWithTagsParams) (*IsAccountAllowedToCreateOfferingsWithTagsResponse, error)
 	NewIsAccountAllowedToCreateOfferingsWithTagsParams(id string) *IsAccountAllowedToCreateOfferingsWithTagsParams
 	LinkAccountToLdap(p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error)
+	LinkAccountToLdapWithContext(ctx context.Context, p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error)
 	NewLinkAccountToLdapParams(account string, domainid string, ldapdomain string) *LinkAccountToLdapParams
 	ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error)
+	ListAccountsAll(p *ListAccountsParams, opts ...PageOption) ([]*Account, error)
+	ListAccountsAllWithContext(ctx context.Context, p *ListAccountsParams, opts ...PageOption) ([]*Account, error)
+	ListAccountsIter(p *ListAccountsParams, opts ...PageOption) iter.Seq2[*Account, error]
+	ListAccountsIterWithContext(ctx context.Context, p *ListAccountsParams, opts ...PageOption) iter.Seq2[*Account, error]
 	NewListAccountsParams() *ListAccountsParams
 	GetAccountID(name string, opts ...OptionFunc) (string, int, error)
+	GetAccountIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Account, int, error)
 	GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error)
+	GetAccountByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Account, int, error)
 	ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error)
+	ListProjectAccountsAll(p *ListProjectAccountsParams, opts ...PageOption) ([]*ProjectAccount, error)
+	ListProjectAccountsAllWithContext(ctx context.Context, p *ListProjectAccountsParams, opts ...PageOption) ([]*ProjectAccount, error)
+	ListProjectAccountsIter(p *ListProjectAccountsParams, opts ...PageOption) iter.Seq2[*ProjectAccount, error]
+	ListProjectAccountsIterWithContext(ctx context.Context, p *ListProjectAccountsParams, opts ...PageOption) iter.Seq2[*ProjectAccount, error]
 	NewListProjectAccountsParams(projectid string) *ListProjectAccountsParams
 	GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error)
+	GetProjectAccountIDWithContext(ctx context.Context, keyword string, projectid string, opts ...OptionFunc) (string, int, error)
 	LockAccount(p *LockAccountParams) (*LockAccountResponse, error)
+	LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error)
 	NewLockAccountParams(account string, domainid string) *LockAccountParams
 	MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error)
+	MarkDefaultZoneForAccountAsync(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*Job, error)
 	NewMarkDefaultZoneForAccountParams(account string, domainid string, zoneid string) *MarkDefaultZoneForAccountParams
 	UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error)
+	UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error)
 	NewUpdateAccountParams() *UpdateAccountParams
 }
 
@@ -114,6 +142,57 @@ func (p *CreateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateAccountParams) Validate() error {
+	return paramRules{
+		required: []string{"email", "firstname", "lastname", "password", "username"},
+		lengths:  map[string]int{"account": 255, "accountid": 255, "domainid": 255, "email": 255, "firstname": 255, "lastname": 255, "networkdomain": 255, "password": 255, "roleid": 255, "timezone": 255, "userid": 255, "username": 255},
+	}.validate("createAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createAccount API, before the request is signed
+func (p *CreateAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createAccount", b, &p.p, map[string]paramDecoder{
+		"account":        decodeParam[string],
+		"accountdetails": decodeParam[map[string]string],
+		"accountid":      decodeParam[string],
+		"accounttype":    decodeParam[int],
+		"domainid":       decodeParam[string],
+		"email":          decodeParam[string],
+		"firstname":      decodeParam[string],
+		"lastname":       decodeParam[string],
+		"networkdomain":  decodeParam[string],
+		"password":       decodeParam[string],
+		"roleid":         decodeParam[string],
+		"timezone":       decodeParam[string],
+		"userid":         decodeParam[string],
+		"username":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateAccountParams) Clone() *CreateAccountParams {
+	return &CreateAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -423,7 +502,16 @@ func (s *AccountService) NewCreateAccountParams(email string, firstname string,
 
 // Creates an account
 func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("createAccount", p.toURLValues())
+	return s.CreateAccountWithContext(context.Background(), p)
+}
+
+// CreateAccountWithContext is like CreateAccount, but honours the cancellation and deadline of ctx
+func (s *AccountService) CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -433,7 +521,7 @@ func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountRe
 	}
 
 	var r CreateAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -442,111 +530,123 @@ func (s *AccountService) CreateAccount(p *CreateAccountParams) (*CreateAccountRe
 
 type CreateAccountResponse struct {
 	Accountdetails            map[string]string           `json:"accountdetails"`
-	Accounttype               int                         `json:"accounttype"`
+	Accounttype               FlexInt                     `json:"accounttype"`
 	Apikeyaccess              string                      `json:"apikeyaccess"`
 	Backupavailable           string                      `json:"backupavailable"`
 	Backuplimit               string                      `json:"backuplimit"`
 	Backupstorageavailable    string                      `json:"backupstorageavailable"`
 	Backupstoragelimit        string                      `json:"backupstoragelimit"`
-	Backupstoragetotal        int64                       `json:"backupstoragetotal"`
-	Backuptotal               int64                       `json:"backuptotal"`
+	Backupstoragetotal        FlexInt64                   `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                   `json:"backuptotal"`
 	Bucketavailable           string                      `json:"bucketavailable"`
 	Bucketlimit               string                      `json:"bucketlimit"`
-	Buckettotal               int64                       `json:"buckettotal"`
+	Buckettotal               FlexInt64                   `json:"buckettotal"`
 	Cpuavailable              string                      `json:"cpuavailable"`
 	Cpulimit                  string                      `json:"cpulimit"`
-	Cputotal                  int64                       `json:"cputotal"`
-	Created                   string                      `json:"created"`
+	Cputotal                  FlexInt64                   `json:"cputotal"`
+	Created                   Time                        `json:"created"`
 	Defaultzoneid             string                      `json:"defaultzoneid"`
 	Domain                    string                      `json:"domain"`
 	Domainid                  string                      `json:"domainid"`
 	Domainpath                string                      `json:"domainpath"`
 	Gpuavailable              string                      `json:"gpuavailable"`
 	Gpulimit                  string                      `json:"gpulimit"`
-	Gputotal                  int64                       `json:"gputotal"`
+	Gputotal                  FlexInt64                   `json:"gputotal"`
 	Groups                    []string                    `json:"groups"`
 	Icon                      interface{}                 `json:"icon"`
 	Id                        string                      `json:"id"`
 	Ipavailable               string                      `json:"ipavailable"`
 	Iplimit                   string                      `json:"iplimit"`
-	Iptotal                   int64                       `json:"iptotal"`
-	Iscleanuprequired         bool                        `json:"iscleanuprequired"`
-	Isdefault                 bool                        `json:"isdefault"`
+	Iptotal                   FlexInt64                   `json:"iptotal"`
+	Iscleanuprequired         FlexBool                    `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                    `json:"isdefault"`
 	JobID                     string                      `json:"jobid"`
-	Jobstatus                 int                         `json:"jobstatus"`
+	Jobstatus                 FlexInt                     `json:"jobstatus"`
 	Memoryavailable           string                      `json:"memoryavailable"`
 	Memorylimit               string                      `json:"memorylimit"`
-	Memorytotal               int64                       `json:"memorytotal"`
+	Memorytotal               FlexInt64                   `json:"memorytotal"`
 	Name                      string                      `json:"name"`
 	Networkavailable          string                      `json:"networkavailable"`
 	Networkdomain             string                      `json:"networkdomain"`
 	Networklimit              string                      `json:"networklimit"`
-	Networktotal              int64                       `json:"networktotal"`
+	Networktotal              FlexInt64                   `json:"networktotal"`
 	Objectstorageavailable    string                      `json:"objectstorageavailable"`
 	Objectstoragelimit        string                      `json:"objectstoragelimit"`
-	Objectstoragetotal        int64                       `json:"objectstoragetotal"`
+	Objectstoragetotal        FlexInt64                   `json:"objectstoragetotal"`
 	Primarystorageavailable   string                      `json:"primarystorageavailable"`
 	Primarystoragelimit       string                      `json:"primarystoragelimit"`
-	Primarystoragetotal       int64                       `json:"primarystoragetotal"`
+	Primarystoragetotal       FlexInt64                   `json:"primarystoragetotal"`
 	Projectavailable          string                      `json:"projectavailable"`
 	Projectlimit              string                      `json:"projectlimit"`
-	Projecttotal              int64                       `json:"projecttotal"`
-	Receivedbytes             int64                       `json:"receivedbytes"`
+	Projecttotal              FlexInt64                   `json:"projecttotal"`
+	Receivedbytes             FlexInt64                   `json:"receivedbytes"`
 	Roleid                    string                      `json:"roleid"`
 	Rolename                  string                      `json:"rolename"`
 	Roletype                  string                      `json:"roletype"`
 	Secondarystorageavailable string                      `json:"secondarystorageavailable"`
 	Secondarystoragelimit     string                      `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64                     `json:"secondarystoragetotal"`
-	Sentbytes                 int64                       `json:"sentbytes"`
+	Secondarystoragetotal     FlexFloat                   `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                   `json:"sentbytes"`
 	Snapshotavailable         string                      `json:"snapshotavailable"`
 	Snapshotlimit             string                      `json:"snapshotlimit"`
-	Snapshottotal             int64                       `json:"snapshottotal"`
+	Snapshottotal             FlexInt64                   `json:"snapshottotal"`
 	State                     string                      `json:"state"`
 	Taggedresources           []string                    `json:"taggedresources"`
 	Templateavailable         string                      `json:"templateavailable"`
 	Templatelimit             string                      `json:"templatelimit"`
-	Templatetotal             int64                       `json:"templatetotal"`
+	Templatetotal             FlexInt64                   `json:"templatetotal"`
 	User                      []CreateAccountResponseUser `json:"user"`
 	Vmavailable               string                      `json:"vmavailable"`
 	Vmlimit                   string                      `json:"vmlimit"`
-	Vmrunning                 int                         `json:"vmrunning"`
-	Vmstopped                 int                         `json:"vmstopped"`
-	Vmtotal                   int64                       `json:"vmtotal"`
+	Vmrunning                 FlexInt                     `json:"vmrunning"`
+	Vmstopped                 FlexInt                     `json:"vmstopped"`
+	Vmtotal                   FlexInt64                   `json:"vmtotal"`
 	Volumeavailable           string                      `json:"volumeavailable"`
 	Volumelimit               string                      `json:"volumelimit"`
-	Volumetotal               int64                       `json:"volumetotal"`
+	Volumetotal               FlexInt64                   `json:"volumetotal"`
 	Vpcavailable              string                      `json:"vpcavailable"`
 	Vpclimit                  string                      `json:"vpclimit"`
-	Vpctotal                  int64                       `json:"vpctotal"`
+	Vpctotal                  FlexInt64                   `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *CreateAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateAccountResponseUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateAccountResponseUser) UnmarshalJSON(b []byte) error {
+	type alias CreateAccountResponseUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteAccountParams struct {
@@ -564,6 +664,44 @@ func (p *DeleteAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteAccountParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteAccount API, before the request is signed
+func (p *DeleteAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteAccount", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteAccountParams) Clone() *DeleteAccountParams {
+	return &DeleteAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteAccountParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -596,27 +734,39 @@ func (s *AccountService) NewDeleteAccountParams(id string) *DeleteAccountParams
 
 // Deletes an Account and all Users associated with this Account
 func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteAccount", p.toURLValues())
+	return s.DeleteAccountWithContext(context.Background(), p)
+}
+
+// DeleteAccountWithContext is like DeleteAccount, but honours the cancellation and deadline of ctx
+func (s *AccountService) DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteAccount", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteAccount", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -624,11 +774,31 @@ func (s *AccountService) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteAccount async job into r
+func (r *DeleteAccountResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteAccountAsync starts the async job of DeleteAccount without waiting for it to finish, and returns a handle to the job
+func (s *AccountService) DeleteAccountAsync(ctx context.Context, p *DeleteAccountParams) (*Job, error) {
+	r, err := s.DeleteAccountWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteAccount", r.JobID), nil
+}
+
 type DeleteAccountResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableAccountParams struct {
@@ -656,6 +826,47 @@ func (p *DisableAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableAccountParams) Validate() error {
+	return paramRules{
+		required: []string{"lock"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255},
+	}.validate("disableAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableAccount API, before the request is signed
+func (p *DisableAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableAccount", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"id":       decodeParam[string],
+		"lock":     decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableAccountParams) Clone() *DisableAccountParams {
+	return &DisableAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -751,32 +962,39 @@ func (s *AccountService) NewDisableAccountParams(lock bool) *DisableAccountParam
 
 // Disables an account
 func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("disableAccount", p.toURLValues())
+	return s.DisableAccountWithContext(context.Background(), p)
+}
+
+// DisableAccountWithContext is like DisableAccount, but honours the cancellation and deadline of ctx
+func (s *AccountService) DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableAccount", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableAccount", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -784,113 +1002,144 @@ func (s *AccountService) DisableAccount(p *DisableAccountParams) (*DisableAccoun
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableAccount async job into r
+func (r *DisableAccountResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DisableAccountAsync starts the async job of DisableAccount without waiting for it to finish, and returns a handle to the job
+func (s *AccountService) DisableAccountAsync(ctx context.Context, p *DisableAccountParams) (*Job, error) {
+	r, err := s.DisableAccountWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableAccount", r.JobID), nil
+}
+
 type DisableAccountResponse struct {
 	Accountdetails            map[string]string            `json:"accountdetails"`
-	Accounttype               int                          `json:"accounttype"`
+	Accounttype               FlexInt                      `json:"accounttype"`
 	Apikeyaccess              string                       `json:"apikeyaccess"`
 	Backupavailable           string                       `json:"backupavailable"`
 	Backuplimit               string                       `json:"backuplimit"`
 	Backupstorageavailable    string                       `json:"backupstorageavailable"`
 	Backupstoragelimit        string                       `json:"backupstoragelimit"`
-	Backupstoragetotal        int64                        `json:"backupstoragetotal"`
-	Backuptotal               int64                        `json:"backuptotal"`
+	Backupstoragetotal        FlexInt64                    `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                    `json:"backuptotal"`
 	Bucketavailable           string                       `json:"bucketavailable"`
 	Bucketlimit               string                       `json:"bucketlimit"`
-	Buckettotal               int64                        `json:"buckettotal"`
+	Buckettotal               FlexInt64                    `json:"buckettotal"`
 	Cpuavailable              string                       `json:"cpuavailable"`
 	Cpulimit                  string                       `json:"cpulimit"`
-	Cputotal                  int64                        `json:"cputotal"`
-	Created                   string                       `json:"created"`
+	Cputotal                  FlexInt64                    `json:"cputotal"`
+	Created                   Time                         `json:"created"`
 	Defaultzoneid             string                       `json:"defaultzoneid"`
 	Domain                    string                       `json:"domain"`
 	Domainid                  string                       `json:"domainid"`
 	Domainpath                string                       `json:"domainpath"`
 	Gpuavailable              string                       `json:"gpuavailable"`
 	Gpulimit                  string                       `json:"gpulimit"`
-	Gputotal                  int64                        `json:"gputotal"`
+	Gputotal                  FlexInt64                    `json:"gputotal"`
 	Groups                    []string                     `json:"groups"`
 	Icon                      interface{}                  `json:"icon"`
 	Id                        string                       `json:"id"`
 	Ipavailable               string                       `json:"ipavailable"`
 	Iplimit                   string                       `json:"iplimit"`
-	Iptotal                   int64                        `json:"iptotal"`
-	Iscleanuprequired         bool                         `json:"iscleanuprequired"`
-	Isdefault                 bool                         `json:"isdefault"`
+	Iptotal                   FlexInt64                    `json:"iptotal"`
+	Iscleanuprequired         FlexBool                     `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                     `json:"isdefault"`
 	JobID                     string                       `json:"jobid"`
-	Jobstatus                 int                          `json:"jobstatus"`
+	Jobstatus                 FlexInt                      `json:"jobstatus"`
 	Memoryavailable           string                       `json:"memoryavailable"`
 	Memorylimit               string                       `json:"memorylimit"`
-	Memorytotal               int64                        `json:"memorytotal"`
+	Memorytotal               FlexInt64                    `json:"memorytotal"`
 	Name                      string                       `json:"name"`
 	Networkavailable          string                       `json:"networkavailable"`
 	Networkdomain             string                       `json:"networkdomain"`
 	Networklimit              string                       `json:"networklimit"`
-	Networktotal              int64                        `json:"networktotal"`
+	Networktotal              FlexInt64                    `json:"networktotal"`
 	Objectstorageavailable    string                       `json:"objectstorageavailable"`
 	Objectstoragelimit        string                       `json:"objectstoragelimit"`
-	Objectstoragetotal        int64                        `json:"objectstoragetotal"`
+	Objectstoragetotal        FlexInt64                    `json:"objectstoragetotal"`
 	Primarystorageavailable   string                       `json:"primarystorageavailable"`
 	Primarystoragelimit       string                       `json:"primarystoragelimit"`
-	Primarystoragetotal       int64                        `json:"primarystoragetotal"`
+	Primarystoragetotal       FlexInt64                    `json:"primarystoragetotal"`
 	Projectavailable          string                       `json:"projectavailable"`
 	Projectlimit              string                       `json:"projectlimit"`
-	Projecttotal              int64                        `json:"projecttotal"`
-	Receivedbytes             int64                        `json:"receivedbytes"`
+	Projecttotal              FlexInt64                    `json:"projecttotal"`
+	Receivedbytes             FlexInt64                    `json:"receivedbytes"`
 	Roleid                    string                       `json:"roleid"`
 	Rolename                  string                       `json:"rolename"`
 	Roletype                  string                       `json:"roletype"`
 	Secondarystorageavailable string                       `json:"secondarystorageavailable"`
 	Secondarystoragelimit     string                       `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64                      `json:"secondarystoragetotal"`
-	Sentbytes                 int64                        `json:"sentbytes"`
+	Secondarystoragetotal     FlexFloat                    `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                    `json:"sentbytes"`
 	Snapshotavailable         string                       `json:"snapshotavailable"`
 	Snapshotlimit             string                       `json:"snapshotlimit"`
-	Snapshottotal             int64                        `json:"snapshottotal"`
+	Snapshottotal             FlexInt64                    `json:"snapshottotal"`
 	State                     string                       `json:"state"`
 	Taggedresources           []string                     `json:"taggedresources"`
 	Templateavailable         string                       `json:"templateavailable"`
 	Templatelimit             string                       `json:"templatelimit"`
-	Templatetotal             int64                        `json:"templatetotal"`
+	Templatetotal             FlexInt64                    `json:"templatetotal"`
 	User                      []DisableAccountResponseUser `json:"user"`
 	Vmavailable               string                       `json:"vmavailable"`
 	Vmlimit                   string                       `json:"vmlimit"`
-	Vmrunning                 int                          `json:"vmrunning"`
-	Vmstopped                 int                          `json:"vmstopped"`
-	Vmtotal                   int64                        `json:"vmtotal"`
+	Vmrunning                 FlexInt                      `json:"vmrunning"`
+	Vmstopped                 FlexInt                      `json:"vmstopped"`
+	Vmtotal                   FlexInt64                    `json:"vmtotal"`
 	Volumeavailable           string                       `json:"volumeavailable"`
 	Volumelimit               string                       `json:"volumelimit"`
-	Volumetotal               int64                        `json:"volumetotal"`
+	Volumetotal               FlexInt64                    `json:"volumetotal"`
 	Vpcavailable              string                       `json:"vpcavailable"`
 	Vpclimit                  string                       `json:"vpclimit"`
-	Vpctotal                  int64                        `json:"vpctotal"`
+	Vpctotal                  FlexInt64                    `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage   `json:"-"`
+}
+
+func (r *DisableAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableAccountResponseUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableAccountResponseUser) UnmarshalJSON(b []byte) error {
+	type alias DisableAccountResponseUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableAccountParams struct {
@@ -914,6 +1163,45 @@ func (p *EnableAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableAccountParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255},
+	}.validate("enableAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableAccount API, before the request is signed
+func (p *EnableAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableAccount", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"id":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableAccountParams) Clone() *EnableAccountParams {
+	return &EnableAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -987,13 +1275,22 @@ func (s *AccountService) NewEnableAccountParams() *EnableAccountParams {
 
 // Enables an account
 func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("enableAccount", p.toURLValues())
+	return s.EnableAccountWithContext(context.Background(), p)
+}
+
+// EnableAccountWithContext is like EnableAccount, but honours the cancellation and deadline of ctx
+func (s *AccountService) EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "enableAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1002,111 +1299,123 @@ func (s *AccountService) EnableAccount(p *EnableAccountParams) (*EnableAccountRe
 
 type EnableAccountResponse struct {
 	Accountdetails            map[string]string           `json:"accountdetails"`
-	Accounttype               int                         `json:"accounttype"`
+	Accounttype               FlexInt                     `json:"accounttype"`
 	Apikeyaccess              string                      `json:"apikeyaccess"`
 	Backupavailable           string                      `json:"backupavailable"`
 	Backuplimit               string                      `json:"backuplimit"`
 	Backupstorageavailable    string                      `json:"backupstorageavailable"`
 	Backupstoragelimit        string                      `json:"backupstoragelimit"`
-	Backupstoragetotal        int64                       `json:"backupstoragetotal"`
-	Backuptotal               int64                       `json:"backuptotal"`
+	Backupstoragetotal        FlexInt64                   `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                   `json:"backuptotal"`
 	Bucketavailable           string                      `json:"bucketavailable"`
 	Bucketlimit               string                      `json:"bucketlimit"`
-	Buckettotal               int64                       `json:"buckettotal"`
+	Buckettotal               FlexInt64                   `json:"buckettotal"`
 	Cpuavailable              string                      `json:"cpuavailable"`
 	Cpulimit                  string                      `json:"cpulimit"`
-	Cputotal                  int64                       `json:"cputotal"`
-	Created                   string                      `json:"created"`
+	Cputotal                  FlexInt64                   `json:"cputotal"`
+	Created                   Time                        `json:"created"`
 	Defaultzoneid             string                      `json:"defaultzoneid"`
 	Domain                    string                      `json:"domain"`
 	Domainid                  string                      `json:"domainid"`
 	Domainpath                string                      `json:"domainpath"`
 	Gpuavailable              string                      `json:"gpuavailable"`
 	Gpulimit                  string                      `json:"gpulimit"`
-	Gputotal                  int64                       `json:"gputotal"`
+	Gputotal                  FlexInt64                   `json:"gputotal"`
 	Groups                    []string                    `json:"groups"`
 	Icon                      interface{}                 `json:"icon"`
 	Id                        string                      `json:"id"`
 	Ipavailable               string                      `json:"ipavailable"`
 	Iplimit                   string                      `json:"iplimit"`
-	Iptotal                   int64                       `json:"iptotal"`
-	Iscleanuprequired         bool                        `json:"iscleanuprequired"`
-	Isdefault                 bool                        `json:"isdefault"`
+	Iptotal                   FlexInt64                   `json:"iptotal"`
+	Iscleanuprequired         FlexBool                    `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                    `json:"isdefault"`
 	JobID                     string                      `json:"jobid"`
-	Jobstatus                 int                         `json:"jobstatus"`
+	Jobstatus                 FlexInt                     `json:"jobstatus"`
 	Memoryavailable           string                      `json:"memoryavailable"`
 	Memorylimit               string                      `json:"memorylimit"`
-	Memorytotal               int64                       `json:"memorytotal"`
+	Memorytotal               FlexInt64                   `json:"memorytotal"`
 	Name                      string                      `json:"name"`
 	Networkavailable          string                      `json:"networkavailable"`
 	Networkdomain             string                      `json:"networkdomain"`
 	Networklimit              string                      `json:"networklimit"`
-	Networktotal              int64                       `json:"networktotal"`
+	Networktotal              FlexInt64                   `json:"networktotal"`
 	Objectstorageavailable    string                      `json:"objectstorageavailable"`
 	Objectstoragelimit        string                      `json:"objectstoragelimit"`
-	Objectstoragetotal        int64                       `json:"objectstoragetotal"`
+	Objectstoragetotal        FlexInt64                   `json:"objectstoragetotal"`
 	Primarystorageavailable   string                      `json:"primarystorageavailable"`
 	Primarystoragelimit       string                      `json:"primarystoragelimit"`
-	Primarystoragetotal       int64                       `json:"primarystoragetotal"`
+	Primarystoragetotal       FlexInt64                   `json:"primarystoragetotal"`
 	Projectavailable          string                      `json:"projectavailable"`
 	Projectlimit              string                      `json:"projectlimit"`
-	Projecttotal              int64                       `json:"projecttotal"`
-	Receivedbytes             int64                       `json:"receivedbytes"`
+	Projecttotal              FlexInt64                   `json:"projecttotal"`
+	Receivedbytes             FlexInt64                   `json:"receivedbytes"`
 	Roleid                    string                      `json:"roleid"`
 	Rolename                  string                      `json:"rolename"`
 	Roletype                  string                      `json:"roletype"`
 	Secondarystorageavailable string                      `json:"secondarystorageavailable"`
 	Secondarystoragelimit     string                      `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64                     `json:"secondarystoragetotal"`
-	Sentbytes                 int64                       `json:"sentbytes"`
+	Secondarystoragetotal     FlexFloat                   `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                   `json:"sentbytes"`
 	Snapshotavailable         string                      `json:"snapshotavailable"`
 	Snapshotlimit             string                      `json:"snapshotlimit"`
-	Snapshottotal             int64                       `json:"snapshottotal"`
+	Snapshottotal             FlexInt64                   `json:"snapshottotal"`
 	State                     string                      `json:"state"`
 	Taggedresources           []string                    `json:"taggedresources"`
 	Templateavailable         string                      `json:"templateavailable"`
 	Templatelimit             string                      `json:"templatelimit"`
-	Templatetotal             int64                       `json:"templatetotal"`
+	Templatetotal             FlexInt64                   `json:"templatetotal"`
 	User                      []EnableAccountResponseUser `json:"user"`
 	Vmavailable               string                      `json:"vmavailable"`
 	Vmlimit                   string                      `json:"vmlimit"`
-	Vmrunning                 int                         `json:"vmrunning"`
-	Vmstopped                 int                         `json:"vmstopped"`
-	Vmtotal                   int64                       `json:"vmtotal"`
+	Vmrunning                 FlexInt                     `json:"vmrunning"`
+	Vmstopped                 FlexInt                     `json:"vmstopped"`
+	Vmtotal                   FlexInt64                   `json:"vmtotal"`
 	Volumeavailable           string                      `json:"volumeavailable"`
 	Volumelimit               string                      `json:"volumelimit"`
-	Volumetotal               int64                       `json:"volumetotal"`
+	Volumetotal               FlexInt64                   `json:"volumetotal"`
 	Vpcavailable              string                      `json:"vpcavailable"`
 	Vpclimit                  string                      `json:"vpclimit"`
-	Vpctotal                  int64                       `json:"vpctotal"`
+	Vpctotal                  FlexInt64                   `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *EnableAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableAccountResponseUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableAccountResponseUser) UnmarshalJSON(b []byte) error {
+	type alias EnableAccountResponseUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type IsAccountAllowedToCreateOfferingsWithTagsParams struct {
@@ -1124,6 +1433,44 @@ func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) toURLValues() url.Valu
 	return u
 }
 
+// ValidateAgainst checks the parameters against the isAccountAllowedToCreateOfferingsWithTags API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("isAccountAllowedToCreateOfferingsWithTags", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the isAccountAllowedToCreateOfferingsWithTags API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("isAccountAllowedToCreateOfferingsWithTags", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the isAccountAllowedToCreateOfferingsWithTags API, before the request is signed
+func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("isAccountAllowedToCreateOfferingsWithTags", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) Clone() *IsAccountAllowedToCreateOfferingsWithTagsParams {
+	return &IsAccountAllowedToCreateOfferingsWithTagsParams{p: cloneParams(p.p)}
+}
+
 func (p *IsAccountAllowedToCreateOfferingsWithTagsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1156,13 +1503,22 @@ func (s *AccountService) NewIsAccountAllowedToCreateOfferingsWithTagsParams(id s
 
 // Return true if the specified account is allowed to create offerings with tags.
 func (s *AccountService) IsAccountAllowedToCreateOfferingsWithTags(p *IsAccountAllowedToCreateOfferingsWithTagsParams) (*IsAccountAllowedToCreateOfferingsWithTagsResponse, error) {
-	resp, err := s.cs.newRequest("isAccountAllowedToCreateOfferingsWithTags", p.toURLValues())
+	return s.IsAccountAllowedToCreateOfferingsWithTagsWithContext(context.Background(), p)
+}
+
+// IsAccountAllowedToCreateOfferingsWithTagsWithContext is like IsAccountAllowedToCreateOfferingsWithTags, but honours the cancellation and deadline of ctx
+func (s *AccountService) IsAccountAllowedToCreateOfferingsWithTagsWithContext(ctx context.Context, p *IsAccountAllowedToCreateOfferingsWithTagsParams) (*IsAccountAllowedToCreateOfferingsWithTagsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "isAccountAllowedToCreateOfferingsWithTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r IsAccountAllowedToCreateOfferingsWithTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("isAccountAllowedToCreateOfferingsWithTags", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1170,9 +1526,15 @@ func (s *AccountService) IsAccountAllowedToCreateOfferingsWithTags(p *IsAccountA
 }
 
 type IsAccountAllowedToCreateOfferingsWithTagsResponse struct {
-	Isallowed bool   `json:"isallowed"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
+	Isallowed FlexBool                   `json:"isallowed"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *IsAccountAllowedToCreateOfferingsWithTagsResponse) UnmarshalJSON(b []byte) error {
+	type alias IsAccountAllowedToCreateOfferingsWithTagsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LinkAccountToLdapParams struct {
@@ -1209,6 +1571,50 @@ func (p *LinkAccountToLdapParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the linkAccountToLdap API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LinkAccountToLdapParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("linkAccountToLdap", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the linkAccountToLdap API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LinkAccountToLdapParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "domainid", "ldapdomain"},
+		lengths:  map[string]int{"account": 255, "admin": 255, "domainid": 255, "ldapdomain": 255, "roleid": 255, "type": 255},
+	}.validate("linkAccountToLdap", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the linkAccountToLdap API, before the request is signed
+func (p *LinkAccountToLdapParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LinkAccountToLdapParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LinkAccountToLdapParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("linkAccountToLdap", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"accounttype": decodeParam[int],
+		"admin":       decodeParam[string],
+		"domainid":    decodeParam[string],
+		"ldapdomain":  decodeParam[string],
+		"roleid":      decodeParam[string],
+		"type":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LinkAccountToLdapParams) Clone() *LinkAccountToLdapParams {
+	return &LinkAccountToLdapParams{p: cloneParams(p.p)}
+}
+
 func (p *LinkAccountToLdapParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1369,13 +1775,22 @@ func (s *AccountService) NewLinkAccountToLdapParams(account string, domainid str
 
 // Link a cloudstack account to a group or OU in ldap
 func (s *AccountService) LinkAccountToLdap(p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error) {
-	resp, err := s.cs.newPostRequest("linkAccountToLdap", p.toURLValues())
+	return s.LinkAccountToLdapWithContext(context.Background(), p)
+}
+
+// LinkAccountToLdapWithContext is like LinkAccountToLdap, but honours the cancellation and deadline of ctx
+func (s *AccountService) LinkAccountToLdapWithContext(ctx context.Context, p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "linkAccountToLdap", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LinkAccountToLdapResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("linkAccountToLdap", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1383,14 +1798,20 @@ func (s *AccountService) LinkAccountToLdap(p *LinkAccountToLdapParams) (*LinkAcc
 }
 
 type LinkAccountToLdapResponse struct {
-	Accountid   string `json:"accountid"`
-	Accounttype int    `json:"accounttype"`
-	Domainid    string `json:"domainid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Ldapdomain  string `json:"ldapdomain"`
-	Name        string `json:"name"`
-	Type        string `json:"type"`
+	Accountid   string                     `json:"accountid"`
+	Accounttype FlexInt                    `json:"accounttype"`
+	Domainid    string                     `json:"domainid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Ldapdomain  string                     `json:"ldapdomain"`
+	Name        string                     `json:"name"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LinkAccountToLdapResponse) UnmarshalJSON(b []byte) error {
+	type alias LinkAccountToLdapResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAccountsParams struct {
@@ -1458,6 +1879,57 @@ func (p *ListAccountsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAccounts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAccountsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAccounts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAccounts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAccountsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"apikeyaccess": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "state": 255, "tag": 255},
+	}.validate("listAccounts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAccounts API, before the request is signed
+func (p *ListAccountsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAccountsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAccountsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAccounts", b, &p.p, map[string]paramDecoder{
+		"accounttype":       decodeParam[int],
+		"apikeyaccess":      decodeParam[string],
+		"details":           decodeParam[[]string],
+		"domainid":          decodeParam[string],
+		"id":                decodeParam[string],
+		"iscleanuprequired": decodeParam[bool],
+		"isrecursive":       decodeParam[bool],
+		"keyword":           decodeParam[string],
+		"listall":           decodeParam[bool],
+		"name":              decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"showicon":          decodeParam[bool],
+		"state":             decodeParam[string],
+		"tag":               decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAccountsParams) Clone() *ListAccountsParams {
+	return &ListAccountsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAccountsParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1783,6 +2255,11 @@ func (s *AccountService) NewListAccountsParams() *ListAccountsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAccountIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAccountIDWithContext is like GetAccountID, but honours the cancellation and deadline of ctx
+func (s *AccountService) GetAccountIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAccountsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1794,7 +2271,7 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListAccounts(p)
+	l, err := s.ListAccountsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1819,12 +2296,17 @@ func (s *AccountService) GetAccountID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error) {
-	id, count, err := s.GetAccountID(name, opts...)
+	return s.GetAccountByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAccountByNameWithContext is like GetAccountByName, but honours the cancellation and deadline of ctx
+func (s *AccountService) GetAccountByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Account, int, error) {
+	id, count, err := s.GetAccountIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAccountByID(id, opts...)
+	r, count, err := s.GetAccountByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1833,6 +2315,11 @@ func (s *AccountService) GetAccountByName(name string, opts ...OptionFunc) (*Acc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error) {
+	return s.GetAccountByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAccountByIDWithContext is like GetAccountByID, but honours the cancellation and deadline of ctx
+func (s *AccountService) GetAccountByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Account, int, error) {
 	p := &ListAccountsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1844,11 +2331,10 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 		}
 	}
 
-	l, err := s.ListAccounts(p)
+	l, err := s.ListAccountsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1866,131 +2352,180 @@ func (s *AccountService) GetAccountByID(id string, opts ...OptionFunc) (*Account
 
 // Lists Accounts and provides detailed Account information for listed Accounts
 func (s *AccountService) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listAccounts", p.toURLValues())
+	return s.ListAccountsWithContext(context.Background(), p)
+}
+
+// ListAccountsWithContext is like ListAccounts, but honours the cancellation and deadline of ctx
+func (s *AccountService) ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAccounts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAccountsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAccounts", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAccountsAll returns all Accounts matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AccountService) ListAccountsAll(p *ListAccountsParams, opts ...PageOption) ([]*Account, error) {
+	return s.ListAccountsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAccountsAllWithContext is like ListAccountsAll, but honours the cancellation and deadline of ctx
+func (s *AccountService) ListAccountsAllWithContext(ctx context.Context, p *ListAccountsParams, opts ...PageOption) ([]*Account, error) {
+	return collectPages(s.ListAccountsIterWithContext(ctx, p, opts...))
+}
+
+// ListAccountsIter returns an iterator over all Accounts matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AccountService) ListAccountsIter(p *ListAccountsParams, opts ...PageOption) iter.Seq2[*Account, error] {
+	return s.ListAccountsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAccountsIterWithContext is like ListAccountsIter, but honours the cancellation and deadline of ctx
+func (s *AccountService) ListAccountsIterWithContext(ctx context.Context, p *ListAccountsParams, opts ...PageOption) iter.Seq2[*Account, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Account, int, error) {
+		l, err := s.ListAccountsWithContext(ctx, &ListAccountsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Accounts, l.Count, nil
+	}, opts...)
+}
+
 type ListAccountsResponse struct {
 	Count    int        `json:"count"`
 	Accounts []*Account `json:"account"`
 }
 
 type Account struct {
-	Accountdetails            map[string]string `json:"accountdetails"`
-	Accounttype               int               `json:"accounttype"`
-	Apikeyaccess              string            `json:"apikeyaccess"`
-	Backupavailable           string            `json:"backupavailable"`
-	Backuplimit               string            `json:"backuplimit"`
-	Backupstorageavailable    string            `json:"backupstorageavailable"`
-	Backupstoragelimit        string            `json:"backupstoragelimit"`
-	Backupstoragetotal        int64             `json:"backupstoragetotal"`
-	Backuptotal               int64             `json:"backuptotal"`
-	Bucketavailable           string            `json:"bucketavailable"`
-	Bucketlimit               string            `json:"bucketlimit"`
-	Buckettotal               int64             `json:"buckettotal"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Created                   string            `json:"created"`
-	Defaultzoneid             string            `json:"defaultzoneid"`
-	Domain                    string            `json:"domain"`
-	Domainid                  string            `json:"domainid"`
-	Domainpath                string            `json:"domainpath"`
-	Gpuavailable              string            `json:"gpuavailable"`
-	Gpulimit                  string            `json:"gpulimit"`
-	Gputotal                  int64             `json:"gputotal"`
-	Groups                    []string          `json:"groups"`
-	Icon                      interface{}       `json:"icon"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	Iscleanuprequired         bool              `json:"iscleanuprequired"`
-	Isdefault                 bool              `json:"isdefault"`
-	JobID                     string            `json:"jobid"`
-	Jobstatus                 int               `json:"jobstatus"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Objectstorageavailable    string            `json:"objectstorageavailable"`
-	Objectstoragelimit        string            `json:"objectstoragelimit"`
-	Objectstoragetotal        int64             `json:"objectstoragetotal"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Receivedbytes             int64             `json:"receivedbytes"`
-	Roleid                    string            `json:"roleid"`
-	Rolename                  string            `json:"rolename"`
-	Roletype                  string            `json:"roletype"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64           `json:"secondarystoragetotal"`
-	Sentbytes                 int64             `json:"sentbytes"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Taggedresources           []string          `json:"taggedresources"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
-	User                      []AccountUser     `json:"user"`
-	Vmavailable               string            `json:"vmavailable"`
-	Vmlimit                   string            `json:"vmlimit"`
-	Vmrunning                 int               `json:"vmrunning"`
-	Vmstopped                 int               `json:"vmstopped"`
-	Vmtotal                   int64             `json:"vmtotal"`
-	Volumeavailable           string            `json:"volumeavailable"`
-	Volumelimit               string            `json:"volumelimit"`
-	Volumetotal               int64             `json:"volumetotal"`
-	Vpcavailable              string            `json:"vpcavailable"`
-	Vpclimit                  string            `json:"vpclimit"`
-	Vpctotal                  int64             `json:"vpctotal"`
+	Accountdetails            map[string]string          `json:"accountdetails"`
+	Accounttype               FlexInt                    `json:"accounttype"`
+	Apikeyaccess              string                     `json:"apikeyaccess"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Defaultzoneid             string                     `json:"defaultzoneid"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Domainpath                string                     `json:"domainpath"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Groups                    []string                   `json:"groups"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	Iscleanuprequired         FlexBool                   `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                   `json:"isdefault"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networkdomain             string                     `json:"networkdomain"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectavailable          string                     `json:"projectavailable"`
+	Projectlimit              string                     `json:"projectlimit"`
+	Projecttotal              FlexInt64                  `json:"projecttotal"`
+	Receivedbytes             FlexInt64                  `json:"receivedbytes"`
+	Roleid                    string                     `json:"roleid"`
+	Rolename                  string                     `json:"rolename"`
+	Roletype                  string                     `json:"roletype"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                  `json:"sentbytes"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	User                      []AccountUser              `json:"user"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Account) UnmarshalJSON(b []byte) error {
+	type alias Account
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AccountUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AccountUser) UnmarshalJSON(b []byte) error {
+	type alias AccountUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListProjectAccountsParams struct {
@@ -2031,6 +2566,51 @@ func (p *ListProjectAccountsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listProjectAccounts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListProjectAccountsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listProjectAccounts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listProjectAccounts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListProjectAccountsParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid"},
+		lengths:  map[string]int{"account": 255, "keyword": 255, "projectid": 255, "projectroleid": 255, "role": 255, "userid": 255},
+	}.validate("listProjectAccounts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listProjectAccounts API, before the request is signed
+func (p *ListProjectAccountsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListProjectAccountsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListProjectAccountsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listProjectAccounts", b, &p.p, map[string]paramDecoder{
+		"account":       decodeParam[string],
+		"keyword":       decodeParam[string],
+		"page":          decodeParam[int],
+		"pagesize":      decodeParam[int],
+		"projectid":     decodeParam[string],
+		"projectroleid": decodeParam[string],
+		"role":          decodeParam[string],
+		"userid":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListProjectAccountsParams) Clone() *ListProjectAccountsParams {
+	return &ListProjectAccountsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListProjectAccountsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2210,6 +2790,11 @@ func (s *AccountService) NewListProjectAccountsParams(projectid string) *ListPro
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AccountService) GetProjectAccountID(keyword string, projectid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetProjectAccountIDWithContext(context.Background(), keyword, projectid, opts...)
+}
+
+// GetProjectAccountIDWithContext is like GetProjectAccountID, but honours the cancellation and deadline of ctx
+func (s *AccountService) GetProjectAccountIDWithContext(ctx context.Context, keyword string, projectid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectAccountsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2222,7 +2807,7 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 		}
 	}
 
-	l, err := s.ListProjectAccounts(p)
+	l, err := s.ListProjectAccountsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2247,103 +2832,152 @@ func (s *AccountService) GetProjectAccountID(keyword string, projectid string, o
 
 // Lists project's Accounts
 func (s *AccountService) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectAccounts", p.toURLValues())
+	return s.ListProjectAccountsWithContext(context.Background(), p)
+}
+
+// ListProjectAccountsWithContext is like ListProjectAccounts, but honours the cancellation and deadline of ctx
+func (s *AccountService) ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listProjectAccounts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectAccountsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listProjectAccounts", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListProjectAccountsAll returns all ProjectAccounts matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AccountService) ListProjectAccountsAll(p *ListProjectAccountsParams, opts ...PageOption) ([]*ProjectAccount, error) {
+	return s.ListProjectAccountsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectAccountsAllWithContext is like ListProjectAccountsAll, but honours the cancellation and deadline of ctx
+func (s *AccountService) ListProjectAccountsAllWithContext(ctx context.Context, p *ListProjectAccountsParams, opts ...PageOption) ([]*ProjectAccount, error) {
+	return collectPages(s.ListProjectAccountsIterWithContext(ctx, p, opts...))
+}
+
+// ListProjectAccountsIter returns an iterator over all ProjectAccounts matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AccountService) ListProjectAccountsIter(p *ListProjectAccountsParams, opts ...PageOption) iter.Seq2[*ProjectAccount, error] {
+	return s.ListProjectAccountsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectAccountsIterWithContext is like ListProjectAccountsIter, but honours the cancellation and deadline of ctx
+func (s *AccountService) ListProjectAccountsIterWithContext(ctx context.Context, p *ListProjectAccountsParams, opts ...PageOption) iter.Seq2[*ProjectAccount, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ProjectAccount, int, error) {
+		l, err := s.ListProjectAccountsWithContext(ctx, &ListProjectAccountsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ProjectAccounts, l.Count, nil
+	}, opts...)
+}
+
 type ListProjectAccountsResponse struct {
 	Count           int               `json:"count"`
 	ProjectAccounts []*ProjectAccount `json:"projectaccount"`
 }
 
 type ProjectAccount struct {
-	Backupavailable           string              `json:"backupavailable"`
-	Backuplimit               string              `json:"backuplimit"`
-	Backupstorageavailable    string              `json:"backupstorageavailable"`
-	Backupstoragelimit        string              `json:"backupstoragelimit"`
-	Backupstoragetotal        int64               `json:"backupstoragetotal"`
-	Backuptotal               int64               `json:"backuptotal"`
-	Bucketavailable           string              `json:"bucketavailable"`
-	Bucketlimit               string              `json:"bucketlimit"`
-	Buckettotal               int64               `json:"buckettotal"`
-	Cpuavailable              string              `json:"cpuavailable"`
-	Cpulimit                  string              `json:"cpulimit"`
-	Cputotal                  int64               `json:"cputotal"`
-	Created                   string              `json:"created"`
-	Displaytext               string              `json:"displaytext"`
-	Domain                    string              `json:"domain"`
-	Domainid                  string              `json:"domainid"`
-	Gpuavailable              string              `json:"gpuavailable"`
-	Gpulimit                  string              `json:"gpulimit"`
-	Gputotal                  int64               `json:"gputotal"`
-	Icon                      interface{}         `json:"icon"`
-	Id                        string              `json:"id"`
-	Ipavailable               string              `json:"ipavailable"`
-	Iplimit                   string              `json:"iplimit"`
-	Iptotal                   int64               `json:"iptotal"`
-	JobID                     string              `json:"jobid"`
-	Jobstatus                 int                 `json:"jobstatus"`
-	Memoryavailable           string              `json:"memoryavailable"`
-	Memorylimit               string              `json:"memorylimit"`
-	Memorytotal               int64               `json:"memorytotal"`
-	Name                      string              `json:"name"`
-	Networkavailable          string              `json:"networkavailable"`
-	Networklimit              string              `json:"networklimit"`
-	Networktotal              int64               `json:"networktotal"`
-	Objectstorageavailable    string              `json:"objectstorageavailable"`
-	Objectstoragelimit        string              `json:"objectstoragelimit"`
-	Objectstoragetotal        int64               `json:"objectstoragetotal"`
-	Owner                     []map[string]string `json:"owner"`
-	Primarystorageavailable   string              `json:"primarystorageavailable"`
-	Primarystoragelimit       string              `json:"primarystoragelimit"`
-	Primarystoragetotal       int64               `json:"primarystoragetotal"`
-	Projectaccountname        string              `json:"projectaccountname"`
-	Secondarystorageavailable string              `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string              `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64             `json:"secondarystoragetotal"`
-	Snapshotavailable         string              `json:"snapshotavailable"`
-	Snapshotlimit             string              `json:"snapshotlimit"`
-	Snapshottotal             int64               `json:"snapshottotal"`
-	State                     string              `json:"state"`
-	Taggedresources           []string            `json:"taggedresources"`
-	Tags                      []Tags              `json:"tags"`
-	Templateavailable         string              `json:"templateavailable"`
-	Templatelimit             string              `json:"templatelimit"`
-	Templatetotal             int64               `json:"templatetotal"`
-	Vmavailable               string              `json:"vmavailable"`
-	Vmlimit                   string              `json:"vmlimit"`
-	Vmrunning                 int                 `json:"vmrunning"`
-	Vmstopped                 int                 `json:"vmstopped"`
-	Vmtotal                   int64               `json:"vmtotal"`
-	Volumeavailable           string              `json:"volumeavailable"`
-	Volumelimit               string              `json:"volumelimit"`
-	Volumetotal               int64               `json:"volumetotal"`
-	Vpcavailable              string              `json:"vpcavailable"`
-	Vpclimit                  string              `json:"vpclimit"`
-	Vpctotal                  int64               `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Displaytext               string                     `json:"displaytext"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Owner                     []map[string]string        `json:"owner"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectaccountname        string                     `json:"projectaccountname"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Tags                      []Tags                     `json:"tags"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ProjectAccount) UnmarshalJSON(b []byte) error {
+	type alias ProjectAccount
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type Tags struct {
-	Account      string `json:"account"`
-	Customer     string `json:"customer"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	Key          string `json:"key"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	Resourceid   string `json:"resourceid"`
-	Resourcetype string `json:"resourcetype"`
-	Value        string `json:"value"`
+	Account      string                     `json:"account"`
+	Customer     string                     `json:"customer"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	Key          string                     `json:"key"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	Resourceid   string                     `json:"resourceid"`
+	Resourcetype string                     `json:"resourcetype"`
+	Value        string                     `json:"value"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Tags) UnmarshalJSON(b []byte) error {
+	type alias Tags
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LockAccountParams struct {
@@ -2364,6 +2998,45 @@ func (p *LockAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the lockAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LockAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("lockAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the lockAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LockAccountParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "domainid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255},
+	}.validate("lockAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the lockAccount API, before the request is signed
+func (p *LockAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LockAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LockAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("lockAccount", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LockAccountParams) Clone() *LockAccountParams {
+	return &LockAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *LockAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2418,13 +3091,22 @@ func (s *AccountService) NewLockAccountParams(account string, domainid string) *
 
 // This deprecated function used to lock an Account. Look for the API DisableAccount instead
 func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("lockAccount", p.toURLValues())
+	return s.LockAccountWithContext(context.Background(), p)
+}
+
+// LockAccountWithContext is like LockAccount, but honours the cancellation and deadline of ctx
+func (s *AccountService) LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "lockAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LockAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("lockAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2432,112 +3114,124 @@ func (s *AccountService) LockAccount(p *LockAccountParams) (*LockAccountResponse
 }
 
 type LockAccountResponse struct {
-	Accountdetails            map[string]string         `json:"accountdetails"`
-	Accounttype               int                       `json:"accounttype"`
-	Apikeyaccess              string                    `json:"apikeyaccess"`
-	Backupavailable           string                    `json:"backupavailable"`
-	Backuplimit               string                    `json:"backuplimit"`
-	Backupstorageavailable    string                    `json:"backupstorageavailable"`
-	Backupstoragelimit        string                    `json:"backupstoragelimit"`
-	Backupstoragetotal        int64                     `json:"backupstoragetotal"`
-	Backuptotal               int64                     `json:"backuptotal"`
-	Bucketavailable           string                    `json:"bucketavailable"`
-	Bucketlimit               string                    `json:"bucketlimit"`
-	Buckettotal               int64                     `json:"buckettotal"`
-	Cpuavailable              string                    `json:"cpuavailable"`
-	Cpulimit                  string                    `json:"cpulimit"`
-	Cputotal                  int64                     `json:"cputotal"`
-	Created                   string                    `json:"created"`
-	Defaultzoneid             string                    `json:"defaultzoneid"`
-	Domain                    string                    `json:"domain"`
-	Domainid                  string                    `json:"domainid"`
-	Domainpath                string                    `json:"domainpath"`
-	Gpuavailable              string                    `json:"gpuavailable"`
-	Gpulimit                  string                    `json:"gpulimit"`
-	Gputotal                  int64                     `json:"gputotal"`
-	Groups                    []string                  `json:"groups"`
-	Icon                      interface{}               `json:"icon"`
-	Id                        string                    `json:"id"`
-	Ipavailable               string                    `json:"ipavailable"`
-	Iplimit                   string                    `json:"iplimit"`
-	Iptotal                   int64                     `json:"iptotal"`
-	Iscleanuprequired         bool                      `json:"iscleanuprequired"`
-	Isdefault                 bool                      `json:"isdefault"`
-	JobID                     string                    `json:"jobid"`
-	Jobstatus                 int                       `json:"jobstatus"`
-	Memoryavailable           string                    `json:"memoryavailable"`
-	Memorylimit               string                    `json:"memorylimit"`
-	Memorytotal               int64                     `json:"memorytotal"`
-	Name                      string                    `json:"name"`
-	Networkavailable          string                    `json:"networkavailable"`
-	Networkdomain             string                    `json:"networkdomain"`
-	Networklimit              string                    `json:"networklimit"`
-	Networktotal              int64                     `json:"networktotal"`
-	Objectstorageavailable    string                    `json:"objectstorageavailable"`
-	Objectstoragelimit        string                    `json:"objectstoragelimit"`
-	Objectstoragetotal        int64                     `json:"objectstoragetotal"`
-	Primarystorageavailable   string                    `json:"primarystorageavailable"`
-	Primarystoragelimit       string                    `json:"primarystoragelimit"`
-	Primarystoragetotal       int64                     `json:"primarystoragetotal"`
-	Projectavailable          string                    `json:"projectavailable"`
-	Projectlimit              string                    `json:"projectlimit"`
-	Projecttotal              int64                     `json:"projecttotal"`
-	Receivedbytes             int64                     `json:"receivedbytes"`
-	Roleid                    string                    `json:"roleid"`
-	Rolename                  string                    `json:"rolename"`
-	Roletype                  string                    `json:"roletype"`
-	Secondarystorageavailable string                    `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string                    `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64                   `json:"secondarystoragetotal"`
-	Sentbytes                 int64                     `json:"sentbytes"`
-	Snapshotavailable         string                    `json:"snapshotavailable"`
-	Snapshotlimit             string                    `json:"snapshotlimit"`
-	Snapshottotal             int64                     `json:"snapshottotal"`
-	State                     string                    `json:"state"`
-	Taggedresources           []string                  `json:"taggedresources"`
-	Templateavailable         string                    `json:"templateavailable"`
-	Templatelimit             string                    `json:"templatelimit"`
-	Templatetotal             int64                     `json:"templatetotal"`
-	User                      []LockAccountResponseUser `json:"user"`
-	Vmavailable               string                    `json:"vmavailable"`
-	Vmlimit                   string                    `json:"vmlimit"`
-	Vmrunning                 int                       `json:"vmrunning"`
-	Vmstopped                 int                       `json:"vmstopped"`
-	Vmtotal                   int64                     `json:"vmtotal"`
-	Volumeavailable           string                    `json:"volumeavailable"`
-	Volumelimit               string                    `json:"volumelimit"`
-	Volumetotal               int64                     `json:"volumetotal"`
-	Vpcavailable              string                    `json:"vpcavailable"`
-	Vpclimit                  string                    `json:"vpclimit"`
-	Vpctotal                  int64                     `json:"vpctotal"`
+	Accountdetails            map[string]string          `json:"accountdetails"`
+	Accounttype               FlexInt                    `json:"accounttype"`
+	Apikeyaccess              string                     `json:"apikeyaccess"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Defaultzoneid             string                     `json:"defaultzoneid"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Domainpath                string                     `json:"domainpath"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Groups                    []string                   `json:"groups"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	Iscleanuprequired         FlexBool                   `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                   `json:"isdefault"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networkdomain             string                     `json:"networkdomain"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectavailable          string                     `json:"projectavailable"`
+	Projectlimit              string                     `json:"projectlimit"`
+	Projecttotal              FlexInt64                  `json:"projecttotal"`
+	Receivedbytes             FlexInt64                  `json:"receivedbytes"`
+	Roleid                    string                     `json:"roleid"`
+	Rolename                  string                     `json:"rolename"`
+	Roletype                  string                     `json:"roletype"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                  `json:"sentbytes"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	User                      []LockAccountResponseUser  `json:"user"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LockAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias LockAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LockAccountResponseUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LockAccountResponseUser) UnmarshalJSON(b []byte) error {
+	type alias LockAccountResponseUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MarkDefaultZoneForAccountParams struct {
@@ -2561,6 +3255,46 @@ func (p *MarkDefaultZoneForAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the markDefaultZoneForAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MarkDefaultZoneForAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("markDefaultZoneForAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the markDefaultZoneForAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MarkDefaultZoneForAccountParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "domainid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "zoneid": 255},
+	}.validate("markDefaultZoneForAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the markDefaultZoneForAccount API, before the request is signed
+func (p *MarkDefaultZoneForAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MarkDefaultZoneForAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MarkDefaultZoneForAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("markDefaultZoneForAccount", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MarkDefaultZoneForAccountParams) Clone() *MarkDefaultZoneForAccountParams {
+	return &MarkDefaultZoneForAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *MarkDefaultZoneForAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2637,32 +3371,39 @@ func (s *AccountService) NewMarkDefaultZoneForAccountParams(account string, doma
 
 // Marks a default zone for this account
 func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("markDefaultZoneForAccount", p.toURLValues())
+	return s.MarkDefaultZoneForAccountWithContext(context.Background(), p)
+}
+
+// MarkDefaultZoneForAccountWithContext is like MarkDefaultZoneForAccount, but honours the cancellation and deadline of ctx
+func (s *AccountService) MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "markDefaultZoneForAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MarkDefaultZoneForAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("markDefaultZoneForAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "markDefaultZoneForAccount", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("markDefaultZoneForAccount", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2670,113 +3411,144 @@ func (s *AccountService) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MarkDefaultZoneForAccount async job into r
+func (r *MarkDefaultZoneForAccountResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MarkDefaultZoneForAccountAsync starts the async job of MarkDefaultZoneForAccount without waiting for it to finish, and returns a handle to the job
+func (s *AccountService) MarkDefaultZoneForAccountAsync(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*Job, error) {
+	r, err := s.MarkDefaultZoneForAccountWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("markDefaultZoneForAccount", r.JobID), nil
+}
+
 type MarkDefaultZoneForAccountResponse struct {
 	Accountdetails            map[string]string                       `json:"accountdetails"`
-	Accounttype               int                                     `json:"accounttype"`
+	Accounttype               FlexInt                                 `json:"accounttype"`
 	Apikeyaccess              string                                  `json:"apikeyaccess"`
 	Backupavailable           string                                  `json:"backupavailable"`
 	Backuplimit               string                                  `json:"backuplimit"`
 	Backupstorageavailable    string                                  `json:"backupstorageavailable"`
 	Backupstoragelimit        string                                  `json:"backupstoragelimit"`
-	Backupstoragetotal        int64                                   `json:"backupstoragetotal"`
-	Backuptotal               int64                                   `json:"backuptotal"`
+	Backupstoragetotal        FlexInt64                               `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                               `json:"backuptotal"`
 	Bucketavailable           string                                  `json:"bucketavailable"`
 	Bucketlimit               string                                  `json:"bucketlimit"`
-	Buckettotal               int64                                   `json:"buckettotal"`
+	Buckettotal               FlexInt64                               `json:"buckettotal"`
 	Cpuavailable              string                                  `json:"cpuavailable"`
 	Cpulimit                  string                                  `json:"cpulimit"`
-	Cputotal                  int64                                   `json:"cputotal"`
-	Created                   string                                  `json:"created"`
+	Cputotal                  FlexInt64                               `json:"cputotal"`
+	Created                   Time                                    `json:"created"`
 	Defaultzoneid             string                                  `json:"defaultzoneid"`
 	Domain                    string                                  `json:"domain"`
 	Domainid                  string                                  `json:"domainid"`
 	Domainpath                string                                  `json:"domainpath"`
 	Gpuavailable              string                                  `json:"gpuavailable"`
 	Gpulimit                  string                                  `json:"gpulimit"`
-	Gputotal                  int64                                   `json:"gputotal"`
+	Gputotal                  FlexInt64                               `json:"gputotal"`
 	Groups                    []string                                `json:"groups"`
 	Icon                      interface{}                             `json:"icon"`
 	Id                        string                                  `json:"id"`
 	Ipavailable               string                                  `json:"ipavailable"`
 	Iplimit                   string                                  `json:"iplimit"`
-	Iptotal                   int64                                   `json:"iptotal"`
-	Iscleanuprequired         bool                                    `json:"iscleanuprequired"`
-	Isdefault                 bool                                    `json:"isdefault"`
+	Iptotal                   FlexInt64                               `json:"iptotal"`
+	Iscleanuprequired         FlexBool                                `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                                `json:"isdefault"`
 	JobID                     string                                  `json:"jobid"`
-	Jobstatus                 int                                     `json:"jobstatus"`
+	Jobstatus                 FlexInt                                 `json:"jobstatus"`
 	Memoryavailable           string                                  `json:"memoryavailable"`
 	Memorylimit               string                                  `json:"memorylimit"`
-	Memorytotal               int64                                   `json:"memorytotal"`
+	Memorytotal               FlexInt64                               `json:"memorytotal"`
 	Name                      string                                  `json:"name"`
 	Networkavailable          string                                  `json:"networkavailable"`
 	Networkdomain             string                                  `json:"networkdomain"`
 	Networklimit              string                                  `json:"networklimit"`
-	Networktotal              int64                                   `json:"networktotal"`
+	Networktotal              FlexInt64                               `json:"networktotal"`
 	Objectstorageavailable    string                                  `json:"objectstorageavailable"`
 	Objectstoragelimit        string                                  `json:"objectstoragelimit"`
-	Objectstoragetotal        int64                                   `json:"objectstoragetotal"`
+	Objectstoragetotal        FlexInt64                               `json:"objectstoragetotal"`
 	Primarystorageavailable   string                                  `json:"primarystorageavailable"`
 	Primarystoragelimit       string                                  `json:"primarystoragelimit"`
-	Primarystoragetotal       int64                                   `json:"primarystoragetotal"`
+	Primarystoragetotal       FlexInt64                               `json:"primarystoragetotal"`
 	Projectavailable          string                                  `json:"projectavailable"`
 	Projectlimit              string                                  `json:"projectlimit"`
-	Projecttotal              int64                                   `json:"projecttotal"`
-	Receivedbytes             int64                                   `json:"receivedbytes"`
+	Projecttotal              FlexInt64                               `json:"projecttotal"`
+	Receivedbytes             FlexInt64                               `json:"receivedbytes"`
 	Roleid                    string                                  `json:"roleid"`
 	Rolename                  string                                  `json:"rolename"`
 	Roletype                  string                                  `json:"roletype"`
 	Secondarystorageavailable string                                  `json:"secondarystorageavailable"`
 	Secondarystoragelimit     string                                  `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64                                 `json:"secondarystoragetotal"`
-	Sentbytes                 int64                                   `json:"sentbytes"`
+	Secondarystoragetotal     FlexFloat                               `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                               `json:"sentbytes"`
 	Snapshotavailable         string                                  `json:"snapshotavailable"`
 	Snapshotlimit             string                                  `json:"snapshotlimit"`
-	Snapshottotal             int64                                   `json:"snapshottotal"`
+	Snapshottotal             FlexInt64                               `json:"snapshottotal"`
 	State                     string                                  `json:"state"`
 	Taggedresources           []string                                `json:"taggedresources"`
 	Templateavailable         string                                  `json:"templateavailable"`
 	Templatelimit             string                                  `json:"templatelimit"`
-	Templatetotal             int64                                   `json:"templatetotal"`
+	Templatetotal             FlexInt64                               `json:"templatetotal"`
 	User                      []MarkDefaultZoneForAccountResponseUser `json:"user"`
 	Vmavailable               string                                  `json:"vmavailable"`
 	Vmlimit                   string                                  `json:"vmlimit"`
-	Vmrunning                 int                                     `json:"vmrunning"`
-	Vmstopped                 int                                     `json:"vmstopped"`
-	Vmtotal                   int64                                   `json:"vmtotal"`
+	Vmrunning                 FlexInt                                 `json:"vmrunning"`
+	Vmstopped                 FlexInt                                 `json:"vmstopped"`
+	Vmtotal                   FlexInt64                               `json:"vmtotal"`
 	Volumeavailable           string                                  `json:"volumeavailable"`
 	Volumelimit               string                                  `json:"volumelimit"`
-	Volumetotal               int64                                   `json:"volumetotal"`
+	Volumetotal               FlexInt64                               `json:"volumetotal"`
 	Vpcavailable              string                                  `json:"vpcavailable"`
 	Vpclimit                  string                                  `json:"vpclimit"`
-	Vpctotal                  int64                                   `json:"vpctotal"`
+	Vpctotal                  FlexInt64                               `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage              `json:"-"`
+}
+
+func (r *MarkDefaultZoneForAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias MarkDefaultZoneForAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MarkDefaultZoneForAccountResponseUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MarkDefaultZoneForAccountResponseUser) UnmarshalJSON(b []byte) error {
+	type alias MarkDefaultZoneForAccountResponseUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateAccountParams struct {
@@ -2818,6 +3590,50 @@ func (p *UpdateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateAccountParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "apikeyaccess": 255, "domainid": 255, "id": 255, "networkdomain": 255, "newname": 255, "roleid": 255},
+	}.validate("updateAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateAccount API, before the request is signed
+func (p *UpdateAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateAccount", b, &p.p, map[string]paramDecoder{
+		"account":        decodeParam[string],
+		"accountdetails": decodeParam[map[string]string],
+		"apikeyaccess":   decodeParam[string],
+		"domainid":       decodeParam[string],
+		"id":             decodeParam[string],
+		"networkdomain":  decodeParam[string],
+		"newname":        decodeParam[string],
+		"roleid":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateAccountParams) Clone() *UpdateAccountParams {
+	return &UpdateAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2996,13 +3812,22 @@ func (s *AccountService) NewUpdateAccountParams() *UpdateAccountParams {
 
 // Updates Account information for the authenticated user
 func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("updateAccount", p.toURLValues())
+	return s.UpdateAccountWithContext(context.Background(), p)
+}
+
+// UpdateAccountWithContext is like UpdateAccount, but honours the cancellation and deadline of ctx
+func (s *AccountService) UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3011,109 +3836,121 @@ func (s *AccountService) UpdateAccount(p *UpdateAccountParams) (*UpdateAccountRe
 
 type UpdateAccountResponse struct {
 	Accountdetails            map[string]string           `json:"accountdetails"`
-	Accounttype               int                         `json:"accounttype"`
+	Accounttype               FlexInt                     `json:"accounttype"`
 	Apikeyaccess              string                      `json:"apikeyaccess"`
 	Backupavailable           string                      `json:"backupavailable"`
 	Backuplimit               string                      `json:"backuplimit"`
 	Backupstorageavailable    string                      `json:"backupstorageavailable"`
 	Backupstoragelimit        string                      `json:"backupstoragelimit"`
-	Backupstoragetotal        int64                       `json:"backupstoragetotal"`
-	Backuptotal               int64                       `json:"backuptotal"`
+	Backupstoragetotal        FlexInt64                   `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                   `json:"backuptotal"`
 	Bucketavailable           string                      `json:"bucketavailable"`
 	Bucketlimit               string                      `json:"bucketlimit"`
-	Buckettotal               int64                       `json:"buckettotal"`
+	Buckettotal               FlexInt64                   `json:"buckettotal"`
 	Cpuavailable              string                      `json:"cpuavailable"`
 	Cpulimit                  string                      `json:"cpulimit"`
-	Cputotal                  int64                       `json:"cputotal"`
-	Created                   string                      `json:"created"`
+	Cputotal                  FlexInt64                   `json:"cputotal"`
+	Created                   Time                        `json:"created"`
 	Defaultzoneid             string                      `json:"defaultzoneid"`
 	Domain                    string                      `json:"domain"`
 	Domainid                  string                      `json:"domainid"`
 	Domainpath                string                      `json:"domainpath"`
 	Gpuavailable              string                      `json:"gpuavailable"`
 	Gpulimit                  string                      `json:"gpulimit"`
-	Gputotal                  int64                       `json:"gputotal"`
+	Gputotal                  FlexInt64                   `json:"gputotal"`
 	Groups                    []string                    `json:"groups"`
 	Icon                      interface{}                 `json:"icon"`
 	Id                        string                      `json:"id"`
 	Ipavailable               string                      `json:"ipavailable"`
 	Iplimit                   string                      `json:"iplimit"`
-	Iptotal                   int64                       `json:"iptotal"`
-	Iscleanuprequired         bool                        `json:"iscleanuprequired"`
-	Isdefault                 bool                        `json:"isdefault"`
+	Iptotal                   FlexInt64                   `json:"iptotal"`
+	Iscleanuprequired         FlexBool                    `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                    `json:"isdefault"`
 	JobID                     string                      `json:"jobid"`
-	Jobstatus                 int                         `json:"jobstatus"`
+	Jobstatus                 FlexInt                     `json:"jobstatus"`
 	Memoryavailable           string                      `json:"memoryavailable"`
 	Memorylimit               string                      `json:"memorylimit"`
-	Memorytotal               int64                       `json:"memorytotal"`
+	Memorytotal               FlexInt64                   `json:"memorytotal"`
 	Name                      string                      `json:"name"`
 	Networkavailable          string                      `json:"networkavailable"`
 	Networkdomain             string                      `json:"networkdomain"`
 	Networklimit              string                      `json:"networklimit"`
-	Networktotal              int64                       `json:"networktotal"`
+	Networktotal              FlexInt64                   `json:"networktotal"`
 	Objectstorageavailable    string                      `json:"objectstorageavailable"`
 	Objectstoragelimit        string                      `json:"objectstoragelimit"`
-	Objectstoragetotal        int64                       `json:"objectstoragetotal"`
+	Objectstoragetotal        FlexInt64                   `json:"objectstoragetotal"`
 	Primarystorageavailable   string                      `json:"primarystorageavailable"`
 	Primarystoragelimit       string                      `json:"primarystoragelimit"`
-	Primarystoragetotal       int64                       `json:"primarystoragetotal"`
+	Primarystoragetotal       FlexInt64                   `json:"primarystoragetotal"`
 	Projectavailable          string                      `json:"projectavailable"`
 	Projectlimit              string                      `json:"projectlimit"`
-	Projecttotal              int64                       `json:"projecttotal"`
-	Receivedbytes             int64                       `json:"receivedbytes"`
+	Projecttotal              FlexInt64                   `json:"projecttotal"`
+	Receivedbytes             FlexInt64                   `json:"receivedbytes"`
 	Roleid                    string                      `json:"roleid"`
 	Rolename                  string                      `json:"rolename"`
 	Roletype                  string                      `json:"roletype"`
 	Secondarystorageavailable string                      `json:"secondarystorageavailable"`
 	Secondarystoragelimit     string                      `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64                     `json:"secondarystoragetotal"`
-	Sentbytes                 int64                       `json:"sentbytes"`
+	Secondarystoragetotal     FlexFloat                   `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                   `json:"sentbytes"`
 	Snapshotavailable         string                      `json:"snapshotavailable"`
 	Snapshotlimit             string                      `json:"snapshotlimit"`
-	Snapshottotal             int64                       `json:"snapshottotal"`
+	Snapshottotal             FlexInt64                   `json:"snapshottotal"`
 	State                     string                      `json:"state"`
 	Taggedresources           []string                    `json:"taggedresources"`
 	Templateavailable         string                      `json:"templateavailable"`
 	Templatelimit             string                      `json:"templatelimit"`
-	Templatetotal             int64                       `json:"templatetotal"`
+	Templatetotal             FlexInt64                   `json:"templatetotal"`
 	User                      []UpdateAccountResponseUser `json:"user"`
 	Vmavailable               string                      `json:"vmavailable"`
 	Vmlimit                   string                      `json:"vmlimit"`
-	Vmrunning                 int                         `json:"vmrunning"`
-	Vmstopped                 int                         `json:"vmstopped"`
-	Vmtotal                   int64                       `json:"vmtotal"`
+	Vmrunning                 FlexInt                     `json:"vmrunning"`
+	Vmstopped                 FlexInt                     `json:"vmstopped"`
+	Vmtotal                   FlexInt64                   `json:"vmtotal"`
 	Volumeavailable           string                      `json:"volumeavailable"`
 	Volumelimit               string                      `json:"volumelimit"`
-	Volumetotal               int64                       `json:"volumetotal"`
+	Volumetotal               FlexInt64                   `json:"volumetotal"`
 	Vpcavailable              string                      `json:"vpcavailable"`
 	Vpclimit                  string                      `json:"vpclimit"`
-	Vpctotal                  int64                       `json:"vpctotal"`
+	Vpctotal                  FlexInt64                   `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *UpdateAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateAccountResponseUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateAccountResponseUser) UnmarshalJSON(b []byte) error {
+	type alias UpdateAccountResponseUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
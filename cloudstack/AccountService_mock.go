@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockAccountServiceIfaceMockRecorder) CreateAccount(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).CreateAccount), p)
 }
 
+// CreateAccountWithContext mocks base method.
+func (m *MockAccountServiceIface) CreateAccountWithContext(ctx context.Context, p *CreateAccountParams) (*CreateAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAccountWithContext indicates an expected call of CreateAccountWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) CreateAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAccountWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).CreateAccountWithContext), ctx, p)
+}
+
 // DeleteAccount mocks base method.
 func (m *MockAccountServiceIface) DeleteAccount(p *DeleteAccountParams) (*DeleteAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockAccountServiceIfaceMockRecorder) DeleteAccount(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).DeleteAccount), p)
 }
 
+// DeleteAccountAsync mocks base method.
+func (m *MockAccountServiceIface) DeleteAccountAsync(ctx context.Context, p *DeleteAccountParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccountAsync indicates an expected call of DeleteAccountAsync.
+func (mr *MockAccountServiceIfaceMockRecorder) DeleteAccountAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountAsync", reflect.TypeOf((*MockAccountServiceIface)(nil).DeleteAccountAsync), ctx, p)
+}
+
+// DeleteAccountWithContext mocks base method.
+func (m *MockAccountServiceIface) DeleteAccountWithContext(ctx context.Context, p *DeleteAccountParams) (*DeleteAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccountWithContext indicates an expected call of DeleteAccountWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) DeleteAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).DeleteAccountWithContext), ctx, p)
+}
+
 // DisableAccount mocks base method.
 func (m *MockAccountServiceIface) DisableAccount(p *DisableAccountParams) (*DisableAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,36 @@ func (mr *MockAccountServiceIfaceMockRecorder) DisableAccount(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).DisableAccount), p)
 }
 
+// DisableAccountAsync mocks base method.
+func (m *MockAccountServiceIface) DisableAccountAsync(ctx context.Context, p *DisableAccountParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccountAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAccountAsync indicates an expected call of DisableAccountAsync.
+func (mr *MockAccountServiceIfaceMockRecorder) DisableAccountAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccountAsync", reflect.TypeOf((*MockAccountServiceIface)(nil).DisableAccountAsync), ctx, p)
+}
+
+// DisableAccountWithContext mocks base method.
+func (m *MockAccountServiceIface) DisableAccountWithContext(ctx context.Context, p *DisableAccountParams) (*DisableAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAccountWithContext indicates an expected call of DisableAccountWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) DisableAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAccountWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).DisableAccountWithContext), ctx, p)
+}
+
 // EnableAccount mocks base method.
 func (m *MockAccountServiceIface) EnableAccount(p *EnableAccountParams) (*EnableAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,21 @@ func (mr *MockAccountServiceIfaceMockRecorder) EnableAccount(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).EnableAccount), p)
 }
 
+// EnableAccountWithContext mocks base method.
+func (m *MockAccountServiceIface) EnableAccountWithContext(ctx context.Context, p *EnableAccountParams) (*EnableAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableAccountWithContext indicates an expected call of EnableAccountWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) EnableAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAccountWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).EnableAccountWithContext), ctx, p)
+}
+
 // GetAccountByID mocks base method.
 func (m *MockAccountServiceIface) GetAccountByID(id string, opts ...OptionFunc) (*Account, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +231,27 @@ func (mr *MockAccountServiceIfaceMockRecorder) GetAccountByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByID", reflect.TypeOf((*MockAccountServiceIface)(nil).GetAccountByID), varargs...)
 }
 
+// GetAccountByIDWithContext mocks base method.
+func (m *MockAccountServiceIface) GetAccountByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Account, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAccountByIDWithContext indicates an expected call of GetAccountByIDWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) GetAccountByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByIDWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).GetAccountByIDWithContext), varargs...)
+}
+
 // GetAccountByName mocks base method.
 func (m *MockAccountServiceIface) GetAccountByName(name string, opts ...OptionFunc) (*Account, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +273,27 @@ func (mr *MockAccountServiceIfaceMockRecorder) GetAccountByName(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByName", reflect.TypeOf((*MockAccountServiceIface)(nil).GetAccountByName), varargs...)
 }
 
+// GetAccountByNameWithContext mocks base method.
+func (m *MockAccountServiceIface) GetAccountByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Account, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Account)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAccountByNameWithContext indicates an expected call of GetAccountByNameWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) GetAccountByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountByNameWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).GetAccountByNameWithContext), varargs...)
+}
+
 // GetAccountID mocks base method.
 func (m *MockAccountServiceIface) GetAccountID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +315,27 @@ func (mr *MockAccountServiceIfaceMockRecorder) GetAccountID(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountID", reflect.TypeOf((*MockAccountServiceIface)(nil).GetAccountID), varargs...)
 }
 
+// GetAccountIDWithContext mocks base method.
+func (m *MockAccountServiceIface) GetAccountIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAccountIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAccountIDWithContext indicates an expected call of GetAccountIDWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) GetAccountIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAccountIDWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).GetAccountIDWithContext), varargs...)
+}
+
 // GetProjectAccountID mocks base method.
 func (m *MockAccountServiceIface) GetProjectAccountID(keyword, projectid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +357,27 @@ func (mr *MockAccountServiceIfaceMockRecorder) GetProjectAccountID(keyword, proj
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAccountID", reflect.TypeOf((*MockAccountServiceIface)(nil).GetProjectAccountID), varargs...)
 }
 
+// GetProjectAccountIDWithContext mocks base method.
+func (m *MockAccountServiceIface) GetProjectAccountIDWithContext(ctx context.Context, keyword, projectid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, projectid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectAccountIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectAccountIDWithContext indicates an expected call of GetProjectAccountIDWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) GetProjectAccountIDWithContext(ctx, keyword, projectid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, projectid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectAccountIDWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).GetProjectAccountIDWithContext), varargs...)
+}
+
 // IsAccountAllowedToCreateOfferingsWithTags mocks base method.
 func (m *MockAccountServiceIface) IsAccountAllowedToCreateOfferingsWithTags(p *IsAccountAllowedToCreateOfferingsWithTagsParams) (*IsAccountAllowedToCreateOfferingsWithTagsResponse, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +393,21 @@ func (mr *MockAccountServiceIfaceMockRecorder) IsAccountAllowedToCreateOfferings
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccountAllowedToCreateOfferingsWithTags", reflect.TypeOf((*MockAccountServiceIface)(nil).IsAccountAllowedToCreateOfferingsWithTags), p)
 }
 
+// IsAccountAllowedToCreateOfferingsWithTagsWithContext mocks base method.
+func (m *MockAccountServiceIface) IsAccountAllowedToCreateOfferingsWithTagsWithContext(ctx context.Context, p *IsAccountAllowedToCreateOfferingsWithTagsParams) (*IsAccountAllowedToCreateOfferingsWithTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsAccountAllowedToCreateOfferingsWithTagsWithContext", ctx, p)
+	ret0, _ := ret[0].(*IsAccountAllowedToCreateOfferingsWithTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsAccountAllowedToCreateOfferingsWithTagsWithContext indicates an expected call of IsAccountAllowedToCreateOfferingsWithTagsWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) IsAccountAllowedToCreateOfferingsWithTagsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsAccountAllowedToCreateOfferingsWithTagsWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).IsAccountAllowedToCreateOfferingsWithTagsWithContext), ctx, p)
+}
+
 // LinkAccountToLdap mocks base method.
 func (m *MockAccountServiceIface) LinkAccountToLdap(p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +423,21 @@ func (mr *MockAccountServiceIfaceMockRecorder) LinkAccountToLdap(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkAccountToLdap", reflect.TypeOf((*MockAccountServiceIface)(nil).LinkAccountToLdap), p)
 }
 
+// LinkAccountToLdapWithContext mocks base method.
+func (m *MockAccountServiceIface) LinkAccountToLdapWithContext(ctx context.Context, p *LinkAccountToLdapParams) (*LinkAccountToLdapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkAccountToLdapWithContext", ctx, p)
+	ret0, _ := ret[0].(*LinkAccountToLdapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkAccountToLdapWithContext indicates an expected call of LinkAccountToLdapWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) LinkAccountToLdapWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkAccountToLdapWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).LinkAccountToLdapWithContext), ctx, p)
+}
+
 // ListAccounts mocks base method.
 func (m *MockAccountServiceIface) ListAccounts(p *ListAccountsParams) (*ListAccountsResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +453,99 @@ func (mr *MockAccountServiceIfaceMockRecorder) ListAccounts(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccounts", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccounts), p)
 }
 
+// ListAccountsAll mocks base method.
+func (m *MockAccountServiceIface) ListAccountsAll(p *ListAccountsParams, opts ...PageOption) ([]*Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountsAll", varargs...)
+	ret0, _ := ret[0].([]*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsAll indicates an expected call of ListAccountsAll.
+func (mr *MockAccountServiceIfaceMockRecorder) ListAccountsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsAll", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccountsAll), varargs...)
+}
+
+// ListAccountsAllWithContext mocks base method.
+func (m *MockAccountServiceIface) ListAccountsAllWithContext(ctx context.Context, p *ListAccountsParams, opts ...PageOption) ([]*Account, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Account)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsAllWithContext indicates an expected call of ListAccountsAllWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) ListAccountsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsAllWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccountsAllWithContext), varargs...)
+}
+
+// ListAccountsIter mocks base method.
+func (m *MockAccountServiceIface) ListAccountsIter(p *ListAccountsParams, opts ...PageOption) iter.Seq2[*Account, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Account, error])
+	return ret0
+}
+
+// ListAccountsIter indicates an expected call of ListAccountsIter.
+func (mr *MockAccountServiceIfaceMockRecorder) ListAccountsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsIter", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccountsIter), varargs...)
+}
+
+// ListAccountsIterWithContext mocks base method.
+func (m *MockAccountServiceIface) ListAccountsIterWithContext(ctx context.Context, p *ListAccountsParams, opts ...PageOption) iter.Seq2[*Account, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAccountsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Account, error])
+	return ret0
+}
+
+// ListAccountsIterWithContext indicates an expected call of ListAccountsIterWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) ListAccountsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsIterWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccountsIterWithContext), varargs...)
+}
+
+// ListAccountsWithContext mocks base method.
+func (m *MockAccountServiceIface) ListAccountsWithContext(ctx context.Context, p *ListAccountsParams) (*ListAccountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAccountsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAccountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAccountsWithContext indicates an expected call of ListAccountsWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) ListAccountsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAccountsWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).ListAccountsWithContext), ctx, p)
+}
+
 // ListProjectAccounts mocks base method.
 func (m *MockAccountServiceIface) ListProjectAccounts(p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +561,99 @@ func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccounts(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccounts", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccounts), p)
 }
 
+// ListProjectAccountsAll mocks base method.
+func (m *MockAccountServiceIface) ListProjectAccountsAll(p *ListProjectAccountsParams, opts ...PageOption) ([]*ProjectAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectAccountsAll", varargs...)
+	ret0, _ := ret[0].([]*ProjectAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectAccountsAll indicates an expected call of ListProjectAccountsAll.
+func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccountsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccountsAll", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccountsAll), varargs...)
+}
+
+// ListProjectAccountsAllWithContext mocks base method.
+func (m *MockAccountServiceIface) ListProjectAccountsAllWithContext(ctx context.Context, p *ListProjectAccountsParams, opts ...PageOption) ([]*ProjectAccount, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectAccountsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ProjectAccount)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectAccountsAllWithContext indicates an expected call of ListProjectAccountsAllWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccountsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccountsAllWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccountsAllWithContext), varargs...)
+}
+
+// ListProjectAccountsIter mocks base method.
+func (m *MockAccountServiceIface) ListProjectAccountsIter(p *ListProjectAccountsParams, opts ...PageOption) iter.Seq2[*ProjectAccount, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectAccountsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ProjectAccount, error])
+	return ret0
+}
+
+// ListProjectAccountsIter indicates an expected call of ListProjectAccountsIter.
+func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccountsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccountsIter", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccountsIter), varargs...)
+}
+
+// ListProjectAccountsIterWithContext mocks base method.
+func (m *MockAccountServiceIface) ListProjectAccountsIterWithContext(ctx context.Context, p *ListProjectAccountsParams, opts ...PageOption) iter.Seq2[*ProjectAccount, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectAccountsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ProjectAccount, error])
+	return ret0
+}
+
+// ListProjectAccountsIterWithContext indicates an expected call of ListProjectAccountsIterWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccountsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccountsIterWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccountsIterWithContext), varargs...)
+}
+
+// ListProjectAccountsWithContext mocks base method.
+func (m *MockAccountServiceIface) ListProjectAccountsWithContext(ctx context.Context, p *ListProjectAccountsParams) (*ListProjectAccountsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectAccountsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListProjectAccountsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectAccountsWithContext indicates an expected call of ListProjectAccountsWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) ListProjectAccountsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectAccountsWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).ListProjectAccountsWithContext), ctx, p)
+}
+
 // LockAccount mocks base method.
 func (m *MockAccountServiceIface) LockAccount(p *LockAccountParams) (*LockAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +669,21 @@ func (mr *MockAccountServiceIfaceMockRecorder) LockAccount(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).LockAccount), p)
 }
 
+// LockAccountWithContext mocks base method.
+func (m *MockAccountServiceIface) LockAccountWithContext(ctx context.Context, p *LockAccountParams) (*LockAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*LockAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockAccountWithContext indicates an expected call of LockAccountWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) LockAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockAccountWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).LockAccountWithContext), ctx, p)
+}
+
 // MarkDefaultZoneForAccount mocks base method.
 func (m *MockAccountServiceIface) MarkDefaultZoneForAccount(p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +699,36 @@ func (mr *MockAccountServiceIfaceMockRecorder) MarkDefaultZoneForAccount(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDefaultZoneForAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).MarkDefaultZoneForAccount), p)
 }
 
+// MarkDefaultZoneForAccountAsync mocks base method.
+func (m *MockAccountServiceIface) MarkDefaultZoneForAccountAsync(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDefaultZoneForAccountAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkDefaultZoneForAccountAsync indicates an expected call of MarkDefaultZoneForAccountAsync.
+func (mr *MockAccountServiceIfaceMockRecorder) MarkDefaultZoneForAccountAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDefaultZoneForAccountAsync", reflect.TypeOf((*MockAccountServiceIface)(nil).MarkDefaultZoneForAccountAsync), ctx, p)
+}
+
+// MarkDefaultZoneForAccountWithContext mocks base method.
+func (m *MockAccountServiceIface) MarkDefaultZoneForAccountWithContext(ctx context.Context, p *MarkDefaultZoneForAccountParams) (*MarkDefaultZoneForAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkDefaultZoneForAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*MarkDefaultZoneForAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MarkDefaultZoneForAccountWithContext indicates an expected call of MarkDefaultZoneForAccountWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) MarkDefaultZoneForAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkDefaultZoneForAccountWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).MarkDefaultZoneForAccountWithContext), ctx, p)
+}
+
 // NewCreateAccountParams mocks base method.
 func (m *MockAccountServiceIface) NewCreateAccountParams(email, firstname, lastname, password, username string) *CreateAccountParams {
 	m.ctrl.T.Helper()
@@ -460,3 +897,18 @@ func (mr *MockAccountServiceIfaceMockRecorder) UpdateAccount(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccount", reflect.TypeOf((*MockAccountServiceIface)(nil).UpdateAccount), p)
 }
+
+// UpdateAccountWithContext mocks base method.
+func (m *MockAccountServiceIface) UpdateAccountWithContext(ctx context.Context, p *UpdateAccountParams) (*UpdateAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAccountWithContext indicates an expected call of UpdateAccountWithContext.
+func (mr *MockAccountServiceIfaceMockRecorder) UpdateAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAccountWithContext", reflect.TypeOf((*MockAccountServiceIface)(nil).UpdateAccountWithContext), ctx, p)
+}
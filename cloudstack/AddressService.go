@@ -20,30 +20,47 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type AddressServiceIface interface {
 	AcquirePodIpAddress(p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error)
+	AcquirePodIpAddressWithContext(ctx context.Context, p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error)
 	NewAcquirePodIpAddressParams(zoneid string) *AcquirePodIpAddressParams
 	AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error)
+	AssociateIpAddressAsync(ctx context.Context, p *AssociateIpAddressParams) (*Job, error)
 	NewAssociateIpAddressParams() *AssociateIpAddressParams
 	DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error)
+	DisassociateIpAddressAsync(ctx context.Context, p *DisassociateIpAddressParams) (*Job, error)
 	NewDisassociateIpAddressParams(id string) *DisassociateIpAddressParams
 	ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error)
+	ListPublicIpAddressesAll(p *ListPublicIpAddressesParams, opts ...PageOption) ([]*PublicIpAddress, error)
+	ListPublicIpAddressesAllWithContext(ctx context.Context, p *ListPublicIpAddressesParams, opts ...PageOption) ([]*PublicIpAddress, error)
+	ListPublicIpAddressesIter(p *ListPublicIpAddressesParams, opts ...PageOption) iter.Seq2[*PublicIpAddress, error]
+	ListPublicIpAddressesIterWithContext(ctx context.Context, p *ListPublicIpAddressesParams, opts ...PageOption) iter.Seq2[*PublicIpAddress, error]
 	NewListPublicIpAddressesParams() *ListPublicIpAddressesParams
 	GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
+	GetPublicIpAddressByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PublicIpAddress, int, error)
 	UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error)
+	UpdateIpAddressAsync(ctx context.Context, p *UpdateIpAddressParams) (*Job, error)
 	NewUpdateIpAddressParams(id string) *UpdateIpAddressParams
 	ReleaseIpAddress(p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error)
+	ReleaseIpAddressWithContext(ctx context.Context, p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error)
 	NewReleaseIpAddressParams(id string) *ReleaseIpAddressParams
 	ReleasePodIpAddress(p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error)
+	ReleasePodIpAddressWithContext(ctx context.Context, p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error)
 	NewReleasePodIpAddressParams(id int64) *ReleasePodIpAddressParams
 	ReserveIpAddress(p *ReserveIpAddressParams) (*ReserveIpAddressResponse, error)
+	ReserveIpAddressWithContext(ctx context.Context, p *ReserveIpAddressParams) (*ReserveIpAddressResponse, error)
 	NewReserveIpAddressParams(id string) *ReserveIpAddressParams
 }
 
@@ -65,6 +82,45 @@ func (p *AcquirePodIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the acquirePodIpAddress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AcquirePodIpAddressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("acquirePodIpAddress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the acquirePodIpAddress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AcquirePodIpAddressParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"podid": 255, "zoneid": 255},
+	}.validate("acquirePodIpAddress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the acquirePodIpAddress API, before the request is signed
+func (p *AcquirePodIpAddressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AcquirePodIpAddressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AcquirePodIpAddressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("acquirePodIpAddress", b, &p.p, map[string]paramDecoder{
+		"podid":  decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AcquirePodIpAddressParams) Clone() *AcquirePodIpAddressParams {
+	return &AcquirePodIpAddressParams{p: cloneParams(p.p)}
+}
+
 func (p *AcquirePodIpAddressParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -118,13 +174,22 @@ func (s *AddressService) NewAcquirePodIpAddressParams(zoneid string) *AcquirePod
 
 // Allocates IP addresses in respective Pod of a Zone
 func (s *AddressService) AcquirePodIpAddress(p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error) {
-	resp, err := s.cs.newPostRequest("acquirePodIpAddress", p.toURLValues())
+	return s.AcquirePodIpAddressWithContext(context.Background(), p)
+}
+
+// AcquirePodIpAddressWithContext is like AcquirePodIpAddress, but honours the cancellation and deadline of ctx
+func (s *AddressService) AcquirePodIpAddressWithContext(ctx context.Context, p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "acquirePodIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AcquirePodIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("acquirePodIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -132,15 +197,21 @@ func (s *AddressService) AcquirePodIpAddress(p *AcquirePodIpAddressParams) (*Acq
 }
 
 type AcquirePodIpAddressResponse struct {
-	Cidr      string `json:"cidr"`
-	Gateway   string `json:"gateway"`
-	Hostmac   int64  `json:"hostmac"`
-	Id        int64  `json:"id"`
-	Ipaddress string `json:"ipaddress"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Nicid     int64  `json:"nicid"`
-	Podid     int64  `json:"podid"`
+	Cidr      string                     `json:"cidr"`
+	Gateway   string                     `json:"gateway"`
+	Hostmac   FlexInt64                  `json:"hostmac"`
+	Id        FlexInt64                  `json:"id"`
+	Ipaddress string                     `json:"ipaddress"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Nicid     FlexInt64                  `json:"nicid"`
+	Podid     FlexInt64                  `json:"podid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AcquirePodIpAddressResponse) UnmarshalJSON(b []byte) error {
+	type alias AcquirePodIpAddressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssociateIpAddressParams struct {
@@ -188,6 +259,52 @@ func (p *AssociateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the associateIpAddress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssociateIpAddressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("associateIpAddress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the associateIpAddress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssociateIpAddressParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "ipaddress": 255, "networkid": 255, "projectid": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("associateIpAddress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the associateIpAddress API, before the request is signed
+func (p *AssociateIpAddressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssociateIpAddressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssociateIpAddressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("associateIpAddress", b, &p.p, map[string]paramDecoder{
+		"account":    decodeParam[string],
+		"domainid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"ipaddress":  decodeParam[string],
+		"isportable": decodeParam[bool],
+		"networkid":  decodeParam[string],
+		"projectid":  decodeParam[string],
+		"regionid":   decodeParam[int],
+		"vpcid":      decodeParam[string],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssociateIpAddressParams) Clone() *AssociateIpAddressParams {
+	return &AssociateIpAddressParams{p: cloneParams(p.p)}
+}
+
 func (p *AssociateIpAddressParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -408,32 +525,39 @@ func (s *AddressService) NewAssociateIpAddressParams() *AssociateIpAddressParams
 
 // Acquires and associates a public IP to an Account.
 func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
-	resp, err := s.cs.newPostRequest("associateIpAddress", p.toURLValues())
+	return s.AssociateIpAddressWithContext(context.Background(), p)
+}
+
+// AssociateIpAddressWithContext is like AssociateIpAddress, but honours the cancellation and deadline of ctx
+func (s *AddressService) AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "associateIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssociateIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("associateIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "associateIpAddress", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("associateIpAddress", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -441,47 +565,72 @@ func (s *AddressService) AssociateIpAddress(p *AssociateIpAddressParams) (*Assoc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AssociateIpAddress async job into r
+func (r *AssociateIpAddressResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AssociateIpAddressAsync starts the async job of AssociateIpAddress without waiting for it to finish, and returns a handle to the job
+func (s *AddressService) AssociateIpAddressAsync(ctx context.Context, p *AssociateIpAddressParams) (*Job, error) {
+	r, err := s.AssociateIpAddressWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("associateIpAddress", r.JobID), nil
+}
+
 type AssociateIpAddressResponse struct {
-	Account                   string `json:"account"`
-	Allocated                 string `json:"allocated"`
-	Associatednetworkid       string `json:"associatednetworkid"`
-	Associatednetworkname     string `json:"associatednetworkname"`
-	Domain                    string `json:"domain"`
-	Domainid                  string `json:"domainid"`
-	Domainpath                string `json:"domainpath"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Forprovider               bool   `json:"forprovider"`
-	Forsystemvms              bool   `json:"forsystemvms"`
-	Forvirtualnetwork         bool   `json:"forvirtualnetwork"`
-	Hasannotations            bool   `json:"hasannotations"`
-	Hasrules                  bool   `json:"hasrules"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Isportable                bool   `json:"isportable"`
-	Issourcenat               bool   `json:"issourcenat"`
-	Isstaticnat               bool   `json:"isstaticnat"`
-	Issystem                  bool   `json:"issystem"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Networkname               string `json:"networkname"`
-	Physicalnetworkid         string `json:"physicalnetworkid"`
-	Project                   string `json:"project"`
-	Projectid                 string `json:"projectid"`
-	Purpose                   string `json:"purpose"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Virtualmachinetype        string `json:"virtualmachinetype"`
-	Vlanid                    string `json:"vlanid"`
-	Vlanname                  string `json:"vlanname"`
-	Vmipaddress               string `json:"vmipaddress"`
-	Vpcid                     string `json:"vpcid"`
-	Vpcname                   string `json:"vpcname"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Account                   string                     `json:"account"`
+	Allocated                 string                     `json:"allocated"`
+	Associatednetworkid       string                     `json:"associatednetworkid"`
+	Associatednetworkname     string                     `json:"associatednetworkname"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Domainpath                string                     `json:"domainpath"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Forprovider               FlexBool                   `json:"forprovider"`
+	Forsystemvms              FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork         FlexBool                   `json:"forvirtualnetwork"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Hasrules                  FlexBool                   `json:"hasrules"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Isportable                FlexBool                   `json:"isportable"`
+	Issourcenat               FlexBool                   `json:"issourcenat"`
+	Isstaticnat               FlexBool                   `json:"isstaticnat"`
+	Issystem                  FlexBool                   `json:"issystem"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Networkname               string                     `json:"networkname"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid"`
+	Project                   string                     `json:"project"`
+	Projectid                 string                     `json:"projectid"`
+	Purpose                   string                     `json:"purpose"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Virtualmachinetype        string                     `json:"virtualmachinetype"`
+	Vlanid                    string                     `json:"vlanid"`
+	Vlanname                  string                     `json:"vlanname"`
+	Vmipaddress               string                     `json:"vmipaddress"`
+	Vpcid                     string                     `json:"vpcid"`
+	Vpcname                   string                     `json:"vpcname"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssociateIpAddressResponse) UnmarshalJSON(b []byte) error {
+	type alias AssociateIpAddressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisassociateIpAddressParams struct {
@@ -502,6 +651,45 @@ func (p *DisassociateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disassociateIpAddress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisassociateIpAddressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disassociateIpAddress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disassociateIpAddress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisassociateIpAddressParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "ipaddress": 255},
+	}.validate("disassociateIpAddress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disassociateIpAddress API, before the request is signed
+func (p *DisassociateIpAddressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisassociateIpAddressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisassociateIpAddressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disassociateIpAddress", b, &p.p, map[string]paramDecoder{
+		"id":        decodeParam[string],
+		"ipaddress": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisassociateIpAddressParams) Clone() *DisassociateIpAddressParams {
+	return &DisassociateIpAddressParams{p: cloneParams(p.p)}
+}
+
 func (p *DisassociateIpAddressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -555,27 +743,39 @@ func (s *AddressService) NewDisassociateIpAddressParams(id string) *Disassociate
 
 // Disassociates an IP address from the Account.
 func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
-	resp, err := s.cs.newPostRequest("disassociateIpAddress", p.toURLValues())
+	return s.DisassociateIpAddressWithContext(context.Background(), p)
+}
+
+// DisassociateIpAddressWithContext is like DisassociateIpAddress, but honours the cancellation and deadline of ctx
+func (s *AddressService) DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disassociateIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisassociateIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disassociateIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disassociateIpAddress", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disassociateIpAddress", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -583,11 +783,31 @@ func (s *AddressService) DisassociateIpAddress(p *DisassociateIpAddressParams) (
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisassociateIpAddress async job into r
+func (r *DisassociateIpAddressResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DisassociateIpAddressAsync starts the async job of DisassociateIpAddress without waiting for it to finish, and returns a handle to the job
+func (s *AddressService) DisassociateIpAddressAsync(ctx context.Context, p *DisassociateIpAddressParams) (*Job, error) {
+	r, err := s.DisassociateIpAddressWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disassociateIpAddress", r.JobID), nil
+}
+
 type DisassociateIpAddressResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisassociateIpAddressResponse) UnmarshalJSON(b []byte) error {
+	type alias DisassociateIpAddressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPublicIpAddressesParams struct {
@@ -700,6 +920,69 @@ func (p *ListPublicIpAddressesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPublicIpAddresses API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPublicIpAddressesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPublicIpAddresses", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPublicIpAddresses API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPublicIpAddressesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "associatednetworkid": 255, "domainid": 255, "id": 255, "ipaddress": 255, "keyword": 255, "networkid": 255, "physicalnetworkid": 255, "projectid": 255, "state": 255, "vlanid": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listPublicIpAddresses", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPublicIpAddresses API, before the request is signed
+func (p *ListPublicIpAddressesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPublicIpAddressesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPublicIpAddressesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPublicIpAddresses", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"allocatedonly":             decodeParam[bool],
+		"associatednetworkid":       decodeParam[string],
+		"domainid":                  decodeParam[string],
+		"fordisplay":                decodeParam[bool],
+		"forloadbalancing":          decodeParam[bool],
+		"forprovider":               decodeParam[bool],
+		"forsystemvms":              decodeParam[bool],
+		"forvirtualnetwork":         decodeParam[bool],
+		"id":                        decodeParam[string],
+		"ipaddress":                 decodeParam[string],
+		"isrecursive":               decodeParam[bool],
+		"issourcenat":               decodeParam[bool],
+		"isstaticnat":               decodeParam[bool],
+		"keyword":                   decodeParam[string],
+		"listall":                   decodeParam[bool],
+		"networkid":                 decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"physicalnetworkid":         decodeParam[string],
+		"projectid":                 decodeParam[string],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"state":                     decodeParam[string],
+		"tags":                      decodeParam[map[string]string],
+		"vlanid":                    decodeParam[string],
+		"vpcid":                     decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPublicIpAddressesParams) Clone() *ListPublicIpAddressesParams {
+	return &ListPublicIpAddressesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPublicIpAddressesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1277,6 +1560,11 @@ func (s *AddressService) NewListPublicIpAddressesParams() *ListPublicIpAddresses
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
+	return s.GetPublicIpAddressByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPublicIpAddressByIDWithContext is like GetPublicIpAddressByID, but honours the cancellation and deadline of ctx
+func (s *AddressService) GetPublicIpAddressByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
 	p := &ListPublicIpAddressesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1288,11 +1576,10 @@ func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListPublicIpAddresses(p)
+	l, err := s.ListPublicIpAddressesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1310,65 +1597,108 @@ func (s *AddressService) GetPublicIpAddressByID(id string, opts ...OptionFunc) (
 
 // Lists all public IP addresses
 func (s *AddressService) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
-	resp, err := s.cs.newRequest("listPublicIpAddresses", p.toURLValues())
+	return s.ListPublicIpAddressesWithContext(context.Background(), p)
+}
+
+// ListPublicIpAddressesWithContext is like ListPublicIpAddresses, but honours the cancellation and deadline of ctx
+func (s *AddressService) ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listPublicIpAddresses", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPublicIpAddressesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPublicIpAddresses", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPublicIpAddressesAll returns all PublicIpAddresses matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AddressService) ListPublicIpAddressesAll(p *ListPublicIpAddressesParams, opts ...PageOption) ([]*PublicIpAddress, error) {
+	return s.ListPublicIpAddressesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPublicIpAddressesAllWithContext is like ListPublicIpAddressesAll, but honours the cancellation and deadline of ctx
+func (s *AddressService) ListPublicIpAddressesAllWithContext(ctx context.Context, p *ListPublicIpAddressesParams, opts ...PageOption) ([]*PublicIpAddress, error) {
+	return collectPages(s.ListPublicIpAddressesIterWithContext(ctx, p, opts...))
+}
+
+// ListPublicIpAddressesIter returns an iterator over all PublicIpAddresses matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AddressService) ListPublicIpAddressesIter(p *ListPublicIpAddressesParams, opts ...PageOption) iter.Seq2[*PublicIpAddress, error] {
+	return s.ListPublicIpAddressesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPublicIpAddressesIterWithContext is like ListPublicIpAddressesIter, but honours the cancellation and deadline of ctx
+func (s *AddressService) ListPublicIpAddressesIterWithContext(ctx context.Context, p *ListPublicIpAddressesParams, opts ...PageOption) iter.Seq2[*PublicIpAddress, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*PublicIpAddress, int, error) {
+		l, err := s.ListPublicIpAddressesWithContext(ctx, &ListPublicIpAddressesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.PublicIpAddresses, l.Count, nil
+	}, opts...)
+}
+
 type ListPublicIpAddressesResponse struct {
 	Count             int                `json:"count"`
 	PublicIpAddresses []*PublicIpAddress `json:"publicipaddress"`
 }
 
 type PublicIpAddress struct {
-	Account                   string `json:"account"`
-	Allocated                 string `json:"allocated"`
-	Associatednetworkid       string `json:"associatednetworkid"`
-	Associatednetworkname     string `json:"associatednetworkname"`
-	Domain                    string `json:"domain"`
-	Domainid                  string `json:"domainid"`
-	Domainpath                string `json:"domainpath"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Forprovider               bool   `json:"forprovider"`
-	Forsystemvms              bool   `json:"forsystemvms"`
-	Forvirtualnetwork         bool   `json:"forvirtualnetwork"`
-	Hasannotations            bool   `json:"hasannotations"`
-	Hasrules                  bool   `json:"hasrules"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Isportable                bool   `json:"isportable"`
-	Issourcenat               bool   `json:"issourcenat"`
-	Isstaticnat               bool   `json:"isstaticnat"`
-	Issystem                  bool   `json:"issystem"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Networkname               string `json:"networkname"`
-	Physicalnetworkid         string `json:"physicalnetworkid"`
-	Project                   string `json:"project"`
-	Projectid                 string `json:"projectid"`
-	Purpose                   string `json:"purpose"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Virtualmachinetype        string `json:"virtualmachinetype"`
-	Vlanid                    string `json:"vlanid"`
-	Vlanname                  string `json:"vlanname"`
-	Vmipaddress               string `json:"vmipaddress"`
-	Vpcid                     string `json:"vpcid"`
-	Vpcname                   string `json:"vpcname"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Account                   string                     `json:"account"`
+	Allocated                 string                     `json:"allocated"`
+	Associatednetworkid       string                     `json:"associatednetworkid"`
+	Associatednetworkname     string                     `json:"associatednetworkname"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Domainpath                string                     `json:"domainpath"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Forprovider               FlexBool                   `json:"forprovider"`
+	Forsystemvms              FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork         FlexBool                   `json:"forvirtualnetwork"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Hasrules                  FlexBool                   `json:"hasrules"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Isportable                FlexBool                   `json:"isportable"`
+	Issourcenat               FlexBool                   `json:"issourcenat"`
+	Isstaticnat               FlexBool                   `json:"isstaticnat"`
+	Issystem                  FlexBool                   `json:"issystem"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Networkname               string                     `json:"networkname"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid"`
+	Project                   string                     `json:"project"`
+	Projectid                 string                     `json:"projectid"`
+	Purpose                   string                     `json:"purpose"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Virtualmachinetype        string                     `json:"virtualmachinetype"`
+	Vlanid                    string                     `json:"vlanid"`
+	Vlanname                  string                     `json:"vlanname"`
+	Vmipaddress               string                     `json:"vmipaddress"`
+	Vpcid                     string                     `json:"vpcid"`
+	Vpcname                   string                     `json:"vpcname"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PublicIpAddress) UnmarshalJSON(b []byte) error {
+	type alias PublicIpAddress
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateIpAddressParams struct {
@@ -1393,6 +1723,46 @@ func (p *UpdateIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateIpAddress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateIpAddressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateIpAddress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateIpAddress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateIpAddressParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateIpAddress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateIpAddress API, before the request is signed
+func (p *UpdateIpAddressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateIpAddressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateIpAddressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateIpAddress", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateIpAddressParams) Clone() *UpdateIpAddressParams {
+	return &UpdateIpAddressParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateIpAddressParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1467,32 +1837,39 @@ func (s *AddressService) NewUpdateIpAddressParams(id string) *UpdateIpAddressPar
 
 // Updates an IP address
 func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
-	resp, err := s.cs.newPostRequest("updateIpAddress", p.toURLValues())
+	return s.UpdateIpAddressWithContext(context.Background(), p)
+}
+
+// UpdateIpAddressWithContext is like UpdateIpAddress, but honours the cancellation and deadline of ctx
+func (s *AddressService) UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateIpAddress", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateIpAddress", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1500,47 +1877,72 @@ func (s *AddressService) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAdd
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateIpAddress async job into r
+func (r *UpdateIpAddressResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateIpAddressAsync starts the async job of UpdateIpAddress without waiting for it to finish, and returns a handle to the job
+func (s *AddressService) UpdateIpAddressAsync(ctx context.Context, p *UpdateIpAddressParams) (*Job, error) {
+	r, err := s.UpdateIpAddressWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateIpAddress", r.JobID), nil
+}
+
 type UpdateIpAddressResponse struct {
-	Account                   string `json:"account"`
-	Allocated                 string `json:"allocated"`
-	Associatednetworkid       string `json:"associatednetworkid"`
-	Associatednetworkname     string `json:"associatednetworkname"`
-	Domain                    string `json:"domain"`
-	Domainid                  string `json:"domainid"`
-	Domainpath                string `json:"domainpath"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Forprovider               bool   `json:"forprovider"`
-	Forsystemvms              bool   `json:"forsystemvms"`
-	Forvirtualnetwork         bool   `json:"forvirtualnetwork"`
-	Hasannotations            bool   `json:"hasannotations"`
-	Hasrules                  bool   `json:"hasrules"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Isportable                bool   `json:"isportable"`
-	Issourcenat               bool   `json:"issourcenat"`
-	Isstaticnat               bool   `json:"isstaticnat"`
-	Issystem                  bool   `json:"issystem"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Networkname               string `json:"networkname"`
-	Physicalnetworkid         string `json:"physicalnetworkid"`
-	Project                   string `json:"project"`
-	Projectid                 string `json:"projectid"`
-	Purpose                   string `json:"purpose"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Virtualmachinetype        string `json:"virtualmachinetype"`
-	Vlanid                    string `json:"vlanid"`
-	Vlanname                  string `json:"vlanname"`
-	Vmipaddress               string `json:"vmipaddress"`
-	Vpcid                     string `json:"vpcid"`
-	Vpcname                   string `json:"vpcname"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Account                   string                     `json:"account"`
+	Allocated                 string                     `json:"allocated"`
+	Associatednetworkid       string                     `json:"associatednetworkid"`
+	Associatednetworkname     string                     `json:"associatednetworkname"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Domainpath                string                     `json:"domainpath"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Forprovider               FlexBool                   `json:"forprovider"`
+	Forsystemvms              FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork         FlexBool                   `json:"forvirtualnetwork"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Hasrules                  FlexBool                   `json:"hasrules"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Isportable                FlexBool                   `json:"isportable"`
+	Issourcenat               FlexBool                   `json:"issourcenat"`
+	Isstaticnat               FlexBool                   `json:"isstaticnat"`
+	Issystem                  FlexBool                   `json:"issystem"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Networkname               string                     `json:"networkname"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid"`
+	Project                   string                     `json:"project"`
+	Projectid                 string                     `json:"projectid"`
+	Purpose                   string                     `json:"purpose"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Virtualmachinetype        string                     `json:"virtualmachinetype"`
+	Vlanid                    string                     `json:"vlanid"`
+	Vlanname                  string                     `json:"vlanname"`
+	Vmipaddress               string                     `json:"vmipaddress"`
+	Vpcid                     string                     `json:"vpcid"`
+	Vpcname                   string                     `json:"vpcname"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateIpAddressResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateIpAddressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseIpAddressParams struct {
@@ -1558,6 +1960,44 @@ func (p *ReleaseIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseIpAddress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseIpAddressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseIpAddress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseIpAddress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseIpAddressParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("releaseIpAddress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseIpAddress API, before the request is signed
+func (p *ReleaseIpAddressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseIpAddressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseIpAddressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseIpAddress", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseIpAddressParams) Clone() *ReleaseIpAddressParams {
+	return &ReleaseIpAddressParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseIpAddressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1590,13 +2030,22 @@ func (s *AddressService) NewReleaseIpAddressParams(id string) *ReleaseIpAddressP
 
 // Releases an IP address from the Account.
 func (s *AddressService) ReleaseIpAddress(p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseIpAddress", p.toURLValues())
+	return s.ReleaseIpAddressWithContext(context.Background(), p)
+}
+
+// ReleaseIpAddressWithContext is like ReleaseIpAddress, but honours the cancellation and deadline of ctx
+func (s *AddressService) ReleaseIpAddressWithContext(ctx context.Context, p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1604,10 +2053,11 @@ func (s *AddressService) ReleaseIpAddress(p *ReleaseIpAddressParams) (*ReleaseIp
 }
 
 type ReleaseIpAddressResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ReleaseIpAddressResponse) UnmarshalJSON(b []byte) error {
@@ -1634,7 +2084,7 @@ func (r *ReleaseIpAddressResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ReleaseIpAddressResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleasePodIpAddressParams struct {
@@ -1653,6 +2103,43 @@ func (p *ReleasePodIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releasePodIpAddress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleasePodIpAddressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releasePodIpAddress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releasePodIpAddress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleasePodIpAddressParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+	}.validate("releasePodIpAddress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releasePodIpAddress API, before the request is signed
+func (p *ReleasePodIpAddressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleasePodIpAddressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleasePodIpAddressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releasePodIpAddress", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleasePodIpAddressParams) Clone() *ReleasePodIpAddressParams {
+	return &ReleasePodIpAddressParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleasePodIpAddressParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1685,13 +2172,22 @@ func (s *AddressService) NewReleasePodIpAddressParams(id int64) *ReleasePodIpAdd
 
 // Releases a Pod IP back to the Pod
 func (s *AddressService) ReleasePodIpAddress(p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error) {
-	resp, err := s.cs.newPostRequest("releasePodIpAddress", p.toURLValues())
+	return s.ReleasePodIpAddressWithContext(context.Background(), p)
+}
+
+// ReleasePodIpAddressWithContext is like ReleasePodIpAddress, but honours the cancellation and deadline of ctx
+func (s *AddressService) ReleasePodIpAddressWithContext(ctx context.Context, p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "releasePodIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleasePodIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releasePodIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1699,10 +2195,11 @@ func (s *AddressService) ReleasePodIpAddress(p *ReleasePodIpAddressParams) (*Rel
 }
 
 type ReleasePodIpAddressResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ReleasePodIpAddressResponse) UnmarshalJSON(b []byte) error {
@@ -1729,7 +2226,7 @@ func (r *ReleasePodIpAddressResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ReleasePodIpAddressResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReserveIpAddressParams struct {
@@ -1760,6 +2257,48 @@ func (p *ReserveIpAddressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the reserveIpAddress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReserveIpAddressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("reserveIpAddress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the reserveIpAddress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReserveIpAddressParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "projectid": 255},
+	}.validate("reserveIpAddress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the reserveIpAddress API, before the request is signed
+func (p *ReserveIpAddressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReserveIpAddressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReserveIpAddressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("reserveIpAddress", b, &p.p, map[string]paramDecoder{
+		"account":    decodeParam[string],
+		"domainid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+		"projectid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReserveIpAddressParams) Clone() *ReserveIpAddressParams {
+	return &ReserveIpAddressParams{p: cloneParams(p.p)}
+}
+
 func (p *ReserveIpAddressParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1876,13 +2415,22 @@ func (s *AddressService) NewReserveIpAddressParams(id string) *ReserveIpAddressP
 
 // Reserve a public IP to an Account.
 func (s *AddressService) ReserveIpAddress(p *ReserveIpAddressParams) (*ReserveIpAddressResponse, error) {
-	resp, err := s.cs.newPostRequest("reserveIpAddress", p.toURLValues())
+	return s.ReserveIpAddressWithContext(context.Background(), p)
+}
+
+// ReserveIpAddressWithContext is like ReserveIpAddress, but honours the cancellation and deadline of ctx
+func (s *AddressService) ReserveIpAddressWithContext(ctx context.Context, p *ReserveIpAddressParams) (*ReserveIpAddressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "reserveIpAddress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReserveIpAddressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("reserveIpAddress", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1890,44 +2438,50 @@ func (s *AddressService) ReserveIpAddress(p *ReserveIpAddressParams) (*ReserveIp
 }
 
 type ReserveIpAddressResponse struct {
-	Account                   string `json:"account"`
-	Allocated                 string `json:"allocated"`
-	Associatednetworkid       string `json:"associatednetworkid"`
-	Associatednetworkname     string `json:"associatednetworkname"`
-	Domain                    string `json:"domain"`
-	Domainid                  string `json:"domainid"`
-	Domainpath                string `json:"domainpath"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Forprovider               bool   `json:"forprovider"`
-	Forsystemvms              bool   `json:"forsystemvms"`
-	Forvirtualnetwork         bool   `json:"forvirtualnetwork"`
-	Hasannotations            bool   `json:"hasannotations"`
-	Hasrules                  bool   `json:"hasrules"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Isportable                bool   `json:"isportable"`
-	Issourcenat               bool   `json:"issourcenat"`
-	Isstaticnat               bool   `json:"isstaticnat"`
-	Issystem                  bool   `json:"issystem"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Networkname               string `json:"networkname"`
-	Physicalnetworkid         string `json:"physicalnetworkid"`
-	Project                   string `json:"project"`
-	Projectid                 string `json:"projectid"`
-	Purpose                   string `json:"purpose"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Virtualmachinetype        string `json:"virtualmachinetype"`
-	Vlanid                    string `json:"vlanid"`
-	Vlanname                  string `json:"vlanname"`
-	Vmipaddress               string `json:"vmipaddress"`
-	Vpcid                     string `json:"vpcid"`
-	Vpcname                   string `json:"vpcname"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Account                   string                     `json:"account"`
+	Allocated                 string                     `json:"allocated"`
+	Associatednetworkid       string                     `json:"associatednetworkid"`
+	Associatednetworkname     string                     `json:"associatednetworkname"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Domainpath                string                     `json:"domainpath"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Forprovider               FlexBool                   `json:"forprovider"`
+	Forsystemvms              FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork         FlexBool                   `json:"forvirtualnetwork"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Hasrules                  FlexBool                   `json:"hasrules"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Isportable                FlexBool                   `json:"isportable"`
+	Issourcenat               FlexBool                   `json:"issourcenat"`
+	Isstaticnat               FlexBool                   `json:"isstaticnat"`
+	Issystem                  FlexBool                   `json:"issystem"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Networkname               string                     `json:"networkname"`
+	Physicalnetworkid         string                     `json:"physicalnetworkid"`
+	Project                   string                     `json:"project"`
+	Projectid                 string                     `json:"projectid"`
+	Purpose                   string                     `json:"purpose"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Virtualmachinetype        string                     `json:"virtualmachinetype"`
+	Vlanid                    string                     `json:"vlanid"`
+	Vlanname                  string                     `json:"vlanname"`
+	Vmipaddress               string                     `json:"vmipaddress"`
+	Vpcid                     string                     `json:"vpcid"`
+	Vpcname                   string                     `json:"vpcname"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReserveIpAddressResponse) UnmarshalJSON(b []byte) error {
+	type alias ReserveIpAddressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockAddressServiceIfaceMockRecorder) AcquirePodIpAddress(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquirePodIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).AcquirePodIpAddress), p)
 }
 
+// AcquirePodIpAddressWithContext mocks base method.
+func (m *MockAddressServiceIface) AcquirePodIpAddressWithContext(ctx context.Context, p *AcquirePodIpAddressParams) (*AcquirePodIpAddressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AcquirePodIpAddressWithContext", ctx, p)
+	ret0, _ := ret[0].(*AcquirePodIpAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AcquirePodIpAddressWithContext indicates an expected call of AcquirePodIpAddressWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) AcquirePodIpAddressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AcquirePodIpAddressWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).AcquirePodIpAddressWithContext), ctx, p)
+}
+
 // AssociateIpAddress mocks base method.
 func (m *MockAddressServiceIface) AssociateIpAddress(p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockAddressServiceIfaceMockRecorder) AssociateIpAddress(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).AssociateIpAddress), p)
 }
 
+// AssociateIpAddressAsync mocks base method.
+func (m *MockAddressServiceIface) AssociateIpAddressAsync(ctx context.Context, p *AssociateIpAddressParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateIpAddressAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateIpAddressAsync indicates an expected call of AssociateIpAddressAsync.
+func (mr *MockAddressServiceIfaceMockRecorder) AssociateIpAddressAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateIpAddressAsync", reflect.TypeOf((*MockAddressServiceIface)(nil).AssociateIpAddressAsync), ctx, p)
+}
+
+// AssociateIpAddressWithContext mocks base method.
+func (m *MockAddressServiceIface) AssociateIpAddressWithContext(ctx context.Context, p *AssociateIpAddressParams) (*AssociateIpAddressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateIpAddressWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssociateIpAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateIpAddressWithContext indicates an expected call of AssociateIpAddressWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) AssociateIpAddressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateIpAddressWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).AssociateIpAddressWithContext), ctx, p)
+}
+
 // DisassociateIpAddress mocks base method.
 func (m *MockAddressServiceIface) DisassociateIpAddress(p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,36 @@ func (mr *MockAddressServiceIfaceMockRecorder) DisassociateIpAddress(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).DisassociateIpAddress), p)
 }
 
+// DisassociateIpAddressAsync mocks base method.
+func (m *MockAddressServiceIface) DisassociateIpAddressAsync(ctx context.Context, p *DisassociateIpAddressParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateIpAddressAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisassociateIpAddressAsync indicates an expected call of DisassociateIpAddressAsync.
+func (mr *MockAddressServiceIfaceMockRecorder) DisassociateIpAddressAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateIpAddressAsync", reflect.TypeOf((*MockAddressServiceIface)(nil).DisassociateIpAddressAsync), ctx, p)
+}
+
+// DisassociateIpAddressWithContext mocks base method.
+func (m *MockAddressServiceIface) DisassociateIpAddressWithContext(ctx context.Context, p *DisassociateIpAddressParams) (*DisassociateIpAddressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisassociateIpAddressWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisassociateIpAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisassociateIpAddressWithContext indicates an expected call of DisassociateIpAddressWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) DisassociateIpAddressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisassociateIpAddressWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).DisassociateIpAddressWithContext), ctx, p)
+}
+
 // GetPublicIpAddressByID mocks base method.
 func (m *MockAddressServiceIface) GetPublicIpAddressByID(id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +201,27 @@ func (mr *MockAddressServiceIfaceMockRecorder) GetPublicIpAddressByID(id any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicIpAddressByID", reflect.TypeOf((*MockAddressServiceIface)(nil).GetPublicIpAddressByID), varargs...)
 }
 
+// GetPublicIpAddressByIDWithContext mocks base method.
+func (m *MockAddressServiceIface) GetPublicIpAddressByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PublicIpAddress, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPublicIpAddressByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*PublicIpAddress)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPublicIpAddressByIDWithContext indicates an expected call of GetPublicIpAddressByIDWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) GetPublicIpAddressByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPublicIpAddressByIDWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).GetPublicIpAddressByIDWithContext), varargs...)
+}
+
 // ListPublicIpAddresses mocks base method.
 func (m *MockAddressServiceIface) ListPublicIpAddresses(p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +237,99 @@ func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddresses(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddresses", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddresses), p)
 }
 
+// ListPublicIpAddressesAll mocks base method.
+func (m *MockAddressServiceIface) ListPublicIpAddressesAll(p *ListPublicIpAddressesParams, opts ...PageOption) ([]*PublicIpAddress, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPublicIpAddressesAll", varargs...)
+	ret0, _ := ret[0].([]*PublicIpAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicIpAddressesAll indicates an expected call of ListPublicIpAddressesAll.
+func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddressesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddressesAll", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddressesAll), varargs...)
+}
+
+// ListPublicIpAddressesAllWithContext mocks base method.
+func (m *MockAddressServiceIface) ListPublicIpAddressesAllWithContext(ctx context.Context, p *ListPublicIpAddressesParams, opts ...PageOption) ([]*PublicIpAddress, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPublicIpAddressesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*PublicIpAddress)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicIpAddressesAllWithContext indicates an expected call of ListPublicIpAddressesAllWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddressesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddressesAllWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddressesAllWithContext), varargs...)
+}
+
+// ListPublicIpAddressesIter mocks base method.
+func (m *MockAddressServiceIface) ListPublicIpAddressesIter(p *ListPublicIpAddressesParams, opts ...PageOption) iter.Seq2[*PublicIpAddress, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPublicIpAddressesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PublicIpAddress, error])
+	return ret0
+}
+
+// ListPublicIpAddressesIter indicates an expected call of ListPublicIpAddressesIter.
+func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddressesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddressesIter", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddressesIter), varargs...)
+}
+
+// ListPublicIpAddressesIterWithContext mocks base method.
+func (m *MockAddressServiceIface) ListPublicIpAddressesIterWithContext(ctx context.Context, p *ListPublicIpAddressesParams, opts ...PageOption) iter.Seq2[*PublicIpAddress, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPublicIpAddressesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PublicIpAddress, error])
+	return ret0
+}
+
+// ListPublicIpAddressesIterWithContext indicates an expected call of ListPublicIpAddressesIterWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddressesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddressesIterWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddressesIterWithContext), varargs...)
+}
+
+// ListPublicIpAddressesWithContext mocks base method.
+func (m *MockAddressServiceIface) ListPublicIpAddressesWithContext(ctx context.Context, p *ListPublicIpAddressesParams) (*ListPublicIpAddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPublicIpAddressesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPublicIpAddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPublicIpAddressesWithContext indicates an expected call of ListPublicIpAddressesWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) ListPublicIpAddressesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPublicIpAddressesWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).ListPublicIpAddressesWithContext), ctx, p)
+}
+
 // NewAcquirePodIpAddressParams mocks base method.
 func (m *MockAddressServiceIface) NewAcquirePodIpAddressParams(zoneid string) *AcquirePodIpAddressParams {
 	m.ctrl.T.Helper()
@@ -266,6 +457,21 @@ func (mr *MockAddressServiceIfaceMockRecorder) ReleaseIpAddress(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).ReleaseIpAddress), p)
 }
 
+// ReleaseIpAddressWithContext mocks base method.
+func (m *MockAddressServiceIface) ReleaseIpAddressWithContext(ctx context.Context, p *ReleaseIpAddressParams) (*ReleaseIpAddressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIpAddressWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseIpAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseIpAddressWithContext indicates an expected call of ReleaseIpAddressWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) ReleaseIpAddressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIpAddressWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).ReleaseIpAddressWithContext), ctx, p)
+}
+
 // ReleasePodIpAddress mocks base method.
 func (m *MockAddressServiceIface) ReleasePodIpAddress(p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -281,6 +487,21 @@ func (mr *MockAddressServiceIfaceMockRecorder) ReleasePodIpAddress(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePodIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).ReleasePodIpAddress), p)
 }
 
+// ReleasePodIpAddressWithContext mocks base method.
+func (m *MockAddressServiceIface) ReleasePodIpAddressWithContext(ctx context.Context, p *ReleasePodIpAddressParams) (*ReleasePodIpAddressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePodIpAddressWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleasePodIpAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleasePodIpAddressWithContext indicates an expected call of ReleasePodIpAddressWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) ReleasePodIpAddressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePodIpAddressWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).ReleasePodIpAddressWithContext), ctx, p)
+}
+
 // ReserveIpAddress mocks base method.
 func (m *MockAddressServiceIface) ReserveIpAddress(p *ReserveIpAddressParams) (*ReserveIpAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -296,6 +517,21 @@ func (mr *MockAddressServiceIfaceMockRecorder) ReserveIpAddress(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).ReserveIpAddress), p)
 }
 
+// ReserveIpAddressWithContext mocks base method.
+func (m *MockAddressServiceIface) ReserveIpAddressWithContext(ctx context.Context, p *ReserveIpAddressParams) (*ReserveIpAddressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReserveIpAddressWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReserveIpAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReserveIpAddressWithContext indicates an expected call of ReserveIpAddressWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) ReserveIpAddressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReserveIpAddressWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).ReserveIpAddressWithContext), ctx, p)
+}
+
 // UpdateIpAddress mocks base method.
 func (m *MockAddressServiceIface) UpdateIpAddress(p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -310,3 +546,33 @@ func (mr *MockAddressServiceIfaceMockRecorder) UpdateIpAddress(p any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpAddress", reflect.TypeOf((*MockAddressServiceIface)(nil).UpdateIpAddress), p)
 }
+
+// UpdateIpAddressAsync mocks base method.
+func (m *MockAddressServiceIface) UpdateIpAddressAsync(ctx context.Context, p *UpdateIpAddressParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIpAddressAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIpAddressAsync indicates an expected call of UpdateIpAddressAsync.
+func (mr *MockAddressServiceIfaceMockRecorder) UpdateIpAddressAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpAddressAsync", reflect.TypeOf((*MockAddressServiceIface)(nil).UpdateIpAddressAsync), ctx, p)
+}
+
+// UpdateIpAddressWithContext mocks base method.
+func (m *MockAddressServiceIface) UpdateIpAddressWithContext(ctx context.Context, p *UpdateIpAddressParams) (*UpdateIpAddressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIpAddressWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateIpAddressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIpAddressWithContext indicates an expected call of UpdateIpAddressWithContext.
+func (mr *MockAddressServiceIfaceMockRecorder) UpdateIpAddressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpAddressWithContext", reflect.TypeOf((*MockAddressServiceIface)(nil).UpdateIpAddressWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,17 +31,36 @@ import (
 
 type AffinityGroupServiceIface interface {
 	CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error)
+	CreateAffinityGroupAsync(ctx context.Context, p *CreateAffinityGroupParams) (*Job, error)
 	NewCreateAffinityGroupParams(name string, affinityGroupType string) *CreateAffinityGroupParams
 	DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error)
+	DeleteAffinityGroupAsync(ctx context.Context, p *DeleteAffinityGroupParams) (*Job, error)
 	NewDeleteAffinityGroupParams() *DeleteAffinityGroupParams
 	ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error)
+	ListAffinityGroupTypesAll(p *ListAffinityGroupTypesParams, opts ...PageOption) ([]*AffinityGroupType, error)
+	ListAffinityGroupTypesAllWithContext(ctx context.Context, p *ListAffinityGroupTypesParams, opts ...PageOption) ([]*AffinityGroupType, error)
+	ListAffinityGroupTypesIter(p *ListAffinityGroupTypesParams, opts ...PageOption) iter.Seq2[*AffinityGroupType, error]
+	ListAffinityGroupTypesIterWithContext(ctx context.Context, p *ListAffinityGroupTypesParams, opts ...PageOption) iter.Seq2[*AffinityGroupType, error]
 	NewListAffinityGroupTypesParams() *ListAffinityGroupTypesParams
 	ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error)
+	ListAffinityGroupsAll(p *ListAffinityGroupsParams, opts ...PageOption) ([]*AffinityGroup, error)
+	ListAffinityGroupsAllWithContext(ctx context.Context, p *ListAffinityGroupsParams, opts ...PageOption) ([]*AffinityGroup, error)
+	ListAffinityGroupsIter(p *ListAffinityGroupsParams, opts ...PageOption) iter.Seq2[*AffinityGroup, error]
+	ListAffinityGroupsIterWithContext(ctx context.Context, p *ListAffinityGroupsParams, opts ...PageOption) iter.Seq2[*AffinityGroup, error]
 	NewListAffinityGroupsParams() *ListAffinityGroupsParams
 	GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetAffinityGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AffinityGroup, int, error)
 	GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error)
+	GetAffinityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AffinityGroup, int, error)
 	UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error)
+	UpdateVMAffinityGroupAsync(ctx context.Context, p *UpdateVMAffinityGroupParams) (*Job, error)
 	NewUpdateVMAffinityGroupParams(id string) *UpdateVMAffinityGroupParams
 }
 
@@ -73,6 +94,49 @@ func (p *CreateAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createAffinityGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateAffinityGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createAffinityGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createAffinityGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateAffinityGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "type"},
+		lengths:  map[string]int{"account": 255, "description": 4096, "domainid": 255, "name": 255, "projectid": 255, "type": 255},
+	}.validate("createAffinityGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createAffinityGroup API, before the request is signed
+func (p *CreateAffinityGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createAffinityGroup", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"description": decodeParam[string],
+		"domainid":    decodeParam[string],
+		"name":        decodeParam[string],
+		"projectid":   decodeParam[string],
+		"type":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateAffinityGroupParams) Clone() *CreateAffinityGroupParams {
+	return &CreateAffinityGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateAffinityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -211,32 +275,39 @@ func (s *AffinityGroupService) NewCreateAffinityGroupParams(name string, affinit
 
 // Creates an affinity/anti-affinity group
 func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("createAffinityGroup", p.toURLValues())
+	return s.CreateAffinityGroupWithContext(context.Background(), p)
+}
+
+// CreateAffinityGroupWithContext is like CreateAffinityGroup, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createAffinityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateAffinityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createAffinityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createAffinityGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createAffinityGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -244,21 +315,46 @@ func (s *AffinityGroupService) CreateAffinityGroup(p *CreateAffinityGroupParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateAffinityGroup async job into r
+func (r *CreateAffinityGroupResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateAffinityGroupAsync starts the async job of CreateAffinityGroup without waiting for it to finish, and returns a handle to the job
+func (s *AffinityGroupService) CreateAffinityGroupAsync(ctx context.Context, p *CreateAffinityGroupParams) (*Job, error) {
+	r, err := s.CreateAffinityGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createAffinityGroup", r.JobID), nil
+}
+
 type CreateAffinityGroupResponse struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	JobID              string   `json:"jobid"`
-	Jobstatus          int      `json:"jobstatus"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateAffinityGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateAffinityGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteAffinityGroupParams struct {
@@ -288,6 +384,47 @@ func (p *DeleteAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteAffinityGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteAffinityGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteAffinityGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteAffinityGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteAffinityGroupParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "name": 255, "projectid": 255},
+	}.validate("deleteAffinityGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteAffinityGroup API, before the request is signed
+func (p *DeleteAffinityGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteAffinityGroup", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"name":      decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteAffinityGroupParams) Clone() *DeleteAffinityGroupParams {
+	return &DeleteAffinityGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteAffinityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -403,27 +540,39 @@ func (s *AffinityGroupService) NewDeleteAffinityGroupParams() *DeleteAffinityGro
 
 // Deletes affinity group
 func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteAffinityGroup", p.toURLValues())
+	return s.DeleteAffinityGroupWithContext(context.Background(), p)
+}
+
+// DeleteAffinityGroupWithContext is like DeleteAffinityGroup, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteAffinityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAffinityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteAffinityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteAffinityGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteAffinityGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -431,11 +580,31 @@ func (s *AffinityGroupService) DeleteAffinityGroup(p *DeleteAffinityGroupParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteAffinityGroup async job into r
+func (r *DeleteAffinityGroupResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteAffinityGroupAsync starts the async job of DeleteAffinityGroup without waiting for it to finish, and returns a handle to the job
+func (s *AffinityGroupService) DeleteAffinityGroupAsync(ctx context.Context, p *DeleteAffinityGroupParams) (*Job, error) {
+	r, err := s.DeleteAffinityGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteAffinityGroup", r.JobID), nil
+}
+
 type DeleteAffinityGroupResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteAffinityGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteAffinityGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAffinityGroupTypesParams struct {
@@ -461,6 +630,45 @@ func (p *ListAffinityGroupTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAffinityGroupTypes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAffinityGroupTypesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAffinityGroupTypes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAffinityGroupTypes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAffinityGroupTypesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listAffinityGroupTypes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAffinityGroupTypes API, before the request is signed
+func (p *ListAffinityGroupTypesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAffinityGroupTypesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAffinityGroupTypesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAffinityGroupTypes", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAffinityGroupTypesParams) Clone() *ListAffinityGroupTypesParams {
+	return &ListAffinityGroupTypesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAffinityGroupTypesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -534,28 +742,71 @@ func (s *AffinityGroupService) NewListAffinityGroupTypesParams() *ListAffinityGr
 
 // Lists affinity group types available
 func (s *AffinityGroupService) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroupTypes", p.toURLValues())
+	return s.ListAffinityGroupTypesWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupTypesWithContext is like ListAffinityGroupTypes, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAffinityGroupTypes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAffinityGroupTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAffinityGroupTypes", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAffinityGroupTypesAll returns all AffinityGroupTypes matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AffinityGroupService) ListAffinityGroupTypesAll(p *ListAffinityGroupTypesParams, opts ...PageOption) ([]*AffinityGroupType, error) {
+	return s.ListAffinityGroupTypesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAffinityGroupTypesAllWithContext is like ListAffinityGroupTypesAll, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) ListAffinityGroupTypesAllWithContext(ctx context.Context, p *ListAffinityGroupTypesParams, opts ...PageOption) ([]*AffinityGroupType, error) {
+	return collectPages(s.ListAffinityGroupTypesIterWithContext(ctx, p, opts...))
+}
+
+// ListAffinityGroupTypesIter returns an iterator over all AffinityGroupTypes matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AffinityGroupService) ListAffinityGroupTypesIter(p *ListAffinityGroupTypesParams, opts ...PageOption) iter.Seq2[*AffinityGroupType, error] {
+	return s.ListAffinityGroupTypesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAffinityGroupTypesIterWithContext is like ListAffinityGroupTypesIter, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) ListAffinityGroupTypesIterWithContext(ctx context.Context, p *ListAffinityGroupTypesParams, opts ...PageOption) iter.Seq2[*AffinityGroupType, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*AffinityGroupType, int, error) {
+		l, err := s.ListAffinityGroupTypesWithContext(ctx, &ListAffinityGroupTypesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.AffinityGroupTypes, l.Count, nil
+	}, opts...)
+}
+
 type ListAffinityGroupTypesResponse struct {
 	Count              int                  `json:"count"`
 	AffinityGroupTypes []*AffinityGroupType `json:"affinitygrouptype"`
 }
 
 type AffinityGroupType struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Type      string `json:"type"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Type      string                     `json:"type"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AffinityGroupType) UnmarshalJSON(b []byte) error {
+	type alias AffinityGroupType
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAffinityGroupsParams struct {
@@ -610,6 +861,54 @@ func (p *ListAffinityGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAffinityGroups API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAffinityGroupsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAffinityGroups", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAffinityGroups API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAffinityGroupsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "type": 255, "virtualmachineid": 255},
+	}.validate("listAffinityGroups", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAffinityGroups API, before the request is signed
+func (p *ListAffinityGroupsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAffinityGroupsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAffinityGroupsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAffinityGroups", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"id":               decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"name":             decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"type":             decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAffinityGroupsParams) Clone() *ListAffinityGroupsParams {
+	return &ListAffinityGroupsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAffinityGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -872,6 +1171,11 @@ func (s *AffinityGroupService) NewListAffinityGroupsParams() *ListAffinityGroups
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAffinityGroupIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAffinityGroupIDWithContext is like GetAffinityGroupID, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) GetAffinityGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAffinityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -883,7 +1187,7 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListAffinityGroups(p)
+	l, err := s.ListAffinityGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -912,12 +1216,17 @@ func (s *AffinityGroupService) GetAffinityGroupID(name string, opts ...OptionFun
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
-	id, count, err := s.GetAffinityGroupID(name, opts...)
+	return s.GetAffinityGroupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAffinityGroupByNameWithContext is like GetAffinityGroupByName, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) GetAffinityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	id, count, err := s.GetAffinityGroupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAffinityGroupByID(id, opts...)
+	r, count, err := s.GetAffinityGroupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -926,6 +1235,11 @@ func (s *AffinityGroupService) GetAffinityGroupByName(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	return s.GetAffinityGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAffinityGroupByIDWithContext is like GetAffinityGroupByID, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) GetAffinityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
 	p := &ListAffinityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -937,11 +1251,10 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListAffinityGroups(p)
+	l, err := s.ListAffinityGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -963,39 +1276,82 @@ func (s *AffinityGroupService) GetAffinityGroupByID(id string, opts ...OptionFun
 
 // Lists affinity groups
 func (s *AffinityGroupService) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAffinityGroups", p.toURLValues())
+	return s.ListAffinityGroupsWithContext(context.Background(), p)
+}
+
+// ListAffinityGroupsWithContext is like ListAffinityGroups, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAffinityGroups", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAffinityGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAffinityGroups", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAffinityGroupsAll returns all AffinityGroups matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AffinityGroupService) ListAffinityGroupsAll(p *ListAffinityGroupsParams, opts ...PageOption) ([]*AffinityGroup, error) {
+	return s.ListAffinityGroupsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAffinityGroupsAllWithContext is like ListAffinityGroupsAll, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) ListAffinityGroupsAllWithContext(ctx context.Context, p *ListAffinityGroupsParams, opts ...PageOption) ([]*AffinityGroup, error) {
+	return collectPages(s.ListAffinityGroupsIterWithContext(ctx, p, opts...))
+}
+
+// ListAffinityGroupsIter returns an iterator over all AffinityGroups matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AffinityGroupService) ListAffinityGroupsIter(p *ListAffinityGroupsParams, opts ...PageOption) iter.Seq2[*AffinityGroup, error] {
+	return s.ListAffinityGroupsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAffinityGroupsIterWithContext is like ListAffinityGroupsIter, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) ListAffinityGroupsIterWithContext(ctx context.Context, p *ListAffinityGroupsParams, opts ...PageOption) iter.Seq2[*AffinityGroup, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*AffinityGroup, int, error) {
+		l, err := s.ListAffinityGroupsWithContext(ctx, &ListAffinityGroupsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.AffinityGroups, l.Count, nil
+	}, opts...)
+}
+
 type ListAffinityGroupsResponse struct {
 	Count          int              `json:"count"`
 	AffinityGroups []*AffinityGroup `json:"affinitygroup"`
 }
 
 type AffinityGroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	JobID              string   `json:"jobid"`
-	Jobstatus          int      `json:"jobstatus"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AffinityGroup) UnmarshalJSON(b []byte) error {
+	type alias AffinityGroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVMAffinityGroupParams struct {
@@ -1021,6 +1377,46 @@ func (p *UpdateVMAffinityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVMAffinityGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVMAffinityGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVMAffinityGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVMAffinityGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVMAffinityGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("updateVMAffinityGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVMAffinityGroup API, before the request is signed
+func (p *UpdateVMAffinityGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVMAffinityGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVMAffinityGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVMAffinityGroup", b, &p.p, map[string]paramDecoder{
+		"affinitygroupids":   decodeParam[[]string],
+		"affinitygroupnames": decodeParam[[]string],
+		"id":                 decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVMAffinityGroupParams) Clone() *UpdateVMAffinityGroupParams {
+	return &UpdateVMAffinityGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVMAffinityGroupParams) SetAffinitygroupids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1095,32 +1491,39 @@ func (s *AffinityGroupService) NewUpdateVMAffinityGroupParams(id string) *Update
 
 // Updates the affinity/anti-affinity group associations of an Instance. The Instance has to be stopped and restarted for the new properties to take effect.
 func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVMAffinityGroup", p.toURLValues())
+	return s.UpdateVMAffinityGroupWithContext(context.Background(), p)
+}
+
+// UpdateVMAffinityGroupWithContext is like UpdateVMAffinityGroup, but honours the cancellation and deadline of ctx
+func (s *AffinityGroupService) UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVMAffinityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVMAffinityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVMAffinityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVMAffinityGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVMAffinityGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1128,6 +1531,25 @@ func (s *AffinityGroupService) UpdateVMAffinityGroup(p *UpdateVMAffinityGroupPar
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVMAffinityGroup async job into r
+func (r *UpdateVMAffinityGroupResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVMAffinityGroupAsync starts the async job of UpdateVMAffinityGroup without waiting for it to finish, and returns a handle to the job
+func (s *AffinityGroupService) UpdateVMAffinityGroupAsync(ctx context.Context, p *UpdateVMAffinityGroupParams) (*Job, error) {
+	r, err := s.UpdateVMAffinityGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVMAffinityGroup", r.JobID), nil
+}
+
 type UpdateVMAffinityGroupResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []UpdateVMAffinityGroupResponseAffinitygroup `json:"affinitygroup"`
@@ -1139,32 +1561,32 @@ type UpdateVMAffinityGroupResponse struct {
 	Backupofferingname    string                                       `json:"backupofferingname"`
 	Bootmode              string                                       `json:"bootmode"`
 	Boottype              string                                       `json:"boottype"`
-	Cpunumber             int                                          `json:"cpunumber"`
-	Cpuspeed              int                                          `json:"cpuspeed"`
+	Cpunumber             FlexInt                                      `json:"cpunumber"`
+	Cpuspeed              FlexInt                                      `json:"cpuspeed"`
 	Cpuused               string                                       `json:"cpuused"`
-	Created               string                                       `json:"created"`
-	Deleteprotection      bool                                         `json:"deleteprotection"`
+	Created               Time                                         `json:"created"`
+	Deleteprotection      FlexBool                                     `json:"deleteprotection"`
 	Details               map[string]string                            `json:"details"`
-	Diskioread            int64                                        `json:"diskioread"`
-	Diskiowrite           int64                                        `json:"diskiowrite"`
-	Diskkbsread           int64                                        `json:"diskkbsread"`
-	Diskkbswrite          int64                                        `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                    `json:"diskioread"`
+	Diskiowrite           FlexInt64                                    `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                    `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                    `json:"diskkbswrite"`
 	Diskofferingid        string                                       `json:"diskofferingid"`
 	Diskofferingname      string                                       `json:"diskofferingname"`
 	Displayname           string                                       `json:"displayname"`
-	Displayvm             bool                                         `json:"displayvm"`
+	Displayvm             FlexBool                                     `json:"displayvm"`
 	Domain                string                                       `json:"domain"`
 	Domainid              string                                       `json:"domainid"`
 	Domainpath            string                                       `json:"domainpath"`
-	Forvirtualnetwork     bool                                         `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                     `json:"forvirtualnetwork"`
 	Gpucardid             string                                       `json:"gpucardid"`
 	Gpucardname           string                                       `json:"gpucardname"`
-	Gpucount              int                                          `json:"gpucount"`
+	Gpucount              FlexInt                                      `json:"gpucount"`
 	Group                 string                                       `json:"group"`
 	Groupid               string                                       `json:"groupid"`
 	Guestosid             string                                       `json:"guestosid"`
-	Haenable              bool                                         `json:"haenable"`
-	Hasannotations        bool                                         `json:"hasannotations"`
+	Haenable              FlexBool                                     `json:"haenable"`
+	Hasannotations        FlexBool                                     `json:"hasannotations"`
 	Hostcontrolstate      string                                       `json:"hostcontrolstate"`
 	Hostid                string                                       `json:"hostid"`
 	Hostname              string                                       `json:"hostname"`
@@ -1173,43 +1595,43 @@ type UpdateVMAffinityGroupResponse struct {
 	Id                    string                                       `json:"id"`
 	Instancename          string                                       `json:"instancename"`
 	Ipaddress             string                                       `json:"ipaddress"`
-	Isdynamicallyscalable bool                                         `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                     `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                       `json:"isodisplaytext"`
 	Isoid                 string                                       `json:"isoid"`
 	Isoname               string                                       `json:"isoname"`
 	JobID                 string                                       `json:"jobid"`
-	Jobstatus             int                                          `json:"jobstatus"`
+	Jobstatus             FlexInt                                      `json:"jobstatus"`
 	Keypairs              string                                       `json:"keypairs"`
-	Lastupdated           string                                       `json:"lastupdated"`
-	Leaseduration         int                                          `json:"leaseduration"`
+	Lastupdated           Time                                         `json:"lastupdated"`
+	Leaseduration         FlexInt                                      `json:"leaseduration"`
 	Leaseexpiryaction     string                                       `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                       `json:"leaseexpirydate"`
-	Maxheads              int64                                        `json:"maxheads"`
-	Maxresolutionx        int64                                        `json:"maxresolutionx"`
-	Maxresolutiony        int64                                        `json:"maxresolutiony"`
-	Memory                int                                          `json:"memory"`
-	Memoryintfreekbs      int64                                        `json:"memoryintfreekbs"`
-	Memorykbs             int64                                        `json:"memorykbs"`
-	Memorytargetkbs       int64                                        `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                         `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                    `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                    `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                    `json:"maxresolutiony"`
+	Memory                FlexInt                                      `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                    `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                    `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                    `json:"memorytargetkbs"`
 	Name                  string                                       `json:"name"`
-	Networkkbsread        int64                                        `json:"networkkbsread"`
-	Networkkbswrite       int64                                        `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                    `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                    `json:"networkkbswrite"`
 	Nic                   []Nic                                        `json:"nic"`
 	Osdisplayname         string                                       `json:"osdisplayname"`
 	Ostypeid              string                                       `json:"ostypeid"`
 	Password              string                                       `json:"password"`
-	Passwordenabled       bool                                         `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                     `json:"passwordenabled"`
 	Pooltype              string                                       `json:"pooltype"`
 	Project               string                                       `json:"project"`
 	Projectid             string                                       `json:"projectid"`
 	Publicip              string                                       `json:"publicip"`
 	Publicipid            string                                       `json:"publicipid"`
 	Readonlydetails       string                                       `json:"readonlydetails"`
-	Receivedbytes         int64                                        `json:"receivedbytes"`
-	Rootdeviceid          int64                                        `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                    `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                    `json:"rootdeviceid"`
 	Rootdevicetype        string                                       `json:"rootdevicetype"`
 	Securitygroup         []UpdateVMAffinityGroupResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                        `json:"sentbytes"`
+	Sentbytes             FlexInt64                                    `json:"sentbytes"`
 	Serviceofferingid     string                                       `json:"serviceofferingid"`
 	Serviceofferingname   string                                       `json:"serviceofferingname"`
 	Servicestate          string                                       `json:"servicestate"`
@@ -1230,12 +1652,13 @@ type UpdateVMAffinityGroupResponse struct {
 	Vgpu                  string                                       `json:"vgpu"`
 	Vgpuprofileid         string                                       `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                       `json:"vgpuprofilename"`
-	Videoram              int64                                        `json:"videoram"`
+	Videoram              FlexInt64                                    `json:"videoram"`
 	Vmtype                string                                       `json:"vmtype"`
 	Vnfdetails            map[string]string                            `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                    `json:"vnfnics"`
 	Zoneid                string                                       `json:"zoneid"`
 	Zonename              string                                       `json:"zonename"`
+	Extra                 map[string]json.RawMessage                   `json:"-"`
 }
 
 type UpdateVMAffinityGroupResponseSecuritygroup struct {
@@ -1251,36 +1674,54 @@ type UpdateVMAffinityGroupResponseSecuritygroup struct {
 	Project             string                                           `json:"project"`
 	Projectid           string                                           `json:"projectid"`
 	Tags                []Tags                                           `json:"tags"`
-	Virtualmachinecount int                                              `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                          `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                    `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *UpdateVMAffinityGroupResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateVMAffinityGroupResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVMAffinityGroupResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVMAffinityGroupResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias UpdateVMAffinityGroupResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVMAffinityGroupResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVMAffinityGroupResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateVMAffinityGroupResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *UpdateVMAffinityGroupResponse) UnmarshalJSON(b []byte) error {
@@ -1307,5 +1748,5 @@ func (r *UpdateVMAffinityGroupResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateVMAffinityGroupResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) CreateAffinityGroup(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).CreateAffinityGroup), p)
 }
 
+// CreateAffinityGroupAsync mocks base method.
+func (m *MockAffinityGroupServiceIface) CreateAffinityGroupAsync(ctx context.Context, p *CreateAffinityGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAffinityGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAffinityGroupAsync indicates an expected call of CreateAffinityGroupAsync.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) CreateAffinityGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAffinityGroupAsync", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).CreateAffinityGroupAsync), ctx, p)
+}
+
+// CreateAffinityGroupWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) CreateAffinityGroupWithContext(ctx context.Context, p *CreateAffinityGroupParams) (*CreateAffinityGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAffinityGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateAffinityGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAffinityGroupWithContext indicates an expected call of CreateAffinityGroupWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) CreateAffinityGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAffinityGroupWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).CreateAffinityGroupWithContext), ctx, p)
+}
+
 // DeleteAffinityGroup mocks base method.
 func (m *MockAffinityGroupServiceIface) DeleteAffinityGroup(p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) DeleteAffinityGroup(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).DeleteAffinityGroup), p)
 }
 
+// DeleteAffinityGroupAsync mocks base method.
+func (m *MockAffinityGroupServiceIface) DeleteAffinityGroupAsync(ctx context.Context, p *DeleteAffinityGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAffinityGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAffinityGroupAsync indicates an expected call of DeleteAffinityGroupAsync.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) DeleteAffinityGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAffinityGroupAsync", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).DeleteAffinityGroupAsync), ctx, p)
+}
+
+// DeleteAffinityGroupWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) DeleteAffinityGroupWithContext(ctx context.Context, p *DeleteAffinityGroupParams) (*DeleteAffinityGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAffinityGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteAffinityGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAffinityGroupWithContext indicates an expected call of DeleteAffinityGroupWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) DeleteAffinityGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAffinityGroupWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).DeleteAffinityGroupWithContext), ctx, p)
+}
+
 // GetAffinityGroupByID mocks base method.
 func (m *MockAffinityGroupServiceIface) GetAffinityGroupByID(id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +171,27 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) GetAffinityGroupByID(id any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffinityGroupByID", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).GetAffinityGroupByID), varargs...)
 }
 
+// GetAffinityGroupByIDWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) GetAffinityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAffinityGroupByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*AffinityGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAffinityGroupByIDWithContext indicates an expected call of GetAffinityGroupByIDWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) GetAffinityGroupByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffinityGroupByIDWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).GetAffinityGroupByIDWithContext), varargs...)
+}
+
 // GetAffinityGroupByName mocks base method.
 func (m *MockAffinityGroupServiceIface) GetAffinityGroupByName(name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +213,27 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) GetAffinityGroupByName(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffinityGroupByName", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).GetAffinityGroupByName), varargs...)
 }
 
+// GetAffinityGroupByNameWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) GetAffinityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AffinityGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAffinityGroupByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*AffinityGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAffinityGroupByNameWithContext indicates an expected call of GetAffinityGroupByNameWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) GetAffinityGroupByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffinityGroupByNameWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).GetAffinityGroupByNameWithContext), varargs...)
+}
+
 // GetAffinityGroupID mocks base method.
 func (m *MockAffinityGroupServiceIface) GetAffinityGroupID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +255,27 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) GetAffinityGroupID(name any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffinityGroupID", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).GetAffinityGroupID), varargs...)
 }
 
+// GetAffinityGroupIDWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) GetAffinityGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAffinityGroupIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAffinityGroupIDWithContext indicates an expected call of GetAffinityGroupIDWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) GetAffinityGroupIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffinityGroupIDWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).GetAffinityGroupIDWithContext), varargs...)
+}
+
 // ListAffinityGroupTypes mocks base method.
 func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypes(p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +291,99 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypes(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypes", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypes), p)
 }
 
+// ListAffinityGroupTypesAll mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypesAll(p *ListAffinityGroupTypesParams, opts ...PageOption) ([]*AffinityGroupType, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupTypesAll", varargs...)
+	ret0, _ := ret[0].([]*AffinityGroupType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffinityGroupTypesAll indicates an expected call of ListAffinityGroupTypesAll.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypesAll", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypesAll), varargs...)
+}
+
+// ListAffinityGroupTypesAllWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypesAllWithContext(ctx context.Context, p *ListAffinityGroupTypesParams, opts ...PageOption) ([]*AffinityGroupType, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupTypesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*AffinityGroupType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffinityGroupTypesAllWithContext indicates an expected call of ListAffinityGroupTypesAllWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypesAllWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypesAllWithContext), varargs...)
+}
+
+// ListAffinityGroupTypesIter mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypesIter(p *ListAffinityGroupTypesParams, opts ...PageOption) iter.Seq2[*AffinityGroupType, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupTypesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AffinityGroupType, error])
+	return ret0
+}
+
+// ListAffinityGroupTypesIter indicates an expected call of ListAffinityGroupTypesIter.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypesIter", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypesIter), varargs...)
+}
+
+// ListAffinityGroupTypesIterWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypesIterWithContext(ctx context.Context, p *ListAffinityGroupTypesParams, opts ...PageOption) iter.Seq2[*AffinityGroupType, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupTypesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AffinityGroupType, error])
+	return ret0
+}
+
+// ListAffinityGroupTypesIterWithContext indicates an expected call of ListAffinityGroupTypesIterWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypesIterWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypesIterWithContext), varargs...)
+}
+
+// ListAffinityGroupTypesWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupTypesWithContext(ctx context.Context, p *ListAffinityGroupTypesParams) (*ListAffinityGroupTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAffinityGroupTypesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAffinityGroupTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffinityGroupTypesWithContext indicates an expected call of ListAffinityGroupTypesWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupTypesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupTypesWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupTypesWithContext), ctx, p)
+}
+
 // ListAffinityGroups mocks base method.
 func (m *MockAffinityGroupServiceIface) ListAffinityGroups(p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +399,99 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroups(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroups", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroups), p)
 }
 
+// ListAffinityGroupsAll mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupsAll(p *ListAffinityGroupsParams, opts ...PageOption) ([]*AffinityGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupsAll", varargs...)
+	ret0, _ := ret[0].([]*AffinityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffinityGroupsAll indicates an expected call of ListAffinityGroupsAll.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupsAll", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupsAll), varargs...)
+}
+
+// ListAffinityGroupsAllWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupsAllWithContext(ctx context.Context, p *ListAffinityGroupsParams, opts ...PageOption) ([]*AffinityGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*AffinityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffinityGroupsAllWithContext indicates an expected call of ListAffinityGroupsAllWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupsAllWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupsAllWithContext), varargs...)
+}
+
+// ListAffinityGroupsIter mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupsIter(p *ListAffinityGroupsParams, opts ...PageOption) iter.Seq2[*AffinityGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AffinityGroup, error])
+	return ret0
+}
+
+// ListAffinityGroupsIter indicates an expected call of ListAffinityGroupsIter.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupsIter", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupsIter), varargs...)
+}
+
+// ListAffinityGroupsIterWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupsIterWithContext(ctx context.Context, p *ListAffinityGroupsParams, opts ...PageOption) iter.Seq2[*AffinityGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffinityGroupsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AffinityGroup, error])
+	return ret0
+}
+
+// ListAffinityGroupsIterWithContext indicates an expected call of ListAffinityGroupsIterWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupsIterWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupsIterWithContext), varargs...)
+}
+
+// ListAffinityGroupsWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) ListAffinityGroupsWithContext(ctx context.Context, p *ListAffinityGroupsParams) (*ListAffinityGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAffinityGroupsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAffinityGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffinityGroupsWithContext indicates an expected call of ListAffinityGroupsWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) ListAffinityGroupsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffinityGroupsWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).ListAffinityGroupsWithContext), ctx, p)
+}
+
 // NewCreateAffinityGroupParams mocks base method.
 func (m *MockAffinityGroupServiceIface) NewCreateAffinityGroupParams(name, affinityGroupType string) *CreateAffinityGroupParams {
 	m.ctrl.T.Helper()
@@ -265,3 +576,33 @@ func (mr *MockAffinityGroupServiceIfaceMockRecorder) UpdateVMAffinityGroup(p any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMAffinityGroup", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).UpdateVMAffinityGroup), p)
 }
+
+// UpdateVMAffinityGroupAsync mocks base method.
+func (m *MockAffinityGroupServiceIface) UpdateVMAffinityGroupAsync(ctx context.Context, p *UpdateVMAffinityGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVMAffinityGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVMAffinityGroupAsync indicates an expected call of UpdateVMAffinityGroupAsync.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) UpdateVMAffinityGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMAffinityGroupAsync", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).UpdateVMAffinityGroupAsync), ctx, p)
+}
+
+// UpdateVMAffinityGroupWithContext mocks base method.
+func (m *MockAffinityGroupServiceIface) UpdateVMAffinityGroupWithContext(ctx context.Context, p *UpdateVMAffinityGroupParams) (*UpdateVMAffinityGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVMAffinityGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVMAffinityGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVMAffinityGroupWithContext indicates an expected call of UpdateVMAffinityGroupWithContext.
+func (mr *MockAffinityGroupServiceIfaceMockRecorder) UpdateVMAffinityGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMAffinityGroupWithContext", reflect.TypeOf((*MockAffinityGroupServiceIface)(nil).UpdateVMAffinityGroupWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,17 +31,30 @@ import (
 
 type AlertServiceIface interface {
 	ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
+	ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error)
 	NewArchiveAlertsParams() *ArchiveAlertsParams
 	DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
+	DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error)
 	NewDeleteAlertsParams() *DeleteAlertsParams
 	GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error)
+	GenerateAlertAsync(ctx context.Context, p *GenerateAlertParams) (*Job, error)
 	NewGenerateAlertParams(description string, name string, alertType int) *GenerateAlertParams
 	ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error)
+	ListAlertsAll(p *ListAlertsParams, opts ...PageOption) ([]*Alert, error)
+	ListAlertsAllWithContext(ctx context.Context, p *ListAlertsParams, opts ...PageOption) ([]*Alert, error)
+	ListAlertsIter(p *ListAlertsParams, opts ...PageOption) iter.Seq2[*Alert, error]
+	ListAlertsIterWithContext(ctx context.Context, p *ListAlertsParams, opts ...PageOption) iter.Seq2[*Alert, error]
 	NewListAlertsParams() *ListAlertsParams
 	GetAlertID(name string, opts ...OptionFunc) (string, int, error)
+	GetAlertIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Alert, int, error)
 	GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error)
+	GetAlertByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Alert, int, error)
 	ListAlertTypes(p *ListAlertTypesParams) (*ListAlertTypesResponse, error)
+	ListAlertTypesWithContext(ctx context.Context, p *ListAlertTypesParams) (*ListAlertTypesResponse, error)
 	NewListAlertTypesParams() *ListAlertTypesParams
 }
 
@@ -68,6 +83,46 @@ func (p *ArchiveAlertsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the archiveAlerts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ArchiveAlertsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("archiveAlerts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the archiveAlerts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ArchiveAlertsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "startdate": 255, "type": 255},
+	}.validate("archiveAlerts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the archiveAlerts API, before the request is signed
+func (p *ArchiveAlertsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ArchiveAlertsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ArchiveAlertsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("archiveAlerts", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"ids":       decodeParam[[]string],
+		"startdate": decodeParam[string],
+		"type":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ArchiveAlertsParams) Clone() *ArchiveAlertsParams {
+	return &ArchiveAlertsParams{p: cloneParams(p.p)}
+}
+
 func (p *ArchiveAlertsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,13 +217,22 @@ func (s *AlertService) NewArchiveAlertsParams() *ArchiveAlertsParams {
 
 // Archive one or more alerts.
 func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
-	resp, err := s.cs.newPostRequest("archiveAlerts", p.toURLValues())
+	return s.ArchiveAlertsWithContext(context.Background(), p)
+}
+
+// ArchiveAlertsWithContext is like ArchiveAlerts, but honours the cancellation and deadline of ctx
+func (s *AlertService) ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "archiveAlerts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ArchiveAlertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("archiveAlerts", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -176,10 +240,11 @@ func (s *AlertService) ArchiveAlerts(p *ArchiveAlertsParams) (*ArchiveAlertsResp
 }
 
 type ArchiveAlertsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ArchiveAlertsResponse) UnmarshalJSON(b []byte) error {
@@ -206,7 +271,7 @@ func (r *ArchiveAlertsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ArchiveAlertsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteAlertsParams struct {
@@ -234,6 +299,46 @@ func (p *DeleteAlertsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteAlerts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteAlertsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteAlerts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteAlerts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteAlertsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "startdate": 255, "type": 255},
+	}.validate("deleteAlerts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteAlerts API, before the request is signed
+func (p *DeleteAlertsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteAlertsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteAlertsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteAlerts", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"ids":       decodeParam[[]string],
+		"startdate": decodeParam[string],
+		"type":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteAlertsParams) Clone() *DeleteAlertsParams {
+	return &DeleteAlertsParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteAlertsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -328,13 +433,22 @@ func (s *AlertService) NewDeleteAlertsParams() *DeleteAlertsParams {
 
 // Delete one or more alerts.
 func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteAlerts", p.toURLValues())
+	return s.DeleteAlertsWithContext(context.Background(), p)
+}
+
+// DeleteAlertsWithContext is like DeleteAlerts, but honours the cancellation and deadline of ctx
+func (s *AlertService) DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteAlerts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAlertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteAlerts", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -342,10 +456,11 @@ func (s *AlertService) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsRespons
 }
 
 type DeleteAlertsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteAlertsResponse) UnmarshalJSON(b []byte) error {
@@ -372,7 +487,7 @@ func (r *DeleteAlertsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteAlertsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GenerateAlertParams struct {
@@ -403,6 +518,48 @@ func (p *GenerateAlertParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the generateAlert API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GenerateAlertParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("generateAlert", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the generateAlert API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GenerateAlertParams) Validate() error {
+	return paramRules{
+		required: []string{"description", "name", "type"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "podid": 255, "zoneid": 255},
+	}.validate("generateAlert", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the generateAlert API, before the request is signed
+func (p *GenerateAlertParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GenerateAlertParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GenerateAlertParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("generateAlert", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"name":        decodeParam[string],
+		"podid":       decodeParam[string],
+		"type":        decodeParam[int],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GenerateAlertParams) Clone() *GenerateAlertParams {
+	return &GenerateAlertParams{p: cloneParams(p.p)}
+}
+
 func (p *GenerateAlertParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -521,27 +678,39 @@ func (s *AlertService) NewGenerateAlertParams(description string, name string, a
 
 // Generates an alert
 func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
-	resp, err := s.cs.newPostRequest("generateAlert", p.toURLValues())
+	return s.GenerateAlertWithContext(context.Background(), p)
+}
+
+// GenerateAlertWithContext is like GenerateAlert, but honours the cancellation and deadline of ctx
+func (s *AlertService) GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "generateAlert", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GenerateAlertResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("generateAlert", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "generateAlert", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("generateAlert", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -549,11 +718,31 @@ func (s *AlertService) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResp
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished GenerateAlert async job into r
+func (r *GenerateAlertResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// GenerateAlertAsync starts the async job of GenerateAlert without waiting for it to finish, and returns a handle to the job
+func (s *AlertService) GenerateAlertAsync(ctx context.Context, p *GenerateAlertParams) (*Job, error) {
+	r, err := s.GenerateAlertWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("generateAlert", r.JobID), nil
+}
+
 type GenerateAlertResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GenerateAlertResponse) UnmarshalJSON(b []byte) error {
+	type alias GenerateAlertResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAlertsParams struct {
@@ -588,6 +777,48 @@ func (p *ListAlertsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAlerts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAlertsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAlerts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAlerts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAlertsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "type": 255},
+	}.validate("listAlerts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAlerts API, before the request is signed
+func (p *ListAlertsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAlertsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAlertsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAlerts", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"type":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAlertsParams) Clone() *ListAlertsParams {
+	return &ListAlertsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAlertsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -724,6 +955,11 @@ func (s *AlertService) NewListAlertsParams() *ListAlertsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAlertIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAlertIDWithContext is like GetAlertID, but honours the cancellation and deadline of ctx
+func (s *AlertService) GetAlertIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAlertsParams{}
 	p.p = make(map[string]interface{})
 
@@ -735,7 +971,7 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 		}
 	}
 
-	l, err := s.ListAlerts(p)
+	l, err := s.ListAlertsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -760,12 +996,17 @@ func (s *AlertService) GetAlertID(name string, opts ...OptionFunc) (string, int,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error) {
-	id, count, err := s.GetAlertID(name, opts...)
+	return s.GetAlertByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAlertByNameWithContext is like GetAlertByName, but honours the cancellation and deadline of ctx
+func (s *AlertService) GetAlertByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Alert, int, error) {
+	id, count, err := s.GetAlertIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAlertByID(id, opts...)
+	r, count, err := s.GetAlertByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -774,6 +1015,11 @@ func (s *AlertService) GetAlertByName(name string, opts ...OptionFunc) (*Alert,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error) {
+	return s.GetAlertByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAlertByIDWithContext is like GetAlertByID, but honours the cancellation and deadline of ctx
+func (s *AlertService) GetAlertByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Alert, int, error) {
 	p := &ListAlertsParams{}
 	p.p = make(map[string]interface{})
 
@@ -785,11 +1031,10 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 		}
 	}
 
-	l, err := s.ListAlerts(p)
+	l, err := s.ListAlertsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -807,32 +1052,75 @@ func (s *AlertService) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int,
 
 // Lists all alerts.
 func (s *AlertService) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
-	resp, err := s.cs.newRequest("listAlerts", p.toURLValues())
+	return s.ListAlertsWithContext(context.Background(), p)
+}
+
+// ListAlertsWithContext is like ListAlerts, but honours the cancellation and deadline of ctx
+func (s *AlertService) ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAlerts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAlertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAlerts", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAlertsAll returns all Alerts matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AlertService) ListAlertsAll(p *ListAlertsParams, opts ...PageOption) ([]*Alert, error) {
+	return s.ListAlertsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAlertsAllWithContext is like ListAlertsAll, but honours the cancellation and deadline of ctx
+func (s *AlertService) ListAlertsAllWithContext(ctx context.Context, p *ListAlertsParams, opts ...PageOption) ([]*Alert, error) {
+	return collectPages(s.ListAlertsIterWithContext(ctx, p, opts...))
+}
+
+// ListAlertsIter returns an iterator over all Alerts matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AlertService) ListAlertsIter(p *ListAlertsParams, opts ...PageOption) iter.Seq2[*Alert, error] {
+	return s.ListAlertsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAlertsIterWithContext is like ListAlertsIter, but honours the cancellation and deadline of ctx
+func (s *AlertService) ListAlertsIterWithContext(ctx context.Context, p *ListAlertsParams, opts ...PageOption) iter.Seq2[*Alert, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Alert, int, error) {
+		l, err := s.ListAlertsWithContext(ctx, &ListAlertsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Alerts, l.Count, nil
+	}, opts...)
+}
+
 type ListAlertsResponse struct {
 	Count  int      `json:"count"`
 	Alerts []*Alert `json:"alert"`
 }
 
 type Alert struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Sent        string `json:"sent"`
-	Type        int    `json:"type"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Sent        string                     `json:"sent"`
+	Type        FlexInt                    `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Alert) UnmarshalJSON(b []byte) error {
+	type alias Alert
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAlertTypesParams struct {
@@ -847,6 +1135,39 @@ func (p *ListAlertTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAlertTypes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAlertTypesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAlertTypes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAlertTypes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAlertTypesParams) Validate() error {
+	return paramRules{}.validate("listAlertTypes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAlertTypes API, before the request is signed
+func (p *ListAlertTypesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAlertTypesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAlertTypesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAlertTypes", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAlertTypesParams) Clone() *ListAlertTypesParams {
+	return &ListAlertTypesParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new ListAlertTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *AlertService) NewListAlertTypesParams() *ListAlertTypesParams {
@@ -857,13 +1178,22 @@ func (s *AlertService) NewListAlertTypesParams() *ListAlertTypesParams {
 
 // Lists all alerts types
 func (s *AlertService) ListAlertTypes(p *ListAlertTypesParams) (*ListAlertTypesResponse, error) {
-	resp, err := s.cs.newRequest("listAlertTypes", p.toURLValues())
+	return s.ListAlertTypesWithContext(context.Background(), p)
+}
+
+// ListAlertTypesWithContext is like ListAlertTypes, but honours the cancellation and deadline of ctx
+func (s *AlertService) ListAlertTypesWithContext(ctx context.Context, p *ListAlertTypesParams) (*ListAlertTypesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAlertTypes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAlertTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAlertTypes", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -876,11 +1206,17 @@ type ListAlertTypesResponse struct {
 }
 
 type AlertType struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Sent        string `json:"sent"`
-	Type        int    `json:"type"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Sent        string                     `json:"sent"`
+	Type        FlexInt                    `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AlertType) UnmarshalJSON(b []byte) error {
+	type alias AlertType
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockAlertServiceIfaceMockRecorder) ArchiveAlerts(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).ArchiveAlerts), p)
 }
 
+// ArchiveAlertsWithContext mocks base method.
+func (m *MockAlertServiceIface) ArchiveAlertsWithContext(ctx context.Context, p *ArchiveAlertsParams) (*ArchiveAlertsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveAlertsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ArchiveAlertsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveAlertsWithContext indicates an expected call of ArchiveAlertsWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) ArchiveAlertsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveAlertsWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).ArchiveAlertsWithContext), ctx, p)
+}
+
 // DeleteAlerts mocks base method.
 func (m *MockAlertServiceIface) DeleteAlerts(p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockAlertServiceIfaceMockRecorder) DeleteAlerts(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).DeleteAlerts), p)
 }
 
+// DeleteAlertsWithContext mocks base method.
+func (m *MockAlertServiceIface) DeleteAlertsWithContext(ctx context.Context, p *DeleteAlertsParams) (*DeleteAlertsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAlertsWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteAlertsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAlertsWithContext indicates an expected call of DeleteAlertsWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) DeleteAlertsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAlertsWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).DeleteAlertsWithContext), ctx, p)
+}
+
 // GenerateAlert mocks base method.
 func (m *MockAlertServiceIface) GenerateAlert(p *GenerateAlertParams) (*GenerateAlertResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,36 @@ func (mr *MockAlertServiceIfaceMockRecorder) GenerateAlert(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAlert", reflect.TypeOf((*MockAlertServiceIface)(nil).GenerateAlert), p)
 }
 
+// GenerateAlertAsync mocks base method.
+func (m *MockAlertServiceIface) GenerateAlertAsync(ctx context.Context, p *GenerateAlertParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAlertAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateAlertAsync indicates an expected call of GenerateAlertAsync.
+func (mr *MockAlertServiceIfaceMockRecorder) GenerateAlertAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAlertAsync", reflect.TypeOf((*MockAlertServiceIface)(nil).GenerateAlertAsync), ctx, p)
+}
+
+// GenerateAlertWithContext mocks base method.
+func (m *MockAlertServiceIface) GenerateAlertWithContext(ctx context.Context, p *GenerateAlertParams) (*GenerateAlertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateAlertWithContext", ctx, p)
+	ret0, _ := ret[0].(*GenerateAlertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateAlertWithContext indicates an expected call of GenerateAlertWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) GenerateAlertWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateAlertWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).GenerateAlertWithContext), ctx, p)
+}
+
 // GetAlertByID mocks base method.
 func (m *MockAlertServiceIface) GetAlertByID(id string, opts ...OptionFunc) (*Alert, int, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +186,27 @@ func (mr *MockAlertServiceIfaceMockRecorder) GetAlertByID(id any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertByID", reflect.TypeOf((*MockAlertServiceIface)(nil).GetAlertByID), varargs...)
 }
 
+// GetAlertByIDWithContext mocks base method.
+func (m *MockAlertServiceIface) GetAlertByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Alert, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAlertByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Alert)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAlertByIDWithContext indicates an expected call of GetAlertByIDWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) GetAlertByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertByIDWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).GetAlertByIDWithContext), varargs...)
+}
+
 // GetAlertByName mocks base method.
 func (m *MockAlertServiceIface) GetAlertByName(name string, opts ...OptionFunc) (*Alert, int, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +228,27 @@ func (mr *MockAlertServiceIfaceMockRecorder) GetAlertByName(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertByName", reflect.TypeOf((*MockAlertServiceIface)(nil).GetAlertByName), varargs...)
 }
 
+// GetAlertByNameWithContext mocks base method.
+func (m *MockAlertServiceIface) GetAlertByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Alert, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAlertByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Alert)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAlertByNameWithContext indicates an expected call of GetAlertByNameWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) GetAlertByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertByNameWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).GetAlertByNameWithContext), varargs...)
+}
+
 // GetAlertID mocks base method.
 func (m *MockAlertServiceIface) GetAlertID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +270,27 @@ func (mr *MockAlertServiceIfaceMockRecorder) GetAlertID(name any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertID", reflect.TypeOf((*MockAlertServiceIface)(nil).GetAlertID), varargs...)
 }
 
+// GetAlertIDWithContext mocks base method.
+func (m *MockAlertServiceIface) GetAlertIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAlertIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAlertIDWithContext indicates an expected call of GetAlertIDWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) GetAlertIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAlertIDWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).GetAlertIDWithContext), varargs...)
+}
+
 // ListAlertTypes mocks base method.
 func (m *MockAlertServiceIface) ListAlertTypes(p *ListAlertTypesParams) (*ListAlertTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +306,21 @@ func (mr *MockAlertServiceIfaceMockRecorder) ListAlertTypes(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertTypes", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlertTypes), p)
 }
 
+// ListAlertTypesWithContext mocks base method.
+func (m *MockAlertServiceIface) ListAlertTypesWithContext(ctx context.Context, p *ListAlertTypesParams) (*ListAlertTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertTypesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAlertTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertTypesWithContext indicates an expected call of ListAlertTypesWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) ListAlertTypesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertTypesWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlertTypesWithContext), ctx, p)
+}
+
 // ListAlerts mocks base method.
 func (m *MockAlertServiceIface) ListAlerts(p *ListAlertsParams) (*ListAlertsResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +336,99 @@ func (mr *MockAlertServiceIfaceMockRecorder) ListAlerts(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlerts", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlerts), p)
 }
 
+// ListAlertsAll mocks base method.
+func (m *MockAlertServiceIface) ListAlertsAll(p *ListAlertsParams, opts ...PageOption) ([]*Alert, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAlertsAll", varargs...)
+	ret0, _ := ret[0].([]*Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertsAll indicates an expected call of ListAlertsAll.
+func (mr *MockAlertServiceIfaceMockRecorder) ListAlertsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertsAll", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlertsAll), varargs...)
+}
+
+// ListAlertsAllWithContext mocks base method.
+func (m *MockAlertServiceIface) ListAlertsAllWithContext(ctx context.Context, p *ListAlertsParams, opts ...PageOption) ([]*Alert, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAlertsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Alert)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertsAllWithContext indicates an expected call of ListAlertsAllWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) ListAlertsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertsAllWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlertsAllWithContext), varargs...)
+}
+
+// ListAlertsIter mocks base method.
+func (m *MockAlertServiceIface) ListAlertsIter(p *ListAlertsParams, opts ...PageOption) iter.Seq2[*Alert, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAlertsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Alert, error])
+	return ret0
+}
+
+// ListAlertsIter indicates an expected call of ListAlertsIter.
+func (mr *MockAlertServiceIfaceMockRecorder) ListAlertsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertsIter", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlertsIter), varargs...)
+}
+
+// ListAlertsIterWithContext mocks base method.
+func (m *MockAlertServiceIface) ListAlertsIterWithContext(ctx context.Context, p *ListAlertsParams, opts ...PageOption) iter.Seq2[*Alert, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAlertsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Alert, error])
+	return ret0
+}
+
+// ListAlertsIterWithContext indicates an expected call of ListAlertsIterWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) ListAlertsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertsIterWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlertsIterWithContext), varargs...)
+}
+
+// ListAlertsWithContext mocks base method.
+func (m *MockAlertServiceIface) ListAlertsWithContext(ctx context.Context, p *ListAlertsParams) (*ListAlertsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAlertsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAlertsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAlertsWithContext indicates an expected call of ListAlertsWithContext.
+func (mr *MockAlertServiceIfaceMockRecorder) ListAlertsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAlertsWithContext", reflect.TypeOf((*MockAlertServiceIface)(nil).ListAlertsWithContext), ctx, p)
+}
+
 // NewArchiveAlertsParams mocks base method.
 func (m *MockAlertServiceIface) NewArchiveAlertsParams() *ArchiveAlertsParams {
 	m.ctrl.T.Helper()
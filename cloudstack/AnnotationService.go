@@ -20,22 +20,32 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type AnnotationServiceIface interface {
 	AddAnnotation(p *AddAnnotationParams) (*AddAnnotationResponse, error)
+	AddAnnotationWithContext(ctx context.Context, p *AddAnnotationParams) (*AddAnnotationResponse, error)
 	NewAddAnnotationParams() *AddAnnotationParams
 	ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error)
+	ListAnnotationsWithContext(ctx context.Context, p *ListAnnotationsParams) (*ListAnnotationsResponse, error)
+	ListAnnotationsAll(p *ListAnnotationsParams, opts ...PageOption) ([]*Annotation, error)
+	ListAnnotationsAllWithContext(ctx context.Context, p *ListAnnotationsParams, opts ...PageOption) ([]*Annotation, error)
+	ListAnnotationsIter(p *ListAnnotationsParams, opts ...PageOption) iter.Seq2[*Annotation, error]
+	ListAnnotationsIterWithContext(ctx context.Context, p *ListAnnotationsParams, opts ...PageOption) iter.Seq2[*Annotation, error]
 	NewListAnnotationsParams() *ListAnnotationsParams
 	GetAnnotationByID(id string, opts ...OptionFunc) (*Annotation, int, error)
+	GetAnnotationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Annotation, int, error)
 	RemoveAnnotation(p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error)
+	RemoveAnnotationWithContext(ctx context.Context, p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error)
 	NewRemoveAnnotationParams(id string) *RemoveAnnotationParams
 	UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error)
+	UpdateAnnotationVisibilityWithContext(ctx context.Context, p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error)
 	NewUpdateAnnotationVisibilityParams(adminsonly bool, id string) *UpdateAnnotationVisibilityParams
 }
 
@@ -64,6 +74,46 @@ func (p *AddAnnotationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addAnnotation API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddAnnotationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addAnnotation", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addAnnotation API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddAnnotationParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"annotation": 4096, "entityid": 255, "entitytype": 255},
+	}.validate("addAnnotation", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addAnnotation API, before the request is signed
+func (p *AddAnnotationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddAnnotationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddAnnotationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addAnnotation", b, &p.p, map[string]paramDecoder{
+		"adminsonly": decodeParam[bool],
+		"annotation": decodeParam[string],
+		"entityid":   decodeParam[string],
+		"entitytype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddAnnotationParams) Clone() *AddAnnotationParams {
+	return &AddAnnotationParams{p: cloneParams(p.p)}
+}
+
 func (p *AddAnnotationParams) SetAdminsonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,7 +208,16 @@ func (s *AnnotationService) NewAddAnnotationParams() *AddAnnotationParams {
 
 // Add an annotation.
 func (s *AnnotationService) AddAnnotation(p *AddAnnotationParams) (*AddAnnotationResponse, error) {
-	resp, err := s.cs.newPostRequest("addAnnotation", p.toURLValues())
+	return s.AddAnnotationWithContext(context.Background(), p)
+}
+
+// AddAnnotationWithContext is like AddAnnotation, but honours the cancellation and deadline of ctx
+func (s *AnnotationService) AddAnnotationWithContext(ctx context.Context, p *AddAnnotationParams) (*AddAnnotationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addAnnotation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -168,7 +227,7 @@ func (s *AnnotationService) AddAnnotation(p *AddAnnotationParams) (*AddAnnotatio
 	}
 
 	var r AddAnnotationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addAnnotation", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -176,18 +235,24 @@ func (s *AnnotationService) AddAnnotation(p *AddAnnotationParams) (*AddAnnotatio
 }
 
 type AddAnnotationResponse struct {
-	Adminsonly bool   `json:"adminsonly"`
-	Annotation string `json:"annotation"`
-	Created    string `json:"created"`
-	Entityid   string `json:"entityid"`
-	Entityname string `json:"entityname"`
-	Entitytype string `json:"entitytype"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Removed    string `json:"removed"`
-	Userid     string `json:"userid"`
-	Username   string `json:"username"`
+	Adminsonly FlexBool                   `json:"adminsonly"`
+	Annotation string                     `json:"annotation"`
+	Created    Time                       `json:"created"`
+	Entityid   string                     `json:"entityid"`
+	Entityname string                     `json:"entityname"`
+	Entitytype string                     `json:"entitytype"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Removed    Time                       `json:"removed"`
+	Userid     string                     `json:"userid"`
+	Username   string                     `json:"username"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddAnnotationResponse) UnmarshalJSON(b []byte) error {
+	type alias AddAnnotationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAnnotationsParams struct {
@@ -228,6 +293,50 @@ func (p *ListAnnotationsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAnnotations API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAnnotationsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAnnotations", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAnnotations API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAnnotationsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"annotationfilter": 255, "entityid": 255, "entitytype": 255, "id": 255, "keyword": 255, "userid": 255},
+	}.validate("listAnnotations", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAnnotations API, before the request is signed
+func (p *ListAnnotationsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAnnotationsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAnnotationsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAnnotations", b, &p.p, map[string]paramDecoder{
+		"annotationfilter": decodeParam[string],
+		"entityid":         decodeParam[string],
+		"entitytype":       decodeParam[string],
+		"id":               decodeParam[string],
+		"keyword":          decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"userid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAnnotationsParams) Clone() *ListAnnotationsParams {
+	return &ListAnnotationsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAnnotationsParams) SetAnnotationfilter(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -406,6 +515,11 @@ func (s *AnnotationService) NewListAnnotationsParams() *ListAnnotationsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AnnotationService) GetAnnotationByID(id string, opts ...OptionFunc) (*Annotation, int, error) {
+	return s.GetAnnotationByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAnnotationByIDWithContext is like GetAnnotationByID, but honours the cancellation and deadline of ctx
+func (s *AnnotationService) GetAnnotationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Annotation, int, error) {
 	p := &ListAnnotationsParams{}
 	p.p = make(map[string]interface{})
 
@@ -417,11 +531,10 @@ func (s *AnnotationService) GetAnnotationByID(id string, opts ...OptionFunc) (*A
 		}
 	}
 
-	l, err := s.ListAnnotations(p)
+	l, err := s.ListAnnotationsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -439,37 +552,80 @@ func (s *AnnotationService) GetAnnotationByID(id string, opts ...OptionFunc) (*A
 
 // Lists annotations.
 func (s *AnnotationService) ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
-	resp, err := s.cs.newRequest("listAnnotations", p.toURLValues())
+	return s.ListAnnotationsWithContext(context.Background(), p)
+}
+
+// ListAnnotationsWithContext is like ListAnnotations, but honours the cancellation and deadline of ctx
+func (s *AnnotationService) ListAnnotationsWithContext(ctx context.Context, p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAnnotations", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAnnotationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAnnotations", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAnnotationsAll returns all Annotations matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AnnotationService) ListAnnotationsAll(p *ListAnnotationsParams, opts ...PageOption) ([]*Annotation, error) {
+	return s.ListAnnotationsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAnnotationsAllWithContext is like ListAnnotationsAll, but honours the cancellation and deadline of ctx
+func (s *AnnotationService) ListAnnotationsAllWithContext(ctx context.Context, p *ListAnnotationsParams, opts ...PageOption) ([]*Annotation, error) {
+	return collectPages(s.ListAnnotationsIterWithContext(ctx, p, opts...))
+}
+
+// ListAnnotationsIter returns an iterator over all Annotations matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AnnotationService) ListAnnotationsIter(p *ListAnnotationsParams, opts ...PageOption) iter.Seq2[*Annotation, error] {
+	return s.ListAnnotationsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAnnotationsIterWithContext is like ListAnnotationsIter, but honours the cancellation and deadline of ctx
+func (s *AnnotationService) ListAnnotationsIterWithContext(ctx context.Context, p *ListAnnotationsParams, opts ...PageOption) iter.Seq2[*Annotation, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Annotation, int, error) {
+		l, err := s.ListAnnotationsWithContext(ctx, &ListAnnotationsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Annotations, l.Count, nil
+	}, opts...)
+}
+
 type ListAnnotationsResponse struct {
 	Count       int           `json:"count"`
 	Annotations []*Annotation `json:"annotation"`
 }
 
 type Annotation struct {
-	Adminsonly bool   `json:"adminsonly"`
-	Annotation string `json:"annotation"`
-	Created    string `json:"created"`
-	Entityid   string `json:"entityid"`
-	Entityname string `json:"entityname"`
-	Entitytype string `json:"entitytype"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Removed    string `json:"removed"`
-	Userid     string `json:"userid"`
-	Username   string `json:"username"`
+	Adminsonly FlexBool                   `json:"adminsonly"`
+	Annotation string                     `json:"annotation"`
+	Created    Time                       `json:"created"`
+	Entityid   string                     `json:"entityid"`
+	Entityname string                     `json:"entityname"`
+	Entitytype string                     `json:"entitytype"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Removed    Time                       `json:"removed"`
+	Userid     string                     `json:"userid"`
+	Username   string                     `json:"username"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Annotation) UnmarshalJSON(b []byte) error {
+	type alias Annotation
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveAnnotationParams struct {
@@ -487,6 +643,44 @@ func (p *RemoveAnnotationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeAnnotation API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveAnnotationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeAnnotation", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeAnnotation API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveAnnotationParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeAnnotation", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeAnnotation API, before the request is signed
+func (p *RemoveAnnotationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveAnnotationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveAnnotationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeAnnotation", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveAnnotationParams) Clone() *RemoveAnnotationParams {
+	return &RemoveAnnotationParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveAnnotationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -519,7 +713,16 @@ func (s *AnnotationService) NewRemoveAnnotationParams(id string) *RemoveAnnotati
 
 // Remove an annotation.
 func (s *AnnotationService) RemoveAnnotation(p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error) {
-	resp, err := s.cs.newPostRequest("removeAnnotation", p.toURLValues())
+	return s.RemoveAnnotationWithContext(context.Background(), p)
+}
+
+// RemoveAnnotationWithContext is like RemoveAnnotation, but honours the cancellation and deadline of ctx
+func (s *AnnotationService) RemoveAnnotationWithContext(ctx context.Context, p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeAnnotation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -529,7 +732,7 @@ func (s *AnnotationService) RemoveAnnotation(p *RemoveAnnotationParams) (*Remove
 	}
 
 	var r RemoveAnnotationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeAnnotation", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -537,18 +740,24 @@ func (s *AnnotationService) RemoveAnnotation(p *RemoveAnnotationParams) (*Remove
 }
 
 type RemoveAnnotationResponse struct {
-	Adminsonly bool   `json:"adminsonly"`
-	Annotation string `json:"annotation"`
-	Created    string `json:"created"`
-	Entityid   string `json:"entityid"`
-	Entityname string `json:"entityname"`
-	Entitytype string `json:"entitytype"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Removed    string `json:"removed"`
-	Userid     string `json:"userid"`
-	Username   string `json:"username"`
+	Adminsonly FlexBool                   `json:"adminsonly"`
+	Annotation string                     `json:"annotation"`
+	Created    Time                       `json:"created"`
+	Entityid   string                     `json:"entityid"`
+	Entityname string                     `json:"entityname"`
+	Entitytype string                     `json:"entitytype"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Removed    Time                       `json:"removed"`
+	Userid     string                     `json:"userid"`
+	Username   string                     `json:"username"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveAnnotationResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveAnnotationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateAnnotationVisibilityParams struct {
@@ -570,6 +779,45 @@ func (p *UpdateAnnotationVisibilityParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateAnnotationVisibility API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateAnnotationVisibilityParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateAnnotationVisibility", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateAnnotationVisibility API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateAnnotationVisibilityParams) Validate() error {
+	return paramRules{
+		required: []string{"adminsonly", "id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("updateAnnotationVisibility", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateAnnotationVisibility API, before the request is signed
+func (p *UpdateAnnotationVisibilityParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateAnnotationVisibilityParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateAnnotationVisibilityParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateAnnotationVisibility", b, &p.p, map[string]paramDecoder{
+		"adminsonly": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateAnnotationVisibilityParams) Clone() *UpdateAnnotationVisibilityParams {
+	return &UpdateAnnotationVisibilityParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateAnnotationVisibilityParams) SetAdminsonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -624,13 +872,22 @@ func (s *AnnotationService) NewUpdateAnnotationVisibilityParams(adminsonly bool,
 
 // Update an annotation visibility.
 func (s *AnnotationService) UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error) {
-	resp, err := s.cs.newPostRequest("updateAnnotationVisibility", p.toURLValues())
+	return s.UpdateAnnotationVisibilityWithContext(context.Background(), p)
+}
+
+// UpdateAnnotationVisibilityWithContext is like UpdateAnnotationVisibility, but honours the cancellation and deadline of ctx
+func (s *AnnotationService) UpdateAnnotationVisibilityWithContext(ctx context.Context, p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateAnnotationVisibility", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateAnnotationVisibilityResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateAnnotationVisibility", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -638,16 +895,22 @@ func (s *AnnotationService) UpdateAnnotationVisibility(p *UpdateAnnotationVisibi
 }
 
 type UpdateAnnotationVisibilityResponse struct {
-	Adminsonly bool   `json:"adminsonly"`
-	Annotation string `json:"annotation"`
-	Created    string `json:"created"`
-	Entityid   string `json:"entityid"`
-	Entityname string `json:"entityname"`
-	Entitytype string `json:"entitytype"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Removed    string `json:"removed"`
-	Userid     string `json:"userid"`
-	Username   string `json:"username"`
+	Adminsonly FlexBool                   `json:"adminsonly"`
+	Annotation string                     `json:"annotation"`
+	Created    Time                       `json:"created"`
+	Entityid   string                     `json:"entityid"`
+	Entityname string                     `json:"entityname"`
+	Entitytype string                     `json:"entitytype"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Removed    Time                       `json:"removed"`
+	Userid     string                     `json:"userid"`
+	Username   string                     `json:"username"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateAnnotationVisibilityResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateAnnotationVisibilityResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockAnnotationServiceIfaceMockRecorder) AddAnnotation(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnnotation", reflect.TypeOf((*MockAnnotationServiceIface)(nil).AddAnnotation), p)
 }
 
+// AddAnnotationWithContext mocks base method.
+func (m *MockAnnotationServiceIface) AddAnnotationWithContext(ctx context.Context, p *AddAnnotationParams) (*AddAnnotationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAnnotationWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddAnnotationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAnnotationWithContext indicates an expected call of AddAnnotationWithContext.
+func (mr *MockAnnotationServiceIfaceMockRecorder) AddAnnotationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAnnotationWithContext", reflect.TypeOf((*MockAnnotationServiceIface)(nil).AddAnnotationWithContext), ctx, p)
+}
+
 // GetAnnotationByID mocks base method.
 func (m *MockAnnotationServiceIface) GetAnnotationByID(id string, opts ...OptionFunc) (*Annotation, int, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +111,27 @@ func (mr *MockAnnotationServiceIfaceMockRecorder) GetAnnotationByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotationByID", reflect.TypeOf((*MockAnnotationServiceIface)(nil).GetAnnotationByID), varargs...)
 }
 
+// GetAnnotationByIDWithContext mocks base method.
+func (m *MockAnnotationServiceIface) GetAnnotationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Annotation, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAnnotationByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Annotation)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAnnotationByIDWithContext indicates an expected call of GetAnnotationByIDWithContext.
+func (mr *MockAnnotationServiceIfaceMockRecorder) GetAnnotationByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAnnotationByIDWithContext", reflect.TypeOf((*MockAnnotationServiceIface)(nil).GetAnnotationByIDWithContext), varargs...)
+}
+
 // ListAnnotations mocks base method.
 func (m *MockAnnotationServiceIface) ListAnnotations(p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +147,99 @@ func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotations(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotations", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotations), p)
 }
 
+// ListAnnotationsAll mocks base method.
+func (m *MockAnnotationServiceIface) ListAnnotationsAll(p *ListAnnotationsParams, opts ...PageOption) ([]*Annotation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAnnotationsAll", varargs...)
+	ret0, _ := ret[0].([]*Annotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAnnotationsAll indicates an expected call of ListAnnotationsAll.
+func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotationsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotationsAll", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotationsAll), varargs...)
+}
+
+// ListAnnotationsAllWithContext mocks base method.
+func (m *MockAnnotationServiceIface) ListAnnotationsAllWithContext(ctx context.Context, p *ListAnnotationsParams, opts ...PageOption) ([]*Annotation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAnnotationsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Annotation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAnnotationsAllWithContext indicates an expected call of ListAnnotationsAllWithContext.
+func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotationsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotationsAllWithContext", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotationsAllWithContext), varargs...)
+}
+
+// ListAnnotationsIter mocks base method.
+func (m *MockAnnotationServiceIface) ListAnnotationsIter(p *ListAnnotationsParams, opts ...PageOption) iter.Seq2[*Annotation, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAnnotationsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Annotation, error])
+	return ret0
+}
+
+// ListAnnotationsIter indicates an expected call of ListAnnotationsIter.
+func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotationsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotationsIter", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotationsIter), varargs...)
+}
+
+// ListAnnotationsIterWithContext mocks base method.
+func (m *MockAnnotationServiceIface) ListAnnotationsIterWithContext(ctx context.Context, p *ListAnnotationsParams, opts ...PageOption) iter.Seq2[*Annotation, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAnnotationsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Annotation, error])
+	return ret0
+}
+
+// ListAnnotationsIterWithContext indicates an expected call of ListAnnotationsIterWithContext.
+func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotationsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotationsIterWithContext", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotationsIterWithContext), varargs...)
+}
+
+// ListAnnotationsWithContext mocks base method.
+func (m *MockAnnotationServiceIface) ListAnnotationsWithContext(ctx context.Context, p *ListAnnotationsParams) (*ListAnnotationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAnnotationsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAnnotationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAnnotationsWithContext indicates an expected call of ListAnnotationsWithContext.
+func (mr *MockAnnotationServiceIfaceMockRecorder) ListAnnotationsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAnnotationsWithContext", reflect.TypeOf((*MockAnnotationServiceIface)(nil).ListAnnotationsWithContext), ctx, p)
+}
+
 // NewAddAnnotationParams mocks base method.
 func (m *MockAnnotationServiceIface) NewAddAnnotationParams() *AddAnnotationParams {
 	m.ctrl.T.Helper()
@@ -180,6 +311,21 @@ func (mr *MockAnnotationServiceIfaceMockRecorder) RemoveAnnotation(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAnnotation", reflect.TypeOf((*MockAnnotationServiceIface)(nil).RemoveAnnotation), p)
 }
 
+// RemoveAnnotationWithContext mocks base method.
+func (m *MockAnnotationServiceIface) RemoveAnnotationWithContext(ctx context.Context, p *RemoveAnnotationParams) (*RemoveAnnotationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveAnnotationWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveAnnotationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveAnnotationWithContext indicates an expected call of RemoveAnnotationWithContext.
+func (mr *MockAnnotationServiceIfaceMockRecorder) RemoveAnnotationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveAnnotationWithContext", reflect.TypeOf((*MockAnnotationServiceIface)(nil).RemoveAnnotationWithContext), ctx, p)
+}
+
 // UpdateAnnotationVisibility mocks base method.
 func (m *MockAnnotationServiceIface) UpdateAnnotationVisibility(p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error) {
 	m.ctrl.T.Helper()
@@ -194,3 +340,18 @@ func (mr *MockAnnotationServiceIfaceMockRecorder) UpdateAnnotationVisibility(p a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationVisibility", reflect.TypeOf((*MockAnnotationServiceIface)(nil).UpdateAnnotationVisibility), p)
 }
+
+// UpdateAnnotationVisibilityWithContext mocks base method.
+func (m *MockAnnotationServiceIface) UpdateAnnotationVisibilityWithContext(ctx context.Context, p *UpdateAnnotationVisibilityParams) (*UpdateAnnotationVisibilityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAnnotationVisibilityWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateAnnotationVisibilityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAnnotationVisibilityWithContext indicates an expected call of UpdateAnnotationVisibilityWithContext.
+func (mr *MockAnnotationServiceIfaceMockRecorder) UpdateAnnotationVisibilityWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAnnotationVisibilityWithContext", reflect.TypeOf((*MockAnnotationServiceIface)(nil).UpdateAnnotationVisibilityWithContext), ctx, p)
+}
@@ -20,7 +20,9 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 	"time"
@@ -28,8 +30,14 @@ import (
 
 type AsyncjobServiceIface interface {
 	ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error)
+	ListAsyncJobsAll(p *ListAsyncJobsParams, opts ...PageOption) ([]*AsyncJob, error)
+	ListAsyncJobsAllWithContext(ctx context.Context, p *ListAsyncJobsParams, opts ...PageOption) ([]*AsyncJob, error)
+	ListAsyncJobsIter(p *ListAsyncJobsParams, opts ...PageOption) iter.Seq2[*AsyncJob, error]
+	ListAsyncJobsIterWithContext(ctx context.Context, p *ListAsyncJobsParams, opts ...PageOption) iter.Seq2[*AsyncJob, error]
 	NewListAsyncJobsParams() *ListAsyncJobsParams
 	QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
+	QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error)
 	NewQueryAsyncJobResultParams(jobid string) *QueryAsyncJobResultParams
 }
 
@@ -82,6 +90,53 @@ func (p *ListAsyncJobsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAsyncJobs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAsyncJobsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAsyncJobs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAsyncJobs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAsyncJobsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "keyword": 255, "managementserverid": 255, "resourceid": 255, "resourcetype": 255, "startdate": 255},
+	}.validate("listAsyncJobs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAsyncJobs API, before the request is signed
+func (p *ListAsyncJobsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAsyncJobsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAsyncJobsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAsyncJobs", b, &p.p, map[string]paramDecoder{
+		"account":            decodeParam[string],
+		"domainid":           decodeParam[string],
+		"isrecursive":        decodeParam[bool],
+		"keyword":            decodeParam[string],
+		"listall":            decodeParam[bool],
+		"managementserverid": decodeParam[UUID],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"resourceid":         decodeParam[string],
+		"resourcetype":       decodeParam[string],
+		"startdate":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAsyncJobsParams) Clone() *ListAsyncJobsParams {
+	return &ListAsyncJobsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAsyncJobsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -323,43 +378,86 @@ func (s *AsyncjobService) NewListAsyncJobsParams() *ListAsyncJobsParams {
 
 // Lists all pending asynchronous jobs for the Account.
 func (s *AsyncjobService) ListAsyncJobs(p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
-	resp, err := s.cs.newRequest("listAsyncJobs", p.toURLValues())
+	return s.ListAsyncJobsWithContext(context.Background(), p)
+}
+
+// ListAsyncJobsWithContext is like ListAsyncJobs, but honours the cancellation and deadline of ctx
+func (s *AsyncjobService) ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAsyncJobs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAsyncJobsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAsyncJobs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAsyncJobsAll returns all AsyncJobs matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AsyncjobService) ListAsyncJobsAll(p *ListAsyncJobsParams, opts ...PageOption) ([]*AsyncJob, error) {
+	return s.ListAsyncJobsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAsyncJobsAllWithContext is like ListAsyncJobsAll, but honours the cancellation and deadline of ctx
+func (s *AsyncjobService) ListAsyncJobsAllWithContext(ctx context.Context, p *ListAsyncJobsParams, opts ...PageOption) ([]*AsyncJob, error) {
+	return collectPages(s.ListAsyncJobsIterWithContext(ctx, p, opts...))
+}
+
+// ListAsyncJobsIter returns an iterator over all AsyncJobs matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AsyncjobService) ListAsyncJobsIter(p *ListAsyncJobsParams, opts ...PageOption) iter.Seq2[*AsyncJob, error] {
+	return s.ListAsyncJobsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAsyncJobsIterWithContext is like ListAsyncJobsIter, but honours the cancellation and deadline of ctx
+func (s *AsyncjobService) ListAsyncJobsIterWithContext(ctx context.Context, p *ListAsyncJobsParams, opts ...PageOption) iter.Seq2[*AsyncJob, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*AsyncJob, int, error) {
+		l, err := s.ListAsyncJobsWithContext(ctx, &ListAsyncJobsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.AsyncJobs, l.Count, nil
+	}, opts...)
+}
+
 type ListAsyncJobsResponse struct {
 	Count     int         `json:"count"`
 	AsyncJobs []*AsyncJob `json:"asyncjobs"`
 }
 
 type AsyncJob struct {
-	Account              string          `json:"account"`
-	Accountid            string          `json:"accountid"`
-	Cmd                  string          `json:"cmd"`
-	Completed            string          `json:"completed"`
-	Created              string          `json:"created"`
-	Domainid             string          `json:"domainid"`
-	Domainpath           string          `json:"domainpath"`
-	JobID                string          `json:"jobid"`
-	Jobinstanceid        string          `json:"jobinstanceid"`
-	Jobinstancetype      string          `json:"jobinstancetype"`
-	Jobprocstatus        int             `json:"jobprocstatus"`
-	Jobresult            json.RawMessage `json:"jobresult"`
-	Jobresultcode        int             `json:"jobresultcode"`
-	Jobresulttype        string          `json:"jobresulttype"`
-	Jobstatus            int             `json:"jobstatus"`
-	Managementserverid   UUID            `json:"managementserverid"`
-	Managementservername string          `json:"managementservername"`
-	Userid               string          `json:"userid"`
+	Account              string                     `json:"account"`
+	Accountid            string                     `json:"accountid"`
+	Cmd                  string                     `json:"cmd"`
+	Completed            string                     `json:"completed"`
+	Created              Time                       `json:"created"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	JobID                string                     `json:"jobid"`
+	Jobinstanceid        string                     `json:"jobinstanceid"`
+	Jobinstancetype      string                     `json:"jobinstancetype"`
+	Jobprocstatus        FlexInt                    `json:"jobprocstatus"`
+	Jobresult            json.RawMessage            `json:"jobresult"`
+	Jobresultcode        FlexInt                    `json:"jobresultcode"`
+	Jobresulttype        string                     `json:"jobresulttype"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Managementservername string                     `json:"managementservername"`
+	Userid               string                     `json:"userid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AsyncJob) UnmarshalJSON(b []byte) error {
+	type alias AsyncJob
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QueryAsyncJobResultParams struct {
@@ -383,6 +481,46 @@ func (p *QueryAsyncJobResultParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the queryAsyncJobResult API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QueryAsyncJobResultParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("queryAsyncJobResult", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the queryAsyncJobResult API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QueryAsyncJobResultParams) Validate() error {
+	return paramRules{
+		required: []string{"jobid"},
+		lengths:  map[string]int{"jobid": 255, "resourceid": 255, "resourcetype": 255},
+	}.validate("queryAsyncJobResult", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the queryAsyncJobResult API, before the request is signed
+func (p *QueryAsyncJobResultParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QueryAsyncJobResultParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QueryAsyncJobResultParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("queryAsyncJobResult", b, &p.p, map[string]paramDecoder{
+		"jobid":        decodeParam[string],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QueryAsyncJobResultParams) Clone() *QueryAsyncJobResultParams {
+	return &QueryAsyncJobResultParams{p: cloneParams(p.p)}
+}
+
 func (p *QueryAsyncJobResultParams) SetJobID(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -457,23 +595,42 @@ func (s *AsyncjobService) NewQueryAsyncJobResultParams(jobid string) *QueryAsync
 
 // Retrieves the current status of asynchronous job.
 func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	return s.QueryAsyncJobResultWithContext(context.Background(), p)
+}
+
+// QueryAsyncJobResultWithContext is like QueryAsyncJobResult, but honours the cancellation and deadline of ctx
+func (s *AsyncjobService) QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
 	var resp json.RawMessage
 	var err error
 
-	// We should be able to retry on failure as this call is idempotent
-	for i := 0; i < 3; i++ {
-		resp, err = s.cs.newRequest("queryAsyncJobResult", p.toURLValues())
-		if err == nil {
+	// We should be able to retry on failure as this call is idempotent, unless the retry policy
+	// of the client retries it already
+	attempts := 3
+	if s.cs.retry != nil {
+		attempts = 1
+	}
+	for i := 0; i < attempts; i++ {
+		resp, err = s.cs.newRequest(ctx, "queryAsyncJobResult", p.toURLValues())
+		if err == nil || i == attempts-1 {
 			break
 		}
-		time.Sleep(500 * time.Millisecond)
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(500 * time.Millisecond):
+		}
 	}
 	if err != nil {
 		return nil, err
 	}
 
 	var r QueryAsyncJobResultResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("queryAsyncJobResult", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -481,22 +638,28 @@ func (s *AsyncjobService) QueryAsyncJobResult(p *QueryAsyncJobResultParams) (*Qu
 }
 
 type QueryAsyncJobResultResponse struct {
-	Account              string          `json:"account"`
-	Accountid            string          `json:"accountid"`
-	Cmd                  string          `json:"cmd"`
-	Completed            string          `json:"completed"`
-	Created              string          `json:"created"`
-	Domainid             string          `json:"domainid"`
-	Domainpath           string          `json:"domainpath"`
-	JobID                string          `json:"jobid"`
-	Jobinstanceid        string          `json:"jobinstanceid"`
-	Jobinstancetype      string          `json:"jobinstancetype"`
-	Jobprocstatus        int             `json:"jobprocstatus"`
-	Jobresult            json.RawMessage `json:"jobresult"`
-	Jobresultcode        int             `json:"jobresultcode"`
-	Jobresulttype        string          `json:"jobresulttype"`
-	Jobstatus            int             `json:"jobstatus"`
-	Managementserverid   UUID            `json:"managementserverid"`
-	Managementservername string          `json:"managementservername"`
-	Userid               string          `json:"userid"`
+	Account              string                     `json:"account"`
+	Accountid            string                     `json:"accountid"`
+	Cmd                  string                     `json:"cmd"`
+	Completed            string                     `json:"completed"`
+	Created              Time                       `json:"created"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	JobID                string                     `json:"jobid"`
+	Jobinstanceid        string                     `json:"jobinstanceid"`
+	Jobinstancetype      string                     `json:"jobinstancetype"`
+	Jobprocstatus        FlexInt                    `json:"jobprocstatus"`
+	Jobresult            json.RawMessage            `json:"jobresult"`
+	Jobresultcode        FlexInt                    `json:"jobresultcode"`
+	Jobresulttype        string                     `json:"jobresulttype"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Managementservername string                     `json:"managementservername"`
+	Userid               string                     `json:"userid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QueryAsyncJobResultResponse) UnmarshalJSON(b []byte) error {
+	type alias QueryAsyncJobResultResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
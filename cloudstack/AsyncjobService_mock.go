@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,99 @@ func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobs(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobs", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobs), p)
 }
 
+// ListAsyncJobsAll mocks base method.
+func (m *MockAsyncjobServiceIface) ListAsyncJobsAll(p *ListAsyncJobsParams, opts ...PageOption) ([]*AsyncJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAsyncJobsAll", varargs...)
+	ret0, _ := ret[0].([]*AsyncJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAsyncJobsAll indicates an expected call of ListAsyncJobsAll.
+func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobsAll", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobsAll), varargs...)
+}
+
+// ListAsyncJobsAllWithContext mocks base method.
+func (m *MockAsyncjobServiceIface) ListAsyncJobsAllWithContext(ctx context.Context, p *ListAsyncJobsParams, opts ...PageOption) ([]*AsyncJob, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAsyncJobsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*AsyncJob)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAsyncJobsAllWithContext indicates an expected call of ListAsyncJobsAllWithContext.
+func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobsAllWithContext", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobsAllWithContext), varargs...)
+}
+
+// ListAsyncJobsIter mocks base method.
+func (m *MockAsyncjobServiceIface) ListAsyncJobsIter(p *ListAsyncJobsParams, opts ...PageOption) iter.Seq2[*AsyncJob, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAsyncJobsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AsyncJob, error])
+	return ret0
+}
+
+// ListAsyncJobsIter indicates an expected call of ListAsyncJobsIter.
+func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobsIter", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobsIter), varargs...)
+}
+
+// ListAsyncJobsIterWithContext mocks base method.
+func (m *MockAsyncjobServiceIface) ListAsyncJobsIterWithContext(ctx context.Context, p *ListAsyncJobsParams, opts ...PageOption) iter.Seq2[*AsyncJob, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAsyncJobsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AsyncJob, error])
+	return ret0
+}
+
+// ListAsyncJobsIterWithContext indicates an expected call of ListAsyncJobsIterWithContext.
+func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobsIterWithContext", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobsIterWithContext), varargs...)
+}
+
+// ListAsyncJobsWithContext mocks base method.
+func (m *MockAsyncjobServiceIface) ListAsyncJobsWithContext(ctx context.Context, p *ListAsyncJobsParams) (*ListAsyncJobsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAsyncJobsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAsyncJobsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAsyncJobsWithContext indicates an expected call of ListAsyncJobsWithContext.
+func (mr *MockAsyncjobServiceIfaceMockRecorder) ListAsyncJobsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAsyncJobsWithContext", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).ListAsyncJobsWithContext), ctx, p)
+}
+
 // NewListAsyncJobsParams mocks base method.
 func (m *MockAsyncjobServiceIface) NewListAsyncJobsParams() *ListAsyncJobsParams {
 	m.ctrl.T.Helper()
@@ -115,3 +210,18 @@ func (mr *MockAsyncjobServiceIfaceMockRecorder) QueryAsyncJobResult(p any) *gomo
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAsyncJobResult", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).QueryAsyncJobResult), p)
 }
+
+// QueryAsyncJobResultWithContext mocks base method.
+func (m *MockAsyncjobServiceIface) QueryAsyncJobResultWithContext(ctx context.Context, p *QueryAsyncJobResultParams) (*QueryAsyncJobResultResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QueryAsyncJobResultWithContext", ctx, p)
+	ret0, _ := ret[0].(*QueryAsyncJobResultResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QueryAsyncJobResultWithContext indicates an expected call of QueryAsyncJobResultWithContext.
+func (mr *MockAsyncjobServiceIfaceMockRecorder) QueryAsyncJobResultWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QueryAsyncJobResultWithContext", reflect.TypeOf((*MockAsyncjobServiceIface)(nil).QueryAsyncJobResultWithContext), ctx, p)
+}
@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -27,10 +28,13 @@ import (
 
 type AuthenticationServiceIface interface {
 	Login(p *LoginParams) (*LoginResponse, error)
+	LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error)
 	NewLoginParams(password string, username string) *LoginParams
 	Logout(p *LogoutParams) (*LogoutResponse, error)
+	LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error)
 	NewLogoutParams() *LogoutParams
 	Oauthlogin(p *OauthloginParams) (*OauthloginResponse, error)
+	OauthloginWithContext(ctx context.Context, p *OauthloginParams) (*OauthloginResponse, error)
 	NewOauthloginParams(email string, provider string) *OauthloginParams
 }
 
@@ -59,6 +63,47 @@ func (p *LoginParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the login API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LoginParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("login", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the login API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LoginParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "username"},
+		lengths:  map[string]int{"domain": 255, "password": 255, "username": 255},
+	}.validate("login", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the login API, before the request is signed
+func (p *LoginParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LoginParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LoginParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("login", b, &p.p, map[string]paramDecoder{
+		"domain":   decodeParam[string],
+		"domainId": decodeParam[int64],
+		"password": decodeParam[string],
+		"username": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LoginParams) Clone() *LoginParams {
+	return &LoginParams{p: cloneParams(p.p)}
+}
+
 func (p *LoginParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -155,13 +200,22 @@ func (s *AuthenticationService) NewLoginParams(password string, username string)
 
 // Logs a user into the CloudStack. A successful login attempt will generate a JSESSIONID cookie value that can be passed in subsequent Query command calls until the "logout" command has been issued or the session has expired.
 func (s *AuthenticationService) Login(p *LoginParams) (*LoginResponse, error) {
-	resp, err := s.cs.newPostRequest("login", p.toURLValues())
+	return s.LoginWithContext(context.Background(), p)
+}
+
+// LoginWithContext is like Login, but honours the cancellation and deadline of ctx
+func (s *AuthenticationService) LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "login", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LoginResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("login", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -169,25 +223,64 @@ func (s *AuthenticationService) Login(p *LoginParams) (*LoginResponse, error) {
 }
 
 type LoginResponse struct {
-	Account            string `json:"account"`
-	Domainid           string `json:"domainid"`
-	Firstname          string `json:"firstname"`
-	Is2faenabled       string `json:"is2faenabled"`
-	Is2faverified      string `json:"is2faverified"`
-	Issuerfor2fa       string `json:"issuerfor2fa"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Lastname           string `json:"lastname"`
-	Managementserverid UUID   `json:"managementserverid"`
-	Providerfor2fa     string `json:"providerfor2fa"`
-	Registered         string `json:"registered"`
-	Sessionkey         string `json:"sessionkey"`
-	Timeout            int    `json:"timeout"`
-	Timezone           string `json:"timezone"`
-	Timezoneoffset     string `json:"timezoneoffset"`
-	Type               string `json:"type"`
-	Userid             string `json:"userid"`
-	Username           string `json:"username"`
+	Account            string                     `json:"account"`
+	Domainid           string                     `json:"domainid"`
+	Firstname          string                     `json:"firstname"`
+	Is2faenabled       string                     `json:"is2faenabled"`
+	Is2faverified      string                     `json:"is2faverified"`
+	Issuerfor2fa       string                     `json:"issuerfor2fa"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Lastname           string                     `json:"lastname"`
+	Managementserverid UUID                       `json:"managementserverid"`
+	Providerfor2fa     string                     `json:"providerfor2fa"`
+	Registered         string                     `json:"registered"`
+	Sessionkey         string                     `json:"sessionkey"`
+	Timeout            FlexInt                    `json:"timeout"`
+	Timezone           string                     `json:"timezone"`
+	Timezoneoffset     string                     `json:"timezoneoffset"`
+	Type               string                     `json:"type"`
+	Userid             string                     `json:"userid"`
+	Username           string                     `json:"username"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LoginResponse) UnmarshalJSON(b []byte) error {
+	var m map[string]interface{}
+	err := json.Unmarshal(b, &m)
+	if err != nil {
+		return err
+	}
+
+	if success, ok := m["success"].(string); ok {
+		m["success"] = success == "true"
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if ostypeid, ok := m["ostypeid"].(float64); ok {
+		m["ostypeid"] = strconv.Itoa(int(ostypeid))
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	if timeout, ok := m["timeout"].(string); ok {
+		m["timeout"], err = strconv.ParseFloat(timeout, 64)
+		if err != nil {
+			return err
+		}
+		b, err = json.Marshal(m)
+		if err != nil {
+			return err
+		}
+	}
+
+	type alias LoginResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LogoutParams struct {
@@ -202,6 +295,39 @@ func (p *LogoutParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the logout API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LogoutParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("logout", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the logout API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LogoutParams) Validate() error {
+	return paramRules{}.validate("logout", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the logout API, before the request is signed
+func (p *LogoutParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LogoutParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LogoutParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("logout", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LogoutParams) Clone() *LogoutParams {
+	return &LogoutParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new LogoutParams instance,
 // as then you are sure you have configured all required params
 func (s *AuthenticationService) NewLogoutParams() *LogoutParams {
@@ -212,13 +338,22 @@ func (s *AuthenticationService) NewLogoutParams() *LogoutParams {
 
 // Logs out the user
 func (s *AuthenticationService) Logout(p *LogoutParams) (*LogoutResponse, error) {
-	resp, err := s.cs.newPostRequest("logout", p.toURLValues())
+	return s.LogoutWithContext(context.Background(), p)
+}
+
+// LogoutWithContext is like Logout, but honours the cancellation and deadline of ctx
+func (s *AuthenticationService) LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "logout", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LogoutResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("logout", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -226,9 +361,15 @@ func (s *AuthenticationService) Logout(p *LogoutParams) (*LogoutResponse, error)
 }
 
 type LogoutResponse struct {
-	Description string `json:"description"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
+	Description string                     `json:"description"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LogoutResponse) UnmarshalJSON(b []byte) error {
+	type alias LogoutResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type OauthloginParams struct {
@@ -259,6 +400,48 @@ func (p *OauthloginParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the oauthlogin API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *OauthloginParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("oauthlogin", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the oauthlogin API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *OauthloginParams) Validate() error {
+	return paramRules{
+		required: []string{"email", "provider"},
+		lengths:  map[string]int{"domain": 255, "email": 255, "provider": 255, "secretcode": 255},
+	}.validate("oauthlogin", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the oauthlogin API, before the request is signed
+func (p *OauthloginParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *OauthloginParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *OauthloginParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("oauthlogin", b, &p.p, map[string]paramDecoder{
+		"domain":     decodeParam[string],
+		"domainId":   decodeParam[int64],
+		"email":      decodeParam[string],
+		"provider":   decodeParam[string],
+		"secretcode": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *OauthloginParams) Clone() *OauthloginParams {
+	return &OauthloginParams{p: cloneParams(p.p)}
+}
+
 func (p *OauthloginParams) SetDomain(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -376,13 +559,22 @@ func (s *AuthenticationService) NewOauthloginParams(email string, provider strin
 
 // Logs a user into the CloudStack after successful verification of OAuth secret code from the particular provider.A successful login attempt will generate a JSESSIONID cookie value that can be passed in subsequent Query command calls until the "logout" command has been issued or the session has expired.
 func (s *AuthenticationService) Oauthlogin(p *OauthloginParams) (*OauthloginResponse, error) {
-	resp, err := s.cs.newPostRequest("oauthlogin", p.toURLValues())
+	return s.OauthloginWithContext(context.Background(), p)
+}
+
+// OauthloginWithContext is like Oauthlogin, but honours the cancellation and deadline of ctx
+func (s *AuthenticationService) OauthloginWithContext(ctx context.Context, p *OauthloginParams) (*OauthloginResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "oauthlogin", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r OauthloginResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("oauthlogin", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -390,23 +582,29 @@ func (s *AuthenticationService) Oauthlogin(p *OauthloginParams) (*OauthloginResp
 }
 
 type OauthloginResponse struct {
-	Account            string `json:"account"`
-	Domainid           string `json:"domainid"`
-	Firstname          string `json:"firstname"`
-	Is2faenabled       string `json:"is2faenabled"`
-	Is2faverified      string `json:"is2faverified"`
-	Issuerfor2fa       string `json:"issuerfor2fa"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Lastname           string `json:"lastname"`
-	Managementserverid UUID   `json:"managementserverid"`
-	Providerfor2fa     string `json:"providerfor2fa"`
-	Registered         string `json:"registered"`
-	Sessionkey         string `json:"sessionkey"`
-	Timeout            int    `json:"timeout"`
-	Timezone           string `json:"timezone"`
-	Timezoneoffset     string `json:"timezoneoffset"`
-	Type               string `json:"type"`
-	Userid             string `json:"userid"`
-	Username           string `json:"username"`
+	Account            string                     `json:"account"`
+	Domainid           string                     `json:"domainid"`
+	Firstname          string                     `json:"firstname"`
+	Is2faenabled       string                     `json:"is2faenabled"`
+	Is2faverified      string                     `json:"is2faverified"`
+	Issuerfor2fa       string                     `json:"issuerfor2fa"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Lastname           string                     `json:"lastname"`
+	Managementserverid UUID                       `json:"managementserverid"`
+	Providerfor2fa     string                     `json:"providerfor2fa"`
+	Registered         string                     `json:"registered"`
+	Sessionkey         string                     `json:"sessionkey"`
+	Timeout            FlexInt                    `json:"timeout"`
+	Timezone           string                     `json:"timezone"`
+	Timezoneoffset     string                     `json:"timezoneoffset"`
+	Type               string                     `json:"type"`
+	Userid             string                     `json:"userid"`
+	Username           string                     `json:"username"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OauthloginResponse) UnmarshalJSON(b []byte) error {
+	type alias OauthloginResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
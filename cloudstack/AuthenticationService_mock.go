@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockAuthenticationServiceIfaceMockRecorder) Login(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Login", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).Login), p)
 }
 
+// LoginWithContext mocks base method.
+func (m *MockAuthenticationServiceIface) LoginWithContext(ctx context.Context, p *LoginParams) (*LoginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LoginWithContext", ctx, p)
+	ret0, _ := ret[0].(*LoginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LoginWithContext indicates an expected call of LoginWithContext.
+func (mr *MockAuthenticationServiceIfaceMockRecorder) LoginWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LoginWithContext", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).LoginWithContext), ctx, p)
+}
+
 // Logout mocks base method.
 func (m *MockAuthenticationServiceIface) Logout(p *LogoutParams) (*LogoutResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +104,21 @@ func (mr *MockAuthenticationServiceIfaceMockRecorder) Logout(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Logout", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).Logout), p)
 }
 
+// LogoutWithContext mocks base method.
+func (m *MockAuthenticationServiceIface) LogoutWithContext(ctx context.Context, p *LogoutParams) (*LogoutResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LogoutWithContext", ctx, p)
+	ret0, _ := ret[0].(*LogoutResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LogoutWithContext indicates an expected call of LogoutWithContext.
+func (mr *MockAuthenticationServiceIfaceMockRecorder) LogoutWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LogoutWithContext", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).LogoutWithContext), ctx, p)
+}
+
 // NewLoginParams mocks base method.
 func (m *MockAuthenticationServiceIface) NewLoginParams(password, username string) *LoginParams {
 	m.ctrl.T.Helper()
@@ -144,3 +175,18 @@ func (mr *MockAuthenticationServiceIfaceMockRecorder) Oauthlogin(p any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Oauthlogin", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).Oauthlogin), p)
 }
+
+// OauthloginWithContext mocks base method.
+func (m *MockAuthenticationServiceIface) OauthloginWithContext(ctx context.Context, p *OauthloginParams) (*OauthloginResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "OauthloginWithContext", ctx, p)
+	ret0, _ := ret[0].(*OauthloginResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// OauthloginWithContext indicates an expected call of OauthloginWithContext.
+func (mr *MockAuthenticationServiceIfaceMockRecorder) OauthloginWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "OauthloginWithContext", reflect.TypeOf((*MockAuthenticationServiceIface)(nil).OauthloginWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,57 +31,125 @@ import (
 
 type AutoScaleServiceIface interface {
 	CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyWithContext(ctx context.Context, p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error)
+	CreateAutoScalePolicyAsync(ctx context.Context, p *CreateAutoScalePolicyParams) (*Job, error)
 	NewCreateAutoScalePolicyParams(action string, conditionids []string, duration int) *CreateAutoScalePolicyParams
 	CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error)
+	CreateAutoScaleVmGroupAsync(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*Job, error)
 	NewCreateAutoScaleVmGroupParams(lbruleid string, maxmembers int, minmembers int, scaledownpolicyids []string, scaleuppolicyids []string, vmprofileid string) *CreateAutoScaleVmGroupParams
 	CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error)
+	CreateAutoScaleVmProfileAsync(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*Job, error)
 	NewCreateAutoScaleVmProfileParams(serviceofferingid string, templateid string, zoneid string) *CreateAutoScaleVmProfileParams
 	CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error)
+	CreateConditionWithContext(ctx context.Context, p *CreateConditionParams) (*CreateConditionResponse, error)
+	CreateConditionAsync(ctx context.Context, p *CreateConditionParams) (*Job, error)
 	NewCreateConditionParams(counterid string, relationaloperator string, threshold int64) *CreateConditionParams
 	CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error)
+	CreateCounterWithContext(ctx context.Context, p *CreateCounterParams) (*CreateCounterResponse, error)
+	CreateCounterAsync(ctx context.Context, p *CreateCounterParams) (*Job, error)
 	NewCreateCounterParams(name string, provider string, source string, value string) *CreateCounterParams
 	DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error)
+	DeleteAutoScalePolicyAsync(ctx context.Context, p *DeleteAutoScalePolicyParams) (*Job, error)
 	NewDeleteAutoScalePolicyParams(id string) *DeleteAutoScalePolicyParams
 	DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error)
+	DeleteAutoScaleVmGroupAsync(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*Job, error)
 	NewDeleteAutoScaleVmGroupParams(id string) *DeleteAutoScaleVmGroupParams
 	DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error)
+	DeleteAutoScaleVmProfileAsync(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*Job, error)
 	NewDeleteAutoScaleVmProfileParams(id string) *DeleteAutoScaleVmProfileParams
 	DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error)
+	DeleteConditionWithContext(ctx context.Context, p *DeleteConditionParams) (*DeleteConditionResponse, error)
+	DeleteConditionAsync(ctx context.Context, p *DeleteConditionParams) (*Job, error)
 	NewDeleteConditionParams(id string) *DeleteConditionParams
 	DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error)
+	DeleteCounterWithContext(ctx context.Context, p *DeleteCounterParams) (*DeleteCounterResponse, error)
+	DeleteCounterAsync(ctx context.Context, p *DeleteCounterParams) (*Job, error)
 	NewDeleteCounterParams(id string) *DeleteCounterParams
 	DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error)
+	DisableAutoScaleVmGroupAsync(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*Job, error)
 	NewDisableAutoScaleVmGroupParams(id string) *DisableAutoScaleVmGroupParams
 	EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error)
+	EnableAutoScaleVmGroupAsync(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*Job, error)
 	NewEnableAutoScaleVmGroupParams(id string) *EnableAutoScaleVmGroupParams
 	ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error)
+	ListAutoScalePoliciesAll(p *ListAutoScalePoliciesParams, opts ...PageOption) ([]*AutoScalePolicy, error)
+	ListAutoScalePoliciesAllWithContext(ctx context.Context, p *ListAutoScalePoliciesParams, opts ...PageOption) ([]*AutoScalePolicy, error)
+	ListAutoScalePoliciesIter(p *ListAutoScalePoliciesParams, opts ...PageOption) iter.Seq2[*AutoScalePolicy, error]
+	ListAutoScalePoliciesIterWithContext(ctx context.Context, p *ListAutoScalePoliciesParams, opts ...PageOption) iter.Seq2[*AutoScalePolicy, error]
 	NewListAutoScalePoliciesParams() *ListAutoScalePoliciesParams
 	GetAutoScalePolicyID(name string, opts ...OptionFunc) (string, int, error)
+	GetAutoScalePolicyIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetAutoScalePolicyByName(name string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
+	GetAutoScalePolicyByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
 	GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
+	GetAutoScalePolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScalePolicy, int, error)
 	ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error)
+	ListAutoScaleVmGroupsAll(p *ListAutoScaleVmGroupsParams, opts ...PageOption) ([]*AutoScaleVmGroup, error)
+	ListAutoScaleVmGroupsAllWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams, opts ...PageOption) ([]*AutoScaleVmGroup, error)
+	ListAutoScaleVmGroupsIter(p *ListAutoScaleVmGroupsParams, opts ...PageOption) iter.Seq2[*AutoScaleVmGroup, error]
+	ListAutoScaleVmGroupsIterWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams, opts ...PageOption) iter.Seq2[*AutoScaleVmGroup, error]
 	NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGroupsParams
 	GetAutoScaleVmGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetAutoScaleVmGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetAutoScaleVmGroupByName(name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
+	GetAutoScaleVmGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
 	GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
+	GetAutoScaleVmGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error)
 	ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error)
+	ListAutoScaleVmProfilesAll(p *ListAutoScaleVmProfilesParams, opts ...PageOption) ([]*AutoScaleVmProfile, error)
+	ListAutoScaleVmProfilesAllWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams, opts ...PageOption) ([]*AutoScaleVmProfile, error)
+	ListAutoScaleVmProfilesIter(p *ListAutoScaleVmProfilesParams, opts ...PageOption) iter.Seq2[*AutoScaleVmProfile, error]
+	ListAutoScaleVmProfilesIterWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams, opts ...PageOption) iter.Seq2[*AutoScaleVmProfile, error]
 	NewListAutoScaleVmProfilesParams() *ListAutoScaleVmProfilesParams
 	GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error)
+	GetAutoScaleVmProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error)
 	ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error)
+	ListConditionsWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error)
+	ListConditionsAll(p *ListConditionsParams, opts ...PageOption) ([]*Condition, error)
+	ListConditionsAllWithContext(ctx context.Context, p *ListConditionsParams, opts ...PageOption) ([]*Condition, error)
+	ListConditionsIter(p *ListConditionsParams, opts ...PageOption) iter.Seq2[*Condition, error]
+	ListConditionsIterWithContext(ctx context.Context, p *ListConditionsParams, opts ...PageOption) iter.Seq2[*Condition, error]
 	NewListConditionsParams() *ListConditionsParams
 	GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error)
+	GetConditionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Condition, int, error)
 	ListCounters(p *ListCountersParams) (*ListCountersResponse, error)
+	ListCountersWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error)
+	ListCountersAll(p *ListCountersParams, opts ...PageOption) ([]*Counter, error)
+	ListCountersAllWithContext(ctx context.Context, p *ListCountersParams, opts ...PageOption) ([]*Counter, error)
+	ListCountersIter(p *ListCountersParams, opts ...PageOption) iter.Seq2[*Counter, error]
+	ListCountersIterWithContext(ctx context.Context, p *ListCountersParams, opts ...PageOption) iter.Seq2[*Counter, error]
 	NewListCountersParams() *ListCountersParams
 	GetCounterID(name string, opts ...OptionFunc) (string, int, error)
+	GetCounterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Counter, int, error)
 	GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error)
+	GetCounterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Counter, int, error)
 	UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error)
+	UpdateAutoScalePolicyAsync(ctx context.Context, p *UpdateAutoScalePolicyParams) (*Job, error)
 	NewUpdateAutoScalePolicyParams(id string) *UpdateAutoScalePolicyParams
 	UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error)
+	UpdateAutoScaleVmGroupAsync(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*Job, error)
 	NewUpdateAutoScaleVmGroupParams(id string) *UpdateAutoScaleVmGroupParams
 	UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error)
+	UpdateAutoScaleVmProfileAsync(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*Job, error)
 	NewUpdateAutoScaleVmProfileParams(id string) *UpdateAutoScaleVmProfileParams
 	UpdateCondition(p *UpdateConditionParams) (*UpdateConditionResponse, error)
+	UpdateConditionWithContext(ctx context.Context, p *UpdateConditionParams) (*UpdateConditionResponse, error)
+	UpdateConditionAsync(ctx context.Context, p *UpdateConditionParams) (*Job, error)
 	NewUpdateConditionParams(id string, relationaloperator string, threshold int64) *UpdateConditionParams
 }
 
@@ -113,6 +183,48 @@ func (p *CreateAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createAutoScalePolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateAutoScalePolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createAutoScalePolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createAutoScalePolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateAutoScalePolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"action", "conditionids", "duration"},
+		lengths:  map[string]int{"action": 255, "name": 255},
+	}.validate("createAutoScalePolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createAutoScalePolicy API, before the request is signed
+func (p *CreateAutoScalePolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createAutoScalePolicy", b, &p.p, map[string]paramDecoder{
+		"action":       decodeParam[string],
+		"conditionids": decodeParam[[]string],
+		"duration":     decodeParam[int],
+		"name":         decodeParam[string],
+		"quiettime":    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateAutoScalePolicyParams) Clone() *CreateAutoScalePolicyParams {
+	return &CreateAutoScalePolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateAutoScalePolicyParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -231,32 +343,39 @@ func (s *AutoScaleService) NewCreateAutoScalePolicyParams(action string, conditi
 
 // Creates an autoscale policy for a provision or deprovision action, the action is taken when the all the conditions evaluates to true for the specified duration. The policy is in effect once it is attached to a autscale Instance group.
 func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("createAutoScalePolicy", p.toURLValues())
+	return s.CreateAutoScalePolicyWithContext(context.Background(), p)
+}
+
+// CreateAutoScalePolicyWithContext is like CreateAutoScalePolicy, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) CreateAutoScalePolicyWithContext(ctx context.Context, p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createAutoScalePolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateAutoScalePolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createAutoScalePolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createAutoScalePolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createAutoScalePolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -264,21 +383,46 @@ func (s *AutoScaleService) CreateAutoScalePolicy(p *CreateAutoScalePolicyParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateAutoScalePolicy async job into r
+func (r *CreateAutoScalePolicyResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateAutoScalePolicyAsync starts the async job of CreateAutoScalePolicy without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) CreateAutoScalePolicyAsync(ctx context.Context, p *CreateAutoScalePolicyParams) (*Job, error) {
+	r, err := s.CreateAutoScalePolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createAutoScalePolicy", r.JobID), nil
+}
+
 type CreateAutoScalePolicyResponse struct {
-	Account    string       `json:"account"`
-	Action     string       `json:"action"`
-	Conditions []*Condition `json:"conditions"`
-	Domain     string       `json:"domain"`
-	Domainid   string       `json:"domainid"`
-	Domainpath string       `json:"domainpath"`
-	Duration   int          `json:"duration"`
-	Id         string       `json:"id"`
-	JobID      string       `json:"jobid"`
-	Jobstatus  int          `json:"jobstatus"`
-	Name       string       `json:"name"`
-	Project    string       `json:"project"`
-	Projectid  string       `json:"projectid"`
-	Quiettime  int          `json:"quiettime"`
+	Account    string                     `json:"account"`
+	Action     string                     `json:"action"`
+	Conditions []*Condition               `json:"conditions"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Duration   FlexInt                    `json:"duration"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Quiettime  FlexInt                    `json:"quiettime"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateAutoScalePolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateAutoScalePolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateAutoScaleVmGroupParams struct {
@@ -326,6 +470,52 @@ func (p *CreateAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createAutoScaleVmGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateAutoScaleVmGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createAutoScaleVmGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createAutoScaleVmGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateAutoScaleVmGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"lbruleid", "maxmembers", "minmembers", "scaledownpolicyids", "scaleuppolicyids", "vmprofileid"},
+		lengths:  map[string]int{"lbruleid": 255, "name": 255, "vmprofileid": 255},
+	}.validate("createAutoScaleVmGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createAutoScaleVmGroup API, before the request is signed
+func (p *CreateAutoScaleVmGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createAutoScaleVmGroup", b, &p.p, map[string]paramDecoder{
+		"fordisplay":         decodeParam[bool],
+		"interval":           decodeParam[int],
+		"lbruleid":           decodeParam[string],
+		"maxmembers":         decodeParam[int],
+		"minmembers":         decodeParam[int],
+		"name":               decodeParam[string],
+		"scaledownpolicyids": decodeParam[[]string],
+		"scaleuppolicyids":   decodeParam[[]string],
+		"vmprofileid":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateAutoScaleVmGroupParams) Clone() *CreateAutoScaleVmGroupParams {
+	return &CreateAutoScaleVmGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateAutoScaleVmGroupParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -531,32 +721,39 @@ func (s *AutoScaleService) NewCreateAutoScaleVmGroupParams(lbruleid string, maxm
 
 // Creates and automatically starts an Instance based on a service offering, disk offering, and Template.
 func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("createAutoScaleVmGroup", p.toURLValues())
+	return s.CreateAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// CreateAutoScaleVmGroupWithContext is like CreateAutoScaleVmGroup, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) CreateAutoScaleVmGroupWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createAutoScaleVmGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createAutoScaleVmGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -564,36 +761,61 @@ func (s *AutoScaleService) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateAutoScaleVmGroup async job into r
+func (r *CreateAutoScaleVmGroupResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateAutoScaleVmGroupAsync starts the async job of CreateAutoScaleVmGroup without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) CreateAutoScaleVmGroupAsync(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*Job, error) {
+	r, err := s.CreateAutoScaleVmGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createAutoScaleVmGroup", r.JobID), nil
+}
+
 type CreateAutoScaleVmGroupResponse struct {
-	Account                      string             `json:"account"`
-	Associatednetworkid          string             `json:"associatednetworkid"`
-	Associatednetworkname        string             `json:"associatednetworkname"`
-	Availablevirtualmachinecount int                `json:"availablevirtualmachinecount"`
-	Created                      string             `json:"created"`
-	Domain                       string             `json:"domain"`
-	Domainid                     string             `json:"domainid"`
-	Domainpath                   string             `json:"domainpath"`
-	Fordisplay                   bool               `json:"fordisplay"`
-	Hasannotations               bool               `json:"hasannotations"`
-	Id                           string             `json:"id"`
-	Interval                     int                `json:"interval"`
-	JobID                        string             `json:"jobid"`
-	Jobstatus                    int                `json:"jobstatus"`
-	Lbprovider                   string             `json:"lbprovider"`
-	Lbruleid                     string             `json:"lbruleid"`
-	Maxmembers                   int                `json:"maxmembers"`
-	Minmembers                   int                `json:"minmembers"`
-	Name                         string             `json:"name"`
-	Privateport                  string             `json:"privateport"`
-	Project                      string             `json:"project"`
-	Projectid                    string             `json:"projectid"`
-	Publicip                     string             `json:"publicip"`
-	Publicipid                   string             `json:"publicipid"`
-	Publicport                   string             `json:"publicport"`
-	Scaledownpolicies            []*AutoScalePolicy `json:"scaledownpolicies"`
-	Scaleuppolicies              []*AutoScalePolicy `json:"scaleuppolicies"`
-	State                        string             `json:"state"`
-	Vmprofileid                  string             `json:"vmprofileid"`
+	Account                      string                     `json:"account"`
+	Associatednetworkid          string                     `json:"associatednetworkid"`
+	Associatednetworkname        string                     `json:"associatednetworkname"`
+	Availablevirtualmachinecount FlexInt                    `json:"availablevirtualmachinecount"`
+	Created                      Time                       `json:"created"`
+	Domain                       string                     `json:"domain"`
+	Domainid                     string                     `json:"domainid"`
+	Domainpath                   string                     `json:"domainpath"`
+	Fordisplay                   FlexBool                   `json:"fordisplay"`
+	Hasannotations               FlexBool                   `json:"hasannotations"`
+	Id                           string                     `json:"id"`
+	Interval                     FlexInt                    `json:"interval"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Lbprovider                   string                     `json:"lbprovider"`
+	Lbruleid                     string                     `json:"lbruleid"`
+	Maxmembers                   FlexInt                    `json:"maxmembers"`
+	Minmembers                   FlexInt                    `json:"minmembers"`
+	Name                         string                     `json:"name"`
+	Privateport                  string                     `json:"privateport"`
+	Project                      string                     `json:"project"`
+	Projectid                    string                     `json:"projectid"`
+	Publicip                     string                     `json:"publicip"`
+	Publicipid                   string                     `json:"publicipid"`
+	Publicport                   string                     `json:"publicport"`
+	Scaledownpolicies            []*AutoScalePolicy         `json:"scaledownpolicies"`
+	Scaleuppolicies              []*AutoScalePolicy         `json:"scaleuppolicies"`
+	State                        string                     `json:"state"`
+	Vmprofileid                  string                     `json:"vmprofileid"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateAutoScaleVmGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateAutoScaleVmGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateAutoScaleVmProfileParams struct {
@@ -663,6 +885,57 @@ func (p *CreateAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createAutoScaleVmProfile API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateAutoScaleVmProfileParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createAutoScaleVmProfile", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createAutoScaleVmProfile API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateAutoScaleVmProfileParams) Validate() error {
+	return paramRules{
+		required: []string{"serviceofferingid", "templateid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "autoscaleuserid": 255, "domainid": 255, "projectid": 255, "serviceofferingid": 255, "templateid": 255, "userdata": 1048576, "userdataid": 255, "zoneid": 255},
+	}.validate("createAutoScaleVmProfile", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createAutoScaleVmProfile API, before the request is signed
+func (p *CreateAutoScaleVmProfileParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createAutoScaleVmProfile", b, &p.p, map[string]paramDecoder{
+		"account":              decodeParam[string],
+		"autoscaleuserid":      decodeParam[string],
+		"counterparam":         decodeParam[map[string]string],
+		"domainid":             decodeParam[string],
+		"expungevmgraceperiod": decodeParam[int],
+		"fordisplay":           decodeParam[bool],
+		"otherdeployparams":    decodeParam[map[string]string],
+		"projectid":            decodeParam[string],
+		"serviceofferingid":    decodeParam[string],
+		"templateid":           decodeParam[string],
+		"userdata":             decodeParam[string],
+		"userdatadetails":      decodeParam[map[string]string],
+		"userdataid":           decodeParam[string],
+		"zoneid":               decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateAutoScaleVmProfileParams) Clone() *CreateAutoScaleVmProfileParams {
+	return &CreateAutoScaleVmProfileParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateAutoScaleVmProfileParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -970,32 +1243,39 @@ func (s *AutoScaleService) NewCreateAutoScaleVmProfileParams(serviceofferingid s
 
 // Creates a profile that contains information about the Instance which will be provisioned automatically by autoscale feature.
 func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newPostRequest("createAutoScaleVmProfile", p.toURLValues())
+	return s.CreateAutoScaleVmProfileWithContext(context.Background(), p)
+}
+
+// CreateAutoScaleVmProfileWithContext is like CreateAutoScaleVmProfile, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) CreateAutoScaleVmProfileWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createAutoScaleVmProfile", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateAutoScaleVmProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createAutoScaleVmProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createAutoScaleVmProfile", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createAutoScaleVmProfile", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1003,28 +1283,53 @@ func (s *AutoScaleService) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateAutoScaleVmProfile async job into r
+func (r *CreateAutoScaleVmProfileResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateAutoScaleVmProfileAsync starts the async job of CreateAutoScaleVmProfile without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) CreateAutoScaleVmProfileAsync(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*Job, error) {
+	r, err := s.CreateAutoScaleVmProfileWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createAutoScaleVmProfile", r.JobID), nil
+}
+
 type CreateAutoScaleVmProfileResponse struct {
-	Account              string            `json:"account"`
-	Autoscaleuserid      string            `json:"autoscaleuserid"`
-	Domain               string            `json:"domain"`
-	Domainid             string            `json:"domainid"`
-	Domainpath           string            `json:"domainpath"`
-	Expungevmgraceperiod int               `json:"expungevmgraceperiod"`
-	Fordisplay           bool              `json:"fordisplay"`
-	Id                   string            `json:"id"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Otherdeployparams    map[string]string `json:"otherdeployparams"`
-	Project              string            `json:"project"`
-	Projectid            string            `json:"projectid"`
-	Serviceofferingid    string            `json:"serviceofferingid"`
-	Templateid           string            `json:"templateid"`
-	Userdata             string            `json:"userdata"`
-	Userdatadetails      string            `json:"userdatadetails"`
-	Userdataid           string            `json:"userdataid"`
-	Userdataname         string            `json:"userdataname"`
-	Userdatapolicy       string            `json:"userdatapolicy"`
-	Zoneid               string            `json:"zoneid"`
+	Account              string                     `json:"account"`
+	Autoscaleuserid      string                     `json:"autoscaleuserid"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Expungevmgraceperiod FlexInt                    `json:"expungevmgraceperiod"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Otherdeployparams    map[string]string          `json:"otherdeployparams"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Serviceofferingid    string                     `json:"serviceofferingid"`
+	Templateid           string                     `json:"templateid"`
+	Userdata             string                     `json:"userdata"`
+	Userdatadetails      string                     `json:"userdatadetails"`
+	Userdataid           string                     `json:"userdataid"`
+	Userdataname         string                     `json:"userdataname"`
+	Userdatapolicy       string                     `json:"userdatapolicy"`
+	Zoneid               string                     `json:"zoneid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateAutoScaleVmProfileResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateAutoScaleVmProfileResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateConditionParams struct {
@@ -1058,6 +1363,49 @@ func (p *CreateConditionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createCondition API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateConditionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createCondition", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createCondition API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateConditionParams) Validate() error {
+	return paramRules{
+		required: []string{"counterid", "relationaloperator", "threshold"},
+		lengths:  map[string]int{"account": 255, "counterid": 255, "domainid": 255, "projectid": 255, "relationaloperator": 255},
+	}.validate("createCondition", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createCondition API, before the request is signed
+func (p *CreateConditionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateConditionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateConditionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createCondition", b, &p.p, map[string]paramDecoder{
+		"account":            decodeParam[string],
+		"counterid":          decodeParam[string],
+		"domainid":           decodeParam[string],
+		"projectid":          decodeParam[string],
+		"relationaloperator": decodeParam[string],
+		"threshold":          decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateConditionParams) Clone() *CreateConditionParams {
+	return &CreateConditionParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateConditionParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1197,32 +1545,39 @@ func (s *AutoScaleService) NewCreateConditionParams(counterid string, relational
 
 // Creates a condition for Instance auto scaling
 func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
-	resp, err := s.cs.newPostRequest("createCondition", p.toURLValues())
+	return s.CreateConditionWithContext(context.Background(), p)
+}
+
+// CreateConditionWithContext is like CreateCondition, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) CreateConditionWithContext(ctx context.Context, p *CreateConditionParams) (*CreateConditionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createCondition", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateConditionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createCondition", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createCondition", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createCondition", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1230,22 +1585,47 @@ func (s *AutoScaleService) CreateCondition(p *CreateConditionParams) (*CreateCon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateCondition async job into r
+func (r *CreateConditionResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateConditionAsync starts the async job of CreateCondition without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) CreateConditionAsync(ctx context.Context, p *CreateConditionParams) (*Job, error) {
+	r, err := s.CreateConditionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createCondition", r.JobID), nil
+}
+
 type CreateConditionResponse struct {
-	Account            string   `json:"account"`
-	Counter            *Counter `json:"counter"`
-	Counterid          string   `json:"counterid"`
-	Countername        string   `json:"countername"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	JobID              string   `json:"jobid"`
-	Jobstatus          int      `json:"jobstatus"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Relationaloperator string   `json:"relationaloperator"`
-	Threshold          int64    `json:"threshold"`
-	Zoneid             string   `json:"zoneid"`
+	Account            string                     `json:"account"`
+	Counter            *Counter                   `json:"counter"`
+	Counterid          string                     `json:"counterid"`
+	Countername        string                     `json:"countername"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Relationaloperator string                     `json:"relationaloperator"`
+	Threshold          FlexInt64                  `json:"threshold"`
+	Zoneid             string                     `json:"zoneid"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateConditionResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateConditionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateCounterParams struct {
@@ -1272,6 +1652,47 @@ func (p *CreateCounterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createCounter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateCounterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createCounter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createCounter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateCounterParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "provider", "source", "value"},
+		lengths:  map[string]int{"name": 255, "provider": 255, "source": 255, "value": 4096},
+	}.validate("createCounter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createCounter API, before the request is signed
+func (p *CreateCounterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateCounterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateCounterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createCounter", b, &p.p, map[string]paramDecoder{
+		"name":     decodeParam[string],
+		"provider": decodeParam[string],
+		"source":   decodeParam[string],
+		"value":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateCounterParams) Clone() *CreateCounterParams {
+	return &CreateCounterParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateCounterParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1370,32 +1791,39 @@ func (s *AutoScaleService) NewCreateCounterParams(name string, provider string,
 
 // Adds metric counter for Instance auto scaling
 func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
-	resp, err := s.cs.newPostRequest("createCounter", p.toURLValues())
+	return s.CreateCounterWithContext(context.Background(), p)
+}
+
+// CreateCounterWithContext is like CreateCounter, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) CreateCounterWithContext(ctx context.Context, p *CreateCounterParams) (*CreateCounterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createCounter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateCounterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createCounter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createCounter", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createCounter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1403,15 +1831,40 @@ func (s *AutoScaleService) CreateCounter(p *CreateCounterParams) (*CreateCounter
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateCounter async job into r
+func (r *CreateCounterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateCounterAsync starts the async job of CreateCounter without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) CreateCounterAsync(ctx context.Context, p *CreateCounterParams) (*Job, error) {
+	r, err := s.CreateCounterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createCounter", r.JobID), nil
+}
+
 type CreateCounterResponse struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Provider  string `json:"provider"`
-	Source    string `json:"source"`
-	Value     string `json:"value"`
-	Zoneid    string `json:"zoneid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Provider  string                     `json:"provider"`
+	Source    string                     `json:"source"`
+	Value     string                     `json:"value"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateCounterResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateCounterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteAutoScalePolicyParams struct {
@@ -1429,6 +1882,44 @@ func (p *DeleteAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteAutoScalePolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteAutoScalePolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteAutoScalePolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteAutoScalePolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteAutoScalePolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteAutoScalePolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteAutoScalePolicy API, before the request is signed
+func (p *DeleteAutoScalePolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteAutoScalePolicy", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteAutoScalePolicyParams) Clone() *DeleteAutoScalePolicyParams {
+	return &DeleteAutoScalePolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteAutoScalePolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1461,27 +1952,39 @@ func (s *AutoScaleService) NewDeleteAutoScalePolicyParams(id string) *DeleteAuto
 
 // Deletes a autoscale policy.
 func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteAutoScalePolicy", p.toURLValues())
+	return s.DeleteAutoScalePolicyWithContext(context.Background(), p)
+}
+
+// DeleteAutoScalePolicyWithContext is like DeleteAutoScalePolicy, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) DeleteAutoScalePolicyWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteAutoScalePolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAutoScalePolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteAutoScalePolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteAutoScalePolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteAutoScalePolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1489,11 +1992,31 @@ func (s *AutoScaleService) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteAutoScalePolicy async job into r
+func (r *DeleteAutoScalePolicyResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteAutoScalePolicyAsync starts the async job of DeleteAutoScalePolicy without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) DeleteAutoScalePolicyAsync(ctx context.Context, p *DeleteAutoScalePolicyParams) (*Job, error) {
+	r, err := s.DeleteAutoScalePolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteAutoScalePolicy", r.JobID), nil
+}
+
 type DeleteAutoScalePolicyResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteAutoScalePolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteAutoScalePolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteAutoScaleVmGroupParams struct {
@@ -1515,6 +2038,45 @@ func (p *DeleteAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteAutoScaleVmGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteAutoScaleVmGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteAutoScaleVmGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteAutoScaleVmGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteAutoScaleVmGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteAutoScaleVmGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteAutoScaleVmGroup API, before the request is signed
+func (p *DeleteAutoScaleVmGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteAutoScaleVmGroup", b, &p.p, map[string]paramDecoder{
+		"cleanup": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteAutoScaleVmGroupParams) Clone() *DeleteAutoScaleVmGroupParams {
+	return &DeleteAutoScaleVmGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteAutoScaleVmGroupParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1568,27 +2130,39 @@ func (s *AutoScaleService) NewDeleteAutoScaleVmGroupParams(id string) *DeleteAut
 
 // Deletes an autoscale Instance group.
 func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteAutoScaleVmGroup", p.toURLValues())
+	return s.DeleteAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// DeleteAutoScaleVmGroupWithContext is like DeleteAutoScaleVmGroup, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteAutoScaleVmGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteAutoScaleVmGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1596,11 +2170,31 @@ func (s *AutoScaleService) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteAutoScaleVmGroup async job into r
+func (r *DeleteAutoScaleVmGroupResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteAutoScaleVmGroupAsync starts the async job of DeleteAutoScaleVmGroup without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) DeleteAutoScaleVmGroupAsync(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*Job, error) {
+	r, err := s.DeleteAutoScaleVmGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteAutoScaleVmGroup", r.JobID), nil
+}
+
 type DeleteAutoScaleVmGroupResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteAutoScaleVmGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteAutoScaleVmGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteAutoScaleVmProfileParams struct {
@@ -1618,6 +2212,44 @@ func (p *DeleteAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteAutoScaleVmProfile API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteAutoScaleVmProfileParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteAutoScaleVmProfile", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteAutoScaleVmProfile API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteAutoScaleVmProfileParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteAutoScaleVmProfile", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteAutoScaleVmProfile API, before the request is signed
+func (p *DeleteAutoScaleVmProfileParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteAutoScaleVmProfile", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteAutoScaleVmProfileParams) Clone() *DeleteAutoScaleVmProfileParams {
+	return &DeleteAutoScaleVmProfileParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteAutoScaleVmProfileParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1650,27 +2282,39 @@ func (s *AutoScaleService) NewDeleteAutoScaleVmProfileParams(id string) *DeleteA
 
 // Deletes a autoscale Instance profile.
 func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteAutoScaleVmProfile", p.toURLValues())
+	return s.DeleteAutoScaleVmProfileWithContext(context.Background(), p)
+}
+
+// DeleteAutoScaleVmProfileWithContext is like DeleteAutoScaleVmProfile, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteAutoScaleVmProfile", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAutoScaleVmProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteAutoScaleVmProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteAutoScaleVmProfile", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteAutoScaleVmProfile", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1678,11 +2322,31 @@ func (s *AutoScaleService) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteAutoScaleVmProfile async job into r
+func (r *DeleteAutoScaleVmProfileResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteAutoScaleVmProfileAsync starts the async job of DeleteAutoScaleVmProfile without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) DeleteAutoScaleVmProfileAsync(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*Job, error) {
+	r, err := s.DeleteAutoScaleVmProfileWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteAutoScaleVmProfile", r.JobID), nil
+}
+
 type DeleteAutoScaleVmProfileResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteAutoScaleVmProfileResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteAutoScaleVmProfileResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteConditionParams struct {
@@ -1700,6 +2364,44 @@ func (p *DeleteConditionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteCondition API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteConditionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteCondition", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteCondition API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteConditionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteCondition", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteCondition API, before the request is signed
+func (p *DeleteConditionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteConditionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteConditionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteCondition", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteConditionParams) Clone() *DeleteConditionParams {
+	return &DeleteConditionParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteConditionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1732,27 +2434,39 @@ func (s *AutoScaleService) NewDeleteConditionParams(id string) *DeleteConditionP
 
 // Removes a condition for Instance auto scaling
 func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteCondition", p.toURLValues())
+	return s.DeleteConditionWithContext(context.Background(), p)
+}
+
+// DeleteConditionWithContext is like DeleteCondition, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) DeleteConditionWithContext(ctx context.Context, p *DeleteConditionParams) (*DeleteConditionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteCondition", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteConditionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteCondition", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteCondition", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteCondition", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1760,11 +2474,31 @@ func (s *AutoScaleService) DeleteCondition(p *DeleteConditionParams) (*DeleteCon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteCondition async job into r
+func (r *DeleteConditionResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteConditionAsync starts the async job of DeleteCondition without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) DeleteConditionAsync(ctx context.Context, p *DeleteConditionParams) (*Job, error) {
+	r, err := s.DeleteConditionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteCondition", r.JobID), nil
+}
+
 type DeleteConditionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteConditionResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteConditionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteCounterParams struct {
@@ -1782,6 +2516,44 @@ func (p *DeleteCounterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteCounter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteCounterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteCounter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteCounter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteCounterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteCounter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteCounter API, before the request is signed
+func (p *DeleteCounterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteCounterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteCounterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteCounter", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteCounterParams) Clone() *DeleteCounterParams {
+	return &DeleteCounterParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteCounterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1814,27 +2586,39 @@ func (s *AutoScaleService) NewDeleteCounterParams(id string) *DeleteCounterParam
 
 // Deletes a counter for Instance auto scaling
 func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteCounter", p.toURLValues())
+	return s.DeleteCounterWithContext(context.Background(), p)
+}
+
+// DeleteCounterWithContext is like DeleteCounter, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) DeleteCounterWithContext(ctx context.Context, p *DeleteCounterParams) (*DeleteCounterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteCounter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteCounterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteCounter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteCounter", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteCounter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1842,11 +2626,31 @@ func (s *AutoScaleService) DeleteCounter(p *DeleteCounterParams) (*DeleteCounter
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteCounter async job into r
+func (r *DeleteCounterResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteCounterAsync starts the async job of DeleteCounter without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) DeleteCounterAsync(ctx context.Context, p *DeleteCounterParams) (*Job, error) {
+	r, err := s.DeleteCounterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteCounter", r.JobID), nil
+}
+
 type DeleteCounterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteCounterResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteCounterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableAutoScaleVmGroupParams struct {
@@ -1864,6 +2668,44 @@ func (p *DisableAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableAutoScaleVmGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableAutoScaleVmGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableAutoScaleVmGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableAutoScaleVmGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableAutoScaleVmGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("disableAutoScaleVmGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableAutoScaleVmGroup API, before the request is signed
+func (p *DisableAutoScaleVmGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableAutoScaleVmGroup", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableAutoScaleVmGroupParams) Clone() *DisableAutoScaleVmGroupParams {
+	return &DisableAutoScaleVmGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1896,32 +2738,39 @@ func (s *AutoScaleService) NewDisableAutoScaleVmGroupParams(id string) *DisableA
 
 // Disables an AutoScale Instance Group
 func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("disableAutoScaleVmGroup", p.toURLValues())
+	return s.DisableAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// DisableAutoScaleVmGroupWithContext is like DisableAutoScaleVmGroup, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) DisableAutoScaleVmGroupWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableAutoScaleVmGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableAutoScaleVmGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1929,36 +2778,61 @@ func (s *AutoScaleService) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupPar
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableAutoScaleVmGroup async job into r
+func (r *DisableAutoScaleVmGroupResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DisableAutoScaleVmGroupAsync starts the async job of DisableAutoScaleVmGroup without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) DisableAutoScaleVmGroupAsync(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*Job, error) {
+	r, err := s.DisableAutoScaleVmGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableAutoScaleVmGroup", r.JobID), nil
+}
+
 type DisableAutoScaleVmGroupResponse struct {
-	Account                      string             `json:"account"`
-	Associatednetworkid          string             `json:"associatednetworkid"`
-	Associatednetworkname        string             `json:"associatednetworkname"`
-	Availablevirtualmachinecount int                `json:"availablevirtualmachinecount"`
-	Created                      string             `json:"created"`
-	Domain                       string             `json:"domain"`
-	Domainid                     string             `json:"domainid"`
-	Domainpath                   string             `json:"domainpath"`
-	Fordisplay                   bool               `json:"fordisplay"`
-	Hasannotations               bool               `json:"hasannotations"`
-	Id                           string             `json:"id"`
-	Interval                     int                `json:"interval"`
-	JobID                        string             `json:"jobid"`
-	Jobstatus                    int                `json:"jobstatus"`
-	Lbprovider                   string             `json:"lbprovider"`
-	Lbruleid                     string             `json:"lbruleid"`
-	Maxmembers                   int                `json:"maxmembers"`
-	Minmembers                   int                `json:"minmembers"`
-	Name                         string             `json:"name"`
-	Privateport                  string             `json:"privateport"`
-	Project                      string             `json:"project"`
-	Projectid                    string             `json:"projectid"`
-	Publicip                     string             `json:"publicip"`
-	Publicipid                   string             `json:"publicipid"`
-	Publicport                   string             `json:"publicport"`
-	Scaledownpolicies            []*AutoScalePolicy `json:"scaledownpolicies"`
-	Scaleuppolicies              []*AutoScalePolicy `json:"scaleuppolicies"`
-	State                        string             `json:"state"`
-	Vmprofileid                  string             `json:"vmprofileid"`
+	Account                      string                     `json:"account"`
+	Associatednetworkid          string                     `json:"associatednetworkid"`
+	Associatednetworkname        string                     `json:"associatednetworkname"`
+	Availablevirtualmachinecount FlexInt                    `json:"availablevirtualmachinecount"`
+	Created                      Time                       `json:"created"`
+	Domain                       string                     `json:"domain"`
+	Domainid                     string                     `json:"domainid"`
+	Domainpath                   string                     `json:"domainpath"`
+	Fordisplay                   FlexBool                   `json:"fordisplay"`
+	Hasannotations               FlexBool                   `json:"hasannotations"`
+	Id                           string                     `json:"id"`
+	Interval                     FlexInt                    `json:"interval"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Lbprovider                   string                     `json:"lbprovider"`
+	Lbruleid                     string                     `json:"lbruleid"`
+	Maxmembers                   FlexInt                    `json:"maxmembers"`
+	Minmembers                   FlexInt                    `json:"minmembers"`
+	Name                         string                     `json:"name"`
+	Privateport                  string                     `json:"privateport"`
+	Project                      string                     `json:"project"`
+	Projectid                    string                     `json:"projectid"`
+	Publicip                     string                     `json:"publicip"`
+	Publicipid                   string                     `json:"publicipid"`
+	Publicport                   string                     `json:"publicport"`
+	Scaledownpolicies            []*AutoScalePolicy         `json:"scaledownpolicies"`
+	Scaleuppolicies              []*AutoScalePolicy         `json:"scaleuppolicies"`
+	State                        string                     `json:"state"`
+	Vmprofileid                  string                     `json:"vmprofileid"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableAutoScaleVmGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableAutoScaleVmGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableAutoScaleVmGroupParams struct {
@@ -1976,6 +2850,44 @@ func (p *EnableAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableAutoScaleVmGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableAutoScaleVmGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableAutoScaleVmGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableAutoScaleVmGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableAutoScaleVmGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("enableAutoScaleVmGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableAutoScaleVmGroup API, before the request is signed
+func (p *EnableAutoScaleVmGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableAutoScaleVmGroup", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableAutoScaleVmGroupParams) Clone() *EnableAutoScaleVmGroupParams {
+	return &EnableAutoScaleVmGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableAutoScaleVmGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2008,32 +2920,39 @@ func (s *AutoScaleService) NewEnableAutoScaleVmGroupParams(id string) *EnableAut
 
 // Enables an AutoScale Instance Group
 func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("enableAutoScaleVmGroup", p.toURLValues())
+	return s.EnableAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// EnableAutoScaleVmGroupWithContext is like EnableAutoScaleVmGroup, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) EnableAutoScaleVmGroupWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableAutoScaleVmGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableAutoScaleVmGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2041,36 +2960,61 @@ func (s *AutoScaleService) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableAutoScaleVmGroup async job into r
+func (r *EnableAutoScaleVmGroupResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// EnableAutoScaleVmGroupAsync starts the async job of EnableAutoScaleVmGroup without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) EnableAutoScaleVmGroupAsync(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*Job, error) {
+	r, err := s.EnableAutoScaleVmGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableAutoScaleVmGroup", r.JobID), nil
+}
+
 type EnableAutoScaleVmGroupResponse struct {
-	Account                      string             `json:"account"`
-	Associatednetworkid          string             `json:"associatednetworkid"`
-	Associatednetworkname        string             `json:"associatednetworkname"`
-	Availablevirtualmachinecount int                `json:"availablevirtualmachinecount"`
-	Created                      string             `json:"created"`
-	Domain                       string             `json:"domain"`
-	Domainid                     string             `json:"domainid"`
-	Domainpath                   string             `json:"domainpath"`
-	Fordisplay                   bool               `json:"fordisplay"`
-	Hasannotations               bool               `json:"hasannotations"`
-	Id                           string             `json:"id"`
-	Interval                     int                `json:"interval"`
-	JobID                        string             `json:"jobid"`
-	Jobstatus                    int                `json:"jobstatus"`
-	Lbprovider                   string             `json:"lbprovider"`
-	Lbruleid                     string             `json:"lbruleid"`
-	Maxmembers                   int                `json:"maxmembers"`
-	Minmembers                   int                `json:"minmembers"`
-	Name                         string             `json:"name"`
-	Privateport                  string             `json:"privateport"`
-	Project                      string             `json:"project"`
-	Projectid                    string             `json:"projectid"`
-	Publicip                     string             `json:"publicip"`
-	Publicipid                   string             `json:"publicipid"`
-	Publicport                   string             `json:"publicport"`
-	Scaledownpolicies            []*AutoScalePolicy `json:"scaledownpolicies"`
-	Scaleuppolicies              []*AutoScalePolicy `json:"scaleuppolicies"`
-	State                        string             `json:"state"`
-	Vmprofileid                  string             `json:"vmprofileid"`
+	Account                      string                     `json:"account"`
+	Associatednetworkid          string                     `json:"associatednetworkid"`
+	Associatednetworkname        string                     `json:"associatednetworkname"`
+	Availablevirtualmachinecount FlexInt                    `json:"availablevirtualmachinecount"`
+	Created                      Time                       `json:"created"`
+	Domain                       string                     `json:"domain"`
+	Domainid                     string                     `json:"domainid"`
+	Domainpath                   string                     `json:"domainpath"`
+	Fordisplay                   FlexBool                   `json:"fordisplay"`
+	Hasannotations               FlexBool                   `json:"hasannotations"`
+	Id                           string                     `json:"id"`
+	Interval                     FlexInt                    `json:"interval"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Lbprovider                   string                     `json:"lbprovider"`
+	Lbruleid                     string                     `json:"lbruleid"`
+	Maxmembers                   FlexInt                    `json:"maxmembers"`
+	Minmembers                   FlexInt                    `json:"minmembers"`
+	Name                         string                     `json:"name"`
+	Privateport                  string                     `json:"privateport"`
+	Project                      string                     `json:"project"`
+	Projectid                    string                     `json:"projectid"`
+	Publicip                     string                     `json:"publicip"`
+	Publicipid                   string                     `json:"publicipid"`
+	Publicport                   string                     `json:"publicport"`
+	Scaledownpolicies            []*AutoScalePolicy         `json:"scaledownpolicies"`
+	Scaleuppolicies              []*AutoScalePolicy         `json:"scaleuppolicies"`
+	State                        string                     `json:"state"`
+	Vmprofileid                  string                     `json:"vmprofileid"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableAutoScaleVmGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableAutoScaleVmGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAutoScalePoliciesParams struct {
@@ -2128,6 +3072,55 @@ func (p *ListAutoScalePoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAutoScalePolicies API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAutoScalePoliciesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAutoScalePolicies", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAutoScalePolicies API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAutoScalePoliciesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "action": 255, "conditionid": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "vmgroupid": 255},
+	}.validate("listAutoScalePolicies", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAutoScalePolicies API, before the request is signed
+func (p *ListAutoScalePoliciesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAutoScalePoliciesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAutoScalePoliciesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAutoScalePolicies", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"action":      decodeParam[string],
+		"conditionid": decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"vmgroupid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAutoScalePoliciesParams) Clone() *ListAutoScalePoliciesParams {
+	return &ListAutoScalePoliciesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAutoScalePoliciesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2411,6 +3404,11 @@ func (s *AutoScaleService) NewListAutoScalePoliciesParams() *ListAutoScalePolici
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScalePolicyID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAutoScalePolicyIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAutoScalePolicyIDWithContext is like GetAutoScalePolicyID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetAutoScalePolicyIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAutoScalePoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2422,7 +3420,7 @@ func (s *AutoScaleService) GetAutoScalePolicyID(name string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListAutoScalePolicies(p)
+	l, err := s.ListAutoScalePoliciesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2447,12 +3445,17 @@ func (s *AutoScaleService) GetAutoScalePolicyID(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScalePolicyByName(name string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
-	id, count, err := s.GetAutoScalePolicyID(name, opts...)
+	return s.GetAutoScalePolicyByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAutoScalePolicyByNameWithContext is like GetAutoScalePolicyByName, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetAutoScalePolicyByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
+	id, count, err := s.GetAutoScalePolicyIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAutoScalePolicyByID(id, opts...)
+	r, count, err := s.GetAutoScalePolicyByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2461,6 +3464,11 @@ func (s *AutoScaleService) GetAutoScalePolicyByName(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
+	return s.GetAutoScalePolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAutoScalePolicyByIDWithContext is like GetAutoScalePolicyByID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetAutoScalePolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
 	p := &ListAutoScalePoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2472,11 +3480,10 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListAutoScalePolicies(p)
+	l, err := s.ListAutoScalePoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2494,17 +3501,54 @@ func (s *AutoScaleService) GetAutoScalePolicyByID(id string, opts ...OptionFunc)
 
 // Lists autoscale policies.
 func (s *AutoScaleService) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScalePolicies", p.toURLValues())
+	return s.ListAutoScalePoliciesWithContext(context.Background(), p)
+}
+
+// ListAutoScalePoliciesWithContext is like ListAutoScalePolicies, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScalePoliciesWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAutoScalePolicies", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListAutoScalePoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+	var r ListAutoScalePoliciesResponse
+	if err := s.cs.decodeResponse("listAutoScalePolicies", resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListAutoScalePoliciesAll returns all AutoScalePolicies matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AutoScaleService) ListAutoScalePoliciesAll(p *ListAutoScalePoliciesParams, opts ...PageOption) ([]*AutoScalePolicy, error) {
+	return s.ListAutoScalePoliciesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAutoScalePoliciesAllWithContext is like ListAutoScalePoliciesAll, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScalePoliciesAllWithContext(ctx context.Context, p *ListAutoScalePoliciesParams, opts ...PageOption) ([]*AutoScalePolicy, error) {
+	return collectPages(s.ListAutoScalePoliciesIterWithContext(ctx, p, opts...))
+}
+
+// ListAutoScalePoliciesIter returns an iterator over all AutoScalePolicies matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AutoScaleService) ListAutoScalePoliciesIter(p *ListAutoScalePoliciesParams, opts ...PageOption) iter.Seq2[*AutoScalePolicy, error] {
+	return s.ListAutoScalePoliciesIterWithContext(context.Background(), p, opts...)
+}
 
-	return &r, nil
+// ListAutoScalePoliciesIterWithContext is like ListAutoScalePoliciesIter, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScalePoliciesIterWithContext(ctx context.Context, p *ListAutoScalePoliciesParams, opts ...PageOption) iter.Seq2[*AutoScalePolicy, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*AutoScalePolicy, int, error) {
+		l, err := s.ListAutoScalePoliciesWithContext(ctx, &ListAutoScalePoliciesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.AutoScalePolicies, l.Count, nil
+	}, opts...)
 }
 
 type ListAutoScalePoliciesResponse struct {
@@ -2513,20 +3557,26 @@ type ListAutoScalePoliciesResponse struct {
 }
 
 type AutoScalePolicy struct {
-	Account    string       `json:"account"`
-	Action     string       `json:"action"`
-	Conditions []*Condition `json:"conditions"`
-	Domain     string       `json:"domain"`
-	Domainid   string       `json:"domainid"`
-	Domainpath string       `json:"domainpath"`
-	Duration   int          `json:"duration"`
-	Id         string       `json:"id"`
-	JobID      string       `json:"jobid"`
-	Jobstatus  int          `json:"jobstatus"`
-	Name       string       `json:"name"`
-	Project    string       `json:"project"`
-	Projectid  string       `json:"projectid"`
-	Quiettime  int          `json:"quiettime"`
+	Account    string                     `json:"account"`
+	Action     string                     `json:"action"`
+	Conditions []*Condition               `json:"conditions"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Duration   FlexInt                    `json:"duration"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Quiettime  FlexInt                    `json:"quiettime"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AutoScalePolicy) UnmarshalJSON(b []byte) error {
+	type alias AutoScalePolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAutoScaleVmGroupsParams struct {
@@ -2591,6 +3641,57 @@ func (p *ListAutoScaleVmGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAutoScaleVmGroups API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAutoScaleVmGroupsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAutoScaleVmGroups", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAutoScaleVmGroups API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAutoScaleVmGroupsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "lbruleid": 255, "name": 255, "policyid": 255, "projectid": 255, "vmprofileid": 255, "zoneid": 255},
+	}.validate("listAutoScaleVmGroups", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAutoScaleVmGroups API, before the request is signed
+func (p *ListAutoScaleVmGroupsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAutoScaleVmGroupsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAutoScaleVmGroupsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAutoScaleVmGroups", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"lbruleid":    decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"policyid":    decodeParam[string],
+		"projectid":   decodeParam[string],
+		"vmprofileid": decodeParam[string],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAutoScaleVmGroupsParams) Clone() *ListAutoScaleVmGroupsParams {
+	return &ListAutoScaleVmGroupsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAutoScaleVmGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2916,6 +4017,11 @@ func (s *AutoScaleService) NewListAutoScaleVmGroupsParams() *ListAutoScaleVmGrou
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAutoScaleVmGroupIDWithContext(context.Background(), name, opts...)
+}
+
+// GetAutoScaleVmGroupIDWithContext is like GetAutoScaleVmGroupID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetAutoScaleVmGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAutoScaleVmGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2927,7 +4033,7 @@ func (s *AutoScaleService) GetAutoScaleVmGroupID(name string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListAutoScaleVmGroups(p)
+	l, err := s.ListAutoScaleVmGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2952,12 +4058,17 @@ func (s *AutoScaleService) GetAutoScaleVmGroupID(name string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmGroupByName(name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
-	id, count, err := s.GetAutoScaleVmGroupID(name, opts...)
+	return s.GetAutoScaleVmGroupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetAutoScaleVmGroupByNameWithContext is like GetAutoScaleVmGroupByName, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetAutoScaleVmGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+	id, count, err := s.GetAutoScaleVmGroupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetAutoScaleVmGroupByID(id, opts...)
+	r, count, err := s.GetAutoScaleVmGroupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2966,6 +4077,11 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByName(name string, opts ...Option
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+	return s.GetAutoScaleVmGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAutoScaleVmGroupByIDWithContext is like GetAutoScaleVmGroupByID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetAutoScaleVmGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
 	p := &ListAutoScaleVmGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2977,11 +4093,10 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListAutoScaleVmGroups(p)
+	l, err := s.ListAutoScaleVmGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2999,54 +4114,97 @@ func (s *AutoScaleService) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc
 
 // Lists autoscale Instance groups.
 func (s *AutoScaleService) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmGroups", p.toURLValues())
+	return s.ListAutoScaleVmGroupsWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmGroupsWithContext is like ListAutoScaleVmGroups, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScaleVmGroupsWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAutoScaleVmGroups", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAutoScaleVmGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAutoScaleVmGroups", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAutoScaleVmGroupsAll returns all AutoScaleVmGroups matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AutoScaleService) ListAutoScaleVmGroupsAll(p *ListAutoScaleVmGroupsParams, opts ...PageOption) ([]*AutoScaleVmGroup, error) {
+	return s.ListAutoScaleVmGroupsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAutoScaleVmGroupsAllWithContext is like ListAutoScaleVmGroupsAll, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScaleVmGroupsAllWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams, opts ...PageOption) ([]*AutoScaleVmGroup, error) {
+	return collectPages(s.ListAutoScaleVmGroupsIterWithContext(ctx, p, opts...))
+}
+
+// ListAutoScaleVmGroupsIter returns an iterator over all AutoScaleVmGroups matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AutoScaleService) ListAutoScaleVmGroupsIter(p *ListAutoScaleVmGroupsParams, opts ...PageOption) iter.Seq2[*AutoScaleVmGroup, error] {
+	return s.ListAutoScaleVmGroupsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAutoScaleVmGroupsIterWithContext is like ListAutoScaleVmGroupsIter, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScaleVmGroupsIterWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams, opts ...PageOption) iter.Seq2[*AutoScaleVmGroup, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*AutoScaleVmGroup, int, error) {
+		l, err := s.ListAutoScaleVmGroupsWithContext(ctx, &ListAutoScaleVmGroupsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.AutoScaleVmGroups, l.Count, nil
+	}, opts...)
+}
+
 type ListAutoScaleVmGroupsResponse struct {
 	Count             int                 `json:"count"`
 	AutoScaleVmGroups []*AutoScaleVmGroup `json:"autoscalevmgroup"`
 }
 
 type AutoScaleVmGroup struct {
-	Account                      string             `json:"account"`
-	Associatednetworkid          string             `json:"associatednetworkid"`
-	Associatednetworkname        string             `json:"associatednetworkname"`
-	Availablevirtualmachinecount int                `json:"availablevirtualmachinecount"`
-	Created                      string             `json:"created"`
-	Domain                       string             `json:"domain"`
-	Domainid                     string             `json:"domainid"`
-	Domainpath                   string             `json:"domainpath"`
-	Fordisplay                   bool               `json:"fordisplay"`
-	Hasannotations               bool               `json:"hasannotations"`
-	Id                           string             `json:"id"`
-	Interval                     int                `json:"interval"`
-	JobID                        string             `json:"jobid"`
-	Jobstatus                    int                `json:"jobstatus"`
-	Lbprovider                   string             `json:"lbprovider"`
-	Lbruleid                     string             `json:"lbruleid"`
-	Maxmembers                   int                `json:"maxmembers"`
-	Minmembers                   int                `json:"minmembers"`
-	Name                         string             `json:"name"`
-	Privateport                  string             `json:"privateport"`
-	Project                      string             `json:"project"`
-	Projectid                    string             `json:"projectid"`
-	Publicip                     string             `json:"publicip"`
-	Publicipid                   string             `json:"publicipid"`
-	Publicport                   string             `json:"publicport"`
-	Scaledownpolicies            []*AutoScalePolicy `json:"scaledownpolicies"`
-	Scaleuppolicies              []*AutoScalePolicy `json:"scaleuppolicies"`
-	State                        string             `json:"state"`
-	Vmprofileid                  string             `json:"vmprofileid"`
+	Account                      string                     `json:"account"`
+	Associatednetworkid          string                     `json:"associatednetworkid"`
+	Associatednetworkname        string                     `json:"associatednetworkname"`
+	Availablevirtualmachinecount FlexInt                    `json:"availablevirtualmachinecount"`
+	Created                      Time                       `json:"created"`
+	Domain                       string                     `json:"domain"`
+	Domainid                     string                     `json:"domainid"`
+	Domainpath                   string                     `json:"domainpath"`
+	Fordisplay                   FlexBool                   `json:"fordisplay"`
+	Hasannotations               FlexBool                   `json:"hasannotations"`
+	Id                           string                     `json:"id"`
+	Interval                     FlexInt                    `json:"interval"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Lbprovider                   string                     `json:"lbprovider"`
+	Lbruleid                     string                     `json:"lbruleid"`
+	Maxmembers                   FlexInt                    `json:"maxmembers"`
+	Minmembers                   FlexInt                    `json:"minmembers"`
+	Name                         string                     `json:"name"`
+	Privateport                  string                     `json:"privateport"`
+	Project                      string                     `json:"project"`
+	Projectid                    string                     `json:"projectid"`
+	Publicip                     string                     `json:"publicip"`
+	Publicipid                   string                     `json:"publicipid"`
+	Publicport                   string                     `json:"publicport"`
+	Scaledownpolicies            []*AutoScalePolicy         `json:"scaledownpolicies"`
+	Scaleuppolicies              []*AutoScalePolicy         `json:"scaleuppolicies"`
+	State                        string                     `json:"state"`
+	Vmprofileid                  string                     `json:"vmprofileid"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AutoScaleVmGroup) UnmarshalJSON(b []byte) error {
+	type alias AutoScaleVmGroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAutoScaleVmProfilesParams struct {
@@ -3108,6 +4266,56 @@ func (p *ListAutoScaleVmProfilesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAutoScaleVmProfiles API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAutoScaleVmProfilesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAutoScaleVmProfiles", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAutoScaleVmProfiles API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAutoScaleVmProfilesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "otherdeployparams": 255, "projectid": 255, "serviceofferingid": 255, "templateid": 255, "zoneid": 255},
+	}.validate("listAutoScaleVmProfiles", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAutoScaleVmProfiles API, before the request is signed
+func (p *ListAutoScaleVmProfilesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAutoScaleVmProfilesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAutoScaleVmProfilesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAutoScaleVmProfiles", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"domainid":          decodeParam[string],
+		"fordisplay":        decodeParam[bool],
+		"id":                decodeParam[string],
+		"isrecursive":       decodeParam[bool],
+		"keyword":           decodeParam[string],
+		"listall":           decodeParam[bool],
+		"otherdeployparams": decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"projectid":         decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+		"templateid":        decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAutoScaleVmProfilesParams) Clone() *ListAutoScaleVmProfilesParams {
+	return &ListAutoScaleVmProfilesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAutoScaleVmProfilesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3412,6 +4620,11 @@ func (s *AutoScaleService) NewListAutoScaleVmProfilesParams() *ListAutoScaleVmPr
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
+	return s.GetAutoScaleVmProfileByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetAutoScaleVmProfileByIDWithContext is like GetAutoScaleVmProfileByID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetAutoScaleVmProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
 	p := &ListAutoScaleVmProfilesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3423,11 +4636,10 @@ func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFu
 		}
 	}
 
-	l, err := s.ListAutoScaleVmProfiles(p)
+	l, err := s.ListAutoScaleVmProfilesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3445,46 +4657,89 @@ func (s *AutoScaleService) GetAutoScaleVmProfileByID(id string, opts ...OptionFu
 
 // Lists autoscale Instance profiles.
 func (s *AutoScaleService) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listAutoScaleVmProfiles", p.toURLValues())
+	return s.ListAutoScaleVmProfilesWithContext(context.Background(), p)
+}
+
+// ListAutoScaleVmProfilesWithContext is like ListAutoScaleVmProfiles, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScaleVmProfilesWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAutoScaleVmProfiles", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAutoScaleVmProfilesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAutoScaleVmProfiles", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAutoScaleVmProfilesAll returns all AutoScaleVmProfiles matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AutoScaleService) ListAutoScaleVmProfilesAll(p *ListAutoScaleVmProfilesParams, opts ...PageOption) ([]*AutoScaleVmProfile, error) {
+	return s.ListAutoScaleVmProfilesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAutoScaleVmProfilesAllWithContext is like ListAutoScaleVmProfilesAll, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScaleVmProfilesAllWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams, opts ...PageOption) ([]*AutoScaleVmProfile, error) {
+	return collectPages(s.ListAutoScaleVmProfilesIterWithContext(ctx, p, opts...))
+}
+
+// ListAutoScaleVmProfilesIter returns an iterator over all AutoScaleVmProfiles matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AutoScaleService) ListAutoScaleVmProfilesIter(p *ListAutoScaleVmProfilesParams, opts ...PageOption) iter.Seq2[*AutoScaleVmProfile, error] {
+	return s.ListAutoScaleVmProfilesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAutoScaleVmProfilesIterWithContext is like ListAutoScaleVmProfilesIter, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListAutoScaleVmProfilesIterWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams, opts ...PageOption) iter.Seq2[*AutoScaleVmProfile, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*AutoScaleVmProfile, int, error) {
+		l, err := s.ListAutoScaleVmProfilesWithContext(ctx, &ListAutoScaleVmProfilesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.AutoScaleVmProfiles, l.Count, nil
+	}, opts...)
+}
+
 type ListAutoScaleVmProfilesResponse struct {
 	Count               int                   `json:"count"`
 	AutoScaleVmProfiles []*AutoScaleVmProfile `json:"autoscalevmprofile"`
 }
 
 type AutoScaleVmProfile struct {
-	Account              string            `json:"account"`
-	Autoscaleuserid      string            `json:"autoscaleuserid"`
-	Domain               string            `json:"domain"`
-	Domainid             string            `json:"domainid"`
-	Domainpath           string            `json:"domainpath"`
-	Expungevmgraceperiod int               `json:"expungevmgraceperiod"`
-	Fordisplay           bool              `json:"fordisplay"`
-	Id                   string            `json:"id"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Otherdeployparams    map[string]string `json:"otherdeployparams"`
-	Project              string            `json:"project"`
-	Projectid            string            `json:"projectid"`
-	Serviceofferingid    string            `json:"serviceofferingid"`
-	Templateid           string            `json:"templateid"`
-	Userdata             string            `json:"userdata"`
-	Userdatadetails      string            `json:"userdatadetails"`
-	Userdataid           string            `json:"userdataid"`
-	Userdataname         string            `json:"userdataname"`
-	Userdatapolicy       string            `json:"userdatapolicy"`
-	Zoneid               string            `json:"zoneid"`
+	Account              string                     `json:"account"`
+	Autoscaleuserid      string                     `json:"autoscaleuserid"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Expungevmgraceperiod FlexInt                    `json:"expungevmgraceperiod"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Otherdeployparams    map[string]string          `json:"otherdeployparams"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Serviceofferingid    string                     `json:"serviceofferingid"`
+	Templateid           string                     `json:"templateid"`
+	Userdata             string                     `json:"userdata"`
+	Userdatadetails      string                     `json:"userdatadetails"`
+	Userdataid           string                     `json:"userdataid"`
+	Userdataname         string                     `json:"userdataname"`
+	Userdatapolicy       string                     `json:"userdatapolicy"`
+	Zoneid               string                     `json:"zoneid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AutoScaleVmProfile) UnmarshalJSON(b []byte) error {
+	type alias AutoScaleVmProfile
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListConditionsParams struct {
@@ -3536,6 +4791,53 @@ func (p *ListConditionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listConditions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListConditionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listConditions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listConditions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListConditionsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "counterid": 255, "domainid": 255, "id": 255, "keyword": 255, "policyid": 255, "projectid": 255},
+	}.validate("listConditions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listConditions API, before the request is signed
+func (p *ListConditionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListConditionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListConditionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listConditions", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"counterid":   decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"policyid":    decodeParam[string],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListConditionsParams) Clone() *ListConditionsParams {
+	return &ListConditionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListConditionsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3777,6 +5079,11 @@ func (s *AutoScaleService) NewListConditionsParams() *ListConditionsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error) {
+	return s.GetConditionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetConditionByIDWithContext is like GetConditionByID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetConditionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Condition, int, error) {
 	p := &ListConditionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3788,11 +5095,10 @@ func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Con
 		}
 	}
 
-	l, err := s.ListConditions(p)
+	l, err := s.ListConditionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3810,40 +5116,83 @@ func (s *AutoScaleService) GetConditionByID(id string, opts ...OptionFunc) (*Con
 
 // List Conditions for Instance auto scaling
 func (s *AutoScaleService) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
-	resp, err := s.cs.newRequest("listConditions", p.toURLValues())
+	return s.ListConditionsWithContext(context.Background(), p)
+}
+
+// ListConditionsWithContext is like ListConditions, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListConditionsWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listConditions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListConditionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listConditions", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListConditionsAll returns all Conditions matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AutoScaleService) ListConditionsAll(p *ListConditionsParams, opts ...PageOption) ([]*Condition, error) {
+	return s.ListConditionsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListConditionsAllWithContext is like ListConditionsAll, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListConditionsAllWithContext(ctx context.Context, p *ListConditionsParams, opts ...PageOption) ([]*Condition, error) {
+	return collectPages(s.ListConditionsIterWithContext(ctx, p, opts...))
+}
+
+// ListConditionsIter returns an iterator over all Conditions matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AutoScaleService) ListConditionsIter(p *ListConditionsParams, opts ...PageOption) iter.Seq2[*Condition, error] {
+	return s.ListConditionsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListConditionsIterWithContext is like ListConditionsIter, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListConditionsIterWithContext(ctx context.Context, p *ListConditionsParams, opts ...PageOption) iter.Seq2[*Condition, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Condition, int, error) {
+		l, err := s.ListConditionsWithContext(ctx, &ListConditionsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Conditions, l.Count, nil
+	}, opts...)
+}
+
 type ListConditionsResponse struct {
 	Count      int          `json:"count"`
 	Conditions []*Condition `json:"condition"`
 }
 
 type Condition struct {
-	Account            string   `json:"account"`
-	Counter            *Counter `json:"counter"`
-	Counterid          string   `json:"counterid"`
-	Countername        string   `json:"countername"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	JobID              string   `json:"jobid"`
-	Jobstatus          int      `json:"jobstatus"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Relationaloperator string   `json:"relationaloperator"`
-	Threshold          int64    `json:"threshold"`
-	Zoneid             string   `json:"zoneid"`
+	Account            string                     `json:"account"`
+	Counter            *Counter                   `json:"counter"`
+	Counterid          string                     `json:"counterid"`
+	Countername        string                     `json:"countername"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Relationaloperator string                     `json:"relationaloperator"`
+	Threshold          FlexInt64                  `json:"threshold"`
+	Zoneid             string                     `json:"zoneid"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Condition) UnmarshalJSON(b []byte) error {
+	type alias Condition
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListCountersParams struct {
@@ -3881,6 +5230,49 @@ func (p *ListCountersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listCounters API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListCountersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listCounters", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listCounters API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListCountersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "provider": 255, "source": 255},
+	}.validate("listCounters", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listCounters API, before the request is signed
+func (p *ListCountersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListCountersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListCountersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listCounters", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"provider": decodeParam[string],
+		"source":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListCountersParams) Clone() *ListCountersParams {
+	return &ListCountersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListCountersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4038,6 +5430,11 @@ func (s *AutoScaleService) NewListCountersParams() *ListCountersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetCounterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetCounterIDWithContext is like GetCounterID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetCounterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListCountersParams{}
 	p.p = make(map[string]interface{})
 
@@ -4049,7 +5446,7 @@ func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListCounters(p)
+	l, err := s.ListCountersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4074,12 +5471,17 @@ func (s *AutoScaleService) GetCounterID(name string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error) {
-	id, count, err := s.GetCounterID(name, opts...)
+	return s.GetCounterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetCounterByNameWithContext is like GetCounterByName, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetCounterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Counter, int, error) {
+	id, count, err := s.GetCounterIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetCounterByID(id, opts...)
+	r, count, err := s.GetCounterByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4088,6 +5490,11 @@ func (s *AutoScaleService) GetCounterByName(name string, opts ...OptionFunc) (*C
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error) {
+	return s.GetCounterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetCounterByIDWithContext is like GetCounterByID, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) GetCounterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Counter, int, error) {
 	p := &ListCountersParams{}
 	p.p = make(map[string]interface{})
 
@@ -4099,11 +5506,10 @@ func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Count
 		}
 	}
 
-	l, err := s.ListCounters(p)
+	l, err := s.ListCountersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4121,33 +5527,76 @@ func (s *AutoScaleService) GetCounterByID(id string, opts ...OptionFunc) (*Count
 
 // List the counters for Instance auto scaling
 func (s *AutoScaleService) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
-	resp, err := s.cs.newRequest("listCounters", p.toURLValues())
+	return s.ListCountersWithContext(context.Background(), p)
+}
+
+// ListCountersWithContext is like ListCounters, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListCountersWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listCounters", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCountersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listCounters", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListCountersAll returns all Counters matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *AutoScaleService) ListCountersAll(p *ListCountersParams, opts ...PageOption) ([]*Counter, error) {
+	return s.ListCountersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListCountersAllWithContext is like ListCountersAll, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListCountersAllWithContext(ctx context.Context, p *ListCountersParams, opts ...PageOption) ([]*Counter, error) {
+	return collectPages(s.ListCountersIterWithContext(ctx, p, opts...))
+}
+
+// ListCountersIter returns an iterator over all Counters matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *AutoScaleService) ListCountersIter(p *ListCountersParams, opts ...PageOption) iter.Seq2[*Counter, error] {
+	return s.ListCountersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListCountersIterWithContext is like ListCountersIter, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) ListCountersIterWithContext(ctx context.Context, p *ListCountersParams, opts ...PageOption) iter.Seq2[*Counter, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Counter, int, error) {
+		l, err := s.ListCountersWithContext(ctx, &ListCountersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Counters, l.Count, nil
+	}, opts...)
+}
+
 type ListCountersResponse struct {
 	Count    int        `json:"count"`
 	Counters []*Counter `json:"counter"`
 }
 
 type Counter struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Provider  string `json:"provider"`
-	Source    string `json:"source"`
-	Value     string `json:"value"`
-	Zoneid    string `json:"zoneid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Provider  string                     `json:"provider"`
+	Source    string                     `json:"source"`
+	Value     string                     `json:"value"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Counter) UnmarshalJSON(b []byte) error {
+	type alias Counter
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateAutoScalePolicyParams struct {
@@ -4180,6 +5629,48 @@ func (p *UpdateAutoScalePolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateAutoScalePolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateAutoScalePolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateAutoScalePolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateAutoScalePolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateAutoScalePolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "name": 255},
+	}.validate("updateAutoScalePolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateAutoScalePolicy API, before the request is signed
+func (p *UpdateAutoScalePolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateAutoScalePolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateAutoScalePolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateAutoScalePolicy", b, &p.p, map[string]paramDecoder{
+		"conditionids": decodeParam[[]string],
+		"duration":     decodeParam[int],
+		"id":           decodeParam[string],
+		"name":         decodeParam[string],
+		"quiettime":    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateAutoScalePolicyParams) Clone() *UpdateAutoScalePolicyParams {
+	return &UpdateAutoScalePolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateAutoScalePolicyParams) SetConditionids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4296,32 +5787,39 @@ func (s *AutoScaleService) NewUpdateAutoScalePolicyParams(id string) *UpdateAuto
 
 // Updates an existing autoscale policy.
 func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("updateAutoScalePolicy", p.toURLValues())
+	return s.UpdateAutoScalePolicyWithContext(context.Background(), p)
+}
+
+// UpdateAutoScalePolicyWithContext is like UpdateAutoScalePolicy, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) UpdateAutoScalePolicyWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateAutoScalePolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateAutoScalePolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateAutoScalePolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateAutoScalePolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateAutoScalePolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4329,21 +5827,46 @@ func (s *AutoScaleService) UpdateAutoScalePolicy(p *UpdateAutoScalePolicyParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateAutoScalePolicy async job into r
+func (r *UpdateAutoScalePolicyResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateAutoScalePolicyAsync starts the async job of UpdateAutoScalePolicy without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) UpdateAutoScalePolicyAsync(ctx context.Context, p *UpdateAutoScalePolicyParams) (*Job, error) {
+	r, err := s.UpdateAutoScalePolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateAutoScalePolicy", r.JobID), nil
+}
+
 type UpdateAutoScalePolicyResponse struct {
-	Account    string       `json:"account"`
-	Action     string       `json:"action"`
-	Conditions []*Condition `json:"conditions"`
-	Domain     string       `json:"domain"`
-	Domainid   string       `json:"domainid"`
-	Domainpath string       `json:"domainpath"`
-	Duration   int          `json:"duration"`
-	Id         string       `json:"id"`
-	JobID      string       `json:"jobid"`
-	Jobstatus  int          `json:"jobstatus"`
-	Name       string       `json:"name"`
-	Project    string       `json:"project"`
-	Projectid  string       `json:"projectid"`
-	Quiettime  int          `json:"quiettime"`
+	Account    string                     `json:"account"`
+	Action     string                     `json:"action"`
+	Conditions []*Condition               `json:"conditions"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Duration   FlexInt                    `json:"duration"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Quiettime  FlexInt                    `json:"quiettime"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateAutoScalePolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateAutoScalePolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateAutoScaleVmGroupParams struct {
@@ -4391,6 +5914,52 @@ func (p *UpdateAutoScaleVmGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateAutoScaleVmGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateAutoScaleVmGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateAutoScaleVmGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateAutoScaleVmGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateAutoScaleVmGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255, "name": 255},
+	}.validate("updateAutoScaleVmGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateAutoScaleVmGroup API, before the request is signed
+func (p *UpdateAutoScaleVmGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateAutoScaleVmGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateAutoScaleVmGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateAutoScaleVmGroup", b, &p.p, map[string]paramDecoder{
+		"customid":           decodeParam[string],
+		"fordisplay":         decodeParam[bool],
+		"id":                 decodeParam[string],
+		"interval":           decodeParam[int],
+		"maxmembers":         decodeParam[int],
+		"minmembers":         decodeParam[int],
+		"name":               decodeParam[string],
+		"scaledownpolicyids": decodeParam[[]string],
+		"scaleuppolicyids":   decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateAutoScaleVmGroupParams) Clone() *UpdateAutoScaleVmGroupParams {
+	return &UpdateAutoScaleVmGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateAutoScaleVmGroupParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4591,32 +6160,39 @@ func (s *AutoScaleService) NewUpdateAutoScaleVmGroupParams(id string) *UpdateAut
 
 // Updates an existing autoscale Instance group.
 func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("updateAutoScaleVmGroup", p.toURLValues())
+	return s.UpdateAutoScaleVmGroupWithContext(context.Background(), p)
+}
+
+// UpdateAutoScaleVmGroupWithContext is like UpdateAutoScaleVmGroup, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateAutoScaleVmGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateAutoScaleVmGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateAutoScaleVmGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateAutoScaleVmGroup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateAutoScaleVmGroup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4624,36 +6200,61 @@ func (s *AutoScaleService) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParam
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateAutoScaleVmGroup async job into r
+func (r *UpdateAutoScaleVmGroupResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateAutoScaleVmGroupAsync starts the async job of UpdateAutoScaleVmGroup without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) UpdateAutoScaleVmGroupAsync(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*Job, error) {
+	r, err := s.UpdateAutoScaleVmGroupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateAutoScaleVmGroup", r.JobID), nil
+}
+
 type UpdateAutoScaleVmGroupResponse struct {
-	Account                      string             `json:"account"`
-	Associatednetworkid          string             `json:"associatednetworkid"`
-	Associatednetworkname        string             `json:"associatednetworkname"`
-	Availablevirtualmachinecount int                `json:"availablevirtualmachinecount"`
-	Created                      string             `json:"created"`
-	Domain                       string             `json:"domain"`
-	Domainid                     string             `json:"domainid"`
-	Domainpath                   string             `json:"domainpath"`
-	Fordisplay                   bool               `json:"fordisplay"`
-	Hasannotations               bool               `json:"hasannotations"`
-	Id                           string             `json:"id"`
-	Interval                     int                `json:"interval"`
-	JobID                        string             `json:"jobid"`
-	Jobstatus                    int                `json:"jobstatus"`
-	Lbprovider                   string             `json:"lbprovider"`
-	Lbruleid                     string             `json:"lbruleid"`
-	Maxmembers                   int                `json:"maxmembers"`
-	Minmembers                   int                `json:"minmembers"`
-	Name                         string             `json:"name"`
-	Privateport                  string             `json:"privateport"`
-	Project                      string             `json:"project"`
-	Projectid                    string             `json:"projectid"`
-	Publicip                     string             `json:"publicip"`
-	Publicipid                   string             `json:"publicipid"`
-	Publicport                   string             `json:"publicport"`
-	Scaledownpolicies            []*AutoScalePolicy `json:"scaledownpolicies"`
-	Scaleuppolicies              []*AutoScalePolicy `json:"scaleuppolicies"`
-	State                        string             `json:"state"`
-	Vmprofileid                  string             `json:"vmprofileid"`
+	Account                      string                     `json:"account"`
+	Associatednetworkid          string                     `json:"associatednetworkid"`
+	Associatednetworkname        string                     `json:"associatednetworkname"`
+	Availablevirtualmachinecount FlexInt                    `json:"availablevirtualmachinecount"`
+	Created                      Time                       `json:"created"`
+	Domain                       string                     `json:"domain"`
+	Domainid                     string                     `json:"domainid"`
+	Domainpath                   string                     `json:"domainpath"`
+	Fordisplay                   FlexBool                   `json:"fordisplay"`
+	Hasannotations               FlexBool                   `json:"hasannotations"`
+	Id                           string                     `json:"id"`
+	Interval                     FlexInt                    `json:"interval"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Lbprovider                   string                     `json:"lbprovider"`
+	Lbruleid                     string                     `json:"lbruleid"`
+	Maxmembers                   FlexInt                    `json:"maxmembers"`
+	Minmembers                   FlexInt                    `json:"minmembers"`
+	Name                         string                     `json:"name"`
+	Privateport                  string                     `json:"privateport"`
+	Project                      string                     `json:"project"`
+	Projectid                    string                     `json:"projectid"`
+	Publicip                     string                     `json:"publicip"`
+	Publicipid                   string                     `json:"publicipid"`
+	Publicport                   string                     `json:"publicport"`
+	Scaledownpolicies            []*AutoScalePolicy         `json:"scaledownpolicies"`
+	Scaleuppolicies              []*AutoScalePolicy         `json:"scaleuppolicies"`
+	State                        string                     `json:"state"`
+	Vmprofileid                  string                     `json:"vmprofileid"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateAutoScaleVmGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateAutoScaleVmGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateAutoScaleVmProfileParams struct {
@@ -4717,6 +6318,55 @@ func (p *UpdateAutoScaleVmProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateAutoScaleVmProfile API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateAutoScaleVmProfileParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateAutoScaleVmProfile", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateAutoScaleVmProfile API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateAutoScaleVmProfileParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"autoscaleuserid": 255, "customid": 255, "id": 255, "serviceofferingid": 255, "templateid": 255, "userdata": 1048576, "userdataid": 255},
+	}.validate("updateAutoScaleVmProfile", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateAutoScaleVmProfile API, before the request is signed
+func (p *UpdateAutoScaleVmProfileParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateAutoScaleVmProfileParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateAutoScaleVmProfileParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateAutoScaleVmProfile", b, &p.p, map[string]paramDecoder{
+		"autoscaleuserid":      decodeParam[string],
+		"counterparam":         decodeParam[map[string]string],
+		"customid":             decodeParam[string],
+		"expungevmgraceperiod": decodeParam[int],
+		"fordisplay":           decodeParam[bool],
+		"id":                   decodeParam[string],
+		"otherdeployparams":    decodeParam[map[string]string],
+		"serviceofferingid":    decodeParam[string],
+		"templateid":           decodeParam[string],
+		"userdata":             decodeParam[string],
+		"userdatadetails":      decodeParam[map[string]string],
+		"userdataid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateAutoScaleVmProfileParams) Clone() *UpdateAutoScaleVmProfileParams {
+	return &UpdateAutoScaleVmProfileParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateAutoScaleVmProfileParams) SetAutoscaleuserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4980,32 +6630,39 @@ func (s *AutoScaleService) NewUpdateAutoScaleVmProfileParams(id string) *UpdateA
 
 // Updates an existing autoscale Instance profile.
 func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
-	resp, err := s.cs.newPostRequest("updateAutoScaleVmProfile", p.toURLValues())
+	return s.UpdateAutoScaleVmProfileWithContext(context.Background(), p)
+}
+
+// UpdateAutoScaleVmProfileWithContext is like UpdateAutoScaleVmProfile, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateAutoScaleVmProfile", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateAutoScaleVmProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateAutoScaleVmProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateAutoScaleVmProfile", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateAutoScaleVmProfile", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5013,28 +6670,53 @@ func (s *AutoScaleService) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateAutoScaleVmProfile async job into r
+func (r *UpdateAutoScaleVmProfileResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateAutoScaleVmProfileAsync starts the async job of UpdateAutoScaleVmProfile without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) UpdateAutoScaleVmProfileAsync(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*Job, error) {
+	r, err := s.UpdateAutoScaleVmProfileWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateAutoScaleVmProfile", r.JobID), nil
+}
+
 type UpdateAutoScaleVmProfileResponse struct {
-	Account              string            `json:"account"`
-	Autoscaleuserid      string            `json:"autoscaleuserid"`
-	Domain               string            `json:"domain"`
-	Domainid             string            `json:"domainid"`
-	Domainpath           string            `json:"domainpath"`
-	Expungevmgraceperiod int               `json:"expungevmgraceperiod"`
-	Fordisplay           bool              `json:"fordisplay"`
-	Id                   string            `json:"id"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Otherdeployparams    map[string]string `json:"otherdeployparams"`
-	Project              string            `json:"project"`
-	Projectid            string            `json:"projectid"`
-	Serviceofferingid    string            `json:"serviceofferingid"`
-	Templateid           string            `json:"templateid"`
-	Userdata             string            `json:"userdata"`
-	Userdatadetails      string            `json:"userdatadetails"`
-	Userdataid           string            `json:"userdataid"`
-	Userdataname         string            `json:"userdataname"`
-	Userdatapolicy       string            `json:"userdatapolicy"`
-	Zoneid               string            `json:"zoneid"`
+	Account              string                     `json:"account"`
+	Autoscaleuserid      string                     `json:"autoscaleuserid"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Expungevmgraceperiod FlexInt                    `json:"expungevmgraceperiod"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Otherdeployparams    map[string]string          `json:"otherdeployparams"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Serviceofferingid    string                     `json:"serviceofferingid"`
+	Templateid           string                     `json:"templateid"`
+	Userdata             string                     `json:"userdata"`
+	Userdatadetails      string                     `json:"userdatadetails"`
+	Userdataid           string                     `json:"userdataid"`
+	Userdataname         string                     `json:"userdataname"`
+	Userdatapolicy       string                     `json:"userdatapolicy"`
+	Zoneid               string                     `json:"zoneid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateAutoScaleVmProfileResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateAutoScaleVmProfileResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateConditionParams struct {
@@ -5059,6 +6741,46 @@ func (p *UpdateConditionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateCondition API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateConditionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateCondition", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateCondition API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateConditionParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "relationaloperator", "threshold"},
+		lengths:  map[string]int{"id": 255, "relationaloperator": 255},
+	}.validate("updateCondition", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateCondition API, before the request is signed
+func (p *UpdateConditionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateConditionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateConditionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateCondition", b, &p.p, map[string]paramDecoder{
+		"id":                 decodeParam[string],
+		"relationaloperator": decodeParam[string],
+		"threshold":          decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateConditionParams) Clone() *UpdateConditionParams {
+	return &UpdateConditionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateConditionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5135,27 +6857,39 @@ func (s *AutoScaleService) NewUpdateConditionParams(id string, relationaloperato
 
 // Updates a condition for Instance auto scaling
 func (s *AutoScaleService) UpdateCondition(p *UpdateConditionParams) (*UpdateConditionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateCondition", p.toURLValues())
+	return s.UpdateConditionWithContext(context.Background(), p)
+}
+
+// UpdateConditionWithContext is like UpdateCondition, but honours the cancellation and deadline of ctx
+func (s *AutoScaleService) UpdateConditionWithContext(ctx context.Context, p *UpdateConditionParams) (*UpdateConditionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateCondition", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateConditionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateCondition", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateCondition", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateCondition", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5163,9 +6897,29 @@ func (s *AutoScaleService) UpdateCondition(p *UpdateConditionParams) (*UpdateCon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateCondition async job into r
+func (r *UpdateConditionResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// UpdateConditionAsync starts the async job of UpdateCondition without waiting for it to finish, and returns a handle to the job
+func (s *AutoScaleService) UpdateConditionAsync(ctx context.Context, p *UpdateConditionParams) (*Job, error) {
+	r, err := s.UpdateConditionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateCondition", r.JobID), nil
+}
+
 type UpdateConditionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateConditionResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateConditionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
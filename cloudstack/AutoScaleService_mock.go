@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScalePolicy(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScalePolicy), p)
 }
 
+// CreateAutoScalePolicyAsync mocks base method.
+func (m *MockAutoScaleServiceIface) CreateAutoScalePolicyAsync(ctx context.Context, p *CreateAutoScalePolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScalePolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScalePolicyAsync indicates an expected call of CreateAutoScalePolicyAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScalePolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalePolicyAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScalePolicyAsync), ctx, p)
+}
+
+// CreateAutoScalePolicyWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) CreateAutoScalePolicyWithContext(ctx context.Context, p *CreateAutoScalePolicyParams) (*CreateAutoScalePolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScalePolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateAutoScalePolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScalePolicyWithContext indicates an expected call of CreateAutoScalePolicyWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScalePolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScalePolicyWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScalePolicyWithContext), ctx, p)
+}
+
 // CreateAutoScaleVmGroup mocks base method.
 func (m *MockAutoScaleServiceIface) CreateAutoScaleVmGroup(p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmGroup(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmGroup), p)
 }
 
+// CreateAutoScaleVmGroupAsync mocks base method.
+func (m *MockAutoScaleServiceIface) CreateAutoScaleVmGroupAsync(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScaleVmGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScaleVmGroupAsync indicates an expected call of CreateAutoScaleVmGroupAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmGroupAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmGroupAsync), ctx, p)
+}
+
+// CreateAutoScaleVmGroupWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) CreateAutoScaleVmGroupWithContext(ctx context.Context, p *CreateAutoScaleVmGroupParams) (*CreateAutoScaleVmGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScaleVmGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateAutoScaleVmGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScaleVmGroupWithContext indicates an expected call of CreateAutoScaleVmGroupWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmGroupWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmGroupWithContext), ctx, p)
+}
+
 // CreateAutoScaleVmProfile mocks base method.
 func (m *MockAutoScaleServiceIface) CreateAutoScaleVmProfile(p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmProfile(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmProfile), p)
 }
 
+// CreateAutoScaleVmProfileAsync mocks base method.
+func (m *MockAutoScaleServiceIface) CreateAutoScaleVmProfileAsync(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScaleVmProfileAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScaleVmProfileAsync indicates an expected call of CreateAutoScaleVmProfileAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmProfileAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmProfileAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmProfileAsync), ctx, p)
+}
+
+// CreateAutoScaleVmProfileWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) CreateAutoScaleVmProfileWithContext(ctx context.Context, p *CreateAutoScaleVmProfileParams) (*CreateAutoScaleVmProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateAutoScaleVmProfileWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateAutoScaleVmProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateAutoScaleVmProfileWithContext indicates an expected call of CreateAutoScaleVmProfileWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateAutoScaleVmProfileWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateAutoScaleVmProfileWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateAutoScaleVmProfileWithContext), ctx, p)
+}
+
 // CreateCondition mocks base method.
 func (m *MockAutoScaleServiceIface) CreateCondition(p *CreateConditionParams) (*CreateConditionResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCondition(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCondition", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCondition), p)
 }
 
+// CreateConditionAsync mocks base method.
+func (m *MockAutoScaleServiceIface) CreateConditionAsync(ctx context.Context, p *CreateConditionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConditionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConditionAsync indicates an expected call of CreateConditionAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateConditionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConditionAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateConditionAsync), ctx, p)
+}
+
+// CreateConditionWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) CreateConditionWithContext(ctx context.Context, p *CreateConditionParams) (*CreateConditionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConditionWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateConditionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConditionWithContext indicates an expected call of CreateConditionWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateConditionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConditionWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateConditionWithContext), ctx, p)
+}
+
 // CreateCounter mocks base method.
 func (m *MockAutoScaleServiceIface) CreateCounter(p *CreateCounterParams) (*CreateCounterResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCounter(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCounter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCounter), p)
 }
 
+// CreateCounterAsync mocks base method.
+func (m *MockAutoScaleServiceIface) CreateCounterAsync(ctx context.Context, p *CreateCounterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCounterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCounterAsync indicates an expected call of CreateCounterAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCounterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCounterAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCounterAsync), ctx, p)
+}
+
+// CreateCounterWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) CreateCounterWithContext(ctx context.Context, p *CreateCounterParams) (*CreateCounterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateCounterWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateCounterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateCounterWithContext indicates an expected call of CreateCounterWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) CreateCounterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateCounterWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).CreateCounterWithContext), ctx, p)
+}
+
 // DeleteAutoScalePolicy mocks base method.
 func (m *MockAutoScaleServiceIface) DeleteAutoScalePolicy(p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +300,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScalePolicy(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScalePolicy), p)
 }
 
+// DeleteAutoScalePolicyAsync mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteAutoScalePolicyAsync(ctx context.Context, p *DeleteAutoScalePolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutoScalePolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAutoScalePolicyAsync indicates an expected call of DeleteAutoScalePolicyAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScalePolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScalePolicyAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScalePolicyAsync), ctx, p)
+}
+
+// DeleteAutoScalePolicyWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteAutoScalePolicyWithContext(ctx context.Context, p *DeleteAutoScalePolicyParams) (*DeleteAutoScalePolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutoScalePolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteAutoScalePolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAutoScalePolicyWithContext indicates an expected call of DeleteAutoScalePolicyWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScalePolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScalePolicyWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScalePolicyWithContext), ctx, p)
+}
+
 // DeleteAutoScaleVmGroup mocks base method.
 func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmGroup(p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +345,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmGroup(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmGroup), p)
 }
 
+// DeleteAutoScaleVmGroupAsync mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmGroupAsync(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutoScaleVmGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAutoScaleVmGroupAsync indicates an expected call of DeleteAutoScaleVmGroupAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmGroupAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmGroupAsync), ctx, p)
+}
+
+// DeleteAutoScaleVmGroupWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmGroupWithContext(ctx context.Context, p *DeleteAutoScaleVmGroupParams) (*DeleteAutoScaleVmGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutoScaleVmGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteAutoScaleVmGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAutoScaleVmGroupWithContext indicates an expected call of DeleteAutoScaleVmGroupWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmGroupWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmGroupWithContext), ctx, p)
+}
+
 // DeleteAutoScaleVmProfile mocks base method.
 func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmProfile(p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +390,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmProfile(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmProfile), p)
 }
 
+// DeleteAutoScaleVmProfileAsync mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmProfileAsync(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutoScaleVmProfileAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAutoScaleVmProfileAsync indicates an expected call of DeleteAutoScaleVmProfileAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmProfileAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmProfileAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmProfileAsync), ctx, p)
+}
+
+// DeleteAutoScaleVmProfileWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteAutoScaleVmProfileWithContext(ctx context.Context, p *DeleteAutoScaleVmProfileParams) (*DeleteAutoScaleVmProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAutoScaleVmProfileWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteAutoScaleVmProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAutoScaleVmProfileWithContext indicates an expected call of DeleteAutoScaleVmProfileWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteAutoScaleVmProfileWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAutoScaleVmProfileWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteAutoScaleVmProfileWithContext), ctx, p)
+}
+
 // DeleteCondition mocks base method.
 func (m *MockAutoScaleServiceIface) DeleteCondition(p *DeleteConditionParams) (*DeleteConditionResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +435,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCondition(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCondition", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCondition), p)
 }
 
+// DeleteConditionAsync mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteConditionAsync(ctx context.Context, p *DeleteConditionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConditionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConditionAsync indicates an expected call of DeleteConditionAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteConditionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConditionAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteConditionAsync), ctx, p)
+}
+
+// DeleteConditionWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteConditionWithContext(ctx context.Context, p *DeleteConditionParams) (*DeleteConditionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteConditionWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteConditionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteConditionWithContext indicates an expected call of DeleteConditionWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteConditionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteConditionWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteConditionWithContext), ctx, p)
+}
+
 // DeleteCounter mocks base method.
 func (m *MockAutoScaleServiceIface) DeleteCounter(p *DeleteCounterParams) (*DeleteCounterResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +480,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCounter(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCounter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCounter), p)
 }
 
+// DeleteCounterAsync mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteCounterAsync(ctx context.Context, p *DeleteCounterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCounterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCounterAsync indicates an expected call of DeleteCounterAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCounterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCounterAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCounterAsync), ctx, p)
+}
+
+// DeleteCounterWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) DeleteCounterWithContext(ctx context.Context, p *DeleteCounterParams) (*DeleteCounterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCounterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteCounterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCounterWithContext indicates an expected call of DeleteCounterWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DeleteCounterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCounterWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DeleteCounterWithContext), ctx, p)
+}
+
 // DisableAutoScaleVmGroup mocks base method.
 func (m *MockAutoScaleServiceIface) DisableAutoScaleVmGroup(p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +525,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) DisableAutoScaleVmGroup(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DisableAutoScaleVmGroup), p)
 }
 
+// DisableAutoScaleVmGroupAsync mocks base method.
+func (m *MockAutoScaleServiceIface) DisableAutoScaleVmGroupAsync(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAutoScaleVmGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAutoScaleVmGroupAsync indicates an expected call of DisableAutoScaleVmGroupAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DisableAutoScaleVmGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAutoScaleVmGroupAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DisableAutoScaleVmGroupAsync), ctx, p)
+}
+
+// DisableAutoScaleVmGroupWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) DisableAutoScaleVmGroupWithContext(ctx context.Context, p *DisableAutoScaleVmGroupParams) (*DisableAutoScaleVmGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableAutoScaleVmGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableAutoScaleVmGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableAutoScaleVmGroupWithContext indicates an expected call of DisableAutoScaleVmGroupWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) DisableAutoScaleVmGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableAutoScaleVmGroupWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).DisableAutoScaleVmGroupWithContext), ctx, p)
+}
+
 // EnableAutoScaleVmGroup mocks base method.
 func (m *MockAutoScaleServiceIface) EnableAutoScaleVmGroup(p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +570,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) EnableAutoScaleVmGroup(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).EnableAutoScaleVmGroup), p)
 }
 
+// EnableAutoScaleVmGroupAsync mocks base method.
+func (m *MockAutoScaleServiceIface) EnableAutoScaleVmGroupAsync(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAutoScaleVmGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableAutoScaleVmGroupAsync indicates an expected call of EnableAutoScaleVmGroupAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) EnableAutoScaleVmGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAutoScaleVmGroupAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).EnableAutoScaleVmGroupAsync), ctx, p)
+}
+
+// EnableAutoScaleVmGroupWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) EnableAutoScaleVmGroupWithContext(ctx context.Context, p *EnableAutoScaleVmGroupParams) (*EnableAutoScaleVmGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableAutoScaleVmGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableAutoScaleVmGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableAutoScaleVmGroupWithContext indicates an expected call of EnableAutoScaleVmGroupWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) EnableAutoScaleVmGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableAutoScaleVmGroupWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).EnableAutoScaleVmGroupWithContext), ctx, p)
+}
+
 // GetAutoScalePolicyByID mocks base method.
 func (m *MockAutoScaleServiceIface) GetAutoScalePolicyByID(id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +621,27 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScalePolicyByID(id any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalePolicyByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScalePolicyByID), varargs...)
 }
 
+// GetAutoScalePolicyByIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScalePolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScalePolicyByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*AutoScalePolicy)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScalePolicyByIDWithContext indicates an expected call of GetAutoScalePolicyByIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScalePolicyByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalePolicyByIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScalePolicyByIDWithContext), varargs...)
+}
+
 // GetAutoScalePolicyByName mocks base method.
 func (m *MockAutoScaleServiceIface) GetAutoScalePolicyByName(name string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +663,27 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScalePolicyByName(name a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalePolicyByName", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScalePolicyByName), varargs...)
 }
 
+// GetAutoScalePolicyByNameWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScalePolicyByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AutoScalePolicy, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScalePolicyByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*AutoScalePolicy)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScalePolicyByNameWithContext indicates an expected call of GetAutoScalePolicyByNameWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScalePolicyByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalePolicyByNameWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScalePolicyByNameWithContext), varargs...)
+}
+
 // GetAutoScalePolicyID mocks base method.
 func (m *MockAutoScaleServiceIface) GetAutoScalePolicyID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -301,6 +705,27 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScalePolicyID(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalePolicyID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScalePolicyID), varargs...)
 }
 
+// GetAutoScalePolicyIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScalePolicyIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScalePolicyIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScalePolicyIDWithContext indicates an expected call of GetAutoScalePolicyIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScalePolicyIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScalePolicyIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScalePolicyIDWithContext), varargs...)
+}
+
 // GetAutoScaleVmGroupByID mocks base method.
 func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupByID(id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
 	m.ctrl.T.Helper()
@@ -318,230 +743,863 @@ func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupByID(id string, opts ...O
 // GetAutoScaleVmGroupByID indicates an expected call of GetAutoScaleVmGroupByID.
 func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupByID(id any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupByID), varargs...)
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupByID), varargs...)
+}
+
+// GetAutoScaleVmGroupByIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScaleVmGroupByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*AutoScaleVmGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScaleVmGroupByIDWithContext indicates an expected call of GetAutoScaleVmGroupByIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupByIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupByIDWithContext), varargs...)
+}
+
+// GetAutoScaleVmGroupByName mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupByName(name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScaleVmGroupByName", varargs...)
+	ret0, _ := ret[0].(*AutoScaleVmGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScaleVmGroupByName indicates an expected call of GetAutoScaleVmGroupByName.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupByName", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupByName), varargs...)
+}
+
+// GetAutoScaleVmGroupByNameWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScaleVmGroupByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*AutoScaleVmGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScaleVmGroupByNameWithContext indicates an expected call of GetAutoScaleVmGroupByNameWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupByNameWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupByNameWithContext), varargs...)
+}
+
+// GetAutoScaleVmGroupID mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScaleVmGroupID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScaleVmGroupID indicates an expected call of GetAutoScaleVmGroupID.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupID), varargs...)
+}
+
+// GetAutoScaleVmGroupIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScaleVmGroupIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScaleVmGroupIDWithContext indicates an expected call of GetAutoScaleVmGroupIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupIDWithContext), varargs...)
+}
+
+// GetAutoScaleVmProfileByID mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScaleVmProfileByID", varargs...)
+	ret0, _ := ret[0].(*AutoScaleVmProfile)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScaleVmProfileByID indicates an expected call of GetAutoScaleVmProfileByID.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmProfileByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmProfileByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmProfileByID), varargs...)
+}
+
+// GetAutoScaleVmProfileByIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetAutoScaleVmProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAutoScaleVmProfileByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*AutoScaleVmProfile)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAutoScaleVmProfileByIDWithContext indicates an expected call of GetAutoScaleVmProfileByIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmProfileByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmProfileByIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmProfileByIDWithContext), varargs...)
+}
+
+// GetConditionByID mocks base method.
+func (m *MockAutoScaleServiceIface) GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConditionByID", varargs...)
+	ret0, _ := ret[0].(*Condition)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConditionByID indicates an expected call of GetConditionByID.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetConditionByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditionByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetConditionByID), varargs...)
+}
+
+// GetConditionByIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetConditionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Condition, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetConditionByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Condition)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetConditionByIDWithContext indicates an expected call of GetConditionByIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetConditionByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditionByIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetConditionByIDWithContext), varargs...)
+}
+
+// GetCounterByID mocks base method.
+func (m *MockAutoScaleServiceIface) GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCounterByID", varargs...)
+	ret0, _ := ret[0].(*Counter)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCounterByID indicates an expected call of GetCounterByID.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterByID), varargs...)
+}
+
+// GetCounterByIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetCounterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Counter, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCounterByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Counter)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCounterByIDWithContext indicates an expected call of GetCounterByIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterByIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterByIDWithContext), varargs...)
+}
+
+// GetCounterByName mocks base method.
+func (m *MockAutoScaleServiceIface) GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCounterByName", varargs...)
+	ret0, _ := ret[0].(*Counter)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCounterByName indicates an expected call of GetCounterByName.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterByName", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterByName), varargs...)
+}
+
+// GetCounterByNameWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetCounterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Counter, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCounterByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Counter)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCounterByNameWithContext indicates an expected call of GetCounterByNameWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterByNameWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterByNameWithContext), varargs...)
+}
+
+// GetCounterID mocks base method.
+func (m *MockAutoScaleServiceIface) GetCounterID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCounterID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCounterID indicates an expected call of GetCounterID.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterID), varargs...)
+}
+
+// GetCounterIDWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) GetCounterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCounterIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCounterIDWithContext indicates an expected call of GetCounterIDWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterIDWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterIDWithContext), varargs...)
+}
+
+// ListAutoScalePolicies mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoScalePolicies", p)
+	ret0, _ := ret[0].(*ListAutoScalePoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScalePolicies indicates an expected call of ListAutoScalePolicies.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePolicies(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePolicies", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePolicies), p)
+}
+
+// ListAutoScalePoliciesAll mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScalePoliciesAll(p *ListAutoScalePoliciesParams, opts ...PageOption) ([]*AutoScalePolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScalePoliciesAll", varargs...)
+	ret0, _ := ret[0].([]*AutoScalePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScalePoliciesAll indicates an expected call of ListAutoScalePoliciesAll.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePoliciesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePoliciesAll", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePoliciesAll), varargs...)
+}
+
+// ListAutoScalePoliciesAllWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScalePoliciesAllWithContext(ctx context.Context, p *ListAutoScalePoliciesParams, opts ...PageOption) ([]*AutoScalePolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScalePoliciesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*AutoScalePolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScalePoliciesAllWithContext indicates an expected call of ListAutoScalePoliciesAllWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePoliciesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePoliciesAllWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePoliciesAllWithContext), varargs...)
+}
+
+// ListAutoScalePoliciesIter mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScalePoliciesIter(p *ListAutoScalePoliciesParams, opts ...PageOption) iter.Seq2[*AutoScalePolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScalePoliciesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AutoScalePolicy, error])
+	return ret0
+}
+
+// ListAutoScalePoliciesIter indicates an expected call of ListAutoScalePoliciesIter.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePoliciesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePoliciesIter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePoliciesIter), varargs...)
+}
+
+// ListAutoScalePoliciesIterWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScalePoliciesIterWithContext(ctx context.Context, p *ListAutoScalePoliciesParams, opts ...PageOption) iter.Seq2[*AutoScalePolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScalePoliciesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AutoScalePolicy, error])
+	return ret0
+}
+
+// ListAutoScalePoliciesIterWithContext indicates an expected call of ListAutoScalePoliciesIterWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePoliciesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePoliciesIterWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePoliciesIterWithContext), varargs...)
+}
+
+// ListAutoScalePoliciesWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScalePoliciesWithContext(ctx context.Context, p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoScalePoliciesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAutoScalePoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScalePoliciesWithContext indicates an expected call of ListAutoScalePoliciesWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePoliciesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePoliciesWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePoliciesWithContext), ctx, p)
+}
+
+// ListAutoScaleVmGroups mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoScaleVmGroups", p)
+	ret0, _ := ret[0].(*ListAutoScaleVmGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScaleVmGroups indicates an expected call of ListAutoScaleVmGroups.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroups(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroups", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroups), p)
+}
+
+// ListAutoScaleVmGroupsAll mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroupsAll(p *ListAutoScaleVmGroupsParams, opts ...PageOption) ([]*AutoScaleVmGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmGroupsAll", varargs...)
+	ret0, _ := ret[0].([]*AutoScaleVmGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScaleVmGroupsAll indicates an expected call of ListAutoScaleVmGroupsAll.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroupsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroupsAll", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroupsAll), varargs...)
+}
+
+// ListAutoScaleVmGroupsAllWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroupsAllWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams, opts ...PageOption) ([]*AutoScaleVmGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmGroupsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*AutoScaleVmGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScaleVmGroupsAllWithContext indicates an expected call of ListAutoScaleVmGroupsAllWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroupsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroupsAllWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroupsAllWithContext), varargs...)
+}
+
+// ListAutoScaleVmGroupsIter mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroupsIter(p *ListAutoScaleVmGroupsParams, opts ...PageOption) iter.Seq2[*AutoScaleVmGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmGroupsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AutoScaleVmGroup, error])
+	return ret0
+}
+
+// ListAutoScaleVmGroupsIter indicates an expected call of ListAutoScaleVmGroupsIter.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroupsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroupsIter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroupsIter), varargs...)
+}
+
+// ListAutoScaleVmGroupsIterWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroupsIterWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams, opts ...PageOption) iter.Seq2[*AutoScaleVmGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmGroupsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AutoScaleVmGroup, error])
+	return ret0
+}
+
+// ListAutoScaleVmGroupsIterWithContext indicates an expected call of ListAutoScaleVmGroupsIterWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroupsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroupsIterWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroupsIterWithContext), varargs...)
+}
+
+// ListAutoScaleVmGroupsWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroupsWithContext(ctx context.Context, p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoScaleVmGroupsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAutoScaleVmGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScaleVmGroupsWithContext indicates an expected call of ListAutoScaleVmGroupsWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroupsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroupsWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroupsWithContext), ctx, p)
+}
+
+// ListAutoScaleVmProfiles mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAutoScaleVmProfiles", p)
+	ret0, _ := ret[0].(*ListAutoScaleVmProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScaleVmProfiles indicates an expected call of ListAutoScaleVmProfiles.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfiles(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfiles", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfiles), p)
+}
+
+// ListAutoScaleVmProfilesAll mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfilesAll(p *ListAutoScaleVmProfilesParams, opts ...PageOption) ([]*AutoScaleVmProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmProfilesAll", varargs...)
+	ret0, _ := ret[0].([]*AutoScaleVmProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScaleVmProfilesAll indicates an expected call of ListAutoScaleVmProfilesAll.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfilesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfilesAll", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfilesAll), varargs...)
 }
 
-// GetAutoScaleVmGroupByName mocks base method.
-func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupByName(name string, opts ...OptionFunc) (*AutoScaleVmGroup, int, error) {
+// ListAutoScaleVmProfilesAllWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfilesAllWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams, opts ...PageOption) ([]*AutoScaleVmProfile, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetAutoScaleVmGroupByName", varargs...)
-	ret0, _ := ret[0].(*AutoScaleVmGroup)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListAutoScaleVmProfilesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*AutoScaleVmProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetAutoScaleVmGroupByName indicates an expected call of GetAutoScaleVmGroupByName.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupByName(name any, opts ...any) *gomock.Call {
+// ListAutoScaleVmProfilesAllWithContext indicates an expected call of ListAutoScaleVmProfilesAllWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfilesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupByName", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupByName), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfilesAllWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfilesAllWithContext), varargs...)
 }
 
-// GetAutoScaleVmGroupID mocks base method.
-func (m *MockAutoScaleServiceIface) GetAutoScaleVmGroupID(name string, opts ...OptionFunc) (string, int, error) {
+// ListAutoScaleVmProfilesIter mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfilesIter(p *ListAutoScaleVmProfilesParams, opts ...PageOption) iter.Seq2[*AutoScaleVmProfile, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetAutoScaleVmGroupID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListAutoScaleVmProfilesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AutoScaleVmProfile, error])
+	return ret0
 }
 
-// GetAutoScaleVmGroupID indicates an expected call of GetAutoScaleVmGroupID.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmGroupID(name any, opts ...any) *gomock.Call {
+// ListAutoScaleVmProfilesIter indicates an expected call of ListAutoScaleVmProfilesIter.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfilesIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmGroupID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmGroupID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfilesIter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfilesIter), varargs...)
 }
 
-// GetAutoScaleVmProfileByID mocks base method.
-func (m *MockAutoScaleServiceIface) GetAutoScaleVmProfileByID(id string, opts ...OptionFunc) (*AutoScaleVmProfile, int, error) {
+// ListAutoScaleVmProfilesIterWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfilesIterWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams, opts ...PageOption) iter.Seq2[*AutoScaleVmProfile, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetAutoScaleVmProfileByID", varargs...)
-	ret0, _ := ret[0].(*AutoScaleVmProfile)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListAutoScaleVmProfilesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AutoScaleVmProfile, error])
+	return ret0
 }
 
-// GetAutoScaleVmProfileByID indicates an expected call of GetAutoScaleVmProfileByID.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) GetAutoScaleVmProfileByID(id any, opts ...any) *gomock.Call {
+// ListAutoScaleVmProfilesIterWithContext indicates an expected call of ListAutoScaleVmProfilesIterWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfilesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAutoScaleVmProfileByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetAutoScaleVmProfileByID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfilesIterWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfilesIterWithContext), varargs...)
 }
 
-// GetConditionByID mocks base method.
-func (m *MockAutoScaleServiceIface) GetConditionByID(id string, opts ...OptionFunc) (*Condition, int, error) {
+// ListAutoScaleVmProfilesWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfilesWithContext(ctx context.Context, p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	ret := m.ctrl.Call(m, "ListAutoScaleVmProfilesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAutoScaleVmProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAutoScaleVmProfilesWithContext indicates an expected call of ListAutoScaleVmProfilesWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfilesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfilesWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfilesWithContext), ctx, p)
+}
+
+// ListConditions mocks base method.
+func (m *MockAutoScaleServiceIface) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConditions", p)
+	ret0, _ := ret[0].(*ListConditionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConditions indicates an expected call of ListConditions.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditions(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditions", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditions), p)
+}
+
+// ListConditionsAll mocks base method.
+func (m *MockAutoScaleServiceIface) ListConditionsAll(p *ListConditionsParams, opts ...PageOption) ([]*Condition, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetConditionByID", varargs...)
-	ret0, _ := ret[0].(*Condition)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListConditionsAll", varargs...)
+	ret0, _ := ret[0].([]*Condition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetConditionByID indicates an expected call of GetConditionByID.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) GetConditionByID(id any, opts ...any) *gomock.Call {
+// ListConditionsAll indicates an expected call of ListConditionsAll.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditionsAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetConditionByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetConditionByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditionsAll", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditionsAll), varargs...)
 }
 
-// GetCounterByID mocks base method.
-func (m *MockAutoScaleServiceIface) GetCounterByID(id string, opts ...OptionFunc) (*Counter, int, error) {
+// ListConditionsAllWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListConditionsAllWithContext(ctx context.Context, p *ListConditionsParams, opts ...PageOption) ([]*Condition, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetCounterByID", varargs...)
-	ret0, _ := ret[0].(*Counter)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListConditionsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Condition)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetCounterByID indicates an expected call of GetCounterByID.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterByID(id any, opts ...any) *gomock.Call {
+// ListConditionsAllWithContext indicates an expected call of ListConditionsAllWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditionsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterByID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterByID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditionsAllWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditionsAllWithContext), varargs...)
 }
 
-// GetCounterByName mocks base method.
-func (m *MockAutoScaleServiceIface) GetCounterByName(name string, opts ...OptionFunc) (*Counter, int, error) {
+// ListConditionsIter mocks base method.
+func (m *MockAutoScaleServiceIface) ListConditionsIter(p *ListConditionsParams, opts ...PageOption) iter.Seq2[*Condition, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetCounterByName", varargs...)
-	ret0, _ := ret[0].(*Counter)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListConditionsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Condition, error])
+	return ret0
 }
 
-// GetCounterByName indicates an expected call of GetCounterByName.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterByName(name any, opts ...any) *gomock.Call {
+// ListConditionsIter indicates an expected call of ListConditionsIter.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditionsIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterByName", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterByName), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditionsIter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditionsIter), varargs...)
 }
 
-// GetCounterID mocks base method.
-func (m *MockAutoScaleServiceIface) GetCounterID(name string, opts ...OptionFunc) (string, int, error) {
+// ListConditionsIterWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListConditionsIterWithContext(ctx context.Context, p *ListConditionsParams, opts ...PageOption) iter.Seq2[*Condition, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetCounterID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListConditionsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Condition, error])
+	return ret0
 }
 
-// GetCounterID indicates an expected call of GetCounterID.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) GetCounterID(name any, opts ...any) *gomock.Call {
+// ListConditionsIterWithContext indicates an expected call of ListConditionsIterWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditionsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCounterID", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).GetCounterID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditionsIterWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditionsIterWithContext), varargs...)
 }
 
-// ListAutoScalePolicies mocks base method.
-func (m *MockAutoScaleServiceIface) ListAutoScalePolicies(p *ListAutoScalePoliciesParams) (*ListAutoScalePoliciesResponse, error) {
+// ListConditionsWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListConditionsWithContext(ctx context.Context, p *ListConditionsParams) (*ListConditionsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAutoScalePolicies", p)
-	ret0, _ := ret[0].(*ListAutoScalePoliciesResponse)
+	ret := m.ctrl.Call(m, "ListConditionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListConditionsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAutoScalePolicies indicates an expected call of ListAutoScalePolicies.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScalePolicies(p any) *gomock.Call {
+// ListConditionsWithContext indicates an expected call of ListConditionsWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditionsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScalePolicies", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScalePolicies), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditionsWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditionsWithContext), ctx, p)
 }
 
-// ListAutoScaleVmGroups mocks base method.
-func (m *MockAutoScaleServiceIface) ListAutoScaleVmGroups(p *ListAutoScaleVmGroupsParams) (*ListAutoScaleVmGroupsResponse, error) {
+// ListCounters mocks base method.
+func (m *MockAutoScaleServiceIface) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAutoScaleVmGroups", p)
-	ret0, _ := ret[0].(*ListAutoScaleVmGroupsResponse)
+	ret := m.ctrl.Call(m, "ListCounters", p)
+	ret0, _ := ret[0].(*ListCountersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAutoScaleVmGroups indicates an expected call of ListAutoScaleVmGroups.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmGroups(p any) *gomock.Call {
+// ListCounters indicates an expected call of ListCounters.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCounters(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmGroups", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmGroups), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCounters", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCounters), p)
 }
 
-// ListAutoScaleVmProfiles mocks base method.
-func (m *MockAutoScaleServiceIface) ListAutoScaleVmProfiles(p *ListAutoScaleVmProfilesParams) (*ListAutoScaleVmProfilesResponse, error) {
+// ListCountersAll mocks base method.
+func (m *MockAutoScaleServiceIface) ListCountersAll(p *ListCountersParams, opts ...PageOption) ([]*Counter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListAutoScaleVmProfiles", p)
-	ret0, _ := ret[0].(*ListAutoScaleVmProfilesResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCountersAll", varargs...)
+	ret0, _ := ret[0].([]*Counter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListAutoScaleVmProfiles indicates an expected call of ListAutoScaleVmProfiles.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListAutoScaleVmProfiles(p any) *gomock.Call {
+// ListCountersAll indicates an expected call of ListCountersAll.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCountersAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAutoScaleVmProfiles", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListAutoScaleVmProfiles), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCountersAll", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCountersAll), varargs...)
 }
 
-// ListConditions mocks base method.
-func (m *MockAutoScaleServiceIface) ListConditions(p *ListConditionsParams) (*ListConditionsResponse, error) {
+// ListCountersAllWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListCountersAllWithContext(ctx context.Context, p *ListCountersParams, opts ...PageOption) ([]*Counter, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListConditions", p)
-	ret0, _ := ret[0].(*ListConditionsResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCountersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Counter)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListConditions indicates an expected call of ListConditions.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListConditions(p any) *gomock.Call {
+// ListCountersAllWithContext indicates an expected call of ListCountersAllWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCountersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConditions", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListConditions), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCountersAllWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCountersAllWithContext), varargs...)
 }
 
-// ListCounters mocks base method.
-func (m *MockAutoScaleServiceIface) ListCounters(p *ListCountersParams) (*ListCountersResponse, error) {
+// ListCountersIter mocks base method.
+func (m *MockAutoScaleServiceIface) ListCountersIter(p *ListCountersParams, opts ...PageOption) iter.Seq2[*Counter, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListCounters", p)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCountersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Counter, error])
+	return ret0
+}
+
+// ListCountersIter indicates an expected call of ListCountersIter.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCountersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCountersIter", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCountersIter), varargs...)
+}
+
+// ListCountersIterWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListCountersIterWithContext(ctx context.Context, p *ListCountersParams, opts ...PageOption) iter.Seq2[*Counter, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCountersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Counter, error])
+	return ret0
+}
+
+// ListCountersIterWithContext indicates an expected call of ListCountersIterWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCountersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCountersIterWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCountersIterWithContext), varargs...)
+}
+
+// ListCountersWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) ListCountersWithContext(ctx context.Context, p *ListCountersParams) (*ListCountersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCountersWithContext", ctx, p)
 	ret0, _ := ret[0].(*ListCountersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListCounters indicates an expected call of ListCounters.
-func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCounters(p any) *gomock.Call {
+// ListCountersWithContext indicates an expected call of ListCountersWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) ListCountersWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCounters", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCounters), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCountersWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).ListCountersWithContext), ctx, p)
 }
 
 // NewCreateAutoScalePolicyParams mocks base method.
@@ -853,6 +1911,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScalePolicy(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScalePolicy", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScalePolicy), p)
 }
 
+// UpdateAutoScalePolicyAsync mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateAutoScalePolicyAsync(ctx context.Context, p *UpdateAutoScalePolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoScalePolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAutoScalePolicyAsync indicates an expected call of UpdateAutoScalePolicyAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScalePolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScalePolicyAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScalePolicyAsync), ctx, p)
+}
+
+// UpdateAutoScalePolicyWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateAutoScalePolicyWithContext(ctx context.Context, p *UpdateAutoScalePolicyParams) (*UpdateAutoScalePolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoScalePolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateAutoScalePolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAutoScalePolicyWithContext indicates an expected call of UpdateAutoScalePolicyWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScalePolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScalePolicyWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScalePolicyWithContext), ctx, p)
+}
+
 // UpdateAutoScaleVmGroup mocks base method.
 func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmGroup(p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -868,6 +1956,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmGroup(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmGroup", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmGroup), p)
 }
 
+// UpdateAutoScaleVmGroupAsync mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmGroupAsync(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoScaleVmGroupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAutoScaleVmGroupAsync indicates an expected call of UpdateAutoScaleVmGroupAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmGroupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmGroupAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmGroupAsync), ctx, p)
+}
+
+// UpdateAutoScaleVmGroupWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmGroupWithContext(ctx context.Context, p *UpdateAutoScaleVmGroupParams) (*UpdateAutoScaleVmGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoScaleVmGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateAutoScaleVmGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAutoScaleVmGroupWithContext indicates an expected call of UpdateAutoScaleVmGroupWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmGroupWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmGroupWithContext), ctx, p)
+}
+
 // UpdateAutoScaleVmProfile mocks base method.
 func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmProfile(p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -883,6 +2001,36 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmProfile(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmProfile", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmProfile), p)
 }
 
+// UpdateAutoScaleVmProfileAsync mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmProfileAsync(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoScaleVmProfileAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAutoScaleVmProfileAsync indicates an expected call of UpdateAutoScaleVmProfileAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmProfileAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmProfileAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmProfileAsync), ctx, p)
+}
+
+// UpdateAutoScaleVmProfileWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateAutoScaleVmProfileWithContext(ctx context.Context, p *UpdateAutoScaleVmProfileParams) (*UpdateAutoScaleVmProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateAutoScaleVmProfileWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateAutoScaleVmProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateAutoScaleVmProfileWithContext indicates an expected call of UpdateAutoScaleVmProfileWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateAutoScaleVmProfileWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateAutoScaleVmProfileWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateAutoScaleVmProfileWithContext), ctx, p)
+}
+
 // UpdateCondition mocks base method.
 func (m *MockAutoScaleServiceIface) UpdateCondition(p *UpdateConditionParams) (*UpdateConditionResponse, error) {
 	m.ctrl.T.Helper()
@@ -897,3 +2045,33 @@ func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateCondition(p any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCondition", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateCondition), p)
 }
+
+// UpdateConditionAsync mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateConditionAsync(ctx context.Context, p *UpdateConditionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConditionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConditionAsync indicates an expected call of UpdateConditionAsync.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateConditionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConditionAsync", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateConditionAsync), ctx, p)
+}
+
+// UpdateConditionWithContext mocks base method.
+func (m *MockAutoScaleServiceIface) UpdateConditionWithContext(ctx context.Context, p *UpdateConditionParams) (*UpdateConditionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConditionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateConditionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConditionWithContext indicates an expected call of UpdateConditionWithContext.
+func (mr *MockAutoScaleServiceIfaceMockRecorder) UpdateConditionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConditionWithContext", reflect.TypeOf((*MockAutoScaleServiceIface)(nil).UpdateConditionWithContext), ctx, p)
+}
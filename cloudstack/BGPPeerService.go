@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,19 +31,37 @@ import (
 
 type BGPPeerServiceIface interface {
 	ChangeBgpPeersForVpc(p *ChangeBgpPeersForVpcParams) (*ChangeBgpPeersForVpcResponse, error)
+	ChangeBgpPeersForVpcWithContext(ctx context.Context, p *ChangeBgpPeersForVpcParams) (*ChangeBgpPeersForVpcResponse, error)
+	ChangeBgpPeersForVpcAsync(ctx context.Context, p *ChangeBgpPeersForVpcParams) (*Job, error)
 	NewChangeBgpPeersForVpcParams(vpcid string) *ChangeBgpPeersForVpcParams
 	CreateBgpPeer(p *CreateBgpPeerParams) (*CreateBgpPeerResponse, error)
+	CreateBgpPeerWithContext(ctx context.Context, p *CreateBgpPeerParams) (*CreateBgpPeerResponse, error)
+	CreateBgpPeerAsync(ctx context.Context, p *CreateBgpPeerParams) (*Job, error)
 	NewCreateBgpPeerParams(asnumber int64, zoneid string) *CreateBgpPeerParams
 	DedicateBgpPeer(p *DedicateBgpPeerParams) (*DedicateBgpPeerResponse, error)
+	DedicateBgpPeerWithContext(ctx context.Context, p *DedicateBgpPeerParams) (*DedicateBgpPeerResponse, error)
+	DedicateBgpPeerAsync(ctx context.Context, p *DedicateBgpPeerParams) (*Job, error)
 	NewDedicateBgpPeerParams(id string) *DedicateBgpPeerParams
 	DeleteBgpPeer(p *DeleteBgpPeerParams) (*DeleteBgpPeerResponse, error)
+	DeleteBgpPeerWithContext(ctx context.Context, p *DeleteBgpPeerParams) (*DeleteBgpPeerResponse, error)
+	DeleteBgpPeerAsync(ctx context.Context, p *DeleteBgpPeerParams) (*Job, error)
 	NewDeleteBgpPeerParams(id string) *DeleteBgpPeerParams
 	ListBgpPeers(p *ListBgpPeersParams) (*ListBgpPeersResponse, error)
+	ListBgpPeersWithContext(ctx context.Context, p *ListBgpPeersParams) (*ListBgpPeersResponse, error)
+	ListBgpPeersAll(p *ListBgpPeersParams, opts ...PageOption) ([]*BgpPeer, error)
+	ListBgpPeersAllWithContext(ctx context.Context, p *ListBgpPeersParams, opts ...PageOption) ([]*BgpPeer, error)
+	ListBgpPeersIter(p *ListBgpPeersParams, opts ...PageOption) iter.Seq2[*BgpPeer, error]
+	ListBgpPeersIterWithContext(ctx context.Context, p *ListBgpPeersParams, opts ...PageOption) iter.Seq2[*BgpPeer, error]
 	NewListBgpPeersParams() *ListBgpPeersParams
 	GetBgpPeerByID(id string, opts ...OptionFunc) (*BgpPeer, int, error)
+	GetBgpPeerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BgpPeer, int, error)
 	ReleaseBgpPeer(p *ReleaseBgpPeerParams) (*ReleaseBgpPeerResponse, error)
+	ReleaseBgpPeerWithContext(ctx context.Context, p *ReleaseBgpPeerParams) (*ReleaseBgpPeerResponse, error)
+	ReleaseBgpPeerAsync(ctx context.Context, p *ReleaseBgpPeerParams) (*Job, error)
 	NewReleaseBgpPeerParams(id string) *ReleaseBgpPeerParams
 	UpdateBgpPeer(p *UpdateBgpPeerParams) (*UpdateBgpPeerResponse, error)
+	UpdateBgpPeerWithContext(ctx context.Context, p *UpdateBgpPeerParams) (*UpdateBgpPeerResponse, error)
+	UpdateBgpPeerAsync(ctx context.Context, p *UpdateBgpPeerParams) (*Job, error)
 	NewUpdateBgpPeerParams(id string) *UpdateBgpPeerParams
 }
 
@@ -64,6 +84,45 @@ func (p *ChangeBgpPeersForVpcParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeBgpPeersForVpc API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeBgpPeersForVpcParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeBgpPeersForVpc", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeBgpPeersForVpc API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeBgpPeersForVpcParams) Validate() error {
+	return paramRules{
+		required: []string{"vpcid"},
+		lengths:  map[string]int{"vpcid": 255},
+	}.validate("changeBgpPeersForVpc", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeBgpPeersForVpc API, before the request is signed
+func (p *ChangeBgpPeersForVpcParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeBgpPeersForVpcParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeBgpPeersForVpcParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeBgpPeersForVpc", b, &p.p, map[string]paramDecoder{
+		"bgppeerids": decodeParam[[]string],
+		"vpcid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeBgpPeersForVpcParams) Clone() *ChangeBgpPeersForVpcParams {
+	return &ChangeBgpPeersForVpcParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeBgpPeersForVpcParams) SetBgppeerids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -117,32 +176,39 @@ func (s *BGPPeerService) NewChangeBgpPeersForVpcParams(vpcid string) *ChangeBgpP
 
 // Change the BGP peers for a VPC.
 func (s *BGPPeerService) ChangeBgpPeersForVpc(p *ChangeBgpPeersForVpcParams) (*ChangeBgpPeersForVpcResponse, error) {
-	resp, err := s.cs.newPostRequest("changeBgpPeersForVpc", p.toURLValues())
+	return s.ChangeBgpPeersForVpcWithContext(context.Background(), p)
+}
+
+// ChangeBgpPeersForVpcWithContext is like ChangeBgpPeersForVpc, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) ChangeBgpPeersForVpcWithContext(ctx context.Context, p *ChangeBgpPeersForVpcParams) (*ChangeBgpPeersForVpcResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "changeBgpPeersForVpc", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeBgpPeersForVpcResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeBgpPeersForVpc", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "changeBgpPeersForVpc", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("changeBgpPeersForVpc", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -150,23 +216,48 @@ func (s *BGPPeerService) ChangeBgpPeersForVpc(p *ChangeBgpPeersForVpcParams) (*C
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ChangeBgpPeersForVpc async job into r
+func (r *ChangeBgpPeersForVpcResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ChangeBgpPeersForVpcAsync starts the async job of ChangeBgpPeersForVpc without waiting for it to finish, and returns a handle to the job
+func (s *BGPPeerService) ChangeBgpPeersForVpcAsync(ctx context.Context, p *ChangeBgpPeersForVpcParams) (*Job, error) {
+	r, err := s.ChangeBgpPeersForVpcWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("changeBgpPeersForVpc", r.JobID), nil
+}
+
 type ChangeBgpPeersForVpcResponse struct {
-	Account    string            `json:"account"`
-	Asnumber   int64             `json:"asnumber"`
-	Created    string            `json:"created"`
-	Details    map[string]string `json:"details"`
-	Domain     string            `json:"domain"`
-	Domainid   string            `json:"domainid"`
-	Id         string            `json:"id"`
-	Ip6address string            `json:"ip6address"`
-	Ipaddress  string            `json:"ipaddress"`
-	JobID      string            `json:"jobid"`
-	Jobstatus  int               `json:"jobstatus"`
-	Password   string            `json:"password"`
-	Project    string            `json:"project"`
-	Projectid  string            `json:"projectid"`
-	Zoneid     string            `json:"zoneid"`
-	Zonename   string            `json:"zonename"`
+	Account    string                     `json:"account"`
+	Asnumber   FlexInt64                  `json:"asnumber"`
+	Created    Time                       `json:"created"`
+	Details    map[string]string          `json:"details"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ip6address string                     `json:"ip6address"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Password   string                     `json:"password"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeBgpPeersForVpcResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeBgpPeersForVpcResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateBgpPeerParams struct {
@@ -212,6 +303,52 @@ func (p *CreateBgpPeerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createBgpPeer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateBgpPeerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createBgpPeer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createBgpPeer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateBgpPeerParams) Validate() error {
+	return paramRules{
+		required: []string{"asnumber", "zoneid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "ip6address": 255, "ipaddress": 255, "password": 255, "projectid": 255, "zoneid": 255},
+	}.validate("createBgpPeer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createBgpPeer API, before the request is signed
+func (p *CreateBgpPeerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateBgpPeerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateBgpPeerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createBgpPeer", b, &p.p, map[string]paramDecoder{
+		"account":    decodeParam[string],
+		"asnumber":   decodeParam[int64],
+		"details":    decodeParam[map[string]string],
+		"domainid":   decodeParam[string],
+		"ip6address": decodeParam[string],
+		"ipaddress":  decodeParam[string],
+		"password":   decodeParam[string],
+		"projectid":  decodeParam[string],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateBgpPeerParams) Clone() *CreateBgpPeerParams {
+	return &CreateBgpPeerParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateBgpPeerParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -413,32 +550,39 @@ func (s *BGPPeerService) NewCreateBgpPeerParams(asnumber int64, zoneid string) *
 
 // Creates a Bgp Peer for a zone.
 func (s *BGPPeerService) CreateBgpPeer(p *CreateBgpPeerParams) (*CreateBgpPeerResponse, error) {
-	resp, err := s.cs.newPostRequest("createBgpPeer", p.toURLValues())
+	return s.CreateBgpPeerWithContext(context.Background(), p)
+}
+
+// CreateBgpPeerWithContext is like CreateBgpPeer, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) CreateBgpPeerWithContext(ctx context.Context, p *CreateBgpPeerParams) (*CreateBgpPeerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createBgpPeer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateBgpPeerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createBgpPeer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createBgpPeer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createBgpPeer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -446,23 +590,48 @@ func (s *BGPPeerService) CreateBgpPeer(p *CreateBgpPeerParams) (*CreateBgpPeerRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateBgpPeer async job into r
+func (r *CreateBgpPeerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateBgpPeerAsync starts the async job of CreateBgpPeer without waiting for it to finish, and returns a handle to the job
+func (s *BGPPeerService) CreateBgpPeerAsync(ctx context.Context, p *CreateBgpPeerParams) (*Job, error) {
+	r, err := s.CreateBgpPeerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createBgpPeer", r.JobID), nil
+}
+
 type CreateBgpPeerResponse struct {
-	Account    string            `json:"account"`
-	Asnumber   int64             `json:"asnumber"`
-	Created    string            `json:"created"`
-	Details    map[string]string `json:"details"`
-	Domain     string            `json:"domain"`
-	Domainid   string            `json:"domainid"`
-	Id         string            `json:"id"`
-	Ip6address string            `json:"ip6address"`
-	Ipaddress  string            `json:"ipaddress"`
-	JobID      string            `json:"jobid"`
-	Jobstatus  int               `json:"jobstatus"`
-	Password   string            `json:"password"`
-	Project    string            `json:"project"`
-	Projectid  string            `json:"projectid"`
-	Zoneid     string            `json:"zoneid"`
-	Zonename   string            `json:"zonename"`
+	Account    string                     `json:"account"`
+	Asnumber   FlexInt64                  `json:"asnumber"`
+	Created    Time                       `json:"created"`
+	Details    map[string]string          `json:"details"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ip6address string                     `json:"ip6address"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Password   string                     `json:"password"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateBgpPeerResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateBgpPeerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicateBgpPeerParams struct {
@@ -489,6 +658,47 @@ func (p *DedicateBgpPeerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the dedicateBgpPeer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicateBgpPeerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicateBgpPeer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicateBgpPeer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicateBgpPeerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "projectid": 255},
+	}.validate("dedicateBgpPeer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicateBgpPeer API, before the request is signed
+func (p *DedicateBgpPeerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicateBgpPeerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicateBgpPeerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicateBgpPeer", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicateBgpPeerParams) Clone() *DedicateBgpPeerParams {
+	return &DedicateBgpPeerParams{p: cloneParams(p.p)}
+}
+
 func (p *DedicateBgpPeerParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -584,32 +794,39 @@ func (s *BGPPeerService) NewDedicateBgpPeerParams(id string) *DedicateBgpPeerPar
 
 // Dedicates an existing Bgp Peer to an account or a domain.
 func (s *BGPPeerService) DedicateBgpPeer(p *DedicateBgpPeerParams) (*DedicateBgpPeerResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicateBgpPeer", p.toURLValues())
+	return s.DedicateBgpPeerWithContext(context.Background(), p)
+}
+
+// DedicateBgpPeerWithContext is like DedicateBgpPeer, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) DedicateBgpPeerWithContext(ctx context.Context, p *DedicateBgpPeerParams) (*DedicateBgpPeerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicateBgpPeer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicateBgpPeerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicateBgpPeer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "dedicateBgpPeer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("dedicateBgpPeer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -617,23 +834,48 @@ func (s *BGPPeerService) DedicateBgpPeer(p *DedicateBgpPeerParams) (*DedicateBgp
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DedicateBgpPeer async job into r
+func (r *DedicateBgpPeerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DedicateBgpPeerAsync starts the async job of DedicateBgpPeer without waiting for it to finish, and returns a handle to the job
+func (s *BGPPeerService) DedicateBgpPeerAsync(ctx context.Context, p *DedicateBgpPeerParams) (*Job, error) {
+	r, err := s.DedicateBgpPeerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("dedicateBgpPeer", r.JobID), nil
+}
+
 type DedicateBgpPeerResponse struct {
-	Account    string            `json:"account"`
-	Asnumber   int64             `json:"asnumber"`
-	Created    string            `json:"created"`
-	Details    map[string]string `json:"details"`
-	Domain     string            `json:"domain"`
-	Domainid   string            `json:"domainid"`
-	Id         string            `json:"id"`
-	Ip6address string            `json:"ip6address"`
-	Ipaddress  string            `json:"ipaddress"`
-	JobID      string            `json:"jobid"`
-	Jobstatus  int               `json:"jobstatus"`
-	Password   string            `json:"password"`
-	Project    string            `json:"project"`
-	Projectid  string            `json:"projectid"`
-	Zoneid     string            `json:"zoneid"`
-	Zonename   string            `json:"zonename"`
+	Account    string                     `json:"account"`
+	Asnumber   FlexInt64                  `json:"asnumber"`
+	Created    Time                       `json:"created"`
+	Details    map[string]string          `json:"details"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ip6address string                     `json:"ip6address"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Password   string                     `json:"password"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicateBgpPeerResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicateBgpPeerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBgpPeerParams struct {
@@ -651,6 +893,44 @@ func (p *DeleteBgpPeerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBgpPeer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBgpPeerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBgpPeer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBgpPeer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBgpPeerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteBgpPeer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBgpPeer API, before the request is signed
+func (p *DeleteBgpPeerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBgpPeerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBgpPeerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBgpPeer", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBgpPeerParams) Clone() *DeleteBgpPeerParams {
+	return &DeleteBgpPeerParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBgpPeerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -683,27 +963,39 @@ func (s *BGPPeerService) NewDeleteBgpPeerParams(id string) *DeleteBgpPeerParams
 
 // Deletes an existing Bgp Peer.
 func (s *BGPPeerService) DeleteBgpPeer(p *DeleteBgpPeerParams) (*DeleteBgpPeerResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBgpPeer", p.toURLValues())
+	return s.DeleteBgpPeerWithContext(context.Background(), p)
+}
+
+// DeleteBgpPeerWithContext is like DeleteBgpPeer, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) DeleteBgpPeerWithContext(ctx context.Context, p *DeleteBgpPeerParams) (*DeleteBgpPeerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBgpPeer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBgpPeerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBgpPeer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteBgpPeer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteBgpPeer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -711,11 +1003,31 @@ func (s *BGPPeerService) DeleteBgpPeer(p *DeleteBgpPeerParams) (*DeleteBgpPeerRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteBgpPeer async job into r
+func (r *DeleteBgpPeerResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteBgpPeerAsync starts the async job of DeleteBgpPeer without waiting for it to finish, and returns a handle to the job
+func (s *BGPPeerService) DeleteBgpPeerAsync(ctx context.Context, p *DeleteBgpPeerParams) (*Job, error) {
+	r, err := s.DeleteBgpPeerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteBgpPeer", r.JobID), nil
+}
+
 type DeleteBgpPeerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteBgpPeerResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteBgpPeerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBgpPeersParams struct {
@@ -764,6 +1076,52 @@ func (p *ListBgpPeersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBgpPeers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBgpPeersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBgpPeers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBgpPeers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBgpPeersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "zoneid": 255},
+	}.validate("listBgpPeers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBgpPeers API, before the request is signed
+func (p *ListBgpPeersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBgpPeersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBgpPeersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBgpPeers", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"asnumber":    decodeParam[int64],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isdedicated": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBgpPeersParams) Clone() *ListBgpPeersParams {
+	return &ListBgpPeersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBgpPeersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -984,6 +1342,11 @@ func (s *BGPPeerService) NewListBgpPeersParams() *ListBgpPeersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BGPPeerService) GetBgpPeerByID(id string, opts ...OptionFunc) (*BgpPeer, int, error) {
+	return s.GetBgpPeerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBgpPeerByIDWithContext is like GetBgpPeerByID, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) GetBgpPeerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BgpPeer, int, error) {
 	p := &ListBgpPeersParams{}
 	p.p = make(map[string]interface{})
 
@@ -995,11 +1358,10 @@ func (s *BGPPeerService) GetBgpPeerByID(id string, opts ...OptionFunc) (*BgpPeer
 		}
 	}
 
-	l, err := s.ListBgpPeers(p)
+	l, err := s.ListBgpPeersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1017,41 +1379,84 @@ func (s *BGPPeerService) GetBgpPeerByID(id string, opts ...OptionFunc) (*BgpPeer
 
 // Lists Bgp Peers.
 func (s *BGPPeerService) ListBgpPeers(p *ListBgpPeersParams) (*ListBgpPeersResponse, error) {
-	resp, err := s.cs.newRequest("listBgpPeers", p.toURLValues())
+	return s.ListBgpPeersWithContext(context.Background(), p)
+}
+
+// ListBgpPeersWithContext is like ListBgpPeers, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) ListBgpPeersWithContext(ctx context.Context, p *ListBgpPeersParams) (*ListBgpPeersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBgpPeers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBgpPeersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBgpPeers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBgpPeersAll returns all BgpPeers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BGPPeerService) ListBgpPeersAll(p *ListBgpPeersParams, opts ...PageOption) ([]*BgpPeer, error) {
+	return s.ListBgpPeersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBgpPeersAllWithContext is like ListBgpPeersAll, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) ListBgpPeersAllWithContext(ctx context.Context, p *ListBgpPeersParams, opts ...PageOption) ([]*BgpPeer, error) {
+	return collectPages(s.ListBgpPeersIterWithContext(ctx, p, opts...))
+}
+
+// ListBgpPeersIter returns an iterator over all BgpPeers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BGPPeerService) ListBgpPeersIter(p *ListBgpPeersParams, opts ...PageOption) iter.Seq2[*BgpPeer, error] {
+	return s.ListBgpPeersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBgpPeersIterWithContext is like ListBgpPeersIter, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) ListBgpPeersIterWithContext(ctx context.Context, p *ListBgpPeersParams, opts ...PageOption) iter.Seq2[*BgpPeer, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BgpPeer, int, error) {
+		l, err := s.ListBgpPeersWithContext(ctx, &ListBgpPeersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BgpPeers, l.Count, nil
+	}, opts...)
+}
+
 type ListBgpPeersResponse struct {
 	Count    int        `json:"count"`
 	BgpPeers []*BgpPeer `json:"bgppeer"`
 }
 
 type BgpPeer struct {
-	Account    string            `json:"account"`
-	Asnumber   int64             `json:"asnumber"`
-	Created    string            `json:"created"`
-	Details    map[string]string `json:"details"`
-	Domain     string            `json:"domain"`
-	Domainid   string            `json:"domainid"`
-	Id         string            `json:"id"`
-	Ip6address string            `json:"ip6address"`
-	Ipaddress  string            `json:"ipaddress"`
-	JobID      string            `json:"jobid"`
-	Jobstatus  int               `json:"jobstatus"`
-	Password   string            `json:"password"`
-	Project    string            `json:"project"`
-	Projectid  string            `json:"projectid"`
-	Zoneid     string            `json:"zoneid"`
-	Zonename   string            `json:"zonename"`
+	Account    string                     `json:"account"`
+	Asnumber   FlexInt64                  `json:"asnumber"`
+	Created    Time                       `json:"created"`
+	Details    map[string]string          `json:"details"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ip6address string                     `json:"ip6address"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Password   string                     `json:"password"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BgpPeer) UnmarshalJSON(b []byte) error {
+	type alias BgpPeer
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseBgpPeerParams struct {
@@ -1069,6 +1474,44 @@ func (p *ReleaseBgpPeerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseBgpPeer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseBgpPeerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseBgpPeer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseBgpPeer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseBgpPeerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("releaseBgpPeer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseBgpPeer API, before the request is signed
+func (p *ReleaseBgpPeerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseBgpPeerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseBgpPeerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseBgpPeer", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseBgpPeerParams) Clone() *ReleaseBgpPeerParams {
+	return &ReleaseBgpPeerParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseBgpPeerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1101,32 +1544,39 @@ func (s *BGPPeerService) NewReleaseBgpPeerParams(id string) *ReleaseBgpPeerParam
 
 // Releases an existing dedicated Bgp Peer.
 func (s *BGPPeerService) ReleaseBgpPeer(p *ReleaseBgpPeerParams) (*ReleaseBgpPeerResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseBgpPeer", p.toURLValues())
+	return s.ReleaseBgpPeerWithContext(context.Background(), p)
+}
+
+// ReleaseBgpPeerWithContext is like ReleaseBgpPeer, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) ReleaseBgpPeerWithContext(ctx context.Context, p *ReleaseBgpPeerParams) (*ReleaseBgpPeerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseBgpPeer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseBgpPeerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseBgpPeer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseBgpPeer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseBgpPeer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1134,23 +1584,48 @@ func (s *BGPPeerService) ReleaseBgpPeer(p *ReleaseBgpPeerParams) (*ReleaseBgpPee
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseBgpPeer async job into r
+func (r *ReleaseBgpPeerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseBgpPeerAsync starts the async job of ReleaseBgpPeer without waiting for it to finish, and returns a handle to the job
+func (s *BGPPeerService) ReleaseBgpPeerAsync(ctx context.Context, p *ReleaseBgpPeerParams) (*Job, error) {
+	r, err := s.ReleaseBgpPeerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseBgpPeer", r.JobID), nil
+}
+
 type ReleaseBgpPeerResponse struct {
-	Account    string            `json:"account"`
-	Asnumber   int64             `json:"asnumber"`
-	Created    string            `json:"created"`
-	Details    map[string]string `json:"details"`
-	Domain     string            `json:"domain"`
-	Domainid   string            `json:"domainid"`
-	Id         string            `json:"id"`
-	Ip6address string            `json:"ip6address"`
-	Ipaddress  string            `json:"ipaddress"`
-	JobID      string            `json:"jobid"`
-	Jobstatus  int               `json:"jobstatus"`
-	Password   string            `json:"password"`
-	Project    string            `json:"project"`
-	Projectid  string            `json:"projectid"`
-	Zoneid     string            `json:"zoneid"`
-	Zonename   string            `json:"zonename"`
+	Account    string                     `json:"account"`
+	Asnumber   FlexInt64                  `json:"asnumber"`
+	Created    Time                       `json:"created"`
+	Details    map[string]string          `json:"details"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ip6address string                     `json:"ip6address"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Password   string                     `json:"password"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseBgpPeerResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseBgpPeerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateBgpPeerParams struct {
@@ -1191,6 +1666,50 @@ func (p *UpdateBgpPeerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateBgpPeer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateBgpPeerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateBgpPeer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateBgpPeer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateBgpPeerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "ip6address": 255, "ipaddress": 255, "password": 255},
+	}.validate("updateBgpPeer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateBgpPeer API, before the request is signed
+func (p *UpdateBgpPeerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateBgpPeerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateBgpPeerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateBgpPeer", b, &p.p, map[string]paramDecoder{
+		"asnumber":       decodeParam[int64],
+		"cleanupdetails": decodeParam[bool],
+		"details":        decodeParam[map[string]string],
+		"id":             decodeParam[string],
+		"ip6address":     decodeParam[string],
+		"ipaddress":      decodeParam[string],
+		"password":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateBgpPeerParams) Clone() *UpdateBgpPeerParams {
+	return &UpdateBgpPeerParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateBgpPeerParams) SetAsnumber(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1349,32 +1868,39 @@ func (s *BGPPeerService) NewUpdateBgpPeerParams(id string) *UpdateBgpPeerParams
 
 // Updates an existing Bgp Peer.
 func (s *BGPPeerService) UpdateBgpPeer(p *UpdateBgpPeerParams) (*UpdateBgpPeerResponse, error) {
-	resp, err := s.cs.newPostRequest("updateBgpPeer", p.toURLValues())
+	return s.UpdateBgpPeerWithContext(context.Background(), p)
+}
+
+// UpdateBgpPeerWithContext is like UpdateBgpPeer, but honours the cancellation and deadline of ctx
+func (s *BGPPeerService) UpdateBgpPeerWithContext(ctx context.Context, p *UpdateBgpPeerParams) (*UpdateBgpPeerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateBgpPeer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateBgpPeerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateBgpPeer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateBgpPeer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateBgpPeer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1382,21 +1908,46 @@ func (s *BGPPeerService) UpdateBgpPeer(p *UpdateBgpPeerParams) (*UpdateBgpPeerRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateBgpPeer async job into r
+func (r *UpdateBgpPeerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateBgpPeerAsync starts the async job of UpdateBgpPeer without waiting for it to finish, and returns a handle to the job
+func (s *BGPPeerService) UpdateBgpPeerAsync(ctx context.Context, p *UpdateBgpPeerParams) (*Job, error) {
+	r, err := s.UpdateBgpPeerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateBgpPeer", r.JobID), nil
+}
+
 type UpdateBgpPeerResponse struct {
-	Account    string            `json:"account"`
-	Asnumber   int64             `json:"asnumber"`
-	Created    string            `json:"created"`
-	Details    map[string]string `json:"details"`
-	Domain     string            `json:"domain"`
-	Domainid   string            `json:"domainid"`
-	Id         string            `json:"id"`
-	Ip6address string            `json:"ip6address"`
-	Ipaddress  string            `json:"ipaddress"`
-	JobID      string            `json:"jobid"`
-	Jobstatus  int               `json:"jobstatus"`
-	Password   string            `json:"password"`
-	Project    string            `json:"project"`
-	Projectid  string            `json:"projectid"`
-	Zoneid     string            `json:"zoneid"`
-	Zonename   string            `json:"zonename"`
+	Account    string                     `json:"account"`
+	Asnumber   FlexInt64                  `json:"asnumber"`
+	Created    Time                       `json:"created"`
+	Details    map[string]string          `json:"details"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ip6address string                     `json:"ip6address"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Password   string                     `json:"password"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateBgpPeerResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateBgpPeerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) ChangeBgpPeersForVpc(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBgpPeersForVpc", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ChangeBgpPeersForVpc), p)
 }
 
+// ChangeBgpPeersForVpcAsync mocks base method.
+func (m *MockBGPPeerServiceIface) ChangeBgpPeersForVpcAsync(ctx context.Context, p *ChangeBgpPeersForVpcParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeBgpPeersForVpcAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeBgpPeersForVpcAsync indicates an expected call of ChangeBgpPeersForVpcAsync.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ChangeBgpPeersForVpcAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBgpPeersForVpcAsync", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ChangeBgpPeersForVpcAsync), ctx, p)
+}
+
+// ChangeBgpPeersForVpcWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) ChangeBgpPeersForVpcWithContext(ctx context.Context, p *ChangeBgpPeersForVpcParams) (*ChangeBgpPeersForVpcResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeBgpPeersForVpcWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeBgpPeersForVpcResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeBgpPeersForVpcWithContext indicates an expected call of ChangeBgpPeersForVpcWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ChangeBgpPeersForVpcWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBgpPeersForVpcWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ChangeBgpPeersForVpcWithContext), ctx, p)
+}
+
 // CreateBgpPeer mocks base method.
 func (m *MockBGPPeerServiceIface) CreateBgpPeer(p *CreateBgpPeerParams) (*CreateBgpPeerResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) CreateBgpPeer(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBgpPeer", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).CreateBgpPeer), p)
 }
 
+// CreateBgpPeerAsync mocks base method.
+func (m *MockBGPPeerServiceIface) CreateBgpPeerAsync(ctx context.Context, p *CreateBgpPeerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBgpPeerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBgpPeerAsync indicates an expected call of CreateBgpPeerAsync.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) CreateBgpPeerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBgpPeerAsync", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).CreateBgpPeerAsync), ctx, p)
+}
+
+// CreateBgpPeerWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) CreateBgpPeerWithContext(ctx context.Context, p *CreateBgpPeerParams) (*CreateBgpPeerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBgpPeerWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateBgpPeerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBgpPeerWithContext indicates an expected call of CreateBgpPeerWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) CreateBgpPeerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBgpPeerWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).CreateBgpPeerWithContext), ctx, p)
+}
+
 // DedicateBgpPeer mocks base method.
 func (m *MockBGPPeerServiceIface) DedicateBgpPeer(p *DedicateBgpPeerParams) (*DedicateBgpPeerResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) DedicateBgpPeer(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateBgpPeer", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).DedicateBgpPeer), p)
 }
 
+// DedicateBgpPeerAsync mocks base method.
+func (m *MockBGPPeerServiceIface) DedicateBgpPeerAsync(ctx context.Context, p *DedicateBgpPeerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateBgpPeerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateBgpPeerAsync indicates an expected call of DedicateBgpPeerAsync.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) DedicateBgpPeerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateBgpPeerAsync", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).DedicateBgpPeerAsync), ctx, p)
+}
+
+// DedicateBgpPeerWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) DedicateBgpPeerWithContext(ctx context.Context, p *DedicateBgpPeerParams) (*DedicateBgpPeerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateBgpPeerWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicateBgpPeerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateBgpPeerWithContext indicates an expected call of DedicateBgpPeerWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) DedicateBgpPeerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateBgpPeerWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).DedicateBgpPeerWithContext), ctx, p)
+}
+
 // DeleteBgpPeer mocks base method.
 func (m *MockBGPPeerServiceIface) DeleteBgpPeer(p *DeleteBgpPeerParams) (*DeleteBgpPeerResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) DeleteBgpPeer(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBgpPeer", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).DeleteBgpPeer), p)
 }
 
+// DeleteBgpPeerAsync mocks base method.
+func (m *MockBGPPeerServiceIface) DeleteBgpPeerAsync(ctx context.Context, p *DeleteBgpPeerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBgpPeerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBgpPeerAsync indicates an expected call of DeleteBgpPeerAsync.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) DeleteBgpPeerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBgpPeerAsync", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).DeleteBgpPeerAsync), ctx, p)
+}
+
+// DeleteBgpPeerWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) DeleteBgpPeerWithContext(ctx context.Context, p *DeleteBgpPeerParams) (*DeleteBgpPeerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBgpPeerWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBgpPeerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBgpPeerWithContext indicates an expected call of DeleteBgpPeerWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) DeleteBgpPeerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBgpPeerWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).DeleteBgpPeerWithContext), ctx, p)
+}
+
 // GetBgpPeerByID mocks base method.
 func (m *MockBGPPeerServiceIface) GetBgpPeerByID(id string, opts ...OptionFunc) (*BgpPeer, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +261,27 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) GetBgpPeerByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBgpPeerByID", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).GetBgpPeerByID), varargs...)
 }
 
+// GetBgpPeerByIDWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) GetBgpPeerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BgpPeer, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBgpPeerByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*BgpPeer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBgpPeerByIDWithContext indicates an expected call of GetBgpPeerByIDWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) GetBgpPeerByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBgpPeerByIDWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).GetBgpPeerByIDWithContext), varargs...)
+}
+
 // ListBgpPeers mocks base method.
 func (m *MockBGPPeerServiceIface) ListBgpPeers(p *ListBgpPeersParams) (*ListBgpPeersResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +297,99 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) ListBgpPeers(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBgpPeers", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ListBgpPeers), p)
 }
 
+// ListBgpPeersAll mocks base method.
+func (m *MockBGPPeerServiceIface) ListBgpPeersAll(p *ListBgpPeersParams, opts ...PageOption) ([]*BgpPeer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBgpPeersAll", varargs...)
+	ret0, _ := ret[0].([]*BgpPeer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBgpPeersAll indicates an expected call of ListBgpPeersAll.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ListBgpPeersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBgpPeersAll", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ListBgpPeersAll), varargs...)
+}
+
+// ListBgpPeersAllWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) ListBgpPeersAllWithContext(ctx context.Context, p *ListBgpPeersParams, opts ...PageOption) ([]*BgpPeer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBgpPeersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BgpPeer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBgpPeersAllWithContext indicates an expected call of ListBgpPeersAllWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ListBgpPeersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBgpPeersAllWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ListBgpPeersAllWithContext), varargs...)
+}
+
+// ListBgpPeersIter mocks base method.
+func (m *MockBGPPeerServiceIface) ListBgpPeersIter(p *ListBgpPeersParams, opts ...PageOption) iter.Seq2[*BgpPeer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBgpPeersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BgpPeer, error])
+	return ret0
+}
+
+// ListBgpPeersIter indicates an expected call of ListBgpPeersIter.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ListBgpPeersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBgpPeersIter", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ListBgpPeersIter), varargs...)
+}
+
+// ListBgpPeersIterWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) ListBgpPeersIterWithContext(ctx context.Context, p *ListBgpPeersParams, opts ...PageOption) iter.Seq2[*BgpPeer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBgpPeersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BgpPeer, error])
+	return ret0
+}
+
+// ListBgpPeersIterWithContext indicates an expected call of ListBgpPeersIterWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ListBgpPeersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBgpPeersIterWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ListBgpPeersIterWithContext), varargs...)
+}
+
+// ListBgpPeersWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) ListBgpPeersWithContext(ctx context.Context, p *ListBgpPeersParams) (*ListBgpPeersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBgpPeersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBgpPeersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBgpPeersWithContext indicates an expected call of ListBgpPeersWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ListBgpPeersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBgpPeersWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ListBgpPeersWithContext), ctx, p)
+}
+
 // NewChangeBgpPeersForVpcParams mocks base method.
 func (m *MockBGPPeerServiceIface) NewChangeBgpPeersForVpcParams(vpcid string) *ChangeBgpPeersForVpcParams {
 	m.ctrl.T.Helper()
@@ -267,6 +503,36 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) ReleaseBgpPeer(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBgpPeer", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ReleaseBgpPeer), p)
 }
 
+// ReleaseBgpPeerAsync mocks base method.
+func (m *MockBGPPeerServiceIface) ReleaseBgpPeerAsync(ctx context.Context, p *ReleaseBgpPeerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBgpPeerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseBgpPeerAsync indicates an expected call of ReleaseBgpPeerAsync.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ReleaseBgpPeerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBgpPeerAsync", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ReleaseBgpPeerAsync), ctx, p)
+}
+
+// ReleaseBgpPeerWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) ReleaseBgpPeerWithContext(ctx context.Context, p *ReleaseBgpPeerParams) (*ReleaseBgpPeerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseBgpPeerWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseBgpPeerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseBgpPeerWithContext indicates an expected call of ReleaseBgpPeerWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) ReleaseBgpPeerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseBgpPeerWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).ReleaseBgpPeerWithContext), ctx, p)
+}
+
 // UpdateBgpPeer mocks base method.
 func (m *MockBGPPeerServiceIface) UpdateBgpPeer(p *UpdateBgpPeerParams) (*UpdateBgpPeerResponse, error) {
 	m.ctrl.T.Helper()
@@ -281,3 +547,33 @@ func (mr *MockBGPPeerServiceIfaceMockRecorder) UpdateBgpPeer(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBgpPeer", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).UpdateBgpPeer), p)
 }
+
+// UpdateBgpPeerAsync mocks base method.
+func (m *MockBGPPeerServiceIface) UpdateBgpPeerAsync(ctx context.Context, p *UpdateBgpPeerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBgpPeerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBgpPeerAsync indicates an expected call of UpdateBgpPeerAsync.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) UpdateBgpPeerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBgpPeerAsync", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).UpdateBgpPeerAsync), ctx, p)
+}
+
+// UpdateBgpPeerWithContext mocks base method.
+func (m *MockBGPPeerServiceIface) UpdateBgpPeerWithContext(ctx context.Context, p *UpdateBgpPeerParams) (*UpdateBgpPeerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBgpPeerWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateBgpPeerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBgpPeerWithContext indicates an expected call of UpdateBgpPeerWithContext.
+func (mr *MockBGPPeerServiceIfaceMockRecorder) UpdateBgpPeerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBgpPeerWithContext", reflect.TypeOf((*MockBGPPeerServiceIface)(nil).UpdateBgpPeerWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,54 +31,109 @@ import (
 
 type BackupServiceIface interface {
 	AddBackupRepository(p *AddBackupRepositoryParams) (*AddBackupRepositoryResponse, error)
+	AddBackupRepositoryWithContext(ctx context.Context, p *AddBackupRepositoryParams) (*AddBackupRepositoryResponse, error)
 	NewAddBackupRepositoryParams(address string, name string, backupType string, zoneid string) *AddBackupRepositoryParams
 	CreateBackup(p *CreateBackupParams) (*CreateBackupResponse, error)
+	CreateBackupWithContext(ctx context.Context, p *CreateBackupParams) (*CreateBackupResponse, error)
+	CreateBackupAsync(ctx context.Context, p *CreateBackupParams) (*Job, error)
 	NewCreateBackupParams(virtualmachineid string) *CreateBackupParams
 	CreateBackupSchedule(p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error)
-	NewCreateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams
+	CreateBackupScheduleWithContext(ctx context.Context, p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error)
+	NewCreateBackupScheduleParams(intervaltype IntervalType, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams
 	CreateVMFromBackup(p *CreateVMFromBackupParams) (*CreateVMFromBackupResponse, error)
+	CreateVMFromBackupWithContext(ctx context.Context, p *CreateVMFromBackupParams) (*CreateVMFromBackupResponse, error)
+	CreateVMFromBackupAsync(ctx context.Context, p *CreateVMFromBackupParams) (*Job, error)
 	NewCreateVMFromBackupParams(backupid string, zoneid string) *CreateVMFromBackupParams
 	DeleteBackup(p *DeleteBackupParams) (*DeleteBackupResponse, error)
+	DeleteBackupWithContext(ctx context.Context, p *DeleteBackupParams) (*DeleteBackupResponse, error)
+	DeleteBackupAsync(ctx context.Context, p *DeleteBackupParams) (*Job, error)
 	NewDeleteBackupParams(id string) *DeleteBackupParams
 	DeleteBackupOffering(p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error)
+	DeleteBackupOfferingWithContext(ctx context.Context, p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error)
 	NewDeleteBackupOfferingParams(id string) *DeleteBackupOfferingParams
 	DeleteBackupRepository(p *DeleteBackupRepositoryParams) (*DeleteBackupRepositoryResponse, error)
+	DeleteBackupRepositoryWithContext(ctx context.Context, p *DeleteBackupRepositoryParams) (*DeleteBackupRepositoryResponse, error)
 	NewDeleteBackupRepositoryParams(id string) *DeleteBackupRepositoryParams
 	DeleteBackupSchedule(p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error)
+	DeleteBackupScheduleWithContext(ctx context.Context, p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error)
 	NewDeleteBackupScheduleParams() *DeleteBackupScheduleParams
 	ImportBackupOffering(p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error)
+	ImportBackupOfferingWithContext(ctx context.Context, p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error)
+	ImportBackupOfferingAsync(ctx context.Context, p *ImportBackupOfferingParams) (*Job, error)
 	NewImportBackupOfferingParams(allowuserdrivenbackups bool, description string, externalid string, name string, zoneid string) *ImportBackupOfferingParams
 	ListBackupOfferings(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error)
+	ListBackupOfferingsWithContext(ctx context.Context, p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error)
+	ListBackupOfferingsAll(p *ListBackupOfferingsParams, opts ...PageOption) ([]*BackupOffering, error)
+	ListBackupOfferingsAllWithContext(ctx context.Context, p *ListBackupOfferingsParams, opts ...PageOption) ([]*BackupOffering, error)
+	ListBackupOfferingsIter(p *ListBackupOfferingsParams, opts ...PageOption) iter.Seq2[*BackupOffering, error]
+	ListBackupOfferingsIterWithContext(ctx context.Context, p *ListBackupOfferingsParams, opts ...PageOption) iter.Seq2[*BackupOffering, error]
 	NewListBackupOfferingsParams() *ListBackupOfferingsParams
 	GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetBackupOfferingIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error)
+	GetBackupOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupOffering, int, error)
 	GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error)
+	GetBackupOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupOffering, int, error)
 	ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error)
+	ListBackupProviderOfferingsWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error)
+	ListBackupProviderOfferingsAll(p *ListBackupProviderOfferingsParams, opts ...PageOption) ([]*BackupProviderOffering, error)
+	ListBackupProviderOfferingsAllWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams, opts ...PageOption) ([]*BackupProviderOffering, error)
+	ListBackupProviderOfferingsIter(p *ListBackupProviderOfferingsParams, opts ...PageOption) iter.Seq2[*BackupProviderOffering, error]
+	ListBackupProviderOfferingsIterWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams, opts ...PageOption) iter.Seq2[*BackupProviderOffering, error]
 	NewListBackupProviderOfferingsParams(zoneid string) *ListBackupProviderOfferingsParams
 	GetBackupProviderOfferingID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetBackupProviderOfferingIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
 	ListBackupProviders(p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error)
+	ListBackupProvidersWithContext(ctx context.Context, p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error)
 	NewListBackupProvidersParams() *ListBackupProvidersParams
 	ListBackupRepositories(p *ListBackupRepositoriesParams) (*ListBackupRepositoriesResponse, error)
+	ListBackupRepositoriesWithContext(ctx context.Context, p *ListBackupRepositoriesParams) (*ListBackupRepositoriesResponse, error)
+	ListBackupRepositoriesAll(p *ListBackupRepositoriesParams, opts ...PageOption) ([]*BackupRepository, error)
+	ListBackupRepositoriesAllWithContext(ctx context.Context, p *ListBackupRepositoriesParams, opts ...PageOption) ([]*BackupRepository, error)
+	ListBackupRepositoriesIter(p *ListBackupRepositoriesParams, opts ...PageOption) iter.Seq2[*BackupRepository, error]
+	ListBackupRepositoriesIterWithContext(ctx context.Context, p *ListBackupRepositoriesParams, opts ...PageOption) iter.Seq2[*BackupRepository, error]
 	NewListBackupRepositoriesParams() *ListBackupRepositoriesParams
 	GetBackupRepositoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetBackupRepositoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetBackupRepositoryByName(name string, opts ...OptionFunc) (*BackupRepository, int, error)
+	GetBackupRepositoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupRepository, int, error)
 	GetBackupRepositoryByID(id string, opts ...OptionFunc) (*BackupRepository, int, error)
+	GetBackupRepositoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupRepository, int, error)
 	ListBackupSchedule(p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error)
+	ListBackupScheduleWithContext(ctx context.Context, p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error)
+	ListBackupScheduleAll(p *ListBackupScheduleParams, opts ...PageOption) ([]*BackupSchedule, error)
+	ListBackupScheduleAllWithContext(ctx context.Context, p *ListBackupScheduleParams, opts ...PageOption) ([]*BackupSchedule, error)
+	ListBackupScheduleIter(p *ListBackupScheduleParams, opts ...PageOption) iter.Seq2[*BackupSchedule, error]
+	ListBackupScheduleIterWithContext(ctx context.Context, p *ListBackupScheduleParams, opts ...PageOption) iter.Seq2[*BackupSchedule, error]
 	NewListBackupScheduleParams() *ListBackupScheduleParams
 	GetBackupScheduleByID(id string, opts ...OptionFunc) (*BackupSchedule, int, error)
+	GetBackupScheduleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupSchedule, int, error)
 	ListBackups(p *ListBackupsParams) (*ListBackupsResponse, error)
+	ListBackupsWithContext(ctx context.Context, p *ListBackupsParams) (*ListBackupsResponse, error)
+	ListBackupsAll(p *ListBackupsParams, opts ...PageOption) ([]*Backup, error)
+	ListBackupsAllWithContext(ctx context.Context, p *ListBackupsParams, opts ...PageOption) ([]*Backup, error)
+	ListBackupsIter(p *ListBackupsParams, opts ...PageOption) iter.Seq2[*Backup, error]
+	ListBackupsIterWithContext(ctx context.Context, p *ListBackupsParams, opts ...PageOption) iter.Seq2[*Backup, error]
 	NewListBackupsParams() *ListBackupsParams
 	GetBackupID(name string, opts ...OptionFunc) (string, int, error)
+	GetBackupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetBackupByName(name string, opts ...OptionFunc) (*Backup, int, error)
+	GetBackupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Backup, int, error)
 	GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error)
+	GetBackupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Backup, int, error)
 	RestoreBackup(p *RestoreBackupParams) (*RestoreBackupResponse, error)
+	RestoreBackupWithContext(ctx context.Context, p *RestoreBackupParams) (*RestoreBackupResponse, error)
+	RestoreBackupAsync(ctx context.Context, p *RestoreBackupParams) (*Job, error)
 	NewRestoreBackupParams(id string) *RestoreBackupParams
 	UpdateBackupRepository(p *UpdateBackupRepositoryParams) (*UpdateBackupRepositoryResponse, error)
+	UpdateBackupRepositoryWithContext(ctx context.Context, p *UpdateBackupRepositoryParams) (*UpdateBackupRepositoryResponse, error)
 	NewUpdateBackupRepositoryParams(id string) *UpdateBackupRepositoryParams
 	UpdateBackupOffering(p *UpdateBackupOfferingParams) (*UpdateBackupOfferingResponse, error)
+	UpdateBackupOfferingWithContext(ctx context.Context, p *UpdateBackupOfferingParams) (*UpdateBackupOfferingResponse, error)
 	NewUpdateBackupOfferingParams(id string) *UpdateBackupOfferingParams
 	UpdateBackupSchedule(p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error)
-	NewUpdateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams
+	UpdateBackupScheduleWithContext(ctx context.Context, p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error)
+	NewUpdateBackupScheduleParams(intervaltype IntervalType, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams
 }
 
 type AddBackupRepositoryParams struct {
@@ -117,6 +174,51 @@ func (p *AddBackupRepositoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBackupRepository API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBackupRepositoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBackupRepository", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBackupRepository API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBackupRepositoryParams) Validate() error {
+	return paramRules{
+		required: []string{"address", "name", "type", "zoneid"},
+		lengths:  map[string]int{"address": 255, "mountopts": 255, "name": 255, "provider": 255, "type": 255, "zoneid": 255},
+	}.validate("addBackupRepository", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBackupRepository API, before the request is signed
+func (p *AddBackupRepositoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBackupRepositoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBackupRepositoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBackupRepository", b, &p.p, map[string]paramDecoder{
+		"address":                   decodeParam[string],
+		"capacitybytes":             decodeParam[int64],
+		"crosszoneinstancecreation": decodeParam[bool],
+		"mountopts":                 decodeParam[string],
+		"name":                      decodeParam[string],
+		"provider":                  decodeParam[string],
+		"type":                      decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBackupRepositoryParams) Clone() *AddBackupRepositoryParams {
+	return &AddBackupRepositoryParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBackupRepositoryParams) SetAddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -299,13 +401,22 @@ func (s *BackupService) NewAddBackupRepositoryParams(address string, name string
 
 // Adds a backup repository to store NAS backups
 func (s *BackupService) AddBackupRepository(p *AddBackupRepositoryParams) (*AddBackupRepositoryResponse, error) {
-	resp, err := s.cs.newPostRequest("addBackupRepository", p.toURLValues())
+	return s.AddBackupRepositoryWithContext(context.Background(), p)
+}
+
+// AddBackupRepositoryWithContext is like AddBackupRepository, but honours the cancellation and deadline of ctx
+func (s *BackupService) AddBackupRepositoryWithContext(ctx context.Context, p *AddBackupRepositoryParams) (*AddBackupRepositoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addBackupRepository", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBackupRepositoryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBackupRepository", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -313,19 +424,25 @@ func (s *BackupService) AddBackupRepository(p *AddBackupRepositoryParams) (*AddB
 }
 
 type AddBackupRepositoryResponse struct {
-	Address                   string `json:"address"`
-	Capacitybytes             int64  `json:"capacitybytes"`
-	Created                   string `json:"created"`
-	Crosszoneinstancecreation bool   `json:"crosszoneinstancecreation"`
-	Id                        string `json:"id"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Mountopts                 string `json:"mountopts"`
-	Name                      string `json:"name"`
-	Provider                  string `json:"provider"`
-	Type                      string `json:"type"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Address                   string                     `json:"address"`
+	Capacitybytes             FlexInt64                  `json:"capacitybytes"`
+	Created                   Time                       `json:"created"`
+	Crosszoneinstancecreation FlexBool                   `json:"crosszoneinstancecreation"`
+	Id                        string                     `json:"id"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Mountopts                 string                     `json:"mountopts"`
+	Name                      string                     `json:"name"`
+	Provider                  string                     `json:"provider"`
+	Type                      string                     `json:"type"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBackupRepositoryResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBackupRepositoryResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateBackupParams struct {
@@ -353,6 +470,47 @@ func (p *CreateBackupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createBackup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateBackupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createBackup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createBackup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateBackupParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "virtualmachineid": 255},
+	}.validate("createBackup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createBackup API, before the request is signed
+func (p *CreateBackupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateBackupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateBackupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createBackup", b, &p.p, map[string]paramDecoder{
+		"description":      decodeParam[string],
+		"name":             decodeParam[string],
+		"quiescevm":        decodeParam[bool],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateBackupParams) Clone() *CreateBackupParams {
+	return &CreateBackupParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateBackupParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -448,27 +606,39 @@ func (s *BackupService) NewCreateBackupParams(virtualmachineid string) *CreateBa
 
 // Create Instance backup
 func (s *BackupService) CreateBackup(p *CreateBackupParams) (*CreateBackupResponse, error) {
-	resp, err := s.cs.newPostRequest("createBackup", p.toURLValues())
+	return s.CreateBackupWithContext(context.Background(), p)
+}
+
+// CreateBackupWithContext is like CreateBackup, but honours the cancellation and deadline of ctx
+func (s *BackupService) CreateBackupWithContext(ctx context.Context, p *CreateBackupParams) (*CreateBackupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createBackup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateBackupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createBackup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createBackup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createBackup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -476,11 +646,31 @@ func (s *BackupService) CreateBackup(p *CreateBackupParams) (*CreateBackupRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateBackup async job into r
+func (r *CreateBackupResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// CreateBackupAsync starts the async job of CreateBackup without waiting for it to finish, and returns a handle to the job
+func (s *BackupService) CreateBackupAsync(ctx context.Context, p *CreateBackupParams) (*Job, error) {
+	r, err := s.CreateBackupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createBackup", r.JobID), nil
+}
+
 type CreateBackupResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateBackupResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateBackupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateBackupScheduleParams struct {
@@ -493,7 +683,7 @@ func (p *CreateBackupScheduleParams) toURLValues() url.Values {
 		return u
 	}
 	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
+		u.Set("intervaltype", string(v.(IntervalType)))
 	}
 	if v, found := p.p["maxbackups"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -515,7 +705,50 @@ func (p *CreateBackupScheduleParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *CreateBackupScheduleParams) SetIntervaltype(v string) {
+// ValidateAgainst checks the parameters against the createBackupSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateBackupScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createBackupSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createBackupSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateBackupScheduleParams) Validate() error {
+	return paramRules{
+		required: []string{"intervaltype", "schedule", "timezone", "virtualmachineid"},
+		lengths:  map[string]int{"intervaltype": 255, "schedule": 255, "timezone": 255, "virtualmachineid": 255},
+	}.validate("createBackupSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createBackupSchedule API, before the request is signed
+func (p *CreateBackupScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createBackupSchedule", b, &p.p, map[string]paramDecoder{
+		"intervaltype":     decodeParam[IntervalType],
+		"maxbackups":       decodeParam[int],
+		"quiescevm":        decodeParam[bool],
+		"schedule":         decodeParam[string],
+		"timezone":         decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateBackupScheduleParams) Clone() *CreateBackupScheduleParams {
+	return &CreateBackupScheduleParams{p: cloneParams(p.p)}
+}
+
+func (p *CreateBackupScheduleParams) SetIntervaltype(v IntervalType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -528,11 +761,11 @@ func (p *CreateBackupScheduleParams) ResetIntervaltype() {
 	}
 }
 
-func (p *CreateBackupScheduleParams) GetIntervaltype() (string, bool) {
+func (p *CreateBackupScheduleParams) GetIntervaltype() (IntervalType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["intervaltype"].(string)
+	value, ok := p.p["intervaltype"].(IntervalType)
 	return value, ok
 }
 
@@ -643,7 +876,7 @@ func (p *CreateBackupScheduleParams) GetVirtualmachineid() (string, bool) {
 
 // You should always use this function to get a new CreateBackupScheduleParams instance,
 // as then you are sure you have configured all required params
-func (s *BackupService) NewCreateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams {
+func (s *BackupService) NewCreateBackupScheduleParams(intervaltype IntervalType, schedule string, timezone string, virtualmachineid string) *CreateBackupScheduleParams {
 	p := &CreateBackupScheduleParams{}
 	p.p = make(map[string]interface{})
 	p.p["intervaltype"] = intervaltype
@@ -655,13 +888,22 @@ func (s *BackupService) NewCreateBackupScheduleParams(intervaltype string, sched
 
 // Creates a User-defined Instance backup schedule
 func (s *BackupService) CreateBackupSchedule(p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error) {
-	resp, err := s.cs.newPostRequest("createBackupSchedule", p.toURLValues())
+	return s.CreateBackupScheduleWithContext(context.Background(), p)
+}
+
+// CreateBackupScheduleWithContext is like CreateBackupSchedule, but honours the cancellation and deadline of ctx
+func (s *BackupService) CreateBackupScheduleWithContext(ctx context.Context, p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createBackupSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateBackupScheduleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createBackupSchedule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -669,16 +911,22 @@ func (s *BackupService) CreateBackupSchedule(p *CreateBackupScheduleParams) (*Cr
 }
 
 type CreateBackupScheduleResponse struct {
-	Id                 string `json:"id"`
-	Intervaltype       string `json:"intervaltype"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Maxbackups         int    `json:"maxbackups"`
-	Quiescevm          bool   `json:"quiescevm"`
-	Schedule           string `json:"schedule"`
-	Timezone           string `json:"timezone"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
+	Id                 string                     `json:"id"`
+	Intervaltype       string                     `json:"intervaltype"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Maxbackups         FlexInt                    `json:"maxbackups"`
+	Quiescevm          FlexBool                   `json:"quiescevm"`
+	Schedule           string                     `json:"schedule"`
+	Timezone           string                     `json:"timezone"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateBackupScheduleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateBackupScheduleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVMFromBackupParams struct {
@@ -790,7 +1038,7 @@ func (p *CreateVMFromBackupParams) toURLValues() url.Values {
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["iodriverpolicy"]; found {
 		u.Set("iodriverpolicy", v.(string))
@@ -923,6 +1171,101 @@ func (p *CreateVMFromBackupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVMFromBackup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVMFromBackupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVMFromBackup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVMFromBackup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVMFromBackupParams) Validate() error {
+	return paramRules{
+		required: []string{"backupid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "backupid": 255, "bootmode": 255, "boottype": 255, "clusterid": 255, "customid": 255, "deploymentplanner": 255, "diskofferingid": 255, "displayname": 255, "domainid": 255, "extraconfig": 255, "group": 255, "hostid": 255, "hypervisor": 255, "iodriverpolicy": 255, "ip6address": 255, "ipaddress": 255, "keyboard": 255, "keypair": 255, "leaseexpiryaction": 255, "macaddress": 255, "name": 255, "overridediskofferingid": 255, "password": 255, "podid": 255, "projectid": 255, "serviceofferingid": 255, "templateid": 255, "userdata": 1048576, "userdataid": 255, "zoneid": 255},
+	}.validate("createVMFromBackup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVMFromBackup API, before the request is signed
+func (p *CreateVMFromBackupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVMFromBackupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVMFromBackupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVMFromBackup", b, &p.p, map[string]paramDecoder{
+		"account":                    decodeParam[string],
+		"affinitygroupids":           decodeParam[[]string],
+		"affinitygroupnames":         decodeParam[[]string],
+		"backupid":                   decodeParam[string],
+		"bootintosetup":              decodeParam[bool],
+		"bootmode":                   decodeParam[string],
+		"boottype":                   decodeParam[string],
+		"clusterid":                  decodeParam[string],
+		"copyimagetags":              decodeParam[bool],
+		"customid":                   decodeParam[string],
+		"datadiskofferinglist":       decodeParam[map[string]string],
+		"datadisksdetails":           decodeParam[[]map[string]string],
+		"deploymentplanner":          decodeParam[string],
+		"details":                    decodeParam[map[string]string],
+		"dhcpoptionsnetworklist":     decodeParam[[]map[string]string],
+		"diskofferingid":             decodeParam[string],
+		"displayname":                decodeParam[string],
+		"displayvm":                  decodeParam[bool],
+		"domainid":                   decodeParam[string],
+		"dynamicscalingenabled":      decodeParam[bool],
+		"externaldetails":            decodeParam[map[string]string],
+		"extraconfig":                decodeParam[string],
+		"group":                      decodeParam[string],
+		"hostid":                     decodeParam[string],
+		"hypervisor":                 decodeParam[HypervisorType],
+		"iodriverpolicy":             decodeParam[string],
+		"iothreadsenabled":           decodeParam[bool],
+		"ip6address":                 decodeParam[string],
+		"ipaddress":                  decodeParam[string],
+		"iptonetworklist":            decodeParam[[]map[string]string],
+		"keyboard":                   decodeParam[string],
+		"keypair":                    decodeParam[string],
+		"keypairs":                   decodeParam[[]string],
+		"leaseduration":              decodeParam[int],
+		"leaseexpiryaction":          decodeParam[string],
+		"macaddress":                 decodeParam[string],
+		"name":                       decodeParam[string],
+		"networkids":                 decodeParam[[]string],
+		"nicmultiqueuenumber":        decodeParam[int],
+		"nicnetworklist":             decodeParam[[]map[string]string],
+		"nicpackedvirtqueuesenabled": decodeParam[bool],
+		"overridediskofferingid":     decodeParam[string],
+		"password":                   decodeParam[string],
+		"podid":                      decodeParam[string],
+		"preserveip":                 decodeParam[bool],
+		"projectid":                  decodeParam[string],
+		"properties":                 decodeParam[map[string]string],
+		"rootdisksize":               decodeParam[int64],
+		"securitygroupids":           decodeParam[[]string],
+		"securitygroupnames":         decodeParam[[]string],
+		"serviceofferingid":          decodeParam[string],
+		"size":                       decodeParam[int64],
+		"startvm":                    decodeParam[bool],
+		"templateid":                 decodeParam[string],
+		"userdata":                   decodeParam[string],
+		"userdatadetails":            decodeParam[map[string]string],
+		"userdataid":                 decodeParam[string],
+		"zoneid":                     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVMFromBackupParams) Clone() *CreateVMFromBackupParams {
+	return &CreateVMFromBackupParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVMFromBackupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1455,7 +1798,7 @@ func (p *CreateVMFromBackupParams) GetHostid() (string, bool) {
 	return value, ok
 }
 
-func (p *CreateVMFromBackupParams) SetHypervisor(v string) {
+func (p *CreateVMFromBackupParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1468,11 +1811,11 @@ func (p *CreateVMFromBackupParams) ResetHypervisor() {
 	}
 }
 
-func (p *CreateVMFromBackupParams) GetHypervisor() (string, bool) {
+func (p *CreateVMFromBackupParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -2209,32 +2552,39 @@ func (s *BackupService) NewCreateVMFromBackupParams(backupid string, zoneid stri
 
 // Creates and automatically starts a VM from a backup.
 func (s *BackupService) CreateVMFromBackup(p *CreateVMFromBackupParams) (*CreateVMFromBackupResponse, error) {
-	resp, err := s.cs.newPostRequest("createVMFromBackup", p.toURLValues())
+	return s.CreateVMFromBackupWithContext(context.Background(), p)
+}
+
+// CreateVMFromBackupWithContext is like CreateVMFromBackup, but honours the cancellation and deadline of ctx
+func (s *BackupService) CreateVMFromBackupWithContext(ctx context.Context, p *CreateVMFromBackupParams) (*CreateVMFromBackupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVMFromBackup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVMFromBackupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVMFromBackup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVMFromBackup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVMFromBackup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2242,6 +2592,25 @@ func (s *BackupService) CreateVMFromBackup(p *CreateVMFromBackupParams) (*Create
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVMFromBackup async job into r
+func (r *CreateVMFromBackupResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVMFromBackupAsync starts the async job of CreateVMFromBackup without waiting for it to finish, and returns a handle to the job
+func (s *BackupService) CreateVMFromBackupAsync(ctx context.Context, p *CreateVMFromBackupParams) (*Job, error) {
+	r, err := s.CreateVMFromBackupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVMFromBackup", r.JobID), nil
+}
+
 type CreateVMFromBackupResponse struct {
 	Account               string                                    `json:"account"`
 	Affinitygroup         []CreateVMFromBackupResponseAffinitygroup `json:"affinitygroup"`
@@ -2253,32 +2622,32 @@ type CreateVMFromBackupResponse struct {
 	Backupofferingname    string                                    `json:"backupofferingname"`
 	Bootmode              string                                    `json:"bootmode"`
 	Boottype              string                                    `json:"boottype"`
-	Cpunumber             int                                       `json:"cpunumber"`
-	Cpuspeed              int                                       `json:"cpuspeed"`
+	Cpunumber             FlexInt                                   `json:"cpunumber"`
+	Cpuspeed              FlexInt                                   `json:"cpuspeed"`
 	Cpuused               string                                    `json:"cpuused"`
-	Created               string                                    `json:"created"`
-	Deleteprotection      bool                                      `json:"deleteprotection"`
+	Created               Time                                      `json:"created"`
+	Deleteprotection      FlexBool                                  `json:"deleteprotection"`
 	Details               map[string]string                         `json:"details"`
-	Diskioread            int64                                     `json:"diskioread"`
-	Diskiowrite           int64                                     `json:"diskiowrite"`
-	Diskkbsread           int64                                     `json:"diskkbsread"`
-	Diskkbswrite          int64                                     `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                 `json:"diskioread"`
+	Diskiowrite           FlexInt64                                 `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                 `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                 `json:"diskkbswrite"`
 	Diskofferingid        string                                    `json:"diskofferingid"`
 	Diskofferingname      string                                    `json:"diskofferingname"`
 	Displayname           string                                    `json:"displayname"`
-	Displayvm             bool                                      `json:"displayvm"`
+	Displayvm             FlexBool                                  `json:"displayvm"`
 	Domain                string                                    `json:"domain"`
 	Domainid              string                                    `json:"domainid"`
 	Domainpath            string                                    `json:"domainpath"`
-	Forvirtualnetwork     bool                                      `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                  `json:"forvirtualnetwork"`
 	Gpucardid             string                                    `json:"gpucardid"`
 	Gpucardname           string                                    `json:"gpucardname"`
-	Gpucount              int                                       `json:"gpucount"`
+	Gpucount              FlexInt                                   `json:"gpucount"`
 	Group                 string                                    `json:"group"`
 	Groupid               string                                    `json:"groupid"`
 	Guestosid             string                                    `json:"guestosid"`
-	Haenable              bool                                      `json:"haenable"`
-	Hasannotations        bool                                      `json:"hasannotations"`
+	Haenable              FlexBool                                  `json:"haenable"`
+	Hasannotations        FlexBool                                  `json:"hasannotations"`
 	Hostcontrolstate      string                                    `json:"hostcontrolstate"`
 	Hostid                string                                    `json:"hostid"`
 	Hostname              string                                    `json:"hostname"`
@@ -2287,43 +2656,43 @@ type CreateVMFromBackupResponse struct {
 	Id                    string                                    `json:"id"`
 	Instancename          string                                    `json:"instancename"`
 	Ipaddress             string                                    `json:"ipaddress"`
-	Isdynamicallyscalable bool                                      `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                  `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                    `json:"isodisplaytext"`
 	Isoid                 string                                    `json:"isoid"`
 	Isoname               string                                    `json:"isoname"`
 	JobID                 string                                    `json:"jobid"`
-	Jobstatus             int                                       `json:"jobstatus"`
+	Jobstatus             FlexInt                                   `json:"jobstatus"`
 	Keypairs              string                                    `json:"keypairs"`
-	Lastupdated           string                                    `json:"lastupdated"`
-	Leaseduration         int                                       `json:"leaseduration"`
+	Lastupdated           Time                                      `json:"lastupdated"`
+	Leaseduration         FlexInt                                   `json:"leaseduration"`
 	Leaseexpiryaction     string                                    `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                    `json:"leaseexpirydate"`
-	Maxheads              int64                                     `json:"maxheads"`
-	Maxresolutionx        int64                                     `json:"maxresolutionx"`
-	Maxresolutiony        int64                                     `json:"maxresolutiony"`
-	Memory                int                                       `json:"memory"`
-	Memoryintfreekbs      int64                                     `json:"memoryintfreekbs"`
-	Memorykbs             int64                                     `json:"memorykbs"`
-	Memorytargetkbs       int64                                     `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                      `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                 `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                 `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                 `json:"maxresolutiony"`
+	Memory                FlexInt                                   `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                 `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                 `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                 `json:"memorytargetkbs"`
 	Name                  string                                    `json:"name"`
-	Networkkbsread        int64                                     `json:"networkkbsread"`
-	Networkkbswrite       int64                                     `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                 `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                 `json:"networkkbswrite"`
 	Nic                   []Nic                                     `json:"nic"`
 	Osdisplayname         string                                    `json:"osdisplayname"`
 	Ostypeid              string                                    `json:"ostypeid"`
 	Password              string                                    `json:"password"`
-	Passwordenabled       bool                                      `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                  `json:"passwordenabled"`
 	Pooltype              string                                    `json:"pooltype"`
 	Project               string                                    `json:"project"`
 	Projectid             string                                    `json:"projectid"`
 	Publicip              string                                    `json:"publicip"`
 	Publicipid            string                                    `json:"publicipid"`
 	Readonlydetails       string                                    `json:"readonlydetails"`
-	Receivedbytes         int64                                     `json:"receivedbytes"`
-	Rootdeviceid          int64                                     `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                 `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                 `json:"rootdeviceid"`
 	Rootdevicetype        string                                    `json:"rootdevicetype"`
 	Securitygroup         []CreateVMFromBackupResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                     `json:"sentbytes"`
+	Sentbytes             FlexInt64                                 `json:"sentbytes"`
 	Serviceofferingid     string                                    `json:"serviceofferingid"`
 	Serviceofferingname   string                                    `json:"serviceofferingname"`
 	Servicestate          string                                    `json:"servicestate"`
@@ -2344,12 +2713,13 @@ type CreateVMFromBackupResponse struct {
 	Vgpu                  string                                    `json:"vgpu"`
 	Vgpuprofileid         string                                    `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                    `json:"vgpuprofilename"`
-	Videoram              int64                                     `json:"videoram"`
+	Videoram              FlexInt64                                 `json:"videoram"`
 	Vmtype                string                                    `json:"vmtype"`
 	Vnfdetails            map[string]string                         `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                 `json:"vnfnics"`
 	Zoneid                string                                    `json:"zoneid"`
 	Zonename              string                                    `json:"zonename"`
+	Extra                 map[string]json.RawMessage                `json:"-"`
 }
 
 type CreateVMFromBackupResponseSecuritygroup struct {
@@ -2365,36 +2735,54 @@ type CreateVMFromBackupResponseSecuritygroup struct {
 	Project             string                                        `json:"project"`
 	Projectid           string                                        `json:"projectid"`
 	Tags                []Tags                                        `json:"tags"`
-	Virtualmachinecount int                                           `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                       `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                 `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                    `json:"-"`
+}
+
+func (r *CreateVMFromBackupResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias CreateVMFromBackupResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVMFromBackupResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVMFromBackupResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias CreateVMFromBackupResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVMFromBackupResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVMFromBackupResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias CreateVMFromBackupResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *CreateVMFromBackupResponse) UnmarshalJSON(b []byte) error {
@@ -2421,7 +2809,7 @@ func (r *CreateVMFromBackupResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CreateVMFromBackupResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBackupParams struct {
@@ -2443,6 +2831,45 @@ func (p *DeleteBackupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBackup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBackupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBackup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBackup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBackupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteBackup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBackup API, before the request is signed
+func (p *DeleteBackupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBackupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBackupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBackup", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBackupParams) Clone() *DeleteBackupParams {
+	return &DeleteBackupParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBackupParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2496,27 +2923,39 @@ func (s *BackupService) NewDeleteBackupParams(id string) *DeleteBackupParams {
 
 // Delete Instance backup
 func (s *BackupService) DeleteBackup(p *DeleteBackupParams) (*DeleteBackupResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBackup", p.toURLValues())
+	return s.DeleteBackupWithContext(context.Background(), p)
+}
+
+// DeleteBackupWithContext is like DeleteBackup, but honours the cancellation and deadline of ctx
+func (s *BackupService) DeleteBackupWithContext(ctx context.Context, p *DeleteBackupParams) (*DeleteBackupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBackup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBackupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBackup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteBackup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteBackup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2524,11 +2963,31 @@ func (s *BackupService) DeleteBackup(p *DeleteBackupParams) (*DeleteBackupRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteBackup async job into r
+func (r *DeleteBackupResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteBackupAsync starts the async job of DeleteBackup without waiting for it to finish, and returns a handle to the job
+func (s *BackupService) DeleteBackupAsync(ctx context.Context, p *DeleteBackupParams) (*Job, error) {
+	r, err := s.DeleteBackupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteBackup", r.JobID), nil
+}
+
 type DeleteBackupResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteBackupResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteBackupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBackupOfferingParams struct {
@@ -2546,6 +3005,44 @@ func (p *DeleteBackupOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBackupOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBackupOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBackupOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBackupOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBackupOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteBackupOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBackupOffering API, before the request is signed
+func (p *DeleteBackupOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBackupOffering", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBackupOfferingParams) Clone() *DeleteBackupOfferingParams {
+	return &DeleteBackupOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBackupOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2578,13 +3075,22 @@ func (s *BackupService) NewDeleteBackupOfferingParams(id string) *DeleteBackupOf
 
 // Deletes a backup offering
 func (s *BackupService) DeleteBackupOffering(p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBackupOffering", p.toURLValues())
+	return s.DeleteBackupOfferingWithContext(context.Background(), p)
+}
+
+// DeleteBackupOfferingWithContext is like DeleteBackupOffering, but honours the cancellation and deadline of ctx
+func (s *BackupService) DeleteBackupOfferingWithContext(ctx context.Context, p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBackupOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBackupOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBackupOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2592,10 +3098,11 @@ func (s *BackupService) DeleteBackupOffering(p *DeleteBackupOfferingParams) (*De
 }
 
 type DeleteBackupOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteBackupOfferingResponse) UnmarshalJSON(b []byte) error {
@@ -2622,7 +3129,7 @@ func (r *DeleteBackupOfferingResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteBackupOfferingResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBackupRepositoryParams struct {
@@ -2640,6 +3147,44 @@ func (p *DeleteBackupRepositoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBackupRepository API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBackupRepositoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBackupRepository", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBackupRepository API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBackupRepositoryParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteBackupRepository", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBackupRepository API, before the request is signed
+func (p *DeleteBackupRepositoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBackupRepositoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBackupRepositoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBackupRepository", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBackupRepositoryParams) Clone() *DeleteBackupRepositoryParams {
+	return &DeleteBackupRepositoryParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBackupRepositoryParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2672,13 +3217,22 @@ func (s *BackupService) NewDeleteBackupRepositoryParams(id string) *DeleteBackup
 
 // delete a backup repository
 func (s *BackupService) DeleteBackupRepository(p *DeleteBackupRepositoryParams) (*DeleteBackupRepositoryResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBackupRepository", p.toURLValues())
+	return s.DeleteBackupRepositoryWithContext(context.Background(), p)
+}
+
+// DeleteBackupRepositoryWithContext is like DeleteBackupRepository, but honours the cancellation and deadline of ctx
+func (s *BackupService) DeleteBackupRepositoryWithContext(ctx context.Context, p *DeleteBackupRepositoryParams) (*DeleteBackupRepositoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBackupRepository", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBackupRepositoryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBackupRepository", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2686,10 +3240,11 @@ func (s *BackupService) DeleteBackupRepository(p *DeleteBackupRepositoryParams)
 }
 
 type DeleteBackupRepositoryResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteBackupRepositoryResponse) UnmarshalJSON(b []byte) error {
@@ -2716,7 +3271,7 @@ func (r *DeleteBackupRepositoryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteBackupRepositoryResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBackupScheduleParams struct {
@@ -2737,6 +3292,44 @@ func (p *DeleteBackupScheduleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBackupSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBackupScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBackupSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBackupSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBackupScheduleParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "virtualmachineid": 255},
+	}.validate("deleteBackupSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBackupSchedule API, before the request is signed
+func (p *DeleteBackupScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBackupSchedule", b, &p.p, map[string]paramDecoder{
+		"id":               decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBackupScheduleParams) Clone() *DeleteBackupScheduleParams {
+	return &DeleteBackupScheduleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBackupScheduleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2789,13 +3382,22 @@ func (s *BackupService) NewDeleteBackupScheduleParams() *DeleteBackupSchedulePar
 
 // Deletes the backup schedule of a Instance
 func (s *BackupService) DeleteBackupSchedule(p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBackupSchedule", p.toURLValues())
+	return s.DeleteBackupScheduleWithContext(context.Background(), p)
+}
+
+// DeleteBackupScheduleWithContext is like DeleteBackupSchedule, but honours the cancellation and deadline of ctx
+func (s *BackupService) DeleteBackupScheduleWithContext(ctx context.Context, p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBackupSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBackupScheduleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBackupSchedule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2803,10 +3405,11 @@ func (s *BackupService) DeleteBackupSchedule(p *DeleteBackupScheduleParams) (*De
 }
 
 type DeleteBackupScheduleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteBackupScheduleResponse) UnmarshalJSON(b []byte) error {
@@ -2833,7 +3436,7 @@ func (r *DeleteBackupScheduleResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteBackupScheduleResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportBackupOfferingParams struct {
@@ -2864,6 +3467,48 @@ func (p *ImportBackupOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the importBackupOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ImportBackupOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("importBackupOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the importBackupOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ImportBackupOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"allowuserdrivenbackups", "description", "externalid", "name", "zoneid"},
+		lengths:  map[string]int{"description": 4096, "externalid": 255, "name": 255, "zoneid": 255},
+	}.validate("importBackupOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the importBackupOffering API, before the request is signed
+func (p *ImportBackupOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ImportBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ImportBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("importBackupOffering", b, &p.p, map[string]paramDecoder{
+		"allowuserdrivenbackups": decodeParam[bool],
+		"description":            decodeParam[string],
+		"externalid":             decodeParam[string],
+		"name":                   decodeParam[string],
+		"zoneid":                 decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ImportBackupOfferingParams) Clone() *ImportBackupOfferingParams {
+	return &ImportBackupOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *ImportBackupOfferingParams) SetAllowuserdrivenbackups(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2984,32 +3629,39 @@ func (s *BackupService) NewImportBackupOfferingParams(allowuserdrivenbackups boo
 
 // Imports a backup offering using a backup provider
 func (s *BackupService) ImportBackupOffering(p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("importBackupOffering", p.toURLValues())
+	return s.ImportBackupOfferingWithContext(context.Background(), p)
+}
+
+// ImportBackupOfferingWithContext is like ImportBackupOffering, but honours the cancellation and deadline of ctx
+func (s *BackupService) ImportBackupOfferingWithContext(ctx context.Context, p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "importBackupOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ImportBackupOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("importBackupOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "importBackupOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("importBackupOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3017,19 +3669,44 @@ func (s *BackupService) ImportBackupOffering(p *ImportBackupOfferingParams) (*Im
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ImportBackupOffering async job into r
+func (r *ImportBackupOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ImportBackupOfferingAsync starts the async job of ImportBackupOffering without waiting for it to finish, and returns a handle to the job
+func (s *BackupService) ImportBackupOfferingAsync(ctx context.Context, p *ImportBackupOfferingParams) (*Job, error) {
+	r, err := s.ImportBackupOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("importBackupOffering", r.JobID), nil
+}
+
 type ImportBackupOfferingResponse struct {
-	Allowuserdrivenbackups    bool   `json:"allowuserdrivenbackups"`
-	Created                   string `json:"created"`
-	Crosszoneinstancecreation bool   `json:"crosszoneinstancecreation"`
-	Description               string `json:"description"`
-	Externalid                string `json:"externalid"`
-	Id                        string `json:"id"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Name                      string `json:"name"`
-	Provider                  string `json:"provider"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Allowuserdrivenbackups    FlexBool                   `json:"allowuserdrivenbackups"`
+	Created                   Time                       `json:"created"`
+	Crosszoneinstancecreation FlexBool                   `json:"crosszoneinstancecreation"`
+	Description               string                     `json:"description"`
+	Externalid                string                     `json:"externalid"`
+	Id                        string                     `json:"id"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Name                      string                     `json:"name"`
+	Provider                  string                     `json:"provider"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportBackupOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias ImportBackupOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBackupOfferingsParams struct {
@@ -3061,6 +3738,47 @@ func (p *ListBackupOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBackupOfferings API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBackupOfferingsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBackupOfferings", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBackupOfferings API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBackupOfferingsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "zoneid": 255},
+	}.validate("listBackupOfferings", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBackupOfferings API, before the request is signed
+func (p *ListBackupOfferingsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBackupOfferingsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBackupOfferingsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBackupOfferings", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBackupOfferingsParams) Clone() *ListBackupOfferingsParams {
+	return &ListBackupOfferingsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBackupOfferingsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3176,6 +3894,11 @@ func (s *BackupService) NewListBackupOfferingsParams() *ListBackupOfferingsParam
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBackupOfferingIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetBackupOfferingIDWithContext is like GetBackupOfferingID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupOfferingIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBackupOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3187,7 +3910,7 @@ func (s *BackupService) GetBackupOfferingID(keyword string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListBackupOfferings(p)
+	l, err := s.ListBackupOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3212,12 +3935,17 @@ func (s *BackupService) GetBackupOfferingID(keyword string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error) {
-	id, count, err := s.GetBackupOfferingID(name, opts...)
+	return s.GetBackupOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetBackupOfferingByNameWithContext is like GetBackupOfferingByName, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	id, count, err := s.GetBackupOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetBackupOfferingByID(id, opts...)
+	r, count, err := s.GetBackupOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3226,6 +3954,11 @@ func (s *BackupService) GetBackupOfferingByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	return s.GetBackupOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBackupOfferingByIDWithContext is like GetBackupOfferingByID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupOffering, int, error) {
 	p := &ListBackupOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3237,11 +3970,10 @@ func (s *BackupService) GetBackupOfferingByID(id string, opts ...OptionFunc) (*B
 		}
 	}
 
-	l, err := s.ListBackupOfferings(p)
+	l, err := s.ListBackupOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3259,37 +3991,80 @@ func (s *BackupService) GetBackupOfferingByID(id string, opts ...OptionFunc) (*B
 
 // Lists backup offerings
 func (s *BackupService) ListBackupOfferings(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listBackupOfferings", p.toURLValues())
+	return s.ListBackupOfferingsWithContext(context.Background(), p)
+}
+
+// ListBackupOfferingsWithContext is like ListBackupOfferings, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupOfferingsWithContext(ctx context.Context, p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBackupOfferings", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBackupOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBackupOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBackupOfferingsAll returns all BackupOfferings matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BackupService) ListBackupOfferingsAll(p *ListBackupOfferingsParams, opts ...PageOption) ([]*BackupOffering, error) {
+	return s.ListBackupOfferingsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupOfferingsAllWithContext is like ListBackupOfferingsAll, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupOfferingsAllWithContext(ctx context.Context, p *ListBackupOfferingsParams, opts ...PageOption) ([]*BackupOffering, error) {
+	return collectPages(s.ListBackupOfferingsIterWithContext(ctx, p, opts...))
+}
+
+// ListBackupOfferingsIter returns an iterator over all BackupOfferings matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BackupService) ListBackupOfferingsIter(p *ListBackupOfferingsParams, opts ...PageOption) iter.Seq2[*BackupOffering, error] {
+	return s.ListBackupOfferingsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupOfferingsIterWithContext is like ListBackupOfferingsIter, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupOfferingsIterWithContext(ctx context.Context, p *ListBackupOfferingsParams, opts ...PageOption) iter.Seq2[*BackupOffering, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BackupOffering, int, error) {
+		l, err := s.ListBackupOfferingsWithContext(ctx, &ListBackupOfferingsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BackupOfferings, l.Count, nil
+	}, opts...)
+}
+
 type ListBackupOfferingsResponse struct {
 	Count           int               `json:"count"`
 	BackupOfferings []*BackupOffering `json:"backupoffering"`
 }
 
 type BackupOffering struct {
-	Allowuserdrivenbackups    bool   `json:"allowuserdrivenbackups"`
-	Created                   string `json:"created"`
-	Crosszoneinstancecreation bool   `json:"crosszoneinstancecreation"`
-	Description               string `json:"description"`
-	Externalid                string `json:"externalid"`
-	Id                        string `json:"id"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Name                      string `json:"name"`
-	Provider                  string `json:"provider"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Allowuserdrivenbackups    FlexBool                   `json:"allowuserdrivenbackups"`
+	Created                   Time                       `json:"created"`
+	Crosszoneinstancecreation FlexBool                   `json:"crosszoneinstancecreation"`
+	Description               string                     `json:"description"`
+	Externalid                string                     `json:"externalid"`
+	Id                        string                     `json:"id"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Name                      string                     `json:"name"`
+	Provider                  string                     `json:"provider"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BackupOffering) UnmarshalJSON(b []byte) error {
+	type alias BackupOffering
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBackupProviderOfferingsParams struct {
@@ -3318,6 +4093,47 @@ func (p *ListBackupProviderOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBackupProviderOfferings API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBackupProviderOfferingsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBackupProviderOfferings", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBackupProviderOfferings API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBackupProviderOfferingsParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"keyword": 255, "zoneid": 255},
+	}.validate("listBackupProviderOfferings", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBackupProviderOfferings API, before the request is signed
+func (p *ListBackupProviderOfferingsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBackupProviderOfferingsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBackupProviderOfferingsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBackupProviderOfferings", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBackupProviderOfferingsParams) Clone() *ListBackupProviderOfferingsParams {
+	return &ListBackupProviderOfferingsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBackupProviderOfferingsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3413,6 +4229,11 @@ func (s *BackupService) NewListBackupProviderOfferingsParams(zoneid string) *Lis
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupProviderOfferingID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBackupProviderOfferingIDWithContext(context.Background(), keyword, zoneid, opts...)
+}
+
+// GetBackupProviderOfferingIDWithContext is like GetBackupProviderOfferingID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupProviderOfferingIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBackupProviderOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3425,7 +4246,7 @@ func (s *BackupService) GetBackupProviderOfferingID(keyword string, zoneid strin
 		}
 	}
 
-	l, err := s.ListBackupProviderOfferings(p)
+	l, err := s.ListBackupProviderOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3450,37 +4271,80 @@ func (s *BackupService) GetBackupProviderOfferingID(keyword string, zoneid strin
 
 // Lists external backup offerings of the provider
 func (s *BackupService) ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listBackupProviderOfferings", p.toURLValues())
+	return s.ListBackupProviderOfferingsWithContext(context.Background(), p)
+}
+
+// ListBackupProviderOfferingsWithContext is like ListBackupProviderOfferings, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupProviderOfferingsWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBackupProviderOfferings", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBackupProviderOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBackupProviderOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBackupProviderOfferingsAll returns all BackupProviderOfferings matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BackupService) ListBackupProviderOfferingsAll(p *ListBackupProviderOfferingsParams, opts ...PageOption) ([]*BackupProviderOffering, error) {
+	return s.ListBackupProviderOfferingsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupProviderOfferingsAllWithContext is like ListBackupProviderOfferingsAll, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupProviderOfferingsAllWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams, opts ...PageOption) ([]*BackupProviderOffering, error) {
+	return collectPages(s.ListBackupProviderOfferingsIterWithContext(ctx, p, opts...))
+}
+
+// ListBackupProviderOfferingsIter returns an iterator over all BackupProviderOfferings matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BackupService) ListBackupProviderOfferingsIter(p *ListBackupProviderOfferingsParams, opts ...PageOption) iter.Seq2[*BackupProviderOffering, error] {
+	return s.ListBackupProviderOfferingsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupProviderOfferingsIterWithContext is like ListBackupProviderOfferingsIter, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupProviderOfferingsIterWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams, opts ...PageOption) iter.Seq2[*BackupProviderOffering, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BackupProviderOffering, int, error) {
+		l, err := s.ListBackupProviderOfferingsWithContext(ctx, &ListBackupProviderOfferingsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BackupProviderOfferings, l.Count, nil
+	}, opts...)
+}
+
 type ListBackupProviderOfferingsResponse struct {
 	Count                   int                       `json:"count"`
 	BackupProviderOfferings []*BackupProviderOffering `json:"backupprovideroffering"`
 }
 
 type BackupProviderOffering struct {
-	Allowuserdrivenbackups    bool   `json:"allowuserdrivenbackups"`
-	Created                   string `json:"created"`
-	Crosszoneinstancecreation bool   `json:"crosszoneinstancecreation"`
-	Description               string `json:"description"`
-	Externalid                string `json:"externalid"`
-	Id                        string `json:"id"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Name                      string `json:"name"`
-	Provider                  string `json:"provider"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Allowuserdrivenbackups    FlexBool                   `json:"allowuserdrivenbackups"`
+	Created                   Time                       `json:"created"`
+	Crosszoneinstancecreation FlexBool                   `json:"crosszoneinstancecreation"`
+	Description               string                     `json:"description"`
+	Externalid                string                     `json:"externalid"`
+	Id                        string                     `json:"id"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Name                      string                     `json:"name"`
+	Provider                  string                     `json:"provider"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BackupProviderOffering) UnmarshalJSON(b []byte) error {
+	type alias BackupProviderOffering
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBackupProvidersParams struct {
@@ -3498,6 +4362,43 @@ func (p *ListBackupProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBackupProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBackupProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBackupProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBackupProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBackupProvidersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"name": 255},
+	}.validate("listBackupProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBackupProviders API, before the request is signed
+func (p *ListBackupProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBackupProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBackupProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBackupProviders", b, &p.p, map[string]paramDecoder{
+		"name": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBackupProvidersParams) Clone() *ListBackupProvidersParams {
+	return &ListBackupProvidersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBackupProvidersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3529,13 +4430,22 @@ func (s *BackupService) NewListBackupProvidersParams() *ListBackupProvidersParam
 
 // Lists Backup and Recovery providers
 func (s *BackupService) ListBackupProviders(p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listBackupProviders", p.toURLValues())
+	return s.ListBackupProvidersWithContext(context.Background(), p)
+}
+
+// ListBackupProvidersWithContext is like ListBackupProviders, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupProvidersWithContext(ctx context.Context, p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBackupProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBackupProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBackupProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3548,10 +4458,16 @@ type ListBackupProvidersResponse struct {
 }
 
 type BackupProvider struct {
-	Description string `json:"description"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
+	Description string                     `json:"description"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BackupProvider) UnmarshalJSON(b []byte) error {
+	type alias BackupProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBackupRepositoriesParams struct {
@@ -3589,6 +4505,49 @@ func (p *ListBackupRepositoriesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBackupRepositories API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBackupRepositoriesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBackupRepositories", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBackupRepositories API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBackupRepositoriesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "provider": 255, "zoneid": 255},
+	}.validate("listBackupRepositories", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBackupRepositories API, before the request is signed
+func (p *ListBackupRepositoriesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBackupRepositoriesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBackupRepositoriesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBackupRepositories", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"provider": decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBackupRepositoriesParams) Clone() *ListBackupRepositoriesParams {
+	return &ListBackupRepositoriesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBackupRepositoriesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3746,6 +4705,11 @@ func (s *BackupService) NewListBackupRepositoriesParams() *ListBackupRepositorie
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupRepositoryID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBackupRepositoryIDWithContext(context.Background(), name, opts...)
+}
+
+// GetBackupRepositoryIDWithContext is like GetBackupRepositoryID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupRepositoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBackupRepositoriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3757,7 +4721,7 @@ func (s *BackupService) GetBackupRepositoryID(name string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListBackupRepositories(p)
+	l, err := s.ListBackupRepositoriesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3782,12 +4746,17 @@ func (s *BackupService) GetBackupRepositoryID(name string, opts ...OptionFunc) (
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupRepositoryByName(name string, opts ...OptionFunc) (*BackupRepository, int, error) {
-	id, count, err := s.GetBackupRepositoryID(name, opts...)
+	return s.GetBackupRepositoryByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetBackupRepositoryByNameWithContext is like GetBackupRepositoryByName, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupRepositoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupRepository, int, error) {
+	id, count, err := s.GetBackupRepositoryIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetBackupRepositoryByID(id, opts...)
+	r, count, err := s.GetBackupRepositoryByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3796,6 +4765,11 @@ func (s *BackupService) GetBackupRepositoryByName(name string, opts ...OptionFun
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupRepositoryByID(id string, opts ...OptionFunc) (*BackupRepository, int, error) {
+	return s.GetBackupRepositoryByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBackupRepositoryByIDWithContext is like GetBackupRepositoryByID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupRepositoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupRepository, int, error) {
 	p := &ListBackupRepositoriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3807,11 +4781,10 @@ func (s *BackupService) GetBackupRepositoryByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListBackupRepositories(p)
+	l, err := s.ListBackupRepositoriesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3829,38 +4802,81 @@ func (s *BackupService) GetBackupRepositoryByID(id string, opts ...OptionFunc) (
 
 // Lists all backup repositories
 func (s *BackupService) ListBackupRepositories(p *ListBackupRepositoriesParams) (*ListBackupRepositoriesResponse, error) {
-	resp, err := s.cs.newRequest("listBackupRepositories", p.toURLValues())
+	return s.ListBackupRepositoriesWithContext(context.Background(), p)
+}
+
+// ListBackupRepositoriesWithContext is like ListBackupRepositories, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupRepositoriesWithContext(ctx context.Context, p *ListBackupRepositoriesParams) (*ListBackupRepositoriesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBackupRepositories", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBackupRepositoriesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBackupRepositories", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBackupRepositoriesAll returns all BackupRepositories matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BackupService) ListBackupRepositoriesAll(p *ListBackupRepositoriesParams, opts ...PageOption) ([]*BackupRepository, error) {
+	return s.ListBackupRepositoriesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupRepositoriesAllWithContext is like ListBackupRepositoriesAll, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupRepositoriesAllWithContext(ctx context.Context, p *ListBackupRepositoriesParams, opts ...PageOption) ([]*BackupRepository, error) {
+	return collectPages(s.ListBackupRepositoriesIterWithContext(ctx, p, opts...))
+}
+
+// ListBackupRepositoriesIter returns an iterator over all BackupRepositories matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BackupService) ListBackupRepositoriesIter(p *ListBackupRepositoriesParams, opts ...PageOption) iter.Seq2[*BackupRepository, error] {
+	return s.ListBackupRepositoriesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupRepositoriesIterWithContext is like ListBackupRepositoriesIter, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupRepositoriesIterWithContext(ctx context.Context, p *ListBackupRepositoriesParams, opts ...PageOption) iter.Seq2[*BackupRepository, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BackupRepository, int, error) {
+		l, err := s.ListBackupRepositoriesWithContext(ctx, &ListBackupRepositoriesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BackupRepositories, l.Count, nil
+	}, opts...)
+}
+
 type ListBackupRepositoriesResponse struct {
 	Count              int                 `json:"count"`
 	BackupRepositories []*BackupRepository `json:"backuprepository"`
 }
 
 type BackupRepository struct {
-	Address                   string `json:"address"`
-	Capacitybytes             int64  `json:"capacitybytes"`
-	Created                   string `json:"created"`
-	Crosszoneinstancecreation bool   `json:"crosszoneinstancecreation"`
-	Id                        string `json:"id"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Mountopts                 string `json:"mountopts"`
-	Name                      string `json:"name"`
-	Provider                  string `json:"provider"`
-	Type                      string `json:"type"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Address                   string                     `json:"address"`
+	Capacitybytes             FlexInt64                  `json:"capacitybytes"`
+	Created                   Time                       `json:"created"`
+	Crosszoneinstancecreation FlexBool                   `json:"crosszoneinstancecreation"`
+	Id                        string                     `json:"id"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Mountopts                 string                     `json:"mountopts"`
+	Name                      string                     `json:"name"`
+	Provider                  string                     `json:"provider"`
+	Type                      string                     `json:"type"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BackupRepository) UnmarshalJSON(b []byte) error {
+	type alias BackupRepository
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBackupScheduleParams struct {
@@ -3909,6 +4925,52 @@ func (p *ListBackupScheduleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBackupSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBackupScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBackupSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBackupSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBackupScheduleParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "virtualmachineid": 255},
+	}.validate("listBackupSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBackupSchedule API, before the request is signed
+func (p *ListBackupScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBackupSchedule", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"id":               decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBackupScheduleParams) Clone() *ListBackupScheduleParams {
+	return &ListBackupScheduleParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBackupScheduleParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4129,6 +5191,11 @@ func (s *BackupService) NewListBackupScheduleParams() *ListBackupScheduleParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupScheduleByID(id string, opts ...OptionFunc) (*BackupSchedule, int, error) {
+	return s.GetBackupScheduleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBackupScheduleByIDWithContext is like GetBackupScheduleByID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupScheduleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupSchedule, int, error) {
 	p := &ListBackupScheduleParams{}
 	p.p = make(map[string]interface{})
 
@@ -4140,11 +5207,10 @@ func (s *BackupService) GetBackupScheduleByID(id string, opts ...OptionFunc) (*B
 		}
 	}
 
-	l, err := s.ListBackupSchedule(p)
+	l, err := s.ListBackupScheduleWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4162,35 +5228,78 @@ func (s *BackupService) GetBackupScheduleByID(id string, opts ...OptionFunc) (*B
 
 // List backup schedule of an Instance
 func (s *BackupService) ListBackupSchedule(p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error) {
-	resp, err := s.cs.newRequest("listBackupSchedule", p.toURLValues())
+	return s.ListBackupScheduleWithContext(context.Background(), p)
+}
+
+// ListBackupScheduleWithContext is like ListBackupSchedule, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupScheduleWithContext(ctx context.Context, p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBackupSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBackupScheduleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBackupSchedule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBackupScheduleAll returns all BackupSchedule matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BackupService) ListBackupScheduleAll(p *ListBackupScheduleParams, opts ...PageOption) ([]*BackupSchedule, error) {
+	return s.ListBackupScheduleAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupScheduleAllWithContext is like ListBackupScheduleAll, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupScheduleAllWithContext(ctx context.Context, p *ListBackupScheduleParams, opts ...PageOption) ([]*BackupSchedule, error) {
+	return collectPages(s.ListBackupScheduleIterWithContext(ctx, p, opts...))
+}
+
+// ListBackupScheduleIter returns an iterator over all BackupSchedule matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BackupService) ListBackupScheduleIter(p *ListBackupScheduleParams, opts ...PageOption) iter.Seq2[*BackupSchedule, error] {
+	return s.ListBackupScheduleIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupScheduleIterWithContext is like ListBackupScheduleIter, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupScheduleIterWithContext(ctx context.Context, p *ListBackupScheduleParams, opts ...PageOption) iter.Seq2[*BackupSchedule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BackupSchedule, int, error) {
+		l, err := s.ListBackupScheduleWithContext(ctx, &ListBackupScheduleParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BackupSchedule, l.Count, nil
+	}, opts...)
+}
+
 type ListBackupScheduleResponse struct {
 	Count          int               `json:"count"`
 	BackupSchedule []*BackupSchedule `json:"backupschedule"`
 }
 
 type BackupSchedule struct {
-	Id                 string `json:"id"`
-	Intervaltype       string `json:"intervaltype"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Maxbackups         int    `json:"maxbackups"`
-	Quiescevm          bool   `json:"quiescevm"`
-	Schedule           string `json:"schedule"`
-	Timezone           string `json:"timezone"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
+	Id                 string                     `json:"id"`
+	Intervaltype       string                     `json:"intervaltype"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Maxbackups         FlexInt                    `json:"maxbackups"`
+	Quiescevm          FlexBool                   `json:"quiescevm"`
+	Schedule           string                     `json:"schedule"`
+	Timezone           string                     `json:"timezone"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BackupSchedule) UnmarshalJSON(b []byte) error {
+	type alias BackupSchedule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBackupsParams struct {
@@ -4252,6 +5361,56 @@ func (p *ListBackupsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBackups API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBackupsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBackups", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBackups API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBackupsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "backupofferingid": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("listBackups", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBackups API, before the request is signed
+func (p *ListBackupsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBackupsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBackupsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBackups", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"backupofferingid": decodeParam[string],
+		"domainid":         decodeParam[string],
+		"id":               decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"listvmdetails":    decodeParam[bool],
+		"name":             decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBackupsParams) Clone() *ListBackupsParams {
+	return &ListBackupsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBackupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4556,6 +5715,11 @@ func (s *BackupService) NewListBackupsParams() *ListBackupsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBackupIDWithContext(context.Background(), name, opts...)
+}
+
+// GetBackupIDWithContext is like GetBackupID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBackupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -4567,7 +5731,7 @@ func (s *BackupService) GetBackupID(name string, opts ...OptionFunc) (string, in
 		}
 	}
 
-	l, err := s.ListBackups(p)
+	l, err := s.ListBackupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4592,12 +5756,17 @@ func (s *BackupService) GetBackupID(name string, opts ...OptionFunc) (string, in
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupByName(name string, opts ...OptionFunc) (*Backup, int, error) {
-	id, count, err := s.GetBackupID(name, opts...)
+	return s.GetBackupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetBackupByNameWithContext is like GetBackupByName, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Backup, int, error) {
+	id, count, err := s.GetBackupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetBackupByID(id, opts...)
+	r, count, err := s.GetBackupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4606,6 +5775,11 @@ func (s *BackupService) GetBackupByName(name string, opts ...OptionFunc) (*Backu
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BackupService) GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error) {
+	return s.GetBackupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBackupByIDWithContext is like GetBackupByID, but honours the cancellation and deadline of ctx
+func (s *BackupService) GetBackupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Backup, int, error) {
 	p := &ListBackupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -4617,11 +5791,10 @@ func (s *BackupService) GetBackupByID(id string, opts ...OptionFunc) (*Backup, i
 		}
 	}
 
-	l, err := s.ListBackups(p)
+	l, err := s.ListBackupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4639,51 +5812,94 @@ func (s *BackupService) GetBackupByID(id string, opts ...OptionFunc) (*Backup, i
 
 // Lists Instance backups
 func (s *BackupService) ListBackups(p *ListBackupsParams) (*ListBackupsResponse, error) {
-	resp, err := s.cs.newRequest("listBackups", p.toURLValues())
+	return s.ListBackupsWithContext(context.Background(), p)
+}
+
+// ListBackupsWithContext is like ListBackups, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupsWithContext(ctx context.Context, p *ListBackupsParams) (*ListBackupsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBackups", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBackupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBackups", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBackupsAll returns all Backups matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BackupService) ListBackupsAll(p *ListBackupsParams, opts ...PageOption) ([]*Backup, error) {
+	return s.ListBackupsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupsAllWithContext is like ListBackupsAll, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupsAllWithContext(ctx context.Context, p *ListBackupsParams, opts ...PageOption) ([]*Backup, error) {
+	return collectPages(s.ListBackupsIterWithContext(ctx, p, opts...))
+}
+
+// ListBackupsIter returns an iterator over all Backups matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BackupService) ListBackupsIter(p *ListBackupsParams, opts ...PageOption) iter.Seq2[*Backup, error] {
+	return s.ListBackupsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBackupsIterWithContext is like ListBackupsIter, but honours the cancellation and deadline of ctx
+func (s *BackupService) ListBackupsIterWithContext(ctx context.Context, p *ListBackupsParams, opts ...PageOption) iter.Seq2[*Backup, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Backup, int, error) {
+		l, err := s.ListBackupsWithContext(ctx, &ListBackupsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Backups, l.Count, nil
+	}, opts...)
+}
+
 type ListBackupsResponse struct {
 	Count   int       `json:"count"`
 	Backups []*Backup `json:"backup"`
 }
 
 type Backup struct {
-	Account                 string            `json:"account"`
-	Accountid               string            `json:"accountid"`
-	Backupofferingid        string            `json:"backupofferingid"`
-	Backupofferingname      string            `json:"backupofferingname"`
-	Created                 string            `json:"created"`
-	Description             string            `json:"description"`
-	Domain                  string            `json:"domain"`
-	Domainid                string            `json:"domainid"`
-	Externalid              string            `json:"externalid"`
-	Id                      string            `json:"id"`
-	Intervaltype            string            `json:"intervaltype"`
-	Isbackupvmexpunged      bool              `json:"isbackupvmexpunged"`
-	JobID                   string            `json:"jobid"`
-	Jobstatus               int               `json:"jobstatus"`
-	Name                    string            `json:"name"`
-	Size                    int64             `json:"size"`
-	Status                  string            `json:"status"`
-	Type                    string            `json:"type"`
-	Virtualmachineid        string            `json:"virtualmachineid"`
-	Virtualmachinename      string            `json:"virtualmachinename"`
-	Virtualsize             int64             `json:"virtualsize"`
-	Vmbackupofferingremoved bool              `json:"vmbackupofferingremoved"`
-	Vmdetails               map[string]string `json:"vmdetails"`
-	Volumes                 string            `json:"volumes"`
-	Zone                    string            `json:"zone"`
-	Zoneid                  string            `json:"zoneid"`
+	Account                 string                     `json:"account"`
+	Accountid               string                     `json:"accountid"`
+	Backupofferingid        string                     `json:"backupofferingid"`
+	Backupofferingname      string                     `json:"backupofferingname"`
+	Created                 Time                       `json:"created"`
+	Description             string                     `json:"description"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Externalid              string                     `json:"externalid"`
+	Id                      string                     `json:"id"`
+	Intervaltype            string                     `json:"intervaltype"`
+	Isbackupvmexpunged      FlexBool                   `json:"isbackupvmexpunged"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Size                    FlexInt64                  `json:"size"`
+	Status                  string                     `json:"status"`
+	Type                    string                     `json:"type"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualmachinename      string                     `json:"virtualmachinename"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmbackupofferingremoved FlexBool                   `json:"vmbackupofferingremoved"`
+	Vmdetails               map[string]string          `json:"vmdetails"`
+	Volumes                 string                     `json:"volumes"`
+	Zone                    string                     `json:"zone"`
+	Zoneid                  string                     `json:"zoneid"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Backup) UnmarshalJSON(b []byte) error {
+	type alias Backup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestoreBackupParams struct {
@@ -4701,6 +5917,44 @@ func (p *RestoreBackupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the restoreBackup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RestoreBackupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("restoreBackup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the restoreBackup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RestoreBackupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("restoreBackup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the restoreBackup API, before the request is signed
+func (p *RestoreBackupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RestoreBackupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RestoreBackupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("restoreBackup", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RestoreBackupParams) Clone() *RestoreBackupParams {
+	return &RestoreBackupParams{p: cloneParams(p.p)}
+}
+
 func (p *RestoreBackupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4733,27 +5987,39 @@ func (s *BackupService) NewRestoreBackupParams(id string) *RestoreBackupParams {
 
 // Restores an existing stopped or deleted Instance using an Instance backup
 func (s *BackupService) RestoreBackup(p *RestoreBackupParams) (*RestoreBackupResponse, error) {
-	resp, err := s.cs.newPostRequest("restoreBackup", p.toURLValues())
+	return s.RestoreBackupWithContext(context.Background(), p)
+}
+
+// RestoreBackupWithContext is like RestoreBackup, but honours the cancellation and deadline of ctx
+func (s *BackupService) RestoreBackupWithContext(ctx context.Context, p *RestoreBackupParams) (*RestoreBackupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "restoreBackup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RestoreBackupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("restoreBackup", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "restoreBackup", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("restoreBackup", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4761,11 +6027,31 @@ func (s *BackupService) RestoreBackup(p *RestoreBackupParams) (*RestoreBackupRes
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RestoreBackup async job into r
+func (r *RestoreBackupResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RestoreBackupAsync starts the async job of RestoreBackup without waiting for it to finish, and returns a handle to the job
+func (s *BackupService) RestoreBackupAsync(ctx context.Context, p *RestoreBackupParams) (*Job, error) {
+	r, err := s.RestoreBackupWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("restoreBackup", r.JobID), nil
+}
+
 type RestoreBackupResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RestoreBackupResponse) UnmarshalJSON(b []byte) error {
+	type alias RestoreBackupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateBackupRepositoryParams struct {
@@ -4796,6 +6082,48 @@ func (p *UpdateBackupRepositoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateBackupRepository API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateBackupRepositoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateBackupRepository", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateBackupRepository API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateBackupRepositoryParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"address": 255, "id": 255, "mountopts": 255, "name": 255},
+	}.validate("updateBackupRepository", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateBackupRepository API, before the request is signed
+func (p *UpdateBackupRepositoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateBackupRepositoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateBackupRepositoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateBackupRepository", b, &p.p, map[string]paramDecoder{
+		"address":                   decodeParam[string],
+		"crosszoneinstancecreation": decodeParam[bool],
+		"id":                        decodeParam[string],
+		"mountopts":                 decodeParam[string],
+		"name":                      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateBackupRepositoryParams) Clone() *UpdateBackupRepositoryParams {
+	return &UpdateBackupRepositoryParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateBackupRepositoryParams) SetAddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4912,13 +6240,22 @@ func (s *BackupService) NewUpdateBackupRepositoryParams(id string) *UpdateBackup
 
 // Update a backup repository
 func (s *BackupService) UpdateBackupRepository(p *UpdateBackupRepositoryParams) (*UpdateBackupRepositoryResponse, error) {
-	resp, err := s.cs.newPostRequest("updateBackupRepository", p.toURLValues())
+	return s.UpdateBackupRepositoryWithContext(context.Background(), p)
+}
+
+// UpdateBackupRepositoryWithContext is like UpdateBackupRepository, but honours the cancellation and deadline of ctx
+func (s *BackupService) UpdateBackupRepositoryWithContext(ctx context.Context, p *UpdateBackupRepositoryParams) (*UpdateBackupRepositoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateBackupRepository", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateBackupRepositoryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateBackupRepository", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4926,19 +6263,25 @@ func (s *BackupService) UpdateBackupRepository(p *UpdateBackupRepositoryParams)
 }
 
 type UpdateBackupRepositoryResponse struct {
-	Address                   string `json:"address"`
-	Capacitybytes             int64  `json:"capacitybytes"`
-	Created                   string `json:"created"`
-	Crosszoneinstancecreation bool   `json:"crosszoneinstancecreation"`
-	Id                        string `json:"id"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Mountopts                 string `json:"mountopts"`
-	Name                      string `json:"name"`
-	Provider                  string `json:"provider"`
-	Type                      string `json:"type"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Address                   string                     `json:"address"`
+	Capacitybytes             FlexInt64                  `json:"capacitybytes"`
+	Created                   Time                       `json:"created"`
+	Crosszoneinstancecreation FlexBool                   `json:"crosszoneinstancecreation"`
+	Id                        string                     `json:"id"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Mountopts                 string                     `json:"mountopts"`
+	Name                      string                     `json:"name"`
+	Provider                  string                     `json:"provider"`
+	Type                      string                     `json:"type"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateBackupRepositoryResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateBackupRepositoryResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateBackupOfferingParams struct {
@@ -4966,6 +6309,47 @@ func (p *UpdateBackupOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateBackupOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateBackupOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateBackupOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateBackupOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateBackupOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "id": 255, "name": 255},
+	}.validate("updateBackupOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateBackupOffering API, before the request is signed
+func (p *UpdateBackupOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateBackupOffering", b, &p.p, map[string]paramDecoder{
+		"allowuserdrivenbackups": decodeParam[bool],
+		"description":            decodeParam[string],
+		"id":                     decodeParam[string],
+		"name":                   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateBackupOfferingParams) Clone() *UpdateBackupOfferingParams {
+	return &UpdateBackupOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateBackupOfferingParams) SetAllowuserdrivenbackups(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5061,13 +6445,22 @@ func (s *BackupService) NewUpdateBackupOfferingParams(id string) *UpdateBackupOf
 
 // Updates a backup offering.
 func (s *BackupService) UpdateBackupOffering(p *UpdateBackupOfferingParams) (*UpdateBackupOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("updateBackupOffering", p.toURLValues())
+	return s.UpdateBackupOfferingWithContext(context.Background(), p)
+}
+
+// UpdateBackupOfferingWithContext is like UpdateBackupOffering, but honours the cancellation and deadline of ctx
+func (s *BackupService) UpdateBackupOfferingWithContext(ctx context.Context, p *UpdateBackupOfferingParams) (*UpdateBackupOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateBackupOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateBackupOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateBackupOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5075,18 +6468,24 @@ func (s *BackupService) UpdateBackupOffering(p *UpdateBackupOfferingParams) (*Up
 }
 
 type UpdateBackupOfferingResponse struct {
-	Allowuserdrivenbackups    bool   `json:"allowuserdrivenbackups"`
-	Created                   string `json:"created"`
-	Crosszoneinstancecreation bool   `json:"crosszoneinstancecreation"`
-	Description               string `json:"description"`
-	Externalid                string `json:"externalid"`
-	Id                        string `json:"id"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Name                      string `json:"name"`
-	Provider                  string `json:"provider"`
-	Zoneid                    string `json:"zoneid"`
-	Zonename                  string `json:"zonename"`
+	Allowuserdrivenbackups    FlexBool                   `json:"allowuserdrivenbackups"`
+	Created                   Time                       `json:"created"`
+	Crosszoneinstancecreation FlexBool                   `json:"crosszoneinstancecreation"`
+	Description               string                     `json:"description"`
+	Externalid                string                     `json:"externalid"`
+	Id                        string                     `json:"id"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Name                      string                     `json:"name"`
+	Provider                  string                     `json:"provider"`
+	Zoneid                    string                     `json:"zoneid"`
+	Zonename                  string                     `json:"zonename"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateBackupOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateBackupOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateBackupScheduleParams struct {
@@ -5099,7 +6498,7 @@ func (p *UpdateBackupScheduleParams) toURLValues() url.Values {
 		return u
 	}
 	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
+		u.Set("intervaltype", string(v.(IntervalType)))
 	}
 	if v, found := p.p["maxbackups"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -5121,7 +6520,50 @@ func (p *UpdateBackupScheduleParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *UpdateBackupScheduleParams) SetIntervaltype(v string) {
+// ValidateAgainst checks the parameters against the updateBackupSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateBackupScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateBackupSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateBackupSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateBackupScheduleParams) Validate() error {
+	return paramRules{
+		required: []string{"intervaltype", "schedule", "timezone", "virtualmachineid"},
+		lengths:  map[string]int{"intervaltype": 255, "schedule": 255, "timezone": 255, "virtualmachineid": 255},
+	}.validate("updateBackupSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateBackupSchedule API, before the request is signed
+func (p *UpdateBackupScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateBackupScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateBackupScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateBackupSchedule", b, &p.p, map[string]paramDecoder{
+		"intervaltype":     decodeParam[IntervalType],
+		"maxbackups":       decodeParam[int],
+		"quiescevm":        decodeParam[bool],
+		"schedule":         decodeParam[string],
+		"timezone":         decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateBackupScheduleParams) Clone() *UpdateBackupScheduleParams {
+	return &UpdateBackupScheduleParams{p: cloneParams(p.p)}
+}
+
+func (p *UpdateBackupScheduleParams) SetIntervaltype(v IntervalType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -5134,11 +6576,11 @@ func (p *UpdateBackupScheduleParams) ResetIntervaltype() {
 	}
 }
 
-func (p *UpdateBackupScheduleParams) GetIntervaltype() (string, bool) {
+func (p *UpdateBackupScheduleParams) GetIntervaltype() (IntervalType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["intervaltype"].(string)
+	value, ok := p.p["intervaltype"].(IntervalType)
 	return value, ok
 }
 
@@ -5249,7 +6691,7 @@ func (p *UpdateBackupScheduleParams) GetVirtualmachineid() (string, bool) {
 
 // You should always use this function to get a new UpdateBackupScheduleParams instance,
 // as then you are sure you have configured all required params
-func (s *BackupService) NewUpdateBackupScheduleParams(intervaltype string, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams {
+func (s *BackupService) NewUpdateBackupScheduleParams(intervaltype IntervalType, schedule string, timezone string, virtualmachineid string) *UpdateBackupScheduleParams {
 	p := &UpdateBackupScheduleParams{}
 	p.p = make(map[string]interface{})
 	p.p["intervaltype"] = intervaltype
@@ -5261,13 +6703,22 @@ func (s *BackupService) NewUpdateBackupScheduleParams(intervaltype string, sched
 
 // Updates a User-defined Instance backup schedule
 func (s *BackupService) UpdateBackupSchedule(p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateBackupSchedule", p.toURLValues())
+	return s.UpdateBackupScheduleWithContext(context.Background(), p)
+}
+
+// UpdateBackupScheduleWithContext is like UpdateBackupSchedule, but honours the cancellation and deadline of ctx
+func (s *BackupService) UpdateBackupScheduleWithContext(ctx context.Context, p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateBackupSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateBackupScheduleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateBackupSchedule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5275,30 +6726,36 @@ func (s *BackupService) UpdateBackupSchedule(p *UpdateBackupScheduleParams) (*Up
 }
 
 type UpdateBackupScheduleResponse struct {
-	Account                 string            `json:"account"`
-	Accountid               string            `json:"accountid"`
-	Backupofferingid        string            `json:"backupofferingid"`
-	Backupofferingname      string            `json:"backupofferingname"`
-	Created                 string            `json:"created"`
-	Description             string            `json:"description"`
-	Domain                  string            `json:"domain"`
-	Domainid                string            `json:"domainid"`
-	Externalid              string            `json:"externalid"`
-	Id                      string            `json:"id"`
-	Intervaltype            string            `json:"intervaltype"`
-	Isbackupvmexpunged      bool              `json:"isbackupvmexpunged"`
-	JobID                   string            `json:"jobid"`
-	Jobstatus               int               `json:"jobstatus"`
-	Name                    string            `json:"name"`
-	Size                    int64             `json:"size"`
-	Status                  string            `json:"status"`
-	Type                    string            `json:"type"`
-	Virtualmachineid        string            `json:"virtualmachineid"`
-	Virtualmachinename      string            `json:"virtualmachinename"`
-	Virtualsize             int64             `json:"virtualsize"`
-	Vmbackupofferingremoved bool              `json:"vmbackupofferingremoved"`
-	Vmdetails               map[string]string `json:"vmdetails"`
-	Volumes                 string            `json:"volumes"`
-	Zone                    string            `json:"zone"`
-	Zoneid                  string            `json:"zoneid"`
+	Account                 string                     `json:"account"`
+	Accountid               string                     `json:"accountid"`
+	Backupofferingid        string                     `json:"backupofferingid"`
+	Backupofferingname      string                     `json:"backupofferingname"`
+	Created                 Time                       `json:"created"`
+	Description             string                     `json:"description"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Externalid              string                     `json:"externalid"`
+	Id                      string                     `json:"id"`
+	Intervaltype            string                     `json:"intervaltype"`
+	Isbackupvmexpunged      FlexBool                   `json:"isbackupvmexpunged"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Size                    FlexInt64                  `json:"size"`
+	Status                  string                     `json:"status"`
+	Type                    string                     `json:"type"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualmachinename      string                     `json:"virtualmachinename"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmbackupofferingremoved FlexBool                   `json:"vmbackupofferingremoved"`
+	Vmdetails               map[string]string          `json:"vmdetails"`
+	Volumes                 string                     `json:"volumes"`
+	Zone                    string                     `json:"zone"`
+	Zoneid                  string                     `json:"zoneid"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateBackupScheduleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateBackupScheduleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockBackupServiceIfaceMockRecorder) AddBackupRepository(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBackupRepository", reflect.TypeOf((*MockBackupServiceIface)(nil).AddBackupRepository), p)
 }
 
+// AddBackupRepositoryWithContext mocks base method.
+func (m *MockBackupServiceIface) AddBackupRepositoryWithContext(ctx context.Context, p *AddBackupRepositoryParams) (*AddBackupRepositoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBackupRepositoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBackupRepositoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBackupRepositoryWithContext indicates an expected call of AddBackupRepositoryWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) AddBackupRepositoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBackupRepositoryWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).AddBackupRepositoryWithContext), ctx, p)
+}
+
 // CreateBackup mocks base method.
 func (m *MockBackupServiceIface) CreateBackup(p *CreateBackupParams) (*CreateBackupResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockBackupServiceIfaceMockRecorder) CreateBackup(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackup", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateBackup), p)
 }
 
+// CreateBackupAsync mocks base method.
+func (m *MockBackupServiceIface) CreateBackupAsync(ctx context.Context, p *CreateBackupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBackupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackupAsync indicates an expected call of CreateBackupAsync.
+func (mr *MockBackupServiceIfaceMockRecorder) CreateBackupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupAsync", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateBackupAsync), ctx, p)
+}
+
 // CreateBackupSchedule mocks base method.
 func (m *MockBackupServiceIface) CreateBackupSchedule(p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,36 @@ func (mr *MockBackupServiceIfaceMockRecorder) CreateBackupSchedule(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateBackupSchedule), p)
 }
 
+// CreateBackupScheduleWithContext mocks base method.
+func (m *MockBackupServiceIface) CreateBackupScheduleWithContext(ctx context.Context, p *CreateBackupScheduleParams) (*CreateBackupScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBackupScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackupScheduleWithContext indicates an expected call of CreateBackupScheduleWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) CreateBackupScheduleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupScheduleWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateBackupScheduleWithContext), ctx, p)
+}
+
+// CreateBackupWithContext mocks base method.
+func (m *MockBackupServiceIface) CreateBackupWithContext(ctx context.Context, p *CreateBackupParams) (*CreateBackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBackupWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBackupWithContext indicates an expected call of CreateBackupWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) CreateBackupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBackupWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateBackupWithContext), ctx, p)
+}
+
 // CreateVMFromBackup mocks base method.
 func (m *MockBackupServiceIface) CreateVMFromBackup(p *CreateVMFromBackupParams) (*CreateVMFromBackupResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,36 @@ func (mr *MockBackupServiceIfaceMockRecorder) CreateVMFromBackup(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMFromBackup", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateVMFromBackup), p)
 }
 
+// CreateVMFromBackupAsync mocks base method.
+func (m *MockBackupServiceIface) CreateVMFromBackupAsync(ctx context.Context, p *CreateVMFromBackupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVMFromBackupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVMFromBackupAsync indicates an expected call of CreateVMFromBackupAsync.
+func (mr *MockBackupServiceIfaceMockRecorder) CreateVMFromBackupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMFromBackupAsync", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateVMFromBackupAsync), ctx, p)
+}
+
+// CreateVMFromBackupWithContext mocks base method.
+func (m *MockBackupServiceIface) CreateVMFromBackupWithContext(ctx context.Context, p *CreateVMFromBackupParams) (*CreateVMFromBackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVMFromBackupWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVMFromBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVMFromBackupWithContext indicates an expected call of CreateVMFromBackupWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) CreateVMFromBackupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMFromBackupWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).CreateVMFromBackupWithContext), ctx, p)
+}
+
 // DeleteBackup mocks base method.
 func (m *MockBackupServiceIface) DeleteBackup(p *DeleteBackupParams) (*DeleteBackupResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +225,21 @@ func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackup(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackup", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackup), p)
 }
 
+// DeleteBackupAsync mocks base method.
+func (m *MockBackupServiceIface) DeleteBackupAsync(ctx context.Context, p *DeleteBackupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupAsync indicates an expected call of DeleteBackupAsync.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupAsync", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupAsync), ctx, p)
+}
+
 // DeleteBackupOffering mocks base method.
 func (m *MockBackupServiceIface) DeleteBackupOffering(p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +255,21 @@ func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupOffering(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupOffering), p)
 }
 
+// DeleteBackupOfferingWithContext mocks base method.
+func (m *MockBackupServiceIface) DeleteBackupOfferingWithContext(ctx context.Context, p *DeleteBackupOfferingParams) (*DeleteBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupOfferingWithContext indicates an expected call of DeleteBackupOfferingWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupOfferingWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupOfferingWithContext), ctx, p)
+}
+
 // DeleteBackupRepository mocks base method.
 func (m *MockBackupServiceIface) DeleteBackupRepository(p *DeleteBackupRepositoryParams) (*DeleteBackupRepositoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +285,21 @@ func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupRepository(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupRepository", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupRepository), p)
 }
 
+// DeleteBackupRepositoryWithContext mocks base method.
+func (m *MockBackupServiceIface) DeleteBackupRepositoryWithContext(ctx context.Context, p *DeleteBackupRepositoryParams) (*DeleteBackupRepositoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupRepositoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBackupRepositoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupRepositoryWithContext indicates an expected call of DeleteBackupRepositoryWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupRepositoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupRepositoryWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupRepositoryWithContext), ctx, p)
+}
+
 // DeleteBackupSchedule mocks base method.
 func (m *MockBackupServiceIface) DeleteBackupSchedule(p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +315,36 @@ func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupSchedule(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupSchedule), p)
 }
 
+// DeleteBackupScheduleWithContext mocks base method.
+func (m *MockBackupServiceIface) DeleteBackupScheduleWithContext(ctx context.Context, p *DeleteBackupScheduleParams) (*DeleteBackupScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupScheduleWithContext indicates an expected call of DeleteBackupScheduleWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupScheduleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupScheduleWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupScheduleWithContext), ctx, p)
+}
+
+// DeleteBackupWithContext mocks base method.
+func (m *MockBackupServiceIface) DeleteBackupWithContext(ctx context.Context, p *DeleteBackupParams) (*DeleteBackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBackupWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBackupWithContext indicates an expected call of DeleteBackupWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) DeleteBackupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBackupWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).DeleteBackupWithContext), ctx, p)
+}
+
 // GetBackupByID mocks base method.
 func (m *MockBackupServiceIface) GetBackupByID(id string, opts ...OptionFunc) (*Backup, int, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +366,27 @@ func (mr *MockBackupServiceIfaceMockRecorder) GetBackupByID(id any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupByID), varargs...)
 }
 
+// GetBackupByIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Backup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Backup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupByIDWithContext indicates an expected call of GetBackupByIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupByIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupByIDWithContext), varargs...)
+}
+
 // GetBackupByName mocks base method.
 func (m *MockBackupServiceIface) GetBackupByName(name string, opts ...OptionFunc) (*Backup, int, error) {
 	m.ctrl.T.Helper()
@@ -216,302 +404,1022 @@ func (m *MockBackupServiceIface) GetBackupByName(name string, opts ...OptionFunc
 // GetBackupByName indicates an expected call of GetBackupByName.
 func (mr *MockBackupServiceIfaceMockRecorder) GetBackupByName(name any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupByName", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupByName), varargs...)
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupByName", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupByName), varargs...)
+}
+
+// GetBackupByNameWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Backup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Backup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupByNameWithContext indicates an expected call of GetBackupByNameWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupByNameWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupByNameWithContext), varargs...)
+}
+
+// GetBackupID mocks base method.
+func (m *MockBackupServiceIface) GetBackupID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupID indicates an expected call of GetBackupID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupID), varargs...)
+}
+
+// GetBackupIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupIDWithContext indicates an expected call of GetBackupIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupIDWithContext), varargs...)
+}
+
+// GetBackupOfferingByID mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingByID", varargs...)
+	ret0, _ := ret[0].(*BackupOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingByID indicates an expected call of GetBackupOfferingByID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByID), varargs...)
+}
+
+// GetBackupOfferingByIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*BackupOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingByIDWithContext indicates an expected call of GetBackupOfferingByIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByIDWithContext), varargs...)
+}
+
+// GetBackupOfferingByName mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingByName", varargs...)
+	ret0, _ := ret[0].(*BackupOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingByName indicates an expected call of GetBackupOfferingByName.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByName", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByName), varargs...)
+}
+
+// GetBackupOfferingByNameWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*BackupOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingByNameWithContext indicates an expected call of GetBackupOfferingByNameWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByNameWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByNameWithContext), varargs...)
+}
+
+// GetBackupOfferingID mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingID indicates an expected call of GetBackupOfferingID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingID(keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingID), varargs...)
+}
+
+// GetBackupOfferingIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupOfferingIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupOfferingIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupOfferingIDWithContext indicates an expected call of GetBackupOfferingIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingIDWithContext), varargs...)
+}
+
+// GetBackupProviderOfferingID mocks base method.
+func (m *MockBackupServiceIface) GetBackupProviderOfferingID(keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{keyword, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupProviderOfferingID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupProviderOfferingID indicates an expected call of GetBackupProviderOfferingID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupProviderOfferingID(keyword, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{keyword, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupProviderOfferingID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupProviderOfferingID), varargs...)
+}
+
+// GetBackupProviderOfferingIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupProviderOfferingIDWithContext(ctx context.Context, keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupProviderOfferingIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupProviderOfferingIDWithContext indicates an expected call of GetBackupProviderOfferingIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupProviderOfferingIDWithContext(ctx, keyword, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupProviderOfferingIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupProviderOfferingIDWithContext), varargs...)
+}
+
+// GetBackupRepositoryByID mocks base method.
+func (m *MockBackupServiceIface) GetBackupRepositoryByID(id string, opts ...OptionFunc) (*BackupRepository, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupRepositoryByID", varargs...)
+	ret0, _ := ret[0].(*BackupRepository)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupRepositoryByID indicates an expected call of GetBackupRepositoryByID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryByID), varargs...)
+}
+
+// GetBackupRepositoryByIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupRepositoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupRepository, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupRepositoryByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*BackupRepository)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupRepositoryByIDWithContext indicates an expected call of GetBackupRepositoryByIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryByIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryByIDWithContext), varargs...)
+}
+
+// GetBackupRepositoryByName mocks base method.
+func (m *MockBackupServiceIface) GetBackupRepositoryByName(name string, opts ...OptionFunc) (*BackupRepository, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupRepositoryByName", varargs...)
+	ret0, _ := ret[0].(*BackupRepository)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupRepositoryByName indicates an expected call of GetBackupRepositoryByName.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryByName", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryByName), varargs...)
+}
+
+// GetBackupRepositoryByNameWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupRepositoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*BackupRepository, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupRepositoryByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*BackupRepository)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupRepositoryByNameWithContext indicates an expected call of GetBackupRepositoryByNameWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryByNameWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryByNameWithContext), varargs...)
+}
+
+// GetBackupRepositoryID mocks base method.
+func (m *MockBackupServiceIface) GetBackupRepositoryID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupRepositoryID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupRepositoryID indicates an expected call of GetBackupRepositoryID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryID), varargs...)
+}
+
+// GetBackupRepositoryIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupRepositoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupRepositoryIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupRepositoryIDWithContext indicates an expected call of GetBackupRepositoryIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryIDWithContext), varargs...)
+}
+
+// GetBackupScheduleByID mocks base method.
+func (m *MockBackupServiceIface) GetBackupScheduleByID(id string, opts ...OptionFunc) (*BackupSchedule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupScheduleByID", varargs...)
+	ret0, _ := ret[0].(*BackupSchedule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupScheduleByID indicates an expected call of GetBackupScheduleByID.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupScheduleByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupScheduleByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupScheduleByID), varargs...)
+}
+
+// GetBackupScheduleByIDWithContext mocks base method.
+func (m *MockBackupServiceIface) GetBackupScheduleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*BackupSchedule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBackupScheduleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*BackupSchedule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBackupScheduleByIDWithContext indicates an expected call of GetBackupScheduleByIDWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) GetBackupScheduleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupScheduleByIDWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupScheduleByIDWithContext), varargs...)
+}
+
+// ImportBackupOffering mocks base method.
+func (m *MockBackupServiceIface) ImportBackupOffering(p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBackupOffering", p)
+	ret0, _ := ret[0].(*ImportBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBackupOffering indicates an expected call of ImportBackupOffering.
+func (mr *MockBackupServiceIfaceMockRecorder) ImportBackupOffering(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).ImportBackupOffering), p)
+}
+
+// ImportBackupOfferingAsync mocks base method.
+func (m *MockBackupServiceIface) ImportBackupOfferingAsync(ctx context.Context, p *ImportBackupOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBackupOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBackupOfferingAsync indicates an expected call of ImportBackupOfferingAsync.
+func (mr *MockBackupServiceIfaceMockRecorder) ImportBackupOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBackupOfferingAsync", reflect.TypeOf((*MockBackupServiceIface)(nil).ImportBackupOfferingAsync), ctx, p)
+}
+
+// ImportBackupOfferingWithContext mocks base method.
+func (m *MockBackupServiceIface) ImportBackupOfferingWithContext(ctx context.Context, p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportBackupOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*ImportBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportBackupOfferingWithContext indicates an expected call of ImportBackupOfferingWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ImportBackupOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBackupOfferingWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ImportBackupOfferingWithContext), ctx, p)
+}
+
+// ListBackupOfferings mocks base method.
+func (m *MockBackupServiceIface) ListBackupOfferings(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupOfferings", p)
+	ret0, _ := ret[0].(*ListBackupOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupOfferings indicates an expected call of ListBackupOfferings.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferings(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferings", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferings), p)
+}
+
+// ListBackupOfferingsAll mocks base method.
+func (m *MockBackupServiceIface) ListBackupOfferingsAll(p *ListBackupOfferingsParams, opts ...PageOption) ([]*BackupOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupOfferingsAll", varargs...)
+	ret0, _ := ret[0].([]*BackupOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupOfferingsAll indicates an expected call of ListBackupOfferingsAll.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferingsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferingsAll", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferingsAll), varargs...)
+}
+
+// ListBackupOfferingsAllWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupOfferingsAllWithContext(ctx context.Context, p *ListBackupOfferingsParams, opts ...PageOption) ([]*BackupOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupOfferingsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BackupOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupOfferingsAllWithContext indicates an expected call of ListBackupOfferingsAllWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferingsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferingsAllWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferingsAllWithContext), varargs...)
+}
+
+// ListBackupOfferingsIter mocks base method.
+func (m *MockBackupServiceIface) ListBackupOfferingsIter(p *ListBackupOfferingsParams, opts ...PageOption) iter.Seq2[*BackupOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupOfferingsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupOffering, error])
+	return ret0
+}
+
+// ListBackupOfferingsIter indicates an expected call of ListBackupOfferingsIter.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferingsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferingsIter", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferingsIter), varargs...)
+}
+
+// ListBackupOfferingsIterWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupOfferingsIterWithContext(ctx context.Context, p *ListBackupOfferingsParams, opts ...PageOption) iter.Seq2[*BackupOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupOfferingsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupOffering, error])
+	return ret0
+}
+
+// ListBackupOfferingsIterWithContext indicates an expected call of ListBackupOfferingsIterWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferingsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferingsIterWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferingsIterWithContext), varargs...)
+}
+
+// ListBackupOfferingsWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupOfferingsWithContext(ctx context.Context, p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupOfferingsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBackupOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupOfferingsWithContext indicates an expected call of ListBackupOfferingsWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferingsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferingsWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferingsWithContext), ctx, p)
+}
+
+// ListBackupProviderOfferings mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupProviderOfferings", p)
+	ret0, _ := ret[0].(*ListBackupProviderOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProviderOfferings indicates an expected call of ListBackupProviderOfferings.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferings(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferings", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferings), p)
+}
+
+// ListBackupProviderOfferingsAll mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviderOfferingsAll(p *ListBackupProviderOfferingsParams, opts ...PageOption) ([]*BackupProviderOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupProviderOfferingsAll", varargs...)
+	ret0, _ := ret[0].([]*BackupProviderOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProviderOfferingsAll indicates an expected call of ListBackupProviderOfferingsAll.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferingsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferingsAll", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferingsAll), varargs...)
+}
+
+// ListBackupProviderOfferingsAllWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviderOfferingsAllWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams, opts ...PageOption) ([]*BackupProviderOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupProviderOfferingsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BackupProviderOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProviderOfferingsAllWithContext indicates an expected call of ListBackupProviderOfferingsAllWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferingsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferingsAllWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferingsAllWithContext), varargs...)
+}
+
+// ListBackupProviderOfferingsIter mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviderOfferingsIter(p *ListBackupProviderOfferingsParams, opts ...PageOption) iter.Seq2[*BackupProviderOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupProviderOfferingsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupProviderOffering, error])
+	return ret0
+}
+
+// ListBackupProviderOfferingsIter indicates an expected call of ListBackupProviderOfferingsIter.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferingsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferingsIter", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferingsIter), varargs...)
+}
+
+// ListBackupProviderOfferingsIterWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviderOfferingsIterWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams, opts ...PageOption) iter.Seq2[*BackupProviderOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupProviderOfferingsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupProviderOffering, error])
+	return ret0
+}
+
+// ListBackupProviderOfferingsIterWithContext indicates an expected call of ListBackupProviderOfferingsIterWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferingsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferingsIterWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferingsIterWithContext), varargs...)
+}
+
+// ListBackupProviderOfferingsWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviderOfferingsWithContext(ctx context.Context, p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupProviderOfferingsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBackupProviderOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProviderOfferingsWithContext indicates an expected call of ListBackupProviderOfferingsWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferingsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferingsWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferingsWithContext), ctx, p)
+}
+
+// ListBackupProviders mocks base method.
+func (m *MockBackupServiceIface) ListBackupProviders(p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupProviders", p)
+	ret0, _ := ret[0].(*ListBackupProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProviders indicates an expected call of ListBackupProviders.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviders(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviders", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviders), p)
+}
+
+// ListBackupProvidersWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupProvidersWithContext(ctx context.Context, p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBackupProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupProvidersWithContext indicates an expected call of ListBackupProvidersWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProvidersWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProvidersWithContext), ctx, p)
+}
+
+// ListBackupRepositories mocks base method.
+func (m *MockBackupServiceIface) ListBackupRepositories(p *ListBackupRepositoriesParams) (*ListBackupRepositoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBackupRepositories", p)
+	ret0, _ := ret[0].(*ListBackupRepositoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupRepositories indicates an expected call of ListBackupRepositories.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupRepositories(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupRepositories", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupRepositories), p)
+}
+
+// ListBackupRepositoriesAll mocks base method.
+func (m *MockBackupServiceIface) ListBackupRepositoriesAll(p *ListBackupRepositoriesParams, opts ...PageOption) ([]*BackupRepository, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupRepositoriesAll", varargs...)
+	ret0, _ := ret[0].([]*BackupRepository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBackupRepositoriesAll indicates an expected call of ListBackupRepositoriesAll.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupRepositoriesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupRepositoriesAll", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupRepositoriesAll), varargs...)
 }
 
-// GetBackupID mocks base method.
-func (m *MockBackupServiceIface) GetBackupID(name string, opts ...OptionFunc) (string, int, error) {
+// ListBackupRepositoriesAllWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupRepositoriesAllWithContext(ctx context.Context, p *ListBackupRepositoriesParams, opts ...PageOption) ([]*BackupRepository, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetBackupID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupRepositoriesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BackupRepository)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetBackupID indicates an expected call of GetBackupID.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupID(name any, opts ...any) *gomock.Call {
+// ListBackupRepositoriesAllWithContext indicates an expected call of ListBackupRepositoriesAllWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupRepositoriesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupRepositoriesAllWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupRepositoriesAllWithContext), varargs...)
 }
 
-// GetBackupOfferingByID mocks base method.
-func (m *MockBackupServiceIface) GetBackupOfferingByID(id string, opts ...OptionFunc) (*BackupOffering, int, error) {
+// ListBackupRepositoriesIter mocks base method.
+func (m *MockBackupServiceIface) ListBackupRepositoriesIter(p *ListBackupRepositoriesParams, opts ...PageOption) iter.Seq2[*BackupRepository, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetBackupOfferingByID", varargs...)
-	ret0, _ := ret[0].(*BackupOffering)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupRepositoriesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupRepository, error])
+	return ret0
 }
 
-// GetBackupOfferingByID indicates an expected call of GetBackupOfferingByID.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByID(id any, opts ...any) *gomock.Call {
+// ListBackupRepositoriesIter indicates an expected call of ListBackupRepositoriesIter.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupRepositoriesIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupRepositoriesIter", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupRepositoriesIter), varargs...)
 }
 
-// GetBackupOfferingByName mocks base method.
-func (m *MockBackupServiceIface) GetBackupOfferingByName(name string, opts ...OptionFunc) (*BackupOffering, int, error) {
+// ListBackupRepositoriesIterWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupRepositoriesIterWithContext(ctx context.Context, p *ListBackupRepositoriesParams, opts ...PageOption) iter.Seq2[*BackupRepository, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetBackupOfferingByName", varargs...)
-	ret0, _ := ret[0].(*BackupOffering)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupRepositoriesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupRepository, error])
+	return ret0
 }
 
-// GetBackupOfferingByName indicates an expected call of GetBackupOfferingByName.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingByName(name any, opts ...any) *gomock.Call {
+// ListBackupRepositoriesIterWithContext indicates an expected call of ListBackupRepositoriesIterWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupRepositoriesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingByName", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingByName), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupRepositoriesIterWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupRepositoriesIterWithContext), varargs...)
 }
 
-// GetBackupOfferingID mocks base method.
-func (m *MockBackupServiceIface) GetBackupOfferingID(keyword string, opts ...OptionFunc) (string, int, error) {
+// ListBackupRepositoriesWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupRepositoriesWithContext(ctx context.Context, p *ListBackupRepositoriesParams) (*ListBackupRepositoriesResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{keyword}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetBackupOfferingID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupRepositoriesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBackupRepositoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetBackupOfferingID indicates an expected call of GetBackupOfferingID.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupOfferingID(keyword any, opts ...any) *gomock.Call {
+// ListBackupRepositoriesWithContext indicates an expected call of ListBackupRepositoriesWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupRepositoriesWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{keyword}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupOfferingID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupOfferingID), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupRepositoriesWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupRepositoriesWithContext), ctx, p)
 }
 
-// GetBackupProviderOfferingID mocks base method.
-func (m *MockBackupServiceIface) GetBackupProviderOfferingID(keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+// ListBackupSchedule mocks base method.
+func (m *MockBackupServiceIface) ListBackupSchedule(p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{keyword, zoneid}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetBackupProviderOfferingID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupSchedule", p)
+	ret0, _ := ret[0].(*ListBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetBackupProviderOfferingID indicates an expected call of GetBackupProviderOfferingID.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupProviderOfferingID(keyword, zoneid any, opts ...any) *gomock.Call {
+// ListBackupSchedule indicates an expected call of ListBackupSchedule.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupSchedule(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{keyword, zoneid}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupProviderOfferingID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupProviderOfferingID), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupSchedule), p)
 }
 
-// GetBackupRepositoryByID mocks base method.
-func (m *MockBackupServiceIface) GetBackupRepositoryByID(id string, opts ...OptionFunc) (*BackupRepository, int, error) {
+// ListBackupScheduleAll mocks base method.
+func (m *MockBackupServiceIface) ListBackupScheduleAll(p *ListBackupScheduleParams, opts ...PageOption) ([]*BackupSchedule, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetBackupRepositoryByID", varargs...)
-	ret0, _ := ret[0].(*BackupRepository)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupScheduleAll", varargs...)
+	ret0, _ := ret[0].([]*BackupSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetBackupRepositoryByID indicates an expected call of GetBackupRepositoryByID.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryByID(id any, opts ...any) *gomock.Call {
+// ListBackupScheduleAll indicates an expected call of ListBackupScheduleAll.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupScheduleAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupScheduleAll", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupScheduleAll), varargs...)
 }
 
-// GetBackupRepositoryByName mocks base method.
-func (m *MockBackupServiceIface) GetBackupRepositoryByName(name string, opts ...OptionFunc) (*BackupRepository, int, error) {
+// ListBackupScheduleAllWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupScheduleAllWithContext(ctx context.Context, p *ListBackupScheduleParams, opts ...PageOption) ([]*BackupSchedule, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetBackupRepositoryByName", varargs...)
-	ret0, _ := ret[0].(*BackupRepository)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupScheduleAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BackupSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetBackupRepositoryByName indicates an expected call of GetBackupRepositoryByName.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryByName(name any, opts ...any) *gomock.Call {
+// ListBackupScheduleAllWithContext indicates an expected call of ListBackupScheduleAllWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupScheduleAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryByName", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryByName), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupScheduleAllWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupScheduleAllWithContext), varargs...)
 }
 
-// GetBackupRepositoryID mocks base method.
-func (m *MockBackupServiceIface) GetBackupRepositoryID(name string, opts ...OptionFunc) (string, int, error) {
+// ListBackupScheduleIter mocks base method.
+func (m *MockBackupServiceIface) ListBackupScheduleIter(p *ListBackupScheduleParams, opts ...PageOption) iter.Seq2[*BackupSchedule, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetBackupRepositoryID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupScheduleIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupSchedule, error])
+	return ret0
 }
 
-// GetBackupRepositoryID indicates an expected call of GetBackupRepositoryID.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupRepositoryID(name any, opts ...any) *gomock.Call {
+// ListBackupScheduleIter indicates an expected call of ListBackupScheduleIter.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupScheduleIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupRepositoryID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupRepositoryID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupScheduleIter", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupScheduleIter), varargs...)
 }
 
-// GetBackupScheduleByID mocks base method.
-func (m *MockBackupServiceIface) GetBackupScheduleByID(id string, opts ...OptionFunc) (*BackupSchedule, int, error) {
+// ListBackupScheduleIterWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupScheduleIterWithContext(ctx context.Context, p *ListBackupScheduleParams, opts ...PageOption) iter.Seq2[*BackupSchedule, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetBackupScheduleByID", varargs...)
-	ret0, _ := ret[0].(*BackupSchedule)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListBackupScheduleIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BackupSchedule, error])
+	return ret0
 }
 
-// GetBackupScheduleByID indicates an expected call of GetBackupScheduleByID.
-func (mr *MockBackupServiceIfaceMockRecorder) GetBackupScheduleByID(id any, opts ...any) *gomock.Call {
+// ListBackupScheduleIterWithContext indicates an expected call of ListBackupScheduleIterWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupScheduleIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBackupScheduleByID", reflect.TypeOf((*MockBackupServiceIface)(nil).GetBackupScheduleByID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupScheduleIterWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupScheduleIterWithContext), varargs...)
 }
 
-// ImportBackupOffering mocks base method.
-func (m *MockBackupServiceIface) ImportBackupOffering(p *ImportBackupOfferingParams) (*ImportBackupOfferingResponse, error) {
+// ListBackupScheduleWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupScheduleWithContext(ctx context.Context, p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImportBackupOffering", p)
-	ret0, _ := ret[0].(*ImportBackupOfferingResponse)
+	ret := m.ctrl.Call(m, "ListBackupScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBackupScheduleResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ImportBackupOffering indicates an expected call of ImportBackupOffering.
-func (mr *MockBackupServiceIfaceMockRecorder) ImportBackupOffering(p any) *gomock.Call {
+// ListBackupScheduleWithContext indicates an expected call of ListBackupScheduleWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupScheduleWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).ImportBackupOffering), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupScheduleWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupScheduleWithContext), ctx, p)
 }
 
-// ListBackupOfferings mocks base method.
-func (m *MockBackupServiceIface) ListBackupOfferings(p *ListBackupOfferingsParams) (*ListBackupOfferingsResponse, error) {
+// ListBackups mocks base method.
+func (m *MockBackupServiceIface) ListBackups(p *ListBackupsParams) (*ListBackupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBackupOfferings", p)
-	ret0, _ := ret[0].(*ListBackupOfferingsResponse)
+	ret := m.ctrl.Call(m, "ListBackups", p)
+	ret0, _ := ret[0].(*ListBackupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListBackupOfferings indicates an expected call of ListBackupOfferings.
-func (mr *MockBackupServiceIfaceMockRecorder) ListBackupOfferings(p any) *gomock.Call {
+// ListBackups indicates an expected call of ListBackups.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackups(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupOfferings", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupOfferings), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackups", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackups), p)
 }
 
-// ListBackupProviderOfferings mocks base method.
-func (m *MockBackupServiceIface) ListBackupProviderOfferings(p *ListBackupProviderOfferingsParams) (*ListBackupProviderOfferingsResponse, error) {
+// ListBackupsAll mocks base method.
+func (m *MockBackupServiceIface) ListBackupsAll(p *ListBackupsParams, opts ...PageOption) ([]*Backup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBackupProviderOfferings", p)
-	ret0, _ := ret[0].(*ListBackupProviderOfferingsResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupsAll", varargs...)
+	ret0, _ := ret[0].([]*Backup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListBackupProviderOfferings indicates an expected call of ListBackupProviderOfferings.
-func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviderOfferings(p any) *gomock.Call {
+// ListBackupsAll indicates an expected call of ListBackupsAll.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupsAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviderOfferings", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviderOfferings), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupsAll", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupsAll), varargs...)
 }
 
-// ListBackupProviders mocks base method.
-func (m *MockBackupServiceIface) ListBackupProviders(p *ListBackupProvidersParams) (*ListBackupProvidersResponse, error) {
+// ListBackupsAllWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupsAllWithContext(ctx context.Context, p *ListBackupsParams, opts ...PageOption) ([]*Backup, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBackupProviders", p)
-	ret0, _ := ret[0].(*ListBackupProvidersResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Backup)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListBackupProviders indicates an expected call of ListBackupProviders.
-func (mr *MockBackupServiceIfaceMockRecorder) ListBackupProviders(p any) *gomock.Call {
+// ListBackupsAllWithContext indicates an expected call of ListBackupsAllWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupProviders", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupProviders), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupsAllWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupsAllWithContext), varargs...)
 }
 
-// ListBackupRepositories mocks base method.
-func (m *MockBackupServiceIface) ListBackupRepositories(p *ListBackupRepositoriesParams) (*ListBackupRepositoriesResponse, error) {
+// ListBackupsIter mocks base method.
+func (m *MockBackupServiceIface) ListBackupsIter(p *ListBackupsParams, opts ...PageOption) iter.Seq2[*Backup, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBackupRepositories", p)
-	ret0, _ := ret[0].(*ListBackupRepositoriesResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Backup, error])
+	return ret0
 }
 
-// ListBackupRepositories indicates an expected call of ListBackupRepositories.
-func (mr *MockBackupServiceIfaceMockRecorder) ListBackupRepositories(p any) *gomock.Call {
+// ListBackupsIter indicates an expected call of ListBackupsIter.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupsIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupRepositories", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupRepositories), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupsIter", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupsIter), varargs...)
 }
 
-// ListBackupSchedule mocks base method.
-func (m *MockBackupServiceIface) ListBackupSchedule(p *ListBackupScheduleParams) (*ListBackupScheduleResponse, error) {
+// ListBackupsIterWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupsIterWithContext(ctx context.Context, p *ListBackupsParams, opts ...PageOption) iter.Seq2[*Backup, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBackupSchedule", p)
-	ret0, _ := ret[0].(*ListBackupScheduleResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBackupsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Backup, error])
+	return ret0
 }
 
-// ListBackupSchedule indicates an expected call of ListBackupSchedule.
-func (mr *MockBackupServiceIfaceMockRecorder) ListBackupSchedule(p any) *gomock.Call {
+// ListBackupsIterWithContext indicates an expected call of ListBackupsIterWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupSchedule), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupsIterWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupsIterWithContext), varargs...)
 }
 
-// ListBackups mocks base method.
-func (m *MockBackupServiceIface) ListBackups(p *ListBackupsParams) (*ListBackupsResponse, error) {
+// ListBackupsWithContext mocks base method.
+func (m *MockBackupServiceIface) ListBackupsWithContext(ctx context.Context, p *ListBackupsParams) (*ListBackupsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListBackups", p)
+	ret := m.ctrl.Call(m, "ListBackupsWithContext", ctx, p)
 	ret0, _ := ret[0].(*ListBackupsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListBackups indicates an expected call of ListBackups.
-func (mr *MockBackupServiceIfaceMockRecorder) ListBackups(p any) *gomock.Call {
+// ListBackupsWithContext indicates an expected call of ListBackupsWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) ListBackupsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackups", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackups), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBackupsWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).ListBackupsWithContext), ctx, p)
 }
 
 // NewAddBackupRepositoryParams mocks base method.
@@ -543,7 +1451,7 @@ func (mr *MockBackupServiceIfaceMockRecorder) NewCreateBackupParams(virtualmachi
 }
 
 // NewCreateBackupScheduleParams mocks base method.
-func (m *MockBackupServiceIface) NewCreateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid string) *CreateBackupScheduleParams {
+func (m *MockBackupServiceIface) NewCreateBackupScheduleParams(intervaltype IntervalType, schedule, timezone, virtualmachineid string) *CreateBackupScheduleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateBackupScheduleParams", intervaltype, schedule, timezone, virtualmachineid)
 	ret0, _ := ret[0].(*CreateBackupScheduleParams)
@@ -767,7 +1675,7 @@ func (mr *MockBackupServiceIfaceMockRecorder) NewUpdateBackupRepositoryParams(id
 }
 
 // NewUpdateBackupScheduleParams mocks base method.
-func (m *MockBackupServiceIface) NewUpdateBackupScheduleParams(intervaltype, schedule, timezone, virtualmachineid string) *UpdateBackupScheduleParams {
+func (m *MockBackupServiceIface) NewUpdateBackupScheduleParams(intervaltype IntervalType, schedule, timezone, virtualmachineid string) *UpdateBackupScheduleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewUpdateBackupScheduleParams", intervaltype, schedule, timezone, virtualmachineid)
 	ret0, _ := ret[0].(*UpdateBackupScheduleParams)
@@ -795,6 +1703,36 @@ func (mr *MockBackupServiceIfaceMockRecorder) RestoreBackup(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackup", reflect.TypeOf((*MockBackupServiceIface)(nil).RestoreBackup), p)
 }
 
+// RestoreBackupAsync mocks base method.
+func (m *MockBackupServiceIface) RestoreBackupAsync(ctx context.Context, p *RestoreBackupParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBackupAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBackupAsync indicates an expected call of RestoreBackupAsync.
+func (mr *MockBackupServiceIfaceMockRecorder) RestoreBackupAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackupAsync", reflect.TypeOf((*MockBackupServiceIface)(nil).RestoreBackupAsync), ctx, p)
+}
+
+// RestoreBackupWithContext mocks base method.
+func (m *MockBackupServiceIface) RestoreBackupWithContext(ctx context.Context, p *RestoreBackupParams) (*RestoreBackupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreBackupWithContext", ctx, p)
+	ret0, _ := ret[0].(*RestoreBackupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreBackupWithContext indicates an expected call of RestoreBackupWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) RestoreBackupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreBackupWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).RestoreBackupWithContext), ctx, p)
+}
+
 // UpdateBackupOffering mocks base method.
 func (m *MockBackupServiceIface) UpdateBackupOffering(p *UpdateBackupOfferingParams) (*UpdateBackupOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -810,6 +1748,21 @@ func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupOffering(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupOffering", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupOffering), p)
 }
 
+// UpdateBackupOfferingWithContext mocks base method.
+func (m *MockBackupServiceIface) UpdateBackupOfferingWithContext(ctx context.Context, p *UpdateBackupOfferingParams) (*UpdateBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBackupOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBackupOfferingWithContext indicates an expected call of UpdateBackupOfferingWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupOfferingWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupOfferingWithContext), ctx, p)
+}
+
 // UpdateBackupRepository mocks base method.
 func (m *MockBackupServiceIface) UpdateBackupRepository(p *UpdateBackupRepositoryParams) (*UpdateBackupRepositoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -825,6 +1778,21 @@ func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupRepository(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupRepository", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupRepository), p)
 }
 
+// UpdateBackupRepositoryWithContext mocks base method.
+func (m *MockBackupServiceIface) UpdateBackupRepositoryWithContext(ctx context.Context, p *UpdateBackupRepositoryParams) (*UpdateBackupRepositoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBackupRepositoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateBackupRepositoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBackupRepositoryWithContext indicates an expected call of UpdateBackupRepositoryWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupRepositoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupRepositoryWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupRepositoryWithContext), ctx, p)
+}
+
 // UpdateBackupSchedule mocks base method.
 func (m *MockBackupServiceIface) UpdateBackupSchedule(p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -839,3 +1807,18 @@ func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupSchedule(p any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupSchedule", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupSchedule), p)
 }
+
+// UpdateBackupScheduleWithContext mocks base method.
+func (m *MockBackupServiceIface) UpdateBackupScheduleWithContext(ctx context.Context, p *UpdateBackupScheduleParams) (*UpdateBackupScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBackupScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateBackupScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBackupScheduleWithContext indicates an expected call of UpdateBackupScheduleWithContext.
+func (mr *MockBackupServiceIfaceMockRecorder) UpdateBackupScheduleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBackupScheduleWithContext", reflect.TypeOf((*MockBackupServiceIface)(nil).UpdateBackupScheduleWithContext), ctx, p)
+}
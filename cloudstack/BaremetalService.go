@@ -20,29 +20,58 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type BaremetalServiceIface interface {
 	AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpWithContext(ctx context.Context, p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error)
+	AddBaremetalDhcpAsync(ctx context.Context, p *AddBaremetalDhcpParams) (*Job, error)
 	NewAddBaremetalDhcpParams(dhcpservertype string, password string, physicalnetworkid string, url string, username string) *AddBaremetalDhcpParams
 	AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error)
+	AddBaremetalPxeKickStartServerAsync(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*Job, error)
 	NewAddBaremetalPxeKickStartServerParams(password string, physicalnetworkid string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxeKickStartServerParams
 	AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error)
+	AddBaremetalPxePingServerAsync(ctx context.Context, p *AddBaremetalPxePingServerParams) (*Job, error)
 	NewAddBaremetalPxePingServerParams(password string, physicalnetworkid string, pingdir string, pingstorageserverip string, pxeservertype string, tftpdir string, url string, username string) *AddBaremetalPxePingServerParams
 	AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error)
+	AddBaremetalRctWithContext(ctx context.Context, p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error)
+	AddBaremetalRctAsync(ctx context.Context, p *AddBaremetalRctParams) (*Job, error)
 	NewAddBaremetalRctParams(baremetalrcturl string) *AddBaremetalRctParams
 	DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctWithContext(ctx context.Context, p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error)
+	DeleteBaremetalRctAsync(ctx context.Context, p *DeleteBaremetalRctParams) (*Job, error)
 	NewDeleteBaremetalRctParams(id string) *DeleteBaremetalRctParams
 	ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error)
+	ListBaremetalDhcpAll(p *ListBaremetalDhcpParams, opts ...PageOption) ([]*BaremetalDhcp, error)
+	ListBaremetalDhcpAllWithContext(ctx context.Context, p *ListBaremetalDhcpParams, opts ...PageOption) ([]*BaremetalDhcp, error)
+	ListBaremetalDhcpIter(p *ListBaremetalDhcpParams, opts ...PageOption) iter.Seq2[*BaremetalDhcp, error]
+	ListBaremetalDhcpIterWithContext(ctx context.Context, p *ListBaremetalDhcpParams, opts ...PageOption) iter.Seq2[*BaremetalDhcp, error]
 	NewListBaremetalDhcpParams(physicalnetworkid string) *ListBaremetalDhcpParams
 	ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error)
+	ListBaremetalPxeServersAll(p *ListBaremetalPxeServersParams, opts ...PageOption) ([]*BaremetalPxeServer, error)
+	ListBaremetalPxeServersAllWithContext(ctx context.Context, p *ListBaremetalPxeServersParams, opts ...PageOption) ([]*BaremetalPxeServer, error)
+	ListBaremetalPxeServersIter(p *ListBaremetalPxeServersParams, opts ...PageOption) iter.Seq2[*BaremetalPxeServer, error]
+	ListBaremetalPxeServersIterWithContext(ctx context.Context, p *ListBaremetalPxeServersParams, opts ...PageOption) iter.Seq2[*BaremetalPxeServer, error]
 	NewListBaremetalPxeServersParams(physicalnetworkid string) *ListBaremetalPxeServersParams
 	ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	ListBaremetalRctWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error)
+	ListBaremetalRctAll(p *ListBaremetalRctParams, opts ...PageOption) ([]*BaremetalRct, error)
+	ListBaremetalRctAllWithContext(ctx context.Context, p *ListBaremetalRctParams, opts ...PageOption) ([]*BaremetalRct, error)
+	ListBaremetalRctIter(p *ListBaremetalRctParams, opts ...PageOption) iter.Seq2[*BaremetalRct, error]
+	ListBaremetalRctIterWithContext(ctx context.Context, p *ListBaremetalRctParams, opts ...PageOption) iter.Seq2[*BaremetalRct, error]
 	NewListBaremetalRctParams() *ListBaremetalRctParams
 	NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error)
+	NotifyBaremetalProvisionDoneAsync(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*Job, error)
 	NewNotifyBaremetalProvisionDoneParams(mac string) *NotifyBaremetalProvisionDoneParams
 }
 
@@ -73,6 +102,48 @@ func (p *AddBaremetalDhcpParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBaremetalDhcp API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBaremetalDhcpParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBaremetalDhcp", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBaremetalDhcp API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBaremetalDhcpParams) Validate() error {
+	return paramRules{
+		required: []string{"dhcpservertype", "password", "physicalnetworkid", "url", "username"},
+		lengths:  map[string]int{"dhcpservertype": 255, "password": 255, "physicalnetworkid": 255, "url": 2048, "username": 255},
+	}.validate("addBaremetalDhcp", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBaremetalDhcp API, before the request is signed
+func (p *AddBaremetalDhcpParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBaremetalDhcpParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBaremetalDhcpParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBaremetalDhcp", b, &p.p, map[string]paramDecoder{
+		"dhcpservertype":    decodeParam[string],
+		"password":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"url":               decodeParam[string],
+		"username":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBaremetalDhcpParams) Clone() *AddBaremetalDhcpParams {
+	return &AddBaremetalDhcpParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBaremetalDhcpParams) SetDhcpservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -193,32 +264,39 @@ func (s *BaremetalService) NewAddBaremetalDhcpParams(dhcpservertype string, pass
 
 // Adds a baremetal DHCP server
 func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newPostRequest("addBaremetalDhcp", p.toURLValues())
+	return s.AddBaremetalDhcpWithContext(context.Background(), p)
+}
+
+// AddBaremetalDhcpWithContext is like AddBaremetalDhcp, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) AddBaremetalDhcpWithContext(ctx context.Context, p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addBaremetalDhcp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBaremetalDhcpResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBaremetalDhcp", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addBaremetalDhcp", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addBaremetalDhcp", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -226,14 +304,39 @@ func (s *BaremetalService) AddBaremetalDhcp(p *AddBaremetalDhcpParams) (*AddBare
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddBaremetalDhcp async job into r
+func (r *AddBaremetalDhcpResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddBaremetalDhcpAsync starts the async job of AddBaremetalDhcp without waiting for it to finish, and returns a handle to the job
+func (s *BaremetalService) AddBaremetalDhcpAsync(ctx context.Context, p *AddBaremetalDhcpParams) (*Job, error) {
+	r, err := s.AddBaremetalDhcpWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addBaremetalDhcp", r.JobID), nil
+}
+
 type AddBaremetalDhcpResponse struct {
-	Dhcpservertype    string `json:"dhcpservertype"`
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Provider          string `json:"provider"`
-	Url               string `json:"url"`
+	Dhcpservertype    string                     `json:"dhcpservertype"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Provider          string                     `json:"provider"`
+	Url               string                     `json:"url"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBaremetalDhcpResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBaremetalDhcpResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddBaremetalPxeKickStartServerParams struct {
@@ -269,6 +372,50 @@ func (p *AddBaremetalPxeKickStartServerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBaremetalPxeKickStartServer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBaremetalPxeKickStartServerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBaremetalPxeKickStartServer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBaremetalPxeKickStartServer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBaremetalPxeKickStartServerParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "physicalnetworkid", "pxeservertype", "tftpdir", "url", "username"},
+		lengths:  map[string]int{"password": 255, "physicalnetworkid": 255, "podid": 255, "pxeservertype": 255, "tftpdir": 255, "url": 2048, "username": 255},
+	}.validate("addBaremetalPxeKickStartServer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBaremetalPxeKickStartServer API, before the request is signed
+func (p *AddBaremetalPxeKickStartServerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBaremetalPxeKickStartServerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBaremetalPxeKickStartServerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBaremetalPxeKickStartServer", b, &p.p, map[string]paramDecoder{
+		"password":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"podid":             decodeParam[string],
+		"pxeservertype":     decodeParam[string],
+		"tftpdir":           decodeParam[string],
+		"url":               decodeParam[string],
+		"username":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBaremetalPxeKickStartServerParams) Clone() *AddBaremetalPxeKickStartServerParams {
+	return &AddBaremetalPxeKickStartServerParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBaremetalPxeKickStartServerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -432,32 +579,39 @@ func (s *BaremetalService) NewAddBaremetalPxeKickStartServerParams(password stri
 
 // Add a baremetal PXE server
 func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
-	resp, err := s.cs.newPostRequest("addBaremetalPxeKickStartServer", p.toURLValues())
+	return s.AddBaremetalPxeKickStartServerWithContext(context.Background(), p)
+}
+
+// AddBaremetalPxeKickStartServerWithContext is like AddBaremetalPxeKickStartServer, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addBaremetalPxeKickStartServer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBaremetalPxeKickStartServerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBaremetalPxeKickStartServer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addBaremetalPxeKickStartServer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addBaremetalPxeKickStartServer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -465,14 +619,39 @@ func (s *BaremetalService) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKick
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddBaremetalPxeKickStartServer async job into r
+func (r *AddBaremetalPxeKickStartServerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddBaremetalPxeKickStartServerAsync starts the async job of AddBaremetalPxeKickStartServer without waiting for it to finish, and returns a handle to the job
+func (s *BaremetalService) AddBaremetalPxeKickStartServerAsync(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*Job, error) {
+	r, err := s.AddBaremetalPxeKickStartServerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addBaremetalPxeKickStartServer", r.JobID), nil
+}
+
 type AddBaremetalPxeKickStartServerResponse struct {
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Provider          string `json:"provider"`
-	Tftpdir           string `json:"tftpdir"`
-	Url               string `json:"url"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Provider          string                     `json:"provider"`
+	Tftpdir           string                     `json:"tftpdir"`
+	Url               string                     `json:"url"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBaremetalPxeKickStartServerResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBaremetalPxeKickStartServerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddBaremetalPxePingServerParams struct {
@@ -520,6 +699,54 @@ func (p *AddBaremetalPxePingServerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBaremetalPxePingServer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBaremetalPxePingServerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBaremetalPxePingServer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBaremetalPxePingServer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBaremetalPxePingServerParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "physicalnetworkid", "pingdir", "pingstorageserverip", "pxeservertype", "tftpdir", "url", "username"},
+		lengths:  map[string]int{"password": 255, "physicalnetworkid": 255, "pingcifspassword": 255, "pingcifsusername": 255, "pingdir": 255, "pingstorageserverip": 255, "podid": 255, "pxeservertype": 255, "tftpdir": 255, "url": 2048, "username": 255},
+	}.validate("addBaremetalPxePingServer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBaremetalPxePingServer API, before the request is signed
+func (p *AddBaremetalPxePingServerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBaremetalPxePingServerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBaremetalPxePingServerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBaremetalPxePingServer", b, &p.p, map[string]paramDecoder{
+		"password":            decodeParam[string],
+		"physicalnetworkid":   decodeParam[string],
+		"pingcifspassword":    decodeParam[string],
+		"pingcifsusername":    decodeParam[string],
+		"pingdir":             decodeParam[string],
+		"pingstorageserverip": decodeParam[string],
+		"podid":               decodeParam[string],
+		"pxeservertype":       decodeParam[string],
+		"tftpdir":             decodeParam[string],
+		"url":                 decodeParam[string],
+		"username":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBaremetalPxePingServerParams) Clone() *AddBaremetalPxePingServerParams {
+	return &AddBaremetalPxePingServerParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBaremetalPxePingServerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -769,32 +996,39 @@ func (s *BaremetalService) NewAddBaremetalPxePingServerParams(password string, p
 
 // Add a baremetal ping PXE server
 func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
-	resp, err := s.cs.newPostRequest("addBaremetalPxePingServer", p.toURLValues())
+	return s.AddBaremetalPxePingServerWithContext(context.Background(), p)
+}
+
+// AddBaremetalPxePingServerWithContext is like AddBaremetalPxePingServer, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) AddBaremetalPxePingServerWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addBaremetalPxePingServer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBaremetalPxePingServerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBaremetalPxePingServer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addBaremetalPxePingServer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addBaremetalPxePingServer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -802,16 +1036,41 @@ func (s *BaremetalService) AddBaremetalPxePingServer(p *AddBaremetalPxePingServe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddBaremetalPxePingServer async job into r
+func (r *AddBaremetalPxePingServerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddBaremetalPxePingServerAsync starts the async job of AddBaremetalPxePingServer without waiting for it to finish, and returns a handle to the job
+func (s *BaremetalService) AddBaremetalPxePingServerAsync(ctx context.Context, p *AddBaremetalPxePingServerParams) (*Job, error) {
+	r, err := s.AddBaremetalPxePingServerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addBaremetalPxePingServer", r.JobID), nil
+}
+
 type AddBaremetalPxePingServerResponse struct {
-	Id                  string `json:"id"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Physicalnetworkid   string `json:"physicalnetworkid"`
-	Pingdir             string `json:"pingdir"`
-	Pingstorageserverip string `json:"pingstorageserverip"`
-	Provider            string `json:"provider"`
-	Tftpdir             string `json:"tftpdir"`
-	Url                 string `json:"url"`
+	Id                  string                     `json:"id"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid"`
+	Pingdir             string                     `json:"pingdir"`
+	Pingstorageserverip string                     `json:"pingstorageserverip"`
+	Provider            string                     `json:"provider"`
+	Tftpdir             string                     `json:"tftpdir"`
+	Url                 string                     `json:"url"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBaremetalPxePingServerResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBaremetalPxePingServerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddBaremetalRctParams struct {
@@ -829,6 +1088,44 @@ func (p *AddBaremetalRctParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBaremetalRct API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBaremetalRctParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBaremetalRct", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBaremetalRct API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBaremetalRctParams) Validate() error {
+	return paramRules{
+		required: []string{"baremetalrcturl"},
+		lengths:  map[string]int{"baremetalrcturl": 255},
+	}.validate("addBaremetalRct", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBaremetalRct API, before the request is signed
+func (p *AddBaremetalRctParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBaremetalRct", b, &p.p, map[string]paramDecoder{
+		"baremetalrcturl": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBaremetalRctParams) Clone() *AddBaremetalRctParams {
+	return &AddBaremetalRctParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBaremetalRctParams) SetBaremetalrcturl(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -861,32 +1158,39 @@ func (s *BaremetalService) NewAddBaremetalRctParams(baremetalrcturl string) *Add
 
 // Adds baremetal rack configuration text
 func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
-	resp, err := s.cs.newPostRequest("addBaremetalRct", p.toURLValues())
+	return s.AddBaremetalRctWithContext(context.Background(), p)
+}
+
+// AddBaremetalRctWithContext is like AddBaremetalRct, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) AddBaremetalRctWithContext(ctx context.Context, p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addBaremetalRct", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBaremetalRctResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBaremetalRct", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addBaremetalRct", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addBaremetalRct", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -894,11 +1198,36 @@ func (s *BaremetalService) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBareme
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddBaremetalRct async job into r
+func (r *AddBaremetalRctResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddBaremetalRctAsync starts the async job of AddBaremetalRct without waiting for it to finish, and returns a handle to the job
+func (s *BaremetalService) AddBaremetalRctAsync(ctx context.Context, p *AddBaremetalRctParams) (*Job, error) {
+	r, err := s.AddBaremetalRctWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addBaremetalRct", r.JobID), nil
+}
+
 type AddBaremetalRctResponse struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Url       string `json:"url"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Url       string                     `json:"url"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBaremetalRctResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBaremetalRctResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBaremetalRctParams struct {
@@ -916,6 +1245,44 @@ func (p *DeleteBaremetalRctParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBaremetalRct API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBaremetalRctParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBaremetalRct", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBaremetalRct API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBaremetalRctParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteBaremetalRct", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBaremetalRct API, before the request is signed
+func (p *DeleteBaremetalRctParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBaremetalRct", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBaremetalRctParams) Clone() *DeleteBaremetalRctParams {
+	return &DeleteBaremetalRctParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBaremetalRctParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -948,27 +1315,39 @@ func (s *BaremetalService) NewDeleteBaremetalRctParams(id string) *DeleteBaremet
 
 // Deletes baremetal rack configuration text
 func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBaremetalRct", p.toURLValues())
+	return s.DeleteBaremetalRctWithContext(context.Background(), p)
+}
+
+// DeleteBaremetalRctWithContext is like DeleteBaremetalRct, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) DeleteBaremetalRctWithContext(ctx context.Context, p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBaremetalRct", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBaremetalRctResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBaremetalRct", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteBaremetalRct", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteBaremetalRct", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -976,11 +1355,31 @@ func (s *BaremetalService) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*Del
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteBaremetalRct async job into r
+func (r *DeleteBaremetalRctResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteBaremetalRctAsync starts the async job of DeleteBaremetalRct without waiting for it to finish, and returns a handle to the job
+func (s *BaremetalService) DeleteBaremetalRctAsync(ctx context.Context, p *DeleteBaremetalRctParams) (*Job, error) {
+	r, err := s.DeleteBaremetalRctWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteBaremetalRct", r.JobID), nil
+}
+
 type DeleteBaremetalRctResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteBaremetalRctResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteBaremetalRctResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBaremetalDhcpParams struct {
@@ -1016,6 +1415,49 @@ func (p *ListBaremetalDhcpParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBaremetalDhcp API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBaremetalDhcpParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBaremetalDhcp", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBaremetalDhcp API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBaremetalDhcpParams) Validate() error {
+	return paramRules{
+		required: []string{"physicalnetworkid"},
+		lengths:  map[string]int{"dhcpservertype": 255, "keyword": 255, "physicalnetworkid": 255},
+	}.validate("listBaremetalDhcp", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBaremetalDhcp API, before the request is signed
+func (p *ListBaremetalDhcpParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBaremetalDhcpParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBaremetalDhcpParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBaremetalDhcp", b, &p.p, map[string]paramDecoder{
+		"dhcpservertype":    decodeParam[string],
+		"id":                decodeParam[int64],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBaremetalDhcpParams) Clone() *ListBaremetalDhcpParams {
+	return &ListBaremetalDhcpParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBaremetalDhcpParams) SetDhcpservertype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1153,32 +1595,75 @@ func (s *BaremetalService) NewListBaremetalDhcpParams(physicalnetworkid string)
 
 // List baremetal dhcp servers
 func (s *BaremetalService) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalDhcp", p.toURLValues())
+	return s.ListBaremetalDhcpWithContext(context.Background(), p)
+}
+
+// ListBaremetalDhcpWithContext is like ListBaremetalDhcp, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalDhcpWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBaremetalDhcp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBaremetalDhcpResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBaremetalDhcp", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBaremetalDhcpAll returns all BaremetalDhcp matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BaremetalService) ListBaremetalDhcpAll(p *ListBaremetalDhcpParams, opts ...PageOption) ([]*BaremetalDhcp, error) {
+	return s.ListBaremetalDhcpAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBaremetalDhcpAllWithContext is like ListBaremetalDhcpAll, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalDhcpAllWithContext(ctx context.Context, p *ListBaremetalDhcpParams, opts ...PageOption) ([]*BaremetalDhcp, error) {
+	return collectPages(s.ListBaremetalDhcpIterWithContext(ctx, p, opts...))
+}
+
+// ListBaremetalDhcpIter returns an iterator over all BaremetalDhcp matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BaremetalService) ListBaremetalDhcpIter(p *ListBaremetalDhcpParams, opts ...PageOption) iter.Seq2[*BaremetalDhcp, error] {
+	return s.ListBaremetalDhcpIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBaremetalDhcpIterWithContext is like ListBaremetalDhcpIter, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalDhcpIterWithContext(ctx context.Context, p *ListBaremetalDhcpParams, opts ...PageOption) iter.Seq2[*BaremetalDhcp, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BaremetalDhcp, int, error) {
+		l, err := s.ListBaremetalDhcpWithContext(ctx, &ListBaremetalDhcpParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BaremetalDhcp, l.Count, nil
+	}, opts...)
+}
+
 type ListBaremetalDhcpResponse struct {
 	Count         int              `json:"count"`
 	BaremetalDhcp []*BaremetalDhcp `json:"baremetaldhcp"`
 }
 
 type BaremetalDhcp struct {
-	Dhcpservertype    string `json:"dhcpservertype"`
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Provider          string `json:"provider"`
-	Url               string `json:"url"`
+	Dhcpservertype    string                     `json:"dhcpservertype"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Provider          string                     `json:"provider"`
+	Url               string                     `json:"url"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BaremetalDhcp) UnmarshalJSON(b []byte) error {
+	type alias BaremetalDhcp
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBaremetalPxeServersParams struct {
@@ -1211,6 +1696,48 @@ func (p *ListBaremetalPxeServersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBaremetalPxeServers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBaremetalPxeServersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBaremetalPxeServers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBaremetalPxeServers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBaremetalPxeServersParams) Validate() error {
+	return paramRules{
+		required: []string{"physicalnetworkid"},
+		lengths:  map[string]int{"keyword": 255, "physicalnetworkid": 255},
+	}.validate("listBaremetalPxeServers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBaremetalPxeServers API, before the request is signed
+func (p *ListBaremetalPxeServersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBaremetalPxeServersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBaremetalPxeServersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBaremetalPxeServers", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[int64],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBaremetalPxeServersParams) Clone() *ListBaremetalPxeServersParams {
+	return &ListBaremetalPxeServersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBaremetalPxeServersParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1327,31 +1854,74 @@ func (s *BaremetalService) NewListBaremetalPxeServersParams(physicalnetworkid st
 
 // List baremetal pxe server
 func (s *BaremetalService) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalPxeServers", p.toURLValues())
+	return s.ListBaremetalPxeServersWithContext(context.Background(), p)
+}
+
+// ListBaremetalPxeServersWithContext is like ListBaremetalPxeServers, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalPxeServersWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBaremetalPxeServers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBaremetalPxeServersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBaremetalPxeServers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBaremetalPxeServersAll returns all BaremetalPxeServers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BaremetalService) ListBaremetalPxeServersAll(p *ListBaremetalPxeServersParams, opts ...PageOption) ([]*BaremetalPxeServer, error) {
+	return s.ListBaremetalPxeServersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBaremetalPxeServersAllWithContext is like ListBaremetalPxeServersAll, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalPxeServersAllWithContext(ctx context.Context, p *ListBaremetalPxeServersParams, opts ...PageOption) ([]*BaremetalPxeServer, error) {
+	return collectPages(s.ListBaremetalPxeServersIterWithContext(ctx, p, opts...))
+}
+
+// ListBaremetalPxeServersIter returns an iterator over all BaremetalPxeServers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BaremetalService) ListBaremetalPxeServersIter(p *ListBaremetalPxeServersParams, opts ...PageOption) iter.Seq2[*BaremetalPxeServer, error] {
+	return s.ListBaremetalPxeServersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBaremetalPxeServersIterWithContext is like ListBaremetalPxeServersIter, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalPxeServersIterWithContext(ctx context.Context, p *ListBaremetalPxeServersParams, opts ...PageOption) iter.Seq2[*BaremetalPxeServer, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BaremetalPxeServer, int, error) {
+		l, err := s.ListBaremetalPxeServersWithContext(ctx, &ListBaremetalPxeServersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BaremetalPxeServers, l.Count, nil
+	}, opts...)
+}
+
 type ListBaremetalPxeServersResponse struct {
 	Count               int                   `json:"count"`
 	BaremetalPxeServers []*BaremetalPxeServer `json:"baremetalpxeserver"`
 }
 
 type BaremetalPxeServer struct {
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Provider          string `json:"provider"`
-	Url               string `json:"url"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Provider          string                     `json:"provider"`
+	Url               string                     `json:"url"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BaremetalPxeServer) UnmarshalJSON(b []byte) error {
+	type alias BaremetalPxeServer
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBaremetalRctParams struct {
@@ -1377,6 +1947,45 @@ func (p *ListBaremetalRctParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBaremetalRct API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBaremetalRctParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBaremetalRct", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBaremetalRct API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBaremetalRctParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listBaremetalRct", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBaremetalRct API, before the request is signed
+func (p *ListBaremetalRctParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBaremetalRctParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBaremetalRctParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBaremetalRct", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBaremetalRctParams) Clone() *ListBaremetalRctParams {
+	return &ListBaremetalRctParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBaremetalRctParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1450,29 +2059,72 @@ func (s *BaremetalService) NewListBaremetalRctParams() *ListBaremetalRctParams {
 
 // List baremetal rack configuration
 func (s *BaremetalService) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
-	resp, err := s.cs.newRequest("listBaremetalRct", p.toURLValues())
+	return s.ListBaremetalRctWithContext(context.Background(), p)
+}
+
+// ListBaremetalRctWithContext is like ListBaremetalRct, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalRctWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBaremetalRct", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBaremetalRctResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBaremetalRct", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBaremetalRctAll returns all BaremetalRct matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BaremetalService) ListBaremetalRctAll(p *ListBaremetalRctParams, opts ...PageOption) ([]*BaremetalRct, error) {
+	return s.ListBaremetalRctAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBaremetalRctAllWithContext is like ListBaremetalRctAll, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalRctAllWithContext(ctx context.Context, p *ListBaremetalRctParams, opts ...PageOption) ([]*BaremetalRct, error) {
+	return collectPages(s.ListBaremetalRctIterWithContext(ctx, p, opts...))
+}
+
+// ListBaremetalRctIter returns an iterator over all BaremetalRct matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BaremetalService) ListBaremetalRctIter(p *ListBaremetalRctParams, opts ...PageOption) iter.Seq2[*BaremetalRct, error] {
+	return s.ListBaremetalRctIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBaremetalRctIterWithContext is like ListBaremetalRctIter, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) ListBaremetalRctIterWithContext(ctx context.Context, p *ListBaremetalRctParams, opts ...PageOption) iter.Seq2[*BaremetalRct, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BaremetalRct, int, error) {
+		l, err := s.ListBaremetalRctWithContext(ctx, &ListBaremetalRctParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BaremetalRct, l.Count, nil
+	}, opts...)
+}
+
 type ListBaremetalRctResponse struct {
 	Count        int             `json:"count"`
 	BaremetalRct []*BaremetalRct `json:"baremetalrct"`
 }
 
 type BaremetalRct struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Url       string `json:"url"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Url       string                     `json:"url"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BaremetalRct) UnmarshalJSON(b []byte) error {
+	type alias BaremetalRct
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NotifyBaremetalProvisionDoneParams struct {
@@ -1490,6 +2142,44 @@ func (p *NotifyBaremetalProvisionDoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the notifyBaremetalProvisionDone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *NotifyBaremetalProvisionDoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("notifyBaremetalProvisionDone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the notifyBaremetalProvisionDone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *NotifyBaremetalProvisionDoneParams) Validate() error {
+	return paramRules{
+		required: []string{"mac"},
+		lengths:  map[string]int{"mac": 255},
+	}.validate("notifyBaremetalProvisionDone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the notifyBaremetalProvisionDone API, before the request is signed
+func (p *NotifyBaremetalProvisionDoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *NotifyBaremetalProvisionDoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *NotifyBaremetalProvisionDoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("notifyBaremetalProvisionDone", b, &p.p, map[string]paramDecoder{
+		"mac": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *NotifyBaremetalProvisionDoneParams) Clone() *NotifyBaremetalProvisionDoneParams {
+	return &NotifyBaremetalProvisionDoneParams{p: cloneParams(p.p)}
+}
+
 func (p *NotifyBaremetalProvisionDoneParams) SetMac(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1522,27 +2212,39 @@ func (s *BaremetalService) NewNotifyBaremetalProvisionDoneParams(mac string) *No
 
 // Notify provision has been done on a host. This api is for baremetal virtual router service, not for end user
 func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
-	resp, err := s.cs.newPostRequest("notifyBaremetalProvisionDone", p.toURLValues())
+	return s.NotifyBaremetalProvisionDoneWithContext(context.Background(), p)
+}
+
+// NotifyBaremetalProvisionDoneWithContext is like NotifyBaremetalProvisionDone, but honours the cancellation and deadline of ctx
+func (s *BaremetalService) NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "notifyBaremetalProvisionDone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r NotifyBaremetalProvisionDoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("notifyBaremetalProvisionDone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "notifyBaremetalProvisionDone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("notifyBaremetalProvisionDone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1550,9 +2252,29 @@ func (s *BaremetalService) NotifyBaremetalProvisionDone(p *NotifyBaremetalProvis
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished NotifyBaremetalProvisionDone async job into r
+func (r *NotifyBaremetalProvisionDoneResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// NotifyBaremetalProvisionDoneAsync starts the async job of NotifyBaremetalProvisionDone without waiting for it to finish, and returns a handle to the job
+func (s *BaremetalService) NotifyBaremetalProvisionDoneAsync(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*Job, error) {
+	r, err := s.NotifyBaremetalProvisionDoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("notifyBaremetalProvisionDone", r.JobID), nil
+}
+
 type NotifyBaremetalProvisionDoneResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NotifyBaremetalProvisionDoneResponse) UnmarshalJSON(b []byte) error {
+	type alias NotifyBaremetalProvisionDoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalDhcp(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalDhcp", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalDhcp), p)
 }
 
+// AddBaremetalDhcpAsync mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalDhcpAsync(ctx context.Context, p *AddBaremetalDhcpParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalDhcpAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalDhcpAsync indicates an expected call of AddBaremetalDhcpAsync.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalDhcpAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalDhcpAsync", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalDhcpAsync), ctx, p)
+}
+
+// AddBaremetalDhcpWithContext mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalDhcpWithContext(ctx context.Context, p *AddBaremetalDhcpParams) (*AddBaremetalDhcpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalDhcpWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBaremetalDhcpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalDhcpWithContext indicates an expected call of AddBaremetalDhcpWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalDhcpWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalDhcpWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalDhcpWithContext), ctx, p)
+}
+
 // AddBaremetalPxeKickStartServer mocks base method.
 func (m *MockBaremetalServiceIface) AddBaremetalPxeKickStartServer(p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxeKickStartServer(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxeKickStartServer", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxeKickStartServer), p)
 }
 
+// AddBaremetalPxeKickStartServerAsync mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalPxeKickStartServerAsync(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalPxeKickStartServerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalPxeKickStartServerAsync indicates an expected call of AddBaremetalPxeKickStartServerAsync.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxeKickStartServerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxeKickStartServerAsync", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxeKickStartServerAsync), ctx, p)
+}
+
+// AddBaremetalPxeKickStartServerWithContext mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalPxeKickStartServerWithContext(ctx context.Context, p *AddBaremetalPxeKickStartServerParams) (*AddBaremetalPxeKickStartServerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalPxeKickStartServerWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBaremetalPxeKickStartServerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalPxeKickStartServerWithContext indicates an expected call of AddBaremetalPxeKickStartServerWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxeKickStartServerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxeKickStartServerWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxeKickStartServerWithContext), ctx, p)
+}
+
 // AddBaremetalPxePingServer mocks base method.
 func (m *MockBaremetalServiceIface) AddBaremetalPxePingServer(p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxePingServer(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxePingServer", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxePingServer), p)
 }
 
+// AddBaremetalPxePingServerAsync mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalPxePingServerAsync(ctx context.Context, p *AddBaremetalPxePingServerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalPxePingServerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalPxePingServerAsync indicates an expected call of AddBaremetalPxePingServerAsync.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxePingServerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxePingServerAsync", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxePingServerAsync), ctx, p)
+}
+
+// AddBaremetalPxePingServerWithContext mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalPxePingServerWithContext(ctx context.Context, p *AddBaremetalPxePingServerParams) (*AddBaremetalPxePingServerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalPxePingServerWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBaremetalPxePingServerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalPxePingServerWithContext indicates an expected call of AddBaremetalPxePingServerWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalPxePingServerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalPxePingServerWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalPxePingServerWithContext), ctx, p)
+}
+
 // AddBaremetalRct mocks base method.
 func (m *MockBaremetalServiceIface) AddBaremetalRct(p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalRct(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalRct), p)
 }
 
+// AddBaremetalRctAsync mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalRctAsync(ctx context.Context, p *AddBaremetalRctParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalRctAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalRctAsync indicates an expected call of AddBaremetalRctAsync.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalRctAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalRctAsync", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalRctAsync), ctx, p)
+}
+
+// AddBaremetalRctWithContext mocks base method.
+func (m *MockBaremetalServiceIface) AddBaremetalRctWithContext(ctx context.Context, p *AddBaremetalRctParams) (*AddBaremetalRctResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalRctWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBaremetalRctResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalRctWithContext indicates an expected call of AddBaremetalRctWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) AddBaremetalRctWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalRctWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).AddBaremetalRctWithContext), ctx, p)
+}
+
 // DeleteBaremetalRct mocks base method.
 func (m *MockBaremetalServiceIface) DeleteBaremetalRct(p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) DeleteBaremetalRct(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).DeleteBaremetalRct), p)
 }
 
+// DeleteBaremetalRctAsync mocks base method.
+func (m *MockBaremetalServiceIface) DeleteBaremetalRctAsync(ctx context.Context, p *DeleteBaremetalRctParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBaremetalRctAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBaremetalRctAsync indicates an expected call of DeleteBaremetalRctAsync.
+func (mr *MockBaremetalServiceIfaceMockRecorder) DeleteBaremetalRctAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBaremetalRctAsync", reflect.TypeOf((*MockBaremetalServiceIface)(nil).DeleteBaremetalRctAsync), ctx, p)
+}
+
+// DeleteBaremetalRctWithContext mocks base method.
+func (m *MockBaremetalServiceIface) DeleteBaremetalRctWithContext(ctx context.Context, p *DeleteBaremetalRctParams) (*DeleteBaremetalRctResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBaremetalRctWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBaremetalRctResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBaremetalRctWithContext indicates an expected call of DeleteBaremetalRctWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) DeleteBaremetalRctWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBaremetalRctWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).DeleteBaremetalRctWithContext), ctx, p)
+}
+
 // ListBaremetalDhcp mocks base method.
 func (m *MockBaremetalServiceIface) ListBaremetalDhcp(p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +300,99 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcp(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcp", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcp), p)
 }
 
+// ListBaremetalDhcpAll mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalDhcpAll(p *ListBaremetalDhcpParams, opts ...PageOption) ([]*BaremetalDhcp, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalDhcpAll", varargs...)
+	ret0, _ := ret[0].([]*BaremetalDhcp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalDhcpAll indicates an expected call of ListBaremetalDhcpAll.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcpAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcpAll", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcpAll), varargs...)
+}
+
+// ListBaremetalDhcpAllWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalDhcpAllWithContext(ctx context.Context, p *ListBaremetalDhcpParams, opts ...PageOption) ([]*BaremetalDhcp, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalDhcpAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BaremetalDhcp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalDhcpAllWithContext indicates an expected call of ListBaremetalDhcpAllWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcpAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcpAllWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcpAllWithContext), varargs...)
+}
+
+// ListBaremetalDhcpIter mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalDhcpIter(p *ListBaremetalDhcpParams, opts ...PageOption) iter.Seq2[*BaremetalDhcp, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalDhcpIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BaremetalDhcp, error])
+	return ret0
+}
+
+// ListBaremetalDhcpIter indicates an expected call of ListBaremetalDhcpIter.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcpIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcpIter", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcpIter), varargs...)
+}
+
+// ListBaremetalDhcpIterWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalDhcpIterWithContext(ctx context.Context, p *ListBaremetalDhcpParams, opts ...PageOption) iter.Seq2[*BaremetalDhcp, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalDhcpIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BaremetalDhcp, error])
+	return ret0
+}
+
+// ListBaremetalDhcpIterWithContext indicates an expected call of ListBaremetalDhcpIterWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcpIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcpIterWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcpIterWithContext), varargs...)
+}
+
+// ListBaremetalDhcpWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalDhcpWithContext(ctx context.Context, p *ListBaremetalDhcpParams) (*ListBaremetalDhcpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBaremetalDhcpWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBaremetalDhcpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalDhcpWithContext indicates an expected call of ListBaremetalDhcpWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalDhcpWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalDhcpWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalDhcpWithContext), ctx, p)
+}
+
 // ListBaremetalPxeServers mocks base method.
 func (m *MockBaremetalServiceIface) ListBaremetalPxeServers(p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +408,99 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServers(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServers", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServers), p)
 }
 
+// ListBaremetalPxeServersAll mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalPxeServersAll(p *ListBaremetalPxeServersParams, opts ...PageOption) ([]*BaremetalPxeServer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalPxeServersAll", varargs...)
+	ret0, _ := ret[0].([]*BaremetalPxeServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalPxeServersAll indicates an expected call of ListBaremetalPxeServersAll.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServersAll", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServersAll), varargs...)
+}
+
+// ListBaremetalPxeServersAllWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalPxeServersAllWithContext(ctx context.Context, p *ListBaremetalPxeServersParams, opts ...PageOption) ([]*BaremetalPxeServer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalPxeServersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BaremetalPxeServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalPxeServersAllWithContext indicates an expected call of ListBaremetalPxeServersAllWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServersAllWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServersAllWithContext), varargs...)
+}
+
+// ListBaremetalPxeServersIter mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalPxeServersIter(p *ListBaremetalPxeServersParams, opts ...PageOption) iter.Seq2[*BaremetalPxeServer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalPxeServersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BaremetalPxeServer, error])
+	return ret0
+}
+
+// ListBaremetalPxeServersIter indicates an expected call of ListBaremetalPxeServersIter.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServersIter", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServersIter), varargs...)
+}
+
+// ListBaremetalPxeServersIterWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalPxeServersIterWithContext(ctx context.Context, p *ListBaremetalPxeServersParams, opts ...PageOption) iter.Seq2[*BaremetalPxeServer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalPxeServersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BaremetalPxeServer, error])
+	return ret0
+}
+
+// ListBaremetalPxeServersIterWithContext indicates an expected call of ListBaremetalPxeServersIterWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServersIterWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServersIterWithContext), varargs...)
+}
+
+// ListBaremetalPxeServersWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalPxeServersWithContext(ctx context.Context, p *ListBaremetalPxeServersParams) (*ListBaremetalPxeServersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBaremetalPxeServersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBaremetalPxeServersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalPxeServersWithContext indicates an expected call of ListBaremetalPxeServersWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalPxeServersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalPxeServersWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalPxeServersWithContext), ctx, p)
+}
+
 // ListBaremetalRct mocks base method.
 func (m *MockBaremetalServiceIface) ListBaremetalRct(p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +516,99 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRct(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRct", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRct), p)
 }
 
+// ListBaremetalRctAll mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalRctAll(p *ListBaremetalRctParams, opts ...PageOption) ([]*BaremetalRct, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalRctAll", varargs...)
+	ret0, _ := ret[0].([]*BaremetalRct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalRctAll indicates an expected call of ListBaremetalRctAll.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRctAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRctAll", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRctAll), varargs...)
+}
+
+// ListBaremetalRctAllWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalRctAllWithContext(ctx context.Context, p *ListBaremetalRctParams, opts ...PageOption) ([]*BaremetalRct, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalRctAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BaremetalRct)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalRctAllWithContext indicates an expected call of ListBaremetalRctAllWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRctAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRctAllWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRctAllWithContext), varargs...)
+}
+
+// ListBaremetalRctIter mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalRctIter(p *ListBaremetalRctParams, opts ...PageOption) iter.Seq2[*BaremetalRct, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalRctIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BaremetalRct, error])
+	return ret0
+}
+
+// ListBaremetalRctIter indicates an expected call of ListBaremetalRctIter.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRctIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRctIter", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRctIter), varargs...)
+}
+
+// ListBaremetalRctIterWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalRctIterWithContext(ctx context.Context, p *ListBaremetalRctParams, opts ...PageOption) iter.Seq2[*BaremetalRct, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBaremetalRctIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BaremetalRct, error])
+	return ret0
+}
+
+// ListBaremetalRctIterWithContext indicates an expected call of ListBaremetalRctIterWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRctIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRctIterWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRctIterWithContext), varargs...)
+}
+
+// ListBaremetalRctWithContext mocks base method.
+func (m *MockBaremetalServiceIface) ListBaremetalRctWithContext(ctx context.Context, p *ListBaremetalRctParams) (*ListBaremetalRctResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBaremetalRctWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBaremetalRctResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBaremetalRctWithContext indicates an expected call of ListBaremetalRctWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) ListBaremetalRctWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBaremetalRctWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).ListBaremetalRctWithContext), ctx, p)
+}
+
 // NewAddBaremetalDhcpParams mocks base method.
 func (m *MockBaremetalServiceIface) NewAddBaremetalDhcpParams(dhcpservertype, password, physicalnetworkid, url, username string) *AddBaremetalDhcpParams {
 	m.ctrl.T.Helper()
@@ -318,3 +749,33 @@ func (mr *MockBaremetalServiceIfaceMockRecorder) NotifyBaremetalProvisionDone(p
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyBaremetalProvisionDone", reflect.TypeOf((*MockBaremetalServiceIface)(nil).NotifyBaremetalProvisionDone), p)
 }
+
+// NotifyBaremetalProvisionDoneAsync mocks base method.
+func (m *MockBaremetalServiceIface) NotifyBaremetalProvisionDoneAsync(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyBaremetalProvisionDoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyBaremetalProvisionDoneAsync indicates an expected call of NotifyBaremetalProvisionDoneAsync.
+func (mr *MockBaremetalServiceIfaceMockRecorder) NotifyBaremetalProvisionDoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyBaremetalProvisionDoneAsync", reflect.TypeOf((*MockBaremetalServiceIface)(nil).NotifyBaremetalProvisionDoneAsync), ctx, p)
+}
+
+// NotifyBaremetalProvisionDoneWithContext mocks base method.
+func (m *MockBaremetalServiceIface) NotifyBaremetalProvisionDoneWithContext(ctx context.Context, p *NotifyBaremetalProvisionDoneParams) (*NotifyBaremetalProvisionDoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NotifyBaremetalProvisionDoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*NotifyBaremetalProvisionDoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NotifyBaremetalProvisionDoneWithContext indicates an expected call of NotifyBaremetalProvisionDoneWithContext.
+func (mr *MockBaremetalServiceIfaceMockRecorder) NotifyBaremetalProvisionDoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NotifyBaremetalProvisionDoneWithContext", reflect.TypeOf((*MockBaremetalServiceIface)(nil).NotifyBaremetalProvisionDoneWithContext), ctx, p)
+}
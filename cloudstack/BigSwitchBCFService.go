@@ -20,17 +20,28 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type BigSwitchBCFServiceIface interface {
 	AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error)
+	AddBigSwitchBcfDeviceAsync(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*Job, error)
 	NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password string, physicalnetworkid string, username string) *AddBigSwitchBcfDeviceParams
 	DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error)
+	DeleteBigSwitchBcfDeviceAsync(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*Job, error)
 	NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid string) *DeleteBigSwitchBcfDeviceParams
 	ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error)
+	ListBigSwitchBcfDevicesAll(p *ListBigSwitchBcfDevicesParams, opts ...PageOption) ([]*BigSwitchBcfDevice, error)
+	ListBigSwitchBcfDevicesAllWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams, opts ...PageOption) ([]*BigSwitchBcfDevice, error)
+	ListBigSwitchBcfDevicesIter(p *ListBigSwitchBcfDevicesParams, opts ...PageOption) iter.Seq2[*BigSwitchBcfDevice, error]
+	ListBigSwitchBcfDevicesIterWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams, opts ...PageOption) iter.Seq2[*BigSwitchBcfDevice, error]
 	NewListBigSwitchBcfDevicesParams() *ListBigSwitchBcfDevicesParams
 }
 
@@ -62,6 +73,48 @@ func (p *AddBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBigSwitchBcfDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBigSwitchBcfDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBigSwitchBcfDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBigSwitchBcfDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBigSwitchBcfDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"hostname", "nat", "password", "physicalnetworkid", "username"},
+		lengths:  map[string]int{"hostname": 255, "password": 255, "physicalnetworkid": 255, "username": 255},
+	}.validate("addBigSwitchBcfDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBigSwitchBcfDevice API, before the request is signed
+func (p *AddBigSwitchBcfDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBigSwitchBcfDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBigSwitchBcfDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBigSwitchBcfDevice", b, &p.p, map[string]paramDecoder{
+		"hostname":          decodeParam[string],
+		"nat":               decodeParam[bool],
+		"password":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"username":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBigSwitchBcfDeviceParams) Clone() *AddBigSwitchBcfDeviceParams {
+	return &AddBigSwitchBcfDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBigSwitchBcfDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -182,32 +235,39 @@ func (s *BigSwitchBCFService) NewAddBigSwitchBcfDeviceParams(hostname string, na
 
 // Adds a BigSwitch BCF Controller device
 func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("addBigSwitchBcfDevice", p.toURLValues())
+	return s.AddBigSwitchBcfDeviceWithContext(context.Background(), p)
+}
+
+// AddBigSwitchBcfDeviceWithContext is like AddBigSwitchBcfDevice, but honours the cancellation and deadline of ctx
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addBigSwitchBcfDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBigSwitchBcfDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBigSwitchBcfDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addBigSwitchBcfDevice", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addBigSwitchBcfDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -215,17 +275,42 @@ func (s *BigSwitchBCFService) AddBigSwitchBcfDevice(p *AddBigSwitchBcfDevicePara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddBigSwitchBcfDevice async job into r
+func (r *AddBigSwitchBcfDeviceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddBigSwitchBcfDeviceAsync starts the async job of AddBigSwitchBcfDevice without waiting for it to finish, and returns a handle to the job
+func (s *BigSwitchBCFService) AddBigSwitchBcfDeviceAsync(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*Job, error) {
+	r, err := s.AddBigSwitchBcfDeviceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addBigSwitchBcfDevice", r.JobID), nil
+}
+
 type AddBigSwitchBcfDeviceResponse struct {
-	Bcfdeviceid         string `json:"bcfdeviceid"`
-	Bigswitchdevicename string `json:"bigswitchdevicename"`
-	Hostname            string `json:"hostname"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Nat                 bool   `json:"nat"`
-	Password            string `json:"password"`
-	Physicalnetworkid   string `json:"physicalnetworkid"`
-	Provider            string `json:"provider"`
-	Username            string `json:"username"`
+	Bcfdeviceid         string                     `json:"bcfdeviceid"`
+	Bigswitchdevicename string                     `json:"bigswitchdevicename"`
+	Hostname            string                     `json:"hostname"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Nat                 FlexBool                   `json:"nat"`
+	Password            string                     `json:"password"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid"`
+	Provider            string                     `json:"provider"`
+	Username            string                     `json:"username"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBigSwitchBcfDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBigSwitchBcfDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBigSwitchBcfDeviceParams struct {
@@ -243,6 +328,44 @@ func (p *DeleteBigSwitchBcfDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBigSwitchBcfDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBigSwitchBcfDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBigSwitchBcfDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBigSwitchBcfDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBigSwitchBcfDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"bcfdeviceid"},
+		lengths:  map[string]int{"bcfdeviceid": 255},
+	}.validate("deleteBigSwitchBcfDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBigSwitchBcfDevice API, before the request is signed
+func (p *DeleteBigSwitchBcfDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBigSwitchBcfDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBigSwitchBcfDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBigSwitchBcfDevice", b, &p.p, map[string]paramDecoder{
+		"bcfdeviceid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBigSwitchBcfDeviceParams) Clone() *DeleteBigSwitchBcfDeviceParams {
+	return &DeleteBigSwitchBcfDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBigSwitchBcfDeviceParams) SetBcfdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -275,27 +398,39 @@ func (s *BigSwitchBCFService) NewDeleteBigSwitchBcfDeviceParams(bcfdeviceid stri
 
 // delete a BigSwitch BCF Controller device
 func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBigSwitchBcfDevice", p.toURLValues())
+	return s.DeleteBigSwitchBcfDeviceWithContext(context.Background(), p)
+}
+
+// DeleteBigSwitchBcfDeviceWithContext is like DeleteBigSwitchBcfDevice, but honours the cancellation and deadline of ctx
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBigSwitchBcfDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBigSwitchBcfDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBigSwitchBcfDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteBigSwitchBcfDevice", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteBigSwitchBcfDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -303,11 +438,31 @@ func (s *BigSwitchBCFService) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDevi
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteBigSwitchBcfDevice async job into r
+func (r *DeleteBigSwitchBcfDeviceResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteBigSwitchBcfDeviceAsync starts the async job of DeleteBigSwitchBcfDevice without waiting for it to finish, and returns a handle to the job
+func (s *BigSwitchBCFService) DeleteBigSwitchBcfDeviceAsync(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*Job, error) {
+	r, err := s.DeleteBigSwitchBcfDeviceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteBigSwitchBcfDevice", r.JobID), nil
+}
+
 type DeleteBigSwitchBcfDeviceResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteBigSwitchBcfDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteBigSwitchBcfDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBigSwitchBcfDevicesParams struct {
@@ -339,6 +494,47 @@ func (p *ListBigSwitchBcfDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBigSwitchBcfDevices API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBigSwitchBcfDevicesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBigSwitchBcfDevices", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBigSwitchBcfDevices API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBigSwitchBcfDevicesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"bcfdeviceid": 255, "keyword": 255, "physicalnetworkid": 255},
+	}.validate("listBigSwitchBcfDevices", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBigSwitchBcfDevices API, before the request is signed
+func (p *ListBigSwitchBcfDevicesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBigSwitchBcfDevicesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBigSwitchBcfDevicesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBigSwitchBcfDevices", b, &p.p, map[string]paramDecoder{
+		"bcfdeviceid":       decodeParam[string],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBigSwitchBcfDevicesParams) Clone() *ListBigSwitchBcfDevicesParams {
+	return &ListBigSwitchBcfDevicesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBigSwitchBcfDevicesParams) SetBcfdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -454,33 +650,76 @@ func (s *BigSwitchBCFService) NewListBigSwitchBcfDevicesParams() *ListBigSwitchB
 
 // Lists BigSwitch BCF Controller devices
 func (s *BigSwitchBCFService) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBigSwitchBcfDevices", p.toURLValues())
+	return s.ListBigSwitchBcfDevicesWithContext(context.Background(), p)
+}
+
+// ListBigSwitchBcfDevicesWithContext is like ListBigSwitchBcfDevices, but honours the cancellation and deadline of ctx
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBigSwitchBcfDevices", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBigSwitchBcfDevicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBigSwitchBcfDevices", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBigSwitchBcfDevicesAll returns all BigSwitchBcfDevices matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesAll(p *ListBigSwitchBcfDevicesParams, opts ...PageOption) ([]*BigSwitchBcfDevice, error) {
+	return s.ListBigSwitchBcfDevicesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBigSwitchBcfDevicesAllWithContext is like ListBigSwitchBcfDevicesAll, but honours the cancellation and deadline of ctx
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesAllWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams, opts ...PageOption) ([]*BigSwitchBcfDevice, error) {
+	return collectPages(s.ListBigSwitchBcfDevicesIterWithContext(ctx, p, opts...))
+}
+
+// ListBigSwitchBcfDevicesIter returns an iterator over all BigSwitchBcfDevices matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesIter(p *ListBigSwitchBcfDevicesParams, opts ...PageOption) iter.Seq2[*BigSwitchBcfDevice, error] {
+	return s.ListBigSwitchBcfDevicesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBigSwitchBcfDevicesIterWithContext is like ListBigSwitchBcfDevicesIter, but honours the cancellation and deadline of ctx
+func (s *BigSwitchBCFService) ListBigSwitchBcfDevicesIterWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams, opts ...PageOption) iter.Seq2[*BigSwitchBcfDevice, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BigSwitchBcfDevice, int, error) {
+		l, err := s.ListBigSwitchBcfDevicesWithContext(ctx, &ListBigSwitchBcfDevicesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BigSwitchBcfDevices, l.Count, nil
+	}, opts...)
+}
+
 type ListBigSwitchBcfDevicesResponse struct {
 	Count               int                   `json:"count"`
 	BigSwitchBcfDevices []*BigSwitchBcfDevice `json:"bigswitchbcfdevice"`
 }
 
 type BigSwitchBcfDevice struct {
-	Bcfdeviceid         string `json:"bcfdeviceid"`
-	Bigswitchdevicename string `json:"bigswitchdevicename"`
-	Hostname            string `json:"hostname"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Nat                 bool   `json:"nat"`
-	Password            string `json:"password"`
-	Physicalnetworkid   string `json:"physicalnetworkid"`
-	Provider            string `json:"provider"`
-	Username            string `json:"username"`
+	Bcfdeviceid         string                     `json:"bcfdeviceid"`
+	Bigswitchdevicename string                     `json:"bigswitchdevicename"`
+	Hostname            string                     `json:"hostname"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Nat                 FlexBool                   `json:"nat"`
+	Password            string                     `json:"password"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid"`
+	Provider            string                     `json:"provider"`
+	Username            string                     `json:"username"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BigSwitchBcfDevice) UnmarshalJSON(b []byte) error {
+	type alias BigSwitchBcfDevice
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
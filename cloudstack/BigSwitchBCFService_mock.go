@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) AddBigSwitchBcfDevice(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBigSwitchBcfDevice", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).AddBigSwitchBcfDevice), p)
 }
 
+// AddBigSwitchBcfDeviceAsync mocks base method.
+func (m *MockBigSwitchBCFServiceIface) AddBigSwitchBcfDeviceAsync(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBigSwitchBcfDeviceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBigSwitchBcfDeviceAsync indicates an expected call of AddBigSwitchBcfDeviceAsync.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) AddBigSwitchBcfDeviceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBigSwitchBcfDeviceAsync", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).AddBigSwitchBcfDeviceAsync), ctx, p)
+}
+
+// AddBigSwitchBcfDeviceWithContext mocks base method.
+func (m *MockBigSwitchBCFServiceIface) AddBigSwitchBcfDeviceWithContext(ctx context.Context, p *AddBigSwitchBcfDeviceParams) (*AddBigSwitchBcfDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBigSwitchBcfDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBigSwitchBcfDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBigSwitchBcfDeviceWithContext indicates an expected call of AddBigSwitchBcfDeviceWithContext.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) AddBigSwitchBcfDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBigSwitchBcfDeviceWithContext", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).AddBigSwitchBcfDeviceWithContext), ctx, p)
+}
+
 // DeleteBigSwitchBcfDevice mocks base method.
 func (m *MockBigSwitchBCFServiceIface) DeleteBigSwitchBcfDevice(p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) DeleteBigSwitchBcfDevice(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBigSwitchBcfDevice", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).DeleteBigSwitchBcfDevice), p)
 }
 
+// DeleteBigSwitchBcfDeviceAsync mocks base method.
+func (m *MockBigSwitchBCFServiceIface) DeleteBigSwitchBcfDeviceAsync(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBigSwitchBcfDeviceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBigSwitchBcfDeviceAsync indicates an expected call of DeleteBigSwitchBcfDeviceAsync.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) DeleteBigSwitchBcfDeviceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBigSwitchBcfDeviceAsync", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).DeleteBigSwitchBcfDeviceAsync), ctx, p)
+}
+
+// DeleteBigSwitchBcfDeviceWithContext mocks base method.
+func (m *MockBigSwitchBCFServiceIface) DeleteBigSwitchBcfDeviceWithContext(ctx context.Context, p *DeleteBigSwitchBcfDeviceParams) (*DeleteBigSwitchBcfDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBigSwitchBcfDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBigSwitchBcfDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBigSwitchBcfDeviceWithContext indicates an expected call of DeleteBigSwitchBcfDeviceWithContext.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) DeleteBigSwitchBcfDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBigSwitchBcfDeviceWithContext", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).DeleteBigSwitchBcfDeviceWithContext), ctx, p)
+}
+
 // ListBigSwitchBcfDevices mocks base method.
 func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevices(p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,99 @@ func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevices(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevices", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevices), p)
 }
 
+// ListBigSwitchBcfDevicesAll mocks base method.
+func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevicesAll(p *ListBigSwitchBcfDevicesParams, opts ...PageOption) ([]*BigSwitchBcfDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBigSwitchBcfDevicesAll", varargs...)
+	ret0, _ := ret[0].([]*BigSwitchBcfDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBigSwitchBcfDevicesAll indicates an expected call of ListBigSwitchBcfDevicesAll.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevicesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevicesAll", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevicesAll), varargs...)
+}
+
+// ListBigSwitchBcfDevicesAllWithContext mocks base method.
+func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevicesAllWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams, opts ...PageOption) ([]*BigSwitchBcfDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBigSwitchBcfDevicesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BigSwitchBcfDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBigSwitchBcfDevicesAllWithContext indicates an expected call of ListBigSwitchBcfDevicesAllWithContext.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevicesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevicesAllWithContext", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevicesAllWithContext), varargs...)
+}
+
+// ListBigSwitchBcfDevicesIter mocks base method.
+func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevicesIter(p *ListBigSwitchBcfDevicesParams, opts ...PageOption) iter.Seq2[*BigSwitchBcfDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBigSwitchBcfDevicesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BigSwitchBcfDevice, error])
+	return ret0
+}
+
+// ListBigSwitchBcfDevicesIter indicates an expected call of ListBigSwitchBcfDevicesIter.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevicesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevicesIter", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevicesIter), varargs...)
+}
+
+// ListBigSwitchBcfDevicesIterWithContext mocks base method.
+func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevicesIterWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams, opts ...PageOption) iter.Seq2[*BigSwitchBcfDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBigSwitchBcfDevicesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BigSwitchBcfDevice, error])
+	return ret0
+}
+
+// ListBigSwitchBcfDevicesIterWithContext indicates an expected call of ListBigSwitchBcfDevicesIterWithContext.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevicesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevicesIterWithContext", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevicesIterWithContext), varargs...)
+}
+
+// ListBigSwitchBcfDevicesWithContext mocks base method.
+func (m *MockBigSwitchBCFServiceIface) ListBigSwitchBcfDevicesWithContext(ctx context.Context, p *ListBigSwitchBcfDevicesParams) (*ListBigSwitchBcfDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBigSwitchBcfDevicesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBigSwitchBcfDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBigSwitchBcfDevicesWithContext indicates an expected call of ListBigSwitchBcfDevicesWithContext.
+func (mr *MockBigSwitchBCFServiceIfaceMockRecorder) ListBigSwitchBcfDevicesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBigSwitchBcfDevicesWithContext", reflect.TypeOf((*MockBigSwitchBCFServiceIface)(nil).ListBigSwitchBcfDevicesWithContext), ctx, p)
+}
+
 // NewAddBigSwitchBcfDeviceParams mocks base method.
 func (m *MockBigSwitchBCFServiceIface) NewAddBigSwitchBcfDeviceParams(hostname string, nat bool, password, physicalnetworkid, username string) *AddBigSwitchBcfDeviceParams {
 	m.ctrl.T.Helper()
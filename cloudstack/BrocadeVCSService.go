@@ -20,21 +20,38 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type BrocadeVCSServiceIface interface {
 	AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error)
+	AddBrocadeVcsDeviceAsync(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*Job, error)
 	NewAddBrocadeVcsDeviceParams(hostname string, password string, physicalnetworkid string, username string) *AddBrocadeVcsDeviceParams
 	DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error)
+	DeleteBrocadeVcsDeviceAsync(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*Job, error)
 	NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string) *DeleteBrocadeVcsDeviceParams
 	ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error)
+	ListBrocadeVcsDeviceNetworksAll(p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) ([]*BrocadeVcsDeviceNetwork, error)
+	ListBrocadeVcsDeviceNetworksAllWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) ([]*BrocadeVcsDeviceNetwork, error)
+	ListBrocadeVcsDeviceNetworksIter(p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDeviceNetwork, error]
+	ListBrocadeVcsDeviceNetworksIterWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDeviceNetwork, error]
 	NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid string) *ListBrocadeVcsDeviceNetworksParams
 	GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetBrocadeVcsDeviceNetworkIDWithContext(ctx context.Context, keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error)
 	ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error)
+	ListBrocadeVcsDevicesAll(p *ListBrocadeVcsDevicesParams, opts ...PageOption) ([]*BrocadeVcsDevice, error)
+	ListBrocadeVcsDevicesAllWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams, opts ...PageOption) ([]*BrocadeVcsDevice, error)
+	ListBrocadeVcsDevicesIter(p *ListBrocadeVcsDevicesParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDevice, error]
+	ListBrocadeVcsDevicesIterWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDevice, error]
 	NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevicesParams
 }
 
@@ -62,6 +79,47 @@ func (p *AddBrocadeVcsDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBrocadeVcsDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBrocadeVcsDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBrocadeVcsDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBrocadeVcsDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBrocadeVcsDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"hostname", "password", "physicalnetworkid", "username"},
+		lengths:  map[string]int{"hostname": 255, "password": 255, "physicalnetworkid": 255, "username": 255},
+	}.validate("addBrocadeVcsDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBrocadeVcsDevice API, before the request is signed
+func (p *AddBrocadeVcsDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBrocadeVcsDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBrocadeVcsDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBrocadeVcsDevice", b, &p.p, map[string]paramDecoder{
+		"hostname":          decodeParam[string],
+		"password":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"username":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBrocadeVcsDeviceParams) Clone() *AddBrocadeVcsDeviceParams {
+	return &AddBrocadeVcsDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBrocadeVcsDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -160,32 +218,39 @@ func (s *BrocadeVCSService) NewAddBrocadeVcsDeviceParams(hostname string, passwo
 
 // Adds a Brocade VCS Switch
 func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("addBrocadeVcsDevice", p.toURLValues())
+	return s.AddBrocadeVcsDeviceWithContext(context.Background(), p)
+}
+
+// AddBrocadeVcsDeviceWithContext is like AddBrocadeVcsDevice, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addBrocadeVcsDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBrocadeVcsDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBrocadeVcsDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addBrocadeVcsDevice", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addBrocadeVcsDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -193,14 +258,39 @@ func (s *BrocadeVCSService) AddBrocadeVcsDevice(p *AddBrocadeVcsDeviceParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddBrocadeVcsDevice async job into r
+func (r *AddBrocadeVcsDeviceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddBrocadeVcsDeviceAsync starts the async job of AddBrocadeVcsDevice without waiting for it to finish, and returns a handle to the job
+func (s *BrocadeVCSService) AddBrocadeVcsDeviceAsync(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*Job, error) {
+	r, err := s.AddBrocadeVcsDeviceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addBrocadeVcsDevice", r.JobID), nil
+}
+
 type AddBrocadeVcsDeviceResponse struct {
-	Brocadedevicename string `json:"brocadedevicename"`
-	Hostname          string `json:"hostname"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Provider          string `json:"provider"`
-	Vcsdeviceid       string `json:"vcsdeviceid"`
+	Brocadedevicename string                     `json:"brocadedevicename"`
+	Hostname          string                     `json:"hostname"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Provider          string                     `json:"provider"`
+	Vcsdeviceid       string                     `json:"vcsdeviceid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBrocadeVcsDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBrocadeVcsDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBrocadeVcsDeviceParams struct {
@@ -218,6 +308,44 @@ func (p *DeleteBrocadeVcsDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBrocadeVcsDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBrocadeVcsDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBrocadeVcsDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBrocadeVcsDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBrocadeVcsDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"vcsdeviceid"},
+		lengths:  map[string]int{"vcsdeviceid": 255},
+	}.validate("deleteBrocadeVcsDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBrocadeVcsDevice API, before the request is signed
+func (p *DeleteBrocadeVcsDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBrocadeVcsDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBrocadeVcsDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBrocadeVcsDevice", b, &p.p, map[string]paramDecoder{
+		"vcsdeviceid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBrocadeVcsDeviceParams) Clone() *DeleteBrocadeVcsDeviceParams {
+	return &DeleteBrocadeVcsDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBrocadeVcsDeviceParams) SetVcsdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -250,27 +378,39 @@ func (s *BrocadeVCSService) NewDeleteBrocadeVcsDeviceParams(vcsdeviceid string)
 
 // delete a Brocade VCS Switch
 func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBrocadeVcsDevice", p.toURLValues())
+	return s.DeleteBrocadeVcsDeviceWithContext(context.Background(), p)
+}
+
+// DeleteBrocadeVcsDeviceWithContext is like DeleteBrocadeVcsDevice, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBrocadeVcsDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBrocadeVcsDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBrocadeVcsDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteBrocadeVcsDevice", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteBrocadeVcsDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -278,11 +418,31 @@ func (s *BrocadeVCSService) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDevicePara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteBrocadeVcsDevice async job into r
+func (r *DeleteBrocadeVcsDeviceResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteBrocadeVcsDeviceAsync starts the async job of DeleteBrocadeVcsDevice without waiting for it to finish, and returns a handle to the job
+func (s *BrocadeVCSService) DeleteBrocadeVcsDeviceAsync(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*Job, error) {
+	r, err := s.DeleteBrocadeVcsDeviceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteBrocadeVcsDevice", r.JobID), nil
+}
+
 type DeleteBrocadeVcsDeviceResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteBrocadeVcsDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteBrocadeVcsDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBrocadeVcsDeviceNetworksParams struct {
@@ -311,6 +471,47 @@ func (p *ListBrocadeVcsDeviceNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBrocadeVcsDeviceNetworks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBrocadeVcsDeviceNetworksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBrocadeVcsDeviceNetworks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBrocadeVcsDeviceNetworks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBrocadeVcsDeviceNetworksParams) Validate() error {
+	return paramRules{
+		required: []string{"vcsdeviceid"},
+		lengths:  map[string]int{"keyword": 255, "vcsdeviceid": 255},
+	}.validate("listBrocadeVcsDeviceNetworks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBrocadeVcsDeviceNetworks API, before the request is signed
+func (p *ListBrocadeVcsDeviceNetworksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBrocadeVcsDeviceNetworksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBrocadeVcsDeviceNetworksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBrocadeVcsDeviceNetworks", b, &p.p, map[string]paramDecoder{
+		"keyword":     decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"vcsdeviceid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBrocadeVcsDeviceNetworksParams) Clone() *ListBrocadeVcsDeviceNetworksParams {
+	return &ListBrocadeVcsDeviceNetworksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBrocadeVcsDeviceNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -406,6 +607,11 @@ func (s *BrocadeVCSService) NewListBrocadeVcsDeviceNetworksParams(vcsdeviceid st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBrocadeVcsDeviceNetworkIDWithContext(context.Background(), keyword, vcsdeviceid, opts...)
+}
+
+// GetBrocadeVcsDeviceNetworkIDWithContext is like GetBrocadeVcsDeviceNetworkID, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkIDWithContext(ctx context.Context, keyword string, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBrocadeVcsDeviceNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -418,7 +624,7 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 		}
 	}
 
-	l, err := s.ListBrocadeVcsDeviceNetworks(p)
+	l, err := s.ListBrocadeVcsDeviceNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -443,19 +649,56 @@ func (s *BrocadeVCSService) GetBrocadeVcsDeviceNetworkID(keyword string, vcsdevi
 
 // Lists network that are using a brocade vcs switch
 func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDeviceNetworks", p.toURLValues())
+	return s.ListBrocadeVcsDeviceNetworksWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDeviceNetworksWithContext is like ListBrocadeVcsDeviceNetworks, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBrocadeVcsDeviceNetworks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBrocadeVcsDeviceNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBrocadeVcsDeviceNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBrocadeVcsDeviceNetworksAll returns all BrocadeVcsDeviceNetworks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksAll(p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) ([]*BrocadeVcsDeviceNetwork, error) {
+	return s.ListBrocadeVcsDeviceNetworksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBrocadeVcsDeviceNetworksAllWithContext is like ListBrocadeVcsDeviceNetworksAll, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksAllWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) ([]*BrocadeVcsDeviceNetwork, error) {
+	return collectPages(s.ListBrocadeVcsDeviceNetworksIterWithContext(ctx, p, opts...))
+}
+
+// ListBrocadeVcsDeviceNetworksIter returns an iterator over all BrocadeVcsDeviceNetworks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksIter(p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDeviceNetwork, error] {
+	return s.ListBrocadeVcsDeviceNetworksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBrocadeVcsDeviceNetworksIterWithContext is like ListBrocadeVcsDeviceNetworksIter, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) ListBrocadeVcsDeviceNetworksIterWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDeviceNetwork, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BrocadeVcsDeviceNetwork, int, error) {
+		l, err := s.ListBrocadeVcsDeviceNetworksWithContext(ctx, &ListBrocadeVcsDeviceNetworksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BrocadeVcsDeviceNetworks, l.Count, nil
+	}, opts...)
+}
+
 type ListBrocadeVcsDeviceNetworksResponse struct {
 	Count                    int                        `json:"count"`
 	BrocadeVcsDeviceNetworks []*BrocadeVcsDeviceNetwork `json:"brocadevcsdevicenetwork"`
@@ -466,28 +709,28 @@ type BrocadeVcsDeviceNetwork struct {
 	Aclid                       string                           `json:"aclid"`
 	Aclname                     string                           `json:"aclname"`
 	Acltype                     string                           `json:"acltype"`
-	Asnumber                    int64                            `json:"asnumber"`
+	Asnumber                    FlexInt64                        `json:"asnumber"`
 	Asnumberid                  string                           `json:"asnumberid"`
 	Associatednetwork           string                           `json:"associatednetwork"`
 	Associatednetworkid         string                           `json:"associatednetworkid"`
 	Bgppeers                    []interface{}                    `json:"bgppeers"`
 	Broadcastdomaintype         string                           `json:"broadcastdomaintype"`
 	Broadcasturi                string                           `json:"broadcasturi"`
-	Canusefordeploy             bool                             `json:"canusefordeploy"`
+	Canusefordeploy             FlexBool                         `json:"canusefordeploy"`
 	Cidr                        string                           `json:"cidr"`
-	Created                     string                           `json:"created"`
+	Created                     Time                             `json:"created"`
 	Details                     map[string]string                `json:"details"`
-	Displaynetwork              bool                             `json:"displaynetwork"`
+	Displaynetwork              FlexBool                         `json:"displaynetwork"`
 	Displaytext                 string                           `json:"displaytext"`
 	Dns1                        string                           `json:"dns1"`
 	Dns2                        string                           `json:"dns2"`
 	Domain                      string                           `json:"domain"`
 	Domainid                    string                           `json:"domainid"`
 	Domainpath                  string                           `json:"domainpath"`
-	Egressdefaultpolicy         bool                             `json:"egressdefaultpolicy"`
+	Egressdefaultpolicy         FlexBool                         `json:"egressdefaultpolicy"`
 	Externalid                  string                           `json:"externalid"`
 	Gateway                     string                           `json:"gateway"`
-	Hasannotations              bool                             `json:"hasannotations"`
+	Hasannotations              FlexBool                         `json:"hasannotations"`
 	Icon                        interface{}                      `json:"icon"`
 	Id                          string                           `json:"id"`
 	Internetprotocol            string                           `json:"internetprotocol"`
@@ -499,38 +742,38 @@ type BrocadeVcsDeviceNetwork struct {
 	Ip6gateway                  string                           `json:"ip6gateway"`
 	Ip6routes                   []interface{}                    `json:"ip6routes"`
 	Ip6routing                  string                           `json:"ip6routing"`
-	Isdefault                   bool                             `json:"isdefault"`
-	Ispersistent                bool                             `json:"ispersistent"`
-	Issystem                    bool                             `json:"issystem"`
+	Isdefault                   FlexBool                         `json:"isdefault"`
+	Ispersistent                FlexBool                         `json:"ispersistent"`
+	Issystem                    FlexBool                         `json:"issystem"`
 	JobID                       string                           `json:"jobid"`
-	Jobstatus                   int                              `json:"jobstatus"`
+	Jobstatus                   FlexInt                          `json:"jobstatus"`
 	Name                        string                           `json:"name"`
 	Netmask                     string                           `json:"netmask"`
 	Networkcidr                 string                           `json:"networkcidr"`
 	Networkdomain               string                           `json:"networkdomain"`
 	Networkofferingavailability string                           `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                             `json:"networkofferingconservemode"`
+	Networkofferingconservemode FlexBool                         `json:"networkofferingconservemode"`
 	Networkofferingdisplaytext  string                           `json:"networkofferingdisplaytext"`
 	Networkofferingid           string                           `json:"networkofferingid"`
 	Networkofferingname         string                           `json:"networkofferingname"`
 	Physicalnetworkid           string                           `json:"physicalnetworkid"`
-	Privatemtu                  int                              `json:"privatemtu"`
+	Privatemtu                  FlexInt                          `json:"privatemtu"`
 	Project                     string                           `json:"project"`
 	Projectid                   string                           `json:"projectid"`
-	Publicmtu                   int                              `json:"publicmtu"`
-	Receivedbytes               int64                            `json:"receivedbytes"`
-	Redundantrouter             bool                             `json:"redundantrouter"`
+	Publicmtu                   FlexInt                          `json:"publicmtu"`
+	Receivedbytes               FlexInt64                        `json:"receivedbytes"`
+	Redundantrouter             FlexBool                         `json:"redundantrouter"`
 	Related                     string                           `json:"related"`
 	Reservediprange             string                           `json:"reservediprange"`
-	Restartrequired             bool                             `json:"restartrequired"`
-	Sentbytes                   int64                            `json:"sentbytes"`
+	Restartrequired             FlexBool                         `json:"restartrequired"`
+	Sentbytes                   FlexInt64                        `json:"sentbytes"`
 	Service                     []BrocadeVcsDeviceNetworkService `json:"service"`
-	Specifyipranges             bool                             `json:"specifyipranges"`
-	Specifyvlan                 bool                             `json:"specifyvlan"`
+	Specifyipranges             FlexBool                         `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                         `json:"specifyvlan"`
 	State                       string                           `json:"state"`
-	Strechedl2subnet            bool                             `json:"strechedl2subnet"`
-	Subdomainaccess             bool                             `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                             `json:"supportsvmautoscaling"`
+	Strechedl2subnet            FlexBool                         `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                         `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                         `json:"supportsvmautoscaling"`
 	Tags                        []Tags                           `json:"tags"`
 	Traffictype                 string                           `json:"traffictype"`
 	Tungstenvirtualrouteruuid   string                           `json:"tungstenvirtualrouteruuid"`
@@ -541,28 +784,52 @@ type BrocadeVcsDeviceNetwork struct {
 	Zoneid                      string                           `json:"zoneid"`
 	Zonename                    string                           `json:"zonename"`
 	Zonesnetworkspans           []interface{}                    `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage       `json:"-"`
+}
+
+func (r *BrocadeVcsDeviceNetwork) UnmarshalJSON(b []byte) error {
+	type alias BrocadeVcsDeviceNetwork
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type BrocadeVcsDeviceNetworkService struct {
 	Capability []BrocadeVcsDeviceNetworkServiceCapability `json:"capability"`
 	Name       string                                     `json:"name"`
 	Provider   []BrocadeVcsDeviceNetworkServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                 `json:"-"`
+}
+
+func (r *BrocadeVcsDeviceNetworkService) UnmarshalJSON(b []byte) error {
+	type alias BrocadeVcsDeviceNetworkService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type BrocadeVcsDeviceNetworkServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BrocadeVcsDeviceNetworkServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias BrocadeVcsDeviceNetworkServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type BrocadeVcsDeviceNetworkServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BrocadeVcsDeviceNetworkServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias BrocadeVcsDeviceNetworkServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBrocadeVcsDevicesParams struct {
@@ -594,6 +861,47 @@ func (p *ListBrocadeVcsDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBrocadeVcsDevices API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBrocadeVcsDevicesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBrocadeVcsDevices", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBrocadeVcsDevices API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBrocadeVcsDevicesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "physicalnetworkid": 255, "vcsdeviceid": 255},
+	}.validate("listBrocadeVcsDevices", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBrocadeVcsDevices API, before the request is signed
+func (p *ListBrocadeVcsDevicesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBrocadeVcsDevicesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBrocadeVcsDevicesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBrocadeVcsDevices", b, &p.p, map[string]paramDecoder{
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+		"vcsdeviceid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBrocadeVcsDevicesParams) Clone() *ListBrocadeVcsDevicesParams {
+	return &ListBrocadeVcsDevicesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBrocadeVcsDevicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -709,30 +1017,73 @@ func (s *BrocadeVCSService) NewListBrocadeVcsDevicesParams() *ListBrocadeVcsDevi
 
 // Lists Brocade VCS Switches
 func (s *BrocadeVCSService) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listBrocadeVcsDevices", p.toURLValues())
+	return s.ListBrocadeVcsDevicesWithContext(context.Background(), p)
+}
+
+// ListBrocadeVcsDevicesWithContext is like ListBrocadeVcsDevices, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBrocadeVcsDevices", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBrocadeVcsDevicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBrocadeVcsDevices", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBrocadeVcsDevicesAll returns all BrocadeVcsDevices matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesAll(p *ListBrocadeVcsDevicesParams, opts ...PageOption) ([]*BrocadeVcsDevice, error) {
+	return s.ListBrocadeVcsDevicesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBrocadeVcsDevicesAllWithContext is like ListBrocadeVcsDevicesAll, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesAllWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams, opts ...PageOption) ([]*BrocadeVcsDevice, error) {
+	return collectPages(s.ListBrocadeVcsDevicesIterWithContext(ctx, p, opts...))
+}
+
+// ListBrocadeVcsDevicesIter returns an iterator over all BrocadeVcsDevices matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesIter(p *ListBrocadeVcsDevicesParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDevice, error] {
+	return s.ListBrocadeVcsDevicesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBrocadeVcsDevicesIterWithContext is like ListBrocadeVcsDevicesIter, but honours the cancellation and deadline of ctx
+func (s *BrocadeVCSService) ListBrocadeVcsDevicesIterWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDevice, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*BrocadeVcsDevice, int, error) {
+		l, err := s.ListBrocadeVcsDevicesWithContext(ctx, &ListBrocadeVcsDevicesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.BrocadeVcsDevices, l.Count, nil
+	}, opts...)
+}
+
 type ListBrocadeVcsDevicesResponse struct {
 	Count             int                 `json:"count"`
 	BrocadeVcsDevices []*BrocadeVcsDevice `json:"brocadevcsdevice"`
 }
 
 type BrocadeVcsDevice struct {
-	Brocadedevicename string `json:"brocadedevicename"`
-	Hostname          string `json:"hostname"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Provider          string `json:"provider"`
-	Vcsdeviceid       string `json:"vcsdeviceid"`
+	Brocadedevicename string                     `json:"brocadedevicename"`
+	Hostname          string                     `json:"hostname"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Provider          string                     `json:"provider"`
+	Vcsdeviceid       string                     `json:"vcsdeviceid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *BrocadeVcsDevice) UnmarshalJSON(b []byte) error {
+	type alias BrocadeVcsDevice
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
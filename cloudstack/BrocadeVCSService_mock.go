@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockBrocadeVCSServiceIfaceMockRecorder) AddBrocadeVcsDevice(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBrocadeVcsDevice", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).AddBrocadeVcsDevice), p)
 }
 
+// AddBrocadeVcsDeviceAsync mocks base method.
+func (m *MockBrocadeVCSServiceIface) AddBrocadeVcsDeviceAsync(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBrocadeVcsDeviceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBrocadeVcsDeviceAsync indicates an expected call of AddBrocadeVcsDeviceAsync.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) AddBrocadeVcsDeviceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBrocadeVcsDeviceAsync", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).AddBrocadeVcsDeviceAsync), ctx, p)
+}
+
+// AddBrocadeVcsDeviceWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) AddBrocadeVcsDeviceWithContext(ctx context.Context, p *AddBrocadeVcsDeviceParams) (*AddBrocadeVcsDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBrocadeVcsDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBrocadeVcsDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBrocadeVcsDeviceWithContext indicates an expected call of AddBrocadeVcsDeviceWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) AddBrocadeVcsDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBrocadeVcsDeviceWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).AddBrocadeVcsDeviceWithContext), ctx, p)
+}
+
 // DeleteBrocadeVcsDevice mocks base method.
 func (m *MockBrocadeVCSServiceIface) DeleteBrocadeVcsDevice(p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockBrocadeVCSServiceIfaceMockRecorder) DeleteBrocadeVcsDevice(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrocadeVcsDevice", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).DeleteBrocadeVcsDevice), p)
 }
 
+// DeleteBrocadeVcsDeviceAsync mocks base method.
+func (m *MockBrocadeVCSServiceIface) DeleteBrocadeVcsDeviceAsync(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBrocadeVcsDeviceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBrocadeVcsDeviceAsync indicates an expected call of DeleteBrocadeVcsDeviceAsync.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) DeleteBrocadeVcsDeviceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrocadeVcsDeviceAsync", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).DeleteBrocadeVcsDeviceAsync), ctx, p)
+}
+
+// DeleteBrocadeVcsDeviceWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) DeleteBrocadeVcsDeviceWithContext(ctx context.Context, p *DeleteBrocadeVcsDeviceParams) (*DeleteBrocadeVcsDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBrocadeVcsDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBrocadeVcsDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBrocadeVcsDeviceWithContext indicates an expected call of DeleteBrocadeVcsDeviceWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) DeleteBrocadeVcsDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBrocadeVcsDeviceWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).DeleteBrocadeVcsDeviceWithContext), ctx, p)
+}
+
 // GetBrocadeVcsDeviceNetworkID mocks base method.
 func (m *MockBrocadeVCSServiceIface) GetBrocadeVcsDeviceNetworkID(keyword, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +171,27 @@ func (mr *MockBrocadeVCSServiceIfaceMockRecorder) GetBrocadeVcsDeviceNetworkID(k
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrocadeVcsDeviceNetworkID", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).GetBrocadeVcsDeviceNetworkID), varargs...)
 }
 
+// GetBrocadeVcsDeviceNetworkIDWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) GetBrocadeVcsDeviceNetworkIDWithContext(ctx context.Context, keyword, vcsdeviceid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, vcsdeviceid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBrocadeVcsDeviceNetworkIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBrocadeVcsDeviceNetworkIDWithContext indicates an expected call of GetBrocadeVcsDeviceNetworkIDWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) GetBrocadeVcsDeviceNetworkIDWithContext(ctx, keyword, vcsdeviceid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, vcsdeviceid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBrocadeVcsDeviceNetworkIDWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).GetBrocadeVcsDeviceNetworkIDWithContext), varargs...)
+}
+
 // ListBrocadeVcsDeviceNetworks mocks base method.
 func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworks(p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +207,99 @@ func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworks(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworks", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworks), p)
 }
 
+// ListBrocadeVcsDeviceNetworksAll mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworksAll(p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) ([]*BrocadeVcsDeviceNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDeviceNetworksAll", varargs...)
+	ret0, _ := ret[0].([]*BrocadeVcsDeviceNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrocadeVcsDeviceNetworksAll indicates an expected call of ListBrocadeVcsDeviceNetworksAll.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworksAll", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworksAll), varargs...)
+}
+
+// ListBrocadeVcsDeviceNetworksAllWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworksAllWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) ([]*BrocadeVcsDeviceNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDeviceNetworksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BrocadeVcsDeviceNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrocadeVcsDeviceNetworksAllWithContext indicates an expected call of ListBrocadeVcsDeviceNetworksAllWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworksAllWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworksAllWithContext), varargs...)
+}
+
+// ListBrocadeVcsDeviceNetworksIter mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworksIter(p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDeviceNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDeviceNetworksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BrocadeVcsDeviceNetwork, error])
+	return ret0
+}
+
+// ListBrocadeVcsDeviceNetworksIter indicates an expected call of ListBrocadeVcsDeviceNetworksIter.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworksIter", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworksIter), varargs...)
+}
+
+// ListBrocadeVcsDeviceNetworksIterWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworksIterWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDeviceNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDeviceNetworksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BrocadeVcsDeviceNetwork, error])
+	return ret0
+}
+
+// ListBrocadeVcsDeviceNetworksIterWithContext indicates an expected call of ListBrocadeVcsDeviceNetworksIterWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworksIterWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworksIterWithContext), varargs...)
+}
+
+// ListBrocadeVcsDeviceNetworksWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDeviceNetworksWithContext(ctx context.Context, p *ListBrocadeVcsDeviceNetworksParams) (*ListBrocadeVcsDeviceNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDeviceNetworksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBrocadeVcsDeviceNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrocadeVcsDeviceNetworksWithContext indicates an expected call of ListBrocadeVcsDeviceNetworksWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDeviceNetworksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDeviceNetworksWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDeviceNetworksWithContext), ctx, p)
+}
+
 // ListBrocadeVcsDevices mocks base method.
 func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevices(p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +315,99 @@ func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevices(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevices", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevices), p)
 }
 
+// ListBrocadeVcsDevicesAll mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevicesAll(p *ListBrocadeVcsDevicesParams, opts ...PageOption) ([]*BrocadeVcsDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDevicesAll", varargs...)
+	ret0, _ := ret[0].([]*BrocadeVcsDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrocadeVcsDevicesAll indicates an expected call of ListBrocadeVcsDevicesAll.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevicesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevicesAll", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevicesAll), varargs...)
+}
+
+// ListBrocadeVcsDevicesAllWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevicesAllWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams, opts ...PageOption) ([]*BrocadeVcsDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDevicesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*BrocadeVcsDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrocadeVcsDevicesAllWithContext indicates an expected call of ListBrocadeVcsDevicesAllWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevicesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevicesAllWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevicesAllWithContext), varargs...)
+}
+
+// ListBrocadeVcsDevicesIter mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevicesIter(p *ListBrocadeVcsDevicesParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDevicesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BrocadeVcsDevice, error])
+	return ret0
+}
+
+// ListBrocadeVcsDevicesIter indicates an expected call of ListBrocadeVcsDevicesIter.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevicesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevicesIter", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevicesIter), varargs...)
+}
+
+// ListBrocadeVcsDevicesIterWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevicesIterWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams, opts ...PageOption) iter.Seq2[*BrocadeVcsDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDevicesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*BrocadeVcsDevice, error])
+	return ret0
+}
+
+// ListBrocadeVcsDevicesIterWithContext indicates an expected call of ListBrocadeVcsDevicesIterWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevicesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevicesIterWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevicesIterWithContext), varargs...)
+}
+
+// ListBrocadeVcsDevicesWithContext mocks base method.
+func (m *MockBrocadeVCSServiceIface) ListBrocadeVcsDevicesWithContext(ctx context.Context, p *ListBrocadeVcsDevicesParams) (*ListBrocadeVcsDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBrocadeVcsDevicesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBrocadeVcsDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBrocadeVcsDevicesWithContext indicates an expected call of ListBrocadeVcsDevicesWithContext.
+func (mr *MockBrocadeVCSServiceIfaceMockRecorder) ListBrocadeVcsDevicesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBrocadeVcsDevicesWithContext", reflect.TypeOf((*MockBrocadeVCSServiceIface)(nil).ListBrocadeVcsDevicesWithContext), ctx, p)
+}
+
 // NewAddBrocadeVcsDeviceParams mocks base method.
 func (m *MockBrocadeVCSServiceIface) NewAddBrocadeVcsDeviceParams(hostname, password, physicalnetworkid, username string) *AddBrocadeVcsDeviceParams {
 	m.ctrl.T.Helper()
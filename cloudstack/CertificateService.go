@@ -20,35 +20,55 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type CertificateServiceIface interface {
 	IssueCertificate(p *IssueCertificateParams) (*IssueCertificateResponse, error)
+	IssueCertificateWithContext(ctx context.Context, p *IssueCertificateParams) (*IssueCertificateResponse, error)
+	IssueCertificateAsync(ctx context.Context, p *IssueCertificateParams) (*Job, error)
 	NewIssueCertificateParams() *IssueCertificateParams
 	ListCAProviders(p *ListCAProvidersParams) (*ListCAProvidersResponse, error)
+	ListCAProvidersWithContext(ctx context.Context, p *ListCAProvidersParams) (*ListCAProvidersResponse, error)
 	NewListCAProvidersParams() *ListCAProvidersParams
 	ListCaCertificate(p *ListCaCertificateParams) (*ListCaCertificateResponse, error)
+	ListCaCertificateWithContext(ctx context.Context, p *ListCaCertificateParams) (*ListCaCertificateResponse, error)
 	NewListCaCertificateParams() *ListCaCertificateParams
 	ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error)
+	ListTemplateDirectDownloadCertificatesWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error)
+	ListTemplateDirectDownloadCertificatesAll(p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) ([]*TemplateDirectDownloadCertificate, error)
+	ListTemplateDirectDownloadCertificatesAllWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) ([]*TemplateDirectDownloadCertificate, error)
+	ListTemplateDirectDownloadCertificatesIter(p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) iter.Seq2[*TemplateDirectDownloadCertificate, error]
+	ListTemplateDirectDownloadCertificatesIterWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) iter.Seq2[*TemplateDirectDownloadCertificate, error]
 	NewListTemplateDirectDownloadCertificatesParams() *ListTemplateDirectDownloadCertificatesParams
 	GetTemplateDirectDownloadCertificateByID(id string, opts ...OptionFunc) (*TemplateDirectDownloadCertificate, int, error)
+	GetTemplateDirectDownloadCertificateByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplateDirectDownloadCertificate, int, error)
 	ProvisionCertificate(p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error)
+	ProvisionCertificateWithContext(ctx context.Context, p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error)
+	ProvisionCertificateAsync(ctx context.Context, p *ProvisionCertificateParams) (*Job, error)
 	NewProvisionCertificateParams(hostid string) *ProvisionCertificateParams
 	ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error)
+	ProvisionTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error)
 	NewProvisionTemplateDirectDownloadCertificateParams(hostid string, id string) *ProvisionTemplateDirectDownloadCertificateParams
 	RevokeCertificate(p *RevokeCertificateParams) (*RevokeCertificateResponse, error)
+	RevokeCertificateWithContext(ctx context.Context, p *RevokeCertificateParams) (*RevokeCertificateResponse, error)
+	RevokeCertificateAsync(ctx context.Context, p *RevokeCertificateParams) (*Job, error)
 	NewRevokeCertificateParams(serial string) *RevokeCertificateParams
 	RevokeTemplateDirectDownloadCertificate(p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error)
+	RevokeTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error)
 	NewRevokeTemplateDirectDownloadCertificateParams(zoneid string) *RevokeTemplateDirectDownloadCertificateParams
 	UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error)
+	UploadCustomCertificateAsync(ctx context.Context, p *UploadCustomCertificateParams) (*Job, error)
 	NewUploadCustomCertificateParams(certificate string, domainsuffix string) *UploadCustomCertificateParams
 	UploadTemplateDirectDownloadCertificate(p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error)
-	NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor string, name string, zoneid string) *UploadTemplateDirectDownloadCertificateParams
+	UploadTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error)
+	NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor HypervisorType, name string, zoneid string) *UploadTemplateDirectDownloadCertificateParams
 }
 
 type IssueCertificateParams struct {
@@ -79,6 +99,47 @@ func (p *IssueCertificateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the issueCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *IssueCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("issueCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the issueCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *IssueCertificateParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"csr": 255, "domain": 255, "ipaddress": 255, "provider": 255},
+	}.validate("issueCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the issueCertificate API, before the request is signed
+func (p *IssueCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *IssueCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *IssueCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("issueCertificate", b, &p.p, map[string]paramDecoder{
+		"csr":       decodeParam[string],
+		"domain":    decodeParam[string],
+		"duration":  decodeParam[int],
+		"ipaddress": decodeParam[string],
+		"provider":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *IssueCertificateParams) Clone() *IssueCertificateParams {
+	return &IssueCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *IssueCertificateParams) SetCsr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -194,32 +255,39 @@ func (s *CertificateService) NewIssueCertificateParams() *IssueCertificateParams
 
 // Issues a client certificate using configured or provided CA plugin
 func (s *CertificateService) IssueCertificate(p *IssueCertificateParams) (*IssueCertificateResponse, error) {
-	resp, err := s.cs.newPostRequest("issueCertificate", p.toURLValues())
+	return s.IssueCertificateWithContext(context.Background(), p)
+}
+
+// IssueCertificateWithContext is like IssueCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) IssueCertificateWithContext(ctx context.Context, p *IssueCertificateParams) (*IssueCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "issueCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r IssueCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("issueCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "issueCertificate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("issueCertificate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -227,12 +295,37 @@ func (s *CertificateService) IssueCertificate(p *IssueCertificateParams) (*Issue
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished IssueCertificate async job into r
+func (r *IssueCertificateResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// IssueCertificateAsync starts the async job of IssueCertificate without waiting for it to finish, and returns a handle to the job
+func (s *CertificateService) IssueCertificateAsync(ctx context.Context, p *IssueCertificateParams) (*Job, error) {
+	r, err := s.IssueCertificateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("issueCertificate", r.JobID), nil
+}
+
 type IssueCertificateResponse struct {
-	Cacertificates string `json:"cacertificates"`
-	Certificate    string `json:"certificate"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Privatekey     string `json:"privatekey"`
+	Cacertificates string                     `json:"cacertificates"`
+	Certificate    string                     `json:"certificate"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Privatekey     string                     `json:"privatekey"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *IssueCertificateResponse) UnmarshalJSON(b []byte) error {
+	type alias IssueCertificateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListCAProvidersParams struct {
@@ -250,6 +343,43 @@ func (p *ListCAProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listCAProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListCAProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listCAProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listCAProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListCAProvidersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"name": 255},
+	}.validate("listCAProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listCAProviders API, before the request is signed
+func (p *ListCAProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListCAProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListCAProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listCAProviders", b, &p.p, map[string]paramDecoder{
+		"name": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListCAProvidersParams) Clone() *ListCAProvidersParams {
+	return &ListCAProvidersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListCAProvidersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,13 +411,22 @@ func (s *CertificateService) NewListCAProvidersParams() *ListCAProvidersParams {
 
 // Lists available certificate authority providers in CloudStack
 func (s *CertificateService) ListCAProviders(p *ListCAProvidersParams) (*ListCAProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listCAProviders", p.toURLValues())
+	return s.ListCAProvidersWithContext(context.Background(), p)
+}
+
+// ListCAProvidersWithContext is like ListCAProviders, but honours the cancellation and deadline of ctx
+func (s *CertificateService) ListCAProvidersWithContext(ctx context.Context, p *ListCAProvidersParams) (*ListCAProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listCAProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCAProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listCAProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -300,10 +439,16 @@ type ListCAProvidersResponse struct {
 }
 
 type CAProvider struct {
-	Description string `json:"description"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
+	Description string                     `json:"description"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CAProvider) UnmarshalJSON(b []byte) error {
+	type alias CAProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListCaCertificateParams struct {
@@ -321,6 +466,43 @@ func (p *ListCaCertificateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listCaCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListCaCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listCaCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listCaCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListCaCertificateParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"provider": 255},
+	}.validate("listCaCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listCaCertificate API, before the request is signed
+func (p *ListCaCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListCaCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListCaCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listCaCertificate", b, &p.p, map[string]paramDecoder{
+		"provider": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListCaCertificateParams) Clone() *ListCaCertificateParams {
+	return &ListCaCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *ListCaCertificateParams) SetProvider(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -352,13 +534,22 @@ func (s *CertificateService) NewListCaCertificateParams() *ListCaCertificatePara
 
 // Lists the CA public certificate(s) as support by the configured/provided CA plugin
 func (s *CertificateService) ListCaCertificate(p *ListCaCertificateParams) (*ListCaCertificateResponse, error) {
-	resp, err := s.cs.newRequest("listCaCertificate", p.toURLValues())
+	return s.ListCaCertificateWithContext(context.Background(), p)
+}
+
+// ListCaCertificateWithContext is like ListCaCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) ListCaCertificateWithContext(ctx context.Context, p *ListCaCertificateParams) (*ListCaCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listCaCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCaCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listCaCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -370,11 +561,17 @@ type ListCaCertificateResponse struct {
 }
 
 type CaCertificate struct {
-	Cacertificates string `json:"cacertificates"`
-	Certificate    string `json:"certificate"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Privatekey     string `json:"privatekey"`
+	Cacertificates string                     `json:"cacertificates"`
+	Certificate    string                     `json:"certificate"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Privatekey     string                     `json:"privatekey"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CaCertificate) UnmarshalJSON(b []byte) error {
+	type alias CaCertificate
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListTemplateDirectDownloadCertificatesParams struct {
@@ -410,6 +607,48 @@ func (p *ListTemplateDirectDownloadCertificatesParams) toURLValues() url.Values
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listTemplateDirectDownloadCertificates API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListTemplateDirectDownloadCertificatesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listTemplateDirectDownloadCertificates", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listTemplateDirectDownloadCertificates API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListTemplateDirectDownloadCertificatesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "zoneid": 255},
+	}.validate("listTemplateDirectDownloadCertificates", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listTemplateDirectDownloadCertificates API, before the request is signed
+func (p *ListTemplateDirectDownloadCertificatesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListTemplateDirectDownloadCertificatesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListTemplateDirectDownloadCertificatesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listTemplateDirectDownloadCertificates", b, &p.p, map[string]paramDecoder{
+		"id":        decodeParam[string],
+		"keyword":   decodeParam[string],
+		"listhosts": decodeParam[bool],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"zoneid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListTemplateDirectDownloadCertificatesParams) Clone() *ListTemplateDirectDownloadCertificatesParams {
+	return &ListTemplateDirectDownloadCertificatesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListTemplateDirectDownloadCertificatesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -546,6 +785,11 @@ func (s *CertificateService) NewListTemplateDirectDownloadCertificatesParams() *
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *CertificateService) GetTemplateDirectDownloadCertificateByID(id string, opts ...OptionFunc) (*TemplateDirectDownloadCertificate, int, error) {
+	return s.GetTemplateDirectDownloadCertificateByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetTemplateDirectDownloadCertificateByIDWithContext is like GetTemplateDirectDownloadCertificateByID, but honours the cancellation and deadline of ctx
+func (s *CertificateService) GetTemplateDirectDownloadCertificateByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplateDirectDownloadCertificate, int, error) {
 	p := &ListTemplateDirectDownloadCertificatesParams{}
 	p.p = make(map[string]interface{})
 
@@ -557,11 +801,10 @@ func (s *CertificateService) GetTemplateDirectDownloadCertificateByID(id string,
 		}
 	}
 
-	l, err := s.ListTemplateDirectDownloadCertificates(p)
+	l, err := s.ListTemplateDirectDownloadCertificatesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -579,38 +822,81 @@ func (s *CertificateService) GetTemplateDirectDownloadCertificateByID(id string,
 
 // List the uploaded certificates for direct download Templates
 func (s *CertificateService) ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error) {
-	resp, err := s.cs.newRequest("listTemplateDirectDownloadCertificates", p.toURLValues())
+	return s.ListTemplateDirectDownloadCertificatesWithContext(context.Background(), p)
+}
+
+// ListTemplateDirectDownloadCertificatesWithContext is like ListTemplateDirectDownloadCertificates, but honours the cancellation and deadline of ctx
+func (s *CertificateService) ListTemplateDirectDownloadCertificatesWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listTemplateDirectDownloadCertificates", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTemplateDirectDownloadCertificatesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listTemplateDirectDownloadCertificates", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListTemplateDirectDownloadCertificatesAll returns all TemplateDirectDownloadCertificates matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *CertificateService) ListTemplateDirectDownloadCertificatesAll(p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) ([]*TemplateDirectDownloadCertificate, error) {
+	return s.ListTemplateDirectDownloadCertificatesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListTemplateDirectDownloadCertificatesAllWithContext is like ListTemplateDirectDownloadCertificatesAll, but honours the cancellation and deadline of ctx
+func (s *CertificateService) ListTemplateDirectDownloadCertificatesAllWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) ([]*TemplateDirectDownloadCertificate, error) {
+	return collectPages(s.ListTemplateDirectDownloadCertificatesIterWithContext(ctx, p, opts...))
+}
+
+// ListTemplateDirectDownloadCertificatesIter returns an iterator over all TemplateDirectDownloadCertificates matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *CertificateService) ListTemplateDirectDownloadCertificatesIter(p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) iter.Seq2[*TemplateDirectDownloadCertificate, error] {
+	return s.ListTemplateDirectDownloadCertificatesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListTemplateDirectDownloadCertificatesIterWithContext is like ListTemplateDirectDownloadCertificatesIter, but honours the cancellation and deadline of ctx
+func (s *CertificateService) ListTemplateDirectDownloadCertificatesIterWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) iter.Seq2[*TemplateDirectDownloadCertificate, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*TemplateDirectDownloadCertificate, int, error) {
+		l, err := s.ListTemplateDirectDownloadCertificatesWithContext(ctx, &ListTemplateDirectDownloadCertificatesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.TemplateDirectDownloadCertificates, l.Count, nil
+	}, opts...)
+}
+
 type ListTemplateDirectDownloadCertificatesResponse struct {
 	Count                              int                                  `json:"count"`
 	TemplateDirectDownloadCertificates []*TemplateDirectDownloadCertificate `json:"templatedirectdownloadcertificate"`
 }
 
 type TemplateDirectDownloadCertificate struct {
-	Alias      string   `json:"alias"`
-	Hostsmap   []string `json:"hostsmap"`
-	Hypervisor string   `json:"hypervisor"`
-	Id         string   `json:"id"`
-	Issuer     string   `json:"issuer"`
-	JobID      string   `json:"jobid"`
-	Jobstatus  int      `json:"jobstatus"`
-	Serialnum  string   `json:"serialnum"`
-	Subject    string   `json:"subject"`
-	Validity   string   `json:"validity"`
-	Version    string   `json:"version"`
-	Zoneid     string   `json:"zoneid"`
-	Zonename   string   `json:"zonename"`
+	Alias      string                     `json:"alias"`
+	Hostsmap   []string                   `json:"hostsmap"`
+	Hypervisor string                     `json:"hypervisor"`
+	Id         string                     `json:"id"`
+	Issuer     string                     `json:"issuer"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Serialnum  string                     `json:"serialnum"`
+	Subject    string                     `json:"subject"`
+	Validity   string                     `json:"validity"`
+	Version    string                     `json:"version"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *TemplateDirectDownloadCertificate) UnmarshalJSON(b []byte) error {
+	type alias TemplateDirectDownloadCertificate
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ProvisionCertificateParams struct {
@@ -635,6 +921,46 @@ func (p *ProvisionCertificateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the provisionCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ProvisionCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("provisionCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the provisionCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ProvisionCertificateParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255, "provider": 255},
+	}.validate("provisionCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the provisionCertificate API, before the request is signed
+func (p *ProvisionCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ProvisionCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ProvisionCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("provisionCertificate", b, &p.p, map[string]paramDecoder{
+		"hostid":    decodeParam[string],
+		"provider":  decodeParam[string],
+		"reconnect": decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ProvisionCertificateParams) Clone() *ProvisionCertificateParams {
+	return &ProvisionCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *ProvisionCertificateParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -709,27 +1035,39 @@ func (s *CertificateService) NewProvisionCertificateParams(hostid string) *Provi
 
 // Issues and propagates client certificate on a connected host/agent using configured CA plugin
 func (s *CertificateService) ProvisionCertificate(p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error) {
-	resp, err := s.cs.newPostRequest("provisionCertificate", p.toURLValues())
+	return s.ProvisionCertificateWithContext(context.Background(), p)
+}
+
+// ProvisionCertificateWithContext is like ProvisionCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) ProvisionCertificateWithContext(ctx context.Context, p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "provisionCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ProvisionCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("provisionCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "provisionCertificate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("provisionCertificate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -737,11 +1075,31 @@ func (s *CertificateService) ProvisionCertificate(p *ProvisionCertificateParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ProvisionCertificate async job into r
+func (r *ProvisionCertificateResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ProvisionCertificateAsync starts the async job of ProvisionCertificate without waiting for it to finish, and returns a handle to the job
+func (s *CertificateService) ProvisionCertificateAsync(ctx context.Context, p *ProvisionCertificateParams) (*Job, error) {
+	r, err := s.ProvisionCertificateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("provisionCertificate", r.JobID), nil
+}
+
 type ProvisionCertificateResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ProvisionCertificateResponse) UnmarshalJSON(b []byte) error {
+	type alias ProvisionCertificateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ProvisionTemplateDirectDownloadCertificateParams struct {
@@ -762,6 +1120,45 @@ func (p *ProvisionTemplateDirectDownloadCertificateParams) toURLValues() url.Val
 	return u
 }
 
+// ValidateAgainst checks the parameters against the provisionTemplateDirectDownloadCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ProvisionTemplateDirectDownloadCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("provisionTemplateDirectDownloadCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the provisionTemplateDirectDownloadCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ProvisionTemplateDirectDownloadCertificateParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid", "id"},
+		lengths:  map[string]int{"hostid": 255, "id": 255},
+	}.validate("provisionTemplateDirectDownloadCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the provisionTemplateDirectDownloadCertificate API, before the request is signed
+func (p *ProvisionTemplateDirectDownloadCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ProvisionTemplateDirectDownloadCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ProvisionTemplateDirectDownloadCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("provisionTemplateDirectDownloadCertificate", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ProvisionTemplateDirectDownloadCertificateParams) Clone() *ProvisionTemplateDirectDownloadCertificateParams {
+	return &ProvisionTemplateDirectDownloadCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *ProvisionTemplateDirectDownloadCertificateParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -816,13 +1213,22 @@ func (s *CertificateService) NewProvisionTemplateDirectDownloadCertificateParams
 
 // Provisions a host with a direct download certificate
 func (s *CertificateService) ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
-	resp, err := s.cs.newPostRequest("provisionTemplateDirectDownloadCertificate", p.toURLValues())
+	return s.ProvisionTemplateDirectDownloadCertificateWithContext(context.Background(), p)
+}
+
+// ProvisionTemplateDirectDownloadCertificateWithContext is like ProvisionTemplateDirectDownloadCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) ProvisionTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "provisionTemplateDirectDownloadCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ProvisionTemplateDirectDownloadCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("provisionTemplateDirectDownloadCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -830,12 +1236,18 @@ func (s *CertificateService) ProvisionTemplateDirectDownloadCertificate(p *Provi
 }
 
 type ProvisionTemplateDirectDownloadCertificateResponse struct {
-	Details   string `json:"details"`
-	Hostid    string `json:"hostid"`
-	Hostname  string `json:"hostname"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Status    string `json:"status"`
+	Details   string                     `json:"details"`
+	Hostid    string                     `json:"hostid"`
+	Hostname  string                     `json:"hostname"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Status    string                     `json:"status"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ProvisionTemplateDirectDownloadCertificateResponse) UnmarshalJSON(b []byte) error {
+	type alias ProvisionTemplateDirectDownloadCertificateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevokeCertificateParams struct {
@@ -859,6 +1271,46 @@ func (p *RevokeCertificateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the revokeCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RevokeCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("revokeCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the revokeCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RevokeCertificateParams) Validate() error {
+	return paramRules{
+		required: []string{"serial"},
+		lengths:  map[string]int{"cn": 255, "provider": 255, "serial": 255},
+	}.validate("revokeCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the revokeCertificate API, before the request is signed
+func (p *RevokeCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RevokeCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RevokeCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("revokeCertificate", b, &p.p, map[string]paramDecoder{
+		"cn":       decodeParam[string],
+		"provider": decodeParam[string],
+		"serial":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RevokeCertificateParams) Clone() *RevokeCertificateParams {
+	return &RevokeCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *RevokeCertificateParams) SetCn(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -933,27 +1385,39 @@ func (s *CertificateService) NewRevokeCertificateParams(serial string) *RevokeCe
 
 // Revokes certificate using configured CA plugin
 func (s *CertificateService) RevokeCertificate(p *RevokeCertificateParams) (*RevokeCertificateResponse, error) {
-	resp, err := s.cs.newPostRequest("revokeCertificate", p.toURLValues())
+	return s.RevokeCertificateWithContext(context.Background(), p)
+}
+
+// RevokeCertificateWithContext is like RevokeCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) RevokeCertificateWithContext(ctx context.Context, p *RevokeCertificateParams) (*RevokeCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "revokeCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RevokeCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("revokeCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "revokeCertificate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("revokeCertificate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -961,11 +1425,31 @@ func (s *CertificateService) RevokeCertificate(p *RevokeCertificateParams) (*Rev
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RevokeCertificate async job into r
+func (r *RevokeCertificateResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RevokeCertificateAsync starts the async job of RevokeCertificate without waiting for it to finish, and returns a handle to the job
+func (s *CertificateService) RevokeCertificateAsync(ctx context.Context, p *RevokeCertificateParams) (*Job, error) {
+	r, err := s.RevokeCertificateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("revokeCertificate", r.JobID), nil
+}
+
 type RevokeCertificateResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RevokeCertificateResponse) UnmarshalJSON(b []byte) error {
+	type alias RevokeCertificateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevokeTemplateDirectDownloadCertificateParams struct {
@@ -981,7 +1465,7 @@ func (p *RevokeTemplateDirectDownloadCertificateParams) toURLValues() url.Values
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -995,6 +1479,48 @@ func (p *RevokeTemplateDirectDownloadCertificateParams) toURLValues() url.Values
 	return u
 }
 
+// ValidateAgainst checks the parameters against the revokeTemplateDirectDownloadCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RevokeTemplateDirectDownloadCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("revokeTemplateDirectDownloadCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the revokeTemplateDirectDownloadCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RevokeTemplateDirectDownloadCertificateParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"hostid": 255, "hypervisor": 255, "id": 255, "name": 255, "zoneid": 255},
+	}.validate("revokeTemplateDirectDownloadCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the revokeTemplateDirectDownloadCertificate API, before the request is signed
+func (p *RevokeTemplateDirectDownloadCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RevokeTemplateDirectDownloadCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RevokeTemplateDirectDownloadCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("revokeTemplateDirectDownloadCertificate", b, &p.p, map[string]paramDecoder{
+		"hostid":     decodeParam[string],
+		"hypervisor": decodeParam[HypervisorType],
+		"id":         decodeParam[string],
+		"name":       decodeParam[string],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RevokeTemplateDirectDownloadCertificateParams) Clone() *RevokeTemplateDirectDownloadCertificateParams {
+	return &RevokeTemplateDirectDownloadCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *RevokeTemplateDirectDownloadCertificateParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1016,7 +1542,7 @@ func (p *RevokeTemplateDirectDownloadCertificateParams) GetHostid() (string, boo
 	return value, ok
 }
 
-func (p *RevokeTemplateDirectDownloadCertificateParams) SetHypervisor(v string) {
+func (p *RevokeTemplateDirectDownloadCertificateParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1029,11 +1555,11 @@ func (p *RevokeTemplateDirectDownloadCertificateParams) ResetHypervisor() {
 	}
 }
 
-func (p *RevokeTemplateDirectDownloadCertificateParams) GetHypervisor() (string, bool) {
+func (p *RevokeTemplateDirectDownloadCertificateParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -1111,13 +1637,22 @@ func (s *CertificateService) NewRevokeTemplateDirectDownloadCertificateParams(zo
 
 // Revoke a direct download certificate from hosts in a zone
 func (s *CertificateService) RevokeTemplateDirectDownloadCertificate(p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error) {
-	resp, err := s.cs.newPostRequest("revokeTemplateDirectDownloadCertificate", p.toURLValues())
+	return s.RevokeTemplateDirectDownloadCertificateWithContext(context.Background(), p)
+}
+
+// RevokeTemplateDirectDownloadCertificateWithContext is like RevokeTemplateDirectDownloadCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) RevokeTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "revokeTemplateDirectDownloadCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RevokeTemplateDirectDownloadCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("revokeTemplateDirectDownloadCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1125,12 +1660,18 @@ func (s *CertificateService) RevokeTemplateDirectDownloadCertificate(p *RevokeTe
 }
 
 type RevokeTemplateDirectDownloadCertificateResponse struct {
-	Details   string `json:"details"`
-	Hostid    string `json:"hostid"`
-	Hostname  string `json:"hostname"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Status    string `json:"status"`
+	Details   string                     `json:"details"`
+	Hostid    string                     `json:"hostid"`
+	Hostname  string                     `json:"hostname"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Status    string                     `json:"status"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RevokeTemplateDirectDownloadCertificateResponse) UnmarshalJSON(b []byte) error {
+	type alias RevokeTemplateDirectDownloadCertificateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UploadCustomCertificateParams struct {
@@ -1161,6 +1702,48 @@ func (p *UploadCustomCertificateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the uploadCustomCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UploadCustomCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("uploadCustomCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the uploadCustomCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UploadCustomCertificateParams) Validate() error {
+	return paramRules{
+		required: []string{"certificate", "domainsuffix"},
+		lengths:  map[string]int{"certificate": 65535, "domainsuffix": 255, "name": 255, "privatekey": 65535},
+	}.validate("uploadCustomCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the uploadCustomCertificate API, before the request is signed
+func (p *UploadCustomCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UploadCustomCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UploadCustomCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("uploadCustomCertificate", b, &p.p, map[string]paramDecoder{
+		"certificate":  decodeParam[string],
+		"domainsuffix": decodeParam[string],
+		"id":           decodeParam[int],
+		"name":         decodeParam[string],
+		"privatekey":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UploadCustomCertificateParams) Clone() *UploadCustomCertificateParams {
+	return &UploadCustomCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *UploadCustomCertificateParams) SetCertificate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1278,32 +1861,39 @@ func (s *CertificateService) NewUploadCustomCertificateParams(certificate string
 
 // Uploads a custom certificate for the console proxy VMs to use for SSL. Can be used to upload a single certificate signed by a known CA. Can also be used, through multiple calls, to upload a chain of certificates from CA to the custom certificate itself.
 func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
-	resp, err := s.cs.newPostRequest("uploadCustomCertificate", p.toURLValues())
+	return s.UploadCustomCertificateWithContext(context.Background(), p)
+}
+
+// UploadCustomCertificateWithContext is like UploadCustomCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "uploadCustomCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UploadCustomCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("uploadCustomCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "uploadCustomCertificate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("uploadCustomCertificate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1311,10 +1901,35 @@ func (s *CertificateService) UploadCustomCertificate(p *UploadCustomCertificateP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UploadCustomCertificate async job into r
+func (r *UploadCustomCertificateResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UploadCustomCertificateAsync starts the async job of UploadCustomCertificate without waiting for it to finish, and returns a handle to the job
+func (s *CertificateService) UploadCustomCertificateAsync(ctx context.Context, p *UploadCustomCertificateParams) (*Job, error) {
+	r, err := s.UploadCustomCertificateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("uploadCustomCertificate", r.JobID), nil
+}
+
 type UploadCustomCertificateResponse struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Message   string `json:"message"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Message   string                     `json:"message"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UploadCustomCertificateResponse) UnmarshalJSON(b []byte) error {
+	type alias UploadCustomCertificateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UploadTemplateDirectDownloadCertificateParams struct {
@@ -1333,7 +1948,7 @@ func (p *UploadTemplateDirectDownloadCertificateParams) toURLValues() url.Values
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["name"]; found {
 		u.Set("name", v.(string))
@@ -1344,6 +1959,48 @@ func (p *UploadTemplateDirectDownloadCertificateParams) toURLValues() url.Values
 	return u
 }
 
+// ValidateAgainst checks the parameters against the uploadTemplateDirectDownloadCertificate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UploadTemplateDirectDownloadCertificateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("uploadTemplateDirectDownloadCertificate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the uploadTemplateDirectDownloadCertificate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UploadTemplateDirectDownloadCertificateParams) Validate() error {
+	return paramRules{
+		required: []string{"certificate", "hypervisor", "name", "zoneid"},
+		lengths:  map[string]int{"certificate": 65535, "hostid": 255, "hypervisor": 255, "name": 255, "zoneid": 255},
+	}.validate("uploadTemplateDirectDownloadCertificate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the uploadTemplateDirectDownloadCertificate API, before the request is signed
+func (p *UploadTemplateDirectDownloadCertificateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UploadTemplateDirectDownloadCertificateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UploadTemplateDirectDownloadCertificateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("uploadTemplateDirectDownloadCertificate", b, &p.p, map[string]paramDecoder{
+		"certificate": decodeParam[string],
+		"hostid":      decodeParam[string],
+		"hypervisor":  decodeParam[HypervisorType],
+		"name":        decodeParam[string],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UploadTemplateDirectDownloadCertificateParams) Clone() *UploadTemplateDirectDownloadCertificateParams {
+	return &UploadTemplateDirectDownloadCertificateParams{p: cloneParams(p.p)}
+}
+
 func (p *UploadTemplateDirectDownloadCertificateParams) SetCertificate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1386,7 +2043,7 @@ func (p *UploadTemplateDirectDownloadCertificateParams) GetHostid() (string, boo
 	return value, ok
 }
 
-func (p *UploadTemplateDirectDownloadCertificateParams) SetHypervisor(v string) {
+func (p *UploadTemplateDirectDownloadCertificateParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1399,11 +2056,11 @@ func (p *UploadTemplateDirectDownloadCertificateParams) ResetHypervisor() {
 	}
 }
 
-func (p *UploadTemplateDirectDownloadCertificateParams) GetHypervisor() (string, bool) {
+func (p *UploadTemplateDirectDownloadCertificateParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -1451,7 +2108,7 @@ func (p *UploadTemplateDirectDownloadCertificateParams) GetZoneid() (string, boo
 
 // You should always use this function to get a new UploadTemplateDirectDownloadCertificateParams instance,
 // as then you are sure you have configured all required params
-func (s *CertificateService) NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor string, name string, zoneid string) *UploadTemplateDirectDownloadCertificateParams {
+func (s *CertificateService) NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor HypervisorType, name string, zoneid string) *UploadTemplateDirectDownloadCertificateParams {
 	p := &UploadTemplateDirectDownloadCertificateParams{}
 	p.p = make(map[string]interface{})
 	p.p["certificate"] = certificate
@@ -1463,13 +2120,22 @@ func (s *CertificateService) NewUploadTemplateDirectDownloadCertificateParams(ce
 
 // Upload a certificate for HTTPS direct Template download on KVM hosts
 func (s *CertificateService) UploadTemplateDirectDownloadCertificate(p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error) {
-	resp, err := s.cs.newPostRequest("uploadTemplateDirectDownloadCertificate", p.toURLValues())
+	return s.UploadTemplateDirectDownloadCertificateWithContext(context.Background(), p)
+}
+
+// UploadTemplateDirectDownloadCertificateWithContext is like UploadTemplateDirectDownloadCertificate, but honours the cancellation and deadline of ctx
+func (s *CertificateService) UploadTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "uploadTemplateDirectDownloadCertificate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UploadTemplateDirectDownloadCertificateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("uploadTemplateDirectDownloadCertificate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1477,17 +2143,23 @@ func (s *CertificateService) UploadTemplateDirectDownloadCertificate(p *UploadTe
 }
 
 type UploadTemplateDirectDownloadCertificateResponse struct {
-	Alias      string   `json:"alias"`
-	Hostsmap   []string `json:"hostsmap"`
-	Hypervisor string   `json:"hypervisor"`
-	Id         string   `json:"id"`
-	Issuer     string   `json:"issuer"`
-	JobID      string   `json:"jobid"`
-	Jobstatus  int      `json:"jobstatus"`
-	Serialnum  string   `json:"serialnum"`
-	Subject    string   `json:"subject"`
-	Validity   string   `json:"validity"`
-	Version    string   `json:"version"`
-	Zoneid     string   `json:"zoneid"`
-	Zonename   string   `json:"zonename"`
+	Alias      string                     `json:"alias"`
+	Hostsmap   []string                   `json:"hostsmap"`
+	Hypervisor string                     `json:"hypervisor"`
+	Id         string                     `json:"id"`
+	Issuer     string                     `json:"issuer"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Serialnum  string                     `json:"serialnum"`
+	Subject    string                     `json:"subject"`
+	Validity   string                     `json:"validity"`
+	Version    string                     `json:"version"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UploadTemplateDirectDownloadCertificateResponse) UnmarshalJSON(b []byte) error {
+	type alias UploadTemplateDirectDownloadCertificateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -79,6 +81,27 @@ func (mr *MockCertificateServiceIfaceMockRecorder) GetTemplateDirectDownloadCert
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateDirectDownloadCertificateByID", reflect.TypeOf((*MockCertificateServiceIface)(nil).GetTemplateDirectDownloadCertificateByID), varargs...)
 }
 
+// GetTemplateDirectDownloadCertificateByIDWithContext mocks base method.
+func (m *MockCertificateServiceIface) GetTemplateDirectDownloadCertificateByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplateDirectDownloadCertificate, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTemplateDirectDownloadCertificateByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*TemplateDirectDownloadCertificate)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTemplateDirectDownloadCertificateByIDWithContext indicates an expected call of GetTemplateDirectDownloadCertificateByIDWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) GetTemplateDirectDownloadCertificateByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateDirectDownloadCertificateByIDWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).GetTemplateDirectDownloadCertificateByIDWithContext), varargs...)
+}
+
 // IssueCertificate mocks base method.
 func (m *MockCertificateServiceIface) IssueCertificate(p *IssueCertificateParams) (*IssueCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +117,36 @@ func (mr *MockCertificateServiceIfaceMockRecorder) IssueCertificate(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).IssueCertificate), p)
 }
 
+// IssueCertificateAsync mocks base method.
+func (m *MockCertificateServiceIface) IssueCertificateAsync(ctx context.Context, p *IssueCertificateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueCertificateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueCertificateAsync indicates an expected call of IssueCertificateAsync.
+func (mr *MockCertificateServiceIfaceMockRecorder) IssueCertificateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueCertificateAsync", reflect.TypeOf((*MockCertificateServiceIface)(nil).IssueCertificateAsync), ctx, p)
+}
+
+// IssueCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) IssueCertificateWithContext(ctx context.Context, p *IssueCertificateParams) (*IssueCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*IssueCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueCertificateWithContext indicates an expected call of IssueCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) IssueCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).IssueCertificateWithContext), ctx, p)
+}
+
 // ListCAProviders mocks base method.
 func (m *MockCertificateServiceIface) ListCAProviders(p *ListCAProvidersParams) (*ListCAProvidersResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +162,21 @@ func (mr *MockCertificateServiceIfaceMockRecorder) ListCAProviders(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCAProviders", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListCAProviders), p)
 }
 
+// ListCAProvidersWithContext mocks base method.
+func (m *MockCertificateServiceIface) ListCAProvidersWithContext(ctx context.Context, p *ListCAProvidersParams) (*ListCAProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCAProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListCAProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCAProvidersWithContext indicates an expected call of ListCAProvidersWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) ListCAProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCAProvidersWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListCAProvidersWithContext), ctx, p)
+}
+
 // ListCaCertificate mocks base method.
 func (m *MockCertificateServiceIface) ListCaCertificate(p *ListCaCertificateParams) (*ListCaCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +192,21 @@ func (mr *MockCertificateServiceIfaceMockRecorder) ListCaCertificate(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCaCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListCaCertificate), p)
 }
 
+// ListCaCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) ListCaCertificateWithContext(ctx context.Context, p *ListCaCertificateParams) (*ListCaCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCaCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListCaCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCaCertificateWithContext indicates an expected call of ListCaCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) ListCaCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCaCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListCaCertificateWithContext), ctx, p)
+}
+
 // ListTemplateDirectDownloadCertificates mocks base method.
 func (m *MockCertificateServiceIface) ListTemplateDirectDownloadCertificates(p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +222,99 @@ func (mr *MockCertificateServiceIfaceMockRecorder) ListTemplateDirectDownloadCer
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificates", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListTemplateDirectDownloadCertificates), p)
 }
 
+// ListTemplateDirectDownloadCertificatesAll mocks base method.
+func (m *MockCertificateServiceIface) ListTemplateDirectDownloadCertificatesAll(p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) ([]*TemplateDirectDownloadCertificate, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplateDirectDownloadCertificatesAll", varargs...)
+	ret0, _ := ret[0].([]*TemplateDirectDownloadCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplateDirectDownloadCertificatesAll indicates an expected call of ListTemplateDirectDownloadCertificatesAll.
+func (mr *MockCertificateServiceIfaceMockRecorder) ListTemplateDirectDownloadCertificatesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificatesAll", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListTemplateDirectDownloadCertificatesAll), varargs...)
+}
+
+// ListTemplateDirectDownloadCertificatesAllWithContext mocks base method.
+func (m *MockCertificateServiceIface) ListTemplateDirectDownloadCertificatesAllWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) ([]*TemplateDirectDownloadCertificate, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplateDirectDownloadCertificatesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*TemplateDirectDownloadCertificate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplateDirectDownloadCertificatesAllWithContext indicates an expected call of ListTemplateDirectDownloadCertificatesAllWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) ListTemplateDirectDownloadCertificatesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificatesAllWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListTemplateDirectDownloadCertificatesAllWithContext), varargs...)
+}
+
+// ListTemplateDirectDownloadCertificatesIter mocks base method.
+func (m *MockCertificateServiceIface) ListTemplateDirectDownloadCertificatesIter(p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) iter.Seq2[*TemplateDirectDownloadCertificate, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplateDirectDownloadCertificatesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TemplateDirectDownloadCertificate, error])
+	return ret0
+}
+
+// ListTemplateDirectDownloadCertificatesIter indicates an expected call of ListTemplateDirectDownloadCertificatesIter.
+func (mr *MockCertificateServiceIfaceMockRecorder) ListTemplateDirectDownloadCertificatesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificatesIter", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListTemplateDirectDownloadCertificatesIter), varargs...)
+}
+
+// ListTemplateDirectDownloadCertificatesIterWithContext mocks base method.
+func (m *MockCertificateServiceIface) ListTemplateDirectDownloadCertificatesIterWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams, opts ...PageOption) iter.Seq2[*TemplateDirectDownloadCertificate, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplateDirectDownloadCertificatesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TemplateDirectDownloadCertificate, error])
+	return ret0
+}
+
+// ListTemplateDirectDownloadCertificatesIterWithContext indicates an expected call of ListTemplateDirectDownloadCertificatesIterWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) ListTemplateDirectDownloadCertificatesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificatesIterWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListTemplateDirectDownloadCertificatesIterWithContext), varargs...)
+}
+
+// ListTemplateDirectDownloadCertificatesWithContext mocks base method.
+func (m *MockCertificateServiceIface) ListTemplateDirectDownloadCertificatesWithContext(ctx context.Context, p *ListTemplateDirectDownloadCertificatesParams) (*ListTemplateDirectDownloadCertificatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplateDirectDownloadCertificatesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListTemplateDirectDownloadCertificatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplateDirectDownloadCertificatesWithContext indicates an expected call of ListTemplateDirectDownloadCertificatesWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) ListTemplateDirectDownloadCertificatesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplateDirectDownloadCertificatesWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).ListTemplateDirectDownloadCertificatesWithContext), ctx, p)
+}
+
 // NewIssueCertificateParams mocks base method.
 func (m *MockCertificateServiceIface) NewIssueCertificateParams() *IssueCertificateParams {
 	m.ctrl.T.Helper()
@@ -266,7 +442,7 @@ func (mr *MockCertificateServiceIfaceMockRecorder) NewUploadCustomCertificatePar
 }
 
 // NewUploadTemplateDirectDownloadCertificateParams mocks base method.
-func (m *MockCertificateServiceIface) NewUploadTemplateDirectDownloadCertificateParams(certificate, hypervisor, name, zoneid string) *UploadTemplateDirectDownloadCertificateParams {
+func (m *MockCertificateServiceIface) NewUploadTemplateDirectDownloadCertificateParams(certificate string, hypervisor HypervisorType, name, zoneid string) *UploadTemplateDirectDownloadCertificateParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewUploadTemplateDirectDownloadCertificateParams", certificate, hypervisor, name, zoneid)
 	ret0, _ := ret[0].(*UploadTemplateDirectDownloadCertificateParams)
@@ -294,6 +470,36 @@ func (mr *MockCertificateServiceIfaceMockRecorder) ProvisionCertificate(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).ProvisionCertificate), p)
 }
 
+// ProvisionCertificateAsync mocks base method.
+func (m *MockCertificateServiceIface) ProvisionCertificateAsync(ctx context.Context, p *ProvisionCertificateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionCertificateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionCertificateAsync indicates an expected call of ProvisionCertificateAsync.
+func (mr *MockCertificateServiceIfaceMockRecorder) ProvisionCertificateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionCertificateAsync", reflect.TypeOf((*MockCertificateServiceIface)(nil).ProvisionCertificateAsync), ctx, p)
+}
+
+// ProvisionCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) ProvisionCertificateWithContext(ctx context.Context, p *ProvisionCertificateParams) (*ProvisionCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*ProvisionCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionCertificateWithContext indicates an expected call of ProvisionCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) ProvisionCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).ProvisionCertificateWithContext), ctx, p)
+}
+
 // ProvisionTemplateDirectDownloadCertificate mocks base method.
 func (m *MockCertificateServiceIface) ProvisionTemplateDirectDownloadCertificate(p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -309,6 +515,21 @@ func (mr *MockCertificateServiceIfaceMockRecorder) ProvisionTemplateDirectDownlo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionTemplateDirectDownloadCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).ProvisionTemplateDirectDownloadCertificate), p)
 }
 
+// ProvisionTemplateDirectDownloadCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) ProvisionTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *ProvisionTemplateDirectDownloadCertificateParams) (*ProvisionTemplateDirectDownloadCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProvisionTemplateDirectDownloadCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*ProvisionTemplateDirectDownloadCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ProvisionTemplateDirectDownloadCertificateWithContext indicates an expected call of ProvisionTemplateDirectDownloadCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) ProvisionTemplateDirectDownloadCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProvisionTemplateDirectDownloadCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).ProvisionTemplateDirectDownloadCertificateWithContext), ctx, p)
+}
+
 // RevokeCertificate mocks base method.
 func (m *MockCertificateServiceIface) RevokeCertificate(p *RevokeCertificateParams) (*RevokeCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -324,6 +545,36 @@ func (mr *MockCertificateServiceIfaceMockRecorder) RevokeCertificate(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).RevokeCertificate), p)
 }
 
+// RevokeCertificateAsync mocks base method.
+func (m *MockCertificateServiceIface) RevokeCertificateAsync(ctx context.Context, p *RevokeCertificateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCertificateAsync indicates an expected call of RevokeCertificateAsync.
+func (mr *MockCertificateServiceIfaceMockRecorder) RevokeCertificateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificateAsync", reflect.TypeOf((*MockCertificateServiceIface)(nil).RevokeCertificateAsync), ctx, p)
+}
+
+// RevokeCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) RevokeCertificateWithContext(ctx context.Context, p *RevokeCertificateParams) (*RevokeCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*RevokeCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeCertificateWithContext indicates an expected call of RevokeCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) RevokeCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).RevokeCertificateWithContext), ctx, p)
+}
+
 // RevokeTemplateDirectDownloadCertificate mocks base method.
 func (m *MockCertificateServiceIface) RevokeTemplateDirectDownloadCertificate(p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -339,6 +590,21 @@ func (mr *MockCertificateServiceIfaceMockRecorder) RevokeTemplateDirectDownloadC
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTemplateDirectDownloadCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).RevokeTemplateDirectDownloadCertificate), p)
 }
 
+// RevokeTemplateDirectDownloadCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) RevokeTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *RevokeTemplateDirectDownloadCertificateParams) (*RevokeTemplateDirectDownloadCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeTemplateDirectDownloadCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*RevokeTemplateDirectDownloadCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeTemplateDirectDownloadCertificateWithContext indicates an expected call of RevokeTemplateDirectDownloadCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) RevokeTemplateDirectDownloadCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeTemplateDirectDownloadCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).RevokeTemplateDirectDownloadCertificateWithContext), ctx, p)
+}
+
 // UploadCustomCertificate mocks base method.
 func (m *MockCertificateServiceIface) UploadCustomCertificate(p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -354,6 +620,36 @@ func (mr *MockCertificateServiceIfaceMockRecorder) UploadCustomCertificate(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCustomCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadCustomCertificate), p)
 }
 
+// UploadCustomCertificateAsync mocks base method.
+func (m *MockCertificateServiceIface) UploadCustomCertificateAsync(ctx context.Context, p *UploadCustomCertificateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCustomCertificateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadCustomCertificateAsync indicates an expected call of UploadCustomCertificateAsync.
+func (mr *MockCertificateServiceIfaceMockRecorder) UploadCustomCertificateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCustomCertificateAsync", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadCustomCertificateAsync), ctx, p)
+}
+
+// UploadCustomCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) UploadCustomCertificateWithContext(ctx context.Context, p *UploadCustomCertificateParams) (*UploadCustomCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadCustomCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*UploadCustomCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadCustomCertificateWithContext indicates an expected call of UploadCustomCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) UploadCustomCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadCustomCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadCustomCertificateWithContext), ctx, p)
+}
+
 // UploadTemplateDirectDownloadCertificate mocks base method.
 func (m *MockCertificateServiceIface) UploadTemplateDirectDownloadCertificate(p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error) {
 	m.ctrl.T.Helper()
@@ -368,3 +664,18 @@ func (mr *MockCertificateServiceIfaceMockRecorder) UploadTemplateDirectDownloadC
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTemplateDirectDownloadCertificate", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadTemplateDirectDownloadCertificate), p)
 }
+
+// UploadTemplateDirectDownloadCertificateWithContext mocks base method.
+func (m *MockCertificateServiceIface) UploadTemplateDirectDownloadCertificateWithContext(ctx context.Context, p *UploadTemplateDirectDownloadCertificateParams) (*UploadTemplateDirectDownloadCertificateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadTemplateDirectDownloadCertificateWithContext", ctx, p)
+	ret0, _ := ret[0].(*UploadTemplateDirectDownloadCertificateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadTemplateDirectDownloadCertificateWithContext indicates an expected call of UploadTemplateDirectDownloadCertificateWithContext.
+func (mr *MockCertificateServiceIfaceMockRecorder) UploadTemplateDirectDownloadCertificateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadTemplateDirectDownloadCertificateWithContext", reflect.TypeOf((*MockCertificateServiceIface)(nil).UploadTemplateDirectDownloadCertificateWithContext), ctx, p)
+}
@@ -20,12 +20,14 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
 
 type CloudIdentifierServiceIface interface {
 	GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
+	GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error)
 	NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams
 }
 
@@ -44,6 +46,44 @@ func (p *GetCloudIdentifierParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getCloudIdentifier API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetCloudIdentifierParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getCloudIdentifier", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getCloudIdentifier API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetCloudIdentifierParams) Validate() error {
+	return paramRules{
+		required: []string{"userid"},
+		lengths:  map[string]int{"userid": 255},
+	}.validate("getCloudIdentifier", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getCloudIdentifier API, before the request is signed
+func (p *GetCloudIdentifierParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetCloudIdentifierParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetCloudIdentifierParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getCloudIdentifier", b, &p.p, map[string]paramDecoder{
+		"userid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetCloudIdentifierParams) Clone() *GetCloudIdentifierParams {
+	return &GetCloudIdentifierParams{p: cloneParams(p.p)}
+}
+
 func (p *GetCloudIdentifierParams) SetUserid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -76,7 +116,16 @@ func (s *CloudIdentifierService) NewGetCloudIdentifierParams(userid string) *Get
 
 // Retrieves a cloud identifier.
 func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
-	resp, err := s.cs.newRequest("getCloudIdentifier", p.toURLValues())
+	return s.GetCloudIdentifierWithContext(context.Background(), p)
+}
+
+// GetCloudIdentifierWithContext is like GetCloudIdentifier, but honours the cancellation and deadline of ctx
+func (s *CloudIdentifierService) GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getCloudIdentifier", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -84,7 +133,7 @@ func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams)
 	var nested struct {
 		Response GetCloudIdentifierResponse `json:"cloudidentifier"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("getCloudIdentifier", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -93,9 +142,15 @@ func (s *CloudIdentifierService) GetCloudIdentifier(p *GetCloudIdentifierParams)
 }
 
 type GetCloudIdentifierResponse struct {
-	Cloudidentifier string `json:"cloudidentifier"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Signature       string `json:"signature"`
-	Userid          string `json:"userid"`
+	Cloudidentifier string                     `json:"cloudidentifier"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Signature       string                     `json:"signature"`
+	Userid          string                     `json:"userid"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetCloudIdentifierResponse) UnmarshalJSON(b []byte) error {
+	type alias GetCloudIdentifierResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockCloudIdentifierServiceIfaceMockRecorder) GetCloudIdentifier(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudIdentifier", reflect.TypeOf((*MockCloudIdentifierServiceIface)(nil).GetCloudIdentifier), p)
 }
 
+// GetCloudIdentifierWithContext mocks base method.
+func (m *MockCloudIdentifierServiceIface) GetCloudIdentifierWithContext(ctx context.Context, p *GetCloudIdentifierParams) (*GetCloudIdentifierResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCloudIdentifierWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetCloudIdentifierResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCloudIdentifierWithContext indicates an expected call of GetCloudIdentifierWithContext.
+func (mr *MockCloudIdentifierServiceIfaceMockRecorder) GetCloudIdentifierWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudIdentifierWithContext", reflect.TypeOf((*MockCloudIdentifierServiceIface)(nil).GetCloudIdentifierWithContext), ctx, p)
+}
+
 // NewGetCloudIdentifierParams mocks base method.
 func (m *MockCloudIdentifierServiceIface) NewGetCloudIdentifierParams(userid string) *GetCloudIdentifierParams {
 	m.ctrl.T.Helper()
@@ -20,12 +20,14 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
 
 type CloudianServiceIface interface {
 	CloudianIsEnabled(p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error)
+	CloudianIsEnabledWithContext(ctx context.Context, p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error)
 	NewCloudianIsEnabledParams() *CloudianIsEnabledParams
 }
 
@@ -41,6 +43,39 @@ func (p *CloudianIsEnabledParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the cloudianIsEnabled API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CloudianIsEnabledParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("cloudianIsEnabled", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the cloudianIsEnabled API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CloudianIsEnabledParams) Validate() error {
+	return paramRules{}.validate("cloudianIsEnabled", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the cloudianIsEnabled API, before the request is signed
+func (p *CloudianIsEnabledParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CloudianIsEnabledParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CloudianIsEnabledParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("cloudianIsEnabled", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CloudianIsEnabledParams) Clone() *CloudianIsEnabledParams {
+	return &CloudianIsEnabledParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new CloudianIsEnabledParams instance,
 // as then you are sure you have configured all required params
 func (s *CloudianService) NewCloudianIsEnabledParams() *CloudianIsEnabledParams {
@@ -51,13 +86,22 @@ func (s *CloudianService) NewCloudianIsEnabledParams() *CloudianIsEnabledParams
 
 // Checks if the Cloudian Connector is enabled
 func (s *CloudianService) CloudianIsEnabled(p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error) {
-	resp, err := s.cs.newRequest("cloudianIsEnabled", p.toURLValues())
+	return s.CloudianIsEnabledWithContext(context.Background(), p)
+}
+
+// CloudianIsEnabledWithContext is like CloudianIsEnabled, but honours the cancellation and deadline of ctx
+func (s *CloudianService) CloudianIsEnabledWithContext(ctx context.Context, p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "cloudianIsEnabled", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CloudianIsEnabledResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("cloudianIsEnabled", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -65,8 +109,14 @@ func (s *CloudianService) CloudianIsEnabled(p *CloudianIsEnabledParams) (*Cloudi
 }
 
 type CloudianIsEnabledResponse struct {
-	Enabled   bool   `json:"enabled"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Url       string `json:"url"`
+	Enabled   FlexBool                   `json:"enabled"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Url       string                     `json:"url"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CloudianIsEnabledResponse) UnmarshalJSON(b []byte) error {
+	type alias CloudianIsEnabledResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockCloudianServiceIfaceMockRecorder) CloudianIsEnabled(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudianIsEnabled", reflect.TypeOf((*MockCloudianServiceIface)(nil).CloudianIsEnabled), p)
 }
 
+// CloudianIsEnabledWithContext mocks base method.
+func (m *MockCloudianServiceIface) CloudianIsEnabledWithContext(ctx context.Context, p *CloudianIsEnabledParams) (*CloudianIsEnabledResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CloudianIsEnabledWithContext", ctx, p)
+	ret0, _ := ret[0].(*CloudianIsEnabledResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CloudianIsEnabledWithContext indicates an expected call of CloudianIsEnabledWithContext.
+func (mr *MockCloudianServiceIfaceMockRecorder) CloudianIsEnabledWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CloudianIsEnabledWithContext", reflect.TypeOf((*MockCloudianServiceIface)(nil).CloudianIsEnabledWithContext), ctx, p)
+}
+
 // NewCloudianIsEnabledParams mocks base method.
 func (m *MockCloudianServiceIface) NewCloudianIsEnabledParams() *CloudianIsEnabledParams {
 	m.ctrl.T.Helper()
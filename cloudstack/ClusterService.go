@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,41 +31,86 @@ import (
 
 type ClusterServiceIface interface {
 	AddCluster(p *AddClusterParams) (*AddClusterResponse, error)
-	NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams
+	AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error)
+	NewAddClusterParams(clustername string, clustertype string, hypervisor HypervisorType, podid string, zoneid string) *AddClusterParams
 	DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error)
+	DedicateClusterAsync(ctx context.Context, p *DedicateClusterParams) (*Job, error)
 	NewDedicateClusterParams(clusterid string, domainid string) *DedicateClusterParams
 	DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error)
+	DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error)
 	NewDeleteClusterParams(id string) *DeleteClusterParams
 	DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error)
+	DisableOutOfBandManagementForClusterAsync(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*Job, error)
 	NewDisableOutOfBandManagementForClusterParams(clusterid string) *DisableOutOfBandManagementForClusterParams
 	EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error)
+	EnableOutOfBandManagementForClusterAsync(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*Job, error)
 	NewEnableOutOfBandManagementForClusterParams(clusterid string) *EnableOutOfBandManagementForClusterParams
 	EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error)
+	EnableHAForClusterWithContext(ctx context.Context, p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error)
+	EnableHAForClusterAsync(ctx context.Context, p *EnableHAForClusterParams) (*Job, error)
 	NewEnableHAForClusterParams(clusterid string) *EnableHAForClusterParams
 	ExecuteClusterDrsPlan(p *ExecuteClusterDrsPlanParams) (*ExecuteClusterDrsPlanResponse, error)
+	ExecuteClusterDrsPlanWithContext(ctx context.Context, p *ExecuteClusterDrsPlanParams) (*ExecuteClusterDrsPlanResponse, error)
+	ExecuteClusterDrsPlanAsync(ctx context.Context, p *ExecuteClusterDrsPlanParams) (*Job, error)
 	NewExecuteClusterDrsPlanParams(id string) *ExecuteClusterDrsPlanParams
 	GenerateClusterDrsPlan(p *GenerateClusterDrsPlanParams) (*GenerateClusterDrsPlanResponse, error)
+	GenerateClusterDrsPlanWithContext(ctx context.Context, p *GenerateClusterDrsPlanParams) (*GenerateClusterDrsPlanResponse, error)
 	NewGenerateClusterDrsPlanParams(id string) *GenerateClusterDrsPlanParams
 	DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error)
+	DisableHAForClusterWithContext(ctx context.Context, p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error)
+	DisableHAForClusterAsync(ctx context.Context, p *DisableHAForClusterParams) (*Job, error)
 	NewDisableHAForClusterParams(clusterid string) *DisableHAForClusterParams
 	ListClusters(p *ListClustersParams) (*ListClustersResponse, error)
+	ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error)
+	ListClustersAll(p *ListClustersParams, opts ...PageOption) ([]*Cluster, error)
+	ListClustersAllWithContext(ctx context.Context, p *ListClustersParams, opts ...PageOption) ([]*Cluster, error)
+	ListClustersIter(p *ListClustersParams, opts ...PageOption) iter.Seq2[*Cluster, error]
+	ListClustersIterWithContext(ctx context.Context, p *ListClustersParams, opts ...PageOption) iter.Seq2[*Cluster, error]
 	NewListClustersParams() *ListClustersParams
 	GetClusterID(name string, opts ...OptionFunc) (string, int, error)
+	GetClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Cluster, int, error)
 	GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error)
+	GetClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Cluster, int, error)
 	ListClusterDrsPlan(p *ListClusterDrsPlanParams) (*ListClusterDrsPlanResponse, error)
+	ListClusterDrsPlanWithContext(ctx context.Context, p *ListClusterDrsPlanParams) (*ListClusterDrsPlanResponse, error)
+	ListClusterDrsPlanAll(p *ListClusterDrsPlanParams, opts ...PageOption) ([]*ClusterDrsPlan, error)
+	ListClusterDrsPlanAllWithContext(ctx context.Context, p *ListClusterDrsPlanParams, opts ...PageOption) ([]*ClusterDrsPlan, error)
+	ListClusterDrsPlanIter(p *ListClusterDrsPlanParams, opts ...PageOption) iter.Seq2[*ClusterDrsPlan, error]
+	ListClusterDrsPlanIterWithContext(ctx context.Context, p *ListClusterDrsPlanParams, opts ...PageOption) iter.Seq2[*ClusterDrsPlan, error]
 	NewListClusterDrsPlanParams() *ListClusterDrsPlanParams
 	GetClusterDrsPlanByID(id string, opts ...OptionFunc) (*ClusterDrsPlan, int, error)
+	GetClusterDrsPlanByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClusterDrsPlan, int, error)
 	ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	ListClustersMetricsWithContext(ctx context.Context, p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error)
+	ListClustersMetricsAll(p *ListClustersMetricsParams, opts ...PageOption) ([]*ClustersMetric, error)
+	ListClustersMetricsAllWithContext(ctx context.Context, p *ListClustersMetricsParams, opts ...PageOption) ([]*ClustersMetric, error)
+	ListClustersMetricsIter(p *ListClustersMetricsParams, opts ...PageOption) iter.Seq2[*ClustersMetric, error]
+	ListClustersMetricsIterWithContext(ctx context.Context, p *ListClustersMetricsParams, opts ...PageOption) iter.Seq2[*ClustersMetric, error]
 	NewListClustersMetricsParams() *ListClustersMetricsParams
 	GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetClustersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetClustersMetricByName(name string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	GetClustersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ClustersMetric, int, error)
 	GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error)
+	GetClustersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClustersMetric, int, error)
 	ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error)
+	ListDedicatedClustersAll(p *ListDedicatedClustersParams, opts ...PageOption) ([]*DedicatedCluster, error)
+	ListDedicatedClustersAllWithContext(ctx context.Context, p *ListDedicatedClustersParams, opts ...PageOption) ([]*DedicatedCluster, error)
+	ListDedicatedClustersIter(p *ListDedicatedClustersParams, opts ...PageOption) iter.Seq2[*DedicatedCluster, error]
+	ListDedicatedClustersIterWithContext(ctx context.Context, p *ListDedicatedClustersParams, opts ...PageOption) iter.Seq2[*DedicatedCluster, error]
 	NewListDedicatedClustersParams() *ListDedicatedClustersParams
 	ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error)
+	ReleaseDedicatedClusterAsync(ctx context.Context, p *ReleaseDedicatedClusterParams) (*Job, error)
 	NewReleaseDedicatedClusterParams(clusterid string) *ReleaseDedicatedClusterParams
 	UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error)
+	UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error)
 	NewUpdateClusterParams(id string) *UpdateClusterParams
 }
 
@@ -105,7 +152,7 @@ func (p *AddClusterParams) toURLValues() url.Values {
 		u.Set("guestvswitchtype", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["ovm3cluster"]; found {
 		u.Set("ovm3cluster", v.(string))
@@ -153,6 +200,66 @@ func (p *AddClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"clustername", "clustertype", "hypervisor", "podid", "zoneid"},
+		lengths:  map[string]int{"allocationstate": 255, "arch": 255, "clustername": 255, "clustertype": 255, "extensionid": 255, "guestvswitchname": 255, "guestvswitchtype": 255, "hypervisor": 255, "ovm3cluster": 255, "ovm3pool": 255, "ovm3vip": 255, "password": 255, "podid": 255, "publicvswitchname": 255, "publicvswitchtype": 255, "url": 2048, "username": 255, "vsmipaddress": 255, "vsmpassword": 255, "vsmusername": 255, "zoneid": 255},
+	}.validate("addCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addCluster API, before the request is signed
+func (p *AddClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addCluster", b, &p.p, map[string]paramDecoder{
+		"allocationstate":     decodeParam[string],
+		"arch":                decodeParam[string],
+		"clustername":         decodeParam[string],
+		"clustertype":         decodeParam[string],
+		"extensionid":         decodeParam[string],
+		"externaldetails":     decodeParam[map[string]string],
+		"guestvswitchname":    decodeParam[string],
+		"guestvswitchtype":    decodeParam[string],
+		"hypervisor":          decodeParam[HypervisorType],
+		"ovm3cluster":         decodeParam[string],
+		"ovm3pool":            decodeParam[string],
+		"ovm3vip":             decodeParam[string],
+		"password":            decodeParam[string],
+		"podid":               decodeParam[string],
+		"publicvswitchname":   decodeParam[string],
+		"publicvswitchtype":   decodeParam[string],
+		"storageaccessgroups": decodeParam[[]string],
+		"url":                 decodeParam[string],
+		"username":            decodeParam[string],
+		"vsmipaddress":        decodeParam[string],
+		"vsmpassword":         decodeParam[string],
+		"vsmusername":         decodeParam[string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddClusterParams) Clone() *AddClusterParams {
+	return &AddClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *AddClusterParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -321,7 +428,7 @@ func (p *AddClusterParams) GetGuestvswitchtype() (string, bool) {
 	return value, ok
 }
 
-func (p *AddClusterParams) SetHypervisor(v string) {
+func (p *AddClusterParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -334,11 +441,11 @@ func (p *AddClusterParams) ResetHypervisor() {
 	}
 }
 
-func (p *AddClusterParams) GetHypervisor() (string, bool) {
+func (p *AddClusterParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -638,7 +745,7 @@ func (p *AddClusterParams) GetZoneid() (string, bool) {
 
 // You should always use this function to get a new AddClusterParams instance,
 // as then you are sure you have configured all required params
-func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor string, podid string, zoneid string) *AddClusterParams {
+func (s *ClusterService) NewAddClusterParams(clustername string, clustertype string, hypervisor HypervisorType, podid string, zoneid string) *AddClusterParams {
 	p := &AddClusterParams{}
 	p.p = make(map[string]interface{})
 	p.p["clustername"] = clustername
@@ -651,7 +758,16 @@ func (s *ClusterService) NewAddClusterParams(clustername string, clustertype str
 
 // Adds a new cluster
 func (s *ClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("addCluster", p.toURLValues())
+	return s.AddClusterWithContext(context.Background(), p)
+}
+
+// AddClusterWithContext is like AddCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -661,7 +777,7 @@ func (s *ClusterService) AddCluster(p *AddClusterParams) (*AddClusterResponse, e
 	}
 
 	var r AddClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -676,11 +792,11 @@ type AddClusterResponse struct {
 	Cpuovercommitratio      string                       `json:"cpuovercommitratio"`
 	Extensionid             string                       `json:"extensionid"`
 	Extensionname           string                       `json:"extensionname"`
-	Hasannotations          bool                         `json:"hasannotations"`
+	Hasannotations          FlexBool                     `json:"hasannotations"`
 	Hypervisortype          string                       `json:"hypervisortype"`
 	Id                      string                       `json:"id"`
 	JobID                   string                       `json:"jobid"`
-	Jobstatus               int                          `json:"jobstatus"`
+	Jobstatus               FlexInt                      `json:"jobstatus"`
 	Managedstate            string                       `json:"managedstate"`
 	Memoryovercommitratio   string                       `json:"memoryovercommitratio"`
 	Name                    string                       `json:"name"`
@@ -693,22 +809,34 @@ type AddClusterResponse struct {
 	Zoneid                  string                       `json:"zoneid"`
 	Zonename                string                       `json:"zonename"`
 	Zonestorageaccessgroups string                       `json:"zonestorageaccessgroups"`
+	Extra                   map[string]json.RawMessage   `json:"-"`
+}
+
+func (r *AddClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias AddClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddClusterResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddClusterResponseCapacity) UnmarshalJSON(b []byte) error {
+	type alias AddClusterResponseCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicateClusterParams struct {
@@ -732,6 +860,46 @@ func (p *DedicateClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the dedicateCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicateClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicateCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicateCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicateClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid", "domainid"},
+		lengths:  map[string]int{"account": 255, "clusterid": 255, "domainid": 255},
+	}.validate("dedicateCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicateCluster API, before the request is signed
+func (p *DedicateClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicateClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicateClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicateCluster", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"clusterid": decodeParam[string],
+		"domainid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicateClusterParams) Clone() *DedicateClusterParams {
+	return &DedicateClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *DedicateClusterParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -807,32 +975,39 @@ func (s *ClusterService) NewDedicateClusterParams(clusterid string, domainid str
 
 // Dedicate an existing cluster
 func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicateCluster", p.toURLValues())
+	return s.DedicateClusterWithContext(context.Background(), p)
+}
+
+// DedicateClusterWithContext is like DedicateCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicateCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicateClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicateCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "dedicateCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("dedicateCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -840,15 +1015,40 @@ func (s *ClusterService) DedicateCluster(p *DedicateClusterParams) (*DedicateClu
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DedicateCluster async job into r
+func (r *DedicateClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DedicateClusterAsync starts the async job of DedicateCluster without waiting for it to finish, and returns a handle to the job
+func (s *ClusterService) DedicateClusterAsync(ctx context.Context, p *DedicateClusterParams) (*Job, error) {
+	r, err := s.DedicateClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("dedicateCluster", r.JobID), nil
+}
+
 type DedicateClusterResponse struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Clusterid       string `json:"clusterid"`
-	Clustername     string `json:"clustername"`
-	Domainid        string `json:"domainid"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Clusterid       string                     `json:"clusterid"`
+	Clustername     string                     `json:"clustername"`
+	Domainid        string                     `json:"domainid"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicateClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicateClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteClusterParams struct {
@@ -866,6 +1066,44 @@ func (p *DeleteClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteCluster API, before the request is signed
+func (p *DeleteClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteCluster", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteClusterParams) Clone() *DeleteClusterParams {
+	return &DeleteClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -898,13 +1136,22 @@ func (s *ClusterService) NewDeleteClusterParams(id string) *DeleteClusterParams
 
 // Deletes a cluster.
 func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteCluster", p.toURLValues())
+	return s.DeleteClusterWithContext(context.Background(), p)
+}
+
+// DeleteClusterWithContext is like DeleteCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -912,10 +1159,11 @@ func (s *ClusterService) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterRe
 }
 
 type DeleteClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteClusterResponse) UnmarshalJSON(b []byte) error {
@@ -942,7 +1190,7 @@ func (r *DeleteClusterResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteClusterResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableOutOfBandManagementForClusterParams struct {
@@ -960,6 +1208,44 @@ func (p *DisableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableOutOfBandManagementForCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableOutOfBandManagementForClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableOutOfBandManagementForCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableOutOfBandManagementForCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableOutOfBandManagementForClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid"},
+		lengths:  map[string]int{"clusterid": 255},
+	}.validate("disableOutOfBandManagementForCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableOutOfBandManagementForCluster API, before the request is signed
+func (p *DisableOutOfBandManagementForClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableOutOfBandManagementForClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableOutOfBandManagementForClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableOutOfBandManagementForCluster", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableOutOfBandManagementForClusterParams) Clone() *DisableOutOfBandManagementForClusterParams {
+	return &DisableOutOfBandManagementForClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -992,32 +1278,39 @@ func (s *ClusterService) NewDisableOutOfBandManagementForClusterParams(clusterid
 
 // Disables out-of-band management for a cluster
 func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("disableOutOfBandManagementForCluster", p.toURLValues())
+	return s.DisableOutOfBandManagementForClusterWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForClusterWithContext is like DisableOutOfBandManagementForCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableOutOfBandManagementForCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableOutOfBandManagementForClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableOutOfBandManagementForCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableOutOfBandManagementForCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableOutOfBandManagementForCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1025,20 +1318,45 @@ func (s *ClusterService) DisableOutOfBandManagementForCluster(p *DisableOutOfBan
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableOutOfBandManagementForCluster async job into r
+func (r *DisableOutOfBandManagementForClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DisableOutOfBandManagementForClusterAsync starts the async job of DisableOutOfBandManagementForCluster without waiting for it to finish, and returns a handle to the job
+func (s *ClusterService) DisableOutOfBandManagementForClusterAsync(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*Job, error) {
+	r, err := s.DisableOutOfBandManagementForClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableOutOfBandManagementForCluster", r.JobID), nil
+}
+
 type DisableOutOfBandManagementForClusterResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableOutOfBandManagementForClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableOutOfBandManagementForClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableOutOfBandManagementForClusterParams struct {
@@ -1056,6 +1374,44 @@ func (p *EnableOutOfBandManagementForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableOutOfBandManagementForCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableOutOfBandManagementForClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableOutOfBandManagementForCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableOutOfBandManagementForCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableOutOfBandManagementForClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid"},
+		lengths:  map[string]int{"clusterid": 255},
+	}.validate("enableOutOfBandManagementForCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableOutOfBandManagementForCluster API, before the request is signed
+func (p *EnableOutOfBandManagementForClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableOutOfBandManagementForClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableOutOfBandManagementForClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableOutOfBandManagementForCluster", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableOutOfBandManagementForClusterParams) Clone() *EnableOutOfBandManagementForClusterParams {
+	return &EnableOutOfBandManagementForClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableOutOfBandManagementForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1088,32 +1444,39 @@ func (s *ClusterService) NewEnableOutOfBandManagementForClusterParams(clusterid
 
 // Enables out-of-band management for a cluster
 func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("enableOutOfBandManagementForCluster", p.toURLValues())
+	return s.EnableOutOfBandManagementForClusterWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForClusterWithContext is like EnableOutOfBandManagementForCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableOutOfBandManagementForCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableOutOfBandManagementForClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableOutOfBandManagementForCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableOutOfBandManagementForCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableOutOfBandManagementForCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1121,20 +1484,45 @@ func (s *ClusterService) EnableOutOfBandManagementForCluster(p *EnableOutOfBandM
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableOutOfBandManagementForCluster async job into r
+func (r *EnableOutOfBandManagementForClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// EnableOutOfBandManagementForClusterAsync starts the async job of EnableOutOfBandManagementForCluster without waiting for it to finish, and returns a handle to the job
+func (s *ClusterService) EnableOutOfBandManagementForClusterAsync(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*Job, error) {
+	r, err := s.EnableOutOfBandManagementForClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableOutOfBandManagementForCluster", r.JobID), nil
+}
+
 type EnableOutOfBandManagementForClusterResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableOutOfBandManagementForClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableOutOfBandManagementForClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableHAForClusterParams struct {
@@ -1152,6 +1540,44 @@ func (p *EnableHAForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableHAForCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableHAForClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableHAForCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableHAForCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableHAForClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid"},
+		lengths:  map[string]int{"clusterid": 255},
+	}.validate("enableHAForCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableHAForCluster API, before the request is signed
+func (p *EnableHAForClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableHAForClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableHAForClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableHAForCluster", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableHAForClusterParams) Clone() *EnableHAForClusterParams {
+	return &EnableHAForClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableHAForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1184,27 +1610,39 @@ func (s *ClusterService) NewEnableHAForClusterParams(clusterid string) *EnableHA
 
 // Enables HA cluster-wide
 func (s *ClusterService) EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("enableHAForCluster", p.toURLValues())
+	return s.EnableHAForClusterWithContext(context.Background(), p)
+}
+
+// EnableHAForClusterWithContext is like EnableHAForCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) EnableHAForClusterWithContext(ctx context.Context, p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableHAForCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableHAForClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableHAForCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableHAForCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableHAForCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1212,11 +1650,31 @@ func (s *ClusterService) EnableHAForCluster(p *EnableHAForClusterParams) (*Enabl
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableHAForCluster async job into r
+func (r *EnableHAForClusterResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// EnableHAForClusterAsync starts the async job of EnableHAForCluster without waiting for it to finish, and returns a handle to the job
+func (s *ClusterService) EnableHAForClusterAsync(ctx context.Context, p *EnableHAForClusterParams) (*Job, error) {
+	r, err := s.EnableHAForClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableHAForCluster", r.JobID), nil
+}
+
 type EnableHAForClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableHAForClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableHAForClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExecuteClusterDrsPlanParams struct {
@@ -1241,6 +1699,45 @@ func (p *ExecuteClusterDrsPlanParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the executeClusterDrsPlan API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExecuteClusterDrsPlanParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("executeClusterDrsPlan", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the executeClusterDrsPlan API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExecuteClusterDrsPlanParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("executeClusterDrsPlan", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the executeClusterDrsPlan API, before the request is signed
+func (p *ExecuteClusterDrsPlanParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExecuteClusterDrsPlanParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExecuteClusterDrsPlanParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("executeClusterDrsPlan", b, &p.p, map[string]paramDecoder{
+		"id":        decodeParam[string],
+		"migrateto": decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExecuteClusterDrsPlanParams) Clone() *ExecuteClusterDrsPlanParams {
+	return &ExecuteClusterDrsPlanParams{p: cloneParams(p.p)}
+}
+
 func (p *ExecuteClusterDrsPlanParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1294,32 +1791,39 @@ func (s *ClusterService) NewExecuteClusterDrsPlanParams(id string) *ExecuteClust
 
 // Execute DRS for a cluster. If there is another plan in progress for the same cluster, this command will fail.
 func (s *ClusterService) ExecuteClusterDrsPlan(p *ExecuteClusterDrsPlanParams) (*ExecuteClusterDrsPlanResponse, error) {
-	resp, err := s.cs.newPostRequest("executeClusterDrsPlan", p.toURLValues())
+	return s.ExecuteClusterDrsPlanWithContext(context.Background(), p)
+}
+
+// ExecuteClusterDrsPlanWithContext is like ExecuteClusterDrsPlan, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ExecuteClusterDrsPlanWithContext(ctx context.Context, p *ExecuteClusterDrsPlanParams) (*ExecuteClusterDrsPlanResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "executeClusterDrsPlan", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExecuteClusterDrsPlanResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("executeClusterDrsPlan", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "executeClusterDrsPlan", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("executeClusterDrsPlan", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1327,15 +1831,40 @@ func (s *ClusterService) ExecuteClusterDrsPlan(p *ExecuteClusterDrsPlanParams) (
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ExecuteClusterDrsPlan async job into r
+func (r *ExecuteClusterDrsPlanResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ExecuteClusterDrsPlanAsync starts the async job of ExecuteClusterDrsPlan without waiting for it to finish, and returns a handle to the job
+func (s *ClusterService) ExecuteClusterDrsPlanAsync(ctx context.Context, p *ExecuteClusterDrsPlanParams) (*Job, error) {
+	r, err := s.ExecuteClusterDrsPlanWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("executeClusterDrsPlan", r.JobID), nil
+}
+
 type ExecuteClusterDrsPlanResponse struct {
-	Clusterid  string   `json:"clusterid"`
-	Eventid    string   `json:"eventid"`
-	Id         string   `json:"id"`
-	JobID      string   `json:"jobid"`
-	Jobstatus  int      `json:"jobstatus"`
-	Migrations []string `json:"migrations"`
-	Status     string   `json:"status"`
-	Type       string   `json:"type"`
+	Clusterid  string                     `json:"clusterid"`
+	Eventid    string                     `json:"eventid"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Migrations []string                   `json:"migrations"`
+	Status     string                     `json:"status"`
+	Type       string                     `json:"type"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExecuteClusterDrsPlanResponse) UnmarshalJSON(b []byte) error {
+	type alias ExecuteClusterDrsPlanResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GenerateClusterDrsPlanParams struct {
@@ -1357,6 +1886,45 @@ func (p *GenerateClusterDrsPlanParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the generateClusterDrsPlan API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GenerateClusterDrsPlanParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("generateClusterDrsPlan", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the generateClusterDrsPlan API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GenerateClusterDrsPlanParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("generateClusterDrsPlan", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the generateClusterDrsPlan API, before the request is signed
+func (p *GenerateClusterDrsPlanParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GenerateClusterDrsPlanParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GenerateClusterDrsPlanParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("generateClusterDrsPlan", b, &p.p, map[string]paramDecoder{
+		"id":         decodeParam[string],
+		"migrations": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GenerateClusterDrsPlanParams) Clone() *GenerateClusterDrsPlanParams {
+	return &GenerateClusterDrsPlanParams{p: cloneParams(p.p)}
+}
+
 func (p *GenerateClusterDrsPlanParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1410,13 +1978,22 @@ func (s *ClusterService) NewGenerateClusterDrsPlanParams(id string) *GenerateClu
 
 // Generate DRS plan for a cluster
 func (s *ClusterService) GenerateClusterDrsPlan(p *GenerateClusterDrsPlanParams) (*GenerateClusterDrsPlanResponse, error) {
-	resp, err := s.cs.newPostRequest("generateClusterDrsPlan", p.toURLValues())
+	return s.GenerateClusterDrsPlanWithContext(context.Background(), p)
+}
+
+// GenerateClusterDrsPlanWithContext is like GenerateClusterDrsPlan, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GenerateClusterDrsPlanWithContext(ctx context.Context, p *GenerateClusterDrsPlanParams) (*GenerateClusterDrsPlanResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "generateClusterDrsPlan", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GenerateClusterDrsPlanResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("generateClusterDrsPlan", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1424,14 +2001,20 @@ func (s *ClusterService) GenerateClusterDrsPlan(p *GenerateClusterDrsPlanParams)
 }
 
 type GenerateClusterDrsPlanResponse struct {
-	Clusterid  string   `json:"clusterid"`
-	Eventid    string   `json:"eventid"`
-	Id         string   `json:"id"`
-	JobID      string   `json:"jobid"`
-	Jobstatus  int      `json:"jobstatus"`
-	Migrations []string `json:"migrations"`
-	Status     string   `json:"status"`
-	Type       string   `json:"type"`
+	Clusterid  string                     `json:"clusterid"`
+	Eventid    string                     `json:"eventid"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Migrations []string                   `json:"migrations"`
+	Status     string                     `json:"status"`
+	Type       string                     `json:"type"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GenerateClusterDrsPlanResponse) UnmarshalJSON(b []byte) error {
+	type alias GenerateClusterDrsPlanResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableHAForClusterParams struct {
@@ -1449,6 +2032,44 @@ func (p *DisableHAForClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableHAForCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableHAForClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableHAForCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableHAForCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableHAForClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid"},
+		lengths:  map[string]int{"clusterid": 255},
+	}.validate("disableHAForCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableHAForCluster API, before the request is signed
+func (p *DisableHAForClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableHAForClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableHAForClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableHAForCluster", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableHAForClusterParams) Clone() *DisableHAForClusterParams {
+	return &DisableHAForClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableHAForClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1481,27 +2102,39 @@ func (s *ClusterService) NewDisableHAForClusterParams(clusterid string) *Disable
 
 // Disables HA cluster-wide
 func (s *ClusterService) DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("disableHAForCluster", p.toURLValues())
+	return s.DisableHAForClusterWithContext(context.Background(), p)
+}
+
+// DisableHAForClusterWithContext is like DisableHAForCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) DisableHAForClusterWithContext(ctx context.Context, p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableHAForCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableHAForClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableHAForCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableHAForCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableHAForCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1509,11 +2142,31 @@ func (s *ClusterService) DisableHAForCluster(p *DisableHAForClusterParams) (*Dis
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableHAForCluster async job into r
+func (r *DisableHAForClusterResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DisableHAForClusterAsync starts the async job of DisableHAForCluster without waiting for it to finish, and returns a handle to the job
+func (s *ClusterService) DisableHAForClusterAsync(ctx context.Context, p *DisableHAForClusterParams) (*Job, error) {
+	r, err := s.DisableHAForClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableHAForCluster", r.JobID), nil
+}
+
 type DisableHAForClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableHAForClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableHAForClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListClustersParams struct {
@@ -1535,7 +2188,7 @@ func (p *ListClustersParams) toURLValues() url.Values {
 		u.Set("clustertype", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -1573,6 +2226,56 @@ func (p *ListClustersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listClusters API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListClustersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listClusters", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listClusters API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListClustersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"allocationstate": 255, "arch": 255, "clustertype": 255, "hypervisor": 255, "id": 255, "keyword": 255, "managedstate": 255, "name": 255, "podid": 255, "storageaccessgroup": 255, "zoneid": 255},
+	}.validate("listClusters", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listClusters API, before the request is signed
+func (p *ListClustersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListClustersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListClustersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listClusters", b, &p.p, map[string]paramDecoder{
+		"allocationstate":    decodeParam[string],
+		"arch":               decodeParam[string],
+		"clustertype":        decodeParam[string],
+		"hypervisor":         decodeParam[HypervisorType],
+		"id":                 decodeParam[string],
+		"keyword":            decodeParam[string],
+		"managedstate":       decodeParam[string],
+		"name":               decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"podid":              decodeParam[string],
+		"showcapacities":     decodeParam[bool],
+		"storageaccessgroup": decodeParam[string],
+		"zoneid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListClustersParams) Clone() *ListClustersParams {
+	return &ListClustersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListClustersParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1636,7 +2339,7 @@ func (p *ListClustersParams) GetClustertype() (string, bool) {
 	return value, ok
 }
 
-func (p *ListClustersParams) SetHypervisor(v string) {
+func (p *ListClustersParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1649,11 +2352,11 @@ func (p *ListClustersParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListClustersParams) GetHypervisor() (string, bool) {
+func (p *ListClustersParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -1877,6 +2580,11 @@ func (s *ClusterService) NewListClustersParams() *ListClustersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetClusterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetClusterIDWithContext is like GetClusterID, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GetClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListClustersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1888,7 +2596,7 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListClusters(p)
+	l, err := s.ListClustersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1913,12 +2621,17 @@ func (s *ClusterService) GetClusterID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error) {
-	id, count, err := s.GetClusterID(name, opts...)
+	return s.GetClusterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetClusterByNameWithContext is like GetClusterByName, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GetClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Cluster, int, error) {
+	id, count, err := s.GetClusterIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetClusterByID(id, opts...)
+	r, count, err := s.GetClusterByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1927,6 +2640,11 @@ func (s *ClusterService) GetClusterByName(name string, opts ...OptionFunc) (*Clu
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error) {
+	return s.GetClusterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetClusterByIDWithContext is like GetClusterByID, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GetClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Cluster, int, error) {
 	p := &ListClustersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1938,11 +2656,10 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 		}
 	}
 
-	l, err := s.ListClusters(p)
+	l, err := s.ListClustersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1960,65 +2677,114 @@ func (s *ClusterService) GetClusterByID(id string, opts ...OptionFunc) (*Cluster
 
 // Lists clusters.
 func (s *ClusterService) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
-	resp, err := s.cs.newRequest("listClusters", p.toURLValues())
+	return s.ListClustersWithContext(context.Background(), p)
+}
+
+// ListClustersWithContext is like ListClusters, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listClusters", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListClustersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listClusters", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListClustersAll returns all Clusters matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ClusterService) ListClustersAll(p *ListClustersParams, opts ...PageOption) ([]*Cluster, error) {
+	return s.ListClustersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListClustersAllWithContext is like ListClustersAll, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClustersAllWithContext(ctx context.Context, p *ListClustersParams, opts ...PageOption) ([]*Cluster, error) {
+	return collectPages(s.ListClustersIterWithContext(ctx, p, opts...))
+}
+
+// ListClustersIter returns an iterator over all Clusters matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ClusterService) ListClustersIter(p *ListClustersParams, opts ...PageOption) iter.Seq2[*Cluster, error] {
+	return s.ListClustersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListClustersIterWithContext is like ListClustersIter, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClustersIterWithContext(ctx context.Context, p *ListClustersParams, opts ...PageOption) iter.Seq2[*Cluster, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Cluster, int, error) {
+		l, err := s.ListClustersWithContext(ctx, &ListClustersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Clusters, l.Count, nil
+	}, opts...)
+}
+
 type ListClustersResponse struct {
 	Count    int        `json:"count"`
 	Clusters []*Cluster `json:"cluster"`
 }
 
 type Cluster struct {
-	Allocationstate         string            `json:"allocationstate"`
-	Arch                    string            `json:"arch"`
-	Capacity                []ClusterCapacity `json:"capacity"`
-	Clustertype             string            `json:"clustertype"`
-	Cpuovercommitratio      string            `json:"cpuovercommitratio"`
-	Extensionid             string            `json:"extensionid"`
-	Extensionname           string            `json:"extensionname"`
-	Hasannotations          bool              `json:"hasannotations"`
-	Hypervisortype          string            `json:"hypervisortype"`
-	Id                      string            `json:"id"`
-	JobID                   string            `json:"jobid"`
-	Jobstatus               int               `json:"jobstatus"`
-	Managedstate            string            `json:"managedstate"`
-	Memoryovercommitratio   string            `json:"memoryovercommitratio"`
-	Name                    string            `json:"name"`
-	Ovm3vip                 string            `json:"ovm3vip"`
-	Podid                   string            `json:"podid"`
-	Podname                 string            `json:"podname"`
-	Podstorageaccessgroups  string            `json:"podstorageaccessgroups"`
-	Resourcedetails         map[string]string `json:"resourcedetails"`
-	Storageaccessgroups     string            `json:"storageaccessgroups"`
-	Zoneid                  string            `json:"zoneid"`
-	Zonename                string            `json:"zonename"`
-	Zonestorageaccessgroups string            `json:"zonestorageaccessgroups"`
+	Allocationstate         string                     `json:"allocationstate"`
+	Arch                    string                     `json:"arch"`
+	Capacity                []ClusterCapacity          `json:"capacity"`
+	Clustertype             string                     `json:"clustertype"`
+	Cpuovercommitratio      string                     `json:"cpuovercommitratio"`
+	Extensionid             string                     `json:"extensionid"`
+	Extensionname           string                     `json:"extensionname"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Hypervisortype          string                     `json:"hypervisortype"`
+	Id                      string                     `json:"id"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Managedstate            string                     `json:"managedstate"`
+	Memoryovercommitratio   string                     `json:"memoryovercommitratio"`
+	Name                    string                     `json:"name"`
+	Ovm3vip                 string                     `json:"ovm3vip"`
+	Podid                   string                     `json:"podid"`
+	Podname                 string                     `json:"podname"`
+	Podstorageaccessgroups  string                     `json:"podstorageaccessgroups"`
+	Resourcedetails         map[string]string          `json:"resourcedetails"`
+	Storageaccessgroups     string                     `json:"storageaccessgroups"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Zonestorageaccessgroups string                     `json:"zonestorageaccessgroups"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Cluster) UnmarshalJSON(b []byte) error {
+	type alias Cluster
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ClusterCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ClusterCapacity) UnmarshalJSON(b []byte) error {
+	type alias ClusterCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListClusterDrsPlanParams struct {
@@ -2050,6 +2816,47 @@ func (p *ListClusterDrsPlanParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listClusterDrsPlan API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListClusterDrsPlanParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listClusterDrsPlan", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listClusterDrsPlan API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListClusterDrsPlanParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"clusterid": 255, "id": 255, "keyword": 255},
+	}.validate("listClusterDrsPlan", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listClusterDrsPlan API, before the request is signed
+func (p *ListClusterDrsPlanParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListClusterDrsPlanParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListClusterDrsPlanParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listClusterDrsPlan", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+		"id":        decodeParam[string],
+		"keyword":   decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListClusterDrsPlanParams) Clone() *ListClusterDrsPlanParams {
+	return &ListClusterDrsPlanParams{p: cloneParams(p.p)}
+}
+
 func (p *ListClusterDrsPlanParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2165,6 +2972,11 @@ func (s *ClusterService) NewListClusterDrsPlanParams() *ListClusterDrsPlanParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClusterDrsPlanByID(id string, opts ...OptionFunc) (*ClusterDrsPlan, int, error) {
+	return s.GetClusterDrsPlanByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetClusterDrsPlanByIDWithContext is like GetClusterDrsPlanByID, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GetClusterDrsPlanByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClusterDrsPlan, int, error) {
 	p := &ListClusterDrsPlanParams{}
 	p.p = make(map[string]interface{})
 
@@ -2176,11 +2988,10 @@ func (s *ClusterService) GetClusterDrsPlanByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListClusterDrsPlan(p)
+	l, err := s.ListClusterDrsPlanWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2198,33 +3009,76 @@ func (s *ClusterService) GetClusterDrsPlanByID(id string, opts ...OptionFunc) (*
 
 // List DRS plans for a clusters
 func (s *ClusterService) ListClusterDrsPlan(p *ListClusterDrsPlanParams) (*ListClusterDrsPlanResponse, error) {
-	resp, err := s.cs.newRequest("listClusterDrsPlan", p.toURLValues())
+	return s.ListClusterDrsPlanWithContext(context.Background(), p)
+}
+
+// ListClusterDrsPlanWithContext is like ListClusterDrsPlan, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClusterDrsPlanWithContext(ctx context.Context, p *ListClusterDrsPlanParams) (*ListClusterDrsPlanResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listClusterDrsPlan", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListClusterDrsPlanResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listClusterDrsPlan", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListClusterDrsPlanAll returns all ClusterDrsPlan matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ClusterService) ListClusterDrsPlanAll(p *ListClusterDrsPlanParams, opts ...PageOption) ([]*ClusterDrsPlan, error) {
+	return s.ListClusterDrsPlanAllWithContext(context.Background(), p, opts...)
+}
+
+// ListClusterDrsPlanAllWithContext is like ListClusterDrsPlanAll, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClusterDrsPlanAllWithContext(ctx context.Context, p *ListClusterDrsPlanParams, opts ...PageOption) ([]*ClusterDrsPlan, error) {
+	return collectPages(s.ListClusterDrsPlanIterWithContext(ctx, p, opts...))
+}
+
+// ListClusterDrsPlanIter returns an iterator over all ClusterDrsPlan matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ClusterService) ListClusterDrsPlanIter(p *ListClusterDrsPlanParams, opts ...PageOption) iter.Seq2[*ClusterDrsPlan, error] {
+	return s.ListClusterDrsPlanIterWithContext(context.Background(), p, opts...)
+}
+
+// ListClusterDrsPlanIterWithContext is like ListClusterDrsPlanIter, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClusterDrsPlanIterWithContext(ctx context.Context, p *ListClusterDrsPlanParams, opts ...PageOption) iter.Seq2[*ClusterDrsPlan, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ClusterDrsPlan, int, error) {
+		l, err := s.ListClusterDrsPlanWithContext(ctx, &ListClusterDrsPlanParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ClusterDrsPlan, l.Count, nil
+	}, opts...)
+}
+
 type ListClusterDrsPlanResponse struct {
 	Count          int               `json:"count"`
 	ClusterDrsPlan []*ClusterDrsPlan `json:"clusterdrsplan"`
 }
 
 type ClusterDrsPlan struct {
-	Clusterid  string   `json:"clusterid"`
-	Eventid    string   `json:"eventid"`
-	Id         string   `json:"id"`
-	JobID      string   `json:"jobid"`
-	Jobstatus  int      `json:"jobstatus"`
-	Migrations []string `json:"migrations"`
-	Status     string   `json:"status"`
-	Type       string   `json:"type"`
+	Clusterid  string                     `json:"clusterid"`
+	Eventid    string                     `json:"eventid"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Migrations []string                   `json:"migrations"`
+	Status     string                     `json:"status"`
+	Type       string                     `json:"type"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ClusterDrsPlan) UnmarshalJSON(b []byte) error {
+	type alias ClusterDrsPlan
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListClustersMetricsParams struct {
@@ -2246,7 +3100,7 @@ func (p *ListClustersMetricsParams) toURLValues() url.Values {
 		u.Set("clustertype", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -2284,6 +3138,56 @@ func (p *ListClustersMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listClustersMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListClustersMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listClustersMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listClustersMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListClustersMetricsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"allocationstate": 255, "arch": 255, "clustertype": 255, "hypervisor": 255, "id": 255, "keyword": 255, "managedstate": 255, "name": 255, "podid": 255, "storageaccessgroup": 255, "zoneid": 255},
+	}.validate("listClustersMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listClustersMetrics API, before the request is signed
+func (p *ListClustersMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListClustersMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListClustersMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listClustersMetrics", b, &p.p, map[string]paramDecoder{
+		"allocationstate":    decodeParam[string],
+		"arch":               decodeParam[string],
+		"clustertype":        decodeParam[string],
+		"hypervisor":         decodeParam[HypervisorType],
+		"id":                 decodeParam[string],
+		"keyword":            decodeParam[string],
+		"managedstate":       decodeParam[string],
+		"name":               decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"podid":              decodeParam[string],
+		"showcapacities":     decodeParam[bool],
+		"storageaccessgroup": decodeParam[string],
+		"zoneid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListClustersMetricsParams) Clone() *ListClustersMetricsParams {
+	return &ListClustersMetricsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListClustersMetricsParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2347,7 +3251,7 @@ func (p *ListClustersMetricsParams) GetClustertype() (string, bool) {
 	return value, ok
 }
 
-func (p *ListClustersMetricsParams) SetHypervisor(v string) {
+func (p *ListClustersMetricsParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -2360,11 +3264,11 @@ func (p *ListClustersMetricsParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListClustersMetricsParams) GetHypervisor() (string, bool) {
+func (p *ListClustersMetricsParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -2588,6 +3492,11 @@ func (s *ClusterService) NewListClustersMetricsParams() *ListClustersMetricsPara
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetClustersMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetClustersMetricIDWithContext is like GetClustersMetricID, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GetClustersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListClustersMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2599,7 +3508,7 @@ func (s *ClusterService) GetClustersMetricID(name string, opts ...OptionFunc) (s
 		}
 	}
 
-	l, err := s.ListClustersMetrics(p)
+	l, err := s.ListClustersMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2624,12 +3533,17 @@ func (s *ClusterService) GetClustersMetricID(name string, opts ...OptionFunc) (s
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricByName(name string, opts ...OptionFunc) (*ClustersMetric, int, error) {
-	id, count, err := s.GetClustersMetricID(name, opts...)
+	return s.GetClustersMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetClustersMetricByNameWithContext is like GetClustersMetricByName, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GetClustersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ClustersMetric, int, error) {
+	id, count, err := s.GetClustersMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetClustersMetricByID(id, opts...)
+	r, count, err := s.GetClustersMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2638,6 +3552,11 @@ func (s *ClusterService) GetClustersMetricByName(name string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error) {
+	return s.GetClustersMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetClustersMetricByIDWithContext is like GetClustersMetricByID, but honours the cancellation and deadline of ctx
+func (s *ClusterService) GetClustersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClustersMetric, int, error) {
 	p := &ListClustersMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2649,11 +3568,10 @@ func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListClustersMetrics(p)
+	l, err := s.ListClustersMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2671,84 +3589,133 @@ func (s *ClusterService) GetClustersMetricByID(id string, opts ...OptionFunc) (*
 
 // Lists clusters metrics
 func (s *ClusterService) ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listClustersMetrics", p.toURLValues())
+	return s.ListClustersMetricsWithContext(context.Background(), p)
+}
+
+// ListClustersMetricsWithContext is like ListClustersMetrics, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClustersMetricsWithContext(ctx context.Context, p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listClustersMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListClustersMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listClustersMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListClustersMetricsAll returns all ClustersMetrics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ClusterService) ListClustersMetricsAll(p *ListClustersMetricsParams, opts ...PageOption) ([]*ClustersMetric, error) {
+	return s.ListClustersMetricsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListClustersMetricsAllWithContext is like ListClustersMetricsAll, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClustersMetricsAllWithContext(ctx context.Context, p *ListClustersMetricsParams, opts ...PageOption) ([]*ClustersMetric, error) {
+	return collectPages(s.ListClustersMetricsIterWithContext(ctx, p, opts...))
+}
+
+// ListClustersMetricsIter returns an iterator over all ClustersMetrics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ClusterService) ListClustersMetricsIter(p *ListClustersMetricsParams, opts ...PageOption) iter.Seq2[*ClustersMetric, error] {
+	return s.ListClustersMetricsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListClustersMetricsIterWithContext is like ListClustersMetricsIter, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListClustersMetricsIterWithContext(ctx context.Context, p *ListClustersMetricsParams, opts ...PageOption) iter.Seq2[*ClustersMetric, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ClustersMetric, int, error) {
+		l, err := s.ListClustersMetricsWithContext(ctx, &ListClustersMetricsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ClustersMetrics, l.Count, nil
+	}, opts...)
+}
+
 type ListClustersMetricsResponse struct {
 	Count           int               `json:"count"`
 	ClustersMetrics []*ClustersMetric `json:"cluster"`
 }
 
 type ClustersMetric struct {
-	Allocationstate                 string                   `json:"allocationstate"`
-	Arch                            string                   `json:"arch"`
-	Capacity                        []ClustersMetricCapacity `json:"capacity"`
-	Clustertype                     string                   `json:"clustertype"`
-	Cpuallocated                    string                   `json:"cpuallocated"`
-	Cpuallocateddisablethreshold    bool                     `json:"cpuallocateddisablethreshold"`
-	Cpuallocatedthreshold           bool                     `json:"cpuallocatedthreshold"`
-	Cpudisablethreshold             bool                     `json:"cpudisablethreshold"`
-	Cpumaxdeviation                 string                   `json:"cpumaxdeviation"`
-	Cpuovercommitratio              string                   `json:"cpuovercommitratio"`
-	Cputhreshold                    bool                     `json:"cputhreshold"`
-	Cputotal                        string                   `json:"cputotal"`
-	Cpuused                         string                   `json:"cpuused"`
-	Drsimbalance                    string                   `json:"drsimbalance"`
-	Extensionid                     string                   `json:"extensionid"`
-	Extensionname                   string                   `json:"extensionname"`
-	Hasannotations                  bool                     `json:"hasannotations"`
-	Hosts                           string                   `json:"hosts"`
-	Hypervisortype                  string                   `json:"hypervisortype"`
-	Id                              string                   `json:"id"`
-	JobID                           string                   `json:"jobid"`
-	Jobstatus                       int                      `json:"jobstatus"`
-	Managedstate                    string                   `json:"managedstate"`
-	Memoryallocated                 string                   `json:"memoryallocated"`
-	Memoryallocateddisablethreshold bool                     `json:"memoryallocateddisablethreshold"`
-	Memoryallocatedthreshold        bool                     `json:"memoryallocatedthreshold"`
-	Memorydisablethreshold          bool                     `json:"memorydisablethreshold"`
-	Memorymaxdeviation              string                   `json:"memorymaxdeviation"`
-	Memoryovercommitratio           string                   `json:"memoryovercommitratio"`
-	Memorythreshold                 bool                     `json:"memorythreshold"`
-	Memorytotal                     string                   `json:"memorytotal"`
-	Memoryused                      string                   `json:"memoryused"`
-	Name                            string                   `json:"name"`
-	Ovm3vip                         string                   `json:"ovm3vip"`
-	Podid                           string                   `json:"podid"`
-	Podname                         string                   `json:"podname"`
-	Podstorageaccessgroups          string                   `json:"podstorageaccessgroups"`
-	Resourcedetails                 map[string]string        `json:"resourcedetails"`
-	State                           string                   `json:"state"`
-	Storageaccessgroups             string                   `json:"storageaccessgroups"`
-	Zoneid                          string                   `json:"zoneid"`
-	Zonename                        string                   `json:"zonename"`
-	Zonestorageaccessgroups         string                   `json:"zonestorageaccessgroups"`
+	Allocationstate                 string                     `json:"allocationstate"`
+	Arch                            string                     `json:"arch"`
+	Capacity                        []ClustersMetricCapacity   `json:"capacity"`
+	Clustertype                     string                     `json:"clustertype"`
+	Cpuallocated                    string                     `json:"cpuallocated"`
+	Cpuallocateddisablethreshold    FlexBool                   `json:"cpuallocateddisablethreshold"`
+	Cpuallocatedthreshold           FlexBool                   `json:"cpuallocatedthreshold"`
+	Cpudisablethreshold             FlexBool                   `json:"cpudisablethreshold"`
+	Cpumaxdeviation                 string                     `json:"cpumaxdeviation"`
+	Cpuovercommitratio              string                     `json:"cpuovercommitratio"`
+	Cputhreshold                    FlexBool                   `json:"cputhreshold"`
+	Cputotal                        string                     `json:"cputotal"`
+	Cpuused                         string                     `json:"cpuused"`
+	Drsimbalance                    string                     `json:"drsimbalance"`
+	Extensionid                     string                     `json:"extensionid"`
+	Extensionname                   string                     `json:"extensionname"`
+	Hasannotations                  FlexBool                   `json:"hasannotations"`
+	Hosts                           string                     `json:"hosts"`
+	Hypervisortype                  string                     `json:"hypervisortype"`
+	Id                              string                     `json:"id"`
+	JobID                           string                     `json:"jobid"`
+	Jobstatus                       FlexInt                    `json:"jobstatus"`
+	Managedstate                    string                     `json:"managedstate"`
+	Memoryallocated                 string                     `json:"memoryallocated"`
+	Memoryallocateddisablethreshold FlexBool                   `json:"memoryallocateddisablethreshold"`
+	Memoryallocatedthreshold        FlexBool                   `json:"memoryallocatedthreshold"`
+	Memorydisablethreshold          FlexBool                   `json:"memorydisablethreshold"`
+	Memorymaxdeviation              string                     `json:"memorymaxdeviation"`
+	Memoryovercommitratio           string                     `json:"memoryovercommitratio"`
+	Memorythreshold                 FlexBool                   `json:"memorythreshold"`
+	Memorytotal                     string                     `json:"memorytotal"`
+	Memoryused                      string                     `json:"memoryused"`
+	Name                            string                     `json:"name"`
+	Ovm3vip                         string                     `json:"ovm3vip"`
+	Podid                           string                     `json:"podid"`
+	Podname                         string                     `json:"podname"`
+	Podstorageaccessgroups          string                     `json:"podstorageaccessgroups"`
+	Resourcedetails                 map[string]string          `json:"resourcedetails"`
+	State                           string                     `json:"state"`
+	Storageaccessgroups             string                     `json:"storageaccessgroups"`
+	Zoneid                          string                     `json:"zoneid"`
+	Zonename                        string                     `json:"zonename"`
+	Zonestorageaccessgroups         string                     `json:"zonestorageaccessgroups"`
+	Extra                           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ClustersMetric) UnmarshalJSON(b []byte) error {
+	type alias ClustersMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ClustersMetricCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ClustersMetricCapacity) UnmarshalJSON(b []byte) error {
+	type alias ClustersMetricCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDedicatedClustersParams struct {
@@ -2786,6 +3753,49 @@ func (p *ListDedicatedClustersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDedicatedClusters API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDedicatedClustersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDedicatedClusters", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDedicatedClusters API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDedicatedClustersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "affinitygroupid": 255, "clusterid": 255, "domainid": 255, "keyword": 255},
+	}.validate("listDedicatedClusters", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDedicatedClusters API, before the request is signed
+func (p *ListDedicatedClustersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDedicatedClustersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDedicatedClustersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDedicatedClusters", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"affinitygroupid": decodeParam[string],
+		"clusterid":       decodeParam[string],
+		"domainid":        decodeParam[string],
+		"keyword":         decodeParam[string],
+		"page":            decodeParam[int],
+		"pagesize":        decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDedicatedClustersParams) Clone() *ListDedicatedClustersParams {
+	return &ListDedicatedClustersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDedicatedClustersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2943,33 +3953,76 @@ func (s *ClusterService) NewListDedicatedClustersParams() *ListDedicatedClusters
 
 // Lists dedicated clusters.
 func (s *ClusterService) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedClusters", p.toURLValues())
+	return s.ListDedicatedClustersWithContext(context.Background(), p)
+}
+
+// ListDedicatedClustersWithContext is like ListDedicatedClusters, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDedicatedClusters", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDedicatedClustersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDedicatedClusters", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDedicatedClustersAll returns all DedicatedClusters matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ClusterService) ListDedicatedClustersAll(p *ListDedicatedClustersParams, opts ...PageOption) ([]*DedicatedCluster, error) {
+	return s.ListDedicatedClustersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedClustersAllWithContext is like ListDedicatedClustersAll, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListDedicatedClustersAllWithContext(ctx context.Context, p *ListDedicatedClustersParams, opts ...PageOption) ([]*DedicatedCluster, error) {
+	return collectPages(s.ListDedicatedClustersIterWithContext(ctx, p, opts...))
+}
+
+// ListDedicatedClustersIter returns an iterator over all DedicatedClusters matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ClusterService) ListDedicatedClustersIter(p *ListDedicatedClustersParams, opts ...PageOption) iter.Seq2[*DedicatedCluster, error] {
+	return s.ListDedicatedClustersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedClustersIterWithContext is like ListDedicatedClustersIter, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ListDedicatedClustersIterWithContext(ctx context.Context, p *ListDedicatedClustersParams, opts ...PageOption) iter.Seq2[*DedicatedCluster, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DedicatedCluster, int, error) {
+		l, err := s.ListDedicatedClustersWithContext(ctx, &ListDedicatedClustersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DedicatedClusters, l.Count, nil
+	}, opts...)
+}
+
 type ListDedicatedClustersResponse struct {
 	Count             int                 `json:"count"`
 	DedicatedClusters []*DedicatedCluster `json:"dedicatedcluster"`
 }
 
 type DedicatedCluster struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Clusterid       string `json:"clusterid"`
-	Clustername     string `json:"clustername"`
-	Domainid        string `json:"domainid"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Clusterid       string                     `json:"clusterid"`
+	Clustername     string                     `json:"clustername"`
+	Domainid        string                     `json:"domainid"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicatedCluster) UnmarshalJSON(b []byte) error {
+	type alias DedicatedCluster
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseDedicatedClusterParams struct {
@@ -2987,6 +4040,44 @@ func (p *ReleaseDedicatedClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseDedicatedCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseDedicatedClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseDedicatedCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseDedicatedCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseDedicatedClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid"},
+		lengths:  map[string]int{"clusterid": 255},
+	}.validate("releaseDedicatedCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseDedicatedCluster API, before the request is signed
+func (p *ReleaseDedicatedClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseDedicatedClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseDedicatedClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseDedicatedCluster", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseDedicatedClusterParams) Clone() *ReleaseDedicatedClusterParams {
+	return &ReleaseDedicatedClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseDedicatedClusterParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3019,27 +4110,39 @@ func (s *ClusterService) NewReleaseDedicatedClusterParams(clusterid string) *Rel
 
 // Release the dedication for cluster
 func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseDedicatedCluster", p.toURLValues())
+	return s.ReleaseDedicatedClusterWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedClusterWithContext is like ReleaseDedicatedCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseDedicatedCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseDedicatedClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseDedicatedCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseDedicatedCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseDedicatedCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3047,11 +4150,31 @@ func (s *ClusterService) ReleaseDedicatedCluster(p *ReleaseDedicatedClusterParam
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseDedicatedCluster async job into r
+func (r *ReleaseDedicatedClusterResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseDedicatedClusterAsync starts the async job of ReleaseDedicatedCluster without waiting for it to finish, and returns a handle to the job
+func (s *ClusterService) ReleaseDedicatedClusterAsync(ctx context.Context, p *ReleaseDedicatedClusterParams) (*Job, error) {
+	r, err := s.ReleaseDedicatedClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseDedicatedCluster", r.JobID), nil
+}
+
 type ReleaseDedicatedClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseDedicatedClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseDedicatedClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateClusterParams struct {
@@ -3083,7 +4206,7 @@ func (p *UpdateClusterParams) toURLValues() url.Values {
 		}
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -3094,6 +4217,51 @@ func (p *UpdateClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"allocationstate": 255, "arch": 255, "clustername": 255, "clustertype": 255, "hypervisor": 255, "id": 255, "managedstate": 255},
+	}.validate("updateCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateCluster API, before the request is signed
+func (p *UpdateClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateCluster", b, &p.p, map[string]paramDecoder{
+		"allocationstate": decodeParam[string],
+		"arch":            decodeParam[string],
+		"clustername":     decodeParam[string],
+		"clustertype":     decodeParam[string],
+		"externaldetails": decodeParam[map[string]string],
+		"hypervisor":      decodeParam[HypervisorType],
+		"id":              decodeParam[string],
+		"managedstate":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateClusterParams) Clone() *UpdateClusterParams {
+	return &UpdateClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateClusterParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3199,7 +4367,7 @@ func (p *UpdateClusterParams) GetExternaldetails() (map[string]string, bool) {
 	return value, ok
 }
 
-func (p *UpdateClusterParams) SetHypervisor(v string) {
+func (p *UpdateClusterParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -3212,11 +4380,11 @@ func (p *UpdateClusterParams) ResetHypervisor() {
 	}
 }
 
-func (p *UpdateClusterParams) GetHypervisor() (string, bool) {
+func (p *UpdateClusterParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -3273,7 +4441,16 @@ func (s *ClusterService) NewUpdateClusterParams(id string) *UpdateClusterParams
 
 // Updates an existing cluster
 func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("updateCluster", p.toURLValues())
+	return s.UpdateClusterWithContext(context.Background(), p)
+}
+
+// UpdateClusterWithContext is like UpdateCluster, but honours the cancellation and deadline of ctx
+func (s *ClusterService) UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3283,7 +4460,7 @@ func (s *ClusterService) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterRe
 	}
 
 	var r UpdateClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3298,11 +4475,11 @@ type UpdateClusterResponse struct {
 	Cpuovercommitratio      string                          `json:"cpuovercommitratio"`
 	Extensionid             string                          `json:"extensionid"`
 	Extensionname           string                          `json:"extensionname"`
-	Hasannotations          bool                            `json:"hasannotations"`
+	Hasannotations          FlexBool                        `json:"hasannotations"`
 	Hypervisortype          string                          `json:"hypervisortype"`
 	Id                      string                          `json:"id"`
 	JobID                   string                          `json:"jobid"`
-	Jobstatus               int                             `json:"jobstatus"`
+	Jobstatus               FlexInt                         `json:"jobstatus"`
 	Managedstate            string                          `json:"managedstate"`
 	Memoryovercommitratio   string                          `json:"memoryovercommitratio"`
 	Name                    string                          `json:"name"`
@@ -3315,20 +4492,32 @@ type UpdateClusterResponse struct {
 	Zoneid                  string                          `json:"zoneid"`
 	Zonename                string                          `json:"zonename"`
 	Zonestorageaccessgroups string                          `json:"zonestorageaccessgroups"`
+	Extra                   map[string]json.RawMessage      `json:"-"`
+}
+
+func (r *UpdateClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateClusterResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateClusterResponseCapacity) UnmarshalJSON(b []byte) error {
+	type alias UpdateClusterResponseCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
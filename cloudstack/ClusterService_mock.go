@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockClusterServiceIfaceMockRecorder) AddCluster(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).AddCluster), p)
 }
 
+// AddClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) AddClusterWithContext(ctx context.Context, p *AddClusterParams) (*AddClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddClusterWithContext indicates an expected call of AddClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) AddClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).AddClusterWithContext), ctx, p)
+}
+
 // DedicateCluster mocks base method.
 func (m *MockClusterServiceIface) DedicateCluster(p *DedicateClusterParams) (*DedicateClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockClusterServiceIfaceMockRecorder) DedicateCluster(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DedicateCluster), p)
 }
 
+// DedicateClusterAsync mocks base method.
+func (m *MockClusterServiceIface) DedicateClusterAsync(ctx context.Context, p *DedicateClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateClusterAsync indicates an expected call of DedicateClusterAsync.
+func (mr *MockClusterServiceIfaceMockRecorder) DedicateClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateClusterAsync", reflect.TypeOf((*MockClusterServiceIface)(nil).DedicateClusterAsync), ctx, p)
+}
+
+// DedicateClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) DedicateClusterWithContext(ctx context.Context, p *DedicateClusterParams) (*DedicateClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicateClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateClusterWithContext indicates an expected call of DedicateClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) DedicateClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).DedicateClusterWithContext), ctx, p)
+}
+
 // DeleteCluster mocks base method.
 func (m *MockClusterServiceIface) DeleteCluster(p *DeleteClusterParams) (*DeleteClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockClusterServiceIfaceMockRecorder) DeleteCluster(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DeleteCluster), p)
 }
 
+// DeleteClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) DeleteClusterWithContext(ctx context.Context, p *DeleteClusterParams) (*DeleteClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteClusterWithContext indicates an expected call of DeleteClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) DeleteClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).DeleteClusterWithContext), ctx, p)
+}
+
 // DisableHAForCluster mocks base method.
 func (m *MockClusterServiceIface) DisableHAForCluster(p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,36 @@ func (mr *MockClusterServiceIfaceMockRecorder) DisableHAForCluster(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableHAForCluster), p)
 }
 
+// DisableHAForClusterAsync mocks base method.
+func (m *MockClusterServiceIface) DisableHAForClusterAsync(ctx context.Context, p *DisableHAForClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableHAForClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableHAForClusterAsync indicates an expected call of DisableHAForClusterAsync.
+func (mr *MockClusterServiceIfaceMockRecorder) DisableHAForClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForClusterAsync", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableHAForClusterAsync), ctx, p)
+}
+
+// DisableHAForClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) DisableHAForClusterWithContext(ctx context.Context, p *DisableHAForClusterParams) (*DisableHAForClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableHAForClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableHAForClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableHAForClusterWithContext indicates an expected call of DisableHAForClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) DisableHAForClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableHAForClusterWithContext), ctx, p)
+}
+
 // DisableOutOfBandManagementForCluster mocks base method.
 func (m *MockClusterServiceIface) DisableOutOfBandManagementForCluster(p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +225,36 @@ func (mr *MockClusterServiceIfaceMockRecorder) DisableOutOfBandManagementForClus
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableOutOfBandManagementForCluster), p)
 }
 
+// DisableOutOfBandManagementForClusterAsync mocks base method.
+func (m *MockClusterServiceIface) DisableOutOfBandManagementForClusterAsync(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForClusterAsync indicates an expected call of DisableOutOfBandManagementForClusterAsync.
+func (mr *MockClusterServiceIfaceMockRecorder) DisableOutOfBandManagementForClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForClusterAsync", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableOutOfBandManagementForClusterAsync), ctx, p)
+}
+
+// DisableOutOfBandManagementForClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) DisableOutOfBandManagementForClusterWithContext(ctx context.Context, p *DisableOutOfBandManagementForClusterParams) (*DisableOutOfBandManagementForClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableOutOfBandManagementForClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForClusterWithContext indicates an expected call of DisableOutOfBandManagementForClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) DisableOutOfBandManagementForClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).DisableOutOfBandManagementForClusterWithContext), ctx, p)
+}
+
 // EnableHAForCluster mocks base method.
 func (m *MockClusterServiceIface) EnableHAForCluster(p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +270,36 @@ func (mr *MockClusterServiceIfaceMockRecorder) EnableHAForCluster(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableHAForCluster), p)
 }
 
+// EnableHAForClusterAsync mocks base method.
+func (m *MockClusterServiceIface) EnableHAForClusterAsync(ctx context.Context, p *EnableHAForClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableHAForClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableHAForClusterAsync indicates an expected call of EnableHAForClusterAsync.
+func (mr *MockClusterServiceIfaceMockRecorder) EnableHAForClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForClusterAsync", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableHAForClusterAsync), ctx, p)
+}
+
+// EnableHAForClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) EnableHAForClusterWithContext(ctx context.Context, p *EnableHAForClusterParams) (*EnableHAForClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableHAForClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableHAForClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableHAForClusterWithContext indicates an expected call of EnableHAForClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) EnableHAForClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableHAForClusterWithContext), ctx, p)
+}
+
 // EnableOutOfBandManagementForCluster mocks base method.
 func (m *MockClusterServiceIface) EnableOutOfBandManagementForCluster(p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +315,36 @@ func (mr *MockClusterServiceIfaceMockRecorder) EnableOutOfBandManagementForClust
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableOutOfBandManagementForCluster), p)
 }
 
+// EnableOutOfBandManagementForClusterAsync mocks base method.
+func (m *MockClusterServiceIface) EnableOutOfBandManagementForClusterAsync(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForClusterAsync indicates an expected call of EnableOutOfBandManagementForClusterAsync.
+func (mr *MockClusterServiceIfaceMockRecorder) EnableOutOfBandManagementForClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForClusterAsync", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableOutOfBandManagementForClusterAsync), ctx, p)
+}
+
+// EnableOutOfBandManagementForClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) EnableOutOfBandManagementForClusterWithContext(ctx context.Context, p *EnableOutOfBandManagementForClusterParams) (*EnableOutOfBandManagementForClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableOutOfBandManagementForClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForClusterWithContext indicates an expected call of EnableOutOfBandManagementForClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) EnableOutOfBandManagementForClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).EnableOutOfBandManagementForClusterWithContext), ctx, p)
+}
+
 // ExecuteClusterDrsPlan mocks base method.
 func (m *MockClusterServiceIface) ExecuteClusterDrsPlan(p *ExecuteClusterDrsPlanParams) (*ExecuteClusterDrsPlanResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +360,36 @@ func (mr *MockClusterServiceIfaceMockRecorder) ExecuteClusterDrsPlan(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteClusterDrsPlan", reflect.TypeOf((*MockClusterServiceIface)(nil).ExecuteClusterDrsPlan), p)
 }
 
+// ExecuteClusterDrsPlanAsync mocks base method.
+func (m *MockClusterServiceIface) ExecuteClusterDrsPlanAsync(ctx context.Context, p *ExecuteClusterDrsPlanParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteClusterDrsPlanAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteClusterDrsPlanAsync indicates an expected call of ExecuteClusterDrsPlanAsync.
+func (mr *MockClusterServiceIfaceMockRecorder) ExecuteClusterDrsPlanAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteClusterDrsPlanAsync", reflect.TypeOf((*MockClusterServiceIface)(nil).ExecuteClusterDrsPlanAsync), ctx, p)
+}
+
+// ExecuteClusterDrsPlanWithContext mocks base method.
+func (m *MockClusterServiceIface) ExecuteClusterDrsPlanWithContext(ctx context.Context, p *ExecuteClusterDrsPlanParams) (*ExecuteClusterDrsPlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteClusterDrsPlanWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExecuteClusterDrsPlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteClusterDrsPlanWithContext indicates an expected call of ExecuteClusterDrsPlanWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ExecuteClusterDrsPlanWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteClusterDrsPlanWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ExecuteClusterDrsPlanWithContext), ctx, p)
+}
+
 // GenerateClusterDrsPlan mocks base method.
 func (m *MockClusterServiceIface) GenerateClusterDrsPlan(p *GenerateClusterDrsPlanParams) (*GenerateClusterDrsPlanResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +405,21 @@ func (mr *MockClusterServiceIfaceMockRecorder) GenerateClusterDrsPlan(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateClusterDrsPlan", reflect.TypeOf((*MockClusterServiceIface)(nil).GenerateClusterDrsPlan), p)
 }
 
+// GenerateClusterDrsPlanWithContext mocks base method.
+func (m *MockClusterServiceIface) GenerateClusterDrsPlanWithContext(ctx context.Context, p *GenerateClusterDrsPlanParams) (*GenerateClusterDrsPlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateClusterDrsPlanWithContext", ctx, p)
+	ret0, _ := ret[0].(*GenerateClusterDrsPlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateClusterDrsPlanWithContext indicates an expected call of GenerateClusterDrsPlanWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GenerateClusterDrsPlanWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateClusterDrsPlanWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GenerateClusterDrsPlanWithContext), ctx, p)
+}
+
 // GetClusterByID mocks base method.
 func (m *MockClusterServiceIface) GetClusterByID(id string, opts ...OptionFunc) (*Cluster, int, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +441,27 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClusterByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterByID), varargs...)
 }
 
+// GetClusterByIDWithContext mocks base method.
+func (m *MockClusterServiceIface) GetClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Cluster, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Cluster)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterByIDWithContext indicates an expected call of GetClusterByIDWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClusterByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByIDWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterByIDWithContext), varargs...)
+}
+
 // GetClusterByName mocks base method.
 func (m *MockClusterServiceIface) GetClusterByName(name string, opts ...OptionFunc) (*Cluster, int, error) {
 	m.ctrl.T.Helper()
@@ -235,6 +483,27 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClusterByName(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByName", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterByName), varargs...)
 }
 
+// GetClusterByNameWithContext mocks base method.
+func (m *MockClusterServiceIface) GetClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Cluster, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Cluster)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterByNameWithContext indicates an expected call of GetClusterByNameWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClusterByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterByNameWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterByNameWithContext), varargs...)
+}
+
 // GetClusterDrsPlanByID mocks base method.
 func (m *MockClusterServiceIface) GetClusterDrsPlanByID(id string, opts ...OptionFunc) (*ClusterDrsPlan, int, error) {
 	m.ctrl.T.Helper()
@@ -256,6 +525,27 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClusterDrsPlanByID(id any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDrsPlanByID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterDrsPlanByID), varargs...)
 }
 
+// GetClusterDrsPlanByIDWithContext mocks base method.
+func (m *MockClusterServiceIface) GetClusterDrsPlanByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClusterDrsPlan, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterDrsPlanByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ClusterDrsPlan)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterDrsPlanByIDWithContext indicates an expected call of GetClusterDrsPlanByIDWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClusterDrsPlanByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterDrsPlanByIDWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterDrsPlanByIDWithContext), varargs...)
+}
+
 // GetClusterID mocks base method.
 func (m *MockClusterServiceIface) GetClusterID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +567,27 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClusterID(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterID), varargs...)
 }
 
+// GetClusterIDWithContext mocks base method.
+func (m *MockClusterServiceIface) GetClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClusterIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClusterIDWithContext indicates an expected call of GetClusterIDWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClusterIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClusterIDWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClusterIDWithContext), varargs...)
+}
+
 // GetClustersMetricByID mocks base method.
 func (m *MockClusterServiceIface) GetClustersMetricByID(id string, opts ...OptionFunc) (*ClustersMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -298,6 +609,27 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricByID(id any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricByID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricByID), varargs...)
 }
 
+// GetClustersMetricByIDWithContext mocks base method.
+func (m *MockClusterServiceIface) GetClustersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ClustersMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClustersMetricByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ClustersMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClustersMetricByIDWithContext indicates an expected call of GetClustersMetricByIDWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricByIDWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricByIDWithContext), varargs...)
+}
+
 // GetClustersMetricByName mocks base method.
 func (m *MockClusterServiceIface) GetClustersMetricByName(name string, opts ...OptionFunc) (*ClustersMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +651,27 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricByName(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricByName", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricByName), varargs...)
 }
 
+// GetClustersMetricByNameWithContext mocks base method.
+func (m *MockClusterServiceIface) GetClustersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ClustersMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClustersMetricByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*ClustersMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClustersMetricByNameWithContext indicates an expected call of GetClustersMetricByNameWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricByNameWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricByNameWithContext), varargs...)
+}
+
 // GetClustersMetricID mocks base method.
 func (m *MockClusterServiceIface) GetClustersMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +693,27 @@ func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricID(name any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricID", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricID), varargs...)
 }
 
+// GetClustersMetricIDWithContext mocks base method.
+func (m *MockClusterServiceIface) GetClustersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetClustersMetricIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetClustersMetricIDWithContext indicates an expected call of GetClustersMetricIDWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) GetClustersMetricIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetClustersMetricIDWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).GetClustersMetricIDWithContext), varargs...)
+}
+
 // ListClusterDrsPlan mocks base method.
 func (m *MockClusterServiceIface) ListClusterDrsPlan(p *ListClusterDrsPlanParams) (*ListClusterDrsPlanResponse, error) {
 	m.ctrl.T.Helper()
@@ -355,6 +729,99 @@ func (mr *MockClusterServiceIfaceMockRecorder) ListClusterDrsPlan(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDrsPlan", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusterDrsPlan), p)
 }
 
+// ListClusterDrsPlanAll mocks base method.
+func (m *MockClusterServiceIface) ListClusterDrsPlanAll(p *ListClusterDrsPlanParams, opts ...PageOption) ([]*ClusterDrsPlan, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusterDrsPlanAll", varargs...)
+	ret0, _ := ret[0].([]*ClusterDrsPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterDrsPlanAll indicates an expected call of ListClusterDrsPlanAll.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClusterDrsPlanAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDrsPlanAll", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusterDrsPlanAll), varargs...)
+}
+
+// ListClusterDrsPlanAllWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClusterDrsPlanAllWithContext(ctx context.Context, p *ListClusterDrsPlanParams, opts ...PageOption) ([]*ClusterDrsPlan, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusterDrsPlanAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ClusterDrsPlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterDrsPlanAllWithContext indicates an expected call of ListClusterDrsPlanAllWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClusterDrsPlanAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDrsPlanAllWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusterDrsPlanAllWithContext), varargs...)
+}
+
+// ListClusterDrsPlanIter mocks base method.
+func (m *MockClusterServiceIface) ListClusterDrsPlanIter(p *ListClusterDrsPlanParams, opts ...PageOption) iter.Seq2[*ClusterDrsPlan, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusterDrsPlanIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ClusterDrsPlan, error])
+	return ret0
+}
+
+// ListClusterDrsPlanIter indicates an expected call of ListClusterDrsPlanIter.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClusterDrsPlanIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDrsPlanIter", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusterDrsPlanIter), varargs...)
+}
+
+// ListClusterDrsPlanIterWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClusterDrsPlanIterWithContext(ctx context.Context, p *ListClusterDrsPlanParams, opts ...PageOption) iter.Seq2[*ClusterDrsPlan, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClusterDrsPlanIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ClusterDrsPlan, error])
+	return ret0
+}
+
+// ListClusterDrsPlanIterWithContext indicates an expected call of ListClusterDrsPlanIterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClusterDrsPlanIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDrsPlanIterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusterDrsPlanIterWithContext), varargs...)
+}
+
+// ListClusterDrsPlanWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClusterDrsPlanWithContext(ctx context.Context, p *ListClusterDrsPlanParams) (*ListClusterDrsPlanResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClusterDrsPlanWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListClusterDrsPlanResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClusterDrsPlanWithContext indicates an expected call of ListClusterDrsPlanWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClusterDrsPlanWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusterDrsPlanWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusterDrsPlanWithContext), ctx, p)
+}
+
 // ListClusters mocks base method.
 func (m *MockClusterServiceIface) ListClusters(p *ListClustersParams) (*ListClustersResponse, error) {
 	m.ctrl.T.Helper()
@@ -370,6 +837,84 @@ func (mr *MockClusterServiceIfaceMockRecorder) ListClusters(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClusters), p)
 }
 
+// ListClustersAll mocks base method.
+func (m *MockClusterServiceIface) ListClustersAll(p *ListClustersParams, opts ...PageOption) ([]*Cluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersAll", varargs...)
+	ret0, _ := ret[0].([]*Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClustersAll indicates an expected call of ListClustersAll.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersAll", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersAll), varargs...)
+}
+
+// ListClustersAllWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClustersAllWithContext(ctx context.Context, p *ListClustersParams, opts ...PageOption) ([]*Cluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Cluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClustersAllWithContext indicates an expected call of ListClustersAllWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersAllWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersAllWithContext), varargs...)
+}
+
+// ListClustersIter mocks base method.
+func (m *MockClusterServiceIface) ListClustersIter(p *ListClustersParams, opts ...PageOption) iter.Seq2[*Cluster, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Cluster, error])
+	return ret0
+}
+
+// ListClustersIter indicates an expected call of ListClustersIter.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersIter", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersIter), varargs...)
+}
+
+// ListClustersIterWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClustersIterWithContext(ctx context.Context, p *ListClustersParams, opts ...PageOption) iter.Seq2[*Cluster, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Cluster, error])
+	return ret0
+}
+
+// ListClustersIterWithContext indicates an expected call of ListClustersIterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersIterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersIterWithContext), varargs...)
+}
+
 // ListClustersMetrics mocks base method.
 func (m *MockClusterServiceIface) ListClustersMetrics(p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
 	m.ctrl.T.Helper()
@@ -385,6 +930,114 @@ func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetrics(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetrics", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetrics), p)
 }
 
+// ListClustersMetricsAll mocks base method.
+func (m *MockClusterServiceIface) ListClustersMetricsAll(p *ListClustersMetricsParams, opts ...PageOption) ([]*ClustersMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersMetricsAll", varargs...)
+	ret0, _ := ret[0].([]*ClustersMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClustersMetricsAll indicates an expected call of ListClustersMetricsAll.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetricsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetricsAll", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetricsAll), varargs...)
+}
+
+// ListClustersMetricsAllWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClustersMetricsAllWithContext(ctx context.Context, p *ListClustersMetricsParams, opts ...PageOption) ([]*ClustersMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersMetricsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ClustersMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClustersMetricsAllWithContext indicates an expected call of ListClustersMetricsAllWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetricsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetricsAllWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetricsAllWithContext), varargs...)
+}
+
+// ListClustersMetricsIter mocks base method.
+func (m *MockClusterServiceIface) ListClustersMetricsIter(p *ListClustersMetricsParams, opts ...PageOption) iter.Seq2[*ClustersMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersMetricsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ClustersMetric, error])
+	return ret0
+}
+
+// ListClustersMetricsIter indicates an expected call of ListClustersMetricsIter.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetricsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetricsIter", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetricsIter), varargs...)
+}
+
+// ListClustersMetricsIterWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClustersMetricsIterWithContext(ctx context.Context, p *ListClustersMetricsParams, opts ...PageOption) iter.Seq2[*ClustersMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListClustersMetricsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ClustersMetric, error])
+	return ret0
+}
+
+// ListClustersMetricsIterWithContext indicates an expected call of ListClustersMetricsIterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetricsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetricsIterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetricsIterWithContext), varargs...)
+}
+
+// ListClustersMetricsWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClustersMetricsWithContext(ctx context.Context, p *ListClustersMetricsParams) (*ListClustersMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClustersMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListClustersMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClustersMetricsWithContext indicates an expected call of ListClustersMetricsWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersMetricsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersMetricsWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersMetricsWithContext), ctx, p)
+}
+
+// ListClustersWithContext mocks base method.
+func (m *MockClusterServiceIface) ListClustersWithContext(ctx context.Context, p *ListClustersParams) (*ListClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListClustersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListClustersWithContext indicates an expected call of ListClustersWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListClustersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListClustersWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListClustersWithContext), ctx, p)
+}
+
 // ListDedicatedClusters mocks base method.
 func (m *MockClusterServiceIface) ListDedicatedClusters(p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,8 +1053,101 @@ func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClusters(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClusters", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClusters), p)
 }
 
+// ListDedicatedClustersAll mocks base method.
+func (m *MockClusterServiceIface) ListDedicatedClustersAll(p *ListDedicatedClustersParams, opts ...PageOption) ([]*DedicatedCluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedClustersAll", varargs...)
+	ret0, _ := ret[0].([]*DedicatedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedClustersAll indicates an expected call of ListDedicatedClustersAll.
+func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClustersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClustersAll", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClustersAll), varargs...)
+}
+
+// ListDedicatedClustersAllWithContext mocks base method.
+func (m *MockClusterServiceIface) ListDedicatedClustersAllWithContext(ctx context.Context, p *ListDedicatedClustersParams, opts ...PageOption) ([]*DedicatedCluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedClustersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DedicatedCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedClustersAllWithContext indicates an expected call of ListDedicatedClustersAllWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClustersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClustersAllWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClustersAllWithContext), varargs...)
+}
+
+// ListDedicatedClustersIter mocks base method.
+func (m *MockClusterServiceIface) ListDedicatedClustersIter(p *ListDedicatedClustersParams, opts ...PageOption) iter.Seq2[*DedicatedCluster, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedClustersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedCluster, error])
+	return ret0
+}
+
+// ListDedicatedClustersIter indicates an expected call of ListDedicatedClustersIter.
+func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClustersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClustersIter", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClustersIter), varargs...)
+}
+
+// ListDedicatedClustersIterWithContext mocks base method.
+func (m *MockClusterServiceIface) ListDedicatedClustersIterWithContext(ctx context.Context, p *ListDedicatedClustersParams, opts ...PageOption) iter.Seq2[*DedicatedCluster, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedClustersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedCluster, error])
+	return ret0
+}
+
+// ListDedicatedClustersIterWithContext indicates an expected call of ListDedicatedClustersIterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClustersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClustersIterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClustersIterWithContext), varargs...)
+}
+
+// ListDedicatedClustersWithContext mocks base method.
+func (m *MockClusterServiceIface) ListDedicatedClustersWithContext(ctx context.Context, p *ListDedicatedClustersParams) (*ListDedicatedClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedClustersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDedicatedClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedClustersWithContext indicates an expected call of ListDedicatedClustersWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ListDedicatedClustersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedClustersWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ListDedicatedClustersWithContext), ctx, p)
+}
+
 // NewAddClusterParams mocks base method.
-func (m *MockClusterServiceIface) NewAddClusterParams(clustername, clustertype, hypervisor, podid, zoneid string) *AddClusterParams {
+func (m *MockClusterServiceIface) NewAddClusterParams(clustername, clustertype string, hypervisor HypervisorType, podid, zoneid string) *AddClusterParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewAddClusterParams", clustername, clustertype, hypervisor, podid, zoneid)
 	ret0, _ := ret[0].(*AddClusterParams)
@@ -625,6 +1371,36 @@ func (mr *MockClusterServiceIfaceMockRecorder) ReleaseDedicatedCluster(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).ReleaseDedicatedCluster), p)
 }
 
+// ReleaseDedicatedClusterAsync mocks base method.
+func (m *MockClusterServiceIface) ReleaseDedicatedClusterAsync(ctx context.Context, p *ReleaseDedicatedClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedClusterAsync indicates an expected call of ReleaseDedicatedClusterAsync.
+func (mr *MockClusterServiceIfaceMockRecorder) ReleaseDedicatedClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedClusterAsync", reflect.TypeOf((*MockClusterServiceIface)(nil).ReleaseDedicatedClusterAsync), ctx, p)
+}
+
+// ReleaseDedicatedClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) ReleaseDedicatedClusterWithContext(ctx context.Context, p *ReleaseDedicatedClusterParams) (*ReleaseDedicatedClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseDedicatedClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedClusterWithContext indicates an expected call of ReleaseDedicatedClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) ReleaseDedicatedClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).ReleaseDedicatedClusterWithContext), ctx, p)
+}
+
 // UpdateCluster mocks base method.
 func (m *MockClusterServiceIface) UpdateCluster(p *UpdateClusterParams) (*UpdateClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -639,3 +1415,18 @@ func (mr *MockClusterServiceIfaceMockRecorder) UpdateCluster(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCluster", reflect.TypeOf((*MockClusterServiceIface)(nil).UpdateCluster), p)
 }
+
+// UpdateClusterWithContext mocks base method.
+func (m *MockClusterServiceIface) UpdateClusterWithContext(ctx context.Context, p *UpdateClusterParams) (*UpdateClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateClusterWithContext indicates an expected call of UpdateClusterWithContext.
+func (mr *MockClusterServiceIfaceMockRecorder) UpdateClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateClusterWithContext", reflect.TypeOf((*MockClusterServiceIface)(nil).UpdateClusterWithContext), ctx, p)
+}
@@ -20,36 +20,62 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type ConfigurationServiceIface interface {
 	ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
+	ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error)
 	NewListCapabilitiesParams() *ListCapabilitiesParams
 	ListConfigurationGroups(p *ListConfigurationGroupsParams) (*ListConfigurationGroupsResponse, error)
+	ListConfigurationGroupsWithContext(ctx context.Context, p *ListConfigurationGroupsParams) (*ListConfigurationGroupsResponse, error)
+	ListConfigurationGroupsAll(p *ListConfigurationGroupsParams, opts ...PageOption) ([]*ConfigurationGroup, error)
+	ListConfigurationGroupsAllWithContext(ctx context.Context, p *ListConfigurationGroupsParams, opts ...PageOption) ([]*ConfigurationGroup, error)
+	ListConfigurationGroupsIter(p *ListConfigurationGroupsParams, opts ...PageOption) iter.Seq2[*ConfigurationGroup, error]
+	ListConfigurationGroupsIterWithContext(ctx context.Context, p *ListConfigurationGroupsParams, opts ...PageOption) iter.Seq2[*ConfigurationGroup, error]
 	NewListConfigurationGroupsParams() *ListConfigurationGroupsParams
 	ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error)
+	ListConfigurationsAll(p *ListConfigurationsParams, opts ...PageOption) ([]*Configuration, error)
+	ListConfigurationsAllWithContext(ctx context.Context, p *ListConfigurationsParams, opts ...PageOption) ([]*Configuration, error)
+	ListConfigurationsIter(p *ListConfigurationsParams, opts ...PageOption) iter.Seq2[*Configuration, error]
+	ListConfigurationsIterWithContext(ctx context.Context, p *ListConfigurationsParams, opts ...PageOption) iter.Seq2[*Configuration, error]
 	NewListConfigurationsParams() *ListConfigurationsParams
 	ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error)
+	ListDeploymentPlannersAll(p *ListDeploymentPlannersParams, opts ...PageOption) ([]*DeploymentPlanner, error)
+	ListDeploymentPlannersAllWithContext(ctx context.Context, p *ListDeploymentPlannersParams, opts ...PageOption) ([]*DeploymentPlanner, error)
+	ListDeploymentPlannersIter(p *ListDeploymentPlannersParams, opts ...PageOption) iter.Seq2[*DeploymentPlanner, error]
+	ListDeploymentPlannersIterWithContext(ctx context.Context, p *ListDeploymentPlannersParams, opts ...PageOption) iter.Seq2[*DeploymentPlanner, error]
 	NewListDeploymentPlannersParams() *ListDeploymentPlannersParams
 	UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
+	UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error)
 	NewUpdateConfigurationParams(name string) *UpdateConfigurationParams
 	ResetConfiguration(p *ResetConfigurationParams) (*ResetConfigurationResponse, error)
+	ResetConfigurationWithContext(ctx context.Context, p *ResetConfigurationParams) (*ResetConfigurationResponse, error)
 	NewResetConfigurationParams(name string) *ResetConfigurationParams
 	UpdateStorageCapabilities(p *UpdateStorageCapabilitiesParams) (*UpdateStorageCapabilitiesResponse, error)
+	UpdateStorageCapabilitiesWithContext(ctx context.Context, p *UpdateStorageCapabilitiesParams) (*UpdateStorageCapabilitiesResponse, error)
 	NewUpdateStorageCapabilitiesParams(id string) *UpdateStorageCapabilitiesParams
 	RegisterCniConfiguration(p *RegisterCniConfigurationParams) (*RegisterCniConfigurationResponse, error)
+	RegisterCniConfigurationWithContext(ctx context.Context, p *RegisterCniConfigurationParams) (*RegisterCniConfigurationResponse, error)
 	NewRegisterCniConfigurationParams(name string) *RegisterCniConfigurationParams
 	ListCniConfiguration(p *ListCniConfigurationParams) (*ListCniConfigurationResponse, error)
+	ListCniConfigurationWithContext(ctx context.Context, p *ListCniConfigurationParams) (*ListCniConfigurationResponse, error)
 	NewListCniConfigurationParams() *ListCniConfigurationParams
 	GetCniConfigurationID(name string, opts ...OptionFunc) (string, int, error)
+	GetCniConfigurationIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetCniConfigurationByName(name string, opts ...OptionFunc) (*UserData, int, error)
+	GetCniConfigurationByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UserData, int, error)
 	GetCniConfigurationByID(id string, opts ...OptionFunc) (*UserData, int, error)
+	GetCniConfigurationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UserData, int, error)
 	DeleteCniConfiguration(p *DeleteCniConfigurationParams) (*DeleteCniConfigurationResponse, error)
+	DeleteCniConfigurationWithContext(ctx context.Context, p *DeleteCniConfigurationParams) (*DeleteCniConfigurationResponse, error)
 	NewDeleteCniConfigurationParams(id string) *DeleteCniConfigurationParams
 }
 
@@ -65,6 +91,39 @@ func (p *ListCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listCapabilities API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListCapabilitiesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listCapabilities", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listCapabilities API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListCapabilitiesParams) Validate() error {
+	return paramRules{}.validate("listCapabilities", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listCapabilities API, before the request is signed
+func (p *ListCapabilitiesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listCapabilities", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListCapabilitiesParams) Clone() *ListCapabilitiesParams {
+	return &ListCapabilitiesParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new ListCapabilitiesParams instance,
 // as then you are sure you have configured all required params
 func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesParams {
@@ -75,13 +134,22 @@ func (s *ConfigurationService) NewListCapabilitiesParams() *ListCapabilitiesPara
 
 // Lists capabilities
 func (s *ConfigurationService) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listCapabilities", p.toURLValues())
+	return s.ListCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListCapabilitiesWithContext is like ListCapabilities, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listCapabilities", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCapabilitiesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listCapabilities", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -93,41 +161,47 @@ type ListCapabilitiesResponse struct {
 }
 
 type Capability struct {
-	Additionalconfigenabled                      bool   `json:"additionalconfigenabled"`
-	Allowusercreateprojects                      bool   `json:"allowusercreateprojects"`
-	Allowuserexpungerecovervm                    bool   `json:"allowuserexpungerecovervm"`
-	Allowuserexpungerecovervolume                bool   `json:"allowuserexpungerecovervolume"`
-	Allowuserforcestopvm                         bool   `json:"allowuserforcestopvm"`
-	Allowuserviewalldomainaccounts               bool   `json:"allowuserviewalldomainaccounts"`
-	Allowuserviewdestroyedvm                     bool   `json:"allowuserviewdestroyedvm"`
-	Apilimitinterval                             int    `json:"apilimitinterval"`
-	Apilimitmax                                  int    `json:"apilimitmax"`
-	Cloudstackversion                            string `json:"cloudstackversion"`
-	Customdiskofferingmaxsize                    int64  `json:"customdiskofferingmaxsize"`
-	Customdiskofferingminsize                    int64  `json:"customdiskofferingminsize"`
-	Customhypervisordisplayname                  string `json:"customhypervisordisplayname"`
-	Defaultuipagesize                            int64  `json:"defaultuipagesize"`
-	Dynamicrolesenabled                          bool   `json:"dynamicrolesenabled"`
-	Dynamicscalingenabled                        bool   `json:"dynamicscalingenabled"`
-	Extensionspath                               string `json:"extensionspath"`
-	Instanceleaseenabled                         bool   `json:"instanceleaseenabled"`
-	Instancesdisksstatsretentionenabled          bool   `json:"instancesdisksstatsretentionenabled"`
-	Instancesdisksstatsretentiontime             int    `json:"instancesdisksstatsretentiontime"`
-	Instancesstatsretentiontime                  int    `json:"instancesstatsretentiontime"`
-	Instancesstatsuseronly                       bool   `json:"instancesstatsuseronly"`
-	JobID                                        string `json:"jobid"`
-	Jobstatus                                    int    `json:"jobstatus"`
-	Kubernetesclusterexperimentalfeaturesenabled bool   `json:"kubernetesclusterexperimentalfeaturesenabled"`
-	Kubernetesserviceenabled                     bool   `json:"kubernetesserviceenabled"`
-	Kvmsnapshotenabled                           bool   `json:"kvmsnapshotenabled"`
-	Projectinviterequired                        bool   `json:"projectinviterequired"`
-	Regionsecondaryenabled                       bool   `json:"regionsecondaryenabled"`
-	Securitygroupsenabled                        bool   `json:"securitygroupsenabled"`
-	Sharedfsvmmincpucount                        int    `json:"sharedfsvmmincpucount"`
-	Sharedfsvmminramsize                         int    `json:"sharedfsvmminramsize"`
-	Snapshotshowchainsize                        bool   `json:"snapshotshowchainsize"`
-	SupportELB                                   string `json:"supportELB"`
-	Userpublictemplateenabled                    bool   `json:"userpublictemplateenabled"`
+	Additionalconfigenabled                      FlexBool                   `json:"additionalconfigenabled"`
+	Allowusercreateprojects                      FlexBool                   `json:"allowusercreateprojects"`
+	Allowuserexpungerecovervm                    FlexBool                   `json:"allowuserexpungerecovervm"`
+	Allowuserexpungerecovervolume                FlexBool                   `json:"allowuserexpungerecovervolume"`
+	Allowuserforcestopvm                         FlexBool                   `json:"allowuserforcestopvm"`
+	Allowuserviewalldomainaccounts               FlexBool                   `json:"allowuserviewalldomainaccounts"`
+	Allowuserviewdestroyedvm                     FlexBool                   `json:"allowuserviewdestroyedvm"`
+	Apilimitinterval                             FlexInt                    `json:"apilimitinterval"`
+	Apilimitmax                                  FlexInt                    `json:"apilimitmax"`
+	Cloudstackversion                            string                     `json:"cloudstackversion"`
+	Customdiskofferingmaxsize                    FlexInt64                  `json:"customdiskofferingmaxsize"`
+	Customdiskofferingminsize                    FlexInt64                  `json:"customdiskofferingminsize"`
+	Customhypervisordisplayname                  string                     `json:"customhypervisordisplayname"`
+	Defaultuipagesize                            FlexInt64                  `json:"defaultuipagesize"`
+	Dynamicrolesenabled                          FlexBool                   `json:"dynamicrolesenabled"`
+	Dynamicscalingenabled                        FlexBool                   `json:"dynamicscalingenabled"`
+	Extensionspath                               string                     `json:"extensionspath"`
+	Instanceleaseenabled                         FlexBool                   `json:"instanceleaseenabled"`
+	Instancesdisksstatsretentionenabled          FlexBool                   `json:"instancesdisksstatsretentionenabled"`
+	Instancesdisksstatsretentiontime             FlexInt                    `json:"instancesdisksstatsretentiontime"`
+	Instancesstatsretentiontime                  FlexInt                    `json:"instancesstatsretentiontime"`
+	Instancesstatsuseronly                       FlexBool                   `json:"instancesstatsuseronly"`
+	JobID                                        string                     `json:"jobid"`
+	Jobstatus                                    FlexInt                    `json:"jobstatus"`
+	Kubernetesclusterexperimentalfeaturesenabled FlexBool                   `json:"kubernetesclusterexperimentalfeaturesenabled"`
+	Kubernetesserviceenabled                     FlexBool                   `json:"kubernetesserviceenabled"`
+	Kvmsnapshotenabled                           FlexBool                   `json:"kvmsnapshotenabled"`
+	Projectinviterequired                        FlexBool                   `json:"projectinviterequired"`
+	Regionsecondaryenabled                       FlexBool                   `json:"regionsecondaryenabled"`
+	Securitygroupsenabled                        FlexBool                   `json:"securitygroupsenabled"`
+	Sharedfsvmmincpucount                        FlexInt                    `json:"sharedfsvmmincpucount"`
+	Sharedfsvmminramsize                         FlexInt                    `json:"sharedfsvmminramsize"`
+	Snapshotshowchainsize                        FlexBool                   `json:"snapshotshowchainsize"`
+	SupportELB                                   string                     `json:"supportELB"`
+	Userpublictemplateenabled                    FlexBool                   `json:"userpublictemplateenabled"`
+	Extra                                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Capability) UnmarshalJSON(b []byte) error {
+	type alias Capability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListConfigurationGroupsParams struct {
@@ -156,6 +230,46 @@ func (p *ListConfigurationGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listConfigurationGroups API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListConfigurationGroupsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listConfigurationGroups", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listConfigurationGroups API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListConfigurationGroupsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"group": 255, "keyword": 255},
+	}.validate("listConfigurationGroups", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listConfigurationGroups API, before the request is signed
+func (p *ListConfigurationGroupsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListConfigurationGroupsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListConfigurationGroupsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listConfigurationGroups", b, &p.p, map[string]paramDecoder{
+		"group":    decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListConfigurationGroupsParams) Clone() *ListConfigurationGroupsParams {
+	return &ListConfigurationGroupsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListConfigurationGroupsParams) SetGroup(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -250,19 +364,56 @@ func (s *ConfigurationService) NewListConfigurationGroupsParams() *ListConfigura
 
 // Lists all configuration groups (primarily used for UI).
 func (s *ConfigurationService) ListConfigurationGroups(p *ListConfigurationGroupsParams) (*ListConfigurationGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listConfigurationGroups", p.toURLValues())
+	return s.ListConfigurationGroupsWithContext(context.Background(), p)
+}
+
+// ListConfigurationGroupsWithContext is like ListConfigurationGroups, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListConfigurationGroupsWithContext(ctx context.Context, p *ListConfigurationGroupsParams) (*ListConfigurationGroupsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listConfigurationGroups", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListConfigurationGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listConfigurationGroups", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListConfigurationGroupsAll returns all ConfigurationGroups matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ConfigurationService) ListConfigurationGroupsAll(p *ListConfigurationGroupsParams, opts ...PageOption) ([]*ConfigurationGroup, error) {
+	return s.ListConfigurationGroupsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListConfigurationGroupsAllWithContext is like ListConfigurationGroupsAll, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListConfigurationGroupsAllWithContext(ctx context.Context, p *ListConfigurationGroupsParams, opts ...PageOption) ([]*ConfigurationGroup, error) {
+	return collectPages(s.ListConfigurationGroupsIterWithContext(ctx, p, opts...))
+}
+
+// ListConfigurationGroupsIter returns an iterator over all ConfigurationGroups matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ConfigurationService) ListConfigurationGroupsIter(p *ListConfigurationGroupsParams, opts ...PageOption) iter.Seq2[*ConfigurationGroup, error] {
+	return s.ListConfigurationGroupsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListConfigurationGroupsIterWithContext is like ListConfigurationGroupsIter, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListConfigurationGroupsIterWithContext(ctx context.Context, p *ListConfigurationGroupsParams, opts ...PageOption) iter.Seq2[*ConfigurationGroup, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ConfigurationGroup, int, error) {
+		l, err := s.ListConfigurationGroupsWithContext(ctx, &ListConfigurationGroupsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ConfigurationGroups, l.Count, nil
+	}, opts...)
+}
+
 type ListConfigurationGroupsResponse struct {
 	Count               int                   `json:"count"`
 	ConfigurationGroups []*ConfigurationGroup `json:"configurationgroup"`
@@ -271,15 +422,27 @@ type ListConfigurationGroupsResponse struct {
 type ConfigurationGroup struct {
 	Description string                       `json:"description"`
 	JobID       string                       `json:"jobid"`
-	Jobstatus   int                          `json:"jobstatus"`
+	Jobstatus   FlexInt                      `json:"jobstatus"`
 	Name        string                       `json:"name"`
-	Precedence  int64                        `json:"precedence"`
+	Precedence  FlexInt64                    `json:"precedence"`
 	Subgroup    []ConfigurationGroupSubgroup `json:"subgroup"`
+	Extra       map[string]json.RawMessage   `json:"-"`
+}
+
+func (r *ConfigurationGroup) UnmarshalJSON(b []byte) error {
+	type alias ConfigurationGroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ConfigurationGroupSubgroup struct {
-	Name       string `json:"name"`
-	Precedence int64  `json:"precedence"`
+	Name       string                     `json:"name"`
+	Precedence FlexInt64                  `json:"precedence"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ConfigurationGroupSubgroup) UnmarshalJSON(b []byte) error {
+	type alias ConfigurationGroupSubgroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListConfigurationsParams struct {
@@ -338,6 +501,56 @@ func (p *ListConfigurationsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listConfigurations API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListConfigurationsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listConfigurations", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listConfigurations API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListConfigurationsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"accountid": 255, "category": 255, "clusterid": 255, "domainid": 255, "group": 255, "imagestoreuuid": 255, "keyword": 255, "name": 255, "parent": 255, "storageid": 255, "subgroup": 255, "zoneid": 255},
+	}.validate("listConfigurations", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listConfigurations API, before the request is signed
+func (p *ListConfigurationsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListConfigurationsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListConfigurationsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listConfigurations", b, &p.p, map[string]paramDecoder{
+		"accountid":      decodeParam[string],
+		"category":       decodeParam[string],
+		"clusterid":      decodeParam[string],
+		"domainid":       decodeParam[string],
+		"group":          decodeParam[string],
+		"imagestoreuuid": decodeParam[string],
+		"keyword":        decodeParam[string],
+		"name":           decodeParam[string],
+		"page":           decodeParam[int],
+		"pagesize":       decodeParam[int],
+		"parent":         decodeParam[string],
+		"storageid":      decodeParam[string],
+		"subgroup":       decodeParam[string],
+		"zoneid":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListConfigurationsParams) Clone() *ListConfigurationsParams {
+	return &ListConfigurationsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListConfigurationsParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -642,42 +855,85 @@ func (s *ConfigurationService) NewListConfigurationsParams() *ListConfigurations
 
 // Lists all configurations.
 func (s *ConfigurationService) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listConfigurations", p.toURLValues())
+	return s.ListConfigurationsWithContext(context.Background(), p)
+}
+
+// ListConfigurationsWithContext is like ListConfigurations, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listConfigurations", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListConfigurationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listConfigurations", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListConfigurationsAll returns all Configurations matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ConfigurationService) ListConfigurationsAll(p *ListConfigurationsParams, opts ...PageOption) ([]*Configuration, error) {
+	return s.ListConfigurationsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListConfigurationsAllWithContext is like ListConfigurationsAll, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListConfigurationsAllWithContext(ctx context.Context, p *ListConfigurationsParams, opts ...PageOption) ([]*Configuration, error) {
+	return collectPages(s.ListConfigurationsIterWithContext(ctx, p, opts...))
+}
+
+// ListConfigurationsIter returns an iterator over all Configurations matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ConfigurationService) ListConfigurationsIter(p *ListConfigurationsParams, opts ...PageOption) iter.Seq2[*Configuration, error] {
+	return s.ListConfigurationsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListConfigurationsIterWithContext is like ListConfigurationsIter, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListConfigurationsIterWithContext(ctx context.Context, p *ListConfigurationsParams, opts ...PageOption) iter.Seq2[*Configuration, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Configuration, int, error) {
+		l, err := s.ListConfigurationsWithContext(ctx, &ListConfigurationsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Configurations, l.Count, nil
+	}, opts...)
+}
+
 type ListConfigurationsResponse struct {
 	Count          int              `json:"count"`
 	Configurations []*Configuration `json:"configuration"`
 }
 
 type Configuration struct {
-	Category     string `json:"category"`
-	Component    string `json:"component"`
-	Defaultvalue string `json:"defaultvalue"`
-	Description  string `json:"description"`
-	Displaytext  string `json:"displaytext"`
-	Group        string `json:"group"`
-	Id           int64  `json:"id"`
-	Isdynamic    bool   `json:"isdynamic"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Name         string `json:"name"`
-	Options      string `json:"options"`
-	Parent       string `json:"parent"`
-	Scope        string `json:"scope"`
-	Subgroup     string `json:"subgroup"`
-	Type         string `json:"type"`
-	Value        string `json:"value"`
+	Category     string                     `json:"category"`
+	Component    string                     `json:"component"`
+	Defaultvalue string                     `json:"defaultvalue"`
+	Description  string                     `json:"description"`
+	Displaytext  string                     `json:"displaytext"`
+	Group        string                     `json:"group"`
+	Id           FlexInt64                  `json:"id"`
+	Isdynamic    FlexBool                   `json:"isdynamic"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Name         string                     `json:"name"`
+	Options      string                     `json:"options"`
+	Parent       string                     `json:"parent"`
+	Scope        string                     `json:"scope"`
+	Subgroup     string                     `json:"subgroup"`
+	Type         string                     `json:"type"`
+	Value        string                     `json:"value"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Configuration) UnmarshalJSON(b []byte) error {
+	type alias Configuration
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDeploymentPlannersParams struct {
@@ -703,6 +959,45 @@ func (p *ListDeploymentPlannersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDeploymentPlanners API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDeploymentPlannersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDeploymentPlanners", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDeploymentPlanners API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDeploymentPlannersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listDeploymentPlanners", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDeploymentPlanners API, before the request is signed
+func (p *ListDeploymentPlannersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDeploymentPlannersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDeploymentPlannersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDeploymentPlanners", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDeploymentPlannersParams) Clone() *ListDeploymentPlannersParams {
+	return &ListDeploymentPlannersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDeploymentPlannersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -776,28 +1071,71 @@ func (s *ConfigurationService) NewListDeploymentPlannersParams() *ListDeployment
 
 // Lists all DeploymentPlanners available.
 func (s *ConfigurationService) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
-	resp, err := s.cs.newRequest("listDeploymentPlanners", p.toURLValues())
+	return s.ListDeploymentPlannersWithContext(context.Background(), p)
+}
+
+// ListDeploymentPlannersWithContext is like ListDeploymentPlanners, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDeploymentPlanners", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDeploymentPlannersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDeploymentPlanners", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDeploymentPlannersAll returns all DeploymentPlanners matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ConfigurationService) ListDeploymentPlannersAll(p *ListDeploymentPlannersParams, opts ...PageOption) ([]*DeploymentPlanner, error) {
+	return s.ListDeploymentPlannersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDeploymentPlannersAllWithContext is like ListDeploymentPlannersAll, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListDeploymentPlannersAllWithContext(ctx context.Context, p *ListDeploymentPlannersParams, opts ...PageOption) ([]*DeploymentPlanner, error) {
+	return collectPages(s.ListDeploymentPlannersIterWithContext(ctx, p, opts...))
+}
+
+// ListDeploymentPlannersIter returns an iterator over all DeploymentPlanners matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ConfigurationService) ListDeploymentPlannersIter(p *ListDeploymentPlannersParams, opts ...PageOption) iter.Seq2[*DeploymentPlanner, error] {
+	return s.ListDeploymentPlannersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDeploymentPlannersIterWithContext is like ListDeploymentPlannersIter, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListDeploymentPlannersIterWithContext(ctx context.Context, p *ListDeploymentPlannersParams, opts ...PageOption) iter.Seq2[*DeploymentPlanner, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DeploymentPlanner, int, error) {
+		l, err := s.ListDeploymentPlannersWithContext(ctx, &ListDeploymentPlannersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DeploymentPlanners, l.Count, nil
+	}, opts...)
+}
+
 type ListDeploymentPlannersResponse struct {
 	Count              int                  `json:"count"`
 	DeploymentPlanners []*DeploymentPlanner `json:"deploymentplanner"`
 }
 
 type DeploymentPlanner struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeploymentPlanner) UnmarshalJSON(b []byte) error {
+	type alias DeploymentPlanner
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateConfigurationParams struct {
@@ -836,6 +1174,51 @@ func (p *UpdateConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateConfiguration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateConfigurationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateConfiguration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateConfiguration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateConfigurationParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"accountid": 255, "clusterid": 255, "domainid": 255, "imagestoreuuid": 255, "name": 255, "storageid": 255, "value": 4096, "zoneid": 255},
+	}.validate("updateConfiguration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateConfiguration API, before the request is signed
+func (p *UpdateConfigurationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateConfigurationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateConfigurationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateConfiguration", b, &p.p, map[string]paramDecoder{
+		"accountid":      decodeParam[string],
+		"clusterid":      decodeParam[string],
+		"domainid":       decodeParam[string],
+		"imagestoreuuid": decodeParam[string],
+		"name":           decodeParam[string],
+		"storageid":      decodeParam[string],
+		"value":          decodeParam[string],
+		"zoneid":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateConfigurationParams) Clone() *UpdateConfigurationParams {
+	return &UpdateConfigurationParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateConfigurationParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1015,7 +1398,16 @@ func (s *ConfigurationService) NewUpdateConfigurationParams(name string) *Update
 
 // Updates a configuration.
 func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
-	resp, err := s.cs.newPostRequest("updateConfiguration", p.toURLValues())
+	return s.UpdateConfigurationWithContext(context.Background(), p)
+}
+
+// UpdateConfigurationWithContext is like UpdateConfiguration, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1025,7 +1417,7 @@ func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams)
 	}
 
 	var r UpdateConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1033,23 +1425,29 @@ func (s *ConfigurationService) UpdateConfiguration(p *UpdateConfigurationParams)
 }
 
 type UpdateConfigurationResponse struct {
-	Category     string `json:"category"`
-	Component    string `json:"component"`
-	Defaultvalue string `json:"defaultvalue"`
-	Description  string `json:"description"`
-	Displaytext  string `json:"displaytext"`
-	Group        string `json:"group"`
-	Id           int64  `json:"id"`
-	Isdynamic    bool   `json:"isdynamic"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Name         string `json:"name"`
-	Options      string `json:"options"`
-	Parent       string `json:"parent"`
-	Scope        string `json:"scope"`
-	Subgroup     string `json:"subgroup"`
-	Type         string `json:"type"`
-	Value        string `json:"value"`
+	Category     string                     `json:"category"`
+	Component    string                     `json:"component"`
+	Defaultvalue string                     `json:"defaultvalue"`
+	Description  string                     `json:"description"`
+	Displaytext  string                     `json:"displaytext"`
+	Group        string                     `json:"group"`
+	Id           FlexInt64                  `json:"id"`
+	Isdynamic    FlexBool                   `json:"isdynamic"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Name         string                     `json:"name"`
+	Options      string                     `json:"options"`
+	Parent       string                     `json:"parent"`
+	Scope        string                     `json:"scope"`
+	Subgroup     string                     `json:"subgroup"`
+	Type         string                     `json:"type"`
+	Value        string                     `json:"value"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateConfigurationResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateConfigurationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetConfigurationParams struct {
@@ -1085,6 +1483,50 @@ func (p *ResetConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resetConfiguration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResetConfigurationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resetConfiguration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resetConfiguration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResetConfigurationParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"accountid": 255, "clusterid": 255, "domainid": 255, "imagestoreid": 255, "name": 255, "storageid": 255, "zoneid": 255},
+	}.validate("resetConfiguration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resetConfiguration API, before the request is signed
+func (p *ResetConfigurationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResetConfigurationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResetConfigurationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resetConfiguration", b, &p.p, map[string]paramDecoder{
+		"accountid":    decodeParam[string],
+		"clusterid":    decodeParam[string],
+		"domainid":     decodeParam[string],
+		"imagestoreid": decodeParam[string],
+		"name":         decodeParam[string],
+		"storageid":    decodeParam[string],
+		"zoneid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResetConfigurationParams) Clone() *ResetConfigurationParams {
+	return &ResetConfigurationParams{p: cloneParams(p.p)}
+}
+
 func (p *ResetConfigurationParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1243,13 +1685,22 @@ func (s *ConfigurationService) NewResetConfigurationParams(name string) *ResetCo
 
 // Resets a configuration. The configuration will be set to default value for global setting, and removed from account_details or domain_details for Account/Domain settings
 func (s *ConfigurationService) ResetConfiguration(p *ResetConfigurationParams) (*ResetConfigurationResponse, error) {
-	resp, err := s.cs.newPostRequest("resetConfiguration", p.toURLValues())
+	return s.ResetConfigurationWithContext(context.Background(), p)
+}
+
+// ResetConfigurationWithContext is like ResetConfiguration, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ResetConfigurationWithContext(ctx context.Context, p *ResetConfigurationParams) (*ResetConfigurationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "resetConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resetConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1257,23 +1708,29 @@ func (s *ConfigurationService) ResetConfiguration(p *ResetConfigurationParams) (
 }
 
 type ResetConfigurationResponse struct {
-	Category     string `json:"category"`
-	Component    string `json:"component"`
-	Defaultvalue string `json:"defaultvalue"`
-	Description  string `json:"description"`
-	Displaytext  string `json:"displaytext"`
-	Group        string `json:"group"`
-	Id           int64  `json:"id"`
-	Isdynamic    bool   `json:"isdynamic"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Name         string `json:"name"`
-	Options      string `json:"options"`
-	Parent       string `json:"parent"`
-	Scope        string `json:"scope"`
-	Subgroup     string `json:"subgroup"`
-	Type         string `json:"type"`
-	Value        string `json:"value"`
+	Category     string                     `json:"category"`
+	Component    string                     `json:"component"`
+	Defaultvalue string                     `json:"defaultvalue"`
+	Description  string                     `json:"description"`
+	Displaytext  string                     `json:"displaytext"`
+	Group        string                     `json:"group"`
+	Id           FlexInt64                  `json:"id"`
+	Isdynamic    FlexBool                   `json:"isdynamic"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Name         string                     `json:"name"`
+	Options      string                     `json:"options"`
+	Parent       string                     `json:"parent"`
+	Scope        string                     `json:"scope"`
+	Subgroup     string                     `json:"subgroup"`
+	Type         string                     `json:"type"`
+	Value        string                     `json:"value"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetConfigurationResponse) UnmarshalJSON(b []byte) error {
+	type alias ResetConfigurationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateStorageCapabilitiesParams struct {
@@ -1291,6 +1748,44 @@ func (p *UpdateStorageCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateStorageCapabilities API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateStorageCapabilitiesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateStorageCapabilities", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateStorageCapabilities API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateStorageCapabilitiesParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("updateStorageCapabilities", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateStorageCapabilities API, before the request is signed
+func (p *UpdateStorageCapabilitiesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateStorageCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateStorageCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateStorageCapabilities", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateStorageCapabilitiesParams) Clone() *UpdateStorageCapabilitiesParams {
+	return &UpdateStorageCapabilitiesParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateStorageCapabilitiesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1323,13 +1818,22 @@ func (s *ConfigurationService) NewUpdateStorageCapabilitiesParams(id string) *Up
 
 // Syncs capabilities of storage pools
 func (s *ConfigurationService) UpdateStorageCapabilities(p *UpdateStorageCapabilitiesParams) (*UpdateStorageCapabilitiesResponse, error) {
-	resp, err := s.cs.newPostRequest("updateStorageCapabilities", p.toURLValues())
+	return s.UpdateStorageCapabilitiesWithContext(context.Background(), p)
+}
+
+// UpdateStorageCapabilitiesWithContext is like UpdateStorageCapabilities, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) UpdateStorageCapabilitiesWithContext(ctx context.Context, p *UpdateStorageCapabilitiesParams) (*UpdateStorageCapabilitiesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateStorageCapabilities", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateStorageCapabilitiesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateStorageCapabilities", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1337,42 +1841,48 @@ func (s *ConfigurationService) UpdateStorageCapabilities(p *UpdateStorageCapabil
 }
 
 type UpdateStorageCapabilitiesResponse struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateStorageCapabilitiesResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateStorageCapabilitiesResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterCniConfigurationParams struct {
@@ -1405,6 +1915,49 @@ func (p *RegisterCniConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerCniConfiguration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterCniConfigurationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerCniConfiguration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerCniConfiguration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterCniConfigurationParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"account": 255, "cniconfig": 255, "domainid": 255, "name": 255, "params": 255, "projectid": 255},
+	}.validate("registerCniConfiguration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerCniConfiguration API, before the request is signed
+func (p *RegisterCniConfigurationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterCniConfigurationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterCniConfigurationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerCniConfiguration", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"cniconfig": decodeParam[string],
+		"domainid":  decodeParam[string],
+		"name":      decodeParam[string],
+		"params":    decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterCniConfigurationParams) Clone() *RegisterCniConfigurationParams {
+	return &RegisterCniConfigurationParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterCniConfigurationParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1542,13 +2095,22 @@ func (s *ConfigurationService) NewRegisterCniConfigurationParams(name string) *R
 
 // Register a CNI Configuration to be used with CKS cluster
 func (s *ConfigurationService) RegisterCniConfiguration(p *RegisterCniConfigurationParams) (*RegisterCniConfigurationResponse, error) {
-	resp, err := s.cs.newPostRequest("registerCniConfiguration", p.toURLValues())
+	return s.RegisterCniConfigurationWithContext(context.Background(), p)
+}
+
+// RegisterCniConfigurationWithContext is like RegisterCniConfiguration, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) RegisterCniConfigurationWithContext(ctx context.Context, p *RegisterCniConfigurationParams) (*RegisterCniConfigurationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerCniConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterCniConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerCniConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1560,10 +2122,11 @@ type RegisterCniConfigurationResponse struct {
 }
 
 type RegisterCniConfiguration struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RegisterCniConfiguration) UnmarshalJSON(b []byte) error {
@@ -1590,7 +2153,7 @@ func (r *RegisterCniConfiguration) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RegisterCniConfiguration
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListCniConfigurationParams struct {
@@ -1639,6 +2202,52 @@ func (p *ListCniConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listCniConfiguration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListCniConfigurationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listCniConfiguration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listCniConfiguration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListCniConfigurationParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255},
+	}.validate("listCniConfiguration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listCniConfiguration API, before the request is signed
+func (p *ListCniConfigurationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListCniConfigurationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListCniConfigurationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listCniConfiguration", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListCniConfigurationParams) Clone() *ListCniConfigurationParams {
+	return &ListCniConfigurationParams{p: cloneParams(p.p)}
+}
+
 func (p *ListCniConfigurationParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1859,6 +2468,11 @@ func (s *ConfigurationService) NewListCniConfigurationParams() *ListCniConfigura
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ConfigurationService) GetCniConfigurationID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetCniConfigurationIDWithContext(context.Background(), name, opts...)
+}
+
+// GetCniConfigurationIDWithContext is like GetCniConfigurationID, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) GetCniConfigurationIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListCniConfigurationParams{}
 	p.p = make(map[string]interface{})
 
@@ -1870,7 +2484,7 @@ func (s *ConfigurationService) GetCniConfigurationID(name string, opts ...Option
 		}
 	}
 
-	l, err := s.ListCniConfiguration(p)
+	l, err := s.ListCniConfigurationWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1895,12 +2509,17 @@ func (s *ConfigurationService) GetCniConfigurationID(name string, opts ...Option
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ConfigurationService) GetCniConfigurationByName(name string, opts ...OptionFunc) (*UserData, int, error) {
-	id, count, err := s.GetCniConfigurationID(name, opts...)
+	return s.GetCniConfigurationByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetCniConfigurationByNameWithContext is like GetCniConfigurationByName, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) GetCniConfigurationByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UserData, int, error) {
+	id, count, err := s.GetCniConfigurationIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetCniConfigurationByID(id, opts...)
+	r, count, err := s.GetCniConfigurationByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1909,6 +2528,11 @@ func (s *ConfigurationService) GetCniConfigurationByName(name string, opts ...Op
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ConfigurationService) GetCniConfigurationByID(id string, opts ...OptionFunc) (*UserData, int, error) {
+	return s.GetCniConfigurationByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetCniConfigurationByIDWithContext is like GetCniConfigurationByID, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) GetCniConfigurationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UserData, int, error) {
 	p := &ListCniConfigurationParams{}
 	p.p = make(map[string]interface{})
 
@@ -1920,11 +2544,10 @@ func (s *ConfigurationService) GetCniConfigurationByID(id string, opts ...Option
 		}
 	}
 
-	l, err := s.ListCniConfiguration(p)
+	l, err := s.ListCniConfigurationWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1942,13 +2565,22 @@ func (s *ConfigurationService) GetCniConfigurationByID(id string, opts ...Option
 
 // List user data for CNI plugins
 func (s *ConfigurationService) ListCniConfiguration(p *ListCniConfigurationParams) (*ListCniConfigurationResponse, error) {
-	resp, err := s.cs.newRequest("listCniConfiguration", p.toURLValues())
+	return s.ListCniConfigurationWithContext(context.Background(), p)
+}
+
+// ListCniConfigurationWithContext is like ListCniConfiguration, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) ListCniConfigurationWithContext(ctx context.Context, p *ListCniConfigurationParams) (*ListCniConfigurationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listCniConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCniConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listCniConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1984,6 +2616,47 @@ func (p *DeleteCniConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteCniConfiguration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteCniConfigurationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteCniConfiguration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteCniConfiguration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteCniConfigurationParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "projectid": 255},
+	}.validate("deleteCniConfiguration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteCniConfiguration API, before the request is signed
+func (p *DeleteCniConfigurationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteCniConfigurationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteCniConfigurationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteCniConfiguration", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteCniConfigurationParams) Clone() *DeleteCniConfigurationParams {
+	return &DeleteCniConfigurationParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteCniConfigurationParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2079,13 +2752,22 @@ func (s *ConfigurationService) NewDeleteCniConfigurationParams(id string) *Delet
 
 // Deletes a CNI Configuration
 func (s *ConfigurationService) DeleteCniConfiguration(p *DeleteCniConfigurationParams) (*DeleteCniConfigurationResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteCniConfiguration", p.toURLValues())
+	return s.DeleteCniConfigurationWithContext(context.Background(), p)
+}
+
+// DeleteCniConfigurationWithContext is like DeleteCniConfiguration, but honours the cancellation and deadline of ctx
+func (s *ConfigurationService) DeleteCniConfigurationWithContext(ctx context.Context, p *DeleteCniConfigurationParams) (*DeleteCniConfigurationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteCniConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteCniConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteCniConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2093,10 +2775,11 @@ func (s *ConfigurationService) DeleteCniConfiguration(p *DeleteCniConfigurationP
 }
 
 type DeleteCniConfigurationResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteCniConfigurationResponse) UnmarshalJSON(b []byte) error {
@@ -2123,5 +2806,5 @@ func (r *DeleteCniConfigurationResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteCniConfigurationResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
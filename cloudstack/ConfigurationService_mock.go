@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) DeleteCniConfiguration(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCniConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).DeleteCniConfiguration), p)
 }
 
+// DeleteCniConfigurationWithContext mocks base method.
+func (m *MockConfigurationServiceIface) DeleteCniConfigurationWithContext(ctx context.Context, p *DeleteCniConfigurationParams) (*DeleteCniConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCniConfigurationWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteCniConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCniConfigurationWithContext indicates an expected call of DeleteCniConfigurationWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) DeleteCniConfigurationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCniConfigurationWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).DeleteCniConfigurationWithContext), ctx, p)
+}
+
 // GetCniConfigurationByID mocks base method.
 func (m *MockConfigurationServiceIface) GetCniConfigurationByID(id string, opts ...OptionFunc) (*UserData, int, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +111,27 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) GetCniConfigurationByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCniConfigurationByID", reflect.TypeOf((*MockConfigurationServiceIface)(nil).GetCniConfigurationByID), varargs...)
 }
 
+// GetCniConfigurationByIDWithContext mocks base method.
+func (m *MockConfigurationServiceIface) GetCniConfigurationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UserData, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCniConfigurationByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*UserData)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCniConfigurationByIDWithContext indicates an expected call of GetCniConfigurationByIDWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) GetCniConfigurationByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCniConfigurationByIDWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).GetCniConfigurationByIDWithContext), varargs...)
+}
+
 // GetCniConfigurationByName mocks base method.
 func (m *MockConfigurationServiceIface) GetCniConfigurationByName(name string, opts ...OptionFunc) (*UserData, int, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +153,27 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) GetCniConfigurationByName(n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCniConfigurationByName", reflect.TypeOf((*MockConfigurationServiceIface)(nil).GetCniConfigurationByName), varargs...)
 }
 
+// GetCniConfigurationByNameWithContext mocks base method.
+func (m *MockConfigurationServiceIface) GetCniConfigurationByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UserData, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCniConfigurationByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*UserData)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCniConfigurationByNameWithContext indicates an expected call of GetCniConfigurationByNameWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) GetCniConfigurationByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCniConfigurationByNameWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).GetCniConfigurationByNameWithContext), varargs...)
+}
+
 // GetCniConfigurationID mocks base method.
 func (m *MockConfigurationServiceIface) GetCniConfigurationID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +195,27 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) GetCniConfigurationID(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCniConfigurationID", reflect.TypeOf((*MockConfigurationServiceIface)(nil).GetCniConfigurationID), varargs...)
 }
 
+// GetCniConfigurationIDWithContext mocks base method.
+func (m *MockConfigurationServiceIface) GetCniConfigurationIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCniConfigurationIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCniConfigurationIDWithContext indicates an expected call of GetCniConfigurationIDWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) GetCniConfigurationIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCniConfigurationIDWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).GetCniConfigurationIDWithContext), varargs...)
+}
+
 // ListCapabilities mocks base method.
 func (m *MockConfigurationServiceIface) ListCapabilities(p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +231,21 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) ListCapabilities(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapabilities", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListCapabilities), p)
 }
 
+// ListCapabilitiesWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListCapabilitiesWithContext(ctx context.Context, p *ListCapabilitiesParams) (*ListCapabilitiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapabilitiesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListCapabilitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapabilitiesWithContext indicates an expected call of ListCapabilitiesWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListCapabilitiesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapabilitiesWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListCapabilitiesWithContext), ctx, p)
+}
+
 // ListCniConfiguration mocks base method.
 func (m *MockConfigurationServiceIface) ListCniConfiguration(p *ListCniConfigurationParams) (*ListCniConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +261,21 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) ListCniConfiguration(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCniConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListCniConfiguration), p)
 }
 
+// ListCniConfigurationWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListCniConfigurationWithContext(ctx context.Context, p *ListCniConfigurationParams) (*ListCniConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCniConfigurationWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListCniConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCniConfigurationWithContext indicates an expected call of ListCniConfigurationWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListCniConfigurationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCniConfigurationWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListCniConfigurationWithContext), ctx, p)
+}
+
 // ListConfigurationGroups mocks base method.
 func (m *MockConfigurationServiceIface) ListConfigurationGroups(p *ListConfigurationGroupsParams) (*ListConfigurationGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +291,99 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationGroups(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationGroups", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationGroups), p)
 }
 
+// ListConfigurationGroupsAll mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationGroupsAll(p *ListConfigurationGroupsParams, opts ...PageOption) ([]*ConfigurationGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationGroupsAll", varargs...)
+	ret0, _ := ret[0].([]*ConfigurationGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigurationGroupsAll indicates an expected call of ListConfigurationGroupsAll.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationGroupsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationGroupsAll", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationGroupsAll), varargs...)
+}
+
+// ListConfigurationGroupsAllWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationGroupsAllWithContext(ctx context.Context, p *ListConfigurationGroupsParams, opts ...PageOption) ([]*ConfigurationGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationGroupsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ConfigurationGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigurationGroupsAllWithContext indicates an expected call of ListConfigurationGroupsAllWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationGroupsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationGroupsAllWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationGroupsAllWithContext), varargs...)
+}
+
+// ListConfigurationGroupsIter mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationGroupsIter(p *ListConfigurationGroupsParams, opts ...PageOption) iter.Seq2[*ConfigurationGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationGroupsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ConfigurationGroup, error])
+	return ret0
+}
+
+// ListConfigurationGroupsIter indicates an expected call of ListConfigurationGroupsIter.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationGroupsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationGroupsIter", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationGroupsIter), varargs...)
+}
+
+// ListConfigurationGroupsIterWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationGroupsIterWithContext(ctx context.Context, p *ListConfigurationGroupsParams, opts ...PageOption) iter.Seq2[*ConfigurationGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationGroupsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ConfigurationGroup, error])
+	return ret0
+}
+
+// ListConfigurationGroupsIterWithContext indicates an expected call of ListConfigurationGroupsIterWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationGroupsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationGroupsIterWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationGroupsIterWithContext), varargs...)
+}
+
+// ListConfigurationGroupsWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationGroupsWithContext(ctx context.Context, p *ListConfigurationGroupsParams) (*ListConfigurationGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConfigurationGroupsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListConfigurationGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigurationGroupsWithContext indicates an expected call of ListConfigurationGroupsWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationGroupsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationGroupsWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationGroupsWithContext), ctx, p)
+}
+
 // ListConfigurations mocks base method.
 func (m *MockConfigurationServiceIface) ListConfigurations(p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +399,99 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurations(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurations", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurations), p)
 }
 
+// ListConfigurationsAll mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationsAll(p *ListConfigurationsParams, opts ...PageOption) ([]*Configuration, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationsAll", varargs...)
+	ret0, _ := ret[0].([]*Configuration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigurationsAll indicates an expected call of ListConfigurationsAll.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationsAll", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationsAll), varargs...)
+}
+
+// ListConfigurationsAllWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationsAllWithContext(ctx context.Context, p *ListConfigurationsParams, opts ...PageOption) ([]*Configuration, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Configuration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigurationsAllWithContext indicates an expected call of ListConfigurationsAllWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationsAllWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationsAllWithContext), varargs...)
+}
+
+// ListConfigurationsIter mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationsIter(p *ListConfigurationsParams, opts ...PageOption) iter.Seq2[*Configuration, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Configuration, error])
+	return ret0
+}
+
+// ListConfigurationsIter indicates an expected call of ListConfigurationsIter.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationsIter", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationsIter), varargs...)
+}
+
+// ListConfigurationsIterWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationsIterWithContext(ctx context.Context, p *ListConfigurationsParams, opts ...PageOption) iter.Seq2[*Configuration, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListConfigurationsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Configuration, error])
+	return ret0
+}
+
+// ListConfigurationsIterWithContext indicates an expected call of ListConfigurationsIterWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationsIterWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationsIterWithContext), varargs...)
+}
+
+// ListConfigurationsWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListConfigurationsWithContext(ctx context.Context, p *ListConfigurationsParams) (*ListConfigurationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListConfigurationsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListConfigurationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListConfigurationsWithContext indicates an expected call of ListConfigurationsWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListConfigurationsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListConfigurationsWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListConfigurationsWithContext), ctx, p)
+}
+
 // ListDeploymentPlanners mocks base method.
 func (m *MockConfigurationServiceIface) ListDeploymentPlanners(p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +507,99 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlanners(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlanners", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlanners), p)
 }
 
+// ListDeploymentPlannersAll mocks base method.
+func (m *MockConfigurationServiceIface) ListDeploymentPlannersAll(p *ListDeploymentPlannersParams, opts ...PageOption) ([]*DeploymentPlanner, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeploymentPlannersAll", varargs...)
+	ret0, _ := ret[0].([]*DeploymentPlanner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeploymentPlannersAll indicates an expected call of ListDeploymentPlannersAll.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlannersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlannersAll", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlannersAll), varargs...)
+}
+
+// ListDeploymentPlannersAllWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListDeploymentPlannersAllWithContext(ctx context.Context, p *ListDeploymentPlannersParams, opts ...PageOption) ([]*DeploymentPlanner, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeploymentPlannersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DeploymentPlanner)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeploymentPlannersAllWithContext indicates an expected call of ListDeploymentPlannersAllWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlannersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlannersAllWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlannersAllWithContext), varargs...)
+}
+
+// ListDeploymentPlannersIter mocks base method.
+func (m *MockConfigurationServiceIface) ListDeploymentPlannersIter(p *ListDeploymentPlannersParams, opts ...PageOption) iter.Seq2[*DeploymentPlanner, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeploymentPlannersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DeploymentPlanner, error])
+	return ret0
+}
+
+// ListDeploymentPlannersIter indicates an expected call of ListDeploymentPlannersIter.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlannersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlannersIter", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlannersIter), varargs...)
+}
+
+// ListDeploymentPlannersIterWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListDeploymentPlannersIterWithContext(ctx context.Context, p *ListDeploymentPlannersParams, opts ...PageOption) iter.Seq2[*DeploymentPlanner, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDeploymentPlannersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DeploymentPlanner, error])
+	return ret0
+}
+
+// ListDeploymentPlannersIterWithContext indicates an expected call of ListDeploymentPlannersIterWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlannersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlannersIterWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlannersIterWithContext), varargs...)
+}
+
+// ListDeploymentPlannersWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ListDeploymentPlannersWithContext(ctx context.Context, p *ListDeploymentPlannersParams) (*ListDeploymentPlannersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDeploymentPlannersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDeploymentPlannersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDeploymentPlannersWithContext indicates an expected call of ListDeploymentPlannersWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ListDeploymentPlannersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDeploymentPlannersWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ListDeploymentPlannersWithContext), ctx, p)
+}
+
 // NewDeleteCniConfigurationParams mocks base method.
 func (m *MockConfigurationServiceIface) NewDeleteCniConfigurationParams(id string) *DeleteCniConfigurationParams {
 	m.ctrl.T.Helper()
@@ -366,6 +755,21 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) RegisterCniConfiguration(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCniConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).RegisterCniConfiguration), p)
 }
 
+// RegisterCniConfigurationWithContext mocks base method.
+func (m *MockConfigurationServiceIface) RegisterCniConfigurationWithContext(ctx context.Context, p *RegisterCniConfigurationParams) (*RegisterCniConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterCniConfigurationWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterCniConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterCniConfigurationWithContext indicates an expected call of RegisterCniConfigurationWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) RegisterCniConfigurationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterCniConfigurationWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).RegisterCniConfigurationWithContext), ctx, p)
+}
+
 // ResetConfiguration mocks base method.
 func (m *MockConfigurationServiceIface) ResetConfiguration(p *ResetConfigurationParams) (*ResetConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -381,6 +785,21 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) ResetConfiguration(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ResetConfiguration), p)
 }
 
+// ResetConfigurationWithContext mocks base method.
+func (m *MockConfigurationServiceIface) ResetConfigurationWithContext(ctx context.Context, p *ResetConfigurationParams) (*ResetConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetConfigurationWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResetConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetConfigurationWithContext indicates an expected call of ResetConfigurationWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) ResetConfigurationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetConfigurationWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).ResetConfigurationWithContext), ctx, p)
+}
+
 // UpdateConfiguration mocks base method.
 func (m *MockConfigurationServiceIface) UpdateConfiguration(p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -396,6 +815,21 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) UpdateConfiguration(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfiguration", reflect.TypeOf((*MockConfigurationServiceIface)(nil).UpdateConfiguration), p)
 }
 
+// UpdateConfigurationWithContext mocks base method.
+func (m *MockConfigurationServiceIface) UpdateConfigurationWithContext(ctx context.Context, p *UpdateConfigurationParams) (*UpdateConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateConfigurationWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateConfigurationWithContext indicates an expected call of UpdateConfigurationWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) UpdateConfigurationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateConfigurationWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).UpdateConfigurationWithContext), ctx, p)
+}
+
 // UpdateStorageCapabilities mocks base method.
 func (m *MockConfigurationServiceIface) UpdateStorageCapabilities(p *UpdateStorageCapabilitiesParams) (*UpdateStorageCapabilitiesResponse, error) {
 	m.ctrl.T.Helper()
@@ -410,3 +844,18 @@ func (mr *MockConfigurationServiceIfaceMockRecorder) UpdateStorageCapabilities(p
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageCapabilities", reflect.TypeOf((*MockConfigurationServiceIface)(nil).UpdateStorageCapabilities), p)
 }
+
+// UpdateStorageCapabilitiesWithContext mocks base method.
+func (m *MockConfigurationServiceIface) UpdateStorageCapabilitiesWithContext(ctx context.Context, p *UpdateStorageCapabilitiesParams) (*UpdateStorageCapabilitiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStorageCapabilitiesWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateStorageCapabilitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStorageCapabilitiesWithContext indicates an expected call of UpdateStorageCapabilitiesWithContext.
+func (mr *MockConfigurationServiceIfaceMockRecorder) UpdateStorageCapabilitiesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageCapabilitiesWithContext", reflect.TypeOf((*MockConfigurationServiceIface)(nil).UpdateStorageCapabilitiesWithContext), ctx, p)
+}
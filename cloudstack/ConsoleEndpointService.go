@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -27,6 +28,7 @@ import (
 
 type ConsoleEndpointServiceIface interface {
 	CreateConsoleEndpoint(p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error)
+	CreateConsoleEndpointWithContext(ctx context.Context, p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error)
 	NewCreateConsoleEndpointParams(virtualmachineid string) *CreateConsoleEndpointParams
 }
 
@@ -48,6 +50,45 @@ func (p *CreateConsoleEndpointParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createConsoleEndpoint API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateConsoleEndpointParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createConsoleEndpoint", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createConsoleEndpoint API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateConsoleEndpointParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"token": 255, "virtualmachineid": 255},
+	}.validate("createConsoleEndpoint", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createConsoleEndpoint API, before the request is signed
+func (p *CreateConsoleEndpointParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateConsoleEndpointParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateConsoleEndpointParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createConsoleEndpoint", b, &p.p, map[string]paramDecoder{
+		"token":            decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateConsoleEndpointParams) Clone() *CreateConsoleEndpointParams {
+	return &CreateConsoleEndpointParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateConsoleEndpointParams) SetToken(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -101,7 +142,16 @@ func (s *ConsoleEndpointService) NewCreateConsoleEndpointParams(virtualmachineid
 
 // Create a console endpoint to connect to a Instance console
 func (s *ConsoleEndpointService) CreateConsoleEndpoint(p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error) {
-	resp, err := s.cs.newPostRequest("createConsoleEndpoint", p.toURLValues())
+	return s.CreateConsoleEndpointWithContext(context.Background(), p)
+}
+
+// CreateConsoleEndpointWithContext is like CreateConsoleEndpoint, but honours the cancellation and deadline of ctx
+func (s *ConsoleEndpointService) CreateConsoleEndpointWithContext(ctx context.Context, p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createConsoleEndpoint", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -109,7 +159,7 @@ func (s *ConsoleEndpointService) CreateConsoleEndpoint(p *CreateConsoleEndpointP
 	var nested struct {
 		Response CreateConsoleEndpointResponse `json:"consoleendpoint"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("createConsoleEndpoint", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -118,12 +168,13 @@ func (s *ConsoleEndpointService) CreateConsoleEndpoint(p *CreateConsoleEndpointP
 }
 
 type CreateConsoleEndpointResponse struct {
-	Details   string                 `json:"details"`
-	JobID     string                 `json:"jobid"`
-	Jobstatus int                    `json:"jobstatus"`
-	Success   bool                   `json:"success"`
-	Url       string                 `json:"url"`
-	Websocket map[string]interface{} `json:"websocket"`
+	Details   string                     `json:"details"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Success   bool                       `json:"success"`
+	Url       string                     `json:"url"`
+	Websocket map[string]interface{}     `json:"websocket"`
+	Extra     map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CreateConsoleEndpointResponse) UnmarshalJSON(b []byte) error {
@@ -150,5 +201,5 @@ func (r *CreateConsoleEndpointResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CreateConsoleEndpointResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
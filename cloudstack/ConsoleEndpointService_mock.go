@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockConsoleEndpointServiceIfaceMockRecorder) CreateConsoleEndpoint(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsoleEndpoint", reflect.TypeOf((*MockConsoleEndpointServiceIface)(nil).CreateConsoleEndpoint), p)
 }
 
+// CreateConsoleEndpointWithContext mocks base method.
+func (m *MockConsoleEndpointServiceIface) CreateConsoleEndpointWithContext(ctx context.Context, p *CreateConsoleEndpointParams) (*CreateConsoleEndpointResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateConsoleEndpointWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateConsoleEndpointResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateConsoleEndpointWithContext indicates an expected call of CreateConsoleEndpointWithContext.
+func (mr *MockConsoleEndpointServiceIfaceMockRecorder) CreateConsoleEndpointWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateConsoleEndpointWithContext", reflect.TypeOf((*MockConsoleEndpointServiceIface)(nil).CreateConsoleEndpointWithContext), ctx, p)
+}
+
 // NewCreateConsoleEndpointParams mocks base method.
 func (m *MockConsoleEndpointServiceIface) NewCreateConsoleEndpointParams(virtualmachineid string) *CreateConsoleEndpointParams {
 	m.ctrl.T.Helper()
@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -77,7 +78,11 @@ func (p *CustomServiceParams) GetParam(param string) (interface{}, bool) {
 }
 
 func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {
-	resp, err := s.cs.newPostRequest(api, p.toURLValues())
+	return s.CustomRequestWithContext(context.Background(), api, p, result)
+}
+
+func (s *CustomService) CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {
+	resp, err := s.cs.newPostRequest(ctx, api, p.toURLValues())
 	if err != nil {
 		return err
 	}
@@ -85,7 +90,11 @@ func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result
 	return json.Unmarshal(resp, result)
 }
 func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, result interface{}) error {
-	resp, err := s.cs.newPostRequest(api, p.toURLValues())
+	return s.CustomPostRequestWithContext(context.Background(), api, p, result)
+}
+
+func (s *CustomService) CustomPostRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {
+	resp, err := s.cs.newPostRequest(ctx, api, p.toURLValues())
 	if err != nil {
 		return err
 	}
@@ -94,4 +103,9 @@ func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, re
 }
 
 type CustomServiceIface interface {
+	CustomRequest(api string, p *CustomServiceParams, result interface{}) error
+	CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error
+	CustomPostRequest(api string, p *CustomServiceParams, result interface{}) error
+	CustomPostRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error
+	Call(ctx context.Context, command string, params map[string]any) (*DynamicResult, error)
 }
@@ -29,6 +29,9 @@
 package cloudstack
 
 import (
+	context "context"
+	reflect "reflect"
+
 	gomock "go.uber.org/mock/gomock"
 )
 
@@ -55,3 +58,74 @@ func NewMockCustomServiceIface(ctrl *gomock.Controller) *MockCustomServiceIface
 func (m *MockCustomServiceIface) EXPECT() *MockCustomServiceIfaceMockRecorder {
 	return m.recorder
 }
+
+// Call mocks base method.
+func (m *MockCustomServiceIface) Call(ctx context.Context, command string, params map[string]any) (*DynamicResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Call", ctx, command, params)
+	ret0, _ := ret[0].(*DynamicResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Call indicates an expected call of Call.
+func (mr *MockCustomServiceIfaceMockRecorder) Call(ctx, command, params any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Call", reflect.TypeOf((*MockCustomServiceIface)(nil).Call), ctx, command, params)
+}
+
+// CustomPostRequest mocks base method.
+func (m *MockCustomServiceIface) CustomPostRequest(api string, p *CustomServiceParams, result interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomPostRequest", api, p, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomPostRequest indicates an expected call of CustomPostRequest.
+func (mr *MockCustomServiceIfaceMockRecorder) CustomPostRequest(api, p, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomPostRequest", reflect.TypeOf((*MockCustomServiceIface)(nil).CustomPostRequest), api, p, result)
+}
+
+// CustomPostRequestWithContext mocks base method.
+func (m *MockCustomServiceIface) CustomPostRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomPostRequestWithContext", ctx, api, p, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomPostRequestWithContext indicates an expected call of CustomPostRequestWithContext.
+func (mr *MockCustomServiceIfaceMockRecorder) CustomPostRequestWithContext(ctx, api, p, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomPostRequestWithContext", reflect.TypeOf((*MockCustomServiceIface)(nil).CustomPostRequestWithContext), ctx, api, p, result)
+}
+
+// CustomRequest mocks base method.
+func (m *MockCustomServiceIface) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomRequest", api, p, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomRequest indicates an expected call of CustomRequest.
+func (mr *MockCustomServiceIfaceMockRecorder) CustomRequest(api, p, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomRequest", reflect.TypeOf((*MockCustomServiceIface)(nil).CustomRequest), api, p, result)
+}
+
+// CustomRequestWithContext mocks base method.
+func (m *MockCustomServiceIface) CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CustomRequestWithContext", ctx, api, p, result)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// CustomRequestWithContext indicates an expected call of CustomRequestWithContext.
+func (mr *MockCustomServiceIfaceMockRecorder) CustomRequestWithContext(ctx, api, p, result any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CustomRequestWithContext", reflect.TypeOf((*MockCustomServiceIface)(nil).CustomRequestWithContext), ctx, api, p, result)
+}
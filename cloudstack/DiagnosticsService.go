@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strings"
@@ -27,8 +28,12 @@ import (
 
 type DiagnosticsServiceIface interface {
 	GetDiagnosticsData(p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error)
+	GetDiagnosticsDataWithContext(ctx context.Context, p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error)
+	GetDiagnosticsDataAsync(ctx context.Context, p *GetDiagnosticsDataParams) (*Job, error)
 	NewGetDiagnosticsDataParams(targetid string) *GetDiagnosticsDataParams
 	RunDiagnostics(p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error)
+	RunDiagnosticsWithContext(ctx context.Context, p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error)
+	RunDiagnosticsAsync(ctx context.Context, p *RunDiagnosticsParams) (*Job, error)
 	NewRunDiagnosticsParams(ipaddress string, targetid string, diagnosticsType string) *RunDiagnosticsParams
 }
 
@@ -51,6 +56,45 @@ func (p *GetDiagnosticsDataParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getDiagnosticsData API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetDiagnosticsDataParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getDiagnosticsData", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getDiagnosticsData API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetDiagnosticsDataParams) Validate() error {
+	return paramRules{
+		required: []string{"targetid"},
+		lengths:  map[string]int{"targetid": 255},
+	}.validate("getDiagnosticsData", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getDiagnosticsData API, before the request is signed
+func (p *GetDiagnosticsDataParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetDiagnosticsDataParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetDiagnosticsDataParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getDiagnosticsData", b, &p.p, map[string]paramDecoder{
+		"files":    decodeParam[[]string],
+		"targetid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetDiagnosticsDataParams) Clone() *GetDiagnosticsDataParams {
+	return &GetDiagnosticsDataParams{p: cloneParams(p.p)}
+}
+
 func (p *GetDiagnosticsDataParams) SetFiles(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -104,32 +148,39 @@ func (s *DiagnosticsService) NewGetDiagnosticsDataParams(targetid string) *GetDi
 
 // Get diagnostics and files from System VMs
 func (s *DiagnosticsService) GetDiagnosticsData(p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error) {
-	resp, err := s.cs.newRequest("getDiagnosticsData", p.toURLValues())
+	return s.GetDiagnosticsDataWithContext(context.Background(), p)
+}
+
+// GetDiagnosticsDataWithContext is like GetDiagnosticsData, but honours the cancellation and deadline of ctx
+func (s *DiagnosticsService) GetDiagnosticsDataWithContext(ctx context.Context, p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newRequest(ctx, "getDiagnosticsData", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetDiagnosticsDataResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getDiagnosticsData", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "getDiagnosticsData", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("getDiagnosticsData", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -137,10 +188,35 @@ func (s *DiagnosticsService) GetDiagnosticsData(p *GetDiagnosticsDataParams) (*G
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished GetDiagnosticsData async job into r
+func (r *GetDiagnosticsDataResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// GetDiagnosticsDataAsync starts the async job of GetDiagnosticsData without waiting for it to finish, and returns a handle to the job
+func (s *DiagnosticsService) GetDiagnosticsDataAsync(ctx context.Context, p *GetDiagnosticsDataParams) (*Job, error) {
+	r, err := s.GetDiagnosticsDataWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("getDiagnosticsData", r.JobID), nil
+}
+
 type GetDiagnosticsDataResponse struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Url       string `json:"url"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Url       string                     `json:"url"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetDiagnosticsDataResponse) UnmarshalJSON(b []byte) error {
+	type alias GetDiagnosticsDataResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RunDiagnosticsParams struct {
@@ -167,6 +243,47 @@ func (p *RunDiagnosticsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the runDiagnostics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RunDiagnosticsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("runDiagnostics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the runDiagnostics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RunDiagnosticsParams) Validate() error {
+	return paramRules{
+		required: []string{"ipaddress", "targetid", "type"},
+		lengths:  map[string]int{"ipaddress": 255, "params": 255, "targetid": 255, "type": 255},
+	}.validate("runDiagnostics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the runDiagnostics API, before the request is signed
+func (p *RunDiagnosticsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RunDiagnosticsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RunDiagnosticsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("runDiagnostics", b, &p.p, map[string]paramDecoder{
+		"ipaddress": decodeParam[string],
+		"params":    decodeParam[string],
+		"targetid":  decodeParam[string],
+		"type":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RunDiagnosticsParams) Clone() *RunDiagnosticsParams {
+	return &RunDiagnosticsParams{p: cloneParams(p.p)}
+}
+
 func (p *RunDiagnosticsParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -264,32 +381,39 @@ func (s *DiagnosticsService) NewRunDiagnosticsParams(ipaddress string, targetid
 
 // Execute network-utility command (ping/arping/tracert) on System VMs remotely
 func (s *DiagnosticsService) RunDiagnostics(p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error) {
-	resp, err := s.cs.newPostRequest("runDiagnostics", p.toURLValues())
+	return s.RunDiagnosticsWithContext(context.Background(), p)
+}
+
+// RunDiagnosticsWithContext is like RunDiagnostics, but honours the cancellation and deadline of ctx
+func (s *DiagnosticsService) RunDiagnosticsWithContext(ctx context.Context, p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "runDiagnostics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RunDiagnosticsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("runDiagnostics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "runDiagnostics", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("runDiagnostics", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -297,10 +421,35 @@ func (s *DiagnosticsService) RunDiagnostics(p *RunDiagnosticsParams) (*RunDiagno
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RunDiagnostics async job into r
+func (r *RunDiagnosticsResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RunDiagnosticsAsync starts the async job of RunDiagnostics without waiting for it to finish, and returns a handle to the job
+func (s *DiagnosticsService) RunDiagnosticsAsync(ctx context.Context, p *RunDiagnosticsParams) (*Job, error) {
+	r, err := s.RunDiagnosticsWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("runDiagnostics", r.JobID), nil
+}
+
 type RunDiagnosticsResponse struct {
-	Exitcode  string `json:"exitcode"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Stderr    string `json:"stderr"`
-	Stdout    string `json:"stdout"`
+	Exitcode  string                     `json:"exitcode"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Stderr    string                     `json:"stderr"`
+	Stdout    string                     `json:"stdout"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RunDiagnosticsResponse) UnmarshalJSON(b []byte) error {
+	type alias RunDiagnosticsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
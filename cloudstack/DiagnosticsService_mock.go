@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,36 @@ func (mr *MockDiagnosticsServiceIfaceMockRecorder) GetDiagnosticsData(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiagnosticsData", reflect.TypeOf((*MockDiagnosticsServiceIface)(nil).GetDiagnosticsData), p)
 }
 
+// GetDiagnosticsDataAsync mocks base method.
+func (m *MockDiagnosticsServiceIface) GetDiagnosticsDataAsync(ctx context.Context, p *GetDiagnosticsDataParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiagnosticsDataAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiagnosticsDataAsync indicates an expected call of GetDiagnosticsDataAsync.
+func (mr *MockDiagnosticsServiceIfaceMockRecorder) GetDiagnosticsDataAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiagnosticsDataAsync", reflect.TypeOf((*MockDiagnosticsServiceIface)(nil).GetDiagnosticsDataAsync), ctx, p)
+}
+
+// GetDiagnosticsDataWithContext mocks base method.
+func (m *MockDiagnosticsServiceIface) GetDiagnosticsDataWithContext(ctx context.Context, p *GetDiagnosticsDataParams) (*GetDiagnosticsDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetDiagnosticsDataWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetDiagnosticsDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetDiagnosticsDataWithContext indicates an expected call of GetDiagnosticsDataWithContext.
+func (mr *MockDiagnosticsServiceIfaceMockRecorder) GetDiagnosticsDataWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiagnosticsDataWithContext", reflect.TypeOf((*MockDiagnosticsServiceIface)(nil).GetDiagnosticsDataWithContext), ctx, p)
+}
+
 // NewGetDiagnosticsDataParams mocks base method.
 func (m *MockDiagnosticsServiceIface) NewGetDiagnosticsDataParams(targetid string) *GetDiagnosticsDataParams {
 	m.ctrl.T.Helper()
@@ -115,3 +146,33 @@ func (mr *MockDiagnosticsServiceIfaceMockRecorder) RunDiagnostics(p any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDiagnostics", reflect.TypeOf((*MockDiagnosticsServiceIface)(nil).RunDiagnostics), p)
 }
+
+// RunDiagnosticsAsync mocks base method.
+func (m *MockDiagnosticsServiceIface) RunDiagnosticsAsync(ctx context.Context, p *RunDiagnosticsParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunDiagnosticsAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDiagnosticsAsync indicates an expected call of RunDiagnosticsAsync.
+func (mr *MockDiagnosticsServiceIfaceMockRecorder) RunDiagnosticsAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDiagnosticsAsync", reflect.TypeOf((*MockDiagnosticsServiceIface)(nil).RunDiagnosticsAsync), ctx, p)
+}
+
+// RunDiagnosticsWithContext mocks base method.
+func (m *MockDiagnosticsServiceIface) RunDiagnosticsWithContext(ctx context.Context, p *RunDiagnosticsParams) (*RunDiagnosticsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunDiagnosticsWithContext", ctx, p)
+	ret0, _ := ret[0].(*RunDiagnosticsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunDiagnosticsWithContext indicates an expected call of RunDiagnosticsWithContext.
+func (mr *MockDiagnosticsServiceIfaceMockRecorder) RunDiagnosticsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunDiagnosticsWithContext", reflect.TypeOf((*MockDiagnosticsServiceIface)(nil).RunDiagnosticsWithContext), ctx, p)
+}
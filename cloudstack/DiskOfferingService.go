@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,15 +31,26 @@ import (
 
 type DiskOfferingServiceIface interface {
 	CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
+	CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error)
 	NewCreateDiskOfferingParams(displaytext string, name string) *CreateDiskOfferingParams
 	DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
+	DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error)
 	NewDeleteDiskOfferingParams(id string) *DeleteDiskOfferingParams
 	ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error)
+	ListDiskOfferingsAll(p *ListDiskOfferingsParams, opts ...PageOption) ([]*DiskOffering, error)
+	ListDiskOfferingsAllWithContext(ctx context.Context, p *ListDiskOfferingsParams, opts ...PageOption) ([]*DiskOffering, error)
+	ListDiskOfferingsIter(p *ListDiskOfferingsParams, opts ...PageOption) iter.Seq2[*DiskOffering, error]
+	ListDiskOfferingsIterWithContext(ctx context.Context, p *ListDiskOfferingsParams, opts ...PageOption) iter.Seq2[*DiskOffering, error]
 	NewListDiskOfferingsParams() *ListDiskOfferingsParams
 	GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetDiskOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DiskOffering, int, error)
 	GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error)
+	GetDiskOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DiskOffering, int, error)
 	UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
+	UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error)
 	NewUpdateDiskOfferingParams(id string) *UpdateDiskOfferingParams
 }
 
@@ -172,6 +185,74 @@ func (p *CreateDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createDiskOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateDiskOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createDiskOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createDiskOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateDiskOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "name"},
+		lengths:  map[string]int{"cachemode": 255, "displaytext": 4096, "name": 255, "provisioningtype": 255, "storagepolicy": 255, "storagetype": 255, "tags": 4096},
+	}.validate("createDiskOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createDiskOffering API, before the request is signed
+func (p *CreateDiskOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createDiskOffering", b, &p.p, map[string]paramDecoder{
+		"bytesreadrate":             decodeParam[int64],
+		"bytesreadratemax":          decodeParam[int64],
+		"bytesreadratemaxlength":    decodeParam[int64],
+		"byteswriterate":            decodeParam[int64],
+		"byteswriteratemax":         decodeParam[int64],
+		"byteswriteratemaxlength":   decodeParam[int64],
+		"cachemode":                 decodeParam[string],
+		"customized":                decodeParam[bool],
+		"customizediops":            decodeParam[bool],
+		"details":                   decodeParam[map[string]string],
+		"disksize":                  decodeParam[int64],
+		"disksizestrictness":        decodeParam[bool],
+		"displayoffering":           decodeParam[bool],
+		"displaytext":               decodeParam[string],
+		"domainid":                  decodeParam[[]string],
+		"encrypt":                   decodeParam[bool],
+		"hypervisorsnapshotreserve": decodeParam[int],
+		"iopsreadrate":              decodeParam[int64],
+		"iopsreadratemax":           decodeParam[int64],
+		"iopsreadratemaxlength":     decodeParam[int64],
+		"iopswriterate":             decodeParam[int64],
+		"iopswriteratemax":          decodeParam[int64],
+		"iopswriteratemaxlength":    decodeParam[int64],
+		"maxiops":                   decodeParam[int64],
+		"miniops":                   decodeParam[int64],
+		"name":                      decodeParam[string],
+		"provisioningtype":          decodeParam[string],
+		"storagepolicy":             decodeParam[string],
+		"storagetype":               decodeParam[string],
+		"tags":                      decodeParam[string],
+		"zoneid":                    decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateDiskOfferingParams) Clone() *CreateDiskOfferingParams {
+	return &CreateDiskOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateDiskOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -835,7 +916,16 @@ func (s *DiskOfferingService) NewCreateDiskOfferingParams(displaytext string, na
 
 // Creates a disk offering.
 func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("createDiskOffering", p.toURLValues())
+	return s.CreateDiskOfferingWithContext(context.Background(), p)
+}
+
+// CreateDiskOfferingWithContext is like CreateDiskOffering, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createDiskOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -845,7 +935,7 @@ func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*
 	}
 
 	var r CreateDiskOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createDiskOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -853,46 +943,52 @@ func (s *DiskOfferingService) CreateDiskOffering(p *CreateDiskOfferingParams) (*
 }
 
 type CreateDiskOfferingResponse struct {
-	CacheMode                   string            `json:"cacheMode"`
-	Created                     string            `json:"created"`
-	Details                     map[string]string `json:"details"`
-	DiskBytesReadRate           int64             `json:"diskBytesReadRate"`
-	DiskBytesReadRateMax        int64             `json:"diskBytesReadRateMax"`
-	DiskBytesReadRateMaxLength  int64             `json:"diskBytesReadRateMaxLength"`
-	DiskBytesWriteRate          int64             `json:"diskBytesWriteRate"`
-	DiskBytesWriteRateMax       int64             `json:"diskBytesWriteRateMax"`
-	DiskBytesWriteRateMaxLength int64             `json:"diskBytesWriteRateMaxLength"`
-	DiskIopsReadRate            int64             `json:"diskIopsReadRate"`
-	DiskIopsReadRateMax         int64             `json:"diskIopsReadRateMax"`
-	DiskIopsReadRateMaxLength   int64             `json:"diskIopsReadRateMaxLength"`
-	DiskIopsWriteRate           int64             `json:"diskIopsWriteRate"`
-	DiskIopsWriteRateMax        int64             `json:"diskIopsWriteRateMax"`
-	DiskIopsWriteRateMaxLength  int64             `json:"diskIopsWriteRateMaxLength"`
-	Disksize                    int64             `json:"disksize"`
-	Disksizestrictness          bool              `json:"disksizestrictness"`
-	Displayoffering             bool              `json:"displayoffering"`
-	Displaytext                 string            `json:"displaytext"`
-	Domain                      string            `json:"domain"`
-	Domainid                    string            `json:"domainid"`
-	Encrypt                     bool              `json:"encrypt"`
-	Hasannotations              bool              `json:"hasannotations"`
-	Hypervisorsnapshotreserve   int               `json:"hypervisorsnapshotreserve"`
-	Id                          string            `json:"id"`
-	Iscustomized                bool              `json:"iscustomized"`
-	Iscustomizediops            bool              `json:"iscustomizediops"`
-	JobID                       string            `json:"jobid"`
-	Jobstatus                   int               `json:"jobstatus"`
-	Maxiops                     int64             `json:"maxiops"`
-	Miniops                     int64             `json:"miniops"`
-	Name                        string            `json:"name"`
-	Provisioningtype            string            `json:"provisioningtype"`
-	State                       string            `json:"state"`
-	Storagetype                 string            `json:"storagetype"`
-	Suitableforvirtualmachine   bool              `json:"suitableforvirtualmachine"`
-	Tags                        string            `json:"tags"`
-	Vspherestoragepolicy        string            `json:"vspherestoragepolicy"`
-	Zone                        string            `json:"zone"`
-	Zoneid                      string            `json:"zoneid"`
+	CacheMode                   string                     `json:"cacheMode"`
+	Created                     Time                       `json:"created"`
+	Details                     map[string]string          `json:"details"`
+	DiskBytesReadRate           FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesReadRateMax        FlexInt64                  `json:"diskBytesReadRateMax"`
+	DiskBytesReadRateMaxLength  FlexInt64                  `json:"diskBytesReadRateMaxLength"`
+	DiskBytesWriteRate          FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskBytesWriteRateMax       FlexInt64                  `json:"diskBytesWriteRateMax"`
+	DiskBytesWriteRateMaxLength FlexInt64                  `json:"diskBytesWriteRateMaxLength"`
+	DiskIopsReadRate            FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsReadRateMax         FlexInt64                  `json:"diskIopsReadRateMax"`
+	DiskIopsReadRateMaxLength   FlexInt64                  `json:"diskIopsReadRateMaxLength"`
+	DiskIopsWriteRate           FlexInt64                  `json:"diskIopsWriteRate"`
+	DiskIopsWriteRateMax        FlexInt64                  `json:"diskIopsWriteRateMax"`
+	DiskIopsWriteRateMaxLength  FlexInt64                  `json:"diskIopsWriteRateMaxLength"`
+	Disksize                    FlexInt64                  `json:"disksize"`
+	Disksizestrictness          FlexBool                   `json:"disksizestrictness"`
+	Displayoffering             FlexBool                   `json:"displayoffering"`
+	Displaytext                 string                     `json:"displaytext"`
+	Domain                      string                     `json:"domain"`
+	Domainid                    string                     `json:"domainid"`
+	Encrypt                     FlexBool                   `json:"encrypt"`
+	Hasannotations              FlexBool                   `json:"hasannotations"`
+	Hypervisorsnapshotreserve   FlexInt                    `json:"hypervisorsnapshotreserve"`
+	Id                          string                     `json:"id"`
+	Iscustomized                FlexBool                   `json:"iscustomized"`
+	Iscustomizediops            FlexBool                   `json:"iscustomizediops"`
+	JobID                       string                     `json:"jobid"`
+	Jobstatus                   FlexInt                    `json:"jobstatus"`
+	Maxiops                     FlexInt64                  `json:"maxiops"`
+	Miniops                     FlexInt64                  `json:"miniops"`
+	Name                        string                     `json:"name"`
+	Provisioningtype            string                     `json:"provisioningtype"`
+	State                       string                     `json:"state"`
+	Storagetype                 string                     `json:"storagetype"`
+	Suitableforvirtualmachine   FlexBool                   `json:"suitableforvirtualmachine"`
+	Tags                        string                     `json:"tags"`
+	Vspherestoragepolicy        string                     `json:"vspherestoragepolicy"`
+	Zone                        string                     `json:"zone"`
+	Zoneid                      string                     `json:"zoneid"`
+	Extra                       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateDiskOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateDiskOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteDiskOfferingParams struct {
@@ -910,6 +1006,44 @@ func (p *DeleteDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteDiskOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteDiskOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteDiskOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteDiskOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteDiskOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteDiskOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteDiskOffering API, before the request is signed
+func (p *DeleteDiskOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteDiskOffering", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteDiskOfferingParams) Clone() *DeleteDiskOfferingParams {
+	return &DeleteDiskOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteDiskOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -942,13 +1076,22 @@ func (s *DiskOfferingService) NewDeleteDiskOfferingParams(id string) *DeleteDisk
 
 // Updates a disk offering.
 func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteDiskOffering", p.toURLValues())
+	return s.DeleteDiskOfferingWithContext(context.Background(), p)
+}
+
+// DeleteDiskOfferingWithContext is like DeleteDiskOffering, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteDiskOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteDiskOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteDiskOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -956,10 +1099,11 @@ func (s *DiskOfferingService) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*
 }
 
 type DeleteDiskOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteDiskOfferingResponse) UnmarshalJSON(b []byte) error {
@@ -986,7 +1130,7 @@ func (r *DeleteDiskOfferingResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteDiskOfferingResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDiskOfferingsParams struct {
@@ -1057,6 +1201,59 @@ func (p *ListDiskOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDiskOfferings API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDiskOfferingsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDiskOfferings", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDiskOfferings API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDiskOfferingsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "state": 255, "storageid": 255, "storagetype": 255, "virtualmachineid": 255, "volumeid": 255, "zoneid": 255},
+	}.validate("listDiskOfferings", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDiskOfferings API, before the request is signed
+func (p *ListDiskOfferingsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDiskOfferingsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDiskOfferingsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDiskOfferings", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"encrypt":          decodeParam[bool],
+		"id":               decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"name":             decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"state":            decodeParam[string],
+		"storageid":        decodeParam[string],
+		"storagetype":      decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+		"volumeid":         decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDiskOfferingsParams) Clone() *ListDiskOfferingsParams {
+	return &ListDiskOfferingsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDiskOfferingsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1424,6 +1621,11 @@ func (s *DiskOfferingService) NewListDiskOfferingsParams() *ListDiskOfferingsPar
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetDiskOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetDiskOfferingIDWithContext is like GetDiskOfferingID, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) GetDiskOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDiskOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1435,7 +1637,7 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListDiskOfferings(p)
+	l, err := s.ListDiskOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1460,12 +1662,17 @@ func (s *DiskOfferingService) GetDiskOfferingID(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error) {
-	id, count, err := s.GetDiskOfferingID(name, opts...)
+	return s.GetDiskOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetDiskOfferingByNameWithContext is like GetDiskOfferingByName, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) GetDiskOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	id, count, err := s.GetDiskOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetDiskOfferingByID(id, opts...)
+	r, count, err := s.GetDiskOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1474,6 +1681,11 @@ func (s *DiskOfferingService) GetDiskOfferingByName(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	return s.GetDiskOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDiskOfferingByIDWithContext is like GetDiskOfferingByID, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) GetDiskOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DiskOffering, int, error) {
 	p := &ListDiskOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1485,11 +1697,10 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListDiskOfferings(p)
+	l, err := s.ListDiskOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1507,65 +1718,108 @@ func (s *DiskOfferingService) GetDiskOfferingByID(id string, opts ...OptionFunc)
 
 // Lists all available disk offerings.
 func (s *DiskOfferingService) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listDiskOfferings", p.toURLValues())
+	return s.ListDiskOfferingsWithContext(context.Background(), p)
+}
+
+// ListDiskOfferingsWithContext is like ListDiskOfferings, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDiskOfferings", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDiskOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDiskOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDiskOfferingsAll returns all DiskOfferings matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *DiskOfferingService) ListDiskOfferingsAll(p *ListDiskOfferingsParams, opts ...PageOption) ([]*DiskOffering, error) {
+	return s.ListDiskOfferingsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDiskOfferingsAllWithContext is like ListDiskOfferingsAll, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) ListDiskOfferingsAllWithContext(ctx context.Context, p *ListDiskOfferingsParams, opts ...PageOption) ([]*DiskOffering, error) {
+	return collectPages(s.ListDiskOfferingsIterWithContext(ctx, p, opts...))
+}
+
+// ListDiskOfferingsIter returns an iterator over all DiskOfferings matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *DiskOfferingService) ListDiskOfferingsIter(p *ListDiskOfferingsParams, opts ...PageOption) iter.Seq2[*DiskOffering, error] {
+	return s.ListDiskOfferingsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDiskOfferingsIterWithContext is like ListDiskOfferingsIter, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) ListDiskOfferingsIterWithContext(ctx context.Context, p *ListDiskOfferingsParams, opts ...PageOption) iter.Seq2[*DiskOffering, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DiskOffering, int, error) {
+		l, err := s.ListDiskOfferingsWithContext(ctx, &ListDiskOfferingsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DiskOfferings, l.Count, nil
+	}, opts...)
+}
+
 type ListDiskOfferingsResponse struct {
 	Count         int             `json:"count"`
 	DiskOfferings []*DiskOffering `json:"diskoffering"`
 }
 
 type DiskOffering struct {
-	CacheMode                   string            `json:"cacheMode"`
-	Created                     string            `json:"created"`
-	Details                     map[string]string `json:"details"`
-	DiskBytesReadRate           int64             `json:"diskBytesReadRate"`
-	DiskBytesReadRateMax        int64             `json:"diskBytesReadRateMax"`
-	DiskBytesReadRateMaxLength  int64             `json:"diskBytesReadRateMaxLength"`
-	DiskBytesWriteRate          int64             `json:"diskBytesWriteRate"`
-	DiskBytesWriteRateMax       int64             `json:"diskBytesWriteRateMax"`
-	DiskBytesWriteRateMaxLength int64             `json:"diskBytesWriteRateMaxLength"`
-	DiskIopsReadRate            int64             `json:"diskIopsReadRate"`
-	DiskIopsReadRateMax         int64             `json:"diskIopsReadRateMax"`
-	DiskIopsReadRateMaxLength   int64             `json:"diskIopsReadRateMaxLength"`
-	DiskIopsWriteRate           int64             `json:"diskIopsWriteRate"`
-	DiskIopsWriteRateMax        int64             `json:"diskIopsWriteRateMax"`
-	DiskIopsWriteRateMaxLength  int64             `json:"diskIopsWriteRateMaxLength"`
-	Disksize                    int64             `json:"disksize"`
-	Disksizestrictness          bool              `json:"disksizestrictness"`
-	Displayoffering             bool              `json:"displayoffering"`
-	Displaytext                 string            `json:"displaytext"`
-	Domain                      string            `json:"domain"`
-	Domainid                    string            `json:"domainid"`
-	Encrypt                     bool              `json:"encrypt"`
-	Hasannotations              bool              `json:"hasannotations"`
-	Hypervisorsnapshotreserve   int               `json:"hypervisorsnapshotreserve"`
-	Id                          string            `json:"id"`
-	Iscustomized                bool              `json:"iscustomized"`
-	Iscustomizediops            bool              `json:"iscustomizediops"`
-	JobID                       string            `json:"jobid"`
-	Jobstatus                   int               `json:"jobstatus"`
-	Maxiops                     int64             `json:"maxiops"`
-	Miniops                     int64             `json:"miniops"`
-	Name                        string            `json:"name"`
-	Provisioningtype            string            `json:"provisioningtype"`
-	State                       string            `json:"state"`
-	Storagetype                 string            `json:"storagetype"`
-	Suitableforvirtualmachine   bool              `json:"suitableforvirtualmachine"`
-	Tags                        string            `json:"tags"`
-	Vspherestoragepolicy        string            `json:"vspherestoragepolicy"`
-	Zone                        string            `json:"zone"`
-	Zoneid                      string            `json:"zoneid"`
+	CacheMode                   string                     `json:"cacheMode"`
+	Created                     Time                       `json:"created"`
+	Details                     map[string]string          `json:"details"`
+	DiskBytesReadRate           FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesReadRateMax        FlexInt64                  `json:"diskBytesReadRateMax"`
+	DiskBytesReadRateMaxLength  FlexInt64                  `json:"diskBytesReadRateMaxLength"`
+	DiskBytesWriteRate          FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskBytesWriteRateMax       FlexInt64                  `json:"diskBytesWriteRateMax"`
+	DiskBytesWriteRateMaxLength FlexInt64                  `json:"diskBytesWriteRateMaxLength"`
+	DiskIopsReadRate            FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsReadRateMax         FlexInt64                  `json:"diskIopsReadRateMax"`
+	DiskIopsReadRateMaxLength   FlexInt64                  `json:"diskIopsReadRateMaxLength"`
+	DiskIopsWriteRate           FlexInt64                  `json:"diskIopsWriteRate"`
+	DiskIopsWriteRateMax        FlexInt64                  `json:"diskIopsWriteRateMax"`
+	DiskIopsWriteRateMaxLength  FlexInt64                  `json:"diskIopsWriteRateMaxLength"`
+	Disksize                    FlexInt64                  `json:"disksize"`
+	Disksizestrictness          FlexBool                   `json:"disksizestrictness"`
+	Displayoffering             FlexBool                   `json:"displayoffering"`
+	Displaytext                 string                     `json:"displaytext"`
+	Domain                      string                     `json:"domain"`
+	Domainid                    string                     `json:"domainid"`
+	Encrypt                     FlexBool                   `json:"encrypt"`
+	Hasannotations              FlexBool                   `json:"hasannotations"`
+	Hypervisorsnapshotreserve   FlexInt                    `json:"hypervisorsnapshotreserve"`
+	Id                          string                     `json:"id"`
+	Iscustomized                FlexBool                   `json:"iscustomized"`
+	Iscustomizediops            FlexBool                   `json:"iscustomizediops"`
+	JobID                       string                     `json:"jobid"`
+	Jobstatus                   FlexInt                    `json:"jobstatus"`
+	Maxiops                     FlexInt64                  `json:"maxiops"`
+	Miniops                     FlexInt64                  `json:"miniops"`
+	Name                        string                     `json:"name"`
+	Provisioningtype            string                     `json:"provisioningtype"`
+	State                       string                     `json:"state"`
+	Storagetype                 string                     `json:"storagetype"`
+	Suitableforvirtualmachine   FlexBool                   `json:"suitableforvirtualmachine"`
+	Tags                        string                     `json:"tags"`
+	Vspherestoragepolicy        string                     `json:"vspherestoragepolicy"`
+	Zone                        string                     `json:"zone"`
+	Zoneid                      string                     `json:"zoneid"`
+	Extra                       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DiskOffering) UnmarshalJSON(b []byte) error {
+	type alias DiskOffering
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateDiskOfferingParams struct {
@@ -1660,6 +1914,65 @@ func (p *UpdateDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateDiskOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateDiskOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateDiskOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateDiskOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateDiskOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"cachemode": 255, "displaytext": 4096, "domainid": 255, "id": 255, "name": 255, "state": 255, "tags": 4096, "zoneid": 255},
+	}.validate("updateDiskOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateDiskOffering API, before the request is signed
+func (p *UpdateDiskOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateDiskOffering", b, &p.p, map[string]paramDecoder{
+		"bytesreadrate":           decodeParam[int64],
+		"bytesreadratemax":        decodeParam[int64],
+		"bytesreadratemaxlength":  decodeParam[int64],
+		"byteswriterate":          decodeParam[int64],
+		"byteswriteratemax":       decodeParam[int64],
+		"byteswriteratemaxlength": decodeParam[int64],
+		"cachemode":               decodeParam[string],
+		"displayoffering":         decodeParam[bool],
+		"displaytext":             decodeParam[string],
+		"domainid":                decodeParam[string],
+		"id":                      decodeParam[string],
+		"iopsreadrate":            decodeParam[int64],
+		"iopsreadratemax":         decodeParam[int64],
+		"iopsreadratemaxlength":   decodeParam[int64],
+		"iopswriterate":           decodeParam[int64],
+		"iopswriteratemax":        decodeParam[int64],
+		"iopswriteratemaxlength":  decodeParam[int64],
+		"name":                    decodeParam[string],
+		"sortkey":                 decodeParam[int],
+		"state":                   decodeParam[string],
+		"tags":                    decodeParam[string],
+		"zoneid":                  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateDiskOfferingParams) Clone() *UpdateDiskOfferingParams {
+	return &UpdateDiskOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateDiskOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2133,13 +2446,22 @@ func (s *DiskOfferingService) NewUpdateDiskOfferingParams(id string) *UpdateDisk
 
 // Updates a disk offering.
 func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("updateDiskOffering", p.toURLValues())
+	return s.UpdateDiskOfferingWithContext(context.Background(), p)
+}
+
+// UpdateDiskOfferingWithContext is like UpdateDiskOffering, but honours the cancellation and deadline of ctx
+func (s *DiskOfferingService) UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateDiskOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateDiskOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateDiskOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2147,44 +2469,50 @@ func (s *DiskOfferingService) UpdateDiskOffering(p *UpdateDiskOfferingParams) (*
 }
 
 type UpdateDiskOfferingResponse struct {
-	CacheMode                   string            `json:"cacheMode"`
-	Created                     string            `json:"created"`
-	Details                     map[string]string `json:"details"`
-	DiskBytesReadRate           int64             `json:"diskBytesReadRate"`
-	DiskBytesReadRateMax        int64             `json:"diskBytesReadRateMax"`
-	DiskBytesReadRateMaxLength  int64             `json:"diskBytesReadRateMaxLength"`
-	DiskBytesWriteRate          int64             `json:"diskBytesWriteRate"`
-	DiskBytesWriteRateMax       int64             `json:"diskBytesWriteRateMax"`
-	DiskBytesWriteRateMaxLength int64             `json:"diskBytesWriteRateMaxLength"`
-	DiskIopsReadRate            int64             `json:"diskIopsReadRate"`
-	DiskIopsReadRateMax         int64             `json:"diskIopsReadRateMax"`
-	DiskIopsReadRateMaxLength   int64             `json:"diskIopsReadRateMaxLength"`
-	DiskIopsWriteRate           int64             `json:"diskIopsWriteRate"`
-	DiskIopsWriteRateMax        int64             `json:"diskIopsWriteRateMax"`
-	DiskIopsWriteRateMaxLength  int64             `json:"diskIopsWriteRateMaxLength"`
-	Disksize                    int64             `json:"disksize"`
-	Disksizestrictness          bool              `json:"disksizestrictness"`
-	Displayoffering             bool              `json:"displayoffering"`
-	Displaytext                 string            `json:"displaytext"`
-	Domain                      string            `json:"domain"`
-	Domainid                    string            `json:"domainid"`
-	Encrypt                     bool              `json:"encrypt"`
-	Hasannotations              bool              `json:"hasannotations"`
-	Hypervisorsnapshotreserve   int               `json:"hypervisorsnapshotreserve"`
-	Id                          string            `json:"id"`
-	Iscustomized                bool              `json:"iscustomized"`
-	Iscustomizediops            bool              `json:"iscustomizediops"`
-	JobID                       string            `json:"jobid"`
-	Jobstatus                   int               `json:"jobstatus"`
-	Maxiops                     int64             `json:"maxiops"`
-	Miniops                     int64             `json:"miniops"`
-	Name                        string            `json:"name"`
-	Provisioningtype            string            `json:"provisioningtype"`
-	State                       string            `json:"state"`
-	Storagetype                 string            `json:"storagetype"`
-	Suitableforvirtualmachine   bool              `json:"suitableforvirtualmachine"`
-	Tags                        string            `json:"tags"`
-	Vspherestoragepolicy        string            `json:"vspherestoragepolicy"`
-	Zone                        string            `json:"zone"`
-	Zoneid                      string            `json:"zoneid"`
+	CacheMode                   string                     `json:"cacheMode"`
+	Created                     Time                       `json:"created"`
+	Details                     map[string]string          `json:"details"`
+	DiskBytesReadRate           FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesReadRateMax        FlexInt64                  `json:"diskBytesReadRateMax"`
+	DiskBytesReadRateMaxLength  FlexInt64                  `json:"diskBytesReadRateMaxLength"`
+	DiskBytesWriteRate          FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskBytesWriteRateMax       FlexInt64                  `json:"diskBytesWriteRateMax"`
+	DiskBytesWriteRateMaxLength FlexInt64                  `json:"diskBytesWriteRateMaxLength"`
+	DiskIopsReadRate            FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsReadRateMax         FlexInt64                  `json:"diskIopsReadRateMax"`
+	DiskIopsReadRateMaxLength   FlexInt64                  `json:"diskIopsReadRateMaxLength"`
+	DiskIopsWriteRate           FlexInt64                  `json:"diskIopsWriteRate"`
+	DiskIopsWriteRateMax        FlexInt64                  `json:"diskIopsWriteRateMax"`
+	DiskIopsWriteRateMaxLength  FlexInt64                  `json:"diskIopsWriteRateMaxLength"`
+	Disksize                    FlexInt64                  `json:"disksize"`
+	Disksizestrictness          FlexBool                   `json:"disksizestrictness"`
+	Displayoffering             FlexBool                   `json:"displayoffering"`
+	Displaytext                 string                     `json:"displaytext"`
+	Domain                      string                     `json:"domain"`
+	Domainid                    string                     `json:"domainid"`
+	Encrypt                     FlexBool                   `json:"encrypt"`
+	Hasannotations              FlexBool                   `json:"hasannotations"`
+	Hypervisorsnapshotreserve   FlexInt                    `json:"hypervisorsnapshotreserve"`
+	Id                          string                     `json:"id"`
+	Iscustomized                FlexBool                   `json:"iscustomized"`
+	Iscustomizediops            FlexBool                   `json:"iscustomizediops"`
+	JobID                       string                     `json:"jobid"`
+	Jobstatus                   FlexInt                    `json:"jobstatus"`
+	Maxiops                     FlexInt64                  `json:"maxiops"`
+	Miniops                     FlexInt64                  `json:"miniops"`
+	Name                        string                     `json:"name"`
+	Provisioningtype            string                     `json:"provisioningtype"`
+	State                       string                     `json:"state"`
+	Storagetype                 string                     `json:"storagetype"`
+	Suitableforvirtualmachine   FlexBool                   `json:"suitableforvirtualmachine"`
+	Tags                        string                     `json:"tags"`
+	Vspherestoragepolicy        string                     `json:"vspherestoragepolicy"`
+	Zone                        string                     `json:"zone"`
+	Zoneid                      string                     `json:"zoneid"`
+	Extra                       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateDiskOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateDiskOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
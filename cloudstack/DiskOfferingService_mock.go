@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) CreateDiskOffering(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).CreateDiskOffering), p)
 }
 
+// CreateDiskOfferingWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) CreateDiskOfferingWithContext(ctx context.Context, p *CreateDiskOfferingParams) (*CreateDiskOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDiskOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateDiskOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDiskOfferingWithContext indicates an expected call of CreateDiskOfferingWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) CreateDiskOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDiskOfferingWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).CreateDiskOfferingWithContext), ctx, p)
+}
+
 // DeleteDiskOffering mocks base method.
 func (m *MockDiskOfferingServiceIface) DeleteDiskOffering(p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) DeleteDiskOffering(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).DeleteDiskOffering), p)
 }
 
+// DeleteDiskOfferingWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) DeleteDiskOfferingWithContext(ctx context.Context, p *DeleteDiskOfferingParams) (*DeleteDiskOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDiskOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteDiskOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDiskOfferingWithContext indicates an expected call of DeleteDiskOfferingWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) DeleteDiskOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDiskOfferingWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).DeleteDiskOfferingWithContext), ctx, p)
+}
+
 // GetDiskOfferingByID mocks base method.
 func (m *MockDiskOfferingServiceIface) GetDiskOfferingByID(id string, opts ...OptionFunc) (*DiskOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) GetDiskOfferingByID(id any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskOfferingByID", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).GetDiskOfferingByID), varargs...)
 }
 
+// GetDiskOfferingByIDWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) GetDiskOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDiskOfferingByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*DiskOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDiskOfferingByIDWithContext indicates an expected call of GetDiskOfferingByIDWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) GetDiskOfferingByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskOfferingByIDWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).GetDiskOfferingByIDWithContext), varargs...)
+}
+
 // GetDiskOfferingByName mocks base method.
 func (m *MockDiskOfferingServiceIface) GetDiskOfferingByName(name string, opts ...OptionFunc) (*DiskOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +183,27 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) GetDiskOfferingByName(name a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskOfferingByName", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).GetDiskOfferingByName), varargs...)
 }
 
+// GetDiskOfferingByNameWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) GetDiskOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DiskOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDiskOfferingByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*DiskOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDiskOfferingByNameWithContext indicates an expected call of GetDiskOfferingByNameWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) GetDiskOfferingByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskOfferingByNameWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).GetDiskOfferingByNameWithContext), varargs...)
+}
+
 // GetDiskOfferingID mocks base method.
 func (m *MockDiskOfferingServiceIface) GetDiskOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +225,27 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) GetDiskOfferingID(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskOfferingID", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).GetDiskOfferingID), varargs...)
 }
 
+// GetDiskOfferingIDWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) GetDiskOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDiskOfferingIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDiskOfferingIDWithContext indicates an expected call of GetDiskOfferingIDWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) GetDiskOfferingIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDiskOfferingIDWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).GetDiskOfferingIDWithContext), varargs...)
+}
+
 // ListDiskOfferings mocks base method.
 func (m *MockDiskOfferingServiceIface) ListDiskOfferings(p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +261,99 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferings(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferings", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferings), p)
 }
 
+// ListDiskOfferingsAll mocks base method.
+func (m *MockDiskOfferingServiceIface) ListDiskOfferingsAll(p *ListDiskOfferingsParams, opts ...PageOption) ([]*DiskOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiskOfferingsAll", varargs...)
+	ret0, _ := ret[0].([]*DiskOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiskOfferingsAll indicates an expected call of ListDiskOfferingsAll.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferingsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferingsAll", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferingsAll), varargs...)
+}
+
+// ListDiskOfferingsAllWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) ListDiskOfferingsAllWithContext(ctx context.Context, p *ListDiskOfferingsParams, opts ...PageOption) ([]*DiskOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiskOfferingsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DiskOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiskOfferingsAllWithContext indicates an expected call of ListDiskOfferingsAllWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferingsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferingsAllWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferingsAllWithContext), varargs...)
+}
+
+// ListDiskOfferingsIter mocks base method.
+func (m *MockDiskOfferingServiceIface) ListDiskOfferingsIter(p *ListDiskOfferingsParams, opts ...PageOption) iter.Seq2[*DiskOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiskOfferingsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DiskOffering, error])
+	return ret0
+}
+
+// ListDiskOfferingsIter indicates an expected call of ListDiskOfferingsIter.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferingsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferingsIter", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferingsIter), varargs...)
+}
+
+// ListDiskOfferingsIterWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) ListDiskOfferingsIterWithContext(ctx context.Context, p *ListDiskOfferingsParams, opts ...PageOption) iter.Seq2[*DiskOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDiskOfferingsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DiskOffering, error])
+	return ret0
+}
+
+// ListDiskOfferingsIterWithContext indicates an expected call of ListDiskOfferingsIterWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferingsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferingsIterWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferingsIterWithContext), varargs...)
+}
+
+// ListDiskOfferingsWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) ListDiskOfferingsWithContext(ctx context.Context, p *ListDiskOfferingsParams) (*ListDiskOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDiskOfferingsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDiskOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDiskOfferingsWithContext indicates an expected call of ListDiskOfferingsWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) ListDiskOfferingsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDiskOfferingsWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).ListDiskOfferingsWithContext), ctx, p)
+}
+
 // NewCreateDiskOfferingParams mocks base method.
 func (m *MockDiskOfferingServiceIface) NewCreateDiskOfferingParams(displaytext, name string) *CreateDiskOfferingParams {
 	m.ctrl.T.Helper()
@@ -236,3 +424,18 @@ func (mr *MockDiskOfferingServiceIfaceMockRecorder) UpdateDiskOffering(p any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiskOffering", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).UpdateDiskOffering), p)
 }
+
+// UpdateDiskOfferingWithContext mocks base method.
+func (m *MockDiskOfferingServiceIface) UpdateDiskOfferingWithContext(ctx context.Context, p *UpdateDiskOfferingParams) (*UpdateDiskOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDiskOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateDiskOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDiskOfferingWithContext indicates an expected call of UpdateDiskOfferingWithContext.
+func (mr *MockDiskOfferingServiceIfaceMockRecorder) UpdateDiskOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDiskOfferingWithContext", reflect.TypeOf((*MockDiskOfferingServiceIface)(nil).UpdateDiskOfferingWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,22 +31,43 @@ import (
 
 type DomainServiceIface interface {
 	CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error)
+	CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error)
 	NewCreateDomainParams(name string) *CreateDomainParams
 	DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error)
+	DeleteDomainAsync(ctx context.Context, p *DeleteDomainParams) (*Job, error)
 	NewDeleteDomainParams(id string) *DeleteDomainParams
 	ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error)
+	ListDomainChildrenAll(p *ListDomainChildrenParams, opts ...PageOption) ([]*DomainChildren, error)
+	ListDomainChildrenAllWithContext(ctx context.Context, p *ListDomainChildrenParams, opts ...PageOption) ([]*DomainChildren, error)
+	ListDomainChildrenIter(p *ListDomainChildrenParams, opts ...PageOption) iter.Seq2[*DomainChildren, error]
+	ListDomainChildrenIterWithContext(ctx context.Context, p *ListDomainChildrenParams, opts ...PageOption) iter.Seq2[*DomainChildren, error]
 	NewListDomainChildrenParams() *ListDomainChildrenParams
 	GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainChildrenIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DomainChildren, int, error)
 	GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error)
+	GetDomainChildrenByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DomainChildren, int, error)
 	ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error)
+	ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error)
+	ListDomainsAll(p *ListDomainsParams, opts ...PageOption) ([]*Domain, error)
+	ListDomainsAllWithContext(ctx context.Context, p *ListDomainsParams, opts ...PageOption) ([]*Domain, error)
+	ListDomainsIter(p *ListDomainsParams, opts ...PageOption) iter.Seq2[*Domain, error]
+	ListDomainsIterWithContext(ctx context.Context, p *ListDomainsParams, opts ...PageOption) iter.Seq2[*Domain, error]
 	NewListDomainsParams() *ListDomainsParams
 	GetDomainID(name string, opts ...OptionFunc) (string, int, error)
+	GetDomainIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Domain, int, error)
 	GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error)
+	GetDomainByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Domain, int, error)
 	MoveDomain(p *MoveDomainParams) (*MoveDomainResponse, error)
+	MoveDomainWithContext(ctx context.Context, p *MoveDomainParams) (*MoveDomainResponse, error)
 	NewMoveDomainParams(domainid string, parentdomainid string) *MoveDomainParams
 	UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error)
+	UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error)
 	NewUpdateDomainParams(id string) *UpdateDomainParams
 }
 
@@ -72,6 +95,47 @@ func (p *CreateDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createDomain API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateDomainParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createDomain", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createDomain API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateDomainParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"domainid": 255, "name": 255, "networkdomain": 255, "parentdomainid": 255},
+	}.validate("createDomain", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createDomain API, before the request is signed
+func (p *CreateDomainParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateDomainParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateDomainParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createDomain", b, &p.p, map[string]paramDecoder{
+		"domainid":       decodeParam[string],
+		"name":           decodeParam[string],
+		"networkdomain":  decodeParam[string],
+		"parentdomainid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateDomainParams) Clone() *CreateDomainParams {
+	return &CreateDomainParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateDomainParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -167,7 +231,16 @@ func (s *DomainService) NewCreateDomainParams(name string) *CreateDomainParams {
 
 // Creates a domain
 func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainResponse, error) {
-	resp, err := s.cs.newPostRequest("createDomain", p.toURLValues())
+	return s.CreateDomainWithContext(context.Background(), p)
+}
+
+// CreateDomainWithContext is like CreateDomain, but honours the cancellation and deadline of ctx
+func (s *DomainService) CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createDomain", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -177,7 +250,7 @@ func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainRespon
 	}
 
 	var r CreateDomainResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createDomain", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -185,73 +258,79 @@ func (s *DomainService) CreateDomain(p *CreateDomainParams) (*CreateDomainRespon
 }
 
 type CreateDomainResponse struct {
-	Backupavailable           string            `json:"backupavailable"`
-	Backuplimit               string            `json:"backuplimit"`
-	Backupstorageavailable    string            `json:"backupstorageavailable"`
-	Backupstoragelimit        string            `json:"backupstoragelimit"`
-	Backupstoragetotal        int64             `json:"backupstoragetotal"`
-	Backuptotal               int64             `json:"backuptotal"`
-	Bucketavailable           string            `json:"bucketavailable"`
-	Bucketlimit               string            `json:"bucketlimit"`
-	Buckettotal               int64             `json:"buckettotal"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Created                   string            `json:"created"`
-	Domaindetails             map[string]string `json:"domaindetails"`
-	Gpuavailable              string            `json:"gpuavailable"`
-	Gpulimit                  string            `json:"gpulimit"`
-	Gputotal                  int64             `json:"gputotal"`
-	Hasannotations            bool              `json:"hasannotations"`
-	Haschild                  bool              `json:"haschild"`
-	Icon                      interface{}       `json:"icon"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	JobID                     string            `json:"jobid"`
-	Jobstatus                 int               `json:"jobstatus"`
-	Level                     int               `json:"level"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Objectstorageavailable    string            `json:"objectstorageavailable"`
-	Objectstoragelimit        string            `json:"objectstoragelimit"`
-	Objectstoragetotal        int64             `json:"objectstoragetotal"`
-	Parentdomainid            string            `json:"parentdomainid"`
-	Parentdomainname          string            `json:"parentdomainname"`
-	Path                      string            `json:"path"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64           `json:"secondarystoragetotal"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Taggedresources           []string          `json:"taggedresources"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
-	Vmavailable               string            `json:"vmavailable"`
-	Vmlimit                   string            `json:"vmlimit"`
-	Vmtotal                   int64             `json:"vmtotal"`
-	Volumeavailable           string            `json:"volumeavailable"`
-	Volumelimit               string            `json:"volumelimit"`
-	Volumetotal               int64             `json:"volumetotal"`
-	Vpcavailable              string            `json:"vpcavailable"`
-	Vpclimit                  string            `json:"vpclimit"`
-	Vpctotal                  int64             `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Domaindetails             map[string]string          `json:"domaindetails"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Haschild                  FlexBool                   `json:"haschild"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Level                     FlexInt                    `json:"level"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networkdomain             string                     `json:"networkdomain"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Parentdomainid            string                     `json:"parentdomainid"`
+	Parentdomainname          string                     `json:"parentdomainname"`
+	Path                      string                     `json:"path"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectavailable          string                     `json:"projectavailable"`
+	Projectlimit              string                     `json:"projectlimit"`
+	Projecttotal              FlexInt64                  `json:"projecttotal"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateDomainResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateDomainResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteDomainParams struct {
@@ -273,6 +352,45 @@ func (p *DeleteDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteDomain API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteDomainParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteDomain", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteDomain API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteDomainParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteDomain", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteDomain API, before the request is signed
+func (p *DeleteDomainParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteDomainParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteDomainParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteDomain", b, &p.p, map[string]paramDecoder{
+		"cleanup": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteDomainParams) Clone() *DeleteDomainParams {
+	return &DeleteDomainParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteDomainParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -326,27 +444,39 @@ func (s *DomainService) NewDeleteDomainParams(id string) *DeleteDomainParams {
 
 // Deletes a specified domain
 func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteDomain", p.toURLValues())
+	return s.DeleteDomainWithContext(context.Background(), p)
+}
+
+// DeleteDomainWithContext is like DeleteDomain, but honours the cancellation and deadline of ctx
+func (s *DomainService) DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteDomain", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteDomainResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteDomain", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteDomain", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteDomain", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -354,11 +484,31 @@ func (s *DomainService) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteDomain async job into r
+func (r *DeleteDomainResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteDomainAsync starts the async job of DeleteDomain without waiting for it to finish, and returns a handle to the job
+func (s *DomainService) DeleteDomainAsync(ctx context.Context, p *DeleteDomainParams) (*Job, error) {
+	r, err := s.DeleteDomainWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteDomain", r.JobID), nil
+}
+
 type DeleteDomainResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteDomainResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteDomainResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDomainChildrenParams struct {
@@ -402,6 +552,50 @@ func (p *ListDomainChildrenParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDomainChildren API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDomainChildrenParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDomainChildren", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDomainChildren API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDomainChildrenParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255},
+	}.validate("listDomainChildren", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDomainChildren API, before the request is signed
+func (p *ListDomainChildrenParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDomainChildrenParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDomainChildrenParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDomainChildren", b, &p.p, map[string]paramDecoder{
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"showicon":    decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDomainChildrenParams) Clone() *ListDomainChildrenParams {
+	return &ListDomainChildrenParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDomainChildrenParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -580,6 +774,11 @@ func (s *DomainService) NewListDomainChildrenParams() *ListDomainChildrenParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetDomainChildrenIDWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainChildrenIDWithContext is like GetDomainChildrenID, but honours the cancellation and deadline of ctx
+func (s *DomainService) GetDomainChildrenIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainChildrenParams{}
 	p.p = make(map[string]interface{})
 
@@ -591,7 +790,7 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 		}
 	}
 
-	l, err := s.ListDomainChildren(p)
+	l, err := s.ListDomainChildrenWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -616,12 +815,17 @@ func (s *DomainService) GetDomainChildrenID(name string, opts ...OptionFunc) (st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error) {
-	id, count, err := s.GetDomainChildrenID(name, opts...)
+	return s.GetDomainChildrenByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainChildrenByNameWithContext is like GetDomainChildrenByName, but honours the cancellation and deadline of ctx
+func (s *DomainService) GetDomainChildrenByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	id, count, err := s.GetDomainChildrenIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetDomainChildrenByID(id, opts...)
+	r, count, err := s.GetDomainChildrenByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -630,6 +834,11 @@ func (s *DomainService) GetDomainChildrenByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	return s.GetDomainChildrenByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDomainChildrenByIDWithContext is like GetDomainChildrenByID, but honours the cancellation and deadline of ctx
+func (s *DomainService) GetDomainChildrenByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DomainChildren, int, error) {
 	p := &ListDomainChildrenParams{}
 	p.p = make(map[string]interface{})
 
@@ -641,11 +850,10 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 		}
 	}
 
-	l, err := s.ListDomainChildren(p)
+	l, err := s.ListDomainChildrenWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -663,92 +871,135 @@ func (s *DomainService) GetDomainChildrenByID(id string, opts ...OptionFunc) (*D
 
 // Lists all children domains belonging to a specified domain
 func (s *DomainService) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
-	resp, err := s.cs.newRequest("listDomainChildren", p.toURLValues())
+	return s.ListDomainChildrenWithContext(context.Background(), p)
+}
+
+// ListDomainChildrenWithContext is like ListDomainChildren, but honours the cancellation and deadline of ctx
+func (s *DomainService) ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDomainChildren", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDomainChildrenResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDomainChildren", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDomainChildrenAll returns all DomainChildren matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *DomainService) ListDomainChildrenAll(p *ListDomainChildrenParams, opts ...PageOption) ([]*DomainChildren, error) {
+	return s.ListDomainChildrenAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDomainChildrenAllWithContext is like ListDomainChildrenAll, but honours the cancellation and deadline of ctx
+func (s *DomainService) ListDomainChildrenAllWithContext(ctx context.Context, p *ListDomainChildrenParams, opts ...PageOption) ([]*DomainChildren, error) {
+	return collectPages(s.ListDomainChildrenIterWithContext(ctx, p, opts...))
+}
+
+// ListDomainChildrenIter returns an iterator over all DomainChildren matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *DomainService) ListDomainChildrenIter(p *ListDomainChildrenParams, opts ...PageOption) iter.Seq2[*DomainChildren, error] {
+	return s.ListDomainChildrenIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDomainChildrenIterWithContext is like ListDomainChildrenIter, but honours the cancellation and deadline of ctx
+func (s *DomainService) ListDomainChildrenIterWithContext(ctx context.Context, p *ListDomainChildrenParams, opts ...PageOption) iter.Seq2[*DomainChildren, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DomainChildren, int, error) {
+		l, err := s.ListDomainChildrenWithContext(ctx, &ListDomainChildrenParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DomainChildren, l.Count, nil
+	}, opts...)
+}
+
 type ListDomainChildrenResponse struct {
 	Count          int               `json:"count"`
 	DomainChildren []*DomainChildren `json:"domain"`
 }
 
 type DomainChildren struct {
-	Backupavailable           string            `json:"backupavailable"`
-	Backuplimit               string            `json:"backuplimit"`
-	Backupstorageavailable    string            `json:"backupstorageavailable"`
-	Backupstoragelimit        string            `json:"backupstoragelimit"`
-	Backupstoragetotal        int64             `json:"backupstoragetotal"`
-	Backuptotal               int64             `json:"backuptotal"`
-	Bucketavailable           string            `json:"bucketavailable"`
-	Bucketlimit               string            `json:"bucketlimit"`
-	Buckettotal               int64             `json:"buckettotal"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Created                   string            `json:"created"`
-	Domaindetails             map[string]string `json:"domaindetails"`
-	Gpuavailable              string            `json:"gpuavailable"`
-	Gpulimit                  string            `json:"gpulimit"`
-	Gputotal                  int64             `json:"gputotal"`
-	Hasannotations            bool              `json:"hasannotations"`
-	Haschild                  bool              `json:"haschild"`
-	Icon                      interface{}       `json:"icon"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	JobID                     string            `json:"jobid"`
-	Jobstatus                 int               `json:"jobstatus"`
-	Level                     int               `json:"level"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Objectstorageavailable    string            `json:"objectstorageavailable"`
-	Objectstoragelimit        string            `json:"objectstoragelimit"`
-	Objectstoragetotal        int64             `json:"objectstoragetotal"`
-	Parentdomainid            string            `json:"parentdomainid"`
-	Parentdomainname          string            `json:"parentdomainname"`
-	Path                      string            `json:"path"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64           `json:"secondarystoragetotal"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Taggedresources           []string          `json:"taggedresources"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
-	Vmavailable               string            `json:"vmavailable"`
-	Vmlimit                   string            `json:"vmlimit"`
-	Vmtotal                   int64             `json:"vmtotal"`
-	Volumeavailable           string            `json:"volumeavailable"`
-	Volumelimit               string            `json:"volumelimit"`
-	Volumetotal               int64             `json:"volumetotal"`
-	Vpcavailable              string            `json:"vpcavailable"`
-	Vpclimit                  string            `json:"vpclimit"`
-	Vpctotal                  int64             `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Domaindetails             map[string]string          `json:"domaindetails"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Haschild                  FlexBool                   `json:"haschild"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Level                     FlexInt                    `json:"level"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networkdomain             string                     `json:"networkdomain"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Parentdomainid            string                     `json:"parentdomainid"`
+	Parentdomainname          string                     `json:"parentdomainname"`
+	Path                      string                     `json:"path"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectavailable          string                     `json:"projectavailable"`
+	Projectlimit              string                     `json:"projectlimit"`
+	Projecttotal              FlexInt64                  `json:"projecttotal"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DomainChildren) UnmarshalJSON(b []byte) error {
+	type alias DomainChildren
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDomainsParams struct {
@@ -799,6 +1050,52 @@ func (p *ListDomainsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDomains API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDomainsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDomains", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDomains API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDomainsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "tag": 255},
+	}.validate("listDomains", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDomains API, before the request is signed
+func (p *ListDomainsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDomainsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDomainsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDomains", b, &p.p, map[string]paramDecoder{
+		"details":  decodeParam[[]string],
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"level":    decodeParam[int],
+		"listall":  decodeParam[bool],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"showicon": decodeParam[bool],
+		"tag":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDomainsParams) Clone() *ListDomainsParams {
+	return &ListDomainsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDomainsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1019,6 +1316,11 @@ func (s *DomainService) NewListDomainsParams() *ListDomainsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetDomainIDWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainIDWithContext is like GetDomainID, but honours the cancellation and deadline of ctx
+func (s *DomainService) GetDomainIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListDomainsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1030,7 +1332,7 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 		}
 	}
 
-	l, err := s.ListDomains(p)
+	l, err := s.ListDomainsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1055,12 +1357,17 @@ func (s *DomainService) GetDomainID(name string, opts ...OptionFunc) (string, in
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error) {
-	id, count, err := s.GetDomainID(name, opts...)
+	return s.GetDomainByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetDomainByNameWithContext is like GetDomainByName, but honours the cancellation and deadline of ctx
+func (s *DomainService) GetDomainByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Domain, int, error) {
+	id, count, err := s.GetDomainIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetDomainByID(id, opts...)
+	r, count, err := s.GetDomainByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1069,6 +1376,11 @@ func (s *DomainService) GetDomainByName(name string, opts ...OptionFunc) (*Domai
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error) {
+	return s.GetDomainByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDomainByIDWithContext is like GetDomainByID, but honours the cancellation and deadline of ctx
+func (s *DomainService) GetDomainByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Domain, int, error) {
 	p := &ListDomainsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1080,11 +1392,10 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 		}
 	}
 
-	l, err := s.ListDomains(p)
+	l, err := s.ListDomainsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1102,92 +1413,135 @@ func (s *DomainService) GetDomainByID(id string, opts ...OptionFunc) (*Domain, i
 
 // Lists domains and provides detailed information for listed domains
 func (s *DomainService) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
-	resp, err := s.cs.newRequest("listDomains", p.toURLValues())
+	return s.ListDomainsWithContext(context.Background(), p)
+}
+
+// ListDomainsWithContext is like ListDomains, but honours the cancellation and deadline of ctx
+func (s *DomainService) ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDomains", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDomainsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDomains", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDomainsAll returns all Domains matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *DomainService) ListDomainsAll(p *ListDomainsParams, opts ...PageOption) ([]*Domain, error) {
+	return s.ListDomainsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDomainsAllWithContext is like ListDomainsAll, but honours the cancellation and deadline of ctx
+func (s *DomainService) ListDomainsAllWithContext(ctx context.Context, p *ListDomainsParams, opts ...PageOption) ([]*Domain, error) {
+	return collectPages(s.ListDomainsIterWithContext(ctx, p, opts...))
+}
+
+// ListDomainsIter returns an iterator over all Domains matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *DomainService) ListDomainsIter(p *ListDomainsParams, opts ...PageOption) iter.Seq2[*Domain, error] {
+	return s.ListDomainsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDomainsIterWithContext is like ListDomainsIter, but honours the cancellation and deadline of ctx
+func (s *DomainService) ListDomainsIterWithContext(ctx context.Context, p *ListDomainsParams, opts ...PageOption) iter.Seq2[*Domain, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Domain, int, error) {
+		l, err := s.ListDomainsWithContext(ctx, &ListDomainsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Domains, l.Count, nil
+	}, opts...)
+}
+
 type ListDomainsResponse struct {
 	Count   int       `json:"count"`
 	Domains []*Domain `json:"domain"`
 }
 
 type Domain struct {
-	Backupavailable           string            `json:"backupavailable"`
-	Backuplimit               string            `json:"backuplimit"`
-	Backupstorageavailable    string            `json:"backupstorageavailable"`
-	Backupstoragelimit        string            `json:"backupstoragelimit"`
-	Backupstoragetotal        int64             `json:"backupstoragetotal"`
-	Backuptotal               int64             `json:"backuptotal"`
-	Bucketavailable           string            `json:"bucketavailable"`
-	Bucketlimit               string            `json:"bucketlimit"`
-	Buckettotal               int64             `json:"buckettotal"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Created                   string            `json:"created"`
-	Domaindetails             map[string]string `json:"domaindetails"`
-	Gpuavailable              string            `json:"gpuavailable"`
-	Gpulimit                  string            `json:"gpulimit"`
-	Gputotal                  int64             `json:"gputotal"`
-	Hasannotations            bool              `json:"hasannotations"`
-	Haschild                  bool              `json:"haschild"`
-	Icon                      interface{}       `json:"icon"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	JobID                     string            `json:"jobid"`
-	Jobstatus                 int               `json:"jobstatus"`
-	Level                     int               `json:"level"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Objectstorageavailable    string            `json:"objectstorageavailable"`
-	Objectstoragelimit        string            `json:"objectstoragelimit"`
-	Objectstoragetotal        int64             `json:"objectstoragetotal"`
-	Parentdomainid            string            `json:"parentdomainid"`
-	Parentdomainname          string            `json:"parentdomainname"`
-	Path                      string            `json:"path"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64           `json:"secondarystoragetotal"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Taggedresources           []string          `json:"taggedresources"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
-	Vmavailable               string            `json:"vmavailable"`
-	Vmlimit                   string            `json:"vmlimit"`
-	Vmtotal                   int64             `json:"vmtotal"`
-	Volumeavailable           string            `json:"volumeavailable"`
-	Volumelimit               string            `json:"volumelimit"`
-	Volumetotal               int64             `json:"volumetotal"`
-	Vpcavailable              string            `json:"vpcavailable"`
-	Vpclimit                  string            `json:"vpclimit"`
-	Vpctotal                  int64             `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Domaindetails             map[string]string          `json:"domaindetails"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Haschild                  FlexBool                   `json:"haschild"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Level                     FlexInt                    `json:"level"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networkdomain             string                     `json:"networkdomain"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Parentdomainid            string                     `json:"parentdomainid"`
+	Parentdomainname          string                     `json:"parentdomainname"`
+	Path                      string                     `json:"path"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectavailable          string                     `json:"projectavailable"`
+	Projectlimit              string                     `json:"projectlimit"`
+	Projecttotal              FlexInt64                  `json:"projecttotal"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Domain) UnmarshalJSON(b []byte) error {
+	type alias Domain
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MoveDomainParams struct {
@@ -1208,6 +1562,45 @@ func (p *MoveDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the moveDomain API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MoveDomainParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("moveDomain", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the moveDomain API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MoveDomainParams) Validate() error {
+	return paramRules{
+		required: []string{"domainid", "parentdomainid"},
+		lengths:  map[string]int{"domainid": 255, "parentdomainid": 255},
+	}.validate("moveDomain", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the moveDomain API, before the request is signed
+func (p *MoveDomainParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MoveDomainParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MoveDomainParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("moveDomain", b, &p.p, map[string]paramDecoder{
+		"domainid":       decodeParam[string],
+		"parentdomainid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MoveDomainParams) Clone() *MoveDomainParams {
+	return &MoveDomainParams{p: cloneParams(p.p)}
+}
+
 func (p *MoveDomainParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1262,13 +1655,22 @@ func (s *DomainService) NewMoveDomainParams(domainid string, parentdomainid stri
 
 // Moves a domain and its children to a new parent domain.
 func (s *DomainService) MoveDomain(p *MoveDomainParams) (*MoveDomainResponse, error) {
-	resp, err := s.cs.newPostRequest("moveDomain", p.toURLValues())
+	return s.MoveDomainWithContext(context.Background(), p)
+}
+
+// MoveDomainWithContext is like MoveDomain, but honours the cancellation and deadline of ctx
+func (s *DomainService) MoveDomainWithContext(ctx context.Context, p *MoveDomainParams) (*MoveDomainResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "moveDomain", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MoveDomainResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("moveDomain", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1276,73 +1678,79 @@ func (s *DomainService) MoveDomain(p *MoveDomainParams) (*MoveDomainResponse, er
 }
 
 type MoveDomainResponse struct {
-	Backupavailable           string            `json:"backupavailable"`
-	Backuplimit               string            `json:"backuplimit"`
-	Backupstorageavailable    string            `json:"backupstorageavailable"`
-	Backupstoragelimit        string            `json:"backupstoragelimit"`
-	Backupstoragetotal        int64             `json:"backupstoragetotal"`
-	Backuptotal               int64             `json:"backuptotal"`
-	Bucketavailable           string            `json:"bucketavailable"`
-	Bucketlimit               string            `json:"bucketlimit"`
-	Buckettotal               int64             `json:"buckettotal"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Created                   string            `json:"created"`
-	Domaindetails             map[string]string `json:"domaindetails"`
-	Gpuavailable              string            `json:"gpuavailable"`
-	Gpulimit                  string            `json:"gpulimit"`
-	Gputotal                  int64             `json:"gputotal"`
-	Hasannotations            bool              `json:"hasannotations"`
-	Haschild                  bool              `json:"haschild"`
-	Icon                      interface{}       `json:"icon"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	JobID                     string            `json:"jobid"`
-	Jobstatus                 int               `json:"jobstatus"`
-	Level                     int               `json:"level"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Objectstorageavailable    string            `json:"objectstorageavailable"`
-	Objectstoragelimit        string            `json:"objectstoragelimit"`
-	Objectstoragetotal        int64             `json:"objectstoragetotal"`
-	Parentdomainid            string            `json:"parentdomainid"`
-	Parentdomainname          string            `json:"parentdomainname"`
-	Path                      string            `json:"path"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64           `json:"secondarystoragetotal"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Taggedresources           []string          `json:"taggedresources"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
-	Vmavailable               string            `json:"vmavailable"`
-	Vmlimit                   string            `json:"vmlimit"`
-	Vmtotal                   int64             `json:"vmtotal"`
-	Volumeavailable           string            `json:"volumeavailable"`
-	Volumelimit               string            `json:"volumelimit"`
-	Volumetotal               int64             `json:"volumetotal"`
-	Vpcavailable              string            `json:"vpcavailable"`
-	Vpclimit                  string            `json:"vpclimit"`
-	Vpctotal                  int64             `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Domaindetails             map[string]string          `json:"domaindetails"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Haschild                  FlexBool                   `json:"haschild"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Level                     FlexInt                    `json:"level"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networkdomain             string                     `json:"networkdomain"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Parentdomainid            string                     `json:"parentdomainid"`
+	Parentdomainname          string                     `json:"parentdomainname"`
+	Path                      string                     `json:"path"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectavailable          string                     `json:"projectavailable"`
+	Projectlimit              string                     `json:"projectlimit"`
+	Projecttotal              FlexInt64                  `json:"projecttotal"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MoveDomainResponse) UnmarshalJSON(b []byte) error {
+	type alias MoveDomainResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateDomainParams struct {
@@ -1366,6 +1774,46 @@ func (p *UpdateDomainParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateDomain API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateDomainParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateDomain", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateDomain API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateDomainParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "name": 255, "networkdomain": 255},
+	}.validate("updateDomain", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateDomain API, before the request is signed
+func (p *UpdateDomainParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateDomainParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateDomainParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateDomain", b, &p.p, map[string]paramDecoder{
+		"id":            decodeParam[string],
+		"name":          decodeParam[string],
+		"networkdomain": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateDomainParams) Clone() *UpdateDomainParams {
+	return &UpdateDomainParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateDomainParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1440,7 +1888,16 @@ func (s *DomainService) NewUpdateDomainParams(id string) *UpdateDomainParams {
 
 // Updates a domain with a new name
 func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainResponse, error) {
-	resp, err := s.cs.newPostRequest("updateDomain", p.toURLValues())
+	return s.UpdateDomainWithContext(context.Background(), p)
+}
+
+// UpdateDomainWithContext is like UpdateDomain, but honours the cancellation and deadline of ctx
+func (s *DomainService) UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateDomain", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1450,7 +1907,7 @@ func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainRespon
 	}
 
 	var r UpdateDomainResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateDomain", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1458,71 +1915,77 @@ func (s *DomainService) UpdateDomain(p *UpdateDomainParams) (*UpdateDomainRespon
 }
 
 type UpdateDomainResponse struct {
-	Backupavailable           string            `json:"backupavailable"`
-	Backuplimit               string            `json:"backuplimit"`
-	Backupstorageavailable    string            `json:"backupstorageavailable"`
-	Backupstoragelimit        string            `json:"backupstoragelimit"`
-	Backupstoragetotal        int64             `json:"backupstoragetotal"`
-	Backuptotal               int64             `json:"backuptotal"`
-	Bucketavailable           string            `json:"bucketavailable"`
-	Bucketlimit               string            `json:"bucketlimit"`
-	Buckettotal               int64             `json:"buckettotal"`
-	Cpuavailable              string            `json:"cpuavailable"`
-	Cpulimit                  string            `json:"cpulimit"`
-	Cputotal                  int64             `json:"cputotal"`
-	Created                   string            `json:"created"`
-	Domaindetails             map[string]string `json:"domaindetails"`
-	Gpuavailable              string            `json:"gpuavailable"`
-	Gpulimit                  string            `json:"gpulimit"`
-	Gputotal                  int64             `json:"gputotal"`
-	Hasannotations            bool              `json:"hasannotations"`
-	Haschild                  bool              `json:"haschild"`
-	Icon                      interface{}       `json:"icon"`
-	Id                        string            `json:"id"`
-	Ipavailable               string            `json:"ipavailable"`
-	Iplimit                   string            `json:"iplimit"`
-	Iptotal                   int64             `json:"iptotal"`
-	JobID                     string            `json:"jobid"`
-	Jobstatus                 int               `json:"jobstatus"`
-	Level                     int               `json:"level"`
-	Memoryavailable           string            `json:"memoryavailable"`
-	Memorylimit               string            `json:"memorylimit"`
-	Memorytotal               int64             `json:"memorytotal"`
-	Name                      string            `json:"name"`
-	Networkavailable          string            `json:"networkavailable"`
-	Networkdomain             string            `json:"networkdomain"`
-	Networklimit              string            `json:"networklimit"`
-	Networktotal              int64             `json:"networktotal"`
-	Objectstorageavailable    string            `json:"objectstorageavailable"`
-	Objectstoragelimit        string            `json:"objectstoragelimit"`
-	Objectstoragetotal        int64             `json:"objectstoragetotal"`
-	Parentdomainid            string            `json:"parentdomainid"`
-	Parentdomainname          string            `json:"parentdomainname"`
-	Path                      string            `json:"path"`
-	Primarystorageavailable   string            `json:"primarystorageavailable"`
-	Primarystoragelimit       string            `json:"primarystoragelimit"`
-	Primarystoragetotal       int64             `json:"primarystoragetotal"`
-	Projectavailable          string            `json:"projectavailable"`
-	Projectlimit              string            `json:"projectlimit"`
-	Projecttotal              int64             `json:"projecttotal"`
-	Secondarystorageavailable string            `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string            `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64           `json:"secondarystoragetotal"`
-	Snapshotavailable         string            `json:"snapshotavailable"`
-	Snapshotlimit             string            `json:"snapshotlimit"`
-	Snapshottotal             int64             `json:"snapshottotal"`
-	State                     string            `json:"state"`
-	Taggedresources           []string          `json:"taggedresources"`
-	Templateavailable         string            `json:"templateavailable"`
-	Templatelimit             string            `json:"templatelimit"`
-	Templatetotal             int64             `json:"templatetotal"`
-	Vmavailable               string            `json:"vmavailable"`
-	Vmlimit                   string            `json:"vmlimit"`
-	Vmtotal                   int64             `json:"vmtotal"`
-	Volumeavailable           string            `json:"volumeavailable"`
-	Volumelimit               string            `json:"volumelimit"`
-	Volumetotal               int64             `json:"volumetotal"`
-	Vpcavailable              string            `json:"vpcavailable"`
-	Vpclimit                  string            `json:"vpclimit"`
-	Vpctotal                  int64             `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Domaindetails             map[string]string          `json:"domaindetails"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Hasannotations            FlexBool                   `json:"hasannotations"`
+	Haschild                  FlexBool                   `json:"haschild"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Level                     FlexInt                    `json:"level"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networkdomain             string                     `json:"networkdomain"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Parentdomainid            string                     `json:"parentdomainid"`
+	Parentdomainname          string                     `json:"parentdomainname"`
+	Path                      string                     `json:"path"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectavailable          string                     `json:"projectavailable"`
+	Projectlimit              string                     `json:"projectlimit"`
+	Projecttotal              FlexInt64                  `json:"projecttotal"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateDomainResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateDomainResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockDomainServiceIfaceMockRecorder) CreateDomain(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).CreateDomain), p)
 }
 
+// CreateDomainWithContext mocks base method.
+func (m *MockDomainServiceIface) CreateDomainWithContext(ctx context.Context, p *CreateDomainParams) (*CreateDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateDomainWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateDomainWithContext indicates an expected call of CreateDomainWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) CreateDomainWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDomainWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).CreateDomainWithContext), ctx, p)
+}
+
 // DeleteDomain mocks base method.
 func (m *MockDomainServiceIface) DeleteDomain(p *DeleteDomainParams) (*DeleteDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockDomainServiceIfaceMockRecorder) DeleteDomain(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).DeleteDomain), p)
 }
 
+// DeleteDomainAsync mocks base method.
+func (m *MockDomainServiceIface) DeleteDomainAsync(ctx context.Context, p *DeleteDomainParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDomainAsync indicates an expected call of DeleteDomainAsync.
+func (mr *MockDomainServiceIfaceMockRecorder) DeleteDomainAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainAsync", reflect.TypeOf((*MockDomainServiceIface)(nil).DeleteDomainAsync), ctx, p)
+}
+
+// DeleteDomainWithContext mocks base method.
+func (m *MockDomainServiceIface) DeleteDomainWithContext(ctx context.Context, p *DeleteDomainParams) (*DeleteDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteDomainWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteDomainWithContext indicates an expected call of DeleteDomainWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) DeleteDomainWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDomainWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).DeleteDomainWithContext), ctx, p)
+}
+
 // GetDomainByID mocks base method.
 func (m *MockDomainServiceIface) GetDomainByID(id string, opts ...OptionFunc) (*Domain, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +156,27 @@ func (mr *MockDomainServiceIfaceMockRecorder) GetDomainByID(id any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainByID", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainByID), varargs...)
 }
 
+// GetDomainByIDWithContext mocks base method.
+func (m *MockDomainServiceIface) GetDomainByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Domain, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Domain)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDomainByIDWithContext indicates an expected call of GetDomainByIDWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) GetDomainByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainByIDWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainByIDWithContext), varargs...)
+}
+
 // GetDomainByName mocks base method.
 func (m *MockDomainServiceIface) GetDomainByName(name string, opts ...OptionFunc) (*Domain, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +198,27 @@ func (mr *MockDomainServiceIfaceMockRecorder) GetDomainByName(name any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainByName", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainByName), varargs...)
 }
 
+// GetDomainByNameWithContext mocks base method.
+func (m *MockDomainServiceIface) GetDomainByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Domain, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Domain)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDomainByNameWithContext indicates an expected call of GetDomainByNameWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) GetDomainByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainByNameWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainByNameWithContext), varargs...)
+}
+
 // GetDomainChildrenByID mocks base method.
 func (m *MockDomainServiceIface) GetDomainChildrenByID(id string, opts ...OptionFunc) (*DomainChildren, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +240,27 @@ func (mr *MockDomainServiceIfaceMockRecorder) GetDomainChildrenByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainChildrenByID", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainChildrenByID), varargs...)
 }
 
+// GetDomainChildrenByIDWithContext mocks base method.
+func (m *MockDomainServiceIface) GetDomainChildrenByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainChildrenByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*DomainChildren)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDomainChildrenByIDWithContext indicates an expected call of GetDomainChildrenByIDWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) GetDomainChildrenByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainChildrenByIDWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainChildrenByIDWithContext), varargs...)
+}
+
 // GetDomainChildrenByName mocks base method.
 func (m *MockDomainServiceIface) GetDomainChildrenByName(name string, opts ...OptionFunc) (*DomainChildren, int, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +282,27 @@ func (mr *MockDomainServiceIfaceMockRecorder) GetDomainChildrenByName(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainChildrenByName", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainChildrenByName), varargs...)
 }
 
+// GetDomainChildrenByNameWithContext mocks base method.
+func (m *MockDomainServiceIface) GetDomainChildrenByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*DomainChildren, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainChildrenByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*DomainChildren)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDomainChildrenByNameWithContext indicates an expected call of GetDomainChildrenByNameWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) GetDomainChildrenByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainChildrenByNameWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainChildrenByNameWithContext), varargs...)
+}
+
 // GetDomainChildrenID mocks base method.
 func (m *MockDomainServiceIface) GetDomainChildrenID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +324,27 @@ func (mr *MockDomainServiceIfaceMockRecorder) GetDomainChildrenID(name any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainChildrenID", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainChildrenID), varargs...)
 }
 
+// GetDomainChildrenIDWithContext mocks base method.
+func (m *MockDomainServiceIface) GetDomainChildrenIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainChildrenIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDomainChildrenIDWithContext indicates an expected call of GetDomainChildrenIDWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) GetDomainChildrenIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainChildrenIDWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainChildrenIDWithContext), varargs...)
+}
+
 // GetDomainID mocks base method.
 func (m *MockDomainServiceIface) GetDomainID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +366,27 @@ func (mr *MockDomainServiceIfaceMockRecorder) GetDomainID(name any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainID", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainID), varargs...)
 }
 
+// GetDomainIDWithContext mocks base method.
+func (m *MockDomainServiceIface) GetDomainIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDomainIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDomainIDWithContext indicates an expected call of GetDomainIDWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) GetDomainIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDomainIDWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).GetDomainIDWithContext), varargs...)
+}
+
 // ListDomainChildren mocks base method.
 func (m *MockDomainServiceIface) ListDomainChildren(p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +402,99 @@ func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildren(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildren", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildren), p)
 }
 
+// ListDomainChildrenAll mocks base method.
+func (m *MockDomainServiceIface) ListDomainChildrenAll(p *ListDomainChildrenParams, opts ...PageOption) ([]*DomainChildren, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainChildrenAll", varargs...)
+	ret0, _ := ret[0].([]*DomainChildren)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainChildrenAll indicates an expected call of ListDomainChildrenAll.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildrenAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildrenAll", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildrenAll), varargs...)
+}
+
+// ListDomainChildrenAllWithContext mocks base method.
+func (m *MockDomainServiceIface) ListDomainChildrenAllWithContext(ctx context.Context, p *ListDomainChildrenParams, opts ...PageOption) ([]*DomainChildren, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainChildrenAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DomainChildren)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainChildrenAllWithContext indicates an expected call of ListDomainChildrenAllWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildrenAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildrenAllWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildrenAllWithContext), varargs...)
+}
+
+// ListDomainChildrenIter mocks base method.
+func (m *MockDomainServiceIface) ListDomainChildrenIter(p *ListDomainChildrenParams, opts ...PageOption) iter.Seq2[*DomainChildren, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainChildrenIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DomainChildren, error])
+	return ret0
+}
+
+// ListDomainChildrenIter indicates an expected call of ListDomainChildrenIter.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildrenIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildrenIter", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildrenIter), varargs...)
+}
+
+// ListDomainChildrenIterWithContext mocks base method.
+func (m *MockDomainServiceIface) ListDomainChildrenIterWithContext(ctx context.Context, p *ListDomainChildrenParams, opts ...PageOption) iter.Seq2[*DomainChildren, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainChildrenIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DomainChildren, error])
+	return ret0
+}
+
+// ListDomainChildrenIterWithContext indicates an expected call of ListDomainChildrenIterWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildrenIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildrenIterWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildrenIterWithContext), varargs...)
+}
+
+// ListDomainChildrenWithContext mocks base method.
+func (m *MockDomainServiceIface) ListDomainChildrenWithContext(ctx context.Context, p *ListDomainChildrenParams) (*ListDomainChildrenResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDomainChildrenWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDomainChildrenResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainChildrenWithContext indicates an expected call of ListDomainChildrenWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainChildrenWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainChildrenWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainChildrenWithContext), ctx, p)
+}
+
 // ListDomains mocks base method.
 func (m *MockDomainServiceIface) ListDomains(p *ListDomainsParams) (*ListDomainsResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +510,99 @@ func (mr *MockDomainServiceIfaceMockRecorder) ListDomains(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomains", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomains), p)
 }
 
+// ListDomainsAll mocks base method.
+func (m *MockDomainServiceIface) ListDomainsAll(p *ListDomainsParams, opts ...PageOption) ([]*Domain, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainsAll", varargs...)
+	ret0, _ := ret[0].([]*Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainsAll indicates an expected call of ListDomainsAll.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainsAll", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainsAll), varargs...)
+}
+
+// ListDomainsAllWithContext mocks base method.
+func (m *MockDomainServiceIface) ListDomainsAllWithContext(ctx context.Context, p *ListDomainsParams, opts ...PageOption) ([]*Domain, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Domain)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainsAllWithContext indicates an expected call of ListDomainsAllWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainsAllWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainsAllWithContext), varargs...)
+}
+
+// ListDomainsIter mocks base method.
+func (m *MockDomainServiceIface) ListDomainsIter(p *ListDomainsParams, opts ...PageOption) iter.Seq2[*Domain, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Domain, error])
+	return ret0
+}
+
+// ListDomainsIter indicates an expected call of ListDomainsIter.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainsIter", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainsIter), varargs...)
+}
+
+// ListDomainsIterWithContext mocks base method.
+func (m *MockDomainServiceIface) ListDomainsIterWithContext(ctx context.Context, p *ListDomainsParams, opts ...PageOption) iter.Seq2[*Domain, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDomainsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Domain, error])
+	return ret0
+}
+
+// ListDomainsIterWithContext indicates an expected call of ListDomainsIterWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainsIterWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainsIterWithContext), varargs...)
+}
+
+// ListDomainsWithContext mocks base method.
+func (m *MockDomainServiceIface) ListDomainsWithContext(ctx context.Context, p *ListDomainsParams) (*ListDomainsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDomainsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDomainsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDomainsWithContext indicates an expected call of ListDomainsWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) ListDomainsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDomainsWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).ListDomainsWithContext), ctx, p)
+}
+
 // MoveDomain mocks base method.
 func (m *MockDomainServiceIface) MoveDomain(p *MoveDomainParams) (*MoveDomainResponse, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +618,21 @@ func (mr *MockDomainServiceIfaceMockRecorder) MoveDomain(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).MoveDomain), p)
 }
 
+// MoveDomainWithContext mocks base method.
+func (m *MockDomainServiceIface) MoveDomainWithContext(ctx context.Context, p *MoveDomainParams) (*MoveDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveDomainWithContext", ctx, p)
+	ret0, _ := ret[0].(*MoveDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveDomainWithContext indicates an expected call of MoveDomainWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) MoveDomainWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveDomainWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).MoveDomainWithContext), ctx, p)
+}
+
 // NewCreateDomainParams mocks base method.
 func (m *MockDomainServiceIface) NewCreateDomainParams(name string) *CreateDomainParams {
 	m.ctrl.T.Helper()
@@ -357,3 +731,18 @@ func (mr *MockDomainServiceIfaceMockRecorder) UpdateDomain(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomain", reflect.TypeOf((*MockDomainServiceIface)(nil).UpdateDomain), p)
 }
+
+// UpdateDomainWithContext mocks base method.
+func (m *MockDomainServiceIface) UpdateDomainWithContext(ctx context.Context, p *UpdateDomainParams) (*UpdateDomainResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDomainWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateDomainResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDomainWithContext indicates an expected call of UpdateDomainWithContext.
+func (mr *MockDomainServiceIfaceMockRecorder) UpdateDomainWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDomainWithContext", reflect.TypeOf((*MockDomainServiceIface)(nil).UpdateDomainWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,14 +31,23 @@ import (
 
 type EventServiceIface interface {
 	ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
+	ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error)
 	NewArchiveEventsParams() *ArchiveEventsParams
 	DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error)
+	DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error)
 	NewDeleteEventsParams() *DeleteEventsParams
 	ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error)
+	ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error)
 	NewListEventTypesParams() *ListEventTypesParams
 	ListEvents(p *ListEventsParams) (*ListEventsResponse, error)
+	ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error)
+	ListEventsAll(p *ListEventsParams, opts ...PageOption) ([]*Event, error)
+	ListEventsAllWithContext(ctx context.Context, p *ListEventsParams, opts ...PageOption) ([]*Event, error)
+	ListEventsIter(p *ListEventsParams, opts ...PageOption) iter.Seq2[*Event, error]
+	ListEventsIterWithContext(ctx context.Context, p *ListEventsParams, opts ...PageOption) iter.Seq2[*Event, error]
 	NewListEventsParams() *ListEventsParams
 	GetEventByID(id string, opts ...OptionFunc) (*Event, int, error)
+	GetEventByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Event, int, error)
 }
 
 type ArchiveEventsParams struct {
@@ -64,6 +75,46 @@ func (p *ArchiveEventsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the archiveEvents API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ArchiveEventsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("archiveEvents", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the archiveEvents API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ArchiveEventsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "startdate": 255, "type": 255},
+	}.validate("archiveEvents", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the archiveEvents API, before the request is signed
+func (p *ArchiveEventsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ArchiveEventsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ArchiveEventsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("archiveEvents", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"ids":       decodeParam[[]string],
+		"startdate": decodeParam[string],
+		"type":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ArchiveEventsParams) Clone() *ArchiveEventsParams {
+	return &ArchiveEventsParams{p: cloneParams(p.p)}
+}
+
 func (p *ArchiveEventsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -158,13 +209,22 @@ func (s *EventService) NewArchiveEventsParams() *ArchiveEventsParams {
 
 // Archive one or more events.
 func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
-	resp, err := s.cs.newPostRequest("archiveEvents", p.toURLValues())
+	return s.ArchiveEventsWithContext(context.Background(), p)
+}
+
+// ArchiveEventsWithContext is like ArchiveEvents, but honours the cancellation and deadline of ctx
+func (s *EventService) ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "archiveEvents", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ArchiveEventsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("archiveEvents", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -172,10 +232,11 @@ func (s *EventService) ArchiveEvents(p *ArchiveEventsParams) (*ArchiveEventsResp
 }
 
 type ArchiveEventsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ArchiveEventsResponse) UnmarshalJSON(b []byte) error {
@@ -202,7 +263,7 @@ func (r *ArchiveEventsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ArchiveEventsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteEventsParams struct {
@@ -230,6 +291,46 @@ func (p *DeleteEventsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteEvents API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteEventsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteEvents", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteEvents API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteEventsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "startdate": 255, "type": 255},
+	}.validate("deleteEvents", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteEvents API, before the request is signed
+func (p *DeleteEventsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteEventsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteEventsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteEvents", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"ids":       decodeParam[[]string],
+		"startdate": decodeParam[string],
+		"type":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteEventsParams) Clone() *DeleteEventsParams {
+	return &DeleteEventsParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteEventsParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -324,13 +425,22 @@ func (s *EventService) NewDeleteEventsParams() *DeleteEventsParams {
 
 // Delete one or more events.
 func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteEvents", p.toURLValues())
+	return s.DeleteEventsWithContext(context.Background(), p)
+}
+
+// DeleteEventsWithContext is like DeleteEvents, but honours the cancellation and deadline of ctx
+func (s *EventService) DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteEvents", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteEventsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteEvents", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -338,10 +448,11 @@ func (s *EventService) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsRespons
 }
 
 type DeleteEventsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteEventsResponse) UnmarshalJSON(b []byte) error {
@@ -368,7 +479,7 @@ func (r *DeleteEventsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteEventsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListEventTypesParams struct {
@@ -383,6 +494,39 @@ func (p *ListEventTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listEventTypes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListEventTypesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listEventTypes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listEventTypes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListEventTypesParams) Validate() error {
+	return paramRules{}.validate("listEventTypes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listEventTypes API, before the request is signed
+func (p *ListEventTypesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListEventTypesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListEventTypesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listEventTypes", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListEventTypesParams) Clone() *ListEventTypesParams {
+	return &ListEventTypesParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new ListEventTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
@@ -393,13 +537,22 @@ func (s *EventService) NewListEventTypesParams() *ListEventTypesParams {
 
 // List Event Types
 func (s *EventService) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
-	resp, err := s.cs.newRequest("listEventTypes", p.toURLValues())
+	return s.ListEventTypesWithContext(context.Background(), p)
+}
+
+// ListEventTypesWithContext is like ListEventTypes, but honours the cancellation and deadline of ctx
+func (s *EventService) ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listEventTypes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListEventTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listEventTypes", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -412,9 +565,15 @@ type ListEventTypesResponse struct {
 }
 
 type EventType struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EventType) UnmarshalJSON(b []byte) error {
+	type alias EventType
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListEventsParams struct {
@@ -496,6 +655,62 @@ func (p *ListEventsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listEvents API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListEventsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listEvents", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listEvents API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListEventsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "enddate": 255, "id": 255, "keyword": 255, "level": 255, "projectid": 255, "resourceid": 255, "resourcetype": 255, "startdate": 255, "startid": 255, "state": 255, "type": 255},
+	}.validate("listEvents", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listEvents API, before the request is signed
+func (p *ListEventsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListEventsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListEventsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listEvents", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"archived":     decodeParam[bool],
+		"domainid":     decodeParam[string],
+		"duration":     decodeParam[int],
+		"enddate":      decodeParam[string],
+		"entrytime":    decodeParam[int],
+		"id":           decodeParam[string],
+		"isrecursive":  decodeParam[bool],
+		"keyword":      decodeParam[string],
+		"level":        decodeParam[string],
+		"listall":      decodeParam[bool],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"projectid":    decodeParam[string],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+		"startdate":    decodeParam[string],
+		"startid":      decodeParam[string],
+		"state":        decodeParam[string],
+		"type":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListEventsParams) Clone() *ListEventsParams {
+	return &ListEventsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListEventsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -926,6 +1141,11 @@ func (s *EventService) NewListEventsParams() *ListEventsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int, error) {
+	return s.GetEventByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetEventByIDWithContext is like GetEventByID, but honours the cancellation and deadline of ctx
+func (s *EventService) GetEventByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Event, int, error) {
 	p := &ListEventsParams{}
 	p.p = make(map[string]interface{})
 
@@ -937,11 +1157,10 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 		}
 	}
 
-	l, err := s.ListEvents(p)
+	l, err := s.ListEventsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -959,43 +1178,86 @@ func (s *EventService) GetEventByID(id string, opts ...OptionFunc) (*Event, int,
 
 // A command to list events.
 func (s *EventService) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
-	resp, err := s.cs.newRequest("listEvents", p.toURLValues())
+	return s.ListEventsWithContext(context.Background(), p)
+}
+
+// ListEventsWithContext is like ListEvents, but honours the cancellation and deadline of ctx
+func (s *EventService) ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listEvents", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListEventsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listEvents", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListEventsAll returns all Events matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *EventService) ListEventsAll(p *ListEventsParams, opts ...PageOption) ([]*Event, error) {
+	return s.ListEventsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListEventsAllWithContext is like ListEventsAll, but honours the cancellation and deadline of ctx
+func (s *EventService) ListEventsAllWithContext(ctx context.Context, p *ListEventsParams, opts ...PageOption) ([]*Event, error) {
+	return collectPages(s.ListEventsIterWithContext(ctx, p, opts...))
+}
+
+// ListEventsIter returns an iterator over all Events matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *EventService) ListEventsIter(p *ListEventsParams, opts ...PageOption) iter.Seq2[*Event, error] {
+	return s.ListEventsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListEventsIterWithContext is like ListEventsIter, but honours the cancellation and deadline of ctx
+func (s *EventService) ListEventsIterWithContext(ctx context.Context, p *ListEventsParams, opts ...PageOption) iter.Seq2[*Event, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Event, int, error) {
+		l, err := s.ListEventsWithContext(ctx, &ListEventsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Events, l.Count, nil
+	}, opts...)
+}
+
 type ListEventsResponse struct {
 	Count  int      `json:"count"`
 	Events []*Event `json:"event"`
 }
 
 type Event struct {
-	Account      string `json:"account"`
-	Archived     bool   `json:"archived"`
-	Created      string `json:"created"`
-	Description  string `json:"description"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Level        string `json:"level"`
-	Parentid     string `json:"parentid"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	Resourceid   string `json:"resourceid"`
-	Resourcename string `json:"resourcename"`
-	Resourcetype string `json:"resourcetype"`
-	State        string `json:"state"`
-	Type         string `json:"type"`
-	Username     string `json:"username"`
+	Account      string                     `json:"account"`
+	Archived     FlexBool                   `json:"archived"`
+	Created      Time                       `json:"created"`
+	Description  string                     `json:"description"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Level        string                     `json:"level"`
+	Parentid     string                     `json:"parentid"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	Resourceid   string                     `json:"resourceid"`
+	Resourcename string                     `json:"resourcename"`
+	Resourcetype string                     `json:"resourcetype"`
+	State        string                     `json:"state"`
+	Type         string                     `json:"type"`
+	Username     string                     `json:"username"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Event) UnmarshalJSON(b []byte) error {
+	type alias Event
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockEventServiceIfaceMockRecorder) ArchiveEvents(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveEvents", reflect.TypeOf((*MockEventServiceIface)(nil).ArchiveEvents), p)
 }
 
+// ArchiveEventsWithContext mocks base method.
+func (m *MockEventServiceIface) ArchiveEventsWithContext(ctx context.Context, p *ArchiveEventsParams) (*ArchiveEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveEventsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ArchiveEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveEventsWithContext indicates an expected call of ArchiveEventsWithContext.
+func (mr *MockEventServiceIfaceMockRecorder) ArchiveEventsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveEventsWithContext", reflect.TypeOf((*MockEventServiceIface)(nil).ArchiveEventsWithContext), ctx, p)
+}
+
 // DeleteEvents mocks base method.
 func (m *MockEventServiceIface) DeleteEvents(p *DeleteEventsParams) (*DeleteEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockEventServiceIfaceMockRecorder) DeleteEvents(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEvents", reflect.TypeOf((*MockEventServiceIface)(nil).DeleteEvents), p)
 }
 
+// DeleteEventsWithContext mocks base method.
+func (m *MockEventServiceIface) DeleteEventsWithContext(ctx context.Context, p *DeleteEventsParams) (*DeleteEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEventsWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEventsWithContext indicates an expected call of DeleteEventsWithContext.
+func (mr *MockEventServiceIfaceMockRecorder) DeleteEventsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEventsWithContext", reflect.TypeOf((*MockEventServiceIface)(nil).DeleteEventsWithContext), ctx, p)
+}
+
 // GetEventByID mocks base method.
 func (m *MockEventServiceIface) GetEventByID(id string, opts ...OptionFunc) (*Event, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockEventServiceIfaceMockRecorder) GetEventByID(id any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByID", reflect.TypeOf((*MockEventServiceIface)(nil).GetEventByID), varargs...)
 }
 
+// GetEventByIDWithContext mocks base method.
+func (m *MockEventServiceIface) GetEventByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Event, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEventByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Event)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEventByIDWithContext indicates an expected call of GetEventByIDWithContext.
+func (mr *MockEventServiceIfaceMockRecorder) GetEventByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEventByIDWithContext", reflect.TypeOf((*MockEventServiceIface)(nil).GetEventByIDWithContext), varargs...)
+}
+
 // ListEventTypes mocks base method.
 func (m *MockEventServiceIface) ListEventTypes(p *ListEventTypesParams) (*ListEventTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +177,21 @@ func (mr *MockEventServiceIfaceMockRecorder) ListEventTypes(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventTypes", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventTypes), p)
 }
 
+// ListEventTypesWithContext mocks base method.
+func (m *MockEventServiceIface) ListEventTypesWithContext(ctx context.Context, p *ListEventTypesParams) (*ListEventTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventTypesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListEventTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventTypesWithContext indicates an expected call of ListEventTypesWithContext.
+func (mr *MockEventServiceIfaceMockRecorder) ListEventTypesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventTypesWithContext", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventTypesWithContext), ctx, p)
+}
+
 // ListEvents mocks base method.
 func (m *MockEventServiceIface) ListEvents(p *ListEventsParams) (*ListEventsResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +207,99 @@ func (mr *MockEventServiceIfaceMockRecorder) ListEvents(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEvents", reflect.TypeOf((*MockEventServiceIface)(nil).ListEvents), p)
 }
 
+// ListEventsAll mocks base method.
+func (m *MockEventServiceIface) ListEventsAll(p *ListEventsParams, opts ...PageOption) ([]*Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventsAll", varargs...)
+	ret0, _ := ret[0].([]*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventsAll indicates an expected call of ListEventsAll.
+func (mr *MockEventServiceIfaceMockRecorder) ListEventsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsAll", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventsAll), varargs...)
+}
+
+// ListEventsAllWithContext mocks base method.
+func (m *MockEventServiceIface) ListEventsAllWithContext(ctx context.Context, p *ListEventsParams, opts ...PageOption) ([]*Event, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Event)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventsAllWithContext indicates an expected call of ListEventsAllWithContext.
+func (mr *MockEventServiceIfaceMockRecorder) ListEventsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsAllWithContext", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventsAllWithContext), varargs...)
+}
+
+// ListEventsIter mocks base method.
+func (m *MockEventServiceIface) ListEventsIter(p *ListEventsParams, opts ...PageOption) iter.Seq2[*Event, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Event, error])
+	return ret0
+}
+
+// ListEventsIter indicates an expected call of ListEventsIter.
+func (mr *MockEventServiceIfaceMockRecorder) ListEventsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsIter", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventsIter), varargs...)
+}
+
+// ListEventsIterWithContext mocks base method.
+func (m *MockEventServiceIface) ListEventsIterWithContext(ctx context.Context, p *ListEventsParams, opts ...PageOption) iter.Seq2[*Event, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEventsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Event, error])
+	return ret0
+}
+
+// ListEventsIterWithContext indicates an expected call of ListEventsIterWithContext.
+func (mr *MockEventServiceIfaceMockRecorder) ListEventsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsIterWithContext", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventsIterWithContext), varargs...)
+}
+
+// ListEventsWithContext mocks base method.
+func (m *MockEventServiceIface) ListEventsWithContext(ctx context.Context, p *ListEventsParams) (*ListEventsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEventsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListEventsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEventsWithContext indicates an expected call of ListEventsWithContext.
+func (mr *MockEventServiceIfaceMockRecorder) ListEventsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEventsWithContext", reflect.TypeOf((*MockEventServiceIface)(nil).ListEventsWithContext), ctx, p)
+}
+
 // NewArchiveEventsParams mocks base method.
 func (m *MockEventServiceIface) NewArchiveEventsParams() *ArchiveEventsParams {
 	m.ctrl.T.Helper()
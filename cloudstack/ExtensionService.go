@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,32 +31,58 @@ import (
 
 type ExtensionServiceIface interface {
 	AddCustomAction(p *AddCustomActionParams) (*AddCustomActionResponse, error)
+	AddCustomActionWithContext(ctx context.Context, p *AddCustomActionParams) (*AddCustomActionResponse, error)
 	NewAddCustomActionParams(extensionid string, name string) *AddCustomActionParams
 	CreateExtension(p *CreateExtensionParams) (*CreateExtensionResponse, error)
+	CreateExtensionWithContext(ctx context.Context, p *CreateExtensionParams) (*CreateExtensionResponse, error)
 	NewCreateExtensionParams(name string, extensionType string) *CreateExtensionParams
 	DeleteCustomAction(p *DeleteCustomActionParams) (*DeleteCustomActionResponse, error)
+	DeleteCustomActionWithContext(ctx context.Context, p *DeleteCustomActionParams) (*DeleteCustomActionResponse, error)
 	NewDeleteCustomActionParams() *DeleteCustomActionParams
 	DeleteExtension(p *DeleteExtensionParams) (*DeleteExtensionResponse, error)
+	DeleteExtensionWithContext(ctx context.Context, p *DeleteExtensionParams) (*DeleteExtensionResponse, error)
 	NewDeleteExtensionParams() *DeleteExtensionParams
 	ListCustomActions(p *ListCustomActionsParams) (*ListCustomActionsResponse, error)
+	ListCustomActionsWithContext(ctx context.Context, p *ListCustomActionsParams) (*ListCustomActionsResponse, error)
+	ListCustomActionsAll(p *ListCustomActionsParams, opts ...PageOption) ([]*CustomAction, error)
+	ListCustomActionsAllWithContext(ctx context.Context, p *ListCustomActionsParams, opts ...PageOption) ([]*CustomAction, error)
+	ListCustomActionsIter(p *ListCustomActionsParams, opts ...PageOption) iter.Seq2[*CustomAction, error]
+	ListCustomActionsIterWithContext(ctx context.Context, p *ListCustomActionsParams, opts ...PageOption) iter.Seq2[*CustomAction, error]
 	NewListCustomActionsParams() *ListCustomActionsParams
 	GetCustomActionID(name string, opts ...OptionFunc) (string, int, error)
+	GetCustomActionIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetCustomActionByName(name string, opts ...OptionFunc) (*CustomAction, int, error)
+	GetCustomActionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*CustomAction, int, error)
 	GetCustomActionByID(id string, opts ...OptionFunc) (*CustomAction, int, error)
+	GetCustomActionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*CustomAction, int, error)
 	ListExtensions(p *ListExtensionsParams) (*ListExtensionsResponse, error)
+	ListExtensionsWithContext(ctx context.Context, p *ListExtensionsParams) (*ListExtensionsResponse, error)
+	ListExtensionsAll(p *ListExtensionsParams, opts ...PageOption) ([]*Extension, error)
+	ListExtensionsAllWithContext(ctx context.Context, p *ListExtensionsParams, opts ...PageOption) ([]*Extension, error)
+	ListExtensionsIter(p *ListExtensionsParams, opts ...PageOption) iter.Seq2[*Extension, error]
+	ListExtensionsIterWithContext(ctx context.Context, p *ListExtensionsParams, opts ...PageOption) iter.Seq2[*Extension, error]
 	NewListExtensionsParams() *ListExtensionsParams
 	GetExtensionID(name string, opts ...OptionFunc) (string, int, error)
+	GetExtensionIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetExtensionByName(name string, opts ...OptionFunc) (*Extension, int, error)
+	GetExtensionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Extension, int, error)
 	GetExtensionByID(id string, opts ...OptionFunc) (*Extension, int, error)
+	GetExtensionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Extension, int, error)
 	RegisterExtension(p *RegisterExtensionParams) (*RegisterExtensionResponse, error)
+	RegisterExtensionWithContext(ctx context.Context, p *RegisterExtensionParams) (*RegisterExtensionResponse, error)
 	NewRegisterExtensionParams(extensionid string, resourceid string, resourcetype string) *RegisterExtensionParams
 	RunCustomAction(p *RunCustomActionParams) (*RunCustomActionResponse, error)
+	RunCustomActionWithContext(ctx context.Context, p *RunCustomActionParams) (*RunCustomActionResponse, error)
+	RunCustomActionAsync(ctx context.Context, p *RunCustomActionParams) (*Job, error)
 	NewRunCustomActionParams(customactionid string, resourceid string) *RunCustomActionParams
 	UnregisterExtension(p *UnregisterExtensionParams) (*UnregisterExtensionResponse, error)
+	UnregisterExtensionWithContext(ctx context.Context, p *UnregisterExtensionParams) (*UnregisterExtensionResponse, error)
 	NewUnregisterExtensionParams(extensionid string, resourceid string, resourcetype string) *UnregisterExtensionParams
 	UpdateCustomAction(p *UpdateCustomActionParams) (*UpdateCustomActionResponse, error)
+	UpdateCustomActionWithContext(ctx context.Context, p *UpdateCustomActionParams) (*UpdateCustomActionResponse, error)
 	NewUpdateCustomActionParams(id string) *UpdateCustomActionParams
 	UpdateExtension(p *UpdateExtensionParams) (*UpdateExtensionResponse, error)
+	UpdateExtensionWithContext(ctx context.Context, p *UpdateExtensionParams) (*UpdateExtensionResponse, error)
 	NewUpdateExtensionParams(id string) *UpdateExtensionParams
 }
 
@@ -113,6 +141,54 @@ func (p *AddCustomActionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addCustomAction API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddCustomActionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addCustomAction", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addCustomAction API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddCustomActionParams) Validate() error {
+	return paramRules{
+		required: []string{"extensionid", "name"},
+		lengths:  map[string]int{"description": 4096, "errormessage": 255, "extensionid": 255, "name": 255, "resourcetype": 255, "successmessage": 255},
+	}.validate("addCustomAction", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addCustomAction API, before the request is signed
+func (p *AddCustomActionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddCustomActionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddCustomActionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addCustomAction", b, &p.p, map[string]paramDecoder{
+		"allowedroletypes": decodeParam[[]string],
+		"description":      decodeParam[string],
+		"details":          decodeParam[map[string]string],
+		"enabled":          decodeParam[bool],
+		"errormessage":     decodeParam[string],
+		"extensionid":      decodeParam[string],
+		"name":             decodeParam[string],
+		"parameters":       decodeParam[map[string]string],
+		"resourcetype":     decodeParam[string],
+		"successmessage":   decodeParam[string],
+		"timeout":          decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddCustomActionParams) Clone() *AddCustomActionParams {
+	return &AddCustomActionParams{p: cloneParams(p.p)}
+}
+
 func (p *AddCustomActionParams) SetAllowedroletypes(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -356,13 +432,22 @@ func (s *ExtensionService) NewAddCustomActionParams(extensionid string, name str
 
 // Add a custom action for an extension
 func (s *ExtensionService) AddCustomAction(p *AddCustomActionParams) (*AddCustomActionResponse, error) {
-	resp, err := s.cs.newPostRequest("addCustomAction", p.toURLValues())
+	return s.AddCustomActionWithContext(context.Background(), p)
+}
+
+// AddCustomActionWithContext is like AddCustomAction, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) AddCustomActionWithContext(ctx context.Context, p *AddCustomActionParams) (*AddCustomActionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addCustomAction", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddCustomActionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addCustomAction", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -371,29 +456,41 @@ func (s *ExtensionService) AddCustomAction(p *AddCustomActionParams) (*AddCustom
 
 type AddCustomActionResponse struct {
 	Allowedroletypes []string                            `json:"allowedroletypes"`
-	Created          string                              `json:"created"`
+	Created          Time                                `json:"created"`
 	Description      string                              `json:"description"`
 	Details          map[string]string                   `json:"details"`
-	Enabled          bool                                `json:"enabled"`
+	Enabled          FlexBool                            `json:"enabled"`
 	Errormessage     string                              `json:"errormessage"`
 	Extensionid      string                              `json:"extensionid"`
 	Extensionname    string                              `json:"extensionname"`
 	Id               string                              `json:"id"`
 	JobID            string                              `json:"jobid"`
-	Jobstatus        int                                 `json:"jobstatus"`
+	Jobstatus        FlexInt                             `json:"jobstatus"`
 	Name             string                              `json:"name"`
 	Parameters       []AddCustomActionResponseParameters `json:"parameters"`
 	Resourcetype     string                              `json:"resourcetype"`
 	Successmessage   string                              `json:"successmessage"`
-	Timeout          int                                 `json:"timeout"`
+	Timeout          FlexInt                             `json:"timeout"`
+	Extra            map[string]json.RawMessage          `json:"-"`
+}
+
+func (r *AddCustomActionResponse) UnmarshalJSON(b []byte) error {
+	type alias AddCustomActionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddCustomActionResponseParameters struct {
-	Name             string   `json:"name"`
-	Required         bool     `json:"required"`
-	Type             string   `json:"type"`
-	Validationformat string   `json:"validationformat"`
-	Valueoptions     []string `json:"valueoptions"`
+	Name             string                     `json:"name"`
+	Required         FlexBool                   `json:"required"`
+	Type             string                     `json:"type"`
+	Validationformat string                     `json:"validationformat"`
+	Valueoptions     []string                   `json:"valueoptions"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddCustomActionResponseParameters) UnmarshalJSON(b []byte) error {
+	type alias AddCustomActionResponseParameters
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateExtensionParams struct {
@@ -436,6 +533,51 @@ func (p *CreateExtensionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createExtension API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateExtensionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createExtension", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createExtension API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateExtensionParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "type"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "path": 255, "reservedresourcedetails": 255, "state": 255, "type": 255},
+	}.validate("createExtension", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createExtension API, before the request is signed
+func (p *CreateExtensionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateExtensionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateExtensionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createExtension", b, &p.p, map[string]paramDecoder{
+		"description":                   decodeParam[string],
+		"details":                       decodeParam[map[string]string],
+		"name":                          decodeParam[string],
+		"orchestratorrequirespreparevm": decodeParam[bool],
+		"path":                          decodeParam[string],
+		"reservedresourcedetails":       decodeParam[string],
+		"state":                         decodeParam[string],
+		"type":                          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateExtensionParams) Clone() *CreateExtensionParams {
+	return &CreateExtensionParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateExtensionParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -616,13 +758,22 @@ func (s *ExtensionService) NewCreateExtensionParams(name string, extensionType s
 
 // Create an extension
 func (s *ExtensionService) CreateExtension(p *CreateExtensionParams) (*CreateExtensionResponse, error) {
-	resp, err := s.cs.newPostRequest("createExtension", p.toURLValues())
+	return s.CreateExtensionWithContext(context.Background(), p)
+}
+
+// CreateExtensionWithContext is like CreateExtension, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) CreateExtensionWithContext(ctx context.Context, p *CreateExtensionParams) (*CreateExtensionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createExtension", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateExtensionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createExtension", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -630,29 +781,41 @@ func (s *ExtensionService) CreateExtension(p *CreateExtensionParams) (*CreateExt
 }
 
 type CreateExtensionResponse struct {
-	Created                 string                             `json:"created"`
+	Created                 Time                               `json:"created"`
 	Description             string                             `json:"description"`
 	Details                 map[string]string                  `json:"details"`
 	Id                      string                             `json:"id"`
-	Isuserdefined           bool                               `json:"isuserdefined"`
+	Isuserdefined           FlexBool                           `json:"isuserdefined"`
 	JobID                   string                             `json:"jobid"`
-	Jobstatus               int                                `json:"jobstatus"`
+	Jobstatus               FlexInt                            `json:"jobstatus"`
 	Name                    string                             `json:"name"`
 	Path                    string                             `json:"path"`
-	Pathready               bool                               `json:"pathready"`
-	Removed                 string                             `json:"removed"`
+	Pathready               FlexBool                           `json:"pathready"`
+	Removed                 Time                               `json:"removed"`
 	Reservedresourcedetails string                             `json:"reservedresourcedetails"`
 	Resources               []CreateExtensionResponseResources `json:"resources"`
 	State                   string                             `json:"state"`
 	Type                    string                             `json:"type"`
+	Extra                   map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *CreateExtensionResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateExtensionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateExtensionResponseResources struct {
-	Created string            `json:"created"`
-	Details map[string]string `json:"details"`
-	Id      string            `json:"id"`
-	Name    string            `json:"name"`
-	Type    string            `json:"type"`
+	Created Time                       `json:"created"`
+	Details map[string]string          `json:"details"`
+	Id      string                     `json:"id"`
+	Name    string                     `json:"name"`
+	Type    string                     `json:"type"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateExtensionResponseResources) UnmarshalJSON(b []byte) error {
+	type alias CreateExtensionResponseResources
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteCustomActionParams struct {
@@ -670,6 +833,43 @@ func (p *DeleteCustomActionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteCustomAction API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteCustomActionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteCustomAction", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteCustomAction API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteCustomActionParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("deleteCustomAction", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteCustomAction API, before the request is signed
+func (p *DeleteCustomActionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteCustomActionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteCustomActionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteCustomAction", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteCustomActionParams) Clone() *DeleteCustomActionParams {
+	return &DeleteCustomActionParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteCustomActionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -701,13 +901,22 @@ func (s *ExtensionService) NewDeleteCustomActionParams() *DeleteCustomActionPara
 
 // Delete the custom action
 func (s *ExtensionService) DeleteCustomAction(p *DeleteCustomActionParams) (*DeleteCustomActionResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteCustomAction", p.toURLValues())
+	return s.DeleteCustomActionWithContext(context.Background(), p)
+}
+
+// DeleteCustomActionWithContext is like DeleteCustomAction, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) DeleteCustomActionWithContext(ctx context.Context, p *DeleteCustomActionParams) (*DeleteCustomActionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteCustomAction", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteCustomActionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteCustomAction", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -715,10 +924,11 @@ func (s *ExtensionService) DeleteCustomAction(p *DeleteCustomActionParams) (*Del
 }
 
 type DeleteCustomActionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteCustomActionResponse) UnmarshalJSON(b []byte) error {
@@ -745,7 +955,7 @@ func (r *DeleteCustomActionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteCustomActionResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteExtensionParams struct {
@@ -767,6 +977,44 @@ func (p *DeleteExtensionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteExtension API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteExtensionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteExtension", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteExtension API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteExtensionParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("deleteExtension", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteExtension API, before the request is signed
+func (p *DeleteExtensionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteExtensionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteExtensionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteExtension", b, &p.p, map[string]paramDecoder{
+		"cleanup": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteExtensionParams) Clone() *DeleteExtensionParams {
+	return &DeleteExtensionParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteExtensionParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -819,13 +1067,22 @@ func (s *ExtensionService) NewDeleteExtensionParams() *DeleteExtensionParams {
 
 // Delete the extensions
 func (s *ExtensionService) DeleteExtension(p *DeleteExtensionParams) (*DeleteExtensionResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteExtension", p.toURLValues())
+	return s.DeleteExtensionWithContext(context.Background(), p)
+}
+
+// DeleteExtensionWithContext is like DeleteExtension, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) DeleteExtensionWithContext(ctx context.Context, p *DeleteExtensionParams) (*DeleteExtensionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteExtension", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteExtensionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteExtension", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -833,29 +1090,41 @@ func (s *ExtensionService) DeleteExtension(p *DeleteExtensionParams) (*DeleteExt
 }
 
 type DeleteExtensionResponse struct {
-	Created                 string                             `json:"created"`
+	Created                 Time                               `json:"created"`
 	Description             string                             `json:"description"`
 	Details                 map[string]string                  `json:"details"`
 	Id                      string                             `json:"id"`
-	Isuserdefined           bool                               `json:"isuserdefined"`
+	Isuserdefined           FlexBool                           `json:"isuserdefined"`
 	JobID                   string                             `json:"jobid"`
-	Jobstatus               int                                `json:"jobstatus"`
+	Jobstatus               FlexInt                            `json:"jobstatus"`
 	Name                    string                             `json:"name"`
 	Path                    string                             `json:"path"`
-	Pathready               bool                               `json:"pathready"`
-	Removed                 string                             `json:"removed"`
+	Pathready               FlexBool                           `json:"pathready"`
+	Removed                 Time                               `json:"removed"`
 	Reservedresourcedetails string                             `json:"reservedresourcedetails"`
 	Resources               []DeleteExtensionResponseResources `json:"resources"`
 	State                   string                             `json:"state"`
 	Type                    string                             `json:"type"`
+	Extra                   map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *DeleteExtensionResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteExtensionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteExtensionResponseResources struct {
-	Created string            `json:"created"`
-	Details map[string]string `json:"details"`
-	Id      string            `json:"id"`
-	Name    string            `json:"name"`
-	Type    string            `json:"type"`
+	Created Time                       `json:"created"`
+	Details map[string]string          `json:"details"`
+	Id      string                     `json:"id"`
+	Name    string                     `json:"name"`
+	Type    string                     `json:"type"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteExtensionResponseResources) UnmarshalJSON(b []byte) error {
+	type alias DeleteExtensionResponseResources
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListCustomActionsParams struct {
@@ -900,6 +1169,51 @@ func (p *ListCustomActionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listCustomActions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListCustomActionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listCustomActions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listCustomActions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListCustomActionsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"extensionid": 255, "id": 255, "keyword": 255, "name": 255, "resourceid": 255, "resourcetype": 255},
+	}.validate("listCustomActions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listCustomActions API, before the request is signed
+func (p *ListCustomActionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListCustomActionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListCustomActionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listCustomActions", b, &p.p, map[string]paramDecoder{
+		"enabled":      decodeParam[bool],
+		"extensionid":  decodeParam[string],
+		"id":           decodeParam[string],
+		"keyword":      decodeParam[string],
+		"name":         decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListCustomActionsParams) Clone() *ListCustomActionsParams {
+	return &ListCustomActionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListCustomActionsParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1099,6 +1413,11 @@ func (s *ExtensionService) NewListCustomActionsParams() *ListCustomActionsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ExtensionService) GetCustomActionID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetCustomActionIDWithContext(context.Background(), name, opts...)
+}
+
+// GetCustomActionIDWithContext is like GetCustomActionID, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) GetCustomActionIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListCustomActionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1110,7 +1429,7 @@ func (s *ExtensionService) GetCustomActionID(name string, opts ...OptionFunc) (s
 		}
 	}
 
-	l, err := s.ListCustomActions(p)
+	l, err := s.ListCustomActionsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1135,12 +1454,17 @@ func (s *ExtensionService) GetCustomActionID(name string, opts ...OptionFunc) (s
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ExtensionService) GetCustomActionByName(name string, opts ...OptionFunc) (*CustomAction, int, error) {
-	id, count, err := s.GetCustomActionID(name, opts...)
+	return s.GetCustomActionByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetCustomActionByNameWithContext is like GetCustomActionByName, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) GetCustomActionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*CustomAction, int, error) {
+	id, count, err := s.GetCustomActionIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetCustomActionByID(id, opts...)
+	r, count, err := s.GetCustomActionByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1149,6 +1473,11 @@ func (s *ExtensionService) GetCustomActionByName(name string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ExtensionService) GetCustomActionByID(id string, opts ...OptionFunc) (*CustomAction, int, error) {
+	return s.GetCustomActionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetCustomActionByIDWithContext is like GetCustomActionByID, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) GetCustomActionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*CustomAction, int, error) {
 	p := &ListCustomActionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1160,11 +1489,10 @@ func (s *ExtensionService) GetCustomActionByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListCustomActions(p)
+	l, err := s.ListCustomActionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1182,49 +1510,98 @@ func (s *ExtensionService) GetCustomActionByID(id string, opts ...OptionFunc) (*
 
 // Lists the custom actions
 func (s *ExtensionService) ListCustomActions(p *ListCustomActionsParams) (*ListCustomActionsResponse, error) {
-	resp, err := s.cs.newRequest("listCustomActions", p.toURLValues())
+	return s.ListCustomActionsWithContext(context.Background(), p)
+}
+
+// ListCustomActionsWithContext is like ListCustomActions, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) ListCustomActionsWithContext(ctx context.Context, p *ListCustomActionsParams) (*ListCustomActionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listCustomActions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCustomActionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listCustomActions", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListCustomActionsAll returns all CustomActions matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ExtensionService) ListCustomActionsAll(p *ListCustomActionsParams, opts ...PageOption) ([]*CustomAction, error) {
+	return s.ListCustomActionsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListCustomActionsAllWithContext is like ListCustomActionsAll, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) ListCustomActionsAllWithContext(ctx context.Context, p *ListCustomActionsParams, opts ...PageOption) ([]*CustomAction, error) {
+	return collectPages(s.ListCustomActionsIterWithContext(ctx, p, opts...))
+}
+
+// ListCustomActionsIter returns an iterator over all CustomActions matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ExtensionService) ListCustomActionsIter(p *ListCustomActionsParams, opts ...PageOption) iter.Seq2[*CustomAction, error] {
+	return s.ListCustomActionsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListCustomActionsIterWithContext is like ListCustomActionsIter, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) ListCustomActionsIterWithContext(ctx context.Context, p *ListCustomActionsParams, opts ...PageOption) iter.Seq2[*CustomAction, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*CustomAction, int, error) {
+		l, err := s.ListCustomActionsWithContext(ctx, &ListCustomActionsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.CustomActions, l.Count, nil
+	}, opts...)
+}
+
 type ListCustomActionsResponse struct {
 	Count         int             `json:"count"`
 	CustomActions []*CustomAction `json:"extensioncustomaction"`
 }
 
 type CustomAction struct {
-	Allowedroletypes []string                 `json:"allowedroletypes"`
-	Created          string                   `json:"created"`
-	Description      string                   `json:"description"`
-	Details          map[string]string        `json:"details"`
-	Enabled          bool                     `json:"enabled"`
-	Errormessage     string                   `json:"errormessage"`
-	Extensionid      string                   `json:"extensionid"`
-	Extensionname    string                   `json:"extensionname"`
-	Id               string                   `json:"id"`
-	JobID            string                   `json:"jobid"`
-	Jobstatus        int                      `json:"jobstatus"`
-	Name             string                   `json:"name"`
-	Parameters       []CustomActionParameters `json:"parameters"`
-	Resourcetype     string                   `json:"resourcetype"`
-	Successmessage   string                   `json:"successmessage"`
-	Timeout          int                      `json:"timeout"`
+	Allowedroletypes []string                   `json:"allowedroletypes"`
+	Created          Time                       `json:"created"`
+	Description      string                     `json:"description"`
+	Details          map[string]string          `json:"details"`
+	Enabled          FlexBool                   `json:"enabled"`
+	Errormessage     string                     `json:"errormessage"`
+	Extensionid      string                     `json:"extensionid"`
+	Extensionname    string                     `json:"extensionname"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Parameters       []CustomActionParameters   `json:"parameters"`
+	Resourcetype     string                     `json:"resourcetype"`
+	Successmessage   string                     `json:"successmessage"`
+	Timeout          FlexInt                    `json:"timeout"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CustomAction) UnmarshalJSON(b []byte) error {
+	type alias CustomAction
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CustomActionParameters struct {
-	Name             string   `json:"name"`
-	Required         bool     `json:"required"`
-	Type             string   `json:"type"`
-	Validationformat string   `json:"validationformat"`
-	Valueoptions     []string `json:"valueoptions"`
+	Name             string                     `json:"name"`
+	Required         FlexBool                   `json:"required"`
+	Type             string                     `json:"type"`
+	Validationformat string                     `json:"validationformat"`
+	Valueoptions     []string                   `json:"valueoptions"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CustomActionParameters) UnmarshalJSON(b []byte) error {
+	type alias CustomActionParameters
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListExtensionsParams struct {
@@ -1260,6 +1637,48 @@ func (p *ListExtensionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listExtensions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListExtensionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listExtensions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listExtensions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListExtensionsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255},
+	}.validate("listExtensions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listExtensions API, before the request is signed
+func (p *ListExtensionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListExtensionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListExtensionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listExtensions", b, &p.p, map[string]paramDecoder{
+		"details":  decodeParam[[]string],
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListExtensionsParams) Clone() *ListExtensionsParams {
+	return &ListExtensionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListExtensionsParams) SetDetails(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1396,6 +1815,11 @@ func (s *ExtensionService) NewListExtensionsParams() *ListExtensionsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ExtensionService) GetExtensionID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetExtensionIDWithContext(context.Background(), name, opts...)
+}
+
+// GetExtensionIDWithContext is like GetExtensionID, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) GetExtensionIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListExtensionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1407,7 +1831,7 @@ func (s *ExtensionService) GetExtensionID(name string, opts ...OptionFunc) (stri
 		}
 	}
 
-	l, err := s.ListExtensions(p)
+	l, err := s.ListExtensionsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1432,12 +1856,17 @@ func (s *ExtensionService) GetExtensionID(name string, opts ...OptionFunc) (stri
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ExtensionService) GetExtensionByName(name string, opts ...OptionFunc) (*Extension, int, error) {
-	id, count, err := s.GetExtensionID(name, opts...)
+	return s.GetExtensionByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetExtensionByNameWithContext is like GetExtensionByName, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) GetExtensionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Extension, int, error) {
+	id, count, err := s.GetExtensionIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetExtensionByID(id, opts...)
+	r, count, err := s.GetExtensionByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1446,6 +1875,11 @@ func (s *ExtensionService) GetExtensionByName(name string, opts ...OptionFunc) (
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ExtensionService) GetExtensionByID(id string, opts ...OptionFunc) (*Extension, int, error) {
+	return s.GetExtensionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetExtensionByIDWithContext is like GetExtensionByID, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) GetExtensionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Extension, int, error) {
 	p := &ListExtensionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1457,11 +1891,10 @@ func (s *ExtensionService) GetExtensionByID(id string, opts ...OptionFunc) (*Ext
 		}
 	}
 
-	l, err := s.ListExtensions(p)
+	l, err := s.ListExtensionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1479,48 +1912,97 @@ func (s *ExtensionService) GetExtensionByID(id string, opts ...OptionFunc) (*Ext
 
 // Lists extensions
 func (s *ExtensionService) ListExtensions(p *ListExtensionsParams) (*ListExtensionsResponse, error) {
-	resp, err := s.cs.newRequest("listExtensions", p.toURLValues())
+	return s.ListExtensionsWithContext(context.Background(), p)
+}
+
+// ListExtensionsWithContext is like ListExtensions, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) ListExtensionsWithContext(ctx context.Context, p *ListExtensionsParams) (*ListExtensionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listExtensions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListExtensionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listExtensions", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListExtensionsAll returns all Extensions matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ExtensionService) ListExtensionsAll(p *ListExtensionsParams, opts ...PageOption) ([]*Extension, error) {
+	return s.ListExtensionsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListExtensionsAllWithContext is like ListExtensionsAll, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) ListExtensionsAllWithContext(ctx context.Context, p *ListExtensionsParams, opts ...PageOption) ([]*Extension, error) {
+	return collectPages(s.ListExtensionsIterWithContext(ctx, p, opts...))
+}
+
+// ListExtensionsIter returns an iterator over all Extensions matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ExtensionService) ListExtensionsIter(p *ListExtensionsParams, opts ...PageOption) iter.Seq2[*Extension, error] {
+	return s.ListExtensionsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListExtensionsIterWithContext is like ListExtensionsIter, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) ListExtensionsIterWithContext(ctx context.Context, p *ListExtensionsParams, opts ...PageOption) iter.Seq2[*Extension, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Extension, int, error) {
+		l, err := s.ListExtensionsWithContext(ctx, &ListExtensionsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Extensions, l.Count, nil
+	}, opts...)
+}
+
 type ListExtensionsResponse struct {
 	Count      int          `json:"count"`
 	Extensions []*Extension `json:"extension"`
 }
 
 type Extension struct {
-	Created                 string               `json:"created"`
-	Description             string               `json:"description"`
-	Details                 map[string]string    `json:"details"`
-	Id                      string               `json:"id"`
-	Isuserdefined           bool                 `json:"isuserdefined"`
-	JobID                   string               `json:"jobid"`
-	Jobstatus               int                  `json:"jobstatus"`
-	Name                    string               `json:"name"`
-	Path                    string               `json:"path"`
-	Pathready               bool                 `json:"pathready"`
-	Removed                 string               `json:"removed"`
-	Reservedresourcedetails string               `json:"reservedresourcedetails"`
-	Resources               []ExtensionResources `json:"resources"`
-	State                   string               `json:"state"`
-	Type                    string               `json:"type"`
+	Created                 Time                       `json:"created"`
+	Description             string                     `json:"description"`
+	Details                 map[string]string          `json:"details"`
+	Id                      string                     `json:"id"`
+	Isuserdefined           FlexBool                   `json:"isuserdefined"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Path                    string                     `json:"path"`
+	Pathready               FlexBool                   `json:"pathready"`
+	Removed                 Time                       `json:"removed"`
+	Reservedresourcedetails string                     `json:"reservedresourcedetails"`
+	Resources               []ExtensionResources       `json:"resources"`
+	State                   string                     `json:"state"`
+	Type                    string                     `json:"type"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Extension) UnmarshalJSON(b []byte) error {
+	type alias Extension
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExtensionResources struct {
-	Created string            `json:"created"`
-	Details map[string]string `json:"details"`
-	Id      string            `json:"id"`
-	Name    string            `json:"name"`
-	Type    string            `json:"type"`
+	Created Time                       `json:"created"`
+	Details map[string]string          `json:"details"`
+	Id      string                     `json:"id"`
+	Name    string                     `json:"name"`
+	Type    string                     `json:"type"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExtensionResources) UnmarshalJSON(b []byte) error {
+	type alias ExtensionResources
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterExtensionParams struct {
@@ -1550,6 +2032,47 @@ func (p *RegisterExtensionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerExtension API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterExtensionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerExtension", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerExtension API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterExtensionParams) Validate() error {
+	return paramRules{
+		required: []string{"extensionid", "resourceid", "resourcetype"},
+		lengths:  map[string]int{"extensionid": 255, "resourceid": 255, "resourcetype": 255},
+	}.validate("registerExtension", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerExtension API, before the request is signed
+func (p *RegisterExtensionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterExtensionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterExtensionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerExtension", b, &p.p, map[string]paramDecoder{
+		"details":      decodeParam[map[string]string],
+		"extensionid":  decodeParam[string],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterExtensionParams) Clone() *RegisterExtensionParams {
+	return &RegisterExtensionParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterExtensionParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1647,13 +2170,22 @@ func (s *ExtensionService) NewRegisterExtensionParams(extensionid string, resour
 
 // Register an extension with a resource
 func (s *ExtensionService) RegisterExtension(p *RegisterExtensionParams) (*RegisterExtensionResponse, error) {
-	resp, err := s.cs.newPostRequest("registerExtension", p.toURLValues())
+	return s.RegisterExtensionWithContext(context.Background(), p)
+}
+
+// RegisterExtensionWithContext is like RegisterExtension, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) RegisterExtensionWithContext(ctx context.Context, p *RegisterExtensionParams) (*RegisterExtensionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerExtension", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterExtensionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerExtension", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1661,29 +2193,41 @@ func (s *ExtensionService) RegisterExtension(p *RegisterExtensionParams) (*Regis
 }
 
 type RegisterExtensionResponse struct {
-	Created                 string                               `json:"created"`
+	Created                 Time                                 `json:"created"`
 	Description             string                               `json:"description"`
 	Details                 map[string]string                    `json:"details"`
 	Id                      string                               `json:"id"`
-	Isuserdefined           bool                                 `json:"isuserdefined"`
+	Isuserdefined           FlexBool                             `json:"isuserdefined"`
 	JobID                   string                               `json:"jobid"`
-	Jobstatus               int                                  `json:"jobstatus"`
+	Jobstatus               FlexInt                              `json:"jobstatus"`
 	Name                    string                               `json:"name"`
 	Path                    string                               `json:"path"`
-	Pathready               bool                                 `json:"pathready"`
-	Removed                 string                               `json:"removed"`
+	Pathready               FlexBool                             `json:"pathready"`
+	Removed                 Time                                 `json:"removed"`
 	Reservedresourcedetails string                               `json:"reservedresourcedetails"`
 	Resources               []RegisterExtensionResponseResources `json:"resources"`
 	State                   string                               `json:"state"`
 	Type                    string                               `json:"type"`
+	Extra                   map[string]json.RawMessage           `json:"-"`
+}
+
+func (r *RegisterExtensionResponse) UnmarshalJSON(b []byte) error {
+	type alias RegisterExtensionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterExtensionResponseResources struct {
-	Created string            `json:"created"`
-	Details map[string]string `json:"details"`
-	Id      string            `json:"id"`
-	Name    string            `json:"name"`
-	Type    string            `json:"type"`
+	Created Time                       `json:"created"`
+	Details map[string]string          `json:"details"`
+	Id      string                     `json:"id"`
+	Name    string                     `json:"name"`
+	Type    string                     `json:"type"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RegisterExtensionResponseResources) UnmarshalJSON(b []byte) error {
+	type alias RegisterExtensionResponseResources
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RunCustomActionParams struct {
@@ -1714,6 +2258,47 @@ func (p *RunCustomActionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the runCustomAction API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RunCustomActionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("runCustomAction", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the runCustomAction API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RunCustomActionParams) Validate() error {
+	return paramRules{
+		required: []string{"customactionid", "resourceid"},
+		lengths:  map[string]int{"customactionid": 255, "resourceid": 255, "resourcetype": 255},
+	}.validate("runCustomAction", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the runCustomAction API, before the request is signed
+func (p *RunCustomActionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RunCustomActionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RunCustomActionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("runCustomAction", b, &p.p, map[string]paramDecoder{
+		"customactionid": decodeParam[string],
+		"parameters":     decodeParam[map[string]string],
+		"resourceid":     decodeParam[string],
+		"resourcetype":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RunCustomActionParams) Clone() *RunCustomActionParams {
+	return &RunCustomActionParams{p: cloneParams(p.p)}
+}
+
 func (p *RunCustomActionParams) SetCustomactionid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1810,32 +2395,39 @@ func (s *ExtensionService) NewRunCustomActionParams(customactionid string, resou
 
 // Run the custom action
 func (s *ExtensionService) RunCustomAction(p *RunCustomActionParams) (*RunCustomActionResponse, error) {
-	resp, err := s.cs.newPostRequest("runCustomAction", p.toURLValues())
+	return s.RunCustomActionWithContext(context.Background(), p)
+}
+
+// RunCustomActionWithContext is like RunCustomAction, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) RunCustomActionWithContext(ctx context.Context, p *RunCustomActionParams) (*RunCustomActionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "runCustomAction", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RunCustomActionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("runCustomAction", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "runCustomAction", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("runCustomAction", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1843,13 +2435,38 @@ func (s *ExtensionService) RunCustomAction(p *RunCustomActionParams) (*RunCustom
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RunCustomAction async job into r
+func (r *RunCustomActionResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RunCustomActionAsync starts the async job of RunCustomAction without waiting for it to finish, and returns a handle to the job
+func (s *ExtensionService) RunCustomActionAsync(ctx context.Context, p *RunCustomActionParams) (*Job, error) {
+	r, err := s.RunCustomActionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("runCustomAction", r.JobID), nil
+}
+
 type RunCustomActionResponse struct {
-	Id        string            `json:"id"`
-	JobID     string            `json:"jobid"`
-	Jobstatus int               `json:"jobstatus"`
-	Name      string            `json:"name"`
-	Result    map[string]string `json:"result"`
-	Success   bool              `json:"success"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Result    map[string]string          `json:"result"`
+	Success   bool                       `json:"success"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RunCustomActionResponse) UnmarshalJSON(b []byte) error {
+	type alias RunCustomActionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UnregisterExtensionParams struct {
@@ -1873,6 +2490,46 @@ func (p *UnregisterExtensionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the unregisterExtension API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UnregisterExtensionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("unregisterExtension", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the unregisterExtension API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UnregisterExtensionParams) Validate() error {
+	return paramRules{
+		required: []string{"extensionid", "resourceid", "resourcetype"},
+		lengths:  map[string]int{"extensionid": 255, "resourceid": 255, "resourcetype": 255},
+	}.validate("unregisterExtension", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the unregisterExtension API, before the request is signed
+func (p *UnregisterExtensionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UnregisterExtensionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UnregisterExtensionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("unregisterExtension", b, &p.p, map[string]paramDecoder{
+		"extensionid":  decodeParam[string],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UnregisterExtensionParams) Clone() *UnregisterExtensionParams {
+	return &UnregisterExtensionParams{p: cloneParams(p.p)}
+}
+
 func (p *UnregisterExtensionParams) SetExtensionid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1949,13 +2606,22 @@ func (s *ExtensionService) NewUnregisterExtensionParams(extensionid string, reso
 
 // Unregister an extension with a resource
 func (s *ExtensionService) UnregisterExtension(p *UnregisterExtensionParams) (*UnregisterExtensionResponse, error) {
-	resp, err := s.cs.newPostRequest("unregisterExtension", p.toURLValues())
+	return s.UnregisterExtensionWithContext(context.Background(), p)
+}
+
+// UnregisterExtensionWithContext is like UnregisterExtension, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) UnregisterExtensionWithContext(ctx context.Context, p *UnregisterExtensionParams) (*UnregisterExtensionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "unregisterExtension", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UnregisterExtensionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("unregisterExtension", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1963,29 +2629,41 @@ func (s *ExtensionService) UnregisterExtension(p *UnregisterExtensionParams) (*U
 }
 
 type UnregisterExtensionResponse struct {
-	Created                 string                                 `json:"created"`
+	Created                 Time                                   `json:"created"`
 	Description             string                                 `json:"description"`
 	Details                 map[string]string                      `json:"details"`
 	Id                      string                                 `json:"id"`
-	Isuserdefined           bool                                   `json:"isuserdefined"`
+	Isuserdefined           FlexBool                               `json:"isuserdefined"`
 	JobID                   string                                 `json:"jobid"`
-	Jobstatus               int                                    `json:"jobstatus"`
+	Jobstatus               FlexInt                                `json:"jobstatus"`
 	Name                    string                                 `json:"name"`
 	Path                    string                                 `json:"path"`
-	Pathready               bool                                   `json:"pathready"`
-	Removed                 string                                 `json:"removed"`
+	Pathready               FlexBool                               `json:"pathready"`
+	Removed                 Time                                   `json:"removed"`
 	Reservedresourcedetails string                                 `json:"reservedresourcedetails"`
 	Resources               []UnregisterExtensionResponseResources `json:"resources"`
 	State                   string                                 `json:"state"`
 	Type                    string                                 `json:"type"`
+	Extra                   map[string]json.RawMessage             `json:"-"`
+}
+
+func (r *UnregisterExtensionResponse) UnmarshalJSON(b []byte) error {
+	type alias UnregisterExtensionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UnregisterExtensionResponseResources struct {
-	Created string            `json:"created"`
-	Details map[string]string `json:"details"`
-	Id      string            `json:"id"`
-	Name    string            `json:"name"`
-	Type    string            `json:"type"`
+	Created Time                       `json:"created"`
+	Details map[string]string          `json:"details"`
+	Id      string                     `json:"id"`
+	Name    string                     `json:"name"`
+	Type    string                     `json:"type"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UnregisterExtensionResponseResources) UnmarshalJSON(b []byte) error {
+	type alias UnregisterExtensionResponseResources
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateCustomActionParams struct {
@@ -2048,6 +2726,55 @@ func (p *UpdateCustomActionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateCustomAction API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateCustomActionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateCustomAction", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateCustomAction API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateCustomActionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "errormessage": 255, "id": 255, "resourcetype": 255, "successmessage": 255},
+	}.validate("updateCustomAction", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateCustomAction API, before the request is signed
+func (p *UpdateCustomActionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateCustomActionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateCustomActionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateCustomAction", b, &p.p, map[string]paramDecoder{
+		"allowedroletypes":  decodeParam[[]string],
+		"cleanupdetails":    decodeParam[bool],
+		"cleanupparameters": decodeParam[bool],
+		"description":       decodeParam[string],
+		"details":           decodeParam[map[string]string],
+		"enabled":           decodeParam[bool],
+		"errormessage":      decodeParam[string],
+		"id":                decodeParam[string],
+		"parameters":        decodeParam[map[string]string],
+		"resourcetype":      decodeParam[string],
+		"successmessage":    decodeParam[string],
+		"timeout":           decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateCustomActionParams) Clone() *UpdateCustomActionParams {
+	return &UpdateCustomActionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateCustomActionParams) SetAllowedroletypes(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2311,13 +3038,22 @@ func (s *ExtensionService) NewUpdateCustomActionParams(id string) *UpdateCustomA
 
 // Update the custom action
 func (s *ExtensionService) UpdateCustomAction(p *UpdateCustomActionParams) (*UpdateCustomActionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateCustomAction", p.toURLValues())
+	return s.UpdateCustomActionWithContext(context.Background(), p)
+}
+
+// UpdateCustomActionWithContext is like UpdateCustomAction, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) UpdateCustomActionWithContext(ctx context.Context, p *UpdateCustomActionParams) (*UpdateCustomActionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateCustomAction", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateCustomActionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateCustomAction", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2325,10 +3061,11 @@ func (s *ExtensionService) UpdateCustomAction(p *UpdateCustomActionParams) (*Upd
 }
 
 type UpdateCustomActionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateCustomActionResponse) UnmarshalJSON(b []byte) error {
@@ -2355,7 +3092,7 @@ func (r *UpdateCustomActionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateCustomActionResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateExtensionParams struct {
@@ -2396,6 +3133,50 @@ func (p *UpdateExtensionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateExtension API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateExtensionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateExtension", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateExtension API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateExtensionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "id": 255, "reservedresourcedetails": 255, "state": 255},
+	}.validate("updateExtension", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateExtension API, before the request is signed
+func (p *UpdateExtensionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateExtensionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateExtensionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateExtension", b, &p.p, map[string]paramDecoder{
+		"cleanupdetails":                decodeParam[bool],
+		"description":                   decodeParam[string],
+		"details":                       decodeParam[map[string]string],
+		"id":                            decodeParam[string],
+		"orchestratorrequirespreparevm": decodeParam[bool],
+		"reservedresourcedetails":       decodeParam[string],
+		"state":                         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateExtensionParams) Clone() *UpdateExtensionParams {
+	return &UpdateExtensionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateExtensionParams) SetCleanupdetails(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2554,13 +3335,22 @@ func (s *ExtensionService) NewUpdateExtensionParams(id string) *UpdateExtensionP
 
 // Update the extension
 func (s *ExtensionService) UpdateExtension(p *UpdateExtensionParams) (*UpdateExtensionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateExtension", p.toURLValues())
+	return s.UpdateExtensionWithContext(context.Background(), p)
+}
+
+// UpdateExtensionWithContext is like UpdateExtension, but honours the cancellation and deadline of ctx
+func (s *ExtensionService) UpdateExtensionWithContext(ctx context.Context, p *UpdateExtensionParams) (*UpdateExtensionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateExtension", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateExtensionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateExtension", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2568,27 +3358,39 @@ func (s *ExtensionService) UpdateExtension(p *UpdateExtensionParams) (*UpdateExt
 }
 
 type UpdateExtensionResponse struct {
-	Created                 string                             `json:"created"`
+	Created                 Time                               `json:"created"`
 	Description             string                             `json:"description"`
 	Details                 map[string]string                  `json:"details"`
 	Id                      string                             `json:"id"`
-	Isuserdefined           bool                               `json:"isuserdefined"`
+	Isuserdefined           FlexBool                           `json:"isuserdefined"`
 	JobID                   string                             `json:"jobid"`
-	Jobstatus               int                                `json:"jobstatus"`
+	Jobstatus               FlexInt                            `json:"jobstatus"`
 	Name                    string                             `json:"name"`
 	Path                    string                             `json:"path"`
-	Pathready               bool                               `json:"pathready"`
-	Removed                 string                             `json:"removed"`
+	Pathready               FlexBool                           `json:"pathready"`
+	Removed                 Time                               `json:"removed"`
 	Reservedresourcedetails string                             `json:"reservedresourcedetails"`
 	Resources               []UpdateExtensionResponseResources `json:"resources"`
 	State                   string                             `json:"state"`
 	Type                    string                             `json:"type"`
+	Extra                   map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *UpdateExtensionResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateExtensionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateExtensionResponseResources struct {
-	Created string            `json:"created"`
-	Details map[string]string `json:"details"`
-	Id      string            `json:"id"`
-	Name    string            `json:"name"`
-	Type    string            `json:"type"`
+	Created Time                       `json:"created"`
+	Details map[string]string          `json:"details"`
+	Id      string                     `json:"id"`
+	Name    string                     `json:"name"`
+	Type    string                     `json:"type"`
+	Extra   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateExtensionResponseResources) UnmarshalJSON(b []byte) error {
+	type alias UpdateExtensionResponseResources
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockExtensionServiceIfaceMockRecorder) AddCustomAction(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomAction", reflect.TypeOf((*MockExtensionServiceIface)(nil).AddCustomAction), p)
 }
 
+// AddCustomActionWithContext mocks base method.
+func (m *MockExtensionServiceIface) AddCustomActionWithContext(ctx context.Context, p *AddCustomActionParams) (*AddCustomActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCustomActionWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddCustomActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddCustomActionWithContext indicates an expected call of AddCustomActionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) AddCustomActionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCustomActionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).AddCustomActionWithContext), ctx, p)
+}
+
 // CreateExtension mocks base method.
 func (m *MockExtensionServiceIface) CreateExtension(p *CreateExtensionParams) (*CreateExtensionResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockExtensionServiceIfaceMockRecorder) CreateExtension(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExtension", reflect.TypeOf((*MockExtensionServiceIface)(nil).CreateExtension), p)
 }
 
+// CreateExtensionWithContext mocks base method.
+func (m *MockExtensionServiceIface) CreateExtensionWithContext(ctx context.Context, p *CreateExtensionParams) (*CreateExtensionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateExtensionWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateExtensionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateExtensionWithContext indicates an expected call of CreateExtensionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) CreateExtensionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateExtensionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).CreateExtensionWithContext), ctx, p)
+}
+
 // DeleteCustomAction mocks base method.
 func (m *MockExtensionServiceIface) DeleteCustomAction(p *DeleteCustomActionParams) (*DeleteCustomActionResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,21 @@ func (mr *MockExtensionServiceIfaceMockRecorder) DeleteCustomAction(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomAction", reflect.TypeOf((*MockExtensionServiceIface)(nil).DeleteCustomAction), p)
 }
 
+// DeleteCustomActionWithContext mocks base method.
+func (m *MockExtensionServiceIface) DeleteCustomActionWithContext(ctx context.Context, p *DeleteCustomActionParams) (*DeleteCustomActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteCustomActionWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteCustomActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteCustomActionWithContext indicates an expected call of DeleteCustomActionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) DeleteCustomActionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteCustomActionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).DeleteCustomActionWithContext), ctx, p)
+}
+
 // DeleteExtension mocks base method.
 func (m *MockExtensionServiceIface) DeleteExtension(p *DeleteExtensionParams) (*DeleteExtensionResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +165,21 @@ func (mr *MockExtensionServiceIfaceMockRecorder) DeleteExtension(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExtension", reflect.TypeOf((*MockExtensionServiceIface)(nil).DeleteExtension), p)
 }
 
+// DeleteExtensionWithContext mocks base method.
+func (m *MockExtensionServiceIface) DeleteExtensionWithContext(ctx context.Context, p *DeleteExtensionParams) (*DeleteExtensionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteExtensionWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteExtensionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteExtensionWithContext indicates an expected call of DeleteExtensionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) DeleteExtensionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteExtensionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).DeleteExtensionWithContext), ctx, p)
+}
+
 // GetCustomActionByID mocks base method.
 func (m *MockExtensionServiceIface) GetCustomActionByID(id string, opts ...OptionFunc) (*CustomAction, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +201,27 @@ func (mr *MockExtensionServiceIfaceMockRecorder) GetCustomActionByID(id any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomActionByID", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetCustomActionByID), varargs...)
 }
 
+// GetCustomActionByIDWithContext mocks base method.
+func (m *MockExtensionServiceIface) GetCustomActionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*CustomAction, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCustomActionByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*CustomAction)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCustomActionByIDWithContext indicates an expected call of GetCustomActionByIDWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) GetCustomActionByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomActionByIDWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetCustomActionByIDWithContext), varargs...)
+}
+
 // GetCustomActionByName mocks base method.
 func (m *MockExtensionServiceIface) GetCustomActionByName(name string, opts ...OptionFunc) (*CustomAction, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +243,27 @@ func (mr *MockExtensionServiceIfaceMockRecorder) GetCustomActionByName(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomActionByName", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetCustomActionByName), varargs...)
 }
 
+// GetCustomActionByNameWithContext mocks base method.
+func (m *MockExtensionServiceIface) GetCustomActionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*CustomAction, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCustomActionByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*CustomAction)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCustomActionByNameWithContext indicates an expected call of GetCustomActionByNameWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) GetCustomActionByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomActionByNameWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetCustomActionByNameWithContext), varargs...)
+}
+
 // GetCustomActionID mocks base method.
 func (m *MockExtensionServiceIface) GetCustomActionID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +285,27 @@ func (mr *MockExtensionServiceIfaceMockRecorder) GetCustomActionID(name any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomActionID", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetCustomActionID), varargs...)
 }
 
+// GetCustomActionIDWithContext mocks base method.
+func (m *MockExtensionServiceIface) GetCustomActionIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetCustomActionIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetCustomActionIDWithContext indicates an expected call of GetCustomActionIDWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) GetCustomActionIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCustomActionIDWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetCustomActionIDWithContext), varargs...)
+}
+
 // GetExtensionByID mocks base method.
 func (m *MockExtensionServiceIface) GetExtensionByID(id string, opts ...OptionFunc) (*Extension, int, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +327,27 @@ func (mr *MockExtensionServiceIfaceMockRecorder) GetExtensionByID(id any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtensionByID", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetExtensionByID), varargs...)
 }
 
+// GetExtensionByIDWithContext mocks base method.
+func (m *MockExtensionServiceIface) GetExtensionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Extension, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExtensionByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Extension)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExtensionByIDWithContext indicates an expected call of GetExtensionByIDWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) GetExtensionByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtensionByIDWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetExtensionByIDWithContext), varargs...)
+}
+
 // GetExtensionByName mocks base method.
 func (m *MockExtensionServiceIface) GetExtensionByName(name string, opts ...OptionFunc) (*Extension, int, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +369,27 @@ func (mr *MockExtensionServiceIfaceMockRecorder) GetExtensionByName(name any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtensionByName", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetExtensionByName), varargs...)
 }
 
+// GetExtensionByNameWithContext mocks base method.
+func (m *MockExtensionServiceIface) GetExtensionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Extension, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExtensionByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Extension)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExtensionByNameWithContext indicates an expected call of GetExtensionByNameWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) GetExtensionByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtensionByNameWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetExtensionByNameWithContext), varargs...)
+}
+
 // GetExtensionID mocks base method.
 func (m *MockExtensionServiceIface) GetExtensionID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +411,27 @@ func (mr *MockExtensionServiceIfaceMockRecorder) GetExtensionID(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtensionID", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetExtensionID), varargs...)
 }
 
+// GetExtensionIDWithContext mocks base method.
+func (m *MockExtensionServiceIface) GetExtensionIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetExtensionIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetExtensionIDWithContext indicates an expected call of GetExtensionIDWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) GetExtensionIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetExtensionIDWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).GetExtensionIDWithContext), varargs...)
+}
+
 // ListCustomActions mocks base method.
 func (m *MockExtensionServiceIface) ListCustomActions(p *ListCustomActionsParams) (*ListCustomActionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +447,99 @@ func (mr *MockExtensionServiceIfaceMockRecorder) ListCustomActions(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomActions", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListCustomActions), p)
 }
 
+// ListCustomActionsAll mocks base method.
+func (m *MockExtensionServiceIface) ListCustomActionsAll(p *ListCustomActionsParams, opts ...PageOption) ([]*CustomAction, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomActionsAll", varargs...)
+	ret0, _ := ret[0].([]*CustomAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomActionsAll indicates an expected call of ListCustomActionsAll.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListCustomActionsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomActionsAll", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListCustomActionsAll), varargs...)
+}
+
+// ListCustomActionsAllWithContext mocks base method.
+func (m *MockExtensionServiceIface) ListCustomActionsAllWithContext(ctx context.Context, p *ListCustomActionsParams, opts ...PageOption) ([]*CustomAction, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomActionsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*CustomAction)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomActionsAllWithContext indicates an expected call of ListCustomActionsAllWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListCustomActionsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomActionsAllWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListCustomActionsAllWithContext), varargs...)
+}
+
+// ListCustomActionsIter mocks base method.
+func (m *MockExtensionServiceIface) ListCustomActionsIter(p *ListCustomActionsParams, opts ...PageOption) iter.Seq2[*CustomAction, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomActionsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*CustomAction, error])
+	return ret0
+}
+
+// ListCustomActionsIter indicates an expected call of ListCustomActionsIter.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListCustomActionsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomActionsIter", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListCustomActionsIter), varargs...)
+}
+
+// ListCustomActionsIterWithContext mocks base method.
+func (m *MockExtensionServiceIface) ListCustomActionsIterWithContext(ctx context.Context, p *ListCustomActionsParams, opts ...PageOption) iter.Seq2[*CustomAction, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCustomActionsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*CustomAction, error])
+	return ret0
+}
+
+// ListCustomActionsIterWithContext indicates an expected call of ListCustomActionsIterWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListCustomActionsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomActionsIterWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListCustomActionsIterWithContext), varargs...)
+}
+
+// ListCustomActionsWithContext mocks base method.
+func (m *MockExtensionServiceIface) ListCustomActionsWithContext(ctx context.Context, p *ListCustomActionsParams) (*ListCustomActionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCustomActionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListCustomActionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCustomActionsWithContext indicates an expected call of ListCustomActionsWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListCustomActionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCustomActionsWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListCustomActionsWithContext), ctx, p)
+}
+
 // ListExtensions mocks base method.
 func (m *MockExtensionServiceIface) ListExtensions(p *ListExtensionsParams) (*ListExtensionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -274,6 +555,99 @@ func (mr *MockExtensionServiceIfaceMockRecorder) ListExtensions(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExtensions", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListExtensions), p)
 }
 
+// ListExtensionsAll mocks base method.
+func (m *MockExtensionServiceIface) ListExtensionsAll(p *ListExtensionsParams, opts ...PageOption) ([]*Extension, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExtensionsAll", varargs...)
+	ret0, _ := ret[0].([]*Extension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExtensionsAll indicates an expected call of ListExtensionsAll.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListExtensionsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExtensionsAll", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListExtensionsAll), varargs...)
+}
+
+// ListExtensionsAllWithContext mocks base method.
+func (m *MockExtensionServiceIface) ListExtensionsAllWithContext(ctx context.Context, p *ListExtensionsParams, opts ...PageOption) ([]*Extension, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExtensionsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Extension)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExtensionsAllWithContext indicates an expected call of ListExtensionsAllWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListExtensionsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExtensionsAllWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListExtensionsAllWithContext), varargs...)
+}
+
+// ListExtensionsIter mocks base method.
+func (m *MockExtensionServiceIface) ListExtensionsIter(p *ListExtensionsParams, opts ...PageOption) iter.Seq2[*Extension, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExtensionsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Extension, error])
+	return ret0
+}
+
+// ListExtensionsIter indicates an expected call of ListExtensionsIter.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListExtensionsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExtensionsIter", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListExtensionsIter), varargs...)
+}
+
+// ListExtensionsIterWithContext mocks base method.
+func (m *MockExtensionServiceIface) ListExtensionsIterWithContext(ctx context.Context, p *ListExtensionsParams, opts ...PageOption) iter.Seq2[*Extension, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListExtensionsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Extension, error])
+	return ret0
+}
+
+// ListExtensionsIterWithContext indicates an expected call of ListExtensionsIterWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListExtensionsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExtensionsIterWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListExtensionsIterWithContext), varargs...)
+}
+
+// ListExtensionsWithContext mocks base method.
+func (m *MockExtensionServiceIface) ListExtensionsWithContext(ctx context.Context, p *ListExtensionsParams) (*ListExtensionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListExtensionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListExtensionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListExtensionsWithContext indicates an expected call of ListExtensionsWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) ListExtensionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListExtensionsWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).ListExtensionsWithContext), ctx, p)
+}
+
 // NewAddCustomActionParams mocks base method.
 func (m *MockExtensionServiceIface) NewAddCustomActionParams(extensionid, name string) *AddCustomActionParams {
 	m.ctrl.T.Helper()
@@ -443,6 +817,21 @@ func (mr *MockExtensionServiceIfaceMockRecorder) RegisterExtension(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterExtension", reflect.TypeOf((*MockExtensionServiceIface)(nil).RegisterExtension), p)
 }
 
+// RegisterExtensionWithContext mocks base method.
+func (m *MockExtensionServiceIface) RegisterExtensionWithContext(ctx context.Context, p *RegisterExtensionParams) (*RegisterExtensionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterExtensionWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterExtensionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterExtensionWithContext indicates an expected call of RegisterExtensionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) RegisterExtensionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterExtensionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).RegisterExtensionWithContext), ctx, p)
+}
+
 // RunCustomAction mocks base method.
 func (m *MockExtensionServiceIface) RunCustomAction(p *RunCustomActionParams) (*RunCustomActionResponse, error) {
 	m.ctrl.T.Helper()
@@ -458,6 +847,36 @@ func (mr *MockExtensionServiceIfaceMockRecorder) RunCustomAction(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCustomAction", reflect.TypeOf((*MockExtensionServiceIface)(nil).RunCustomAction), p)
 }
 
+// RunCustomActionAsync mocks base method.
+func (m *MockExtensionServiceIface) RunCustomActionAsync(ctx context.Context, p *RunCustomActionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunCustomActionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCustomActionAsync indicates an expected call of RunCustomActionAsync.
+func (mr *MockExtensionServiceIfaceMockRecorder) RunCustomActionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCustomActionAsync", reflect.TypeOf((*MockExtensionServiceIface)(nil).RunCustomActionAsync), ctx, p)
+}
+
+// RunCustomActionWithContext mocks base method.
+func (m *MockExtensionServiceIface) RunCustomActionWithContext(ctx context.Context, p *RunCustomActionParams) (*RunCustomActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunCustomActionWithContext", ctx, p)
+	ret0, _ := ret[0].(*RunCustomActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RunCustomActionWithContext indicates an expected call of RunCustomActionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) RunCustomActionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunCustomActionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).RunCustomActionWithContext), ctx, p)
+}
+
 // UnregisterExtension mocks base method.
 func (m *MockExtensionServiceIface) UnregisterExtension(p *UnregisterExtensionParams) (*UnregisterExtensionResponse, error) {
 	m.ctrl.T.Helper()
@@ -473,6 +892,21 @@ func (mr *MockExtensionServiceIfaceMockRecorder) UnregisterExtension(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterExtension", reflect.TypeOf((*MockExtensionServiceIface)(nil).UnregisterExtension), p)
 }
 
+// UnregisterExtensionWithContext mocks base method.
+func (m *MockExtensionServiceIface) UnregisterExtensionWithContext(ctx context.Context, p *UnregisterExtensionParams) (*UnregisterExtensionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnregisterExtensionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UnregisterExtensionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnregisterExtensionWithContext indicates an expected call of UnregisterExtensionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) UnregisterExtensionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnregisterExtensionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).UnregisterExtensionWithContext), ctx, p)
+}
+
 // UpdateCustomAction mocks base method.
 func (m *MockExtensionServiceIface) UpdateCustomAction(p *UpdateCustomActionParams) (*UpdateCustomActionResponse, error) {
 	m.ctrl.T.Helper()
@@ -488,6 +922,21 @@ func (mr *MockExtensionServiceIfaceMockRecorder) UpdateCustomAction(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomAction", reflect.TypeOf((*MockExtensionServiceIface)(nil).UpdateCustomAction), p)
 }
 
+// UpdateCustomActionWithContext mocks base method.
+func (m *MockExtensionServiceIface) UpdateCustomActionWithContext(ctx context.Context, p *UpdateCustomActionParams) (*UpdateCustomActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCustomActionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateCustomActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCustomActionWithContext indicates an expected call of UpdateCustomActionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) UpdateCustomActionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCustomActionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).UpdateCustomActionWithContext), ctx, p)
+}
+
 // UpdateExtension mocks base method.
 func (m *MockExtensionServiceIface) UpdateExtension(p *UpdateExtensionParams) (*UpdateExtensionResponse, error) {
 	m.ctrl.T.Helper()
@@ -502,3 +951,18 @@ func (mr *MockExtensionServiceIfaceMockRecorder) UpdateExtension(p any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExtension", reflect.TypeOf((*MockExtensionServiceIface)(nil).UpdateExtension), p)
 }
+
+// UpdateExtensionWithContext mocks base method.
+func (m *MockExtensionServiceIface) UpdateExtensionWithContext(ctx context.Context, p *UpdateExtensionParams) (*UpdateExtensionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateExtensionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateExtensionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateExtensionWithContext indicates an expected call of UpdateExtensionWithContext.
+func (mr *MockExtensionServiceIfaceMockRecorder) UpdateExtensionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateExtensionWithContext", reflect.TypeOf((*MockExtensionServiceIface)(nil).UpdateExtensionWithContext), ctx, p)
+}
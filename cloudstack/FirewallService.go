@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -79,57 +81,128 @@ func convertFirewallServiceListResponse(b []byte) ([]byte, error) {
 
 type FirewallServiceIface interface {
 	AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallWithContext(ctx context.Context, p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error)
+	AddPaloAltoFirewallAsync(ctx context.Context, p *AddPaloAltoFirewallParams) (*Job, error)
 	NewAddPaloAltoFirewallParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddPaloAltoFirewallParams
 	ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error)
+	ConfigurePaloAltoFirewallAsync(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*Job, error)
 	NewConfigurePaloAltoFirewallParams(fwdeviceid string) *ConfigurePaloAltoFirewallParams
 	CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
-	NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams
+	CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error)
+	CreateEgressFirewallRuleAsync(ctx context.Context, p *CreateEgressFirewallRuleParams) (*Job, error)
+	NewCreateEgressFirewallRuleParams(networkid string, protocol Protocol) *CreateEgressFirewallRuleParams
 	CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
-	NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams
+	CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error)
+	CreateFirewallRuleAsync(ctx context.Context, p *CreateFirewallRuleParams) (*Job, error)
+	NewCreateFirewallRuleParams(ipaddressid string, protocol Protocol) *CreateFirewallRuleParams
 	CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
-	NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams
+	CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error)
+	CreatePortForwardingRuleAsync(ctx context.Context, p *CreatePortForwardingRuleParams) (*Job, error)
+	NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol Protocol, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams
 	CreateRoutingFirewallRule(p *CreateRoutingFirewallRuleParams) (*CreateRoutingFirewallRuleResponse, error)
-	NewCreateRoutingFirewallRuleParams(networkid string, protocol string) *CreateRoutingFirewallRuleParams
+	CreateRoutingFirewallRuleWithContext(ctx context.Context, p *CreateRoutingFirewallRuleParams) (*CreateRoutingFirewallRuleResponse, error)
+	CreateRoutingFirewallRuleAsync(ctx context.Context, p *CreateRoutingFirewallRuleParams) (*Job, error)
+	NewCreateRoutingFirewallRuleParams(networkid string, protocol Protocol) *CreateRoutingFirewallRuleParams
 	DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error)
+	DeleteEgressFirewallRuleAsync(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*Job, error)
 	NewDeleteEgressFirewallRuleParams(id string) *DeleteEgressFirewallRuleParams
 	DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error)
+	DeleteFirewallRuleAsync(ctx context.Context, p *DeleteFirewallRuleParams) (*Job, error)
 	NewDeleteFirewallRuleParams(id string) *DeleteFirewallRuleParams
 	DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error)
+	DeletePaloAltoFirewallAsync(ctx context.Context, p *DeletePaloAltoFirewallParams) (*Job, error)
 	NewDeletePaloAltoFirewallParams(fwdeviceid string) *DeletePaloAltoFirewallParams
 	DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error)
+	DeletePortForwardingRuleAsync(ctx context.Context, p *DeletePortForwardingRuleParams) (*Job, error)
 	NewDeletePortForwardingRuleParams(id string) *DeletePortForwardingRuleParams
 	DeleteRoutingFirewallRule(p *DeleteRoutingFirewallRuleParams) (*DeleteRoutingFirewallRuleResponse, error)
+	DeleteRoutingFirewallRuleWithContext(ctx context.Context, p *DeleteRoutingFirewallRuleParams) (*DeleteRoutingFirewallRuleResponse, error)
+	DeleteRoutingFirewallRuleAsync(ctx context.Context, p *DeleteRoutingFirewallRuleParams) (*Job, error)
 	NewDeleteRoutingFirewallRuleParams(id string) *DeleteRoutingFirewallRuleParams
 	ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error)
+	ListEgressFirewallRulesAll(p *ListEgressFirewallRulesParams, opts ...PageOption) ([]*EgressFirewallRule, error)
+	ListEgressFirewallRulesAllWithContext(ctx context.Context, p *ListEgressFirewallRulesParams, opts ...PageOption) ([]*EgressFirewallRule, error)
+	ListEgressFirewallRulesIter(p *ListEgressFirewallRulesParams, opts ...PageOption) iter.Seq2[*EgressFirewallRule, error]
+	ListEgressFirewallRulesIterWithContext(ctx context.Context, p *ListEgressFirewallRulesParams, opts ...PageOption) iter.Seq2[*EgressFirewallRule, error]
 	NewListEgressFirewallRulesParams() *ListEgressFirewallRulesParams
 	GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
+	GetEgressFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*EgressFirewallRule, int, error)
 	ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error)
+	ListFirewallRulesAll(p *ListFirewallRulesParams, opts ...PageOption) ([]*FirewallRule, error)
+	ListFirewallRulesAllWithContext(ctx context.Context, p *ListFirewallRulesParams, opts ...PageOption) ([]*FirewallRule, error)
+	ListFirewallRulesIter(p *ListFirewallRulesParams, opts ...PageOption) iter.Seq2[*FirewallRule, error]
+	ListFirewallRulesIterWithContext(ctx context.Context, p *ListFirewallRulesParams, opts ...PageOption) iter.Seq2[*FirewallRule, error]
 	NewListFirewallRulesParams() *ListFirewallRulesParams
 	GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error)
+	GetFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*FirewallRule, int, error)
 	ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error)
+	ListPaloAltoFirewallsAll(p *ListPaloAltoFirewallsParams, opts ...PageOption) ([]*PaloAltoFirewall, error)
+	ListPaloAltoFirewallsAllWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams, opts ...PageOption) ([]*PaloAltoFirewall, error)
+	ListPaloAltoFirewallsIter(p *ListPaloAltoFirewallsParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewall, error]
+	ListPaloAltoFirewallsIterWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewall, error]
 	NewListPaloAltoFirewallsParams() *ListPaloAltoFirewallsParams
 	ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error)
+	ListPortForwardingRulesAll(p *ListPortForwardingRulesParams, opts ...PageOption) ([]*PortForwardingRule, error)
+	ListPortForwardingRulesAllWithContext(ctx context.Context, p *ListPortForwardingRulesParams, opts ...PageOption) ([]*PortForwardingRule, error)
+	ListPortForwardingRulesIter(p *ListPortForwardingRulesParams, opts ...PageOption) iter.Seq2[*PortForwardingRule, error]
+	ListPortForwardingRulesIterWithContext(ctx context.Context, p *ListPortForwardingRulesParams, opts ...PageOption) iter.Seq2[*PortForwardingRule, error]
 	NewListPortForwardingRulesParams() *ListPortForwardingRulesParams
 	GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
+	GetPortForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortForwardingRule, int, error)
 	ListRoutingFirewallRules(p *ListRoutingFirewallRulesParams) (*ListRoutingFirewallRulesResponse, error)
+	ListRoutingFirewallRulesWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams) (*ListRoutingFirewallRulesResponse, error)
+	ListRoutingFirewallRulesAll(p *ListRoutingFirewallRulesParams, opts ...PageOption) ([]*RoutingFirewallRule, error)
+	ListRoutingFirewallRulesAllWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams, opts ...PageOption) ([]*RoutingFirewallRule, error)
+	ListRoutingFirewallRulesIter(p *ListRoutingFirewallRulesParams, opts ...PageOption) iter.Seq2[*RoutingFirewallRule, error]
+	ListRoutingFirewallRulesIterWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams, opts ...PageOption) iter.Seq2[*RoutingFirewallRule, error]
 	NewListRoutingFirewallRulesParams() *ListRoutingFirewallRulesParams
 	GetRoutingFirewallRuleByID(id string, opts ...OptionFunc) (*RoutingFirewallRule, int, error)
+	GetRoutingFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RoutingFirewallRule, int, error)
 	UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error)
+	UpdateEgressFirewallRuleAsync(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*Job, error)
 	NewUpdateEgressFirewallRuleParams(id string) *UpdateEgressFirewallRuleParams
 	UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error)
+	UpdateFirewallRuleAsync(ctx context.Context, p *UpdateFirewallRuleParams) (*Job, error)
 	NewUpdateFirewallRuleParams(id string) *UpdateFirewallRuleParams
 	UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error)
+	UpdatePortForwardingRuleAsync(ctx context.Context, p *UpdatePortForwardingRuleParams) (*Job, error)
 	NewUpdatePortForwardingRuleParams(id string) *UpdatePortForwardingRuleParams
 	ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error)
+	ListIpv6FirewallRulesWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error)
+	ListIpv6FirewallRulesAll(p *ListIpv6FirewallRulesParams, opts ...PageOption) ([]*Ipv6FirewallRule, error)
+	ListIpv6FirewallRulesAllWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams, opts ...PageOption) ([]*Ipv6FirewallRule, error)
+	ListIpv6FirewallRulesIter(p *ListIpv6FirewallRulesParams, opts ...PageOption) iter.Seq2[*Ipv6FirewallRule, error]
+	ListIpv6FirewallRulesIterWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams, opts ...PageOption) iter.Seq2[*Ipv6FirewallRule, error]
 	NewListIpv6FirewallRulesParams() *ListIpv6FirewallRulesParams
 	GetIpv6FirewallRuleByID(id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error)
+	GetIpv6FirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error)
 	CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error)
-	NewCreateIpv6FirewallRuleParams(networkid string, protocol string) *CreateIpv6FirewallRuleParams
+	CreateIpv6FirewallRuleWithContext(ctx context.Context, p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error)
+	CreateIpv6FirewallRuleAsync(ctx context.Context, p *CreateIpv6FirewallRuleParams) (*Job, error)
+	NewCreateIpv6FirewallRuleParams(networkid string, protocol Protocol) *CreateIpv6FirewallRuleParams
 	UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error)
+	UpdateIpv6FirewallRuleWithContext(ctx context.Context, p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error)
+	UpdateIpv6FirewallRuleAsync(ctx context.Context, p *UpdateIpv6FirewallRuleParams) (*Job, error)
 	NewUpdateIpv6FirewallRuleParams(id string) *UpdateIpv6FirewallRuleParams
 	DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error)
+	DeleteIpv6FirewallRuleWithContext(ctx context.Context, p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error)
+	DeleteIpv6FirewallRuleAsync(ctx context.Context, p *DeleteIpv6FirewallRuleParams) (*Job, error)
 	NewDeleteIpv6FirewallRuleParams(id string) *DeleteIpv6FirewallRuleParams
 	UpdateRoutingFirewallRule(p *UpdateRoutingFirewallRuleParams) (*UpdateRoutingFirewallRuleResponse, error)
+	UpdateRoutingFirewallRuleWithContext(ctx context.Context, p *UpdateRoutingFirewallRuleParams) (*UpdateRoutingFirewallRuleResponse, error)
+	UpdateRoutingFirewallRuleAsync(ctx context.Context, p *UpdateRoutingFirewallRuleParams) (*Job, error)
 	NewUpdateRoutingFirewallRuleParams(id string) *UpdateRoutingFirewallRuleParams
 }
 
@@ -160,6 +233,48 @@ func (p *AddPaloAltoFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addPaloAltoFirewall API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddPaloAltoFirewallParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addPaloAltoFirewall", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addPaloAltoFirewall API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddPaloAltoFirewallParams) Validate() error {
+	return paramRules{
+		required: []string{"networkdevicetype", "password", "physicalnetworkid", "url", "username"},
+		lengths:  map[string]int{"networkdevicetype": 255, "password": 255, "physicalnetworkid": 255, "url": 2048, "username": 255},
+	}.validate("addPaloAltoFirewall", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addPaloAltoFirewall API, before the request is signed
+func (p *AddPaloAltoFirewallParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddPaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddPaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addPaloAltoFirewall", b, &p.p, map[string]paramDecoder{
+		"networkdevicetype": decodeParam[string],
+		"password":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"url":               decodeParam[string],
+		"username":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddPaloAltoFirewallParams) Clone() *AddPaloAltoFirewallParams {
+	return &AddPaloAltoFirewallParams{p: cloneParams(p.p)}
+}
+
 func (p *AddPaloAltoFirewallParams) SetNetworkdevicetype(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -280,63 +395,95 @@ func (s *FirewallService) NewAddPaloAltoFirewallParams(networkdevicetype string,
 
 // Adds a Palo Alto firewall device
 func (s *FirewallService) AddPaloAltoFirewall(p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newPostRequest("addPaloAltoFirewall", p.toURLValues())
+	return s.AddPaloAltoFirewallWithContext(context.Background(), p)
+}
+
+// AddPaloAltoFirewallWithContext is like AddPaloAltoFirewall, but honours the cancellation and deadline of ctx
+func (s *FirewallService) AddPaloAltoFirewallWithContext(ctx context.Context, p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addPaloAltoFirewall", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddPaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addPaloAltoFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addPaloAltoFirewall", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("addPaloAltoFirewall", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished AddPaloAltoFirewall async job into r
+func (r *AddPaloAltoFirewallResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddPaloAltoFirewallAsync starts the async job of AddPaloAltoFirewall without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) AddPaloAltoFirewallAsync(ctx context.Context, p *AddPaloAltoFirewallParams) (*Job, error) {
+	r, err := s.AddPaloAltoFirewallWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addPaloAltoFirewall", r.JobID), nil
 }
 
 type AddPaloAltoFirewallResponse struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
-	Fwdeviceid        string `json:"fwdeviceid"`
-	Fwdevicename      string `json:"fwdevicename"`
-	Fwdevicestate     string `json:"fwdevicestate"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Numretries        string `json:"numretries"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Privateinterface  string `json:"privateinterface"`
-	Privatezone       string `json:"privatezone"`
-	Provider          string `json:"provider"`
-	Publicinterface   string `json:"publicinterface"`
-	Publiczone        string `json:"publiczone"`
-	Timeout           string `json:"timeout"`
-	Usageinterface    string `json:"usageinterface"`
-	Username          string `json:"username"`
-	Zoneid            string `json:"zoneid"`
+	Fwdevicecapacity  FlexInt64                  `json:"fwdevicecapacity"`
+	Fwdeviceid        string                     `json:"fwdeviceid"`
+	Fwdevicename      string                     `json:"fwdevicename"`
+	Fwdevicestate     string                     `json:"fwdevicestate"`
+	Ipaddress         string                     `json:"ipaddress"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Numretries        string                     `json:"numretries"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Privateinterface  string                     `json:"privateinterface"`
+	Privatezone       string                     `json:"privatezone"`
+	Provider          string                     `json:"provider"`
+	Publicinterface   string                     `json:"publicinterface"`
+	Publiczone        string                     `json:"publiczone"`
+	Timeout           string                     `json:"timeout"`
+	Usageinterface    string                     `json:"usageinterface"`
+	Username          string                     `json:"username"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddPaloAltoFirewallResponse) UnmarshalJSON(b []byte) error {
+	type alias AddPaloAltoFirewallResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ConfigurePaloAltoFirewallParams struct {
@@ -358,6 +505,45 @@ func (p *ConfigurePaloAltoFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configurePaloAltoFirewall API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigurePaloAltoFirewallParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configurePaloAltoFirewall", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configurePaloAltoFirewall API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigurePaloAltoFirewallParams) Validate() error {
+	return paramRules{
+		required: []string{"fwdeviceid"},
+		lengths:  map[string]int{"fwdeviceid": 255},
+	}.validate("configurePaloAltoFirewall", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configurePaloAltoFirewall API, before the request is signed
+func (p *ConfigurePaloAltoFirewallParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigurePaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigurePaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configurePaloAltoFirewall", b, &p.p, map[string]paramDecoder{
+		"fwdevicecapacity": decodeParam[int64],
+		"fwdeviceid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigurePaloAltoFirewallParams) Clone() *ConfigurePaloAltoFirewallParams {
+	return &ConfigurePaloAltoFirewallParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigurePaloAltoFirewallParams) SetFwdevicecapacity(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,63 +597,95 @@ func (s *FirewallService) NewConfigurePaloAltoFirewallParams(fwdeviceid string)
 
 // Configures a Palo Alto firewall device
 func (s *FirewallService) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newPostRequest("configurePaloAltoFirewall", p.toURLValues())
+	return s.ConfigurePaloAltoFirewallWithContext(context.Background(), p)
+}
+
+// ConfigurePaloAltoFirewallWithContext is like ConfigurePaloAltoFirewall, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "configurePaloAltoFirewall", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r PaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configurePaloAltoFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "configurePaloAltoFirewall", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("configurePaloAltoFirewall", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished ConfigurePaloAltoFirewall async job into r
+func (r *PaloAltoFirewallResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ConfigurePaloAltoFirewallAsync starts the async job of ConfigurePaloAltoFirewall without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) ConfigurePaloAltoFirewallAsync(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*Job, error) {
+	r, err := s.ConfigurePaloAltoFirewallWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("configurePaloAltoFirewall", r.JobID), nil
 }
 
 type PaloAltoFirewallResponse struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
-	Fwdeviceid        string `json:"fwdeviceid"`
-	Fwdevicename      string `json:"fwdevicename"`
-	Fwdevicestate     string `json:"fwdevicestate"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Numretries        string `json:"numretries"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Privateinterface  string `json:"privateinterface"`
-	Privatezone       string `json:"privatezone"`
-	Provider          string `json:"provider"`
-	Publicinterface   string `json:"publicinterface"`
-	Publiczone        string `json:"publiczone"`
-	Timeout           string `json:"timeout"`
-	Usageinterface    string `json:"usageinterface"`
-	Username          string `json:"username"`
-	Zoneid            string `json:"zoneid"`
+	Fwdevicecapacity  FlexInt64                  `json:"fwdevicecapacity"`
+	Fwdeviceid        string                     `json:"fwdeviceid"`
+	Fwdevicename      string                     `json:"fwdevicename"`
+	Fwdevicestate     string                     `json:"fwdevicestate"`
+	Ipaddress         string                     `json:"ipaddress"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Numretries        string                     `json:"numretries"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Privateinterface  string                     `json:"privateinterface"`
+	Privatezone       string                     `json:"privatezone"`
+	Provider          string                     `json:"provider"`
+	Publicinterface   string                     `json:"publicinterface"`
+	Publiczone        string                     `json:"publiczone"`
+	Timeout           string                     `json:"timeout"`
+	Usageinterface    string                     `json:"usageinterface"`
+	Username          string                     `json:"username"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PaloAltoFirewallResponse) UnmarshalJSON(b []byte) error {
+	type alias PaloAltoFirewallResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateEgressFirewallRuleParams struct {
@@ -507,7 +725,7 @@ func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
 		u.Set("networkid", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["startport"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -519,6 +737,53 @@ func (p *CreateEgressFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createEgressFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateEgressFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createEgressFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createEgressFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateEgressFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid", "protocol"},
+		lengths:  map[string]int{"networkid": 255, "protocol": 255, "type": 255},
+	}.validate("createEgressFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createEgressFirewallRule API, before the request is signed
+func (p *CreateEgressFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createEgressFirewallRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":     decodeParam[[]string],
+		"destcidrlist": decodeParam[[]string],
+		"endport":      decodeParam[int],
+		"fordisplay":   decodeParam[bool],
+		"icmpcode":     decodeParam[int],
+		"icmptype":     decodeParam[int],
+		"networkid":    decodeParam[string],
+		"protocol":     decodeParam[Protocol],
+		"startport":    decodeParam[int],
+		"type":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateEgressFirewallRuleParams) Clone() *CreateEgressFirewallRuleParams {
+	return &CreateEgressFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateEgressFirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -666,7 +931,7 @@ func (p *CreateEgressFirewallRuleParams) GetNetworkid() (string, bool) {
 	return value, ok
 }
 
-func (p *CreateEgressFirewallRuleParams) SetProtocol(v string) {
+func (p *CreateEgressFirewallRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -679,11 +944,11 @@ func (p *CreateEgressFirewallRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *CreateEgressFirewallRuleParams) GetProtocol() (string, bool) {
+func (p *CreateEgressFirewallRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -731,7 +996,7 @@ func (p *CreateEgressFirewallRuleParams) GetType() (string, bool) {
 
 // You should always use this function to get a new CreateEgressFirewallRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol string) *CreateEgressFirewallRuleParams {
+func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, protocol Protocol) *CreateEgressFirewallRuleParams {
 	p := &CreateEgressFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkid"] = networkid
@@ -741,62 +1006,94 @@ func (s *FirewallService) NewCreateEgressFirewallRuleParams(networkid string, pr
 
 // Creates a egress firewall rule for a given network
 func (s *FirewallService) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createEgressFirewallRule", p.toURLValues())
+	return s.CreateEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// CreateEgressFirewallRuleWithContext is like CreateEgressFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createEgressFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateEgressFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createEgressFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createEgressFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("createEgressFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished CreateEgressFirewallRule async job into r
+func (r *CreateEgressFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateEgressFirewallRuleAsync starts the async job of CreateEgressFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) CreateEgressFirewallRuleAsync(ctx context.Context, p *CreateEgressFirewallRuleParams) (*Job, error) {
+	r, err := s.CreateEgressFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createEgressFirewallRule", r.JobID), nil
 }
 
 type CreateEgressFirewallRuleResponse struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Traffictype  string `json:"traffictype"`
+	Cidrlist     string                     `json:"cidrlist"`
+	Destcidrlist string                     `json:"destcidrlist"`
+	Endport      FlexInt                    `json:"endport"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Icmpcode     FlexInt                    `json:"icmpcode"`
+	Icmptype     FlexInt                    `json:"icmptype"`
+	Id           string                     `json:"id"`
+	Ipaddress    string                     `json:"ipaddress"`
+	Ipaddressid  string                     `json:"ipaddressid"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Protocol     string                     `json:"protocol"`
+	Startport    FlexInt                    `json:"startport"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Traffictype  string                     `json:"traffictype"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateEgressFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateEgressFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateFirewallRuleParams struct {
@@ -832,7 +1129,7 @@ func (p *CreateFirewallRuleParams) toURLValues() url.Values {
 		u.Set("ipaddressid", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["startport"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -844,6 +1141,52 @@ func (p *CreateFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"ipaddressid", "protocol"},
+		lengths:  map[string]int{"ipaddressid": 255, "protocol": 255, "type": 255},
+	}.validate("createFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createFirewallRule API, before the request is signed
+func (p *CreateFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createFirewallRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":    decodeParam[[]string],
+		"endport":     decodeParam[int],
+		"fordisplay":  decodeParam[bool],
+		"icmpcode":    decodeParam[int],
+		"icmptype":    decodeParam[int],
+		"ipaddressid": decodeParam[string],
+		"protocol":    decodeParam[Protocol],
+		"startport":   decodeParam[int],
+		"type":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateFirewallRuleParams) Clone() *CreateFirewallRuleParams {
+	return &CreateFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateFirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -970,7 +1313,7 @@ func (p *CreateFirewallRuleParams) GetIpaddressid() (string, bool) {
 	return value, ok
 }
 
-func (p *CreateFirewallRuleParams) SetProtocol(v string) {
+func (p *CreateFirewallRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -983,11 +1326,11 @@ func (p *CreateFirewallRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *CreateFirewallRuleParams) GetProtocol() (string, bool) {
+func (p *CreateFirewallRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -1035,7 +1378,7 @@ func (p *CreateFirewallRuleParams) GetType() (string, bool) {
 
 // You should always use this function to get a new CreateFirewallRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol string) *CreateFirewallRuleParams {
+func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protocol Protocol) *CreateFirewallRuleParams {
 	p := &CreateFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
@@ -1045,62 +1388,94 @@ func (s *FirewallService) NewCreateFirewallRuleParams(ipaddressid string, protoc
 
 // Creates a firewall rule for a given IP address
 func (s *FirewallService) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createFirewallRule", p.toURLValues())
+	return s.CreateFirewallRuleWithContext(context.Background(), p)
+}
+
+// CreateFirewallRuleWithContext is like CreateFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("createFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished CreateFirewallRule async job into r
+func (r *CreateFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateFirewallRuleAsync starts the async job of CreateFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) CreateFirewallRuleAsync(ctx context.Context, p *CreateFirewallRuleParams) (*Job, error) {
+	r, err := s.CreateFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createFirewallRule", r.JobID), nil
 }
 
 type CreateFirewallRuleResponse struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Traffictype  string `json:"traffictype"`
+	Cidrlist     string                     `json:"cidrlist"`
+	Destcidrlist string                     `json:"destcidrlist"`
+	Endport      FlexInt                    `json:"endport"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Icmpcode     FlexInt                    `json:"icmpcode"`
+	Icmptype     FlexInt                    `json:"icmptype"`
+	Id           string                     `json:"id"`
+	Ipaddress    string                     `json:"ipaddress"`
+	Ipaddressid  string                     `json:"ipaddressid"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Protocol     string                     `json:"protocol"`
+	Startport    FlexInt                    `json:"startport"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Traffictype  string                     `json:"traffictype"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreatePortForwardingRuleParams struct {
@@ -1139,7 +1514,7 @@ func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
 		u.Set("privateport", vv)
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["publicendport"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -1158,6 +1533,55 @@ func (p *CreatePortForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createPortForwardingRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreatePortForwardingRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createPortForwardingRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createPortForwardingRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreatePortForwardingRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"ipaddressid", "privateport", "protocol", "publicport", "virtualmachineid"},
+		lengths:  map[string]int{"ipaddressid": 255, "networkid": 255, "protocol": 255, "virtualmachineid": 255, "vmguestip": 255},
+	}.validate("createPortForwardingRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createPortForwardingRule API, before the request is signed
+func (p *CreatePortForwardingRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreatePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreatePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createPortForwardingRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":         decodeParam[[]string],
+		"fordisplay":       decodeParam[bool],
+		"ipaddressid":      decodeParam[string],
+		"networkid":        decodeParam[string],
+		"openfirewall":     decodeParam[bool],
+		"privateendport":   decodeParam[int],
+		"privateport":      decodeParam[int],
+		"protocol":         decodeParam[Protocol],
+		"publicendport":    decodeParam[int],
+		"publicport":       decodeParam[int],
+		"virtualmachineid": decodeParam[string],
+		"vmguestip":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreatePortForwardingRuleParams) Clone() *CreatePortForwardingRuleParams {
+	return &CreatePortForwardingRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreatePortForwardingRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1305,7 +1729,7 @@ func (p *CreatePortForwardingRuleParams) GetPrivateport() (int, bool) {
 	return value, ok
 }
 
-func (p *CreatePortForwardingRuleParams) SetProtocol(v string) {
+func (p *CreatePortForwardingRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1318,11 +1742,11 @@ func (p *CreatePortForwardingRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *CreatePortForwardingRuleParams) GetProtocol() (string, bool) {
+func (p *CreatePortForwardingRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -1412,7 +1836,7 @@ func (p *CreatePortForwardingRuleParams) GetVmguestip() (string, bool) {
 
 // You should always use this function to get a new CreatePortForwardingRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
+func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol Protocol, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
 	p := &CreatePortForwardingRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
@@ -1425,64 +1849,96 @@ func (s *FirewallService) NewCreatePortForwardingRuleParams(ipaddressid string,
 
 // Creates a port forwarding rule
 func (s *FirewallService) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createPortForwardingRule", p.toURLValues())
+	return s.CreatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// CreatePortForwardingRuleWithContext is like CreatePortForwardingRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createPortForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreatePortForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createPortForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createPortForwardingRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("createPortForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished CreatePortForwardingRule async job into r
+func (r *CreatePortForwardingRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreatePortForwardingRuleAsync starts the async job of CreatePortForwardingRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) CreatePortForwardingRuleAsync(ctx context.Context, p *CreatePortForwardingRuleParams) (*Job, error) {
+	r, err := s.CreatePortForwardingRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createPortForwardingRule", r.JobID), nil
 }
 
 type CreatePortForwardingRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreatePortForwardingRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreatePortForwardingRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateRoutingFirewallRuleParams struct {
@@ -1522,7 +1978,7 @@ func (p *CreateRoutingFirewallRuleParams) toURLValues() url.Values {
 		u.Set("networkid", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["startport"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -1534,6 +1990,53 @@ func (p *CreateRoutingFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createRoutingFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateRoutingFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createRoutingFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createRoutingFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateRoutingFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid", "protocol"},
+		lengths:  map[string]int{"networkid": 255, "protocol": 255, "traffictype": 255},
+	}.validate("createRoutingFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createRoutingFirewallRule API, before the request is signed
+func (p *CreateRoutingFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateRoutingFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateRoutingFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createRoutingFirewallRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":     decodeParam[[]string],
+		"destcidrlist": decodeParam[[]string],
+		"endport":      decodeParam[int],
+		"fordisplay":   decodeParam[bool],
+		"icmpcode":     decodeParam[int],
+		"icmptype":     decodeParam[int],
+		"networkid":    decodeParam[string],
+		"protocol":     decodeParam[Protocol],
+		"startport":    decodeParam[int],
+		"traffictype":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateRoutingFirewallRuleParams) Clone() *CreateRoutingFirewallRuleParams {
+	return &CreateRoutingFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateRoutingFirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1681,7 +2184,7 @@ func (p *CreateRoutingFirewallRuleParams) GetNetworkid() (string, bool) {
 	return value, ok
 }
 
-func (p *CreateRoutingFirewallRuleParams) SetProtocol(v string) {
+func (p *CreateRoutingFirewallRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1694,11 +2197,11 @@ func (p *CreateRoutingFirewallRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *CreateRoutingFirewallRuleParams) GetProtocol() (string, bool) {
+func (p *CreateRoutingFirewallRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -1746,7 +2249,7 @@ func (p *CreateRoutingFirewallRuleParams) GetTraffictype() (string, bool) {
 
 // You should always use this function to get a new CreateRoutingFirewallRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateRoutingFirewallRuleParams(networkid string, protocol string) *CreateRoutingFirewallRuleParams {
+func (s *FirewallService) NewCreateRoutingFirewallRuleParams(networkid string, protocol Protocol) *CreateRoutingFirewallRuleParams {
 	p := &CreateRoutingFirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkid"] = networkid
@@ -1756,64 +2259,96 @@ func (s *FirewallService) NewCreateRoutingFirewallRuleParams(networkid string, p
 
 // Creates a routing firewall rule in the given network in ROUTED mode
 func (s *FirewallService) CreateRoutingFirewallRule(p *CreateRoutingFirewallRuleParams) (*CreateRoutingFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createRoutingFirewallRule", p.toURLValues())
+	return s.CreateRoutingFirewallRuleWithContext(context.Background(), p)
+}
+
+// CreateRoutingFirewallRuleWithContext is like CreateRoutingFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) CreateRoutingFirewallRuleWithContext(ctx context.Context, p *CreateRoutingFirewallRuleParams) (*CreateRoutingFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createRoutingFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateRoutingFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createRoutingFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createRoutingFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("createRoutingFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished CreateRoutingFirewallRule async job into r
+func (r *CreateRoutingFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateRoutingFirewallRuleAsync starts the async job of CreateRoutingFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) CreateRoutingFirewallRuleAsync(ctx context.Context, p *CreateRoutingFirewallRuleParams) (*Job, error) {
+	r, err := s.CreateRoutingFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createRoutingFirewallRule", r.JobID), nil
 }
 
 type CreateRoutingFirewallRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateRoutingFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateRoutingFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteEgressFirewallRuleParams struct {
@@ -1831,6 +2366,44 @@ func (p *DeleteEgressFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteEgressFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteEgressFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteEgressFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteEgressFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteEgressFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteEgressFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteEgressFirewallRule API, before the request is signed
+func (p *DeleteEgressFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteEgressFirewallRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteEgressFirewallRuleParams) Clone() *DeleteEgressFirewallRuleParams {
+	return &DeleteEgressFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteEgressFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1863,32 +2436,39 @@ func (s *FirewallService) NewDeleteEgressFirewallRuleParams(id string) *DeleteEg
 
 // Deletes an egress firewall rule
 func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteEgressFirewallRule", p.toURLValues())
+	return s.DeleteEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteEgressFirewallRuleWithContext is like DeleteEgressFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteEgressFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteEgressFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteEgressFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteEgressFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteEgressFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1896,11 +2476,36 @@ func (s *FirewallService) DeleteEgressFirewallRule(p *DeleteEgressFirewallRulePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteEgressFirewallRule async job into r
+func (r *DeleteEgressFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeleteEgressFirewallRuleAsync starts the async job of DeleteEgressFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) DeleteEgressFirewallRuleAsync(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*Job, error) {
+	r, err := s.DeleteEgressFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteEgressFirewallRule", r.JobID), nil
+}
+
 type DeleteEgressFirewallRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteEgressFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteEgressFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteFirewallRuleParams struct {
@@ -1918,6 +2523,44 @@ func (p *DeleteFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteFirewallRule API, before the request is signed
+func (p *DeleteFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteFirewallRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteFirewallRuleParams) Clone() *DeleteFirewallRuleParams {
+	return &DeleteFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1950,32 +2593,39 @@ func (s *FirewallService) NewDeleteFirewallRuleParams(id string) *DeleteFirewall
 
 // Deletes a firewall rule
 func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteFirewallRule", p.toURLValues())
+	return s.DeleteFirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteFirewallRuleWithContext is like DeleteFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1983,11 +2633,36 @@ func (s *FirewallService) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*Dele
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteFirewallRule async job into r
+func (r *DeleteFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeleteFirewallRuleAsync starts the async job of DeleteFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) DeleteFirewallRuleAsync(ctx context.Context, p *DeleteFirewallRuleParams) (*Job, error) {
+	r, err := s.DeleteFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteFirewallRule", r.JobID), nil
+}
+
 type DeleteFirewallRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeletePaloAltoFirewallParams struct {
@@ -2005,6 +2680,44 @@ func (p *DeletePaloAltoFirewallParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deletePaloAltoFirewall API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeletePaloAltoFirewallParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deletePaloAltoFirewall", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deletePaloAltoFirewall API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeletePaloAltoFirewallParams) Validate() error {
+	return paramRules{
+		required: []string{"fwdeviceid"},
+		lengths:  map[string]int{"fwdeviceid": 255},
+	}.validate("deletePaloAltoFirewall", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deletePaloAltoFirewall API, before the request is signed
+func (p *DeletePaloAltoFirewallParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeletePaloAltoFirewallParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeletePaloAltoFirewallParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deletePaloAltoFirewall", b, &p.p, map[string]paramDecoder{
+		"fwdeviceid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeletePaloAltoFirewallParams) Clone() *DeletePaloAltoFirewallParams {
+	return &DeletePaloAltoFirewallParams{p: cloneParams(p.p)}
+}
+
 func (p *DeletePaloAltoFirewallParams) SetFwdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2037,32 +2750,39 @@ func (s *FirewallService) NewDeletePaloAltoFirewallParams(fwdeviceid string) *De
 
 // delete a Palo Alto firewall device
 func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
-	resp, err := s.cs.newPostRequest("deletePaloAltoFirewall", p.toURLValues())
+	return s.DeletePaloAltoFirewallWithContext(context.Background(), p)
+}
+
+// DeletePaloAltoFirewallWithContext is like DeletePaloAltoFirewall, but honours the cancellation and deadline of ctx
+func (s *FirewallService) DeletePaloAltoFirewallWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deletePaloAltoFirewall", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePaloAltoFirewallResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deletePaloAltoFirewall", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deletePaloAltoFirewall", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deletePaloAltoFirewall", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2070,11 +2790,36 @@ func (s *FirewallService) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeletePaloAltoFirewall async job into r
+func (r *DeletePaloAltoFirewallResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeletePaloAltoFirewallAsync starts the async job of DeletePaloAltoFirewall without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) DeletePaloAltoFirewallAsync(ctx context.Context, p *DeletePaloAltoFirewallParams) (*Job, error) {
+	r, err := s.DeletePaloAltoFirewallWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deletePaloAltoFirewall", r.JobID), nil
+}
+
 type DeletePaloAltoFirewallResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeletePaloAltoFirewallResponse) UnmarshalJSON(b []byte) error {
+	type alias DeletePaloAltoFirewallResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeletePortForwardingRuleParams struct {
@@ -2092,6 +2837,44 @@ func (p *DeletePortForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deletePortForwardingRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeletePortForwardingRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deletePortForwardingRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deletePortForwardingRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeletePortForwardingRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deletePortForwardingRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deletePortForwardingRule API, before the request is signed
+func (p *DeletePortForwardingRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeletePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeletePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deletePortForwardingRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeletePortForwardingRuleParams) Clone() *DeletePortForwardingRuleParams {
+	return &DeletePortForwardingRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeletePortForwardingRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2124,32 +2907,39 @@ func (s *FirewallService) NewDeletePortForwardingRuleParams(id string) *DeletePo
 
 // Deletes a port forwarding rule
 func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deletePortForwardingRule", p.toURLValues())
+	return s.DeletePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// DeletePortForwardingRuleWithContext is like DeletePortForwardingRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deletePortForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePortForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deletePortForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deletePortForwardingRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deletePortForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2157,11 +2947,36 @@ func (s *FirewallService) DeletePortForwardingRule(p *DeletePortForwardingRulePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeletePortForwardingRule async job into r
+func (r *DeletePortForwardingRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeletePortForwardingRuleAsync starts the async job of DeletePortForwardingRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) DeletePortForwardingRuleAsync(ctx context.Context, p *DeletePortForwardingRuleParams) (*Job, error) {
+	r, err := s.DeletePortForwardingRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deletePortForwardingRule", r.JobID), nil
+}
+
 type DeletePortForwardingRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeletePortForwardingRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeletePortForwardingRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteRoutingFirewallRuleParams struct {
@@ -2179,6 +2994,44 @@ func (p *DeleteRoutingFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteRoutingFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteRoutingFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteRoutingFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteRoutingFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteRoutingFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteRoutingFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteRoutingFirewallRule API, before the request is signed
+func (p *DeleteRoutingFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteRoutingFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteRoutingFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteRoutingFirewallRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteRoutingFirewallRuleParams) Clone() *DeleteRoutingFirewallRuleParams {
+	return &DeleteRoutingFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteRoutingFirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2211,32 +3064,39 @@ func (s *FirewallService) NewDeleteRoutingFirewallRuleParams(id string) *DeleteR
 
 // Deletes a routing firewall rule
 func (s *FirewallService) DeleteRoutingFirewallRule(p *DeleteRoutingFirewallRuleParams) (*DeleteRoutingFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteRoutingFirewallRule", p.toURLValues())
+	return s.DeleteRoutingFirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteRoutingFirewallRuleWithContext is like DeleteRoutingFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) DeleteRoutingFirewallRuleWithContext(ctx context.Context, p *DeleteRoutingFirewallRuleParams) (*DeleteRoutingFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteRoutingFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteRoutingFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteRoutingFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteRoutingFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteRoutingFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2244,11 +3104,36 @@ func (s *FirewallService) DeleteRoutingFirewallRule(p *DeleteRoutingFirewallRule
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteRoutingFirewallRule async job into r
+func (r *DeleteRoutingFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeleteRoutingFirewallRuleAsync starts the async job of DeleteRoutingFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) DeleteRoutingFirewallRuleAsync(ctx context.Context, p *DeleteRoutingFirewallRuleParams) (*Job, error) {
+	r, err := s.DeleteRoutingFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteRoutingFirewallRule", r.JobID), nil
+}
+
 type DeleteRoutingFirewallRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteRoutingFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteRoutingFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListEgressFirewallRulesParams struct {
@@ -2311,6 +3196,55 @@ func (p *ListEgressFirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listEgressFirewallRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListEgressFirewallRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listEgressFirewallRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listEgressFirewallRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListEgressFirewallRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "ipaddressid": 255, "keyword": 255, "networkid": 255, "projectid": 255},
+	}.validate("listEgressFirewallRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listEgressFirewallRules API, before the request is signed
+func (p *ListEgressFirewallRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListEgressFirewallRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListEgressFirewallRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listEgressFirewallRules", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"ipaddressid": decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"tags":        decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListEgressFirewallRulesParams) Clone() *ListEgressFirewallRulesParams {
+	return &ListEgressFirewallRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListEgressFirewallRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2594,6 +3528,11 @@ func (s *FirewallService) NewListEgressFirewallRulesParams() *ListEgressFirewall
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+	return s.GetEgressFirewallRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetEgressFirewallRuleByIDWithContext is like GetEgressFirewallRuleByID, but honours the cancellation and deadline of ctx
+func (s *FirewallService) GetEgressFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
 	p := &ListEgressFirewallRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2605,11 +3544,10 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListEgressFirewallRules(p)
+	l, err := s.ListEgressFirewallRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2627,7 +3565,16 @@ func (s *FirewallService) GetEgressFirewallRuleByID(id string, opts ...OptionFun
 
 // Lists all egress firewall rules for network ID.
 func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listEgressFirewallRules", p.toURLValues())
+	return s.ListEgressFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListEgressFirewallRulesWithContext is like ListEgressFirewallRules, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listEgressFirewallRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2638,36 +3585,70 @@ func (s *FirewallService) ListEgressFirewallRules(p *ListEgressFirewallRulesPara
 	}
 
 	var r ListEgressFirewallRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listEgressFirewallRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListEgressFirewallRulesAll returns all EgressFirewallRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *FirewallService) ListEgressFirewallRulesAll(p *ListEgressFirewallRulesParams, opts ...PageOption) ([]*EgressFirewallRule, error) {
+	return s.ListEgressFirewallRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListEgressFirewallRulesAllWithContext is like ListEgressFirewallRulesAll, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListEgressFirewallRulesAllWithContext(ctx context.Context, p *ListEgressFirewallRulesParams, opts ...PageOption) ([]*EgressFirewallRule, error) {
+	return collectPages(s.ListEgressFirewallRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListEgressFirewallRulesIter returns an iterator over all EgressFirewallRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *FirewallService) ListEgressFirewallRulesIter(p *ListEgressFirewallRulesParams, opts ...PageOption) iter.Seq2[*EgressFirewallRule, error] {
+	return s.ListEgressFirewallRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListEgressFirewallRulesIterWithContext is like ListEgressFirewallRulesIter, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListEgressFirewallRulesIterWithContext(ctx context.Context, p *ListEgressFirewallRulesParams, opts ...PageOption) iter.Seq2[*EgressFirewallRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*EgressFirewallRule, int, error) {
+		l, err := s.ListEgressFirewallRulesWithContext(ctx, &ListEgressFirewallRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.EgressFirewallRules, l.Count, nil
+	}, opts...)
+}
+
 type ListEgressFirewallRulesResponse struct {
 	Count               int                   `json:"count"`
 	EgressFirewallRules []*EgressFirewallRule `json:"firewallrule"`
 }
 
 type EgressFirewallRule struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Traffictype  string `json:"traffictype"`
+	Cidrlist     string                     `json:"cidrlist"`
+	Destcidrlist string                     `json:"destcidrlist"`
+	Endport      FlexInt                    `json:"endport"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Icmpcode     FlexInt                    `json:"icmpcode"`
+	Icmptype     FlexInt                    `json:"icmptype"`
+	Id           string                     `json:"id"`
+	Ipaddress    string                     `json:"ipaddress"`
+	Ipaddressid  string                     `json:"ipaddressid"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Protocol     string                     `json:"protocol"`
+	Startport    FlexInt                    `json:"startport"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Traffictype  string                     `json:"traffictype"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EgressFirewallRule) UnmarshalJSON(b []byte) error {
+	type alias EgressFirewallRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListFirewallRulesParams struct {
@@ -2730,6 +3711,55 @@ func (p *ListFirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listFirewallRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListFirewallRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listFirewallRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listFirewallRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListFirewallRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "ipaddressid": 255, "keyword": 255, "networkid": 255, "projectid": 255},
+	}.validate("listFirewallRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listFirewallRules API, before the request is signed
+func (p *ListFirewallRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListFirewallRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListFirewallRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listFirewallRules", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"ipaddressid": decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"tags":        decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListFirewallRulesParams) Clone() *ListFirewallRulesParams {
+	return &ListFirewallRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListFirewallRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3013,6 +4043,11 @@ func (s *FirewallService) NewListFirewallRulesParams() *ListFirewallRulesParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	return s.GetFirewallRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetFirewallRuleByIDWithContext is like GetFirewallRuleByID, but honours the cancellation and deadline of ctx
+func (s *FirewallService) GetFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*FirewallRule, int, error) {
 	p := &ListFirewallRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3024,11 +4059,10 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 		}
 	}
 
-	l, err := s.ListFirewallRules(p)
+	l, err := s.ListFirewallRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3046,7 +4080,16 @@ func (s *FirewallService) GetFirewallRuleByID(id string, opts ...OptionFunc) (*F
 
 // Lists all firewall rules for an IP address.
 func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listFirewallRules", p.toURLValues())
+	return s.ListFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListFirewallRulesWithContext is like ListFirewallRules, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listFirewallRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3057,36 +4100,70 @@ func (s *FirewallService) ListFirewallRules(p *ListFirewallRulesParams) (*ListFi
 	}
 
 	var r ListFirewallRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listFirewallRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListFirewallRulesAll returns all FirewallRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *FirewallService) ListFirewallRulesAll(p *ListFirewallRulesParams, opts ...PageOption) ([]*FirewallRule, error) {
+	return s.ListFirewallRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListFirewallRulesAllWithContext is like ListFirewallRulesAll, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListFirewallRulesAllWithContext(ctx context.Context, p *ListFirewallRulesParams, opts ...PageOption) ([]*FirewallRule, error) {
+	return collectPages(s.ListFirewallRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListFirewallRulesIter returns an iterator over all FirewallRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *FirewallService) ListFirewallRulesIter(p *ListFirewallRulesParams, opts ...PageOption) iter.Seq2[*FirewallRule, error] {
+	return s.ListFirewallRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListFirewallRulesIterWithContext is like ListFirewallRulesIter, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListFirewallRulesIterWithContext(ctx context.Context, p *ListFirewallRulesParams, opts ...PageOption) iter.Seq2[*FirewallRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*FirewallRule, int, error) {
+		l, err := s.ListFirewallRulesWithContext(ctx, &ListFirewallRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.FirewallRules, l.Count, nil
+	}, opts...)
+}
+
 type ListFirewallRulesResponse struct {
 	Count         int             `json:"count"`
 	FirewallRules []*FirewallRule `json:"firewallrule"`
 }
 
 type FirewallRule struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Traffictype  string `json:"traffictype"`
+	Cidrlist     string                     `json:"cidrlist"`
+	Destcidrlist string                     `json:"destcidrlist"`
+	Endport      FlexInt                    `json:"endport"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Icmpcode     FlexInt                    `json:"icmpcode"`
+	Icmptype     FlexInt                    `json:"icmptype"`
+	Id           string                     `json:"id"`
+	Ipaddress    string                     `json:"ipaddress"`
+	Ipaddressid  string                     `json:"ipaddressid"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Protocol     string                     `json:"protocol"`
+	Startport    FlexInt                    `json:"startport"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Traffictype  string                     `json:"traffictype"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *FirewallRule) UnmarshalJSON(b []byte) error {
+	type alias FirewallRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPaloAltoFirewallsParams struct {
@@ -3118,6 +4195,47 @@ func (p *ListPaloAltoFirewallsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPaloAltoFirewalls API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPaloAltoFirewallsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPaloAltoFirewalls", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPaloAltoFirewalls API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPaloAltoFirewallsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"fwdeviceid": 255, "keyword": 255, "physicalnetworkid": 255},
+	}.validate("listPaloAltoFirewalls", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPaloAltoFirewalls API, before the request is signed
+func (p *ListPaloAltoFirewallsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPaloAltoFirewallsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPaloAltoFirewallsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPaloAltoFirewalls", b, &p.p, map[string]paramDecoder{
+		"fwdeviceid":        decodeParam[string],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPaloAltoFirewallsParams) Clone() *ListPaloAltoFirewallsParams {
+	return &ListPaloAltoFirewallsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPaloAltoFirewallsParams) SetFwdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3233,7 +4351,16 @@ func (s *FirewallService) NewListPaloAltoFirewallsParams() *ListPaloAltoFirewall
 
 // Lists Palo Alto firewall devices in a physical Network
 func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewalls", p.toURLValues())
+	return s.ListPaloAltoFirewallsWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallsWithContext is like ListPaloAltoFirewalls, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListPaloAltoFirewallsWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listPaloAltoFirewalls", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3244,37 +4371,71 @@ func (s *FirewallService) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams)
 	}
 
 	var r ListPaloAltoFirewallsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPaloAltoFirewalls", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPaloAltoFirewallsAll returns all PaloAltoFirewalls matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *FirewallService) ListPaloAltoFirewallsAll(p *ListPaloAltoFirewallsParams, opts ...PageOption) ([]*PaloAltoFirewall, error) {
+	return s.ListPaloAltoFirewallsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPaloAltoFirewallsAllWithContext is like ListPaloAltoFirewallsAll, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListPaloAltoFirewallsAllWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams, opts ...PageOption) ([]*PaloAltoFirewall, error) {
+	return collectPages(s.ListPaloAltoFirewallsIterWithContext(ctx, p, opts...))
+}
+
+// ListPaloAltoFirewallsIter returns an iterator over all PaloAltoFirewalls matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *FirewallService) ListPaloAltoFirewallsIter(p *ListPaloAltoFirewallsParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewall, error] {
+	return s.ListPaloAltoFirewallsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPaloAltoFirewallsIterWithContext is like ListPaloAltoFirewallsIter, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListPaloAltoFirewallsIterWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewall, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*PaloAltoFirewall, int, error) {
+		l, err := s.ListPaloAltoFirewallsWithContext(ctx, &ListPaloAltoFirewallsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.PaloAltoFirewalls, l.Count, nil
+	}, opts...)
+}
+
 type ListPaloAltoFirewallsResponse struct {
 	Count             int                 `json:"count"`
 	PaloAltoFirewalls []*PaloAltoFirewall `json:"paloaltofirewall"`
 }
 
 type PaloAltoFirewall struct {
-	Fwdevicecapacity  int64  `json:"fwdevicecapacity"`
-	Fwdeviceid        string `json:"fwdeviceid"`
-	Fwdevicename      string `json:"fwdevicename"`
-	Fwdevicestate     string `json:"fwdevicestate"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Numretries        string `json:"numretries"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Privateinterface  string `json:"privateinterface"`
-	Privatezone       string `json:"privatezone"`
-	Provider          string `json:"provider"`
-	Publicinterface   string `json:"publicinterface"`
-	Publiczone        string `json:"publiczone"`
-	Timeout           string `json:"timeout"`
-	Usageinterface    string `json:"usageinterface"`
-	Username          string `json:"username"`
-	Zoneid            string `json:"zoneid"`
+	Fwdevicecapacity  FlexInt64                  `json:"fwdevicecapacity"`
+	Fwdeviceid        string                     `json:"fwdeviceid"`
+	Fwdevicename      string                     `json:"fwdevicename"`
+	Fwdevicestate     string                     `json:"fwdevicestate"`
+	Ipaddress         string                     `json:"ipaddress"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Numretries        string                     `json:"numretries"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Privateinterface  string                     `json:"privateinterface"`
+	Privatezone       string                     `json:"privatezone"`
+	Provider          string                     `json:"provider"`
+	Publicinterface   string                     `json:"publicinterface"`
+	Publiczone        string                     `json:"publiczone"`
+	Timeout           string                     `json:"timeout"`
+	Usageinterface    string                     `json:"usageinterface"`
+	Username          string                     `json:"username"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PaloAltoFirewall) UnmarshalJSON(b []byte) error {
+	type alias PaloAltoFirewall
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPortForwardingRulesParams struct {
@@ -3337,6 +4498,55 @@ func (p *ListPortForwardingRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPortForwardingRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPortForwardingRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPortForwardingRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPortForwardingRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPortForwardingRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "ipaddressid": 255, "keyword": 255, "networkid": 255, "projectid": 255},
+	}.validate("listPortForwardingRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPortForwardingRules API, before the request is signed
+func (p *ListPortForwardingRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPortForwardingRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPortForwardingRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPortForwardingRules", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"ipaddressid": decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"tags":        decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPortForwardingRulesParams) Clone() *ListPortForwardingRulesParams {
+	return &ListPortForwardingRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPortForwardingRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3620,6 +4830,11 @@ func (s *FirewallService) NewListPortForwardingRulesParams() *ListPortForwarding
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+	return s.GetPortForwardingRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPortForwardingRuleByIDWithContext is like GetPortForwardingRuleByID, but honours the cancellation and deadline of ctx
+func (s *FirewallService) GetPortForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
 	p := &ListPortForwardingRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3631,11 +4846,10 @@ func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListPortForwardingRules(p)
+	l, err := s.ListPortForwardingRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3653,7 +4867,16 @@ func (s *FirewallService) GetPortForwardingRuleByID(id string, opts ...OptionFun
 
 // Lists all port forwarding rules for an IP address.
 func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listPortForwardingRules", p.toURLValues())
+	return s.ListPortForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListPortForwardingRulesWithContext is like ListPortForwardingRules, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listPortForwardingRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3664,38 +4887,72 @@ func (s *FirewallService) ListPortForwardingRules(p *ListPortForwardingRulesPara
 	}
 
 	var r ListPortForwardingRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPortForwardingRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPortForwardingRulesAll returns all PortForwardingRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *FirewallService) ListPortForwardingRulesAll(p *ListPortForwardingRulesParams, opts ...PageOption) ([]*PortForwardingRule, error) {
+	return s.ListPortForwardingRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPortForwardingRulesAllWithContext is like ListPortForwardingRulesAll, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListPortForwardingRulesAllWithContext(ctx context.Context, p *ListPortForwardingRulesParams, opts ...PageOption) ([]*PortForwardingRule, error) {
+	return collectPages(s.ListPortForwardingRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListPortForwardingRulesIter returns an iterator over all PortForwardingRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *FirewallService) ListPortForwardingRulesIter(p *ListPortForwardingRulesParams, opts ...PageOption) iter.Seq2[*PortForwardingRule, error] {
+	return s.ListPortForwardingRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPortForwardingRulesIterWithContext is like ListPortForwardingRulesIter, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListPortForwardingRulesIterWithContext(ctx context.Context, p *ListPortForwardingRulesParams, opts ...PageOption) iter.Seq2[*PortForwardingRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*PortForwardingRule, int, error) {
+		l, err := s.ListPortForwardingRulesWithContext(ctx, &ListPortForwardingRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.PortForwardingRules, l.Count, nil
+	}, opts...)
+}
+
 type ListPortForwardingRulesResponse struct {
 	Count               int                   `json:"count"`
 	PortForwardingRules []*PortForwardingRule `json:"portforwardingrule"`
 }
 
 type PortForwardingRule struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PortForwardingRule) UnmarshalJSON(b []byte) error {
+	type alias PortForwardingRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListRoutingFirewallRulesParams struct {
@@ -3758,6 +5015,55 @@ func (p *ListRoutingFirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listRoutingFirewallRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListRoutingFirewallRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listRoutingFirewallRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listRoutingFirewallRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListRoutingFirewallRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "networkid": 255, "projectid": 255, "traffictype": 255},
+	}.validate("listRoutingFirewallRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listRoutingFirewallRules API, before the request is signed
+func (p *ListRoutingFirewallRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListRoutingFirewallRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListRoutingFirewallRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listRoutingFirewallRules", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"tags":        decodeParam[map[string]string],
+		"traffictype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListRoutingFirewallRulesParams) Clone() *ListRoutingFirewallRulesParams {
+	return &ListRoutingFirewallRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListRoutingFirewallRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4041,6 +5347,11 @@ func (s *FirewallService) NewListRoutingFirewallRulesParams() *ListRoutingFirewa
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetRoutingFirewallRuleByID(id string, opts ...OptionFunc) (*RoutingFirewallRule, int, error) {
+	return s.GetRoutingFirewallRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetRoutingFirewallRuleByIDWithContext is like GetRoutingFirewallRuleByID, but honours the cancellation and deadline of ctx
+func (s *FirewallService) GetRoutingFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RoutingFirewallRule, int, error) {
 	p := &ListRoutingFirewallRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -4052,11 +5363,10 @@ func (s *FirewallService) GetRoutingFirewallRuleByID(id string, opts ...OptionFu
 		}
 	}
 
-	l, err := s.ListRoutingFirewallRules(p)
+	l, err := s.ListRoutingFirewallRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4074,7 +5384,16 @@ func (s *FirewallService) GetRoutingFirewallRuleByID(id string, opts ...OptionFu
 
 // Lists all Routing firewall rules
 func (s *FirewallService) ListRoutingFirewallRules(p *ListRoutingFirewallRulesParams) (*ListRoutingFirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listRoutingFirewallRules", p.toURLValues())
+	return s.ListRoutingFirewallRulesWithContext(context.Background(), p)
+}
+
+// ListRoutingFirewallRulesWithContext is like ListRoutingFirewallRules, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListRoutingFirewallRulesWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams) (*ListRoutingFirewallRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listRoutingFirewallRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4085,38 +5404,72 @@ func (s *FirewallService) ListRoutingFirewallRules(p *ListRoutingFirewallRulesPa
 	}
 
 	var r ListRoutingFirewallRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listRoutingFirewallRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListRoutingFirewallRulesAll returns all RoutingFirewallRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *FirewallService) ListRoutingFirewallRulesAll(p *ListRoutingFirewallRulesParams, opts ...PageOption) ([]*RoutingFirewallRule, error) {
+	return s.ListRoutingFirewallRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListRoutingFirewallRulesAllWithContext is like ListRoutingFirewallRulesAll, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListRoutingFirewallRulesAllWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams, opts ...PageOption) ([]*RoutingFirewallRule, error) {
+	return collectPages(s.ListRoutingFirewallRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListRoutingFirewallRulesIter returns an iterator over all RoutingFirewallRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *FirewallService) ListRoutingFirewallRulesIter(p *ListRoutingFirewallRulesParams, opts ...PageOption) iter.Seq2[*RoutingFirewallRule, error] {
+	return s.ListRoutingFirewallRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListRoutingFirewallRulesIterWithContext is like ListRoutingFirewallRulesIter, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListRoutingFirewallRulesIterWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams, opts ...PageOption) iter.Seq2[*RoutingFirewallRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*RoutingFirewallRule, int, error) {
+		l, err := s.ListRoutingFirewallRulesWithContext(ctx, &ListRoutingFirewallRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.RoutingFirewallRules, l.Count, nil
+	}, opts...)
+}
+
 type ListRoutingFirewallRulesResponse struct {
 	Count                int                    `json:"count"`
 	RoutingFirewallRules []*RoutingFirewallRule `json:"firewallrule"`
 }
 
 type RoutingFirewallRule struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RoutingFirewallRule) UnmarshalJSON(b []byte) error {
+	type alias RoutingFirewallRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateEgressFirewallRuleParams struct {
@@ -4141,6 +5494,46 @@ func (p *UpdateEgressFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateEgressFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateEgressFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateEgressFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateEgressFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateEgressFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateEgressFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateEgressFirewallRule API, before the request is signed
+func (p *UpdateEgressFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateEgressFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateEgressFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateEgressFirewallRule", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateEgressFirewallRuleParams) Clone() *UpdateEgressFirewallRuleParams {
+	return &UpdateEgressFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateEgressFirewallRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4215,62 +5608,94 @@ func (s *FirewallService) NewUpdateEgressFirewallRuleParams(id string) *UpdateEg
 
 // Updates egress firewall rule
 func (s *FirewallService) UpdateEgressFirewallRule(p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateEgressFirewallRule", p.toURLValues())
+	return s.UpdateEgressFirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateEgressFirewallRuleWithContext is like UpdateEgressFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateEgressFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateEgressFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateEgressFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateEgressFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("updateEgressFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished UpdateEgressFirewallRule async job into r
+func (r *UpdateEgressFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	return json.Unmarshal(b, r)
+}
+
+// UpdateEgressFirewallRuleAsync starts the async job of UpdateEgressFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) UpdateEgressFirewallRuleAsync(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*Job, error) {
+	r, err := s.UpdateEgressFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateEgressFirewallRule", r.JobID), nil
 }
 
 type UpdateEgressFirewallRuleResponse struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Traffictype  string `json:"traffictype"`
+	Cidrlist     string                     `json:"cidrlist"`
+	Destcidrlist string                     `json:"destcidrlist"`
+	Endport      FlexInt                    `json:"endport"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Icmpcode     FlexInt                    `json:"icmpcode"`
+	Icmptype     FlexInt                    `json:"icmptype"`
+	Id           string                     `json:"id"`
+	Ipaddress    string                     `json:"ipaddress"`
+	Ipaddressid  string                     `json:"ipaddressid"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Protocol     string                     `json:"protocol"`
+	Startport    FlexInt                    `json:"startport"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Traffictype  string                     `json:"traffictype"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateEgressFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateEgressFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateFirewallRuleParams struct {
@@ -4295,6 +5720,46 @@ func (p *UpdateFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateFirewallRule API, before the request is signed
+func (p *UpdateFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateFirewallRule", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateFirewallRuleParams) Clone() *UpdateFirewallRuleParams {
+	return &UpdateFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateFirewallRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4369,62 +5834,94 @@ func (s *FirewallService) NewUpdateFirewallRuleParams(id string) *UpdateFirewall
 
 // Updates firewall rule
 func (s *FirewallService) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateFirewallRule", p.toURLValues())
+	return s.UpdateFirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateFirewallRuleWithContext is like UpdateFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("updateFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished UpdateFirewallRule async job into r
+func (r *UpdateFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateFirewallRuleAsync starts the async job of UpdateFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) UpdateFirewallRuleAsync(ctx context.Context, p *UpdateFirewallRuleParams) (*Job, error) {
+	r, err := s.UpdateFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateFirewallRule", r.JobID), nil
 }
 
 type UpdateFirewallRuleResponse struct {
-	Cidrlist     string `json:"cidrlist"`
-	Destcidrlist string `json:"destcidrlist"`
-	Endport      int    `json:"endport"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Icmpcode     int    `json:"icmpcode"`
-	Icmptype     int    `json:"icmptype"`
-	Id           string `json:"id"`
-	Ipaddress    string `json:"ipaddress"`
-	Ipaddressid  string `json:"ipaddressid"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Protocol     string `json:"protocol"`
-	Startport    int    `json:"startport"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Traffictype  string `json:"traffictype"`
+	Cidrlist     string                     `json:"cidrlist"`
+	Destcidrlist string                     `json:"destcidrlist"`
+	Endport      FlexInt                    `json:"endport"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Icmpcode     FlexInt                    `json:"icmpcode"`
+	Icmptype     FlexInt                    `json:"icmptype"`
+	Id           string                     `json:"id"`
+	Ipaddress    string                     `json:"ipaddress"`
+	Ipaddressid  string                     `json:"ipaddressid"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Protocol     string                     `json:"protocol"`
+	Startport    FlexInt                    `json:"startport"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Traffictype  string                     `json:"traffictype"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdatePortForwardingRuleParams struct {
@@ -4467,6 +5964,51 @@ func (p *UpdatePortForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updatePortForwardingRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdatePortForwardingRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updatePortForwardingRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updatePortForwardingRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdatePortForwardingRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255, "virtualmachineid": 255, "vmguestip": 255},
+	}.validate("updatePortForwardingRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updatePortForwardingRule API, before the request is signed
+func (p *UpdatePortForwardingRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdatePortForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdatePortForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updatePortForwardingRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":         decodeParam[[]string],
+		"customid":         decodeParam[string],
+		"fordisplay":       decodeParam[bool],
+		"id":               decodeParam[string],
+		"privateendport":   decodeParam[int],
+		"privateport":      decodeParam[int],
+		"virtualmachineid": decodeParam[string],
+		"vmguestip":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdatePortForwardingRuleParams) Clone() *UpdatePortForwardingRuleParams {
+	return &UpdatePortForwardingRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdatePortForwardingRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4646,64 +6188,96 @@ func (s *FirewallService) NewUpdatePortForwardingRuleParams(id string) *UpdatePo
 
 // Updates a port forwarding rule. Only the private port and the  Instance can be updated.
 func (s *FirewallService) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("updatePortForwardingRule", p.toURLValues())
+	return s.UpdatePortForwardingRuleWithContext(context.Background(), p)
+}
+
+// UpdatePortForwardingRuleWithContext is like UpdatePortForwardingRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updatePortForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdatePortForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updatePortForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updatePortForwardingRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("updatePortForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished UpdatePortForwardingRule async job into r
+func (r *UpdatePortForwardingRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdatePortForwardingRuleAsync starts the async job of UpdatePortForwardingRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) UpdatePortForwardingRuleAsync(ctx context.Context, p *UpdatePortForwardingRuleParams) (*Job, error) {
+	r, err := s.UpdatePortForwardingRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updatePortForwardingRule", r.JobID), nil
 }
 
 type UpdatePortForwardingRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdatePortForwardingRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdatePortForwardingRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListIpv6FirewallRulesParams struct {
@@ -4766,6 +6340,55 @@ func (p *ListIpv6FirewallRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listIpv6FirewallRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListIpv6FirewallRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listIpv6FirewallRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listIpv6FirewallRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListIpv6FirewallRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "networkid": 255, "projectid": 255, "traffictype": 255},
+	}.validate("listIpv6FirewallRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listIpv6FirewallRules API, before the request is signed
+func (p *ListIpv6FirewallRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListIpv6FirewallRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListIpv6FirewallRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listIpv6FirewallRules", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"tags":        decodeParam[map[string]string],
+		"traffictype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListIpv6FirewallRulesParams) Clone() *ListIpv6FirewallRulesParams {
+	return &ListIpv6FirewallRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListIpv6FirewallRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5049,6 +6672,11 @@ func (s *FirewallService) NewListIpv6FirewallRulesParams() *ListIpv6FirewallRule
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *FirewallService) GetIpv6FirewallRuleByID(id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error) {
+	return s.GetIpv6FirewallRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIpv6FirewallRuleByIDWithContext is like GetIpv6FirewallRuleByID, but honours the cancellation and deadline of ctx
+func (s *FirewallService) GetIpv6FirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error) {
 	p := &ListIpv6FirewallRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -5060,11 +6688,10 @@ func (s *FirewallService) GetIpv6FirewallRuleByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListIpv6FirewallRules(p)
+	l, err := s.ListIpv6FirewallRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -5082,7 +6709,16 @@ func (s *FirewallService) GetIpv6FirewallRuleByID(id string, opts ...OptionFunc)
 
 // Lists all IPv6 firewall rules
 func (s *FirewallService) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error) {
-	resp, err := s.cs.newRequest("listIpv6FirewallRules", p.toURLValues())
+	return s.ListIpv6FirewallRulesWithContext(context.Background(), p)
+}
+
+// ListIpv6FirewallRulesWithContext is like ListIpv6FirewallRules, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListIpv6FirewallRulesWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listIpv6FirewallRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -5093,38 +6729,72 @@ func (s *FirewallService) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams)
 	}
 
 	var r ListIpv6FirewallRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listIpv6FirewallRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListIpv6FirewallRulesAll returns all Ipv6FirewallRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *FirewallService) ListIpv6FirewallRulesAll(p *ListIpv6FirewallRulesParams, opts ...PageOption) ([]*Ipv6FirewallRule, error) {
+	return s.ListIpv6FirewallRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListIpv6FirewallRulesAllWithContext is like ListIpv6FirewallRulesAll, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListIpv6FirewallRulesAllWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams, opts ...PageOption) ([]*Ipv6FirewallRule, error) {
+	return collectPages(s.ListIpv6FirewallRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListIpv6FirewallRulesIter returns an iterator over all Ipv6FirewallRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *FirewallService) ListIpv6FirewallRulesIter(p *ListIpv6FirewallRulesParams, opts ...PageOption) iter.Seq2[*Ipv6FirewallRule, error] {
+	return s.ListIpv6FirewallRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListIpv6FirewallRulesIterWithContext is like ListIpv6FirewallRulesIter, but honours the cancellation and deadline of ctx
+func (s *FirewallService) ListIpv6FirewallRulesIterWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams, opts ...PageOption) iter.Seq2[*Ipv6FirewallRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Ipv6FirewallRule, int, error) {
+		l, err := s.ListIpv6FirewallRulesWithContext(ctx, &ListIpv6FirewallRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Ipv6FirewallRules, l.Count, nil
+	}, opts...)
+}
+
 type ListIpv6FirewallRulesResponse struct {
 	Count             int                 `json:"count"`
 	Ipv6FirewallRules []*Ipv6FirewallRule `json:"ipv6firewallrule"`
 }
 
 type Ipv6FirewallRule struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Ipv6FirewallRule) UnmarshalJSON(b []byte) error {
+	type alias Ipv6FirewallRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateIpv6FirewallRuleParams struct {
@@ -5164,7 +6834,7 @@ func (p *CreateIpv6FirewallRuleParams) toURLValues() url.Values {
 		u.Set("networkid", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["startport"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -5176,6 +6846,53 @@ func (p *CreateIpv6FirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createIpv6FirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateIpv6FirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createIpv6FirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createIpv6FirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateIpv6FirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid", "protocol"},
+		lengths:  map[string]int{"networkid": 255, "protocol": 255, "traffictype": 255},
+	}.validate("createIpv6FirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createIpv6FirewallRule API, before the request is signed
+func (p *CreateIpv6FirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateIpv6FirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateIpv6FirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createIpv6FirewallRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":     decodeParam[[]string],
+		"destcidrlist": decodeParam[[]string],
+		"endport":      decodeParam[int],
+		"fordisplay":   decodeParam[bool],
+		"icmpcode":     decodeParam[int],
+		"icmptype":     decodeParam[int],
+		"networkid":    decodeParam[string],
+		"protocol":     decodeParam[Protocol],
+		"startport":    decodeParam[int],
+		"traffictype":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateIpv6FirewallRuleParams) Clone() *CreateIpv6FirewallRuleParams {
+	return &CreateIpv6FirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateIpv6FirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5323,7 +7040,7 @@ func (p *CreateIpv6FirewallRuleParams) GetNetworkid() (string, bool) {
 	return value, ok
 }
 
-func (p *CreateIpv6FirewallRuleParams) SetProtocol(v string) {
+func (p *CreateIpv6FirewallRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -5336,11 +7053,11 @@ func (p *CreateIpv6FirewallRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *CreateIpv6FirewallRuleParams) GetProtocol() (string, bool) {
+func (p *CreateIpv6FirewallRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -5388,7 +7105,7 @@ func (p *CreateIpv6FirewallRuleParams) GetTraffictype() (string, bool) {
 
 // You should always use this function to get a new CreateIpv6FirewallRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *FirewallService) NewCreateIpv6FirewallRuleParams(networkid string, protocol string) *CreateIpv6FirewallRuleParams {
+func (s *FirewallService) NewCreateIpv6FirewallRuleParams(networkid string, protocol Protocol) *CreateIpv6FirewallRuleParams {
 	p := &CreateIpv6FirewallRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["networkid"] = networkid
@@ -5398,64 +7115,96 @@ func (s *FirewallService) NewCreateIpv6FirewallRuleParams(networkid string, prot
 
 // Creates an IPv6 firewall rule in the given Network (the Network must not belong to VPC)
 func (s *FirewallService) CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createIpv6FirewallRule", p.toURLValues())
+	return s.CreateIpv6FirewallRuleWithContext(context.Background(), p)
+}
+
+// CreateIpv6FirewallRuleWithContext is like CreateIpv6FirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) CreateIpv6FirewallRuleWithContext(ctx context.Context, p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createIpv6FirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateIpv6FirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createIpv6FirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createIpv6FirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("createIpv6FirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished CreateIpv6FirewallRule async job into r
+func (r *CreateIpv6FirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateIpv6FirewallRuleAsync starts the async job of CreateIpv6FirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) CreateIpv6FirewallRuleAsync(ctx context.Context, p *CreateIpv6FirewallRuleParams) (*Job, error) {
+	r, err := s.CreateIpv6FirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createIpv6FirewallRule", r.JobID), nil
 }
 
 type CreateIpv6FirewallRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateIpv6FirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateIpv6FirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateIpv6FirewallRuleParams struct {
@@ -5494,7 +7243,7 @@ func (p *UpdateIpv6FirewallRuleParams) toURLValues() url.Values {
 		u.Set("id", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["startport"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -5506,6 +7255,53 @@ func (p *UpdateIpv6FirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateIpv6FirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateIpv6FirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateIpv6FirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateIpv6FirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateIpv6FirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255, "protocol": 255, "traffictype": 255},
+	}.validate("updateIpv6FirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateIpv6FirewallRule API, before the request is signed
+func (p *UpdateIpv6FirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateIpv6FirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateIpv6FirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateIpv6FirewallRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":    decodeParam[[]string],
+		"customid":    decodeParam[string],
+		"endport":     decodeParam[int],
+		"fordisplay":  decodeParam[bool],
+		"icmpcode":    decodeParam[int],
+		"icmptype":    decodeParam[int],
+		"id":          decodeParam[string],
+		"protocol":    decodeParam[Protocol],
+		"startport":   decodeParam[int],
+		"traffictype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateIpv6FirewallRuleParams) Clone() *UpdateIpv6FirewallRuleParams {
+	return &UpdateIpv6FirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateIpv6FirewallRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5653,7 +7449,7 @@ func (p *UpdateIpv6FirewallRuleParams) GetId() (string, bool) {
 	return value, ok
 }
 
-func (p *UpdateIpv6FirewallRuleParams) SetProtocol(v string) {
+func (p *UpdateIpv6FirewallRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -5666,11 +7462,11 @@ func (p *UpdateIpv6FirewallRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *UpdateIpv6FirewallRuleParams) GetProtocol() (string, bool) {
+func (p *UpdateIpv6FirewallRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -5727,64 +7523,96 @@ func (s *FirewallService) NewUpdateIpv6FirewallRuleParams(id string) *UpdateIpv6
 
 // Updates Ipv6 firewall rule with specified ID
 func (s *FirewallService) UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateIpv6FirewallRule", p.toURLValues())
+	return s.UpdateIpv6FirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateIpv6FirewallRuleWithContext is like UpdateIpv6FirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) UpdateIpv6FirewallRuleWithContext(ctx context.Context, p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateIpv6FirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateIpv6FirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateIpv6FirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateIpv6FirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("updateIpv6FirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished UpdateIpv6FirewallRule async job into r
+func (r *UpdateIpv6FirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateIpv6FirewallRuleAsync starts the async job of UpdateIpv6FirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) UpdateIpv6FirewallRuleAsync(ctx context.Context, p *UpdateIpv6FirewallRuleParams) (*Job, error) {
+	r, err := s.UpdateIpv6FirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateIpv6FirewallRule", r.JobID), nil
 }
 
 type UpdateIpv6FirewallRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateIpv6FirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateIpv6FirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteIpv6FirewallRuleParams struct {
@@ -5802,6 +7630,44 @@ func (p *DeleteIpv6FirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteIpv6FirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteIpv6FirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteIpv6FirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteIpv6FirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteIpv6FirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteIpv6FirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteIpv6FirewallRule API, before the request is signed
+func (p *DeleteIpv6FirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteIpv6FirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteIpv6FirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteIpv6FirewallRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteIpv6FirewallRuleParams) Clone() *DeleteIpv6FirewallRuleParams {
+	return &DeleteIpv6FirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteIpv6FirewallRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5834,32 +7700,39 @@ func (s *FirewallService) NewDeleteIpv6FirewallRuleParams(id string) *DeleteIpv6
 
 // Deletes a IPv6 firewall rule
 func (s *FirewallService) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteIpv6FirewallRule", p.toURLValues())
+	return s.DeleteIpv6FirewallRuleWithContext(context.Background(), p)
+}
+
+// DeleteIpv6FirewallRuleWithContext is like DeleteIpv6FirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) DeleteIpv6FirewallRuleWithContext(ctx context.Context, p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteIpv6FirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteIpv6FirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteIpv6FirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteIpv6FirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteIpv6FirewallRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5867,11 +7740,36 @@ func (s *FirewallService) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteIpv6FirewallRule async job into r
+func (r *DeleteIpv6FirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeleteIpv6FirewallRuleAsync starts the async job of DeleteIpv6FirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) DeleteIpv6FirewallRuleAsync(ctx context.Context, p *DeleteIpv6FirewallRuleParams) (*Job, error) {
+	r, err := s.DeleteIpv6FirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteIpv6FirewallRule", r.JobID), nil
+}
+
 type DeleteIpv6FirewallRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteIpv6FirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteIpv6FirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateRoutingFirewallRuleParams struct {
@@ -5896,6 +7794,46 @@ func (p *UpdateRoutingFirewallRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateRoutingFirewallRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateRoutingFirewallRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateRoutingFirewallRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateRoutingFirewallRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateRoutingFirewallRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateRoutingFirewallRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateRoutingFirewallRule API, before the request is signed
+func (p *UpdateRoutingFirewallRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateRoutingFirewallRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateRoutingFirewallRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateRoutingFirewallRule", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateRoutingFirewallRuleParams) Clone() *UpdateRoutingFirewallRuleParams {
+	return &UpdateRoutingFirewallRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateRoutingFirewallRuleParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5970,62 +7908,94 @@ func (s *FirewallService) NewUpdateRoutingFirewallRuleParams(id string) *UpdateR
 
 // Updates Routing firewall rule with specified ID
 func (s *FirewallService) UpdateRoutingFirewallRule(p *UpdateRoutingFirewallRuleParams) (*UpdateRoutingFirewallRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateRoutingFirewallRule", p.toURLValues())
+	return s.UpdateRoutingFirewallRuleWithContext(context.Background(), p)
+}
+
+// UpdateRoutingFirewallRuleWithContext is like UpdateRoutingFirewallRule, but honours the cancellation and deadline of ctx
+func (s *FirewallService) UpdateRoutingFirewallRuleWithContext(ctx context.Context, p *UpdateRoutingFirewallRuleParams) (*UpdateRoutingFirewallRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateRoutingFirewallRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateRoutingFirewallRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateRoutingFirewallRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateRoutingFirewallRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("updateRoutingFirewallRule", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertFirewallServiceResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished UpdateRoutingFirewallRule async job into r
+func (r *UpdateRoutingFirewallRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertFirewallServiceResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateRoutingFirewallRuleAsync starts the async job of UpdateRoutingFirewallRule without waiting for it to finish, and returns a handle to the job
+func (s *FirewallService) UpdateRoutingFirewallRuleAsync(ctx context.Context, p *UpdateRoutingFirewallRuleParams) (*Job, error) {
+	r, err := s.UpdateRoutingFirewallRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateRoutingFirewallRule", r.JobID), nil
 }
 
 type UpdateRoutingFirewallRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateRoutingFirewallRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateRoutingFirewallRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
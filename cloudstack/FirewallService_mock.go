@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) AddPaloAltoFirewall(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).AddPaloAltoFirewall), p)
 }
 
+// AddPaloAltoFirewallAsync mocks base method.
+func (m *MockFirewallServiceIface) AddPaloAltoFirewallAsync(ctx context.Context, p *AddPaloAltoFirewallParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPaloAltoFirewallAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPaloAltoFirewallAsync indicates an expected call of AddPaloAltoFirewallAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) AddPaloAltoFirewallAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaloAltoFirewallAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).AddPaloAltoFirewallAsync), ctx, p)
+}
+
+// AddPaloAltoFirewallWithContext mocks base method.
+func (m *MockFirewallServiceIface) AddPaloAltoFirewallWithContext(ctx context.Context, p *AddPaloAltoFirewallParams) (*AddPaloAltoFirewallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddPaloAltoFirewallWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddPaloAltoFirewallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddPaloAltoFirewallWithContext indicates an expected call of AddPaloAltoFirewallWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) AddPaloAltoFirewallWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddPaloAltoFirewallWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).AddPaloAltoFirewallWithContext), ctx, p)
+}
+
 // ConfigurePaloAltoFirewall mocks base method.
 func (m *MockFirewallServiceIface) ConfigurePaloAltoFirewall(p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) ConfigurePaloAltoFirewall(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigurePaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).ConfigurePaloAltoFirewall), p)
 }
 
+// ConfigurePaloAltoFirewallAsync mocks base method.
+func (m *MockFirewallServiceIface) ConfigurePaloAltoFirewallAsync(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigurePaloAltoFirewallAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigurePaloAltoFirewallAsync indicates an expected call of ConfigurePaloAltoFirewallAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) ConfigurePaloAltoFirewallAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigurePaloAltoFirewallAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).ConfigurePaloAltoFirewallAsync), ctx, p)
+}
+
+// ConfigurePaloAltoFirewallWithContext mocks base method.
+func (m *MockFirewallServiceIface) ConfigurePaloAltoFirewallWithContext(ctx context.Context, p *ConfigurePaloAltoFirewallParams) (*PaloAltoFirewallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigurePaloAltoFirewallWithContext", ctx, p)
+	ret0, _ := ret[0].(*PaloAltoFirewallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigurePaloAltoFirewallWithContext indicates an expected call of ConfigurePaloAltoFirewallWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ConfigurePaloAltoFirewallWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigurePaloAltoFirewallWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ConfigurePaloAltoFirewallWithContext), ctx, p)
+}
+
 // CreateEgressFirewallRule mocks base method.
 func (m *MockFirewallServiceIface) CreateEgressFirewallRule(p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) CreateEgressFirewallRule(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateEgressFirewallRule), p)
 }
 
+// CreateEgressFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) CreateEgressFirewallRuleAsync(ctx context.Context, p *CreateEgressFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEgressFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEgressFirewallRuleAsync indicates an expected call of CreateEgressFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateEgressFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateEgressFirewallRuleAsync), ctx, p)
+}
+
+// CreateEgressFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) CreateEgressFirewallRuleWithContext(ctx context.Context, p *CreateEgressFirewallRuleParams) (*CreateEgressFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateEgressFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateEgressFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateEgressFirewallRuleWithContext indicates an expected call of CreateEgressFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateEgressFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEgressFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateEgressFirewallRuleWithContext), ctx, p)
+}
+
 // CreateFirewallRule mocks base method.
 func (m *MockFirewallServiceIface) CreateFirewallRule(p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) CreateFirewallRule(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateFirewallRule), p)
 }
 
+// CreateFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) CreateFirewallRuleAsync(ctx context.Context, p *CreateFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirewallRuleAsync indicates an expected call of CreateFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateFirewallRuleAsync), ctx, p)
+}
+
+// CreateFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) CreateFirewallRuleWithContext(ctx context.Context, p *CreateFirewallRuleParams) (*CreateFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFirewallRuleWithContext indicates an expected call of CreateFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateFirewallRuleWithContext), ctx, p)
+}
+
 // CreateIpv6FirewallRule mocks base method.
 func (m *MockFirewallServiceIface) CreateIpv6FirewallRule(p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) CreateIpv6FirewallRule(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateIpv6FirewallRule), p)
 }
 
+// CreateIpv6FirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) CreateIpv6FirewallRuleAsync(ctx context.Context, p *CreateIpv6FirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv6FirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv6FirewallRuleAsync indicates an expected call of CreateIpv6FirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateIpv6FirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6FirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateIpv6FirewallRuleAsync), ctx, p)
+}
+
+// CreateIpv6FirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) CreateIpv6FirewallRuleWithContext(ctx context.Context, p *CreateIpv6FirewallRuleParams) (*CreateIpv6FirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv6FirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateIpv6FirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv6FirewallRuleWithContext indicates an expected call of CreateIpv6FirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateIpv6FirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6FirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateIpv6FirewallRuleWithContext), ctx, p)
+}
+
 // CreatePortForwardingRule mocks base method.
 func (m *MockFirewallServiceIface) CreatePortForwardingRule(p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +300,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) CreatePortForwardingRule(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreatePortForwardingRule), p)
 }
 
+// CreatePortForwardingRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) CreatePortForwardingRuleAsync(ctx context.Context, p *CreatePortForwardingRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePortForwardingRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePortForwardingRuleAsync indicates an expected call of CreatePortForwardingRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreatePortForwardingRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortForwardingRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreatePortForwardingRuleAsync), ctx, p)
+}
+
+// CreatePortForwardingRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) CreatePortForwardingRuleWithContext(ctx context.Context, p *CreatePortForwardingRuleParams) (*CreatePortForwardingRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePortForwardingRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreatePortForwardingRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePortForwardingRuleWithContext indicates an expected call of CreatePortForwardingRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreatePortForwardingRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortForwardingRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreatePortForwardingRuleWithContext), ctx, p)
+}
+
 // CreateRoutingFirewallRule mocks base method.
 func (m *MockFirewallServiceIface) CreateRoutingFirewallRule(p *CreateRoutingFirewallRuleParams) (*CreateRoutingFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +345,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) CreateRoutingFirewallRule(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutingFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateRoutingFirewallRule), p)
 }
 
+// CreateRoutingFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) CreateRoutingFirewallRuleAsync(ctx context.Context, p *CreateRoutingFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutingFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutingFirewallRuleAsync indicates an expected call of CreateRoutingFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateRoutingFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutingFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateRoutingFirewallRuleAsync), ctx, p)
+}
+
+// CreateRoutingFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) CreateRoutingFirewallRuleWithContext(ctx context.Context, p *CreateRoutingFirewallRuleParams) (*CreateRoutingFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoutingFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateRoutingFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoutingFirewallRuleWithContext indicates an expected call of CreateRoutingFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) CreateRoutingFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoutingFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).CreateRoutingFirewallRuleWithContext), ctx, p)
+}
+
 // DeleteEgressFirewallRule mocks base method.
 func (m *MockFirewallServiceIface) DeleteEgressFirewallRule(p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +390,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) DeleteEgressFirewallRule(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteEgressFirewallRule), p)
 }
 
+// DeleteEgressFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) DeleteEgressFirewallRuleAsync(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEgressFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEgressFirewallRuleAsync indicates an expected call of DeleteEgressFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteEgressFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteEgressFirewallRuleAsync), ctx, p)
+}
+
+// DeleteEgressFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) DeleteEgressFirewallRuleWithContext(ctx context.Context, p *DeleteEgressFirewallRuleParams) (*DeleteEgressFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteEgressFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteEgressFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteEgressFirewallRuleWithContext indicates an expected call of DeleteEgressFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteEgressFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEgressFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteEgressFirewallRuleWithContext), ctx, p)
+}
+
 // DeleteFirewallRule mocks base method.
 func (m *MockFirewallServiceIface) DeleteFirewallRule(p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +435,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) DeleteFirewallRule(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteFirewallRule), p)
 }
 
+// DeleteFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) DeleteFirewallRuleAsync(ctx context.Context, p *DeleteFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFirewallRuleAsync indicates an expected call of DeleteFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteFirewallRuleAsync), ctx, p)
+}
+
+// DeleteFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) DeleteFirewallRuleWithContext(ctx context.Context, p *DeleteFirewallRuleParams) (*DeleteFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFirewallRuleWithContext indicates an expected call of DeleteFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteFirewallRuleWithContext), ctx, p)
+}
+
 // DeleteIpv6FirewallRule mocks base method.
 func (m *MockFirewallServiceIface) DeleteIpv6FirewallRule(p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,244 +480,1027 @@ func (mr *MockFirewallServiceIfaceMockRecorder) DeleteIpv6FirewallRule(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteIpv6FirewallRule), p)
 }
 
+// DeleteIpv6FirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) DeleteIpv6FirewallRuleAsync(ctx context.Context, p *DeleteIpv6FirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv6FirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv6FirewallRuleAsync indicates an expected call of DeleteIpv6FirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteIpv6FirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6FirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteIpv6FirewallRuleAsync), ctx, p)
+}
+
+// DeleteIpv6FirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) DeleteIpv6FirewallRuleWithContext(ctx context.Context, p *DeleteIpv6FirewallRuleParams) (*DeleteIpv6FirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv6FirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteIpv6FirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv6FirewallRuleWithContext indicates an expected call of DeleteIpv6FirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteIpv6FirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6FirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteIpv6FirewallRuleWithContext), ctx, p)
+}
+
 // DeletePaloAltoFirewall mocks base method.
 func (m *MockFirewallServiceIface) DeletePaloAltoFirewall(p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePaloAltoFirewall", p)
-	ret0, _ := ret[0].(*DeletePaloAltoFirewallResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	ret := m.ctrl.Call(m, "DeletePaloAltoFirewall", p)
+	ret0, _ := ret[0].(*DeletePaloAltoFirewallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePaloAltoFirewall indicates an expected call of DeletePaloAltoFirewall.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePaloAltoFirewall(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePaloAltoFirewall), p)
+}
+
+// DeletePaloAltoFirewallAsync mocks base method.
+func (m *MockFirewallServiceIface) DeletePaloAltoFirewallAsync(ctx context.Context, p *DeletePaloAltoFirewallParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePaloAltoFirewallAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePaloAltoFirewallAsync indicates an expected call of DeletePaloAltoFirewallAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePaloAltoFirewallAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaloAltoFirewallAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePaloAltoFirewallAsync), ctx, p)
+}
+
+// DeletePaloAltoFirewallWithContext mocks base method.
+func (m *MockFirewallServiceIface) DeletePaloAltoFirewallWithContext(ctx context.Context, p *DeletePaloAltoFirewallParams) (*DeletePaloAltoFirewallResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePaloAltoFirewallWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeletePaloAltoFirewallResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePaloAltoFirewallWithContext indicates an expected call of DeletePaloAltoFirewallWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePaloAltoFirewallWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaloAltoFirewallWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePaloAltoFirewallWithContext), ctx, p)
+}
+
+// DeletePortForwardingRule mocks base method.
+func (m *MockFirewallServiceIface) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePortForwardingRule", p)
+	ret0, _ := ret[0].(*DeletePortForwardingRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePortForwardingRule indicates an expected call of DeletePortForwardingRule.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePortForwardingRule(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePortForwardingRule), p)
+}
+
+// DeletePortForwardingRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) DeletePortForwardingRuleAsync(ctx context.Context, p *DeletePortForwardingRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePortForwardingRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePortForwardingRuleAsync indicates an expected call of DeletePortForwardingRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePortForwardingRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortForwardingRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePortForwardingRuleAsync), ctx, p)
+}
+
+// DeletePortForwardingRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) DeletePortForwardingRuleWithContext(ctx context.Context, p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePortForwardingRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeletePortForwardingRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePortForwardingRuleWithContext indicates an expected call of DeletePortForwardingRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeletePortForwardingRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortForwardingRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePortForwardingRuleWithContext), ctx, p)
+}
+
+// DeleteRoutingFirewallRule mocks base method.
+func (m *MockFirewallServiceIface) DeleteRoutingFirewallRule(p *DeleteRoutingFirewallRuleParams) (*DeleteRoutingFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutingFirewallRule", p)
+	ret0, _ := ret[0].(*DeleteRoutingFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoutingFirewallRule indicates an expected call of DeleteRoutingFirewallRule.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteRoutingFirewallRule(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteRoutingFirewallRule), p)
+}
+
+// DeleteRoutingFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) DeleteRoutingFirewallRuleAsync(ctx context.Context, p *DeleteRoutingFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutingFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoutingFirewallRuleAsync indicates an expected call of DeleteRoutingFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteRoutingFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteRoutingFirewallRuleAsync), ctx, p)
+}
+
+// DeleteRoutingFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) DeleteRoutingFirewallRuleWithContext(ctx context.Context, p *DeleteRoutingFirewallRuleParams) (*DeleteRoutingFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoutingFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteRoutingFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoutingFirewallRuleWithContext indicates an expected call of DeleteRoutingFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) DeleteRoutingFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteRoutingFirewallRuleWithContext), ctx, p)
+}
+
+// GetEgressFirewallRuleByID mocks base method.
+func (m *MockFirewallServiceIface) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEgressFirewallRuleByID", varargs...)
+	ret0, _ := ret[0].(*EgressFirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEgressFirewallRuleByID indicates an expected call of GetEgressFirewallRuleByID.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetEgressFirewallRuleByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressFirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetEgressFirewallRuleByID), varargs...)
+}
+
+// GetEgressFirewallRuleByIDWithContext mocks base method.
+func (m *MockFirewallServiceIface) GetEgressFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetEgressFirewallRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*EgressFirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetEgressFirewallRuleByIDWithContext indicates an expected call of GetEgressFirewallRuleByIDWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetEgressFirewallRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressFirewallRuleByIDWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetEgressFirewallRuleByIDWithContext), varargs...)
+}
+
+// GetFirewallRuleByID mocks base method.
+func (m *MockFirewallServiceIface) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFirewallRuleByID", varargs...)
+	ret0, _ := ret[0].(*FirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFirewallRuleByID indicates an expected call of GetFirewallRuleByID.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetFirewallRuleByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetFirewallRuleByID), varargs...)
+}
+
+// GetFirewallRuleByIDWithContext mocks base method.
+func (m *MockFirewallServiceIface) GetFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetFirewallRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*FirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetFirewallRuleByIDWithContext indicates an expected call of GetFirewallRuleByIDWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetFirewallRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRuleByIDWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetFirewallRuleByIDWithContext), varargs...)
+}
+
+// GetIpv6FirewallRuleByID mocks base method.
+func (m *MockFirewallServiceIface) GetIpv6FirewallRuleByID(id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIpv6FirewallRuleByID", varargs...)
+	ret0, _ := ret[0].(*Ipv6FirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIpv6FirewallRuleByID indicates an expected call of GetIpv6FirewallRuleByID.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetIpv6FirewallRuleByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv6FirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetIpv6FirewallRuleByID), varargs...)
+}
+
+// GetIpv6FirewallRuleByIDWithContext mocks base method.
+func (m *MockFirewallServiceIface) GetIpv6FirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIpv6FirewallRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Ipv6FirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIpv6FirewallRuleByIDWithContext indicates an expected call of GetIpv6FirewallRuleByIDWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetIpv6FirewallRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv6FirewallRuleByIDWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetIpv6FirewallRuleByIDWithContext), varargs...)
+}
+
+// GetPortForwardingRuleByID mocks base method.
+func (m *MockFirewallServiceIface) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPortForwardingRuleByID", varargs...)
+	ret0, _ := ret[0].(*PortForwardingRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPortForwardingRuleByID indicates an expected call of GetPortForwardingRuleByID.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetPortForwardingRuleByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortForwardingRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetPortForwardingRuleByID), varargs...)
+}
+
+// GetPortForwardingRuleByIDWithContext mocks base method.
+func (m *MockFirewallServiceIface) GetPortForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPortForwardingRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*PortForwardingRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPortForwardingRuleByIDWithContext indicates an expected call of GetPortForwardingRuleByIDWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetPortForwardingRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortForwardingRuleByIDWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetPortForwardingRuleByIDWithContext), varargs...)
+}
+
+// GetRoutingFirewallRuleByID mocks base method.
+func (m *MockFirewallServiceIface) GetRoutingFirewallRuleByID(id string, opts ...OptionFunc) (*RoutingFirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRoutingFirewallRuleByID", varargs...)
+	ret0, _ := ret[0].(*RoutingFirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRoutingFirewallRuleByID indicates an expected call of GetRoutingFirewallRuleByID.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetRoutingFirewallRuleByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingFirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetRoutingFirewallRuleByID), varargs...)
+}
+
+// GetRoutingFirewallRuleByIDWithContext mocks base method.
+func (m *MockFirewallServiceIface) GetRoutingFirewallRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RoutingFirewallRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRoutingFirewallRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*RoutingFirewallRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRoutingFirewallRuleByIDWithContext indicates an expected call of GetRoutingFirewallRuleByIDWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) GetRoutingFirewallRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingFirewallRuleByIDWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetRoutingFirewallRuleByIDWithContext), varargs...)
+}
+
+// ListEgressFirewallRules mocks base method.
+func (m *MockFirewallServiceIface) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEgressFirewallRules", p)
+	ret0, _ := ret[0].(*ListEgressFirewallRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEgressFirewallRules indicates an expected call of ListEgressFirewallRules.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRules(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRules), p)
+}
+
+// ListEgressFirewallRulesAll mocks base method.
+func (m *MockFirewallServiceIface) ListEgressFirewallRulesAll(p *ListEgressFirewallRulesParams, opts ...PageOption) ([]*EgressFirewallRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEgressFirewallRulesAll", varargs...)
+	ret0, _ := ret[0].([]*EgressFirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEgressFirewallRulesAll indicates an expected call of ListEgressFirewallRulesAll.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRulesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRulesAll", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRulesAll), varargs...)
+}
+
+// ListEgressFirewallRulesAllWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListEgressFirewallRulesAllWithContext(ctx context.Context, p *ListEgressFirewallRulesParams, opts ...PageOption) ([]*EgressFirewallRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEgressFirewallRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*EgressFirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEgressFirewallRulesAllWithContext indicates an expected call of ListEgressFirewallRulesAllWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRulesAllWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRulesAllWithContext), varargs...)
+}
+
+// ListEgressFirewallRulesIter mocks base method.
+func (m *MockFirewallServiceIface) ListEgressFirewallRulesIter(p *ListEgressFirewallRulesParams, opts ...PageOption) iter.Seq2[*EgressFirewallRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEgressFirewallRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*EgressFirewallRule, error])
+	return ret0
+}
+
+// ListEgressFirewallRulesIter indicates an expected call of ListEgressFirewallRulesIter.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRulesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRulesIter", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRulesIter), varargs...)
+}
+
+// ListEgressFirewallRulesIterWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListEgressFirewallRulesIterWithContext(ctx context.Context, p *ListEgressFirewallRulesParams, opts ...PageOption) iter.Seq2[*EgressFirewallRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListEgressFirewallRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*EgressFirewallRule, error])
+	return ret0
+}
+
+// ListEgressFirewallRulesIterWithContext indicates an expected call of ListEgressFirewallRulesIterWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRulesIterWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRulesIterWithContext), varargs...)
+}
+
+// ListEgressFirewallRulesWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListEgressFirewallRulesWithContext(ctx context.Context, p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListEgressFirewallRulesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListEgressFirewallRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListEgressFirewallRulesWithContext indicates an expected call of ListEgressFirewallRulesWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRulesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRulesWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRulesWithContext), ctx, p)
+}
+
+// ListFirewallRules mocks base method.
+func (m *MockFirewallServiceIface) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallRules", p)
+	ret0, _ := ret[0].(*ListFirewallRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallRules indicates an expected call of ListFirewallRules.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRules(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRules), p)
+}
+
+// ListFirewallRulesAll mocks base method.
+func (m *MockFirewallServiceIface) ListFirewallRulesAll(p *ListFirewallRulesParams, opts ...PageOption) ([]*FirewallRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFirewallRulesAll", varargs...)
+	ret0, _ := ret[0].([]*FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallRulesAll indicates an expected call of ListFirewallRulesAll.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRulesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRulesAll", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRulesAll), varargs...)
+}
+
+// ListFirewallRulesAllWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListFirewallRulesAllWithContext(ctx context.Context, p *ListFirewallRulesParams, opts ...PageOption) ([]*FirewallRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFirewallRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallRulesAllWithContext indicates an expected call of ListFirewallRulesAllWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRulesAllWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRulesAllWithContext), varargs...)
+}
+
+// ListFirewallRulesIter mocks base method.
+func (m *MockFirewallServiceIface) ListFirewallRulesIter(p *ListFirewallRulesParams, opts ...PageOption) iter.Seq2[*FirewallRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFirewallRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*FirewallRule, error])
+	return ret0
+}
+
+// ListFirewallRulesIter indicates an expected call of ListFirewallRulesIter.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRulesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRulesIter", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRulesIter), varargs...)
+}
+
+// ListFirewallRulesIterWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListFirewallRulesIterWithContext(ctx context.Context, p *ListFirewallRulesParams, opts ...PageOption) iter.Seq2[*FirewallRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListFirewallRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*FirewallRule, error])
+	return ret0
+}
+
+// ListFirewallRulesIterWithContext indicates an expected call of ListFirewallRulesIterWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRulesIterWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRulesIterWithContext), varargs...)
+}
+
+// ListFirewallRulesWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListFirewallRulesWithContext(ctx context.Context, p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListFirewallRulesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListFirewallRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListFirewallRulesWithContext indicates an expected call of ListFirewallRulesWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRulesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRulesWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRulesWithContext), ctx, p)
+}
+
+// ListIpv6FirewallRules mocks base method.
+func (m *MockFirewallServiceIface) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv6FirewallRules", p)
+	ret0, _ := ret[0].(*ListIpv6FirewallRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv6FirewallRules indicates an expected call of ListIpv6FirewallRules.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRules(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRules), p)
+}
+
+// ListIpv6FirewallRulesAll mocks base method.
+func (m *MockFirewallServiceIface) ListIpv6FirewallRulesAll(p *ListIpv6FirewallRulesParams, opts ...PageOption) ([]*Ipv6FirewallRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv6FirewallRulesAll", varargs...)
+	ret0, _ := ret[0].([]*Ipv6FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv6FirewallRulesAll indicates an expected call of ListIpv6FirewallRulesAll.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRulesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRulesAll", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRulesAll), varargs...)
+}
+
+// ListIpv6FirewallRulesAllWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListIpv6FirewallRulesAllWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams, opts ...PageOption) ([]*Ipv6FirewallRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv6FirewallRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Ipv6FirewallRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv6FirewallRulesAllWithContext indicates an expected call of ListIpv6FirewallRulesAllWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRulesAllWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRulesAllWithContext), varargs...)
+}
+
+// ListIpv6FirewallRulesIter mocks base method.
+func (m *MockFirewallServiceIface) ListIpv6FirewallRulesIter(p *ListIpv6FirewallRulesParams, opts ...PageOption) iter.Seq2[*Ipv6FirewallRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv6FirewallRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Ipv6FirewallRule, error])
+	return ret0
+}
+
+// ListIpv6FirewallRulesIter indicates an expected call of ListIpv6FirewallRulesIter.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRulesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRulesIter", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRulesIter), varargs...)
+}
+
+// ListIpv6FirewallRulesIterWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListIpv6FirewallRulesIterWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams, opts ...PageOption) iter.Seq2[*Ipv6FirewallRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv6FirewallRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Ipv6FirewallRule, error])
+	return ret0
+}
+
+// ListIpv6FirewallRulesIterWithContext indicates an expected call of ListIpv6FirewallRulesIterWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRulesIterWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRulesIterWithContext), varargs...)
+}
+
+// ListIpv6FirewallRulesWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListIpv6FirewallRulesWithContext(ctx context.Context, p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv6FirewallRulesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListIpv6FirewallRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv6FirewallRulesWithContext indicates an expected call of ListIpv6FirewallRulesWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRulesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRulesWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRulesWithContext), ctx, p)
+}
+
+// ListPaloAltoFirewalls mocks base method.
+func (m *MockFirewallServiceIface) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewalls", p)
+	ret0, _ := ret[0].(*ListPaloAltoFirewallsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaloAltoFirewalls indicates an expected call of ListPaloAltoFirewalls.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewalls(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewalls", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewalls), p)
+}
+
+// ListPaloAltoFirewallsAll mocks base method.
+func (m *MockFirewallServiceIface) ListPaloAltoFirewallsAll(p *ListPaloAltoFirewallsParams, opts ...PageOption) ([]*PaloAltoFirewall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallsAll", varargs...)
+	ret0, _ := ret[0].([]*PaloAltoFirewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaloAltoFirewallsAll indicates an expected call of ListPaloAltoFirewallsAll.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewallsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallsAll", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewallsAll), varargs...)
+}
+
+// ListPaloAltoFirewallsAllWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListPaloAltoFirewallsAllWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams, opts ...PageOption) ([]*PaloAltoFirewall, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*PaloAltoFirewall)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaloAltoFirewallsAllWithContext indicates an expected call of ListPaloAltoFirewallsAllWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewallsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallsAllWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewallsAllWithContext), varargs...)
+}
+
+// ListPaloAltoFirewallsIter mocks base method.
+func (m *MockFirewallServiceIface) ListPaloAltoFirewallsIter(p *ListPaloAltoFirewallsParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewall, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PaloAltoFirewall, error])
+	return ret0
+}
+
+// ListPaloAltoFirewallsIter indicates an expected call of ListPaloAltoFirewallsIter.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewallsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallsIter", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewallsIter), varargs...)
+}
+
+// ListPaloAltoFirewallsIterWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListPaloAltoFirewallsIterWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewall, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PaloAltoFirewall, error])
+	return ret0
 }
 
-// DeletePaloAltoFirewall indicates an expected call of DeletePaloAltoFirewall.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeletePaloAltoFirewall(p any) *gomock.Call {
+// ListPaloAltoFirewallsIterWithContext indicates an expected call of ListPaloAltoFirewallsIterWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewallsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePaloAltoFirewall", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePaloAltoFirewall), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallsIterWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewallsIterWithContext), varargs...)
 }
 
-// DeletePortForwardingRule mocks base method.
-func (m *MockFirewallServiceIface) DeletePortForwardingRule(p *DeletePortForwardingRuleParams) (*DeletePortForwardingRuleResponse, error) {
+// ListPaloAltoFirewallsWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListPaloAltoFirewallsWithContext(ctx context.Context, p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeletePortForwardingRule", p)
-	ret0, _ := ret[0].(*DeletePortForwardingRuleResponse)
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPaloAltoFirewallsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeletePortForwardingRule indicates an expected call of DeletePortForwardingRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeletePortForwardingRule(p any) *gomock.Call {
+// ListPaloAltoFirewallsWithContext indicates an expected call of ListPaloAltoFirewallsWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewallsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeletePortForwardingRule), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallsWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewallsWithContext), ctx, p)
 }
 
-// DeleteRoutingFirewallRule mocks base method.
-func (m *MockFirewallServiceIface) DeleteRoutingFirewallRule(p *DeleteRoutingFirewallRuleParams) (*DeleteRoutingFirewallRuleResponse, error) {
+// ListPortForwardingRules mocks base method.
+func (m *MockFirewallServiceIface) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteRoutingFirewallRule", p)
-	ret0, _ := ret[0].(*DeleteRoutingFirewallRuleResponse)
+	ret := m.ctrl.Call(m, "ListPortForwardingRules", p)
+	ret0, _ := ret[0].(*ListPortForwardingRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteRoutingFirewallRule indicates an expected call of DeleteRoutingFirewallRule.
-func (mr *MockFirewallServiceIfaceMockRecorder) DeleteRoutingFirewallRule(p any) *gomock.Call {
+// ListPortForwardingRules indicates an expected call of ListPortForwardingRules.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRules(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoutingFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).DeleteRoutingFirewallRule), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRules), p)
 }
 
-// GetEgressFirewallRuleByID mocks base method.
-func (m *MockFirewallServiceIface) GetEgressFirewallRuleByID(id string, opts ...OptionFunc) (*EgressFirewallRule, int, error) {
+// ListPortForwardingRulesAll mocks base method.
+func (m *MockFirewallServiceIface) ListPortForwardingRulesAll(p *ListPortForwardingRulesParams, opts ...PageOption) ([]*PortForwardingRule, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetEgressFirewallRuleByID", varargs...)
-	ret0, _ := ret[0].(*EgressFirewallRule)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListPortForwardingRulesAll", varargs...)
+	ret0, _ := ret[0].([]*PortForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetEgressFirewallRuleByID indicates an expected call of GetEgressFirewallRuleByID.
-func (mr *MockFirewallServiceIfaceMockRecorder) GetEgressFirewallRuleByID(id any, opts ...any) *gomock.Call {
+// ListPortForwardingRulesAll indicates an expected call of ListPortForwardingRulesAll.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRulesAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEgressFirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetEgressFirewallRuleByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRulesAll", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRulesAll), varargs...)
 }
 
-// GetFirewallRuleByID mocks base method.
-func (m *MockFirewallServiceIface) GetFirewallRuleByID(id string, opts ...OptionFunc) (*FirewallRule, int, error) {
+// ListPortForwardingRulesAllWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListPortForwardingRulesAllWithContext(ctx context.Context, p *ListPortForwardingRulesParams, opts ...PageOption) ([]*PortForwardingRule, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetFirewallRuleByID", varargs...)
-	ret0, _ := ret[0].(*FirewallRule)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListPortForwardingRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*PortForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetFirewallRuleByID indicates an expected call of GetFirewallRuleByID.
-func (mr *MockFirewallServiceIfaceMockRecorder) GetFirewallRuleByID(id any, opts ...any) *gomock.Call {
+// ListPortForwardingRulesAllWithContext indicates an expected call of ListPortForwardingRulesAllWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetFirewallRuleByID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRulesAllWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRulesAllWithContext), varargs...)
 }
 
-// GetIpv6FirewallRuleByID mocks base method.
-func (m *MockFirewallServiceIface) GetIpv6FirewallRuleByID(id string, opts ...OptionFunc) (*Ipv6FirewallRule, int, error) {
+// ListPortForwardingRulesIter mocks base method.
+func (m *MockFirewallServiceIface) ListPortForwardingRulesIter(p *ListPortForwardingRulesParams, opts ...PageOption) iter.Seq2[*PortForwardingRule, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetIpv6FirewallRuleByID", varargs...)
-	ret0, _ := ret[0].(*Ipv6FirewallRule)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListPortForwardingRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PortForwardingRule, error])
+	return ret0
 }
 
-// GetIpv6FirewallRuleByID indicates an expected call of GetIpv6FirewallRuleByID.
-func (mr *MockFirewallServiceIfaceMockRecorder) GetIpv6FirewallRuleByID(id any, opts ...any) *gomock.Call {
+// ListPortForwardingRulesIter indicates an expected call of ListPortForwardingRulesIter.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRulesIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv6FirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetIpv6FirewallRuleByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRulesIter", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRulesIter), varargs...)
 }
 
-// GetPortForwardingRuleByID mocks base method.
-func (m *MockFirewallServiceIface) GetPortForwardingRuleByID(id string, opts ...OptionFunc) (*PortForwardingRule, int, error) {
+// ListPortForwardingRulesIterWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListPortForwardingRulesIterWithContext(ctx context.Context, p *ListPortForwardingRulesParams, opts ...PageOption) iter.Seq2[*PortForwardingRule, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetPortForwardingRuleByID", varargs...)
-	ret0, _ := ret[0].(*PortForwardingRule)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListPortForwardingRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PortForwardingRule, error])
+	return ret0
 }
 
-// GetPortForwardingRuleByID indicates an expected call of GetPortForwardingRuleByID.
-func (mr *MockFirewallServiceIfaceMockRecorder) GetPortForwardingRuleByID(id any, opts ...any) *gomock.Call {
+// ListPortForwardingRulesIterWithContext indicates an expected call of ListPortForwardingRulesIterWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortForwardingRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetPortForwardingRuleByID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRulesIterWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRulesIterWithContext), varargs...)
 }
 
-// GetRoutingFirewallRuleByID mocks base method.
-func (m *MockFirewallServiceIface) GetRoutingFirewallRuleByID(id string, opts ...OptionFunc) (*RoutingFirewallRule, int, error) {
+// ListPortForwardingRulesWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListPortForwardingRulesWithContext(ctx context.Context, p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetRoutingFirewallRuleByID", varargs...)
-	ret0, _ := ret[0].(*RoutingFirewallRule)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListPortForwardingRulesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPortForwardingRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetRoutingFirewallRuleByID indicates an expected call of GetRoutingFirewallRuleByID.
-func (mr *MockFirewallServiceIfaceMockRecorder) GetRoutingFirewallRuleByID(id any, opts ...any) *gomock.Call {
+// ListPortForwardingRulesWithContext indicates an expected call of ListPortForwardingRulesWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRulesWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoutingFirewallRuleByID", reflect.TypeOf((*MockFirewallServiceIface)(nil).GetRoutingFirewallRuleByID), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRulesWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRulesWithContext), ctx, p)
 }
 
-// ListEgressFirewallRules mocks base method.
-func (m *MockFirewallServiceIface) ListEgressFirewallRules(p *ListEgressFirewallRulesParams) (*ListEgressFirewallRulesResponse, error) {
+// ListRoutingFirewallRules mocks base method.
+func (m *MockFirewallServiceIface) ListRoutingFirewallRules(p *ListRoutingFirewallRulesParams) (*ListRoutingFirewallRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListEgressFirewallRules", p)
-	ret0, _ := ret[0].(*ListEgressFirewallRulesResponse)
+	ret := m.ctrl.Call(m, "ListRoutingFirewallRules", p)
+	ret0, _ := ret[0].(*ListRoutingFirewallRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListEgressFirewallRules indicates an expected call of ListEgressFirewallRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListEgressFirewallRules(p any) *gomock.Call {
+// ListRoutingFirewallRules indicates an expected call of ListRoutingFirewallRules.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListRoutingFirewallRules(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEgressFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListEgressFirewallRules), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutingFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListRoutingFirewallRules), p)
 }
 
-// ListFirewallRules mocks base method.
-func (m *MockFirewallServiceIface) ListFirewallRules(p *ListFirewallRulesParams) (*ListFirewallRulesResponse, error) {
+// ListRoutingFirewallRulesAll mocks base method.
+func (m *MockFirewallServiceIface) ListRoutingFirewallRulesAll(p *ListRoutingFirewallRulesParams, opts ...PageOption) ([]*RoutingFirewallRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListFirewallRules", p)
-	ret0, _ := ret[0].(*ListFirewallRulesResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutingFirewallRulesAll", varargs...)
+	ret0, _ := ret[0].([]*RoutingFirewallRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListFirewallRules indicates an expected call of ListFirewallRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListFirewallRules(p any) *gomock.Call {
+// ListRoutingFirewallRulesAll indicates an expected call of ListRoutingFirewallRulesAll.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListRoutingFirewallRulesAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListFirewallRules), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutingFirewallRulesAll", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListRoutingFirewallRulesAll), varargs...)
 }
 
-// ListIpv6FirewallRules mocks base method.
-func (m *MockFirewallServiceIface) ListIpv6FirewallRules(p *ListIpv6FirewallRulesParams) (*ListIpv6FirewallRulesResponse, error) {
+// ListRoutingFirewallRulesAllWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListRoutingFirewallRulesAllWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams, opts ...PageOption) ([]*RoutingFirewallRule, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIpv6FirewallRules", p)
-	ret0, _ := ret[0].(*ListIpv6FirewallRulesResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutingFirewallRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*RoutingFirewallRule)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListIpv6FirewallRules indicates an expected call of ListIpv6FirewallRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListIpv6FirewallRules(p any) *gomock.Call {
+// ListRoutingFirewallRulesAllWithContext indicates an expected call of ListRoutingFirewallRulesAllWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListRoutingFirewallRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6FirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListIpv6FirewallRules), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutingFirewallRulesAllWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListRoutingFirewallRulesAllWithContext), varargs...)
 }
 
-// ListPaloAltoFirewalls mocks base method.
-func (m *MockFirewallServiceIface) ListPaloAltoFirewalls(p *ListPaloAltoFirewallsParams) (*ListPaloAltoFirewallsResponse, error) {
+// ListRoutingFirewallRulesIter mocks base method.
+func (m *MockFirewallServiceIface) ListRoutingFirewallRulesIter(p *ListRoutingFirewallRulesParams, opts ...PageOption) iter.Seq2[*RoutingFirewallRule, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPaloAltoFirewalls", p)
-	ret0, _ := ret[0].(*ListPaloAltoFirewallsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutingFirewallRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*RoutingFirewallRule, error])
+	return ret0
 }
 
-// ListPaloAltoFirewalls indicates an expected call of ListPaloAltoFirewalls.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListPaloAltoFirewalls(p any) *gomock.Call {
+// ListRoutingFirewallRulesIter indicates an expected call of ListRoutingFirewallRulesIter.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListRoutingFirewallRulesIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewalls", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPaloAltoFirewalls), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutingFirewallRulesIter", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListRoutingFirewallRulesIter), varargs...)
 }
 
-// ListPortForwardingRules mocks base method.
-func (m *MockFirewallServiceIface) ListPortForwardingRules(p *ListPortForwardingRulesParams) (*ListPortForwardingRulesResponse, error) {
+// ListRoutingFirewallRulesIterWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListRoutingFirewallRulesIterWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams, opts ...PageOption) iter.Seq2[*RoutingFirewallRule, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPortForwardingRules", p)
-	ret0, _ := ret[0].(*ListPortForwardingRulesResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutingFirewallRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*RoutingFirewallRule, error])
+	return ret0
 }
 
-// ListPortForwardingRules indicates an expected call of ListPortForwardingRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListPortForwardingRules(p any) *gomock.Call {
+// ListRoutingFirewallRulesIterWithContext indicates an expected call of ListRoutingFirewallRulesIterWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListRoutingFirewallRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortForwardingRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListPortForwardingRules), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutingFirewallRulesIterWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListRoutingFirewallRulesIterWithContext), varargs...)
 }
 
-// ListRoutingFirewallRules mocks base method.
-func (m *MockFirewallServiceIface) ListRoutingFirewallRules(p *ListRoutingFirewallRulesParams) (*ListRoutingFirewallRulesResponse, error) {
+// ListRoutingFirewallRulesWithContext mocks base method.
+func (m *MockFirewallServiceIface) ListRoutingFirewallRulesWithContext(ctx context.Context, p *ListRoutingFirewallRulesParams) (*ListRoutingFirewallRulesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRoutingFirewallRules", p)
+	ret := m.ctrl.Call(m, "ListRoutingFirewallRulesWithContext", ctx, p)
 	ret0, _ := ret[0].(*ListRoutingFirewallRulesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRoutingFirewallRules indicates an expected call of ListRoutingFirewallRules.
-func (mr *MockFirewallServiceIfaceMockRecorder) ListRoutingFirewallRules(p any) *gomock.Call {
+// ListRoutingFirewallRulesWithContext indicates an expected call of ListRoutingFirewallRulesWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) ListRoutingFirewallRulesWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutingFirewallRules", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListRoutingFirewallRules), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutingFirewallRulesWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).ListRoutingFirewallRulesWithContext), ctx, p)
 }
 
 // NewAddPaloAltoFirewallParams mocks base method.
@@ -477,7 +1532,7 @@ func (mr *MockFirewallServiceIfaceMockRecorder) NewConfigurePaloAltoFirewallPara
 }
 
 // NewCreateEgressFirewallRuleParams mocks base method.
-func (m *MockFirewallServiceIface) NewCreateEgressFirewallRuleParams(networkid, protocol string) *CreateEgressFirewallRuleParams {
+func (m *MockFirewallServiceIface) NewCreateEgressFirewallRuleParams(networkid string, protocol Protocol) *CreateEgressFirewallRuleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateEgressFirewallRuleParams", networkid, protocol)
 	ret0, _ := ret[0].(*CreateEgressFirewallRuleParams)
@@ -491,7 +1546,7 @@ func (mr *MockFirewallServiceIfaceMockRecorder) NewCreateEgressFirewallRuleParam
 }
 
 // NewCreateFirewallRuleParams mocks base method.
-func (m *MockFirewallServiceIface) NewCreateFirewallRuleParams(ipaddressid, protocol string) *CreateFirewallRuleParams {
+func (m *MockFirewallServiceIface) NewCreateFirewallRuleParams(ipaddressid string, protocol Protocol) *CreateFirewallRuleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateFirewallRuleParams", ipaddressid, protocol)
 	ret0, _ := ret[0].(*CreateFirewallRuleParams)
@@ -505,7 +1560,7 @@ func (mr *MockFirewallServiceIfaceMockRecorder) NewCreateFirewallRuleParams(ipad
 }
 
 // NewCreateIpv6FirewallRuleParams mocks base method.
-func (m *MockFirewallServiceIface) NewCreateIpv6FirewallRuleParams(networkid, protocol string) *CreateIpv6FirewallRuleParams {
+func (m *MockFirewallServiceIface) NewCreateIpv6FirewallRuleParams(networkid string, protocol Protocol) *CreateIpv6FirewallRuleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateIpv6FirewallRuleParams", networkid, protocol)
 	ret0, _ := ret[0].(*CreateIpv6FirewallRuleParams)
@@ -519,7 +1574,7 @@ func (mr *MockFirewallServiceIfaceMockRecorder) NewCreateIpv6FirewallRuleParams(
 }
 
 // NewCreatePortForwardingRuleParams mocks base method.
-func (m *MockFirewallServiceIface) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol string, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
+func (m *MockFirewallServiceIface) NewCreatePortForwardingRuleParams(ipaddressid string, privateport int, protocol Protocol, publicport int, virtualmachineid string) *CreatePortForwardingRuleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreatePortForwardingRuleParams", ipaddressid, privateport, protocol, publicport, virtualmachineid)
 	ret0, _ := ret[0].(*CreatePortForwardingRuleParams)
@@ -533,7 +1588,7 @@ func (mr *MockFirewallServiceIfaceMockRecorder) NewCreatePortForwardingRuleParam
 }
 
 // NewCreateRoutingFirewallRuleParams mocks base method.
-func (m *MockFirewallServiceIface) NewCreateRoutingFirewallRuleParams(networkid, protocol string) *CreateRoutingFirewallRuleParams {
+func (m *MockFirewallServiceIface) NewCreateRoutingFirewallRuleParams(networkid string, protocol Protocol) *CreateRoutingFirewallRuleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateRoutingFirewallRuleParams", networkid, protocol)
 	ret0, _ := ret[0].(*CreateRoutingFirewallRuleParams)
@@ -799,6 +1854,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) UpdateEgressFirewallRule(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateEgressFirewallRule), p)
 }
 
+// UpdateEgressFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) UpdateEgressFirewallRuleAsync(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEgressFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEgressFirewallRuleAsync indicates an expected call of UpdateEgressFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateEgressFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateEgressFirewallRuleAsync), ctx, p)
+}
+
+// UpdateEgressFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) UpdateEgressFirewallRuleWithContext(ctx context.Context, p *UpdateEgressFirewallRuleParams) (*UpdateEgressFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateEgressFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateEgressFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateEgressFirewallRuleWithContext indicates an expected call of UpdateEgressFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateEgressFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateEgressFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateEgressFirewallRuleWithContext), ctx, p)
+}
+
 // UpdateFirewallRule mocks base method.
 func (m *MockFirewallServiceIface) UpdateFirewallRule(p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -814,6 +1899,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) UpdateFirewallRule(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateFirewallRule), p)
 }
 
+// UpdateFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) UpdateFirewallRuleAsync(ctx context.Context, p *UpdateFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFirewallRuleAsync indicates an expected call of UpdateFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateFirewallRuleAsync), ctx, p)
+}
+
+// UpdateFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) UpdateFirewallRuleWithContext(ctx context.Context, p *UpdateFirewallRuleParams) (*UpdateFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateFirewallRuleWithContext indicates an expected call of UpdateFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateFirewallRuleWithContext), ctx, p)
+}
+
 // UpdateIpv6FirewallRule mocks base method.
 func (m *MockFirewallServiceIface) UpdateIpv6FirewallRule(p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -829,6 +1944,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) UpdateIpv6FirewallRule(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv6FirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateIpv6FirewallRule), p)
 }
 
+// UpdateIpv6FirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) UpdateIpv6FirewallRuleAsync(ctx context.Context, p *UpdateIpv6FirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIpv6FirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIpv6FirewallRuleAsync indicates an expected call of UpdateIpv6FirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateIpv6FirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv6FirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateIpv6FirewallRuleAsync), ctx, p)
+}
+
+// UpdateIpv6FirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) UpdateIpv6FirewallRuleWithContext(ctx context.Context, p *UpdateIpv6FirewallRuleParams) (*UpdateIpv6FirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIpv6FirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateIpv6FirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIpv6FirewallRuleWithContext indicates an expected call of UpdateIpv6FirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateIpv6FirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv6FirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateIpv6FirewallRuleWithContext), ctx, p)
+}
+
 // UpdatePortForwardingRule mocks base method.
 func (m *MockFirewallServiceIface) UpdatePortForwardingRule(p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -844,6 +1989,36 @@ func (mr *MockFirewallServiceIfaceMockRecorder) UpdatePortForwardingRule(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePortForwardingRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdatePortForwardingRule), p)
 }
 
+// UpdatePortForwardingRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) UpdatePortForwardingRuleAsync(ctx context.Context, p *UpdatePortForwardingRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePortForwardingRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePortForwardingRuleAsync indicates an expected call of UpdatePortForwardingRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdatePortForwardingRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePortForwardingRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdatePortForwardingRuleAsync), ctx, p)
+}
+
+// UpdatePortForwardingRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) UpdatePortForwardingRuleWithContext(ctx context.Context, p *UpdatePortForwardingRuleParams) (*UpdatePortForwardingRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePortForwardingRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdatePortForwardingRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePortForwardingRuleWithContext indicates an expected call of UpdatePortForwardingRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdatePortForwardingRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePortForwardingRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdatePortForwardingRuleWithContext), ctx, p)
+}
+
 // UpdateRoutingFirewallRule mocks base method.
 func (m *MockFirewallServiceIface) UpdateRoutingFirewallRule(p *UpdateRoutingFirewallRuleParams) (*UpdateRoutingFirewallRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -858,3 +2033,33 @@ func (mr *MockFirewallServiceIfaceMockRecorder) UpdateRoutingFirewallRule(p any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutingFirewallRule", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateRoutingFirewallRule), p)
 }
+
+// UpdateRoutingFirewallRuleAsync mocks base method.
+func (m *MockFirewallServiceIface) UpdateRoutingFirewallRuleAsync(ctx context.Context, p *UpdateRoutingFirewallRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoutingFirewallRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoutingFirewallRuleAsync indicates an expected call of UpdateRoutingFirewallRuleAsync.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateRoutingFirewallRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutingFirewallRuleAsync", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateRoutingFirewallRuleAsync), ctx, p)
+}
+
+// UpdateRoutingFirewallRuleWithContext mocks base method.
+func (m *MockFirewallServiceIface) UpdateRoutingFirewallRuleWithContext(ctx context.Context, p *UpdateRoutingFirewallRuleParams) (*UpdateRoutingFirewallRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoutingFirewallRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateRoutingFirewallRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoutingFirewallRuleWithContext indicates an expected call of UpdateRoutingFirewallRuleWithContext.
+func (mr *MockFirewallServiceIfaceMockRecorder) UpdateRoutingFirewallRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoutingFirewallRuleWithContext", reflect.TypeOf((*MockFirewallServiceIface)(nil).UpdateRoutingFirewallRuleWithContext), ctx, p)
+}
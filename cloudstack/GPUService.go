@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,41 +31,75 @@ import (
 
 type GPUServiceIface interface {
 	CreateGpuCard(p *CreateGpuCardParams) (*CreateGpuCardResponse, error)
+	CreateGpuCardWithContext(ctx context.Context, p *CreateGpuCardParams) (*CreateGpuCardResponse, error)
 	NewCreateGpuCardParams(deviceid string, devicename string, name string, vendorid string, vendorname string) *CreateGpuCardParams
 	CreateGpuDevice(p *CreateGpuDeviceParams) (*CreateGpuDeviceResponse, error)
+	CreateGpuDeviceWithContext(ctx context.Context, p *CreateGpuDeviceParams) (*CreateGpuDeviceResponse, error)
 	NewCreateGpuDeviceParams(busaddress string, gpucardid string, hostid string, vgpuprofileid string) *CreateGpuDeviceParams
 	CreateVgpuProfile(p *CreateVgpuProfileParams) (*CreateVgpuProfileResponse, error)
+	CreateVgpuProfileWithContext(ctx context.Context, p *CreateVgpuProfileParams) (*CreateVgpuProfileResponse, error)
 	NewCreateVgpuProfileParams(gpucardid string, name string) *CreateVgpuProfileParams
 	DeleteGpuCard(p *DeleteGpuCardParams) (*DeleteGpuCardResponse, error)
+	DeleteGpuCardWithContext(ctx context.Context, p *DeleteGpuCardParams) (*DeleteGpuCardResponse, error)
 	NewDeleteGpuCardParams(id string) *DeleteGpuCardParams
 	DeleteGpuDevice(p *DeleteGpuDeviceParams) (*DeleteGpuDeviceResponse, error)
+	DeleteGpuDeviceWithContext(ctx context.Context, p *DeleteGpuDeviceParams) (*DeleteGpuDeviceResponse, error)
 	NewDeleteGpuDeviceParams(ids []string) *DeleteGpuDeviceParams
 	DeleteVgpuProfile(p *DeleteVgpuProfileParams) (*DeleteVgpuProfileResponse, error)
+	DeleteVgpuProfileWithContext(ctx context.Context, p *DeleteVgpuProfileParams) (*DeleteVgpuProfileResponse, error)
 	NewDeleteVgpuProfileParams(id string) *DeleteVgpuProfileParams
 	DiscoverGpuDevices(p *DiscoverGpuDevicesParams) (*DiscoverGpuDevicesResponse, error)
+	DiscoverGpuDevicesWithContext(ctx context.Context, p *DiscoverGpuDevicesParams) (*DiscoverGpuDevicesResponse, error)
 	NewDiscoverGpuDevicesParams(id string) *DiscoverGpuDevicesParams
 	ListGpuCards(p *ListGpuCardsParams) (*ListGpuCardsResponse, error)
+	ListGpuCardsWithContext(ctx context.Context, p *ListGpuCardsParams) (*ListGpuCardsResponse, error)
+	ListGpuCardsAll(p *ListGpuCardsParams, opts ...PageOption) ([]*GpuCard, error)
+	ListGpuCardsAllWithContext(ctx context.Context, p *ListGpuCardsParams, opts ...PageOption) ([]*GpuCard, error)
+	ListGpuCardsIter(p *ListGpuCardsParams, opts ...PageOption) iter.Seq2[*GpuCard, error]
+	ListGpuCardsIterWithContext(ctx context.Context, p *ListGpuCardsParams, opts ...PageOption) iter.Seq2[*GpuCard, error]
 	NewListGpuCardsParams() *ListGpuCardsParams
 	GetGpuCardID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetGpuCardIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetGpuCardByName(name string, opts ...OptionFunc) (*GpuCard, int, error)
+	GetGpuCardByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GpuCard, int, error)
 	GetGpuCardByID(id string, opts ...OptionFunc) (*GpuCard, int, error)
+	GetGpuCardByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GpuCard, int, error)
 	ListGpuDevices(p *ListGpuDevicesParams) (*ListGpuDevicesResponse, error)
+	ListGpuDevicesWithContext(ctx context.Context, p *ListGpuDevicesParams) (*ListGpuDevicesResponse, error)
+	ListGpuDevicesAll(p *ListGpuDevicesParams, opts ...PageOption) ([]*GpuDevice, error)
+	ListGpuDevicesAllWithContext(ctx context.Context, p *ListGpuDevicesParams, opts ...PageOption) ([]*GpuDevice, error)
+	ListGpuDevicesIter(p *ListGpuDevicesParams, opts ...PageOption) iter.Seq2[*GpuDevice, error]
+	ListGpuDevicesIterWithContext(ctx context.Context, p *ListGpuDevicesParams, opts ...PageOption) iter.Seq2[*GpuDevice, error]
 	NewListGpuDevicesParams() *ListGpuDevicesParams
 	GetGpuDeviceByID(id string, opts ...OptionFunc) (*GpuDevice, int, error)
+	GetGpuDeviceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GpuDevice, int, error)
 	ListVgpuProfiles(p *ListVgpuProfilesParams) (*ListVgpuProfilesResponse, error)
+	ListVgpuProfilesWithContext(ctx context.Context, p *ListVgpuProfilesParams) (*ListVgpuProfilesResponse, error)
+	ListVgpuProfilesAll(p *ListVgpuProfilesParams, opts ...PageOption) ([]*VgpuProfile, error)
+	ListVgpuProfilesAllWithContext(ctx context.Context, p *ListVgpuProfilesParams, opts ...PageOption) ([]*VgpuProfile, error)
+	ListVgpuProfilesIter(p *ListVgpuProfilesParams, opts ...PageOption) iter.Seq2[*VgpuProfile, error]
+	ListVgpuProfilesIterWithContext(ctx context.Context, p *ListVgpuProfilesParams, opts ...PageOption) iter.Seq2[*VgpuProfile, error]
 	NewListVgpuProfilesParams() *ListVgpuProfilesParams
 	GetVgpuProfileID(name string, opts ...OptionFunc) (string, int, error)
+	GetVgpuProfileIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVgpuProfileByName(name string, opts ...OptionFunc) (*VgpuProfile, int, error)
+	GetVgpuProfileByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VgpuProfile, int, error)
 	GetVgpuProfileByID(id string, opts ...OptionFunc) (*VgpuProfile, int, error)
+	GetVgpuProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VgpuProfile, int, error)
 	ManageGpuDevice(p *ManageGpuDeviceParams) (*ManageGpuDeviceResponse, error)
+	ManageGpuDeviceWithContext(ctx context.Context, p *ManageGpuDeviceParams) (*ManageGpuDeviceResponse, error)
 	NewManageGpuDeviceParams(ids []string) *ManageGpuDeviceParams
 	UnmanageGpuDevice(p *UnmanageGpuDeviceParams) (*UnmanageGpuDeviceResponse, error)
+	UnmanageGpuDeviceWithContext(ctx context.Context, p *UnmanageGpuDeviceParams) (*UnmanageGpuDeviceResponse, error)
 	NewUnmanageGpuDeviceParams(ids []string) *UnmanageGpuDeviceParams
 	UpdateGpuCard(p *UpdateGpuCardParams) (*UpdateGpuCardResponse, error)
+	UpdateGpuCardWithContext(ctx context.Context, p *UpdateGpuCardParams) (*UpdateGpuCardResponse, error)
 	NewUpdateGpuCardParams(id string) *UpdateGpuCardParams
 	UpdateGpuDevice(p *UpdateGpuDeviceParams) (*UpdateGpuDeviceResponse, error)
+	UpdateGpuDeviceWithContext(ctx context.Context, p *UpdateGpuDeviceParams) (*UpdateGpuDeviceResponse, error)
 	NewUpdateGpuDeviceParams(id string) *UpdateGpuDeviceParams
 	UpdateVgpuProfile(p *UpdateVgpuProfileParams) (*UpdateVgpuProfileResponse, error)
+	UpdateVgpuProfileWithContext(ctx context.Context, p *UpdateVgpuProfileParams) (*UpdateVgpuProfileResponse, error)
 	NewUpdateVgpuProfileParams(id string) *UpdateVgpuProfileParams
 }
 
@@ -98,6 +134,49 @@ func (p *CreateGpuCardParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createGpuCard API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateGpuCardParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createGpuCard", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createGpuCard API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateGpuCardParams) Validate() error {
+	return paramRules{
+		required: []string{"deviceid", "devicename", "name", "vendorid", "vendorname"},
+		lengths:  map[string]int{"deviceid": 255, "devicename": 255, "name": 255, "vendorid": 255, "vendorname": 255},
+	}.validate("createGpuCard", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createGpuCard API, before the request is signed
+func (p *CreateGpuCardParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateGpuCardParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateGpuCardParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createGpuCard", b, &p.p, map[string]paramDecoder{
+		"deviceid":   decodeParam[string],
+		"devicename": decodeParam[string],
+		"name":       decodeParam[string],
+		"vendorid":   decodeParam[string],
+		"vendorname": decodeParam[string],
+		"videoram":   decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateGpuCardParams) Clone() *CreateGpuCardParams {
+	return &CreateGpuCardParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateGpuCardParams) SetDeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -239,13 +318,22 @@ func (s *GPUService) NewCreateGpuCardParams(deviceid string, devicename string,
 
 // Creates a GPU card definition in the system
 func (s *GPUService) CreateGpuCard(p *CreateGpuCardParams) (*CreateGpuCardResponse, error) {
-	resp, err := s.cs.newPostRequest("createGpuCard", p.toURLValues())
+	return s.CreateGpuCardWithContext(context.Background(), p)
+}
+
+// CreateGpuCardWithContext is like CreateGpuCard, but honours the cancellation and deadline of ctx
+func (s *GPUService) CreateGpuCardWithContext(ctx context.Context, p *CreateGpuCardParams) (*CreateGpuCardResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createGpuCard", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateGpuCardResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createGpuCard", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -253,14 +341,20 @@ func (s *GPUService) CreateGpuCard(p *CreateGpuCardParams) (*CreateGpuCardRespon
 }
 
 type CreateGpuCardResponse struct {
-	Deviceid   string `json:"deviceid"`
-	Devicename string `json:"devicename"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
-	Vendorid   string `json:"vendorid"`
-	Vendorname string `json:"vendorname"`
+	Deviceid   string                     `json:"deviceid"`
+	Devicename string                     `json:"devicename"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Vendorid   string                     `json:"vendorid"`
+	Vendorname string                     `json:"vendorname"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateGpuCardResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateGpuCardResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateGpuDeviceParams struct {
@@ -296,6 +390,50 @@ func (p *CreateGpuDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createGpuDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateGpuDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createGpuDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createGpuDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateGpuDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"busaddress", "gpucardid", "hostid", "vgpuprofileid"},
+		lengths:  map[string]int{"busaddress": 255, "gpucardid": 255, "hostid": 255, "numanode": 255, "parentgpudeviceid": 255, "type": 255, "vgpuprofileid": 255},
+	}.validate("createGpuDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createGpuDevice API, before the request is signed
+func (p *CreateGpuDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateGpuDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateGpuDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createGpuDevice", b, &p.p, map[string]paramDecoder{
+		"busaddress":        decodeParam[string],
+		"gpucardid":         decodeParam[string],
+		"hostid":            decodeParam[string],
+		"numanode":          decodeParam[string],
+		"parentgpudeviceid": decodeParam[string],
+		"type":              decodeParam[string],
+		"vgpuprofileid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateGpuDeviceParams) Clone() *CreateGpuDeviceParams {
+	return &CreateGpuDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateGpuDeviceParams) SetBusaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -457,13 +595,22 @@ func (s *GPUService) NewCreateGpuDeviceParams(busaddress string, gpucardid strin
 
 // Creates a GPU device manually on a host
 func (s *GPUService) CreateGpuDevice(p *CreateGpuDeviceParams) (*CreateGpuDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("createGpuDevice", p.toURLValues())
+	return s.CreateGpuDeviceWithContext(context.Background(), p)
+}
+
+// CreateGpuDeviceWithContext is like CreateGpuDevice, but honours the cancellation and deadline of ctx
+func (s *GPUService) CreateGpuDeviceWithContext(ctx context.Context, p *CreateGpuDeviceParams) (*CreateGpuDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createGpuDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateGpuDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createGpuDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -471,24 +618,30 @@ func (s *GPUService) CreateGpuDevice(p *CreateGpuDeviceParams) (*CreateGpuDevice
 }
 
 type CreateGpuDeviceResponse struct {
-	Busaddress         string `json:"busaddress"`
-	Gpucardid          string `json:"gpucardid"`
-	Gpucardname        string `json:"gpucardname"`
-	Gpudevicetype      string `json:"gpudevicetype"`
-	Hostid             string `json:"hostid"`
-	Hostname           string `json:"hostname"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Managedstate       string `json:"managedstate"`
-	Numanode           string `json:"numanode"`
-	Parentgpudeviceid  string `json:"parentgpudeviceid"`
-	State              string `json:"state"`
-	Vgpuprofileid      string `json:"vgpuprofileid"`
-	Vgpuprofilename    string `json:"vgpuprofilename"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
-	Vmstate            string `json:"vmstate"`
+	Busaddress         string                     `json:"busaddress"`
+	Gpucardid          string                     `json:"gpucardid"`
+	Gpucardname        string                     `json:"gpucardname"`
+	Gpudevicetype      string                     `json:"gpudevicetype"`
+	Hostid             string                     `json:"hostid"`
+	Hostname           string                     `json:"hostname"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Managedstate       string                     `json:"managedstate"`
+	Numanode           string                     `json:"numanode"`
+	Parentgpudeviceid  string                     `json:"parentgpudeviceid"`
+	State              string                     `json:"state"`
+	Vgpuprofileid      string                     `json:"vgpuprofileid"`
+	Vgpuprofilename    string                     `json:"vgpuprofilename"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Vmstate            string                     `json:"vmstate"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateGpuDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateGpuDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVgpuProfileParams struct {
@@ -532,6 +685,51 @@ func (p *CreateVgpuProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVgpuProfile API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVgpuProfileParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVgpuProfile", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVgpuProfile API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVgpuProfileParams) Validate() error {
+	return paramRules{
+		required: []string{"gpucardid", "name"},
+		lengths:  map[string]int{"description": 4096, "gpucardid": 255, "name": 255},
+	}.validate("createVgpuProfile", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVgpuProfile API, before the request is signed
+func (p *CreateVgpuProfileParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVgpuProfileParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVgpuProfileParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVgpuProfile", b, &p.p, map[string]paramDecoder{
+		"description":           decodeParam[string],
+		"gpucardid":             decodeParam[string],
+		"maxheads":              decodeParam[int64],
+		"maxresolutionx":        decodeParam[int64],
+		"maxresolutiony":        decodeParam[int64],
+		"maxvgpuperphysicalgpu": decodeParam[int64],
+		"name":                  decodeParam[string],
+		"videoram":              decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVgpuProfileParams) Clone() *CreateVgpuProfileParams {
+	return &CreateVgpuProfileParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVgpuProfileParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -712,13 +910,22 @@ func (s *GPUService) NewCreateVgpuProfileParams(gpucardid string, name string) *
 
 // Creates a vGPU profile in the system
 func (s *GPUService) CreateVgpuProfile(p *CreateVgpuProfileParams) (*CreateVgpuProfileResponse, error) {
-	resp, err := s.cs.newPostRequest("createVgpuProfile", p.toURLValues())
+	return s.CreateVgpuProfileWithContext(context.Background(), p)
+}
+
+// CreateVgpuProfileWithContext is like CreateVgpuProfile, but honours the cancellation and deadline of ctx
+func (s *GPUService) CreateVgpuProfileWithContext(ctx context.Context, p *CreateVgpuProfileParams) (*CreateVgpuProfileResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createVgpuProfile", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVgpuProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVgpuProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -726,22 +933,28 @@ func (s *GPUService) CreateVgpuProfile(p *CreateVgpuProfileParams) (*CreateVgpuP
 }
 
 type CreateVgpuProfileResponse struct {
-	Description           string `json:"description"`
-	Deviceid              string `json:"deviceid"`
-	Devicename            string `json:"devicename"`
-	Gpucardid             string `json:"gpucardid"`
-	Gpucardname           string `json:"gpucardname"`
-	Id                    string `json:"id"`
-	JobID                 string `json:"jobid"`
-	Jobstatus             int    `json:"jobstatus"`
-	Maxheads              int64  `json:"maxheads"`
-	Maxresolutionx        int64  `json:"maxresolutionx"`
-	Maxresolutiony        int64  `json:"maxresolutiony"`
-	Maxvgpuperphysicalgpu int64  `json:"maxvgpuperphysicalgpu"`
-	Name                  string `json:"name"`
-	Vendorid              string `json:"vendorid"`
-	Vendorname            string `json:"vendorname"`
-	Videoram              int64  `json:"videoram"`
+	Description           string                     `json:"description"`
+	Deviceid              string                     `json:"deviceid"`
+	Devicename            string                     `json:"devicename"`
+	Gpucardid             string                     `json:"gpucardid"`
+	Gpucardname           string                     `json:"gpucardname"`
+	Id                    string                     `json:"id"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Maxheads              FlexInt64                  `json:"maxheads"`
+	Maxresolutionx        FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperphysicalgpu FlexInt64                  `json:"maxvgpuperphysicalgpu"`
+	Name                  string                     `json:"name"`
+	Vendorid              string                     `json:"vendorid"`
+	Vendorname            string                     `json:"vendorname"`
+	Videoram              FlexInt64                  `json:"videoram"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVgpuProfileResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVgpuProfileResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteGpuCardParams struct {
@@ -759,6 +972,44 @@ func (p *DeleteGpuCardParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteGpuCard API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteGpuCardParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteGpuCard", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteGpuCard API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteGpuCardParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteGpuCard", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteGpuCard API, before the request is signed
+func (p *DeleteGpuCardParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteGpuCardParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteGpuCardParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteGpuCard", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteGpuCardParams) Clone() *DeleteGpuCardParams {
+	return &DeleteGpuCardParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteGpuCardParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -791,13 +1042,22 @@ func (s *GPUService) NewDeleteGpuCardParams(id string) *DeleteGpuCardParams {
 
 // Deletes a GPU card definition from the system
 func (s *GPUService) DeleteGpuCard(p *DeleteGpuCardParams) (*DeleteGpuCardResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteGpuCard", p.toURLValues())
+	return s.DeleteGpuCardWithContext(context.Background(), p)
+}
+
+// DeleteGpuCardWithContext is like DeleteGpuCard, but honours the cancellation and deadline of ctx
+func (s *GPUService) DeleteGpuCardWithContext(ctx context.Context, p *DeleteGpuCardParams) (*DeleteGpuCardResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteGpuCard", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteGpuCardResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteGpuCard", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -805,10 +1065,11 @@ func (s *GPUService) DeleteGpuCard(p *DeleteGpuCardParams) (*DeleteGpuCardRespon
 }
 
 type DeleteGpuCardResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteGpuCardResponse) UnmarshalJSON(b []byte) error {
@@ -835,7 +1096,7 @@ func (r *DeleteGpuCardResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteGpuCardResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteGpuDeviceParams struct {
@@ -854,6 +1115,43 @@ func (p *DeleteGpuDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteGpuDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteGpuDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteGpuDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteGpuDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteGpuDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"ids"},
+	}.validate("deleteGpuDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteGpuDevice API, before the request is signed
+func (p *DeleteGpuDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteGpuDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteGpuDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteGpuDevice", b, &p.p, map[string]paramDecoder{
+		"ids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteGpuDeviceParams) Clone() *DeleteGpuDeviceParams {
+	return &DeleteGpuDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteGpuDeviceParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -886,13 +1184,22 @@ func (s *GPUService) NewDeleteGpuDeviceParams(ids []string) *DeleteGpuDevicePara
 
 // Deletes a vGPU profile from the system
 func (s *GPUService) DeleteGpuDevice(p *DeleteGpuDeviceParams) (*DeleteGpuDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteGpuDevice", p.toURLValues())
+	return s.DeleteGpuDeviceWithContext(context.Background(), p)
+}
+
+// DeleteGpuDeviceWithContext is like DeleteGpuDevice, but honours the cancellation and deadline of ctx
+func (s *GPUService) DeleteGpuDeviceWithContext(ctx context.Context, p *DeleteGpuDeviceParams) (*DeleteGpuDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteGpuDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteGpuDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteGpuDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -900,10 +1207,11 @@ func (s *GPUService) DeleteGpuDevice(p *DeleteGpuDeviceParams) (*DeleteGpuDevice
 }
 
 type DeleteGpuDeviceResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteGpuDeviceResponse) UnmarshalJSON(b []byte) error {
@@ -930,7 +1238,7 @@ func (r *DeleteGpuDeviceResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteGpuDeviceResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVgpuProfileParams struct {
@@ -948,6 +1256,44 @@ func (p *DeleteVgpuProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVgpuProfile API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVgpuProfileParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVgpuProfile", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVgpuProfile API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVgpuProfileParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVgpuProfile", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVgpuProfile API, before the request is signed
+func (p *DeleteVgpuProfileParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVgpuProfileParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVgpuProfileParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVgpuProfile", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVgpuProfileParams) Clone() *DeleteVgpuProfileParams {
+	return &DeleteVgpuProfileParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVgpuProfileParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -980,13 +1326,22 @@ func (s *GPUService) NewDeleteVgpuProfileParams(id string) *DeleteVgpuProfilePar
 
 // Deletes a vGPU profile from the system
 func (s *GPUService) DeleteVgpuProfile(p *DeleteVgpuProfileParams) (*DeleteVgpuProfileResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVgpuProfile", p.toURLValues())
+	return s.DeleteVgpuProfileWithContext(context.Background(), p)
+}
+
+// DeleteVgpuProfileWithContext is like DeleteVgpuProfile, but honours the cancellation and deadline of ctx
+func (s *GPUService) DeleteVgpuProfileWithContext(ctx context.Context, p *DeleteVgpuProfileParams) (*DeleteVgpuProfileResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVgpuProfile", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVgpuProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVgpuProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -994,10 +1349,11 @@ func (s *GPUService) DeleteVgpuProfile(p *DeleteVgpuProfileParams) (*DeleteVgpuP
 }
 
 type DeleteVgpuProfileResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteVgpuProfileResponse) UnmarshalJSON(b []byte) error {
@@ -1024,7 +1380,7 @@ func (r *DeleteVgpuProfileResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteVgpuProfileResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DiscoverGpuDevicesParams struct {
@@ -1053,6 +1409,47 @@ func (p *DiscoverGpuDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the discoverGpuDevices API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DiscoverGpuDevicesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("discoverGpuDevices", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the discoverGpuDevices API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DiscoverGpuDevicesParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "keyword": 255},
+	}.validate("discoverGpuDevices", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the discoverGpuDevices API, before the request is signed
+func (p *DiscoverGpuDevicesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DiscoverGpuDevicesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DiscoverGpuDevicesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("discoverGpuDevices", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DiscoverGpuDevicesParams) Clone() *DiscoverGpuDevicesParams {
+	return &DiscoverGpuDevicesParams{p: cloneParams(p.p)}
+}
+
 func (p *DiscoverGpuDevicesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1148,13 +1545,22 @@ func (s *GPUService) NewDiscoverGpuDevicesParams(id string) *DiscoverGpuDevicesP
 
 // Discovers available GPU devices on a host
 func (s *GPUService) DiscoverGpuDevices(p *DiscoverGpuDevicesParams) (*DiscoverGpuDevicesResponse, error) {
-	resp, err := s.cs.newPostRequest("discoverGpuDevices", p.toURLValues())
+	return s.DiscoverGpuDevicesWithContext(context.Background(), p)
+}
+
+// DiscoverGpuDevicesWithContext is like DiscoverGpuDevices, but honours the cancellation and deadline of ctx
+func (s *GPUService) DiscoverGpuDevicesWithContext(ctx context.Context, p *DiscoverGpuDevicesParams) (*DiscoverGpuDevicesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "discoverGpuDevices", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DiscoverGpuDevicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("discoverGpuDevices", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1162,24 +1568,30 @@ func (s *GPUService) DiscoverGpuDevices(p *DiscoverGpuDevicesParams) (*DiscoverG
 }
 
 type DiscoverGpuDevicesResponse struct {
-	Busaddress         string `json:"busaddress"`
-	Gpucardid          string `json:"gpucardid"`
-	Gpucardname        string `json:"gpucardname"`
-	Gpudevicetype      string `json:"gpudevicetype"`
-	Hostid             string `json:"hostid"`
-	Hostname           string `json:"hostname"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Managedstate       string `json:"managedstate"`
-	Numanode           string `json:"numanode"`
-	Parentgpudeviceid  string `json:"parentgpudeviceid"`
-	State              string `json:"state"`
-	Vgpuprofileid      string `json:"vgpuprofileid"`
-	Vgpuprofilename    string `json:"vgpuprofilename"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
-	Vmstate            string `json:"vmstate"`
+	Busaddress         string                     `json:"busaddress"`
+	Gpucardid          string                     `json:"gpucardid"`
+	Gpucardname        string                     `json:"gpucardname"`
+	Gpudevicetype      string                     `json:"gpudevicetype"`
+	Hostid             string                     `json:"hostid"`
+	Hostname           string                     `json:"hostname"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Managedstate       string                     `json:"managedstate"`
+	Numanode           string                     `json:"numanode"`
+	Parentgpudeviceid  string                     `json:"parentgpudeviceid"`
+	State              string                     `json:"state"`
+	Vgpuprofileid      string                     `json:"vgpuprofileid"`
+	Vgpuprofilename    string                     `json:"vgpuprofilename"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Vmstate            string                     `json:"vmstate"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DiscoverGpuDevicesResponse) UnmarshalJSON(b []byte) error {
+	type alias DiscoverGpuDevicesResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListGpuCardsParams struct {
@@ -1224,6 +1636,51 @@ func (p *ListGpuCardsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listGpuCards API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListGpuCardsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listGpuCards", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listGpuCards API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListGpuCardsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"deviceid": 255, "devicename": 255, "id": 255, "keyword": 255, "vendorid": 255, "vendorname": 255},
+	}.validate("listGpuCards", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listGpuCards API, before the request is signed
+func (p *ListGpuCardsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListGpuCardsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListGpuCardsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listGpuCards", b, &p.p, map[string]paramDecoder{
+		"activeonly": decodeParam[bool],
+		"deviceid":   decodeParam[string],
+		"devicename": decodeParam[string],
+		"id":         decodeParam[string],
+		"keyword":    decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+		"vendorid":   decodeParam[string],
+		"vendorname": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListGpuCardsParams) Clone() *ListGpuCardsParams {
+	return &ListGpuCardsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListGpuCardsParams) SetActiveonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1423,6 +1880,11 @@ func (s *GPUService) NewListGpuCardsParams() *ListGpuCardsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GPUService) GetGpuCardID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetGpuCardIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetGpuCardIDWithContext is like GetGpuCardID, but honours the cancellation and deadline of ctx
+func (s *GPUService) GetGpuCardIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListGpuCardsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1434,7 +1896,7 @@ func (s *GPUService) GetGpuCardID(keyword string, opts ...OptionFunc) (string, i
 		}
 	}
 
-	l, err := s.ListGpuCards(p)
+	l, err := s.ListGpuCardsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1459,12 +1921,17 @@ func (s *GPUService) GetGpuCardID(keyword string, opts ...OptionFunc) (string, i
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GPUService) GetGpuCardByName(name string, opts ...OptionFunc) (*GpuCard, int, error) {
-	id, count, err := s.GetGpuCardID(name, opts...)
+	return s.GetGpuCardByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetGpuCardByNameWithContext is like GetGpuCardByName, but honours the cancellation and deadline of ctx
+func (s *GPUService) GetGpuCardByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GpuCard, int, error) {
+	id, count, err := s.GetGpuCardIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetGpuCardByID(id, opts...)
+	r, count, err := s.GetGpuCardByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1473,6 +1940,11 @@ func (s *GPUService) GetGpuCardByName(name string, opts ...OptionFunc) (*GpuCard
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GPUService) GetGpuCardByID(id string, opts ...OptionFunc) (*GpuCard, int, error) {
+	return s.GetGpuCardByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetGpuCardByIDWithContext is like GetGpuCardByID, but honours the cancellation and deadline of ctx
+func (s *GPUService) GetGpuCardByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GpuCard, int, error) {
 	p := &ListGpuCardsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1484,11 +1956,10 @@ func (s *GPUService) GetGpuCardByID(id string, opts ...OptionFunc) (*GpuCard, in
 		}
 	}
 
-	l, err := s.ListGpuCards(p)
+	l, err := s.ListGpuCardsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1506,33 +1977,76 @@ func (s *GPUService) GetGpuCardByID(id string, opts ...OptionFunc) (*GpuCard, in
 
 // Lists all available GPU cards
 func (s *GPUService) ListGpuCards(p *ListGpuCardsParams) (*ListGpuCardsResponse, error) {
-	resp, err := s.cs.newRequest("listGpuCards", p.toURLValues())
+	return s.ListGpuCardsWithContext(context.Background(), p)
+}
+
+// ListGpuCardsWithContext is like ListGpuCards, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListGpuCardsWithContext(ctx context.Context, p *ListGpuCardsParams) (*ListGpuCardsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listGpuCards", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListGpuCardsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listGpuCards", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListGpuCardsAll returns all GpuCards matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *GPUService) ListGpuCardsAll(p *ListGpuCardsParams, opts ...PageOption) ([]*GpuCard, error) {
+	return s.ListGpuCardsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListGpuCardsAllWithContext is like ListGpuCardsAll, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListGpuCardsAllWithContext(ctx context.Context, p *ListGpuCardsParams, opts ...PageOption) ([]*GpuCard, error) {
+	return collectPages(s.ListGpuCardsIterWithContext(ctx, p, opts...))
+}
+
+// ListGpuCardsIter returns an iterator over all GpuCards matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *GPUService) ListGpuCardsIter(p *ListGpuCardsParams, opts ...PageOption) iter.Seq2[*GpuCard, error] {
+	return s.ListGpuCardsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListGpuCardsIterWithContext is like ListGpuCardsIter, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListGpuCardsIterWithContext(ctx context.Context, p *ListGpuCardsParams, opts ...PageOption) iter.Seq2[*GpuCard, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*GpuCard, int, error) {
+		l, err := s.ListGpuCardsWithContext(ctx, &ListGpuCardsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.GpuCards, l.Count, nil
+	}, opts...)
+}
+
 type ListGpuCardsResponse struct {
 	Count    int        `json:"count"`
 	GpuCards []*GpuCard `json:"gpucard"`
 }
 
 type GpuCard struct {
-	Deviceid   string `json:"deviceid"`
-	Devicename string `json:"devicename"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
-	Vendorid   string `json:"vendorid"`
-	Vendorname string `json:"vendorname"`
+	Deviceid   string                     `json:"deviceid"`
+	Devicename string                     `json:"devicename"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Vendorid   string                     `json:"vendorid"`
+	Vendorname string                     `json:"vendorname"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GpuCard) UnmarshalJSON(b []byte) error {
+	type alias GpuCard
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListGpuDevicesParams struct {
@@ -1573,6 +2087,50 @@ func (p *ListGpuDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listGpuDevices API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListGpuDevicesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listGpuDevices", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listGpuDevices API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListGpuDevicesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"gpucardid": 255, "hostid": 255, "id": 255, "keyword": 255, "vgpuprofileid": 255, "virtualmachineid": 255},
+	}.validate("listGpuDevices", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listGpuDevices API, before the request is signed
+func (p *ListGpuDevicesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListGpuDevicesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListGpuDevicesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listGpuDevices", b, &p.p, map[string]paramDecoder{
+		"gpucardid":        decodeParam[string],
+		"hostid":           decodeParam[string],
+		"id":               decodeParam[string],
+		"keyword":          decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"vgpuprofileid":    decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListGpuDevicesParams) Clone() *ListGpuDevicesParams {
+	return &ListGpuDevicesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListGpuDevicesParams) SetGpucardid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1751,6 +2309,11 @@ func (s *GPUService) NewListGpuDevicesParams() *ListGpuDevicesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GPUService) GetGpuDeviceByID(id string, opts ...OptionFunc) (*GpuDevice, int, error) {
+	return s.GetGpuDeviceByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetGpuDeviceByIDWithContext is like GetGpuDeviceByID, but honours the cancellation and deadline of ctx
+func (s *GPUService) GetGpuDeviceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GpuDevice, int, error) {
 	p := &ListGpuDevicesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1762,11 +2325,10 @@ func (s *GPUService) GetGpuDeviceByID(id string, opts ...OptionFunc) (*GpuDevice
 		}
 	}
 
-	l, err := s.ListGpuDevices(p)
+	l, err := s.ListGpuDevicesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1784,43 +2346,86 @@ func (s *GPUService) GetGpuDeviceByID(id string, opts ...OptionFunc) (*GpuDevice
 
 // Lists all available GPU devices
 func (s *GPUService) ListGpuDevices(p *ListGpuDevicesParams) (*ListGpuDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listGpuDevices", p.toURLValues())
+	return s.ListGpuDevicesWithContext(context.Background(), p)
+}
+
+// ListGpuDevicesWithContext is like ListGpuDevices, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListGpuDevicesWithContext(ctx context.Context, p *ListGpuDevicesParams) (*ListGpuDevicesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listGpuDevices", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListGpuDevicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listGpuDevices", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListGpuDevicesAll returns all GpuDevices matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *GPUService) ListGpuDevicesAll(p *ListGpuDevicesParams, opts ...PageOption) ([]*GpuDevice, error) {
+	return s.ListGpuDevicesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListGpuDevicesAllWithContext is like ListGpuDevicesAll, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListGpuDevicesAllWithContext(ctx context.Context, p *ListGpuDevicesParams, opts ...PageOption) ([]*GpuDevice, error) {
+	return collectPages(s.ListGpuDevicesIterWithContext(ctx, p, opts...))
+}
+
+// ListGpuDevicesIter returns an iterator over all GpuDevices matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *GPUService) ListGpuDevicesIter(p *ListGpuDevicesParams, opts ...PageOption) iter.Seq2[*GpuDevice, error] {
+	return s.ListGpuDevicesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListGpuDevicesIterWithContext is like ListGpuDevicesIter, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListGpuDevicesIterWithContext(ctx context.Context, p *ListGpuDevicesParams, opts ...PageOption) iter.Seq2[*GpuDevice, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*GpuDevice, int, error) {
+		l, err := s.ListGpuDevicesWithContext(ctx, &ListGpuDevicesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.GpuDevices, l.Count, nil
+	}, opts...)
+}
+
 type ListGpuDevicesResponse struct {
 	Count      int          `json:"count"`
 	GpuDevices []*GpuDevice `json:"gpudevice"`
 }
 
 type GpuDevice struct {
-	Busaddress         string `json:"busaddress"`
-	Gpucardid          string `json:"gpucardid"`
-	Gpucardname        string `json:"gpucardname"`
-	Gpudevicetype      string `json:"gpudevicetype"`
-	Hostid             string `json:"hostid"`
-	Hostname           string `json:"hostname"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Managedstate       string `json:"managedstate"`
-	Numanode           string `json:"numanode"`
-	Parentgpudeviceid  string `json:"parentgpudeviceid"`
-	State              string `json:"state"`
-	Vgpuprofileid      string `json:"vgpuprofileid"`
-	Vgpuprofilename    string `json:"vgpuprofilename"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
-	Vmstate            string `json:"vmstate"`
+	Busaddress         string                     `json:"busaddress"`
+	Gpucardid          string                     `json:"gpucardid"`
+	Gpucardname        string                     `json:"gpucardname"`
+	Gpudevicetype      string                     `json:"gpudevicetype"`
+	Hostid             string                     `json:"hostid"`
+	Hostname           string                     `json:"hostname"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Managedstate       string                     `json:"managedstate"`
+	Numanode           string                     `json:"numanode"`
+	Parentgpudeviceid  string                     `json:"parentgpudeviceid"`
+	State              string                     `json:"state"`
+	Vgpuprofileid      string                     `json:"vgpuprofileid"`
+	Vgpuprofilename    string                     `json:"vgpuprofilename"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Vmstate            string                     `json:"vmstate"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GpuDevice) UnmarshalJSON(b []byte) error {
+	type alias GpuDevice
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVgpuProfilesParams struct {
@@ -1859,6 +2464,49 @@ func (p *ListVgpuProfilesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVgpuProfiles API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVgpuProfilesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVgpuProfiles", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVgpuProfiles API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVgpuProfilesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"gpucardid": 255, "id": 255, "keyword": 255, "name": 255},
+	}.validate("listVgpuProfiles", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVgpuProfiles API, before the request is signed
+func (p *ListVgpuProfilesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVgpuProfilesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVgpuProfilesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVgpuProfiles", b, &p.p, map[string]paramDecoder{
+		"activeonly": decodeParam[bool],
+		"gpucardid":  decodeParam[string],
+		"id":         decodeParam[string],
+		"keyword":    decodeParam[string],
+		"name":       decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVgpuProfilesParams) Clone() *ListVgpuProfilesParams {
+	return &ListVgpuProfilesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVgpuProfilesParams) SetActiveonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2016,6 +2664,11 @@ func (s *GPUService) NewListVgpuProfilesParams() *ListVgpuProfilesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GPUService) GetVgpuProfileID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVgpuProfileIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVgpuProfileIDWithContext is like GetVgpuProfileID, but honours the cancellation and deadline of ctx
+func (s *GPUService) GetVgpuProfileIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVgpuProfilesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2027,7 +2680,7 @@ func (s *GPUService) GetVgpuProfileID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListVgpuProfiles(p)
+	l, err := s.ListVgpuProfilesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2052,12 +2705,17 @@ func (s *GPUService) GetVgpuProfileID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GPUService) GetVgpuProfileByName(name string, opts ...OptionFunc) (*VgpuProfile, int, error) {
-	id, count, err := s.GetVgpuProfileID(name, opts...)
+	return s.GetVgpuProfileByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVgpuProfileByNameWithContext is like GetVgpuProfileByName, but honours the cancellation and deadline of ctx
+func (s *GPUService) GetVgpuProfileByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VgpuProfile, int, error) {
+	id, count, err := s.GetVgpuProfileIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVgpuProfileByID(id, opts...)
+	r, count, err := s.GetVgpuProfileByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2066,6 +2724,11 @@ func (s *GPUService) GetVgpuProfileByName(name string, opts ...OptionFunc) (*Vgp
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GPUService) GetVgpuProfileByID(id string, opts ...OptionFunc) (*VgpuProfile, int, error) {
+	return s.GetVgpuProfileByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVgpuProfileByIDWithContext is like GetVgpuProfileByID, but honours the cancellation and deadline of ctx
+func (s *GPUService) GetVgpuProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VgpuProfile, int, error) {
 	p := &ListVgpuProfilesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2077,11 +2740,10 @@ func (s *GPUService) GetVgpuProfileByID(id string, opts ...OptionFunc) (*VgpuPro
 		}
 	}
 
-	l, err := s.ListVgpuProfiles(p)
+	l, err := s.ListVgpuProfilesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2099,41 +2761,84 @@ func (s *GPUService) GetVgpuProfileByID(id string, opts ...OptionFunc) (*VgpuPro
 
 // Lists all available vGPU profiles
 func (s *GPUService) ListVgpuProfiles(p *ListVgpuProfilesParams) (*ListVgpuProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listVgpuProfiles", p.toURLValues())
+	return s.ListVgpuProfilesWithContext(context.Background(), p)
+}
+
+// ListVgpuProfilesWithContext is like ListVgpuProfiles, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListVgpuProfilesWithContext(ctx context.Context, p *ListVgpuProfilesParams) (*ListVgpuProfilesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVgpuProfiles", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVgpuProfilesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVgpuProfiles", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVgpuProfilesAll returns all VgpuProfiles matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *GPUService) ListVgpuProfilesAll(p *ListVgpuProfilesParams, opts ...PageOption) ([]*VgpuProfile, error) {
+	return s.ListVgpuProfilesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVgpuProfilesAllWithContext is like ListVgpuProfilesAll, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListVgpuProfilesAllWithContext(ctx context.Context, p *ListVgpuProfilesParams, opts ...PageOption) ([]*VgpuProfile, error) {
+	return collectPages(s.ListVgpuProfilesIterWithContext(ctx, p, opts...))
+}
+
+// ListVgpuProfilesIter returns an iterator over all VgpuProfiles matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *GPUService) ListVgpuProfilesIter(p *ListVgpuProfilesParams, opts ...PageOption) iter.Seq2[*VgpuProfile, error] {
+	return s.ListVgpuProfilesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVgpuProfilesIterWithContext is like ListVgpuProfilesIter, but honours the cancellation and deadline of ctx
+func (s *GPUService) ListVgpuProfilesIterWithContext(ctx context.Context, p *ListVgpuProfilesParams, opts ...PageOption) iter.Seq2[*VgpuProfile, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VgpuProfile, int, error) {
+		l, err := s.ListVgpuProfilesWithContext(ctx, &ListVgpuProfilesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VgpuProfiles, l.Count, nil
+	}, opts...)
+}
+
 type ListVgpuProfilesResponse struct {
 	Count        int            `json:"count"`
 	VgpuProfiles []*VgpuProfile `json:"vgpuprofile"`
 }
 
 type VgpuProfile struct {
-	Description           string `json:"description"`
-	Deviceid              string `json:"deviceid"`
-	Devicename            string `json:"devicename"`
-	Gpucardid             string `json:"gpucardid"`
-	Gpucardname           string `json:"gpucardname"`
-	Id                    string `json:"id"`
-	JobID                 string `json:"jobid"`
-	Jobstatus             int    `json:"jobstatus"`
-	Maxheads              int64  `json:"maxheads"`
-	Maxresolutionx        int64  `json:"maxresolutionx"`
-	Maxresolutiony        int64  `json:"maxresolutiony"`
-	Maxvgpuperphysicalgpu int64  `json:"maxvgpuperphysicalgpu"`
-	Name                  string `json:"name"`
-	Vendorid              string `json:"vendorid"`
-	Vendorname            string `json:"vendorname"`
-	Videoram              int64  `json:"videoram"`
+	Description           string                     `json:"description"`
+	Deviceid              string                     `json:"deviceid"`
+	Devicename            string                     `json:"devicename"`
+	Gpucardid             string                     `json:"gpucardid"`
+	Gpucardname           string                     `json:"gpucardname"`
+	Id                    string                     `json:"id"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Maxheads              FlexInt64                  `json:"maxheads"`
+	Maxresolutionx        FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperphysicalgpu FlexInt64                  `json:"maxvgpuperphysicalgpu"`
+	Name                  string                     `json:"name"`
+	Vendorid              string                     `json:"vendorid"`
+	Vendorname            string                     `json:"vendorname"`
+	Videoram              FlexInt64                  `json:"videoram"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VgpuProfile) UnmarshalJSON(b []byte) error {
+	type alias VgpuProfile
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ManageGpuDeviceParams struct {
@@ -2152,6 +2857,43 @@ func (p *ManageGpuDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the manageGpuDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ManageGpuDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("manageGpuDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the manageGpuDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ManageGpuDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"ids"},
+	}.validate("manageGpuDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the manageGpuDevice API, before the request is signed
+func (p *ManageGpuDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ManageGpuDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ManageGpuDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("manageGpuDevice", b, &p.p, map[string]paramDecoder{
+		"ids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ManageGpuDeviceParams) Clone() *ManageGpuDeviceParams {
+	return &ManageGpuDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *ManageGpuDeviceParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2184,13 +2926,22 @@ func (s *GPUService) NewManageGpuDeviceParams(ids []string) *ManageGpuDevicePara
 
 // Manages a GPU device
 func (s *GPUService) ManageGpuDevice(p *ManageGpuDeviceParams) (*ManageGpuDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("manageGpuDevice", p.toURLValues())
+	return s.ManageGpuDeviceWithContext(context.Background(), p)
+}
+
+// ManageGpuDeviceWithContext is like ManageGpuDevice, but honours the cancellation and deadline of ctx
+func (s *GPUService) ManageGpuDeviceWithContext(ctx context.Context, p *ManageGpuDeviceParams) (*ManageGpuDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "manageGpuDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ManageGpuDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("manageGpuDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2198,10 +2949,11 @@ func (s *GPUService) ManageGpuDevice(p *ManageGpuDeviceParams) (*ManageGpuDevice
 }
 
 type ManageGpuDeviceResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ManageGpuDeviceResponse) UnmarshalJSON(b []byte) error {
@@ -2228,7 +2980,7 @@ func (r *ManageGpuDeviceResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ManageGpuDeviceResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UnmanageGpuDeviceParams struct {
@@ -2247,6 +2999,43 @@ func (p *UnmanageGpuDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the unmanageGpuDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UnmanageGpuDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("unmanageGpuDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the unmanageGpuDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UnmanageGpuDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"ids"},
+	}.validate("unmanageGpuDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the unmanageGpuDevice API, before the request is signed
+func (p *UnmanageGpuDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UnmanageGpuDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UnmanageGpuDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("unmanageGpuDevice", b, &p.p, map[string]paramDecoder{
+		"ids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UnmanageGpuDeviceParams) Clone() *UnmanageGpuDeviceParams {
+	return &UnmanageGpuDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *UnmanageGpuDeviceParams) SetIds(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2279,13 +3068,22 @@ func (s *GPUService) NewUnmanageGpuDeviceParams(ids []string) *UnmanageGpuDevice
 
 // Unmanage a GPU device
 func (s *GPUService) UnmanageGpuDevice(p *UnmanageGpuDeviceParams) (*UnmanageGpuDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("unmanageGpuDevice", p.toURLValues())
+	return s.UnmanageGpuDeviceWithContext(context.Background(), p)
+}
+
+// UnmanageGpuDeviceWithContext is like UnmanageGpuDevice, but honours the cancellation and deadline of ctx
+func (s *GPUService) UnmanageGpuDeviceWithContext(ctx context.Context, p *UnmanageGpuDeviceParams) (*UnmanageGpuDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "unmanageGpuDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UnmanageGpuDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("unmanageGpuDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2293,10 +3091,11 @@ func (s *GPUService) UnmanageGpuDevice(p *UnmanageGpuDeviceParams) (*UnmanageGpu
 }
 
 type UnmanageGpuDeviceResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UnmanageGpuDeviceResponse) UnmarshalJSON(b []byte) error {
@@ -2323,7 +3122,7 @@ func (r *UnmanageGpuDeviceResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UnmanageGpuDeviceResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateGpuCardParams struct {
@@ -2350,6 +3149,47 @@ func (p *UpdateGpuCardParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateGpuCard API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateGpuCardParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateGpuCard", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateGpuCard API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateGpuCardParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"devicename": 255, "id": 255, "name": 255, "vendorname": 255},
+	}.validate("updateGpuCard", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateGpuCard API, before the request is signed
+func (p *UpdateGpuCardParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateGpuCardParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateGpuCardParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateGpuCard", b, &p.p, map[string]paramDecoder{
+		"devicename": decodeParam[string],
+		"id":         decodeParam[string],
+		"name":       decodeParam[string],
+		"vendorname": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateGpuCardParams) Clone() *UpdateGpuCardParams {
+	return &UpdateGpuCardParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateGpuCardParams) SetDevicename(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2445,13 +3285,22 @@ func (s *GPUService) NewUpdateGpuCardParams(id string) *UpdateGpuCardParams {
 
 // Updates a GPU card definition in the system
 func (s *GPUService) UpdateGpuCard(p *UpdateGpuCardParams) (*UpdateGpuCardResponse, error) {
-	resp, err := s.cs.newPostRequest("updateGpuCard", p.toURLValues())
+	return s.UpdateGpuCardWithContext(context.Background(), p)
+}
+
+// UpdateGpuCardWithContext is like UpdateGpuCard, but honours the cancellation and deadline of ctx
+func (s *GPUService) UpdateGpuCardWithContext(ctx context.Context, p *UpdateGpuCardParams) (*UpdateGpuCardResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateGpuCard", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateGpuCardResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateGpuCard", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2459,14 +3308,20 @@ func (s *GPUService) UpdateGpuCard(p *UpdateGpuCardParams) (*UpdateGpuCardRespon
 }
 
 type UpdateGpuCardResponse struct {
-	Deviceid   string `json:"deviceid"`
-	Devicename string `json:"devicename"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
-	Vendorid   string `json:"vendorid"`
-	Vendorname string `json:"vendorname"`
+	Deviceid   string                     `json:"deviceid"`
+	Devicename string                     `json:"devicename"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Vendorid   string                     `json:"vendorid"`
+	Vendorname string                     `json:"vendorname"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateGpuCardResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateGpuCardResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateGpuDeviceParams struct {
@@ -2499,6 +3354,49 @@ func (p *UpdateGpuDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateGpuDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateGpuDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateGpuDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateGpuDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateGpuDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"gpucardid": 255, "id": 255, "numanode": 255, "parentgpudeviceid": 255, "type": 255, "vgpuprofileid": 255},
+	}.validate("updateGpuDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateGpuDevice API, before the request is signed
+func (p *UpdateGpuDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateGpuDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateGpuDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateGpuDevice", b, &p.p, map[string]paramDecoder{
+		"gpucardid":         decodeParam[string],
+		"id":                decodeParam[string],
+		"numanode":          decodeParam[string],
+		"parentgpudeviceid": decodeParam[string],
+		"type":              decodeParam[string],
+		"vgpuprofileid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateGpuDeviceParams) Clone() *UpdateGpuDeviceParams {
+	return &UpdateGpuDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateGpuDeviceParams) SetGpucardid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2636,13 +3534,22 @@ func (s *GPUService) NewUpdateGpuDeviceParams(id string) *UpdateGpuDeviceParams
 
 // Updates an existing GPU device
 func (s *GPUService) UpdateGpuDevice(p *UpdateGpuDeviceParams) (*UpdateGpuDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("updateGpuDevice", p.toURLValues())
+	return s.UpdateGpuDeviceWithContext(context.Background(), p)
+}
+
+// UpdateGpuDeviceWithContext is like UpdateGpuDevice, but honours the cancellation and deadline of ctx
+func (s *GPUService) UpdateGpuDeviceWithContext(ctx context.Context, p *UpdateGpuDeviceParams) (*UpdateGpuDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateGpuDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateGpuDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateGpuDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2650,24 +3557,30 @@ func (s *GPUService) UpdateGpuDevice(p *UpdateGpuDeviceParams) (*UpdateGpuDevice
 }
 
 type UpdateGpuDeviceResponse struct {
-	Busaddress         string `json:"busaddress"`
-	Gpucardid          string `json:"gpucardid"`
-	Gpucardname        string `json:"gpucardname"`
-	Gpudevicetype      string `json:"gpudevicetype"`
-	Hostid             string `json:"hostid"`
-	Hostname           string `json:"hostname"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Managedstate       string `json:"managedstate"`
-	Numanode           string `json:"numanode"`
-	Parentgpudeviceid  string `json:"parentgpudeviceid"`
-	State              string `json:"state"`
-	Vgpuprofileid      string `json:"vgpuprofileid"`
-	Vgpuprofilename    string `json:"vgpuprofilename"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
-	Vmstate            string `json:"vmstate"`
+	Busaddress         string                     `json:"busaddress"`
+	Gpucardid          string                     `json:"gpucardid"`
+	Gpucardname        string                     `json:"gpucardname"`
+	Gpudevicetype      string                     `json:"gpudevicetype"`
+	Hostid             string                     `json:"hostid"`
+	Hostname           string                     `json:"hostname"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Managedstate       string                     `json:"managedstate"`
+	Numanode           string                     `json:"numanode"`
+	Parentgpudeviceid  string                     `json:"parentgpudeviceid"`
+	State              string                     `json:"state"`
+	Vgpuprofileid      string                     `json:"vgpuprofileid"`
+	Vgpuprofilename    string                     `json:"vgpuprofilename"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Vmstate            string                     `json:"vmstate"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateGpuDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateGpuDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVgpuProfileParams struct {
@@ -2711,6 +3624,51 @@ func (p *UpdateVgpuProfileParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVgpuProfile API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVgpuProfileParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVgpuProfile", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVgpuProfile API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVgpuProfileParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "id": 255, "name": 255},
+	}.validate("updateVgpuProfile", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVgpuProfile API, before the request is signed
+func (p *UpdateVgpuProfileParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVgpuProfileParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVgpuProfileParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVgpuProfile", b, &p.p, map[string]paramDecoder{
+		"description":           decodeParam[string],
+		"id":                    decodeParam[string],
+		"maxheads":              decodeParam[int64],
+		"maxresolutionx":        decodeParam[int64],
+		"maxresolutiony":        decodeParam[int64],
+		"maxvgpuperphysicalgpu": decodeParam[int64],
+		"name":                  decodeParam[string],
+		"videoram":              decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVgpuProfileParams) Clone() *UpdateVgpuProfileParams {
+	return &UpdateVgpuProfileParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVgpuProfileParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2890,13 +3848,22 @@ func (s *GPUService) NewUpdateVgpuProfileParams(id string) *UpdateVgpuProfilePar
 
 // Updates a vGPU profile in the system
 func (s *GPUService) UpdateVgpuProfile(p *UpdateVgpuProfileParams) (*UpdateVgpuProfileResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVgpuProfile", p.toURLValues())
+	return s.UpdateVgpuProfileWithContext(context.Background(), p)
+}
+
+// UpdateVgpuProfileWithContext is like UpdateVgpuProfile, but honours the cancellation and deadline of ctx
+func (s *GPUService) UpdateVgpuProfileWithContext(ctx context.Context, p *UpdateVgpuProfileParams) (*UpdateVgpuProfileResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVgpuProfile", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVgpuProfileResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVgpuProfile", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2904,20 +3871,26 @@ func (s *GPUService) UpdateVgpuProfile(p *UpdateVgpuProfileParams) (*UpdateVgpuP
 }
 
 type UpdateVgpuProfileResponse struct {
-	Description           string `json:"description"`
-	Deviceid              string `json:"deviceid"`
-	Devicename            string `json:"devicename"`
-	Gpucardid             string `json:"gpucardid"`
-	Gpucardname           string `json:"gpucardname"`
-	Id                    string `json:"id"`
-	JobID                 string `json:"jobid"`
-	Jobstatus             int    `json:"jobstatus"`
-	Maxheads              int64  `json:"maxheads"`
-	Maxresolutionx        int64  `json:"maxresolutionx"`
-	Maxresolutiony        int64  `json:"maxresolutiony"`
-	Maxvgpuperphysicalgpu int64  `json:"maxvgpuperphysicalgpu"`
-	Name                  string `json:"name"`
-	Vendorid              string `json:"vendorid"`
-	Vendorname            string `json:"vendorname"`
-	Videoram              int64  `json:"videoram"`
+	Description           string                     `json:"description"`
+	Deviceid              string                     `json:"deviceid"`
+	Devicename            string                     `json:"devicename"`
+	Gpucardid             string                     `json:"gpucardid"`
+	Gpucardname           string                     `json:"gpucardname"`
+	Id                    string                     `json:"id"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Maxheads              FlexInt64                  `json:"maxheads"`
+	Maxresolutionx        FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperphysicalgpu FlexInt64                  `json:"maxvgpuperphysicalgpu"`
+	Name                  string                     `json:"name"`
+	Vendorid              string                     `json:"vendorid"`
+	Vendorname            string                     `json:"vendorname"`
+	Videoram              FlexInt64                  `json:"videoram"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVgpuProfileResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVgpuProfileResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
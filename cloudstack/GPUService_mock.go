@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) CreateGpuCard(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGpuCard", reflect.TypeOf((*MockGPUServiceIface)(nil).CreateGpuCard), p)
 }
 
+// CreateGpuCardWithContext mocks base method.
+func (m *MockGPUServiceIface) CreateGpuCardWithContext(ctx context.Context, p *CreateGpuCardParams) (*CreateGpuCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGpuCardWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateGpuCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGpuCardWithContext indicates an expected call of CreateGpuCardWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) CreateGpuCardWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGpuCardWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).CreateGpuCardWithContext), ctx, p)
+}
+
 // CreateGpuDevice mocks base method.
 func (m *MockGPUServiceIface) CreateGpuDevice(p *CreateGpuDeviceParams) (*CreateGpuDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) CreateGpuDevice(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGpuDevice", reflect.TypeOf((*MockGPUServiceIface)(nil).CreateGpuDevice), p)
 }
 
+// CreateGpuDeviceWithContext mocks base method.
+func (m *MockGPUServiceIface) CreateGpuDeviceWithContext(ctx context.Context, p *CreateGpuDeviceParams) (*CreateGpuDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGpuDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateGpuDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGpuDeviceWithContext indicates an expected call of CreateGpuDeviceWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) CreateGpuDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGpuDeviceWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).CreateGpuDeviceWithContext), ctx, p)
+}
+
 // CreateVgpuProfile mocks base method.
 func (m *MockGPUServiceIface) CreateVgpuProfile(p *CreateVgpuProfileParams) (*CreateVgpuProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) CreateVgpuProfile(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVgpuProfile", reflect.TypeOf((*MockGPUServiceIface)(nil).CreateVgpuProfile), p)
 }
 
+// CreateVgpuProfileWithContext mocks base method.
+func (m *MockGPUServiceIface) CreateVgpuProfileWithContext(ctx context.Context, p *CreateVgpuProfileParams) (*CreateVgpuProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVgpuProfileWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVgpuProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVgpuProfileWithContext indicates an expected call of CreateVgpuProfileWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) CreateVgpuProfileWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVgpuProfileWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).CreateVgpuProfileWithContext), ctx, p)
+}
+
 // DeleteGpuCard mocks base method.
 func (m *MockGPUServiceIface) DeleteGpuCard(p *DeleteGpuCardParams) (*DeleteGpuCardResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +165,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) DeleteGpuCard(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGpuCard", reflect.TypeOf((*MockGPUServiceIface)(nil).DeleteGpuCard), p)
 }
 
+// DeleteGpuCardWithContext mocks base method.
+func (m *MockGPUServiceIface) DeleteGpuCardWithContext(ctx context.Context, p *DeleteGpuCardParams) (*DeleteGpuCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGpuCardWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteGpuCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGpuCardWithContext indicates an expected call of DeleteGpuCardWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) DeleteGpuCardWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGpuCardWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).DeleteGpuCardWithContext), ctx, p)
+}
+
 // DeleteGpuDevice mocks base method.
 func (m *MockGPUServiceIface) DeleteGpuDevice(p *DeleteGpuDeviceParams) (*DeleteGpuDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +195,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) DeleteGpuDevice(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGpuDevice", reflect.TypeOf((*MockGPUServiceIface)(nil).DeleteGpuDevice), p)
 }
 
+// DeleteGpuDeviceWithContext mocks base method.
+func (m *MockGPUServiceIface) DeleteGpuDeviceWithContext(ctx context.Context, p *DeleteGpuDeviceParams) (*DeleteGpuDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGpuDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteGpuDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGpuDeviceWithContext indicates an expected call of DeleteGpuDeviceWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) DeleteGpuDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGpuDeviceWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).DeleteGpuDeviceWithContext), ctx, p)
+}
+
 // DeleteVgpuProfile mocks base method.
 func (m *MockGPUServiceIface) DeleteVgpuProfile(p *DeleteVgpuProfileParams) (*DeleteVgpuProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +225,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) DeleteVgpuProfile(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVgpuProfile", reflect.TypeOf((*MockGPUServiceIface)(nil).DeleteVgpuProfile), p)
 }
 
+// DeleteVgpuProfileWithContext mocks base method.
+func (m *MockGPUServiceIface) DeleteVgpuProfileWithContext(ctx context.Context, p *DeleteVgpuProfileParams) (*DeleteVgpuProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVgpuProfileWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVgpuProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVgpuProfileWithContext indicates an expected call of DeleteVgpuProfileWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) DeleteVgpuProfileWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVgpuProfileWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).DeleteVgpuProfileWithContext), ctx, p)
+}
+
 // DiscoverGpuDevices mocks base method.
 func (m *MockGPUServiceIface) DiscoverGpuDevices(p *DiscoverGpuDevicesParams) (*DiscoverGpuDevicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +255,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) DiscoverGpuDevices(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverGpuDevices", reflect.TypeOf((*MockGPUServiceIface)(nil).DiscoverGpuDevices), p)
 }
 
+// DiscoverGpuDevicesWithContext mocks base method.
+func (m *MockGPUServiceIface) DiscoverGpuDevicesWithContext(ctx context.Context, p *DiscoverGpuDevicesParams) (*DiscoverGpuDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DiscoverGpuDevicesWithContext", ctx, p)
+	ret0, _ := ret[0].(*DiscoverGpuDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DiscoverGpuDevicesWithContext indicates an expected call of DiscoverGpuDevicesWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) DiscoverGpuDevicesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DiscoverGpuDevicesWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).DiscoverGpuDevicesWithContext), ctx, p)
+}
+
 // GetGpuCardByID mocks base method.
 func (m *MockGPUServiceIface) GetGpuCardByID(id string, opts ...OptionFunc) (*GpuCard, int, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +291,27 @@ func (mr *MockGPUServiceIfaceMockRecorder) GetGpuCardByID(id any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuCardByID", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuCardByID), varargs...)
 }
 
+// GetGpuCardByIDWithContext mocks base method.
+func (m *MockGPUServiceIface) GetGpuCardByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GpuCard, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGpuCardByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*GpuCard)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGpuCardByIDWithContext indicates an expected call of GetGpuCardByIDWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) GetGpuCardByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuCardByIDWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuCardByIDWithContext), varargs...)
+}
+
 // GetGpuCardByName mocks base method.
 func (m *MockGPUServiceIface) GetGpuCardByName(name string, opts ...OptionFunc) (*GpuCard, int, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +333,27 @@ func (mr *MockGPUServiceIfaceMockRecorder) GetGpuCardByName(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuCardByName", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuCardByName), varargs...)
 }
 
+// GetGpuCardByNameWithContext mocks base method.
+func (m *MockGPUServiceIface) GetGpuCardByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GpuCard, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGpuCardByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*GpuCard)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGpuCardByNameWithContext indicates an expected call of GetGpuCardByNameWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) GetGpuCardByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuCardByNameWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuCardByNameWithContext), varargs...)
+}
+
 // GetGpuCardID mocks base method.
 func (m *MockGPUServiceIface) GetGpuCardID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +375,27 @@ func (mr *MockGPUServiceIfaceMockRecorder) GetGpuCardID(keyword any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuCardID", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuCardID), varargs...)
 }
 
+// GetGpuCardIDWithContext mocks base method.
+func (m *MockGPUServiceIface) GetGpuCardIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGpuCardIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGpuCardIDWithContext indicates an expected call of GetGpuCardIDWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) GetGpuCardIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuCardIDWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuCardIDWithContext), varargs...)
+}
+
 // GetGpuDeviceByID mocks base method.
 func (m *MockGPUServiceIface) GetGpuDeviceByID(id string, opts ...OptionFunc) (*GpuDevice, int, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +417,27 @@ func (mr *MockGPUServiceIfaceMockRecorder) GetGpuDeviceByID(id any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuDeviceByID", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuDeviceByID), varargs...)
 }
 
+// GetGpuDeviceByIDWithContext mocks base method.
+func (m *MockGPUServiceIface) GetGpuDeviceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GpuDevice, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGpuDeviceByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*GpuDevice)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGpuDeviceByIDWithContext indicates an expected call of GetGpuDeviceByIDWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) GetGpuDeviceByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGpuDeviceByIDWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).GetGpuDeviceByIDWithContext), varargs...)
+}
+
 // GetVgpuProfileByID mocks base method.
 func (m *MockGPUServiceIface) GetVgpuProfileByID(id string, opts ...OptionFunc) (*VgpuProfile, int, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +459,27 @@ func (mr *MockGPUServiceIfaceMockRecorder) GetVgpuProfileByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVgpuProfileByID", reflect.TypeOf((*MockGPUServiceIface)(nil).GetVgpuProfileByID), varargs...)
 }
 
+// GetVgpuProfileByIDWithContext mocks base method.
+func (m *MockGPUServiceIface) GetVgpuProfileByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VgpuProfile, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVgpuProfileByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VgpuProfile)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVgpuProfileByIDWithContext indicates an expected call of GetVgpuProfileByIDWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) GetVgpuProfileByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVgpuProfileByIDWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).GetVgpuProfileByIDWithContext), varargs...)
+}
+
 // GetVgpuProfileByName mocks base method.
 func (m *MockGPUServiceIface) GetVgpuProfileByName(name string, opts ...OptionFunc) (*VgpuProfile, int, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +501,27 @@ func (mr *MockGPUServiceIfaceMockRecorder) GetVgpuProfileByName(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVgpuProfileByName", reflect.TypeOf((*MockGPUServiceIface)(nil).GetVgpuProfileByName), varargs...)
 }
 
+// GetVgpuProfileByNameWithContext mocks base method.
+func (m *MockGPUServiceIface) GetVgpuProfileByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VgpuProfile, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVgpuProfileByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VgpuProfile)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVgpuProfileByNameWithContext indicates an expected call of GetVgpuProfileByNameWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) GetVgpuProfileByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVgpuProfileByNameWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).GetVgpuProfileByNameWithContext), varargs...)
+}
+
 // GetVgpuProfileID mocks base method.
 func (m *MockGPUServiceIface) GetVgpuProfileID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +543,27 @@ func (mr *MockGPUServiceIfaceMockRecorder) GetVgpuProfileID(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVgpuProfileID", reflect.TypeOf((*MockGPUServiceIface)(nil).GetVgpuProfileID), varargs...)
 }
 
+// GetVgpuProfileIDWithContext mocks base method.
+func (m *MockGPUServiceIface) GetVgpuProfileIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVgpuProfileIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVgpuProfileIDWithContext indicates an expected call of GetVgpuProfileIDWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) GetVgpuProfileIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVgpuProfileIDWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).GetVgpuProfileIDWithContext), varargs...)
+}
+
 // ListGpuCards mocks base method.
 func (m *MockGPUServiceIface) ListGpuCards(p *ListGpuCardsParams) (*ListGpuCardsResponse, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +579,99 @@ func (mr *MockGPUServiceIfaceMockRecorder) ListGpuCards(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuCards", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuCards), p)
 }
 
+// ListGpuCardsAll mocks base method.
+func (m *MockGPUServiceIface) ListGpuCardsAll(p *ListGpuCardsParams, opts ...PageOption) ([]*GpuCard, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuCardsAll", varargs...)
+	ret0, _ := ret[0].([]*GpuCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGpuCardsAll indicates an expected call of ListGpuCardsAll.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuCardsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuCardsAll", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuCardsAll), varargs...)
+}
+
+// ListGpuCardsAllWithContext mocks base method.
+func (m *MockGPUServiceIface) ListGpuCardsAllWithContext(ctx context.Context, p *ListGpuCardsParams, opts ...PageOption) ([]*GpuCard, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuCardsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*GpuCard)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGpuCardsAllWithContext indicates an expected call of ListGpuCardsAllWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuCardsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuCardsAllWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuCardsAllWithContext), varargs...)
+}
+
+// ListGpuCardsIter mocks base method.
+func (m *MockGPUServiceIface) ListGpuCardsIter(p *ListGpuCardsParams, opts ...PageOption) iter.Seq2[*GpuCard, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuCardsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GpuCard, error])
+	return ret0
+}
+
+// ListGpuCardsIter indicates an expected call of ListGpuCardsIter.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuCardsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuCardsIter", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuCardsIter), varargs...)
+}
+
+// ListGpuCardsIterWithContext mocks base method.
+func (m *MockGPUServiceIface) ListGpuCardsIterWithContext(ctx context.Context, p *ListGpuCardsParams, opts ...PageOption) iter.Seq2[*GpuCard, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuCardsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GpuCard, error])
+	return ret0
+}
+
+// ListGpuCardsIterWithContext indicates an expected call of ListGpuCardsIterWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuCardsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuCardsIterWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuCardsIterWithContext), varargs...)
+}
+
+// ListGpuCardsWithContext mocks base method.
+func (m *MockGPUServiceIface) ListGpuCardsWithContext(ctx context.Context, p *ListGpuCardsParams) (*ListGpuCardsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGpuCardsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListGpuCardsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGpuCardsWithContext indicates an expected call of ListGpuCardsWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuCardsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuCardsWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuCardsWithContext), ctx, p)
+}
+
 // ListGpuDevices mocks base method.
 func (m *MockGPUServiceIface) ListGpuDevices(p *ListGpuDevicesParams) (*ListGpuDevicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +687,99 @@ func (mr *MockGPUServiceIfaceMockRecorder) ListGpuDevices(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuDevices", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuDevices), p)
 }
 
+// ListGpuDevicesAll mocks base method.
+func (m *MockGPUServiceIface) ListGpuDevicesAll(p *ListGpuDevicesParams, opts ...PageOption) ([]*GpuDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuDevicesAll", varargs...)
+	ret0, _ := ret[0].([]*GpuDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGpuDevicesAll indicates an expected call of ListGpuDevicesAll.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuDevicesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuDevicesAll", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuDevicesAll), varargs...)
+}
+
+// ListGpuDevicesAllWithContext mocks base method.
+func (m *MockGPUServiceIface) ListGpuDevicesAllWithContext(ctx context.Context, p *ListGpuDevicesParams, opts ...PageOption) ([]*GpuDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuDevicesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*GpuDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGpuDevicesAllWithContext indicates an expected call of ListGpuDevicesAllWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuDevicesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuDevicesAllWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuDevicesAllWithContext), varargs...)
+}
+
+// ListGpuDevicesIter mocks base method.
+func (m *MockGPUServiceIface) ListGpuDevicesIter(p *ListGpuDevicesParams, opts ...PageOption) iter.Seq2[*GpuDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuDevicesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GpuDevice, error])
+	return ret0
+}
+
+// ListGpuDevicesIter indicates an expected call of ListGpuDevicesIter.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuDevicesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuDevicesIter", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuDevicesIter), varargs...)
+}
+
+// ListGpuDevicesIterWithContext mocks base method.
+func (m *MockGPUServiceIface) ListGpuDevicesIterWithContext(ctx context.Context, p *ListGpuDevicesParams, opts ...PageOption) iter.Seq2[*GpuDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGpuDevicesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GpuDevice, error])
+	return ret0
+}
+
+// ListGpuDevicesIterWithContext indicates an expected call of ListGpuDevicesIterWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuDevicesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuDevicesIterWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuDevicesIterWithContext), varargs...)
+}
+
+// ListGpuDevicesWithContext mocks base method.
+func (m *MockGPUServiceIface) ListGpuDevicesWithContext(ctx context.Context, p *ListGpuDevicesParams) (*ListGpuDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGpuDevicesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListGpuDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGpuDevicesWithContext indicates an expected call of ListGpuDevicesWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListGpuDevicesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGpuDevicesWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListGpuDevicesWithContext), ctx, p)
+}
+
 // ListVgpuProfiles mocks base method.
 func (m *MockGPUServiceIface) ListVgpuProfiles(p *ListVgpuProfilesParams) (*ListVgpuProfilesResponse, error) {
 	m.ctrl.T.Helper()
@@ -355,6 +795,99 @@ func (mr *MockGPUServiceIfaceMockRecorder) ListVgpuProfiles(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVgpuProfiles", reflect.TypeOf((*MockGPUServiceIface)(nil).ListVgpuProfiles), p)
 }
 
+// ListVgpuProfilesAll mocks base method.
+func (m *MockGPUServiceIface) ListVgpuProfilesAll(p *ListVgpuProfilesParams, opts ...PageOption) ([]*VgpuProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVgpuProfilesAll", varargs...)
+	ret0, _ := ret[0].([]*VgpuProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVgpuProfilesAll indicates an expected call of ListVgpuProfilesAll.
+func (mr *MockGPUServiceIfaceMockRecorder) ListVgpuProfilesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVgpuProfilesAll", reflect.TypeOf((*MockGPUServiceIface)(nil).ListVgpuProfilesAll), varargs...)
+}
+
+// ListVgpuProfilesAllWithContext mocks base method.
+func (m *MockGPUServiceIface) ListVgpuProfilesAllWithContext(ctx context.Context, p *ListVgpuProfilesParams, opts ...PageOption) ([]*VgpuProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVgpuProfilesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VgpuProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVgpuProfilesAllWithContext indicates an expected call of ListVgpuProfilesAllWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListVgpuProfilesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVgpuProfilesAllWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListVgpuProfilesAllWithContext), varargs...)
+}
+
+// ListVgpuProfilesIter mocks base method.
+func (m *MockGPUServiceIface) ListVgpuProfilesIter(p *ListVgpuProfilesParams, opts ...PageOption) iter.Seq2[*VgpuProfile, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVgpuProfilesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VgpuProfile, error])
+	return ret0
+}
+
+// ListVgpuProfilesIter indicates an expected call of ListVgpuProfilesIter.
+func (mr *MockGPUServiceIfaceMockRecorder) ListVgpuProfilesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVgpuProfilesIter", reflect.TypeOf((*MockGPUServiceIface)(nil).ListVgpuProfilesIter), varargs...)
+}
+
+// ListVgpuProfilesIterWithContext mocks base method.
+func (m *MockGPUServiceIface) ListVgpuProfilesIterWithContext(ctx context.Context, p *ListVgpuProfilesParams, opts ...PageOption) iter.Seq2[*VgpuProfile, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVgpuProfilesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VgpuProfile, error])
+	return ret0
+}
+
+// ListVgpuProfilesIterWithContext indicates an expected call of ListVgpuProfilesIterWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListVgpuProfilesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVgpuProfilesIterWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListVgpuProfilesIterWithContext), varargs...)
+}
+
+// ListVgpuProfilesWithContext mocks base method.
+func (m *MockGPUServiceIface) ListVgpuProfilesWithContext(ctx context.Context, p *ListVgpuProfilesParams) (*ListVgpuProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVgpuProfilesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVgpuProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVgpuProfilesWithContext indicates an expected call of ListVgpuProfilesWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ListVgpuProfilesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVgpuProfilesWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ListVgpuProfilesWithContext), ctx, p)
+}
+
 // ManageGpuDevice mocks base method.
 func (m *MockGPUServiceIface) ManageGpuDevice(p *ManageGpuDeviceParams) (*ManageGpuDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -370,6 +903,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) ManageGpuDevice(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManageGpuDevice", reflect.TypeOf((*MockGPUServiceIface)(nil).ManageGpuDevice), p)
 }
 
+// ManageGpuDeviceWithContext mocks base method.
+func (m *MockGPUServiceIface) ManageGpuDeviceWithContext(ctx context.Context, p *ManageGpuDeviceParams) (*ManageGpuDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ManageGpuDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*ManageGpuDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ManageGpuDeviceWithContext indicates an expected call of ManageGpuDeviceWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) ManageGpuDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ManageGpuDeviceWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).ManageGpuDeviceWithContext), ctx, p)
+}
+
 // NewCreateGpuCardParams mocks base method.
 func (m *MockGPUServiceIface) NewCreateGpuCardParams(deviceid, devicename, name, vendorid, vendorname string) *CreateGpuCardParams {
 	m.ctrl.T.Helper()
@@ -595,6 +1143,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) UnmanageGpuDevice(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageGpuDevice", reflect.TypeOf((*MockGPUServiceIface)(nil).UnmanageGpuDevice), p)
 }
 
+// UnmanageGpuDeviceWithContext mocks base method.
+func (m *MockGPUServiceIface) UnmanageGpuDeviceWithContext(ctx context.Context, p *UnmanageGpuDeviceParams) (*UnmanageGpuDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmanageGpuDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*UnmanageGpuDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnmanageGpuDeviceWithContext indicates an expected call of UnmanageGpuDeviceWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) UnmanageGpuDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageGpuDeviceWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).UnmanageGpuDeviceWithContext), ctx, p)
+}
+
 // UpdateGpuCard mocks base method.
 func (m *MockGPUServiceIface) UpdateGpuCard(p *UpdateGpuCardParams) (*UpdateGpuCardResponse, error) {
 	m.ctrl.T.Helper()
@@ -610,6 +1173,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) UpdateGpuCard(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGpuCard", reflect.TypeOf((*MockGPUServiceIface)(nil).UpdateGpuCard), p)
 }
 
+// UpdateGpuCardWithContext mocks base method.
+func (m *MockGPUServiceIface) UpdateGpuCardWithContext(ctx context.Context, p *UpdateGpuCardParams) (*UpdateGpuCardResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGpuCardWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateGpuCardResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGpuCardWithContext indicates an expected call of UpdateGpuCardWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) UpdateGpuCardWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGpuCardWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).UpdateGpuCardWithContext), ctx, p)
+}
+
 // UpdateGpuDevice mocks base method.
 func (m *MockGPUServiceIface) UpdateGpuDevice(p *UpdateGpuDeviceParams) (*UpdateGpuDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -625,6 +1203,21 @@ func (mr *MockGPUServiceIfaceMockRecorder) UpdateGpuDevice(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGpuDevice", reflect.TypeOf((*MockGPUServiceIface)(nil).UpdateGpuDevice), p)
 }
 
+// UpdateGpuDeviceWithContext mocks base method.
+func (m *MockGPUServiceIface) UpdateGpuDeviceWithContext(ctx context.Context, p *UpdateGpuDeviceParams) (*UpdateGpuDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGpuDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateGpuDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGpuDeviceWithContext indicates an expected call of UpdateGpuDeviceWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) UpdateGpuDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGpuDeviceWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).UpdateGpuDeviceWithContext), ctx, p)
+}
+
 // UpdateVgpuProfile mocks base method.
 func (m *MockGPUServiceIface) UpdateVgpuProfile(p *UpdateVgpuProfileParams) (*UpdateVgpuProfileResponse, error) {
 	m.ctrl.T.Helper()
@@ -639,3 +1232,18 @@ func (mr *MockGPUServiceIfaceMockRecorder) UpdateVgpuProfile(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVgpuProfile", reflect.TypeOf((*MockGPUServiceIface)(nil).UpdateVgpuProfile), p)
 }
+
+// UpdateVgpuProfileWithContext mocks base method.
+func (m *MockGPUServiceIface) UpdateVgpuProfileWithContext(ctx context.Context, p *UpdateVgpuProfileParams) (*UpdateVgpuProfileResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVgpuProfileWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVgpuProfileResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVgpuProfileWithContext indicates an expected call of UpdateVgpuProfileWithContext.
+func (mr *MockGPUServiceIfaceMockRecorder) UpdateVgpuProfileWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVgpuProfileWithContext", reflect.TypeOf((*MockGPUServiceIface)(nil).UpdateVgpuProfileWithContext), ctx, p)
+}
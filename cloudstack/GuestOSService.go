@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,37 +31,76 @@ import (
 
 type GuestOSServiceIface interface {
 	AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error)
+	AddGuestOsAsync(ctx context.Context, p *AddGuestOsParams) (*Job, error)
 	NewAddGuestOsParams(oscategoryid string, osdisplayname string) *AddGuestOsParams
 	AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
-	NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams
+	AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error)
+	AddGuestOsMappingAsync(ctx context.Context, p *AddGuestOsMappingParams) (*Job, error)
+	NewAddGuestOsMappingParams(hypervisor HypervisorType, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams
 	ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error)
+	ListGuestOsMappingAll(p *ListGuestOsMappingParams, opts ...PageOption) ([]*GuestOsMapping, error)
+	ListGuestOsMappingAllWithContext(ctx context.Context, p *ListGuestOsMappingParams, opts ...PageOption) ([]*GuestOsMapping, error)
+	ListGuestOsMappingIter(p *ListGuestOsMappingParams, opts ...PageOption) iter.Seq2[*GuestOsMapping, error]
+	ListGuestOsMappingIterWithContext(ctx context.Context, p *ListGuestOsMappingParams, opts ...PageOption) iter.Seq2[*GuestOsMapping, error]
 	NewListGuestOsMappingParams() *ListGuestOsMappingParams
 	GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
+	GetGuestOsMappingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestOsMapping, int, error)
 	ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error)
+	ListOsCategoriesAll(p *ListOsCategoriesParams, opts ...PageOption) ([]*OsCategory, error)
+	ListOsCategoriesAllWithContext(ctx context.Context, p *ListOsCategoriesParams, opts ...PageOption) ([]*OsCategory, error)
+	ListOsCategoriesIter(p *ListOsCategoriesParams, opts ...PageOption) iter.Seq2[*OsCategory, error]
+	ListOsCategoriesIterWithContext(ctx context.Context, p *ListOsCategoriesParams, opts ...PageOption) iter.Seq2[*OsCategory, error]
 	NewListOsCategoriesParams() *ListOsCategoriesParams
 	GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetOsCategoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsCategory, int, error)
 	GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error)
+	GetOsCategoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsCategory, int, error)
 	ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error)
+	ListOsTypesAll(p *ListOsTypesParams, opts ...PageOption) ([]*OsType, error)
+	ListOsTypesAllWithContext(ctx context.Context, p *ListOsTypesParams, opts ...PageOption) ([]*OsType, error)
+	ListOsTypesIter(p *ListOsTypesParams, opts ...PageOption) iter.Seq2[*OsType, error]
+	ListOsTypesIterWithContext(ctx context.Context, p *ListOsTypesParams, opts ...PageOption) iter.Seq2[*OsType, error]
 	NewListOsTypesParams() *ListOsTypesParams
 	GetOsTypeID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetOsTypeIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetOsTypeByName(name string, opts ...OptionFunc) (*OsType, int, error)
+	GetOsTypeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsType, int, error)
 	GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error)
+	GetOsTypeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsType, int, error)
 	RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error)
+	RemoveGuestOsAsync(ctx context.Context, p *RemoveGuestOsParams) (*Job, error)
 	NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
 	RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error)
+	RemoveGuestOsMappingAsync(ctx context.Context, p *RemoveGuestOsMappingParams) (*Job, error)
 	NewRemoveGuestOsMappingParams(id string) *RemoveGuestOsMappingParams
 	UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error)
+	UpdateGuestOsAsync(ctx context.Context, p *UpdateGuestOsParams) (*Job, error)
 	NewUpdateGuestOsParams(id string, osdisplayname string) *UpdateGuestOsParams
 	UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error)
+	UpdateGuestOsMappingAsync(ctx context.Context, p *UpdateGuestOsMappingParams) (*Job, error)
 	NewUpdateGuestOsMappingParams(id string, osnameforhypervisor string) *UpdateGuestOsMappingParams
 	GetHypervisorGuestOsNames(p *GetHypervisorGuestOsNamesParams) (*GetHypervisorGuestOsNamesResponse, error)
-	NewGetHypervisorGuestOsNamesParams(hypervisor string, hypervisorversion string) *GetHypervisorGuestOsNamesParams
+	GetHypervisorGuestOsNamesWithContext(ctx context.Context, p *GetHypervisorGuestOsNamesParams) (*GetHypervisorGuestOsNamesResponse, error)
+	GetHypervisorGuestOsNamesAsync(ctx context.Context, p *GetHypervisorGuestOsNamesParams) (*Job, error)
+	NewGetHypervisorGuestOsNamesParams(hypervisor HypervisorType, hypervisorversion string) *GetHypervisorGuestOsNamesParams
 	AddOsCategory(p *AddOsCategoryParams) (*AddOsCategoryResponse, error)
+	AddOsCategoryWithContext(ctx context.Context, p *AddOsCategoryParams) (*AddOsCategoryResponse, error)
 	NewAddOsCategoryParams(name string) *AddOsCategoryParams
 	DeleteOsCategory(p *DeleteOsCategoryParams) (*DeleteOsCategoryResponse, error)
+	DeleteOsCategoryWithContext(ctx context.Context, p *DeleteOsCategoryParams) (*DeleteOsCategoryResponse, error)
 	NewDeleteOsCategoryParams(id string) *DeleteOsCategoryParams
 	UpdateOsCategory(p *UpdateOsCategoryParams) (*UpdateOsCategoryResponse, error)
+	UpdateOsCategoryWithContext(ctx context.Context, p *UpdateOsCategoryParams) (*UpdateOsCategoryResponse, error)
 	NewUpdateOsCategoryParams(id string) *UpdateOsCategoryParams
 }
 
@@ -95,6 +136,48 @@ func (p *AddGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addGuestOs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddGuestOsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addGuestOs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addGuestOs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddGuestOsParams) Validate() error {
+	return paramRules{
+		required: []string{"oscategoryid", "osdisplayname"},
+		lengths:  map[string]int{"name": 255, "oscategoryid": 255, "osdisplayname": 255},
+	}.validate("addGuestOs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addGuestOs API, before the request is signed
+func (p *AddGuestOsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddGuestOsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddGuestOsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addGuestOs", b, &p.p, map[string]paramDecoder{
+		"details":       decodeParam[map[string]string],
+		"forDisplay":    decodeParam[bool],
+		"name":          decodeParam[string],
+		"oscategoryid":  decodeParam[string],
+		"osdisplayname": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddGuestOsParams) Clone() *AddGuestOsParams {
+	return &AddGuestOsParams{p: cloneParams(p.p)}
+}
+
 func (p *AddGuestOsParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -212,32 +295,39 @@ func (s *GuestOSService) NewAddGuestOsParams(oscategoryid string, osdisplayname
 
 // Add a new guest OS type
 func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, error) {
-	resp, err := s.cs.newPostRequest("addGuestOs", p.toURLValues())
+	return s.AddGuestOsWithContext(context.Background(), p)
+}
+
+// AddGuestOsWithContext is like AddGuestOs, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addGuestOs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddGuestOsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addGuestOs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addGuestOs", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addGuestOs", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -245,16 +335,41 @@ func (s *GuestOSService) AddGuestOs(p *AddGuestOsParams) (*AddGuestOsResponse, e
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddGuestOs async job into r
+func (r *AddGuestOsResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddGuestOsAsync starts the async job of AddGuestOs without waiting for it to finish, and returns a handle to the job
+func (s *GuestOSService) AddGuestOsAsync(ctx context.Context, p *AddGuestOsParams) (*Job, error) {
+	r, err := s.AddGuestOsWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addGuestOs", r.JobID), nil
+}
+
 type AddGuestOsResponse struct {
-	Description    string `json:"description"`
-	Fordisplay     bool   `json:"fordisplay"`
-	Id             string `json:"id"`
-	Isuserdefined  bool   `json:"isuserdefined"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Oscategoryid   string `json:"oscategoryid"`
-	Oscategoryname string `json:"oscategoryname"`
+	Description    string                     `json:"description"`
+	Fordisplay     FlexBool                   `json:"fordisplay"`
+	Id             string                     `json:"id"`
+	Isuserdefined  FlexBool                   `json:"isuserdefined"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Oscategoryid   string                     `json:"oscategoryid"`
+	Oscategoryname string                     `json:"oscategoryname"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddGuestOsResponse) UnmarshalJSON(b []byte) error {
+	type alias AddGuestOsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddGuestOsMappingParams struct {
@@ -271,7 +386,7 @@ func (p *AddGuestOsMappingParams) toURLValues() url.Values {
 		u.Set("forced", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["hypervisorversion"]; found {
 		u.Set("hypervisorversion", v.(string))
@@ -292,6 +407,50 @@ func (p *AddGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addGuestOsMapping API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddGuestOsMappingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addGuestOsMapping", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addGuestOsMapping API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddGuestOsMappingParams) Validate() error {
+	return paramRules{
+		required: []string{"hypervisor", "hypervisorversion", "osnameforhypervisor"},
+		lengths:  map[string]int{"hypervisor": 255, "hypervisorversion": 255, "osdisplayname": 255, "osnameforhypervisor": 255, "ostypeid": 255},
+	}.validate("addGuestOsMapping", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addGuestOsMapping API, before the request is signed
+func (p *AddGuestOsMappingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addGuestOsMapping", b, &p.p, map[string]paramDecoder{
+		"forced":                decodeParam[bool],
+		"hypervisor":            decodeParam[HypervisorType],
+		"hypervisorversion":     decodeParam[string],
+		"osdisplayname":         decodeParam[string],
+		"osmappingcheckenabled": decodeParam[bool],
+		"osnameforhypervisor":   decodeParam[string],
+		"ostypeid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddGuestOsMappingParams) Clone() *AddGuestOsMappingParams {
+	return &AddGuestOsMappingParams{p: cloneParams(p.p)}
+}
+
 func (p *AddGuestOsMappingParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -313,7 +472,7 @@ func (p *AddGuestOsMappingParams) GetForced() (bool, bool) {
 	return value, ok
 }
 
-func (p *AddGuestOsMappingParams) SetHypervisor(v string) {
+func (p *AddGuestOsMappingParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -326,11 +485,11 @@ func (p *AddGuestOsMappingParams) ResetHypervisor() {
 	}
 }
 
-func (p *AddGuestOsMappingParams) GetHypervisor() (string, bool) {
+func (p *AddGuestOsMappingParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -441,7 +600,7 @@ func (p *AddGuestOsMappingParams) GetOstypeid() (string, bool) {
 
 // You should always use this function to get a new AddGuestOsMappingParams instance,
 // as then you are sure you have configured all required params
-func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
+func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor HypervisorType, hypervisorversion string, osnameforhypervisor string) *AddGuestOsMappingParams {
 	p := &AddGuestOsMappingParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
@@ -452,32 +611,39 @@ func (s *GuestOSService) NewAddGuestOsMappingParams(hypervisor string, hyperviso
 
 // Adds a guest OS name to hypervisor OS name mapping
 func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
-	resp, err := s.cs.newPostRequest("addGuestOsMapping", p.toURLValues())
+	return s.AddGuestOsMappingWithContext(context.Background(), p)
+}
+
+// AddGuestOsMappingWithContext is like AddGuestOsMapping, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addGuestOsMapping", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addGuestOsMapping", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addGuestOsMapping", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addGuestOsMapping", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -485,16 +651,36 @@ func (s *GuestOSService) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGues
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddGuestOsMapping async job into r
+func (r *AddGuestOsMappingResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddGuestOsMappingAsync starts the async job of AddGuestOsMapping without waiting for it to finish, and returns a handle to the job
+func (s *GuestOSService) AddGuestOsMappingAsync(ctx context.Context, p *AddGuestOsMappingParams) (*Job, error) {
+	r, err := s.AddGuestOsMappingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addGuestOsMapping", r.JobID), nil
+}
+
 type AddGuestOsMappingResponse struct {
-	Hypervisor          string `json:"hypervisor"`
-	Hypervisorversion   string `json:"hypervisorversion"`
-	Id                  string `json:"id"`
-	Isuserdefined       string `json:"isuserdefined"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Osdisplayname       string `json:"osdisplayname"`
-	Osnameforhypervisor string `json:"osnameforhypervisor"`
-	Ostypeid            string `json:"ostypeid"`
+	Hypervisor          string                     `json:"hypervisor"`
+	Hypervisorversion   string                     `json:"hypervisorversion"`
+	Id                  string                     `json:"id"`
+	Isuserdefined       string                     `json:"isuserdefined"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Osdisplayname       string                     `json:"osdisplayname"`
+	Osnameforhypervisor string                     `json:"osnameforhypervisor"`
+	Ostypeid            string                     `json:"ostypeid"`
+	Extra               map[string]json.RawMessage `json:"-"`
 }
 
 func (r *AddGuestOsMappingResponse) UnmarshalJSON(b []byte) error {
@@ -521,7 +707,7 @@ func (r *AddGuestOsMappingResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias AddGuestOsMappingResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListGuestOsMappingParams struct {
@@ -534,7 +720,7 @@ func (p *ListGuestOsMappingParams) toURLValues() url.Values {
 		return u
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["hypervisorversion"]; found {
 		u.Set("hypervisorversion", v.(string))
@@ -565,7 +751,52 @@ func (p *ListGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListGuestOsMappingParams) SetHypervisor(v string) {
+// ValidateAgainst checks the parameters against the listGuestOsMapping API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListGuestOsMappingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listGuestOsMapping", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listGuestOsMapping API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListGuestOsMappingParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"hypervisor": 255, "hypervisorversion": 255, "id": 255, "keyword": 255, "osdisplayname": 255, "osnameforhypervisor": 255, "ostypeid": 255},
+	}.validate("listGuestOsMapping", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listGuestOsMapping API, before the request is signed
+func (p *ListGuestOsMappingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listGuestOsMapping", b, &p.p, map[string]paramDecoder{
+		"hypervisor":          decodeParam[HypervisorType],
+		"hypervisorversion":   decodeParam[string],
+		"id":                  decodeParam[string],
+		"keyword":             decodeParam[string],
+		"osdisplayname":       decodeParam[string],
+		"osnameforhypervisor": decodeParam[string],
+		"ostypeid":            decodeParam[string],
+		"page":                decodeParam[int],
+		"pagesize":            decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListGuestOsMappingParams) Clone() *ListGuestOsMappingParams {
+	return &ListGuestOsMappingParams{p: cloneParams(p.p)}
+}
+
+func (p *ListGuestOsMappingParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -578,11 +809,11 @@ func (p *ListGuestOsMappingParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListGuestOsMappingParams) GetHypervisor() (string, bool) {
+func (p *ListGuestOsMappingParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -764,6 +995,11 @@ func (s *GuestOSService) NewListGuestOsMappingParams() *ListGuestOsMappingParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
+	return s.GetGuestOsMappingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetGuestOsMappingByIDWithContext is like GetGuestOsMappingByID, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetGuestOsMappingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
 	p := &ListGuestOsMappingParams{}
 	p.p = make(map[string]interface{})
 
@@ -775,11 +1011,10 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListGuestOsMapping(p)
+	l, err := s.ListGuestOsMappingWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -797,34 +1032,72 @@ func (s *GuestOSService) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*
 
 // Lists all available OS mappings for given hypervisor
 func (s *GuestOSService) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
-	resp, err := s.cs.newRequest("listGuestOsMapping", p.toURLValues())
+	return s.ListGuestOsMappingWithContext(context.Background(), p)
+}
+
+// ListGuestOsMappingWithContext is like ListGuestOsMapping, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listGuestOsMapping", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listGuestOsMapping", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListGuestOsMappingAll returns all GuestOsMapping matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *GuestOSService) ListGuestOsMappingAll(p *ListGuestOsMappingParams, opts ...PageOption) ([]*GuestOsMapping, error) {
+	return s.ListGuestOsMappingAllWithContext(context.Background(), p, opts...)
+}
+
+// ListGuestOsMappingAllWithContext is like ListGuestOsMappingAll, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListGuestOsMappingAllWithContext(ctx context.Context, p *ListGuestOsMappingParams, opts ...PageOption) ([]*GuestOsMapping, error) {
+	return collectPages(s.ListGuestOsMappingIterWithContext(ctx, p, opts...))
+}
+
+// ListGuestOsMappingIter returns an iterator over all GuestOsMapping matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *GuestOSService) ListGuestOsMappingIter(p *ListGuestOsMappingParams, opts ...PageOption) iter.Seq2[*GuestOsMapping, error] {
+	return s.ListGuestOsMappingIterWithContext(context.Background(), p, opts...)
+}
+
+// ListGuestOsMappingIterWithContext is like ListGuestOsMappingIter, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListGuestOsMappingIterWithContext(ctx context.Context, p *ListGuestOsMappingParams, opts ...PageOption) iter.Seq2[*GuestOsMapping, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*GuestOsMapping, int, error) {
+		l, err := s.ListGuestOsMappingWithContext(ctx, &ListGuestOsMappingParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.GuestOsMapping, l.Count, nil
+	}, opts...)
+}
+
 type ListGuestOsMappingResponse struct {
 	Count          int               `json:"count"`
 	GuestOsMapping []*GuestOsMapping `json:"guestosmapping"`
 }
 
 type GuestOsMapping struct {
-	Hypervisor          string `json:"hypervisor"`
-	Hypervisorversion   string `json:"hypervisorversion"`
-	Id                  string `json:"id"`
-	Isuserdefined       string `json:"isuserdefined"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Osdisplayname       string `json:"osdisplayname"`
-	Osnameforhypervisor string `json:"osnameforhypervisor"`
-	Ostypeid            string `json:"ostypeid"`
+	Hypervisor          string                     `json:"hypervisor"`
+	Hypervisorversion   string                     `json:"hypervisorversion"`
+	Id                  string                     `json:"id"`
+	Isuserdefined       string                     `json:"isuserdefined"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Osdisplayname       string                     `json:"osdisplayname"`
+	Osnameforhypervisor string                     `json:"osnameforhypervisor"`
+	Ostypeid            string                     `json:"ostypeid"`
+	Extra               map[string]json.RawMessage `json:"-"`
 }
 
 func (r *GuestOsMapping) UnmarshalJSON(b []byte) error {
@@ -851,7 +1124,7 @@ func (r *GuestOsMapping) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias GuestOsMapping
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListOsCategoriesParams struct {
@@ -905,6 +1178,53 @@ func (p *ListOsCategoriesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listOsCategories API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListOsCategoriesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listOsCategories", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listOsCategories API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListOsCategoriesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"arch": 255, "id": 255, "keyword": 255, "name": 255, "zoneid": 255},
+	}.validate("listOsCategories", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listOsCategories API, before the request is signed
+func (p *ListOsCategoriesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListOsCategoriesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListOsCategoriesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listOsCategories", b, &p.p, map[string]paramDecoder{
+		"arch":       decodeParam[string],
+		"id":         decodeParam[string],
+		"isfeatured": decodeParam[bool],
+		"isiso":      decodeParam[bool],
+		"isvnf":      decodeParam[bool],
+		"keyword":    decodeParam[string],
+		"name":       decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+		"showicon":   decodeParam[bool],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListOsCategoriesParams) Clone() *ListOsCategoriesParams {
+	return &ListOsCategoriesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListOsCategoriesParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1146,6 +1466,11 @@ func (s *GuestOSService) NewListOsCategoriesParams() *ListOsCategoriesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetOsCategoryIDWithContext(context.Background(), name, opts...)
+}
+
+// GetOsCategoryIDWithContext is like GetOsCategoryID, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetOsCategoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListOsCategoriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1157,7 +1482,7 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 		}
 	}
 
-	l, err := s.ListOsCategories(p)
+	l, err := s.ListOsCategoriesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1182,12 +1507,17 @@ func (s *GuestOSService) GetOsCategoryID(name string, opts ...OptionFunc) (strin
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error) {
-	id, count, err := s.GetOsCategoryID(name, opts...)
+	return s.GetOsCategoryByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetOsCategoryByNameWithContext is like GetOsCategoryByName, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetOsCategoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsCategory, int, error) {
+	id, count, err := s.GetOsCategoryIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetOsCategoryByID(id, opts...)
+	r, count, err := s.GetOsCategoryByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1196,6 +1526,11 @@ func (s *GuestOSService) GetOsCategoryByName(name string, opts ...OptionFunc) (*
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error) {
+	return s.GetOsCategoryByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOsCategoryByIDWithContext is like GetOsCategoryByID, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetOsCategoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsCategory, int, error) {
 	p := &ListOsCategoriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1207,11 +1542,10 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 		}
 	}
 
-	l, err := s.ListOsCategories(p)
+	l, err := s.ListOsCategoriesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1229,32 +1563,75 @@ func (s *GuestOSService) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCa
 
 // Lists all supported OS categories for this cloud.
 func (s *GuestOSService) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
-	resp, err := s.cs.newRequest("listOsCategories", p.toURLValues())
+	return s.ListOsCategoriesWithContext(context.Background(), p)
+}
+
+// ListOsCategoriesWithContext is like ListOsCategories, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listOsCategories", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListOsCategoriesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listOsCategories", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListOsCategoriesAll returns all OsCategories matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *GuestOSService) ListOsCategoriesAll(p *ListOsCategoriesParams, opts ...PageOption) ([]*OsCategory, error) {
+	return s.ListOsCategoriesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListOsCategoriesAllWithContext is like ListOsCategoriesAll, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListOsCategoriesAllWithContext(ctx context.Context, p *ListOsCategoriesParams, opts ...PageOption) ([]*OsCategory, error) {
+	return collectPages(s.ListOsCategoriesIterWithContext(ctx, p, opts...))
+}
+
+// ListOsCategoriesIter returns an iterator over all OsCategories matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *GuestOSService) ListOsCategoriesIter(p *ListOsCategoriesParams, opts ...PageOption) iter.Seq2[*OsCategory, error] {
+	return s.ListOsCategoriesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListOsCategoriesIterWithContext is like ListOsCategoriesIter, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListOsCategoriesIterWithContext(ctx context.Context, p *ListOsCategoriesParams, opts ...PageOption) iter.Seq2[*OsCategory, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*OsCategory, int, error) {
+		l, err := s.ListOsCategoriesWithContext(ctx, &ListOsCategoriesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.OsCategories, l.Count, nil
+	}, opts...)
+}
+
 type ListOsCategoriesResponse struct {
 	Count        int           `json:"count"`
 	OsCategories []*OsCategory `json:"oscategory"`
 }
 
 type OsCategory struct {
-	Created    string      `json:"created"`
-	Icon       interface{} `json:"icon"`
-	Id         string      `json:"id"`
-	Isfeatured bool        `json:"isfeatured"`
-	JobID      string      `json:"jobid"`
-	Jobstatus  int         `json:"jobstatus"`
-	Name       string      `json:"name"`
+	Created    Time                       `json:"created"`
+	Icon       interface{}                `json:"icon"`
+	Id         string                     `json:"id"`
+	Isfeatured FlexBool                   `json:"isfeatured"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OsCategory) UnmarshalJSON(b []byte) error {
+	type alias OsCategory
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListOsTypesParams struct {
@@ -1297,6 +1674,50 @@ func (p *ListOsTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listOsTypes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListOsTypesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listOsTypes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listOsTypes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListOsTypesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"description": 4096, "id": 255, "keyword": 255, "oscategoryid": 255},
+	}.validate("listOsTypes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listOsTypes API, before the request is signed
+func (p *ListOsTypesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListOsTypesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListOsTypesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listOsTypes", b, &p.p, map[string]paramDecoder{
+		"description":  decodeParam[string],
+		"fordisplay":   decodeParam[bool],
+		"id":           decodeParam[string],
+		"ids":          decodeParam[[]string],
+		"keyword":      decodeParam[string],
+		"oscategoryid": decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListOsTypesParams) Clone() *ListOsTypesParams {
+	return &ListOsTypesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListOsTypesParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1475,6 +1896,11 @@ func (s *GuestOSService) NewListOsTypesParams() *ListOsTypesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsTypeID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetOsTypeIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetOsTypeIDWithContext is like GetOsTypeID, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetOsTypeIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListOsTypesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1486,7 +1912,7 @@ func (s *GuestOSService) GetOsTypeID(keyword string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListOsTypes(p)
+	l, err := s.ListOsTypesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1511,12 +1937,17 @@ func (s *GuestOSService) GetOsTypeID(keyword string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsTypeByName(name string, opts ...OptionFunc) (*OsType, int, error) {
-	id, count, err := s.GetOsTypeID(name, opts...)
+	return s.GetOsTypeByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetOsTypeByNameWithContext is like GetOsTypeByName, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetOsTypeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsType, int, error) {
+	id, count, err := s.GetOsTypeIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetOsTypeByID(id, opts...)
+	r, count, err := s.GetOsTypeByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1525,6 +1956,11 @@ func (s *GuestOSService) GetOsTypeByName(name string, opts ...OptionFunc) (*OsTy
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
+	return s.GetOsTypeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOsTypeByIDWithContext is like GetOsTypeByID, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetOsTypeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsType, int, error) {
 	p := &ListOsTypesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1536,11 +1972,10 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 		}
 	}
 
-	l, err := s.ListOsTypes(p)
+	l, err := s.ListOsTypesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1558,34 +1993,77 @@ func (s *GuestOSService) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType,
 
 // Lists all supported OS types for this cloud.
 func (s *GuestOSService) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
-	resp, err := s.cs.newRequest("listOsTypes", p.toURLValues())
+	return s.ListOsTypesWithContext(context.Background(), p)
+}
+
+// ListOsTypesWithContext is like ListOsTypes, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listOsTypes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListOsTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listOsTypes", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListOsTypesAll returns all OsTypes matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *GuestOSService) ListOsTypesAll(p *ListOsTypesParams, opts ...PageOption) ([]*OsType, error) {
+	return s.ListOsTypesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListOsTypesAllWithContext is like ListOsTypesAll, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListOsTypesAllWithContext(ctx context.Context, p *ListOsTypesParams, opts ...PageOption) ([]*OsType, error) {
+	return collectPages(s.ListOsTypesIterWithContext(ctx, p, opts...))
+}
+
+// ListOsTypesIter returns an iterator over all OsTypes matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *GuestOSService) ListOsTypesIter(p *ListOsTypesParams, opts ...PageOption) iter.Seq2[*OsType, error] {
+	return s.ListOsTypesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListOsTypesIterWithContext is like ListOsTypesIter, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) ListOsTypesIterWithContext(ctx context.Context, p *ListOsTypesParams, opts ...PageOption) iter.Seq2[*OsType, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*OsType, int, error) {
+		l, err := s.ListOsTypesWithContext(ctx, &ListOsTypesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.OsTypes, l.Count, nil
+	}, opts...)
+}
+
 type ListOsTypesResponse struct {
 	Count   int       `json:"count"`
 	OsTypes []*OsType `json:"ostype"`
 }
 
 type OsType struct {
-	Description    string `json:"description"`
-	Fordisplay     bool   `json:"fordisplay"`
-	Id             string `json:"id"`
-	Isuserdefined  bool   `json:"isuserdefined"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Oscategoryid   string `json:"oscategoryid"`
-	Oscategoryname string `json:"oscategoryname"`
+	Description    string                     `json:"description"`
+	Fordisplay     FlexBool                   `json:"fordisplay"`
+	Id             string                     `json:"id"`
+	Isuserdefined  FlexBool                   `json:"isuserdefined"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Oscategoryid   string                     `json:"oscategoryid"`
+	Oscategoryname string                     `json:"oscategoryname"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OsType) UnmarshalJSON(b []byte) error {
+	type alias OsType
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveGuestOsParams struct {
@@ -1603,6 +2081,44 @@ func (p *RemoveGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeGuestOs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveGuestOsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeGuestOs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeGuestOs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveGuestOsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeGuestOs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeGuestOs API, before the request is signed
+func (p *RemoveGuestOsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveGuestOsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveGuestOsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeGuestOs", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveGuestOsParams) Clone() *RemoveGuestOsParams {
+	return &RemoveGuestOsParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveGuestOsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1635,27 +2151,39 @@ func (s *GuestOSService) NewRemoveGuestOsParams(id string) *RemoveGuestOsParams
 
 // Removes a Guest OS from listing.
 func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
-	resp, err := s.cs.newPostRequest("removeGuestOs", p.toURLValues())
+	return s.RemoveGuestOsWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsWithContext is like RemoveGuestOs, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeGuestOs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveGuestOsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeGuestOs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeGuestOs", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeGuestOs", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1663,11 +2191,31 @@ func (s *GuestOSService) RemoveGuestOs(p *RemoveGuestOsParams) (*RemoveGuestOsRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveGuestOs async job into r
+func (r *RemoveGuestOsResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveGuestOsAsync starts the async job of RemoveGuestOs without waiting for it to finish, and returns a handle to the job
+func (s *GuestOSService) RemoveGuestOsAsync(ctx context.Context, p *RemoveGuestOsParams) (*Job, error) {
+	r, err := s.RemoveGuestOsWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeGuestOs", r.JobID), nil
+}
+
 type RemoveGuestOsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveGuestOsResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveGuestOsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveGuestOsMappingParams struct {
@@ -1685,6 +2233,44 @@ func (p *RemoveGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeGuestOsMapping API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveGuestOsMappingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeGuestOsMapping", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeGuestOsMapping API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveGuestOsMappingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeGuestOsMapping", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeGuestOsMapping API, before the request is signed
+func (p *RemoveGuestOsMappingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeGuestOsMapping", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveGuestOsMappingParams) Clone() *RemoveGuestOsMappingParams {
+	return &RemoveGuestOsMappingParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1717,27 +2303,39 @@ func (s *GuestOSService) NewRemoveGuestOsMappingParams(id string) *RemoveGuestOs
 
 // Removes a Guest OS Mapping.
 func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
-	resp, err := s.cs.newPostRequest("removeGuestOsMapping", p.toURLValues())
+	return s.RemoveGuestOsMappingWithContext(context.Background(), p)
+}
+
+// RemoveGuestOsMappingWithContext is like RemoveGuestOsMapping, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeGuestOsMapping", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeGuestOsMapping", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeGuestOsMapping", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeGuestOsMapping", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1745,11 +2343,31 @@ func (s *GuestOSService) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*R
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveGuestOsMapping async job into r
+func (r *RemoveGuestOsMappingResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveGuestOsMappingAsync starts the async job of RemoveGuestOsMapping without waiting for it to finish, and returns a handle to the job
+func (s *GuestOSService) RemoveGuestOsMappingAsync(ctx context.Context, p *RemoveGuestOsMappingParams) (*Job, error) {
+	r, err := s.RemoveGuestOsMappingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeGuestOsMapping", r.JobID), nil
+}
+
 type RemoveGuestOsMappingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveGuestOsMappingResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveGuestOsMappingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateGuestOsParams struct {
@@ -1784,6 +2402,48 @@ func (p *UpdateGuestOsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateGuestOs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateGuestOsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateGuestOs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateGuestOs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateGuestOsParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "osdisplayname"},
+		lengths:  map[string]int{"id": 255, "oscategoryid": 255, "osdisplayname": 255},
+	}.validate("updateGuestOs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateGuestOs API, before the request is signed
+func (p *UpdateGuestOsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateGuestOsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateGuestOsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateGuestOs", b, &p.p, map[string]paramDecoder{
+		"details":       decodeParam[map[string]string],
+		"forDisplay":    decodeParam[bool],
+		"id":            decodeParam[string],
+		"oscategoryid":  decodeParam[string],
+		"osdisplayname": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateGuestOsParams) Clone() *UpdateGuestOsParams {
+	return &UpdateGuestOsParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateGuestOsParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1901,32 +2561,39 @@ func (s *GuestOSService) NewUpdateGuestOsParams(id string, osdisplayname string)
 
 // Updates the information about Guest OS
 func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
-	resp, err := s.cs.newPostRequest("updateGuestOs", p.toURLValues())
+	return s.UpdateGuestOsWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsWithContext is like UpdateGuestOs, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateGuestOs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateGuestOsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateGuestOs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateGuestOs", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateGuestOs", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1934,16 +2601,41 @@ func (s *GuestOSService) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateGuestOs async job into r
+func (r *UpdateGuestOsResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateGuestOsAsync starts the async job of UpdateGuestOs without waiting for it to finish, and returns a handle to the job
+func (s *GuestOSService) UpdateGuestOsAsync(ctx context.Context, p *UpdateGuestOsParams) (*Job, error) {
+	r, err := s.UpdateGuestOsWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateGuestOs", r.JobID), nil
+}
+
 type UpdateGuestOsResponse struct {
-	Description    string `json:"description"`
-	Fordisplay     bool   `json:"fordisplay"`
-	Id             string `json:"id"`
-	Isuserdefined  bool   `json:"isuserdefined"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Oscategoryid   string `json:"oscategoryid"`
-	Oscategoryname string `json:"oscategoryname"`
+	Description    string                     `json:"description"`
+	Fordisplay     FlexBool                   `json:"fordisplay"`
+	Id             string                     `json:"id"`
+	Isuserdefined  FlexBool                   `json:"isuserdefined"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Oscategoryid   string                     `json:"oscategoryid"`
+	Oscategoryname string                     `json:"oscategoryname"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateGuestOsResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateGuestOsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateGuestOsMappingParams struct {
@@ -1968,6 +2660,46 @@ func (p *UpdateGuestOsMappingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateGuestOsMapping API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateGuestOsMappingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateGuestOsMapping", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateGuestOsMapping API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateGuestOsMappingParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "osnameforhypervisor"},
+		lengths:  map[string]int{"id": 255, "osnameforhypervisor": 255},
+	}.validate("updateGuestOsMapping", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateGuestOsMapping API, before the request is signed
+func (p *UpdateGuestOsMappingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateGuestOsMappingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateGuestOsMappingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateGuestOsMapping", b, &p.p, map[string]paramDecoder{
+		"id":                    decodeParam[string],
+		"osmappingcheckenabled": decodeParam[bool],
+		"osnameforhypervisor":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateGuestOsMappingParams) Clone() *UpdateGuestOsMappingParams {
+	return &UpdateGuestOsMappingParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateGuestOsMappingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2043,32 +2775,39 @@ func (s *GuestOSService) NewUpdateGuestOsMappingParams(id string, osnameforhyper
 
 // Updates the information about Guest OS to Hypervisor specific name mapping
 func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
-	resp, err := s.cs.newPostRequest("updateGuestOsMapping", p.toURLValues())
+	return s.UpdateGuestOsMappingWithContext(context.Background(), p)
+}
+
+// UpdateGuestOsMappingWithContext is like UpdateGuestOsMapping, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateGuestOsMapping", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateGuestOsMappingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateGuestOsMapping", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateGuestOsMapping", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateGuestOsMapping", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2076,16 +2815,36 @@ func (s *GuestOSService) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*U
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateGuestOsMapping async job into r
+func (r *UpdateGuestOsMappingResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateGuestOsMappingAsync starts the async job of UpdateGuestOsMapping without waiting for it to finish, and returns a handle to the job
+func (s *GuestOSService) UpdateGuestOsMappingAsync(ctx context.Context, p *UpdateGuestOsMappingParams) (*Job, error) {
+	r, err := s.UpdateGuestOsMappingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateGuestOsMapping", r.JobID), nil
+}
+
 type UpdateGuestOsMappingResponse struct {
-	Hypervisor          string `json:"hypervisor"`
-	Hypervisorversion   string `json:"hypervisorversion"`
-	Id                  string `json:"id"`
-	Isuserdefined       string `json:"isuserdefined"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Osdisplayname       string `json:"osdisplayname"`
-	Osnameforhypervisor string `json:"osnameforhypervisor"`
-	Ostypeid            string `json:"ostypeid"`
+	Hypervisor          string                     `json:"hypervisor"`
+	Hypervisorversion   string                     `json:"hypervisorversion"`
+	Id                  string                     `json:"id"`
+	Isuserdefined       string                     `json:"isuserdefined"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Osdisplayname       string                     `json:"osdisplayname"`
+	Osnameforhypervisor string                     `json:"osnameforhypervisor"`
+	Ostypeid            string                     `json:"ostypeid"`
+	Extra               map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateGuestOsMappingResponse) UnmarshalJSON(b []byte) error {
@@ -2112,7 +2871,7 @@ func (r *UpdateGuestOsMappingResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateGuestOsMappingResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetHypervisorGuestOsNamesParams struct {
@@ -2125,7 +2884,7 @@ func (p *GetHypervisorGuestOsNamesParams) toURLValues() url.Values {
 		return u
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["hypervisorversion"]; found {
 		u.Set("hypervisorversion", v.(string))
@@ -2136,7 +2895,47 @@ func (p *GetHypervisorGuestOsNamesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *GetHypervisorGuestOsNamesParams) SetHypervisor(v string) {
+// ValidateAgainst checks the parameters against the getHypervisorGuestOsNames API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetHypervisorGuestOsNamesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getHypervisorGuestOsNames", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getHypervisorGuestOsNames API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetHypervisorGuestOsNamesParams) Validate() error {
+	return paramRules{
+		required: []string{"hypervisor", "hypervisorversion"},
+		lengths:  map[string]int{"hypervisor": 255, "hypervisorversion": 255, "keyword": 255},
+	}.validate("getHypervisorGuestOsNames", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getHypervisorGuestOsNames API, before the request is signed
+func (p *GetHypervisorGuestOsNamesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetHypervisorGuestOsNamesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetHypervisorGuestOsNamesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getHypervisorGuestOsNames", b, &p.p, map[string]paramDecoder{
+		"hypervisor":        decodeParam[HypervisorType],
+		"hypervisorversion": decodeParam[string],
+		"keyword":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetHypervisorGuestOsNamesParams) Clone() *GetHypervisorGuestOsNamesParams {
+	return &GetHypervisorGuestOsNamesParams{p: cloneParams(p.p)}
+}
+
+func (p *GetHypervisorGuestOsNamesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -2149,11 +2948,11 @@ func (p *GetHypervisorGuestOsNamesParams) ResetHypervisor() {
 	}
 }
 
-func (p *GetHypervisorGuestOsNamesParams) GetHypervisor() (string, bool) {
+func (p *GetHypervisorGuestOsNamesParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -2201,7 +3000,7 @@ func (p *GetHypervisorGuestOsNamesParams) GetKeyword() (string, bool) {
 
 // You should always use this function to get a new GetHypervisorGuestOsNamesParams instance,
 // as then you are sure you have configured all required params
-func (s *GuestOSService) NewGetHypervisorGuestOsNamesParams(hypervisor string, hypervisorversion string) *GetHypervisorGuestOsNamesParams {
+func (s *GuestOSService) NewGetHypervisorGuestOsNamesParams(hypervisor HypervisorType, hypervisorversion string) *GetHypervisorGuestOsNamesParams {
 	p := &GetHypervisorGuestOsNamesParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
@@ -2211,32 +3010,39 @@ func (s *GuestOSService) NewGetHypervisorGuestOsNamesParams(hypervisor string, h
 
 // Gets the guest OS names in the hypervisor
 func (s *GuestOSService) GetHypervisorGuestOsNames(p *GetHypervisorGuestOsNamesParams) (*GetHypervisorGuestOsNamesResponse, error) {
-	resp, err := s.cs.newRequest("getHypervisorGuestOsNames", p.toURLValues())
+	return s.GetHypervisorGuestOsNamesWithContext(context.Background(), p)
+}
+
+// GetHypervisorGuestOsNamesWithContext is like GetHypervisorGuestOsNames, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) GetHypervisorGuestOsNamesWithContext(ctx context.Context, p *GetHypervisorGuestOsNamesParams) (*GetHypervisorGuestOsNamesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newRequest(ctx, "getHypervisorGuestOsNames", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetHypervisorGuestOsNamesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getHypervisorGuestOsNames", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "getHypervisorGuestOsNames", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("getHypervisorGuestOsNames", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2244,18 +3050,49 @@ func (s *GuestOSService) GetHypervisorGuestOsNames(p *GetHypervisorGuestOsNamesP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished GetHypervisorGuestOsNames async job into r
+func (r *GetHypervisorGuestOsNamesResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// GetHypervisorGuestOsNamesAsync starts the async job of GetHypervisorGuestOsNames without waiting for it to finish, and returns a handle to the job
+func (s *GuestOSService) GetHypervisorGuestOsNamesAsync(ctx context.Context, p *GetHypervisorGuestOsNamesParams) (*Job, error) {
+	r, err := s.GetHypervisorGuestOsNamesWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("getHypervisorGuestOsNames", r.JobID), nil
+}
+
 type GetHypervisorGuestOsNamesResponse struct {
-	Guestoscount      int                                            `json:"guestoscount"`
+	Guestoscount      FlexInt                                        `json:"guestoscount"`
 	Guestoslist       []GetHypervisorGuestOsNamesResponseGuestoslist `json:"guestoslist"`
 	Hypervisor        string                                         `json:"hypervisor"`
 	Hypervisorversion string                                         `json:"hypervisorversion"`
 	JobID             string                                         `json:"jobid"`
-	Jobstatus         int                                            `json:"jobstatus"`
+	Jobstatus         FlexInt                                        `json:"jobstatus"`
+	Extra             map[string]json.RawMessage                     `json:"-"`
+}
+
+func (r *GetHypervisorGuestOsNamesResponse) UnmarshalJSON(b []byte) error {
+	type alias GetHypervisorGuestOsNamesResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetHypervisorGuestOsNamesResponseGuestoslist struct {
-	Osdisplayname       string `json:"osdisplayname"`
-	Osnameforhypervisor string `json:"osnameforhypervisor"`
+	Osdisplayname       string                     `json:"osdisplayname"`
+	Osnameforhypervisor string                     `json:"osnameforhypervisor"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetHypervisorGuestOsNamesResponseGuestoslist) UnmarshalJSON(b []byte) error {
+	type alias GetHypervisorGuestOsNamesResponseGuestoslist
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddOsCategoryParams struct {
@@ -2277,6 +3114,45 @@ func (p *AddOsCategoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addOsCategory API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddOsCategoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addOsCategory", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addOsCategory API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddOsCategoryParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"name": 255},
+	}.validate("addOsCategory", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addOsCategory API, before the request is signed
+func (p *AddOsCategoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddOsCategoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddOsCategoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addOsCategory", b, &p.p, map[string]paramDecoder{
+		"isfeatured": decodeParam[bool],
+		"name":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddOsCategoryParams) Clone() *AddOsCategoryParams {
+	return &AddOsCategoryParams{p: cloneParams(p.p)}
+}
+
 func (p *AddOsCategoryParams) SetIsfeatured(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2330,7 +3206,16 @@ func (s *GuestOSService) NewAddOsCategoryParams(name string) *AddOsCategoryParam
 
 // Adds a new OS category
 func (s *GuestOSService) AddOsCategory(p *AddOsCategoryParams) (*AddOsCategoryResponse, error) {
-	resp, err := s.cs.newPostRequest("addOsCategory", p.toURLValues())
+	return s.AddOsCategoryWithContext(context.Background(), p)
+}
+
+// AddOsCategoryWithContext is like AddOsCategory, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) AddOsCategoryWithContext(ctx context.Context, p *AddOsCategoryParams) (*AddOsCategoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addOsCategory", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2338,7 +3223,7 @@ func (s *GuestOSService) AddOsCategory(p *AddOsCategoryParams) (*AddOsCategoryRe
 	var nested struct {
 		Response AddOsCategoryResponse `json:"oscategory"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("addOsCategory", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -2347,13 +3232,19 @@ func (s *GuestOSService) AddOsCategory(p *AddOsCategoryParams) (*AddOsCategoryRe
 }
 
 type AddOsCategoryResponse struct {
-	Created    string      `json:"created"`
-	Icon       interface{} `json:"icon"`
-	Id         string      `json:"id"`
-	Isfeatured bool        `json:"isfeatured"`
-	JobID      string      `json:"jobid"`
-	Jobstatus  int         `json:"jobstatus"`
-	Name       string      `json:"name"`
+	Created    Time                       `json:"created"`
+	Icon       interface{}                `json:"icon"`
+	Id         string                     `json:"id"`
+	Isfeatured FlexBool                   `json:"isfeatured"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddOsCategoryResponse) UnmarshalJSON(b []byte) error {
+	type alias AddOsCategoryResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteOsCategoryParams struct {
@@ -2371,6 +3262,44 @@ func (p *DeleteOsCategoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteOsCategory API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteOsCategoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteOsCategory", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteOsCategory API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteOsCategoryParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteOsCategory", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteOsCategory API, before the request is signed
+func (p *DeleteOsCategoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteOsCategoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteOsCategoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteOsCategory", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteOsCategoryParams) Clone() *DeleteOsCategoryParams {
+	return &DeleteOsCategoryParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteOsCategoryParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2403,13 +3332,22 @@ func (s *GuestOSService) NewDeleteOsCategoryParams(id string) *DeleteOsCategoryP
 
 // Deletes an OS category
 func (s *GuestOSService) DeleteOsCategory(p *DeleteOsCategoryParams) (*DeleteOsCategoryResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteOsCategory", p.toURLValues())
+	return s.DeleteOsCategoryWithContext(context.Background(), p)
+}
+
+// DeleteOsCategoryWithContext is like DeleteOsCategory, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) DeleteOsCategoryWithContext(ctx context.Context, p *DeleteOsCategoryParams) (*DeleteOsCategoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteOsCategory", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteOsCategoryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteOsCategory", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2417,10 +3355,11 @@ func (s *GuestOSService) DeleteOsCategory(p *DeleteOsCategoryParams) (*DeleteOsC
 }
 
 type DeleteOsCategoryResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteOsCategoryResponse) UnmarshalJSON(b []byte) error {
@@ -2447,7 +3386,7 @@ func (r *DeleteOsCategoryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteOsCategoryResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateOsCategoryParams struct {
@@ -2476,6 +3415,47 @@ func (p *UpdateOsCategoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateOsCategory API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateOsCategoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateOsCategory", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateOsCategory API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateOsCategoryParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "name": 255},
+	}.validate("updateOsCategory", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateOsCategory API, before the request is signed
+func (p *UpdateOsCategoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateOsCategoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateOsCategoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateOsCategory", b, &p.p, map[string]paramDecoder{
+		"id":         decodeParam[string],
+		"isfeatured": decodeParam[bool],
+		"name":       decodeParam[string],
+		"sortkey":    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateOsCategoryParams) Clone() *UpdateOsCategoryParams {
+	return &UpdateOsCategoryParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateOsCategoryParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2571,7 +3551,16 @@ func (s *GuestOSService) NewUpdateOsCategoryParams(id string) *UpdateOsCategoryP
 
 // Updates an OS category
 func (s *GuestOSService) UpdateOsCategory(p *UpdateOsCategoryParams) (*UpdateOsCategoryResponse, error) {
-	resp, err := s.cs.newPostRequest("updateOsCategory", p.toURLValues())
+	return s.UpdateOsCategoryWithContext(context.Background(), p)
+}
+
+// UpdateOsCategoryWithContext is like UpdateOsCategory, but honours the cancellation and deadline of ctx
+func (s *GuestOSService) UpdateOsCategoryWithContext(ctx context.Context, p *UpdateOsCategoryParams) (*UpdateOsCategoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateOsCategory", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2579,7 +3568,7 @@ func (s *GuestOSService) UpdateOsCategory(p *UpdateOsCategoryParams) (*UpdateOsC
 	var nested struct {
 		Response UpdateOsCategoryResponse `json:"oscategory"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateOsCategory", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -2588,11 +3577,17 @@ func (s *GuestOSService) UpdateOsCategory(p *UpdateOsCategoryParams) (*UpdateOsC
 }
 
 type UpdateOsCategoryResponse struct {
-	Created    string      `json:"created"`
-	Icon       interface{} `json:"icon"`
-	Id         string      `json:"id"`
-	Isfeatured bool        `json:"isfeatured"`
-	JobID      string      `json:"jobid"`
-	Jobstatus  int         `json:"jobstatus"`
-	Name       string      `json:"name"`
+	Created    Time                       `json:"created"`
+	Icon       interface{}                `json:"icon"`
+	Id         string                     `json:"id"`
+	Isfeatured FlexBool                   `json:"isfeatured"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateOsCategoryResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateOsCategoryResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOs(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOs), p)
 }
 
+// AddGuestOsAsync mocks base method.
+func (m *MockGuestOSServiceIface) AddGuestOsAsync(ctx context.Context, p *AddGuestOsParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuestOsAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuestOsAsync indicates an expected call of AddGuestOsAsync.
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsAsync", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsAsync), ctx, p)
+}
+
 // AddGuestOsMapping mocks base method.
 func (m *MockGuestOSServiceIface) AddGuestOsMapping(p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,51 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsMapping(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsMapping), p)
 }
 
+// AddGuestOsMappingAsync mocks base method.
+func (m *MockGuestOSServiceIface) AddGuestOsMappingAsync(ctx context.Context, p *AddGuestOsMappingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuestOsMappingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuestOsMappingAsync indicates an expected call of AddGuestOsMappingAsync.
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsMappingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsMappingAsync", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsMappingAsync), ctx, p)
+}
+
+// AddGuestOsMappingWithContext mocks base method.
+func (m *MockGuestOSServiceIface) AddGuestOsMappingWithContext(ctx context.Context, p *AddGuestOsMappingParams) (*AddGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuestOsMappingWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuestOsMappingWithContext indicates an expected call of AddGuestOsMappingWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsMappingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsMappingWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsMappingWithContext), ctx, p)
+}
+
+// AddGuestOsWithContext mocks base method.
+func (m *MockGuestOSServiceIface) AddGuestOsWithContext(ctx context.Context, p *AddGuestOsParams) (*AddGuestOsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGuestOsWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddGuestOsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGuestOsWithContext indicates an expected call of AddGuestOsWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddGuestOsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGuestOsWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddGuestOsWithContext), ctx, p)
+}
+
 // AddOsCategory mocks base method.
 func (m *MockGuestOSServiceIface) AddOsCategory(p *AddOsCategoryParams) (*AddOsCategoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,21 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) AddOsCategory(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOsCategory", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddOsCategory), p)
 }
 
+// AddOsCategoryWithContext mocks base method.
+func (m *MockGuestOSServiceIface) AddOsCategoryWithContext(ctx context.Context, p *AddOsCategoryParams) (*AddOsCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOsCategoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddOsCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOsCategoryWithContext indicates an expected call of AddOsCategoryWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) AddOsCategoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOsCategoryWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).AddOsCategoryWithContext), ctx, p)
+}
+
 // DeleteOsCategory mocks base method.
 func (m *MockGuestOSServiceIface) DeleteOsCategory(p *DeleteOsCategoryParams) (*DeleteOsCategoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,21 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) DeleteOsCategory(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOsCategory", reflect.TypeOf((*MockGuestOSServiceIface)(nil).DeleteOsCategory), p)
 }
 
+// DeleteOsCategoryWithContext mocks base method.
+func (m *MockGuestOSServiceIface) DeleteOsCategoryWithContext(ctx context.Context, p *DeleteOsCategoryParams) (*DeleteOsCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOsCategoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteOsCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOsCategoryWithContext indicates an expected call of DeleteOsCategoryWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) DeleteOsCategoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOsCategoryWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).DeleteOsCategoryWithContext), ctx, p)
+}
+
 // GetGuestOsMappingByID mocks base method.
 func (m *MockGuestOSServiceIface) GetGuestOsMappingByID(id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +231,27 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetGuestOsMappingByID(id any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestOsMappingByID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetGuestOsMappingByID), varargs...)
 }
 
+// GetGuestOsMappingByIDWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetGuestOsMappingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestOsMapping, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGuestOsMappingByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*GuestOsMapping)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGuestOsMappingByIDWithContext indicates an expected call of GetGuestOsMappingByIDWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetGuestOsMappingByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestOsMappingByIDWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetGuestOsMappingByIDWithContext), varargs...)
+}
+
 // GetHypervisorGuestOsNames mocks base method.
 func (m *MockGuestOSServiceIface) GetHypervisorGuestOsNames(p *GetHypervisorGuestOsNamesParams) (*GetHypervisorGuestOsNamesResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +267,36 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetHypervisorGuestOsNames(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHypervisorGuestOsNames", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetHypervisorGuestOsNames), p)
 }
 
+// GetHypervisorGuestOsNamesAsync mocks base method.
+func (m *MockGuestOSServiceIface) GetHypervisorGuestOsNamesAsync(ctx context.Context, p *GetHypervisorGuestOsNamesParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHypervisorGuestOsNamesAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHypervisorGuestOsNamesAsync indicates an expected call of GetHypervisorGuestOsNamesAsync.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetHypervisorGuestOsNamesAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHypervisorGuestOsNamesAsync", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetHypervisorGuestOsNamesAsync), ctx, p)
+}
+
+// GetHypervisorGuestOsNamesWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetHypervisorGuestOsNamesWithContext(ctx context.Context, p *GetHypervisorGuestOsNamesParams) (*GetHypervisorGuestOsNamesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHypervisorGuestOsNamesWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetHypervisorGuestOsNamesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHypervisorGuestOsNamesWithContext indicates an expected call of GetHypervisorGuestOsNamesWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetHypervisorGuestOsNamesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHypervisorGuestOsNamesWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetHypervisorGuestOsNamesWithContext), ctx, p)
+}
+
 // GetOsCategoryByID mocks base method.
 func (m *MockGuestOSServiceIface) GetOsCategoryByID(id string, opts ...OptionFunc) (*OsCategory, int, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +318,27 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryByID(id any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryByID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryByID), varargs...)
 }
 
+// GetOsCategoryByIDWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetOsCategoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsCategory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsCategoryByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*OsCategory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsCategoryByIDWithContext indicates an expected call of GetOsCategoryByIDWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryByIDWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryByIDWithContext), varargs...)
+}
+
 // GetOsCategoryByName mocks base method.
 func (m *MockGuestOSServiceIface) GetOsCategoryByName(name string, opts ...OptionFunc) (*OsCategory, int, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +360,27 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryByName(name any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryByName", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryByName), varargs...)
 }
 
+// GetOsCategoryByNameWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetOsCategoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsCategory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsCategoryByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*OsCategory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsCategoryByNameWithContext indicates an expected call of GetOsCategoryByNameWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryByNameWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryByNameWithContext), varargs...)
+}
+
 // GetOsCategoryID mocks base method.
 func (m *MockGuestOSServiceIface) GetOsCategoryID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +402,27 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryID(name any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryID), varargs...)
 }
 
+// GetOsCategoryIDWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetOsCategoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsCategoryIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsCategoryIDWithContext indicates an expected call of GetOsCategoryIDWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsCategoryIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsCategoryIDWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsCategoryIDWithContext), varargs...)
+}
+
 // GetOsTypeByID mocks base method.
 func (m *MockGuestOSServiceIface) GetOsTypeByID(id string, opts ...OptionFunc) (*OsType, int, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +444,27 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeByID(id any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsTypeByID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsTypeByID), varargs...)
 }
 
+// GetOsTypeByIDWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetOsTypeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OsType, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsTypeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*OsType)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsTypeByIDWithContext indicates an expected call of GetOsTypeByIDWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsTypeByIDWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsTypeByIDWithContext), varargs...)
+}
+
 // GetOsTypeByName mocks base method.
 func (m *MockGuestOSServiceIface) GetOsTypeByName(name string, opts ...OptionFunc) (*OsType, int, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +486,27 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeByName(name any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsTypeByName", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsTypeByName), varargs...)
 }
 
+// GetOsTypeByNameWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetOsTypeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OsType, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsTypeByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*OsType)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsTypeByNameWithContext indicates an expected call of GetOsTypeByNameWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsTypeByNameWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsTypeByNameWithContext), varargs...)
+}
+
 // GetOsTypeID mocks base method.
 func (m *MockGuestOSServiceIface) GetOsTypeID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +528,27 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeID(keyword any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsTypeID", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsTypeID), varargs...)
 }
 
+// GetOsTypeIDWithContext mocks base method.
+func (m *MockGuestOSServiceIface) GetOsTypeIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOsTypeIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOsTypeIDWithContext indicates an expected call of GetOsTypeIDWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) GetOsTypeIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOsTypeIDWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).GetOsTypeIDWithContext), varargs...)
+}
+
 // ListGuestOsMapping mocks base method.
 func (m *MockGuestOSServiceIface) ListGuestOsMapping(p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
@@ -295,6 +564,99 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMapping(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMapping), p)
 }
 
+// ListGuestOsMappingAll mocks base method.
+func (m *MockGuestOSServiceIface) ListGuestOsMappingAll(p *ListGuestOsMappingParams, opts ...PageOption) ([]*GuestOsMapping, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestOsMappingAll", varargs...)
+	ret0, _ := ret[0].([]*GuestOsMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestOsMappingAll indicates an expected call of ListGuestOsMappingAll.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMappingAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMappingAll", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMappingAll), varargs...)
+}
+
+// ListGuestOsMappingAllWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListGuestOsMappingAllWithContext(ctx context.Context, p *ListGuestOsMappingParams, opts ...PageOption) ([]*GuestOsMapping, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestOsMappingAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*GuestOsMapping)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestOsMappingAllWithContext indicates an expected call of ListGuestOsMappingAllWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMappingAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMappingAllWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMappingAllWithContext), varargs...)
+}
+
+// ListGuestOsMappingIter mocks base method.
+func (m *MockGuestOSServiceIface) ListGuestOsMappingIter(p *ListGuestOsMappingParams, opts ...PageOption) iter.Seq2[*GuestOsMapping, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestOsMappingIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GuestOsMapping, error])
+	return ret0
+}
+
+// ListGuestOsMappingIter indicates an expected call of ListGuestOsMappingIter.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMappingIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMappingIter", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMappingIter), varargs...)
+}
+
+// ListGuestOsMappingIterWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListGuestOsMappingIterWithContext(ctx context.Context, p *ListGuestOsMappingParams, opts ...PageOption) iter.Seq2[*GuestOsMapping, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestOsMappingIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GuestOsMapping, error])
+	return ret0
+}
+
+// ListGuestOsMappingIterWithContext indicates an expected call of ListGuestOsMappingIterWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMappingIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMappingIterWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMappingIterWithContext), varargs...)
+}
+
+// ListGuestOsMappingWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListGuestOsMappingWithContext(ctx context.Context, p *ListGuestOsMappingParams) (*ListGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuestOsMappingWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestOsMappingWithContext indicates an expected call of ListGuestOsMappingWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListGuestOsMappingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestOsMappingWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListGuestOsMappingWithContext), ctx, p)
+}
+
 // ListOsCategories mocks base method.
 func (m *MockGuestOSServiceIface) ListOsCategories(p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +672,99 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategories(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategories", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategories), p)
 }
 
+// ListOsCategoriesAll mocks base method.
+func (m *MockGuestOSServiceIface) ListOsCategoriesAll(p *ListOsCategoriesParams, opts ...PageOption) ([]*OsCategory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsCategoriesAll", varargs...)
+	ret0, _ := ret[0].([]*OsCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsCategoriesAll indicates an expected call of ListOsCategoriesAll.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategoriesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategoriesAll", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategoriesAll), varargs...)
+}
+
+// ListOsCategoriesAllWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListOsCategoriesAllWithContext(ctx context.Context, p *ListOsCategoriesParams, opts ...PageOption) ([]*OsCategory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsCategoriesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*OsCategory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsCategoriesAllWithContext indicates an expected call of ListOsCategoriesAllWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategoriesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategoriesAllWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategoriesAllWithContext), varargs...)
+}
+
+// ListOsCategoriesIter mocks base method.
+func (m *MockGuestOSServiceIface) ListOsCategoriesIter(p *ListOsCategoriesParams, opts ...PageOption) iter.Seq2[*OsCategory, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsCategoriesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OsCategory, error])
+	return ret0
+}
+
+// ListOsCategoriesIter indicates an expected call of ListOsCategoriesIter.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategoriesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategoriesIter", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategoriesIter), varargs...)
+}
+
+// ListOsCategoriesIterWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListOsCategoriesIterWithContext(ctx context.Context, p *ListOsCategoriesParams, opts ...PageOption) iter.Seq2[*OsCategory, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsCategoriesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OsCategory, error])
+	return ret0
+}
+
+// ListOsCategoriesIterWithContext indicates an expected call of ListOsCategoriesIterWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategoriesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategoriesIterWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategoriesIterWithContext), varargs...)
+}
+
+// ListOsCategoriesWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListOsCategoriesWithContext(ctx context.Context, p *ListOsCategoriesParams) (*ListOsCategoriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOsCategoriesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListOsCategoriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsCategoriesWithContext indicates an expected call of ListOsCategoriesWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsCategoriesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsCategoriesWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsCategoriesWithContext), ctx, p)
+}
+
 // ListOsTypes mocks base method.
 func (m *MockGuestOSServiceIface) ListOsTypes(p *ListOsTypesParams) (*ListOsTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -325,8 +780,101 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypes(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypes", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypes), p)
 }
 
+// ListOsTypesAll mocks base method.
+func (m *MockGuestOSServiceIface) ListOsTypesAll(p *ListOsTypesParams, opts ...PageOption) ([]*OsType, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsTypesAll", varargs...)
+	ret0, _ := ret[0].([]*OsType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsTypesAll indicates an expected call of ListOsTypesAll.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypesAll", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypesAll), varargs...)
+}
+
+// ListOsTypesAllWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListOsTypesAllWithContext(ctx context.Context, p *ListOsTypesParams, opts ...PageOption) ([]*OsType, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsTypesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*OsType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsTypesAllWithContext indicates an expected call of ListOsTypesAllWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypesAllWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypesAllWithContext), varargs...)
+}
+
+// ListOsTypesIter mocks base method.
+func (m *MockGuestOSServiceIface) ListOsTypesIter(p *ListOsTypesParams, opts ...PageOption) iter.Seq2[*OsType, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsTypesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OsType, error])
+	return ret0
+}
+
+// ListOsTypesIter indicates an expected call of ListOsTypesIter.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypesIter", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypesIter), varargs...)
+}
+
+// ListOsTypesIterWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListOsTypesIterWithContext(ctx context.Context, p *ListOsTypesParams, opts ...PageOption) iter.Seq2[*OsType, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOsTypesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OsType, error])
+	return ret0
+}
+
+// ListOsTypesIterWithContext indicates an expected call of ListOsTypesIterWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypesIterWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypesIterWithContext), varargs...)
+}
+
+// ListOsTypesWithContext mocks base method.
+func (m *MockGuestOSServiceIface) ListOsTypesWithContext(ctx context.Context, p *ListOsTypesParams) (*ListOsTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOsTypesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListOsTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOsTypesWithContext indicates an expected call of ListOsTypesWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) ListOsTypesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOsTypesWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).ListOsTypesWithContext), ctx, p)
+}
+
 // NewAddGuestOsMappingParams mocks base method.
-func (m *MockGuestOSServiceIface) NewAddGuestOsMappingParams(hypervisor, hypervisorversion, osnameforhypervisor string) *AddGuestOsMappingParams {
+func (m *MockGuestOSServiceIface) NewAddGuestOsMappingParams(hypervisor HypervisorType, hypervisorversion, osnameforhypervisor string) *AddGuestOsMappingParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewAddGuestOsMappingParams", hypervisor, hypervisorversion, osnameforhypervisor)
 	ret0, _ := ret[0].(*AddGuestOsMappingParams)
@@ -382,7 +930,7 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) NewDeleteOsCategoryParams(id any)
 }
 
 // NewGetHypervisorGuestOsNamesParams mocks base method.
-func (m *MockGuestOSServiceIface) NewGetHypervisorGuestOsNamesParams(hypervisor, hypervisorversion string) *GetHypervisorGuestOsNamesParams {
+func (m *MockGuestOSServiceIface) NewGetHypervisorGuestOsNamesParams(hypervisor HypervisorType, hypervisorversion string) *GetHypervisorGuestOsNamesParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewGetHypervisorGuestOsNamesParams", hypervisor, hypervisorversion)
 	ret0, _ := ret[0].(*GetHypervisorGuestOsNamesParams)
@@ -522,6 +1070,21 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOs(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOs), p)
 }
 
+// RemoveGuestOsAsync mocks base method.
+func (m *MockGuestOSServiceIface) RemoveGuestOsAsync(ctx context.Context, p *RemoveGuestOsParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuestOsAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGuestOsAsync indicates an expected call of RemoveGuestOsAsync.
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsAsync", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsAsync), ctx, p)
+}
+
 // RemoveGuestOsMapping mocks base method.
 func (m *MockGuestOSServiceIface) RemoveGuestOsMapping(p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
@@ -537,6 +1100,51 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsMapping(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsMapping), p)
 }
 
+// RemoveGuestOsMappingAsync mocks base method.
+func (m *MockGuestOSServiceIface) RemoveGuestOsMappingAsync(ctx context.Context, p *RemoveGuestOsMappingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuestOsMappingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGuestOsMappingAsync indicates an expected call of RemoveGuestOsMappingAsync.
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsMappingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsMappingAsync", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsMappingAsync), ctx, p)
+}
+
+// RemoveGuestOsMappingWithContext mocks base method.
+func (m *MockGuestOSServiceIface) RemoveGuestOsMappingWithContext(ctx context.Context, p *RemoveGuestOsMappingParams) (*RemoveGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuestOsMappingWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGuestOsMappingWithContext indicates an expected call of RemoveGuestOsMappingWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsMappingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsMappingWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsMappingWithContext), ctx, p)
+}
+
+// RemoveGuestOsWithContext mocks base method.
+func (m *MockGuestOSServiceIface) RemoveGuestOsWithContext(ctx context.Context, p *RemoveGuestOsParams) (*RemoveGuestOsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveGuestOsWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveGuestOsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveGuestOsWithContext indicates an expected call of RemoveGuestOsWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) RemoveGuestOsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveGuestOsWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).RemoveGuestOsWithContext), ctx, p)
+}
+
 // UpdateGuestOs mocks base method.
 func (m *MockGuestOSServiceIface) UpdateGuestOs(p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
 	m.ctrl.T.Helper()
@@ -552,6 +1160,21 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOs(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOs", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOs), p)
 }
 
+// UpdateGuestOsAsync mocks base method.
+func (m *MockGuestOSServiceIface) UpdateGuestOsAsync(ctx context.Context, p *UpdateGuestOsParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestOsAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuestOsAsync indicates an expected call of UpdateGuestOsAsync.
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsAsync", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsAsync), ctx, p)
+}
+
 // UpdateGuestOsMapping mocks base method.
 func (m *MockGuestOSServiceIface) UpdateGuestOsMapping(p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
 	m.ctrl.T.Helper()
@@ -567,6 +1190,51 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsMapping(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsMapping", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsMapping), p)
 }
 
+// UpdateGuestOsMappingAsync mocks base method.
+func (m *MockGuestOSServiceIface) UpdateGuestOsMappingAsync(ctx context.Context, p *UpdateGuestOsMappingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestOsMappingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuestOsMappingAsync indicates an expected call of UpdateGuestOsMappingAsync.
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsMappingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsMappingAsync", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsMappingAsync), ctx, p)
+}
+
+// UpdateGuestOsMappingWithContext mocks base method.
+func (m *MockGuestOSServiceIface) UpdateGuestOsMappingWithContext(ctx context.Context, p *UpdateGuestOsMappingParams) (*UpdateGuestOsMappingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestOsMappingWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateGuestOsMappingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuestOsMappingWithContext indicates an expected call of UpdateGuestOsMappingWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsMappingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsMappingWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsMappingWithContext), ctx, p)
+}
+
+// UpdateGuestOsWithContext mocks base method.
+func (m *MockGuestOSServiceIface) UpdateGuestOsWithContext(ctx context.Context, p *UpdateGuestOsParams) (*UpdateGuestOsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGuestOsWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateGuestOsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGuestOsWithContext indicates an expected call of UpdateGuestOsWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateGuestOsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGuestOsWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateGuestOsWithContext), ctx, p)
+}
+
 // UpdateOsCategory mocks base method.
 func (m *MockGuestOSServiceIface) UpdateOsCategory(p *UpdateOsCategoryParams) (*UpdateOsCategoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -581,3 +1249,18 @@ func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateOsCategory(p any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOsCategory", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateOsCategory), p)
 }
+
+// UpdateOsCategoryWithContext mocks base method.
+func (m *MockGuestOSServiceIface) UpdateOsCategoryWithContext(ctx context.Context, p *UpdateOsCategoryParams) (*UpdateOsCategoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOsCategoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateOsCategoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOsCategoryWithContext indicates an expected call of UpdateOsCategoryWithContext.
+func (mr *MockGuestOSServiceIfaceMockRecorder) UpdateOsCategoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOsCategoryWithContext", reflect.TypeOf((*MockGuestOSServiceIface)(nil).UpdateOsCategoryWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,76 +31,151 @@ import (
 
 type HostServiceIface interface {
 	AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error)
-	NewAddBaremetalHostParams(hypervisor string, podid string, url string, zoneid string) *AddBaremetalHostParams
+	AddBaremetalHostWithContext(ctx context.Context, p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error)
+	NewAddBaremetalHostParams(hypervisor HypervisorType, podid string, url string, zoneid string) *AddBaremetalHostParams
 	AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHostWithContext(ctx context.Context, p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error)
+	AddGloboDnsHostAsync(ctx context.Context, p *AddGloboDnsHostParams) (*Job, error)
 	NewAddGloboDnsHostParams(password string, physicalnetworkid string, url string, username string) *AddGloboDnsHostParams
 	AddHost(p *AddHostParams) (*AddHostResponse, error)
-	NewAddHostParams(hypervisor string, podid string, url string, zoneid string) *AddHostParams
+	AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error)
+	NewAddHostParams(hypervisor HypervisorType, podid string, url string, zoneid string) *AddHostParams
 	AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
+	AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error)
 	NewAddSecondaryStorageParams(url string) *AddSecondaryStorageParams
 	CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error)
+	CancelHostMaintenanceAsync(ctx context.Context, p *CancelHostMaintenanceParams) (*Job, error)
 	NewCancelHostMaintenanceParams(id string) *CancelHostMaintenanceParams
 	ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error)
+	ConfigureHAForHostWithContext(ctx context.Context, p *ConfigureHAForHostParams) (*HAForHostResponse, error)
+	ConfigureHAForHostAsync(ctx context.Context, p *ConfigureHAForHostParams) (*Job, error)
 	NewConfigureHAForHostParams(hostid string, provider string) *ConfigureHAForHostParams
 	EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error)
+	EnableHAForHostWithContext(ctx context.Context, p *EnableHAForHostParams) (*EnableHAForHostResponse, error)
+	EnableHAForHostAsync(ctx context.Context, p *EnableHAForHostParams) (*Job, error)
 	NewEnableHAForHostParams(hostid string) *EnableHAForHostParams
 	DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error)
+	DedicateHostAsync(ctx context.Context, p *DedicateHostParams) (*Job, error)
 	NewDedicateHostParams(domainid string, hostid string) *DedicateHostParams
 	DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error)
+	DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error)
 	NewDeleteHostParams(id string) *DeleteHostParams
 	DisableHAForHost(p *DisableHAForHostParams) (*DisableHAForHostResponse, error)
+	DisableHAForHostWithContext(ctx context.Context, p *DisableHAForHostParams) (*DisableHAForHostResponse, error)
+	DisableHAForHostAsync(ctx context.Context, p *DisableHAForHostParams) (*Job, error)
 	NewDisableHAForHostParams(hostid string) *DisableHAForHostParams
 	DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error)
+	DisableOutOfBandManagementForHostAsync(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*Job, error)
 	NewDisableOutOfBandManagementForHostParams(hostid string) *DisableOutOfBandManagementForHostParams
 	EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error)
+	EnableOutOfBandManagementForHostAsync(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*Job, error)
 	NewEnableOutOfBandManagementForHostParams(hostid string) *EnableOutOfBandManagementForHostParams
 	FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
+	FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error)
 	NewFindHostsForMigrationParams(virtualmachineid string) *FindHostsForMigrationParams
 	ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error)
+	ListDedicatedHostsAll(p *ListDedicatedHostsParams, opts ...PageOption) ([]*DedicatedHost, error)
+	ListDedicatedHostsAllWithContext(ctx context.Context, p *ListDedicatedHostsParams, opts ...PageOption) ([]*DedicatedHost, error)
+	ListDedicatedHostsIter(p *ListDedicatedHostsParams, opts ...PageOption) iter.Seq2[*DedicatedHost, error]
+	ListDedicatedHostsIterWithContext(ctx context.Context, p *ListDedicatedHostsParams, opts ...PageOption) iter.Seq2[*DedicatedHost, error]
 	NewListDedicatedHostsParams() *ListDedicatedHostsParams
 	ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error)
+	ListHostTagsAll(p *ListHostTagsParams, opts ...PageOption) ([]*HostTag, error)
+	ListHostTagsAllWithContext(ctx context.Context, p *ListHostTagsParams, opts ...PageOption) ([]*HostTag, error)
+	ListHostTagsIter(p *ListHostTagsParams, opts ...PageOption) iter.Seq2[*HostTag, error]
+	ListHostTagsIterWithContext(ctx context.Context, p *ListHostTagsParams, opts ...PageOption) iter.Seq2[*HostTag, error]
 	NewListHostTagsParams() *ListHostTagsParams
 	GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetHostTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	ListHosts(p *ListHostsParams) (*ListHostsResponse, error)
+	ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error)
+	ListHostsAll(p *ListHostsParams, opts ...PageOption) ([]*Host, error)
+	ListHostsAllWithContext(ctx context.Context, p *ListHostsParams, opts ...PageOption) ([]*Host, error)
+	ListHostsIter(p *ListHostsParams, opts ...PageOption) iter.Seq2[*Host, error]
+	ListHostsIterWithContext(ctx context.Context, p *ListHostsParams, opts ...PageOption) iter.Seq2[*Host, error]
 	NewListHostsParams() *ListHostsParams
 	GetHostID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetHostByName(name string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Host, int, error)
 	GetHostByID(id string, opts ...OptionFunc) (*Host, int, error)
+	GetHostByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Host, int, error)
 	ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	ListHostsMetricsWithContext(ctx context.Context, p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error)
+	ListHostsMetricsAll(p *ListHostsMetricsParams, opts ...PageOption) ([]*HostsMetric, error)
+	ListHostsMetricsAllWithContext(ctx context.Context, p *ListHostsMetricsParams, opts ...PageOption) ([]*HostsMetric, error)
+	ListHostsMetricsIter(p *ListHostsMetricsParams, opts ...PageOption) iter.Seq2[*HostsMetric, error]
+	ListHostsMetricsIterWithContext(ctx context.Context, p *ListHostsMetricsParams, opts ...PageOption) iter.Seq2[*HostsMetric, error]
 	NewListHostsMetricsParams() *ListHostsMetricsParams
 	GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetHostsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error)
+	GetHostsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*HostsMetric, int, error)
 	GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error)
+	GetHostsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HostsMetric, int, error)
 	PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error)
+	PrepareHostForMaintenanceAsync(ctx context.Context, p *PrepareHostForMaintenanceParams) (*Job, error)
 	NewPrepareHostForMaintenanceParams(id string) *PrepareHostForMaintenanceParams
 	ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error)
+	ReconnectHostAsync(ctx context.Context, p *ReconnectHostParams) (*Job, error)
 	NewReconnectHostParams(id string) *ReconnectHostParams
 	ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error)
+	ReleaseDedicatedHostAsync(ctx context.Context, p *ReleaseDedicatedHostParams) (*Job, error)
 	NewReleaseDedicatedHostParams(hostid string) *ReleaseDedicatedHostParams
 	ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error)
+	ReleaseHostReservationAsync(ctx context.Context, p *ReleaseHostReservationParams) (*Job, error)
 	NewReleaseHostReservationParams(id string) *ReleaseHostReservationParams
 	UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error)
+	UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error)
 	NewUpdateHostParams(id string) *UpdateHostParams
 	UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
+	UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error)
 	NewUpdateHostPasswordParams(password string, username string) *UpdateHostPasswordParams
 	MigrateSecondaryStorageData(p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error)
+	MigrateSecondaryStorageDataWithContext(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error)
+	MigrateSecondaryStorageDataAsync(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*Job, error)
 	NewMigrateSecondaryStorageDataParams(destpools []string, srcpool string) *MigrateSecondaryStorageDataParams
 	CancelHostAsDegraded(p *CancelHostAsDegradedParams) (*CancelHostAsDegradedResponse, error)
+	CancelHostAsDegradedWithContext(ctx context.Context, p *CancelHostAsDegradedParams) (*CancelHostAsDegradedResponse, error)
+	CancelHostAsDegradedAsync(ctx context.Context, p *CancelHostAsDegradedParams) (*Job, error)
 	NewCancelHostAsDegradedParams(id string) *CancelHostAsDegradedParams
 	ListHostHAProviders(p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error)
-	NewListHostHAProvidersParams(hypervisor string) *ListHostHAProvidersParams
+	ListHostHAProvidersWithContext(ctx context.Context, p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error)
+	NewListHostHAProvidersParams(hypervisor HypervisorType) *ListHostHAProvidersParams
 	ListSecondaryStorageSelectors(p *ListSecondaryStorageSelectorsParams) (*ListSecondaryStorageSelectorsResponse, error)
+	ListSecondaryStorageSelectorsWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams) (*ListSecondaryStorageSelectorsResponse, error)
+	ListSecondaryStorageSelectorsAll(p *ListSecondaryStorageSelectorsParams, opts ...PageOption) ([]*SecondaryStorageSelector, error)
+	ListSecondaryStorageSelectorsAllWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams, opts ...PageOption) ([]*SecondaryStorageSelector, error)
+	ListSecondaryStorageSelectorsIter(p *ListSecondaryStorageSelectorsParams, opts ...PageOption) iter.Seq2[*SecondaryStorageSelector, error]
+	ListSecondaryStorageSelectorsIterWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams, opts ...PageOption) iter.Seq2[*SecondaryStorageSelector, error]
 	NewListSecondaryStorageSelectorsParams(zoneid string) *ListSecondaryStorageSelectorsParams
 	GetSecondaryStorageSelectorID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStorageSelectorIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
 	CreateSecondaryStorageSelector(p *CreateSecondaryStorageSelectorParams) (*CreateSecondaryStorageSelectorResponse, error)
+	CreateSecondaryStorageSelectorWithContext(ctx context.Context, p *CreateSecondaryStorageSelectorParams) (*CreateSecondaryStorageSelectorResponse, error)
 	NewCreateSecondaryStorageSelectorParams(description string, heuristicrule string, name string, hostType string, zoneid string) *CreateSecondaryStorageSelectorParams
 	RemoveSecondaryStorageSelector(p *RemoveSecondaryStorageSelectorParams) (*RemoveSecondaryStorageSelectorResponse, error)
+	RemoveSecondaryStorageSelectorWithContext(ctx context.Context, p *RemoveSecondaryStorageSelectorParams) (*RemoveSecondaryStorageSelectorResponse, error)
 	NewRemoveSecondaryStorageSelectorParams(id string) *RemoveSecondaryStorageSelectorParams
 	ListHostHAResources(p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error)
+	ListHostHAResourcesWithContext(ctx context.Context, p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error)
 	NewListHostHAResourcesParams() *ListHostHAResourcesParams
 	DeclareHostAsDegraded(p *DeclareHostAsDegradedParams) (*DeclareHostAsDegradedResponse, error)
+	DeclareHostAsDegradedWithContext(ctx context.Context, p *DeclareHostAsDegradedParams) (*DeclareHostAsDegradedResponse, error)
+	DeclareHostAsDegradedAsync(ctx context.Context, p *DeclareHostAsDegradedParams) (*Job, error)
 	NewDeclareHostAsDegradedParams(id string) *DeclareHostAsDegradedParams
 	UpdateSecondaryStorageSelector(p *UpdateSecondaryStorageSelectorParams) (*UpdateSecondaryStorageSelectorResponse, error)
+	UpdateSecondaryStorageSelectorWithContext(ctx context.Context, p *UpdateSecondaryStorageSelectorParams) (*UpdateSecondaryStorageSelectorResponse, error)
 	NewUpdateSecondaryStorageSelectorParams(heuristicrule string, id string) *UpdateSecondaryStorageSelectorParams
 }
 
@@ -132,7 +209,7 @@ func (p *AddBaremetalHostParams) toURLValues() url.Values {
 		u.Set("hosttags", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["ipaddress"]; found {
 		u.Set("ipaddress", v.(string))
@@ -159,6 +236,56 @@ func (p *AddBaremetalHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addBaremetalHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddBaremetalHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addBaremetalHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addBaremetalHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddBaremetalHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hypervisor", "podid", "url", "zoneid"},
+		lengths:  map[string]int{"allocationstate": 255, "clusterid": 255, "clustername": 255, "hypervisor": 255, "ipaddress": 255, "password": 255, "podid": 255, "url": 2048, "username": 255, "zoneid": 255},
+	}.validate("addBaremetalHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addBaremetalHost API, before the request is signed
+func (p *AddBaremetalHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddBaremetalHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddBaremetalHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addBaremetalHost", b, &p.p, map[string]paramDecoder{
+		"allocationstate":     decodeParam[string],
+		"clusterid":           decodeParam[string],
+		"clustername":         decodeParam[string],
+		"externaldetails":     decodeParam[map[string]string],
+		"hosttags":            decodeParam[[]string],
+		"hypervisor":          decodeParam[HypervisorType],
+		"ipaddress":           decodeParam[string],
+		"password":            decodeParam[string],
+		"podid":               decodeParam[string],
+		"storageaccessgroups": decodeParam[[]string],
+		"url":                 decodeParam[string],
+		"username":            decodeParam[string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddBaremetalHostParams) Clone() *AddBaremetalHostParams {
+	return &AddBaremetalHostParams{p: cloneParams(p.p)}
+}
+
 func (p *AddBaremetalHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -264,7 +391,7 @@ func (p *AddBaremetalHostParams) GetHosttags() ([]string, bool) {
 	return value, ok
 }
 
-func (p *AddBaremetalHostParams) SetHypervisor(v string) {
+func (p *AddBaremetalHostParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -277,11 +404,11 @@ func (p *AddBaremetalHostParams) ResetHypervisor() {
 	}
 }
 
-func (p *AddBaremetalHostParams) GetHypervisor() (string, bool) {
+func (p *AddBaremetalHostParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -434,7 +561,7 @@ func (p *AddBaremetalHostParams) GetZoneid() (string, bool) {
 
 // You should always use this function to get a new AddBaremetalHostParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewAddBaremetalHostParams(hypervisor string, podid string, url string, zoneid string) *AddBaremetalHostParams {
+func (s *HostService) NewAddBaremetalHostParams(hypervisor HypervisorType, podid string, url string, zoneid string) *AddBaremetalHostParams {
 	p := &AddBaremetalHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
@@ -446,13 +573,22 @@ func (s *HostService) NewAddBaremetalHostParams(hypervisor string, podid string,
 
 // Add a baremetal host
 func (s *HostService) AddBaremetalHost(p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
-	resp, err := s.cs.newPostRequest("addBaremetalHost", p.toURLValues())
+	return s.AddBaremetalHostWithContext(context.Background(), p)
+}
+
+// AddBaremetalHostWithContext is like AddBaremetalHost, but honours the cancellation and deadline of ctx
+func (s *HostService) AddBaremetalHostWithContext(ctx context.Context, p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addBaremetalHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddBaremetalHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addBaremetalHost", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -469,90 +605,108 @@ type AddBaremetalHostResponse struct {
 	Clustertype                      string                             `json:"clustertype"`
 	Cpuallocated                     string                             `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                             `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                              `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                          `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                             `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                            `json:"cpuloadaverage"`
-	Cpunumber                        int                                `json:"cpunumber"`
-	Cpusockets                       int                                `json:"cpusockets"`
-	Cpuspeed                         int64                              `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                          `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                            `json:"cpunumber"`
+	Cpusockets                       FlexInt                            `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                          `json:"cpuspeed"`
 	Cpuused                          string                             `json:"cpuused"`
 	Cpuwithoverprovisioning          string                             `json:"cpuwithoverprovisioning"`
-	Created                          string                             `json:"created"`
+	Created                          Time                               `json:"created"`
 	Details                          map[string]string                  `json:"details"`
-	Disconnected                     string                             `json:"disconnected"`
-	Disksizeallocated                int64                              `json:"disksizeallocated"`
-	Disksizetotal                    int64                              `json:"disksizetotal"`
-	Encryptionsupported              bool                               `json:"encryptionsupported"`
+	Disconnected                     Time                               `json:"disconnected"`
+	Disksizeallocated                FlexInt64                          `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                          `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                           `json:"encryptionsupported"`
 	Events                           string                             `json:"events"`
 	Explicithosttags                 string                             `json:"explicithosttags"`
 	Extensionid                      string                             `json:"extensionid"`
 	Extensionname                    string                             `json:"extensionname"`
 	Gpugroup                         []AddBaremetalHostResponseGpugroup `json:"gpugroup"`
-	Gputotal                         int64                              `json:"gputotal"`
-	Gpuused                          int64                              `json:"gpuused"`
-	Hahost                           bool                               `json:"hahost"`
-	Hasannotations                   bool                               `json:"hasannotations"`
-	Hasenoughcapacity                bool                               `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                          `json:"gputotal"`
+	Gpuused                          FlexInt64                          `json:"gpuused"`
+	Hahost                           FlexBool                           `json:"hahost"`
+	Hasannotations                   FlexBool                           `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                           `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse                  `json:"hostha"`
 	Hosttags                         string                             `json:"hosttags"`
 	Hypervisor                       string                             `json:"hypervisor"`
 	Hypervisorversion                string                             `json:"hypervisorversion"`
 	Id                               string                             `json:"id"`
 	Implicithosttags                 string                             `json:"implicithosttags"`
-	Instanceconversionsupported      bool                               `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                           `json:"instanceconversionsupported"`
 	Ipaddress                        string                             `json:"ipaddress"`
-	Islocalstorageactive             bool                               `json:"islocalstorageactive"`
-	Istagarule                       bool                               `json:"istagarule"`
+	Islocalstorageactive             FlexBool                           `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                           `json:"istagarule"`
 	JobID                            string                             `json:"jobid"`
-	Jobstatus                        int                                `json:"jobstatus"`
-	Lastannotated                    string                             `json:"lastannotated"`
-	Lastpinged                       string                             `json:"lastpinged"`
+	Jobstatus                        FlexInt                            `json:"jobstatus"`
+	Lastannotated                    Time                               `json:"lastannotated"`
+	Lastpinged                       Time                               `json:"lastpinged"`
 	Managementserverid               UUID                               `json:"managementserverid"`
 	Managementservername             string                             `json:"managementservername"`
-	Memoryallocated                  int64                              `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                              `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                          `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                          `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                             `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                              `json:"memorytotal"`
-	Memoryused                       int64                              `json:"memoryused"`
+	Memorytotal                      FlexInt64                          `json:"memorytotal"`
+	Memoryused                       FlexInt64                          `json:"memoryused"`
 	Memorywithoverprovisioning       string                             `json:"memorywithoverprovisioning"`
 	Name                             string                             `json:"name"`
-	Networkkbsread                   int64                              `json:"networkkbsread"`
-	Networkkbswrite                  int64                              `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                          `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                          `json:"networkkbswrite"`
 	Oscategoryid                     string                             `json:"oscategoryid"`
 	Oscategoryname                   string                             `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse        `json:"outofbandmanagement"`
 	Podid                            string                             `json:"podid"`
 	Podname                          string                             `json:"podname"`
 	Podstorageaccessgroups           string                             `json:"podstorageaccessgroups"`
-	Removed                          string                             `json:"removed"`
-	Resourcestate                    string                             `json:"resourcestate"`
+	Removed                          Time                               `json:"removed"`
+	Resourcestate                    HostResourceState                  `json:"resourcestate"`
 	State                            string                             `json:"state"`
 	Storageaccessgroups              string                             `json:"storageaccessgroups"`
-	Suitableformigration             bool                               `json:"suitableformigration"`
+	Suitableformigration             FlexBool                           `json:"suitableformigration"`
 	Type                             string                             `json:"type"`
-	Ueficapability                   bool                               `json:"ueficapability"`
+	Ueficapability                   FlexBool                           `json:"ueficapability"`
 	Username                         string                             `json:"username"`
 	Version                          string                             `json:"version"`
 	Virtualmachineid                 string                             `json:"virtualmachineid"`
 	Zoneid                           string                             `json:"zoneid"`
 	Zonename                         string                             `json:"zonename"`
 	Zonestorageaccessgroups          string                             `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *AddBaremetalHostResponse) UnmarshalJSON(b []byte) error {
+	type alias AddBaremetalHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddBaremetalHostResponseGpugroup struct {
 	Gpugroupname string                                 `json:"gpugroupname"`
 	Vgpu         []AddBaremetalHostResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage             `json:"-"`
+}
+
+func (r *AddBaremetalHostResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias AddBaremetalHostResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddBaremetalHostResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddBaremetalHostResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias AddBaremetalHostResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddGloboDnsHostParams struct {
@@ -579,6 +733,47 @@ func (p *AddGloboDnsHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addGloboDnsHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddGloboDnsHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addGloboDnsHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addGloboDnsHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddGloboDnsHostParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "physicalnetworkid", "url", "username"},
+		lengths:  map[string]int{"password": 255, "physicalnetworkid": 255, "url": 2048, "username": 255},
+	}.validate("addGloboDnsHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addGloboDnsHost API, before the request is signed
+func (p *AddGloboDnsHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddGloboDnsHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddGloboDnsHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addGloboDnsHost", b, &p.p, map[string]paramDecoder{
+		"password":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"url":               decodeParam[string],
+		"username":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddGloboDnsHostParams) Clone() *AddGloboDnsHostParams {
+	return &AddGloboDnsHostParams{p: cloneParams(p.p)}
+}
+
 func (p *AddGloboDnsHostParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -677,27 +872,39 @@ func (s *HostService) NewAddGloboDnsHostParams(password string, physicalnetworki
 
 // Adds the GloboDNS external host
 func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
-	resp, err := s.cs.newPostRequest("addGloboDnsHost", p.toURLValues())
+	return s.AddGloboDnsHostWithContext(context.Background(), p)
+}
+
+// AddGloboDnsHostWithContext is like AddGloboDnsHost, but honours the cancellation and deadline of ctx
+func (s *HostService) AddGloboDnsHostWithContext(ctx context.Context, p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addGloboDnsHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddGloboDnsHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addGloboDnsHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addGloboDnsHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addGloboDnsHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -705,11 +912,31 @@ func (s *HostService) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHos
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddGloboDnsHost async job into r
+func (r *AddGloboDnsHostResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AddGloboDnsHostAsync starts the async job of AddGloboDnsHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) AddGloboDnsHostAsync(ctx context.Context, p *AddGloboDnsHostParams) (*Job, error) {
+	r, err := s.AddGloboDnsHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addGloboDnsHost", r.JobID), nil
+}
+
 type AddGloboDnsHostResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddGloboDnsHostResponse) UnmarshalJSON(b []byte) error {
+	type alias AddGloboDnsHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddHostParams struct {
@@ -742,7 +969,7 @@ func (p *AddHostParams) toURLValues() url.Values {
 		u.Set("hosttags", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["password"]; found {
 		u.Set("password", v.(string))
@@ -766,6 +993,55 @@ func (p *AddHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hypervisor", "podid", "url", "zoneid"},
+		lengths:  map[string]int{"allocationstate": 255, "clusterid": 255, "clustername": 255, "hypervisor": 255, "password": 255, "podid": 255, "url": 2048, "username": 255, "zoneid": 255},
+	}.validate("addHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addHost API, before the request is signed
+func (p *AddHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addHost", b, &p.p, map[string]paramDecoder{
+		"allocationstate":     decodeParam[string],
+		"clusterid":           decodeParam[string],
+		"clustername":         decodeParam[string],
+		"externaldetails":     decodeParam[map[string]string],
+		"hosttags":            decodeParam[[]string],
+		"hypervisor":          decodeParam[HypervisorType],
+		"password":            decodeParam[string],
+		"podid":               decodeParam[string],
+		"storageaccessgroups": decodeParam[[]string],
+		"url":                 decodeParam[string],
+		"username":            decodeParam[string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddHostParams) Clone() *AddHostParams {
+	return &AddHostParams{p: cloneParams(p.p)}
+}
+
 func (p *AddHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -871,7 +1147,7 @@ func (p *AddHostParams) GetHosttags() ([]string, bool) {
 	return value, ok
 }
 
-func (p *AddHostParams) SetHypervisor(v string) {
+func (p *AddHostParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -884,11 +1160,11 @@ func (p *AddHostParams) ResetHypervisor() {
 	}
 }
 
-func (p *AddHostParams) GetHypervisor() (string, bool) {
+func (p *AddHostParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -1020,7 +1296,7 @@ func (p *AddHostParams) GetZoneid() (string, bool) {
 
 // You should always use this function to get a new AddHostParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewAddHostParams(hypervisor string, podid string, url string, zoneid string) *AddHostParams {
+func (s *HostService) NewAddHostParams(hypervisor HypervisorType, podid string, url string, zoneid string) *AddHostParams {
 	p := &AddHostParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
@@ -1032,7 +1308,16 @@ func (s *HostService) NewAddHostParams(hypervisor string, podid string, url stri
 
 // Adds a new host.
 func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
-	resp, err := s.cs.newPostRequest("addHost", p.toURLValues())
+	return s.AddHostWithContext(context.Background(), p)
+}
+
+// AddHostWithContext is like AddHost, but honours the cancellation and deadline of ctx
+func (s *HostService) AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1042,7 +1327,7 @@ func (s *HostService) AddHost(p *AddHostParams) (*AddHostResponse, error) {
 	}
 
 	var r AddHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addHost", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1059,90 +1344,108 @@ type AddHostResponse struct {
 	Clustertype                      string                      `json:"clustertype"`
 	Cpuallocated                     string                      `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                      `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                       `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                   `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                      `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                     `json:"cpuloadaverage"`
-	Cpunumber                        int                         `json:"cpunumber"`
-	Cpusockets                       int                         `json:"cpusockets"`
-	Cpuspeed                         int64                       `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                   `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                     `json:"cpunumber"`
+	Cpusockets                       FlexInt                     `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                   `json:"cpuspeed"`
 	Cpuused                          string                      `json:"cpuused"`
 	Cpuwithoverprovisioning          string                      `json:"cpuwithoverprovisioning"`
-	Created                          string                      `json:"created"`
+	Created                          Time                        `json:"created"`
 	Details                          map[string]string           `json:"details"`
-	Disconnected                     string                      `json:"disconnected"`
-	Disksizeallocated                int64                       `json:"disksizeallocated"`
-	Disksizetotal                    int64                       `json:"disksizetotal"`
-	Encryptionsupported              bool                        `json:"encryptionsupported"`
+	Disconnected                     Time                        `json:"disconnected"`
+	Disksizeallocated                FlexInt64                   `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                   `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                    `json:"encryptionsupported"`
 	Events                           string                      `json:"events"`
 	Explicithosttags                 string                      `json:"explicithosttags"`
 	Extensionid                      string                      `json:"extensionid"`
 	Extensionname                    string                      `json:"extensionname"`
 	Gpugroup                         []AddHostResponseGpugroup   `json:"gpugroup"`
-	Gputotal                         int64                       `json:"gputotal"`
-	Gpuused                          int64                       `json:"gpuused"`
-	Hahost                           bool                        `json:"hahost"`
-	Hasannotations                   bool                        `json:"hasannotations"`
-	Hasenoughcapacity                bool                        `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                   `json:"gputotal"`
+	Gpuused                          FlexInt64                   `json:"gpuused"`
+	Hahost                           FlexBool                    `json:"hahost"`
+	Hasannotations                   FlexBool                    `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                    `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse           `json:"hostha"`
 	Hosttags                         string                      `json:"hosttags"`
 	Hypervisor                       string                      `json:"hypervisor"`
 	Hypervisorversion                string                      `json:"hypervisorversion"`
 	Id                               string                      `json:"id"`
 	Implicithosttags                 string                      `json:"implicithosttags"`
-	Instanceconversionsupported      bool                        `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                    `json:"instanceconversionsupported"`
 	Ipaddress                        string                      `json:"ipaddress"`
-	Islocalstorageactive             bool                        `json:"islocalstorageactive"`
-	Istagarule                       bool                        `json:"istagarule"`
+	Islocalstorageactive             FlexBool                    `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                    `json:"istagarule"`
 	JobID                            string                      `json:"jobid"`
-	Jobstatus                        int                         `json:"jobstatus"`
-	Lastannotated                    string                      `json:"lastannotated"`
-	Lastpinged                       string                      `json:"lastpinged"`
+	Jobstatus                        FlexInt                     `json:"jobstatus"`
+	Lastannotated                    Time                        `json:"lastannotated"`
+	Lastpinged                       Time                        `json:"lastpinged"`
 	Managementserverid               UUID                        `json:"managementserverid"`
 	Managementservername             string                      `json:"managementservername"`
-	Memoryallocated                  int64                       `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                       `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                   `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                   `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                      `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                       `json:"memorytotal"`
-	Memoryused                       int64                       `json:"memoryused"`
+	Memorytotal                      FlexInt64                   `json:"memorytotal"`
+	Memoryused                       FlexInt64                   `json:"memoryused"`
 	Memorywithoverprovisioning       string                      `json:"memorywithoverprovisioning"`
 	Name                             string                      `json:"name"`
-	Networkkbsread                   int64                       `json:"networkkbsread"`
-	Networkkbswrite                  int64                       `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                   `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                   `json:"networkkbswrite"`
 	Oscategoryid                     string                      `json:"oscategoryid"`
 	Oscategoryname                   string                      `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse `json:"outofbandmanagement"`
 	Podid                            string                      `json:"podid"`
 	Podname                          string                      `json:"podname"`
 	Podstorageaccessgroups           string                      `json:"podstorageaccessgroups"`
-	Removed                          string                      `json:"removed"`
-	Resourcestate                    string                      `json:"resourcestate"`
+	Removed                          Time                        `json:"removed"`
+	Resourcestate                    HostResourceState           `json:"resourcestate"`
 	State                            string                      `json:"state"`
 	Storageaccessgroups              string                      `json:"storageaccessgroups"`
-	Suitableformigration             bool                        `json:"suitableformigration"`
+	Suitableformigration             FlexBool                    `json:"suitableformigration"`
 	Type                             string                      `json:"type"`
-	Ueficapability                   bool                        `json:"ueficapability"`
+	Ueficapability                   FlexBool                    `json:"ueficapability"`
 	Username                         string                      `json:"username"`
 	Version                          string                      `json:"version"`
 	Virtualmachineid                 string                      `json:"virtualmachineid"`
 	Zoneid                           string                      `json:"zoneid"`
 	Zonename                         string                      `json:"zonename"`
 	Zonestorageaccessgroups          string                      `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *AddHostResponse) UnmarshalJSON(b []byte) error {
+	type alias AddHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddHostResponseGpugroup struct {
 	Gpugroupname string                        `json:"gpugroupname"`
 	Vgpu         []AddHostResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage    `json:"-"`
+}
+
+func (r *AddHostResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias AddHostResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddHostResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddHostResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias AddHostResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddSecondaryStorageParams struct {
@@ -1169,6 +1472,46 @@ func (p *AddSecondaryStorageParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addSecondaryStorage API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddSecondaryStorageParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addSecondaryStorage", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addSecondaryStorage API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddSecondaryStorageParams) Validate() error {
+	return paramRules{
+		required: []string{"url"},
+		lengths:  map[string]int{"url": 2048, "zoneid": 255},
+	}.validate("addSecondaryStorage", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addSecondaryStorage API, before the request is signed
+func (p *AddSecondaryStorageParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddSecondaryStorageParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddSecondaryStorageParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addSecondaryStorage", b, &p.p, map[string]paramDecoder{
+		"details": decodeParam[map[string]string],
+		"url":     decodeParam[string],
+		"zoneid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddSecondaryStorageParams) Clone() *AddSecondaryStorageParams {
+	return &AddSecondaryStorageParams{p: cloneParams(p.p)}
+}
+
 func (p *AddSecondaryStorageParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1243,13 +1586,22 @@ func (s *HostService) NewAddSecondaryStorageParams(url string) *AddSecondaryStor
 
 // Adds secondary storage.
 func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
-	resp, err := s.cs.newPostRequest("addSecondaryStorage", p.toURLValues())
+	return s.AddSecondaryStorageWithContext(context.Background(), p)
+}
+
+// AddSecondaryStorageWithContext is like AddSecondaryStorage, but honours the cancellation and deadline of ctx
+func (s *HostService) AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addSecondaryStorage", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddSecondaryStorageResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addSecondaryStorage", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1257,20 +1609,26 @@ func (s *HostService) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSec
 }
 
 type AddSecondaryStorageResponse struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddSecondaryStorageResponse) UnmarshalJSON(b []byte) error {
+	type alias AddSecondaryStorageResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelHostMaintenanceParams struct {
@@ -1288,6 +1646,44 @@ func (p *CancelHostMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the cancelHostMaintenance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CancelHostMaintenanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("cancelHostMaintenance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the cancelHostMaintenance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CancelHostMaintenanceParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("cancelHostMaintenance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the cancelHostMaintenance API, before the request is signed
+func (p *CancelHostMaintenanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CancelHostMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CancelHostMaintenanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("cancelHostMaintenance", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CancelHostMaintenanceParams) Clone() *CancelHostMaintenanceParams {
+	return &CancelHostMaintenanceParams{p: cloneParams(p.p)}
+}
+
 func (p *CancelHostMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1320,32 +1716,39 @@ func (s *HostService) NewCancelHostMaintenanceParams(id string) *CancelHostMaint
 
 // Cancels host maintenance.
 func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
-	resp, err := s.cs.newPostRequest("cancelHostMaintenance", p.toURLValues())
+	return s.CancelHostMaintenanceWithContext(context.Background(), p)
+}
+
+// CancelHostMaintenanceWithContext is like CancelHostMaintenance, but honours the cancellation and deadline of ctx
+func (s *HostService) CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "cancelHostMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CancelHostMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("cancelHostMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "cancelHostMaintenance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("cancelHostMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1353,6 +1756,25 @@ func (s *HostService) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*Ca
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CancelHostMaintenance async job into r
+func (r *CancelHostMaintenanceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CancelHostMaintenanceAsync starts the async job of CancelHostMaintenance without waiting for it to finish, and returns a handle to the job
+func (s *HostService) CancelHostMaintenanceAsync(ctx context.Context, p *CancelHostMaintenanceParams) (*Job, error) {
+	r, err := s.CancelHostMaintenanceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("cancelHostMaintenance", r.JobID), nil
+}
+
 type CancelHostMaintenanceResponse struct {
 	Annotation                       string                                  `json:"annotation"`
 	Arch                             string                                  `json:"arch"`
@@ -1363,90 +1785,108 @@ type CancelHostMaintenanceResponse struct {
 	Clustertype                      string                                  `json:"clustertype"`
 	Cpuallocated                     string                                  `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                                  `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                                   `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                               `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                                  `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                                 `json:"cpuloadaverage"`
-	Cpunumber                        int                                     `json:"cpunumber"`
-	Cpusockets                       int                                     `json:"cpusockets"`
-	Cpuspeed                         int64                                   `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                               `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                                 `json:"cpunumber"`
+	Cpusockets                       FlexInt                                 `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                               `json:"cpuspeed"`
 	Cpuused                          string                                  `json:"cpuused"`
 	Cpuwithoverprovisioning          string                                  `json:"cpuwithoverprovisioning"`
-	Created                          string                                  `json:"created"`
+	Created                          Time                                    `json:"created"`
 	Details                          map[string]string                       `json:"details"`
-	Disconnected                     string                                  `json:"disconnected"`
-	Disksizeallocated                int64                                   `json:"disksizeallocated"`
-	Disksizetotal                    int64                                   `json:"disksizetotal"`
-	Encryptionsupported              bool                                    `json:"encryptionsupported"`
+	Disconnected                     Time                                    `json:"disconnected"`
+	Disksizeallocated                FlexInt64                               `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                               `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                                `json:"encryptionsupported"`
 	Events                           string                                  `json:"events"`
 	Explicithosttags                 string                                  `json:"explicithosttags"`
 	Extensionid                      string                                  `json:"extensionid"`
 	Extensionname                    string                                  `json:"extensionname"`
 	Gpugroup                         []CancelHostMaintenanceResponseGpugroup `json:"gpugroup"`
-	Gputotal                         int64                                   `json:"gputotal"`
-	Gpuused                          int64                                   `json:"gpuused"`
-	Hahost                           bool                                    `json:"hahost"`
-	Hasannotations                   bool                                    `json:"hasannotations"`
-	Hasenoughcapacity                bool                                    `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                               `json:"gputotal"`
+	Gpuused                          FlexInt64                               `json:"gpuused"`
+	Hahost                           FlexBool                                `json:"hahost"`
+	Hasannotations                   FlexBool                                `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                                `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse                       `json:"hostha"`
 	Hosttags                         string                                  `json:"hosttags"`
 	Hypervisor                       string                                  `json:"hypervisor"`
 	Hypervisorversion                string                                  `json:"hypervisorversion"`
 	Id                               string                                  `json:"id"`
 	Implicithosttags                 string                                  `json:"implicithosttags"`
-	Instanceconversionsupported      bool                                    `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                                `json:"instanceconversionsupported"`
 	Ipaddress                        string                                  `json:"ipaddress"`
-	Islocalstorageactive             bool                                    `json:"islocalstorageactive"`
-	Istagarule                       bool                                    `json:"istagarule"`
+	Islocalstorageactive             FlexBool                                `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                                `json:"istagarule"`
 	JobID                            string                                  `json:"jobid"`
-	Jobstatus                        int                                     `json:"jobstatus"`
-	Lastannotated                    string                                  `json:"lastannotated"`
-	Lastpinged                       string                                  `json:"lastpinged"`
+	Jobstatus                        FlexInt                                 `json:"jobstatus"`
+	Lastannotated                    Time                                    `json:"lastannotated"`
+	Lastpinged                       Time                                    `json:"lastpinged"`
 	Managementserverid               UUID                                    `json:"managementserverid"`
 	Managementservername             string                                  `json:"managementservername"`
-	Memoryallocated                  int64                                   `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                                   `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                               `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                               `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                                  `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                                   `json:"memorytotal"`
-	Memoryused                       int64                                   `json:"memoryused"`
+	Memorytotal                      FlexInt64                               `json:"memorytotal"`
+	Memoryused                       FlexInt64                               `json:"memoryused"`
 	Memorywithoverprovisioning       string                                  `json:"memorywithoverprovisioning"`
 	Name                             string                                  `json:"name"`
-	Networkkbsread                   int64                                   `json:"networkkbsread"`
-	Networkkbswrite                  int64                                   `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                               `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                               `json:"networkkbswrite"`
 	Oscategoryid                     string                                  `json:"oscategoryid"`
 	Oscategoryname                   string                                  `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse             `json:"outofbandmanagement"`
 	Podid                            string                                  `json:"podid"`
 	Podname                          string                                  `json:"podname"`
 	Podstorageaccessgroups           string                                  `json:"podstorageaccessgroups"`
-	Removed                          string                                  `json:"removed"`
-	Resourcestate                    string                                  `json:"resourcestate"`
+	Removed                          Time                                    `json:"removed"`
+	Resourcestate                    HostResourceState                       `json:"resourcestate"`
 	State                            string                                  `json:"state"`
 	Storageaccessgroups              string                                  `json:"storageaccessgroups"`
-	Suitableformigration             bool                                    `json:"suitableformigration"`
+	Suitableformigration             FlexBool                                `json:"suitableformigration"`
 	Type                             string                                  `json:"type"`
-	Ueficapability                   bool                                    `json:"ueficapability"`
+	Ueficapability                   FlexBool                                `json:"ueficapability"`
 	Username                         string                                  `json:"username"`
 	Version                          string                                  `json:"version"`
 	Virtualmachineid                 string                                  `json:"virtualmachineid"`
 	Zoneid                           string                                  `json:"zoneid"`
 	Zonename                         string                                  `json:"zonename"`
 	Zonestorageaccessgroups          string                                  `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage              `json:"-"`
+}
+
+func (r *CancelHostMaintenanceResponse) UnmarshalJSON(b []byte) error {
+	type alias CancelHostMaintenanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelHostMaintenanceResponseGpugroup struct {
 	Gpugroupname string                                      `json:"gpugroupname"`
 	Vgpu         []CancelHostMaintenanceResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage                  `json:"-"`
+}
+
+func (r *CancelHostMaintenanceResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias CancelHostMaintenanceResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelHostMaintenanceResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CancelHostMaintenanceResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias CancelHostMaintenanceResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ConfigureHAForHostParams struct {
@@ -1467,6 +1907,45 @@ func (p *ConfigureHAForHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configureHAForHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigureHAForHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configureHAForHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configureHAForHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigureHAForHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid", "provider"},
+		lengths:  map[string]int{"hostid": 255, "provider": 255},
+	}.validate("configureHAForHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configureHAForHost API, before the request is signed
+func (p *ConfigureHAForHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigureHAForHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigureHAForHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configureHAForHost", b, &p.p, map[string]paramDecoder{
+		"hostid":   decodeParam[string],
+		"provider": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigureHAForHostParams) Clone() *ConfigureHAForHostParams {
+	return &ConfigureHAForHostParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigureHAForHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1521,32 +2000,39 @@ func (s *HostService) NewConfigureHAForHostParams(hostid string, provider string
 
 // Configures HA for a host
 func (s *HostService) ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
-	resp, err := s.cs.newPostRequest("configureHAForHost", p.toURLValues())
+	return s.ConfigureHAForHostWithContext(context.Background(), p)
+}
+
+// ConfigureHAForHostWithContext is like ConfigureHAForHost, but honours the cancellation and deadline of ctx
+func (s *HostService) ConfigureHAForHostWithContext(ctx context.Context, p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "configureHAForHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r HAForHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configureHAForHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "configureHAForHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("configureHAForHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1554,14 +2040,39 @@ func (s *HostService) ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHos
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ConfigureHAForHost async job into r
+func (r *HAForHostResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ConfigureHAForHostAsync starts the async job of ConfigureHAForHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) ConfigureHAForHostAsync(ctx context.Context, p *ConfigureHAForHostParams) (*Job, error) {
+	r, err := s.ConfigureHAForHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("configureHAForHost", r.JobID), nil
+}
+
 type HAForHostResponse struct {
-	Haenable   bool   `json:"haenable"`
-	Haprovider string `json:"haprovider"`
-	Hastate    string `json:"hastate"`
-	Hostid     string `json:"hostid"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Status     bool   `json:"status"`
+	Haenable   FlexBool                   `json:"haenable"`
+	Haprovider string                     `json:"haprovider"`
+	Hastate    string                     `json:"hastate"`
+	Hostid     string                     `json:"hostid"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Status     FlexBool                   `json:"status"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HAForHostResponse) UnmarshalJSON(b []byte) error {
+	type alias HAForHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableHAForHostParams struct {
@@ -1579,6 +2090,44 @@ func (p *EnableHAForHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableHAForHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableHAForHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableHAForHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableHAForHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableHAForHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255},
+	}.validate("enableHAForHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableHAForHost API, before the request is signed
+func (p *EnableHAForHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableHAForHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableHAForHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableHAForHost", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableHAForHostParams) Clone() *EnableHAForHostParams {
+	return &EnableHAForHostParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableHAForHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1611,32 +2160,39 @@ func (s *HostService) NewEnableHAForHostParams(hostid string) *EnableHAForHostPa
 
 // Enables HA for a host
 func (s *HostService) EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
-	resp, err := s.cs.newPostRequest("enableHAForHost", p.toURLValues())
+	return s.EnableHAForHostWithContext(context.Background(), p)
+}
+
+// EnableHAForHostWithContext is like EnableHAForHost, but honours the cancellation and deadline of ctx
+func (s *HostService) EnableHAForHostWithContext(ctx context.Context, p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableHAForHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableHAForHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableHAForHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableHAForHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableHAForHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1644,14 +2200,39 @@ func (s *HostService) EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHos
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableHAForHost async job into r
+func (r *EnableHAForHostResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// EnableHAForHostAsync starts the async job of EnableHAForHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) EnableHAForHostAsync(ctx context.Context, p *EnableHAForHostParams) (*Job, error) {
+	r, err := s.EnableHAForHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableHAForHost", r.JobID), nil
+}
+
 type EnableHAForHostResponse struct {
-	Haenable   bool   `json:"haenable"`
-	Haprovider string `json:"haprovider"`
-	Hastate    string `json:"hastate"`
-	Hostid     string `json:"hostid"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Status     bool   `json:"status"`
+	Haenable   FlexBool                   `json:"haenable"`
+	Haprovider string                     `json:"haprovider"`
+	Hastate    string                     `json:"hastate"`
+	Hostid     string                     `json:"hostid"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Status     FlexBool                   `json:"status"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableHAForHostResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableHAForHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicateHostParams struct {
@@ -1675,6 +2256,46 @@ func (p *DedicateHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the dedicateHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicateHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicateHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicateHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicateHostParams) Validate() error {
+	return paramRules{
+		required: []string{"domainid", "hostid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "hostid": 255},
+	}.validate("dedicateHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicateHost API, before the request is signed
+func (p *DedicateHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicateHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicateHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicateHost", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"hostid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicateHostParams) Clone() *DedicateHostParams {
+	return &DedicateHostParams{p: cloneParams(p.p)}
+}
+
 func (p *DedicateHostParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1750,32 +2371,39 @@ func (s *HostService) NewDedicateHostParams(domainid string, hostid string) *Ded
 
 // Dedicates a host.
 func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicateHost", p.toURLValues())
+	return s.DedicateHostWithContext(context.Background(), p)
+}
+
+// DedicateHostWithContext is like DedicateHost, but honours the cancellation and deadline of ctx
+func (s *HostService) DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicateHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicateHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicateHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "dedicateHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("dedicateHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1783,15 +2411,40 @@ func (s *HostService) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DedicateHost async job into r
+func (r *DedicateHostResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DedicateHostAsync starts the async job of DedicateHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) DedicateHostAsync(ctx context.Context, p *DedicateHostParams) (*Job, error) {
+	r, err := s.DedicateHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("dedicateHost", r.JobID), nil
+}
+
 type DedicateHostResponse struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Domainid        string `json:"domainid"`
-	Hostid          string `json:"hostid"`
-	Hostname        string `json:"hostname"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Domainid        string                     `json:"domainid"`
+	Hostid          string                     `json:"hostid"`
+	Hostname        string                     `json:"hostname"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicateHostResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicateHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteHostParams struct {
@@ -1817,6 +2470,46 @@ func (p *DeleteHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteHostParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteHost API, before the request is signed
+func (p *DeleteHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteHost", b, &p.p, map[string]paramDecoder{
+		"forced":                   decodeParam[bool],
+		"forcedestroylocalstorage": decodeParam[bool],
+		"id":                       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteHostParams) Clone() *DeleteHostParams {
+	return &DeleteHostParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteHostParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1891,13 +2584,22 @@ func (s *HostService) NewDeleteHostParams(id string) *DeleteHostParams {
 
 // Deletes a host.
 func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteHost", p.toURLValues())
+	return s.DeleteHostWithContext(context.Background(), p)
+}
+
+// DeleteHostWithContext is like DeleteHost, but honours the cancellation and deadline of ctx
+func (s *HostService) DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteHost", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1905,10 +2607,11 @@ func (s *HostService) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, erro
 }
 
 type DeleteHostResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteHostResponse) UnmarshalJSON(b []byte) error {
@@ -1935,7 +2638,7 @@ func (r *DeleteHostResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteHostResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableHAForHostParams struct {
@@ -1953,11 +2656,49 @@ func (p *DisableHAForHostParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *DisableHAForHostParams) SetHostid(v string) {
-	if p.p == nil {
-		p.p = make(map[string]interface{})
-	}
-	p.p["hostid"] = v
+// ValidateAgainst checks the parameters against the disableHAForHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableHAForHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableHAForHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableHAForHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableHAForHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255},
+	}.validate("disableHAForHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableHAForHost API, before the request is signed
+func (p *DisableHAForHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableHAForHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableHAForHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableHAForHost", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableHAForHostParams) Clone() *DisableHAForHostParams {
+	return &DisableHAForHostParams{p: cloneParams(p.p)}
+}
+
+func (p *DisableHAForHostParams) SetHostid(v string) {
+	if p.p == nil {
+		p.p = make(map[string]interface{})
+	}
+	p.p["hostid"] = v
 }
 
 func (p *DisableHAForHostParams) ResetHostid() {
@@ -1985,32 +2726,39 @@ func (s *HostService) NewDisableHAForHostParams(hostid string) *DisableHAForHost
 
 // Disables HA for a host
 func (s *HostService) DisableHAForHost(p *DisableHAForHostParams) (*DisableHAForHostResponse, error) {
-	resp, err := s.cs.newPostRequest("disableHAForHost", p.toURLValues())
+	return s.DisableHAForHostWithContext(context.Background(), p)
+}
+
+// DisableHAForHostWithContext is like DisableHAForHost, but honours the cancellation and deadline of ctx
+func (s *HostService) DisableHAForHostWithContext(ctx context.Context, p *DisableHAForHostParams) (*DisableHAForHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableHAForHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableHAForHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableHAForHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableHAForHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableHAForHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2018,14 +2766,39 @@ func (s *HostService) DisableHAForHost(p *DisableHAForHostParams) (*DisableHAFor
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableHAForHost async job into r
+func (r *DisableHAForHostResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DisableHAForHostAsync starts the async job of DisableHAForHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) DisableHAForHostAsync(ctx context.Context, p *DisableHAForHostParams) (*Job, error) {
+	r, err := s.DisableHAForHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableHAForHost", r.JobID), nil
+}
+
 type DisableHAForHostResponse struct {
-	Haenable   bool   `json:"haenable"`
-	Haprovider string `json:"haprovider"`
-	Hastate    string `json:"hastate"`
-	Hostid     string `json:"hostid"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Status     bool   `json:"status"`
+	Haenable   FlexBool                   `json:"haenable"`
+	Haprovider string                     `json:"haprovider"`
+	Hastate    string                     `json:"hastate"`
+	Hostid     string                     `json:"hostid"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Status     FlexBool                   `json:"status"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableHAForHostResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableHAForHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableOutOfBandManagementForHostParams struct {
@@ -2043,6 +2816,44 @@ func (p *DisableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableOutOfBandManagementForHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableOutOfBandManagementForHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableOutOfBandManagementForHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableOutOfBandManagementForHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableOutOfBandManagementForHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255},
+	}.validate("disableOutOfBandManagementForHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableOutOfBandManagementForHost API, before the request is signed
+func (p *DisableOutOfBandManagementForHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableOutOfBandManagementForHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableOutOfBandManagementForHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableOutOfBandManagementForHost", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableOutOfBandManagementForHostParams) Clone() *DisableOutOfBandManagementForHostParams {
+	return &DisableOutOfBandManagementForHostParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableOutOfBandManagementForHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2075,32 +2886,39 @@ func (s *HostService) NewDisableOutOfBandManagementForHostParams(hostid string)
 
 // Disables out-of-band management for a host
 func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newPostRequest("disableOutOfBandManagementForHost", p.toURLValues())
+	return s.DisableOutOfBandManagementForHostWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForHostWithContext is like DisableOutOfBandManagementForHost, but honours the cancellation and deadline of ctx
+func (s *HostService) DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableOutOfBandManagementForHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableOutOfBandManagementForHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableOutOfBandManagementForHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableOutOfBandManagementForHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableOutOfBandManagementForHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2108,20 +2926,45 @@ func (s *HostService) DisableOutOfBandManagementForHost(p *DisableOutOfBandManag
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableOutOfBandManagementForHost async job into r
+func (r *DisableOutOfBandManagementForHostResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DisableOutOfBandManagementForHostAsync starts the async job of DisableOutOfBandManagementForHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) DisableOutOfBandManagementForHostAsync(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*Job, error) {
+	r, err := s.DisableOutOfBandManagementForHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableOutOfBandManagementForHost", r.JobID), nil
+}
+
 type DisableOutOfBandManagementForHostResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableOutOfBandManagementForHostResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableOutOfBandManagementForHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableOutOfBandManagementForHostParams struct {
@@ -2139,6 +2982,44 @@ func (p *EnableOutOfBandManagementForHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableOutOfBandManagementForHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableOutOfBandManagementForHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableOutOfBandManagementForHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableOutOfBandManagementForHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableOutOfBandManagementForHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255},
+	}.validate("enableOutOfBandManagementForHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableOutOfBandManagementForHost API, before the request is signed
+func (p *EnableOutOfBandManagementForHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableOutOfBandManagementForHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableOutOfBandManagementForHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableOutOfBandManagementForHost", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableOutOfBandManagementForHostParams) Clone() *EnableOutOfBandManagementForHostParams {
+	return &EnableOutOfBandManagementForHostParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableOutOfBandManagementForHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2171,32 +3052,39 @@ func (s *HostService) NewEnableOutOfBandManagementForHostParams(hostid string) *
 
 // Enables out-of-band management for a host
 func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
-	resp, err := s.cs.newPostRequest("enableOutOfBandManagementForHost", p.toURLValues())
+	return s.EnableOutOfBandManagementForHostWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForHostWithContext is like EnableOutOfBandManagementForHost, but honours the cancellation and deadline of ctx
+func (s *HostService) EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableOutOfBandManagementForHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableOutOfBandManagementForHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableOutOfBandManagementForHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableOutOfBandManagementForHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableOutOfBandManagementForHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2204,20 +3092,45 @@ func (s *HostService) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagem
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableOutOfBandManagementForHost async job into r
+func (r *EnableOutOfBandManagementForHostResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// EnableOutOfBandManagementForHostAsync starts the async job of EnableOutOfBandManagementForHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) EnableOutOfBandManagementForHostAsync(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*Job, error) {
+	r, err := s.EnableOutOfBandManagementForHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableOutOfBandManagementForHost", r.JobID), nil
+}
+
 type EnableOutOfBandManagementForHostResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableOutOfBandManagementForHostResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableOutOfBandManagementForHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type FindHostsForMigrationParams struct {
@@ -2246,6 +3159,47 @@ func (p *FindHostsForMigrationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the findHostsForMigration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *FindHostsForMigrationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("findHostsForMigration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the findHostsForMigration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *FindHostsForMigrationParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"keyword": 255, "virtualmachineid": 255},
+	}.validate("findHostsForMigration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the findHostsForMigration API, before the request is signed
+func (p *FindHostsForMigrationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *FindHostsForMigrationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *FindHostsForMigrationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("findHostsForMigration", b, &p.p, map[string]paramDecoder{
+		"keyword":          decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *FindHostsForMigrationParams) Clone() *FindHostsForMigrationParams {
+	return &FindHostsForMigrationParams{p: cloneParams(p.p)}
+}
+
 func (p *FindHostsForMigrationParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2341,13 +3295,22 @@ func (s *HostService) NewFindHostsForMigrationParams(virtualmachineid string) *F
 
 // Find hosts suitable for migrating an Instance.
 func (s *HostService) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findHostsForMigration", p.toURLValues())
+	return s.FindHostsForMigrationWithContext(context.Background(), p)
+}
+
+// FindHostsForMigrationWithContext is like FindHostsForMigration, but honours the cancellation and deadline of ctx
+func (s *HostService) FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "findHostsForMigration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r FindHostsForMigrationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("findHostsForMigration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2369,91 +3332,109 @@ type HostForMigration struct {
 	Clustertype                      string                      `json:"clustertype"`
 	Cpuallocated                     string                      `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                      `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                       `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                   `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                      `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                     `json:"cpuloadaverage"`
-	Cpunumber                        int                         `json:"cpunumber"`
-	Cpusockets                       int                         `json:"cpusockets"`
-	Cpuspeed                         int64                       `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                   `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                     `json:"cpunumber"`
+	Cpusockets                       FlexInt                     `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                   `json:"cpuspeed"`
 	Cpuused                          string                      `json:"cpuused"`
 	Cpuwithoverprovisioning          string                      `json:"cpuwithoverprovisioning"`
-	Created                          string                      `json:"created"`
+	Created                          Time                        `json:"created"`
 	Details                          map[string]string           `json:"details"`
-	Disconnected                     string                      `json:"disconnected"`
-	Disksizeallocated                int64                       `json:"disksizeallocated"`
-	Disksizetotal                    int64                       `json:"disksizetotal"`
-	Encryptionsupported              bool                        `json:"encryptionsupported"`
+	Disconnected                     Time                        `json:"disconnected"`
+	Disksizeallocated                FlexInt64                   `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                   `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                    `json:"encryptionsupported"`
 	Events                           string                      `json:"events"`
 	Explicithosttags                 string                      `json:"explicithosttags"`
 	Extensionid                      string                      `json:"extensionid"`
 	Extensionname                    string                      `json:"extensionname"`
 	Gpugroup                         []HostForMigrationGpugroup  `json:"gpugroup"`
-	Gputotal                         int64                       `json:"gputotal"`
-	Gpuused                          int64                       `json:"gpuused"`
-	Hahost                           bool                        `json:"hahost"`
-	Hasannotations                   bool                        `json:"hasannotations"`
-	Hasenoughcapacity                bool                        `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                   `json:"gputotal"`
+	Gpuused                          FlexInt64                   `json:"gpuused"`
+	Hahost                           FlexBool                    `json:"hahost"`
+	Hasannotations                   FlexBool                    `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                    `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse           `json:"hostha"`
 	Hosttags                         string                      `json:"hosttags"`
 	Hypervisor                       string                      `json:"hypervisor"`
 	Hypervisorversion                string                      `json:"hypervisorversion"`
 	Id                               string                      `json:"id"`
 	Implicithosttags                 string                      `json:"implicithosttags"`
-	Instanceconversionsupported      bool                        `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                    `json:"instanceconversionsupported"`
 	Ipaddress                        string                      `json:"ipaddress"`
-	Islocalstorageactive             bool                        `json:"islocalstorageactive"`
-	Istagarule                       bool                        `json:"istagarule"`
+	Islocalstorageactive             FlexBool                    `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                    `json:"istagarule"`
 	JobID                            string                      `json:"jobid"`
-	Jobstatus                        int                         `json:"jobstatus"`
-	Lastannotated                    string                      `json:"lastannotated"`
-	Lastpinged                       string                      `json:"lastpinged"`
+	Jobstatus                        FlexInt                     `json:"jobstatus"`
+	Lastannotated                    Time                        `json:"lastannotated"`
+	Lastpinged                       Time                        `json:"lastpinged"`
 	Managementserverid               UUID                        `json:"managementserverid"`
 	Managementservername             string                      `json:"managementservername"`
-	Memoryallocated                  int64                       `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                       `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                   `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                   `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                      `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                       `json:"memorytotal"`
-	Memoryused                       int64                       `json:"memoryused"`
+	Memorytotal                      FlexInt64                   `json:"memorytotal"`
+	Memoryused                       FlexInt64                   `json:"memoryused"`
 	Memorywithoverprovisioning       string                      `json:"memorywithoverprovisioning"`
 	Name                             string                      `json:"name"`
-	Networkkbsread                   int64                       `json:"networkkbsread"`
-	Networkkbswrite                  int64                       `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                   `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                   `json:"networkkbswrite"`
 	Oscategoryid                     string                      `json:"oscategoryid"`
 	Oscategoryname                   string                      `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse `json:"outofbandmanagement"`
 	Podid                            string                      `json:"podid"`
 	Podname                          string                      `json:"podname"`
 	Podstorageaccessgroups           string                      `json:"podstorageaccessgroups"`
-	Removed                          string                      `json:"removed"`
-	RequiresStorageMotion            bool                        `json:"requiresStorageMotion"`
-	Resourcestate                    string                      `json:"resourcestate"`
+	Removed                          Time                        `json:"removed"`
+	RequiresStorageMotion            FlexBool                    `json:"requiresStorageMotion"`
+	Resourcestate                    HostResourceState           `json:"resourcestate"`
 	State                            string                      `json:"state"`
 	Storageaccessgroups              string                      `json:"storageaccessgroups"`
-	Suitableformigration             bool                        `json:"suitableformigration"`
+	Suitableformigration             FlexBool                    `json:"suitableformigration"`
 	Type                             string                      `json:"type"`
-	Ueficapability                   bool                        `json:"ueficapability"`
+	Ueficapability                   FlexBool                    `json:"ueficapability"`
 	Username                         string                      `json:"username"`
 	Version                          string                      `json:"version"`
 	Virtualmachineid                 string                      `json:"virtualmachineid"`
 	Zoneid                           string                      `json:"zoneid"`
 	Zonename                         string                      `json:"zonename"`
 	Zonestorageaccessgroups          string                      `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *HostForMigration) UnmarshalJSON(b []byte) error {
+	type alias HostForMigration
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type HostForMigrationGpugroup struct {
 	Gpugroupname string                         `json:"gpugroupname"`
 	Vgpu         []HostForMigrationGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage     `json:"-"`
+}
+
+func (r *HostForMigrationGpugroup) UnmarshalJSON(b []byte) error {
+	type alias HostForMigrationGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type HostForMigrationGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostForMigrationGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias HostForMigrationGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDedicatedHostsParams struct {
@@ -2491,6 +3472,49 @@ func (p *ListDedicatedHostsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDedicatedHosts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDedicatedHostsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDedicatedHosts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDedicatedHosts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDedicatedHostsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "affinitygroupid": 255, "domainid": 255, "hostid": 255, "keyword": 255},
+	}.validate("listDedicatedHosts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDedicatedHosts API, before the request is signed
+func (p *ListDedicatedHostsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDedicatedHostsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDedicatedHostsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDedicatedHosts", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"affinitygroupid": decodeParam[string],
+		"domainid":        decodeParam[string],
+		"hostid":          decodeParam[string],
+		"keyword":         decodeParam[string],
+		"page":            decodeParam[int],
+		"pagesize":        decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDedicatedHostsParams) Clone() *ListDedicatedHostsParams {
+	return &ListDedicatedHostsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDedicatedHostsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2648,33 +3672,76 @@ func (s *HostService) NewListDedicatedHostsParams() *ListDedicatedHostsParams {
 
 // Lists dedicated hosts.
 func (s *HostService) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedHosts", p.toURLValues())
+	return s.ListDedicatedHostsWithContext(context.Background(), p)
+}
+
+// ListDedicatedHostsWithContext is like ListDedicatedHosts, but honours the cancellation and deadline of ctx
+func (s *HostService) ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDedicatedHosts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDedicatedHostsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDedicatedHosts", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDedicatedHostsAll returns all DedicatedHosts matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *HostService) ListDedicatedHostsAll(p *ListDedicatedHostsParams, opts ...PageOption) ([]*DedicatedHost, error) {
+	return s.ListDedicatedHostsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedHostsAllWithContext is like ListDedicatedHostsAll, but honours the cancellation and deadline of ctx
+func (s *HostService) ListDedicatedHostsAllWithContext(ctx context.Context, p *ListDedicatedHostsParams, opts ...PageOption) ([]*DedicatedHost, error) {
+	return collectPages(s.ListDedicatedHostsIterWithContext(ctx, p, opts...))
+}
+
+// ListDedicatedHostsIter returns an iterator over all DedicatedHosts matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *HostService) ListDedicatedHostsIter(p *ListDedicatedHostsParams, opts ...PageOption) iter.Seq2[*DedicatedHost, error] {
+	return s.ListDedicatedHostsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedHostsIterWithContext is like ListDedicatedHostsIter, but honours the cancellation and deadline of ctx
+func (s *HostService) ListDedicatedHostsIterWithContext(ctx context.Context, p *ListDedicatedHostsParams, opts ...PageOption) iter.Seq2[*DedicatedHost, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DedicatedHost, int, error) {
+		l, err := s.ListDedicatedHostsWithContext(ctx, &ListDedicatedHostsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DedicatedHosts, l.Count, nil
+	}, opts...)
+}
+
 type ListDedicatedHostsResponse struct {
 	Count          int              `json:"count"`
 	DedicatedHosts []*DedicatedHost `json:"dedicatedhost"`
 }
 
 type DedicatedHost struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Domainid        string `json:"domainid"`
-	Hostid          string `json:"hostid"`
-	Hostname        string `json:"hostname"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Domainid        string                     `json:"domainid"`
+	Hostid          string                     `json:"hostid"`
+	Hostname        string                     `json:"hostname"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicatedHost) UnmarshalJSON(b []byte) error {
+	type alias DedicatedHost
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListHostTagsParams struct {
@@ -2700,6 +3767,45 @@ func (p *ListHostTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listHostTags API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListHostTagsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listHostTags", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listHostTags API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListHostTagsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listHostTags", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listHostTags API, before the request is signed
+func (p *ListHostTagsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListHostTagsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListHostTagsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listHostTags", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListHostTagsParams) Clone() *ListHostTagsParams {
+	return &ListHostTagsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListHostTagsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2773,6 +3879,11 @@ func (s *HostService) NewListHostTagsParams() *ListHostTagsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetHostTagIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetHostTagIDWithContext is like GetHostTagID, but honours the cancellation and deadline of ctx
+func (s *HostService) GetHostTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostTagsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2784,7 +3895,7 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListHostTags(p)
+	l, err := s.ListHostTagsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2809,31 +3920,74 @@ func (s *HostService) GetHostTagID(keyword string, opts ...OptionFunc) (string,
 
 // Lists host tags
 func (s *HostService) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
-	resp, err := s.cs.newRequest("listHostTags", p.toURLValues())
+	return s.ListHostTagsWithContext(context.Background(), p)
+}
+
+// ListHostTagsWithContext is like ListHostTags, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listHostTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHostTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listHostTags", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListHostTagsAll returns all HostTags matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *HostService) ListHostTagsAll(p *ListHostTagsParams, opts ...PageOption) ([]*HostTag, error) {
+	return s.ListHostTagsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListHostTagsAllWithContext is like ListHostTagsAll, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostTagsAllWithContext(ctx context.Context, p *ListHostTagsParams, opts ...PageOption) ([]*HostTag, error) {
+	return collectPages(s.ListHostTagsIterWithContext(ctx, p, opts...))
+}
+
+// ListHostTagsIter returns an iterator over all HostTags matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *HostService) ListHostTagsIter(p *ListHostTagsParams, opts ...PageOption) iter.Seq2[*HostTag, error] {
+	return s.ListHostTagsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListHostTagsIterWithContext is like ListHostTagsIter, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostTagsIterWithContext(ctx context.Context, p *ListHostTagsParams, opts ...PageOption) iter.Seq2[*HostTag, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*HostTag, int, error) {
+		l, err := s.ListHostTagsWithContext(ctx, &ListHostTagsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.HostTags, l.Count, nil
+	}, opts...)
+}
+
 type ListHostTagsResponse struct {
 	Count    int        `json:"count"`
 	HostTags []*HostTag `json:"hosttag"`
 }
 
 type HostTag struct {
-	Hostid     int64  `json:"hostid"`
-	Id         string `json:"id"`
-	Isimplicit bool   `json:"isimplicit"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
+	Hostid     FlexInt64                  `json:"hostid"`
+	Id         string                     `json:"id"`
+	Isimplicit FlexBool                   `json:"isimplicit"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostTag) UnmarshalJSON(b []byte) error {
+	type alias HostTag
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListHostsParams struct {
@@ -2860,7 +4014,7 @@ func (p *ListHostsParams) toURLValues() url.Values {
 		u.Set("hahost", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -2916,6 +4070,63 @@ func (p *ListHostsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listHosts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListHostsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listHosts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listHosts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListHostsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"arch": 255, "clusterid": 255, "hypervisor": 255, "id": 255, "keyword": 255, "managementserverid": 255, "name": 255, "outofbandmanagementpowerstate": 255, "podid": 255, "resourcestate": 255, "state": 255, "storageaccessgroup": 255, "type": 255, "version": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("listHosts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listHosts API, before the request is signed
+func (p *ListHostsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListHostsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListHostsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listHosts", b, &p.p, map[string]paramDecoder{
+		"arch":                          decodeParam[string],
+		"clusterid":                     decodeParam[string],
+		"details":                       decodeParam[[]string],
+		"hahost":                        decodeParam[bool],
+		"hypervisor":                    decodeParam[HypervisorType],
+		"id":                            decodeParam[string],
+		"keyword":                       decodeParam[string],
+		"managementserverid":            decodeParam[UUID],
+		"name":                          decodeParam[string],
+		"outofbandmanagementenabled":    decodeParam[bool],
+		"outofbandmanagementpowerstate": decodeParam[string],
+		"page":                          decodeParam[int],
+		"pagesize":                      decodeParam[int],
+		"podid":                         decodeParam[string],
+		"resourcestate":                 decodeParam[string],
+		"state":                         decodeParam[string],
+		"storageaccessgroup":            decodeParam[string],
+		"type":                          decodeParam[string],
+		"version":                       decodeParam[string],
+		"virtualmachineid":              decodeParam[string],
+		"zoneid":                        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListHostsParams) Clone() *ListHostsParams {
+	return &ListHostsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListHostsParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3000,7 +4211,7 @@ func (p *ListHostsParams) GetHahost() (bool, bool) {
 	return value, ok
 }
 
-func (p *ListHostsParams) SetHypervisor(v string) {
+func (p *ListHostsParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -3013,11 +4224,11 @@ func (p *ListHostsParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListHostsParams) GetHypervisor() (string, bool) {
+func (p *ListHostsParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -3367,6 +4578,11 @@ func (s *HostService) NewListHostsParams() *ListHostsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetHostIDWithContext(context.Background(), name, opts...)
+}
+
+// GetHostIDWithContext is like GetHostID, but honours the cancellation and deadline of ctx
+func (s *HostService) GetHostIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3378,7 +4594,7 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 		}
 	}
 
-	l, err := s.ListHosts(p)
+	l, err := s.ListHostsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3403,12 +4619,17 @@ func (s *HostService) GetHostID(name string, opts ...OptionFunc) (string, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostByName(name string, opts ...OptionFunc) (*Host, int, error) {
-	id, count, err := s.GetHostID(name, opts...)
+	return s.GetHostByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetHostByNameWithContext is like GetHostByName, but honours the cancellation and deadline of ctx
+func (s *HostService) GetHostByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Host, int, error) {
+	id, count, err := s.GetHostIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetHostByID(id, opts...)
+	r, count, err := s.GetHostByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3417,6 +4638,11 @@ func (s *HostService) GetHostByName(name string, opts ...OptionFunc) (*Host, int
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, error) {
+	return s.GetHostByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetHostByIDWithContext is like GetHostByID, but honours the cancellation and deadline of ctx
+func (s *HostService) GetHostByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Host, int, error) {
 	p := &ListHostsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3428,11 +4654,10 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 		}
 	}
 
-	l, err := s.ListHosts(p)
+	l, err := s.ListHostsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3450,19 +4675,56 @@ func (s *HostService) GetHostByID(id string, opts ...OptionFunc) (*Host, int, er
 
 // Lists hosts.
 func (s *HostService) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
-	resp, err := s.cs.newRequest("listHosts", p.toURLValues())
+	return s.ListHostsWithContext(context.Background(), p)
+}
+
+// ListHostsWithContext is like ListHosts, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listHosts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHostsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listHosts", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListHostsAll returns all Hosts matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *HostService) ListHostsAll(p *ListHostsParams, opts ...PageOption) ([]*Host, error) {
+	return s.ListHostsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListHostsAllWithContext is like ListHostsAll, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostsAllWithContext(ctx context.Context, p *ListHostsParams, opts ...PageOption) ([]*Host, error) {
+	return collectPages(s.ListHostsIterWithContext(ctx, p, opts...))
+}
+
+// ListHostsIter returns an iterator over all Hosts matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *HostService) ListHostsIter(p *ListHostsParams, opts ...PageOption) iter.Seq2[*Host, error] {
+	return s.ListHostsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListHostsIterWithContext is like ListHostsIter, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostsIterWithContext(ctx context.Context, p *ListHostsParams, opts ...PageOption) iter.Seq2[*Host, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Host, int, error) {
+		l, err := s.ListHostsWithContext(ctx, &ListHostsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Hosts, l.Count, nil
+	}, opts...)
+}
+
 type ListHostsResponse struct {
 	Count int     `json:"count"`
 	Hosts []*Host `json:"host"`
@@ -3478,90 +4740,108 @@ type Host struct {
 	Clustertype                      string                      `json:"clustertype"`
 	Cpuallocated                     string                      `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                      `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                       `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                   `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                      `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                     `json:"cpuloadaverage"`
-	Cpunumber                        int                         `json:"cpunumber"`
-	Cpusockets                       int                         `json:"cpusockets"`
-	Cpuspeed                         int64                       `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                   `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                     `json:"cpunumber"`
+	Cpusockets                       FlexInt                     `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                   `json:"cpuspeed"`
 	Cpuused                          string                      `json:"cpuused"`
 	Cpuwithoverprovisioning          string                      `json:"cpuwithoverprovisioning"`
-	Created                          string                      `json:"created"`
+	Created                          Time                        `json:"created"`
 	Details                          map[string]string           `json:"details"`
-	Disconnected                     string                      `json:"disconnected"`
-	Disksizeallocated                int64                       `json:"disksizeallocated"`
-	Disksizetotal                    int64                       `json:"disksizetotal"`
-	Encryptionsupported              bool                        `json:"encryptionsupported"`
+	Disconnected                     Time                        `json:"disconnected"`
+	Disksizeallocated                FlexInt64                   `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                   `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                    `json:"encryptionsupported"`
 	Events                           string                      `json:"events"`
 	Explicithosttags                 string                      `json:"explicithosttags"`
 	Extensionid                      string                      `json:"extensionid"`
 	Extensionname                    string                      `json:"extensionname"`
 	Gpugroup                         []HostGpugroup              `json:"gpugroup"`
-	Gputotal                         int64                       `json:"gputotal"`
-	Gpuused                          int64                       `json:"gpuused"`
-	Hahost                           bool                        `json:"hahost"`
-	Hasannotations                   bool                        `json:"hasannotations"`
-	Hasenoughcapacity                bool                        `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                   `json:"gputotal"`
+	Gpuused                          FlexInt64                   `json:"gpuused"`
+	Hahost                           FlexBool                    `json:"hahost"`
+	Hasannotations                   FlexBool                    `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                    `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse           `json:"hostha"`
 	Hosttags                         string                      `json:"hosttags"`
 	Hypervisor                       string                      `json:"hypervisor"`
 	Hypervisorversion                string                      `json:"hypervisorversion"`
 	Id                               string                      `json:"id"`
 	Implicithosttags                 string                      `json:"implicithosttags"`
-	Instanceconversionsupported      bool                        `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                    `json:"instanceconversionsupported"`
 	Ipaddress                        string                      `json:"ipaddress"`
-	Islocalstorageactive             bool                        `json:"islocalstorageactive"`
-	Istagarule                       bool                        `json:"istagarule"`
+	Islocalstorageactive             FlexBool                    `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                    `json:"istagarule"`
 	JobID                            string                      `json:"jobid"`
-	Jobstatus                        int                         `json:"jobstatus"`
-	Lastannotated                    string                      `json:"lastannotated"`
-	Lastpinged                       string                      `json:"lastpinged"`
+	Jobstatus                        FlexInt                     `json:"jobstatus"`
+	Lastannotated                    Time                        `json:"lastannotated"`
+	Lastpinged                       Time                        `json:"lastpinged"`
 	Managementserverid               UUID                        `json:"managementserverid"`
 	Managementservername             string                      `json:"managementservername"`
-	Memoryallocated                  int64                       `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                       `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                   `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                   `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                      `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                       `json:"memorytotal"`
-	Memoryused                       int64                       `json:"memoryused"`
+	Memorytotal                      FlexInt64                   `json:"memorytotal"`
+	Memoryused                       FlexInt64                   `json:"memoryused"`
 	Memorywithoverprovisioning       string                      `json:"memorywithoverprovisioning"`
 	Name                             string                      `json:"name"`
-	Networkkbsread                   int64                       `json:"networkkbsread"`
-	Networkkbswrite                  int64                       `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                   `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                   `json:"networkkbswrite"`
 	Oscategoryid                     string                      `json:"oscategoryid"`
 	Oscategoryname                   string                      `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse `json:"outofbandmanagement"`
 	Podid                            string                      `json:"podid"`
 	Podname                          string                      `json:"podname"`
 	Podstorageaccessgroups           string                      `json:"podstorageaccessgroups"`
-	Removed                          string                      `json:"removed"`
-	Resourcestate                    string                      `json:"resourcestate"`
+	Removed                          Time                        `json:"removed"`
+	Resourcestate                    HostResourceState           `json:"resourcestate"`
 	State                            string                      `json:"state"`
 	Storageaccessgroups              string                      `json:"storageaccessgroups"`
-	Suitableformigration             bool                        `json:"suitableformigration"`
+	Suitableformigration             FlexBool                    `json:"suitableformigration"`
 	Type                             string                      `json:"type"`
-	Ueficapability                   bool                        `json:"ueficapability"`
+	Ueficapability                   FlexBool                    `json:"ueficapability"`
 	Username                         string                      `json:"username"`
 	Version                          string                      `json:"version"`
 	Virtualmachineid                 string                      `json:"virtualmachineid"`
 	Zoneid                           string                      `json:"zoneid"`
 	Zonename                         string                      `json:"zonename"`
 	Zonestorageaccessgroups          string                      `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *Host) UnmarshalJSON(b []byte) error {
+	type alias Host
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type HostGpugroup struct {
-	Gpugroupname string             `json:"gpugroupname"`
-	Vgpu         []HostGpugroupVgpu `json:"vgpu"`
+	Gpugroupname string                     `json:"gpugroupname"`
+	Vgpu         []HostGpugroupVgpu         `json:"vgpu"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostGpugroup) UnmarshalJSON(b []byte) error {
+	type alias HostGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type HostGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias HostGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListHostsMetricsParams struct {
@@ -3588,7 +4868,7 @@ func (p *ListHostsMetricsParams) toURLValues() url.Values {
 		u.Set("hahost", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -3644,6 +4924,63 @@ func (p *ListHostsMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listHostsMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListHostsMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listHostsMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listHostsMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListHostsMetricsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"arch": 255, "clusterid": 255, "hypervisor": 255, "id": 255, "keyword": 255, "managementserverid": 255, "name": 255, "outofbandmanagementpowerstate": 255, "podid": 255, "resourcestate": 255, "state": 255, "storageaccessgroup": 255, "type": 255, "version": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("listHostsMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listHostsMetrics API, before the request is signed
+func (p *ListHostsMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListHostsMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListHostsMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listHostsMetrics", b, &p.p, map[string]paramDecoder{
+		"arch":                          decodeParam[string],
+		"clusterid":                     decodeParam[string],
+		"details":                       decodeParam[[]string],
+		"hahost":                        decodeParam[bool],
+		"hypervisor":                    decodeParam[HypervisorType],
+		"id":                            decodeParam[string],
+		"keyword":                       decodeParam[string],
+		"managementserverid":            decodeParam[UUID],
+		"name":                          decodeParam[string],
+		"outofbandmanagementenabled":    decodeParam[bool],
+		"outofbandmanagementpowerstate": decodeParam[string],
+		"page":                          decodeParam[int],
+		"pagesize":                      decodeParam[int],
+		"podid":                         decodeParam[string],
+		"resourcestate":                 decodeParam[string],
+		"state":                         decodeParam[string],
+		"storageaccessgroup":            decodeParam[string],
+		"type":                          decodeParam[string],
+		"version":                       decodeParam[string],
+		"virtualmachineid":              decodeParam[string],
+		"zoneid":                        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListHostsMetricsParams) Clone() *ListHostsMetricsParams {
+	return &ListHostsMetricsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListHostsMetricsParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3728,7 +5065,7 @@ func (p *ListHostsMetricsParams) GetHahost() (bool, bool) {
 	return value, ok
 }
 
-func (p *ListHostsMetricsParams) SetHypervisor(v string) {
+func (p *ListHostsMetricsParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -3741,11 +5078,11 @@ func (p *ListHostsMetricsParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListHostsMetricsParams) GetHypervisor() (string, bool) {
+func (p *ListHostsMetricsParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -4095,6 +5432,11 @@ func (s *HostService) NewListHostsMetricsParams() *ListHostsMetricsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetHostsMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetHostsMetricIDWithContext is like GetHostsMetricID, but honours the cancellation and deadline of ctx
+func (s *HostService) GetHostsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListHostsMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -4106,7 +5448,7 @@ func (s *HostService) GetHostsMetricID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListHostsMetrics(p)
+	l, err := s.ListHostsMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4131,12 +5473,17 @@ func (s *HostService) GetHostsMetricID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error) {
-	id, count, err := s.GetHostsMetricID(name, opts...)
+	return s.GetHostsMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetHostsMetricByNameWithContext is like GetHostsMetricByName, but honours the cancellation and deadline of ctx
+func (s *HostService) GetHostsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	id, count, err := s.GetHostsMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetHostsMetricByID(id, opts...)
+	r, count, err := s.GetHostsMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4145,6 +5492,11 @@ func (s *HostService) GetHostsMetricByName(name string, opts ...OptionFunc) (*Ho
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	return s.GetHostsMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetHostsMetricByIDWithContext is like GetHostsMetricByID, but honours the cancellation and deadline of ctx
+func (s *HostService) GetHostsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HostsMetric, int, error) {
 	p := &ListHostsMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -4156,11 +5508,10 @@ func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsM
 		}
 	}
 
-	l, err := s.ListHostsMetrics(p)
+	l, err := s.ListHostsMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4178,26 +5529,63 @@ func (s *HostService) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsM
 
 // Lists hosts metrics
 func (s *HostService) ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listHostsMetrics", p.toURLValues())
+	return s.ListHostsMetricsWithContext(context.Background(), p)
+}
+
+// ListHostsMetricsWithContext is like ListHostsMetrics, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostsMetricsWithContext(ctx context.Context, p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listHostsMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHostsMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listHostsMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
-type ListHostsMetricsResponse struct {
-	Count        int            `json:"count"`
-	HostsMetrics []*HostsMetric `json:"host"`
+// ListHostsMetricsAll returns all HostsMetrics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *HostService) ListHostsMetricsAll(p *ListHostsMetricsParams, opts ...PageOption) ([]*HostsMetric, error) {
+	return s.ListHostsMetricsAllWithContext(context.Background(), p, opts...)
 }
 
-type HostsMetric struct {
-	Annotation                       string                      `json:"annotation"`
+// ListHostsMetricsAllWithContext is like ListHostsMetricsAll, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostsMetricsAllWithContext(ctx context.Context, p *ListHostsMetricsParams, opts ...PageOption) ([]*HostsMetric, error) {
+	return collectPages(s.ListHostsMetricsIterWithContext(ctx, p, opts...))
+}
+
+// ListHostsMetricsIter returns an iterator over all HostsMetrics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *HostService) ListHostsMetricsIter(p *ListHostsMetricsParams, opts ...PageOption) iter.Seq2[*HostsMetric, error] {
+	return s.ListHostsMetricsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListHostsMetricsIterWithContext is like ListHostsMetricsIter, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostsMetricsIterWithContext(ctx context.Context, p *ListHostsMetricsParams, opts ...PageOption) iter.Seq2[*HostsMetric, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*HostsMetric, int, error) {
+		l, err := s.ListHostsMetricsWithContext(ctx, &ListHostsMetricsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.HostsMetrics, l.Count, nil
+	}, opts...)
+}
+
+type ListHostsMetricsResponse struct {
+	Count        int            `json:"count"`
+	HostsMetrics []*HostsMetric `json:"host"`
+}
+
+type HostsMetric struct {
+	Annotation                       string                      `json:"annotation"`
 	Arch                             string                      `json:"arch"`
 	Capabilities                     string                      `json:"capabilities"`
 	Clusterid                        string                      `json:"clusterid"`
@@ -4205,71 +5593,71 @@ type HostsMetric struct {
 	Clusterstorageaccessgroups       string                      `json:"clusterstorageaccessgroups"`
 	Clustertype                      string                      `json:"clustertype"`
 	Cpuallocated                     string                      `json:"cpuallocated"`
-	Cpuallocateddisablethreshold     bool                        `json:"cpuallocateddisablethreshold"`
+	Cpuallocateddisablethreshold     FlexBool                    `json:"cpuallocateddisablethreshold"`
 	Cpuallocatedghz                  string                      `json:"cpuallocatedghz"`
 	Cpuallocatedpercentage           string                      `json:"cpuallocatedpercentage"`
-	Cpuallocatedthreshold            bool                        `json:"cpuallocatedthreshold"`
-	Cpuallocatedvalue                int64                       `json:"cpuallocatedvalue"`
+	Cpuallocatedthreshold            FlexBool                    `json:"cpuallocatedthreshold"`
+	Cpuallocatedvalue                FlexInt64                   `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                      `json:"cpuallocatedwithoverprovisioning"`
-	Cpudisablethreshold              bool                        `json:"cpudisablethreshold"`
-	Cpuloadaverage                   float64                     `json:"cpuloadaverage"`
-	Cpunumber                        int                         `json:"cpunumber"`
-	Cpusockets                       int                         `json:"cpusockets"`
-	Cpuspeed                         int64                       `json:"cpuspeed"`
-	Cputhreshold                     bool                        `json:"cputhreshold"`
+	Cpudisablethreshold              FlexBool                    `json:"cpudisablethreshold"`
+	Cpuloadaverage                   FlexFloat                   `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                     `json:"cpunumber"`
+	Cpusockets                       FlexInt                     `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                   `json:"cpuspeed"`
+	Cputhreshold                     FlexBool                    `json:"cputhreshold"`
 	Cputotalghz                      string                      `json:"cputotalghz"`
 	Cpuused                          string                      `json:"cpuused"`
 	Cpuusedghz                       string                      `json:"cpuusedghz"`
 	Cpuwithoverprovisioning          string                      `json:"cpuwithoverprovisioning"`
-	Created                          string                      `json:"created"`
+	Created                          Time                        `json:"created"`
 	Details                          map[string]string           `json:"details"`
-	Disconnected                     string                      `json:"disconnected"`
-	Disksizeallocated                int64                       `json:"disksizeallocated"`
-	Disksizetotal                    int64                       `json:"disksizetotal"`
-	Encryptionsupported              bool                        `json:"encryptionsupported"`
+	Disconnected                     Time                        `json:"disconnected"`
+	Disksizeallocated                FlexInt64                   `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                   `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                    `json:"encryptionsupported"`
 	Events                           string                      `json:"events"`
 	Explicithosttags                 string                      `json:"explicithosttags"`
 	Extensionid                      string                      `json:"extensionid"`
 	Extensionname                    string                      `json:"extensionname"`
 	Gpugroup                         []HostsMetricGpugroup       `json:"gpugroup"`
-	Gputotal                         int64                       `json:"gputotal"`
-	Gpuused                          int64                       `json:"gpuused"`
-	Hahost                           bool                        `json:"hahost"`
-	Hasannotations                   bool                        `json:"hasannotations"`
-	Hasenoughcapacity                bool                        `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                   `json:"gputotal"`
+	Gpuused                          FlexInt64                   `json:"gpuused"`
+	Hahost                           FlexBool                    `json:"hahost"`
+	Hasannotations                   FlexBool                    `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                    `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse           `json:"hostha"`
 	Hosttags                         string                      `json:"hosttags"`
 	Hypervisor                       string                      `json:"hypervisor"`
 	Hypervisorversion                string                      `json:"hypervisorversion"`
 	Id                               string                      `json:"id"`
 	Implicithosttags                 string                      `json:"implicithosttags"`
-	Instanceconversionsupported      bool                        `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                    `json:"instanceconversionsupported"`
 	Instances                        string                      `json:"instances"`
 	Ipaddress                        string                      `json:"ipaddress"`
-	Islocalstorageactive             bool                        `json:"islocalstorageactive"`
-	Istagarule                       bool                        `json:"istagarule"`
+	Islocalstorageactive             FlexBool                    `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                    `json:"istagarule"`
 	JobID                            string                      `json:"jobid"`
-	Jobstatus                        int                         `json:"jobstatus"`
-	Lastannotated                    string                      `json:"lastannotated"`
-	Lastpinged                       string                      `json:"lastpinged"`
+	Jobstatus                        FlexInt                     `json:"jobstatus"`
+	Lastannotated                    Time                        `json:"lastannotated"`
+	Lastpinged                       Time                        `json:"lastpinged"`
 	Managementserverid               UUID                        `json:"managementserverid"`
 	Managementservername             string                      `json:"managementservername"`
-	Memoryallocated                  int64                       `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                       `json:"memoryallocatedbytes"`
-	Memoryallocateddisablethreshold  bool                        `json:"memoryallocateddisablethreshold"`
+	Memoryallocated                  FlexInt64                   `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                   `json:"memoryallocatedbytes"`
+	Memoryallocateddisablethreshold  FlexBool                    `json:"memoryallocateddisablethreshold"`
 	Memoryallocatedgb                string                      `json:"memoryallocatedgb"`
 	Memoryallocatedpercentage        string                      `json:"memoryallocatedpercentage"`
-	Memoryallocatedthreshold         bool                        `json:"memoryallocatedthreshold"`
-	Memorydisablethreshold           bool                        `json:"memorydisablethreshold"`
-	Memorythreshold                  bool                        `json:"memorythreshold"`
-	Memorytotal                      int64                       `json:"memorytotal"`
+	Memoryallocatedthreshold         FlexBool                    `json:"memoryallocatedthreshold"`
+	Memorydisablethreshold           FlexBool                    `json:"memorydisablethreshold"`
+	Memorythreshold                  FlexBool                    `json:"memorythreshold"`
+	Memorytotal                      FlexInt64                   `json:"memorytotal"`
 	Memorytotalgb                    string                      `json:"memorytotalgb"`
-	Memoryused                       int64                       `json:"memoryused"`
+	Memoryused                       FlexInt64                   `json:"memoryused"`
 	Memoryusedgb                     string                      `json:"memoryusedgb"`
 	Memorywithoverprovisioning       string                      `json:"memorywithoverprovisioning"`
 	Name                             string                      `json:"name"`
-	Networkkbsread                   int64                       `json:"networkkbsread"`
-	Networkkbswrite                  int64                       `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                   `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                   `json:"networkkbswrite"`
 	Networkread                      string                      `json:"networkread"`
 	Networkwrite                     string                      `json:"networkwrite"`
 	Oscategoryid                     string                      `json:"oscategoryid"`
@@ -4279,36 +5667,54 @@ type HostsMetric struct {
 	Podname                          string                      `json:"podname"`
 	Podstorageaccessgroups           string                      `json:"podstorageaccessgroups"`
 	Powerstate                       string                      `json:"powerstate"`
-	Removed                          string                      `json:"removed"`
-	Resourcestate                    string                      `json:"resourcestate"`
+	Removed                          Time                        `json:"removed"`
+	Resourcestate                    HostResourceState           `json:"resourcestate"`
 	State                            string                      `json:"state"`
 	Storageaccessgroups              string                      `json:"storageaccessgroups"`
-	Suitableformigration             bool                        `json:"suitableformigration"`
+	Suitableformigration             FlexBool                    `json:"suitableformigration"`
 	Systeminstances                  string                      `json:"systeminstances"`
 	Type                             string                      `json:"type"`
-	Ueficapability                   bool                        `json:"ueficapability"`
+	Ueficapability                   FlexBool                    `json:"ueficapability"`
 	Username                         string                      `json:"username"`
 	Version                          string                      `json:"version"`
 	Virtualmachineid                 string                      `json:"virtualmachineid"`
 	Zoneid                           string                      `json:"zoneid"`
 	Zonename                         string                      `json:"zonename"`
 	Zonestorageaccessgroups          string                      `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *HostsMetric) UnmarshalJSON(b []byte) error {
+	type alias HostsMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type HostsMetricGpugroup struct {
-	Gpugroupname string                    `json:"gpugroupname"`
-	Vgpu         []HostsMetricGpugroupVgpu `json:"vgpu"`
+	Gpugroupname string                     `json:"gpugroupname"`
+	Vgpu         []HostsMetricGpugroupVgpu  `json:"vgpu"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostsMetricGpugroup) UnmarshalJSON(b []byte) error {
+	type alias HostsMetricGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type HostsMetricGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostsMetricGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias HostsMetricGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PrepareHostForMaintenanceParams struct {
@@ -4326,6 +5732,44 @@ func (p *PrepareHostForMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the prepareHostForMaintenance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *PrepareHostForMaintenanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("prepareHostForMaintenance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the prepareHostForMaintenance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *PrepareHostForMaintenanceParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("prepareHostForMaintenance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the prepareHostForMaintenance API, before the request is signed
+func (p *PrepareHostForMaintenanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *PrepareHostForMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *PrepareHostForMaintenanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("prepareHostForMaintenance", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *PrepareHostForMaintenanceParams) Clone() *PrepareHostForMaintenanceParams {
+	return &PrepareHostForMaintenanceParams{p: cloneParams(p.p)}
+}
+
 func (p *PrepareHostForMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4358,32 +5802,39 @@ func (s *HostService) NewPrepareHostForMaintenanceParams(id string) *PrepareHost
 
 // Prepares a host for maintenance.
 func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
-	resp, err := s.cs.newPostRequest("prepareHostForMaintenance", p.toURLValues())
+	return s.PrepareHostForMaintenanceWithContext(context.Background(), p)
+}
+
+// PrepareHostForMaintenanceWithContext is like PrepareHostForMaintenance, but honours the cancellation and deadline of ctx
+func (s *HostService) PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "prepareHostForMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r PrepareHostForMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("prepareHostForMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "prepareHostForMaintenance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("prepareHostForMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4391,6 +5842,25 @@ func (s *HostService) PrepareHostForMaintenance(p *PrepareHostForMaintenancePara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished PrepareHostForMaintenance async job into r
+func (r *PrepareHostForMaintenanceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// PrepareHostForMaintenanceAsync starts the async job of PrepareHostForMaintenance without waiting for it to finish, and returns a handle to the job
+func (s *HostService) PrepareHostForMaintenanceAsync(ctx context.Context, p *PrepareHostForMaintenanceParams) (*Job, error) {
+	r, err := s.PrepareHostForMaintenanceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("prepareHostForMaintenance", r.JobID), nil
+}
+
 type PrepareHostForMaintenanceResponse struct {
 	Annotation                       string                                      `json:"annotation"`
 	Arch                             string                                      `json:"arch"`
@@ -4401,90 +5871,108 @@ type PrepareHostForMaintenanceResponse struct {
 	Clustertype                      string                                      `json:"clustertype"`
 	Cpuallocated                     string                                      `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                                      `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                                       `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                                   `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                                      `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                                     `json:"cpuloadaverage"`
-	Cpunumber                        int                                         `json:"cpunumber"`
-	Cpusockets                       int                                         `json:"cpusockets"`
-	Cpuspeed                         int64                                       `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                                   `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                                     `json:"cpunumber"`
+	Cpusockets                       FlexInt                                     `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                                   `json:"cpuspeed"`
 	Cpuused                          string                                      `json:"cpuused"`
 	Cpuwithoverprovisioning          string                                      `json:"cpuwithoverprovisioning"`
-	Created                          string                                      `json:"created"`
+	Created                          Time                                        `json:"created"`
 	Details                          map[string]string                           `json:"details"`
-	Disconnected                     string                                      `json:"disconnected"`
-	Disksizeallocated                int64                                       `json:"disksizeallocated"`
-	Disksizetotal                    int64                                       `json:"disksizetotal"`
-	Encryptionsupported              bool                                        `json:"encryptionsupported"`
+	Disconnected                     Time                                        `json:"disconnected"`
+	Disksizeallocated                FlexInt64                                   `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                                   `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                                    `json:"encryptionsupported"`
 	Events                           string                                      `json:"events"`
 	Explicithosttags                 string                                      `json:"explicithosttags"`
 	Extensionid                      string                                      `json:"extensionid"`
 	Extensionname                    string                                      `json:"extensionname"`
 	Gpugroup                         []PrepareHostForMaintenanceResponseGpugroup `json:"gpugroup"`
-	Gputotal                         int64                                       `json:"gputotal"`
-	Gpuused                          int64                                       `json:"gpuused"`
-	Hahost                           bool                                        `json:"hahost"`
-	Hasannotations                   bool                                        `json:"hasannotations"`
-	Hasenoughcapacity                bool                                        `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                                   `json:"gputotal"`
+	Gpuused                          FlexInt64                                   `json:"gpuused"`
+	Hahost                           FlexBool                                    `json:"hahost"`
+	Hasannotations                   FlexBool                                    `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                                    `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse                           `json:"hostha"`
 	Hosttags                         string                                      `json:"hosttags"`
 	Hypervisor                       string                                      `json:"hypervisor"`
 	Hypervisorversion                string                                      `json:"hypervisorversion"`
 	Id                               string                                      `json:"id"`
 	Implicithosttags                 string                                      `json:"implicithosttags"`
-	Instanceconversionsupported      bool                                        `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                                    `json:"instanceconversionsupported"`
 	Ipaddress                        string                                      `json:"ipaddress"`
-	Islocalstorageactive             bool                                        `json:"islocalstorageactive"`
-	Istagarule                       bool                                        `json:"istagarule"`
+	Islocalstorageactive             FlexBool                                    `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                                    `json:"istagarule"`
 	JobID                            string                                      `json:"jobid"`
-	Jobstatus                        int                                         `json:"jobstatus"`
-	Lastannotated                    string                                      `json:"lastannotated"`
-	Lastpinged                       string                                      `json:"lastpinged"`
+	Jobstatus                        FlexInt                                     `json:"jobstatus"`
+	Lastannotated                    Time                                        `json:"lastannotated"`
+	Lastpinged                       Time                                        `json:"lastpinged"`
 	Managementserverid               UUID                                        `json:"managementserverid"`
 	Managementservername             string                                      `json:"managementservername"`
-	Memoryallocated                  int64                                       `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                                       `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                                   `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                                   `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                                      `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                                       `json:"memorytotal"`
-	Memoryused                       int64                                       `json:"memoryused"`
+	Memorytotal                      FlexInt64                                   `json:"memorytotal"`
+	Memoryused                       FlexInt64                                   `json:"memoryused"`
 	Memorywithoverprovisioning       string                                      `json:"memorywithoverprovisioning"`
 	Name                             string                                      `json:"name"`
-	Networkkbsread                   int64                                       `json:"networkkbsread"`
-	Networkkbswrite                  int64                                       `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                                   `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                                   `json:"networkkbswrite"`
 	Oscategoryid                     string                                      `json:"oscategoryid"`
 	Oscategoryname                   string                                      `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse                 `json:"outofbandmanagement"`
 	Podid                            string                                      `json:"podid"`
 	Podname                          string                                      `json:"podname"`
 	Podstorageaccessgroups           string                                      `json:"podstorageaccessgroups"`
-	Removed                          string                                      `json:"removed"`
-	Resourcestate                    string                                      `json:"resourcestate"`
+	Removed                          Time                                        `json:"removed"`
+	Resourcestate                    HostResourceState                           `json:"resourcestate"`
 	State                            string                                      `json:"state"`
 	Storageaccessgroups              string                                      `json:"storageaccessgroups"`
-	Suitableformigration             bool                                        `json:"suitableformigration"`
+	Suitableformigration             FlexBool                                    `json:"suitableformigration"`
 	Type                             string                                      `json:"type"`
-	Ueficapability                   bool                                        `json:"ueficapability"`
+	Ueficapability                   FlexBool                                    `json:"ueficapability"`
 	Username                         string                                      `json:"username"`
 	Version                          string                                      `json:"version"`
 	Virtualmachineid                 string                                      `json:"virtualmachineid"`
 	Zoneid                           string                                      `json:"zoneid"`
 	Zonename                         string                                      `json:"zonename"`
 	Zonestorageaccessgroups          string                                      `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage                  `json:"-"`
+}
+
+func (r *PrepareHostForMaintenanceResponse) UnmarshalJSON(b []byte) error {
+	type alias PrepareHostForMaintenanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PrepareHostForMaintenanceResponseGpugroup struct {
 	Gpugroupname string                                          `json:"gpugroupname"`
 	Vgpu         []PrepareHostForMaintenanceResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage                      `json:"-"`
+}
+
+func (r *PrepareHostForMaintenanceResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias PrepareHostForMaintenanceResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PrepareHostForMaintenanceResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PrepareHostForMaintenanceResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias PrepareHostForMaintenanceResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReconnectHostParams struct {
@@ -4502,6 +5990,44 @@ func (p *ReconnectHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the reconnectHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReconnectHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("reconnectHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the reconnectHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReconnectHostParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("reconnectHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the reconnectHost API, before the request is signed
+func (p *ReconnectHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReconnectHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReconnectHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("reconnectHost", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReconnectHostParams) Clone() *ReconnectHostParams {
+	return &ReconnectHostParams{p: cloneParams(p.p)}
+}
+
 func (p *ReconnectHostParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4534,32 +6060,39 @@ func (s *HostService) NewReconnectHostParams(id string) *ReconnectHostParams {
 
 // Reconnects a host.
 func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
-	resp, err := s.cs.newPostRequest("reconnectHost", p.toURLValues())
+	return s.ReconnectHostWithContext(context.Background(), p)
+}
+
+// ReconnectHostWithContext is like ReconnectHost, but honours the cancellation and deadline of ctx
+func (s *HostService) ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "reconnectHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReconnectHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("reconnectHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "reconnectHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("reconnectHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4567,6 +6100,25 @@ func (s *HostService) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostRespo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReconnectHost async job into r
+func (r *ReconnectHostResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ReconnectHostAsync starts the async job of ReconnectHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) ReconnectHostAsync(ctx context.Context, p *ReconnectHostParams) (*Job, error) {
+	r, err := s.ReconnectHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("reconnectHost", r.JobID), nil
+}
+
 type ReconnectHostResponse struct {
 	Annotation                       string                          `json:"annotation"`
 	Arch                             string                          `json:"arch"`
@@ -4577,90 +6129,108 @@ type ReconnectHostResponse struct {
 	Clustertype                      string                          `json:"clustertype"`
 	Cpuallocated                     string                          `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                          `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                           `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                       `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                          `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                         `json:"cpuloadaverage"`
-	Cpunumber                        int                             `json:"cpunumber"`
-	Cpusockets                       int                             `json:"cpusockets"`
-	Cpuspeed                         int64                           `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                       `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                         `json:"cpunumber"`
+	Cpusockets                       FlexInt                         `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                       `json:"cpuspeed"`
 	Cpuused                          string                          `json:"cpuused"`
 	Cpuwithoverprovisioning          string                          `json:"cpuwithoverprovisioning"`
-	Created                          string                          `json:"created"`
+	Created                          Time                            `json:"created"`
 	Details                          map[string]string               `json:"details"`
-	Disconnected                     string                          `json:"disconnected"`
-	Disksizeallocated                int64                           `json:"disksizeallocated"`
-	Disksizetotal                    int64                           `json:"disksizetotal"`
-	Encryptionsupported              bool                            `json:"encryptionsupported"`
+	Disconnected                     Time                            `json:"disconnected"`
+	Disksizeallocated                FlexInt64                       `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                       `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                        `json:"encryptionsupported"`
 	Events                           string                          `json:"events"`
 	Explicithosttags                 string                          `json:"explicithosttags"`
 	Extensionid                      string                          `json:"extensionid"`
 	Extensionname                    string                          `json:"extensionname"`
 	Gpugroup                         []ReconnectHostResponseGpugroup `json:"gpugroup"`
-	Gputotal                         int64                           `json:"gputotal"`
-	Gpuused                          int64                           `json:"gpuused"`
-	Hahost                           bool                            `json:"hahost"`
-	Hasannotations                   bool                            `json:"hasannotations"`
-	Hasenoughcapacity                bool                            `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                       `json:"gputotal"`
+	Gpuused                          FlexInt64                       `json:"gpuused"`
+	Hahost                           FlexBool                        `json:"hahost"`
+	Hasannotations                   FlexBool                        `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                        `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse               `json:"hostha"`
 	Hosttags                         string                          `json:"hosttags"`
 	Hypervisor                       string                          `json:"hypervisor"`
 	Hypervisorversion                string                          `json:"hypervisorversion"`
 	Id                               string                          `json:"id"`
 	Implicithosttags                 string                          `json:"implicithosttags"`
-	Instanceconversionsupported      bool                            `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                        `json:"instanceconversionsupported"`
 	Ipaddress                        string                          `json:"ipaddress"`
-	Islocalstorageactive             bool                            `json:"islocalstorageactive"`
-	Istagarule                       bool                            `json:"istagarule"`
+	Islocalstorageactive             FlexBool                        `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                        `json:"istagarule"`
 	JobID                            string                          `json:"jobid"`
-	Jobstatus                        int                             `json:"jobstatus"`
-	Lastannotated                    string                          `json:"lastannotated"`
-	Lastpinged                       string                          `json:"lastpinged"`
+	Jobstatus                        FlexInt                         `json:"jobstatus"`
+	Lastannotated                    Time                            `json:"lastannotated"`
+	Lastpinged                       Time                            `json:"lastpinged"`
 	Managementserverid               UUID                            `json:"managementserverid"`
 	Managementservername             string                          `json:"managementservername"`
-	Memoryallocated                  int64                           `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                           `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                       `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                       `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                          `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                           `json:"memorytotal"`
-	Memoryused                       int64                           `json:"memoryused"`
+	Memorytotal                      FlexInt64                       `json:"memorytotal"`
+	Memoryused                       FlexInt64                       `json:"memoryused"`
 	Memorywithoverprovisioning       string                          `json:"memorywithoverprovisioning"`
 	Name                             string                          `json:"name"`
-	Networkkbsread                   int64                           `json:"networkkbsread"`
-	Networkkbswrite                  int64                           `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                       `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                       `json:"networkkbswrite"`
 	Oscategoryid                     string                          `json:"oscategoryid"`
 	Oscategoryname                   string                          `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse     `json:"outofbandmanagement"`
 	Podid                            string                          `json:"podid"`
 	Podname                          string                          `json:"podname"`
 	Podstorageaccessgroups           string                          `json:"podstorageaccessgroups"`
-	Removed                          string                          `json:"removed"`
-	Resourcestate                    string                          `json:"resourcestate"`
+	Removed                          Time                            `json:"removed"`
+	Resourcestate                    HostResourceState               `json:"resourcestate"`
 	State                            string                          `json:"state"`
 	Storageaccessgroups              string                          `json:"storageaccessgroups"`
-	Suitableformigration             bool                            `json:"suitableformigration"`
+	Suitableformigration             FlexBool                        `json:"suitableformigration"`
 	Type                             string                          `json:"type"`
-	Ueficapability                   bool                            `json:"ueficapability"`
+	Ueficapability                   FlexBool                        `json:"ueficapability"`
 	Username                         string                          `json:"username"`
 	Version                          string                          `json:"version"`
 	Virtualmachineid                 string                          `json:"virtualmachineid"`
 	Zoneid                           string                          `json:"zoneid"`
 	Zonename                         string                          `json:"zonename"`
 	Zonestorageaccessgroups          string                          `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage      `json:"-"`
+}
+
+func (r *ReconnectHostResponse) UnmarshalJSON(b []byte) error {
+	type alias ReconnectHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReconnectHostResponseGpugroup struct {
 	Gpugroupname string                              `json:"gpugroupname"`
 	Vgpu         []ReconnectHostResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage          `json:"-"`
+}
+
+func (r *ReconnectHostResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias ReconnectHostResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReconnectHostResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReconnectHostResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias ReconnectHostResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseDedicatedHostParams struct {
@@ -4678,6 +6248,44 @@ func (p *ReleaseDedicatedHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseDedicatedHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseDedicatedHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseDedicatedHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseDedicatedHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseDedicatedHostParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255},
+	}.validate("releaseDedicatedHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseDedicatedHost API, before the request is signed
+func (p *ReleaseDedicatedHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseDedicatedHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseDedicatedHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseDedicatedHost", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseDedicatedHostParams) Clone() *ReleaseDedicatedHostParams {
+	return &ReleaseDedicatedHostParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseDedicatedHostParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4710,27 +6318,39 @@ func (s *HostService) NewReleaseDedicatedHostParams(hostid string) *ReleaseDedic
 
 // Release the dedication for host
 func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseDedicatedHost", p.toURLValues())
+	return s.ReleaseDedicatedHostWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedHostWithContext is like ReleaseDedicatedHost, but honours the cancellation and deadline of ctx
+func (s *HostService) ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseDedicatedHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseDedicatedHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseDedicatedHost", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseDedicatedHost", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseDedicatedHost", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4738,11 +6358,31 @@ func (s *HostService) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*Rele
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseDedicatedHost async job into r
+func (r *ReleaseDedicatedHostResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseDedicatedHostAsync starts the async job of ReleaseDedicatedHost without waiting for it to finish, and returns a handle to the job
+func (s *HostService) ReleaseDedicatedHostAsync(ctx context.Context, p *ReleaseDedicatedHostParams) (*Job, error) {
+	r, err := s.ReleaseDedicatedHostWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseDedicatedHost", r.JobID), nil
+}
+
 type ReleaseDedicatedHostResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseDedicatedHostResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseDedicatedHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseHostReservationParams struct {
@@ -4760,6 +6400,44 @@ func (p *ReleaseHostReservationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseHostReservation API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseHostReservationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseHostReservation", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseHostReservation API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseHostReservationParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("releaseHostReservation", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseHostReservation API, before the request is signed
+func (p *ReleaseHostReservationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseHostReservationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseHostReservationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseHostReservation", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseHostReservationParams) Clone() *ReleaseHostReservationParams {
+	return &ReleaseHostReservationParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseHostReservationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4792,27 +6470,39 @@ func (s *HostService) NewReleaseHostReservationParams(id string) *ReleaseHostRes
 
 // Releases host reservation.
 func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseHostReservation", p.toURLValues())
+	return s.ReleaseHostReservationWithContext(context.Background(), p)
+}
+
+// ReleaseHostReservationWithContext is like ReleaseHostReservation, but honours the cancellation and deadline of ctx
+func (s *HostService) ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseHostReservation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseHostReservationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseHostReservation", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseHostReservation", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseHostReservation", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4820,11 +6510,31 @@ func (s *HostService) ReleaseHostReservation(p *ReleaseHostReservationParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseHostReservation async job into r
+func (r *ReleaseHostReservationResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseHostReservationAsync starts the async job of ReleaseHostReservation without waiting for it to finish, and returns a handle to the job
+func (s *HostService) ReleaseHostReservationAsync(ctx context.Context, p *ReleaseHostReservationParams) (*Job, error) {
+	r, err := s.ReleaseHostReservationWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseHostReservation", r.JobID), nil
+}
+
 type ReleaseHostReservationResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseHostReservationResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseHostReservationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateHostParams struct {
@@ -4876,6 +6586,53 @@ func (p *UpdateHostParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateHost API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateHostParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateHost", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateHost API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateHostParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"allocationstate": 255, "annotation": 4096, "id": 255, "name": 255, "oscategoryid": 255, "url": 2048},
+	}.validate("updateHost", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateHost API, before the request is signed
+func (p *UpdateHostParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateHostParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateHostParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateHost", b, &p.p, map[string]paramDecoder{
+		"allocationstate":        decodeParam[string],
+		"annotation":             decodeParam[string],
+		"cleanupexternaldetails": decodeParam[bool],
+		"externaldetails":        decodeParam[map[string]string],
+		"hosttags":               decodeParam[[]string],
+		"id":                     decodeParam[string],
+		"istagarule":             decodeParam[bool],
+		"name":                   decodeParam[string],
+		"oscategoryid":           decodeParam[string],
+		"url":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateHostParams) Clone() *UpdateHostParams {
+	return &UpdateHostParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateHostParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5097,13 +6854,22 @@ func (s *HostService) NewUpdateHostParams(id string) *UpdateHostParams {
 
 // Updates a host.
 func (s *HostService) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
-	resp, err := s.cs.newPostRequest("updateHost", p.toURLValues())
+	return s.UpdateHostWithContext(context.Background(), p)
+}
+
+// UpdateHostWithContext is like UpdateHost, but honours the cancellation and deadline of ctx
+func (s *HostService) UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateHost", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateHostResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateHost", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5120,90 +6886,108 @@ type UpdateHostResponse struct {
 	Clustertype                      string                       `json:"clustertype"`
 	Cpuallocated                     string                       `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                       `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                        `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                    `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                       `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                      `json:"cpuloadaverage"`
-	Cpunumber                        int                          `json:"cpunumber"`
-	Cpusockets                       int                          `json:"cpusockets"`
-	Cpuspeed                         int64                        `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                    `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                      `json:"cpunumber"`
+	Cpusockets                       FlexInt                      `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                    `json:"cpuspeed"`
 	Cpuused                          string                       `json:"cpuused"`
 	Cpuwithoverprovisioning          string                       `json:"cpuwithoverprovisioning"`
-	Created                          string                       `json:"created"`
+	Created                          Time                         `json:"created"`
 	Details                          map[string]string            `json:"details"`
-	Disconnected                     string                       `json:"disconnected"`
-	Disksizeallocated                int64                        `json:"disksizeallocated"`
-	Disksizetotal                    int64                        `json:"disksizetotal"`
-	Encryptionsupported              bool                         `json:"encryptionsupported"`
+	Disconnected                     Time                         `json:"disconnected"`
+	Disksizeallocated                FlexInt64                    `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                    `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                     `json:"encryptionsupported"`
 	Events                           string                       `json:"events"`
 	Explicithosttags                 string                       `json:"explicithosttags"`
 	Extensionid                      string                       `json:"extensionid"`
 	Extensionname                    string                       `json:"extensionname"`
 	Gpugroup                         []UpdateHostResponseGpugroup `json:"gpugroup"`
-	Gputotal                         int64                        `json:"gputotal"`
-	Gpuused                          int64                        `json:"gpuused"`
-	Hahost                           bool                         `json:"hahost"`
-	Hasannotations                   bool                         `json:"hasannotations"`
-	Hasenoughcapacity                bool                         `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                    `json:"gputotal"`
+	Gpuused                          FlexInt64                    `json:"gpuused"`
+	Hahost                           FlexBool                     `json:"hahost"`
+	Hasannotations                   FlexBool                     `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                     `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse            `json:"hostha"`
 	Hosttags                         string                       `json:"hosttags"`
 	Hypervisor                       string                       `json:"hypervisor"`
 	Hypervisorversion                string                       `json:"hypervisorversion"`
 	Id                               string                       `json:"id"`
 	Implicithosttags                 string                       `json:"implicithosttags"`
-	Instanceconversionsupported      bool                         `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                     `json:"instanceconversionsupported"`
 	Ipaddress                        string                       `json:"ipaddress"`
-	Islocalstorageactive             bool                         `json:"islocalstorageactive"`
-	Istagarule                       bool                         `json:"istagarule"`
+	Islocalstorageactive             FlexBool                     `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                     `json:"istagarule"`
 	JobID                            string                       `json:"jobid"`
-	Jobstatus                        int                          `json:"jobstatus"`
-	Lastannotated                    string                       `json:"lastannotated"`
-	Lastpinged                       string                       `json:"lastpinged"`
+	Jobstatus                        FlexInt                      `json:"jobstatus"`
+	Lastannotated                    Time                         `json:"lastannotated"`
+	Lastpinged                       Time                         `json:"lastpinged"`
 	Managementserverid               UUID                         `json:"managementserverid"`
 	Managementservername             string                       `json:"managementservername"`
-	Memoryallocated                  int64                        `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                        `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                    `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                    `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                       `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                        `json:"memorytotal"`
-	Memoryused                       int64                        `json:"memoryused"`
+	Memorytotal                      FlexInt64                    `json:"memorytotal"`
+	Memoryused                       FlexInt64                    `json:"memoryused"`
 	Memorywithoverprovisioning       string                       `json:"memorywithoverprovisioning"`
 	Name                             string                       `json:"name"`
-	Networkkbsread                   int64                        `json:"networkkbsread"`
-	Networkkbswrite                  int64                        `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                    `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                    `json:"networkkbswrite"`
 	Oscategoryid                     string                       `json:"oscategoryid"`
 	Oscategoryname                   string                       `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse  `json:"outofbandmanagement"`
 	Podid                            string                       `json:"podid"`
 	Podname                          string                       `json:"podname"`
 	Podstorageaccessgroups           string                       `json:"podstorageaccessgroups"`
-	Removed                          string                       `json:"removed"`
-	Resourcestate                    string                       `json:"resourcestate"`
+	Removed                          Time                         `json:"removed"`
+	Resourcestate                    HostResourceState            `json:"resourcestate"`
 	State                            string                       `json:"state"`
 	Storageaccessgroups              string                       `json:"storageaccessgroups"`
-	Suitableformigration             bool                         `json:"suitableformigration"`
+	Suitableformigration             FlexBool                     `json:"suitableformigration"`
 	Type                             string                       `json:"type"`
-	Ueficapability                   bool                         `json:"ueficapability"`
+	Ueficapability                   FlexBool                     `json:"ueficapability"`
 	Username                         string                       `json:"username"`
 	Version                          string                       `json:"version"`
 	Virtualmachineid                 string                       `json:"virtualmachineid"`
 	Zoneid                           string                       `json:"zoneid"`
 	Zonename                         string                       `json:"zonename"`
 	Zonestorageaccessgroups          string                       `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage   `json:"-"`
+}
+
+func (r *UpdateHostResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateHostResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateHostResponseGpugroup struct {
 	Gpugroupname string                           `json:"gpugroupname"`
 	Vgpu         []UpdateHostResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage       `json:"-"`
+}
+
+func (r *UpdateHostResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateHostResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateHostResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateHostResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias UpdateHostResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateHostPasswordParams struct {
@@ -5234,6 +7018,48 @@ func (p *UpdateHostPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateHostPassword API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateHostPasswordParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateHostPassword", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateHostPassword API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateHostPasswordParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "username"},
+		lengths:  map[string]int{"clusterid": 255, "hostid": 255, "password": 255, "username": 255},
+	}.validate("updateHostPassword", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateHostPassword API, before the request is signed
+func (p *UpdateHostPasswordParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateHostPasswordParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateHostPasswordParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateHostPassword", b, &p.p, map[string]paramDecoder{
+		"clusterid":             decodeParam[string],
+		"hostid":                decodeParam[string],
+		"password":              decodeParam[string],
+		"update_passwd_on_host": decodeParam[bool],
+		"username":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateHostPasswordParams) Clone() *UpdateHostPasswordParams {
+	return &UpdateHostPasswordParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateHostPasswordParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5351,13 +7177,22 @@ func (s *HostService) NewUpdateHostPasswordParams(password string, username stri
 
 // Update password of a host/pool on management server.
 func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
-	resp, err := s.cs.newPostRequest("updateHostPassword", p.toURLValues())
+	return s.UpdateHostPasswordWithContext(context.Background(), p)
+}
+
+// UpdateHostPasswordWithContext is like UpdateHostPassword, but honours the cancellation and deadline of ctx
+func (s *HostService) UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateHostPassword", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateHostPasswordResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateHostPassword", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5365,10 +7200,11 @@ func (s *HostService) UpdateHostPassword(p *UpdateHostPasswordParams) (*UpdateHo
 }
 
 type UpdateHostPasswordResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateHostPasswordResponse) UnmarshalJSON(b []byte) error {
@@ -5395,7 +7231,7 @@ func (r *UpdateHostPasswordResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateHostPasswordResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateSecondaryStorageDataParams struct {
@@ -5420,6 +7256,46 @@ func (p *MigrateSecondaryStorageDataParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateSecondaryStorageData API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateSecondaryStorageDataParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateSecondaryStorageData", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateSecondaryStorageData API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateSecondaryStorageDataParams) Validate() error {
+	return paramRules{
+		required: []string{"destpools", "srcpool"},
+		lengths:  map[string]int{"migrationtype": 255, "srcpool": 255},
+	}.validate("migrateSecondaryStorageData", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateSecondaryStorageData API, before the request is signed
+func (p *MigrateSecondaryStorageDataParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateSecondaryStorageDataParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateSecondaryStorageDataParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateSecondaryStorageData", b, &p.p, map[string]paramDecoder{
+		"destpools":     decodeParam[[]string],
+		"migrationtype": decodeParam[string],
+		"srcpool":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateSecondaryStorageDataParams) Clone() *MigrateSecondaryStorageDataParams {
+	return &MigrateSecondaryStorageDataParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateSecondaryStorageDataParams) SetDestpools(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5495,32 +7371,39 @@ func (s *HostService) NewMigrateSecondaryStorageDataParams(destpools []string, s
 
 // Migrates data objects from one secondary storage to destination image store(s)
 func (s *HostService) MigrateSecondaryStorageData(p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateSecondaryStorageData", p.toURLValues())
+	return s.MigrateSecondaryStorageDataWithContext(context.Background(), p)
+}
+
+// MigrateSecondaryStorageDataWithContext is like MigrateSecondaryStorageData, but honours the cancellation and deadline of ctx
+func (s *HostService) MigrateSecondaryStorageDataWithContext(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateSecondaryStorageData", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateSecondaryStorageDataResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateSecondaryStorageData", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateSecondaryStorageData", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateSecondaryStorageData", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5528,12 +7411,37 @@ func (s *HostService) MigrateSecondaryStorageData(p *MigrateSecondaryStorageData
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateSecondaryStorageData async job into r
+func (r *MigrateSecondaryStorageDataResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateSecondaryStorageDataAsync starts the async job of MigrateSecondaryStorageData without waiting for it to finish, and returns a handle to the job
+func (s *HostService) MigrateSecondaryStorageDataAsync(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*Job, error) {
+	r, err := s.MigrateSecondaryStorageDataWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateSecondaryStorageData", r.JobID), nil
+}
+
 type MigrateSecondaryStorageDataResponse struct {
-	JobID         string `json:"jobid"`
-	Jobstatus     int    `json:"jobstatus"`
-	Message       string `json:"message"`
-	Migrationtype string `json:"migrationtype"`
-	Success       bool   `json:"success"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Message       string                     `json:"message"`
+	Migrationtype string                     `json:"migrationtype"`
+	Success       bool                       `json:"success"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateSecondaryStorageDataResponse) UnmarshalJSON(b []byte) error {
+	type alias MigrateSecondaryStorageDataResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelHostAsDegradedParams struct {
@@ -5551,6 +7459,44 @@ func (p *CancelHostAsDegradedParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the cancelHostAsDegraded API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CancelHostAsDegradedParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("cancelHostAsDegraded", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the cancelHostAsDegraded API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CancelHostAsDegradedParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("cancelHostAsDegraded", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the cancelHostAsDegraded API, before the request is signed
+func (p *CancelHostAsDegradedParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CancelHostAsDegradedParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CancelHostAsDegradedParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("cancelHostAsDegraded", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CancelHostAsDegradedParams) Clone() *CancelHostAsDegradedParams {
+	return &CancelHostAsDegradedParams{p: cloneParams(p.p)}
+}
+
 func (p *CancelHostAsDegradedParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5583,32 +7529,39 @@ func (s *HostService) NewCancelHostAsDegradedParams(id string) *CancelHostAsDegr
 
 // Cancel host status from 'Degraded'. Host will transit back to status 'Enabled'.
 func (s *HostService) CancelHostAsDegraded(p *CancelHostAsDegradedParams) (*CancelHostAsDegradedResponse, error) {
-	resp, err := s.cs.newPostRequest("cancelHostAsDegraded", p.toURLValues())
+	return s.CancelHostAsDegradedWithContext(context.Background(), p)
+}
+
+// CancelHostAsDegradedWithContext is like CancelHostAsDegraded, but honours the cancellation and deadline of ctx
+func (s *HostService) CancelHostAsDegradedWithContext(ctx context.Context, p *CancelHostAsDegradedParams) (*CancelHostAsDegradedResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "cancelHostAsDegraded", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CancelHostAsDegradedResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("cancelHostAsDegraded", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "cancelHostAsDegraded", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("cancelHostAsDegraded", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5616,6 +7569,25 @@ func (s *HostService) CancelHostAsDegraded(p *CancelHostAsDegradedParams) (*Canc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CancelHostAsDegraded async job into r
+func (r *CancelHostAsDegradedResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CancelHostAsDegradedAsync starts the async job of CancelHostAsDegraded without waiting for it to finish, and returns a handle to the job
+func (s *HostService) CancelHostAsDegradedAsync(ctx context.Context, p *CancelHostAsDegradedParams) (*Job, error) {
+	r, err := s.CancelHostAsDegradedWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("cancelHostAsDegraded", r.JobID), nil
+}
+
 type CancelHostAsDegradedResponse struct {
 	Annotation                       string                                 `json:"annotation"`
 	Arch                             string                                 `json:"arch"`
@@ -5626,90 +7598,108 @@ type CancelHostAsDegradedResponse struct {
 	Clustertype                      string                                 `json:"clustertype"`
 	Cpuallocated                     string                                 `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                                 `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                                  `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                              `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                                 `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                                `json:"cpuloadaverage"`
-	Cpunumber                        int                                    `json:"cpunumber"`
-	Cpusockets                       int                                    `json:"cpusockets"`
-	Cpuspeed                         int64                                  `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                              `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                                `json:"cpunumber"`
+	Cpusockets                       FlexInt                                `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                              `json:"cpuspeed"`
 	Cpuused                          string                                 `json:"cpuused"`
 	Cpuwithoverprovisioning          string                                 `json:"cpuwithoverprovisioning"`
-	Created                          string                                 `json:"created"`
+	Created                          Time                                   `json:"created"`
 	Details                          map[string]string                      `json:"details"`
-	Disconnected                     string                                 `json:"disconnected"`
-	Disksizeallocated                int64                                  `json:"disksizeallocated"`
-	Disksizetotal                    int64                                  `json:"disksizetotal"`
-	Encryptionsupported              bool                                   `json:"encryptionsupported"`
+	Disconnected                     Time                                   `json:"disconnected"`
+	Disksizeallocated                FlexInt64                              `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                              `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                               `json:"encryptionsupported"`
 	Events                           string                                 `json:"events"`
 	Explicithosttags                 string                                 `json:"explicithosttags"`
 	Extensionid                      string                                 `json:"extensionid"`
 	Extensionname                    string                                 `json:"extensionname"`
 	Gpugroup                         []CancelHostAsDegradedResponseGpugroup `json:"gpugroup"`
-	Gputotal                         int64                                  `json:"gputotal"`
-	Gpuused                          int64                                  `json:"gpuused"`
-	Hahost                           bool                                   `json:"hahost"`
-	Hasannotations                   bool                                   `json:"hasannotations"`
-	Hasenoughcapacity                bool                                   `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                              `json:"gputotal"`
+	Gpuused                          FlexInt64                              `json:"gpuused"`
+	Hahost                           FlexBool                               `json:"hahost"`
+	Hasannotations                   FlexBool                               `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                               `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse                      `json:"hostha"`
 	Hosttags                         string                                 `json:"hosttags"`
 	Hypervisor                       string                                 `json:"hypervisor"`
 	Hypervisorversion                string                                 `json:"hypervisorversion"`
 	Id                               string                                 `json:"id"`
 	Implicithosttags                 string                                 `json:"implicithosttags"`
-	Instanceconversionsupported      bool                                   `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                               `json:"instanceconversionsupported"`
 	Ipaddress                        string                                 `json:"ipaddress"`
-	Islocalstorageactive             bool                                   `json:"islocalstorageactive"`
-	Istagarule                       bool                                   `json:"istagarule"`
+	Islocalstorageactive             FlexBool                               `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                               `json:"istagarule"`
 	JobID                            string                                 `json:"jobid"`
-	Jobstatus                        int                                    `json:"jobstatus"`
-	Lastannotated                    string                                 `json:"lastannotated"`
-	Lastpinged                       string                                 `json:"lastpinged"`
+	Jobstatus                        FlexInt                                `json:"jobstatus"`
+	Lastannotated                    Time                                   `json:"lastannotated"`
+	Lastpinged                       Time                                   `json:"lastpinged"`
 	Managementserverid               UUID                                   `json:"managementserverid"`
 	Managementservername             string                                 `json:"managementservername"`
-	Memoryallocated                  int64                                  `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                                  `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                              `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                              `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                                 `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                                  `json:"memorytotal"`
-	Memoryused                       int64                                  `json:"memoryused"`
+	Memorytotal                      FlexInt64                              `json:"memorytotal"`
+	Memoryused                       FlexInt64                              `json:"memoryused"`
 	Memorywithoverprovisioning       string                                 `json:"memorywithoverprovisioning"`
 	Name                             string                                 `json:"name"`
-	Networkkbsread                   int64                                  `json:"networkkbsread"`
-	Networkkbswrite                  int64                                  `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                              `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                              `json:"networkkbswrite"`
 	Oscategoryid                     string                                 `json:"oscategoryid"`
 	Oscategoryname                   string                                 `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse            `json:"outofbandmanagement"`
 	Podid                            string                                 `json:"podid"`
 	Podname                          string                                 `json:"podname"`
 	Podstorageaccessgroups           string                                 `json:"podstorageaccessgroups"`
-	Removed                          string                                 `json:"removed"`
-	Resourcestate                    string                                 `json:"resourcestate"`
+	Removed                          Time                                   `json:"removed"`
+	Resourcestate                    HostResourceState                      `json:"resourcestate"`
 	State                            string                                 `json:"state"`
 	Storageaccessgroups              string                                 `json:"storageaccessgroups"`
-	Suitableformigration             bool                                   `json:"suitableformigration"`
+	Suitableformigration             FlexBool                               `json:"suitableformigration"`
 	Type                             string                                 `json:"type"`
-	Ueficapability                   bool                                   `json:"ueficapability"`
+	Ueficapability                   FlexBool                               `json:"ueficapability"`
 	Username                         string                                 `json:"username"`
 	Version                          string                                 `json:"version"`
 	Virtualmachineid                 string                                 `json:"virtualmachineid"`
 	Zoneid                           string                                 `json:"zoneid"`
 	Zonename                         string                                 `json:"zonename"`
 	Zonestorageaccessgroups          string                                 `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage             `json:"-"`
+}
+
+func (r *CancelHostAsDegradedResponse) UnmarshalJSON(b []byte) error {
+	type alias CancelHostAsDegradedResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelHostAsDegradedResponseGpugroup struct {
 	Gpugroupname string                                     `json:"gpugroupname"`
 	Vgpu         []CancelHostAsDegradedResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage                 `json:"-"`
+}
+
+func (r *CancelHostAsDegradedResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias CancelHostAsDegradedResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelHostAsDegradedResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CancelHostAsDegradedResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias CancelHostAsDegradedResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListHostHAProvidersParams struct {
@@ -5722,12 +7712,50 @@ func (p *ListHostHAProvidersParams) toURLValues() url.Values {
 		return u
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	return u
 }
 
-func (p *ListHostHAProvidersParams) SetHypervisor(v string) {
+// ValidateAgainst checks the parameters against the listHostHAProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListHostHAProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listHostHAProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listHostHAProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListHostHAProvidersParams) Validate() error {
+	return paramRules{
+		required: []string{"hypervisor"},
+		lengths:  map[string]int{"hypervisor": 255},
+	}.validate("listHostHAProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listHostHAProviders API, before the request is signed
+func (p *ListHostHAProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListHostHAProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListHostHAProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listHostHAProviders", b, &p.p, map[string]paramDecoder{
+		"hypervisor": decodeParam[HypervisorType],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListHostHAProvidersParams) Clone() *ListHostHAProvidersParams {
+	return &ListHostHAProvidersParams{p: cloneParams(p.p)}
+}
+
+func (p *ListHostHAProvidersParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -5740,17 +7768,17 @@ func (p *ListHostHAProvidersParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListHostHAProvidersParams) GetHypervisor() (string, bool) {
+func (p *ListHostHAProvidersParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
 // You should always use this function to get a new ListHostHAProvidersParams instance,
 // as then you are sure you have configured all required params
-func (s *HostService) NewListHostHAProvidersParams(hypervisor string) *ListHostHAProvidersParams {
+func (s *HostService) NewListHostHAProvidersParams(hypervisor HypervisorType) *ListHostHAProvidersParams {
 	p := &ListHostHAProvidersParams{}
 	p.p = make(map[string]interface{})
 	p.p["hypervisor"] = hypervisor
@@ -5759,13 +7787,22 @@ func (s *HostService) NewListHostHAProvidersParams(hypervisor string) *ListHostH
 
 // Lists HA providers
 func (s *HostService) ListHostHAProviders(p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listHostHAProviders", p.toURLValues())
+	return s.ListHostHAProvidersWithContext(context.Background(), p)
+}
+
+// ListHostHAProvidersWithContext is like ListHostHAProviders, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostHAProvidersWithContext(ctx context.Context, p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listHostHAProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHostHAProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listHostHAProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5778,13 +7815,19 @@ type ListHostHAProvidersResponse struct {
 }
 
 type HostHAProvider struct {
-	Haenable   bool   `json:"haenable"`
-	Haprovider string `json:"haprovider"`
-	Hastate    string `json:"hastate"`
-	Hostid     string `json:"hostid"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Status     bool   `json:"status"`
+	Haenable   FlexBool                   `json:"haenable"`
+	Haprovider string                     `json:"haprovider"`
+	Hastate    string                     `json:"hastate"`
+	Hostid     string                     `json:"hostid"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Status     FlexBool                   `json:"status"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostHAProvider) UnmarshalJSON(b []byte) error {
+	type alias HostHAProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSecondaryStorageSelectorsParams struct {
@@ -5820,6 +7863,49 @@ func (p *ListSecondaryStorageSelectorsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSecondaryStorageSelectors API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSecondaryStorageSelectorsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSecondaryStorageSelectors", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSecondaryStorageSelectors API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSecondaryStorageSelectorsParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"keyword": 255, "type": 255, "zoneid": 255},
+	}.validate("listSecondaryStorageSelectors", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSecondaryStorageSelectors API, before the request is signed
+func (p *ListSecondaryStorageSelectorsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSecondaryStorageSelectorsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSecondaryStorageSelectorsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSecondaryStorageSelectors", b, &p.p, map[string]paramDecoder{
+		"keyword":     decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"showremoved": decodeParam[bool],
+		"type":        decodeParam[string],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSecondaryStorageSelectorsParams) Clone() *ListSecondaryStorageSelectorsParams {
+	return &ListSecondaryStorageSelectorsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSecondaryStorageSelectorsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5957,6 +8043,11 @@ func (s *HostService) NewListSecondaryStorageSelectorsParams(zoneid string) *Lis
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HostService) GetSecondaryStorageSelectorID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSecondaryStorageSelectorIDWithContext(context.Background(), keyword, zoneid, opts...)
+}
+
+// GetSecondaryStorageSelectorIDWithContext is like GetSecondaryStorageSelectorID, but honours the cancellation and deadline of ctx
+func (s *HostService) GetSecondaryStorageSelectorIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecondaryStorageSelectorsParams{}
 	p.p = make(map[string]interface{})
 
@@ -5969,7 +8060,7 @@ func (s *HostService) GetSecondaryStorageSelectorID(keyword string, zoneid strin
 		}
 	}
 
-	l, err := s.ListSecondaryStorageSelectors(p)
+	l, err := s.ListSecondaryStorageSelectorsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -5994,35 +8085,78 @@ func (s *HostService) GetSecondaryStorageSelectorID(keyword string, zoneid strin
 
 // Lists the secondary storage selectors and their rules.
 func (s *HostService) ListSecondaryStorageSelectors(p *ListSecondaryStorageSelectorsParams) (*ListSecondaryStorageSelectorsResponse, error) {
-	resp, err := s.cs.newRequest("listSecondaryStorageSelectors", p.toURLValues())
+	return s.ListSecondaryStorageSelectorsWithContext(context.Background(), p)
+}
+
+// ListSecondaryStorageSelectorsWithContext is like ListSecondaryStorageSelectors, but honours the cancellation and deadline of ctx
+func (s *HostService) ListSecondaryStorageSelectorsWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams) (*ListSecondaryStorageSelectorsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSecondaryStorageSelectors", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSecondaryStorageSelectorsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSecondaryStorageSelectors", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSecondaryStorageSelectorsAll returns all SecondaryStorageSelectors matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *HostService) ListSecondaryStorageSelectorsAll(p *ListSecondaryStorageSelectorsParams, opts ...PageOption) ([]*SecondaryStorageSelector, error) {
+	return s.ListSecondaryStorageSelectorsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSecondaryStorageSelectorsAllWithContext is like ListSecondaryStorageSelectorsAll, but honours the cancellation and deadline of ctx
+func (s *HostService) ListSecondaryStorageSelectorsAllWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams, opts ...PageOption) ([]*SecondaryStorageSelector, error) {
+	return collectPages(s.ListSecondaryStorageSelectorsIterWithContext(ctx, p, opts...))
+}
+
+// ListSecondaryStorageSelectorsIter returns an iterator over all SecondaryStorageSelectors matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *HostService) ListSecondaryStorageSelectorsIter(p *ListSecondaryStorageSelectorsParams, opts ...PageOption) iter.Seq2[*SecondaryStorageSelector, error] {
+	return s.ListSecondaryStorageSelectorsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSecondaryStorageSelectorsIterWithContext is like ListSecondaryStorageSelectorsIter, but honours the cancellation and deadline of ctx
+func (s *HostService) ListSecondaryStorageSelectorsIterWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams, opts ...PageOption) iter.Seq2[*SecondaryStorageSelector, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SecondaryStorageSelector, int, error) {
+		l, err := s.ListSecondaryStorageSelectorsWithContext(ctx, &ListSecondaryStorageSelectorsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SecondaryStorageSelectors, l.Count, nil
+	}, opts...)
+}
+
 type ListSecondaryStorageSelectorsResponse struct {
 	Count                     int                         `json:"count"`
 	SecondaryStorageSelectors []*SecondaryStorageSelector `json:"heuristics"`
 }
 
 type SecondaryStorageSelector struct {
-	Created       string `json:"created"`
-	Description   string `json:"description"`
-	Heuristicrule string `json:"heuristicrule"`
-	Id            string `json:"id"`
-	JobID         string `json:"jobid"`
-	Jobstatus     int    `json:"jobstatus"`
-	Name          string `json:"name"`
-	Removed       string `json:"removed"`
-	Type          string `json:"type"`
-	Zoneid        string `json:"zoneid"`
+	Created       Time                       `json:"created"`
+	Description   string                     `json:"description"`
+	Heuristicrule string                     `json:"heuristicrule"`
+	Id            string                     `json:"id"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Name          string                     `json:"name"`
+	Removed       Time                       `json:"removed"`
+	Type          string                     `json:"type"`
+	Zoneid        string                     `json:"zoneid"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SecondaryStorageSelector) UnmarshalJSON(b []byte) error {
+	type alias SecondaryStorageSelector
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSecondaryStorageSelectorParams struct {
@@ -6052,6 +8186,48 @@ func (p *CreateSecondaryStorageSelectorParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSecondaryStorageSelector API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSecondaryStorageSelectorParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSecondaryStorageSelector", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSecondaryStorageSelector API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSecondaryStorageSelectorParams) Validate() error {
+	return paramRules{
+		required: []string{"description", "heuristicrule", "name", "type", "zoneid"},
+		lengths:  map[string]int{"description": 4096, "heuristicrule": 255, "name": 255, "type": 255, "zoneid": 255},
+	}.validate("createSecondaryStorageSelector", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSecondaryStorageSelector API, before the request is signed
+func (p *CreateSecondaryStorageSelectorParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSecondaryStorageSelectorParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSecondaryStorageSelectorParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSecondaryStorageSelector", b, &p.p, map[string]paramDecoder{
+		"description":   decodeParam[string],
+		"heuristicrule": decodeParam[string],
+		"name":          decodeParam[string],
+		"type":          decodeParam[string],
+		"zoneid":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSecondaryStorageSelectorParams) Clone() *CreateSecondaryStorageSelectorParams {
+	return &CreateSecondaryStorageSelectorParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSecondaryStorageSelectorParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6172,7 +8348,16 @@ func (s *HostService) NewCreateSecondaryStorageSelectorParams(description string
 
 // Creates a secondary storage selector, described by the heuristic rule.
 func (s *HostService) CreateSecondaryStorageSelector(p *CreateSecondaryStorageSelectorParams) (*CreateSecondaryStorageSelectorResponse, error) {
-	resp, err := s.cs.newPostRequest("createSecondaryStorageSelector", p.toURLValues())
+	return s.CreateSecondaryStorageSelectorWithContext(context.Background(), p)
+}
+
+// CreateSecondaryStorageSelectorWithContext is like CreateSecondaryStorageSelector, but honours the cancellation and deadline of ctx
+func (s *HostService) CreateSecondaryStorageSelectorWithContext(ctx context.Context, p *CreateSecondaryStorageSelectorParams) (*CreateSecondaryStorageSelectorResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createSecondaryStorageSelector", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -6180,7 +8365,7 @@ func (s *HostService) CreateSecondaryStorageSelector(p *CreateSecondaryStorageSe
 	var nested struct {
 		Response CreateSecondaryStorageSelectorResponse `json:"heuristics"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("createSecondaryStorageSelector", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -6189,16 +8374,22 @@ func (s *HostService) CreateSecondaryStorageSelector(p *CreateSecondaryStorageSe
 }
 
 type CreateSecondaryStorageSelectorResponse struct {
-	Created       string `json:"created"`
-	Description   string `json:"description"`
-	Heuristicrule string `json:"heuristicrule"`
-	Id            string `json:"id"`
-	JobID         string `json:"jobid"`
-	Jobstatus     int    `json:"jobstatus"`
-	Name          string `json:"name"`
-	Removed       string `json:"removed"`
-	Type          string `json:"type"`
-	Zoneid        string `json:"zoneid"`
+	Created       Time                       `json:"created"`
+	Description   string                     `json:"description"`
+	Heuristicrule string                     `json:"heuristicrule"`
+	Id            string                     `json:"id"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Name          string                     `json:"name"`
+	Removed       Time                       `json:"removed"`
+	Type          string                     `json:"type"`
+	Zoneid        string                     `json:"zoneid"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateSecondaryStorageSelectorResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateSecondaryStorageSelectorResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveSecondaryStorageSelectorParams struct {
@@ -6216,6 +8407,44 @@ func (p *RemoveSecondaryStorageSelectorParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeSecondaryStorageSelector API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveSecondaryStorageSelectorParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeSecondaryStorageSelector", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeSecondaryStorageSelector API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveSecondaryStorageSelectorParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeSecondaryStorageSelector", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeSecondaryStorageSelector API, before the request is signed
+func (p *RemoveSecondaryStorageSelectorParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveSecondaryStorageSelectorParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveSecondaryStorageSelectorParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeSecondaryStorageSelector", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveSecondaryStorageSelectorParams) Clone() *RemoveSecondaryStorageSelectorParams {
+	return &RemoveSecondaryStorageSelectorParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveSecondaryStorageSelectorParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6248,13 +8477,22 @@ func (s *HostService) NewRemoveSecondaryStorageSelectorParams(id string) *Remove
 
 // Removes an existing secondary storage selector.
 func (s *HostService) RemoveSecondaryStorageSelector(p *RemoveSecondaryStorageSelectorParams) (*RemoveSecondaryStorageSelectorResponse, error) {
-	resp, err := s.cs.newPostRequest("removeSecondaryStorageSelector", p.toURLValues())
+	return s.RemoveSecondaryStorageSelectorWithContext(context.Background(), p)
+}
+
+// RemoveSecondaryStorageSelectorWithContext is like RemoveSecondaryStorageSelector, but honours the cancellation and deadline of ctx
+func (s *HostService) RemoveSecondaryStorageSelectorWithContext(ctx context.Context, p *RemoveSecondaryStorageSelectorParams) (*RemoveSecondaryStorageSelectorResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeSecondaryStorageSelector", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveSecondaryStorageSelectorResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeSecondaryStorageSelector", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -6262,10 +8500,11 @@ func (s *HostService) RemoveSecondaryStorageSelector(p *RemoveSecondaryStorageSe
 }
 
 type RemoveSecondaryStorageSelectorResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RemoveSecondaryStorageSelectorResponse) UnmarshalJSON(b []byte) error {
@@ -6292,7 +8531,7 @@ func (r *RemoveSecondaryStorageSelectorResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RemoveSecondaryStorageSelectorResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListHostHAResourcesParams struct {
@@ -6310,6 +8549,43 @@ func (p *ListHostHAResourcesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listHostHAResources API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListHostHAResourcesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listHostHAResources", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listHostHAResources API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListHostHAResourcesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"hostid": 255},
+	}.validate("listHostHAResources", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listHostHAResources API, before the request is signed
+func (p *ListHostHAResourcesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListHostHAResourcesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListHostHAResourcesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listHostHAResources", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListHostHAResourcesParams) Clone() *ListHostHAResourcesParams {
+	return &ListHostHAResourcesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListHostHAResourcesParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6341,13 +8617,22 @@ func (s *HostService) NewListHostHAResourcesParams() *ListHostHAResourcesParams
 
 // Lists host HA resources
 func (s *HostService) ListHostHAResources(p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error) {
-	resp, err := s.cs.newRequest("listHostHAResources", p.toURLValues())
+	return s.ListHostHAResourcesWithContext(context.Background(), p)
+}
+
+// ListHostHAResourcesWithContext is like ListHostHAResources, but honours the cancellation and deadline of ctx
+func (s *HostService) ListHostHAResourcesWithContext(ctx context.Context, p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listHostHAResources", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHostHAResourcesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listHostHAResources", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -6360,13 +8645,19 @@ type ListHostHAResourcesResponse struct {
 }
 
 type HostHAResource struct {
-	Haenable   bool   `json:"haenable"`
-	Haprovider string `json:"haprovider"`
-	Hastate    string `json:"hastate"`
-	Hostid     string `json:"hostid"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Status     bool   `json:"status"`
+	Haenable   FlexBool                   `json:"haenable"`
+	Haprovider string                     `json:"haprovider"`
+	Hastate    string                     `json:"hastate"`
+	Hostid     string                     `json:"hostid"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Status     FlexBool                   `json:"status"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HostHAResource) UnmarshalJSON(b []byte) error {
+	type alias HostHAResource
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeclareHostAsDegradedParams struct {
@@ -6384,6 +8675,44 @@ func (p *DeclareHostAsDegradedParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the declareHostAsDegraded API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeclareHostAsDegradedParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("declareHostAsDegraded", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the declareHostAsDegraded API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeclareHostAsDegradedParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("declareHostAsDegraded", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the declareHostAsDegraded API, before the request is signed
+func (p *DeclareHostAsDegradedParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeclareHostAsDegradedParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeclareHostAsDegradedParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("declareHostAsDegraded", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeclareHostAsDegradedParams) Clone() *DeclareHostAsDegradedParams {
+	return &DeclareHostAsDegradedParams{p: cloneParams(p.p)}
+}
+
 func (p *DeclareHostAsDegradedParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6416,32 +8745,39 @@ func (s *HostService) NewDeclareHostAsDegradedParams(id string) *DeclareHostAsDe
 
 // Declare host as 'Degraded'. Host must be on 'Disconnected' or 'Alert' state. The ADMIN must be sure that there are no Instances running on the respective host otherwise this command might corrupted Instances that were running on the 'Degraded' host.
 func (s *HostService) DeclareHostAsDegraded(p *DeclareHostAsDegradedParams) (*DeclareHostAsDegradedResponse, error) {
-	resp, err := s.cs.newPostRequest("declareHostAsDegraded", p.toURLValues())
+	return s.DeclareHostAsDegradedWithContext(context.Background(), p)
+}
+
+// DeclareHostAsDegradedWithContext is like DeclareHostAsDegraded, but honours the cancellation and deadline of ctx
+func (s *HostService) DeclareHostAsDegradedWithContext(ctx context.Context, p *DeclareHostAsDegradedParams) (*DeclareHostAsDegradedResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "declareHostAsDegraded", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeclareHostAsDegradedResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("declareHostAsDegraded", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "declareHostAsDegraded", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("declareHostAsDegraded", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6449,6 +8785,25 @@ func (s *HostService) DeclareHostAsDegraded(p *DeclareHostAsDegradedParams) (*De
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeclareHostAsDegraded async job into r
+func (r *DeclareHostAsDegradedResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeclareHostAsDegradedAsync starts the async job of DeclareHostAsDegraded without waiting for it to finish, and returns a handle to the job
+func (s *HostService) DeclareHostAsDegradedAsync(ctx context.Context, p *DeclareHostAsDegradedParams) (*Job, error) {
+	r, err := s.DeclareHostAsDegradedWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("declareHostAsDegraded", r.JobID), nil
+}
+
 type DeclareHostAsDegradedResponse struct {
 	Annotation                       string                                  `json:"annotation"`
 	Arch                             string                                  `json:"arch"`
@@ -6459,90 +8814,108 @@ type DeclareHostAsDegradedResponse struct {
 	Clustertype                      string                                  `json:"clustertype"`
 	Cpuallocated                     string                                  `json:"cpuallocated"`
 	Cpuallocatedpercentage           string                                  `json:"cpuallocatedpercentage"`
-	Cpuallocatedvalue                int64                                   `json:"cpuallocatedvalue"`
+	Cpuallocatedvalue                FlexInt64                               `json:"cpuallocatedvalue"`
 	Cpuallocatedwithoverprovisioning string                                  `json:"cpuallocatedwithoverprovisioning"`
-	Cpuloadaverage                   float64                                 `json:"cpuloadaverage"`
-	Cpunumber                        int                                     `json:"cpunumber"`
-	Cpusockets                       int                                     `json:"cpusockets"`
-	Cpuspeed                         int64                                   `json:"cpuspeed"`
+	Cpuloadaverage                   FlexFloat                               `json:"cpuloadaverage"`
+	Cpunumber                        FlexInt                                 `json:"cpunumber"`
+	Cpusockets                       FlexInt                                 `json:"cpusockets"`
+	Cpuspeed                         FlexInt64                               `json:"cpuspeed"`
 	Cpuused                          string                                  `json:"cpuused"`
 	Cpuwithoverprovisioning          string                                  `json:"cpuwithoverprovisioning"`
-	Created                          string                                  `json:"created"`
+	Created                          Time                                    `json:"created"`
 	Details                          map[string]string                       `json:"details"`
-	Disconnected                     string                                  `json:"disconnected"`
-	Disksizeallocated                int64                                   `json:"disksizeallocated"`
-	Disksizetotal                    int64                                   `json:"disksizetotal"`
-	Encryptionsupported              bool                                    `json:"encryptionsupported"`
+	Disconnected                     Time                                    `json:"disconnected"`
+	Disksizeallocated                FlexInt64                               `json:"disksizeallocated"`
+	Disksizetotal                    FlexInt64                               `json:"disksizetotal"`
+	Encryptionsupported              FlexBool                                `json:"encryptionsupported"`
 	Events                           string                                  `json:"events"`
 	Explicithosttags                 string                                  `json:"explicithosttags"`
 	Extensionid                      string                                  `json:"extensionid"`
 	Extensionname                    string                                  `json:"extensionname"`
 	Gpugroup                         []DeclareHostAsDegradedResponseGpugroup `json:"gpugroup"`
-	Gputotal                         int64                                   `json:"gputotal"`
-	Gpuused                          int64                                   `json:"gpuused"`
-	Hahost                           bool                                    `json:"hahost"`
-	Hasannotations                   bool                                    `json:"hasannotations"`
-	Hasenoughcapacity                bool                                    `json:"hasenoughcapacity"`
+	Gputotal                         FlexInt64                               `json:"gputotal"`
+	Gpuused                          FlexInt64                               `json:"gpuused"`
+	Hahost                           FlexBool                                `json:"hahost"`
+	Hasannotations                   FlexBool                                `json:"hasannotations"`
+	Hasenoughcapacity                FlexBool                                `json:"hasenoughcapacity"`
 	Hostha                           HAForHostResponse                       `json:"hostha"`
 	Hosttags                         string                                  `json:"hosttags"`
 	Hypervisor                       string                                  `json:"hypervisor"`
 	Hypervisorversion                string                                  `json:"hypervisorversion"`
 	Id                               string                                  `json:"id"`
 	Implicithosttags                 string                                  `json:"implicithosttags"`
-	Instanceconversionsupported      bool                                    `json:"instanceconversionsupported"`
+	Instanceconversionsupported      FlexBool                                `json:"instanceconversionsupported"`
 	Ipaddress                        string                                  `json:"ipaddress"`
-	Islocalstorageactive             bool                                    `json:"islocalstorageactive"`
-	Istagarule                       bool                                    `json:"istagarule"`
+	Islocalstorageactive             FlexBool                                `json:"islocalstorageactive"`
+	Istagarule                       FlexBool                                `json:"istagarule"`
 	JobID                            string                                  `json:"jobid"`
-	Jobstatus                        int                                     `json:"jobstatus"`
-	Lastannotated                    string                                  `json:"lastannotated"`
-	Lastpinged                       string                                  `json:"lastpinged"`
+	Jobstatus                        FlexInt                                 `json:"jobstatus"`
+	Lastannotated                    Time                                    `json:"lastannotated"`
+	Lastpinged                       Time                                    `json:"lastpinged"`
 	Managementserverid               UUID                                    `json:"managementserverid"`
 	Managementservername             string                                  `json:"managementservername"`
-	Memoryallocated                  int64                                   `json:"memoryallocated"`
-	Memoryallocatedbytes             int64                                   `json:"memoryallocatedbytes"`
+	Memoryallocated                  FlexInt64                               `json:"memoryallocated"`
+	Memoryallocatedbytes             FlexInt64                               `json:"memoryallocatedbytes"`
 	Memoryallocatedpercentage        string                                  `json:"memoryallocatedpercentage"`
-	Memorytotal                      int64                                   `json:"memorytotal"`
-	Memoryused                       int64                                   `json:"memoryused"`
+	Memorytotal                      FlexInt64                               `json:"memorytotal"`
+	Memoryused                       FlexInt64                               `json:"memoryused"`
 	Memorywithoverprovisioning       string                                  `json:"memorywithoverprovisioning"`
 	Name                             string                                  `json:"name"`
-	Networkkbsread                   int64                                   `json:"networkkbsread"`
-	Networkkbswrite                  int64                                   `json:"networkkbswrite"`
+	Networkkbsread                   FlexInt64                               `json:"networkkbsread"`
+	Networkkbswrite                  FlexInt64                               `json:"networkkbswrite"`
 	Oscategoryid                     string                                  `json:"oscategoryid"`
 	Oscategoryname                   string                                  `json:"oscategoryname"`
 	Outofbandmanagement              OutOfBandManagementResponse             `json:"outofbandmanagement"`
 	Podid                            string                                  `json:"podid"`
 	Podname                          string                                  `json:"podname"`
 	Podstorageaccessgroups           string                                  `json:"podstorageaccessgroups"`
-	Removed                          string                                  `json:"removed"`
-	Resourcestate                    string                                  `json:"resourcestate"`
+	Removed                          Time                                    `json:"removed"`
+	Resourcestate                    HostResourceState                       `json:"resourcestate"`
 	State                            string                                  `json:"state"`
 	Storageaccessgroups              string                                  `json:"storageaccessgroups"`
-	Suitableformigration             bool                                    `json:"suitableformigration"`
+	Suitableformigration             FlexBool                                `json:"suitableformigration"`
 	Type                             string                                  `json:"type"`
-	Ueficapability                   bool                                    `json:"ueficapability"`
+	Ueficapability                   FlexBool                                `json:"ueficapability"`
 	Username                         string                                  `json:"username"`
 	Version                          string                                  `json:"version"`
 	Virtualmachineid                 string                                  `json:"virtualmachineid"`
 	Zoneid                           string                                  `json:"zoneid"`
 	Zonename                         string                                  `json:"zonename"`
 	Zonestorageaccessgroups          string                                  `json:"zonestorageaccessgroups"`
+	Extra                            map[string]json.RawMessage              `json:"-"`
+}
+
+func (r *DeclareHostAsDegradedResponse) UnmarshalJSON(b []byte) error {
+	type alias DeclareHostAsDegradedResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeclareHostAsDegradedResponseGpugroup struct {
 	Gpugroupname string                                      `json:"gpugroupname"`
 	Vgpu         []DeclareHostAsDegradedResponseGpugroupVgpu `json:"vgpu"`
+	Extra        map[string]json.RawMessage                  `json:"-"`
+}
+
+func (r *DeclareHostAsDegradedResponseGpugroup) UnmarshalJSON(b []byte) error {
+	type alias DeclareHostAsDegradedResponseGpugroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeclareHostAsDegradedResponseGpugroupVgpu struct {
-	Maxcapacity       int64  `json:"maxcapacity"`
-	Maxheads          int64  `json:"maxheads"`
-	Maxresolutionx    int64  `json:"maxresolutionx"`
-	Maxresolutiony    int64  `json:"maxresolutiony"`
-	Maxvgpuperpgpu    int64  `json:"maxvgpuperpgpu"`
-	Remainingcapacity int64  `json:"remainingcapacity"`
-	Vgputype          string `json:"vgputype"`
-	Videoram          int64  `json:"videoram"`
+	Maxcapacity       FlexInt64                  `json:"maxcapacity"`
+	Maxheads          FlexInt64                  `json:"maxheads"`
+	Maxresolutionx    FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony    FlexInt64                  `json:"maxresolutiony"`
+	Maxvgpuperpgpu    FlexInt64                  `json:"maxvgpuperpgpu"`
+	Remainingcapacity FlexInt64                  `json:"remainingcapacity"`
+	Vgputype          string                     `json:"vgputype"`
+	Videoram          FlexInt64                  `json:"videoram"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeclareHostAsDegradedResponseGpugroupVgpu) UnmarshalJSON(b []byte) error {
+	type alias DeclareHostAsDegradedResponseGpugroupVgpu
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateSecondaryStorageSelectorParams struct {
@@ -6563,6 +8936,45 @@ func (p *UpdateSecondaryStorageSelectorParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateSecondaryStorageSelector API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateSecondaryStorageSelectorParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateSecondaryStorageSelector", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateSecondaryStorageSelector API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateSecondaryStorageSelectorParams) Validate() error {
+	return paramRules{
+		required: []string{"heuristicrule", "id"},
+		lengths:  map[string]int{"heuristicrule": 255, "id": 255},
+	}.validate("updateSecondaryStorageSelector", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateSecondaryStorageSelector API, before the request is signed
+func (p *UpdateSecondaryStorageSelectorParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateSecondaryStorageSelectorParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateSecondaryStorageSelectorParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateSecondaryStorageSelector", b, &p.p, map[string]paramDecoder{
+		"heuristicrule": decodeParam[string],
+		"id":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateSecondaryStorageSelectorParams) Clone() *UpdateSecondaryStorageSelectorParams {
+	return &UpdateSecondaryStorageSelectorParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateSecondaryStorageSelectorParams) SetHeuristicrule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6617,7 +9029,16 @@ func (s *HostService) NewUpdateSecondaryStorageSelectorParams(heuristicrule stri
 
 // Updates an existing secondary storage selector.
 func (s *HostService) UpdateSecondaryStorageSelector(p *UpdateSecondaryStorageSelectorParams) (*UpdateSecondaryStorageSelectorResponse, error) {
-	resp, err := s.cs.newPostRequest("updateSecondaryStorageSelector", p.toURLValues())
+	return s.UpdateSecondaryStorageSelectorWithContext(context.Background(), p)
+}
+
+// UpdateSecondaryStorageSelectorWithContext is like UpdateSecondaryStorageSelector, but honours the cancellation and deadline of ctx
+func (s *HostService) UpdateSecondaryStorageSelectorWithContext(ctx context.Context, p *UpdateSecondaryStorageSelectorParams) (*UpdateSecondaryStorageSelectorResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateSecondaryStorageSelector", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -6625,7 +9046,7 @@ func (s *HostService) UpdateSecondaryStorageSelector(p *UpdateSecondaryStorageSe
 	var nested struct {
 		Response UpdateSecondaryStorageSelectorResponse `json:"heuristics"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateSecondaryStorageSelector", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -6634,14 +9055,20 @@ func (s *HostService) UpdateSecondaryStorageSelector(p *UpdateSecondaryStorageSe
 }
 
 type UpdateSecondaryStorageSelectorResponse struct {
-	Created       string `json:"created"`
-	Description   string `json:"description"`
-	Heuristicrule string `json:"heuristicrule"`
-	Id            string `json:"id"`
-	JobID         string `json:"jobid"`
-	Jobstatus     int    `json:"jobstatus"`
-	Name          string `json:"name"`
-	Removed       string `json:"removed"`
-	Type          string `json:"type"`
-	Zoneid        string `json:"zoneid"`
+	Created       Time                       `json:"created"`
+	Description   string                     `json:"description"`
+	Heuristicrule string                     `json:"heuristicrule"`
+	Id            string                     `json:"id"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Name          string                     `json:"name"`
+	Removed       Time                       `json:"removed"`
+	Type          string                     `json:"type"`
+	Zoneid        string                     `json:"zoneid"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateSecondaryStorageSelectorResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateSecondaryStorageSelectorResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockHostServiceIfaceMockRecorder) AddBaremetalHost(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddBaremetalHost), p)
 }
 
+// AddBaremetalHostWithContext mocks base method.
+func (m *MockHostServiceIface) AddBaremetalHostWithContext(ctx context.Context, p *AddBaremetalHostParams) (*AddBaremetalHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddBaremetalHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddBaremetalHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddBaremetalHostWithContext indicates an expected call of AddBaremetalHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) AddBaremetalHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddBaremetalHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).AddBaremetalHostWithContext), ctx, p)
+}
+
 // AddGloboDnsHost mocks base method.
 func (m *MockHostServiceIface) AddGloboDnsHost(p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockHostServiceIfaceMockRecorder) AddGloboDnsHost(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGloboDnsHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddGloboDnsHost), p)
 }
 
+// AddGloboDnsHostAsync mocks base method.
+func (m *MockHostServiceIface) AddGloboDnsHostAsync(ctx context.Context, p *AddGloboDnsHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGloboDnsHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGloboDnsHostAsync indicates an expected call of AddGloboDnsHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) AddGloboDnsHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGloboDnsHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).AddGloboDnsHostAsync), ctx, p)
+}
+
+// AddGloboDnsHostWithContext mocks base method.
+func (m *MockHostServiceIface) AddGloboDnsHostWithContext(ctx context.Context, p *AddGloboDnsHostParams) (*AddGloboDnsHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddGloboDnsHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddGloboDnsHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddGloboDnsHostWithContext indicates an expected call of AddGloboDnsHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) AddGloboDnsHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddGloboDnsHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).AddGloboDnsHostWithContext), ctx, p)
+}
+
 // AddHost mocks base method.
 func (m *MockHostServiceIface) AddHost(p *AddHostParams) (*AddHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockHostServiceIfaceMockRecorder) AddHost(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHost", reflect.TypeOf((*MockHostServiceIface)(nil).AddHost), p)
 }
 
+// AddHostWithContext mocks base method.
+func (m *MockHostServiceIface) AddHostWithContext(ctx context.Context, p *AddHostParams) (*AddHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddHostWithContext indicates an expected call of AddHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) AddHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).AddHostWithContext), ctx, p)
+}
+
 // AddSecondaryStorage mocks base method.
 func (m *MockHostServiceIface) AddSecondaryStorage(p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,21 @@ func (mr *MockHostServiceIfaceMockRecorder) AddSecondaryStorage(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecondaryStorage", reflect.TypeOf((*MockHostServiceIface)(nil).AddSecondaryStorage), p)
 }
 
+// AddSecondaryStorageWithContext mocks base method.
+func (m *MockHostServiceIface) AddSecondaryStorageWithContext(ctx context.Context, p *AddSecondaryStorageParams) (*AddSecondaryStorageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSecondaryStorageWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddSecondaryStorageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSecondaryStorageWithContext indicates an expected call of AddSecondaryStorageWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) AddSecondaryStorageWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSecondaryStorageWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).AddSecondaryStorageWithContext), ctx, p)
+}
+
 // CancelHostAsDegraded mocks base method.
 func (m *MockHostServiceIface) CancelHostAsDegraded(p *CancelHostAsDegradedParams) (*CancelHostAsDegradedResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +210,36 @@ func (mr *MockHostServiceIfaceMockRecorder) CancelHostAsDegraded(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostAsDegraded", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostAsDegraded), p)
 }
 
+// CancelHostAsDegradedAsync mocks base method.
+func (m *MockHostServiceIface) CancelHostAsDegradedAsync(ctx context.Context, p *CancelHostAsDegradedParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelHostAsDegradedAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelHostAsDegradedAsync indicates an expected call of CancelHostAsDegradedAsync.
+func (mr *MockHostServiceIfaceMockRecorder) CancelHostAsDegradedAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostAsDegradedAsync", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostAsDegradedAsync), ctx, p)
+}
+
+// CancelHostAsDegradedWithContext mocks base method.
+func (m *MockHostServiceIface) CancelHostAsDegradedWithContext(ctx context.Context, p *CancelHostAsDegradedParams) (*CancelHostAsDegradedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelHostAsDegradedWithContext", ctx, p)
+	ret0, _ := ret[0].(*CancelHostAsDegradedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelHostAsDegradedWithContext indicates an expected call of CancelHostAsDegradedWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) CancelHostAsDegradedWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostAsDegradedWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostAsDegradedWithContext), ctx, p)
+}
+
 // CancelHostMaintenance mocks base method.
 func (m *MockHostServiceIface) CancelHostMaintenance(p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +255,36 @@ func (mr *MockHostServiceIfaceMockRecorder) CancelHostMaintenance(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostMaintenance", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostMaintenance), p)
 }
 
+// CancelHostMaintenanceAsync mocks base method.
+func (m *MockHostServiceIface) CancelHostMaintenanceAsync(ctx context.Context, p *CancelHostMaintenanceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelHostMaintenanceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelHostMaintenanceAsync indicates an expected call of CancelHostMaintenanceAsync.
+func (mr *MockHostServiceIfaceMockRecorder) CancelHostMaintenanceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostMaintenanceAsync", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostMaintenanceAsync), ctx, p)
+}
+
+// CancelHostMaintenanceWithContext mocks base method.
+func (m *MockHostServiceIface) CancelHostMaintenanceWithContext(ctx context.Context, p *CancelHostMaintenanceParams) (*CancelHostMaintenanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelHostMaintenanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*CancelHostMaintenanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelHostMaintenanceWithContext indicates an expected call of CancelHostMaintenanceWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) CancelHostMaintenanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelHostMaintenanceWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).CancelHostMaintenanceWithContext), ctx, p)
+}
+
 // ConfigureHAForHost mocks base method.
 func (m *MockHostServiceIface) ConfigureHAForHost(p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +300,36 @@ func (mr *MockHostServiceIfaceMockRecorder) ConfigureHAForHost(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureHAForHost", reflect.TypeOf((*MockHostServiceIface)(nil).ConfigureHAForHost), p)
 }
 
+// ConfigureHAForHostAsync mocks base method.
+func (m *MockHostServiceIface) ConfigureHAForHostAsync(ctx context.Context, p *ConfigureHAForHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureHAForHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureHAForHostAsync indicates an expected call of ConfigureHAForHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) ConfigureHAForHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureHAForHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).ConfigureHAForHostAsync), ctx, p)
+}
+
+// ConfigureHAForHostWithContext mocks base method.
+func (m *MockHostServiceIface) ConfigureHAForHostWithContext(ctx context.Context, p *ConfigureHAForHostParams) (*HAForHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureHAForHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*HAForHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureHAForHostWithContext indicates an expected call of ConfigureHAForHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ConfigureHAForHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureHAForHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ConfigureHAForHostWithContext), ctx, p)
+}
+
 // CreateSecondaryStorageSelector mocks base method.
 func (m *MockHostServiceIface) CreateSecondaryStorageSelector(p *CreateSecondaryStorageSelectorParams) (*CreateSecondaryStorageSelectorResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +345,21 @@ func (mr *MockHostServiceIfaceMockRecorder) CreateSecondaryStorageSelector(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecondaryStorageSelector", reflect.TypeOf((*MockHostServiceIface)(nil).CreateSecondaryStorageSelector), p)
 }
 
+// CreateSecondaryStorageSelectorWithContext mocks base method.
+func (m *MockHostServiceIface) CreateSecondaryStorageSelectorWithContext(ctx context.Context, p *CreateSecondaryStorageSelectorParams) (*CreateSecondaryStorageSelectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecondaryStorageSelectorWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSecondaryStorageSelectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecondaryStorageSelectorWithContext indicates an expected call of CreateSecondaryStorageSelectorWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) CreateSecondaryStorageSelectorWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecondaryStorageSelectorWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).CreateSecondaryStorageSelectorWithContext), ctx, p)
+}
+
 // DeclareHostAsDegraded mocks base method.
 func (m *MockHostServiceIface) DeclareHostAsDegraded(p *DeclareHostAsDegradedParams) (*DeclareHostAsDegradedResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +375,36 @@ func (mr *MockHostServiceIfaceMockRecorder) DeclareHostAsDegraded(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclareHostAsDegraded", reflect.TypeOf((*MockHostServiceIface)(nil).DeclareHostAsDegraded), p)
 }
 
+// DeclareHostAsDegradedAsync mocks base method.
+func (m *MockHostServiceIface) DeclareHostAsDegradedAsync(ctx context.Context, p *DeclareHostAsDegradedParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclareHostAsDegradedAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclareHostAsDegradedAsync indicates an expected call of DeclareHostAsDegradedAsync.
+func (mr *MockHostServiceIfaceMockRecorder) DeclareHostAsDegradedAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclareHostAsDegradedAsync", reflect.TypeOf((*MockHostServiceIface)(nil).DeclareHostAsDegradedAsync), ctx, p)
+}
+
+// DeclareHostAsDegradedWithContext mocks base method.
+func (m *MockHostServiceIface) DeclareHostAsDegradedWithContext(ctx context.Context, p *DeclareHostAsDegradedParams) (*DeclareHostAsDegradedResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeclareHostAsDegradedWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeclareHostAsDegradedResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeclareHostAsDegradedWithContext indicates an expected call of DeclareHostAsDegradedWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) DeclareHostAsDegradedWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeclareHostAsDegradedWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).DeclareHostAsDegradedWithContext), ctx, p)
+}
+
 // DedicateHost mocks base method.
 func (m *MockHostServiceIface) DedicateHost(p *DedicateHostParams) (*DedicateHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +420,36 @@ func (mr *MockHostServiceIfaceMockRecorder) DedicateHost(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateHost", reflect.TypeOf((*MockHostServiceIface)(nil).DedicateHost), p)
 }
 
+// DedicateHostAsync mocks base method.
+func (m *MockHostServiceIface) DedicateHostAsync(ctx context.Context, p *DedicateHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateHostAsync indicates an expected call of DedicateHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) DedicateHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).DedicateHostAsync), ctx, p)
+}
+
+// DedicateHostWithContext mocks base method.
+func (m *MockHostServiceIface) DedicateHostWithContext(ctx context.Context, p *DedicateHostParams) (*DedicateHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicateHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateHostWithContext indicates an expected call of DedicateHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) DedicateHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).DedicateHostWithContext), ctx, p)
+}
+
 // DeleteHost mocks base method.
 func (m *MockHostServiceIface) DeleteHost(p *DeleteHostParams) (*DeleteHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +465,21 @@ func (mr *MockHostServiceIfaceMockRecorder) DeleteHost(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHost", reflect.TypeOf((*MockHostServiceIface)(nil).DeleteHost), p)
 }
 
+// DeleteHostWithContext mocks base method.
+func (m *MockHostServiceIface) DeleteHostWithContext(ctx context.Context, p *DeleteHostParams) (*DeleteHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteHostWithContext indicates an expected call of DeleteHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) DeleteHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).DeleteHostWithContext), ctx, p)
+}
+
 // DisableHAForHost mocks base method.
 func (m *MockHostServiceIface) DisableHAForHost(p *DisableHAForHostParams) (*DisableHAForHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +495,36 @@ func (mr *MockHostServiceIfaceMockRecorder) DisableHAForHost(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForHost", reflect.TypeOf((*MockHostServiceIface)(nil).DisableHAForHost), p)
 }
 
+// DisableHAForHostAsync mocks base method.
+func (m *MockHostServiceIface) DisableHAForHostAsync(ctx context.Context, p *DisableHAForHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableHAForHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableHAForHostAsync indicates an expected call of DisableHAForHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) DisableHAForHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).DisableHAForHostAsync), ctx, p)
+}
+
+// DisableHAForHostWithContext mocks base method.
+func (m *MockHostServiceIface) DisableHAForHostWithContext(ctx context.Context, p *DisableHAForHostParams) (*DisableHAForHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableHAForHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableHAForHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableHAForHostWithContext indicates an expected call of DisableHAForHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) DisableHAForHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).DisableHAForHostWithContext), ctx, p)
+}
+
 // DisableOutOfBandManagementForHost mocks base method.
 func (m *MockHostServiceIface) DisableOutOfBandManagementForHost(p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +540,36 @@ func (mr *MockHostServiceIfaceMockRecorder) DisableOutOfBandManagementForHost(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForHost", reflect.TypeOf((*MockHostServiceIface)(nil).DisableOutOfBandManagementForHost), p)
 }
 
+// DisableOutOfBandManagementForHostAsync mocks base method.
+func (m *MockHostServiceIface) DisableOutOfBandManagementForHostAsync(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForHostAsync indicates an expected call of DisableOutOfBandManagementForHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) DisableOutOfBandManagementForHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).DisableOutOfBandManagementForHostAsync), ctx, p)
+}
+
+// DisableOutOfBandManagementForHostWithContext mocks base method.
+func (m *MockHostServiceIface) DisableOutOfBandManagementForHostWithContext(ctx context.Context, p *DisableOutOfBandManagementForHostParams) (*DisableOutOfBandManagementForHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableOutOfBandManagementForHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForHostWithContext indicates an expected call of DisableOutOfBandManagementForHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) DisableOutOfBandManagementForHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).DisableOutOfBandManagementForHostWithContext), ctx, p)
+}
+
 // EnableHAForHost mocks base method.
 func (m *MockHostServiceIface) EnableHAForHost(p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +585,36 @@ func (mr *MockHostServiceIfaceMockRecorder) EnableHAForHost(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForHost", reflect.TypeOf((*MockHostServiceIface)(nil).EnableHAForHost), p)
 }
 
+// EnableHAForHostAsync mocks base method.
+func (m *MockHostServiceIface) EnableHAForHostAsync(ctx context.Context, p *EnableHAForHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableHAForHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableHAForHostAsync indicates an expected call of EnableHAForHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) EnableHAForHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).EnableHAForHostAsync), ctx, p)
+}
+
+// EnableHAForHostWithContext mocks base method.
+func (m *MockHostServiceIface) EnableHAForHostWithContext(ctx context.Context, p *EnableHAForHostParams) (*EnableHAForHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableHAForHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableHAForHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableHAForHostWithContext indicates an expected call of EnableHAForHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) EnableHAForHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).EnableHAForHostWithContext), ctx, p)
+}
+
 // EnableOutOfBandManagementForHost mocks base method.
 func (m *MockHostServiceIface) EnableOutOfBandManagementForHost(p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +630,36 @@ func (mr *MockHostServiceIfaceMockRecorder) EnableOutOfBandManagementForHost(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForHost", reflect.TypeOf((*MockHostServiceIface)(nil).EnableOutOfBandManagementForHost), p)
 }
 
+// EnableOutOfBandManagementForHostAsync mocks base method.
+func (m *MockHostServiceIface) EnableOutOfBandManagementForHostAsync(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForHostAsync indicates an expected call of EnableOutOfBandManagementForHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) EnableOutOfBandManagementForHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).EnableOutOfBandManagementForHostAsync), ctx, p)
+}
+
+// EnableOutOfBandManagementForHostWithContext mocks base method.
+func (m *MockHostServiceIface) EnableOutOfBandManagementForHostWithContext(ctx context.Context, p *EnableOutOfBandManagementForHostParams) (*EnableOutOfBandManagementForHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableOutOfBandManagementForHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForHostWithContext indicates an expected call of EnableOutOfBandManagementForHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) EnableOutOfBandManagementForHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).EnableOutOfBandManagementForHostWithContext), ctx, p)
+}
+
 // FindHostsForMigration mocks base method.
 func (m *MockHostServiceIface) FindHostsForMigration(p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
 	m.ctrl.T.Helper()
@@ -298,6 +675,21 @@ func (mr *MockHostServiceIfaceMockRecorder) FindHostsForMigration(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHostsForMigration", reflect.TypeOf((*MockHostServiceIface)(nil).FindHostsForMigration), p)
 }
 
+// FindHostsForMigrationWithContext mocks base method.
+func (m *MockHostServiceIface) FindHostsForMigrationWithContext(ctx context.Context, p *FindHostsForMigrationParams) (*FindHostsForMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindHostsForMigrationWithContext", ctx, p)
+	ret0, _ := ret[0].(*FindHostsForMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindHostsForMigrationWithContext indicates an expected call of FindHostsForMigrationWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) FindHostsForMigrationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindHostsForMigrationWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).FindHostsForMigrationWithContext), ctx, p)
+}
+
 // GetHostByID mocks base method.
 func (m *MockHostServiceIface) GetHostByID(id string, opts ...OptionFunc) (*Host, int, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +711,27 @@ func (mr *MockHostServiceIfaceMockRecorder) GetHostByID(id any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostByID", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostByID), varargs...)
 }
 
+// GetHostByIDWithContext mocks base method.
+func (m *MockHostServiceIface) GetHostByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Host, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostByIDWithContext indicates an expected call of GetHostByIDWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostByIDWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostByIDWithContext), varargs...)
+}
+
 // GetHostByName mocks base method.
 func (m *MockHostServiceIface) GetHostByName(name string, opts ...OptionFunc) (*Host, int, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +753,27 @@ func (mr *MockHostServiceIfaceMockRecorder) GetHostByName(name any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostByName", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostByName), varargs...)
 }
 
+// GetHostByNameWithContext mocks base method.
+func (m *MockHostServiceIface) GetHostByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Host, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Host)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostByNameWithContext indicates an expected call of GetHostByNameWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostByNameWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostByNameWithContext), varargs...)
+}
+
 // GetHostID mocks base method.
 func (m *MockHostServiceIface) GetHostID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -361,214 +795,835 @@ func (mr *MockHostServiceIfaceMockRecorder) GetHostID(name any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostID", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostID), varargs...)
 }
 
-// GetHostTagID mocks base method.
-func (m *MockHostServiceIface) GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+// GetHostIDWithContext mocks base method.
+func (m *MockHostServiceIface) GetHostIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{keyword}
+	varargs := []any{ctx, name}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetHostTagID", varargs...)
+	ret := m.ctrl.Call(m, "GetHostIDWithContext", varargs...)
 	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
 }
 
-// GetHostTagID indicates an expected call of GetHostTagID.
-func (mr *MockHostServiceIfaceMockRecorder) GetHostTagID(keyword any, opts ...any) *gomock.Call {
+// GetHostIDWithContext indicates an expected call of GetHostIDWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostIDWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostIDWithContext), varargs...)
+}
+
+// GetHostTagID mocks base method.
+func (m *MockHostServiceIface) GetHostTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostTagID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostTagID indicates an expected call of GetHostTagID.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostTagID(keyword any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	varargs := append([]any{keyword}, opts...)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostTagID", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostTagID), varargs...)
 }
 
-// GetHostsMetricByID mocks base method.
-func (m *MockHostServiceIface) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error) {
+// GetHostTagIDWithContext mocks base method.
+func (m *MockHostServiceIface) GetHostTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostTagIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostTagIDWithContext indicates an expected call of GetHostTagIDWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostTagIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostTagIDWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostTagIDWithContext), varargs...)
+}
+
+// GetHostsMetricByID mocks base method.
+func (m *MockHostServiceIface) GetHostsMetricByID(id string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostsMetricByID", varargs...)
+	ret0, _ := ret[0].(*HostsMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostsMetricByID indicates an expected call of GetHostsMetricByID.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricByID", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricByID), varargs...)
+}
+
+// GetHostsMetricByIDWithContext mocks base method.
+func (m *MockHostServiceIface) GetHostsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostsMetricByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*HostsMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostsMetricByIDWithContext indicates an expected call of GetHostsMetricByIDWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricByIDWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricByIDWithContext), varargs...)
+}
+
+// GetHostsMetricByName mocks base method.
+func (m *MockHostServiceIface) GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostsMetricByName", varargs...)
+	ret0, _ := ret[0].(*HostsMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostsMetricByName indicates an expected call of GetHostsMetricByName.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricByName", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricByName), varargs...)
+}
+
+// GetHostsMetricByNameWithContext mocks base method.
+func (m *MockHostServiceIface) GetHostsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*HostsMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostsMetricByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*HostsMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostsMetricByNameWithContext indicates an expected call of GetHostsMetricByNameWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricByNameWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricByNameWithContext), varargs...)
+}
+
+// GetHostsMetricID mocks base method.
+func (m *MockHostServiceIface) GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostsMetricID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostsMetricID indicates an expected call of GetHostsMetricID.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricID", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricID), varargs...)
+}
+
+// GetHostsMetricIDWithContext mocks base method.
+func (m *MockHostServiceIface) GetHostsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHostsMetricIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHostsMetricIDWithContext indicates an expected call of GetHostsMetricIDWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricIDWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricIDWithContext), varargs...)
+}
+
+// GetSecondaryStorageSelectorID mocks base method.
+func (m *MockHostServiceIface) GetSecondaryStorageSelectorID(keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{keyword, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecondaryStorageSelectorID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecondaryStorageSelectorID indicates an expected call of GetSecondaryStorageSelectorID.
+func (mr *MockHostServiceIfaceMockRecorder) GetSecondaryStorageSelectorID(keyword, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{keyword, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStorageSelectorID", reflect.TypeOf((*MockHostServiceIface)(nil).GetSecondaryStorageSelectorID), varargs...)
+}
+
+// GetSecondaryStorageSelectorIDWithContext mocks base method.
+func (m *MockHostServiceIface) GetSecondaryStorageSelectorIDWithContext(ctx context.Context, keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecondaryStorageSelectorIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecondaryStorageSelectorIDWithContext indicates an expected call of GetSecondaryStorageSelectorIDWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) GetSecondaryStorageSelectorIDWithContext(ctx, keyword, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStorageSelectorIDWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).GetSecondaryStorageSelectorIDWithContext), varargs...)
+}
+
+// ListDedicatedHosts mocks base method.
+func (m *MockHostServiceIface) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedHosts", p)
+	ret0, _ := ret[0].(*ListDedicatedHostsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedHosts indicates an expected call of ListDedicatedHosts.
+func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHosts(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHosts), p)
+}
+
+// ListDedicatedHostsAll mocks base method.
+func (m *MockHostServiceIface) ListDedicatedHostsAll(p *ListDedicatedHostsParams, opts ...PageOption) ([]*DedicatedHost, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedHostsAll", varargs...)
+	ret0, _ := ret[0].([]*DedicatedHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedHostsAll indicates an expected call of ListDedicatedHostsAll.
+func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHostsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHostsAll", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHostsAll), varargs...)
+}
+
+// ListDedicatedHostsAllWithContext mocks base method.
+func (m *MockHostServiceIface) ListDedicatedHostsAllWithContext(ctx context.Context, p *ListDedicatedHostsParams, opts ...PageOption) ([]*DedicatedHost, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedHostsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DedicatedHost)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedHostsAllWithContext indicates an expected call of ListDedicatedHostsAllWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHostsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHostsAllWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHostsAllWithContext), varargs...)
+}
+
+// ListDedicatedHostsIter mocks base method.
+func (m *MockHostServiceIface) ListDedicatedHostsIter(p *ListDedicatedHostsParams, opts ...PageOption) iter.Seq2[*DedicatedHost, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedHostsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedHost, error])
+	return ret0
+}
+
+// ListDedicatedHostsIter indicates an expected call of ListDedicatedHostsIter.
+func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHostsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHostsIter", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHostsIter), varargs...)
+}
+
+// ListDedicatedHostsIterWithContext mocks base method.
+func (m *MockHostServiceIface) ListDedicatedHostsIterWithContext(ctx context.Context, p *ListDedicatedHostsParams, opts ...PageOption) iter.Seq2[*DedicatedHost, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedHostsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedHost, error])
+	return ret0
+}
+
+// ListDedicatedHostsIterWithContext indicates an expected call of ListDedicatedHostsIterWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHostsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHostsIterWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHostsIterWithContext), varargs...)
+}
+
+// ListDedicatedHostsWithContext mocks base method.
+func (m *MockHostServiceIface) ListDedicatedHostsWithContext(ctx context.Context, p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedHostsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDedicatedHostsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedHostsWithContext indicates an expected call of ListDedicatedHostsWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHostsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHostsWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHostsWithContext), ctx, p)
+}
+
+// ListHostHAProviders mocks base method.
+func (m *MockHostServiceIface) ListHostHAProviders(p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostHAProviders", p)
+	ret0, _ := ret[0].(*ListHostHAProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostHAProviders indicates an expected call of ListHostHAProviders.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostHAProviders(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostHAProviders", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostHAProviders), p)
+}
+
+// ListHostHAProvidersWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostHAProvidersWithContext(ctx context.Context, p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostHAProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListHostHAProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostHAProvidersWithContext indicates an expected call of ListHostHAProvidersWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostHAProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostHAProvidersWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostHAProvidersWithContext), ctx, p)
+}
+
+// ListHostHAResources mocks base method.
+func (m *MockHostServiceIface) ListHostHAResources(p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostHAResources", p)
+	ret0, _ := ret[0].(*ListHostHAResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostHAResources indicates an expected call of ListHostHAResources.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostHAResources(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostHAResources", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostHAResources), p)
+}
+
+// ListHostHAResourcesWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostHAResourcesWithContext(ctx context.Context, p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostHAResourcesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListHostHAResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostHAResourcesWithContext indicates an expected call of ListHostHAResourcesWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostHAResourcesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostHAResourcesWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostHAResourcesWithContext), ctx, p)
+}
+
+// ListHostTags mocks base method.
+func (m *MockHostServiceIface) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostTags", p)
+	ret0, _ := ret[0].(*ListHostTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostTags indicates an expected call of ListHostTags.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostTags(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTags", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTags), p)
+}
+
+// ListHostTagsAll mocks base method.
+func (m *MockHostServiceIface) ListHostTagsAll(p *ListHostTagsParams, opts ...PageOption) ([]*HostTag, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostTagsAll", varargs...)
+	ret0, _ := ret[0].([]*HostTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostTagsAll indicates an expected call of ListHostTagsAll.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostTagsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTagsAll", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTagsAll), varargs...)
+}
+
+// ListHostTagsAllWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostTagsAllWithContext(ctx context.Context, p *ListHostTagsParams, opts ...PageOption) ([]*HostTag, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostTagsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*HostTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostTagsAllWithContext indicates an expected call of ListHostTagsAllWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostTagsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTagsAllWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTagsAllWithContext), varargs...)
+}
+
+// ListHostTagsIter mocks base method.
+func (m *MockHostServiceIface) ListHostTagsIter(p *ListHostTagsParams, opts ...PageOption) iter.Seq2[*HostTag, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostTagsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*HostTag, error])
+	return ret0
+}
+
+// ListHostTagsIter indicates an expected call of ListHostTagsIter.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostTagsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTagsIter", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTagsIter), varargs...)
+}
+
+// ListHostTagsIterWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostTagsIterWithContext(ctx context.Context, p *ListHostTagsParams, opts ...PageOption) iter.Seq2[*HostTag, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostTagsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*HostTag, error])
+	return ret0
+}
+
+// ListHostTagsIterWithContext indicates an expected call of ListHostTagsIterWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostTagsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTagsIterWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTagsIterWithContext), varargs...)
+}
+
+// ListHostTagsWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostTagsWithContext(ctx context.Context, p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostTagsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListHostTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostTagsWithContext indicates an expected call of ListHostTagsWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostTagsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTagsWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTagsWithContext), ctx, p)
+}
+
+// ListHosts mocks base method.
+func (m *MockHostServiceIface) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHosts", p)
+	ret0, _ := ret[0].(*ListHostsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHosts indicates an expected call of ListHosts.
+func (mr *MockHostServiceIfaceMockRecorder) ListHosts(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListHosts), p)
+}
+
+// ListHostsAll mocks base method.
+func (m *MockHostServiceIface) ListHostsAll(p *ListHostsParams, opts ...PageOption) ([]*Host, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostsAll", varargs...)
+	ret0, _ := ret[0].([]*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostsAll indicates an expected call of ListHostsAll.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsAll", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsAll), varargs...)
+}
+
+// ListHostsAllWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostsAllWithContext(ctx context.Context, p *ListHostsParams, opts ...PageOption) ([]*Host, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Host)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostsAllWithContext indicates an expected call of ListHostsAllWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsAllWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsAllWithContext), varargs...)
+}
+
+// ListHostsIter mocks base method.
+func (m *MockHostServiceIface) ListHostsIter(p *ListHostsParams, opts ...PageOption) iter.Seq2[*Host, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Host, error])
+	return ret0
+}
+
+// ListHostsIter indicates an expected call of ListHostsIter.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsIter", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsIter), varargs...)
+}
+
+// ListHostsIterWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostsIterWithContext(ctx context.Context, p *ListHostsParams, opts ...PageOption) iter.Seq2[*Host, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHostsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Host, error])
+	return ret0
+}
+
+// ListHostsIterWithContext indicates an expected call of ListHostsIterWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsIterWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsIterWithContext), varargs...)
+}
+
+// ListHostsMetrics mocks base method.
+func (m *MockHostServiceIface) ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHostsMetrics", p)
+	ret0, _ := ret[0].(*ListHostsMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHostsMetrics indicates an expected call of ListHostsMetrics.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetrics(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetrics", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetrics), p)
+}
+
+// ListHostsMetricsAll mocks base method.
+func (m *MockHostServiceIface) ListHostsMetricsAll(p *ListHostsMetricsParams, opts ...PageOption) ([]*HostsMetric, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetHostsMetricByID", varargs...)
-	ret0, _ := ret[0].(*HostsMetric)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListHostsMetricsAll", varargs...)
+	ret0, _ := ret[0].([]*HostsMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetHostsMetricByID indicates an expected call of GetHostsMetricByID.
-func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricByID(id any, opts ...any) *gomock.Call {
+// ListHostsMetricsAll indicates an expected call of ListHostsMetricsAll.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetricsAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricByID", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetricsAll", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetricsAll), varargs...)
 }
 
-// GetHostsMetricByName mocks base method.
-func (m *MockHostServiceIface) GetHostsMetricByName(name string, opts ...OptionFunc) (*HostsMetric, int, error) {
+// ListHostsMetricsAllWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostsMetricsAllWithContext(ctx context.Context, p *ListHostsMetricsParams, opts ...PageOption) ([]*HostsMetric, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetHostsMetricByName", varargs...)
-	ret0, _ := ret[0].(*HostsMetric)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListHostsMetricsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*HostsMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetHostsMetricByName indicates an expected call of GetHostsMetricByName.
-func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricByName(name any, opts ...any) *gomock.Call {
+// ListHostsMetricsAllWithContext indicates an expected call of ListHostsMetricsAllWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetricsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricByName", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricByName), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetricsAllWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetricsAllWithContext), varargs...)
 }
 
-// GetHostsMetricID mocks base method.
-func (m *MockHostServiceIface) GetHostsMetricID(name string, opts ...OptionFunc) (string, int, error) {
+// ListHostsMetricsIter mocks base method.
+func (m *MockHostServiceIface) ListHostsMetricsIter(p *ListHostsMetricsParams, opts ...PageOption) iter.Seq2[*HostsMetric, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetHostsMetricID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListHostsMetricsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*HostsMetric, error])
+	return ret0
 }
 
-// GetHostsMetricID indicates an expected call of GetHostsMetricID.
-func (mr *MockHostServiceIfaceMockRecorder) GetHostsMetricID(name any, opts ...any) *gomock.Call {
+// ListHostsMetricsIter indicates an expected call of ListHostsMetricsIter.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetricsIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHostsMetricID", reflect.TypeOf((*MockHostServiceIface)(nil).GetHostsMetricID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetricsIter", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetricsIter), varargs...)
 }
 
-// GetSecondaryStorageSelectorID mocks base method.
-func (m *MockHostServiceIface) GetSecondaryStorageSelectorID(keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+// ListHostsMetricsIterWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostsMetricsIterWithContext(ctx context.Context, p *ListHostsMetricsParams, opts ...PageOption) iter.Seq2[*HostsMetric, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{keyword, zoneid}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetSecondaryStorageSelectorID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListHostsMetricsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*HostsMetric, error])
+	return ret0
 }
 
-// GetSecondaryStorageSelectorID indicates an expected call of GetSecondaryStorageSelectorID.
-func (mr *MockHostServiceIfaceMockRecorder) GetSecondaryStorageSelectorID(keyword, zoneid any, opts ...any) *gomock.Call {
+// ListHostsMetricsIterWithContext indicates an expected call of ListHostsMetricsIterWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetricsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{keyword, zoneid}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStorageSelectorID", reflect.TypeOf((*MockHostServiceIface)(nil).GetSecondaryStorageSelectorID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetricsIterWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetricsIterWithContext), varargs...)
 }
 
-// ListDedicatedHosts mocks base method.
-func (m *MockHostServiceIface) ListDedicatedHosts(p *ListDedicatedHostsParams) (*ListDedicatedHostsResponse, error) {
+// ListHostsMetricsWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostsMetricsWithContext(ctx context.Context, p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDedicatedHosts", p)
-	ret0, _ := ret[0].(*ListDedicatedHostsResponse)
+	ret := m.ctrl.Call(m, "ListHostsMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListHostsMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListDedicatedHosts indicates an expected call of ListDedicatedHosts.
-func (mr *MockHostServiceIfaceMockRecorder) ListDedicatedHosts(p any) *gomock.Call {
+// ListHostsMetricsWithContext indicates an expected call of ListHostsMetricsWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetricsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListDedicatedHosts), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetricsWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetricsWithContext), ctx, p)
 }
 
-// ListHostHAProviders mocks base method.
-func (m *MockHostServiceIface) ListHostHAProviders(p *ListHostHAProvidersParams) (*ListHostHAProvidersResponse, error) {
+// ListHostsWithContext mocks base method.
+func (m *MockHostServiceIface) ListHostsWithContext(ctx context.Context, p *ListHostsParams) (*ListHostsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHostHAProviders", p)
-	ret0, _ := ret[0].(*ListHostHAProvidersResponse)
+	ret := m.ctrl.Call(m, "ListHostsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListHostsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListHostHAProviders indicates an expected call of ListHostHAProviders.
-func (mr *MockHostServiceIfaceMockRecorder) ListHostHAProviders(p any) *gomock.Call {
+// ListHostsWithContext indicates an expected call of ListHostsWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListHostsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostHAProviders", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostHAProviders), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsWithContext), ctx, p)
 }
 
-// ListHostHAResources mocks base method.
-func (m *MockHostServiceIface) ListHostHAResources(p *ListHostHAResourcesParams) (*ListHostHAResourcesResponse, error) {
+// ListSecondaryStorageSelectors mocks base method.
+func (m *MockHostServiceIface) ListSecondaryStorageSelectors(p *ListSecondaryStorageSelectorsParams) (*ListSecondaryStorageSelectorsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHostHAResources", p)
-	ret0, _ := ret[0].(*ListHostHAResourcesResponse)
+	ret := m.ctrl.Call(m, "ListSecondaryStorageSelectors", p)
+	ret0, _ := ret[0].(*ListSecondaryStorageSelectorsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListHostHAResources indicates an expected call of ListHostHAResources.
-func (mr *MockHostServiceIfaceMockRecorder) ListHostHAResources(p any) *gomock.Call {
+// ListSecondaryStorageSelectors indicates an expected call of ListSecondaryStorageSelectors.
+func (mr *MockHostServiceIfaceMockRecorder) ListSecondaryStorageSelectors(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostHAResources", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostHAResources), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStorageSelectors", reflect.TypeOf((*MockHostServiceIface)(nil).ListSecondaryStorageSelectors), p)
 }
 
-// ListHostTags mocks base method.
-func (m *MockHostServiceIface) ListHostTags(p *ListHostTagsParams) (*ListHostTagsResponse, error) {
+// ListSecondaryStorageSelectorsAll mocks base method.
+func (m *MockHostServiceIface) ListSecondaryStorageSelectorsAll(p *ListSecondaryStorageSelectorsParams, opts ...PageOption) ([]*SecondaryStorageSelector, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHostTags", p)
-	ret0, _ := ret[0].(*ListHostTagsResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStorageSelectorsAll", varargs...)
+	ret0, _ := ret[0].([]*SecondaryStorageSelector)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListHostTags indicates an expected call of ListHostTags.
-func (mr *MockHostServiceIfaceMockRecorder) ListHostTags(p any) *gomock.Call {
+// ListSecondaryStorageSelectorsAll indicates an expected call of ListSecondaryStorageSelectorsAll.
+func (mr *MockHostServiceIfaceMockRecorder) ListSecondaryStorageSelectorsAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostTags", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostTags), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStorageSelectorsAll", reflect.TypeOf((*MockHostServiceIface)(nil).ListSecondaryStorageSelectorsAll), varargs...)
 }
 
-// ListHosts mocks base method.
-func (m *MockHostServiceIface) ListHosts(p *ListHostsParams) (*ListHostsResponse, error) {
+// ListSecondaryStorageSelectorsAllWithContext mocks base method.
+func (m *MockHostServiceIface) ListSecondaryStorageSelectorsAllWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams, opts ...PageOption) ([]*SecondaryStorageSelector, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHosts", p)
-	ret0, _ := ret[0].(*ListHostsResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStorageSelectorsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SecondaryStorageSelector)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListHosts indicates an expected call of ListHosts.
-func (mr *MockHostServiceIfaceMockRecorder) ListHosts(p any) *gomock.Call {
+// ListSecondaryStorageSelectorsAllWithContext indicates an expected call of ListSecondaryStorageSelectorsAllWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListSecondaryStorageSelectorsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHosts", reflect.TypeOf((*MockHostServiceIface)(nil).ListHosts), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStorageSelectorsAllWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListSecondaryStorageSelectorsAllWithContext), varargs...)
 }
 
-// ListHostsMetrics mocks base method.
-func (m *MockHostServiceIface) ListHostsMetrics(p *ListHostsMetricsParams) (*ListHostsMetricsResponse, error) {
+// ListSecondaryStorageSelectorsIter mocks base method.
+func (m *MockHostServiceIface) ListSecondaryStorageSelectorsIter(p *ListSecondaryStorageSelectorsParams, opts ...PageOption) iter.Seq2[*SecondaryStorageSelector, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListHostsMetrics", p)
-	ret0, _ := ret[0].(*ListHostsMetricsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStorageSelectorsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SecondaryStorageSelector, error])
+	return ret0
 }
 
-// ListHostsMetrics indicates an expected call of ListHostsMetrics.
-func (mr *MockHostServiceIfaceMockRecorder) ListHostsMetrics(p any) *gomock.Call {
+// ListSecondaryStorageSelectorsIter indicates an expected call of ListSecondaryStorageSelectorsIter.
+func (mr *MockHostServiceIfaceMockRecorder) ListSecondaryStorageSelectorsIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHostsMetrics", reflect.TypeOf((*MockHostServiceIface)(nil).ListHostsMetrics), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStorageSelectorsIter", reflect.TypeOf((*MockHostServiceIface)(nil).ListSecondaryStorageSelectorsIter), varargs...)
 }
 
-// ListSecondaryStorageSelectors mocks base method.
-func (m *MockHostServiceIface) ListSecondaryStorageSelectors(p *ListSecondaryStorageSelectorsParams) (*ListSecondaryStorageSelectorsResponse, error) {
+// ListSecondaryStorageSelectorsIterWithContext mocks base method.
+func (m *MockHostServiceIface) ListSecondaryStorageSelectorsIterWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams, opts ...PageOption) iter.Seq2[*SecondaryStorageSelector, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSecondaryStorageSelectors", p)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStorageSelectorsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SecondaryStorageSelector, error])
+	return ret0
+}
+
+// ListSecondaryStorageSelectorsIterWithContext indicates an expected call of ListSecondaryStorageSelectorsIterWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListSecondaryStorageSelectorsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStorageSelectorsIterWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListSecondaryStorageSelectorsIterWithContext), varargs...)
+}
+
+// ListSecondaryStorageSelectorsWithContext mocks base method.
+func (m *MockHostServiceIface) ListSecondaryStorageSelectorsWithContext(ctx context.Context, p *ListSecondaryStorageSelectorsParams) (*ListSecondaryStorageSelectorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecondaryStorageSelectorsWithContext", ctx, p)
 	ret0, _ := ret[0].(*ListSecondaryStorageSelectorsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSecondaryStorageSelectors indicates an expected call of ListSecondaryStorageSelectors.
-func (mr *MockHostServiceIfaceMockRecorder) ListSecondaryStorageSelectors(p any) *gomock.Call {
+// ListSecondaryStorageSelectorsWithContext indicates an expected call of ListSecondaryStorageSelectorsWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ListSecondaryStorageSelectorsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStorageSelectors", reflect.TypeOf((*MockHostServiceIface)(nil).ListSecondaryStorageSelectors), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStorageSelectorsWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ListSecondaryStorageSelectorsWithContext), ctx, p)
 }
 
 // MigrateSecondaryStorageData mocks base method.
@@ -586,8 +1641,38 @@ func (mr *MockHostServiceIfaceMockRecorder) MigrateSecondaryStorageData(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecondaryStorageData", reflect.TypeOf((*MockHostServiceIface)(nil).MigrateSecondaryStorageData), p)
 }
 
+// MigrateSecondaryStorageDataAsync mocks base method.
+func (m *MockHostServiceIface) MigrateSecondaryStorageDataAsync(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSecondaryStorageDataAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSecondaryStorageDataAsync indicates an expected call of MigrateSecondaryStorageDataAsync.
+func (mr *MockHostServiceIfaceMockRecorder) MigrateSecondaryStorageDataAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecondaryStorageDataAsync", reflect.TypeOf((*MockHostServiceIface)(nil).MigrateSecondaryStorageDataAsync), ctx, p)
+}
+
+// MigrateSecondaryStorageDataWithContext mocks base method.
+func (m *MockHostServiceIface) MigrateSecondaryStorageDataWithContext(ctx context.Context, p *MigrateSecondaryStorageDataParams) (*MigrateSecondaryStorageDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSecondaryStorageDataWithContext", ctx, p)
+	ret0, _ := ret[0].(*MigrateSecondaryStorageDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSecondaryStorageDataWithContext indicates an expected call of MigrateSecondaryStorageDataWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) MigrateSecondaryStorageDataWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSecondaryStorageDataWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).MigrateSecondaryStorageDataWithContext), ctx, p)
+}
+
 // NewAddBaremetalHostParams mocks base method.
-func (m *MockHostServiceIface) NewAddBaremetalHostParams(hypervisor, podid, url, zoneid string) *AddBaremetalHostParams {
+func (m *MockHostServiceIface) NewAddBaremetalHostParams(hypervisor HypervisorType, podid, url, zoneid string) *AddBaremetalHostParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewAddBaremetalHostParams", hypervisor, podid, url, zoneid)
 	ret0, _ := ret[0].(*AddBaremetalHostParams)
@@ -615,7 +1700,7 @@ func (mr *MockHostServiceIfaceMockRecorder) NewAddGloboDnsHostParams(password, p
 }
 
 // NewAddHostParams mocks base method.
-func (m *MockHostServiceIface) NewAddHostParams(hypervisor, podid, url, zoneid string) *AddHostParams {
+func (m *MockHostServiceIface) NewAddHostParams(hypervisor HypervisorType, podid, url, zoneid string) *AddHostParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewAddHostParams", hypervisor, podid, url, zoneid)
 	ret0, _ := ret[0].(*AddHostParams)
@@ -825,7 +1910,7 @@ func (mr *MockHostServiceIfaceMockRecorder) NewListDedicatedHostsParams() *gomoc
 }
 
 // NewListHostHAProvidersParams mocks base method.
-func (m *MockHostServiceIface) NewListHostHAProvidersParams(hypervisor string) *ListHostHAProvidersParams {
+func (m *MockHostServiceIface) NewListHostHAProvidersParams(hypervisor HypervisorType) *ListHostHAProvidersParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewListHostHAProvidersParams", hypervisor)
 	ret0, _ := ret[0].(*ListHostHAProvidersParams)
@@ -1049,6 +2134,36 @@ func (mr *MockHostServiceIfaceMockRecorder) PrepareHostForMaintenance(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareHostForMaintenance", reflect.TypeOf((*MockHostServiceIface)(nil).PrepareHostForMaintenance), p)
 }
 
+// PrepareHostForMaintenanceAsync mocks base method.
+func (m *MockHostServiceIface) PrepareHostForMaintenanceAsync(ctx context.Context, p *PrepareHostForMaintenanceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareHostForMaintenanceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareHostForMaintenanceAsync indicates an expected call of PrepareHostForMaintenanceAsync.
+func (mr *MockHostServiceIfaceMockRecorder) PrepareHostForMaintenanceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareHostForMaintenanceAsync", reflect.TypeOf((*MockHostServiceIface)(nil).PrepareHostForMaintenanceAsync), ctx, p)
+}
+
+// PrepareHostForMaintenanceWithContext mocks base method.
+func (m *MockHostServiceIface) PrepareHostForMaintenanceWithContext(ctx context.Context, p *PrepareHostForMaintenanceParams) (*PrepareHostForMaintenanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareHostForMaintenanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*PrepareHostForMaintenanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareHostForMaintenanceWithContext indicates an expected call of PrepareHostForMaintenanceWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) PrepareHostForMaintenanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareHostForMaintenanceWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).PrepareHostForMaintenanceWithContext), ctx, p)
+}
+
 // ReconnectHost mocks base method.
 func (m *MockHostServiceIface) ReconnectHost(p *ReconnectHostParams) (*ReconnectHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -1064,6 +2179,36 @@ func (mr *MockHostServiceIfaceMockRecorder) ReconnectHost(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconnectHost", reflect.TypeOf((*MockHostServiceIface)(nil).ReconnectHost), p)
 }
 
+// ReconnectHostAsync mocks base method.
+func (m *MockHostServiceIface) ReconnectHostAsync(ctx context.Context, p *ReconnectHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconnectHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconnectHostAsync indicates an expected call of ReconnectHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) ReconnectHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconnectHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).ReconnectHostAsync), ctx, p)
+}
+
+// ReconnectHostWithContext mocks base method.
+func (m *MockHostServiceIface) ReconnectHostWithContext(ctx context.Context, p *ReconnectHostParams) (*ReconnectHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReconnectHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReconnectHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReconnectHostWithContext indicates an expected call of ReconnectHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ReconnectHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReconnectHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ReconnectHostWithContext), ctx, p)
+}
+
 // ReleaseDedicatedHost mocks base method.
 func (m *MockHostServiceIface) ReleaseDedicatedHost(p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -1079,6 +2224,36 @@ func (mr *MockHostServiceIfaceMockRecorder) ReleaseDedicatedHost(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedHost", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseDedicatedHost), p)
 }
 
+// ReleaseDedicatedHostAsync mocks base method.
+func (m *MockHostServiceIface) ReleaseDedicatedHostAsync(ctx context.Context, p *ReleaseDedicatedHostParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedHostAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedHostAsync indicates an expected call of ReleaseDedicatedHostAsync.
+func (mr *MockHostServiceIfaceMockRecorder) ReleaseDedicatedHostAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedHostAsync", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseDedicatedHostAsync), ctx, p)
+}
+
+// ReleaseDedicatedHostWithContext mocks base method.
+func (m *MockHostServiceIface) ReleaseDedicatedHostWithContext(ctx context.Context, p *ReleaseDedicatedHostParams) (*ReleaseDedicatedHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseDedicatedHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedHostWithContext indicates an expected call of ReleaseDedicatedHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ReleaseDedicatedHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseDedicatedHostWithContext), ctx, p)
+}
+
 // ReleaseHostReservation mocks base method.
 func (m *MockHostServiceIface) ReleaseHostReservation(p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
 	m.ctrl.T.Helper()
@@ -1094,6 +2269,36 @@ func (mr *MockHostServiceIfaceMockRecorder) ReleaseHostReservation(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHostReservation", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseHostReservation), p)
 }
 
+// ReleaseHostReservationAsync mocks base method.
+func (m *MockHostServiceIface) ReleaseHostReservationAsync(ctx context.Context, p *ReleaseHostReservationParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHostReservationAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHostReservationAsync indicates an expected call of ReleaseHostReservationAsync.
+func (mr *MockHostServiceIfaceMockRecorder) ReleaseHostReservationAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHostReservationAsync", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseHostReservationAsync), ctx, p)
+}
+
+// ReleaseHostReservationWithContext mocks base method.
+func (m *MockHostServiceIface) ReleaseHostReservationWithContext(ctx context.Context, p *ReleaseHostReservationParams) (*ReleaseHostReservationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseHostReservationWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseHostReservationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseHostReservationWithContext indicates an expected call of ReleaseHostReservationWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) ReleaseHostReservationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseHostReservationWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).ReleaseHostReservationWithContext), ctx, p)
+}
+
 // RemoveSecondaryStorageSelector mocks base method.
 func (m *MockHostServiceIface) RemoveSecondaryStorageSelector(p *RemoveSecondaryStorageSelectorParams) (*RemoveSecondaryStorageSelectorResponse, error) {
 	m.ctrl.T.Helper()
@@ -1109,6 +2314,21 @@ func (mr *MockHostServiceIfaceMockRecorder) RemoveSecondaryStorageSelector(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSecondaryStorageSelector", reflect.TypeOf((*MockHostServiceIface)(nil).RemoveSecondaryStorageSelector), p)
 }
 
+// RemoveSecondaryStorageSelectorWithContext mocks base method.
+func (m *MockHostServiceIface) RemoveSecondaryStorageSelectorWithContext(ctx context.Context, p *RemoveSecondaryStorageSelectorParams) (*RemoveSecondaryStorageSelectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveSecondaryStorageSelectorWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveSecondaryStorageSelectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveSecondaryStorageSelectorWithContext indicates an expected call of RemoveSecondaryStorageSelectorWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) RemoveSecondaryStorageSelectorWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveSecondaryStorageSelectorWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).RemoveSecondaryStorageSelectorWithContext), ctx, p)
+}
+
 // UpdateHost mocks base method.
 func (m *MockHostServiceIface) UpdateHost(p *UpdateHostParams) (*UpdateHostResponse, error) {
 	m.ctrl.T.Helper()
@@ -1139,6 +2359,36 @@ func (mr *MockHostServiceIfaceMockRecorder) UpdateHostPassword(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostPassword", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateHostPassword), p)
 }
 
+// UpdateHostPasswordWithContext mocks base method.
+func (m *MockHostServiceIface) UpdateHostPasswordWithContext(ctx context.Context, p *UpdateHostPasswordParams) (*UpdateHostPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHostPasswordWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateHostPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHostPasswordWithContext indicates an expected call of UpdateHostPasswordWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) UpdateHostPasswordWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostPasswordWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateHostPasswordWithContext), ctx, p)
+}
+
+// UpdateHostWithContext mocks base method.
+func (m *MockHostServiceIface) UpdateHostWithContext(ctx context.Context, p *UpdateHostParams) (*UpdateHostResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHostWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateHostResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHostWithContext indicates an expected call of UpdateHostWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) UpdateHostWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHostWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateHostWithContext), ctx, p)
+}
+
 // UpdateSecondaryStorageSelector mocks base method.
 func (m *MockHostServiceIface) UpdateSecondaryStorageSelector(p *UpdateSecondaryStorageSelectorParams) (*UpdateSecondaryStorageSelectorResponse, error) {
 	m.ctrl.T.Helper()
@@ -1153,3 +2403,18 @@ func (mr *MockHostServiceIfaceMockRecorder) UpdateSecondaryStorageSelector(p any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecondaryStorageSelector", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateSecondaryStorageSelector), p)
 }
+
+// UpdateSecondaryStorageSelectorWithContext mocks base method.
+func (m *MockHostServiceIface) UpdateSecondaryStorageSelectorWithContext(ctx context.Context, p *UpdateSecondaryStorageSelectorParams) (*UpdateSecondaryStorageSelectorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecondaryStorageSelectorWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateSecondaryStorageSelectorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecondaryStorageSelectorWithContext indicates an expected call of UpdateSecondaryStorageSelectorWithContext.
+func (mr *MockHostServiceIfaceMockRecorder) UpdateSecondaryStorageSelectorWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecondaryStorageSelectorWithContext", reflect.TypeOf((*MockHostServiceIface)(nil).UpdateSecondaryStorageSelectorWithContext), ctx, p)
+}
@@ -20,20 +20,29 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type HypervisorServiceIface interface {
 	ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error)
+	ListHypervisorCapabilitiesAll(p *ListHypervisorCapabilitiesParams, opts ...PageOption) ([]*HypervisorCapability, error)
+	ListHypervisorCapabilitiesAllWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams, opts ...PageOption) ([]*HypervisorCapability, error)
+	ListHypervisorCapabilitiesIter(p *ListHypervisorCapabilitiesParams, opts ...PageOption) iter.Seq2[*HypervisorCapability, error]
+	ListHypervisorCapabilitiesIterWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams, opts ...PageOption) iter.Seq2[*HypervisorCapability, error]
 	NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams
 	GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
+	GetHypervisorCapabilityByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HypervisorCapability, int, error)
 	ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
+	ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error)
 	NewListHypervisorsParams() *ListHypervisorsParams
 	UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
+	UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error)
 	NewUpdateHypervisorCapabilitiesParams() *UpdateHypervisorCapabilitiesParams
 }
 
@@ -47,7 +56,7 @@ func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
 		return u
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -66,7 +75,48 @@ func (p *ListHypervisorCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v string) {
+// ValidateAgainst checks the parameters against the listHypervisorCapabilities API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListHypervisorCapabilitiesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listHypervisorCapabilities", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listHypervisorCapabilities API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListHypervisorCapabilitiesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"hypervisor": 255, "id": 255, "keyword": 255},
+	}.validate("listHypervisorCapabilities", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listHypervisorCapabilities API, before the request is signed
+func (p *ListHypervisorCapabilitiesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListHypervisorCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListHypervisorCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listHypervisorCapabilities", b, &p.p, map[string]paramDecoder{
+		"hypervisor": decodeParam[HypervisorType],
+		"id":         decodeParam[string],
+		"keyword":    decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListHypervisorCapabilitiesParams) Clone() *ListHypervisorCapabilitiesParams {
+	return &ListHypervisorCapabilitiesParams{p: cloneParams(p.p)}
+}
+
+func (p *ListHypervisorCapabilitiesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -79,11 +129,11 @@ func (p *ListHypervisorCapabilitiesParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListHypervisorCapabilitiesParams) GetHypervisor() (string, bool) {
+func (p *ListHypervisorCapabilitiesParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -181,6 +231,11 @@ func (s *HypervisorService) NewListHypervisorCapabilitiesParams() *ListHyperviso
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
+	return s.GetHypervisorCapabilityByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetHypervisorCapabilityByIDWithContext is like GetHypervisorCapabilityByID, but honours the cancellation and deadline of ctx
+func (s *HypervisorService) GetHypervisorCapabilityByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
 	p := &ListHypervisorCapabilitiesParams{}
 	p.p = make(map[string]interface{})
 
@@ -192,11 +247,10 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListHypervisorCapabilities(p)
+	l, err := s.ListHypervisorCapabilitiesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -214,36 +268,79 @@ func (s *HypervisorService) GetHypervisorCapabilityByID(id string, opts ...Optio
 
 // Lists all hypervisor capabilities.
 func (s *HypervisorService) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisorCapabilities", p.toURLValues())
+	return s.ListHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+// ListHypervisorCapabilitiesWithContext is like ListHypervisorCapabilities, but honours the cancellation and deadline of ctx
+func (s *HypervisorService) ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listHypervisorCapabilities", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHypervisorCapabilitiesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listHypervisorCapabilities", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListHypervisorCapabilitiesAll returns all HypervisorCapabilities matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *HypervisorService) ListHypervisorCapabilitiesAll(p *ListHypervisorCapabilitiesParams, opts ...PageOption) ([]*HypervisorCapability, error) {
+	return s.ListHypervisorCapabilitiesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListHypervisorCapabilitiesAllWithContext is like ListHypervisorCapabilitiesAll, but honours the cancellation and deadline of ctx
+func (s *HypervisorService) ListHypervisorCapabilitiesAllWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams, opts ...PageOption) ([]*HypervisorCapability, error) {
+	return collectPages(s.ListHypervisorCapabilitiesIterWithContext(ctx, p, opts...))
+}
+
+// ListHypervisorCapabilitiesIter returns an iterator over all HypervisorCapabilities matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *HypervisorService) ListHypervisorCapabilitiesIter(p *ListHypervisorCapabilitiesParams, opts ...PageOption) iter.Seq2[*HypervisorCapability, error] {
+	return s.ListHypervisorCapabilitiesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListHypervisorCapabilitiesIterWithContext is like ListHypervisorCapabilitiesIter, but honours the cancellation and deadline of ctx
+func (s *HypervisorService) ListHypervisorCapabilitiesIterWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams, opts ...PageOption) iter.Seq2[*HypervisorCapability, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*HypervisorCapability, int, error) {
+		l, err := s.ListHypervisorCapabilitiesWithContext(ctx, &ListHypervisorCapabilitiesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.HypervisorCapabilities, l.Count, nil
+	}, opts...)
+}
+
 type ListHypervisorCapabilitiesResponse struct {
 	Count                  int                     `json:"count"`
 	HypervisorCapabilities []*HypervisorCapability `json:"hypervisorCapabilities"`
 }
 
 type HypervisorCapability struct {
-	Hypervisor           string `json:"hypervisor"`
-	Hypervisorversion    string `json:"hypervisorversion"`
-	Id                   string `json:"id"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Maxdatavolumeslimit  int    `json:"maxdatavolumeslimit"`
-	Maxguestslimit       int64  `json:"maxguestslimit"`
-	Maxhostspercluster   int    `json:"maxhostspercluster"`
-	Securitygroupenabled bool   `json:"securitygroupenabled"`
-	Storagemotionenabled bool   `json:"storagemotionenabled"`
-	Vmsnapshotenabled    bool   `json:"vmsnapshotenabled"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Hypervisorversion    string                     `json:"hypervisorversion"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maxdatavolumeslimit  FlexInt                    `json:"maxdatavolumeslimit"`
+	Maxguestslimit       FlexInt64                  `json:"maxguestslimit"`
+	Maxhostspercluster   FlexInt                    `json:"maxhostspercluster"`
+	Securitygroupenabled FlexBool                   `json:"securitygroupenabled"`
+	Storagemotionenabled FlexBool                   `json:"storagemotionenabled"`
+	Vmsnapshotenabled    FlexBool                   `json:"vmsnapshotenabled"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *HypervisorCapability) UnmarshalJSON(b []byte) error {
+	type alias HypervisorCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListHypervisorsParams struct {
@@ -261,6 +358,43 @@ func (p *ListHypervisorsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listHypervisors API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListHypervisorsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listHypervisors", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listHypervisors API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListHypervisorsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"zoneid": 255},
+	}.validate("listHypervisors", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listHypervisors API, before the request is signed
+func (p *ListHypervisorsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListHypervisorsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListHypervisorsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listHypervisors", b, &p.p, map[string]paramDecoder{
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListHypervisorsParams) Clone() *ListHypervisorsParams {
+	return &ListHypervisorsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListHypervisorsParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -292,13 +426,22 @@ func (s *HypervisorService) NewListHypervisorsParams() *ListHypervisorsParams {
 
 // List hypervisors
 func (s *HypervisorService) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
-	resp, err := s.cs.newRequest("listHypervisors", p.toURLValues())
+	return s.ListHypervisorsWithContext(context.Background(), p)
+}
+
+// ListHypervisorsWithContext is like ListHypervisors, but honours the cancellation and deadline of ctx
+func (s *HypervisorService) ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listHypervisors", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListHypervisorsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listHypervisors", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -311,9 +454,15 @@ type ListHypervisorsResponse struct {
 }
 
 type Hypervisor struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Hypervisor) UnmarshalJSON(b []byte) error {
+	type alias Hypervisor
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateHypervisorCapabilitiesParams struct {
@@ -326,7 +475,7 @@ func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
 		return u
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["hypervisorversion"]; found {
 		u.Set("hypervisorversion", v.(string))
@@ -361,7 +510,52 @@ func (p *UpdateHypervisorCapabilitiesParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *UpdateHypervisorCapabilitiesParams) SetHypervisor(v string) {
+// ValidateAgainst checks the parameters against the updateHypervisorCapabilities API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateHypervisorCapabilitiesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateHypervisorCapabilities", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateHypervisorCapabilities API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateHypervisorCapabilitiesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"hypervisor": 255, "hypervisorversion": 255, "id": 255},
+	}.validate("updateHypervisorCapabilities", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateHypervisorCapabilities API, before the request is signed
+func (p *UpdateHypervisorCapabilitiesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateHypervisorCapabilitiesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateHypervisorCapabilitiesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateHypervisorCapabilities", b, &p.p, map[string]paramDecoder{
+		"hypervisor":           decodeParam[HypervisorType],
+		"hypervisorversion":    decodeParam[string],
+		"id":                   decodeParam[string],
+		"maxdatavolumeslimit":  decodeParam[int],
+		"maxguestslimit":       decodeParam[int64],
+		"maxhostspercluster":   decodeParam[int],
+		"securitygroupenabled": decodeParam[bool],
+		"storagemotionenabled": decodeParam[bool],
+		"vmsnapshotenabled":    decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateHypervisorCapabilitiesParams) Clone() *UpdateHypervisorCapabilitiesParams {
+	return &UpdateHypervisorCapabilitiesParams{p: cloneParams(p.p)}
+}
+
+func (p *UpdateHypervisorCapabilitiesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -374,11 +568,11 @@ func (p *UpdateHypervisorCapabilitiesParams) ResetHypervisor() {
 	}
 }
 
-func (p *UpdateHypervisorCapabilitiesParams) GetHypervisor() (string, bool) {
+func (p *UpdateHypervisorCapabilitiesParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -560,13 +754,22 @@ func (s *HypervisorService) NewUpdateHypervisorCapabilitiesParams() *UpdateHyper
 
 // Updates a hypervisor capabilities.
 func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
-	resp, err := s.cs.newPostRequest("updateHypervisorCapabilities", p.toURLValues())
+	return s.UpdateHypervisorCapabilitiesWithContext(context.Background(), p)
+}
+
+// UpdateHypervisorCapabilitiesWithContext is like UpdateHypervisorCapabilities, but honours the cancellation and deadline of ctx
+func (s *HypervisorService) UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateHypervisorCapabilities", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateHypervisorCapabilitiesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateHypervisorCapabilities", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -574,15 +777,21 @@ func (s *HypervisorService) UpdateHypervisorCapabilities(p *UpdateHypervisorCapa
 }
 
 type UpdateHypervisorCapabilitiesResponse struct {
-	Hypervisor           string `json:"hypervisor"`
-	Hypervisorversion    string `json:"hypervisorversion"`
-	Id                   string `json:"id"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Maxdatavolumeslimit  int    `json:"maxdatavolumeslimit"`
-	Maxguestslimit       int64  `json:"maxguestslimit"`
-	Maxhostspercluster   int    `json:"maxhostspercluster"`
-	Securitygroupenabled bool   `json:"securitygroupenabled"`
-	Storagemotionenabled bool   `json:"storagemotionenabled"`
-	Vmsnapshotenabled    bool   `json:"vmsnapshotenabled"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Hypervisorversion    string                     `json:"hypervisorversion"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maxdatavolumeslimit  FlexInt                    `json:"maxdatavolumeslimit"`
+	Maxguestslimit       FlexInt64                  `json:"maxguestslimit"`
+	Maxhostspercluster   FlexInt                    `json:"maxhostspercluster"`
+	Securitygroupenabled FlexBool                   `json:"securitygroupenabled"`
+	Storagemotionenabled FlexBool                   `json:"storagemotionenabled"`
+	Vmsnapshotenabled    FlexBool                   `json:"vmsnapshotenabled"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateHypervisorCapabilitiesResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateHypervisorCapabilitiesResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -79,6 +81,27 @@ func (mr *MockHypervisorServiceIfaceMockRecorder) GetHypervisorCapabilityByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHypervisorCapabilityByID", reflect.TypeOf((*MockHypervisorServiceIface)(nil).GetHypervisorCapabilityByID), varargs...)
 }
 
+// GetHypervisorCapabilityByIDWithContext mocks base method.
+func (m *MockHypervisorServiceIface) GetHypervisorCapabilityByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*HypervisorCapability, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetHypervisorCapabilityByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*HypervisorCapability)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetHypervisorCapabilityByIDWithContext indicates an expected call of GetHypervisorCapabilityByIDWithContext.
+func (mr *MockHypervisorServiceIfaceMockRecorder) GetHypervisorCapabilityByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHypervisorCapabilityByIDWithContext", reflect.TypeOf((*MockHypervisorServiceIface)(nil).GetHypervisorCapabilityByIDWithContext), varargs...)
+}
+
 // ListHypervisorCapabilities mocks base method.
 func (m *MockHypervisorServiceIface) ListHypervisorCapabilities(p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +117,99 @@ func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilities(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilities", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilities), p)
 }
 
+// ListHypervisorCapabilitiesAll mocks base method.
+func (m *MockHypervisorServiceIface) ListHypervisorCapabilitiesAll(p *ListHypervisorCapabilitiesParams, opts ...PageOption) ([]*HypervisorCapability, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHypervisorCapabilitiesAll", varargs...)
+	ret0, _ := ret[0].([]*HypervisorCapability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHypervisorCapabilitiesAll indicates an expected call of ListHypervisorCapabilitiesAll.
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilitiesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilitiesAll", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilitiesAll), varargs...)
+}
+
+// ListHypervisorCapabilitiesAllWithContext mocks base method.
+func (m *MockHypervisorServiceIface) ListHypervisorCapabilitiesAllWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams, opts ...PageOption) ([]*HypervisorCapability, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHypervisorCapabilitiesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*HypervisorCapability)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHypervisorCapabilitiesAllWithContext indicates an expected call of ListHypervisorCapabilitiesAllWithContext.
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilitiesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilitiesAllWithContext", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilitiesAllWithContext), varargs...)
+}
+
+// ListHypervisorCapabilitiesIter mocks base method.
+func (m *MockHypervisorServiceIface) ListHypervisorCapabilitiesIter(p *ListHypervisorCapabilitiesParams, opts ...PageOption) iter.Seq2[*HypervisorCapability, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHypervisorCapabilitiesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*HypervisorCapability, error])
+	return ret0
+}
+
+// ListHypervisorCapabilitiesIter indicates an expected call of ListHypervisorCapabilitiesIter.
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilitiesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilitiesIter", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilitiesIter), varargs...)
+}
+
+// ListHypervisorCapabilitiesIterWithContext mocks base method.
+func (m *MockHypervisorServiceIface) ListHypervisorCapabilitiesIterWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams, opts ...PageOption) iter.Seq2[*HypervisorCapability, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListHypervisorCapabilitiesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*HypervisorCapability, error])
+	return ret0
+}
+
+// ListHypervisorCapabilitiesIterWithContext indicates an expected call of ListHypervisorCapabilitiesIterWithContext.
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilitiesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilitiesIterWithContext", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilitiesIterWithContext), varargs...)
+}
+
+// ListHypervisorCapabilitiesWithContext mocks base method.
+func (m *MockHypervisorServiceIface) ListHypervisorCapabilitiesWithContext(ctx context.Context, p *ListHypervisorCapabilitiesParams) (*ListHypervisorCapabilitiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHypervisorCapabilitiesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListHypervisorCapabilitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHypervisorCapabilitiesWithContext indicates an expected call of ListHypervisorCapabilitiesWithContext.
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorCapabilitiesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorCapabilitiesWithContext", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorCapabilitiesWithContext), ctx, p)
+}
+
 // ListHypervisors mocks base method.
 func (m *MockHypervisorServiceIface) ListHypervisors(p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +225,21 @@ func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisors(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisors", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisors), p)
 }
 
+// ListHypervisorsWithContext mocks base method.
+func (m *MockHypervisorServiceIface) ListHypervisorsWithContext(ctx context.Context, p *ListHypervisorsParams) (*ListHypervisorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListHypervisorsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListHypervisorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListHypervisorsWithContext indicates an expected call of ListHypervisorsWithContext.
+func (mr *MockHypervisorServiceIfaceMockRecorder) ListHypervisorsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListHypervisorsWithContext", reflect.TypeOf((*MockHypervisorServiceIface)(nil).ListHypervisorsWithContext), ctx, p)
+}
+
 // NewListHypervisorCapabilitiesParams mocks base method.
 func (m *MockHypervisorServiceIface) NewListHypervisorCapabilitiesParams() *ListHypervisorCapabilitiesParams {
 	m.ctrl.T.Helper()
@@ -165,3 +296,18 @@ func (mr *MockHypervisorServiceIfaceMockRecorder) UpdateHypervisorCapabilities(p
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHypervisorCapabilities", reflect.TypeOf((*MockHypervisorServiceIface)(nil).UpdateHypervisorCapabilities), p)
 }
+
+// UpdateHypervisorCapabilitiesWithContext mocks base method.
+func (m *MockHypervisorServiceIface) UpdateHypervisorCapabilitiesWithContext(ctx context.Context, p *UpdateHypervisorCapabilitiesParams) (*UpdateHypervisorCapabilitiesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateHypervisorCapabilitiesWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateHypervisorCapabilitiesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateHypervisorCapabilitiesWithContext indicates an expected call of UpdateHypervisorCapabilitiesWithContext.
+func (mr *MockHypervisorServiceIfaceMockRecorder) UpdateHypervisorCapabilitiesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateHypervisorCapabilitiesWithContext", reflect.TypeOf((*MockHypervisorServiceIface)(nil).UpdateHypervisorCapabilitiesWithContext), ctx, p)
+}
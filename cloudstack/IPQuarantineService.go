@@ -20,17 +20,26 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type IPQuarantineServiceIface interface {
 	ListQuarantinedIps(p *ListQuarantinedIpsParams) (*ListQuarantinedIpsResponse, error)
+	ListQuarantinedIpsWithContext(ctx context.Context, p *ListQuarantinedIpsParams) (*ListQuarantinedIpsResponse, error)
+	ListQuarantinedIpsAll(p *ListQuarantinedIpsParams, opts ...PageOption) ([]*QuarantinedIp, error)
+	ListQuarantinedIpsAllWithContext(ctx context.Context, p *ListQuarantinedIpsParams, opts ...PageOption) ([]*QuarantinedIp, error)
+	ListQuarantinedIpsIter(p *ListQuarantinedIpsParams, opts ...PageOption) iter.Seq2[*QuarantinedIp, error]
+	ListQuarantinedIpsIterWithContext(ctx context.Context, p *ListQuarantinedIpsParams, opts ...PageOption) iter.Seq2[*QuarantinedIp, error]
 	NewListQuarantinedIpsParams() *ListQuarantinedIpsParams
 	RemoveQuarantinedIp(p *RemoveQuarantinedIpParams) (*RemoveQuarantinedIpResponse, error)
+	RemoveQuarantinedIpWithContext(ctx context.Context, p *RemoveQuarantinedIpParams) (*RemoveQuarantinedIpResponse, error)
 	NewRemoveQuarantinedIpParams(removalreason string) *RemoveQuarantinedIpParams
 	UpdateQuarantinedIp(p *UpdateQuarantinedIpParams) (*UpdateQuarantinedIpResponse, error)
+	UpdateQuarantinedIpWithContext(ctx context.Context, p *UpdateQuarantinedIpParams) (*UpdateQuarantinedIpResponse, error)
 	NewUpdateQuarantinedIpParams(enddate string) *UpdateQuarantinedIpParams
 }
 
@@ -65,6 +74,47 @@ func (p *ListQuarantinedIpsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listQuarantinedIps API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListQuarantinedIpsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listQuarantinedIps", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listQuarantinedIps API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListQuarantinedIpsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listQuarantinedIps", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listQuarantinedIps API, before the request is signed
+func (p *ListQuarantinedIpsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListQuarantinedIpsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListQuarantinedIpsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listQuarantinedIps", b, &p.p, map[string]paramDecoder{
+		"keyword":      decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"showinactive": decodeParam[bool],
+		"showremoved":  decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListQuarantinedIpsParams) Clone() *ListQuarantinedIpsParams {
+	return &ListQuarantinedIpsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListQuarantinedIpsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -180,36 +230,79 @@ func (s *IPQuarantineService) NewListQuarantinedIpsParams() *ListQuarantinedIpsP
 
 // List public IP addresses in quarantine.
 func (s *IPQuarantineService) ListQuarantinedIps(p *ListQuarantinedIpsParams) (*ListQuarantinedIpsResponse, error) {
-	resp, err := s.cs.newRequest("listQuarantinedIps", p.toURLValues())
+	return s.ListQuarantinedIpsWithContext(context.Background(), p)
+}
+
+// ListQuarantinedIpsWithContext is like ListQuarantinedIps, but honours the cancellation and deadline of ctx
+func (s *IPQuarantineService) ListQuarantinedIpsWithContext(ctx context.Context, p *ListQuarantinedIpsParams) (*ListQuarantinedIpsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listQuarantinedIps", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListQuarantinedIpsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listQuarantinedIps", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListQuarantinedIpsAll returns all QuarantinedIps matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *IPQuarantineService) ListQuarantinedIpsAll(p *ListQuarantinedIpsParams, opts ...PageOption) ([]*QuarantinedIp, error) {
+	return s.ListQuarantinedIpsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListQuarantinedIpsAllWithContext is like ListQuarantinedIpsAll, but honours the cancellation and deadline of ctx
+func (s *IPQuarantineService) ListQuarantinedIpsAllWithContext(ctx context.Context, p *ListQuarantinedIpsParams, opts ...PageOption) ([]*QuarantinedIp, error) {
+	return collectPages(s.ListQuarantinedIpsIterWithContext(ctx, p, opts...))
+}
+
+// ListQuarantinedIpsIter returns an iterator over all QuarantinedIps matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *IPQuarantineService) ListQuarantinedIpsIter(p *ListQuarantinedIpsParams, opts ...PageOption) iter.Seq2[*QuarantinedIp, error] {
+	return s.ListQuarantinedIpsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListQuarantinedIpsIterWithContext is like ListQuarantinedIpsIter, but honours the cancellation and deadline of ctx
+func (s *IPQuarantineService) ListQuarantinedIpsIterWithContext(ctx context.Context, p *ListQuarantinedIpsParams, opts ...PageOption) iter.Seq2[*QuarantinedIp, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*QuarantinedIp, int, error) {
+		l, err := s.ListQuarantinedIpsWithContext(ctx, &ListQuarantinedIpsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.QuarantinedIps, l.Count, nil
+	}, opts...)
+}
+
 type ListQuarantinedIpsResponse struct {
 	Count          int              `json:"count"`
 	QuarantinedIps []*QuarantinedIp `json:"quarantinedip"`
 }
 
 type QuarantinedIp struct {
-	Created           string `json:"created"`
-	Enddate           string `json:"enddate"`
-	Id                string `json:"id"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Previousownerid   string `json:"previousownerid"`
-	Previousownername string `json:"previousownername"`
-	Removalreason     string `json:"removalreason"`
-	Removed           string `json:"removed"`
-	Removeraccountid  string `json:"removeraccountid"`
+	Created           Time                       `json:"created"`
+	Enddate           Time                       `json:"enddate"`
+	Id                string                     `json:"id"`
+	Ipaddress         string                     `json:"ipaddress"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Previousownerid   string                     `json:"previousownerid"`
+	Previousownername string                     `json:"previousownername"`
+	Removalreason     string                     `json:"removalreason"`
+	Removed           Time                       `json:"removed"`
+	Removeraccountid  string                     `json:"removeraccountid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuarantinedIp) UnmarshalJSON(b []byte) error {
+	type alias QuarantinedIp
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveQuarantinedIpParams struct {
@@ -233,6 +326,46 @@ func (p *RemoveQuarantinedIpParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeQuarantinedIp API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveQuarantinedIpParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeQuarantinedIp", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeQuarantinedIp API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveQuarantinedIpParams) Validate() error {
+	return paramRules{
+		required: []string{"removalreason"},
+		lengths:  map[string]int{"id": 255, "ipaddress": 255, "removalreason": 255},
+	}.validate("removeQuarantinedIp", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeQuarantinedIp API, before the request is signed
+func (p *RemoveQuarantinedIpParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveQuarantinedIpParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveQuarantinedIpParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeQuarantinedIp", b, &p.p, map[string]paramDecoder{
+		"id":            decodeParam[string],
+		"ipaddress":     decodeParam[string],
+		"removalreason": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveQuarantinedIpParams) Clone() *RemoveQuarantinedIpParams {
+	return &RemoveQuarantinedIpParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveQuarantinedIpParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -307,13 +440,22 @@ func (s *IPQuarantineService) NewRemoveQuarantinedIpParams(removalreason string)
 
 // Removes a public IP address from quarantine. Only IPs in active quarantine can be removed.
 func (s *IPQuarantineService) RemoveQuarantinedIp(p *RemoveQuarantinedIpParams) (*RemoveQuarantinedIpResponse, error) {
-	resp, err := s.cs.newPostRequest("removeQuarantinedIp", p.toURLValues())
+	return s.RemoveQuarantinedIpWithContext(context.Background(), p)
+}
+
+// RemoveQuarantinedIpWithContext is like RemoveQuarantinedIp, but honours the cancellation and deadline of ctx
+func (s *IPQuarantineService) RemoveQuarantinedIpWithContext(ctx context.Context, p *RemoveQuarantinedIpParams) (*RemoveQuarantinedIpResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeQuarantinedIp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveQuarantinedIpResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeQuarantinedIp", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -321,17 +463,23 @@ func (s *IPQuarantineService) RemoveQuarantinedIp(p *RemoveQuarantinedIpParams)
 }
 
 type RemoveQuarantinedIpResponse struct {
-	Created           string `json:"created"`
-	Enddate           string `json:"enddate"`
-	Id                string `json:"id"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Previousownerid   string `json:"previousownerid"`
-	Previousownername string `json:"previousownername"`
-	Removalreason     string `json:"removalreason"`
-	Removed           string `json:"removed"`
-	Removeraccountid  string `json:"removeraccountid"`
+	Created           Time                       `json:"created"`
+	Enddate           Time                       `json:"enddate"`
+	Id                string                     `json:"id"`
+	Ipaddress         string                     `json:"ipaddress"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Previousownerid   string                     `json:"previousownerid"`
+	Previousownername string                     `json:"previousownername"`
+	Removalreason     string                     `json:"removalreason"`
+	Removed           Time                       `json:"removed"`
+	Removeraccountid  string                     `json:"removeraccountid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveQuarantinedIpResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveQuarantinedIpResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateQuarantinedIpParams struct {
@@ -355,6 +503,46 @@ func (p *UpdateQuarantinedIpParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateQuarantinedIp API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateQuarantinedIpParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateQuarantinedIp", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateQuarantinedIp API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateQuarantinedIpParams) Validate() error {
+	return paramRules{
+		required: []string{"enddate"},
+		lengths:  map[string]int{"enddate": 255, "id": 255, "ipaddress": 255},
+	}.validate("updateQuarantinedIp", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateQuarantinedIp API, before the request is signed
+func (p *UpdateQuarantinedIpParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateQuarantinedIpParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateQuarantinedIpParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateQuarantinedIp", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"id":        decodeParam[string],
+		"ipaddress": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateQuarantinedIpParams) Clone() *UpdateQuarantinedIpParams {
+	return &UpdateQuarantinedIpParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateQuarantinedIpParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -429,13 +617,22 @@ func (s *IPQuarantineService) NewUpdateQuarantinedIpParams(enddate string) *Upda
 
 // Updates the quarantine end date for the given public IP address.
 func (s *IPQuarantineService) UpdateQuarantinedIp(p *UpdateQuarantinedIpParams) (*UpdateQuarantinedIpResponse, error) {
-	resp, err := s.cs.newPostRequest("updateQuarantinedIp", p.toURLValues())
+	return s.UpdateQuarantinedIpWithContext(context.Background(), p)
+}
+
+// UpdateQuarantinedIpWithContext is like UpdateQuarantinedIp, but honours the cancellation and deadline of ctx
+func (s *IPQuarantineService) UpdateQuarantinedIpWithContext(ctx context.Context, p *UpdateQuarantinedIpParams) (*UpdateQuarantinedIpResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateQuarantinedIp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateQuarantinedIpResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateQuarantinedIp", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -443,15 +640,21 @@ func (s *IPQuarantineService) UpdateQuarantinedIp(p *UpdateQuarantinedIpParams)
 }
 
 type UpdateQuarantinedIpResponse struct {
-	Created           string `json:"created"`
-	Enddate           string `json:"enddate"`
-	Id                string `json:"id"`
-	Ipaddress         string `json:"ipaddress"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Previousownerid   string `json:"previousownerid"`
-	Previousownername string `json:"previousownername"`
-	Removalreason     string `json:"removalreason"`
-	Removed           string `json:"removed"`
-	Removeraccountid  string `json:"removeraccountid"`
+	Created           Time                       `json:"created"`
+	Enddate           Time                       `json:"enddate"`
+	Id                string                     `json:"id"`
+	Ipaddress         string                     `json:"ipaddress"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Previousownerid   string                     `json:"previousownerid"`
+	Previousownername string                     `json:"previousownername"`
+	Removalreason     string                     `json:"removalreason"`
+	Removed           Time                       `json:"removed"`
+	Removeraccountid  string                     `json:"removeraccountid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateQuarantinedIpResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateQuarantinedIpResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,99 @@ func (mr *MockIPQuarantineServiceIfaceMockRecorder) ListQuarantinedIps(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedIps", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).ListQuarantinedIps), p)
 }
 
+// ListQuarantinedIpsAll mocks base method.
+func (m *MockIPQuarantineServiceIface) ListQuarantinedIpsAll(p *ListQuarantinedIpsParams, opts ...PageOption) ([]*QuarantinedIp, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQuarantinedIpsAll", varargs...)
+	ret0, _ := ret[0].([]*QuarantinedIp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuarantinedIpsAll indicates an expected call of ListQuarantinedIpsAll.
+func (mr *MockIPQuarantineServiceIfaceMockRecorder) ListQuarantinedIpsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedIpsAll", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).ListQuarantinedIpsAll), varargs...)
+}
+
+// ListQuarantinedIpsAllWithContext mocks base method.
+func (m *MockIPQuarantineServiceIface) ListQuarantinedIpsAllWithContext(ctx context.Context, p *ListQuarantinedIpsParams, opts ...PageOption) ([]*QuarantinedIp, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQuarantinedIpsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*QuarantinedIp)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuarantinedIpsAllWithContext indicates an expected call of ListQuarantinedIpsAllWithContext.
+func (mr *MockIPQuarantineServiceIfaceMockRecorder) ListQuarantinedIpsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedIpsAllWithContext", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).ListQuarantinedIpsAllWithContext), varargs...)
+}
+
+// ListQuarantinedIpsIter mocks base method.
+func (m *MockIPQuarantineServiceIface) ListQuarantinedIpsIter(p *ListQuarantinedIpsParams, opts ...PageOption) iter.Seq2[*QuarantinedIp, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQuarantinedIpsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*QuarantinedIp, error])
+	return ret0
+}
+
+// ListQuarantinedIpsIter indicates an expected call of ListQuarantinedIpsIter.
+func (mr *MockIPQuarantineServiceIfaceMockRecorder) ListQuarantinedIpsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedIpsIter", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).ListQuarantinedIpsIter), varargs...)
+}
+
+// ListQuarantinedIpsIterWithContext mocks base method.
+func (m *MockIPQuarantineServiceIface) ListQuarantinedIpsIterWithContext(ctx context.Context, p *ListQuarantinedIpsParams, opts ...PageOption) iter.Seq2[*QuarantinedIp, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListQuarantinedIpsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*QuarantinedIp, error])
+	return ret0
+}
+
+// ListQuarantinedIpsIterWithContext indicates an expected call of ListQuarantinedIpsIterWithContext.
+func (mr *MockIPQuarantineServiceIfaceMockRecorder) ListQuarantinedIpsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedIpsIterWithContext", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).ListQuarantinedIpsIterWithContext), varargs...)
+}
+
+// ListQuarantinedIpsWithContext mocks base method.
+func (m *MockIPQuarantineServiceIface) ListQuarantinedIpsWithContext(ctx context.Context, p *ListQuarantinedIpsParams) (*ListQuarantinedIpsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListQuarantinedIpsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListQuarantinedIpsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListQuarantinedIpsWithContext indicates an expected call of ListQuarantinedIpsWithContext.
+func (mr *MockIPQuarantineServiceIfaceMockRecorder) ListQuarantinedIpsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListQuarantinedIpsWithContext", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).ListQuarantinedIpsWithContext), ctx, p)
+}
+
 // NewListQuarantinedIpsParams mocks base method.
 func (m *MockIPQuarantineServiceIface) NewListQuarantinedIpsParams() *ListQuarantinedIpsParams {
 	m.ctrl.T.Helper()
@@ -130,6 +225,21 @@ func (mr *MockIPQuarantineServiceIfaceMockRecorder) RemoveQuarantinedIp(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveQuarantinedIp", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).RemoveQuarantinedIp), p)
 }
 
+// RemoveQuarantinedIpWithContext mocks base method.
+func (m *MockIPQuarantineServiceIface) RemoveQuarantinedIpWithContext(ctx context.Context, p *RemoveQuarantinedIpParams) (*RemoveQuarantinedIpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveQuarantinedIpWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveQuarantinedIpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveQuarantinedIpWithContext indicates an expected call of RemoveQuarantinedIpWithContext.
+func (mr *MockIPQuarantineServiceIfaceMockRecorder) RemoveQuarantinedIpWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveQuarantinedIpWithContext", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).RemoveQuarantinedIpWithContext), ctx, p)
+}
+
 // UpdateQuarantinedIp mocks base method.
 func (m *MockIPQuarantineServiceIface) UpdateQuarantinedIp(p *UpdateQuarantinedIpParams) (*UpdateQuarantinedIpResponse, error) {
 	m.ctrl.T.Helper()
@@ -144,3 +254,18 @@ func (mr *MockIPQuarantineServiceIfaceMockRecorder) UpdateQuarantinedIp(p any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuarantinedIp", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).UpdateQuarantinedIp), p)
 }
+
+// UpdateQuarantinedIpWithContext mocks base method.
+func (m *MockIPQuarantineServiceIface) UpdateQuarantinedIpWithContext(ctx context.Context, p *UpdateQuarantinedIpParams) (*UpdateQuarantinedIpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateQuarantinedIpWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateQuarantinedIpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateQuarantinedIpWithContext indicates an expected call of UpdateQuarantinedIpWithContext.
+func (mr *MockIPQuarantineServiceIfaceMockRecorder) UpdateQuarantinedIpWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateQuarantinedIpWithContext", reflect.TypeOf((*MockIPQuarantineServiceIface)(nil).UpdateQuarantinedIpWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,30 +31,54 @@ import (
 
 type ISOServiceIface interface {
 	AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error)
+	AttachIsoAsync(ctx context.Context, p *AttachIsoParams) (*Job, error)
 	NewAttachIsoParams(id string, virtualmachineid string) *AttachIsoParams
 	CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error)
+	CopyIsoAsync(ctx context.Context, p *CopyIsoParams) (*Job, error)
 	NewCopyIsoParams(id string) *CopyIsoParams
 	DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error)
+	DeleteIsoAsync(ctx context.Context, p *DeleteIsoParams) (*Job, error)
 	NewDeleteIsoParams(id string) *DeleteIsoParams
 	DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error)
+	DetachIsoAsync(ctx context.Context, p *DetachIsoParams) (*Job, error)
 	NewDetachIsoParams(virtualmachineid string) *DetachIsoParams
 	ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error)
+	ExtractIsoAsync(ctx context.Context, p *ExtractIsoParams) (*Job, error)
 	NewExtractIsoParams(id string, mode string) *ExtractIsoParams
 	GetUploadParamsForIso(p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error)
+	GetUploadParamsForIsoWithContext(ctx context.Context, p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error)
 	NewGetUploadParamsForIsoParams(format string, name string, zoneid string) *GetUploadParamsForIsoParams
 	ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
+	ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error)
 	NewListIsoPermissionsParams(id string) *ListIsoPermissionsParams
 	GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error)
+	GetIsoPermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IsoPermission, int, error)
 	ListIsos(p *ListIsosParams) (*ListIsosResponse, error)
+	ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error)
+	ListIsosAll(p *ListIsosParams, opts ...PageOption) ([]*Iso, error)
+	ListIsosAllWithContext(ctx context.Context, p *ListIsosParams, opts ...PageOption) ([]*Iso, error)
+	ListIsosIter(p *ListIsosParams, opts ...PageOption) iter.Seq2[*Iso, error]
+	ListIsosIterWithContext(ctx context.Context, p *ListIsosParams, opts ...PageOption) iter.Seq2[*Iso, error]
 	NewListIsosParams() *ListIsosParams
 	GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetIsoIDWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error)
 	GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByNameWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error)
 	GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error)
+	GetIsoByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Iso, int, error)
 	RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error)
+	RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error)
 	NewRegisterIsoParams(displaytext string, name string, url string, zoneid string) *RegisterIsoParams
 	UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error)
+	UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error)
 	NewUpdateIsoParams(id string) *UpdateIsoParams
 	UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
+	UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error)
 	NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissionsParams
 }
 
@@ -78,6 +104,46 @@ func (p *AttachIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the attachIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AttachIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("attachIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the attachIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AttachIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "virtualmachineid"},
+		lengths:  map[string]int{"id": 255, "virtualmachineid": 255},
+	}.validate("attachIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the attachIso API, before the request is signed
+func (p *AttachIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AttachIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AttachIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("attachIso", b, &p.p, map[string]paramDecoder{
+		"forced":           decodeParam[bool],
+		"id":               decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AttachIsoParams) Clone() *AttachIsoParams {
+	return &AttachIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *AttachIsoParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,32 +219,39 @@ func (s *ISOService) NewAttachIsoParams(id string, virtualmachineid string) *Att
 
 // Attaches an ISO to  an Instance.
 func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
-	resp, err := s.cs.newPostRequest("attachIso", p.toURLValues())
+	return s.AttachIsoWithContext(context.Background(), p)
+}
+
+// AttachIsoWithContext is like AttachIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "attachIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AttachIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("attachIso", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "attachIso", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("attachIso", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -186,6 +259,25 @@ func (s *ISOService) AttachIso(p *AttachIsoParams) (*AttachIsoResponse, error) {
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AttachIso async job into r
+func (r *AttachIsoResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AttachIsoAsync starts the async job of AttachIso without waiting for it to finish, and returns a handle to the job
+func (s *ISOService) AttachIsoAsync(ctx context.Context, p *AttachIsoParams) (*Job, error) {
+	r, err := s.AttachIsoWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("attachIso", r.JobID), nil
+}
+
 type AttachIsoResponse struct {
 	Account               string                           `json:"account"`
 	Affinitygroup         []AttachIsoResponseAffinitygroup `json:"affinitygroup"`
@@ -197,32 +289,32 @@ type AttachIsoResponse struct {
 	Backupofferingname    string                           `json:"backupofferingname"`
 	Bootmode              string                           `json:"bootmode"`
 	Boottype              string                           `json:"boottype"`
-	Cpunumber             int                              `json:"cpunumber"`
-	Cpuspeed              int                              `json:"cpuspeed"`
+	Cpunumber             FlexInt                          `json:"cpunumber"`
+	Cpuspeed              FlexInt                          `json:"cpuspeed"`
 	Cpuused               string                           `json:"cpuused"`
-	Created               string                           `json:"created"`
-	Deleteprotection      bool                             `json:"deleteprotection"`
+	Created               Time                             `json:"created"`
+	Deleteprotection      FlexBool                         `json:"deleteprotection"`
 	Details               map[string]string                `json:"details"`
-	Diskioread            int64                            `json:"diskioread"`
-	Diskiowrite           int64                            `json:"diskiowrite"`
-	Diskkbsread           int64                            `json:"diskkbsread"`
-	Diskkbswrite          int64                            `json:"diskkbswrite"`
+	Diskioread            FlexInt64                        `json:"diskioread"`
+	Diskiowrite           FlexInt64                        `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                        `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                        `json:"diskkbswrite"`
 	Diskofferingid        string                           `json:"diskofferingid"`
 	Diskofferingname      string                           `json:"diskofferingname"`
 	Displayname           string                           `json:"displayname"`
-	Displayvm             bool                             `json:"displayvm"`
+	Displayvm             FlexBool                         `json:"displayvm"`
 	Domain                string                           `json:"domain"`
 	Domainid              string                           `json:"domainid"`
 	Domainpath            string                           `json:"domainpath"`
-	Forvirtualnetwork     bool                             `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                         `json:"forvirtualnetwork"`
 	Gpucardid             string                           `json:"gpucardid"`
 	Gpucardname           string                           `json:"gpucardname"`
-	Gpucount              int                              `json:"gpucount"`
+	Gpucount              FlexInt                          `json:"gpucount"`
 	Group                 string                           `json:"group"`
 	Groupid               string                           `json:"groupid"`
 	Guestosid             string                           `json:"guestosid"`
-	Haenable              bool                             `json:"haenable"`
-	Hasannotations        bool                             `json:"hasannotations"`
+	Haenable              FlexBool                         `json:"haenable"`
+	Hasannotations        FlexBool                         `json:"hasannotations"`
 	Hostcontrolstate      string                           `json:"hostcontrolstate"`
 	Hostid                string                           `json:"hostid"`
 	Hostname              string                           `json:"hostname"`
@@ -231,43 +323,43 @@ type AttachIsoResponse struct {
 	Id                    string                           `json:"id"`
 	Instancename          string                           `json:"instancename"`
 	Ipaddress             string                           `json:"ipaddress"`
-	Isdynamicallyscalable bool                             `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                         `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                           `json:"isodisplaytext"`
 	Isoid                 string                           `json:"isoid"`
 	Isoname               string                           `json:"isoname"`
 	JobID                 string                           `json:"jobid"`
-	Jobstatus             int                              `json:"jobstatus"`
+	Jobstatus             FlexInt                          `json:"jobstatus"`
 	Keypairs              string                           `json:"keypairs"`
-	Lastupdated           string                           `json:"lastupdated"`
-	Leaseduration         int                              `json:"leaseduration"`
+	Lastupdated           Time                             `json:"lastupdated"`
+	Leaseduration         FlexInt                          `json:"leaseduration"`
 	Leaseexpiryaction     string                           `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                           `json:"leaseexpirydate"`
-	Maxheads              int64                            `json:"maxheads"`
-	Maxresolutionx        int64                            `json:"maxresolutionx"`
-	Maxresolutiony        int64                            `json:"maxresolutiony"`
-	Memory                int                              `json:"memory"`
-	Memoryintfreekbs      int64                            `json:"memoryintfreekbs"`
-	Memorykbs             int64                            `json:"memorykbs"`
-	Memorytargetkbs       int64                            `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                             `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                        `json:"maxheads"`
+	Maxresolutionx        FlexInt64                        `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                        `json:"maxresolutiony"`
+	Memory                FlexInt                          `json:"memory"`
+	Memoryintfreekbs      FlexInt64                        `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                        `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                        `json:"memorytargetkbs"`
 	Name                  string                           `json:"name"`
-	Networkkbsread        int64                            `json:"networkkbsread"`
-	Networkkbswrite       int64                            `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                        `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                        `json:"networkkbswrite"`
 	Nic                   []Nic                            `json:"nic"`
 	Osdisplayname         string                           `json:"osdisplayname"`
 	Ostypeid              string                           `json:"ostypeid"`
 	Password              string                           `json:"password"`
-	Passwordenabled       bool                             `json:"passwordenabled"`
+	Passwordenabled       FlexBool                         `json:"passwordenabled"`
 	Pooltype              string                           `json:"pooltype"`
 	Project               string                           `json:"project"`
 	Projectid             string                           `json:"projectid"`
 	Publicip              string                           `json:"publicip"`
 	Publicipid            string                           `json:"publicipid"`
 	Readonlydetails       string                           `json:"readonlydetails"`
-	Receivedbytes         int64                            `json:"receivedbytes"`
-	Rootdeviceid          int64                            `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                        `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                        `json:"rootdeviceid"`
 	Rootdevicetype        string                           `json:"rootdevicetype"`
 	Securitygroup         []AttachIsoResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                            `json:"sentbytes"`
+	Sentbytes             FlexInt64                        `json:"sentbytes"`
 	Serviceofferingid     string                           `json:"serviceofferingid"`
 	Serviceofferingname   string                           `json:"serviceofferingname"`
 	Servicestate          string                           `json:"servicestate"`
@@ -288,12 +380,13 @@ type AttachIsoResponse struct {
 	Vgpu                  string                           `json:"vgpu"`
 	Vgpuprofileid         string                           `json:"vgpuprofileid"`
 	Vgpuprofilename       string                           `json:"vgpuprofilename"`
-	Videoram              int64                            `json:"videoram"`
+	Videoram              FlexInt64                        `json:"videoram"`
 	Vmtype                string                           `json:"vmtype"`
 	Vnfdetails            map[string]string                `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                        `json:"vnfnics"`
 	Zoneid                string                           `json:"zoneid"`
 	Zonename              string                           `json:"zonename"`
+	Extra                 map[string]json.RawMessage       `json:"-"`
 }
 
 type AttachIsoResponseSecuritygroup struct {
@@ -309,36 +402,54 @@ type AttachIsoResponseSecuritygroup struct {
 	Project             string                               `json:"project"`
 	Projectid           string                               `json:"projectid"`
 	Tags                []Tags                               `json:"tags"`
-	Virtualmachinecount int                                  `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                              `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                        `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage           `json:"-"`
+}
+
+func (r *AttachIsoResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias AttachIsoResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AttachIsoResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AttachIsoResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias AttachIsoResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AttachIsoResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AttachIsoResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias AttachIsoResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *AttachIsoResponse) UnmarshalJSON(b []byte) error {
@@ -365,7 +476,7 @@ func (r *AttachIsoResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias AttachIsoResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CopyIsoParams struct {
@@ -393,6 +504,47 @@ func (p *CopyIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the copyIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CopyIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("copyIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the copyIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CopyIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"destzoneid": 255, "id": 255, "sourcezoneid": 255},
+	}.validate("copyIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the copyIso API, before the request is signed
+func (p *CopyIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CopyIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CopyIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("copyIso", b, &p.p, map[string]paramDecoder{
+		"destzoneid":   decodeParam[string],
+		"destzoneids":  decodeParam[[]string],
+		"id":           decodeParam[string],
+		"sourcezoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CopyIsoParams) Clone() *CopyIsoParams {
+	return &CopyIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *CopyIsoParams) SetDestzoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -488,32 +640,39 @@ func (s *ISOService) NewCopyIsoParams(id string) *CopyIsoParams {
 
 // Copies an ISO from one zone to another.
 func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
-	resp, err := s.cs.newPostRequest("copyIso", p.toURLValues())
+	return s.CopyIsoWithContext(context.Background(), p)
+}
+
+// CopyIsoWithContext is like CopyIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "copyIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CopyIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("copyIso", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "copyIso", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("copyIso", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -521,66 +680,86 @@ func (s *ISOService) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CopyIso async job into r
+func (r *CopyIsoResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CopyIsoAsync starts the async job of CopyIso without waiting for it to finish, and returns a handle to the job
+func (s *ISOService) CopyIsoAsync(ctx context.Context, p *CopyIsoParams) (*Job, error) {
+	r, err := s.CopyIsoWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("copyIso", r.JobID), nil
+}
+
 type CopyIsoResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                string                     `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CopyIsoResponse) UnmarshalJSON(b []byte) error {
@@ -607,7 +786,7 @@ func (r *CopyIsoResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CopyIsoResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteIsoParams struct {
@@ -628,6 +807,45 @@ func (p *DeleteIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "zoneid": 255},
+	}.validate("deleteIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteIso API, before the request is signed
+func (p *DeleteIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteIso", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteIsoParams) Clone() *DeleteIsoParams {
+	return &DeleteIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -681,27 +899,39 @@ func (s *ISOService) NewDeleteIsoParams(id string) *DeleteIsoParams {
 
 // Deletes an ISO file.
 func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteIso", p.toURLValues())
+	return s.DeleteIsoWithContext(context.Background(), p)
+}
+
+// DeleteIsoWithContext is like DeleteIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteIso", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteIso", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteIso", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -709,11 +939,31 @@ func (s *ISOService) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteIso async job into r
+func (r *DeleteIsoResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteIsoAsync starts the async job of DeleteIso without waiting for it to finish, and returns a handle to the job
+func (s *ISOService) DeleteIsoAsync(ctx context.Context, p *DeleteIsoParams) (*Job, error) {
+	r, err := s.DeleteIsoWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteIso", r.JobID), nil
+}
+
 type DeleteIsoResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteIsoResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteIsoResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DetachIsoParams struct {
@@ -735,6 +985,45 @@ func (p *DetachIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the detachIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DetachIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("detachIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the detachIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DetachIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"virtualmachineid": 255},
+	}.validate("detachIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the detachIso API, before the request is signed
+func (p *DetachIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DetachIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DetachIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("detachIso", b, &p.p, map[string]paramDecoder{
+		"forced":           decodeParam[bool],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DetachIsoParams) Clone() *DetachIsoParams {
+	return &DetachIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *DetachIsoParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -788,32 +1077,39 @@ func (s *ISOService) NewDetachIsoParams(virtualmachineid string) *DetachIsoParam
 
 // Detaches any ISO file (if any) currently attached to  an Instance.
 func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
-	resp, err := s.cs.newPostRequest("detachIso", p.toURLValues())
+	return s.DetachIsoWithContext(context.Background(), p)
+}
+
+// DetachIsoWithContext is like DetachIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "detachIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DetachIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("detachIso", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "detachIso", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("detachIso", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -821,6 +1117,25 @@ func (s *ISOService) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DetachIso async job into r
+func (r *DetachIsoResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DetachIsoAsync starts the async job of DetachIso without waiting for it to finish, and returns a handle to the job
+func (s *ISOService) DetachIsoAsync(ctx context.Context, p *DetachIsoParams) (*Job, error) {
+	r, err := s.DetachIsoWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("detachIso", r.JobID), nil
+}
+
 type DetachIsoResponse struct {
 	Account               string                           `json:"account"`
 	Affinitygroup         []DetachIsoResponseAffinitygroup `json:"affinitygroup"`
@@ -832,32 +1147,32 @@ type DetachIsoResponse struct {
 	Backupofferingname    string                           `json:"backupofferingname"`
 	Bootmode              string                           `json:"bootmode"`
 	Boottype              string                           `json:"boottype"`
-	Cpunumber             int                              `json:"cpunumber"`
-	Cpuspeed              int                              `json:"cpuspeed"`
+	Cpunumber             FlexInt                          `json:"cpunumber"`
+	Cpuspeed              FlexInt                          `json:"cpuspeed"`
 	Cpuused               string                           `json:"cpuused"`
-	Created               string                           `json:"created"`
-	Deleteprotection      bool                             `json:"deleteprotection"`
+	Created               Time                             `json:"created"`
+	Deleteprotection      FlexBool                         `json:"deleteprotection"`
 	Details               map[string]string                `json:"details"`
-	Diskioread            int64                            `json:"diskioread"`
-	Diskiowrite           int64                            `json:"diskiowrite"`
-	Diskkbsread           int64                            `json:"diskkbsread"`
-	Diskkbswrite          int64                            `json:"diskkbswrite"`
+	Diskioread            FlexInt64                        `json:"diskioread"`
+	Diskiowrite           FlexInt64                        `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                        `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                        `json:"diskkbswrite"`
 	Diskofferingid        string                           `json:"diskofferingid"`
 	Diskofferingname      string                           `json:"diskofferingname"`
 	Displayname           string                           `json:"displayname"`
-	Displayvm             bool                             `json:"displayvm"`
+	Displayvm             FlexBool                         `json:"displayvm"`
 	Domain                string                           `json:"domain"`
 	Domainid              string                           `json:"domainid"`
 	Domainpath            string                           `json:"domainpath"`
-	Forvirtualnetwork     bool                             `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                         `json:"forvirtualnetwork"`
 	Gpucardid             string                           `json:"gpucardid"`
 	Gpucardname           string                           `json:"gpucardname"`
-	Gpucount              int                              `json:"gpucount"`
+	Gpucount              FlexInt                          `json:"gpucount"`
 	Group                 string                           `json:"group"`
 	Groupid               string                           `json:"groupid"`
 	Guestosid             string                           `json:"guestosid"`
-	Haenable              bool                             `json:"haenable"`
-	Hasannotations        bool                             `json:"hasannotations"`
+	Haenable              FlexBool                         `json:"haenable"`
+	Hasannotations        FlexBool                         `json:"hasannotations"`
 	Hostcontrolstate      string                           `json:"hostcontrolstate"`
 	Hostid                string                           `json:"hostid"`
 	Hostname              string                           `json:"hostname"`
@@ -866,43 +1181,43 @@ type DetachIsoResponse struct {
 	Id                    string                           `json:"id"`
 	Instancename          string                           `json:"instancename"`
 	Ipaddress             string                           `json:"ipaddress"`
-	Isdynamicallyscalable bool                             `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                         `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                           `json:"isodisplaytext"`
 	Isoid                 string                           `json:"isoid"`
 	Isoname               string                           `json:"isoname"`
 	JobID                 string                           `json:"jobid"`
-	Jobstatus             int                              `json:"jobstatus"`
+	Jobstatus             FlexInt                          `json:"jobstatus"`
 	Keypairs              string                           `json:"keypairs"`
-	Lastupdated           string                           `json:"lastupdated"`
-	Leaseduration         int                              `json:"leaseduration"`
+	Lastupdated           Time                             `json:"lastupdated"`
+	Leaseduration         FlexInt                          `json:"leaseduration"`
 	Leaseexpiryaction     string                           `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                           `json:"leaseexpirydate"`
-	Maxheads              int64                            `json:"maxheads"`
-	Maxresolutionx        int64                            `json:"maxresolutionx"`
-	Maxresolutiony        int64                            `json:"maxresolutiony"`
-	Memory                int                              `json:"memory"`
-	Memoryintfreekbs      int64                            `json:"memoryintfreekbs"`
-	Memorykbs             int64                            `json:"memorykbs"`
-	Memorytargetkbs       int64                            `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                             `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                        `json:"maxheads"`
+	Maxresolutionx        FlexInt64                        `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                        `json:"maxresolutiony"`
+	Memory                FlexInt                          `json:"memory"`
+	Memoryintfreekbs      FlexInt64                        `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                        `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                        `json:"memorytargetkbs"`
 	Name                  string                           `json:"name"`
-	Networkkbsread        int64                            `json:"networkkbsread"`
-	Networkkbswrite       int64                            `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                        `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                        `json:"networkkbswrite"`
 	Nic                   []Nic                            `json:"nic"`
 	Osdisplayname         string                           `json:"osdisplayname"`
 	Ostypeid              string                           `json:"ostypeid"`
 	Password              string                           `json:"password"`
-	Passwordenabled       bool                             `json:"passwordenabled"`
+	Passwordenabled       FlexBool                         `json:"passwordenabled"`
 	Pooltype              string                           `json:"pooltype"`
 	Project               string                           `json:"project"`
 	Projectid             string                           `json:"projectid"`
 	Publicip              string                           `json:"publicip"`
 	Publicipid            string                           `json:"publicipid"`
 	Readonlydetails       string                           `json:"readonlydetails"`
-	Receivedbytes         int64                            `json:"receivedbytes"`
-	Rootdeviceid          int64                            `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                        `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                        `json:"rootdeviceid"`
 	Rootdevicetype        string                           `json:"rootdevicetype"`
 	Securitygroup         []DetachIsoResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                            `json:"sentbytes"`
+	Sentbytes             FlexInt64                        `json:"sentbytes"`
 	Serviceofferingid     string                           `json:"serviceofferingid"`
 	Serviceofferingname   string                           `json:"serviceofferingname"`
 	Servicestate          string                           `json:"servicestate"`
@@ -923,12 +1238,13 @@ type DetachIsoResponse struct {
 	Vgpu                  string                           `json:"vgpu"`
 	Vgpuprofileid         string                           `json:"vgpuprofileid"`
 	Vgpuprofilename       string                           `json:"vgpuprofilename"`
-	Videoram              int64                            `json:"videoram"`
+	Videoram              FlexInt64                        `json:"videoram"`
 	Vmtype                string                           `json:"vmtype"`
 	Vnfdetails            map[string]string                `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                        `json:"vnfnics"`
 	Zoneid                string                           `json:"zoneid"`
 	Zonename              string                           `json:"zonename"`
+	Extra                 map[string]json.RawMessage       `json:"-"`
 }
 
 type DetachIsoResponseSecuritygroup struct {
@@ -944,36 +1260,54 @@ type DetachIsoResponseSecuritygroup struct {
 	Project             string                               `json:"project"`
 	Projectid           string                               `json:"projectid"`
 	Tags                []Tags                               `json:"tags"`
-	Virtualmachinecount int                                  `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                              `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                        `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage           `json:"-"`
+}
+
+func (r *DetachIsoResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias DetachIsoResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DetachIsoResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DetachIsoResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias DetachIsoResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DetachIsoResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DetachIsoResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias DetachIsoResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *DetachIsoResponse) UnmarshalJSON(b []byte) error {
@@ -1000,7 +1334,7 @@ func (r *DetachIsoResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DetachIsoResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExtractIsoParams struct {
@@ -1027,6 +1361,47 @@ func (p *ExtractIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the extractIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExtractIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("extractIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the extractIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExtractIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "mode"},
+		lengths:  map[string]int{"id": 255, "mode": 255, "url": 2048, "zoneid": 255},
+	}.validate("extractIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the extractIso API, before the request is signed
+func (p *ExtractIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExtractIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExtractIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("extractIso", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"mode":   decodeParam[string],
+		"url":    decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExtractIsoParams) Clone() *ExtractIsoParams {
+	return &ExtractIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *ExtractIsoParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1123,32 +1498,39 @@ func (s *ISOService) NewExtractIsoParams(id string, mode string) *ExtractIsoPara
 
 // Extracts an ISO
 func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
-	resp, err := s.cs.newPostRequest("extractIso", p.toURLValues())
+	return s.ExtractIsoWithContext(context.Background(), p)
+}
+
+// ExtractIsoWithContext is like ExtractIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "extractIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExtractIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("extractIso", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "extractIso", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("extractIso", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1156,23 +1538,48 @@ func (s *ISOService) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ExtractIso async job into r
+func (r *ExtractIsoResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ExtractIsoAsync starts the async job of ExtractIso without waiting for it to finish, and returns a handle to the job
+func (s *ISOService) ExtractIsoAsync(ctx context.Context, p *ExtractIsoParams) (*Job, error) {
+	r, err := s.ExtractIsoWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("extractIso", r.JobID), nil
+}
+
 type ExtractIsoResponse struct {
-	Accountid        string `json:"accountid"`
-	Created          string `json:"created"`
-	ExtractId        string `json:"extractId"`
-	ExtractMode      string `json:"extractMode"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Resultstring     string `json:"resultstring"`
-	State            string `json:"state"`
-	Status           string `json:"status"`
-	Storagetype      string `json:"storagetype"`
-	Uploadpercentage int    `json:"uploadpercentage"`
-	Url              string `json:"url"`
-	Zoneid           string `json:"zoneid"`
-	Zonename         string `json:"zonename"`
+	Accountid        string                     `json:"accountid"`
+	Created          Time                       `json:"created"`
+	ExtractId        string                     `json:"extractId"`
+	ExtractMode      string                     `json:"extractMode"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Resultstring     string                     `json:"resultstring"`
+	State            string                     `json:"state"`
+	Status           string                     `json:"status"`
+	Storagetype      string                     `json:"storagetype"`
+	Uploadpercentage FlexInt                    `json:"uploadpercentage"`
+	Url              string                     `json:"url"`
+	Zoneid           string                     `json:"zoneid"`
+	Zonename         string                     `json:"zonename"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExtractIsoResponse) UnmarshalJSON(b []byte) error {
+	type alias ExtractIsoResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetUploadParamsForIsoParams struct {
@@ -1230,6 +1637,56 @@ func (p *GetUploadParamsForIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getUploadParamsForIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetUploadParamsForIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getUploadParamsForIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getUploadParamsForIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetUploadParamsForIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"format", "name", "zoneid"},
+		lengths:  map[string]int{"account": 255, "checksum": 255, "displaytext": 4096, "domainid": 255, "format": 255, "name": 255, "ostypeid": 255, "projectid": 255, "zoneid": 255},
+	}.validate("getUploadParamsForIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getUploadParamsForIso API, before the request is signed
+func (p *GetUploadParamsForIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetUploadParamsForIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetUploadParamsForIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getUploadParamsForIso", b, &p.p, map[string]paramDecoder{
+		"account":       decodeParam[string],
+		"bootable":      decodeParam[bool],
+		"checksum":      decodeParam[string],
+		"displaytext":   decodeParam[string],
+		"domainid":      decodeParam[string],
+		"format":        decodeParam[string],
+		"isextractable": decodeParam[bool],
+		"isfeatured":    decodeParam[bool],
+		"ispublic":      decodeParam[bool],
+		"name":          decodeParam[string],
+		"ostypeid":      decodeParam[string],
+		"projectid":     decodeParam[string],
+		"zoneid":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetUploadParamsForIsoParams) Clone() *GetUploadParamsForIsoParams {
+	return &GetUploadParamsForIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *GetUploadParamsForIsoParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1516,13 +1973,22 @@ func (s *ISOService) NewGetUploadParamsForIsoParams(format string, name string,
 
 // Upload an existing ISO into the CloudStack cloud.
 func (s *ISOService) GetUploadParamsForIso(p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForIso", p.toURLValues())
+	return s.GetUploadParamsForIsoWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForIsoWithContext is like GetUploadParamsForIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) GetUploadParamsForIsoWithContext(ctx context.Context, p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getUploadParamsForIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetUploadParamsForIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getUploadParamsForIso", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1530,13 +1996,19 @@ func (s *ISOService) GetUploadParamsForIso(p *GetUploadParamsForIsoParams) (*Get
 }
 
 type GetUploadParamsForIsoResponse struct {
-	Expires   string `json:"expires"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Metadata  string `json:"metadata"`
-	PostURL   string `json:"postURL"`
-	Signature string `json:"signature"`
+	Expires   Time                       `json:"expires"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Metadata  string                     `json:"metadata"`
+	PostURL   string                     `json:"postURL"`
+	Signature string                     `json:"signature"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetUploadParamsForIsoResponse) UnmarshalJSON(b []byte) error {
+	type alias GetUploadParamsForIsoResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListIsoPermissionsParams struct {
@@ -1554,6 +2026,44 @@ func (p *ListIsoPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listIsoPermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListIsoPermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listIsoPermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listIsoPermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListIsoPermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("listIsoPermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listIsoPermissions API, before the request is signed
+func (p *ListIsoPermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListIsoPermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListIsoPermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listIsoPermissions", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListIsoPermissionsParams) Clone() *ListIsoPermissionsParams {
+	return &ListIsoPermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListIsoPermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1586,6 +2096,11 @@ func (s *ISOService) NewListIsoPermissionsParams(id string) *ListIsoPermissionsP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
+	return s.GetIsoPermissionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIsoPermissionByIDWithContext is like GetIsoPermissionByID, but honours the cancellation and deadline of ctx
+func (s *ISOService) GetIsoPermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IsoPermission, int, error) {
 	p := &ListIsoPermissionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1597,11 +2112,10 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 		}
 	}
 
-	l, err := s.ListIsoPermissions(p)
+	l, err := s.ListIsoPermissionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1619,13 +2133,22 @@ func (s *ISOService) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPe
 
 // List ISO visibility and all accounts that have permissions to view this ISO.
 func (s *ISOService) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listIsoPermissions", p.toURLValues())
+	return s.ListIsoPermissionsWithContext(context.Background(), p)
+}
+
+// ListIsoPermissionsWithContext is like ListIsoPermissions, but honours the cancellation and deadline of ctx
+func (s *ISOService) ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listIsoPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListIsoPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listIsoPermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1638,13 +2161,19 @@ type ListIsoPermissionsResponse struct {
 }
 
 type IsoPermission struct {
-	Account    []string `json:"account"`
-	Domainid   string   `json:"domainid"`
-	Id         string   `json:"id"`
-	Ispublic   bool     `json:"ispublic"`
-	JobID      string   `json:"jobid"`
-	Jobstatus  int      `json:"jobstatus"`
-	Projectids []string `json:"projectids"`
+	Account    []string                   `json:"account"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ispublic   FlexBool                   `json:"ispublic"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Projectids []string                   `json:"projectids"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *IsoPermission) UnmarshalJSON(b []byte) error {
+	type alias IsoPermission
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListIsosParams struct {
@@ -1670,7 +2199,7 @@ func (p *ListIsosParams) toURLValues() url.Values {
 		u.Set("domainid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -1745,6 +2274,66 @@ func (p *ListIsosParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listIsos API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListIsosParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listIsos", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listIsos API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListIsosParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "arch": 255, "domainid": 255, "hypervisor": 255, "id": 255, "imagestoreid": 255, "isofilter": 255, "keyword": 255, "name": 255, "oscategoryid": 255, "projectid": 255, "storageid": 255, "zoneid": 255},
+	}.validate("listIsos", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listIsos API, before the request is signed
+func (p *ListIsosParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListIsosParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListIsosParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listIsos", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"arch":         decodeParam[string],
+		"bootable":     decodeParam[bool],
+		"domainid":     decodeParam[string],
+		"hypervisor":   decodeParam[HypervisorType],
+		"id":           decodeParam[string],
+		"imagestoreid": decodeParam[string],
+		"isofilter":    decodeParam[string],
+		"ispublic":     decodeParam[bool],
+		"isready":      decodeParam[bool],
+		"isrecursive":  decodeParam[bool],
+		"keyword":      decodeParam[string],
+		"listall":      decodeParam[bool],
+		"name":         decodeParam[string],
+		"oscategoryid": decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"projectid":    decodeParam[string],
+		"showicon":     decodeParam[bool],
+		"showremoved":  decodeParam[bool],
+		"showunique":   decodeParam[bool],
+		"storageid":    decodeParam[string],
+		"tags":         decodeParam[map[string]string],
+		"zoneid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListIsosParams) Clone() *ListIsosParams {
+	return &ListIsosParams{p: cloneParams(p.p)}
+}
+
 func (p *ListIsosParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1829,7 +2418,7 @@ func (p *ListIsosParams) GetDomainid() (string, bool) {
 	return value, ok
 }
 
-func (p *ListIsosParams) SetHypervisor(v string) {
+func (p *ListIsosParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1842,11 +2431,11 @@ func (p *ListIsosParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListIsosParams) GetHypervisor() (string, bool) {
+func (p *ListIsosParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -2259,6 +2848,11 @@ func (s *ISOService) NewListIsosParams() *ListIsosParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetIsoIDWithContext(context.Background(), name, isofilter, zoneid, opts...)
+}
+
+// GetIsoIDWithContext is like GetIsoID, but honours the cancellation and deadline of ctx
+func (s *ISOService) GetIsoIDWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListIsosParams{}
 	p.p = make(map[string]interface{})
 
@@ -2272,7 +2866,7 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 		}
 	}
 
-	l, err := s.ListIsos(p)
+	l, err := s.ListIsosWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2297,12 +2891,17 @@ func (s *ISOService) GetIsoID(name string, isofilter string, zoneid string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoByName(name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error) {
-	id, count, err := s.GetIsoID(name, isofilter, zoneid, opts...)
+	return s.GetIsoByNameWithContext(context.Background(), name, isofilter, zoneid, opts...)
+}
+
+// GetIsoByNameWithContext is like GetIsoByName, but honours the cancellation and deadline of ctx
+func (s *ISOService) GetIsoByNameWithContext(ctx context.Context, name string, isofilter string, zoneid string, opts ...OptionFunc) (*Iso, int, error) {
+	id, count, err := s.GetIsoIDWithContext(ctx, name, isofilter, zoneid, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetIsoByID(id, append(opts, WithZone(zoneid))...)
+	r, count, err := s.GetIsoByIDWithContext(ctx, id, append(opts, WithZone(zoneid))...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2311,6 +2910,11 @@ func (s *ISOService) GetIsoByName(name string, isofilter string, zoneid string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error) {
+	return s.GetIsoByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIsoByIDWithContext is like GetIsoByID, but honours the cancellation and deadline of ctx
+func (s *ISOService) GetIsoByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Iso, int, error) {
 	p := &ListIsosParams{}
 	p.p = make(map[string]interface{})
 
@@ -2322,11 +2926,10 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 		}
 	}
 
-	l, err := s.ListIsos(p)
+	l, err := s.ListIsosWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2344,84 +2947,122 @@ func (s *ISOService) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error
 
 // Lists all available ISO files.
 func (s *ISOService) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
-	resp, err := s.cs.newRequest("listIsos", p.toURLValues())
+	return s.ListIsosWithContext(context.Background(), p)
+}
+
+// ListIsosWithContext is like ListIsos, but honours the cancellation and deadline of ctx
+func (s *ISOService) ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listIsos", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListIsosResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listIsos", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListIsosAll returns all Isos matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ISOService) ListIsosAll(p *ListIsosParams, opts ...PageOption) ([]*Iso, error) {
+	return s.ListIsosAllWithContext(context.Background(), p, opts...)
+}
+
+// ListIsosAllWithContext is like ListIsosAll, but honours the cancellation and deadline of ctx
+func (s *ISOService) ListIsosAllWithContext(ctx context.Context, p *ListIsosParams, opts ...PageOption) ([]*Iso, error) {
+	return collectPages(s.ListIsosIterWithContext(ctx, p, opts...))
+}
+
+// ListIsosIter returns an iterator over all Isos matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ISOService) ListIsosIter(p *ListIsosParams, opts ...PageOption) iter.Seq2[*Iso, error] {
+	return s.ListIsosIterWithContext(context.Background(), p, opts...)
+}
+
+// ListIsosIterWithContext is like ListIsosIter, but honours the cancellation and deadline of ctx
+func (s *ISOService) ListIsosIterWithContext(ctx context.Context, p *ListIsosParams, opts ...PageOption) iter.Seq2[*Iso, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Iso, int, error) {
+		l, err := s.ListIsosWithContext(ctx, &ListIsosParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Isos, l.Count, nil
+	}, opts...)
+}
+
 type ListIsosResponse struct {
 	Count int    `json:"count"`
 	Isos  []*Iso `json:"iso"`
 }
 
 type Iso struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                string                     `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *Iso) UnmarshalJSON(b []byte) error {
@@ -2448,7 +3089,7 @@ func (r *Iso) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias Iso
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterIsoParams struct {
@@ -2524,6 +3165,61 @@ func (p *RegisterIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "name", "url", "zoneid"},
+		lengths:  map[string]int{"account": 255, "arch": 255, "checksum": 255, "displaytext": 4096, "domainid": 255, "imagestoreuuid": 255, "name": 255, "ostypeid": 255, "projectid": 255, "url": 2048, "zoneid": 255},
+	}.validate("registerIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerIso API, before the request is signed
+func (p *RegisterIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerIso", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"arch":                  decodeParam[string],
+		"bootable":              decodeParam[bool],
+		"checksum":              decodeParam[string],
+		"directdownload":        decodeParam[bool],
+		"displaytext":           decodeParam[string],
+		"domainid":              decodeParam[string],
+		"imagestoreuuid":        decodeParam[string],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isextractable":         decodeParam[bool],
+		"isfeatured":            decodeParam[bool],
+		"ispublic":              decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"projectid":             decodeParam[string],
+		"url":                   decodeParam[string],
+		"zoneid":                decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterIsoParams) Clone() *RegisterIsoParams {
+	return &RegisterIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterIsoParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2916,7 +3612,16 @@ func (s *ISOService) NewRegisterIsoParams(displaytext string, name string, url s
 
 // Registers an existing ISO into the CloudStack Cloud.
 func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, error) {
-	resp, err := s.cs.newPostRequest("registerIso", p.toURLValues())
+	return s.RegisterIsoWithContext(context.Background(), p)
+}
+
+// RegisterIsoWithContext is like RegisterIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2926,7 +3631,7 @@ func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, er
 	}
 
 	var r RegisterIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerIso", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2934,65 +3639,66 @@ func (s *ISOService) RegisterIso(p *RegisterIsoParams) (*RegisterIsoResponse, er
 }
 
 type RegisterIsoResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                string                     `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RegisterIsoResponse) UnmarshalJSON(b []byte) error {
@@ -3019,7 +3725,7 @@ func (r *RegisterIsoResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RegisterIsoResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateIsoParams struct {
@@ -3094,6 +3800,59 @@ func (p *UpdateIsoParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateIso API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateIsoParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateIso", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateIso API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateIsoParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"arch": 255, "displaytext": 4096, "format": 255, "id": 255, "name": 255, "ostypeid": 255},
+	}.validate("updateIso", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateIso API, before the request is signed
+func (p *UpdateIsoParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateIsoParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateIsoParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateIso", b, &p.p, map[string]paramDecoder{
+		"arch":                  decodeParam[string],
+		"bootable":              decodeParam[bool],
+		"cleanupdetails":        decodeParam[bool],
+		"details":               decodeParam[map[string]string],
+		"displaytext":           decodeParam[string],
+		"forceupdateostype":     decodeParam[bool],
+		"format":                decodeParam[string],
+		"id":                    decodeParam[string],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isrouting":             decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"requireshvm":           decodeParam[bool],
+		"sortkey":               decodeParam[int],
+		"sshkeyenabled":         decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateIsoParams) Clone() *UpdateIsoParams {
+	return &UpdateIsoParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateIsoParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3441,13 +4200,22 @@ func (s *ISOService) NewUpdateIsoParams(id string) *UpdateIsoParams {
 
 // Updates an ISO file.
 func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
-	resp, err := s.cs.newPostRequest("updateIso", p.toURLValues())
+	return s.UpdateIsoWithContext(context.Background(), p)
+}
+
+// UpdateIsoWithContext is like UpdateIso, but honours the cancellation and deadline of ctx
+func (s *ISOService) UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateIso", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateIsoResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateIso", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3455,65 +4223,66 @@ func (s *ISOService) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
 }
 
 type UpdateIsoResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                string                     `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateIsoResponse) UnmarshalJSON(b []byte) error {
@@ -3540,7 +4309,7 @@ func (r *UpdateIsoResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateIsoResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateIsoPermissionsParams struct {
@@ -3581,6 +4350,50 @@ func (p *UpdateIsoPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateIsoPermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateIsoPermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateIsoPermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateIsoPermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateIsoPermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "op": 255},
+	}.validate("updateIsoPermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateIsoPermissions API, before the request is signed
+func (p *UpdateIsoPermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateIsoPermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateIsoPermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateIsoPermissions", b, &p.p, map[string]paramDecoder{
+		"accounts":      decodeParam[[]string],
+		"id":            decodeParam[string],
+		"isextractable": decodeParam[bool],
+		"isfeatured":    decodeParam[bool],
+		"ispublic":      decodeParam[bool],
+		"op":            decodeParam[string],
+		"projectids":    decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateIsoPermissionsParams) Clone() *UpdateIsoPermissionsParams {
+	return &UpdateIsoPermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateIsoPermissionsParams) SetAccounts(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3739,13 +4552,22 @@ func (s *ISOService) NewUpdateIsoPermissionsParams(id string) *UpdateIsoPermissi
 
 // Updates ISO permissions
 func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
-	resp, err := s.cs.newPostRequest("updateIsoPermissions", p.toURLValues())
+	return s.UpdateIsoPermissionsWithContext(context.Background(), p)
+}
+
+// UpdateIsoPermissionsWithContext is like UpdateIsoPermissions, but honours the cancellation and deadline of ctx
+func (s *ISOService) UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateIsoPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateIsoPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateIsoPermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3753,10 +4575,11 @@ func (s *ISOService) UpdateIsoPermissions(p *UpdateIsoPermissionsParams) (*Updat
 }
 
 type UpdateIsoPermissionsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateIsoPermissionsResponse) UnmarshalJSON(b []byte) error {
@@ -3783,5 +4606,5 @@ func (r *UpdateIsoPermissionsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateIsoPermissionsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
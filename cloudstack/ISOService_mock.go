@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockISOServiceIfaceMockRecorder) AttachIso(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachIso", reflect.TypeOf((*MockISOServiceIface)(nil).AttachIso), p)
 }
 
+// AttachIsoAsync mocks base method.
+func (m *MockISOServiceIface) AttachIsoAsync(ctx context.Context, p *AttachIsoParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachIsoAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachIsoAsync indicates an expected call of AttachIsoAsync.
+func (mr *MockISOServiceIfaceMockRecorder) AttachIsoAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachIsoAsync", reflect.TypeOf((*MockISOServiceIface)(nil).AttachIsoAsync), ctx, p)
+}
+
+// AttachIsoWithContext mocks base method.
+func (m *MockISOServiceIface) AttachIsoWithContext(ctx context.Context, p *AttachIsoParams) (*AttachIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*AttachIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachIsoWithContext indicates an expected call of AttachIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) AttachIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).AttachIsoWithContext), ctx, p)
+}
+
 // CopyIso mocks base method.
 func (m *MockISOServiceIface) CopyIso(p *CopyIsoParams) (*CopyIsoResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockISOServiceIfaceMockRecorder) CopyIso(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyIso", reflect.TypeOf((*MockISOServiceIface)(nil).CopyIso), p)
 }
 
+// CopyIsoAsync mocks base method.
+func (m *MockISOServiceIface) CopyIsoAsync(ctx context.Context, p *CopyIsoParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyIsoAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyIsoAsync indicates an expected call of CopyIsoAsync.
+func (mr *MockISOServiceIfaceMockRecorder) CopyIsoAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyIsoAsync", reflect.TypeOf((*MockISOServiceIface)(nil).CopyIsoAsync), ctx, p)
+}
+
+// CopyIsoWithContext mocks base method.
+func (m *MockISOServiceIface) CopyIsoWithContext(ctx context.Context, p *CopyIsoParams) (*CopyIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*CopyIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyIsoWithContext indicates an expected call of CopyIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) CopyIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).CopyIsoWithContext), ctx, p)
+}
+
 // DeleteIso mocks base method.
 func (m *MockISOServiceIface) DeleteIso(p *DeleteIsoParams) (*DeleteIsoResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockISOServiceIfaceMockRecorder) DeleteIso(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIso", reflect.TypeOf((*MockISOServiceIface)(nil).DeleteIso), p)
 }
 
+// DeleteIsoAsync mocks base method.
+func (m *MockISOServiceIface) DeleteIsoAsync(ctx context.Context, p *DeleteIsoParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIsoAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIsoAsync indicates an expected call of DeleteIsoAsync.
+func (mr *MockISOServiceIfaceMockRecorder) DeleteIsoAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIsoAsync", reflect.TypeOf((*MockISOServiceIface)(nil).DeleteIsoAsync), ctx, p)
+}
+
+// DeleteIsoWithContext mocks base method.
+func (m *MockISOServiceIface) DeleteIsoWithContext(ctx context.Context, p *DeleteIsoParams) (*DeleteIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIsoWithContext indicates an expected call of DeleteIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) DeleteIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).DeleteIsoWithContext), ctx, p)
+}
+
 // DetachIso mocks base method.
 func (m *MockISOServiceIface) DetachIso(p *DetachIsoParams) (*DetachIsoResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockISOServiceIfaceMockRecorder) DetachIso(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachIso", reflect.TypeOf((*MockISOServiceIface)(nil).DetachIso), p)
 }
 
+// DetachIsoAsync mocks base method.
+func (m *MockISOServiceIface) DetachIsoAsync(ctx context.Context, p *DetachIsoParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachIsoAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachIsoAsync indicates an expected call of DetachIsoAsync.
+func (mr *MockISOServiceIfaceMockRecorder) DetachIsoAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachIsoAsync", reflect.TypeOf((*MockISOServiceIface)(nil).DetachIsoAsync), ctx, p)
+}
+
+// DetachIsoWithContext mocks base method.
+func (m *MockISOServiceIface) DetachIsoWithContext(ctx context.Context, p *DetachIsoParams) (*DetachIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*DetachIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachIsoWithContext indicates an expected call of DetachIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) DetachIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).DetachIsoWithContext), ctx, p)
+}
+
 // ExtractIso mocks base method.
 func (m *MockISOServiceIface) ExtractIso(p *ExtractIsoParams) (*ExtractIsoResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockISOServiceIfaceMockRecorder) ExtractIso(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractIso", reflect.TypeOf((*MockISOServiceIface)(nil).ExtractIso), p)
 }
 
+// ExtractIsoAsync mocks base method.
+func (m *MockISOServiceIface) ExtractIsoAsync(ctx context.Context, p *ExtractIsoParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractIsoAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractIsoAsync indicates an expected call of ExtractIsoAsync.
+func (mr *MockISOServiceIfaceMockRecorder) ExtractIsoAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractIsoAsync", reflect.TypeOf((*MockISOServiceIface)(nil).ExtractIsoAsync), ctx, p)
+}
+
+// ExtractIsoWithContext mocks base method.
+func (m *MockISOServiceIface) ExtractIsoWithContext(ctx context.Context, p *ExtractIsoParams) (*ExtractIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExtractIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractIsoWithContext indicates an expected call of ExtractIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) ExtractIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).ExtractIsoWithContext), ctx, p)
+}
+
 // GetIsoByID mocks base method.
 func (m *MockISOServiceIface) GetIsoByID(id string, opts ...OptionFunc) (*Iso, int, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +306,27 @@ func (mr *MockISOServiceIfaceMockRecorder) GetIsoByID(id any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoByID", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoByID), varargs...)
 }
 
+// GetIsoByIDWithContext mocks base method.
+func (m *MockISOServiceIface) GetIsoByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Iso, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIsoByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Iso)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIsoByIDWithContext indicates an expected call of GetIsoByIDWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) GetIsoByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoByIDWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoByIDWithContext), varargs...)
+}
+
 // GetIsoByName mocks base method.
 func (m *MockISOServiceIface) GetIsoByName(name, isofilter, zoneid string, opts ...OptionFunc) (*Iso, int, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +348,27 @@ func (mr *MockISOServiceIfaceMockRecorder) GetIsoByName(name, isofilter, zoneid
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoByName", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoByName), varargs...)
 }
 
+// GetIsoByNameWithContext mocks base method.
+func (m *MockISOServiceIface) GetIsoByNameWithContext(ctx context.Context, name, isofilter, zoneid string, opts ...OptionFunc) (*Iso, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, isofilter, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIsoByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Iso)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIsoByNameWithContext indicates an expected call of GetIsoByNameWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) GetIsoByNameWithContext(ctx, name, isofilter, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, isofilter, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoByNameWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoByNameWithContext), varargs...)
+}
+
 // GetIsoID mocks base method.
 func (m *MockISOServiceIface) GetIsoID(name, isofilter, zoneid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +390,27 @@ func (mr *MockISOServiceIfaceMockRecorder) GetIsoID(name, isofilter, zoneid any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoID", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoID), varargs...)
 }
 
+// GetIsoIDWithContext mocks base method.
+func (m *MockISOServiceIface) GetIsoIDWithContext(ctx context.Context, name, isofilter, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, isofilter, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIsoIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIsoIDWithContext indicates an expected call of GetIsoIDWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) GetIsoIDWithContext(ctx, name, isofilter, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, isofilter, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoIDWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoIDWithContext), varargs...)
+}
+
 // GetIsoPermissionByID mocks base method.
 func (m *MockISOServiceIface) GetIsoPermissionByID(id string, opts ...OptionFunc) (*IsoPermission, int, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +432,27 @@ func (mr *MockISOServiceIfaceMockRecorder) GetIsoPermissionByID(id any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoPermissionByID", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoPermissionByID), varargs...)
 }
 
+// GetIsoPermissionByIDWithContext mocks base method.
+func (m *MockISOServiceIface) GetIsoPermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IsoPermission, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIsoPermissionByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*IsoPermission)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIsoPermissionByIDWithContext indicates an expected call of GetIsoPermissionByIDWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) GetIsoPermissionByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIsoPermissionByIDWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).GetIsoPermissionByIDWithContext), varargs...)
+}
+
 // GetUploadParamsForIso mocks base method.
 func (m *MockISOServiceIface) GetUploadParamsForIso(p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +468,21 @@ func (mr *MockISOServiceIfaceMockRecorder) GetUploadParamsForIso(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForIso", reflect.TypeOf((*MockISOServiceIface)(nil).GetUploadParamsForIso), p)
 }
 
+// GetUploadParamsForIsoWithContext mocks base method.
+func (m *MockISOServiceIface) GetUploadParamsForIsoWithContext(ctx context.Context, p *GetUploadParamsForIsoParams) (*GetUploadParamsForIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadParamsForIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetUploadParamsForIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadParamsForIsoWithContext indicates an expected call of GetUploadParamsForIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) GetUploadParamsForIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).GetUploadParamsForIsoWithContext), ctx, p)
+}
+
 // ListIsoPermissions mocks base method.
 func (m *MockISOServiceIface) ListIsoPermissions(p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +498,21 @@ func (mr *MockISOServiceIfaceMockRecorder) ListIsoPermissions(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsoPermissions", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsoPermissions), p)
 }
 
+// ListIsoPermissionsWithContext mocks base method.
+func (m *MockISOServiceIface) ListIsoPermissionsWithContext(ctx context.Context, p *ListIsoPermissionsParams) (*ListIsoPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIsoPermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListIsoPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIsoPermissionsWithContext indicates an expected call of ListIsoPermissionsWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) ListIsoPermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsoPermissionsWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsoPermissionsWithContext), ctx, p)
+}
+
 // ListIsos mocks base method.
 func (m *MockISOServiceIface) ListIsos(p *ListIsosParams) (*ListIsosResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +528,99 @@ func (mr *MockISOServiceIfaceMockRecorder) ListIsos(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsos", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsos), p)
 }
 
+// ListIsosAll mocks base method.
+func (m *MockISOServiceIface) ListIsosAll(p *ListIsosParams, opts ...PageOption) ([]*Iso, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIsosAll", varargs...)
+	ret0, _ := ret[0].([]*Iso)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIsosAll indicates an expected call of ListIsosAll.
+func (mr *MockISOServiceIfaceMockRecorder) ListIsosAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsosAll", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsosAll), varargs...)
+}
+
+// ListIsosAllWithContext mocks base method.
+func (m *MockISOServiceIface) ListIsosAllWithContext(ctx context.Context, p *ListIsosParams, opts ...PageOption) ([]*Iso, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIsosAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Iso)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIsosAllWithContext indicates an expected call of ListIsosAllWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) ListIsosAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsosAllWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsosAllWithContext), varargs...)
+}
+
+// ListIsosIter mocks base method.
+func (m *MockISOServiceIface) ListIsosIter(p *ListIsosParams, opts ...PageOption) iter.Seq2[*Iso, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIsosIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Iso, error])
+	return ret0
+}
+
+// ListIsosIter indicates an expected call of ListIsosIter.
+func (mr *MockISOServiceIfaceMockRecorder) ListIsosIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsosIter", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsosIter), varargs...)
+}
+
+// ListIsosIterWithContext mocks base method.
+func (m *MockISOServiceIface) ListIsosIterWithContext(ctx context.Context, p *ListIsosParams, opts ...PageOption) iter.Seq2[*Iso, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIsosIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Iso, error])
+	return ret0
+}
+
+// ListIsosIterWithContext indicates an expected call of ListIsosIterWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) ListIsosIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsosIterWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsosIterWithContext), varargs...)
+}
+
+// ListIsosWithContext mocks base method.
+func (m *MockISOServiceIface) ListIsosWithContext(ctx context.Context, p *ListIsosParams) (*ListIsosResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIsosWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListIsosResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIsosWithContext indicates an expected call of ListIsosWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) ListIsosWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIsosWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).ListIsosWithContext), ctx, p)
+}
+
 // NewAttachIsoParams mocks base method.
 func (m *MockISOServiceIface) NewAttachIsoParams(id, virtualmachineid string) *AttachIsoParams {
 	m.ctrl.T.Helper()
@@ -431,6 +790,21 @@ func (mr *MockISOServiceIfaceMockRecorder) RegisterIso(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterIso", reflect.TypeOf((*MockISOServiceIface)(nil).RegisterIso), p)
 }
 
+// RegisterIsoWithContext mocks base method.
+func (m *MockISOServiceIface) RegisterIsoWithContext(ctx context.Context, p *RegisterIsoParams) (*RegisterIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterIsoWithContext indicates an expected call of RegisterIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) RegisterIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).RegisterIsoWithContext), ctx, p)
+}
+
 // UpdateIso mocks base method.
 func (m *MockISOServiceIface) UpdateIso(p *UpdateIsoParams) (*UpdateIsoResponse, error) {
 	m.ctrl.T.Helper()
@@ -460,3 +834,33 @@ func (mr *MockISOServiceIfaceMockRecorder) UpdateIsoPermissions(p any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsoPermissions", reflect.TypeOf((*MockISOServiceIface)(nil).UpdateIsoPermissions), p)
 }
+
+// UpdateIsoPermissionsWithContext mocks base method.
+func (m *MockISOServiceIface) UpdateIsoPermissionsWithContext(ctx context.Context, p *UpdateIsoPermissionsParams) (*UpdateIsoPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIsoPermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateIsoPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIsoPermissionsWithContext indicates an expected call of UpdateIsoPermissionsWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) UpdateIsoPermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsoPermissionsWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).UpdateIsoPermissionsWithContext), ctx, p)
+}
+
+// UpdateIsoWithContext mocks base method.
+func (m *MockISOServiceIface) UpdateIsoWithContext(ctx context.Context, p *UpdateIsoParams) (*UpdateIsoResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIsoWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateIsoResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIsoWithContext indicates an expected call of UpdateIsoWithContext.
+func (mr *MockISOServiceIfaceMockRecorder) UpdateIsoWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIsoWithContext", reflect.TypeOf((*MockISOServiceIface)(nil).UpdateIsoWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,35 +31,68 @@ import (
 
 type ImageStoreServiceIface interface {
 	AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error)
+	AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error)
 	NewAddImageStoreParams(provider string) *AddImageStoreParams
 	AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error)
+	AddImageStoreS3WithContext(ctx context.Context, p *AddImageStoreS3Params) (*AddImageStoreS3Response, error)
 	NewAddImageStoreS3Params(accesskey string, bucket string, endpoint string, secretkey string) *AddImageStoreS3Params
 	CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
+	CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error)
 	NewCreateSecondaryStagingStoreParams(url string) *CreateSecondaryStagingStoreParams
 	DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
+	DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error)
 	NewDeleteImageStoreParams(id string) *DeleteImageStoreParams
 	DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
+	DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error)
 	NewDeleteSecondaryStagingStoreParams(id string) *DeleteSecondaryStagingStoreParams
 	ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error)
+	ListImageStoresAll(p *ListImageStoresParams, opts ...PageOption) ([]*ImageStore, error)
+	ListImageStoresAllWithContext(ctx context.Context, p *ListImageStoresParams, opts ...PageOption) ([]*ImageStore, error)
+	ListImageStoresIter(p *ListImageStoresParams, opts ...PageOption) iter.Seq2[*ImageStore, error]
+	ListImageStoresIterWithContext(ctx context.Context, p *ListImageStoresParams, opts ...PageOption) iter.Seq2[*ImageStore, error]
 	NewListImageStoresParams() *ListImageStoresParams
 	GetImageStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetImageStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ImageStore, int, error)
 	GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error)
+	GetImageStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStore, int, error)
 	ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error)
+	ListSecondaryStagingStoresAll(p *ListSecondaryStagingStoresParams, opts ...PageOption) ([]*SecondaryStagingStore, error)
+	ListSecondaryStagingStoresAllWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams, opts ...PageOption) ([]*SecondaryStagingStore, error)
+	ListSecondaryStagingStoresIter(p *ListSecondaryStagingStoresParams, opts ...PageOption) iter.Seq2[*SecondaryStagingStore, error]
+	ListSecondaryStagingStoresIterWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams, opts ...PageOption) iter.Seq2[*SecondaryStagingStore, error]
 	NewListSecondaryStagingStoresParams() *ListSecondaryStagingStoresParams
 	GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error)
+	GetSecondaryStagingStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
 	GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
+	GetSecondaryStagingStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error)
 	MigrateResourceToAnotherSecondaryStorage(p *MigrateResourceToAnotherSecondaryStorageParams) (*MigrateResourceToAnotherSecondaryStorageResponse, error)
+	MigrateResourceToAnotherSecondaryStorageWithContext(ctx context.Context, p *MigrateResourceToAnotherSecondaryStorageParams) (*MigrateResourceToAnotherSecondaryStorageResponse, error)
+	MigrateResourceToAnotherSecondaryStorageAsync(ctx context.Context, p *MigrateResourceToAnotherSecondaryStorageParams) (*Job, error)
 	NewMigrateResourceToAnotherSecondaryStorageParams(destpool string, srcpool string) *MigrateResourceToAnotherSecondaryStorageParams
 	UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error)
+	UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error)
 	NewUpdateCloudToUseObjectStoreParams(provider string) *UpdateCloudToUseObjectStoreParams
 	ListImageStoreObjects(p *ListImageStoreObjectsParams) (*ListImageStoreObjectsResponse, error)
+	ListImageStoreObjectsWithContext(ctx context.Context, p *ListImageStoreObjectsParams) (*ListImageStoreObjectsResponse, error)
+	ListImageStoreObjectsAll(p *ListImageStoreObjectsParams, opts ...PageOption) ([]*ImageStoreObject, error)
+	ListImageStoreObjectsAllWithContext(ctx context.Context, p *ListImageStoreObjectsParams, opts ...PageOption) ([]*ImageStoreObject, error)
+	ListImageStoreObjectsIter(p *ListImageStoreObjectsParams, opts ...PageOption) iter.Seq2[*ImageStoreObject, error]
+	ListImageStoreObjectsIterWithContext(ctx context.Context, p *ListImageStoreObjectsParams, opts ...PageOption) iter.Seq2[*ImageStoreObject, error]
 	NewListImageStoreObjectsParams(id string) *ListImageStoreObjectsParams
 	GetImageStoreObjectByID(id string, opts ...OptionFunc) (*ImageStoreObject, int, error)
+	GetImageStoreObjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStoreObject, int, error)
 	UpdateImageStore(p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error)
+	UpdateImageStoreWithContext(ctx context.Context, p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error)
 	NewUpdateImageStoreParams(id string) *UpdateImageStoreParams
 	DownloadImageStoreObject(p *DownloadImageStoreObjectParams) (*DownloadImageStoreObjectResponse, error)
+	DownloadImageStoreObjectWithContext(ctx context.Context, p *DownloadImageStoreObjectParams) (*DownloadImageStoreObjectResponse, error)
+	DownloadImageStoreObjectAsync(ctx context.Context, p *DownloadImageStoreObjectParams) (*Job, error)
 	NewDownloadImageStoreObjectParams(id string) *DownloadImageStoreObjectParams
 }
 
@@ -92,6 +127,48 @@ func (p *AddImageStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addImageStore API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddImageStoreParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addImageStore", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addImageStore API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddImageStoreParams) Validate() error {
+	return paramRules{
+		required: []string{"provider"},
+		lengths:  map[string]int{"name": 255, "provider": 255, "url": 2048, "zoneid": 255},
+	}.validate("addImageStore", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addImageStore API, before the request is signed
+func (p *AddImageStoreParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddImageStoreParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddImageStoreParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addImageStore", b, &p.p, map[string]paramDecoder{
+		"details":  decodeParam[map[string]string],
+		"name":     decodeParam[string],
+		"provider": decodeParam[string],
+		"url":      decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddImageStoreParams) Clone() *AddImageStoreParams {
+	return &AddImageStoreParams{p: cloneParams(p.p)}
+}
+
 func (p *AddImageStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -208,7 +285,16 @@ func (s *ImageStoreService) NewAddImageStoreParams(provider string) *AddImageSto
 
 // Adds backup image store.
 func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStoreResponse, error) {
-	resp, err := s.cs.newPostRequest("addImageStore", p.toURLValues())
+	return s.AddImageStoreWithContext(context.Background(), p)
+}
+
+// AddImageStoreWithContext is like AddImageStore, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addImageStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -218,7 +304,7 @@ func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStor
 	}
 
 	var r AddImageStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addImageStore", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -226,20 +312,26 @@ func (s *ImageStoreService) AddImageStore(p *AddImageStoreParams) (*AddImageStor
 }
 
 type AddImageStoreResponse struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddImageStoreResponse) UnmarshalJSON(b []byte) error {
+	type alias AddImageStoreResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddImageStoreS3Params struct {
@@ -293,6 +385,54 @@ func (p *AddImageStoreS3Params) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addImageStoreS3 API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddImageStoreS3Params) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addImageStoreS3", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addImageStoreS3 API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddImageStoreS3Params) Validate() error {
+	return paramRules{
+		required: []string{"accesskey", "bucket", "endpoint", "secretkey"},
+		lengths:  map[string]int{"accesskey": 255, "bucket": 255, "endpoint": 255, "s3signer": 255, "secretkey": 255},
+	}.validate("addImageStoreS3", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addImageStoreS3 API, before the request is signed
+func (p *AddImageStoreS3Params) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddImageStoreS3Params) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddImageStoreS3Params) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addImageStoreS3", b, &p.p, map[string]paramDecoder{
+		"accesskey":         decodeParam[string],
+		"bucket":            decodeParam[string],
+		"connectiontimeout": decodeParam[int],
+		"connectionttl":     decodeParam[int],
+		"endpoint":          decodeParam[string],
+		"maxerrorretry":     decodeParam[int],
+		"s3signer":          decodeParam[string],
+		"secretkey":         decodeParam[string],
+		"sockettimeout":     decodeParam[int],
+		"usehttps":          decodeParam[bool],
+		"usetcpkeepalive":   decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddImageStoreS3Params) Clone() *AddImageStoreS3Params {
+	return &AddImageStoreS3Params{p: cloneParams(p.p)}
+}
+
 func (p *AddImageStoreS3Params) SetAccesskey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -538,13 +678,22 @@ func (s *ImageStoreService) NewAddImageStoreS3Params(accesskey string, bucket st
 
 // Adds S3 Image Store
 func (s *ImageStoreService) AddImageStoreS3(p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
-	resp, err := s.cs.newPostRequest("addImageStoreS3", p.toURLValues())
+	return s.AddImageStoreS3WithContext(context.Background(), p)
+}
+
+// AddImageStoreS3WithContext is like AddImageStoreS3, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) AddImageStoreS3WithContext(ctx context.Context, p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addImageStoreS3", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddImageStoreS3Response
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addImageStoreS3", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -552,20 +701,26 @@ func (s *ImageStoreService) AddImageStoreS3(p *AddImageStoreS3Params) (*AddImage
 }
 
 type AddImageStoreS3Response struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddImageStoreS3Response) UnmarshalJSON(b []byte) error {
+	type alias AddImageStoreS3Response
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSecondaryStagingStoreParams struct {
@@ -599,6 +754,48 @@ func (p *CreateSecondaryStagingStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSecondaryStagingStore API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSecondaryStagingStoreParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSecondaryStagingStore", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSecondaryStagingStore API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSecondaryStagingStoreParams) Validate() error {
+	return paramRules{
+		required: []string{"url"},
+		lengths:  map[string]int{"provider": 255, "scope": 255, "url": 2048, "zoneid": 255},
+	}.validate("createSecondaryStagingStore", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSecondaryStagingStore API, before the request is signed
+func (p *CreateSecondaryStagingStoreParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSecondaryStagingStoreParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSecondaryStagingStoreParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSecondaryStagingStore", b, &p.p, map[string]paramDecoder{
+		"details":  decodeParam[map[string]string],
+		"provider": decodeParam[string],
+		"scope":    decodeParam[string],
+		"url":      decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSecondaryStagingStoreParams) Clone() *CreateSecondaryStagingStoreParams {
+	return &CreateSecondaryStagingStoreParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSecondaryStagingStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -715,13 +912,22 @@ func (s *ImageStoreService) NewCreateSecondaryStagingStoreParams(url string) *Cr
 
 // Create secondary staging store.
 func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newPostRequest("createSecondaryStagingStore", p.toURLValues())
+	return s.CreateSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+// CreateSecondaryStagingStoreWithContext is like CreateSecondaryStagingStore, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createSecondaryStagingStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSecondaryStagingStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createSecondaryStagingStore", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -729,20 +935,26 @@ func (s *ImageStoreService) CreateSecondaryStagingStore(p *CreateSecondaryStagin
 }
 
 type CreateSecondaryStagingStoreResponse struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateSecondaryStagingStoreResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateSecondaryStagingStoreResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteImageStoreParams struct {
@@ -760,6 +972,44 @@ func (p *DeleteImageStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteImageStore API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteImageStoreParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteImageStore", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteImageStore API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteImageStoreParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteImageStore", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteImageStore API, before the request is signed
+func (p *DeleteImageStoreParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteImageStoreParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteImageStoreParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteImageStore", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteImageStoreParams) Clone() *DeleteImageStoreParams {
+	return &DeleteImageStoreParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteImageStoreParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -792,13 +1042,22 @@ func (s *ImageStoreService) NewDeleteImageStoreParams(id string) *DeleteImageSto
 
 // Deletes an image store or Secondary Storage.
 func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteImageStore", p.toURLValues())
+	return s.DeleteImageStoreWithContext(context.Background(), p)
+}
+
+// DeleteImageStoreWithContext is like DeleteImageStore, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteImageStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteImageStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteImageStore", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -806,10 +1065,11 @@ func (s *ImageStoreService) DeleteImageStore(p *DeleteImageStoreParams) (*Delete
 }
 
 type DeleteImageStoreResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteImageStoreResponse) UnmarshalJSON(b []byte) error {
@@ -836,7 +1096,7 @@ func (r *DeleteImageStoreResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteImageStoreResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteSecondaryStagingStoreParams struct {
@@ -854,6 +1114,44 @@ func (p *DeleteSecondaryStagingStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteSecondaryStagingStore API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteSecondaryStagingStoreParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteSecondaryStagingStore", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteSecondaryStagingStore API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteSecondaryStagingStoreParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteSecondaryStagingStore", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteSecondaryStagingStore API, before the request is signed
+func (p *DeleteSecondaryStagingStoreParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteSecondaryStagingStoreParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteSecondaryStagingStoreParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteSecondaryStagingStore", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteSecondaryStagingStoreParams) Clone() *DeleteSecondaryStagingStoreParams {
+	return &DeleteSecondaryStagingStoreParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteSecondaryStagingStoreParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -886,13 +1184,22 @@ func (s *ImageStoreService) NewDeleteSecondaryStagingStoreParams(id string) *Del
 
 // Deletes a secondary staging store .
 func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteSecondaryStagingStore", p.toURLValues())
+	return s.DeleteSecondaryStagingStoreWithContext(context.Background(), p)
+}
+
+// DeleteSecondaryStagingStoreWithContext is like DeleteSecondaryStagingStore, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteSecondaryStagingStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSecondaryStagingStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteSecondaryStagingStore", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -900,10 +1207,11 @@ func (s *ImageStoreService) DeleteSecondaryStagingStore(p *DeleteSecondaryStagin
 }
 
 type DeleteSecondaryStagingStoreResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteSecondaryStagingStoreResponse) UnmarshalJSON(b []byte) error {
@@ -930,7 +1238,7 @@ func (r *DeleteSecondaryStagingStoreResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteSecondaryStagingStoreResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListImageStoresParams struct {
@@ -975,6 +1283,51 @@ func (p *ListImageStoresParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listImageStores API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListImageStoresParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listImageStores", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listImageStores API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListImageStoresParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "protocol": 255, "provider": 255, "zoneid": 255},
+	}.validate("listImageStores", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listImageStores API, before the request is signed
+func (p *ListImageStoresParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListImageStoresParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListImageStoresParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listImageStores", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"protocol": decodeParam[string],
+		"provider": decodeParam[string],
+		"readonly": decodeParam[bool],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListImageStoresParams) Clone() *ListImageStoresParams {
+	return &ListImageStoresParams{p: cloneParams(p.p)}
+}
+
 func (p *ListImageStoresParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1174,6 +1527,11 @@ func (s *ImageStoreService) NewListImageStoresParams() *ListImageStoresParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetImageStoreIDWithContext(context.Background(), name, opts...)
+}
+
+// GetImageStoreIDWithContext is like GetImageStoreID, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) GetImageStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListImageStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -1185,7 +1543,7 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 		}
 	}
 
-	l, err := s.ListImageStores(p)
+	l, err := s.ListImageStoresWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1210,12 +1568,17 @@ func (s *ImageStoreService) GetImageStoreID(name string, opts ...OptionFunc) (st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error) {
-	id, count, err := s.GetImageStoreID(name, opts...)
+	return s.GetImageStoreByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetImageStoreByNameWithContext is like GetImageStoreByName, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) GetImageStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ImageStore, int, error) {
+	id, count, err := s.GetImageStoreIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetImageStoreByID(id, opts...)
+	r, count, err := s.GetImageStoreByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1224,6 +1587,11 @@ func (s *ImageStoreService) GetImageStoreByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error) {
+	return s.GetImageStoreByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetImageStoreByIDWithContext is like GetImageStoreByID, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) GetImageStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStore, int, error) {
 	p := &ListImageStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -1235,11 +1603,10 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 		}
 	}
 
-	l, err := s.ListImageStores(p)
+	l, err := s.ListImageStoresWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1257,39 +1624,82 @@ func (s *ImageStoreService) GetImageStoreByID(id string, opts ...OptionFunc) (*I
 
 // Lists image stores.
 func (s *ImageStoreService) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
-	resp, err := s.cs.newRequest("listImageStores", p.toURLValues())
+	return s.ListImageStoresWithContext(context.Background(), p)
+}
+
+// ListImageStoresWithContext is like ListImageStores, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listImageStores", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListImageStoresResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listImageStores", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListImageStoresAll returns all ImageStores matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ImageStoreService) ListImageStoresAll(p *ListImageStoresParams, opts ...PageOption) ([]*ImageStore, error) {
+	return s.ListImageStoresAllWithContext(context.Background(), p, opts...)
+}
+
+// ListImageStoresAllWithContext is like ListImageStoresAll, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListImageStoresAllWithContext(ctx context.Context, p *ListImageStoresParams, opts ...PageOption) ([]*ImageStore, error) {
+	return collectPages(s.ListImageStoresIterWithContext(ctx, p, opts...))
+}
+
+// ListImageStoresIter returns an iterator over all ImageStores matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ImageStoreService) ListImageStoresIter(p *ListImageStoresParams, opts ...PageOption) iter.Seq2[*ImageStore, error] {
+	return s.ListImageStoresIterWithContext(context.Background(), p, opts...)
+}
+
+// ListImageStoresIterWithContext is like ListImageStoresIter, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListImageStoresIterWithContext(ctx context.Context, p *ListImageStoresParams, opts ...PageOption) iter.Seq2[*ImageStore, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ImageStore, int, error) {
+		l, err := s.ListImageStoresWithContext(ctx, &ListImageStoresParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ImageStores, l.Count, nil
+	}, opts...)
+}
+
 type ListImageStoresResponse struct {
 	Count       int           `json:"count"`
 	ImageStores []*ImageStore `json:"imagestore"`
 }
 
 type ImageStore struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImageStore) UnmarshalJSON(b []byte) error {
+	type alias ImageStore
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSecondaryStagingStoresParams struct {
@@ -1330,6 +1740,50 @@ func (p *ListSecondaryStagingStoresParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSecondaryStagingStores API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSecondaryStagingStoresParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSecondaryStagingStores", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSecondaryStagingStores API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSecondaryStagingStoresParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "protocol": 255, "provider": 255, "zoneid": 255},
+	}.validate("listSecondaryStagingStores", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSecondaryStagingStores API, before the request is signed
+func (p *ListSecondaryStagingStoresParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSecondaryStagingStoresParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSecondaryStagingStoresParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSecondaryStagingStores", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"protocol": decodeParam[string],
+		"provider": decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSecondaryStagingStoresParams) Clone() *ListSecondaryStagingStoresParams {
+	return &ListSecondaryStagingStoresParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSecondaryStagingStoresParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1508,6 +1962,11 @@ func (s *ImageStoreService) NewListSecondaryStagingStoresParams() *ListSecondary
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSecondaryStagingStoreIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSecondaryStagingStoreIDWithContext is like GetSecondaryStagingStoreID, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) GetSecondaryStagingStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -1519,7 +1978,7 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 		}
 	}
 
-	l, err := s.ListSecondaryStagingStores(p)
+	l, err := s.ListSecondaryStagingStoresWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1544,12 +2003,17 @@ func (s *ImageStoreService) GetSecondaryStagingStoreID(name string, opts ...Opti
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
-	id, count, err := s.GetSecondaryStagingStoreID(name, opts...)
+	return s.GetSecondaryStagingStoreByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSecondaryStagingStoreByNameWithContext is like GetSecondaryStagingStoreByName, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) GetSecondaryStagingStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	id, count, err := s.GetSecondaryStagingStoreIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSecondaryStagingStoreByID(id, opts...)
+	r, count, err := s.GetSecondaryStagingStoreByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1558,6 +2022,11 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByName(name string, opts ...
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	return s.GetSecondaryStagingStoreByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSecondaryStagingStoreByIDWithContext is like GetSecondaryStagingStoreByID, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) GetSecondaryStagingStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
 	p := &ListSecondaryStagingStoresParams{}
 	p.p = make(map[string]interface{})
 
@@ -1569,11 +2038,10 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 		}
 	}
 
-	l, err := s.ListSecondaryStagingStores(p)
+	l, err := s.ListSecondaryStagingStoresWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1591,39 +2059,82 @@ func (s *ImageStoreService) GetSecondaryStagingStoreByID(id string, opts ...Opti
 
 // Lists secondary staging stores.
 func (s *ImageStoreService) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
-	resp, err := s.cs.newRequest("listSecondaryStagingStores", p.toURLValues())
+	return s.ListSecondaryStagingStoresWithContext(context.Background(), p)
+}
+
+// ListSecondaryStagingStoresWithContext is like ListSecondaryStagingStores, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSecondaryStagingStores", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSecondaryStagingStoresResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSecondaryStagingStores", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSecondaryStagingStoresAll returns all SecondaryStagingStores matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ImageStoreService) ListSecondaryStagingStoresAll(p *ListSecondaryStagingStoresParams, opts ...PageOption) ([]*SecondaryStagingStore, error) {
+	return s.ListSecondaryStagingStoresAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSecondaryStagingStoresAllWithContext is like ListSecondaryStagingStoresAll, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListSecondaryStagingStoresAllWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams, opts ...PageOption) ([]*SecondaryStagingStore, error) {
+	return collectPages(s.ListSecondaryStagingStoresIterWithContext(ctx, p, opts...))
+}
+
+// ListSecondaryStagingStoresIter returns an iterator over all SecondaryStagingStores matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ImageStoreService) ListSecondaryStagingStoresIter(p *ListSecondaryStagingStoresParams, opts ...PageOption) iter.Seq2[*SecondaryStagingStore, error] {
+	return s.ListSecondaryStagingStoresIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSecondaryStagingStoresIterWithContext is like ListSecondaryStagingStoresIter, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListSecondaryStagingStoresIterWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams, opts ...PageOption) iter.Seq2[*SecondaryStagingStore, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SecondaryStagingStore, int, error) {
+		l, err := s.ListSecondaryStagingStoresWithContext(ctx, &ListSecondaryStagingStoresParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SecondaryStagingStores, l.Count, nil
+	}, opts...)
+}
+
 type ListSecondaryStagingStoresResponse struct {
 	Count                  int                      `json:"count"`
 	SecondaryStagingStores []*SecondaryStagingStore `json:"secondarystagingstore"`
 }
 
 type SecondaryStagingStore struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SecondaryStagingStore) UnmarshalJSON(b []byte) error {
+	type alias SecondaryStagingStore
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateResourceToAnotherSecondaryStorageParams struct {
@@ -1652,6 +2163,47 @@ func (p *MigrateResourceToAnotherSecondaryStorageParams) toURLValues() url.Value
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateResourceToAnotherSecondaryStorage API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateResourceToAnotherSecondaryStorageParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateResourceToAnotherSecondaryStorage", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateResourceToAnotherSecondaryStorage API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateResourceToAnotherSecondaryStorageParams) Validate() error {
+	return paramRules{
+		required: []string{"destpool", "srcpool"},
+		lengths:  map[string]int{"destpool": 255, "srcpool": 255},
+	}.validate("migrateResourceToAnotherSecondaryStorage", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateResourceToAnotherSecondaryStorage API, before the request is signed
+func (p *MigrateResourceToAnotherSecondaryStorageParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateResourceToAnotherSecondaryStorageParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateResourceToAnotherSecondaryStorageParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateResourceToAnotherSecondaryStorage", b, &p.p, map[string]paramDecoder{
+		"destpool":  decodeParam[string],
+		"snapshots": decodeParam[[]string],
+		"srcpool":   decodeParam[string],
+		"templates": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateResourceToAnotherSecondaryStorageParams) Clone() *MigrateResourceToAnotherSecondaryStorageParams {
+	return &MigrateResourceToAnotherSecondaryStorageParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateResourceToAnotherSecondaryStorageParams) SetDestpool(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1748,32 +2300,39 @@ func (s *ImageStoreService) NewMigrateResourceToAnotherSecondaryStorageParams(de
 
 // migrates resources from one secondary storage to destination image store
 func (s *ImageStoreService) MigrateResourceToAnotherSecondaryStorage(p *MigrateResourceToAnotherSecondaryStorageParams) (*MigrateResourceToAnotherSecondaryStorageResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateResourceToAnotherSecondaryStorage", p.toURLValues())
+	return s.MigrateResourceToAnotherSecondaryStorageWithContext(context.Background(), p)
+}
+
+// MigrateResourceToAnotherSecondaryStorageWithContext is like MigrateResourceToAnotherSecondaryStorage, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) MigrateResourceToAnotherSecondaryStorageWithContext(ctx context.Context, p *MigrateResourceToAnotherSecondaryStorageParams) (*MigrateResourceToAnotherSecondaryStorageResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateResourceToAnotherSecondaryStorage", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateResourceToAnotherSecondaryStorageResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateResourceToAnotherSecondaryStorage", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateResourceToAnotherSecondaryStorage", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateResourceToAnotherSecondaryStorage", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1781,12 +2340,37 @@ func (s *ImageStoreService) MigrateResourceToAnotherSecondaryStorage(p *MigrateR
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateResourceToAnotherSecondaryStorage async job into r
+func (r *MigrateResourceToAnotherSecondaryStorageResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateResourceToAnotherSecondaryStorageAsync starts the async job of MigrateResourceToAnotherSecondaryStorage without waiting for it to finish, and returns a handle to the job
+func (s *ImageStoreService) MigrateResourceToAnotherSecondaryStorageAsync(ctx context.Context, p *MigrateResourceToAnotherSecondaryStorageParams) (*Job, error) {
+	r, err := s.MigrateResourceToAnotherSecondaryStorageWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateResourceToAnotherSecondaryStorage", r.JobID), nil
+}
+
 type MigrateResourceToAnotherSecondaryStorageResponse struct {
-	JobID         string `json:"jobid"`
-	Jobstatus     int    `json:"jobstatus"`
-	Message       string `json:"message"`
-	Migrationtype string `json:"migrationtype"`
-	Success       bool   `json:"success"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Message       string                     `json:"message"`
+	Migrationtype string                     `json:"migrationtype"`
+	Success       bool                       `json:"success"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateResourceToAnotherSecondaryStorageResponse) UnmarshalJSON(b []byte) error {
+	type alias MigrateResourceToAnotherSecondaryStorageResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateCloudToUseObjectStoreParams struct {
@@ -1817,6 +2401,47 @@ func (p *UpdateCloudToUseObjectStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateCloudToUseObjectStore API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateCloudToUseObjectStoreParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateCloudToUseObjectStore", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateCloudToUseObjectStore API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateCloudToUseObjectStoreParams) Validate() error {
+	return paramRules{
+		required: []string{"provider"},
+		lengths:  map[string]int{"name": 255, "provider": 255, "url": 2048},
+	}.validate("updateCloudToUseObjectStore", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateCloudToUseObjectStore API, before the request is signed
+func (p *UpdateCloudToUseObjectStoreParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateCloudToUseObjectStoreParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateCloudToUseObjectStoreParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateCloudToUseObjectStore", b, &p.p, map[string]paramDecoder{
+		"details":  decodeParam[map[string]string],
+		"name":     decodeParam[string],
+		"provider": decodeParam[string],
+		"url":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateCloudToUseObjectStoreParams) Clone() *UpdateCloudToUseObjectStoreParams {
+	return &UpdateCloudToUseObjectStoreParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateCloudToUseObjectStoreParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1912,13 +2537,22 @@ func (s *ImageStoreService) NewUpdateCloudToUseObjectStoreParams(provider string
 
 // Migrate current NFS secondary storages to use object store.
 func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
-	resp, err := s.cs.newPostRequest("updateCloudToUseObjectStore", p.toURLValues())
+	return s.UpdateCloudToUseObjectStoreWithContext(context.Background(), p)
+}
+
+// UpdateCloudToUseObjectStoreWithContext is like UpdateCloudToUseObjectStore, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateCloudToUseObjectStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateCloudToUseObjectStoreResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateCloudToUseObjectStore", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1926,20 +2560,26 @@ func (s *ImageStoreService) UpdateCloudToUseObjectStore(p *UpdateCloudToUseObjec
 }
 
 type UpdateCloudToUseObjectStoreResponse struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateCloudToUseObjectStoreResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateCloudToUseObjectStoreResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListImageStoreObjectsParams struct {
@@ -1971,6 +2611,48 @@ func (p *ListImageStoreObjectsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listImageStoreObjects API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListImageStoreObjectsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listImageStoreObjects", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listImageStoreObjects API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListImageStoreObjectsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "keyword": 255, "path": 255},
+	}.validate("listImageStoreObjects", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listImageStoreObjects API, before the request is signed
+func (p *ListImageStoreObjectsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListImageStoreObjectsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListImageStoreObjectsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listImageStoreObjects", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"path":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListImageStoreObjectsParams) Clone() *ListImageStoreObjectsParams {
+	return &ListImageStoreObjectsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListImageStoreObjectsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2087,6 +2769,11 @@ func (s *ImageStoreService) NewListImageStoreObjectsParams(id string) *ListImage
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ImageStoreService) GetImageStoreObjectByID(id string, opts ...OptionFunc) (*ImageStoreObject, int, error) {
+	return s.GetImageStoreObjectByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetImageStoreObjectByIDWithContext is like GetImageStoreObjectByID, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) GetImageStoreObjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStoreObject, int, error) {
 	p := &ListImageStoreObjectsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2098,11 +2785,10 @@ func (s *ImageStoreService) GetImageStoreObjectByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListImageStoreObjects(p)
+	l, err := s.ListImageStoreObjectsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2120,38 +2806,81 @@ func (s *ImageStoreService) GetImageStoreObjectByID(id string, opts ...OptionFun
 
 // Lists objects at specified path on an image store.
 func (s *ImageStoreService) ListImageStoreObjects(p *ListImageStoreObjectsParams) (*ListImageStoreObjectsResponse, error) {
-	resp, err := s.cs.newRequest("listImageStoreObjects", p.toURLValues())
+	return s.ListImageStoreObjectsWithContext(context.Background(), p)
+}
+
+// ListImageStoreObjectsWithContext is like ListImageStoreObjects, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListImageStoreObjectsWithContext(ctx context.Context, p *ListImageStoreObjectsParams) (*ListImageStoreObjectsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listImageStoreObjects", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListImageStoreObjectsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listImageStoreObjects", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListImageStoreObjectsAll returns all ImageStoreObjects matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ImageStoreService) ListImageStoreObjectsAll(p *ListImageStoreObjectsParams, opts ...PageOption) ([]*ImageStoreObject, error) {
+	return s.ListImageStoreObjectsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListImageStoreObjectsAllWithContext is like ListImageStoreObjectsAll, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListImageStoreObjectsAllWithContext(ctx context.Context, p *ListImageStoreObjectsParams, opts ...PageOption) ([]*ImageStoreObject, error) {
+	return collectPages(s.ListImageStoreObjectsIterWithContext(ctx, p, opts...))
+}
+
+// ListImageStoreObjectsIter returns an iterator over all ImageStoreObjects matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ImageStoreService) ListImageStoreObjectsIter(p *ListImageStoreObjectsParams, opts ...PageOption) iter.Seq2[*ImageStoreObject, error] {
+	return s.ListImageStoreObjectsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListImageStoreObjectsIterWithContext is like ListImageStoreObjectsIter, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) ListImageStoreObjectsIterWithContext(ctx context.Context, p *ListImageStoreObjectsParams, opts ...PageOption) iter.Seq2[*ImageStoreObject, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ImageStoreObject, int, error) {
+		l, err := s.ListImageStoreObjectsWithContext(ctx, &ListImageStoreObjectsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ImageStoreObjects, l.Count, nil
+	}, opts...)
+}
+
 type ListImageStoreObjectsResponse struct {
 	Count             int                 `json:"count"`
 	ImageStoreObjects []*ImageStoreObject `json:"datastoreobject"`
 }
 
 type ImageStoreObject struct {
-	Format       string `json:"format"`
-	Isdirectory  bool   `json:"isdirectory"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Lastupdated  string `json:"lastupdated"`
-	Name         string `json:"name"`
-	Size         int64  `json:"size"`
-	Snapshotid   string `json:"snapshotid"`
-	Snapshotname string `json:"snapshotname"`
-	Templateid   string `json:"templateid"`
-	Templatename string `json:"templatename"`
-	Volumeid     string `json:"volumeid"`
-	Volumename   string `json:"volumename"`
+	Format       string                     `json:"format"`
+	Isdirectory  FlexBool                   `json:"isdirectory"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Lastupdated  Time                       `json:"lastupdated"`
+	Name         string                     `json:"name"`
+	Size         FlexInt64                  `json:"size"`
+	Snapshotid   string                     `json:"snapshotid"`
+	Snapshotname string                     `json:"snapshotname"`
+	Templateid   string                     `json:"templateid"`
+	Templatename string                     `json:"templatename"`
+	Volumeid     string                     `json:"volumeid"`
+	Volumename   string                     `json:"volumename"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImageStoreObject) UnmarshalJSON(b []byte) error {
+	type alias ImageStoreObject
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateImageStoreParams struct {
@@ -2180,6 +2909,47 @@ func (p *UpdateImageStoreParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateImageStore API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateImageStoreParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateImageStore", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateImageStore API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateImageStoreParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "name": 255},
+	}.validate("updateImageStore", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateImageStore API, before the request is signed
+func (p *UpdateImageStoreParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateImageStoreParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateImageStoreParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateImageStore", b, &p.p, map[string]paramDecoder{
+		"capacitybytes": decodeParam[int64],
+		"id":            decodeParam[string],
+		"name":          decodeParam[string],
+		"readonly":      decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateImageStoreParams) Clone() *UpdateImageStoreParams {
+	return &UpdateImageStoreParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateImageStoreParams) SetCapacitybytes(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2275,7 +3045,16 @@ func (s *ImageStoreService) NewUpdateImageStoreParams(id string) *UpdateImageSto
 
 // Updates image store read-only status
 func (s *ImageStoreService) UpdateImageStore(p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error) {
-	resp, err := s.cs.newPostRequest("updateImageStore", p.toURLValues())
+	return s.UpdateImageStoreWithContext(context.Background(), p)
+}
+
+// UpdateImageStoreWithContext is like UpdateImageStore, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) UpdateImageStoreWithContext(ctx context.Context, p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateImageStore", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2283,7 +3062,7 @@ func (s *ImageStoreService) UpdateImageStore(p *UpdateImageStoreParams) (*Update
 	var nested struct {
 		Response UpdateImageStoreResponse `json:"imagestore"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateImageStore", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -2292,20 +3071,26 @@ func (s *ImageStoreService) UpdateImageStore(p *UpdateImageStoreParams) (*Update
 }
 
 type UpdateImageStoreResponse struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateImageStoreResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateImageStoreResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DownloadImageStoreObjectParams struct {
@@ -2326,6 +3111,45 @@ func (p *DownloadImageStoreObjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the downloadImageStoreObject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DownloadImageStoreObjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("downloadImageStoreObject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the downloadImageStoreObject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DownloadImageStoreObjectParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "path": 255},
+	}.validate("downloadImageStoreObject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the downloadImageStoreObject API, before the request is signed
+func (p *DownloadImageStoreObjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DownloadImageStoreObjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DownloadImageStoreObjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("downloadImageStoreObject", b, &p.p, map[string]paramDecoder{
+		"id":   decodeParam[string],
+		"path": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DownloadImageStoreObjectParams) Clone() *DownloadImageStoreObjectParams {
+	return &DownloadImageStoreObjectParams{p: cloneParams(p.p)}
+}
+
 func (p *DownloadImageStoreObjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2379,32 +3203,39 @@ func (s *ImageStoreService) NewDownloadImageStoreObjectParams(id string) *Downlo
 
 // Download object at a specified path on an image store.
 func (s *ImageStoreService) DownloadImageStoreObject(p *DownloadImageStoreObjectParams) (*DownloadImageStoreObjectResponse, error) {
-	resp, err := s.cs.newPostRequest("downloadImageStoreObject", p.toURLValues())
+	return s.DownloadImageStoreObjectWithContext(context.Background(), p)
+}
+
+// DownloadImageStoreObjectWithContext is like DownloadImageStoreObject, but honours the cancellation and deadline of ctx
+func (s *ImageStoreService) DownloadImageStoreObjectWithContext(ctx context.Context, p *DownloadImageStoreObjectParams) (*DownloadImageStoreObjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "downloadImageStoreObject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DownloadImageStoreObjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("downloadImageStoreObject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "downloadImageStoreObject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("downloadImageStoreObject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2412,21 +3243,46 @@ func (s *ImageStoreService) DownloadImageStoreObject(p *DownloadImageStoreObject
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DownloadImageStoreObject async job into r
+func (r *DownloadImageStoreObjectResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DownloadImageStoreObjectAsync starts the async job of DownloadImageStoreObject without waiting for it to finish, and returns a handle to the job
+func (s *ImageStoreService) DownloadImageStoreObjectAsync(ctx context.Context, p *DownloadImageStoreObjectParams) (*Job, error) {
+	r, err := s.DownloadImageStoreObjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("downloadImageStoreObject", r.JobID), nil
+}
+
 type DownloadImageStoreObjectResponse struct {
-	Accountid        string `json:"accountid"`
-	Created          string `json:"created"`
-	ExtractId        string `json:"extractId"`
-	ExtractMode      string `json:"extractMode"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Resultstring     string `json:"resultstring"`
-	State            string `json:"state"`
-	Status           string `json:"status"`
-	Storagetype      string `json:"storagetype"`
-	Uploadpercentage int    `json:"uploadpercentage"`
-	Url              string `json:"url"`
-	Zoneid           string `json:"zoneid"`
-	Zonename         string `json:"zonename"`
+	Accountid        string                     `json:"accountid"`
+	Created          Time                       `json:"created"`
+	ExtractId        string                     `json:"extractId"`
+	ExtractMode      string                     `json:"extractMode"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Resultstring     string                     `json:"resultstring"`
+	State            string                     `json:"state"`
+	Status           string                     `json:"status"`
+	Storagetype      string                     `json:"storagetype"`
+	Uploadpercentage FlexInt                    `json:"uploadpercentage"`
+	Url              string                     `json:"url"`
+	Zoneid           string                     `json:"zoneid"`
+	Zonename         string                     `json:"zonename"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DownloadImageStoreObjectResponse) UnmarshalJSON(b []byte) error {
+	type alias DownloadImageStoreObjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
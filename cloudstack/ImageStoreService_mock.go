@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -88,6 +90,36 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) AddImageStoreS3(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageStoreS3", reflect.TypeOf((*MockImageStoreServiceIface)(nil).AddImageStoreS3), p)
 }
 
+// AddImageStoreS3WithContext mocks base method.
+func (m *MockImageStoreServiceIface) AddImageStoreS3WithContext(ctx context.Context, p *AddImageStoreS3Params) (*AddImageStoreS3Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImageStoreS3WithContext", ctx, p)
+	ret0, _ := ret[0].(*AddImageStoreS3Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddImageStoreS3WithContext indicates an expected call of AddImageStoreS3WithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) AddImageStoreS3WithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageStoreS3WithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).AddImageStoreS3WithContext), ctx, p)
+}
+
+// AddImageStoreWithContext mocks base method.
+func (m *MockImageStoreServiceIface) AddImageStoreWithContext(ctx context.Context, p *AddImageStoreParams) (*AddImageStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddImageStoreWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddImageStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddImageStoreWithContext indicates an expected call of AddImageStoreWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) AddImageStoreWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddImageStoreWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).AddImageStoreWithContext), ctx, p)
+}
+
 // CreateSecondaryStagingStore mocks base method.
 func (m *MockImageStoreServiceIface) CreateSecondaryStagingStore(p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,21 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) CreateSecondaryStagingStore(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecondaryStagingStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).CreateSecondaryStagingStore), p)
 }
 
+// CreateSecondaryStagingStoreWithContext mocks base method.
+func (m *MockImageStoreServiceIface) CreateSecondaryStagingStoreWithContext(ctx context.Context, p *CreateSecondaryStagingStoreParams) (*CreateSecondaryStagingStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecondaryStagingStoreWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSecondaryStagingStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecondaryStagingStoreWithContext indicates an expected call of CreateSecondaryStagingStoreWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) CreateSecondaryStagingStoreWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecondaryStagingStoreWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).CreateSecondaryStagingStoreWithContext), ctx, p)
+}
+
 // DeleteImageStore mocks base method.
 func (m *MockImageStoreServiceIface) DeleteImageStore(p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +165,21 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteImageStore(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteImageStore), p)
 }
 
+// DeleteImageStoreWithContext mocks base method.
+func (m *MockImageStoreServiceIface) DeleteImageStoreWithContext(ctx context.Context, p *DeleteImageStoreParams) (*DeleteImageStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteImageStoreWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteImageStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteImageStoreWithContext indicates an expected call of DeleteImageStoreWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteImageStoreWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteImageStoreWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteImageStoreWithContext), ctx, p)
+}
+
 // DeleteSecondaryStagingStore mocks base method.
 func (m *MockImageStoreServiceIface) DeleteSecondaryStagingStore(p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +195,21 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteSecondaryStagingStore(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecondaryStagingStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteSecondaryStagingStore), p)
 }
 
+// DeleteSecondaryStagingStoreWithContext mocks base method.
+func (m *MockImageStoreServiceIface) DeleteSecondaryStagingStoreWithContext(ctx context.Context, p *DeleteSecondaryStagingStoreParams) (*DeleteSecondaryStagingStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecondaryStagingStoreWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteSecondaryStagingStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecondaryStagingStoreWithContext indicates an expected call of DeleteSecondaryStagingStoreWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) DeleteSecondaryStagingStoreWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecondaryStagingStoreWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DeleteSecondaryStagingStoreWithContext), ctx, p)
+}
+
 // DownloadImageStoreObject mocks base method.
 func (m *MockImageStoreServiceIface) DownloadImageStoreObject(p *DownloadImageStoreObjectParams) (*DownloadImageStoreObjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +225,36 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) DownloadImageStoreObject(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadImageStoreObject", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DownloadImageStoreObject), p)
 }
 
+// DownloadImageStoreObjectAsync mocks base method.
+func (m *MockImageStoreServiceIface) DownloadImageStoreObjectAsync(ctx context.Context, p *DownloadImageStoreObjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadImageStoreObjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadImageStoreObjectAsync indicates an expected call of DownloadImageStoreObjectAsync.
+func (mr *MockImageStoreServiceIfaceMockRecorder) DownloadImageStoreObjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadImageStoreObjectAsync", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DownloadImageStoreObjectAsync), ctx, p)
+}
+
+// DownloadImageStoreObjectWithContext mocks base method.
+func (m *MockImageStoreServiceIface) DownloadImageStoreObjectWithContext(ctx context.Context, p *DownloadImageStoreObjectParams) (*DownloadImageStoreObjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DownloadImageStoreObjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*DownloadImageStoreObjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DownloadImageStoreObjectWithContext indicates an expected call of DownloadImageStoreObjectWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) DownloadImageStoreObjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DownloadImageStoreObjectWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).DownloadImageStoreObjectWithContext), ctx, p)
+}
+
 // GetImageStoreByID mocks base method.
 func (m *MockImageStoreServiceIface) GetImageStoreByID(id string, opts ...OptionFunc) (*ImageStore, int, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +276,27 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreByID", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreByID), varargs...)
 }
 
+// GetImageStoreByIDWithContext mocks base method.
+func (m *MockImageStoreServiceIface) GetImageStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStore, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetImageStoreByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ImageStore)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImageStoreByIDWithContext indicates an expected call of GetImageStoreByIDWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreByIDWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreByIDWithContext), varargs...)
+}
+
 // GetImageStoreByName mocks base method.
 func (m *MockImageStoreServiceIface) GetImageStoreByName(name string, opts ...OptionFunc) (*ImageStore, int, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +318,27 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreByName(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreByName", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreByName), varargs...)
 }
 
+// GetImageStoreByNameWithContext mocks base method.
+func (m *MockImageStoreServiceIface) GetImageStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ImageStore, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetImageStoreByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*ImageStore)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImageStoreByNameWithContext indicates an expected call of GetImageStoreByNameWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreByNameWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreByNameWithContext), varargs...)
+}
+
 // GetImageStoreID mocks base method.
 func (m *MockImageStoreServiceIface) GetImageStoreID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +360,27 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreID(name any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreID", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreID), varargs...)
 }
 
+// GetImageStoreIDWithContext mocks base method.
+func (m *MockImageStoreServiceIface) GetImageStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetImageStoreIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImageStoreIDWithContext indicates an expected call of GetImageStoreIDWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreIDWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreIDWithContext), varargs...)
+}
+
 // GetImageStoreObjectByID mocks base method.
 func (m *MockImageStoreServiceIface) GetImageStoreObjectByID(id string, opts ...OptionFunc) (*ImageStoreObject, int, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +402,27 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreObjectByID(id any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreObjectByID", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreObjectByID), varargs...)
 }
 
+// GetImageStoreObjectByIDWithContext mocks base method.
+func (m *MockImageStoreServiceIface) GetImageStoreObjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ImageStoreObject, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetImageStoreObjectByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ImageStoreObject)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetImageStoreObjectByIDWithContext indicates an expected call of GetImageStoreObjectByIDWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) GetImageStoreObjectByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetImageStoreObjectByIDWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetImageStoreObjectByIDWithContext), varargs...)
+}
+
 // GetSecondaryStagingStoreByID mocks base method.
 func (m *MockImageStoreServiceIface) GetSecondaryStagingStoreByID(id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +444,27 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetSecondaryStagingStoreByID(i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStagingStoreByID", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetSecondaryStagingStoreByID), varargs...)
 }
 
+// GetSecondaryStagingStoreByIDWithContext mocks base method.
+func (m *MockImageStoreServiceIface) GetSecondaryStagingStoreByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecondaryStagingStoreByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*SecondaryStagingStore)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecondaryStagingStoreByIDWithContext indicates an expected call of GetSecondaryStagingStoreByIDWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) GetSecondaryStagingStoreByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStagingStoreByIDWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetSecondaryStagingStoreByIDWithContext), varargs...)
+}
+
 // GetSecondaryStagingStoreByName mocks base method.
 func (m *MockImageStoreServiceIface) GetSecondaryStagingStoreByName(name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
 	m.ctrl.T.Helper()
@@ -274,6 +486,27 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetSecondaryStagingStoreByName
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStagingStoreByName", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetSecondaryStagingStoreByName), varargs...)
 }
 
+// GetSecondaryStagingStoreByNameWithContext mocks base method.
+func (m *MockImageStoreServiceIface) GetSecondaryStagingStoreByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecondaryStagingStore, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecondaryStagingStoreByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*SecondaryStagingStore)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecondaryStagingStoreByNameWithContext indicates an expected call of GetSecondaryStagingStoreByNameWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) GetSecondaryStagingStoreByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStagingStoreByNameWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetSecondaryStagingStoreByNameWithContext), varargs...)
+}
+
 // GetSecondaryStagingStoreID mocks base method.
 func (m *MockImageStoreServiceIface) GetSecondaryStagingStoreID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -295,6 +528,27 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) GetSecondaryStagingStoreID(nam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStagingStoreID", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetSecondaryStagingStoreID), varargs...)
 }
 
+// GetSecondaryStagingStoreIDWithContext mocks base method.
+func (m *MockImageStoreServiceIface) GetSecondaryStagingStoreIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecondaryStagingStoreIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecondaryStagingStoreIDWithContext indicates an expected call of GetSecondaryStagingStoreIDWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) GetSecondaryStagingStoreIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecondaryStagingStoreIDWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).GetSecondaryStagingStoreIDWithContext), varargs...)
+}
+
 // ListImageStoreObjects mocks base method.
 func (m *MockImageStoreServiceIface) ListImageStoreObjects(p *ListImageStoreObjectsParams) (*ListImageStoreObjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -310,6 +564,99 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoreObjects(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoreObjects", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoreObjects), p)
 }
 
+// ListImageStoreObjectsAll mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoreObjectsAll(p *ListImageStoreObjectsParams, opts ...PageOption) ([]*ImageStoreObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoreObjectsAll", varargs...)
+	ret0, _ := ret[0].([]*ImageStoreObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImageStoreObjectsAll indicates an expected call of ListImageStoreObjectsAll.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoreObjectsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoreObjectsAll", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoreObjectsAll), varargs...)
+}
+
+// ListImageStoreObjectsAllWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoreObjectsAllWithContext(ctx context.Context, p *ListImageStoreObjectsParams, opts ...PageOption) ([]*ImageStoreObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoreObjectsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ImageStoreObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImageStoreObjectsAllWithContext indicates an expected call of ListImageStoreObjectsAllWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoreObjectsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoreObjectsAllWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoreObjectsAllWithContext), varargs...)
+}
+
+// ListImageStoreObjectsIter mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoreObjectsIter(p *ListImageStoreObjectsParams, opts ...PageOption) iter.Seq2[*ImageStoreObject, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoreObjectsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ImageStoreObject, error])
+	return ret0
+}
+
+// ListImageStoreObjectsIter indicates an expected call of ListImageStoreObjectsIter.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoreObjectsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoreObjectsIter", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoreObjectsIter), varargs...)
+}
+
+// ListImageStoreObjectsIterWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoreObjectsIterWithContext(ctx context.Context, p *ListImageStoreObjectsParams, opts ...PageOption) iter.Seq2[*ImageStoreObject, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoreObjectsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ImageStoreObject, error])
+	return ret0
+}
+
+// ListImageStoreObjectsIterWithContext indicates an expected call of ListImageStoreObjectsIterWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoreObjectsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoreObjectsIterWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoreObjectsIterWithContext), varargs...)
+}
+
+// ListImageStoreObjectsWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoreObjectsWithContext(ctx context.Context, p *ListImageStoreObjectsParams) (*ListImageStoreObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImageStoreObjectsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListImageStoreObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImageStoreObjectsWithContext indicates an expected call of ListImageStoreObjectsWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoreObjectsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoreObjectsWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoreObjectsWithContext), ctx, p)
+}
+
 // ListImageStores mocks base method.
 func (m *MockImageStoreServiceIface) ListImageStores(p *ListImageStoresParams) (*ListImageStoresResponse, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +672,99 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStores(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStores", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStores), p)
 }
 
+// ListImageStoresAll mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoresAll(p *ListImageStoresParams, opts ...PageOption) ([]*ImageStore, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoresAll", varargs...)
+	ret0, _ := ret[0].([]*ImageStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImageStoresAll indicates an expected call of ListImageStoresAll.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoresAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoresAll", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoresAll), varargs...)
+}
+
+// ListImageStoresAllWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoresAllWithContext(ctx context.Context, p *ListImageStoresParams, opts ...PageOption) ([]*ImageStore, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoresAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ImageStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImageStoresAllWithContext indicates an expected call of ListImageStoresAllWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoresAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoresAllWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoresAllWithContext), varargs...)
+}
+
+// ListImageStoresIter mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoresIter(p *ListImageStoresParams, opts ...PageOption) iter.Seq2[*ImageStore, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoresIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ImageStore, error])
+	return ret0
+}
+
+// ListImageStoresIter indicates an expected call of ListImageStoresIter.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoresIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoresIter", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoresIter), varargs...)
+}
+
+// ListImageStoresIterWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoresIterWithContext(ctx context.Context, p *ListImageStoresParams, opts ...PageOption) iter.Seq2[*ImageStore, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImageStoresIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ImageStore, error])
+	return ret0
+}
+
+// ListImageStoresIterWithContext indicates an expected call of ListImageStoresIterWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoresIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoresIterWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoresIterWithContext), varargs...)
+}
+
+// ListImageStoresWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListImageStoresWithContext(ctx context.Context, p *ListImageStoresParams) (*ListImageStoresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImageStoresWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListImageStoresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImageStoresWithContext indicates an expected call of ListImageStoresWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListImageStoresWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImageStoresWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListImageStoresWithContext), ctx, p)
+}
+
 // ListSecondaryStagingStores mocks base method.
 func (m *MockImageStoreServiceIface) ListSecondaryStagingStores(p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +780,99 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStores(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStores", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStores), p)
 }
 
+// ListSecondaryStagingStoresAll mocks base method.
+func (m *MockImageStoreServiceIface) ListSecondaryStagingStoresAll(p *ListSecondaryStagingStoresParams, opts ...PageOption) ([]*SecondaryStagingStore, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStagingStoresAll", varargs...)
+	ret0, _ := ret[0].([]*SecondaryStagingStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecondaryStagingStoresAll indicates an expected call of ListSecondaryStagingStoresAll.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStoresAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStoresAll", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStoresAll), varargs...)
+}
+
+// ListSecondaryStagingStoresAllWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListSecondaryStagingStoresAllWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams, opts ...PageOption) ([]*SecondaryStagingStore, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStagingStoresAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SecondaryStagingStore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecondaryStagingStoresAllWithContext indicates an expected call of ListSecondaryStagingStoresAllWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStoresAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStoresAllWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStoresAllWithContext), varargs...)
+}
+
+// ListSecondaryStagingStoresIter mocks base method.
+func (m *MockImageStoreServiceIface) ListSecondaryStagingStoresIter(p *ListSecondaryStagingStoresParams, opts ...PageOption) iter.Seq2[*SecondaryStagingStore, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStagingStoresIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SecondaryStagingStore, error])
+	return ret0
+}
+
+// ListSecondaryStagingStoresIter indicates an expected call of ListSecondaryStagingStoresIter.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStoresIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStoresIter", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStoresIter), varargs...)
+}
+
+// ListSecondaryStagingStoresIterWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListSecondaryStagingStoresIterWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams, opts ...PageOption) iter.Seq2[*SecondaryStagingStore, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecondaryStagingStoresIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SecondaryStagingStore, error])
+	return ret0
+}
+
+// ListSecondaryStagingStoresIterWithContext indicates an expected call of ListSecondaryStagingStoresIterWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStoresIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStoresIterWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStoresIterWithContext), varargs...)
+}
+
+// ListSecondaryStagingStoresWithContext mocks base method.
+func (m *MockImageStoreServiceIface) ListSecondaryStagingStoresWithContext(ctx context.Context, p *ListSecondaryStagingStoresParams) (*ListSecondaryStagingStoresResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecondaryStagingStoresWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSecondaryStagingStoresResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecondaryStagingStoresWithContext indicates an expected call of ListSecondaryStagingStoresWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) ListSecondaryStagingStoresWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecondaryStagingStoresWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).ListSecondaryStagingStoresWithContext), ctx, p)
+}
+
 // MigrateResourceToAnotherSecondaryStorage mocks base method.
 func (m *MockImageStoreServiceIface) MigrateResourceToAnotherSecondaryStorage(p *MigrateResourceToAnotherSecondaryStorageParams) (*MigrateResourceToAnotherSecondaryStorageResponse, error) {
 	m.ctrl.T.Helper()
@@ -355,6 +888,36 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) MigrateResourceToAnotherSecond
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateResourceToAnotherSecondaryStorage", reflect.TypeOf((*MockImageStoreServiceIface)(nil).MigrateResourceToAnotherSecondaryStorage), p)
 }
 
+// MigrateResourceToAnotherSecondaryStorageAsync mocks base method.
+func (m *MockImageStoreServiceIface) MigrateResourceToAnotherSecondaryStorageAsync(ctx context.Context, p *MigrateResourceToAnotherSecondaryStorageParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateResourceToAnotherSecondaryStorageAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateResourceToAnotherSecondaryStorageAsync indicates an expected call of MigrateResourceToAnotherSecondaryStorageAsync.
+func (mr *MockImageStoreServiceIfaceMockRecorder) MigrateResourceToAnotherSecondaryStorageAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateResourceToAnotherSecondaryStorageAsync", reflect.TypeOf((*MockImageStoreServiceIface)(nil).MigrateResourceToAnotherSecondaryStorageAsync), ctx, p)
+}
+
+// MigrateResourceToAnotherSecondaryStorageWithContext mocks base method.
+func (m *MockImageStoreServiceIface) MigrateResourceToAnotherSecondaryStorageWithContext(ctx context.Context, p *MigrateResourceToAnotherSecondaryStorageParams) (*MigrateResourceToAnotherSecondaryStorageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateResourceToAnotherSecondaryStorageWithContext", ctx, p)
+	ret0, _ := ret[0].(*MigrateResourceToAnotherSecondaryStorageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateResourceToAnotherSecondaryStorageWithContext indicates an expected call of MigrateResourceToAnotherSecondaryStorageWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) MigrateResourceToAnotherSecondaryStorageWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateResourceToAnotherSecondaryStorageWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).MigrateResourceToAnotherSecondaryStorageWithContext), ctx, p)
+}
+
 // NewAddImageStoreParams mocks base method.
 func (m *MockImageStoreServiceIface) NewAddImageStoreParams(provider string) *AddImageStoreParams {
 	m.ctrl.T.Helper()
@@ -538,6 +1101,21 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) UpdateCloudToUseObjectStore(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCloudToUseObjectStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).UpdateCloudToUseObjectStore), p)
 }
 
+// UpdateCloudToUseObjectStoreWithContext mocks base method.
+func (m *MockImageStoreServiceIface) UpdateCloudToUseObjectStoreWithContext(ctx context.Context, p *UpdateCloudToUseObjectStoreParams) (*UpdateCloudToUseObjectStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateCloudToUseObjectStoreWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateCloudToUseObjectStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateCloudToUseObjectStoreWithContext indicates an expected call of UpdateCloudToUseObjectStoreWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) UpdateCloudToUseObjectStoreWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateCloudToUseObjectStoreWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).UpdateCloudToUseObjectStoreWithContext), ctx, p)
+}
+
 // UpdateImageStore mocks base method.
 func (m *MockImageStoreServiceIface) UpdateImageStore(p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error) {
 	m.ctrl.T.Helper()
@@ -552,3 +1130,18 @@ func (mr *MockImageStoreServiceIfaceMockRecorder) UpdateImageStore(p any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageStore", reflect.TypeOf((*MockImageStoreServiceIface)(nil).UpdateImageStore), p)
 }
+
+// UpdateImageStoreWithContext mocks base method.
+func (m *MockImageStoreServiceIface) UpdateImageStoreWithContext(ctx context.Context, p *UpdateImageStoreParams) (*UpdateImageStoreResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateImageStoreWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateImageStoreResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateImageStoreWithContext indicates an expected call of UpdateImageStoreWithContext.
+func (mr *MockImageStoreServiceIfaceMockRecorder) UpdateImageStoreWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateImageStoreWithContext", reflect.TypeOf((*MockImageStoreServiceIface)(nil).UpdateImageStoreWithContext), ctx, p)
+}
@@ -20,12 +20,14 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
 
 type InfrastructureUsageServiceIface interface {
 	ListDbMetrics(p *ListDbMetricsParams) (*ListDbMetricsResponse, error)
+	ListDbMetricsWithContext(ctx context.Context, p *ListDbMetricsParams) (*ListDbMetricsResponse, error)
 	NewListDbMetricsParams() *ListDbMetricsParams
 }
 
@@ -41,6 +43,39 @@ func (p *ListDbMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDbMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDbMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDbMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDbMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDbMetricsParams) Validate() error {
+	return paramRules{}.validate("listDbMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDbMetrics API, before the request is signed
+func (p *ListDbMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDbMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDbMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDbMetrics", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDbMetricsParams) Clone() *ListDbMetricsParams {
+	return &ListDbMetricsParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new ListDbMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *InfrastructureUsageService) NewListDbMetricsParams() *ListDbMetricsParams {
@@ -51,13 +86,22 @@ func (s *InfrastructureUsageService) NewListDbMetricsParams() *ListDbMetricsPara
 
 // List the db hosts and statistics
 func (s *InfrastructureUsageService) ListDbMetrics(p *ListDbMetricsParams) (*ListDbMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listDbMetrics", p.toURLValues())
+	return s.ListDbMetricsWithContext(context.Background(), p)
+}
+
+// ListDbMetricsWithContext is like ListDbMetrics, but honours the cancellation and deadline of ctx
+func (s *InfrastructureUsageService) ListDbMetricsWithContext(ctx context.Context, p *ListDbMetricsParams) (*ListDbMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDbMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDbMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDbMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -69,16 +113,22 @@ type ListDbMetricsResponse struct {
 }
 
 type DbMetric struct {
-	Collectiontime string    `json:"collectiontime"`
-	Connections    int       `json:"connections"`
-	Dbloadaverages []float64 `json:"dbloadaverages"`
-	Hostname       string    `json:"hostname"`
-	JobID          string    `json:"jobid"`
-	Jobstatus      int       `json:"jobstatus"`
-	Queries        int64     `json:"queries"`
-	Replicas       []string  `json:"replicas"`
-	Tlsversions    string    `json:"tlsversions"`
-	Uptime         int64     `json:"uptime"`
-	Version        string    `json:"version"`
-	Versioncomment string    `json:"versioncomment"`
+	Collectiontime Time                       `json:"collectiontime"`
+	Connections    FlexInt                    `json:"connections"`
+	Dbloadaverages []float64                  `json:"dbloadaverages"`
+	Hostname       string                     `json:"hostname"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Queries        FlexInt64                  `json:"queries"`
+	Replicas       []string                   `json:"replicas"`
+	Tlsversions    string                     `json:"tlsversions"`
+	Uptime         FlexInt64                  `json:"uptime"`
+	Version        string                     `json:"version"`
+	Versioncomment string                     `json:"versioncomment"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DbMetric) UnmarshalJSON(b []byte) error {
+	type alias DbMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
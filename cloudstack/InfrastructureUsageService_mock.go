@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockInfrastructureUsageServiceIfaceMockRecorder) ListDbMetrics(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDbMetrics", reflect.TypeOf((*MockInfrastructureUsageServiceIface)(nil).ListDbMetrics), p)
 }
 
+// ListDbMetricsWithContext mocks base method.
+func (m *MockInfrastructureUsageServiceIface) ListDbMetricsWithContext(ctx context.Context, p *ListDbMetricsParams) (*ListDbMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDbMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDbMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDbMetricsWithContext indicates an expected call of ListDbMetricsWithContext.
+func (mr *MockInfrastructureUsageServiceIfaceMockRecorder) ListDbMetricsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDbMetricsWithContext", reflect.TypeOf((*MockInfrastructureUsageServiceIface)(nil).ListDbMetricsWithContext), ctx, p)
+}
+
 // NewListDbMetricsParams mocks base method.
 func (m *MockInfrastructureUsageServiceIface) NewListDbMetricsParams() *ListDbMetricsParams {
 	m.ctrl.T.Helper()
@@ -20,29 +20,52 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type InternalLBServiceIface interface {
 	ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error)
+	ConfigureInternalLoadBalancerElementAsync(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*Job, error)
 	NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams
 	CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error)
+	CreateInternalLoadBalancerElementAsync(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*Job, error)
 	NewCreateInternalLoadBalancerElementParams(nspid string) *CreateInternalLoadBalancerElementParams
 	ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error)
+	ListInternalLoadBalancerElementsAll(p *ListInternalLoadBalancerElementsParams, opts ...PageOption) ([]*InternalLoadBalancerElement, error)
+	ListInternalLoadBalancerElementsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams, opts ...PageOption) ([]*InternalLoadBalancerElement, error)
+	ListInternalLoadBalancerElementsIter(p *ListInternalLoadBalancerElementsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerElement, error]
+	ListInternalLoadBalancerElementsIterWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerElement, error]
 	NewListInternalLoadBalancerElementsParams() *ListInternalLoadBalancerElementsParams
 	GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
+	GetInternalLoadBalancerElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error)
 	ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error)
+	ListInternalLoadBalancerVMsAll(p *ListInternalLoadBalancerVMsParams, opts ...PageOption) ([]*InternalLoadBalancerVM, error)
+	ListInternalLoadBalancerVMsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams, opts ...PageOption) ([]*InternalLoadBalancerVM, error)
+	ListInternalLoadBalancerVMsIter(p *ListInternalLoadBalancerVMsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerVM, error]
+	ListInternalLoadBalancerVMsIterWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerVM, error]
 	NewListInternalLoadBalancerVMsParams() *ListInternalLoadBalancerVMsParams
 	GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error)
+	GetInternalLoadBalancerVMIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
 	GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
+	GetInternalLoadBalancerVMByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error)
 	StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error)
+	StartInternalLoadBalancerVMAsync(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*Job, error)
 	NewStartInternalLoadBalancerVMParams(id string) *StartInternalLoadBalancerVMParams
 	StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error)
+	StopInternalLoadBalancerVMAsync(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*Job, error)
 	NewStopInternalLoadBalancerVMParams(id string) *StopInternalLoadBalancerVMParams
 }
 
@@ -65,6 +88,45 @@ func (p *ConfigureInternalLoadBalancerElementParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configureInternalLoadBalancerElement API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigureInternalLoadBalancerElementParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configureInternalLoadBalancerElement", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configureInternalLoadBalancerElement API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigureInternalLoadBalancerElementParams) Validate() error {
+	return paramRules{
+		required: []string{"enabled", "id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("configureInternalLoadBalancerElement", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configureInternalLoadBalancerElement API, before the request is signed
+func (p *ConfigureInternalLoadBalancerElementParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigureInternalLoadBalancerElementParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigureInternalLoadBalancerElementParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configureInternalLoadBalancerElement", b, &p.p, map[string]paramDecoder{
+		"enabled": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigureInternalLoadBalancerElementParams) Clone() *ConfigureInternalLoadBalancerElementParams {
+	return &ConfigureInternalLoadBalancerElementParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigureInternalLoadBalancerElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -119,32 +181,39 @@ func (s *InternalLBService) NewConfigureInternalLoadBalancerElementParams(enable
 
 // Configures an Internal Load Balancer element.
 func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newPostRequest("configureInternalLoadBalancerElement", p.toURLValues())
+	return s.ConfigureInternalLoadBalancerElementWithContext(context.Background(), p)
+}
+
+// ConfigureInternalLoadBalancerElementWithContext is like ConfigureInternalLoadBalancerElement, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "configureInternalLoadBalancerElement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r InternalLoadBalancerElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configureInternalLoadBalancerElement", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "configureInternalLoadBalancerElement", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("configureInternalLoadBalancerElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -152,12 +221,37 @@ func (s *InternalLBService) ConfigureInternalLoadBalancerElement(p *ConfigureInt
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ConfigureInternalLoadBalancerElement async job into r
+func (r *InternalLoadBalancerElementResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ConfigureInternalLoadBalancerElementAsync starts the async job of ConfigureInternalLoadBalancerElement without waiting for it to finish, and returns a handle to the job
+func (s *InternalLBService) ConfigureInternalLoadBalancerElementAsync(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*Job, error) {
+	r, err := s.ConfigureInternalLoadBalancerElementWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("configureInternalLoadBalancerElement", r.JobID), nil
+}
+
 type InternalLoadBalancerElementResponse struct {
-	Enabled   bool   `json:"enabled"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Nspid     string `json:"nspid"`
+	Enabled   FlexBool                   `json:"enabled"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Nspid     string                     `json:"nspid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *InternalLoadBalancerElementResponse) UnmarshalJSON(b []byte) error {
+	type alias InternalLoadBalancerElementResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateInternalLoadBalancerElementParams struct {
@@ -175,6 +269,44 @@ func (p *CreateInternalLoadBalancerElementParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createInternalLoadBalancerElement API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateInternalLoadBalancerElementParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createInternalLoadBalancerElement", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createInternalLoadBalancerElement API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateInternalLoadBalancerElementParams) Validate() error {
+	return paramRules{
+		required: []string{"nspid"},
+		lengths:  map[string]int{"nspid": 255},
+	}.validate("createInternalLoadBalancerElement", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createInternalLoadBalancerElement API, before the request is signed
+func (p *CreateInternalLoadBalancerElementParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateInternalLoadBalancerElementParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateInternalLoadBalancerElementParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createInternalLoadBalancerElement", b, &p.p, map[string]paramDecoder{
+		"nspid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateInternalLoadBalancerElementParams) Clone() *CreateInternalLoadBalancerElementParams {
+	return &CreateInternalLoadBalancerElementParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateInternalLoadBalancerElementParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -207,32 +339,39 @@ func (s *InternalLBService) NewCreateInternalLoadBalancerElementParams(nspid str
 
 // Create an Internal Load Balancer element.
 func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
-	resp, err := s.cs.newPostRequest("createInternalLoadBalancerElement", p.toURLValues())
+	return s.CreateInternalLoadBalancerElementWithContext(context.Background(), p)
+}
+
+// CreateInternalLoadBalancerElementWithContext is like CreateInternalLoadBalancerElement, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createInternalLoadBalancerElement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateInternalLoadBalancerElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createInternalLoadBalancerElement", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createInternalLoadBalancerElement", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createInternalLoadBalancerElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -240,12 +379,37 @@ func (s *InternalLBService) CreateInternalLoadBalancerElement(p *CreateInternalL
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateInternalLoadBalancerElement async job into r
+func (r *CreateInternalLoadBalancerElementResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateInternalLoadBalancerElementAsync starts the async job of CreateInternalLoadBalancerElement without waiting for it to finish, and returns a handle to the job
+func (s *InternalLBService) CreateInternalLoadBalancerElementAsync(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*Job, error) {
+	r, err := s.CreateInternalLoadBalancerElementWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createInternalLoadBalancerElement", r.JobID), nil
+}
+
 type CreateInternalLoadBalancerElementResponse struct {
-	Enabled   bool   `json:"enabled"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Nspid     string `json:"nspid"`
+	Enabled   FlexBool                   `json:"enabled"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Nspid     string                     `json:"nspid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateInternalLoadBalancerElementResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateInternalLoadBalancerElementResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListInternalLoadBalancerElementsParams struct {
@@ -281,6 +445,48 @@ func (p *ListInternalLoadBalancerElementsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listInternalLoadBalancerElements API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListInternalLoadBalancerElementsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listInternalLoadBalancerElements", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listInternalLoadBalancerElements API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListInternalLoadBalancerElementsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "nspid": 255},
+	}.validate("listInternalLoadBalancerElements", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listInternalLoadBalancerElements API, before the request is signed
+func (p *ListInternalLoadBalancerElementsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListInternalLoadBalancerElementsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListInternalLoadBalancerElementsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listInternalLoadBalancerElements", b, &p.p, map[string]paramDecoder{
+		"enabled":  decodeParam[bool],
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"nspid":    decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListInternalLoadBalancerElementsParams) Clone() *ListInternalLoadBalancerElementsParams {
+	return &ListInternalLoadBalancerElementsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListInternalLoadBalancerElementsParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -417,6 +623,11 @@ func (s *InternalLBService) NewListInternalLoadBalancerElementsParams() *ListInt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
+	return s.GetInternalLoadBalancerElementByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetInternalLoadBalancerElementByIDWithContext is like GetInternalLoadBalancerElementByID, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) GetInternalLoadBalancerElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
 	p := &ListInternalLoadBalancerElementsParams{}
 	p.p = make(map[string]interface{})
 
@@ -428,11 +639,10 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts .
 		}
 	}
 
-	l, err := s.ListInternalLoadBalancerElements(p)
+	l, err := s.ListInternalLoadBalancerElementsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -450,30 +660,73 @@ func (s *InternalLBService) GetInternalLoadBalancerElementByID(id string, opts .
 
 // Lists all available Internal Load Balancer elements.
 func (s *InternalLBService) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerElements", p.toURLValues())
+	return s.ListInternalLoadBalancerElementsWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerElementsWithContext is like ListInternalLoadBalancerElements, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listInternalLoadBalancerElements", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListInternalLoadBalancerElementsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listInternalLoadBalancerElements", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListInternalLoadBalancerElementsAll returns all InternalLoadBalancerElements matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *InternalLBService) ListInternalLoadBalancerElementsAll(p *ListInternalLoadBalancerElementsParams, opts ...PageOption) ([]*InternalLoadBalancerElement, error) {
+	return s.ListInternalLoadBalancerElementsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListInternalLoadBalancerElementsAllWithContext is like ListInternalLoadBalancerElementsAll, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) ListInternalLoadBalancerElementsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams, opts ...PageOption) ([]*InternalLoadBalancerElement, error) {
+	return collectPages(s.ListInternalLoadBalancerElementsIterWithContext(ctx, p, opts...))
+}
+
+// ListInternalLoadBalancerElementsIter returns an iterator over all InternalLoadBalancerElements matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *InternalLBService) ListInternalLoadBalancerElementsIter(p *ListInternalLoadBalancerElementsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerElement, error] {
+	return s.ListInternalLoadBalancerElementsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListInternalLoadBalancerElementsIterWithContext is like ListInternalLoadBalancerElementsIter, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) ListInternalLoadBalancerElementsIterWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerElement, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*InternalLoadBalancerElement, int, error) {
+		l, err := s.ListInternalLoadBalancerElementsWithContext(ctx, &ListInternalLoadBalancerElementsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.InternalLoadBalancerElements, l.Count, nil
+	}, opts...)
+}
+
 type ListInternalLoadBalancerElementsResponse struct {
 	Count                        int                            `json:"count"`
 	InternalLoadBalancerElements []*InternalLoadBalancerElement `json:"internalloadbalancerelement"`
 }
 
 type InternalLoadBalancerElement struct {
-	Enabled   bool   `json:"enabled"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Nspid     string `json:"nspid"`
+	Enabled   FlexBool                   `json:"enabled"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Nspid     string                     `json:"nspid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *InternalLoadBalancerElement) UnmarshalJSON(b []byte) error {
+	type alias InternalLoadBalancerElement
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListInternalLoadBalancerVMsParams struct {
@@ -548,6 +801,60 @@ func (p *ListInternalLoadBalancerVMsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listInternalLoadBalancerVMs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListInternalLoadBalancerVMsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listInternalLoadBalancerVMs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listInternalLoadBalancerVMs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListInternalLoadBalancerVMsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "hostid": 255, "id": 255, "keyword": 255, "name": 255, "networkid": 255, "podid": 255, "projectid": 255, "state": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listInternalLoadBalancerVMs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listInternalLoadBalancerVMs API, before the request is signed
+func (p *ListInternalLoadBalancerVMsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListInternalLoadBalancerVMsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListInternalLoadBalancerVMsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listInternalLoadBalancerVMs", b, &p.p, map[string]paramDecoder{
+		"account":                 decodeParam[string],
+		"domainid":                decodeParam[string],
+		"fetchhealthcheckresults": decodeParam[bool],
+		"forvpc":                  decodeParam[bool],
+		"hostid":                  decodeParam[string],
+		"id":                      decodeParam[string],
+		"isrecursive":             decodeParam[bool],
+		"keyword":                 decodeParam[string],
+		"listall":                 decodeParam[bool],
+		"name":                    decodeParam[string],
+		"networkid":               decodeParam[string],
+		"page":                    decodeParam[int],
+		"pagesize":                decodeParam[int],
+		"podid":                   decodeParam[string],
+		"projectid":               decodeParam[string],
+		"state":                   decodeParam[string],
+		"vpcid":                   decodeParam[string],
+		"zoneid":                  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListInternalLoadBalancerVMsParams) Clone() *ListInternalLoadBalancerVMsParams {
+	return &ListInternalLoadBalancerVMsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListInternalLoadBalancerVMsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -936,6 +1243,11 @@ func (s *InternalLBService) NewListInternalLoadBalancerVMsParams() *ListInternal
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetInternalLoadBalancerVMIDWithContext(context.Background(), name, opts...)
+}
+
+// GetInternalLoadBalancerVMIDWithContext is like GetInternalLoadBalancerVMID, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) GetInternalLoadBalancerVMIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
 	p.p = make(map[string]interface{})
 
@@ -947,7 +1259,7 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...Opt
 		}
 	}
 
-	l, err := s.ListInternalLoadBalancerVMs(p)
+	l, err := s.ListInternalLoadBalancerVMsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -972,12 +1284,17 @@ func (s *InternalLBService) GetInternalLoadBalancerVMID(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
-	id, count, err := s.GetInternalLoadBalancerVMID(name, opts...)
+	return s.GetInternalLoadBalancerVMByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetInternalLoadBalancerVMByNameWithContext is like GetInternalLoadBalancerVMByName, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) GetInternalLoadBalancerVMByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
+	id, count, err := s.GetInternalLoadBalancerVMIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetInternalLoadBalancerVMByID(id, opts...)
+	r, count, err := s.GetInternalLoadBalancerVMByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -986,6 +1303,11 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByName(name string, opts ..
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
+	return s.GetInternalLoadBalancerVMByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetInternalLoadBalancerVMByIDWithContext is like GetInternalLoadBalancerVMByID, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) GetInternalLoadBalancerVMByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
 	p := &ListInternalLoadBalancerVMsParams{}
 	p.p = make(map[string]interface{})
 
@@ -997,11 +1319,10 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...Opt
 		}
 	}
 
-	l, err := s.ListInternalLoadBalancerVMs(p)
+	l, err := s.ListInternalLoadBalancerVMsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1019,19 +1340,56 @@ func (s *InternalLBService) GetInternalLoadBalancerVMByID(id string, opts ...Opt
 
 // List internal LB Instances.
 func (s *InternalLBService) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
-	resp, err := s.cs.newRequest("listInternalLoadBalancerVMs", p.toURLValues())
+	return s.ListInternalLoadBalancerVMsWithContext(context.Background(), p)
+}
+
+// ListInternalLoadBalancerVMsWithContext is like ListInternalLoadBalancerVMs, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listInternalLoadBalancerVMs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListInternalLoadBalancerVMsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listInternalLoadBalancerVMs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListInternalLoadBalancerVMsAll returns all InternalLoadBalancerVMs matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *InternalLBService) ListInternalLoadBalancerVMsAll(p *ListInternalLoadBalancerVMsParams, opts ...PageOption) ([]*InternalLoadBalancerVM, error) {
+	return s.ListInternalLoadBalancerVMsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListInternalLoadBalancerVMsAllWithContext is like ListInternalLoadBalancerVMsAll, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) ListInternalLoadBalancerVMsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams, opts ...PageOption) ([]*InternalLoadBalancerVM, error) {
+	return collectPages(s.ListInternalLoadBalancerVMsIterWithContext(ctx, p, opts...))
+}
+
+// ListInternalLoadBalancerVMsIter returns an iterator over all InternalLoadBalancerVMs matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *InternalLBService) ListInternalLoadBalancerVMsIter(p *ListInternalLoadBalancerVMsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerVM, error] {
+	return s.ListInternalLoadBalancerVMsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListInternalLoadBalancerVMsIterWithContext is like ListInternalLoadBalancerVMsIter, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) ListInternalLoadBalancerVMsIterWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerVM, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*InternalLoadBalancerVM, int, error) {
+		l, err := s.ListInternalLoadBalancerVMsWithContext(ctx, &ListInternalLoadBalancerVMsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.InternalLoadBalancerVMs, l.Count, nil
+	}, opts...)
+}
+
 type ListInternalLoadBalancerVMsResponse struct {
 	Count                   int                       `json:"count"`
 	InternalLoadBalancerVMs []*InternalLoadBalancerVM `json:"internalloadbalancervm"`
@@ -1040,7 +1398,7 @@ type ListInternalLoadBalancerVMsResponse struct {
 type InternalLoadBalancerVM struct {
 	Account             string                                     `json:"account"`
 	Arch                string                                     `json:"arch"`
-	Created             string                                     `json:"created"`
+	Created             Time                                       `json:"created"`
 	Dns1                string                                     `json:"dns1"`
 	Dns2                string                                     `json:"dns2"`
 	Domain              string                                     `json:"domain"`
@@ -1052,9 +1410,9 @@ type InternalLoadBalancerVM struct {
 	Guestnetmask        string                                     `json:"guestnetmask"`
 	Guestnetworkid      string                                     `json:"guestnetworkid"`
 	Guestnetworkname    string                                     `json:"guestnetworkname"`
-	Hasannotations      bool                                       `json:"hasannotations"`
+	Hasannotations      FlexBool                                   `json:"hasannotations"`
 	Healthcheckresults  []InternalLoadBalancerVMHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                       `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                   `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                     `json:"hostcontrolstate"`
 	Hostid              string                                     `json:"hostid"`
 	Hostname            string                                     `json:"hostname"`
@@ -1062,9 +1420,9 @@ type InternalLoadBalancerVM struct {
 	Id                  string                                     `json:"id"`
 	Ip6dns1             string                                     `json:"ip6dns1"`
 	Ip6dns2             string                                     `json:"ip6dns2"`
-	Isredundantrouter   bool                                       `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                   `json:"isredundantrouter"`
 	JobID               string                                     `json:"jobid"`
-	Jobstatus           int                                        `json:"jobstatus"`
+	Jobstatus           FlexInt                                    `json:"jobstatus"`
 	Linklocalip         string                                     `json:"linklocalip"`
 	Linklocalmacaddress string                                     `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                     `json:"linklocalnetmask"`
@@ -1081,7 +1439,7 @@ type InternalLoadBalancerVM struct {
 	Publicnetmask       string                                     `json:"publicnetmask"`
 	Publicnetworkid     string                                     `json:"publicnetworkid"`
 	Redundantstate      string                                     `json:"redundantstate"`
-	Requiresupgrade     bool                                       `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                   `json:"requiresupgrade"`
 	Role                string                                     `json:"role"`
 	Scriptsversion      string                                     `json:"scriptsversion"`
 	Serviceofferingid   string                                     `json:"serviceofferingid"`
@@ -1095,15 +1453,27 @@ type InternalLoadBalancerVM struct {
 	Vpcname             string                                     `json:"vpcname"`
 	Zoneid              string                                     `json:"zoneid"`
 	Zonename            string                                     `json:"zonename"`
+	Extra               map[string]json.RawMessage                 `json:"-"`
+}
+
+func (r *InternalLoadBalancerVM) UnmarshalJSON(b []byte) error {
+	type alias InternalLoadBalancerVM
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type InternalLoadBalancerVMHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *InternalLoadBalancerVMHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias InternalLoadBalancerVMHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartInternalLoadBalancerVMParams struct {
@@ -1121,6 +1491,44 @@ func (p *StartInternalLoadBalancerVMParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the startInternalLoadBalancerVM API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StartInternalLoadBalancerVMParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("startInternalLoadBalancerVM", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the startInternalLoadBalancerVM API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StartInternalLoadBalancerVMParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("startInternalLoadBalancerVM", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the startInternalLoadBalancerVM API, before the request is signed
+func (p *StartInternalLoadBalancerVMParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StartInternalLoadBalancerVMParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StartInternalLoadBalancerVMParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("startInternalLoadBalancerVM", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StartInternalLoadBalancerVMParams) Clone() *StartInternalLoadBalancerVMParams {
+	return &StartInternalLoadBalancerVMParams{p: cloneParams(p.p)}
+}
+
 func (p *StartInternalLoadBalancerVMParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1153,32 +1561,39 @@ func (s *InternalLBService) NewStartInternalLoadBalancerVMParams(id string) *Sta
 
 // Starts an existing Internal LB Instance.
 func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newPostRequest("startInternalLoadBalancerVM", p.toURLValues())
+	return s.StartInternalLoadBalancerVMWithContext(context.Background(), p)
+}
+
+// StartInternalLoadBalancerVMWithContext is like StartInternalLoadBalancerVM, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) StartInternalLoadBalancerVMWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "startInternalLoadBalancerVM", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StartInternalLoadBalancerVMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("startInternalLoadBalancerVM", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "startInternalLoadBalancerVM", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("startInternalLoadBalancerVM", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1186,10 +1601,29 @@ func (s *InternalLBService) StartInternalLoadBalancerVM(p *StartInternalLoadBala
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StartInternalLoadBalancerVM async job into r
+func (r *StartInternalLoadBalancerVMResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StartInternalLoadBalancerVMAsync starts the async job of StartInternalLoadBalancerVM without waiting for it to finish, and returns a handle to the job
+func (s *InternalLBService) StartInternalLoadBalancerVMAsync(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*Job, error) {
+	r, err := s.StartInternalLoadBalancerVMWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("startInternalLoadBalancerVM", r.JobID), nil
+}
+
 type StartInternalLoadBalancerVMResponse struct {
 	Account             string                                                  `json:"account"`
 	Arch                string                                                  `json:"arch"`
-	Created             string                                                  `json:"created"`
+	Created             Time                                                    `json:"created"`
 	Dns1                string                                                  `json:"dns1"`
 	Dns2                string                                                  `json:"dns2"`
 	Domain              string                                                  `json:"domain"`
@@ -1201,9 +1635,9 @@ type StartInternalLoadBalancerVMResponse struct {
 	Guestnetmask        string                                                  `json:"guestnetmask"`
 	Guestnetworkid      string                                                  `json:"guestnetworkid"`
 	Guestnetworkname    string                                                  `json:"guestnetworkname"`
-	Hasannotations      bool                                                    `json:"hasannotations"`
+	Hasannotations      FlexBool                                                `json:"hasannotations"`
 	Healthcheckresults  []StartInternalLoadBalancerVMResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                                    `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                                `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                                  `json:"hostcontrolstate"`
 	Hostid              string                                                  `json:"hostid"`
 	Hostname            string                                                  `json:"hostname"`
@@ -1211,9 +1645,9 @@ type StartInternalLoadBalancerVMResponse struct {
 	Id                  string                                                  `json:"id"`
 	Ip6dns1             string                                                  `json:"ip6dns1"`
 	Ip6dns2             string                                                  `json:"ip6dns2"`
-	Isredundantrouter   bool                                                    `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                                `json:"isredundantrouter"`
 	JobID               string                                                  `json:"jobid"`
-	Jobstatus           int                                                     `json:"jobstatus"`
+	Jobstatus           FlexInt                                                 `json:"jobstatus"`
 	Linklocalip         string                                                  `json:"linklocalip"`
 	Linklocalmacaddress string                                                  `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                                  `json:"linklocalnetmask"`
@@ -1230,7 +1664,7 @@ type StartInternalLoadBalancerVMResponse struct {
 	Publicnetmask       string                                                  `json:"publicnetmask"`
 	Publicnetworkid     string                                                  `json:"publicnetworkid"`
 	Redundantstate      string                                                  `json:"redundantstate"`
-	Requiresupgrade     bool                                                    `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                                `json:"requiresupgrade"`
 	Role                string                                                  `json:"role"`
 	Scriptsversion      string                                                  `json:"scriptsversion"`
 	Serviceofferingid   string                                                  `json:"serviceofferingid"`
@@ -1244,15 +1678,27 @@ type StartInternalLoadBalancerVMResponse struct {
 	Vpcname             string                                                  `json:"vpcname"`
 	Zoneid              string                                                  `json:"zoneid"`
 	Zonename            string                                                  `json:"zonename"`
+	Extra               map[string]json.RawMessage                              `json:"-"`
+}
+
+func (r *StartInternalLoadBalancerVMResponse) UnmarshalJSON(b []byte) error {
+	type alias StartInternalLoadBalancerVMResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartInternalLoadBalancerVMResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartInternalLoadBalancerVMResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias StartInternalLoadBalancerVMResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopInternalLoadBalancerVMParams struct {
@@ -1274,6 +1720,45 @@ func (p *StopInternalLoadBalancerVMParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the stopInternalLoadBalancerVM API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StopInternalLoadBalancerVMParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("stopInternalLoadBalancerVM", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the stopInternalLoadBalancerVM API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StopInternalLoadBalancerVMParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("stopInternalLoadBalancerVM", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the stopInternalLoadBalancerVM API, before the request is signed
+func (p *StopInternalLoadBalancerVMParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StopInternalLoadBalancerVMParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StopInternalLoadBalancerVMParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("stopInternalLoadBalancerVM", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StopInternalLoadBalancerVMParams) Clone() *StopInternalLoadBalancerVMParams {
+	return &StopInternalLoadBalancerVMParams{p: cloneParams(p.p)}
+}
+
 func (p *StopInternalLoadBalancerVMParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1327,32 +1812,39 @@ func (s *InternalLBService) NewStopInternalLoadBalancerVMParams(id string) *Stop
 
 // Stops an Internal LB Instance.
 func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
-	resp, err := s.cs.newPostRequest("stopInternalLoadBalancerVM", p.toURLValues())
+	return s.StopInternalLoadBalancerVMWithContext(context.Background(), p)
+}
+
+// StopInternalLoadBalancerVMWithContext is like StopInternalLoadBalancerVM, but honours the cancellation and deadline of ctx
+func (s *InternalLBService) StopInternalLoadBalancerVMWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "stopInternalLoadBalancerVM", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StopInternalLoadBalancerVMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("stopInternalLoadBalancerVM", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "stopInternalLoadBalancerVM", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("stopInternalLoadBalancerVM", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1360,10 +1852,29 @@ func (s *InternalLBService) StopInternalLoadBalancerVM(p *StopInternalLoadBalanc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StopInternalLoadBalancerVM async job into r
+func (r *StopInternalLoadBalancerVMResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StopInternalLoadBalancerVMAsync starts the async job of StopInternalLoadBalancerVM without waiting for it to finish, and returns a handle to the job
+func (s *InternalLBService) StopInternalLoadBalancerVMAsync(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*Job, error) {
+	r, err := s.StopInternalLoadBalancerVMWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("stopInternalLoadBalancerVM", r.JobID), nil
+}
+
 type StopInternalLoadBalancerVMResponse struct {
 	Account             string                                                 `json:"account"`
 	Arch                string                                                 `json:"arch"`
-	Created             string                                                 `json:"created"`
+	Created             Time                                                   `json:"created"`
 	Dns1                string                                                 `json:"dns1"`
 	Dns2                string                                                 `json:"dns2"`
 	Domain              string                                                 `json:"domain"`
@@ -1375,9 +1886,9 @@ type StopInternalLoadBalancerVMResponse struct {
 	Guestnetmask        string                                                 `json:"guestnetmask"`
 	Guestnetworkid      string                                                 `json:"guestnetworkid"`
 	Guestnetworkname    string                                                 `json:"guestnetworkname"`
-	Hasannotations      bool                                                   `json:"hasannotations"`
+	Hasannotations      FlexBool                                               `json:"hasannotations"`
 	Healthcheckresults  []StopInternalLoadBalancerVMResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                                   `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                               `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                                 `json:"hostcontrolstate"`
 	Hostid              string                                                 `json:"hostid"`
 	Hostname            string                                                 `json:"hostname"`
@@ -1385,9 +1896,9 @@ type StopInternalLoadBalancerVMResponse struct {
 	Id                  string                                                 `json:"id"`
 	Ip6dns1             string                                                 `json:"ip6dns1"`
 	Ip6dns2             string                                                 `json:"ip6dns2"`
-	Isredundantrouter   bool                                                   `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                               `json:"isredundantrouter"`
 	JobID               string                                                 `json:"jobid"`
-	Jobstatus           int                                                    `json:"jobstatus"`
+	Jobstatus           FlexInt                                                `json:"jobstatus"`
 	Linklocalip         string                                                 `json:"linklocalip"`
 	Linklocalmacaddress string                                                 `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                                 `json:"linklocalnetmask"`
@@ -1404,7 +1915,7 @@ type StopInternalLoadBalancerVMResponse struct {
 	Publicnetmask       string                                                 `json:"publicnetmask"`
 	Publicnetworkid     string                                                 `json:"publicnetworkid"`
 	Redundantstate      string                                                 `json:"redundantstate"`
-	Requiresupgrade     bool                                                   `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                               `json:"requiresupgrade"`
 	Role                string                                                 `json:"role"`
 	Scriptsversion      string                                                 `json:"scriptsversion"`
 	Serviceofferingid   string                                                 `json:"serviceofferingid"`
@@ -1418,13 +1929,25 @@ type StopInternalLoadBalancerVMResponse struct {
 	Vpcname             string                                                 `json:"vpcname"`
 	Zoneid              string                                                 `json:"zoneid"`
 	Zonename            string                                                 `json:"zonename"`
+	Extra               map[string]json.RawMessage                             `json:"-"`
+}
+
+func (r *StopInternalLoadBalancerVMResponse) UnmarshalJSON(b []byte) error {
+	type alias StopInternalLoadBalancerVMResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopInternalLoadBalancerVMResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopInternalLoadBalancerVMResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias StopInternalLoadBalancerVMResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
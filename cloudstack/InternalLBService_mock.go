@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) ConfigureInternalLoadBalancerE
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureInternalLoadBalancerElement", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ConfigureInternalLoadBalancerElement), p)
 }
 
+// ConfigureInternalLoadBalancerElementAsync mocks base method.
+func (m *MockInternalLBServiceIface) ConfigureInternalLoadBalancerElementAsync(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureInternalLoadBalancerElementAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureInternalLoadBalancerElementAsync indicates an expected call of ConfigureInternalLoadBalancerElementAsync.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ConfigureInternalLoadBalancerElementAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureInternalLoadBalancerElementAsync", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ConfigureInternalLoadBalancerElementAsync), ctx, p)
+}
+
+// ConfigureInternalLoadBalancerElementWithContext mocks base method.
+func (m *MockInternalLBServiceIface) ConfigureInternalLoadBalancerElementWithContext(ctx context.Context, p *ConfigureInternalLoadBalancerElementParams) (*InternalLoadBalancerElementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureInternalLoadBalancerElementWithContext", ctx, p)
+	ret0, _ := ret[0].(*InternalLoadBalancerElementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureInternalLoadBalancerElementWithContext indicates an expected call of ConfigureInternalLoadBalancerElementWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ConfigureInternalLoadBalancerElementWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureInternalLoadBalancerElementWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ConfigureInternalLoadBalancerElementWithContext), ctx, p)
+}
+
 // CreateInternalLoadBalancerElement mocks base method.
 func (m *MockInternalLBServiceIface) CreateInternalLoadBalancerElement(p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) CreateInternalLoadBalancerElem
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInternalLoadBalancerElement", reflect.TypeOf((*MockInternalLBServiceIface)(nil).CreateInternalLoadBalancerElement), p)
 }
 
+// CreateInternalLoadBalancerElementAsync mocks base method.
+func (m *MockInternalLBServiceIface) CreateInternalLoadBalancerElementAsync(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInternalLoadBalancerElementAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInternalLoadBalancerElementAsync indicates an expected call of CreateInternalLoadBalancerElementAsync.
+func (mr *MockInternalLBServiceIfaceMockRecorder) CreateInternalLoadBalancerElementAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInternalLoadBalancerElementAsync", reflect.TypeOf((*MockInternalLBServiceIface)(nil).CreateInternalLoadBalancerElementAsync), ctx, p)
+}
+
+// CreateInternalLoadBalancerElementWithContext mocks base method.
+func (m *MockInternalLBServiceIface) CreateInternalLoadBalancerElementWithContext(ctx context.Context, p *CreateInternalLoadBalancerElementParams) (*CreateInternalLoadBalancerElementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInternalLoadBalancerElementWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateInternalLoadBalancerElementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInternalLoadBalancerElementWithContext indicates an expected call of CreateInternalLoadBalancerElementWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) CreateInternalLoadBalancerElementWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInternalLoadBalancerElementWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).CreateInternalLoadBalancerElementWithContext), ctx, p)
+}
+
 // GetInternalLoadBalancerElementByID mocks base method.
 func (m *MockInternalLBServiceIface) GetInternalLoadBalancerElementByID(id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +171,27 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerElement
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerElementByID", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerElementByID), varargs...)
 }
 
+// GetInternalLoadBalancerElementByIDWithContext mocks base method.
+func (m *MockInternalLBServiceIface) GetInternalLoadBalancerElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerElement, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInternalLoadBalancerElementByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*InternalLoadBalancerElement)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInternalLoadBalancerElementByIDWithContext indicates an expected call of GetInternalLoadBalancerElementByIDWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerElementByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerElementByIDWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerElementByIDWithContext), varargs...)
+}
+
 // GetInternalLoadBalancerVMByID mocks base method.
 func (m *MockInternalLBServiceIface) GetInternalLoadBalancerVMByID(id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +213,27 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerVMByID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerVMByID", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerVMByID), varargs...)
 }
 
+// GetInternalLoadBalancerVMByIDWithContext mocks base method.
+func (m *MockInternalLBServiceIface) GetInternalLoadBalancerVMByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInternalLoadBalancerVMByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*InternalLoadBalancerVM)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInternalLoadBalancerVMByIDWithContext indicates an expected call of GetInternalLoadBalancerVMByIDWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerVMByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerVMByIDWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerVMByIDWithContext), varargs...)
+}
+
 // GetInternalLoadBalancerVMByName mocks base method.
 func (m *MockInternalLBServiceIface) GetInternalLoadBalancerVMByName(name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +255,27 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerVMByNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerVMByName", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerVMByName), varargs...)
 }
 
+// GetInternalLoadBalancerVMByNameWithContext mocks base method.
+func (m *MockInternalLBServiceIface) GetInternalLoadBalancerVMByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InternalLoadBalancerVM, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInternalLoadBalancerVMByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*InternalLoadBalancerVM)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInternalLoadBalancerVMByNameWithContext indicates an expected call of GetInternalLoadBalancerVMByNameWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerVMByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerVMByNameWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerVMByNameWithContext), varargs...)
+}
+
 // GetInternalLoadBalancerVMID mocks base method.
 func (m *MockInternalLBServiceIface) GetInternalLoadBalancerVMID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +297,27 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerVMID(na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerVMID", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerVMID), varargs...)
 }
 
+// GetInternalLoadBalancerVMIDWithContext mocks base method.
+func (m *MockInternalLBServiceIface) GetInternalLoadBalancerVMIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInternalLoadBalancerVMIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInternalLoadBalancerVMIDWithContext indicates an expected call of GetInternalLoadBalancerVMIDWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) GetInternalLoadBalancerVMIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInternalLoadBalancerVMIDWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).GetInternalLoadBalancerVMIDWithContext), varargs...)
+}
+
 // ListInternalLoadBalancerElements mocks base method.
 func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElements(p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
 	m.ctrl.T.Helper()
@@ -187,6 +333,99 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElemen
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElements", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElements), p)
 }
 
+// ListInternalLoadBalancerElementsAll mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElementsAll(p *ListInternalLoadBalancerElementsParams, opts ...PageOption) ([]*InternalLoadBalancerElement, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerElementsAll", varargs...)
+	ret0, _ := ret[0].([]*InternalLoadBalancerElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInternalLoadBalancerElementsAll indicates an expected call of ListInternalLoadBalancerElementsAll.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElementsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElementsAll", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElementsAll), varargs...)
+}
+
+// ListInternalLoadBalancerElementsAllWithContext mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElementsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams, opts ...PageOption) ([]*InternalLoadBalancerElement, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerElementsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*InternalLoadBalancerElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInternalLoadBalancerElementsAllWithContext indicates an expected call of ListInternalLoadBalancerElementsAllWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElementsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElementsAllWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElementsAllWithContext), varargs...)
+}
+
+// ListInternalLoadBalancerElementsIter mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElementsIter(p *ListInternalLoadBalancerElementsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerElement, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerElementsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*InternalLoadBalancerElement, error])
+	return ret0
+}
+
+// ListInternalLoadBalancerElementsIter indicates an expected call of ListInternalLoadBalancerElementsIter.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElementsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElementsIter", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElementsIter), varargs...)
+}
+
+// ListInternalLoadBalancerElementsIterWithContext mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElementsIterWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerElement, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerElementsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*InternalLoadBalancerElement, error])
+	return ret0
+}
+
+// ListInternalLoadBalancerElementsIterWithContext indicates an expected call of ListInternalLoadBalancerElementsIterWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElementsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElementsIterWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElementsIterWithContext), varargs...)
+}
+
+// ListInternalLoadBalancerElementsWithContext mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerElementsWithContext(ctx context.Context, p *ListInternalLoadBalancerElementsParams) (*ListInternalLoadBalancerElementsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerElementsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListInternalLoadBalancerElementsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInternalLoadBalancerElementsWithContext indicates an expected call of ListInternalLoadBalancerElementsWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerElementsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerElementsWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerElementsWithContext), ctx, p)
+}
+
 // ListInternalLoadBalancerVMs mocks base method.
 func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMs(p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +441,99 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMs(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMs", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMs), p)
 }
 
+// ListInternalLoadBalancerVMsAll mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMsAll(p *ListInternalLoadBalancerVMsParams, opts ...PageOption) ([]*InternalLoadBalancerVM, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerVMsAll", varargs...)
+	ret0, _ := ret[0].([]*InternalLoadBalancerVM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInternalLoadBalancerVMsAll indicates an expected call of ListInternalLoadBalancerVMsAll.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMsAll", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMsAll), varargs...)
+}
+
+// ListInternalLoadBalancerVMsAllWithContext mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMsAllWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams, opts ...PageOption) ([]*InternalLoadBalancerVM, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerVMsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*InternalLoadBalancerVM)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInternalLoadBalancerVMsAllWithContext indicates an expected call of ListInternalLoadBalancerVMsAllWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMsAllWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMsAllWithContext), varargs...)
+}
+
+// ListInternalLoadBalancerVMsIter mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMsIter(p *ListInternalLoadBalancerVMsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerVM, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerVMsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*InternalLoadBalancerVM, error])
+	return ret0
+}
+
+// ListInternalLoadBalancerVMsIter indicates an expected call of ListInternalLoadBalancerVMsIter.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMsIter", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMsIter), varargs...)
+}
+
+// ListInternalLoadBalancerVMsIterWithContext mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMsIterWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams, opts ...PageOption) iter.Seq2[*InternalLoadBalancerVM, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerVMsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*InternalLoadBalancerVM, error])
+	return ret0
+}
+
+// ListInternalLoadBalancerVMsIterWithContext indicates an expected call of ListInternalLoadBalancerVMsIterWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMsIterWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMsIterWithContext), varargs...)
+}
+
+// ListInternalLoadBalancerVMsWithContext mocks base method.
+func (m *MockInternalLBServiceIface) ListInternalLoadBalancerVMsWithContext(ctx context.Context, p *ListInternalLoadBalancerVMsParams) (*ListInternalLoadBalancerVMsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInternalLoadBalancerVMsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListInternalLoadBalancerVMsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInternalLoadBalancerVMsWithContext indicates an expected call of ListInternalLoadBalancerVMsWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) ListInternalLoadBalancerVMsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInternalLoadBalancerVMsWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).ListInternalLoadBalancerVMsWithContext), ctx, p)
+}
+
 // NewConfigureInternalLoadBalancerElementParams mocks base method.
 func (m *MockInternalLBServiceIface) NewConfigureInternalLoadBalancerElementParams(enabled bool, id string) *ConfigureInternalLoadBalancerElementParams {
 	m.ctrl.T.Helper()
@@ -301,6 +633,36 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) StartInternalLoadBalancerVM(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInternalLoadBalancerVM", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StartInternalLoadBalancerVM), p)
 }
 
+// StartInternalLoadBalancerVMAsync mocks base method.
+func (m *MockInternalLBServiceIface) StartInternalLoadBalancerVMAsync(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartInternalLoadBalancerVMAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartInternalLoadBalancerVMAsync indicates an expected call of StartInternalLoadBalancerVMAsync.
+func (mr *MockInternalLBServiceIfaceMockRecorder) StartInternalLoadBalancerVMAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInternalLoadBalancerVMAsync", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StartInternalLoadBalancerVMAsync), ctx, p)
+}
+
+// StartInternalLoadBalancerVMWithContext mocks base method.
+func (m *MockInternalLBServiceIface) StartInternalLoadBalancerVMWithContext(ctx context.Context, p *StartInternalLoadBalancerVMParams) (*StartInternalLoadBalancerVMResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartInternalLoadBalancerVMWithContext", ctx, p)
+	ret0, _ := ret[0].(*StartInternalLoadBalancerVMResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartInternalLoadBalancerVMWithContext indicates an expected call of StartInternalLoadBalancerVMWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) StartInternalLoadBalancerVMWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartInternalLoadBalancerVMWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StartInternalLoadBalancerVMWithContext), ctx, p)
+}
+
 // StopInternalLoadBalancerVM mocks base method.
 func (m *MockInternalLBServiceIface) StopInternalLoadBalancerVM(p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
 	m.ctrl.T.Helper()
@@ -315,3 +677,33 @@ func (mr *MockInternalLBServiceIfaceMockRecorder) StopInternalLoadBalancerVM(p a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInternalLoadBalancerVM", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StopInternalLoadBalancerVM), p)
 }
+
+// StopInternalLoadBalancerVMAsync mocks base method.
+func (m *MockInternalLBServiceIface) StopInternalLoadBalancerVMAsync(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopInternalLoadBalancerVMAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopInternalLoadBalancerVMAsync indicates an expected call of StopInternalLoadBalancerVMAsync.
+func (mr *MockInternalLBServiceIfaceMockRecorder) StopInternalLoadBalancerVMAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInternalLoadBalancerVMAsync", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StopInternalLoadBalancerVMAsync), ctx, p)
+}
+
+// StopInternalLoadBalancerVMWithContext mocks base method.
+func (m *MockInternalLBServiceIface) StopInternalLoadBalancerVMWithContext(ctx context.Context, p *StopInternalLoadBalancerVMParams) (*StopInternalLoadBalancerVMResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopInternalLoadBalancerVMWithContext", ctx, p)
+	ret0, _ := ret[0].(*StopInternalLoadBalancerVMResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopInternalLoadBalancerVMWithContext indicates an expected call of StopInternalLoadBalancerVMWithContext.
+func (mr *MockInternalLBServiceIfaceMockRecorder) StopInternalLoadBalancerVMWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopInternalLoadBalancerVMWithContext", reflect.TypeOf((*MockInternalLBServiceIface)(nil).StopInternalLoadBalancerVMWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,44 +31,84 @@ import (
 
 type KubernetesServiceIface interface {
 	AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error)
+	AddKubernetesSupportedVersionWithContext(ctx context.Context, p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error)
 	NewAddKubernetesSupportedVersionParams(mincpunumber int, minmemory int, semanticversion string) *AddKubernetesSupportedVersionParams
 	CreateKubernetesCluster(p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error)
+	CreateKubernetesClusterWithContext(ctx context.Context, p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error)
+	CreateKubernetesClusterAsync(ctx context.Context, p *CreateKubernetesClusterParams) (*Job, error)
 	NewCreateKubernetesClusterParams(description string, kubernetesversionid string, name string, serviceofferingid string, size int64, zoneid string) *CreateKubernetesClusterParams
 	DeleteKubernetesCluster(p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error)
+	DeleteKubernetesClusterWithContext(ctx context.Context, p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error)
+	DeleteKubernetesClusterAsync(ctx context.Context, p *DeleteKubernetesClusterParams) (*Job, error)
 	NewDeleteKubernetesClusterParams(id string) *DeleteKubernetesClusterParams
 	DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error)
+	DeleteKubernetesSupportedVersionWithContext(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error)
+	DeleteKubernetesSupportedVersionAsync(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*Job, error)
 	NewDeleteKubernetesSupportedVersionParams(id string) *DeleteKubernetesSupportedVersionParams
 	GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error)
+	GetKubernetesClusterConfigWithContext(ctx context.Context, p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error)
 	NewGetKubernetesClusterConfigParams() *GetKubernetesClusterConfigParams
 	ListKubernetesClusters(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error)
+	ListKubernetesClustersWithContext(ctx context.Context, p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error)
+	ListKubernetesClustersAll(p *ListKubernetesClustersParams, opts ...PageOption) ([]*KubernetesCluster, error)
+	ListKubernetesClustersAllWithContext(ctx context.Context, p *ListKubernetesClustersParams, opts ...PageOption) ([]*KubernetesCluster, error)
+	ListKubernetesClustersIter(p *ListKubernetesClustersParams, opts ...PageOption) iter.Seq2[*KubernetesCluster, error]
+	ListKubernetesClustersIterWithContext(ctx context.Context, p *ListKubernetesClustersParams, opts ...PageOption) iter.Seq2[*KubernetesCluster, error]
 	NewListKubernetesClustersParams() *ListKubernetesClustersParams
 	GetKubernetesClusterID(name string, opts ...OptionFunc) (string, int, error)
+	GetKubernetesClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetKubernetesClusterByName(name string, opts ...OptionFunc) (*KubernetesCluster, int, error)
+	GetKubernetesClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesCluster, int, error)
 	GetKubernetesClusterByID(id string, opts ...OptionFunc) (*KubernetesCluster, int, error)
+	GetKubernetesClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesCluster, int, error)
 	ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error)
+	ListKubernetesSupportedVersionsWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error)
+	ListKubernetesSupportedVersionsAll(p *ListKubernetesSupportedVersionsParams, opts ...PageOption) ([]*KubernetesSupportedVersion, error)
+	ListKubernetesSupportedVersionsAllWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams, opts ...PageOption) ([]*KubernetesSupportedVersion, error)
+	ListKubernetesSupportedVersionsIter(p *ListKubernetesSupportedVersionsParams, opts ...PageOption) iter.Seq2[*KubernetesSupportedVersion, error]
+	ListKubernetesSupportedVersionsIterWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams, opts ...PageOption) iter.Seq2[*KubernetesSupportedVersion, error]
 	NewListKubernetesSupportedVersionsParams() *ListKubernetesSupportedVersionsParams
 	GetKubernetesSupportedVersionID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetKubernetesSupportedVersionIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetKubernetesSupportedVersionByName(name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
 	GetKubernetesSupportedVersionByID(id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
+	GetKubernetesSupportedVersionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error)
 	ScaleKubernetesCluster(p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error)
+	ScaleKubernetesClusterWithContext(ctx context.Context, p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error)
+	ScaleKubernetesClusterAsync(ctx context.Context, p *ScaleKubernetesClusterParams) (*Job, error)
 	NewScaleKubernetesClusterParams(id string) *ScaleKubernetesClusterParams
 	StartKubernetesCluster(p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error)
+	StartKubernetesClusterWithContext(ctx context.Context, p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error)
+	StartKubernetesClusterAsync(ctx context.Context, p *StartKubernetesClusterParams) (*Job, error)
 	NewStartKubernetesClusterParams(id string) *StartKubernetesClusterParams
 	StopKubernetesCluster(p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error)
+	StopKubernetesClusterWithContext(ctx context.Context, p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error)
+	StopKubernetesClusterAsync(ctx context.Context, p *StopKubernetesClusterParams) (*Job, error)
 	NewStopKubernetesClusterParams(id string) *StopKubernetesClusterParams
 	UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error)
+	UpdateKubernetesSupportedVersionWithContext(ctx context.Context, p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error)
 	NewUpdateKubernetesSupportedVersionParams(id string, state string) *UpdateKubernetesSupportedVersionParams
 	UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error)
+	UpgradeKubernetesClusterWithContext(ctx context.Context, p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error)
+	UpgradeKubernetesClusterAsync(ctx context.Context, p *UpgradeKubernetesClusterParams) (*Job, error)
 	NewUpgradeKubernetesClusterParams(id string, kubernetesversionid string) *UpgradeKubernetesClusterParams
 	AddVirtualMachinesToKubernetesCluster(p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error)
+	AddVirtualMachinesToKubernetesClusterWithContext(ctx context.Context, p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error)
 	NewAddVirtualMachinesToKubernetesClusterParams(id string, virtualmachineids []string) *AddVirtualMachinesToKubernetesClusterParams
 	RemoveVirtualMachinesFromKubernetesCluster(p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error)
+	RemoveVirtualMachinesFromKubernetesClusterWithContext(ctx context.Context, p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error)
 	NewRemoveVirtualMachinesFromKubernetesClusterParams(id string, virtualmachineids []string) *RemoveVirtualMachinesFromKubernetesClusterParams
 	AddNodesToKubernetesCluster(p *AddNodesToKubernetesClusterParams) (*AddNodesToKubernetesClusterResponse, error)
+	AddNodesToKubernetesClusterWithContext(ctx context.Context, p *AddNodesToKubernetesClusterParams) (*AddNodesToKubernetesClusterResponse, error)
+	AddNodesToKubernetesClusterAsync(ctx context.Context, p *AddNodesToKubernetesClusterParams) (*Job, error)
 	NewAddNodesToKubernetesClusterParams(id string, nodeids []string) *AddNodesToKubernetesClusterParams
 	RemoveNodesFromKubernetesCluster(p *RemoveNodesFromKubernetesClusterParams) (*RemoveNodesFromKubernetesClusterResponse, error)
+	RemoveNodesFromKubernetesClusterWithContext(ctx context.Context, p *RemoveNodesFromKubernetesClusterParams) (*RemoveNodesFromKubernetesClusterResponse, error)
+	RemoveNodesFromKubernetesClusterAsync(ctx context.Context, p *RemoveNodesFromKubernetesClusterParams) (*Job, error)
 	NewRemoveNodesFromKubernetesClusterParams(id string, nodeids []string) *RemoveNodesFromKubernetesClusterParams
 	GetUploadParamsForKubernetesSupportedVersion(p *GetUploadParamsForKubernetesSupportedVersionParams) (*GetUploadParamsForKubernetesSupportedVersionResponse, error)
+	GetUploadParamsForKubernetesSupportedVersionWithContext(ctx context.Context, p *GetUploadParamsForKubernetesSupportedVersionParams) (*GetUploadParamsForKubernetesSupportedVersionResponse, error)
 	NewGetUploadParamsForKubernetesSupportedVersionParams(format string, mincpunumber int, minmemory int, name string, semanticversion string, zoneid string) *GetUploadParamsForKubernetesSupportedVersionParams
 }
 
@@ -112,6 +154,52 @@ func (p *AddKubernetesSupportedVersionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addKubernetesSupportedVersion API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddKubernetesSupportedVersionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addKubernetesSupportedVersion", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addKubernetesSupportedVersion API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddKubernetesSupportedVersionParams) Validate() error {
+	return paramRules{
+		required: []string{"mincpunumber", "minmemory", "semanticversion"},
+		lengths:  map[string]int{"arch": 255, "checksum": 255, "name": 255, "semanticversion": 255, "url": 2048, "zoneid": 255},
+	}.validate("addKubernetesSupportedVersion", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addKubernetesSupportedVersion API, before the request is signed
+func (p *AddKubernetesSupportedVersionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addKubernetesSupportedVersion", b, &p.p, map[string]paramDecoder{
+		"arch":            decodeParam[string],
+		"checksum":        decodeParam[string],
+		"directdownload":  decodeParam[bool],
+		"mincpunumber":    decodeParam[int],
+		"minmemory":       decodeParam[int],
+		"name":            decodeParam[string],
+		"semanticversion": decodeParam[string],
+		"url":             decodeParam[string],
+		"zoneid":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddKubernetesSupportedVersionParams) Clone() *AddKubernetesSupportedVersionParams {
+	return &AddKubernetesSupportedVersionParams{p: cloneParams(p.p)}
+}
+
 func (p *AddKubernetesSupportedVersionParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -314,7 +402,16 @@ func (s *KubernetesService) NewAddKubernetesSupportedVersionParams(mincpunumber
 
 // Add a supported Kubernetes version
 func (s *KubernetesService) AddKubernetesSupportedVersion(p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error) {
-	resp, err := s.cs.newPostRequest("addKubernetesSupportedVersion", p.toURLValues())
+	return s.AddKubernetesSupportedVersionWithContext(context.Background(), p)
+}
+
+// AddKubernetesSupportedVersionWithContext is like AddKubernetesSupportedVersion, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) AddKubernetesSupportedVersionWithContext(ctx context.Context, p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addKubernetesSupportedVersion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -324,7 +421,7 @@ func (s *KubernetesService) AddKubernetesSupportedVersion(p *AddKubernetesSuppor
 	}
 
 	var r AddKubernetesSupportedVersionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addKubernetesSupportedVersion", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -332,25 +429,31 @@ func (s *KubernetesService) AddKubernetesSupportedVersion(p *AddKubernetesSuppor
 }
 
 type AddKubernetesSupportedVersionResponse struct {
-	Arch                string `json:"arch"`
-	Created             string `json:"created"`
-	Directdownload      bool   `json:"directdownload"`
-	Id                  string `json:"id"`
-	Isoid               string `json:"isoid"`
-	Isoname             string `json:"isoname"`
-	Isostate            string `json:"isostate"`
-	Isourl              string `json:"isourl"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Mincpunumber        int    `json:"mincpunumber"`
-	Minmemory           int    `json:"minmemory"`
-	Name                string `json:"name"`
-	Semanticversion     string `json:"semanticversion"`
-	State               string `json:"state"`
-	Supportsautoscaling bool   `json:"supportsautoscaling"`
-	Supportsha          bool   `json:"supportsha"`
-	Zoneid              string `json:"zoneid"`
-	Zonename            string `json:"zonename"`
+	Arch                string                     `json:"arch"`
+	Created             Time                       `json:"created"`
+	Directdownload      FlexBool                   `json:"directdownload"`
+	Id                  string                     `json:"id"`
+	Isoid               string                     `json:"isoid"`
+	Isoname             string                     `json:"isoname"`
+	Isostate            string                     `json:"isostate"`
+	Isourl              string                     `json:"isourl"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Mincpunumber        FlexInt                    `json:"mincpunumber"`
+	Minmemory           FlexInt                    `json:"minmemory"`
+	Name                string                     `json:"name"`
+	Semanticversion     string                     `json:"semanticversion"`
+	State               string                     `json:"state"`
+	Supportsautoscaling FlexBool                   `json:"supportsautoscaling"`
+	Supportsha          FlexBool                   `json:"supportsha"`
+	Zoneid              string                     `json:"zoneid"`
+	Zonename            string                     `json:"zonename"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddKubernetesSupportedVersionResponse) UnmarshalJSON(b []byte) error {
+	type alias AddKubernetesSupportedVersionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateKubernetesClusterParams struct {
@@ -412,7 +515,7 @@ func (p *CreateKubernetesClusterParams) toURLValues() url.Values {
 		u.Set("externalloadbalanceripaddress", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["keypair"]; found {
 		u.Set("keypair", v.(string))
@@ -464,6 +567,70 @@ func (p *CreateKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"description", "kubernetesversionid", "name", "serviceofferingid", "size", "zoneid"},
+		lengths:  map[string]int{"account": 255, "clustertype": 255, "cniconfigurationid": 255, "description": 4096, "dockerregistrypassword": 255, "dockerregistryurl": 255, "dockerregistryusername": 255, "domainid": 255, "externalloadbalanceripaddress": 255, "hypervisor": 255, "keypair": 255, "kubernetesversionid": 255, "name": 255, "networkid": 255, "projectid": 255, "serviceofferingid": 255, "zoneid": 255},
+	}.validate("createKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createKubernetesCluster API, before the request is signed
+func (p *CreateKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"account":                       decodeParam[string],
+		"asnumber":                      decodeParam[int64],
+		"clustertype":                   decodeParam[string],
+		"cniconfigdetails":              decodeParam[map[string]string],
+		"cniconfigurationid":            decodeParam[string],
+		"controlnodes":                  decodeParam[int64],
+		"description":                   decodeParam[string],
+		"dockerregistrypassword":        decodeParam[string],
+		"dockerregistryurl":             decodeParam[string],
+		"dockerregistryusername":        decodeParam[string],
+		"domainid":                      decodeParam[string],
+		"enablecsi":                     decodeParam[bool],
+		"etcdnodes":                     decodeParam[int64],
+		"externalloadbalanceripaddress": decodeParam[string],
+		"hypervisor":                    decodeParam[HypervisorType],
+		"keypair":                       decodeParam[string],
+		"kubernetesversionid":           decodeParam[string],
+		"masternodes":                   decodeParam[int64],
+		"name":                          decodeParam[string],
+		"networkid":                     decodeParam[string],
+		"nodeofferings":                 decodeParam[map[string]string],
+		"noderootdisksize":              decodeParam[int64],
+		"nodetemplates":                 decodeParam[map[string]string],
+		"projectid":                     decodeParam[string],
+		"serviceofferingid":             decodeParam[string],
+		"size":                          decodeParam[int64],
+		"zoneid":                        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateKubernetesClusterParams) Clone() *CreateKubernetesClusterParams {
+	return &CreateKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateKubernetesClusterParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -758,7 +925,7 @@ func (p *CreateKubernetesClusterParams) GetExternalloadbalanceripaddress() (stri
 	return value, ok
 }
 
-func (p *CreateKubernetesClusterParams) SetHypervisor(v string) {
+func (p *CreateKubernetesClusterParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -771,11 +938,11 @@ func (p *CreateKubernetesClusterParams) ResetHypervisor() {
 	}
 }
 
-func (p *CreateKubernetesClusterParams) GetHypervisor() (string, bool) {
+func (p *CreateKubernetesClusterParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -1047,32 +1214,39 @@ func (s *KubernetesService) NewCreateKubernetesClusterParams(description string,
 
 // Creates a Kubernetes cluster
 func (s *KubernetesService) CreateKubernetesCluster(p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("createKubernetesCluster", p.toURLValues())
+	return s.CreateKubernetesClusterWithContext(context.Background(), p)
+}
+
+// CreateKubernetesClusterWithContext is like CreateKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) CreateKubernetesClusterWithContext(ctx context.Context, p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1080,57 +1254,82 @@ func (s *KubernetesService) CreateKubernetesCluster(p *CreateKubernetesClusterPa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateKubernetesCluster async job into r
+func (r *CreateKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateKubernetesClusterAsync starts the async job of CreateKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) CreateKubernetesClusterAsync(ctx context.Context, p *CreateKubernetesClusterParams) (*Job, error) {
+	r, err := s.CreateKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createKubernetesCluster", r.JobID), nil
+}
+
 type CreateKubernetesClusterResponse struct {
-	Account               string            `json:"account"`
-	Associatednetworkname string            `json:"associatednetworkname"`
-	Autoscalingenabled    bool              `json:"autoscalingenabled"`
-	Clustertype           string            `json:"clustertype"`
-	Cniconfigname         string            `json:"cniconfigname"`
-	Cniconfigurationid    string            `json:"cniconfigurationid"`
-	Consoleendpoint       string            `json:"consoleendpoint"`
-	Controlnodes          int64             `json:"controlnodes"`
-	Controlofferingid     string            `json:"controlofferingid"`
-	Controlofferingname   string            `json:"controlofferingname"`
-	Cpunumber             string            `json:"cpunumber"`
-	Created               string            `json:"created"`
-	Csienabled            bool              `json:"csienabled"`
-	Description           string            `json:"description"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainpath            string            `json:"domainpath"`
-	Endpoint              string            `json:"endpoint"`
-	Etcdips               map[string]string `json:"etcdips"`
-	Etcdnodes             int64             `json:"etcdnodes"`
-	Etcdofferingid        string            `json:"etcdofferingid"`
-	Etcdofferingname      string            `json:"etcdofferingname"`
-	Hasannotations        bool              `json:"hasannotations"`
-	Id                    string            `json:"id"`
-	Ipaddress             string            `json:"ipaddress"`
-	Ipaddressid           string            `json:"ipaddressid"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Keypair               string            `json:"keypair"`
-	Kubernetesversionid   string            `json:"kubernetesversionid"`
-	Kubernetesversionname string            `json:"kubernetesversionname"`
-	Masternodes           int64             `json:"masternodes"`
-	Maxsize               int64             `json:"maxsize"`
-	Memory                string            `json:"memory"`
-	Minsize               int64             `json:"minsize"`
-	Name                  string            `json:"name"`
-	Networkid             string            `json:"networkid"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Serviceofferingid     string            `json:"serviceofferingid"`
-	Serviceofferingname   string            `json:"serviceofferingname"`
-	Size                  int64             `json:"size"`
-	State                 string            `json:"state"`
-	Templateid            string            `json:"templateid"`
-	Templatename          string            `json:"templatename"`
-	Virtualmachines       []*VirtualMachine `json:"virtualmachines"`
-	Workerofferingid      string            `json:"workerofferingid"`
-	Workerofferingname    string            `json:"workerofferingname"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string                     `json:"account"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Autoscalingenabled    FlexBool                   `json:"autoscalingenabled"`
+	Clustertype           string                     `json:"clustertype"`
+	Cniconfigname         string                     `json:"cniconfigname"`
+	Cniconfigurationid    string                     `json:"cniconfigurationid"`
+	Consoleendpoint       string                     `json:"consoleendpoint"`
+	Controlnodes          FlexInt64                  `json:"controlnodes"`
+	Controlofferingid     string                     `json:"controlofferingid"`
+	Controlofferingname   string                     `json:"controlofferingname"`
+	Cpunumber             string                     `json:"cpunumber"`
+	Created               Time                       `json:"created"`
+	Csienabled            FlexBool                   `json:"csienabled"`
+	Description           string                     `json:"description"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Endpoint              string                     `json:"endpoint"`
+	Etcdips               map[string]string          `json:"etcdips"`
+	Etcdnodes             FlexInt64                  `json:"etcdnodes"`
+	Etcdofferingid        string                     `json:"etcdofferingid"`
+	Etcdofferingname      string                     `json:"etcdofferingname"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Id                    string                     `json:"id"`
+	Ipaddress             string                     `json:"ipaddress"`
+	Ipaddressid           string                     `json:"ipaddressid"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Keypair               string                     `json:"keypair"`
+	Kubernetesversionid   string                     `json:"kubernetesversionid"`
+	Kubernetesversionname string                     `json:"kubernetesversionname"`
+	Masternodes           FlexInt64                  `json:"masternodes"`
+	Maxsize               FlexInt64                  `json:"maxsize"`
+	Memory                string                     `json:"memory"`
+	Minsize               FlexInt64                  `json:"minsize"`
+	Name                  string                     `json:"name"`
+	Networkid             string                     `json:"networkid"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	Size                  FlexInt64                  `json:"size"`
+	State                 string                     `json:"state"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Virtualmachines       []*VirtualMachine          `json:"virtualmachines"`
+	Workerofferingid      string                     `json:"workerofferingid"`
+	Workerofferingname    string                     `json:"workerofferingname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteKubernetesClusterParams struct {
@@ -1156,6 +1355,46 @@ func (p *DeleteKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteKubernetesCluster API, before the request is signed
+func (p *DeleteKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"cleanup": decodeParam[bool],
+		"expunge": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteKubernetesClusterParams) Clone() *DeleteKubernetesClusterParams {
+	return &DeleteKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteKubernetesClusterParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1230,27 +1469,39 @@ func (s *KubernetesService) NewDeleteKubernetesClusterParams(id string) *DeleteK
 
 // Deletes a Kubernetes cluster
 func (s *KubernetesService) DeleteKubernetesCluster(p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteKubernetesCluster", p.toURLValues())
+	return s.DeleteKubernetesClusterWithContext(context.Background(), p)
+}
+
+// DeleteKubernetesClusterWithContext is like DeleteKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) DeleteKubernetesClusterWithContext(ctx context.Context, p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1258,11 +1509,31 @@ func (s *KubernetesService) DeleteKubernetesCluster(p *DeleteKubernetesClusterPa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteKubernetesCluster async job into r
+func (r *DeleteKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteKubernetesClusterAsync starts the async job of DeleteKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) DeleteKubernetesClusterAsync(ctx context.Context, p *DeleteKubernetesClusterParams) (*Job, error) {
+	r, err := s.DeleteKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteKubernetesCluster", r.JobID), nil
+}
+
 type DeleteKubernetesClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteKubernetesSupportedVersionParams struct {
@@ -1280,6 +1551,44 @@ func (p *DeleteKubernetesSupportedVersionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteKubernetesSupportedVersion API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteKubernetesSupportedVersionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteKubernetesSupportedVersion", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteKubernetesSupportedVersion API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteKubernetesSupportedVersionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteKubernetesSupportedVersion", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteKubernetesSupportedVersion API, before the request is signed
+func (p *DeleteKubernetesSupportedVersionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteKubernetesSupportedVersion", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteKubernetesSupportedVersionParams) Clone() *DeleteKubernetesSupportedVersionParams {
+	return &DeleteKubernetesSupportedVersionParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteKubernetesSupportedVersionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1312,27 +1621,39 @@ func (s *KubernetesService) NewDeleteKubernetesSupportedVersionParams(id string)
 
 // Deletes a Kubernetes cluster
 func (s *KubernetesService) DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteKubernetesSupportedVersion", p.toURLValues())
+	return s.DeleteKubernetesSupportedVersionWithContext(context.Background(), p)
+}
+
+// DeleteKubernetesSupportedVersionWithContext is like DeleteKubernetesSupportedVersion, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) DeleteKubernetesSupportedVersionWithContext(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteKubernetesSupportedVersion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteKubernetesSupportedVersionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteKubernetesSupportedVersion", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteKubernetesSupportedVersion", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteKubernetesSupportedVersion", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1340,11 +1661,31 @@ func (s *KubernetesService) DeleteKubernetesSupportedVersion(p *DeleteKubernetes
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteKubernetesSupportedVersion async job into r
+func (r *DeleteKubernetesSupportedVersionResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteKubernetesSupportedVersionAsync starts the async job of DeleteKubernetesSupportedVersion without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) DeleteKubernetesSupportedVersionAsync(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*Job, error) {
+	r, err := s.DeleteKubernetesSupportedVersionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteKubernetesSupportedVersion", r.JobID), nil
+}
+
 type DeleteKubernetesSupportedVersionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteKubernetesSupportedVersionResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteKubernetesSupportedVersionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetKubernetesClusterConfigParams struct {
@@ -1362,6 +1703,43 @@ func (p *GetKubernetesClusterConfigParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getKubernetesClusterConfig API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetKubernetesClusterConfigParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getKubernetesClusterConfig", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getKubernetesClusterConfig API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetKubernetesClusterConfigParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("getKubernetesClusterConfig", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getKubernetesClusterConfig API, before the request is signed
+func (p *GetKubernetesClusterConfigParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetKubernetesClusterConfigParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetKubernetesClusterConfigParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getKubernetesClusterConfig", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetKubernetesClusterConfigParams) Clone() *GetKubernetesClusterConfigParams {
+	return &GetKubernetesClusterConfigParams{p: cloneParams(p.p)}
+}
+
 func (p *GetKubernetesClusterConfigParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1393,7 +1771,16 @@ func (s *KubernetesService) NewGetKubernetesClusterConfigParams() *GetKubernetes
 
 // Get Kubernetes cluster config
 func (s *KubernetesService) GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error) {
-	resp, err := s.cs.newRequest("getKubernetesClusterConfig", p.toURLValues())
+	return s.GetKubernetesClusterConfigWithContext(context.Background(), p)
+}
+
+// GetKubernetesClusterConfigWithContext is like GetKubernetesClusterConfig, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetKubernetesClusterConfigWithContext(ctx context.Context, p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getKubernetesClusterConfig", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1401,7 +1788,7 @@ func (s *KubernetesService) GetKubernetesClusterConfig(p *GetKubernetesClusterCo
 	var nested struct {
 		Response GetKubernetesClusterConfigResponse `json:"clusterconfig"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("getKubernetesClusterConfig", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -1410,11 +1797,17 @@ func (s *KubernetesService) GetKubernetesClusterConfig(p *GetKubernetesClusterCo
 }
 
 type GetKubernetesClusterConfigResponse struct {
-	Configdata string `json:"configdata"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
+	Configdata string                     `json:"configdata"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetKubernetesClusterConfigResponse) UnmarshalJSON(b []byte) error {
+	type alias GetKubernetesClusterConfigResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListKubernetesClustersParams struct {
@@ -1469,6 +1862,54 @@ func (p *ListKubernetesClustersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listKubernetesClusters API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListKubernetesClustersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listKubernetesClusters", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listKubernetesClusters API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListKubernetesClustersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "clustertype": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "state": 255},
+	}.validate("listKubernetesClusters", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listKubernetesClusters API, before the request is signed
+func (p *ListKubernetesClustersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListKubernetesClustersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListKubernetesClustersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listKubernetesClusters", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"clustertype": decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"state":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListKubernetesClustersParams) Clone() *ListKubernetesClustersParams {
+	return &ListKubernetesClustersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListKubernetesClustersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1731,6 +2172,11 @@ func (s *KubernetesService) NewListKubernetesClustersParams() *ListKubernetesClu
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesClusterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetKubernetesClusterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetKubernetesClusterIDWithContext is like GetKubernetesClusterID, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetKubernetesClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListKubernetesClustersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1742,7 +2188,7 @@ func (s *KubernetesService) GetKubernetesClusterID(name string, opts ...OptionFu
 		}
 	}
 
-	l, err := s.ListKubernetesClusters(p)
+	l, err := s.ListKubernetesClustersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1767,12 +2213,17 @@ func (s *KubernetesService) GetKubernetesClusterID(name string, opts ...OptionFu
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesClusterByName(name string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
-	id, count, err := s.GetKubernetesClusterID(name, opts...)
+	return s.GetKubernetesClusterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetKubernetesClusterByNameWithContext is like GetKubernetesClusterByName, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetKubernetesClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	id, count, err := s.GetKubernetesClusterIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetKubernetesClusterByID(id, opts...)
+	r, count, err := s.GetKubernetesClusterByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1781,6 +2232,11 @@ func (s *KubernetesService) GetKubernetesClusterByName(name string, opts ...Opti
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesClusterByID(id string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	return s.GetKubernetesClusterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetKubernetesClusterByIDWithContext is like GetKubernetesClusterByID, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetKubernetesClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
 	p := &ListKubernetesClustersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1792,11 +2248,10 @@ func (s *KubernetesService) GetKubernetesClusterByID(id string, opts ...OptionFu
 		}
 	}
 
-	l, err := s.ListKubernetesClusters(p)
+	l, err := s.ListKubernetesClustersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1814,75 +2269,118 @@ func (s *KubernetesService) GetKubernetesClusterByID(id string, opts ...OptionFu
 
 // Lists Kubernetes clusters
 func (s *KubernetesService) ListKubernetesClusters(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
-	resp, err := s.cs.newRequest("listKubernetesClusters", p.toURLValues())
+	return s.ListKubernetesClustersWithContext(context.Background(), p)
+}
+
+// ListKubernetesClustersWithContext is like ListKubernetesClusters, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) ListKubernetesClustersWithContext(ctx context.Context, p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listKubernetesClusters", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListKubernetesClustersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listKubernetesClusters", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListKubernetesClustersAll returns all KubernetesClusters matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *KubernetesService) ListKubernetesClustersAll(p *ListKubernetesClustersParams, opts ...PageOption) ([]*KubernetesCluster, error) {
+	return s.ListKubernetesClustersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListKubernetesClustersAllWithContext is like ListKubernetesClustersAll, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) ListKubernetesClustersAllWithContext(ctx context.Context, p *ListKubernetesClustersParams, opts ...PageOption) ([]*KubernetesCluster, error) {
+	return collectPages(s.ListKubernetesClustersIterWithContext(ctx, p, opts...))
+}
+
+// ListKubernetesClustersIter returns an iterator over all KubernetesClusters matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *KubernetesService) ListKubernetesClustersIter(p *ListKubernetesClustersParams, opts ...PageOption) iter.Seq2[*KubernetesCluster, error] {
+	return s.ListKubernetesClustersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListKubernetesClustersIterWithContext is like ListKubernetesClustersIter, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) ListKubernetesClustersIterWithContext(ctx context.Context, p *ListKubernetesClustersParams, opts ...PageOption) iter.Seq2[*KubernetesCluster, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*KubernetesCluster, int, error) {
+		l, err := s.ListKubernetesClustersWithContext(ctx, &ListKubernetesClustersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.KubernetesClusters, l.Count, nil
+	}, opts...)
+}
+
 type ListKubernetesClustersResponse struct {
 	Count              int                  `json:"count"`
 	KubernetesClusters []*KubernetesCluster `json:"kubernetescluster"`
 }
 
 type KubernetesCluster struct {
-	Account               string            `json:"account"`
-	Associatednetworkname string            `json:"associatednetworkname"`
-	Autoscalingenabled    bool              `json:"autoscalingenabled"`
-	Clustertype           string            `json:"clustertype"`
-	Cniconfigname         string            `json:"cniconfigname"`
-	Cniconfigurationid    string            `json:"cniconfigurationid"`
-	Consoleendpoint       string            `json:"consoleendpoint"`
-	Controlnodes          int64             `json:"controlnodes"`
-	Controlofferingid     string            `json:"controlofferingid"`
-	Controlofferingname   string            `json:"controlofferingname"`
-	Cpunumber             string            `json:"cpunumber"`
-	Created               string            `json:"created"`
-	Csienabled            bool              `json:"csienabled"`
-	Description           string            `json:"description"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainpath            string            `json:"domainpath"`
-	Endpoint              string            `json:"endpoint"`
-	Etcdips               map[string]string `json:"etcdips"`
-	Etcdnodes             int64             `json:"etcdnodes"`
-	Etcdofferingid        string            `json:"etcdofferingid"`
-	Etcdofferingname      string            `json:"etcdofferingname"`
-	Hasannotations        bool              `json:"hasannotations"`
-	Id                    string            `json:"id"`
-	Ipaddress             string            `json:"ipaddress"`
-	Ipaddressid           string            `json:"ipaddressid"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Keypair               string            `json:"keypair"`
-	Kubernetesversionid   string            `json:"kubernetesversionid"`
-	Kubernetesversionname string            `json:"kubernetesversionname"`
-	Masternodes           int64             `json:"masternodes"`
-	Maxsize               int64             `json:"maxsize"`
-	Memory                string            `json:"memory"`
-	Minsize               int64             `json:"minsize"`
-	Name                  string            `json:"name"`
-	Networkid             string            `json:"networkid"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Serviceofferingid     string            `json:"serviceofferingid"`
-	Serviceofferingname   string            `json:"serviceofferingname"`
-	Size                  int64             `json:"size"`
-	State                 string            `json:"state"`
-	Templateid            string            `json:"templateid"`
-	Templatename          string            `json:"templatename"`
-	Virtualmachines       []*VirtualMachine `json:"virtualmachines"`
-	Workerofferingid      string            `json:"workerofferingid"`
-	Workerofferingname    string            `json:"workerofferingname"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string                     `json:"account"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Autoscalingenabled    FlexBool                   `json:"autoscalingenabled"`
+	Clustertype           string                     `json:"clustertype"`
+	Cniconfigname         string                     `json:"cniconfigname"`
+	Cniconfigurationid    string                     `json:"cniconfigurationid"`
+	Consoleendpoint       string                     `json:"consoleendpoint"`
+	Controlnodes          FlexInt64                  `json:"controlnodes"`
+	Controlofferingid     string                     `json:"controlofferingid"`
+	Controlofferingname   string                     `json:"controlofferingname"`
+	Cpunumber             string                     `json:"cpunumber"`
+	Created               Time                       `json:"created"`
+	Csienabled            FlexBool                   `json:"csienabled"`
+	Description           string                     `json:"description"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Endpoint              string                     `json:"endpoint"`
+	Etcdips               map[string]string          `json:"etcdips"`
+	Etcdnodes             FlexInt64                  `json:"etcdnodes"`
+	Etcdofferingid        string                     `json:"etcdofferingid"`
+	Etcdofferingname      string                     `json:"etcdofferingname"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Id                    string                     `json:"id"`
+	Ipaddress             string                     `json:"ipaddress"`
+	Ipaddressid           string                     `json:"ipaddressid"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Keypair               string                     `json:"keypair"`
+	Kubernetesversionid   string                     `json:"kubernetesversionid"`
+	Kubernetesversionname string                     `json:"kubernetesversionname"`
+	Masternodes           FlexInt64                  `json:"masternodes"`
+	Maxsize               FlexInt64                  `json:"maxsize"`
+	Memory                string                     `json:"memory"`
+	Minsize               FlexInt64                  `json:"minsize"`
+	Name                  string                     `json:"name"`
+	Networkid             string                     `json:"networkid"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	Size                  FlexInt64                  `json:"size"`
+	State                 string                     `json:"state"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Virtualmachines       []*VirtualMachine          `json:"virtualmachines"`
+	Workerofferingid      string                     `json:"workerofferingid"`
+	Workerofferingname    string                     `json:"workerofferingname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *KubernetesCluster) UnmarshalJSON(b []byte) error {
+	type alias KubernetesCluster
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListKubernetesSupportedVersionsParams struct {
@@ -1923,6 +2421,50 @@ func (p *ListKubernetesSupportedVersionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listKubernetesSupportedVersions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListKubernetesSupportedVersionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listKubernetesSupportedVersions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listKubernetesSupportedVersions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListKubernetesSupportedVersionsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"arch": 255, "id": 255, "keyword": 255, "minimumkubernetesversionid": 255, "minimumsemanticversion": 255, "zoneid": 255},
+	}.validate("listKubernetesSupportedVersions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listKubernetesSupportedVersions API, before the request is signed
+func (p *ListKubernetesSupportedVersionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListKubernetesSupportedVersionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListKubernetesSupportedVersionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listKubernetesSupportedVersions", b, &p.p, map[string]paramDecoder{
+		"arch":                       decodeParam[string],
+		"id":                         decodeParam[string],
+		"keyword":                    decodeParam[string],
+		"minimumkubernetesversionid": decodeParam[string],
+		"minimumsemanticversion":     decodeParam[string],
+		"page":                       decodeParam[int],
+		"pagesize":                   decodeParam[int],
+		"zoneid":                     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListKubernetesSupportedVersionsParams) Clone() *ListKubernetesSupportedVersionsParams {
+	return &ListKubernetesSupportedVersionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListKubernetesSupportedVersionsParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2101,6 +2643,11 @@ func (s *KubernetesService) NewListKubernetesSupportedVersionsParams() *ListKube
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesSupportedVersionID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetKubernetesSupportedVersionIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetKubernetesSupportedVersionIDWithContext is like GetKubernetesSupportedVersionID, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetKubernetesSupportedVersionIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListKubernetesSupportedVersionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2112,7 +2659,7 @@ func (s *KubernetesService) GetKubernetesSupportedVersionID(keyword string, opts
 		}
 	}
 
-	l, err := s.ListKubernetesSupportedVersions(p)
+	l, err := s.ListKubernetesSupportedVersionsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2137,12 +2684,17 @@ func (s *KubernetesService) GetKubernetesSupportedVersionID(keyword string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesSupportedVersionByName(name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
-	id, count, err := s.GetKubernetesSupportedVersionID(name, opts...)
+	return s.GetKubernetesSupportedVersionByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetKubernetesSupportedVersionByNameWithContext is like GetKubernetesSupportedVersionByName, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetKubernetesSupportedVersionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	id, count, err := s.GetKubernetesSupportedVersionIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetKubernetesSupportedVersionByID(id, opts...)
+	r, count, err := s.GetKubernetesSupportedVersionByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2151,6 +2703,11 @@ func (s *KubernetesService) GetKubernetesSupportedVersionByName(name string, opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *KubernetesService) GetKubernetesSupportedVersionByID(id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	return s.GetKubernetesSupportedVersionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetKubernetesSupportedVersionByIDWithContext is like GetKubernetesSupportedVersionByID, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetKubernetesSupportedVersionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
 	p := &ListKubernetesSupportedVersionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2162,11 +2719,10 @@ func (s *KubernetesService) GetKubernetesSupportedVersionByID(id string, opts ..
 		}
 	}
 
-	l, err := s.ListKubernetesSupportedVersions(p)
+	l, err := s.ListKubernetesSupportedVersionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2184,44 +2740,87 @@ func (s *KubernetesService) GetKubernetesSupportedVersionByID(id string, opts ..
 
 // Lists supported Kubernetes version
 func (s *KubernetesService) ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
-	resp, err := s.cs.newRequest("listKubernetesSupportedVersions", p.toURLValues())
+	return s.ListKubernetesSupportedVersionsWithContext(context.Background(), p)
+}
+
+// ListKubernetesSupportedVersionsWithContext is like ListKubernetesSupportedVersions, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) ListKubernetesSupportedVersionsWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listKubernetesSupportedVersions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListKubernetesSupportedVersionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listKubernetesSupportedVersions", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListKubernetesSupportedVersionsAll returns all KubernetesSupportedVersions matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *KubernetesService) ListKubernetesSupportedVersionsAll(p *ListKubernetesSupportedVersionsParams, opts ...PageOption) ([]*KubernetesSupportedVersion, error) {
+	return s.ListKubernetesSupportedVersionsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListKubernetesSupportedVersionsAllWithContext is like ListKubernetesSupportedVersionsAll, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) ListKubernetesSupportedVersionsAllWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams, opts ...PageOption) ([]*KubernetesSupportedVersion, error) {
+	return collectPages(s.ListKubernetesSupportedVersionsIterWithContext(ctx, p, opts...))
+}
+
+// ListKubernetesSupportedVersionsIter returns an iterator over all KubernetesSupportedVersions matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *KubernetesService) ListKubernetesSupportedVersionsIter(p *ListKubernetesSupportedVersionsParams, opts ...PageOption) iter.Seq2[*KubernetesSupportedVersion, error] {
+	return s.ListKubernetesSupportedVersionsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListKubernetesSupportedVersionsIterWithContext is like ListKubernetesSupportedVersionsIter, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) ListKubernetesSupportedVersionsIterWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams, opts ...PageOption) iter.Seq2[*KubernetesSupportedVersion, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*KubernetesSupportedVersion, int, error) {
+		l, err := s.ListKubernetesSupportedVersionsWithContext(ctx, &ListKubernetesSupportedVersionsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.KubernetesSupportedVersions, l.Count, nil
+	}, opts...)
+}
+
 type ListKubernetesSupportedVersionsResponse struct {
 	Count                       int                           `json:"count"`
 	KubernetesSupportedVersions []*KubernetesSupportedVersion `json:"kubernetessupportedversion"`
 }
 
 type KubernetesSupportedVersion struct {
-	Arch                string `json:"arch"`
-	Created             string `json:"created"`
-	Directdownload      bool   `json:"directdownload"`
-	Id                  string `json:"id"`
-	Isoid               string `json:"isoid"`
-	Isoname             string `json:"isoname"`
-	Isostate            string `json:"isostate"`
-	Isourl              string `json:"isourl"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Mincpunumber        int    `json:"mincpunumber"`
-	Minmemory           int    `json:"minmemory"`
-	Name                string `json:"name"`
-	Semanticversion     string `json:"semanticversion"`
-	State               string `json:"state"`
-	Supportsautoscaling bool   `json:"supportsautoscaling"`
-	Supportsha          bool   `json:"supportsha"`
-	Zoneid              string `json:"zoneid"`
-	Zonename            string `json:"zonename"`
+	Arch                string                     `json:"arch"`
+	Created             Time                       `json:"created"`
+	Directdownload      FlexBool                   `json:"directdownload"`
+	Id                  string                     `json:"id"`
+	Isoid               string                     `json:"isoid"`
+	Isoname             string                     `json:"isoname"`
+	Isostate            string                     `json:"isostate"`
+	Isourl              string                     `json:"isourl"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Mincpunumber        FlexInt                    `json:"mincpunumber"`
+	Minmemory           FlexInt                    `json:"minmemory"`
+	Name                string                     `json:"name"`
+	Semanticversion     string                     `json:"semanticversion"`
+	State               string                     `json:"state"`
+	Supportsautoscaling FlexBool                   `json:"supportsautoscaling"`
+	Supportsha          FlexBool                   `json:"supportsha"`
+	Zoneid              string                     `json:"zoneid"`
+	Zonename            string                     `json:"zonename"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *KubernetesSupportedVersion) UnmarshalJSON(b []byte) error {
+	type alias KubernetesSupportedVersion
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ScaleKubernetesClusterParams struct {
@@ -2269,6 +2868,51 @@ func (p *ScaleKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the scaleKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ScaleKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("scaleKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the scaleKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ScaleKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "serviceofferingid": 255},
+	}.validate("scaleKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the scaleKubernetesCluster API, before the request is signed
+func (p *ScaleKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ScaleKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ScaleKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("scaleKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"autoscalingenabled": decodeParam[bool],
+		"id":                 decodeParam[string],
+		"maxsize":            decodeParam[int64],
+		"minsize":            decodeParam[int64],
+		"nodeids":            decodeParam[[]string],
+		"nodeofferings":      decodeParam[map[string]string],
+		"serviceofferingid":  decodeParam[string],
+		"size":               decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ScaleKubernetesClusterParams) Clone() *ScaleKubernetesClusterParams {
+	return &ScaleKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *ScaleKubernetesClusterParams) SetAutoscalingenabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2448,32 +3092,39 @@ func (s *KubernetesService) NewScaleKubernetesClusterParams(id string) *ScaleKub
 
 // Scales a created, running or stopped CloudManaged Kubernetes cluster
 func (s *KubernetesService) ScaleKubernetesCluster(p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("scaleKubernetesCluster", p.toURLValues())
+	return s.ScaleKubernetesClusterWithContext(context.Background(), p)
+}
+
+// ScaleKubernetesClusterWithContext is like ScaleKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) ScaleKubernetesClusterWithContext(ctx context.Context, p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "scaleKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ScaleKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("scaleKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "scaleKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("scaleKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2481,57 +3132,82 @@ func (s *KubernetesService) ScaleKubernetesCluster(p *ScaleKubernetesClusterPara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ScaleKubernetesCluster async job into r
+func (r *ScaleKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ScaleKubernetesClusterAsync starts the async job of ScaleKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) ScaleKubernetesClusterAsync(ctx context.Context, p *ScaleKubernetesClusterParams) (*Job, error) {
+	r, err := s.ScaleKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("scaleKubernetesCluster", r.JobID), nil
+}
+
 type ScaleKubernetesClusterResponse struct {
-	Account               string            `json:"account"`
-	Associatednetworkname string            `json:"associatednetworkname"`
-	Autoscalingenabled    bool              `json:"autoscalingenabled"`
-	Clustertype           string            `json:"clustertype"`
-	Cniconfigname         string            `json:"cniconfigname"`
-	Cniconfigurationid    string            `json:"cniconfigurationid"`
-	Consoleendpoint       string            `json:"consoleendpoint"`
-	Controlnodes          int64             `json:"controlnodes"`
-	Controlofferingid     string            `json:"controlofferingid"`
-	Controlofferingname   string            `json:"controlofferingname"`
-	Cpunumber             string            `json:"cpunumber"`
-	Created               string            `json:"created"`
-	Csienabled            bool              `json:"csienabled"`
-	Description           string            `json:"description"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainpath            string            `json:"domainpath"`
-	Endpoint              string            `json:"endpoint"`
-	Etcdips               map[string]string `json:"etcdips"`
-	Etcdnodes             int64             `json:"etcdnodes"`
-	Etcdofferingid        string            `json:"etcdofferingid"`
-	Etcdofferingname      string            `json:"etcdofferingname"`
-	Hasannotations        bool              `json:"hasannotations"`
-	Id                    string            `json:"id"`
-	Ipaddress             string            `json:"ipaddress"`
-	Ipaddressid           string            `json:"ipaddressid"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Keypair               string            `json:"keypair"`
-	Kubernetesversionid   string            `json:"kubernetesversionid"`
-	Kubernetesversionname string            `json:"kubernetesversionname"`
-	Masternodes           int64             `json:"masternodes"`
-	Maxsize               int64             `json:"maxsize"`
-	Memory                string            `json:"memory"`
-	Minsize               int64             `json:"minsize"`
-	Name                  string            `json:"name"`
-	Networkid             string            `json:"networkid"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Serviceofferingid     string            `json:"serviceofferingid"`
-	Serviceofferingname   string            `json:"serviceofferingname"`
-	Size                  int64             `json:"size"`
-	State                 string            `json:"state"`
-	Templateid            string            `json:"templateid"`
-	Templatename          string            `json:"templatename"`
-	Virtualmachines       []*VirtualMachine `json:"virtualmachines"`
-	Workerofferingid      string            `json:"workerofferingid"`
-	Workerofferingname    string            `json:"workerofferingname"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string                     `json:"account"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Autoscalingenabled    FlexBool                   `json:"autoscalingenabled"`
+	Clustertype           string                     `json:"clustertype"`
+	Cniconfigname         string                     `json:"cniconfigname"`
+	Cniconfigurationid    string                     `json:"cniconfigurationid"`
+	Consoleendpoint       string                     `json:"consoleendpoint"`
+	Controlnodes          FlexInt64                  `json:"controlnodes"`
+	Controlofferingid     string                     `json:"controlofferingid"`
+	Controlofferingname   string                     `json:"controlofferingname"`
+	Cpunumber             string                     `json:"cpunumber"`
+	Created               Time                       `json:"created"`
+	Csienabled            FlexBool                   `json:"csienabled"`
+	Description           string                     `json:"description"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Endpoint              string                     `json:"endpoint"`
+	Etcdips               map[string]string          `json:"etcdips"`
+	Etcdnodes             FlexInt64                  `json:"etcdnodes"`
+	Etcdofferingid        string                     `json:"etcdofferingid"`
+	Etcdofferingname      string                     `json:"etcdofferingname"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Id                    string                     `json:"id"`
+	Ipaddress             string                     `json:"ipaddress"`
+	Ipaddressid           string                     `json:"ipaddressid"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Keypair               string                     `json:"keypair"`
+	Kubernetesversionid   string                     `json:"kubernetesversionid"`
+	Kubernetesversionname string                     `json:"kubernetesversionname"`
+	Masternodes           FlexInt64                  `json:"masternodes"`
+	Maxsize               FlexInt64                  `json:"maxsize"`
+	Memory                string                     `json:"memory"`
+	Minsize               FlexInt64                  `json:"minsize"`
+	Name                  string                     `json:"name"`
+	Networkid             string                     `json:"networkid"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	Size                  FlexInt64                  `json:"size"`
+	State                 string                     `json:"state"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Virtualmachines       []*VirtualMachine          `json:"virtualmachines"`
+	Workerofferingid      string                     `json:"workerofferingid"`
+	Workerofferingname    string                     `json:"workerofferingname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ScaleKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias ScaleKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartKubernetesClusterParams struct {
@@ -2549,6 +3225,44 @@ func (p *StartKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the startKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StartKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("startKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the startKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StartKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("startKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the startKubernetesCluster API, before the request is signed
+func (p *StartKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StartKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StartKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("startKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StartKubernetesClusterParams) Clone() *StartKubernetesClusterParams {
+	return &StartKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *StartKubernetesClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2581,32 +3295,39 @@ func (s *KubernetesService) NewStartKubernetesClusterParams(id string) *StartKub
 
 // Starts a stopped CloudManaged Kubernetes cluster
 func (s *KubernetesService) StartKubernetesCluster(p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("startKubernetesCluster", p.toURLValues())
+	return s.StartKubernetesClusterWithContext(context.Background(), p)
+}
+
+// StartKubernetesClusterWithContext is like StartKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) StartKubernetesClusterWithContext(ctx context.Context, p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "startKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StartKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("startKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "startKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("startKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2614,57 +3335,82 @@ func (s *KubernetesService) StartKubernetesCluster(p *StartKubernetesClusterPara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StartKubernetesCluster async job into r
+func (r *StartKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StartKubernetesClusterAsync starts the async job of StartKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) StartKubernetesClusterAsync(ctx context.Context, p *StartKubernetesClusterParams) (*Job, error) {
+	r, err := s.StartKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("startKubernetesCluster", r.JobID), nil
+}
+
 type StartKubernetesClusterResponse struct {
-	Account               string            `json:"account"`
-	Associatednetworkname string            `json:"associatednetworkname"`
-	Autoscalingenabled    bool              `json:"autoscalingenabled"`
-	Clustertype           string            `json:"clustertype"`
-	Cniconfigname         string            `json:"cniconfigname"`
-	Cniconfigurationid    string            `json:"cniconfigurationid"`
-	Consoleendpoint       string            `json:"consoleendpoint"`
-	Controlnodes          int64             `json:"controlnodes"`
-	Controlofferingid     string            `json:"controlofferingid"`
-	Controlofferingname   string            `json:"controlofferingname"`
-	Cpunumber             string            `json:"cpunumber"`
-	Created               string            `json:"created"`
-	Csienabled            bool              `json:"csienabled"`
-	Description           string            `json:"description"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainpath            string            `json:"domainpath"`
-	Endpoint              string            `json:"endpoint"`
-	Etcdips               map[string]string `json:"etcdips"`
-	Etcdnodes             int64             `json:"etcdnodes"`
-	Etcdofferingid        string            `json:"etcdofferingid"`
-	Etcdofferingname      string            `json:"etcdofferingname"`
-	Hasannotations        bool              `json:"hasannotations"`
-	Id                    string            `json:"id"`
-	Ipaddress             string            `json:"ipaddress"`
-	Ipaddressid           string            `json:"ipaddressid"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Keypair               string            `json:"keypair"`
-	Kubernetesversionid   string            `json:"kubernetesversionid"`
-	Kubernetesversionname string            `json:"kubernetesversionname"`
-	Masternodes           int64             `json:"masternodes"`
-	Maxsize               int64             `json:"maxsize"`
-	Memory                string            `json:"memory"`
-	Minsize               int64             `json:"minsize"`
-	Name                  string            `json:"name"`
-	Networkid             string            `json:"networkid"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Serviceofferingid     string            `json:"serviceofferingid"`
-	Serviceofferingname   string            `json:"serviceofferingname"`
-	Size                  int64             `json:"size"`
-	State                 string            `json:"state"`
-	Templateid            string            `json:"templateid"`
-	Templatename          string            `json:"templatename"`
-	Virtualmachines       []*VirtualMachine `json:"virtualmachines"`
-	Workerofferingid      string            `json:"workerofferingid"`
-	Workerofferingname    string            `json:"workerofferingname"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string                     `json:"account"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Autoscalingenabled    FlexBool                   `json:"autoscalingenabled"`
+	Clustertype           string                     `json:"clustertype"`
+	Cniconfigname         string                     `json:"cniconfigname"`
+	Cniconfigurationid    string                     `json:"cniconfigurationid"`
+	Consoleendpoint       string                     `json:"consoleendpoint"`
+	Controlnodes          FlexInt64                  `json:"controlnodes"`
+	Controlofferingid     string                     `json:"controlofferingid"`
+	Controlofferingname   string                     `json:"controlofferingname"`
+	Cpunumber             string                     `json:"cpunumber"`
+	Created               Time                       `json:"created"`
+	Csienabled            FlexBool                   `json:"csienabled"`
+	Description           string                     `json:"description"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Endpoint              string                     `json:"endpoint"`
+	Etcdips               map[string]string          `json:"etcdips"`
+	Etcdnodes             FlexInt64                  `json:"etcdnodes"`
+	Etcdofferingid        string                     `json:"etcdofferingid"`
+	Etcdofferingname      string                     `json:"etcdofferingname"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Id                    string                     `json:"id"`
+	Ipaddress             string                     `json:"ipaddress"`
+	Ipaddressid           string                     `json:"ipaddressid"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Keypair               string                     `json:"keypair"`
+	Kubernetesversionid   string                     `json:"kubernetesversionid"`
+	Kubernetesversionname string                     `json:"kubernetesversionname"`
+	Masternodes           FlexInt64                  `json:"masternodes"`
+	Maxsize               FlexInt64                  `json:"maxsize"`
+	Memory                string                     `json:"memory"`
+	Minsize               FlexInt64                  `json:"minsize"`
+	Name                  string                     `json:"name"`
+	Networkid             string                     `json:"networkid"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	Size                  FlexInt64                  `json:"size"`
+	State                 string                     `json:"state"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Virtualmachines       []*VirtualMachine          `json:"virtualmachines"`
+	Workerofferingid      string                     `json:"workerofferingid"`
+	Workerofferingname    string                     `json:"workerofferingname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias StartKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopKubernetesClusterParams struct {
@@ -2682,6 +3428,44 @@ func (p *StopKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the stopKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StopKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("stopKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the stopKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StopKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("stopKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the stopKubernetesCluster API, before the request is signed
+func (p *StopKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StopKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StopKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("stopKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StopKubernetesClusterParams) Clone() *StopKubernetesClusterParams {
+	return &StopKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *StopKubernetesClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2714,27 +3498,39 @@ func (s *KubernetesService) NewStopKubernetesClusterParams(id string) *StopKuber
 
 // Stops a running CloudManaged Kubernetes cluster
 func (s *KubernetesService) StopKubernetesCluster(p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("stopKubernetesCluster", p.toURLValues())
+	return s.StopKubernetesClusterWithContext(context.Background(), p)
+}
+
+// StopKubernetesClusterWithContext is like StopKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) StopKubernetesClusterWithContext(ctx context.Context, p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "stopKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StopKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("stopKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "stopKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("stopKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2742,11 +3538,31 @@ func (s *KubernetesService) StopKubernetesCluster(p *StopKubernetesClusterParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StopKubernetesCluster async job into r
+func (r *StopKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// StopKubernetesClusterAsync starts the async job of StopKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) StopKubernetesClusterAsync(ctx context.Context, p *StopKubernetesClusterParams) (*Job, error) {
+	r, err := s.StopKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("stopKubernetesCluster", r.JobID), nil
+}
+
 type StopKubernetesClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias StopKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateKubernetesSupportedVersionParams struct {
@@ -2767,6 +3583,45 @@ func (p *UpdateKubernetesSupportedVersionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateKubernetesSupportedVersion API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateKubernetesSupportedVersionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateKubernetesSupportedVersion", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateKubernetesSupportedVersion API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateKubernetesSupportedVersionParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "state"},
+		lengths:  map[string]int{"id": 255, "state": 255},
+	}.validate("updateKubernetesSupportedVersion", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateKubernetesSupportedVersion API, before the request is signed
+func (p *UpdateKubernetesSupportedVersionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateKubernetesSupportedVersion", b, &p.p, map[string]paramDecoder{
+		"id":    decodeParam[string],
+		"state": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateKubernetesSupportedVersionParams) Clone() *UpdateKubernetesSupportedVersionParams {
+	return &UpdateKubernetesSupportedVersionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateKubernetesSupportedVersionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2821,13 +3676,22 @@ func (s *KubernetesService) NewUpdateKubernetesSupportedVersionParams(id string,
 
 // Update a supported Kubernetes version
 func (s *KubernetesService) UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateKubernetesSupportedVersion", p.toURLValues())
+	return s.UpdateKubernetesSupportedVersionWithContext(context.Background(), p)
+}
+
+// UpdateKubernetesSupportedVersionWithContext is like UpdateKubernetesSupportedVersion, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) UpdateKubernetesSupportedVersionWithContext(ctx context.Context, p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateKubernetesSupportedVersion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateKubernetesSupportedVersionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateKubernetesSupportedVersion", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2835,25 +3699,31 @@ func (s *KubernetesService) UpdateKubernetesSupportedVersion(p *UpdateKubernetes
 }
 
 type UpdateKubernetesSupportedVersionResponse struct {
-	Arch                string `json:"arch"`
-	Created             string `json:"created"`
-	Directdownload      bool   `json:"directdownload"`
-	Id                  string `json:"id"`
-	Isoid               string `json:"isoid"`
-	Isoname             string `json:"isoname"`
-	Isostate            string `json:"isostate"`
-	Isourl              string `json:"isourl"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Mincpunumber        int    `json:"mincpunumber"`
-	Minmemory           int    `json:"minmemory"`
-	Name                string `json:"name"`
-	Semanticversion     string `json:"semanticversion"`
-	State               string `json:"state"`
-	Supportsautoscaling bool   `json:"supportsautoscaling"`
-	Supportsha          bool   `json:"supportsha"`
-	Zoneid              string `json:"zoneid"`
-	Zonename            string `json:"zonename"`
+	Arch                string                     `json:"arch"`
+	Created             Time                       `json:"created"`
+	Directdownload      FlexBool                   `json:"directdownload"`
+	Id                  string                     `json:"id"`
+	Isoid               string                     `json:"isoid"`
+	Isoname             string                     `json:"isoname"`
+	Isostate            string                     `json:"isostate"`
+	Isourl              string                     `json:"isourl"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Mincpunumber        FlexInt                    `json:"mincpunumber"`
+	Minmemory           FlexInt                    `json:"minmemory"`
+	Name                string                     `json:"name"`
+	Semanticversion     string                     `json:"semanticversion"`
+	State               string                     `json:"state"`
+	Supportsautoscaling FlexBool                   `json:"supportsautoscaling"`
+	Supportsha          FlexBool                   `json:"supportsha"`
+	Zoneid              string                     `json:"zoneid"`
+	Zonename            string                     `json:"zonename"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateKubernetesSupportedVersionResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateKubernetesSupportedVersionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpgradeKubernetesClusterParams struct {
@@ -2874,6 +3744,45 @@ func (p *UpgradeKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the upgradeKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpgradeKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("upgradeKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the upgradeKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpgradeKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "kubernetesversionid"},
+		lengths:  map[string]int{"id": 255, "kubernetesversionid": 255},
+	}.validate("upgradeKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the upgradeKubernetesCluster API, before the request is signed
+func (p *UpgradeKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpgradeKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpgradeKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("upgradeKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"id":                  decodeParam[string],
+		"kubernetesversionid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpgradeKubernetesClusterParams) Clone() *UpgradeKubernetesClusterParams {
+	return &UpgradeKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *UpgradeKubernetesClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2928,32 +3837,39 @@ func (s *KubernetesService) NewUpgradeKubernetesClusterParams(id string, kuberne
 
 // Upgrades a running CloudManaged Kubernetes cluster
 func (s *KubernetesService) UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("upgradeKubernetesCluster", p.toURLValues())
+	return s.UpgradeKubernetesClusterWithContext(context.Background(), p)
+}
+
+// UpgradeKubernetesClusterWithContext is like UpgradeKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) UpgradeKubernetesClusterWithContext(ctx context.Context, p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "upgradeKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpgradeKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("upgradeKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "upgradeKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("upgradeKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2961,57 +3877,82 @@ func (s *KubernetesService) UpgradeKubernetesCluster(p *UpgradeKubernetesCluster
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpgradeKubernetesCluster async job into r
+func (r *UpgradeKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpgradeKubernetesClusterAsync starts the async job of UpgradeKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) UpgradeKubernetesClusterAsync(ctx context.Context, p *UpgradeKubernetesClusterParams) (*Job, error) {
+	r, err := s.UpgradeKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("upgradeKubernetesCluster", r.JobID), nil
+}
+
 type UpgradeKubernetesClusterResponse struct {
-	Account               string            `json:"account"`
-	Associatednetworkname string            `json:"associatednetworkname"`
-	Autoscalingenabled    bool              `json:"autoscalingenabled"`
-	Clustertype           string            `json:"clustertype"`
-	Cniconfigname         string            `json:"cniconfigname"`
-	Cniconfigurationid    string            `json:"cniconfigurationid"`
-	Consoleendpoint       string            `json:"consoleendpoint"`
-	Controlnodes          int64             `json:"controlnodes"`
-	Controlofferingid     string            `json:"controlofferingid"`
-	Controlofferingname   string            `json:"controlofferingname"`
-	Cpunumber             string            `json:"cpunumber"`
-	Created               string            `json:"created"`
-	Csienabled            bool              `json:"csienabled"`
-	Description           string            `json:"description"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainpath            string            `json:"domainpath"`
-	Endpoint              string            `json:"endpoint"`
-	Etcdips               map[string]string `json:"etcdips"`
-	Etcdnodes             int64             `json:"etcdnodes"`
-	Etcdofferingid        string            `json:"etcdofferingid"`
-	Etcdofferingname      string            `json:"etcdofferingname"`
-	Hasannotations        bool              `json:"hasannotations"`
-	Id                    string            `json:"id"`
-	Ipaddress             string            `json:"ipaddress"`
-	Ipaddressid           string            `json:"ipaddressid"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Keypair               string            `json:"keypair"`
-	Kubernetesversionid   string            `json:"kubernetesversionid"`
-	Kubernetesversionname string            `json:"kubernetesversionname"`
-	Masternodes           int64             `json:"masternodes"`
-	Maxsize               int64             `json:"maxsize"`
-	Memory                string            `json:"memory"`
-	Minsize               int64             `json:"minsize"`
-	Name                  string            `json:"name"`
-	Networkid             string            `json:"networkid"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Serviceofferingid     string            `json:"serviceofferingid"`
-	Serviceofferingname   string            `json:"serviceofferingname"`
-	Size                  int64             `json:"size"`
-	State                 string            `json:"state"`
-	Templateid            string            `json:"templateid"`
-	Templatename          string            `json:"templatename"`
-	Virtualmachines       []*VirtualMachine `json:"virtualmachines"`
-	Workerofferingid      string            `json:"workerofferingid"`
-	Workerofferingname    string            `json:"workerofferingname"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string                     `json:"account"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Autoscalingenabled    FlexBool                   `json:"autoscalingenabled"`
+	Clustertype           string                     `json:"clustertype"`
+	Cniconfigname         string                     `json:"cniconfigname"`
+	Cniconfigurationid    string                     `json:"cniconfigurationid"`
+	Consoleendpoint       string                     `json:"consoleendpoint"`
+	Controlnodes          FlexInt64                  `json:"controlnodes"`
+	Controlofferingid     string                     `json:"controlofferingid"`
+	Controlofferingname   string                     `json:"controlofferingname"`
+	Cpunumber             string                     `json:"cpunumber"`
+	Created               Time                       `json:"created"`
+	Csienabled            FlexBool                   `json:"csienabled"`
+	Description           string                     `json:"description"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Endpoint              string                     `json:"endpoint"`
+	Etcdips               map[string]string          `json:"etcdips"`
+	Etcdnodes             FlexInt64                  `json:"etcdnodes"`
+	Etcdofferingid        string                     `json:"etcdofferingid"`
+	Etcdofferingname      string                     `json:"etcdofferingname"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Id                    string                     `json:"id"`
+	Ipaddress             string                     `json:"ipaddress"`
+	Ipaddressid           string                     `json:"ipaddressid"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Keypair               string                     `json:"keypair"`
+	Kubernetesversionid   string                     `json:"kubernetesversionid"`
+	Kubernetesversionname string                     `json:"kubernetesversionname"`
+	Masternodes           FlexInt64                  `json:"masternodes"`
+	Maxsize               FlexInt64                  `json:"maxsize"`
+	Memory                string                     `json:"memory"`
+	Minsize               FlexInt64                  `json:"minsize"`
+	Name                  string                     `json:"name"`
+	Networkid             string                     `json:"networkid"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	Size                  FlexInt64                  `json:"size"`
+	State                 string                     `json:"state"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Virtualmachines       []*VirtualMachine          `json:"virtualmachines"`
+	Workerofferingid      string                     `json:"workerofferingid"`
+	Workerofferingname    string                     `json:"workerofferingname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpgradeKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias UpgradeKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddVirtualMachinesToKubernetesClusterParams struct {
@@ -3037,6 +3978,46 @@ func (p *AddVirtualMachinesToKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addVirtualMachinesToKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddVirtualMachinesToKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addVirtualMachinesToKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addVirtualMachinesToKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddVirtualMachinesToKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "virtualmachineids"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("addVirtualMachinesToKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addVirtualMachinesToKubernetesCluster API, before the request is signed
+func (p *AddVirtualMachinesToKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddVirtualMachinesToKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddVirtualMachinesToKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addVirtualMachinesToKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[string],
+		"iscontrolnode":     decodeParam[bool],
+		"virtualmachineids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddVirtualMachinesToKubernetesClusterParams) Clone() *AddVirtualMachinesToKubernetesClusterParams {
+	return &AddVirtualMachinesToKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *AddVirtualMachinesToKubernetesClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3112,13 +4093,22 @@ func (s *KubernetesService) NewAddVirtualMachinesToKubernetesClusterParams(id st
 
 // Add VMs to an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes clusters.
 func (s *KubernetesService) AddVirtualMachinesToKubernetesCluster(p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("addVirtualMachinesToKubernetesCluster", p.toURLValues())
+	return s.AddVirtualMachinesToKubernetesClusterWithContext(context.Background(), p)
+}
+
+// AddVirtualMachinesToKubernetesClusterWithContext is like AddVirtualMachinesToKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) AddVirtualMachinesToKubernetesClusterWithContext(ctx context.Context, p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addVirtualMachinesToKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddVirtualMachinesToKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addVirtualMachinesToKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3126,10 +4116,11 @@ func (s *KubernetesService) AddVirtualMachinesToKubernetesCluster(p *AddVirtualM
 }
 
 type AddVirtualMachinesToKubernetesClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *AddVirtualMachinesToKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
@@ -3156,7 +4147,7 @@ func (r *AddVirtualMachinesToKubernetesClusterResponse) UnmarshalJSON(b []byte)
 	}
 
 	type alias AddVirtualMachinesToKubernetesClusterResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveVirtualMachinesFromKubernetesClusterParams struct {
@@ -3189,6 +4180,48 @@ func (p *RemoveVirtualMachinesFromKubernetesClusterParams) toURLValues() url.Val
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeVirtualMachinesFromKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeVirtualMachinesFromKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeVirtualMachinesFromKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "virtualmachineids"},
+		lengths:  map[string]int{"id": 255, "keyword": 255},
+	}.validate("removeVirtualMachinesFromKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeVirtualMachinesFromKubernetesCluster API, before the request is signed
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeVirtualMachinesFromKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[string],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"virtualmachineids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveVirtualMachinesFromKubernetesClusterParams) Clone() *RemoveVirtualMachinesFromKubernetesClusterParams {
+	return &RemoveVirtualMachinesFromKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveVirtualMachinesFromKubernetesClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3306,13 +4339,22 @@ func (s *KubernetesService) NewRemoveVirtualMachinesFromKubernetesClusterParams(
 
 // Remove VMs from an ExternalManaged kubernetes cluster. Not applicable for CloudManaged kubernetes clusters.
 func (s *KubernetesService) RemoveVirtualMachinesFromKubernetesCluster(p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("removeVirtualMachinesFromKubernetesCluster", p.toURLValues())
+	return s.RemoveVirtualMachinesFromKubernetesClusterWithContext(context.Background(), p)
+}
+
+// RemoveVirtualMachinesFromKubernetesClusterWithContext is like RemoveVirtualMachinesFromKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) RemoveVirtualMachinesFromKubernetesClusterWithContext(ctx context.Context, p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeVirtualMachinesFromKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveVirtualMachinesFromKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeVirtualMachinesFromKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3320,11 +4362,12 @@ func (s *KubernetesService) RemoveVirtualMachinesFromKubernetesCluster(p *Remove
 }
 
 type RemoveVirtualMachinesFromKubernetesClusterResponse struct {
-	Displaytext string `json:"displaytext"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RemoveVirtualMachinesFromKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
@@ -3351,7 +4394,7 @@ func (r *RemoveVirtualMachinesFromKubernetesClusterResponse) UnmarshalJSON(b []b
 	}
 
 	type alias RemoveVirtualMachinesFromKubernetesClusterResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddNodesToKubernetesClusterParams struct {
@@ -3381,6 +4424,47 @@ func (p *AddNodesToKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNodesToKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNodesToKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNodesToKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNodesToKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNodesToKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "nodeids"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("addNodesToKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNodesToKubernetesCluster API, before the request is signed
+func (p *AddNodesToKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNodesToKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNodesToKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNodesToKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"id":              decodeParam[string],
+		"manualupgrade":   decodeParam[bool],
+		"mountcksisoonvr": decodeParam[bool],
+		"nodeids":         decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNodesToKubernetesClusterParams) Clone() *AddNodesToKubernetesClusterParams {
+	return &AddNodesToKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNodesToKubernetesClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3477,32 +4561,39 @@ func (s *KubernetesService) NewAddNodesToKubernetesClusterParams(id string, node
 
 // Add nodes as workers to an existing CKS cluster.
 func (s *KubernetesService) AddNodesToKubernetesCluster(p *AddNodesToKubernetesClusterParams) (*AddNodesToKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("addNodesToKubernetesCluster", p.toURLValues())
+	return s.AddNodesToKubernetesClusterWithContext(context.Background(), p)
+}
+
+// AddNodesToKubernetesClusterWithContext is like AddNodesToKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) AddNodesToKubernetesClusterWithContext(ctx context.Context, p *AddNodesToKubernetesClusterParams) (*AddNodesToKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addNodesToKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNodesToKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNodesToKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addNodesToKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addNodesToKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3510,57 +4601,82 @@ func (s *KubernetesService) AddNodesToKubernetesCluster(p *AddNodesToKubernetesC
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddNodesToKubernetesCluster async job into r
+func (r *AddNodesToKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddNodesToKubernetesClusterAsync starts the async job of AddNodesToKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) AddNodesToKubernetesClusterAsync(ctx context.Context, p *AddNodesToKubernetesClusterParams) (*Job, error) {
+	r, err := s.AddNodesToKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addNodesToKubernetesCluster", r.JobID), nil
+}
+
 type AddNodesToKubernetesClusterResponse struct {
-	Account               string            `json:"account"`
-	Associatednetworkname string            `json:"associatednetworkname"`
-	Autoscalingenabled    bool              `json:"autoscalingenabled"`
-	Clustertype           string            `json:"clustertype"`
-	Cniconfigname         string            `json:"cniconfigname"`
-	Cniconfigurationid    string            `json:"cniconfigurationid"`
-	Consoleendpoint       string            `json:"consoleendpoint"`
-	Controlnodes          int64             `json:"controlnodes"`
-	Controlofferingid     string            `json:"controlofferingid"`
-	Controlofferingname   string            `json:"controlofferingname"`
-	Cpunumber             string            `json:"cpunumber"`
-	Created               string            `json:"created"`
-	Csienabled            bool              `json:"csienabled"`
-	Description           string            `json:"description"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainpath            string            `json:"domainpath"`
-	Endpoint              string            `json:"endpoint"`
-	Etcdips               map[string]string `json:"etcdips"`
-	Etcdnodes             int64             `json:"etcdnodes"`
-	Etcdofferingid        string            `json:"etcdofferingid"`
-	Etcdofferingname      string            `json:"etcdofferingname"`
-	Hasannotations        bool              `json:"hasannotations"`
-	Id                    string            `json:"id"`
-	Ipaddress             string            `json:"ipaddress"`
-	Ipaddressid           string            `json:"ipaddressid"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Keypair               string            `json:"keypair"`
-	Kubernetesversionid   string            `json:"kubernetesversionid"`
-	Kubernetesversionname string            `json:"kubernetesversionname"`
-	Masternodes           int64             `json:"masternodes"`
-	Maxsize               int64             `json:"maxsize"`
-	Memory                string            `json:"memory"`
-	Minsize               int64             `json:"minsize"`
-	Name                  string            `json:"name"`
-	Networkid             string            `json:"networkid"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Serviceofferingid     string            `json:"serviceofferingid"`
-	Serviceofferingname   string            `json:"serviceofferingname"`
-	Size                  int64             `json:"size"`
-	State                 string            `json:"state"`
-	Templateid            string            `json:"templateid"`
-	Templatename          string            `json:"templatename"`
-	Virtualmachines       []*VirtualMachine `json:"virtualmachines"`
-	Workerofferingid      string            `json:"workerofferingid"`
-	Workerofferingname    string            `json:"workerofferingname"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string                     `json:"account"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Autoscalingenabled    FlexBool                   `json:"autoscalingenabled"`
+	Clustertype           string                     `json:"clustertype"`
+	Cniconfigname         string                     `json:"cniconfigname"`
+	Cniconfigurationid    string                     `json:"cniconfigurationid"`
+	Consoleendpoint       string                     `json:"consoleendpoint"`
+	Controlnodes          FlexInt64                  `json:"controlnodes"`
+	Controlofferingid     string                     `json:"controlofferingid"`
+	Controlofferingname   string                     `json:"controlofferingname"`
+	Cpunumber             string                     `json:"cpunumber"`
+	Created               Time                       `json:"created"`
+	Csienabled            FlexBool                   `json:"csienabled"`
+	Description           string                     `json:"description"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Endpoint              string                     `json:"endpoint"`
+	Etcdips               map[string]string          `json:"etcdips"`
+	Etcdnodes             FlexInt64                  `json:"etcdnodes"`
+	Etcdofferingid        string                     `json:"etcdofferingid"`
+	Etcdofferingname      string                     `json:"etcdofferingname"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Id                    string                     `json:"id"`
+	Ipaddress             string                     `json:"ipaddress"`
+	Ipaddressid           string                     `json:"ipaddressid"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Keypair               string                     `json:"keypair"`
+	Kubernetesversionid   string                     `json:"kubernetesversionid"`
+	Kubernetesversionname string                     `json:"kubernetesversionname"`
+	Masternodes           FlexInt64                  `json:"masternodes"`
+	Maxsize               FlexInt64                  `json:"maxsize"`
+	Memory                string                     `json:"memory"`
+	Minsize               FlexInt64                  `json:"minsize"`
+	Name                  string                     `json:"name"`
+	Networkid             string                     `json:"networkid"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	Size                  FlexInt64                  `json:"size"`
+	State                 string                     `json:"state"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Virtualmachines       []*VirtualMachine          `json:"virtualmachines"`
+	Workerofferingid      string                     `json:"workerofferingid"`
+	Workerofferingname    string                     `json:"workerofferingname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNodesToKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias AddNodesToKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveNodesFromKubernetesClusterParams struct {
@@ -3582,6 +4698,45 @@ func (p *RemoveNodesFromKubernetesClusterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeNodesFromKubernetesCluster API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveNodesFromKubernetesClusterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeNodesFromKubernetesCluster", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeNodesFromKubernetesCluster API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveNodesFromKubernetesClusterParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "nodeids"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeNodesFromKubernetesCluster", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeNodesFromKubernetesCluster API, before the request is signed
+func (p *RemoveNodesFromKubernetesClusterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveNodesFromKubernetesClusterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveNodesFromKubernetesClusterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeNodesFromKubernetesCluster", b, &p.p, map[string]paramDecoder{
+		"id":      decodeParam[string],
+		"nodeids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveNodesFromKubernetesClusterParams) Clone() *RemoveNodesFromKubernetesClusterParams {
+	return &RemoveNodesFromKubernetesClusterParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveNodesFromKubernetesClusterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3636,32 +4791,39 @@ func (s *KubernetesService) NewRemoveNodesFromKubernetesClusterParams(id string,
 
 // Removes external nodes from a CKS cluster.
 func (s *KubernetesService) RemoveNodesFromKubernetesCluster(p *RemoveNodesFromKubernetesClusterParams) (*RemoveNodesFromKubernetesClusterResponse, error) {
-	resp, err := s.cs.newPostRequest("removeNodesFromKubernetesCluster", p.toURLValues())
+	return s.RemoveNodesFromKubernetesClusterWithContext(context.Background(), p)
+}
+
+// RemoveNodesFromKubernetesClusterWithContext is like RemoveNodesFromKubernetesCluster, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) RemoveNodesFromKubernetesClusterWithContext(ctx context.Context, p *RemoveNodesFromKubernetesClusterParams) (*RemoveNodesFromKubernetesClusterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeNodesFromKubernetesCluster", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveNodesFromKubernetesClusterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeNodesFromKubernetesCluster", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeNodesFromKubernetesCluster", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeNodesFromKubernetesCluster", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3669,57 +4831,82 @@ func (s *KubernetesService) RemoveNodesFromKubernetesCluster(p *RemoveNodesFromK
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveNodesFromKubernetesCluster async job into r
+func (r *RemoveNodesFromKubernetesClusterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RemoveNodesFromKubernetesClusterAsync starts the async job of RemoveNodesFromKubernetesCluster without waiting for it to finish, and returns a handle to the job
+func (s *KubernetesService) RemoveNodesFromKubernetesClusterAsync(ctx context.Context, p *RemoveNodesFromKubernetesClusterParams) (*Job, error) {
+	r, err := s.RemoveNodesFromKubernetesClusterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeNodesFromKubernetesCluster", r.JobID), nil
+}
+
 type RemoveNodesFromKubernetesClusterResponse struct {
-	Account               string            `json:"account"`
-	Associatednetworkname string            `json:"associatednetworkname"`
-	Autoscalingenabled    bool              `json:"autoscalingenabled"`
-	Clustertype           string            `json:"clustertype"`
-	Cniconfigname         string            `json:"cniconfigname"`
-	Cniconfigurationid    string            `json:"cniconfigurationid"`
-	Consoleendpoint       string            `json:"consoleendpoint"`
-	Controlnodes          int64             `json:"controlnodes"`
-	Controlofferingid     string            `json:"controlofferingid"`
-	Controlofferingname   string            `json:"controlofferingname"`
-	Cpunumber             string            `json:"cpunumber"`
-	Created               string            `json:"created"`
-	Csienabled            bool              `json:"csienabled"`
-	Description           string            `json:"description"`
-	Domain                string            `json:"domain"`
-	Domainid              string            `json:"domainid"`
-	Domainpath            string            `json:"domainpath"`
-	Endpoint              string            `json:"endpoint"`
-	Etcdips               map[string]string `json:"etcdips"`
-	Etcdnodes             int64             `json:"etcdnodes"`
-	Etcdofferingid        string            `json:"etcdofferingid"`
-	Etcdofferingname      string            `json:"etcdofferingname"`
-	Hasannotations        bool              `json:"hasannotations"`
-	Id                    string            `json:"id"`
-	Ipaddress             string            `json:"ipaddress"`
-	Ipaddressid           string            `json:"ipaddressid"`
-	JobID                 string            `json:"jobid"`
-	Jobstatus             int               `json:"jobstatus"`
-	Keypair               string            `json:"keypair"`
-	Kubernetesversionid   string            `json:"kubernetesversionid"`
-	Kubernetesversionname string            `json:"kubernetesversionname"`
-	Masternodes           int64             `json:"masternodes"`
-	Maxsize               int64             `json:"maxsize"`
-	Memory                string            `json:"memory"`
-	Minsize               int64             `json:"minsize"`
-	Name                  string            `json:"name"`
-	Networkid             string            `json:"networkid"`
-	Project               string            `json:"project"`
-	Projectid             string            `json:"projectid"`
-	Serviceofferingid     string            `json:"serviceofferingid"`
-	Serviceofferingname   string            `json:"serviceofferingname"`
-	Size                  int64             `json:"size"`
-	State                 string            `json:"state"`
-	Templateid            string            `json:"templateid"`
-	Templatename          string            `json:"templatename"`
-	Virtualmachines       []*VirtualMachine `json:"virtualmachines"`
-	Workerofferingid      string            `json:"workerofferingid"`
-	Workerofferingname    string            `json:"workerofferingname"`
-	Zoneid                string            `json:"zoneid"`
-	Zonename              string            `json:"zonename"`
+	Account               string                     `json:"account"`
+	Associatednetworkname string                     `json:"associatednetworkname"`
+	Autoscalingenabled    FlexBool                   `json:"autoscalingenabled"`
+	Clustertype           string                     `json:"clustertype"`
+	Cniconfigname         string                     `json:"cniconfigname"`
+	Cniconfigurationid    string                     `json:"cniconfigurationid"`
+	Consoleendpoint       string                     `json:"consoleendpoint"`
+	Controlnodes          FlexInt64                  `json:"controlnodes"`
+	Controlofferingid     string                     `json:"controlofferingid"`
+	Controlofferingname   string                     `json:"controlofferingname"`
+	Cpunumber             string                     `json:"cpunumber"`
+	Created               Time                       `json:"created"`
+	Csienabled            FlexBool                   `json:"csienabled"`
+	Description           string                     `json:"description"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Endpoint              string                     `json:"endpoint"`
+	Etcdips               map[string]string          `json:"etcdips"`
+	Etcdnodes             FlexInt64                  `json:"etcdnodes"`
+	Etcdofferingid        string                     `json:"etcdofferingid"`
+	Etcdofferingname      string                     `json:"etcdofferingname"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Id                    string                     `json:"id"`
+	Ipaddress             string                     `json:"ipaddress"`
+	Ipaddressid           string                     `json:"ipaddressid"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Keypair               string                     `json:"keypair"`
+	Kubernetesversionid   string                     `json:"kubernetesversionid"`
+	Kubernetesversionname string                     `json:"kubernetesversionname"`
+	Masternodes           FlexInt64                  `json:"masternodes"`
+	Maxsize               FlexInt64                  `json:"maxsize"`
+	Memory                string                     `json:"memory"`
+	Minsize               FlexInt64                  `json:"minsize"`
+	Name                  string                     `json:"name"`
+	Networkid             string                     `json:"networkid"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	Size                  FlexInt64                  `json:"size"`
+	State                 string                     `json:"state"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Virtualmachines       []*VirtualMachine          `json:"virtualmachines"`
+	Workerofferingid      string                     `json:"workerofferingid"`
+	Workerofferingname    string                     `json:"workerofferingname"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveNodesFromKubernetesClusterResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveNodesFromKubernetesClusterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetUploadParamsForKubernetesSupportedVersionParams struct {
@@ -3769,6 +4956,53 @@ func (p *GetUploadParamsForKubernetesSupportedVersionParams) toURLValues() url.V
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getUploadParamsForKubernetesSupportedVersion API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetUploadParamsForKubernetesSupportedVersionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getUploadParamsForKubernetesSupportedVersion", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getUploadParamsForKubernetesSupportedVersion API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetUploadParamsForKubernetesSupportedVersionParams) Validate() error {
+	return paramRules{
+		required: []string{"format", "mincpunumber", "minmemory", "name", "semanticversion", "zoneid"},
+		lengths:  map[string]int{"account": 255, "checksum": 255, "domainid": 255, "format": 255, "name": 255, "projectid": 255, "semanticversion": 255, "zoneid": 255},
+	}.validate("getUploadParamsForKubernetesSupportedVersion", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getUploadParamsForKubernetesSupportedVersion API, before the request is signed
+func (p *GetUploadParamsForKubernetesSupportedVersionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetUploadParamsForKubernetesSupportedVersionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetUploadParamsForKubernetesSupportedVersionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getUploadParamsForKubernetesSupportedVersion", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"checksum":        decodeParam[string],
+		"domainid":        decodeParam[string],
+		"format":          decodeParam[string],
+		"mincpunumber":    decodeParam[int],
+		"minmemory":       decodeParam[int],
+		"name":            decodeParam[string],
+		"projectid":       decodeParam[string],
+		"semanticversion": decodeParam[string],
+		"zoneid":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetUploadParamsForKubernetesSupportedVersionParams) Clone() *GetUploadParamsForKubernetesSupportedVersionParams {
+	return &GetUploadParamsForKubernetesSupportedVersionParams{p: cloneParams(p.p)}
+}
+
 func (p *GetUploadParamsForKubernetesSupportedVersionParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3995,7 +5229,16 @@ func (s *KubernetesService) NewGetUploadParamsForKubernetesSupportedVersionParam
 
 // Upload a supported Kubernetes version
 func (s *KubernetesService) GetUploadParamsForKubernetesSupportedVersion(p *GetUploadParamsForKubernetesSupportedVersionParams) (*GetUploadParamsForKubernetesSupportedVersionResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForKubernetesSupportedVersion", p.toURLValues())
+	return s.GetUploadParamsForKubernetesSupportedVersionWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForKubernetesSupportedVersionWithContext is like GetUploadParamsForKubernetesSupportedVersion, but honours the cancellation and deadline of ctx
+func (s *KubernetesService) GetUploadParamsForKubernetesSupportedVersionWithContext(ctx context.Context, p *GetUploadParamsForKubernetesSupportedVersionParams) (*GetUploadParamsForKubernetesSupportedVersionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getUploadParamsForKubernetesSupportedVersion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4003,7 +5246,7 @@ func (s *KubernetesService) GetUploadParamsForKubernetesSupportedVersion(p *GetU
 	var nested struct {
 		Response GetUploadParamsForKubernetesSupportedVersionResponse `json:"getuploadparams"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("getUploadParamsForKubernetesSupportedVersion", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) AddKubernetesSupportedVersion(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddKubernetesSupportedVersion", reflect.TypeOf((*MockKubernetesServiceIface)(nil).AddKubernetesSupportedVersion), p)
 }
 
+// AddKubernetesSupportedVersionWithContext mocks base method.
+func (m *MockKubernetesServiceIface) AddKubernetesSupportedVersionWithContext(ctx context.Context, p *AddKubernetesSupportedVersionParams) (*AddKubernetesSupportedVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddKubernetesSupportedVersionWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddKubernetesSupportedVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddKubernetesSupportedVersionWithContext indicates an expected call of AddKubernetesSupportedVersionWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) AddKubernetesSupportedVersionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddKubernetesSupportedVersionWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).AddKubernetesSupportedVersionWithContext), ctx, p)
+}
+
 // AddNodesToKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) AddNodesToKubernetesCluster(p *AddNodesToKubernetesClusterParams) (*AddNodesToKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) AddNodesToKubernetesCluster(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNodesToKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).AddNodesToKubernetesCluster), p)
 }
 
+// AddNodesToKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) AddNodesToKubernetesClusterAsync(ctx context.Context, p *AddNodesToKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNodesToKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNodesToKubernetesClusterAsync indicates an expected call of AddNodesToKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) AddNodesToKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNodesToKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).AddNodesToKubernetesClusterAsync), ctx, p)
+}
+
+// AddNodesToKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) AddNodesToKubernetesClusterWithContext(ctx context.Context, p *AddNodesToKubernetesClusterParams) (*AddNodesToKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNodesToKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNodesToKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNodesToKubernetesClusterWithContext indicates an expected call of AddNodesToKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) AddNodesToKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNodesToKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).AddNodesToKubernetesClusterWithContext), ctx, p)
+}
+
 // AddVirtualMachinesToKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) AddVirtualMachinesToKubernetesCluster(p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) AddVirtualMachinesToKubernetes
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVirtualMachinesToKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).AddVirtualMachinesToKubernetesCluster), p)
 }
 
+// AddVirtualMachinesToKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) AddVirtualMachinesToKubernetesClusterWithContext(ctx context.Context, p *AddVirtualMachinesToKubernetesClusterParams) (*AddVirtualMachinesToKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVirtualMachinesToKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddVirtualMachinesToKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVirtualMachinesToKubernetesClusterWithContext indicates an expected call of AddVirtualMachinesToKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) AddVirtualMachinesToKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVirtualMachinesToKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).AddVirtualMachinesToKubernetesClusterWithContext), ctx, p)
+}
+
 // CreateKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) CreateKubernetesCluster(p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) CreateKubernetesCluster(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).CreateKubernetesCluster), p)
 }
 
+// CreateKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) CreateKubernetesClusterAsync(ctx context.Context, p *CreateKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKubernetesClusterAsync indicates an expected call of CreateKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) CreateKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).CreateKubernetesClusterAsync), ctx, p)
+}
+
+// CreateKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) CreateKubernetesClusterWithContext(ctx context.Context, p *CreateKubernetesClusterParams) (*CreateKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateKubernetesClusterWithContext indicates an expected call of CreateKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) CreateKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).CreateKubernetesClusterWithContext), ctx, p)
+}
+
 // DeleteKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) DeleteKubernetesCluster(p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +225,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) DeleteKubernetesCluster(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).DeleteKubernetesCluster), p)
 }
 
+// DeleteKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) DeleteKubernetesClusterAsync(ctx context.Context, p *DeleteKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteKubernetesClusterAsync indicates an expected call of DeleteKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) DeleteKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).DeleteKubernetesClusterAsync), ctx, p)
+}
+
+// DeleteKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) DeleteKubernetesClusterWithContext(ctx context.Context, p *DeleteKubernetesClusterParams) (*DeleteKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteKubernetesClusterWithContext indicates an expected call of DeleteKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) DeleteKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).DeleteKubernetesClusterWithContext), ctx, p)
+}
+
 // DeleteKubernetesSupportedVersion mocks base method.
 func (m *MockKubernetesServiceIface) DeleteKubernetesSupportedVersion(p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +270,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) DeleteKubernetesSupportedVersi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubernetesSupportedVersion", reflect.TypeOf((*MockKubernetesServiceIface)(nil).DeleteKubernetesSupportedVersion), p)
 }
 
+// DeleteKubernetesSupportedVersionAsync mocks base method.
+func (m *MockKubernetesServiceIface) DeleteKubernetesSupportedVersionAsync(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKubernetesSupportedVersionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteKubernetesSupportedVersionAsync indicates an expected call of DeleteKubernetesSupportedVersionAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) DeleteKubernetesSupportedVersionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubernetesSupportedVersionAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).DeleteKubernetesSupportedVersionAsync), ctx, p)
+}
+
+// DeleteKubernetesSupportedVersionWithContext mocks base method.
+func (m *MockKubernetesServiceIface) DeleteKubernetesSupportedVersionWithContext(ctx context.Context, p *DeleteKubernetesSupportedVersionParams) (*DeleteKubernetesSupportedVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteKubernetesSupportedVersionWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteKubernetesSupportedVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteKubernetesSupportedVersionWithContext indicates an expected call of DeleteKubernetesSupportedVersionWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) DeleteKubernetesSupportedVersionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteKubernetesSupportedVersionWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).DeleteKubernetesSupportedVersionWithContext), ctx, p)
+}
+
 // GetKubernetesClusterByID mocks base method.
 func (m *MockKubernetesServiceIface) GetKubernetesClusterByID(id string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +321,27 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterByID(id an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterByID", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterByID), varargs...)
 }
 
+// GetKubernetesClusterByIDWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetKubernetesClusterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetKubernetesClusterByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*KubernetesCluster)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubernetesClusterByIDWithContext indicates an expected call of GetKubernetesClusterByIDWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterByIDWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterByIDWithContext), varargs...)
+}
+
 // GetKubernetesClusterByName mocks base method.
 func (m *MockKubernetesServiceIface) GetKubernetesClusterByName(name string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +363,27 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterByName(nam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterByName", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterByName), varargs...)
 }
 
+// GetKubernetesClusterByNameWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetKubernetesClusterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesCluster, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetKubernetesClusterByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*KubernetesCluster)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubernetesClusterByNameWithContext indicates an expected call of GetKubernetesClusterByNameWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterByNameWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterByNameWithContext), varargs...)
+}
+
 // GetKubernetesClusterConfig mocks base method.
 func (m *MockKubernetesServiceIface) GetKubernetesClusterConfig(p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -205,6 +399,21 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterConfig(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterConfig", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterConfig), p)
 }
 
+// GetKubernetesClusterConfigWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetKubernetesClusterConfigWithContext(ctx context.Context, p *GetKubernetesClusterConfigParams) (*GetKubernetesClusterConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetKubernetesClusterConfigWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetKubernetesClusterConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetKubernetesClusterConfigWithContext indicates an expected call of GetKubernetesClusterConfigWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterConfigWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterConfigWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterConfigWithContext), ctx, p)
+}
+
 // GetKubernetesClusterID mocks base method.
 func (m *MockKubernetesServiceIface) GetKubernetesClusterID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +435,27 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterID(name an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterID", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterID), varargs...)
 }
 
+// GetKubernetesClusterIDWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetKubernetesClusterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetKubernetesClusterIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubernetesClusterIDWithContext indicates an expected call of GetKubernetesClusterIDWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesClusterIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesClusterIDWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesClusterIDWithContext), varargs...)
+}
+
 // GetKubernetesSupportedVersionByID mocks base method.
 func (m *MockKubernetesServiceIface) GetKubernetesSupportedVersionByID(id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +477,27 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesSupportedVersionB
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesSupportedVersionByID", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesSupportedVersionByID), varargs...)
 }
 
+// GetKubernetesSupportedVersionByIDWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetKubernetesSupportedVersionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetKubernetesSupportedVersionByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*KubernetesSupportedVersion)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubernetesSupportedVersionByIDWithContext indicates an expected call of GetKubernetesSupportedVersionByIDWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesSupportedVersionByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesSupportedVersionByIDWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesSupportedVersionByIDWithContext), varargs...)
+}
+
 // GetKubernetesSupportedVersionByName mocks base method.
 func (m *MockKubernetesServiceIface) GetKubernetesSupportedVersionByName(name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +519,27 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesSupportedVersionB
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesSupportedVersionByName", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesSupportedVersionByName), varargs...)
 }
 
+// GetKubernetesSupportedVersionByNameWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetKubernetesSupportedVersionByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*KubernetesSupportedVersion, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetKubernetesSupportedVersionByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*KubernetesSupportedVersion)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubernetesSupportedVersionByNameWithContext indicates an expected call of GetKubernetesSupportedVersionByNameWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesSupportedVersionByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesSupportedVersionByNameWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesSupportedVersionByNameWithContext), varargs...)
+}
+
 // GetKubernetesSupportedVersionID mocks base method.
 func (m *MockKubernetesServiceIface) GetKubernetesSupportedVersionID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -289,6 +561,27 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesSupportedVersionI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesSupportedVersionID", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesSupportedVersionID), varargs...)
 }
 
+// GetKubernetesSupportedVersionIDWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetKubernetesSupportedVersionIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetKubernetesSupportedVersionIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetKubernetesSupportedVersionIDWithContext indicates an expected call of GetKubernetesSupportedVersionIDWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetKubernetesSupportedVersionIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetKubernetesSupportedVersionIDWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetKubernetesSupportedVersionIDWithContext), varargs...)
+}
+
 // GetUploadParamsForKubernetesSupportedVersion mocks base method.
 func (m *MockKubernetesServiceIface) GetUploadParamsForKubernetesSupportedVersion(p *GetUploadParamsForKubernetesSupportedVersionParams) (*GetUploadParamsForKubernetesSupportedVersionResponse, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +597,21 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) GetUploadParamsForKubernetesSu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForKubernetesSupportedVersion", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetUploadParamsForKubernetesSupportedVersion), p)
 }
 
+// GetUploadParamsForKubernetesSupportedVersionWithContext mocks base method.
+func (m *MockKubernetesServiceIface) GetUploadParamsForKubernetesSupportedVersionWithContext(ctx context.Context, p *GetUploadParamsForKubernetesSupportedVersionParams) (*GetUploadParamsForKubernetesSupportedVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadParamsForKubernetesSupportedVersionWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetUploadParamsForKubernetesSupportedVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadParamsForKubernetesSupportedVersionWithContext indicates an expected call of GetUploadParamsForKubernetesSupportedVersionWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) GetUploadParamsForKubernetesSupportedVersionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForKubernetesSupportedVersionWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).GetUploadParamsForKubernetesSupportedVersionWithContext), ctx, p)
+}
+
 // ListKubernetesClusters mocks base method.
 func (m *MockKubernetesServiceIface) ListKubernetesClusters(p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +627,99 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesClusters(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesClusters", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesClusters), p)
 }
 
+// ListKubernetesClustersAll mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesClustersAll(p *ListKubernetesClustersParams, opts ...PageOption) ([]*KubernetesCluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesClustersAll", varargs...)
+	ret0, _ := ret[0].([]*KubernetesCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesClustersAll indicates an expected call of ListKubernetesClustersAll.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesClustersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesClustersAll", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesClustersAll), varargs...)
+}
+
+// ListKubernetesClustersAllWithContext mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesClustersAllWithContext(ctx context.Context, p *ListKubernetesClustersParams, opts ...PageOption) ([]*KubernetesCluster, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesClustersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*KubernetesCluster)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesClustersAllWithContext indicates an expected call of ListKubernetesClustersAllWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesClustersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesClustersAllWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesClustersAllWithContext), varargs...)
+}
+
+// ListKubernetesClustersIter mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesClustersIter(p *ListKubernetesClustersParams, opts ...PageOption) iter.Seq2[*KubernetesCluster, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesClustersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*KubernetesCluster, error])
+	return ret0
+}
+
+// ListKubernetesClustersIter indicates an expected call of ListKubernetesClustersIter.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesClustersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesClustersIter", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesClustersIter), varargs...)
+}
+
+// ListKubernetesClustersIterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesClustersIterWithContext(ctx context.Context, p *ListKubernetesClustersParams, opts ...PageOption) iter.Seq2[*KubernetesCluster, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesClustersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*KubernetesCluster, error])
+	return ret0
+}
+
+// ListKubernetesClustersIterWithContext indicates an expected call of ListKubernetesClustersIterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesClustersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesClustersIterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesClustersIterWithContext), varargs...)
+}
+
+// ListKubernetesClustersWithContext mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesClustersWithContext(ctx context.Context, p *ListKubernetesClustersParams) (*ListKubernetesClustersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubernetesClustersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListKubernetesClustersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesClustersWithContext indicates an expected call of ListKubernetesClustersWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesClustersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesClustersWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesClustersWithContext), ctx, p)
+}
+
 // ListKubernetesSupportedVersions mocks base method.
 func (m *MockKubernetesServiceIface) ListKubernetesSupportedVersions(p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -334,6 +735,99 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesSupportedVersion
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSupportedVersions", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesSupportedVersions), p)
 }
 
+// ListKubernetesSupportedVersionsAll mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesSupportedVersionsAll(p *ListKubernetesSupportedVersionsParams, opts ...PageOption) ([]*KubernetesSupportedVersion, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesSupportedVersionsAll", varargs...)
+	ret0, _ := ret[0].([]*KubernetesSupportedVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesSupportedVersionsAll indicates an expected call of ListKubernetesSupportedVersionsAll.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesSupportedVersionsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSupportedVersionsAll", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesSupportedVersionsAll), varargs...)
+}
+
+// ListKubernetesSupportedVersionsAllWithContext mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesSupportedVersionsAllWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams, opts ...PageOption) ([]*KubernetesSupportedVersion, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesSupportedVersionsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*KubernetesSupportedVersion)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesSupportedVersionsAllWithContext indicates an expected call of ListKubernetesSupportedVersionsAllWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesSupportedVersionsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSupportedVersionsAllWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesSupportedVersionsAllWithContext), varargs...)
+}
+
+// ListKubernetesSupportedVersionsIter mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesSupportedVersionsIter(p *ListKubernetesSupportedVersionsParams, opts ...PageOption) iter.Seq2[*KubernetesSupportedVersion, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesSupportedVersionsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*KubernetesSupportedVersion, error])
+	return ret0
+}
+
+// ListKubernetesSupportedVersionsIter indicates an expected call of ListKubernetesSupportedVersionsIter.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesSupportedVersionsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSupportedVersionsIter", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesSupportedVersionsIter), varargs...)
+}
+
+// ListKubernetesSupportedVersionsIterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesSupportedVersionsIterWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams, opts ...PageOption) iter.Seq2[*KubernetesSupportedVersion, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListKubernetesSupportedVersionsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*KubernetesSupportedVersion, error])
+	return ret0
+}
+
+// ListKubernetesSupportedVersionsIterWithContext indicates an expected call of ListKubernetesSupportedVersionsIterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesSupportedVersionsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSupportedVersionsIterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesSupportedVersionsIterWithContext), varargs...)
+}
+
+// ListKubernetesSupportedVersionsWithContext mocks base method.
+func (m *MockKubernetesServiceIface) ListKubernetesSupportedVersionsWithContext(ctx context.Context, p *ListKubernetesSupportedVersionsParams) (*ListKubernetesSupportedVersionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListKubernetesSupportedVersionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListKubernetesSupportedVersionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListKubernetesSupportedVersionsWithContext indicates an expected call of ListKubernetesSupportedVersionsWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ListKubernetesSupportedVersionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListKubernetesSupportedVersionsWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ListKubernetesSupportedVersionsWithContext), ctx, p)
+}
+
 // NewAddKubernetesSupportedVersionParams mocks base method.
 func (m *MockKubernetesServiceIface) NewAddKubernetesSupportedVersionParams(mincpunumber, minmemory int, semanticversion string) *AddKubernetesSupportedVersionParams {
 	m.ctrl.T.Helper()
@@ -587,6 +1081,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) RemoveNodesFromKubernetesClust
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNodesFromKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).RemoveNodesFromKubernetesCluster), p)
 }
 
+// RemoveNodesFromKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) RemoveNodesFromKubernetesClusterAsync(ctx context.Context, p *RemoveNodesFromKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNodesFromKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNodesFromKubernetesClusterAsync indicates an expected call of RemoveNodesFromKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) RemoveNodesFromKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNodesFromKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).RemoveNodesFromKubernetesClusterAsync), ctx, p)
+}
+
+// RemoveNodesFromKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) RemoveNodesFromKubernetesClusterWithContext(ctx context.Context, p *RemoveNodesFromKubernetesClusterParams) (*RemoveNodesFromKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNodesFromKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveNodesFromKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNodesFromKubernetesClusterWithContext indicates an expected call of RemoveNodesFromKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) RemoveNodesFromKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNodesFromKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).RemoveNodesFromKubernetesClusterWithContext), ctx, p)
+}
+
 // RemoveVirtualMachinesFromKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) RemoveVirtualMachinesFromKubernetesCluster(p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -602,6 +1126,21 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) RemoveVirtualMachinesFromKuber
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVirtualMachinesFromKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).RemoveVirtualMachinesFromKubernetesCluster), p)
 }
 
+// RemoveVirtualMachinesFromKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) RemoveVirtualMachinesFromKubernetesClusterWithContext(ctx context.Context, p *RemoveVirtualMachinesFromKubernetesClusterParams) (*RemoveVirtualMachinesFromKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVirtualMachinesFromKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveVirtualMachinesFromKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVirtualMachinesFromKubernetesClusterWithContext indicates an expected call of RemoveVirtualMachinesFromKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) RemoveVirtualMachinesFromKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVirtualMachinesFromKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).RemoveVirtualMachinesFromKubernetesClusterWithContext), ctx, p)
+}
+
 // ScaleKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) ScaleKubernetesCluster(p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -617,6 +1156,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) ScaleKubernetesCluster(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ScaleKubernetesCluster), p)
 }
 
+// ScaleKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) ScaleKubernetesClusterAsync(ctx context.Context, p *ScaleKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleKubernetesClusterAsync indicates an expected call of ScaleKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ScaleKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ScaleKubernetesClusterAsync), ctx, p)
+}
+
+// ScaleKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) ScaleKubernetesClusterWithContext(ctx context.Context, p *ScaleKubernetesClusterParams) (*ScaleKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*ScaleKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleKubernetesClusterWithContext indicates an expected call of ScaleKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) ScaleKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).ScaleKubernetesClusterWithContext), ctx, p)
+}
+
 // StartKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) StartKubernetesCluster(p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -632,6 +1201,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) StartKubernetesCluster(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).StartKubernetesCluster), p)
 }
 
+// StartKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) StartKubernetesClusterAsync(ctx context.Context, p *StartKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartKubernetesClusterAsync indicates an expected call of StartKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) StartKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).StartKubernetesClusterAsync), ctx, p)
+}
+
+// StartKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) StartKubernetesClusterWithContext(ctx context.Context, p *StartKubernetesClusterParams) (*StartKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*StartKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartKubernetesClusterWithContext indicates an expected call of StartKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) StartKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).StartKubernetesClusterWithContext), ctx, p)
+}
+
 // StopKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) StopKubernetesCluster(p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -647,6 +1246,36 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) StopKubernetesCluster(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).StopKubernetesCluster), p)
 }
 
+// StopKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) StopKubernetesClusterAsync(ctx context.Context, p *StopKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopKubernetesClusterAsync indicates an expected call of StopKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) StopKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).StopKubernetesClusterAsync), ctx, p)
+}
+
+// StopKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) StopKubernetesClusterWithContext(ctx context.Context, p *StopKubernetesClusterParams) (*StopKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*StopKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopKubernetesClusterWithContext indicates an expected call of StopKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) StopKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).StopKubernetesClusterWithContext), ctx, p)
+}
+
 // UpdateKubernetesSupportedVersion mocks base method.
 func (m *MockKubernetesServiceIface) UpdateKubernetesSupportedVersion(p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error) {
 	m.ctrl.T.Helper()
@@ -662,6 +1291,21 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) UpdateKubernetesSupportedVersi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKubernetesSupportedVersion", reflect.TypeOf((*MockKubernetesServiceIface)(nil).UpdateKubernetesSupportedVersion), p)
 }
 
+// UpdateKubernetesSupportedVersionWithContext mocks base method.
+func (m *MockKubernetesServiceIface) UpdateKubernetesSupportedVersionWithContext(ctx context.Context, p *UpdateKubernetesSupportedVersionParams) (*UpdateKubernetesSupportedVersionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateKubernetesSupportedVersionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateKubernetesSupportedVersionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateKubernetesSupportedVersionWithContext indicates an expected call of UpdateKubernetesSupportedVersionWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) UpdateKubernetesSupportedVersionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKubernetesSupportedVersionWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).UpdateKubernetesSupportedVersionWithContext), ctx, p)
+}
+
 // UpgradeKubernetesCluster mocks base method.
 func (m *MockKubernetesServiceIface) UpgradeKubernetesCluster(p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error) {
 	m.ctrl.T.Helper()
@@ -676,3 +1320,33 @@ func (mr *MockKubernetesServiceIfaceMockRecorder) UpgradeKubernetesCluster(p any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeKubernetesCluster", reflect.TypeOf((*MockKubernetesServiceIface)(nil).UpgradeKubernetesCluster), p)
 }
+
+// UpgradeKubernetesClusterAsync mocks base method.
+func (m *MockKubernetesServiceIface) UpgradeKubernetesClusterAsync(ctx context.Context, p *UpgradeKubernetesClusterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeKubernetesClusterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeKubernetesClusterAsync indicates an expected call of UpgradeKubernetesClusterAsync.
+func (mr *MockKubernetesServiceIfaceMockRecorder) UpgradeKubernetesClusterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeKubernetesClusterAsync", reflect.TypeOf((*MockKubernetesServiceIface)(nil).UpgradeKubernetesClusterAsync), ctx, p)
+}
+
+// UpgradeKubernetesClusterWithContext mocks base method.
+func (m *MockKubernetesServiceIface) UpgradeKubernetesClusterWithContext(ctx context.Context, p *UpgradeKubernetesClusterParams) (*UpgradeKubernetesClusterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeKubernetesClusterWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpgradeKubernetesClusterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeKubernetesClusterWithContext indicates an expected call of UpgradeKubernetesClusterWithContext.
+func (mr *MockKubernetesServiceIfaceMockRecorder) UpgradeKubernetesClusterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeKubernetesClusterWithContext", reflect.TypeOf((*MockKubernetesServiceIface)(nil).UpgradeKubernetesClusterWithContext), ctx, p)
+}
@@ -20,34 +20,54 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type LDAPServiceIface interface {
 	AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
+	AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error)
 	NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams
 	DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
+	DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error)
 	NewDeleteLdapConfigurationParams() *DeleteLdapConfigurationParams
 	ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
+	ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error)
 	NewImportLdapUsersParams() *ImportLdapUsersParams
 	LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error)
+	LdapConfigWithContext(ctx context.Context, p *LdapConfigParams) (*LdapConfigResponse, error)
 	NewLdapConfigParams() *LdapConfigParams
 	LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
+	LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error)
 	NewLdapCreateAccountParams(username string) *LdapCreateAccountParams
 	LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error)
+	LdapRemoveWithContext(ctx context.Context, p *LdapRemoveParams) (*LdapRemoveResponse, error)
 	NewLdapRemoveParams() *LdapRemoveParams
 	LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
+	LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error)
 	NewLinkDomainToLdapParams(accounttype int, domainid string, lDAPType string) *LinkDomainToLdapParams
 	ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error)
+	ListLdapConfigurationsAll(p *ListLdapConfigurationsParams, opts ...PageOption) ([]*LdapConfiguration, error)
+	ListLdapConfigurationsAllWithContext(ctx context.Context, p *ListLdapConfigurationsParams, opts ...PageOption) ([]*LdapConfiguration, error)
+	ListLdapConfigurationsIter(p *ListLdapConfigurationsParams, opts ...PageOption) iter.Seq2[*LdapConfiguration, error]
+	ListLdapConfigurationsIterWithContext(ctx context.Context, p *ListLdapConfigurationsParams, opts ...PageOption) iter.Seq2[*LdapConfiguration, error]
 	NewListLdapConfigurationsParams() *ListLdapConfigurationsParams
 	GetLdapConfigurationByID(id string, opts ...OptionFunc) (*LdapConfiguration, int, error)
+	GetLdapConfigurationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LdapConfiguration, int, error)
 	ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error)
+	ListLdapUsersAll(p *ListLdapUsersParams, opts ...PageOption) ([]*LdapUser, error)
+	ListLdapUsersAllWithContext(ctx context.Context, p *ListLdapUsersParams, opts ...PageOption) ([]*LdapUser, error)
+	ListLdapUsersIter(p *ListLdapUsersParams, opts ...PageOption) iter.Seq2[*LdapUser, error]
+	ListLdapUsersIterWithContext(ctx context.Context, p *ListLdapUsersParams, opts ...PageOption) iter.Seq2[*LdapUser, error]
 	NewListLdapUsersParams() *ListLdapUsersParams
 	SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error)
+	SearchLdapWithContext(ctx context.Context, p *SearchLdapParams) (*SearchLdapResponse, error)
 	NewSearchLdapParams(query string) *SearchLdapParams
 }
 
@@ -73,6 +93,46 @@ func (p *AddLdapConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addLdapConfiguration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddLdapConfigurationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addLdapConfiguration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addLdapConfiguration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddLdapConfigurationParams) Validate() error {
+	return paramRules{
+		required: []string{"hostname", "port"},
+		lengths:  map[string]int{"domainid": 255, "hostname": 255},
+	}.validate("addLdapConfiguration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addLdapConfiguration API, before the request is signed
+func (p *AddLdapConfigurationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddLdapConfigurationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddLdapConfigurationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addLdapConfiguration", b, &p.p, map[string]paramDecoder{
+		"domainid": decodeParam[string],
+		"hostname": decodeParam[string],
+		"port":     decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddLdapConfigurationParams) Clone() *AddLdapConfigurationParams {
+	return &AddLdapConfigurationParams{p: cloneParams(p.p)}
+}
+
 func (p *AddLdapConfigurationParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -148,13 +208,22 @@ func (s *LDAPService) NewAddLdapConfigurationParams(hostname string, port int) *
 
 // Add a new Ldap Configuration
 func (s *LDAPService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
-	resp, err := s.cs.newPostRequest("addLdapConfiguration", p.toURLValues())
+	return s.AddLdapConfigurationWithContext(context.Background(), p)
+}
+
+// AddLdapConfigurationWithContext is like AddLdapConfiguration, but honours the cancellation and deadline of ctx
+func (s *LDAPService) AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addLdapConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddLdapConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addLdapConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -162,12 +231,18 @@ func (s *LDAPService) AddLdapConfiguration(p *AddLdapConfigurationParams) (*AddL
 }
 
 type AddLdapConfigurationResponse struct {
-	Domainid  string `json:"domainid"`
-	Hostname  string `json:"hostname"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Port      int    `json:"port"`
+	Domainid  string                     `json:"domainid"`
+	Hostname  string                     `json:"hostname"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Port      FlexInt                    `json:"port"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddLdapConfigurationResponse) UnmarshalJSON(b []byte) error {
+	type alias AddLdapConfigurationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteLdapConfigurationParams struct {
@@ -195,6 +270,46 @@ func (p *DeleteLdapConfigurationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteLdapConfiguration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteLdapConfigurationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteLdapConfiguration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteLdapConfiguration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteLdapConfigurationParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"domainid": 255, "hostname": 255, "id": 255},
+	}.validate("deleteLdapConfiguration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteLdapConfiguration API, before the request is signed
+func (p *DeleteLdapConfigurationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteLdapConfigurationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteLdapConfigurationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteLdapConfiguration", b, &p.p, map[string]paramDecoder{
+		"domainid": decodeParam[string],
+		"hostname": decodeParam[string],
+		"id":       decodeParam[string],
+		"port":     decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteLdapConfigurationParams) Clone() *DeleteLdapConfigurationParams {
+	return &DeleteLdapConfigurationParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteLdapConfigurationParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -289,13 +404,22 @@ func (s *LDAPService) NewDeleteLdapConfigurationParams() *DeleteLdapConfiguratio
 
 // Remove an Ldap Configuration
 func (s *LDAPService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteLdapConfiguration", p.toURLValues())
+	return s.DeleteLdapConfigurationWithContext(context.Background(), p)
+}
+
+// DeleteLdapConfigurationWithContext is like DeleteLdapConfiguration, but honours the cancellation and deadline of ctx
+func (s *LDAPService) DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteLdapConfiguration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteLdapConfigurationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteLdapConfiguration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -303,12 +427,18 @@ func (s *LDAPService) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams)
 }
 
 type DeleteLdapConfigurationResponse struct {
-	Domainid  string `json:"domainid"`
-	Hostname  string `json:"hostname"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Port      int    `json:"port"`
+	Domainid  string                     `json:"domainid"`
+	Hostname  string                     `json:"hostname"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Port      FlexInt                    `json:"port"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteLdapConfigurationResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteLdapConfigurationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportLdapUsersParams struct {
@@ -360,6 +490,52 @@ func (p *ImportLdapUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the importLdapUsers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ImportLdapUsersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("importLdapUsers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the importLdapUsers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ImportLdapUsersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "group": 255, "keyword": 255, "roleid": 255, "timezone": 255},
+	}.validate("importLdapUsers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the importLdapUsers API, before the request is signed
+func (p *ImportLdapUsersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ImportLdapUsersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ImportLdapUsersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("importLdapUsers", b, &p.p, map[string]paramDecoder{
+		"account":        decodeParam[string],
+		"accountdetails": decodeParam[map[string]string],
+		"accounttype":    decodeParam[int],
+		"domainid":       decodeParam[string],
+		"group":          decodeParam[string],
+		"keyword":        decodeParam[string],
+		"page":           decodeParam[int],
+		"pagesize":       decodeParam[int],
+		"roleid":         decodeParam[string],
+		"timezone":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ImportLdapUsersParams) Clone() *ImportLdapUsersParams {
+	return &ImportLdapUsersParams{p: cloneParams(p.p)}
+}
+
 func (p *ImportLdapUsersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -580,13 +756,22 @@ func (s *LDAPService) NewImportLdapUsersParams() *ImportLdapUsersParams {
 
 // Import LDAP users
 func (s *LDAPService) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
-	resp, err := s.cs.newPostRequest("importLdapUsers", p.toURLValues())
+	return s.ImportLdapUsersWithContext(context.Background(), p)
+}
+
+// ImportLdapUsersWithContext is like ImportLdapUsers, but honours the cancellation and deadline of ctx
+func (s *LDAPService) ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "importLdapUsers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ImportLdapUsersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("importLdapUsers", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -594,15 +779,21 @@ func (s *LDAPService) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUser
 }
 
 type ImportLdapUsersResponse struct {
-	Conflictingusersource string `json:"conflictingusersource"`
-	Domain                string `json:"domain"`
-	Email                 string `json:"email"`
-	Firstname             string `json:"firstname"`
-	JobID                 string `json:"jobid"`
-	Jobstatus             int    `json:"jobstatus"`
-	Lastname              string `json:"lastname"`
-	Principal             string `json:"principal"`
-	Username              string `json:"username"`
+	Conflictingusersource string                     `json:"conflictingusersource"`
+	Domain                string                     `json:"domain"`
+	Email                 string                     `json:"email"`
+	Firstname             string                     `json:"firstname"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Lastname              string                     `json:"lastname"`
+	Principal             string                     `json:"principal"`
+	Username              string                     `json:"username"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportLdapUsersResponse) UnmarshalJSON(b []byte) error {
+	type alias ImportLdapUsersResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LdapConfigParams struct {
@@ -650,6 +841,52 @@ func (p *LdapConfigParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the ldapConfig API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LdapConfigParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("ldapConfig", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the ldapConfig API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LdapConfigParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"binddn": 255, "bindpass": 255, "hostname": 255, "queryfilter": 255, "searchbase": 255, "truststore": 255, "truststorepass": 255},
+	}.validate("ldapConfig", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the ldapConfig API, before the request is signed
+func (p *LdapConfigParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LdapConfigParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LdapConfigParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("ldapConfig", b, &p.p, map[string]paramDecoder{
+		"binddn":         decodeParam[string],
+		"bindpass":       decodeParam[string],
+		"hostname":       decodeParam[string],
+		"listall":        decodeParam[bool],
+		"port":           decodeParam[int],
+		"queryfilter":    decodeParam[string],
+		"searchbase":     decodeParam[string],
+		"ssl":            decodeParam[bool],
+		"truststore":     decodeParam[string],
+		"truststorepass": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LdapConfigParams) Clone() *LdapConfigParams {
+	return &LdapConfigParams{p: cloneParams(p.p)}
+}
+
 func (p *LdapConfigParams) SetBinddn(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -870,13 +1107,22 @@ func (s *LDAPService) NewLdapConfigParams() *LdapConfigParams {
 
 // (Deprecated, use addLdapConfiguration) Configure the LDAP context for this site.
 func (s *LDAPService) LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error) {
-	resp, err := s.cs.newPostRequest("ldapConfig", p.toURLValues())
+	return s.LdapConfigWithContext(context.Background(), p)
+}
+
+// LdapConfigWithContext is like LdapConfig, but honours the cancellation and deadline of ctx
+func (s *LDAPService) LdapConfigWithContext(ctx context.Context, p *LdapConfigParams) (*LdapConfigResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "ldapConfig", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LdapConfigResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("ldapConfig", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -884,15 +1130,21 @@ func (s *LDAPService) LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, erro
 }
 
 type LdapConfigResponse struct {
-	Binddn      string `json:"binddn"`
-	Bindpass    string `json:"bindpass"`
-	Hostname    string `json:"hostname"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Port        string `json:"port"`
-	Queryfilter string `json:"queryfilter"`
-	Searchbase  string `json:"searchbase"`
-	Ssl         string `json:"ssl"`
+	Binddn      string                     `json:"binddn"`
+	Bindpass    string                     `json:"bindpass"`
+	Hostname    string                     `json:"hostname"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Port        string                     `json:"port"`
+	Queryfilter string                     `json:"queryfilter"`
+	Searchbase  string                     `json:"searchbase"`
+	Ssl         string                     `json:"ssl"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LdapConfigResponse) UnmarshalJSON(b []byte) error {
+	type alias LdapConfigResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LdapCreateAccountParams struct {
@@ -942,6 +1194,53 @@ func (p *LdapCreateAccountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the ldapCreateAccount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LdapCreateAccountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("ldapCreateAccount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the ldapCreateAccount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LdapCreateAccountParams) Validate() error {
+	return paramRules{
+		required: []string{"username"},
+		lengths:  map[string]int{"account": 255, "accountid": 255, "domainid": 255, "networkdomain": 255, "roleid": 255, "timezone": 255, "userid": 255, "username": 255},
+	}.validate("ldapCreateAccount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the ldapCreateAccount API, before the request is signed
+func (p *LdapCreateAccountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LdapCreateAccountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LdapCreateAccountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("ldapCreateAccount", b, &p.p, map[string]paramDecoder{
+		"account":        decodeParam[string],
+		"accountdetails": decodeParam[map[string]string],
+		"accountid":      decodeParam[string],
+		"accounttype":    decodeParam[int],
+		"domainid":       decodeParam[string],
+		"networkdomain":  decodeParam[string],
+		"roleid":         decodeParam[string],
+		"timezone":       decodeParam[string],
+		"userid":         decodeParam[string],
+		"username":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LdapCreateAccountParams) Clone() *LdapCreateAccountParams {
+	return &LdapCreateAccountParams{p: cloneParams(p.p)}
+}
+
 func (p *LdapCreateAccountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1163,13 +1462,22 @@ func (s *LDAPService) NewLdapCreateAccountParams(username string) *LdapCreateAcc
 
 // Creates an account from an LDAP user
 func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
-	resp, err := s.cs.newPostRequest("ldapCreateAccount", p.toURLValues())
+	return s.LdapCreateAccountWithContext(context.Background(), p)
+}
+
+// LdapCreateAccountWithContext is like LdapCreateAccount, but honours the cancellation and deadline of ctx
+func (s *LDAPService) LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "ldapCreateAccount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LdapCreateAccountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("ldapCreateAccount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1178,111 +1486,123 @@ func (s *LDAPService) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreate
 
 type LdapCreateAccountResponse struct {
 	Accountdetails            map[string]string               `json:"accountdetails"`
-	Accounttype               int                             `json:"accounttype"`
+	Accounttype               FlexInt                         `json:"accounttype"`
 	Apikeyaccess              string                          `json:"apikeyaccess"`
 	Backupavailable           string                          `json:"backupavailable"`
 	Backuplimit               string                          `json:"backuplimit"`
 	Backupstorageavailable    string                          `json:"backupstorageavailable"`
 	Backupstoragelimit        string                          `json:"backupstoragelimit"`
-	Backupstoragetotal        int64                           `json:"backupstoragetotal"`
-	Backuptotal               int64                           `json:"backuptotal"`
+	Backupstoragetotal        FlexInt64                       `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                       `json:"backuptotal"`
 	Bucketavailable           string                          `json:"bucketavailable"`
 	Bucketlimit               string                          `json:"bucketlimit"`
-	Buckettotal               int64                           `json:"buckettotal"`
+	Buckettotal               FlexInt64                       `json:"buckettotal"`
 	Cpuavailable              string                          `json:"cpuavailable"`
 	Cpulimit                  string                          `json:"cpulimit"`
-	Cputotal                  int64                           `json:"cputotal"`
-	Created                   string                          `json:"created"`
+	Cputotal                  FlexInt64                       `json:"cputotal"`
+	Created                   Time                            `json:"created"`
 	Defaultzoneid             string                          `json:"defaultzoneid"`
 	Domain                    string                          `json:"domain"`
 	Domainid                  string                          `json:"domainid"`
 	Domainpath                string                          `json:"domainpath"`
 	Gpuavailable              string                          `json:"gpuavailable"`
 	Gpulimit                  string                          `json:"gpulimit"`
-	Gputotal                  int64                           `json:"gputotal"`
+	Gputotal                  FlexInt64                       `json:"gputotal"`
 	Groups                    []string                        `json:"groups"`
 	Icon                      interface{}                     `json:"icon"`
 	Id                        string                          `json:"id"`
 	Ipavailable               string                          `json:"ipavailable"`
 	Iplimit                   string                          `json:"iplimit"`
-	Iptotal                   int64                           `json:"iptotal"`
-	Iscleanuprequired         bool                            `json:"iscleanuprequired"`
-	Isdefault                 bool                            `json:"isdefault"`
+	Iptotal                   FlexInt64                       `json:"iptotal"`
+	Iscleanuprequired         FlexBool                        `json:"iscleanuprequired"`
+	Isdefault                 FlexBool                        `json:"isdefault"`
 	JobID                     string                          `json:"jobid"`
-	Jobstatus                 int                             `json:"jobstatus"`
+	Jobstatus                 FlexInt                         `json:"jobstatus"`
 	Memoryavailable           string                          `json:"memoryavailable"`
 	Memorylimit               string                          `json:"memorylimit"`
-	Memorytotal               int64                           `json:"memorytotal"`
+	Memorytotal               FlexInt64                       `json:"memorytotal"`
 	Name                      string                          `json:"name"`
 	Networkavailable          string                          `json:"networkavailable"`
 	Networkdomain             string                          `json:"networkdomain"`
 	Networklimit              string                          `json:"networklimit"`
-	Networktotal              int64                           `json:"networktotal"`
+	Networktotal              FlexInt64                       `json:"networktotal"`
 	Objectstorageavailable    string                          `json:"objectstorageavailable"`
 	Objectstoragelimit        string                          `json:"objectstoragelimit"`
-	Objectstoragetotal        int64                           `json:"objectstoragetotal"`
+	Objectstoragetotal        FlexInt64                       `json:"objectstoragetotal"`
 	Primarystorageavailable   string                          `json:"primarystorageavailable"`
 	Primarystoragelimit       string                          `json:"primarystoragelimit"`
-	Primarystoragetotal       int64                           `json:"primarystoragetotal"`
+	Primarystoragetotal       FlexInt64                       `json:"primarystoragetotal"`
 	Projectavailable          string                          `json:"projectavailable"`
 	Projectlimit              string                          `json:"projectlimit"`
-	Projecttotal              int64                           `json:"projecttotal"`
-	Receivedbytes             int64                           `json:"receivedbytes"`
+	Projecttotal              FlexInt64                       `json:"projecttotal"`
+	Receivedbytes             FlexInt64                       `json:"receivedbytes"`
 	Roleid                    string                          `json:"roleid"`
 	Rolename                  string                          `json:"rolename"`
 	Roletype                  string                          `json:"roletype"`
 	Secondarystorageavailable string                          `json:"secondarystorageavailable"`
 	Secondarystoragelimit     string                          `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64                         `json:"secondarystoragetotal"`
-	Sentbytes                 int64                           `json:"sentbytes"`
+	Secondarystoragetotal     FlexFloat                       `json:"secondarystoragetotal"`
+	Sentbytes                 FlexInt64                       `json:"sentbytes"`
 	Snapshotavailable         string                          `json:"snapshotavailable"`
 	Snapshotlimit             string                          `json:"snapshotlimit"`
-	Snapshottotal             int64                           `json:"snapshottotal"`
+	Snapshottotal             FlexInt64                       `json:"snapshottotal"`
 	State                     string                          `json:"state"`
 	Taggedresources           []string                        `json:"taggedresources"`
 	Templateavailable         string                          `json:"templateavailable"`
 	Templatelimit             string                          `json:"templatelimit"`
-	Templatetotal             int64                           `json:"templatetotal"`
+	Templatetotal             FlexInt64                       `json:"templatetotal"`
 	User                      []LdapCreateAccountResponseUser `json:"user"`
 	Vmavailable               string                          `json:"vmavailable"`
 	Vmlimit                   string                          `json:"vmlimit"`
-	Vmrunning                 int                             `json:"vmrunning"`
-	Vmstopped                 int                             `json:"vmstopped"`
-	Vmtotal                   int64                           `json:"vmtotal"`
+	Vmrunning                 FlexInt                         `json:"vmrunning"`
+	Vmstopped                 FlexInt                         `json:"vmstopped"`
+	Vmtotal                   FlexInt64                       `json:"vmtotal"`
 	Volumeavailable           string                          `json:"volumeavailable"`
 	Volumelimit               string                          `json:"volumelimit"`
-	Volumetotal               int64                           `json:"volumetotal"`
+	Volumetotal               FlexInt64                       `json:"volumetotal"`
 	Vpcavailable              string                          `json:"vpcavailable"`
 	Vpclimit                  string                          `json:"vpclimit"`
-	Vpctotal                  int64                           `json:"vpctotal"`
+	Vpctotal                  FlexInt64                       `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage      `json:"-"`
+}
+
+func (r *LdapCreateAccountResponse) UnmarshalJSON(b []byte) error {
+	type alias LdapCreateAccountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LdapCreateAccountResponseUser struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LdapCreateAccountResponseUser) UnmarshalJSON(b []byte) error {
+	type alias LdapCreateAccountResponseUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LdapRemoveParams struct {
@@ -1297,6 +1617,39 @@ func (p *LdapRemoveParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the ldapRemove API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LdapRemoveParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("ldapRemove", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the ldapRemove API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LdapRemoveParams) Validate() error {
+	return paramRules{}.validate("ldapRemove", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the ldapRemove API, before the request is signed
+func (p *LdapRemoveParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LdapRemoveParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LdapRemoveParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("ldapRemove", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LdapRemoveParams) Clone() *LdapRemoveParams {
+	return &LdapRemoveParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new LdapRemoveParams instance,
 // as then you are sure you have configured all required params
 func (s *LDAPService) NewLdapRemoveParams() *LdapRemoveParams {
@@ -1307,13 +1660,22 @@ func (s *LDAPService) NewLdapRemoveParams() *LdapRemoveParams {
 
 // (Deprecated , use deleteLdapConfiguration) Remove the LDAP context for this site.
 func (s *LDAPService) LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error) {
-	resp, err := s.cs.newPostRequest("ldapRemove", p.toURLValues())
+	return s.LdapRemoveWithContext(context.Background(), p)
+}
+
+// LdapRemoveWithContext is like LdapRemove, but honours the cancellation and deadline of ctx
+func (s *LDAPService) LdapRemoveWithContext(ctx context.Context, p *LdapRemoveParams) (*LdapRemoveResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "ldapRemove", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LdapRemoveResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("ldapRemove", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1321,15 +1683,21 @@ func (s *LDAPService) LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, erro
 }
 
 type LdapRemoveResponse struct {
-	Binddn      string `json:"binddn"`
-	Bindpass    string `json:"bindpass"`
-	Hostname    string `json:"hostname"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Port        string `json:"port"`
-	Queryfilter string `json:"queryfilter"`
-	Searchbase  string `json:"searchbase"`
-	Ssl         string `json:"ssl"`
+	Binddn      string                     `json:"binddn"`
+	Bindpass    string                     `json:"bindpass"`
+	Hostname    string                     `json:"hostname"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Port        string                     `json:"port"`
+	Queryfilter string                     `json:"queryfilter"`
+	Searchbase  string                     `json:"searchbase"`
+	Ssl         string                     `json:"ssl"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LdapRemoveResponse) UnmarshalJSON(b []byte) error {
+	type alias LdapRemoveResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LinkDomainToLdapParams struct {
@@ -1363,6 +1731,49 @@ func (p *LinkDomainToLdapParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the linkDomainToLdap API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LinkDomainToLdapParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("linkDomainToLdap", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the linkDomainToLdap API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LinkDomainToLdapParams) Validate() error {
+	return paramRules{
+		required: []string{"accounttype", "domainid", "type"},
+		lengths:  map[string]int{"admin": 255, "domainid": 255, "ldapdomain": 255, "name": 255, "type": 255},
+	}.validate("linkDomainToLdap", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the linkDomainToLdap API, before the request is signed
+func (p *LinkDomainToLdapParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LinkDomainToLdapParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LinkDomainToLdapParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("linkDomainToLdap", b, &p.p, map[string]paramDecoder{
+		"accounttype": decodeParam[int],
+		"admin":       decodeParam[string],
+		"domainid":    decodeParam[string],
+		"ldapdomain":  decodeParam[string],
+		"name":        decodeParam[string],
+		"type":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LinkDomainToLdapParams) Clone() *LinkDomainToLdapParams {
+	return &LinkDomainToLdapParams{p: cloneParams(p.p)}
+}
+
 func (p *LinkDomainToLdapParams) SetAccounttype(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1502,13 +1913,22 @@ func (s *LDAPService) NewLinkDomainToLdapParams(accounttype int, domainid string
 
 // Link an existing Cloudstack domain to group or OU in ldap
 func (s *LDAPService) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
-	resp, err := s.cs.newPostRequest("linkDomainToLdap", p.toURLValues())
+	return s.LinkDomainToLdapWithContext(context.Background(), p)
+}
+
+// LinkDomainToLdapWithContext is like LinkDomainToLdap, but honours the cancellation and deadline of ctx
+func (s *LDAPService) LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "linkDomainToLdap", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r LinkDomainToLdapResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("linkDomainToLdap", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1516,14 +1936,20 @@ func (s *LDAPService) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainTo
 }
 
 type LinkDomainToLdapResponse struct {
-	Accountid   string `json:"accountid"`
-	Accounttype int    `json:"accounttype"`
-	Domainid    string `json:"domainid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Ldapdomain  string `json:"ldapdomain"`
-	Name        string `json:"name"`
-	Type        string `json:"type"`
+	Accountid   string                     `json:"accountid"`
+	Accounttype FlexInt                    `json:"accounttype"`
+	Domainid    string                     `json:"domainid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Ldapdomain  string                     `json:"ldapdomain"`
+	Name        string                     `json:"name"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LinkDomainToLdapResponse) UnmarshalJSON(b []byte) error {
+	type alias LinkDomainToLdapResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListLdapConfigurationsParams struct {
@@ -1566,6 +1992,50 @@ func (p *ListLdapConfigurationsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listLdapConfigurations API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListLdapConfigurationsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listLdapConfigurations", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listLdapConfigurations API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListLdapConfigurationsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"domainid": 255, "hostname": 255, "id": 255, "keyword": 255},
+	}.validate("listLdapConfigurations", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listLdapConfigurations API, before the request is signed
+func (p *ListLdapConfigurationsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListLdapConfigurationsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListLdapConfigurationsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listLdapConfigurations", b, &p.p, map[string]paramDecoder{
+		"domainid": decodeParam[string],
+		"hostname": decodeParam[string],
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"listall":  decodeParam[bool],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"port":     decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListLdapConfigurationsParams) Clone() *ListLdapConfigurationsParams {
+	return &ListLdapConfigurationsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListLdapConfigurationsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1744,6 +2214,11 @@ func (s *LDAPService) NewListLdapConfigurationsParams() *ListLdapConfigurationsP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LDAPService) GetLdapConfigurationByID(id string, opts ...OptionFunc) (*LdapConfiguration, int, error) {
+	return s.GetLdapConfigurationByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLdapConfigurationByIDWithContext is like GetLdapConfigurationByID, but honours the cancellation and deadline of ctx
+func (s *LDAPService) GetLdapConfigurationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LdapConfiguration, int, error) {
 	p := &ListLdapConfigurationsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1755,11 +2230,10 @@ func (s *LDAPService) GetLdapConfigurationByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListLdapConfigurations(p)
+	l, err := s.ListLdapConfigurationsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1777,31 +2251,74 @@ func (s *LDAPService) GetLdapConfigurationByID(id string, opts ...OptionFunc) (*
 
 // Lists all LDAP configurations
 func (s *LDAPService) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
-	resp, err := s.cs.newRequest("listLdapConfigurations", p.toURLValues())
+	return s.ListLdapConfigurationsWithContext(context.Background(), p)
+}
+
+// ListLdapConfigurationsWithContext is like ListLdapConfigurations, but honours the cancellation and deadline of ctx
+func (s *LDAPService) ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listLdapConfigurations", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListLdapConfigurationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listLdapConfigurations", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListLdapConfigurationsAll returns all LdapConfigurations matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LDAPService) ListLdapConfigurationsAll(p *ListLdapConfigurationsParams, opts ...PageOption) ([]*LdapConfiguration, error) {
+	return s.ListLdapConfigurationsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListLdapConfigurationsAllWithContext is like ListLdapConfigurationsAll, but honours the cancellation and deadline of ctx
+func (s *LDAPService) ListLdapConfigurationsAllWithContext(ctx context.Context, p *ListLdapConfigurationsParams, opts ...PageOption) ([]*LdapConfiguration, error) {
+	return collectPages(s.ListLdapConfigurationsIterWithContext(ctx, p, opts...))
+}
+
+// ListLdapConfigurationsIter returns an iterator over all LdapConfigurations matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LDAPService) ListLdapConfigurationsIter(p *ListLdapConfigurationsParams, opts ...PageOption) iter.Seq2[*LdapConfiguration, error] {
+	return s.ListLdapConfigurationsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListLdapConfigurationsIterWithContext is like ListLdapConfigurationsIter, but honours the cancellation and deadline of ctx
+func (s *LDAPService) ListLdapConfigurationsIterWithContext(ctx context.Context, p *ListLdapConfigurationsParams, opts ...PageOption) iter.Seq2[*LdapConfiguration, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*LdapConfiguration, int, error) {
+		l, err := s.ListLdapConfigurationsWithContext(ctx, &ListLdapConfigurationsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.LdapConfigurations, l.Count, nil
+	}, opts...)
+}
+
 type ListLdapConfigurationsResponse struct {
 	Count              int                  `json:"count"`
 	LdapConfigurations []*LdapConfiguration `json:"ldapconfiguration"`
 }
 
 type LdapConfiguration struct {
-	Domainid  string `json:"domainid"`
-	Hostname  string `json:"hostname"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Port      int    `json:"port"`
+	Domainid  string                     `json:"domainid"`
+	Hostname  string                     `json:"hostname"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Port      FlexInt                    `json:"port"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LdapConfiguration) UnmarshalJSON(b []byte) error {
+	type alias LdapConfiguration
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListLdapUsersParams struct {
@@ -1836,6 +2353,48 @@ func (p *ListLdapUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listLdapUsers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListLdapUsersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listLdapUsers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listLdapUsers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListLdapUsersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"domainid": 255, "keyword": 255, "listtype": 255, "userfilter": 255},
+	}.validate("listLdapUsers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listLdapUsers API, before the request is signed
+func (p *ListLdapUsersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListLdapUsersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListLdapUsersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listLdapUsers", b, &p.p, map[string]paramDecoder{
+		"domainid":   decodeParam[string],
+		"keyword":    decodeParam[string],
+		"listtype":   decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+		"userfilter": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListLdapUsersParams) Clone() *ListLdapUsersParams {
+	return &ListLdapUsersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListLdapUsersParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1972,34 +2531,77 @@ func (s *LDAPService) NewListLdapUsersParams() *ListLdapUsersParams {
 
 // Lists LDAP Users according to the specifications from the user request.
 func (s *LDAPService) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
-	resp, err := s.cs.newRequest("listLdapUsers", p.toURLValues())
+	return s.ListLdapUsersWithContext(context.Background(), p)
+}
+
+// ListLdapUsersWithContext is like ListLdapUsers, but honours the cancellation and deadline of ctx
+func (s *LDAPService) ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listLdapUsers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListLdapUsersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listLdapUsers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListLdapUsersAll returns all LdapUsers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LDAPService) ListLdapUsersAll(p *ListLdapUsersParams, opts ...PageOption) ([]*LdapUser, error) {
+	return s.ListLdapUsersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListLdapUsersAllWithContext is like ListLdapUsersAll, but honours the cancellation and deadline of ctx
+func (s *LDAPService) ListLdapUsersAllWithContext(ctx context.Context, p *ListLdapUsersParams, opts ...PageOption) ([]*LdapUser, error) {
+	return collectPages(s.ListLdapUsersIterWithContext(ctx, p, opts...))
+}
+
+// ListLdapUsersIter returns an iterator over all LdapUsers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LDAPService) ListLdapUsersIter(p *ListLdapUsersParams, opts ...PageOption) iter.Seq2[*LdapUser, error] {
+	return s.ListLdapUsersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListLdapUsersIterWithContext is like ListLdapUsersIter, but honours the cancellation and deadline of ctx
+func (s *LDAPService) ListLdapUsersIterWithContext(ctx context.Context, p *ListLdapUsersParams, opts ...PageOption) iter.Seq2[*LdapUser, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*LdapUser, int, error) {
+		l, err := s.ListLdapUsersWithContext(ctx, &ListLdapUsersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.LdapUsers, l.Count, nil
+	}, opts...)
+}
+
 type ListLdapUsersResponse struct {
 	Count     int         `json:"count"`
 	LdapUsers []*LdapUser `json:"ldapuser"`
 }
 
 type LdapUser struct {
-	Conflictingusersource string `json:"conflictingusersource"`
-	Domain                string `json:"domain"`
-	Email                 string `json:"email"`
-	Firstname             string `json:"firstname"`
-	JobID                 string `json:"jobid"`
-	Jobstatus             int    `json:"jobstatus"`
-	Lastname              string `json:"lastname"`
-	Principal             string `json:"principal"`
-	Username              string `json:"username"`
+	Conflictingusersource string                     `json:"conflictingusersource"`
+	Domain                string                     `json:"domain"`
+	Email                 string                     `json:"email"`
+	Firstname             string                     `json:"firstname"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Lastname              string                     `json:"lastname"`
+	Principal             string                     `json:"principal"`
+	Username              string                     `json:"username"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LdapUser) UnmarshalJSON(b []byte) error {
+	type alias LdapUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SearchLdapParams struct {
@@ -2028,6 +2630,47 @@ func (p *SearchLdapParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the searchLdap API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *SearchLdapParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("searchLdap", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the searchLdap API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *SearchLdapParams) Validate() error {
+	return paramRules{
+		required: []string{"query"},
+		lengths:  map[string]int{"keyword": 255, "query": 255},
+	}.validate("searchLdap", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the searchLdap API, before the request is signed
+func (p *SearchLdapParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *SearchLdapParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *SearchLdapParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("searchLdap", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"query":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *SearchLdapParams) Clone() *SearchLdapParams {
+	return &SearchLdapParams{p: cloneParams(p.p)}
+}
+
 func (p *SearchLdapParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2123,13 +2766,22 @@ func (s *LDAPService) NewSearchLdapParams(query string) *SearchLdapParams {
 
 // Searches LDAP based on the username attribute
 func (s *LDAPService) SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, error) {
-	resp, err := s.cs.newPostRequest("searchLdap", p.toURLValues())
+	return s.SearchLdapWithContext(context.Background(), p)
+}
+
+// SearchLdapWithContext is like SearchLdap, but honours the cancellation and deadline of ctx
+func (s *LDAPService) SearchLdapWithContext(ctx context.Context, p *SearchLdapParams) (*SearchLdapResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "searchLdap", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r SearchLdapResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("searchLdap", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2137,13 +2789,19 @@ func (s *LDAPService) SearchLdap(p *SearchLdapParams) (*SearchLdapResponse, erro
 }
 
 type SearchLdapResponse struct {
-	Conflictingusersource string `json:"conflictingusersource"`
-	Domain                string `json:"domain"`
-	Email                 string `json:"email"`
-	Firstname             string `json:"firstname"`
-	JobID                 string `json:"jobid"`
-	Jobstatus             int    `json:"jobstatus"`
-	Lastname              string `json:"lastname"`
-	Principal             string `json:"principal"`
-	Username              string `json:"username"`
+	Conflictingusersource string                     `json:"conflictingusersource"`
+	Domain                string                     `json:"domain"`
+	Email                 string                     `json:"email"`
+	Firstname             string                     `json:"firstname"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Lastname              string                     `json:"lastname"`
+	Principal             string                     `json:"principal"`
+	Username              string                     `json:"username"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SearchLdapResponse) UnmarshalJSON(b []byte) error {
+	type alias SearchLdapResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) AddLdapConfiguration(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLdapConfiguration", reflect.TypeOf((*MockLDAPServiceIface)(nil).AddLdapConfiguration), p)
 }
 
+// AddLdapConfigurationWithContext mocks base method.
+func (m *MockLDAPServiceIface) AddLdapConfigurationWithContext(ctx context.Context, p *AddLdapConfigurationParams) (*AddLdapConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddLdapConfigurationWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddLdapConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddLdapConfigurationWithContext indicates an expected call of AddLdapConfigurationWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) AddLdapConfigurationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddLdapConfigurationWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).AddLdapConfigurationWithContext), ctx, p)
+}
+
 // DeleteLdapConfiguration mocks base method.
 func (m *MockLDAPServiceIface) DeleteLdapConfiguration(p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) DeleteLdapConfiguration(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLdapConfiguration", reflect.TypeOf((*MockLDAPServiceIface)(nil).DeleteLdapConfiguration), p)
 }
 
+// DeleteLdapConfigurationWithContext mocks base method.
+func (m *MockLDAPServiceIface) DeleteLdapConfigurationWithContext(ctx context.Context, p *DeleteLdapConfigurationParams) (*DeleteLdapConfigurationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLdapConfigurationWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteLdapConfigurationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLdapConfigurationWithContext indicates an expected call of DeleteLdapConfigurationWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) DeleteLdapConfigurationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLdapConfigurationWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).DeleteLdapConfigurationWithContext), ctx, p)
+}
+
 // GetLdapConfigurationByID mocks base method.
 func (m *MockLDAPServiceIface) GetLdapConfigurationByID(id string, opts ...OptionFunc) (*LdapConfiguration, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockLDAPServiceIfaceMockRecorder) GetLdapConfigurationByID(id any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLdapConfigurationByID", reflect.TypeOf((*MockLDAPServiceIface)(nil).GetLdapConfigurationByID), varargs...)
 }
 
+// GetLdapConfigurationByIDWithContext mocks base method.
+func (m *MockLDAPServiceIface) GetLdapConfigurationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LdapConfiguration, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLdapConfigurationByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*LdapConfiguration)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLdapConfigurationByIDWithContext indicates an expected call of GetLdapConfigurationByIDWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) GetLdapConfigurationByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLdapConfigurationByIDWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).GetLdapConfigurationByIDWithContext), varargs...)
+}
+
 // ImportLdapUsers mocks base method.
 func (m *MockLDAPServiceIface) ImportLdapUsers(p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +177,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) ImportLdapUsers(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportLdapUsers", reflect.TypeOf((*MockLDAPServiceIface)(nil).ImportLdapUsers), p)
 }
 
+// ImportLdapUsersWithContext mocks base method.
+func (m *MockLDAPServiceIface) ImportLdapUsersWithContext(ctx context.Context, p *ImportLdapUsersParams) (*ImportLdapUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportLdapUsersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ImportLdapUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportLdapUsersWithContext indicates an expected call of ImportLdapUsersWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) ImportLdapUsersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportLdapUsersWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).ImportLdapUsersWithContext), ctx, p)
+}
+
 // LdapConfig mocks base method.
 func (m *MockLDAPServiceIface) LdapConfig(p *LdapConfigParams) (*LdapConfigResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +207,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) LdapConfig(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapConfig", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapConfig), p)
 }
 
+// LdapConfigWithContext mocks base method.
+func (m *MockLDAPServiceIface) LdapConfigWithContext(ctx context.Context, p *LdapConfigParams) (*LdapConfigResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LdapConfigWithContext", ctx, p)
+	ret0, _ := ret[0].(*LdapConfigResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LdapConfigWithContext indicates an expected call of LdapConfigWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) LdapConfigWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapConfigWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapConfigWithContext), ctx, p)
+}
+
 // LdapCreateAccount mocks base method.
 func (m *MockLDAPServiceIface) LdapCreateAccount(p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +237,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) LdapCreateAccount(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapCreateAccount", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapCreateAccount), p)
 }
 
+// LdapCreateAccountWithContext mocks base method.
+func (m *MockLDAPServiceIface) LdapCreateAccountWithContext(ctx context.Context, p *LdapCreateAccountParams) (*LdapCreateAccountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LdapCreateAccountWithContext", ctx, p)
+	ret0, _ := ret[0].(*LdapCreateAccountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LdapCreateAccountWithContext indicates an expected call of LdapCreateAccountWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) LdapCreateAccountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapCreateAccountWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapCreateAccountWithContext), ctx, p)
+}
+
 // LdapRemove mocks base method.
 func (m *MockLDAPServiceIface) LdapRemove(p *LdapRemoveParams) (*LdapRemoveResponse, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +267,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) LdapRemove(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapRemove", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapRemove), p)
 }
 
+// LdapRemoveWithContext mocks base method.
+func (m *MockLDAPServiceIface) LdapRemoveWithContext(ctx context.Context, p *LdapRemoveParams) (*LdapRemoveResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LdapRemoveWithContext", ctx, p)
+	ret0, _ := ret[0].(*LdapRemoveResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LdapRemoveWithContext indicates an expected call of LdapRemoveWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) LdapRemoveWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LdapRemoveWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).LdapRemoveWithContext), ctx, p)
+}
+
 // LinkDomainToLdap mocks base method.
 func (m *MockLDAPServiceIface) LinkDomainToLdap(p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +297,21 @@ func (mr *MockLDAPServiceIfaceMockRecorder) LinkDomainToLdap(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkDomainToLdap", reflect.TypeOf((*MockLDAPServiceIface)(nil).LinkDomainToLdap), p)
 }
 
+// LinkDomainToLdapWithContext mocks base method.
+func (m *MockLDAPServiceIface) LinkDomainToLdapWithContext(ctx context.Context, p *LinkDomainToLdapParams) (*LinkDomainToLdapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkDomainToLdapWithContext", ctx, p)
+	ret0, _ := ret[0].(*LinkDomainToLdapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkDomainToLdapWithContext indicates an expected call of LinkDomainToLdapWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) LinkDomainToLdapWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkDomainToLdapWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).LinkDomainToLdapWithContext), ctx, p)
+}
+
 // ListLdapConfigurations mocks base method.
 func (m *MockLDAPServiceIface) ListLdapConfigurations(p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +327,99 @@ func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurations(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurations", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurations), p)
 }
 
+// ListLdapConfigurationsAll mocks base method.
+func (m *MockLDAPServiceIface) ListLdapConfigurationsAll(p *ListLdapConfigurationsParams, opts ...PageOption) ([]*LdapConfiguration, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapConfigurationsAll", varargs...)
+	ret0, _ := ret[0].([]*LdapConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLdapConfigurationsAll indicates an expected call of ListLdapConfigurationsAll.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurationsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurationsAll", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurationsAll), varargs...)
+}
+
+// ListLdapConfigurationsAllWithContext mocks base method.
+func (m *MockLDAPServiceIface) ListLdapConfigurationsAllWithContext(ctx context.Context, p *ListLdapConfigurationsParams, opts ...PageOption) ([]*LdapConfiguration, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapConfigurationsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*LdapConfiguration)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLdapConfigurationsAllWithContext indicates an expected call of ListLdapConfigurationsAllWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurationsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurationsAllWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurationsAllWithContext), varargs...)
+}
+
+// ListLdapConfigurationsIter mocks base method.
+func (m *MockLDAPServiceIface) ListLdapConfigurationsIter(p *ListLdapConfigurationsParams, opts ...PageOption) iter.Seq2[*LdapConfiguration, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapConfigurationsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LdapConfiguration, error])
+	return ret0
+}
+
+// ListLdapConfigurationsIter indicates an expected call of ListLdapConfigurationsIter.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurationsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurationsIter", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurationsIter), varargs...)
+}
+
+// ListLdapConfigurationsIterWithContext mocks base method.
+func (m *MockLDAPServiceIface) ListLdapConfigurationsIterWithContext(ctx context.Context, p *ListLdapConfigurationsParams, opts ...PageOption) iter.Seq2[*LdapConfiguration, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapConfigurationsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LdapConfiguration, error])
+	return ret0
+}
+
+// ListLdapConfigurationsIterWithContext indicates an expected call of ListLdapConfigurationsIterWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurationsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurationsIterWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurationsIterWithContext), varargs...)
+}
+
+// ListLdapConfigurationsWithContext mocks base method.
+func (m *MockLDAPServiceIface) ListLdapConfigurationsWithContext(ctx context.Context, p *ListLdapConfigurationsParams) (*ListLdapConfigurationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLdapConfigurationsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListLdapConfigurationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLdapConfigurationsWithContext indicates an expected call of ListLdapConfigurationsWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapConfigurationsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapConfigurationsWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapConfigurationsWithContext), ctx, p)
+}
+
 // ListLdapUsers mocks base method.
 func (m *MockLDAPServiceIface) ListLdapUsers(p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +435,99 @@ func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsers(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsers", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsers), p)
 }
 
+// ListLdapUsersAll mocks base method.
+func (m *MockLDAPServiceIface) ListLdapUsersAll(p *ListLdapUsersParams, opts ...PageOption) ([]*LdapUser, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapUsersAll", varargs...)
+	ret0, _ := ret[0].([]*LdapUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLdapUsersAll indicates an expected call of ListLdapUsersAll.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsersAll", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsersAll), varargs...)
+}
+
+// ListLdapUsersAllWithContext mocks base method.
+func (m *MockLDAPServiceIface) ListLdapUsersAllWithContext(ctx context.Context, p *ListLdapUsersParams, opts ...PageOption) ([]*LdapUser, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapUsersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*LdapUser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLdapUsersAllWithContext indicates an expected call of ListLdapUsersAllWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsersAllWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsersAllWithContext), varargs...)
+}
+
+// ListLdapUsersIter mocks base method.
+func (m *MockLDAPServiceIface) ListLdapUsersIter(p *ListLdapUsersParams, opts ...PageOption) iter.Seq2[*LdapUser, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapUsersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LdapUser, error])
+	return ret0
+}
+
+// ListLdapUsersIter indicates an expected call of ListLdapUsersIter.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsersIter", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsersIter), varargs...)
+}
+
+// ListLdapUsersIterWithContext mocks base method.
+func (m *MockLDAPServiceIface) ListLdapUsersIterWithContext(ctx context.Context, p *ListLdapUsersParams, opts ...PageOption) iter.Seq2[*LdapUser, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLdapUsersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LdapUser, error])
+	return ret0
+}
+
+// ListLdapUsersIterWithContext indicates an expected call of ListLdapUsersIterWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsersIterWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsersIterWithContext), varargs...)
+}
+
+// ListLdapUsersWithContext mocks base method.
+func (m *MockLDAPServiceIface) ListLdapUsersWithContext(ctx context.Context, p *ListLdapUsersParams) (*ListLdapUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLdapUsersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListLdapUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLdapUsersWithContext indicates an expected call of ListLdapUsersWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) ListLdapUsersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLdapUsersWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).ListLdapUsersWithContext), ctx, p)
+}
+
 // NewAddLdapConfigurationParams mocks base method.
 func (m *MockLDAPServiceIface) NewAddLdapConfigurationParams(hostname string, port int) *AddLdapConfigurationParams {
 	m.ctrl.T.Helper()
@@ -368,3 +682,18 @@ func (mr *MockLDAPServiceIfaceMockRecorder) SearchLdap(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchLdap", reflect.TypeOf((*MockLDAPServiceIface)(nil).SearchLdap), p)
 }
+
+// SearchLdapWithContext mocks base method.
+func (m *MockLDAPServiceIface) SearchLdapWithContext(ctx context.Context, p *SearchLdapParams) (*SearchLdapResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SearchLdapWithContext", ctx, p)
+	ret0, _ := ret[0].(*SearchLdapResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SearchLdapWithContext indicates an expected call of SearchLdapWithContext.
+func (mr *MockLDAPServiceIfaceMockRecorder) SearchLdapWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SearchLdapWithContext", reflect.TypeOf((*MockLDAPServiceIface)(nil).SearchLdapWithContext), ctx, p)
+}
@@ -20,21 +20,32 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type LimitServiceIface interface {
 	GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error)
+	GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error)
 	NewGetApiLimitParams() *GetApiLimitParams
 	ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error)
+	ListResourceLimitsAll(p *ListResourceLimitsParams, opts ...PageOption) ([]*ResourceLimit, error)
+	ListResourceLimitsAllWithContext(ctx context.Context, p *ListResourceLimitsParams, opts ...PageOption) ([]*ResourceLimit, error)
+	ListResourceLimitsIter(p *ListResourceLimitsParams, opts ...PageOption) iter.Seq2[*ResourceLimit, error]
+	ListResourceLimitsIterWithContext(ctx context.Context, p *ListResourceLimitsParams, opts ...PageOption) iter.Seq2[*ResourceLimit, error]
 	NewListResourceLimitsParams() *ListResourceLimitsParams
 	ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
+	ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error)
 	NewResetApiLimitParams() *ResetApiLimitParams
 	UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
+	UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error)
 	NewUpdateResourceCountParams(domainid string) *UpdateResourceCountParams
 	UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
+	UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error)
 	NewUpdateResourceLimitParams(resourcetype int) *UpdateResourceLimitParams
 }
 
@@ -50,6 +61,39 @@ func (p *GetApiLimitParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getApiLimit API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetApiLimitParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getApiLimit", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getApiLimit API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetApiLimitParams) Validate() error {
+	return paramRules{}.validate("getApiLimit", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getApiLimit API, before the request is signed
+func (p *GetApiLimitParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetApiLimitParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetApiLimitParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getApiLimit", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetApiLimitParams) Clone() *GetApiLimitParams {
+	return &GetApiLimitParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new GetApiLimitParams instance,
 // as then you are sure you have configured all required params
 func (s *LimitService) NewGetApiLimitParams() *GetApiLimitParams {
@@ -60,13 +104,26 @@ func (s *LimitService) NewGetApiLimitParams() *GetApiLimitParams {
 
 // Get API limit count for the caller
 func (s *LimitService) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse, error) {
-	resp, err := s.cs.newRequest("getApiLimit", p.toURLValues())
+	return s.GetApiLimitWithContext(context.Background(), p)
+}
+
+// GetApiLimitWithContext is like GetApiLimit, but honours the cancellation and deadline of ctx
+func (s *LimitService) GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getApiLimit", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
+	if resp, err = getRawValue(resp); err != nil {
+		return nil, err
+	}
+
 	var r GetApiLimitResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getApiLimit", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -74,13 +131,19 @@ func (s *LimitService) GetApiLimit(p *GetApiLimitParams) (*GetApiLimitResponse,
 }
 
 type GetApiLimitResponse struct {
-	Account     string `json:"account"`
-	Accountid   string `json:"accountid"`
-	ApiAllowed  int    `json:"apiAllowed"`
-	ApiIssued   int    `json:"apiIssued"`
-	ExpireAfter int64  `json:"expireAfter"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
+	Account     string                     `json:"account"`
+	Accountid   string                     `json:"accountid"`
+	ApiAllowed  FlexInt                    `json:"apiAllowed"`
+	ApiIssued   FlexInt                    `json:"apiIssued"`
+	ExpireAfter FlexInt64                  `json:"expireAfter"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetApiLimitResponse) UnmarshalJSON(b []byte) error {
+	type alias GetApiLimitResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListResourceLimitsParams struct {
@@ -137,6 +200,54 @@ func (p *ListResourceLimitsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listResourceLimits API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListResourceLimitsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listResourceLimits", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listResourceLimits API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListResourceLimitsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "keyword": 255, "projectid": 255, "resourcetypename": 255, "tag": 255},
+	}.validate("listResourceLimits", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listResourceLimits API, before the request is signed
+func (p *ListResourceLimitsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListResourceLimitsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListResourceLimitsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listResourceLimits", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"id":               decodeParam[int64],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"resourcetype":     decodeParam[int],
+		"resourcetypename": decodeParam[string],
+		"tag":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListResourceLimitsParams) Clone() *ListResourceLimitsParams {
+	return &ListResourceLimitsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListResourceLimitsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -399,37 +510,80 @@ func (s *LimitService) NewListResourceLimitsParams() *ListResourceLimitsParams {
 
 // Lists resource limits.
 func (s *LimitService) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceLimits", p.toURLValues())
+	return s.ListResourceLimitsWithContext(context.Background(), p)
+}
+
+// ListResourceLimitsWithContext is like ListResourceLimits, but honours the cancellation and deadline of ctx
+func (s *LimitService) ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listResourceLimits", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListResourceLimitsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listResourceLimits", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListResourceLimitsAll returns all ResourceLimits matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LimitService) ListResourceLimitsAll(p *ListResourceLimitsParams, opts ...PageOption) ([]*ResourceLimit, error) {
+	return s.ListResourceLimitsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListResourceLimitsAllWithContext is like ListResourceLimitsAll, but honours the cancellation and deadline of ctx
+func (s *LimitService) ListResourceLimitsAllWithContext(ctx context.Context, p *ListResourceLimitsParams, opts ...PageOption) ([]*ResourceLimit, error) {
+	return collectPages(s.ListResourceLimitsIterWithContext(ctx, p, opts...))
+}
+
+// ListResourceLimitsIter returns an iterator over all ResourceLimits matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LimitService) ListResourceLimitsIter(p *ListResourceLimitsParams, opts ...PageOption) iter.Seq2[*ResourceLimit, error] {
+	return s.ListResourceLimitsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListResourceLimitsIterWithContext is like ListResourceLimitsIter, but honours the cancellation and deadline of ctx
+func (s *LimitService) ListResourceLimitsIterWithContext(ctx context.Context, p *ListResourceLimitsParams, opts ...PageOption) iter.Seq2[*ResourceLimit, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ResourceLimit, int, error) {
+		l, err := s.ListResourceLimitsWithContext(ctx, &ListResourceLimitsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ResourceLimits, l.Count, nil
+	}, opts...)
+}
+
 type ListResourceLimitsResponse struct {
 	Count          int              `json:"count"`
 	ResourceLimits []*ResourceLimit `json:"resourcelimit"`
 }
 
 type ResourceLimit struct {
-	Account          string `json:"account"`
-	Domain           string `json:"domain"`
-	Domainid         string `json:"domainid"`
-	Domainpath       string `json:"domainpath"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Max              int64  `json:"max"`
-	Project          string `json:"project"`
-	Projectid        string `json:"projectid"`
-	Resourcetype     string `json:"resourcetype"`
-	Resourcetypename string `json:"resourcetypename"`
-	Tag              string `json:"tag"`
+	Account          string                     `json:"account"`
+	Domain           string                     `json:"domain"`
+	Domainid         string                     `json:"domainid"`
+	Domainpath       string                     `json:"domainpath"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Max              FlexInt64                  `json:"max"`
+	Project          string                     `json:"project"`
+	Projectid        string                     `json:"projectid"`
+	Resourcetype     string                     `json:"resourcetype"`
+	Resourcetypename string                     `json:"resourcetypename"`
+	Tag              string                     `json:"tag"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResourceLimit) UnmarshalJSON(b []byte) error {
+	type alias ResourceLimit
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetApiLimitParams struct {
@@ -447,6 +601,43 @@ func (p *ResetApiLimitParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resetApiLimit API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResetApiLimitParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resetApiLimit", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resetApiLimit API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResetApiLimitParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255},
+	}.validate("resetApiLimit", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resetApiLimit API, before the request is signed
+func (p *ResetApiLimitParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResetApiLimitParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResetApiLimitParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resetApiLimit", b, &p.p, map[string]paramDecoder{
+		"account": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResetApiLimitParams) Clone() *ResetApiLimitParams {
+	return &ResetApiLimitParams{p: cloneParams(p.p)}
+}
+
 func (p *ResetApiLimitParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -478,13 +669,22 @@ func (s *LimitService) NewResetApiLimitParams() *ResetApiLimitParams {
 
 // Reset api count
 func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
-	resp, err := s.cs.newPostRequest("resetApiLimit", p.toURLValues())
+	return s.ResetApiLimitWithContext(context.Background(), p)
+}
+
+// ResetApiLimitWithContext is like ResetApiLimit, but honours the cancellation and deadline of ctx
+func (s *LimitService) ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "resetApiLimit", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetApiLimitResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resetApiLimit", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -492,10 +692,11 @@ func (s *LimitService) ResetApiLimit(p *ResetApiLimitParams) (*ResetApiLimitResp
 }
 
 type ResetApiLimitResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ResetApiLimitResponse) UnmarshalJSON(b []byte) error {
@@ -522,7 +723,7 @@ func (r *ResetApiLimitResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ResetApiLimitResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateResourceCountParams struct {
@@ -553,6 +754,48 @@ func (p *UpdateResourceCountParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateResourceCount API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateResourceCountParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateResourceCount", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateResourceCount API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateResourceCountParams) Validate() error {
+	return paramRules{
+		required: []string{"domainid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "projectid": 255, "tag": 255},
+	}.validate("updateResourceCount", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateResourceCount API, before the request is signed
+func (p *UpdateResourceCountParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateResourceCountParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateResourceCountParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateResourceCount", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"domainid":     decodeParam[string],
+		"projectid":    decodeParam[string],
+		"resourcetype": decodeParam[int],
+		"tag":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateResourceCountParams) Clone() *UpdateResourceCountParams {
+	return &UpdateResourceCountParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateResourceCountParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -669,13 +912,22 @@ func (s *LimitService) NewUpdateResourceCountParams(domainid string) *UpdateReso
 
 // Recalculate and update resource count for an account or domain. This also executes some cleanup tasks before calculating resource counts.
 func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
-	resp, err := s.cs.newPostRequest("updateResourceCount", p.toURLValues())
+	return s.UpdateResourceCountWithContext(context.Background(), p)
+}
+
+// UpdateResourceCountWithContext is like UpdateResourceCount, but honours the cancellation and deadline of ctx
+func (s *LimitService) UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateResourceCount", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateResourceCountResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateResourceCount", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -683,18 +935,24 @@ func (s *LimitService) UpdateResourceCount(p *UpdateResourceCountParams) (*Updat
 }
 
 type UpdateResourceCountResponse struct {
-	Account          string `json:"account"`
-	Domain           string `json:"domain"`
-	Domainid         string `json:"domainid"`
-	Domainpath       string `json:"domainpath"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Project          string `json:"project"`
-	Projectid        string `json:"projectid"`
-	Resourcecount    int64  `json:"resourcecount"`
-	Resourcetype     string `json:"resourcetype"`
-	Resourcetypename string `json:"resourcetypename"`
-	Tag              string `json:"tag"`
+	Account          string                     `json:"account"`
+	Domain           string                     `json:"domain"`
+	Domainid         string                     `json:"domainid"`
+	Domainpath       string                     `json:"domainpath"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Project          string                     `json:"project"`
+	Projectid        string                     `json:"projectid"`
+	Resourcecount    FlexInt64                  `json:"resourcecount"`
+	Resourcetype     string                     `json:"resourcetype"`
+	Resourcetypename string                     `json:"resourcetypename"`
+	Tag              string                     `json:"tag"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateResourceCountResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateResourceCountResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateResourceLimitParams struct {
@@ -729,6 +987,49 @@ func (p *UpdateResourceLimitParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateResourceLimit API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateResourceLimitParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateResourceLimit", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateResourceLimit API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateResourceLimitParams) Validate() error {
+	return paramRules{
+		required: []string{"resourcetype"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "projectid": 255, "tag": 255},
+	}.validate("updateResourceLimit", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateResourceLimit API, before the request is signed
+func (p *UpdateResourceLimitParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateResourceLimitParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateResourceLimitParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateResourceLimit", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"domainid":     decodeParam[string],
+		"max":          decodeParam[int64],
+		"projectid":    decodeParam[string],
+		"resourcetype": decodeParam[int],
+		"tag":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateResourceLimitParams) Clone() *UpdateResourceLimitParams {
+	return &UpdateResourceLimitParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateResourceLimitParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -866,13 +1167,22 @@ func (s *LimitService) NewUpdateResourceLimitParams(resourcetype int) *UpdateRes
 
 // Updates resource limits for an account or domain.
 func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
-	resp, err := s.cs.newPostRequest("updateResourceLimit", p.toURLValues())
+	return s.UpdateResourceLimitWithContext(context.Background(), p)
+}
+
+// UpdateResourceLimitWithContext is like UpdateResourceLimit, but honours the cancellation and deadline of ctx
+func (s *LimitService) UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateResourceLimit", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateResourceLimitResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateResourceLimit", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -880,16 +1190,22 @@ func (s *LimitService) UpdateResourceLimit(p *UpdateResourceLimitParams) (*Updat
 }
 
 type UpdateResourceLimitResponse struct {
-	Account          string `json:"account"`
-	Domain           string `json:"domain"`
-	Domainid         string `json:"domainid"`
-	Domainpath       string `json:"domainpath"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Max              int64  `json:"max"`
-	Project          string `json:"project"`
-	Projectid        string `json:"projectid"`
-	Resourcetype     string `json:"resourcetype"`
-	Resourcetypename string `json:"resourcetypename"`
-	Tag              string `json:"tag"`
+	Account          string                     `json:"account"`
+	Domain           string                     `json:"domain"`
+	Domainid         string                     `json:"domainid"`
+	Domainpath       string                     `json:"domainpath"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Max              FlexInt64                  `json:"max"`
+	Project          string                     `json:"project"`
+	Projectid        string                     `json:"projectid"`
+	Resourcetype     string                     `json:"resourcetype"`
+	Resourcetypename string                     `json:"resourcetypename"`
+	Tag              string                     `json:"tag"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateResourceLimitResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateResourceLimitResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
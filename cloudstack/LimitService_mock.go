@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockLimitServiceIfaceMockRecorder) GetApiLimit(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).GetApiLimit), p)
 }
 
+// GetApiLimitWithContext mocks base method.
+func (m *MockLimitServiceIface) GetApiLimitWithContext(ctx context.Context, p *GetApiLimitParams) (*GetApiLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetApiLimitWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetApiLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetApiLimitWithContext indicates an expected call of GetApiLimitWithContext.
+func (mr *MockLimitServiceIfaceMockRecorder) GetApiLimitWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetApiLimitWithContext", reflect.TypeOf((*MockLimitServiceIface)(nil).GetApiLimitWithContext), ctx, p)
+}
+
 // ListResourceLimits mocks base method.
 func (m *MockLimitServiceIface) ListResourceLimits(p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,99 @@ func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimits(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimits", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimits), p)
 }
 
+// ListResourceLimitsAll mocks base method.
+func (m *MockLimitServiceIface) ListResourceLimitsAll(p *ListResourceLimitsParams, opts ...PageOption) ([]*ResourceLimit, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceLimitsAll", varargs...)
+	ret0, _ := ret[0].([]*ResourceLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceLimitsAll indicates an expected call of ListResourceLimitsAll.
+func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimitsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimitsAll", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimitsAll), varargs...)
+}
+
+// ListResourceLimitsAllWithContext mocks base method.
+func (m *MockLimitServiceIface) ListResourceLimitsAllWithContext(ctx context.Context, p *ListResourceLimitsParams, opts ...PageOption) ([]*ResourceLimit, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceLimitsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ResourceLimit)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceLimitsAllWithContext indicates an expected call of ListResourceLimitsAllWithContext.
+func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimitsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimitsAllWithContext", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimitsAllWithContext), varargs...)
+}
+
+// ListResourceLimitsIter mocks base method.
+func (m *MockLimitServiceIface) ListResourceLimitsIter(p *ListResourceLimitsParams, opts ...PageOption) iter.Seq2[*ResourceLimit, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceLimitsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ResourceLimit, error])
+	return ret0
+}
+
+// ListResourceLimitsIter indicates an expected call of ListResourceLimitsIter.
+func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimitsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimitsIter", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimitsIter), varargs...)
+}
+
+// ListResourceLimitsIterWithContext mocks base method.
+func (m *MockLimitServiceIface) ListResourceLimitsIterWithContext(ctx context.Context, p *ListResourceLimitsParams, opts ...PageOption) iter.Seq2[*ResourceLimit, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceLimitsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ResourceLimit, error])
+	return ret0
+}
+
+// ListResourceLimitsIterWithContext indicates an expected call of ListResourceLimitsIterWithContext.
+func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimitsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimitsIterWithContext", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimitsIterWithContext), varargs...)
+}
+
+// ListResourceLimitsWithContext mocks base method.
+func (m *MockLimitServiceIface) ListResourceLimitsWithContext(ctx context.Context, p *ListResourceLimitsParams) (*ListResourceLimitsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceLimitsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListResourceLimitsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceLimitsWithContext indicates an expected call of ListResourceLimitsWithContext.
+func (mr *MockLimitServiceIfaceMockRecorder) ListResourceLimitsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceLimitsWithContext", reflect.TypeOf((*MockLimitServiceIface)(nil).ListResourceLimitsWithContext), ctx, p)
+}
+
 // NewGetApiLimitParams mocks base method.
 func (m *MockLimitServiceIface) NewGetApiLimitParams() *GetApiLimitParams {
 	m.ctrl.T.Helper()
@@ -173,6 +283,21 @@ func (mr *MockLimitServiceIfaceMockRecorder) ResetApiLimit(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetApiLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).ResetApiLimit), p)
 }
 
+// ResetApiLimitWithContext mocks base method.
+func (m *MockLimitServiceIface) ResetApiLimitWithContext(ctx context.Context, p *ResetApiLimitParams) (*ResetApiLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetApiLimitWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResetApiLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetApiLimitWithContext indicates an expected call of ResetApiLimitWithContext.
+func (mr *MockLimitServiceIfaceMockRecorder) ResetApiLimitWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetApiLimitWithContext", reflect.TypeOf((*MockLimitServiceIface)(nil).ResetApiLimitWithContext), ctx, p)
+}
+
 // UpdateResourceCount mocks base method.
 func (m *MockLimitServiceIface) UpdateResourceCount(p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
 	m.ctrl.T.Helper()
@@ -188,6 +313,21 @@ func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceCount(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceCount", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceCount), p)
 }
 
+// UpdateResourceCountWithContext mocks base method.
+func (m *MockLimitServiceIface) UpdateResourceCountWithContext(ctx context.Context, p *UpdateResourceCountParams) (*UpdateResourceCountResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceCountWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateResourceCountResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceCountWithContext indicates an expected call of UpdateResourceCountWithContext.
+func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceCountWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceCountWithContext", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceCountWithContext), ctx, p)
+}
+
 // UpdateResourceLimit mocks base method.
 func (m *MockLimitServiceIface) UpdateResourceLimit(p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
 	m.ctrl.T.Helper()
@@ -202,3 +342,18 @@ func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceLimit(p any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceLimit", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceLimit), p)
 }
+
+// UpdateResourceLimitWithContext mocks base method.
+func (m *MockLimitServiceIface) UpdateResourceLimitWithContext(ctx context.Context, p *UpdateResourceLimitParams) (*UpdateResourceLimitResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateResourceLimitWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateResourceLimitResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateResourceLimitWithContext indicates an expected call of UpdateResourceLimitWithContext.
+func (mr *MockLimitServiceIfaceMockRecorder) UpdateResourceLimitWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateResourceLimitWithContext", reflect.TypeOf((*MockLimitServiceIface)(nil).UpdateResourceLimitWithContext), ctx, p)
+}
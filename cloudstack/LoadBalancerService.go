@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,85 +31,179 @@ import (
 
 type LoadBalancerServiceIface interface {
 	AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error)
+	AssignCertToLoadBalancerAsync(ctx context.Context, p *AssignCertToLoadBalancerParams) (*Job, error)
 	NewAssignCertToLoadBalancerParams(certid string, lbruleid string) *AssignCertToLoadBalancerParams
 	AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error)
+	AssignToGlobalLoadBalancerRuleAsync(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*Job, error)
 	NewAssignToGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *AssignToGlobalLoadBalancerRuleParams
 	AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error)
+	AssignToLoadBalancerRuleAsync(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*Job, error)
 	NewAssignToLoadBalancerRuleParams(id string) *AssignToLoadBalancerRuleParams
 	CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error)
+	CreateGlobalLoadBalancerRuleAsync(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*Job, error)
 	NewCreateGlobalLoadBalancerRuleParams(gslbdomainname string, gslbservicetype string, name string, regionid int) *CreateGlobalLoadBalancerRuleParams
 	CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error)
+	CreateLBHealthCheckPolicyAsync(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*Job, error)
 	NewCreateLBHealthCheckPolicyParams(lbruleid string) *CreateLBHealthCheckPolicyParams
 	CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error)
+	CreateLBStickinessPolicyAsync(ctx context.Context, p *CreateLBStickinessPolicyParams) (*Job, error)
 	NewCreateLBStickinessPolicyParams(lbruleid string, methodname string, name string) *CreateLBStickinessPolicyParams
 	CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancerWithContext(ctx context.Context, p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error)
+	CreateLoadBalancerAsync(ctx context.Context, p *CreateLoadBalancerParams) (*Job, error)
 	NewCreateLoadBalancerParams(algorithm string, instanceport int, name string, networkid string, scheme string, sourceipaddressnetworkid string, sourceport int) *CreateLoadBalancerParams
 	CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error)
+	CreateLoadBalancerRuleAsync(ctx context.Context, p *CreateLoadBalancerRuleParams) (*Job, error)
 	NewCreateLoadBalancerRuleParams(algorithm string, name string, privateport int, publicport int) *CreateLoadBalancerRuleParams
 	DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error)
+	DeleteGlobalLoadBalancerRuleAsync(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*Job, error)
 	NewDeleteGlobalLoadBalancerRuleParams(id string) *DeleteGlobalLoadBalancerRuleParams
 	DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error)
+	DeleteLBHealthCheckPolicyAsync(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*Job, error)
 	NewDeleteLBHealthCheckPolicyParams(id string) *DeleteLBHealthCheckPolicyParams
 	DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error)
+	DeleteLBStickinessPolicyAsync(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*Job, error)
 	NewDeleteLBStickinessPolicyParams(id string) *DeleteLBStickinessPolicyParams
 	DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerWithContext(ctx context.Context, p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error)
+	DeleteLoadBalancerAsync(ctx context.Context, p *DeleteLoadBalancerParams) (*Job, error)
 	NewDeleteLoadBalancerParams(id string) *DeleteLoadBalancerParams
 	DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error)
+	DeleteLoadBalancerRuleAsync(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*Job, error)
 	NewDeleteLoadBalancerRuleParams(id string) *DeleteLoadBalancerRuleParams
 	DeleteServicePackageOffering(p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error)
+	DeleteServicePackageOfferingWithContext(ctx context.Context, p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error)
 	NewDeleteServicePackageOfferingParams(id string) *DeleteServicePackageOfferingParams
 	DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
+	DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error)
 	NewDeleteSslCertParams(id string) *DeleteSslCertParams
 	DeployNetscalerVpx(p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error)
+	DeployNetscalerVpxWithContext(ctx context.Context, p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error)
+	DeployNetscalerVpxAsync(ctx context.Context, p *DeployNetscalerVpxParams) (*Job, error)
 	NewDeployNetscalerVpxParams(serviceofferingid string, templateid string, zoneid string) *DeployNetscalerVpxParams
 	ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error)
+	ListGlobalLoadBalancerRulesAll(p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) ([]*GlobalLoadBalancerRule, error)
+	ListGlobalLoadBalancerRulesAllWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) ([]*GlobalLoadBalancerRule, error)
+	ListGlobalLoadBalancerRulesIter(p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*GlobalLoadBalancerRule, error]
+	ListGlobalLoadBalancerRulesIterWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*GlobalLoadBalancerRule, error]
 	NewListGlobalLoadBalancerRulesParams() *ListGlobalLoadBalancerRulesParams
 	GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetGlobalLoadBalancerRuleIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
 	GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
+	GetGlobalLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error)
 	ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error)
+	ListLBHealthCheckPoliciesAll(p *ListLBHealthCheckPoliciesParams, opts ...PageOption) ([]*LBHealthCheckPolicy, error)
+	ListLBHealthCheckPoliciesAllWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams, opts ...PageOption) ([]*LBHealthCheckPolicy, error)
+	ListLBHealthCheckPoliciesIter(p *ListLBHealthCheckPoliciesParams, opts ...PageOption) iter.Seq2[*LBHealthCheckPolicy, error]
+	ListLBHealthCheckPoliciesIterWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams, opts ...PageOption) iter.Seq2[*LBHealthCheckPolicy, error]
 	NewListLBHealthCheckPoliciesParams() *ListLBHealthCheckPoliciesParams
 	GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
+	GetLBHealthCheckPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error)
 	ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error)
+	ListLBStickinessPoliciesAll(p *ListLBStickinessPoliciesParams, opts ...PageOption) ([]*LBStickinessPolicy, error)
+	ListLBStickinessPoliciesAllWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams, opts ...PageOption) ([]*LBStickinessPolicy, error)
+	ListLBStickinessPoliciesIter(p *ListLBStickinessPoliciesParams, opts ...PageOption) iter.Seq2[*LBStickinessPolicy, error]
+	ListLBStickinessPoliciesIterWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams, opts ...PageOption) iter.Seq2[*LBStickinessPolicy, error]
 	NewListLBStickinessPoliciesParams() *ListLBStickinessPoliciesParams
 	GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
+	GetLBStickinessPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error)
 	ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
+	ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error)
 	NewListLoadBalancerRuleInstancesParams(id string) *ListLoadBalancerRuleInstancesParams
 	GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetLoadBalancerRuleInstanceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error)
 	ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error)
+	ListLoadBalancerRulesAll(p *ListLoadBalancerRulesParams, opts ...PageOption) ([]*LoadBalancerRule, error)
+	ListLoadBalancerRulesAllWithContext(ctx context.Context, p *ListLoadBalancerRulesParams, opts ...PageOption) ([]*LoadBalancerRule, error)
+	ListLoadBalancerRulesIter(p *ListLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*LoadBalancerRule, error]
+	ListLoadBalancerRulesIterWithContext(ctx context.Context, p *ListLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*LoadBalancerRule, error]
 	NewListLoadBalancerRulesParams() *ListLoadBalancerRulesParams
 	GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerRuleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
 	GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
+	GetLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancerRule, int, error)
 	ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	ListLoadBalancersWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error)
+	ListLoadBalancersAll(p *ListLoadBalancersParams, opts ...PageOption) ([]*LoadBalancer, error)
+	ListLoadBalancersAllWithContext(ctx context.Context, p *ListLoadBalancersParams, opts ...PageOption) ([]*LoadBalancer, error)
+	ListLoadBalancersIter(p *ListLoadBalancersParams, opts ...PageOption) iter.Seq2[*LoadBalancer, error]
+	ListLoadBalancersIterWithContext(ctx context.Context, p *ListLoadBalancersParams, opts ...PageOption) iter.Seq2[*LoadBalancer, error]
 	NewListLoadBalancersParams() *ListLoadBalancersParams
 	GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error)
+	GetLoadBalancerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancer, int, error)
 	GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error)
+	GetLoadBalancerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancer, int, error)
 	ListRegisteredServicePackages(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error)
+	ListRegisteredServicePackagesWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error)
+	ListRegisteredServicePackagesAll(p *ListRegisteredServicePackagesParams, opts ...PageOption) ([]*RegisteredServicePackage, error)
+	ListRegisteredServicePackagesAllWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams, opts ...PageOption) ([]*RegisteredServicePackage, error)
+	ListRegisteredServicePackagesIter(p *ListRegisteredServicePackagesParams, opts ...PageOption) iter.Seq2[*RegisteredServicePackage, error]
+	ListRegisteredServicePackagesIterWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams, opts ...PageOption) iter.Seq2[*RegisteredServicePackage, error]
 	NewListRegisteredServicePackagesParams() *ListRegisteredServicePackagesParams
 	GetRegisteredServicePackageID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetRegisteredServicePackageIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error)
+	ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error)
 	NewListSslCertsParams() *ListSslCertsParams
 	RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error)
+	RemoveCertFromLoadBalancerAsync(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*Job, error)
 	NewRemoveCertFromLoadBalancerParams(lbruleid string) *RemoveCertFromLoadBalancerParams
 	RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error)
+	RemoveFromGlobalLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*Job, error)
 	NewRemoveFromGlobalLoadBalancerRuleParams(id string, loadbalancerrulelist []string) *RemoveFromGlobalLoadBalancerRuleParams
 	RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error)
+	RemoveFromLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*Job, error)
 	NewRemoveFromLoadBalancerRuleParams(id string) *RemoveFromLoadBalancerRuleParams
 	StopNetScalerVpx(p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error)
+	StopNetScalerVpxWithContext(ctx context.Context, p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error)
+	StopNetScalerVpxAsync(ctx context.Context, p *StopNetScalerVpxParams) (*Job, error)
 	NewStopNetScalerVpxParams(id string) *StopNetScalerVpxParams
 	UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error)
+	UpdateGlobalLoadBalancerRuleAsync(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*Job, error)
 	NewUpdateGlobalLoadBalancerRuleParams(id string) *UpdateGlobalLoadBalancerRuleParams
 	UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error)
+	UpdateLBHealthCheckPolicyAsync(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*Job, error)
 	NewUpdateLBHealthCheckPolicyParams(id string) *UpdateLBHealthCheckPolicyParams
 	UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error)
+	UpdateLBStickinessPolicyAsync(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*Job, error)
 	NewUpdateLBStickinessPolicyParams(id string) *UpdateLBStickinessPolicyParams
 	UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerWithContext(ctx context.Context, p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error)
+	UpdateLoadBalancerAsync(ctx context.Context, p *UpdateLoadBalancerParams) (*Job, error)
 	NewUpdateLoadBalancerParams(id string) *UpdateLoadBalancerParams
 	UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error)
+	UpdateLoadBalancerRuleAsync(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*Job, error)
 	NewUpdateLoadBalancerRuleParams(id string) *UpdateLoadBalancerRuleParams
 	UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error)
+	UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error)
 	NewUploadSslCertParams(certificate string, name string, privatekey string) *UploadSslCertParams
 }
 
@@ -133,6 +229,46 @@ func (p *AssignCertToLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the assignCertToLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssignCertToLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("assignCertToLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the assignCertToLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssignCertToLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"certid", "lbruleid"},
+		lengths:  map[string]int{"certid": 255, "lbruleid": 255},
+	}.validate("assignCertToLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the assignCertToLoadBalancer API, before the request is signed
+func (p *AssignCertToLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssignCertToLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssignCertToLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("assignCertToLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"certid":   decodeParam[string],
+		"forced":   decodeParam[bool],
+		"lbruleid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssignCertToLoadBalancerParams) Clone() *AssignCertToLoadBalancerParams {
+	return &AssignCertToLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *AssignCertToLoadBalancerParams) SetCertid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -208,27 +344,39 @@ func (s *LoadBalancerService) NewAssignCertToLoadBalancerParams(certid string, l
 
 // Assigns a certificate to a load balancer rule
 func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("assignCertToLoadBalancer", p.toURLValues())
+	return s.AssignCertToLoadBalancerWithContext(context.Background(), p)
+}
+
+// AssignCertToLoadBalancerWithContext is like AssignCertToLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "assignCertToLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssignCertToLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("assignCertToLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "assignCertToLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("assignCertToLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -236,11 +384,31 @@ func (s *LoadBalancerService) AssignCertToLoadBalancer(p *AssignCertToLoadBalanc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AssignCertToLoadBalancer async job into r
+func (r *AssignCertToLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AssignCertToLoadBalancerAsync starts the async job of AssignCertToLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) AssignCertToLoadBalancerAsync(ctx context.Context, p *AssignCertToLoadBalancerParams) (*Job, error) {
+	r, err := s.AssignCertToLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("assignCertToLoadBalancer", r.JobID), nil
+}
+
 type AssignCertToLoadBalancerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssignCertToLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias AssignCertToLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssignToGlobalLoadBalancerRuleParams struct {
@@ -269,6 +437,46 @@ func (p *AssignToGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the assignToGlobalLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssignToGlobalLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("assignToGlobalLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the assignToGlobalLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssignToGlobalLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "loadbalancerrulelist"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("assignToGlobalLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the assignToGlobalLoadBalancerRule API, before the request is signed
+func (p *AssignToGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssignToGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssignToGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("assignToGlobalLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"gslblbruleweightsmap": decodeParam[map[string]string],
+		"id":                   decodeParam[string],
+		"loadbalancerrulelist": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssignToGlobalLoadBalancerRuleParams) Clone() *AssignToGlobalLoadBalancerRuleParams {
+	return &AssignToGlobalLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *AssignToGlobalLoadBalancerRuleParams) SetGslblbruleweightsmap(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -344,27 +552,39 @@ func (s *LoadBalancerService) NewAssignToGlobalLoadBalancerRuleParams(id string,
 
 // Assign load balancer rule or list of load balancer rules to a global load balancer rules.
 func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("assignToGlobalLoadBalancerRule", p.toURLValues())
+	return s.AssignToGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// AssignToGlobalLoadBalancerRuleWithContext is like AssignToGlobalLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "assignToGlobalLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssignToGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("assignToGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "assignToGlobalLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("assignToGlobalLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -372,11 +592,31 @@ func (s *LoadBalancerService) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AssignToGlobalLoadBalancerRule async job into r
+func (r *AssignToGlobalLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AssignToGlobalLoadBalancerRuleAsync starts the async job of AssignToGlobalLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) AssignToGlobalLoadBalancerRuleAsync(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.AssignToGlobalLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("assignToGlobalLoadBalancerRule", r.JobID), nil
+}
+
 type AssignToGlobalLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssignToGlobalLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias AssignToGlobalLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssignToLoadBalancerRuleParams struct {
@@ -405,6 +645,46 @@ func (p *AssignToLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the assignToLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssignToLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("assignToLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the assignToLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssignToLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("assignToLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the assignToLoadBalancerRule API, before the request is signed
+func (p *AssignToLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssignToLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssignToLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("assignToLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[string],
+		"virtualmachineids": decodeParam[[]string],
+		"vmidipmap":         decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssignToLoadBalancerRuleParams) Clone() *AssignToLoadBalancerRuleParams {
+	return &AssignToLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *AssignToLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -479,27 +759,39 @@ func (s *LoadBalancerService) NewAssignToLoadBalancerRuleParams(id string) *Assi
 
 // Assigns an Instance or a list of Instances to a load balancer rule.
 func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("assignToLoadBalancerRule", p.toURLValues())
+	return s.AssignToLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// AssignToLoadBalancerRuleWithContext is like AssignToLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "assignToLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssignToLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("assignToLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "assignToLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("assignToLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -507,11 +799,31 @@ func (s *LoadBalancerService) AssignToLoadBalancerRule(p *AssignToLoadBalancerRu
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AssignToLoadBalancerRule async job into r
+func (r *AssignToLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AssignToLoadBalancerRuleAsync starts the async job of AssignToLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) AssignToLoadBalancerRuleAsync(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.AssignToLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("assignToLoadBalancerRule", r.JobID), nil
+}
+
 type AssignToLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssignToLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias AssignToLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateGlobalLoadBalancerRuleParams struct {
@@ -554,6 +866,52 @@ func (p *CreateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createGlobalLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateGlobalLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createGlobalLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createGlobalLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateGlobalLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"gslbdomainname", "gslbservicetype", "name", "regionid"},
+		lengths:  map[string]int{"account": 255, "description": 4096, "domainid": 255, "gslbdomainname": 255, "gslblbmethod": 255, "gslbservicetype": 255, "gslbstickysessionmethodname": 255, "name": 255},
+	}.validate("createGlobalLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createGlobalLoadBalancerRule API, before the request is signed
+func (p *CreateGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createGlobalLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"account":                     decodeParam[string],
+		"description":                 decodeParam[string],
+		"domainid":                    decodeParam[string],
+		"gslbdomainname":              decodeParam[string],
+		"gslblbmethod":                decodeParam[string],
+		"gslbservicetype":             decodeParam[string],
+		"gslbstickysessionmethodname": decodeParam[string],
+		"name":                        decodeParam[string],
+		"regionid":                    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateGlobalLoadBalancerRuleParams) Clone() *CreateGlobalLoadBalancerRuleParams {
+	return &CreateGlobalLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateGlobalLoadBalancerRuleParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -757,32 +1115,39 @@ func (s *LoadBalancerService) NewCreateGlobalLoadBalancerRuleParams(gslbdomainna
 
 // Creates a global load balancer rule
 func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createGlobalLoadBalancerRule", p.toURLValues())
+	return s.CreateGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// CreateGlobalLoadBalancerRuleWithContext is like CreateGlobalLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createGlobalLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createGlobalLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createGlobalLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -790,6 +1155,25 @@ func (s *LoadBalancerService) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateGlobalLoadBalancerRule async job into r
+func (r *CreateGlobalLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateGlobalLoadBalancerRuleAsync starts the async job of CreateGlobalLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) CreateGlobalLoadBalancerRuleAsync(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.CreateGlobalLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createGlobalLoadBalancerRule", r.JobID), nil
+}
+
 type CreateGlobalLoadBalancerRuleResponse struct {
 	Account                     string                                                 `json:"account"`
 	Description                 string                                                 `json:"description"`
@@ -802,37 +1186,49 @@ type CreateGlobalLoadBalancerRuleResponse struct {
 	Gslbstickysessionmethodname string                                                 `json:"gslbstickysessionmethodname"`
 	Id                          string                                                 `json:"id"`
 	JobID                       string                                                 `json:"jobid"`
-	Jobstatus                   int                                                    `json:"jobstatus"`
+	Jobstatus                   FlexInt                                                `json:"jobstatus"`
 	Loadbalancerrule            []CreateGlobalLoadBalancerRuleResponseLoadbalancerrule `json:"loadbalancerrule"`
 	Name                        string                                                 `json:"name"`
 	Project                     string                                                 `json:"project"`
 	Projectid                   string                                                 `json:"projectid"`
-	Regionid                    int                                                    `json:"regionid"`
+	Regionid                    FlexInt                                                `json:"regionid"`
+	Extra                       map[string]json.RawMessage                             `json:"-"`
+}
+
+func (r *CreateGlobalLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateGlobalLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateGlobalLoadBalancerRuleResponseLoadbalancerrule struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Domainpath  string `json:"domainpath"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+	Account     string                     `json:"account"`
+	Algorithm   string                     `json:"algorithm"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Description string                     `json:"description"`
+	Domain      string                     `json:"domain"`
+	Domainid    string                     `json:"domainid"`
+	Domainpath  string                     `json:"domainpath"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	Name        string                     `json:"name"`
+	Networkid   string                     `json:"networkid"`
+	Privateport string                     `json:"privateport"`
+	Project     string                     `json:"project"`
+	Projectid   string                     `json:"projectid"`
+	Protocol    string                     `json:"protocol"`
+	Publicip    string                     `json:"publicip"`
+	Publicipid  string                     `json:"publicipid"`
+	Publicport  string                     `json:"publicport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Zoneid      string                     `json:"zoneid"`
+	Zonename    string                     `json:"zonename"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateGlobalLoadBalancerRuleResponseLoadbalancerrule) UnmarshalJSON(b []byte) error {
+	type alias CreateGlobalLoadBalancerRuleResponseLoadbalancerrule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLBHealthCheckPolicyParams struct {
@@ -876,6 +1272,51 @@ func (p *CreateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createLBHealthCheckPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateLBHealthCheckPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createLBHealthCheckPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createLBHealthCheckPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateLBHealthCheckPolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"lbruleid"},
+		lengths:  map[string]int{"description": 4096, "lbruleid": 255, "pingpath": 255},
+	}.validate("createLBHealthCheckPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createLBHealthCheckPolicy API, before the request is signed
+func (p *CreateLBHealthCheckPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createLBHealthCheckPolicy", b, &p.p, map[string]paramDecoder{
+		"description":        decodeParam[string],
+		"fordisplay":         decodeParam[bool],
+		"healthythreshold":   decodeParam[int],
+		"intervaltime":       decodeParam[int],
+		"lbruleid":           decodeParam[string],
+		"pingpath":           decodeParam[string],
+		"responsetimeout":    decodeParam[int],
+		"unhealthythreshold": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateLBHealthCheckPolicyParams) Clone() *CreateLBHealthCheckPolicyParams {
+	return &CreateLBHealthCheckPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateLBHealthCheckPolicyParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1055,32 +1496,39 @@ func (s *LoadBalancerService) NewCreateLBHealthCheckPolicyParams(lbruleid string
 
 // Creates a load balancer health check policy
 func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("createLBHealthCheckPolicy", p.toURLValues())
+	return s.CreateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// CreateLBHealthCheckPolicyWithContext is like CreateLBHealthCheckPolicy, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createLBHealthCheckPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateLBHealthCheckPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createLBHealthCheckPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createLBHealthCheckPolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createLBHealthCheckPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1088,27 +1536,58 @@ func (s *LoadBalancerService) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateLBHealthCheckPolicy async job into r
+func (r *CreateLBHealthCheckPolicyResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateLBHealthCheckPolicyAsync starts the async job of CreateLBHealthCheckPolicy without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) CreateLBHealthCheckPolicyAsync(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*Job, error) {
+	r, err := s.CreateLBHealthCheckPolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createLBHealthCheckPolicy", r.JobID), nil
+}
+
 type CreateLBHealthCheckPolicyResponse struct {
 	Account           string                                               `json:"account"`
 	Domain            string                                               `json:"domain"`
 	Domainid          string                                               `json:"domainid"`
 	Healthcheckpolicy []CreateLBHealthCheckPolicyResponseHealthcheckpolicy `json:"healthcheckpolicy"`
 	JobID             string                                               `json:"jobid"`
-	Jobstatus         int                                                  `json:"jobstatus"`
+	Jobstatus         FlexInt                                              `json:"jobstatus"`
 	Lbruleid          string                                               `json:"lbruleid"`
 	Zoneid            string                                               `json:"zoneid"`
+	Extra             map[string]json.RawMessage                           `json:"-"`
+}
+
+func (r *CreateLBHealthCheckPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateLBHealthCheckPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLBHealthCheckPolicyResponseHealthcheckpolicy struct {
-	Description             string `json:"description"`
-	Fordisplay              bool   `json:"fordisplay"`
-	Healthcheckinterval     int    `json:"healthcheckinterval"`
-	Healthcheckthresshold   int    `json:"healthcheckthresshold"`
-	Id                      string `json:"id"`
-	Pingpath                string `json:"pingpath"`
-	Responsetime            int    `json:"responsetime"`
-	State                   string `json:"state"`
-	Unhealthcheckthresshold int    `json:"unhealthcheckthresshold"`
+	Description             string                     `json:"description"`
+	Fordisplay              FlexBool                   `json:"fordisplay"`
+	Healthcheckinterval     FlexInt                    `json:"healthcheckinterval"`
+	Healthcheckthresshold   FlexInt                    `json:"healthcheckthresshold"`
+	Id                      string                     `json:"id"`
+	Pingpath                string                     `json:"pingpath"`
+	Responsetime            FlexInt                    `json:"responsetime"`
+	State                   string                     `json:"state"`
+	Unhealthcheckthresshold FlexInt                    `json:"unhealthcheckthresshold"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateLBHealthCheckPolicyResponseHealthcheckpolicy) UnmarshalJSON(b []byte) error {
+	type alias CreateLBHealthCheckPolicyResponseHealthcheckpolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLBStickinessPolicyParams struct {
@@ -1146,6 +1625,49 @@ func (p *CreateLBStickinessPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createLBStickinessPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateLBStickinessPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createLBStickinessPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createLBStickinessPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateLBStickinessPolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"lbruleid", "methodname", "name"},
+		lengths:  map[string]int{"description": 4096, "lbruleid": 255, "methodname": 255, "name": 255},
+	}.validate("createLBStickinessPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createLBStickinessPolicy API, before the request is signed
+func (p *CreateLBStickinessPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createLBStickinessPolicy", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"lbruleid":    decodeParam[string],
+		"methodname":  decodeParam[string],
+		"name":        decodeParam[string],
+		"param":       decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateLBStickinessPolicyParams) Clone() *CreateLBStickinessPolicyParams {
+	return &CreateLBStickinessPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateLBStickinessPolicyParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1285,32 +1807,39 @@ func (s *LoadBalancerService) NewCreateLBStickinessPolicyParams(lbruleid string,
 
 // Creates a load balancer stickiness policy
 func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("createLBStickinessPolicy", p.toURLValues())
+	return s.CreateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// CreateLBStickinessPolicyWithContext is like CreateLBStickinessPolicy, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createLBStickinessPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateLBStickinessPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createLBStickinessPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createLBStickinessPolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createLBStickinessPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1318,28 +1847,59 @@ func (s *LoadBalancerService) CreateLBStickinessPolicy(p *CreateLBStickinessPoli
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateLBStickinessPolicy async job into r
+func (r *CreateLBStickinessPolicyResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateLBStickinessPolicyAsync starts the async job of CreateLBStickinessPolicy without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) CreateLBStickinessPolicyAsync(ctx context.Context, p *CreateLBStickinessPolicyParams) (*Job, error) {
+	r, err := s.CreateLBStickinessPolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createLBStickinessPolicy", r.JobID), nil
+}
+
 type CreateLBStickinessPolicyResponse struct {
 	Account          string                                             `json:"account"`
 	Description      string                                             `json:"description"`
 	Domain           string                                             `json:"domain"`
 	Domainid         string                                             `json:"domainid"`
 	JobID            string                                             `json:"jobid"`
-	Jobstatus        int                                                `json:"jobstatus"`
+	Jobstatus        FlexInt                                            `json:"jobstatus"`
 	Lbruleid         string                                             `json:"lbruleid"`
 	Name             string                                             `json:"name"`
 	State            string                                             `json:"state"`
 	Stickinesspolicy []CreateLBStickinessPolicyResponseStickinesspolicy `json:"stickinesspolicy"`
 	Zoneid           string                                             `json:"zoneid"`
+	Extra            map[string]json.RawMessage                         `json:"-"`
+}
+
+func (r *CreateLBStickinessPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateLBStickinessPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLBStickinessPolicyResponseStickinesspolicy struct {
-	Description string            `json:"description"`
-	Fordisplay  bool              `json:"fordisplay"`
-	Id          string            `json:"id"`
-	Methodname  string            `json:"methodname"`
-	Name        string            `json:"name"`
-	Params      map[string]string `json:"params"`
-	State       string            `json:"state"`
+	Description string                     `json:"description"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	Methodname  string                     `json:"methodname"`
+	Name        string                     `json:"name"`
+	Params      map[string]string          `json:"params"`
+	State       string                     `json:"state"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateLBStickinessPolicyResponseStickinesspolicy) UnmarshalJSON(b []byte) error {
+	type alias CreateLBStickinessPolicyResponseStickinesspolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLoadBalancerParams struct {
@@ -1387,6 +1947,53 @@ func (p *CreateLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"algorithm", "instanceport", "name", "networkid", "scheme", "sourceipaddressnetworkid", "sourceport"},
+		lengths:  map[string]int{"algorithm": 255, "description": 4096, "name": 255, "networkid": 255, "scheme": 255, "sourceipaddress": 255, "sourceipaddressnetworkid": 255},
+	}.validate("createLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createLoadBalancer API, before the request is signed
+func (p *CreateLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"algorithm":                decodeParam[string],
+		"description":              decodeParam[string],
+		"fordisplay":               decodeParam[bool],
+		"instanceport":             decodeParam[int],
+		"name":                     decodeParam[string],
+		"networkid":                decodeParam[string],
+		"scheme":                   decodeParam[string],
+		"sourceipaddress":          decodeParam[string],
+		"sourceipaddressnetworkid": decodeParam[string],
+		"sourceport":               decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateLoadBalancerParams) Clone() *CreateLoadBalancerParams {
+	return &CreateLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateLoadBalancerParams) SetAlgorithm(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1614,32 +2221,39 @@ func (s *LoadBalancerService) NewCreateLoadBalancerParams(algorithm string, inst
 
 // Creates an internal load balancer
 func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("createLoadBalancer", p.toURLValues())
+	return s.CreateLoadBalancerWithContext(context.Background(), p)
+}
+
+// CreateLoadBalancerWithContext is like CreateLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) CreateLoadBalancerWithContext(ctx context.Context, p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1647,6 +2261,25 @@ func (s *LoadBalancerService) CreateLoadBalancer(p *CreateLoadBalancerParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateLoadBalancer async job into r
+func (r *CreateLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateLoadBalancerAsync starts the async job of CreateLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) CreateLoadBalancerAsync(ctx context.Context, p *CreateLoadBalancerParams) (*Job, error) {
+	r, err := s.CreateLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createLoadBalancer", r.JobID), nil
+}
+
 type CreateLoadBalancerResponse struct {
 	Account                  string                                           `json:"account"`
 	Algorithm                string                                           `json:"algorithm"`
@@ -1654,10 +2287,10 @@ type CreateLoadBalancerResponse struct {
 	Domain                   string                                           `json:"domain"`
 	Domainid                 string                                           `json:"domainid"`
 	Domainpath               string                                           `json:"domainpath"`
-	Fordisplay               bool                                             `json:"fordisplay"`
+	Fordisplay               FlexBool                                         `json:"fordisplay"`
 	Id                       string                                           `json:"id"`
 	JobID                    string                                           `json:"jobid"`
-	Jobstatus                int                                              `json:"jobstatus"`
+	Jobstatus                FlexInt                                          `json:"jobstatus"`
 	Loadbalancerinstance     []CreateLoadBalancerResponseLoadbalancerinstance `json:"loadbalancerinstance"`
 	Loadbalancerrule         []CreateLoadBalancerResponseLoadbalancerrule     `json:"loadbalancerrule"`
 	Name                     string                                           `json:"name"`
@@ -1667,19 +2300,37 @@ type CreateLoadBalancerResponse struct {
 	Sourceipaddress          string                                           `json:"sourceipaddress"`
 	Sourceipaddressnetworkid string                                           `json:"sourceipaddressnetworkid"`
 	Tags                     []Tags                                           `json:"tags"`
+	Extra                    map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *CreateLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLoadBalancerResponseLoadbalancerrule struct {
-	Instanceport int    `json:"instanceport"`
-	Sourceport   int    `json:"sourceport"`
-	State        string `json:"state"`
+	Instanceport FlexInt                    `json:"instanceport"`
+	Sourceport   FlexInt                    `json:"sourceport"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateLoadBalancerResponseLoadbalancerrule) UnmarshalJSON(b []byte) error {
+	type alias CreateLoadBalancerResponseLoadbalancerrule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLoadBalancerResponseLoadbalancerinstance struct {
-	Id        string `json:"id"`
-	Ipaddress string `json:"ipaddress"`
-	Name      string `json:"name"`
-	State     string `json:"state"`
+	Id        string                     `json:"id"`
+	Ipaddress string                     `json:"ipaddress"`
+	Name      string                     `json:"name"`
+	State     string                     `json:"state"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateLoadBalancerResponseLoadbalancerinstance) UnmarshalJSON(b []byte) error {
+	type alias CreateLoadBalancerResponseLoadbalancerinstance
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateLoadBalancerRuleParams struct {
@@ -1726,7 +2377,7 @@ func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
 		u.Set("privateport", vv)
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["publicipid"]; found {
 		u.Set("publicipid", v.(string))
@@ -1741,6 +2392,57 @@ func (p *CreateLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"algorithm", "name", "privateport", "publicport"},
+		lengths:  map[string]int{"account": 255, "algorithm": 255, "description": 4096, "domainid": 255, "name": 255, "networkid": 255, "protocol": 255, "publicipid": 255, "zoneid": 255},
+	}.validate("createLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createLoadBalancerRule API, before the request is signed
+func (p *CreateLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"algorithm":    decodeParam[string],
+		"cidrlist":     decodeParam[[]string],
+		"description":  decodeParam[string],
+		"domainid":     decodeParam[string],
+		"fordisplay":   decodeParam[bool],
+		"name":         decodeParam[string],
+		"networkid":    decodeParam[string],
+		"openfirewall": decodeParam[bool],
+		"privateport":  decodeParam[int],
+		"protocol":     decodeParam[Protocol],
+		"publicipid":   decodeParam[string],
+		"publicport":   decodeParam[int],
+		"zoneid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateLoadBalancerRuleParams) Clone() *CreateLoadBalancerRuleParams {
+	return &CreateLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateLoadBalancerRuleParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1951,7 +2653,7 @@ func (p *CreateLoadBalancerRuleParams) GetPrivateport() (int, bool) {
 	return value, ok
 }
 
-func (p *CreateLoadBalancerRuleParams) SetProtocol(v string) {
+func (p *CreateLoadBalancerRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1964,11 +2666,11 @@ func (p *CreateLoadBalancerRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *CreateLoadBalancerRuleParams) GetProtocol() (string, bool) {
+func (p *CreateLoadBalancerRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -2049,32 +2751,39 @@ func (s *LoadBalancerService) NewCreateLoadBalancerRuleParams(algorithm string,
 
 // Creates a load balancer rule
 func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createLoadBalancerRule", p.toURLValues())
+	return s.CreateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// CreateLoadBalancerRuleWithContext is like CreateLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2082,31 +2791,56 @@ func (s *LoadBalancerService) CreateLoadBalancerRule(p *CreateLoadBalancerRulePa
 	return &r, nil
 }
 
-type CreateLoadBalancerRuleResponse struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Domainpath  string `json:"domainpath"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+// decodeJobResult decodes the result of a finished CreateLoadBalancerRule async job into r
+func (r *CreateLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateLoadBalancerRuleAsync starts the async job of CreateLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) CreateLoadBalancerRuleAsync(ctx context.Context, p *CreateLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.CreateLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createLoadBalancerRule", r.JobID), nil
+}
+
+type CreateLoadBalancerRuleResponse struct {
+	Account     string                     `json:"account"`
+	Algorithm   string                     `json:"algorithm"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Description string                     `json:"description"`
+	Domain      string                     `json:"domain"`
+	Domainid    string                     `json:"domainid"`
+	Domainpath  string                     `json:"domainpath"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Networkid   string                     `json:"networkid"`
+	Privateport string                     `json:"privateport"`
+	Project     string                     `json:"project"`
+	Projectid   string                     `json:"projectid"`
+	Protocol    string                     `json:"protocol"`
+	Publicip    string                     `json:"publicip"`
+	Publicipid  string                     `json:"publicipid"`
+	Publicport  string                     `json:"publicport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Zoneid      string                     `json:"zoneid"`
+	Zonename    string                     `json:"zonename"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteGlobalLoadBalancerRuleParams struct {
@@ -2124,6 +2858,44 @@ func (p *DeleteGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteGlobalLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteGlobalLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteGlobalLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteGlobalLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteGlobalLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteGlobalLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteGlobalLoadBalancerRule API, before the request is signed
+func (p *DeleteGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteGlobalLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteGlobalLoadBalancerRuleParams) Clone() *DeleteGlobalLoadBalancerRuleParams {
+	return &DeleteGlobalLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2156,27 +2928,39 @@ func (s *LoadBalancerService) NewDeleteGlobalLoadBalancerRuleParams(id string) *
 
 // Deletes a global load balancer rule.
 func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteGlobalLoadBalancerRule", p.toURLValues())
+	return s.DeleteGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// DeleteGlobalLoadBalancerRuleWithContext is like DeleteGlobalLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteGlobalLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteGlobalLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteGlobalLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2184,11 +2968,31 @@ func (s *LoadBalancerService) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteGlobalLoadBalancerRule async job into r
+func (r *DeleteGlobalLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteGlobalLoadBalancerRuleAsync starts the async job of DeleteGlobalLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) DeleteGlobalLoadBalancerRuleAsync(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.DeleteGlobalLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteGlobalLoadBalancerRule", r.JobID), nil
+}
+
 type DeleteGlobalLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteGlobalLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteGlobalLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteLBHealthCheckPolicyParams struct {
@@ -2206,6 +3010,44 @@ func (p *DeleteLBHealthCheckPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteLBHealthCheckPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteLBHealthCheckPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteLBHealthCheckPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteLBHealthCheckPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteLBHealthCheckPolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteLBHealthCheckPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteLBHealthCheckPolicy API, before the request is signed
+func (p *DeleteLBHealthCheckPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteLBHealthCheckPolicy", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteLBHealthCheckPolicyParams) Clone() *DeleteLBHealthCheckPolicyParams {
+	return &DeleteLBHealthCheckPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteLBHealthCheckPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2238,27 +3080,39 @@ func (s *LoadBalancerService) NewDeleteLBHealthCheckPolicyParams(id string) *Del
 
 // Deletes a load balancer health check policy.
 func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteLBHealthCheckPolicy", p.toURLValues())
+	return s.DeleteLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// DeleteLBHealthCheckPolicyWithContext is like DeleteLBHealthCheckPolicy, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteLBHealthCheckPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteLBHealthCheckPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteLBHealthCheckPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteLBHealthCheckPolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteLBHealthCheckPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2266,11 +3120,31 @@ func (s *LoadBalancerService) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteLBHealthCheckPolicy async job into r
+func (r *DeleteLBHealthCheckPolicyResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteLBHealthCheckPolicyAsync starts the async job of DeleteLBHealthCheckPolicy without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) DeleteLBHealthCheckPolicyAsync(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*Job, error) {
+	r, err := s.DeleteLBHealthCheckPolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteLBHealthCheckPolicy", r.JobID), nil
+}
+
 type DeleteLBHealthCheckPolicyResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteLBHealthCheckPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteLBHealthCheckPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteLBStickinessPolicyParams struct {
@@ -2288,6 +3162,44 @@ func (p *DeleteLBStickinessPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteLBStickinessPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteLBStickinessPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteLBStickinessPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteLBStickinessPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteLBStickinessPolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteLBStickinessPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteLBStickinessPolicy API, before the request is signed
+func (p *DeleteLBStickinessPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteLBStickinessPolicy", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteLBStickinessPolicyParams) Clone() *DeleteLBStickinessPolicyParams {
+	return &DeleteLBStickinessPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteLBStickinessPolicyParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2320,27 +3232,39 @@ func (s *LoadBalancerService) NewDeleteLBStickinessPolicyParams(id string) *Dele
 
 // Deletes a load balancer stickiness policy.
 func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteLBStickinessPolicy", p.toURLValues())
+	return s.DeleteLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// DeleteLBStickinessPolicyWithContext is like DeleteLBStickinessPolicy, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteLBStickinessPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteLBStickinessPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteLBStickinessPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteLBStickinessPolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteLBStickinessPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2348,11 +3272,31 @@ func (s *LoadBalancerService) DeleteLBStickinessPolicy(p *DeleteLBStickinessPoli
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteLBStickinessPolicy async job into r
+func (r *DeleteLBStickinessPolicyResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteLBStickinessPolicyAsync starts the async job of DeleteLBStickinessPolicy without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) DeleteLBStickinessPolicyAsync(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*Job, error) {
+	r, err := s.DeleteLBStickinessPolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteLBStickinessPolicy", r.JobID), nil
+}
+
 type DeleteLBStickinessPolicyResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteLBStickinessPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteLBStickinessPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteLoadBalancerParams struct {
@@ -2370,6 +3314,44 @@ func (p *DeleteLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteLoadBalancer API, before the request is signed
+func (p *DeleteLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteLoadBalancerParams) Clone() *DeleteLoadBalancerParams {
+	return &DeleteLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteLoadBalancerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2402,27 +3384,39 @@ func (s *LoadBalancerService) NewDeleteLoadBalancerParams(id string) *DeleteLoad
 
 // Deletes an internal load balancer
 func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteLoadBalancer", p.toURLValues())
+	return s.DeleteLoadBalancerWithContext(context.Background(), p)
+}
+
+// DeleteLoadBalancerWithContext is like DeleteLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeleteLoadBalancerWithContext(ctx context.Context, p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2430,11 +3424,31 @@ func (s *LoadBalancerService) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteLoadBalancer async job into r
+func (r *DeleteLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteLoadBalancerAsync starts the async job of DeleteLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) DeleteLoadBalancerAsync(ctx context.Context, p *DeleteLoadBalancerParams) (*Job, error) {
+	r, err := s.DeleteLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteLoadBalancer", r.JobID), nil
+}
+
 type DeleteLoadBalancerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteLoadBalancerRuleParams struct {
@@ -2452,6 +3466,44 @@ func (p *DeleteLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteLoadBalancerRule API, before the request is signed
+func (p *DeleteLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteLoadBalancerRuleParams) Clone() *DeleteLoadBalancerRuleParams {
+	return &DeleteLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2484,27 +3536,39 @@ func (s *LoadBalancerService) NewDeleteLoadBalancerRuleParams(id string) *Delete
 
 // Deletes a load balancer rule.
 func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteLoadBalancerRule", p.toURLValues())
+	return s.DeleteLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// DeleteLoadBalancerRuleWithContext is like DeleteLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2512,11 +3576,31 @@ func (s *LoadBalancerService) DeleteLoadBalancerRule(p *DeleteLoadBalancerRulePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteLoadBalancerRule async job into r
+func (r *DeleteLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteLoadBalancerRuleAsync starts the async job of DeleteLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) DeleteLoadBalancerRuleAsync(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.DeleteLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteLoadBalancerRule", r.JobID), nil
+}
+
 type DeleteLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteServicePackageOfferingParams struct {
@@ -2534,6 +3618,44 @@ func (p *DeleteServicePackageOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteServicePackageOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteServicePackageOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteServicePackageOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteServicePackageOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteServicePackageOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteServicePackageOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteServicePackageOffering API, before the request is signed
+func (p *DeleteServicePackageOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteServicePackageOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteServicePackageOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteServicePackageOffering", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteServicePackageOfferingParams) Clone() *DeleteServicePackageOfferingParams {
+	return &DeleteServicePackageOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteServicePackageOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2566,13 +3688,22 @@ func (s *LoadBalancerService) NewDeleteServicePackageOfferingParams(id string) *
 
 // Delete Service Package
 func (s *LoadBalancerService) DeleteServicePackageOffering(p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteServicePackageOffering", p.toURLValues())
+	return s.DeleteServicePackageOfferingWithContext(context.Background(), p)
+}
+
+// DeleteServicePackageOfferingWithContext is like DeleteServicePackageOffering, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeleteServicePackageOfferingWithContext(ctx context.Context, p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteServicePackageOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteServicePackageOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteServicePackageOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2580,10 +3711,11 @@ func (s *LoadBalancerService) DeleteServicePackageOffering(p *DeleteServicePacka
 }
 
 type DeleteServicePackageOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteServicePackageOfferingResponse) UnmarshalJSON(b []byte) error {
@@ -2610,7 +3742,7 @@ func (r *DeleteServicePackageOfferingResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteServicePackageOfferingResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteSslCertParams struct {
@@ -2628,6 +3760,44 @@ func (p *DeleteSslCertParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteSslCert API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteSslCertParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteSslCert", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteSslCert API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteSslCertParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteSslCert", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteSslCert API, before the request is signed
+func (p *DeleteSslCertParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteSslCertParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteSslCertParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteSslCert", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteSslCertParams) Clone() *DeleteSslCertParams {
+	return &DeleteSslCertParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteSslCertParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2660,13 +3830,22 @@ func (s *LoadBalancerService) NewDeleteSslCertParams(id string) *DeleteSslCertPa
 
 // Delete a certificate to CloudStack
 func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteSslCert", p.toURLValues())
+	return s.DeleteSslCertWithContext(context.Background(), p)
+}
+
+// DeleteSslCertWithContext is like DeleteSslCert, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteSslCert", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSslCertResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteSslCert", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2674,10 +3853,11 @@ func (s *LoadBalancerService) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslC
 }
 
 type DeleteSslCertResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteSslCertResponse) UnmarshalJSON(b []byte) error {
@@ -2704,7 +3884,7 @@ func (r *DeleteSslCertResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteSslCertResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeployNetscalerVpxParams struct {
@@ -2731,6 +3911,47 @@ func (p *DeployNetscalerVpxParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deployNetscalerVpx API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeployNetscalerVpxParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deployNetscalerVpx", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deployNetscalerVpx API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeployNetscalerVpxParams) Validate() error {
+	return paramRules{
+		required: []string{"serviceofferingid", "templateid", "zoneid"},
+		lengths:  map[string]int{"networkid": 255, "serviceofferingid": 255, "templateid": 255, "zoneid": 255},
+	}.validate("deployNetscalerVpx", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deployNetscalerVpx API, before the request is signed
+func (p *DeployNetscalerVpxParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeployNetscalerVpxParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeployNetscalerVpxParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deployNetscalerVpx", b, &p.p, map[string]paramDecoder{
+		"networkid":         decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+		"templateid":        decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeployNetscalerVpxParams) Clone() *DeployNetscalerVpxParams {
+	return &DeployNetscalerVpxParams{p: cloneParams(p.p)}
+}
+
 func (p *DeployNetscalerVpxParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2828,32 +4049,39 @@ func (s *LoadBalancerService) NewDeployNetscalerVpxParams(serviceofferingid stri
 
 // Creates new NS Vpx
 func (s *LoadBalancerService) DeployNetscalerVpx(p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error) {
-	resp, err := s.cs.newPostRequest("deployNetscalerVpx", p.toURLValues())
+	return s.DeployNetscalerVpxWithContext(context.Background(), p)
+}
+
+// DeployNetscalerVpxWithContext is like DeployNetscalerVpx, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) DeployNetscalerVpxWithContext(ctx context.Context, p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deployNetscalerVpx", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeployNetscalerVpxResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deployNetscalerVpx", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deployNetscalerVpx", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deployNetscalerVpx", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2861,24 +4089,49 @@ func (s *LoadBalancerService) DeployNetscalerVpx(p *DeployNetscalerVpxParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeployNetscalerVpx async job into r
+func (r *DeployNetscalerVpxResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeployNetscalerVpxAsync starts the async job of DeployNetscalerVpx without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) DeployNetscalerVpxAsync(ctx context.Context, p *DeployNetscalerVpxParams) (*Job, error) {
+	r, err := s.DeployNetscalerVpxWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deployNetscalerVpx", r.JobID), nil
+}
+
 type DeployNetscalerVpxResponse struct {
-	Gslbprovider            bool     `json:"gslbprovider"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
-	Ipaddress               string   `json:"ipaddress"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
-	JobID                   string   `json:"jobid"`
-	Jobstatus               int      `json:"jobstatus"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated"`
-	Lbdeviceid              string   `json:"lbdeviceid"`
-	Lbdevicename            string   `json:"lbdevicename"`
-	Lbdevicestate           string   `json:"lbdevicestate"`
-	Physicalnetworkid       string   `json:"physicalnetworkid"`
-	Podids                  []string `json:"podids"`
-	Privateinterface        string   `json:"privateinterface"`
-	Provider                string   `json:"provider"`
-	Publicinterface         string   `json:"publicinterface"`
+	Gslbprovider            FlexBool                   `json:"gslbprovider"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip"`
+	Ipaddress               string                     `json:"ipaddress"`
+	Isexclusivegslbprovider FlexBool                   `json:"isexclusivegslbprovider"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Lbdevicecapacity        FlexInt64                  `json:"lbdevicecapacity"`
+	Lbdevicededicated       FlexBool                   `json:"lbdevicededicated"`
+	Lbdeviceid              string                     `json:"lbdeviceid"`
+	Lbdevicename            string                     `json:"lbdevicename"`
+	Lbdevicestate           string                     `json:"lbdevicestate"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid"`
+	Podids                  []string                   `json:"podids"`
+	Privateinterface        string                     `json:"privateinterface"`
+	Provider                string                     `json:"provider"`
+	Publicinterface         string                     `json:"publicinterface"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeployNetscalerVpxResponse) UnmarshalJSON(b []byte) error {
+	type alias DeployNetscalerVpxResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListGlobalLoadBalancerRulesParams struct {
@@ -2935,6 +4188,53 @@ func (p *ListGlobalLoadBalancerRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listGlobalLoadBalancerRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListGlobalLoadBalancerRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listGlobalLoadBalancerRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listGlobalLoadBalancerRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListGlobalLoadBalancerRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255},
+	}.validate("listGlobalLoadBalancerRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listGlobalLoadBalancerRules API, before the request is signed
+func (p *ListGlobalLoadBalancerRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListGlobalLoadBalancerRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListGlobalLoadBalancerRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listGlobalLoadBalancerRules", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"regionid":    decodeParam[int],
+		"tags":        decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListGlobalLoadBalancerRulesParams) Clone() *ListGlobalLoadBalancerRulesParams {
+	return &ListGlobalLoadBalancerRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListGlobalLoadBalancerRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3176,6 +4476,11 @@ func (s *LoadBalancerService) NewListGlobalLoadBalancerRulesParams() *ListGlobal
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetGlobalLoadBalancerRuleIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetGlobalLoadBalancerRuleIDWithContext is like GetGlobalLoadBalancerRuleID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListGlobalLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3187,7 +4492,7 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts .
 		}
 	}
 
-	l, err := s.ListGlobalLoadBalancerRules(p)
+	l, err := s.ListGlobalLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3212,12 +4517,17 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleID(keyword string, opts .
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
-	id, count, err := s.GetGlobalLoadBalancerRuleID(name, opts...)
+	return s.GetGlobalLoadBalancerRuleByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetGlobalLoadBalancerRuleByNameWithContext is like GetGlobalLoadBalancerRuleByName, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	id, count, err := s.GetGlobalLoadBalancerRuleIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetGlobalLoadBalancerRuleByID(id, opts...)
+	r, count, err := s.GetGlobalLoadBalancerRuleByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3226,6 +4536,11 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByName(name string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	return s.GetGlobalLoadBalancerRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetGlobalLoadBalancerRuleByIDWithContext is like GetGlobalLoadBalancerRuleByID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
 	p := &ListGlobalLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3237,11 +4552,10 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...O
 		}
 	}
 
-	l, err := s.ListGlobalLoadBalancerRules(p)
+	l, err := s.ListGlobalLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3259,19 +4573,56 @@ func (s *LoadBalancerService) GetGlobalLoadBalancerRuleByID(id string, opts ...O
 
 // Lists load balancer rules.
 func (s *LoadBalancerService) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listGlobalLoadBalancerRules", p.toURLValues())
+	return s.ListGlobalLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+// ListGlobalLoadBalancerRulesWithContext is like ListGlobalLoadBalancerRules, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listGlobalLoadBalancerRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListGlobalLoadBalancerRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listGlobalLoadBalancerRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListGlobalLoadBalancerRulesAll returns all GlobalLoadBalancerRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesAll(p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) ([]*GlobalLoadBalancerRule, error) {
+	return s.ListGlobalLoadBalancerRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListGlobalLoadBalancerRulesAllWithContext is like ListGlobalLoadBalancerRulesAll, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesAllWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) ([]*GlobalLoadBalancerRule, error) {
+	return collectPages(s.ListGlobalLoadBalancerRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListGlobalLoadBalancerRulesIter returns an iterator over all GlobalLoadBalancerRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesIter(p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*GlobalLoadBalancerRule, error] {
+	return s.ListGlobalLoadBalancerRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListGlobalLoadBalancerRulesIterWithContext is like ListGlobalLoadBalancerRulesIter, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListGlobalLoadBalancerRulesIterWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*GlobalLoadBalancerRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*GlobalLoadBalancerRule, int, error) {
+		l, err := s.ListGlobalLoadBalancerRulesWithContext(ctx, &ListGlobalLoadBalancerRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.GlobalLoadBalancerRules, l.Count, nil
+	}, opts...)
+}
+
 type ListGlobalLoadBalancerRulesResponse struct {
 	Count                   int                       `json:"count"`
 	GlobalLoadBalancerRules []*GlobalLoadBalancerRule `json:"globalloadbalancerrule"`
@@ -3289,37 +4640,49 @@ type GlobalLoadBalancerRule struct {
 	Gslbstickysessionmethodname string                                   `json:"gslbstickysessionmethodname"`
 	Id                          string                                   `json:"id"`
 	JobID                       string                                   `json:"jobid"`
-	Jobstatus                   int                                      `json:"jobstatus"`
+	Jobstatus                   FlexInt                                  `json:"jobstatus"`
 	Loadbalancerrule            []GlobalLoadBalancerRuleLoadbalancerrule `json:"loadbalancerrule"`
 	Name                        string                                   `json:"name"`
 	Project                     string                                   `json:"project"`
 	Projectid                   string                                   `json:"projectid"`
-	Regionid                    int                                      `json:"regionid"`
+	Regionid                    FlexInt                                  `json:"regionid"`
+	Extra                       map[string]json.RawMessage               `json:"-"`
+}
+
+func (r *GlobalLoadBalancerRule) UnmarshalJSON(b []byte) error {
+	type alias GlobalLoadBalancerRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GlobalLoadBalancerRuleLoadbalancerrule struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Domainpath  string `json:"domainpath"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+	Account     string                     `json:"account"`
+	Algorithm   string                     `json:"algorithm"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Description string                     `json:"description"`
+	Domain      string                     `json:"domain"`
+	Domainid    string                     `json:"domainid"`
+	Domainpath  string                     `json:"domainpath"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	Name        string                     `json:"name"`
+	Networkid   string                     `json:"networkid"`
+	Privateport string                     `json:"privateport"`
+	Project     string                     `json:"project"`
+	Projectid   string                     `json:"projectid"`
+	Protocol    string                     `json:"protocol"`
+	Publicip    string                     `json:"publicip"`
+	Publicipid  string                     `json:"publicipid"`
+	Publicport  string                     `json:"publicport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Zoneid      string                     `json:"zoneid"`
+	Zonename    string                     `json:"zonename"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GlobalLoadBalancerRuleLoadbalancerrule) UnmarshalJSON(b []byte) error {
+	type alias GlobalLoadBalancerRuleLoadbalancerrule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListLBHealthCheckPoliciesParams struct {
@@ -3355,6 +4718,48 @@ func (p *ListLBHealthCheckPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listLBHealthCheckPolicies API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListLBHealthCheckPoliciesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listLBHealthCheckPolicies", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listLBHealthCheckPolicies API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListLBHealthCheckPoliciesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "lbruleid": 255},
+	}.validate("listLBHealthCheckPolicies", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listLBHealthCheckPolicies API, before the request is signed
+func (p *ListLBHealthCheckPoliciesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListLBHealthCheckPoliciesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListLBHealthCheckPoliciesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listLBHealthCheckPolicies", b, &p.p, map[string]paramDecoder{
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+		"keyword":    decodeParam[string],
+		"lbruleid":   decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListLBHealthCheckPoliciesParams) Clone() *ListLBHealthCheckPoliciesParams {
+	return &ListLBHealthCheckPoliciesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListLBHealthCheckPoliciesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3491,6 +4896,11 @@ func (s *LoadBalancerService) NewListLBHealthCheckPoliciesParams() *ListLBHealth
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
+	return s.GetLBHealthCheckPolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLBHealthCheckPolicyByIDWithContext is like GetLBHealthCheckPolicyByID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLBHealthCheckPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
 	p := &ListLBHealthCheckPoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3502,11 +4912,10 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 		}
 	}
 
-	l, err := s.ListLBHealthCheckPolicies(p)
+	l, err := s.ListLBHealthCheckPoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3524,19 +4933,56 @@ func (s *LoadBalancerService) GetLBHealthCheckPolicyByID(id string, opts ...Opti
 
 // Lists load balancer health check policies.
 func (s *LoadBalancerService) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBHealthCheckPolicies", p.toURLValues())
+	return s.ListLBHealthCheckPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBHealthCheckPoliciesWithContext is like ListLBHealthCheckPolicies, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listLBHealthCheckPolicies", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListLBHealthCheckPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listLBHealthCheckPolicies", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListLBHealthCheckPoliciesAll returns all LBHealthCheckPolicies matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesAll(p *ListLBHealthCheckPoliciesParams, opts ...PageOption) ([]*LBHealthCheckPolicy, error) {
+	return s.ListLBHealthCheckPoliciesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListLBHealthCheckPoliciesAllWithContext is like ListLBHealthCheckPoliciesAll, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesAllWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams, opts ...PageOption) ([]*LBHealthCheckPolicy, error) {
+	return collectPages(s.ListLBHealthCheckPoliciesIterWithContext(ctx, p, opts...))
+}
+
+// ListLBHealthCheckPoliciesIter returns an iterator over all LBHealthCheckPolicies matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesIter(p *ListLBHealthCheckPoliciesParams, opts ...PageOption) iter.Seq2[*LBHealthCheckPolicy, error] {
+	return s.ListLBHealthCheckPoliciesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListLBHealthCheckPoliciesIterWithContext is like ListLBHealthCheckPoliciesIter, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLBHealthCheckPoliciesIterWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams, opts ...PageOption) iter.Seq2[*LBHealthCheckPolicy, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*LBHealthCheckPolicy, int, error) {
+		l, err := s.ListLBHealthCheckPoliciesWithContext(ctx, &ListLBHealthCheckPoliciesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.LBHealthCheckPolicies, l.Count, nil
+	}, opts...)
+}
+
 type ListLBHealthCheckPoliciesResponse struct {
 	Count                 int                    `json:"count"`
 	LBHealthCheckPolicies []*LBHealthCheckPolicy `json:"healthcheckpolicies"`
@@ -3548,21 +4994,33 @@ type LBHealthCheckPolicy struct {
 	Domainid          string                                 `json:"domainid"`
 	Healthcheckpolicy []LBHealthCheckPolicyHealthcheckpolicy `json:"healthcheckpolicy"`
 	JobID             string                                 `json:"jobid"`
-	Jobstatus         int                                    `json:"jobstatus"`
+	Jobstatus         FlexInt                                `json:"jobstatus"`
 	Lbruleid          string                                 `json:"lbruleid"`
 	Zoneid            string                                 `json:"zoneid"`
+	Extra             map[string]json.RawMessage             `json:"-"`
+}
+
+func (r *LBHealthCheckPolicy) UnmarshalJSON(b []byte) error {
+	type alias LBHealthCheckPolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LBHealthCheckPolicyHealthcheckpolicy struct {
-	Description             string `json:"description"`
-	Fordisplay              bool   `json:"fordisplay"`
-	Healthcheckinterval     int    `json:"healthcheckinterval"`
-	Healthcheckthresshold   int    `json:"healthcheckthresshold"`
-	Id                      string `json:"id"`
-	Pingpath                string `json:"pingpath"`
-	Responsetime            int    `json:"responsetime"`
-	State                   string `json:"state"`
-	Unhealthcheckthresshold int    `json:"unhealthcheckthresshold"`
+	Description             string                     `json:"description"`
+	Fordisplay              FlexBool                   `json:"fordisplay"`
+	Healthcheckinterval     FlexInt                    `json:"healthcheckinterval"`
+	Healthcheckthresshold   FlexInt                    `json:"healthcheckthresshold"`
+	Id                      string                     `json:"id"`
+	Pingpath                string                     `json:"pingpath"`
+	Responsetime            FlexInt                    `json:"responsetime"`
+	State                   string                     `json:"state"`
+	Unhealthcheckthresshold FlexInt                    `json:"unhealthcheckthresshold"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LBHealthCheckPolicyHealthcheckpolicy) UnmarshalJSON(b []byte) error {
+	type alias LBHealthCheckPolicyHealthcheckpolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListLBStickinessPoliciesParams struct {
@@ -3598,6 +5056,48 @@ func (p *ListLBStickinessPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listLBStickinessPolicies API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListLBStickinessPoliciesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listLBStickinessPolicies", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listLBStickinessPolicies API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListLBStickinessPoliciesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "lbruleid": 255},
+	}.validate("listLBStickinessPolicies", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listLBStickinessPolicies API, before the request is signed
+func (p *ListLBStickinessPoliciesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListLBStickinessPoliciesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListLBStickinessPoliciesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listLBStickinessPolicies", b, &p.p, map[string]paramDecoder{
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+		"keyword":    decodeParam[string],
+		"lbruleid":   decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListLBStickinessPoliciesParams) Clone() *ListLBStickinessPoliciesParams {
+	return &ListLBStickinessPoliciesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListLBStickinessPoliciesParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3734,6 +5234,11 @@ func (s *LoadBalancerService) NewListLBStickinessPoliciesParams() *ListLBStickin
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
+	return s.GetLBStickinessPolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLBStickinessPolicyByIDWithContext is like GetLBStickinessPolicyByID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLBStickinessPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
 	p := &ListLBStickinessPoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3745,11 +5250,10 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListLBStickinessPolicies(p)
+	l, err := s.ListLBStickinessPoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3767,19 +5271,56 @@ func (s *LoadBalancerService) GetLBStickinessPolicyByID(id string, opts ...Optio
 
 // Lists load balancer stickiness policies.
 func (s *LoadBalancerService) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listLBStickinessPolicies", p.toURLValues())
+	return s.ListLBStickinessPoliciesWithContext(context.Background(), p)
+}
+
+// ListLBStickinessPoliciesWithContext is like ListLBStickinessPolicies, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listLBStickinessPolicies", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListLBStickinessPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listLBStickinessPolicies", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListLBStickinessPoliciesAll returns all LBStickinessPolicies matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LoadBalancerService) ListLBStickinessPoliciesAll(p *ListLBStickinessPoliciesParams, opts ...PageOption) ([]*LBStickinessPolicy, error) {
+	return s.ListLBStickinessPoliciesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListLBStickinessPoliciesAllWithContext is like ListLBStickinessPoliciesAll, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLBStickinessPoliciesAllWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams, opts ...PageOption) ([]*LBStickinessPolicy, error) {
+	return collectPages(s.ListLBStickinessPoliciesIterWithContext(ctx, p, opts...))
+}
+
+// ListLBStickinessPoliciesIter returns an iterator over all LBStickinessPolicies matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LoadBalancerService) ListLBStickinessPoliciesIter(p *ListLBStickinessPoliciesParams, opts ...PageOption) iter.Seq2[*LBStickinessPolicy, error] {
+	return s.ListLBStickinessPoliciesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListLBStickinessPoliciesIterWithContext is like ListLBStickinessPoliciesIter, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLBStickinessPoliciesIterWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams, opts ...PageOption) iter.Seq2[*LBStickinessPolicy, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*LBStickinessPolicy, int, error) {
+		l, err := s.ListLBStickinessPoliciesWithContext(ctx, &ListLBStickinessPoliciesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.LBStickinessPolicies, l.Count, nil
+	}, opts...)
+}
+
 type ListLBStickinessPoliciesResponse struct {
 	Count                int                   `json:"count"`
 	LBStickinessPolicies []*LBStickinessPolicy `json:"stickinesspolicies"`
@@ -3791,22 +5332,34 @@ type LBStickinessPolicy struct {
 	Domain           string                               `json:"domain"`
 	Domainid         string                               `json:"domainid"`
 	JobID            string                               `json:"jobid"`
-	Jobstatus        int                                  `json:"jobstatus"`
+	Jobstatus        FlexInt                              `json:"jobstatus"`
 	Lbruleid         string                               `json:"lbruleid"`
 	Name             string                               `json:"name"`
 	State            string                               `json:"state"`
 	Stickinesspolicy []LBStickinessPolicyStickinesspolicy `json:"stickinesspolicy"`
 	Zoneid           string                               `json:"zoneid"`
+	Extra            map[string]json.RawMessage           `json:"-"`
+}
+
+func (r *LBStickinessPolicy) UnmarshalJSON(b []byte) error {
+	type alias LBStickinessPolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LBStickinessPolicyStickinesspolicy struct {
-	Description string            `json:"description"`
-	Fordisplay  bool              `json:"fordisplay"`
-	Id          string            `json:"id"`
-	Methodname  string            `json:"methodname"`
-	Name        string            `json:"name"`
-	Params      map[string]string `json:"params"`
-	State       string            `json:"state"`
+	Description string                     `json:"description"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	Methodname  string                     `json:"methodname"`
+	Name        string                     `json:"name"`
+	Params      map[string]string          `json:"params"`
+	State       string                     `json:"state"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LBStickinessPolicyStickinesspolicy) UnmarshalJSON(b []byte) error {
+	type alias LBStickinessPolicyStickinesspolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListLoadBalancerRuleInstancesParams struct {
@@ -3843,6 +5396,49 @@ func (p *ListLoadBalancerRuleInstancesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listLoadBalancerRuleInstances API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListLoadBalancerRuleInstancesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listLoadBalancerRuleInstances", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listLoadBalancerRuleInstances API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListLoadBalancerRuleInstancesParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "keyword": 255},
+	}.validate("listLoadBalancerRuleInstances", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listLoadBalancerRuleInstances API, before the request is signed
+func (p *ListLoadBalancerRuleInstancesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListLoadBalancerRuleInstancesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListLoadBalancerRuleInstancesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listLoadBalancerRuleInstances", b, &p.p, map[string]paramDecoder{
+		"applied":  decodeParam[bool],
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"lbvmips":  decodeParam[bool],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListLoadBalancerRuleInstancesParams) Clone() *ListLoadBalancerRuleInstancesParams {
+	return &ListLoadBalancerRuleInstancesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListLoadBalancerRuleInstancesParams) SetApplied(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3980,6 +5576,11 @@ func (s *LoadBalancerService) NewListLoadBalancerRuleInstancesParams(id string)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	return s.GetLoadBalancerRuleInstanceByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLoadBalancerRuleInstanceByIDWithContext is like GetLoadBalancerRuleInstanceByID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	p := &ListLoadBalancerRuleInstancesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3991,11 +5592,10 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 		}
 	}
 
-	l, err := s.ListLoadBalancerRuleInstances(p)
+	l, err := s.ListLoadBalancerRuleInstancesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4013,13 +5613,22 @@ func (s *LoadBalancerService) GetLoadBalancerRuleInstanceByID(id string, opts ..
 
 // List all Instances that are assigned to a load balancer rule.
 func (s *LoadBalancerService) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRuleInstances", p.toURLValues())
+	return s.ListLoadBalancerRuleInstancesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRuleInstancesWithContext is like ListLoadBalancerRuleInstances, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listLoadBalancerRuleInstances", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListLoadBalancerRuleInstancesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listLoadBalancerRuleInstances", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4033,10 +5642,16 @@ type ListLoadBalancerRuleInstancesResponse struct {
 }
 
 type LoadBalancerRuleInstance struct {
-	JobID                    string          `json:"jobid"`
-	Jobstatus                int             `json:"jobstatus"`
-	Lbvmipaddresses          []string        `json:"lbvmipaddresses"`
-	Loadbalancerruleinstance *VirtualMachine `json:"loadbalancerruleinstance"`
+	JobID                    string                     `json:"jobid"`
+	Jobstatus                FlexInt                    `json:"jobstatus"`
+	Lbvmipaddresses          []string                   `json:"lbvmipaddresses"`
+	Loadbalancerruleinstance *VirtualMachine            `json:"loadbalancerruleinstance"`
+	Extra                    map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LoadBalancerRuleInstance) UnmarshalJSON(b []byte) error {
+	type alias LoadBalancerRuleInstance
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListLoadBalancerRulesParams struct {
@@ -4108,6 +5723,58 @@ func (p *ListLoadBalancerRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listLoadBalancerRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListLoadBalancerRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listLoadBalancerRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listLoadBalancerRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListLoadBalancerRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "networkid": 255, "projectid": 255, "publicipid": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("listLoadBalancerRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listLoadBalancerRules API, before the request is signed
+func (p *ListLoadBalancerRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListLoadBalancerRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListLoadBalancerRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listLoadBalancerRules", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"fordisplay":       decodeParam[bool],
+		"id":               decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"name":             decodeParam[string],
+		"networkid":        decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"publicipid":       decodeParam[string],
+		"tags":             decodeParam[map[string]string],
+		"virtualmachineid": decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListLoadBalancerRulesParams) Clone() *ListLoadBalancerRulesParams {
+	return &ListLoadBalancerRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListLoadBalancerRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4454,6 +6121,11 @@ func (s *LoadBalancerService) NewListLoadBalancerRulesParams() *ListLoadBalancer
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetLoadBalancerRuleIDWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerRuleIDWithContext is like GetLoadBalancerRuleID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLoadBalancerRuleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -4465,7 +6137,7 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListLoadBalancerRules(p)
+	l, err := s.ListLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4490,12 +6162,17 @@ func (s *LoadBalancerService) GetLoadBalancerRuleID(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
-	id, count, err := s.GetLoadBalancerRuleID(name, opts...)
+	return s.GetLoadBalancerRuleByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerRuleByNameWithContext is like GetLoadBalancerRuleByName, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	id, count, err := s.GetLoadBalancerRuleIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetLoadBalancerRuleByID(id, opts...)
+	r, count, err := s.GetLoadBalancerRuleByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4504,6 +6181,11 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByName(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	return s.GetLoadBalancerRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLoadBalancerRuleByIDWithContext is like GetLoadBalancerRuleByID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
 	p := &ListLoadBalancerRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -4515,11 +6197,10 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListLoadBalancerRules(p)
+	l, err := s.ListLoadBalancerRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4537,49 +6218,92 @@ func (s *LoadBalancerService) GetLoadBalancerRuleByID(id string, opts ...OptionF
 
 // Lists load balancer rules.
 func (s *LoadBalancerService) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancerRules", p.toURLValues())
+	return s.ListLoadBalancerRulesWithContext(context.Background(), p)
+}
+
+// ListLoadBalancerRulesWithContext is like ListLoadBalancerRules, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listLoadBalancerRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListLoadBalancerRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listLoadBalancerRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListLoadBalancerRulesAll returns all LoadBalancerRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LoadBalancerService) ListLoadBalancerRulesAll(p *ListLoadBalancerRulesParams, opts ...PageOption) ([]*LoadBalancerRule, error) {
+	return s.ListLoadBalancerRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListLoadBalancerRulesAllWithContext is like ListLoadBalancerRulesAll, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLoadBalancerRulesAllWithContext(ctx context.Context, p *ListLoadBalancerRulesParams, opts ...PageOption) ([]*LoadBalancerRule, error) {
+	return collectPages(s.ListLoadBalancerRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListLoadBalancerRulesIter returns an iterator over all LoadBalancerRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LoadBalancerService) ListLoadBalancerRulesIter(p *ListLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*LoadBalancerRule, error] {
+	return s.ListLoadBalancerRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListLoadBalancerRulesIterWithContext is like ListLoadBalancerRulesIter, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLoadBalancerRulesIterWithContext(ctx context.Context, p *ListLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*LoadBalancerRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*LoadBalancerRule, int, error) {
+		l, err := s.ListLoadBalancerRulesWithContext(ctx, &ListLoadBalancerRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.LoadBalancerRules, l.Count, nil
+	}, opts...)
+}
+
 type ListLoadBalancerRulesResponse struct {
 	Count             int                 `json:"count"`
 	LoadBalancerRules []*LoadBalancerRule `json:"loadbalancerrule"`
 }
 
 type LoadBalancerRule struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Domainpath  string `json:"domainpath"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+	Account     string                     `json:"account"`
+	Algorithm   string                     `json:"algorithm"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Description string                     `json:"description"`
+	Domain      string                     `json:"domain"`
+	Domainid    string                     `json:"domainid"`
+	Domainpath  string                     `json:"domainpath"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Networkid   string                     `json:"networkid"`
+	Privateport string                     `json:"privateport"`
+	Project     string                     `json:"project"`
+	Projectid   string                     `json:"projectid"`
+	Protocol    string                     `json:"protocol"`
+	Publicip    string                     `json:"publicip"`
+	Publicipid  string                     `json:"publicipid"`
+	Publicport  string                     `json:"publicport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Zoneid      string                     `json:"zoneid"`
+	Zonename    string                     `json:"zonename"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LoadBalancerRule) UnmarshalJSON(b []byte) error {
+	type alias LoadBalancerRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListLoadBalancersParams struct {
@@ -4651,6 +6375,58 @@ func (p *ListLoadBalancersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listLoadBalancers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListLoadBalancersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listLoadBalancers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listLoadBalancers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListLoadBalancersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "networkid": 255, "projectid": 255, "scheme": 255, "sourceipaddress": 255, "sourceipaddressnetworkid": 255},
+	}.validate("listLoadBalancers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listLoadBalancers API, before the request is signed
+func (p *ListLoadBalancersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListLoadBalancersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListLoadBalancersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listLoadBalancers", b, &p.p, map[string]paramDecoder{
+		"account":                  decodeParam[string],
+		"domainid":                 decodeParam[string],
+		"fordisplay":               decodeParam[bool],
+		"id":                       decodeParam[string],
+		"isrecursive":              decodeParam[bool],
+		"keyword":                  decodeParam[string],
+		"listall":                  decodeParam[bool],
+		"name":                     decodeParam[string],
+		"networkid":                decodeParam[string],
+		"page":                     decodeParam[int],
+		"pagesize":                 decodeParam[int],
+		"projectid":                decodeParam[string],
+		"scheme":                   decodeParam[string],
+		"sourceipaddress":          decodeParam[string],
+		"sourceipaddressnetworkid": decodeParam[string],
+		"tags":                     decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListLoadBalancersParams) Clone() *ListLoadBalancersParams {
+	return &ListLoadBalancersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListLoadBalancersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4997,6 +6773,11 @@ func (s *LoadBalancerService) NewListLoadBalancersParams() *ListLoadBalancersPar
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetLoadBalancerIDWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerIDWithContext is like GetLoadBalancerID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLoadBalancerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListLoadBalancersParams{}
 	p.p = make(map[string]interface{})
 
@@ -5008,7 +6789,7 @@ func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListLoadBalancers(p)
+	l, err := s.ListLoadBalancersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -5033,12 +6814,17 @@ func (s *LoadBalancerService) GetLoadBalancerID(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error) {
-	id, count, err := s.GetLoadBalancerID(name, opts...)
+	return s.GetLoadBalancerByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetLoadBalancerByNameWithContext is like GetLoadBalancerByName, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLoadBalancerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	id, count, err := s.GetLoadBalancerIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetLoadBalancerByID(id, opts...)
+	r, count, err := s.GetLoadBalancerByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -5047,6 +6833,11 @@ func (s *LoadBalancerService) GetLoadBalancerByName(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	return s.GetLoadBalancerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetLoadBalancerByIDWithContext is like GetLoadBalancerByID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetLoadBalancerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
 	p := &ListLoadBalancersParams{}
 	p.p = make(map[string]interface{})
 
@@ -5058,11 +6849,10 @@ func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListLoadBalancers(p)
+	l, err := s.ListLoadBalancersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -5080,19 +6870,56 @@ func (s *LoadBalancerService) GetLoadBalancerByID(id string, opts ...OptionFunc)
 
 // Lists internal load balancers
 func (s *LoadBalancerService) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listLoadBalancers", p.toURLValues())
+	return s.ListLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListLoadBalancersWithContext is like ListLoadBalancers, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLoadBalancersWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listLoadBalancers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListLoadBalancersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listLoadBalancers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListLoadBalancersAll returns all LoadBalancers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LoadBalancerService) ListLoadBalancersAll(p *ListLoadBalancersParams, opts ...PageOption) ([]*LoadBalancer, error) {
+	return s.ListLoadBalancersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListLoadBalancersAllWithContext is like ListLoadBalancersAll, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLoadBalancersAllWithContext(ctx context.Context, p *ListLoadBalancersParams, opts ...PageOption) ([]*LoadBalancer, error) {
+	return collectPages(s.ListLoadBalancersIterWithContext(ctx, p, opts...))
+}
+
+// ListLoadBalancersIter returns an iterator over all LoadBalancers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LoadBalancerService) ListLoadBalancersIter(p *ListLoadBalancersParams, opts ...PageOption) iter.Seq2[*LoadBalancer, error] {
+	return s.ListLoadBalancersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListLoadBalancersIterWithContext is like ListLoadBalancersIter, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListLoadBalancersIterWithContext(ctx context.Context, p *ListLoadBalancersParams, opts ...PageOption) iter.Seq2[*LoadBalancer, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*LoadBalancer, int, error) {
+		l, err := s.ListLoadBalancersWithContext(ctx, &ListLoadBalancersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.LoadBalancers, l.Count, nil
+	}, opts...)
+}
+
 type ListLoadBalancersResponse struct {
 	Count         int             `json:"count"`
 	LoadBalancers []*LoadBalancer `json:"loadbalancer"`
@@ -5105,10 +6932,10 @@ type LoadBalancer struct {
 	Domain                   string                             `json:"domain"`
 	Domainid                 string                             `json:"domainid"`
 	Domainpath               string                             `json:"domainpath"`
-	Fordisplay               bool                               `json:"fordisplay"`
+	Fordisplay               FlexBool                           `json:"fordisplay"`
 	Id                       string                             `json:"id"`
 	JobID                    string                             `json:"jobid"`
-	Jobstatus                int                                `json:"jobstatus"`
+	Jobstatus                FlexInt                            `json:"jobstatus"`
 	Loadbalancerinstance     []LoadBalancerLoadbalancerinstance `json:"loadbalancerinstance"`
 	Loadbalancerrule         []LoadBalancerLoadbalancerrule     `json:"loadbalancerrule"`
 	Name                     string                             `json:"name"`
@@ -5118,19 +6945,37 @@ type LoadBalancer struct {
 	Sourceipaddress          string                             `json:"sourceipaddress"`
 	Sourceipaddressnetworkid string                             `json:"sourceipaddressnetworkid"`
 	Tags                     []Tags                             `json:"tags"`
+	Extra                    map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *LoadBalancer) UnmarshalJSON(b []byte) error {
+	type alias LoadBalancer
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LoadBalancerLoadbalancerrule struct {
-	Instanceport int    `json:"instanceport"`
-	Sourceport   int    `json:"sourceport"`
-	State        string `json:"state"`
+	Instanceport FlexInt                    `json:"instanceport"`
+	Sourceport   FlexInt                    `json:"sourceport"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LoadBalancerLoadbalancerrule) UnmarshalJSON(b []byte) error {
+	type alias LoadBalancerLoadbalancerrule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LoadBalancerLoadbalancerinstance struct {
-	Id        string `json:"id"`
-	Ipaddress string `json:"ipaddress"`
-	Name      string `json:"name"`
-	State     string `json:"state"`
+	Id        string                     `json:"id"`
+	Ipaddress string                     `json:"ipaddress"`
+	Name      string                     `json:"name"`
+	State     string                     `json:"state"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LoadBalancerLoadbalancerinstance) UnmarshalJSON(b []byte) error {
+	type alias LoadBalancerLoadbalancerinstance
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListRegisteredServicePackagesParams struct {
@@ -5156,6 +7001,45 @@ func (p *ListRegisteredServicePackagesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listRegisteredServicePackages API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListRegisteredServicePackagesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listRegisteredServicePackages", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listRegisteredServicePackages API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListRegisteredServicePackagesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listRegisteredServicePackages", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listRegisteredServicePackages API, before the request is signed
+func (p *ListRegisteredServicePackagesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListRegisteredServicePackagesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListRegisteredServicePackagesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listRegisteredServicePackages", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListRegisteredServicePackagesParams) Clone() *ListRegisteredServicePackagesParams {
+	return &ListRegisteredServicePackagesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListRegisteredServicePackagesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5229,6 +7113,11 @@ func (s *LoadBalancerService) NewListRegisteredServicePackagesParams() *ListRegi
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *LoadBalancerService) GetRegisteredServicePackageID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetRegisteredServicePackageIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetRegisteredServicePackageIDWithContext is like GetRegisteredServicePackageID, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) GetRegisteredServicePackageIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRegisteredServicePackagesParams{}
 	p.p = make(map[string]interface{})
 
@@ -5240,7 +7129,7 @@ func (s *LoadBalancerService) GetRegisteredServicePackageID(keyword string, opts
 		}
 	}
 
-	l, err := s.ListRegisteredServicePackages(p)
+	l, err := s.ListRegisteredServicePackagesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -5265,30 +7154,73 @@ func (s *LoadBalancerService) GetRegisteredServicePackageID(keyword string, opts
 
 // Lists registered service packages
 func (s *LoadBalancerService) ListRegisteredServicePackages(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
-	resp, err := s.cs.newRequest("listRegisteredServicePackages", p.toURLValues())
+	return s.ListRegisteredServicePackagesWithContext(context.Background(), p)
+}
+
+// ListRegisteredServicePackagesWithContext is like ListRegisteredServicePackages, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListRegisteredServicePackagesWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listRegisteredServicePackages", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListRegisteredServicePackagesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listRegisteredServicePackages", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListRegisteredServicePackagesAll returns all RegisteredServicePackages matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *LoadBalancerService) ListRegisteredServicePackagesAll(p *ListRegisteredServicePackagesParams, opts ...PageOption) ([]*RegisteredServicePackage, error) {
+	return s.ListRegisteredServicePackagesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListRegisteredServicePackagesAllWithContext is like ListRegisteredServicePackagesAll, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListRegisteredServicePackagesAllWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams, opts ...PageOption) ([]*RegisteredServicePackage, error) {
+	return collectPages(s.ListRegisteredServicePackagesIterWithContext(ctx, p, opts...))
+}
+
+// ListRegisteredServicePackagesIter returns an iterator over all RegisteredServicePackages matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *LoadBalancerService) ListRegisteredServicePackagesIter(p *ListRegisteredServicePackagesParams, opts ...PageOption) iter.Seq2[*RegisteredServicePackage, error] {
+	return s.ListRegisteredServicePackagesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListRegisteredServicePackagesIterWithContext is like ListRegisteredServicePackagesIter, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListRegisteredServicePackagesIterWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams, opts ...PageOption) iter.Seq2[*RegisteredServicePackage, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*RegisteredServicePackage, int, error) {
+		l, err := s.ListRegisteredServicePackagesWithContext(ctx, &ListRegisteredServicePackagesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.RegisteredServicePackages, l.Count, nil
+	}, opts...)
+}
+
 type ListRegisteredServicePackagesResponse struct {
 	Count                     int                         `json:"count"`
 	RegisteredServicePackages []*RegisteredServicePackage `json:"registeredservicepackage"`
 }
 
 type RegisteredServicePackage struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RegisteredServicePackage) UnmarshalJSON(b []byte) error {
+	type alias RegisteredServicePackage
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSslCertsParams struct {
@@ -5315,6 +7247,46 @@ func (p *ListSslCertsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSslCerts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSslCertsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSslCerts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSslCerts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSslCertsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"accountid": 255, "certid": 255, "lbruleid": 255, "projectid": 255},
+	}.validate("listSslCerts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSslCerts API, before the request is signed
+func (p *ListSslCertsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSslCertsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSslCertsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSslCerts", b, &p.p, map[string]paramDecoder{
+		"accountid": decodeParam[string],
+		"certid":    decodeParam[string],
+		"lbruleid":  decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSslCertsParams) Clone() *ListSslCertsParams {
+	return &ListSslCertsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSslCertsParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5409,13 +7381,22 @@ func (s *LoadBalancerService) NewListSslCertsParams() *ListSslCertsParams {
 
 // Lists SSL certificates
 func (s *LoadBalancerService) ListSslCerts(p *ListSslCertsParams) (*ListSslCertsResponse, error) {
-	resp, err := s.cs.newRequest("listSslCerts", p.toURLValues())
+	return s.ListSslCertsWithContext(context.Background(), p)
+}
+
+// ListSslCertsWithContext is like ListSslCerts, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSslCerts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSslCertsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSslCerts", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5428,19 +7409,25 @@ type ListSslCertsResponse struct {
 }
 
 type SslCert struct {
-	Account              string   `json:"account"`
-	Certchain            string   `json:"certchain"`
-	Certificate          string   `json:"certificate"`
-	Domain               string   `json:"domain"`
-	Domainid             string   `json:"domainid"`
-	Fingerprint          string   `json:"fingerprint"`
-	Id                   string   `json:"id"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Loadbalancerrulelist []string `json:"loadbalancerrulelist"`
-	Name                 string   `json:"name"`
-	Project              string   `json:"project"`
-	Projectid            string   `json:"projectid"`
+	Account              string                     `json:"account"`
+	Certchain            string                     `json:"certchain"`
+	Certificate          string                     `json:"certificate"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Fingerprint          string                     `json:"fingerprint"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Loadbalancerrulelist []string                   `json:"loadbalancerrulelist"`
+	Name                 string                     `json:"name"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SslCert) UnmarshalJSON(b []byte) error {
+	type alias SslCert
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveCertFromLoadBalancerParams struct {
@@ -5458,6 +7445,44 @@ func (p *RemoveCertFromLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeCertFromLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveCertFromLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeCertFromLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeCertFromLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveCertFromLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"lbruleid"},
+		lengths:  map[string]int{"lbruleid": 255},
+	}.validate("removeCertFromLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeCertFromLoadBalancer API, before the request is signed
+func (p *RemoveCertFromLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveCertFromLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveCertFromLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeCertFromLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"lbruleid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveCertFromLoadBalancerParams) Clone() *RemoveCertFromLoadBalancerParams {
+	return &RemoveCertFromLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveCertFromLoadBalancerParams) SetLbruleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5490,27 +7515,39 @@ func (s *LoadBalancerService) NewRemoveCertFromLoadBalancerParams(lbruleid strin
 
 // Removes a certificate from a load balancer rule
 func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("removeCertFromLoadBalancer", p.toURLValues())
+	return s.RemoveCertFromLoadBalancerWithContext(context.Background(), p)
+}
+
+// RemoveCertFromLoadBalancerWithContext is like RemoveCertFromLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeCertFromLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveCertFromLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeCertFromLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeCertFromLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeCertFromLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5518,11 +7555,31 @@ func (s *LoadBalancerService) RemoveCertFromLoadBalancer(p *RemoveCertFromLoadBa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveCertFromLoadBalancer async job into r
+func (r *RemoveCertFromLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveCertFromLoadBalancerAsync starts the async job of RemoveCertFromLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) RemoveCertFromLoadBalancerAsync(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*Job, error) {
+	r, err := s.RemoveCertFromLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeCertFromLoadBalancer", r.JobID), nil
+}
+
 type RemoveCertFromLoadBalancerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveCertFromLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveCertFromLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveFromGlobalLoadBalancerRuleParams struct {
@@ -5544,6 +7601,45 @@ func (p *RemoveFromGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeFromGlobalLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveFromGlobalLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeFromGlobalLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeFromGlobalLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "loadbalancerrulelist"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeFromGlobalLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeFromGlobalLoadBalancerRule API, before the request is signed
+func (p *RemoveFromGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveFromGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveFromGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeFromGlobalLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"id":                   decodeParam[string],
+		"loadbalancerrulelist": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveFromGlobalLoadBalancerRuleParams) Clone() *RemoveFromGlobalLoadBalancerRuleParams {
+	return &RemoveFromGlobalLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveFromGlobalLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5598,27 +7694,39 @@ func (s *LoadBalancerService) NewRemoveFromGlobalLoadBalancerRuleParams(id strin
 
 // Removes a load balancer rule association with global load balancer rule
 func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("removeFromGlobalLoadBalancerRule", p.toURLValues())
+	return s.RemoveFromGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// RemoveFromGlobalLoadBalancerRuleWithContext is like RemoveFromGlobalLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeFromGlobalLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveFromGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeFromGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeFromGlobalLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeFromGlobalLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5626,11 +7734,31 @@ func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlob
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveFromGlobalLoadBalancerRule async job into r
+func (r *RemoveFromGlobalLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveFromGlobalLoadBalancerRuleAsync starts the async job of RemoveFromGlobalLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) RemoveFromGlobalLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.RemoveFromGlobalLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeFromGlobalLoadBalancerRule", r.JobID), nil
+}
+
 type RemoveFromGlobalLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveFromGlobalLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveFromGlobalLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveFromLoadBalancerRuleParams struct {
@@ -5659,6 +7787,46 @@ func (p *RemoveFromLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeFromLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveFromLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeFromLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeFromLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveFromLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeFromLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeFromLoadBalancerRule API, before the request is signed
+func (p *RemoveFromLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveFromLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveFromLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeFromLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[string],
+		"virtualmachineids": decodeParam[[]string],
+		"vmidipmap":         decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveFromLoadBalancerRuleParams) Clone() *RemoveFromLoadBalancerRuleParams {
+	return &RemoveFromLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveFromLoadBalancerRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5733,27 +7901,39 @@ func (s *LoadBalancerService) NewRemoveFromLoadBalancerRuleParams(id string) *Re
 
 // Removes an Instance or a list of  Instances from a load balancer rule.
 func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("removeFromLoadBalancerRule", p.toURLValues())
+	return s.RemoveFromLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// RemoveFromLoadBalancerRuleWithContext is like RemoveFromLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeFromLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveFromLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeFromLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeFromLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeFromLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5761,11 +7941,31 @@ func (s *LoadBalancerService) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalanc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveFromLoadBalancerRule async job into r
+func (r *RemoveFromLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveFromLoadBalancerRuleAsync starts the async job of RemoveFromLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) RemoveFromLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.RemoveFromLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeFromLoadBalancerRule", r.JobID), nil
+}
+
 type RemoveFromLoadBalancerRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveFromLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveFromLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopNetScalerVpxParams struct {
@@ -5787,6 +7987,45 @@ func (p *StopNetScalerVpxParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the stopNetScalerVpx API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StopNetScalerVpxParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("stopNetScalerVpx", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the stopNetScalerVpx API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StopNetScalerVpxParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("stopNetScalerVpx", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the stopNetScalerVpx API, before the request is signed
+func (p *StopNetScalerVpxParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StopNetScalerVpxParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StopNetScalerVpxParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("stopNetScalerVpx", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StopNetScalerVpxParams) Clone() *StopNetScalerVpxParams {
+	return &StopNetScalerVpxParams{p: cloneParams(p.p)}
+}
+
 func (p *StopNetScalerVpxParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5840,32 +8079,39 @@ func (s *LoadBalancerService) NewStopNetScalerVpxParams(id string) *StopNetScale
 
 // Stops a NetScalervm.
 func (s *LoadBalancerService) StopNetScalerVpx(p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error) {
-	resp, err := s.cs.newPostRequest("stopNetScalerVpx", p.toURLValues())
+	return s.StopNetScalerVpxWithContext(context.Background(), p)
+}
+
+// StopNetScalerVpxWithContext is like StopNetScalerVpx, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) StopNetScalerVpxWithContext(ctx context.Context, p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "stopNetScalerVpx", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StopNetScalerVpxResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("stopNetScalerVpx", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "stopNetScalerVpx", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("stopNetScalerVpx", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5873,10 +8119,29 @@ func (s *LoadBalancerService) StopNetScalerVpx(p *StopNetScalerVpxParams) (*Stop
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StopNetScalerVpx async job into r
+func (r *StopNetScalerVpxResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StopNetScalerVpxAsync starts the async job of StopNetScalerVpx without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) StopNetScalerVpxAsync(ctx context.Context, p *StopNetScalerVpxParams) (*Job, error) {
+	r, err := s.StopNetScalerVpxWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("stopNetScalerVpx", r.JobID), nil
+}
+
 type StopNetScalerVpxResponse struct {
 	Account             string                                       `json:"account"`
 	Arch                string                                       `json:"arch"`
-	Created             string                                       `json:"created"`
+	Created             Time                                         `json:"created"`
 	Dns1                string                                       `json:"dns1"`
 	Dns2                string                                       `json:"dns2"`
 	Domain              string                                       `json:"domain"`
@@ -5888,9 +8153,9 @@ type StopNetScalerVpxResponse struct {
 	Guestnetmask        string                                       `json:"guestnetmask"`
 	Guestnetworkid      string                                       `json:"guestnetworkid"`
 	Guestnetworkname    string                                       `json:"guestnetworkname"`
-	Hasannotations      bool                                         `json:"hasannotations"`
+	Hasannotations      FlexBool                                     `json:"hasannotations"`
 	Healthcheckresults  []StopNetScalerVpxResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                         `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                     `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                       `json:"hostcontrolstate"`
 	Hostid              string                                       `json:"hostid"`
 	Hostname            string                                       `json:"hostname"`
@@ -5898,9 +8163,9 @@ type StopNetScalerVpxResponse struct {
 	Id                  string                                       `json:"id"`
 	Ip6dns1             string                                       `json:"ip6dns1"`
 	Ip6dns2             string                                       `json:"ip6dns2"`
-	Isredundantrouter   bool                                         `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                     `json:"isredundantrouter"`
 	JobID               string                                       `json:"jobid"`
-	Jobstatus           int                                          `json:"jobstatus"`
+	Jobstatus           FlexInt                                      `json:"jobstatus"`
 	Linklocalip         string                                       `json:"linklocalip"`
 	Linklocalmacaddress string                                       `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                       `json:"linklocalnetmask"`
@@ -5917,7 +8182,7 @@ type StopNetScalerVpxResponse struct {
 	Publicnetmask       string                                       `json:"publicnetmask"`
 	Publicnetworkid     string                                       `json:"publicnetworkid"`
 	Redundantstate      string                                       `json:"redundantstate"`
-	Requiresupgrade     bool                                         `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                     `json:"requiresupgrade"`
 	Role                string                                       `json:"role"`
 	Scriptsversion      string                                       `json:"scriptsversion"`
 	Serviceofferingid   string                                       `json:"serviceofferingid"`
@@ -5931,15 +8196,27 @@ type StopNetScalerVpxResponse struct {
 	Vpcname             string                                       `json:"vpcname"`
 	Zoneid              string                                       `json:"zoneid"`
 	Zonename            string                                       `json:"zonename"`
+	Extra               map[string]json.RawMessage                   `json:"-"`
+}
+
+func (r *StopNetScalerVpxResponse) UnmarshalJSON(b []byte) error {
+	type alias StopNetScalerVpxResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopNetScalerVpxResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopNetScalerVpxResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias StopNetScalerVpxResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateGlobalLoadBalancerRuleParams struct {
@@ -5966,6 +8243,47 @@ func (p *UpdateGlobalLoadBalancerRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateGlobalLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateGlobalLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateGlobalLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateGlobalLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateGlobalLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "gslblbmethod": 255, "gslbstickysessionmethodname": 255, "id": 255},
+	}.validate("updateGlobalLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateGlobalLoadBalancerRule API, before the request is signed
+func (p *UpdateGlobalLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateGlobalLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateGlobalLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateGlobalLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"description":                 decodeParam[string],
+		"gslblbmethod":                decodeParam[string],
+		"gslbstickysessionmethodname": decodeParam[string],
+		"id":                          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateGlobalLoadBalancerRuleParams) Clone() *UpdateGlobalLoadBalancerRuleParams {
+	return &UpdateGlobalLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateGlobalLoadBalancerRuleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6061,32 +8379,39 @@ func (s *LoadBalancerService) NewUpdateGlobalLoadBalancerRuleParams(id string) *
 
 // Update global load balancer rules.
 func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateGlobalLoadBalancerRule", p.toURLValues())
+	return s.UpdateGlobalLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// UpdateGlobalLoadBalancerRuleWithContext is like UpdateGlobalLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateGlobalLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateGlobalLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateGlobalLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateGlobalLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateGlobalLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6094,6 +8419,25 @@ func (s *LoadBalancerService) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateGlobalLoadBalancerRule async job into r
+func (r *UpdateGlobalLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateGlobalLoadBalancerRuleAsync starts the async job of UpdateGlobalLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) UpdateGlobalLoadBalancerRuleAsync(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.UpdateGlobalLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateGlobalLoadBalancerRule", r.JobID), nil
+}
+
 type UpdateGlobalLoadBalancerRuleResponse struct {
 	Account                     string                                                 `json:"account"`
 	Description                 string                                                 `json:"description"`
@@ -6106,37 +8450,49 @@ type UpdateGlobalLoadBalancerRuleResponse struct {
 	Gslbstickysessionmethodname string                                                 `json:"gslbstickysessionmethodname"`
 	Id                          string                                                 `json:"id"`
 	JobID                       string                                                 `json:"jobid"`
-	Jobstatus                   int                                                    `json:"jobstatus"`
+	Jobstatus                   FlexInt                                                `json:"jobstatus"`
 	Loadbalancerrule            []UpdateGlobalLoadBalancerRuleResponseLoadbalancerrule `json:"loadbalancerrule"`
 	Name                        string                                                 `json:"name"`
 	Project                     string                                                 `json:"project"`
 	Projectid                   string                                                 `json:"projectid"`
-	Regionid                    int                                                    `json:"regionid"`
+	Regionid                    FlexInt                                                `json:"regionid"`
+	Extra                       map[string]json.RawMessage                             `json:"-"`
+}
+
+func (r *UpdateGlobalLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateGlobalLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateGlobalLoadBalancerRuleResponseLoadbalancerrule struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Domainpath  string `json:"domainpath"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+	Account     string                     `json:"account"`
+	Algorithm   string                     `json:"algorithm"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Description string                     `json:"description"`
+	Domain      string                     `json:"domain"`
+	Domainid    string                     `json:"domainid"`
+	Domainpath  string                     `json:"domainpath"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	Name        string                     `json:"name"`
+	Networkid   string                     `json:"networkid"`
+	Privateport string                     `json:"privateport"`
+	Project     string                     `json:"project"`
+	Projectid   string                     `json:"projectid"`
+	Protocol    string                     `json:"protocol"`
+	Publicip    string                     `json:"publicip"`
+	Publicipid  string                     `json:"publicipid"`
+	Publicport  string                     `json:"publicport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Zoneid      string                     `json:"zoneid"`
+	Zonename    string                     `json:"zonename"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateGlobalLoadBalancerRuleResponseLoadbalancerrule) UnmarshalJSON(b []byte) error {
+	type alias UpdateGlobalLoadBalancerRuleResponseLoadbalancerrule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLBHealthCheckPolicyParams struct {
@@ -6161,6 +8517,46 @@ func (p *UpdateLBHealthCheckPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateLBHealthCheckPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateLBHealthCheckPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateLBHealthCheckPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateLBHealthCheckPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateLBHealthCheckPolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateLBHealthCheckPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateLBHealthCheckPolicy API, before the request is signed
+func (p *UpdateLBHealthCheckPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateLBHealthCheckPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateLBHealthCheckPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateLBHealthCheckPolicy", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateLBHealthCheckPolicyParams) Clone() *UpdateLBHealthCheckPolicyParams {
+	return &UpdateLBHealthCheckPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateLBHealthCheckPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6235,32 +8631,39 @@ func (s *LoadBalancerService) NewUpdateLBHealthCheckPolicyParams(id string) *Upd
 
 // Updates load balancer health check policy
 func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("updateLBHealthCheckPolicy", p.toURLValues())
+	return s.UpdateLBHealthCheckPolicyWithContext(context.Background(), p)
+}
+
+// UpdateLBHealthCheckPolicyWithContext is like UpdateLBHealthCheckPolicy, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateLBHealthCheckPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateLBHealthCheckPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateLBHealthCheckPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateLBHealthCheckPolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateLBHealthCheckPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6268,27 +8671,58 @@ func (s *LoadBalancerService) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateLBHealthCheckPolicy async job into r
+func (r *UpdateLBHealthCheckPolicyResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateLBHealthCheckPolicyAsync starts the async job of UpdateLBHealthCheckPolicy without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) UpdateLBHealthCheckPolicyAsync(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*Job, error) {
+	r, err := s.UpdateLBHealthCheckPolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateLBHealthCheckPolicy", r.JobID), nil
+}
+
 type UpdateLBHealthCheckPolicyResponse struct {
 	Account           string                                               `json:"account"`
 	Domain            string                                               `json:"domain"`
 	Domainid          string                                               `json:"domainid"`
 	Healthcheckpolicy []UpdateLBHealthCheckPolicyResponseHealthcheckpolicy `json:"healthcheckpolicy"`
 	JobID             string                                               `json:"jobid"`
-	Jobstatus         int                                                  `json:"jobstatus"`
+	Jobstatus         FlexInt                                              `json:"jobstatus"`
 	Lbruleid          string                                               `json:"lbruleid"`
 	Zoneid            string                                               `json:"zoneid"`
+	Extra             map[string]json.RawMessage                           `json:"-"`
+}
+
+func (r *UpdateLBHealthCheckPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateLBHealthCheckPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLBHealthCheckPolicyResponseHealthcheckpolicy struct {
-	Description             string `json:"description"`
-	Fordisplay              bool   `json:"fordisplay"`
-	Healthcheckinterval     int    `json:"healthcheckinterval"`
-	Healthcheckthresshold   int    `json:"healthcheckthresshold"`
-	Id                      string `json:"id"`
-	Pingpath                string `json:"pingpath"`
-	Responsetime            int    `json:"responsetime"`
-	State                   string `json:"state"`
-	Unhealthcheckthresshold int    `json:"unhealthcheckthresshold"`
+	Description             string                     `json:"description"`
+	Fordisplay              FlexBool                   `json:"fordisplay"`
+	Healthcheckinterval     FlexInt                    `json:"healthcheckinterval"`
+	Healthcheckthresshold   FlexInt                    `json:"healthcheckthresshold"`
+	Id                      string                     `json:"id"`
+	Pingpath                string                     `json:"pingpath"`
+	Responsetime            FlexInt                    `json:"responsetime"`
+	State                   string                     `json:"state"`
+	Unhealthcheckthresshold FlexInt                    `json:"unhealthcheckthresshold"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateLBHealthCheckPolicyResponseHealthcheckpolicy) UnmarshalJSON(b []byte) error {
+	type alias UpdateLBHealthCheckPolicyResponseHealthcheckpolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLBStickinessPolicyParams struct {
@@ -6313,6 +8747,46 @@ func (p *UpdateLBStickinessPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateLBStickinessPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateLBStickinessPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateLBStickinessPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateLBStickinessPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateLBStickinessPolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateLBStickinessPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateLBStickinessPolicy API, before the request is signed
+func (p *UpdateLBStickinessPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateLBStickinessPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateLBStickinessPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateLBStickinessPolicy", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateLBStickinessPolicyParams) Clone() *UpdateLBStickinessPolicyParams {
+	return &UpdateLBStickinessPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateLBStickinessPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6387,32 +8861,39 @@ func (s *LoadBalancerService) NewUpdateLBStickinessPolicyParams(id string) *Upda
 
 // Updates load balancer stickiness policy
 func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("updateLBStickinessPolicy", p.toURLValues())
+	return s.UpdateLBStickinessPolicyWithContext(context.Background(), p)
+}
+
+// UpdateLBStickinessPolicyWithContext is like UpdateLBStickinessPolicy, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateLBStickinessPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateLBStickinessPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateLBStickinessPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateLBStickinessPolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateLBStickinessPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6420,28 +8901,59 @@ func (s *LoadBalancerService) UpdateLBStickinessPolicy(p *UpdateLBStickinessPoli
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateLBStickinessPolicy async job into r
+func (r *UpdateLBStickinessPolicyResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateLBStickinessPolicyAsync starts the async job of UpdateLBStickinessPolicy without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) UpdateLBStickinessPolicyAsync(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*Job, error) {
+	r, err := s.UpdateLBStickinessPolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateLBStickinessPolicy", r.JobID), nil
+}
+
 type UpdateLBStickinessPolicyResponse struct {
 	Account          string                                             `json:"account"`
 	Description      string                                             `json:"description"`
 	Domain           string                                             `json:"domain"`
 	Domainid         string                                             `json:"domainid"`
 	JobID            string                                             `json:"jobid"`
-	Jobstatus        int                                                `json:"jobstatus"`
+	Jobstatus        FlexInt                                            `json:"jobstatus"`
 	Lbruleid         string                                             `json:"lbruleid"`
 	Name             string                                             `json:"name"`
 	State            string                                             `json:"state"`
 	Stickinesspolicy []UpdateLBStickinessPolicyResponseStickinesspolicy `json:"stickinesspolicy"`
 	Zoneid           string                                             `json:"zoneid"`
+	Extra            map[string]json.RawMessage                         `json:"-"`
+}
+
+func (r *UpdateLBStickinessPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateLBStickinessPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLBStickinessPolicyResponseStickinesspolicy struct {
-	Description string            `json:"description"`
-	Fordisplay  bool              `json:"fordisplay"`
-	Id          string            `json:"id"`
-	Methodname  string            `json:"methodname"`
-	Name        string            `json:"name"`
-	Params      map[string]string `json:"params"`
-	State       string            `json:"state"`
+	Description string                     `json:"description"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	Methodname  string                     `json:"methodname"`
+	Name        string                     `json:"name"`
+	Params      map[string]string          `json:"params"`
+	State       string                     `json:"state"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateLBStickinessPolicyResponseStickinesspolicy) UnmarshalJSON(b []byte) error {
+	type alias UpdateLBStickinessPolicyResponseStickinesspolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLoadBalancerParams struct {
@@ -6466,6 +8978,46 @@ func (p *UpdateLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateLoadBalancer API, before the request is signed
+func (p *UpdateLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateLoadBalancerParams) Clone() *UpdateLoadBalancerParams {
+	return &UpdateLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateLoadBalancerParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6540,32 +9092,39 @@ func (s *LoadBalancerService) NewUpdateLoadBalancerParams(id string) *UpdateLoad
 
 // Updates an internal load balancer
 func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("updateLoadBalancer", p.toURLValues())
+	return s.UpdateLoadBalancerWithContext(context.Background(), p)
+}
+
+// UpdateLoadBalancerWithContext is like UpdateLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) UpdateLoadBalancerWithContext(ctx context.Context, p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6573,6 +9132,25 @@ func (s *LoadBalancerService) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateLoadBalancer async job into r
+func (r *UpdateLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateLoadBalancerAsync starts the async job of UpdateLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) UpdateLoadBalancerAsync(ctx context.Context, p *UpdateLoadBalancerParams) (*Job, error) {
+	r, err := s.UpdateLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateLoadBalancer", r.JobID), nil
+}
+
 type UpdateLoadBalancerResponse struct {
 	Account                  string                                           `json:"account"`
 	Algorithm                string                                           `json:"algorithm"`
@@ -6580,10 +9158,10 @@ type UpdateLoadBalancerResponse struct {
 	Domain                   string                                           `json:"domain"`
 	Domainid                 string                                           `json:"domainid"`
 	Domainpath               string                                           `json:"domainpath"`
-	Fordisplay               bool                                             `json:"fordisplay"`
+	Fordisplay               FlexBool                                         `json:"fordisplay"`
 	Id                       string                                           `json:"id"`
 	JobID                    string                                           `json:"jobid"`
-	Jobstatus                int                                              `json:"jobstatus"`
+	Jobstatus                FlexInt                                          `json:"jobstatus"`
 	Loadbalancerinstance     []UpdateLoadBalancerResponseLoadbalancerinstance `json:"loadbalancerinstance"`
 	Loadbalancerrule         []UpdateLoadBalancerResponseLoadbalancerrule     `json:"loadbalancerrule"`
 	Name                     string                                           `json:"name"`
@@ -6593,19 +9171,37 @@ type UpdateLoadBalancerResponse struct {
 	Sourceipaddress          string                                           `json:"sourceipaddress"`
 	Sourceipaddressnetworkid string                                           `json:"sourceipaddressnetworkid"`
 	Tags                     []Tags                                           `json:"tags"`
+	Extra                    map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *UpdateLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLoadBalancerResponseLoadbalancerrule struct {
-	Instanceport int    `json:"instanceport"`
-	Sourceport   int    `json:"sourceport"`
-	State        string `json:"state"`
+	Instanceport FlexInt                    `json:"instanceport"`
+	Sourceport   FlexInt                    `json:"sourceport"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateLoadBalancerResponseLoadbalancerrule) UnmarshalJSON(b []byte) error {
+	type alias UpdateLoadBalancerResponseLoadbalancerrule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLoadBalancerResponseLoadbalancerinstance struct {
-	Id        string `json:"id"`
-	Ipaddress string `json:"ipaddress"`
-	Name      string `json:"name"`
-	State     string `json:"state"`
+	Id        string                     `json:"id"`
+	Ipaddress string                     `json:"ipaddress"`
+	Name      string                     `json:"name"`
+	State     string                     `json:"state"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateLoadBalancerResponseLoadbalancerinstance) UnmarshalJSON(b []byte) error {
+	type alias UpdateLoadBalancerResponseLoadbalancerinstance
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateLoadBalancerRuleParams struct {
@@ -6641,11 +9237,56 @@ func (p *UpdateLoadBalancerRuleParams) toURLValues() url.Values {
 		u.Set("name", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateLoadBalancerRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateLoadBalancerRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateLoadBalancerRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateLoadBalancerRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateLoadBalancerRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"algorithm": 255, "customid": 255, "description": 4096, "id": 255, "name": 255, "protocol": 255},
+	}.validate("updateLoadBalancerRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateLoadBalancerRule API, before the request is signed
+func (p *UpdateLoadBalancerRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateLoadBalancerRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateLoadBalancerRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateLoadBalancerRule", b, &p.p, map[string]paramDecoder{
+		"algorithm":   decodeParam[string],
+		"cidrlist":    decodeParam[[]string],
+		"customid":    decodeParam[string],
+		"description": decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"name":        decodeParam[string],
+		"protocol":    decodeParam[Protocol],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateLoadBalancerRuleParams) Clone() *UpdateLoadBalancerRuleParams {
+	return &UpdateLoadBalancerRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateLoadBalancerRuleParams) SetAlgorithm(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6793,7 +9434,7 @@ func (p *UpdateLoadBalancerRuleParams) GetName() (string, bool) {
 	return value, ok
 }
 
-func (p *UpdateLoadBalancerRuleParams) SetProtocol(v string) {
+func (p *UpdateLoadBalancerRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -6806,11 +9447,11 @@ func (p *UpdateLoadBalancerRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *UpdateLoadBalancerRuleParams) GetProtocol() (string, bool) {
+func (p *UpdateLoadBalancerRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -6825,32 +9466,39 @@ func (s *LoadBalancerService) NewUpdateLoadBalancerRuleParams(id string) *Update
 
 // Updates load balancer
 func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateLoadBalancerRule", p.toURLValues())
+	return s.UpdateLoadBalancerRuleWithContext(context.Background(), p)
+}
+
+// UpdateLoadBalancerRuleWithContext is like UpdateLoadBalancerRule, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateLoadBalancerRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateLoadBalancerRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateLoadBalancerRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateLoadBalancerRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateLoadBalancerRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6858,31 +9506,56 @@ func (s *LoadBalancerService) UpdateLoadBalancerRule(p *UpdateLoadBalancerRulePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateLoadBalancerRule async job into r
+func (r *UpdateLoadBalancerRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateLoadBalancerRuleAsync starts the async job of UpdateLoadBalancerRule without waiting for it to finish, and returns a handle to the job
+func (s *LoadBalancerService) UpdateLoadBalancerRuleAsync(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*Job, error) {
+	r, err := s.UpdateLoadBalancerRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateLoadBalancerRule", r.JobID), nil
+}
+
 type UpdateLoadBalancerRuleResponse struct {
-	Account     string `json:"account"`
-	Algorithm   string `json:"algorithm"`
-	Cidrlist    string `json:"cidrlist"`
-	Description string `json:"description"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Domainpath  string `json:"domainpath"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Networkid   string `json:"networkid"`
-	Privateport string `json:"privateport"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
-	Protocol    string `json:"protocol"`
-	Publicip    string `json:"publicip"`
-	Publicipid  string `json:"publicipid"`
-	Publicport  string `json:"publicport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Zoneid      string `json:"zoneid"`
-	Zonename    string `json:"zonename"`
+	Account     string                     `json:"account"`
+	Algorithm   string                     `json:"algorithm"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Description string                     `json:"description"`
+	Domain      string                     `json:"domain"`
+	Domainid    string                     `json:"domainid"`
+	Domainpath  string                     `json:"domainpath"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Networkid   string                     `json:"networkid"`
+	Privateport string                     `json:"privateport"`
+	Project     string                     `json:"project"`
+	Projectid   string                     `json:"projectid"`
+	Protocol    string                     `json:"protocol"`
+	Publicip    string                     `json:"publicip"`
+	Publicipid  string                     `json:"publicipid"`
+	Publicport  string                     `json:"publicport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Zoneid      string                     `json:"zoneid"`
+	Zonename    string                     `json:"zonename"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateLoadBalancerRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateLoadBalancerRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UploadSslCertParams struct {
@@ -6925,6 +9598,52 @@ func (p *UploadSslCertParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the uploadSslCert API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UploadSslCertParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("uploadSslCert", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the uploadSslCert API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UploadSslCertParams) Validate() error {
+	return paramRules{
+		required: []string{"certificate", "name", "privatekey"},
+		lengths:  map[string]int{"account": 255, "certchain": 2097152, "certificate": 65535, "domainid": 255, "name": 255, "password": 255, "privatekey": 65535, "projectid": 255},
+	}.validate("uploadSslCert", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the uploadSslCert API, before the request is signed
+func (p *UploadSslCertParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UploadSslCertParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UploadSslCertParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("uploadSslCert", b, &p.p, map[string]paramDecoder{
+		"account":                decodeParam[string],
+		"certchain":              decodeParam[string],
+		"certificate":            decodeParam[string],
+		"domainid":               decodeParam[string],
+		"enabledrevocationcheck": decodeParam[bool],
+		"name":                   decodeParam[string],
+		"password":               decodeParam[string],
+		"privatekey":             decodeParam[string],
+		"projectid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UploadSslCertParams) Clone() *UploadSslCertParams {
+	return &UploadSslCertParams{p: cloneParams(p.p)}
+}
+
 func (p *UploadSslCertParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7127,13 +9846,22 @@ func (s *LoadBalancerService) NewUploadSslCertParams(certificate string, name st
 
 // Upload a certificate to CloudStack
 func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
-	resp, err := s.cs.newPostRequest("uploadSslCert", p.toURLValues())
+	return s.UploadSslCertWithContext(context.Background(), p)
+}
+
+// UploadSslCertWithContext is like UploadSslCert, but honours the cancellation and deadline of ctx
+func (s *LoadBalancerService) UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "uploadSslCert", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UploadSslCertResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("uploadSslCert", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -7141,17 +9869,23 @@ func (s *LoadBalancerService) UploadSslCert(p *UploadSslCertParams) (*UploadSslC
 }
 
 type UploadSslCertResponse struct {
-	Account              string   `json:"account"`
-	Certchain            string   `json:"certchain"`
-	Certificate          string   `json:"certificate"`
-	Domain               string   `json:"domain"`
-	Domainid             string   `json:"domainid"`
-	Fingerprint          string   `json:"fingerprint"`
-	Id                   string   `json:"id"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Loadbalancerrulelist []string `json:"loadbalancerrulelist"`
-	Name                 string   `json:"name"`
-	Project              string   `json:"project"`
-	Projectid            string   `json:"projectid"`
+	Account              string                     `json:"account"`
+	Certchain            string                     `json:"certchain"`
+	Certificate          string                     `json:"certificate"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Fingerprint          string                     `json:"fingerprint"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Loadbalancerrulelist []string                   `json:"loadbalancerrulelist"`
+	Name                 string                     `json:"name"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UploadSslCertResponse) UnmarshalJSON(b []byte) error {
+	type alias UploadSslCertResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignCertToLoadBalancer(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCertToLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignCertToLoadBalancer), p)
 }
 
+// AssignCertToLoadBalancerAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) AssignCertToLoadBalancerAsync(ctx context.Context, p *AssignCertToLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCertToLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignCertToLoadBalancerAsync indicates an expected call of AssignCertToLoadBalancerAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignCertToLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCertToLoadBalancerAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignCertToLoadBalancerAsync), ctx, p)
+}
+
+// AssignCertToLoadBalancerWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) AssignCertToLoadBalancerWithContext(ctx context.Context, p *AssignCertToLoadBalancerParams) (*AssignCertToLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignCertToLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssignCertToLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignCertToLoadBalancerWithContext indicates an expected call of AssignCertToLoadBalancerWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignCertToLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignCertToLoadBalancerWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignCertToLoadBalancerWithContext), ctx, p)
+}
+
 // AssignToGlobalLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) AssignToGlobalLoadBalancerRule(p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToGlobalLoadBalancerRu
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToGlobalLoadBalancerRule), p)
 }
 
+// AssignToGlobalLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) AssignToGlobalLoadBalancerRuleAsync(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignToGlobalLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignToGlobalLoadBalancerRuleAsync indicates an expected call of AssignToGlobalLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToGlobalLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToGlobalLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToGlobalLoadBalancerRuleAsync), ctx, p)
+}
+
+// AssignToGlobalLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) AssignToGlobalLoadBalancerRuleWithContext(ctx context.Context, p *AssignToGlobalLoadBalancerRuleParams) (*AssignToGlobalLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignToGlobalLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssignToGlobalLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignToGlobalLoadBalancerRuleWithContext indicates an expected call of AssignToGlobalLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToGlobalLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToGlobalLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToGlobalLoadBalancerRuleWithContext), ctx, p)
+}
+
 // AssignToLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) AssignToLoadBalancerRule(p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToLoadBalancerRule(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToLoadBalancerRule), p)
 }
 
+// AssignToLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) AssignToLoadBalancerRuleAsync(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignToLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignToLoadBalancerRuleAsync indicates an expected call of AssignToLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToLoadBalancerRuleAsync), ctx, p)
+}
+
+// AssignToLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) AssignToLoadBalancerRuleWithContext(ctx context.Context, p *AssignToLoadBalancerRuleParams) (*AssignToLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignToLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssignToLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignToLoadBalancerRuleWithContext indicates an expected call of AssignToLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) AssignToLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignToLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).AssignToLoadBalancerRuleWithContext), ctx, p)
+}
+
 // CreateGlobalLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) CreateGlobalLoadBalancerRule(p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateGlobalLoadBalancerRule
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateGlobalLoadBalancerRule), p)
 }
 
+// CreateGlobalLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateGlobalLoadBalancerRuleAsync(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGlobalLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGlobalLoadBalancerRuleAsync indicates an expected call of CreateGlobalLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateGlobalLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateGlobalLoadBalancerRuleAsync), ctx, p)
+}
+
+// CreateGlobalLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *CreateGlobalLoadBalancerRuleParams) (*CreateGlobalLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGlobalLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateGlobalLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGlobalLoadBalancerRuleWithContext indicates an expected call of CreateGlobalLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateGlobalLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGlobalLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateGlobalLoadBalancerRuleWithContext), ctx, p)
+}
+
 // CreateLBHealthCheckPolicy mocks base method.
 func (m *MockLoadBalancerServiceIface) CreateLBHealthCheckPolicy(p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBHealthCheckPolicy(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBHealthCheckPolicy), p)
 }
 
+// CreateLBHealthCheckPolicyAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLBHealthCheckPolicyAsync(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLBHealthCheckPolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLBHealthCheckPolicyAsync indicates an expected call of CreateLBHealthCheckPolicyAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBHealthCheckPolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBHealthCheckPolicyAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBHealthCheckPolicyAsync), ctx, p)
+}
+
+// CreateLBHealthCheckPolicyWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLBHealthCheckPolicyWithContext(ctx context.Context, p *CreateLBHealthCheckPolicyParams) (*CreateLBHealthCheckPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLBHealthCheckPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateLBHealthCheckPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLBHealthCheckPolicyWithContext indicates an expected call of CreateLBHealthCheckPolicyWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBHealthCheckPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBHealthCheckPolicyWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBHealthCheckPolicyWithContext), ctx, p)
+}
+
 // CreateLBStickinessPolicy mocks base method.
 func (m *MockLoadBalancerServiceIface) CreateLBStickinessPolicy(p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +300,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBStickinessPolicy(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBStickinessPolicy), p)
 }
 
+// CreateLBStickinessPolicyAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLBStickinessPolicyAsync(ctx context.Context, p *CreateLBStickinessPolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLBStickinessPolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLBStickinessPolicyAsync indicates an expected call of CreateLBStickinessPolicyAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBStickinessPolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBStickinessPolicyAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBStickinessPolicyAsync), ctx, p)
+}
+
+// CreateLBStickinessPolicyWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLBStickinessPolicyWithContext(ctx context.Context, p *CreateLBStickinessPolicyParams) (*CreateLBStickinessPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLBStickinessPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateLBStickinessPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLBStickinessPolicyWithContext indicates an expected call of CreateLBStickinessPolicyWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLBStickinessPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLBStickinessPolicyWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLBStickinessPolicyWithContext), ctx, p)
+}
+
 // CreateLoadBalancer mocks base method.
 func (m *MockLoadBalancerServiceIface) CreateLoadBalancer(p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +345,21 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancer(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancer), p)
 }
 
+// CreateLoadBalancerAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLoadBalancerAsync(ctx context.Context, p *CreateLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoadBalancerAsync indicates an expected call of CreateLoadBalancerAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancerAsync), ctx, p)
+}
+
 // CreateLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) CreateLoadBalancerRule(p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +375,51 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancerRule(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancerRule), p)
 }
 
+// CreateLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLoadBalancerRuleAsync(ctx context.Context, p *CreateLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoadBalancerRuleAsync indicates an expected call of CreateLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancerRuleAsync), ctx, p)
+}
+
+// CreateLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLoadBalancerRuleWithContext(ctx context.Context, p *CreateLoadBalancerRuleParams) (*CreateLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoadBalancerRuleWithContext indicates an expected call of CreateLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancerRuleWithContext), ctx, p)
+}
+
+// CreateLoadBalancerWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) CreateLoadBalancerWithContext(ctx context.Context, p *CreateLoadBalancerParams) (*CreateLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateLoadBalancerWithContext indicates an expected call of CreateLoadBalancerWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) CreateLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateLoadBalancerWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).CreateLoadBalancerWithContext), ctx, p)
+}
+
 // DeleteGlobalLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) DeleteGlobalLoadBalancerRule(p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +435,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteGlobalLoadBalancerRule
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteGlobalLoadBalancerRule), p)
 }
 
+// DeleteGlobalLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteGlobalLoadBalancerRuleAsync(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGlobalLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGlobalLoadBalancerRuleAsync indicates an expected call of DeleteGlobalLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteGlobalLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteGlobalLoadBalancerRuleAsync), ctx, p)
+}
+
+// DeleteGlobalLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteGlobalLoadBalancerRuleWithContext(ctx context.Context, p *DeleteGlobalLoadBalancerRuleParams) (*DeleteGlobalLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGlobalLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteGlobalLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGlobalLoadBalancerRuleWithContext indicates an expected call of DeleteGlobalLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteGlobalLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGlobalLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteGlobalLoadBalancerRuleWithContext), ctx, p)
+}
+
 // DeleteLBHealthCheckPolicy mocks base method.
 func (m *MockLoadBalancerServiceIface) DeleteLBHealthCheckPolicy(p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +480,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBHealthCheckPolicy(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBHealthCheckPolicy), p)
 }
 
+// DeleteLBHealthCheckPolicyAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLBHealthCheckPolicyAsync(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLBHealthCheckPolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLBHealthCheckPolicyAsync indicates an expected call of DeleteLBHealthCheckPolicyAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBHealthCheckPolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBHealthCheckPolicyAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBHealthCheckPolicyAsync), ctx, p)
+}
+
+// DeleteLBHealthCheckPolicyWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLBHealthCheckPolicyWithContext(ctx context.Context, p *DeleteLBHealthCheckPolicyParams) (*DeleteLBHealthCheckPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLBHealthCheckPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteLBHealthCheckPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLBHealthCheckPolicyWithContext indicates an expected call of DeleteLBHealthCheckPolicyWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBHealthCheckPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBHealthCheckPolicyWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBHealthCheckPolicyWithContext), ctx, p)
+}
+
 // DeleteLBStickinessPolicy mocks base method.
 func (m *MockLoadBalancerServiceIface) DeleteLBStickinessPolicy(p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +525,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBStickinessPolicy(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBStickinessPolicy), p)
 }
 
+// DeleteLBStickinessPolicyAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLBStickinessPolicyAsync(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLBStickinessPolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLBStickinessPolicyAsync indicates an expected call of DeleteLBStickinessPolicyAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBStickinessPolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBStickinessPolicyAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBStickinessPolicyAsync), ctx, p)
+}
+
+// DeleteLBStickinessPolicyWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLBStickinessPolicyWithContext(ctx context.Context, p *DeleteLBStickinessPolicyParams) (*DeleteLBStickinessPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLBStickinessPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteLBStickinessPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLBStickinessPolicyWithContext indicates an expected call of DeleteLBStickinessPolicyWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLBStickinessPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLBStickinessPolicyWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLBStickinessPolicyWithContext), ctx, p)
+}
+
 // DeleteLoadBalancer mocks base method.
 func (m *MockLoadBalancerServiceIface) DeleteLoadBalancer(p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +570,21 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancer(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancer), p)
 }
 
+// DeleteLoadBalancerAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLoadBalancerAsync(ctx context.Context, p *DeleteLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancerAsync indicates an expected call of DeleteLoadBalancerAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancerAsync), ctx, p)
+}
+
 // DeleteLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) DeleteLoadBalancerRule(p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +600,51 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancerRule(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancerRule), p)
 }
 
+// DeleteLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLoadBalancerRuleAsync(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancerRuleAsync indicates an expected call of DeleteLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancerRuleAsync), ctx, p)
+}
+
+// DeleteLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLoadBalancerRuleWithContext(ctx context.Context, p *DeleteLoadBalancerRuleParams) (*DeleteLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancerRuleWithContext indicates an expected call of DeleteLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancerRuleWithContext), ctx, p)
+}
+
+// DeleteLoadBalancerWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteLoadBalancerWithContext(ctx context.Context, p *DeleteLoadBalancerParams) (*DeleteLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteLoadBalancerWithContext indicates an expected call of DeleteLoadBalancerWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteLoadBalancerWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteLoadBalancerWithContext), ctx, p)
+}
+
 // DeleteServicePackageOffering mocks base method.
 func (m *MockLoadBalancerServiceIface) DeleteServicePackageOffering(p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +660,21 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteServicePackageOffering
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServicePackageOffering", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteServicePackageOffering), p)
 }
 
+// DeleteServicePackageOfferingWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteServicePackageOfferingWithContext(ctx context.Context, p *DeleteServicePackageOfferingParams) (*DeleteServicePackageOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServicePackageOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteServicePackageOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServicePackageOfferingWithContext indicates an expected call of DeleteServicePackageOfferingWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteServicePackageOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServicePackageOfferingWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteServicePackageOfferingWithContext), ctx, p)
+}
+
 // DeleteSslCert mocks base method.
 func (m *MockLoadBalancerServiceIface) DeleteSslCert(p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +690,21 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteSslCert(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSslCert", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteSslCert), p)
 }
 
+// DeleteSslCertWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeleteSslCertWithContext(ctx context.Context, p *DeleteSslCertParams) (*DeleteSslCertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSslCertWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteSslCertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSslCertWithContext indicates an expected call of DeleteSslCertWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeleteSslCertWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSslCertWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeleteSslCertWithContext), ctx, p)
+}
+
 // DeployNetscalerVpx mocks base method.
 func (m *MockLoadBalancerServiceIface) DeployNetscalerVpx(p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error) {
 	m.ctrl.T.Helper()
@@ -298,6 +720,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeployNetscalerVpx(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployNetscalerVpx", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeployNetscalerVpx), p)
 }
 
+// DeployNetscalerVpxAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) DeployNetscalerVpxAsync(ctx context.Context, p *DeployNetscalerVpxParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployNetscalerVpxAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployNetscalerVpxAsync indicates an expected call of DeployNetscalerVpxAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeployNetscalerVpxAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployNetscalerVpxAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeployNetscalerVpxAsync), ctx, p)
+}
+
+// DeployNetscalerVpxWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) DeployNetscalerVpxWithContext(ctx context.Context, p *DeployNetscalerVpxParams) (*DeployNetscalerVpxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployNetscalerVpxWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeployNetscalerVpxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployNetscalerVpxWithContext indicates an expected call of DeployNetscalerVpxWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) DeployNetscalerVpxWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployNetscalerVpxWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).DeployNetscalerVpxWithContext), ctx, p)
+}
+
 // GetGlobalLoadBalancerRuleByID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetGlobalLoadBalancerRuleByID(id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
 	m.ctrl.T.Helper()
@@ -319,6 +771,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetGlobalLoadBalancerRuleByI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalLoadBalancerRuleByID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetGlobalLoadBalancerRuleByID), varargs...)
 }
 
+// GetGlobalLoadBalancerRuleByIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetGlobalLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGlobalLoadBalancerRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*GlobalLoadBalancerRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGlobalLoadBalancerRuleByIDWithContext indicates an expected call of GetGlobalLoadBalancerRuleByIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetGlobalLoadBalancerRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalLoadBalancerRuleByIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetGlobalLoadBalancerRuleByIDWithContext), varargs...)
+}
+
 // GetGlobalLoadBalancerRuleByName mocks base method.
 func (m *MockLoadBalancerServiceIface) GetGlobalLoadBalancerRuleByName(name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
 	m.ctrl.T.Helper()
@@ -340,6 +813,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetGlobalLoadBalancerRuleByN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalLoadBalancerRuleByName", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetGlobalLoadBalancerRuleByName), varargs...)
 }
 
+// GetGlobalLoadBalancerRuleByNameWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetGlobalLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*GlobalLoadBalancerRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGlobalLoadBalancerRuleByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*GlobalLoadBalancerRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGlobalLoadBalancerRuleByNameWithContext indicates an expected call of GetGlobalLoadBalancerRuleByNameWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetGlobalLoadBalancerRuleByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalLoadBalancerRuleByNameWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetGlobalLoadBalancerRuleByNameWithContext), varargs...)
+}
+
 // GetGlobalLoadBalancerRuleID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetGlobalLoadBalancerRuleID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +855,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetGlobalLoadBalancerRuleID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalLoadBalancerRuleID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetGlobalLoadBalancerRuleID), varargs...)
 }
 
+// GetGlobalLoadBalancerRuleIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetGlobalLoadBalancerRuleIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGlobalLoadBalancerRuleIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGlobalLoadBalancerRuleIDWithContext indicates an expected call of GetGlobalLoadBalancerRuleIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetGlobalLoadBalancerRuleIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGlobalLoadBalancerRuleIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetGlobalLoadBalancerRuleIDWithContext), varargs...)
+}
+
 // GetLBHealthCheckPolicyByID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLBHealthCheckPolicyByID(id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
 	m.ctrl.T.Helper()
@@ -382,6 +897,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLBHealthCheckPolicyByID(i
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLBHealthCheckPolicyByID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLBHealthCheckPolicyByID), varargs...)
 }
 
+// GetLBHealthCheckPolicyByIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLBHealthCheckPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBHealthCheckPolicy, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLBHealthCheckPolicyByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*LBHealthCheckPolicy)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLBHealthCheckPolicyByIDWithContext indicates an expected call of GetLBHealthCheckPolicyByIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLBHealthCheckPolicyByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLBHealthCheckPolicyByIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLBHealthCheckPolicyByIDWithContext), varargs...)
+}
+
 // GetLBStickinessPolicyByID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLBStickinessPolicyByID(id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
 	m.ctrl.T.Helper()
@@ -403,6 +939,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLBStickinessPolicyByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLBStickinessPolicyByID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLBStickinessPolicyByID), varargs...)
 }
 
+// GetLBStickinessPolicyByIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLBStickinessPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LBStickinessPolicy, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLBStickinessPolicyByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*LBStickinessPolicy)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLBStickinessPolicyByIDWithContext indicates an expected call of GetLBStickinessPolicyByIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLBStickinessPolicyByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLBStickinessPolicyByIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLBStickinessPolicyByIDWithContext), varargs...)
+}
+
 // GetLoadBalancerByID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLoadBalancerByID(id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
 	m.ctrl.T.Helper()
@@ -424,6 +981,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerByID(id any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerByID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerByID), varargs...)
 }
 
+// GetLoadBalancerByIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLoadBalancerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoadBalancerByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*LoadBalancer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerByIDWithContext indicates an expected call of GetLoadBalancerByIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerByIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerByIDWithContext), varargs...)
+}
+
 // GetLoadBalancerByName mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLoadBalancerByName(name string, opts ...OptionFunc) (*LoadBalancer, int, error) {
 	m.ctrl.T.Helper()
@@ -445,6 +1023,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerByName(name a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerByName", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerByName), varargs...)
 }
 
+// GetLoadBalancerByNameWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLoadBalancerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancer, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoadBalancerByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*LoadBalancer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerByNameWithContext indicates an expected call of GetLoadBalancerByNameWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerByNameWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerByNameWithContext), varargs...)
+}
+
 // GetLoadBalancerID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLoadBalancerID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -466,6 +1065,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerID(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerID), varargs...)
 }
 
+// GetLoadBalancerIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLoadBalancerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoadBalancerIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerIDWithContext indicates an expected call of GetLoadBalancerIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerIDWithContext), varargs...)
+}
+
 // GetLoadBalancerRuleByID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleByID(id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
 	m.ctrl.T.Helper()
@@ -487,6 +1107,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleByID(id a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleByID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleByID), varargs...)
 }
 
+// GetLoadBalancerRuleByIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoadBalancerRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*LoadBalancerRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerRuleByIDWithContext indicates an expected call of GetLoadBalancerRuleByIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleByIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleByIDWithContext), varargs...)
+}
+
 // GetLoadBalancerRuleByName mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleByName(name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
 	m.ctrl.T.Helper()
@@ -508,6 +1149,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleByName(na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleByName", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleByName), varargs...)
 }
 
+// GetLoadBalancerRuleByNameWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*LoadBalancerRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoadBalancerRuleByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*LoadBalancerRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerRuleByNameWithContext indicates an expected call of GetLoadBalancerRuleByNameWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleByNameWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleByNameWithContext), varargs...)
+}
+
 // GetLoadBalancerRuleID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -529,6 +1191,27 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleID(name a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleID), varargs...)
 }
 
+// GetLoadBalancerRuleIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoadBalancerRuleIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerRuleIDWithContext indicates an expected call of GetLoadBalancerRuleIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleIDWithContext), varargs...)
+}
+
 // GetLoadBalancerRuleInstanceByID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleInstanceByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	m.ctrl.T.Helper()
@@ -550,130 +1233,745 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleInstanceB
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleInstanceByID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleInstanceByID), varargs...)
 }
 
+// GetLoadBalancerRuleInstanceByIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetLoadBalancerRuleInstanceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetLoadBalancerRuleInstanceByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualMachine)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetLoadBalancerRuleInstanceByIDWithContext indicates an expected call of GetLoadBalancerRuleInstanceByIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetLoadBalancerRuleInstanceByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLoadBalancerRuleInstanceByIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetLoadBalancerRuleInstanceByIDWithContext), varargs...)
+}
+
 // GetRegisteredServicePackageID mocks base method.
 func (m *MockLoadBalancerServiceIface) GetRegisteredServicePackageID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{keyword}
+	varargs := []any{keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRegisteredServicePackageID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRegisteredServicePackageID indicates an expected call of GetRegisteredServicePackageID.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetRegisteredServicePackageID(keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegisteredServicePackageID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetRegisteredServicePackageID), varargs...)
+}
+
+// GetRegisteredServicePackageIDWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) GetRegisteredServicePackageIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRegisteredServicePackageIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRegisteredServicePackageIDWithContext indicates an expected call of GetRegisteredServicePackageIDWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetRegisteredServicePackageIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegisteredServicePackageIDWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetRegisteredServicePackageIDWithContext), varargs...)
+}
+
+// ListGlobalLoadBalancerRules mocks base method.
+func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRules", p)
+	ret0, _ := ret[0].(*ListGlobalLoadBalancerRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGlobalLoadBalancerRules indicates an expected call of ListGlobalLoadBalancerRules.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRules(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRules), p)
+}
+
+// ListGlobalLoadBalancerRulesAll mocks base method.
+func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRulesAll(p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) ([]*GlobalLoadBalancerRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRulesAll", varargs...)
+	ret0, _ := ret[0].([]*GlobalLoadBalancerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGlobalLoadBalancerRulesAll indicates an expected call of ListGlobalLoadBalancerRulesAll.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRulesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRulesAll", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRulesAll), varargs...)
+}
+
+// ListGlobalLoadBalancerRulesAllWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRulesAllWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) ([]*GlobalLoadBalancerRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*GlobalLoadBalancerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGlobalLoadBalancerRulesAllWithContext indicates an expected call of ListGlobalLoadBalancerRulesAllWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRulesAllWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRulesAllWithContext), varargs...)
+}
+
+// ListGlobalLoadBalancerRulesIter mocks base method.
+func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRulesIter(p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*GlobalLoadBalancerRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GlobalLoadBalancerRule, error])
+	return ret0
+}
+
+// ListGlobalLoadBalancerRulesIter indicates an expected call of ListGlobalLoadBalancerRulesIter.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRulesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRulesIter", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRulesIter), varargs...)
+}
+
+// ListGlobalLoadBalancerRulesIterWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRulesIterWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*GlobalLoadBalancerRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GlobalLoadBalancerRule, error])
+	return ret0
+}
+
+// ListGlobalLoadBalancerRulesIterWithContext indicates an expected call of ListGlobalLoadBalancerRulesIterWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRulesIterWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRulesIterWithContext), varargs...)
+}
+
+// ListGlobalLoadBalancerRulesWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRulesWithContext(ctx context.Context, p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRulesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListGlobalLoadBalancerRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGlobalLoadBalancerRulesWithContext indicates an expected call of ListGlobalLoadBalancerRulesWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRulesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRulesWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRulesWithContext), ctx, p)
+}
+
+// ListLBHealthCheckPolicies mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLBHealthCheckPolicies", p)
+	ret0, _ := ret[0].(*ListLBHealthCheckPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBHealthCheckPolicies indicates an expected call of ListLBHealthCheckPolicies.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPolicies(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPolicies), p)
+}
+
+// ListLBHealthCheckPoliciesAll mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPoliciesAll(p *ListLBHealthCheckPoliciesParams, opts ...PageOption) ([]*LBHealthCheckPolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBHealthCheckPoliciesAll", varargs...)
+	ret0, _ := ret[0].([]*LBHealthCheckPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBHealthCheckPoliciesAll indicates an expected call of ListLBHealthCheckPoliciesAll.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPoliciesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPoliciesAll", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPoliciesAll), varargs...)
+}
+
+// ListLBHealthCheckPoliciesAllWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPoliciesAllWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams, opts ...PageOption) ([]*LBHealthCheckPolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBHealthCheckPoliciesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*LBHealthCheckPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBHealthCheckPoliciesAllWithContext indicates an expected call of ListLBHealthCheckPoliciesAllWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPoliciesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPoliciesAllWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPoliciesAllWithContext), varargs...)
+}
+
+// ListLBHealthCheckPoliciesIter mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPoliciesIter(p *ListLBHealthCheckPoliciesParams, opts ...PageOption) iter.Seq2[*LBHealthCheckPolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBHealthCheckPoliciesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LBHealthCheckPolicy, error])
+	return ret0
+}
+
+// ListLBHealthCheckPoliciesIter indicates an expected call of ListLBHealthCheckPoliciesIter.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPoliciesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPoliciesIter", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPoliciesIter), varargs...)
+}
+
+// ListLBHealthCheckPoliciesIterWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPoliciesIterWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams, opts ...PageOption) iter.Seq2[*LBHealthCheckPolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBHealthCheckPoliciesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LBHealthCheckPolicy, error])
+	return ret0
+}
+
+// ListLBHealthCheckPoliciesIterWithContext indicates an expected call of ListLBHealthCheckPoliciesIterWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPoliciesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPoliciesIterWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPoliciesIterWithContext), varargs...)
+}
+
+// ListLBHealthCheckPoliciesWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPoliciesWithContext(ctx context.Context, p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLBHealthCheckPoliciesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListLBHealthCheckPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBHealthCheckPoliciesWithContext indicates an expected call of ListLBHealthCheckPoliciesWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPoliciesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPoliciesWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPoliciesWithContext), ctx, p)
+}
+
+// ListLBStickinessPolicies mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLBStickinessPolicies", p)
+	ret0, _ := ret[0].(*ListLBStickinessPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBStickinessPolicies indicates an expected call of ListLBStickinessPolicies.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPolicies(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPolicies), p)
+}
+
+// ListLBStickinessPoliciesAll mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBStickinessPoliciesAll(p *ListLBStickinessPoliciesParams, opts ...PageOption) ([]*LBStickinessPolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBStickinessPoliciesAll", varargs...)
+	ret0, _ := ret[0].([]*LBStickinessPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBStickinessPoliciesAll indicates an expected call of ListLBStickinessPoliciesAll.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPoliciesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPoliciesAll", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPoliciesAll), varargs...)
+}
+
+// ListLBStickinessPoliciesAllWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBStickinessPoliciesAllWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams, opts ...PageOption) ([]*LBStickinessPolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBStickinessPoliciesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*LBStickinessPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBStickinessPoliciesAllWithContext indicates an expected call of ListLBStickinessPoliciesAllWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPoliciesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPoliciesAllWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPoliciesAllWithContext), varargs...)
+}
+
+// ListLBStickinessPoliciesIter mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBStickinessPoliciesIter(p *ListLBStickinessPoliciesParams, opts ...PageOption) iter.Seq2[*LBStickinessPolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBStickinessPoliciesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LBStickinessPolicy, error])
+	return ret0
+}
+
+// ListLBStickinessPoliciesIter indicates an expected call of ListLBStickinessPoliciesIter.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPoliciesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPoliciesIter", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPoliciesIter), varargs...)
+}
+
+// ListLBStickinessPoliciesIterWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBStickinessPoliciesIterWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams, opts ...PageOption) iter.Seq2[*LBStickinessPolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLBStickinessPoliciesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LBStickinessPolicy, error])
+	return ret0
+}
+
+// ListLBStickinessPoliciesIterWithContext indicates an expected call of ListLBStickinessPoliciesIterWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPoliciesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPoliciesIterWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPoliciesIterWithContext), varargs...)
+}
+
+// ListLBStickinessPoliciesWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLBStickinessPoliciesWithContext(ctx context.Context, p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLBStickinessPoliciesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListLBStickinessPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLBStickinessPoliciesWithContext indicates an expected call of ListLBStickinessPoliciesWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPoliciesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPoliciesWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPoliciesWithContext), ctx, p)
+}
+
+// ListLoadBalancerRuleInstances mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancerRuleInstances", p)
+	ret0, _ := ret[0].(*ListLoadBalancerRuleInstancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancerRuleInstances indicates an expected call of ListLoadBalancerRuleInstances.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRuleInstances(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRuleInstances", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRuleInstances), p)
+}
+
+// ListLoadBalancerRuleInstancesWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRuleInstancesWithContext(ctx context.Context, p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancerRuleInstancesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListLoadBalancerRuleInstancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancerRuleInstancesWithContext indicates an expected call of ListLoadBalancerRuleInstancesWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRuleInstancesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRuleInstancesWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRuleInstancesWithContext), ctx, p)
+}
+
+// ListLoadBalancerRules mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancerRules", p)
+	ret0, _ := ret[0].(*ListLoadBalancerRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancerRules indicates an expected call of ListLoadBalancerRules.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRules(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRules), p)
+}
+
+// ListLoadBalancerRulesAll mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRulesAll(p *ListLoadBalancerRulesParams, opts ...PageOption) ([]*LoadBalancerRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancerRulesAll", varargs...)
+	ret0, _ := ret[0].([]*LoadBalancerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancerRulesAll indicates an expected call of ListLoadBalancerRulesAll.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRulesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRulesAll", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRulesAll), varargs...)
+}
+
+// ListLoadBalancerRulesAllWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRulesAllWithContext(ctx context.Context, p *ListLoadBalancerRulesParams, opts ...PageOption) ([]*LoadBalancerRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancerRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*LoadBalancerRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancerRulesAllWithContext indicates an expected call of ListLoadBalancerRulesAllWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRulesAllWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRulesAllWithContext), varargs...)
+}
+
+// ListLoadBalancerRulesIter mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRulesIter(p *ListLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*LoadBalancerRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancerRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LoadBalancerRule, error])
+	return ret0
+}
+
+// ListLoadBalancerRulesIter indicates an expected call of ListLoadBalancerRulesIter.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRulesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRulesIter", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRulesIter), varargs...)
+}
+
+// ListLoadBalancerRulesIterWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRulesIterWithContext(ctx context.Context, p *ListLoadBalancerRulesParams, opts ...PageOption) iter.Seq2[*LoadBalancerRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancerRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LoadBalancerRule, error])
+	return ret0
+}
+
+// ListLoadBalancerRulesIterWithContext indicates an expected call of ListLoadBalancerRulesIterWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRulesIterWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRulesIterWithContext), varargs...)
+}
+
+// ListLoadBalancerRulesWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancerRulesWithContext(ctx context.Context, p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancerRulesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListLoadBalancerRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancerRulesWithContext indicates an expected call of ListLoadBalancerRulesWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRulesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRulesWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRulesWithContext), ctx, p)
+}
+
+// ListLoadBalancers mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListLoadBalancers", p)
+	ret0, _ := ret[0].(*ListLoadBalancersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancers indicates an expected call of ListLoadBalancers.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancers(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancers", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancers), p)
+}
+
+// ListLoadBalancersAll mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancersAll(p *ListLoadBalancersParams, opts ...PageOption) ([]*LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancersAll", varargs...)
+	ret0, _ := ret[0].([]*LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancersAll indicates an expected call of ListLoadBalancersAll.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancersAll", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancersAll), varargs...)
+}
+
+// ListLoadBalancersAllWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancersAllWithContext(ctx context.Context, p *ListLoadBalancersParams, opts ...PageOption) ([]*LoadBalancer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*LoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListLoadBalancersAllWithContext indicates an expected call of ListLoadBalancersAllWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancersAllWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancersAllWithContext), varargs...)
+}
+
+// ListLoadBalancersIter mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancersIter(p *ListLoadBalancersParams, opts ...PageOption) iter.Seq2[*LoadBalancer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListLoadBalancersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LoadBalancer, error])
+	return ret0
+}
+
+// ListLoadBalancersIter indicates an expected call of ListLoadBalancersIter.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancersIter", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancersIter), varargs...)
+}
+
+// ListLoadBalancersIterWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancersIterWithContext(ctx context.Context, p *ListLoadBalancersParams, opts ...PageOption) iter.Seq2[*LoadBalancer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetRegisteredServicePackageID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListLoadBalancersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*LoadBalancer, error])
+	return ret0
 }
 
-// GetRegisteredServicePackageID indicates an expected call of GetRegisteredServicePackageID.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) GetRegisteredServicePackageID(keyword any, opts ...any) *gomock.Call {
+// ListLoadBalancersIterWithContext indicates an expected call of ListLoadBalancersIterWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{keyword}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegisteredServicePackageID", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).GetRegisteredServicePackageID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancersIterWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancersIterWithContext), varargs...)
 }
 
-// ListGlobalLoadBalancerRules mocks base method.
-func (m *MockLoadBalancerServiceIface) ListGlobalLoadBalancerRules(p *ListGlobalLoadBalancerRulesParams) (*ListGlobalLoadBalancerRulesResponse, error) {
+// ListLoadBalancersWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListLoadBalancersWithContext(ctx context.Context, p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListGlobalLoadBalancerRules", p)
-	ret0, _ := ret[0].(*ListGlobalLoadBalancerRulesResponse)
+	ret := m.ctrl.Call(m, "ListLoadBalancersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListLoadBalancersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListGlobalLoadBalancerRules indicates an expected call of ListGlobalLoadBalancerRules.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListGlobalLoadBalancerRules(p any) *gomock.Call {
+// ListLoadBalancersWithContext indicates an expected call of ListLoadBalancersWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancersWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGlobalLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListGlobalLoadBalancerRules), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancersWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancersWithContext), ctx, p)
 }
 
-// ListLBHealthCheckPolicies mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLBHealthCheckPolicies(p *ListLBHealthCheckPoliciesParams) (*ListLBHealthCheckPoliciesResponse, error) {
+// ListRegisteredServicePackages mocks base method.
+func (m *MockLoadBalancerServiceIface) ListRegisteredServicePackages(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLBHealthCheckPolicies", p)
-	ret0, _ := ret[0].(*ListLBHealthCheckPoliciesResponse)
+	ret := m.ctrl.Call(m, "ListRegisteredServicePackages", p)
+	ret0, _ := ret[0].(*ListRegisteredServicePackagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListLBHealthCheckPolicies indicates an expected call of ListLBHealthCheckPolicies.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBHealthCheckPolicies(p any) *gomock.Call {
+// ListRegisteredServicePackages indicates an expected call of ListRegisteredServicePackages.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListRegisteredServicePackages(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBHealthCheckPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBHealthCheckPolicies), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredServicePackages", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListRegisteredServicePackages), p)
 }
 
-// ListLBStickinessPolicies mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLBStickinessPolicies(p *ListLBStickinessPoliciesParams) (*ListLBStickinessPoliciesResponse, error) {
+// ListRegisteredServicePackagesAll mocks base method.
+func (m *MockLoadBalancerServiceIface) ListRegisteredServicePackagesAll(p *ListRegisteredServicePackagesParams, opts ...PageOption) ([]*RegisteredServicePackage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLBStickinessPolicies", p)
-	ret0, _ := ret[0].(*ListLBStickinessPoliciesResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegisteredServicePackagesAll", varargs...)
+	ret0, _ := ret[0].([]*RegisteredServicePackage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListLBStickinessPolicies indicates an expected call of ListLBStickinessPolicies.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLBStickinessPolicies(p any) *gomock.Call {
+// ListRegisteredServicePackagesAll indicates an expected call of ListRegisteredServicePackagesAll.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListRegisteredServicePackagesAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLBStickinessPolicies", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLBStickinessPolicies), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredServicePackagesAll", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListRegisteredServicePackagesAll), varargs...)
 }
 
-// ListLoadBalancerRuleInstances mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLoadBalancerRuleInstances(p *ListLoadBalancerRuleInstancesParams) (*ListLoadBalancerRuleInstancesResponse, error) {
+// ListRegisteredServicePackagesAllWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListRegisteredServicePackagesAllWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams, opts ...PageOption) ([]*RegisteredServicePackage, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLoadBalancerRuleInstances", p)
-	ret0, _ := ret[0].(*ListLoadBalancerRuleInstancesResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegisteredServicePackagesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*RegisteredServicePackage)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListLoadBalancerRuleInstances indicates an expected call of ListLoadBalancerRuleInstances.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRuleInstances(p any) *gomock.Call {
+// ListRegisteredServicePackagesAllWithContext indicates an expected call of ListRegisteredServicePackagesAllWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListRegisteredServicePackagesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRuleInstances", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRuleInstances), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredServicePackagesAllWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListRegisteredServicePackagesAllWithContext), varargs...)
 }
 
-// ListLoadBalancerRules mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLoadBalancerRules(p *ListLoadBalancerRulesParams) (*ListLoadBalancerRulesResponse, error) {
+// ListRegisteredServicePackagesIter mocks base method.
+func (m *MockLoadBalancerServiceIface) ListRegisteredServicePackagesIter(p *ListRegisteredServicePackagesParams, opts ...PageOption) iter.Seq2[*RegisteredServicePackage, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLoadBalancerRules", p)
-	ret0, _ := ret[0].(*ListLoadBalancerRulesResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegisteredServicePackagesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*RegisteredServicePackage, error])
+	return ret0
 }
 
-// ListLoadBalancerRules indicates an expected call of ListLoadBalancerRules.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancerRules(p any) *gomock.Call {
+// ListRegisteredServicePackagesIter indicates an expected call of ListRegisteredServicePackagesIter.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListRegisteredServicePackagesIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancerRules", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancerRules), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredServicePackagesIter", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListRegisteredServicePackagesIter), varargs...)
 }
 
-// ListLoadBalancers mocks base method.
-func (m *MockLoadBalancerServiceIface) ListLoadBalancers(p *ListLoadBalancersParams) (*ListLoadBalancersResponse, error) {
+// ListRegisteredServicePackagesIterWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListRegisteredServicePackagesIterWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams, opts ...PageOption) iter.Seq2[*RegisteredServicePackage, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListLoadBalancers", p)
-	ret0, _ := ret[0].(*ListLoadBalancersResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegisteredServicePackagesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*RegisteredServicePackage, error])
+	return ret0
 }
 
-// ListLoadBalancers indicates an expected call of ListLoadBalancers.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListLoadBalancers(p any) *gomock.Call {
+// ListRegisteredServicePackagesIterWithContext indicates an expected call of ListRegisteredServicePackagesIterWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListRegisteredServicePackagesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListLoadBalancers", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListLoadBalancers), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredServicePackagesIterWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListRegisteredServicePackagesIterWithContext), varargs...)
 }
 
-// ListRegisteredServicePackages mocks base method.
-func (m *MockLoadBalancerServiceIface) ListRegisteredServicePackages(p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
+// ListRegisteredServicePackagesWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListRegisteredServicePackagesWithContext(ctx context.Context, p *ListRegisteredServicePackagesParams) (*ListRegisteredServicePackagesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRegisteredServicePackages", p)
+	ret := m.ctrl.Call(m, "ListRegisteredServicePackagesWithContext", ctx, p)
 	ret0, _ := ret[0].(*ListRegisteredServicePackagesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRegisteredServicePackages indicates an expected call of ListRegisteredServicePackages.
-func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListRegisteredServicePackages(p any) *gomock.Call {
+// ListRegisteredServicePackagesWithContext indicates an expected call of ListRegisteredServicePackagesWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListRegisteredServicePackagesWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredServicePackages", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListRegisteredServicePackages), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegisteredServicePackagesWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListRegisteredServicePackagesWithContext), ctx, p)
 }
 
 // ListSslCerts mocks base method.
@@ -691,6 +1989,21 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListSslCerts(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSslCerts", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListSslCerts), p)
 }
 
+// ListSslCertsWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) ListSslCertsWithContext(ctx context.Context, p *ListSslCertsParams) (*ListSslCertsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSslCertsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSslCertsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSslCertsWithContext indicates an expected call of ListSslCertsWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) ListSslCertsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSslCertsWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).ListSslCertsWithContext), ctx, p)
+}
+
 // NewAssignCertToLoadBalancerParams mocks base method.
 func (m *MockLoadBalancerServiceIface) NewAssignCertToLoadBalancerParams(certid, lbruleid string) *AssignCertToLoadBalancerParams {
 	m.ctrl.T.Helper()
@@ -1182,6 +2495,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveCertFromLoadBalancer(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCertFromLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveCertFromLoadBalancer), p)
 }
 
+// RemoveCertFromLoadBalancerAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) RemoveCertFromLoadBalancerAsync(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCertFromLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCertFromLoadBalancerAsync indicates an expected call of RemoveCertFromLoadBalancerAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveCertFromLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCertFromLoadBalancerAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveCertFromLoadBalancerAsync), ctx, p)
+}
+
+// RemoveCertFromLoadBalancerWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) RemoveCertFromLoadBalancerWithContext(ctx context.Context, p *RemoveCertFromLoadBalancerParams) (*RemoveCertFromLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveCertFromLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveCertFromLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveCertFromLoadBalancerWithContext indicates an expected call of RemoveCertFromLoadBalancerWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveCertFromLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveCertFromLoadBalancerWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveCertFromLoadBalancerWithContext), ctx, p)
+}
+
 // RemoveFromGlobalLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) RemoveFromGlobalLoadBalancerRule(p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -1197,6 +2540,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromGlobalLoadBalancer
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromGlobalLoadBalancerRule), p)
 }
 
+// RemoveFromGlobalLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) RemoveFromGlobalLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromGlobalLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromGlobalLoadBalancerRuleAsync indicates an expected call of RemoveFromGlobalLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromGlobalLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromGlobalLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromGlobalLoadBalancerRuleAsync), ctx, p)
+}
+
+// RemoveFromGlobalLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) RemoveFromGlobalLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromGlobalLoadBalancerRuleParams) (*RemoveFromGlobalLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromGlobalLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveFromGlobalLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromGlobalLoadBalancerRuleWithContext indicates an expected call of RemoveFromGlobalLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromGlobalLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromGlobalLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromGlobalLoadBalancerRuleWithContext), ctx, p)
+}
+
 // RemoveFromLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) RemoveFromLoadBalancerRule(p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -1212,6 +2585,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromLoadBalancerRule(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromLoadBalancerRule), p)
 }
 
+// RemoveFromLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) RemoveFromLoadBalancerRuleAsync(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromLoadBalancerRuleAsync indicates an expected call of RemoveFromLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromLoadBalancerRuleAsync), ctx, p)
+}
+
+// RemoveFromLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) RemoveFromLoadBalancerRuleWithContext(ctx context.Context, p *RemoveFromLoadBalancerRuleParams) (*RemoveFromLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveFromLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveFromLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveFromLoadBalancerRuleWithContext indicates an expected call of RemoveFromLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) RemoveFromLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveFromLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).RemoveFromLoadBalancerRuleWithContext), ctx, p)
+}
+
 // StopNetScalerVpx mocks base method.
 func (m *MockLoadBalancerServiceIface) StopNetScalerVpx(p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error) {
 	m.ctrl.T.Helper()
@@ -1227,6 +2630,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) StopNetScalerVpx(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopNetScalerVpx", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).StopNetScalerVpx), p)
 }
 
+// StopNetScalerVpxAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) StopNetScalerVpxAsync(ctx context.Context, p *StopNetScalerVpxParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopNetScalerVpxAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopNetScalerVpxAsync indicates an expected call of StopNetScalerVpxAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) StopNetScalerVpxAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopNetScalerVpxAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).StopNetScalerVpxAsync), ctx, p)
+}
+
+// StopNetScalerVpxWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) StopNetScalerVpxWithContext(ctx context.Context, p *StopNetScalerVpxParams) (*StopNetScalerVpxResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopNetScalerVpxWithContext", ctx, p)
+	ret0, _ := ret[0].(*StopNetScalerVpxResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopNetScalerVpxWithContext indicates an expected call of StopNetScalerVpxWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) StopNetScalerVpxWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopNetScalerVpxWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).StopNetScalerVpxWithContext), ctx, p)
+}
+
 // UpdateGlobalLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) UpdateGlobalLoadBalancerRule(p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -1242,6 +2675,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateGlobalLoadBalancerRule
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateGlobalLoadBalancerRule), p)
 }
 
+// UpdateGlobalLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateGlobalLoadBalancerRuleAsync(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGlobalLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGlobalLoadBalancerRuleAsync indicates an expected call of UpdateGlobalLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateGlobalLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateGlobalLoadBalancerRuleAsync), ctx, p)
+}
+
+// UpdateGlobalLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateGlobalLoadBalancerRuleWithContext(ctx context.Context, p *UpdateGlobalLoadBalancerRuleParams) (*UpdateGlobalLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateGlobalLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateGlobalLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateGlobalLoadBalancerRuleWithContext indicates an expected call of UpdateGlobalLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateGlobalLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateGlobalLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateGlobalLoadBalancerRuleWithContext), ctx, p)
+}
+
 // UpdateLBHealthCheckPolicy mocks base method.
 func (m *MockLoadBalancerServiceIface) UpdateLBHealthCheckPolicy(p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1257,6 +2720,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBHealthCheckPolicy(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBHealthCheckPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBHealthCheckPolicy), p)
 }
 
+// UpdateLBHealthCheckPolicyAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLBHealthCheckPolicyAsync(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLBHealthCheckPolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLBHealthCheckPolicyAsync indicates an expected call of UpdateLBHealthCheckPolicyAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBHealthCheckPolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBHealthCheckPolicyAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBHealthCheckPolicyAsync), ctx, p)
+}
+
+// UpdateLBHealthCheckPolicyWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLBHealthCheckPolicyWithContext(ctx context.Context, p *UpdateLBHealthCheckPolicyParams) (*UpdateLBHealthCheckPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLBHealthCheckPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateLBHealthCheckPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLBHealthCheckPolicyWithContext indicates an expected call of UpdateLBHealthCheckPolicyWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBHealthCheckPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBHealthCheckPolicyWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBHealthCheckPolicyWithContext), ctx, p)
+}
+
 // UpdateLBStickinessPolicy mocks base method.
 func (m *MockLoadBalancerServiceIface) UpdateLBStickinessPolicy(p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -1272,6 +2765,36 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBStickinessPolicy(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBStickinessPolicy", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBStickinessPolicy), p)
 }
 
+// UpdateLBStickinessPolicyAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLBStickinessPolicyAsync(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLBStickinessPolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLBStickinessPolicyAsync indicates an expected call of UpdateLBStickinessPolicyAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBStickinessPolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBStickinessPolicyAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBStickinessPolicyAsync), ctx, p)
+}
+
+// UpdateLBStickinessPolicyWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLBStickinessPolicyWithContext(ctx context.Context, p *UpdateLBStickinessPolicyParams) (*UpdateLBStickinessPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLBStickinessPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateLBStickinessPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLBStickinessPolicyWithContext indicates an expected call of UpdateLBStickinessPolicyWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLBStickinessPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLBStickinessPolicyWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLBStickinessPolicyWithContext), ctx, p)
+}
+
 // UpdateLoadBalancer mocks base method.
 func (m *MockLoadBalancerServiceIface) UpdateLoadBalancer(p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
@@ -1287,6 +2810,21 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancer(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancer", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancer), p)
 }
 
+// UpdateLoadBalancerAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLoadBalancerAsync(ctx context.Context, p *UpdateLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLoadBalancerAsync indicates an expected call of UpdateLoadBalancerAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancerAsync), ctx, p)
+}
+
 // UpdateLoadBalancerRule mocks base method.
 func (m *MockLoadBalancerServiceIface) UpdateLoadBalancerRule(p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -1302,6 +2840,51 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancerRule(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerRule", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancerRule), p)
 }
 
+// UpdateLoadBalancerRuleAsync mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLoadBalancerRuleAsync(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLoadBalancerRuleAsync indicates an expected call of UpdateLoadBalancerRuleAsync.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancerRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerRuleAsync", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancerRuleAsync), ctx, p)
+}
+
+// UpdateLoadBalancerRuleWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLoadBalancerRuleWithContext(ctx context.Context, p *UpdateLoadBalancerRuleParams) (*UpdateLoadBalancerRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateLoadBalancerRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLoadBalancerRuleWithContext indicates an expected call of UpdateLoadBalancerRuleWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancerRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerRuleWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancerRuleWithContext), ctx, p)
+}
+
+// UpdateLoadBalancerWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) UpdateLoadBalancerWithContext(ctx context.Context, p *UpdateLoadBalancerParams) (*UpdateLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateLoadBalancerWithContext indicates an expected call of UpdateLoadBalancerWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UpdateLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateLoadBalancerWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UpdateLoadBalancerWithContext), ctx, p)
+}
+
 // UploadSslCert mocks base method.
 func (m *MockLoadBalancerServiceIface) UploadSslCert(p *UploadSslCertParams) (*UploadSslCertResponse, error) {
 	m.ctrl.T.Helper()
@@ -1316,3 +2899,18 @@ func (mr *MockLoadBalancerServiceIfaceMockRecorder) UploadSslCert(p any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSslCert", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UploadSslCert), p)
 }
+
+// UploadSslCertWithContext mocks base method.
+func (m *MockLoadBalancerServiceIface) UploadSslCertWithContext(ctx context.Context, p *UploadSslCertParams) (*UploadSslCertResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadSslCertWithContext", ctx, p)
+	ret0, _ := ret[0].(*UploadSslCertResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadSslCertWithContext indicates an expected call of UploadSslCertWithContext.
+func (mr *MockLoadBalancerServiceIfaceMockRecorder) UploadSslCertWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadSslCertWithContext", reflect.TypeOf((*MockLoadBalancerServiceIface)(nil).UploadSslCertWithContext), ctx, p)
+}
@@ -20,37 +20,61 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type ManagementServiceIface interface {
 	ListManagementServers(p *ListManagementServersParams) (*ListManagementServersResponse, error)
+	ListManagementServersWithContext(ctx context.Context, p *ListManagementServersParams) (*ListManagementServersResponse, error)
+	ListManagementServersAll(p *ListManagementServersParams, opts ...PageOption) ([]*ManagementServer, error)
+	ListManagementServersAllWithContext(ctx context.Context, p *ListManagementServersParams, opts ...PageOption) ([]*ManagementServer, error)
+	ListManagementServersIter(p *ListManagementServersParams, opts ...PageOption) iter.Seq2[*ManagementServer, error]
+	ListManagementServersIterWithContext(ctx context.Context, p *ListManagementServersParams, opts ...PageOption) iter.Seq2[*ManagementServer, error]
 	NewListManagementServersParams() *ListManagementServersParams
 	GetManagementServerID(name string, opts ...OptionFunc) (string, int, error)
+	GetManagementServerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetManagementServerByName(name string, opts ...OptionFunc) (*ManagementServer, int, error)
+	GetManagementServerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServer, int, error)
 	GetManagementServerByID(id string, opts ...OptionFunc) (*ManagementServer, int, error)
+	GetManagementServerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServer, int, error)
 	ListManagementServersMetrics(p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error)
+	ListManagementServersMetricsWithContext(ctx context.Context, p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error)
+	ListManagementServersMetricsAll(p *ListManagementServersMetricsParams, opts ...PageOption) ([]*ManagementServersMetric, error)
+	ListManagementServersMetricsAllWithContext(ctx context.Context, p *ListManagementServersMetricsParams, opts ...PageOption) ([]*ManagementServersMetric, error)
+	ListManagementServersMetricsIter(p *ListManagementServersMetricsParams, opts ...PageOption) iter.Seq2[*ManagementServersMetric, error]
+	ListManagementServersMetricsIterWithContext(ctx context.Context, p *ListManagementServersMetricsParams, opts ...PageOption) iter.Seq2[*ManagementServersMetric, error]
 	NewListManagementServersMetricsParams() *ListManagementServersMetricsParams
 	GetManagementServersMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetManagementServersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetManagementServersMetricByName(name string, opts ...OptionFunc) (*ManagementServersMetric, int, error)
+	GetManagementServersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServersMetric, int, error)
 	GetManagementServersMetricByID(id string, opts ...OptionFunc) (*ManagementServersMetric, int, error)
+	GetManagementServersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServersMetric, int, error)
 	RemoveManagementServer(p *RemoveManagementServerParams) (*RemoveManagementServerResponse, error)
+	RemoveManagementServerWithContext(ctx context.Context, p *RemoveManagementServerParams) (*RemoveManagementServerResponse, error)
 	NewRemoveManagementServerParams(id string) *RemoveManagementServerParams
 	PrepareForMaintenance(p *PrepareForMaintenanceParams) (*PrepareForMaintenanceResponse, error)
+	PrepareForMaintenanceWithContext(ctx context.Context, p *PrepareForMaintenanceParams) (*PrepareForMaintenanceResponse, error)
 	NewPrepareForMaintenanceParams(managementserverid UUID) *PrepareForMaintenanceParams
 	CancelMaintenance(p *CancelMaintenanceParams) (*CancelMaintenanceResponse, error)
+	CancelMaintenanceWithContext(ctx context.Context, p *CancelMaintenanceParams) (*CancelMaintenanceResponse, error)
 	NewCancelMaintenanceParams(managementserverid UUID) *CancelMaintenanceParams
 	CancelShutdown(p *CancelShutdownParams) (*CancelShutdownResponse, error)
+	CancelShutdownWithContext(ctx context.Context, p *CancelShutdownParams) (*CancelShutdownResponse, error)
 	NewCancelShutdownParams(managementserverid UUID) *CancelShutdownParams
 	PrepareForShutdown(p *PrepareForShutdownParams) (*PrepareForShutdownResponse, error)
+	PrepareForShutdownWithContext(ctx context.Context, p *PrepareForShutdownParams) (*PrepareForShutdownResponse, error)
 	NewPrepareForShutdownParams(managementserverid UUID) *PrepareForShutdownParams
 	ReadyForShutdown(p *ReadyForShutdownParams) (*ReadyForShutdownResponse, error)
+	ReadyForShutdownWithContext(ctx context.Context, p *ReadyForShutdownParams) (*ReadyForShutdownResponse, error)
 	NewReadyForShutdownParams(managementserverid UUID) *ReadyForShutdownParams
 	TriggerShutdown(p *TriggerShutdownParams) (*TriggerShutdownResponse, error)
+	TriggerShutdownWithContext(ctx context.Context, p *TriggerShutdownParams) (*TriggerShutdownResponse, error)
 	NewTriggerShutdownParams(managementserverid UUID) *TriggerShutdownParams
 }
 
@@ -90,6 +114,49 @@ func (p *ListManagementServersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listManagementServers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListManagementServersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listManagementServers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listManagementServers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListManagementServersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "version": 255},
+	}.validate("listManagementServers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listManagementServers API, before the request is signed
+func (p *ListManagementServersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListManagementServersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListManagementServersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listManagementServers", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"peers":    decodeParam[bool],
+		"version":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListManagementServersParams) Clone() *ListManagementServersParams {
+	return &ListManagementServersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListManagementServersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -247,6 +314,11 @@ func (s *ManagementService) NewListManagementServersParams() *ListManagementServ
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ManagementService) GetManagementServerID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetManagementServerIDWithContext(context.Background(), name, opts...)
+}
+
+// GetManagementServerIDWithContext is like GetManagementServerID, but honours the cancellation and deadline of ctx
+func (s *ManagementService) GetManagementServerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListManagementServersParams{}
 	p.p = make(map[string]interface{})
 
@@ -258,7 +330,7 @@ func (s *ManagementService) GetManagementServerID(name string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListManagementServers(p)
+	l, err := s.ListManagementServersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -283,12 +355,17 @@ func (s *ManagementService) GetManagementServerID(name string, opts ...OptionFun
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ManagementService) GetManagementServerByName(name string, opts ...OptionFunc) (*ManagementServer, int, error) {
-	id, count, err := s.GetManagementServerID(name, opts...)
+	return s.GetManagementServerByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetManagementServerByNameWithContext is like GetManagementServerByName, but honours the cancellation and deadline of ctx
+func (s *ManagementService) GetManagementServerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	id, count, err := s.GetManagementServerIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetManagementServerByID(id, opts...)
+	r, count, err := s.GetManagementServerByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -297,6 +374,11 @@ func (s *ManagementService) GetManagementServerByName(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ManagementService) GetManagementServerByID(id string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	return s.GetManagementServerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetManagementServerByIDWithContext is like GetManagementServerByID, but honours the cancellation and deadline of ctx
+func (s *ManagementService) GetManagementServerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServer, int, error) {
 	p := &ListManagementServersParams{}
 	p.p = make(map[string]interface{})
 
@@ -308,11 +390,10 @@ func (s *ManagementService) GetManagementServerByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListManagementServers(p)
+	l, err := s.ListManagementServersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -330,45 +411,88 @@ func (s *ManagementService) GetManagementServerByID(id string, opts ...OptionFun
 
 // Lists management servers.
 func (s *ManagementService) ListManagementServers(p *ListManagementServersParams) (*ListManagementServersResponse, error) {
-	resp, err := s.cs.newRequest("listManagementServers", p.toURLValues())
+	return s.ListManagementServersWithContext(context.Background(), p)
+}
+
+// ListManagementServersWithContext is like ListManagementServers, but honours the cancellation and deadline of ctx
+func (s *ManagementService) ListManagementServersWithContext(ctx context.Context, p *ListManagementServersParams) (*ListManagementServersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listManagementServers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListManagementServersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listManagementServers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListManagementServersAll returns all ManagementServers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ManagementService) ListManagementServersAll(p *ListManagementServersParams, opts ...PageOption) ([]*ManagementServer, error) {
+	return s.ListManagementServersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListManagementServersAllWithContext is like ListManagementServersAll, but honours the cancellation and deadline of ctx
+func (s *ManagementService) ListManagementServersAllWithContext(ctx context.Context, p *ListManagementServersParams, opts ...PageOption) ([]*ManagementServer, error) {
+	return collectPages(s.ListManagementServersIterWithContext(ctx, p, opts...))
+}
+
+// ListManagementServersIter returns an iterator over all ManagementServers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ManagementService) ListManagementServersIter(p *ListManagementServersParams, opts ...PageOption) iter.Seq2[*ManagementServer, error] {
+	return s.ListManagementServersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListManagementServersIterWithContext is like ListManagementServersIter, but honours the cancellation and deadline of ctx
+func (s *ManagementService) ListManagementServersIterWithContext(ctx context.Context, p *ListManagementServersParams, opts ...PageOption) iter.Seq2[*ManagementServer, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ManagementServer, int, error) {
+		l, err := s.ListManagementServersWithContext(ctx, &ListManagementServersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ManagementServers, l.Count, nil
+	}, opts...)
+}
+
 type ListManagementServersResponse struct {
 	Count             int                 `json:"count"`
 	ManagementServers []*ManagementServer `json:"managementserver"`
 }
 
 type ManagementServer struct {
-	Agents           []string `json:"agents"`
-	Agentscount      int64    `json:"agentscount"`
-	Id               string   `json:"id"`
-	Ipaddress        string   `json:"ipaddress"`
-	Javadistribution string   `json:"javadistribution"`
-	Javaversion      string   `json:"javaversion"`
-	JobID            string   `json:"jobid"`
-	Jobstatus        int      `json:"jobstatus"`
-	Kernelversion    string   `json:"kernelversion"`
-	Lastagents       []string `json:"lastagents"`
-	Lastboottime     string   `json:"lastboottime"`
-	Lastserverstart  string   `json:"lastserverstart"`
-	Lastserverstop   string   `json:"lastserverstop"`
-	Name             string   `json:"name"`
-	Osdistribution   string   `json:"osdistribution"`
-	Peers            []string `json:"peers"`
-	Pendingjobscount int64    `json:"pendingjobscount"`
-	Serviceip        string   `json:"serviceip"`
-	State            string   `json:"state"`
-	Version          string   `json:"version"`
+	Agents           []string                   `json:"agents"`
+	Agentscount      FlexInt64                  `json:"agentscount"`
+	Id               string                     `json:"id"`
+	Ipaddress        string                     `json:"ipaddress"`
+	Javadistribution string                     `json:"javadistribution"`
+	Javaversion      string                     `json:"javaversion"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Kernelversion    string                     `json:"kernelversion"`
+	Lastagents       []string                   `json:"lastagents"`
+	Lastboottime     Time                       `json:"lastboottime"`
+	Lastserverstart  Time                       `json:"lastserverstart"`
+	Lastserverstop   Time                       `json:"lastserverstop"`
+	Name             string                     `json:"name"`
+	Osdistribution   string                     `json:"osdistribution"`
+	Peers            []string                   `json:"peers"`
+	Pendingjobscount FlexInt64                  `json:"pendingjobscount"`
+	Serviceip        string                     `json:"serviceip"`
+	State            string                     `json:"state"`
+	Version          string                     `json:"version"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ManagementServer) UnmarshalJSON(b []byte) error {
+	type alias ManagementServer
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListManagementServersMetricsParams struct {
@@ -411,6 +535,50 @@ func (p *ListManagementServersMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listManagementServersMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListManagementServersMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listManagementServersMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listManagementServersMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListManagementServersMetricsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "version": 255},
+	}.validate("listManagementServersMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listManagementServersMetrics API, before the request is signed
+func (p *ListManagementServersMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListManagementServersMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListManagementServersMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listManagementServersMetrics", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"peers":    decodeParam[bool],
+		"system":   decodeParam[bool],
+		"version":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListManagementServersMetricsParams) Clone() *ListManagementServersMetricsParams {
+	return &ListManagementServersMetricsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListManagementServersMetricsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -589,6 +757,11 @@ func (s *ManagementService) NewListManagementServersMetricsParams() *ListManagem
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ManagementService) GetManagementServersMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetManagementServersMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetManagementServersMetricIDWithContext is like GetManagementServersMetricID, but honours the cancellation and deadline of ctx
+func (s *ManagementService) GetManagementServersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListManagementServersMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -600,7 +773,7 @@ func (s *ManagementService) GetManagementServersMetricID(name string, opts ...Op
 		}
 	}
 
-	l, err := s.ListManagementServersMetrics(p)
+	l, err := s.ListManagementServersMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -625,12 +798,17 @@ func (s *ManagementService) GetManagementServersMetricID(name string, opts ...Op
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ManagementService) GetManagementServersMetricByName(name string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
-	id, count, err := s.GetManagementServersMetricID(name, opts...)
+	return s.GetManagementServersMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetManagementServersMetricByNameWithContext is like GetManagementServersMetricByName, but honours the cancellation and deadline of ctx
+func (s *ManagementService) GetManagementServersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
+	id, count, err := s.GetManagementServersMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetManagementServersMetricByID(id, opts...)
+	r, count, err := s.GetManagementServersMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -639,6 +817,11 @@ func (s *ManagementService) GetManagementServersMetricByName(name string, opts .
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ManagementService) GetManagementServersMetricByID(id string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
+	return s.GetManagementServersMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetManagementServersMetricByIDWithContext is like GetManagementServersMetricByID, but honours the cancellation and deadline of ctx
+func (s *ManagementService) GetManagementServersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
 	p := &ListManagementServersMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -650,11 +833,10 @@ func (s *ManagementService) GetManagementServersMetricByID(id string, opts ...Op
 		}
 	}
 
-	l, err := s.ListManagementServersMetrics(p)
+	l, err := s.ListManagementServersMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -672,68 +854,111 @@ func (s *ManagementService) GetManagementServersMetricByID(id string, opts ...Op
 
 // Lists Management Server metrics
 func (s *ManagementService) ListManagementServersMetrics(p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listManagementServersMetrics", p.toURLValues())
+	return s.ListManagementServersMetricsWithContext(context.Background(), p)
+}
+
+// ListManagementServersMetricsWithContext is like ListManagementServersMetrics, but honours the cancellation and deadline of ctx
+func (s *ManagementService) ListManagementServersMetricsWithContext(ctx context.Context, p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listManagementServersMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListManagementServersMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listManagementServersMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListManagementServersMetricsAll returns all ManagementServersMetrics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ManagementService) ListManagementServersMetricsAll(p *ListManagementServersMetricsParams, opts ...PageOption) ([]*ManagementServersMetric, error) {
+	return s.ListManagementServersMetricsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListManagementServersMetricsAllWithContext is like ListManagementServersMetricsAll, but honours the cancellation and deadline of ctx
+func (s *ManagementService) ListManagementServersMetricsAllWithContext(ctx context.Context, p *ListManagementServersMetricsParams, opts ...PageOption) ([]*ManagementServersMetric, error) {
+	return collectPages(s.ListManagementServersMetricsIterWithContext(ctx, p, opts...))
+}
+
+// ListManagementServersMetricsIter returns an iterator over all ManagementServersMetrics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ManagementService) ListManagementServersMetricsIter(p *ListManagementServersMetricsParams, opts ...PageOption) iter.Seq2[*ManagementServersMetric, error] {
+	return s.ListManagementServersMetricsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListManagementServersMetricsIterWithContext is like ListManagementServersMetricsIter, but honours the cancellation and deadline of ctx
+func (s *ManagementService) ListManagementServersMetricsIterWithContext(ctx context.Context, p *ListManagementServersMetricsParams, opts ...PageOption) iter.Seq2[*ManagementServersMetric, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ManagementServersMetric, int, error) {
+		l, err := s.ListManagementServersMetricsWithContext(ctx, &ListManagementServersMetricsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ManagementServersMetrics, l.Count, nil
+	}, opts...)
+}
+
 type ListManagementServersMetricsResponse struct {
 	Count                    int                        `json:"count"`
 	ManagementServersMetrics []*ManagementServersMetric `json:"managementserver"`
 }
 
 type ManagementServersMetric struct {
-	Agentcount              int       `json:"agentcount"`
-	Agents                  []string  `json:"agents"`
-	Agentscount             int64     `json:"agentscount"`
-	Availableprocessors     int       `json:"availableprocessors"`
-	Collectiontime          string    `json:"collectiontime"`
-	Cpuload                 string    `json:"cpuload"`
-	Dbislocal               bool      `json:"dbislocal"`
-	Heapmemorytotal         int64     `json:"heapmemorytotal"`
-	Heapmemoryused          int64     `json:"heapmemoryused"`
-	Id                      string    `json:"id"`
-	Ipaddress               string    `json:"ipaddress"`
-	Javadistribution        string    `json:"javadistribution"`
-	Javaversion             string    `json:"javaversion"`
-	JobID                   string    `json:"jobid"`
-	Jobstatus               int       `json:"jobstatus"`
-	Kernelversion           string    `json:"kernelversion"`
-	Lastagents              []string  `json:"lastagents"`
-	Lastboottime            string    `json:"lastboottime"`
-	Lastserverstart         string    `json:"lastserverstart"`
-	Lastserverstop          string    `json:"lastserverstop"`
-	Loginfo                 string    `json:"loginfo"`
-	Name                    string    `json:"name"`
-	Osdistribution          string    `json:"osdistribution"`
-	Peers                   []string  `json:"peers"`
-	Pendingjobscount        int64     `json:"pendingjobscount"`
-	Serviceip               string    `json:"serviceip"`
-	Sessions                int64     `json:"sessions"`
-	State                   string    `json:"state"`
-	Systemcycleusage        []int64   `json:"systemcycleusage"`
-	Systemloadaverages      []float64 `json:"systemloadaverages"`
-	Systemmemoryfree        string    `json:"systemmemoryfree"`
-	Systemmemorytotal       string    `json:"systemmemorytotal"`
-	Systemmemoryused        string    `json:"systemmemoryused"`
-	Systemmemoryvirtualsize string    `json:"systemmemoryvirtualsize"`
-	Systemtotalcpucycles    float64   `json:"systemtotalcpucycles"`
-	Threadsblockedcount     int       `json:"threadsblockedcount"`
-	Threadsdaemoncount      int       `json:"threadsdaemoncount"`
-	Threadsrunnablecount    int       `json:"threadsrunnablecount"`
-	Threadsteminatedcount   int       `json:"threadsteminatedcount"`
-	Threadstotalcount       int       `json:"threadstotalcount"`
-	Threadswaitingcount     int       `json:"threadswaitingcount"`
-	Usageislocal            bool      `json:"usageislocal"`
-	Version                 string    `json:"version"`
+	Agentcount              FlexInt                    `json:"agentcount"`
+	Agents                  []string                   `json:"agents"`
+	Agentscount             FlexInt64                  `json:"agentscount"`
+	Availableprocessors     FlexInt                    `json:"availableprocessors"`
+	Collectiontime          Time                       `json:"collectiontime"`
+	Cpuload                 string                     `json:"cpuload"`
+	Dbislocal               FlexBool                   `json:"dbislocal"`
+	Heapmemorytotal         FlexInt64                  `json:"heapmemorytotal"`
+	Heapmemoryused          FlexInt64                  `json:"heapmemoryused"`
+	Id                      string                     `json:"id"`
+	Ipaddress               string                     `json:"ipaddress"`
+	Javadistribution        string                     `json:"javadistribution"`
+	Javaversion             string                     `json:"javaversion"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Kernelversion           string                     `json:"kernelversion"`
+	Lastagents              []string                   `json:"lastagents"`
+	Lastboottime            Time                       `json:"lastboottime"`
+	Lastserverstart         Time                       `json:"lastserverstart"`
+	Lastserverstop          Time                       `json:"lastserverstop"`
+	Loginfo                 string                     `json:"loginfo"`
+	Name                    string                     `json:"name"`
+	Osdistribution          string                     `json:"osdistribution"`
+	Peers                   []string                   `json:"peers"`
+	Pendingjobscount        FlexInt64                  `json:"pendingjobscount"`
+	Serviceip               string                     `json:"serviceip"`
+	Sessions                FlexInt64                  `json:"sessions"`
+	State                   string                     `json:"state"`
+	Systemcycleusage        []int64                    `json:"systemcycleusage"`
+	Systemloadaverages      []float64                  `json:"systemloadaverages"`
+	Systemmemoryfree        string                     `json:"systemmemoryfree"`
+	Systemmemorytotal       string                     `json:"systemmemorytotal"`
+	Systemmemoryused        string                     `json:"systemmemoryused"`
+	Systemmemoryvirtualsize string                     `json:"systemmemoryvirtualsize"`
+	Systemtotalcpucycles    FlexFloat                  `json:"systemtotalcpucycles"`
+	Threadsblockedcount     FlexInt                    `json:"threadsblockedcount"`
+	Threadsdaemoncount      FlexInt                    `json:"threadsdaemoncount"`
+	Threadsrunnablecount    FlexInt                    `json:"threadsrunnablecount"`
+	Threadsteminatedcount   FlexInt                    `json:"threadsteminatedcount"`
+	Threadstotalcount       FlexInt                    `json:"threadstotalcount"`
+	Threadswaitingcount     FlexInt                    `json:"threadswaitingcount"`
+	Usageislocal            FlexBool                   `json:"usageislocal"`
+	Version                 string                     `json:"version"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ManagementServersMetric) UnmarshalJSON(b []byte) error {
+	type alias ManagementServersMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveManagementServerParams struct {
@@ -751,6 +976,44 @@ func (p *RemoveManagementServerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeManagementServer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveManagementServerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeManagementServer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeManagementServer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveManagementServerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeManagementServer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeManagementServer API, before the request is signed
+func (p *RemoveManagementServerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveManagementServerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveManagementServerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeManagementServer", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveManagementServerParams) Clone() *RemoveManagementServerParams {
+	return &RemoveManagementServerParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveManagementServerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -783,13 +1046,22 @@ func (s *ManagementService) NewRemoveManagementServerParams(id string) *RemoveMa
 
 // Removes a Management Server.
 func (s *ManagementService) RemoveManagementServer(p *RemoveManagementServerParams) (*RemoveManagementServerResponse, error) {
-	resp, err := s.cs.newPostRequest("removeManagementServer", p.toURLValues())
+	return s.RemoveManagementServerWithContext(context.Background(), p)
+}
+
+// RemoveManagementServerWithContext is like RemoveManagementServer, but honours the cancellation and deadline of ctx
+func (s *ManagementService) RemoveManagementServerWithContext(ctx context.Context, p *RemoveManagementServerParams) (*RemoveManagementServerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeManagementServer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveManagementServerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeManagementServer", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -797,10 +1069,11 @@ func (s *ManagementService) RemoveManagementServer(p *RemoveManagementServerPara
 }
 
 type RemoveManagementServerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RemoveManagementServerResponse) UnmarshalJSON(b []byte) error {
@@ -827,7 +1100,7 @@ func (r *RemoveManagementServerResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RemoveManagementServerResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PrepareForMaintenanceParams struct {
@@ -852,6 +1125,46 @@ func (p *PrepareForMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the prepareForMaintenance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *PrepareForMaintenanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("prepareForMaintenance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the prepareForMaintenance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *PrepareForMaintenanceParams) Validate() error {
+	return paramRules{
+		required: []string{"managementserverid"},
+		lengths:  map[string]int{"algorithm": 255, "managementserverid": 255},
+	}.validate("prepareForMaintenance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the prepareForMaintenance API, before the request is signed
+func (p *PrepareForMaintenanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *PrepareForMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *PrepareForMaintenanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("prepareForMaintenance", b, &p.p, map[string]paramDecoder{
+		"algorithm":          decodeParam[string],
+		"forced":             decodeParam[bool],
+		"managementserverid": decodeParam[UUID],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *PrepareForMaintenanceParams) Clone() *PrepareForMaintenanceParams {
+	return &PrepareForMaintenanceParams{p: cloneParams(p.p)}
+}
+
 func (p *PrepareForMaintenanceParams) SetAlgorithm(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -926,13 +1239,22 @@ func (s *ManagementService) NewPrepareForMaintenanceParams(managementserverid UU
 
 // Prepares management server for maintenance by preventing new jobs from being accepted after completion of active jobs and migrating the agents
 func (s *ManagementService) PrepareForMaintenance(p *PrepareForMaintenanceParams) (*PrepareForMaintenanceResponse, error) {
-	resp, err := s.cs.newPostRequest("prepareForMaintenance", p.toURLValues())
+	return s.PrepareForMaintenanceWithContext(context.Background(), p)
+}
+
+// PrepareForMaintenanceWithContext is like PrepareForMaintenance, but honours the cancellation and deadline of ctx
+func (s *ManagementService) PrepareForMaintenanceWithContext(ctx context.Context, p *PrepareForMaintenanceParams) (*PrepareForMaintenanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "prepareForMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r PrepareForMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("prepareForMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -940,16 +1262,22 @@ func (s *ManagementService) PrepareForMaintenance(p *PrepareForMaintenanceParams
 }
 
 type PrepareForMaintenanceResponse struct {
-	Agents               []string `json:"agents"`
-	Agentscount          int64    `json:"agentscount"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Maintenanceinitiated bool     `json:"maintenanceinitiated"`
-	Managementserverid   UUID     `json:"managementserverid"`
-	Pendingjobscount     int64    `json:"pendingjobscount"`
-	Readyforshutdown     bool     `json:"readyforshutdown"`
-	Shutdowntriggered    bool     `json:"shutdowntriggered"`
-	State                string   `json:"state"`
+	Agents               []string                   `json:"agents"`
+	Agentscount          FlexInt64                  `json:"agentscount"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maintenanceinitiated FlexBool                   `json:"maintenanceinitiated"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Pendingjobscount     FlexInt64                  `json:"pendingjobscount"`
+	Readyforshutdown     FlexBool                   `json:"readyforshutdown"`
+	Shutdowntriggered    FlexBool                   `json:"shutdowntriggered"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PrepareForMaintenanceResponse) UnmarshalJSON(b []byte) error {
+	type alias PrepareForMaintenanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelMaintenanceParams struct {
@@ -971,6 +1299,45 @@ func (p *CancelMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the cancelMaintenance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CancelMaintenanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("cancelMaintenance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the cancelMaintenance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CancelMaintenanceParams) Validate() error {
+	return paramRules{
+		required: []string{"managementserverid"},
+		lengths:  map[string]int{"managementserverid": 255},
+	}.validate("cancelMaintenance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the cancelMaintenance API, before the request is signed
+func (p *CancelMaintenanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CancelMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CancelMaintenanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("cancelMaintenance", b, &p.p, map[string]paramDecoder{
+		"managementserverid": decodeParam[UUID],
+		"rebalance":          decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CancelMaintenanceParams) Clone() *CancelMaintenanceParams {
+	return &CancelMaintenanceParams{p: cloneParams(p.p)}
+}
+
 func (p *CancelMaintenanceParams) SetManagementserverid(v UUID) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1024,13 +1391,22 @@ func (s *ManagementService) NewCancelMaintenanceParams(managementserverid UUID)
 
 // Cancels maintenance of the management server
 func (s *ManagementService) CancelMaintenance(p *CancelMaintenanceParams) (*CancelMaintenanceResponse, error) {
-	resp, err := s.cs.newPostRequest("cancelMaintenance", p.toURLValues())
+	return s.CancelMaintenanceWithContext(context.Background(), p)
+}
+
+// CancelMaintenanceWithContext is like CancelMaintenance, but honours the cancellation and deadline of ctx
+func (s *ManagementService) CancelMaintenanceWithContext(ctx context.Context, p *CancelMaintenanceParams) (*CancelMaintenanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "cancelMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CancelMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("cancelMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1038,16 +1414,22 @@ func (s *ManagementService) CancelMaintenance(p *CancelMaintenanceParams) (*Canc
 }
 
 type CancelMaintenanceResponse struct {
-	Agents               []string `json:"agents"`
-	Agentscount          int64    `json:"agentscount"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Maintenanceinitiated bool     `json:"maintenanceinitiated"`
-	Managementserverid   UUID     `json:"managementserverid"`
-	Pendingjobscount     int64    `json:"pendingjobscount"`
-	Readyforshutdown     bool     `json:"readyforshutdown"`
-	Shutdowntriggered    bool     `json:"shutdowntriggered"`
-	State                string   `json:"state"`
+	Agents               []string                   `json:"agents"`
+	Agentscount          FlexInt64                  `json:"agentscount"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maintenanceinitiated FlexBool                   `json:"maintenanceinitiated"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Pendingjobscount     FlexInt64                  `json:"pendingjobscount"`
+	Readyforshutdown     FlexBool                   `json:"readyforshutdown"`
+	Shutdowntriggered    FlexBool                   `json:"shutdowntriggered"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CancelMaintenanceResponse) UnmarshalJSON(b []byte) error {
+	type alias CancelMaintenanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CancelShutdownParams struct {
@@ -1065,6 +1447,44 @@ func (p *CancelShutdownParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the cancelShutdown API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CancelShutdownParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("cancelShutdown", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the cancelShutdown API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CancelShutdownParams) Validate() error {
+	return paramRules{
+		required: []string{"managementserverid"},
+		lengths:  map[string]int{"managementserverid": 255},
+	}.validate("cancelShutdown", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the cancelShutdown API, before the request is signed
+func (p *CancelShutdownParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CancelShutdownParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CancelShutdownParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("cancelShutdown", b, &p.p, map[string]paramDecoder{
+		"managementserverid": decodeParam[UUID],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CancelShutdownParams) Clone() *CancelShutdownParams {
+	return &CancelShutdownParams{p: cloneParams(p.p)}
+}
+
 func (p *CancelShutdownParams) SetManagementserverid(v UUID) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1097,13 +1517,22 @@ func (s *ManagementService) NewCancelShutdownParams(managementserverid UUID) *Ca
 
 // Cancels a triggered shutdown
 func (s *ManagementService) CancelShutdown(p *CancelShutdownParams) (*CancelShutdownResponse, error) {
-	resp, err := s.cs.newPostRequest("cancelShutdown", p.toURLValues())
+	return s.CancelShutdownWithContext(context.Background(), p)
+}
+
+// CancelShutdownWithContext is like CancelShutdown, but honours the cancellation and deadline of ctx
+func (s *ManagementService) CancelShutdownWithContext(ctx context.Context, p *CancelShutdownParams) (*CancelShutdownResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "cancelShutdown", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CancelShutdownResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("cancelShutdown", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1111,16 +1540,22 @@ func (s *ManagementService) CancelShutdown(p *CancelShutdownParams) (*CancelShut
 }
 
 type CancelShutdownResponse struct {
-	Agents               []string `json:"agents"`
-	Agentscount          int64    `json:"agentscount"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Maintenanceinitiated bool     `json:"maintenanceinitiated"`
-	Managementserverid   UUID     `json:"managementserverid"`
-	Pendingjobscount     int64    `json:"pendingjobscount"`
-	Readyforshutdown     bool     `json:"readyforshutdown"`
-	Shutdowntriggered    bool     `json:"shutdowntriggered"`
-	State                string   `json:"state"`
+	Agents               []string                   `json:"agents"`
+	Agentscount          FlexInt64                  `json:"agentscount"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maintenanceinitiated FlexBool                   `json:"maintenanceinitiated"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Pendingjobscount     FlexInt64                  `json:"pendingjobscount"`
+	Readyforshutdown     FlexBool                   `json:"readyforshutdown"`
+	Shutdowntriggered    FlexBool                   `json:"shutdowntriggered"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CancelShutdownResponse) UnmarshalJSON(b []byte) error {
+	type alias CancelShutdownResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PrepareForShutdownParams struct {
@@ -1138,6 +1573,44 @@ func (p *PrepareForShutdownParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the prepareForShutdown API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *PrepareForShutdownParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("prepareForShutdown", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the prepareForShutdown API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *PrepareForShutdownParams) Validate() error {
+	return paramRules{
+		required: []string{"managementserverid"},
+		lengths:  map[string]int{"managementserverid": 255},
+	}.validate("prepareForShutdown", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the prepareForShutdown API, before the request is signed
+func (p *PrepareForShutdownParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *PrepareForShutdownParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *PrepareForShutdownParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("prepareForShutdown", b, &p.p, map[string]paramDecoder{
+		"managementserverid": decodeParam[UUID],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *PrepareForShutdownParams) Clone() *PrepareForShutdownParams {
+	return &PrepareForShutdownParams{p: cloneParams(p.p)}
+}
+
 func (p *PrepareForShutdownParams) SetManagementserverid(v UUID) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1170,13 +1643,22 @@ func (s *ManagementService) NewPrepareForShutdownParams(managementserverid UUID)
 
 // Prepares CloudStack for a safe manual shutdown by preventing new jobs from being accepted
 func (s *ManagementService) PrepareForShutdown(p *PrepareForShutdownParams) (*PrepareForShutdownResponse, error) {
-	resp, err := s.cs.newPostRequest("prepareForShutdown", p.toURLValues())
+	return s.PrepareForShutdownWithContext(context.Background(), p)
+}
+
+// PrepareForShutdownWithContext is like PrepareForShutdown, but honours the cancellation and deadline of ctx
+func (s *ManagementService) PrepareForShutdownWithContext(ctx context.Context, p *PrepareForShutdownParams) (*PrepareForShutdownResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "prepareForShutdown", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r PrepareForShutdownResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("prepareForShutdown", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1184,16 +1666,22 @@ func (s *ManagementService) PrepareForShutdown(p *PrepareForShutdownParams) (*Pr
 }
 
 type PrepareForShutdownResponse struct {
-	Agents               []string `json:"agents"`
-	Agentscount          int64    `json:"agentscount"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Maintenanceinitiated bool     `json:"maintenanceinitiated"`
-	Managementserverid   UUID     `json:"managementserverid"`
-	Pendingjobscount     int64    `json:"pendingjobscount"`
-	Readyforshutdown     bool     `json:"readyforshutdown"`
-	Shutdowntriggered    bool     `json:"shutdowntriggered"`
-	State                string   `json:"state"`
+	Agents               []string                   `json:"agents"`
+	Agentscount          FlexInt64                  `json:"agentscount"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maintenanceinitiated FlexBool                   `json:"maintenanceinitiated"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Pendingjobscount     FlexInt64                  `json:"pendingjobscount"`
+	Readyforshutdown     FlexBool                   `json:"readyforshutdown"`
+	Shutdowntriggered    FlexBool                   `json:"shutdowntriggered"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PrepareForShutdownResponse) UnmarshalJSON(b []byte) error {
+	type alias PrepareForShutdownResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReadyForShutdownParams struct {
@@ -1211,6 +1699,44 @@ func (p *ReadyForShutdownParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the readyForShutdown API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReadyForShutdownParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("readyForShutdown", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the readyForShutdown API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReadyForShutdownParams) Validate() error {
+	return paramRules{
+		required: []string{"managementserverid"},
+		lengths:  map[string]int{"managementserverid": 255},
+	}.validate("readyForShutdown", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the readyForShutdown API, before the request is signed
+func (p *ReadyForShutdownParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReadyForShutdownParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReadyForShutdownParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("readyForShutdown", b, &p.p, map[string]paramDecoder{
+		"managementserverid": decodeParam[UUID],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReadyForShutdownParams) Clone() *ReadyForShutdownParams {
+	return &ReadyForShutdownParams{p: cloneParams(p.p)}
+}
+
 func (p *ReadyForShutdownParams) SetManagementserverid(v UUID) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1243,7 +1769,16 @@ func (s *ManagementService) NewReadyForShutdownParams(managementserverid UUID) *
 
 // Returns the status of CloudStack, whether a shutdown has been triggered and if ready to shutdown
 func (s *ManagementService) ReadyForShutdown(p *ReadyForShutdownParams) (*ReadyForShutdownResponse, error) {
-	resp, err := s.cs.newRequest("readyForShutdown", p.toURLValues())
+	return s.ReadyForShutdownWithContext(context.Background(), p)
+}
+
+// ReadyForShutdownWithContext is like ReadyForShutdown, but honours the cancellation and deadline of ctx
+func (s *ManagementService) ReadyForShutdownWithContext(ctx context.Context, p *ReadyForShutdownParams) (*ReadyForShutdownResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "readyForShutdown", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1251,7 +1786,7 @@ func (s *ManagementService) ReadyForShutdown(p *ReadyForShutdownParams) (*ReadyF
 	var nested struct {
 		Response ReadyForShutdownResponse `json:"readyforshutdown"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("readyForShutdown", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -1260,16 +1795,22 @@ func (s *ManagementService) ReadyForShutdown(p *ReadyForShutdownParams) (*ReadyF
 }
 
 type ReadyForShutdownResponse struct {
-	Agents               []string `json:"agents"`
-	Agentscount          int64    `json:"agentscount"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Maintenanceinitiated bool     `json:"maintenanceinitiated"`
-	Managementserverid   UUID     `json:"managementserverid"`
-	Pendingjobscount     int64    `json:"pendingjobscount"`
-	Readyforshutdown     bool     `json:"readyforshutdown"`
-	Shutdowntriggered    bool     `json:"shutdowntriggered"`
-	State                string   `json:"state"`
+	Agents               []string                   `json:"agents"`
+	Agentscount          FlexInt64                  `json:"agentscount"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maintenanceinitiated FlexBool                   `json:"maintenanceinitiated"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Pendingjobscount     FlexInt64                  `json:"pendingjobscount"`
+	Readyforshutdown     FlexBool                   `json:"readyforshutdown"`
+	Shutdowntriggered    FlexBool                   `json:"shutdowntriggered"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReadyForShutdownResponse) UnmarshalJSON(b []byte) error {
+	type alias ReadyForShutdownResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type TriggerShutdownParams struct {
@@ -1287,6 +1828,44 @@ func (p *TriggerShutdownParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the triggerShutdown API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *TriggerShutdownParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("triggerShutdown", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the triggerShutdown API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *TriggerShutdownParams) Validate() error {
+	return paramRules{
+		required: []string{"managementserverid"},
+		lengths:  map[string]int{"managementserverid": 255},
+	}.validate("triggerShutdown", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the triggerShutdown API, before the request is signed
+func (p *TriggerShutdownParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *TriggerShutdownParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *TriggerShutdownParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("triggerShutdown", b, &p.p, map[string]paramDecoder{
+		"managementserverid": decodeParam[UUID],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *TriggerShutdownParams) Clone() *TriggerShutdownParams {
+	return &TriggerShutdownParams{p: cloneParams(p.p)}
+}
+
 func (p *TriggerShutdownParams) SetManagementserverid(v UUID) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1319,13 +1898,22 @@ func (s *ManagementService) NewTriggerShutdownParams(managementserverid UUID) *T
 
 // Triggers an automatic safe shutdown of CloudStack by not accepting new jobs and shutting down when all pending jobs have been completed.
 func (s *ManagementService) TriggerShutdown(p *TriggerShutdownParams) (*TriggerShutdownResponse, error) {
-	resp, err := s.cs.newPostRequest("triggerShutdown", p.toURLValues())
+	return s.TriggerShutdownWithContext(context.Background(), p)
+}
+
+// TriggerShutdownWithContext is like TriggerShutdown, but honours the cancellation and deadline of ctx
+func (s *ManagementService) TriggerShutdownWithContext(ctx context.Context, p *TriggerShutdownParams) (*TriggerShutdownResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "triggerShutdown", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r TriggerShutdownResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("triggerShutdown", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1333,14 +1921,20 @@ func (s *ManagementService) TriggerShutdown(p *TriggerShutdownParams) (*TriggerS
 }
 
 type TriggerShutdownResponse struct {
-	Agents               []string `json:"agents"`
-	Agentscount          int64    `json:"agentscount"`
-	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
-	Maintenanceinitiated bool     `json:"maintenanceinitiated"`
-	Managementserverid   UUID     `json:"managementserverid"`
-	Pendingjobscount     int64    `json:"pendingjobscount"`
-	Readyforshutdown     bool     `json:"readyforshutdown"`
-	Shutdowntriggered    bool     `json:"shutdowntriggered"`
-	State                string   `json:"state"`
+	Agents               []string                   `json:"agents"`
+	Agentscount          FlexInt64                  `json:"agentscount"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Maintenanceinitiated FlexBool                   `json:"maintenanceinitiated"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Pendingjobscount     FlexInt64                  `json:"pendingjobscount"`
+	Readyforshutdown     FlexBool                   `json:"readyforshutdown"`
+	Shutdowntriggered    FlexBool                   `json:"shutdowntriggered"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *TriggerShutdownResponse) UnmarshalJSON(b []byte) error {
+	type alias TriggerShutdownResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockManagementServiceIfaceMockRecorder) CancelMaintenance(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMaintenance", reflect.TypeOf((*MockManagementServiceIface)(nil).CancelMaintenance), p)
 }
 
+// CancelMaintenanceWithContext mocks base method.
+func (m *MockManagementServiceIface) CancelMaintenanceWithContext(ctx context.Context, p *CancelMaintenanceParams) (*CancelMaintenanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelMaintenanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*CancelMaintenanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelMaintenanceWithContext indicates an expected call of CancelMaintenanceWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) CancelMaintenanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelMaintenanceWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).CancelMaintenanceWithContext), ctx, p)
+}
+
 // CancelShutdown mocks base method.
 func (m *MockManagementServiceIface) CancelShutdown(p *CancelShutdownParams) (*CancelShutdownResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockManagementServiceIfaceMockRecorder) CancelShutdown(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShutdown", reflect.TypeOf((*MockManagementServiceIface)(nil).CancelShutdown), p)
 }
 
+// CancelShutdownWithContext mocks base method.
+func (m *MockManagementServiceIface) CancelShutdownWithContext(ctx context.Context, p *CancelShutdownParams) (*CancelShutdownResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelShutdownWithContext", ctx, p)
+	ret0, _ := ret[0].(*CancelShutdownResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelShutdownWithContext indicates an expected call of CancelShutdownWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) CancelShutdownWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelShutdownWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).CancelShutdownWithContext), ctx, p)
+}
+
 // GetManagementServerByID mocks base method.
 func (m *MockManagementServiceIface) GetManagementServerByID(id string, opts ...OptionFunc) (*ManagementServer, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServerByID(id any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServerByID", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServerByID), varargs...)
 }
 
+// GetManagementServerByIDWithContext mocks base method.
+func (m *MockManagementServiceIface) GetManagementServerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManagementServerByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ManagementServer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManagementServerByIDWithContext indicates an expected call of GetManagementServerByIDWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServerByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServerByIDWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServerByIDWithContext), varargs...)
+}
+
 // GetManagementServerByName mocks base method.
 func (m *MockManagementServiceIface) GetManagementServerByName(name string, opts ...OptionFunc) (*ManagementServer, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +183,27 @@ func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServerByName(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServerByName", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServerByName), varargs...)
 }
 
+// GetManagementServerByNameWithContext mocks base method.
+func (m *MockManagementServiceIface) GetManagementServerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServer, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManagementServerByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*ManagementServer)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManagementServerByNameWithContext indicates an expected call of GetManagementServerByNameWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServerByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServerByNameWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServerByNameWithContext), varargs...)
+}
+
 // GetManagementServerID mocks base method.
 func (m *MockManagementServiceIface) GetManagementServerID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +225,27 @@ func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServerID(name any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServerID", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServerID), varargs...)
 }
 
+// GetManagementServerIDWithContext mocks base method.
+func (m *MockManagementServiceIface) GetManagementServerIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManagementServerIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManagementServerIDWithContext indicates an expected call of GetManagementServerIDWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServerIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServerIDWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServerIDWithContext), varargs...)
+}
+
 // GetManagementServersMetricByID mocks base method.
 func (m *MockManagementServiceIface) GetManagementServersMetricByID(id string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +267,27 @@ func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServersMetricByID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServersMetricByID", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServersMetricByID), varargs...)
 }
 
+// GetManagementServersMetricByIDWithContext mocks base method.
+func (m *MockManagementServiceIface) GetManagementServersMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManagementServersMetricByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ManagementServersMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManagementServersMetricByIDWithContext indicates an expected call of GetManagementServersMetricByIDWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServersMetricByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServersMetricByIDWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServersMetricByIDWithContext), varargs...)
+}
+
 // GetManagementServersMetricByName mocks base method.
 func (m *MockManagementServiceIface) GetManagementServersMetricByName(name string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +309,27 @@ func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServersMetricByNa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServersMetricByName", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServersMetricByName), varargs...)
 }
 
+// GetManagementServersMetricByNameWithContext mocks base method.
+func (m *MockManagementServiceIface) GetManagementServersMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ManagementServersMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManagementServersMetricByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*ManagementServersMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManagementServersMetricByNameWithContext indicates an expected call of GetManagementServersMetricByNameWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServersMetricByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServersMetricByNameWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServersMetricByNameWithContext), varargs...)
+}
+
 // GetManagementServersMetricID mocks base method.
 func (m *MockManagementServiceIface) GetManagementServersMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +351,27 @@ func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServersMetricID(n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServersMetricID", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServersMetricID), varargs...)
 }
 
+// GetManagementServersMetricIDWithContext mocks base method.
+func (m *MockManagementServiceIface) GetManagementServersMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetManagementServersMetricIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetManagementServersMetricIDWithContext indicates an expected call of GetManagementServersMetricIDWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) GetManagementServersMetricIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagementServersMetricIDWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).GetManagementServersMetricIDWithContext), varargs...)
+}
+
 // ListManagementServers mocks base method.
 func (m *MockManagementServiceIface) ListManagementServers(p *ListManagementServersParams) (*ListManagementServersResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +387,84 @@ func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServers(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServers", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServers), p)
 }
 
+// ListManagementServersAll mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersAll(p *ListManagementServersParams, opts ...PageOption) ([]*ManagementServer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersAll", varargs...)
+	ret0, _ := ret[0].([]*ManagementServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagementServersAll indicates an expected call of ListManagementServersAll.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersAll", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersAll), varargs...)
+}
+
+// ListManagementServersAllWithContext mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersAllWithContext(ctx context.Context, p *ListManagementServersParams, opts ...PageOption) ([]*ManagementServer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ManagementServer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagementServersAllWithContext indicates an expected call of ListManagementServersAllWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersAllWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersAllWithContext), varargs...)
+}
+
+// ListManagementServersIter mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersIter(p *ListManagementServersParams, opts ...PageOption) iter.Seq2[*ManagementServer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ManagementServer, error])
+	return ret0
+}
+
+// ListManagementServersIter indicates an expected call of ListManagementServersIter.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersIter", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersIter), varargs...)
+}
+
+// ListManagementServersIterWithContext mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersIterWithContext(ctx context.Context, p *ListManagementServersParams, opts ...PageOption) iter.Seq2[*ManagementServer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ManagementServer, error])
+	return ret0
+}
+
+// ListManagementServersIterWithContext indicates an expected call of ListManagementServersIterWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersIterWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersIterWithContext), varargs...)
+}
+
 // ListManagementServersMetrics mocks base method.
 func (m *MockManagementServiceIface) ListManagementServersMetrics(p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +480,114 @@ func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersMetrics(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetrics", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersMetrics), p)
 }
 
+// ListManagementServersMetricsAll mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersMetricsAll(p *ListManagementServersMetricsParams, opts ...PageOption) ([]*ManagementServersMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersMetricsAll", varargs...)
+	ret0, _ := ret[0].([]*ManagementServersMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagementServersMetricsAll indicates an expected call of ListManagementServersMetricsAll.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersMetricsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetricsAll", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersMetricsAll), varargs...)
+}
+
+// ListManagementServersMetricsAllWithContext mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersMetricsAllWithContext(ctx context.Context, p *ListManagementServersMetricsParams, opts ...PageOption) ([]*ManagementServersMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersMetricsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ManagementServersMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagementServersMetricsAllWithContext indicates an expected call of ListManagementServersMetricsAllWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersMetricsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetricsAllWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersMetricsAllWithContext), varargs...)
+}
+
+// ListManagementServersMetricsIter mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersMetricsIter(p *ListManagementServersMetricsParams, opts ...PageOption) iter.Seq2[*ManagementServersMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersMetricsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ManagementServersMetric, error])
+	return ret0
+}
+
+// ListManagementServersMetricsIter indicates an expected call of ListManagementServersMetricsIter.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersMetricsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetricsIter", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersMetricsIter), varargs...)
+}
+
+// ListManagementServersMetricsIterWithContext mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersMetricsIterWithContext(ctx context.Context, p *ListManagementServersMetricsParams, opts ...PageOption) iter.Seq2[*ManagementServersMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListManagementServersMetricsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ManagementServersMetric, error])
+	return ret0
+}
+
+// ListManagementServersMetricsIterWithContext indicates an expected call of ListManagementServersMetricsIterWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersMetricsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetricsIterWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersMetricsIterWithContext), varargs...)
+}
+
+// ListManagementServersMetricsWithContext mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersMetricsWithContext(ctx context.Context, p *ListManagementServersMetricsParams) (*ListManagementServersMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListManagementServersMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListManagementServersMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagementServersMetricsWithContext indicates an expected call of ListManagementServersMetricsWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersMetricsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersMetricsWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersMetricsWithContext), ctx, p)
+}
+
+// ListManagementServersWithContext mocks base method.
+func (m *MockManagementServiceIface) ListManagementServersWithContext(ctx context.Context, p *ListManagementServersParams) (*ListManagementServersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListManagementServersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListManagementServersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListManagementServersWithContext indicates an expected call of ListManagementServersWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) ListManagementServersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListManagementServersWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).ListManagementServersWithContext), ctx, p)
+}
+
 // NewCancelMaintenanceParams mocks base method.
 func (m *MockManagementServiceIface) NewCancelMaintenanceParams(managementserverid UUID) *CancelMaintenanceParams {
 	m.ctrl.T.Helper()
@@ -385,6 +729,21 @@ func (mr *MockManagementServiceIfaceMockRecorder) PrepareForMaintenance(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareForMaintenance", reflect.TypeOf((*MockManagementServiceIface)(nil).PrepareForMaintenance), p)
 }
 
+// PrepareForMaintenanceWithContext mocks base method.
+func (m *MockManagementServiceIface) PrepareForMaintenanceWithContext(ctx context.Context, p *PrepareForMaintenanceParams) (*PrepareForMaintenanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareForMaintenanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*PrepareForMaintenanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareForMaintenanceWithContext indicates an expected call of PrepareForMaintenanceWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) PrepareForMaintenanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareForMaintenanceWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).PrepareForMaintenanceWithContext), ctx, p)
+}
+
 // PrepareForShutdown mocks base method.
 func (m *MockManagementServiceIface) PrepareForShutdown(p *PrepareForShutdownParams) (*PrepareForShutdownResponse, error) {
 	m.ctrl.T.Helper()
@@ -400,6 +759,21 @@ func (mr *MockManagementServiceIfaceMockRecorder) PrepareForShutdown(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareForShutdown", reflect.TypeOf((*MockManagementServiceIface)(nil).PrepareForShutdown), p)
 }
 
+// PrepareForShutdownWithContext mocks base method.
+func (m *MockManagementServiceIface) PrepareForShutdownWithContext(ctx context.Context, p *PrepareForShutdownParams) (*PrepareForShutdownResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareForShutdownWithContext", ctx, p)
+	ret0, _ := ret[0].(*PrepareForShutdownResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareForShutdownWithContext indicates an expected call of PrepareForShutdownWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) PrepareForShutdownWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareForShutdownWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).PrepareForShutdownWithContext), ctx, p)
+}
+
 // ReadyForShutdown mocks base method.
 func (m *MockManagementServiceIface) ReadyForShutdown(p *ReadyForShutdownParams) (*ReadyForShutdownResponse, error) {
 	m.ctrl.T.Helper()
@@ -415,6 +789,21 @@ func (mr *MockManagementServiceIfaceMockRecorder) ReadyForShutdown(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadyForShutdown", reflect.TypeOf((*MockManagementServiceIface)(nil).ReadyForShutdown), p)
 }
 
+// ReadyForShutdownWithContext mocks base method.
+func (m *MockManagementServiceIface) ReadyForShutdownWithContext(ctx context.Context, p *ReadyForShutdownParams) (*ReadyForShutdownResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReadyForShutdownWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReadyForShutdownResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReadyForShutdownWithContext indicates an expected call of ReadyForShutdownWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) ReadyForShutdownWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReadyForShutdownWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).ReadyForShutdownWithContext), ctx, p)
+}
+
 // RemoveManagementServer mocks base method.
 func (m *MockManagementServiceIface) RemoveManagementServer(p *RemoveManagementServerParams) (*RemoveManagementServerResponse, error) {
 	m.ctrl.T.Helper()
@@ -430,6 +819,21 @@ func (mr *MockManagementServiceIfaceMockRecorder) RemoveManagementServer(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveManagementServer", reflect.TypeOf((*MockManagementServiceIface)(nil).RemoveManagementServer), p)
 }
 
+// RemoveManagementServerWithContext mocks base method.
+func (m *MockManagementServiceIface) RemoveManagementServerWithContext(ctx context.Context, p *RemoveManagementServerParams) (*RemoveManagementServerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveManagementServerWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveManagementServerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveManagementServerWithContext indicates an expected call of RemoveManagementServerWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) RemoveManagementServerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveManagementServerWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).RemoveManagementServerWithContext), ctx, p)
+}
+
 // TriggerShutdown mocks base method.
 func (m *MockManagementServiceIface) TriggerShutdown(p *TriggerShutdownParams) (*TriggerShutdownResponse, error) {
 	m.ctrl.T.Helper()
@@ -444,3 +848,18 @@ func (mr *MockManagementServiceIfaceMockRecorder) TriggerShutdown(p any) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerShutdown", reflect.TypeOf((*MockManagementServiceIface)(nil).TriggerShutdown), p)
 }
+
+// TriggerShutdownWithContext mocks base method.
+func (m *MockManagementServiceIface) TriggerShutdownWithContext(ctx context.Context, p *TriggerShutdownParams) (*TriggerShutdownResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "TriggerShutdownWithContext", ctx, p)
+	ret0, _ := ret[0].(*TriggerShutdownResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// TriggerShutdownWithContext indicates an expected call of TriggerShutdownWithContext.
+func (mr *MockManagementServiceIfaceMockRecorder) TriggerShutdownWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "TriggerShutdownWithContext", reflect.TypeOf((*MockManagementServiceIface)(nil).TriggerShutdownWithContext), ctx, p)
+}
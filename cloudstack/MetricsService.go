@@ -20,12 +20,14 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
 
 type MetricsServiceIface interface {
 	ListInfrastructure(p *ListInfrastructureParams) (*ListInfrastructureResponse, error)
+	ListInfrastructureWithContext(ctx context.Context, p *ListInfrastructureParams) (*ListInfrastructureResponse, error)
 	NewListInfrastructureParams() *ListInfrastructureParams
 }
 
@@ -41,6 +43,39 @@ func (p *ListInfrastructureParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listInfrastructure API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListInfrastructureParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listInfrastructure", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listInfrastructure API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListInfrastructureParams) Validate() error {
+	return paramRules{}.validate("listInfrastructure", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listInfrastructure API, before the request is signed
+func (p *ListInfrastructureParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListInfrastructureParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListInfrastructureParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listInfrastructure", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListInfrastructureParams) Clone() *ListInfrastructureParams {
+	return &ListInfrastructureParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new ListInfrastructureParams instance,
 // as then you are sure you have configured all required params
 func (s *MetricsService) NewListInfrastructureParams() *ListInfrastructureParams {
@@ -51,13 +86,22 @@ func (s *MetricsService) NewListInfrastructureParams() *ListInfrastructureParams
 
 // Lists infrastructure
 func (s *MetricsService) ListInfrastructure(p *ListInfrastructureParams) (*ListInfrastructureResponse, error) {
-	resp, err := s.cs.newRequest("listInfrastructure", p.toURLValues())
+	return s.ListInfrastructureWithContext(context.Background(), p)
+}
+
+// ListInfrastructureWithContext is like ListInfrastructure, but honours the cancellation and deadline of ctx
+func (s *MetricsService) ListInfrastructureWithContext(ctx context.Context, p *ListInfrastructureParams) (*ListInfrastructureResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listInfrastructure", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListInfrastructureResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listInfrastructure", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -70,20 +114,26 @@ type ListInfrastructureResponse struct {
 }
 
 type Infrastructure struct {
-	Alerts             int    `json:"alerts"`
-	Backuprepositories int    `json:"backuprepositories"`
-	Clusters           int    `json:"clusters"`
-	Cpusockets         int    `json:"cpusockets"`
-	Hosts              int    `json:"hosts"`
-	Ilbvms             int    `json:"ilbvms"`
-	Imagestores        int    `json:"imagestores"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Managementservers  int    `json:"managementservers"`
-	Objectstores       int    `json:"objectstores"`
-	Pods               int    `json:"pods"`
-	Routers            int    `json:"routers"`
-	Storagepools       int    `json:"storagepools"`
-	Systemvms          int    `json:"systemvms"`
-	Zones              int    `json:"zones"`
+	Alerts             FlexInt                    `json:"alerts"`
+	Backuprepositories FlexInt                    `json:"backuprepositories"`
+	Clusters           FlexInt                    `json:"clusters"`
+	Cpusockets         FlexInt                    `json:"cpusockets"`
+	Hosts              FlexInt                    `json:"hosts"`
+	Ilbvms             FlexInt                    `json:"ilbvms"`
+	Imagestores        FlexInt                    `json:"imagestores"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Managementservers  FlexInt                    `json:"managementservers"`
+	Objectstores       FlexInt                    `json:"objectstores"`
+	Pods               FlexInt                    `json:"pods"`
+	Routers            FlexInt                    `json:"routers"`
+	Storagepools       FlexInt                    `json:"storagepools"`
+	Systemvms          FlexInt                    `json:"systemvms"`
+	Zones              FlexInt                    `json:"zones"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Infrastructure) UnmarshalJSON(b []byte) error {
+	type alias Infrastructure
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockMetricsServiceIfaceMockRecorder) ListInfrastructure(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInfrastructure", reflect.TypeOf((*MockMetricsServiceIface)(nil).ListInfrastructure), p)
 }
 
+// ListInfrastructureWithContext mocks base method.
+func (m *MockMetricsServiceIface) ListInfrastructureWithContext(ctx context.Context, p *ListInfrastructureParams) (*ListInfrastructureResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInfrastructureWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListInfrastructureResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInfrastructureWithContext indicates an expected call of ListInfrastructureWithContext.
+func (mr *MockMetricsServiceIfaceMockRecorder) ListInfrastructureWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInfrastructureWithContext", reflect.TypeOf((*MockMetricsServiceIface)(nil).ListInfrastructureWithContext), ctx, p)
+}
+
 // NewListInfrastructureParams mocks base method.
 func (m *MockMetricsServiceIface) NewListInfrastructureParams() *ListInfrastructureParams {
 	m.ctrl.T.Helper()
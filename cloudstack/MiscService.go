@@ -20,12 +20,14 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
 
 type MiscServiceIface interface {
 	ListElastistorInterface(p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error)
+	ListElastistorInterfaceWithContext(ctx context.Context, p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error)
 	NewListElastistorInterfaceParams() *ListElastistorInterfaceParams
 }
 
@@ -44,6 +46,43 @@ func (p *ListElastistorInterfaceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listElastistorInterface API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListElastistorInterfaceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listElastistorInterface", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listElastistorInterface API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListElastistorInterfaceParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"controllerid": 255},
+	}.validate("listElastistorInterface", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listElastistorInterface API, before the request is signed
+func (p *ListElastistorInterfaceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListElastistorInterfaceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListElastistorInterfaceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listElastistorInterface", b, &p.p, map[string]paramDecoder{
+		"controllerid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListElastistorInterfaceParams) Clone() *ListElastistorInterfaceParams {
+	return &ListElastistorInterfaceParams{p: cloneParams(p.p)}
+}
+
 func (p *ListElastistorInterfaceParams) SetControllerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -75,13 +114,22 @@ func (s *MiscService) NewListElastistorInterfaceParams() *ListElastistorInterfac
 
 // Lists the network Interfaces of elastistor
 func (s *MiscService) ListElastistorInterface(p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error) {
-	resp, err := s.cs.newRequest("listElastistorInterface", p.toURLValues())
+	return s.ListElastistorInterfaceWithContext(context.Background(), p)
+}
+
+// ListElastistorInterfaceWithContext is like ListElastistorInterface, but honours the cancellation and deadline of ctx
+func (s *MiscService) ListElastistorInterfaceWithContext(ctx context.Context, p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listElastistorInterface", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListElastistorInterfaceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listElastistorInterface", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -94,12 +142,18 @@ type ListElastistorInterfaceResponse struct {
 }
 
 type ElastistorInterface struct {
-	Compression   string `json:"compression"`
-	Deduplication string `json:"deduplication"`
-	Graceallowed  string `json:"graceallowed"`
-	Id            string `json:"id"`
-	JobID         string `json:"jobid"`
-	Jobstatus     int    `json:"jobstatus"`
-	Name          string `json:"name"`
-	Sync          string `json:"sync"`
+	Compression   string                     `json:"compression"`
+	Deduplication string                     `json:"deduplication"`
+	Graceallowed  string                     `json:"graceallowed"`
+	Id            string                     `json:"id"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Name          string                     `json:"name"`
+	Sync          string                     `json:"sync"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ElastistorInterface) UnmarshalJSON(b []byte) error {
+	type alias ElastistorInterface
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
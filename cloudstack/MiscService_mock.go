@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockMiscServiceIfaceMockRecorder) ListElastistorInterface(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElastistorInterface", reflect.TypeOf((*MockMiscServiceIface)(nil).ListElastistorInterface), p)
 }
 
+// ListElastistorInterfaceWithContext mocks base method.
+func (m *MockMiscServiceIface) ListElastistorInterfaceWithContext(ctx context.Context, p *ListElastistorInterfaceParams) (*ListElastistorInterfaceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListElastistorInterfaceWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListElastistorInterfaceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListElastistorInterfaceWithContext indicates an expected call of ListElastistorInterfaceWithContext.
+func (mr *MockMiscServiceIfaceMockRecorder) ListElastistorInterfaceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElastistorInterfaceWithContext", reflect.TypeOf((*MockMiscServiceIface)(nil).ListElastistorInterfaceWithContext), ctx, p)
+}
+
 // NewListElastistorInterfaceParams mocks base method.
 func (m *MockMiscServiceIface) NewListElastistorInterfaceParams() *ListElastistorInterfaceParams {
 	m.ctrl.T.Helper()
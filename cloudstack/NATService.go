@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,16 +31,29 @@ import (
 
 type NATServiceIface interface {
 	CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
-	NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams
+	CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error)
+	CreateIpForwardingRuleAsync(ctx context.Context, p *CreateIpForwardingRuleParams) (*Job, error)
+	NewCreateIpForwardingRuleParams(ipaddressid string, protocol Protocol, startport int) *CreateIpForwardingRuleParams
 	DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error)
+	DeleteIpForwardingRuleAsync(ctx context.Context, p *DeleteIpForwardingRuleParams) (*Job, error)
 	NewDeleteIpForwardingRuleParams(id string) *DeleteIpForwardingRuleParams
 	DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error)
+	DisableStaticNatAsync(ctx context.Context, p *DisableStaticNatParams) (*Job, error)
 	NewDisableStaticNatParams(ipaddressid string) *DisableStaticNatParams
 	EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
+	EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error)
 	NewEnableStaticNatParams(ipaddressid string, virtualmachineid string) *EnableStaticNatParams
 	ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error)
+	ListIpForwardingRulesAll(p *ListIpForwardingRulesParams, opts ...PageOption) ([]*IpForwardingRule, error)
+	ListIpForwardingRulesAllWithContext(ctx context.Context, p *ListIpForwardingRulesParams, opts ...PageOption) ([]*IpForwardingRule, error)
+	ListIpForwardingRulesIter(p *ListIpForwardingRulesParams, opts ...PageOption) iter.Seq2[*IpForwardingRule, error]
+	ListIpForwardingRulesIterWithContext(ctx context.Context, p *ListIpForwardingRulesParams, opts ...PageOption) iter.Seq2[*IpForwardingRule, error]
 	NewListIpForwardingRulesParams() *ListIpForwardingRulesParams
 	GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
+	GetIpForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IpForwardingRule, int, error)
 }
 
 type CreateIpForwardingRuleParams struct {
@@ -66,7 +81,7 @@ func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
 		u.Set("openfirewall", vv)
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["startport"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -75,6 +90,49 @@ func (p *CreateIpForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createIpForwardingRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateIpForwardingRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createIpForwardingRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createIpForwardingRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateIpForwardingRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"ipaddressid", "protocol", "startport"},
+		lengths:  map[string]int{"ipaddressid": 255, "protocol": 255},
+	}.validate("createIpForwardingRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createIpForwardingRule API, before the request is signed
+func (p *CreateIpForwardingRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateIpForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateIpForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createIpForwardingRule", b, &p.p, map[string]paramDecoder{
+		"cidrlist":     decodeParam[[]string],
+		"endport":      decodeParam[int],
+		"ipaddressid":  decodeParam[string],
+		"openfirewall": decodeParam[bool],
+		"protocol":     decodeParam[Protocol],
+		"startport":    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateIpForwardingRuleParams) Clone() *CreateIpForwardingRuleParams {
+	return &CreateIpForwardingRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateIpForwardingRuleParams) SetCidrlist(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -159,7 +217,7 @@ func (p *CreateIpForwardingRuleParams) GetOpenfirewall() (bool, bool) {
 	return value, ok
 }
 
-func (p *CreateIpForwardingRuleParams) SetProtocol(v string) {
+func (p *CreateIpForwardingRuleParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -172,11 +230,11 @@ func (p *CreateIpForwardingRuleParams) ResetProtocol() {
 	}
 }
 
-func (p *CreateIpForwardingRuleParams) GetProtocol() (string, bool) {
+func (p *CreateIpForwardingRuleParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -203,7 +261,7 @@ func (p *CreateIpForwardingRuleParams) GetStartport() (int, bool) {
 
 // You should always use this function to get a new CreateIpForwardingRuleParams instance,
 // as then you are sure you have configured all required params
-func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol string, startport int) *CreateIpForwardingRuleParams {
+func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protocol Protocol, startport int) *CreateIpForwardingRuleParams {
 	p := &CreateIpForwardingRuleParams{}
 	p.p = make(map[string]interface{})
 	p.p["ipaddressid"] = ipaddressid
@@ -214,32 +272,39 @@ func (s *NATService) NewCreateIpForwardingRuleParams(ipaddressid string, protoco
 
 // Creates an IP forwarding rule
 func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("createIpForwardingRule", p.toURLValues())
+	return s.CreateIpForwardingRuleWithContext(context.Background(), p)
+}
+
+// CreateIpForwardingRuleWithContext is like CreateIpForwardingRule, but honours the cancellation and deadline of ctx
+func (s *NATService) CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createIpForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateIpForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createIpForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createIpForwardingRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createIpForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -247,26 +312,51 @@ func (s *NATService) CreateIpForwardingRule(p *CreateIpForwardingRuleParams) (*C
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateIpForwardingRule async job into r
+func (r *CreateIpForwardingRuleResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateIpForwardingRuleAsync starts the async job of CreateIpForwardingRule without waiting for it to finish, and returns a handle to the job
+func (s *NATService) CreateIpForwardingRuleAsync(ctx context.Context, p *CreateIpForwardingRuleParams) (*Job, error) {
+	r, err := s.CreateIpForwardingRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createIpForwardingRule", r.JobID), nil
+}
+
 type CreateIpForwardingRuleResponse struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateIpForwardingRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateIpForwardingRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteIpForwardingRuleParams struct {
@@ -284,6 +374,44 @@ func (p *DeleteIpForwardingRuleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteIpForwardingRule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteIpForwardingRuleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteIpForwardingRule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteIpForwardingRule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteIpForwardingRuleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteIpForwardingRule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteIpForwardingRule API, before the request is signed
+func (p *DeleteIpForwardingRuleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteIpForwardingRuleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteIpForwardingRuleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteIpForwardingRule", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteIpForwardingRuleParams) Clone() *DeleteIpForwardingRuleParams {
+	return &DeleteIpForwardingRuleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteIpForwardingRuleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -316,27 +444,39 @@ func (s *NATService) NewDeleteIpForwardingRuleParams(id string) *DeleteIpForward
 
 // Deletes an IP forwarding rule
 func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteIpForwardingRule", p.toURLValues())
+	return s.DeleteIpForwardingRuleWithContext(context.Background(), p)
+}
+
+// DeleteIpForwardingRuleWithContext is like DeleteIpForwardingRule, but honours the cancellation and deadline of ctx
+func (s *NATService) DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteIpForwardingRule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteIpForwardingRuleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteIpForwardingRule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteIpForwardingRule", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteIpForwardingRule", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -344,11 +484,31 @@ func (s *NATService) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*D
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteIpForwardingRule async job into r
+func (r *DeleteIpForwardingRuleResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteIpForwardingRuleAsync starts the async job of DeleteIpForwardingRule without waiting for it to finish, and returns a handle to the job
+func (s *NATService) DeleteIpForwardingRuleAsync(ctx context.Context, p *DeleteIpForwardingRuleParams) (*Job, error) {
+	r, err := s.DeleteIpForwardingRuleWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteIpForwardingRule", r.JobID), nil
+}
+
 type DeleteIpForwardingRuleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteIpForwardingRuleResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteIpForwardingRuleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableStaticNatParams struct {
@@ -366,6 +526,44 @@ func (p *DisableStaticNatParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableStaticNat API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableStaticNatParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableStaticNat", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableStaticNat API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableStaticNatParams) Validate() error {
+	return paramRules{
+		required: []string{"ipaddressid"},
+		lengths:  map[string]int{"ipaddressid": 255},
+	}.validate("disableStaticNat", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableStaticNat API, before the request is signed
+func (p *DisableStaticNatParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableStaticNatParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableStaticNatParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableStaticNat", b, &p.p, map[string]paramDecoder{
+		"ipaddressid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableStaticNatParams) Clone() *DisableStaticNatParams {
+	return &DisableStaticNatParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableStaticNatParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -398,27 +596,39 @@ func (s *NATService) NewDisableStaticNatParams(ipaddressid string) *DisableStati
 
 // Disables static rule for given IP address
 func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
-	resp, err := s.cs.newPostRequest("disableStaticNat", p.toURLValues())
+	return s.DisableStaticNatWithContext(context.Background(), p)
+}
+
+// DisableStaticNatWithContext is like DisableStaticNat, but honours the cancellation and deadline of ctx
+func (s *NATService) DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableStaticNat", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableStaticNatResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableStaticNat", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableStaticNat", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableStaticNat", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -426,11 +636,31 @@ func (s *NATService) DisableStaticNat(p *DisableStaticNatParams) (*DisableStatic
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableStaticNat async job into r
+func (r *DisableStaticNatResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DisableStaticNatAsync starts the async job of DisableStaticNat without waiting for it to finish, and returns a handle to the job
+func (s *NATService) DisableStaticNatAsync(ctx context.Context, p *DisableStaticNatParams) (*Job, error) {
+	r, err := s.DisableStaticNatWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableStaticNat", r.JobID), nil
+}
+
 type DisableStaticNatResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableStaticNatResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableStaticNatResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableStaticNatParams struct {
@@ -457,6 +687,47 @@ func (p *EnableStaticNatParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableStaticNat API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableStaticNatParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableStaticNat", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableStaticNat API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableStaticNatParams) Validate() error {
+	return paramRules{
+		required: []string{"ipaddressid", "virtualmachineid"},
+		lengths:  map[string]int{"ipaddressid": 255, "networkid": 255, "virtualmachineid": 255, "vmguestip": 255},
+	}.validate("enableStaticNat", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableStaticNat API, before the request is signed
+func (p *EnableStaticNatParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableStaticNatParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableStaticNatParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableStaticNat", b, &p.p, map[string]paramDecoder{
+		"ipaddressid":      decodeParam[string],
+		"networkid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+		"vmguestip":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableStaticNatParams) Clone() *EnableStaticNatParams {
+	return &EnableStaticNatParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableStaticNatParams) SetIpaddressid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -553,13 +824,22 @@ func (s *NATService) NewEnableStaticNatParams(ipaddressid string, virtualmachine
 
 // Enables static NAT for given IP address
 func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
-	resp, err := s.cs.newPostRequest("enableStaticNat", p.toURLValues())
+	return s.EnableStaticNatWithContext(context.Background(), p)
+}
+
+// EnableStaticNatWithContext is like EnableStaticNat, but honours the cancellation and deadline of ctx
+func (s *NATService) EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "enableStaticNat", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableStaticNatResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableStaticNat", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -567,10 +847,11 @@ func (s *NATService) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNat
 }
 
 type EnableStaticNatResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *EnableStaticNatResponse) UnmarshalJSON(b []byte) error {
@@ -597,7 +878,7 @@ func (r *EnableStaticNatResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias EnableStaticNatResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListIpForwardingRulesParams struct {
@@ -649,6 +930,53 @@ func (p *ListIpForwardingRulesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listIpForwardingRules API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListIpForwardingRulesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listIpForwardingRules", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listIpForwardingRules API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListIpForwardingRulesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "ipaddressid": 255, "keyword": 255, "projectid": 255, "virtualmachineid": 255},
+	}.validate("listIpForwardingRules", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listIpForwardingRules API, before the request is signed
+func (p *ListIpForwardingRulesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListIpForwardingRulesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListIpForwardingRulesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listIpForwardingRules", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"id":               decodeParam[string],
+		"ipaddressid":      decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListIpForwardingRulesParams) Clone() *ListIpForwardingRulesParams {
+	return &ListIpForwardingRulesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListIpForwardingRulesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -890,6 +1218,11 @@ func (s *NATService) NewListIpForwardingRulesParams() *ListIpForwardingRulesPara
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
+	return s.GetIpForwardingRuleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIpForwardingRuleByIDWithContext is like GetIpForwardingRuleByID, but honours the cancellation and deadline of ctx
+func (s *NATService) GetIpForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
 	p := &ListIpForwardingRulesParams{}
 	p.p = make(map[string]interface{})
 
@@ -901,11 +1234,10 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 		}
 	}
 
-	l, err := s.ListIpForwardingRules(p)
+	l, err := s.ListIpForwardingRulesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -923,42 +1255,85 @@ func (s *NATService) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*Ip
 
 // List the IP forwarding rules
 func (s *NATService) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
-	resp, err := s.cs.newRequest("listIpForwardingRules", p.toURLValues())
+	return s.ListIpForwardingRulesWithContext(context.Background(), p)
+}
+
+// ListIpForwardingRulesWithContext is like ListIpForwardingRules, but honours the cancellation and deadline of ctx
+func (s *NATService) ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listIpForwardingRules", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListIpForwardingRulesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listIpForwardingRules", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListIpForwardingRulesAll returns all IpForwardingRules matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NATService) ListIpForwardingRulesAll(p *ListIpForwardingRulesParams, opts ...PageOption) ([]*IpForwardingRule, error) {
+	return s.ListIpForwardingRulesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListIpForwardingRulesAllWithContext is like ListIpForwardingRulesAll, but honours the cancellation and deadline of ctx
+func (s *NATService) ListIpForwardingRulesAllWithContext(ctx context.Context, p *ListIpForwardingRulesParams, opts ...PageOption) ([]*IpForwardingRule, error) {
+	return collectPages(s.ListIpForwardingRulesIterWithContext(ctx, p, opts...))
+}
+
+// ListIpForwardingRulesIter returns an iterator over all IpForwardingRules matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NATService) ListIpForwardingRulesIter(p *ListIpForwardingRulesParams, opts ...PageOption) iter.Seq2[*IpForwardingRule, error] {
+	return s.ListIpForwardingRulesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListIpForwardingRulesIterWithContext is like ListIpForwardingRulesIter, but honours the cancellation and deadline of ctx
+func (s *NATService) ListIpForwardingRulesIterWithContext(ctx context.Context, p *ListIpForwardingRulesParams, opts ...PageOption) iter.Seq2[*IpForwardingRule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*IpForwardingRule, int, error) {
+		l, err := s.ListIpForwardingRulesWithContext(ctx, &ListIpForwardingRulesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.IpForwardingRules, l.Count, nil
+	}, opts...)
+}
+
 type ListIpForwardingRulesResponse struct {
 	Count             int                 `json:"count"`
 	IpForwardingRules []*IpForwardingRule `json:"ipforwardingrule"`
 }
 
 type IpForwardingRule struct {
-	Cidrlist                  string `json:"cidrlist"`
-	Fordisplay                bool   `json:"fordisplay"`
-	Id                        string `json:"id"`
-	Ipaddress                 string `json:"ipaddress"`
-	Ipaddressid               string `json:"ipaddressid"`
-	JobID                     string `json:"jobid"`
-	Jobstatus                 int    `json:"jobstatus"`
-	Networkid                 string `json:"networkid"`
-	Privateendport            string `json:"privateendport"`
-	Privateport               string `json:"privateport"`
-	Protocol                  string `json:"protocol"`
-	Publicendport             string `json:"publicendport"`
-	Publicport                string `json:"publicport"`
-	State                     string `json:"state"`
-	Tags                      []Tags `json:"tags"`
-	Virtualmachinedisplayname string `json:"virtualmachinedisplayname"`
-	Virtualmachineid          string `json:"virtualmachineid"`
-	Virtualmachinename        string `json:"virtualmachinename"`
-	Vmguestip                 string `json:"vmguestip"`
+	Cidrlist                  string                     `json:"cidrlist"`
+	Fordisplay                FlexBool                   `json:"fordisplay"`
+	Id                        string                     `json:"id"`
+	Ipaddress                 string                     `json:"ipaddress"`
+	Ipaddressid               string                     `json:"ipaddressid"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Networkid                 string                     `json:"networkid"`
+	Privateendport            string                     `json:"privateendport"`
+	Privateport               string                     `json:"privateport"`
+	Protocol                  string                     `json:"protocol"`
+	Publicendport             string                     `json:"publicendport"`
+	Publicport                string                     `json:"publicport"`
+	State                     string                     `json:"state"`
+	Tags                      []Tags                     `json:"tags"`
+	Virtualmachinedisplayname string                     `json:"virtualmachinedisplayname"`
+	Virtualmachineid          string                     `json:"virtualmachineid"`
+	Virtualmachinename        string                     `json:"virtualmachinename"`
+	Vmguestip                 string                     `json:"vmguestip"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *IpForwardingRule) UnmarshalJSON(b []byte) error {
+	type alias IpForwardingRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockNATServiceIfaceMockRecorder) CreateIpForwardingRule(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpForwardingRule", reflect.TypeOf((*MockNATServiceIface)(nil).CreateIpForwardingRule), p)
 }
 
+// CreateIpForwardingRuleAsync mocks base method.
+func (m *MockNATServiceIface) CreateIpForwardingRuleAsync(ctx context.Context, p *CreateIpForwardingRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpForwardingRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpForwardingRuleAsync indicates an expected call of CreateIpForwardingRuleAsync.
+func (mr *MockNATServiceIfaceMockRecorder) CreateIpForwardingRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpForwardingRuleAsync", reflect.TypeOf((*MockNATServiceIface)(nil).CreateIpForwardingRuleAsync), ctx, p)
+}
+
+// CreateIpForwardingRuleWithContext mocks base method.
+func (m *MockNATServiceIface) CreateIpForwardingRuleWithContext(ctx context.Context, p *CreateIpForwardingRuleParams) (*CreateIpForwardingRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpForwardingRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateIpForwardingRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpForwardingRuleWithContext indicates an expected call of CreateIpForwardingRuleWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) CreateIpForwardingRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpForwardingRuleWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).CreateIpForwardingRuleWithContext), ctx, p)
+}
+
 // DeleteIpForwardingRule mocks base method.
 func (m *MockNATServiceIface) DeleteIpForwardingRule(p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockNATServiceIfaceMockRecorder) DeleteIpForwardingRule(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpForwardingRule", reflect.TypeOf((*MockNATServiceIface)(nil).DeleteIpForwardingRule), p)
 }
 
+// DeleteIpForwardingRuleAsync mocks base method.
+func (m *MockNATServiceIface) DeleteIpForwardingRuleAsync(ctx context.Context, p *DeleteIpForwardingRuleParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpForwardingRuleAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpForwardingRuleAsync indicates an expected call of DeleteIpForwardingRuleAsync.
+func (mr *MockNATServiceIfaceMockRecorder) DeleteIpForwardingRuleAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpForwardingRuleAsync", reflect.TypeOf((*MockNATServiceIface)(nil).DeleteIpForwardingRuleAsync), ctx, p)
+}
+
+// DeleteIpForwardingRuleWithContext mocks base method.
+func (m *MockNATServiceIface) DeleteIpForwardingRuleWithContext(ctx context.Context, p *DeleteIpForwardingRuleParams) (*DeleteIpForwardingRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpForwardingRuleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteIpForwardingRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpForwardingRuleWithContext indicates an expected call of DeleteIpForwardingRuleWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) DeleteIpForwardingRuleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpForwardingRuleWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).DeleteIpForwardingRuleWithContext), ctx, p)
+}
+
 // DisableStaticNat mocks base method.
 func (m *MockNATServiceIface) DisableStaticNat(p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockNATServiceIfaceMockRecorder) DisableStaticNat(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableStaticNat", reflect.TypeOf((*MockNATServiceIface)(nil).DisableStaticNat), p)
 }
 
+// DisableStaticNatAsync mocks base method.
+func (m *MockNATServiceIface) DisableStaticNatAsync(ctx context.Context, p *DisableStaticNatParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableStaticNatAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableStaticNatAsync indicates an expected call of DisableStaticNatAsync.
+func (mr *MockNATServiceIfaceMockRecorder) DisableStaticNatAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableStaticNatAsync", reflect.TypeOf((*MockNATServiceIface)(nil).DisableStaticNatAsync), ctx, p)
+}
+
+// DisableStaticNatWithContext mocks base method.
+func (m *MockNATServiceIface) DisableStaticNatWithContext(ctx context.Context, p *DisableStaticNatParams) (*DisableStaticNatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableStaticNatWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableStaticNatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableStaticNatWithContext indicates an expected call of DisableStaticNatWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) DisableStaticNatWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableStaticNatWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).DisableStaticNatWithContext), ctx, p)
+}
+
 // EnableStaticNat mocks base method.
 func (m *MockNATServiceIface) EnableStaticNat(p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,21 @@ func (mr *MockNATServiceIfaceMockRecorder) EnableStaticNat(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStaticNat", reflect.TypeOf((*MockNATServiceIface)(nil).EnableStaticNat), p)
 }
 
+// EnableStaticNatWithContext mocks base method.
+func (m *MockNATServiceIface) EnableStaticNatWithContext(ctx context.Context, p *EnableStaticNatParams) (*EnableStaticNatResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableStaticNatWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableStaticNatResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableStaticNatWithContext indicates an expected call of EnableStaticNatWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) EnableStaticNatWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStaticNatWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).EnableStaticNatWithContext), ctx, p)
+}
+
 // GetIpForwardingRuleByID mocks base method.
 func (m *MockNATServiceIface) GetIpForwardingRuleByID(id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +246,27 @@ func (mr *MockNATServiceIfaceMockRecorder) GetIpForwardingRuleByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpForwardingRuleByID", reflect.TypeOf((*MockNATServiceIface)(nil).GetIpForwardingRuleByID), varargs...)
 }
 
+// GetIpForwardingRuleByIDWithContext mocks base method.
+func (m *MockNATServiceIface) GetIpForwardingRuleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*IpForwardingRule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIpForwardingRuleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*IpForwardingRule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIpForwardingRuleByIDWithContext indicates an expected call of GetIpForwardingRuleByIDWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) GetIpForwardingRuleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpForwardingRuleByIDWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).GetIpForwardingRuleByIDWithContext), varargs...)
+}
+
 // ListIpForwardingRules mocks base method.
 func (m *MockNATServiceIface) ListIpForwardingRules(p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,8 +282,101 @@ func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRules(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRules", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRules), p)
 }
 
+// ListIpForwardingRulesAll mocks base method.
+func (m *MockNATServiceIface) ListIpForwardingRulesAll(p *ListIpForwardingRulesParams, opts ...PageOption) ([]*IpForwardingRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpForwardingRulesAll", varargs...)
+	ret0, _ := ret[0].([]*IpForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpForwardingRulesAll indicates an expected call of ListIpForwardingRulesAll.
+func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRulesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRulesAll", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRulesAll), varargs...)
+}
+
+// ListIpForwardingRulesAllWithContext mocks base method.
+func (m *MockNATServiceIface) ListIpForwardingRulesAllWithContext(ctx context.Context, p *ListIpForwardingRulesParams, opts ...PageOption) ([]*IpForwardingRule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpForwardingRulesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*IpForwardingRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpForwardingRulesAllWithContext indicates an expected call of ListIpForwardingRulesAllWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRulesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRulesAllWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRulesAllWithContext), varargs...)
+}
+
+// ListIpForwardingRulesIter mocks base method.
+func (m *MockNATServiceIface) ListIpForwardingRulesIter(p *ListIpForwardingRulesParams, opts ...PageOption) iter.Seq2[*IpForwardingRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpForwardingRulesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*IpForwardingRule, error])
+	return ret0
+}
+
+// ListIpForwardingRulesIter indicates an expected call of ListIpForwardingRulesIter.
+func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRulesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRulesIter", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRulesIter), varargs...)
+}
+
+// ListIpForwardingRulesIterWithContext mocks base method.
+func (m *MockNATServiceIface) ListIpForwardingRulesIterWithContext(ctx context.Context, p *ListIpForwardingRulesParams, opts ...PageOption) iter.Seq2[*IpForwardingRule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpForwardingRulesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*IpForwardingRule, error])
+	return ret0
+}
+
+// ListIpForwardingRulesIterWithContext indicates an expected call of ListIpForwardingRulesIterWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRulesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRulesIterWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRulesIterWithContext), varargs...)
+}
+
+// ListIpForwardingRulesWithContext mocks base method.
+func (m *MockNATServiceIface) ListIpForwardingRulesWithContext(ctx context.Context, p *ListIpForwardingRulesParams) (*ListIpForwardingRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpForwardingRulesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListIpForwardingRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpForwardingRulesWithContext indicates an expected call of ListIpForwardingRulesWithContext.
+func (mr *MockNATServiceIfaceMockRecorder) ListIpForwardingRulesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpForwardingRulesWithContext", reflect.TypeOf((*MockNATServiceIface)(nil).ListIpForwardingRulesWithContext), ctx, p)
+}
+
 // NewCreateIpForwardingRuleParams mocks base method.
-func (m *MockNATServiceIface) NewCreateIpForwardingRuleParams(ipaddressid, protocol string, startport int) *CreateIpForwardingRuleParams {
+func (m *MockNATServiceIface) NewCreateIpForwardingRuleParams(ipaddressid string, protocol Protocol, startport int) *CreateIpForwardingRuleParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateIpForwardingRuleParams", ipaddressid, protocol, startport)
 	ret0, _ := ret[0].(*CreateIpForwardingRuleParams)
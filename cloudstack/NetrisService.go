@@ -20,17 +20,26 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type NetrisServiceIface interface {
 	AddNetrisProvider(p *AddNetrisProviderParams) (*AddNetrisProviderResponse, error)
+	AddNetrisProviderWithContext(ctx context.Context, p *AddNetrisProviderParams) (*AddNetrisProviderResponse, error)
 	NewAddNetrisProviderParams(name string, netristag string, netrisurl string, password string, sitename string, tenantname string, username string, zoneid string) *AddNetrisProviderParams
 	DeleteNetrisProvider(p *DeleteNetrisProviderParams) (*DeleteNetrisProviderResponse, error)
+	DeleteNetrisProviderWithContext(ctx context.Context, p *DeleteNetrisProviderParams) (*DeleteNetrisProviderResponse, error)
 	NewDeleteNetrisProviderParams(id string) *DeleteNetrisProviderParams
 	ListNetrisProviders(p *ListNetrisProvidersParams) (*ListNetrisProvidersResponse, error)
+	ListNetrisProvidersWithContext(ctx context.Context, p *ListNetrisProvidersParams) (*ListNetrisProvidersResponse, error)
+	ListNetrisProvidersAll(p *ListNetrisProvidersParams, opts ...PageOption) ([]*NetrisProvider, error)
+	ListNetrisProvidersAllWithContext(ctx context.Context, p *ListNetrisProvidersParams, opts ...PageOption) ([]*NetrisProvider, error)
+	ListNetrisProvidersIter(p *ListNetrisProvidersParams, opts ...PageOption) iter.Seq2[*NetrisProvider, error]
+	ListNetrisProvidersIterWithContext(ctx context.Context, p *ListNetrisProvidersParams, opts ...PageOption) iter.Seq2[*NetrisProvider, error]
 	NewListNetrisProvidersParams() *ListNetrisProvidersParams
 }
 
@@ -70,6 +79,51 @@ func (p *AddNetrisProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNetrisProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNetrisProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNetrisProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNetrisProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNetrisProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "netristag", "netrisurl", "password", "sitename", "tenantname", "username", "zoneid"},
+		lengths:  map[string]int{"name": 255, "netristag": 255, "netrisurl": 255, "password": 255, "sitename": 255, "tenantname": 255, "username": 255, "zoneid": 255},
+	}.validate("addNetrisProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNetrisProvider API, before the request is signed
+func (p *AddNetrisProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNetrisProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNetrisProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNetrisProvider", b, &p.p, map[string]paramDecoder{
+		"name":       decodeParam[string],
+		"netristag":  decodeParam[string],
+		"netrisurl":  decodeParam[string],
+		"password":   decodeParam[string],
+		"sitename":   decodeParam[string],
+		"tenantname": decodeParam[string],
+		"username":   decodeParam[string],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNetrisProviderParams) Clone() *AddNetrisProviderParams {
+	return &AddNetrisProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNetrisProviderParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -256,13 +310,22 @@ func (s *NetrisService) NewAddNetrisProviderParams(name string, netristag string
 
 // Add Netris Provider to CloudStack
 func (s *NetrisService) AddNetrisProvider(p *AddNetrisProviderParams) (*AddNetrisProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("addNetrisProvider", p.toURLValues())
+	return s.AddNetrisProviderWithContext(context.Background(), p)
+}
+
+// AddNetrisProviderWithContext is like AddNetrisProvider, but honours the cancellation and deadline of ctx
+func (s *NetrisService) AddNetrisProviderWithContext(ctx context.Context, p *AddNetrisProviderParams) (*AddNetrisProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addNetrisProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNetrisProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNetrisProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -270,16 +333,22 @@ func (s *NetrisService) AddNetrisProvider(p *AddNetrisProviderParams) (*AddNetri
 }
 
 type AddNetrisProviderResponse struct {
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
-	Netristag  string `json:"netristag"`
-	Netrisurl  string `json:"netrisurl"`
-	Sitename   string `json:"sitename"`
-	Tenantname string `json:"tenantname"`
-	Uuid       string `json:"uuid"`
-	Zoneid     string `json:"zoneid"`
-	Zonename   string `json:"zonename"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Netristag  string                     `json:"netristag"`
+	Netrisurl  string                     `json:"netrisurl"`
+	Sitename   string                     `json:"sitename"`
+	Tenantname string                     `json:"tenantname"`
+	Uuid       string                     `json:"uuid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNetrisProviderResponse) UnmarshalJSON(b []byte) error {
+	type alias AddNetrisProviderResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetrisProviderParams struct {
@@ -297,6 +366,44 @@ func (p *DeleteNetrisProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetrisProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetrisProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetrisProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetrisProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetrisProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetrisProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetrisProvider API, before the request is signed
+func (p *DeleteNetrisProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetrisProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetrisProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetrisProvider", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetrisProviderParams) Clone() *DeleteNetrisProviderParams {
+	return &DeleteNetrisProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetrisProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -329,13 +436,22 @@ func (s *NetrisService) NewDeleteNetrisProviderParams(id string) *DeleteNetrisPr
 
 // delete Netris Provider to CloudStack
 func (s *NetrisService) DeleteNetrisProvider(p *DeleteNetrisProviderParams) (*DeleteNetrisProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetrisProvider", p.toURLValues())
+	return s.DeleteNetrisProviderWithContext(context.Background(), p)
+}
+
+// DeleteNetrisProviderWithContext is like DeleteNetrisProvider, but honours the cancellation and deadline of ctx
+func (s *NetrisService) DeleteNetrisProviderWithContext(ctx context.Context, p *DeleteNetrisProviderParams) (*DeleteNetrisProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetrisProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetrisProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetrisProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -343,16 +459,22 @@ func (s *NetrisService) DeleteNetrisProvider(p *DeleteNetrisProviderParams) (*De
 }
 
 type DeleteNetrisProviderResponse struct {
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
-	Netristag  string `json:"netristag"`
-	Netrisurl  string `json:"netrisurl"`
-	Sitename   string `json:"sitename"`
-	Tenantname string `json:"tenantname"`
-	Uuid       string `json:"uuid"`
-	Zoneid     string `json:"zoneid"`
-	Zonename   string `json:"zonename"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Netristag  string                     `json:"netristag"`
+	Netrisurl  string                     `json:"netrisurl"`
+	Sitename   string                     `json:"sitename"`
+	Tenantname string                     `json:"tenantname"`
+	Uuid       string                     `json:"uuid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNetrisProviderResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNetrisProviderResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetrisProvidersParams struct {
@@ -381,6 +503,46 @@ func (p *ListNetrisProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetrisProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetrisProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetrisProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetrisProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetrisProvidersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "zoneid": 255},
+	}.validate("listNetrisProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetrisProviders API, before the request is signed
+func (p *ListNetrisProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetrisProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetrisProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetrisProviders", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetrisProvidersParams) Clone() *ListNetrisProvidersParams {
+	return &ListNetrisProvidersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetrisProvidersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -475,33 +637,76 @@ func (s *NetrisService) NewListNetrisProvidersParams() *ListNetrisProvidersParam
 
 // list all Netris providers added to CloudStack
 func (s *NetrisService) ListNetrisProviders(p *ListNetrisProvidersParams) (*ListNetrisProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listNetrisProviders", p.toURLValues())
+	return s.ListNetrisProvidersWithContext(context.Background(), p)
+}
+
+// ListNetrisProvidersWithContext is like ListNetrisProviders, but honours the cancellation and deadline of ctx
+func (s *NetrisService) ListNetrisProvidersWithContext(ctx context.Context, p *ListNetrisProvidersParams) (*ListNetrisProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetrisProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetrisProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetrisProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetrisProvidersAll returns all NetrisProviders matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetrisService) ListNetrisProvidersAll(p *ListNetrisProvidersParams, opts ...PageOption) ([]*NetrisProvider, error) {
+	return s.ListNetrisProvidersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetrisProvidersAllWithContext is like ListNetrisProvidersAll, but honours the cancellation and deadline of ctx
+func (s *NetrisService) ListNetrisProvidersAllWithContext(ctx context.Context, p *ListNetrisProvidersParams, opts ...PageOption) ([]*NetrisProvider, error) {
+	return collectPages(s.ListNetrisProvidersIterWithContext(ctx, p, opts...))
+}
+
+// ListNetrisProvidersIter returns an iterator over all NetrisProviders matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetrisService) ListNetrisProvidersIter(p *ListNetrisProvidersParams, opts ...PageOption) iter.Seq2[*NetrisProvider, error] {
+	return s.ListNetrisProvidersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetrisProvidersIterWithContext is like ListNetrisProvidersIter, but honours the cancellation and deadline of ctx
+func (s *NetrisService) ListNetrisProvidersIterWithContext(ctx context.Context, p *ListNetrisProvidersParams, opts ...PageOption) iter.Seq2[*NetrisProvider, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetrisProvider, int, error) {
+		l, err := s.ListNetrisProvidersWithContext(ctx, &ListNetrisProvidersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetrisProviders, l.Count, nil
+	}, opts...)
+}
+
 type ListNetrisProvidersResponse struct {
 	Count           int               `json:"count"`
 	NetrisProviders []*NetrisProvider `json:"netrisprovider"`
 }
 
 type NetrisProvider struct {
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Name       string `json:"name"`
-	Netristag  string `json:"netristag"`
-	Netrisurl  string `json:"netrisurl"`
-	Sitename   string `json:"sitename"`
-	Tenantname string `json:"tenantname"`
-	Uuid       string `json:"uuid"`
-	Zoneid     string `json:"zoneid"`
-	Zonename   string `json:"zonename"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Name       string                     `json:"name"`
+	Netristag  string                     `json:"netristag"`
+	Netrisurl  string                     `json:"netrisurl"`
+	Sitename   string                     `json:"sitename"`
+	Tenantname string                     `json:"tenantname"`
+	Uuid       string                     `json:"uuid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetrisProvider) UnmarshalJSON(b []byte) error {
+	type alias NetrisProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
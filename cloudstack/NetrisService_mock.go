@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockNetrisServiceIfaceMockRecorder) AddNetrisProvider(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetrisProvider", reflect.TypeOf((*MockNetrisServiceIface)(nil).AddNetrisProvider), p)
 }
 
+// AddNetrisProviderWithContext mocks base method.
+func (m *MockNetrisServiceIface) AddNetrisProviderWithContext(ctx context.Context, p *AddNetrisProviderParams) (*AddNetrisProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetrisProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNetrisProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNetrisProviderWithContext indicates an expected call of AddNetrisProviderWithContext.
+func (mr *MockNetrisServiceIfaceMockRecorder) AddNetrisProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetrisProviderWithContext", reflect.TypeOf((*MockNetrisServiceIface)(nil).AddNetrisProviderWithContext), ctx, p)
+}
+
 // DeleteNetrisProvider mocks base method.
 func (m *MockNetrisServiceIface) DeleteNetrisProvider(p *DeleteNetrisProviderParams) (*DeleteNetrisProviderResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockNetrisServiceIfaceMockRecorder) DeleteNetrisProvider(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetrisProvider", reflect.TypeOf((*MockNetrisServiceIface)(nil).DeleteNetrisProvider), p)
 }
 
+// DeleteNetrisProviderWithContext mocks base method.
+func (m *MockNetrisServiceIface) DeleteNetrisProviderWithContext(ctx context.Context, p *DeleteNetrisProviderParams) (*DeleteNetrisProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetrisProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetrisProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetrisProviderWithContext indicates an expected call of DeleteNetrisProviderWithContext.
+func (mr *MockNetrisServiceIfaceMockRecorder) DeleteNetrisProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetrisProviderWithContext", reflect.TypeOf((*MockNetrisServiceIface)(nil).DeleteNetrisProviderWithContext), ctx, p)
+}
+
 // ListNetrisProviders mocks base method.
 func (m *MockNetrisServiceIface) ListNetrisProviders(p *ListNetrisProvidersParams) (*ListNetrisProvidersResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,99 @@ func (mr *MockNetrisServiceIfaceMockRecorder) ListNetrisProviders(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetrisProviders", reflect.TypeOf((*MockNetrisServiceIface)(nil).ListNetrisProviders), p)
 }
 
+// ListNetrisProvidersAll mocks base method.
+func (m *MockNetrisServiceIface) ListNetrisProvidersAll(p *ListNetrisProvidersParams, opts ...PageOption) ([]*NetrisProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetrisProvidersAll", varargs...)
+	ret0, _ := ret[0].([]*NetrisProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetrisProvidersAll indicates an expected call of ListNetrisProvidersAll.
+func (mr *MockNetrisServiceIfaceMockRecorder) ListNetrisProvidersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetrisProvidersAll", reflect.TypeOf((*MockNetrisServiceIface)(nil).ListNetrisProvidersAll), varargs...)
+}
+
+// ListNetrisProvidersAllWithContext mocks base method.
+func (m *MockNetrisServiceIface) ListNetrisProvidersAllWithContext(ctx context.Context, p *ListNetrisProvidersParams, opts ...PageOption) ([]*NetrisProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetrisProvidersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetrisProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetrisProvidersAllWithContext indicates an expected call of ListNetrisProvidersAllWithContext.
+func (mr *MockNetrisServiceIfaceMockRecorder) ListNetrisProvidersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetrisProvidersAllWithContext", reflect.TypeOf((*MockNetrisServiceIface)(nil).ListNetrisProvidersAllWithContext), varargs...)
+}
+
+// ListNetrisProvidersIter mocks base method.
+func (m *MockNetrisServiceIface) ListNetrisProvidersIter(p *ListNetrisProvidersParams, opts ...PageOption) iter.Seq2[*NetrisProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetrisProvidersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetrisProvider, error])
+	return ret0
+}
+
+// ListNetrisProvidersIter indicates an expected call of ListNetrisProvidersIter.
+func (mr *MockNetrisServiceIfaceMockRecorder) ListNetrisProvidersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetrisProvidersIter", reflect.TypeOf((*MockNetrisServiceIface)(nil).ListNetrisProvidersIter), varargs...)
+}
+
+// ListNetrisProvidersIterWithContext mocks base method.
+func (m *MockNetrisServiceIface) ListNetrisProvidersIterWithContext(ctx context.Context, p *ListNetrisProvidersParams, opts ...PageOption) iter.Seq2[*NetrisProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetrisProvidersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetrisProvider, error])
+	return ret0
+}
+
+// ListNetrisProvidersIterWithContext indicates an expected call of ListNetrisProvidersIterWithContext.
+func (mr *MockNetrisServiceIfaceMockRecorder) ListNetrisProvidersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetrisProvidersIterWithContext", reflect.TypeOf((*MockNetrisServiceIface)(nil).ListNetrisProvidersIterWithContext), varargs...)
+}
+
+// ListNetrisProvidersWithContext mocks base method.
+func (m *MockNetrisServiceIface) ListNetrisProvidersWithContext(ctx context.Context, p *ListNetrisProvidersParams) (*ListNetrisProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetrisProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetrisProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetrisProvidersWithContext indicates an expected call of ListNetrisProvidersWithContext.
+func (mr *MockNetrisServiceIfaceMockRecorder) ListNetrisProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetrisProvidersWithContext", reflect.TypeOf((*MockNetrisServiceIface)(nil).ListNetrisProvidersWithContext), ctx, p)
+}
+
 // NewAddNetrisProviderParams mocks base method.
 func (m *MockNetrisServiceIface) NewAddNetrisProviderParams(name, netristag, netrisurl, password, sitename, tenantname, username, zoneid string) *AddNetrisProviderParams {
 	m.ctrl.T.Helper()
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,23 +31,49 @@ import (
 
 type NetscalerServiceIface interface {
 	AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error)
+	AddNetscalerLoadBalancerAsync(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*Job, error)
 	NewAddNetscalerLoadBalancerParams(networkdevicetype string, password string, physicalnetworkid string, url string, username string) *AddNetscalerLoadBalancerParams
 	ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error)
+	ConfigureNetscalerLoadBalancerAsync(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*Job, error)
 	NewConfigureNetscalerLoadBalancerParams(lbdeviceid string) *ConfigureNetscalerLoadBalancerParams
 	DeleteNetscalerControlCenter(p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error)
+	DeleteNetscalerControlCenterWithContext(ctx context.Context, p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error)
 	NewDeleteNetscalerControlCenterParams(id string) *DeleteNetscalerControlCenterParams
 	DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error)
+	DeleteNetscalerLoadBalancerAsync(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*Job, error)
 	NewDeleteNetscalerLoadBalancerParams(lbdeviceid string) *DeleteNetscalerLoadBalancerParams
 	ListNetscalerControlCenter(p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error)
+	ListNetscalerControlCenterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error)
+	ListNetscalerControlCenterAll(p *ListNetscalerControlCenterParams, opts ...PageOption) ([]*NetscalerControlCenter, error)
+	ListNetscalerControlCenterAllWithContext(ctx context.Context, p *ListNetscalerControlCenterParams, opts ...PageOption) ([]*NetscalerControlCenter, error)
+	ListNetscalerControlCenterIter(p *ListNetscalerControlCenterParams, opts ...PageOption) iter.Seq2[*NetscalerControlCenter, error]
+	ListNetscalerControlCenterIterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams, opts ...PageOption) iter.Seq2[*NetscalerControlCenter, error]
 	NewListNetscalerControlCenterParams() *ListNetscalerControlCenterParams
 	ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error)
+	ListNetscalerLoadBalancerNetworksAll(p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) ([]*NetscalerLoadBalancerNetwork, error)
+	ListNetscalerLoadBalancerNetworksAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) ([]*NetscalerLoadBalancerNetwork, error)
+	ListNetscalerLoadBalancerNetworksIter(p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancerNetwork, error]
+	ListNetscalerLoadBalancerNetworksIterWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancerNetwork, error]
 	NewListNetscalerLoadBalancerNetworksParams(lbdeviceid string) *ListNetscalerLoadBalancerNetworksParams
 	GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetNetscalerLoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
 	ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error)
+	ListNetscalerLoadBalancersAll(p *ListNetscalerLoadBalancersParams, opts ...PageOption) ([]*NetscalerLoadBalancer, error)
+	ListNetscalerLoadBalancersAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams, opts ...PageOption) ([]*NetscalerLoadBalancer, error)
+	ListNetscalerLoadBalancersIter(p *ListNetscalerLoadBalancersParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancer, error]
+	ListNetscalerLoadBalancersIterWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancer, error]
 	NewListNetscalerLoadBalancersParams() *ListNetscalerLoadBalancersParams
 	RegisterNetscalerControlCenter(p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error)
+	RegisterNetscalerControlCenterWithContext(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error)
+	RegisterNetscalerControlCenterAsync(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*Job, error)
 	NewRegisterNetscalerControlCenterParams(ipaddress string, numretries int, password string, username string) *RegisterNetscalerControlCenterParams
 	RegisterNetscalerServicePackage(p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error)
+	RegisterNetscalerServicePackageWithContext(ctx context.Context, p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error)
 	NewRegisterNetscalerServicePackageParams(description string, name string) *RegisterNetscalerServicePackageParams
 }
 
@@ -90,6 +118,52 @@ func (p *AddNetscalerLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNetscalerLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNetscalerLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNetscalerLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNetscalerLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNetscalerLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"networkdevicetype", "password", "physicalnetworkid", "url", "username"},
+		lengths:  map[string]int{"gslbproviderprivateip": 255, "gslbproviderpublicip": 255, "networkdevicetype": 255, "password": 255, "physicalnetworkid": 255, "url": 2048, "username": 255},
+	}.validate("addNetscalerLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNetscalerLoadBalancer API, before the request is signed
+func (p *AddNetscalerLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNetscalerLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"gslbprovider":            decodeParam[bool],
+		"gslbproviderprivateip":   decodeParam[string],
+		"gslbproviderpublicip":    decodeParam[string],
+		"isexclusivegslbprovider": decodeParam[bool],
+		"networkdevicetype":       decodeParam[string],
+		"password":                decodeParam[string],
+		"physicalnetworkid":       decodeParam[string],
+		"url":                     decodeParam[string],
+		"username":                decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNetscalerLoadBalancerParams) Clone() *AddNetscalerLoadBalancerParams {
+	return &AddNetscalerLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNetscalerLoadBalancerParams) SetGslbprovider(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -294,32 +368,39 @@ func (s *NetscalerService) NewAddNetscalerLoadBalancerParams(networkdevicetype s
 
 // Adds a netscaler load balancer device
 func (s *NetscalerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("addNetscalerLoadBalancer", p.toURLValues())
+	return s.AddNetscalerLoadBalancerWithContext(context.Background(), p)
+}
+
+// AddNetscalerLoadBalancerWithContext is like AddNetscalerLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) AddNetscalerLoadBalancerWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addNetscalerLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNetscalerLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addNetscalerLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addNetscalerLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -327,24 +408,49 @@ func (s *NetscalerService) AddNetscalerLoadBalancer(p *AddNetscalerLoadBalancerP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddNetscalerLoadBalancer async job into r
+func (r *AddNetscalerLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddNetscalerLoadBalancerAsync starts the async job of AddNetscalerLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *NetscalerService) AddNetscalerLoadBalancerAsync(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*Job, error) {
+	r, err := s.AddNetscalerLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addNetscalerLoadBalancer", r.JobID), nil
+}
+
 type AddNetscalerLoadBalancerResponse struct {
-	Gslbprovider            bool     `json:"gslbprovider"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
-	Ipaddress               string   `json:"ipaddress"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
-	JobID                   string   `json:"jobid"`
-	Jobstatus               int      `json:"jobstatus"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated"`
-	Lbdeviceid              string   `json:"lbdeviceid"`
-	Lbdevicename            string   `json:"lbdevicename"`
-	Lbdevicestate           string   `json:"lbdevicestate"`
-	Physicalnetworkid       string   `json:"physicalnetworkid"`
-	Podids                  []string `json:"podids"`
-	Privateinterface        string   `json:"privateinterface"`
-	Provider                string   `json:"provider"`
-	Publicinterface         string   `json:"publicinterface"`
+	Gslbprovider            FlexBool                   `json:"gslbprovider"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip"`
+	Ipaddress               string                     `json:"ipaddress"`
+	Isexclusivegslbprovider FlexBool                   `json:"isexclusivegslbprovider"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Lbdevicecapacity        FlexInt64                  `json:"lbdevicecapacity"`
+	Lbdevicededicated       FlexBool                   `json:"lbdevicededicated"`
+	Lbdeviceid              string                     `json:"lbdeviceid"`
+	Lbdevicename            string                     `json:"lbdevicename"`
+	Lbdevicestate           string                     `json:"lbdevicestate"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid"`
+	Podids                  []string                   `json:"podids"`
+	Privateinterface        string                     `json:"privateinterface"`
+	Provider                string                     `json:"provider"`
+	Publicinterface         string                     `json:"publicinterface"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNetscalerLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias AddNetscalerLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ConfigureNetscalerLoadBalancerParams struct {
@@ -378,6 +484,48 @@ func (p *ConfigureNetscalerLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configureNetscalerLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigureNetscalerLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configureNetscalerLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configureNetscalerLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigureNetscalerLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"lbdeviceid"},
+		lengths:  map[string]int{"lbdeviceid": 255},
+	}.validate("configureNetscalerLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configureNetscalerLoadBalancer API, before the request is signed
+func (p *ConfigureNetscalerLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigureNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigureNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configureNetscalerLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"inline":            decodeParam[bool],
+		"lbdevicecapacity":  decodeParam[int64],
+		"lbdevicededicated": decodeParam[bool],
+		"lbdeviceid":        decodeParam[string],
+		"podids":            decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigureNetscalerLoadBalancerParams) Clone() *ConfigureNetscalerLoadBalancerParams {
+	return &ConfigureNetscalerLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigureNetscalerLoadBalancerParams) SetInline(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -494,32 +642,39 @@ func (s *NetscalerService) NewConfigureNetscalerLoadBalancerParams(lbdeviceid st
 
 // Configures a netscaler load balancer device
 func (s *NetscalerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("configureNetscalerLoadBalancer", p.toURLValues())
+	return s.ConfigureNetscalerLoadBalancerWithContext(context.Background(), p)
+}
+
+// ConfigureNetscalerLoadBalancerWithContext is like ConfigureNetscalerLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "configureNetscalerLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r NetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configureNetscalerLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "configureNetscalerLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("configureNetscalerLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -527,24 +682,49 @@ func (s *NetscalerService) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerL
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ConfigureNetscalerLoadBalancer async job into r
+func (r *NetscalerLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ConfigureNetscalerLoadBalancerAsync starts the async job of ConfigureNetscalerLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *NetscalerService) ConfigureNetscalerLoadBalancerAsync(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*Job, error) {
+	r, err := s.ConfigureNetscalerLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("configureNetscalerLoadBalancer", r.JobID), nil
+}
+
 type NetscalerLoadBalancerResponse struct {
-	Gslbprovider            bool     `json:"gslbprovider"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
-	Ipaddress               string   `json:"ipaddress"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
-	JobID                   string   `json:"jobid"`
-	Jobstatus               int      `json:"jobstatus"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated"`
-	Lbdeviceid              string   `json:"lbdeviceid"`
-	Lbdevicename            string   `json:"lbdevicename"`
-	Lbdevicestate           string   `json:"lbdevicestate"`
-	Physicalnetworkid       string   `json:"physicalnetworkid"`
-	Podids                  []string `json:"podids"`
-	Privateinterface        string   `json:"privateinterface"`
-	Provider                string   `json:"provider"`
-	Publicinterface         string   `json:"publicinterface"`
+	Gslbprovider            FlexBool                   `json:"gslbprovider"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip"`
+	Ipaddress               string                     `json:"ipaddress"`
+	Isexclusivegslbprovider FlexBool                   `json:"isexclusivegslbprovider"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Lbdevicecapacity        FlexInt64                  `json:"lbdevicecapacity"`
+	Lbdevicededicated       FlexBool                   `json:"lbdevicededicated"`
+	Lbdeviceid              string                     `json:"lbdeviceid"`
+	Lbdevicename            string                     `json:"lbdevicename"`
+	Lbdevicestate           string                     `json:"lbdevicestate"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid"`
+	Podids                  []string                   `json:"podids"`
+	Privateinterface        string                     `json:"privateinterface"`
+	Provider                string                     `json:"provider"`
+	Publicinterface         string                     `json:"publicinterface"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetscalerLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias NetscalerLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetscalerControlCenterParams struct {
@@ -562,6 +742,44 @@ func (p *DeleteNetscalerControlCenterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetscalerControlCenter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetscalerControlCenterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetscalerControlCenter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetscalerControlCenter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetscalerControlCenterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetscalerControlCenter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetscalerControlCenter API, before the request is signed
+func (p *DeleteNetscalerControlCenterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetscalerControlCenterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetscalerControlCenterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetscalerControlCenter", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetscalerControlCenterParams) Clone() *DeleteNetscalerControlCenterParams {
+	return &DeleteNetscalerControlCenterParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetscalerControlCenterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -594,13 +812,22 @@ func (s *NetscalerService) NewDeleteNetscalerControlCenterParams(id string) *Del
 
 // Delete Netscaler Control Center
 func (s *NetscalerService) DeleteNetscalerControlCenter(p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetscalerControlCenter", p.toURLValues())
+	return s.DeleteNetscalerControlCenterWithContext(context.Background(), p)
+}
+
+// DeleteNetscalerControlCenterWithContext is like DeleteNetscalerControlCenter, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) DeleteNetscalerControlCenterWithContext(ctx context.Context, p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetscalerControlCenter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetscalerControlCenterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetscalerControlCenter", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -608,10 +835,11 @@ func (s *NetscalerService) DeleteNetscalerControlCenter(p *DeleteNetscalerContro
 }
 
 type DeleteNetscalerControlCenterResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteNetscalerControlCenterResponse) UnmarshalJSON(b []byte) error {
@@ -638,7 +866,7 @@ func (r *DeleteNetscalerControlCenterResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteNetscalerControlCenterResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetscalerLoadBalancerParams struct {
@@ -656,6 +884,44 @@ func (p *DeleteNetscalerLoadBalancerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetscalerLoadBalancer API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetscalerLoadBalancerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetscalerLoadBalancer", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetscalerLoadBalancer API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetscalerLoadBalancerParams) Validate() error {
+	return paramRules{
+		required: []string{"lbdeviceid"},
+		lengths:  map[string]int{"lbdeviceid": 255},
+	}.validate("deleteNetscalerLoadBalancer", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetscalerLoadBalancer API, before the request is signed
+func (p *DeleteNetscalerLoadBalancerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetscalerLoadBalancerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetscalerLoadBalancerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetscalerLoadBalancer", b, &p.p, map[string]paramDecoder{
+		"lbdeviceid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetscalerLoadBalancerParams) Clone() *DeleteNetscalerLoadBalancerParams {
+	return &DeleteNetscalerLoadBalancerParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetscalerLoadBalancerParams) SetLbdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -688,27 +954,39 @@ func (s *NetscalerService) NewDeleteNetscalerLoadBalancerParams(lbdeviceid strin
 
 // delete a netscaler load balancer device
 func (s *NetscalerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetscalerLoadBalancer", p.toURLValues())
+	return s.DeleteNetscalerLoadBalancerWithContext(context.Background(), p)
+}
+
+// DeleteNetscalerLoadBalancerWithContext is like DeleteNetscalerLoadBalancer, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetscalerLoadBalancer", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetscalerLoadBalancerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetscalerLoadBalancer", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteNetscalerLoadBalancer", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteNetscalerLoadBalancer", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -716,11 +994,31 @@ func (s *NetscalerService) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBal
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteNetscalerLoadBalancer async job into r
+func (r *DeleteNetscalerLoadBalancerResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteNetscalerLoadBalancerAsync starts the async job of DeleteNetscalerLoadBalancer without waiting for it to finish, and returns a handle to the job
+func (s *NetscalerService) DeleteNetscalerLoadBalancerAsync(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*Job, error) {
+	r, err := s.DeleteNetscalerLoadBalancerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteNetscalerLoadBalancer", r.JobID), nil
+}
+
 type DeleteNetscalerLoadBalancerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNetscalerLoadBalancerResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNetscalerLoadBalancerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetscalerControlCenterParams struct {
@@ -746,6 +1044,45 @@ func (p *ListNetscalerControlCenterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetscalerControlCenter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetscalerControlCenterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetscalerControlCenter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetscalerControlCenter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetscalerControlCenterParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listNetscalerControlCenter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetscalerControlCenter API, before the request is signed
+func (p *ListNetscalerControlCenterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetscalerControlCenterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetscalerControlCenterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetscalerControlCenter", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetscalerControlCenterParams) Clone() *ListNetscalerControlCenterParams {
+	return &ListNetscalerControlCenterParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetscalerControlCenterParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -819,32 +1156,75 @@ func (s *NetscalerService) NewListNetscalerControlCenterParams() *ListNetscalerC
 
 // List control center
 func (s *NetscalerService) ListNetscalerControlCenter(p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerControlCenter", p.toURLValues())
+	return s.ListNetscalerControlCenterWithContext(context.Background(), p)
+}
+
+// ListNetscalerControlCenterWithContext is like ListNetscalerControlCenter, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerControlCenterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetscalerControlCenter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetscalerControlCenterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetscalerControlCenter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetscalerControlCenterAll returns all NetscalerControlCenter matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetscalerService) ListNetscalerControlCenterAll(p *ListNetscalerControlCenterParams, opts ...PageOption) ([]*NetscalerControlCenter, error) {
+	return s.ListNetscalerControlCenterAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetscalerControlCenterAllWithContext is like ListNetscalerControlCenterAll, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerControlCenterAllWithContext(ctx context.Context, p *ListNetscalerControlCenterParams, opts ...PageOption) ([]*NetscalerControlCenter, error) {
+	return collectPages(s.ListNetscalerControlCenterIterWithContext(ctx, p, opts...))
+}
+
+// ListNetscalerControlCenterIter returns an iterator over all NetscalerControlCenter matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetscalerService) ListNetscalerControlCenterIter(p *ListNetscalerControlCenterParams, opts ...PageOption) iter.Seq2[*NetscalerControlCenter, error] {
+	return s.ListNetscalerControlCenterIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetscalerControlCenterIterWithContext is like ListNetscalerControlCenterIter, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerControlCenterIterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams, opts ...PageOption) iter.Seq2[*NetscalerControlCenter, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetscalerControlCenter, int, error) {
+		l, err := s.ListNetscalerControlCenterWithContext(ctx, &ListNetscalerControlCenterParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetscalerControlCenter, l.Count, nil
+	}, opts...)
+}
+
 type ListNetscalerControlCenterResponse struct {
 	Count                  int                       `json:"count"`
 	NetscalerControlCenter []*NetscalerControlCenter `json:"netscalercontrolcenter"`
 }
 
 type NetscalerControlCenter struct {
-	Id         string `json:"id"`
-	Ipaddress  string `json:"ipaddress"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Numretries string `json:"numretries"`
-	Username   string `json:"username"`
-	Uuid       string `json:"uuid"`
+	Id         string                     `json:"id"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Numretries string                     `json:"numretries"`
+	Username   string                     `json:"username"`
+	Uuid       string                     `json:"uuid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetscalerControlCenter) UnmarshalJSON(b []byte) error {
+	type alias NetscalerControlCenter
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetscalerLoadBalancerNetworksParams struct {
@@ -873,6 +1253,47 @@ func (p *ListNetscalerLoadBalancerNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetscalerLoadBalancerNetworks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetscalerLoadBalancerNetworksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetscalerLoadBalancerNetworks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetscalerLoadBalancerNetworks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetscalerLoadBalancerNetworksParams) Validate() error {
+	return paramRules{
+		required: []string{"lbdeviceid"},
+		lengths:  map[string]int{"keyword": 255, "lbdeviceid": 255},
+	}.validate("listNetscalerLoadBalancerNetworks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetscalerLoadBalancerNetworks API, before the request is signed
+func (p *ListNetscalerLoadBalancerNetworksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetscalerLoadBalancerNetworksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetscalerLoadBalancerNetworksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetscalerLoadBalancerNetworks", b, &p.p, map[string]paramDecoder{
+		"keyword":    decodeParam[string],
+		"lbdeviceid": decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetscalerLoadBalancerNetworksParams) Clone() *ListNetscalerLoadBalancerNetworksParams {
+	return &ListNetscalerLoadBalancerNetworksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetscalerLoadBalancerNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -968,6 +1389,11 @@ func (s *NetscalerService) NewListNetscalerLoadBalancerNetworksParams(lbdeviceid
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetscalerService) GetNetscalerLoadBalancerNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetscalerLoadBalancerNetworkIDWithContext(context.Background(), keyword, lbdeviceid, opts...)
+}
+
+// GetNetscalerLoadBalancerNetworkIDWithContext is like GetNetscalerLoadBalancerNetworkID, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) GetNetscalerLoadBalancerNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetscalerLoadBalancerNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -980,7 +1406,7 @@ func (s *NetscalerService) GetNetscalerLoadBalancerNetworkID(keyword string, lbd
 		}
 	}
 
-	l, err := s.ListNetscalerLoadBalancerNetworks(p)
+	l, err := s.ListNetscalerLoadBalancerNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1005,19 +1431,56 @@ func (s *NetscalerService) GetNetscalerLoadBalancerNetworkID(keyword string, lbd
 
 // Lists network that are using a netscaler load balancer device
 func (s *NetscalerService) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancerNetworks", p.toURLValues())
+	return s.ListNetscalerLoadBalancerNetworksWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancerNetworksWithContext is like ListNetscalerLoadBalancerNetworks, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetscalerLoadBalancerNetworks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetscalerLoadBalancerNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetscalerLoadBalancerNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetscalerLoadBalancerNetworksAll returns all NetscalerLoadBalancerNetworks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetscalerService) ListNetscalerLoadBalancerNetworksAll(p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) ([]*NetscalerLoadBalancerNetwork, error) {
+	return s.ListNetscalerLoadBalancerNetworksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetscalerLoadBalancerNetworksAllWithContext is like ListNetscalerLoadBalancerNetworksAll, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerLoadBalancerNetworksAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) ([]*NetscalerLoadBalancerNetwork, error) {
+	return collectPages(s.ListNetscalerLoadBalancerNetworksIterWithContext(ctx, p, opts...))
+}
+
+// ListNetscalerLoadBalancerNetworksIter returns an iterator over all NetscalerLoadBalancerNetworks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetscalerService) ListNetscalerLoadBalancerNetworksIter(p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancerNetwork, error] {
+	return s.ListNetscalerLoadBalancerNetworksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetscalerLoadBalancerNetworksIterWithContext is like ListNetscalerLoadBalancerNetworksIter, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerLoadBalancerNetworksIterWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancerNetwork, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetscalerLoadBalancerNetwork, int, error) {
+		l, err := s.ListNetscalerLoadBalancerNetworksWithContext(ctx, &ListNetscalerLoadBalancerNetworksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetscalerLoadBalancerNetworks, l.Count, nil
+	}, opts...)
+}
+
 type ListNetscalerLoadBalancerNetworksResponse struct {
 	Count                         int                             `json:"count"`
 	NetscalerLoadBalancerNetworks []*NetscalerLoadBalancerNetwork `json:"netscalerloadbalancernetwork"`
@@ -1028,28 +1491,28 @@ type NetscalerLoadBalancerNetwork struct {
 	Aclid                       string                                `json:"aclid"`
 	Aclname                     string                                `json:"aclname"`
 	Acltype                     string                                `json:"acltype"`
-	Asnumber                    int64                                 `json:"asnumber"`
+	Asnumber                    FlexInt64                             `json:"asnumber"`
 	Asnumberid                  string                                `json:"asnumberid"`
 	Associatednetwork           string                                `json:"associatednetwork"`
 	Associatednetworkid         string                                `json:"associatednetworkid"`
 	Bgppeers                    []interface{}                         `json:"bgppeers"`
 	Broadcastdomaintype         string                                `json:"broadcastdomaintype"`
 	Broadcasturi                string                                `json:"broadcasturi"`
-	Canusefordeploy             bool                                  `json:"canusefordeploy"`
+	Canusefordeploy             FlexBool                              `json:"canusefordeploy"`
 	Cidr                        string                                `json:"cidr"`
-	Created                     string                                `json:"created"`
+	Created                     Time                                  `json:"created"`
 	Details                     map[string]string                     `json:"details"`
-	Displaynetwork              bool                                  `json:"displaynetwork"`
+	Displaynetwork              FlexBool                              `json:"displaynetwork"`
 	Displaytext                 string                                `json:"displaytext"`
 	Dns1                        string                                `json:"dns1"`
 	Dns2                        string                                `json:"dns2"`
 	Domain                      string                                `json:"domain"`
 	Domainid                    string                                `json:"domainid"`
 	Domainpath                  string                                `json:"domainpath"`
-	Egressdefaultpolicy         bool                                  `json:"egressdefaultpolicy"`
+	Egressdefaultpolicy         FlexBool                              `json:"egressdefaultpolicy"`
 	Externalid                  string                                `json:"externalid"`
 	Gateway                     string                                `json:"gateway"`
-	Hasannotations              bool                                  `json:"hasannotations"`
+	Hasannotations              FlexBool                              `json:"hasannotations"`
 	Icon                        interface{}                           `json:"icon"`
 	Id                          string                                `json:"id"`
 	Internetprotocol            string                                `json:"internetprotocol"`
@@ -1061,38 +1524,38 @@ type NetscalerLoadBalancerNetwork struct {
 	Ip6gateway                  string                                `json:"ip6gateway"`
 	Ip6routes                   []interface{}                         `json:"ip6routes"`
 	Ip6routing                  string                                `json:"ip6routing"`
-	Isdefault                   bool                                  `json:"isdefault"`
-	Ispersistent                bool                                  `json:"ispersistent"`
-	Issystem                    bool                                  `json:"issystem"`
+	Isdefault                   FlexBool                              `json:"isdefault"`
+	Ispersistent                FlexBool                              `json:"ispersistent"`
+	Issystem                    FlexBool                              `json:"issystem"`
 	JobID                       string                                `json:"jobid"`
-	Jobstatus                   int                                   `json:"jobstatus"`
+	Jobstatus                   FlexInt                               `json:"jobstatus"`
 	Name                        string                                `json:"name"`
 	Netmask                     string                                `json:"netmask"`
 	Networkcidr                 string                                `json:"networkcidr"`
 	Networkdomain               string                                `json:"networkdomain"`
 	Networkofferingavailability string                                `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                                  `json:"networkofferingconservemode"`
+	Networkofferingconservemode FlexBool                              `json:"networkofferingconservemode"`
 	Networkofferingdisplaytext  string                                `json:"networkofferingdisplaytext"`
 	Networkofferingid           string                                `json:"networkofferingid"`
 	Networkofferingname         string                                `json:"networkofferingname"`
 	Physicalnetworkid           string                                `json:"physicalnetworkid"`
-	Privatemtu                  int                                   `json:"privatemtu"`
+	Privatemtu                  FlexInt                               `json:"privatemtu"`
 	Project                     string                                `json:"project"`
 	Projectid                   string                                `json:"projectid"`
-	Publicmtu                   int                                   `json:"publicmtu"`
-	Receivedbytes               int64                                 `json:"receivedbytes"`
-	Redundantrouter             bool                                  `json:"redundantrouter"`
+	Publicmtu                   FlexInt                               `json:"publicmtu"`
+	Receivedbytes               FlexInt64                             `json:"receivedbytes"`
+	Redundantrouter             FlexBool                              `json:"redundantrouter"`
 	Related                     string                                `json:"related"`
 	Reservediprange             string                                `json:"reservediprange"`
-	Restartrequired             bool                                  `json:"restartrequired"`
-	Sentbytes                   int64                                 `json:"sentbytes"`
+	Restartrequired             FlexBool                              `json:"restartrequired"`
+	Sentbytes                   FlexInt64                             `json:"sentbytes"`
 	Service                     []NetscalerLoadBalancerNetworkService `json:"service"`
-	Specifyipranges             bool                                  `json:"specifyipranges"`
-	Specifyvlan                 bool                                  `json:"specifyvlan"`
+	Specifyipranges             FlexBool                              `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                              `json:"specifyvlan"`
 	State                       string                                `json:"state"`
-	Strechedl2subnet            bool                                  `json:"strechedl2subnet"`
-	Subdomainaccess             bool                                  `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                                  `json:"supportsvmautoscaling"`
+	Strechedl2subnet            FlexBool                              `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                              `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                              `json:"supportsvmautoscaling"`
 	Tags                        []Tags                                `json:"tags"`
 	Traffictype                 string                                `json:"traffictype"`
 	Tungstenvirtualrouteruuid   string                                `json:"tungstenvirtualrouteruuid"`
@@ -1103,28 +1566,52 @@ type NetscalerLoadBalancerNetwork struct {
 	Zoneid                      string                                `json:"zoneid"`
 	Zonename                    string                                `json:"zonename"`
 	Zonesnetworkspans           []interface{}                         `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage            `json:"-"`
+}
+
+func (r *NetscalerLoadBalancerNetwork) UnmarshalJSON(b []byte) error {
+	type alias NetscalerLoadBalancerNetwork
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetscalerLoadBalancerNetworkService struct {
 	Capability []NetscalerLoadBalancerNetworkServiceCapability `json:"capability"`
 	Name       string                                          `json:"name"`
 	Provider   []NetscalerLoadBalancerNetworkServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                      `json:"-"`
+}
+
+func (r *NetscalerLoadBalancerNetworkService) UnmarshalJSON(b []byte) error {
+	type alias NetscalerLoadBalancerNetworkService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetscalerLoadBalancerNetworkServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetscalerLoadBalancerNetworkServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias NetscalerLoadBalancerNetworkServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetscalerLoadBalancerNetworkServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetscalerLoadBalancerNetworkServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias NetscalerLoadBalancerNetworkServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetscalerLoadBalancersParams struct {
@@ -1156,6 +1643,47 @@ func (p *ListNetscalerLoadBalancersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetscalerLoadBalancers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetscalerLoadBalancersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetscalerLoadBalancers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetscalerLoadBalancers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetscalerLoadBalancersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "lbdeviceid": 255, "physicalnetworkid": 255},
+	}.validate("listNetscalerLoadBalancers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetscalerLoadBalancers API, before the request is signed
+func (p *ListNetscalerLoadBalancersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetscalerLoadBalancersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetscalerLoadBalancersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetscalerLoadBalancers", b, &p.p, map[string]paramDecoder{
+		"keyword":           decodeParam[string],
+		"lbdeviceid":        decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetscalerLoadBalancersParams) Clone() *ListNetscalerLoadBalancersParams {
+	return &ListNetscalerLoadBalancersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetscalerLoadBalancersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1271,42 +1799,85 @@ func (s *NetscalerService) NewListNetscalerLoadBalancersParams() *ListNetscalerL
 
 // Lists netscaler load balancer devices
 func (s *NetscalerService) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
-	resp, err := s.cs.newRequest("listNetscalerLoadBalancers", p.toURLValues())
+	return s.ListNetscalerLoadBalancersWithContext(context.Background(), p)
+}
+
+// ListNetscalerLoadBalancersWithContext is like ListNetscalerLoadBalancers, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerLoadBalancersWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetscalerLoadBalancers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetscalerLoadBalancersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetscalerLoadBalancers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetscalerLoadBalancersAll returns all NetscalerLoadBalancers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetscalerService) ListNetscalerLoadBalancersAll(p *ListNetscalerLoadBalancersParams, opts ...PageOption) ([]*NetscalerLoadBalancer, error) {
+	return s.ListNetscalerLoadBalancersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetscalerLoadBalancersAllWithContext is like ListNetscalerLoadBalancersAll, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerLoadBalancersAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams, opts ...PageOption) ([]*NetscalerLoadBalancer, error) {
+	return collectPages(s.ListNetscalerLoadBalancersIterWithContext(ctx, p, opts...))
+}
+
+// ListNetscalerLoadBalancersIter returns an iterator over all NetscalerLoadBalancers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetscalerService) ListNetscalerLoadBalancersIter(p *ListNetscalerLoadBalancersParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancer, error] {
+	return s.ListNetscalerLoadBalancersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetscalerLoadBalancersIterWithContext is like ListNetscalerLoadBalancersIter, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) ListNetscalerLoadBalancersIterWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancer, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetscalerLoadBalancer, int, error) {
+		l, err := s.ListNetscalerLoadBalancersWithContext(ctx, &ListNetscalerLoadBalancersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetscalerLoadBalancers, l.Count, nil
+	}, opts...)
+}
+
 type ListNetscalerLoadBalancersResponse struct {
 	Count                  int                      `json:"count"`
 	NetscalerLoadBalancers []*NetscalerLoadBalancer `json:"netscalerloadbalancer"`
 }
 
 type NetscalerLoadBalancer struct {
-	Gslbprovider            bool     `json:"gslbprovider"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
-	Ipaddress               string   `json:"ipaddress"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
-	JobID                   string   `json:"jobid"`
-	Jobstatus               int      `json:"jobstatus"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated"`
-	Lbdeviceid              string   `json:"lbdeviceid"`
-	Lbdevicename            string   `json:"lbdevicename"`
-	Lbdevicestate           string   `json:"lbdevicestate"`
-	Physicalnetworkid       string   `json:"physicalnetworkid"`
-	Podids                  []string `json:"podids"`
-	Privateinterface        string   `json:"privateinterface"`
-	Provider                string   `json:"provider"`
-	Publicinterface         string   `json:"publicinterface"`
+	Gslbprovider            FlexBool                   `json:"gslbprovider"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip"`
+	Ipaddress               string                     `json:"ipaddress"`
+	Isexclusivegslbprovider FlexBool                   `json:"isexclusivegslbprovider"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Lbdevicecapacity        FlexInt64                  `json:"lbdevicecapacity"`
+	Lbdevicededicated       FlexBool                   `json:"lbdevicededicated"`
+	Lbdeviceid              string                     `json:"lbdeviceid"`
+	Lbdevicename            string                     `json:"lbdevicename"`
+	Lbdevicestate           string                     `json:"lbdevicestate"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid"`
+	Podids                  []string                   `json:"podids"`
+	Privateinterface        string                     `json:"privateinterface"`
+	Provider                string                     `json:"provider"`
+	Publicinterface         string                     `json:"publicinterface"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetscalerLoadBalancer) UnmarshalJSON(b []byte) error {
+	type alias NetscalerLoadBalancer
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterNetscalerControlCenterParams struct {
@@ -1334,6 +1905,47 @@ func (p *RegisterNetscalerControlCenterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerNetscalerControlCenter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterNetscalerControlCenterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerNetscalerControlCenter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerNetscalerControlCenter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterNetscalerControlCenterParams) Validate() error {
+	return paramRules{
+		required: []string{"ipaddress", "numretries", "password", "username"},
+		lengths:  map[string]int{"ipaddress": 255, "password": 255, "username": 255},
+	}.validate("registerNetscalerControlCenter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerNetscalerControlCenter API, before the request is signed
+func (p *RegisterNetscalerControlCenterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterNetscalerControlCenterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterNetscalerControlCenterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerNetscalerControlCenter", b, &p.p, map[string]paramDecoder{
+		"ipaddress":  decodeParam[string],
+		"numretries": decodeParam[int],
+		"password":   decodeParam[string],
+		"username":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterNetscalerControlCenterParams) Clone() *RegisterNetscalerControlCenterParams {
+	return &RegisterNetscalerControlCenterParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterNetscalerControlCenterParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1432,32 +2044,39 @@ func (s *NetscalerService) NewRegisterNetscalerControlCenterParams(ipaddress str
 
 // Adds a netscaler control center device
 func (s *NetscalerService) RegisterNetscalerControlCenter(p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error) {
-	resp, err := s.cs.newPostRequest("registerNetscalerControlCenter", p.toURLValues())
+	return s.RegisterNetscalerControlCenterWithContext(context.Background(), p)
+}
+
+// RegisterNetscalerControlCenterWithContext is like RegisterNetscalerControlCenter, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) RegisterNetscalerControlCenterWithContext(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "registerNetscalerControlCenter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterNetscalerControlCenterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerNetscalerControlCenter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "registerNetscalerControlCenter", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("registerNetscalerControlCenter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1465,24 +2084,49 @@ func (s *NetscalerService) RegisterNetscalerControlCenter(p *RegisterNetscalerCo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RegisterNetscalerControlCenter async job into r
+func (r *RegisterNetscalerControlCenterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RegisterNetscalerControlCenterAsync starts the async job of RegisterNetscalerControlCenter without waiting for it to finish, and returns a handle to the job
+func (s *NetscalerService) RegisterNetscalerControlCenterAsync(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*Job, error) {
+	r, err := s.RegisterNetscalerControlCenterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("registerNetscalerControlCenter", r.JobID), nil
+}
+
 type RegisterNetscalerControlCenterResponse struct {
-	Gslbprovider            bool     `json:"gslbprovider"`
-	Gslbproviderprivateip   string   `json:"gslbproviderprivateip"`
-	Gslbproviderpublicip    string   `json:"gslbproviderpublicip"`
-	Ipaddress               string   `json:"ipaddress"`
-	Isexclusivegslbprovider bool     `json:"isexclusivegslbprovider"`
-	JobID                   string   `json:"jobid"`
-	Jobstatus               int      `json:"jobstatus"`
-	Lbdevicecapacity        int64    `json:"lbdevicecapacity"`
-	Lbdevicededicated       bool     `json:"lbdevicededicated"`
-	Lbdeviceid              string   `json:"lbdeviceid"`
-	Lbdevicename            string   `json:"lbdevicename"`
-	Lbdevicestate           string   `json:"lbdevicestate"`
-	Physicalnetworkid       string   `json:"physicalnetworkid"`
-	Podids                  []string `json:"podids"`
-	Privateinterface        string   `json:"privateinterface"`
-	Provider                string   `json:"provider"`
-	Publicinterface         string   `json:"publicinterface"`
+	Gslbprovider            FlexBool                   `json:"gslbprovider"`
+	Gslbproviderprivateip   string                     `json:"gslbproviderprivateip"`
+	Gslbproviderpublicip    string                     `json:"gslbproviderpublicip"`
+	Ipaddress               string                     `json:"ipaddress"`
+	Isexclusivegslbprovider FlexBool                   `json:"isexclusivegslbprovider"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Lbdevicecapacity        FlexInt64                  `json:"lbdevicecapacity"`
+	Lbdevicededicated       FlexBool                   `json:"lbdevicededicated"`
+	Lbdeviceid              string                     `json:"lbdeviceid"`
+	Lbdevicename            string                     `json:"lbdevicename"`
+	Lbdevicestate           string                     `json:"lbdevicestate"`
+	Physicalnetworkid       string                     `json:"physicalnetworkid"`
+	Podids                  []string                   `json:"podids"`
+	Privateinterface        string                     `json:"privateinterface"`
+	Provider                string                     `json:"provider"`
+	Publicinterface         string                     `json:"publicinterface"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RegisterNetscalerControlCenterResponse) UnmarshalJSON(b []byte) error {
+	type alias RegisterNetscalerControlCenterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterNetscalerServicePackageParams struct {
@@ -1503,6 +2147,45 @@ func (p *RegisterNetscalerServicePackageParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerNetscalerServicePackage API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterNetscalerServicePackageParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerNetscalerServicePackage", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerNetscalerServicePackage API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterNetscalerServicePackageParams) Validate() error {
+	return paramRules{
+		required: []string{"description", "name"},
+		lengths:  map[string]int{"description": 4096, "name": 255},
+	}.validate("registerNetscalerServicePackage", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerNetscalerServicePackage API, before the request is signed
+func (p *RegisterNetscalerServicePackageParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterNetscalerServicePackageParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterNetscalerServicePackageParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerNetscalerServicePackage", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"name":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterNetscalerServicePackageParams) Clone() *RegisterNetscalerServicePackageParams {
+	return &RegisterNetscalerServicePackageParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterNetscalerServicePackageParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1557,13 +2240,22 @@ func (s *NetscalerService) NewRegisterNetscalerServicePackageParams(description
 
 // Registers NCC Service Package
 func (s *NetscalerService) RegisterNetscalerServicePackage(p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error) {
-	resp, err := s.cs.newPostRequest("registerNetscalerServicePackage", p.toURLValues())
+	return s.RegisterNetscalerServicePackageWithContext(context.Background(), p)
+}
+
+// RegisterNetscalerServicePackageWithContext is like RegisterNetscalerServicePackage, but honours the cancellation and deadline of ctx
+func (s *NetscalerService) RegisterNetscalerServicePackageWithContext(ctx context.Context, p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerNetscalerServicePackage", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterNetscalerServicePackageResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerNetscalerServicePackage", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1571,9 +2263,15 @@ func (s *NetscalerService) RegisterNetscalerServicePackage(p *RegisterNetscalerS
 }
 
 type RegisterNetscalerServicePackageResponse struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RegisterNetscalerServicePackageResponse) UnmarshalJSON(b []byte) error {
+	type alias RegisterNetscalerServicePackageResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
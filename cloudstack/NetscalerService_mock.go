@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) AddNetscalerLoadBalancer(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetscalerLoadBalancer", reflect.TypeOf((*MockNetscalerServiceIface)(nil).AddNetscalerLoadBalancer), p)
 }
 
+// AddNetscalerLoadBalancerAsync mocks base method.
+func (m *MockNetscalerServiceIface) AddNetscalerLoadBalancerAsync(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetscalerLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNetscalerLoadBalancerAsync indicates an expected call of AddNetscalerLoadBalancerAsync.
+func (mr *MockNetscalerServiceIfaceMockRecorder) AddNetscalerLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetscalerLoadBalancerAsync", reflect.TypeOf((*MockNetscalerServiceIface)(nil).AddNetscalerLoadBalancerAsync), ctx, p)
+}
+
+// AddNetscalerLoadBalancerWithContext mocks base method.
+func (m *MockNetscalerServiceIface) AddNetscalerLoadBalancerWithContext(ctx context.Context, p *AddNetscalerLoadBalancerParams) (*AddNetscalerLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetscalerLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNetscalerLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNetscalerLoadBalancerWithContext indicates an expected call of AddNetscalerLoadBalancerWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) AddNetscalerLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetscalerLoadBalancerWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).AddNetscalerLoadBalancerWithContext), ctx, p)
+}
+
 // ConfigureNetscalerLoadBalancer mocks base method.
 func (m *MockNetscalerServiceIface) ConfigureNetscalerLoadBalancer(p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) ConfigureNetscalerLoadBalancer(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureNetscalerLoadBalancer", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ConfigureNetscalerLoadBalancer), p)
 }
 
+// ConfigureNetscalerLoadBalancerAsync mocks base method.
+func (m *MockNetscalerServiceIface) ConfigureNetscalerLoadBalancerAsync(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureNetscalerLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureNetscalerLoadBalancerAsync indicates an expected call of ConfigureNetscalerLoadBalancerAsync.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ConfigureNetscalerLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureNetscalerLoadBalancerAsync", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ConfigureNetscalerLoadBalancerAsync), ctx, p)
+}
+
+// ConfigureNetscalerLoadBalancerWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ConfigureNetscalerLoadBalancerWithContext(ctx context.Context, p *ConfigureNetscalerLoadBalancerParams) (*NetscalerLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureNetscalerLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*NetscalerLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureNetscalerLoadBalancerWithContext indicates an expected call of ConfigureNetscalerLoadBalancerWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ConfigureNetscalerLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureNetscalerLoadBalancerWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ConfigureNetscalerLoadBalancerWithContext), ctx, p)
+}
+
 // DeleteNetscalerControlCenter mocks base method.
 func (m *MockNetscalerServiceIface) DeleteNetscalerControlCenter(p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,21 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) DeleteNetscalerControlCenter(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetscalerControlCenter", reflect.TypeOf((*MockNetscalerServiceIface)(nil).DeleteNetscalerControlCenter), p)
 }
 
+// DeleteNetscalerControlCenterWithContext mocks base method.
+func (m *MockNetscalerServiceIface) DeleteNetscalerControlCenterWithContext(ctx context.Context, p *DeleteNetscalerControlCenterParams) (*DeleteNetscalerControlCenterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetscalerControlCenterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetscalerControlCenterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetscalerControlCenterWithContext indicates an expected call of DeleteNetscalerControlCenterWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) DeleteNetscalerControlCenterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetscalerControlCenterWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).DeleteNetscalerControlCenterWithContext), ctx, p)
+}
+
 // DeleteNetscalerLoadBalancer mocks base method.
 func (m *MockNetscalerServiceIface) DeleteNetscalerLoadBalancer(p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,36 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) DeleteNetscalerLoadBalancer(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetscalerLoadBalancer", reflect.TypeOf((*MockNetscalerServiceIface)(nil).DeleteNetscalerLoadBalancer), p)
 }
 
+// DeleteNetscalerLoadBalancerAsync mocks base method.
+func (m *MockNetscalerServiceIface) DeleteNetscalerLoadBalancerAsync(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetscalerLoadBalancerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetscalerLoadBalancerAsync indicates an expected call of DeleteNetscalerLoadBalancerAsync.
+func (mr *MockNetscalerServiceIfaceMockRecorder) DeleteNetscalerLoadBalancerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetscalerLoadBalancerAsync", reflect.TypeOf((*MockNetscalerServiceIface)(nil).DeleteNetscalerLoadBalancerAsync), ctx, p)
+}
+
+// DeleteNetscalerLoadBalancerWithContext mocks base method.
+func (m *MockNetscalerServiceIface) DeleteNetscalerLoadBalancerWithContext(ctx context.Context, p *DeleteNetscalerLoadBalancerParams) (*DeleteNetscalerLoadBalancerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetscalerLoadBalancerWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetscalerLoadBalancerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetscalerLoadBalancerWithContext indicates an expected call of DeleteNetscalerLoadBalancerWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) DeleteNetscalerLoadBalancerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetscalerLoadBalancerWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).DeleteNetscalerLoadBalancerWithContext), ctx, p)
+}
+
 // GetNetscalerLoadBalancerNetworkID mocks base method.
 func (m *MockNetscalerServiceIface) GetNetscalerLoadBalancerNetworkID(keyword, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +246,27 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) GetNetscalerLoadBalancerNetwork
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetscalerLoadBalancerNetworkID", reflect.TypeOf((*MockNetscalerServiceIface)(nil).GetNetscalerLoadBalancerNetworkID), varargs...)
 }
 
+// GetNetscalerLoadBalancerNetworkIDWithContext mocks base method.
+func (m *MockNetscalerServiceIface) GetNetscalerLoadBalancerNetworkIDWithContext(ctx context.Context, keyword, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, lbdeviceid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetscalerLoadBalancerNetworkIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetscalerLoadBalancerNetworkIDWithContext indicates an expected call of GetNetscalerLoadBalancerNetworkIDWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) GetNetscalerLoadBalancerNetworkIDWithContext(ctx, keyword, lbdeviceid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, lbdeviceid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetscalerLoadBalancerNetworkIDWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).GetNetscalerLoadBalancerNetworkIDWithContext), varargs...)
+}
+
 // ListNetscalerControlCenter mocks base method.
 func (m *MockNetscalerServiceIface) ListNetscalerControlCenter(p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +282,99 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerControlCenter(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerControlCenter", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerControlCenter), p)
 }
 
+// ListNetscalerControlCenterAll mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerControlCenterAll(p *ListNetscalerControlCenterParams, opts ...PageOption) ([]*NetscalerControlCenter, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerControlCenterAll", varargs...)
+	ret0, _ := ret[0].([]*NetscalerControlCenter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerControlCenterAll indicates an expected call of ListNetscalerControlCenterAll.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerControlCenterAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerControlCenterAll", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerControlCenterAll), varargs...)
+}
+
+// ListNetscalerControlCenterAllWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerControlCenterAllWithContext(ctx context.Context, p *ListNetscalerControlCenterParams, opts ...PageOption) ([]*NetscalerControlCenter, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerControlCenterAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetscalerControlCenter)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerControlCenterAllWithContext indicates an expected call of ListNetscalerControlCenterAllWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerControlCenterAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerControlCenterAllWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerControlCenterAllWithContext), varargs...)
+}
+
+// ListNetscalerControlCenterIter mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerControlCenterIter(p *ListNetscalerControlCenterParams, opts ...PageOption) iter.Seq2[*NetscalerControlCenter, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerControlCenterIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetscalerControlCenter, error])
+	return ret0
+}
+
+// ListNetscalerControlCenterIter indicates an expected call of ListNetscalerControlCenterIter.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerControlCenterIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerControlCenterIter", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerControlCenterIter), varargs...)
+}
+
+// ListNetscalerControlCenterIterWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerControlCenterIterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams, opts ...PageOption) iter.Seq2[*NetscalerControlCenter, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerControlCenterIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetscalerControlCenter, error])
+	return ret0
+}
+
+// ListNetscalerControlCenterIterWithContext indicates an expected call of ListNetscalerControlCenterIterWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerControlCenterIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerControlCenterIterWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerControlCenterIterWithContext), varargs...)
+}
+
+// ListNetscalerControlCenterWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerControlCenterWithContext(ctx context.Context, p *ListNetscalerControlCenterParams) (*ListNetscalerControlCenterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetscalerControlCenterWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetscalerControlCenterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerControlCenterWithContext indicates an expected call of ListNetscalerControlCenterWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerControlCenterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerControlCenterWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerControlCenterWithContext), ctx, p)
+}
+
 // ListNetscalerLoadBalancerNetworks mocks base method.
 func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancerNetworks(p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +390,99 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetwor
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworks", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancerNetworks), p)
 }
 
+// ListNetscalerLoadBalancerNetworksAll mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancerNetworksAll(p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) ([]*NetscalerLoadBalancerNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancerNetworksAll", varargs...)
+	ret0, _ := ret[0].([]*NetscalerLoadBalancerNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerLoadBalancerNetworksAll indicates an expected call of ListNetscalerLoadBalancerNetworksAll.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetworksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworksAll", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancerNetworksAll), varargs...)
+}
+
+// ListNetscalerLoadBalancerNetworksAllWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancerNetworksAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) ([]*NetscalerLoadBalancerNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancerNetworksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetscalerLoadBalancerNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerLoadBalancerNetworksAllWithContext indicates an expected call of ListNetscalerLoadBalancerNetworksAllWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetworksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworksAllWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancerNetworksAllWithContext), varargs...)
+}
+
+// ListNetscalerLoadBalancerNetworksIter mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancerNetworksIter(p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancerNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancerNetworksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetscalerLoadBalancerNetwork, error])
+	return ret0
+}
+
+// ListNetscalerLoadBalancerNetworksIter indicates an expected call of ListNetscalerLoadBalancerNetworksIter.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetworksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworksIter", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancerNetworksIter), varargs...)
+}
+
+// ListNetscalerLoadBalancerNetworksIterWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancerNetworksIterWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancerNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancerNetworksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetscalerLoadBalancerNetwork, error])
+	return ret0
+}
+
+// ListNetscalerLoadBalancerNetworksIterWithContext indicates an expected call of ListNetscalerLoadBalancerNetworksIterWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetworksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworksIterWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancerNetworksIterWithContext), varargs...)
+}
+
+// ListNetscalerLoadBalancerNetworksWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancerNetworksWithContext(ctx context.Context, p *ListNetscalerLoadBalancerNetworksParams) (*ListNetscalerLoadBalancerNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancerNetworksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetscalerLoadBalancerNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerLoadBalancerNetworksWithContext indicates an expected call of ListNetscalerLoadBalancerNetworksWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancerNetworksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancerNetworksWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancerNetworksWithContext), ctx, p)
+}
+
 // ListNetscalerLoadBalancers mocks base method.
 func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancers(p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +498,99 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancers(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancers", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancers), p)
 }
 
+// ListNetscalerLoadBalancersAll mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancersAll(p *ListNetscalerLoadBalancersParams, opts ...PageOption) ([]*NetscalerLoadBalancer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancersAll", varargs...)
+	ret0, _ := ret[0].([]*NetscalerLoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerLoadBalancersAll indicates an expected call of ListNetscalerLoadBalancersAll.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancersAll", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancersAll), varargs...)
+}
+
+// ListNetscalerLoadBalancersAllWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancersAllWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams, opts ...PageOption) ([]*NetscalerLoadBalancer, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetscalerLoadBalancer)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerLoadBalancersAllWithContext indicates an expected call of ListNetscalerLoadBalancersAllWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancersAllWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancersAllWithContext), varargs...)
+}
+
+// ListNetscalerLoadBalancersIter mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancersIter(p *ListNetscalerLoadBalancersParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetscalerLoadBalancer, error])
+	return ret0
+}
+
+// ListNetscalerLoadBalancersIter indicates an expected call of ListNetscalerLoadBalancersIter.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancersIter", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancersIter), varargs...)
+}
+
+// ListNetscalerLoadBalancersIterWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancersIterWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams, opts ...PageOption) iter.Seq2[*NetscalerLoadBalancer, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetscalerLoadBalancer, error])
+	return ret0
+}
+
+// ListNetscalerLoadBalancersIterWithContext indicates an expected call of ListNetscalerLoadBalancersIterWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancersIterWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancersIterWithContext), varargs...)
+}
+
+// ListNetscalerLoadBalancersWithContext mocks base method.
+func (m *MockNetscalerServiceIface) ListNetscalerLoadBalancersWithContext(ctx context.Context, p *ListNetscalerLoadBalancersParams) (*ListNetscalerLoadBalancersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetscalerLoadBalancersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetscalerLoadBalancersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetscalerLoadBalancersWithContext indicates an expected call of ListNetscalerLoadBalancersWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) ListNetscalerLoadBalancersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetscalerLoadBalancersWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).ListNetscalerLoadBalancersWithContext), ctx, p)
+}
+
 // NewAddNetscalerLoadBalancerParams mocks base method.
 func (m *MockNetscalerServiceIface) NewAddNetscalerLoadBalancerParams(networkdevicetype, password, physicalnetworkid, url, username string) *AddNetscalerLoadBalancerParams {
 	m.ctrl.T.Helper()
@@ -325,6 +732,36 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) RegisterNetscalerControlCenter(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNetscalerControlCenter", reflect.TypeOf((*MockNetscalerServiceIface)(nil).RegisterNetscalerControlCenter), p)
 }
 
+// RegisterNetscalerControlCenterAsync mocks base method.
+func (m *MockNetscalerServiceIface) RegisterNetscalerControlCenterAsync(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterNetscalerControlCenterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterNetscalerControlCenterAsync indicates an expected call of RegisterNetscalerControlCenterAsync.
+func (mr *MockNetscalerServiceIfaceMockRecorder) RegisterNetscalerControlCenterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNetscalerControlCenterAsync", reflect.TypeOf((*MockNetscalerServiceIface)(nil).RegisterNetscalerControlCenterAsync), ctx, p)
+}
+
+// RegisterNetscalerControlCenterWithContext mocks base method.
+func (m *MockNetscalerServiceIface) RegisterNetscalerControlCenterWithContext(ctx context.Context, p *RegisterNetscalerControlCenterParams) (*RegisterNetscalerControlCenterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterNetscalerControlCenterWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterNetscalerControlCenterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterNetscalerControlCenterWithContext indicates an expected call of RegisterNetscalerControlCenterWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) RegisterNetscalerControlCenterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNetscalerControlCenterWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).RegisterNetscalerControlCenterWithContext), ctx, p)
+}
+
 // RegisterNetscalerServicePackage mocks base method.
 func (m *MockNetscalerServiceIface) RegisterNetscalerServicePackage(p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error) {
 	m.ctrl.T.Helper()
@@ -339,3 +776,18 @@ func (mr *MockNetscalerServiceIfaceMockRecorder) RegisterNetscalerServicePackage
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNetscalerServicePackage", reflect.TypeOf((*MockNetscalerServiceIface)(nil).RegisterNetscalerServicePackage), p)
 }
+
+// RegisterNetscalerServicePackageWithContext mocks base method.
+func (m *MockNetscalerServiceIface) RegisterNetscalerServicePackageWithContext(ctx context.Context, p *RegisterNetscalerServicePackageParams) (*RegisterNetscalerServicePackageResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterNetscalerServicePackageWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterNetscalerServicePackageResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterNetscalerServicePackageWithContext indicates an expected call of RegisterNetscalerServicePackageWithContext.
+func (mr *MockNetscalerServiceIfaceMockRecorder) RegisterNetscalerServicePackageWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterNetscalerServicePackageWithContext", reflect.TypeOf((*MockNetscalerServiceIface)(nil).RegisterNetscalerServicePackageWithContext), ctx, p)
+}
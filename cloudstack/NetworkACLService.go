@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,28 +31,58 @@ import (
 
 type NetworkACLServiceIface interface {
 	CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
-	NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams
+	CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error)
+	CreateNetworkACLAsync(ctx context.Context, p *CreateNetworkACLParams) (*Job, error)
+	NewCreateNetworkACLParams(protocol Protocol) *CreateNetworkACLParams
 	CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error)
+	CreateNetworkACLListAsync(ctx context.Context, p *CreateNetworkACLListParams) (*Job, error)
 	NewCreateNetworkACLListParams(name string, vpcid string) *CreateNetworkACLListParams
 	DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error)
+	DeleteNetworkACLAsync(ctx context.Context, p *DeleteNetworkACLParams) (*Job, error)
 	NewDeleteNetworkACLParams(id string) *DeleteNetworkACLParams
 	DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error)
+	DeleteNetworkACLListAsync(ctx context.Context, p *DeleteNetworkACLListParams) (*Job, error)
 	NewDeleteNetworkACLListParams(id string) *DeleteNetworkACLListParams
 	ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error)
+	ListNetworkACLListsAll(p *ListNetworkACLListsParams, opts ...PageOption) ([]*NetworkACLList, error)
+	ListNetworkACLListsAllWithContext(ctx context.Context, p *ListNetworkACLListsParams, opts ...PageOption) ([]*NetworkACLList, error)
+	ListNetworkACLListsIter(p *ListNetworkACLListsParams, opts ...PageOption) iter.Seq2[*NetworkACLList, error]
+	ListNetworkACLListsIterWithContext(ctx context.Context, p *ListNetworkACLListsParams, opts ...PageOption) iter.Seq2[*NetworkACLList, error]
 	NewListNetworkACLListsParams() *ListNetworkACLListsParams
 	GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkACLListIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkACLList, int, error)
 	GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error)
+	GetNetworkACLListByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACLList, int, error)
 	ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error)
+	ListNetworkACLsAll(p *ListNetworkACLsParams, opts ...PageOption) ([]*NetworkACL, error)
+	ListNetworkACLsAllWithContext(ctx context.Context, p *ListNetworkACLsParams, opts ...PageOption) ([]*NetworkACL, error)
+	ListNetworkACLsIter(p *ListNetworkACLsParams, opts ...PageOption) iter.Seq2[*NetworkACL, error]
+	ListNetworkACLsIterWithContext(ctx context.Context, p *ListNetworkACLsParams, opts ...PageOption) iter.Seq2[*NetworkACL, error]
 	NewListNetworkACLsParams() *ListNetworkACLsParams
 	GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error)
+	GetNetworkACLByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACL, int, error)
 	MoveNetworkAclItem(p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error)
+	MoveNetworkAclItemWithContext(ctx context.Context, p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error)
+	MoveNetworkAclItemAsync(ctx context.Context, p *MoveNetworkAclItemParams) (*Job, error)
 	NewMoveNetworkAclItemParams(id string) *MoveNetworkAclItemParams
 	ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error)
+	ReplaceNetworkACLListAsync(ctx context.Context, p *ReplaceNetworkACLListParams) (*Job, error)
 	NewReplaceNetworkACLListParams(aclid string) *ReplaceNetworkACLListParams
 	UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error)
+	UpdateNetworkACLItemAsync(ctx context.Context, p *UpdateNetworkACLItemParams) (*Job, error)
 	NewUpdateNetworkACLItemParams(id string) *UpdateNetworkACLItemParams
 	UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error)
+	UpdateNetworkACLListAsync(ctx context.Context, p *UpdateNetworkACLListParams) (*Job, error)
 	NewUpdateNetworkACLListParams(id string) *UpdateNetworkACLListParams
 }
 
@@ -97,7 +129,7 @@ func (p *CreateNetworkACLParams) toURLValues() url.Values {
 		u.Set("number", vv)
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["reason"]; found {
 		u.Set("reason", v.(string))
@@ -112,6 +144,56 @@ func (p *CreateNetworkACLParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createNetworkACL API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateNetworkACLParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createNetworkACL", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createNetworkACL API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateNetworkACLParams) Validate() error {
+	return paramRules{
+		required: []string{"protocol"},
+		lengths:  map[string]int{"aclid": 255, "action": 255, "networkid": 255, "protocol": 255, "reason": 255, "traffictype": 255},
+	}.validate("createNetworkACL", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createNetworkACL API, before the request is signed
+func (p *CreateNetworkACLParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateNetworkACLParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateNetworkACLParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createNetworkACL", b, &p.p, map[string]paramDecoder{
+		"aclid":       decodeParam[string],
+		"action":      decodeParam[string],
+		"cidrlist":    decodeParam[[]string],
+		"endport":     decodeParam[int],
+		"fordisplay":  decodeParam[bool],
+		"icmpcode":    decodeParam[int],
+		"icmptype":    decodeParam[int],
+		"networkid":   decodeParam[string],
+		"number":      decodeParam[int],
+		"protocol":    decodeParam[Protocol],
+		"reason":      decodeParam[string],
+		"startport":   decodeParam[int],
+		"traffictype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateNetworkACLParams) Clone() *CreateNetworkACLParams {
+	return &CreateNetworkACLParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateNetworkACLParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -301,7 +383,7 @@ func (p *CreateNetworkACLParams) GetNumber() (int, bool) {
 	return value, ok
 }
 
-func (p *CreateNetworkACLParams) SetProtocol(v string) {
+func (p *CreateNetworkACLParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -314,11 +396,11 @@ func (p *CreateNetworkACLParams) ResetProtocol() {
 	}
 }
 
-func (p *CreateNetworkACLParams) GetProtocol() (string, bool) {
+func (p *CreateNetworkACLParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -387,7 +469,7 @@ func (p *CreateNetworkACLParams) GetTraffictype() (string, bool) {
 
 // You should always use this function to get a new CreateNetworkACLParams instance,
 // as then you are sure you have configured all required params
-func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams {
+func (s *NetworkACLService) NewCreateNetworkACLParams(protocol Protocol) *CreateNetworkACLParams {
 	p := &CreateNetworkACLParams{}
 	p.p = make(map[string]interface{})
 	p.p["protocol"] = protocol
@@ -396,32 +478,39 @@ func (s *NetworkACLService) NewCreateNetworkACLParams(protocol string) *CreateNe
 
 // Creates a ACL rule in the given Network (the Network has to belong to VPC)
 func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
-	resp, err := s.cs.newPostRequest("createNetworkACL", p.toURLValues())
+	return s.CreateNetworkACLWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLWithContext is like CreateNetworkACL, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createNetworkACL", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateNetworkACLResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createNetworkACL", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createNetworkACL", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createNetworkACL", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -429,25 +518,50 @@ func (s *NetworkACLService) CreateNetworkACL(p *CreateNetworkACLParams) (*Create
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateNetworkACL async job into r
+func (r *CreateNetworkACLResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateNetworkACLAsync starts the async job of CreateNetworkACL without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) CreateNetworkACLAsync(ctx context.Context, p *CreateNetworkACLParams) (*Job, error) {
+	r, err := s.CreateNetworkACLWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createNetworkACL", r.JobID), nil
+}
+
 type CreateNetworkACLResponse struct {
-	Aclid       string `json:"aclid"`
-	Aclname     string `json:"aclname"`
-	Action      string `json:"action"`
-	Cidrlist    string `json:"cidrlist"`
-	Endport     string `json:"endport"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Icmpcode    int    `json:"icmpcode"`
-	Icmptype    int    `json:"icmptype"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Number      int    `json:"number"`
-	Protocol    string `json:"protocol"`
-	Reason      string `json:"reason"`
-	Startport   string `json:"startport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Traffictype string `json:"traffictype"`
+	Aclid       string                     `json:"aclid"`
+	Aclname     string                     `json:"aclname"`
+	Action      string                     `json:"action"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Endport     string                     `json:"endport"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Icmpcode    FlexInt                    `json:"icmpcode"`
+	Icmptype    FlexInt                    `json:"icmptype"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Number      FlexInt                    `json:"number"`
+	Protocol    string                     `json:"protocol"`
+	Reason      string                     `json:"reason"`
+	Startport   string                     `json:"startport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Traffictype string                     `json:"traffictype"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateNetworkACLResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkACLResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkACLListParams struct {
@@ -475,6 +589,47 @@ func (p *CreateNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createNetworkACLList API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateNetworkACLListParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createNetworkACLList", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createNetworkACLList API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateNetworkACLListParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "vpcid"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "vpcid": 255},
+	}.validate("createNetworkACLList", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createNetworkACLList API, before the request is signed
+func (p *CreateNetworkACLListParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createNetworkACLList", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"name":        decodeParam[string],
+		"vpcid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateNetworkACLListParams) Clone() *CreateNetworkACLListParams {
+	return &CreateNetworkACLListParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateNetworkACLListParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -571,32 +726,39 @@ func (s *NetworkACLService) NewCreateNetworkACLListParams(name string, vpcid str
 
 // Creates a Network ACL. If no VPC is given, then it creates a global ACL that can be used by everyone.
 func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
-	resp, err := s.cs.newPostRequest("createNetworkACLList", p.toURLValues())
+	return s.CreateNetworkACLListWithContext(context.Background(), p)
+}
+
+// CreateNetworkACLListWithContext is like CreateNetworkACLList, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createNetworkACLList", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createNetworkACLList", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createNetworkACLList", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -604,15 +766,40 @@ func (s *NetworkACLService) CreateNetworkACLList(p *CreateNetworkACLListParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateNetworkACLList async job into r
+func (r *CreateNetworkACLListResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateNetworkACLListAsync starts the async job of CreateNetworkACLList without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) CreateNetworkACLListAsync(ctx context.Context, p *CreateNetworkACLListParams) (*Job, error) {
+	r, err := s.CreateNetworkACLListWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createNetworkACLList", r.JobID), nil
+}
+
 type CreateNetworkACLListResponse struct {
-	Description string `json:"description"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Vpcid       string `json:"vpcid"`
-	Vpcname     string `json:"vpcname"`
+	Description string                     `json:"description"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Vpcid       string                     `json:"vpcid"`
+	Vpcname     string                     `json:"vpcname"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateNetworkACLListResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkACLListResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetworkACLParams struct {
@@ -630,6 +817,44 @@ func (p *DeleteNetworkACLParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetworkACL API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetworkACLParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetworkACL", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetworkACL API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetworkACLParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetworkACL", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetworkACL API, before the request is signed
+func (p *DeleteNetworkACLParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetworkACLParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetworkACLParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetworkACL", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetworkACLParams) Clone() *DeleteNetworkACLParams {
+	return &DeleteNetworkACLParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetworkACLParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -662,27 +887,39 @@ func (s *NetworkACLService) NewDeleteNetworkACLParams(id string) *DeleteNetworkA
 
 // Deletes a Network ACL
 func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetworkACL", p.toURLValues())
+	return s.DeleteNetworkACLWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLWithContext is like DeleteNetworkACL, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetworkACL", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkACLResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetworkACL", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteNetworkACL", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteNetworkACL", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -690,11 +927,31 @@ func (s *NetworkACLService) DeleteNetworkACL(p *DeleteNetworkACLParams) (*Delete
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteNetworkACL async job into r
+func (r *DeleteNetworkACLResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteNetworkACLAsync starts the async job of DeleteNetworkACL without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) DeleteNetworkACLAsync(ctx context.Context, p *DeleteNetworkACLParams) (*Job, error) {
+	r, err := s.DeleteNetworkACLWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteNetworkACL", r.JobID), nil
+}
+
 type DeleteNetworkACLResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNetworkACLResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNetworkACLResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetworkACLListParams struct {
@@ -712,6 +969,44 @@ func (p *DeleteNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetworkACLList API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetworkACLListParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetworkACLList", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetworkACLList API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetworkACLListParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetworkACLList", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetworkACLList API, before the request is signed
+func (p *DeleteNetworkACLListParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetworkACLList", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetworkACLListParams) Clone() *DeleteNetworkACLListParams {
+	return &DeleteNetworkACLListParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetworkACLListParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -744,27 +1039,39 @@ func (s *NetworkACLService) NewDeleteNetworkACLListParams(id string) *DeleteNetw
 
 // Deletes a network ACL
 func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetworkACLList", p.toURLValues())
+	return s.DeleteNetworkACLListWithContext(context.Background(), p)
+}
+
+// DeleteNetworkACLListWithContext is like DeleteNetworkACLList, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetworkACLList", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteNetworkACLList", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteNetworkACLList", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -772,11 +1079,31 @@ func (s *NetworkACLService) DeleteNetworkACLList(p *DeleteNetworkACLListParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteNetworkACLList async job into r
+func (r *DeleteNetworkACLListResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteNetworkACLListAsync starts the async job of DeleteNetworkACLList without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) DeleteNetworkACLListAsync(ctx context.Context, p *DeleteNetworkACLListParams) (*Job, error) {
+	r, err := s.DeleteNetworkACLListWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteNetworkACLList", r.JobID), nil
+}
+
 type DeleteNetworkACLListResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNetworkACLListResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNetworkACLListResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkACLListsParams struct {
@@ -835,6 +1162,55 @@ func (p *ListNetworkACLListsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkACLLists API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkACLListsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkACLLists", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkACLLists API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkACLListsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "networkid": 255, "projectid": 255, "vpcid": 255},
+	}.validate("listNetworkACLLists", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkACLLists API, before the request is signed
+func (p *ListNetworkACLListsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkACLListsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkACLListsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkACLLists", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"vpcid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkACLListsParams) Clone() *ListNetworkACLListsParams {
+	return &ListNetworkACLListsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkACLListsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1118,6 +1494,11 @@ func (s *NetworkACLService) NewListNetworkACLListsParams() *ListNetworkACLListsP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkACLListIDWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkACLListIDWithContext is like GetNetworkACLListID, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) GetNetworkACLListIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkACLListsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1129,7 +1510,7 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListNetworkACLLists(p)
+	l, err := s.ListNetworkACLListsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1154,12 +1535,17 @@ func (s *NetworkACLService) GetNetworkACLListID(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
-	id, count, err := s.GetNetworkACLListID(name, opts...)
+	return s.GetNetworkACLListByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkACLListByNameWithContext is like GetNetworkACLListByName, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) GetNetworkACLListByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	id, count, err := s.GetNetworkACLListIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetNetworkACLListByID(id, opts...)
+	r, count, err := s.GetNetworkACLListByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1168,6 +1554,11 @@ func (s *NetworkACLService) GetNetworkACLListByName(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	return s.GetNetworkACLListByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkACLListByIDWithContext is like GetNetworkACLListByID, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) GetNetworkACLListByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
 	p := &ListNetworkACLListsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1179,11 +1570,10 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListNetworkACLLists(p)
+	l, err := s.ListNetworkACLListsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1201,33 +1591,76 @@ func (s *NetworkACLService) GetNetworkACLListByID(id string, opts ...OptionFunc)
 
 // Lists all network ACLs
 func (s *NetworkACLService) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLLists", p.toURLValues())
+	return s.ListNetworkACLListsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLListsWithContext is like ListNetworkACLLists, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkACLLists", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkACLListsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkACLLists", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetworkACLListsAll returns all NetworkACLLists matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkACLService) ListNetworkACLListsAll(p *ListNetworkACLListsParams, opts ...PageOption) ([]*NetworkACLList, error) {
+	return s.ListNetworkACLListsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkACLListsAllWithContext is like ListNetworkACLListsAll, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) ListNetworkACLListsAllWithContext(ctx context.Context, p *ListNetworkACLListsParams, opts ...PageOption) ([]*NetworkACLList, error) {
+	return collectPages(s.ListNetworkACLListsIterWithContext(ctx, p, opts...))
+}
+
+// ListNetworkACLListsIter returns an iterator over all NetworkACLLists matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkACLService) ListNetworkACLListsIter(p *ListNetworkACLListsParams, opts ...PageOption) iter.Seq2[*NetworkACLList, error] {
+	return s.ListNetworkACLListsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkACLListsIterWithContext is like ListNetworkACLListsIter, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) ListNetworkACLListsIterWithContext(ctx context.Context, p *ListNetworkACLListsParams, opts ...PageOption) iter.Seq2[*NetworkACLList, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetworkACLList, int, error) {
+		l, err := s.ListNetworkACLListsWithContext(ctx, &ListNetworkACLListsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetworkACLLists, l.Count, nil
+	}, opts...)
+}
+
 type ListNetworkACLListsResponse struct {
 	Count           int               `json:"count"`
 	NetworkACLLists []*NetworkACLList `json:"networkacllist"`
 }
 
 type NetworkACLList struct {
-	Description string `json:"description"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Vpcid       string `json:"vpcid"`
-	Vpcname     string `json:"vpcname"`
+	Description string                     `json:"description"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Vpcid       string                     `json:"vpcid"`
+	Vpcname     string                     `json:"vpcname"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkACLList) UnmarshalJSON(b []byte) error {
+	type alias NetworkACLList
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkACLsParams struct {
@@ -1284,7 +1717,7 @@ func (p *ListNetworkACLsParams) toURLValues() url.Values {
 		u.Set("projectid", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["tags"]; found {
 		m := v.(map[string]string)
@@ -1299,6 +1732,58 @@ func (p *ListNetworkACLsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkACLs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkACLsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkACLs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkACLs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkACLsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "aclid": 255, "action": 255, "domainid": 255, "id": 255, "keyword": 255, "networkid": 255, "projectid": 255, "protocol": 255, "traffictype": 255},
+	}.validate("listNetworkACLs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkACLs API, before the request is signed
+func (p *ListNetworkACLsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkACLsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkACLsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkACLs", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"aclid":       decodeParam[string],
+		"action":      decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"protocol":    decodeParam[Protocol],
+		"tags":        decodeParam[map[string]string],
+		"traffictype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkACLsParams) Clone() *ListNetworkACLsParams {
+	return &ListNetworkACLsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkACLsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1572,7 +2057,7 @@ func (p *ListNetworkACLsParams) GetProjectid() (string, bool) {
 	return value, ok
 }
 
-func (p *ListNetworkACLsParams) SetProtocol(v string) {
+func (p *ListNetworkACLsParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1585,11 +2070,11 @@ func (p *ListNetworkACLsParams) ResetProtocol() {
 	}
 }
 
-func (p *ListNetworkACLsParams) GetProtocol() (string, bool) {
+func (p *ListNetworkACLsParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -1645,6 +2130,11 @@ func (s *NetworkACLService) NewListNetworkACLsParams() *ListNetworkACLsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
+	return s.GetNetworkACLByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkACLByIDWithContext is like GetNetworkACLByID, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) GetNetworkACLByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACL, int, error) {
 	p := &ListNetworkACLsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1656,11 +2146,10 @@ func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*N
 		}
 	}
 
-	l, err := s.ListNetworkACLs(p)
+	l, err := s.ListNetworkACLsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1678,43 +2167,86 @@ func (s *NetworkACLService) GetNetworkACLByID(id string, opts ...OptionFunc) (*N
 
 // Lists all network ACL items
 func (s *NetworkACLService) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkACLs", p.toURLValues())
+	return s.ListNetworkACLsWithContext(context.Background(), p)
+}
+
+// ListNetworkACLsWithContext is like ListNetworkACLs, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkACLs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkACLsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkACLs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetworkACLsAll returns all NetworkACLs matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkACLService) ListNetworkACLsAll(p *ListNetworkACLsParams, opts ...PageOption) ([]*NetworkACL, error) {
+	return s.ListNetworkACLsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkACLsAllWithContext is like ListNetworkACLsAll, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) ListNetworkACLsAllWithContext(ctx context.Context, p *ListNetworkACLsParams, opts ...PageOption) ([]*NetworkACL, error) {
+	return collectPages(s.ListNetworkACLsIterWithContext(ctx, p, opts...))
+}
+
+// ListNetworkACLsIter returns an iterator over all NetworkACLs matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkACLService) ListNetworkACLsIter(p *ListNetworkACLsParams, opts ...PageOption) iter.Seq2[*NetworkACL, error] {
+	return s.ListNetworkACLsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkACLsIterWithContext is like ListNetworkACLsIter, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) ListNetworkACLsIterWithContext(ctx context.Context, p *ListNetworkACLsParams, opts ...PageOption) iter.Seq2[*NetworkACL, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetworkACL, int, error) {
+		l, err := s.ListNetworkACLsWithContext(ctx, &ListNetworkACLsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetworkACLs, l.Count, nil
+	}, opts...)
+}
+
 type ListNetworkACLsResponse struct {
 	Count       int           `json:"count"`
 	NetworkACLs []*NetworkACL `json:"networkacl"`
 }
 
 type NetworkACL struct {
-	Aclid       string `json:"aclid"`
-	Aclname     string `json:"aclname"`
-	Action      string `json:"action"`
-	Cidrlist    string `json:"cidrlist"`
-	Endport     string `json:"endport"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Icmpcode    int    `json:"icmpcode"`
-	Icmptype    int    `json:"icmptype"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Number      int    `json:"number"`
-	Protocol    string `json:"protocol"`
-	Reason      string `json:"reason"`
-	Startport   string `json:"startport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Traffictype string `json:"traffictype"`
+	Aclid       string                     `json:"aclid"`
+	Aclname     string                     `json:"aclname"`
+	Action      string                     `json:"action"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Endport     string                     `json:"endport"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Icmpcode    FlexInt                    `json:"icmpcode"`
+	Icmptype    FlexInt                    `json:"icmptype"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Number      FlexInt                    `json:"number"`
+	Protocol    string                     `json:"protocol"`
+	Reason      string                     `json:"reason"`
+	Startport   string                     `json:"startport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Traffictype string                     `json:"traffictype"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkACL) UnmarshalJSON(b []byte) error {
+	type alias NetworkACL
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MoveNetworkAclItemParams struct {
@@ -1744,6 +2276,48 @@ func (p *MoveNetworkAclItemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the moveNetworkAclItem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MoveNetworkAclItemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("moveNetworkAclItem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the moveNetworkAclItem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MoveNetworkAclItemParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"aclconsistencyhash": 255, "customid": 255, "id": 255, "nextaclruleid": 255, "previousaclruleid": 255},
+	}.validate("moveNetworkAclItem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the moveNetworkAclItem API, before the request is signed
+func (p *MoveNetworkAclItemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MoveNetworkAclItemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MoveNetworkAclItemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("moveNetworkAclItem", b, &p.p, map[string]paramDecoder{
+		"aclconsistencyhash": decodeParam[string],
+		"customid":           decodeParam[string],
+		"id":                 decodeParam[string],
+		"nextaclruleid":      decodeParam[string],
+		"previousaclruleid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MoveNetworkAclItemParams) Clone() *MoveNetworkAclItemParams {
+	return &MoveNetworkAclItemParams{p: cloneParams(p.p)}
+}
+
 func (p *MoveNetworkAclItemParams) SetAclconsistencyhash(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1860,32 +2434,39 @@ func (s *NetworkACLService) NewMoveNetworkAclItemParams(id string) *MoveNetworkA
 
 // Move an ACL rule to a position between two other ACL rules of the same ACL Network list
 func (s *NetworkACLService) MoveNetworkAclItem(p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error) {
-	resp, err := s.cs.newPostRequest("moveNetworkAclItem", p.toURLValues())
+	return s.MoveNetworkAclItemWithContext(context.Background(), p)
+}
+
+// MoveNetworkAclItemWithContext is like MoveNetworkAclItem, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) MoveNetworkAclItemWithContext(ctx context.Context, p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "moveNetworkAclItem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MoveNetworkAclItemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("moveNetworkAclItem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "moveNetworkAclItem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("moveNetworkAclItem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1893,25 +2474,50 @@ func (s *NetworkACLService) MoveNetworkAclItem(p *MoveNetworkAclItemParams) (*Mo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MoveNetworkAclItem async job into r
+func (r *MoveNetworkAclItemResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MoveNetworkAclItemAsync starts the async job of MoveNetworkAclItem without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) MoveNetworkAclItemAsync(ctx context.Context, p *MoveNetworkAclItemParams) (*Job, error) {
+	r, err := s.MoveNetworkAclItemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("moveNetworkAclItem", r.JobID), nil
+}
+
 type MoveNetworkAclItemResponse struct {
-	Aclid       string `json:"aclid"`
-	Aclname     string `json:"aclname"`
-	Action      string `json:"action"`
-	Cidrlist    string `json:"cidrlist"`
-	Endport     string `json:"endport"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Icmpcode    int    `json:"icmpcode"`
-	Icmptype    int    `json:"icmptype"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Number      int    `json:"number"`
-	Protocol    string `json:"protocol"`
-	Reason      string `json:"reason"`
-	Startport   string `json:"startport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Traffictype string `json:"traffictype"`
+	Aclid       string                     `json:"aclid"`
+	Aclname     string                     `json:"aclname"`
+	Action      string                     `json:"action"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Endport     string                     `json:"endport"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Icmpcode    FlexInt                    `json:"icmpcode"`
+	Icmptype    FlexInt                    `json:"icmptype"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Number      FlexInt                    `json:"number"`
+	Protocol    string                     `json:"protocol"`
+	Reason      string                     `json:"reason"`
+	Startport   string                     `json:"startport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Traffictype string                     `json:"traffictype"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MoveNetworkAclItemResponse) UnmarshalJSON(b []byte) error {
+	type alias MoveNetworkAclItemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReplaceNetworkACLListParams struct {
@@ -1935,6 +2541,46 @@ func (p *ReplaceNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the replaceNetworkACLList API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReplaceNetworkACLListParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("replaceNetworkACLList", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the replaceNetworkACLList API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReplaceNetworkACLListParams) Validate() error {
+	return paramRules{
+		required: []string{"aclid"},
+		lengths:  map[string]int{"aclid": 255, "gatewayid": 255, "networkid": 255},
+	}.validate("replaceNetworkACLList", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the replaceNetworkACLList API, before the request is signed
+func (p *ReplaceNetworkACLListParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReplaceNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReplaceNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("replaceNetworkACLList", b, &p.p, map[string]paramDecoder{
+		"aclid":     decodeParam[string],
+		"gatewayid": decodeParam[string],
+		"networkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReplaceNetworkACLListParams) Clone() *ReplaceNetworkACLListParams {
+	return &ReplaceNetworkACLListParams{p: cloneParams(p.p)}
+}
+
 func (p *ReplaceNetworkACLListParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2009,27 +2655,39 @@ func (s *NetworkACLService) NewReplaceNetworkACLListParams(aclid string) *Replac
 
 // Replaces ACL associated with a network or private gateway
 func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
-	resp, err := s.cs.newPostRequest("replaceNetworkACLList", p.toURLValues())
+	return s.ReplaceNetworkACLListWithContext(context.Background(), p)
+}
+
+// ReplaceNetworkACLListWithContext is like ReplaceNetworkACLList, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "replaceNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReplaceNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("replaceNetworkACLList", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "replaceNetworkACLList", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("replaceNetworkACLList", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2037,11 +2695,31 @@ func (s *NetworkACLService) ReplaceNetworkACLList(p *ReplaceNetworkACLListParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReplaceNetworkACLList async job into r
+func (r *ReplaceNetworkACLListResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ReplaceNetworkACLListAsync starts the async job of ReplaceNetworkACLList without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) ReplaceNetworkACLListAsync(ctx context.Context, p *ReplaceNetworkACLListParams) (*Job, error) {
+	r, err := s.ReplaceNetworkACLListWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("replaceNetworkACLList", r.JobID), nil
+}
+
 type ReplaceNetworkACLListResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReplaceNetworkACLListResponse) UnmarshalJSON(b []byte) error {
+	type alias ReplaceNetworkACLListResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkACLItemParams struct {
@@ -2091,7 +2769,7 @@ func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
 		u.Set("partialupgrade", vv)
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["reason"]; found {
 		u.Set("reason", v.(string))
@@ -2106,6 +2784,57 @@ func (p *UpdateNetworkACLItemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateNetworkACLItem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateNetworkACLItemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateNetworkACLItem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateNetworkACLItem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateNetworkACLItemParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"action": 255, "customid": 255, "id": 255, "protocol": 255, "reason": 255, "traffictype": 255},
+	}.validate("updateNetworkACLItem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateNetworkACLItem API, before the request is signed
+func (p *UpdateNetworkACLItemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateNetworkACLItemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateNetworkACLItemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateNetworkACLItem", b, &p.p, map[string]paramDecoder{
+		"action":         decodeParam[string],
+		"cidrlist":       decodeParam[[]string],
+		"customid":       decodeParam[string],
+		"endport":        decodeParam[int],
+		"fordisplay":     decodeParam[bool],
+		"icmpcode":       decodeParam[int],
+		"icmptype":       decodeParam[int],
+		"id":             decodeParam[string],
+		"number":         decodeParam[int],
+		"partialupgrade": decodeParam[bool],
+		"protocol":       decodeParam[Protocol],
+		"reason":         decodeParam[string],
+		"startport":      decodeParam[int],
+		"traffictype":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateNetworkACLItemParams) Clone() *UpdateNetworkACLItemParams {
+	return &UpdateNetworkACLItemParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateNetworkACLItemParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2316,7 +3045,7 @@ func (p *UpdateNetworkACLItemParams) GetPartialupgrade() (bool, bool) {
 	return value, ok
 }
 
-func (p *UpdateNetworkACLItemParams) SetProtocol(v string) {
+func (p *UpdateNetworkACLItemParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -2329,11 +3058,11 @@ func (p *UpdateNetworkACLItemParams) ResetProtocol() {
 	}
 }
 
-func (p *UpdateNetworkACLItemParams) GetProtocol() (string, bool) {
+func (p *UpdateNetworkACLItemParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -2411,32 +3140,39 @@ func (s *NetworkACLService) NewUpdateNetworkACLItemParams(id string) *UpdateNetw
 
 // Updates ACL item with specified ID
 func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
-	resp, err := s.cs.newPostRequest("updateNetworkACLItem", p.toURLValues())
+	return s.UpdateNetworkACLItemWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLItemWithContext is like UpdateNetworkACLItem, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateNetworkACLItem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateNetworkACLItemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateNetworkACLItem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateNetworkACLItem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateNetworkACLItem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2444,25 +3180,50 @@ func (s *NetworkACLService) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateNetworkACLItem async job into r
+func (r *UpdateNetworkACLItemResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateNetworkACLItemAsync starts the async job of UpdateNetworkACLItem without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) UpdateNetworkACLItemAsync(ctx context.Context, p *UpdateNetworkACLItemParams) (*Job, error) {
+	r, err := s.UpdateNetworkACLItemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateNetworkACLItem", r.JobID), nil
+}
+
 type UpdateNetworkACLItemResponse struct {
-	Aclid       string `json:"aclid"`
-	Aclname     string `json:"aclname"`
-	Action      string `json:"action"`
-	Cidrlist    string `json:"cidrlist"`
-	Endport     string `json:"endport"`
-	Fordisplay  bool   `json:"fordisplay"`
-	Icmpcode    int    `json:"icmpcode"`
-	Icmptype    int    `json:"icmptype"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Number      int    `json:"number"`
-	Protocol    string `json:"protocol"`
-	Reason      string `json:"reason"`
-	Startport   string `json:"startport"`
-	State       string `json:"state"`
-	Tags        []Tags `json:"tags"`
-	Traffictype string `json:"traffictype"`
+	Aclid       string                     `json:"aclid"`
+	Aclname     string                     `json:"aclname"`
+	Action      string                     `json:"action"`
+	Cidrlist    string                     `json:"cidrlist"`
+	Endport     string                     `json:"endport"`
+	Fordisplay  FlexBool                   `json:"fordisplay"`
+	Icmpcode    FlexInt                    `json:"icmpcode"`
+	Icmptype    FlexInt                    `json:"icmptype"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Number      FlexInt                    `json:"number"`
+	Protocol    string                     `json:"protocol"`
+	Reason      string                     `json:"reason"`
+	Startport   string                     `json:"startport"`
+	State       string                     `json:"state"`
+	Tags        []Tags                     `json:"tags"`
+	Traffictype string                     `json:"traffictype"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateNetworkACLItemResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkACLItemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkACLListParams struct {
@@ -2493,6 +3254,48 @@ func (p *UpdateNetworkACLListParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateNetworkACLList API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateNetworkACLListParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateNetworkACLList", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateNetworkACLList API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateNetworkACLListParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "description": 4096, "id": 255, "name": 255},
+	}.validate("updateNetworkACLList", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateNetworkACLList API, before the request is signed
+func (p *UpdateNetworkACLListParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateNetworkACLListParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateNetworkACLListParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateNetworkACLList", b, &p.p, map[string]paramDecoder{
+		"customid":    decodeParam[string],
+		"description": decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"name":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateNetworkACLListParams) Clone() *UpdateNetworkACLListParams {
+	return &UpdateNetworkACLListParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateNetworkACLListParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2609,27 +3412,39 @@ func (s *NetworkACLService) NewUpdateNetworkACLListParams(id string) *UpdateNetw
 
 // Updates Network ACL list
 func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
-	resp, err := s.cs.newPostRequest("updateNetworkACLList", p.toURLValues())
+	return s.UpdateNetworkACLListWithContext(context.Background(), p)
+}
+
+// UpdateNetworkACLListWithContext is like UpdateNetworkACLList, but honours the cancellation and deadline of ctx
+func (s *NetworkACLService) UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateNetworkACLList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateNetworkACLListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateNetworkACLList", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateNetworkACLList", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateNetworkACLList", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2637,9 +3452,29 @@ func (s *NetworkACLService) UpdateNetworkACLList(p *UpdateNetworkACLListParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateNetworkACLList async job into r
+func (r *UpdateNetworkACLListResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// UpdateNetworkACLListAsync starts the async job of UpdateNetworkACLList without waiting for it to finish, and returns a handle to the job
+func (s *NetworkACLService) UpdateNetworkACLListAsync(ctx context.Context, p *UpdateNetworkACLListParams) (*Job, error) {
+	r, err := s.UpdateNetworkACLListWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateNetworkACLList", r.JobID), nil
+}
+
 type UpdateNetworkACLListResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateNetworkACLListResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkACLListResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACL(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACL", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACL), p)
 }
 
+// CreateNetworkACLAsync mocks base method.
+func (m *MockNetworkACLServiceIface) CreateNetworkACLAsync(ctx context.Context, p *CreateNetworkACLParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkACLAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkACLAsync indicates an expected call of CreateNetworkACLAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACLAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACLAsync), ctx, p)
+}
+
 // CreateNetworkACLList mocks base method.
 func (m *MockNetworkACLServiceIface) CreateNetworkACLList(p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,51 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACLList(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACLList), p)
 }
 
+// CreateNetworkACLListAsync mocks base method.
+func (m *MockNetworkACLServiceIface) CreateNetworkACLListAsync(ctx context.Context, p *CreateNetworkACLListParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkACLListAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkACLListAsync indicates an expected call of CreateNetworkACLListAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACLListAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLListAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACLListAsync), ctx, p)
+}
+
+// CreateNetworkACLListWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) CreateNetworkACLListWithContext(ctx context.Context, p *CreateNetworkACLListParams) (*CreateNetworkACLListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkACLListWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateNetworkACLListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkACLListWithContext indicates an expected call of CreateNetworkACLListWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACLListWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLListWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACLListWithContext), ctx, p)
+}
+
+// CreateNetworkACLWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) CreateNetworkACLWithContext(ctx context.Context, p *CreateNetworkACLParams) (*CreateNetworkACLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkACLWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateNetworkACLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkACLWithContext indicates an expected call of CreateNetworkACLWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) CreateNetworkACLWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkACLWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).CreateNetworkACLWithContext), ctx, p)
+}
+
 // DeleteNetworkACL mocks base method.
 func (m *MockNetworkACLServiceIface) DeleteNetworkACL(p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,21 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACL(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACL", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACL), p)
 }
 
+// DeleteNetworkACLAsync mocks base method.
+func (m *MockNetworkACLServiceIface) DeleteNetworkACLAsync(ctx context.Context, p *DeleteNetworkACLParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkACLAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkACLAsync indicates an expected call of DeleteNetworkACLAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACLAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACLAsync), ctx, p)
+}
+
 // DeleteNetworkACLList mocks base method.
 func (m *MockNetworkACLServiceIface) DeleteNetworkACLList(p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,51 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACLList(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACLList), p)
 }
 
+// DeleteNetworkACLListAsync mocks base method.
+func (m *MockNetworkACLServiceIface) DeleteNetworkACLListAsync(ctx context.Context, p *DeleteNetworkACLListParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkACLListAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkACLListAsync indicates an expected call of DeleteNetworkACLListAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACLListAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLListAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACLListAsync), ctx, p)
+}
+
+// DeleteNetworkACLListWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) DeleteNetworkACLListWithContext(ctx context.Context, p *DeleteNetworkACLListParams) (*DeleteNetworkACLListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkACLListWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetworkACLListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkACLListWithContext indicates an expected call of DeleteNetworkACLListWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACLListWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLListWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACLListWithContext), ctx, p)
+}
+
+// DeleteNetworkACLWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) DeleteNetworkACLWithContext(ctx context.Context, p *DeleteNetworkACLParams) (*DeleteNetworkACLResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkACLWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetworkACLResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkACLWithContext indicates an expected call of DeleteNetworkACLWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) DeleteNetworkACLWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkACLWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).DeleteNetworkACLWithContext), ctx, p)
+}
+
 // GetNetworkACLByID mocks base method.
 func (m *MockNetworkACLServiceIface) GetNetworkACLByID(id string, opts ...OptionFunc) (*NetworkACL, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +261,27 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLByID", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLByID), varargs...)
 }
 
+// GetNetworkACLByIDWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) GetNetworkACLByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACL, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkACLByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*NetworkACL)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkACLByIDWithContext indicates an expected call of GetNetworkACLByIDWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLByIDWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLByIDWithContext), varargs...)
+}
+
 // GetNetworkACLListByID mocks base method.
 func (m *MockNetworkACLServiceIface) GetNetworkACLListByID(id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +303,27 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLListByID(id any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLListByID", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLListByID), varargs...)
 }
 
+// GetNetworkACLListByIDWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) GetNetworkACLListByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkACLListByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*NetworkACLList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkACLListByIDWithContext indicates an expected call of GetNetworkACLListByIDWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLListByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLListByIDWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLListByIDWithContext), varargs...)
+}
+
 // GetNetworkACLListByName mocks base method.
 func (m *MockNetworkACLServiceIface) GetNetworkACLListByName(name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +345,27 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLListByName(name a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLListByName", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLListByName), varargs...)
 }
 
+// GetNetworkACLListByNameWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) GetNetworkACLListByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkACLList, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkACLListByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*NetworkACLList)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkACLListByNameWithContext indicates an expected call of GetNetworkACLListByNameWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLListByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLListByNameWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLListByNameWithContext), varargs...)
+}
+
 // GetNetworkACLListID mocks base method.
 func (m *MockNetworkACLServiceIface) GetNetworkACLListID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +387,27 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLListID(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLListID", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLListID), varargs...)
 }
 
+// GetNetworkACLListIDWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) GetNetworkACLListIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkACLListIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkACLListIDWithContext indicates an expected call of GetNetworkACLListIDWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) GetNetworkACLListIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkACLListIDWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).GetNetworkACLListIDWithContext), varargs...)
+}
+
 // ListNetworkACLLists mocks base method.
 func (m *MockNetworkACLServiceIface) ListNetworkACLLists(p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +423,99 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLLists(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLLists", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLLists), p)
 }
 
+// ListNetworkACLListsAll mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLListsAll(p *ListNetworkACLListsParams, opts ...PageOption) ([]*NetworkACLList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLListsAll", varargs...)
+	ret0, _ := ret[0].([]*NetworkACLList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkACLListsAll indicates an expected call of ListNetworkACLListsAll.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLListsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLListsAll", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLListsAll), varargs...)
+}
+
+// ListNetworkACLListsAllWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLListsAllWithContext(ctx context.Context, p *ListNetworkACLListsParams, opts ...PageOption) ([]*NetworkACLList, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLListsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetworkACLList)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkACLListsAllWithContext indicates an expected call of ListNetworkACLListsAllWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLListsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLListsAllWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLListsAllWithContext), varargs...)
+}
+
+// ListNetworkACLListsIter mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLListsIter(p *ListNetworkACLListsParams, opts ...PageOption) iter.Seq2[*NetworkACLList, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLListsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkACLList, error])
+	return ret0
+}
+
+// ListNetworkACLListsIter indicates an expected call of ListNetworkACLListsIter.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLListsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLListsIter", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLListsIter), varargs...)
+}
+
+// ListNetworkACLListsIterWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLListsIterWithContext(ctx context.Context, p *ListNetworkACLListsParams, opts ...PageOption) iter.Seq2[*NetworkACLList, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLListsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkACLList, error])
+	return ret0
+}
+
+// ListNetworkACLListsIterWithContext indicates an expected call of ListNetworkACLListsIterWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLListsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLListsIterWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLListsIterWithContext), varargs...)
+}
+
+// ListNetworkACLListsWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLListsWithContext(ctx context.Context, p *ListNetworkACLListsParams) (*ListNetworkACLListsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkACLListsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkACLListsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkACLListsWithContext indicates an expected call of ListNetworkACLListsWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLListsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLListsWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLListsWithContext), ctx, p)
+}
+
 // ListNetworkACLs mocks base method.
 func (m *MockNetworkACLServiceIface) ListNetworkACLs(p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +531,99 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLs(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLs", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLs), p)
 }
 
+// ListNetworkACLsAll mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLsAll(p *ListNetworkACLsParams, opts ...PageOption) ([]*NetworkACL, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLsAll", varargs...)
+	ret0, _ := ret[0].([]*NetworkACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkACLsAll indicates an expected call of ListNetworkACLsAll.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLsAll", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLsAll), varargs...)
+}
+
+// ListNetworkACLsAllWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLsAllWithContext(ctx context.Context, p *ListNetworkACLsParams, opts ...PageOption) ([]*NetworkACL, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetworkACL)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkACLsAllWithContext indicates an expected call of ListNetworkACLsAllWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLsAllWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLsAllWithContext), varargs...)
+}
+
+// ListNetworkACLsIter mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLsIter(p *ListNetworkACLsParams, opts ...PageOption) iter.Seq2[*NetworkACL, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkACL, error])
+	return ret0
+}
+
+// ListNetworkACLsIter indicates an expected call of ListNetworkACLsIter.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLsIter", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLsIter), varargs...)
+}
+
+// ListNetworkACLsIterWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLsIterWithContext(ctx context.Context, p *ListNetworkACLsParams, opts ...PageOption) iter.Seq2[*NetworkACL, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkACLsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkACL, error])
+	return ret0
+}
+
+// ListNetworkACLsIterWithContext indicates an expected call of ListNetworkACLsIterWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLsIterWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLsIterWithContext), varargs...)
+}
+
+// ListNetworkACLsWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) ListNetworkACLsWithContext(ctx context.Context, p *ListNetworkACLsParams) (*ListNetworkACLsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkACLsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkACLsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkACLsWithContext indicates an expected call of ListNetworkACLsWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ListNetworkACLsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkACLsWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ListNetworkACLsWithContext), ctx, p)
+}
+
 // MoveNetworkAclItem mocks base method.
 func (m *MockNetworkACLServiceIface) MoveNetworkAclItem(p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +639,36 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) MoveNetworkAclItem(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNetworkAclItem", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).MoveNetworkAclItem), p)
 }
 
+// MoveNetworkAclItemAsync mocks base method.
+func (m *MockNetworkACLServiceIface) MoveNetworkAclItemAsync(ctx context.Context, p *MoveNetworkAclItemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveNetworkAclItemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveNetworkAclItemAsync indicates an expected call of MoveNetworkAclItemAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) MoveNetworkAclItemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNetworkAclItemAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).MoveNetworkAclItemAsync), ctx, p)
+}
+
+// MoveNetworkAclItemWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) MoveNetworkAclItemWithContext(ctx context.Context, p *MoveNetworkAclItemParams) (*MoveNetworkAclItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveNetworkAclItemWithContext", ctx, p)
+	ret0, _ := ret[0].(*MoveNetworkAclItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveNetworkAclItemWithContext indicates an expected call of MoveNetworkAclItemWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) MoveNetworkAclItemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveNetworkAclItemWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).MoveNetworkAclItemWithContext), ctx, p)
+}
+
 // NewCreateNetworkACLListParams mocks base method.
 func (m *MockNetworkACLServiceIface) NewCreateNetworkACLListParams(name, vpcid string) *CreateNetworkACLListParams {
 	m.ctrl.T.Helper()
@@ -262,7 +684,7 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) NewCreateNetworkACLListParams(
 }
 
 // NewCreateNetworkACLParams mocks base method.
-func (m *MockNetworkACLServiceIface) NewCreateNetworkACLParams(protocol string) *CreateNetworkACLParams {
+func (m *MockNetworkACLServiceIface) NewCreateNetworkACLParams(protocol Protocol) *CreateNetworkACLParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateNetworkACLParams", protocol)
 	ret0, _ := ret[0].(*CreateNetworkACLParams)
@@ -402,6 +824,36 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) ReplaceNetworkACLList(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ReplaceNetworkACLList), p)
 }
 
+// ReplaceNetworkACLListAsync mocks base method.
+func (m *MockNetworkACLServiceIface) ReplaceNetworkACLListAsync(ctx context.Context, p *ReplaceNetworkACLListParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNetworkACLListAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceNetworkACLListAsync indicates an expected call of ReplaceNetworkACLListAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ReplaceNetworkACLListAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkACLListAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ReplaceNetworkACLListAsync), ctx, p)
+}
+
+// ReplaceNetworkACLListWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) ReplaceNetworkACLListWithContext(ctx context.Context, p *ReplaceNetworkACLListParams) (*ReplaceNetworkACLListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReplaceNetworkACLListWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReplaceNetworkACLListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReplaceNetworkACLListWithContext indicates an expected call of ReplaceNetworkACLListWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) ReplaceNetworkACLListWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReplaceNetworkACLListWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).ReplaceNetworkACLListWithContext), ctx, p)
+}
+
 // UpdateNetworkACLItem mocks base method.
 func (m *MockNetworkACLServiceIface) UpdateNetworkACLItem(p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
 	m.ctrl.T.Helper()
@@ -417,6 +869,36 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLItem(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLItem", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLItem), p)
 }
 
+// UpdateNetworkACLItemAsync mocks base method.
+func (m *MockNetworkACLServiceIface) UpdateNetworkACLItemAsync(ctx context.Context, p *UpdateNetworkACLItemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkACLItemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkACLItemAsync indicates an expected call of UpdateNetworkACLItemAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLItemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLItemAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLItemAsync), ctx, p)
+}
+
+// UpdateNetworkACLItemWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) UpdateNetworkACLItemWithContext(ctx context.Context, p *UpdateNetworkACLItemParams) (*UpdateNetworkACLItemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkACLItemWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateNetworkACLItemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkACLItemWithContext indicates an expected call of UpdateNetworkACLItemWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLItemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLItemWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLItemWithContext), ctx, p)
+}
+
 // UpdateNetworkACLList mocks base method.
 func (m *MockNetworkACLServiceIface) UpdateNetworkACLList(p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
 	m.ctrl.T.Helper()
@@ -431,3 +913,33 @@ func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLList(p any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLList", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLList), p)
 }
+
+// UpdateNetworkACLListAsync mocks base method.
+func (m *MockNetworkACLServiceIface) UpdateNetworkACLListAsync(ctx context.Context, p *UpdateNetworkACLListParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkACLListAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkACLListAsync indicates an expected call of UpdateNetworkACLListAsync.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLListAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLListAsync", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLListAsync), ctx, p)
+}
+
+// UpdateNetworkACLListWithContext mocks base method.
+func (m *MockNetworkACLServiceIface) UpdateNetworkACLListWithContext(ctx context.Context, p *UpdateNetworkACLListParams) (*UpdateNetworkACLListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkACLListWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateNetworkACLListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkACLListWithContext indicates an expected call of UpdateNetworkACLListWithContext.
+func (mr *MockNetworkACLServiceIfaceMockRecorder) UpdateNetworkACLListWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkACLListWithContext", reflect.TypeOf((*MockNetworkACLServiceIface)(nil).UpdateNetworkACLListWithContext), ctx, p)
+}
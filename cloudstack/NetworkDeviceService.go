@@ -20,18 +20,27 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type NetworkDeviceServiceIface interface {
 	AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
+	AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error)
 	NewAddNetworkDeviceParams() *AddNetworkDeviceParams
 	DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
+	DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error)
 	NewDeleteNetworkDeviceParams(id string) *DeleteNetworkDeviceParams
 	ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error)
+	ListNetworkDeviceAll(p *ListNetworkDeviceParams, opts ...PageOption) ([]*NetworkDevice, error)
+	ListNetworkDeviceAllWithContext(ctx context.Context, p *ListNetworkDeviceParams, opts ...PageOption) ([]*NetworkDevice, error)
+	ListNetworkDeviceIter(p *ListNetworkDeviceParams, opts ...PageOption) iter.Seq2[*NetworkDevice, error]
+	ListNetworkDeviceIterWithContext(ctx context.Context, p *ListNetworkDeviceParams, opts ...PageOption) iter.Seq2[*NetworkDevice, error]
 	NewListNetworkDeviceParams() *ListNetworkDeviceParams
 }
 
@@ -57,6 +66,44 @@ func (p *AddNetworkDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNetworkDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNetworkDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNetworkDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNetworkDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNetworkDeviceParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"networkdevicetype": 255},
+	}.validate("addNetworkDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNetworkDevice API, before the request is signed
+func (p *AddNetworkDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNetworkDevice", b, &p.p, map[string]paramDecoder{
+		"networkdeviceparameterlist": decodeParam[map[string]string],
+		"networkdevicetype":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNetworkDeviceParams) Clone() *AddNetworkDeviceParams {
+	return &AddNetworkDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNetworkDeviceParams) SetNetworkdeviceparameterlist(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -109,13 +156,22 @@ func (s *NetworkDeviceService) NewAddNetworkDeviceParams() *AddNetworkDevicePara
 
 // Adds a network device of one of the following types: ExternalDhcp, ExternalFirewall, ExternalLoadBalancer, PxeServer
 func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("addNetworkDevice", p.toURLValues())
+	return s.AddNetworkDeviceWithContext(context.Background(), p)
+}
+
+// AddNetworkDeviceWithContext is like AddNetworkDevice, but honours the cancellation and deadline of ctx
+func (s *NetworkDeviceService) AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addNetworkDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNetworkDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNetworkDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -123,9 +179,15 @@ func (s *NetworkDeviceService) AddNetworkDevice(p *AddNetworkDeviceParams) (*Add
 }
 
 type AddNetworkDeviceResponse struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNetworkDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias AddNetworkDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetworkDeviceParams struct {
@@ -143,6 +205,44 @@ func (p *DeleteNetworkDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetworkDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetworkDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetworkDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetworkDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetworkDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetworkDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetworkDevice API, before the request is signed
+func (p *DeleteNetworkDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetworkDevice", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetworkDeviceParams) Clone() *DeleteNetworkDeviceParams {
+	return &DeleteNetworkDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetworkDeviceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -175,13 +275,22 @@ func (s *NetworkDeviceService) NewDeleteNetworkDeviceParams(id string) *DeleteNe
 
 // Deletes network device.
 func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetworkDevice", p.toURLValues())
+	return s.DeleteNetworkDeviceWithContext(context.Background(), p)
+}
+
+// DeleteNetworkDeviceWithContext is like DeleteNetworkDevice, but honours the cancellation and deadline of ctx
+func (s *NetworkDeviceService) DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetworkDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetworkDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -189,10 +298,11 @@ func (s *NetworkDeviceService) DeleteNetworkDevice(p *DeleteNetworkDeviceParams)
 }
 
 type DeleteNetworkDeviceResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteNetworkDeviceResponse) UnmarshalJSON(b []byte) error {
@@ -219,7 +329,7 @@ func (r *DeleteNetworkDeviceResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteNetworkDeviceResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkDeviceParams struct {
@@ -255,6 +365,47 @@ func (p *ListNetworkDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkDeviceParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "networkdevicetype": 255},
+	}.validate("listNetworkDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkDevice API, before the request is signed
+func (p *ListNetworkDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkDevice", b, &p.p, map[string]paramDecoder{
+		"keyword":                    decodeParam[string],
+		"networkdeviceparameterlist": decodeParam[map[string]string],
+		"networkdevicetype":          decodeParam[string],
+		"page":                       decodeParam[int],
+		"pagesize":                   decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkDeviceParams) Clone() *ListNetworkDeviceParams {
+	return &ListNetworkDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkDeviceParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -370,26 +521,69 @@ func (s *NetworkDeviceService) NewListNetworkDeviceParams() *ListNetworkDevicePa
 
 // List network devices
 func (s *NetworkDeviceService) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkDevice", p.toURLValues())
+	return s.ListNetworkDeviceWithContext(context.Background(), p)
+}
+
+// ListNetworkDeviceWithContext is like ListNetworkDevice, but honours the cancellation and deadline of ctx
+func (s *NetworkDeviceService) ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetworkDeviceAll returns all NetworkDevice matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkDeviceService) ListNetworkDeviceAll(p *ListNetworkDeviceParams, opts ...PageOption) ([]*NetworkDevice, error) {
+	return s.ListNetworkDeviceAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkDeviceAllWithContext is like ListNetworkDeviceAll, but honours the cancellation and deadline of ctx
+func (s *NetworkDeviceService) ListNetworkDeviceAllWithContext(ctx context.Context, p *ListNetworkDeviceParams, opts ...PageOption) ([]*NetworkDevice, error) {
+	return collectPages(s.ListNetworkDeviceIterWithContext(ctx, p, opts...))
+}
+
+// ListNetworkDeviceIter returns an iterator over all NetworkDevice matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkDeviceService) ListNetworkDeviceIter(p *ListNetworkDeviceParams, opts ...PageOption) iter.Seq2[*NetworkDevice, error] {
+	return s.ListNetworkDeviceIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkDeviceIterWithContext is like ListNetworkDeviceIter, but honours the cancellation and deadline of ctx
+func (s *NetworkDeviceService) ListNetworkDeviceIterWithContext(ctx context.Context, p *ListNetworkDeviceParams, opts ...PageOption) iter.Seq2[*NetworkDevice, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetworkDevice, int, error) {
+		l, err := s.ListNetworkDeviceWithContext(ctx, &ListNetworkDeviceParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetworkDevice, l.Count, nil
+	}, opts...)
+}
+
 type ListNetworkDeviceResponse struct {
 	Count         int              `json:"count"`
 	NetworkDevice []*NetworkDevice `json:"networkdevice"`
 }
 
 type NetworkDevice struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkDevice) UnmarshalJSON(b []byte) error {
+	type alias NetworkDevice
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
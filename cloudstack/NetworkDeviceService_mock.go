@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockNetworkDeviceServiceIfaceMockRecorder) AddNetworkDevice(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).AddNetworkDevice), p)
 }
 
+// AddNetworkDeviceWithContext mocks base method.
+func (m *MockNetworkDeviceServiceIface) AddNetworkDeviceWithContext(ctx context.Context, p *AddNetworkDeviceParams) (*AddNetworkDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetworkDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNetworkDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNetworkDeviceWithContext indicates an expected call of AddNetworkDeviceWithContext.
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) AddNetworkDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkDeviceWithContext", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).AddNetworkDeviceWithContext), ctx, p)
+}
+
 // DeleteNetworkDevice mocks base method.
 func (m *MockNetworkDeviceServiceIface) DeleteNetworkDevice(p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockNetworkDeviceServiceIfaceMockRecorder) DeleteNetworkDevice(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).DeleteNetworkDevice), p)
 }
 
+// DeleteNetworkDeviceWithContext mocks base method.
+func (m *MockNetworkDeviceServiceIface) DeleteNetworkDeviceWithContext(ctx context.Context, p *DeleteNetworkDeviceParams) (*DeleteNetworkDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetworkDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkDeviceWithContext indicates an expected call of DeleteNetworkDeviceWithContext.
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) DeleteNetworkDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkDeviceWithContext", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).DeleteNetworkDeviceWithContext), ctx, p)
+}
+
 // ListNetworkDevice mocks base method.
 func (m *MockNetworkDeviceServiceIface) ListNetworkDevice(p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,99 @@ func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDevice(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDevice", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDevice), p)
 }
 
+// ListNetworkDeviceAll mocks base method.
+func (m *MockNetworkDeviceServiceIface) ListNetworkDeviceAll(p *ListNetworkDeviceParams, opts ...PageOption) ([]*NetworkDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkDeviceAll", varargs...)
+	ret0, _ := ret[0].([]*NetworkDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkDeviceAll indicates an expected call of ListNetworkDeviceAll.
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDeviceAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDeviceAll", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDeviceAll), varargs...)
+}
+
+// ListNetworkDeviceAllWithContext mocks base method.
+func (m *MockNetworkDeviceServiceIface) ListNetworkDeviceAllWithContext(ctx context.Context, p *ListNetworkDeviceParams, opts ...PageOption) ([]*NetworkDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkDeviceAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetworkDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkDeviceAllWithContext indicates an expected call of ListNetworkDeviceAllWithContext.
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDeviceAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDeviceAllWithContext", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDeviceAllWithContext), varargs...)
+}
+
+// ListNetworkDeviceIter mocks base method.
+func (m *MockNetworkDeviceServiceIface) ListNetworkDeviceIter(p *ListNetworkDeviceParams, opts ...PageOption) iter.Seq2[*NetworkDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkDeviceIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkDevice, error])
+	return ret0
+}
+
+// ListNetworkDeviceIter indicates an expected call of ListNetworkDeviceIter.
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDeviceIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDeviceIter", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDeviceIter), varargs...)
+}
+
+// ListNetworkDeviceIterWithContext mocks base method.
+func (m *MockNetworkDeviceServiceIface) ListNetworkDeviceIterWithContext(ctx context.Context, p *ListNetworkDeviceParams, opts ...PageOption) iter.Seq2[*NetworkDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkDeviceIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkDevice, error])
+	return ret0
+}
+
+// ListNetworkDeviceIterWithContext indicates an expected call of ListNetworkDeviceIterWithContext.
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDeviceIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDeviceIterWithContext", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDeviceIterWithContext), varargs...)
+}
+
+// ListNetworkDeviceWithContext mocks base method.
+func (m *MockNetworkDeviceServiceIface) ListNetworkDeviceWithContext(ctx context.Context, p *ListNetworkDeviceParams) (*ListNetworkDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkDeviceWithContext indicates an expected call of ListNetworkDeviceWithContext.
+func (mr *MockNetworkDeviceServiceIfaceMockRecorder) ListNetworkDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkDeviceWithContext", reflect.TypeOf((*MockNetworkDeviceServiceIface)(nil).ListNetworkDeviceWithContext), ctx, p)
+}
+
 // NewAddNetworkDeviceParams mocks base method.
 func (m *MockNetworkDeviceServiceIface) NewAddNetworkDeviceParams() *AddNetworkDeviceParams {
 	m.ctrl.T.Helper()
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,15 +31,26 @@ import (
 
 type NetworkOfferingServiceIface interface {
 	CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
+	CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error)
 	NewCreateNetworkOfferingParams(displaytext string, guestiptype string, name string, traffictype string) *CreateNetworkOfferingParams
 	DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
+	DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error)
 	NewDeleteNetworkOfferingParams(id string) *DeleteNetworkOfferingParams
 	ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error)
+	ListNetworkOfferingsAll(p *ListNetworkOfferingsParams, opts ...PageOption) ([]*NetworkOffering, error)
+	ListNetworkOfferingsAllWithContext(ctx context.Context, p *ListNetworkOfferingsParams, opts ...PageOption) ([]*NetworkOffering, error)
+	ListNetworkOfferingsIter(p *ListNetworkOfferingsParams, opts ...PageOption) iter.Seq2[*NetworkOffering, error]
+	ListNetworkOfferingsIterWithContext(ctx context.Context, p *ListNetworkOfferingsParams, opts ...PageOption) iter.Seq2[*NetworkOffering, error]
 	NewListNetworkOfferingsParams() *ListNetworkOfferingsParams
 	GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkOffering, int, error)
 	GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error)
+	GetNetworkOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkOffering, int, error)
 	UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
+	UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error)
 	NewUpdateNetworkOfferingParams() *UpdateNetworkOfferingParams
 }
 
@@ -179,6 +192,75 @@ func (p *CreateNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createNetworkOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateNetworkOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createNetworkOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createNetworkOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateNetworkOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "guestiptype", "name", "traffictype"},
+		lengths:  map[string]int{"availability": 255, "displaytext": 4096, "guestiptype": 255, "internetprotocol": 255, "name": 255, "networkmode": 255, "provider": 255, "routingmode": 255, "serviceofferingid": 255, "tags": 4096, "traffictype": 255},
+	}.validate("createNetworkOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createNetworkOffering API, before the request is signed
+func (p *CreateNetworkOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createNetworkOffering", b, &p.p, map[string]paramDecoder{
+		"availability":          decodeParam[string],
+		"conservemode":          decodeParam[bool],
+		"details":               decodeParam[map[string]string],
+		"displaytext":           decodeParam[string],
+		"domainid":              decodeParam[[]string],
+		"egressdefaultpolicy":   decodeParam[bool],
+		"enable":                decodeParam[bool],
+		"fornsx":                decodeParam[bool],
+		"fortungsten":           decodeParam[bool],
+		"forvpc":                decodeParam[bool],
+		"guestiptype":           decodeParam[string],
+		"internetprotocol":      decodeParam[string],
+		"ispersistent":          decodeParam[bool],
+		"keepaliveenabled":      decodeParam[bool],
+		"maxconnections":        decodeParam[int],
+		"name":                  decodeParam[string],
+		"networkmode":           decodeParam[string],
+		"networkrate":           decodeParam[int],
+		"nsxsupportlb":          decodeParam[bool],
+		"nsxsupportsinternallb": decodeParam[bool],
+		"provider":              decodeParam[string],
+		"routingmode":           decodeParam[string],
+		"servicecapabilitylist": decodeParam[[]map[string]string],
+		"serviceofferingid":     decodeParam[string],
+		"serviceproviderlist":   decodeParam[map[string]string],
+		"specifyasnumber":       decodeParam[bool],
+		"specifyipranges":       decodeParam[bool],
+		"specifyvlan":           decodeParam[bool],
+		"supportedservices":     decodeParam[[]string],
+		"tags":                  decodeParam[string],
+		"traffictype":           decodeParam[string],
+		"zoneid":                decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateNetworkOfferingParams) Clone() *CreateNetworkOfferingParams {
+	return &CreateNetworkOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateNetworkOfferingParams) SetAvailability(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -879,7 +961,16 @@ func (s *NetworkOfferingService) NewCreateNetworkOfferingParams(displaytext stri
 
 // Creates a network offering.
 func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("createNetworkOffering", p.toURLValues())
+	return s.CreateNetworkOfferingWithContext(context.Background(), p)
+}
+
+// CreateNetworkOfferingWithContext is like CreateNetworkOffering, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createNetworkOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -889,7 +980,7 @@ func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingP
 	}
 
 	var r CreateNetworkOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createNetworkOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -898,64 +989,88 @@ func (s *NetworkOfferingService) CreateNetworkOffering(p *CreateNetworkOfferingP
 
 type CreateNetworkOfferingResponse struct {
 	Availability             string                                 `json:"availability"`
-	Conservemode             bool                                   `json:"conservemode"`
-	Created                  string                                 `json:"created"`
+	Conservemode             FlexBool                               `json:"conservemode"`
+	Created                  Time                                   `json:"created"`
 	Details                  map[string]string                      `json:"details"`
 	Displaytext              string                                 `json:"displaytext"`
 	Domain                   string                                 `json:"domain"`
 	Domainid                 string                                 `json:"domainid"`
-	Egressdefaultpolicy      bool                                   `json:"egressdefaultpolicy"`
-	Fornsx                   bool                                   `json:"fornsx"`
-	Fortungsten              bool                                   `json:"fortungsten"`
-	Forvpc                   bool                                   `json:"forvpc"`
+	Egressdefaultpolicy      FlexBool                               `json:"egressdefaultpolicy"`
+	Fornsx                   FlexBool                               `json:"fornsx"`
+	Fortungsten              FlexBool                               `json:"fortungsten"`
+	Forvpc                   FlexBool                               `json:"forvpc"`
 	Guestiptype              string                                 `json:"guestiptype"`
-	Hasannotations           bool                                   `json:"hasannotations"`
+	Hasannotations           FlexBool                               `json:"hasannotations"`
 	Id                       string                                 `json:"id"`
 	Internetprotocol         string                                 `json:"internetprotocol"`
-	Isdefault                bool                                   `json:"isdefault"`
-	Ispersistent             bool                                   `json:"ispersistent"`
+	Isdefault                FlexBool                               `json:"isdefault"`
+	Ispersistent             FlexBool                               `json:"ispersistent"`
 	JobID                    string                                 `json:"jobid"`
-	Jobstatus                int                                    `json:"jobstatus"`
-	Maxconnections           int                                    `json:"maxconnections"`
+	Jobstatus                FlexInt                                `json:"jobstatus"`
+	Maxconnections           FlexInt                                `json:"maxconnections"`
 	Name                     string                                 `json:"name"`
 	Networkmode              string                                 `json:"networkmode"`
-	Networkrate              int                                    `json:"networkrate"`
+	Networkrate              FlexInt                                `json:"networkrate"`
 	Routingmode              string                                 `json:"routingmode"`
 	Service                  []CreateNetworkOfferingResponseService `json:"service"`
 	Serviceofferingid        string                                 `json:"serviceofferingid"`
-	Specifyasnumber          bool                                   `json:"specifyasnumber"`
-	Specifyipranges          bool                                   `json:"specifyipranges"`
-	Specifyvlan              bool                                   `json:"specifyvlan"`
+	Specifyasnumber          FlexBool                               `json:"specifyasnumber"`
+	Specifyipranges          FlexBool                               `json:"specifyipranges"`
+	Specifyvlan              FlexBool                               `json:"specifyvlan"`
 	State                    string                                 `json:"state"`
-	Supportsinternallb       bool                                   `json:"supportsinternallb"`
-	Supportspublicaccess     bool                                   `json:"supportspublicaccess"`
-	Supportsstrechedl2subnet bool                                   `json:"supportsstrechedl2subnet"`
+	Supportsinternallb       FlexBool                               `json:"supportsinternallb"`
+	Supportspublicaccess     FlexBool                               `json:"supportspublicaccess"`
+	Supportsstrechedl2subnet FlexBool                               `json:"supportsstrechedl2subnet"`
 	Tags                     string                                 `json:"tags"`
 	Traffictype              string                                 `json:"traffictype"`
 	Zone                     string                                 `json:"zone"`
 	Zoneid                   string                                 `json:"zoneid"`
+	Extra                    map[string]json.RawMessage             `json:"-"`
+}
+
+func (r *CreateNetworkOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkOfferingResponseService struct {
 	Capability []CreateNetworkOfferingResponseServiceCapability `json:"capability"`
 	Name       string                                           `json:"name"`
 	Provider   []CreateNetworkOfferingResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *CreateNetworkOfferingResponseService) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkOfferingResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkOfferingResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateNetworkOfferingResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkOfferingResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkOfferingResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateNetworkOfferingResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkOfferingResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetworkOfferingParams struct {
@@ -973,6 +1088,44 @@ func (p *DeleteNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetworkOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetworkOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetworkOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetworkOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetworkOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetworkOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetworkOffering API, before the request is signed
+func (p *DeleteNetworkOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetworkOffering", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetworkOfferingParams) Clone() *DeleteNetworkOfferingParams {
+	return &DeleteNetworkOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetworkOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1005,13 +1158,22 @@ func (s *NetworkOfferingService) NewDeleteNetworkOfferingParams(id string) *Dele
 
 // Deletes a network offering.
 func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetworkOffering", p.toURLValues())
+	return s.DeleteNetworkOfferingWithContext(context.Background(), p)
+}
+
+// DeleteNetworkOfferingWithContext is like DeleteNetworkOffering, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetworkOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetworkOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1019,10 +1181,11 @@ func (s *NetworkOfferingService) DeleteNetworkOffering(p *DeleteNetworkOfferingP
 }
 
 type DeleteNetworkOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteNetworkOfferingResponse) UnmarshalJSON(b []byte) error {
@@ -1049,7 +1212,7 @@ func (r *DeleteNetworkOfferingResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteNetworkOfferingResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkOfferingsParams struct {
@@ -1139,6 +1302,64 @@ func (p *ListNetworkOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkOfferings API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkOfferingsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkOfferings", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkOfferings API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkOfferingsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"availability": 255, "displaytext": 4096, "domainid": 255, "guestiptype": 255, "id": 255, "keyword": 255, "name": 255, "networkid": 255, "routingmode": 255, "state": 255, "tags": 4096, "traffictype": 255, "zoneid": 255},
+	}.validate("listNetworkOfferings", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkOfferings API, before the request is signed
+func (p *ListNetworkOfferingsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkOfferingsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkOfferingsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkOfferings", b, &p.p, map[string]paramDecoder{
+		"availability":       decodeParam[string],
+		"displaytext":        decodeParam[string],
+		"domainid":           decodeParam[string],
+		"forvpc":             decodeParam[bool],
+		"guestiptype":        decodeParam[string],
+		"id":                 decodeParam[string],
+		"isdefault":          decodeParam[bool],
+		"istagged":           decodeParam[bool],
+		"keyword":            decodeParam[string],
+		"name":               decodeParam[string],
+		"networkid":          decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"routingmode":        decodeParam[string],
+		"sourcenatsupported": decodeParam[bool],
+		"specifyipranges":    decodeParam[bool],
+		"specifyvlan":        decodeParam[bool],
+		"state":              decodeParam[string],
+		"supportedservices":  decodeParam[[]string],
+		"tags":               decodeParam[string],
+		"traffictype":        decodeParam[string],
+		"zoneid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkOfferingsParams) Clone() *ListNetworkOfferingsParams {
+	return &ListNetworkOfferingsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkOfferingsParams) SetAvailability(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1611,6 +1832,11 @@ func (s *NetworkOfferingService) NewListNetworkOfferingsParams() *ListNetworkOff
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkOfferingIDWithContext is like GetNetworkOfferingID, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) GetNetworkOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1622,7 +1848,7 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListNetworkOfferings(p)
+	l, err := s.ListNetworkOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1647,12 +1873,17 @@ func (s *NetworkOfferingService) GetNetworkOfferingID(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
-	id, count, err := s.GetNetworkOfferingID(name, opts...)
+	return s.GetNetworkOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkOfferingByNameWithContext is like GetNetworkOfferingByName, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) GetNetworkOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	id, count, err := s.GetNetworkOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetNetworkOfferingByID(id, opts...)
+	r, count, err := s.GetNetworkOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1661,6 +1892,11 @@ func (s *NetworkOfferingService) GetNetworkOfferingByName(name string, opts ...O
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	return s.GetNetworkOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkOfferingByIDWithContext is like GetNetworkOfferingByID, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) GetNetworkOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
 	p := &ListNetworkOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1672,11 +1908,10 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListNetworkOfferings(p)
+	l, err := s.ListNetworkOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1694,19 +1929,56 @@ func (s *NetworkOfferingService) GetNetworkOfferingByID(id string, opts ...Optio
 
 // Lists all available network offerings.
 func (s *NetworkOfferingService) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkOfferings", p.toURLValues())
+	return s.ListNetworkOfferingsWithContext(context.Background(), p)
+}
+
+// ListNetworkOfferingsWithContext is like ListNetworkOfferings, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkOfferings", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetworkOfferingsAll returns all NetworkOfferings matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkOfferingService) ListNetworkOfferingsAll(p *ListNetworkOfferingsParams, opts ...PageOption) ([]*NetworkOffering, error) {
+	return s.ListNetworkOfferingsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkOfferingsAllWithContext is like ListNetworkOfferingsAll, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) ListNetworkOfferingsAllWithContext(ctx context.Context, p *ListNetworkOfferingsParams, opts ...PageOption) ([]*NetworkOffering, error) {
+	return collectPages(s.ListNetworkOfferingsIterWithContext(ctx, p, opts...))
+}
+
+// ListNetworkOfferingsIter returns an iterator over all NetworkOfferings matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkOfferingService) ListNetworkOfferingsIter(p *ListNetworkOfferingsParams, opts ...PageOption) iter.Seq2[*NetworkOffering, error] {
+	return s.ListNetworkOfferingsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkOfferingsIterWithContext is like ListNetworkOfferingsIter, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) ListNetworkOfferingsIterWithContext(ctx context.Context, p *ListNetworkOfferingsParams, opts ...PageOption) iter.Seq2[*NetworkOffering, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetworkOffering, int, error) {
+		l, err := s.ListNetworkOfferingsWithContext(ctx, &ListNetworkOfferingsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetworkOfferings, l.Count, nil
+	}, opts...)
+}
+
 type ListNetworkOfferingsResponse struct {
 	Count            int                `json:"count"`
 	NetworkOfferings []*NetworkOffering `json:"networkoffering"`
@@ -1714,64 +1986,88 @@ type ListNetworkOfferingsResponse struct {
 
 type NetworkOffering struct {
 	Availability             string                           `json:"availability"`
-	Conservemode             bool                             `json:"conservemode"`
-	Created                  string                           `json:"created"`
+	Conservemode             FlexBool                         `json:"conservemode"`
+	Created                  Time                             `json:"created"`
 	Details                  map[string]string                `json:"details"`
 	Displaytext              string                           `json:"displaytext"`
 	Domain                   string                           `json:"domain"`
 	Domainid                 string                           `json:"domainid"`
-	Egressdefaultpolicy      bool                             `json:"egressdefaultpolicy"`
-	Fornsx                   bool                             `json:"fornsx"`
-	Fortungsten              bool                             `json:"fortungsten"`
-	Forvpc                   bool                             `json:"forvpc"`
+	Egressdefaultpolicy      FlexBool                         `json:"egressdefaultpolicy"`
+	Fornsx                   FlexBool                         `json:"fornsx"`
+	Fortungsten              FlexBool                         `json:"fortungsten"`
+	Forvpc                   FlexBool                         `json:"forvpc"`
 	Guestiptype              string                           `json:"guestiptype"`
-	Hasannotations           bool                             `json:"hasannotations"`
+	Hasannotations           FlexBool                         `json:"hasannotations"`
 	Id                       string                           `json:"id"`
 	Internetprotocol         string                           `json:"internetprotocol"`
-	Isdefault                bool                             `json:"isdefault"`
-	Ispersistent             bool                             `json:"ispersistent"`
+	Isdefault                FlexBool                         `json:"isdefault"`
+	Ispersistent             FlexBool                         `json:"ispersistent"`
 	JobID                    string                           `json:"jobid"`
-	Jobstatus                int                              `json:"jobstatus"`
-	Maxconnections           int                              `json:"maxconnections"`
+	Jobstatus                FlexInt                          `json:"jobstatus"`
+	Maxconnections           FlexInt                          `json:"maxconnections"`
 	Name                     string                           `json:"name"`
 	Networkmode              string                           `json:"networkmode"`
-	Networkrate              int                              `json:"networkrate"`
+	Networkrate              FlexInt                          `json:"networkrate"`
 	Routingmode              string                           `json:"routingmode"`
 	Service                  []NetworkOfferingServiceInternal `json:"service"`
 	Serviceofferingid        string                           `json:"serviceofferingid"`
-	Specifyasnumber          bool                             `json:"specifyasnumber"`
-	Specifyipranges          bool                             `json:"specifyipranges"`
-	Specifyvlan              bool                             `json:"specifyvlan"`
+	Specifyasnumber          FlexBool                         `json:"specifyasnumber"`
+	Specifyipranges          FlexBool                         `json:"specifyipranges"`
+	Specifyvlan              FlexBool                         `json:"specifyvlan"`
 	State                    string                           `json:"state"`
-	Supportsinternallb       bool                             `json:"supportsinternallb"`
-	Supportspublicaccess     bool                             `json:"supportspublicaccess"`
-	Supportsstrechedl2subnet bool                             `json:"supportsstrechedl2subnet"`
+	Supportsinternallb       FlexBool                         `json:"supportsinternallb"`
+	Supportspublicaccess     FlexBool                         `json:"supportspublicaccess"`
+	Supportsstrechedl2subnet FlexBool                         `json:"supportsstrechedl2subnet"`
 	Tags                     string                           `json:"tags"`
 	Traffictype              string                           `json:"traffictype"`
 	Zone                     string                           `json:"zone"`
 	Zoneid                   string                           `json:"zoneid"`
+	Extra                    map[string]json.RawMessage       `json:"-"`
+}
+
+func (r *NetworkOffering) UnmarshalJSON(b []byte) error {
+	type alias NetworkOffering
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetworkOfferingServiceInternal struct {
 	Capability []NetworkOfferingServiceInternalCapability `json:"capability"`
 	Name       string                                     `json:"name"`
 	Provider   []NetworkOfferingServiceInternalProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                 `json:"-"`
+}
+
+func (r *NetworkOfferingServiceInternal) UnmarshalJSON(b []byte) error {
+	type alias NetworkOfferingServiceInternal
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetworkOfferingServiceInternalProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkOfferingServiceInternalProvider) UnmarshalJSON(b []byte) error {
+	type alias NetworkOfferingServiceInternalProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetworkOfferingServiceInternalCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkOfferingServiceInternalCapability) UnmarshalJSON(b []byte) error {
+	type alias NetworkOfferingServiceInternalCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkOfferingParams struct {
@@ -1822,6 +2118,53 @@ func (p *UpdateNetworkOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateNetworkOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateNetworkOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateNetworkOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateNetworkOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateNetworkOfferingParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"availability": 255, "displaytext": 4096, "domainid": 255, "id": 255, "name": 255, "state": 255, "tags": 4096, "zoneid": 255},
+	}.validate("updateNetworkOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateNetworkOffering API, before the request is signed
+func (p *UpdateNetworkOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateNetworkOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateNetworkOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateNetworkOffering", b, &p.p, map[string]paramDecoder{
+		"availability":     decodeParam[string],
+		"displaytext":      decodeParam[string],
+		"domainid":         decodeParam[string],
+		"id":               decodeParam[string],
+		"keepaliveenabled": decodeParam[bool],
+		"maxconnections":   decodeParam[int],
+		"name":             decodeParam[string],
+		"sortkey":          decodeParam[int],
+		"state":            decodeParam[string],
+		"tags":             decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateNetworkOfferingParams) Clone() *UpdateNetworkOfferingParams {
+	return &UpdateNetworkOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateNetworkOfferingParams) SetAvailability(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2063,7 +2406,16 @@ func (s *NetworkOfferingService) NewUpdateNetworkOfferingParams() *UpdateNetwork
 
 // Updates a network offering.
 func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("updateNetworkOffering", p.toURLValues())
+	return s.UpdateNetworkOfferingWithContext(context.Background(), p)
+}
+
+// UpdateNetworkOfferingWithContext is like UpdateNetworkOffering, but honours the cancellation and deadline of ctx
+func (s *NetworkOfferingService) UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateNetworkOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2073,7 +2425,7 @@ func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingP
 	}
 
 	var r UpdateNetworkOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateNetworkOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2082,62 +2434,86 @@ func (s *NetworkOfferingService) UpdateNetworkOffering(p *UpdateNetworkOfferingP
 
 type UpdateNetworkOfferingResponse struct {
 	Availability             string                                 `json:"availability"`
-	Conservemode             bool                                   `json:"conservemode"`
-	Created                  string                                 `json:"created"`
+	Conservemode             FlexBool                               `json:"conservemode"`
+	Created                  Time                                   `json:"created"`
 	Details                  map[string]string                      `json:"details"`
 	Displaytext              string                                 `json:"displaytext"`
 	Domain                   string                                 `json:"domain"`
 	Domainid                 string                                 `json:"domainid"`
-	Egressdefaultpolicy      bool                                   `json:"egressdefaultpolicy"`
-	Fornsx                   bool                                   `json:"fornsx"`
-	Fortungsten              bool                                   `json:"fortungsten"`
-	Forvpc                   bool                                   `json:"forvpc"`
+	Egressdefaultpolicy      FlexBool                               `json:"egressdefaultpolicy"`
+	Fornsx                   FlexBool                               `json:"fornsx"`
+	Fortungsten              FlexBool                               `json:"fortungsten"`
+	Forvpc                   FlexBool                               `json:"forvpc"`
 	Guestiptype              string                                 `json:"guestiptype"`
-	Hasannotations           bool                                   `json:"hasannotations"`
+	Hasannotations           FlexBool                               `json:"hasannotations"`
 	Id                       string                                 `json:"id"`
 	Internetprotocol         string                                 `json:"internetprotocol"`
-	Isdefault                bool                                   `json:"isdefault"`
-	Ispersistent             bool                                   `json:"ispersistent"`
+	Isdefault                FlexBool                               `json:"isdefault"`
+	Ispersistent             FlexBool                               `json:"ispersistent"`
 	JobID                    string                                 `json:"jobid"`
-	Jobstatus                int                                    `json:"jobstatus"`
-	Maxconnections           int                                    `json:"maxconnections"`
+	Jobstatus                FlexInt                                `json:"jobstatus"`
+	Maxconnections           FlexInt                                `json:"maxconnections"`
 	Name                     string                                 `json:"name"`
 	Networkmode              string                                 `json:"networkmode"`
-	Networkrate              int                                    `json:"networkrate"`
+	Networkrate              FlexInt                                `json:"networkrate"`
 	Routingmode              string                                 `json:"routingmode"`
 	Service                  []UpdateNetworkOfferingResponseService `json:"service"`
 	Serviceofferingid        string                                 `json:"serviceofferingid"`
-	Specifyasnumber          bool                                   `json:"specifyasnumber"`
-	Specifyipranges          bool                                   `json:"specifyipranges"`
-	Specifyvlan              bool                                   `json:"specifyvlan"`
+	Specifyasnumber          FlexBool                               `json:"specifyasnumber"`
+	Specifyipranges          FlexBool                               `json:"specifyipranges"`
+	Specifyvlan              FlexBool                               `json:"specifyvlan"`
 	State                    string                                 `json:"state"`
-	Supportsinternallb       bool                                   `json:"supportsinternallb"`
-	Supportspublicaccess     bool                                   `json:"supportspublicaccess"`
-	Supportsstrechedl2subnet bool                                   `json:"supportsstrechedl2subnet"`
+	Supportsinternallb       FlexBool                               `json:"supportsinternallb"`
+	Supportspublicaccess     FlexBool                               `json:"supportspublicaccess"`
+	Supportsstrechedl2subnet FlexBool                               `json:"supportsstrechedl2subnet"`
 	Tags                     string                                 `json:"tags"`
 	Traffictype              string                                 `json:"traffictype"`
 	Zone                     string                                 `json:"zone"`
 	Zoneid                   string                                 `json:"zoneid"`
+	Extra                    map[string]json.RawMessage             `json:"-"`
+}
+
+func (r *UpdateNetworkOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkOfferingResponseService struct {
 	Capability []UpdateNetworkOfferingResponseServiceCapability `json:"capability"`
 	Name       string                                           `json:"name"`
 	Provider   []UpdateNetworkOfferingResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *UpdateNetworkOfferingResponseService) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkOfferingResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkOfferingResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateNetworkOfferingResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkOfferingResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkOfferingResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateNetworkOfferingResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkOfferingResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) CreateNetworkOffering(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).CreateNetworkOffering), p)
 }
 
+// CreateNetworkOfferingWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) CreateNetworkOfferingWithContext(ctx context.Context, p *CreateNetworkOfferingParams) (*CreateNetworkOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateNetworkOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkOfferingWithContext indicates an expected call of CreateNetworkOfferingWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) CreateNetworkOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkOfferingWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).CreateNetworkOfferingWithContext), ctx, p)
+}
+
 // DeleteNetworkOffering mocks base method.
 func (m *MockNetworkOfferingServiceIface) DeleteNetworkOffering(p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) DeleteNetworkOffering(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).DeleteNetworkOffering), p)
 }
 
+// DeleteNetworkOfferingWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) DeleteNetworkOfferingWithContext(ctx context.Context, p *DeleteNetworkOfferingParams) (*DeleteNetworkOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetworkOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkOfferingWithContext indicates an expected call of DeleteNetworkOfferingWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) DeleteNetworkOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkOfferingWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).DeleteNetworkOfferingWithContext), ctx, p)
+}
+
 // GetNetworkOfferingByID mocks base method.
 func (m *MockNetworkOfferingServiceIface) GetNetworkOfferingByID(id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) GetNetworkOfferingByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkOfferingByID", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).GetNetworkOfferingByID), varargs...)
 }
 
+// GetNetworkOfferingByIDWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) GetNetworkOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkOfferingByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*NetworkOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkOfferingByIDWithContext indicates an expected call of GetNetworkOfferingByIDWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) GetNetworkOfferingByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkOfferingByIDWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).GetNetworkOfferingByIDWithContext), varargs...)
+}
+
 // GetNetworkOfferingByName mocks base method.
 func (m *MockNetworkOfferingServiceIface) GetNetworkOfferingByName(name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +183,27 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) GetNetworkOfferingByName(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkOfferingByName", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).GetNetworkOfferingByName), varargs...)
 }
 
+// GetNetworkOfferingByNameWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) GetNetworkOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*NetworkOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkOfferingByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*NetworkOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkOfferingByNameWithContext indicates an expected call of GetNetworkOfferingByNameWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) GetNetworkOfferingByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkOfferingByNameWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).GetNetworkOfferingByNameWithContext), varargs...)
+}
+
 // GetNetworkOfferingID mocks base method.
 func (m *MockNetworkOfferingServiceIface) GetNetworkOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +225,27 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) GetNetworkOfferingID(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkOfferingID", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).GetNetworkOfferingID), varargs...)
 }
 
+// GetNetworkOfferingIDWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) GetNetworkOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkOfferingIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkOfferingIDWithContext indicates an expected call of GetNetworkOfferingIDWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) GetNetworkOfferingIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkOfferingIDWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).GetNetworkOfferingIDWithContext), varargs...)
+}
+
 // ListNetworkOfferings mocks base method.
 func (m *MockNetworkOfferingServiceIface) ListNetworkOfferings(p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +261,99 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferings(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferings", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferings), p)
 }
 
+// ListNetworkOfferingsAll mocks base method.
+func (m *MockNetworkOfferingServiceIface) ListNetworkOfferingsAll(p *ListNetworkOfferingsParams, opts ...PageOption) ([]*NetworkOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkOfferingsAll", varargs...)
+	ret0, _ := ret[0].([]*NetworkOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkOfferingsAll indicates an expected call of ListNetworkOfferingsAll.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferingsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferingsAll", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferingsAll), varargs...)
+}
+
+// ListNetworkOfferingsAllWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) ListNetworkOfferingsAllWithContext(ctx context.Context, p *ListNetworkOfferingsParams, opts ...PageOption) ([]*NetworkOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkOfferingsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetworkOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkOfferingsAllWithContext indicates an expected call of ListNetworkOfferingsAllWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferingsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferingsAllWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferingsAllWithContext), varargs...)
+}
+
+// ListNetworkOfferingsIter mocks base method.
+func (m *MockNetworkOfferingServiceIface) ListNetworkOfferingsIter(p *ListNetworkOfferingsParams, opts ...PageOption) iter.Seq2[*NetworkOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkOfferingsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkOffering, error])
+	return ret0
+}
+
+// ListNetworkOfferingsIter indicates an expected call of ListNetworkOfferingsIter.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferingsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferingsIter", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferingsIter), varargs...)
+}
+
+// ListNetworkOfferingsIterWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) ListNetworkOfferingsIterWithContext(ctx context.Context, p *ListNetworkOfferingsParams, opts ...PageOption) iter.Seq2[*NetworkOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkOfferingsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkOffering, error])
+	return ret0
+}
+
+// ListNetworkOfferingsIterWithContext indicates an expected call of ListNetworkOfferingsIterWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferingsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferingsIterWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferingsIterWithContext), varargs...)
+}
+
+// ListNetworkOfferingsWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) ListNetworkOfferingsWithContext(ctx context.Context, p *ListNetworkOfferingsParams) (*ListNetworkOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkOfferingsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkOfferingsWithContext indicates an expected call of ListNetworkOfferingsWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) ListNetworkOfferingsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkOfferingsWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).ListNetworkOfferingsWithContext), ctx, p)
+}
+
 // NewCreateNetworkOfferingParams mocks base method.
 func (m *MockNetworkOfferingServiceIface) NewCreateNetworkOfferingParams(displaytext, guestiptype, name, traffictype string) *CreateNetworkOfferingParams {
 	m.ctrl.T.Helper()
@@ -236,3 +424,18 @@ func (mr *MockNetworkOfferingServiceIfaceMockRecorder) UpdateNetworkOffering(p a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkOffering", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).UpdateNetworkOffering), p)
 }
+
+// UpdateNetworkOfferingWithContext mocks base method.
+func (m *MockNetworkOfferingServiceIface) UpdateNetworkOfferingWithContext(ctx context.Context, p *UpdateNetworkOfferingParams) (*UpdateNetworkOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateNetworkOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkOfferingWithContext indicates an expected call of UpdateNetworkOfferingWithContext.
+func (mr *MockNetworkOfferingServiceIfaceMockRecorder) UpdateNetworkOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkOfferingWithContext", reflect.TypeOf((*MockNetworkOfferingServiceIface)(nil).UpdateNetworkOfferingWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,97 +31,211 @@ import (
 
 type NetworkServiceIface interface {
 	AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error)
+	AddNetworkServiceProviderAsync(ctx context.Context, p *AddNetworkServiceProviderParams) (*Job, error)
 	NewAddNetworkServiceProviderParams(name string, physicalnetworkid string) *AddNetworkServiceProviderParams
 	AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerWithContext(ctx context.Context, p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error)
+	AddOpenDaylightControllerAsync(ctx context.Context, p *AddOpenDaylightControllerParams) (*Job, error)
 	NewAddOpenDaylightControllerParams(password string, physicalnetworkid string, url string, username string) *AddOpenDaylightControllerParams
 	ChangeBgpPeersForNetwork(p *ChangeBgpPeersForNetworkParams) (*ChangeBgpPeersForNetworkResponse, error)
+	ChangeBgpPeersForNetworkWithContext(ctx context.Context, p *ChangeBgpPeersForNetworkParams) (*ChangeBgpPeersForNetworkResponse, error)
+	ChangeBgpPeersForNetworkAsync(ctx context.Context, p *ChangeBgpPeersForNetworkParams) (*Job, error)
 	NewChangeBgpPeersForNetworkParams(networkid string) *ChangeBgpPeersForNetworkParams
 	CreateIpv4SubnetForGuestNetwork(p *CreateIpv4SubnetForGuestNetworkParams) (*CreateIpv4SubnetForGuestNetworkResponse, error)
+	CreateIpv4SubnetForGuestNetworkWithContext(ctx context.Context, p *CreateIpv4SubnetForGuestNetworkParams) (*CreateIpv4SubnetForGuestNetworkResponse, error)
+	CreateIpv4SubnetForGuestNetworkAsync(ctx context.Context, p *CreateIpv4SubnetForGuestNetworkParams) (*Job, error)
 	NewCreateIpv4SubnetForGuestNetworkParams(parentid string) *CreateIpv4SubnetForGuestNetworkParams
 	CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error)
+	CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error)
 	NewCreateNetworkParams(name string, networkofferingid string, zoneid string) *CreateNetworkParams
 	CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error)
+	CreatePhysicalNetworkAsync(ctx context.Context, p *CreatePhysicalNetworkParams) (*Job, error)
 	NewCreatePhysicalNetworkParams(name string, zoneid string) *CreatePhysicalNetworkParams
 	CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstanceWithContext(ctx context.Context, p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error)
+	CreateServiceInstanceAsync(ctx context.Context, p *CreateServiceInstanceParams) (*Job, error)
 	NewCreateServiceInstanceParams(leftnetworkid string, name string, rightnetworkid string, serviceofferingid string, templateid string, zoneid string) *CreateServiceInstanceParams
 	CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error)
+	CreateStorageNetworkIpRangeAsync(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*Job, error)
 	NewCreateStorageNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateStorageNetworkIpRangeParams
 	DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
+	DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error)
 	NewDedicatePublicIpRangeParams(domainid string, id string) *DedicatePublicIpRangeParams
 	DeleteIpv4SubnetForGuestNetwork(p *DeleteIpv4SubnetForGuestNetworkParams) (*DeleteIpv4SubnetForGuestNetworkResponse, error)
+	DeleteIpv4SubnetForGuestNetworkWithContext(ctx context.Context, p *DeleteIpv4SubnetForGuestNetworkParams) (*DeleteIpv4SubnetForGuestNetworkResponse, error)
+	DeleteIpv4SubnetForGuestNetworkAsync(ctx context.Context, p *DeleteIpv4SubnetForGuestNetworkParams) (*Job, error)
 	NewDeleteIpv4SubnetForGuestNetworkParams(id string) *DeleteIpv4SubnetForGuestNetworkParams
 	DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error)
+	DeleteNetworkAsync(ctx context.Context, p *DeleteNetworkParams) (*Job, error)
 	NewDeleteNetworkParams(id string) *DeleteNetworkParams
 	DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error)
+	DeleteNetworkServiceProviderAsync(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*Job, error)
 	NewDeleteNetworkServiceProviderParams(id string) *DeleteNetworkServiceProviderParams
 	DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error)
+	DeleteOpenDaylightControllerAsync(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*Job, error)
 	NewDeleteOpenDaylightControllerParams(id string) *DeleteOpenDaylightControllerParams
 	DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error)
+	DeletePhysicalNetworkAsync(ctx context.Context, p *DeletePhysicalNetworkParams) (*Job, error)
 	NewDeletePhysicalNetworkParams(id string) *DeletePhysicalNetworkParams
 	DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error)
+	DeleteStorageNetworkIpRangeAsync(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*Job, error)
 	NewDeleteStorageNetworkIpRangeParams(id string) *DeleteStorageNetworkIpRangeParams
 	ListIpv4SubnetsForGuestNetwork(p *ListIpv4SubnetsForGuestNetworkParams) (*ListIpv4SubnetsForGuestNetworkResponse, error)
+	ListIpv4SubnetsForGuestNetworkWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams) (*ListIpv4SubnetsForGuestNetworkResponse, error)
+	ListIpv4SubnetsForGuestNetworkAll(p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) ([]*Ipv4SubnetsForGuestNetwork, error)
+	ListIpv4SubnetsForGuestNetworkAllWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) ([]*Ipv4SubnetsForGuestNetwork, error)
+	ListIpv4SubnetsForGuestNetworkIter(p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForGuestNetwork, error]
+	ListIpv4SubnetsForGuestNetworkIterWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForGuestNetwork, error]
 	NewListIpv4SubnetsForGuestNetworkParams() *ListIpv4SubnetsForGuestNetworkParams
 	GetIpv4SubnetsForGuestNetworkByID(id string, opts ...OptionFunc) (*Ipv4SubnetsForGuestNetwork, int, error)
+	GetIpv4SubnetsForGuestNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv4SubnetsForGuestNetwork, int, error)
 	ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error)
+	ListNetworkIsolationMethodsAll(p *ListNetworkIsolationMethodsParams, opts ...PageOption) ([]*NetworkIsolationMethod, error)
+	ListNetworkIsolationMethodsAllWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams, opts ...PageOption) ([]*NetworkIsolationMethod, error)
+	ListNetworkIsolationMethodsIter(p *ListNetworkIsolationMethodsParams, opts ...PageOption) iter.Seq2[*NetworkIsolationMethod, error]
+	ListNetworkIsolationMethodsIterWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams, opts ...PageOption) iter.Seq2[*NetworkIsolationMethod, error]
 	NewListNetworkIsolationMethodsParams() *ListNetworkIsolationMethodsParams
 	ListNetworkProtocols(p *ListNetworkProtocolsParams) (*ListNetworkProtocolsResponse, error)
+	ListNetworkProtocolsWithContext(ctx context.Context, p *ListNetworkProtocolsParams) (*ListNetworkProtocolsResponse, error)
 	NewListNetworkProtocolsParams(option string) *ListNetworkProtocolsParams
 	ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error)
+	ListNetworkServiceProvidersAll(p *ListNetworkServiceProvidersParams, opts ...PageOption) ([]*NetworkServiceProvider, error)
+	ListNetworkServiceProvidersAllWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams, opts ...PageOption) ([]*NetworkServiceProvider, error)
+	ListNetworkServiceProvidersIter(p *ListNetworkServiceProvidersParams, opts ...PageOption) iter.Seq2[*NetworkServiceProvider, error]
+	ListNetworkServiceProvidersIterWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams, opts ...PageOption) iter.Seq2[*NetworkServiceProvider, error]
 	NewListNetworkServiceProvidersParams() *ListNetworkServiceProvidersParams
 	GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkServiceProviderIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error)
+	ListNetworksAll(p *ListNetworksParams, opts ...PageOption) ([]*Network, error)
+	ListNetworksAllWithContext(ctx context.Context, p *ListNetworksParams, opts ...PageOption) ([]*Network, error)
+	ListNetworksIter(p *ListNetworksParams, opts ...PageOption) iter.Seq2[*Network, error]
+	ListNetworksIterWithContext(ctx context.Context, p *ListNetworksParams, opts ...PageOption) iter.Seq2[*Network, error]
 	NewListNetworksParams() *ListNetworksParams
 	GetNetworkID(name string, opts ...OptionFunc) (string, int, error)
+	GetNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Network, int, error)
 	GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error)
+	GetNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Network, int, error)
 	ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error)
+	ListNiciraNvpDeviceNetworksAll(p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) ([]*NiciraNvpDeviceNetwork, error)
+	ListNiciraNvpDeviceNetworksAllWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) ([]*NiciraNvpDeviceNetwork, error)
+	ListNiciraNvpDeviceNetworksIter(p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) iter.Seq2[*NiciraNvpDeviceNetwork, error]
+	ListNiciraNvpDeviceNetworksIterWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) iter.Seq2[*NiciraNvpDeviceNetwork, error]
 	NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string) *ListNiciraNvpDeviceNetworksParams
 	GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetNiciraNvpDeviceNetworkIDWithContext(ctx context.Context, keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error)
 	ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
+	ListOpenDaylightControllersWithContext(ctx context.Context, p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error)
 	NewListOpenDaylightControllersParams() *ListOpenDaylightControllersParams
 	GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error)
+	GetOpenDaylightControllerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OpenDaylightController, int, error)
 	ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error)
+	ListPaloAltoFirewallNetworksAll(p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) ([]*PaloAltoFirewallNetwork, error)
+	ListPaloAltoFirewallNetworksAllWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) ([]*PaloAltoFirewallNetwork, error)
+	ListPaloAltoFirewallNetworksIter(p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewallNetwork, error]
+	ListPaloAltoFirewallNetworksIterWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewallNetwork, error]
 	NewListPaloAltoFirewallNetworksParams(lbdeviceid string) *ListPaloAltoFirewallNetworksParams
 	GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
+	GetPaloAltoFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error)
 	ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error)
+	ListPhysicalNetworksAll(p *ListPhysicalNetworksParams, opts ...PageOption) ([]*PhysicalNetwork, error)
+	ListPhysicalNetworksAllWithContext(ctx context.Context, p *ListPhysicalNetworksParams, opts ...PageOption) ([]*PhysicalNetwork, error)
+	ListPhysicalNetworksIter(p *ListPhysicalNetworksParams, opts ...PageOption) iter.Seq2[*PhysicalNetwork, error]
+	ListPhysicalNetworksIterWithContext(ctx context.Context, p *ListPhysicalNetworksParams, opts ...PageOption) iter.Seq2[*PhysicalNetwork, error]
 	NewListPhysicalNetworksParams() *ListPhysicalNetworksParams
 	GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error)
+	GetPhysicalNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
 	GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
+	GetPhysicalNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PhysicalNetwork, int, error)
 	ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error)
+	ListStorageNetworkIpRangeAll(p *ListStorageNetworkIpRangeParams, opts ...PageOption) ([]*StorageNetworkIpRange, error)
+	ListStorageNetworkIpRangeAllWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams, opts ...PageOption) ([]*StorageNetworkIpRange, error)
+	ListStorageNetworkIpRangeIter(p *ListStorageNetworkIpRangeParams, opts ...PageOption) iter.Seq2[*StorageNetworkIpRange, error]
+	ListStorageNetworkIpRangeIterWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams, opts ...PageOption) iter.Seq2[*StorageNetworkIpRange, error]
 	NewListStorageNetworkIpRangeParams() *ListStorageNetworkIpRangeParams
 	GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
+	GetStorageNetworkIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error)
 	ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error)
+	ListSupportedNetworkServicesAll(p *ListSupportedNetworkServicesParams, opts ...PageOption) ([]*SupportedNetworkService, error)
+	ListSupportedNetworkServicesAllWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams, opts ...PageOption) ([]*SupportedNetworkService, error)
+	ListSupportedNetworkServicesIter(p *ListSupportedNetworkServicesParams, opts ...PageOption) iter.Seq2[*SupportedNetworkService, error]
+	ListSupportedNetworkServicesIterWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams, opts ...PageOption) iter.Seq2[*SupportedNetworkService, error]
 	NewListSupportedNetworkServicesParams() *ListSupportedNetworkServicesParams
 	MigrateNetwork(p *MigrateNetworkParams) (*MigrateNetworkResponse, error)
+	MigrateNetworkWithContext(ctx context.Context, p *MigrateNetworkParams) (*MigrateNetworkResponse, error)
+	MigrateNetworkAsync(ctx context.Context, p *MigrateNetworkParams) (*Job, error)
 	NewMigrateNetworkParams(networkid string, networkofferingid string) *MigrateNetworkParams
 	ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
+	ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error)
 	NewReleasePublicIpRangeParams(id string) *ReleasePublicIpRangeParams
 	RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error)
+	RestartNetworkAsync(ctx context.Context, p *RestartNetworkParams) (*Job, error)
 	NewRestartNetworkParams(id string) *RestartNetworkParams
 	UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error)
+	UpdateNetworkAsync(ctx context.Context, p *UpdateNetworkParams) (*Job, error)
 	NewUpdateNetworkParams(id string) *UpdateNetworkParams
 	UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error)
+	UpdateNetworkServiceProviderAsync(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*Job, error)
 	NewUpdateNetworkServiceProviderParams(id string) *UpdateNetworkServiceProviderParams
 	UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error)
+	UpdatePhysicalNetworkAsync(ctx context.Context, p *UpdatePhysicalNetworkParams) (*Job, error)
 	NewUpdatePhysicalNetworkParams(id string) *UpdatePhysicalNetworkParams
 	UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error)
+	UpdateStorageNetworkIpRangeAsync(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*Job, error)
 	NewUpdateStorageNetworkIpRangeParams(id string) *UpdateStorageNetworkIpRangeParams
 	DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error)
+	DeleteGuestNetworkIpv6PrefixWithContext(ctx context.Context, p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error)
+	DeleteGuestNetworkIpv6PrefixAsync(ctx context.Context, p *DeleteGuestNetworkIpv6PrefixParams) (*Job, error)
 	NewDeleteGuestNetworkIpv6PrefixParams(id string) *DeleteGuestNetworkIpv6PrefixParams
 	CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error)
+	CreateGuestNetworkIpv6PrefixWithContext(ctx context.Context, p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error)
+	CreateGuestNetworkIpv6PrefixAsync(ctx context.Context, p *CreateGuestNetworkIpv6PrefixParams) (*Job, error)
 	NewCreateGuestNetworkIpv6PrefixParams(prefix string, zoneid string) *CreateGuestNetworkIpv6PrefixParams
 	ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error)
+	ListGuestNetworkIpv6PrefixesWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error)
+	ListGuestNetworkIpv6PrefixesAll(p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) ([]*GuestNetworkIpv6Prefixe, error)
+	ListGuestNetworkIpv6PrefixesAllWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) ([]*GuestNetworkIpv6Prefixe, error)
+	ListGuestNetworkIpv6PrefixesIter(p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) iter.Seq2[*GuestNetworkIpv6Prefixe, error]
+	ListGuestNetworkIpv6PrefixesIterWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) iter.Seq2[*GuestNetworkIpv6Prefixe, error]
 	NewListGuestNetworkIpv6PrefixesParams() *ListGuestNetworkIpv6PrefixesParams
 	GetGuestNetworkIpv6PrefixeByID(id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error)
+	GetGuestNetworkIpv6PrefixeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error)
 	CreateNetworkPermissions(p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error)
+	CreateNetworkPermissionsWithContext(ctx context.Context, p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error)
 	NewCreateNetworkPermissionsParams(networkid string) *CreateNetworkPermissionsParams
 	ResetNetworkPermissions(p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error)
+	ResetNetworkPermissionsWithContext(ctx context.Context, p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error)
 	NewResetNetworkPermissionsParams(networkid string) *ResetNetworkPermissionsParams
 	ListNetworkPermissions(p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error)
+	ListNetworkPermissionsWithContext(ctx context.Context, p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error)
 	NewListNetworkPermissionsParams(networkid string) *ListNetworkPermissionsParams
 	RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error)
+	RemoveNetworkPermissionsWithContext(ctx context.Context, p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error)
 	NewRemoveNetworkPermissionsParams(networkid string) *RemoveNetworkPermissionsParams
 }
 
@@ -148,6 +264,47 @@ func (p *AddNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNetworkServiceProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNetworkServiceProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNetworkServiceProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNetworkServiceProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNetworkServiceProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "physicalnetworkid"},
+		lengths:  map[string]int{"destinationphysicalnetworkid": 255, "name": 255, "physicalnetworkid": 255},
+	}.validate("addNetworkServiceProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNetworkServiceProvider API, before the request is signed
+func (p *AddNetworkServiceProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNetworkServiceProvider", b, &p.p, map[string]paramDecoder{
+		"destinationphysicalnetworkid": decodeParam[string],
+		"name":                         decodeParam[string],
+		"physicalnetworkid":            decodeParam[string],
+		"servicelist":                  decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNetworkServiceProviderParams) Clone() *AddNetworkServiceProviderParams {
+	return &AddNetworkServiceProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNetworkServiceProviderParams) SetDestinationphysicalnetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -244,32 +401,39 @@ func (s *NetworkService) NewAddNetworkServiceProviderParams(name string, physica
 
 // Adds a network serviceProvider to a physical network
 func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("addNetworkServiceProvider", p.toURLValues())
+	return s.AddNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// AddNetworkServiceProviderWithContext is like AddNetworkServiceProvider, but honours the cancellation and deadline of ctx
+func (s *NetworkService) AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addNetworkServiceProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNetworkServiceProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNetworkServiceProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addNetworkServiceProvider", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addNetworkServiceProvider", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -277,16 +441,41 @@ func (s *NetworkService) AddNetworkServiceProvider(p *AddNetworkServiceProviderP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddNetworkServiceProvider async job into r
+func (r *AddNetworkServiceProviderResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddNetworkServiceProviderAsync starts the async job of AddNetworkServiceProvider without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) AddNetworkServiceProviderAsync(ctx context.Context, p *AddNetworkServiceProviderParams) (*Job, error) {
+	r, err := s.AddNetworkServiceProviderWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addNetworkServiceProvider", r.JobID), nil
+}
+
 type AddNetworkServiceProviderResponse struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	JobID                        string   `json:"jobid"`
-	Jobstatus                    int      `json:"jobstatus"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNetworkServiceProviderResponse) UnmarshalJSON(b []byte) error {
+	type alias AddNetworkServiceProviderResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddOpenDaylightControllerParams struct {
@@ -313,6 +502,47 @@ func (p *AddOpenDaylightControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addOpenDaylightController API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddOpenDaylightControllerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addOpenDaylightController", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addOpenDaylightController API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddOpenDaylightControllerParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "physicalnetworkid", "url", "username"},
+		lengths:  map[string]int{"password": 255, "physicalnetworkid": 255, "url": 2048, "username": 255},
+	}.validate("addOpenDaylightController", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addOpenDaylightController API, before the request is signed
+func (p *AddOpenDaylightControllerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddOpenDaylightControllerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddOpenDaylightControllerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addOpenDaylightController", b, &p.p, map[string]paramDecoder{
+		"password":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"url":               decodeParam[string],
+		"username":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddOpenDaylightControllerParams) Clone() *AddOpenDaylightControllerParams {
+	return &AddOpenDaylightControllerParams{p: cloneParams(p.p)}
+}
+
 func (p *AddOpenDaylightControllerParams) SetPassword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -411,32 +641,39 @@ func (s *NetworkService) NewAddOpenDaylightControllerParams(password string, phy
 
 // Adds an OpenDyalight controler
 func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newPostRequest("addOpenDaylightController", p.toURLValues())
+	return s.AddOpenDaylightControllerWithContext(context.Background(), p)
+}
+
+// AddOpenDaylightControllerWithContext is like AddOpenDaylightController, but honours the cancellation and deadline of ctx
+func (s *NetworkService) AddOpenDaylightControllerWithContext(ctx context.Context, p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addOpenDaylightController", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddOpenDaylightControllerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addOpenDaylightController", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addOpenDaylightController", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addOpenDaylightController", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -444,14 +681,39 @@ func (s *NetworkService) AddOpenDaylightController(p *AddOpenDaylightControllerP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddOpenDaylightController async job into r
+func (r *AddOpenDaylightControllerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddOpenDaylightControllerAsync starts the async job of AddOpenDaylightController without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) AddOpenDaylightControllerAsync(ctx context.Context, p *AddOpenDaylightControllerParams) (*Job, error) {
+	r, err := s.AddOpenDaylightControllerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addOpenDaylightController", r.JobID), nil
+}
+
 type AddOpenDaylightControllerResponse struct {
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Name              string `json:"name"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Url               string `json:"url"`
-	Username          string `json:"username"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Name              string                     `json:"name"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Url               string                     `json:"url"`
+	Username          string                     `json:"username"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddOpenDaylightControllerResponse) UnmarshalJSON(b []byte) error {
+	type alias AddOpenDaylightControllerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeBgpPeersForNetworkParams struct {
@@ -473,6 +735,45 @@ func (p *ChangeBgpPeersForNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeBgpPeersForNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeBgpPeersForNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeBgpPeersForNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeBgpPeersForNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeBgpPeersForNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid"},
+		lengths:  map[string]int{"networkid": 255},
+	}.validate("changeBgpPeersForNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeBgpPeersForNetwork API, before the request is signed
+func (p *ChangeBgpPeersForNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeBgpPeersForNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeBgpPeersForNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeBgpPeersForNetwork", b, &p.p, map[string]paramDecoder{
+		"bgppeerids": decodeParam[[]string],
+		"networkid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeBgpPeersForNetworkParams) Clone() *ChangeBgpPeersForNetworkParams {
+	return &ChangeBgpPeersForNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeBgpPeersForNetworkParams) SetBgppeerids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -526,32 +827,39 @@ func (s *NetworkService) NewChangeBgpPeersForNetworkParams(networkid string) *Ch
 
 // Change the BGP peers for a network.
 func (s *NetworkService) ChangeBgpPeersForNetwork(p *ChangeBgpPeersForNetworkParams) (*ChangeBgpPeersForNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("changeBgpPeersForNetwork", p.toURLValues())
+	return s.ChangeBgpPeersForNetworkWithContext(context.Background(), p)
+}
+
+// ChangeBgpPeersForNetworkWithContext is like ChangeBgpPeersForNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ChangeBgpPeersForNetworkWithContext(ctx context.Context, p *ChangeBgpPeersForNetworkParams) (*ChangeBgpPeersForNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "changeBgpPeersForNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeBgpPeersForNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeBgpPeersForNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "changeBgpPeersForNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("changeBgpPeersForNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -559,23 +867,48 @@ func (s *NetworkService) ChangeBgpPeersForNetwork(p *ChangeBgpPeersForNetworkPar
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ChangeBgpPeersForNetwork async job into r
+func (r *ChangeBgpPeersForNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ChangeBgpPeersForNetworkAsync starts the async job of ChangeBgpPeersForNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) ChangeBgpPeersForNetworkAsync(ctx context.Context, p *ChangeBgpPeersForNetworkParams) (*Job, error) {
+	r, err := s.ChangeBgpPeersForNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("changeBgpPeersForNetwork", r.JobID), nil
+}
+
 type ChangeBgpPeersForNetworkResponse struct {
-	Account    string            `json:"account"`
-	Asnumber   int64             `json:"asnumber"`
-	Created    string            `json:"created"`
-	Details    map[string]string `json:"details"`
-	Domain     string            `json:"domain"`
-	Domainid   string            `json:"domainid"`
-	Id         string            `json:"id"`
-	Ip6address string            `json:"ip6address"`
-	Ipaddress  string            `json:"ipaddress"`
-	JobID      string            `json:"jobid"`
-	Jobstatus  int               `json:"jobstatus"`
-	Password   string            `json:"password"`
-	Project    string            `json:"project"`
-	Projectid  string            `json:"projectid"`
-	Zoneid     string            `json:"zoneid"`
-	Zonename   string            `json:"zonename"`
+	Account    string                     `json:"account"`
+	Asnumber   FlexInt64                  `json:"asnumber"`
+	Created    Time                       `json:"created"`
+	Details    map[string]string          `json:"details"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ip6address string                     `json:"ip6address"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Password   string                     `json:"password"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Zoneid     string                     `json:"zoneid"`
+	Zonename   string                     `json:"zonename"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeBgpPeersForNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeBgpPeersForNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateIpv4SubnetForGuestNetworkParams struct {
@@ -600,6 +933,46 @@ func (p *CreateIpv4SubnetForGuestNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createIpv4SubnetForGuestNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateIpv4SubnetForGuestNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createIpv4SubnetForGuestNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createIpv4SubnetForGuestNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateIpv4SubnetForGuestNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"parentid"},
+		lengths:  map[string]int{"parentid": 255, "subnet": 255},
+	}.validate("createIpv4SubnetForGuestNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createIpv4SubnetForGuestNetwork API, before the request is signed
+func (p *CreateIpv4SubnetForGuestNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateIpv4SubnetForGuestNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateIpv4SubnetForGuestNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createIpv4SubnetForGuestNetwork", b, &p.p, map[string]paramDecoder{
+		"cidrsize": decodeParam[int],
+		"parentid": decodeParam[string],
+		"subnet":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateIpv4SubnetForGuestNetworkParams) Clone() *CreateIpv4SubnetForGuestNetworkParams {
+	return &CreateIpv4SubnetForGuestNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateIpv4SubnetForGuestNetworkParams) SetCidrsize(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -674,32 +1047,39 @@ func (s *NetworkService) NewCreateIpv4SubnetForGuestNetworkParams(parentid strin
 
 // Creates a IPv4 subnet for guest networks.
 func (s *NetworkService) CreateIpv4SubnetForGuestNetwork(p *CreateIpv4SubnetForGuestNetworkParams) (*CreateIpv4SubnetForGuestNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("createIpv4SubnetForGuestNetwork", p.toURLValues())
+	return s.CreateIpv4SubnetForGuestNetworkWithContext(context.Background(), p)
+}
+
+// CreateIpv4SubnetForGuestNetworkWithContext is like CreateIpv4SubnetForGuestNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) CreateIpv4SubnetForGuestNetworkWithContext(ctx context.Context, p *CreateIpv4SubnetForGuestNetworkParams) (*CreateIpv4SubnetForGuestNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createIpv4SubnetForGuestNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateIpv4SubnetForGuestNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createIpv4SubnetForGuestNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createIpv4SubnetForGuestNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createIpv4SubnetForGuestNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -707,23 +1087,48 @@ func (s *NetworkService) CreateIpv4SubnetForGuestNetwork(p *CreateIpv4SubnetForG
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateIpv4SubnetForGuestNetwork async job into r
+func (r *CreateIpv4SubnetForGuestNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateIpv4SubnetForGuestNetworkAsync starts the async job of CreateIpv4SubnetForGuestNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) CreateIpv4SubnetForGuestNetworkAsync(ctx context.Context, p *CreateIpv4SubnetForGuestNetworkParams) (*Job, error) {
+	r, err := s.CreateIpv4SubnetForGuestNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createIpv4SubnetForGuestNetwork", r.JobID), nil
+}
+
 type CreateIpv4SubnetForGuestNetworkResponse struct {
-	Allocated    string `json:"allocated"`
-	Created      string `json:"created"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Networkname  string `json:"networkname"`
-	Parentid     string `json:"parentid"`
-	Parentsubnet string `json:"parentsubnet"`
-	Removed      string `json:"removed"`
-	State        string `json:"state"`
-	Subnet       string `json:"subnet"`
-	Vpcid        string `json:"vpcid"`
-	Vpcname      string `json:"vpcname"`
-	Zoneid       string `json:"zoneid"`
-	Zonename     string `json:"zonename"`
+	Allocated    string                     `json:"allocated"`
+	Created      Time                       `json:"created"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Networkname  string                     `json:"networkname"`
+	Parentid     string                     `json:"parentid"`
+	Parentsubnet string                     `json:"parentsubnet"`
+	Removed      Time                       `json:"removed"`
+	State        string                     `json:"state"`
+	Subnet       string                     `json:"subnet"`
+	Vpcid        string                     `json:"vpcid"`
+	Vpcname      string                     `json:"vpcname"`
+	Zoneid       string                     `json:"zoneid"`
+	Zonename     string                     `json:"zonename"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateIpv4SubnetForGuestNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateIpv4SubnetForGuestNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkParams struct {
@@ -873,6 +1278,85 @@ func (p *CreateNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "networkofferingid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "aclid": 255, "acltype": 255, "associatednetworkid": 255, "displaytext": 4096, "dns1": 255, "dns2": 255, "domainid": 255, "endip": 255, "endipv6": 255, "externalid": 255, "gateway": 255, "ip6cidr": 255, "ip6dns1": 255, "ip6dns2": 255, "ip6gateway": 255, "isolatedpvlan": 255, "isolatedpvlantype": 255, "name": 255, "netmask": 255, "networkdomain": 255, "networkofferingid": 255, "physicalnetworkid": 255, "projectid": 255, "routerip": 255, "routeripv6": 255, "sourcenatipaddress": 255, "startip": 255, "startipv6": 255, "tungstenvirtualrouteruuid": 255, "vlan": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("createNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createNetwork API, before the request is signed
+func (p *CreateNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createNetwork", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"aclid":                     decodeParam[string],
+		"acltype":                   decodeParam[string],
+		"asnumber":                  decodeParam[int64],
+		"associatednetworkid":       decodeParam[string],
+		"bgppeerids":                decodeParam[[]string],
+		"bypassvlanoverlapcheck":    decodeParam[bool],
+		"cidrsize":                  decodeParam[int],
+		"displaynetwork":            decodeParam[bool],
+		"displaytext":               decodeParam[string],
+		"dns1":                      decodeParam[string],
+		"dns2":                      decodeParam[string],
+		"domainid":                  decodeParam[string],
+		"endip":                     decodeParam[string],
+		"endipv6":                   decodeParam[string],
+		"externalid":                decodeParam[string],
+		"gateway":                   decodeParam[string],
+		"hideipaddressusage":        decodeParam[bool],
+		"ip6cidr":                   decodeParam[string],
+		"ip6dns1":                   decodeParam[string],
+		"ip6dns2":                   decodeParam[string],
+		"ip6gateway":                decodeParam[string],
+		"isolatedpvlan":             decodeParam[string],
+		"isolatedpvlantype":         decodeParam[string],
+		"name":                      decodeParam[string],
+		"netmask":                   decodeParam[string],
+		"networkdomain":             decodeParam[string],
+		"networkofferingid":         decodeParam[string],
+		"physicalnetworkid":         decodeParam[string],
+		"privatemtu":                decodeParam[int],
+		"projectid":                 decodeParam[string],
+		"publicmtu":                 decodeParam[int],
+		"routerip":                  decodeParam[string],
+		"routeripv6":                decodeParam[string],
+		"sourcenatipaddress":        decodeParam[string],
+		"startip":                   decodeParam[string],
+		"startipv6":                 decodeParam[string],
+		"subdomainaccess":           decodeParam[bool],
+		"tungstenvirtualrouteruuid": decodeParam[string],
+		"vlan":                      decodeParam[string],
+		"vpcid":                     decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateNetworkParams) Clone() *CreateNetworkParams {
+	return &CreateNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateNetworkParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1768,7 +2252,16 @@ func (s *NetworkService) NewCreateNetworkParams(name string, networkofferingid s
 
 // Creates a Network
 func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("createNetwork", p.toURLValues())
+	return s.CreateNetworkWithContext(context.Background(), p)
+}
+
+// CreateNetworkWithContext is like CreateNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1778,7 +2271,7 @@ func (s *NetworkService) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkRe
 	}
 
 	var r CreateNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1790,28 +2283,28 @@ type CreateNetworkResponse struct {
 	Aclid                       string                         `json:"aclid"`
 	Aclname                     string                         `json:"aclname"`
 	Acltype                     string                         `json:"acltype"`
-	Asnumber                    int64                          `json:"asnumber"`
+	Asnumber                    FlexInt64                      `json:"asnumber"`
 	Asnumberid                  string                         `json:"asnumberid"`
 	Associatednetwork           string                         `json:"associatednetwork"`
 	Associatednetworkid         string                         `json:"associatednetworkid"`
 	Bgppeers                    []interface{}                  `json:"bgppeers"`
 	Broadcastdomaintype         string                         `json:"broadcastdomaintype"`
 	Broadcasturi                string                         `json:"broadcasturi"`
-	Canusefordeploy             bool                           `json:"canusefordeploy"`
+	Canusefordeploy             FlexBool                       `json:"canusefordeploy"`
 	Cidr                        string                         `json:"cidr"`
-	Created                     string                         `json:"created"`
+	Created                     Time                           `json:"created"`
 	Details                     map[string]string              `json:"details"`
-	Displaynetwork              bool                           `json:"displaynetwork"`
+	Displaynetwork              FlexBool                       `json:"displaynetwork"`
 	Displaytext                 string                         `json:"displaytext"`
 	Dns1                        string                         `json:"dns1"`
 	Dns2                        string                         `json:"dns2"`
 	Domain                      string                         `json:"domain"`
 	Domainid                    string                         `json:"domainid"`
 	Domainpath                  string                         `json:"domainpath"`
-	Egressdefaultpolicy         bool                           `json:"egressdefaultpolicy"`
+	Egressdefaultpolicy         FlexBool                       `json:"egressdefaultpolicy"`
 	Externalid                  string                         `json:"externalid"`
 	Gateway                     string                         `json:"gateway"`
-	Hasannotations              bool                           `json:"hasannotations"`
+	Hasannotations              FlexBool                       `json:"hasannotations"`
 	Icon                        interface{}                    `json:"icon"`
 	Id                          string                         `json:"id"`
 	Internetprotocol            string                         `json:"internetprotocol"`
@@ -1823,38 +2316,38 @@ type CreateNetworkResponse struct {
 	Ip6gateway                  string                         `json:"ip6gateway"`
 	Ip6routes                   []interface{}                  `json:"ip6routes"`
 	Ip6routing                  string                         `json:"ip6routing"`
-	Isdefault                   bool                           `json:"isdefault"`
-	Ispersistent                bool                           `json:"ispersistent"`
-	Issystem                    bool                           `json:"issystem"`
+	Isdefault                   FlexBool                       `json:"isdefault"`
+	Ispersistent                FlexBool                       `json:"ispersistent"`
+	Issystem                    FlexBool                       `json:"issystem"`
 	JobID                       string                         `json:"jobid"`
-	Jobstatus                   int                            `json:"jobstatus"`
+	Jobstatus                   FlexInt                        `json:"jobstatus"`
 	Name                        string                         `json:"name"`
 	Netmask                     string                         `json:"netmask"`
 	Networkcidr                 string                         `json:"networkcidr"`
 	Networkdomain               string                         `json:"networkdomain"`
 	Networkofferingavailability string                         `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                           `json:"networkofferingconservemode"`
+	Networkofferingconservemode FlexBool                       `json:"networkofferingconservemode"`
 	Networkofferingdisplaytext  string                         `json:"networkofferingdisplaytext"`
 	Networkofferingid           string                         `json:"networkofferingid"`
 	Networkofferingname         string                         `json:"networkofferingname"`
 	Physicalnetworkid           string                         `json:"physicalnetworkid"`
-	Privatemtu                  int                            `json:"privatemtu"`
+	Privatemtu                  FlexInt                        `json:"privatemtu"`
 	Project                     string                         `json:"project"`
 	Projectid                   string                         `json:"projectid"`
-	Publicmtu                   int                            `json:"publicmtu"`
-	Receivedbytes               int64                          `json:"receivedbytes"`
-	Redundantrouter             bool                           `json:"redundantrouter"`
+	Publicmtu                   FlexInt                        `json:"publicmtu"`
+	Receivedbytes               FlexInt64                      `json:"receivedbytes"`
+	Redundantrouter             FlexBool                       `json:"redundantrouter"`
 	Related                     string                         `json:"related"`
 	Reservediprange             string                         `json:"reservediprange"`
-	Restartrequired             bool                           `json:"restartrequired"`
-	Sentbytes                   int64                          `json:"sentbytes"`
+	Restartrequired             FlexBool                       `json:"restartrequired"`
+	Sentbytes                   FlexInt64                      `json:"sentbytes"`
 	Service                     []CreateNetworkResponseService `json:"service"`
-	Specifyipranges             bool                           `json:"specifyipranges"`
-	Specifyvlan                 bool                           `json:"specifyvlan"`
-	State                       string                         `json:"state"`
-	Strechedl2subnet            bool                           `json:"strechedl2subnet"`
-	Subdomainaccess             bool                           `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                           `json:"supportsvmautoscaling"`
+	Specifyipranges             FlexBool                       `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                       `json:"specifyvlan"`
+	State                       NetworkState                   `json:"state"`
+	Strechedl2subnet            FlexBool                       `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                       `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                       `json:"supportsvmautoscaling"`
 	Tags                        []Tags                         `json:"tags"`
 	Traffictype                 string                         `json:"traffictype"`
 	Tungstenvirtualrouteruuid   string                         `json:"tungstenvirtualrouteruuid"`
@@ -1865,28 +2358,52 @@ type CreateNetworkResponse struct {
 	Zoneid                      string                         `json:"zoneid"`
 	Zonename                    string                         `json:"zonename"`
 	Zonesnetworkspans           []interface{}                  `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage     `json:"-"`
+}
+
+func (r *CreateNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkResponseService struct {
 	Capability []CreateNetworkResponseServiceCapability `json:"capability"`
 	Name       string                                   `json:"name"`
 	Provider   []CreateNetworkResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage               `json:"-"`
+}
+
+func (r *CreateNetworkResponseService) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateNetworkResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateNetworkResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias CreateNetworkResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreatePhysicalNetworkParams struct {
@@ -1927,6 +2444,51 @@ func (p *CreatePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createPhysicalNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreatePhysicalNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createPhysicalNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createPhysicalNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreatePhysicalNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "zoneid"},
+		lengths:  map[string]int{"broadcastdomainrange": 255, "domainid": 255, "name": 255, "networkspeed": 255, "vlan": 255, "zoneid": 255},
+	}.validate("createPhysicalNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createPhysicalNetwork API, before the request is signed
+func (p *CreatePhysicalNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreatePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreatePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createPhysicalNetwork", b, &p.p, map[string]paramDecoder{
+		"broadcastdomainrange": decodeParam[string],
+		"domainid":             decodeParam[string],
+		"isolationmethods":     decodeParam[[]string],
+		"name":                 decodeParam[string],
+		"networkspeed":         decodeParam[string],
+		"tags":                 decodeParam[[]string],
+		"vlan":                 decodeParam[string],
+		"zoneid":               decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreatePhysicalNetworkParams) Clone() *CreatePhysicalNetworkParams {
+	return &CreatePhysicalNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *CreatePhysicalNetworkParams) SetBroadcastdomainrange(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2107,32 +2669,39 @@ func (s *NetworkService) NewCreatePhysicalNetworkParams(name string, zoneid stri
 
 // Creates a physical Network
 func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("createPhysicalNetwork", p.toURLValues())
+	return s.CreatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// CreatePhysicalNetworkWithContext is like CreatePhysicalNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createPhysicalNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreatePhysicalNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createPhysicalNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createPhysicalNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createPhysicalNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2140,20 +2709,45 @@ func (s *NetworkService) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreatePhysicalNetwork async job into r
+func (r *CreatePhysicalNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreatePhysicalNetworkAsync starts the async job of CreatePhysicalNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) CreatePhysicalNetworkAsync(ctx context.Context, p *CreatePhysicalNetworkParams) (*Job, error) {
+	r, err := s.CreatePhysicalNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createPhysicalNetwork", r.JobID), nil
+}
+
 type CreatePhysicalNetworkResponse struct {
-	Broadcastdomainrange string `json:"broadcastdomainrange"`
-	Domainid             string `json:"domainid"`
-	Id                   string `json:"id"`
-	Isolationmethods     string `json:"isolationmethods"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Name                 string `json:"name"`
-	Networkspeed         string `json:"networkspeed"`
-	State                string `json:"state"`
-	Tags                 string `json:"tags"`
-	Vlan                 string `json:"vlan"`
-	Zoneid               string `json:"zoneid"`
-	Zonename             string `json:"zonename"`
+	Broadcastdomainrange string                     `json:"broadcastdomainrange"`
+	Domainid             string                     `json:"domainid"`
+	Id                   string                     `json:"id"`
+	Isolationmethods     string                     `json:"isolationmethods"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Name                 string                     `json:"name"`
+	Networkspeed         string                     `json:"networkspeed"`
+	State                string                     `json:"state"`
+	Tags                 string                     `json:"tags"`
+	Vlan                 string                     `json:"vlan"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreatePhysicalNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias CreatePhysicalNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateServiceInstanceParams struct {
@@ -2195,6 +2789,52 @@ func (p *CreateServiceInstanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createServiceInstance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateServiceInstanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createServiceInstance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createServiceInstance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateServiceInstanceParams) Validate() error {
+	return paramRules{
+		required: []string{"leftnetworkid", "name", "rightnetworkid", "serviceofferingid", "templateid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "leftnetworkid": 255, "name": 255, "projectid": 255, "rightnetworkid": 255, "serviceofferingid": 255, "templateid": 255, "zoneid": 255},
+	}.validate("createServiceInstance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createServiceInstance API, before the request is signed
+func (p *CreateServiceInstanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateServiceInstanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateServiceInstanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createServiceInstance", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"domainid":          decodeParam[string],
+		"leftnetworkid":     decodeParam[string],
+		"name":              decodeParam[string],
+		"projectid":         decodeParam[string],
+		"rightnetworkid":    decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+		"templateid":        decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateServiceInstanceParams) Clone() *CreateServiceInstanceParams {
+	return &CreateServiceInstanceParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateServiceInstanceParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2400,32 +3040,39 @@ func (s *NetworkService) NewCreateServiceInstanceParams(leftnetworkid string, na
 
 // Creates a system virtual-machine that implements network services
 func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
-	resp, err := s.cs.newPostRequest("createServiceInstance", p.toURLValues())
+	return s.CreateServiceInstanceWithContext(context.Background(), p)
+}
+
+// CreateServiceInstanceWithContext is like CreateServiceInstance, but honours the cancellation and deadline of ctx
+func (s *NetworkService) CreateServiceInstanceWithContext(ctx context.Context, p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createServiceInstance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateServiceInstanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createServiceInstance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createServiceInstance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createServiceInstance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2433,18 +3080,43 @@ func (s *NetworkService) CreateServiceInstance(p *CreateServiceInstanceParams) (
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateServiceInstance async job into r
+func (r *CreateServiceInstanceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateServiceInstanceAsync starts the async job of CreateServiceInstance without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) CreateServiceInstanceAsync(ctx context.Context, p *CreateServiceInstanceParams) (*Job, error) {
+	r, err := s.CreateServiceInstanceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createServiceInstance", r.JobID), nil
+}
+
 type CreateServiceInstanceResponse struct {
-	Account     string `json:"account"`
-	Displayname string `json:"displayname"`
-	Domain      string `json:"domain"`
-	Domainid    string `json:"domainid"`
-	Domainpath  string `json:"domainpath"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Project     string `json:"project"`
-	Projectid   string `json:"projectid"`
+	Account     string                     `json:"account"`
+	Displayname string                     `json:"displayname"`
+	Domain      string                     `json:"domain"`
+	Domainid    string                     `json:"domainid"`
+	Domainpath  string                     `json:"domainpath"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Project     string                     `json:"project"`
+	Projectid   string                     `json:"projectid"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateServiceInstanceResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateServiceInstanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateStorageNetworkIpRangeParams struct {
@@ -2478,6 +3150,49 @@ func (p *CreateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createStorageNetworkIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateStorageNetworkIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createStorageNetworkIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createStorageNetworkIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateStorageNetworkIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"gateway", "netmask", "podid", "startip"},
+		lengths:  map[string]int{"endip": 255, "gateway": 255, "netmask": 255, "podid": 255, "startip": 255},
+	}.validate("createStorageNetworkIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createStorageNetworkIpRange API, before the request is signed
+func (p *CreateStorageNetworkIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createStorageNetworkIpRange", b, &p.p, map[string]paramDecoder{
+		"endip":   decodeParam[string],
+		"gateway": decodeParam[string],
+		"netmask": decodeParam[string],
+		"podid":   decodeParam[string],
+		"startip": decodeParam[string],
+		"vlan":    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateStorageNetworkIpRangeParams) Clone() *CreateStorageNetworkIpRangeParams {
+	return &CreateStorageNetworkIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateStorageNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2618,32 +3333,39 @@ func (s *NetworkService) NewCreateStorageNetworkIpRangeParams(gateway string, ne
 
 // Creates a Storage network IP range.
 func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("createStorageNetworkIpRange", p.toURLValues())
+	return s.CreateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// CreateStorageNetworkIpRangeWithContext is like CreateStorageNetworkIpRange, but honours the cancellation and deadline of ctx
+func (s *NetworkService) CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createStorageNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createStorageNetworkIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createStorageNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2651,18 +3373,43 @@ func (s *NetworkService) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateStorageNetworkIpRange async job into r
+func (r *CreateStorageNetworkIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateStorageNetworkIpRangeAsync starts the async job of CreateStorageNetworkIpRange without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) CreateStorageNetworkIpRangeAsync(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*Job, error) {
+	r, err := s.CreateStorageNetworkIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createStorageNetworkIpRange", r.JobID), nil
+}
+
 type CreateStorageNetworkIpRangeResponse struct {
-	Endip     string `json:"endip"`
-	Gateway   string `json:"gateway"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Netmask   string `json:"netmask"`
-	Networkid string `json:"networkid"`
-	Podid     string `json:"podid"`
-	Startip   string `json:"startip"`
-	Vlan      int    `json:"vlan"`
-	Zoneid    string `json:"zoneid"`
+	Endip     string                     `json:"endip"`
+	Gateway   string                     `json:"gateway"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Netmask   string                     `json:"netmask"`
+	Networkid string                     `json:"networkid"`
+	Podid     string                     `json:"podid"`
+	Startip   string                     `json:"startip"`
+	Vlan      FlexInt                    `json:"vlan"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateStorageNetworkIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateStorageNetworkIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicatePublicIpRangeParams struct {
@@ -2689,8 +3436,49 @@ func (p *DedicatePublicIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
-func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
-	if p.p == nil {
+// ValidateAgainst checks the parameters against the dedicatePublicIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicatePublicIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicatePublicIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicatePublicIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicatePublicIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"domainid", "id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "projectid": 255},
+	}.validate("dedicatePublicIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicatePublicIpRange API, before the request is signed
+func (p *DedicatePublicIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicatePublicIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicatePublicIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicatePublicIpRange", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicatePublicIpRangeParams) Clone() *DedicatePublicIpRangeParams {
+	return &DedicatePublicIpRangeParams{p: cloneParams(p.p)}
+}
+
+func (p *DedicatePublicIpRangeParams) SetAccount(v string) {
+	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
 	p.p["account"] = v
@@ -2785,13 +3573,22 @@ func (s *NetworkService) NewDedicatePublicIpRangeParams(domainid string, id stri
 
 // Dedicates a Public IP range to an account
 func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicatePublicIpRange", p.toURLValues())
+	return s.DedicatePublicIpRangeWithContext(context.Background(), p)
+}
+
+// DedicatePublicIpRangeWithContext is like DedicatePublicIpRange, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicatePublicIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicatePublicIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicatePublicIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2799,34 +3596,40 @@ func (s *NetworkService) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (
 }
 
 type DedicatePublicIpRangeResponse struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Description       string `json:"description"`
-	Domain            string `json:"domain"`
-	Domainid          string `json:"domainid"`
-	Domainpath        string `json:"domainpath"`
-	Endip             string `json:"endip"`
-	Endipv6           string `json:"endipv6"`
-	Forsystemvms      bool   `json:"forsystemvms"`
-	Forvirtualnetwork bool   `json:"forvirtualnetwork"`
-	Gateway           string `json:"gateway"`
-	Id                string `json:"id"`
-	Ip6cidr           string `json:"ip6cidr"`
-	Ip6gateway        string `json:"ip6gateway"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Netmask           string `json:"netmask"`
-	Networkid         string `json:"networkid"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Project           string `json:"project"`
-	Projectid         string `json:"projectid"`
-	Provider          string `json:"provider"`
-	Startip           string `json:"startip"`
-	Startipv6         string `json:"startipv6"`
-	Vlan              string `json:"vlan"`
-	Zoneid            string `json:"zoneid"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Description       string                     `json:"description"`
+	Domain            string                     `json:"domain"`
+	Domainid          string                     `json:"domainid"`
+	Domainpath        string                     `json:"domainpath"`
+	Endip             string                     `json:"endip"`
+	Endipv6           string                     `json:"endipv6"`
+	Forsystemvms      FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork FlexBool                   `json:"forvirtualnetwork"`
+	Gateway           string                     `json:"gateway"`
+	Id                string                     `json:"id"`
+	Ip6cidr           string                     `json:"ip6cidr"`
+	Ip6gateway        string                     `json:"ip6gateway"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Netmask           string                     `json:"netmask"`
+	Networkid         string                     `json:"networkid"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Project           string                     `json:"project"`
+	Projectid         string                     `json:"projectid"`
+	Provider          string                     `json:"provider"`
+	Startip           string                     `json:"startip"`
+	Startipv6         string                     `json:"startipv6"`
+	Vlan              string                     `json:"vlan"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicatePublicIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicatePublicIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteIpv4SubnetForGuestNetworkParams struct {
@@ -2844,6 +3647,44 @@ func (p *DeleteIpv4SubnetForGuestNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteIpv4SubnetForGuestNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteIpv4SubnetForGuestNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteIpv4SubnetForGuestNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteIpv4SubnetForGuestNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteIpv4SubnetForGuestNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteIpv4SubnetForGuestNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteIpv4SubnetForGuestNetwork API, before the request is signed
+func (p *DeleteIpv4SubnetForGuestNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteIpv4SubnetForGuestNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteIpv4SubnetForGuestNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteIpv4SubnetForGuestNetwork", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteIpv4SubnetForGuestNetworkParams) Clone() *DeleteIpv4SubnetForGuestNetworkParams {
+	return &DeleteIpv4SubnetForGuestNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteIpv4SubnetForGuestNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2876,27 +3717,39 @@ func (s *NetworkService) NewDeleteIpv4SubnetForGuestNetworkParams(id string) *De
 
 // Deletes an existing IPv4 subnet for guest network.
 func (s *NetworkService) DeleteIpv4SubnetForGuestNetwork(p *DeleteIpv4SubnetForGuestNetworkParams) (*DeleteIpv4SubnetForGuestNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteIpv4SubnetForGuestNetwork", p.toURLValues())
+	return s.DeleteIpv4SubnetForGuestNetworkWithContext(context.Background(), p)
+}
+
+// DeleteIpv4SubnetForGuestNetworkWithContext is like DeleteIpv4SubnetForGuestNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DeleteIpv4SubnetForGuestNetworkWithContext(ctx context.Context, p *DeleteIpv4SubnetForGuestNetworkParams) (*DeleteIpv4SubnetForGuestNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteIpv4SubnetForGuestNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteIpv4SubnetForGuestNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteIpv4SubnetForGuestNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteIpv4SubnetForGuestNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteIpv4SubnetForGuestNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2904,11 +3757,31 @@ func (s *NetworkService) DeleteIpv4SubnetForGuestNetwork(p *DeleteIpv4SubnetForG
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteIpv4SubnetForGuestNetwork async job into r
+func (r *DeleteIpv4SubnetForGuestNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteIpv4SubnetForGuestNetworkAsync starts the async job of DeleteIpv4SubnetForGuestNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) DeleteIpv4SubnetForGuestNetworkAsync(ctx context.Context, p *DeleteIpv4SubnetForGuestNetworkParams) (*Job, error) {
+	r, err := s.DeleteIpv4SubnetForGuestNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteIpv4SubnetForGuestNetwork", r.JobID), nil
+}
+
 type DeleteIpv4SubnetForGuestNetworkResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteIpv4SubnetForGuestNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteIpv4SubnetForGuestNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetworkParams struct {
@@ -2930,6 +3803,45 @@ func (p *DeleteNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetwork API, before the request is signed
+func (p *DeleteNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetwork", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetworkParams) Clone() *DeleteNetworkParams {
+	return &DeleteNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetworkParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2983,27 +3895,39 @@ func (s *NetworkService) NewDeleteNetworkParams(id string) *DeleteNetworkParams
 
 // Deletes a Network
 func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetwork", p.toURLValues())
+	return s.DeleteNetworkWithContext(context.Background(), p)
+}
+
+// DeleteNetworkWithContext is like DeleteNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3011,11 +3935,31 @@ func (s *NetworkService) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteNetwork async job into r
+func (r *DeleteNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteNetworkAsync starts the async job of DeleteNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) DeleteNetworkAsync(ctx context.Context, p *DeleteNetworkParams) (*Job, error) {
+	r, err := s.DeleteNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteNetwork", r.JobID), nil
+}
+
 type DeleteNetworkResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNetworkServiceProviderParams struct {
@@ -3033,6 +3977,44 @@ func (p *DeleteNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNetworkServiceProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNetworkServiceProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNetworkServiceProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNetworkServiceProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNetworkServiceProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteNetworkServiceProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNetworkServiceProvider API, before the request is signed
+func (p *DeleteNetworkServiceProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNetworkServiceProvider", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNetworkServiceProviderParams) Clone() *DeleteNetworkServiceProviderParams {
+	return &DeleteNetworkServiceProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNetworkServiceProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3065,27 +4047,39 @@ func (s *NetworkService) NewDeleteNetworkServiceProviderParams(id string) *Delet
 
 // Deletes a Network Service Provider.
 func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNetworkServiceProvider", p.toURLValues())
+	return s.DeleteNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// DeleteNetworkServiceProviderWithContext is like DeleteNetworkServiceProvider, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNetworkServiceProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNetworkServiceProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNetworkServiceProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteNetworkServiceProvider", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteNetworkServiceProvider", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3093,11 +4087,31 @@ func (s *NetworkService) DeleteNetworkServiceProvider(p *DeleteNetworkServicePro
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteNetworkServiceProvider async job into r
+func (r *DeleteNetworkServiceProviderResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteNetworkServiceProviderAsync starts the async job of DeleteNetworkServiceProvider without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) DeleteNetworkServiceProviderAsync(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*Job, error) {
+	r, err := s.DeleteNetworkServiceProviderWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteNetworkServiceProvider", r.JobID), nil
+}
+
 type DeleteNetworkServiceProviderResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNetworkServiceProviderResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNetworkServiceProviderResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteOpenDaylightControllerParams struct {
@@ -3115,6 +4129,44 @@ func (p *DeleteOpenDaylightControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteOpenDaylightController API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteOpenDaylightControllerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteOpenDaylightController", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteOpenDaylightController API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteOpenDaylightControllerParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteOpenDaylightController", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteOpenDaylightController API, before the request is signed
+func (p *DeleteOpenDaylightControllerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteOpenDaylightControllerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteOpenDaylightControllerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteOpenDaylightController", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteOpenDaylightControllerParams) Clone() *DeleteOpenDaylightControllerParams {
+	return &DeleteOpenDaylightControllerParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteOpenDaylightControllerParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3147,32 +4199,39 @@ func (s *NetworkService) NewDeleteOpenDaylightControllerParams(id string) *Delet
 
 // Removes an OpenDyalight controler
 func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteOpenDaylightController", p.toURLValues())
+	return s.DeleteOpenDaylightControllerWithContext(context.Background(), p)
+}
+
+// DeleteOpenDaylightControllerWithContext is like DeleteOpenDaylightController, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DeleteOpenDaylightControllerWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteOpenDaylightController", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteOpenDaylightControllerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteOpenDaylightController", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteOpenDaylightController", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteOpenDaylightController", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3180,14 +4239,39 @@ func (s *NetworkService) DeleteOpenDaylightController(p *DeleteOpenDaylightContr
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteOpenDaylightController async job into r
+func (r *DeleteOpenDaylightControllerResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeleteOpenDaylightControllerAsync starts the async job of DeleteOpenDaylightController without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) DeleteOpenDaylightControllerAsync(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*Job, error) {
+	r, err := s.DeleteOpenDaylightControllerWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteOpenDaylightController", r.JobID), nil
+}
+
 type DeleteOpenDaylightControllerResponse struct {
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Name              string `json:"name"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Url               string `json:"url"`
-	Username          string `json:"username"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Name              string                     `json:"name"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Url               string                     `json:"url"`
+	Username          string                     `json:"username"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteOpenDaylightControllerResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteOpenDaylightControllerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeletePhysicalNetworkParams struct {
@@ -3205,6 +4289,44 @@ func (p *DeletePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deletePhysicalNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeletePhysicalNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deletePhysicalNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deletePhysicalNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeletePhysicalNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deletePhysicalNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deletePhysicalNetwork API, before the request is signed
+func (p *DeletePhysicalNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeletePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeletePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deletePhysicalNetwork", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeletePhysicalNetworkParams) Clone() *DeletePhysicalNetworkParams {
+	return &DeletePhysicalNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *DeletePhysicalNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3237,27 +4359,39 @@ func (s *NetworkService) NewDeletePhysicalNetworkParams(id string) *DeletePhysic
 
 // Deletes a Physical Network.
 func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("deletePhysicalNetwork", p.toURLValues())
+	return s.DeletePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// DeletePhysicalNetworkWithContext is like DeletePhysicalNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deletePhysicalNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePhysicalNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deletePhysicalNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deletePhysicalNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deletePhysicalNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3265,11 +4399,31 @@ func (s *NetworkService) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeletePhysicalNetwork async job into r
+func (r *DeletePhysicalNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeletePhysicalNetworkAsync starts the async job of DeletePhysicalNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) DeletePhysicalNetworkAsync(ctx context.Context, p *DeletePhysicalNetworkParams) (*Job, error) {
+	r, err := s.DeletePhysicalNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deletePhysicalNetwork", r.JobID), nil
+}
+
 type DeletePhysicalNetworkResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeletePhysicalNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias DeletePhysicalNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteStorageNetworkIpRangeParams struct {
@@ -3287,6 +4441,44 @@ func (p *DeleteStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteStorageNetworkIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteStorageNetworkIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteStorageNetworkIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteStorageNetworkIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteStorageNetworkIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteStorageNetworkIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteStorageNetworkIpRange API, before the request is signed
+func (p *DeleteStorageNetworkIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteStorageNetworkIpRange", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteStorageNetworkIpRangeParams) Clone() *DeleteStorageNetworkIpRangeParams {
+	return &DeleteStorageNetworkIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteStorageNetworkIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3319,27 +4511,39 @@ func (s *NetworkService) NewDeleteStorageNetworkIpRangeParams(id string) *Delete
 
 // Deletes a storage network IP Range.
 func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteStorageNetworkIpRange", p.toURLValues())
+	return s.DeleteStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteStorageNetworkIpRangeWithContext is like DeleteStorageNetworkIpRange, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteStorageNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteStorageNetworkIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteStorageNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3347,11 +4551,31 @@ func (s *NetworkService) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteStorageNetworkIpRange async job into r
+func (r *DeleteStorageNetworkIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteStorageNetworkIpRangeAsync starts the async job of DeleteStorageNetworkIpRange without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) DeleteStorageNetworkIpRangeAsync(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*Job, error) {
+	r, err := s.DeleteStorageNetworkIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteStorageNetworkIpRange", r.JobID), nil
+}
+
 type DeleteStorageNetworkIpRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteStorageNetworkIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteStorageNetworkIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListIpv4SubnetsForGuestNetworkParams struct {
@@ -3395,6 +4619,51 @@ func (p *ListIpv4SubnetsForGuestNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listIpv4SubnetsForGuestNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListIpv4SubnetsForGuestNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listIpv4SubnetsForGuestNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listIpv4SubnetsForGuestNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListIpv4SubnetsForGuestNetworkParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "networkid": 255, "parentid": 255, "subnet": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listIpv4SubnetsForGuestNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listIpv4SubnetsForGuestNetwork API, before the request is signed
+func (p *ListIpv4SubnetsForGuestNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListIpv4SubnetsForGuestNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListIpv4SubnetsForGuestNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listIpv4SubnetsForGuestNetwork", b, &p.p, map[string]paramDecoder{
+		"id":        decodeParam[string],
+		"keyword":   decodeParam[string],
+		"networkid": decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"parentid":  decodeParam[string],
+		"subnet":    decodeParam[string],
+		"vpcid":     decodeParam[string],
+		"zoneid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListIpv4SubnetsForGuestNetworkParams) Clone() *ListIpv4SubnetsForGuestNetworkParams {
+	return &ListIpv4SubnetsForGuestNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *ListIpv4SubnetsForGuestNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3594,6 +4863,11 @@ func (s *NetworkService) NewListIpv4SubnetsForGuestNetworkParams() *ListIpv4Subn
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetIpv4SubnetsForGuestNetworkByID(id string, opts ...OptionFunc) (*Ipv4SubnetsForGuestNetwork, int, error) {
+	return s.GetIpv4SubnetsForGuestNetworkByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIpv4SubnetsForGuestNetworkByIDWithContext is like GetIpv4SubnetsForGuestNetworkByID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetIpv4SubnetsForGuestNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv4SubnetsForGuestNetwork, int, error) {
 	p := &ListIpv4SubnetsForGuestNetworkParams{}
 	p.p = make(map[string]interface{})
 
@@ -3605,11 +4879,10 @@ func (s *NetworkService) GetIpv4SubnetsForGuestNetworkByID(id string, opts ...Op
 		}
 	}
 
-	l, err := s.ListIpv4SubnetsForGuestNetwork(p)
+	l, err := s.ListIpv4SubnetsForGuestNetworkWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3627,41 +4900,84 @@ func (s *NetworkService) GetIpv4SubnetsForGuestNetworkByID(id string, opts ...Op
 
 // Lists IPv4 subnets for guest networks.
 func (s *NetworkService) ListIpv4SubnetsForGuestNetwork(p *ListIpv4SubnetsForGuestNetworkParams) (*ListIpv4SubnetsForGuestNetworkResponse, error) {
-	resp, err := s.cs.newRequest("listIpv4SubnetsForGuestNetwork", p.toURLValues())
+	return s.ListIpv4SubnetsForGuestNetworkWithContext(context.Background(), p)
+}
+
+// ListIpv4SubnetsForGuestNetworkWithContext is like ListIpv4SubnetsForGuestNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListIpv4SubnetsForGuestNetworkWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams) (*ListIpv4SubnetsForGuestNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listIpv4SubnetsForGuestNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListIpv4SubnetsForGuestNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listIpv4SubnetsForGuestNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListIpv4SubnetsForGuestNetworkAll returns all Ipv4SubnetsForGuestNetwork matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListIpv4SubnetsForGuestNetworkAll(p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) ([]*Ipv4SubnetsForGuestNetwork, error) {
+	return s.ListIpv4SubnetsForGuestNetworkAllWithContext(context.Background(), p, opts...)
+}
+
+// ListIpv4SubnetsForGuestNetworkAllWithContext is like ListIpv4SubnetsForGuestNetworkAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListIpv4SubnetsForGuestNetworkAllWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) ([]*Ipv4SubnetsForGuestNetwork, error) {
+	return collectPages(s.ListIpv4SubnetsForGuestNetworkIterWithContext(ctx, p, opts...))
+}
+
+// ListIpv4SubnetsForGuestNetworkIter returns an iterator over all Ipv4SubnetsForGuestNetwork matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListIpv4SubnetsForGuestNetworkIter(p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForGuestNetwork, error] {
+	return s.ListIpv4SubnetsForGuestNetworkIterWithContext(context.Background(), p, opts...)
+}
+
+// ListIpv4SubnetsForGuestNetworkIterWithContext is like ListIpv4SubnetsForGuestNetworkIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListIpv4SubnetsForGuestNetworkIterWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForGuestNetwork, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Ipv4SubnetsForGuestNetwork, int, error) {
+		l, err := s.ListIpv4SubnetsForGuestNetworkWithContext(ctx, &ListIpv4SubnetsForGuestNetworkParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Ipv4SubnetsForGuestNetwork, l.Count, nil
+	}, opts...)
+}
+
 type ListIpv4SubnetsForGuestNetworkResponse struct {
 	Count                      int                           `json:"count"`
 	Ipv4SubnetsForGuestNetwork []*Ipv4SubnetsForGuestNetwork `json:"ipv4subnetsforguestnetwork"`
 }
 
 type Ipv4SubnetsForGuestNetwork struct {
-	Allocated    string `json:"allocated"`
-	Created      string `json:"created"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Networkid    string `json:"networkid"`
-	Networkname  string `json:"networkname"`
-	Parentid     string `json:"parentid"`
-	Parentsubnet string `json:"parentsubnet"`
-	Removed      string `json:"removed"`
-	State        string `json:"state"`
-	Subnet       string `json:"subnet"`
-	Vpcid        string `json:"vpcid"`
-	Vpcname      string `json:"vpcname"`
-	Zoneid       string `json:"zoneid"`
-	Zonename     string `json:"zonename"`
+	Allocated    string                     `json:"allocated"`
+	Created      Time                       `json:"created"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Networkid    string                     `json:"networkid"`
+	Networkname  string                     `json:"networkname"`
+	Parentid     string                     `json:"parentid"`
+	Parentsubnet string                     `json:"parentsubnet"`
+	Removed      Time                       `json:"removed"`
+	State        string                     `json:"state"`
+	Subnet       string                     `json:"subnet"`
+	Vpcid        string                     `json:"vpcid"`
+	Vpcname      string                     `json:"vpcname"`
+	Zoneid       string                     `json:"zoneid"`
+	Zonename     string                     `json:"zonename"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Ipv4SubnetsForGuestNetwork) UnmarshalJSON(b []byte) error {
+	type alias Ipv4SubnetsForGuestNetwork
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkIsolationMethodsParams struct {
@@ -3687,6 +5003,45 @@ func (p *ListNetworkIsolationMethodsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkIsolationMethods API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkIsolationMethodsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkIsolationMethods", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkIsolationMethods API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkIsolationMethodsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listNetworkIsolationMethods", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkIsolationMethods API, before the request is signed
+func (p *ListNetworkIsolationMethodsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkIsolationMethodsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkIsolationMethodsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkIsolationMethods", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkIsolationMethodsParams) Clone() *ListNetworkIsolationMethodsParams {
+	return &ListNetworkIsolationMethodsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkIsolationMethodsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3760,28 +5115,71 @@ func (s *NetworkService) NewListNetworkIsolationMethodsParams() *ListNetworkIsol
 
 // Lists supported methods of network isolation
 func (s *NetworkService) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkIsolationMethods", p.toURLValues())
+	return s.ListNetworkIsolationMethodsWithContext(context.Background(), p)
+}
+
+// ListNetworkIsolationMethodsWithContext is like ListNetworkIsolationMethods, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkIsolationMethods", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkIsolationMethodsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkIsolationMethods", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetworkIsolationMethodsAll returns all NetworkIsolationMethods matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListNetworkIsolationMethodsAll(p *ListNetworkIsolationMethodsParams, opts ...PageOption) ([]*NetworkIsolationMethod, error) {
+	return s.ListNetworkIsolationMethodsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkIsolationMethodsAllWithContext is like ListNetworkIsolationMethodsAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkIsolationMethodsAllWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams, opts ...PageOption) ([]*NetworkIsolationMethod, error) {
+	return collectPages(s.ListNetworkIsolationMethodsIterWithContext(ctx, p, opts...))
+}
+
+// ListNetworkIsolationMethodsIter returns an iterator over all NetworkIsolationMethods matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListNetworkIsolationMethodsIter(p *ListNetworkIsolationMethodsParams, opts ...PageOption) iter.Seq2[*NetworkIsolationMethod, error] {
+	return s.ListNetworkIsolationMethodsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkIsolationMethodsIterWithContext is like ListNetworkIsolationMethodsIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkIsolationMethodsIterWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams, opts ...PageOption) iter.Seq2[*NetworkIsolationMethod, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetworkIsolationMethod, int, error) {
+		l, err := s.ListNetworkIsolationMethodsWithContext(ctx, &ListNetworkIsolationMethodsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetworkIsolationMethods, l.Count, nil
+	}, opts...)
+}
+
 type ListNetworkIsolationMethodsResponse struct {
 	Count                   int                       `json:"count"`
 	NetworkIsolationMethods []*NetworkIsolationMethod `json:"isolationmethod"`
 }
 
 type NetworkIsolationMethod struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkIsolationMethod) UnmarshalJSON(b []byte) error {
+	type alias NetworkIsolationMethod
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkProtocolsParams struct {
@@ -3799,6 +5197,44 @@ func (p *ListNetworkProtocolsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkProtocols API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkProtocolsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkProtocols", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkProtocols API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkProtocolsParams) Validate() error {
+	return paramRules{
+		required: []string{"option"},
+		lengths:  map[string]int{"option": 255},
+	}.validate("listNetworkProtocols", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkProtocols API, before the request is signed
+func (p *ListNetworkProtocolsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkProtocolsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkProtocolsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkProtocols", b, &p.p, map[string]paramDecoder{
+		"option": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkProtocolsParams) Clone() *ListNetworkProtocolsParams {
+	return &ListNetworkProtocolsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkProtocolsParams) SetOption(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3831,13 +5267,22 @@ func (s *NetworkService) NewListNetworkProtocolsParams(option string) *ListNetwo
 
 // Lists details of network protocols
 func (s *NetworkService) ListNetworkProtocols(p *ListNetworkProtocolsParams) (*ListNetworkProtocolsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkProtocols", p.toURLValues())
+	return s.ListNetworkProtocolsWithContext(context.Background(), p)
+}
+
+// ListNetworkProtocolsWithContext is like ListNetworkProtocols, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkProtocolsWithContext(ctx context.Context, p *ListNetworkProtocolsParams) (*ListNetworkProtocolsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkProtocols", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkProtocolsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkProtocols", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3850,12 +5295,18 @@ type ListNetworkProtocolsResponse struct {
 }
 
 type NetworkProtocol struct {
-	Description string            `json:"description"`
-	Details     map[string]string `json:"details"`
-	Index       int               `json:"index"`
-	JobID       string            `json:"jobid"`
-	Jobstatus   int               `json:"jobstatus"`
-	Name        string            `json:"name"`
+	Description string                     `json:"description"`
+	Details     map[string]string          `json:"details"`
+	Index       FlexInt                    `json:"index"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkProtocol) UnmarshalJSON(b []byte) error {
+	type alias NetworkProtocol
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkServiceProvidersParams struct {
@@ -3890,6 +5341,48 @@ func (p *ListNetworkServiceProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkServiceProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkServiceProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkServiceProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkServiceProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkServiceProvidersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "name": 255, "physicalnetworkid": 255, "state": 255},
+	}.validate("listNetworkServiceProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkServiceProviders API, before the request is signed
+func (p *ListNetworkServiceProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkServiceProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkServiceProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkServiceProviders", b, &p.p, map[string]paramDecoder{
+		"keyword":           decodeParam[string],
+		"name":              decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+		"state":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkServiceProvidersParams) Clone() *ListNetworkServiceProvidersParams {
+	return &ListNetworkServiceProvidersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkServiceProvidersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4026,6 +5519,11 @@ func (s *NetworkService) NewListNetworkServiceProvidersParams() *ListNetworkServ
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkServiceProviderIDWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkServiceProviderIDWithContext is like GetNetworkServiceProviderID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetNetworkServiceProviderIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworkServiceProvidersParams{}
 	p.p = make(map[string]interface{})
 
@@ -4037,7 +5535,7 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 		}
 	}
 
-	l, err := s.ListNetworkServiceProviders(p)
+	l, err := s.ListNetworkServiceProvidersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4062,34 +5560,77 @@ func (s *NetworkService) GetNetworkServiceProviderID(name string, opts ...Option
 
 // Lists network serviceproviders for a given physical network.
 func (s *NetworkService) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkServiceProviders", p.toURLValues())
+	return s.ListNetworkServiceProvidersWithContext(context.Background(), p)
+}
+
+// ListNetworkServiceProvidersWithContext is like ListNetworkServiceProviders, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkServiceProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkServiceProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkServiceProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetworkServiceProvidersAll returns all NetworkServiceProviders matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListNetworkServiceProvidersAll(p *ListNetworkServiceProvidersParams, opts ...PageOption) ([]*NetworkServiceProvider, error) {
+	return s.ListNetworkServiceProvidersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkServiceProvidersAllWithContext is like ListNetworkServiceProvidersAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkServiceProvidersAllWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams, opts ...PageOption) ([]*NetworkServiceProvider, error) {
+	return collectPages(s.ListNetworkServiceProvidersIterWithContext(ctx, p, opts...))
+}
+
+// ListNetworkServiceProvidersIter returns an iterator over all NetworkServiceProviders matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListNetworkServiceProvidersIter(p *ListNetworkServiceProvidersParams, opts ...PageOption) iter.Seq2[*NetworkServiceProvider, error] {
+	return s.ListNetworkServiceProvidersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworkServiceProvidersIterWithContext is like ListNetworkServiceProvidersIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkServiceProvidersIterWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams, opts ...PageOption) iter.Seq2[*NetworkServiceProvider, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NetworkServiceProvider, int, error) {
+		l, err := s.ListNetworkServiceProvidersWithContext(ctx, &ListNetworkServiceProvidersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NetworkServiceProviders, l.Count, nil
+	}, opts...)
+}
+
 type ListNetworkServiceProvidersResponse struct {
 	Count                   int                       `json:"count"`
 	NetworkServiceProviders []*NetworkServiceProvider `json:"networkserviceprovider"`
 }
 
 type NetworkServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	JobID                        string   `json:"jobid"`
-	Jobstatus                    int      `json:"jobstatus"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias NetworkServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworksParams struct {
@@ -4211,6 +5752,72 @@ func (p *ListNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworksParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "acltype": 255, "associatednetworkid": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "networkfilter": 255, "networkofferingid": 255, "physicalnetworkid": 255, "projectid": 255, "traffictype": 255, "type": 255, "vlan": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listNetworks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworks API, before the request is signed
+func (p *ListNetworksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworks", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"acltype":                   decodeParam[string],
+		"associatednetworkid":       decodeParam[string],
+		"canusefordeploy":           decodeParam[bool],
+		"displaynetwork":            decodeParam[bool],
+		"domainid":                  decodeParam[string],
+		"forvpc":                    decodeParam[bool],
+		"id":                        decodeParam[string],
+		"isrecursive":               decodeParam[bool],
+		"issystem":                  decodeParam[bool],
+		"keyword":                   decodeParam[string],
+		"listall":                   decodeParam[bool],
+		"name":                      decodeParam[string],
+		"networkfilter":             decodeParam[string],
+		"networkofferingid":         decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"physicalnetworkid":         decodeParam[string],
+		"projectid":                 decodeParam[string],
+		"restartrequired":           decodeParam[bool],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"showicon":                  decodeParam[bool],
+		"specifyipranges":           decodeParam[bool],
+		"supportedservices":         decodeParam[[]string],
+		"tags":                      decodeParam[map[string]string],
+		"traffictype":               decodeParam[string],
+		"type":                      decodeParam[string],
+		"vlan":                      decodeParam[string],
+		"vpcid":                     decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworksParams) Clone() *ListNetworksParams {
+	return &ListNetworksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworksParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4851,6 +6458,11 @@ func (s *NetworkService) NewListNetworksParams() *ListNetworksParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNetworkIDWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkIDWithContext is like GetNetworkID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -4862,7 +6474,7 @@ func (s *NetworkService) GetNetworkID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListNetworks(p)
+	l, err := s.ListNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4887,12 +6499,17 @@ func (s *NetworkService) GetNetworkID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error) {
-	id, count, err := s.GetNetworkID(name, opts...)
+	return s.GetNetworkByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetNetworkByNameWithContext is like GetNetworkByName, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Network, int, error) {
+	id, count, err := s.GetNetworkIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetNetworkByID(id, opts...)
+	r, count, err := s.GetNetworkByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4901,6 +6518,11 @@ func (s *NetworkService) GetNetworkByName(name string, opts ...OptionFunc) (*Net
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error) {
+	return s.GetNetworkByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetNetworkByIDWithContext is like GetNetworkByID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Network, int, error) {
 	p := &ListNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -4912,11 +6534,10 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 		}
 	}
 
-	l, err := s.ListNetworks(p)
+	l, err := s.ListNetworksWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4934,126 +6555,187 @@ func (s *NetworkService) GetNetworkByID(id string, opts ...OptionFunc) (*Network
 
 // Lists all available networks.
 func (s *NetworkService) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNetworks", p.toURLValues())
+	return s.ListNetworksWithContext(context.Background(), p)
+}
+
+// ListNetworksWithContext is like ListNetworks, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNetworksAll returns all Networks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListNetworksAll(p *ListNetworksParams, opts ...PageOption) ([]*Network, error) {
+	return s.ListNetworksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworksAllWithContext is like ListNetworksAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworksAllWithContext(ctx context.Context, p *ListNetworksParams, opts ...PageOption) ([]*Network, error) {
+	return collectPages(s.ListNetworksIterWithContext(ctx, p, opts...))
+}
+
+// ListNetworksIter returns an iterator over all Networks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListNetworksIter(p *ListNetworksParams, opts ...PageOption) iter.Seq2[*Network, error] {
+	return s.ListNetworksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNetworksIterWithContext is like ListNetworksIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworksIterWithContext(ctx context.Context, p *ListNetworksParams, opts ...PageOption) iter.Seq2[*Network, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Network, int, error) {
+		l, err := s.ListNetworksWithContext(ctx, &ListNetworksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Networks, l.Count, nil
+	}, opts...)
+}
+
 type ListNetworksResponse struct {
 	Count    int        `json:"count"`
 	Networks []*Network `json:"network"`
 }
 
 type Network struct {
-	Account                     string                   `json:"account"`
-	Aclid                       string                   `json:"aclid"`
-	Aclname                     string                   `json:"aclname"`
-	Acltype                     string                   `json:"acltype"`
-	Asnumber                    int64                    `json:"asnumber"`
-	Asnumberid                  string                   `json:"asnumberid"`
-	Associatednetwork           string                   `json:"associatednetwork"`
-	Associatednetworkid         string                   `json:"associatednetworkid"`
-	Bgppeers                    []interface{}            `json:"bgppeers"`
-	Broadcastdomaintype         string                   `json:"broadcastdomaintype"`
-	Broadcasturi                string                   `json:"broadcasturi"`
-	Canusefordeploy             bool                     `json:"canusefordeploy"`
-	Cidr                        string                   `json:"cidr"`
-	Created                     string                   `json:"created"`
-	Details                     map[string]string        `json:"details"`
-	Displaynetwork              bool                     `json:"displaynetwork"`
-	Displaytext                 string                   `json:"displaytext"`
-	Dns1                        string                   `json:"dns1"`
-	Dns2                        string                   `json:"dns2"`
-	Domain                      string                   `json:"domain"`
-	Domainid                    string                   `json:"domainid"`
-	Domainpath                  string                   `json:"domainpath"`
-	Egressdefaultpolicy         bool                     `json:"egressdefaultpolicy"`
-	Externalid                  string                   `json:"externalid"`
-	Gateway                     string                   `json:"gateway"`
-	Hasannotations              bool                     `json:"hasannotations"`
-	Icon                        interface{}              `json:"icon"`
-	Id                          string                   `json:"id"`
-	Internetprotocol            string                   `json:"internetprotocol"`
-	Ip4routes                   []interface{}            `json:"ip4routes"`
-	Ip4routing                  string                   `json:"ip4routing"`
-	Ip6cidr                     string                   `json:"ip6cidr"`
-	Ip6dns1                     string                   `json:"ip6dns1"`
-	Ip6dns2                     string                   `json:"ip6dns2"`
-	Ip6gateway                  string                   `json:"ip6gateway"`
-	Ip6routes                   []interface{}            `json:"ip6routes"`
-	Ip6routing                  string                   `json:"ip6routing"`
-	Isdefault                   bool                     `json:"isdefault"`
-	Ispersistent                bool                     `json:"ispersistent"`
-	Issystem                    bool                     `json:"issystem"`
-	JobID                       string                   `json:"jobid"`
-	Jobstatus                   int                      `json:"jobstatus"`
-	Name                        string                   `json:"name"`
-	Netmask                     string                   `json:"netmask"`
-	Networkcidr                 string                   `json:"networkcidr"`
-	Networkdomain               string                   `json:"networkdomain"`
-	Networkofferingavailability string                   `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                     `json:"networkofferingconservemode"`
-	Networkofferingdisplaytext  string                   `json:"networkofferingdisplaytext"`
-	Networkofferingid           string                   `json:"networkofferingid"`
-	Networkofferingname         string                   `json:"networkofferingname"`
-	Physicalnetworkid           string                   `json:"physicalnetworkid"`
-	Privatemtu                  int                      `json:"privatemtu"`
-	Project                     string                   `json:"project"`
-	Projectid                   string                   `json:"projectid"`
-	Publicmtu                   int                      `json:"publicmtu"`
-	Receivedbytes               int64                    `json:"receivedbytes"`
-	Redundantrouter             bool                     `json:"redundantrouter"`
-	Related                     string                   `json:"related"`
-	Reservediprange             string                   `json:"reservediprange"`
-	Restartrequired             bool                     `json:"restartrequired"`
-	Sentbytes                   int64                    `json:"sentbytes"`
-	Service                     []NetworkServiceInternal `json:"service"`
-	Specifyipranges             bool                     `json:"specifyipranges"`
-	Specifyvlan                 bool                     `json:"specifyvlan"`
-	State                       string                   `json:"state"`
-	Strechedl2subnet            bool                     `json:"strechedl2subnet"`
-	Subdomainaccess             bool                     `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                     `json:"supportsvmautoscaling"`
-	Tags                        []Tags                   `json:"tags"`
-	Traffictype                 string                   `json:"traffictype"`
-	Tungstenvirtualrouteruuid   string                   `json:"tungstenvirtualrouteruuid"`
-	Type                        string                   `json:"type"`
-	Vlan                        string                   `json:"vlan"`
-	Vpcid                       string                   `json:"vpcid"`
-	Vpcname                     string                   `json:"vpcname"`
-	Zoneid                      string                   `json:"zoneid"`
-	Zonename                    string                   `json:"zonename"`
-	Zonesnetworkspans           []interface{}            `json:"zonesnetworkspans"`
+	Account                     string                     `json:"account"`
+	Aclid                       string                     `json:"aclid"`
+	Aclname                     string                     `json:"aclname"`
+	Acltype                     string                     `json:"acltype"`
+	Asnumber                    FlexInt64                  `json:"asnumber"`
+	Asnumberid                  string                     `json:"asnumberid"`
+	Associatednetwork           string                     `json:"associatednetwork"`
+	Associatednetworkid         string                     `json:"associatednetworkid"`
+	Bgppeers                    []interface{}              `json:"bgppeers"`
+	Broadcastdomaintype         string                     `json:"broadcastdomaintype"`
+	Broadcasturi                string                     `json:"broadcasturi"`
+	Canusefordeploy             FlexBool                   `json:"canusefordeploy"`
+	Cidr                        string                     `json:"cidr"`
+	Created                     Time                       `json:"created"`
+	Details                     map[string]string          `json:"details"`
+	Displaynetwork              FlexBool                   `json:"displaynetwork"`
+	Displaytext                 string                     `json:"displaytext"`
+	Dns1                        string                     `json:"dns1"`
+	Dns2                        string                     `json:"dns2"`
+	Domain                      string                     `json:"domain"`
+	Domainid                    string                     `json:"domainid"`
+	Domainpath                  string                     `json:"domainpath"`
+	Egressdefaultpolicy         FlexBool                   `json:"egressdefaultpolicy"`
+	Externalid                  string                     `json:"externalid"`
+	Gateway                     string                     `json:"gateway"`
+	Hasannotations              FlexBool                   `json:"hasannotations"`
+	Icon                        interface{}                `json:"icon"`
+	Id                          string                     `json:"id"`
+	Internetprotocol            string                     `json:"internetprotocol"`
+	Ip4routes                   []interface{}              `json:"ip4routes"`
+	Ip4routing                  string                     `json:"ip4routing"`
+	Ip6cidr                     string                     `json:"ip6cidr"`
+	Ip6dns1                     string                     `json:"ip6dns1"`
+	Ip6dns2                     string                     `json:"ip6dns2"`
+	Ip6gateway                  string                     `json:"ip6gateway"`
+	Ip6routes                   []interface{}              `json:"ip6routes"`
+	Ip6routing                  string                     `json:"ip6routing"`
+	Isdefault                   FlexBool                   `json:"isdefault"`
+	Ispersistent                FlexBool                   `json:"ispersistent"`
+	Issystem                    FlexBool                   `json:"issystem"`
+	JobID                       string                     `json:"jobid"`
+	Jobstatus                   FlexInt                    `json:"jobstatus"`
+	Name                        string                     `json:"name"`
+	Netmask                     string                     `json:"netmask"`
+	Networkcidr                 string                     `json:"networkcidr"`
+	Networkdomain               string                     `json:"networkdomain"`
+	Networkofferingavailability string                     `json:"networkofferingavailability"`
+	Networkofferingconservemode FlexBool                   `json:"networkofferingconservemode"`
+	Networkofferingdisplaytext  string                     `json:"networkofferingdisplaytext"`
+	Networkofferingid           string                     `json:"networkofferingid"`
+	Networkofferingname         string                     `json:"networkofferingname"`
+	Physicalnetworkid           string                     `json:"physicalnetworkid"`
+	Privatemtu                  FlexInt                    `json:"privatemtu"`
+	Project                     string                     `json:"project"`
+	Projectid                   string                     `json:"projectid"`
+	Publicmtu                   FlexInt                    `json:"publicmtu"`
+	Receivedbytes               FlexInt64                  `json:"receivedbytes"`
+	Redundantrouter             FlexBool                   `json:"redundantrouter"`
+	Related                     string                     `json:"related"`
+	Reservediprange             string                     `json:"reservediprange"`
+	Restartrequired             FlexBool                   `json:"restartrequired"`
+	Sentbytes                   FlexInt64                  `json:"sentbytes"`
+	Service                     []NetworkServiceInternal   `json:"service"`
+	Specifyipranges             FlexBool                   `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                   `json:"specifyvlan"`
+	State                       NetworkState               `json:"state"`
+	Strechedl2subnet            FlexBool                   `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                   `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                   `json:"supportsvmautoscaling"`
+	Tags                        []Tags                     `json:"tags"`
+	Traffictype                 string                     `json:"traffictype"`
+	Tungstenvirtualrouteruuid   string                     `json:"tungstenvirtualrouteruuid"`
+	Type                        string                     `json:"type"`
+	Vlan                        string                     `json:"vlan"`
+	Vpcid                       string                     `json:"vpcid"`
+	Vpcname                     string                     `json:"vpcname"`
+	Zoneid                      string                     `json:"zoneid"`
+	Zonename                    string                     `json:"zonename"`
+	Zonesnetworkspans           []interface{}              `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Network) UnmarshalJSON(b []byte) error {
+	type alias Network
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetworkServiceInternal struct {
 	Capability []NetworkServiceInternalCapability `json:"capability"`
 	Name       string                             `json:"name"`
 	Provider   []NetworkServiceInternalProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *NetworkServiceInternal) UnmarshalJSON(b []byte) error {
+	type alias NetworkServiceInternal
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetworkServiceInternalProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkServiceInternalProvider) UnmarshalJSON(b []byte) error {
+	type alias NetworkServiceInternalProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NetworkServiceInternalCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkServiceInternalCapability) UnmarshalJSON(b []byte) error {
+	type alias NetworkServiceInternalCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNiciraNvpDeviceNetworksParams struct {
@@ -5082,6 +6764,47 @@ func (p *ListNiciraNvpDeviceNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNiciraNvpDeviceNetworks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNiciraNvpDeviceNetworksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNiciraNvpDeviceNetworks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNiciraNvpDeviceNetworks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNiciraNvpDeviceNetworksParams) Validate() error {
+	return paramRules{
+		required: []string{"nvpdeviceid"},
+		lengths:  map[string]int{"keyword": 255, "nvpdeviceid": 255},
+	}.validate("listNiciraNvpDeviceNetworks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNiciraNvpDeviceNetworks API, before the request is signed
+func (p *ListNiciraNvpDeviceNetworksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNiciraNvpDeviceNetworksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNiciraNvpDeviceNetworksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNiciraNvpDeviceNetworks", b, &p.p, map[string]paramDecoder{
+		"keyword":     decodeParam[string],
+		"nvpdeviceid": decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNiciraNvpDeviceNetworksParams) Clone() *ListNiciraNvpDeviceNetworksParams {
+	return &ListNiciraNvpDeviceNetworksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNiciraNvpDeviceNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5177,6 +6900,11 @@ func (s *NetworkService) NewListNiciraNvpDeviceNetworksParams(nvpdeviceid string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetNiciraNvpDeviceNetworkIDWithContext(context.Background(), keyword, nvpdeviceid, opts...)
+}
+
+// GetNiciraNvpDeviceNetworkIDWithContext is like GetNiciraNvpDeviceNetworkID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetNiciraNvpDeviceNetworkIDWithContext(ctx context.Context, keyword string, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListNiciraNvpDeviceNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -5189,7 +6917,7 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 		}
 	}
 
-	l, err := s.ListNiciraNvpDeviceNetworks(p)
+	l, err := s.ListNiciraNvpDeviceNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -5214,19 +6942,56 @@ func (s *NetworkService) GetNiciraNvpDeviceNetworkID(keyword string, nvpdeviceid
 
 // Lists network that are using a nicira nvp device
 func (s *NetworkService) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDeviceNetworks", p.toURLValues())
+	return s.ListNiciraNvpDeviceNetworksWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDeviceNetworksWithContext is like ListNiciraNvpDeviceNetworks, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNiciraNvpDeviceNetworks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNiciraNvpDeviceNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNiciraNvpDeviceNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNiciraNvpDeviceNetworksAll returns all NiciraNvpDeviceNetworks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListNiciraNvpDeviceNetworksAll(p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) ([]*NiciraNvpDeviceNetwork, error) {
+	return s.ListNiciraNvpDeviceNetworksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNiciraNvpDeviceNetworksAllWithContext is like ListNiciraNvpDeviceNetworksAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNiciraNvpDeviceNetworksAllWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) ([]*NiciraNvpDeviceNetwork, error) {
+	return collectPages(s.ListNiciraNvpDeviceNetworksIterWithContext(ctx, p, opts...))
+}
+
+// ListNiciraNvpDeviceNetworksIter returns an iterator over all NiciraNvpDeviceNetworks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListNiciraNvpDeviceNetworksIter(p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) iter.Seq2[*NiciraNvpDeviceNetwork, error] {
+	return s.ListNiciraNvpDeviceNetworksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNiciraNvpDeviceNetworksIterWithContext is like ListNiciraNvpDeviceNetworksIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNiciraNvpDeviceNetworksIterWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) iter.Seq2[*NiciraNvpDeviceNetwork, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NiciraNvpDeviceNetwork, int, error) {
+		l, err := s.ListNiciraNvpDeviceNetworksWithContext(ctx, &ListNiciraNvpDeviceNetworksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NiciraNvpDeviceNetworks, l.Count, nil
+	}, opts...)
+}
+
 type ListNiciraNvpDeviceNetworksResponse struct {
 	Count                   int                       `json:"count"`
 	NiciraNvpDeviceNetworks []*NiciraNvpDeviceNetwork `json:"niciranvpdevicenetwork"`
@@ -5237,28 +7002,28 @@ type NiciraNvpDeviceNetwork struct {
 	Aclid                       string                          `json:"aclid"`
 	Aclname                     string                          `json:"aclname"`
 	Acltype                     string                          `json:"acltype"`
-	Asnumber                    int64                           `json:"asnumber"`
+	Asnumber                    FlexInt64                       `json:"asnumber"`
 	Asnumberid                  string                          `json:"asnumberid"`
 	Associatednetwork           string                          `json:"associatednetwork"`
 	Associatednetworkid         string                          `json:"associatednetworkid"`
 	Bgppeers                    []interface{}                   `json:"bgppeers"`
 	Broadcastdomaintype         string                          `json:"broadcastdomaintype"`
 	Broadcasturi                string                          `json:"broadcasturi"`
-	Canusefordeploy             bool                            `json:"canusefordeploy"`
+	Canusefordeploy             FlexBool                        `json:"canusefordeploy"`
 	Cidr                        string                          `json:"cidr"`
-	Created                     string                          `json:"created"`
+	Created                     Time                            `json:"created"`
 	Details                     map[string]string               `json:"details"`
-	Displaynetwork              bool                            `json:"displaynetwork"`
+	Displaynetwork              FlexBool                        `json:"displaynetwork"`
 	Displaytext                 string                          `json:"displaytext"`
 	Dns1                        string                          `json:"dns1"`
 	Dns2                        string                          `json:"dns2"`
 	Domain                      string                          `json:"domain"`
 	Domainid                    string                          `json:"domainid"`
 	Domainpath                  string                          `json:"domainpath"`
-	Egressdefaultpolicy         bool                            `json:"egressdefaultpolicy"`
+	Egressdefaultpolicy         FlexBool                        `json:"egressdefaultpolicy"`
 	Externalid                  string                          `json:"externalid"`
 	Gateway                     string                          `json:"gateway"`
-	Hasannotations              bool                            `json:"hasannotations"`
+	Hasannotations              FlexBool                        `json:"hasannotations"`
 	Icon                        interface{}                     `json:"icon"`
 	Id                          string                          `json:"id"`
 	Internetprotocol            string                          `json:"internetprotocol"`
@@ -5270,38 +7035,38 @@ type NiciraNvpDeviceNetwork struct {
 	Ip6gateway                  string                          `json:"ip6gateway"`
 	Ip6routes                   []interface{}                   `json:"ip6routes"`
 	Ip6routing                  string                          `json:"ip6routing"`
-	Isdefault                   bool                            `json:"isdefault"`
-	Ispersistent                bool                            `json:"ispersistent"`
-	Issystem                    bool                            `json:"issystem"`
+	Isdefault                   FlexBool                        `json:"isdefault"`
+	Ispersistent                FlexBool                        `json:"ispersistent"`
+	Issystem                    FlexBool                        `json:"issystem"`
 	JobID                       string                          `json:"jobid"`
-	Jobstatus                   int                             `json:"jobstatus"`
+	Jobstatus                   FlexInt                         `json:"jobstatus"`
 	Name                        string                          `json:"name"`
 	Netmask                     string                          `json:"netmask"`
 	Networkcidr                 string                          `json:"networkcidr"`
 	Networkdomain               string                          `json:"networkdomain"`
 	Networkofferingavailability string                          `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                            `json:"networkofferingconservemode"`
+	Networkofferingconservemode FlexBool                        `json:"networkofferingconservemode"`
 	Networkofferingdisplaytext  string                          `json:"networkofferingdisplaytext"`
 	Networkofferingid           string                          `json:"networkofferingid"`
 	Networkofferingname         string                          `json:"networkofferingname"`
 	Physicalnetworkid           string                          `json:"physicalnetworkid"`
-	Privatemtu                  int                             `json:"privatemtu"`
+	Privatemtu                  FlexInt                         `json:"privatemtu"`
 	Project                     string                          `json:"project"`
 	Projectid                   string                          `json:"projectid"`
-	Publicmtu                   int                             `json:"publicmtu"`
-	Receivedbytes               int64                           `json:"receivedbytes"`
-	Redundantrouter             bool                            `json:"redundantrouter"`
+	Publicmtu                   FlexInt                         `json:"publicmtu"`
+	Receivedbytes               FlexInt64                       `json:"receivedbytes"`
+	Redundantrouter             FlexBool                        `json:"redundantrouter"`
 	Related                     string                          `json:"related"`
 	Reservediprange             string                          `json:"reservediprange"`
-	Restartrequired             bool                            `json:"restartrequired"`
-	Sentbytes                   int64                           `json:"sentbytes"`
+	Restartrequired             FlexBool                        `json:"restartrequired"`
+	Sentbytes                   FlexInt64                       `json:"sentbytes"`
 	Service                     []NiciraNvpDeviceNetworkService `json:"service"`
-	Specifyipranges             bool                            `json:"specifyipranges"`
-	Specifyvlan                 bool                            `json:"specifyvlan"`
+	Specifyipranges             FlexBool                        `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                        `json:"specifyvlan"`
 	State                       string                          `json:"state"`
-	Strechedl2subnet            bool                            `json:"strechedl2subnet"`
-	Subdomainaccess             bool                            `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                            `json:"supportsvmautoscaling"`
+	Strechedl2subnet            FlexBool                        `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                        `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                        `json:"supportsvmautoscaling"`
 	Tags                        []Tags                          `json:"tags"`
 	Traffictype                 string                          `json:"traffictype"`
 	Tungstenvirtualrouteruuid   string                          `json:"tungstenvirtualrouteruuid"`
@@ -5312,28 +7077,52 @@ type NiciraNvpDeviceNetwork struct {
 	Zoneid                      string                          `json:"zoneid"`
 	Zonename                    string                          `json:"zonename"`
 	Zonesnetworkspans           []interface{}                   `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage      `json:"-"`
+}
+
+func (r *NiciraNvpDeviceNetwork) UnmarshalJSON(b []byte) error {
+	type alias NiciraNvpDeviceNetwork
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NiciraNvpDeviceNetworkService struct {
 	Capability []NiciraNvpDeviceNetworkServiceCapability `json:"capability"`
 	Name       string                                    `json:"name"`
 	Provider   []NiciraNvpDeviceNetworkServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                `json:"-"`
+}
+
+func (r *NiciraNvpDeviceNetworkService) UnmarshalJSON(b []byte) error {
+	type alias NiciraNvpDeviceNetworkService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NiciraNvpDeviceNetworkServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NiciraNvpDeviceNetworkServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias NiciraNvpDeviceNetworkServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type NiciraNvpDeviceNetworkServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NiciraNvpDeviceNetworkServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias NiciraNvpDeviceNetworkServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListOpenDaylightControllersParams struct {
@@ -5354,6 +7143,44 @@ func (p *ListOpenDaylightControllersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listOpenDaylightControllers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListOpenDaylightControllersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listOpenDaylightControllers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listOpenDaylightControllers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListOpenDaylightControllersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "physicalnetworkid": 255},
+	}.validate("listOpenDaylightControllers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listOpenDaylightControllers API, before the request is signed
+func (p *ListOpenDaylightControllersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListOpenDaylightControllersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListOpenDaylightControllersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listOpenDaylightControllers", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListOpenDaylightControllersParams) Clone() *ListOpenDaylightControllersParams {
+	return &ListOpenDaylightControllersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListOpenDaylightControllersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5406,6 +7233,11 @@ func (s *NetworkService) NewListOpenDaylightControllersParams() *ListOpenDayligh
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
+	return s.GetOpenDaylightControllerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOpenDaylightControllerByIDWithContext is like GetOpenDaylightControllerByID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetOpenDaylightControllerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
 	p := &ListOpenDaylightControllersParams{}
 	p.p = make(map[string]interface{})
 
@@ -5417,11 +7249,10 @@ func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...Option
 		}
 	}
 
-	l, err := s.ListOpenDaylightControllers(p)
+	l, err := s.ListOpenDaylightControllersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -5439,13 +7270,22 @@ func (s *NetworkService) GetOpenDaylightControllerByID(id string, opts ...Option
 
 // Lists OpenDyalight controllers
 func (s *NetworkService) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
-	resp, err := s.cs.newRequest("listOpenDaylightControllers", p.toURLValues())
+	return s.ListOpenDaylightControllersWithContext(context.Background(), p)
+}
+
+// ListOpenDaylightControllersWithContext is like ListOpenDaylightControllers, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListOpenDaylightControllersWithContext(ctx context.Context, p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listOpenDaylightControllers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListOpenDaylightControllersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listOpenDaylightControllers", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5458,13 +7298,19 @@ type ListOpenDaylightControllersResponse struct {
 }
 
 type OpenDaylightController struct {
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Name              string `json:"name"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Url               string `json:"url"`
-	Username          string `json:"username"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Name              string                     `json:"name"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Url               string                     `json:"url"`
+	Username          string                     `json:"username"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OpenDaylightController) UnmarshalJSON(b []byte) error {
+	type alias OpenDaylightController
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPaloAltoFirewallNetworksParams struct {
@@ -5493,6 +7339,47 @@ func (p *ListPaloAltoFirewallNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPaloAltoFirewallNetworks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPaloAltoFirewallNetworksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPaloAltoFirewallNetworks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPaloAltoFirewallNetworks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPaloAltoFirewallNetworksParams) Validate() error {
+	return paramRules{
+		required: []string{"lbdeviceid"},
+		lengths:  map[string]int{"keyword": 255, "lbdeviceid": 255},
+	}.validate("listPaloAltoFirewallNetworks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPaloAltoFirewallNetworks API, before the request is signed
+func (p *ListPaloAltoFirewallNetworksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPaloAltoFirewallNetworksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPaloAltoFirewallNetworksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPaloAltoFirewallNetworks", b, &p.p, map[string]paramDecoder{
+		"keyword":    decodeParam[string],
+		"lbdeviceid": decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPaloAltoFirewallNetworksParams) Clone() *ListPaloAltoFirewallNetworksParams {
+	return &ListPaloAltoFirewallNetworksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPaloAltoFirewallNetworksParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5588,6 +7475,11 @@ func (s *NetworkService) NewListPaloAltoFirewallNetworksParams(lbdeviceid string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetPaloAltoFirewallNetworkIDWithContext(context.Background(), keyword, lbdeviceid, opts...)
+}
+
+// GetPaloAltoFirewallNetworkIDWithContext is like GetPaloAltoFirewallNetworkID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetPaloAltoFirewallNetworkIDWithContext(ctx context.Context, keyword string, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPaloAltoFirewallNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -5600,7 +7492,7 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 		}
 	}
 
-	l, err := s.ListPaloAltoFirewallNetworks(p)
+	l, err := s.ListPaloAltoFirewallNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -5620,24 +7512,61 @@ func (s *NetworkService) GetPaloAltoFirewallNetworkID(keyword string, lbdeviceid
 			}
 		}
 	}
-	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
-}
+	return "", l.Count, fmt.Errorf("Could not find an exact match for %s: %+v", keyword, l)
+}
+
+// Lists Network that are using Palo Alto firewall device
+func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	return s.ListPaloAltoFirewallNetworksWithContext(context.Background(), p)
+}
+
+// ListPaloAltoFirewallNetworksWithContext is like ListPaloAltoFirewallNetworks, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
 
-// Lists Network that are using Palo Alto firewall device
-func (s *NetworkService) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPaloAltoFirewallNetworks", p.toURLValues())
+	resp, err := s.cs.newRequest(ctx, "listPaloAltoFirewallNetworks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPaloAltoFirewallNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPaloAltoFirewallNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPaloAltoFirewallNetworksAll returns all PaloAltoFirewallNetworks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListPaloAltoFirewallNetworksAll(p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) ([]*PaloAltoFirewallNetwork, error) {
+	return s.ListPaloAltoFirewallNetworksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPaloAltoFirewallNetworksAllWithContext is like ListPaloAltoFirewallNetworksAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListPaloAltoFirewallNetworksAllWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) ([]*PaloAltoFirewallNetwork, error) {
+	return collectPages(s.ListPaloAltoFirewallNetworksIterWithContext(ctx, p, opts...))
+}
+
+// ListPaloAltoFirewallNetworksIter returns an iterator over all PaloAltoFirewallNetworks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListPaloAltoFirewallNetworksIter(p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewallNetwork, error] {
+	return s.ListPaloAltoFirewallNetworksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPaloAltoFirewallNetworksIterWithContext is like ListPaloAltoFirewallNetworksIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListPaloAltoFirewallNetworksIterWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewallNetwork, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*PaloAltoFirewallNetwork, int, error) {
+		l, err := s.ListPaloAltoFirewallNetworksWithContext(ctx, &ListPaloAltoFirewallNetworksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.PaloAltoFirewallNetworks, l.Count, nil
+	}, opts...)
+}
+
 type ListPaloAltoFirewallNetworksResponse struct {
 	Count                    int                        `json:"count"`
 	PaloAltoFirewallNetworks []*PaloAltoFirewallNetwork `json:"paloaltofirewallnetwork"`
@@ -5648,28 +7577,28 @@ type PaloAltoFirewallNetwork struct {
 	Aclid                       string                           `json:"aclid"`
 	Aclname                     string                           `json:"aclname"`
 	Acltype                     string                           `json:"acltype"`
-	Asnumber                    int64                            `json:"asnumber"`
+	Asnumber                    FlexInt64                        `json:"asnumber"`
 	Asnumberid                  string                           `json:"asnumberid"`
 	Associatednetwork           string                           `json:"associatednetwork"`
 	Associatednetworkid         string                           `json:"associatednetworkid"`
 	Bgppeers                    []interface{}                    `json:"bgppeers"`
 	Broadcastdomaintype         string                           `json:"broadcastdomaintype"`
 	Broadcasturi                string                           `json:"broadcasturi"`
-	Canusefordeploy             bool                             `json:"canusefordeploy"`
+	Canusefordeploy             FlexBool                         `json:"canusefordeploy"`
 	Cidr                        string                           `json:"cidr"`
-	Created                     string                           `json:"created"`
+	Created                     Time                             `json:"created"`
 	Details                     map[string]string                `json:"details"`
-	Displaynetwork              bool                             `json:"displaynetwork"`
+	Displaynetwork              FlexBool                         `json:"displaynetwork"`
 	Displaytext                 string                           `json:"displaytext"`
 	Dns1                        string                           `json:"dns1"`
 	Dns2                        string                           `json:"dns2"`
 	Domain                      string                           `json:"domain"`
 	Domainid                    string                           `json:"domainid"`
 	Domainpath                  string                           `json:"domainpath"`
-	Egressdefaultpolicy         bool                             `json:"egressdefaultpolicy"`
+	Egressdefaultpolicy         FlexBool                         `json:"egressdefaultpolicy"`
 	Externalid                  string                           `json:"externalid"`
 	Gateway                     string                           `json:"gateway"`
-	Hasannotations              bool                             `json:"hasannotations"`
+	Hasannotations              FlexBool                         `json:"hasannotations"`
 	Icon                        interface{}                      `json:"icon"`
 	Id                          string                           `json:"id"`
 	Internetprotocol            string                           `json:"internetprotocol"`
@@ -5681,38 +7610,38 @@ type PaloAltoFirewallNetwork struct {
 	Ip6gateway                  string                           `json:"ip6gateway"`
 	Ip6routes                   []interface{}                    `json:"ip6routes"`
 	Ip6routing                  string                           `json:"ip6routing"`
-	Isdefault                   bool                             `json:"isdefault"`
-	Ispersistent                bool                             `json:"ispersistent"`
-	Issystem                    bool                             `json:"issystem"`
+	Isdefault                   FlexBool                         `json:"isdefault"`
+	Ispersistent                FlexBool                         `json:"ispersistent"`
+	Issystem                    FlexBool                         `json:"issystem"`
 	JobID                       string                           `json:"jobid"`
-	Jobstatus                   int                              `json:"jobstatus"`
+	Jobstatus                   FlexInt                          `json:"jobstatus"`
 	Name                        string                           `json:"name"`
 	Netmask                     string                           `json:"netmask"`
 	Networkcidr                 string                           `json:"networkcidr"`
 	Networkdomain               string                           `json:"networkdomain"`
 	Networkofferingavailability string                           `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                             `json:"networkofferingconservemode"`
+	Networkofferingconservemode FlexBool                         `json:"networkofferingconservemode"`
 	Networkofferingdisplaytext  string                           `json:"networkofferingdisplaytext"`
 	Networkofferingid           string                           `json:"networkofferingid"`
 	Networkofferingname         string                           `json:"networkofferingname"`
 	Physicalnetworkid           string                           `json:"physicalnetworkid"`
-	Privatemtu                  int                              `json:"privatemtu"`
+	Privatemtu                  FlexInt                          `json:"privatemtu"`
 	Project                     string                           `json:"project"`
 	Projectid                   string                           `json:"projectid"`
-	Publicmtu                   int                              `json:"publicmtu"`
-	Receivedbytes               int64                            `json:"receivedbytes"`
-	Redundantrouter             bool                             `json:"redundantrouter"`
+	Publicmtu                   FlexInt                          `json:"publicmtu"`
+	Receivedbytes               FlexInt64                        `json:"receivedbytes"`
+	Redundantrouter             FlexBool                         `json:"redundantrouter"`
 	Related                     string                           `json:"related"`
 	Reservediprange             string                           `json:"reservediprange"`
-	Restartrequired             bool                             `json:"restartrequired"`
-	Sentbytes                   int64                            `json:"sentbytes"`
+	Restartrequired             FlexBool                         `json:"restartrequired"`
+	Sentbytes                   FlexInt64                        `json:"sentbytes"`
 	Service                     []PaloAltoFirewallNetworkService `json:"service"`
-	Specifyipranges             bool                             `json:"specifyipranges"`
-	Specifyvlan                 bool                             `json:"specifyvlan"`
+	Specifyipranges             FlexBool                         `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                         `json:"specifyvlan"`
 	State                       string                           `json:"state"`
-	Strechedl2subnet            bool                             `json:"strechedl2subnet"`
-	Subdomainaccess             bool                             `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                             `json:"supportsvmautoscaling"`
+	Strechedl2subnet            FlexBool                         `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                         `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                         `json:"supportsvmautoscaling"`
 	Tags                        []Tags                           `json:"tags"`
 	Traffictype                 string                           `json:"traffictype"`
 	Tungstenvirtualrouteruuid   string                           `json:"tungstenvirtualrouteruuid"`
@@ -5723,28 +7652,52 @@ type PaloAltoFirewallNetwork struct {
 	Zoneid                      string                           `json:"zoneid"`
 	Zonename                    string                           `json:"zonename"`
 	Zonesnetworkspans           []interface{}                    `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage       `json:"-"`
+}
+
+func (r *PaloAltoFirewallNetwork) UnmarshalJSON(b []byte) error {
+	type alias PaloAltoFirewallNetwork
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PaloAltoFirewallNetworkService struct {
 	Capability []PaloAltoFirewallNetworkServiceCapability `json:"capability"`
 	Name       string                                     `json:"name"`
 	Provider   []PaloAltoFirewallNetworkServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                 `json:"-"`
+}
+
+func (r *PaloAltoFirewallNetworkService) UnmarshalJSON(b []byte) error {
+	type alias PaloAltoFirewallNetworkService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PaloAltoFirewallNetworkServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PaloAltoFirewallNetworkServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias PaloAltoFirewallNetworkServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PaloAltoFirewallNetworkServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PaloAltoFirewallNetworkServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias PaloAltoFirewallNetworkServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPhysicalNetworksParams struct {
@@ -5779,6 +7732,48 @@ func (p *ListPhysicalNetworksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPhysicalNetworks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPhysicalNetworksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPhysicalNetworks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPhysicalNetworks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPhysicalNetworksParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "zoneid": 255},
+	}.validate("listPhysicalNetworks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPhysicalNetworks API, before the request is signed
+func (p *ListPhysicalNetworksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPhysicalNetworksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPhysicalNetworksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPhysicalNetworks", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPhysicalNetworksParams) Clone() *ListPhysicalNetworksParams {
+	return &ListPhysicalNetworksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPhysicalNetworksParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5915,6 +7910,11 @@ func (s *NetworkService) NewListPhysicalNetworksParams() *ListPhysicalNetworksPa
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetPhysicalNetworkIDWithContext(context.Background(), name, opts...)
+}
+
+// GetPhysicalNetworkIDWithContext is like GetPhysicalNetworkID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetPhysicalNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPhysicalNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -5926,7 +7926,7 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListPhysicalNetworks(p)
+	l, err := s.ListPhysicalNetworksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -5951,12 +7951,17 @@ func (s *NetworkService) GetPhysicalNetworkID(name string, opts ...OptionFunc) (
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
-	id, count, err := s.GetPhysicalNetworkID(name, opts...)
+	return s.GetPhysicalNetworkByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetPhysicalNetworkByNameWithContext is like GetPhysicalNetworkByName, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetPhysicalNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	id, count, err := s.GetPhysicalNetworkIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetPhysicalNetworkByID(id, opts...)
+	r, count, err := s.GetPhysicalNetworkByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -5965,6 +7970,11 @@ func (s *NetworkService) GetPhysicalNetworkByName(name string, opts ...OptionFun
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	return s.GetPhysicalNetworkByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPhysicalNetworkByIDWithContext is like GetPhysicalNetworkByID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetPhysicalNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
 	p := &ListPhysicalNetworksParams{}
 	p.p = make(map[string]interface{})
 
@@ -5976,11 +7986,10 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListPhysicalNetworks(p)
+	l, err := s.ListPhysicalNetworksWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -5998,38 +8007,81 @@ func (s *NetworkService) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (
 
 // Lists physical networks
 func (s *NetworkService) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
-	resp, err := s.cs.newRequest("listPhysicalNetworks", p.toURLValues())
+	return s.ListPhysicalNetworksWithContext(context.Background(), p)
+}
+
+// ListPhysicalNetworksWithContext is like ListPhysicalNetworks, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listPhysicalNetworks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPhysicalNetworksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPhysicalNetworks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPhysicalNetworksAll returns all PhysicalNetworks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListPhysicalNetworksAll(p *ListPhysicalNetworksParams, opts ...PageOption) ([]*PhysicalNetwork, error) {
+	return s.ListPhysicalNetworksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPhysicalNetworksAllWithContext is like ListPhysicalNetworksAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListPhysicalNetworksAllWithContext(ctx context.Context, p *ListPhysicalNetworksParams, opts ...PageOption) ([]*PhysicalNetwork, error) {
+	return collectPages(s.ListPhysicalNetworksIterWithContext(ctx, p, opts...))
+}
+
+// ListPhysicalNetworksIter returns an iterator over all PhysicalNetworks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListPhysicalNetworksIter(p *ListPhysicalNetworksParams, opts ...PageOption) iter.Seq2[*PhysicalNetwork, error] {
+	return s.ListPhysicalNetworksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPhysicalNetworksIterWithContext is like ListPhysicalNetworksIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListPhysicalNetworksIterWithContext(ctx context.Context, p *ListPhysicalNetworksParams, opts ...PageOption) iter.Seq2[*PhysicalNetwork, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*PhysicalNetwork, int, error) {
+		l, err := s.ListPhysicalNetworksWithContext(ctx, &ListPhysicalNetworksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.PhysicalNetworks, l.Count, nil
+	}, opts...)
+}
+
 type ListPhysicalNetworksResponse struct {
 	Count            int                `json:"count"`
 	PhysicalNetworks []*PhysicalNetwork `json:"physicalnetwork"`
 }
 
 type PhysicalNetwork struct {
-	Broadcastdomainrange string `json:"broadcastdomainrange"`
-	Domainid             string `json:"domainid"`
-	Id                   string `json:"id"`
-	Isolationmethods     string `json:"isolationmethods"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Name                 string `json:"name"`
-	Networkspeed         string `json:"networkspeed"`
-	State                string `json:"state"`
-	Tags                 string `json:"tags"`
-	Vlan                 string `json:"vlan"`
-	Zoneid               string `json:"zoneid"`
-	Zonename             string `json:"zonename"`
+	Broadcastdomainrange string                     `json:"broadcastdomainrange"`
+	Domainid             string                     `json:"domainid"`
+	Id                   string                     `json:"id"`
+	Isolationmethods     string                     `json:"isolationmethods"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Name                 string                     `json:"name"`
+	Networkspeed         string                     `json:"networkspeed"`
+	State                string                     `json:"state"`
+	Tags                 string                     `json:"tags"`
+	Vlan                 string                     `json:"vlan"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PhysicalNetwork) UnmarshalJSON(b []byte) error {
+	type alias PhysicalNetwork
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStorageNetworkIpRangeParams struct {
@@ -6064,6 +8116,48 @@ func (p *ListStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStorageNetworkIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStorageNetworkIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStorageNetworkIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStorageNetworkIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStorageNetworkIpRangeParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "podid": 255, "zoneid": 255},
+	}.validate("listStorageNetworkIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStorageNetworkIpRange API, before the request is signed
+func (p *ListStorageNetworkIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStorageNetworkIpRange", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"podid":    decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStorageNetworkIpRangeParams) Clone() *ListStorageNetworkIpRangeParams {
+	return &ListStorageNetworkIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStorageNetworkIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6200,6 +8294,11 @@ func (s *NetworkService) NewListStorageNetworkIpRangeParams() *ListStorageNetwor
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
+	return s.GetStorageNetworkIpRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStorageNetworkIpRangeByIDWithContext is like GetStorageNetworkIpRangeByID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetStorageNetworkIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
 	p := &ListStorageNetworkIpRangeParams{}
 	p.p = make(map[string]interface{})
 
@@ -6211,11 +8310,10 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListStorageNetworkIpRange(p)
+	l, err := s.ListStorageNetworkIpRangeWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -6233,36 +8331,79 @@ func (s *NetworkService) GetStorageNetworkIpRangeByID(id string, opts ...OptionF
 
 // List a storage network IP range.
 func (s *NetworkService) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newRequest("listStorageNetworkIpRange", p.toURLValues())
+	return s.ListStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// ListStorageNetworkIpRangeWithContext is like ListStorageNetworkIpRange, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStorageNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStorageNetworkIpRangeAll returns all StorageNetworkIpRange matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListStorageNetworkIpRangeAll(p *ListStorageNetworkIpRangeParams, opts ...PageOption) ([]*StorageNetworkIpRange, error) {
+	return s.ListStorageNetworkIpRangeAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageNetworkIpRangeAllWithContext is like ListStorageNetworkIpRangeAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListStorageNetworkIpRangeAllWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams, opts ...PageOption) ([]*StorageNetworkIpRange, error) {
+	return collectPages(s.ListStorageNetworkIpRangeIterWithContext(ctx, p, opts...))
+}
+
+// ListStorageNetworkIpRangeIter returns an iterator over all StorageNetworkIpRange matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListStorageNetworkIpRangeIter(p *ListStorageNetworkIpRangeParams, opts ...PageOption) iter.Seq2[*StorageNetworkIpRange, error] {
+	return s.ListStorageNetworkIpRangeIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageNetworkIpRangeIterWithContext is like ListStorageNetworkIpRangeIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListStorageNetworkIpRangeIterWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams, opts ...PageOption) iter.Seq2[*StorageNetworkIpRange, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StorageNetworkIpRange, int, error) {
+		l, err := s.ListStorageNetworkIpRangeWithContext(ctx, &ListStorageNetworkIpRangeParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StorageNetworkIpRange, l.Count, nil
+	}, opts...)
+}
+
 type ListStorageNetworkIpRangeResponse struct {
 	Count                 int                      `json:"count"`
 	StorageNetworkIpRange []*StorageNetworkIpRange `json:"storagenetworkiprange"`
 }
 
 type StorageNetworkIpRange struct {
-	Endip     string `json:"endip"`
-	Gateway   string `json:"gateway"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Netmask   string `json:"netmask"`
-	Networkid string `json:"networkid"`
-	Podid     string `json:"podid"`
-	Startip   string `json:"startip"`
-	Vlan      int    `json:"vlan"`
-	Zoneid    string `json:"zoneid"`
+	Endip     string                     `json:"endip"`
+	Gateway   string                     `json:"gateway"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Netmask   string                     `json:"netmask"`
+	Networkid string                     `json:"networkid"`
+	Podid     string                     `json:"podid"`
+	Startip   string                     `json:"startip"`
+	Vlan      FlexInt                    `json:"vlan"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StorageNetworkIpRange) UnmarshalJSON(b []byte) error {
+	type alias StorageNetworkIpRange
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSupportedNetworkServicesParams struct {
@@ -6294,6 +8435,47 @@ func (p *ListSupportedNetworkServicesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSupportedNetworkServices API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSupportedNetworkServicesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSupportedNetworkServices", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSupportedNetworkServices API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSupportedNetworkServicesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "provider": 255, "service": 255},
+	}.validate("listSupportedNetworkServices", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSupportedNetworkServices API, before the request is signed
+func (p *ListSupportedNetworkServicesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSupportedNetworkServicesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSupportedNetworkServicesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSupportedNetworkServices", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"provider": decodeParam[string],
+		"service":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSupportedNetworkServicesParams) Clone() *ListSupportedNetworkServicesParams {
+	return &ListSupportedNetworkServicesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSupportedNetworkServicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6409,19 +8591,56 @@ func (s *NetworkService) NewListSupportedNetworkServicesParams() *ListSupportedN
 
 // Lists all network services provided by CloudStack or for the given Provider.
 func (s *NetworkService) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
-	resp, err := s.cs.newRequest("listSupportedNetworkServices", p.toURLValues())
+	return s.ListSupportedNetworkServicesWithContext(context.Background(), p)
+}
+
+// ListSupportedNetworkServicesWithContext is like ListSupportedNetworkServices, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSupportedNetworkServices", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSupportedNetworkServicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSupportedNetworkServices", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSupportedNetworkServicesAll returns all SupportedNetworkServices matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListSupportedNetworkServicesAll(p *ListSupportedNetworkServicesParams, opts ...PageOption) ([]*SupportedNetworkService, error) {
+	return s.ListSupportedNetworkServicesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSupportedNetworkServicesAllWithContext is like ListSupportedNetworkServicesAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListSupportedNetworkServicesAllWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams, opts ...PageOption) ([]*SupportedNetworkService, error) {
+	return collectPages(s.ListSupportedNetworkServicesIterWithContext(ctx, p, opts...))
+}
+
+// ListSupportedNetworkServicesIter returns an iterator over all SupportedNetworkServices matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListSupportedNetworkServicesIter(p *ListSupportedNetworkServicesParams, opts ...PageOption) iter.Seq2[*SupportedNetworkService, error] {
+	return s.ListSupportedNetworkServicesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSupportedNetworkServicesIterWithContext is like ListSupportedNetworkServicesIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListSupportedNetworkServicesIterWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams, opts ...PageOption) iter.Seq2[*SupportedNetworkService, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SupportedNetworkService, int, error) {
+		l, err := s.ListSupportedNetworkServicesWithContext(ctx, &ListSupportedNetworkServicesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SupportedNetworkServices, l.Count, nil
+	}, opts...)
+}
+
 type ListSupportedNetworkServicesResponse struct {
 	Count                    int                        `json:"count"`
 	SupportedNetworkServices []*SupportedNetworkService `json:"networkservice"`
@@ -6430,25 +8649,43 @@ type ListSupportedNetworkServicesResponse struct {
 type SupportedNetworkService struct {
 	Capability []SupportedNetworkServiceCapability `json:"capability"`
 	JobID      string                              `json:"jobid"`
-	Jobstatus  int                                 `json:"jobstatus"`
+	Jobstatus  FlexInt                             `json:"jobstatus"`
 	Name       string                              `json:"name"`
 	Provider   []SupportedNetworkServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage          `json:"-"`
+}
+
+func (r *SupportedNetworkService) UnmarshalJSON(b []byte) error {
+	type alias SupportedNetworkService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SupportedNetworkServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SupportedNetworkServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias SupportedNetworkServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SupportedNetworkServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SupportedNetworkServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias SupportedNetworkServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateNetworkParams struct {
@@ -6473,6 +8710,46 @@ func (p *MigrateNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid", "networkofferingid"},
+		lengths:  map[string]int{"networkid": 255, "networkofferingid": 255},
+	}.validate("migrateNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateNetwork API, before the request is signed
+func (p *MigrateNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateNetwork", b, &p.p, map[string]paramDecoder{
+		"networkid":         decodeParam[string],
+		"networkofferingid": decodeParam[string],
+		"resume":            decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateNetworkParams) Clone() *MigrateNetworkParams {
+	return &MigrateNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateNetworkParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6548,32 +8825,39 @@ func (s *NetworkService) NewMigrateNetworkParams(networkid string, networkofferi
 
 // Moves a network to another physical network
 func (s *NetworkService) MigrateNetwork(p *MigrateNetworkParams) (*MigrateNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateNetwork", p.toURLValues())
+	return s.MigrateNetworkWithContext(context.Background(), p)
+}
+
+// MigrateNetworkWithContext is like MigrateNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) MigrateNetworkWithContext(ctx context.Context, p *MigrateNetworkParams) (*MigrateNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6581,33 +8865,52 @@ func (s *NetworkService) MigrateNetwork(p *MigrateNetworkParams) (*MigrateNetwor
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateNetwork async job into r
+func (r *MigrateNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateNetworkAsync starts the async job of MigrateNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) MigrateNetworkAsync(ctx context.Context, p *MigrateNetworkParams) (*Job, error) {
+	r, err := s.MigrateNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateNetwork", r.JobID), nil
+}
+
 type MigrateNetworkResponse struct {
 	Account                     string                          `json:"account"`
 	Aclid                       string                          `json:"aclid"`
 	Aclname                     string                          `json:"aclname"`
 	Acltype                     string                          `json:"acltype"`
-	Asnumber                    int64                           `json:"asnumber"`
+	Asnumber                    FlexInt64                       `json:"asnumber"`
 	Asnumberid                  string                          `json:"asnumberid"`
 	Associatednetwork           string                          `json:"associatednetwork"`
 	Associatednetworkid         string                          `json:"associatednetworkid"`
 	Bgppeers                    []interface{}                   `json:"bgppeers"`
 	Broadcastdomaintype         string                          `json:"broadcastdomaintype"`
 	Broadcasturi                string                          `json:"broadcasturi"`
-	Canusefordeploy             bool                            `json:"canusefordeploy"`
+	Canusefordeploy             FlexBool                        `json:"canusefordeploy"`
 	Cidr                        string                          `json:"cidr"`
-	Created                     string                          `json:"created"`
+	Created                     Time                            `json:"created"`
 	Details                     map[string]string               `json:"details"`
-	Displaynetwork              bool                            `json:"displaynetwork"`
+	Displaynetwork              FlexBool                        `json:"displaynetwork"`
 	Displaytext                 string                          `json:"displaytext"`
 	Dns1                        string                          `json:"dns1"`
 	Dns2                        string                          `json:"dns2"`
 	Domain                      string                          `json:"domain"`
 	Domainid                    string                          `json:"domainid"`
 	Domainpath                  string                          `json:"domainpath"`
-	Egressdefaultpolicy         bool                            `json:"egressdefaultpolicy"`
+	Egressdefaultpolicy         FlexBool                        `json:"egressdefaultpolicy"`
 	Externalid                  string                          `json:"externalid"`
 	Gateway                     string                          `json:"gateway"`
-	Hasannotations              bool                            `json:"hasannotations"`
+	Hasannotations              FlexBool                        `json:"hasannotations"`
 	Icon                        interface{}                     `json:"icon"`
 	Id                          string                          `json:"id"`
 	Internetprotocol            string                          `json:"internetprotocol"`
@@ -6619,38 +8922,38 @@ type MigrateNetworkResponse struct {
 	Ip6gateway                  string                          `json:"ip6gateway"`
 	Ip6routes                   []interface{}                   `json:"ip6routes"`
 	Ip6routing                  string                          `json:"ip6routing"`
-	Isdefault                   bool                            `json:"isdefault"`
-	Ispersistent                bool                            `json:"ispersistent"`
-	Issystem                    bool                            `json:"issystem"`
+	Isdefault                   FlexBool                        `json:"isdefault"`
+	Ispersistent                FlexBool                        `json:"ispersistent"`
+	Issystem                    FlexBool                        `json:"issystem"`
 	JobID                       string                          `json:"jobid"`
-	Jobstatus                   int                             `json:"jobstatus"`
+	Jobstatus                   FlexInt                         `json:"jobstatus"`
 	Name                        string                          `json:"name"`
 	Netmask                     string                          `json:"netmask"`
 	Networkcidr                 string                          `json:"networkcidr"`
 	Networkdomain               string                          `json:"networkdomain"`
 	Networkofferingavailability string                          `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                            `json:"networkofferingconservemode"`
+	Networkofferingconservemode FlexBool                        `json:"networkofferingconservemode"`
 	Networkofferingdisplaytext  string                          `json:"networkofferingdisplaytext"`
 	Networkofferingid           string                          `json:"networkofferingid"`
 	Networkofferingname         string                          `json:"networkofferingname"`
 	Physicalnetworkid           string                          `json:"physicalnetworkid"`
-	Privatemtu                  int                             `json:"privatemtu"`
+	Privatemtu                  FlexInt                         `json:"privatemtu"`
 	Project                     string                          `json:"project"`
 	Projectid                   string                          `json:"projectid"`
-	Publicmtu                   int                             `json:"publicmtu"`
-	Receivedbytes               int64                           `json:"receivedbytes"`
-	Redundantrouter             bool                            `json:"redundantrouter"`
+	Publicmtu                   FlexInt                         `json:"publicmtu"`
+	Receivedbytes               FlexInt64                       `json:"receivedbytes"`
+	Redundantrouter             FlexBool                        `json:"redundantrouter"`
 	Related                     string                          `json:"related"`
 	Reservediprange             string                          `json:"reservediprange"`
-	Restartrequired             bool                            `json:"restartrequired"`
-	Sentbytes                   int64                           `json:"sentbytes"`
+	Restartrequired             FlexBool                        `json:"restartrequired"`
+	Sentbytes                   FlexInt64                       `json:"sentbytes"`
 	Service                     []MigrateNetworkResponseService `json:"service"`
-	Specifyipranges             bool                            `json:"specifyipranges"`
-	Specifyvlan                 bool                            `json:"specifyvlan"`
-	State                       string                          `json:"state"`
-	Strechedl2subnet            bool                            `json:"strechedl2subnet"`
-	Subdomainaccess             bool                            `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                            `json:"supportsvmautoscaling"`
+	Specifyipranges             FlexBool                        `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                        `json:"specifyvlan"`
+	State                       NetworkState                    `json:"state"`
+	Strechedl2subnet            FlexBool                        `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                        `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                        `json:"supportsvmautoscaling"`
 	Tags                        []Tags                          `json:"tags"`
 	Traffictype                 string                          `json:"traffictype"`
 	Tungstenvirtualrouteruuid   string                          `json:"tungstenvirtualrouteruuid"`
@@ -6661,28 +8964,52 @@ type MigrateNetworkResponse struct {
 	Zoneid                      string                          `json:"zoneid"`
 	Zonename                    string                          `json:"zonename"`
 	Zonesnetworkspans           []interface{}                   `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage      `json:"-"`
+}
+
+func (r *MigrateNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias MigrateNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateNetworkResponseService struct {
 	Capability []MigrateNetworkResponseServiceCapability `json:"capability"`
 	Name       string                                    `json:"name"`
 	Provider   []MigrateNetworkResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                `json:"-"`
+}
+
+func (r *MigrateNetworkResponseService) UnmarshalJSON(b []byte) error {
+	type alias MigrateNetworkResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateNetworkResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateNetworkResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias MigrateNetworkResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateNetworkResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateNetworkResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias MigrateNetworkResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleasePublicIpRangeParams struct {
@@ -6700,6 +9027,44 @@ func (p *ReleasePublicIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releasePublicIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleasePublicIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releasePublicIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releasePublicIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleasePublicIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("releasePublicIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releasePublicIpRange API, before the request is signed
+func (p *ReleasePublicIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleasePublicIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleasePublicIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releasePublicIpRange", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleasePublicIpRangeParams) Clone() *ReleasePublicIpRangeParams {
+	return &ReleasePublicIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleasePublicIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6732,13 +9097,22 @@ func (s *NetworkService) NewReleasePublicIpRangeParams(id string) *ReleasePublic
 
 // Releases a Public IP range back to the system pool
 func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("releasePublicIpRange", p.toURLValues())
+	return s.ReleasePublicIpRangeWithContext(context.Background(), p)
+}
+
+// ReleasePublicIpRangeWithContext is like ReleasePublicIpRange, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "releasePublicIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleasePublicIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releasePublicIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -6746,10 +9120,11 @@ func (s *NetworkService) ReleasePublicIpRange(p *ReleasePublicIpRangeParams) (*R
 }
 
 type ReleasePublicIpRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ReleasePublicIpRangeResponse) UnmarshalJSON(b []byte) error {
@@ -6776,7 +9151,7 @@ func (r *ReleasePublicIpRangeResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ReleasePublicIpRangeResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestartNetworkParams struct {
@@ -6806,6 +9181,47 @@ func (p *RestartNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the restartNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RestartNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("restartNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the restartNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RestartNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("restartNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the restartNetwork API, before the request is signed
+func (p *RestartNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RestartNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RestartNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("restartNetwork", b, &p.p, map[string]paramDecoder{
+		"cleanup":       decodeParam[bool],
+		"id":            decodeParam[string],
+		"livepatch":     decodeParam[bool],
+		"makeredundant": decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RestartNetworkParams) Clone() *RestartNetworkParams {
+	return &RestartNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *RestartNetworkParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6901,27 +9317,39 @@ func (s *NetworkService) NewRestartNetworkParams(id string) *RestartNetworkParam
 
 // Restarts the Network; includes 1) restarting network elements - virtual routers, DHCP servers 2) reapplying all public IPs 3) reapplying loadBalancing/portForwarding rules
 func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("restartNetwork", p.toURLValues())
+	return s.RestartNetworkWithContext(context.Background(), p)
+}
+
+// RestartNetworkWithContext is like RestartNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "restartNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RestartNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("restartNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "restartNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("restartNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6929,11 +9357,31 @@ func (s *NetworkService) RestartNetwork(p *RestartNetworkParams) (*RestartNetwor
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RestartNetwork async job into r
+func (r *RestartNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RestartNetworkAsync starts the async job of RestartNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) RestartNetworkAsync(ctx context.Context, p *RestartNetworkParams) (*Job, error) {
+	r, err := s.RestartNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("restartNetwork", r.JobID), nil
+}
+
 type RestartNetworkResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RestartNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias RestartNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkParams struct {
@@ -7012,6 +9460,62 @@ func (p *UpdateNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "displaytext": 4096, "dns1": 255, "dns2": 255, "guestvmcidr": 255, "id": 255, "ip6dns1": 255, "ip6dns2": 255, "name": 255, "networkdomain": 255, "networkofferingid": 255, "sourcenatipaddress": 255},
+	}.validate("updateNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateNetwork API, before the request is signed
+func (p *UpdateNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateNetwork", b, &p.p, map[string]paramDecoder{
+		"changecidr":         decodeParam[bool],
+		"customid":           decodeParam[string],
+		"displaynetwork":     decodeParam[bool],
+		"displaytext":        decodeParam[string],
+		"dns1":               decodeParam[string],
+		"dns2":               decodeParam[string],
+		"forced":             decodeParam[bool],
+		"guestvmcidr":        decodeParam[string],
+		"hideipaddressusage": decodeParam[bool],
+		"id":                 decodeParam[string],
+		"ip6dns1":            decodeParam[string],
+		"ip6dns2":            decodeParam[string],
+		"name":               decodeParam[string],
+		"networkdomain":      decodeParam[string],
+		"networkofferingid":  decodeParam[string],
+		"privatemtu":         decodeParam[int],
+		"publicmtu":          decodeParam[int],
+		"sourcenatipaddress": decodeParam[string],
+		"updateinsequence":   decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateNetworkParams) Clone() *UpdateNetworkParams {
+	return &UpdateNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateNetworkParams) SetChangecidr(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7422,32 +9926,39 @@ func (s *NetworkService) NewUpdateNetworkParams(id string) *UpdateNetworkParams
 
 // Updates a network
 func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("updateNetwork", p.toURLValues())
+	return s.UpdateNetworkWithContext(context.Background(), p)
+}
+
+// UpdateNetworkWithContext is like UpdateNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -7455,33 +9966,52 @@ func (s *NetworkService) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateNetwork async job into r
+func (r *UpdateNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateNetworkAsync starts the async job of UpdateNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) UpdateNetworkAsync(ctx context.Context, p *UpdateNetworkParams) (*Job, error) {
+	r, err := s.UpdateNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateNetwork", r.JobID), nil
+}
+
 type UpdateNetworkResponse struct {
 	Account                     string                         `json:"account"`
 	Aclid                       string                         `json:"aclid"`
 	Aclname                     string                         `json:"aclname"`
 	Acltype                     string                         `json:"acltype"`
-	Asnumber                    int64                          `json:"asnumber"`
+	Asnumber                    FlexInt64                      `json:"asnumber"`
 	Asnumberid                  string                         `json:"asnumberid"`
 	Associatednetwork           string                         `json:"associatednetwork"`
 	Associatednetworkid         string                         `json:"associatednetworkid"`
 	Bgppeers                    []interface{}                  `json:"bgppeers"`
 	Broadcastdomaintype         string                         `json:"broadcastdomaintype"`
 	Broadcasturi                string                         `json:"broadcasturi"`
-	Canusefordeploy             bool                           `json:"canusefordeploy"`
+	Canusefordeploy             FlexBool                       `json:"canusefordeploy"`
 	Cidr                        string                         `json:"cidr"`
-	Created                     string                         `json:"created"`
+	Created                     Time                           `json:"created"`
 	Details                     map[string]string              `json:"details"`
-	Displaynetwork              bool                           `json:"displaynetwork"`
+	Displaynetwork              FlexBool                       `json:"displaynetwork"`
 	Displaytext                 string                         `json:"displaytext"`
 	Dns1                        string                         `json:"dns1"`
 	Dns2                        string                         `json:"dns2"`
 	Domain                      string                         `json:"domain"`
 	Domainid                    string                         `json:"domainid"`
 	Domainpath                  string                         `json:"domainpath"`
-	Egressdefaultpolicy         bool                           `json:"egressdefaultpolicy"`
+	Egressdefaultpolicy         FlexBool                       `json:"egressdefaultpolicy"`
 	Externalid                  string                         `json:"externalid"`
 	Gateway                     string                         `json:"gateway"`
-	Hasannotations              bool                           `json:"hasannotations"`
+	Hasannotations              FlexBool                       `json:"hasannotations"`
 	Icon                        interface{}                    `json:"icon"`
 	Id                          string                         `json:"id"`
 	Internetprotocol            string                         `json:"internetprotocol"`
@@ -7493,38 +10023,38 @@ type UpdateNetworkResponse struct {
 	Ip6gateway                  string                         `json:"ip6gateway"`
 	Ip6routes                   []interface{}                  `json:"ip6routes"`
 	Ip6routing                  string                         `json:"ip6routing"`
-	Isdefault                   bool                           `json:"isdefault"`
-	Ispersistent                bool                           `json:"ispersistent"`
-	Issystem                    bool                           `json:"issystem"`
+	Isdefault                   FlexBool                       `json:"isdefault"`
+	Ispersistent                FlexBool                       `json:"ispersistent"`
+	Issystem                    FlexBool                       `json:"issystem"`
 	JobID                       string                         `json:"jobid"`
-	Jobstatus                   int                            `json:"jobstatus"`
+	Jobstatus                   FlexInt                        `json:"jobstatus"`
 	Name                        string                         `json:"name"`
 	Netmask                     string                         `json:"netmask"`
 	Networkcidr                 string                         `json:"networkcidr"`
 	Networkdomain               string                         `json:"networkdomain"`
 	Networkofferingavailability string                         `json:"networkofferingavailability"`
-	Networkofferingconservemode bool                           `json:"networkofferingconservemode"`
+	Networkofferingconservemode FlexBool                       `json:"networkofferingconservemode"`
 	Networkofferingdisplaytext  string                         `json:"networkofferingdisplaytext"`
 	Networkofferingid           string                         `json:"networkofferingid"`
 	Networkofferingname         string                         `json:"networkofferingname"`
 	Physicalnetworkid           string                         `json:"physicalnetworkid"`
-	Privatemtu                  int                            `json:"privatemtu"`
+	Privatemtu                  FlexInt                        `json:"privatemtu"`
 	Project                     string                         `json:"project"`
 	Projectid                   string                         `json:"projectid"`
-	Publicmtu                   int                            `json:"publicmtu"`
-	Receivedbytes               int64                          `json:"receivedbytes"`
-	Redundantrouter             bool                           `json:"redundantrouter"`
+	Publicmtu                   FlexInt                        `json:"publicmtu"`
+	Receivedbytes               FlexInt64                      `json:"receivedbytes"`
+	Redundantrouter             FlexBool                       `json:"redundantrouter"`
 	Related                     string                         `json:"related"`
 	Reservediprange             string                         `json:"reservediprange"`
-	Restartrequired             bool                           `json:"restartrequired"`
-	Sentbytes                   int64                          `json:"sentbytes"`
+	Restartrequired             FlexBool                       `json:"restartrequired"`
+	Sentbytes                   FlexInt64                      `json:"sentbytes"`
 	Service                     []UpdateNetworkResponseService `json:"service"`
-	Specifyipranges             bool                           `json:"specifyipranges"`
-	Specifyvlan                 bool                           `json:"specifyvlan"`
-	State                       string                         `json:"state"`
-	Strechedl2subnet            bool                           `json:"strechedl2subnet"`
-	Subdomainaccess             bool                           `json:"subdomainaccess"`
-	Supportsvmautoscaling       bool                           `json:"supportsvmautoscaling"`
+	Specifyipranges             FlexBool                       `json:"specifyipranges"`
+	Specifyvlan                 FlexBool                       `json:"specifyvlan"`
+	State                       NetworkState                   `json:"state"`
+	Strechedl2subnet            FlexBool                       `json:"strechedl2subnet"`
+	Subdomainaccess             FlexBool                       `json:"subdomainaccess"`
+	Supportsvmautoscaling       FlexBool                       `json:"supportsvmautoscaling"`
 	Tags                        []Tags                         `json:"tags"`
 	Traffictype                 string                         `json:"traffictype"`
 	Tungstenvirtualrouteruuid   string                         `json:"tungstenvirtualrouteruuid"`
@@ -7535,28 +10065,52 @@ type UpdateNetworkResponse struct {
 	Zoneid                      string                         `json:"zoneid"`
 	Zonename                    string                         `json:"zonename"`
 	Zonesnetworkspans           []interface{}                  `json:"zonesnetworkspans"`
+	Extra                       map[string]json.RawMessage     `json:"-"`
+}
+
+func (r *UpdateNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkResponseService struct {
 	Capability []UpdateNetworkResponseServiceCapability `json:"capability"`
 	Name       string                                   `json:"name"`
 	Provider   []UpdateNetworkResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage               `json:"-"`
+}
+
+func (r *UpdateNetworkResponseService) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateNetworkResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateNetworkResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateNetworkServiceProviderParams struct {
@@ -7581,6 +10135,46 @@ func (p *UpdateNetworkServiceProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateNetworkServiceProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateNetworkServiceProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateNetworkServiceProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateNetworkServiceProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateNetworkServiceProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "state": 255},
+	}.validate("updateNetworkServiceProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateNetworkServiceProvider API, before the request is signed
+func (p *UpdateNetworkServiceProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateNetworkServiceProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateNetworkServiceProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateNetworkServiceProvider", b, &p.p, map[string]paramDecoder{
+		"id":          decodeParam[string],
+		"servicelist": decodeParam[[]string],
+		"state":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateNetworkServiceProviderParams) Clone() *UpdateNetworkServiceProviderParams {
+	return &UpdateNetworkServiceProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateNetworkServiceProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7655,32 +10249,39 @@ func (s *NetworkService) NewUpdateNetworkServiceProviderParams(id string) *Updat
 
 // Updates a network serviceProvider of a physical network
 func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("updateNetworkServiceProvider", p.toURLValues())
+	return s.UpdateNetworkServiceProviderWithContext(context.Background(), p)
+}
+
+// UpdateNetworkServiceProviderWithContext is like UpdateNetworkServiceProvider, but honours the cancellation and deadline of ctx
+func (s *NetworkService) UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateNetworkServiceProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateNetworkServiceProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateNetworkServiceProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateNetworkServiceProvider", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateNetworkServiceProvider", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -7688,16 +10289,41 @@ func (s *NetworkService) UpdateNetworkServiceProvider(p *UpdateNetworkServicePro
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateNetworkServiceProvider async job into r
+func (r *UpdateNetworkServiceProviderResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateNetworkServiceProviderAsync starts the async job of UpdateNetworkServiceProvider without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) UpdateNetworkServiceProviderAsync(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*Job, error) {
+	r, err := s.UpdateNetworkServiceProviderWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateNetworkServiceProvider", r.JobID), nil
+}
+
 type UpdateNetworkServiceProviderResponse struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	JobID                        string   `json:"jobid"`
-	Jobstatus                    int      `json:"jobstatus"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateNetworkServiceProviderResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateNetworkServiceProviderResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdatePhysicalNetworkParams struct {
@@ -7728,6 +10354,48 @@ func (p *UpdatePhysicalNetworkParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updatePhysicalNetwork API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdatePhysicalNetworkParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updatePhysicalNetwork", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updatePhysicalNetwork API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdatePhysicalNetworkParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "networkspeed": 255, "state": 255, "vlan": 255},
+	}.validate("updatePhysicalNetwork", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updatePhysicalNetwork API, before the request is signed
+func (p *UpdatePhysicalNetworkParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdatePhysicalNetworkParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdatePhysicalNetworkParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updatePhysicalNetwork", b, &p.p, map[string]paramDecoder{
+		"id":           decodeParam[string],
+		"networkspeed": decodeParam[string],
+		"state":        decodeParam[string],
+		"tags":         decodeParam[[]string],
+		"vlan":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdatePhysicalNetworkParams) Clone() *UpdatePhysicalNetworkParams {
+	return &UpdatePhysicalNetworkParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdatePhysicalNetworkParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7844,32 +10512,39 @@ func (s *NetworkService) NewUpdatePhysicalNetworkParams(id string) *UpdatePhysic
 
 // Updates a physical network
 func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
-	resp, err := s.cs.newPostRequest("updatePhysicalNetwork", p.toURLValues())
+	return s.UpdatePhysicalNetworkWithContext(context.Background(), p)
+}
+
+// UpdatePhysicalNetworkWithContext is like UpdatePhysicalNetwork, but honours the cancellation and deadline of ctx
+func (s *NetworkService) UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updatePhysicalNetwork", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdatePhysicalNetworkResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updatePhysicalNetwork", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updatePhysicalNetwork", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updatePhysicalNetwork", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -7877,20 +10552,45 @@ func (s *NetworkService) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdatePhysicalNetwork async job into r
+func (r *UpdatePhysicalNetworkResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdatePhysicalNetworkAsync starts the async job of UpdatePhysicalNetwork without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) UpdatePhysicalNetworkAsync(ctx context.Context, p *UpdatePhysicalNetworkParams) (*Job, error) {
+	r, err := s.UpdatePhysicalNetworkWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updatePhysicalNetwork", r.JobID), nil
+}
+
 type UpdatePhysicalNetworkResponse struct {
-	Broadcastdomainrange string `json:"broadcastdomainrange"`
-	Domainid             string `json:"domainid"`
-	Id                   string `json:"id"`
-	Isolationmethods     string `json:"isolationmethods"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Name                 string `json:"name"`
-	Networkspeed         string `json:"networkspeed"`
-	State                string `json:"state"`
-	Tags                 string `json:"tags"`
-	Vlan                 string `json:"vlan"`
-	Zoneid               string `json:"zoneid"`
-	Zonename             string `json:"zonename"`
+	Broadcastdomainrange string                     `json:"broadcastdomainrange"`
+	Domainid             string                     `json:"domainid"`
+	Id                   string                     `json:"id"`
+	Isolationmethods     string                     `json:"isolationmethods"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Name                 string                     `json:"name"`
+	Networkspeed         string                     `json:"networkspeed"`
+	State                string                     `json:"state"`
+	Tags                 string                     `json:"tags"`
+	Vlan                 string                     `json:"vlan"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdatePhysicalNetworkResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdatePhysicalNetworkResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateStorageNetworkIpRangeParams struct {
@@ -7921,6 +10621,48 @@ func (p *UpdateStorageNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateStorageNetworkIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateStorageNetworkIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateStorageNetworkIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateStorageNetworkIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateStorageNetworkIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"endip": 255, "id": 255, "netmask": 255, "startip": 255},
+	}.validate("updateStorageNetworkIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateStorageNetworkIpRange API, before the request is signed
+func (p *UpdateStorageNetworkIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateStorageNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateStorageNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateStorageNetworkIpRange", b, &p.p, map[string]paramDecoder{
+		"endip":   decodeParam[string],
+		"id":      decodeParam[string],
+		"netmask": decodeParam[string],
+		"startip": decodeParam[string],
+		"vlan":    decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateStorageNetworkIpRangeParams) Clone() *UpdateStorageNetworkIpRangeParams {
+	return &UpdateStorageNetworkIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateStorageNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8037,32 +10779,39 @@ func (s *NetworkService) NewUpdateStorageNetworkIpRangeParams(id string) *Update
 
 // Update a Storage network IP range, only allowed when no IPs in this range have been allocated.
 func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("updateStorageNetworkIpRange", p.toURLValues())
+	return s.UpdateStorageNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// UpdateStorageNetworkIpRangeWithContext is like UpdateStorageNetworkIpRange, but honours the cancellation and deadline of ctx
+func (s *NetworkService) UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateStorageNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateStorageNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateStorageNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateStorageNetworkIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateStorageNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -8070,18 +10819,43 @@ func (s *NetworkService) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateStorageNetworkIpRange async job into r
+func (r *UpdateStorageNetworkIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateStorageNetworkIpRangeAsync starts the async job of UpdateStorageNetworkIpRange without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) UpdateStorageNetworkIpRangeAsync(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*Job, error) {
+	r, err := s.UpdateStorageNetworkIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateStorageNetworkIpRange", r.JobID), nil
+}
+
 type UpdateStorageNetworkIpRangeResponse struct {
-	Endip     string `json:"endip"`
-	Gateway   string `json:"gateway"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Netmask   string `json:"netmask"`
-	Networkid string `json:"networkid"`
-	Podid     string `json:"podid"`
-	Startip   string `json:"startip"`
-	Vlan      int    `json:"vlan"`
-	Zoneid    string `json:"zoneid"`
+	Endip     string                     `json:"endip"`
+	Gateway   string                     `json:"gateway"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Netmask   string                     `json:"netmask"`
+	Networkid string                     `json:"networkid"`
+	Podid     string                     `json:"podid"`
+	Startip   string                     `json:"startip"`
+	Vlan      FlexInt                    `json:"vlan"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateStorageNetworkIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateStorageNetworkIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteGuestNetworkIpv6PrefixParams struct {
@@ -8099,6 +10873,44 @@ func (p *DeleteGuestNetworkIpv6PrefixParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteGuestNetworkIpv6Prefix API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteGuestNetworkIpv6PrefixParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteGuestNetworkIpv6Prefix", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteGuestNetworkIpv6Prefix API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteGuestNetworkIpv6PrefixParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteGuestNetworkIpv6Prefix", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteGuestNetworkIpv6Prefix API, before the request is signed
+func (p *DeleteGuestNetworkIpv6PrefixParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteGuestNetworkIpv6PrefixParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteGuestNetworkIpv6PrefixParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteGuestNetworkIpv6Prefix", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteGuestNetworkIpv6PrefixParams) Clone() *DeleteGuestNetworkIpv6PrefixParams {
+	return &DeleteGuestNetworkIpv6PrefixParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteGuestNetworkIpv6PrefixParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8131,27 +10943,39 @@ func (s *NetworkService) NewDeleteGuestNetworkIpv6PrefixParams(id string) *Delet
 
 // Deletes an existing guest network IPv6 prefix.
 func (s *NetworkService) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteGuestNetworkIpv6Prefix", p.toURLValues())
+	return s.DeleteGuestNetworkIpv6PrefixWithContext(context.Background(), p)
+}
+
+// DeleteGuestNetworkIpv6PrefixWithContext is like DeleteGuestNetworkIpv6Prefix, but honours the cancellation and deadline of ctx
+func (s *NetworkService) DeleteGuestNetworkIpv6PrefixWithContext(ctx context.Context, p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteGuestNetworkIpv6Prefix", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteGuestNetworkIpv6PrefixResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteGuestNetworkIpv6Prefix", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteGuestNetworkIpv6Prefix", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteGuestNetworkIpv6Prefix", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -8159,11 +10983,31 @@ func (s *NetworkService) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6P
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteGuestNetworkIpv6Prefix async job into r
+func (r *DeleteGuestNetworkIpv6PrefixResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteGuestNetworkIpv6PrefixAsync starts the async job of DeleteGuestNetworkIpv6Prefix without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) DeleteGuestNetworkIpv6PrefixAsync(ctx context.Context, p *DeleteGuestNetworkIpv6PrefixParams) (*Job, error) {
+	r, err := s.DeleteGuestNetworkIpv6PrefixWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteGuestNetworkIpv6Prefix", r.JobID), nil
+}
+
 type DeleteGuestNetworkIpv6PrefixResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteGuestNetworkIpv6PrefixResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteGuestNetworkIpv6PrefixResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateGuestNetworkIpv6PrefixParams struct {
@@ -8184,6 +11028,45 @@ func (p *CreateGuestNetworkIpv6PrefixParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createGuestNetworkIpv6Prefix API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateGuestNetworkIpv6PrefixParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createGuestNetworkIpv6Prefix", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createGuestNetworkIpv6Prefix API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateGuestNetworkIpv6PrefixParams) Validate() error {
+	return paramRules{
+		required: []string{"prefix", "zoneid"},
+		lengths:  map[string]int{"prefix": 255, "zoneid": 255},
+	}.validate("createGuestNetworkIpv6Prefix", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createGuestNetworkIpv6Prefix API, before the request is signed
+func (p *CreateGuestNetworkIpv6PrefixParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateGuestNetworkIpv6PrefixParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateGuestNetworkIpv6PrefixParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createGuestNetworkIpv6Prefix", b, &p.p, map[string]paramDecoder{
+		"prefix": decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateGuestNetworkIpv6PrefixParams) Clone() *CreateGuestNetworkIpv6PrefixParams {
+	return &CreateGuestNetworkIpv6PrefixParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateGuestNetworkIpv6PrefixParams) SetPrefix(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8238,32 +11121,39 @@ func (s *NetworkService) NewCreateGuestNetworkIpv6PrefixParams(prefix string, zo
 
 // Creates a guest network IPv6 prefix.
 func (s *NetworkService) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error) {
-	resp, err := s.cs.newPostRequest("createGuestNetworkIpv6Prefix", p.toURLValues())
+	return s.CreateGuestNetworkIpv6PrefixWithContext(context.Background(), p)
+}
+
+// CreateGuestNetworkIpv6PrefixWithContext is like CreateGuestNetworkIpv6Prefix, but honours the cancellation and deadline of ctx
+func (s *NetworkService) CreateGuestNetworkIpv6PrefixWithContext(ctx context.Context, p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createGuestNetworkIpv6Prefix", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateGuestNetworkIpv6PrefixResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createGuestNetworkIpv6Prefix", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createGuestNetworkIpv6Prefix", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createGuestNetworkIpv6Prefix", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -8271,16 +11161,41 @@ func (s *NetworkService) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6P
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateGuestNetworkIpv6Prefix async job into r
+func (r *CreateGuestNetworkIpv6PrefixResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateGuestNetworkIpv6PrefixAsync starts the async job of CreateGuestNetworkIpv6Prefix without waiting for it to finish, and returns a handle to the job
+func (s *NetworkService) CreateGuestNetworkIpv6PrefixAsync(ctx context.Context, p *CreateGuestNetworkIpv6PrefixParams) (*Job, error) {
+	r, err := s.CreateGuestNetworkIpv6PrefixWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createGuestNetworkIpv6Prefix", r.JobID), nil
+}
+
 type CreateGuestNetworkIpv6PrefixResponse struct {
-	Availablesubnets int    `json:"availablesubnets"`
-	Created          string `json:"created"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Prefix           string `json:"prefix"`
-	Totalsubnets     int    `json:"totalsubnets"`
-	Usedsubnets      int    `json:"usedsubnets"`
-	Zoneid           string `json:"zoneid"`
+	Availablesubnets FlexInt                    `json:"availablesubnets"`
+	Created          Time                       `json:"created"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Prefix           string                     `json:"prefix"`
+	Totalsubnets     FlexInt                    `json:"totalsubnets"`
+	Usedsubnets      FlexInt                    `json:"usedsubnets"`
+	Zoneid           string                     `json:"zoneid"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateGuestNetworkIpv6PrefixResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateGuestNetworkIpv6PrefixResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListGuestNetworkIpv6PrefixesParams struct {
@@ -8312,6 +11227,47 @@ func (p *ListGuestNetworkIpv6PrefixesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listGuestNetworkIpv6Prefixes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListGuestNetworkIpv6PrefixesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listGuestNetworkIpv6Prefixes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listGuestNetworkIpv6Prefixes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListGuestNetworkIpv6PrefixesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "zoneid": 255},
+	}.validate("listGuestNetworkIpv6Prefixes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listGuestNetworkIpv6Prefixes API, before the request is signed
+func (p *ListGuestNetworkIpv6PrefixesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListGuestNetworkIpv6PrefixesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListGuestNetworkIpv6PrefixesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listGuestNetworkIpv6Prefixes", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListGuestNetworkIpv6PrefixesParams) Clone() *ListGuestNetworkIpv6PrefixesParams {
+	return &ListGuestNetworkIpv6PrefixesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListGuestNetworkIpv6PrefixesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8427,6 +11383,11 @@ func (s *NetworkService) NewListGuestNetworkIpv6PrefixesParams() *ListGuestNetwo
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *NetworkService) GetGuestNetworkIpv6PrefixeByID(id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error) {
+	return s.GetGuestNetworkIpv6PrefixeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetGuestNetworkIpv6PrefixeByIDWithContext is like GetGuestNetworkIpv6PrefixeByID, but honours the cancellation and deadline of ctx
+func (s *NetworkService) GetGuestNetworkIpv6PrefixeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error) {
 	p := &ListGuestNetworkIpv6PrefixesParams{}
 	p.p = make(map[string]interface{})
 
@@ -8438,11 +11399,10 @@ func (s *NetworkService) GetGuestNetworkIpv6PrefixeByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListGuestNetworkIpv6Prefixes(p)
+	l, err := s.ListGuestNetworkIpv6PrefixesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -8460,34 +11420,77 @@ func (s *NetworkService) GetGuestNetworkIpv6PrefixeByID(id string, opts ...Optio
 
 // Lists guest network IPv6 prefixes
 func (s *NetworkService) ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error) {
-	resp, err := s.cs.newRequest("listGuestNetworkIpv6Prefixes", p.toURLValues())
+	return s.ListGuestNetworkIpv6PrefixesWithContext(context.Background(), p)
+}
+
+// ListGuestNetworkIpv6PrefixesWithContext is like ListGuestNetworkIpv6Prefixes, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListGuestNetworkIpv6PrefixesWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listGuestNetworkIpv6Prefixes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListGuestNetworkIpv6PrefixesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listGuestNetworkIpv6Prefixes", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListGuestNetworkIpv6PrefixesAll returns all GuestNetworkIpv6Prefixes matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NetworkService) ListGuestNetworkIpv6PrefixesAll(p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) ([]*GuestNetworkIpv6Prefixe, error) {
+	return s.ListGuestNetworkIpv6PrefixesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListGuestNetworkIpv6PrefixesAllWithContext is like ListGuestNetworkIpv6PrefixesAll, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListGuestNetworkIpv6PrefixesAllWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) ([]*GuestNetworkIpv6Prefixe, error) {
+	return collectPages(s.ListGuestNetworkIpv6PrefixesIterWithContext(ctx, p, opts...))
+}
+
+// ListGuestNetworkIpv6PrefixesIter returns an iterator over all GuestNetworkIpv6Prefixes matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NetworkService) ListGuestNetworkIpv6PrefixesIter(p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) iter.Seq2[*GuestNetworkIpv6Prefixe, error] {
+	return s.ListGuestNetworkIpv6PrefixesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListGuestNetworkIpv6PrefixesIterWithContext is like ListGuestNetworkIpv6PrefixesIter, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListGuestNetworkIpv6PrefixesIterWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) iter.Seq2[*GuestNetworkIpv6Prefixe, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*GuestNetworkIpv6Prefixe, int, error) {
+		l, err := s.ListGuestNetworkIpv6PrefixesWithContext(ctx, &ListGuestNetworkIpv6PrefixesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.GuestNetworkIpv6Prefixes, l.Count, nil
+	}, opts...)
+}
+
 type ListGuestNetworkIpv6PrefixesResponse struct {
 	Count                    int                        `json:"count"`
 	GuestNetworkIpv6Prefixes []*GuestNetworkIpv6Prefixe `json:"guestnetworkipv6prefix"`
 }
 
 type GuestNetworkIpv6Prefixe struct {
-	Availablesubnets int    `json:"availablesubnets"`
-	Created          string `json:"created"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Prefix           string `json:"prefix"`
-	Totalsubnets     int    `json:"totalsubnets"`
-	Usedsubnets      int    `json:"usedsubnets"`
-	Zoneid           string `json:"zoneid"`
+	Availablesubnets FlexInt                    `json:"availablesubnets"`
+	Created          Time                       `json:"created"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Prefix           string                     `json:"prefix"`
+	Totalsubnets     FlexInt                    `json:"totalsubnets"`
+	Usedsubnets      FlexInt                    `json:"usedsubnets"`
+	Zoneid           string                     `json:"zoneid"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GuestNetworkIpv6Prefixe) UnmarshalJSON(b []byte) error {
+	type alias GuestNetworkIpv6Prefixe
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateNetworkPermissionsParams struct {
@@ -8517,6 +11520,47 @@ func (p *CreateNetworkPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createNetworkPermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateNetworkPermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createNetworkPermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createNetworkPermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateNetworkPermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid"},
+		lengths:  map[string]int{"networkid": 255},
+	}.validate("createNetworkPermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createNetworkPermissions API, before the request is signed
+func (p *CreateNetworkPermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createNetworkPermissions", b, &p.p, map[string]paramDecoder{
+		"accountids": decodeParam[[]string],
+		"accounts":   decodeParam[[]string],
+		"networkid":  decodeParam[string],
+		"projectids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateNetworkPermissionsParams) Clone() *CreateNetworkPermissionsParams {
+	return &CreateNetworkPermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateNetworkPermissionsParams) SetAccountids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8612,13 +11656,22 @@ func (s *NetworkService) NewCreateNetworkPermissionsParams(networkid string) *Cr
 
 // Updates network permissions.
 func (s *NetworkService) CreateNetworkPermissions(p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newPostRequest("createNetworkPermissions", p.toURLValues())
+	return s.CreateNetworkPermissionsWithContext(context.Background(), p)
+}
+
+// CreateNetworkPermissionsWithContext is like CreateNetworkPermissions, but honours the cancellation and deadline of ctx
+func (s *NetworkService) CreateNetworkPermissionsWithContext(ctx context.Context, p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createNetworkPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateNetworkPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createNetworkPermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -8626,10 +11679,11 @@ func (s *NetworkService) CreateNetworkPermissions(p *CreateNetworkPermissionsPar
 }
 
 type CreateNetworkPermissionsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CreateNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
@@ -8656,7 +11710,7 @@ func (r *CreateNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CreateNetworkPermissionsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetNetworkPermissionsParams struct {
@@ -8674,6 +11728,44 @@ func (p *ResetNetworkPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resetNetworkPermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResetNetworkPermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resetNetworkPermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resetNetworkPermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResetNetworkPermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid"},
+		lengths:  map[string]int{"networkid": 255},
+	}.validate("resetNetworkPermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resetNetworkPermissions API, before the request is signed
+func (p *ResetNetworkPermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResetNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResetNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resetNetworkPermissions", b, &p.p, map[string]paramDecoder{
+		"networkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResetNetworkPermissionsParams) Clone() *ResetNetworkPermissionsParams {
+	return &ResetNetworkPermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ResetNetworkPermissionsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8706,13 +11798,22 @@ func (s *NetworkService) NewResetNetworkPermissionsParams(networkid string) *Res
 
 // Resets Network permissions.
 func (s *NetworkService) ResetNetworkPermissions(p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newPostRequest("resetNetworkPermissions", p.toURLValues())
+	return s.ResetNetworkPermissionsWithContext(context.Background(), p)
+}
+
+// ResetNetworkPermissionsWithContext is like ResetNetworkPermissions, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ResetNetworkPermissionsWithContext(ctx context.Context, p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "resetNetworkPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetNetworkPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resetNetworkPermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -8720,10 +11821,11 @@ func (s *NetworkService) ResetNetworkPermissions(p *ResetNetworkPermissionsParam
 }
 
 type ResetNetworkPermissionsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ResetNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
@@ -8750,7 +11852,7 @@ func (r *ResetNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ResetNetworkPermissionsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNetworkPermissionsParams struct {
@@ -8768,6 +11870,44 @@ func (p *ListNetworkPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNetworkPermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNetworkPermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNetworkPermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNetworkPermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNetworkPermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid"},
+		lengths:  map[string]int{"networkid": 255},
+	}.validate("listNetworkPermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNetworkPermissions API, before the request is signed
+func (p *ListNetworkPermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNetworkPermissions", b, &p.p, map[string]paramDecoder{
+		"networkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNetworkPermissionsParams) Clone() *ListNetworkPermissionsParams {
+	return &ListNetworkPermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNetworkPermissionsParams) SetNetworkid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8800,13 +11940,22 @@ func (s *NetworkService) NewListNetworkPermissionsParams(networkid string) *List
 
 // List Network visibility and all Accounts that have permissions to view this Network.
 func (s *NetworkService) ListNetworkPermissions(p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listNetworkPermissions", p.toURLValues())
+	return s.ListNetworkPermissionsWithContext(context.Background(), p)
+}
+
+// ListNetworkPermissionsWithContext is like ListNetworkPermissions, but honours the cancellation and deadline of ctx
+func (s *NetworkService) ListNetworkPermissionsWithContext(ctx context.Context, p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNetworkPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNetworkPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNetworkPermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -8819,15 +11968,21 @@ type ListNetworkPermissionsResponse struct {
 }
 
 type NetworkPermission struct {
-	Account   string `json:"account"`
-	Accountid string `json:"accountid"`
-	Domain    string `json:"domain"`
-	Domainid  string `json:"domainid"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Networkid string `json:"networkid"`
-	Project   string `json:"project"`
-	Projectid string `json:"projectid"`
+	Account   string                     `json:"account"`
+	Accountid string                     `json:"accountid"`
+	Domain    string                     `json:"domain"`
+	Domainid  string                     `json:"domainid"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Networkid string                     `json:"networkid"`
+	Project   string                     `json:"project"`
+	Projectid string                     `json:"projectid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NetworkPermission) UnmarshalJSON(b []byte) error {
+	type alias NetworkPermission
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveNetworkPermissionsParams struct {
@@ -8857,6 +12012,47 @@ func (p *RemoveNetworkPermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeNetworkPermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveNetworkPermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeNetworkPermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeNetworkPermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveNetworkPermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid"},
+		lengths:  map[string]int{"networkid": 255},
+	}.validate("removeNetworkPermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeNetworkPermissions API, before the request is signed
+func (p *RemoveNetworkPermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveNetworkPermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveNetworkPermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeNetworkPermissions", b, &p.p, map[string]paramDecoder{
+		"accountids": decodeParam[[]string],
+		"accounts":   decodeParam[[]string],
+		"networkid":  decodeParam[string],
+		"projectids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveNetworkPermissionsParams) Clone() *RemoveNetworkPermissionsParams {
+	return &RemoveNetworkPermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveNetworkPermissionsParams) SetAccountids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8952,13 +12148,22 @@ func (s *NetworkService) NewRemoveNetworkPermissionsParams(networkid string) *Re
 
 // Removes Network permissions.
 func (s *NetworkService) RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error) {
-	resp, err := s.cs.newPostRequest("removeNetworkPermissions", p.toURLValues())
+	return s.RemoveNetworkPermissionsWithContext(context.Background(), p)
+}
+
+// RemoveNetworkPermissionsWithContext is like RemoveNetworkPermissions, but honours the cancellation and deadline of ctx
+func (s *NetworkService) RemoveNetworkPermissionsWithContext(ctx context.Context, p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeNetworkPermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveNetworkPermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeNetworkPermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -8966,10 +12171,11 @@ func (s *NetworkService) RemoveNetworkPermissions(p *RemoveNetworkPermissionsPar
 }
 
 type RemoveNetworkPermissionsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RemoveNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
@@ -8996,5 +12202,5 @@ func (r *RemoveNetworkPermissionsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RemoveNetworkPermissionsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
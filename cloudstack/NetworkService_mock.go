@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) AddNetworkServiceProvider(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddNetworkServiceProvider), p)
 }
 
+// AddNetworkServiceProviderAsync mocks base method.
+func (m *MockNetworkServiceIface) AddNetworkServiceProviderAsync(ctx context.Context, p *AddNetworkServiceProviderParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetworkServiceProviderAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNetworkServiceProviderAsync indicates an expected call of AddNetworkServiceProviderAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) AddNetworkServiceProviderAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkServiceProviderAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddNetworkServiceProviderAsync), ctx, p)
+}
+
+// AddNetworkServiceProviderWithContext mocks base method.
+func (m *MockNetworkServiceIface) AddNetworkServiceProviderWithContext(ctx context.Context, p *AddNetworkServiceProviderParams) (*AddNetworkServiceProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNetworkServiceProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNetworkServiceProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNetworkServiceProviderWithContext indicates an expected call of AddNetworkServiceProviderWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) AddNetworkServiceProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNetworkServiceProviderWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddNetworkServiceProviderWithContext), ctx, p)
+}
+
 // AddOpenDaylightController mocks base method.
 func (m *MockNetworkServiceIface) AddOpenDaylightController(p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) AddOpenDaylightController(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOpenDaylightController", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddOpenDaylightController), p)
 }
 
+// AddOpenDaylightControllerAsync mocks base method.
+func (m *MockNetworkServiceIface) AddOpenDaylightControllerAsync(ctx context.Context, p *AddOpenDaylightControllerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOpenDaylightControllerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOpenDaylightControllerAsync indicates an expected call of AddOpenDaylightControllerAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) AddOpenDaylightControllerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOpenDaylightControllerAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddOpenDaylightControllerAsync), ctx, p)
+}
+
+// AddOpenDaylightControllerWithContext mocks base method.
+func (m *MockNetworkServiceIface) AddOpenDaylightControllerWithContext(ctx context.Context, p *AddOpenDaylightControllerParams) (*AddOpenDaylightControllerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddOpenDaylightControllerWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddOpenDaylightControllerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddOpenDaylightControllerWithContext indicates an expected call of AddOpenDaylightControllerWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) AddOpenDaylightControllerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddOpenDaylightControllerWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).AddOpenDaylightControllerWithContext), ctx, p)
+}
+
 // ChangeBgpPeersForNetwork mocks base method.
 func (m *MockNetworkServiceIface) ChangeBgpPeersForNetwork(p *ChangeBgpPeersForNetworkParams) (*ChangeBgpPeersForNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) ChangeBgpPeersForNetwork(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBgpPeersForNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).ChangeBgpPeersForNetwork), p)
 }
 
+// ChangeBgpPeersForNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) ChangeBgpPeersForNetworkAsync(ctx context.Context, p *ChangeBgpPeersForNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeBgpPeersForNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeBgpPeersForNetworkAsync indicates an expected call of ChangeBgpPeersForNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) ChangeBgpPeersForNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBgpPeersForNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).ChangeBgpPeersForNetworkAsync), ctx, p)
+}
+
+// ChangeBgpPeersForNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) ChangeBgpPeersForNetworkWithContext(ctx context.Context, p *ChangeBgpPeersForNetworkParams) (*ChangeBgpPeersForNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeBgpPeersForNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeBgpPeersForNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeBgpPeersForNetworkWithContext indicates an expected call of ChangeBgpPeersForNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ChangeBgpPeersForNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeBgpPeersForNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ChangeBgpPeersForNetworkWithContext), ctx, p)
+}
+
 // CreateGuestNetworkIpv6Prefix mocks base method.
 func (m *MockNetworkServiceIface) CreateGuestNetworkIpv6Prefix(p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) CreateGuestNetworkIpv6Prefix(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestNetworkIpv6Prefix", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateGuestNetworkIpv6Prefix), p)
 }
 
+// CreateGuestNetworkIpv6PrefixAsync mocks base method.
+func (m *MockNetworkServiceIface) CreateGuestNetworkIpv6PrefixAsync(ctx context.Context, p *CreateGuestNetworkIpv6PrefixParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuestNetworkIpv6PrefixAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGuestNetworkIpv6PrefixAsync indicates an expected call of CreateGuestNetworkIpv6PrefixAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateGuestNetworkIpv6PrefixAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestNetworkIpv6PrefixAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateGuestNetworkIpv6PrefixAsync), ctx, p)
+}
+
+// CreateGuestNetworkIpv6PrefixWithContext mocks base method.
+func (m *MockNetworkServiceIface) CreateGuestNetworkIpv6PrefixWithContext(ctx context.Context, p *CreateGuestNetworkIpv6PrefixParams) (*CreateGuestNetworkIpv6PrefixResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateGuestNetworkIpv6PrefixWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateGuestNetworkIpv6PrefixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateGuestNetworkIpv6PrefixWithContext indicates an expected call of CreateGuestNetworkIpv6PrefixWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateGuestNetworkIpv6PrefixWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateGuestNetworkIpv6PrefixWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateGuestNetworkIpv6PrefixWithContext), ctx, p)
+}
+
 // CreateIpv4SubnetForGuestNetwork mocks base method.
 func (m *MockNetworkServiceIface) CreateIpv4SubnetForGuestNetwork(p *CreateIpv4SubnetForGuestNetworkParams) (*CreateIpv4SubnetForGuestNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) CreateIpv4SubnetForGuestNetwork(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv4SubnetForGuestNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateIpv4SubnetForGuestNetwork), p)
 }
 
+// CreateIpv4SubnetForGuestNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) CreateIpv4SubnetForGuestNetworkAsync(ctx context.Context, p *CreateIpv4SubnetForGuestNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv4SubnetForGuestNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv4SubnetForGuestNetworkAsync indicates an expected call of CreateIpv4SubnetForGuestNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateIpv4SubnetForGuestNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv4SubnetForGuestNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateIpv4SubnetForGuestNetworkAsync), ctx, p)
+}
+
+// CreateIpv4SubnetForGuestNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) CreateIpv4SubnetForGuestNetworkWithContext(ctx context.Context, p *CreateIpv4SubnetForGuestNetworkParams) (*CreateIpv4SubnetForGuestNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv4SubnetForGuestNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateIpv4SubnetForGuestNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv4SubnetForGuestNetworkWithContext indicates an expected call of CreateIpv4SubnetForGuestNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateIpv4SubnetForGuestNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv4SubnetForGuestNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateIpv4SubnetForGuestNetworkWithContext), ctx, p)
+}
+
 // CreateNetwork mocks base method.
 func (m *MockNetworkServiceIface) CreateNetwork(p *CreateNetworkParams) (*CreateNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +315,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) CreateNetworkPermissions(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateNetworkPermissions), p)
 }
 
+// CreateNetworkPermissionsWithContext mocks base method.
+func (m *MockNetworkServiceIface) CreateNetworkPermissionsWithContext(ctx context.Context, p *CreateNetworkPermissionsParams) (*CreateNetworkPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkPermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateNetworkPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkPermissionsWithContext indicates an expected call of CreateNetworkPermissionsWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateNetworkPermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkPermissionsWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateNetworkPermissionsWithContext), ctx, p)
+}
+
+// CreateNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) CreateNetworkWithContext(ctx context.Context, p *CreateNetworkParams) (*CreateNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNetworkWithContext indicates an expected call of CreateNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateNetworkWithContext), ctx, p)
+}
+
 // CreatePhysicalNetwork mocks base method.
 func (m *MockNetworkServiceIface) CreatePhysicalNetwork(p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +360,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) CreatePhysicalNetwork(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreatePhysicalNetwork), p)
 }
 
+// CreatePhysicalNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) CreatePhysicalNetworkAsync(ctx context.Context, p *CreatePhysicalNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePhysicalNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePhysicalNetworkAsync indicates an expected call of CreatePhysicalNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreatePhysicalNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhysicalNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreatePhysicalNetworkAsync), ctx, p)
+}
+
+// CreatePhysicalNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) CreatePhysicalNetworkWithContext(ctx context.Context, p *CreatePhysicalNetworkParams) (*CreatePhysicalNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePhysicalNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreatePhysicalNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePhysicalNetworkWithContext indicates an expected call of CreatePhysicalNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreatePhysicalNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePhysicalNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreatePhysicalNetworkWithContext), ctx, p)
+}
+
 // CreateServiceInstance mocks base method.
 func (m *MockNetworkServiceIface) CreateServiceInstance(p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +405,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) CreateServiceInstance(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceInstance", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateServiceInstance), p)
 }
 
+// CreateServiceInstanceAsync mocks base method.
+func (m *MockNetworkServiceIface) CreateServiceInstanceAsync(ctx context.Context, p *CreateServiceInstanceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceInstanceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceInstanceAsync indicates an expected call of CreateServiceInstanceAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateServiceInstanceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceInstanceAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateServiceInstanceAsync), ctx, p)
+}
+
+// CreateServiceInstanceWithContext mocks base method.
+func (m *MockNetworkServiceIface) CreateServiceInstanceWithContext(ctx context.Context, p *CreateServiceInstanceParams) (*CreateServiceInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceInstanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateServiceInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceInstanceWithContext indicates an expected call of CreateServiceInstanceWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateServiceInstanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceInstanceWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateServiceInstanceWithContext), ctx, p)
+}
+
 // CreateStorageNetworkIpRange mocks base method.
 func (m *MockNetworkServiceIface) CreateStorageNetworkIpRange(p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +450,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) CreateStorageNetworkIpRange(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateStorageNetworkIpRange), p)
 }
 
+// CreateStorageNetworkIpRangeAsync mocks base method.
+func (m *MockNetworkServiceIface) CreateStorageNetworkIpRangeAsync(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageNetworkIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageNetworkIpRangeAsync indicates an expected call of CreateStorageNetworkIpRangeAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateStorageNetworkIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageNetworkIpRangeAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateStorageNetworkIpRangeAsync), ctx, p)
+}
+
+// CreateStorageNetworkIpRangeWithContext mocks base method.
+func (m *MockNetworkServiceIface) CreateStorageNetworkIpRangeWithContext(ctx context.Context, p *CreateStorageNetworkIpRangeParams) (*CreateStorageNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStorageNetworkIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateStorageNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStorageNetworkIpRangeWithContext indicates an expected call of CreateStorageNetworkIpRangeWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) CreateStorageNetworkIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStorageNetworkIpRangeWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).CreateStorageNetworkIpRangeWithContext), ctx, p)
+}
+
 // DedicatePublicIpRange mocks base method.
 func (m *MockNetworkServiceIface) DedicatePublicIpRange(p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +495,21 @@ func (mr *MockNetworkServiceIfaceMockRecorder) DedicatePublicIpRange(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePublicIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).DedicatePublicIpRange), p)
 }
 
+// DedicatePublicIpRangeWithContext mocks base method.
+func (m *MockNetworkServiceIface) DedicatePublicIpRangeWithContext(ctx context.Context, p *DedicatePublicIpRangeParams) (*DedicatePublicIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicatePublicIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicatePublicIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicatePublicIpRangeWithContext indicates an expected call of DedicatePublicIpRangeWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DedicatePublicIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePublicIpRangeWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DedicatePublicIpRangeWithContext), ctx, p)
+}
+
 // DeleteGuestNetworkIpv6Prefix mocks base method.
 func (m *MockNetworkServiceIface) DeleteGuestNetworkIpv6Prefix(p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +525,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) DeleteGuestNetworkIpv6Prefix(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuestNetworkIpv6Prefix", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteGuestNetworkIpv6Prefix), p)
 }
 
+// DeleteGuestNetworkIpv6PrefixAsync mocks base method.
+func (m *MockNetworkServiceIface) DeleteGuestNetworkIpv6PrefixAsync(ctx context.Context, p *DeleteGuestNetworkIpv6PrefixParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGuestNetworkIpv6PrefixAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGuestNetworkIpv6PrefixAsync indicates an expected call of DeleteGuestNetworkIpv6PrefixAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteGuestNetworkIpv6PrefixAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuestNetworkIpv6PrefixAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteGuestNetworkIpv6PrefixAsync), ctx, p)
+}
+
+// DeleteGuestNetworkIpv6PrefixWithContext mocks base method.
+func (m *MockNetworkServiceIface) DeleteGuestNetworkIpv6PrefixWithContext(ctx context.Context, p *DeleteGuestNetworkIpv6PrefixParams) (*DeleteGuestNetworkIpv6PrefixResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteGuestNetworkIpv6PrefixWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteGuestNetworkIpv6PrefixResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteGuestNetworkIpv6PrefixWithContext indicates an expected call of DeleteGuestNetworkIpv6PrefixWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteGuestNetworkIpv6PrefixWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteGuestNetworkIpv6PrefixWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteGuestNetworkIpv6PrefixWithContext), ctx, p)
+}
+
 // DeleteIpv4SubnetForGuestNetwork mocks base method.
 func (m *MockNetworkServiceIface) DeleteIpv4SubnetForGuestNetwork(p *DeleteIpv4SubnetForGuestNetworkParams) (*DeleteIpv4SubnetForGuestNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -253,6 +570,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) DeleteIpv4SubnetForGuestNetwork(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv4SubnetForGuestNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteIpv4SubnetForGuestNetwork), p)
 }
 
+// DeleteIpv4SubnetForGuestNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) DeleteIpv4SubnetForGuestNetworkAsync(ctx context.Context, p *DeleteIpv4SubnetForGuestNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv4SubnetForGuestNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv4SubnetForGuestNetworkAsync indicates an expected call of DeleteIpv4SubnetForGuestNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteIpv4SubnetForGuestNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv4SubnetForGuestNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteIpv4SubnetForGuestNetworkAsync), ctx, p)
+}
+
+// DeleteIpv4SubnetForGuestNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) DeleteIpv4SubnetForGuestNetworkWithContext(ctx context.Context, p *DeleteIpv4SubnetForGuestNetworkParams) (*DeleteIpv4SubnetForGuestNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv4SubnetForGuestNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteIpv4SubnetForGuestNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv4SubnetForGuestNetworkWithContext indicates an expected call of DeleteIpv4SubnetForGuestNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteIpv4SubnetForGuestNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv4SubnetForGuestNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteIpv4SubnetForGuestNetworkWithContext), ctx, p)
+}
+
 // DeleteNetwork mocks base method.
 func (m *MockNetworkServiceIface) DeleteNetwork(p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -268,6 +615,21 @@ func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetwork(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetwork), p)
 }
 
+// DeleteNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) DeleteNetworkAsync(ctx context.Context, p *DeleteNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkAsync indicates an expected call of DeleteNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetworkAsync), ctx, p)
+}
+
 // DeleteNetworkServiceProvider mocks base method.
 func (m *MockNetworkServiceIface) DeleteNetworkServiceProvider(p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +645,51 @@ func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetworkServiceProvider(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetworkServiceProvider), p)
 }
 
+// DeleteNetworkServiceProviderAsync mocks base method.
+func (m *MockNetworkServiceIface) DeleteNetworkServiceProviderAsync(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkServiceProviderAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkServiceProviderAsync indicates an expected call of DeleteNetworkServiceProviderAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetworkServiceProviderAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkServiceProviderAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetworkServiceProviderAsync), ctx, p)
+}
+
+// DeleteNetworkServiceProviderWithContext mocks base method.
+func (m *MockNetworkServiceIface) DeleteNetworkServiceProviderWithContext(ctx context.Context, p *DeleteNetworkServiceProviderParams) (*DeleteNetworkServiceProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkServiceProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetworkServiceProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkServiceProviderWithContext indicates an expected call of DeleteNetworkServiceProviderWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetworkServiceProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkServiceProviderWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetworkServiceProviderWithContext), ctx, p)
+}
+
+// DeleteNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) DeleteNetworkWithContext(ctx context.Context, p *DeleteNetworkParams) (*DeleteNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNetworkWithContext indicates an expected call of DeleteNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteNetworkWithContext), ctx, p)
+}
+
 // DeleteOpenDaylightController mocks base method.
 func (m *MockNetworkServiceIface) DeleteOpenDaylightController(p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
 	m.ctrl.T.Helper()
@@ -298,6 +705,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) DeleteOpenDaylightController(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenDaylightController", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteOpenDaylightController), p)
 }
 
+// DeleteOpenDaylightControllerAsync mocks base method.
+func (m *MockNetworkServiceIface) DeleteOpenDaylightControllerAsync(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOpenDaylightControllerAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOpenDaylightControllerAsync indicates an expected call of DeleteOpenDaylightControllerAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteOpenDaylightControllerAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenDaylightControllerAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteOpenDaylightControllerAsync), ctx, p)
+}
+
+// DeleteOpenDaylightControllerWithContext mocks base method.
+func (m *MockNetworkServiceIface) DeleteOpenDaylightControllerWithContext(ctx context.Context, p *DeleteOpenDaylightControllerParams) (*DeleteOpenDaylightControllerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOpenDaylightControllerWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteOpenDaylightControllerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOpenDaylightControllerWithContext indicates an expected call of DeleteOpenDaylightControllerWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteOpenDaylightControllerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOpenDaylightControllerWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteOpenDaylightControllerWithContext), ctx, p)
+}
+
 // DeletePhysicalNetwork mocks base method.
 func (m *MockNetworkServiceIface) DeletePhysicalNetwork(p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -313,22 +750,82 @@ func (mr *MockNetworkServiceIfaceMockRecorder) DeletePhysicalNetwork(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeletePhysicalNetwork), p)
 }
 
-// DeleteStorageNetworkIpRange mocks base method.
-func (m *MockNetworkServiceIface) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+// DeletePhysicalNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) DeletePhysicalNetworkAsync(ctx context.Context, p *DeletePhysicalNetworkParams) (*Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "DeleteStorageNetworkIpRange", p)
-	ret0, _ := ret[0].(*DeleteStorageNetworkIpRangeResponse)
+	ret := m.ctrl.Call(m, "DeletePhysicalNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// DeleteStorageNetworkIpRange indicates an expected call of DeleteStorageNetworkIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) DeleteStorageNetworkIpRange(p any) *gomock.Call {
+// DeletePhysicalNetworkAsync indicates an expected call of DeletePhysicalNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeletePhysicalNetworkAsync(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteStorageNetworkIpRange), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhysicalNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeletePhysicalNetworkAsync), ctx, p)
 }
 
-// GetGuestNetworkIpv6PrefixeByID mocks base method.
+// DeletePhysicalNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) DeletePhysicalNetworkWithContext(ctx context.Context, p *DeletePhysicalNetworkParams) (*DeletePhysicalNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePhysicalNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeletePhysicalNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePhysicalNetworkWithContext indicates an expected call of DeletePhysicalNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeletePhysicalNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePhysicalNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeletePhysicalNetworkWithContext), ctx, p)
+}
+
+// DeleteStorageNetworkIpRange mocks base method.
+func (m *MockNetworkServiceIface) DeleteStorageNetworkIpRange(p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageNetworkIpRange", p)
+	ret0, _ := ret[0].(*DeleteStorageNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStorageNetworkIpRange indicates an expected call of DeleteStorageNetworkIpRange.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteStorageNetworkIpRange(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteStorageNetworkIpRange), p)
+}
+
+// DeleteStorageNetworkIpRangeAsync mocks base method.
+func (m *MockNetworkServiceIface) DeleteStorageNetworkIpRangeAsync(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageNetworkIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStorageNetworkIpRangeAsync indicates an expected call of DeleteStorageNetworkIpRangeAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteStorageNetworkIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageNetworkIpRangeAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteStorageNetworkIpRangeAsync), ctx, p)
+}
+
+// DeleteStorageNetworkIpRangeWithContext mocks base method.
+func (m *MockNetworkServiceIface) DeleteStorageNetworkIpRangeWithContext(ctx context.Context, p *DeleteStorageNetworkIpRangeParams) (*DeleteStorageNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStorageNetworkIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteStorageNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStorageNetworkIpRangeWithContext indicates an expected call of DeleteStorageNetworkIpRangeWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) DeleteStorageNetworkIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStorageNetworkIpRangeWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).DeleteStorageNetworkIpRangeWithContext), ctx, p)
+}
+
+// GetGuestNetworkIpv6PrefixeByID mocks base method.
 func (m *MockNetworkServiceIface) GetGuestNetworkIpv6PrefixeByID(id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error) {
 	m.ctrl.T.Helper()
 	varargs := []any{id}
@@ -349,6 +846,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetGuestNetworkIpv6PrefixeByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestNetworkIpv6PrefixeByID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetGuestNetworkIpv6PrefixeByID), varargs...)
 }
 
+// GetGuestNetworkIpv6PrefixeByIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetGuestNetworkIpv6PrefixeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*GuestNetworkIpv6Prefixe, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetGuestNetworkIpv6PrefixeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*GuestNetworkIpv6Prefixe)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetGuestNetworkIpv6PrefixeByIDWithContext indicates an expected call of GetGuestNetworkIpv6PrefixeByIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetGuestNetworkIpv6PrefixeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGuestNetworkIpv6PrefixeByIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetGuestNetworkIpv6PrefixeByIDWithContext), varargs...)
+}
+
 // GetIpv4SubnetsForGuestNetworkByID mocks base method.
 func (m *MockNetworkServiceIface) GetIpv4SubnetsForGuestNetworkByID(id string, opts ...OptionFunc) (*Ipv4SubnetsForGuestNetwork, int, error) {
 	m.ctrl.T.Helper()
@@ -370,6 +888,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetIpv4SubnetsForGuestNetworkByID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv4SubnetsForGuestNetworkByID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetIpv4SubnetsForGuestNetworkByID), varargs...)
 }
 
+// GetIpv4SubnetsForGuestNetworkByIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetIpv4SubnetsForGuestNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv4SubnetsForGuestNetwork, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIpv4SubnetsForGuestNetworkByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Ipv4SubnetsForGuestNetwork)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIpv4SubnetsForGuestNetworkByIDWithContext indicates an expected call of GetIpv4SubnetsForGuestNetworkByIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetIpv4SubnetsForGuestNetworkByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv4SubnetsForGuestNetworkByIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetIpv4SubnetsForGuestNetworkByIDWithContext), varargs...)
+}
+
 // GetNetworkByID mocks base method.
 func (m *MockNetworkServiceIface) GetNetworkByID(id string, opts ...OptionFunc) (*Network, int, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +930,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkByID), varargs...)
 }
 
+// GetNetworkByIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Network, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Network)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkByIDWithContext indicates an expected call of GetNetworkByIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkByIDWithContext), varargs...)
+}
+
 // GetNetworkByName mocks base method.
 func (m *MockNetworkServiceIface) GetNetworkByName(name string, opts ...OptionFunc) (*Network, int, error) {
 	m.ctrl.T.Helper()
@@ -412,6 +972,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkByName(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByName", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkByName), varargs...)
 }
 
+// GetNetworkByNameWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Network, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Network)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkByNameWithContext indicates an expected call of GetNetworkByNameWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkByNameWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkByNameWithContext), varargs...)
+}
+
 // GetNetworkID mocks base method.
 func (m *MockNetworkServiceIface) GetNetworkID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -433,6 +1014,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkID(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkID), varargs...)
 }
 
+// GetNetworkIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkIDWithContext indicates an expected call of GetNetworkIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkIDWithContext), varargs...)
+}
+
 // GetNetworkServiceProviderID mocks base method.
 func (m *MockNetworkServiceIface) GetNetworkServiceProviderID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +1056,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkServiceProviderID(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkServiceProviderID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkServiceProviderID), varargs...)
 }
 
+// GetNetworkServiceProviderIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetNetworkServiceProviderIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNetworkServiceProviderIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNetworkServiceProviderIDWithContext indicates an expected call of GetNetworkServiceProviderIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetNetworkServiceProviderIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNetworkServiceProviderIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNetworkServiceProviderIDWithContext), varargs...)
+}
+
 // GetNiciraNvpDeviceNetworkID mocks base method.
 func (m *MockNetworkServiceIface) GetNiciraNvpDeviceNetworkID(keyword, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -475,6 +1098,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetNiciraNvpDeviceNetworkID(keywo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNiciraNvpDeviceNetworkID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNiciraNvpDeviceNetworkID), varargs...)
 }
 
+// GetNiciraNvpDeviceNetworkIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetNiciraNvpDeviceNetworkIDWithContext(ctx context.Context, keyword, nvpdeviceid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, nvpdeviceid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetNiciraNvpDeviceNetworkIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetNiciraNvpDeviceNetworkIDWithContext indicates an expected call of GetNiciraNvpDeviceNetworkIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetNiciraNvpDeviceNetworkIDWithContext(ctx, keyword, nvpdeviceid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, nvpdeviceid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNiciraNvpDeviceNetworkIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetNiciraNvpDeviceNetworkIDWithContext), varargs...)
+}
+
 // GetOpenDaylightControllerByID mocks base method.
 func (m *MockNetworkServiceIface) GetOpenDaylightControllerByID(id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
 	m.ctrl.T.Helper()
@@ -496,6 +1140,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetOpenDaylightControllerByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenDaylightControllerByID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetOpenDaylightControllerByID), varargs...)
 }
 
+// GetOpenDaylightControllerByIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetOpenDaylightControllerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OpenDaylightController, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOpenDaylightControllerByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*OpenDaylightController)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOpenDaylightControllerByIDWithContext indicates an expected call of GetOpenDaylightControllerByIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetOpenDaylightControllerByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenDaylightControllerByIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetOpenDaylightControllerByIDWithContext), varargs...)
+}
+
 // GetPaloAltoFirewallNetworkID mocks base method.
 func (m *MockNetworkServiceIface) GetPaloAltoFirewallNetworkID(keyword, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -517,6 +1182,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetPaloAltoFirewallNetworkID(keyw
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaloAltoFirewallNetworkID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPaloAltoFirewallNetworkID), varargs...)
 }
 
+// GetPaloAltoFirewallNetworkIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetPaloAltoFirewallNetworkIDWithContext(ctx context.Context, keyword, lbdeviceid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, lbdeviceid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPaloAltoFirewallNetworkIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPaloAltoFirewallNetworkIDWithContext indicates an expected call of GetPaloAltoFirewallNetworkIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetPaloAltoFirewallNetworkIDWithContext(ctx, keyword, lbdeviceid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, lbdeviceid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPaloAltoFirewallNetworkIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPaloAltoFirewallNetworkIDWithContext), varargs...)
+}
+
 // GetPhysicalNetworkByID mocks base method.
 func (m *MockNetworkServiceIface) GetPhysicalNetworkByID(id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
 	m.ctrl.T.Helper()
@@ -538,6 +1224,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetPhysicalNetworkByID(id any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalNetworkByID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPhysicalNetworkByID), varargs...)
 }
 
+// GetPhysicalNetworkByIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetPhysicalNetworkByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPhysicalNetworkByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*PhysicalNetwork)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPhysicalNetworkByIDWithContext indicates an expected call of GetPhysicalNetworkByIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetPhysicalNetworkByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalNetworkByIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPhysicalNetworkByIDWithContext), varargs...)
+}
+
 // GetPhysicalNetworkByName mocks base method.
 func (m *MockNetworkServiceIface) GetPhysicalNetworkByName(name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
 	m.ctrl.T.Helper()
@@ -559,6 +1266,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetPhysicalNetworkByName(name any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalNetworkByName", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPhysicalNetworkByName), varargs...)
 }
 
+// GetPhysicalNetworkByNameWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetPhysicalNetworkByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*PhysicalNetwork, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPhysicalNetworkByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*PhysicalNetwork)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPhysicalNetworkByNameWithContext indicates an expected call of GetPhysicalNetworkByNameWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetPhysicalNetworkByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalNetworkByNameWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPhysicalNetworkByNameWithContext), varargs...)
+}
+
 // GetPhysicalNetworkID mocks base method.
 func (m *MockNetworkServiceIface) GetPhysicalNetworkID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -580,6 +1308,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetPhysicalNetworkID(name any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalNetworkID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPhysicalNetworkID), varargs...)
 }
 
+// GetPhysicalNetworkIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetPhysicalNetworkIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPhysicalNetworkIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPhysicalNetworkIDWithContext indicates an expected call of GetPhysicalNetworkIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetPhysicalNetworkIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPhysicalNetworkIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetPhysicalNetworkIDWithContext), varargs...)
+}
+
 // GetStorageNetworkIpRangeByID mocks base method.
 func (m *MockNetworkServiceIface) GetStorageNetworkIpRangeByID(id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
 	m.ctrl.T.Helper()
@@ -601,6 +1350,27 @@ func (mr *MockNetworkServiceIfaceMockRecorder) GetStorageNetworkIpRangeByID(id a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageNetworkIpRangeByID", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetStorageNetworkIpRangeByID), varargs...)
 }
 
+// GetStorageNetworkIpRangeByIDWithContext mocks base method.
+func (m *MockNetworkServiceIface) GetStorageNetworkIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StorageNetworkIpRange, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStorageNetworkIpRangeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*StorageNetworkIpRange)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStorageNetworkIpRangeByIDWithContext indicates an expected call of GetStorageNetworkIpRangeByIDWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) GetStorageNetworkIpRangeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageNetworkIpRangeByIDWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).GetStorageNetworkIpRangeByIDWithContext), varargs...)
+}
+
 // ListGuestNetworkIpv6Prefixes mocks base method.
 func (m *MockNetworkServiceIface) ListGuestNetworkIpv6Prefixes(p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error) {
 	m.ctrl.T.Helper()
@@ -610,205 +1380,1210 @@ func (m *MockNetworkServiceIface) ListGuestNetworkIpv6Prefixes(p *ListGuestNetwo
 	return ret0, ret1
 }
 
-// ListGuestNetworkIpv6Prefixes indicates an expected call of ListGuestNetworkIpv6Prefixes.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6Prefixes(p any) *gomock.Call {
+// ListGuestNetworkIpv6Prefixes indicates an expected call of ListGuestNetworkIpv6Prefixes.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6Prefixes(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6Prefixes", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6Prefixes), p)
+}
+
+// ListGuestNetworkIpv6PrefixesAll mocks base method.
+func (m *MockNetworkServiceIface) ListGuestNetworkIpv6PrefixesAll(p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) ([]*GuestNetworkIpv6Prefixe, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestNetworkIpv6PrefixesAll", varargs...)
+	ret0, _ := ret[0].([]*GuestNetworkIpv6Prefixe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestNetworkIpv6PrefixesAll indicates an expected call of ListGuestNetworkIpv6PrefixesAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6PrefixesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6PrefixesAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6PrefixesAll), varargs...)
+}
+
+// ListGuestNetworkIpv6PrefixesAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListGuestNetworkIpv6PrefixesAllWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) ([]*GuestNetworkIpv6Prefixe, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestNetworkIpv6PrefixesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*GuestNetworkIpv6Prefixe)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestNetworkIpv6PrefixesAllWithContext indicates an expected call of ListGuestNetworkIpv6PrefixesAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6PrefixesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6PrefixesAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6PrefixesAllWithContext), varargs...)
+}
+
+// ListGuestNetworkIpv6PrefixesIter mocks base method.
+func (m *MockNetworkServiceIface) ListGuestNetworkIpv6PrefixesIter(p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) iter.Seq2[*GuestNetworkIpv6Prefixe, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestNetworkIpv6PrefixesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GuestNetworkIpv6Prefixe, error])
+	return ret0
+}
+
+// ListGuestNetworkIpv6PrefixesIter indicates an expected call of ListGuestNetworkIpv6PrefixesIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6PrefixesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6PrefixesIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6PrefixesIter), varargs...)
+}
+
+// ListGuestNetworkIpv6PrefixesIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListGuestNetworkIpv6PrefixesIterWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams, opts ...PageOption) iter.Seq2[*GuestNetworkIpv6Prefixe, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestNetworkIpv6PrefixesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GuestNetworkIpv6Prefixe, error])
+	return ret0
+}
+
+// ListGuestNetworkIpv6PrefixesIterWithContext indicates an expected call of ListGuestNetworkIpv6PrefixesIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6PrefixesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6PrefixesIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6PrefixesIterWithContext), varargs...)
+}
+
+// ListGuestNetworkIpv6PrefixesWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListGuestNetworkIpv6PrefixesWithContext(ctx context.Context, p *ListGuestNetworkIpv6PrefixesParams) (*ListGuestNetworkIpv6PrefixesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuestNetworkIpv6PrefixesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListGuestNetworkIpv6PrefixesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestNetworkIpv6PrefixesWithContext indicates an expected call of ListGuestNetworkIpv6PrefixesWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListGuestNetworkIpv6PrefixesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6PrefixesWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6PrefixesWithContext), ctx, p)
+}
+
+// ListIpv4SubnetsForGuestNetwork mocks base method.
+func (m *MockNetworkServiceIface) ListIpv4SubnetsForGuestNetwork(p *ListIpv4SubnetsForGuestNetworkParams) (*ListIpv4SubnetsForGuestNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForGuestNetwork", p)
+	ret0, _ := ret[0].(*ListIpv4SubnetsForGuestNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForGuestNetwork indicates an expected call of ListIpv4SubnetsForGuestNetwork.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListIpv4SubnetsForGuestNetwork(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForGuestNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListIpv4SubnetsForGuestNetwork), p)
+}
+
+// ListIpv4SubnetsForGuestNetworkAll mocks base method.
+func (m *MockNetworkServiceIface) ListIpv4SubnetsForGuestNetworkAll(p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) ([]*Ipv4SubnetsForGuestNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForGuestNetworkAll", varargs...)
+	ret0, _ := ret[0].([]*Ipv4SubnetsForGuestNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForGuestNetworkAll indicates an expected call of ListIpv4SubnetsForGuestNetworkAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListIpv4SubnetsForGuestNetworkAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForGuestNetworkAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListIpv4SubnetsForGuestNetworkAll), varargs...)
+}
+
+// ListIpv4SubnetsForGuestNetworkAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListIpv4SubnetsForGuestNetworkAllWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) ([]*Ipv4SubnetsForGuestNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForGuestNetworkAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Ipv4SubnetsForGuestNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForGuestNetworkAllWithContext indicates an expected call of ListIpv4SubnetsForGuestNetworkAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListIpv4SubnetsForGuestNetworkAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForGuestNetworkAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListIpv4SubnetsForGuestNetworkAllWithContext), varargs...)
+}
+
+// ListIpv4SubnetsForGuestNetworkIter mocks base method.
+func (m *MockNetworkServiceIface) ListIpv4SubnetsForGuestNetworkIter(p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForGuestNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForGuestNetworkIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Ipv4SubnetsForGuestNetwork, error])
+	return ret0
+}
+
+// ListIpv4SubnetsForGuestNetworkIter indicates an expected call of ListIpv4SubnetsForGuestNetworkIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListIpv4SubnetsForGuestNetworkIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForGuestNetworkIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListIpv4SubnetsForGuestNetworkIter), varargs...)
+}
+
+// ListIpv4SubnetsForGuestNetworkIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListIpv4SubnetsForGuestNetworkIterWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForGuestNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForGuestNetworkIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Ipv4SubnetsForGuestNetwork, error])
+	return ret0
+}
+
+// ListIpv4SubnetsForGuestNetworkIterWithContext indicates an expected call of ListIpv4SubnetsForGuestNetworkIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListIpv4SubnetsForGuestNetworkIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForGuestNetworkIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListIpv4SubnetsForGuestNetworkIterWithContext), varargs...)
+}
+
+// ListIpv4SubnetsForGuestNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListIpv4SubnetsForGuestNetworkWithContext(ctx context.Context, p *ListIpv4SubnetsForGuestNetworkParams) (*ListIpv4SubnetsForGuestNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForGuestNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListIpv4SubnetsForGuestNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForGuestNetworkWithContext indicates an expected call of ListIpv4SubnetsForGuestNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListIpv4SubnetsForGuestNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForGuestNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListIpv4SubnetsForGuestNetworkWithContext), ctx, p)
+}
+
+// ListNetworkIsolationMethods mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkIsolationMethods", p)
+	ret0, _ := ret[0].(*ListNetworkIsolationMethodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkIsolationMethods indicates an expected call of ListNetworkIsolationMethods.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethods(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethods", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethods), p)
+}
+
+// ListNetworkIsolationMethodsAll mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkIsolationMethodsAll(p *ListNetworkIsolationMethodsParams, opts ...PageOption) ([]*NetworkIsolationMethod, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkIsolationMethodsAll", varargs...)
+	ret0, _ := ret[0].([]*NetworkIsolationMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkIsolationMethodsAll indicates an expected call of ListNetworkIsolationMethodsAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethodsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethodsAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethodsAll), varargs...)
+}
+
+// ListNetworkIsolationMethodsAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkIsolationMethodsAllWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams, opts ...PageOption) ([]*NetworkIsolationMethod, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkIsolationMethodsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetworkIsolationMethod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkIsolationMethodsAllWithContext indicates an expected call of ListNetworkIsolationMethodsAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethodsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethodsAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethodsAllWithContext), varargs...)
+}
+
+// ListNetworkIsolationMethodsIter mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkIsolationMethodsIter(p *ListNetworkIsolationMethodsParams, opts ...PageOption) iter.Seq2[*NetworkIsolationMethod, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkIsolationMethodsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkIsolationMethod, error])
+	return ret0
+}
+
+// ListNetworkIsolationMethodsIter indicates an expected call of ListNetworkIsolationMethodsIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethodsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethodsIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethodsIter), varargs...)
+}
+
+// ListNetworkIsolationMethodsIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkIsolationMethodsIterWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams, opts ...PageOption) iter.Seq2[*NetworkIsolationMethod, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkIsolationMethodsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkIsolationMethod, error])
+	return ret0
+}
+
+// ListNetworkIsolationMethodsIterWithContext indicates an expected call of ListNetworkIsolationMethodsIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethodsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethodsIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethodsIterWithContext), varargs...)
+}
+
+// ListNetworkIsolationMethodsWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkIsolationMethodsWithContext(ctx context.Context, p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkIsolationMethodsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkIsolationMethodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkIsolationMethodsWithContext indicates an expected call of ListNetworkIsolationMethodsWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethodsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethodsWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethodsWithContext), ctx, p)
+}
+
+// ListNetworkPermissions mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkPermissions(p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkPermissions", p)
+	ret0, _ := ret[0].(*ListNetworkPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkPermissions indicates an expected call of ListNetworkPermissions.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkPermissions(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkPermissions), p)
+}
+
+// ListNetworkPermissionsWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkPermissionsWithContext(ctx context.Context, p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkPermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkPermissionsWithContext indicates an expected call of ListNetworkPermissionsWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkPermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkPermissionsWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkPermissionsWithContext), ctx, p)
+}
+
+// ListNetworkProtocols mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkProtocols(p *ListNetworkProtocolsParams) (*ListNetworkProtocolsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkProtocols", p)
+	ret0, _ := ret[0].(*ListNetworkProtocolsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkProtocols indicates an expected call of ListNetworkProtocols.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkProtocols(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkProtocols", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkProtocols), p)
+}
+
+// ListNetworkProtocolsWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkProtocolsWithContext(ctx context.Context, p *ListNetworkProtocolsParams) (*ListNetworkProtocolsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkProtocolsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkProtocolsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkProtocolsWithContext indicates an expected call of ListNetworkProtocolsWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkProtocolsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkProtocolsWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkProtocolsWithContext), ctx, p)
+}
+
+// ListNetworkServiceProviders mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkServiceProviders", p)
+	ret0, _ := ret[0].(*ListNetworkServiceProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkServiceProviders indicates an expected call of ListNetworkServiceProviders.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProviders(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProviders", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProviders), p)
+}
+
+// ListNetworkServiceProvidersAll mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkServiceProvidersAll(p *ListNetworkServiceProvidersParams, opts ...PageOption) ([]*NetworkServiceProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkServiceProvidersAll", varargs...)
+	ret0, _ := ret[0].([]*NetworkServiceProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkServiceProvidersAll indicates an expected call of ListNetworkServiceProvidersAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProvidersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProvidersAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProvidersAll), varargs...)
+}
+
+// ListNetworkServiceProvidersAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkServiceProvidersAllWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams, opts ...PageOption) ([]*NetworkServiceProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkServiceProvidersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NetworkServiceProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkServiceProvidersAllWithContext indicates an expected call of ListNetworkServiceProvidersAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProvidersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProvidersAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProvidersAllWithContext), varargs...)
+}
+
+// ListNetworkServiceProvidersIter mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkServiceProvidersIter(p *ListNetworkServiceProvidersParams, opts ...PageOption) iter.Seq2[*NetworkServiceProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkServiceProvidersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkServiceProvider, error])
+	return ret0
+}
+
+// ListNetworkServiceProvidersIter indicates an expected call of ListNetworkServiceProvidersIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProvidersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProvidersIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProvidersIter), varargs...)
+}
+
+// ListNetworkServiceProvidersIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkServiceProvidersIterWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams, opts ...PageOption) iter.Seq2[*NetworkServiceProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworkServiceProvidersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NetworkServiceProvider, error])
+	return ret0
+}
+
+// ListNetworkServiceProvidersIterWithContext indicates an expected call of ListNetworkServiceProvidersIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProvidersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProvidersIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProvidersIterWithContext), varargs...)
+}
+
+// ListNetworkServiceProvidersWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworkServiceProvidersWithContext(ctx context.Context, p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworkServiceProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworkServiceProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworkServiceProvidersWithContext indicates an expected call of ListNetworkServiceProvidersWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProvidersWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProvidersWithContext), ctx, p)
+}
+
+// ListNetworks mocks base method.
+func (m *MockNetworkServiceIface) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworks", p)
+	ret0, _ := ret[0].(*ListNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworks indicates an expected call of ListNetworks.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworks(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworks), p)
+}
+
+// ListNetworksAll mocks base method.
+func (m *MockNetworkServiceIface) ListNetworksAll(p *ListNetworksParams, opts ...PageOption) ([]*Network, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworksAll", varargs...)
+	ret0, _ := ret[0].([]*Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworksAll indicates an expected call of ListNetworksAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworksAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworksAll), varargs...)
+}
+
+// ListNetworksAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworksAllWithContext(ctx context.Context, p *ListNetworksParams, opts ...PageOption) ([]*Network, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Network)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworksAllWithContext indicates an expected call of ListNetworksAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworksAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworksAllWithContext), varargs...)
+}
+
+// ListNetworksIter mocks base method.
+func (m *MockNetworkServiceIface) ListNetworksIter(p *ListNetworksParams, opts ...PageOption) iter.Seq2[*Network, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Network, error])
+	return ret0
+}
+
+// ListNetworksIter indicates an expected call of ListNetworksIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworksIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworksIter), varargs...)
+}
+
+// ListNetworksIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworksIterWithContext(ctx context.Context, p *ListNetworksParams, opts ...PageOption) iter.Seq2[*Network, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNetworksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Network, error])
+	return ret0
+}
+
+// ListNetworksIterWithContext indicates an expected call of ListNetworksIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworksIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworksIterWithContext), varargs...)
+}
+
+// ListNetworksWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNetworksWithContext(ctx context.Context, p *ListNetworksParams) (*ListNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNetworksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNetworksWithContext indicates an expected call of ListNetworksWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworksWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworksWithContext), ctx, p)
+}
+
+// ListNiciraNvpDeviceNetworks mocks base method.
+func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworks", p)
+	ret0, _ := ret[0].(*ListNiciraNvpDeviceNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNiciraNvpDeviceNetworks indicates an expected call of ListNiciraNvpDeviceNetworks.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworks(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworks), p)
+}
+
+// ListNiciraNvpDeviceNetworksAll mocks base method.
+func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworksAll(p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) ([]*NiciraNvpDeviceNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworksAll", varargs...)
+	ret0, _ := ret[0].([]*NiciraNvpDeviceNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNiciraNvpDeviceNetworksAll indicates an expected call of ListNiciraNvpDeviceNetworksAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworksAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworksAll), varargs...)
+}
+
+// ListNiciraNvpDeviceNetworksAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworksAllWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) ([]*NiciraNvpDeviceNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NiciraNvpDeviceNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNiciraNvpDeviceNetworksAllWithContext indicates an expected call of ListNiciraNvpDeviceNetworksAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworksAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworksAllWithContext), varargs...)
+}
+
+// ListNiciraNvpDeviceNetworksIter mocks base method.
+func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworksIter(p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) iter.Seq2[*NiciraNvpDeviceNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NiciraNvpDeviceNetwork, error])
+	return ret0
+}
+
+// ListNiciraNvpDeviceNetworksIter indicates an expected call of ListNiciraNvpDeviceNetworksIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworksIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworksIter), varargs...)
+}
+
+// ListNiciraNvpDeviceNetworksIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworksIterWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams, opts ...PageOption) iter.Seq2[*NiciraNvpDeviceNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NiciraNvpDeviceNetwork, error])
+	return ret0
+}
+
+// ListNiciraNvpDeviceNetworksIterWithContext indicates an expected call of ListNiciraNvpDeviceNetworksIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworksIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworksIterWithContext), varargs...)
+}
+
+// ListNiciraNvpDeviceNetworksWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworksWithContext(ctx context.Context, p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNiciraNvpDeviceNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNiciraNvpDeviceNetworksWithContext indicates an expected call of ListNiciraNvpDeviceNetworksWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworksWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworksWithContext), ctx, p)
+}
+
+// ListOpenDaylightControllers mocks base method.
+func (m *MockNetworkServiceIface) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenDaylightControllers", p)
+	ret0, _ := ret[0].(*ListOpenDaylightControllersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenDaylightControllers indicates an expected call of ListOpenDaylightControllers.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListOpenDaylightControllers(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenDaylightControllers", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListOpenDaylightControllers), p)
+}
+
+// ListOpenDaylightControllersWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListOpenDaylightControllersWithContext(ctx context.Context, p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOpenDaylightControllersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListOpenDaylightControllersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOpenDaylightControllersWithContext indicates an expected call of ListOpenDaylightControllersWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListOpenDaylightControllersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenDaylightControllersWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListOpenDaylightControllersWithContext), ctx, p)
+}
+
+// ListPaloAltoFirewallNetworks mocks base method.
+func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworks", p)
+	ret0, _ := ret[0].(*ListPaloAltoFirewallNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaloAltoFirewallNetworks indicates an expected call of ListPaloAltoFirewallNetworks.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworks(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworks), p)
+}
+
+// ListPaloAltoFirewallNetworksAll mocks base method.
+func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworksAll(p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) ([]*PaloAltoFirewallNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworksAll", varargs...)
+	ret0, _ := ret[0].([]*PaloAltoFirewallNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaloAltoFirewallNetworksAll indicates an expected call of ListPaloAltoFirewallNetworksAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworksAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworksAll), varargs...)
+}
+
+// ListPaloAltoFirewallNetworksAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworksAllWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) ([]*PaloAltoFirewallNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*PaloAltoFirewallNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaloAltoFirewallNetworksAllWithContext indicates an expected call of ListPaloAltoFirewallNetworksAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworksAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworksAllWithContext), varargs...)
+}
+
+// ListPaloAltoFirewallNetworksIter mocks base method.
+func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworksIter(p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewallNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PaloAltoFirewallNetwork, error])
+	return ret0
+}
+
+// ListPaloAltoFirewallNetworksIter indicates an expected call of ListPaloAltoFirewallNetworksIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworksIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworksIter), varargs...)
+}
+
+// ListPaloAltoFirewallNetworksIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworksIterWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams, opts ...PageOption) iter.Seq2[*PaloAltoFirewallNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PaloAltoFirewallNetwork, error])
+	return ret0
+}
+
+// ListPaloAltoFirewallNetworksIterWithContext indicates an expected call of ListPaloAltoFirewallNetworksIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworksIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworksIterWithContext), varargs...)
+}
+
+// ListPaloAltoFirewallNetworksWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworksWithContext(ctx context.Context, p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPaloAltoFirewallNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPaloAltoFirewallNetworksWithContext indicates an expected call of ListPaloAltoFirewallNetworksWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworksWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworksWithContext), ctx, p)
+}
+
+// ListPhysicalNetworks mocks base method.
+func (m *MockNetworkServiceIface) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPhysicalNetworks", p)
+	ret0, _ := ret[0].(*ListPhysicalNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPhysicalNetworks indicates an expected call of ListPhysicalNetworks.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworks(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworks), p)
+}
+
+// ListPhysicalNetworksAll mocks base method.
+func (m *MockNetworkServiceIface) ListPhysicalNetworksAll(p *ListPhysicalNetworksParams, opts ...PageOption) ([]*PhysicalNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPhysicalNetworksAll", varargs...)
+	ret0, _ := ret[0].([]*PhysicalNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPhysicalNetworksAll indicates an expected call of ListPhysicalNetworksAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworksAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworksAll), varargs...)
+}
+
+// ListPhysicalNetworksAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListPhysicalNetworksAllWithContext(ctx context.Context, p *ListPhysicalNetworksParams, opts ...PageOption) ([]*PhysicalNetwork, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPhysicalNetworksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*PhysicalNetwork)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPhysicalNetworksAllWithContext indicates an expected call of ListPhysicalNetworksAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworksAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworksAllWithContext), varargs...)
+}
+
+// ListPhysicalNetworksIter mocks base method.
+func (m *MockNetworkServiceIface) ListPhysicalNetworksIter(p *ListPhysicalNetworksParams, opts ...PageOption) iter.Seq2[*PhysicalNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPhysicalNetworksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PhysicalNetwork, error])
+	return ret0
+}
+
+// ListPhysicalNetworksIter indicates an expected call of ListPhysicalNetworksIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworksIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworksIter), varargs...)
+}
+
+// ListPhysicalNetworksIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListPhysicalNetworksIterWithContext(ctx context.Context, p *ListPhysicalNetworksParams, opts ...PageOption) iter.Seq2[*PhysicalNetwork, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPhysicalNetworksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PhysicalNetwork, error])
+	return ret0
+}
+
+// ListPhysicalNetworksIterWithContext indicates an expected call of ListPhysicalNetworksIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworksIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworksIterWithContext), varargs...)
+}
+
+// ListPhysicalNetworksWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListPhysicalNetworksWithContext(ctx context.Context, p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPhysicalNetworksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPhysicalNetworksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPhysicalNetworksWithContext indicates an expected call of ListPhysicalNetworksWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworksWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworksWithContext), ctx, p)
+}
+
+// ListStorageNetworkIpRange mocks base method.
+func (m *MockNetworkServiceIface) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageNetworkIpRange", p)
+	ret0, _ := ret[0].(*ListStorageNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageNetworkIpRange indicates an expected call of ListStorageNetworkIpRange.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRange(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRange), p)
+}
+
+// ListStorageNetworkIpRangeAll mocks base method.
+func (m *MockNetworkServiceIface) ListStorageNetworkIpRangeAll(p *ListStorageNetworkIpRangeParams, opts ...PageOption) ([]*StorageNetworkIpRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageNetworkIpRangeAll", varargs...)
+	ret0, _ := ret[0].([]*StorageNetworkIpRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageNetworkIpRangeAll indicates an expected call of ListStorageNetworkIpRangeAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRangeAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestNetworkIpv6Prefixes", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListGuestNetworkIpv6Prefixes), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRangeAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRangeAll), varargs...)
 }
 
-// ListIpv4SubnetsForGuestNetwork mocks base method.
-func (m *MockNetworkServiceIface) ListIpv4SubnetsForGuestNetwork(p *ListIpv4SubnetsForGuestNetworkParams) (*ListIpv4SubnetsForGuestNetworkResponse, error) {
+// ListStorageNetworkIpRangeAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListStorageNetworkIpRangeAllWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams, opts ...PageOption) ([]*StorageNetworkIpRange, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIpv4SubnetsForGuestNetwork", p)
-	ret0, _ := ret[0].(*ListIpv4SubnetsForGuestNetworkResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageNetworkIpRangeAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StorageNetworkIpRange)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListIpv4SubnetsForGuestNetwork indicates an expected call of ListIpv4SubnetsForGuestNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListIpv4SubnetsForGuestNetwork(p any) *gomock.Call {
+// ListStorageNetworkIpRangeAllWithContext indicates an expected call of ListStorageNetworkIpRangeAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRangeAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForGuestNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListIpv4SubnetsForGuestNetwork), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRangeAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRangeAllWithContext), varargs...)
 }
 
-// ListNetworkIsolationMethods mocks base method.
-func (m *MockNetworkServiceIface) ListNetworkIsolationMethods(p *ListNetworkIsolationMethodsParams) (*ListNetworkIsolationMethodsResponse, error) {
+// ListStorageNetworkIpRangeIter mocks base method.
+func (m *MockNetworkServiceIface) ListStorageNetworkIpRangeIter(p *ListStorageNetworkIpRangeParams, opts ...PageOption) iter.Seq2[*StorageNetworkIpRange, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkIsolationMethods", p)
-	ret0, _ := ret[0].(*ListNetworkIsolationMethodsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageNetworkIpRangeIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageNetworkIpRange, error])
+	return ret0
 }
 
-// ListNetworkIsolationMethods indicates an expected call of ListNetworkIsolationMethods.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkIsolationMethods(p any) *gomock.Call {
+// ListStorageNetworkIpRangeIter indicates an expected call of ListStorageNetworkIpRangeIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRangeIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkIsolationMethods", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkIsolationMethods), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRangeIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRangeIter), varargs...)
 }
 
-// ListNetworkPermissions mocks base method.
-func (m *MockNetworkServiceIface) ListNetworkPermissions(p *ListNetworkPermissionsParams) (*ListNetworkPermissionsResponse, error) {
+// ListStorageNetworkIpRangeIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListStorageNetworkIpRangeIterWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams, opts ...PageOption) iter.Seq2[*StorageNetworkIpRange, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkPermissions", p)
-	ret0, _ := ret[0].(*ListNetworkPermissionsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageNetworkIpRangeIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageNetworkIpRange, error])
+	return ret0
 }
 
-// ListNetworkPermissions indicates an expected call of ListNetworkPermissions.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkPermissions(p any) *gomock.Call {
+// ListStorageNetworkIpRangeIterWithContext indicates an expected call of ListStorageNetworkIpRangeIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRangeIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkPermissions), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRangeIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRangeIterWithContext), varargs...)
 }
 
-// ListNetworkProtocols mocks base method.
-func (m *MockNetworkServiceIface) ListNetworkProtocols(p *ListNetworkProtocolsParams) (*ListNetworkProtocolsResponse, error) {
+// ListStorageNetworkIpRangeWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListStorageNetworkIpRangeWithContext(ctx context.Context, p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkProtocols", p)
-	ret0, _ := ret[0].(*ListNetworkProtocolsResponse)
+	ret := m.ctrl.Call(m, "ListStorageNetworkIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStorageNetworkIpRangeResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNetworkProtocols indicates an expected call of ListNetworkProtocols.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkProtocols(p any) *gomock.Call {
+// ListStorageNetworkIpRangeWithContext indicates an expected call of ListStorageNetworkIpRangeWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRangeWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkProtocols", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkProtocols), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRangeWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRangeWithContext), ctx, p)
 }
 
-// ListNetworkServiceProviders mocks base method.
-func (m *MockNetworkServiceIface) ListNetworkServiceProviders(p *ListNetworkServiceProvidersParams) (*ListNetworkServiceProvidersResponse, error) {
+// ListSupportedNetworkServices mocks base method.
+func (m *MockNetworkServiceIface) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworkServiceProviders", p)
-	ret0, _ := ret[0].(*ListNetworkServiceProvidersResponse)
+	ret := m.ctrl.Call(m, "ListSupportedNetworkServices", p)
+	ret0, _ := ret[0].(*ListSupportedNetworkServicesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNetworkServiceProviders indicates an expected call of ListNetworkServiceProviders.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworkServiceProviders(p any) *gomock.Call {
+// ListSupportedNetworkServices indicates an expected call of ListSupportedNetworkServices.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServices(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworkServiceProviders", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworkServiceProviders), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServices", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServices), p)
 }
 
-// ListNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListNetworks(p *ListNetworksParams) (*ListNetworksResponse, error) {
+// ListSupportedNetworkServicesAll mocks base method.
+func (m *MockNetworkServiceIface) ListSupportedNetworkServicesAll(p *ListSupportedNetworkServicesParams, opts ...PageOption) ([]*SupportedNetworkService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNetworks", p)
-	ret0, _ := ret[0].(*ListNetworksResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSupportedNetworkServicesAll", varargs...)
+	ret0, _ := ret[0].([]*SupportedNetworkService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNetworks indicates an expected call of ListNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNetworks(p any) *gomock.Call {
+// ListSupportedNetworkServicesAll indicates an expected call of ListSupportedNetworkServicesAll.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServicesAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNetworks), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServicesAll", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServicesAll), varargs...)
 }
 
-// ListNiciraNvpDeviceNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListNiciraNvpDeviceNetworks(p *ListNiciraNvpDeviceNetworksParams) (*ListNiciraNvpDeviceNetworksResponse, error) {
+// ListSupportedNetworkServicesAllWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListSupportedNetworkServicesAllWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams, opts ...PageOption) ([]*SupportedNetworkService, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListNiciraNvpDeviceNetworks", p)
-	ret0, _ := ret[0].(*ListNiciraNvpDeviceNetworksResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSupportedNetworkServicesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SupportedNetworkService)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListNiciraNvpDeviceNetworks indicates an expected call of ListNiciraNvpDeviceNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListNiciraNvpDeviceNetworks(p any) *gomock.Call {
+// ListSupportedNetworkServicesAllWithContext indicates an expected call of ListSupportedNetworkServicesAllWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServicesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDeviceNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListNiciraNvpDeviceNetworks), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServicesAllWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServicesAllWithContext), varargs...)
 }
 
-// ListOpenDaylightControllers mocks base method.
-func (m *MockNetworkServiceIface) ListOpenDaylightControllers(p *ListOpenDaylightControllersParams) (*ListOpenDaylightControllersResponse, error) {
+// ListSupportedNetworkServicesIter mocks base method.
+func (m *MockNetworkServiceIface) ListSupportedNetworkServicesIter(p *ListSupportedNetworkServicesParams, opts ...PageOption) iter.Seq2[*SupportedNetworkService, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListOpenDaylightControllers", p)
-	ret0, _ := ret[0].(*ListOpenDaylightControllersResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSupportedNetworkServicesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SupportedNetworkService, error])
+	return ret0
 }
 
-// ListOpenDaylightControllers indicates an expected call of ListOpenDaylightControllers.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListOpenDaylightControllers(p any) *gomock.Call {
+// ListSupportedNetworkServicesIter indicates an expected call of ListSupportedNetworkServicesIter.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServicesIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOpenDaylightControllers", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListOpenDaylightControllers), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServicesIter", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServicesIter), varargs...)
 }
 
-// ListPaloAltoFirewallNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListPaloAltoFirewallNetworks(p *ListPaloAltoFirewallNetworksParams) (*ListPaloAltoFirewallNetworksResponse, error) {
+// ListSupportedNetworkServicesIterWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListSupportedNetworkServicesIterWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams, opts ...PageOption) iter.Seq2[*SupportedNetworkService, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPaloAltoFirewallNetworks", p)
-	ret0, _ := ret[0].(*ListPaloAltoFirewallNetworksResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSupportedNetworkServicesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SupportedNetworkService, error])
+	return ret0
 }
 
-// ListPaloAltoFirewallNetworks indicates an expected call of ListPaloAltoFirewallNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListPaloAltoFirewallNetworks(p any) *gomock.Call {
+// ListSupportedNetworkServicesIterWithContext indicates an expected call of ListSupportedNetworkServicesIterWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServicesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPaloAltoFirewallNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPaloAltoFirewallNetworks), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServicesIterWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServicesIterWithContext), varargs...)
 }
 
-// ListPhysicalNetworks mocks base method.
-func (m *MockNetworkServiceIface) ListPhysicalNetworks(p *ListPhysicalNetworksParams) (*ListPhysicalNetworksResponse, error) {
+// ListSupportedNetworkServicesWithContext mocks base method.
+func (m *MockNetworkServiceIface) ListSupportedNetworkServicesWithContext(ctx context.Context, p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListPhysicalNetworks", p)
-	ret0, _ := ret[0].(*ListPhysicalNetworksResponse)
+	ret := m.ctrl.Call(m, "ListSupportedNetworkServicesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSupportedNetworkServicesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListPhysicalNetworks indicates an expected call of ListPhysicalNetworks.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListPhysicalNetworks(p any) *gomock.Call {
+// ListSupportedNetworkServicesWithContext indicates an expected call of ListSupportedNetworkServicesWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServicesWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPhysicalNetworks", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListPhysicalNetworks), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServicesWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServicesWithContext), ctx, p)
 }
 
-// ListStorageNetworkIpRange mocks base method.
-func (m *MockNetworkServiceIface) ListStorageNetworkIpRange(p *ListStorageNetworkIpRangeParams) (*ListStorageNetworkIpRangeResponse, error) {
+// MigrateNetwork mocks base method.
+func (m *MockNetworkServiceIface) MigrateNetwork(p *MigrateNetworkParams) (*MigrateNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListStorageNetworkIpRange", p)
-	ret0, _ := ret[0].(*ListStorageNetworkIpRangeResponse)
+	ret := m.ctrl.Call(m, "MigrateNetwork", p)
+	ret0, _ := ret[0].(*MigrateNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListStorageNetworkIpRange indicates an expected call of ListStorageNetworkIpRange.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListStorageNetworkIpRange(p any) *gomock.Call {
+// MigrateNetwork indicates an expected call of MigrateNetwork.
+func (mr *MockNetworkServiceIfaceMockRecorder) MigrateNetwork(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListStorageNetworkIpRange), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).MigrateNetwork), p)
 }
 
-// ListSupportedNetworkServices mocks base method.
-func (m *MockNetworkServiceIface) ListSupportedNetworkServices(p *ListSupportedNetworkServicesParams) (*ListSupportedNetworkServicesResponse, error) {
+// MigrateNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) MigrateNetworkAsync(ctx context.Context, p *MigrateNetworkParams) (*Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListSupportedNetworkServices", p)
-	ret0, _ := ret[0].(*ListSupportedNetworkServicesResponse)
+	ret := m.ctrl.Call(m, "MigrateNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListSupportedNetworkServices indicates an expected call of ListSupportedNetworkServices.
-func (mr *MockNetworkServiceIfaceMockRecorder) ListSupportedNetworkServices(p any) *gomock.Call {
+// MigrateNetworkAsync indicates an expected call of MigrateNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) MigrateNetworkAsync(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSupportedNetworkServices", reflect.TypeOf((*MockNetworkServiceIface)(nil).ListSupportedNetworkServices), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).MigrateNetworkAsync), ctx, p)
 }
 
-// MigrateNetwork mocks base method.
-func (m *MockNetworkServiceIface) MigrateNetwork(p *MigrateNetworkParams) (*MigrateNetworkResponse, error) {
+// MigrateNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) MigrateNetworkWithContext(ctx context.Context, p *MigrateNetworkParams) (*MigrateNetworkResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateNetwork", p)
+	ret := m.ctrl.Call(m, "MigrateNetworkWithContext", ctx, p)
 	ret0, _ := ret[0].(*MigrateNetworkResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MigrateNetwork indicates an expected call of MigrateNetwork.
-func (mr *MockNetworkServiceIfaceMockRecorder) MigrateNetwork(p any) *gomock.Call {
+// MigrateNetworkWithContext indicates an expected call of MigrateNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) MigrateNetworkWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).MigrateNetwork), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).MigrateNetworkWithContext), ctx, p)
 }
 
 // NewAddNetworkServiceProviderParams mocks base method.
@@ -1386,6 +3161,21 @@ func (mr *MockNetworkServiceIfaceMockRecorder) ReleasePublicIpRange(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePublicIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).ReleasePublicIpRange), p)
 }
 
+// ReleasePublicIpRangeWithContext mocks base method.
+func (m *MockNetworkServiceIface) ReleasePublicIpRangeWithContext(ctx context.Context, p *ReleasePublicIpRangeParams) (*ReleasePublicIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleasePublicIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleasePublicIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleasePublicIpRangeWithContext indicates an expected call of ReleasePublicIpRangeWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ReleasePublicIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleasePublicIpRangeWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ReleasePublicIpRangeWithContext), ctx, p)
+}
+
 // RemoveNetworkPermissions mocks base method.
 func (m *MockNetworkServiceIface) RemoveNetworkPermissions(p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1401,6 +3191,21 @@ func (mr *MockNetworkServiceIfaceMockRecorder) RemoveNetworkPermissions(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).RemoveNetworkPermissions), p)
 }
 
+// RemoveNetworkPermissionsWithContext mocks base method.
+func (m *MockNetworkServiceIface) RemoveNetworkPermissionsWithContext(ctx context.Context, p *RemoveNetworkPermissionsParams) (*RemoveNetworkPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNetworkPermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveNetworkPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNetworkPermissionsWithContext indicates an expected call of RemoveNetworkPermissionsWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) RemoveNetworkPermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNetworkPermissionsWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).RemoveNetworkPermissionsWithContext), ctx, p)
+}
+
 // ResetNetworkPermissions mocks base method.
 func (m *MockNetworkServiceIface) ResetNetworkPermissions(p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -1416,6 +3221,21 @@ func (mr *MockNetworkServiceIfaceMockRecorder) ResetNetworkPermissions(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetNetworkPermissions", reflect.TypeOf((*MockNetworkServiceIface)(nil).ResetNetworkPermissions), p)
 }
 
+// ResetNetworkPermissionsWithContext mocks base method.
+func (m *MockNetworkServiceIface) ResetNetworkPermissionsWithContext(ctx context.Context, p *ResetNetworkPermissionsParams) (*ResetNetworkPermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetNetworkPermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResetNetworkPermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetNetworkPermissionsWithContext indicates an expected call of ResetNetworkPermissionsWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) ResetNetworkPermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetNetworkPermissionsWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).ResetNetworkPermissionsWithContext), ctx, p)
+}
+
 // RestartNetwork mocks base method.
 func (m *MockNetworkServiceIface) RestartNetwork(p *RestartNetworkParams) (*RestartNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -1431,6 +3251,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) RestartNetwork(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).RestartNetwork), p)
 }
 
+// RestartNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) RestartNetworkAsync(ctx context.Context, p *RestartNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartNetworkAsync indicates an expected call of RestartNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) RestartNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).RestartNetworkAsync), ctx, p)
+}
+
+// RestartNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) RestartNetworkWithContext(ctx context.Context, p *RestartNetworkParams) (*RestartNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*RestartNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartNetworkWithContext indicates an expected call of RestartNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) RestartNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).RestartNetworkWithContext), ctx, p)
+}
+
 // UpdateNetwork mocks base method.
 func (m *MockNetworkServiceIface) UpdateNetwork(p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -1446,6 +3296,21 @@ func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetwork(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetwork), p)
 }
 
+// UpdateNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) UpdateNetworkAsync(ctx context.Context, p *UpdateNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkAsync indicates an expected call of UpdateNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetworkAsync), ctx, p)
+}
+
 // UpdateNetworkServiceProvider mocks base method.
 func (m *MockNetworkServiceIface) UpdateNetworkServiceProvider(p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
 	m.ctrl.T.Helper()
@@ -1461,6 +3326,51 @@ func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetworkServiceProvider(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkServiceProvider", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetworkServiceProvider), p)
 }
 
+// UpdateNetworkServiceProviderAsync mocks base method.
+func (m *MockNetworkServiceIface) UpdateNetworkServiceProviderAsync(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkServiceProviderAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkServiceProviderAsync indicates an expected call of UpdateNetworkServiceProviderAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetworkServiceProviderAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkServiceProviderAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetworkServiceProviderAsync), ctx, p)
+}
+
+// UpdateNetworkServiceProviderWithContext mocks base method.
+func (m *MockNetworkServiceIface) UpdateNetworkServiceProviderWithContext(ctx context.Context, p *UpdateNetworkServiceProviderParams) (*UpdateNetworkServiceProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkServiceProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateNetworkServiceProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkServiceProviderWithContext indicates an expected call of UpdateNetworkServiceProviderWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetworkServiceProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkServiceProviderWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetworkServiceProviderWithContext), ctx, p)
+}
+
+// UpdateNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) UpdateNetworkWithContext(ctx context.Context, p *UpdateNetworkParams) (*UpdateNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateNetworkWithContext indicates an expected call of UpdateNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateNetworkWithContext), ctx, p)
+}
+
 // UpdatePhysicalNetwork mocks base method.
 func (m *MockNetworkServiceIface) UpdatePhysicalNetwork(p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
 	m.ctrl.T.Helper()
@@ -1476,6 +3386,36 @@ func (mr *MockNetworkServiceIfaceMockRecorder) UpdatePhysicalNetwork(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhysicalNetwork", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdatePhysicalNetwork), p)
 }
 
+// UpdatePhysicalNetworkAsync mocks base method.
+func (m *MockNetworkServiceIface) UpdatePhysicalNetworkAsync(ctx context.Context, p *UpdatePhysicalNetworkParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhysicalNetworkAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePhysicalNetworkAsync indicates an expected call of UpdatePhysicalNetworkAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdatePhysicalNetworkAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhysicalNetworkAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdatePhysicalNetworkAsync), ctx, p)
+}
+
+// UpdatePhysicalNetworkWithContext mocks base method.
+func (m *MockNetworkServiceIface) UpdatePhysicalNetworkWithContext(ctx context.Context, p *UpdatePhysicalNetworkParams) (*UpdatePhysicalNetworkResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePhysicalNetworkWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdatePhysicalNetworkResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePhysicalNetworkWithContext indicates an expected call of UpdatePhysicalNetworkWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdatePhysicalNetworkWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePhysicalNetworkWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdatePhysicalNetworkWithContext), ctx, p)
+}
+
 // UpdateStorageNetworkIpRange mocks base method.
 func (m *MockNetworkServiceIface) UpdateStorageNetworkIpRange(p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1490,3 +3430,33 @@ func (mr *MockNetworkServiceIfaceMockRecorder) UpdateStorageNetworkIpRange(p any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageNetworkIpRange", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateStorageNetworkIpRange), p)
 }
+
+// UpdateStorageNetworkIpRangeAsync mocks base method.
+func (m *MockNetworkServiceIface) UpdateStorageNetworkIpRangeAsync(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStorageNetworkIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStorageNetworkIpRangeAsync indicates an expected call of UpdateStorageNetworkIpRangeAsync.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateStorageNetworkIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageNetworkIpRangeAsync", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateStorageNetworkIpRangeAsync), ctx, p)
+}
+
+// UpdateStorageNetworkIpRangeWithContext mocks base method.
+func (m *MockNetworkServiceIface) UpdateStorageNetworkIpRangeWithContext(ctx context.Context, p *UpdateStorageNetworkIpRangeParams) (*UpdateStorageNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStorageNetworkIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateStorageNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStorageNetworkIpRangeWithContext indicates an expected call of UpdateStorageNetworkIpRangeWithContext.
+func (mr *MockNetworkServiceIfaceMockRecorder) UpdateStorageNetworkIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStorageNetworkIpRangeWithContext", reflect.TypeOf((*MockNetworkServiceIface)(nil).UpdateStorageNetworkIpRangeWithContext), ctx, p)
+}
@@ -20,19 +20,32 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type NicServiceIface interface {
 	AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error)
+	AddIpToNicAsync(ctx context.Context, p *AddIpToNicParams) (*Job, error)
 	NewAddIpToNicParams(nicid string) *AddIpToNicParams
 	ListNics(p *ListNicsParams) (*ListNicsResponse, error)
+	ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error)
+	ListNicsAll(p *ListNicsParams, opts ...PageOption) ([]*Nic, error)
+	ListNicsAllWithContext(ctx context.Context, p *ListNicsParams, opts ...PageOption) ([]*Nic, error)
+	ListNicsIter(p *ListNicsParams, opts ...PageOption) iter.Seq2[*Nic, error]
+	ListNicsIterWithContext(ctx context.Context, p *ListNicsParams, opts ...PageOption) iter.Seq2[*Nic, error]
 	NewListNicsParams(virtualmachineid string) *ListNicsParams
 	RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error)
+	RemoveIpFromNicAsync(ctx context.Context, p *RemoveIpFromNicParams) (*Job, error)
 	NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
 	UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error)
+	UpdateVmNicIpAsync(ctx context.Context, p *UpdateVmNicIpParams) (*Job, error)
 	NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams
 }
 
@@ -54,6 +67,45 @@ func (p *AddIpToNicParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addIpToNic API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddIpToNicParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addIpToNic", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addIpToNic API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddIpToNicParams) Validate() error {
+	return paramRules{
+		required: []string{"nicid"},
+		lengths:  map[string]int{"ipaddress": 255, "nicid": 255},
+	}.validate("addIpToNic", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addIpToNic API, before the request is signed
+func (p *AddIpToNicParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddIpToNicParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddIpToNicParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addIpToNic", b, &p.p, map[string]paramDecoder{
+		"ipaddress": decodeParam[string],
+		"nicid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddIpToNicParams) Clone() *AddIpToNicParams {
+	return &AddIpToNicParams{p: cloneParams(p.p)}
+}
+
 func (p *AddIpToNicParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -107,32 +159,39 @@ func (s *NicService) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
 
 // Assigns secondary IP to NIC
 func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error) {
-	resp, err := s.cs.newPostRequest("addIpToNic", p.toURLValues())
+	return s.AddIpToNicWithContext(context.Background(), p)
+}
+
+// AddIpToNicWithContext is like AddIpToNic, but honours the cancellation and deadline of ctx
+func (s *NicService) AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addIpToNic", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddIpToNicResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addIpToNic", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addIpToNic", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addIpToNic", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -140,18 +199,43 @@ func (s *NicService) AddIpToNic(p *AddIpToNicParams) (*AddIpToNicResponse, error
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddIpToNic async job into r
+func (r *AddIpToNicResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddIpToNicAsync starts the async job of AddIpToNic without waiting for it to finish, and returns a handle to the job
+func (s *NicService) AddIpToNicAsync(ctx context.Context, p *AddIpToNicParams) (*Job, error) {
+	r, err := s.AddIpToNicWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addIpToNic", r.JobID), nil
+}
+
 type AddIpToNicResponse struct {
-	Id          string `json:"id"`
-	Ipaddress   string `json:"ipaddress"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Networkid   string `json:"networkid"`
-	Nicid       string `json:"nicid"`
+	Id          string  `json:"id"`
+	Ipaddress   string  `json:"ipaddress"`
+	JobID       string  `json:"jobid"`
+	Jobstatus   FlexInt `json:"jobstatus"`
+	Networkid   string  `json:"networkid"`
+	Nicid       string  `json:"nicid"`
 	Secondaryip []struct {
 		Id        string `json:"id"`
 		Ipaddress string `json:"ipaddress"`
 	} `json:"secondaryip"`
-	Virtualmachineid string `json:"virtualmachineid"`
+	Virtualmachineid string                     `json:"virtualmachineid"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddIpToNicResponse) UnmarshalJSON(b []byte) error {
+	type alias AddIpToNicResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNicsParams struct {
@@ -190,6 +274,50 @@ func (p *ListNicsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNicsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNicsParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"keyword": 255, "networkid": 255, "nicid": 255, "virtualmachineid": 255},
+	}.validate("listNics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNics API, before the request is signed
+func (p *ListNicsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNicsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNicsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNics", b, &p.p, map[string]paramDecoder{
+		"fordisplay":       decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"networkid":        decodeParam[string],
+		"nicid":            decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNicsParams) Clone() *ListNicsParams {
+	return &ListNicsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNicsParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -348,19 +476,56 @@ func (s *NicService) NewListNicsParams(virtualmachineid string) *ListNicsParams
 
 // List the Instance NICs IP to NIC
 func (s *NicService) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
-	resp, err := s.cs.newRequest("listNics", p.toURLValues())
+	return s.ListNicsWithContext(context.Background(), p)
+}
+
+// ListNicsWithContext is like ListNics, but honours the cancellation and deadline of ctx
+func (s *NicService) ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNicsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNicsAll returns all Nics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NicService) ListNicsAll(p *ListNicsParams, opts ...PageOption) ([]*Nic, error) {
+	return s.ListNicsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNicsAllWithContext is like ListNicsAll, but honours the cancellation and deadline of ctx
+func (s *NicService) ListNicsAllWithContext(ctx context.Context, p *ListNicsParams, opts ...PageOption) ([]*Nic, error) {
+	return collectPages(s.ListNicsIterWithContext(ctx, p, opts...))
+}
+
+// ListNicsIter returns an iterator over all Nics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NicService) ListNicsIter(p *ListNicsParams, opts ...PageOption) iter.Seq2[*Nic, error] {
+	return s.ListNicsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNicsIterWithContext is like ListNicsIter, but honours the cancellation and deadline of ctx
+func (s *NicService) ListNicsIterWithContext(ctx context.Context, p *ListNicsParams, opts ...PageOption) iter.Seq2[*Nic, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Nic, int, error) {
+		l, err := s.ListNicsWithContext(ctx, &ListNicsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Nics, l.Count, nil
+	}, opts...)
+}
+
 type ListNicsResponse struct {
 	Count int    `json:"count"`
 	Nics  []*Nic `json:"nic"`
@@ -378,14 +543,14 @@ type Nic struct {
 	Ip6gateway           string   `json:"ip6gateway"`
 	Ipaddress            string   `json:"ipaddress"`
 	Ipaddresses          []string `json:"ipaddresses"`
-	Isdefault            bool     `json:"isdefault"`
-	Isolatedpvlan        int      `json:"isolatedpvlan"`
+	Isdefault            FlexBool `json:"isdefault"`
+	Isolatedpvlan        FlexInt  `json:"isolatedpvlan"`
 	Isolatedpvlantype    string   `json:"isolatedpvlantype"`
 	Isolationuri         string   `json:"isolationuri"`
 	JobID                string   `json:"jobid"`
-	Jobstatus            int      `json:"jobstatus"`
+	Jobstatus            FlexInt  `json:"jobstatus"`
 	Macaddress           string   `json:"macaddress"`
-	Mtu                  int      `json:"mtu"`
+	Mtu                  FlexInt  `json:"mtu"`
 	Netmask              string   `json:"netmask"`
 	Networkid            string   `json:"networkid"`
 	Networkname          string   `json:"networkname"`
@@ -397,12 +562,18 @@ type Nic struct {
 		Id        string `json:"id"`
 		Ipaddress string `json:"ipaddress"`
 	} `json:"secondaryip"`
-	Traffictype      string `json:"traffictype"`
-	Type             string `json:"type"`
-	Virtualmachineid string `json:"virtualmachineid"`
-	Vlanid           int    `json:"vlanid"`
-	Vpcid            string `json:"vpcid"`
-	Vpcname          string `json:"vpcname"`
+	Traffictype      string                     `json:"traffictype"`
+	Type             string                     `json:"type"`
+	Virtualmachineid string                     `json:"virtualmachineid"`
+	Vlanid           FlexInt                    `json:"vlanid"`
+	Vpcid            string                     `json:"vpcid"`
+	Vpcname          string                     `json:"vpcname"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Nic) UnmarshalJSON(b []byte) error {
+	type alias Nic
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveIpFromNicParams struct {
@@ -420,6 +591,44 @@ func (p *RemoveIpFromNicParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeIpFromNic API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveIpFromNicParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeIpFromNic", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeIpFromNic API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveIpFromNicParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("removeIpFromNic", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeIpFromNic API, before the request is signed
+func (p *RemoveIpFromNicParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveIpFromNicParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveIpFromNicParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeIpFromNic", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveIpFromNicParams) Clone() *RemoveIpFromNicParams {
+	return &RemoveIpFromNicParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveIpFromNicParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -452,27 +661,39 @@ func (s *NicService) NewRemoveIpFromNicParams(id string) *RemoveIpFromNicParams
 
 // Removes secondary IP from the NIC.
 func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
-	resp, err := s.cs.newPostRequest("removeIpFromNic", p.toURLValues())
+	return s.RemoveIpFromNicWithContext(context.Background(), p)
+}
+
+// RemoveIpFromNicWithContext is like RemoveIpFromNic, but honours the cancellation and deadline of ctx
+func (s *NicService) RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeIpFromNic", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveIpFromNicResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeIpFromNic", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeIpFromNic", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeIpFromNic", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -480,11 +701,31 @@ func (s *NicService) RemoveIpFromNic(p *RemoveIpFromNicParams) (*RemoveIpFromNic
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveIpFromNic async job into r
+func (r *RemoveIpFromNicResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveIpFromNicAsync starts the async job of RemoveIpFromNic without waiting for it to finish, and returns a handle to the job
+func (s *NicService) RemoveIpFromNicAsync(ctx context.Context, p *RemoveIpFromNicParams) (*Job, error) {
+	r, err := s.RemoveIpFromNicWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeIpFromNic", r.JobID), nil
+}
+
 type RemoveIpFromNicResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveIpFromNicResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveIpFromNicResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVmNicIpParams struct {
@@ -505,6 +746,45 @@ func (p *UpdateVmNicIpParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVmNicIp API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVmNicIpParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVmNicIp", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVmNicIp API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVmNicIpParams) Validate() error {
+	return paramRules{
+		required: []string{"nicid"},
+		lengths:  map[string]int{"ipaddress": 255, "nicid": 255},
+	}.validate("updateVmNicIp", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVmNicIp API, before the request is signed
+func (p *UpdateVmNicIpParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVmNicIpParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVmNicIpParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVmNicIp", b, &p.p, map[string]paramDecoder{
+		"ipaddress": decodeParam[string],
+		"nicid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVmNicIpParams) Clone() *UpdateVmNicIpParams {
+	return &UpdateVmNicIpParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVmNicIpParams) SetIpaddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -558,32 +838,39 @@ func (s *NicService) NewUpdateVmNicIpParams(nicid string) *UpdateVmNicIpParams {
 
 // Update the default IP of an Instance NIC
 func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVmNicIp", p.toURLValues())
+	return s.UpdateVmNicIpWithContext(context.Background(), p)
+}
+
+// UpdateVmNicIpWithContext is like UpdateVmNicIp, but honours the cancellation and deadline of ctx
+func (s *NicService) UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVmNicIp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVmNicIpResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVmNicIp", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVmNicIp", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVmNicIp", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -591,6 +878,25 @@ func (s *NicService) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVmNicIp async job into r
+func (r *UpdateVmNicIpResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVmNicIpAsync starts the async job of UpdateVmNicIp without waiting for it to finish, and returns a handle to the job
+func (s *NicService) UpdateVmNicIpAsync(ctx context.Context, p *UpdateVmNicIpParams) (*Job, error) {
+	r, err := s.UpdateVmNicIpWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVmNicIp", r.JobID), nil
+}
+
 type UpdateVmNicIpResponse struct {
 	Account               string                               `json:"account"`
 	Affinitygroup         []UpdateVmNicIpResponseAffinitygroup `json:"affinitygroup"`
@@ -602,32 +908,32 @@ type UpdateVmNicIpResponse struct {
 	Backupofferingname    string                               `json:"backupofferingname"`
 	Bootmode              string                               `json:"bootmode"`
 	Boottype              string                               `json:"boottype"`
-	Cpunumber             int                                  `json:"cpunumber"`
-	Cpuspeed              int                                  `json:"cpuspeed"`
+	Cpunumber             FlexInt                              `json:"cpunumber"`
+	Cpuspeed              FlexInt                              `json:"cpuspeed"`
 	Cpuused               string                               `json:"cpuused"`
-	Created               string                               `json:"created"`
-	Deleteprotection      bool                                 `json:"deleteprotection"`
+	Created               Time                                 `json:"created"`
+	Deleteprotection      FlexBool                             `json:"deleteprotection"`
 	Details               map[string]string                    `json:"details"`
-	Diskioread            int64                                `json:"diskioread"`
-	Diskiowrite           int64                                `json:"diskiowrite"`
-	Diskkbsread           int64                                `json:"diskkbsread"`
-	Diskkbswrite          int64                                `json:"diskkbswrite"`
+	Diskioread            FlexInt64                            `json:"diskioread"`
+	Diskiowrite           FlexInt64                            `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                            `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                            `json:"diskkbswrite"`
 	Diskofferingid        string                               `json:"diskofferingid"`
 	Diskofferingname      string                               `json:"diskofferingname"`
 	Displayname           string                               `json:"displayname"`
-	Displayvm             bool                                 `json:"displayvm"`
+	Displayvm             FlexBool                             `json:"displayvm"`
 	Domain                string                               `json:"domain"`
 	Domainid              string                               `json:"domainid"`
 	Domainpath            string                               `json:"domainpath"`
-	Forvirtualnetwork     bool                                 `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                             `json:"forvirtualnetwork"`
 	Gpucardid             string                               `json:"gpucardid"`
 	Gpucardname           string                               `json:"gpucardname"`
-	Gpucount              int                                  `json:"gpucount"`
+	Gpucount              FlexInt                              `json:"gpucount"`
 	Group                 string                               `json:"group"`
 	Groupid               string                               `json:"groupid"`
 	Guestosid             string                               `json:"guestosid"`
-	Haenable              bool                                 `json:"haenable"`
-	Hasannotations        bool                                 `json:"hasannotations"`
+	Haenable              FlexBool                             `json:"haenable"`
+	Hasannotations        FlexBool                             `json:"hasannotations"`
 	Hostcontrolstate      string                               `json:"hostcontrolstate"`
 	Hostid                string                               `json:"hostid"`
 	Hostname              string                               `json:"hostname"`
@@ -636,43 +942,43 @@ type UpdateVmNicIpResponse struct {
 	Id                    string                               `json:"id"`
 	Instancename          string                               `json:"instancename"`
 	Ipaddress             string                               `json:"ipaddress"`
-	Isdynamicallyscalable bool                                 `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                             `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                               `json:"isodisplaytext"`
 	Isoid                 string                               `json:"isoid"`
 	Isoname               string                               `json:"isoname"`
 	JobID                 string                               `json:"jobid"`
-	Jobstatus             int                                  `json:"jobstatus"`
+	Jobstatus             FlexInt                              `json:"jobstatus"`
 	Keypairs              string                               `json:"keypairs"`
-	Lastupdated           string                               `json:"lastupdated"`
-	Leaseduration         int                                  `json:"leaseduration"`
+	Lastupdated           Time                                 `json:"lastupdated"`
+	Leaseduration         FlexInt                              `json:"leaseduration"`
 	Leaseexpiryaction     string                               `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                               `json:"leaseexpirydate"`
-	Maxheads              int64                                `json:"maxheads"`
-	Maxresolutionx        int64                                `json:"maxresolutionx"`
-	Maxresolutiony        int64                                `json:"maxresolutiony"`
-	Memory                int                                  `json:"memory"`
-	Memoryintfreekbs      int64                                `json:"memoryintfreekbs"`
-	Memorykbs             int64                                `json:"memorykbs"`
-	Memorytargetkbs       int64                                `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                 `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                            `json:"maxheads"`
+	Maxresolutionx        FlexInt64                            `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                            `json:"maxresolutiony"`
+	Memory                FlexInt                              `json:"memory"`
+	Memoryintfreekbs      FlexInt64                            `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                            `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                            `json:"memorytargetkbs"`
 	Name                  string                               `json:"name"`
-	Networkkbsread        int64                                `json:"networkkbsread"`
-	Networkkbswrite       int64                                `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                            `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                            `json:"networkkbswrite"`
 	Nic                   []Nic                                `json:"nic"`
 	Osdisplayname         string                               `json:"osdisplayname"`
 	Ostypeid              string                               `json:"ostypeid"`
 	Password              string                               `json:"password"`
-	Passwordenabled       bool                                 `json:"passwordenabled"`
+	Passwordenabled       FlexBool                             `json:"passwordenabled"`
 	Pooltype              string                               `json:"pooltype"`
 	Project               string                               `json:"project"`
 	Projectid             string                               `json:"projectid"`
 	Publicip              string                               `json:"publicip"`
 	Publicipid            string                               `json:"publicipid"`
 	Readonlydetails       string                               `json:"readonlydetails"`
-	Receivedbytes         int64                                `json:"receivedbytes"`
-	Rootdeviceid          int64                                `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                            `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                            `json:"rootdeviceid"`
 	Rootdevicetype        string                               `json:"rootdevicetype"`
 	Securitygroup         []UpdateVmNicIpResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                `json:"sentbytes"`
+	Sentbytes             FlexInt64                            `json:"sentbytes"`
 	Serviceofferingid     string                               `json:"serviceofferingid"`
 	Serviceofferingname   string                               `json:"serviceofferingname"`
 	Servicestate          string                               `json:"servicestate"`
@@ -693,12 +999,13 @@ type UpdateVmNicIpResponse struct {
 	Vgpu                  string                               `json:"vgpu"`
 	Vgpuprofileid         string                               `json:"vgpuprofileid"`
 	Vgpuprofilename       string                               `json:"vgpuprofilename"`
-	Videoram              int64                                `json:"videoram"`
+	Videoram              FlexInt64                            `json:"videoram"`
 	Vmtype                string                               `json:"vmtype"`
 	Vnfdetails            map[string]string                    `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                            `json:"vnfnics"`
 	Zoneid                string                               `json:"zoneid"`
 	Zonename              string                               `json:"zonename"`
+	Extra                 map[string]json.RawMessage           `json:"-"`
 }
 
 type UpdateVmNicIpResponseSecuritygroup struct {
@@ -714,36 +1021,54 @@ type UpdateVmNicIpResponseSecuritygroup struct {
 	Project             string                                   `json:"project"`
 	Projectid           string                                   `json:"projectid"`
 	Tags                []Tags                                   `json:"tags"`
-	Virtualmachinecount int                                      `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                  `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                            `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage               `json:"-"`
+}
+
+func (r *UpdateVmNicIpResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateVmNicIpResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVmNicIpResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVmNicIpResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias UpdateVmNicIpResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVmNicIpResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVmNicIpResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateVmNicIpResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *UpdateVmNicIpResponse) UnmarshalJSON(b []byte) error {
@@ -770,5 +1095,5 @@ func (r *UpdateVmNicIpResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateVmNicIpResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
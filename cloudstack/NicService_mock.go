@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockNicServiceIfaceMockRecorder) AddIpToNic(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIpToNic", reflect.TypeOf((*MockNicServiceIface)(nil).AddIpToNic), p)
 }
 
+// AddIpToNicAsync mocks base method.
+func (m *MockNicServiceIface) AddIpToNicAsync(ctx context.Context, p *AddIpToNicParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIpToNicAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddIpToNicAsync indicates an expected call of AddIpToNicAsync.
+func (mr *MockNicServiceIfaceMockRecorder) AddIpToNicAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIpToNicAsync", reflect.TypeOf((*MockNicServiceIface)(nil).AddIpToNicAsync), ctx, p)
+}
+
+// AddIpToNicWithContext mocks base method.
+func (m *MockNicServiceIface) AddIpToNicWithContext(ctx context.Context, p *AddIpToNicParams) (*AddIpToNicResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddIpToNicWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddIpToNicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddIpToNicWithContext indicates an expected call of AddIpToNicWithContext.
+func (mr *MockNicServiceIfaceMockRecorder) AddIpToNicWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddIpToNicWithContext", reflect.TypeOf((*MockNicServiceIface)(nil).AddIpToNicWithContext), ctx, p)
+}
+
 // ListNics mocks base method.
 func (m *MockNicServiceIface) ListNics(p *ListNicsParams) (*ListNicsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,99 @@ func (mr *MockNicServiceIfaceMockRecorder) ListNics(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNics", reflect.TypeOf((*MockNicServiceIface)(nil).ListNics), p)
 }
 
+// ListNicsAll mocks base method.
+func (m *MockNicServiceIface) ListNicsAll(p *ListNicsParams, opts ...PageOption) ([]*Nic, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNicsAll", varargs...)
+	ret0, _ := ret[0].([]*Nic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNicsAll indicates an expected call of ListNicsAll.
+func (mr *MockNicServiceIfaceMockRecorder) ListNicsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNicsAll", reflect.TypeOf((*MockNicServiceIface)(nil).ListNicsAll), varargs...)
+}
+
+// ListNicsAllWithContext mocks base method.
+func (m *MockNicServiceIface) ListNicsAllWithContext(ctx context.Context, p *ListNicsParams, opts ...PageOption) ([]*Nic, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNicsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Nic)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNicsAllWithContext indicates an expected call of ListNicsAllWithContext.
+func (mr *MockNicServiceIfaceMockRecorder) ListNicsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNicsAllWithContext", reflect.TypeOf((*MockNicServiceIface)(nil).ListNicsAllWithContext), varargs...)
+}
+
+// ListNicsIter mocks base method.
+func (m *MockNicServiceIface) ListNicsIter(p *ListNicsParams, opts ...PageOption) iter.Seq2[*Nic, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNicsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Nic, error])
+	return ret0
+}
+
+// ListNicsIter indicates an expected call of ListNicsIter.
+func (mr *MockNicServiceIfaceMockRecorder) ListNicsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNicsIter", reflect.TypeOf((*MockNicServiceIface)(nil).ListNicsIter), varargs...)
+}
+
+// ListNicsIterWithContext mocks base method.
+func (m *MockNicServiceIface) ListNicsIterWithContext(ctx context.Context, p *ListNicsParams, opts ...PageOption) iter.Seq2[*Nic, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNicsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Nic, error])
+	return ret0
+}
+
+// ListNicsIterWithContext indicates an expected call of ListNicsIterWithContext.
+func (mr *MockNicServiceIfaceMockRecorder) ListNicsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNicsIterWithContext", reflect.TypeOf((*MockNicServiceIface)(nil).ListNicsIterWithContext), varargs...)
+}
+
+// ListNicsWithContext mocks base method.
+func (m *MockNicServiceIface) ListNicsWithContext(ctx context.Context, p *ListNicsParams) (*ListNicsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNicsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNicsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNicsWithContext indicates an expected call of ListNicsWithContext.
+func (mr *MockNicServiceIfaceMockRecorder) ListNicsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNicsWithContext", reflect.TypeOf((*MockNicServiceIface)(nil).ListNicsWithContext), ctx, p)
+}
+
 // NewAddIpToNicParams mocks base method.
 func (m *MockNicServiceIface) NewAddIpToNicParams(nicid string) *AddIpToNicParams {
 	m.ctrl.T.Helper()
@@ -159,6 +284,36 @@ func (mr *MockNicServiceIfaceMockRecorder) RemoveIpFromNic(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIpFromNic", reflect.TypeOf((*MockNicServiceIface)(nil).RemoveIpFromNic), p)
 }
 
+// RemoveIpFromNicAsync mocks base method.
+func (m *MockNicServiceIface) RemoveIpFromNicAsync(ctx context.Context, p *RemoveIpFromNicParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveIpFromNicAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveIpFromNicAsync indicates an expected call of RemoveIpFromNicAsync.
+func (mr *MockNicServiceIfaceMockRecorder) RemoveIpFromNicAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIpFromNicAsync", reflect.TypeOf((*MockNicServiceIface)(nil).RemoveIpFromNicAsync), ctx, p)
+}
+
+// RemoveIpFromNicWithContext mocks base method.
+func (m *MockNicServiceIface) RemoveIpFromNicWithContext(ctx context.Context, p *RemoveIpFromNicParams) (*RemoveIpFromNicResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveIpFromNicWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveIpFromNicResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveIpFromNicWithContext indicates an expected call of RemoveIpFromNicWithContext.
+func (mr *MockNicServiceIfaceMockRecorder) RemoveIpFromNicWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveIpFromNicWithContext", reflect.TypeOf((*MockNicServiceIface)(nil).RemoveIpFromNicWithContext), ctx, p)
+}
+
 // UpdateVmNicIp mocks base method.
 func (m *MockNicServiceIface) UpdateVmNicIp(p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
 	m.ctrl.T.Helper()
@@ -173,3 +328,33 @@ func (mr *MockNicServiceIfaceMockRecorder) UpdateVmNicIp(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVmNicIp", reflect.TypeOf((*MockNicServiceIface)(nil).UpdateVmNicIp), p)
 }
+
+// UpdateVmNicIpAsync mocks base method.
+func (m *MockNicServiceIface) UpdateVmNicIpAsync(ctx context.Context, p *UpdateVmNicIpParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVmNicIpAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVmNicIpAsync indicates an expected call of UpdateVmNicIpAsync.
+func (mr *MockNicServiceIfaceMockRecorder) UpdateVmNicIpAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVmNicIpAsync", reflect.TypeOf((*MockNicServiceIface)(nil).UpdateVmNicIpAsync), ctx, p)
+}
+
+// UpdateVmNicIpWithContext mocks base method.
+func (m *MockNicServiceIface) UpdateVmNicIpWithContext(ctx context.Context, p *UpdateVmNicIpParams) (*UpdateVmNicIpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVmNicIpWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVmNicIpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVmNicIpWithContext indicates an expected call of UpdateVmNicIpWithContext.
+func (mr *MockNicServiceIfaceMockRecorder) UpdateVmNicIpWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVmNicIpWithContext", reflect.TypeOf((*MockNicServiceIface)(nil).UpdateVmNicIpWithContext), ctx, p)
+}
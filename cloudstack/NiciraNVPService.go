@@ -20,17 +20,28 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type NiciraNVPServiceIface interface {
 	AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error)
+	AddNiciraNvpDeviceAsync(ctx context.Context, p *AddNiciraNvpDeviceParams) (*Job, error)
 	NewAddNiciraNvpDeviceParams(hostname string, password string, physicalnetworkid string, transportzoneuuid string, username string) *AddNiciraNvpDeviceParams
 	DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error)
+	DeleteNiciraNvpDeviceAsync(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*Job, error)
 	NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *DeleteNiciraNvpDeviceParams
 	ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error)
+	ListNiciraNvpDevicesAll(p *ListNiciraNvpDevicesParams, opts ...PageOption) ([]*NiciraNvpDevice, error)
+	ListNiciraNvpDevicesAllWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams, opts ...PageOption) ([]*NiciraNvpDevice, error)
+	ListNiciraNvpDevicesIter(p *ListNiciraNvpDevicesParams, opts ...PageOption) iter.Seq2[*NiciraNvpDevice, error]
+	ListNiciraNvpDevicesIterWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams, opts ...PageOption) iter.Seq2[*NiciraNvpDevice, error]
 	NewListNiciraNvpDevicesParams() *ListNiciraNvpDevicesParams
 }
 
@@ -67,6 +78,50 @@ func (p *AddNiciraNvpDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNiciraNvpDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNiciraNvpDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNiciraNvpDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNiciraNvpDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNiciraNvpDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"hostname", "password", "physicalnetworkid", "transportzoneuuid", "username"},
+		lengths:  map[string]int{"hostname": 255, "l2gatewayserviceuuid": 255, "l3gatewayserviceuuid": 255, "password": 255, "physicalnetworkid": 255, "transportzoneuuid": 255, "username": 255},
+	}.validate("addNiciraNvpDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNiciraNvpDevice API, before the request is signed
+func (p *AddNiciraNvpDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNiciraNvpDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNiciraNvpDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNiciraNvpDevice", b, &p.p, map[string]paramDecoder{
+		"hostname":             decodeParam[string],
+		"l2gatewayserviceuuid": decodeParam[string],
+		"l3gatewayserviceuuid": decodeParam[string],
+		"password":             decodeParam[string],
+		"physicalnetworkid":    decodeParam[string],
+		"transportzoneuuid":    decodeParam[string],
+		"username":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNiciraNvpDeviceParams) Clone() *AddNiciraNvpDeviceParams {
+	return &AddNiciraNvpDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNiciraNvpDeviceParams) SetHostname(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -229,32 +284,39 @@ func (s *NiciraNVPService) NewAddNiciraNvpDeviceParams(hostname string, password
 
 // Adds a Nicira NVP device
 func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("addNiciraNvpDevice", p.toURLValues())
+	return s.AddNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+// AddNiciraNvpDeviceWithContext is like AddNiciraNvpDevice, but honours the cancellation and deadline of ctx
+func (s *NiciraNVPService) AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addNiciraNvpDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNiciraNvpDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNiciraNvpDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addNiciraNvpDevice", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addNiciraNvpDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -262,17 +324,42 @@ func (s *NiciraNVPService) AddNiciraNvpDevice(p *AddNiciraNvpDeviceParams) (*Add
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddNiciraNvpDevice async job into r
+func (r *AddNiciraNvpDeviceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddNiciraNvpDeviceAsync starts the async job of AddNiciraNvpDevice without waiting for it to finish, and returns a handle to the job
+func (s *NiciraNVPService) AddNiciraNvpDeviceAsync(ctx context.Context, p *AddNiciraNvpDeviceParams) (*Job, error) {
+	r, err := s.AddNiciraNvpDeviceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addNiciraNvpDevice", r.JobID), nil
+}
+
 type AddNiciraNvpDeviceResponse struct {
-	Hostname             string `json:"hostname"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	L2gatewayserviceuuid string `json:"l2gatewayserviceuuid"`
-	L3gatewayserviceuuid string `json:"l3gatewayserviceuuid"`
-	Niciradevicename     string `json:"niciradevicename"`
-	Nvpdeviceid          string `json:"nvpdeviceid"`
-	Physicalnetworkid    string `json:"physicalnetworkid"`
-	Provider             string `json:"provider"`
-	Transportzoneuuid    string `json:"transportzoneuuid"`
+	Hostname             string                     `json:"hostname"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	L2gatewayserviceuuid string                     `json:"l2gatewayserviceuuid"`
+	L3gatewayserviceuuid string                     `json:"l3gatewayserviceuuid"`
+	Niciradevicename     string                     `json:"niciradevicename"`
+	Nvpdeviceid          string                     `json:"nvpdeviceid"`
+	Physicalnetworkid    string                     `json:"physicalnetworkid"`
+	Provider             string                     `json:"provider"`
+	Transportzoneuuid    string                     `json:"transportzoneuuid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNiciraNvpDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias AddNiciraNvpDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNiciraNvpDeviceParams struct {
@@ -290,6 +377,44 @@ func (p *DeleteNiciraNvpDeviceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNiciraNvpDevice API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNiciraNvpDeviceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNiciraNvpDevice", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNiciraNvpDevice API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNiciraNvpDeviceParams) Validate() error {
+	return paramRules{
+		required: []string{"nvpdeviceid"},
+		lengths:  map[string]int{"nvpdeviceid": 255},
+	}.validate("deleteNiciraNvpDevice", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNiciraNvpDevice API, before the request is signed
+func (p *DeleteNiciraNvpDeviceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNiciraNvpDeviceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNiciraNvpDeviceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNiciraNvpDevice", b, &p.p, map[string]paramDecoder{
+		"nvpdeviceid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNiciraNvpDeviceParams) Clone() *DeleteNiciraNvpDeviceParams {
+	return &DeleteNiciraNvpDeviceParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNiciraNvpDeviceParams) SetNvpdeviceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -322,27 +447,39 @@ func (s *NiciraNVPService) NewDeleteNiciraNvpDeviceParams(nvpdeviceid string) *D
 
 // delete a nicira nvp device
 func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNiciraNvpDevice", p.toURLValues())
+	return s.DeleteNiciraNvpDeviceWithContext(context.Background(), p)
+}
+
+// DeleteNiciraNvpDeviceWithContext is like DeleteNiciraNvpDevice, but honours the cancellation and deadline of ctx
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNiciraNvpDevice", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNiciraNvpDeviceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNiciraNvpDevice", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteNiciraNvpDevice", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteNiciraNvpDevice", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -350,11 +487,31 @@ func (s *NiciraNVPService) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteNiciraNvpDevice async job into r
+func (r *DeleteNiciraNvpDeviceResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteNiciraNvpDeviceAsync starts the async job of DeleteNiciraNvpDevice without waiting for it to finish, and returns a handle to the job
+func (s *NiciraNVPService) DeleteNiciraNvpDeviceAsync(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*Job, error) {
+	r, err := s.DeleteNiciraNvpDeviceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteNiciraNvpDevice", r.JobID), nil
+}
+
 type DeleteNiciraNvpDeviceResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNiciraNvpDeviceResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNiciraNvpDeviceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNiciraNvpDevicesParams struct {
@@ -386,6 +543,47 @@ func (p *ListNiciraNvpDevicesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNiciraNvpDevices API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNiciraNvpDevicesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNiciraNvpDevices", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNiciraNvpDevices API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNiciraNvpDevicesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "nvpdeviceid": 255, "physicalnetworkid": 255},
+	}.validate("listNiciraNvpDevices", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNiciraNvpDevices API, before the request is signed
+func (p *ListNiciraNvpDevicesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNiciraNvpDevicesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNiciraNvpDevicesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNiciraNvpDevices", b, &p.p, map[string]paramDecoder{
+		"keyword":           decodeParam[string],
+		"nvpdeviceid":       decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNiciraNvpDevicesParams) Clone() *ListNiciraNvpDevicesParams {
+	return &ListNiciraNvpDevicesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNiciraNvpDevicesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -501,33 +699,76 @@ func (s *NiciraNVPService) NewListNiciraNvpDevicesParams() *ListNiciraNvpDevices
 
 // Lists Nicira NVP devices
 func (s *NiciraNVPService) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
-	resp, err := s.cs.newRequest("listNiciraNvpDevices", p.toURLValues())
+	return s.ListNiciraNvpDevicesWithContext(context.Background(), p)
+}
+
+// ListNiciraNvpDevicesWithContext is like ListNiciraNvpDevices, but honours the cancellation and deadline of ctx
+func (s *NiciraNVPService) ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNiciraNvpDevices", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNiciraNvpDevicesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNiciraNvpDevices", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNiciraNvpDevicesAll returns all NiciraNvpDevices matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NiciraNVPService) ListNiciraNvpDevicesAll(p *ListNiciraNvpDevicesParams, opts ...PageOption) ([]*NiciraNvpDevice, error) {
+	return s.ListNiciraNvpDevicesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNiciraNvpDevicesAllWithContext is like ListNiciraNvpDevicesAll, but honours the cancellation and deadline of ctx
+func (s *NiciraNVPService) ListNiciraNvpDevicesAllWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams, opts ...PageOption) ([]*NiciraNvpDevice, error) {
+	return collectPages(s.ListNiciraNvpDevicesIterWithContext(ctx, p, opts...))
+}
+
+// ListNiciraNvpDevicesIter returns an iterator over all NiciraNvpDevices matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NiciraNVPService) ListNiciraNvpDevicesIter(p *ListNiciraNvpDevicesParams, opts ...PageOption) iter.Seq2[*NiciraNvpDevice, error] {
+	return s.ListNiciraNvpDevicesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNiciraNvpDevicesIterWithContext is like ListNiciraNvpDevicesIter, but honours the cancellation and deadline of ctx
+func (s *NiciraNVPService) ListNiciraNvpDevicesIterWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams, opts ...PageOption) iter.Seq2[*NiciraNvpDevice, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NiciraNvpDevice, int, error) {
+		l, err := s.ListNiciraNvpDevicesWithContext(ctx, &ListNiciraNvpDevicesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NiciraNvpDevices, l.Count, nil
+	}, opts...)
+}
+
 type ListNiciraNvpDevicesResponse struct {
 	Count            int                `json:"count"`
 	NiciraNvpDevices []*NiciraNvpDevice `json:"niciranvpdevice"`
 }
 
 type NiciraNvpDevice struct {
-	Hostname             string `json:"hostname"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	L2gatewayserviceuuid string `json:"l2gatewayserviceuuid"`
-	L3gatewayserviceuuid string `json:"l3gatewayserviceuuid"`
-	Niciradevicename     string `json:"niciradevicename"`
-	Nvpdeviceid          string `json:"nvpdeviceid"`
-	Physicalnetworkid    string `json:"physicalnetworkid"`
-	Provider             string `json:"provider"`
-	Transportzoneuuid    string `json:"transportzoneuuid"`
+	Hostname             string                     `json:"hostname"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	L2gatewayserviceuuid string                     `json:"l2gatewayserviceuuid"`
+	L3gatewayserviceuuid string                     `json:"l3gatewayserviceuuid"`
+	Niciradevicename     string                     `json:"niciradevicename"`
+	Nvpdeviceid          string                     `json:"nvpdeviceid"`
+	Physicalnetworkid    string                     `json:"physicalnetworkid"`
+	Provider             string                     `json:"provider"`
+	Transportzoneuuid    string                     `json:"transportzoneuuid"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NiciraNvpDevice) UnmarshalJSON(b []byte) error {
+	type alias NiciraNvpDevice
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
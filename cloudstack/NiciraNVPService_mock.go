@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockNiciraNVPServiceIfaceMockRecorder) AddNiciraNvpDevice(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNiciraNvpDevice", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).AddNiciraNvpDevice), p)
 }
 
+// AddNiciraNvpDeviceAsync mocks base method.
+func (m *MockNiciraNVPServiceIface) AddNiciraNvpDeviceAsync(ctx context.Context, p *AddNiciraNvpDeviceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNiciraNvpDeviceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNiciraNvpDeviceAsync indicates an expected call of AddNiciraNvpDeviceAsync.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) AddNiciraNvpDeviceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNiciraNvpDeviceAsync", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).AddNiciraNvpDeviceAsync), ctx, p)
+}
+
+// AddNiciraNvpDeviceWithContext mocks base method.
+func (m *MockNiciraNVPServiceIface) AddNiciraNvpDeviceWithContext(ctx context.Context, p *AddNiciraNvpDeviceParams) (*AddNiciraNvpDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNiciraNvpDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNiciraNvpDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNiciraNvpDeviceWithContext indicates an expected call of AddNiciraNvpDeviceWithContext.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) AddNiciraNvpDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNiciraNvpDeviceWithContext", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).AddNiciraNvpDeviceWithContext), ctx, p)
+}
+
 // DeleteNiciraNvpDevice mocks base method.
 func (m *MockNiciraNVPServiceIface) DeleteNiciraNvpDevice(p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockNiciraNVPServiceIfaceMockRecorder) DeleteNiciraNvpDevice(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNiciraNvpDevice", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).DeleteNiciraNvpDevice), p)
 }
 
+// DeleteNiciraNvpDeviceAsync mocks base method.
+func (m *MockNiciraNVPServiceIface) DeleteNiciraNvpDeviceAsync(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNiciraNvpDeviceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNiciraNvpDeviceAsync indicates an expected call of DeleteNiciraNvpDeviceAsync.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) DeleteNiciraNvpDeviceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNiciraNvpDeviceAsync", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).DeleteNiciraNvpDeviceAsync), ctx, p)
+}
+
+// DeleteNiciraNvpDeviceWithContext mocks base method.
+func (m *MockNiciraNVPServiceIface) DeleteNiciraNvpDeviceWithContext(ctx context.Context, p *DeleteNiciraNvpDeviceParams) (*DeleteNiciraNvpDeviceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNiciraNvpDeviceWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNiciraNvpDeviceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNiciraNvpDeviceWithContext indicates an expected call of DeleteNiciraNvpDeviceWithContext.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) DeleteNiciraNvpDeviceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNiciraNvpDeviceWithContext", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).DeleteNiciraNvpDeviceWithContext), ctx, p)
+}
+
 // ListNiciraNvpDevices mocks base method.
 func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevices(p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,99 @@ func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevices(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevices", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevices), p)
 }
 
+// ListNiciraNvpDevicesAll mocks base method.
+func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevicesAll(p *ListNiciraNvpDevicesParams, opts ...PageOption) ([]*NiciraNvpDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDevicesAll", varargs...)
+	ret0, _ := ret[0].([]*NiciraNvpDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNiciraNvpDevicesAll indicates an expected call of ListNiciraNvpDevicesAll.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevicesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevicesAll", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevicesAll), varargs...)
+}
+
+// ListNiciraNvpDevicesAllWithContext mocks base method.
+func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevicesAllWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams, opts ...PageOption) ([]*NiciraNvpDevice, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDevicesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NiciraNvpDevice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNiciraNvpDevicesAllWithContext indicates an expected call of ListNiciraNvpDevicesAllWithContext.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevicesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevicesAllWithContext", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevicesAllWithContext), varargs...)
+}
+
+// ListNiciraNvpDevicesIter mocks base method.
+func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevicesIter(p *ListNiciraNvpDevicesParams, opts ...PageOption) iter.Seq2[*NiciraNvpDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDevicesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NiciraNvpDevice, error])
+	return ret0
+}
+
+// ListNiciraNvpDevicesIter indicates an expected call of ListNiciraNvpDevicesIter.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevicesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevicesIter", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevicesIter), varargs...)
+}
+
+// ListNiciraNvpDevicesIterWithContext mocks base method.
+func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevicesIterWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams, opts ...PageOption) iter.Seq2[*NiciraNvpDevice, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNiciraNvpDevicesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NiciraNvpDevice, error])
+	return ret0
+}
+
+// ListNiciraNvpDevicesIterWithContext indicates an expected call of ListNiciraNvpDevicesIterWithContext.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevicesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevicesIterWithContext", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevicesIterWithContext), varargs...)
+}
+
+// ListNiciraNvpDevicesWithContext mocks base method.
+func (m *MockNiciraNVPServiceIface) ListNiciraNvpDevicesWithContext(ctx context.Context, p *ListNiciraNvpDevicesParams) (*ListNiciraNvpDevicesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNiciraNvpDevicesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNiciraNvpDevicesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNiciraNvpDevicesWithContext indicates an expected call of ListNiciraNvpDevicesWithContext.
+func (mr *MockNiciraNVPServiceIfaceMockRecorder) ListNiciraNvpDevicesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNiciraNvpDevicesWithContext", reflect.TypeOf((*MockNiciraNVPServiceIface)(nil).ListNiciraNvpDevicesWithContext), ctx, p)
+}
+
 // NewAddNiciraNvpDeviceParams mocks base method.
 func (m *MockNiciraNVPServiceIface) NewAddNiciraNvpDeviceParams(hostname, password, physicalnetworkid, transportzoneuuid, username string) *AddNiciraNvpDeviceParams {
 	m.ctrl.T.Helper()
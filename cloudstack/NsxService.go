@@ -20,17 +20,26 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type NsxServiceIface interface {
 	AddNsxController(p *AddNsxControllerParams) (*AddNsxControllerResponse, error)
+	AddNsxControllerWithContext(ctx context.Context, p *AddNsxControllerParams) (*AddNsxControllerResponse, error)
 	NewAddNsxControllerParams(edgecluster string, name string, nsxproviderhostname string, password string, tier0gateway string, transportzone string, username string, zoneid string) *AddNsxControllerParams
 	DeleteNsxController(p *DeleteNsxControllerParams) (*DeleteNsxControllerResponse, error)
+	DeleteNsxControllerWithContext(ctx context.Context, p *DeleteNsxControllerParams) (*DeleteNsxControllerResponse, error)
 	NewDeleteNsxControllerParams(nsxcontrollerid string) *DeleteNsxControllerParams
 	ListNsxControllers(p *ListNsxControllersParams) (*ListNsxControllersResponse, error)
+	ListNsxControllersWithContext(ctx context.Context, p *ListNsxControllersParams) (*ListNsxControllersResponse, error)
+	ListNsxControllersAll(p *ListNsxControllersParams, opts ...PageOption) ([]*NsxController, error)
+	ListNsxControllersAllWithContext(ctx context.Context, p *ListNsxControllersParams, opts ...PageOption) ([]*NsxController, error)
+	ListNsxControllersIter(p *ListNsxControllersParams, opts ...PageOption) iter.Seq2[*NsxController, error]
+	ListNsxControllersIterWithContext(ctx context.Context, p *ListNsxControllersParams, opts ...PageOption) iter.Seq2[*NsxController, error]
 	NewListNsxControllersParams() *ListNsxControllersParams
 }
 
@@ -73,6 +82,52 @@ func (p *AddNsxControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNsxController API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNsxControllerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNsxController", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNsxController API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNsxControllerParams) Validate() error {
+	return paramRules{
+		required: []string{"edgecluster", "name", "nsxproviderhostname", "password", "tier0gateway", "transportzone", "username", "zoneid"},
+		lengths:  map[string]int{"edgecluster": 255, "name": 255, "nsxproviderhostname": 255, "nsxproviderport": 255, "password": 255, "tier0gateway": 255, "transportzone": 255, "username": 255, "zoneid": 255},
+	}.validate("addNsxController", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNsxController API, before the request is signed
+func (p *AddNsxControllerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNsxControllerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNsxControllerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNsxController", b, &p.p, map[string]paramDecoder{
+		"edgecluster":         decodeParam[string],
+		"name":                decodeParam[string],
+		"nsxproviderhostname": decodeParam[string],
+		"nsxproviderport":     decodeParam[string],
+		"password":            decodeParam[string],
+		"tier0gateway":        decodeParam[string],
+		"transportzone":       decodeParam[string],
+		"username":            decodeParam[string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNsxControllerParams) Clone() *AddNsxControllerParams {
+	return &AddNsxControllerParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNsxControllerParams) SetEdgecluster(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -280,13 +335,22 @@ func (s *NsxService) NewAddNsxControllerParams(edgecluster string, name string,
 
 // Add NSX Controller to CloudStack
 func (s *NsxService) AddNsxController(p *AddNsxControllerParams) (*AddNsxControllerResponse, error) {
-	resp, err := s.cs.newPostRequest("addNsxController", p.toURLValues())
+	return s.AddNsxControllerWithContext(context.Background(), p)
+}
+
+// AddNsxControllerWithContext is like AddNsxController, but honours the cancellation and deadline of ctx
+func (s *NsxService) AddNsxControllerWithContext(ctx context.Context, p *AddNsxControllerParams) (*AddNsxControllerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addNsxController", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNsxControllerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNsxController", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -294,17 +358,23 @@ func (s *NsxService) AddNsxController(p *AddNsxControllerParams) (*AddNsxControl
 }
 
 type AddNsxControllerResponse struct {
-	Edgecluster     string `json:"edgecluster"`
-	Hostname        string `json:"hostname"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Nsxprovideruuid string `json:"nsxprovideruuid"`
-	Port            string `json:"port"`
-	Tier0gateway    string `json:"tier0gateway"`
-	Transportzone   string `json:"transportzone"`
-	Zoneid          string `json:"zoneid"`
-	Zonename        string `json:"zonename"`
+	Edgecluster     string                     `json:"edgecluster"`
+	Hostname        string                     `json:"hostname"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Nsxprovideruuid string                     `json:"nsxprovideruuid"`
+	Port            string                     `json:"port"`
+	Tier0gateway    string                     `json:"tier0gateway"`
+	Transportzone   string                     `json:"transportzone"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNsxControllerResponse) UnmarshalJSON(b []byte) error {
+	type alias AddNsxControllerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteNsxControllerParams struct {
@@ -322,6 +392,44 @@ func (p *DeleteNsxControllerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteNsxController API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteNsxControllerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteNsxController", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteNsxController API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteNsxControllerParams) Validate() error {
+	return paramRules{
+		required: []string{"nsxcontrollerid"},
+		lengths:  map[string]int{"nsxcontrollerid": 255},
+	}.validate("deleteNsxController", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteNsxController API, before the request is signed
+func (p *DeleteNsxControllerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteNsxControllerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteNsxControllerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteNsxController", b, &p.p, map[string]paramDecoder{
+		"nsxcontrollerid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteNsxControllerParams) Clone() *DeleteNsxControllerParams {
+	return &DeleteNsxControllerParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteNsxControllerParams) SetNsxcontrollerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -354,13 +462,22 @@ func (s *NsxService) NewDeleteNsxControllerParams(nsxcontrollerid string) *Delet
 
 // delete NSX Controller to CloudStack
 func (s *NsxService) DeleteNsxController(p *DeleteNsxControllerParams) (*DeleteNsxControllerResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteNsxController", p.toURLValues())
+	return s.DeleteNsxControllerWithContext(context.Background(), p)
+}
+
+// DeleteNsxControllerWithContext is like DeleteNsxController, but honours the cancellation and deadline of ctx
+func (s *NsxService) DeleteNsxControllerWithContext(ctx context.Context, p *DeleteNsxControllerParams) (*DeleteNsxControllerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteNsxController", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteNsxControllerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteNsxController", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -368,17 +485,23 @@ func (s *NsxService) DeleteNsxController(p *DeleteNsxControllerParams) (*DeleteN
 }
 
 type DeleteNsxControllerResponse struct {
-	Edgecluster     string `json:"edgecluster"`
-	Hostname        string `json:"hostname"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Nsxprovideruuid string `json:"nsxprovideruuid"`
-	Port            string `json:"port"`
-	Tier0gateway    string `json:"tier0gateway"`
-	Transportzone   string `json:"transportzone"`
-	Zoneid          string `json:"zoneid"`
-	Zonename        string `json:"zonename"`
+	Edgecluster     string                     `json:"edgecluster"`
+	Hostname        string                     `json:"hostname"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Nsxprovideruuid string                     `json:"nsxprovideruuid"`
+	Port            string                     `json:"port"`
+	Tier0gateway    string                     `json:"tier0gateway"`
+	Transportzone   string                     `json:"transportzone"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteNsxControllerResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteNsxControllerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListNsxControllersParams struct {
@@ -407,6 +530,46 @@ func (p *ListNsxControllersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listNsxControllers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListNsxControllersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listNsxControllers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listNsxControllers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListNsxControllersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "zoneid": 255},
+	}.validate("listNsxControllers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listNsxControllers API, before the request is signed
+func (p *ListNsxControllersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListNsxControllersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListNsxControllersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listNsxControllers", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListNsxControllersParams) Clone() *ListNsxControllersParams {
+	return &ListNsxControllersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListNsxControllersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -501,34 +664,77 @@ func (s *NsxService) NewListNsxControllersParams() *ListNsxControllersParams {
 
 // list all NSX controllers added to CloudStack
 func (s *NsxService) ListNsxControllers(p *ListNsxControllersParams) (*ListNsxControllersResponse, error) {
-	resp, err := s.cs.newRequest("listNsxControllers", p.toURLValues())
+	return s.ListNsxControllersWithContext(context.Background(), p)
+}
+
+// ListNsxControllersWithContext is like ListNsxControllers, but honours the cancellation and deadline of ctx
+func (s *NsxService) ListNsxControllersWithContext(ctx context.Context, p *ListNsxControllersParams) (*ListNsxControllersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listNsxControllers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListNsxControllersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listNsxControllers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListNsxControllersAll returns all NsxControllers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *NsxService) ListNsxControllersAll(p *ListNsxControllersParams, opts ...PageOption) ([]*NsxController, error) {
+	return s.ListNsxControllersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListNsxControllersAllWithContext is like ListNsxControllersAll, but honours the cancellation and deadline of ctx
+func (s *NsxService) ListNsxControllersAllWithContext(ctx context.Context, p *ListNsxControllersParams, opts ...PageOption) ([]*NsxController, error) {
+	return collectPages(s.ListNsxControllersIterWithContext(ctx, p, opts...))
+}
+
+// ListNsxControllersIter returns an iterator over all NsxControllers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *NsxService) ListNsxControllersIter(p *ListNsxControllersParams, opts ...PageOption) iter.Seq2[*NsxController, error] {
+	return s.ListNsxControllersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListNsxControllersIterWithContext is like ListNsxControllersIter, but honours the cancellation and deadline of ctx
+func (s *NsxService) ListNsxControllersIterWithContext(ctx context.Context, p *ListNsxControllersParams, opts ...PageOption) iter.Seq2[*NsxController, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*NsxController, int, error) {
+		l, err := s.ListNsxControllersWithContext(ctx, &ListNsxControllersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.NsxControllers, l.Count, nil
+	}, opts...)
+}
+
 type ListNsxControllersResponse struct {
 	Count          int              `json:"count"`
 	NsxControllers []*NsxController `json:"nsxcontroller"`
 }
 
 type NsxController struct {
-	Edgecluster     string `json:"edgecluster"`
-	Hostname        string `json:"hostname"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Nsxprovideruuid string `json:"nsxprovideruuid"`
-	Port            string `json:"port"`
-	Tier0gateway    string `json:"tier0gateway"`
-	Transportzone   string `json:"transportzone"`
-	Zoneid          string `json:"zoneid"`
-	Zonename        string `json:"zonename"`
+	Edgecluster     string                     `json:"edgecluster"`
+	Hostname        string                     `json:"hostname"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Nsxprovideruuid string                     `json:"nsxprovideruuid"`
+	Port            string                     `json:"port"`
+	Tier0gateway    string                     `json:"tier0gateway"`
+	Transportzone   string                     `json:"transportzone"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *NsxController) UnmarshalJSON(b []byte) error {
+	type alias NsxController
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
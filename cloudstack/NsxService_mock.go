@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockNsxServiceIfaceMockRecorder) AddNsxController(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNsxController", reflect.TypeOf((*MockNsxServiceIface)(nil).AddNsxController), p)
 }
 
+// AddNsxControllerWithContext mocks base method.
+func (m *MockNsxServiceIface) AddNsxControllerWithContext(ctx context.Context, p *AddNsxControllerParams) (*AddNsxControllerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNsxControllerWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNsxControllerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNsxControllerWithContext indicates an expected call of AddNsxControllerWithContext.
+func (mr *MockNsxServiceIfaceMockRecorder) AddNsxControllerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNsxControllerWithContext", reflect.TypeOf((*MockNsxServiceIface)(nil).AddNsxControllerWithContext), ctx, p)
+}
+
 // DeleteNsxController mocks base method.
 func (m *MockNsxServiceIface) DeleteNsxController(p *DeleteNsxControllerParams) (*DeleteNsxControllerResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockNsxServiceIfaceMockRecorder) DeleteNsxController(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNsxController", reflect.TypeOf((*MockNsxServiceIface)(nil).DeleteNsxController), p)
 }
 
+// DeleteNsxControllerWithContext mocks base method.
+func (m *MockNsxServiceIface) DeleteNsxControllerWithContext(ctx context.Context, p *DeleteNsxControllerParams) (*DeleteNsxControllerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNsxControllerWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteNsxControllerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNsxControllerWithContext indicates an expected call of DeleteNsxControllerWithContext.
+func (mr *MockNsxServiceIfaceMockRecorder) DeleteNsxControllerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNsxControllerWithContext", reflect.TypeOf((*MockNsxServiceIface)(nil).DeleteNsxControllerWithContext), ctx, p)
+}
+
 // ListNsxControllers mocks base method.
 func (m *MockNsxServiceIface) ListNsxControllers(p *ListNsxControllersParams) (*ListNsxControllersResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,99 @@ func (mr *MockNsxServiceIfaceMockRecorder) ListNsxControllers(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNsxControllers", reflect.TypeOf((*MockNsxServiceIface)(nil).ListNsxControllers), p)
 }
 
+// ListNsxControllersAll mocks base method.
+func (m *MockNsxServiceIface) ListNsxControllersAll(p *ListNsxControllersParams, opts ...PageOption) ([]*NsxController, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNsxControllersAll", varargs...)
+	ret0, _ := ret[0].([]*NsxController)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNsxControllersAll indicates an expected call of ListNsxControllersAll.
+func (mr *MockNsxServiceIfaceMockRecorder) ListNsxControllersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNsxControllersAll", reflect.TypeOf((*MockNsxServiceIface)(nil).ListNsxControllersAll), varargs...)
+}
+
+// ListNsxControllersAllWithContext mocks base method.
+func (m *MockNsxServiceIface) ListNsxControllersAllWithContext(ctx context.Context, p *ListNsxControllersParams, opts ...PageOption) ([]*NsxController, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNsxControllersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*NsxController)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNsxControllersAllWithContext indicates an expected call of ListNsxControllersAllWithContext.
+func (mr *MockNsxServiceIfaceMockRecorder) ListNsxControllersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNsxControllersAllWithContext", reflect.TypeOf((*MockNsxServiceIface)(nil).ListNsxControllersAllWithContext), varargs...)
+}
+
+// ListNsxControllersIter mocks base method.
+func (m *MockNsxServiceIface) ListNsxControllersIter(p *ListNsxControllersParams, opts ...PageOption) iter.Seq2[*NsxController, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNsxControllersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NsxController, error])
+	return ret0
+}
+
+// ListNsxControllersIter indicates an expected call of ListNsxControllersIter.
+func (mr *MockNsxServiceIfaceMockRecorder) ListNsxControllersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNsxControllersIter", reflect.TypeOf((*MockNsxServiceIface)(nil).ListNsxControllersIter), varargs...)
+}
+
+// ListNsxControllersIterWithContext mocks base method.
+func (m *MockNsxServiceIface) ListNsxControllersIterWithContext(ctx context.Context, p *ListNsxControllersParams, opts ...PageOption) iter.Seq2[*NsxController, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListNsxControllersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*NsxController, error])
+	return ret0
+}
+
+// ListNsxControllersIterWithContext indicates an expected call of ListNsxControllersIterWithContext.
+func (mr *MockNsxServiceIfaceMockRecorder) ListNsxControllersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNsxControllersIterWithContext", reflect.TypeOf((*MockNsxServiceIface)(nil).ListNsxControllersIterWithContext), varargs...)
+}
+
+// ListNsxControllersWithContext mocks base method.
+func (m *MockNsxServiceIface) ListNsxControllersWithContext(ctx context.Context, p *ListNsxControllersParams) (*ListNsxControllersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNsxControllersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListNsxControllersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNsxControllersWithContext indicates an expected call of ListNsxControllersWithContext.
+func (mr *MockNsxServiceIfaceMockRecorder) ListNsxControllersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNsxControllersWithContext", reflect.TypeOf((*MockNsxServiceIface)(nil).ListNsxControllersWithContext), ctx, p)
+}
+
 // NewAddNsxControllerParams mocks base method.
 func (m *MockNsxServiceIface) NewAddNsxControllerParams(edgecluster, name, nsxproviderhostname, password, tier0gateway, transportzone, username, zoneid string) *AddNsxControllerParams {
 	m.ctrl.T.Helper()
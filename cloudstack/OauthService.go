@@ -20,22 +20,33 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type OauthServiceIface interface {
 	ListOauthProvider(p *ListOauthProviderParams) (*ListOauthProviderResponse, error)
+	ListOauthProviderWithContext(ctx context.Context, p *ListOauthProviderParams) (*ListOauthProviderResponse, error)
+	ListOauthProviderAll(p *ListOauthProviderParams, opts ...PageOption) ([]*OauthProvider, error)
+	ListOauthProviderAllWithContext(ctx context.Context, p *ListOauthProviderParams, opts ...PageOption) ([]*OauthProvider, error)
+	ListOauthProviderIter(p *ListOauthProviderParams, opts ...PageOption) iter.Seq2[*OauthProvider, error]
+	ListOauthProviderIterWithContext(ctx context.Context, p *ListOauthProviderParams, opts ...PageOption) iter.Seq2[*OauthProvider, error]
 	NewListOauthProviderParams() *ListOauthProviderParams
 	GetOauthProviderID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetOauthProviderIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetOauthProviderByName(name string, opts ...OptionFunc) (*OauthProvider, int, error)
+	GetOauthProviderByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OauthProvider, int, error)
 	GetOauthProviderByID(id string, opts ...OptionFunc) (*OauthProvider, int, error)
+	GetOauthProviderByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OauthProvider, int, error)
 	UpdateOauthProvider(p *UpdateOauthProviderParams) (*UpdateOauthProviderResponse, error)
+	UpdateOauthProviderWithContext(ctx context.Context, p *UpdateOauthProviderParams) (*UpdateOauthProviderResponse, error)
 	NewUpdateOauthProviderParams(id string) *UpdateOauthProviderParams
 	DeleteOauthProvider(p *DeleteOauthProviderParams) (*DeleteOauthProviderResponse, error)
+	DeleteOauthProviderWithContext(ctx context.Context, p *DeleteOauthProviderParams) (*DeleteOauthProviderResponse, error)
 	NewDeleteOauthProviderParams(id string) *DeleteOauthProviderParams
 }
 
@@ -68,6 +79,47 @@ func (p *ListOauthProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listOauthProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListOauthProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listOauthProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listOauthProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListOauthProviderParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "provider": 255},
+	}.validate("listOauthProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listOauthProvider API, before the request is signed
+func (p *ListOauthProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListOauthProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListOauthProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listOauthProvider", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"provider": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListOauthProviderParams) Clone() *ListOauthProviderParams {
+	return &ListOauthProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *ListOauthProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -183,6 +235,11 @@ func (s *OauthService) NewListOauthProviderParams() *ListOauthProviderParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *OauthService) GetOauthProviderID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetOauthProviderIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetOauthProviderIDWithContext is like GetOauthProviderID, but honours the cancellation and deadline of ctx
+func (s *OauthService) GetOauthProviderIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListOauthProviderParams{}
 	p.p = make(map[string]interface{})
 
@@ -194,7 +251,7 @@ func (s *OauthService) GetOauthProviderID(keyword string, opts ...OptionFunc) (s
 		}
 	}
 
-	l, err := s.ListOauthProvider(p)
+	l, err := s.ListOauthProviderWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -219,12 +276,17 @@ func (s *OauthService) GetOauthProviderID(keyword string, opts ...OptionFunc) (s
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *OauthService) GetOauthProviderByName(name string, opts ...OptionFunc) (*OauthProvider, int, error) {
-	id, count, err := s.GetOauthProviderID(name, opts...)
+	return s.GetOauthProviderByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetOauthProviderByNameWithContext is like GetOauthProviderByName, but honours the cancellation and deadline of ctx
+func (s *OauthService) GetOauthProviderByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OauthProvider, int, error) {
+	id, count, err := s.GetOauthProviderIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetOauthProviderByID(id, opts...)
+	r, count, err := s.GetOauthProviderByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -233,6 +295,11 @@ func (s *OauthService) GetOauthProviderByName(name string, opts ...OptionFunc) (
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *OauthService) GetOauthProviderByID(id string, opts ...OptionFunc) (*OauthProvider, int, error) {
+	return s.GetOauthProviderByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOauthProviderByIDWithContext is like GetOauthProviderByID, but honours the cancellation and deadline of ctx
+func (s *OauthService) GetOauthProviderByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OauthProvider, int, error) {
 	p := &ListOauthProviderParams{}
 	p.p = make(map[string]interface{})
 
@@ -244,11 +311,10 @@ func (s *OauthService) GetOauthProviderByID(id string, opts ...OptionFunc) (*Oau
 		}
 	}
 
-	l, err := s.ListOauthProvider(p)
+	l, err := s.ListOauthProviderWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -266,35 +332,78 @@ func (s *OauthService) GetOauthProviderByID(id string, opts ...OptionFunc) (*Oau
 
 // List OAuth providers registered
 func (s *OauthService) ListOauthProvider(p *ListOauthProviderParams) (*ListOauthProviderResponse, error) {
-	resp, err := s.cs.newRequest("listOauthProvider", p.toURLValues())
+	return s.ListOauthProviderWithContext(context.Background(), p)
+}
+
+// ListOauthProviderWithContext is like ListOauthProvider, but honours the cancellation and deadline of ctx
+func (s *OauthService) ListOauthProviderWithContext(ctx context.Context, p *ListOauthProviderParams) (*ListOauthProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listOauthProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListOauthProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listOauthProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListOauthProviderAll returns all OauthProvider matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *OauthService) ListOauthProviderAll(p *ListOauthProviderParams, opts ...PageOption) ([]*OauthProvider, error) {
+	return s.ListOauthProviderAllWithContext(context.Background(), p, opts...)
+}
+
+// ListOauthProviderAllWithContext is like ListOauthProviderAll, but honours the cancellation and deadline of ctx
+func (s *OauthService) ListOauthProviderAllWithContext(ctx context.Context, p *ListOauthProviderParams, opts ...PageOption) ([]*OauthProvider, error) {
+	return collectPages(s.ListOauthProviderIterWithContext(ctx, p, opts...))
+}
+
+// ListOauthProviderIter returns an iterator over all OauthProvider matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *OauthService) ListOauthProviderIter(p *ListOauthProviderParams, opts ...PageOption) iter.Seq2[*OauthProvider, error] {
+	return s.ListOauthProviderIterWithContext(context.Background(), p, opts...)
+}
+
+// ListOauthProviderIterWithContext is like ListOauthProviderIter, but honours the cancellation and deadline of ctx
+func (s *OauthService) ListOauthProviderIterWithContext(ctx context.Context, p *ListOauthProviderParams, opts ...PageOption) iter.Seq2[*OauthProvider, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*OauthProvider, int, error) {
+		l, err := s.ListOauthProviderWithContext(ctx, &ListOauthProviderParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.OauthProvider, l.Count, nil
+	}, opts...)
+}
+
 type ListOauthProviderResponse struct {
 	Count         int              `json:"count"`
 	OauthProvider []*OauthProvider `json:"oauthprovider"`
 }
 
 type OauthProvider struct {
-	Clientid    string `json:"clientid"`
-	Description string `json:"description"`
-	Enabled     bool   `json:"enabled"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Provider    string `json:"provider"`
-	Redirecturi string `json:"redirecturi"`
-	Secretkey   string `json:"secretkey"`
+	Clientid    string                     `json:"clientid"`
+	Description string                     `json:"description"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Provider    string                     `json:"provider"`
+	Redirecturi string                     `json:"redirecturi"`
+	Secretkey   string                     `json:"secretkey"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OauthProvider) UnmarshalJSON(b []byte) error {
+	type alias OauthProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateOauthProviderParams struct {
@@ -328,6 +437,49 @@ func (p *UpdateOauthProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateOauthProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateOauthProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateOauthProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateOauthProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateOauthProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"clientid": 255, "description": 4096, "id": 255, "redirecturi": 255, "secretkey": 255},
+	}.validate("updateOauthProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateOauthProvider API, before the request is signed
+func (p *UpdateOauthProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateOauthProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateOauthProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateOauthProvider", b, &p.p, map[string]paramDecoder{
+		"clientid":    decodeParam[string],
+		"description": decodeParam[string],
+		"enabled":     decodeParam[bool],
+		"id":          decodeParam[string],
+		"redirecturi": decodeParam[string],
+		"secretkey":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateOauthProviderParams) Clone() *UpdateOauthProviderParams {
+	return &UpdateOauthProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateOauthProviderParams) SetClientid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -465,7 +617,16 @@ func (s *OauthService) NewUpdateOauthProviderParams(id string) *UpdateOauthProvi
 
 // Updates the registered OAuth provider details
 func (s *OauthService) UpdateOauthProvider(p *UpdateOauthProviderParams) (*UpdateOauthProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("updateOauthProvider", p.toURLValues())
+	return s.UpdateOauthProviderWithContext(context.Background(), p)
+}
+
+// UpdateOauthProviderWithContext is like UpdateOauthProvider, but honours the cancellation and deadline of ctx
+func (s *OauthService) UpdateOauthProviderWithContext(ctx context.Context, p *UpdateOauthProviderParams) (*UpdateOauthProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateOauthProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -473,7 +634,7 @@ func (s *OauthService) UpdateOauthProvider(p *UpdateOauthProviderParams) (*Updat
 	var nested struct {
 		Response UpdateOauthProviderResponse `json:"oauthprovider"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateOauthProvider", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -482,16 +643,22 @@ func (s *OauthService) UpdateOauthProvider(p *UpdateOauthProviderParams) (*Updat
 }
 
 type UpdateOauthProviderResponse struct {
-	Clientid    string `json:"clientid"`
-	Description string `json:"description"`
-	Enabled     bool   `json:"enabled"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Provider    string `json:"provider"`
-	Redirecturi string `json:"redirecturi"`
-	Secretkey   string `json:"secretkey"`
+	Clientid    string                     `json:"clientid"`
+	Description string                     `json:"description"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Provider    string                     `json:"provider"`
+	Redirecturi string                     `json:"redirecturi"`
+	Secretkey   string                     `json:"secretkey"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateOauthProviderResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateOauthProviderResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteOauthProviderParams struct {
@@ -509,6 +676,44 @@ func (p *DeleteOauthProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteOauthProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteOauthProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteOauthProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteOauthProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteOauthProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteOauthProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteOauthProvider API, before the request is signed
+func (p *DeleteOauthProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteOauthProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteOauthProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteOauthProvider", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteOauthProviderParams) Clone() *DeleteOauthProviderParams {
+	return &DeleteOauthProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteOauthProviderParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -541,13 +746,22 @@ func (s *OauthService) NewDeleteOauthProviderParams(id string) *DeleteOauthProvi
 
 // Deletes the registered OAuth provider
 func (s *OauthService) DeleteOauthProvider(p *DeleteOauthProviderParams) (*DeleteOauthProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteOauthProvider", p.toURLValues())
+	return s.DeleteOauthProviderWithContext(context.Background(), p)
+}
+
+// DeleteOauthProviderWithContext is like DeleteOauthProvider, but honours the cancellation and deadline of ctx
+func (s *OauthService) DeleteOauthProviderWithContext(ctx context.Context, p *DeleteOauthProviderParams) (*DeleteOauthProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteOauthProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteOauthProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteOauthProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -555,10 +769,11 @@ func (s *OauthService) DeleteOauthProvider(p *DeleteOauthProviderParams) (*Delet
 }
 
 type DeleteOauthProviderResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteOauthProviderResponse) UnmarshalJSON(b []byte) error {
@@ -585,5 +800,5 @@ func (r *DeleteOauthProviderResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteOauthProviderResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
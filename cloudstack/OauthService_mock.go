@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockOauthServiceIfaceMockRecorder) DeleteOauthProvider(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOauthProvider", reflect.TypeOf((*MockOauthServiceIface)(nil).DeleteOauthProvider), p)
 }
 
+// DeleteOauthProviderWithContext mocks base method.
+func (m *MockOauthServiceIface) DeleteOauthProviderWithContext(ctx context.Context, p *DeleteOauthProviderParams) (*DeleteOauthProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteOauthProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteOauthProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteOauthProviderWithContext indicates an expected call of DeleteOauthProviderWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) DeleteOauthProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteOauthProviderWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).DeleteOauthProviderWithContext), ctx, p)
+}
+
 // GetOauthProviderByID mocks base method.
 func (m *MockOauthServiceIface) GetOauthProviderByID(id string, opts ...OptionFunc) (*OauthProvider, int, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +111,27 @@ func (mr *MockOauthServiceIfaceMockRecorder) GetOauthProviderByID(id any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOauthProviderByID", reflect.TypeOf((*MockOauthServiceIface)(nil).GetOauthProviderByID), varargs...)
 }
 
+// GetOauthProviderByIDWithContext mocks base method.
+func (m *MockOauthServiceIface) GetOauthProviderByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OauthProvider, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOauthProviderByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*OauthProvider)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOauthProviderByIDWithContext indicates an expected call of GetOauthProviderByIDWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) GetOauthProviderByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOauthProviderByIDWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).GetOauthProviderByIDWithContext), varargs...)
+}
+
 // GetOauthProviderByName mocks base method.
 func (m *MockOauthServiceIface) GetOauthProviderByName(name string, opts ...OptionFunc) (*OauthProvider, int, error) {
 	m.ctrl.T.Helper()
@@ -115,6 +153,27 @@ func (mr *MockOauthServiceIfaceMockRecorder) GetOauthProviderByName(name any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOauthProviderByName", reflect.TypeOf((*MockOauthServiceIface)(nil).GetOauthProviderByName), varargs...)
 }
 
+// GetOauthProviderByNameWithContext mocks base method.
+func (m *MockOauthServiceIface) GetOauthProviderByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*OauthProvider, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOauthProviderByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*OauthProvider)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOauthProviderByNameWithContext indicates an expected call of GetOauthProviderByNameWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) GetOauthProviderByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOauthProviderByNameWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).GetOauthProviderByNameWithContext), varargs...)
+}
+
 // GetOauthProviderID mocks base method.
 func (m *MockOauthServiceIface) GetOauthProviderID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -136,6 +195,27 @@ func (mr *MockOauthServiceIfaceMockRecorder) GetOauthProviderID(keyword any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOauthProviderID", reflect.TypeOf((*MockOauthServiceIface)(nil).GetOauthProviderID), varargs...)
 }
 
+// GetOauthProviderIDWithContext mocks base method.
+func (m *MockOauthServiceIface) GetOauthProviderIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOauthProviderIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOauthProviderIDWithContext indicates an expected call of GetOauthProviderIDWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) GetOauthProviderIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOauthProviderIDWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).GetOauthProviderIDWithContext), varargs...)
+}
+
 // ListOauthProvider mocks base method.
 func (m *MockOauthServiceIface) ListOauthProvider(p *ListOauthProviderParams) (*ListOauthProviderResponse, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +231,99 @@ func (mr *MockOauthServiceIfaceMockRecorder) ListOauthProvider(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOauthProvider", reflect.TypeOf((*MockOauthServiceIface)(nil).ListOauthProvider), p)
 }
 
+// ListOauthProviderAll mocks base method.
+func (m *MockOauthServiceIface) ListOauthProviderAll(p *ListOauthProviderParams, opts ...PageOption) ([]*OauthProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOauthProviderAll", varargs...)
+	ret0, _ := ret[0].([]*OauthProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOauthProviderAll indicates an expected call of ListOauthProviderAll.
+func (mr *MockOauthServiceIfaceMockRecorder) ListOauthProviderAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOauthProviderAll", reflect.TypeOf((*MockOauthServiceIface)(nil).ListOauthProviderAll), varargs...)
+}
+
+// ListOauthProviderAllWithContext mocks base method.
+func (m *MockOauthServiceIface) ListOauthProviderAllWithContext(ctx context.Context, p *ListOauthProviderParams, opts ...PageOption) ([]*OauthProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOauthProviderAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*OauthProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOauthProviderAllWithContext indicates an expected call of ListOauthProviderAllWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) ListOauthProviderAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOauthProviderAllWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).ListOauthProviderAllWithContext), varargs...)
+}
+
+// ListOauthProviderIter mocks base method.
+func (m *MockOauthServiceIface) ListOauthProviderIter(p *ListOauthProviderParams, opts ...PageOption) iter.Seq2[*OauthProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOauthProviderIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OauthProvider, error])
+	return ret0
+}
+
+// ListOauthProviderIter indicates an expected call of ListOauthProviderIter.
+func (mr *MockOauthServiceIfaceMockRecorder) ListOauthProviderIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOauthProviderIter", reflect.TypeOf((*MockOauthServiceIface)(nil).ListOauthProviderIter), varargs...)
+}
+
+// ListOauthProviderIterWithContext mocks base method.
+func (m *MockOauthServiceIface) ListOauthProviderIterWithContext(ctx context.Context, p *ListOauthProviderParams, opts ...PageOption) iter.Seq2[*OauthProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOauthProviderIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OauthProvider, error])
+	return ret0
+}
+
+// ListOauthProviderIterWithContext indicates an expected call of ListOauthProviderIterWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) ListOauthProviderIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOauthProviderIterWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).ListOauthProviderIterWithContext), varargs...)
+}
+
+// ListOauthProviderWithContext mocks base method.
+func (m *MockOauthServiceIface) ListOauthProviderWithContext(ctx context.Context, p *ListOauthProviderParams) (*ListOauthProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOauthProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListOauthProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOauthProviderWithContext indicates an expected call of ListOauthProviderWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) ListOauthProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOauthProviderWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).ListOauthProviderWithContext), ctx, p)
+}
+
 // NewDeleteOauthProviderParams mocks base method.
 func (m *MockOauthServiceIface) NewDeleteOauthProviderParams(id string) *DeleteOauthProviderParams {
 	m.ctrl.T.Helper()
@@ -207,3 +380,18 @@ func (mr *MockOauthServiceIfaceMockRecorder) UpdateOauthProvider(p any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOauthProvider", reflect.TypeOf((*MockOauthServiceIface)(nil).UpdateOauthProvider), p)
 }
+
+// UpdateOauthProviderWithContext mocks base method.
+func (m *MockOauthServiceIface) UpdateOauthProviderWithContext(ctx context.Context, p *UpdateOauthProviderParams) (*UpdateOauthProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateOauthProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateOauthProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateOauthProviderWithContext indicates an expected call of UpdateOauthProviderWithContext.
+func (mr *MockOauthServiceIfaceMockRecorder) UpdateOauthProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateOauthProviderWithContext", reflect.TypeOf((*MockOauthServiceIface)(nil).UpdateOauthProviderWithContext), ctx, p)
+}
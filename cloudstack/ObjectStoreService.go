@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,16 +31,28 @@ import (
 
 type ObjectStoreServiceIface interface {
 	CreateBucket(p *CreateBucketParams) (*CreateBucketResponse, error)
+	CreateBucketWithContext(ctx context.Context, p *CreateBucketParams) (*CreateBucketResponse, error)
+	CreateBucketAsync(ctx context.Context, p *CreateBucketParams) (*Job, error)
 	NewCreateBucketParams(name string, objectstorageid string, quota int) *CreateBucketParams
 	DeleteBucket(p *DeleteBucketParams) (*DeleteBucketResponse, error)
+	DeleteBucketWithContext(ctx context.Context, p *DeleteBucketParams) (*DeleteBucketResponse, error)
 	NewDeleteBucketParams(id string) *DeleteBucketParams
 	UpdateBucket(p *UpdateBucketParams) (*UpdateBucketResponse, error)
+	UpdateBucketWithContext(ctx context.Context, p *UpdateBucketParams) (*UpdateBucketResponse, error)
 	NewUpdateBucketParams(id string) *UpdateBucketParams
 	ListBuckets(p *ListBucketsParams) (*ListBucketsResponse, error)
+	ListBucketsWithContext(ctx context.Context, p *ListBucketsParams) (*ListBucketsResponse, error)
+	ListBucketsAll(p *ListBucketsParams, opts ...PageOption) ([]*Bucket, error)
+	ListBucketsAllWithContext(ctx context.Context, p *ListBucketsParams, opts ...PageOption) ([]*Bucket, error)
+	ListBucketsIter(p *ListBucketsParams, opts ...PageOption) iter.Seq2[*Bucket, error]
+	ListBucketsIterWithContext(ctx context.Context, p *ListBucketsParams, opts ...PageOption) iter.Seq2[*Bucket, error]
 	NewListBucketsParams() *ListBucketsParams
 	GetBucketID(name string, opts ...OptionFunc) (string, int, error)
+	GetBucketIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetBucketByName(name string, opts ...OptionFunc) (*Bucket, int, error)
+	GetBucketByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Bucket, int, error)
 	GetBucketByID(id string, opts ...OptionFunc) (*Bucket, int, error)
+	GetBucketByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Bucket, int, error)
 }
 
 type CreateBucketParams struct {
@@ -87,6 +101,53 @@ func (p *CreateBucketParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createBucket API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateBucketParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createBucket", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createBucket API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateBucketParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "objectstorageid", "quota"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "name": 255, "objectstorageid": 255, "policy": 255, "projectid": 255},
+	}.validate("createBucket", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createBucket API, before the request is signed
+func (p *CreateBucketParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateBucketParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateBucketParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createBucket", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"domainid":        decodeParam[string],
+		"encryption":      decodeParam[bool],
+		"name":            decodeParam[string],
+		"objectlocking":   decodeParam[bool],
+		"objectstorageid": decodeParam[string],
+		"policy":          decodeParam[string],
+		"projectid":       decodeParam[string],
+		"quota":           decodeParam[int],
+		"versioning":      decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateBucketParams) Clone() *CreateBucketParams {
+	return &CreateBucketParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateBucketParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,32 +371,39 @@ func (s *ObjectStoreService) NewCreateBucketParams(name string, objectstorageid
 
 // Creates a bucket in the specified object storage pool.
 func (s *ObjectStoreService) CreateBucket(p *CreateBucketParams) (*CreateBucketResponse, error) {
-	resp, err := s.cs.newPostRequest("createBucket", p.toURLValues())
+	return s.CreateBucketWithContext(context.Background(), p)
+}
+
+// CreateBucketWithContext is like CreateBucket, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) CreateBucketWithContext(ctx context.Context, p *CreateBucketParams) (*CreateBucketResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createBucket", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateBucketResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createBucket", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createBucket", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createBucket", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -343,33 +411,58 @@ func (s *ObjectStoreService) CreateBucket(p *CreateBucketParams) (*CreateBucketR
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateBucket async job into r
+func (r *CreateBucketResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateBucketAsync starts the async job of CreateBucket without waiting for it to finish, and returns a handle to the job
+func (s *ObjectStoreService) CreateBucketAsync(ctx context.Context, p *CreateBucketParams) (*Job, error) {
+	r, err := s.CreateBucketWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createBucket", r.JobID), nil
+}
+
 type CreateBucketResponse struct {
-	Accesskey       string `json:"accesskey"`
-	Account         string `json:"account"`
-	Created         string `json:"created"`
-	Domain          string `json:"domain"`
-	Domainid        string `json:"domainid"`
-	Domainpath      string `json:"domainpath"`
-	Encryption      bool   `json:"encryption"`
-	Hasannotations  bool   `json:"hasannotations"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Objectlocking   bool   `json:"objectlocking"`
-	Objectstorageid string `json:"objectstorageid"`
-	Objectstore     string `json:"objectstore"`
-	Policy          string `json:"policy"`
-	Project         string `json:"project"`
-	Projectid       string `json:"projectid"`
-	Provider        string `json:"provider"`
-	Quota           int    `json:"quota"`
-	Size            int64  `json:"size"`
-	State           string `json:"state"`
-	Tags            []Tags `json:"tags"`
-	Url             string `json:"url"`
-	Usersecretkey   string `json:"usersecretkey"`
-	Versioning      bool   `json:"versioning"`
+	Accesskey       string                     `json:"accesskey"`
+	Account         string                     `json:"account"`
+	Created         Time                       `json:"created"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Encryption      FlexBool                   `json:"encryption"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Objectlocking   FlexBool                   `json:"objectlocking"`
+	Objectstorageid string                     `json:"objectstorageid"`
+	Objectstore     string                     `json:"objectstore"`
+	Policy          string                     `json:"policy"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Provider        string                     `json:"provider"`
+	Quota           FlexInt                    `json:"quota"`
+	Size            FlexInt64                  `json:"size"`
+	State           string                     `json:"state"`
+	Tags            []Tags                     `json:"tags"`
+	Url             string                     `json:"url"`
+	Usersecretkey   string                     `json:"usersecretkey"`
+	Versioning      FlexBool                   `json:"versioning"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateBucketResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateBucketResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteBucketParams struct {
@@ -387,6 +480,44 @@ func (p *DeleteBucketParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteBucket API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteBucketParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteBucket", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteBucket API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteBucketParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteBucket", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteBucket API, before the request is signed
+func (p *DeleteBucketParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteBucketParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteBucketParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteBucket", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteBucketParams) Clone() *DeleteBucketParams {
+	return &DeleteBucketParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteBucketParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -419,13 +550,22 @@ func (s *ObjectStoreService) NewDeleteBucketParams(id string) *DeleteBucketParam
 
 // Deletes an empty Bucket.
 func (s *ObjectStoreService) DeleteBucket(p *DeleteBucketParams) (*DeleteBucketResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteBucket", p.toURLValues())
+	return s.DeleteBucketWithContext(context.Background(), p)
+}
+
+// DeleteBucketWithContext is like DeleteBucket, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) DeleteBucketWithContext(ctx context.Context, p *DeleteBucketParams) (*DeleteBucketResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteBucket", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteBucketResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteBucket", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -433,10 +573,11 @@ func (s *ObjectStoreService) DeleteBucket(p *DeleteBucketParams) (*DeleteBucketR
 }
 
 type DeleteBucketResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteBucketResponse) UnmarshalJSON(b []byte) error {
@@ -463,7 +604,7 @@ func (r *DeleteBucketResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteBucketResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateBucketParams struct {
@@ -496,6 +637,48 @@ func (p *UpdateBucketParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateBucket API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateBucketParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateBucket", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateBucket API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateBucketParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "policy": 255},
+	}.validate("updateBucket", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateBucket API, before the request is signed
+func (p *UpdateBucketParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateBucketParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateBucketParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateBucket", b, &p.p, map[string]paramDecoder{
+		"encryption": decodeParam[bool],
+		"id":         decodeParam[string],
+		"policy":     decodeParam[string],
+		"quota":      decodeParam[int],
+		"versioning": decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateBucketParams) Clone() *UpdateBucketParams {
+	return &UpdateBucketParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateBucketParams) SetEncryption(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -612,13 +795,22 @@ func (s *ObjectStoreService) NewUpdateBucketParams(id string) *UpdateBucketParam
 
 // Updates Bucket properties
 func (s *ObjectStoreService) UpdateBucket(p *UpdateBucketParams) (*UpdateBucketResponse, error) {
-	resp, err := s.cs.newPostRequest("updateBucket", p.toURLValues())
+	return s.UpdateBucketWithContext(context.Background(), p)
+}
+
+// UpdateBucketWithContext is like UpdateBucket, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) UpdateBucketWithContext(ctx context.Context, p *UpdateBucketParams) (*UpdateBucketResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateBucket", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateBucketResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateBucket", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -626,10 +818,11 @@ func (s *ObjectStoreService) UpdateBucket(p *UpdateBucketParams) (*UpdateBucketR
 }
 
 type UpdateBucketResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateBucketResponse) UnmarshalJSON(b []byte) error {
@@ -656,7 +849,7 @@ func (r *UpdateBucketResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateBucketResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListBucketsParams struct {
@@ -719,6 +912,55 @@ func (p *ListBucketsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listBuckets API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListBucketsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listBuckets", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listBuckets API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListBucketsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "objectstorageid": 255, "projectid": 255},
+	}.validate("listBuckets", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listBuckets API, before the request is signed
+func (p *ListBucketsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListBucketsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListBucketsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listBuckets", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"domainid":        decodeParam[string],
+		"id":              decodeParam[string],
+		"ids":             decodeParam[[]string],
+		"isrecursive":     decodeParam[bool],
+		"keyword":         decodeParam[string],
+		"listall":         decodeParam[bool],
+		"name":            decodeParam[string],
+		"objectstorageid": decodeParam[string],
+		"page":            decodeParam[int],
+		"pagesize":        decodeParam[int],
+		"projectid":       decodeParam[string],
+		"tags":            decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListBucketsParams) Clone() *ListBucketsParams {
+	return &ListBucketsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListBucketsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1002,6 +1244,11 @@ func (s *ObjectStoreService) NewListBucketsParams() *ListBucketsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ObjectStoreService) GetBucketID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetBucketIDWithContext(context.Background(), name, opts...)
+}
+
+// GetBucketIDWithContext is like GetBucketID, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) GetBucketIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListBucketsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1013,7 +1260,7 @@ func (s *ObjectStoreService) GetBucketID(name string, opts ...OptionFunc) (strin
 		}
 	}
 
-	l, err := s.ListBuckets(p)
+	l, err := s.ListBucketsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1038,12 +1285,17 @@ func (s *ObjectStoreService) GetBucketID(name string, opts ...OptionFunc) (strin
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ObjectStoreService) GetBucketByName(name string, opts ...OptionFunc) (*Bucket, int, error) {
-	id, count, err := s.GetBucketID(name, opts...)
+	return s.GetBucketByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetBucketByNameWithContext is like GetBucketByName, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) GetBucketByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Bucket, int, error) {
+	id, count, err := s.GetBucketIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetBucketByID(id, opts...)
+	r, count, err := s.GetBucketByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1052,6 +1304,11 @@ func (s *ObjectStoreService) GetBucketByName(name string, opts ...OptionFunc) (*
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ObjectStoreService) GetBucketByID(id string, opts ...OptionFunc) (*Bucket, int, error) {
+	return s.GetBucketByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetBucketByIDWithContext is like GetBucketByID, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) GetBucketByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Bucket, int, error) {
 	p := &ListBucketsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1063,11 +1320,10 @@ func (s *ObjectStoreService) GetBucketByID(id string, opts ...OptionFunc) (*Buck
 		}
 	}
 
-	l, err := s.ListBuckets(p)
+	l, err := s.ListBucketsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1085,49 +1341,92 @@ func (s *ObjectStoreService) GetBucketByID(id string, opts ...OptionFunc) (*Buck
 
 // Lists all Buckets.
 func (s *ObjectStoreService) ListBuckets(p *ListBucketsParams) (*ListBucketsResponse, error) {
-	resp, err := s.cs.newRequest("listBuckets", p.toURLValues())
+	return s.ListBucketsWithContext(context.Background(), p)
+}
+
+// ListBucketsWithContext is like ListBuckets, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) ListBucketsWithContext(ctx context.Context, p *ListBucketsParams) (*ListBucketsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listBuckets", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListBucketsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listBuckets", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListBucketsAll returns all Buckets matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ObjectStoreService) ListBucketsAll(p *ListBucketsParams, opts ...PageOption) ([]*Bucket, error) {
+	return s.ListBucketsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListBucketsAllWithContext is like ListBucketsAll, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) ListBucketsAllWithContext(ctx context.Context, p *ListBucketsParams, opts ...PageOption) ([]*Bucket, error) {
+	return collectPages(s.ListBucketsIterWithContext(ctx, p, opts...))
+}
+
+// ListBucketsIter returns an iterator over all Buckets matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ObjectStoreService) ListBucketsIter(p *ListBucketsParams, opts ...PageOption) iter.Seq2[*Bucket, error] {
+	return s.ListBucketsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListBucketsIterWithContext is like ListBucketsIter, but honours the cancellation and deadline of ctx
+func (s *ObjectStoreService) ListBucketsIterWithContext(ctx context.Context, p *ListBucketsParams, opts ...PageOption) iter.Seq2[*Bucket, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Bucket, int, error) {
+		l, err := s.ListBucketsWithContext(ctx, &ListBucketsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Buckets, l.Count, nil
+	}, opts...)
+}
+
 type ListBucketsResponse struct {
 	Count   int       `json:"count"`
 	Buckets []*Bucket `json:"bucket"`
 }
 
 type Bucket struct {
-	Accesskey       string `json:"accesskey"`
-	Account         string `json:"account"`
-	Created         string `json:"created"`
-	Domain          string `json:"domain"`
-	Domainid        string `json:"domainid"`
-	Domainpath      string `json:"domainpath"`
-	Encryption      bool   `json:"encryption"`
-	Hasannotations  bool   `json:"hasannotations"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Objectlocking   bool   `json:"objectlocking"`
-	Objectstorageid string `json:"objectstorageid"`
-	Objectstore     string `json:"objectstore"`
-	Policy          string `json:"policy"`
-	Project         string `json:"project"`
-	Projectid       string `json:"projectid"`
-	Provider        string `json:"provider"`
-	Quota           int    `json:"quota"`
-	Size            int64  `json:"size"`
-	State           string `json:"state"`
-	Tags            []Tags `json:"tags"`
-	Url             string `json:"url"`
-	Usersecretkey   string `json:"usersecretkey"`
-	Versioning      bool   `json:"versioning"`
+	Accesskey       string                     `json:"accesskey"`
+	Account         string                     `json:"account"`
+	Created         Time                       `json:"created"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Encryption      FlexBool                   `json:"encryption"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Objectlocking   FlexBool                   `json:"objectlocking"`
+	Objectstorageid string                     `json:"objectstorageid"`
+	Objectstore     string                     `json:"objectstore"`
+	Policy          string                     `json:"policy"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Provider        string                     `json:"provider"`
+	Quota           FlexInt                    `json:"quota"`
+	Size            FlexInt64                  `json:"size"`
+	State           string                     `json:"state"`
+	Tags            []Tags                     `json:"tags"`
+	Url             string                     `json:"url"`
+	Usersecretkey   string                     `json:"usersecretkey"`
+	Versioning      FlexBool                   `json:"versioning"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Bucket) UnmarshalJSON(b []byte) error {
+	type alias Bucket
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockObjectStoreServiceIfaceMockRecorder) CreateBucket(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucket", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).CreateBucket), p)
 }
 
+// CreateBucketAsync mocks base method.
+func (m *MockObjectStoreServiceIface) CreateBucketAsync(ctx context.Context, p *CreateBucketParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBucketAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBucketAsync indicates an expected call of CreateBucketAsync.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) CreateBucketAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketAsync", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).CreateBucketAsync), ctx, p)
+}
+
+// CreateBucketWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) CreateBucketWithContext(ctx context.Context, p *CreateBucketParams) (*CreateBucketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateBucketWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateBucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateBucketWithContext indicates an expected call of CreateBucketWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) CreateBucketWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateBucketWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).CreateBucketWithContext), ctx, p)
+}
+
 // DeleteBucket mocks base method.
 func (m *MockObjectStoreServiceIface) DeleteBucket(p *DeleteBucketParams) (*DeleteBucketResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,21 @@ func (mr *MockObjectStoreServiceIfaceMockRecorder) DeleteBucket(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucket", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).DeleteBucket), p)
 }
 
+// DeleteBucketWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) DeleteBucketWithContext(ctx context.Context, p *DeleteBucketParams) (*DeleteBucketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteBucketWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteBucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteBucketWithContext indicates an expected call of DeleteBucketWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) DeleteBucketWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteBucketWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).DeleteBucketWithContext), ctx, p)
+}
+
 // GetBucketByID mocks base method.
 func (m *MockObjectStoreServiceIface) GetBucketByID(id string, opts ...OptionFunc) (*Bucket, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +156,27 @@ func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketByID(id any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByID", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketByID), varargs...)
 }
 
+// GetBucketByIDWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) GetBucketByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Bucket, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Bucket)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBucketByIDWithContext indicates an expected call of GetBucketByIDWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByIDWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketByIDWithContext), varargs...)
+}
+
 // GetBucketByName mocks base method.
 func (m *MockObjectStoreServiceIface) GetBucketByName(name string, opts ...OptionFunc) (*Bucket, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +198,27 @@ func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketByName(name any, opt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByName", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketByName), varargs...)
 }
 
+// GetBucketByNameWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) GetBucketByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Bucket, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Bucket)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBucketByNameWithContext indicates an expected call of GetBucketByNameWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketByNameWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketByNameWithContext), varargs...)
+}
+
 // GetBucketID mocks base method.
 func (m *MockObjectStoreServiceIface) GetBucketID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +240,27 @@ func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketID(name any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketID", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketID), varargs...)
 }
 
+// GetBucketIDWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) GetBucketIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetBucketIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetBucketIDWithContext indicates an expected call of GetBucketIDWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) GetBucketIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetBucketIDWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).GetBucketIDWithContext), varargs...)
+}
+
 // ListBuckets mocks base method.
 func (m *MockObjectStoreServiceIface) ListBuckets(p *ListBucketsParams) (*ListBucketsResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +276,99 @@ func (mr *MockObjectStoreServiceIfaceMockRecorder) ListBuckets(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBuckets", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListBuckets), p)
 }
 
+// ListBucketsAll mocks base method.
+func (m *MockObjectStoreServiceIface) ListBucketsAll(p *ListBucketsParams, opts ...PageOption) ([]*Bucket, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBucketsAll", varargs...)
+	ret0, _ := ret[0].([]*Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBucketsAll indicates an expected call of ListBucketsAll.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) ListBucketsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBucketsAll", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListBucketsAll), varargs...)
+}
+
+// ListBucketsAllWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) ListBucketsAllWithContext(ctx context.Context, p *ListBucketsParams, opts ...PageOption) ([]*Bucket, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBucketsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Bucket)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBucketsAllWithContext indicates an expected call of ListBucketsAllWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) ListBucketsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBucketsAllWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListBucketsAllWithContext), varargs...)
+}
+
+// ListBucketsIter mocks base method.
+func (m *MockObjectStoreServiceIface) ListBucketsIter(p *ListBucketsParams, opts ...PageOption) iter.Seq2[*Bucket, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBucketsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Bucket, error])
+	return ret0
+}
+
+// ListBucketsIter indicates an expected call of ListBucketsIter.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) ListBucketsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBucketsIter", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListBucketsIter), varargs...)
+}
+
+// ListBucketsIterWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) ListBucketsIterWithContext(ctx context.Context, p *ListBucketsParams, opts ...PageOption) iter.Seq2[*Bucket, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListBucketsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Bucket, error])
+	return ret0
+}
+
+// ListBucketsIterWithContext indicates an expected call of ListBucketsIterWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) ListBucketsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBucketsIterWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListBucketsIterWithContext), varargs...)
+}
+
+// ListBucketsWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) ListBucketsWithContext(ctx context.Context, p *ListBucketsParams) (*ListBucketsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListBucketsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListBucketsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListBucketsWithContext indicates an expected call of ListBucketsWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) ListBucketsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListBucketsWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).ListBucketsWithContext), ctx, p)
+}
+
 // NewCreateBucketParams mocks base method.
 func (m *MockObjectStoreServiceIface) NewCreateBucketParams(name, objectstorageid string, quota int) *CreateBucketParams {
 	m.ctrl.T.Helper()
@@ -236,3 +439,18 @@ func (mr *MockObjectStoreServiceIfaceMockRecorder) UpdateBucket(p any) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBucket", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).UpdateBucket), p)
 }
+
+// UpdateBucketWithContext mocks base method.
+func (m *MockObjectStoreServiceIface) UpdateBucketWithContext(ctx context.Context, p *UpdateBucketParams) (*UpdateBucketResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateBucketWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateBucketResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateBucketWithContext indicates an expected call of UpdateBucketWithContext.
+func (mr *MockObjectStoreServiceIfaceMockRecorder) UpdateBucketWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateBucketWithContext", reflect.TypeOf((*MockObjectStoreServiceIface)(nil).UpdateBucketWithContext), ctx, p)
+}
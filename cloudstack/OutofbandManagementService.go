@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -27,10 +28,15 @@ import (
 
 type OutofbandManagementServiceIface interface {
 	ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error)
+	ChangeOutOfBandManagementPasswordAsync(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*Job, error)
 	NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams
 	ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error)
+	ConfigureOutOfBandManagementWithContext(ctx context.Context, p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error)
 	NewConfigureOutOfBandManagementParams(address string, driver string, hostid string, password string, port string, username string) *ConfigureOutOfBandManagementParams
 	IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error)
+	IssueOutOfBandManagementPowerActionAsync(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*Job, error)
 	NewIssueOutOfBandManagementPowerActionParams(action string, hostid string) *IssueOutOfBandManagementPowerActionParams
 }
 
@@ -52,6 +58,45 @@ func (p *ChangeOutOfBandManagementPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeOutOfBandManagementPassword API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeOutOfBandManagementPasswordParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeOutOfBandManagementPassword", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeOutOfBandManagementPassword API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeOutOfBandManagementPasswordParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255, "password": 255},
+	}.validate("changeOutOfBandManagementPassword", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeOutOfBandManagementPassword API, before the request is signed
+func (p *ChangeOutOfBandManagementPasswordParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeOutOfBandManagementPasswordParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeOutOfBandManagementPasswordParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeOutOfBandManagementPassword", b, &p.p, map[string]paramDecoder{
+		"hostid":   decodeParam[string],
+		"password": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeOutOfBandManagementPasswordParams) Clone() *ChangeOutOfBandManagementPasswordParams {
+	return &ChangeOutOfBandManagementPasswordParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeOutOfBandManagementPasswordParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -105,32 +150,39 @@ func (s *OutofbandManagementService) NewChangeOutOfBandManagementPasswordParams(
 
 // Changes out-of-band management interface password on the host and updates the interface configuration in CloudStack if the operation succeeds, else reverts the old password
 func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
-	resp, err := s.cs.newPostRequest("changeOutOfBandManagementPassword", p.toURLValues())
+	return s.ChangeOutOfBandManagementPasswordWithContext(context.Background(), p)
+}
+
+// ChangeOutOfBandManagementPasswordWithContext is like ChangeOutOfBandManagementPassword, but honours the cancellation and deadline of ctx
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "changeOutOfBandManagementPassword", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeOutOfBandManagementPasswordResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeOutOfBandManagementPassword", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "changeOutOfBandManagementPassword", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("changeOutOfBandManagementPassword", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -138,20 +190,45 @@ func (s *OutofbandManagementService) ChangeOutOfBandManagementPassword(p *Change
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ChangeOutOfBandManagementPassword async job into r
+func (r *ChangeOutOfBandManagementPasswordResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ChangeOutOfBandManagementPasswordAsync starts the async job of ChangeOutOfBandManagementPassword without waiting for it to finish, and returns a handle to the job
+func (s *OutofbandManagementService) ChangeOutOfBandManagementPasswordAsync(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*Job, error) {
+	r, err := s.ChangeOutOfBandManagementPasswordWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("changeOutOfBandManagementPassword", r.JobID), nil
+}
+
 type ChangeOutOfBandManagementPasswordResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeOutOfBandManagementPasswordResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeOutOfBandManagementPasswordResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ConfigureOutOfBandManagementParams struct {
@@ -184,6 +261,49 @@ func (p *ConfigureOutOfBandManagementParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configureOutOfBandManagement API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigureOutOfBandManagementParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configureOutOfBandManagement", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configureOutOfBandManagement API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigureOutOfBandManagementParams) Validate() error {
+	return paramRules{
+		required: []string{"address", "driver", "hostid", "password", "port", "username"},
+		lengths:  map[string]int{"address": 255, "driver": 255, "hostid": 255, "password": 255, "port": 255, "username": 255},
+	}.validate("configureOutOfBandManagement", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configureOutOfBandManagement API, before the request is signed
+func (p *ConfigureOutOfBandManagementParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigureOutOfBandManagementParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigureOutOfBandManagementParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configureOutOfBandManagement", b, &p.p, map[string]paramDecoder{
+		"address":  decodeParam[string],
+		"driver":   decodeParam[string],
+		"hostid":   decodeParam[string],
+		"password": decodeParam[string],
+		"port":     decodeParam[string],
+		"username": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigureOutOfBandManagementParams) Clone() *ConfigureOutOfBandManagementParams {
+	return &ConfigureOutOfBandManagementParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigureOutOfBandManagementParams) SetAddress(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -326,13 +446,22 @@ func (s *OutofbandManagementService) NewConfigureOutOfBandManagementParams(addre
 
 // Configures a host's out-of-band management interface
 func (s *OutofbandManagementService) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
-	resp, err := s.cs.newPostRequest("configureOutOfBandManagement", p.toURLValues())
+	return s.ConfigureOutOfBandManagementWithContext(context.Background(), p)
+}
+
+// ConfigureOutOfBandManagementWithContext is like ConfigureOutOfBandManagement, but honours the cancellation and deadline of ctx
+func (s *OutofbandManagementService) ConfigureOutOfBandManagementWithContext(ctx context.Context, p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "configureOutOfBandManagement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r OutOfBandManagementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configureOutOfBandManagement", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -340,19 +469,25 @@ func (s *OutofbandManagementService) ConfigureOutOfBandManagement(p *ConfigureOu
 }
 
 type OutOfBandManagementResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OutOfBandManagementResponse) UnmarshalJSON(b []byte) error {
+	type alias OutOfBandManagementResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type IssueOutOfBandManagementPowerActionParams struct {
@@ -377,6 +512,46 @@ func (p *IssueOutOfBandManagementPowerActionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the issueOutOfBandManagementPowerAction API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *IssueOutOfBandManagementPowerActionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("issueOutOfBandManagementPowerAction", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the issueOutOfBandManagementPowerAction API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *IssueOutOfBandManagementPowerActionParams) Validate() error {
+	return paramRules{
+		required: []string{"action", "hostid"},
+		lengths:  map[string]int{"action": 255, "hostid": 255},
+	}.validate("issueOutOfBandManagementPowerAction", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the issueOutOfBandManagementPowerAction API, before the request is signed
+func (p *IssueOutOfBandManagementPowerActionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *IssueOutOfBandManagementPowerActionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *IssueOutOfBandManagementPowerActionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("issueOutOfBandManagementPowerAction", b, &p.p, map[string]paramDecoder{
+		"action":  decodeParam[string],
+		"hostid":  decodeParam[string],
+		"timeout": decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *IssueOutOfBandManagementPowerActionParams) Clone() *IssueOutOfBandManagementPowerActionParams {
+	return &IssueOutOfBandManagementPowerActionParams{p: cloneParams(p.p)}
+}
+
 func (p *IssueOutOfBandManagementPowerActionParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -452,32 +627,39 @@ func (s *OutofbandManagementService) NewIssueOutOfBandManagementPowerActionParam
 
 // Initiates the specified power action to the host's out-of-band management interface
 func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
-	resp, err := s.cs.newPostRequest("issueOutOfBandManagementPowerAction", p.toURLValues())
+	return s.IssueOutOfBandManagementPowerActionWithContext(context.Background(), p)
+}
+
+// IssueOutOfBandManagementPowerActionWithContext is like IssueOutOfBandManagementPowerAction, but honours the cancellation and deadline of ctx
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "issueOutOfBandManagementPowerAction", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r IssueOutOfBandManagementPowerActionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("issueOutOfBandManagementPowerAction", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "issueOutOfBandManagementPowerAction", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("issueOutOfBandManagementPowerAction", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -485,18 +667,43 @@ func (s *OutofbandManagementService) IssueOutOfBandManagementPowerAction(p *Issu
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished IssueOutOfBandManagementPowerAction async job into r
+func (r *IssueOutOfBandManagementPowerActionResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// IssueOutOfBandManagementPowerActionAsync starts the async job of IssueOutOfBandManagementPowerAction without waiting for it to finish, and returns a handle to the job
+func (s *OutofbandManagementService) IssueOutOfBandManagementPowerActionAsync(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*Job, error) {
+	r, err := s.IssueOutOfBandManagementPowerActionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("issueOutOfBandManagementPowerAction", r.JobID), nil
+}
+
 type IssueOutOfBandManagementPowerActionResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *IssueOutOfBandManagementPowerActionResponse) UnmarshalJSON(b []byte) error {
+	type alias IssueOutOfBandManagementPowerActionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,36 @@ func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ChangeOutOfBandManage
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOutOfBandManagementPassword", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ChangeOutOfBandManagementPassword), p)
 }
 
+// ChangeOutOfBandManagementPasswordAsync mocks base method.
+func (m *MockOutofbandManagementServiceIface) ChangeOutOfBandManagementPasswordAsync(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeOutOfBandManagementPasswordAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeOutOfBandManagementPasswordAsync indicates an expected call of ChangeOutOfBandManagementPasswordAsync.
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ChangeOutOfBandManagementPasswordAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOutOfBandManagementPasswordAsync", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ChangeOutOfBandManagementPasswordAsync), ctx, p)
+}
+
+// ChangeOutOfBandManagementPasswordWithContext mocks base method.
+func (m *MockOutofbandManagementServiceIface) ChangeOutOfBandManagementPasswordWithContext(ctx context.Context, p *ChangeOutOfBandManagementPasswordParams) (*ChangeOutOfBandManagementPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeOutOfBandManagementPasswordWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeOutOfBandManagementPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeOutOfBandManagementPasswordWithContext indicates an expected call of ChangeOutOfBandManagementPasswordWithContext.
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ChangeOutOfBandManagementPasswordWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOutOfBandManagementPasswordWithContext", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ChangeOutOfBandManagementPasswordWithContext), ctx, p)
+}
+
 // ConfigureOutOfBandManagement mocks base method.
 func (m *MockOutofbandManagementServiceIface) ConfigureOutOfBandManagement(p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +119,21 @@ func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ConfigureOutOfBandMan
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOutOfBandManagement", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ConfigureOutOfBandManagement), p)
 }
 
+// ConfigureOutOfBandManagementWithContext mocks base method.
+func (m *MockOutofbandManagementServiceIface) ConfigureOutOfBandManagementWithContext(ctx context.Context, p *ConfigureOutOfBandManagementParams) (*OutOfBandManagementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureOutOfBandManagementWithContext", ctx, p)
+	ret0, _ := ret[0].(*OutOfBandManagementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureOutOfBandManagementWithContext indicates an expected call of ConfigureOutOfBandManagementWithContext.
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) ConfigureOutOfBandManagementWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOutOfBandManagementWithContext", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).ConfigureOutOfBandManagementWithContext), ctx, p)
+}
+
 // IssueOutOfBandManagementPowerAction mocks base method.
 func (m *MockOutofbandManagementServiceIface) IssueOutOfBandManagementPowerAction(p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +149,36 @@ func (mr *MockOutofbandManagementServiceIfaceMockRecorder) IssueOutOfBandManagem
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueOutOfBandManagementPowerAction", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).IssueOutOfBandManagementPowerAction), p)
 }
 
+// IssueOutOfBandManagementPowerActionAsync mocks base method.
+func (m *MockOutofbandManagementServiceIface) IssueOutOfBandManagementPowerActionAsync(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueOutOfBandManagementPowerActionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueOutOfBandManagementPowerActionAsync indicates an expected call of IssueOutOfBandManagementPowerActionAsync.
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) IssueOutOfBandManagementPowerActionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueOutOfBandManagementPowerActionAsync", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).IssueOutOfBandManagementPowerActionAsync), ctx, p)
+}
+
+// IssueOutOfBandManagementPowerActionWithContext mocks base method.
+func (m *MockOutofbandManagementServiceIface) IssueOutOfBandManagementPowerActionWithContext(ctx context.Context, p *IssueOutOfBandManagementPowerActionParams) (*IssueOutOfBandManagementPowerActionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IssueOutOfBandManagementPowerActionWithContext", ctx, p)
+	ret0, _ := ret[0].(*IssueOutOfBandManagementPowerActionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IssueOutOfBandManagementPowerActionWithContext indicates an expected call of IssueOutOfBandManagementPowerActionWithContext.
+func (mr *MockOutofbandManagementServiceIfaceMockRecorder) IssueOutOfBandManagementPowerActionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IssueOutOfBandManagementPowerActionWithContext", reflect.TypeOf((*MockOutofbandManagementServiceIface)(nil).IssueOutOfBandManagementPowerActionWithContext), ctx, p)
+}
+
 // NewChangeOutOfBandManagementPasswordParams mocks base method.
 func (m *MockOutofbandManagementServiceIface) NewChangeOutOfBandManagementPasswordParams(hostid string) *ChangeOutOfBandManagementPasswordParams {
 	m.ctrl.T.Helper()
@@ -20,19 +20,28 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type OvsElementServiceIface interface {
 	ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error)
+	ConfigureOvsElementWithContext(ctx context.Context, p *ConfigureOvsElementParams) (*OvsElementResponse, error)
+	ConfigureOvsElementAsync(ctx context.Context, p *ConfigureOvsElementParams) (*Job, error)
 	NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams
 	ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	ListOvsElementsWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error)
+	ListOvsElementsAll(p *ListOvsElementsParams, opts ...PageOption) ([]*OvsElement, error)
+	ListOvsElementsAllWithContext(ctx context.Context, p *ListOvsElementsParams, opts ...PageOption) ([]*OvsElement, error)
+	ListOvsElementsIter(p *ListOvsElementsParams, opts ...PageOption) iter.Seq2[*OvsElement, error]
+	ListOvsElementsIterWithContext(ctx context.Context, p *ListOvsElementsParams, opts ...PageOption) iter.Seq2[*OvsElement, error]
 	NewListOvsElementsParams() *ListOvsElementsParams
 	GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error)
+	GetOvsElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OvsElement, int, error)
 }
 
 type ConfigureOvsElementParams struct {
@@ -54,6 +63,45 @@ func (p *ConfigureOvsElementParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configureOvsElement API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigureOvsElementParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configureOvsElement", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configureOvsElement API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigureOvsElementParams) Validate() error {
+	return paramRules{
+		required: []string{"enabled", "id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("configureOvsElement", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configureOvsElement API, before the request is signed
+func (p *ConfigureOvsElementParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigureOvsElementParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigureOvsElementParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configureOvsElement", b, &p.p, map[string]paramDecoder{
+		"enabled": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigureOvsElementParams) Clone() *ConfigureOvsElementParams {
+	return &ConfigureOvsElementParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigureOvsElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -108,32 +156,39 @@ func (s *OvsElementService) NewConfigureOvsElementParams(enabled bool, id string
 
 // Configures an ovs element.
 func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
-	resp, err := s.cs.newPostRequest("configureOvsElement", p.toURLValues())
+	return s.ConfigureOvsElementWithContext(context.Background(), p)
+}
+
+// ConfigureOvsElementWithContext is like ConfigureOvsElement, but honours the cancellation and deadline of ctx
+func (s *OvsElementService) ConfigureOvsElementWithContext(ctx context.Context, p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "configureOvsElement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r OvsElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configureOvsElement", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "configureOvsElement", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("configureOvsElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -141,18 +196,43 @@ func (s *OvsElementService) ConfigureOvsElement(p *ConfigureOvsElementParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ConfigureOvsElement async job into r
+func (r *OvsElementResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ConfigureOvsElementAsync starts the async job of ConfigureOvsElement without waiting for it to finish, and returns a handle to the job
+func (s *OvsElementService) ConfigureOvsElementAsync(ctx context.Context, p *ConfigureOvsElementParams) (*Job, error) {
+	r, err := s.ConfigureOvsElementWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("configureOvsElement", r.JobID), nil
+}
+
 type OvsElementResponse struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Enabled    bool   `json:"enabled"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Nspid      string `json:"nspid"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Enabled    FlexBool                   `json:"enabled"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Nspid      string                     `json:"nspid"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OvsElementResponse) UnmarshalJSON(b []byte) error {
+	type alias OvsElementResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListOvsElementsParams struct {
@@ -188,6 +268,48 @@ func (p *ListOvsElementsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listOvsElements API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListOvsElementsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listOvsElements", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listOvsElements API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListOvsElementsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "nspid": 255},
+	}.validate("listOvsElements", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listOvsElements API, before the request is signed
+func (p *ListOvsElementsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListOvsElementsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListOvsElementsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listOvsElements", b, &p.p, map[string]paramDecoder{
+		"enabled":  decodeParam[bool],
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"nspid":    decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListOvsElementsParams) Clone() *ListOvsElementsParams {
+	return &ListOvsElementsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListOvsElementsParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -324,6 +446,11 @@ func (s *OvsElementService) NewListOvsElementsParams() *ListOvsElementsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error) {
+	return s.GetOvsElementByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetOvsElementByIDWithContext is like GetOvsElementByID, but honours the cancellation and deadline of ctx
+func (s *OvsElementService) GetOvsElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OvsElement, int, error) {
 	p := &ListOvsElementsParams{}
 	p.p = make(map[string]interface{})
 
@@ -335,11 +462,10 @@ func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*O
 		}
 	}
 
-	l, err := s.ListOvsElements(p)
+	l, err := s.ListOvsElementsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -357,34 +483,77 @@ func (s *OvsElementService) GetOvsElementByID(id string, opts ...OptionFunc) (*O
 
 // Lists all available ovs elements.
 func (s *OvsElementService) ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
-	resp, err := s.cs.newRequest("listOvsElements", p.toURLValues())
+	return s.ListOvsElementsWithContext(context.Background(), p)
+}
+
+// ListOvsElementsWithContext is like ListOvsElements, but honours the cancellation and deadline of ctx
+func (s *OvsElementService) ListOvsElementsWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listOvsElements", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListOvsElementsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listOvsElements", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListOvsElementsAll returns all OvsElements matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *OvsElementService) ListOvsElementsAll(p *ListOvsElementsParams, opts ...PageOption) ([]*OvsElement, error) {
+	return s.ListOvsElementsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListOvsElementsAllWithContext is like ListOvsElementsAll, but honours the cancellation and deadline of ctx
+func (s *OvsElementService) ListOvsElementsAllWithContext(ctx context.Context, p *ListOvsElementsParams, opts ...PageOption) ([]*OvsElement, error) {
+	return collectPages(s.ListOvsElementsIterWithContext(ctx, p, opts...))
+}
+
+// ListOvsElementsIter returns an iterator over all OvsElements matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *OvsElementService) ListOvsElementsIter(p *ListOvsElementsParams, opts ...PageOption) iter.Seq2[*OvsElement, error] {
+	return s.ListOvsElementsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListOvsElementsIterWithContext is like ListOvsElementsIter, but honours the cancellation and deadline of ctx
+func (s *OvsElementService) ListOvsElementsIterWithContext(ctx context.Context, p *ListOvsElementsParams, opts ...PageOption) iter.Seq2[*OvsElement, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*OvsElement, int, error) {
+		l, err := s.ListOvsElementsWithContext(ctx, &ListOvsElementsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.OvsElements, l.Count, nil
+	}, opts...)
+}
+
 type ListOvsElementsResponse struct {
 	Count       int           `json:"count"`
 	OvsElements []*OvsElement `json:"ovselement"`
 }
 
 type OvsElement struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Enabled    bool   `json:"enabled"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Nspid      string `json:"nspid"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Enabled    FlexBool                   `json:"enabled"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Nspid      string                     `json:"nspid"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *OvsElement) UnmarshalJSON(b []byte) error {
+	type alias OvsElement
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
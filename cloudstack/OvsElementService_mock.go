@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockOvsElementServiceIfaceMockRecorder) ConfigureOvsElement(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOvsElement", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ConfigureOvsElement), p)
 }
 
+// ConfigureOvsElementAsync mocks base method.
+func (m *MockOvsElementServiceIface) ConfigureOvsElementAsync(ctx context.Context, p *ConfigureOvsElementParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureOvsElementAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureOvsElementAsync indicates an expected call of ConfigureOvsElementAsync.
+func (mr *MockOvsElementServiceIfaceMockRecorder) ConfigureOvsElementAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOvsElementAsync", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ConfigureOvsElementAsync), ctx, p)
+}
+
+// ConfigureOvsElementWithContext mocks base method.
+func (m *MockOvsElementServiceIface) ConfigureOvsElementWithContext(ctx context.Context, p *ConfigureOvsElementParams) (*OvsElementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureOvsElementWithContext", ctx, p)
+	ret0, _ := ret[0].(*OvsElementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureOvsElementWithContext indicates an expected call of ConfigureOvsElementWithContext.
+func (mr *MockOvsElementServiceIfaceMockRecorder) ConfigureOvsElementWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureOvsElementWithContext", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ConfigureOvsElementWithContext), ctx, p)
+}
+
 // GetOvsElementByID mocks base method.
 func (m *MockOvsElementServiceIface) GetOvsElementByID(id string, opts ...OptionFunc) (*OvsElement, int, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +126,27 @@ func (mr *MockOvsElementServiceIfaceMockRecorder) GetOvsElementByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvsElementByID", reflect.TypeOf((*MockOvsElementServiceIface)(nil).GetOvsElementByID), varargs...)
 }
 
+// GetOvsElementByIDWithContext mocks base method.
+func (m *MockOvsElementServiceIface) GetOvsElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*OvsElement, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetOvsElementByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*OvsElement)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetOvsElementByIDWithContext indicates an expected call of GetOvsElementByIDWithContext.
+func (mr *MockOvsElementServiceIfaceMockRecorder) GetOvsElementByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOvsElementByIDWithContext", reflect.TypeOf((*MockOvsElementServiceIface)(nil).GetOvsElementByIDWithContext), varargs...)
+}
+
 // ListOvsElements mocks base method.
 func (m *MockOvsElementServiceIface) ListOvsElements(p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +162,99 @@ func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElements(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElements", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElements), p)
 }
 
+// ListOvsElementsAll mocks base method.
+func (m *MockOvsElementServiceIface) ListOvsElementsAll(p *ListOvsElementsParams, opts ...PageOption) ([]*OvsElement, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOvsElementsAll", varargs...)
+	ret0, _ := ret[0].([]*OvsElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOvsElementsAll indicates an expected call of ListOvsElementsAll.
+func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElementsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElementsAll", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElementsAll), varargs...)
+}
+
+// ListOvsElementsAllWithContext mocks base method.
+func (m *MockOvsElementServiceIface) ListOvsElementsAllWithContext(ctx context.Context, p *ListOvsElementsParams, opts ...PageOption) ([]*OvsElement, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOvsElementsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*OvsElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOvsElementsAllWithContext indicates an expected call of ListOvsElementsAllWithContext.
+func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElementsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElementsAllWithContext", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElementsAllWithContext), varargs...)
+}
+
+// ListOvsElementsIter mocks base method.
+func (m *MockOvsElementServiceIface) ListOvsElementsIter(p *ListOvsElementsParams, opts ...PageOption) iter.Seq2[*OvsElement, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOvsElementsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OvsElement, error])
+	return ret0
+}
+
+// ListOvsElementsIter indicates an expected call of ListOvsElementsIter.
+func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElementsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElementsIter", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElementsIter), varargs...)
+}
+
+// ListOvsElementsIterWithContext mocks base method.
+func (m *MockOvsElementServiceIface) ListOvsElementsIterWithContext(ctx context.Context, p *ListOvsElementsParams, opts ...PageOption) iter.Seq2[*OvsElement, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListOvsElementsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*OvsElement, error])
+	return ret0
+}
+
+// ListOvsElementsIterWithContext indicates an expected call of ListOvsElementsIterWithContext.
+func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElementsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElementsIterWithContext", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElementsIterWithContext), varargs...)
+}
+
+// ListOvsElementsWithContext mocks base method.
+func (m *MockOvsElementServiceIface) ListOvsElementsWithContext(ctx context.Context, p *ListOvsElementsParams) (*ListOvsElementsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListOvsElementsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListOvsElementsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListOvsElementsWithContext indicates an expected call of ListOvsElementsWithContext.
+func (mr *MockOvsElementServiceIfaceMockRecorder) ListOvsElementsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListOvsElementsWithContext", reflect.TypeOf((*MockOvsElementServiceIface)(nil).ListOvsElementsWithContext), ctx, p)
+}
+
 // NewConfigureOvsElementParams mocks base method.
 func (m *MockOvsElementServiceIface) NewConfigureOvsElementParams(enabled bool, id string) *ConfigureOvsElementParams {
 	m.ctrl.T.Helper()
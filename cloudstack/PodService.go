@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,27 +31,53 @@ import (
 
 type PodServiceIface interface {
 	CreateManagementNetworkIpRange(p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error)
+	CreateManagementNetworkIpRangeWithContext(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error)
+	CreateManagementNetworkIpRangeAsync(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*Job, error)
 	NewCreateManagementNetworkIpRangeParams(gateway string, netmask string, podid string, startip string) *CreateManagementNetworkIpRangeParams
 	CreatePod(p *CreatePodParams) (*CreatePodResponse, error)
+	CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error)
 	NewCreatePodParams(name string, zoneid string) *CreatePodParams
 	DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error)
+	DedicatePodAsync(ctx context.Context, p *DedicatePodParams) (*Job, error)
 	NewDedicatePodParams(domainid string, podid string) *DedicatePodParams
 	DeleteManagementNetworkIpRange(p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error)
+	DeleteManagementNetworkIpRangeWithContext(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error)
+	DeleteManagementNetworkIpRangeAsync(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*Job, error)
 	NewDeleteManagementNetworkIpRangeParams(endip string, podid string, startip string, vlan string) *DeleteManagementNetworkIpRangeParams
 	DeletePod(p *DeletePodParams) (*DeletePodResponse, error)
+	DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error)
 	NewDeletePodParams(id string) *DeletePodParams
 	ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error)
+	ListDedicatedPodsAll(p *ListDedicatedPodsParams, opts ...PageOption) ([]*DedicatedPod, error)
+	ListDedicatedPodsAllWithContext(ctx context.Context, p *ListDedicatedPodsParams, opts ...PageOption) ([]*DedicatedPod, error)
+	ListDedicatedPodsIter(p *ListDedicatedPodsParams, opts ...PageOption) iter.Seq2[*DedicatedPod, error]
+	ListDedicatedPodsIterWithContext(ctx context.Context, p *ListDedicatedPodsParams, opts ...PageOption) iter.Seq2[*DedicatedPod, error]
 	NewListDedicatedPodsParams() *ListDedicatedPodsParams
 	ListPods(p *ListPodsParams) (*ListPodsResponse, error)
+	ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error)
+	ListPodsAll(p *ListPodsParams, opts ...PageOption) ([]*Pod, error)
+	ListPodsAllWithContext(ctx context.Context, p *ListPodsParams, opts ...PageOption) ([]*Pod, error)
+	ListPodsIter(p *ListPodsParams, opts ...PageOption) iter.Seq2[*Pod, error]
+	ListPodsIterWithContext(ctx context.Context, p *ListPodsParams, opts ...PageOption) iter.Seq2[*Pod, error]
 	NewListPodsParams() *ListPodsParams
 	GetPodID(name string, opts ...OptionFunc) (string, int, error)
+	GetPodIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Pod, int, error)
 	GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error)
+	GetPodByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Pod, int, error)
 	ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error)
+	ReleaseDedicatedPodAsync(ctx context.Context, p *ReleaseDedicatedPodParams) (*Job, error)
 	NewReleaseDedicatedPodParams(podid string) *ReleaseDedicatedPodParams
 	UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error)
+	UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error)
 	NewUpdatePodParams(id string) *UpdatePodParams
 	UpdatePodManagementNetworkIpRange(p *UpdatePodManagementNetworkIpRangeParams) (*UpdatePodManagementNetworkIpRangeResponse, error)
+	UpdatePodManagementNetworkIpRangeWithContext(ctx context.Context, p *UpdatePodManagementNetworkIpRangeParams) (*UpdatePodManagementNetworkIpRangeResponse, error)
+	UpdatePodManagementNetworkIpRangeAsync(ctx context.Context, p *UpdatePodManagementNetworkIpRangeParams) (*Job, error)
 	NewUpdatePodManagementNetworkIpRangeParams(currentendip string, currentstartip string, podid string) *UpdatePodManagementNetworkIpRangeParams
 }
 
@@ -87,6 +115,50 @@ func (p *CreateManagementNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createManagementNetworkIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateManagementNetworkIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createManagementNetworkIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createManagementNetworkIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateManagementNetworkIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"gateway", "netmask", "podid", "startip"},
+		lengths:  map[string]int{"endip": 255, "gateway": 255, "netmask": 255, "podid": 255, "startip": 255, "vlan": 255},
+	}.validate("createManagementNetworkIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createManagementNetworkIpRange API, before the request is signed
+func (p *CreateManagementNetworkIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateManagementNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateManagementNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createManagementNetworkIpRange", b, &p.p, map[string]paramDecoder{
+		"endip":        decodeParam[string],
+		"forsystemvms": decodeParam[bool],
+		"gateway":      decodeParam[string],
+		"netmask":      decodeParam[string],
+		"podid":        decodeParam[string],
+		"startip":      decodeParam[string],
+		"vlan":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateManagementNetworkIpRangeParams) Clone() *CreateManagementNetworkIpRangeParams {
+	return &CreateManagementNetworkIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateManagementNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -248,32 +320,39 @@ func (s *PodService) NewCreateManagementNetworkIpRangeParams(gateway string, net
 
 // Creates a Management network IP range.
 func (s *PodService) CreateManagementNetworkIpRange(p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("createManagementNetworkIpRange", p.toURLValues())
+	return s.CreateManagementNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// CreateManagementNetworkIpRangeWithContext is like CreateManagementNetworkIpRange, but honours the cancellation and deadline of ctx
+func (s *PodService) CreateManagementNetworkIpRangeWithContext(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createManagementNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateManagementNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createManagementNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createManagementNetworkIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createManagementNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -281,17 +360,36 @@ func (s *PodService) CreateManagementNetworkIpRange(p *CreateManagementNetworkIp
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateManagementNetworkIpRange async job into r
+func (r *CreateManagementNetworkIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateManagementNetworkIpRangeAsync starts the async job of CreateManagementNetworkIpRange without waiting for it to finish, and returns a handle to the job
+func (s *PodService) CreateManagementNetworkIpRangeAsync(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*Job, error) {
+	r, err := s.CreateManagementNetworkIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createManagementNetworkIpRange", r.JobID), nil
+}
+
 type CreateManagementNetworkIpRangeResponse struct {
 	Allocationstate         string                                           `json:"allocationstate"`
 	Capacity                []CreateManagementNetworkIpRangeResponseCapacity `json:"capacity"`
 	Endip                   []string                                         `json:"endip"`
 	Forsystemvms            []string                                         `json:"forsystemvms"`
 	Gateway                 string                                           `json:"gateway"`
-	Hasannotations          bool                                             `json:"hasannotations"`
+	Hasannotations          FlexBool                                         `json:"hasannotations"`
 	Id                      string                                           `json:"id"`
 	Ipranges                []CreateManagementNetworkIpRangeResponseIpranges `json:"ipranges"`
 	JobID                   string                                           `json:"jobid"`
-	Jobstatus               int                                              `json:"jobstatus"`
+	Jobstatus               FlexInt                                          `json:"jobstatus"`
 	Name                    string                                           `json:"name"`
 	Netmask                 string                                           `json:"netmask"`
 	Startip                 []string                                         `json:"startip"`
@@ -300,31 +398,49 @@ type CreateManagementNetworkIpRangeResponse struct {
 	Zoneid                  string                                           `json:"zoneid"`
 	Zonename                string                                           `json:"zonename"`
 	Zonestorageaccessgroups string                                           `json:"zonestorageaccessgroups"`
+	Extra                   map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *CreateManagementNetworkIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateManagementNetworkIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateManagementNetworkIpRangeResponseIpranges struct {
-	Cidr         string `json:"cidr"`
-	Endip        string `json:"endip"`
-	Forsystemvms string `json:"forsystemvms"`
-	Gateway      string `json:"gateway"`
-	Startip      string `json:"startip"`
-	Vlanid       string `json:"vlanid"`
+	Cidr         string                     `json:"cidr"`
+	Endip        string                     `json:"endip"`
+	Forsystemvms string                     `json:"forsystemvms"`
+	Gateway      string                     `json:"gateway"`
+	Startip      string                     `json:"startip"`
+	Vlanid       string                     `json:"vlanid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateManagementNetworkIpRangeResponseIpranges) UnmarshalJSON(b []byte) error {
+	type alias CreateManagementNetworkIpRangeResponseIpranges
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateManagementNetworkIpRangeResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateManagementNetworkIpRangeResponseCapacity) UnmarshalJSON(b []byte) error {
+	type alias CreateManagementNetworkIpRangeResponseCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreatePodParams struct {
@@ -364,6 +480,51 @@ func (p *CreatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createPod API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreatePodParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createPod", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createPod API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreatePodParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "zoneid"},
+		lengths:  map[string]int{"allocationstate": 255, "endip": 255, "gateway": 255, "name": 255, "netmask": 255, "startip": 255, "zoneid": 255},
+	}.validate("createPod", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createPod API, before the request is signed
+func (p *CreatePodParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreatePodParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreatePodParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createPod", b, &p.p, map[string]paramDecoder{
+		"allocationstate":     decodeParam[string],
+		"endip":               decodeParam[string],
+		"gateway":             decodeParam[string],
+		"name":                decodeParam[string],
+		"netmask":             decodeParam[string],
+		"startip":             decodeParam[string],
+		"storageaccessgroups": decodeParam[[]string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreatePodParams) Clone() *CreatePodParams {
+	return &CreatePodParams{p: cloneParams(p.p)}
+}
+
 func (p *CreatePodParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -544,7 +705,16 @@ func (s *PodService) NewCreatePodParams(name string, zoneid string) *CreatePodPa
 
 // Creates a new Pod.
 func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
-	resp, err := s.cs.newPostRequest("createPod", p.toURLValues())
+	return s.CreatePodWithContext(context.Background(), p)
+}
+
+// CreatePodWithContext is like CreatePod, but honours the cancellation and deadline of ctx
+func (s *PodService) CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createPod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -554,7 +724,7 @@ func (s *PodService) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
 	}
 
 	var r CreatePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createPod", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -567,11 +737,11 @@ type CreatePodResponse struct {
 	Endip                   []string                    `json:"endip"`
 	Forsystemvms            []string                    `json:"forsystemvms"`
 	Gateway                 string                      `json:"gateway"`
-	Hasannotations          bool                        `json:"hasannotations"`
+	Hasannotations          FlexBool                    `json:"hasannotations"`
 	Id                      string                      `json:"id"`
 	Ipranges                []CreatePodResponseIpranges `json:"ipranges"`
 	JobID                   string                      `json:"jobid"`
-	Jobstatus               int                         `json:"jobstatus"`
+	Jobstatus               FlexInt                     `json:"jobstatus"`
 	Name                    string                      `json:"name"`
 	Netmask                 string                      `json:"netmask"`
 	Startip                 []string                    `json:"startip"`
@@ -580,31 +750,49 @@ type CreatePodResponse struct {
 	Zoneid                  string                      `json:"zoneid"`
 	Zonename                string                      `json:"zonename"`
 	Zonestorageaccessgroups string                      `json:"zonestorageaccessgroups"`
+	Extra                   map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *CreatePodResponse) UnmarshalJSON(b []byte) error {
+	type alias CreatePodResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreatePodResponseIpranges struct {
-	Cidr         string `json:"cidr"`
-	Endip        string `json:"endip"`
-	Forsystemvms string `json:"forsystemvms"`
-	Gateway      string `json:"gateway"`
-	Startip      string `json:"startip"`
-	Vlanid       string `json:"vlanid"`
+	Cidr         string                     `json:"cidr"`
+	Endip        string                     `json:"endip"`
+	Forsystemvms string                     `json:"forsystemvms"`
+	Gateway      string                     `json:"gateway"`
+	Startip      string                     `json:"startip"`
+	Vlanid       string                     `json:"vlanid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreatePodResponseIpranges) UnmarshalJSON(b []byte) error {
+	type alias CreatePodResponseIpranges
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreatePodResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreatePodResponseCapacity) UnmarshalJSON(b []byte) error {
+	type alias CreatePodResponseCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicatePodParams struct {
@@ -628,6 +816,46 @@ func (p *DedicatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the dedicatePod API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicatePodParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicatePod", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicatePod API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicatePodParams) Validate() error {
+	return paramRules{
+		required: []string{"domainid", "podid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "podid": 255},
+	}.validate("dedicatePod", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicatePod API, before the request is signed
+func (p *DedicatePodParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicatePodParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicatePodParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicatePod", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"podid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicatePodParams) Clone() *DedicatePodParams {
+	return &DedicatePodParams{p: cloneParams(p.p)}
+}
+
 func (p *DedicatePodParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -703,32 +931,39 @@ func (s *PodService) NewDedicatePodParams(domainid string, podid string) *Dedica
 
 // Dedicates a Pod.
 func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicatePod", p.toURLValues())
+	return s.DedicatePodWithContext(context.Background(), p)
+}
+
+// DedicatePodWithContext is like DedicatePod, but honours the cancellation and deadline of ctx
+func (s *PodService) DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicatePod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicatePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicatePod", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "dedicatePod", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("dedicatePod", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -736,15 +971,40 @@ func (s *PodService) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, er
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DedicatePod async job into r
+func (r *DedicatePodResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DedicatePodAsync starts the async job of DedicatePod without waiting for it to finish, and returns a handle to the job
+func (s *PodService) DedicatePodAsync(ctx context.Context, p *DedicatePodParams) (*Job, error) {
+	r, err := s.DedicatePodWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("dedicatePod", r.JobID), nil
+}
+
 type DedicatePodResponse struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Domainid        string `json:"domainid"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Podid           string `json:"podid"`
-	Podname         string `json:"podname"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Domainid        string                     `json:"domainid"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Podid           string                     `json:"podid"`
+	Podname         string                     `json:"podname"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicatePodResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicatePodResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteManagementNetworkIpRangeParams struct {
@@ -771,6 +1031,47 @@ func (p *DeleteManagementNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteManagementNetworkIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteManagementNetworkIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteManagementNetworkIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteManagementNetworkIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteManagementNetworkIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"endip", "podid", "startip", "vlan"},
+		lengths:  map[string]int{"endip": 255, "podid": 255, "startip": 255, "vlan": 255},
+	}.validate("deleteManagementNetworkIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteManagementNetworkIpRange API, before the request is signed
+func (p *DeleteManagementNetworkIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteManagementNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteManagementNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteManagementNetworkIpRange", b, &p.p, map[string]paramDecoder{
+		"endip":   decodeParam[string],
+		"podid":   decodeParam[string],
+		"startip": decodeParam[string],
+		"vlan":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteManagementNetworkIpRangeParams) Clone() *DeleteManagementNetworkIpRangeParams {
+	return &DeleteManagementNetworkIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteManagementNetworkIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -869,27 +1170,39 @@ func (s *PodService) NewDeleteManagementNetworkIpRangeParams(endip string, podid
 
 // Deletes a management network IP range. This action is only allowed when no IPs in this range are allocated.
 func (s *PodService) DeleteManagementNetworkIpRange(p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteManagementNetworkIpRange", p.toURLValues())
+	return s.DeleteManagementNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteManagementNetworkIpRangeWithContext is like DeleteManagementNetworkIpRange, but honours the cancellation and deadline of ctx
+func (s *PodService) DeleteManagementNetworkIpRangeWithContext(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteManagementNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteManagementNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteManagementNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteManagementNetworkIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteManagementNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -897,11 +1210,31 @@ func (s *PodService) DeleteManagementNetworkIpRange(p *DeleteManagementNetworkIp
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteManagementNetworkIpRange async job into r
+func (r *DeleteManagementNetworkIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteManagementNetworkIpRangeAsync starts the async job of DeleteManagementNetworkIpRange without waiting for it to finish, and returns a handle to the job
+func (s *PodService) DeleteManagementNetworkIpRangeAsync(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*Job, error) {
+	r, err := s.DeleteManagementNetworkIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteManagementNetworkIpRange", r.JobID), nil
+}
+
 type DeleteManagementNetworkIpRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteManagementNetworkIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteManagementNetworkIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeletePodParams struct {
@@ -919,6 +1252,44 @@ func (p *DeletePodParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deletePod API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeletePodParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deletePod", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deletePod API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeletePodParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deletePod", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deletePod API, before the request is signed
+func (p *DeletePodParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeletePodParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeletePodParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deletePod", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeletePodParams) Clone() *DeletePodParams {
+	return &DeletePodParams{p: cloneParams(p.p)}
+}
+
 func (p *DeletePodParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -951,13 +1322,22 @@ func (s *PodService) NewDeletePodParams(id string) *DeletePodParams {
 
 // Deletes a Pod.
 func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
-	resp, err := s.cs.newPostRequest("deletePod", p.toURLValues())
+	return s.DeletePodWithContext(context.Background(), p)
+}
+
+// DeletePodWithContext is like DeletePod, but honours the cancellation and deadline of ctx
+func (s *PodService) DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deletePod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deletePod", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -965,10 +1345,11 @@ func (s *PodService) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
 }
 
 type DeletePodResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeletePodResponse) UnmarshalJSON(b []byte) error {
@@ -995,7 +1376,7 @@ func (r *DeletePodResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeletePodResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDedicatedPodsParams struct {
@@ -1033,6 +1414,49 @@ func (p *ListDedicatedPodsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDedicatedPods API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDedicatedPodsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDedicatedPods", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDedicatedPods API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDedicatedPodsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "affinitygroupid": 255, "domainid": 255, "keyword": 255, "podid": 255},
+	}.validate("listDedicatedPods", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDedicatedPods API, before the request is signed
+func (p *ListDedicatedPodsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDedicatedPodsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDedicatedPodsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDedicatedPods", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"affinitygroupid": decodeParam[string],
+		"domainid":        decodeParam[string],
+		"keyword":         decodeParam[string],
+		"page":            decodeParam[int],
+		"pagesize":        decodeParam[int],
+		"podid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDedicatedPodsParams) Clone() *ListDedicatedPodsParams {
+	return &ListDedicatedPodsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDedicatedPodsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1190,33 +1614,76 @@ func (s *PodService) NewListDedicatedPodsParams() *ListDedicatedPodsParams {
 
 // Lists dedicated pods.
 func (s *PodService) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedPods", p.toURLValues())
+	return s.ListDedicatedPodsWithContext(context.Background(), p)
+}
+
+// ListDedicatedPodsWithContext is like ListDedicatedPods, but honours the cancellation and deadline of ctx
+func (s *PodService) ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDedicatedPods", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDedicatedPodsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDedicatedPods", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDedicatedPodsAll returns all DedicatedPods matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *PodService) ListDedicatedPodsAll(p *ListDedicatedPodsParams, opts ...PageOption) ([]*DedicatedPod, error) {
+	return s.ListDedicatedPodsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedPodsAllWithContext is like ListDedicatedPodsAll, but honours the cancellation and deadline of ctx
+func (s *PodService) ListDedicatedPodsAllWithContext(ctx context.Context, p *ListDedicatedPodsParams, opts ...PageOption) ([]*DedicatedPod, error) {
+	return collectPages(s.ListDedicatedPodsIterWithContext(ctx, p, opts...))
+}
+
+// ListDedicatedPodsIter returns an iterator over all DedicatedPods matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *PodService) ListDedicatedPodsIter(p *ListDedicatedPodsParams, opts ...PageOption) iter.Seq2[*DedicatedPod, error] {
+	return s.ListDedicatedPodsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedPodsIterWithContext is like ListDedicatedPodsIter, but honours the cancellation and deadline of ctx
+func (s *PodService) ListDedicatedPodsIterWithContext(ctx context.Context, p *ListDedicatedPodsParams, opts ...PageOption) iter.Seq2[*DedicatedPod, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DedicatedPod, int, error) {
+		l, err := s.ListDedicatedPodsWithContext(ctx, &ListDedicatedPodsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DedicatedPods, l.Count, nil
+	}, opts...)
+}
+
 type ListDedicatedPodsResponse struct {
 	Count         int             `json:"count"`
 	DedicatedPods []*DedicatedPod `json:"dedicatedpod"`
 }
 
 type DedicatedPod struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Domainid        string `json:"domainid"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Podid           string `json:"podid"`
-	Podname         string `json:"podname"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Domainid        string                     `json:"domainid"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Podid           string                     `json:"podid"`
+	Podname         string                     `json:"podname"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicatedPod) UnmarshalJSON(b []byte) error {
+	type alias DedicatedPod
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPodsParams struct {
@@ -1261,6 +1728,51 @@ func (p *ListPodsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPods API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPodsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPods", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPods API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPodsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"allocationstate": 255, "id": 255, "keyword": 255, "name": 255, "storageaccessgroup": 255, "zoneid": 255},
+	}.validate("listPods", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPods API, before the request is signed
+func (p *ListPodsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPodsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPodsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPods", b, &p.p, map[string]paramDecoder{
+		"allocationstate":    decodeParam[string],
+		"id":                 decodeParam[string],
+		"keyword":            decodeParam[string],
+		"name":               decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"showcapacities":     decodeParam[bool],
+		"storageaccessgroup": decodeParam[string],
+		"zoneid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPodsParams) Clone() *ListPodsParams {
+	return &ListPodsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPodsParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1460,6 +1972,11 @@ func (s *PodService) NewListPodsParams() *ListPodsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetPodIDWithContext(context.Background(), name, opts...)
+}
+
+// GetPodIDWithContext is like GetPodID, but honours the cancellation and deadline of ctx
+func (s *PodService) GetPodIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListPodsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1471,7 +1988,7 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 		}
 	}
 
-	l, err := s.ListPods(p)
+	l, err := s.ListPodsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1496,12 +2013,17 @@ func (s *PodService) GetPodID(name string, opts ...OptionFunc) (string, int, err
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error) {
-	id, count, err := s.GetPodID(name, opts...)
+	return s.GetPodByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetPodByNameWithContext is like GetPodByName, but honours the cancellation and deadline of ctx
+func (s *PodService) GetPodByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Pod, int, error) {
+	id, count, err := s.GetPodIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetPodByID(id, opts...)
+	r, count, err := s.GetPodByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1510,6 +2032,11 @@ func (s *PodService) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error) {
+	return s.GetPodByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPodByIDWithContext is like GetPodByID, but honours the cancellation and deadline of ctx
+func (s *PodService) GetPodByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Pod, int, error) {
 	p := &ListPodsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1521,11 +2048,10 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 		}
 	}
 
-	l, err := s.ListPods(p)
+	l, err := s.ListPodsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1543,68 +2069,123 @@ func (s *PodService) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error
 
 // Lists all Pods.
 func (s *PodService) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
-	resp, err := s.cs.newRequest("listPods", p.toURLValues())
+	return s.ListPodsWithContext(context.Background(), p)
+}
+
+// ListPodsWithContext is like ListPods, but honours the cancellation and deadline of ctx
+func (s *PodService) ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listPods", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPodsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPods", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPodsAll returns all Pods matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *PodService) ListPodsAll(p *ListPodsParams, opts ...PageOption) ([]*Pod, error) {
+	return s.ListPodsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPodsAllWithContext is like ListPodsAll, but honours the cancellation and deadline of ctx
+func (s *PodService) ListPodsAllWithContext(ctx context.Context, p *ListPodsParams, opts ...PageOption) ([]*Pod, error) {
+	return collectPages(s.ListPodsIterWithContext(ctx, p, opts...))
+}
+
+// ListPodsIter returns an iterator over all Pods matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *PodService) ListPodsIter(p *ListPodsParams, opts ...PageOption) iter.Seq2[*Pod, error] {
+	return s.ListPodsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPodsIterWithContext is like ListPodsIter, but honours the cancellation and deadline of ctx
+func (s *PodService) ListPodsIterWithContext(ctx context.Context, p *ListPodsParams, opts ...PageOption) iter.Seq2[*Pod, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Pod, int, error) {
+		l, err := s.ListPodsWithContext(ctx, &ListPodsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Pods, l.Count, nil
+	}, opts...)
+}
+
 type ListPodsResponse struct {
 	Count int    `json:"count"`
 	Pods  []*Pod `json:"pod"`
 }
 
 type Pod struct {
-	Allocationstate         string        `json:"allocationstate"`
-	Capacity                []PodCapacity `json:"capacity"`
-	Endip                   []string      `json:"endip"`
-	Forsystemvms            []string      `json:"forsystemvms"`
-	Gateway                 string        `json:"gateway"`
-	Hasannotations          bool          `json:"hasannotations"`
-	Id                      string        `json:"id"`
-	Ipranges                []PodIpranges `json:"ipranges"`
-	JobID                   string        `json:"jobid"`
-	Jobstatus               int           `json:"jobstatus"`
-	Name                    string        `json:"name"`
-	Netmask                 string        `json:"netmask"`
-	Startip                 []string      `json:"startip"`
-	Storageaccessgroups     string        `json:"storageaccessgroups"`
-	Vlanid                  []string      `json:"vlanid"`
-	Zoneid                  string        `json:"zoneid"`
-	Zonename                string        `json:"zonename"`
-	Zonestorageaccessgroups string        `json:"zonestorageaccessgroups"`
+	Allocationstate         string                     `json:"allocationstate"`
+	Capacity                []PodCapacity              `json:"capacity"`
+	Endip                   []string                   `json:"endip"`
+	Forsystemvms            []string                   `json:"forsystemvms"`
+	Gateway                 string                     `json:"gateway"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Ipranges                []PodIpranges              `json:"ipranges"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Netmask                 string                     `json:"netmask"`
+	Startip                 []string                   `json:"startip"`
+	Storageaccessgroups     string                     `json:"storageaccessgroups"`
+	Vlanid                  []string                   `json:"vlanid"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Zonestorageaccessgroups string                     `json:"zonestorageaccessgroups"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Pod) UnmarshalJSON(b []byte) error {
+	type alias Pod
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PodIpranges struct {
-	Cidr         string `json:"cidr"`
-	Endip        string `json:"endip"`
-	Forsystemvms string `json:"forsystemvms"`
-	Gateway      string `json:"gateway"`
-	Startip      string `json:"startip"`
-	Vlanid       string `json:"vlanid"`
+	Cidr         string                     `json:"cidr"`
+	Endip        string                     `json:"endip"`
+	Forsystemvms string                     `json:"forsystemvms"`
+	Gateway      string                     `json:"gateway"`
+	Startip      string                     `json:"startip"`
+	Vlanid       string                     `json:"vlanid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PodIpranges) UnmarshalJSON(b []byte) error {
+	type alias PodIpranges
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PodCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PodCapacity) UnmarshalJSON(b []byte) error {
+	type alias PodCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseDedicatedPodParams struct {
@@ -1622,6 +2203,44 @@ func (p *ReleaseDedicatedPodParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseDedicatedPod API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseDedicatedPodParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseDedicatedPod", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseDedicatedPod API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseDedicatedPodParams) Validate() error {
+	return paramRules{
+		required: []string{"podid"},
+		lengths:  map[string]int{"podid": 255},
+	}.validate("releaseDedicatedPod", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseDedicatedPod API, before the request is signed
+func (p *ReleaseDedicatedPodParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseDedicatedPodParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseDedicatedPodParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseDedicatedPod", b, &p.p, map[string]paramDecoder{
+		"podid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseDedicatedPodParams) Clone() *ReleaseDedicatedPodParams {
+	return &ReleaseDedicatedPodParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseDedicatedPodParams) SetPodid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1654,27 +2273,39 @@ func (s *PodService) NewReleaseDedicatedPodParams(podid string) *ReleaseDedicate
 
 // Release the dedication for the pod
 func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseDedicatedPod", p.toURLValues())
+	return s.ReleaseDedicatedPodWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedPodWithContext is like ReleaseDedicatedPod, but honours the cancellation and deadline of ctx
+func (s *PodService) ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseDedicatedPod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseDedicatedPodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseDedicatedPod", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseDedicatedPod", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseDedicatedPod", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1682,11 +2313,31 @@ func (s *PodService) ReleaseDedicatedPod(p *ReleaseDedicatedPodParams) (*Release
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseDedicatedPod async job into r
+func (r *ReleaseDedicatedPodResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseDedicatedPodAsync starts the async job of ReleaseDedicatedPod without waiting for it to finish, and returns a handle to the job
+func (s *PodService) ReleaseDedicatedPodAsync(ctx context.Context, p *ReleaseDedicatedPodParams) (*Job, error) {
+	r, err := s.ReleaseDedicatedPodWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseDedicatedPod", r.JobID), nil
+}
+
 type ReleaseDedicatedPodResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseDedicatedPodResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseDedicatedPodResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdatePodParams struct {
@@ -1722,6 +2373,50 @@ func (p *UpdatePodParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updatePod API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdatePodParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updatePod", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updatePod API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdatePodParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"allocationstate": 255, "endip": 255, "gateway": 255, "id": 255, "name": 255, "netmask": 255, "startip": 255},
+	}.validate("updatePod", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updatePod API, before the request is signed
+func (p *UpdatePodParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdatePodParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdatePodParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updatePod", b, &p.p, map[string]paramDecoder{
+		"allocationstate": decodeParam[string],
+		"endip":           decodeParam[string],
+		"gateway":         decodeParam[string],
+		"id":              decodeParam[string],
+		"name":            decodeParam[string],
+		"netmask":         decodeParam[string],
+		"startip":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdatePodParams) Clone() *UpdatePodParams {
+	return &UpdatePodParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdatePodParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1880,7 +2575,16 @@ func (s *PodService) NewUpdatePodParams(id string) *UpdatePodParams {
 
 // Updates a Pod.
 func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
-	resp, err := s.cs.newPostRequest("updatePod", p.toURLValues())
+	return s.UpdatePodWithContext(context.Background(), p)
+}
+
+// UpdatePodWithContext is like UpdatePod, but honours the cancellation and deadline of ctx
+func (s *PodService) UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updatePod", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1890,7 +2594,7 @@ func (s *PodService) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
 	}
 
 	var r UpdatePodResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updatePod", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1903,11 +2607,11 @@ type UpdatePodResponse struct {
 	Endip                   []string                    `json:"endip"`
 	Forsystemvms            []string                    `json:"forsystemvms"`
 	Gateway                 string                      `json:"gateway"`
-	Hasannotations          bool                        `json:"hasannotations"`
+	Hasannotations          FlexBool                    `json:"hasannotations"`
 	Id                      string                      `json:"id"`
 	Ipranges                []UpdatePodResponseIpranges `json:"ipranges"`
 	JobID                   string                      `json:"jobid"`
-	Jobstatus               int                         `json:"jobstatus"`
+	Jobstatus               FlexInt                     `json:"jobstatus"`
 	Name                    string                      `json:"name"`
 	Netmask                 string                      `json:"netmask"`
 	Startip                 []string                    `json:"startip"`
@@ -1916,31 +2620,49 @@ type UpdatePodResponse struct {
 	Zoneid                  string                      `json:"zoneid"`
 	Zonename                string                      `json:"zonename"`
 	Zonestorageaccessgroups string                      `json:"zonestorageaccessgroups"`
+	Extra                   map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *UpdatePodResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdatePodResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdatePodResponseIpranges struct {
-	Cidr         string `json:"cidr"`
-	Endip        string `json:"endip"`
-	Forsystemvms string `json:"forsystemvms"`
-	Gateway      string `json:"gateway"`
-	Startip      string `json:"startip"`
-	Vlanid       string `json:"vlanid"`
+	Cidr         string                     `json:"cidr"`
+	Endip        string                     `json:"endip"`
+	Forsystemvms string                     `json:"forsystemvms"`
+	Gateway      string                     `json:"gateway"`
+	Startip      string                     `json:"startip"`
+	Vlanid       string                     `json:"vlanid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdatePodResponseIpranges) UnmarshalJSON(b []byte) error {
+	type alias UpdatePodResponseIpranges
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdatePodResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdatePodResponseCapacity) UnmarshalJSON(b []byte) error {
+	type alias UpdatePodResponseCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdatePodManagementNetworkIpRangeParams struct {
@@ -1970,6 +2692,48 @@ func (p *UpdatePodManagementNetworkIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updatePodManagementNetworkIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdatePodManagementNetworkIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updatePodManagementNetworkIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updatePodManagementNetworkIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdatePodManagementNetworkIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"currentendip", "currentstartip", "podid"},
+		lengths:  map[string]int{"currentendip": 255, "currentstartip": 255, "newendip": 255, "newstartip": 255, "podid": 255},
+	}.validate("updatePodManagementNetworkIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updatePodManagementNetworkIpRange API, before the request is signed
+func (p *UpdatePodManagementNetworkIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdatePodManagementNetworkIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdatePodManagementNetworkIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updatePodManagementNetworkIpRange", b, &p.p, map[string]paramDecoder{
+		"currentendip":   decodeParam[string],
+		"currentstartip": decodeParam[string],
+		"newendip":       decodeParam[string],
+		"newstartip":     decodeParam[string],
+		"podid":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdatePodManagementNetworkIpRangeParams) Clone() *UpdatePodManagementNetworkIpRangeParams {
+	return &UpdatePodManagementNetworkIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdatePodManagementNetworkIpRangeParams) SetCurrentendip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2088,27 +2852,39 @@ func (s *PodService) NewUpdatePodManagementNetworkIpRangeParams(currentendip str
 
 // Updates a management network IP range. Only allowed when no IPs are allocated.
 func (s *PodService) UpdatePodManagementNetworkIpRange(p *UpdatePodManagementNetworkIpRangeParams) (*UpdatePodManagementNetworkIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("updatePodManagementNetworkIpRange", p.toURLValues())
+	return s.UpdatePodManagementNetworkIpRangeWithContext(context.Background(), p)
+}
+
+// UpdatePodManagementNetworkIpRangeWithContext is like UpdatePodManagementNetworkIpRange, but honours the cancellation and deadline of ctx
+func (s *PodService) UpdatePodManagementNetworkIpRangeWithContext(ctx context.Context, p *UpdatePodManagementNetworkIpRangeParams) (*UpdatePodManagementNetworkIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updatePodManagementNetworkIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdatePodManagementNetworkIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updatePodManagementNetworkIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updatePodManagementNetworkIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updatePodManagementNetworkIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2116,9 +2892,29 @@ func (s *PodService) UpdatePodManagementNetworkIpRange(p *UpdatePodManagementNet
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdatePodManagementNetworkIpRange async job into r
+func (r *UpdatePodManagementNetworkIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// UpdatePodManagementNetworkIpRangeAsync starts the async job of UpdatePodManagementNetworkIpRange without waiting for it to finish, and returns a handle to the job
+func (s *PodService) UpdatePodManagementNetworkIpRangeAsync(ctx context.Context, p *UpdatePodManagementNetworkIpRangeParams) (*Job, error) {
+	r, err := s.UpdatePodManagementNetworkIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updatePodManagementNetworkIpRange", r.JobID), nil
+}
+
 type UpdatePodManagementNetworkIpRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdatePodManagementNetworkIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdatePodManagementNetworkIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockPodServiceIfaceMockRecorder) CreateManagementNetworkIpRange(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagementNetworkIpRange", reflect.TypeOf((*MockPodServiceIface)(nil).CreateManagementNetworkIpRange), p)
 }
 
+// CreateManagementNetworkIpRangeAsync mocks base method.
+func (m *MockPodServiceIface) CreateManagementNetworkIpRangeAsync(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManagementNetworkIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManagementNetworkIpRangeAsync indicates an expected call of CreateManagementNetworkIpRangeAsync.
+func (mr *MockPodServiceIfaceMockRecorder) CreateManagementNetworkIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagementNetworkIpRangeAsync", reflect.TypeOf((*MockPodServiceIface)(nil).CreateManagementNetworkIpRangeAsync), ctx, p)
+}
+
+// CreateManagementNetworkIpRangeWithContext mocks base method.
+func (m *MockPodServiceIface) CreateManagementNetworkIpRangeWithContext(ctx context.Context, p *CreateManagementNetworkIpRangeParams) (*CreateManagementNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManagementNetworkIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateManagementNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManagementNetworkIpRangeWithContext indicates an expected call of CreateManagementNetworkIpRangeWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) CreateManagementNetworkIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagementNetworkIpRangeWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).CreateManagementNetworkIpRangeWithContext), ctx, p)
+}
+
 // CreatePod mocks base method.
 func (m *MockPodServiceIface) CreatePod(p *CreatePodParams) (*CreatePodResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,21 @@ func (mr *MockPodServiceIfaceMockRecorder) CreatePod(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePod", reflect.TypeOf((*MockPodServiceIface)(nil).CreatePod), p)
 }
 
+// CreatePodWithContext mocks base method.
+func (m *MockPodServiceIface) CreatePodWithContext(ctx context.Context, p *CreatePodParams) (*CreatePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePodWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreatePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePodWithContext indicates an expected call of CreatePodWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) CreatePodWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePodWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).CreatePodWithContext), ctx, p)
+}
+
 // DedicatePod mocks base method.
 func (m *MockPodServiceIface) DedicatePod(p *DedicatePodParams) (*DedicatePodResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,36 @@ func (mr *MockPodServiceIfaceMockRecorder) DedicatePod(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePod", reflect.TypeOf((*MockPodServiceIface)(nil).DedicatePod), p)
 }
 
+// DedicatePodAsync mocks base method.
+func (m *MockPodServiceIface) DedicatePodAsync(ctx context.Context, p *DedicatePodParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicatePodAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicatePodAsync indicates an expected call of DedicatePodAsync.
+func (mr *MockPodServiceIfaceMockRecorder) DedicatePodAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePodAsync", reflect.TypeOf((*MockPodServiceIface)(nil).DedicatePodAsync), ctx, p)
+}
+
+// DedicatePodWithContext mocks base method.
+func (m *MockPodServiceIface) DedicatePodWithContext(ctx context.Context, p *DedicatePodParams) (*DedicatePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicatePodWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicatePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicatePodWithContext indicates an expected call of DedicatePodWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) DedicatePodWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicatePodWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).DedicatePodWithContext), ctx, p)
+}
+
 // DeleteManagementNetworkIpRange mocks base method.
 func (m *MockPodServiceIface) DeleteManagementNetworkIpRange(p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,36 @@ func (mr *MockPodServiceIfaceMockRecorder) DeleteManagementNetworkIpRange(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagementNetworkIpRange", reflect.TypeOf((*MockPodServiceIface)(nil).DeleteManagementNetworkIpRange), p)
 }
 
+// DeleteManagementNetworkIpRangeAsync mocks base method.
+func (m *MockPodServiceIface) DeleteManagementNetworkIpRangeAsync(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagementNetworkIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManagementNetworkIpRangeAsync indicates an expected call of DeleteManagementNetworkIpRangeAsync.
+func (mr *MockPodServiceIfaceMockRecorder) DeleteManagementNetworkIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagementNetworkIpRangeAsync", reflect.TypeOf((*MockPodServiceIface)(nil).DeleteManagementNetworkIpRangeAsync), ctx, p)
+}
+
+// DeleteManagementNetworkIpRangeWithContext mocks base method.
+func (m *MockPodServiceIface) DeleteManagementNetworkIpRangeWithContext(ctx context.Context, p *DeleteManagementNetworkIpRangeParams) (*DeleteManagementNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagementNetworkIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteManagementNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteManagementNetworkIpRangeWithContext indicates an expected call of DeleteManagementNetworkIpRangeWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) DeleteManagementNetworkIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagementNetworkIpRangeWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).DeleteManagementNetworkIpRangeWithContext), ctx, p)
+}
+
 // DeletePod mocks base method.
 func (m *MockPodServiceIface) DeletePod(p *DeletePodParams) (*DeletePodResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +240,21 @@ func (mr *MockPodServiceIfaceMockRecorder) DeletePod(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePod", reflect.TypeOf((*MockPodServiceIface)(nil).DeletePod), p)
 }
 
+// DeletePodWithContext mocks base method.
+func (m *MockPodServiceIface) DeletePodWithContext(ctx context.Context, p *DeletePodParams) (*DeletePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePodWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeletePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePodWithContext indicates an expected call of DeletePodWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) DeletePodWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePodWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).DeletePodWithContext), ctx, p)
+}
+
 // GetPodByID mocks base method.
 func (m *MockPodServiceIface) GetPodByID(id string, opts ...OptionFunc) (*Pod, int, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +276,27 @@ func (mr *MockPodServiceIfaceMockRecorder) GetPodByID(id any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByID", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodByID), varargs...)
 }
 
+// GetPodByIDWithContext mocks base method.
+func (m *MockPodServiceIface) GetPodByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Pod, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPodByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Pod)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPodByIDWithContext indicates an expected call of GetPodByIDWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) GetPodByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByIDWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodByIDWithContext), varargs...)
+}
+
 // GetPodByName mocks base method.
 func (m *MockPodServiceIface) GetPodByName(name string, opts ...OptionFunc) (*Pod, int, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +318,27 @@ func (mr *MockPodServiceIfaceMockRecorder) GetPodByName(name any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByName", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodByName), varargs...)
 }
 
+// GetPodByNameWithContext mocks base method.
+func (m *MockPodServiceIface) GetPodByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Pod, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPodByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Pod)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPodByNameWithContext indicates an expected call of GetPodByNameWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) GetPodByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodByNameWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodByNameWithContext), varargs...)
+}
+
 // GetPodID mocks base method.
 func (m *MockPodServiceIface) GetPodID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +360,27 @@ func (mr *MockPodServiceIfaceMockRecorder) GetPodID(name any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodID", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodID), varargs...)
 }
 
+// GetPodIDWithContext mocks base method.
+func (m *MockPodServiceIface) GetPodIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPodIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPodIDWithContext indicates an expected call of GetPodIDWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) GetPodIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPodIDWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).GetPodIDWithContext), varargs...)
+}
+
 // ListDedicatedPods mocks base method.
 func (m *MockPodServiceIface) ListDedicatedPods(p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +396,99 @@ func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPods(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPods), p)
 }
 
+// ListDedicatedPodsAll mocks base method.
+func (m *MockPodServiceIface) ListDedicatedPodsAll(p *ListDedicatedPodsParams, opts ...PageOption) ([]*DedicatedPod, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedPodsAll", varargs...)
+	ret0, _ := ret[0].([]*DedicatedPod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedPodsAll indicates an expected call of ListDedicatedPodsAll.
+func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPodsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPodsAll", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPodsAll), varargs...)
+}
+
+// ListDedicatedPodsAllWithContext mocks base method.
+func (m *MockPodServiceIface) ListDedicatedPodsAllWithContext(ctx context.Context, p *ListDedicatedPodsParams, opts ...PageOption) ([]*DedicatedPod, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedPodsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DedicatedPod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedPodsAllWithContext indicates an expected call of ListDedicatedPodsAllWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPodsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPodsAllWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPodsAllWithContext), varargs...)
+}
+
+// ListDedicatedPodsIter mocks base method.
+func (m *MockPodServiceIface) ListDedicatedPodsIter(p *ListDedicatedPodsParams, opts ...PageOption) iter.Seq2[*DedicatedPod, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedPodsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedPod, error])
+	return ret0
+}
+
+// ListDedicatedPodsIter indicates an expected call of ListDedicatedPodsIter.
+func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPodsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPodsIter", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPodsIter), varargs...)
+}
+
+// ListDedicatedPodsIterWithContext mocks base method.
+func (m *MockPodServiceIface) ListDedicatedPodsIterWithContext(ctx context.Context, p *ListDedicatedPodsParams, opts ...PageOption) iter.Seq2[*DedicatedPod, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedPodsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedPod, error])
+	return ret0
+}
+
+// ListDedicatedPodsIterWithContext indicates an expected call of ListDedicatedPodsIterWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPodsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPodsIterWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPodsIterWithContext), varargs...)
+}
+
+// ListDedicatedPodsWithContext mocks base method.
+func (m *MockPodServiceIface) ListDedicatedPodsWithContext(ctx context.Context, p *ListDedicatedPodsParams) (*ListDedicatedPodsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedPodsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDedicatedPodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedPodsWithContext indicates an expected call of ListDedicatedPodsWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) ListDedicatedPodsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedPodsWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).ListDedicatedPodsWithContext), ctx, p)
+}
+
 // ListPods mocks base method.
 func (m *MockPodServiceIface) ListPods(p *ListPodsParams) (*ListPodsResponse, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +504,99 @@ func (mr *MockPodServiceIfaceMockRecorder) ListPods(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPods", reflect.TypeOf((*MockPodServiceIface)(nil).ListPods), p)
 }
 
+// ListPodsAll mocks base method.
+func (m *MockPodServiceIface) ListPodsAll(p *ListPodsParams, opts ...PageOption) ([]*Pod, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPodsAll", varargs...)
+	ret0, _ := ret[0].([]*Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodsAll indicates an expected call of ListPodsAll.
+func (mr *MockPodServiceIfaceMockRecorder) ListPodsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodsAll", reflect.TypeOf((*MockPodServiceIface)(nil).ListPodsAll), varargs...)
+}
+
+// ListPodsAllWithContext mocks base method.
+func (m *MockPodServiceIface) ListPodsAllWithContext(ctx context.Context, p *ListPodsParams, opts ...PageOption) ([]*Pod, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPodsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Pod)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodsAllWithContext indicates an expected call of ListPodsAllWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) ListPodsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodsAllWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).ListPodsAllWithContext), varargs...)
+}
+
+// ListPodsIter mocks base method.
+func (m *MockPodServiceIface) ListPodsIter(p *ListPodsParams, opts ...PageOption) iter.Seq2[*Pod, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPodsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Pod, error])
+	return ret0
+}
+
+// ListPodsIter indicates an expected call of ListPodsIter.
+func (mr *MockPodServiceIfaceMockRecorder) ListPodsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodsIter", reflect.TypeOf((*MockPodServiceIface)(nil).ListPodsIter), varargs...)
+}
+
+// ListPodsIterWithContext mocks base method.
+func (m *MockPodServiceIface) ListPodsIterWithContext(ctx context.Context, p *ListPodsParams, opts ...PageOption) iter.Seq2[*Pod, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPodsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Pod, error])
+	return ret0
+}
+
+// ListPodsIterWithContext indicates an expected call of ListPodsIterWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) ListPodsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodsIterWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).ListPodsIterWithContext), varargs...)
+}
+
+// ListPodsWithContext mocks base method.
+func (m *MockPodServiceIface) ListPodsWithContext(ctx context.Context, p *ListPodsParams) (*ListPodsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPodsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPodsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPodsWithContext indicates an expected call of ListPodsWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) ListPodsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPodsWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).ListPodsWithContext), ctx, p)
+}
+
 // NewCreateManagementNetworkIpRangeParams mocks base method.
 func (m *MockPodServiceIface) NewCreateManagementNetworkIpRangeParams(gateway, netmask, podid, startip string) *CreateManagementNetworkIpRangeParams {
 	m.ctrl.T.Helper()
@@ -381,6 +752,36 @@ func (mr *MockPodServiceIfaceMockRecorder) ReleaseDedicatedPod(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedPod", reflect.TypeOf((*MockPodServiceIface)(nil).ReleaseDedicatedPod), p)
 }
 
+// ReleaseDedicatedPodAsync mocks base method.
+func (m *MockPodServiceIface) ReleaseDedicatedPodAsync(ctx context.Context, p *ReleaseDedicatedPodParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedPodAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedPodAsync indicates an expected call of ReleaseDedicatedPodAsync.
+func (mr *MockPodServiceIfaceMockRecorder) ReleaseDedicatedPodAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedPodAsync", reflect.TypeOf((*MockPodServiceIface)(nil).ReleaseDedicatedPodAsync), ctx, p)
+}
+
+// ReleaseDedicatedPodWithContext mocks base method.
+func (m *MockPodServiceIface) ReleaseDedicatedPodWithContext(ctx context.Context, p *ReleaseDedicatedPodParams) (*ReleaseDedicatedPodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedPodWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseDedicatedPodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedPodWithContext indicates an expected call of ReleaseDedicatedPodWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) ReleaseDedicatedPodWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedPodWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).ReleaseDedicatedPodWithContext), ctx, p)
+}
+
 // UpdatePod mocks base method.
 func (m *MockPodServiceIface) UpdatePod(p *UpdatePodParams) (*UpdatePodResponse, error) {
 	m.ctrl.T.Helper()
@@ -410,3 +811,48 @@ func (mr *MockPodServiceIfaceMockRecorder) UpdatePodManagementNetworkIpRange(p a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePodManagementNetworkIpRange", reflect.TypeOf((*MockPodServiceIface)(nil).UpdatePodManagementNetworkIpRange), p)
 }
+
+// UpdatePodManagementNetworkIpRangeAsync mocks base method.
+func (m *MockPodServiceIface) UpdatePodManagementNetworkIpRangeAsync(ctx context.Context, p *UpdatePodManagementNetworkIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePodManagementNetworkIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePodManagementNetworkIpRangeAsync indicates an expected call of UpdatePodManagementNetworkIpRangeAsync.
+func (mr *MockPodServiceIfaceMockRecorder) UpdatePodManagementNetworkIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePodManagementNetworkIpRangeAsync", reflect.TypeOf((*MockPodServiceIface)(nil).UpdatePodManagementNetworkIpRangeAsync), ctx, p)
+}
+
+// UpdatePodManagementNetworkIpRangeWithContext mocks base method.
+func (m *MockPodServiceIface) UpdatePodManagementNetworkIpRangeWithContext(ctx context.Context, p *UpdatePodManagementNetworkIpRangeParams) (*UpdatePodManagementNetworkIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePodManagementNetworkIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdatePodManagementNetworkIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePodManagementNetworkIpRangeWithContext indicates an expected call of UpdatePodManagementNetworkIpRangeWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) UpdatePodManagementNetworkIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePodManagementNetworkIpRangeWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).UpdatePodManagementNetworkIpRangeWithContext), ctx, p)
+}
+
+// UpdatePodWithContext mocks base method.
+func (m *MockPodServiceIface) UpdatePodWithContext(ctx context.Context, p *UpdatePodParams) (*UpdatePodResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdatePodWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdatePodResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdatePodWithContext indicates an expected call of UpdatePodWithContext.
+func (mr *MockPodServiceIfaceMockRecorder) UpdatePodWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdatePodWithContext", reflect.TypeOf((*MockPodServiceIface)(nil).UpdatePodWithContext), ctx, p)
+}
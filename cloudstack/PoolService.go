@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,27 +31,50 @@ import (
 
 type PoolServiceIface interface {
 	CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
+	CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error)
 	NewCreateStoragePoolParams(name string, url string, zoneid string) *CreateStoragePoolParams
 	DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
+	DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error)
 	NewDeleteStoragePoolParams(id string) *DeleteStoragePoolParams
 	FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
+	FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error)
 	NewFindStoragePoolsForMigrationParams(id string) *FindStoragePoolsForMigrationParams
 	ListElastistorPool(p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error)
+	ListElastistorPoolWithContext(ctx context.Context, p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error)
 	NewListElastistorPoolParams() *ListElastistorPoolParams
 	ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error)
+	ListStoragePoolsAll(p *ListStoragePoolsParams, opts ...PageOption) ([]*StoragePool, error)
+	ListStoragePoolsAllWithContext(ctx context.Context, p *ListStoragePoolsParams, opts ...PageOption) ([]*StoragePool, error)
+	ListStoragePoolsIter(p *ListStoragePoolsParams, opts ...PageOption) iter.Seq2[*StoragePool, error]
+	ListStoragePoolsIterWithContext(ctx context.Context, p *ListStoragePoolsParams, opts ...PageOption) iter.Seq2[*StoragePool, error]
 	NewListStoragePoolsParams() *ListStoragePoolsParams
 	GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePool, int, error)
 	GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error)
+	GetStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePool, int, error)
 	SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error)
+	SyncStoragePoolWithContext(ctx context.Context, p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error)
+	SyncStoragePoolAsync(ctx context.Context, p *SyncStoragePoolParams) (*Job, error)
 	NewSyncStoragePoolParams(id string) *SyncStoragePoolParams
 	UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
+	UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error)
 	NewUpdateStoragePoolParams(id string) *UpdateStoragePoolParams
 	ConfigureStorageAccess(p *ConfigureStorageAccessParams) (*StorageAccessResponse, error)
+	ConfigureStorageAccessWithContext(ctx context.Context, p *ConfigureStorageAccessParams) (*StorageAccessResponse, error)
+	ConfigureStorageAccessAsync(ctx context.Context, p *ConfigureStorageAccessParams) (*Job, error)
 	NewConfigureStorageAccessParams() *ConfigureStorageAccessParams
 	ListStorageAccessGroups(p *ListStorageAccessGroupsParams) (*ListStorageAccessGroupsResponse, error)
+	ListStorageAccessGroupsWithContext(ctx context.Context, p *ListStorageAccessGroupsParams) (*ListStorageAccessGroupsResponse, error)
+	ListStorageAccessGroupsAll(p *ListStorageAccessGroupsParams, opts ...PageOption) ([]*StorageAccessGroup, error)
+	ListStorageAccessGroupsAllWithContext(ctx context.Context, p *ListStorageAccessGroupsParams, opts ...PageOption) ([]*StorageAccessGroup, error)
+	ListStorageAccessGroupsIter(p *ListStorageAccessGroupsParams, opts ...PageOption) iter.Seq2[*StorageAccessGroup, error]
+	ListStorageAccessGroupsIterWithContext(ctx context.Context, p *ListStorageAccessGroupsParams, opts ...PageOption) iter.Seq2[*StorageAccessGroup, error]
 	NewListStorageAccessGroupsParams() *ListStorageAccessGroupsParams
 	GetStorageAccessGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetStorageAccessGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 }
 
 type CreateStoragePoolParams struct {
@@ -79,7 +104,7 @@ func (p *CreateStoragePoolParams) toURLValues() url.Values {
 		}
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["istagarule"]; found {
 		vv := strconv.FormatBool(v.(bool))
@@ -116,6 +141,58 @@ func (p *CreateStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createStoragePool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateStoragePoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createStoragePool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createStoragePool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateStoragePoolParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "url", "zoneid"},
+		lengths:  map[string]int{"clusterid": 255, "hypervisor": 255, "name": 255, "podid": 255, "provider": 255, "scope": 255, "storageaccessgroups": 255, "tags": 4096, "url": 2048, "zoneid": 255},
+	}.validate("createStoragePool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createStoragePool API, before the request is signed
+func (p *CreateStoragePoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateStoragePoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createStoragePool", b, &p.p, map[string]paramDecoder{
+		"capacitybytes":       decodeParam[int64],
+		"capacityiops":        decodeParam[int64],
+		"clusterid":           decodeParam[string],
+		"details":             decodeParam[map[string]string],
+		"hypervisor":          decodeParam[HypervisorType],
+		"istagarule":          decodeParam[bool],
+		"managed":             decodeParam[bool],
+		"name":                decodeParam[string],
+		"podid":               decodeParam[string],
+		"provider":            decodeParam[string],
+		"scope":               decodeParam[string],
+		"storageaccessgroups": decodeParam[string],
+		"tags":                decodeParam[string],
+		"url":                 decodeParam[string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateStoragePoolParams) Clone() *CreateStoragePoolParams {
+	return &CreateStoragePoolParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateStoragePoolParams) SetCapacitybytes(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -200,7 +277,7 @@ func (p *CreateStoragePoolParams) GetDetails() (map[string]string, bool) {
 	return value, ok
 }
 
-func (p *CreateStoragePoolParams) SetHypervisor(v string) {
+func (p *CreateStoragePoolParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -213,11 +290,11 @@ func (p *CreateStoragePoolParams) ResetHypervisor() {
 	}
 }
 
-func (p *CreateStoragePoolParams) GetHypervisor() (string, bool) {
+func (p *CreateStoragePoolParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -444,7 +521,16 @@ func (s *PoolService) NewCreateStoragePoolParams(name string, url string, zoneid
 
 // Creates a storage pool.
 func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
-	resp, err := s.cs.newPostRequest("createStoragePool", p.toURLValues())
+	return s.CreateStoragePoolWithContext(context.Background(), p)
+}
+
+// CreateStoragePoolWithContext is like CreateStoragePool, but honours the cancellation and deadline of ctx
+func (s *PoolService) CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -454,7 +540,7 @@ func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStor
 	}
 
 	var r CreateStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createStoragePool", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -462,42 +548,48 @@ func (s *PoolService) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStor
 }
 
 type CreateStoragePoolResponse struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateStoragePoolResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateStoragePoolResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteStoragePoolParams struct {
@@ -519,6 +611,45 @@ func (p *DeleteStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteStoragePool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteStoragePoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteStoragePool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteStoragePool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteStoragePoolParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteStoragePool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteStoragePool API, before the request is signed
+func (p *DeleteStoragePoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteStoragePoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteStoragePool", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteStoragePoolParams) Clone() *DeleteStoragePoolParams {
+	return &DeleteStoragePoolParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteStoragePoolParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -572,13 +703,22 @@ func (s *PoolService) NewDeleteStoragePoolParams(id string) *DeleteStoragePoolPa
 
 // Deletes a storage pool.
 func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteStoragePool", p.toURLValues())
+	return s.DeleteStoragePoolWithContext(context.Background(), p)
+}
+
+// DeleteStoragePoolWithContext is like DeleteStoragePool, but honours the cancellation and deadline of ctx
+func (s *PoolService) DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteStoragePool", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -586,10 +726,11 @@ func (s *PoolService) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStor
 }
 
 type DeleteStoragePoolResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteStoragePoolResponse) UnmarshalJSON(b []byte) error {
@@ -616,7 +757,7 @@ func (r *DeleteStoragePoolResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteStoragePoolResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type FindStoragePoolsForMigrationParams struct {
@@ -645,6 +786,47 @@ func (p *FindStoragePoolsForMigrationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the findStoragePoolsForMigration API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *FindStoragePoolsForMigrationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("findStoragePoolsForMigration", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the findStoragePoolsForMigration API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *FindStoragePoolsForMigrationParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "keyword": 255},
+	}.validate("findStoragePoolsForMigration", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the findStoragePoolsForMigration API, before the request is signed
+func (p *FindStoragePoolsForMigrationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *FindStoragePoolsForMigrationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *FindStoragePoolsForMigrationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("findStoragePoolsForMigration", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *FindStoragePoolsForMigrationParams) Clone() *FindStoragePoolsForMigrationParams {
+	return &FindStoragePoolsForMigrationParams{p: cloneParams(p.p)}
+}
+
 func (p *FindStoragePoolsForMigrationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -740,13 +922,22 @@ func (s *PoolService) NewFindStoragePoolsForMigrationParams(id string) *FindStor
 
 // Lists storage pools available for migration of a volume.
 func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
-	resp, err := s.cs.newRequest("findStoragePoolsForMigration", p.toURLValues())
+	return s.FindStoragePoolsForMigrationWithContext(context.Background(), p)
+}
+
+// FindStoragePoolsForMigrationWithContext is like FindStoragePoolsForMigration, but honours the cancellation and deadline of ctx
+func (s *PoolService) FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "findStoragePoolsForMigration", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r FindStoragePoolsForMigrationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("findStoragePoolsForMigration", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -754,42 +945,48 @@ func (s *PoolService) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrati
 }
 
 type FindStoragePoolsForMigrationResponse struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *FindStoragePoolsForMigrationResponse) UnmarshalJSON(b []byte) error {
+	type alias FindStoragePoolsForMigrationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListElastistorPoolParams struct {
@@ -808,6 +1005,41 @@ func (p *ListElastistorPoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listElastistorPool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListElastistorPoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listElastistorPool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listElastistorPool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListElastistorPoolParams) Validate() error {
+	return paramRules{}.validate("listElastistorPool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listElastistorPool API, before the request is signed
+func (p *ListElastistorPoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListElastistorPoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListElastistorPoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listElastistorPool", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListElastistorPoolParams) Clone() *ListElastistorPoolParams {
+	return &ListElastistorPoolParams{p: cloneParams(p.p)}
+}
+
 func (p *ListElastistorPoolParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -839,13 +1071,22 @@ func (s *PoolService) NewListElastistorPoolParams() *ListElastistorPoolParams {
 
 // Lists the pools of elastistor
 func (s *PoolService) ListElastistorPool(p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error) {
-	resp, err := s.cs.newRequest("listElastistorPool", p.toURLValues())
+	return s.ListElastistorPoolWithContext(context.Background(), p)
+}
+
+// ListElastistorPoolWithContext is like ListElastistorPool, but honours the cancellation and deadline of ctx
+func (s *PoolService) ListElastistorPoolWithContext(ctx context.Context, p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listElastistorPool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListElastistorPoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listElastistorPool", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -858,15 +1099,21 @@ type ListElastistorPoolResponse struct {
 }
 
 type ElastistorPool struct {
-	Controllerid string `json:"controllerid"`
-	Gateway      string `json:"gateway"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Maxiops      int64  `json:"maxiops"`
-	Name         string `json:"name"`
-	Size         int64  `json:"size"`
-	State        string `json:"state"`
+	Controllerid string                     `json:"controllerid"`
+	Gateway      string                     `json:"gateway"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Maxiops      FlexInt64                  `json:"maxiops"`
+	Name         string                     `json:"name"`
+	Size         FlexInt64                  `json:"size"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ElastistorPool) UnmarshalJSON(b []byte) error {
+	type alias ElastistorPool
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStoragePoolsParams struct {
@@ -929,6 +1176,57 @@ func (p *ListStoragePoolsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStoragePools API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStoragePoolsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStoragePools", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStoragePools API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStoragePoolsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"clusterid": 255, "hostid": 255, "id": 255, "ipaddress": 255, "keyword": 255, "name": 255, "path": 255, "podid": 255, "scope": 255, "status": 255, "storageaccessgroup": 255, "zoneid": 255},
+	}.validate("listStoragePools", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStoragePools API, before the request is signed
+func (p *ListStoragePoolsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStoragePoolsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStoragePoolsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStoragePools", b, &p.p, map[string]paramDecoder{
+		"clusterid":          decodeParam[string],
+		"hostid":             decodeParam[string],
+		"id":                 decodeParam[string],
+		"ipaddress":          decodeParam[string],
+		"keyword":            decodeParam[string],
+		"name":               decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"path":               decodeParam[string],
+		"podid":              decodeParam[string],
+		"scope":              decodeParam[string],
+		"status":             decodeParam[string],
+		"storageaccessgroup": decodeParam[string],
+		"storagecustomstats": decodeParam[bool],
+		"zoneid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStoragePoolsParams) Clone() *ListStoragePoolsParams {
+	return &ListStoragePoolsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStoragePoolsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1254,6 +1552,11 @@ func (s *PoolService) NewListStoragePoolsParams() *ListStoragePoolsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetStoragePoolIDWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolIDWithContext is like GetStoragePoolID, but honours the cancellation and deadline of ctx
+func (s *PoolService) GetStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStoragePoolsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1265,7 +1568,7 @@ func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListStoragePools(p)
+	l, err := s.ListStoragePoolsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1290,12 +1593,17 @@ func (s *PoolService) GetStoragePoolID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PoolService) GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error) {
-	id, count, err := s.GetStoragePoolID(name, opts...)
+	return s.GetStoragePoolByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolByNameWithContext is like GetStoragePoolByName, but honours the cancellation and deadline of ctx
+func (s *PoolService) GetStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePool, int, error) {
+	id, count, err := s.GetStoragePoolIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetStoragePoolByID(id, opts...)
+	r, count, err := s.GetStoragePoolByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1304,6 +1612,11 @@ func (s *PoolService) GetStoragePoolByName(name string, opts ...OptionFunc) (*St
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error) {
+	return s.GetStoragePoolByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStoragePoolByIDWithContext is like GetStoragePoolByID, but honours the cancellation and deadline of ctx
+func (s *PoolService) GetStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePool, int, error) {
 	p := &ListStoragePoolsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1315,11 +1628,10 @@ func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*Storag
 		}
 	}
 
-	l, err := s.ListStoragePools(p)
+	l, err := s.ListStoragePoolsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1337,61 +1649,104 @@ func (s *PoolService) GetStoragePoolByID(id string, opts ...OptionFunc) (*Storag
 
 // Lists storage pools.
 func (s *PoolService) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
-	resp, err := s.cs.newRequest("listStoragePools", p.toURLValues())
+	return s.ListStoragePoolsWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsWithContext is like ListStoragePools, but honours the cancellation and deadline of ctx
+func (s *PoolService) ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStoragePools", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStoragePoolsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStoragePools", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStoragePoolsAll returns all StoragePools matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *PoolService) ListStoragePoolsAll(p *ListStoragePoolsParams, opts ...PageOption) ([]*StoragePool, error) {
+	return s.ListStoragePoolsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStoragePoolsAllWithContext is like ListStoragePoolsAll, but honours the cancellation and deadline of ctx
+func (s *PoolService) ListStoragePoolsAllWithContext(ctx context.Context, p *ListStoragePoolsParams, opts ...PageOption) ([]*StoragePool, error) {
+	return collectPages(s.ListStoragePoolsIterWithContext(ctx, p, opts...))
+}
+
+// ListStoragePoolsIter returns an iterator over all StoragePools matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *PoolService) ListStoragePoolsIter(p *ListStoragePoolsParams, opts ...PageOption) iter.Seq2[*StoragePool, error] {
+	return s.ListStoragePoolsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStoragePoolsIterWithContext is like ListStoragePoolsIter, but honours the cancellation and deadline of ctx
+func (s *PoolService) ListStoragePoolsIterWithContext(ctx context.Context, p *ListStoragePoolsParams, opts ...PageOption) iter.Seq2[*StoragePool, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StoragePool, int, error) {
+		l, err := s.ListStoragePoolsWithContext(ctx, &ListStoragePoolsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StoragePools, l.Count, nil
+	}, opts...)
+}
+
 type ListStoragePoolsResponse struct {
 	Count        int            `json:"count"`
 	StoragePools []*StoragePool `json:"storagepool"`
 }
 
 type StoragePool struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StoragePool) UnmarshalJSON(b []byte) error {
+	type alias StoragePool
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SyncStoragePoolParams struct {
@@ -1409,6 +1764,44 @@ func (p *SyncStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the syncStoragePool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *SyncStoragePoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("syncStoragePool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the syncStoragePool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *SyncStoragePoolParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("syncStoragePool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the syncStoragePool API, before the request is signed
+func (p *SyncStoragePoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *SyncStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *SyncStoragePoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("syncStoragePool", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *SyncStoragePoolParams) Clone() *SyncStoragePoolParams {
+	return &SyncStoragePoolParams{p: cloneParams(p.p)}
+}
+
 func (p *SyncStoragePoolParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1441,32 +1834,39 @@ func (s *PoolService) NewSyncStoragePoolParams(id string) *SyncStoragePoolParams
 
 // Sync storage pool with management server (currently supported for Datastore Cluster in VMware and syncs the datastores in it)
 func (s *PoolService) SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error) {
-	resp, err := s.cs.newPostRequest("syncStoragePool", p.toURLValues())
+	return s.SyncStoragePoolWithContext(context.Background(), p)
+}
+
+// SyncStoragePoolWithContext is like SyncStoragePool, but honours the cancellation and deadline of ctx
+func (s *PoolService) SyncStoragePoolWithContext(ctx context.Context, p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "syncStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r SyncStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("syncStoragePool", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "syncStoragePool", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("syncStoragePool", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1474,43 +1874,68 @@ func (s *PoolService) SyncStoragePool(p *SyncStoragePoolParams) (*SyncStoragePoo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished SyncStoragePool async job into r
+func (r *SyncStoragePoolResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// SyncStoragePoolAsync starts the async job of SyncStoragePool without waiting for it to finish, and returns a handle to the job
+func (s *PoolService) SyncStoragePoolAsync(ctx context.Context, p *SyncStoragePoolParams) (*Job, error) {
+	r, err := s.SyncStoragePoolWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("syncStoragePool", r.JobID), nil
+}
+
 type SyncStoragePoolResponse struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SyncStoragePoolResponse) UnmarshalJSON(b []byte) error {
+	type alias SyncStoragePoolResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateStoragePoolParams struct {
@@ -1560,6 +1985,52 @@ func (p *UpdateStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateStoragePool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateStoragePoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateStoragePool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateStoragePool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateStoragePoolParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "name": 255, "url": 2048},
+	}.validate("updateStoragePool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateStoragePool API, before the request is signed
+func (p *UpdateStoragePoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateStoragePoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateStoragePool", b, &p.p, map[string]paramDecoder{
+		"capacitybytes": decodeParam[int64],
+		"capacityiops":  decodeParam[int64],
+		"details":       decodeParam[map[string]string],
+		"enabled":       decodeParam[bool],
+		"id":            decodeParam[string],
+		"istagarule":    decodeParam[bool],
+		"name":          decodeParam[string],
+		"tags":          decodeParam[[]string],
+		"url":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateStoragePoolParams) Clone() *UpdateStoragePoolParams {
+	return &UpdateStoragePoolParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateStoragePoolParams) SetCapacitybytes(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1760,13 +2231,22 @@ func (s *PoolService) NewUpdateStoragePoolParams(id string) *UpdateStoragePoolPa
 
 // Updates a storage pool.
 func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
-	resp, err := s.cs.newPostRequest("updateStoragePool", p.toURLValues())
+	return s.UpdateStoragePoolWithContext(context.Background(), p)
+}
+
+// UpdateStoragePoolWithContext is like UpdateStoragePool, but honours the cancellation and deadline of ctx
+func (s *PoolService) UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateStoragePool", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1774,42 +2254,48 @@ func (s *PoolService) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStor
 }
 
 type UpdateStoragePoolResponse struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateStoragePoolResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateStoragePoolResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ConfigureStorageAccessParams struct {
@@ -1843,6 +2329,48 @@ func (p *ConfigureStorageAccessParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configureStorageAccess API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigureStorageAccessParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configureStorageAccess", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configureStorageAccess API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigureStorageAccessParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"clusterid": 255, "hostid": 255, "podid": 255, "storageid": 255, "zoneid": 255},
+	}.validate("configureStorageAccess", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configureStorageAccess API, before the request is signed
+func (p *ConfigureStorageAccessParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigureStorageAccessParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigureStorageAccessParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configureStorageAccess", b, &p.p, map[string]paramDecoder{
+		"clusterid":           decodeParam[string],
+		"hostid":              decodeParam[string],
+		"podid":               decodeParam[string],
+		"storageaccessgroups": decodeParam[[]string],
+		"storageid":           decodeParam[string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigureStorageAccessParams) Clone() *ConfigureStorageAccessParams {
+	return &ConfigureStorageAccessParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigureStorageAccessParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1979,27 +2507,39 @@ func (s *PoolService) NewConfigureStorageAccessParams() *ConfigureStorageAccessP
 
 // Configure the storage access groups on zone/pod/cluster/host and storage, accordingly connections to the storage pools
 func (s *PoolService) ConfigureStorageAccess(p *ConfigureStorageAccessParams) (*StorageAccessResponse, error) {
-	resp, err := s.cs.newPostRequest("configureStorageAccess", p.toURLValues())
+	return s.ConfigureStorageAccessWithContext(context.Background(), p)
+}
+
+// ConfigureStorageAccessWithContext is like ConfigureStorageAccess, but honours the cancellation and deadline of ctx
+func (s *PoolService) ConfigureStorageAccessWithContext(ctx context.Context, p *ConfigureStorageAccessParams) (*StorageAccessResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "configureStorageAccess", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StorageAccessResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configureStorageAccess", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "configureStorageAccess", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("configureStorageAccess", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2007,11 +2547,31 @@ func (s *PoolService) ConfigureStorageAccess(p *ConfigureStorageAccessParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ConfigureStorageAccess async job into r
+func (r *StorageAccessResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ConfigureStorageAccessAsync starts the async job of ConfigureStorageAccess without waiting for it to finish, and returns a handle to the job
+func (s *PoolService) ConfigureStorageAccessAsync(ctx context.Context, p *ConfigureStorageAccessParams) (*Job, error) {
+	r, err := s.ConfigureStorageAccessWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("configureStorageAccess", r.JobID), nil
+}
+
 type StorageAccessResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StorageAccessResponse) UnmarshalJSON(b []byte) error {
+	type alias StorageAccessResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStorageAccessGroupsParams struct {
@@ -2040,6 +2600,46 @@ func (p *ListStorageAccessGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStorageAccessGroups API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStorageAccessGroupsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStorageAccessGroups", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStorageAccessGroups API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStorageAccessGroupsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "name": 255},
+	}.validate("listStorageAccessGroups", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStorageAccessGroups API, before the request is signed
+func (p *ListStorageAccessGroupsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStorageAccessGroupsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStorageAccessGroupsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStorageAccessGroups", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStorageAccessGroupsParams) Clone() *ListStorageAccessGroupsParams {
+	return &ListStorageAccessGroupsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStorageAccessGroupsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2134,6 +2734,11 @@ func (s *PoolService) NewListStorageAccessGroupsParams() *ListStorageAccessGroup
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PoolService) GetStorageAccessGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetStorageAccessGroupIDWithContext(context.Background(), name, opts...)
+}
+
+// GetStorageAccessGroupIDWithContext is like GetStorageAccessGroupID, but honours the cancellation and deadline of ctx
+func (s *PoolService) GetStorageAccessGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStorageAccessGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2145,7 +2750,7 @@ func (s *PoolService) GetStorageAccessGroupID(name string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListStorageAccessGroups(p)
+	l, err := s.ListStorageAccessGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2170,32 +2775,75 @@ func (s *PoolService) GetStorageAccessGroupID(name string, opts ...OptionFunc) (
 
 // Lists storage access groups
 func (s *PoolService) ListStorageAccessGroups(p *ListStorageAccessGroupsParams) (*ListStorageAccessGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listStorageAccessGroups", p.toURLValues())
+	return s.ListStorageAccessGroupsWithContext(context.Background(), p)
+}
+
+// ListStorageAccessGroupsWithContext is like ListStorageAccessGroups, but honours the cancellation and deadline of ctx
+func (s *PoolService) ListStorageAccessGroupsWithContext(ctx context.Context, p *ListStorageAccessGroupsParams) (*ListStorageAccessGroupsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStorageAccessGroups", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStorageAccessGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStorageAccessGroups", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStorageAccessGroupsAll returns all StorageAccessGroups matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *PoolService) ListStorageAccessGroupsAll(p *ListStorageAccessGroupsParams, opts ...PageOption) ([]*StorageAccessGroup, error) {
+	return s.ListStorageAccessGroupsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageAccessGroupsAllWithContext is like ListStorageAccessGroupsAll, but honours the cancellation and deadline of ctx
+func (s *PoolService) ListStorageAccessGroupsAllWithContext(ctx context.Context, p *ListStorageAccessGroupsParams, opts ...PageOption) ([]*StorageAccessGroup, error) {
+	return collectPages(s.ListStorageAccessGroupsIterWithContext(ctx, p, opts...))
+}
+
+// ListStorageAccessGroupsIter returns an iterator over all StorageAccessGroups matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *PoolService) ListStorageAccessGroupsIter(p *ListStorageAccessGroupsParams, opts ...PageOption) iter.Seq2[*StorageAccessGroup, error] {
+	return s.ListStorageAccessGroupsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageAccessGroupsIterWithContext is like ListStorageAccessGroupsIter, but honours the cancellation and deadline of ctx
+func (s *PoolService) ListStorageAccessGroupsIterWithContext(ctx context.Context, p *ListStorageAccessGroupsParams, opts ...PageOption) iter.Seq2[*StorageAccessGroup, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StorageAccessGroup, int, error) {
+		l, err := s.ListStorageAccessGroupsWithContext(ctx, &ListStorageAccessGroupsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StorageAccessGroups, l.Count, nil
+	}, opts...)
+}
+
 type ListStorageAccessGroupsResponse struct {
 	Count               int                   `json:"count"`
 	StorageAccessGroups []*StorageAccessGroup `json:"storageaccessgroup"`
 }
 
 type StorageAccessGroup struct {
-	Clusters     string `json:"clusters"`
-	Hosts        string `json:"hosts"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Name         string `json:"name"`
-	Pods         string `json:"pods"`
-	Storagepools string `json:"storagepools"`
-	Zones        string `json:"zones"`
+	Clusters     string                     `json:"clusters"`
+	Hosts        string                     `json:"hosts"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Name         string                     `json:"name"`
+	Pods         string                     `json:"pods"`
+	Storagepools string                     `json:"storagepools"`
+	Zones        string                     `json:"zones"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StorageAccessGroup) UnmarshalJSON(b []byte) error {
+	type alias StorageAccessGroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockPoolServiceIfaceMockRecorder) ConfigureStorageAccess(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureStorageAccess", reflect.TypeOf((*MockPoolServiceIface)(nil).ConfigureStorageAccess), p)
 }
 
+// ConfigureStorageAccessAsync mocks base method.
+func (m *MockPoolServiceIface) ConfigureStorageAccessAsync(ctx context.Context, p *ConfigureStorageAccessParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureStorageAccessAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureStorageAccessAsync indicates an expected call of ConfigureStorageAccessAsync.
+func (mr *MockPoolServiceIfaceMockRecorder) ConfigureStorageAccessAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureStorageAccessAsync", reflect.TypeOf((*MockPoolServiceIface)(nil).ConfigureStorageAccessAsync), ctx, p)
+}
+
+// ConfigureStorageAccessWithContext mocks base method.
+func (m *MockPoolServiceIface) ConfigureStorageAccessWithContext(ctx context.Context, p *ConfigureStorageAccessParams) (*StorageAccessResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureStorageAccessWithContext", ctx, p)
+	ret0, _ := ret[0].(*StorageAccessResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureStorageAccessWithContext indicates an expected call of ConfigureStorageAccessWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ConfigureStorageAccessWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureStorageAccessWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ConfigureStorageAccessWithContext), ctx, p)
+}
+
 // CreateStoragePool mocks base method.
 func (m *MockPoolServiceIface) CreateStoragePool(p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,21 @@ func (mr *MockPoolServiceIfaceMockRecorder) CreateStoragePool(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).CreateStoragePool), p)
 }
 
+// CreateStoragePoolWithContext mocks base method.
+func (m *MockPoolServiceIface) CreateStoragePoolWithContext(ctx context.Context, p *CreateStoragePoolParams) (*CreateStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStoragePoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStoragePoolWithContext indicates an expected call of CreateStoragePoolWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) CreateStoragePoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStoragePoolWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).CreateStoragePoolWithContext), ctx, p)
+}
+
 // DeleteStoragePool mocks base method.
 func (m *MockPoolServiceIface) DeleteStoragePool(p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockPoolServiceIfaceMockRecorder) DeleteStoragePool(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).DeleteStoragePool), p)
 }
 
+// DeleteStoragePoolWithContext mocks base method.
+func (m *MockPoolServiceIface) DeleteStoragePoolWithContext(ctx context.Context, p *DeleteStoragePoolParams) (*DeleteStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStoragePoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStoragePoolWithContext indicates an expected call of DeleteStoragePoolWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) DeleteStoragePoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStoragePoolWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).DeleteStoragePoolWithContext), ctx, p)
+}
+
 // FindStoragePoolsForMigration mocks base method.
 func (m *MockPoolServiceIface) FindStoragePoolsForMigration(p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,21 @@ func (mr *MockPoolServiceIfaceMockRecorder) FindStoragePoolsForMigration(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStoragePoolsForMigration", reflect.TypeOf((*MockPoolServiceIface)(nil).FindStoragePoolsForMigration), p)
 }
 
+// FindStoragePoolsForMigrationWithContext mocks base method.
+func (m *MockPoolServiceIface) FindStoragePoolsForMigrationWithContext(ctx context.Context, p *FindStoragePoolsForMigrationParams) (*FindStoragePoolsForMigrationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindStoragePoolsForMigrationWithContext", ctx, p)
+	ret0, _ := ret[0].(*FindStoragePoolsForMigrationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindStoragePoolsForMigrationWithContext indicates an expected call of FindStoragePoolsForMigrationWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) FindStoragePoolsForMigrationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindStoragePoolsForMigrationWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).FindStoragePoolsForMigrationWithContext), ctx, p)
+}
+
 // GetStorageAccessGroupID mocks base method.
 func (m *MockPoolServiceIface) GetStorageAccessGroupID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +216,27 @@ func (mr *MockPoolServiceIfaceMockRecorder) GetStorageAccessGroupID(name any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageAccessGroupID", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStorageAccessGroupID), varargs...)
 }
 
+// GetStorageAccessGroupIDWithContext mocks base method.
+func (m *MockPoolServiceIface) GetStorageAccessGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStorageAccessGroupIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStorageAccessGroupIDWithContext indicates an expected call of GetStorageAccessGroupIDWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) GetStorageAccessGroupIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageAccessGroupIDWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStorageAccessGroupIDWithContext), varargs...)
+}
+
 // GetStoragePoolByID mocks base method.
 func (m *MockPoolServiceIface) GetStoragePoolByID(id string, opts ...OptionFunc) (*StoragePool, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +258,27 @@ func (mr *MockPoolServiceIfaceMockRecorder) GetStoragePoolByID(id any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolByID", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStoragePoolByID), varargs...)
 }
 
+// GetStoragePoolByIDWithContext mocks base method.
+func (m *MockPoolServiceIface) GetStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePool, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoragePoolByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*StoragePool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolByIDWithContext indicates an expected call of GetStoragePoolByIDWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) GetStoragePoolByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolByIDWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStoragePoolByIDWithContext), varargs...)
+}
+
 // GetStoragePoolByName mocks base method.
 func (m *MockPoolServiceIface) GetStoragePoolByName(name string, opts ...OptionFunc) (*StoragePool, int, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +300,27 @@ func (mr *MockPoolServiceIfaceMockRecorder) GetStoragePoolByName(name any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolByName", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStoragePoolByName), varargs...)
 }
 
+// GetStoragePoolByNameWithContext mocks base method.
+func (m *MockPoolServiceIface) GetStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePool, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoragePoolByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*StoragePool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolByNameWithContext indicates an expected call of GetStoragePoolByNameWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) GetStoragePoolByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolByNameWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStoragePoolByNameWithContext), varargs...)
+}
+
 // GetStoragePoolID mocks base method.
 func (m *MockPoolServiceIface) GetStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +342,27 @@ func (mr *MockPoolServiceIfaceMockRecorder) GetStoragePoolID(name any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolID", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStoragePoolID), varargs...)
 }
 
+// GetStoragePoolIDWithContext mocks base method.
+func (m *MockPoolServiceIface) GetStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoragePoolIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolIDWithContext indicates an expected call of GetStoragePoolIDWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) GetStoragePoolIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolIDWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).GetStoragePoolIDWithContext), varargs...)
+}
+
 // ListElastistorPool mocks base method.
 func (m *MockPoolServiceIface) ListElastistorPool(p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +378,21 @@ func (mr *MockPoolServiceIfaceMockRecorder) ListElastistorPool(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElastistorPool", reflect.TypeOf((*MockPoolServiceIface)(nil).ListElastistorPool), p)
 }
 
+// ListElastistorPoolWithContext mocks base method.
+func (m *MockPoolServiceIface) ListElastistorPoolWithContext(ctx context.Context, p *ListElastistorPoolParams) (*ListElastistorPoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListElastistorPoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListElastistorPoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListElastistorPoolWithContext indicates an expected call of ListElastistorPoolWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ListElastistorPoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElastistorPoolWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ListElastistorPoolWithContext), ctx, p)
+}
+
 // ListStorageAccessGroups mocks base method.
 func (m *MockPoolServiceIface) ListStorageAccessGroups(p *ListStorageAccessGroupsParams) (*ListStorageAccessGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +408,99 @@ func (mr *MockPoolServiceIfaceMockRecorder) ListStorageAccessGroups(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageAccessGroups", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStorageAccessGroups), p)
 }
 
+// ListStorageAccessGroupsAll mocks base method.
+func (m *MockPoolServiceIface) ListStorageAccessGroupsAll(p *ListStorageAccessGroupsParams, opts ...PageOption) ([]*StorageAccessGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageAccessGroupsAll", varargs...)
+	ret0, _ := ret[0].([]*StorageAccessGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageAccessGroupsAll indicates an expected call of ListStorageAccessGroupsAll.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStorageAccessGroupsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageAccessGroupsAll", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStorageAccessGroupsAll), varargs...)
+}
+
+// ListStorageAccessGroupsAllWithContext mocks base method.
+func (m *MockPoolServiceIface) ListStorageAccessGroupsAllWithContext(ctx context.Context, p *ListStorageAccessGroupsParams, opts ...PageOption) ([]*StorageAccessGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageAccessGroupsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StorageAccessGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageAccessGroupsAllWithContext indicates an expected call of ListStorageAccessGroupsAllWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStorageAccessGroupsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageAccessGroupsAllWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStorageAccessGroupsAllWithContext), varargs...)
+}
+
+// ListStorageAccessGroupsIter mocks base method.
+func (m *MockPoolServiceIface) ListStorageAccessGroupsIter(p *ListStorageAccessGroupsParams, opts ...PageOption) iter.Seq2[*StorageAccessGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageAccessGroupsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageAccessGroup, error])
+	return ret0
+}
+
+// ListStorageAccessGroupsIter indicates an expected call of ListStorageAccessGroupsIter.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStorageAccessGroupsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageAccessGroupsIter", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStorageAccessGroupsIter), varargs...)
+}
+
+// ListStorageAccessGroupsIterWithContext mocks base method.
+func (m *MockPoolServiceIface) ListStorageAccessGroupsIterWithContext(ctx context.Context, p *ListStorageAccessGroupsParams, opts ...PageOption) iter.Seq2[*StorageAccessGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageAccessGroupsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageAccessGroup, error])
+	return ret0
+}
+
+// ListStorageAccessGroupsIterWithContext indicates an expected call of ListStorageAccessGroupsIterWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStorageAccessGroupsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageAccessGroupsIterWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStorageAccessGroupsIterWithContext), varargs...)
+}
+
+// ListStorageAccessGroupsWithContext mocks base method.
+func (m *MockPoolServiceIface) ListStorageAccessGroupsWithContext(ctx context.Context, p *ListStorageAccessGroupsParams) (*ListStorageAccessGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageAccessGroupsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStorageAccessGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageAccessGroupsWithContext indicates an expected call of ListStorageAccessGroupsWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStorageAccessGroupsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageAccessGroupsWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStorageAccessGroupsWithContext), ctx, p)
+}
+
 // ListStoragePools mocks base method.
 func (m *MockPoolServiceIface) ListStoragePools(p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +516,99 @@ func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePools(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePools", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePools), p)
 }
 
+// ListStoragePoolsAll mocks base method.
+func (m *MockPoolServiceIface) ListStoragePoolsAll(p *ListStoragePoolsParams, opts ...PageOption) ([]*StoragePool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsAll", varargs...)
+	ret0, _ := ret[0].([]*StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolsAll indicates an expected call of ListStoragePoolsAll.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePoolsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsAll", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePoolsAll), varargs...)
+}
+
+// ListStoragePoolsAllWithContext mocks base method.
+func (m *MockPoolServiceIface) ListStoragePoolsAllWithContext(ctx context.Context, p *ListStoragePoolsParams, opts ...PageOption) ([]*StoragePool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolsAllWithContext indicates an expected call of ListStoragePoolsAllWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePoolsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsAllWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePoolsAllWithContext), varargs...)
+}
+
+// ListStoragePoolsIter mocks base method.
+func (m *MockPoolServiceIface) ListStoragePoolsIter(p *ListStoragePoolsParams, opts ...PageOption) iter.Seq2[*StoragePool, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StoragePool, error])
+	return ret0
+}
+
+// ListStoragePoolsIter indicates an expected call of ListStoragePoolsIter.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePoolsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsIter", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePoolsIter), varargs...)
+}
+
+// ListStoragePoolsIterWithContext mocks base method.
+func (m *MockPoolServiceIface) ListStoragePoolsIterWithContext(ctx context.Context, p *ListStoragePoolsParams, opts ...PageOption) iter.Seq2[*StoragePool, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StoragePool, error])
+	return ret0
+}
+
+// ListStoragePoolsIterWithContext indicates an expected call of ListStoragePoolsIterWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePoolsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsIterWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePoolsIterWithContext), varargs...)
+}
+
+// ListStoragePoolsWithContext mocks base method.
+func (m *MockPoolServiceIface) ListStoragePoolsWithContext(ctx context.Context, p *ListStoragePoolsParams) (*ListStoragePoolsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoragePoolsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStoragePoolsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolsWithContext indicates an expected call of ListStoragePoolsWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) ListStoragePoolsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).ListStoragePoolsWithContext), ctx, p)
+}
+
 // NewConfigureStorageAccessParams mocks base method.
 func (m *MockPoolServiceIface) NewConfigureStorageAccessParams() *ConfigureStorageAccessParams {
 	m.ctrl.T.Helper()
@@ -388,6 +750,36 @@ func (mr *MockPoolServiceIfaceMockRecorder) SyncStoragePool(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).SyncStoragePool), p)
 }
 
+// SyncStoragePoolAsync mocks base method.
+func (m *MockPoolServiceIface) SyncStoragePoolAsync(ctx context.Context, p *SyncStoragePoolParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStoragePoolAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStoragePoolAsync indicates an expected call of SyncStoragePoolAsync.
+func (mr *MockPoolServiceIfaceMockRecorder) SyncStoragePoolAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStoragePoolAsync", reflect.TypeOf((*MockPoolServiceIface)(nil).SyncStoragePoolAsync), ctx, p)
+}
+
+// SyncStoragePoolWithContext mocks base method.
+func (m *MockPoolServiceIface) SyncStoragePoolWithContext(ctx context.Context, p *SyncStoragePoolParams) (*SyncStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncStoragePoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*SyncStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SyncStoragePoolWithContext indicates an expected call of SyncStoragePoolWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) SyncStoragePoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncStoragePoolWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).SyncStoragePoolWithContext), ctx, p)
+}
+
 // UpdateStoragePool mocks base method.
 func (m *MockPoolServiceIface) UpdateStoragePool(p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
@@ -402,3 +794,18 @@ func (mr *MockPoolServiceIfaceMockRecorder) UpdateStoragePool(p any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStoragePool", reflect.TypeOf((*MockPoolServiceIface)(nil).UpdateStoragePool), p)
 }
+
+// UpdateStoragePoolWithContext mocks base method.
+func (m *MockPoolServiceIface) UpdateStoragePoolWithContext(ctx context.Context, p *UpdateStoragePoolParams) (*UpdateStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateStoragePoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateStoragePoolWithContext indicates an expected call of UpdateStoragePoolWithContext.
+func (mr *MockPoolServiceIfaceMockRecorder) UpdateStoragePoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateStoragePoolWithContext", reflect.TypeOf((*MockPoolServiceIface)(nil).UpdateStoragePoolWithContext), ctx, p)
+}
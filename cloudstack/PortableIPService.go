@@ -20,21 +20,32 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type PortableIPServiceIface interface {
 	CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeWithContext(ctx context.Context, p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error)
+	CreatePortableIpRangeAsync(ctx context.Context, p *CreatePortableIpRangeParams) (*Job, error)
 	NewCreatePortableIpRangeParams(endip string, gateway string, netmask string, regionid int, startip string) *CreatePortableIpRangeParams
 	DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeWithContext(ctx context.Context, p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error)
+	DeletePortableIpRangeAsync(ctx context.Context, p *DeletePortableIpRangeParams) (*Job, error)
 	NewDeletePortableIpRangeParams(id string) *DeletePortableIpRangeParams
 	ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRangesWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error)
+	ListPortableIpRangesAll(p *ListPortableIpRangesParams, opts ...PageOption) ([]*PortableIpRange, error)
+	ListPortableIpRangesAllWithContext(ctx context.Context, p *ListPortableIpRangesParams, opts ...PageOption) ([]*PortableIpRange, error)
+	ListPortableIpRangesIter(p *ListPortableIpRangesParams, opts ...PageOption) iter.Seq2[*PortableIpRange, error]
+	ListPortableIpRangesIterWithContext(ctx context.Context, p *ListPortableIpRangesParams, opts ...PageOption) iter.Seq2[*PortableIpRange, error]
 	NewListPortableIpRangesParams() *ListPortableIpRangesParams
 	GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error)
+	GetPortableIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortableIpRange, int, error)
 }
 
 type CreatePortableIpRangeParams struct {
@@ -68,6 +79,49 @@ func (p *CreatePortableIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createPortableIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreatePortableIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createPortableIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createPortableIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreatePortableIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"endip", "gateway", "netmask", "regionid", "startip"},
+		lengths:  map[string]int{"endip": 255, "gateway": 255, "netmask": 255, "startip": 255, "vlan": 255},
+	}.validate("createPortableIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createPortableIpRange API, before the request is signed
+func (p *CreatePortableIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreatePortableIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreatePortableIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createPortableIpRange", b, &p.p, map[string]paramDecoder{
+		"endip":    decodeParam[string],
+		"gateway":  decodeParam[string],
+		"netmask":  decodeParam[string],
+		"regionid": decodeParam[int],
+		"startip":  decodeParam[string],
+		"vlan":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreatePortableIpRangeParams) Clone() *CreatePortableIpRangeParams {
+	return &CreatePortableIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *CreatePortableIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -209,32 +263,39 @@ func (s *PortableIPService) NewCreatePortableIpRangeParams(endip string, gateway
 
 // Adds a range of portable public IP's to a region
 func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("createPortableIpRange", p.toURLValues())
+	return s.CreatePortableIpRangeWithContext(context.Background(), p)
+}
+
+// CreatePortableIpRangeWithContext is like CreatePortableIpRange, but honours the cancellation and deadline of ctx
+func (s *PortableIPService) CreatePortableIpRangeWithContext(ctx context.Context, p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createPortableIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreatePortableIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createPortableIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createPortableIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createPortableIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -242,30 +303,61 @@ func (s *PortableIPService) CreatePortableIpRange(p *CreatePortableIpRangeParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreatePortableIpRange async job into r
+func (r *CreatePortableIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreatePortableIpRangeAsync starts the async job of CreatePortableIpRange without waiting for it to finish, and returns a handle to the job
+func (s *PortableIPService) CreatePortableIpRangeAsync(ctx context.Context, p *CreatePortableIpRangeParams) (*Job, error) {
+	r, err := s.CreatePortableIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createPortableIpRange", r.JobID), nil
+}
+
 type CreatePortableIpRangeResponse struct {
 	Endip             string                                           `json:"endip"`
 	Gateway           string                                           `json:"gateway"`
 	Id                string                                           `json:"id"`
 	JobID             string                                           `json:"jobid"`
-	Jobstatus         int                                              `json:"jobstatus"`
+	Jobstatus         FlexInt                                          `json:"jobstatus"`
 	Netmask           string                                           `json:"netmask"`
 	Portableipaddress []CreatePortableIpRangeResponsePortableipaddress `json:"portableipaddress"`
-	Regionid          int                                              `json:"regionid"`
+	Regionid          FlexInt                                          `json:"regionid"`
 	Startip           string                                           `json:"startip"`
 	Vlan              string                                           `json:"vlan"`
+	Extra             map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *CreatePortableIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias CreatePortableIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreatePortableIpRangeResponsePortableipaddress struct {
-	Accountid         string `json:"accountid"`
-	Allocated         string `json:"allocated"`
-	Domainid          string `json:"domainid"`
-	Ipaddress         string `json:"ipaddress"`
-	Networkid         string `json:"networkid"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Regionid          int    `json:"regionid"`
-	State             string `json:"state"`
-	Vpcid             string `json:"vpcid"`
-	Zoneid            string `json:"zoneid"`
+	Accountid         string                     `json:"accountid"`
+	Allocated         string                     `json:"allocated"`
+	Domainid          string                     `json:"domainid"`
+	Ipaddress         string                     `json:"ipaddress"`
+	Networkid         string                     `json:"networkid"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Regionid          FlexInt                    `json:"regionid"`
+	State             string                     `json:"state"`
+	Vpcid             string                     `json:"vpcid"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreatePortableIpRangeResponsePortableipaddress) UnmarshalJSON(b []byte) error {
+	type alias CreatePortableIpRangeResponsePortableipaddress
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeletePortableIpRangeParams struct {
@@ -283,6 +375,44 @@ func (p *DeletePortableIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deletePortableIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeletePortableIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deletePortableIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deletePortableIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeletePortableIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deletePortableIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deletePortableIpRange API, before the request is signed
+func (p *DeletePortableIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeletePortableIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeletePortableIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deletePortableIpRange", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeletePortableIpRangeParams) Clone() *DeletePortableIpRangeParams {
+	return &DeletePortableIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *DeletePortableIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -315,27 +445,39 @@ func (s *PortableIPService) NewDeletePortableIpRangeParams(id string) *DeletePor
 
 // Deletes a range of portable public IP's associated with a region
 func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("deletePortableIpRange", p.toURLValues())
+	return s.DeletePortableIpRangeWithContext(context.Background(), p)
+}
+
+// DeletePortableIpRangeWithContext is like DeletePortableIpRange, but honours the cancellation and deadline of ctx
+func (s *PortableIPService) DeletePortableIpRangeWithContext(ctx context.Context, p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deletePortableIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePortableIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deletePortableIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deletePortableIpRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deletePortableIpRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -343,11 +485,31 @@ func (s *PortableIPService) DeletePortableIpRange(p *DeletePortableIpRangeParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeletePortableIpRange async job into r
+func (r *DeletePortableIpRangeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeletePortableIpRangeAsync starts the async job of DeletePortableIpRange without waiting for it to finish, and returns a handle to the job
+func (s *PortableIPService) DeletePortableIpRangeAsync(ctx context.Context, p *DeletePortableIpRangeParams) (*Job, error) {
+	r, err := s.DeletePortableIpRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deletePortableIpRange", r.JobID), nil
+}
+
 type DeletePortableIpRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeletePortableIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias DeletePortableIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPortableIpRangesParams struct {
@@ -380,6 +542,47 @@ func (p *ListPortableIpRangesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPortableIpRanges API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPortableIpRangesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPortableIpRanges", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPortableIpRanges API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPortableIpRangesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255},
+	}.validate("listPortableIpRanges", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPortableIpRanges API, before the request is signed
+func (p *ListPortableIpRangesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPortableIpRangesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPortableIpRangesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPortableIpRanges", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"regionid": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPortableIpRangesParams) Clone() *ListPortableIpRangesParams {
+	return &ListPortableIpRangesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPortableIpRangesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -495,6 +698,11 @@ func (s *PortableIPService) NewListPortableIpRangesParams() *ListPortableIpRange
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
+	return s.GetPortableIpRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPortableIpRangeByIDWithContext is like GetPortableIpRangeByID, but honours the cancellation and deadline of ctx
+func (s *PortableIPService) GetPortableIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
 	p := &ListPortableIpRangesParams{}
 	p.p = make(map[string]interface{})
 
@@ -506,11 +714,10 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListPortableIpRanges(p)
+	l, err := s.ListPortableIpRangesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -528,19 +735,56 @@ func (s *PortableIPService) GetPortableIpRangeByID(id string, opts ...OptionFunc
 
 // List portable IP ranges
 func (s *PortableIPService) ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listPortableIpRanges", p.toURLValues())
+	return s.ListPortableIpRangesWithContext(context.Background(), p)
+}
+
+// ListPortableIpRangesWithContext is like ListPortableIpRanges, but honours the cancellation and deadline of ctx
+func (s *PortableIPService) ListPortableIpRangesWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listPortableIpRanges", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPortableIpRangesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPortableIpRanges", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPortableIpRangesAll returns all PortableIpRanges matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *PortableIPService) ListPortableIpRangesAll(p *ListPortableIpRangesParams, opts ...PageOption) ([]*PortableIpRange, error) {
+	return s.ListPortableIpRangesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPortableIpRangesAllWithContext is like ListPortableIpRangesAll, but honours the cancellation and deadline of ctx
+func (s *PortableIPService) ListPortableIpRangesAllWithContext(ctx context.Context, p *ListPortableIpRangesParams, opts ...PageOption) ([]*PortableIpRange, error) {
+	return collectPages(s.ListPortableIpRangesIterWithContext(ctx, p, opts...))
+}
+
+// ListPortableIpRangesIter returns an iterator over all PortableIpRanges matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *PortableIPService) ListPortableIpRangesIter(p *ListPortableIpRangesParams, opts ...PageOption) iter.Seq2[*PortableIpRange, error] {
+	return s.ListPortableIpRangesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPortableIpRangesIterWithContext is like ListPortableIpRangesIter, but honours the cancellation and deadline of ctx
+func (s *PortableIPService) ListPortableIpRangesIterWithContext(ctx context.Context, p *ListPortableIpRangesParams, opts ...PageOption) iter.Seq2[*PortableIpRange, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*PortableIpRange, int, error) {
+		l, err := s.ListPortableIpRangesWithContext(ctx, &ListPortableIpRangesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.PortableIpRanges, l.Count, nil
+	}, opts...)
+}
+
 type ListPortableIpRangesResponse struct {
 	Count            int                `json:"count"`
 	PortableIpRanges []*PortableIpRange `json:"portableiprange"`
@@ -551,23 +795,35 @@ type PortableIpRange struct {
 	Gateway           string                             `json:"gateway"`
 	Id                string                             `json:"id"`
 	JobID             string                             `json:"jobid"`
-	Jobstatus         int                                `json:"jobstatus"`
+	Jobstatus         FlexInt                            `json:"jobstatus"`
 	Netmask           string                             `json:"netmask"`
 	Portableipaddress []PortableIpRangePortableipaddress `json:"portableipaddress"`
-	Regionid          int                                `json:"regionid"`
+	Regionid          FlexInt                            `json:"regionid"`
 	Startip           string                             `json:"startip"`
 	Vlan              string                             `json:"vlan"`
+	Extra             map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *PortableIpRange) UnmarshalJSON(b []byte) error {
+	type alias PortableIpRange
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PortableIpRangePortableipaddress struct {
-	Accountid         string `json:"accountid"`
-	Allocated         string `json:"allocated"`
-	Domainid          string `json:"domainid"`
-	Ipaddress         string `json:"ipaddress"`
-	Networkid         string `json:"networkid"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Regionid          int    `json:"regionid"`
-	State             string `json:"state"`
-	Vpcid             string `json:"vpcid"`
-	Zoneid            string `json:"zoneid"`
+	Accountid         string                     `json:"accountid"`
+	Allocated         string                     `json:"allocated"`
+	Domainid          string                     `json:"domainid"`
+	Ipaddress         string                     `json:"ipaddress"`
+	Networkid         string                     `json:"networkid"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Regionid          FlexInt                    `json:"regionid"`
+	State             string                     `json:"state"`
+	Vpcid             string                     `json:"vpcid"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PortableIpRangePortableipaddress) UnmarshalJSON(b []byte) error {
+	type alias PortableIpRangePortableipaddress
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockPortableIPServiceIfaceMockRecorder) CreatePortableIpRange(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortableIpRange", reflect.TypeOf((*MockPortableIPServiceIface)(nil).CreatePortableIpRange), p)
 }
 
+// CreatePortableIpRangeAsync mocks base method.
+func (m *MockPortableIPServiceIface) CreatePortableIpRangeAsync(ctx context.Context, p *CreatePortableIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePortableIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePortableIpRangeAsync indicates an expected call of CreatePortableIpRangeAsync.
+func (mr *MockPortableIPServiceIfaceMockRecorder) CreatePortableIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortableIpRangeAsync", reflect.TypeOf((*MockPortableIPServiceIface)(nil).CreatePortableIpRangeAsync), ctx, p)
+}
+
+// CreatePortableIpRangeWithContext mocks base method.
+func (m *MockPortableIPServiceIface) CreatePortableIpRangeWithContext(ctx context.Context, p *CreatePortableIpRangeParams) (*CreatePortableIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePortableIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreatePortableIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePortableIpRangeWithContext indicates an expected call of CreatePortableIpRangeWithContext.
+func (mr *MockPortableIPServiceIfaceMockRecorder) CreatePortableIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePortableIpRangeWithContext", reflect.TypeOf((*MockPortableIPServiceIface)(nil).CreatePortableIpRangeWithContext), ctx, p)
+}
+
 // DeletePortableIpRange mocks base method.
 func (m *MockPortableIPServiceIface) DeletePortableIpRange(p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockPortableIPServiceIfaceMockRecorder) DeletePortableIpRange(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortableIpRange", reflect.TypeOf((*MockPortableIPServiceIface)(nil).DeletePortableIpRange), p)
 }
 
+// DeletePortableIpRangeAsync mocks base method.
+func (m *MockPortableIPServiceIface) DeletePortableIpRangeAsync(ctx context.Context, p *DeletePortableIpRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePortableIpRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePortableIpRangeAsync indicates an expected call of DeletePortableIpRangeAsync.
+func (mr *MockPortableIPServiceIfaceMockRecorder) DeletePortableIpRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortableIpRangeAsync", reflect.TypeOf((*MockPortableIPServiceIface)(nil).DeletePortableIpRangeAsync), ctx, p)
+}
+
+// DeletePortableIpRangeWithContext mocks base method.
+func (m *MockPortableIPServiceIface) DeletePortableIpRangeWithContext(ctx context.Context, p *DeletePortableIpRangeParams) (*DeletePortableIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePortableIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeletePortableIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePortableIpRangeWithContext indicates an expected call of DeletePortableIpRangeWithContext.
+func (mr *MockPortableIPServiceIfaceMockRecorder) DeletePortableIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePortableIpRangeWithContext", reflect.TypeOf((*MockPortableIPServiceIface)(nil).DeletePortableIpRangeWithContext), ctx, p)
+}
+
 // GetPortableIpRangeByID mocks base method.
 func (m *MockPortableIPServiceIface) GetPortableIpRangeByID(id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +171,27 @@ func (mr *MockPortableIPServiceIfaceMockRecorder) GetPortableIpRangeByID(id any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortableIpRangeByID", reflect.TypeOf((*MockPortableIPServiceIface)(nil).GetPortableIpRangeByID), varargs...)
 }
 
+// GetPortableIpRangeByIDWithContext mocks base method.
+func (m *MockPortableIPServiceIface) GetPortableIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PortableIpRange, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPortableIpRangeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*PortableIpRange)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPortableIpRangeByIDWithContext indicates an expected call of GetPortableIpRangeByIDWithContext.
+func (mr *MockPortableIPServiceIfaceMockRecorder) GetPortableIpRangeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPortableIpRangeByIDWithContext", reflect.TypeOf((*MockPortableIPServiceIface)(nil).GetPortableIpRangeByIDWithContext), varargs...)
+}
+
 // ListPortableIpRanges mocks base method.
 func (m *MockPortableIPServiceIface) ListPortableIpRanges(p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +207,99 @@ func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRanges(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRanges", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRanges), p)
 }
 
+// ListPortableIpRangesAll mocks base method.
+func (m *MockPortableIPServiceIface) ListPortableIpRangesAll(p *ListPortableIpRangesParams, opts ...PageOption) ([]*PortableIpRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPortableIpRangesAll", varargs...)
+	ret0, _ := ret[0].([]*PortableIpRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPortableIpRangesAll indicates an expected call of ListPortableIpRangesAll.
+func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRangesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRangesAll", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRangesAll), varargs...)
+}
+
+// ListPortableIpRangesAllWithContext mocks base method.
+func (m *MockPortableIPServiceIface) ListPortableIpRangesAllWithContext(ctx context.Context, p *ListPortableIpRangesParams, opts ...PageOption) ([]*PortableIpRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPortableIpRangesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*PortableIpRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPortableIpRangesAllWithContext indicates an expected call of ListPortableIpRangesAllWithContext.
+func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRangesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRangesAllWithContext", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRangesAllWithContext), varargs...)
+}
+
+// ListPortableIpRangesIter mocks base method.
+func (m *MockPortableIPServiceIface) ListPortableIpRangesIter(p *ListPortableIpRangesParams, opts ...PageOption) iter.Seq2[*PortableIpRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPortableIpRangesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PortableIpRange, error])
+	return ret0
+}
+
+// ListPortableIpRangesIter indicates an expected call of ListPortableIpRangesIter.
+func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRangesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRangesIter", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRangesIter), varargs...)
+}
+
+// ListPortableIpRangesIterWithContext mocks base method.
+func (m *MockPortableIPServiceIface) ListPortableIpRangesIterWithContext(ctx context.Context, p *ListPortableIpRangesParams, opts ...PageOption) iter.Seq2[*PortableIpRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPortableIpRangesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PortableIpRange, error])
+	return ret0
+}
+
+// ListPortableIpRangesIterWithContext indicates an expected call of ListPortableIpRangesIterWithContext.
+func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRangesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRangesIterWithContext", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRangesIterWithContext), varargs...)
+}
+
+// ListPortableIpRangesWithContext mocks base method.
+func (m *MockPortableIPServiceIface) ListPortableIpRangesWithContext(ctx context.Context, p *ListPortableIpRangesParams) (*ListPortableIpRangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPortableIpRangesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPortableIpRangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPortableIpRangesWithContext indicates an expected call of ListPortableIpRangesWithContext.
+func (mr *MockPortableIPServiceIfaceMockRecorder) ListPortableIpRangesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPortableIpRangesWithContext", reflect.TypeOf((*MockPortableIPServiceIface)(nil).ListPortableIpRangesWithContext), ctx, p)
+}
+
 // NewCreatePortableIpRangeParams mocks base method.
 func (m *MockPortableIPServiceIface) NewCreatePortableIpRangeParams(endip, gateway, netmask string, regionid int, startip string) *CreatePortableIpRangeParams {
 	m.ctrl.T.Helper()
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,48 +31,91 @@ import (
 
 type ProjectServiceIface interface {
 	ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error)
+	ActivateProjectAsync(ctx context.Context, p *ActivateProjectParams) (*Job, error)
 	NewActivateProjectParams(id string) *ActivateProjectParams
 	AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error)
+	AddAccountToProjectAsync(ctx context.Context, p *AddAccountToProjectParams) (*Job, error)
 	NewAddAccountToProjectParams(projectid string) *AddAccountToProjectParams
 	AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error)
+	AddUserToProjectWithContext(ctx context.Context, p *AddUserToProjectParams) (*AddUserToProjectResponse, error)
+	AddUserToProjectAsync(ctx context.Context, p *AddUserToProjectParams) (*Job, error)
 	NewAddUserToProjectParams(projectid string, username string) *AddUserToProjectParams
 	CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error)
+	CreateProjectAsync(ctx context.Context, p *CreateProjectParams) (*Job, error)
 	NewCreateProjectParams(displaytext string, name string) *CreateProjectParams
 	DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error)
+	DeleteAccountFromProjectAsync(ctx context.Context, p *DeleteAccountFromProjectParams) (*Job, error)
 	NewDeleteAccountFromProjectParams(account string, projectid string) *DeleteAccountFromProjectParams
 	DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error)
+	DeleteUserFromProjectWithContext(ctx context.Context, p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error)
+	DeleteUserFromProjectAsync(ctx context.Context, p *DeleteUserFromProjectParams) (*Job, error)
 	NewDeleteUserFromProjectParams(projectid string, userid string) *DeleteUserFromProjectParams
 	DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error)
+	DeleteProjectAsync(ctx context.Context, p *DeleteProjectParams) (*Job, error)
 	NewDeleteProjectParams(id string) *DeleteProjectParams
 	DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error)
+	DeleteProjectInvitationAsync(ctx context.Context, p *DeleteProjectInvitationParams) (*Job, error)
 	NewDeleteProjectInvitationParams(id string) *DeleteProjectInvitationParams
 	ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error)
+	ListProjectInvitationsAll(p *ListProjectInvitationsParams, opts ...PageOption) ([]*ProjectInvitation, error)
+	ListProjectInvitationsAllWithContext(ctx context.Context, p *ListProjectInvitationsParams, opts ...PageOption) ([]*ProjectInvitation, error)
+	ListProjectInvitationsIter(p *ListProjectInvitationsParams, opts ...PageOption) iter.Seq2[*ProjectInvitation, error]
+	ListProjectInvitationsIterWithContext(ctx context.Context, p *ListProjectInvitationsParams, opts ...PageOption) iter.Seq2[*ProjectInvitation, error]
 	NewListProjectInvitationsParams() *ListProjectInvitationsParams
 	GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
+	GetProjectInvitationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ProjectInvitation, int, error)
 	ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error)
+	ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error)
+	ListProjectsAll(p *ListProjectsParams, opts ...PageOption) ([]*Project, error)
+	ListProjectsAllWithContext(ctx context.Context, p *ListProjectsParams, opts ...PageOption) ([]*Project, error)
+	ListProjectsIter(p *ListProjectsParams, opts ...PageOption) iter.Seq2[*Project, error]
+	ListProjectsIterWithContext(ctx context.Context, p *ListProjectsParams, opts ...PageOption) iter.Seq2[*Project, error]
 	NewListProjectsParams() *ListProjectsParams
 	GetProjectID(name string, opts ...OptionFunc) (string, int, error)
+	GetProjectIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Project, int, error)
 	GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error)
+	GetProjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Project, int, error)
 	SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error)
+	SuspendProjectAsync(ctx context.Context, p *SuspendProjectParams) (*Job, error)
 	NewSuspendProjectParams(id string) *SuspendProjectParams
 	UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error)
+	UpdateProjectAsync(ctx context.Context, p *UpdateProjectParams) (*Job, error)
 	NewUpdateProjectParams(id string) *UpdateProjectParams
 	UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error)
+	UpdateProjectInvitationAsync(ctx context.Context, p *UpdateProjectInvitationParams) (*Job, error)
 	NewUpdateProjectInvitationParams(projectid string) *UpdateProjectInvitationParams
 	ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error)
+	ListProjectRolePermissionsWithContext(ctx context.Context, p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error)
 	NewListProjectRolePermissionsParams(projectid string) *ListProjectRolePermissionsParams
 	CreateProjectRolePermission(p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error)
+	CreateProjectRolePermissionWithContext(ctx context.Context, p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error)
 	NewCreateProjectRolePermissionParams(permission string, projectid string, projectroleid string, rule string) *CreateProjectRolePermissionParams
 	UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error)
+	UpdateProjectRolePermissionWithContext(ctx context.Context, p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error)
 	NewUpdateProjectRolePermissionParams(projectid string, projectroleid string) *UpdateProjectRolePermissionParams
 	DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error)
+	DeleteProjectRolePermissionWithContext(ctx context.Context, p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error)
 	NewDeleteProjectRolePermissionParams(id string, projectid string) *DeleteProjectRolePermissionParams
 	CreateProjectRole(p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error)
+	CreateProjectRoleWithContext(ctx context.Context, p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error)
 	NewCreateProjectRoleParams(name string, projectid string) *CreateProjectRoleParams
 	UpdateProjectRole(p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error)
+	UpdateProjectRoleWithContext(ctx context.Context, p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error)
 	NewUpdateProjectRoleParams(id string, projectid string) *UpdateProjectRoleParams
 	DeleteProjectRole(p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error)
+	DeleteProjectRoleWithContext(ctx context.Context, p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error)
 	NewDeleteProjectRoleParams(id string, projectid string) *DeleteProjectRoleParams
 }
 
@@ -89,6 +134,44 @@ func (p *ActivateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the activateProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ActivateProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("activateProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the activateProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ActivateProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("activateProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the activateProject API, before the request is signed
+func (p *ActivateProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ActivateProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ActivateProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("activateProject", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ActivateProjectParams) Clone() *ActivateProjectParams {
+	return &ActivateProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *ActivateProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -121,32 +204,39 @@ func (s *ProjectService) NewActivateProjectParams(id string) *ActivateProjectPar
 
 // Activates a project
 func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivateProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("activateProject", p.toURLValues())
+	return s.ActivateProjectWithContext(context.Background(), p)
+}
+
+// ActivateProjectWithContext is like ActivateProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "activateProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ActivateProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("activateProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "activateProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("activateProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -154,71 +244,96 @@ func (s *ProjectService) ActivateProject(p *ActivateProjectParams) (*ActivatePro
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ActivateProject async job into r
+func (r *ActivateProjectResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ActivateProjectAsync starts the async job of ActivateProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) ActivateProjectAsync(ctx context.Context, p *ActivateProjectParams) (*Job, error) {
+	r, err := s.ActivateProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("activateProject", r.JobID), nil
+}
+
 type ActivateProjectResponse struct {
-	Backupavailable           string              `json:"backupavailable"`
-	Backuplimit               string              `json:"backuplimit"`
-	Backupstorageavailable    string              `json:"backupstorageavailable"`
-	Backupstoragelimit        string              `json:"backupstoragelimit"`
-	Backupstoragetotal        int64               `json:"backupstoragetotal"`
-	Backuptotal               int64               `json:"backuptotal"`
-	Bucketavailable           string              `json:"bucketavailable"`
-	Bucketlimit               string              `json:"bucketlimit"`
-	Buckettotal               int64               `json:"buckettotal"`
-	Cpuavailable              string              `json:"cpuavailable"`
-	Cpulimit                  string              `json:"cpulimit"`
-	Cputotal                  int64               `json:"cputotal"`
-	Created                   string              `json:"created"`
-	Displaytext               string              `json:"displaytext"`
-	Domain                    string              `json:"domain"`
-	Domainid                  string              `json:"domainid"`
-	Gpuavailable              string              `json:"gpuavailable"`
-	Gpulimit                  string              `json:"gpulimit"`
-	Gputotal                  int64               `json:"gputotal"`
-	Icon                      interface{}         `json:"icon"`
-	Id                        string              `json:"id"`
-	Ipavailable               string              `json:"ipavailable"`
-	Iplimit                   string              `json:"iplimit"`
-	Iptotal                   int64               `json:"iptotal"`
-	JobID                     string              `json:"jobid"`
-	Jobstatus                 int                 `json:"jobstatus"`
-	Memoryavailable           string              `json:"memoryavailable"`
-	Memorylimit               string              `json:"memorylimit"`
-	Memorytotal               int64               `json:"memorytotal"`
-	Name                      string              `json:"name"`
-	Networkavailable          string              `json:"networkavailable"`
-	Networklimit              string              `json:"networklimit"`
-	Networktotal              int64               `json:"networktotal"`
-	Objectstorageavailable    string              `json:"objectstorageavailable"`
-	Objectstoragelimit        string              `json:"objectstoragelimit"`
-	Objectstoragetotal        int64               `json:"objectstoragetotal"`
-	Owner                     []map[string]string `json:"owner"`
-	Primarystorageavailable   string              `json:"primarystorageavailable"`
-	Primarystoragelimit       string              `json:"primarystoragelimit"`
-	Primarystoragetotal       int64               `json:"primarystoragetotal"`
-	Projectaccountname        string              `json:"projectaccountname"`
-	Secondarystorageavailable string              `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string              `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64             `json:"secondarystoragetotal"`
-	Snapshotavailable         string              `json:"snapshotavailable"`
-	Snapshotlimit             string              `json:"snapshotlimit"`
-	Snapshottotal             int64               `json:"snapshottotal"`
-	State                     string              `json:"state"`
-	Taggedresources           []string            `json:"taggedresources"`
-	Tags                      []Tags              `json:"tags"`
-	Templateavailable         string              `json:"templateavailable"`
-	Templatelimit             string              `json:"templatelimit"`
-	Templatetotal             int64               `json:"templatetotal"`
-	Vmavailable               string              `json:"vmavailable"`
-	Vmlimit                   string              `json:"vmlimit"`
-	Vmrunning                 int                 `json:"vmrunning"`
-	Vmstopped                 int                 `json:"vmstopped"`
-	Vmtotal                   int64               `json:"vmtotal"`
-	Volumeavailable           string              `json:"volumeavailable"`
-	Volumelimit               string              `json:"volumelimit"`
-	Volumetotal               int64               `json:"volumetotal"`
-	Vpcavailable              string              `json:"vpcavailable"`
-	Vpclimit                  string              `json:"vpclimit"`
-	Vpctotal                  int64               `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Displaytext               string                     `json:"displaytext"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Owner                     []map[string]string        `json:"owner"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectaccountname        string                     `json:"projectaccountname"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Tags                      []Tags                     `json:"tags"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ActivateProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias ActivateProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddAccountToProjectParams struct {
@@ -248,6 +363,48 @@ func (p *AddAccountToProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addAccountToProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddAccountToProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addAccountToProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addAccountToProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddAccountToProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid"},
+		lengths:  map[string]int{"account": 255, "email": 255, "projectid": 255, "projectroleid": 255, "roletype": 255},
+	}.validate("addAccountToProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addAccountToProject API, before the request is signed
+func (p *AddAccountToProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddAccountToProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddAccountToProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addAccountToProject", b, &p.p, map[string]paramDecoder{
+		"account":       decodeParam[string],
+		"email":         decodeParam[string],
+		"projectid":     decodeParam[string],
+		"projectroleid": decodeParam[string],
+		"roletype":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddAccountToProjectParams) Clone() *AddAccountToProjectParams {
+	return &AddAccountToProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *AddAccountToProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -364,27 +521,39 @@ func (s *ProjectService) NewAddAccountToProjectParams(projectid string) *AddAcco
 
 // Adds Account to a project
 func (s *ProjectService) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("addAccountToProject", p.toURLValues())
+	return s.AddAccountToProjectWithContext(context.Background(), p)
+}
+
+// AddAccountToProjectWithContext is like AddAccountToProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addAccountToProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddAccountToProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addAccountToProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addAccountToProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addAccountToProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -392,11 +561,31 @@ func (s *ProjectService) AddAccountToProject(p *AddAccountToProjectParams) (*Add
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddAccountToProject async job into r
+func (r *AddAccountToProjectResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AddAccountToProjectAsync starts the async job of AddAccountToProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) AddAccountToProjectAsync(ctx context.Context, p *AddAccountToProjectParams) (*Job, error) {
+	r, err := s.AddAccountToProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addAccountToProject", r.JobID), nil
+}
+
 type AddAccountToProjectResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddAccountToProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias AddAccountToProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddUserToProjectParams struct {
@@ -426,6 +615,48 @@ func (p *AddUserToProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addUserToProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddUserToProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addUserToProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addUserToProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddUserToProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid", "username"},
+		lengths:  map[string]int{"email": 255, "projectid": 255, "projectroleid": 255, "roletype": 255, "username": 255},
+	}.validate("addUserToProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addUserToProject API, before the request is signed
+func (p *AddUserToProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddUserToProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddUserToProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addUserToProject", b, &p.p, map[string]paramDecoder{
+		"email":         decodeParam[string],
+		"projectid":     decodeParam[string],
+		"projectroleid": decodeParam[string],
+		"roletype":      decodeParam[string],
+		"username":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddUserToProjectParams) Clone() *AddUserToProjectParams {
+	return &AddUserToProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *AddUserToProjectParams) SetEmail(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -543,27 +774,39 @@ func (s *ProjectService) NewAddUserToProjectParams(projectid string, username st
 
 // Adds User to a project
 func (s *ProjectService) AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("addUserToProject", p.toURLValues())
+	return s.AddUserToProjectWithContext(context.Background(), p)
+}
+
+// AddUserToProjectWithContext is like AddUserToProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) AddUserToProjectWithContext(ctx context.Context, p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addUserToProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddUserToProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addUserToProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addUserToProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addUserToProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -571,11 +814,31 @@ func (s *ProjectService) AddUserToProject(p *AddUserToProjectParams) (*AddUserTo
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddUserToProject async job into r
+func (r *AddUserToProjectResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AddUserToProjectAsync starts the async job of AddUserToProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) AddUserToProjectAsync(ctx context.Context, p *AddUserToProjectParams) (*Job, error) {
+	r, err := s.AddUserToProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addUserToProject", r.JobID), nil
+}
+
 type AddUserToProjectResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddUserToProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias AddUserToProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateProjectParams struct {
@@ -608,6 +871,49 @@ func (p *CreateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "name"},
+		lengths:  map[string]int{"account": 255, "accountid": 255, "displaytext": 4096, "domainid": 255, "name": 255, "userid": 255},
+	}.validate("createProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createProject API, before the request is signed
+func (p *CreateProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createProject", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"accountid":   decodeParam[string],
+		"displaytext": decodeParam[string],
+		"domainid":    decodeParam[string],
+		"name":        decodeParam[string],
+		"userid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateProjectParams) Clone() *CreateProjectParams {
+	return &CreateProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -746,32 +1052,39 @@ func (s *ProjectService) NewCreateProjectParams(displaytext string, name string)
 
 // Creates a project
 func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("createProject", p.toURLValues())
+	return s.CreateProjectWithContext(context.Background(), p)
+}
+
+// CreateProjectWithContext is like CreateProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -779,71 +1092,96 @@ func (s *ProjectService) CreateProject(p *CreateProjectParams) (*CreateProjectRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateProject async job into r
+func (r *CreateProjectResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateProjectAsync starts the async job of CreateProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) CreateProjectAsync(ctx context.Context, p *CreateProjectParams) (*Job, error) {
+	r, err := s.CreateProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createProject", r.JobID), nil
+}
+
 type CreateProjectResponse struct {
-	Backupavailable           string              `json:"backupavailable"`
-	Backuplimit               string              `json:"backuplimit"`
-	Backupstorageavailable    string              `json:"backupstorageavailable"`
-	Backupstoragelimit        string              `json:"backupstoragelimit"`
-	Backupstoragetotal        int64               `json:"backupstoragetotal"`
-	Backuptotal               int64               `json:"backuptotal"`
-	Bucketavailable           string              `json:"bucketavailable"`
-	Bucketlimit               string              `json:"bucketlimit"`
-	Buckettotal               int64               `json:"buckettotal"`
-	Cpuavailable              string              `json:"cpuavailable"`
-	Cpulimit                  string              `json:"cpulimit"`
-	Cputotal                  int64               `json:"cputotal"`
-	Created                   string              `json:"created"`
-	Displaytext               string              `json:"displaytext"`
-	Domain                    string              `json:"domain"`
-	Domainid                  string              `json:"domainid"`
-	Gpuavailable              string              `json:"gpuavailable"`
-	Gpulimit                  string              `json:"gpulimit"`
-	Gputotal                  int64               `json:"gputotal"`
-	Icon                      interface{}         `json:"icon"`
-	Id                        string              `json:"id"`
-	Ipavailable               string              `json:"ipavailable"`
-	Iplimit                   string              `json:"iplimit"`
-	Iptotal                   int64               `json:"iptotal"`
-	JobID                     string              `json:"jobid"`
-	Jobstatus                 int                 `json:"jobstatus"`
-	Memoryavailable           string              `json:"memoryavailable"`
-	Memorylimit               string              `json:"memorylimit"`
-	Memorytotal               int64               `json:"memorytotal"`
-	Name                      string              `json:"name"`
-	Networkavailable          string              `json:"networkavailable"`
-	Networklimit              string              `json:"networklimit"`
-	Networktotal              int64               `json:"networktotal"`
-	Objectstorageavailable    string              `json:"objectstorageavailable"`
-	Objectstoragelimit        string              `json:"objectstoragelimit"`
-	Objectstoragetotal        int64               `json:"objectstoragetotal"`
-	Owner                     []map[string]string `json:"owner"`
-	Primarystorageavailable   string              `json:"primarystorageavailable"`
-	Primarystoragelimit       string              `json:"primarystoragelimit"`
-	Primarystoragetotal       int64               `json:"primarystoragetotal"`
-	Projectaccountname        string              `json:"projectaccountname"`
-	Secondarystorageavailable string              `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string              `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64             `json:"secondarystoragetotal"`
-	Snapshotavailable         string              `json:"snapshotavailable"`
-	Snapshotlimit             string              `json:"snapshotlimit"`
-	Snapshottotal             int64               `json:"snapshottotal"`
-	State                     string              `json:"state"`
-	Taggedresources           []string            `json:"taggedresources"`
-	Tags                      []Tags              `json:"tags"`
-	Templateavailable         string              `json:"templateavailable"`
-	Templatelimit             string              `json:"templatelimit"`
-	Templatetotal             int64               `json:"templatetotal"`
-	Vmavailable               string              `json:"vmavailable"`
-	Vmlimit                   string              `json:"vmlimit"`
-	Vmrunning                 int                 `json:"vmrunning"`
-	Vmstopped                 int                 `json:"vmstopped"`
-	Vmtotal                   int64               `json:"vmtotal"`
-	Volumeavailable           string              `json:"volumeavailable"`
-	Volumelimit               string              `json:"volumelimit"`
-	Volumetotal               int64               `json:"volumetotal"`
-	Vpcavailable              string              `json:"vpcavailable"`
-	Vpclimit                  string              `json:"vpclimit"`
-	Vpctotal                  int64               `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Displaytext               string                     `json:"displaytext"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Owner                     []map[string]string        `json:"owner"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectaccountname        string                     `json:"projectaccountname"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Tags                      []Tags                     `json:"tags"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteAccountFromProjectParams struct {
@@ -864,6 +1202,45 @@ func (p *DeleteAccountFromProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteAccountFromProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteAccountFromProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteAccountFromProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteAccountFromProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteAccountFromProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "projectid"},
+		lengths:  map[string]int{"account": 255, "projectid": 255},
+	}.validate("deleteAccountFromProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteAccountFromProject API, before the request is signed
+func (p *DeleteAccountFromProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteAccountFromProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteAccountFromProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteAccountFromProject", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteAccountFromProjectParams) Clone() *DeleteAccountFromProjectParams {
+	return &DeleteAccountFromProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteAccountFromProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -918,27 +1295,39 @@ func (s *ProjectService) NewDeleteAccountFromProjectParams(account string, proje
 
 // Deletes Account from the project
 func (s *ProjectService) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteAccountFromProject", p.toURLValues())
+	return s.DeleteAccountFromProjectWithContext(context.Background(), p)
+}
+
+// DeleteAccountFromProjectWithContext is like DeleteAccountFromProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteAccountFromProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteAccountFromProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteAccountFromProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteAccountFromProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteAccountFromProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -946,11 +1335,31 @@ func (s *ProjectService) DeleteAccountFromProject(p *DeleteAccountFromProjectPar
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteAccountFromProject async job into r
+func (r *DeleteAccountFromProjectResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteAccountFromProjectAsync starts the async job of DeleteAccountFromProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) DeleteAccountFromProjectAsync(ctx context.Context, p *DeleteAccountFromProjectParams) (*Job, error) {
+	r, err := s.DeleteAccountFromProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteAccountFromProject", r.JobID), nil
+}
+
 type DeleteAccountFromProjectResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteAccountFromProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteAccountFromProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteUserFromProjectParams struct {
@@ -971,6 +1380,45 @@ func (p *DeleteUserFromProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteUserFromProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteUserFromProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteUserFromProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteUserFromProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteUserFromProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid", "userid"},
+		lengths:  map[string]int{"projectid": 255, "userid": 255},
+	}.validate("deleteUserFromProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteUserFromProject API, before the request is signed
+func (p *DeleteUserFromProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteUserFromProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteUserFromProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteUserFromProject", b, &p.p, map[string]paramDecoder{
+		"projectid": decodeParam[string],
+		"userid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteUserFromProjectParams) Clone() *DeleteUserFromProjectParams {
+	return &DeleteUserFromProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteUserFromProjectParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1025,27 +1473,39 @@ func (s *ProjectService) NewDeleteUserFromProjectParams(projectid string, userid
 
 // Deletes User from the project
 func (s *ProjectService) DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteUserFromProject", p.toURLValues())
+	return s.DeleteUserFromProjectWithContext(context.Background(), p)
+}
+
+// DeleteUserFromProjectWithContext is like DeleteUserFromProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) DeleteUserFromProjectWithContext(ctx context.Context, p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteUserFromProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteUserFromProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteUserFromProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteUserFromProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteUserFromProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1053,11 +1513,31 @@ func (s *ProjectService) DeleteUserFromProject(p *DeleteUserFromProjectParams) (
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteUserFromProject async job into r
+func (r *DeleteUserFromProjectResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteUserFromProjectAsync starts the async job of DeleteUserFromProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) DeleteUserFromProjectAsync(ctx context.Context, p *DeleteUserFromProjectParams) (*Job, error) {
+	r, err := s.DeleteUserFromProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteUserFromProject", r.JobID), nil
+}
+
 type DeleteUserFromProjectResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteUserFromProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteUserFromProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteProjectParams struct {
@@ -1079,6 +1559,45 @@ func (p *DeleteProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteProject API, before the request is signed
+func (p *DeleteProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteProject", b, &p.p, map[string]paramDecoder{
+		"cleanup": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteProjectParams) Clone() *DeleteProjectParams {
+	return &DeleteProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteProjectParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1132,27 +1651,39 @@ func (s *ProjectService) NewDeleteProjectParams(id string) *DeleteProjectParams
 
 // Deletes a project
 func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteProject", p.toURLValues())
+	return s.DeleteProjectWithContext(context.Background(), p)
+}
+
+// DeleteProjectWithContext is like DeleteProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1160,11 +1691,31 @@ func (s *ProjectService) DeleteProject(p *DeleteProjectParams) (*DeleteProjectRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteProject async job into r
+func (r *DeleteProjectResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteProjectAsync starts the async job of DeleteProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) DeleteProjectAsync(ctx context.Context, p *DeleteProjectParams) (*Job, error) {
+	r, err := s.DeleteProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteProject", r.JobID), nil
+}
+
 type DeleteProjectResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteProjectInvitationParams struct {
@@ -1182,6 +1733,44 @@ func (p *DeleteProjectInvitationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteProjectInvitation API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteProjectInvitationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteProjectInvitation", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteProjectInvitation API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteProjectInvitationParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteProjectInvitation", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteProjectInvitation API, before the request is signed
+func (p *DeleteProjectInvitationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteProjectInvitationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteProjectInvitationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteProjectInvitation", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteProjectInvitationParams) Clone() *DeleteProjectInvitationParams {
+	return &DeleteProjectInvitationParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteProjectInvitationParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1214,27 +1803,39 @@ func (s *ProjectService) NewDeleteProjectInvitationParams(id string) *DeleteProj
 
 // Deletes project invitation
 func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteProjectInvitation", p.toURLValues())
+	return s.DeleteProjectInvitationWithContext(context.Background(), p)
+}
+
+// DeleteProjectInvitationWithContext is like DeleteProjectInvitation, but honours the cancellation and deadline of ctx
+func (s *ProjectService) DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteProjectInvitation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteProjectInvitationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteProjectInvitation", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteProjectInvitation", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteProjectInvitation", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1242,11 +1843,31 @@ func (s *ProjectService) DeleteProjectInvitation(p *DeleteProjectInvitationParam
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteProjectInvitation async job into r
+func (r *DeleteProjectInvitationResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteProjectInvitationAsync starts the async job of DeleteProjectInvitation without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) DeleteProjectInvitationAsync(ctx context.Context, p *DeleteProjectInvitationParams) (*Job, error) {
+	r, err := s.DeleteProjectInvitationWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteProjectInvitation", r.JobID), nil
+}
+
 type DeleteProjectInvitationResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteProjectInvitationResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteProjectInvitationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListProjectInvitationsParams struct {
@@ -1302,6 +1923,54 @@ func (p *ListProjectInvitationsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listProjectInvitations API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListProjectInvitationsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listProjectInvitations", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listProjectInvitations API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListProjectInvitationsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "state": 255, "userid": 255},
+	}.validate("listProjectInvitations", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listProjectInvitations API, before the request is signed
+func (p *ListProjectInvitationsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListProjectInvitationsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListProjectInvitationsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listProjectInvitations", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"activeonly":  decodeParam[bool],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"state":       decodeParam[string],
+		"userid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListProjectInvitationsParams) Clone() *ListProjectInvitationsParams {
+	return &ListProjectInvitationsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListProjectInvitationsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1564,6 +2233,11 @@ func (s *ProjectService) NewListProjectInvitationsParams() *ListProjectInvitatio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
+	return s.GetProjectInvitationByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetProjectInvitationByIDWithContext is like GetProjectInvitationByID, but honours the cancellation and deadline of ctx
+func (s *ProjectService) GetProjectInvitationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
 	p := &ListProjectInvitationsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1575,11 +2249,10 @@ func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListProjectInvitations(p)
+	l, err := s.ListProjectInvitationsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1597,37 +2270,80 @@ func (s *ProjectService) GetProjectInvitationByID(id string, opts ...OptionFunc)
 
 // Lists project invitations and provides detailed information for listed invitations
 func (s *ProjectService) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectInvitations", p.toURLValues())
+	return s.ListProjectInvitationsWithContext(context.Background(), p)
+}
+
+// ListProjectInvitationsWithContext is like ListProjectInvitations, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listProjectInvitations", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectInvitationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listProjectInvitations", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListProjectInvitationsAll returns all ProjectInvitations matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ProjectService) ListProjectInvitationsAll(p *ListProjectInvitationsParams, opts ...PageOption) ([]*ProjectInvitation, error) {
+	return s.ListProjectInvitationsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectInvitationsAllWithContext is like ListProjectInvitationsAll, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ListProjectInvitationsAllWithContext(ctx context.Context, p *ListProjectInvitationsParams, opts ...PageOption) ([]*ProjectInvitation, error) {
+	return collectPages(s.ListProjectInvitationsIterWithContext(ctx, p, opts...))
+}
+
+// ListProjectInvitationsIter returns an iterator over all ProjectInvitations matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ProjectService) ListProjectInvitationsIter(p *ListProjectInvitationsParams, opts ...PageOption) iter.Seq2[*ProjectInvitation, error] {
+	return s.ListProjectInvitationsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectInvitationsIterWithContext is like ListProjectInvitationsIter, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ListProjectInvitationsIterWithContext(ctx context.Context, p *ListProjectInvitationsParams, opts ...PageOption) iter.Seq2[*ProjectInvitation, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ProjectInvitation, int, error) {
+		l, err := s.ListProjectInvitationsWithContext(ctx, &ListProjectInvitationsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ProjectInvitations, l.Count, nil
+	}, opts...)
+}
+
 type ListProjectInvitationsResponse struct {
 	Count              int                  `json:"count"`
 	ProjectInvitations []*ProjectInvitation `json:"projectinvitation"`
 }
 
 type ProjectInvitation struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Email      string `json:"email"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
-	State      string `json:"state"`
-	Userid     string `json:"userid"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Email      string                     `json:"email"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	State      string                     `json:"state"`
+	Userid     string                     `json:"userid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ProjectInvitation) UnmarshalJSON(b []byte) error {
+	type alias ProjectInvitation
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListProjectsParams struct {
@@ -1697,6 +2413,57 @@ func (p *ListProjectsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listProjects API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListProjectsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listProjects", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listProjects API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListProjectsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "displaytext": 4096, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "state": 255, "username": 255},
+	}.validate("listProjects", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listProjects API, before the request is signed
+func (p *ListProjectsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListProjectsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListProjectsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listProjects", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"details":     decodeParam[[]string],
+		"displaytext": decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"showicon":    decodeParam[bool],
+		"state":       decodeParam[string],
+		"tags":        decodeParam[map[string]string],
+		"username":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListProjectsParams) Clone() *ListProjectsParams {
+	return &ListProjectsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListProjectsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2022,6 +2789,11 @@ func (s *ProjectService) NewListProjectsParams() *ListProjectsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetProjectIDWithContext(context.Background(), name, opts...)
+}
+
+// GetProjectIDWithContext is like GetProjectID, but honours the cancellation and deadline of ctx
+func (s *ProjectService) GetProjectIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2033,7 +2805,7 @@ func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListProjects(p)
+	l, err := s.ListProjectsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2058,12 +2830,17 @@ func (s *ProjectService) GetProjectID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error) {
-	id, count, err := s.GetProjectID(name, opts...)
+	return s.GetProjectByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetProjectByNameWithContext is like GetProjectByName, but honours the cancellation and deadline of ctx
+func (s *ProjectService) GetProjectByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Project, int, error) {
+	id, count, err := s.GetProjectIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetProjectByID(id, opts...)
+	r, count, err := s.GetProjectByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2072,6 +2849,11 @@ func (s *ProjectService) GetProjectByName(name string, opts ...OptionFunc) (*Pro
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error) {
+	return s.GetProjectByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetProjectByIDWithContext is like GetProjectByID, but honours the cancellation and deadline of ctx
+func (s *ProjectService) GetProjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Project, int, error) {
 	p := &ListProjectsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2083,11 +2865,10 @@ func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project
 		}
 	}
 
-	l, err := s.ListProjects(p)
+	l, err := s.ListProjectsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2105,89 +2886,132 @@ func (s *ProjectService) GetProjectByID(id string, opts ...OptionFunc) (*Project
 
 // Lists projects and provides detailed information for listed projects
 func (s *ProjectService) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
-	resp, err := s.cs.newRequest("listProjects", p.toURLValues())
+	return s.ListProjectsWithContext(context.Background(), p)
+}
+
+// ListProjectsWithContext is like ListProjects, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listProjects", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listProjects", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListProjectsAll returns all Projects matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ProjectService) ListProjectsAll(p *ListProjectsParams, opts ...PageOption) ([]*Project, error) {
+	return s.ListProjectsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectsAllWithContext is like ListProjectsAll, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ListProjectsAllWithContext(ctx context.Context, p *ListProjectsParams, opts ...PageOption) ([]*Project, error) {
+	return collectPages(s.ListProjectsIterWithContext(ctx, p, opts...))
+}
+
+// ListProjectsIter returns an iterator over all Projects matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ProjectService) ListProjectsIter(p *ListProjectsParams, opts ...PageOption) iter.Seq2[*Project, error] {
+	return s.ListProjectsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectsIterWithContext is like ListProjectsIter, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ListProjectsIterWithContext(ctx context.Context, p *ListProjectsParams, opts ...PageOption) iter.Seq2[*Project, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Project, int, error) {
+		l, err := s.ListProjectsWithContext(ctx, &ListProjectsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Projects, l.Count, nil
+	}, opts...)
+}
+
 type ListProjectsResponse struct {
 	Count    int        `json:"count"`
 	Projects []*Project `json:"project"`
 }
 
 type Project struct {
-	Backupavailable           string              `json:"backupavailable"`
-	Backuplimit               string              `json:"backuplimit"`
-	Backupstorageavailable    string              `json:"backupstorageavailable"`
-	Backupstoragelimit        string              `json:"backupstoragelimit"`
-	Backupstoragetotal        int64               `json:"backupstoragetotal"`
-	Backuptotal               int64               `json:"backuptotal"`
-	Bucketavailable           string              `json:"bucketavailable"`
-	Bucketlimit               string              `json:"bucketlimit"`
-	Buckettotal               int64               `json:"buckettotal"`
-	Cpuavailable              string              `json:"cpuavailable"`
-	Cpulimit                  string              `json:"cpulimit"`
-	Cputotal                  int64               `json:"cputotal"`
-	Created                   string              `json:"created"`
-	Displaytext               string              `json:"displaytext"`
-	Domain                    string              `json:"domain"`
-	Domainid                  string              `json:"domainid"`
-	Gpuavailable              string              `json:"gpuavailable"`
-	Gpulimit                  string              `json:"gpulimit"`
-	Gputotal                  int64               `json:"gputotal"`
-	Icon                      interface{}         `json:"icon"`
-	Id                        string              `json:"id"`
-	Ipavailable               string              `json:"ipavailable"`
-	Iplimit                   string              `json:"iplimit"`
-	Iptotal                   int64               `json:"iptotal"`
-	JobID                     string              `json:"jobid"`
-	Jobstatus                 int                 `json:"jobstatus"`
-	Memoryavailable           string              `json:"memoryavailable"`
-	Memorylimit               string              `json:"memorylimit"`
-	Memorytotal               int64               `json:"memorytotal"`
-	Name                      string              `json:"name"`
-	Networkavailable          string              `json:"networkavailable"`
-	Networklimit              string              `json:"networklimit"`
-	Networktotal              int64               `json:"networktotal"`
-	Objectstorageavailable    string              `json:"objectstorageavailable"`
-	Objectstoragelimit        string              `json:"objectstoragelimit"`
-	Objectstoragetotal        int64               `json:"objectstoragetotal"`
-	Owner                     []map[string]string `json:"owner"`
-	Primarystorageavailable   string              `json:"primarystorageavailable"`
-	Primarystoragelimit       string              `json:"primarystoragelimit"`
-	Primarystoragetotal       int64               `json:"primarystoragetotal"`
-	Projectaccountname        string              `json:"projectaccountname"`
-	Secondarystorageavailable string              `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string              `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64             `json:"secondarystoragetotal"`
-	Snapshotavailable         string              `json:"snapshotavailable"`
-	Snapshotlimit             string              `json:"snapshotlimit"`
-	Snapshottotal             int64               `json:"snapshottotal"`
-	State                     string              `json:"state"`
-	Taggedresources           []string            `json:"taggedresources"`
-	Tags                      []Tags              `json:"tags"`
-	Templateavailable         string              `json:"templateavailable"`
-	Templatelimit             string              `json:"templatelimit"`
-	Templatetotal             int64               `json:"templatetotal"`
-	Vmavailable               string              `json:"vmavailable"`
-	Vmlimit                   string              `json:"vmlimit"`
-	Vmrunning                 int                 `json:"vmrunning"`
-	Vmstopped                 int                 `json:"vmstopped"`
-	Vmtotal                   int64               `json:"vmtotal"`
-	Volumeavailable           string              `json:"volumeavailable"`
-	Volumelimit               string              `json:"volumelimit"`
-	Volumetotal               int64               `json:"volumetotal"`
-	Vpcavailable              string              `json:"vpcavailable"`
-	Vpclimit                  string              `json:"vpclimit"`
-	Vpctotal                  int64               `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Displaytext               string                     `json:"displaytext"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Owner                     []map[string]string        `json:"owner"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectaccountname        string                     `json:"projectaccountname"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Tags                      []Tags                     `json:"tags"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Project) UnmarshalJSON(b []byte) error {
+	type alias Project
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SuspendProjectParams struct {
@@ -2205,6 +3029,44 @@ func (p *SuspendProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the suspendProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *SuspendProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("suspendProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the suspendProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *SuspendProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("suspendProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the suspendProject API, before the request is signed
+func (p *SuspendProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *SuspendProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *SuspendProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("suspendProject", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *SuspendProjectParams) Clone() *SuspendProjectParams {
+	return &SuspendProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *SuspendProjectParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2237,32 +3099,39 @@ func (s *ProjectService) NewSuspendProjectParams(id string) *SuspendProjectParam
 
 // Suspends a project
 func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("suspendProject", p.toURLValues())
+	return s.SuspendProjectWithContext(context.Background(), p)
+}
+
+// SuspendProjectWithContext is like SuspendProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "suspendProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r SuspendProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("suspendProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "suspendProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("suspendProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2270,71 +3139,96 @@ func (s *ProjectService) SuspendProject(p *SuspendProjectParams) (*SuspendProjec
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished SuspendProject async job into r
+func (r *SuspendProjectResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// SuspendProjectAsync starts the async job of SuspendProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) SuspendProjectAsync(ctx context.Context, p *SuspendProjectParams) (*Job, error) {
+	r, err := s.SuspendProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("suspendProject", r.JobID), nil
+}
+
 type SuspendProjectResponse struct {
-	Backupavailable           string              `json:"backupavailable"`
-	Backuplimit               string              `json:"backuplimit"`
-	Backupstorageavailable    string              `json:"backupstorageavailable"`
-	Backupstoragelimit        string              `json:"backupstoragelimit"`
-	Backupstoragetotal        int64               `json:"backupstoragetotal"`
-	Backuptotal               int64               `json:"backuptotal"`
-	Bucketavailable           string              `json:"bucketavailable"`
-	Bucketlimit               string              `json:"bucketlimit"`
-	Buckettotal               int64               `json:"buckettotal"`
-	Cpuavailable              string              `json:"cpuavailable"`
-	Cpulimit                  string              `json:"cpulimit"`
-	Cputotal                  int64               `json:"cputotal"`
-	Created                   string              `json:"created"`
-	Displaytext               string              `json:"displaytext"`
-	Domain                    string              `json:"domain"`
-	Domainid                  string              `json:"domainid"`
-	Gpuavailable              string              `json:"gpuavailable"`
-	Gpulimit                  string              `json:"gpulimit"`
-	Gputotal                  int64               `json:"gputotal"`
-	Icon                      interface{}         `json:"icon"`
-	Id                        string              `json:"id"`
-	Ipavailable               string              `json:"ipavailable"`
-	Iplimit                   string              `json:"iplimit"`
-	Iptotal                   int64               `json:"iptotal"`
-	JobID                     string              `json:"jobid"`
-	Jobstatus                 int                 `json:"jobstatus"`
-	Memoryavailable           string              `json:"memoryavailable"`
-	Memorylimit               string              `json:"memorylimit"`
-	Memorytotal               int64               `json:"memorytotal"`
-	Name                      string              `json:"name"`
-	Networkavailable          string              `json:"networkavailable"`
-	Networklimit              string              `json:"networklimit"`
-	Networktotal              int64               `json:"networktotal"`
-	Objectstorageavailable    string              `json:"objectstorageavailable"`
-	Objectstoragelimit        string              `json:"objectstoragelimit"`
-	Objectstoragetotal        int64               `json:"objectstoragetotal"`
-	Owner                     []map[string]string `json:"owner"`
-	Primarystorageavailable   string              `json:"primarystorageavailable"`
-	Primarystoragelimit       string              `json:"primarystoragelimit"`
-	Primarystoragetotal       int64               `json:"primarystoragetotal"`
-	Projectaccountname        string              `json:"projectaccountname"`
-	Secondarystorageavailable string              `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string              `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64             `json:"secondarystoragetotal"`
-	Snapshotavailable         string              `json:"snapshotavailable"`
-	Snapshotlimit             string              `json:"snapshotlimit"`
-	Snapshottotal             int64               `json:"snapshottotal"`
-	State                     string              `json:"state"`
-	Taggedresources           []string            `json:"taggedresources"`
-	Tags                      []Tags              `json:"tags"`
-	Templateavailable         string              `json:"templateavailable"`
-	Templatelimit             string              `json:"templatelimit"`
-	Templatetotal             int64               `json:"templatetotal"`
-	Vmavailable               string              `json:"vmavailable"`
-	Vmlimit                   string              `json:"vmlimit"`
-	Vmrunning                 int                 `json:"vmrunning"`
-	Vmstopped                 int                 `json:"vmstopped"`
-	Vmtotal                   int64               `json:"vmtotal"`
-	Volumeavailable           string              `json:"volumeavailable"`
-	Volumelimit               string              `json:"volumelimit"`
-	Volumetotal               int64               `json:"volumetotal"`
-	Vpcavailable              string              `json:"vpcavailable"`
-	Vpclimit                  string              `json:"vpclimit"`
-	Vpctotal                  int64               `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Displaytext               string                     `json:"displaytext"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Owner                     []map[string]string        `json:"owner"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectaccountname        string                     `json:"projectaccountname"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Tags                      []Tags                     `json:"tags"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SuspendProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias SuspendProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateProjectParams struct {
@@ -2371,6 +3265,50 @@ func (p *UpdateProjectParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateProject API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateProjectParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateProject", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateProject API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateProjectParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "displaytext": 4096, "id": 255, "name": 255, "roletype": 255, "userid": 255},
+	}.validate("updateProject", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateProject API, before the request is signed
+func (p *UpdateProjectParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateProjectParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateProjectParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateProject", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"displaytext": decodeParam[string],
+		"id":          decodeParam[string],
+		"name":        decodeParam[string],
+		"roletype":    decodeParam[string],
+		"swapowner":   decodeParam[bool],
+		"userid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateProjectParams) Clone() *UpdateProjectParams {
+	return &UpdateProjectParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateProjectParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2529,32 +3467,39 @@ func (s *ProjectService) NewUpdateProjectParams(id string) *UpdateProjectParams
 
 // Updates a project
 func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
-	resp, err := s.cs.newPostRequest("updateProject", p.toURLValues())
+	return s.UpdateProjectWithContext(context.Background(), p)
+}
+
+// UpdateProjectWithContext is like UpdateProject, but honours the cancellation and deadline of ctx
+func (s *ProjectService) UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateProject", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateProjectResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateProject", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateProject", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateProject", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2562,71 +3507,96 @@ func (s *ProjectService) UpdateProject(p *UpdateProjectParams) (*UpdateProjectRe
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateProject async job into r
+func (r *UpdateProjectResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateProjectAsync starts the async job of UpdateProject without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) UpdateProjectAsync(ctx context.Context, p *UpdateProjectParams) (*Job, error) {
+	r, err := s.UpdateProjectWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateProject", r.JobID), nil
+}
+
 type UpdateProjectResponse struct {
-	Backupavailable           string              `json:"backupavailable"`
-	Backuplimit               string              `json:"backuplimit"`
-	Backupstorageavailable    string              `json:"backupstorageavailable"`
-	Backupstoragelimit        string              `json:"backupstoragelimit"`
-	Backupstoragetotal        int64               `json:"backupstoragetotal"`
-	Backuptotal               int64               `json:"backuptotal"`
-	Bucketavailable           string              `json:"bucketavailable"`
-	Bucketlimit               string              `json:"bucketlimit"`
-	Buckettotal               int64               `json:"buckettotal"`
-	Cpuavailable              string              `json:"cpuavailable"`
-	Cpulimit                  string              `json:"cpulimit"`
-	Cputotal                  int64               `json:"cputotal"`
-	Created                   string              `json:"created"`
-	Displaytext               string              `json:"displaytext"`
-	Domain                    string              `json:"domain"`
-	Domainid                  string              `json:"domainid"`
-	Gpuavailable              string              `json:"gpuavailable"`
-	Gpulimit                  string              `json:"gpulimit"`
-	Gputotal                  int64               `json:"gputotal"`
-	Icon                      interface{}         `json:"icon"`
-	Id                        string              `json:"id"`
-	Ipavailable               string              `json:"ipavailable"`
-	Iplimit                   string              `json:"iplimit"`
-	Iptotal                   int64               `json:"iptotal"`
-	JobID                     string              `json:"jobid"`
-	Jobstatus                 int                 `json:"jobstatus"`
-	Memoryavailable           string              `json:"memoryavailable"`
-	Memorylimit               string              `json:"memorylimit"`
-	Memorytotal               int64               `json:"memorytotal"`
-	Name                      string              `json:"name"`
-	Networkavailable          string              `json:"networkavailable"`
-	Networklimit              string              `json:"networklimit"`
-	Networktotal              int64               `json:"networktotal"`
-	Objectstorageavailable    string              `json:"objectstorageavailable"`
-	Objectstoragelimit        string              `json:"objectstoragelimit"`
-	Objectstoragetotal        int64               `json:"objectstoragetotal"`
-	Owner                     []map[string]string `json:"owner"`
-	Primarystorageavailable   string              `json:"primarystorageavailable"`
-	Primarystoragelimit       string              `json:"primarystoragelimit"`
-	Primarystoragetotal       int64               `json:"primarystoragetotal"`
-	Projectaccountname        string              `json:"projectaccountname"`
-	Secondarystorageavailable string              `json:"secondarystorageavailable"`
-	Secondarystoragelimit     string              `json:"secondarystoragelimit"`
-	Secondarystoragetotal     float64             `json:"secondarystoragetotal"`
-	Snapshotavailable         string              `json:"snapshotavailable"`
-	Snapshotlimit             string              `json:"snapshotlimit"`
-	Snapshottotal             int64               `json:"snapshottotal"`
-	State                     string              `json:"state"`
-	Taggedresources           []string            `json:"taggedresources"`
-	Tags                      []Tags              `json:"tags"`
-	Templateavailable         string              `json:"templateavailable"`
-	Templatelimit             string              `json:"templatelimit"`
-	Templatetotal             int64               `json:"templatetotal"`
-	Vmavailable               string              `json:"vmavailable"`
-	Vmlimit                   string              `json:"vmlimit"`
-	Vmrunning                 int                 `json:"vmrunning"`
-	Vmstopped                 int                 `json:"vmstopped"`
-	Vmtotal                   int64               `json:"vmtotal"`
-	Volumeavailable           string              `json:"volumeavailable"`
-	Volumelimit               string              `json:"volumelimit"`
-	Volumetotal               int64               `json:"volumetotal"`
-	Vpcavailable              string              `json:"vpcavailable"`
-	Vpclimit                  string              `json:"vpclimit"`
-	Vpctotal                  int64               `json:"vpctotal"`
+	Backupavailable           string                     `json:"backupavailable"`
+	Backuplimit               string                     `json:"backuplimit"`
+	Backupstorageavailable    string                     `json:"backupstorageavailable"`
+	Backupstoragelimit        string                     `json:"backupstoragelimit"`
+	Backupstoragetotal        FlexInt64                  `json:"backupstoragetotal"`
+	Backuptotal               FlexInt64                  `json:"backuptotal"`
+	Bucketavailable           string                     `json:"bucketavailable"`
+	Bucketlimit               string                     `json:"bucketlimit"`
+	Buckettotal               FlexInt64                  `json:"buckettotal"`
+	Cpuavailable              string                     `json:"cpuavailable"`
+	Cpulimit                  string                     `json:"cpulimit"`
+	Cputotal                  FlexInt64                  `json:"cputotal"`
+	Created                   Time                       `json:"created"`
+	Displaytext               string                     `json:"displaytext"`
+	Domain                    string                     `json:"domain"`
+	Domainid                  string                     `json:"domainid"`
+	Gpuavailable              string                     `json:"gpuavailable"`
+	Gpulimit                  string                     `json:"gpulimit"`
+	Gputotal                  FlexInt64                  `json:"gputotal"`
+	Icon                      interface{}                `json:"icon"`
+	Id                        string                     `json:"id"`
+	Ipavailable               string                     `json:"ipavailable"`
+	Iplimit                   string                     `json:"iplimit"`
+	Iptotal                   FlexInt64                  `json:"iptotal"`
+	JobID                     string                     `json:"jobid"`
+	Jobstatus                 FlexInt                    `json:"jobstatus"`
+	Memoryavailable           string                     `json:"memoryavailable"`
+	Memorylimit               string                     `json:"memorylimit"`
+	Memorytotal               FlexInt64                  `json:"memorytotal"`
+	Name                      string                     `json:"name"`
+	Networkavailable          string                     `json:"networkavailable"`
+	Networklimit              string                     `json:"networklimit"`
+	Networktotal              FlexInt64                  `json:"networktotal"`
+	Objectstorageavailable    string                     `json:"objectstorageavailable"`
+	Objectstoragelimit        string                     `json:"objectstoragelimit"`
+	Objectstoragetotal        FlexInt64                  `json:"objectstoragetotal"`
+	Owner                     []map[string]string        `json:"owner"`
+	Primarystorageavailable   string                     `json:"primarystorageavailable"`
+	Primarystoragelimit       string                     `json:"primarystoragelimit"`
+	Primarystoragetotal       FlexInt64                  `json:"primarystoragetotal"`
+	Projectaccountname        string                     `json:"projectaccountname"`
+	Secondarystorageavailable string                     `json:"secondarystorageavailable"`
+	Secondarystoragelimit     string                     `json:"secondarystoragelimit"`
+	Secondarystoragetotal     FlexFloat                  `json:"secondarystoragetotal"`
+	Snapshotavailable         string                     `json:"snapshotavailable"`
+	Snapshotlimit             string                     `json:"snapshotlimit"`
+	Snapshottotal             FlexInt64                  `json:"snapshottotal"`
+	State                     string                     `json:"state"`
+	Taggedresources           []string                   `json:"taggedresources"`
+	Tags                      []Tags                     `json:"tags"`
+	Templateavailable         string                     `json:"templateavailable"`
+	Templatelimit             string                     `json:"templatelimit"`
+	Templatetotal             FlexInt64                  `json:"templatetotal"`
+	Vmavailable               string                     `json:"vmavailable"`
+	Vmlimit                   string                     `json:"vmlimit"`
+	Vmrunning                 FlexInt                    `json:"vmrunning"`
+	Vmstopped                 FlexInt                    `json:"vmstopped"`
+	Vmtotal                   FlexInt64                  `json:"vmtotal"`
+	Volumeavailable           string                     `json:"volumeavailable"`
+	Volumelimit               string                     `json:"volumelimit"`
+	Volumetotal               FlexInt64                  `json:"volumetotal"`
+	Vpcavailable              string                     `json:"vpcavailable"`
+	Vpclimit                  string                     `json:"vpclimit"`
+	Vpctotal                  FlexInt64                  `json:"vpctotal"`
+	Extra                     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateProjectResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateProjectResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateProjectInvitationParams struct {
@@ -2657,6 +3627,48 @@ func (p *UpdateProjectInvitationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateProjectInvitation API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateProjectInvitationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateProjectInvitation", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateProjectInvitation API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateProjectInvitationParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid"},
+		lengths:  map[string]int{"account": 255, "projectid": 255, "token": 255, "userid": 255},
+	}.validate("updateProjectInvitation", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateProjectInvitation API, before the request is signed
+func (p *UpdateProjectInvitationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateProjectInvitationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateProjectInvitationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateProjectInvitation", b, &p.p, map[string]paramDecoder{
+		"accept":    decodeParam[bool],
+		"account":   decodeParam[string],
+		"projectid": decodeParam[string],
+		"token":     decodeParam[string],
+		"userid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateProjectInvitationParams) Clone() *UpdateProjectInvitationParams {
+	return &UpdateProjectInvitationParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateProjectInvitationParams) SetAccept(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2773,27 +3785,39 @@ func (s *ProjectService) NewUpdateProjectInvitationParams(projectid string) *Upd
 
 // Accepts or declines project invitation
 func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
-	resp, err := s.cs.newPostRequest("updateProjectInvitation", p.toURLValues())
+	return s.UpdateProjectInvitationWithContext(context.Background(), p)
+}
+
+// UpdateProjectInvitationWithContext is like UpdateProjectInvitation, but honours the cancellation and deadline of ctx
+func (s *ProjectService) UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateProjectInvitation", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateProjectInvitationResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateProjectInvitation", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateProjectInvitation", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateProjectInvitation", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2801,11 +3825,31 @@ func (s *ProjectService) UpdateProjectInvitation(p *UpdateProjectInvitationParam
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateProjectInvitation async job into r
+func (r *UpdateProjectInvitationResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// UpdateProjectInvitationAsync starts the async job of UpdateProjectInvitation without waiting for it to finish, and returns a handle to the job
+func (s *ProjectService) UpdateProjectInvitationAsync(ctx context.Context, p *UpdateProjectInvitationParams) (*Job, error) {
+	r, err := s.UpdateProjectInvitationWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateProjectInvitation", r.JobID), nil
+}
+
 type UpdateProjectInvitationResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateProjectInvitationResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateProjectInvitationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListProjectRolePermissionsParams struct {
@@ -2826,6 +3870,45 @@ func (p *ListProjectRolePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listProjectRolePermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListProjectRolePermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listProjectRolePermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listProjectRolePermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListProjectRolePermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid"},
+		lengths:  map[string]int{"projectid": 255, "projectroleid": 255},
+	}.validate("listProjectRolePermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listProjectRolePermissions API, before the request is signed
+func (p *ListProjectRolePermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListProjectRolePermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListProjectRolePermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listProjectRolePermissions", b, &p.p, map[string]paramDecoder{
+		"projectid":     decodeParam[string],
+		"projectroleid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListProjectRolePermissionsParams) Clone() *ListProjectRolePermissionsParams {
+	return &ListProjectRolePermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListProjectRolePermissionsParams) SetProjectid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2879,13 +3962,22 @@ func (s *ProjectService) NewListProjectRolePermissionsParams(projectid string) *
 
 // Lists a project's project role permissions
 func (s *ProjectService) ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listProjectRolePermissions", p.toURLValues())
+	return s.ListProjectRolePermissionsWithContext(context.Background(), p)
+}
+
+// ListProjectRolePermissionsWithContext is like ListProjectRolePermissions, but honours the cancellation and deadline of ctx
+func (s *ProjectService) ListProjectRolePermissionsWithContext(ctx context.Context, p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listProjectRolePermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectRolePermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listProjectRolePermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2898,15 +3990,21 @@ type ListProjectRolePermissionsResponse struct {
 }
 
 type ProjectRolePermission struct {
-	Description     string `json:"description"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Permission      string `json:"permission"`
-	Projectid       string `json:"projectid"`
-	Projectroleid   string `json:"projectroleid"`
-	Projectrolename string `json:"projectrolename"`
-	Rule            string `json:"rule"`
+	Description     string                     `json:"description"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Permission      string                     `json:"permission"`
+	Projectid       string                     `json:"projectid"`
+	Projectroleid   string                     `json:"projectroleid"`
+	Projectrolename string                     `json:"projectrolename"`
+	Rule            string                     `json:"rule"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ProjectRolePermission) UnmarshalJSON(b []byte) error {
+	type alias ProjectRolePermission
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateProjectRolePermissionParams struct {
@@ -2936,6 +4034,48 @@ func (p *CreateProjectRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createProjectRolePermission API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateProjectRolePermissionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createProjectRolePermission", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createProjectRolePermission API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateProjectRolePermissionParams) Validate() error {
+	return paramRules{
+		required: []string{"permission", "projectid", "projectroleid", "rule"},
+		lengths:  map[string]int{"description": 4096, "permission": 255, "projectid": 255, "projectroleid": 255, "rule": 255},
+	}.validate("createProjectRolePermission", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createProjectRolePermission API, before the request is signed
+func (p *CreateProjectRolePermissionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createProjectRolePermission", b, &p.p, map[string]paramDecoder{
+		"description":   decodeParam[string],
+		"permission":    decodeParam[string],
+		"projectid":     decodeParam[string],
+		"projectroleid": decodeParam[string],
+		"rule":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateProjectRolePermissionParams) Clone() *CreateProjectRolePermissionParams {
+	return &CreateProjectRolePermissionParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateProjectRolePermissionParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3055,13 +4195,22 @@ func (s *ProjectService) NewCreateProjectRolePermissionParams(permission string,
 
 // Adds API permissions to a project role
 func (s *ProjectService) CreateProjectRolePermission(p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error) {
-	resp, err := s.cs.newPostRequest("createProjectRolePermission", p.toURLValues())
+	return s.CreateProjectRolePermissionWithContext(context.Background(), p)
+}
+
+// CreateProjectRolePermissionWithContext is like CreateProjectRolePermission, but honours the cancellation and deadline of ctx
+func (s *ProjectService) CreateProjectRolePermissionWithContext(ctx context.Context, p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createProjectRolePermission", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateProjectRolePermissionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createProjectRolePermission", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3069,15 +4218,21 @@ func (s *ProjectService) CreateProjectRolePermission(p *CreateProjectRolePermiss
 }
 
 type CreateProjectRolePermissionResponse struct {
-	Description     string `json:"description"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Permission      string `json:"permission"`
-	Projectid       string `json:"projectid"`
-	Projectroleid   string `json:"projectroleid"`
-	Projectrolename string `json:"projectrolename"`
-	Rule            string `json:"rule"`
+	Description     string                     `json:"description"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Permission      string                     `json:"permission"`
+	Projectid       string                     `json:"projectid"`
+	Projectroleid   string                     `json:"projectroleid"`
+	Projectrolename string                     `json:"projectrolename"`
+	Rule            string                     `json:"rule"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateProjectRolePermissionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateProjectRolePermissionParams struct {
@@ -3108,6 +4263,48 @@ func (p *UpdateProjectRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateProjectRolePermission API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateProjectRolePermissionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateProjectRolePermission", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateProjectRolePermission API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateProjectRolePermissionParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid", "projectroleid"},
+		lengths:  map[string]int{"permission": 255, "projectid": 255, "projectroleid": 255, "projectrolepermissionid": 255},
+	}.validate("updateProjectRolePermission", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateProjectRolePermission API, before the request is signed
+func (p *UpdateProjectRolePermissionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateProjectRolePermission", b, &p.p, map[string]paramDecoder{
+		"permission":              decodeParam[string],
+		"projectid":               decodeParam[string],
+		"projectroleid":           decodeParam[string],
+		"projectrolepermissionid": decodeParam[string],
+		"ruleorder":               decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateProjectRolePermissionParams) Clone() *UpdateProjectRolePermissionParams {
+	return &UpdateProjectRolePermissionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateProjectRolePermissionParams) SetPermission(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3225,13 +4422,22 @@ func (s *ProjectService) NewUpdateProjectRolePermissionParams(projectid string,
 
 // Updates a project role permission and/or order
 func (s *ProjectService) UpdateProjectRolePermission(p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateProjectRolePermission", p.toURLValues())
+	return s.UpdateProjectRolePermissionWithContext(context.Background(), p)
+}
+
+// UpdateProjectRolePermissionWithContext is like UpdateProjectRolePermission, but honours the cancellation and deadline of ctx
+func (s *ProjectService) UpdateProjectRolePermissionWithContext(ctx context.Context, p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateProjectRolePermission", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateProjectRolePermissionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateProjectRolePermission", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3239,10 +4445,11 @@ func (s *ProjectService) UpdateProjectRolePermission(p *UpdateProjectRolePermiss
 }
 
 type UpdateProjectRolePermissionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
@@ -3269,7 +4476,7 @@ func (r *UpdateProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateProjectRolePermissionResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteProjectRolePermissionParams struct {
@@ -3290,6 +4497,45 @@ func (p *DeleteProjectRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteProjectRolePermission API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteProjectRolePermissionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteProjectRolePermission", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteProjectRolePermission API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteProjectRolePermissionParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "projectid"},
+		lengths:  map[string]int{"id": 255, "projectid": 255},
+	}.validate("deleteProjectRolePermission", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteProjectRolePermission API, before the request is signed
+func (p *DeleteProjectRolePermissionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteProjectRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteProjectRolePermissionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteProjectRolePermission", b, &p.p, map[string]paramDecoder{
+		"id":        decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteProjectRolePermissionParams) Clone() *DeleteProjectRolePermissionParams {
+	return &DeleteProjectRolePermissionParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteProjectRolePermissionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3344,13 +4590,22 @@ func (s *ProjectService) NewDeleteProjectRolePermissionParams(id string, project
 
 // Deletes a project role permission in the project
 func (s *ProjectService) DeleteProjectRolePermission(p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteProjectRolePermission", p.toURLValues())
+	return s.DeleteProjectRolePermissionWithContext(context.Background(), p)
+}
+
+// DeleteProjectRolePermissionWithContext is like DeleteProjectRolePermission, but honours the cancellation and deadline of ctx
+func (s *ProjectService) DeleteProjectRolePermissionWithContext(ctx context.Context, p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteProjectRolePermission", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteProjectRolePermissionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteProjectRolePermission", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3358,10 +4613,11 @@ func (s *ProjectService) DeleteProjectRolePermission(p *DeleteProjectRolePermiss
 }
 
 type DeleteProjectRolePermissionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
@@ -3388,7 +4644,7 @@ func (r *DeleteProjectRolePermissionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteProjectRolePermissionResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateProjectRoleParams struct {
@@ -3412,6 +4668,46 @@ func (p *CreateProjectRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createProjectRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateProjectRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createProjectRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createProjectRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateProjectRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "projectid"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "projectid": 255},
+	}.validate("createProjectRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createProjectRole API, before the request is signed
+func (p *CreateProjectRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateProjectRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateProjectRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createProjectRole", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"name":        decodeParam[string],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateProjectRoleParams) Clone() *CreateProjectRoleParams {
+	return &CreateProjectRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateProjectRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3487,7 +4783,16 @@ func (s *ProjectService) NewCreateProjectRoleParams(name string, projectid strin
 
 // Creates a Project role
 func (s *ProjectService) CreateProjectRole(p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("createProjectRole", p.toURLValues())
+	return s.CreateProjectRoleWithContext(context.Background(), p)
+}
+
+// CreateProjectRoleWithContext is like CreateProjectRole, but honours the cancellation and deadline of ctx
+func (s *ProjectService) CreateProjectRoleWithContext(ctx context.Context, p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createProjectRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3495,7 +4800,7 @@ func (s *ProjectService) CreateProjectRole(p *CreateProjectRoleParams) (*CreateP
 	var nested struct {
 		Response CreateProjectRoleResponse `json:"projectrole"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("createProjectRole", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -3504,13 +4809,19 @@ func (s *ProjectService) CreateProjectRole(p *CreateProjectRoleParams) (*CreateP
 }
 
 type CreateProjectRoleResponse struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	Ispublic    bool   `json:"ispublic"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Projectid   string `json:"projectid"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	Ispublic    FlexBool                   `json:"ispublic"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Projectid   string                     `json:"projectid"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateProjectRoleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateProjectRoleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateProjectRoleParams struct {
@@ -3537,6 +4848,47 @@ func (p *UpdateProjectRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateProjectRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateProjectRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateProjectRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateProjectRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateProjectRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "projectid"},
+		lengths:  map[string]int{"description": 4096, "id": 255, "name": 255, "projectid": 255},
+	}.validate("updateProjectRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateProjectRole API, before the request is signed
+func (p *UpdateProjectRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateProjectRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateProjectRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateProjectRole", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"id":          decodeParam[string],
+		"name":        decodeParam[string],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateProjectRoleParams) Clone() *UpdateProjectRoleParams {
+	return &UpdateProjectRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateProjectRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3633,7 +4985,16 @@ func (s *ProjectService) NewUpdateProjectRoleParams(id string, projectid string)
 
 // Creates a Project role
 func (s *ProjectService) UpdateProjectRole(p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateProjectRole", p.toURLValues())
+	return s.UpdateProjectRoleWithContext(context.Background(), p)
+}
+
+// UpdateProjectRoleWithContext is like UpdateProjectRole, but honours the cancellation and deadline of ctx
+func (s *ProjectService) UpdateProjectRoleWithContext(ctx context.Context, p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateProjectRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3641,7 +5002,7 @@ func (s *ProjectService) UpdateProjectRole(p *UpdateProjectRoleParams) (*UpdateP
 	var nested struct {
 		Response UpdateProjectRoleResponse `json:"projectrole"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateProjectRole", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -3650,13 +5011,19 @@ func (s *ProjectService) UpdateProjectRole(p *UpdateProjectRoleParams) (*UpdateP
 }
 
 type UpdateProjectRoleResponse struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	Ispublic    bool   `json:"ispublic"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Projectid   string `json:"projectid"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	Ispublic    FlexBool                   `json:"ispublic"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Projectid   string                     `json:"projectid"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateProjectRoleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateProjectRoleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteProjectRoleParams struct {
@@ -3677,6 +5044,45 @@ func (p *DeleteProjectRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteProjectRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteProjectRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteProjectRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteProjectRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteProjectRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "projectid"},
+		lengths:  map[string]int{"id": 255, "projectid": 255},
+	}.validate("deleteProjectRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteProjectRole API, before the request is signed
+func (p *DeleteProjectRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteProjectRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteProjectRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteProjectRole", b, &p.p, map[string]paramDecoder{
+		"id":        decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteProjectRoleParams) Clone() *DeleteProjectRoleParams {
+	return &DeleteProjectRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteProjectRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3731,13 +5137,22 @@ func (s *ProjectService) NewDeleteProjectRoleParams(id string, projectid string)
 
 // Delete Project roles in CloudStack
 func (s *ProjectService) DeleteProjectRole(p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteProjectRole", p.toURLValues())
+	return s.DeleteProjectRoleWithContext(context.Background(), p)
+}
+
+// DeleteProjectRoleWithContext is like DeleteProjectRole, but honours the cancellation and deadline of ctx
+func (s *ProjectService) DeleteProjectRoleWithContext(ctx context.Context, p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteProjectRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteProjectRoleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteProjectRole", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3745,10 +5160,11 @@ func (s *ProjectService) DeleteProjectRole(p *DeleteProjectRoleParams) (*DeleteP
 }
 
 type DeleteProjectRoleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteProjectRoleResponse) UnmarshalJSON(b []byte) error {
@@ -3775,5 +5191,5 @@ func (r *DeleteProjectRoleResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteProjectRoleResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
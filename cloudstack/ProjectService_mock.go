@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) ActivateProject(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).ActivateProject), p)
 }
 
+// ActivateProjectAsync mocks base method.
+func (m *MockProjectServiceIface) ActivateProjectAsync(ctx context.Context, p *ActivateProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateProjectAsync indicates an expected call of ActivateProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) ActivateProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).ActivateProjectAsync), ctx, p)
+}
+
+// ActivateProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) ActivateProjectWithContext(ctx context.Context, p *ActivateProjectParams) (*ActivateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ActivateProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*ActivateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ActivateProjectWithContext indicates an expected call of ActivateProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ActivateProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ActivateProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ActivateProjectWithContext), ctx, p)
+}
+
 // AddAccountToProject mocks base method.
 func (m *MockProjectServiceIface) AddAccountToProject(p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) AddAccountToProject(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountToProject", reflect.TypeOf((*MockProjectServiceIface)(nil).AddAccountToProject), p)
 }
 
+// AddAccountToProjectAsync mocks base method.
+func (m *MockProjectServiceIface) AddAccountToProjectAsync(ctx context.Context, p *AddAccountToProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccountToProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountToProjectAsync indicates an expected call of AddAccountToProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) AddAccountToProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountToProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).AddAccountToProjectAsync), ctx, p)
+}
+
+// AddAccountToProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) AddAccountToProjectWithContext(ctx context.Context, p *AddAccountToProjectParams) (*AddAccountToProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddAccountToProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddAccountToProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddAccountToProjectWithContext indicates an expected call of AddAccountToProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) AddAccountToProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddAccountToProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).AddAccountToProjectWithContext), ctx, p)
+}
+
 // AddUserToProject mocks base method.
 func (m *MockProjectServiceIface) AddUserToProject(p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) AddUserToProject(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToProject", reflect.TypeOf((*MockProjectServiceIface)(nil).AddUserToProject), p)
 }
 
+// AddUserToProjectAsync mocks base method.
+func (m *MockProjectServiceIface) AddUserToProjectAsync(ctx context.Context, p *AddUserToProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserToProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUserToProjectAsync indicates an expected call of AddUserToProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) AddUserToProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).AddUserToProjectAsync), ctx, p)
+}
+
+// AddUserToProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) AddUserToProjectWithContext(ctx context.Context, p *AddUserToProjectParams) (*AddUserToProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUserToProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddUserToProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUserToProjectWithContext indicates an expected call of AddUserToProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) AddUserToProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUserToProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).AddUserToProjectWithContext), ctx, p)
+}
+
 // CreateProject mocks base method.
 func (m *MockProjectServiceIface) CreateProject(p *CreateProjectParams) (*CreateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,21 @@ func (mr *MockProjectServiceIfaceMockRecorder) CreateProject(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProject), p)
 }
 
+// CreateProjectAsync mocks base method.
+func (m *MockProjectServiceIface) CreateProjectAsync(ctx context.Context, p *CreateProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectAsync indicates an expected call of CreateProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) CreateProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProjectAsync), ctx, p)
+}
+
 // CreateProjectRole mocks base method.
 func (m *MockProjectServiceIface) CreateProjectRole(p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +255,51 @@ func (mr *MockProjectServiceIfaceMockRecorder) CreateProjectRolePermission(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProjectRolePermission), p)
 }
 
+// CreateProjectRolePermissionWithContext mocks base method.
+func (m *MockProjectServiceIface) CreateProjectRolePermissionWithContext(ctx context.Context, p *CreateProjectRolePermissionParams) (*CreateProjectRolePermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectRolePermissionWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateProjectRolePermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectRolePermissionWithContext indicates an expected call of CreateProjectRolePermissionWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) CreateProjectRolePermissionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectRolePermissionWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProjectRolePermissionWithContext), ctx, p)
+}
+
+// CreateProjectRoleWithContext mocks base method.
+func (m *MockProjectServiceIface) CreateProjectRoleWithContext(ctx context.Context, p *CreateProjectRoleParams) (*CreateProjectRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateProjectRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectRoleWithContext indicates an expected call of CreateProjectRoleWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) CreateProjectRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectRoleWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProjectRoleWithContext), ctx, p)
+}
+
+// CreateProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) CreateProjectWithContext(ctx context.Context, p *CreateProjectParams) (*CreateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateProjectWithContext indicates an expected call of CreateProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) CreateProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).CreateProjectWithContext), ctx, p)
+}
+
 // DeleteAccountFromProject mocks base method.
 func (m *MockProjectServiceIface) DeleteAccountFromProject(p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +315,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) DeleteAccountFromProject(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountFromProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteAccountFromProject), p)
 }
 
+// DeleteAccountFromProjectAsync mocks base method.
+func (m *MockProjectServiceIface) DeleteAccountFromProjectAsync(ctx context.Context, p *DeleteAccountFromProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountFromProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccountFromProjectAsync indicates an expected call of DeleteAccountFromProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteAccountFromProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountFromProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteAccountFromProjectAsync), ctx, p)
+}
+
+// DeleteAccountFromProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) DeleteAccountFromProjectWithContext(ctx context.Context, p *DeleteAccountFromProjectParams) (*DeleteAccountFromProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteAccountFromProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteAccountFromProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteAccountFromProjectWithContext indicates an expected call of DeleteAccountFromProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteAccountFromProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteAccountFromProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteAccountFromProjectWithContext), ctx, p)
+}
+
 // DeleteProject mocks base method.
 func (m *MockProjectServiceIface) DeleteProject(p *DeleteProjectParams) (*DeleteProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +360,21 @@ func (mr *MockProjectServiceIfaceMockRecorder) DeleteProject(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProject), p)
 }
 
+// DeleteProjectAsync mocks base method.
+func (m *MockProjectServiceIface) DeleteProjectAsync(ctx context.Context, p *DeleteProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectAsync indicates an expected call of DeleteProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectAsync), ctx, p)
+}
+
 // DeleteProjectInvitation mocks base method.
 func (m *MockProjectServiceIface) DeleteProjectInvitation(p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +390,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectInvitation(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectInvitation", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectInvitation), p)
 }
 
+// DeleteProjectInvitationAsync mocks base method.
+func (m *MockProjectServiceIface) DeleteProjectInvitationAsync(ctx context.Context, p *DeleteProjectInvitationParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectInvitationAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectInvitationAsync indicates an expected call of DeleteProjectInvitationAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectInvitationAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectInvitationAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectInvitationAsync), ctx, p)
+}
+
+// DeleteProjectInvitationWithContext mocks base method.
+func (m *MockProjectServiceIface) DeleteProjectInvitationWithContext(ctx context.Context, p *DeleteProjectInvitationParams) (*DeleteProjectInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectInvitationWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteProjectInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectInvitationWithContext indicates an expected call of DeleteProjectInvitationWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectInvitationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectInvitationWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectInvitationWithContext), ctx, p)
+}
+
 // DeleteProjectRole mocks base method.
 func (m *MockProjectServiceIface) DeleteProjectRole(p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +450,51 @@ func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectRolePermission(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectRolePermission), p)
 }
 
+// DeleteProjectRolePermissionWithContext mocks base method.
+func (m *MockProjectServiceIface) DeleteProjectRolePermissionWithContext(ctx context.Context, p *DeleteProjectRolePermissionParams) (*DeleteProjectRolePermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectRolePermissionWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteProjectRolePermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectRolePermissionWithContext indicates an expected call of DeleteProjectRolePermissionWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectRolePermissionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectRolePermissionWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectRolePermissionWithContext), ctx, p)
+}
+
+// DeleteProjectRoleWithContext mocks base method.
+func (m *MockProjectServiceIface) DeleteProjectRoleWithContext(ctx context.Context, p *DeleteProjectRoleParams) (*DeleteProjectRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteProjectRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectRoleWithContext indicates an expected call of DeleteProjectRoleWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectRoleWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectRoleWithContext), ctx, p)
+}
+
+// DeleteProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) DeleteProjectWithContext(ctx context.Context, p *DeleteProjectParams) (*DeleteProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteProjectWithContext indicates an expected call of DeleteProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteProjectWithContext), ctx, p)
+}
+
 // DeleteUserFromProject mocks base method.
 func (m *MockProjectServiceIface) DeleteUserFromProject(p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +510,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) DeleteUserFromProject(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserFromProject", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteUserFromProject), p)
 }
 
+// DeleteUserFromProjectAsync mocks base method.
+func (m *MockProjectServiceIface) DeleteUserFromProjectAsync(ctx context.Context, p *DeleteUserFromProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserFromProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserFromProjectAsync indicates an expected call of DeleteUserFromProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteUserFromProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserFromProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteUserFromProjectAsync), ctx, p)
+}
+
+// DeleteUserFromProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) DeleteUserFromProjectWithContext(ctx context.Context, p *DeleteUserFromProjectParams) (*DeleteUserFromProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserFromProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteUserFromProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserFromProjectWithContext indicates an expected call of DeleteUserFromProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) DeleteUserFromProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserFromProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).DeleteUserFromProjectWithContext), ctx, p)
+}
+
 // GetProjectByID mocks base method.
 func (m *MockProjectServiceIface) GetProjectByID(id string, opts ...OptionFunc) (*Project, int, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +561,27 @@ func (mr *MockProjectServiceIfaceMockRecorder) GetProjectByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByID", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectByID), varargs...)
 }
 
+// GetProjectByIDWithContext mocks base method.
+func (m *MockProjectServiceIface) GetProjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Project, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Project)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectByIDWithContext indicates an expected call of GetProjectByIDWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) GetProjectByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByIDWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectByIDWithContext), varargs...)
+}
+
 // GetProjectByName mocks base method.
 func (m *MockProjectServiceIface) GetProjectByName(name string, opts ...OptionFunc) (*Project, int, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +603,27 @@ func (mr *MockProjectServiceIfaceMockRecorder) GetProjectByName(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByName", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectByName), varargs...)
 }
 
+// GetProjectByNameWithContext mocks base method.
+func (m *MockProjectServiceIface) GetProjectByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Project, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Project)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectByNameWithContext indicates an expected call of GetProjectByNameWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) GetProjectByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectByNameWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectByNameWithContext), varargs...)
+}
+
 // GetProjectID mocks base method.
 func (m *MockProjectServiceIface) GetProjectID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -301,6 +645,27 @@ func (mr *MockProjectServiceIfaceMockRecorder) GetProjectID(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectID", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectID), varargs...)
 }
 
+// GetProjectIDWithContext mocks base method.
+func (m *MockProjectServiceIface) GetProjectIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectIDWithContext indicates an expected call of GetProjectIDWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) GetProjectIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectIDWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectIDWithContext), varargs...)
+}
+
 // GetProjectInvitationByID mocks base method.
 func (m *MockProjectServiceIface) GetProjectInvitationByID(id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +687,27 @@ func (mr *MockProjectServiceIfaceMockRecorder) GetProjectInvitationByID(id any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectInvitationByID", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectInvitationByID), varargs...)
 }
 
+// GetProjectInvitationByIDWithContext mocks base method.
+func (m *MockProjectServiceIface) GetProjectInvitationByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ProjectInvitation, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectInvitationByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ProjectInvitation)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectInvitationByIDWithContext indicates an expected call of GetProjectInvitationByIDWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) GetProjectInvitationByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectInvitationByIDWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).GetProjectInvitationByIDWithContext), varargs...)
+}
+
 // ListProjectInvitations mocks base method.
 func (m *MockProjectServiceIface) ListProjectInvitations(p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +723,99 @@ func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitations(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitations", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitations), p)
 }
 
+// ListProjectInvitationsAll mocks base method.
+func (m *MockProjectServiceIface) ListProjectInvitationsAll(p *ListProjectInvitationsParams, opts ...PageOption) ([]*ProjectInvitation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectInvitationsAll", varargs...)
+	ret0, _ := ret[0].([]*ProjectInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectInvitationsAll indicates an expected call of ListProjectInvitationsAll.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitationsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitationsAll", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitationsAll), varargs...)
+}
+
+// ListProjectInvitationsAllWithContext mocks base method.
+func (m *MockProjectServiceIface) ListProjectInvitationsAllWithContext(ctx context.Context, p *ListProjectInvitationsParams, opts ...PageOption) ([]*ProjectInvitation, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectInvitationsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ProjectInvitation)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectInvitationsAllWithContext indicates an expected call of ListProjectInvitationsAllWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitationsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitationsAllWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitationsAllWithContext), varargs...)
+}
+
+// ListProjectInvitationsIter mocks base method.
+func (m *MockProjectServiceIface) ListProjectInvitationsIter(p *ListProjectInvitationsParams, opts ...PageOption) iter.Seq2[*ProjectInvitation, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectInvitationsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ProjectInvitation, error])
+	return ret0
+}
+
+// ListProjectInvitationsIter indicates an expected call of ListProjectInvitationsIter.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitationsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitationsIter", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitationsIter), varargs...)
+}
+
+// ListProjectInvitationsIterWithContext mocks base method.
+func (m *MockProjectServiceIface) ListProjectInvitationsIterWithContext(ctx context.Context, p *ListProjectInvitationsParams, opts ...PageOption) iter.Seq2[*ProjectInvitation, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectInvitationsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ProjectInvitation, error])
+	return ret0
+}
+
+// ListProjectInvitationsIterWithContext indicates an expected call of ListProjectInvitationsIterWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitationsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitationsIterWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitationsIterWithContext), varargs...)
+}
+
+// ListProjectInvitationsWithContext mocks base method.
+func (m *MockProjectServiceIface) ListProjectInvitationsWithContext(ctx context.Context, p *ListProjectInvitationsParams) (*ListProjectInvitationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectInvitationsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListProjectInvitationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectInvitationsWithContext indicates an expected call of ListProjectInvitationsWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectInvitationsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectInvitationsWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectInvitationsWithContext), ctx, p)
+}
+
 // ListProjectRolePermissions mocks base method.
 func (m *MockProjectServiceIface) ListProjectRolePermissions(p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -352,6 +831,21 @@ func (mr *MockProjectServiceIfaceMockRecorder) ListProjectRolePermissions(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolePermissions", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectRolePermissions), p)
 }
 
+// ListProjectRolePermissionsWithContext mocks base method.
+func (m *MockProjectServiceIface) ListProjectRolePermissionsWithContext(ctx context.Context, p *ListProjectRolePermissionsParams) (*ListProjectRolePermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectRolePermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListProjectRolePermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectRolePermissionsWithContext indicates an expected call of ListProjectRolePermissionsWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectRolePermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolePermissionsWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectRolePermissionsWithContext), ctx, p)
+}
+
 // ListProjects mocks base method.
 func (m *MockProjectServiceIface) ListProjects(p *ListProjectsParams) (*ListProjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -367,6 +861,99 @@ func (mr *MockProjectServiceIfaceMockRecorder) ListProjects(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjects", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjects), p)
 }
 
+// ListProjectsAll mocks base method.
+func (m *MockProjectServiceIface) ListProjectsAll(p *ListProjectsParams, opts ...PageOption) ([]*Project, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectsAll", varargs...)
+	ret0, _ := ret[0].([]*Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsAll indicates an expected call of ListProjectsAll.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsAll", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectsAll), varargs...)
+}
+
+// ListProjectsAllWithContext mocks base method.
+func (m *MockProjectServiceIface) ListProjectsAllWithContext(ctx context.Context, p *ListProjectsParams, opts ...PageOption) ([]*Project, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Project)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsAllWithContext indicates an expected call of ListProjectsAllWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsAllWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectsAllWithContext), varargs...)
+}
+
+// ListProjectsIter mocks base method.
+func (m *MockProjectServiceIface) ListProjectsIter(p *ListProjectsParams, opts ...PageOption) iter.Seq2[*Project, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Project, error])
+	return ret0
+}
+
+// ListProjectsIter indicates an expected call of ListProjectsIter.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsIter", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectsIter), varargs...)
+}
+
+// ListProjectsIterWithContext mocks base method.
+func (m *MockProjectServiceIface) ListProjectsIterWithContext(ctx context.Context, p *ListProjectsParams, opts ...PageOption) iter.Seq2[*Project, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Project, error])
+	return ret0
+}
+
+// ListProjectsIterWithContext indicates an expected call of ListProjectsIterWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsIterWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectsIterWithContext), varargs...)
+}
+
+// ListProjectsWithContext mocks base method.
+func (m *MockProjectServiceIface) ListProjectsWithContext(ctx context.Context, p *ListProjectsParams) (*ListProjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListProjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectsWithContext indicates an expected call of ListProjectsWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) ListProjectsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectsWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).ListProjectsWithContext), ctx, p)
+}
+
 // NewActivateProjectParams mocks base method.
 func (m *MockProjectServiceIface) NewActivateProjectParams(id string) *ActivateProjectParams {
 	m.ctrl.T.Helper()
@@ -662,6 +1249,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) SuspendProject(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProject", reflect.TypeOf((*MockProjectServiceIface)(nil).SuspendProject), p)
 }
 
+// SuspendProjectAsync mocks base method.
+func (m *MockProjectServiceIface) SuspendProjectAsync(ctx context.Context, p *SuspendProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendProjectAsync indicates an expected call of SuspendProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) SuspendProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).SuspendProjectAsync), ctx, p)
+}
+
+// SuspendProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) SuspendProjectWithContext(ctx context.Context, p *SuspendProjectParams) (*SuspendProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SuspendProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*SuspendProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SuspendProjectWithContext indicates an expected call of SuspendProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) SuspendProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SuspendProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).SuspendProjectWithContext), ctx, p)
+}
+
 // UpdateProject mocks base method.
 func (m *MockProjectServiceIface) UpdateProject(p *UpdateProjectParams) (*UpdateProjectResponse, error) {
 	m.ctrl.T.Helper()
@@ -677,6 +1294,21 @@ func (mr *MockProjectServiceIfaceMockRecorder) UpdateProject(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProject", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProject), p)
 }
 
+// UpdateProjectAsync mocks base method.
+func (m *MockProjectServiceIface) UpdateProjectAsync(ctx context.Context, p *UpdateProjectParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectAsync indicates an expected call of UpdateProjectAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectAsync), ctx, p)
+}
+
 // UpdateProjectInvitation mocks base method.
 func (m *MockProjectServiceIface) UpdateProjectInvitation(p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
 	m.ctrl.T.Helper()
@@ -692,6 +1324,36 @@ func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectInvitation(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectInvitation", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectInvitation), p)
 }
 
+// UpdateProjectInvitationAsync mocks base method.
+func (m *MockProjectServiceIface) UpdateProjectInvitationAsync(ctx context.Context, p *UpdateProjectInvitationParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectInvitationAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectInvitationAsync indicates an expected call of UpdateProjectInvitationAsync.
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectInvitationAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectInvitationAsync", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectInvitationAsync), ctx, p)
+}
+
+// UpdateProjectInvitationWithContext mocks base method.
+func (m *MockProjectServiceIface) UpdateProjectInvitationWithContext(ctx context.Context, p *UpdateProjectInvitationParams) (*UpdateProjectInvitationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectInvitationWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateProjectInvitationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectInvitationWithContext indicates an expected call of UpdateProjectInvitationWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectInvitationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectInvitationWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectInvitationWithContext), ctx, p)
+}
+
 // UpdateProjectRole mocks base method.
 func (m *MockProjectServiceIface) UpdateProjectRole(p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -721,3 +1383,48 @@ func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectRolePermission(p any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectRolePermission", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectRolePermission), p)
 }
+
+// UpdateProjectRolePermissionWithContext mocks base method.
+func (m *MockProjectServiceIface) UpdateProjectRolePermissionWithContext(ctx context.Context, p *UpdateProjectRolePermissionParams) (*UpdateProjectRolePermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectRolePermissionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateProjectRolePermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectRolePermissionWithContext indicates an expected call of UpdateProjectRolePermissionWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectRolePermissionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectRolePermissionWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectRolePermissionWithContext), ctx, p)
+}
+
+// UpdateProjectRoleWithContext mocks base method.
+func (m *MockProjectServiceIface) UpdateProjectRoleWithContext(ctx context.Context, p *UpdateProjectRoleParams) (*UpdateProjectRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateProjectRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectRoleWithContext indicates an expected call of UpdateProjectRoleWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectRoleWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectRoleWithContext), ctx, p)
+}
+
+// UpdateProjectWithContext mocks base method.
+func (m *MockProjectServiceIface) UpdateProjectWithContext(ctx context.Context, p *UpdateProjectParams) (*UpdateProjectResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateProjectWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateProjectResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateProjectWithContext indicates an expected call of UpdateProjectWithContext.
+func (mr *MockProjectServiceIfaceMockRecorder) UpdateProjectWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateProjectWithContext", reflect.TypeOf((*MockProjectServiceIface)(nil).UpdateProjectWithContext), ctx, p)
+}
@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -27,24 +28,34 @@ import (
 
 type QuotaServiceIface interface {
 	QuotaBalance(p *QuotaBalanceParams) (*QuotaBalanceResponse, error)
+	QuotaBalanceWithContext(ctx context.Context, p *QuotaBalanceParams) (*QuotaBalanceResponse, error)
 	NewQuotaBalanceParams(account string, domainid string) *QuotaBalanceParams
 	QuotaCredits(p *QuotaCreditsParams) (*QuotaCreditsResponse, error)
+	QuotaCreditsWithContext(ctx context.Context, p *QuotaCreditsParams) (*QuotaCreditsResponse, error)
 	NewQuotaCreditsParams(account string, domainid string, value float64) *QuotaCreditsParams
 	QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error)
+	QuotaIsEnabledWithContext(ctx context.Context, p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error)
 	NewQuotaIsEnabledParams() *QuotaIsEnabledParams
 	QuotaStatement(p *QuotaStatementParams) (*QuotaStatementResponse, error)
+	QuotaStatementWithContext(ctx context.Context, p *QuotaStatementParams) (*QuotaStatementResponse, error)
 	NewQuotaStatementParams(account string, domainid string, enddate string, startdate string) *QuotaStatementParams
 	QuotaSummary(p *QuotaSummaryParams) (*QuotaSummaryResponse, error)
+	QuotaSummaryWithContext(ctx context.Context, p *QuotaSummaryParams) (*QuotaSummaryResponse, error)
 	NewQuotaSummaryParams() *QuotaSummaryParams
 	QuotaTariffCreate(p *QuotaTariffCreateParams) (*QuotaTariffCreateResponse, error)
+	QuotaTariffCreateWithContext(ctx context.Context, p *QuotaTariffCreateParams) (*QuotaTariffCreateResponse, error)
 	NewQuotaTariffCreateParams(name string, usagetype int, value float64) *QuotaTariffCreateParams
 	QuotaTariffDelete(p *QuotaTariffDeleteParams) (*QuotaTariffDeleteResponse, error)
+	QuotaTariffDeleteWithContext(ctx context.Context, p *QuotaTariffDeleteParams) (*QuotaTariffDeleteResponse, error)
 	NewQuotaTariffDeleteParams(id string) *QuotaTariffDeleteParams
 	QuotaTariffList(p *QuotaTariffListParams) (*QuotaTariffListResponse, error)
+	QuotaTariffListWithContext(ctx context.Context, p *QuotaTariffListParams) (*QuotaTariffListResponse, error)
 	NewQuotaTariffListParams() *QuotaTariffListParams
 	QuotaTariffUpdate(p *QuotaTariffUpdateParams) (*QuotaTariffUpdateResponse, error)
+	QuotaTariffUpdateWithContext(ctx context.Context, p *QuotaTariffUpdateParams) (*QuotaTariffUpdateResponse, error)
 	NewQuotaTariffUpdateParams(name string) *QuotaTariffUpdateParams
 	QuotaUpdate(p *QuotaUpdateParams) (*QuotaUpdateResponse, error)
+	QuotaUpdateWithContext(ctx context.Context, p *QuotaUpdateParams) (*QuotaUpdateResponse, error)
 	NewQuotaUpdateParams() *QuotaUpdateParams
 }
 
@@ -75,6 +86,48 @@ func (p *QuotaBalanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaBalance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaBalanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaBalance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaBalance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaBalanceParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "domainid"},
+		lengths:  map[string]int{"account": 255, "accountid": 255, "domainid": 255, "enddate": 255, "startdate": 255},
+	}.validate("quotaBalance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaBalance API, before the request is signed
+func (p *QuotaBalanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaBalanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaBalanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaBalance", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"accountid": decodeParam[string],
+		"domainid":  decodeParam[string],
+		"enddate":   decodeParam[string],
+		"startdate": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaBalanceParams) Clone() *QuotaBalanceParams {
+	return &QuotaBalanceParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaBalanceParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -192,13 +245,22 @@ func (s *QuotaService) NewQuotaBalanceParams(account string, domainid string) *Q
 
 // Create a quota balance statement
 func (s *QuotaService) QuotaBalance(p *QuotaBalanceParams) (*QuotaBalanceResponse, error) {
-	resp, err := s.cs.newRequest("quotaBalance", p.toURLValues())
+	return s.QuotaBalanceWithContext(context.Background(), p)
+}
+
+// QuotaBalanceWithContext is like QuotaBalance, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaBalanceWithContext(ctx context.Context, p *QuotaBalanceParams) (*QuotaBalanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "quotaBalance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaBalanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaBalance", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -246,6 +308,48 @@ func (p *QuotaCreditsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaCredits API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaCreditsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaCredits", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaCredits API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaCreditsParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "domainid", "value"},
+		lengths:  map[string]int{"account": 255, "domainid": 255},
+	}.validate("quotaCredits", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaCredits API, before the request is signed
+func (p *QuotaCreditsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaCreditsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaCreditsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaCredits", b, &p.p, map[string]paramDecoder{
+		"account":       decodeParam[string],
+		"domainid":      decodeParam[string],
+		"min_balance":   decodeParam[float64],
+		"quota_enforce": decodeParam[bool],
+		"value":         decodeParam[float64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaCreditsParams) Clone() *QuotaCreditsParams {
+	return &QuotaCreditsParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaCreditsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -364,13 +468,22 @@ func (s *QuotaService) NewQuotaCreditsParams(account string, domainid string, va
 
 // Add +-credits to an Account
 func (s *QuotaService) QuotaCredits(p *QuotaCreditsParams) (*QuotaCreditsResponse, error) {
-	resp, err := s.cs.newPostRequest("quotaCredits", p.toURLValues())
+	return s.QuotaCreditsWithContext(context.Background(), p)
+}
+
+// QuotaCreditsWithContext is like QuotaCredits, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaCreditsWithContext(ctx context.Context, p *QuotaCreditsParams) (*QuotaCreditsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "quotaCredits", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaCreditsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaCredits", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -378,13 +491,19 @@ func (s *QuotaService) QuotaCredits(p *QuotaCreditsParams) (*QuotaCreditsRespons
 }
 
 type QuotaCreditsResponse struct {
-	Credit           float64 `json:"credit"`
-	Creditedon       string  `json:"creditedon"`
-	Creditoruserid   string  `json:"creditoruserid"`
-	Creditorusername string  `json:"creditorusername"`
-	Currency         string  `json:"currency"`
-	JobID            string  `json:"jobid"`
-	Jobstatus        int     `json:"jobstatus"`
+	Credit           FlexFloat                  `json:"credit"`
+	Creditedon       string                     `json:"creditedon"`
+	Creditoruserid   string                     `json:"creditoruserid"`
+	Creditorusername string                     `json:"creditorusername"`
+	Currency         string                     `json:"currency"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuotaCreditsResponse) UnmarshalJSON(b []byte) error {
+	type alias QuotaCreditsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QuotaIsEnabledParams struct {
@@ -399,6 +518,39 @@ func (p *QuotaIsEnabledParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaIsEnabled API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaIsEnabledParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaIsEnabled", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaIsEnabled API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaIsEnabledParams) Validate() error {
+	return paramRules{}.validate("quotaIsEnabled", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaIsEnabled API, before the request is signed
+func (p *QuotaIsEnabledParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaIsEnabledParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaIsEnabledParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaIsEnabled", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaIsEnabledParams) Clone() *QuotaIsEnabledParams {
+	return &QuotaIsEnabledParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new QuotaIsEnabledParams instance,
 // as then you are sure you have configured all required params
 func (s *QuotaService) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
@@ -409,13 +561,22 @@ func (s *QuotaService) NewQuotaIsEnabledParams() *QuotaIsEnabledParams {
 
 // Return true if the plugin is enabled
 func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
-	resp, err := s.cs.newRequest("quotaIsEnabled", p.toURLValues())
+	return s.QuotaIsEnabledWithContext(context.Background(), p)
+}
+
+// QuotaIsEnabledWithContext is like QuotaIsEnabled, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaIsEnabledWithContext(ctx context.Context, p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "quotaIsEnabled", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaIsEnabledResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaIsEnabled", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -423,9 +584,15 @@ func (s *QuotaService) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledR
 }
 
 type QuotaIsEnabledResponse struct {
-	Isenabled bool   `json:"isenabled"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
+	Isenabled FlexBool                   `json:"isenabled"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuotaIsEnabledResponse) UnmarshalJSON(b []byte) error {
+	type alias QuotaIsEnabledResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QuotaStatementParams struct {
@@ -459,6 +626,49 @@ func (p *QuotaStatementParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaStatement API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaStatementParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaStatement", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaStatement API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaStatementParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "domainid", "enddate", "startdate"},
+		lengths:  map[string]int{"account": 255, "accountid": 255, "domainid": 255, "enddate": 255, "startdate": 255},
+	}.validate("quotaStatement", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaStatement API, before the request is signed
+func (p *QuotaStatementParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaStatementParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaStatementParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaStatement", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"accountid": decodeParam[string],
+		"domainid":  decodeParam[string],
+		"enddate":   decodeParam[string],
+		"startdate": decodeParam[string],
+		"type":      decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaStatementParams) Clone() *QuotaStatementParams {
+	return &QuotaStatementParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaStatementParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -599,13 +809,22 @@ func (s *QuotaService) NewQuotaStatementParams(account string, domainid string,
 
 // Create a quota statement
 func (s *QuotaService) QuotaStatement(p *QuotaStatementParams) (*QuotaStatementResponse, error) {
-	resp, err := s.cs.newRequest("quotaStatement", p.toURLValues())
+	return s.QuotaStatementWithContext(context.Background(), p)
+}
+
+// QuotaStatementWithContext is like QuotaStatement, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaStatementWithContext(ctx context.Context, p *QuotaStatementParams) (*QuotaStatementResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "quotaStatement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaStatementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaStatement", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -666,6 +885,48 @@ func (p *QuotaSummaryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaSummary API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaSummaryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaSummary", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaSummary API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaSummaryParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "keyword": 255},
+	}.validate("quotaSummary", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaSummary API, before the request is signed
+func (p *QuotaSummaryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaSummaryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaSummaryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaSummary", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"keyword":  decodeParam[string],
+		"listall":  decodeParam[bool],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaSummaryParams) Clone() *QuotaSummaryParams {
+	return &QuotaSummaryParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaSummaryParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -802,13 +1063,22 @@ func (s *QuotaService) NewQuotaSummaryParams() *QuotaSummaryParams {
 
 // Lists balance and quota usage for all Accounts
 func (s *QuotaService) QuotaSummary(p *QuotaSummaryParams) (*QuotaSummaryResponse, error) {
-	resp, err := s.cs.newRequest("quotaSummary", p.toURLValues())
+	return s.QuotaSummaryWithContext(context.Background(), p)
+}
+
+// QuotaSummaryWithContext is like QuotaSummary, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaSummaryWithContext(ctx context.Context, p *QuotaSummaryParams) (*QuotaSummaryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "quotaSummary", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaSummaryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaSummary", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -821,19 +1091,25 @@ type QuotaSummaryResponse struct {
 }
 
 type QuotaSummary struct {
-	Account      string  `json:"account"`
-	Accountid    string  `json:"accountid"`
-	Balance      float64 `json:"balance"`
-	Currency     string  `json:"currency"`
-	Domain       string  `json:"domain"`
-	Domainid     string  `json:"domainid"`
-	Enddate      string  `json:"enddate"`
-	JobID        string  `json:"jobid"`
-	Jobstatus    int     `json:"jobstatus"`
-	Quota        float64 `json:"quota"`
-	Quotaenabled bool    `json:"quotaenabled"`
-	Startdate    string  `json:"startdate"`
-	State        string  `json:"state"`
+	Account      string                     `json:"account"`
+	Accountid    string                     `json:"accountid"`
+	Balance      FlexFloat                  `json:"balance"`
+	Currency     string                     `json:"currency"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Enddate      Time                       `json:"enddate"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Quota        FlexFloat                  `json:"quota"`
+	Quotaenabled FlexBool                   `json:"quotaenabled"`
+	Startdate    Time                       `json:"startdate"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuotaSummary) UnmarshalJSON(b []byte) error {
+	type alias QuotaSummary
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QuotaTariffCreateParams struct {
@@ -875,6 +1151,51 @@ func (p *QuotaTariffCreateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaTariffCreate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaTariffCreateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaTariffCreate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaTariffCreate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaTariffCreateParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "usagetype", "value"},
+		lengths:  map[string]int{"activationrule": 255, "description": 4096, "enddate": 255, "name": 255, "startdate": 255},
+	}.validate("quotaTariffCreate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaTariffCreate API, before the request is signed
+func (p *QuotaTariffCreateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaTariffCreateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaTariffCreateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaTariffCreate", b, &p.p, map[string]paramDecoder{
+		"activationrule": decodeParam[string],
+		"description":    decodeParam[string],
+		"enddate":        decodeParam[string],
+		"name":           decodeParam[string],
+		"position":       decodeParam[int],
+		"startdate":      decodeParam[string],
+		"usagetype":      decodeParam[int],
+		"value":          decodeParam[float64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaTariffCreateParams) Clone() *QuotaTariffCreateParams {
+	return &QuotaTariffCreateParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaTariffCreateParams) SetActivationrule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1056,13 +1377,22 @@ func (s *QuotaService) NewQuotaTariffCreateParams(name string, usagetype int, va
 
 // Creates a quota tariff for a resource.
 func (s *QuotaService) QuotaTariffCreate(p *QuotaTariffCreateParams) (*QuotaTariffCreateResponse, error) {
-	resp, err := s.cs.newPostRequest("quotaTariffCreate", p.toURLValues())
+	return s.QuotaTariffCreateWithContext(context.Background(), p)
+}
+
+// QuotaTariffCreateWithContext is like QuotaTariffCreate, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaTariffCreateWithContext(ctx context.Context, p *QuotaTariffCreateParams) (*QuotaTariffCreateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "quotaTariffCreate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaTariffCreateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaTariffCreate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1070,23 +1400,29 @@ func (s *QuotaService) QuotaTariffCreate(p *QuotaTariffCreateParams) (*QuotaTari
 }
 
 type QuotaTariffCreateResponse struct {
-	ActivationRule       string  `json:"activationRule"`
-	Currency             string  `json:"currency"`
-	Description          string  `json:"description"`
-	EffectiveDate        string  `json:"effectiveDate"`
-	EndDate              string  `json:"endDate"`
-	Id                   string  `json:"id"`
-	JobID                string  `json:"jobid"`
-	Jobstatus            int     `json:"jobstatus"`
-	Name                 string  `json:"name"`
-	Position             int     `json:"position"`
-	Removed              string  `json:"removed"`
-	TariffValue          float64 `json:"tariffValue"`
-	UsageDiscriminator   string  `json:"usageDiscriminator"`
-	UsageName            string  `json:"usageName"`
-	UsageType            int     `json:"usageType"`
-	UsageTypeDescription string  `json:"usageTypeDescription"`
-	UsageUnit            string  `json:"usageUnit"`
+	ActivationRule       string                     `json:"activationRule"`
+	Currency             string                     `json:"currency"`
+	Description          string                     `json:"description"`
+	EffectiveDate        string                     `json:"effectiveDate"`
+	EndDate              string                     `json:"endDate"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Name                 string                     `json:"name"`
+	Position             FlexInt                    `json:"position"`
+	Removed              Time                       `json:"removed"`
+	TariffValue          FlexFloat                  `json:"tariffValue"`
+	UsageDiscriminator   string                     `json:"usageDiscriminator"`
+	UsageName            string                     `json:"usageName"`
+	UsageType            FlexInt                    `json:"usageType"`
+	UsageTypeDescription string                     `json:"usageTypeDescription"`
+	UsageUnit            string                     `json:"usageUnit"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuotaTariffCreateResponse) UnmarshalJSON(b []byte) error {
+	type alias QuotaTariffCreateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QuotaTariffDeleteParams struct {
@@ -1104,6 +1440,44 @@ func (p *QuotaTariffDeleteParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaTariffDelete API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaTariffDeleteParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaTariffDelete", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaTariffDelete API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaTariffDeleteParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("quotaTariffDelete", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaTariffDelete API, before the request is signed
+func (p *QuotaTariffDeleteParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaTariffDeleteParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaTariffDeleteParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaTariffDelete", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaTariffDeleteParams) Clone() *QuotaTariffDeleteParams {
+	return &QuotaTariffDeleteParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaTariffDeleteParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1136,13 +1510,22 @@ func (s *QuotaService) NewQuotaTariffDeleteParams(id string) *QuotaTariffDeleteP
 
 // Marks a quota tariff as removed.
 func (s *QuotaService) QuotaTariffDelete(p *QuotaTariffDeleteParams) (*QuotaTariffDeleteResponse, error) {
-	resp, err := s.cs.newPostRequest("quotaTariffDelete", p.toURLValues())
+	return s.QuotaTariffDeleteWithContext(context.Background(), p)
+}
+
+// QuotaTariffDeleteWithContext is like QuotaTariffDelete, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaTariffDeleteWithContext(ctx context.Context, p *QuotaTariffDeleteParams) (*QuotaTariffDeleteResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "quotaTariffDelete", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaTariffDeleteResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaTariffDelete", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1150,10 +1533,11 @@ func (s *QuotaService) QuotaTariffDelete(p *QuotaTariffDeleteParams) (*QuotaTari
 }
 
 type QuotaTariffDeleteResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *QuotaTariffDeleteResponse) UnmarshalJSON(b []byte) error {
@@ -1180,7 +1564,7 @@ func (r *QuotaTariffDeleteResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias QuotaTariffDeleteResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QuotaTariffListParams struct {
@@ -1230,6 +1614,52 @@ func (p *QuotaTariffListParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaTariffList API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaTariffListParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaTariffList", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaTariffList API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaTariffListParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "id": 255, "keyword": 255, "name": 255, "startdate": 255},
+	}.validate("quotaTariffList", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaTariffList API, before the request is signed
+func (p *QuotaTariffListParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaTariffListParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaTariffListParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaTariffList", b, &p.p, map[string]paramDecoder{
+		"enddate":         decodeParam[string],
+		"id":              decodeParam[string],
+		"keyword":         decodeParam[string],
+		"listall":         decodeParam[bool],
+		"listonlyremoved": decodeParam[bool],
+		"name":            decodeParam[string],
+		"page":            decodeParam[int],
+		"pagesize":        decodeParam[int],
+		"startdate":       decodeParam[string],
+		"usagetype":       decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaTariffListParams) Clone() *QuotaTariffListParams {
+	return &QuotaTariffListParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaTariffListParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1450,13 +1880,22 @@ func (s *QuotaService) NewQuotaTariffListParams() *QuotaTariffListParams {
 
 // Lists all quota tariff plans
 func (s *QuotaService) QuotaTariffList(p *QuotaTariffListParams) (*QuotaTariffListResponse, error) {
-	resp, err := s.cs.newRequest("quotaTariffList", p.toURLValues())
+	return s.QuotaTariffListWithContext(context.Background(), p)
+}
+
+// QuotaTariffListWithContext is like QuotaTariffList, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaTariffListWithContext(ctx context.Context, p *QuotaTariffListParams) (*QuotaTariffListResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "quotaTariffList", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaTariffListResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaTariffList", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1469,23 +1908,29 @@ type QuotaTariffListResponse struct {
 }
 
 type QuotaTariffList struct {
-	ActivationRule       string  `json:"activationRule"`
-	Currency             string  `json:"currency"`
-	Description          string  `json:"description"`
-	EffectiveDate        string  `json:"effectiveDate"`
-	EndDate              string  `json:"endDate"`
-	Id                   string  `json:"id"`
-	JobID                string  `json:"jobid"`
-	Jobstatus            int     `json:"jobstatus"`
-	Name                 string  `json:"name"`
-	Position             int     `json:"position"`
-	Removed              string  `json:"removed"`
-	TariffValue          float64 `json:"tariffValue"`
-	UsageDiscriminator   string  `json:"usageDiscriminator"`
-	UsageName            string  `json:"usageName"`
-	UsageType            int     `json:"usageType"`
-	UsageTypeDescription string  `json:"usageTypeDescription"`
-	UsageUnit            string  `json:"usageUnit"`
+	ActivationRule       string                     `json:"activationRule"`
+	Currency             string                     `json:"currency"`
+	Description          string                     `json:"description"`
+	EffectiveDate        string                     `json:"effectiveDate"`
+	EndDate              string                     `json:"endDate"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Name                 string                     `json:"name"`
+	Position             FlexInt                    `json:"position"`
+	Removed              Time                       `json:"removed"`
+	TariffValue          FlexFloat                  `json:"tariffValue"`
+	UsageDiscriminator   string                     `json:"usageDiscriminator"`
+	UsageName            string                     `json:"usageName"`
+	UsageType            FlexInt                    `json:"usageType"`
+	UsageTypeDescription string                     `json:"usageTypeDescription"`
+	UsageUnit            string                     `json:"usageUnit"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuotaTariffList) UnmarshalJSON(b []byte) error {
+	type alias QuotaTariffList
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QuotaTariffUpdateParams struct {
@@ -1527,6 +1972,51 @@ func (p *QuotaTariffUpdateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaTariffUpdate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaTariffUpdateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaTariffUpdate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaTariffUpdate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaTariffUpdateParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"activationrule": 255, "description": 4096, "enddate": 255, "name": 255, "startdate": 255},
+	}.validate("quotaTariffUpdate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaTariffUpdate API, before the request is signed
+func (p *QuotaTariffUpdateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaTariffUpdateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaTariffUpdateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaTariffUpdate", b, &p.p, map[string]paramDecoder{
+		"activationrule": decodeParam[string],
+		"description":    decodeParam[string],
+		"enddate":        decodeParam[string],
+		"name":           decodeParam[string],
+		"position":       decodeParam[int],
+		"startdate":      decodeParam[string],
+		"usagetype":      decodeParam[int],
+		"value":          decodeParam[float64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaTariffUpdateParams) Clone() *QuotaTariffUpdateParams {
+	return &QuotaTariffUpdateParams{p: cloneParams(p.p)}
+}
+
 func (p *QuotaTariffUpdateParams) SetActivationrule(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1706,13 +2196,22 @@ func (s *QuotaService) NewQuotaTariffUpdateParams(name string) *QuotaTariffUpdat
 
 // Update the tariff plan for a resource
 func (s *QuotaService) QuotaTariffUpdate(p *QuotaTariffUpdateParams) (*QuotaTariffUpdateResponse, error) {
-	resp, err := s.cs.newPostRequest("quotaTariffUpdate", p.toURLValues())
+	return s.QuotaTariffUpdateWithContext(context.Background(), p)
+}
+
+// QuotaTariffUpdateWithContext is like QuotaTariffUpdate, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaTariffUpdateWithContext(ctx context.Context, p *QuotaTariffUpdateParams) (*QuotaTariffUpdateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "quotaTariffUpdate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaTariffUpdateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaTariffUpdate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1720,23 +2219,29 @@ func (s *QuotaService) QuotaTariffUpdate(p *QuotaTariffUpdateParams) (*QuotaTari
 }
 
 type QuotaTariffUpdateResponse struct {
-	ActivationRule       string  `json:"activationRule"`
-	Currency             string  `json:"currency"`
-	Description          string  `json:"description"`
-	EffectiveDate        string  `json:"effectiveDate"`
-	EndDate              string  `json:"endDate"`
-	Id                   string  `json:"id"`
-	JobID                string  `json:"jobid"`
-	Jobstatus            int     `json:"jobstatus"`
-	Name                 string  `json:"name"`
-	Position             int     `json:"position"`
-	Removed              string  `json:"removed"`
-	TariffValue          float64 `json:"tariffValue"`
-	UsageDiscriminator   string  `json:"usageDiscriminator"`
-	UsageName            string  `json:"usageName"`
-	UsageType            int     `json:"usageType"`
-	UsageTypeDescription string  `json:"usageTypeDescription"`
-	UsageUnit            string  `json:"usageUnit"`
+	ActivationRule       string                     `json:"activationRule"`
+	Currency             string                     `json:"currency"`
+	Description          string                     `json:"description"`
+	EffectiveDate        string                     `json:"effectiveDate"`
+	EndDate              string                     `json:"endDate"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Name                 string                     `json:"name"`
+	Position             FlexInt                    `json:"position"`
+	Removed              Time                       `json:"removed"`
+	TariffValue          FlexFloat                  `json:"tariffValue"`
+	UsageDiscriminator   string                     `json:"usageDiscriminator"`
+	UsageName            string                     `json:"usageName"`
+	UsageType            FlexInt                    `json:"usageType"`
+	UsageTypeDescription string                     `json:"usageTypeDescription"`
+	UsageUnit            string                     `json:"usageUnit"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuotaTariffUpdateResponse) UnmarshalJSON(b []byte) error {
+	type alias QuotaTariffUpdateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type QuotaUpdateParams struct {
@@ -1751,6 +2256,39 @@ func (p *QuotaUpdateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the quotaUpdate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *QuotaUpdateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("quotaUpdate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the quotaUpdate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *QuotaUpdateParams) Validate() error {
+	return paramRules{}.validate("quotaUpdate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the quotaUpdate API, before the request is signed
+func (p *QuotaUpdateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *QuotaUpdateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *QuotaUpdateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("quotaUpdate", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *QuotaUpdateParams) Clone() *QuotaUpdateParams {
+	return &QuotaUpdateParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new QuotaUpdateParams instance,
 // as then you are sure you have configured all required params
 func (s *QuotaService) NewQuotaUpdateParams() *QuotaUpdateParams {
@@ -1761,13 +2299,22 @@ func (s *QuotaService) NewQuotaUpdateParams() *QuotaUpdateParams {
 
 // Update quota calculations, alerts and statements
 func (s *QuotaService) QuotaUpdate(p *QuotaUpdateParams) (*QuotaUpdateResponse, error) {
-	resp, err := s.cs.newPostRequest("quotaUpdate", p.toURLValues())
+	return s.QuotaUpdateWithContext(context.Background(), p)
+}
+
+// QuotaUpdateWithContext is like QuotaUpdate, but honours the cancellation and deadline of ctx
+func (s *QuotaService) QuotaUpdateWithContext(ctx context.Context, p *QuotaUpdateParams) (*QuotaUpdateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "quotaUpdate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r QuotaUpdateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("quotaUpdate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1775,7 +2322,13 @@ func (s *QuotaService) QuotaUpdate(p *QuotaUpdateParams) (*QuotaUpdateResponse,
 }
 
 type QuotaUpdateResponse struct {
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Updated_on string `json:"updated_on"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Updated_on string                     `json:"updated_on"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *QuotaUpdateResponse) UnmarshalJSON(b []byte) error {
+	type alias QuotaUpdateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -213,6 +214,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaBalance(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaBalance", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaBalance), p)
 }
 
+// QuotaBalanceWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaBalanceWithContext(ctx context.Context, p *QuotaBalanceParams) (*QuotaBalanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaBalanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaBalanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaBalanceWithContext indicates an expected call of QuotaBalanceWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaBalanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaBalanceWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaBalanceWithContext), ctx, p)
+}
+
 // QuotaCredits mocks base method.
 func (m *MockQuotaServiceIface) QuotaCredits(p *QuotaCreditsParams) (*QuotaCreditsResponse, error) {
 	m.ctrl.T.Helper()
@@ -228,6 +244,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaCredits(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaCredits", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaCredits), p)
 }
 
+// QuotaCreditsWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaCreditsWithContext(ctx context.Context, p *QuotaCreditsParams) (*QuotaCreditsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaCreditsWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaCreditsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaCreditsWithContext indicates an expected call of QuotaCreditsWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaCreditsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaCreditsWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaCreditsWithContext), ctx, p)
+}
+
 // QuotaIsEnabled mocks base method.
 func (m *MockQuotaServiceIface) QuotaIsEnabled(p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
 	m.ctrl.T.Helper()
@@ -243,6 +274,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaIsEnabled(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaIsEnabled", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaIsEnabled), p)
 }
 
+// QuotaIsEnabledWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaIsEnabledWithContext(ctx context.Context, p *QuotaIsEnabledParams) (*QuotaIsEnabledResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaIsEnabledWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaIsEnabledResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaIsEnabledWithContext indicates an expected call of QuotaIsEnabledWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaIsEnabledWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaIsEnabledWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaIsEnabledWithContext), ctx, p)
+}
+
 // QuotaStatement mocks base method.
 func (m *MockQuotaServiceIface) QuotaStatement(p *QuotaStatementParams) (*QuotaStatementResponse, error) {
 	m.ctrl.T.Helper()
@@ -258,6 +304,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaStatement(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaStatement", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaStatement), p)
 }
 
+// QuotaStatementWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaStatementWithContext(ctx context.Context, p *QuotaStatementParams) (*QuotaStatementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaStatementWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaStatementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaStatementWithContext indicates an expected call of QuotaStatementWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaStatementWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaStatementWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaStatementWithContext), ctx, p)
+}
+
 // QuotaSummary mocks base method.
 func (m *MockQuotaServiceIface) QuotaSummary(p *QuotaSummaryParams) (*QuotaSummaryResponse, error) {
 	m.ctrl.T.Helper()
@@ -273,6 +334,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaSummary(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaSummary", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaSummary), p)
 }
 
+// QuotaSummaryWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaSummaryWithContext(ctx context.Context, p *QuotaSummaryParams) (*QuotaSummaryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaSummaryWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaSummaryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaSummaryWithContext indicates an expected call of QuotaSummaryWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaSummaryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaSummaryWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaSummaryWithContext), ctx, p)
+}
+
 // QuotaTariffCreate mocks base method.
 func (m *MockQuotaServiceIface) QuotaTariffCreate(p *QuotaTariffCreateParams) (*QuotaTariffCreateResponse, error) {
 	m.ctrl.T.Helper()
@@ -288,6 +364,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffCreate(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffCreate", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffCreate), p)
 }
 
+// QuotaTariffCreateWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffCreateWithContext(ctx context.Context, p *QuotaTariffCreateParams) (*QuotaTariffCreateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaTariffCreateWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaTariffCreateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffCreateWithContext indicates an expected call of QuotaTariffCreateWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffCreateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffCreateWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffCreateWithContext), ctx, p)
+}
+
 // QuotaTariffDelete mocks base method.
 func (m *MockQuotaServiceIface) QuotaTariffDelete(p *QuotaTariffDeleteParams) (*QuotaTariffDeleteResponse, error) {
 	m.ctrl.T.Helper()
@@ -303,6 +394,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffDelete(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffDelete", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffDelete), p)
 }
 
+// QuotaTariffDeleteWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffDeleteWithContext(ctx context.Context, p *QuotaTariffDeleteParams) (*QuotaTariffDeleteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaTariffDeleteWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaTariffDeleteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffDeleteWithContext indicates an expected call of QuotaTariffDeleteWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffDeleteWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffDeleteWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffDeleteWithContext), ctx, p)
+}
+
 // QuotaTariffList mocks base method.
 func (m *MockQuotaServiceIface) QuotaTariffList(p *QuotaTariffListParams) (*QuotaTariffListResponse, error) {
 	m.ctrl.T.Helper()
@@ -318,6 +424,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffList(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffList", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffList), p)
 }
 
+// QuotaTariffListWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffListWithContext(ctx context.Context, p *QuotaTariffListParams) (*QuotaTariffListResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaTariffListWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaTariffListResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffListWithContext indicates an expected call of QuotaTariffListWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffListWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffListWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffListWithContext), ctx, p)
+}
+
 // QuotaTariffUpdate mocks base method.
 func (m *MockQuotaServiceIface) QuotaTariffUpdate(p *QuotaTariffUpdateParams) (*QuotaTariffUpdateResponse, error) {
 	m.ctrl.T.Helper()
@@ -333,6 +454,21 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffUpdate(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffUpdate", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffUpdate), p)
 }
 
+// QuotaTariffUpdateWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaTariffUpdateWithContext(ctx context.Context, p *QuotaTariffUpdateParams) (*QuotaTariffUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaTariffUpdateWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaTariffUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaTariffUpdateWithContext indicates an expected call of QuotaTariffUpdateWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaTariffUpdateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaTariffUpdateWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaTariffUpdateWithContext), ctx, p)
+}
+
 // QuotaUpdate mocks base method.
 func (m *MockQuotaServiceIface) QuotaUpdate(p *QuotaUpdateParams) (*QuotaUpdateResponse, error) {
 	m.ctrl.T.Helper()
@@ -347,3 +483,18 @@ func (mr *MockQuotaServiceIfaceMockRecorder) QuotaUpdate(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaUpdate", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaUpdate), p)
 }
+
+// QuotaUpdateWithContext mocks base method.
+func (m *MockQuotaServiceIface) QuotaUpdateWithContext(ctx context.Context, p *QuotaUpdateParams) (*QuotaUpdateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuotaUpdateWithContext", ctx, p)
+	ret0, _ := ret[0].(*QuotaUpdateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// QuotaUpdateWithContext indicates an expected call of QuotaUpdateWithContext.
+func (mr *MockQuotaServiceIfaceMockRecorder) QuotaUpdateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuotaUpdateWithContext", reflect.TypeOf((*MockQuotaServiceIface)(nil).QuotaUpdateWithContext), ctx, p)
+}
@@ -20,19 +20,29 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type RegionServiceIface interface {
 	AddRegion(p *AddRegionParams) (*AddRegionResponse, error)
+	AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error)
 	NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams
 	ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error)
+	ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error)
+	ListRegionsAll(p *ListRegionsParams, opts ...PageOption) ([]*Region, error)
+	ListRegionsAllWithContext(ctx context.Context, p *ListRegionsParams, opts ...PageOption) ([]*Region, error)
+	ListRegionsIter(p *ListRegionsParams, opts ...PageOption) iter.Seq2[*Region, error]
+	ListRegionsIterWithContext(ctx context.Context, p *ListRegionsParams, opts ...PageOption) iter.Seq2[*Region, error]
 	NewListRegionsParams() *ListRegionsParams
 	RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error)
+	RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error)
 	NewRemoveRegionParams(id int) *RemoveRegionParams
 	UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error)
+	UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error)
 	NewUpdateRegionParams(id int) *UpdateRegionParams
 }
 
@@ -58,6 +68,46 @@ func (p *AddRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addRegion API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddRegionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addRegion", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addRegion API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddRegionParams) Validate() error {
+	return paramRules{
+		required: []string{"endpoint", "id", "name"},
+		lengths:  map[string]int{"endpoint": 255, "name": 255},
+	}.validate("addRegion", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addRegion API, before the request is signed
+func (p *AddRegionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddRegionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddRegionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addRegion", b, &p.p, map[string]paramDecoder{
+		"endpoint": decodeParam[string],
+		"id":       decodeParam[int],
+		"name":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddRegionParams) Clone() *AddRegionParams {
+	return &AddRegionParams{p: cloneParams(p.p)}
+}
+
 func (p *AddRegionParams) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -134,13 +184,22 @@ func (s *RegionService) NewAddRegionParams(endpoint string, id int, name string)
 
 // Adds a Region
 func (s *RegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error) {
-	resp, err := s.cs.newPostRequest("addRegion", p.toURLValues())
+	return s.AddRegionWithContext(context.Background(), p)
+}
+
+// AddRegionWithContext is like AddRegion, but honours the cancellation and deadline of ctx
+func (s *RegionService) AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addRegion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddRegionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addRegion", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -148,13 +207,19 @@ func (s *RegionService) AddRegion(p *AddRegionParams) (*AddRegionResponse, error
 }
 
 type AddRegionResponse struct {
-	Endpoint                 string `json:"endpoint"`
-	Gslbserviceenabled       bool   `json:"gslbserviceenabled"`
-	Id                       int    `json:"id"`
-	JobID                    string `json:"jobid"`
-	Jobstatus                int    `json:"jobstatus"`
-	Name                     string `json:"name"`
-	Portableipserviceenabled bool   `json:"portableipserviceenabled"`
+	Endpoint                 string                     `json:"endpoint"`
+	Gslbserviceenabled       FlexBool                   `json:"gslbserviceenabled"`
+	Id                       FlexInt                    `json:"id"`
+	JobID                    string                     `json:"jobid"`
+	Jobstatus                FlexInt                    `json:"jobstatus"`
+	Name                     string                     `json:"name"`
+	Portableipserviceenabled FlexBool                   `json:"portableipserviceenabled"`
+	Extra                    map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddRegionResponse) UnmarshalJSON(b []byte) error {
+	type alias AddRegionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListRegionsParams struct {
@@ -187,6 +252,47 @@ func (p *ListRegionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listRegions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListRegionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listRegions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listRegions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListRegionsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "name": 255},
+	}.validate("listRegions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listRegions API, before the request is signed
+func (p *ListRegionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListRegionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListRegionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listRegions", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[int],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListRegionsParams) Clone() *ListRegionsParams {
+	return &ListRegionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListRegionsParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -302,32 +408,75 @@ func (s *RegionService) NewListRegionsParams() *ListRegionsParams {
 
 // Lists Regions
 func (s *RegionService) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
-	resp, err := s.cs.newRequest("listRegions", p.toURLValues())
+	return s.ListRegionsWithContext(context.Background(), p)
+}
+
+// ListRegionsWithContext is like ListRegions, but honours the cancellation and deadline of ctx
+func (s *RegionService) ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listRegions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListRegionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listRegions", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListRegionsAll returns all Regions matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *RegionService) ListRegionsAll(p *ListRegionsParams, opts ...PageOption) ([]*Region, error) {
+	return s.ListRegionsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListRegionsAllWithContext is like ListRegionsAll, but honours the cancellation and deadline of ctx
+func (s *RegionService) ListRegionsAllWithContext(ctx context.Context, p *ListRegionsParams, opts ...PageOption) ([]*Region, error) {
+	return collectPages(s.ListRegionsIterWithContext(ctx, p, opts...))
+}
+
+// ListRegionsIter returns an iterator over all Regions matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *RegionService) ListRegionsIter(p *ListRegionsParams, opts ...PageOption) iter.Seq2[*Region, error] {
+	return s.ListRegionsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListRegionsIterWithContext is like ListRegionsIter, but honours the cancellation and deadline of ctx
+func (s *RegionService) ListRegionsIterWithContext(ctx context.Context, p *ListRegionsParams, opts ...PageOption) iter.Seq2[*Region, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Region, int, error) {
+		l, err := s.ListRegionsWithContext(ctx, &ListRegionsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Regions, l.Count, nil
+	}, opts...)
+}
+
 type ListRegionsResponse struct {
 	Count   int       `json:"count"`
 	Regions []*Region `json:"region"`
 }
 
 type Region struct {
-	Endpoint                 string `json:"endpoint"`
-	Gslbserviceenabled       bool   `json:"gslbserviceenabled"`
-	Id                       int    `json:"id"`
-	JobID                    string `json:"jobid"`
-	Jobstatus                int    `json:"jobstatus"`
-	Name                     string `json:"name"`
-	Portableipserviceenabled bool   `json:"portableipserviceenabled"`
+	Endpoint                 string                     `json:"endpoint"`
+	Gslbserviceenabled       FlexBool                   `json:"gslbserviceenabled"`
+	Id                       FlexInt                    `json:"id"`
+	JobID                    string                     `json:"jobid"`
+	Jobstatus                FlexInt                    `json:"jobstatus"`
+	Name                     string                     `json:"name"`
+	Portableipserviceenabled FlexBool                   `json:"portableipserviceenabled"`
+	Extra                    map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Region) UnmarshalJSON(b []byte) error {
+	type alias Region
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveRegionParams struct {
@@ -346,6 +495,43 @@ func (p *RemoveRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeRegion API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveRegionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeRegion", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeRegion API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveRegionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+	}.validate("removeRegion", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeRegion API, before the request is signed
+func (p *RemoveRegionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveRegionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveRegionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeRegion", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveRegionParams) Clone() *RemoveRegionParams {
+	return &RemoveRegionParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveRegionParams) SetId(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -378,13 +564,22 @@ func (s *RegionService) NewRemoveRegionParams(id int) *RemoveRegionParams {
 
 // Removes specified region
 func (s *RegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionResponse, error) {
-	resp, err := s.cs.newPostRequest("removeRegion", p.toURLValues())
+	return s.RemoveRegionWithContext(context.Background(), p)
+}
+
+// RemoveRegionWithContext is like RemoveRegion, but honours the cancellation and deadline of ctx
+func (s *RegionService) RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeRegion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveRegionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeRegion", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -392,10 +587,11 @@ func (s *RegionService) RemoveRegion(p *RemoveRegionParams) (*RemoveRegionRespon
 }
 
 type RemoveRegionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RemoveRegionResponse) UnmarshalJSON(b []byte) error {
@@ -422,7 +618,7 @@ func (r *RemoveRegionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RemoveRegionResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateRegionParams struct {
@@ -447,6 +643,46 @@ func (p *UpdateRegionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateRegion API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateRegionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateRegion", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateRegion API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateRegionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"endpoint": 255, "name": 255},
+	}.validate("updateRegion", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateRegion API, before the request is signed
+func (p *UpdateRegionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateRegionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateRegionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateRegion", b, &p.p, map[string]paramDecoder{
+		"endpoint": decodeParam[string],
+		"id":       decodeParam[int],
+		"name":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateRegionParams) Clone() *UpdateRegionParams {
+	return &UpdateRegionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateRegionParams) SetEndpoint(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -521,13 +757,22 @@ func (s *RegionService) NewUpdateRegionParams(id int) *UpdateRegionParams {
 
 // Updates a region
 func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateRegion", p.toURLValues())
+	return s.UpdateRegionWithContext(context.Background(), p)
+}
+
+// UpdateRegionWithContext is like UpdateRegion, but honours the cancellation and deadline of ctx
+func (s *RegionService) UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateRegion", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateRegionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateRegion", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -535,11 +780,17 @@ func (s *RegionService) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionRespon
 }
 
 type UpdateRegionResponse struct {
-	Endpoint                 string `json:"endpoint"`
-	Gslbserviceenabled       bool   `json:"gslbserviceenabled"`
-	Id                       int    `json:"id"`
-	JobID                    string `json:"jobid"`
-	Jobstatus                int    `json:"jobstatus"`
-	Name                     string `json:"name"`
-	Portableipserviceenabled bool   `json:"portableipserviceenabled"`
+	Endpoint                 string                     `json:"endpoint"`
+	Gslbserviceenabled       FlexBool                   `json:"gslbserviceenabled"`
+	Id                       FlexInt                    `json:"id"`
+	JobID                    string                     `json:"jobid"`
+	Jobstatus                FlexInt                    `json:"jobstatus"`
+	Name                     string                     `json:"name"`
+	Portableipserviceenabled FlexBool                   `json:"portableipserviceenabled"`
+	Extra                    map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateRegionResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateRegionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockRegionServiceIfaceMockRecorder) AddRegion(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).AddRegion), p)
 }
 
+// AddRegionWithContext mocks base method.
+func (m *MockRegionServiceIface) AddRegionWithContext(ctx context.Context, p *AddRegionParams) (*AddRegionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddRegionWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddRegionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddRegionWithContext indicates an expected call of AddRegionWithContext.
+func (mr *MockRegionServiceIfaceMockRecorder) AddRegionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddRegionWithContext", reflect.TypeOf((*MockRegionServiceIface)(nil).AddRegionWithContext), ctx, p)
+}
+
 // ListRegions mocks base method.
 func (m *MockRegionServiceIface) ListRegions(p *ListRegionsParams) (*ListRegionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,99 @@ func (mr *MockRegionServiceIfaceMockRecorder) ListRegions(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegions", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegions), p)
 }
 
+// ListRegionsAll mocks base method.
+func (m *MockRegionServiceIface) ListRegionsAll(p *ListRegionsParams, opts ...PageOption) ([]*Region, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegionsAll", varargs...)
+	ret0, _ := ret[0].([]*Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegionsAll indicates an expected call of ListRegionsAll.
+func (mr *MockRegionServiceIfaceMockRecorder) ListRegionsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegionsAll", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegionsAll), varargs...)
+}
+
+// ListRegionsAllWithContext mocks base method.
+func (m *MockRegionServiceIface) ListRegionsAllWithContext(ctx context.Context, p *ListRegionsParams, opts ...PageOption) ([]*Region, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegionsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Region)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegionsAllWithContext indicates an expected call of ListRegionsAllWithContext.
+func (mr *MockRegionServiceIfaceMockRecorder) ListRegionsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegionsAllWithContext", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegionsAllWithContext), varargs...)
+}
+
+// ListRegionsIter mocks base method.
+func (m *MockRegionServiceIface) ListRegionsIter(p *ListRegionsParams, opts ...PageOption) iter.Seq2[*Region, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegionsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Region, error])
+	return ret0
+}
+
+// ListRegionsIter indicates an expected call of ListRegionsIter.
+func (mr *MockRegionServiceIfaceMockRecorder) ListRegionsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegionsIter", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegionsIter), varargs...)
+}
+
+// ListRegionsIterWithContext mocks base method.
+func (m *MockRegionServiceIface) ListRegionsIterWithContext(ctx context.Context, p *ListRegionsParams, opts ...PageOption) iter.Seq2[*Region, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRegionsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Region, error])
+	return ret0
+}
+
+// ListRegionsIterWithContext indicates an expected call of ListRegionsIterWithContext.
+func (mr *MockRegionServiceIfaceMockRecorder) ListRegionsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegionsIterWithContext", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegionsIterWithContext), varargs...)
+}
+
+// ListRegionsWithContext mocks base method.
+func (m *MockRegionServiceIface) ListRegionsWithContext(ctx context.Context, p *ListRegionsParams) (*ListRegionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRegionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListRegionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRegionsWithContext indicates an expected call of ListRegionsWithContext.
+func (mr *MockRegionServiceIfaceMockRecorder) ListRegionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRegionsWithContext", reflect.TypeOf((*MockRegionServiceIface)(nil).ListRegionsWithContext), ctx, p)
+}
+
 // NewAddRegionParams mocks base method.
 func (m *MockRegionServiceIface) NewAddRegionParams(endpoint string, id int, name string) *AddRegionParams {
 	m.ctrl.T.Helper()
@@ -159,6 +269,21 @@ func (mr *MockRegionServiceIfaceMockRecorder) RemoveRegion(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).RemoveRegion), p)
 }
 
+// RemoveRegionWithContext mocks base method.
+func (m *MockRegionServiceIface) RemoveRegionWithContext(ctx context.Context, p *RemoveRegionParams) (*RemoveRegionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRegionWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveRegionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRegionWithContext indicates an expected call of RemoveRegionWithContext.
+func (mr *MockRegionServiceIfaceMockRecorder) RemoveRegionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRegionWithContext", reflect.TypeOf((*MockRegionServiceIface)(nil).RemoveRegionWithContext), ctx, p)
+}
+
 // UpdateRegion mocks base method.
 func (m *MockRegionServiceIface) UpdateRegion(p *UpdateRegionParams) (*UpdateRegionResponse, error) {
 	m.ctrl.T.Helper()
@@ -173,3 +298,18 @@ func (mr *MockRegionServiceIfaceMockRecorder) UpdateRegion(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegion", reflect.TypeOf((*MockRegionServiceIface)(nil).UpdateRegion), p)
 }
+
+// UpdateRegionWithContext mocks base method.
+func (m *MockRegionServiceIface) UpdateRegionWithContext(ctx context.Context, p *UpdateRegionParams) (*UpdateRegionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRegionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateRegionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRegionWithContext indicates an expected call of UpdateRegionWithContext.
+func (mr *MockRegionServiceIfaceMockRecorder) UpdateRegionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRegionWithContext", reflect.TypeOf((*MockRegionServiceIface)(nil).UpdateRegionWithContext), ctx, p)
+}
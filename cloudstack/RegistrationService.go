@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -28,6 +29,7 @@ import (
 
 type RegistrationServiceIface interface {
 	RegisterOauthProvider(p *RegisterOauthProviderParams) (*RegisterOauthProviderResponse, error)
+	RegisterOauthProviderWithContext(ctx context.Context, p *RegisterOauthProviderParams) (*RegisterOauthProviderResponse, error)
 	NewRegisterOauthProviderParams(clientid string, description string, provider string, redirecturi string, secretkey string) *RegisterOauthProviderParams
 }
 
@@ -66,6 +68,49 @@ func (p *RegisterOauthProviderParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerOauthProvider API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterOauthProviderParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerOauthProvider", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerOauthProvider API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterOauthProviderParams) Validate() error {
+	return paramRules{
+		required: []string{"clientid", "description", "provider", "redirecturi", "secretkey"},
+		lengths:  map[string]int{"clientid": 255, "description": 4096, "provider": 255, "redirecturi": 255, "secretkey": 255},
+	}.validate("registerOauthProvider", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerOauthProvider API, before the request is signed
+func (p *RegisterOauthProviderParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterOauthProviderParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterOauthProviderParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerOauthProvider", b, &p.p, map[string]paramDecoder{
+		"clientid":    decodeParam[string],
+		"description": decodeParam[string],
+		"details":     decodeParam[[]map[string]string],
+		"provider":    decodeParam[string],
+		"redirecturi": decodeParam[string],
+		"secretkey":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterOauthProviderParams) Clone() *RegisterOauthProviderParams {
+	return &RegisterOauthProviderParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterOauthProviderParams) SetClientid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -221,13 +266,22 @@ func (s *RegistrationService) NewRegisterOauthProviderParams(clientid string, de
 
 // Register the OAuth2 provider in CloudStack
 func (s *RegistrationService) RegisterOauthProvider(p *RegisterOauthProviderParams) (*RegisterOauthProviderResponse, error) {
-	resp, err := s.cs.newPostRequest("registerOauthProvider", p.toURLValues())
+	return s.RegisterOauthProviderWithContext(context.Background(), p)
+}
+
+// RegisterOauthProviderWithContext is like RegisterOauthProvider, but honours the cancellation and deadline of ctx
+func (s *RegistrationService) RegisterOauthProviderWithContext(ctx context.Context, p *RegisterOauthProviderParams) (*RegisterOauthProviderResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerOauthProvider", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterOauthProviderResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerOauthProvider", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -235,10 +289,11 @@ func (s *RegistrationService) RegisterOauthProvider(p *RegisterOauthProviderPara
 }
 
 type RegisterOauthProviderResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RegisterOauthProviderResponse) UnmarshalJSON(b []byte) error {
@@ -265,5 +320,5 @@ func (r *RegisterOauthProviderResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RegisterOauthProviderResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -86,3 +87,18 @@ func (mr *MockRegistrationServiceIfaceMockRecorder) RegisterOauthProvider(p any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterOauthProvider", reflect.TypeOf((*MockRegistrationServiceIface)(nil).RegisterOauthProvider), p)
 }
+
+// RegisterOauthProviderWithContext mocks base method.
+func (m *MockRegistrationServiceIface) RegisterOauthProviderWithContext(ctx context.Context, p *RegisterOauthProviderParams) (*RegisterOauthProviderResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterOauthProviderWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterOauthProviderResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterOauthProviderWithContext indicates an expected call of RegisterOauthProviderWithContext.
+func (mr *MockRegistrationServiceIfaceMockRecorder) RegisterOauthProviderWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterOauthProviderWithContext", reflect.TypeOf((*MockRegistrationServiceIface)(nil).RegisterOauthProviderWithContext), ctx, p)
+}
@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -28,10 +29,13 @@ import (
 
 type ResourceIconServiceIface interface {
 	DeleteResourceIcon(p *DeleteResourceIconParams) (*DeleteResourceIconResponse, error)
+	DeleteResourceIconWithContext(ctx context.Context, p *DeleteResourceIconParams) (*DeleteResourceIconResponse, error)
 	NewDeleteResourceIconParams(resourceids []string, resourcetype string) *DeleteResourceIconParams
 	ListResourceIcon(p *ListResourceIconParams) (*ListResourceIconResponse, error)
+	ListResourceIconWithContext(ctx context.Context, p *ListResourceIconParams) (*ListResourceIconResponse, error)
 	NewListResourceIconParams(resourceids []string, resourcetype string) *ListResourceIconParams
 	UploadResourceIcon(p *UploadResourceIconParams) (*UploadResourceIconResponse, error)
+	UploadResourceIconWithContext(ctx context.Context, p *UploadResourceIconParams) (*UploadResourceIconResponse, error)
 	NewUploadResourceIconParams(base64image string, resourceids []string, resourcetype string) *UploadResourceIconParams
 }
 
@@ -54,6 +58,45 @@ func (p *DeleteResourceIconParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteResourceIcon API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteResourceIconParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteResourceIcon", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteResourceIcon API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteResourceIconParams) Validate() error {
+	return paramRules{
+		required: []string{"resourceids", "resourcetype"},
+		lengths:  map[string]int{"resourcetype": 255},
+	}.validate("deleteResourceIcon", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteResourceIcon API, before the request is signed
+func (p *DeleteResourceIconParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteResourceIconParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteResourceIconParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteResourceIcon", b, &p.p, map[string]paramDecoder{
+		"resourceids":  decodeParam[[]string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteResourceIconParams) Clone() *DeleteResourceIconParams {
+	return &DeleteResourceIconParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteResourceIconParams) SetResourceids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -108,13 +151,22 @@ func (s *ResourceIconService) NewDeleteResourceIconParams(resourceids []string,
 
 // Deletes the resource icon from the specified resource(s)
 func (s *ResourceIconService) DeleteResourceIcon(p *DeleteResourceIconParams) (*DeleteResourceIconResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteResourceIcon", p.toURLValues())
+	return s.DeleteResourceIconWithContext(context.Background(), p)
+}
+
+// DeleteResourceIconWithContext is like DeleteResourceIcon, but honours the cancellation and deadline of ctx
+func (s *ResourceIconService) DeleteResourceIconWithContext(ctx context.Context, p *DeleteResourceIconParams) (*DeleteResourceIconResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteResourceIcon", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteResourceIconResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteResourceIcon", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -122,10 +174,11 @@ func (s *ResourceIconService) DeleteResourceIcon(p *DeleteResourceIconParams) (*
 }
 
 type DeleteResourceIconResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteResourceIconResponse) UnmarshalJSON(b []byte) error {
@@ -152,7 +205,7 @@ func (r *DeleteResourceIconResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteResourceIconResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListResourceIconParams struct {
@@ -174,6 +227,45 @@ func (p *ListResourceIconParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listResourceIcon API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListResourceIconParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listResourceIcon", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listResourceIcon API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListResourceIconParams) Validate() error {
+	return paramRules{
+		required: []string{"resourceids", "resourcetype"},
+		lengths:  map[string]int{"resourcetype": 255},
+	}.validate("listResourceIcon", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listResourceIcon API, before the request is signed
+func (p *ListResourceIconParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListResourceIconParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListResourceIconParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listResourceIcon", b, &p.p, map[string]paramDecoder{
+		"resourceids":  decodeParam[[]string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListResourceIconParams) Clone() *ListResourceIconParams {
+	return &ListResourceIconParams{p: cloneParams(p.p)}
+}
+
 func (p *ListResourceIconParams) SetResourceids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -228,13 +320,22 @@ func (s *ResourceIconService) NewListResourceIconParams(resourceids []string, re
 
 // Lists the resource icon for the specified resource(s)
 func (s *ResourceIconService) ListResourceIcon(p *ListResourceIconParams) (*ListResourceIconResponse, error) {
-	resp, err := s.cs.newRequest("listResourceIcon", p.toURLValues())
+	return s.ListResourceIconWithContext(context.Background(), p)
+}
+
+// ListResourceIconWithContext is like ListResourceIcon, but honours the cancellation and deadline of ctx
+func (s *ResourceIconService) ListResourceIconWithContext(ctx context.Context, p *ListResourceIconParams) (*ListResourceIconResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listResourceIcon", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListResourceIconResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listResourceIcon", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -247,11 +348,17 @@ type ListResourceIconResponse struct {
 }
 
 type ResourceIcon struct {
-	Base64image  string `json:"base64image"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Resourceid   string `json:"resourceid"`
-	Resourcetype string `json:"resourcetype"`
+	Base64image  string                     `json:"base64image"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Resourceid   string                     `json:"resourceid"`
+	Resourcetype string                     `json:"resourcetype"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResourceIcon) UnmarshalJSON(b []byte) error {
+	type alias ResourceIcon
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UploadResourceIconParams struct {
@@ -276,6 +383,46 @@ func (p *UploadResourceIconParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the uploadResourceIcon API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UploadResourceIconParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("uploadResourceIcon", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the uploadResourceIcon API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UploadResourceIconParams) Validate() error {
+	return paramRules{
+		required: []string{"base64image", "resourceids", "resourcetype"},
+		lengths:  map[string]int{"base64image": 255, "resourcetype": 255},
+	}.validate("uploadResourceIcon", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the uploadResourceIcon API, before the request is signed
+func (p *UploadResourceIconParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UploadResourceIconParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UploadResourceIconParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("uploadResourceIcon", b, &p.p, map[string]paramDecoder{
+		"base64image":  decodeParam[string],
+		"resourceids":  decodeParam[[]string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UploadResourceIconParams) Clone() *UploadResourceIconParams {
+	return &UploadResourceIconParams{p: cloneParams(p.p)}
+}
+
 func (p *UploadResourceIconParams) SetBase64image(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -352,13 +499,22 @@ func (s *ResourceIconService) NewUploadResourceIconParams(base64image string, re
 
 // Uploads an icon for the specified resource(s)
 func (s *ResourceIconService) UploadResourceIcon(p *UploadResourceIconParams) (*UploadResourceIconResponse, error) {
-	resp, err := s.cs.newPostRequest("uploadResourceIcon", p.toURLValues())
+	return s.UploadResourceIconWithContext(context.Background(), p)
+}
+
+// UploadResourceIconWithContext is like UploadResourceIcon, but honours the cancellation and deadline of ctx
+func (s *ResourceIconService) UploadResourceIconWithContext(ctx context.Context, p *UploadResourceIconParams) (*UploadResourceIconResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "uploadResourceIcon", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UploadResourceIconResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("uploadResourceIcon", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -366,10 +522,11 @@ func (s *ResourceIconService) UploadResourceIcon(p *UploadResourceIconParams) (*
 }
 
 type UploadResourceIconResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UploadResourceIconResponse) UnmarshalJSON(b []byte) error {
@@ -396,5 +553,5 @@ func (r *UploadResourceIconResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UploadResourceIconResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
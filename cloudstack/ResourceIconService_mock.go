@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockResourceIconServiceIfaceMockRecorder) DeleteResourceIcon(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceIcon", reflect.TypeOf((*MockResourceIconServiceIface)(nil).DeleteResourceIcon), p)
 }
 
+// DeleteResourceIconWithContext mocks base method.
+func (m *MockResourceIconServiceIface) DeleteResourceIconWithContext(ctx context.Context, p *DeleteResourceIconParams) (*DeleteResourceIconResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteResourceIconWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteResourceIconResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteResourceIconWithContext indicates an expected call of DeleteResourceIconWithContext.
+func (mr *MockResourceIconServiceIfaceMockRecorder) DeleteResourceIconWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteResourceIconWithContext", reflect.TypeOf((*MockResourceIconServiceIface)(nil).DeleteResourceIconWithContext), ctx, p)
+}
+
 // ListResourceIcon mocks base method.
 func (m *MockResourceIconServiceIface) ListResourceIcon(p *ListResourceIconParams) (*ListResourceIconResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +104,21 @@ func (mr *MockResourceIconServiceIfaceMockRecorder) ListResourceIcon(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceIcon", reflect.TypeOf((*MockResourceIconServiceIface)(nil).ListResourceIcon), p)
 }
 
+// ListResourceIconWithContext mocks base method.
+func (m *MockResourceIconServiceIface) ListResourceIconWithContext(ctx context.Context, p *ListResourceIconParams) (*ListResourceIconResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceIconWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListResourceIconResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceIconWithContext indicates an expected call of ListResourceIconWithContext.
+func (mr *MockResourceIconServiceIfaceMockRecorder) ListResourceIconWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceIconWithContext", reflect.TypeOf((*MockResourceIconServiceIface)(nil).ListResourceIconWithContext), ctx, p)
+}
+
 // NewDeleteResourceIconParams mocks base method.
 func (m *MockResourceIconServiceIface) NewDeleteResourceIconParams(resourceids []string, resourcetype string) *DeleteResourceIconParams {
 	m.ctrl.T.Helper()
@@ -144,3 +175,18 @@ func (mr *MockResourceIconServiceIfaceMockRecorder) UploadResourceIcon(p any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadResourceIcon", reflect.TypeOf((*MockResourceIconServiceIface)(nil).UploadResourceIcon), p)
 }
+
+// UploadResourceIconWithContext mocks base method.
+func (m *MockResourceIconServiceIface) UploadResourceIconWithContext(ctx context.Context, p *UploadResourceIconParams) (*UploadResourceIconResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadResourceIconWithContext", ctx, p)
+	ret0, _ := ret[0].(*UploadResourceIconResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadResourceIconWithContext indicates an expected call of UploadResourceIconWithContext.
+func (mr *MockResourceIconServiceIfaceMockRecorder) UploadResourceIconWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadResourceIconWithContext", reflect.TypeOf((*MockResourceIconServiceIface)(nil).UploadResourceIconWithContext), ctx, p)
+}
@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -27,6 +28,8 @@ import (
 
 type ResourceServiceIface interface {
 	PurgeExpungedResources(p *PurgeExpungedResourcesParams) (*PurgeExpungedResourcesResponse, error)
+	PurgeExpungedResourcesWithContext(ctx context.Context, p *PurgeExpungedResourcesParams) (*PurgeExpungedResourcesResponse, error)
+	PurgeExpungedResourcesAsync(ctx context.Context, p *PurgeExpungedResourcesParams) (*Job, error)
 	NewPurgeExpungedResourcesParams() *PurgeExpungedResourcesParams
 }
 
@@ -55,6 +58,46 @@ func (p *PurgeExpungedResourcesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the purgeExpungedResources API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *PurgeExpungedResourcesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("purgeExpungedResources", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the purgeExpungedResources API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *PurgeExpungedResourcesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "resourcetype": 255, "startdate": 255},
+	}.validate("purgeExpungedResources", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the purgeExpungedResources API, before the request is signed
+func (p *PurgeExpungedResourcesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *PurgeExpungedResourcesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *PurgeExpungedResourcesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("purgeExpungedResources", b, &p.p, map[string]paramDecoder{
+		"batchsize":    decodeParam[int64],
+		"enddate":      decodeParam[string],
+		"resourcetype": decodeParam[string],
+		"startdate":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *PurgeExpungedResourcesParams) Clone() *PurgeExpungedResourcesParams {
+	return &PurgeExpungedResourcesParams{p: cloneParams(p.p)}
+}
+
 func (p *PurgeExpungedResourcesParams) SetBatchsize(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -149,27 +192,39 @@ func (s *ResourceService) NewPurgeExpungedResourcesParams() *PurgeExpungedResour
 
 // Purge expunged resources
 func (s *ResourceService) PurgeExpungedResources(p *PurgeExpungedResourcesParams) (*PurgeExpungedResourcesResponse, error) {
-	resp, err := s.cs.newPostRequest("purgeExpungedResources", p.toURLValues())
+	return s.PurgeExpungedResourcesWithContext(context.Background(), p)
+}
+
+// PurgeExpungedResourcesWithContext is like PurgeExpungedResources, but honours the cancellation and deadline of ctx
+func (s *ResourceService) PurgeExpungedResourcesWithContext(ctx context.Context, p *PurgeExpungedResourcesParams) (*PurgeExpungedResourcesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "purgeExpungedResources", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r PurgeExpungedResourcesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("purgeExpungedResources", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "purgeExpungedResources", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("purgeExpungedResources", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -177,9 +232,29 @@ func (s *ResourceService) PurgeExpungedResources(p *PurgeExpungedResourcesParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished PurgeExpungedResources async job into r
+func (r *PurgeExpungedResourcesResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// PurgeExpungedResourcesAsync starts the async job of PurgeExpungedResources without waiting for it to finish, and returns a handle to the job
+func (s *ResourceService) PurgeExpungedResourcesAsync(ctx context.Context, p *PurgeExpungedResourcesParams) (*Job, error) {
+	r, err := s.PurgeExpungedResourcesWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("purgeExpungedResources", r.JobID), nil
+}
+
 type PurgeExpungedResourcesResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PurgeExpungedResourcesResponse) UnmarshalJSON(b []byte) error {
+	type alias PurgeExpungedResourcesResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -86,3 +87,33 @@ func (mr *MockResourceServiceIfaceMockRecorder) PurgeExpungedResources(p any) *g
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpungedResources", reflect.TypeOf((*MockResourceServiceIface)(nil).PurgeExpungedResources), p)
 }
+
+// PurgeExpungedResourcesAsync mocks base method.
+func (m *MockResourceServiceIface) PurgeExpungedResourcesAsync(ctx context.Context, p *PurgeExpungedResourcesParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpungedResourcesAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpungedResourcesAsync indicates an expected call of PurgeExpungedResourcesAsync.
+func (mr *MockResourceServiceIfaceMockRecorder) PurgeExpungedResourcesAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpungedResourcesAsync", reflect.TypeOf((*MockResourceServiceIface)(nil).PurgeExpungedResourcesAsync), ctx, p)
+}
+
+// PurgeExpungedResourcesWithContext mocks base method.
+func (m *MockResourceServiceIface) PurgeExpungedResourcesWithContext(ctx context.Context, p *PurgeExpungedResourcesParams) (*PurgeExpungedResourcesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PurgeExpungedResourcesWithContext", ctx, p)
+	ret0, _ := ret[0].(*PurgeExpungedResourcesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PurgeExpungedResourcesWithContext indicates an expected call of PurgeExpungedResourcesWithContext.
+func (mr *MockResourceServiceIfaceMockRecorder) PurgeExpungedResourcesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PurgeExpungedResourcesWithContext", reflect.TypeOf((*MockResourceServiceIface)(nil).PurgeExpungedResourcesWithContext), ctx, p)
+}
@@ -20,22 +20,35 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type ResourcemetadataServiceIface interface {
 	AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error)
+	AddResourceDetailAsync(ctx context.Context, p *AddResourceDetailParams) (*Job, error)
 	NewAddResourceDetailParams(details map[string]string, resourceid string, resourcetype string) *AddResourceDetailParams
 	ListDetailOptions(p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error)
+	ListDetailOptionsWithContext(ctx context.Context, p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error)
 	NewListDetailOptionsParams(resourcetype string) *ListDetailOptionsParams
 	GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error)
+	GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error)
 	NewGetVolumeSnapshotDetailsParams(snapshotid string) *GetVolumeSnapshotDetailsParams
 	ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error)
+	ListResourceDetailsAll(p *ListResourceDetailsParams, opts ...PageOption) ([]*ResourceDetail, error)
+	ListResourceDetailsAllWithContext(ctx context.Context, p *ListResourceDetailsParams, opts ...PageOption) ([]*ResourceDetail, error)
+	ListResourceDetailsIter(p *ListResourceDetailsParams, opts ...PageOption) iter.Seq2[*ResourceDetail, error]
+	ListResourceDetailsIterWithContext(ctx context.Context, p *ListResourceDetailsParams, opts ...PageOption) iter.Seq2[*ResourceDetail, error]
 	NewListResourceDetailsParams(resourcetype string) *ListResourceDetailsParams
 	RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error)
+	RemoveResourceDetailAsync(ctx context.Context, p *RemoveResourceDetailParams) (*Job, error)
 	NewRemoveResourceDetailParams(resourceid string, resourcetype string) *RemoveResourceDetailParams
 }
 
@@ -68,6 +81,47 @@ func (p *AddResourceDetailParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addResourceDetail API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddResourceDetailParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addResourceDetail", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addResourceDetail API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddResourceDetailParams) Validate() error {
+	return paramRules{
+		required: []string{"details", "resourceid", "resourcetype"},
+		lengths:  map[string]int{"resourceid": 255, "resourcetype": 255},
+	}.validate("addResourceDetail", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addResourceDetail API, before the request is signed
+func (p *AddResourceDetailParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddResourceDetailParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddResourceDetailParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addResourceDetail", b, &p.p, map[string]paramDecoder{
+		"details":      decodeParam[map[string]string],
+		"fordisplay":   decodeParam[bool],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddResourceDetailParams) Clone() *AddResourceDetailParams {
+	return &AddResourceDetailParams{p: cloneParams(p.p)}
+}
+
 func (p *AddResourceDetailParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,27 +219,39 @@ func (s *ResourcemetadataService) NewAddResourceDetailParams(details map[string]
 
 // Adds detail for the Resource.
 func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
-	resp, err := s.cs.newPostRequest("addResourceDetail", p.toURLValues())
+	return s.AddResourceDetailWithContext(context.Background(), p)
+}
+
+// AddResourceDetailWithContext is like AddResourceDetail, but honours the cancellation and deadline of ctx
+func (s *ResourcemetadataService) AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addResourceDetail", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddResourceDetailResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addResourceDetail", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addResourceDetail", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addResourceDetail", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -193,11 +259,31 @@ func (s *ResourcemetadataService) AddResourceDetail(p *AddResourceDetailParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddResourceDetail async job into r
+func (r *AddResourceDetailResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AddResourceDetailAsync starts the async job of AddResourceDetail without waiting for it to finish, and returns a handle to the job
+func (s *ResourcemetadataService) AddResourceDetailAsync(ctx context.Context, p *AddResourceDetailParams) (*Job, error) {
+	r, err := s.AddResourceDetailWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addResourceDetail", r.JobID), nil
+}
+
 type AddResourceDetailResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddResourceDetailResponse) UnmarshalJSON(b []byte) error {
+	type alias AddResourceDetailResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDetailOptionsParams struct {
@@ -218,6 +304,45 @@ func (p *ListDetailOptionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDetailOptions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDetailOptionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDetailOptions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDetailOptions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDetailOptionsParams) Validate() error {
+	return paramRules{
+		required: []string{"resourcetype"},
+		lengths:  map[string]int{"resourceid": 255, "resourcetype": 255},
+	}.validate("listDetailOptions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDetailOptions API, before the request is signed
+func (p *ListDetailOptionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDetailOptionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDetailOptionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDetailOptions", b, &p.p, map[string]paramDecoder{
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDetailOptionsParams) Clone() *ListDetailOptionsParams {
+	return &ListDetailOptionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDetailOptionsParams) SetResourceid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -271,13 +396,22 @@ func (s *ResourcemetadataService) NewListDetailOptionsParams(resourcetype string
 
 // Lists all possible details and their options for a resource type such as an Instance or a Template
 func (s *ResourcemetadataService) ListDetailOptions(p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error) {
-	resp, err := s.cs.newRequest("listDetailOptions", p.toURLValues())
+	return s.ListDetailOptionsWithContext(context.Background(), p)
+}
+
+// ListDetailOptionsWithContext is like ListDetailOptions, but honours the cancellation and deadline of ctx
+func (s *ResourcemetadataService) ListDetailOptionsWithContext(ctx context.Context, p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDetailOptions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDetailOptionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDetailOptions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -290,9 +424,15 @@ type ListDetailOptionsResponse struct {
 }
 
 type DetailOption struct {
-	Details   map[string]string `json:"details"`
-	JobID     string            `json:"jobid"`
-	Jobstatus int               `json:"jobstatus"`
+	Details   map[string]string          `json:"details"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DetailOption) UnmarshalJSON(b []byte) error {
+	type alias DetailOption
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetVolumeSnapshotDetailsParams struct {
@@ -310,6 +450,44 @@ func (p *GetVolumeSnapshotDetailsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getVolumeSnapshotDetails API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetVolumeSnapshotDetailsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getVolumeSnapshotDetails", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getVolumeSnapshotDetails API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetVolumeSnapshotDetailsParams) Validate() error {
+	return paramRules{
+		required: []string{"snapshotid"},
+		lengths:  map[string]int{"snapshotid": 255},
+	}.validate("getVolumeSnapshotDetails", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getVolumeSnapshotDetails API, before the request is signed
+func (p *GetVolumeSnapshotDetailsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetVolumeSnapshotDetailsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetVolumeSnapshotDetailsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getVolumeSnapshotDetails", b, &p.p, map[string]paramDecoder{
+		"snapshotid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetVolumeSnapshotDetailsParams) Clone() *GetVolumeSnapshotDetailsParams {
+	return &GetVolumeSnapshotDetailsParams{p: cloneParams(p.p)}
+}
+
 func (p *GetVolumeSnapshotDetailsParams) SetSnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -342,13 +520,22 @@ func (s *ResourcemetadataService) NewGetVolumeSnapshotDetailsParams(snapshotid s
 
 // Get Volume Snapshot Details
 func (s *ResourcemetadataService) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeSnapshotDetails", p.toURLValues())
+	return s.GetVolumeSnapshotDetailsWithContext(context.Background(), p)
+}
+
+// GetVolumeSnapshotDetailsWithContext is like GetVolumeSnapshotDetails, but honours the cancellation and deadline of ctx
+func (s *ResourcemetadataService) GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getVolumeSnapshotDetails", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetVolumeSnapshotDetailsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getVolumeSnapshotDetails", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -356,9 +543,15 @@ func (s *ResourcemetadataService) GetVolumeSnapshotDetails(p *GetVolumeSnapshotD
 }
 
 type GetVolumeSnapshotDetailsResponse struct {
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	VolumeiScsiName string `json:"volumeiScsiName"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	VolumeiScsiName string                     `json:"volumeiScsiName"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetVolumeSnapshotDetailsResponse) UnmarshalJSON(b []byte) error {
+	type alias GetVolumeSnapshotDetailsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListResourceDetailsParams struct {
@@ -417,6 +610,56 @@ func (p *ListResourceDetailsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listResourceDetails API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListResourceDetailsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listResourceDetails", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listResourceDetails API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListResourceDetailsParams) Validate() error {
+	return paramRules{
+		required: []string{"resourcetype"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "key": 255, "keyword": 255, "projectid": 255, "resourceid": 255, "resourcetype": 255, "value": 4096},
+	}.validate("listResourceDetails", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listResourceDetails API, before the request is signed
+func (p *ListResourceDetailsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListResourceDetailsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListResourceDetailsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listResourceDetails", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"domainid":     decodeParam[string],
+		"fordisplay":   decodeParam[bool],
+		"isrecursive":  decodeParam[bool],
+		"key":          decodeParam[string],
+		"keyword":      decodeParam[string],
+		"listall":      decodeParam[bool],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"projectid":    decodeParam[string],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+		"value":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListResourceDetailsParams) Clone() *ListResourceDetailsParams {
+	return &ListResourceDetailsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListResourceDetailsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -701,38 +944,81 @@ func (s *ResourcemetadataService) NewListResourceDetailsParams(resourcetype stri
 
 // List resource detail(s)
 func (s *ResourcemetadataService) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
-	resp, err := s.cs.newRequest("listResourceDetails", p.toURLValues())
+	return s.ListResourceDetailsWithContext(context.Background(), p)
+}
+
+// ListResourceDetailsWithContext is like ListResourceDetails, but honours the cancellation and deadline of ctx
+func (s *ResourcemetadataService) ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listResourceDetails", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListResourceDetailsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listResourceDetails", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListResourceDetailsAll returns all ResourceDetails matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ResourcemetadataService) ListResourceDetailsAll(p *ListResourceDetailsParams, opts ...PageOption) ([]*ResourceDetail, error) {
+	return s.ListResourceDetailsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListResourceDetailsAllWithContext is like ListResourceDetailsAll, but honours the cancellation and deadline of ctx
+func (s *ResourcemetadataService) ListResourceDetailsAllWithContext(ctx context.Context, p *ListResourceDetailsParams, opts ...PageOption) ([]*ResourceDetail, error) {
+	return collectPages(s.ListResourceDetailsIterWithContext(ctx, p, opts...))
+}
+
+// ListResourceDetailsIter returns an iterator over all ResourceDetails matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ResourcemetadataService) ListResourceDetailsIter(p *ListResourceDetailsParams, opts ...PageOption) iter.Seq2[*ResourceDetail, error] {
+	return s.ListResourceDetailsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListResourceDetailsIterWithContext is like ListResourceDetailsIter, but honours the cancellation and deadline of ctx
+func (s *ResourcemetadataService) ListResourceDetailsIterWithContext(ctx context.Context, p *ListResourceDetailsParams, opts ...PageOption) iter.Seq2[*ResourceDetail, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ResourceDetail, int, error) {
+		l, err := s.ListResourceDetailsWithContext(ctx, &ListResourceDetailsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ResourceDetails, l.Count, nil
+	}, opts...)
+}
+
 type ListResourceDetailsResponse struct {
 	Count           int               `json:"count"`
 	ResourceDetails []*ResourceDetail `json:"resourcedetail"`
 }
 
 type ResourceDetail struct {
-	Account      string `json:"account"`
-	Customer     string `json:"customer"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Key          string `json:"key"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	Resourceid   string `json:"resourceid"`
-	Resourcetype string `json:"resourcetype"`
-	Value        string `json:"value"`
+	Account      string                     `json:"account"`
+	Customer     string                     `json:"customer"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Key          string                     `json:"key"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	Resourceid   string                     `json:"resourceid"`
+	Resourcetype string                     `json:"resourcetype"`
+	Value        string                     `json:"value"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResourceDetail) UnmarshalJSON(b []byte) error {
+	type alias ResourceDetail
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveResourceDetailParams struct {
@@ -756,6 +1042,46 @@ func (p *RemoveResourceDetailParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeResourceDetail API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveResourceDetailParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeResourceDetail", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeResourceDetail API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveResourceDetailParams) Validate() error {
+	return paramRules{
+		required: []string{"resourceid", "resourcetype"},
+		lengths:  map[string]int{"key": 255, "resourceid": 255, "resourcetype": 255},
+	}.validate("removeResourceDetail", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeResourceDetail API, before the request is signed
+func (p *RemoveResourceDetailParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveResourceDetailParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveResourceDetailParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeResourceDetail", b, &p.p, map[string]paramDecoder{
+		"key":          decodeParam[string],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveResourceDetailParams) Clone() *RemoveResourceDetailParams {
+	return &RemoveResourceDetailParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveResourceDetailParams) SetKey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -831,27 +1157,39 @@ func (s *ResourcemetadataService) NewRemoveResourceDetailParams(resourceid strin
 
 // Removes detail for the Resource.
 func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
-	resp, err := s.cs.newPostRequest("removeResourceDetail", p.toURLValues())
+	return s.RemoveResourceDetailWithContext(context.Background(), p)
+}
+
+// RemoveResourceDetailWithContext is like RemoveResourceDetail, but honours the cancellation and deadline of ctx
+func (s *ResourcemetadataService) RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeResourceDetail", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveResourceDetailResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeResourceDetail", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeResourceDetail", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeResourceDetail", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -859,9 +1197,29 @@ func (s *ResourcemetadataService) RemoveResourceDetail(p *RemoveResourceDetailPa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveResourceDetail async job into r
+func (r *RemoveResourceDetailResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveResourceDetailAsync starts the async job of RemoveResourceDetail without waiting for it to finish, and returns a handle to the job
+func (s *ResourcemetadataService) RemoveResourceDetailAsync(ctx context.Context, p *RemoveResourceDetailParams) (*Job, error) {
+	r, err := s.RemoveResourceDetailWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeResourceDetail", r.JobID), nil
+}
+
 type RemoveResourceDetailResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveResourceDetailResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveResourceDetailResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockResourcemetadataServiceIfaceMockRecorder) AddResourceDetail(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResourceDetail", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).AddResourceDetail), p)
 }
 
+// AddResourceDetailAsync mocks base method.
+func (m *MockResourcemetadataServiceIface) AddResourceDetailAsync(ctx context.Context, p *AddResourceDetailParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddResourceDetailAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddResourceDetailAsync indicates an expected call of AddResourceDetailAsync.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) AddResourceDetailAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResourceDetailAsync", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).AddResourceDetailAsync), ctx, p)
+}
+
+// AddResourceDetailWithContext mocks base method.
+func (m *MockResourcemetadataServiceIface) AddResourceDetailWithContext(ctx context.Context, p *AddResourceDetailParams) (*AddResourceDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddResourceDetailWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddResourceDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddResourceDetailWithContext indicates an expected call of AddResourceDetailWithContext.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) AddResourceDetailWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddResourceDetailWithContext", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).AddResourceDetailWithContext), ctx, p)
+}
+
 // GetVolumeSnapshotDetails mocks base method.
 func (m *MockResourcemetadataServiceIface) GetVolumeSnapshotDetails(p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,21 @@ func (mr *MockResourcemetadataServiceIfaceMockRecorder) GetVolumeSnapshotDetails
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshotDetails", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).GetVolumeSnapshotDetails), p)
 }
 
+// GetVolumeSnapshotDetailsWithContext mocks base method.
+func (m *MockResourcemetadataServiceIface) GetVolumeSnapshotDetailsWithContext(ctx context.Context, p *GetVolumeSnapshotDetailsParams) (*GetVolumeSnapshotDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeSnapshotDetailsWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetVolumeSnapshotDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeSnapshotDetailsWithContext indicates an expected call of GetVolumeSnapshotDetailsWithContext.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) GetVolumeSnapshotDetailsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeSnapshotDetailsWithContext", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).GetVolumeSnapshotDetailsWithContext), ctx, p)
+}
+
 // ListDetailOptions mocks base method.
 func (m *MockResourcemetadataServiceIface) ListDetailOptions(p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListDetailOptions(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetailOptions", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListDetailOptions), p)
 }
 
+// ListDetailOptionsWithContext mocks base method.
+func (m *MockResourcemetadataServiceIface) ListDetailOptionsWithContext(ctx context.Context, p *ListDetailOptionsParams) (*ListDetailOptionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDetailOptionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDetailOptionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDetailOptionsWithContext indicates an expected call of ListDetailOptionsWithContext.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListDetailOptionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDetailOptionsWithContext", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListDetailOptionsWithContext), ctx, p)
+}
+
 // ListResourceDetails mocks base method.
 func (m *MockResourcemetadataServiceIface) ListResourceDetails(p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,99 @@ func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetails(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetails", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetails), p)
 }
 
+// ListResourceDetailsAll mocks base method.
+func (m *MockResourcemetadataServiceIface) ListResourceDetailsAll(p *ListResourceDetailsParams, opts ...PageOption) ([]*ResourceDetail, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceDetailsAll", varargs...)
+	ret0, _ := ret[0].([]*ResourceDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceDetailsAll indicates an expected call of ListResourceDetailsAll.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetailsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetailsAll", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetailsAll), varargs...)
+}
+
+// ListResourceDetailsAllWithContext mocks base method.
+func (m *MockResourcemetadataServiceIface) ListResourceDetailsAllWithContext(ctx context.Context, p *ListResourceDetailsParams, opts ...PageOption) ([]*ResourceDetail, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceDetailsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ResourceDetail)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceDetailsAllWithContext indicates an expected call of ListResourceDetailsAllWithContext.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetailsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetailsAllWithContext", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetailsAllWithContext), varargs...)
+}
+
+// ListResourceDetailsIter mocks base method.
+func (m *MockResourcemetadataServiceIface) ListResourceDetailsIter(p *ListResourceDetailsParams, opts ...PageOption) iter.Seq2[*ResourceDetail, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceDetailsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ResourceDetail, error])
+	return ret0
+}
+
+// ListResourceDetailsIter indicates an expected call of ListResourceDetailsIter.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetailsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetailsIter", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetailsIter), varargs...)
+}
+
+// ListResourceDetailsIterWithContext mocks base method.
+func (m *MockResourcemetadataServiceIface) ListResourceDetailsIterWithContext(ctx context.Context, p *ListResourceDetailsParams, opts ...PageOption) iter.Seq2[*ResourceDetail, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListResourceDetailsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ResourceDetail, error])
+	return ret0
+}
+
+// ListResourceDetailsIterWithContext indicates an expected call of ListResourceDetailsIterWithContext.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetailsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetailsIterWithContext", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetailsIterWithContext), varargs...)
+}
+
+// ListResourceDetailsWithContext mocks base method.
+func (m *MockResourcemetadataServiceIface) ListResourceDetailsWithContext(ctx context.Context, p *ListResourceDetailsParams) (*ListResourceDetailsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListResourceDetailsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListResourceDetailsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListResourceDetailsWithContext indicates an expected call of ListResourceDetailsWithContext.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) ListResourceDetailsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListResourceDetailsWithContext", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).ListResourceDetailsWithContext), ctx, p)
+}
+
 // NewAddResourceDetailParams mocks base method.
 func (m *MockResourcemetadataServiceIface) NewAddResourceDetailParams(details map[string]string, resourceid, resourcetype string) *AddResourceDetailParams {
 	m.ctrl.T.Helper()
@@ -202,3 +357,33 @@ func (mr *MockResourcemetadataServiceIfaceMockRecorder) RemoveResourceDetail(p a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResourceDetail", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).RemoveResourceDetail), p)
 }
+
+// RemoveResourceDetailAsync mocks base method.
+func (m *MockResourcemetadataServiceIface) RemoveResourceDetailAsync(ctx context.Context, p *RemoveResourceDetailParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveResourceDetailAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveResourceDetailAsync indicates an expected call of RemoveResourceDetailAsync.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) RemoveResourceDetailAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResourceDetailAsync", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).RemoveResourceDetailAsync), ctx, p)
+}
+
+// RemoveResourceDetailWithContext mocks base method.
+func (m *MockResourcemetadataServiceIface) RemoveResourceDetailWithContext(ctx context.Context, p *RemoveResourceDetailParams) (*RemoveResourceDetailResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveResourceDetailWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveResourceDetailResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveResourceDetailWithContext indicates an expected call of RemoveResourceDetailWithContext.
+func (mr *MockResourcemetadataServiceIfaceMockRecorder) RemoveResourceDetailWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveResourceDetailWithContext", reflect.TypeOf((*MockResourcemetadataServiceIface)(nil).RemoveResourceDetailWithContext), ctx, p)
+}
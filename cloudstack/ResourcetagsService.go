@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,13 +31,28 @@ import (
 
 type ResourcetagsServiceIface interface {
 	CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error)
+	CreateTagsAsync(ctx context.Context, p *CreateTagsParams) (*Job, error)
 	NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams
 	DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error)
+	DeleteTagsAsync(ctx context.Context, p *DeleteTagsParams) (*Job, error)
 	NewDeleteTagsParams(resourceids []string, resourcetype string) *DeleteTagsParams
 	ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error)
+	ListStorageTagsAll(p *ListStorageTagsParams, opts ...PageOption) ([]*StorageTag, error)
+	ListStorageTagsAllWithContext(ctx context.Context, p *ListStorageTagsParams, opts ...PageOption) ([]*StorageTag, error)
+	ListStorageTagsIter(p *ListStorageTagsParams, opts ...PageOption) iter.Seq2[*StorageTag, error]
+	ListStorageTagsIterWithContext(ctx context.Context, p *ListStorageTagsParams, opts ...PageOption) iter.Seq2[*StorageTag, error]
 	NewListStorageTagsParams() *ListStorageTagsParams
 	GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetStorageTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	ListTags(p *ListTagsParams) (*ListTagsResponse, error)
+	ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error)
+	ListTagsAll(p *ListTagsParams, opts ...PageOption) ([]*Tag, error)
+	ListTagsAllWithContext(ctx context.Context, p *ListTagsParams, opts ...PageOption) ([]*Tag, error)
+	ListTagsIter(p *ListTagsParams, opts ...PageOption) iter.Seq2[*Tag, error]
+	ListTagsIterWithContext(ctx context.Context, p *ListTagsParams, opts ...PageOption) iter.Seq2[*Tag, error]
 	NewListTagsParams() *ListTagsParams
 }
 
@@ -68,6 +85,47 @@ func (p *CreateTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createTags API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateTagsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createTags", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createTags API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateTagsParams) Validate() error {
+	return paramRules{
+		required: []string{"resourceids", "resourcetype", "tags"},
+		lengths:  map[string]int{"customer": 255, "resourcetype": 255},
+	}.validate("createTags", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createTags API, before the request is signed
+func (p *CreateTagsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateTagsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateTagsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createTags", b, &p.p, map[string]paramDecoder{
+		"customer":     decodeParam[string],
+		"resourceids":  decodeParam[[]string],
+		"resourcetype": decodeParam[string],
+		"tags":         decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateTagsParams) Clone() *CreateTagsParams {
+	return &CreateTagsParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateTagsParams) SetCustomer(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -165,27 +223,39 @@ func (s *ResourcetagsService) NewCreateTagsParams(resourceids []string, resource
 
 // Creates resource tag(s)
 func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsResponse, error) {
-	resp, err := s.cs.newPostRequest("createTags", p.toURLValues())
+	return s.CreateTagsWithContext(context.Background(), p)
+}
+
+// CreateTagsWithContext is like CreateTags, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createTags", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createTags", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createTags", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -193,11 +263,31 @@ func (s *ResourcetagsService) CreateTags(p *CreateTagsParams) (*CreateTagsRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateTags async job into r
+func (r *CreateTagsResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// CreateTagsAsync starts the async job of CreateTags without waiting for it to finish, and returns a handle to the job
+func (s *ResourcetagsService) CreateTagsAsync(ctx context.Context, p *CreateTagsParams) (*Job, error) {
+	r, err := s.CreateTagsWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createTags", r.JobID), nil
+}
+
 type CreateTagsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateTagsResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateTagsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteTagsParams struct {
@@ -228,6 +318,46 @@ func (p *DeleteTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteTags API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteTagsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteTags", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteTags API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteTagsParams) Validate() error {
+	return paramRules{
+		required: []string{"resourceids", "resourcetype"},
+		lengths:  map[string]int{"resourcetype": 255},
+	}.validate("deleteTags", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteTags API, before the request is signed
+func (p *DeleteTagsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteTagsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteTagsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteTags", b, &p.p, map[string]paramDecoder{
+		"resourceids":  decodeParam[[]string],
+		"resourcetype": decodeParam[string],
+		"tags":         decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteTagsParams) Clone() *DeleteTagsParams {
+	return &DeleteTagsParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteTagsParams) SetResourceids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -303,27 +433,39 @@ func (s *ResourcetagsService) NewDeleteTagsParams(resourceids []string, resource
 
 // Deleting resource tag(s)
 func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteTags", p.toURLValues())
+	return s.DeleteTagsWithContext(context.Background(), p)
+}
+
+// DeleteTagsWithContext is like DeleteTags, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteTags", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteTags", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteTags", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -331,11 +473,31 @@ func (s *ResourcetagsService) DeleteTags(p *DeleteTagsParams) (*DeleteTagsRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteTags async job into r
+func (r *DeleteTagsResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteTagsAsync starts the async job of DeleteTags without waiting for it to finish, and returns a handle to the job
+func (s *ResourcetagsService) DeleteTagsAsync(ctx context.Context, p *DeleteTagsParams) (*Job, error) {
+	r, err := s.DeleteTagsWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteTags", r.JobID), nil
+}
+
 type DeleteTagsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteTagsResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteTagsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStorageTagsParams struct {
@@ -361,6 +523,45 @@ func (p *ListStorageTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStorageTags API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStorageTagsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStorageTags", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStorageTags API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStorageTagsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listStorageTags", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStorageTags API, before the request is signed
+func (p *ListStorageTagsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStorageTagsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStorageTagsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStorageTags", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStorageTagsParams) Clone() *ListStorageTagsParams {
+	return &ListStorageTagsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStorageTagsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -434,6 +635,11 @@ func (s *ResourcetagsService) NewListStorageTagsParams() *ListStorageTagsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetStorageTagIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetStorageTagIDWithContext is like GetStorageTagID, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) GetStorageTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStorageTagsParams{}
 	p.p = make(map[string]interface{})
 
@@ -445,7 +651,7 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListStorageTags(p)
+	l, err := s.ListStorageTagsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -470,30 +676,73 @@ func (s *ResourcetagsService) GetStorageTagID(keyword string, opts ...OptionFunc
 
 // Lists storage tags
 func (s *ResourcetagsService) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
-	resp, err := s.cs.newRequest("listStorageTags", p.toURLValues())
+	return s.ListStorageTagsWithContext(context.Background(), p)
+}
+
+// ListStorageTagsWithContext is like ListStorageTags, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStorageTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStorageTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStorageTags", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStorageTagsAll returns all StorageTags matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ResourcetagsService) ListStorageTagsAll(p *ListStorageTagsParams, opts ...PageOption) ([]*StorageTag, error) {
+	return s.ListStorageTagsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageTagsAllWithContext is like ListStorageTagsAll, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) ListStorageTagsAllWithContext(ctx context.Context, p *ListStorageTagsParams, opts ...PageOption) ([]*StorageTag, error) {
+	return collectPages(s.ListStorageTagsIterWithContext(ctx, p, opts...))
+}
+
+// ListStorageTagsIter returns an iterator over all StorageTags matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ResourcetagsService) ListStorageTagsIter(p *ListStorageTagsParams, opts ...PageOption) iter.Seq2[*StorageTag, error] {
+	return s.ListStorageTagsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageTagsIterWithContext is like ListStorageTagsIter, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) ListStorageTagsIterWithContext(ctx context.Context, p *ListStorageTagsParams, opts ...PageOption) iter.Seq2[*StorageTag, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StorageTag, int, error) {
+		l, err := s.ListStorageTagsWithContext(ctx, &ListStorageTagsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StorageTags, l.Count, nil
+	}, opts...)
+}
+
 type ListStorageTagsResponse struct {
 	Count       int           `json:"count"`
 	StorageTags []*StorageTag `json:"storagetag"`
 }
 
 type StorageTag struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Poolid    int64  `json:"poolid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Poolid    FlexInt64                  `json:"poolid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StorageTag) UnmarshalJSON(b []byte) error {
+	type alias StorageTag
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListTagsParams struct {
@@ -551,6 +800,55 @@ func (p *ListTagsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listTags API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListTagsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listTags", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listTags API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListTagsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "customer": 255, "domainid": 255, "key": 255, "keyword": 255, "projectid": 255, "resourceid": 255, "resourcetype": 255, "value": 4096},
+	}.validate("listTags", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listTags API, before the request is signed
+func (p *ListTagsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListTagsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListTagsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listTags", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"customer":     decodeParam[string],
+		"domainid":     decodeParam[string],
+		"isrecursive":  decodeParam[bool],
+		"key":          decodeParam[string],
+		"keyword":      decodeParam[string],
+		"listall":      decodeParam[bool],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"projectid":    decodeParam[string],
+		"resourceid":   decodeParam[string],
+		"resourcetype": decodeParam[string],
+		"value":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListTagsParams) Clone() *ListTagsParams {
+	return &ListTagsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListTagsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -834,36 +1132,79 @@ func (s *ResourcetagsService) NewListTagsParams() *ListTagsParams {
 
 // List resource tag(s)
 func (s *ResourcetagsService) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
-	resp, err := s.cs.newRequest("listTags", p.toURLValues())
+	return s.ListTagsWithContext(context.Background(), p)
+}
+
+// ListTagsWithContext is like ListTags, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listTags", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTagsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listTags", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListTagsAll returns all Tags matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ResourcetagsService) ListTagsAll(p *ListTagsParams, opts ...PageOption) ([]*Tag, error) {
+	return s.ListTagsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListTagsAllWithContext is like ListTagsAll, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) ListTagsAllWithContext(ctx context.Context, p *ListTagsParams, opts ...PageOption) ([]*Tag, error) {
+	return collectPages(s.ListTagsIterWithContext(ctx, p, opts...))
+}
+
+// ListTagsIter returns an iterator over all Tags matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ResourcetagsService) ListTagsIter(p *ListTagsParams, opts ...PageOption) iter.Seq2[*Tag, error] {
+	return s.ListTagsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListTagsIterWithContext is like ListTagsIter, but honours the cancellation and deadline of ctx
+func (s *ResourcetagsService) ListTagsIterWithContext(ctx context.Context, p *ListTagsParams, opts ...PageOption) iter.Seq2[*Tag, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Tag, int, error) {
+		l, err := s.ListTagsWithContext(ctx, &ListTagsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Tags, l.Count, nil
+	}, opts...)
+}
+
 type ListTagsResponse struct {
 	Count int    `json:"count"`
 	Tags  []*Tag `json:"tag"`
 }
 
 type Tag struct {
-	Account      string `json:"account"`
-	Customer     string `json:"customer"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Key          string `json:"key"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	Resourceid   string `json:"resourceid"`
-	Resourcetype string `json:"resourcetype"`
-	Value        string `json:"value"`
+	Account      string                     `json:"account"`
+	Customer     string                     `json:"customer"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Key          string                     `json:"key"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	Resourceid   string                     `json:"resourceid"`
+	Resourcetype string                     `json:"resourcetype"`
+	Value        string                     `json:"value"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Tag) UnmarshalJSON(b []byte) error {
+	type alias Tag
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockResourcetagsServiceIfaceMockRecorder) CreateTags(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).CreateTags), p)
 }
 
+// CreateTagsAsync mocks base method.
+func (m *MockResourcetagsServiceIface) CreateTagsAsync(ctx context.Context, p *CreateTagsParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTagsAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTagsAsync indicates an expected call of CreateTagsAsync.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) CreateTagsAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTagsAsync", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).CreateTagsAsync), ctx, p)
+}
+
+// CreateTagsWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) CreateTagsWithContext(ctx context.Context, p *CreateTagsParams) (*CreateTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTagsWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTagsWithContext indicates an expected call of CreateTagsWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) CreateTagsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTagsWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).CreateTagsWithContext), ctx, p)
+}
+
 // DeleteTags mocks base method.
 func (m *MockResourcetagsServiceIface) DeleteTags(p *DeleteTagsParams) (*DeleteTagsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockResourcetagsServiceIfaceMockRecorder) DeleteTags(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).DeleteTags), p)
 }
 
+// DeleteTagsAsync mocks base method.
+func (m *MockResourcetagsServiceIface) DeleteTagsAsync(ctx context.Context, p *DeleteTagsParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagsAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagsAsync indicates an expected call of DeleteTagsAsync.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) DeleteTagsAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagsAsync", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).DeleteTagsAsync), ctx, p)
+}
+
+// DeleteTagsWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) DeleteTagsWithContext(ctx context.Context, p *DeleteTagsParams) (*DeleteTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTagsWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTagsWithContext indicates an expected call of DeleteTagsWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) DeleteTagsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTagsWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).DeleteTagsWithContext), ctx, p)
+}
+
 // GetStorageTagID mocks base method.
 func (m *MockResourcetagsServiceIface) GetStorageTagID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +171,27 @@ func (mr *MockResourcetagsServiceIfaceMockRecorder) GetStorageTagID(keyword any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageTagID", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).GetStorageTagID), varargs...)
 }
 
+// GetStorageTagIDWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) GetStorageTagIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStorageTagIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStorageTagIDWithContext indicates an expected call of GetStorageTagIDWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) GetStorageTagIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStorageTagIDWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).GetStorageTagIDWithContext), varargs...)
+}
+
 // ListStorageTags mocks base method.
 func (m *MockResourcetagsServiceIface) ListStorageTags(p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +207,99 @@ func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTags(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTags), p)
 }
 
+// ListStorageTagsAll mocks base method.
+func (m *MockResourcetagsServiceIface) ListStorageTagsAll(p *ListStorageTagsParams, opts ...PageOption) ([]*StorageTag, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageTagsAll", varargs...)
+	ret0, _ := ret[0].([]*StorageTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageTagsAll indicates an expected call of ListStorageTagsAll.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTagsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTagsAll", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTagsAll), varargs...)
+}
+
+// ListStorageTagsAllWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) ListStorageTagsAllWithContext(ctx context.Context, p *ListStorageTagsParams, opts ...PageOption) ([]*StorageTag, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageTagsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StorageTag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageTagsAllWithContext indicates an expected call of ListStorageTagsAllWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTagsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTagsAllWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTagsAllWithContext), varargs...)
+}
+
+// ListStorageTagsIter mocks base method.
+func (m *MockResourcetagsServiceIface) ListStorageTagsIter(p *ListStorageTagsParams, opts ...PageOption) iter.Seq2[*StorageTag, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageTagsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageTag, error])
+	return ret0
+}
+
+// ListStorageTagsIter indicates an expected call of ListStorageTagsIter.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTagsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTagsIter", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTagsIter), varargs...)
+}
+
+// ListStorageTagsIterWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) ListStorageTagsIterWithContext(ctx context.Context, p *ListStorageTagsParams, opts ...PageOption) iter.Seq2[*StorageTag, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageTagsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageTag, error])
+	return ret0
+}
+
+// ListStorageTagsIterWithContext indicates an expected call of ListStorageTagsIterWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTagsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTagsIterWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTagsIterWithContext), varargs...)
+}
+
+// ListStorageTagsWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) ListStorageTagsWithContext(ctx context.Context, p *ListStorageTagsParams) (*ListStorageTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageTagsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStorageTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageTagsWithContext indicates an expected call of ListStorageTagsWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListStorageTagsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageTagsWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListStorageTagsWithContext), ctx, p)
+}
+
 // ListTags mocks base method.
 func (m *MockResourcetagsServiceIface) ListTags(p *ListTagsParams) (*ListTagsResponse, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +315,99 @@ func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTags(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTags", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTags), p)
 }
 
+// ListTagsAll mocks base method.
+func (m *MockResourcetagsServiceIface) ListTagsAll(p *ListTagsParams, opts ...PageOption) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsAll", varargs...)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsAll indicates an expected call of ListTagsAll.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTagsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsAll", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTagsAll), varargs...)
+}
+
+// ListTagsAllWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) ListTagsAllWithContext(ctx context.Context, p *ListTagsParams, opts ...PageOption) ([]*Tag, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Tag)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsAllWithContext indicates an expected call of ListTagsAllWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTagsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsAllWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTagsAllWithContext), varargs...)
+}
+
+// ListTagsIter mocks base method.
+func (m *MockResourcetagsServiceIface) ListTagsIter(p *ListTagsParams, opts ...PageOption) iter.Seq2[*Tag, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Tag, error])
+	return ret0
+}
+
+// ListTagsIter indicates an expected call of ListTagsIter.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTagsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsIter", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTagsIter), varargs...)
+}
+
+// ListTagsIterWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) ListTagsIterWithContext(ctx context.Context, p *ListTagsParams, opts ...PageOption) iter.Seq2[*Tag, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTagsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Tag, error])
+	return ret0
+}
+
+// ListTagsIterWithContext indicates an expected call of ListTagsIterWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTagsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsIterWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTagsIterWithContext), varargs...)
+}
+
+// ListTagsWithContext mocks base method.
+func (m *MockResourcetagsServiceIface) ListTagsWithContext(ctx context.Context, p *ListTagsParams) (*ListTagsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTagsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListTagsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTagsWithContext indicates an expected call of ListTagsWithContext.
+func (mr *MockResourcetagsServiceIfaceMockRecorder) ListTagsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTagsWithContext", reflect.TypeOf((*MockResourcetagsServiceIface)(nil).ListTagsWithContext), ctx, p)
+}
+
 // NewCreateTagsParams mocks base method.
 func (m *MockResourcetagsServiceIface) NewCreateTagsParams(resourceids []string, resourcetype string, tags map[string]string) *CreateTagsParams {
 	m.ctrl.T.Helper()
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,33 +31,57 @@ import (
 
 type RoleServiceIface interface {
 	CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error)
+	CreateRoleWithContext(ctx context.Context, p *CreateRoleParams) (*CreateRoleResponse, error)
 	NewCreateRoleParams(name string) *CreateRoleParams
 	CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error)
+	CreateRolePermissionWithContext(ctx context.Context, p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error)
 	NewCreateRolePermissionParams(permission string, roleid string, rule string) *CreateRolePermissionParams
 	DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error)
+	DeleteRoleWithContext(ctx context.Context, p *DeleteRoleParams) (*DeleteRoleResponse, error)
 	NewDeleteRoleParams(id string) *DeleteRoleParams
 	DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error)
+	DeleteRolePermissionWithContext(ctx context.Context, p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error)
 	NewDeleteRolePermissionParams(id string) *DeleteRolePermissionParams
 	DisableRole(p *DisableRoleParams) (*DisableRoleResponse, error)
+	DisableRoleWithContext(ctx context.Context, p *DisableRoleParams) (*DisableRoleResponse, error)
 	NewDisableRoleParams(id string) *DisableRoleParams
 	EnableRole(p *EnableRoleParams) (*EnableRoleResponse, error)
+	EnableRoleWithContext(ctx context.Context, p *EnableRoleParams) (*EnableRoleResponse, error)
 	NewEnableRoleParams(id string) *EnableRoleParams
 	ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error)
+	ImportRoleWithContext(ctx context.Context, p *ImportRoleParams) (*ImportRoleResponse, error)
 	NewImportRoleParams(name string, rules map[string]string) *ImportRoleParams
 	ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
+	ListRolePermissionsWithContext(ctx context.Context, p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error)
 	NewListRolePermissionsParams() *ListRolePermissionsParams
 	ListRoles(p *ListRolesParams) (*ListRolesResponse, error)
+	ListRolesWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error)
+	ListRolesAll(p *ListRolesParams, opts ...PageOption) ([]*Role, error)
+	ListRolesAllWithContext(ctx context.Context, p *ListRolesParams, opts ...PageOption) ([]*Role, error)
+	ListRolesIter(p *ListRolesParams, opts ...PageOption) iter.Seq2[*Role, error]
+	ListRolesIterWithContext(ctx context.Context, p *ListRolesParams, opts ...PageOption) iter.Seq2[*Role, error]
 	NewListRolesParams() *ListRolesParams
 	GetRoleID(name string, opts ...OptionFunc) (string, int, error)
+	GetRoleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Role, int, error)
 	GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error)
+	GetRoleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Role, int, error)
 	UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error)
+	UpdateRoleWithContext(ctx context.Context, p *UpdateRoleParams) (*UpdateRoleResponse, error)
 	NewUpdateRoleParams(id string) *UpdateRoleParams
 	UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error)
+	UpdateRolePermissionWithContext(ctx context.Context, p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error)
 	NewUpdateRolePermissionParams(roleid string) *UpdateRolePermissionParams
 	ListProjectRoles(p *ListProjectRolesParams) (*ListProjectRolesResponse, error)
+	ListProjectRolesWithContext(ctx context.Context, p *ListProjectRolesParams) (*ListProjectRolesResponse, error)
+	ListProjectRolesAll(p *ListProjectRolesParams, opts ...PageOption) ([]*ProjectRole, error)
+	ListProjectRolesAllWithContext(ctx context.Context, p *ListProjectRolesParams, opts ...PageOption) ([]*ProjectRole, error)
+	ListProjectRolesIter(p *ListProjectRolesParams, opts ...PageOption) iter.Seq2[*ProjectRole, error]
+	ListProjectRolesIterWithContext(ctx context.Context, p *ListProjectRolesParams, opts ...PageOption) iter.Seq2[*ProjectRole, error]
 	NewListProjectRolesParams(projectid string) *ListProjectRolesParams
 	GetProjectRoleID(name string, projectid string, opts ...OptionFunc) (string, int, error)
+	GetProjectRoleIDWithContext(ctx context.Context, name string, projectid string, opts ...OptionFunc) (string, int, error)
 }
 
 type CreateRoleParams struct {
@@ -86,6 +112,48 @@ func (p *CreateRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "roleid": 255, "type": 255},
+	}.validate("createRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createRole API, before the request is signed
+func (p *CreateRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createRole", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"ispublic":    decodeParam[bool],
+		"name":        decodeParam[string],
+		"roleid":      decodeParam[string],
+		"type":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateRoleParams) Clone() *CreateRoleParams {
+	return &CreateRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -202,7 +270,16 @@ func (s *RoleService) NewCreateRoleParams(name string) *CreateRoleParams {
 
 // Creates a role
 func (s *RoleService) CreateRole(p *CreateRoleParams) (*CreateRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("createRole", p.toURLValues())
+	return s.CreateRoleWithContext(context.Background(), p)
+}
+
+// CreateRoleWithContext is like CreateRole, but honours the cancellation and deadline of ctx
+func (s *RoleService) CreateRoleWithContext(ctx context.Context, p *CreateRoleParams) (*CreateRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -210,7 +287,7 @@ func (s *RoleService) CreateRole(p *CreateRoleParams) (*CreateRoleResponse, erro
 	var nested struct {
 		Response CreateRoleResponse `json:"role"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("createRole", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -219,15 +296,21 @@ func (s *RoleService) CreateRole(p *CreateRoleParams) (*CreateRoleResponse, erro
 }
 
 type CreateRoleResponse struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	Isdefault   bool   `json:"isdefault"`
-	Ispublic    bool   `json:"ispublic"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	State       string `json:"state"`
-	Type        string `json:"type"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	Isdefault   FlexBool                   `json:"isdefault"`
+	Ispublic    FlexBool                   `json:"ispublic"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	State       string                     `json:"state"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateRoleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateRoleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateRolePermissionParams struct {
@@ -254,6 +337,47 @@ func (p *CreateRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createRolePermission API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateRolePermissionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createRolePermission", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createRolePermission API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateRolePermissionParams) Validate() error {
+	return paramRules{
+		required: []string{"permission", "roleid", "rule"},
+		lengths:  map[string]int{"description": 4096, "permission": 255, "roleid": 255, "rule": 255},
+	}.validate("createRolePermission", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createRolePermission API, before the request is signed
+func (p *CreateRolePermissionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateRolePermissionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createRolePermission", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"permission":  decodeParam[string],
+		"roleid":      decodeParam[string],
+		"rule":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateRolePermissionParams) Clone() *CreateRolePermissionParams {
+	return &CreateRolePermissionParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateRolePermissionParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -351,7 +475,16 @@ func (s *RoleService) NewCreateRolePermissionParams(permission string, roleid st
 
 // Adds an API permission to a role
 func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
-	resp, err := s.cs.newPostRequest("createRolePermission", p.toURLValues())
+	return s.CreateRolePermissionWithContext(context.Background(), p)
+}
+
+// CreateRolePermissionWithContext is like CreateRolePermission, but honours the cancellation and deadline of ctx
+func (s *RoleService) CreateRolePermissionWithContext(ctx context.Context, p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createRolePermission", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -359,7 +492,7 @@ func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams) (*Crea
 	var nested struct {
 		Response CreateRolePermissionResponse `json:"rolepermission"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("createRolePermission", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -368,14 +501,20 @@ func (s *RoleService) CreateRolePermission(p *CreateRolePermissionParams) (*Crea
 }
 
 type CreateRolePermissionResponse struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Permission  string `json:"permission"`
-	Roleid      string `json:"roleid"`
-	Rolename    string `json:"rolename"`
-	Rule        string `json:"rule"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Permission  string                     `json:"permission"`
+	Roleid      string                     `json:"roleid"`
+	Rolename    string                     `json:"rolename"`
+	Rule        string                     `json:"rule"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateRolePermissionResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateRolePermissionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteRoleParams struct {
@@ -393,6 +532,44 @@ func (p *DeleteRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteRole API, before the request is signed
+func (p *DeleteRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteRole", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteRoleParams) Clone() *DeleteRoleParams {
+	return &DeleteRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -425,13 +602,22 @@ func (s *RoleService) NewDeleteRoleParams(id string) *DeleteRoleParams {
 
 // Deletes a role
 func (s *RoleService) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteRole", p.toURLValues())
+	return s.DeleteRoleWithContext(context.Background(), p)
+}
+
+// DeleteRoleWithContext is like DeleteRole, but honours the cancellation and deadline of ctx
+func (s *RoleService) DeleteRoleWithContext(ctx context.Context, p *DeleteRoleParams) (*DeleteRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteRoleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteRole", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -439,10 +625,11 @@ func (s *RoleService) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, erro
 }
 
 type DeleteRoleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteRoleResponse) UnmarshalJSON(b []byte) error {
@@ -469,7 +656,7 @@ func (r *DeleteRoleResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteRoleResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteRolePermissionParams struct {
@@ -487,6 +674,44 @@ func (p *DeleteRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteRolePermission API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteRolePermissionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteRolePermission", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteRolePermission API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteRolePermissionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteRolePermission", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteRolePermission API, before the request is signed
+func (p *DeleteRolePermissionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteRolePermissionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteRolePermission", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteRolePermissionParams) Clone() *DeleteRolePermissionParams {
+	return &DeleteRolePermissionParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteRolePermissionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -519,13 +744,22 @@ func (s *RoleService) NewDeleteRolePermissionParams(id string) *DeleteRolePermis
 
 // Deletes a role permission
 func (s *RoleService) DeleteRolePermission(p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteRolePermission", p.toURLValues())
+	return s.DeleteRolePermissionWithContext(context.Background(), p)
+}
+
+// DeleteRolePermissionWithContext is like DeleteRolePermission, but honours the cancellation and deadline of ctx
+func (s *RoleService) DeleteRolePermissionWithContext(ctx context.Context, p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteRolePermission", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteRolePermissionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteRolePermission", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -533,10 +767,11 @@ func (s *RoleService) DeleteRolePermission(p *DeleteRolePermissionParams) (*Dele
 }
 
 type DeleteRolePermissionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteRolePermissionResponse) UnmarshalJSON(b []byte) error {
@@ -563,7 +798,7 @@ func (r *DeleteRolePermissionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteRolePermissionResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableRoleParams struct {
@@ -581,6 +816,44 @@ func (p *DisableRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("disableRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableRole API, before the request is signed
+func (p *DisableRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableRole", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableRoleParams) Clone() *DisableRoleParams {
+	return &DisableRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -613,13 +886,22 @@ func (s *RoleService) NewDisableRoleParams(id string) *DisableRoleParams {
 
 // Disables a role
 func (s *RoleService) DisableRole(p *DisableRoleParams) (*DisableRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("disableRole", p.toURLValues())
+	return s.DisableRoleWithContext(context.Background(), p)
+}
+
+// DisableRoleWithContext is like DisableRole, but honours the cancellation and deadline of ctx
+func (s *RoleService) DisableRoleWithContext(ctx context.Context, p *DisableRoleParams) (*DisableRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "disableRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableRoleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableRole", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -627,10 +909,11 @@ func (s *RoleService) DisableRole(p *DisableRoleParams) (*DisableRoleResponse, e
 }
 
 type DisableRoleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DisableRoleResponse) UnmarshalJSON(b []byte) error {
@@ -657,7 +940,7 @@ func (r *DisableRoleResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DisableRoleResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableRoleParams struct {
@@ -675,6 +958,44 @@ func (p *EnableRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("enableRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableRole API, before the request is signed
+func (p *EnableRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableRole", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableRoleParams) Clone() *EnableRoleParams {
+	return &EnableRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableRoleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -707,13 +1028,22 @@ func (s *RoleService) NewEnableRoleParams(id string) *EnableRoleParams {
 
 // Enables a role
 func (s *RoleService) EnableRole(p *EnableRoleParams) (*EnableRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("enableRole", p.toURLValues())
+	return s.EnableRoleWithContext(context.Background(), p)
+}
+
+// EnableRoleWithContext is like EnableRole, but honours the cancellation and deadline of ctx
+func (s *RoleService) EnableRoleWithContext(ctx context.Context, p *EnableRoleParams) (*EnableRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "enableRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableRoleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableRole", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -721,10 +1051,11 @@ func (s *RoleService) EnableRole(p *EnableRoleParams) (*EnableRoleResponse, erro
 }
 
 type EnableRoleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *EnableRoleResponse) UnmarshalJSON(b []byte) error {
@@ -751,7 +1082,7 @@ func (r *EnableRoleResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias EnableRoleResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportRoleParams struct {
@@ -790,6 +1121,49 @@ func (p *ImportRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the importRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ImportRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("importRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the importRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ImportRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "rules"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "type": 255},
+	}.validate("importRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the importRole API, before the request is signed
+func (p *ImportRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ImportRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ImportRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("importRole", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"forced":      decodeParam[bool],
+		"ispublic":    decodeParam[bool],
+		"name":        decodeParam[string],
+		"rules":       decodeParam[map[string]string],
+		"type":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ImportRoleParams) Clone() *ImportRoleParams {
+	return &ImportRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *ImportRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -928,13 +1302,22 @@ func (s *RoleService) NewImportRoleParams(name string, rules map[string]string)
 
 // Imports a role based on provided map of rule permissions
 func (s *RoleService) ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("importRole", p.toURLValues())
+	return s.ImportRoleWithContext(context.Background(), p)
+}
+
+// ImportRoleWithContext is like ImportRole, but honours the cancellation and deadline of ctx
+func (s *RoleService) ImportRoleWithContext(ctx context.Context, p *ImportRoleParams) (*ImportRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "importRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ImportRoleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("importRole", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -942,15 +1325,21 @@ func (s *RoleService) ImportRole(p *ImportRoleParams) (*ImportRoleResponse, erro
 }
 
 type ImportRoleResponse struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	Isdefault   bool   `json:"isdefault"`
-	Ispublic    bool   `json:"ispublic"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	State       string `json:"state"`
-	Type        string `json:"type"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	Isdefault   FlexBool                   `json:"isdefault"`
+	Ispublic    FlexBool                   `json:"ispublic"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	State       string                     `json:"state"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportRoleResponse) UnmarshalJSON(b []byte) error {
+	type alias ImportRoleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListRolePermissionsParams struct {
@@ -968,6 +1357,43 @@ func (p *ListRolePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listRolePermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListRolePermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listRolePermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listRolePermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListRolePermissionsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"roleid": 255},
+	}.validate("listRolePermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listRolePermissions API, before the request is signed
+func (p *ListRolePermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListRolePermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListRolePermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listRolePermissions", b, &p.p, map[string]paramDecoder{
+		"roleid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListRolePermissionsParams) Clone() *ListRolePermissionsParams {
+	return &ListRolePermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListRolePermissionsParams) SetRoleid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -999,13 +1425,22 @@ func (s *RoleService) NewListRolePermissionsParams() *ListRolePermissionsParams
 
 // Lists role permissions
 func (s *RoleService) ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listRolePermissions", p.toURLValues())
+	return s.ListRolePermissionsWithContext(context.Background(), p)
+}
+
+// ListRolePermissionsWithContext is like ListRolePermissions, but honours the cancellation and deadline of ctx
+func (s *RoleService) ListRolePermissionsWithContext(ctx context.Context, p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listRolePermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListRolePermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listRolePermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1018,14 +1453,20 @@ type ListRolePermissionsResponse struct {
 }
 
 type RolePermission struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Permission  string `json:"permission"`
-	Roleid      string `json:"roleid"`
-	Rolename    string `json:"rolename"`
-	Rule        string `json:"rule"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Permission  string                     `json:"permission"`
+	Roleid      string                     `json:"roleid"`
+	Rolename    string                     `json:"rolename"`
+	Rule        string                     `json:"rule"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RolePermission) UnmarshalJSON(b []byte) error {
+	type alias RolePermission
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListRolesParams struct {
@@ -1063,6 +1504,49 @@ func (p *ListRolesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listRoles API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListRolesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listRoles", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listRoles API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListRolesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "state": 255, "type": 255},
+	}.validate("listRoles", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listRoles API, before the request is signed
+func (p *ListRolesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListRolesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListRolesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listRoles", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"state":    decodeParam[string],
+		"type":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListRolesParams) Clone() *ListRolesParams {
+	return &ListRolesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListRolesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1220,6 +1704,11 @@ func (s *RoleService) NewListRolesParams() *ListRolesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetRoleIDWithContext(context.Background(), name, opts...)
+}
+
+// GetRoleIDWithContext is like GetRoleID, but honours the cancellation and deadline of ctx
+func (s *RoleService) GetRoleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRolesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1231,7 +1720,7 @@ func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, e
 		}
 	}
 
-	l, err := s.ListRoles(p)
+	l, err := s.ListRolesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1256,12 +1745,17 @@ func (s *RoleService) GetRoleID(name string, opts ...OptionFunc) (string, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error) {
-	id, count, err := s.GetRoleID(name, opts...)
+	return s.GetRoleByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetRoleByNameWithContext is like GetRoleByName, but honours the cancellation and deadline of ctx
+func (s *RoleService) GetRoleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Role, int, error) {
+	id, count, err := s.GetRoleIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetRoleByID(id, opts...)
+	r, count, err := s.GetRoleByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1270,6 +1764,11 @@ func (s *RoleService) GetRoleByName(name string, opts ...OptionFunc) (*Role, int
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error) {
+	return s.GetRoleByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetRoleByIDWithContext is like GetRoleByID, but honours the cancellation and deadline of ctx
+func (s *RoleService) GetRoleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Role, int, error) {
 	p := &ListRolesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1281,11 +1780,10 @@ func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, er
 		}
 	}
 
-	l, err := s.ListRoles(p)
+	l, err := s.ListRolesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1303,34 +1801,77 @@ func (s *RoleService) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, er
 
 // Lists dynamic roles in CloudStack
 func (s *RoleService) ListRoles(p *ListRolesParams) (*ListRolesResponse, error) {
-	resp, err := s.cs.newRequest("listRoles", p.toURLValues())
+	return s.ListRolesWithContext(context.Background(), p)
+}
+
+// ListRolesWithContext is like ListRoles, but honours the cancellation and deadline of ctx
+func (s *RoleService) ListRolesWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listRoles", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListRolesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listRoles", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListRolesAll returns all Roles matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *RoleService) ListRolesAll(p *ListRolesParams, opts ...PageOption) ([]*Role, error) {
+	return s.ListRolesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListRolesAllWithContext is like ListRolesAll, but honours the cancellation and deadline of ctx
+func (s *RoleService) ListRolesAllWithContext(ctx context.Context, p *ListRolesParams, opts ...PageOption) ([]*Role, error) {
+	return collectPages(s.ListRolesIterWithContext(ctx, p, opts...))
+}
+
+// ListRolesIter returns an iterator over all Roles matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *RoleService) ListRolesIter(p *ListRolesParams, opts ...PageOption) iter.Seq2[*Role, error] {
+	return s.ListRolesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListRolesIterWithContext is like ListRolesIter, but honours the cancellation and deadline of ctx
+func (s *RoleService) ListRolesIterWithContext(ctx context.Context, p *ListRolesParams, opts ...PageOption) iter.Seq2[*Role, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Role, int, error) {
+		l, err := s.ListRolesWithContext(ctx, &ListRolesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Roles, l.Count, nil
+	}, opts...)
+}
+
 type ListRolesResponse struct {
 	Count int     `json:"count"`
 	Roles []*Role `json:"role"`
 }
 
 type Role struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	Isdefault   bool   `json:"isdefault"`
-	Ispublic    bool   `json:"ispublic"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	State       string `json:"state"`
-	Type        string `json:"type"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	Isdefault   FlexBool                   `json:"isdefault"`
+	Ispublic    FlexBool                   `json:"ispublic"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	State       string                     `json:"state"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Role) UnmarshalJSON(b []byte) error {
+	type alias Role
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateRoleParams struct {
@@ -1364,6 +1905,48 @@ func (p *UpdateRoleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateRole API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateRoleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateRole", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateRole API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateRoleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "id": 255, "name": 255, "type": 255},
+	}.validate("updateRole", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateRole API, before the request is signed
+func (p *UpdateRoleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateRoleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateRoleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateRole", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"id":          decodeParam[string],
+		"ispublic":    decodeParam[bool],
+		"name":        decodeParam[string],
+		"type":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateRoleParams) Clone() *UpdateRoleParams {
+	return &UpdateRoleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateRoleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1480,13 +2063,22 @@ func (s *RoleService) NewUpdateRoleParams(id string) *UpdateRoleParams {
 
 // Updates a role
 func (s *RoleService) UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateRole", p.toURLValues())
+	return s.UpdateRoleWithContext(context.Background(), p)
+}
+
+// UpdateRoleWithContext is like UpdateRole, but honours the cancellation and deadline of ctx
+func (s *RoleService) UpdateRoleWithContext(ctx context.Context, p *UpdateRoleParams) (*UpdateRoleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateRole", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateRoleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateRole", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1494,15 +2086,21 @@ func (s *RoleService) UpdateRole(p *UpdateRoleParams) (*UpdateRoleResponse, erro
 }
 
 type UpdateRoleResponse struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	Isdefault   bool   `json:"isdefault"`
-	Ispublic    bool   `json:"ispublic"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	State       string `json:"state"`
-	Type        string `json:"type"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	Isdefault   FlexBool                   `json:"isdefault"`
+	Ispublic    FlexBool                   `json:"ispublic"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	State       string                     `json:"state"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateRoleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateRoleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateRolePermissionParams struct {
@@ -1530,6 +2128,47 @@ func (p *UpdateRolePermissionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateRolePermission API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateRolePermissionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateRolePermission", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateRolePermission API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateRolePermissionParams) Validate() error {
+	return paramRules{
+		required: []string{"roleid"},
+		lengths:  map[string]int{"permission": 255, "roleid": 255, "ruleid": 255},
+	}.validate("updateRolePermission", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateRolePermission API, before the request is signed
+func (p *UpdateRolePermissionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateRolePermissionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateRolePermissionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateRolePermission", b, &p.p, map[string]paramDecoder{
+		"permission": decodeParam[string],
+		"roleid":     decodeParam[string],
+		"ruleid":     decodeParam[string],
+		"ruleorder":  decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateRolePermissionParams) Clone() *UpdateRolePermissionParams {
+	return &UpdateRolePermissionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateRolePermissionParams) SetPermission(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1625,13 +2264,22 @@ func (s *RoleService) NewUpdateRolePermissionParams(roleid string) *UpdateRolePe
 
 // Updates a role permission order
 func (s *RoleService) UpdateRolePermission(p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateRolePermission", p.toURLValues())
+	return s.UpdateRolePermissionWithContext(context.Background(), p)
+}
+
+// UpdateRolePermissionWithContext is like UpdateRolePermission, but honours the cancellation and deadline of ctx
+func (s *RoleService) UpdateRolePermissionWithContext(ctx context.Context, p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateRolePermission", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateRolePermissionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateRolePermission", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1639,10 +2287,11 @@ func (s *RoleService) UpdateRolePermission(p *UpdateRolePermissionParams) (*Upda
 }
 
 type UpdateRolePermissionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateRolePermissionResponse) UnmarshalJSON(b []byte) error {
@@ -1669,7 +2318,7 @@ func (r *UpdateRolePermissionResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateRolePermissionResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListProjectRolesParams struct {
@@ -1704,6 +2353,49 @@ func (p *ListProjectRolesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listProjectRoles API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListProjectRolesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listProjectRoles", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listProjectRoles API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListProjectRolesParams) Validate() error {
+	return paramRules{
+		required: []string{"projectid"},
+		lengths:  map[string]int{"keyword": 255, "name": 255, "projectid": 255, "projectroleid": 255},
+	}.validate("listProjectRoles", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listProjectRoles API, before the request is signed
+func (p *ListProjectRolesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListProjectRolesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListProjectRolesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listProjectRoles", b, &p.p, map[string]paramDecoder{
+		"keyword":       decodeParam[string],
+		"name":          decodeParam[string],
+		"page":          decodeParam[int],
+		"pagesize":      decodeParam[int],
+		"projectid":     decodeParam[string],
+		"projectroleid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListProjectRolesParams) Clone() *ListProjectRolesParams {
+	return &ListProjectRolesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListProjectRolesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1841,6 +2533,11 @@ func (s *RoleService) NewListProjectRolesParams(projectid string) *ListProjectRo
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RoleService) GetProjectRoleID(name string, projectid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetProjectRoleIDWithContext(context.Background(), name, projectid, opts...)
+}
+
+// GetProjectRoleIDWithContext is like GetProjectRoleID, but honours the cancellation and deadline of ctx
+func (s *RoleService) GetProjectRoleIDWithContext(ctx context.Context, name string, projectid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListProjectRolesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1853,7 +2550,7 @@ func (s *RoleService) GetProjectRoleID(name string, projectid string, opts ...Op
 		}
 	}
 
-	l, err := s.ListProjectRoles(p)
+	l, err := s.ListProjectRolesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1878,30 +2575,73 @@ func (s *RoleService) GetProjectRoleID(name string, projectid string, opts ...Op
 
 // Lists Project roles in CloudStack
 func (s *RoleService) ListProjectRoles(p *ListProjectRolesParams) (*ListProjectRolesResponse, error) {
-	resp, err := s.cs.newRequest("listProjectRoles", p.toURLValues())
+	return s.ListProjectRolesWithContext(context.Background(), p)
+}
+
+// ListProjectRolesWithContext is like ListProjectRoles, but honours the cancellation and deadline of ctx
+func (s *RoleService) ListProjectRolesWithContext(ctx context.Context, p *ListProjectRolesParams) (*ListProjectRolesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listProjectRoles", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListProjectRolesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listProjectRoles", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListProjectRolesAll returns all ProjectRoles matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *RoleService) ListProjectRolesAll(p *ListProjectRolesParams, opts ...PageOption) ([]*ProjectRole, error) {
+	return s.ListProjectRolesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectRolesAllWithContext is like ListProjectRolesAll, but honours the cancellation and deadline of ctx
+func (s *RoleService) ListProjectRolesAllWithContext(ctx context.Context, p *ListProjectRolesParams, opts ...PageOption) ([]*ProjectRole, error) {
+	return collectPages(s.ListProjectRolesIterWithContext(ctx, p, opts...))
+}
+
+// ListProjectRolesIter returns an iterator over all ProjectRoles matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *RoleService) ListProjectRolesIter(p *ListProjectRolesParams, opts ...PageOption) iter.Seq2[*ProjectRole, error] {
+	return s.ListProjectRolesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListProjectRolesIterWithContext is like ListProjectRolesIter, but honours the cancellation and deadline of ctx
+func (s *RoleService) ListProjectRolesIterWithContext(ctx context.Context, p *ListProjectRolesParams, opts ...PageOption) iter.Seq2[*ProjectRole, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ProjectRole, int, error) {
+		l, err := s.ListProjectRolesWithContext(ctx, &ListProjectRolesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ProjectRoles, l.Count, nil
+	}, opts...)
+}
+
 type ListProjectRolesResponse struct {
 	Count        int            `json:"count"`
 	ProjectRoles []*ProjectRole `json:"projectrole"`
 }
 
 type ProjectRole struct {
-	Description string `json:"description"`
-	Id          string `json:"id"`
-	Ispublic    bool   `json:"ispublic"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Projectid   string `json:"projectid"`
+	Description string                     `json:"description"`
+	Id          string                     `json:"id"`
+	Ispublic    FlexBool                   `json:"ispublic"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Projectid   string                     `json:"projectid"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ProjectRole) UnmarshalJSON(b []byte) error {
+	type alias ProjectRole
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -88,6 +90,36 @@ func (mr *MockRoleServiceIfaceMockRecorder) CreateRolePermission(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).CreateRolePermission), p)
 }
 
+// CreateRolePermissionWithContext mocks base method.
+func (m *MockRoleServiceIface) CreateRolePermissionWithContext(ctx context.Context, p *CreateRolePermissionParams) (*CreateRolePermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRolePermissionWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateRolePermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRolePermissionWithContext indicates an expected call of CreateRolePermissionWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) CreateRolePermissionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRolePermissionWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).CreateRolePermissionWithContext), ctx, p)
+}
+
+// CreateRoleWithContext mocks base method.
+func (m *MockRoleServiceIface) CreateRoleWithContext(ctx context.Context, p *CreateRoleParams) (*CreateRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRoleWithContext indicates an expected call of CreateRoleWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) CreateRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRoleWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).CreateRoleWithContext), ctx, p)
+}
+
 // DeleteRole mocks base method.
 func (m *MockRoleServiceIface) DeleteRole(p *DeleteRoleParams) (*DeleteRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +150,36 @@ func (mr *MockRoleServiceIfaceMockRecorder) DeleteRolePermission(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).DeleteRolePermission), p)
 }
 
+// DeleteRolePermissionWithContext mocks base method.
+func (m *MockRoleServiceIface) DeleteRolePermissionWithContext(ctx context.Context, p *DeleteRolePermissionParams) (*DeleteRolePermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRolePermissionWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteRolePermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRolePermissionWithContext indicates an expected call of DeleteRolePermissionWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) DeleteRolePermissionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRolePermissionWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).DeleteRolePermissionWithContext), ctx, p)
+}
+
+// DeleteRoleWithContext mocks base method.
+func (m *MockRoleServiceIface) DeleteRoleWithContext(ctx context.Context, p *DeleteRoleParams) (*DeleteRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRoleWithContext indicates an expected call of DeleteRoleWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) DeleteRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRoleWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).DeleteRoleWithContext), ctx, p)
+}
+
 // DisableRole mocks base method.
 func (m *MockRoleServiceIface) DisableRole(p *DisableRoleParams) (*DisableRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +195,21 @@ func (mr *MockRoleServiceIfaceMockRecorder) DisableRole(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableRole", reflect.TypeOf((*MockRoleServiceIface)(nil).DisableRole), p)
 }
 
+// DisableRoleWithContext mocks base method.
+func (m *MockRoleServiceIface) DisableRoleWithContext(ctx context.Context, p *DisableRoleParams) (*DisableRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableRoleWithContext indicates an expected call of DisableRoleWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) DisableRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableRoleWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).DisableRoleWithContext), ctx, p)
+}
+
 // EnableRole mocks base method.
 func (m *MockRoleServiceIface) EnableRole(p *EnableRoleParams) (*EnableRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +225,21 @@ func (mr *MockRoleServiceIfaceMockRecorder) EnableRole(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableRole", reflect.TypeOf((*MockRoleServiceIface)(nil).EnableRole), p)
 }
 
+// EnableRoleWithContext mocks base method.
+func (m *MockRoleServiceIface) EnableRoleWithContext(ctx context.Context, p *EnableRoleParams) (*EnableRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableRoleWithContext indicates an expected call of EnableRoleWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) EnableRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableRoleWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).EnableRoleWithContext), ctx, p)
+}
+
 // GetProjectRoleID mocks base method.
 func (m *MockRoleServiceIface) GetProjectRoleID(name, projectid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +261,27 @@ func (mr *MockRoleServiceIfaceMockRecorder) GetProjectRoleID(name, projectid any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectRoleID", reflect.TypeOf((*MockRoleServiceIface)(nil).GetProjectRoleID), varargs...)
 }
 
+// GetProjectRoleIDWithContext mocks base method.
+func (m *MockRoleServiceIface) GetProjectRoleIDWithContext(ctx context.Context, name, projectid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, projectid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProjectRoleIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetProjectRoleIDWithContext indicates an expected call of GetProjectRoleIDWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) GetProjectRoleIDWithContext(ctx, name, projectid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, projectid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProjectRoleIDWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).GetProjectRoleIDWithContext), varargs...)
+}
+
 // GetRoleByID mocks base method.
 func (m *MockRoleServiceIface) GetRoleByID(id string, opts ...OptionFunc) (*Role, int, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +303,27 @@ func (mr *MockRoleServiceIfaceMockRecorder) GetRoleByID(id any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByID", reflect.TypeOf((*MockRoleServiceIface)(nil).GetRoleByID), varargs...)
 }
 
+// GetRoleByIDWithContext mocks base method.
+func (m *MockRoleServiceIface) GetRoleByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Role, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRoleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Role)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRoleByIDWithContext indicates an expected call of GetRoleByIDWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) GetRoleByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByIDWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).GetRoleByIDWithContext), varargs...)
+}
+
 // GetRoleByName mocks base method.
 func (m *MockRoleServiceIface) GetRoleByName(name string, opts ...OptionFunc) (*Role, int, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +345,27 @@ func (mr *MockRoleServiceIfaceMockRecorder) GetRoleByName(name any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByName", reflect.TypeOf((*MockRoleServiceIface)(nil).GetRoleByName), varargs...)
 }
 
+// GetRoleByNameWithContext mocks base method.
+func (m *MockRoleServiceIface) GetRoleByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Role, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRoleByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Role)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRoleByNameWithContext indicates an expected call of GetRoleByNameWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) GetRoleByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleByNameWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).GetRoleByNameWithContext), varargs...)
+}
+
 // GetRoleID mocks base method.
 func (m *MockRoleServiceIface) GetRoleID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +387,27 @@ func (mr *MockRoleServiceIfaceMockRecorder) GetRoleID(name any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleID", reflect.TypeOf((*MockRoleServiceIface)(nil).GetRoleID), varargs...)
 }
 
+// GetRoleIDWithContext mocks base method.
+func (m *MockRoleServiceIface) GetRoleIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRoleIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRoleIDWithContext indicates an expected call of GetRoleIDWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) GetRoleIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRoleIDWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).GetRoleIDWithContext), varargs...)
+}
+
 // ImportRole mocks base method.
 func (m *MockRoleServiceIface) ImportRole(p *ImportRoleParams) (*ImportRoleResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +423,21 @@ func (mr *MockRoleServiceIfaceMockRecorder) ImportRole(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRole", reflect.TypeOf((*MockRoleServiceIface)(nil).ImportRole), p)
 }
 
+// ImportRoleWithContext mocks base method.
+func (m *MockRoleServiceIface) ImportRoleWithContext(ctx context.Context, p *ImportRoleParams) (*ImportRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*ImportRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportRoleWithContext indicates an expected call of ImportRoleWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ImportRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportRoleWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ImportRoleWithContext), ctx, p)
+}
+
 // ListProjectRoles mocks base method.
 func (m *MockRoleServiceIface) ListProjectRoles(p *ListProjectRolesParams) (*ListProjectRolesResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +453,99 @@ func (mr *MockRoleServiceIfaceMockRecorder) ListProjectRoles(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRoles", reflect.TypeOf((*MockRoleServiceIface)(nil).ListProjectRoles), p)
 }
 
+// ListProjectRolesAll mocks base method.
+func (m *MockRoleServiceIface) ListProjectRolesAll(p *ListProjectRolesParams, opts ...PageOption) ([]*ProjectRole, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectRolesAll", varargs...)
+	ret0, _ := ret[0].([]*ProjectRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectRolesAll indicates an expected call of ListProjectRolesAll.
+func (mr *MockRoleServiceIfaceMockRecorder) ListProjectRolesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolesAll", reflect.TypeOf((*MockRoleServiceIface)(nil).ListProjectRolesAll), varargs...)
+}
+
+// ListProjectRolesAllWithContext mocks base method.
+func (m *MockRoleServiceIface) ListProjectRolesAllWithContext(ctx context.Context, p *ListProjectRolesParams, opts ...PageOption) ([]*ProjectRole, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectRolesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ProjectRole)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectRolesAllWithContext indicates an expected call of ListProjectRolesAllWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ListProjectRolesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolesAllWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ListProjectRolesAllWithContext), varargs...)
+}
+
+// ListProjectRolesIter mocks base method.
+func (m *MockRoleServiceIface) ListProjectRolesIter(p *ListProjectRolesParams, opts ...PageOption) iter.Seq2[*ProjectRole, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectRolesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ProjectRole, error])
+	return ret0
+}
+
+// ListProjectRolesIter indicates an expected call of ListProjectRolesIter.
+func (mr *MockRoleServiceIfaceMockRecorder) ListProjectRolesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolesIter", reflect.TypeOf((*MockRoleServiceIface)(nil).ListProjectRolesIter), varargs...)
+}
+
+// ListProjectRolesIterWithContext mocks base method.
+func (m *MockRoleServiceIface) ListProjectRolesIterWithContext(ctx context.Context, p *ListProjectRolesParams, opts ...PageOption) iter.Seq2[*ProjectRole, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListProjectRolesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ProjectRole, error])
+	return ret0
+}
+
+// ListProjectRolesIterWithContext indicates an expected call of ListProjectRolesIterWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ListProjectRolesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolesIterWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ListProjectRolesIterWithContext), varargs...)
+}
+
+// ListProjectRolesWithContext mocks base method.
+func (m *MockRoleServiceIface) ListProjectRolesWithContext(ctx context.Context, p *ListProjectRolesParams) (*ListProjectRolesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListProjectRolesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListProjectRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListProjectRolesWithContext indicates an expected call of ListProjectRolesWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ListProjectRolesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListProjectRolesWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ListProjectRolesWithContext), ctx, p)
+}
+
 // ListRolePermissions mocks base method.
 func (m *MockRoleServiceIface) ListRolePermissions(p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +561,21 @@ func (mr *MockRoleServiceIfaceMockRecorder) ListRolePermissions(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissions", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolePermissions), p)
 }
 
+// ListRolePermissionsWithContext mocks base method.
+func (m *MockRoleServiceIface) ListRolePermissionsWithContext(ctx context.Context, p *ListRolePermissionsParams) (*ListRolePermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolePermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListRolePermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolePermissionsWithContext indicates an expected call of ListRolePermissionsWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ListRolePermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolePermissionsWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolePermissionsWithContext), ctx, p)
+}
+
 // ListRoles mocks base method.
 func (m *MockRoleServiceIface) ListRoles(p *ListRolesParams) (*ListRolesResponse, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +591,99 @@ func (mr *MockRoleServiceIfaceMockRecorder) ListRoles(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoles", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRoles), p)
 }
 
+// ListRolesAll mocks base method.
+func (m *MockRoleServiceIface) ListRolesAll(p *ListRolesParams, opts ...PageOption) ([]*Role, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRolesAll", varargs...)
+	ret0, _ := ret[0].([]*Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolesAll indicates an expected call of ListRolesAll.
+func (mr *MockRoleServiceIfaceMockRecorder) ListRolesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolesAll", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolesAll), varargs...)
+}
+
+// ListRolesAllWithContext mocks base method.
+func (m *MockRoleServiceIface) ListRolesAllWithContext(ctx context.Context, p *ListRolesParams, opts ...PageOption) ([]*Role, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRolesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Role)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolesAllWithContext indicates an expected call of ListRolesAllWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ListRolesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolesAllWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolesAllWithContext), varargs...)
+}
+
+// ListRolesIter mocks base method.
+func (m *MockRoleServiceIface) ListRolesIter(p *ListRolesParams, opts ...PageOption) iter.Seq2[*Role, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRolesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Role, error])
+	return ret0
+}
+
+// ListRolesIter indicates an expected call of ListRolesIter.
+func (mr *MockRoleServiceIfaceMockRecorder) ListRolesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolesIter", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolesIter), varargs...)
+}
+
+// ListRolesIterWithContext mocks base method.
+func (m *MockRoleServiceIface) ListRolesIterWithContext(ctx context.Context, p *ListRolesParams, opts ...PageOption) iter.Seq2[*Role, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRolesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Role, error])
+	return ret0
+}
+
+// ListRolesIterWithContext indicates an expected call of ListRolesIterWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ListRolesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolesIterWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolesIterWithContext), varargs...)
+}
+
+// ListRolesWithContext mocks base method.
+func (m *MockRoleServiceIface) ListRolesWithContext(ctx context.Context, p *ListRolesParams) (*ListRolesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRolesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListRolesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRolesWithContext indicates an expected call of ListRolesWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) ListRolesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRolesWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).ListRolesWithContext), ctx, p)
+}
+
 // NewCreateRoleParams mocks base method.
 func (m *MockRoleServiceIface) NewCreateRoleParams(name string) *CreateRoleParams {
 	m.ctrl.T.Helper()
@@ -489,3 +881,33 @@ func (mr *MockRoleServiceIfaceMockRecorder) UpdateRolePermission(p any) *gomock.
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRolePermission", reflect.TypeOf((*MockRoleServiceIface)(nil).UpdateRolePermission), p)
 }
+
+// UpdateRolePermissionWithContext mocks base method.
+func (m *MockRoleServiceIface) UpdateRolePermissionWithContext(ctx context.Context, p *UpdateRolePermissionParams) (*UpdateRolePermissionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRolePermissionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateRolePermissionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRolePermissionWithContext indicates an expected call of UpdateRolePermissionWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) UpdateRolePermissionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRolePermissionWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).UpdateRolePermissionWithContext), ctx, p)
+}
+
+// UpdateRoleWithContext mocks base method.
+func (m *MockRoleServiceIface) UpdateRoleWithContext(ctx context.Context, p *UpdateRoleParams) (*UpdateRoleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRoleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateRoleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRoleWithContext indicates an expected call of UpdateRoleWithContext.
+func (mr *MockRoleServiceIfaceMockRecorder) UpdateRoleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRoleWithContext", reflect.TypeOf((*MockRoleServiceIface)(nil).UpdateRoleWithContext), ctx, p)
+}
@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -28,6 +29,8 @@ import (
 
 type RollingMaintenanceServiceIface interface {
 	StartRollingMaintenance(p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error)
+	StartRollingMaintenanceWithContext(ctx context.Context, p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error)
+	StartRollingMaintenanceAsync(ctx context.Context, p *StartRollingMaintenanceParams) (*Job, error)
 	NewStartRollingMaintenanceParams() *StartRollingMaintenanceParams
 }
 
@@ -70,6 +73,49 @@ func (p *StartRollingMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the startRollingMaintenance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StartRollingMaintenanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("startRollingMaintenance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the startRollingMaintenance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StartRollingMaintenanceParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"payload": 255},
+	}.validate("startRollingMaintenance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the startRollingMaintenance API, before the request is signed
+func (p *StartRollingMaintenanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StartRollingMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StartRollingMaintenanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("startRollingMaintenance", b, &p.p, map[string]paramDecoder{
+		"clusterids": decodeParam[[]string],
+		"forced":     decodeParam[bool],
+		"hostids":    decodeParam[[]string],
+		"payload":    decodeParam[string],
+		"podids":     decodeParam[[]string],
+		"timeout":    decodeParam[int],
+		"zoneids":    decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StartRollingMaintenanceParams) Clone() *StartRollingMaintenanceParams {
+	return &StartRollingMaintenanceParams{p: cloneParams(p.p)}
+}
+
 func (p *StartRollingMaintenanceParams) SetClusterids(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -227,32 +273,39 @@ func (s *RollingMaintenanceService) NewStartRollingMaintenanceParams() *StartRol
 
 // Start rolling maintenance
 func (s *RollingMaintenanceService) StartRollingMaintenance(p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error) {
-	resp, err := s.cs.newPostRequest("startRollingMaintenance", p.toURLValues())
+	return s.StartRollingMaintenanceWithContext(context.Background(), p)
+}
+
+// StartRollingMaintenanceWithContext is like StartRollingMaintenance, but honours the cancellation and deadline of ctx
+func (s *RollingMaintenanceService) StartRollingMaintenanceWithContext(ctx context.Context, p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "startRollingMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StartRollingMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("startRollingMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "startRollingMaintenance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("startRollingMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -260,25 +313,62 @@ func (s *RollingMaintenanceService) StartRollingMaintenance(p *StartRollingMaint
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StartRollingMaintenance async job into r
+func (r *StartRollingMaintenanceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StartRollingMaintenanceAsync starts the async job of StartRollingMaintenance without waiting for it to finish, and returns a handle to the job
+func (s *RollingMaintenanceService) StartRollingMaintenanceAsync(ctx context.Context, p *StartRollingMaintenanceParams) (*Job, error) {
+	r, err := s.StartRollingMaintenanceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("startRollingMaintenance", r.JobID), nil
+}
+
 type StartRollingMaintenanceResponse struct {
 	Details      string                                        `json:"details"`
 	Hostsskipped []StartRollingMaintenanceResponseHostsskipped `json:"hostsskipped"`
 	Hostsupdated []StartRollingMaintenanceResponseHostsupdated `json:"hostsupdated"`
 	JobID        string                                        `json:"jobid"`
-	Jobstatus    int                                           `json:"jobstatus"`
+	Jobstatus    FlexInt                                       `json:"jobstatus"`
 	Success      bool                                          `json:"success"`
+	Extra        map[string]json.RawMessage                    `json:"-"`
+}
+
+func (r *StartRollingMaintenanceResponse) UnmarshalJSON(b []byte) error {
+	type alias StartRollingMaintenanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartRollingMaintenanceResponseHostsupdated struct {
-	Enddate   string `json:"enddate"`
-	Hostid    string `json:"hostid"`
-	Hostname  string `json:"hostname"`
-	Output    string `json:"output"`
-	Startdate string `json:"startdate"`
+	Enddate   Time                       `json:"enddate"`
+	Hostid    string                     `json:"hostid"`
+	Hostname  string                     `json:"hostname"`
+	Output    string                     `json:"output"`
+	Startdate Time                       `json:"startdate"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartRollingMaintenanceResponseHostsupdated) UnmarshalJSON(b []byte) error {
+	type alias StartRollingMaintenanceResponseHostsupdated
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartRollingMaintenanceResponseHostsskipped struct {
-	Hostid   string `json:"hostid"`
-	Hostname string `json:"hostname"`
-	Reason   string `json:"reason"`
+	Hostid   string                     `json:"hostid"`
+	Hostname string                     `json:"hostname"`
+	Reason   string                     `json:"reason"`
+	Extra    map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartRollingMaintenanceResponseHostsskipped) UnmarshalJSON(b []byte) error {
+	type alias StartRollingMaintenanceResponseHostsskipped
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -86,3 +87,33 @@ func (mr *MockRollingMaintenanceServiceIfaceMockRecorder) StartRollingMaintenanc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRollingMaintenance", reflect.TypeOf((*MockRollingMaintenanceServiceIface)(nil).StartRollingMaintenance), p)
 }
+
+// StartRollingMaintenanceAsync mocks base method.
+func (m *MockRollingMaintenanceServiceIface) StartRollingMaintenanceAsync(ctx context.Context, p *StartRollingMaintenanceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRollingMaintenanceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRollingMaintenanceAsync indicates an expected call of StartRollingMaintenanceAsync.
+func (mr *MockRollingMaintenanceServiceIfaceMockRecorder) StartRollingMaintenanceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRollingMaintenanceAsync", reflect.TypeOf((*MockRollingMaintenanceServiceIface)(nil).StartRollingMaintenanceAsync), ctx, p)
+}
+
+// StartRollingMaintenanceWithContext mocks base method.
+func (m *MockRollingMaintenanceServiceIface) StartRollingMaintenanceWithContext(ctx context.Context, p *StartRollingMaintenanceParams) (*StartRollingMaintenanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRollingMaintenanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*StartRollingMaintenanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRollingMaintenanceWithContext indicates an expected call of StartRollingMaintenanceWithContext.
+func (mr *MockRollingMaintenanceServiceIfaceMockRecorder) StartRollingMaintenanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRollingMaintenanceWithContext", reflect.TypeOf((*MockRollingMaintenanceServiceIface)(nil).StartRollingMaintenanceWithContext), ctx, p)
+}
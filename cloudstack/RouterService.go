@@ -20,37 +20,66 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type RouterServiceIface interface {
 	ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
+	ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error)
 	NewChangeServiceForRouterParams(id string, serviceofferingid string) *ChangeServiceForRouterParams
 	ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error)
+	ConfigureVirtualRouterElementAsync(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*Job, error)
 	NewConfigureVirtualRouterElementParams(enabled bool, id string) *ConfigureVirtualRouterElementParams
 	CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error)
+	CreateVirtualRouterElementAsync(ctx context.Context, p *CreateVirtualRouterElementParams) (*Job, error)
 	NewCreateVirtualRouterElementParams(nspid string) *CreateVirtualRouterElementParams
 	DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error)
+	DestroyRouterAsync(ctx context.Context, p *DestroyRouterParams) (*Job, error)
 	NewDestroyRouterParams(id string) *DestroyRouterParams
 	GetRouterHealthCheckResults(p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error)
+	GetRouterHealthCheckResultsWithContext(ctx context.Context, p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error)
 	NewGetRouterHealthCheckResultsParams(routerid string) *GetRouterHealthCheckResultsParams
 	ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error)
+	ListRoutersAll(p *ListRoutersParams, opts ...PageOption) ([]*Router, error)
+	ListRoutersAllWithContext(ctx context.Context, p *ListRoutersParams, opts ...PageOption) ([]*Router, error)
+	ListRoutersIter(p *ListRoutersParams, opts ...PageOption) iter.Seq2[*Router, error]
+	ListRoutersIterWithContext(ctx context.Context, p *ListRoutersParams, opts ...PageOption) iter.Seq2[*Router, error]
 	NewListRoutersParams() *ListRoutersParams
 	GetRouterID(name string, opts ...OptionFunc) (string, int, error)
+	GetRouterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Router, int, error)
 	GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error)
+	GetRouterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Router, int, error)
 	ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error)
+	ListVirtualRouterElementsAll(p *ListVirtualRouterElementsParams, opts ...PageOption) ([]*VirtualRouterElement, error)
+	ListVirtualRouterElementsAllWithContext(ctx context.Context, p *ListVirtualRouterElementsParams, opts ...PageOption) ([]*VirtualRouterElement, error)
+	ListVirtualRouterElementsIter(p *ListVirtualRouterElementsParams, opts ...PageOption) iter.Seq2[*VirtualRouterElement, error]
+	ListVirtualRouterElementsIterWithContext(ctx context.Context, p *ListVirtualRouterElementsParams, opts ...PageOption) iter.Seq2[*VirtualRouterElement, error]
 	NewListVirtualRouterElementsParams() *ListVirtualRouterElementsParams
 	GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
+	GetVirtualRouterElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualRouterElement, int, error)
 	RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error)
+	RebootRouterAsync(ctx context.Context, p *RebootRouterParams) (*Job, error)
 	NewRebootRouterParams(id string) *RebootRouterParams
 	StartRouter(p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error)
+	StartRouterAsync(ctx context.Context, p *StartRouterParams) (*Job, error)
 	NewStartRouterParams(id string) *StartRouterParams
 	StopRouter(p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error)
+	StopRouterAsync(ctx context.Context, p *StopRouterParams) (*Job, error)
 	NewStopRouterParams(id string) *StopRouterParams
 }
 
@@ -72,6 +101,45 @@ func (p *ChangeServiceForRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeServiceForRouter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeServiceForRouterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeServiceForRouter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeServiceForRouter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeServiceForRouterParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "serviceofferingid"},
+		lengths:  map[string]int{"id": 255, "serviceofferingid": 255},
+	}.validate("changeServiceForRouter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeServiceForRouter API, before the request is signed
+func (p *ChangeServiceForRouterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeServiceForRouterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeServiceForRouterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeServiceForRouter", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeServiceForRouterParams) Clone() *ChangeServiceForRouterParams {
+	return &ChangeServiceForRouterParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeServiceForRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -126,13 +194,22 @@ func (s *RouterService) NewChangeServiceForRouterParams(id string, serviceofferi
 
 // Upgrades domain router to a new service offering
 func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
-	resp, err := s.cs.newPostRequest("changeServiceForRouter", p.toURLValues())
+	return s.ChangeServiceForRouterWithContext(context.Background(), p)
+}
+
+// ChangeServiceForRouterWithContext is like ChangeServiceForRouter, but honours the cancellation and deadline of ctx
+func (s *RouterService) ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "changeServiceForRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeServiceForRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeServiceForRouter", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -142,7 +219,7 @@ func (s *RouterService) ChangeServiceForRouter(p *ChangeServiceForRouterParams)
 type ChangeServiceForRouterResponse struct {
 	Account             string                                             `json:"account"`
 	Arch                string                                             `json:"arch"`
-	Created             string                                             `json:"created"`
+	Created             Time                                               `json:"created"`
 	Dns1                string                                             `json:"dns1"`
 	Dns2                string                                             `json:"dns2"`
 	Domain              string                                             `json:"domain"`
@@ -154,9 +231,9 @@ type ChangeServiceForRouterResponse struct {
 	Guestnetmask        string                                             `json:"guestnetmask"`
 	Guestnetworkid      string                                             `json:"guestnetworkid"`
 	Guestnetworkname    string                                             `json:"guestnetworkname"`
-	Hasannotations      bool                                               `json:"hasannotations"`
+	Hasannotations      FlexBool                                           `json:"hasannotations"`
 	Healthcheckresults  []ChangeServiceForRouterResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                               `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                           `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                             `json:"hostcontrolstate"`
 	Hostid              string                                             `json:"hostid"`
 	Hostname            string                                             `json:"hostname"`
@@ -164,9 +241,9 @@ type ChangeServiceForRouterResponse struct {
 	Id                  string                                             `json:"id"`
 	Ip6dns1             string                                             `json:"ip6dns1"`
 	Ip6dns2             string                                             `json:"ip6dns2"`
-	Isredundantrouter   bool                                               `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                           `json:"isredundantrouter"`
 	JobID               string                                             `json:"jobid"`
-	Jobstatus           int                                                `json:"jobstatus"`
+	Jobstatus           FlexInt                                            `json:"jobstatus"`
 	Linklocalip         string                                             `json:"linklocalip"`
 	Linklocalmacaddress string                                             `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                             `json:"linklocalnetmask"`
@@ -183,7 +260,7 @@ type ChangeServiceForRouterResponse struct {
 	Publicnetmask       string                                             `json:"publicnetmask"`
 	Publicnetworkid     string                                             `json:"publicnetworkid"`
 	Redundantstate      string                                             `json:"redundantstate"`
-	Requiresupgrade     bool                                               `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                           `json:"requiresupgrade"`
 	Role                string                                             `json:"role"`
 	Scriptsversion      string                                             `json:"scriptsversion"`
 	Serviceofferingid   string                                             `json:"serviceofferingid"`
@@ -197,15 +274,27 @@ type ChangeServiceForRouterResponse struct {
 	Vpcname             string                                             `json:"vpcname"`
 	Zoneid              string                                             `json:"zoneid"`
 	Zonename            string                                             `json:"zonename"`
+	Extra               map[string]json.RawMessage                         `json:"-"`
+}
+
+func (r *ChangeServiceForRouterResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeServiceForRouterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeServiceForRouterResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeServiceForRouterResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias ChangeServiceForRouterResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ConfigureVirtualRouterElementParams struct {
@@ -227,6 +316,45 @@ func (p *ConfigureVirtualRouterElementParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the configureVirtualRouterElement API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ConfigureVirtualRouterElementParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("configureVirtualRouterElement", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the configureVirtualRouterElement API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ConfigureVirtualRouterElementParams) Validate() error {
+	return paramRules{
+		required: []string{"enabled", "id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("configureVirtualRouterElement", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the configureVirtualRouterElement API, before the request is signed
+func (p *ConfigureVirtualRouterElementParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ConfigureVirtualRouterElementParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ConfigureVirtualRouterElementParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("configureVirtualRouterElement", b, &p.p, map[string]paramDecoder{
+		"enabled": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ConfigureVirtualRouterElementParams) Clone() *ConfigureVirtualRouterElementParams {
+	return &ConfigureVirtualRouterElementParams{p: cloneParams(p.p)}
+}
+
 func (p *ConfigureVirtualRouterElementParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -281,32 +409,39 @@ func (s *RouterService) NewConfigureVirtualRouterElementParams(enabled bool, id
 
 // Configures a virtual router element.
 func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
-	resp, err := s.cs.newPostRequest("configureVirtualRouterElement", p.toURLValues())
+	return s.ConfigureVirtualRouterElementWithContext(context.Background(), p)
+}
+
+// ConfigureVirtualRouterElementWithContext is like ConfigureVirtualRouterElement, but honours the cancellation and deadline of ctx
+func (s *RouterService) ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "configureVirtualRouterElement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r VirtualRouterElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("configureVirtualRouterElement", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "configureVirtualRouterElement", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("configureVirtualRouterElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -314,18 +449,43 @@ func (s *RouterService) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterE
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ConfigureVirtualRouterElement async job into r
+func (r *VirtualRouterElementResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ConfigureVirtualRouterElementAsync starts the async job of ConfigureVirtualRouterElement without waiting for it to finish, and returns a handle to the job
+func (s *RouterService) ConfigureVirtualRouterElementAsync(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*Job, error) {
+	r, err := s.ConfigureVirtualRouterElementWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("configureVirtualRouterElement", r.JobID), nil
+}
+
 type VirtualRouterElementResponse struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Enabled    bool   `json:"enabled"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Nspid      string `json:"nspid"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Enabled    FlexBool                   `json:"enabled"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Nspid      string                     `json:"nspid"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VirtualRouterElementResponse) UnmarshalJSON(b []byte) error {
+	type alias VirtualRouterElementResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVirtualRouterElementParams struct {
@@ -346,6 +506,45 @@ func (p *CreateVirtualRouterElementParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVirtualRouterElement API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVirtualRouterElementParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVirtualRouterElement", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVirtualRouterElement API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVirtualRouterElementParams) Validate() error {
+	return paramRules{
+		required: []string{"nspid"},
+		lengths:  map[string]int{"nspid": 255, "providertype": 255},
+	}.validate("createVirtualRouterElement", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVirtualRouterElement API, before the request is signed
+func (p *CreateVirtualRouterElementParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVirtualRouterElementParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVirtualRouterElementParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVirtualRouterElement", b, &p.p, map[string]paramDecoder{
+		"nspid":        decodeParam[string],
+		"providertype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVirtualRouterElementParams) Clone() *CreateVirtualRouterElementParams {
+	return &CreateVirtualRouterElementParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVirtualRouterElementParams) SetNspid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -399,32 +598,39 @@ func (s *RouterService) NewCreateVirtualRouterElementParams(nspid string) *Creat
 
 // Create a virtual router element.
 func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
-	resp, err := s.cs.newPostRequest("createVirtualRouterElement", p.toURLValues())
+	return s.CreateVirtualRouterElementWithContext(context.Background(), p)
+}
+
+// CreateVirtualRouterElementWithContext is like CreateVirtualRouterElement, but honours the cancellation and deadline of ctx
+func (s *RouterService) CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVirtualRouterElement", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVirtualRouterElementResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVirtualRouterElement", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVirtualRouterElement", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVirtualRouterElement", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -432,18 +638,43 @@ func (s *RouterService) CreateVirtualRouterElement(p *CreateVirtualRouterElement
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVirtualRouterElement async job into r
+func (r *CreateVirtualRouterElementResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVirtualRouterElementAsync starts the async job of CreateVirtualRouterElement without waiting for it to finish, and returns a handle to the job
+func (s *RouterService) CreateVirtualRouterElementAsync(ctx context.Context, p *CreateVirtualRouterElementParams) (*Job, error) {
+	r, err := s.CreateVirtualRouterElementWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVirtualRouterElement", r.JobID), nil
+}
+
 type CreateVirtualRouterElementResponse struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Enabled    bool   `json:"enabled"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Nspid      string `json:"nspid"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Enabled    FlexBool                   `json:"enabled"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Nspid      string                     `json:"nspid"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVirtualRouterElementResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVirtualRouterElementResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroyRouterParams struct {
@@ -461,6 +692,44 @@ func (p *DestroyRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the destroyRouter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DestroyRouterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("destroyRouter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the destroyRouter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DestroyRouterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("destroyRouter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the destroyRouter API, before the request is signed
+func (p *DestroyRouterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DestroyRouterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DestroyRouterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("destroyRouter", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DestroyRouterParams) Clone() *DestroyRouterParams {
+	return &DestroyRouterParams{p: cloneParams(p.p)}
+}
+
 func (p *DestroyRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -493,32 +762,39 @@ func (s *RouterService) NewDestroyRouterParams(id string) *DestroyRouterParams {
 
 // Destroys a router.
 func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
-	resp, err := s.cs.newPostRequest("destroyRouter", p.toURLValues())
+	return s.DestroyRouterWithContext(context.Background(), p)
+}
+
+// DestroyRouterWithContext is like DestroyRouter, but honours the cancellation and deadline of ctx
+func (s *RouterService) DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "destroyRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DestroyRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("destroyRouter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "destroyRouter", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("destroyRouter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -526,10 +802,29 @@ func (s *RouterService) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterRes
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DestroyRouter async job into r
+func (r *DestroyRouterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DestroyRouterAsync starts the async job of DestroyRouter without waiting for it to finish, and returns a handle to the job
+func (s *RouterService) DestroyRouterAsync(ctx context.Context, p *DestroyRouterParams) (*Job, error) {
+	r, err := s.DestroyRouterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("destroyRouter", r.JobID), nil
+}
+
 type DestroyRouterResponse struct {
 	Account             string                                    `json:"account"`
 	Arch                string                                    `json:"arch"`
-	Created             string                                    `json:"created"`
+	Created             Time                                      `json:"created"`
 	Dns1                string                                    `json:"dns1"`
 	Dns2                string                                    `json:"dns2"`
 	Domain              string                                    `json:"domain"`
@@ -541,9 +836,9 @@ type DestroyRouterResponse struct {
 	Guestnetmask        string                                    `json:"guestnetmask"`
 	Guestnetworkid      string                                    `json:"guestnetworkid"`
 	Guestnetworkname    string                                    `json:"guestnetworkname"`
-	Hasannotations      bool                                      `json:"hasannotations"`
+	Hasannotations      FlexBool                                  `json:"hasannotations"`
 	Healthcheckresults  []DestroyRouterResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                      `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                  `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                    `json:"hostcontrolstate"`
 	Hostid              string                                    `json:"hostid"`
 	Hostname            string                                    `json:"hostname"`
@@ -551,9 +846,9 @@ type DestroyRouterResponse struct {
 	Id                  string                                    `json:"id"`
 	Ip6dns1             string                                    `json:"ip6dns1"`
 	Ip6dns2             string                                    `json:"ip6dns2"`
-	Isredundantrouter   bool                                      `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                  `json:"isredundantrouter"`
 	JobID               string                                    `json:"jobid"`
-	Jobstatus           int                                       `json:"jobstatus"`
+	Jobstatus           FlexInt                                   `json:"jobstatus"`
 	Linklocalip         string                                    `json:"linklocalip"`
 	Linklocalmacaddress string                                    `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                    `json:"linklocalnetmask"`
@@ -570,7 +865,7 @@ type DestroyRouterResponse struct {
 	Publicnetmask       string                                    `json:"publicnetmask"`
 	Publicnetworkid     string                                    `json:"publicnetworkid"`
 	Redundantstate      string                                    `json:"redundantstate"`
-	Requiresupgrade     bool                                      `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                  `json:"requiresupgrade"`
 	Role                string                                    `json:"role"`
 	Scriptsversion      string                                    `json:"scriptsversion"`
 	Serviceofferingid   string                                    `json:"serviceofferingid"`
@@ -584,15 +879,27 @@ type DestroyRouterResponse struct {
 	Vpcname             string                                    `json:"vpcname"`
 	Zoneid              string                                    `json:"zoneid"`
 	Zonename            string                                    `json:"zonename"`
+	Extra               map[string]json.RawMessage                `json:"-"`
+}
+
+func (r *DestroyRouterResponse) UnmarshalJSON(b []byte) error {
+	type alias DestroyRouterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroyRouterResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DestroyRouterResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias DestroyRouterResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetRouterHealthCheckResultsParams struct {
@@ -614,6 +921,45 @@ func (p *GetRouterHealthCheckResultsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getRouterHealthCheckResults API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetRouterHealthCheckResultsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getRouterHealthCheckResults", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getRouterHealthCheckResults API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetRouterHealthCheckResultsParams) Validate() error {
+	return paramRules{
+		required: []string{"routerid"},
+		lengths:  map[string]int{"routerid": 255},
+	}.validate("getRouterHealthCheckResults", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getRouterHealthCheckResults API, before the request is signed
+func (p *GetRouterHealthCheckResultsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetRouterHealthCheckResultsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetRouterHealthCheckResultsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getRouterHealthCheckResults", b, &p.p, map[string]paramDecoder{
+		"performfreshchecks": decodeParam[bool],
+		"routerid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetRouterHealthCheckResultsParams) Clone() *GetRouterHealthCheckResultsParams {
+	return &GetRouterHealthCheckResultsParams{p: cloneParams(p.p)}
+}
+
 func (p *GetRouterHealthCheckResultsParams) SetPerformfreshchecks(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -667,13 +1013,22 @@ func (s *RouterService) NewGetRouterHealthCheckResultsParams(routerid string) *G
 
 // Starts a router.
 func (s *RouterService) GetRouterHealthCheckResults(p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error) {
-	resp, err := s.cs.newRequest("getRouterHealthCheckResults", p.toURLValues())
+	return s.GetRouterHealthCheckResultsWithContext(context.Background(), p)
+}
+
+// GetRouterHealthCheckResultsWithContext is like GetRouterHealthCheckResults, but honours the cancellation and deadline of ctx
+func (s *RouterService) GetRouterHealthCheckResultsWithContext(ctx context.Context, p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getRouterHealthCheckResults", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetRouterHealthCheckResultsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getRouterHealthCheckResults", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -681,10 +1036,16 @@ func (s *RouterService) GetRouterHealthCheckResults(p *GetRouterHealthCheckResul
 }
 
 type GetRouterHealthCheckResultsResponse struct {
-	Healthchecks []string `json:"healthchecks"`
-	JobID        string   `json:"jobid"`
-	Jobstatus    int      `json:"jobstatus"`
-	Routerid     string   `json:"routerid"`
+	Healthchecks []string                   `json:"healthchecks"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Routerid     string                     `json:"routerid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetRouterHealthCheckResultsResponse) UnmarshalJSON(b []byte) error {
+	type alias GetRouterHealthCheckResultsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListRoutersParams struct {
@@ -772,6 +1133,64 @@ func (p *ListRoutersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listRouters API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListRoutersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listRouters", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listRouters API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListRoutersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "arch": 255, "clusterid": 255, "domainid": 255, "hostid": 255, "id": 255, "keyword": 255, "name": 255, "networkid": 255, "podid": 255, "projectid": 255, "state": 255, "version": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listRouters", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listRouters API, before the request is signed
+func (p *ListRoutersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListRoutersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListRoutersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listRouters", b, &p.p, map[string]paramDecoder{
+		"account":                 decodeParam[string],
+		"arch":                    decodeParam[string],
+		"clusterid":               decodeParam[string],
+		"domainid":                decodeParam[string],
+		"fetchhealthcheckresults": decodeParam[bool],
+		"forvpc":                  decodeParam[bool],
+		"healthchecksfailed":      decodeParam[bool],
+		"hostid":                  decodeParam[string],
+		"id":                      decodeParam[string],
+		"isrecursive":             decodeParam[bool],
+		"keyword":                 decodeParam[string],
+		"listall":                 decodeParam[bool],
+		"name":                    decodeParam[string],
+		"networkid":               decodeParam[string],
+		"page":                    decodeParam[int],
+		"pagesize":                decodeParam[int],
+		"podid":                   decodeParam[string],
+		"projectid":               decodeParam[string],
+		"state":                   decodeParam[string],
+		"version":                 decodeParam[string],
+		"vpcid":                   decodeParam[string],
+		"zoneid":                  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListRoutersParams) Clone() *ListRoutersParams {
+	return &ListRoutersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListRoutersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1244,6 +1663,11 @@ func (s *RouterService) NewListRoutersParams() *ListRoutersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetRouterIDWithContext(context.Background(), name, opts...)
+}
+
+// GetRouterIDWithContext is like GetRouterID, but honours the cancellation and deadline of ctx
+func (s *RouterService) GetRouterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListRoutersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1255,7 +1679,7 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 		}
 	}
 
-	l, err := s.ListRouters(p)
+	l, err := s.ListRoutersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1280,12 +1704,17 @@ func (s *RouterService) GetRouterID(name string, opts ...OptionFunc) (string, in
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error) {
-	id, count, err := s.GetRouterID(name, opts...)
+	return s.GetRouterByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetRouterByNameWithContext is like GetRouterByName, but honours the cancellation and deadline of ctx
+func (s *RouterService) GetRouterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Router, int, error) {
+	id, count, err := s.GetRouterIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetRouterByID(id, opts...)
+	r, count, err := s.GetRouterByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1294,6 +1723,11 @@ func (s *RouterService) GetRouterByName(name string, opts ...OptionFunc) (*Route
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error) {
+	return s.GetRouterByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetRouterByIDWithContext is like GetRouterByID, but honours the cancellation and deadline of ctx
+func (s *RouterService) GetRouterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Router, int, error) {
 	p := &ListRoutersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1305,11 +1739,10 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 		}
 	}
 
-	l, err := s.ListRouters(p)
+	l, err := s.ListRoutersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1327,19 +1760,56 @@ func (s *RouterService) GetRouterByID(id string, opts ...OptionFunc) (*Router, i
 
 // List routers.
 func (s *RouterService) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
-	resp, err := s.cs.newRequest("listRouters", p.toURLValues())
+	return s.ListRoutersWithContext(context.Background(), p)
+}
+
+// ListRoutersWithContext is like ListRouters, but honours the cancellation and deadline of ctx
+func (s *RouterService) ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listRouters", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListRoutersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listRouters", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListRoutersAll returns all Routers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *RouterService) ListRoutersAll(p *ListRoutersParams, opts ...PageOption) ([]*Router, error) {
+	return s.ListRoutersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListRoutersAllWithContext is like ListRoutersAll, but honours the cancellation and deadline of ctx
+func (s *RouterService) ListRoutersAllWithContext(ctx context.Context, p *ListRoutersParams, opts ...PageOption) ([]*Router, error) {
+	return collectPages(s.ListRoutersIterWithContext(ctx, p, opts...))
+}
+
+// ListRoutersIter returns an iterator over all Routers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *RouterService) ListRoutersIter(p *ListRoutersParams, opts ...PageOption) iter.Seq2[*Router, error] {
+	return s.ListRoutersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListRoutersIterWithContext is like ListRoutersIter, but honours the cancellation and deadline of ctx
+func (s *RouterService) ListRoutersIterWithContext(ctx context.Context, p *ListRoutersParams, opts ...PageOption) iter.Seq2[*Router, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Router, int, error) {
+		l, err := s.ListRoutersWithContext(ctx, &ListRoutersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Routers, l.Count, nil
+	}, opts...)
+}
+
 type ListRoutersResponse struct {
 	Count   int       `json:"count"`
 	Routers []*Router `json:"router"`
@@ -1348,7 +1818,7 @@ type ListRoutersResponse struct {
 type Router struct {
 	Account             string                     `json:"account"`
 	Arch                string                     `json:"arch"`
-	Created             string                     `json:"created"`
+	Created             Time                       `json:"created"`
 	Dns1                string                     `json:"dns1"`
 	Dns2                string                     `json:"dns2"`
 	Domain              string                     `json:"domain"`
@@ -1360,9 +1830,9 @@ type Router struct {
 	Guestnetmask        string                     `json:"guestnetmask"`
 	Guestnetworkid      string                     `json:"guestnetworkid"`
 	Guestnetworkname    string                     `json:"guestnetworkname"`
-	Hasannotations      bool                       `json:"hasannotations"`
+	Hasannotations      FlexBool                   `json:"hasannotations"`
 	Healthcheckresults  []RouterHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                       `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                   `json:"healthchecksfailed"`
 	Hostcontrolstate    string                     `json:"hostcontrolstate"`
 	Hostid              string                     `json:"hostid"`
 	Hostname            string                     `json:"hostname"`
@@ -1370,9 +1840,9 @@ type Router struct {
 	Id                  string                     `json:"id"`
 	Ip6dns1             string                     `json:"ip6dns1"`
 	Ip6dns2             string                     `json:"ip6dns2"`
-	Isredundantrouter   bool                       `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                   `json:"isredundantrouter"`
 	JobID               string                     `json:"jobid"`
-	Jobstatus           int                        `json:"jobstatus"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
 	Linklocalip         string                     `json:"linklocalip"`
 	Linklocalmacaddress string                     `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                     `json:"linklocalnetmask"`
@@ -1389,7 +1859,7 @@ type Router struct {
 	Publicnetmask       string                     `json:"publicnetmask"`
 	Publicnetworkid     string                     `json:"publicnetworkid"`
 	Redundantstate      string                     `json:"redundantstate"`
-	Requiresupgrade     bool                       `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                   `json:"requiresupgrade"`
 	Role                string                     `json:"role"`
 	Scriptsversion      string                     `json:"scriptsversion"`
 	Serviceofferingid   string                     `json:"serviceofferingid"`
@@ -1403,15 +1873,27 @@ type Router struct {
 	Vpcname             string                     `json:"vpcname"`
 	Zoneid              string                     `json:"zoneid"`
 	Zonename            string                     `json:"zonename"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Router) UnmarshalJSON(b []byte) error {
+	type alias Router
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RouterHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RouterHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias RouterHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVirtualRouterElementsParams struct {
@@ -1447,6 +1929,48 @@ func (p *ListVirtualRouterElementsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVirtualRouterElements API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVirtualRouterElementsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVirtualRouterElements", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVirtualRouterElements API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVirtualRouterElementsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "nspid": 255},
+	}.validate("listVirtualRouterElements", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVirtualRouterElements API, before the request is signed
+func (p *ListVirtualRouterElementsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVirtualRouterElementsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVirtualRouterElementsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVirtualRouterElements", b, &p.p, map[string]paramDecoder{
+		"enabled":  decodeParam[bool],
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"nspid":    decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVirtualRouterElementsParams) Clone() *ListVirtualRouterElementsParams {
+	return &ListVirtualRouterElementsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVirtualRouterElementsParams) SetEnabled(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1583,6 +2107,11 @@ func (s *RouterService) NewListVirtualRouterElementsParams() *ListVirtualRouterE
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
+	return s.GetVirtualRouterElementByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVirtualRouterElementByIDWithContext is like GetVirtualRouterElementByID, but honours the cancellation and deadline of ctx
+func (s *RouterService) GetVirtualRouterElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
 	p := &ListVirtualRouterElementsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1594,11 +2123,10 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListVirtualRouterElements(p)
+	l, err := s.ListVirtualRouterElementsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1616,36 +2144,79 @@ func (s *RouterService) GetVirtualRouterElementByID(id string, opts ...OptionFun
 
 // Lists all available virtual router elements.
 func (s *RouterService) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualRouterElements", p.toURLValues())
+	return s.ListVirtualRouterElementsWithContext(context.Background(), p)
+}
+
+// ListVirtualRouterElementsWithContext is like ListVirtualRouterElements, but honours the cancellation and deadline of ctx
+func (s *RouterService) ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVirtualRouterElements", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVirtualRouterElementsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVirtualRouterElements", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVirtualRouterElementsAll returns all VirtualRouterElements matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *RouterService) ListVirtualRouterElementsAll(p *ListVirtualRouterElementsParams, opts ...PageOption) ([]*VirtualRouterElement, error) {
+	return s.ListVirtualRouterElementsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualRouterElementsAllWithContext is like ListVirtualRouterElementsAll, but honours the cancellation and deadline of ctx
+func (s *RouterService) ListVirtualRouterElementsAllWithContext(ctx context.Context, p *ListVirtualRouterElementsParams, opts ...PageOption) ([]*VirtualRouterElement, error) {
+	return collectPages(s.ListVirtualRouterElementsIterWithContext(ctx, p, opts...))
+}
+
+// ListVirtualRouterElementsIter returns an iterator over all VirtualRouterElements matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *RouterService) ListVirtualRouterElementsIter(p *ListVirtualRouterElementsParams, opts ...PageOption) iter.Seq2[*VirtualRouterElement, error] {
+	return s.ListVirtualRouterElementsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualRouterElementsIterWithContext is like ListVirtualRouterElementsIter, but honours the cancellation and deadline of ctx
+func (s *RouterService) ListVirtualRouterElementsIterWithContext(ctx context.Context, p *ListVirtualRouterElementsParams, opts ...PageOption) iter.Seq2[*VirtualRouterElement, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VirtualRouterElement, int, error) {
+		l, err := s.ListVirtualRouterElementsWithContext(ctx, &ListVirtualRouterElementsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VirtualRouterElements, l.Count, nil
+	}, opts...)
+}
+
 type ListVirtualRouterElementsResponse struct {
 	Count                 int                     `json:"count"`
 	VirtualRouterElements []*VirtualRouterElement `json:"virtualrouterelement"`
 }
 
 type VirtualRouterElement struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Enabled    bool   `json:"enabled"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Nspid      string `json:"nspid"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Enabled    FlexBool                   `json:"enabled"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Nspid      string                     `json:"nspid"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VirtualRouterElement) UnmarshalJSON(b []byte) error {
+	type alias VirtualRouterElement
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RebootRouterParams struct {
@@ -1667,6 +2238,45 @@ func (p *RebootRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the rebootRouter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RebootRouterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("rebootRouter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the rebootRouter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RebootRouterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("rebootRouter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the rebootRouter API, before the request is signed
+func (p *RebootRouterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RebootRouterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RebootRouterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("rebootRouter", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RebootRouterParams) Clone() *RebootRouterParams {
+	return &RebootRouterParams{p: cloneParams(p.p)}
+}
+
 func (p *RebootRouterParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1720,32 +2330,39 @@ func (s *RouterService) NewRebootRouterParams(id string) *RebootRouterParams {
 
 // Starts a router.
 func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterResponse, error) {
-	resp, err := s.cs.newPostRequest("rebootRouter", p.toURLValues())
+	return s.RebootRouterWithContext(context.Background(), p)
+}
+
+// RebootRouterWithContext is like RebootRouter, but honours the cancellation and deadline of ctx
+func (s *RouterService) RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "rebootRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RebootRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("rebootRouter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "rebootRouter", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("rebootRouter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1753,10 +2370,29 @@ func (s *RouterService) RebootRouter(p *RebootRouterParams) (*RebootRouterRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RebootRouter async job into r
+func (r *RebootRouterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RebootRouterAsync starts the async job of RebootRouter without waiting for it to finish, and returns a handle to the job
+func (s *RouterService) RebootRouterAsync(ctx context.Context, p *RebootRouterParams) (*Job, error) {
+	r, err := s.RebootRouterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("rebootRouter", r.JobID), nil
+}
+
 type RebootRouterResponse struct {
 	Account             string                                   `json:"account"`
 	Arch                string                                   `json:"arch"`
-	Created             string                                   `json:"created"`
+	Created             Time                                     `json:"created"`
 	Dns1                string                                   `json:"dns1"`
 	Dns2                string                                   `json:"dns2"`
 	Domain              string                                   `json:"domain"`
@@ -1768,9 +2404,9 @@ type RebootRouterResponse struct {
 	Guestnetmask        string                                   `json:"guestnetmask"`
 	Guestnetworkid      string                                   `json:"guestnetworkid"`
 	Guestnetworkname    string                                   `json:"guestnetworkname"`
-	Hasannotations      bool                                     `json:"hasannotations"`
+	Hasannotations      FlexBool                                 `json:"hasannotations"`
 	Healthcheckresults  []RebootRouterResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                     `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                 `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                   `json:"hostcontrolstate"`
 	Hostid              string                                   `json:"hostid"`
 	Hostname            string                                   `json:"hostname"`
@@ -1778,9 +2414,9 @@ type RebootRouterResponse struct {
 	Id                  string                                   `json:"id"`
 	Ip6dns1             string                                   `json:"ip6dns1"`
 	Ip6dns2             string                                   `json:"ip6dns2"`
-	Isredundantrouter   bool                                     `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                 `json:"isredundantrouter"`
 	JobID               string                                   `json:"jobid"`
-	Jobstatus           int                                      `json:"jobstatus"`
+	Jobstatus           FlexInt                                  `json:"jobstatus"`
 	Linklocalip         string                                   `json:"linklocalip"`
 	Linklocalmacaddress string                                   `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                   `json:"linklocalnetmask"`
@@ -1797,7 +2433,7 @@ type RebootRouterResponse struct {
 	Publicnetmask       string                                   `json:"publicnetmask"`
 	Publicnetworkid     string                                   `json:"publicnetworkid"`
 	Redundantstate      string                                   `json:"redundantstate"`
-	Requiresupgrade     bool                                     `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                 `json:"requiresupgrade"`
 	Role                string                                   `json:"role"`
 	Scriptsversion      string                                   `json:"scriptsversion"`
 	Serviceofferingid   string                                   `json:"serviceofferingid"`
@@ -1811,15 +2447,27 @@ type RebootRouterResponse struct {
 	Vpcname             string                                   `json:"vpcname"`
 	Zoneid              string                                   `json:"zoneid"`
 	Zonename            string                                   `json:"zonename"`
+	Extra               map[string]json.RawMessage               `json:"-"`
+}
+
+func (r *RebootRouterResponse) UnmarshalJSON(b []byte) error {
+	type alias RebootRouterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RebootRouterResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RebootRouterResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias RebootRouterResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartRouterParams struct {
@@ -1837,6 +2485,44 @@ func (p *StartRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the startRouter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StartRouterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("startRouter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the startRouter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StartRouterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("startRouter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the startRouter API, before the request is signed
+func (p *StartRouterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StartRouterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StartRouterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("startRouter", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StartRouterParams) Clone() *StartRouterParams {
+	return &StartRouterParams{p: cloneParams(p.p)}
+}
+
 func (p *StartRouterParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1869,32 +2555,39 @@ func (s *RouterService) NewStartRouterParams(id string) *StartRouterParams {
 
 // Starts a router.
 func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
-	resp, err := s.cs.newPostRequest("startRouter", p.toURLValues())
+	return s.StartRouterWithContext(context.Background(), p)
+}
+
+// StartRouterWithContext is like StartRouter, but honours the cancellation and deadline of ctx
+func (s *RouterService) StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "startRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StartRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("startRouter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "startRouter", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("startRouter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1902,10 +2595,29 @@ func (s *RouterService) StartRouter(p *StartRouterParams) (*StartRouterResponse,
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StartRouter async job into r
+func (r *StartRouterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StartRouterAsync starts the async job of StartRouter without waiting for it to finish, and returns a handle to the job
+func (s *RouterService) StartRouterAsync(ctx context.Context, p *StartRouterParams) (*Job, error) {
+	r, err := s.StartRouterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("startRouter", r.JobID), nil
+}
+
 type StartRouterResponse struct {
 	Account             string                                  `json:"account"`
 	Arch                string                                  `json:"arch"`
-	Created             string                                  `json:"created"`
+	Created             Time                                    `json:"created"`
 	Dns1                string                                  `json:"dns1"`
 	Dns2                string                                  `json:"dns2"`
 	Domain              string                                  `json:"domain"`
@@ -1917,9 +2629,9 @@ type StartRouterResponse struct {
 	Guestnetmask        string                                  `json:"guestnetmask"`
 	Guestnetworkid      string                                  `json:"guestnetworkid"`
 	Guestnetworkname    string                                  `json:"guestnetworkname"`
-	Hasannotations      bool                                    `json:"hasannotations"`
+	Hasannotations      FlexBool                                `json:"hasannotations"`
 	Healthcheckresults  []StartRouterResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                    `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                                `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                  `json:"hostcontrolstate"`
 	Hostid              string                                  `json:"hostid"`
 	Hostname            string                                  `json:"hostname"`
@@ -1927,9 +2639,9 @@ type StartRouterResponse struct {
 	Id                  string                                  `json:"id"`
 	Ip6dns1             string                                  `json:"ip6dns1"`
 	Ip6dns2             string                                  `json:"ip6dns2"`
-	Isredundantrouter   bool                                    `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                                `json:"isredundantrouter"`
 	JobID               string                                  `json:"jobid"`
-	Jobstatus           int                                     `json:"jobstatus"`
+	Jobstatus           FlexInt                                 `json:"jobstatus"`
 	Linklocalip         string                                  `json:"linklocalip"`
 	Linklocalmacaddress string                                  `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                  `json:"linklocalnetmask"`
@@ -1946,7 +2658,7 @@ type StartRouterResponse struct {
 	Publicnetmask       string                                  `json:"publicnetmask"`
 	Publicnetworkid     string                                  `json:"publicnetworkid"`
 	Redundantstate      string                                  `json:"redundantstate"`
-	Requiresupgrade     bool                                    `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                                `json:"requiresupgrade"`
 	Role                string                                  `json:"role"`
 	Scriptsversion      string                                  `json:"scriptsversion"`
 	Serviceofferingid   string                                  `json:"serviceofferingid"`
@@ -1960,15 +2672,27 @@ type StartRouterResponse struct {
 	Vpcname             string                                  `json:"vpcname"`
 	Zoneid              string                                  `json:"zoneid"`
 	Zonename            string                                  `json:"zonename"`
+	Extra               map[string]json.RawMessage              `json:"-"`
+}
+
+func (r *StartRouterResponse) UnmarshalJSON(b []byte) error {
+	type alias StartRouterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartRouterResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartRouterResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias StartRouterResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopRouterParams struct {
@@ -1990,6 +2714,45 @@ func (p *StopRouterParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the stopRouter API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StopRouterParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("stopRouter", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the stopRouter API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StopRouterParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("stopRouter", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the stopRouter API, before the request is signed
+func (p *StopRouterParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StopRouterParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StopRouterParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("stopRouter", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StopRouterParams) Clone() *StopRouterParams {
+	return &StopRouterParams{p: cloneParams(p.p)}
+}
+
 func (p *StopRouterParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2043,32 +2806,39 @@ func (s *RouterService) NewStopRouterParams(id string) *StopRouterParams {
 
 // Stops a router.
 func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
-	resp, err := s.cs.newPostRequest("stopRouter", p.toURLValues())
+	return s.StopRouterWithContext(context.Background(), p)
+}
+
+// StopRouterWithContext is like StopRouter, but honours the cancellation and deadline of ctx
+func (s *RouterService) StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "stopRouter", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StopRouterResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("stopRouter", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "stopRouter", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("stopRouter", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2076,10 +2846,29 @@ func (s *RouterService) StopRouter(p *StopRouterParams) (*StopRouterResponse, er
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StopRouter async job into r
+func (r *StopRouterResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StopRouterAsync starts the async job of StopRouter without waiting for it to finish, and returns a handle to the job
+func (s *RouterService) StopRouterAsync(ctx context.Context, p *StopRouterParams) (*Job, error) {
+	r, err := s.StopRouterWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("stopRouter", r.JobID), nil
+}
+
 type StopRouterResponse struct {
 	Account             string                                 `json:"account"`
 	Arch                string                                 `json:"arch"`
-	Created             string                                 `json:"created"`
+	Created             Time                                   `json:"created"`
 	Dns1                string                                 `json:"dns1"`
 	Dns2                string                                 `json:"dns2"`
 	Domain              string                                 `json:"domain"`
@@ -2091,9 +2880,9 @@ type StopRouterResponse struct {
 	Guestnetmask        string                                 `json:"guestnetmask"`
 	Guestnetworkid      string                                 `json:"guestnetworkid"`
 	Guestnetworkname    string                                 `json:"guestnetworkname"`
-	Hasannotations      bool                                   `json:"hasannotations"`
+	Hasannotations      FlexBool                               `json:"hasannotations"`
 	Healthcheckresults  []StopRouterResponseHealthcheckresults `json:"healthcheckresults"`
-	Healthchecksfailed  bool                                   `json:"healthchecksfailed"`
+	Healthchecksfailed  FlexBool                               `json:"healthchecksfailed"`
 	Hostcontrolstate    string                                 `json:"hostcontrolstate"`
 	Hostid              string                                 `json:"hostid"`
 	Hostname            string                                 `json:"hostname"`
@@ -2101,9 +2890,9 @@ type StopRouterResponse struct {
 	Id                  string                                 `json:"id"`
 	Ip6dns1             string                                 `json:"ip6dns1"`
 	Ip6dns2             string                                 `json:"ip6dns2"`
-	Isredundantrouter   bool                                   `json:"isredundantrouter"`
+	Isredundantrouter   FlexBool                               `json:"isredundantrouter"`
 	JobID               string                                 `json:"jobid"`
-	Jobstatus           int                                    `json:"jobstatus"`
+	Jobstatus           FlexInt                                `json:"jobstatus"`
 	Linklocalip         string                                 `json:"linklocalip"`
 	Linklocalmacaddress string                                 `json:"linklocalmacaddress"`
 	Linklocalnetmask    string                                 `json:"linklocalnetmask"`
@@ -2120,7 +2909,7 @@ type StopRouterResponse struct {
 	Publicnetmask       string                                 `json:"publicnetmask"`
 	Publicnetworkid     string                                 `json:"publicnetworkid"`
 	Redundantstate      string                                 `json:"redundantstate"`
-	Requiresupgrade     bool                                   `json:"requiresupgrade"`
+	Requiresupgrade     FlexBool                               `json:"requiresupgrade"`
 	Role                string                                 `json:"role"`
 	Scriptsversion      string                                 `json:"scriptsversion"`
 	Serviceofferingid   string                                 `json:"serviceofferingid"`
@@ -2134,13 +2923,25 @@ type StopRouterResponse struct {
 	Vpcname             string                                 `json:"vpcname"`
 	Zoneid              string                                 `json:"zoneid"`
 	Zonename            string                                 `json:"zonename"`
+	Extra               map[string]json.RawMessage             `json:"-"`
+}
+
+func (r *StopRouterResponse) UnmarshalJSON(b []byte) error {
+	type alias StopRouterResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopRouterResponseHealthcheckresults struct {
-	Checkname   string `json:"checkname"`
-	Checktype   string `json:"checktype"`
-	Details     string `json:"details"`
-	Lastupdated string `json:"lastupdated"`
-	Status      string `json:"status"`
-	Success     bool   `json:"success"`
+	Checkname   string                     `json:"checkname"`
+	Checktype   string                     `json:"checktype"`
+	Details     string                     `json:"details"`
+	Lastupdated Time                       `json:"lastupdated"`
+	Status      string                     `json:"status"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopRouterResponseHealthcheckresults) UnmarshalJSON(b []byte) error {
+	type alias StopRouterResponseHealthcheckresults
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockRouterServiceIfaceMockRecorder) ChangeServiceForRouter(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).ChangeServiceForRouter), p)
 }
 
+// ChangeServiceForRouterWithContext mocks base method.
+func (m *MockRouterServiceIface) ChangeServiceForRouterWithContext(ctx context.Context, p *ChangeServiceForRouterParams) (*ChangeServiceForRouterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeServiceForRouterWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeServiceForRouterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeServiceForRouterWithContext indicates an expected call of ChangeServiceForRouterWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ChangeServiceForRouterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForRouterWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ChangeServiceForRouterWithContext), ctx, p)
+}
+
 // ConfigureVirtualRouterElement mocks base method.
 func (m *MockRouterServiceIface) ConfigureVirtualRouterElement(p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockRouterServiceIfaceMockRecorder) ConfigureVirtualRouterElement(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureVirtualRouterElement", reflect.TypeOf((*MockRouterServiceIface)(nil).ConfigureVirtualRouterElement), p)
 }
 
+// ConfigureVirtualRouterElementAsync mocks base method.
+func (m *MockRouterServiceIface) ConfigureVirtualRouterElementAsync(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureVirtualRouterElementAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureVirtualRouterElementAsync indicates an expected call of ConfigureVirtualRouterElementAsync.
+func (mr *MockRouterServiceIfaceMockRecorder) ConfigureVirtualRouterElementAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureVirtualRouterElementAsync", reflect.TypeOf((*MockRouterServiceIface)(nil).ConfigureVirtualRouterElementAsync), ctx, p)
+}
+
+// ConfigureVirtualRouterElementWithContext mocks base method.
+func (m *MockRouterServiceIface) ConfigureVirtualRouterElementWithContext(ctx context.Context, p *ConfigureVirtualRouterElementParams) (*VirtualRouterElementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ConfigureVirtualRouterElementWithContext", ctx, p)
+	ret0, _ := ret[0].(*VirtualRouterElementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ConfigureVirtualRouterElementWithContext indicates an expected call of ConfigureVirtualRouterElementWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ConfigureVirtualRouterElementWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ConfigureVirtualRouterElementWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ConfigureVirtualRouterElementWithContext), ctx, p)
+}
+
 // CreateVirtualRouterElement mocks base method.
 func (m *MockRouterServiceIface) CreateVirtualRouterElement(p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,36 @@ func (mr *MockRouterServiceIfaceMockRecorder) CreateVirtualRouterElement(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualRouterElement", reflect.TypeOf((*MockRouterServiceIface)(nil).CreateVirtualRouterElement), p)
 }
 
+// CreateVirtualRouterElementAsync mocks base method.
+func (m *MockRouterServiceIface) CreateVirtualRouterElementAsync(ctx context.Context, p *CreateVirtualRouterElementParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualRouterElementAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVirtualRouterElementAsync indicates an expected call of CreateVirtualRouterElementAsync.
+func (mr *MockRouterServiceIfaceMockRecorder) CreateVirtualRouterElementAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualRouterElementAsync", reflect.TypeOf((*MockRouterServiceIface)(nil).CreateVirtualRouterElementAsync), ctx, p)
+}
+
+// CreateVirtualRouterElementWithContext mocks base method.
+func (m *MockRouterServiceIface) CreateVirtualRouterElementWithContext(ctx context.Context, p *CreateVirtualRouterElementParams) (*CreateVirtualRouterElementResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVirtualRouterElementWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVirtualRouterElementResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVirtualRouterElementWithContext indicates an expected call of CreateVirtualRouterElementWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) CreateVirtualRouterElementWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVirtualRouterElementWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).CreateVirtualRouterElementWithContext), ctx, p)
+}
+
 // DestroyRouter mocks base method.
 func (m *MockRouterServiceIface) DestroyRouter(p *DestroyRouterParams) (*DestroyRouterResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,36 @@ func (mr *MockRouterServiceIfaceMockRecorder) DestroyRouter(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).DestroyRouter), p)
 }
 
+// DestroyRouterAsync mocks base method.
+func (m *MockRouterServiceIface) DestroyRouterAsync(ctx context.Context, p *DestroyRouterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyRouterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyRouterAsync indicates an expected call of DestroyRouterAsync.
+func (mr *MockRouterServiceIfaceMockRecorder) DestroyRouterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyRouterAsync", reflect.TypeOf((*MockRouterServiceIface)(nil).DestroyRouterAsync), ctx, p)
+}
+
+// DestroyRouterWithContext mocks base method.
+func (m *MockRouterServiceIface) DestroyRouterWithContext(ctx context.Context, p *DestroyRouterParams) (*DestroyRouterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyRouterWithContext", ctx, p)
+	ret0, _ := ret[0].(*DestroyRouterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyRouterWithContext indicates an expected call of DestroyRouterWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) DestroyRouterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyRouterWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).DestroyRouterWithContext), ctx, p)
+}
+
 // GetRouterByID mocks base method.
 func (m *MockRouterServiceIface) GetRouterByID(id string, opts ...OptionFunc) (*Router, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +246,27 @@ func (mr *MockRouterServiceIfaceMockRecorder) GetRouterByID(id any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterByID", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterByID), varargs...)
 }
 
+// GetRouterByIDWithContext mocks base method.
+func (m *MockRouterServiceIface) GetRouterByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Router, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRouterByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Router)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRouterByIDWithContext indicates an expected call of GetRouterByIDWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) GetRouterByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterByIDWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterByIDWithContext), varargs...)
+}
+
 // GetRouterByName mocks base method.
 func (m *MockRouterServiceIface) GetRouterByName(name string, opts ...OptionFunc) (*Router, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +288,27 @@ func (mr *MockRouterServiceIfaceMockRecorder) GetRouterByName(name any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterByName", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterByName), varargs...)
 }
 
+// GetRouterByNameWithContext mocks base method.
+func (m *MockRouterServiceIface) GetRouterByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Router, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRouterByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Router)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRouterByNameWithContext indicates an expected call of GetRouterByNameWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) GetRouterByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterByNameWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterByNameWithContext), varargs...)
+}
+
 // GetRouterHealthCheckResults mocks base method.
 func (m *MockRouterServiceIface) GetRouterHealthCheckResults(p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +324,21 @@ func (mr *MockRouterServiceIfaceMockRecorder) GetRouterHealthCheckResults(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterHealthCheckResults", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterHealthCheckResults), p)
 }
 
+// GetRouterHealthCheckResultsWithContext mocks base method.
+func (m *MockRouterServiceIface) GetRouterHealthCheckResultsWithContext(ctx context.Context, p *GetRouterHealthCheckResultsParams) (*GetRouterHealthCheckResultsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetRouterHealthCheckResultsWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetRouterHealthCheckResultsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetRouterHealthCheckResultsWithContext indicates an expected call of GetRouterHealthCheckResultsWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) GetRouterHealthCheckResultsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterHealthCheckResultsWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterHealthCheckResultsWithContext), ctx, p)
+}
+
 // GetRouterID mocks base method.
 func (m *MockRouterServiceIface) GetRouterID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +360,27 @@ func (mr *MockRouterServiceIfaceMockRecorder) GetRouterID(name any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterID", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterID), varargs...)
 }
 
+// GetRouterIDWithContext mocks base method.
+func (m *MockRouterServiceIface) GetRouterIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRouterIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRouterIDWithContext indicates an expected call of GetRouterIDWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) GetRouterIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouterIDWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).GetRouterIDWithContext), varargs...)
+}
+
 // GetVirtualRouterElementByID mocks base method.
 func (m *MockRouterServiceIface) GetVirtualRouterElementByID(id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +402,27 @@ func (mr *MockRouterServiceIfaceMockRecorder) GetVirtualRouterElementByID(id any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualRouterElementByID", reflect.TypeOf((*MockRouterServiceIface)(nil).GetVirtualRouterElementByID), varargs...)
 }
 
+// GetVirtualRouterElementByIDWithContext mocks base method.
+func (m *MockRouterServiceIface) GetVirtualRouterElementByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualRouterElement, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualRouterElementByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualRouterElement)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualRouterElementByIDWithContext indicates an expected call of GetVirtualRouterElementByIDWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) GetVirtualRouterElementByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualRouterElementByIDWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).GetVirtualRouterElementByIDWithContext), varargs...)
+}
+
 // ListRouters mocks base method.
 func (m *MockRouterServiceIface) ListRouters(p *ListRoutersParams) (*ListRoutersResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +438,99 @@ func (mr *MockRouterServiceIfaceMockRecorder) ListRouters(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRouters", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRouters), p)
 }
 
+// ListRoutersAll mocks base method.
+func (m *MockRouterServiceIface) ListRoutersAll(p *ListRoutersParams, opts ...PageOption) ([]*Router, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutersAll", varargs...)
+	ret0, _ := ret[0].([]*Router)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutersAll indicates an expected call of ListRoutersAll.
+func (mr *MockRouterServiceIfaceMockRecorder) ListRoutersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutersAll", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRoutersAll), varargs...)
+}
+
+// ListRoutersAllWithContext mocks base method.
+func (m *MockRouterServiceIface) ListRoutersAllWithContext(ctx context.Context, p *ListRoutersParams, opts ...PageOption) ([]*Router, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Router)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutersAllWithContext indicates an expected call of ListRoutersAllWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ListRoutersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutersAllWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRoutersAllWithContext), varargs...)
+}
+
+// ListRoutersIter mocks base method.
+func (m *MockRouterServiceIface) ListRoutersIter(p *ListRoutersParams, opts ...PageOption) iter.Seq2[*Router, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Router, error])
+	return ret0
+}
+
+// ListRoutersIter indicates an expected call of ListRoutersIter.
+func (mr *MockRouterServiceIfaceMockRecorder) ListRoutersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutersIter", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRoutersIter), varargs...)
+}
+
+// ListRoutersIterWithContext mocks base method.
+func (m *MockRouterServiceIface) ListRoutersIterWithContext(ctx context.Context, p *ListRoutersParams, opts ...PageOption) iter.Seq2[*Router, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRoutersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Router, error])
+	return ret0
+}
+
+// ListRoutersIterWithContext indicates an expected call of ListRoutersIterWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ListRoutersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutersIterWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRoutersIterWithContext), varargs...)
+}
+
+// ListRoutersWithContext mocks base method.
+func (m *MockRouterServiceIface) ListRoutersWithContext(ctx context.Context, p *ListRoutersParams) (*ListRoutersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRoutersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListRoutersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRoutersWithContext indicates an expected call of ListRoutersWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ListRoutersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRoutersWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ListRoutersWithContext), ctx, p)
+}
+
 // ListVirtualRouterElements mocks base method.
 func (m *MockRouterServiceIface) ListVirtualRouterElements(p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +546,99 @@ func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElements(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElements", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElements), p)
 }
 
+// ListVirtualRouterElementsAll mocks base method.
+func (m *MockRouterServiceIface) ListVirtualRouterElementsAll(p *ListVirtualRouterElementsParams, opts ...PageOption) ([]*VirtualRouterElement, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualRouterElementsAll", varargs...)
+	ret0, _ := ret[0].([]*VirtualRouterElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualRouterElementsAll indicates an expected call of ListVirtualRouterElementsAll.
+func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElementsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElementsAll", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElementsAll), varargs...)
+}
+
+// ListVirtualRouterElementsAllWithContext mocks base method.
+func (m *MockRouterServiceIface) ListVirtualRouterElementsAllWithContext(ctx context.Context, p *ListVirtualRouterElementsParams, opts ...PageOption) ([]*VirtualRouterElement, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualRouterElementsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VirtualRouterElement)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualRouterElementsAllWithContext indicates an expected call of ListVirtualRouterElementsAllWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElementsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElementsAllWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElementsAllWithContext), varargs...)
+}
+
+// ListVirtualRouterElementsIter mocks base method.
+func (m *MockRouterServiceIface) ListVirtualRouterElementsIter(p *ListVirtualRouterElementsParams, opts ...PageOption) iter.Seq2[*VirtualRouterElement, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualRouterElementsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualRouterElement, error])
+	return ret0
+}
+
+// ListVirtualRouterElementsIter indicates an expected call of ListVirtualRouterElementsIter.
+func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElementsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElementsIter", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElementsIter), varargs...)
+}
+
+// ListVirtualRouterElementsIterWithContext mocks base method.
+func (m *MockRouterServiceIface) ListVirtualRouterElementsIterWithContext(ctx context.Context, p *ListVirtualRouterElementsParams, opts ...PageOption) iter.Seq2[*VirtualRouterElement, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualRouterElementsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualRouterElement, error])
+	return ret0
+}
+
+// ListVirtualRouterElementsIterWithContext indicates an expected call of ListVirtualRouterElementsIterWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElementsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElementsIterWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElementsIterWithContext), varargs...)
+}
+
+// ListVirtualRouterElementsWithContext mocks base method.
+func (m *MockRouterServiceIface) ListVirtualRouterElementsWithContext(ctx context.Context, p *ListVirtualRouterElementsParams) (*ListVirtualRouterElementsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualRouterElementsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVirtualRouterElementsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualRouterElementsWithContext indicates an expected call of ListVirtualRouterElementsWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) ListVirtualRouterElementsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualRouterElementsWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).ListVirtualRouterElementsWithContext), ctx, p)
+}
+
 // NewChangeServiceForRouterParams mocks base method.
 func (m *MockRouterServiceIface) NewChangeServiceForRouterParams(id, serviceofferingid string) *ChangeServiceForRouterParams {
 	m.ctrl.T.Helper()
@@ -402,6 +794,36 @@ func (mr *MockRouterServiceIfaceMockRecorder) RebootRouter(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).RebootRouter), p)
 }
 
+// RebootRouterAsync mocks base method.
+func (m *MockRouterServiceIface) RebootRouterAsync(ctx context.Context, p *RebootRouterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootRouterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootRouterAsync indicates an expected call of RebootRouterAsync.
+func (mr *MockRouterServiceIfaceMockRecorder) RebootRouterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootRouterAsync", reflect.TypeOf((*MockRouterServiceIface)(nil).RebootRouterAsync), ctx, p)
+}
+
+// RebootRouterWithContext mocks base method.
+func (m *MockRouterServiceIface) RebootRouterWithContext(ctx context.Context, p *RebootRouterParams) (*RebootRouterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootRouterWithContext", ctx, p)
+	ret0, _ := ret[0].(*RebootRouterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootRouterWithContext indicates an expected call of RebootRouterWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) RebootRouterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootRouterWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).RebootRouterWithContext), ctx, p)
+}
+
 // StartRouter mocks base method.
 func (m *MockRouterServiceIface) StartRouter(p *StartRouterParams) (*StartRouterResponse, error) {
 	m.ctrl.T.Helper()
@@ -417,6 +839,36 @@ func (mr *MockRouterServiceIfaceMockRecorder) StartRouter(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).StartRouter), p)
 }
 
+// StartRouterAsync mocks base method.
+func (m *MockRouterServiceIface) StartRouterAsync(ctx context.Context, p *StartRouterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRouterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRouterAsync indicates an expected call of StartRouterAsync.
+func (mr *MockRouterServiceIfaceMockRecorder) StartRouterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRouterAsync", reflect.TypeOf((*MockRouterServiceIface)(nil).StartRouterAsync), ctx, p)
+}
+
+// StartRouterWithContext mocks base method.
+func (m *MockRouterServiceIface) StartRouterWithContext(ctx context.Context, p *StartRouterParams) (*StartRouterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartRouterWithContext", ctx, p)
+	ret0, _ := ret[0].(*StartRouterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartRouterWithContext indicates an expected call of StartRouterWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) StartRouterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartRouterWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).StartRouterWithContext), ctx, p)
+}
+
 // StopRouter mocks base method.
 func (m *MockRouterServiceIface) StopRouter(p *StopRouterParams) (*StopRouterResponse, error) {
 	m.ctrl.T.Helper()
@@ -431,3 +883,33 @@ func (mr *MockRouterServiceIfaceMockRecorder) StopRouter(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRouter", reflect.TypeOf((*MockRouterServiceIface)(nil).StopRouter), p)
 }
+
+// StopRouterAsync mocks base method.
+func (m *MockRouterServiceIface) StopRouterAsync(ctx context.Context, p *StopRouterParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopRouterAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopRouterAsync indicates an expected call of StopRouterAsync.
+func (mr *MockRouterServiceIfaceMockRecorder) StopRouterAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRouterAsync", reflect.TypeOf((*MockRouterServiceIface)(nil).StopRouterAsync), ctx, p)
+}
+
+// StopRouterWithContext mocks base method.
+func (m *MockRouterServiceIface) StopRouterWithContext(ctx context.Context, p *StopRouterParams) (*StopRouterResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopRouterWithContext", ctx, p)
+	ret0, _ := ret[0].(*StopRouterResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopRouterWithContext indicates an expected call of StopRouterWithContext.
+func (mr *MockRouterServiceIfaceMockRecorder) StopRouterWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopRouterWithContext", reflect.TypeOf((*MockRouterServiceIface)(nil).StopRouterWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,17 +31,30 @@ import (
 
 type SSHServiceIface interface {
 	CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
+	CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error)
 	NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams
 	DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
+	DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error)
 	NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairParams
 	ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error)
+	ListSSHKeyPairsAll(p *ListSSHKeyPairsParams, opts ...PageOption) ([]*SSHKeyPair, error)
+	ListSSHKeyPairsAllWithContext(ctx context.Context, p *ListSSHKeyPairsParams, opts ...PageOption) ([]*SSHKeyPair, error)
+	ListSSHKeyPairsIter(p *ListSSHKeyPairsParams, opts ...PageOption) iter.Seq2[*SSHKeyPair, error]
+	ListSSHKeyPairsIterWithContext(ctx context.Context, p *ListSSHKeyPairsParams, opts ...PageOption) iter.Seq2[*SSHKeyPair, error]
 	NewListSSHKeyPairsParams() *ListSSHKeyPairsParams
 	GetSSHKeyPairID(name string, opts ...OptionFunc) (string, int, error)
+	GetSSHKeyPairIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetSSHKeyPairByName(name string, opts ...OptionFunc) (*SSHKeyPair, int, error)
+	GetSSHKeyPairByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SSHKeyPair, int, error)
 	GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPair, int, error)
+	GetSSHKeyPairByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SSHKeyPair, int, error)
 	RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
+	RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error)
 	NewRegisterSSHKeyPairParams(name string, publickey string) *RegisterSSHKeyPairParams
 	ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error)
+	ResetSSHKeyForVirtualMachineAsync(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*Job, error)
 	NewResetSSHKeyForVirtualMachineParams(id string) *ResetSSHKeyForVirtualMachineParams
 }
 
@@ -67,6 +82,47 @@ func (p *CreateSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSSHKeyPair API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSSHKeyPairParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSSHKeyPair", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSSHKeyPair API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSSHKeyPairParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "name": 255, "projectid": 255},
+	}.validate("createSSHKeyPair", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSSHKeyPair API, before the request is signed
+func (p *CreateSSHKeyPairParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSSHKeyPair", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"name":      decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSSHKeyPairParams) Clone() *CreateSSHKeyPairParams {
+	return &CreateSSHKeyPairParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -162,7 +218,16 @@ func (s *SSHService) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairPar
 
 // Create a new keypair and returns the private key
 func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
-	resp, err := s.cs.newPostRequest("createSSHKeyPair", p.toURLValues())
+	return s.CreateSSHKeyPairWithContext(context.Background(), p)
+}
+
+// CreateSSHKeyPairWithContext is like CreateSSHKeyPair, but honours the cancellation and deadline of ctx
+func (s *SSHService) CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createSSHKeyPair", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -172,7 +237,7 @@ func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyP
 	}
 
 	var r CreateSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createSSHKeyPair", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -180,18 +245,24 @@ func (s *SSHService) CreateSSHKeyPair(p *CreateSSHKeyPairParams) (*CreateSSHKeyP
 }
 
 type CreateSSHKeyPairResponse struct {
-	Account        string `json:"account"`
-	Domain         string `json:"domain"`
-	Domainid       string `json:"domainid"`
-	Fingerprint    string `json:"fingerprint"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Privatekey     string `json:"privatekey"`
-	Project        string `json:"project"`
-	Projectid      string `json:"projectid"`
+	Account        string                     `json:"account"`
+	Domain         string                     `json:"domain"`
+	Domainid       string                     `json:"domainid"`
+	Fingerprint    string                     `json:"fingerprint"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Privatekey     string                     `json:"privatekey"`
+	Project        string                     `json:"project"`
+	Projectid      string                     `json:"projectid"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateSSHKeyPairResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateSSHKeyPairResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteSSHKeyPairParams struct {
@@ -218,6 +289,47 @@ func (p *DeleteSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteSSHKeyPair API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteSSHKeyPairParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteSSHKeyPair", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteSSHKeyPair API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteSSHKeyPairParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "name": 255, "projectid": 255},
+	}.validate("deleteSSHKeyPair", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteSSHKeyPair API, before the request is signed
+func (p *DeleteSSHKeyPairParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteSSHKeyPair", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"name":      decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteSSHKeyPairParams) Clone() *DeleteSSHKeyPairParams {
+	return &DeleteSSHKeyPairParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -313,13 +425,22 @@ func (s *SSHService) NewDeleteSSHKeyPairParams(name string) *DeleteSSHKeyPairPar
 
 // Deletes a keypair by name
 func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteSSHKeyPair", p.toURLValues())
+	return s.DeleteSSHKeyPairWithContext(context.Background(), p)
+}
+
+// DeleteSSHKeyPairWithContext is like DeleteSSHKeyPair, but honours the cancellation and deadline of ctx
+func (s *SSHService) DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteSSHKeyPair", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteSSHKeyPair", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -327,10 +448,11 @@ func (s *SSHService) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyP
 }
 
 type DeleteSSHKeyPairResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteSSHKeyPairResponse) UnmarshalJSON(b []byte) error {
@@ -357,7 +479,7 @@ func (r *DeleteSSHKeyPairResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteSSHKeyPairResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSSHKeyPairsParams struct {
@@ -409,6 +531,53 @@ func (p *ListSSHKeyPairsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSSHKeyPairs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSSHKeyPairsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSSHKeyPairs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSSHKeyPairs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSSHKeyPairsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "fingerprint": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255},
+	}.validate("listSSHKeyPairs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSSHKeyPairs API, before the request is signed
+func (p *ListSSHKeyPairsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSSHKeyPairsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSSHKeyPairsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSSHKeyPairs", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fingerprint": decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSSHKeyPairsParams) Clone() *ListSSHKeyPairsParams {
+	return &ListSSHKeyPairsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSSHKeyPairsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -650,6 +819,11 @@ func (s *SSHService) NewListSSHKeyPairsParams() *ListSSHKeyPairsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SSHService) GetSSHKeyPairID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSSHKeyPairIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSSHKeyPairIDWithContext is like GetSSHKeyPairID, but honours the cancellation and deadline of ctx
+func (s *SSHService) GetSSHKeyPairIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSSHKeyPairsParams{}
 	p.p = make(map[string]interface{})
 
@@ -661,7 +835,7 @@ func (s *SSHService) GetSSHKeyPairID(name string, opts ...OptionFunc) (string, i
 		}
 	}
 
-	l, err := s.ListSSHKeyPairs(p)
+	l, err := s.ListSSHKeyPairsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -686,12 +860,17 @@ func (s *SSHService) GetSSHKeyPairID(name string, opts ...OptionFunc) (string, i
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SSHService) GetSSHKeyPairByName(name string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
-	id, count, err := s.GetSSHKeyPairID(name, opts...)
+	return s.GetSSHKeyPairByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSSHKeyPairByNameWithContext is like GetSSHKeyPairByName, but honours the cancellation and deadline of ctx
+func (s *SSHService) GetSSHKeyPairByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
+	id, count, err := s.GetSSHKeyPairIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSSHKeyPairByID(id, opts...)
+	r, count, err := s.GetSSHKeyPairByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -700,6 +879,11 @@ func (s *SSHService) GetSSHKeyPairByName(name string, opts ...OptionFunc) (*SSHK
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SSHService) GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
+	return s.GetSSHKeyPairByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSSHKeyPairByIDWithContext is like GetSSHKeyPairByID, but honours the cancellation and deadline of ctx
+func (s *SSHService) GetSSHKeyPairByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
 	p := &ListSSHKeyPairsParams{}
 	p.p = make(map[string]interface{})
 
@@ -711,11 +895,10 @@ func (s *SSHService) GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPa
 		}
 	}
 
-	l, err := s.ListSSHKeyPairs(p)
+	l, err := s.ListSSHKeyPairsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -733,36 +916,79 @@ func (s *SSHService) GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPa
 
 // List registered keypairs
 func (s *SSHService) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
-	resp, err := s.cs.newRequest("listSSHKeyPairs", p.toURLValues())
+	return s.ListSSHKeyPairsWithContext(context.Background(), p)
+}
+
+// ListSSHKeyPairsWithContext is like ListSSHKeyPairs, but honours the cancellation and deadline of ctx
+func (s *SSHService) ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSSHKeyPairs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSSHKeyPairsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSSHKeyPairs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSSHKeyPairsAll returns all SSHKeyPairs matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SSHService) ListSSHKeyPairsAll(p *ListSSHKeyPairsParams, opts ...PageOption) ([]*SSHKeyPair, error) {
+	return s.ListSSHKeyPairsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSSHKeyPairsAllWithContext is like ListSSHKeyPairsAll, but honours the cancellation and deadline of ctx
+func (s *SSHService) ListSSHKeyPairsAllWithContext(ctx context.Context, p *ListSSHKeyPairsParams, opts ...PageOption) ([]*SSHKeyPair, error) {
+	return collectPages(s.ListSSHKeyPairsIterWithContext(ctx, p, opts...))
+}
+
+// ListSSHKeyPairsIter returns an iterator over all SSHKeyPairs matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SSHService) ListSSHKeyPairsIter(p *ListSSHKeyPairsParams, opts ...PageOption) iter.Seq2[*SSHKeyPair, error] {
+	return s.ListSSHKeyPairsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSSHKeyPairsIterWithContext is like ListSSHKeyPairsIter, but honours the cancellation and deadline of ctx
+func (s *SSHService) ListSSHKeyPairsIterWithContext(ctx context.Context, p *ListSSHKeyPairsParams, opts ...PageOption) iter.Seq2[*SSHKeyPair, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SSHKeyPair, int, error) {
+		l, err := s.ListSSHKeyPairsWithContext(ctx, &ListSSHKeyPairsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SSHKeyPairs, l.Count, nil
+	}, opts...)
+}
+
 type ListSSHKeyPairsResponse struct {
 	Count       int           `json:"count"`
 	SSHKeyPairs []*SSHKeyPair `json:"sshkeypair"`
 }
 
 type SSHKeyPair struct {
-	Account        string `json:"account"`
-	Domain         string `json:"domain"`
-	Domainid       string `json:"domainid"`
-	Fingerprint    string `json:"fingerprint"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Project        string `json:"project"`
-	Projectid      string `json:"projectid"`
+	Account        string                     `json:"account"`
+	Domain         string                     `json:"domain"`
+	Domainid       string                     `json:"domainid"`
+	Fingerprint    string                     `json:"fingerprint"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Project        string                     `json:"project"`
+	Projectid      string                     `json:"projectid"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SSHKeyPair) UnmarshalJSON(b []byte) error {
+	type alias SSHKeyPair
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterSSHKeyPairParams struct {
@@ -792,6 +1018,48 @@ func (p *RegisterSSHKeyPairParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerSSHKeyPair API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterSSHKeyPairParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerSSHKeyPair", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerSSHKeyPair API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterSSHKeyPairParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "publickey"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "name": 255, "projectid": 255, "publickey": 5120},
+	}.validate("registerSSHKeyPair", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerSSHKeyPair API, before the request is signed
+func (p *RegisterSSHKeyPairParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterSSHKeyPairParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterSSHKeyPairParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerSSHKeyPair", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"name":      decodeParam[string],
+		"projectid": decodeParam[string],
+		"publickey": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterSSHKeyPairParams) Clone() *RegisterSSHKeyPairParams {
+	return &RegisterSSHKeyPairParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterSSHKeyPairParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -909,7 +1177,16 @@ func (s *SSHService) NewRegisterSSHKeyPairParams(name string, publickey string)
 
 // Register a public key in a keypair under a certain name
 func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
-	resp, err := s.cs.newPostRequest("registerSSHKeyPair", p.toURLValues())
+	return s.RegisterSSHKeyPairWithContext(context.Background(), p)
+}
+
+// RegisterSSHKeyPairWithContext is like RegisterSSHKeyPair, but honours the cancellation and deadline of ctx
+func (s *SSHService) RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerSSHKeyPair", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -919,7 +1196,7 @@ func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterS
 	}
 
 	var r RegisterSSHKeyPairResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerSSHKeyPair", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -927,17 +1204,23 @@ func (s *SSHService) RegisterSSHKeyPair(p *RegisterSSHKeyPairParams) (*RegisterS
 }
 
 type RegisterSSHKeyPairResponse struct {
-	Account        string `json:"account"`
-	Domain         string `json:"domain"`
-	Domainid       string `json:"domainid"`
-	Fingerprint    string `json:"fingerprint"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Project        string `json:"project"`
-	Projectid      string `json:"projectid"`
+	Account        string                     `json:"account"`
+	Domain         string                     `json:"domain"`
+	Domainid       string                     `json:"domainid"`
+	Fingerprint    string                     `json:"fingerprint"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Project        string                     `json:"project"`
+	Projectid      string                     `json:"projectid"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RegisterSSHKeyPairResponse) UnmarshalJSON(b []byte) error {
+	type alias RegisterSSHKeyPairResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetSSHKeyForVirtualMachineParams struct {
@@ -971,6 +1254,49 @@ func (p *ResetSSHKeyForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resetSSHKeyForVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResetSSHKeyForVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resetSSHKeyForVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resetSSHKeyForVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResetSSHKeyForVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "keypair": 255, "projectid": 255},
+	}.validate("resetSSHKeyForVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resetSSHKeyForVirtualMachine API, before the request is signed
+func (p *ResetSSHKeyForVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResetSSHKeyForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResetSSHKeyForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resetSSHKeyForVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"keypair":   decodeParam[string],
+		"keypairs":  decodeParam[[]string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResetSSHKeyForVirtualMachineParams) Clone() *ResetSSHKeyForVirtualMachineParams {
+	return &ResetSSHKeyForVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *ResetSSHKeyForVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1108,32 +1434,39 @@ func (s *SSHService) NewResetSSHKeyForVirtualMachineParams(id string) *ResetSSHK
 
 // Resets the SSH Key for Instance. The Instance must be in a "Stopped" state. [async]
 func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("resetSSHKeyForVirtualMachine", p.toURLValues())
+	return s.ResetSSHKeyForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ResetSSHKeyForVirtualMachineWithContext is like ResetSSHKeyForVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *SSHService) ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "resetSSHKeyForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetSSHKeyForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resetSSHKeyForVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "resetSSHKeyForVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("resetSSHKeyForVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1141,6 +1474,25 @@ func (s *SSHService) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachin
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ResetSSHKeyForVirtualMachine async job into r
+func (r *ResetSSHKeyForVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ResetSSHKeyForVirtualMachineAsync starts the async job of ResetSSHKeyForVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *SSHService) ResetSSHKeyForVirtualMachineAsync(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*Job, error) {
+	r, err := s.ResetSSHKeyForVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("resetSSHKeyForVirtualMachine", r.JobID), nil
+}
+
 type ResetSSHKeyForVirtualMachineResponse struct {
 	Account               string                                              `json:"account"`
 	Affinitygroup         []ResetSSHKeyForVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -1152,32 +1504,32 @@ type ResetSSHKeyForVirtualMachineResponse struct {
 	Backupofferingname    string                                              `json:"backupofferingname"`
 	Bootmode              string                                              `json:"bootmode"`
 	Boottype              string                                              `json:"boottype"`
-	Cpunumber             int                                                 `json:"cpunumber"`
-	Cpuspeed              int                                                 `json:"cpuspeed"`
+	Cpunumber             FlexInt                                             `json:"cpunumber"`
+	Cpuspeed              FlexInt                                             `json:"cpuspeed"`
 	Cpuused               string                                              `json:"cpuused"`
-	Created               string                                              `json:"created"`
-	Deleteprotection      bool                                                `json:"deleteprotection"`
+	Created               Time                                                `json:"created"`
+	Deleteprotection      FlexBool                                            `json:"deleteprotection"`
 	Details               map[string]string                                   `json:"details"`
-	Diskioread            int64                                               `json:"diskioread"`
-	Diskiowrite           int64                                               `json:"diskiowrite"`
-	Diskkbsread           int64                                               `json:"diskkbsread"`
-	Diskkbswrite          int64                                               `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                           `json:"diskioread"`
+	Diskiowrite           FlexInt64                                           `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                           `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                           `json:"diskkbswrite"`
 	Diskofferingid        string                                              `json:"diskofferingid"`
 	Diskofferingname      string                                              `json:"diskofferingname"`
 	Displayname           string                                              `json:"displayname"`
-	Displayvm             bool                                                `json:"displayvm"`
+	Displayvm             FlexBool                                            `json:"displayvm"`
 	Domain                string                                              `json:"domain"`
 	Domainid              string                                              `json:"domainid"`
 	Domainpath            string                                              `json:"domainpath"`
-	Forvirtualnetwork     bool                                                `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                            `json:"forvirtualnetwork"`
 	Gpucardid             string                                              `json:"gpucardid"`
 	Gpucardname           string                                              `json:"gpucardname"`
-	Gpucount              int                                                 `json:"gpucount"`
+	Gpucount              FlexInt                                             `json:"gpucount"`
 	Group                 string                                              `json:"group"`
 	Groupid               string                                              `json:"groupid"`
 	Guestosid             string                                              `json:"guestosid"`
-	Haenable              bool                                                `json:"haenable"`
-	Hasannotations        bool                                                `json:"hasannotations"`
+	Haenable              FlexBool                                            `json:"haenable"`
+	Hasannotations        FlexBool                                            `json:"hasannotations"`
 	Hostcontrolstate      string                                              `json:"hostcontrolstate"`
 	Hostid                string                                              `json:"hostid"`
 	Hostname              string                                              `json:"hostname"`
@@ -1186,47 +1538,47 @@ type ResetSSHKeyForVirtualMachineResponse struct {
 	Id                    string                                              `json:"id"`
 	Instancename          string                                              `json:"instancename"`
 	Ipaddress             string                                              `json:"ipaddress"`
-	Isdynamicallyscalable bool                                                `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                            `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                              `json:"isodisplaytext"`
 	Isoid                 string                                              `json:"isoid"`
 	Isoname               string                                              `json:"isoname"`
 	JobID                 string                                              `json:"jobid"`
-	Jobstatus             int                                                 `json:"jobstatus"`
+	Jobstatus             FlexInt                                             `json:"jobstatus"`
 	Keypairs              string                                              `json:"keypairs"`
-	Lastupdated           string                                              `json:"lastupdated"`
-	Leaseduration         int                                                 `json:"leaseduration"`
+	Lastupdated           Time                                                `json:"lastupdated"`
+	Leaseduration         FlexInt                                             `json:"leaseduration"`
 	Leaseexpiryaction     string                                              `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                              `json:"leaseexpirydate"`
-	Maxheads              int64                                               `json:"maxheads"`
-	Maxresolutionx        int64                                               `json:"maxresolutionx"`
-	Maxresolutiony        int64                                               `json:"maxresolutiony"`
-	Memory                int                                                 `json:"memory"`
-	Memoryintfreekbs      int64                                               `json:"memoryintfreekbs"`
-	Memorykbs             int64                                               `json:"memorykbs"`
-	Memorytargetkbs       int64                                               `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                                `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                           `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                           `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                           `json:"maxresolutiony"`
+	Memory                FlexInt                                             `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                           `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                           `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                           `json:"memorytargetkbs"`
 	Name                  string                                              `json:"name"`
-	Networkkbsread        int64                                               `json:"networkkbsread"`
-	Networkkbswrite       int64                                               `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                           `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                           `json:"networkkbswrite"`
 	Nic                   []Nic                                               `json:"nic"`
 	Osdisplayname         string                                              `json:"osdisplayname"`
 	Ostypeid              string                                              `json:"ostypeid"`
 	Password              string                                              `json:"password"`
-	Passwordenabled       bool                                                `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                            `json:"passwordenabled"`
 	Pooltype              string                                              `json:"pooltype"`
 	Project               string                                              `json:"project"`
 	Projectid             string                                              `json:"projectid"`
 	Publicip              string                                              `json:"publicip"`
 	Publicipid            string                                              `json:"publicipid"`
 	Readonlydetails       string                                              `json:"readonlydetails"`
-	Receivedbytes         int64                                               `json:"receivedbytes"`
-	Rootdeviceid          int64                                               `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                           `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                           `json:"rootdeviceid"`
 	Rootdevicetype        string                                              `json:"rootdevicetype"`
 	Securitygroup         []ResetSSHKeyForVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                               `json:"sentbytes"`
+	Sentbytes             FlexInt64                                           `json:"sentbytes"`
 	Serviceofferingid     string                                              `json:"serviceofferingid"`
 	Serviceofferingname   string                                              `json:"serviceofferingname"`
 	Servicestate          string                                              `json:"servicestate"`
-	State                 string                                              `json:"state"`
+	State                 VirtualMachineState                                 `json:"state"`
 	Tags                  []Tags                                              `json:"tags"`
 	Templatedisplaytext   string                                              `json:"templatedisplaytext"`
 	Templateformat        string                                              `json:"templateformat"`
@@ -1243,12 +1595,13 @@ type ResetSSHKeyForVirtualMachineResponse struct {
 	Vgpu                  string                                              `json:"vgpu"`
 	Vgpuprofileid         string                                              `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                              `json:"vgpuprofilename"`
-	Videoram              int64                                               `json:"videoram"`
+	Videoram              FlexInt64                                           `json:"videoram"`
 	Vmtype                string                                              `json:"vmtype"`
 	Vnfdetails            map[string]string                                   `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                           `json:"vnfnics"`
 	Zoneid                string                                              `json:"zoneid"`
 	Zonename              string                                              `json:"zonename"`
+	Extra                 map[string]json.RawMessage                          `json:"-"`
 }
 
 type ResetSSHKeyForVirtualMachineResponseSecuritygroup struct {
@@ -1264,36 +1617,54 @@ type ResetSSHKeyForVirtualMachineResponseSecuritygroup struct {
 	Project             string                                                  `json:"project"`
 	Projectid           string                                                  `json:"projectid"`
 	Tags                []Tags                                                  `json:"tags"`
-	Virtualmachinecount int                                                     `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                                 `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                           `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                              `json:"-"`
+}
+
+func (r *ResetSSHKeyForVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias ResetSSHKeyForVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetSSHKeyForVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetSSHKeyForVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias ResetSSHKeyForVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetSSHKeyForVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetSSHKeyForVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias ResetSSHKeyForVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *ResetSSHKeyForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -1320,5 +1691,5 @@ func (r *ResetSSHKeyForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ResetSSHKeyForVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
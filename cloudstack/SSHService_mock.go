@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockSSHServiceIfaceMockRecorder) CreateSSHKeyPair(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).CreateSSHKeyPair), p)
 }
 
+// CreateSSHKeyPairWithContext mocks base method.
+func (m *MockSSHServiceIface) CreateSSHKeyPairWithContext(ctx context.Context, p *CreateSSHKeyPairParams) (*CreateSSHKeyPairResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSSHKeyPairWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSSHKeyPairResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSSHKeyPairWithContext indicates an expected call of CreateSSHKeyPairWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) CreateSSHKeyPairWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSSHKeyPairWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).CreateSSHKeyPairWithContext), ctx, p)
+}
+
 // DeleteSSHKeyPair mocks base method.
 func (m *MockSSHServiceIface) DeleteSSHKeyPair(p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockSSHServiceIfaceMockRecorder) DeleteSSHKeyPair(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).DeleteSSHKeyPair), p)
 }
 
+// DeleteSSHKeyPairWithContext mocks base method.
+func (m *MockSSHServiceIface) DeleteSSHKeyPairWithContext(ctx context.Context, p *DeleteSSHKeyPairParams) (*DeleteSSHKeyPairResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSSHKeyPairWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteSSHKeyPairResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSSHKeyPairWithContext indicates an expected call of DeleteSSHKeyPairWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) DeleteSSHKeyPairWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSSHKeyPairWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).DeleteSSHKeyPairWithContext), ctx, p)
+}
+
 // GetSSHKeyPairByID mocks base method.
 func (m *MockSSHServiceIface) GetSSHKeyPairByID(id string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockSSHServiceIfaceMockRecorder) GetSSHKeyPairByID(id any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyPairByID", reflect.TypeOf((*MockSSHServiceIface)(nil).GetSSHKeyPairByID), varargs...)
 }
 
+// GetSSHKeyPairByIDWithContext mocks base method.
+func (m *MockSSHServiceIface) GetSSHKeyPairByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSSHKeyPairByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*SSHKeyPair)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSSHKeyPairByIDWithContext indicates an expected call of GetSSHKeyPairByIDWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) GetSSHKeyPairByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyPairByIDWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).GetSSHKeyPairByIDWithContext), varargs...)
+}
+
 // GetSSHKeyPairByName mocks base method.
 func (m *MockSSHServiceIface) GetSSHKeyPairByName(name string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +183,27 @@ func (mr *MockSSHServiceIfaceMockRecorder) GetSSHKeyPairByName(name any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyPairByName", reflect.TypeOf((*MockSSHServiceIface)(nil).GetSSHKeyPairByName), varargs...)
 }
 
+// GetSSHKeyPairByNameWithContext mocks base method.
+func (m *MockSSHServiceIface) GetSSHKeyPairByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SSHKeyPair, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSSHKeyPairByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*SSHKeyPair)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSSHKeyPairByNameWithContext indicates an expected call of GetSSHKeyPairByNameWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) GetSSHKeyPairByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyPairByNameWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).GetSSHKeyPairByNameWithContext), varargs...)
+}
+
 // GetSSHKeyPairID mocks base method.
 func (m *MockSSHServiceIface) GetSSHKeyPairID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +225,27 @@ func (mr *MockSSHServiceIfaceMockRecorder) GetSSHKeyPairID(name any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyPairID", reflect.TypeOf((*MockSSHServiceIface)(nil).GetSSHKeyPairID), varargs...)
 }
 
+// GetSSHKeyPairIDWithContext mocks base method.
+func (m *MockSSHServiceIface) GetSSHKeyPairIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSSHKeyPairIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSSHKeyPairIDWithContext indicates an expected call of GetSSHKeyPairIDWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) GetSSHKeyPairIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSSHKeyPairIDWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).GetSSHKeyPairIDWithContext), varargs...)
+}
+
 // ListSSHKeyPairs mocks base method.
 func (m *MockSSHServiceIface) ListSSHKeyPairs(p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +261,99 @@ func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairs(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairs", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairs), p)
 }
 
+// ListSSHKeyPairsAll mocks base method.
+func (m *MockSSHServiceIface) ListSSHKeyPairsAll(p *ListSSHKeyPairsParams, opts ...PageOption) ([]*SSHKeyPair, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSSHKeyPairsAll", varargs...)
+	ret0, _ := ret[0].([]*SSHKeyPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSSHKeyPairsAll indicates an expected call of ListSSHKeyPairsAll.
+func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairsAll", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairsAll), varargs...)
+}
+
+// ListSSHKeyPairsAllWithContext mocks base method.
+func (m *MockSSHServiceIface) ListSSHKeyPairsAllWithContext(ctx context.Context, p *ListSSHKeyPairsParams, opts ...PageOption) ([]*SSHKeyPair, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSSHKeyPairsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SSHKeyPair)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSSHKeyPairsAllWithContext indicates an expected call of ListSSHKeyPairsAllWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairsAllWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairsAllWithContext), varargs...)
+}
+
+// ListSSHKeyPairsIter mocks base method.
+func (m *MockSSHServiceIface) ListSSHKeyPairsIter(p *ListSSHKeyPairsParams, opts ...PageOption) iter.Seq2[*SSHKeyPair, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSSHKeyPairsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SSHKeyPair, error])
+	return ret0
+}
+
+// ListSSHKeyPairsIter indicates an expected call of ListSSHKeyPairsIter.
+func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairsIter", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairsIter), varargs...)
+}
+
+// ListSSHKeyPairsIterWithContext mocks base method.
+func (m *MockSSHServiceIface) ListSSHKeyPairsIterWithContext(ctx context.Context, p *ListSSHKeyPairsParams, opts ...PageOption) iter.Seq2[*SSHKeyPair, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSSHKeyPairsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SSHKeyPair, error])
+	return ret0
+}
+
+// ListSSHKeyPairsIterWithContext indicates an expected call of ListSSHKeyPairsIterWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairsIterWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairsIterWithContext), varargs...)
+}
+
+// ListSSHKeyPairsWithContext mocks base method.
+func (m *MockSSHServiceIface) ListSSHKeyPairsWithContext(ctx context.Context, p *ListSSHKeyPairsParams) (*ListSSHKeyPairsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSSHKeyPairsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSSHKeyPairsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSSHKeyPairsWithContext indicates an expected call of ListSSHKeyPairsWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) ListSSHKeyPairsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSSHKeyPairsWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).ListSSHKeyPairsWithContext), ctx, p)
+}
+
 // NewCreateSSHKeyPairParams mocks base method.
 func (m *MockSSHServiceIface) NewCreateSSHKeyPairParams(name string) *CreateSSHKeyPairParams {
 	m.ctrl.T.Helper()
@@ -251,6 +439,21 @@ func (mr *MockSSHServiceIfaceMockRecorder) RegisterSSHKeyPair(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSSHKeyPair", reflect.TypeOf((*MockSSHServiceIface)(nil).RegisterSSHKeyPair), p)
 }
 
+// RegisterSSHKeyPairWithContext mocks base method.
+func (m *MockSSHServiceIface) RegisterSSHKeyPairWithContext(ctx context.Context, p *RegisterSSHKeyPairParams) (*RegisterSSHKeyPairResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterSSHKeyPairWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterSSHKeyPairResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterSSHKeyPairWithContext indicates an expected call of RegisterSSHKeyPairWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) RegisterSSHKeyPairWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterSSHKeyPairWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).RegisterSSHKeyPairWithContext), ctx, p)
+}
+
 // ResetSSHKeyForVirtualMachine mocks base method.
 func (m *MockSSHServiceIface) ResetSSHKeyForVirtualMachine(p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -265,3 +468,33 @@ func (mr *MockSSHServiceIfaceMockRecorder) ResetSSHKeyForVirtualMachine(p any) *
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetSSHKeyForVirtualMachine", reflect.TypeOf((*MockSSHServiceIface)(nil).ResetSSHKeyForVirtualMachine), p)
 }
+
+// ResetSSHKeyForVirtualMachineAsync mocks base method.
+func (m *MockSSHServiceIface) ResetSSHKeyForVirtualMachineAsync(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetSSHKeyForVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetSSHKeyForVirtualMachineAsync indicates an expected call of ResetSSHKeyForVirtualMachineAsync.
+func (mr *MockSSHServiceIfaceMockRecorder) ResetSSHKeyForVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetSSHKeyForVirtualMachineAsync", reflect.TypeOf((*MockSSHServiceIface)(nil).ResetSSHKeyForVirtualMachineAsync), ctx, p)
+}
+
+// ResetSSHKeyForVirtualMachineWithContext mocks base method.
+func (m *MockSSHServiceIface) ResetSSHKeyForVirtualMachineWithContext(ctx context.Context, p *ResetSSHKeyForVirtualMachineParams) (*ResetSSHKeyForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetSSHKeyForVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResetSSHKeyForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetSSHKeyForVirtualMachineWithContext indicates an expected call of ResetSSHKeyForVirtualMachineWithContext.
+func (mr *MockSSHServiceIfaceMockRecorder) ResetSSHKeyForVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetSSHKeyForVirtualMachineWithContext", reflect.TypeOf((*MockSSHServiceIface)(nil).ResetSSHKeyForVirtualMachineWithContext), ctx, p)
+}
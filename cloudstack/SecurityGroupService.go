@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -61,23 +63,42 @@ func convertAuthorizeSecurityGroupEgressResponse(b []byte) ([]byte, error) {
 
 type SecurityGroupServiceIface interface {
 	AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error)
+	AuthorizeSecurityGroupEgressAsync(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*Job, error)
 	NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams
 	AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error)
+	AuthorizeSecurityGroupIngressAsync(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*Job, error)
 	NewAuthorizeSecurityGroupIngressParams() *AuthorizeSecurityGroupIngressParams
 	CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error)
+	CreateSecurityGroupWithContext(ctx context.Context, p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error)
 	NewCreateSecurityGroupParams(name string) *CreateSecurityGroupParams
 	DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error)
+	DeleteSecurityGroupWithContext(ctx context.Context, p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error)
 	NewDeleteSecurityGroupParams() *DeleteSecurityGroupParams
 	ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error)
+	ListSecurityGroupsAll(p *ListSecurityGroupsParams, opts ...PageOption) ([]*SecurityGroup, error)
+	ListSecurityGroupsAllWithContext(ctx context.Context, p *ListSecurityGroupsParams, opts ...PageOption) ([]*SecurityGroup, error)
+	ListSecurityGroupsIter(p *ListSecurityGroupsParams, opts ...PageOption) iter.Seq2[*SecurityGroup, error]
+	ListSecurityGroupsIterWithContext(ctx context.Context, p *ListSecurityGroupsParams, opts ...PageOption) iter.Seq2[*SecurityGroup, error]
 	NewListSecurityGroupsParams() *ListSecurityGroupsParams
 	GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetSecurityGroupIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecurityGroup, int, error)
 	GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error)
+	GetSecurityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecurityGroup, int, error)
 	RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error)
+	RevokeSecurityGroupEgressAsync(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*Job, error)
 	NewRevokeSecurityGroupEgressParams(id string) *RevokeSecurityGroupEgressParams
 	RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error)
+	RevokeSecurityGroupIngressAsync(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*Job, error)
 	NewRevokeSecurityGroupIngressParams(id string) *RevokeSecurityGroupIngressParams
 	UpdateSecurityGroup(p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error)
+	UpdateSecurityGroupWithContext(ctx context.Context, p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error)
 	NewUpdateSecurityGroupParams(id string) *UpdateSecurityGroupParams
 }
 
@@ -116,7 +137,7 @@ func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
 		u.Set("projectid", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["securitygroupid"]; found {
 		u.Set("securitygroupid", v.(string))
@@ -138,6 +159,54 @@ func (p *AuthorizeSecurityGroupEgressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the authorizeSecurityGroupEgress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AuthorizeSecurityGroupEgressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("authorizeSecurityGroupEgress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the authorizeSecurityGroupEgress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AuthorizeSecurityGroupEgressParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "projectid": 255, "protocol": 255, "securitygroupid": 255, "securitygroupname": 255},
+	}.validate("authorizeSecurityGroupEgress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the authorizeSecurityGroupEgress API, before the request is signed
+func (p *AuthorizeSecurityGroupEgressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AuthorizeSecurityGroupEgressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AuthorizeSecurityGroupEgressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("authorizeSecurityGroupEgress", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"cidrlist":              decodeParam[[]string],
+		"domainid":              decodeParam[string],
+		"endport":               decodeParam[int],
+		"icmpcode":              decodeParam[int],
+		"icmptype":              decodeParam[int],
+		"projectid":             decodeParam[string],
+		"protocol":              decodeParam[Protocol],
+		"securitygroupid":       decodeParam[string],
+		"securitygroupname":     decodeParam[string],
+		"startport":             decodeParam[int],
+		"usersecuritygrouplist": decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AuthorizeSecurityGroupEgressParams) Clone() *AuthorizeSecurityGroupEgressParams {
+	return &AuthorizeSecurityGroupEgressParams{p: cloneParams(p.p)}
+}
+
 func (p *AuthorizeSecurityGroupEgressParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -285,7 +354,7 @@ func (p *AuthorizeSecurityGroupEgressParams) GetProjectid() (string, bool) {
 	return value, ok
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v string) {
+func (p *AuthorizeSecurityGroupEgressParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -298,11 +367,11 @@ func (p *AuthorizeSecurityGroupEgressParams) ResetProtocol() {
 	}
 }
 
-func (p *AuthorizeSecurityGroupEgressParams) GetProtocol() (string, bool) {
+func (p *AuthorizeSecurityGroupEgressParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -400,57 +469,89 @@ func (s *SecurityGroupService) NewAuthorizeSecurityGroupEgressParams() *Authoriz
 
 // Authorizes a particular egress rule for this security group
 func (s *SecurityGroupService) AuthorizeSecurityGroupEgress(p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newPostRequest("authorizeSecurityGroupEgress", p.toURLValues())
+	return s.AuthorizeSecurityGroupEgressWithContext(context.Background(), p)
+}
+
+// AuthorizeSecurityGroupEgressWithContext is like AuthorizeSecurityGroupEgress, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "authorizeSecurityGroupEgress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AuthorizeSecurityGroupEgressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("authorizeSecurityGroupEgress", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "authorizeSecurityGroupEgress", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("authorizeSecurityGroupEgress", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertAuthorizeSecurityGroupEgressResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished AuthorizeSecurityGroupEgress async job into r
+func (r *AuthorizeSecurityGroupEgressResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertAuthorizeSecurityGroupEgressResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AuthorizeSecurityGroupEgressAsync starts the async job of AuthorizeSecurityGroupEgress without waiting for it to finish, and returns a handle to the job
+func (s *SecurityGroupService) AuthorizeSecurityGroupEgressAsync(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*Job, error) {
+	r, err := s.AuthorizeSecurityGroupEgressWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("authorizeSecurityGroupEgress", r.JobID), nil
 }
 
 type AuthorizeSecurityGroupEgressResponse struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AuthorizeSecurityGroupEgressResponse) UnmarshalJSON(b []byte) error {
+	type alias AuthorizeSecurityGroupEgressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AuthorizeSecurityGroupIngressParams struct {
@@ -488,7 +589,7 @@ func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
 		u.Set("projectid", v.(string))
 	}
 	if v, found := p.p["protocol"]; found {
-		u.Set("protocol", v.(string))
+		u.Set("protocol", string(v.(Protocol)))
 	}
 	if v, found := p.p["securitygroupid"]; found {
 		u.Set("securitygroupid", v.(string))
@@ -510,6 +611,54 @@ func (p *AuthorizeSecurityGroupIngressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the authorizeSecurityGroupIngress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AuthorizeSecurityGroupIngressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("authorizeSecurityGroupIngress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the authorizeSecurityGroupIngress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AuthorizeSecurityGroupIngressParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "projectid": 255, "protocol": 255, "securitygroupid": 255, "securitygroupname": 255},
+	}.validate("authorizeSecurityGroupIngress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the authorizeSecurityGroupIngress API, before the request is signed
+func (p *AuthorizeSecurityGroupIngressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AuthorizeSecurityGroupIngressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AuthorizeSecurityGroupIngressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("authorizeSecurityGroupIngress", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"cidrlist":              decodeParam[[]string],
+		"domainid":              decodeParam[string],
+		"endport":               decodeParam[int],
+		"icmpcode":              decodeParam[int],
+		"icmptype":              decodeParam[int],
+		"projectid":             decodeParam[string],
+		"protocol":              decodeParam[Protocol],
+		"securitygroupid":       decodeParam[string],
+		"securitygroupname":     decodeParam[string],
+		"startport":             decodeParam[int],
+		"usersecuritygrouplist": decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AuthorizeSecurityGroupIngressParams) Clone() *AuthorizeSecurityGroupIngressParams {
+	return &AuthorizeSecurityGroupIngressParams{p: cloneParams(p.p)}
+}
+
 func (p *AuthorizeSecurityGroupIngressParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -657,7 +806,7 @@ func (p *AuthorizeSecurityGroupIngressParams) GetProjectid() (string, bool) {
 	return value, ok
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v string) {
+func (p *AuthorizeSecurityGroupIngressParams) SetProtocol(v Protocol) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -670,11 +819,11 @@ func (p *AuthorizeSecurityGroupIngressParams) ResetProtocol() {
 	}
 }
 
-func (p *AuthorizeSecurityGroupIngressParams) GetProtocol() (string, bool) {
+func (p *AuthorizeSecurityGroupIngressParams) GetProtocol() (Protocol, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["protocol"].(string)
+	value, ok := p.p["protocol"].(Protocol)
 	return value, ok
 }
 
@@ -772,57 +921,89 @@ func (s *SecurityGroupService) NewAuthorizeSecurityGroupIngressParams() *Authori
 
 // Authorizes a particular ingress rule for this security group
 func (s *SecurityGroupService) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newPostRequest("authorizeSecurityGroupIngress", p.toURLValues())
+	return s.AuthorizeSecurityGroupIngressWithContext(context.Background(), p)
+}
+
+// AuthorizeSecurityGroupIngressWithContext is like AuthorizeSecurityGroupIngress, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "authorizeSecurityGroupIngress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AuthorizeSecurityGroupIngressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("authorizeSecurityGroupIngress", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "authorizeSecurityGroupIngress", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
+		if err := s.cs.decodeJobResult("authorizeSecurityGroupIngress", b, &r); err != nil {
 			return nil, err
 		}
+	}
 
-		b, err = convertAuthorizeSecurityGroupIngressResponse(b)
-		if err != nil {
-			return nil, err
-		}
+	return &r, nil
+}
 
-		if err := json.Unmarshal(b, &r); err != nil {
-			return nil, err
-		}
+// decodeJobResult decodes the result of a finished AuthorizeSecurityGroupIngress async job into r
+func (r *AuthorizeSecurityGroupIngressResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
 	}
 
-	return &r, nil
+	b, err = convertAuthorizeSecurityGroupIngressResponse(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AuthorizeSecurityGroupIngressAsync starts the async job of AuthorizeSecurityGroupIngress without waiting for it to finish, and returns a handle to the job
+func (s *SecurityGroupService) AuthorizeSecurityGroupIngressAsync(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*Job, error) {
+	r, err := s.AuthorizeSecurityGroupIngressWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("authorizeSecurityGroupIngress", r.JobID), nil
 }
 
 type AuthorizeSecurityGroupIngressResponse struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AuthorizeSecurityGroupIngressResponse) UnmarshalJSON(b []byte) error {
+	type alias AuthorizeSecurityGroupIngressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSecurityGroupParams struct {
@@ -852,6 +1033,48 @@ func (p *CreateSecurityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSecurityGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSecurityGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSecurityGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSecurityGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSecurityGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"account": 255, "description": 4096, "domainid": 255, "name": 255, "projectid": 255},
+	}.validate("createSecurityGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSecurityGroup API, before the request is signed
+func (p *CreateSecurityGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSecurityGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSecurityGroup", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"description": decodeParam[string],
+		"domainid":    decodeParam[string],
+		"name":        decodeParam[string],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSecurityGroupParams) Clone() *CreateSecurityGroupParams {
+	return &CreateSecurityGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSecurityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -968,7 +1191,16 @@ func (s *SecurityGroupService) NewCreateSecurityGroupParams(name string) *Create
 
 // Creates a security group
 func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("createSecurityGroup", p.toURLValues())
+	return s.CreateSecurityGroupWithContext(context.Background(), p)
+}
+
+// CreateSecurityGroupWithContext is like CreateSecurityGroup, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) CreateSecurityGroupWithContext(ctx context.Context, p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createSecurityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -978,7 +1210,7 @@ func (s *SecurityGroupService) CreateSecurityGroup(p *CreateSecurityGroupParams)
 	}
 
 	var r CreateSecurityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createSecurityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -995,26 +1227,38 @@ type CreateSecurityGroupResponse struct {
 	Id                  string                            `json:"id"`
 	Ingressrule         []CreateSecurityGroupResponseRule `json:"ingressrule"`
 	JobID               string                            `json:"jobid"`
-	Jobstatus           int                               `json:"jobstatus"`
+	Jobstatus           FlexInt                           `json:"jobstatus"`
 	Name                string                            `json:"name"`
 	Project             string                            `json:"project"`
 	Projectid           string                            `json:"projectid"`
 	Tags                []Tags                            `json:"tags"`
-	Virtualmachinecount int                               `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                           `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                     `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage        `json:"-"`
+}
+
+func (r *CreateSecurityGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateSecurityGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSecurityGroupResponseRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateSecurityGroupResponseRule) UnmarshalJSON(b []byte) error {
+	type alias CreateSecurityGroupResponseRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteSecurityGroupParams struct {
@@ -1044,6 +1288,47 @@ func (p *DeleteSecurityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteSecurityGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteSecurityGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteSecurityGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteSecurityGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteSecurityGroupParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "name": 255, "projectid": 255},
+	}.validate("deleteSecurityGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteSecurityGroup API, before the request is signed
+func (p *DeleteSecurityGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteSecurityGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteSecurityGroup", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"name":      decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteSecurityGroupParams) Clone() *DeleteSecurityGroupParams {
+	return &DeleteSecurityGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteSecurityGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1159,13 +1444,22 @@ func (s *SecurityGroupService) NewDeleteSecurityGroupParams() *DeleteSecurityGro
 
 // Deletes security group
 func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteSecurityGroup", p.toURLValues())
+	return s.DeleteSecurityGroupWithContext(context.Background(), p)
+}
+
+// DeleteSecurityGroupWithContext is like DeleteSecurityGroup, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) DeleteSecurityGroupWithContext(ctx context.Context, p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteSecurityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSecurityGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteSecurityGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1173,10 +1467,11 @@ func (s *SecurityGroupService) DeleteSecurityGroup(p *DeleteSecurityGroupParams)
 }
 
 type DeleteSecurityGroupResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteSecurityGroupResponse) UnmarshalJSON(b []byte) error {
@@ -1203,7 +1498,7 @@ func (r *DeleteSecurityGroupResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteSecurityGroupResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSecurityGroupsParams struct {
@@ -1262,6 +1557,54 @@ func (p *ListSecurityGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSecurityGroups API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSecurityGroupsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSecurityGroups", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSecurityGroups API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSecurityGroupsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "securitygroupname": 255, "virtualmachineid": 255},
+	}.validate("listSecurityGroups", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSecurityGroups API, before the request is signed
+func (p *ListSecurityGroupsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSecurityGroupsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSecurityGroupsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSecurityGroups", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"domainid":          decodeParam[string],
+		"id":                decodeParam[string],
+		"isrecursive":       decodeParam[bool],
+		"keyword":           decodeParam[string],
+		"listall":           decodeParam[bool],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"projectid":         decodeParam[string],
+		"securitygroupname": decodeParam[string],
+		"tags":              decodeParam[map[string]string],
+		"virtualmachineid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSecurityGroupsParams) Clone() *ListSecurityGroupsParams {
+	return &ListSecurityGroupsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSecurityGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1524,6 +1867,11 @@ func (s *SecurityGroupService) NewListSecurityGroupsParams() *ListSecurityGroups
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSecurityGroupIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetSecurityGroupIDWithContext is like GetSecurityGroupID, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) GetSecurityGroupIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSecurityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1535,7 +1883,7 @@ func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...Option
 		}
 	}
 
-	l, err := s.ListSecurityGroups(p)
+	l, err := s.ListSecurityGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1560,12 +1908,17 @@ func (s *SecurityGroupService) GetSecurityGroupID(keyword string, opts ...Option
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
-	id, count, err := s.GetSecurityGroupID(name, opts...)
+	return s.GetSecurityGroupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSecurityGroupByNameWithContext is like GetSecurityGroupByName, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) GetSecurityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	id, count, err := s.GetSecurityGroupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSecurityGroupByID(id, opts...)
+	r, count, err := s.GetSecurityGroupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1574,6 +1927,11 @@ func (s *SecurityGroupService) GetSecurityGroupByName(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	return s.GetSecurityGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSecurityGroupByIDWithContext is like GetSecurityGroupByID, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) GetSecurityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
 	p := &ListSecurityGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1585,11 +1943,10 @@ func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListSecurityGroups(p)
+	l, err := s.ListSecurityGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1607,54 +1964,103 @@ func (s *SecurityGroupService) GetSecurityGroupByID(id string, opts ...OptionFun
 
 // Lists security groups
 func (s *SecurityGroupService) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listSecurityGroups", p.toURLValues())
+	return s.ListSecurityGroupsWithContext(context.Background(), p)
+}
+
+// ListSecurityGroupsWithContext is like ListSecurityGroups, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSecurityGroups", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSecurityGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSecurityGroups", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSecurityGroupsAll returns all SecurityGroups matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SecurityGroupService) ListSecurityGroupsAll(p *ListSecurityGroupsParams, opts ...PageOption) ([]*SecurityGroup, error) {
+	return s.ListSecurityGroupsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSecurityGroupsAllWithContext is like ListSecurityGroupsAll, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) ListSecurityGroupsAllWithContext(ctx context.Context, p *ListSecurityGroupsParams, opts ...PageOption) ([]*SecurityGroup, error) {
+	return collectPages(s.ListSecurityGroupsIterWithContext(ctx, p, opts...))
+}
+
+// ListSecurityGroupsIter returns an iterator over all SecurityGroups matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SecurityGroupService) ListSecurityGroupsIter(p *ListSecurityGroupsParams, opts ...PageOption) iter.Seq2[*SecurityGroup, error] {
+	return s.ListSecurityGroupsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSecurityGroupsIterWithContext is like ListSecurityGroupsIter, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) ListSecurityGroupsIterWithContext(ctx context.Context, p *ListSecurityGroupsParams, opts ...PageOption) iter.Seq2[*SecurityGroup, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SecurityGroup, int, error) {
+		l, err := s.ListSecurityGroupsWithContext(ctx, &ListSecurityGroupsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SecurityGroups, l.Count, nil
+	}, opts...)
+}
+
 type ListSecurityGroupsResponse struct {
 	Count          int              `json:"count"`
 	SecurityGroups []*SecurityGroup `json:"securitygroup"`
 }
 
 type SecurityGroup struct {
-	Account             string              `json:"account"`
-	Description         string              `json:"description"`
-	Domain              string              `json:"domain"`
-	Domainid            string              `json:"domainid"`
-	Domainpath          string              `json:"domainpath"`
-	Egressrule          []SecurityGroupRule `json:"egressrule"`
-	Id                  string              `json:"id"`
-	Ingressrule         []SecurityGroupRule `json:"ingressrule"`
-	JobID               string              `json:"jobid"`
-	Jobstatus           int                 `json:"jobstatus"`
-	Name                string              `json:"name"`
-	Project             string              `json:"project"`
-	Projectid           string              `json:"projectid"`
-	Tags                []Tags              `json:"tags"`
-	Virtualmachinecount int                 `json:"virtualmachinecount"`
-	Virtualmachineids   []interface{}       `json:"virtualmachineids"`
+	Account             string                     `json:"account"`
+	Description         string                     `json:"description"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Domainpath          string                     `json:"domainpath"`
+	Egressrule          []SecurityGroupRule        `json:"egressrule"`
+	Id                  string                     `json:"id"`
+	Ingressrule         []SecurityGroupRule        `json:"ingressrule"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Name                string                     `json:"name"`
+	Project             string                     `json:"project"`
+	Projectid           string                     `json:"projectid"`
+	Tags                []Tags                     `json:"tags"`
+	Virtualmachinecount FlexInt                    `json:"virtualmachinecount"`
+	Virtualmachineids   []interface{}              `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SecurityGroup) UnmarshalJSON(b []byte) error {
+	type alias SecurityGroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SecurityGroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SecurityGroupRule) UnmarshalJSON(b []byte) error {
+	type alias SecurityGroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevokeSecurityGroupEgressParams struct {
@@ -1672,6 +2078,44 @@ func (p *RevokeSecurityGroupEgressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the revokeSecurityGroupEgress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RevokeSecurityGroupEgressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("revokeSecurityGroupEgress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the revokeSecurityGroupEgress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RevokeSecurityGroupEgressParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("revokeSecurityGroupEgress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the revokeSecurityGroupEgress API, before the request is signed
+func (p *RevokeSecurityGroupEgressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RevokeSecurityGroupEgressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RevokeSecurityGroupEgressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("revokeSecurityGroupEgress", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RevokeSecurityGroupEgressParams) Clone() *RevokeSecurityGroupEgressParams {
+	return &RevokeSecurityGroupEgressParams{p: cloneParams(p.p)}
+}
+
 func (p *RevokeSecurityGroupEgressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1704,27 +2148,39 @@ func (s *SecurityGroupService) NewRevokeSecurityGroupEgressParams(id string) *Re
 
 // Deletes a particular egress rule from this security group
 func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
-	resp, err := s.cs.newPostRequest("revokeSecurityGroupEgress", p.toURLValues())
+	return s.RevokeSecurityGroupEgressWithContext(context.Background(), p)
+}
+
+// RevokeSecurityGroupEgressWithContext is like RevokeSecurityGroupEgress, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) RevokeSecurityGroupEgressWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "revokeSecurityGroupEgress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RevokeSecurityGroupEgressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("revokeSecurityGroupEgress", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "revokeSecurityGroupEgress", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("revokeSecurityGroupEgress", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1732,11 +2188,31 @@ func (s *SecurityGroupService) RevokeSecurityGroupEgress(p *RevokeSecurityGroupE
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RevokeSecurityGroupEgress async job into r
+func (r *RevokeSecurityGroupEgressResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RevokeSecurityGroupEgressAsync starts the async job of RevokeSecurityGroupEgress without waiting for it to finish, and returns a handle to the job
+func (s *SecurityGroupService) RevokeSecurityGroupEgressAsync(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*Job, error) {
+	r, err := s.RevokeSecurityGroupEgressWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("revokeSecurityGroupEgress", r.JobID), nil
+}
+
 type RevokeSecurityGroupEgressResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RevokeSecurityGroupEgressResponse) UnmarshalJSON(b []byte) error {
+	type alias RevokeSecurityGroupEgressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevokeSecurityGroupIngressParams struct {
@@ -1754,6 +2230,44 @@ func (p *RevokeSecurityGroupIngressParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the revokeSecurityGroupIngress API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RevokeSecurityGroupIngressParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("revokeSecurityGroupIngress", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the revokeSecurityGroupIngress API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RevokeSecurityGroupIngressParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("revokeSecurityGroupIngress", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the revokeSecurityGroupIngress API, before the request is signed
+func (p *RevokeSecurityGroupIngressParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RevokeSecurityGroupIngressParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RevokeSecurityGroupIngressParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("revokeSecurityGroupIngress", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RevokeSecurityGroupIngressParams) Clone() *RevokeSecurityGroupIngressParams {
+	return &RevokeSecurityGroupIngressParams{p: cloneParams(p.p)}
+}
+
 func (p *RevokeSecurityGroupIngressParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1786,27 +2300,39 @@ func (s *SecurityGroupService) NewRevokeSecurityGroupIngressParams(id string) *R
 
 // Deletes a particular ingress rule from this security group
 func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
-	resp, err := s.cs.newPostRequest("revokeSecurityGroupIngress", p.toURLValues())
+	return s.RevokeSecurityGroupIngressWithContext(context.Background(), p)
+}
+
+// RevokeSecurityGroupIngressWithContext is like RevokeSecurityGroupIngress, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) RevokeSecurityGroupIngressWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "revokeSecurityGroupIngress", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RevokeSecurityGroupIngressResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("revokeSecurityGroupIngress", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "revokeSecurityGroupIngress", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("revokeSecurityGroupIngress", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1814,11 +2340,31 @@ func (s *SecurityGroupService) RevokeSecurityGroupIngress(p *RevokeSecurityGroup
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RevokeSecurityGroupIngress async job into r
+func (r *RevokeSecurityGroupIngressResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RevokeSecurityGroupIngressAsync starts the async job of RevokeSecurityGroupIngress without waiting for it to finish, and returns a handle to the job
+func (s *SecurityGroupService) RevokeSecurityGroupIngressAsync(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*Job, error) {
+	r, err := s.RevokeSecurityGroupIngressWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("revokeSecurityGroupIngress", r.JobID), nil
+}
+
 type RevokeSecurityGroupIngressResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RevokeSecurityGroupIngressResponse) UnmarshalJSON(b []byte) error {
+	type alias RevokeSecurityGroupIngressResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateSecurityGroupParams struct {
@@ -1842,6 +2388,46 @@ func (p *UpdateSecurityGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateSecurityGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateSecurityGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateSecurityGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateSecurityGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateSecurityGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255, "name": 255},
+	}.validate("updateSecurityGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateSecurityGroup API, before the request is signed
+func (p *UpdateSecurityGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateSecurityGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateSecurityGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateSecurityGroup", b, &p.p, map[string]paramDecoder{
+		"customid": decodeParam[string],
+		"id":       decodeParam[string],
+		"name":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateSecurityGroupParams) Clone() *UpdateSecurityGroupParams {
+	return &UpdateSecurityGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateSecurityGroupParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1916,7 +2502,16 @@ func (s *SecurityGroupService) NewUpdateSecurityGroupParams(id string) *UpdateSe
 
 // Updates a security group
 func (s *SecurityGroupService) UpdateSecurityGroup(p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("updateSecurityGroup", p.toURLValues())
+	return s.UpdateSecurityGroupWithContext(context.Background(), p)
+}
+
+// UpdateSecurityGroupWithContext is like UpdateSecurityGroup, but honours the cancellation and deadline of ctx
+func (s *SecurityGroupService) UpdateSecurityGroupWithContext(ctx context.Context, p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateSecurityGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1924,7 +2519,7 @@ func (s *SecurityGroupService) UpdateSecurityGroup(p *UpdateSecurityGroupParams)
 	var nested struct {
 		Response UpdateSecurityGroupResponse `json:"securitygroup"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateSecurityGroup", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -1942,24 +2537,36 @@ type UpdateSecurityGroupResponse struct {
 	Id                  string                            `json:"id"`
 	Ingressrule         []UpdateSecurityGroupResponseRule `json:"ingressrule"`
 	JobID               string                            `json:"jobid"`
-	Jobstatus           int                               `json:"jobstatus"`
+	Jobstatus           FlexInt                           `json:"jobstatus"`
 	Name                string                            `json:"name"`
 	Project             string                            `json:"project"`
 	Projectid           string                            `json:"projectid"`
 	Tags                []Tags                            `json:"tags"`
-	Virtualmachinecount int                               `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                           `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                     `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage        `json:"-"`
+}
+
+func (r *UpdateSecurityGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateSecurityGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateSecurityGroupResponseRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateSecurityGroupResponseRule) UnmarshalJSON(b []byte) error {
+	type alias UpdateSecurityGroupResponseRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupEgres
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupEgress), p)
 }
 
+// AuthorizeSecurityGroupEgressAsync mocks base method.
+func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupEgressAsync(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgressAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeSecurityGroupEgressAsync indicates an expected call of AuthorizeSecurityGroupEgressAsync.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupEgressAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgressAsync", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupEgressAsync), ctx, p)
+}
+
+// AuthorizeSecurityGroupEgressWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupEgressWithContext(ctx context.Context, p *AuthorizeSecurityGroupEgressParams) (*AuthorizeSecurityGroupEgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupEgressWithContext", ctx, p)
+	ret0, _ := ret[0].(*AuthorizeSecurityGroupEgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeSecurityGroupEgressWithContext indicates an expected call of AuthorizeSecurityGroupEgressWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupEgressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupEgressWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupEgressWithContext), ctx, p)
+}
+
 // AuthorizeSecurityGroupIngress mocks base method.
 func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupIngress(p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupIngre
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupIngress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupIngress), p)
 }
 
+// AuthorizeSecurityGroupIngressAsync mocks base method.
+func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupIngressAsync(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupIngressAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeSecurityGroupIngressAsync indicates an expected call of AuthorizeSecurityGroupIngressAsync.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupIngressAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupIngressAsync", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupIngressAsync), ctx, p)
+}
+
+// AuthorizeSecurityGroupIngressWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) AuthorizeSecurityGroupIngressWithContext(ctx context.Context, p *AuthorizeSecurityGroupIngressParams) (*AuthorizeSecurityGroupIngressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AuthorizeSecurityGroupIngressWithContext", ctx, p)
+	ret0, _ := ret[0].(*AuthorizeSecurityGroupIngressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AuthorizeSecurityGroupIngressWithContext indicates an expected call of AuthorizeSecurityGroupIngressWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) AuthorizeSecurityGroupIngressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AuthorizeSecurityGroupIngressWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).AuthorizeSecurityGroupIngressWithContext), ctx, p)
+}
+
 // CreateSecurityGroup mocks base method.
 func (m *MockSecurityGroupServiceIface) CreateSecurityGroup(p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,21 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) CreateSecurityGroup(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroup", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).CreateSecurityGroup), p)
 }
 
+// CreateSecurityGroupWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) CreateSecurityGroupWithContext(ctx context.Context, p *CreateSecurityGroupParams) (*CreateSecurityGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSecurityGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSecurityGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSecurityGroupWithContext indicates an expected call of CreateSecurityGroupWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) CreateSecurityGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSecurityGroupWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).CreateSecurityGroupWithContext), ctx, p)
+}
+
 // DeleteSecurityGroup mocks base method.
 func (m *MockSecurityGroupServiceIface) DeleteSecurityGroup(p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,21 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) DeleteSecurityGroup(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroup", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).DeleteSecurityGroup), p)
 }
 
+// DeleteSecurityGroupWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) DeleteSecurityGroupWithContext(ctx context.Context, p *DeleteSecurityGroupParams) (*DeleteSecurityGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSecurityGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteSecurityGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSecurityGroupWithContext indicates an expected call of DeleteSecurityGroupWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) DeleteSecurityGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSecurityGroupWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).DeleteSecurityGroupWithContext), ctx, p)
+}
+
 // GetSecurityGroupByID mocks base method.
 func (m *MockSecurityGroupServiceIface) GetSecurityGroupByID(id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +231,27 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) GetSecurityGroupByID(id any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupByID", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).GetSecurityGroupByID), varargs...)
 }
 
+// GetSecurityGroupByIDWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) GetSecurityGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecurityGroupByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*SecurityGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecurityGroupByIDWithContext indicates an expected call of GetSecurityGroupByIDWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) GetSecurityGroupByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupByIDWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).GetSecurityGroupByIDWithContext), varargs...)
+}
+
 // GetSecurityGroupByName mocks base method.
 func (m *MockSecurityGroupServiceIface) GetSecurityGroupByName(name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +273,27 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) GetSecurityGroupByName(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupByName", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).GetSecurityGroupByName), varargs...)
 }
 
+// GetSecurityGroupByNameWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) GetSecurityGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SecurityGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecurityGroupByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*SecurityGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecurityGroupByNameWithContext indicates an expected call of GetSecurityGroupByNameWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) GetSecurityGroupByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupByNameWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).GetSecurityGroupByNameWithContext), varargs...)
+}
+
 // GetSecurityGroupID mocks base method.
 func (m *MockSecurityGroupServiceIface) GetSecurityGroupID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +315,27 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) GetSecurityGroupID(keyword
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupID", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).GetSecurityGroupID), varargs...)
 }
 
+// GetSecurityGroupIDWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) GetSecurityGroupIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSecurityGroupIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSecurityGroupIDWithContext indicates an expected call of GetSecurityGroupIDWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) GetSecurityGroupIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupIDWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).GetSecurityGroupIDWithContext), varargs...)
+}
+
 // ListSecurityGroups mocks base method.
 func (m *MockSecurityGroupServiceIface) ListSecurityGroups(p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +351,99 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroups(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroups", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroups), p)
 }
 
+// ListSecurityGroupsAll mocks base method.
+func (m *MockSecurityGroupServiceIface) ListSecurityGroupsAll(p *ListSecurityGroupsParams, opts ...PageOption) ([]*SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecurityGroupsAll", varargs...)
+	ret0, _ := ret[0].([]*SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityGroupsAll indicates an expected call of ListSecurityGroupsAll.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroupsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupsAll", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroupsAll), varargs...)
+}
+
+// ListSecurityGroupsAllWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) ListSecurityGroupsAllWithContext(ctx context.Context, p *ListSecurityGroupsParams, opts ...PageOption) ([]*SecurityGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecurityGroupsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SecurityGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityGroupsAllWithContext indicates an expected call of ListSecurityGroupsAllWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroupsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupsAllWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroupsAllWithContext), varargs...)
+}
+
+// ListSecurityGroupsIter mocks base method.
+func (m *MockSecurityGroupServiceIface) ListSecurityGroupsIter(p *ListSecurityGroupsParams, opts ...PageOption) iter.Seq2[*SecurityGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecurityGroupsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SecurityGroup, error])
+	return ret0
+}
+
+// ListSecurityGroupsIter indicates an expected call of ListSecurityGroupsIter.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroupsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupsIter", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroupsIter), varargs...)
+}
+
+// ListSecurityGroupsIterWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) ListSecurityGroupsIterWithContext(ctx context.Context, p *ListSecurityGroupsParams, opts ...PageOption) iter.Seq2[*SecurityGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSecurityGroupsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SecurityGroup, error])
+	return ret0
+}
+
+// ListSecurityGroupsIterWithContext indicates an expected call of ListSecurityGroupsIterWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroupsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupsIterWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroupsIterWithContext), varargs...)
+}
+
+// ListSecurityGroupsWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) ListSecurityGroupsWithContext(ctx context.Context, p *ListSecurityGroupsParams) (*ListSecurityGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSecurityGroupsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSecurityGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSecurityGroupsWithContext indicates an expected call of ListSecurityGroupsWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) ListSecurityGroupsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSecurityGroupsWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).ListSecurityGroupsWithContext), ctx, p)
+}
+
 // NewAuthorizeSecurityGroupEgressParams mocks base method.
 func (m *MockSecurityGroupServiceIface) NewAuthorizeSecurityGroupEgressParams() *AuthorizeSecurityGroupEgressParams {
 	m.ctrl.T.Helper()
@@ -323,6 +571,36 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupEgress(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupEgress), p)
 }
 
+// RevokeSecurityGroupEgressAsync mocks base method.
+func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupEgressAsync(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupEgressAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSecurityGroupEgressAsync indicates an expected call of RevokeSecurityGroupEgressAsync.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupEgressAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgressAsync", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupEgressAsync), ctx, p)
+}
+
+// RevokeSecurityGroupEgressWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupEgressWithContext(ctx context.Context, p *RevokeSecurityGroupEgressParams) (*RevokeSecurityGroupEgressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupEgressWithContext", ctx, p)
+	ret0, _ := ret[0].(*RevokeSecurityGroupEgressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSecurityGroupEgressWithContext indicates an expected call of RevokeSecurityGroupEgressWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupEgressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupEgressWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupEgressWithContext), ctx, p)
+}
+
 // RevokeSecurityGroupIngress mocks base method.
 func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupIngress(p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
 	m.ctrl.T.Helper()
@@ -338,6 +616,36 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupIngress(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupIngress", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupIngress), p)
 }
 
+// RevokeSecurityGroupIngressAsync mocks base method.
+func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupIngressAsync(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupIngressAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSecurityGroupIngressAsync indicates an expected call of RevokeSecurityGroupIngressAsync.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupIngressAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupIngressAsync", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupIngressAsync), ctx, p)
+}
+
+// RevokeSecurityGroupIngressWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) RevokeSecurityGroupIngressWithContext(ctx context.Context, p *RevokeSecurityGroupIngressParams) (*RevokeSecurityGroupIngressResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevokeSecurityGroupIngressWithContext", ctx, p)
+	ret0, _ := ret[0].(*RevokeSecurityGroupIngressResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevokeSecurityGroupIngressWithContext indicates an expected call of RevokeSecurityGroupIngressWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) RevokeSecurityGroupIngressWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupIngressWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).RevokeSecurityGroupIngressWithContext), ctx, p)
+}
+
 // UpdateSecurityGroup mocks base method.
 func (m *MockSecurityGroupServiceIface) UpdateSecurityGroup(p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -352,3 +660,18 @@ func (mr *MockSecurityGroupServiceIfaceMockRecorder) UpdateSecurityGroup(p any)
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityGroup", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).UpdateSecurityGroup), p)
 }
+
+// UpdateSecurityGroupWithContext mocks base method.
+func (m *MockSecurityGroupServiceIface) UpdateSecurityGroupWithContext(ctx context.Context, p *UpdateSecurityGroupParams) (*UpdateSecurityGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSecurityGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateSecurityGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSecurityGroupWithContext indicates an expected call of UpdateSecurityGroupWithContext.
+func (mr *MockSecurityGroupServiceIfaceMockRecorder) UpdateSecurityGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSecurityGroupWithContext", reflect.TypeOf((*MockSecurityGroupServiceIface)(nil).UpdateSecurityGroupWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,15 +31,26 @@ import (
 
 type ServiceOfferingServiceIface interface {
 	CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
+	CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error)
 	NewCreateServiceOfferingParams(displaytext string, name string) *CreateServiceOfferingParams
 	DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
+	DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error)
 	NewDeleteServiceOfferingParams(id string) *DeleteServiceOfferingParams
 	ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error)
+	ListServiceOfferingsAll(p *ListServiceOfferingsParams, opts ...PageOption) ([]*ServiceOffering, error)
+	ListServiceOfferingsAllWithContext(ctx context.Context, p *ListServiceOfferingsParams, opts ...PageOption) ([]*ServiceOffering, error)
+	ListServiceOfferingsIter(p *ListServiceOfferingsParams, opts ...PageOption) iter.Seq2[*ServiceOffering, error]
+	ListServiceOfferingsIterWithContext(ctx context.Context, p *ListServiceOfferingsParams, opts ...PageOption) iter.Seq2[*ServiceOffering, error]
 	NewListServiceOfferingsParams() *ListServiceOfferingsParams
 	GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetServiceOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ServiceOffering, int, error)
 	GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error)
+	GetServiceOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ServiceOffering, int, error)
 	UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
+	UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error)
 	NewUpdateServiceOfferingParams(id string) *UpdateServiceOfferingParams
 }
 
@@ -262,6 +275,97 @@ func (p *CreateServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createServiceOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateServiceOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createServiceOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createServiceOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateServiceOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "name"},
+		lengths:  map[string]int{"cachemode": 255, "deploymentplanner": 255, "diskofferingid": 255, "displaytext": 4096, "hosttags": 4096, "leaseexpiryaction": 255, "name": 255, "provisioningtype": 255, "storagepolicy": 255, "storagetype": 255, "systemvmtype": 255, "tags": 4096, "vgpuprofileid": 255},
+	}.validate("createServiceOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createServiceOffering API, before the request is signed
+func (p *CreateServiceOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createServiceOffering", b, &p.p, map[string]paramDecoder{
+		"bytesreadrate":             decodeParam[int64],
+		"bytesreadratemax":          decodeParam[int64],
+		"bytesreadratemaxlength":    decodeParam[int64],
+		"byteswriterate":            decodeParam[int64],
+		"byteswriteratemax":         decodeParam[int64],
+		"byteswriteratemaxlength":   decodeParam[int64],
+		"cachemode":                 decodeParam[string],
+		"cpunumber":                 decodeParam[int],
+		"cpuspeed":                  decodeParam[int],
+		"customized":                decodeParam[bool],
+		"customizediops":            decodeParam[bool],
+		"deploymentplanner":         decodeParam[string],
+		"diskofferingid":            decodeParam[string],
+		"diskofferingstrictness":    decodeParam[bool],
+		"displaytext":               decodeParam[string],
+		"domainid":                  decodeParam[[]string],
+		"dynamicscalingenabled":     decodeParam[bool],
+		"encryptroot":               decodeParam[bool],
+		"externaldetails":           decodeParam[map[string]string],
+		"gpucount":                  decodeParam[int],
+		"gpudisplay":                decodeParam[bool],
+		"hosttags":                  decodeParam[string],
+		"hypervisorsnapshotreserve": decodeParam[int],
+		"iopsreadrate":              decodeParam[int64],
+		"iopsreadratemax":           decodeParam[int64],
+		"iopsreadratemaxlength":     decodeParam[int64],
+		"iopswriterate":             decodeParam[int64],
+		"iopswriteratemax":          decodeParam[int64],
+		"iopswriteratemaxlength":    decodeParam[int64],
+		"issystem":                  decodeParam[bool],
+		"isvolatile":                decodeParam[bool],
+		"leaseduration":             decodeParam[int],
+		"leaseexpiryaction":         decodeParam[string],
+		"limitcpuuse":               decodeParam[bool],
+		"maxcpunumber":              decodeParam[int],
+		"maxiops":                   decodeParam[int64],
+		"maxmemory":                 decodeParam[int],
+		"memory":                    decodeParam[int],
+		"mincpunumber":              decodeParam[int],
+		"miniops":                   decodeParam[int64],
+		"minmemory":                 decodeParam[int],
+		"name":                      decodeParam[string],
+		"networkrate":               decodeParam[int],
+		"offerha":                   decodeParam[bool],
+		"provisioningtype":          decodeParam[string],
+		"purgeresources":            decodeParam[bool],
+		"rootdisksize":              decodeParam[int64],
+		"serviceofferingdetails":    decodeParam[map[string]string],
+		"storagepolicy":             decodeParam[string],
+		"storagetype":               decodeParam[string],
+		"systemvmtype":              decodeParam[string],
+		"tags":                      decodeParam[string],
+		"vgpuprofileid":             decodeParam[string],
+		"zoneid":                    decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateServiceOfferingParams) Clone() *CreateServiceOfferingParams {
+	return &CreateServiceOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateServiceOfferingParams) SetBytesreadrate(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1408,7 +1512,16 @@ func (s *ServiceOfferingService) NewCreateServiceOfferingParams(displaytext stri
 
 // Creates a service offering.
 func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("createServiceOffering", p.toURLValues())
+	return s.CreateServiceOfferingWithContext(context.Background(), p)
+}
+
+// CreateServiceOfferingWithContext is like CreateServiceOffering, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createServiceOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1418,7 +1531,7 @@ func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingP
 	}
 
 	var r CreateServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createServiceOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1426,73 +1539,79 @@ func (s *ServiceOfferingService) CreateServiceOffering(p *CreateServiceOfferingP
 }
 
 type CreateServiceOfferingResponse struct {
-	CacheMode                   string            `json:"cacheMode"`
-	Cpunumber                   int               `json:"cpunumber"`
-	Cpuspeed                    int               `json:"cpuspeed"`
-	Created                     string            `json:"created"`
-	Defaultuse                  bool              `json:"defaultuse"`
-	Deploymentplanner           string            `json:"deploymentplanner"`
-	DiskBytesReadRate           int64             `json:"diskBytesReadRate"`
-	DiskBytesReadRateMax        int64             `json:"diskBytesReadRateMax"`
-	DiskBytesReadRateMaxLength  int64             `json:"diskBytesReadRateMaxLength"`
-	DiskBytesWriteRate          int64             `json:"diskBytesWriteRate"`
-	DiskBytesWriteRateMax       int64             `json:"diskBytesWriteRateMax"`
-	DiskBytesWriteRateMaxLength int64             `json:"diskBytesWriteRateMaxLength"`
-	DiskIopsReadRate            int64             `json:"diskIopsReadRate"`
-	DiskIopsReadRateMax         int64             `json:"diskIopsReadRateMax"`
-	DiskIopsReadRateMaxLength   int64             `json:"diskIopsReadRateMaxLength"`
-	DiskIopsWriteRate           int64             `json:"diskIopsWriteRate"`
-	DiskIopsWriteRateMax        int64             `json:"diskIopsWriteRateMax"`
-	DiskIopsWriteRateMaxLength  int64             `json:"diskIopsWriteRateMaxLength"`
-	Diskofferingdisplaytext     string            `json:"diskofferingdisplaytext"`
-	Diskofferingid              string            `json:"diskofferingid"`
-	Diskofferingname            string            `json:"diskofferingname"`
-	Diskofferingstrictness      bool              `json:"diskofferingstrictness"`
-	Displaytext                 string            `json:"displaytext"`
-	Domain                      string            `json:"domain"`
-	Domainid                    string            `json:"domainid"`
-	Dynamicscalingenabled       bool              `json:"dynamicscalingenabled"`
-	Encryptroot                 bool              `json:"encryptroot"`
-	Gpucardid                   string            `json:"gpucardid"`
-	Gpucardname                 string            `json:"gpucardname"`
-	Gpucount                    int               `json:"gpucount"`
-	Gpudisplay                  bool              `json:"gpudisplay"`
-	Hasannotations              bool              `json:"hasannotations"`
-	Hosttags                    string            `json:"hosttags"`
-	Hypervisorsnapshotreserve   int               `json:"hypervisorsnapshotreserve"`
-	Id                          string            `json:"id"`
-	Iscustomized                bool              `json:"iscustomized"`
-	Iscustomizediops            bool              `json:"iscustomizediops"`
-	Issystem                    bool              `json:"issystem"`
-	Isvolatile                  bool              `json:"isvolatile"`
-	JobID                       string            `json:"jobid"`
-	Jobstatus                   int               `json:"jobstatus"`
-	Leaseduration               int               `json:"leaseduration"`
-	Leaseexpiryaction           string            `json:"leaseexpiryaction"`
-	Limitcpuuse                 bool              `json:"limitcpuuse"`
-	Maxheads                    int64             `json:"maxheads"`
-	Maxiops                     int64             `json:"maxiops"`
-	Maxresolutionx              int64             `json:"maxresolutionx"`
-	Maxresolutiony              int64             `json:"maxresolutiony"`
-	Memory                      int               `json:"memory"`
-	Miniops                     int64             `json:"miniops"`
-	Name                        string            `json:"name"`
-	Networkrate                 int               `json:"networkrate"`
-	Offerha                     bool              `json:"offerha"`
-	Provisioningtype            string            `json:"provisioningtype"`
-	Purgeresources              bool              `json:"purgeresources"`
-	Rootdisksize                int64             `json:"rootdisksize"`
-	Serviceofferingdetails      map[string]string `json:"serviceofferingdetails"`
-	State                       string            `json:"state"`
-	Storagetags                 string            `json:"storagetags"`
-	Storagetype                 string            `json:"storagetype"`
-	Systemvmtype                string            `json:"systemvmtype"`
-	Vgpuprofileid               string            `json:"vgpuprofileid"`
-	Vgpuprofilename             string            `json:"vgpuprofilename"`
-	Videoram                    int64             `json:"videoram"`
-	Vspherestoragepolicy        string            `json:"vspherestoragepolicy"`
-	Zone                        string            `json:"zone"`
-	Zoneid                      string            `json:"zoneid"`
+	CacheMode                   string                     `json:"cacheMode"`
+	Cpunumber                   FlexInt                    `json:"cpunumber"`
+	Cpuspeed                    FlexInt                    `json:"cpuspeed"`
+	Created                     Time                       `json:"created"`
+	Defaultuse                  FlexBool                   `json:"defaultuse"`
+	Deploymentplanner           string                     `json:"deploymentplanner"`
+	DiskBytesReadRate           FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesReadRateMax        FlexInt64                  `json:"diskBytesReadRateMax"`
+	DiskBytesReadRateMaxLength  FlexInt64                  `json:"diskBytesReadRateMaxLength"`
+	DiskBytesWriteRate          FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskBytesWriteRateMax       FlexInt64                  `json:"diskBytesWriteRateMax"`
+	DiskBytesWriteRateMaxLength FlexInt64                  `json:"diskBytesWriteRateMaxLength"`
+	DiskIopsReadRate            FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsReadRateMax         FlexInt64                  `json:"diskIopsReadRateMax"`
+	DiskIopsReadRateMaxLength   FlexInt64                  `json:"diskIopsReadRateMaxLength"`
+	DiskIopsWriteRate           FlexInt64                  `json:"diskIopsWriteRate"`
+	DiskIopsWriteRateMax        FlexInt64                  `json:"diskIopsWriteRateMax"`
+	DiskIopsWriteRateMaxLength  FlexInt64                  `json:"diskIopsWriteRateMaxLength"`
+	Diskofferingdisplaytext     string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid              string                     `json:"diskofferingid"`
+	Diskofferingname            string                     `json:"diskofferingname"`
+	Diskofferingstrictness      FlexBool                   `json:"diskofferingstrictness"`
+	Displaytext                 string                     `json:"displaytext"`
+	Domain                      string                     `json:"domain"`
+	Domainid                    string                     `json:"domainid"`
+	Dynamicscalingenabled       FlexBool                   `json:"dynamicscalingenabled"`
+	Encryptroot                 FlexBool                   `json:"encryptroot"`
+	Gpucardid                   string                     `json:"gpucardid"`
+	Gpucardname                 string                     `json:"gpucardname"`
+	Gpucount                    FlexInt                    `json:"gpucount"`
+	Gpudisplay                  FlexBool                   `json:"gpudisplay"`
+	Hasannotations              FlexBool                   `json:"hasannotations"`
+	Hosttags                    string                     `json:"hosttags"`
+	Hypervisorsnapshotreserve   FlexInt                    `json:"hypervisorsnapshotreserve"`
+	Id                          string                     `json:"id"`
+	Iscustomized                FlexBool                   `json:"iscustomized"`
+	Iscustomizediops            FlexBool                   `json:"iscustomizediops"`
+	Issystem                    FlexBool                   `json:"issystem"`
+	Isvolatile                  FlexBool                   `json:"isvolatile"`
+	JobID                       string                     `json:"jobid"`
+	Jobstatus                   FlexInt                    `json:"jobstatus"`
+	Leaseduration               FlexInt                    `json:"leaseduration"`
+	Leaseexpiryaction           string                     `json:"leaseexpiryaction"`
+	Limitcpuuse                 FlexBool                   `json:"limitcpuuse"`
+	Maxheads                    FlexInt64                  `json:"maxheads"`
+	Maxiops                     FlexInt64                  `json:"maxiops"`
+	Maxresolutionx              FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony              FlexInt64                  `json:"maxresolutiony"`
+	Memory                      FlexInt                    `json:"memory"`
+	Miniops                     FlexInt64                  `json:"miniops"`
+	Name                        string                     `json:"name"`
+	Networkrate                 FlexInt                    `json:"networkrate"`
+	Offerha                     FlexBool                   `json:"offerha"`
+	Provisioningtype            string                     `json:"provisioningtype"`
+	Purgeresources              FlexBool                   `json:"purgeresources"`
+	Rootdisksize                FlexInt64                  `json:"rootdisksize"`
+	Serviceofferingdetails      map[string]string          `json:"serviceofferingdetails"`
+	State                       string                     `json:"state"`
+	Storagetags                 string                     `json:"storagetags"`
+	Storagetype                 string                     `json:"storagetype"`
+	Systemvmtype                string                     `json:"systemvmtype"`
+	Vgpuprofileid               string                     `json:"vgpuprofileid"`
+	Vgpuprofilename             string                     `json:"vgpuprofilename"`
+	Videoram                    FlexInt64                  `json:"videoram"`
+	Vspherestoragepolicy        string                     `json:"vspherestoragepolicy"`
+	Zone                        string                     `json:"zone"`
+	Zoneid                      string                     `json:"zoneid"`
+	Extra                       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateServiceOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateServiceOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteServiceOfferingParams struct {
@@ -1510,6 +1629,44 @@ func (p *DeleteServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteServiceOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteServiceOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteServiceOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteServiceOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteServiceOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteServiceOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteServiceOffering API, before the request is signed
+func (p *DeleteServiceOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteServiceOffering", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteServiceOfferingParams) Clone() *DeleteServiceOfferingParams {
+	return &DeleteServiceOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteServiceOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1542,13 +1699,22 @@ func (s *ServiceOfferingService) NewDeleteServiceOfferingParams(id string) *Dele
 
 // Deletes a service offering.
 func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteServiceOffering", p.toURLValues())
+	return s.DeleteServiceOfferingWithContext(context.Background(), p)
+}
+
+// DeleteServiceOfferingWithContext is like DeleteServiceOffering, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteServiceOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteServiceOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1556,10 +1722,11 @@ func (s *ServiceOfferingService) DeleteServiceOffering(p *DeleteServiceOfferingP
 }
 
 type DeleteServiceOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteServiceOfferingResponse) UnmarshalJSON(b []byte) error {
@@ -1586,7 +1753,7 @@ func (r *DeleteServiceOfferingResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteServiceOfferingResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListServiceOfferingsParams struct {
@@ -1680,6 +1847,65 @@ func (p *ListServiceOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listServiceOfferings API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListServiceOfferingsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listServiceOfferings", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listServiceOfferings API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListServiceOfferingsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "state": 255, "storagetype": 255, "systemvmtype": 255, "templateid": 255, "vgpuprofileid": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("listServiceOfferings", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listServiceOfferings API, before the request is signed
+func (p *ListServiceOfferingsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListServiceOfferingsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListServiceOfferingsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listServiceOfferings", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"cpunumber":        decodeParam[int],
+		"cpuspeed":         decodeParam[int],
+		"domainid":         decodeParam[string],
+		"encryptroot":      decodeParam[bool],
+		"gpuenabled":       decodeParam[bool],
+		"id":               decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"issystem":         decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"memory":           decodeParam[int],
+		"name":             decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"state":            decodeParam[string],
+		"storagetype":      decodeParam[string],
+		"systemvmtype":     decodeParam[string],
+		"templateid":       decodeParam[string],
+		"vgpuprofileid":    decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListServiceOfferingsParams) Clone() *ListServiceOfferingsParams {
+	return &ListServiceOfferingsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListServiceOfferingsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2173,6 +2399,11 @@ func (s *ServiceOfferingService) NewListServiceOfferingsParams() *ListServiceOff
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetServiceOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetServiceOfferingIDWithContext is like GetServiceOfferingID, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) GetServiceOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListServiceOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2184,7 +2415,7 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListServiceOfferings(p)
+	l, err := s.ListServiceOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2209,12 +2440,17 @@ func (s *ServiceOfferingService) GetServiceOfferingID(name string, opts ...Optio
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
-	id, count, err := s.GetServiceOfferingID(name, opts...)
+	return s.GetServiceOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetServiceOfferingByNameWithContext is like GetServiceOfferingByName, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) GetServiceOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	id, count, err := s.GetServiceOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetServiceOfferingByID(id, opts...)
+	r, count, err := s.GetServiceOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2223,6 +2459,11 @@ func (s *ServiceOfferingService) GetServiceOfferingByName(name string, opts ...O
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	return s.GetServiceOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetServiceOfferingByIDWithContext is like GetServiceOfferingByID, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) GetServiceOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
 	p := &ListServiceOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2234,11 +2475,10 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListServiceOfferings(p)
+	l, err := s.ListServiceOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2256,92 +2496,135 @@ func (s *ServiceOfferingService) GetServiceOfferingByID(id string, opts ...Optio
 
 // Lists all available service offerings.
 func (s *ServiceOfferingService) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listServiceOfferings", p.toURLValues())
+	return s.ListServiceOfferingsWithContext(context.Background(), p)
+}
+
+// ListServiceOfferingsWithContext is like ListServiceOfferings, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listServiceOfferings", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListServiceOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listServiceOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListServiceOfferingsAll returns all ServiceOfferings matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ServiceOfferingService) ListServiceOfferingsAll(p *ListServiceOfferingsParams, opts ...PageOption) ([]*ServiceOffering, error) {
+	return s.ListServiceOfferingsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListServiceOfferingsAllWithContext is like ListServiceOfferingsAll, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) ListServiceOfferingsAllWithContext(ctx context.Context, p *ListServiceOfferingsParams, opts ...PageOption) ([]*ServiceOffering, error) {
+	return collectPages(s.ListServiceOfferingsIterWithContext(ctx, p, opts...))
+}
+
+// ListServiceOfferingsIter returns an iterator over all ServiceOfferings matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ServiceOfferingService) ListServiceOfferingsIter(p *ListServiceOfferingsParams, opts ...PageOption) iter.Seq2[*ServiceOffering, error] {
+	return s.ListServiceOfferingsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListServiceOfferingsIterWithContext is like ListServiceOfferingsIter, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) ListServiceOfferingsIterWithContext(ctx context.Context, p *ListServiceOfferingsParams, opts ...PageOption) iter.Seq2[*ServiceOffering, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ServiceOffering, int, error) {
+		l, err := s.ListServiceOfferingsWithContext(ctx, &ListServiceOfferingsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ServiceOfferings, l.Count, nil
+	}, opts...)
+}
+
 type ListServiceOfferingsResponse struct {
 	Count            int                `json:"count"`
 	ServiceOfferings []*ServiceOffering `json:"serviceoffering"`
 }
 
 type ServiceOffering struct {
-	CacheMode                   string            `json:"cacheMode"`
-	Cpunumber                   int               `json:"cpunumber"`
-	Cpuspeed                    int               `json:"cpuspeed"`
-	Created                     string            `json:"created"`
-	Defaultuse                  bool              `json:"defaultuse"`
-	Deploymentplanner           string            `json:"deploymentplanner"`
-	DiskBytesReadRate           int64             `json:"diskBytesReadRate"`
-	DiskBytesReadRateMax        int64             `json:"diskBytesReadRateMax"`
-	DiskBytesReadRateMaxLength  int64             `json:"diskBytesReadRateMaxLength"`
-	DiskBytesWriteRate          int64             `json:"diskBytesWriteRate"`
-	DiskBytesWriteRateMax       int64             `json:"diskBytesWriteRateMax"`
-	DiskBytesWriteRateMaxLength int64             `json:"diskBytesWriteRateMaxLength"`
-	DiskIopsReadRate            int64             `json:"diskIopsReadRate"`
-	DiskIopsReadRateMax         int64             `json:"diskIopsReadRateMax"`
-	DiskIopsReadRateMaxLength   int64             `json:"diskIopsReadRateMaxLength"`
-	DiskIopsWriteRate           int64             `json:"diskIopsWriteRate"`
-	DiskIopsWriteRateMax        int64             `json:"diskIopsWriteRateMax"`
-	DiskIopsWriteRateMaxLength  int64             `json:"diskIopsWriteRateMaxLength"`
-	Diskofferingdisplaytext     string            `json:"diskofferingdisplaytext"`
-	Diskofferingid              string            `json:"diskofferingid"`
-	Diskofferingname            string            `json:"diskofferingname"`
-	Diskofferingstrictness      bool              `json:"diskofferingstrictness"`
-	Displaytext                 string            `json:"displaytext"`
-	Domain                      string            `json:"domain"`
-	Domainid                    string            `json:"domainid"`
-	Dynamicscalingenabled       bool              `json:"dynamicscalingenabled"`
-	Encryptroot                 bool              `json:"encryptroot"`
-	Gpucardid                   string            `json:"gpucardid"`
-	Gpucardname                 string            `json:"gpucardname"`
-	Gpucount                    int               `json:"gpucount"`
-	Gpudisplay                  bool              `json:"gpudisplay"`
-	Hasannotations              bool              `json:"hasannotations"`
-	Hosttags                    string            `json:"hosttags"`
-	Hypervisorsnapshotreserve   int               `json:"hypervisorsnapshotreserve"`
-	Id                          string            `json:"id"`
-	Iscustomized                bool              `json:"iscustomized"`
-	Iscustomizediops            bool              `json:"iscustomizediops"`
-	Issystem                    bool              `json:"issystem"`
-	Isvolatile                  bool              `json:"isvolatile"`
-	JobID                       string            `json:"jobid"`
-	Jobstatus                   int               `json:"jobstatus"`
-	Leaseduration               int               `json:"leaseduration"`
-	Leaseexpiryaction           string            `json:"leaseexpiryaction"`
-	Limitcpuuse                 bool              `json:"limitcpuuse"`
-	Maxheads                    int64             `json:"maxheads"`
-	Maxiops                     int64             `json:"maxiops"`
-	Maxresolutionx              int64             `json:"maxresolutionx"`
-	Maxresolutiony              int64             `json:"maxresolutiony"`
-	Memory                      int               `json:"memory"`
-	Miniops                     int64             `json:"miniops"`
-	Name                        string            `json:"name"`
-	Networkrate                 int               `json:"networkrate"`
-	Offerha                     bool              `json:"offerha"`
-	Provisioningtype            string            `json:"provisioningtype"`
-	Purgeresources              bool              `json:"purgeresources"`
-	Rootdisksize                int64             `json:"rootdisksize"`
-	Serviceofferingdetails      map[string]string `json:"serviceofferingdetails"`
-	State                       string            `json:"state"`
-	Storagetags                 string            `json:"storagetags"`
-	Storagetype                 string            `json:"storagetype"`
-	Systemvmtype                string            `json:"systemvmtype"`
-	Vgpuprofileid               string            `json:"vgpuprofileid"`
-	Vgpuprofilename             string            `json:"vgpuprofilename"`
-	Videoram                    int64             `json:"videoram"`
-	Vspherestoragepolicy        string            `json:"vspherestoragepolicy"`
-	Zone                        string            `json:"zone"`
-	Zoneid                      string            `json:"zoneid"`
+	CacheMode                   string                     `json:"cacheMode"`
+	Cpunumber                   FlexInt                    `json:"cpunumber"`
+	Cpuspeed                    FlexInt                    `json:"cpuspeed"`
+	Created                     Time                       `json:"created"`
+	Defaultuse                  FlexBool                   `json:"defaultuse"`
+	Deploymentplanner           string                     `json:"deploymentplanner"`
+	DiskBytesReadRate           FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesReadRateMax        FlexInt64                  `json:"diskBytesReadRateMax"`
+	DiskBytesReadRateMaxLength  FlexInt64                  `json:"diskBytesReadRateMaxLength"`
+	DiskBytesWriteRate          FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskBytesWriteRateMax       FlexInt64                  `json:"diskBytesWriteRateMax"`
+	DiskBytesWriteRateMaxLength FlexInt64                  `json:"diskBytesWriteRateMaxLength"`
+	DiskIopsReadRate            FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsReadRateMax         FlexInt64                  `json:"diskIopsReadRateMax"`
+	DiskIopsReadRateMaxLength   FlexInt64                  `json:"diskIopsReadRateMaxLength"`
+	DiskIopsWriteRate           FlexInt64                  `json:"diskIopsWriteRate"`
+	DiskIopsWriteRateMax        FlexInt64                  `json:"diskIopsWriteRateMax"`
+	DiskIopsWriteRateMaxLength  FlexInt64                  `json:"diskIopsWriteRateMaxLength"`
+	Diskofferingdisplaytext     string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid              string                     `json:"diskofferingid"`
+	Diskofferingname            string                     `json:"diskofferingname"`
+	Diskofferingstrictness      FlexBool                   `json:"diskofferingstrictness"`
+	Displaytext                 string                     `json:"displaytext"`
+	Domain                      string                     `json:"domain"`
+	Domainid                    string                     `json:"domainid"`
+	Dynamicscalingenabled       FlexBool                   `json:"dynamicscalingenabled"`
+	Encryptroot                 FlexBool                   `json:"encryptroot"`
+	Gpucardid                   string                     `json:"gpucardid"`
+	Gpucardname                 string                     `json:"gpucardname"`
+	Gpucount                    FlexInt                    `json:"gpucount"`
+	Gpudisplay                  FlexBool                   `json:"gpudisplay"`
+	Hasannotations              FlexBool                   `json:"hasannotations"`
+	Hosttags                    string                     `json:"hosttags"`
+	Hypervisorsnapshotreserve   FlexInt                    `json:"hypervisorsnapshotreserve"`
+	Id                          string                     `json:"id"`
+	Iscustomized                FlexBool                   `json:"iscustomized"`
+	Iscustomizediops            FlexBool                   `json:"iscustomizediops"`
+	Issystem                    FlexBool                   `json:"issystem"`
+	Isvolatile                  FlexBool                   `json:"isvolatile"`
+	JobID                       string                     `json:"jobid"`
+	Jobstatus                   FlexInt                    `json:"jobstatus"`
+	Leaseduration               FlexInt                    `json:"leaseduration"`
+	Leaseexpiryaction           string                     `json:"leaseexpiryaction"`
+	Limitcpuuse                 FlexBool                   `json:"limitcpuuse"`
+	Maxheads                    FlexInt64                  `json:"maxheads"`
+	Maxiops                     FlexInt64                  `json:"maxiops"`
+	Maxresolutionx              FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony              FlexInt64                  `json:"maxresolutiony"`
+	Memory                      FlexInt                    `json:"memory"`
+	Miniops                     FlexInt64                  `json:"miniops"`
+	Name                        string                     `json:"name"`
+	Networkrate                 FlexInt                    `json:"networkrate"`
+	Offerha                     FlexBool                   `json:"offerha"`
+	Provisioningtype            string                     `json:"provisioningtype"`
+	Purgeresources              FlexBool                   `json:"purgeresources"`
+	Rootdisksize                FlexInt64                  `json:"rootdisksize"`
+	Serviceofferingdetails      map[string]string          `json:"serviceofferingdetails"`
+	State                       string                     `json:"state"`
+	Storagetags                 string                     `json:"storagetags"`
+	Storagetype                 string                     `json:"storagetype"`
+	Systemvmtype                string                     `json:"systemvmtype"`
+	Vgpuprofileid               string                     `json:"vgpuprofileid"`
+	Vgpuprofilename             string                     `json:"vgpuprofilename"`
+	Videoram                    FlexInt64                  `json:"videoram"`
+	Vspherestoragepolicy        string                     `json:"vspherestoragepolicy"`
+	Zone                        string                     `json:"zone"`
+	Zoneid                      string                     `json:"zoneid"`
+	Extra                       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ServiceOffering) UnmarshalJSON(b []byte) error {
+	type alias ServiceOffering
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateServiceOfferingParams struct {
@@ -2399,6 +2682,55 @@ func (p *UpdateServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateServiceOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateServiceOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateServiceOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateServiceOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateServiceOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"displaytext": 4096, "domainid": 255, "hosttags": 4096, "id": 255, "name": 255, "state": 255, "storagetags": 4096, "zoneid": 255},
+	}.validate("updateServiceOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateServiceOffering API, before the request is signed
+func (p *UpdateServiceOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateServiceOffering", b, &p.p, map[string]paramDecoder{
+		"cleanupexternaldetails": decodeParam[bool],
+		"displaytext":            decodeParam[string],
+		"domainid":               decodeParam[string],
+		"externaldetails":        decodeParam[map[string]string],
+		"hosttags":               decodeParam[string],
+		"id":                     decodeParam[string],
+		"name":                   decodeParam[string],
+		"purgeresources":         decodeParam[bool],
+		"sortkey":                decodeParam[int],
+		"state":                  decodeParam[string],
+		"storagetags":            decodeParam[string],
+		"zoneid":                 decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateServiceOfferingParams) Clone() *UpdateServiceOfferingParams {
+	return &UpdateServiceOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateServiceOfferingParams) SetCleanupexternaldetails(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2662,7 +2994,16 @@ func (s *ServiceOfferingService) NewUpdateServiceOfferingParams(id string) *Upda
 
 // Updates a service offering.
 func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("updateServiceOffering", p.toURLValues())
+	return s.UpdateServiceOfferingWithContext(context.Background(), p)
+}
+
+// UpdateServiceOfferingWithContext is like UpdateServiceOffering, but honours the cancellation and deadline of ctx
+func (s *ServiceOfferingService) UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateServiceOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2672,7 +3013,7 @@ func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingP
 	}
 
 	var r UpdateServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateServiceOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2680,71 +3021,77 @@ func (s *ServiceOfferingService) UpdateServiceOffering(p *UpdateServiceOfferingP
 }
 
 type UpdateServiceOfferingResponse struct {
-	CacheMode                   string            `json:"cacheMode"`
-	Cpunumber                   int               `json:"cpunumber"`
-	Cpuspeed                    int               `json:"cpuspeed"`
-	Created                     string            `json:"created"`
-	Defaultuse                  bool              `json:"defaultuse"`
-	Deploymentplanner           string            `json:"deploymentplanner"`
-	DiskBytesReadRate           int64             `json:"diskBytesReadRate"`
-	DiskBytesReadRateMax        int64             `json:"diskBytesReadRateMax"`
-	DiskBytesReadRateMaxLength  int64             `json:"diskBytesReadRateMaxLength"`
-	DiskBytesWriteRate          int64             `json:"diskBytesWriteRate"`
-	DiskBytesWriteRateMax       int64             `json:"diskBytesWriteRateMax"`
-	DiskBytesWriteRateMaxLength int64             `json:"diskBytesWriteRateMaxLength"`
-	DiskIopsReadRate            int64             `json:"diskIopsReadRate"`
-	DiskIopsReadRateMax         int64             `json:"diskIopsReadRateMax"`
-	DiskIopsReadRateMaxLength   int64             `json:"diskIopsReadRateMaxLength"`
-	DiskIopsWriteRate           int64             `json:"diskIopsWriteRate"`
-	DiskIopsWriteRateMax        int64             `json:"diskIopsWriteRateMax"`
-	DiskIopsWriteRateMaxLength  int64             `json:"diskIopsWriteRateMaxLength"`
-	Diskofferingdisplaytext     string            `json:"diskofferingdisplaytext"`
-	Diskofferingid              string            `json:"diskofferingid"`
-	Diskofferingname            string            `json:"diskofferingname"`
-	Diskofferingstrictness      bool              `json:"diskofferingstrictness"`
-	Displaytext                 string            `json:"displaytext"`
-	Domain                      string            `json:"domain"`
-	Domainid                    string            `json:"domainid"`
-	Dynamicscalingenabled       bool              `json:"dynamicscalingenabled"`
-	Encryptroot                 bool              `json:"encryptroot"`
-	Gpucardid                   string            `json:"gpucardid"`
-	Gpucardname                 string            `json:"gpucardname"`
-	Gpucount                    int               `json:"gpucount"`
-	Gpudisplay                  bool              `json:"gpudisplay"`
-	Hasannotations              bool              `json:"hasannotations"`
-	Hosttags                    string            `json:"hosttags"`
-	Hypervisorsnapshotreserve   int               `json:"hypervisorsnapshotreserve"`
-	Id                          string            `json:"id"`
-	Iscustomized                bool              `json:"iscustomized"`
-	Iscustomizediops            bool              `json:"iscustomizediops"`
-	Issystem                    bool              `json:"issystem"`
-	Isvolatile                  bool              `json:"isvolatile"`
-	JobID                       string            `json:"jobid"`
-	Jobstatus                   int               `json:"jobstatus"`
-	Leaseduration               int               `json:"leaseduration"`
-	Leaseexpiryaction           string            `json:"leaseexpiryaction"`
-	Limitcpuuse                 bool              `json:"limitcpuuse"`
-	Maxheads                    int64             `json:"maxheads"`
-	Maxiops                     int64             `json:"maxiops"`
-	Maxresolutionx              int64             `json:"maxresolutionx"`
-	Maxresolutiony              int64             `json:"maxresolutiony"`
-	Memory                      int               `json:"memory"`
-	Miniops                     int64             `json:"miniops"`
-	Name                        string            `json:"name"`
-	Networkrate                 int               `json:"networkrate"`
-	Offerha                     bool              `json:"offerha"`
-	Provisioningtype            string            `json:"provisioningtype"`
-	Purgeresources              bool              `json:"purgeresources"`
-	Rootdisksize                int64             `json:"rootdisksize"`
-	Serviceofferingdetails      map[string]string `json:"serviceofferingdetails"`
-	State                       string            `json:"state"`
-	Storagetags                 string            `json:"storagetags"`
-	Storagetype                 string            `json:"storagetype"`
-	Systemvmtype                string            `json:"systemvmtype"`
-	Vgpuprofileid               string            `json:"vgpuprofileid"`
-	Vgpuprofilename             string            `json:"vgpuprofilename"`
-	Videoram                    int64             `json:"videoram"`
-	Vspherestoragepolicy        string            `json:"vspherestoragepolicy"`
-	Zone                        string            `json:"zone"`
-	Zoneid                      string            `json:"zoneid"`
+	CacheMode                   string                     `json:"cacheMode"`
+	Cpunumber                   FlexInt                    `json:"cpunumber"`
+	Cpuspeed                    FlexInt                    `json:"cpuspeed"`
+	Created                     Time                       `json:"created"`
+	Defaultuse                  FlexBool                   `json:"defaultuse"`
+	Deploymentplanner           string                     `json:"deploymentplanner"`
+	DiskBytesReadRate           FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesReadRateMax        FlexInt64                  `json:"diskBytesReadRateMax"`
+	DiskBytesReadRateMaxLength  FlexInt64                  `json:"diskBytesReadRateMaxLength"`
+	DiskBytesWriteRate          FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskBytesWriteRateMax       FlexInt64                  `json:"diskBytesWriteRateMax"`
+	DiskBytesWriteRateMaxLength FlexInt64                  `json:"diskBytesWriteRateMaxLength"`
+	DiskIopsReadRate            FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsReadRateMax         FlexInt64                  `json:"diskIopsReadRateMax"`
+	DiskIopsReadRateMaxLength   FlexInt64                  `json:"diskIopsReadRateMaxLength"`
+	DiskIopsWriteRate           FlexInt64                  `json:"diskIopsWriteRate"`
+	DiskIopsWriteRateMax        FlexInt64                  `json:"diskIopsWriteRateMax"`
+	DiskIopsWriteRateMaxLength  FlexInt64                  `json:"diskIopsWriteRateMaxLength"`
+	Diskofferingdisplaytext     string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid              string                     `json:"diskofferingid"`
+	Diskofferingname            string                     `json:"diskofferingname"`
+	Diskofferingstrictness      FlexBool                   `json:"diskofferingstrictness"`
+	Displaytext                 string                     `json:"displaytext"`
+	Domain                      string                     `json:"domain"`
+	Domainid                    string                     `json:"domainid"`
+	Dynamicscalingenabled       FlexBool                   `json:"dynamicscalingenabled"`
+	Encryptroot                 FlexBool                   `json:"encryptroot"`
+	Gpucardid                   string                     `json:"gpucardid"`
+	Gpucardname                 string                     `json:"gpucardname"`
+	Gpucount                    FlexInt                    `json:"gpucount"`
+	Gpudisplay                  FlexBool                   `json:"gpudisplay"`
+	Hasannotations              FlexBool                   `json:"hasannotations"`
+	Hosttags                    string                     `json:"hosttags"`
+	Hypervisorsnapshotreserve   FlexInt                    `json:"hypervisorsnapshotreserve"`
+	Id                          string                     `json:"id"`
+	Iscustomized                FlexBool                   `json:"iscustomized"`
+	Iscustomizediops            FlexBool                   `json:"iscustomizediops"`
+	Issystem                    FlexBool                   `json:"issystem"`
+	Isvolatile                  FlexBool                   `json:"isvolatile"`
+	JobID                       string                     `json:"jobid"`
+	Jobstatus                   FlexInt                    `json:"jobstatus"`
+	Leaseduration               FlexInt                    `json:"leaseduration"`
+	Leaseexpiryaction           string                     `json:"leaseexpiryaction"`
+	Limitcpuuse                 FlexBool                   `json:"limitcpuuse"`
+	Maxheads                    FlexInt64                  `json:"maxheads"`
+	Maxiops                     FlexInt64                  `json:"maxiops"`
+	Maxresolutionx              FlexInt64                  `json:"maxresolutionx"`
+	Maxresolutiony              FlexInt64                  `json:"maxresolutiony"`
+	Memory                      FlexInt                    `json:"memory"`
+	Miniops                     FlexInt64                  `json:"miniops"`
+	Name                        string                     `json:"name"`
+	Networkrate                 FlexInt                    `json:"networkrate"`
+	Offerha                     FlexBool                   `json:"offerha"`
+	Provisioningtype            string                     `json:"provisioningtype"`
+	Purgeresources              FlexBool                   `json:"purgeresources"`
+	Rootdisksize                FlexInt64                  `json:"rootdisksize"`
+	Serviceofferingdetails      map[string]string          `json:"serviceofferingdetails"`
+	State                       string                     `json:"state"`
+	Storagetags                 string                     `json:"storagetags"`
+	Storagetype                 string                     `json:"storagetype"`
+	Systemvmtype                string                     `json:"systemvmtype"`
+	Vgpuprofileid               string                     `json:"vgpuprofileid"`
+	Vgpuprofilename             string                     `json:"vgpuprofilename"`
+	Videoram                    FlexInt64                  `json:"videoram"`
+	Vspherestoragepolicy        string                     `json:"vspherestoragepolicy"`
+	Zone                        string                     `json:"zone"`
+	Zoneid                      string                     `json:"zoneid"`
+	Extra                       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateServiceOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateServiceOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) CreateServiceOffering(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).CreateServiceOffering), p)
 }
 
+// CreateServiceOfferingWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) CreateServiceOfferingWithContext(ctx context.Context, p *CreateServiceOfferingParams) (*CreateServiceOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateServiceOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateServiceOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateServiceOfferingWithContext indicates an expected call of CreateServiceOfferingWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) CreateServiceOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateServiceOfferingWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).CreateServiceOfferingWithContext), ctx, p)
+}
+
 // DeleteServiceOffering mocks base method.
 func (m *MockServiceOfferingServiceIface) DeleteServiceOffering(p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) DeleteServiceOffering(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).DeleteServiceOffering), p)
 }
 
+// DeleteServiceOfferingWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) DeleteServiceOfferingWithContext(ctx context.Context, p *DeleteServiceOfferingParams) (*DeleteServiceOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteServiceOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteServiceOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteServiceOfferingWithContext indicates an expected call of DeleteServiceOfferingWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) DeleteServiceOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteServiceOfferingWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).DeleteServiceOfferingWithContext), ctx, p)
+}
+
 // GetServiceOfferingByID mocks base method.
 func (m *MockServiceOfferingServiceIface) GetServiceOfferingByID(id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) GetServiceOfferingByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOfferingByID", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).GetServiceOfferingByID), varargs...)
 }
 
+// GetServiceOfferingByIDWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) GetServiceOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServiceOfferingByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ServiceOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetServiceOfferingByIDWithContext indicates an expected call of GetServiceOfferingByIDWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) GetServiceOfferingByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOfferingByIDWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).GetServiceOfferingByIDWithContext), varargs...)
+}
+
 // GetServiceOfferingByName mocks base method.
 func (m *MockServiceOfferingServiceIface) GetServiceOfferingByName(name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +183,27 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) GetServiceOfferingByName(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOfferingByName", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).GetServiceOfferingByName), varargs...)
 }
 
+// GetServiceOfferingByNameWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) GetServiceOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ServiceOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServiceOfferingByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*ServiceOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetServiceOfferingByNameWithContext indicates an expected call of GetServiceOfferingByNameWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) GetServiceOfferingByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOfferingByNameWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).GetServiceOfferingByNameWithContext), varargs...)
+}
+
 // GetServiceOfferingID mocks base method.
 func (m *MockServiceOfferingServiceIface) GetServiceOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +225,27 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) GetServiceOfferingID(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOfferingID", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).GetServiceOfferingID), varargs...)
 }
 
+// GetServiceOfferingIDWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) GetServiceOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetServiceOfferingIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetServiceOfferingIDWithContext indicates an expected call of GetServiceOfferingIDWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) GetServiceOfferingIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetServiceOfferingIDWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).GetServiceOfferingIDWithContext), varargs...)
+}
+
 // ListServiceOfferings mocks base method.
 func (m *MockServiceOfferingServiceIface) ListServiceOfferings(p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +261,99 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferings(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferings", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferings), p)
 }
 
+// ListServiceOfferingsAll mocks base method.
+func (m *MockServiceOfferingServiceIface) ListServiceOfferingsAll(p *ListServiceOfferingsParams, opts ...PageOption) ([]*ServiceOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceOfferingsAll", varargs...)
+	ret0, _ := ret[0].([]*ServiceOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOfferingsAll indicates an expected call of ListServiceOfferingsAll.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferingsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferingsAll", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferingsAll), varargs...)
+}
+
+// ListServiceOfferingsAllWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) ListServiceOfferingsAllWithContext(ctx context.Context, p *ListServiceOfferingsParams, opts ...PageOption) ([]*ServiceOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceOfferingsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ServiceOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOfferingsAllWithContext indicates an expected call of ListServiceOfferingsAllWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferingsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferingsAllWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferingsAllWithContext), varargs...)
+}
+
+// ListServiceOfferingsIter mocks base method.
+func (m *MockServiceOfferingServiceIface) ListServiceOfferingsIter(p *ListServiceOfferingsParams, opts ...PageOption) iter.Seq2[*ServiceOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceOfferingsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ServiceOffering, error])
+	return ret0
+}
+
+// ListServiceOfferingsIter indicates an expected call of ListServiceOfferingsIter.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferingsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferingsIter", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferingsIter), varargs...)
+}
+
+// ListServiceOfferingsIterWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) ListServiceOfferingsIterWithContext(ctx context.Context, p *ListServiceOfferingsParams, opts ...PageOption) iter.Seq2[*ServiceOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListServiceOfferingsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ServiceOffering, error])
+	return ret0
+}
+
+// ListServiceOfferingsIterWithContext indicates an expected call of ListServiceOfferingsIterWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferingsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferingsIterWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferingsIterWithContext), varargs...)
+}
+
+// ListServiceOfferingsWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) ListServiceOfferingsWithContext(ctx context.Context, p *ListServiceOfferingsParams) (*ListServiceOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListServiceOfferingsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListServiceOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListServiceOfferingsWithContext indicates an expected call of ListServiceOfferingsWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) ListServiceOfferingsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListServiceOfferingsWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).ListServiceOfferingsWithContext), ctx, p)
+}
+
 // NewCreateServiceOfferingParams mocks base method.
 func (m *MockServiceOfferingServiceIface) NewCreateServiceOfferingParams(displaytext, name string) *CreateServiceOfferingParams {
 	m.ctrl.T.Helper()
@@ -236,3 +424,18 @@ func (mr *MockServiceOfferingServiceIfaceMockRecorder) UpdateServiceOffering(p a
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceOffering", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).UpdateServiceOffering), p)
 }
+
+// UpdateServiceOfferingWithContext mocks base method.
+func (m *MockServiceOfferingServiceIface) UpdateServiceOfferingWithContext(ctx context.Context, p *UpdateServiceOfferingParams) (*UpdateServiceOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateServiceOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateServiceOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateServiceOfferingWithContext indicates an expected call of UpdateServiceOfferingWithContext.
+func (mr *MockServiceOfferingServiceIfaceMockRecorder) UpdateServiceOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateServiceOfferingWithContext", reflect.TypeOf((*MockServiceOfferingServiceIface)(nil).UpdateServiceOfferingWithContext), ctx, p)
+}
@@ -20,40 +20,72 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type SharedFileSystemServiceIface interface {
 	ChangeSharedFileSystemDiskOffering(p *ChangeSharedFileSystemDiskOfferingParams) (*ChangeSharedFileSystemDiskOfferingResponse, error)
+	ChangeSharedFileSystemDiskOfferingWithContext(ctx context.Context, p *ChangeSharedFileSystemDiskOfferingParams) (*ChangeSharedFileSystemDiskOfferingResponse, error)
+	ChangeSharedFileSystemDiskOfferingAsync(ctx context.Context, p *ChangeSharedFileSystemDiskOfferingParams) (*Job, error)
 	NewChangeSharedFileSystemDiskOfferingParams(id string) *ChangeSharedFileSystemDiskOfferingParams
 	ChangeSharedFileSystemServiceOffering(p *ChangeSharedFileSystemServiceOfferingParams) (*ChangeSharedFileSystemServiceOfferingResponse, error)
+	ChangeSharedFileSystemServiceOfferingWithContext(ctx context.Context, p *ChangeSharedFileSystemServiceOfferingParams) (*ChangeSharedFileSystemServiceOfferingResponse, error)
+	ChangeSharedFileSystemServiceOfferingAsync(ctx context.Context, p *ChangeSharedFileSystemServiceOfferingParams) (*Job, error)
 	NewChangeSharedFileSystemServiceOfferingParams(id string, serviceofferingid string) *ChangeSharedFileSystemServiceOfferingParams
 	CreateSharedFileSystem(p *CreateSharedFileSystemParams) (*CreateSharedFileSystemResponse, error)
+	CreateSharedFileSystemWithContext(ctx context.Context, p *CreateSharedFileSystemParams) (*CreateSharedFileSystemResponse, error)
+	CreateSharedFileSystemAsync(ctx context.Context, p *CreateSharedFileSystemParams) (*Job, error)
 	NewCreateSharedFileSystemParams(diskofferingid string, filesystem string, name string, networkid string, serviceofferingid string, zoneid string) *CreateSharedFileSystemParams
 	DestroySharedFileSystem(p *DestroySharedFileSystemParams) (*DestroySharedFileSystemResponse, error)
+	DestroySharedFileSystemWithContext(ctx context.Context, p *DestroySharedFileSystemParams) (*DestroySharedFileSystemResponse, error)
+	DestroySharedFileSystemAsync(ctx context.Context, p *DestroySharedFileSystemParams) (*Job, error)
 	NewDestroySharedFileSystemParams() *DestroySharedFileSystemParams
 	ExpungeSharedFileSystem(p *ExpungeSharedFileSystemParams) (*ExpungeSharedFileSystemResponse, error)
+	ExpungeSharedFileSystemWithContext(ctx context.Context, p *ExpungeSharedFileSystemParams) (*ExpungeSharedFileSystemResponse, error)
+	ExpungeSharedFileSystemAsync(ctx context.Context, p *ExpungeSharedFileSystemParams) (*Job, error)
 	NewExpungeSharedFileSystemParams() *ExpungeSharedFileSystemParams
 	ListSharedFileSystemProviders(p *ListSharedFileSystemProvidersParams) (*ListSharedFileSystemProvidersResponse, error)
+	ListSharedFileSystemProvidersWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams) (*ListSharedFileSystemProvidersResponse, error)
+	ListSharedFileSystemProvidersAll(p *ListSharedFileSystemProvidersParams, opts ...PageOption) ([]*SharedFileSystemProvider, error)
+	ListSharedFileSystemProvidersAllWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams, opts ...PageOption) ([]*SharedFileSystemProvider, error)
+	ListSharedFileSystemProvidersIter(p *ListSharedFileSystemProvidersParams, opts ...PageOption) iter.Seq2[*SharedFileSystemProvider, error]
+	ListSharedFileSystemProvidersIterWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams, opts ...PageOption) iter.Seq2[*SharedFileSystemProvider, error]
 	NewListSharedFileSystemProvidersParams() *ListSharedFileSystemProvidersParams
 	ListSharedFileSystems(p *ListSharedFileSystemsParams) (*ListSharedFileSystemsResponse, error)
+	ListSharedFileSystemsWithContext(ctx context.Context, p *ListSharedFileSystemsParams) (*ListSharedFileSystemsResponse, error)
+	ListSharedFileSystemsAll(p *ListSharedFileSystemsParams, opts ...PageOption) ([]*SharedFileSystem, error)
+	ListSharedFileSystemsAllWithContext(ctx context.Context, p *ListSharedFileSystemsParams, opts ...PageOption) ([]*SharedFileSystem, error)
+	ListSharedFileSystemsIter(p *ListSharedFileSystemsParams, opts ...PageOption) iter.Seq2[*SharedFileSystem, error]
+	ListSharedFileSystemsIterWithContext(ctx context.Context, p *ListSharedFileSystemsParams, opts ...PageOption) iter.Seq2[*SharedFileSystem, error]
 	NewListSharedFileSystemsParams() *ListSharedFileSystemsParams
 	GetSharedFileSystemID(name string, opts ...OptionFunc) (string, int, error)
+	GetSharedFileSystemIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetSharedFileSystemByName(name string, opts ...OptionFunc) (*SharedFileSystem, int, error)
+	GetSharedFileSystemByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SharedFileSystem, int, error)
 	GetSharedFileSystemByID(id string, opts ...OptionFunc) (*SharedFileSystem, int, error)
+	GetSharedFileSystemByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SharedFileSystem, int, error)
 	RecoverSharedFileSystem(p *RecoverSharedFileSystemParams) (*RecoverSharedFileSystemResponse, error)
+	RecoverSharedFileSystemWithContext(ctx context.Context, p *RecoverSharedFileSystemParams) (*RecoverSharedFileSystemResponse, error)
 	NewRecoverSharedFileSystemParams() *RecoverSharedFileSystemParams
 	RestartSharedFileSystem(p *RestartSharedFileSystemParams) (*RestartSharedFileSystemResponse, error)
+	RestartSharedFileSystemWithContext(ctx context.Context, p *RestartSharedFileSystemParams) (*RestartSharedFileSystemResponse, error)
+	RestartSharedFileSystemAsync(ctx context.Context, p *RestartSharedFileSystemParams) (*Job, error)
 	NewRestartSharedFileSystemParams(id string) *RestartSharedFileSystemParams
 	StartSharedFileSystem(p *StartSharedFileSystemParams) (*StartSharedFileSystemResponse, error)
+	StartSharedFileSystemWithContext(ctx context.Context, p *StartSharedFileSystemParams) (*StartSharedFileSystemResponse, error)
+	StartSharedFileSystemAsync(ctx context.Context, p *StartSharedFileSystemParams) (*Job, error)
 	NewStartSharedFileSystemParams(id string) *StartSharedFileSystemParams
 	StopSharedFileSystem(p *StopSharedFileSystemParams) (*StopSharedFileSystemResponse, error)
+	StopSharedFileSystemWithContext(ctx context.Context, p *StopSharedFileSystemParams) (*StopSharedFileSystemResponse, error)
+	StopSharedFileSystemAsync(ctx context.Context, p *StopSharedFileSystemParams) (*Job, error)
 	NewStopSharedFileSystemParams(id string) *StopSharedFileSystemParams
 	UpdateSharedFileSystem(p *UpdateSharedFileSystemParams) (*UpdateSharedFileSystemResponse, error)
+	UpdateSharedFileSystemWithContext(ctx context.Context, p *UpdateSharedFileSystemParams) (*UpdateSharedFileSystemResponse, error)
 	NewUpdateSharedFileSystemParams(id string) *UpdateSharedFileSystemParams
 }
 
@@ -87,6 +119,48 @@ func (p *ChangeSharedFileSystemDiskOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeSharedFileSystemDiskOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeSharedFileSystemDiskOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeSharedFileSystemDiskOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeSharedFileSystemDiskOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeSharedFileSystemDiskOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"diskofferingid": 255, "id": 255},
+	}.validate("changeSharedFileSystemDiskOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeSharedFileSystemDiskOffering API, before the request is signed
+func (p *ChangeSharedFileSystemDiskOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeSharedFileSystemDiskOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeSharedFileSystemDiskOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeSharedFileSystemDiskOffering", b, &p.p, map[string]paramDecoder{
+		"diskofferingid": decodeParam[string],
+		"id":             decodeParam[string],
+		"maxiops":        decodeParam[int64],
+		"miniops":        decodeParam[int64],
+		"size":           decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeSharedFileSystemDiskOfferingParams) Clone() *ChangeSharedFileSystemDiskOfferingParams {
+	return &ChangeSharedFileSystemDiskOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeSharedFileSystemDiskOfferingParams) SetDiskofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -203,32 +277,39 @@ func (s *SharedFileSystemService) NewChangeSharedFileSystemDiskOfferingParams(id
 
 // Change Disk offering of a Shared FileSystem
 func (s *SharedFileSystemService) ChangeSharedFileSystemDiskOffering(p *ChangeSharedFileSystemDiskOfferingParams) (*ChangeSharedFileSystemDiskOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("changeSharedFileSystemDiskOffering", p.toURLValues())
+	return s.ChangeSharedFileSystemDiskOfferingWithContext(context.Background(), p)
+}
+
+// ChangeSharedFileSystemDiskOfferingWithContext is like ChangeSharedFileSystemDiskOffering, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ChangeSharedFileSystemDiskOfferingWithContext(ctx context.Context, p *ChangeSharedFileSystemDiskOfferingParams) (*ChangeSharedFileSystemDiskOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "changeSharedFileSystemDiskOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeSharedFileSystemDiskOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeSharedFileSystemDiskOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "changeSharedFileSystemDiskOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("changeSharedFileSystemDiskOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -236,51 +317,76 @@ func (s *SharedFileSystemService) ChangeSharedFileSystemDiskOffering(p *ChangeSh
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ChangeSharedFileSystemDiskOffering async job into r
+func (r *ChangeSharedFileSystemDiskOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ChangeSharedFileSystemDiskOfferingAsync starts the async job of ChangeSharedFileSystemDiskOffering without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) ChangeSharedFileSystemDiskOfferingAsync(ctx context.Context, p *ChangeSharedFileSystemDiskOfferingParams) (*Job, error) {
+	r, err := s.ChangeSharedFileSystemDiskOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("changeSharedFileSystemDiskOffering", r.JobID), nil
+}
+
 type ChangeSharedFileSystemDiskOfferingResponse struct {
-	Account                 string `json:"account"`
-	Description             string `json:"description"`
-	Diskioread              int64  `json:"diskioread"`
-	Diskiowrite             int64  `json:"diskiowrite"`
-	Diskkbsread             int64  `json:"diskkbsread"`
-	Diskkbswrite            int64  `json:"diskkbswrite"`
-	Diskofferingdisplaytext string `json:"diskofferingdisplaytext"`
-	Diskofferingid          string `json:"diskofferingid"`
-	Diskofferingname        string `json:"diskofferingname"`
-	Domain                  string `json:"domain"`
-	Domainid                string `json:"domainid"`
-	Domainpath              string `json:"domainpath"`
-	Filesystem              string `json:"filesystem"`
-	Hasannotations          bool   `json:"hasannotations"`
-	Id                      string `json:"id"`
-	Iscustomdiskoffering    bool   `json:"iscustomdiskoffering"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Name                    string `json:"name"`
-	Networkid               string `json:"networkid"`
-	Networkname             string `json:"networkname"`
-	Nic                     []Nic  `json:"nic"`
-	Path                    string `json:"path"`
-	Physicalsize            int64  `json:"physicalsize"`
-	Project                 string `json:"project"`
-	Projectid               string `json:"projectid"`
-	Provider                string `json:"provider"`
-	Provisioningtype        string `json:"provisioningtype"`
-	Serviceofferingid       string `json:"serviceofferingid"`
-	Serviceofferingname     string `json:"serviceofferingname"`
-	Size                    int64  `json:"size"`
-	Sizegb                  string `json:"sizegb"`
-	State                   string `json:"state"`
-	Storage                 string `json:"storage"`
-	Storageid               string `json:"storageid"`
-	Tags                    []Tags `json:"tags"`
-	Utilization             string `json:"utilization"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Virtualsize             int64  `json:"virtualsize"`
-	Vmstate                 string `json:"vmstate"`
-	Volumeid                string `json:"volumeid"`
-	Volumename              string `json:"volumename"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Description             string                     `json:"description"`
+	Diskioread              FlexInt64                  `json:"diskioread"`
+	Diskiowrite             FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread             FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite            FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid          string                     `json:"diskofferingid"`
+	Diskofferingname        string                     `json:"diskofferingname"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Domainpath              string                     `json:"domainpath"`
+	Filesystem              string                     `json:"filesystem"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Iscustomdiskoffering    FlexBool                   `json:"iscustomdiskoffering"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Networkid               string                     `json:"networkid"`
+	Networkname             string                     `json:"networkname"`
+	Nic                     []Nic                      `json:"nic"`
+	Path                    string                     `json:"path"`
+	Physicalsize            FlexInt64                  `json:"physicalsize"`
+	Project                 string                     `json:"project"`
+	Projectid               string                     `json:"projectid"`
+	Provider                string                     `json:"provider"`
+	Provisioningtype        string                     `json:"provisioningtype"`
+	Serviceofferingid       string                     `json:"serviceofferingid"`
+	Serviceofferingname     string                     `json:"serviceofferingname"`
+	Size                    FlexInt64                  `json:"size"`
+	Sizegb                  string                     `json:"sizegb"`
+	State                   string                     `json:"state"`
+	Storage                 string                     `json:"storage"`
+	Storageid               string                     `json:"storageid"`
+	Tags                    []Tags                     `json:"tags"`
+	Utilization             string                     `json:"utilization"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmstate                 string                     `json:"vmstate"`
+	Volumeid                string                     `json:"volumeid"`
+	Volumename              string                     `json:"volumename"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeSharedFileSystemDiskOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeSharedFileSystemDiskOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeSharedFileSystemServiceOfferingParams struct {
@@ -301,6 +407,45 @@ func (p *ChangeSharedFileSystemServiceOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeSharedFileSystemServiceOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeSharedFileSystemServiceOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeSharedFileSystemServiceOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeSharedFileSystemServiceOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeSharedFileSystemServiceOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "serviceofferingid"},
+		lengths:  map[string]int{"id": 255, "serviceofferingid": 255},
+	}.validate("changeSharedFileSystemServiceOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeSharedFileSystemServiceOffering API, before the request is signed
+func (p *ChangeSharedFileSystemServiceOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeSharedFileSystemServiceOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeSharedFileSystemServiceOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeSharedFileSystemServiceOffering", b, &p.p, map[string]paramDecoder{
+		"id":                decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeSharedFileSystemServiceOfferingParams) Clone() *ChangeSharedFileSystemServiceOfferingParams {
+	return &ChangeSharedFileSystemServiceOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeSharedFileSystemServiceOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -355,32 +500,39 @@ func (s *SharedFileSystemService) NewChangeSharedFileSystemServiceOfferingParams
 
 // Change Service offering of a Shared FileSystem
 func (s *SharedFileSystemService) ChangeSharedFileSystemServiceOffering(p *ChangeSharedFileSystemServiceOfferingParams) (*ChangeSharedFileSystemServiceOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("changeSharedFileSystemServiceOffering", p.toURLValues())
+	return s.ChangeSharedFileSystemServiceOfferingWithContext(context.Background(), p)
+}
+
+// ChangeSharedFileSystemServiceOfferingWithContext is like ChangeSharedFileSystemServiceOffering, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ChangeSharedFileSystemServiceOfferingWithContext(ctx context.Context, p *ChangeSharedFileSystemServiceOfferingParams) (*ChangeSharedFileSystemServiceOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "changeSharedFileSystemServiceOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeSharedFileSystemServiceOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeSharedFileSystemServiceOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "changeSharedFileSystemServiceOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("changeSharedFileSystemServiceOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -388,51 +540,76 @@ func (s *SharedFileSystemService) ChangeSharedFileSystemServiceOffering(p *Chang
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ChangeSharedFileSystemServiceOffering async job into r
+func (r *ChangeSharedFileSystemServiceOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ChangeSharedFileSystemServiceOfferingAsync starts the async job of ChangeSharedFileSystemServiceOffering without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) ChangeSharedFileSystemServiceOfferingAsync(ctx context.Context, p *ChangeSharedFileSystemServiceOfferingParams) (*Job, error) {
+	r, err := s.ChangeSharedFileSystemServiceOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("changeSharedFileSystemServiceOffering", r.JobID), nil
+}
+
 type ChangeSharedFileSystemServiceOfferingResponse struct {
-	Account                 string `json:"account"`
-	Description             string `json:"description"`
-	Diskioread              int64  `json:"diskioread"`
-	Diskiowrite             int64  `json:"diskiowrite"`
-	Diskkbsread             int64  `json:"diskkbsread"`
-	Diskkbswrite            int64  `json:"diskkbswrite"`
-	Diskofferingdisplaytext string `json:"diskofferingdisplaytext"`
-	Diskofferingid          string `json:"diskofferingid"`
-	Diskofferingname        string `json:"diskofferingname"`
-	Domain                  string `json:"domain"`
-	Domainid                string `json:"domainid"`
-	Domainpath              string `json:"domainpath"`
-	Filesystem              string `json:"filesystem"`
-	Hasannotations          bool   `json:"hasannotations"`
-	Id                      string `json:"id"`
-	Iscustomdiskoffering    bool   `json:"iscustomdiskoffering"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Name                    string `json:"name"`
-	Networkid               string `json:"networkid"`
-	Networkname             string `json:"networkname"`
-	Nic                     []Nic  `json:"nic"`
-	Path                    string `json:"path"`
-	Physicalsize            int64  `json:"physicalsize"`
-	Project                 string `json:"project"`
-	Projectid               string `json:"projectid"`
-	Provider                string `json:"provider"`
-	Provisioningtype        string `json:"provisioningtype"`
-	Serviceofferingid       string `json:"serviceofferingid"`
-	Serviceofferingname     string `json:"serviceofferingname"`
-	Size                    int64  `json:"size"`
-	Sizegb                  string `json:"sizegb"`
-	State                   string `json:"state"`
-	Storage                 string `json:"storage"`
-	Storageid               string `json:"storageid"`
-	Tags                    []Tags `json:"tags"`
-	Utilization             string `json:"utilization"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Virtualsize             int64  `json:"virtualsize"`
-	Vmstate                 string `json:"vmstate"`
-	Volumeid                string `json:"volumeid"`
-	Volumename              string `json:"volumename"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Description             string                     `json:"description"`
+	Diskioread              FlexInt64                  `json:"diskioread"`
+	Diskiowrite             FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread             FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite            FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid          string                     `json:"diskofferingid"`
+	Diskofferingname        string                     `json:"diskofferingname"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Domainpath              string                     `json:"domainpath"`
+	Filesystem              string                     `json:"filesystem"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Iscustomdiskoffering    FlexBool                   `json:"iscustomdiskoffering"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Networkid               string                     `json:"networkid"`
+	Networkname             string                     `json:"networkname"`
+	Nic                     []Nic                      `json:"nic"`
+	Path                    string                     `json:"path"`
+	Physicalsize            FlexInt64                  `json:"physicalsize"`
+	Project                 string                     `json:"project"`
+	Projectid               string                     `json:"projectid"`
+	Provider                string                     `json:"provider"`
+	Provisioningtype        string                     `json:"provisioningtype"`
+	Serviceofferingid       string                     `json:"serviceofferingid"`
+	Serviceofferingname     string                     `json:"serviceofferingname"`
+	Size                    FlexInt64                  `json:"size"`
+	Sizegb                  string                     `json:"sizegb"`
+	State                   string                     `json:"state"`
+	Storage                 string                     `json:"storage"`
+	Storageid               string                     `json:"storageid"`
+	Tags                    []Tags                     `json:"tags"`
+	Utilization             string                     `json:"utilization"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmstate                 string                     `json:"vmstate"`
+	Volumeid                string                     `json:"volumeid"`
+	Volumename              string                     `json:"volumename"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeSharedFileSystemServiceOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeSharedFileSystemServiceOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSharedFileSystemParams struct {
@@ -492,6 +669,57 @@ func (p *CreateSharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSharedFileSystemParams) Validate() error {
+	return paramRules{
+		required: []string{"diskofferingid", "filesystem", "name", "networkid", "serviceofferingid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "description": 4096, "diskofferingid": 255, "domainid": 255, "filesystem": 255, "name": 255, "networkid": 255, "projectid": 255, "provider": 255, "serviceofferingid": 255, "zoneid": 255},
+	}.validate("createSharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSharedFileSystem API, before the request is signed
+func (p *CreateSharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"description":       decodeParam[string],
+		"diskofferingid":    decodeParam[string],
+		"domainid":          decodeParam[string],
+		"filesystem":        decodeParam[string],
+		"maxiops":           decodeParam[int64],
+		"miniops":           decodeParam[int64],
+		"name":              decodeParam[string],
+		"networkid":         decodeParam[string],
+		"projectid":         decodeParam[string],
+		"provider":          decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+		"size":              decodeParam[int64],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSharedFileSystemParams) Clone() *CreateSharedFileSystemParams {
+	return &CreateSharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSharedFileSystemParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -802,32 +1030,39 @@ func (s *SharedFileSystemService) NewCreateSharedFileSystemParams(diskofferingid
 
 // Create a new Shared File System of specified size and disk offering, attached to the given network
 func (s *SharedFileSystemService) CreateSharedFileSystem(p *CreateSharedFileSystemParams) (*CreateSharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("createSharedFileSystem", p.toURLValues())
+	return s.CreateSharedFileSystemWithContext(context.Background(), p)
+}
+
+// CreateSharedFileSystemWithContext is like CreateSharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) CreateSharedFileSystemWithContext(ctx context.Context, p *CreateSharedFileSystemParams) (*CreateSharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createSharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createSharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createSharedFileSystem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createSharedFileSystem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -835,51 +1070,76 @@ func (s *SharedFileSystemService) CreateSharedFileSystem(p *CreateSharedFileSyst
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateSharedFileSystem async job into r
+func (r *CreateSharedFileSystemResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateSharedFileSystemAsync starts the async job of CreateSharedFileSystem without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) CreateSharedFileSystemAsync(ctx context.Context, p *CreateSharedFileSystemParams) (*Job, error) {
+	r, err := s.CreateSharedFileSystemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createSharedFileSystem", r.JobID), nil
+}
+
 type CreateSharedFileSystemResponse struct {
-	Account                 string `json:"account"`
-	Description             string `json:"description"`
-	Diskioread              int64  `json:"diskioread"`
-	Diskiowrite             int64  `json:"diskiowrite"`
-	Diskkbsread             int64  `json:"diskkbsread"`
-	Diskkbswrite            int64  `json:"diskkbswrite"`
-	Diskofferingdisplaytext string `json:"diskofferingdisplaytext"`
-	Diskofferingid          string `json:"diskofferingid"`
-	Diskofferingname        string `json:"diskofferingname"`
-	Domain                  string `json:"domain"`
-	Domainid                string `json:"domainid"`
-	Domainpath              string `json:"domainpath"`
-	Filesystem              string `json:"filesystem"`
-	Hasannotations          bool   `json:"hasannotations"`
-	Id                      string `json:"id"`
-	Iscustomdiskoffering    bool   `json:"iscustomdiskoffering"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Name                    string `json:"name"`
-	Networkid               string `json:"networkid"`
-	Networkname             string `json:"networkname"`
-	Nic                     []Nic  `json:"nic"`
-	Path                    string `json:"path"`
-	Physicalsize            int64  `json:"physicalsize"`
-	Project                 string `json:"project"`
-	Projectid               string `json:"projectid"`
-	Provider                string `json:"provider"`
-	Provisioningtype        string `json:"provisioningtype"`
-	Serviceofferingid       string `json:"serviceofferingid"`
-	Serviceofferingname     string `json:"serviceofferingname"`
-	Size                    int64  `json:"size"`
-	Sizegb                  string `json:"sizegb"`
-	State                   string `json:"state"`
-	Storage                 string `json:"storage"`
-	Storageid               string `json:"storageid"`
-	Tags                    []Tags `json:"tags"`
-	Utilization             string `json:"utilization"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Virtualsize             int64  `json:"virtualsize"`
-	Vmstate                 string `json:"vmstate"`
-	Volumeid                string `json:"volumeid"`
-	Volumename              string `json:"volumename"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Description             string                     `json:"description"`
+	Diskioread              FlexInt64                  `json:"diskioread"`
+	Diskiowrite             FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread             FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite            FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid          string                     `json:"diskofferingid"`
+	Diskofferingname        string                     `json:"diskofferingname"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Domainpath              string                     `json:"domainpath"`
+	Filesystem              string                     `json:"filesystem"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Iscustomdiskoffering    FlexBool                   `json:"iscustomdiskoffering"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Networkid               string                     `json:"networkid"`
+	Networkname             string                     `json:"networkname"`
+	Nic                     []Nic                      `json:"nic"`
+	Path                    string                     `json:"path"`
+	Physicalsize            FlexInt64                  `json:"physicalsize"`
+	Project                 string                     `json:"project"`
+	Projectid               string                     `json:"projectid"`
+	Provider                string                     `json:"provider"`
+	Provisioningtype        string                     `json:"provisioningtype"`
+	Serviceofferingid       string                     `json:"serviceofferingid"`
+	Serviceofferingname     string                     `json:"serviceofferingname"`
+	Size                    FlexInt64                  `json:"size"`
+	Sizegb                  string                     `json:"sizegb"`
+	State                   string                     `json:"state"`
+	Storage                 string                     `json:"storage"`
+	Storageid               string                     `json:"storageid"`
+	Tags                    []Tags                     `json:"tags"`
+	Utilization             string                     `json:"utilization"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmstate                 string                     `json:"vmstate"`
+	Volumeid                string                     `json:"volumeid"`
+	Volumename              string                     `json:"volumename"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateSharedFileSystemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroySharedFileSystemParams struct {
@@ -905,6 +1165,45 @@ func (p *DestroySharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the destroySharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DestroySharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("destroySharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the destroySharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DestroySharedFileSystemParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("destroySharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the destroySharedFileSystem API, before the request is signed
+func (p *DestroySharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DestroySharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DestroySharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("destroySharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"expunge": decodeParam[bool],
+		"forced":  decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DestroySharedFileSystemParams) Clone() *DestroySharedFileSystemParams {
+	return &DestroySharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *DestroySharedFileSystemParams) SetExpunge(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -978,27 +1277,39 @@ func (s *SharedFileSystemService) NewDestroySharedFileSystemParams() *DestroySha
 
 // Destroy a Shared FileSystem by id
 func (s *SharedFileSystemService) DestroySharedFileSystem(p *DestroySharedFileSystemParams) (*DestroySharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("destroySharedFileSystem", p.toURLValues())
+	return s.DestroySharedFileSystemWithContext(context.Background(), p)
+}
+
+// DestroySharedFileSystemWithContext is like DestroySharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) DestroySharedFileSystemWithContext(ctx context.Context, p *DestroySharedFileSystemParams) (*DestroySharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "destroySharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DestroySharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("destroySharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "destroySharedFileSystem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("destroySharedFileSystem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1006,11 +1317,31 @@ func (s *SharedFileSystemService) DestroySharedFileSystem(p *DestroySharedFileSy
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DestroySharedFileSystem async job into r
+func (r *DestroySharedFileSystemResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DestroySharedFileSystemAsync starts the async job of DestroySharedFileSystem without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) DestroySharedFileSystemAsync(ctx context.Context, p *DestroySharedFileSystemParams) (*Job, error) {
+	r, err := s.DestroySharedFileSystemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("destroySharedFileSystem", r.JobID), nil
+}
+
 type DestroySharedFileSystemResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DestroySharedFileSystemResponse) UnmarshalJSON(b []byte) error {
+	type alias DestroySharedFileSystemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExpungeSharedFileSystemParams struct {
@@ -1028,6 +1359,43 @@ func (p *ExpungeSharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the expungeSharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExpungeSharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("expungeSharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the expungeSharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExpungeSharedFileSystemParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("expungeSharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the expungeSharedFileSystem API, before the request is signed
+func (p *ExpungeSharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExpungeSharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExpungeSharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("expungeSharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExpungeSharedFileSystemParams) Clone() *ExpungeSharedFileSystemParams {
+	return &ExpungeSharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *ExpungeSharedFileSystemParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1059,27 +1427,39 @@ func (s *SharedFileSystemService) NewExpungeSharedFileSystemParams() *ExpungeSha
 
 // Expunge a Shared FileSystem by id
 func (s *SharedFileSystemService) ExpungeSharedFileSystem(p *ExpungeSharedFileSystemParams) (*ExpungeSharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("expungeSharedFileSystem", p.toURLValues())
+	return s.ExpungeSharedFileSystemWithContext(context.Background(), p)
+}
+
+// ExpungeSharedFileSystemWithContext is like ExpungeSharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ExpungeSharedFileSystemWithContext(ctx context.Context, p *ExpungeSharedFileSystemParams) (*ExpungeSharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "expungeSharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExpungeSharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("expungeSharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "expungeSharedFileSystem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("expungeSharedFileSystem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1087,11 +1467,31 @@ func (s *SharedFileSystemService) ExpungeSharedFileSystem(p *ExpungeSharedFileSy
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ExpungeSharedFileSystem async job into r
+func (r *ExpungeSharedFileSystemResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ExpungeSharedFileSystemAsync starts the async job of ExpungeSharedFileSystem without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) ExpungeSharedFileSystemAsync(ctx context.Context, p *ExpungeSharedFileSystemParams) (*Job, error) {
+	r, err := s.ExpungeSharedFileSystemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("expungeSharedFileSystem", r.JobID), nil
+}
+
 type ExpungeSharedFileSystemResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExpungeSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
+	type alias ExpungeSharedFileSystemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSharedFileSystemProvidersParams struct {
@@ -1117,6 +1517,45 @@ func (p *ListSharedFileSystemProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSharedFileSystemProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSharedFileSystemProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSharedFileSystemProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSharedFileSystemProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSharedFileSystemProvidersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listSharedFileSystemProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSharedFileSystemProviders API, before the request is signed
+func (p *ListSharedFileSystemProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSharedFileSystemProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSharedFileSystemProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSharedFileSystemProviders", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSharedFileSystemProvidersParams) Clone() *ListSharedFileSystemProvidersParams {
+	return &ListSharedFileSystemProvidersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSharedFileSystemProvidersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1190,28 +1629,71 @@ func (s *SharedFileSystemService) NewListSharedFileSystemProvidersParams() *List
 
 // Lists all available shared filesystem providers.
 func (s *SharedFileSystemService) ListSharedFileSystemProviders(p *ListSharedFileSystemProvidersParams) (*ListSharedFileSystemProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listSharedFileSystemProviders", p.toURLValues())
+	return s.ListSharedFileSystemProvidersWithContext(context.Background(), p)
+}
+
+// ListSharedFileSystemProvidersWithContext is like ListSharedFileSystemProviders, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ListSharedFileSystemProvidersWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams) (*ListSharedFileSystemProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSharedFileSystemProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSharedFileSystemProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSharedFileSystemProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSharedFileSystemProvidersAll returns all SharedFileSystemProviders matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SharedFileSystemService) ListSharedFileSystemProvidersAll(p *ListSharedFileSystemProvidersParams, opts ...PageOption) ([]*SharedFileSystemProvider, error) {
+	return s.ListSharedFileSystemProvidersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSharedFileSystemProvidersAllWithContext is like ListSharedFileSystemProvidersAll, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ListSharedFileSystemProvidersAllWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams, opts ...PageOption) ([]*SharedFileSystemProvider, error) {
+	return collectPages(s.ListSharedFileSystemProvidersIterWithContext(ctx, p, opts...))
+}
+
+// ListSharedFileSystemProvidersIter returns an iterator over all SharedFileSystemProviders matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SharedFileSystemService) ListSharedFileSystemProvidersIter(p *ListSharedFileSystemProvidersParams, opts ...PageOption) iter.Seq2[*SharedFileSystemProvider, error] {
+	return s.ListSharedFileSystemProvidersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSharedFileSystemProvidersIterWithContext is like ListSharedFileSystemProvidersIter, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ListSharedFileSystemProvidersIterWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams, opts ...PageOption) iter.Seq2[*SharedFileSystemProvider, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SharedFileSystemProvider, int, error) {
+		l, err := s.ListSharedFileSystemProvidersWithContext(ctx, &ListSharedFileSystemProvidersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SharedFileSystemProviders, l.Count, nil
+	}, opts...)
+}
+
 type ListSharedFileSystemProvidersResponse struct {
 	Count                     int                         `json:"count"`
 	SharedFileSystemProviders []*SharedFileSystemProvider `json:"sharedfilesystemprovider"`
 }
 
 type SharedFileSystemProvider struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SharedFileSystemProvider) UnmarshalJSON(b []byte) error {
+	type alias SharedFileSystemProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSharedFileSystemsParams struct {
@@ -1283,6 +1765,58 @@ func (p *ListSharedFileSystemsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSharedFileSystems API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSharedFileSystemsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSharedFileSystems", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSharedFileSystems API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSharedFileSystemsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "diskofferingid": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "networkid": 255, "projectid": 255, "serviceofferingid": 255, "zoneid": 255},
+	}.validate("listSharedFileSystems", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSharedFileSystems API, before the request is signed
+func (p *ListSharedFileSystemsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSharedFileSystemsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSharedFileSystemsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSharedFileSystems", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"diskofferingid":            decodeParam[string],
+		"domainid":                  decodeParam[string],
+		"id":                        decodeParam[string],
+		"isrecursive":               decodeParam[bool],
+		"keyword":                   decodeParam[string],
+		"listall":                   decodeParam[bool],
+		"name":                      decodeParam[string],
+		"networkid":                 decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"projectid":                 decodeParam[string],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"serviceofferingid":         decodeParam[string],
+		"tags":                      decodeParam[map[string]string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSharedFileSystemsParams) Clone() *ListSharedFileSystemsParams {
+	return &ListSharedFileSystemsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSharedFileSystemsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1629,6 +2163,11 @@ func (s *SharedFileSystemService) NewListSharedFileSystemsParams() *ListSharedFi
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SharedFileSystemService) GetSharedFileSystemID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSharedFileSystemIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSharedFileSystemIDWithContext is like GetSharedFileSystemID, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) GetSharedFileSystemIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSharedFileSystemsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1640,7 +2179,7 @@ func (s *SharedFileSystemService) GetSharedFileSystemID(name string, opts ...Opt
 		}
 	}
 
-	l, err := s.ListSharedFileSystems(p)
+	l, err := s.ListSharedFileSystemsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1665,12 +2204,17 @@ func (s *SharedFileSystemService) GetSharedFileSystemID(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SharedFileSystemService) GetSharedFileSystemByName(name string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
-	id, count, err := s.GetSharedFileSystemID(name, opts...)
+	return s.GetSharedFileSystemByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSharedFileSystemByNameWithContext is like GetSharedFileSystemByName, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) GetSharedFileSystemByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
+	id, count, err := s.GetSharedFileSystemIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSharedFileSystemByID(id, opts...)
+	r, count, err := s.GetSharedFileSystemByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1679,6 +2223,11 @@ func (s *SharedFileSystemService) GetSharedFileSystemByName(name string, opts ..
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SharedFileSystemService) GetSharedFileSystemByID(id string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
+	return s.GetSharedFileSystemByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSharedFileSystemByIDWithContext is like GetSharedFileSystemByID, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) GetSharedFileSystemByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
 	p := &ListSharedFileSystemsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1690,11 +2239,10 @@ func (s *SharedFileSystemService) GetSharedFileSystemByID(id string, opts ...Opt
 		}
 	}
 
-	l, err := s.ListSharedFileSystems(p)
+	l, err := s.ListSharedFileSystemsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1712,69 +2260,112 @@ func (s *SharedFileSystemService) GetSharedFileSystemByID(id string, opts ...Opt
 
 // List Shared FileSystems
 func (s *SharedFileSystemService) ListSharedFileSystems(p *ListSharedFileSystemsParams) (*ListSharedFileSystemsResponse, error) {
-	resp, err := s.cs.newRequest("listSharedFileSystems", p.toURLValues())
+	return s.ListSharedFileSystemsWithContext(context.Background(), p)
+}
+
+// ListSharedFileSystemsWithContext is like ListSharedFileSystems, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ListSharedFileSystemsWithContext(ctx context.Context, p *ListSharedFileSystemsParams) (*ListSharedFileSystemsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSharedFileSystems", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSharedFileSystemsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSharedFileSystems", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSharedFileSystemsAll returns all SharedFileSystems matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SharedFileSystemService) ListSharedFileSystemsAll(p *ListSharedFileSystemsParams, opts ...PageOption) ([]*SharedFileSystem, error) {
+	return s.ListSharedFileSystemsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSharedFileSystemsAllWithContext is like ListSharedFileSystemsAll, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ListSharedFileSystemsAllWithContext(ctx context.Context, p *ListSharedFileSystemsParams, opts ...PageOption) ([]*SharedFileSystem, error) {
+	return collectPages(s.ListSharedFileSystemsIterWithContext(ctx, p, opts...))
+}
+
+// ListSharedFileSystemsIter returns an iterator over all SharedFileSystems matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SharedFileSystemService) ListSharedFileSystemsIter(p *ListSharedFileSystemsParams, opts ...PageOption) iter.Seq2[*SharedFileSystem, error] {
+	return s.ListSharedFileSystemsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSharedFileSystemsIterWithContext is like ListSharedFileSystemsIter, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) ListSharedFileSystemsIterWithContext(ctx context.Context, p *ListSharedFileSystemsParams, opts ...PageOption) iter.Seq2[*SharedFileSystem, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SharedFileSystem, int, error) {
+		l, err := s.ListSharedFileSystemsWithContext(ctx, &ListSharedFileSystemsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SharedFileSystems, l.Count, nil
+	}, opts...)
+}
+
 type ListSharedFileSystemsResponse struct {
 	Count             int                 `json:"count"`
 	SharedFileSystems []*SharedFileSystem `json:"sharedfilesystem"`
 }
 
 type SharedFileSystem struct {
-	Account                 string `json:"account"`
-	Description             string `json:"description"`
-	Diskioread              int64  `json:"diskioread"`
-	Diskiowrite             int64  `json:"diskiowrite"`
-	Diskkbsread             int64  `json:"diskkbsread"`
-	Diskkbswrite            int64  `json:"diskkbswrite"`
-	Diskofferingdisplaytext string `json:"diskofferingdisplaytext"`
-	Diskofferingid          string `json:"diskofferingid"`
-	Diskofferingname        string `json:"diskofferingname"`
-	Domain                  string `json:"domain"`
-	Domainid                string `json:"domainid"`
-	Domainpath              string `json:"domainpath"`
-	Filesystem              string `json:"filesystem"`
-	Hasannotations          bool   `json:"hasannotations"`
-	Id                      string `json:"id"`
-	Iscustomdiskoffering    bool   `json:"iscustomdiskoffering"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Name                    string `json:"name"`
-	Networkid               string `json:"networkid"`
-	Networkname             string `json:"networkname"`
-	Nic                     []Nic  `json:"nic"`
-	Path                    string `json:"path"`
-	Physicalsize            int64  `json:"physicalsize"`
-	Project                 string `json:"project"`
-	Projectid               string `json:"projectid"`
-	Provider                string `json:"provider"`
-	Provisioningtype        string `json:"provisioningtype"`
-	Serviceofferingid       string `json:"serviceofferingid"`
-	Serviceofferingname     string `json:"serviceofferingname"`
-	Size                    int64  `json:"size"`
-	Sizegb                  string `json:"sizegb"`
-	State                   string `json:"state"`
-	Storage                 string `json:"storage"`
-	Storageid               string `json:"storageid"`
-	Tags                    []Tags `json:"tags"`
-	Utilization             string `json:"utilization"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Virtualsize             int64  `json:"virtualsize"`
-	Vmstate                 string `json:"vmstate"`
-	Volumeid                string `json:"volumeid"`
-	Volumename              string `json:"volumename"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Description             string                     `json:"description"`
+	Diskioread              FlexInt64                  `json:"diskioread"`
+	Diskiowrite             FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread             FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite            FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid          string                     `json:"diskofferingid"`
+	Diskofferingname        string                     `json:"diskofferingname"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Domainpath              string                     `json:"domainpath"`
+	Filesystem              string                     `json:"filesystem"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Iscustomdiskoffering    FlexBool                   `json:"iscustomdiskoffering"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Networkid               string                     `json:"networkid"`
+	Networkname             string                     `json:"networkname"`
+	Nic                     []Nic                      `json:"nic"`
+	Path                    string                     `json:"path"`
+	Physicalsize            FlexInt64                  `json:"physicalsize"`
+	Project                 string                     `json:"project"`
+	Projectid               string                     `json:"projectid"`
+	Provider                string                     `json:"provider"`
+	Provisioningtype        string                     `json:"provisioningtype"`
+	Serviceofferingid       string                     `json:"serviceofferingid"`
+	Serviceofferingname     string                     `json:"serviceofferingname"`
+	Size                    FlexInt64                  `json:"size"`
+	Sizegb                  string                     `json:"sizegb"`
+	State                   string                     `json:"state"`
+	Storage                 string                     `json:"storage"`
+	Storageid               string                     `json:"storageid"`
+	Tags                    []Tags                     `json:"tags"`
+	Utilization             string                     `json:"utilization"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmstate                 string                     `json:"vmstate"`
+	Volumeid                string                     `json:"volumeid"`
+	Volumename              string                     `json:"volumename"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SharedFileSystem) UnmarshalJSON(b []byte) error {
+	type alias SharedFileSystem
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RecoverSharedFileSystemParams struct {
@@ -1792,6 +2383,43 @@ func (p *RecoverSharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the recoverSharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RecoverSharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("recoverSharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the recoverSharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RecoverSharedFileSystemParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("recoverSharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the recoverSharedFileSystem API, before the request is signed
+func (p *RecoverSharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RecoverSharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RecoverSharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("recoverSharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RecoverSharedFileSystemParams) Clone() *RecoverSharedFileSystemParams {
+	return &RecoverSharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *RecoverSharedFileSystemParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1823,13 +2451,22 @@ func (s *SharedFileSystemService) NewRecoverSharedFileSystemParams() *RecoverSha
 
 // Recover a Shared FileSystem by id
 func (s *SharedFileSystemService) RecoverSharedFileSystem(p *RecoverSharedFileSystemParams) (*RecoverSharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("recoverSharedFileSystem", p.toURLValues())
+	return s.RecoverSharedFileSystemWithContext(context.Background(), p)
+}
+
+// RecoverSharedFileSystemWithContext is like RecoverSharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) RecoverSharedFileSystemWithContext(ctx context.Context, p *RecoverSharedFileSystemParams) (*RecoverSharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "recoverSharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RecoverSharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("recoverSharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1837,10 +2474,11 @@ func (s *SharedFileSystemService) RecoverSharedFileSystem(p *RecoverSharedFileSy
 }
 
 type RecoverSharedFileSystemResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RecoverSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
@@ -1867,7 +2505,7 @@ func (r *RecoverSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RecoverSharedFileSystemResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestartSharedFileSystemParams struct {
@@ -1889,6 +2527,45 @@ func (p *RestartSharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the restartSharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RestartSharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("restartSharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the restartSharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RestartSharedFileSystemParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("restartSharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the restartSharedFileSystem API, before the request is signed
+func (p *RestartSharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RestartSharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RestartSharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("restartSharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"cleanup": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RestartSharedFileSystemParams) Clone() *RestartSharedFileSystemParams {
+	return &RestartSharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *RestartSharedFileSystemParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1942,27 +2619,39 @@ func (s *SharedFileSystemService) NewRestartSharedFileSystemParams(id string) *R
 
 // Restart a Shared FileSystem
 func (s *SharedFileSystemService) RestartSharedFileSystem(p *RestartSharedFileSystemParams) (*RestartSharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("restartSharedFileSystem", p.toURLValues())
+	return s.RestartSharedFileSystemWithContext(context.Background(), p)
+}
+
+// RestartSharedFileSystemWithContext is like RestartSharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) RestartSharedFileSystemWithContext(ctx context.Context, p *RestartSharedFileSystemParams) (*RestartSharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "restartSharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RestartSharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("restartSharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "restartSharedFileSystem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("restartSharedFileSystem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1970,11 +2659,31 @@ func (s *SharedFileSystemService) RestartSharedFileSystem(p *RestartSharedFileSy
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RestartSharedFileSystem async job into r
+func (r *RestartSharedFileSystemResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RestartSharedFileSystemAsync starts the async job of RestartSharedFileSystem without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) RestartSharedFileSystemAsync(ctx context.Context, p *RestartSharedFileSystemParams) (*Job, error) {
+	r, err := s.RestartSharedFileSystemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("restartSharedFileSystem", r.JobID), nil
+}
+
 type RestartSharedFileSystemResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RestartSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
+	type alias RestartSharedFileSystemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartSharedFileSystemParams struct {
@@ -1992,6 +2701,44 @@ func (p *StartSharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the startSharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StartSharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("startSharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the startSharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StartSharedFileSystemParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("startSharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the startSharedFileSystem API, before the request is signed
+func (p *StartSharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StartSharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StartSharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("startSharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StartSharedFileSystemParams) Clone() *StartSharedFileSystemParams {
+	return &StartSharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *StartSharedFileSystemParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2024,32 +2771,39 @@ func (s *SharedFileSystemService) NewStartSharedFileSystemParams(id string) *Sta
 
 // Start a Shared FileSystem
 func (s *SharedFileSystemService) StartSharedFileSystem(p *StartSharedFileSystemParams) (*StartSharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("startSharedFileSystem", p.toURLValues())
+	return s.StartSharedFileSystemWithContext(context.Background(), p)
+}
+
+// StartSharedFileSystemWithContext is like StartSharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) StartSharedFileSystemWithContext(ctx context.Context, p *StartSharedFileSystemParams) (*StartSharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "startSharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StartSharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("startSharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "startSharedFileSystem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("startSharedFileSystem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2057,51 +2811,76 @@ func (s *SharedFileSystemService) StartSharedFileSystem(p *StartSharedFileSystem
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StartSharedFileSystem async job into r
+func (r *StartSharedFileSystemResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StartSharedFileSystemAsync starts the async job of StartSharedFileSystem without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) StartSharedFileSystemAsync(ctx context.Context, p *StartSharedFileSystemParams) (*Job, error) {
+	r, err := s.StartSharedFileSystemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("startSharedFileSystem", r.JobID), nil
+}
+
 type StartSharedFileSystemResponse struct {
-	Account                 string `json:"account"`
-	Description             string `json:"description"`
-	Diskioread              int64  `json:"diskioread"`
-	Diskiowrite             int64  `json:"diskiowrite"`
-	Diskkbsread             int64  `json:"diskkbsread"`
-	Diskkbswrite            int64  `json:"diskkbswrite"`
-	Diskofferingdisplaytext string `json:"diskofferingdisplaytext"`
-	Diskofferingid          string `json:"diskofferingid"`
-	Diskofferingname        string `json:"diskofferingname"`
-	Domain                  string `json:"domain"`
-	Domainid                string `json:"domainid"`
-	Domainpath              string `json:"domainpath"`
-	Filesystem              string `json:"filesystem"`
-	Hasannotations          bool   `json:"hasannotations"`
-	Id                      string `json:"id"`
-	Iscustomdiskoffering    bool   `json:"iscustomdiskoffering"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Name                    string `json:"name"`
-	Networkid               string `json:"networkid"`
-	Networkname             string `json:"networkname"`
-	Nic                     []Nic  `json:"nic"`
-	Path                    string `json:"path"`
-	Physicalsize            int64  `json:"physicalsize"`
-	Project                 string `json:"project"`
-	Projectid               string `json:"projectid"`
-	Provider                string `json:"provider"`
-	Provisioningtype        string `json:"provisioningtype"`
-	Serviceofferingid       string `json:"serviceofferingid"`
-	Serviceofferingname     string `json:"serviceofferingname"`
-	Size                    int64  `json:"size"`
-	Sizegb                  string `json:"sizegb"`
-	State                   string `json:"state"`
-	Storage                 string `json:"storage"`
-	Storageid               string `json:"storageid"`
-	Tags                    []Tags `json:"tags"`
-	Utilization             string `json:"utilization"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Virtualsize             int64  `json:"virtualsize"`
-	Vmstate                 string `json:"vmstate"`
-	Volumeid                string `json:"volumeid"`
-	Volumename              string `json:"volumename"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Description             string                     `json:"description"`
+	Diskioread              FlexInt64                  `json:"diskioread"`
+	Diskiowrite             FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread             FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite            FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid          string                     `json:"diskofferingid"`
+	Diskofferingname        string                     `json:"diskofferingname"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Domainpath              string                     `json:"domainpath"`
+	Filesystem              string                     `json:"filesystem"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Iscustomdiskoffering    FlexBool                   `json:"iscustomdiskoffering"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Networkid               string                     `json:"networkid"`
+	Networkname             string                     `json:"networkname"`
+	Nic                     []Nic                      `json:"nic"`
+	Path                    string                     `json:"path"`
+	Physicalsize            FlexInt64                  `json:"physicalsize"`
+	Project                 string                     `json:"project"`
+	Projectid               string                     `json:"projectid"`
+	Provider                string                     `json:"provider"`
+	Provisioningtype        string                     `json:"provisioningtype"`
+	Serviceofferingid       string                     `json:"serviceofferingid"`
+	Serviceofferingname     string                     `json:"serviceofferingname"`
+	Size                    FlexInt64                  `json:"size"`
+	Sizegb                  string                     `json:"sizegb"`
+	State                   string                     `json:"state"`
+	Storage                 string                     `json:"storage"`
+	Storageid               string                     `json:"storageid"`
+	Tags                    []Tags                     `json:"tags"`
+	Utilization             string                     `json:"utilization"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmstate                 string                     `json:"vmstate"`
+	Volumeid                string                     `json:"volumeid"`
+	Volumename              string                     `json:"volumename"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
+	type alias StartSharedFileSystemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopSharedFileSystemParams struct {
@@ -2123,6 +2902,45 @@ func (p *StopSharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the stopSharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StopSharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("stopSharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the stopSharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StopSharedFileSystemParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("stopSharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the stopSharedFileSystem API, before the request is signed
+func (p *StopSharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StopSharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StopSharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("stopSharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StopSharedFileSystemParams) Clone() *StopSharedFileSystemParams {
+	return &StopSharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *StopSharedFileSystemParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2176,32 +2994,39 @@ func (s *SharedFileSystemService) NewStopSharedFileSystemParams(id string) *Stop
 
 // Stop a Shared FileSystem
 func (s *SharedFileSystemService) StopSharedFileSystem(p *StopSharedFileSystemParams) (*StopSharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("stopSharedFileSystem", p.toURLValues())
+	return s.StopSharedFileSystemWithContext(context.Background(), p)
+}
+
+// StopSharedFileSystemWithContext is like StopSharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) StopSharedFileSystemWithContext(ctx context.Context, p *StopSharedFileSystemParams) (*StopSharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "stopSharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StopSharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("stopSharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "stopSharedFileSystem", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("stopSharedFileSystem", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2209,51 +3034,76 @@ func (s *SharedFileSystemService) StopSharedFileSystem(p *StopSharedFileSystemPa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StopSharedFileSystem async job into r
+func (r *StopSharedFileSystemResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StopSharedFileSystemAsync starts the async job of StopSharedFileSystem without waiting for it to finish, and returns a handle to the job
+func (s *SharedFileSystemService) StopSharedFileSystemAsync(ctx context.Context, p *StopSharedFileSystemParams) (*Job, error) {
+	r, err := s.StopSharedFileSystemWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("stopSharedFileSystem", r.JobID), nil
+}
+
 type StopSharedFileSystemResponse struct {
-	Account                 string `json:"account"`
-	Description             string `json:"description"`
-	Diskioread              int64  `json:"diskioread"`
-	Diskiowrite             int64  `json:"diskiowrite"`
-	Diskkbsread             int64  `json:"diskkbsread"`
-	Diskkbswrite            int64  `json:"diskkbswrite"`
-	Diskofferingdisplaytext string `json:"diskofferingdisplaytext"`
-	Diskofferingid          string `json:"diskofferingid"`
-	Diskofferingname        string `json:"diskofferingname"`
-	Domain                  string `json:"domain"`
-	Domainid                string `json:"domainid"`
-	Domainpath              string `json:"domainpath"`
-	Filesystem              string `json:"filesystem"`
-	Hasannotations          bool   `json:"hasannotations"`
-	Id                      string `json:"id"`
-	Iscustomdiskoffering    bool   `json:"iscustomdiskoffering"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Name                    string `json:"name"`
-	Networkid               string `json:"networkid"`
-	Networkname             string `json:"networkname"`
-	Nic                     []Nic  `json:"nic"`
-	Path                    string `json:"path"`
-	Physicalsize            int64  `json:"physicalsize"`
-	Project                 string `json:"project"`
-	Projectid               string `json:"projectid"`
-	Provider                string `json:"provider"`
-	Provisioningtype        string `json:"provisioningtype"`
-	Serviceofferingid       string `json:"serviceofferingid"`
-	Serviceofferingname     string `json:"serviceofferingname"`
-	Size                    int64  `json:"size"`
-	Sizegb                  string `json:"sizegb"`
-	State                   string `json:"state"`
-	Storage                 string `json:"storage"`
-	Storageid               string `json:"storageid"`
-	Tags                    []Tags `json:"tags"`
-	Utilization             string `json:"utilization"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Virtualsize             int64  `json:"virtualsize"`
-	Vmstate                 string `json:"vmstate"`
-	Volumeid                string `json:"volumeid"`
-	Volumename              string `json:"volumename"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Description             string                     `json:"description"`
+	Diskioread              FlexInt64                  `json:"diskioread"`
+	Diskiowrite             FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread             FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite            FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid          string                     `json:"diskofferingid"`
+	Diskofferingname        string                     `json:"diskofferingname"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Domainpath              string                     `json:"domainpath"`
+	Filesystem              string                     `json:"filesystem"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Iscustomdiskoffering    FlexBool                   `json:"iscustomdiskoffering"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Networkid               string                     `json:"networkid"`
+	Networkname             string                     `json:"networkname"`
+	Nic                     []Nic                      `json:"nic"`
+	Path                    string                     `json:"path"`
+	Physicalsize            FlexInt64                  `json:"physicalsize"`
+	Project                 string                     `json:"project"`
+	Projectid               string                     `json:"projectid"`
+	Provider                string                     `json:"provider"`
+	Provisioningtype        string                     `json:"provisioningtype"`
+	Serviceofferingid       string                     `json:"serviceofferingid"`
+	Serviceofferingname     string                     `json:"serviceofferingname"`
+	Size                    FlexInt64                  `json:"size"`
+	Sizegb                  string                     `json:"sizegb"`
+	State                   string                     `json:"state"`
+	Storage                 string                     `json:"storage"`
+	Storageid               string                     `json:"storageid"`
+	Tags                    []Tags                     `json:"tags"`
+	Utilization             string                     `json:"utilization"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmstate                 string                     `json:"vmstate"`
+	Volumeid                string                     `json:"volumeid"`
+	Volumename              string                     `json:"volumename"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
+	type alias StopSharedFileSystemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateSharedFileSystemParams struct {
@@ -2277,6 +3127,46 @@ func (p *UpdateSharedFileSystemParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateSharedFileSystem API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateSharedFileSystemParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateSharedFileSystem", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateSharedFileSystem API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateSharedFileSystemParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "id": 255, "name": 255},
+	}.validate("updateSharedFileSystem", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateSharedFileSystem API, before the request is signed
+func (p *UpdateSharedFileSystemParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateSharedFileSystemParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateSharedFileSystemParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateSharedFileSystem", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"id":          decodeParam[string],
+		"name":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateSharedFileSystemParams) Clone() *UpdateSharedFileSystemParams {
+	return &UpdateSharedFileSystemParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateSharedFileSystemParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2351,13 +3241,22 @@ func (s *SharedFileSystemService) NewUpdateSharedFileSystemParams(id string) *Up
 
 // Update a Shared FileSystem
 func (s *SharedFileSystemService) UpdateSharedFileSystem(p *UpdateSharedFileSystemParams) (*UpdateSharedFileSystemResponse, error) {
-	resp, err := s.cs.newPostRequest("updateSharedFileSystem", p.toURLValues())
+	return s.UpdateSharedFileSystemWithContext(context.Background(), p)
+}
+
+// UpdateSharedFileSystemWithContext is like UpdateSharedFileSystem, but honours the cancellation and deadline of ctx
+func (s *SharedFileSystemService) UpdateSharedFileSystemWithContext(ctx context.Context, p *UpdateSharedFileSystemParams) (*UpdateSharedFileSystemResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateSharedFileSystem", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateSharedFileSystemResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateSharedFileSystem", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2365,48 +3264,54 @@ func (s *SharedFileSystemService) UpdateSharedFileSystem(p *UpdateSharedFileSyst
 }
 
 type UpdateSharedFileSystemResponse struct {
-	Account                 string `json:"account"`
-	Description             string `json:"description"`
-	Diskioread              int64  `json:"diskioread"`
-	Diskiowrite             int64  `json:"diskiowrite"`
-	Diskkbsread             int64  `json:"diskkbsread"`
-	Diskkbswrite            int64  `json:"diskkbswrite"`
-	Diskofferingdisplaytext string `json:"diskofferingdisplaytext"`
-	Diskofferingid          string `json:"diskofferingid"`
-	Diskofferingname        string `json:"diskofferingname"`
-	Domain                  string `json:"domain"`
-	Domainid                string `json:"domainid"`
-	Domainpath              string `json:"domainpath"`
-	Filesystem              string `json:"filesystem"`
-	Hasannotations          bool   `json:"hasannotations"`
-	Id                      string `json:"id"`
-	Iscustomdiskoffering    bool   `json:"iscustomdiskoffering"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Name                    string `json:"name"`
-	Networkid               string `json:"networkid"`
-	Networkname             string `json:"networkname"`
-	Nic                     []Nic  `json:"nic"`
-	Path                    string `json:"path"`
-	Physicalsize            int64  `json:"physicalsize"`
-	Project                 string `json:"project"`
-	Projectid               string `json:"projectid"`
-	Provider                string `json:"provider"`
-	Provisioningtype        string `json:"provisioningtype"`
-	Serviceofferingid       string `json:"serviceofferingid"`
-	Serviceofferingname     string `json:"serviceofferingname"`
-	Size                    int64  `json:"size"`
-	Sizegb                  string `json:"sizegb"`
-	State                   string `json:"state"`
-	Storage                 string `json:"storage"`
-	Storageid               string `json:"storageid"`
-	Tags                    []Tags `json:"tags"`
-	Utilization             string `json:"utilization"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Virtualsize             int64  `json:"virtualsize"`
-	Vmstate                 string `json:"vmstate"`
-	Volumeid                string `json:"volumeid"`
-	Volumename              string `json:"volumename"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Description             string                     `json:"description"`
+	Diskioread              FlexInt64                  `json:"diskioread"`
+	Diskiowrite             FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread             FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite            FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid          string                     `json:"diskofferingid"`
+	Diskofferingname        string                     `json:"diskofferingname"`
+	Domain                  string                     `json:"domain"`
+	Domainid                string                     `json:"domainid"`
+	Domainpath              string                     `json:"domainpath"`
+	Filesystem              string                     `json:"filesystem"`
+	Hasannotations          FlexBool                   `json:"hasannotations"`
+	Id                      string                     `json:"id"`
+	Iscustomdiskoffering    FlexBool                   `json:"iscustomdiskoffering"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Name                    string                     `json:"name"`
+	Networkid               string                     `json:"networkid"`
+	Networkname             string                     `json:"networkname"`
+	Nic                     []Nic                      `json:"nic"`
+	Path                    string                     `json:"path"`
+	Physicalsize            FlexInt64                  `json:"physicalsize"`
+	Project                 string                     `json:"project"`
+	Projectid               string                     `json:"projectid"`
+	Provider                string                     `json:"provider"`
+	Provisioningtype        string                     `json:"provisioningtype"`
+	Serviceofferingid       string                     `json:"serviceofferingid"`
+	Serviceofferingname     string                     `json:"serviceofferingname"`
+	Size                    FlexInt64                  `json:"size"`
+	Sizegb                  string                     `json:"sizegb"`
+	State                   string                     `json:"state"`
+	Storage                 string                     `json:"storage"`
+	Storageid               string                     `json:"storageid"`
+	Tags                    []Tags                     `json:"tags"`
+	Utilization             string                     `json:"utilization"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Virtualsize             FlexInt64                  `json:"virtualsize"`
+	Vmstate                 string                     `json:"vmstate"`
+	Volumeid                string                     `json:"volumeid"`
+	Volumename              string                     `json:"volumename"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateSharedFileSystemResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateSharedFileSystemResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
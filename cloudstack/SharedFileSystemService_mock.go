@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ChangeSharedFileSystemDi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSharedFileSystemDiskOffering", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ChangeSharedFileSystemDiskOffering), p)
 }
 
+// ChangeSharedFileSystemDiskOfferingAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) ChangeSharedFileSystemDiskOfferingAsync(ctx context.Context, p *ChangeSharedFileSystemDiskOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeSharedFileSystemDiskOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeSharedFileSystemDiskOfferingAsync indicates an expected call of ChangeSharedFileSystemDiskOfferingAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ChangeSharedFileSystemDiskOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSharedFileSystemDiskOfferingAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ChangeSharedFileSystemDiskOfferingAsync), ctx, p)
+}
+
+// ChangeSharedFileSystemDiskOfferingWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ChangeSharedFileSystemDiskOfferingWithContext(ctx context.Context, p *ChangeSharedFileSystemDiskOfferingParams) (*ChangeSharedFileSystemDiskOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeSharedFileSystemDiskOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeSharedFileSystemDiskOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeSharedFileSystemDiskOfferingWithContext indicates an expected call of ChangeSharedFileSystemDiskOfferingWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ChangeSharedFileSystemDiskOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSharedFileSystemDiskOfferingWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ChangeSharedFileSystemDiskOfferingWithContext), ctx, p)
+}
+
 // ChangeSharedFileSystemServiceOffering mocks base method.
 func (m *MockSharedFileSystemServiceIface) ChangeSharedFileSystemServiceOffering(p *ChangeSharedFileSystemServiceOfferingParams) (*ChangeSharedFileSystemServiceOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ChangeSharedFileSystemSe
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSharedFileSystemServiceOffering", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ChangeSharedFileSystemServiceOffering), p)
 }
 
+// ChangeSharedFileSystemServiceOfferingAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) ChangeSharedFileSystemServiceOfferingAsync(ctx context.Context, p *ChangeSharedFileSystemServiceOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeSharedFileSystemServiceOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeSharedFileSystemServiceOfferingAsync indicates an expected call of ChangeSharedFileSystemServiceOfferingAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ChangeSharedFileSystemServiceOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSharedFileSystemServiceOfferingAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ChangeSharedFileSystemServiceOfferingAsync), ctx, p)
+}
+
+// ChangeSharedFileSystemServiceOfferingWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ChangeSharedFileSystemServiceOfferingWithContext(ctx context.Context, p *ChangeSharedFileSystemServiceOfferingParams) (*ChangeSharedFileSystemServiceOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeSharedFileSystemServiceOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeSharedFileSystemServiceOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeSharedFileSystemServiceOfferingWithContext indicates an expected call of ChangeSharedFileSystemServiceOfferingWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ChangeSharedFileSystemServiceOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeSharedFileSystemServiceOfferingWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ChangeSharedFileSystemServiceOfferingWithContext), ctx, p)
+}
+
 // CreateSharedFileSystem mocks base method.
 func (m *MockSharedFileSystemServiceIface) CreateSharedFileSystem(p *CreateSharedFileSystemParams) (*CreateSharedFileSystemResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) CreateSharedFileSystem(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).CreateSharedFileSystem), p)
 }
 
+// CreateSharedFileSystemAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) CreateSharedFileSystemAsync(ctx context.Context, p *CreateSharedFileSystemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSharedFileSystemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSharedFileSystemAsync indicates an expected call of CreateSharedFileSystemAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) CreateSharedFileSystemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSharedFileSystemAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).CreateSharedFileSystemAsync), ctx, p)
+}
+
+// CreateSharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) CreateSharedFileSystemWithContext(ctx context.Context, p *CreateSharedFileSystemParams) (*CreateSharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSharedFileSystemWithContext indicates an expected call of CreateSharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) CreateSharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).CreateSharedFileSystemWithContext), ctx, p)
+}
+
 // DestroySharedFileSystem mocks base method.
 func (m *MockSharedFileSystemServiceIface) DestroySharedFileSystem(p *DestroySharedFileSystemParams) (*DestroySharedFileSystemResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) DestroySharedFileSystem(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).DestroySharedFileSystem), p)
 }
 
+// DestroySharedFileSystemAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) DestroySharedFileSystemAsync(ctx context.Context, p *DestroySharedFileSystemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySharedFileSystemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroySharedFileSystemAsync indicates an expected call of DestroySharedFileSystemAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) DestroySharedFileSystemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySharedFileSystemAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).DestroySharedFileSystemAsync), ctx, p)
+}
+
+// DestroySharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) DestroySharedFileSystemWithContext(ctx context.Context, p *DestroySharedFileSystemParams) (*DestroySharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*DestroySharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroySharedFileSystemWithContext indicates an expected call of DestroySharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) DestroySharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).DestroySharedFileSystemWithContext), ctx, p)
+}
+
 // ExpungeSharedFileSystem mocks base method.
 func (m *MockSharedFileSystemServiceIface) ExpungeSharedFileSystem(p *ExpungeSharedFileSystemParams) (*ExpungeSharedFileSystemResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ExpungeSharedFileSystem(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeSharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ExpungeSharedFileSystem), p)
 }
 
+// ExpungeSharedFileSystemAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) ExpungeSharedFileSystemAsync(ctx context.Context, p *ExpungeSharedFileSystemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpungeSharedFileSystemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpungeSharedFileSystemAsync indicates an expected call of ExpungeSharedFileSystemAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ExpungeSharedFileSystemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeSharedFileSystemAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ExpungeSharedFileSystemAsync), ctx, p)
+}
+
+// ExpungeSharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ExpungeSharedFileSystemWithContext(ctx context.Context, p *ExpungeSharedFileSystemParams) (*ExpungeSharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpungeSharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExpungeSharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpungeSharedFileSystemWithContext indicates an expected call of ExpungeSharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ExpungeSharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeSharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ExpungeSharedFileSystemWithContext), ctx, p)
+}
+
 // GetSharedFileSystemByID mocks base method.
 func (m *MockSharedFileSystemServiceIface) GetSharedFileSystemByID(id string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +306,27 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) GetSharedFileSystemByID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFileSystemByID", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).GetSharedFileSystemByID), varargs...)
 }
 
+// GetSharedFileSystemByIDWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) GetSharedFileSystemByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSharedFileSystemByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*SharedFileSystem)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSharedFileSystemByIDWithContext indicates an expected call of GetSharedFileSystemByIDWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) GetSharedFileSystemByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFileSystemByIDWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).GetSharedFileSystemByIDWithContext), varargs...)
+}
+
 // GetSharedFileSystemByName mocks base method.
 func (m *MockSharedFileSystemServiceIface) GetSharedFileSystemByName(name string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +348,27 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) GetSharedFileSystemByNam
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFileSystemByName", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).GetSharedFileSystemByName), varargs...)
 }
 
+// GetSharedFileSystemByNameWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) GetSharedFileSystemByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SharedFileSystem, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSharedFileSystemByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*SharedFileSystem)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSharedFileSystemByNameWithContext indicates an expected call of GetSharedFileSystemByNameWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) GetSharedFileSystemByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFileSystemByNameWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).GetSharedFileSystemByNameWithContext), varargs...)
+}
+
 // GetSharedFileSystemID mocks base method.
 func (m *MockSharedFileSystemServiceIface) GetSharedFileSystemID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +390,27 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) GetSharedFileSystemID(na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFileSystemID", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).GetSharedFileSystemID), varargs...)
 }
 
+// GetSharedFileSystemIDWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) GetSharedFileSystemIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSharedFileSystemIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSharedFileSystemIDWithContext indicates an expected call of GetSharedFileSystemIDWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) GetSharedFileSystemIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSharedFileSystemIDWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).GetSharedFileSystemIDWithContext), varargs...)
+}
+
 // ListSharedFileSystemProviders mocks base method.
 func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemProviders(p *ListSharedFileSystemProvidersParams) (*ListSharedFileSystemProvidersResponse, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +426,99 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemProv
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemProviders", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemProviders), p)
 }
 
+// ListSharedFileSystemProvidersAll mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemProvidersAll(p *ListSharedFileSystemProvidersParams, opts ...PageOption) ([]*SharedFileSystemProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemProvidersAll", varargs...)
+	ret0, _ := ret[0].([]*SharedFileSystemProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedFileSystemProvidersAll indicates an expected call of ListSharedFileSystemProvidersAll.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemProvidersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemProvidersAll", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemProvidersAll), varargs...)
+}
+
+// ListSharedFileSystemProvidersAllWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemProvidersAllWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams, opts ...PageOption) ([]*SharedFileSystemProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemProvidersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SharedFileSystemProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedFileSystemProvidersAllWithContext indicates an expected call of ListSharedFileSystemProvidersAllWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemProvidersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemProvidersAllWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemProvidersAllWithContext), varargs...)
+}
+
+// ListSharedFileSystemProvidersIter mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemProvidersIter(p *ListSharedFileSystemProvidersParams, opts ...PageOption) iter.Seq2[*SharedFileSystemProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemProvidersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SharedFileSystemProvider, error])
+	return ret0
+}
+
+// ListSharedFileSystemProvidersIter indicates an expected call of ListSharedFileSystemProvidersIter.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemProvidersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemProvidersIter", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemProvidersIter), varargs...)
+}
+
+// ListSharedFileSystemProvidersIterWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemProvidersIterWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams, opts ...PageOption) iter.Seq2[*SharedFileSystemProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemProvidersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SharedFileSystemProvider, error])
+	return ret0
+}
+
+// ListSharedFileSystemProvidersIterWithContext indicates an expected call of ListSharedFileSystemProvidersIterWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemProvidersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemProvidersIterWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemProvidersIterWithContext), varargs...)
+}
+
+// ListSharedFileSystemProvidersWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemProvidersWithContext(ctx context.Context, p *ListSharedFileSystemProvidersParams) (*ListSharedFileSystemProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSharedFileSystemProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSharedFileSystemProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedFileSystemProvidersWithContext indicates an expected call of ListSharedFileSystemProvidersWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemProvidersWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemProvidersWithContext), ctx, p)
+}
+
 // ListSharedFileSystems mocks base method.
 func (m *MockSharedFileSystemServiceIface) ListSharedFileSystems(p *ListSharedFileSystemsParams) (*ListSharedFileSystemsResponse, error) {
 	m.ctrl.T.Helper()
@@ -226,6 +534,99 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystems(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystems", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystems), p)
 }
 
+// ListSharedFileSystemsAll mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemsAll(p *ListSharedFileSystemsParams, opts ...PageOption) ([]*SharedFileSystem, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemsAll", varargs...)
+	ret0, _ := ret[0].([]*SharedFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedFileSystemsAll indicates an expected call of ListSharedFileSystemsAll.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemsAll", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemsAll), varargs...)
+}
+
+// ListSharedFileSystemsAllWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemsAllWithContext(ctx context.Context, p *ListSharedFileSystemsParams, opts ...PageOption) ([]*SharedFileSystem, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SharedFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedFileSystemsAllWithContext indicates an expected call of ListSharedFileSystemsAllWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemsAllWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemsAllWithContext), varargs...)
+}
+
+// ListSharedFileSystemsIter mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemsIter(p *ListSharedFileSystemsParams, opts ...PageOption) iter.Seq2[*SharedFileSystem, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SharedFileSystem, error])
+	return ret0
+}
+
+// ListSharedFileSystemsIter indicates an expected call of ListSharedFileSystemsIter.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemsIter", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemsIter), varargs...)
+}
+
+// ListSharedFileSystemsIterWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemsIterWithContext(ctx context.Context, p *ListSharedFileSystemsParams, opts ...PageOption) iter.Seq2[*SharedFileSystem, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSharedFileSystemsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SharedFileSystem, error])
+	return ret0
+}
+
+// ListSharedFileSystemsIterWithContext indicates an expected call of ListSharedFileSystemsIterWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemsIterWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemsIterWithContext), varargs...)
+}
+
+// ListSharedFileSystemsWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) ListSharedFileSystemsWithContext(ctx context.Context, p *ListSharedFileSystemsParams) (*ListSharedFileSystemsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSharedFileSystemsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSharedFileSystemsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSharedFileSystemsWithContext indicates an expected call of ListSharedFileSystemsWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) ListSharedFileSystemsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSharedFileSystemsWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).ListSharedFileSystemsWithContext), ctx, p)
+}
+
 // NewChangeSharedFileSystemDiskOfferingParams mocks base method.
 func (m *MockSharedFileSystemServiceIface) NewChangeSharedFileSystemDiskOfferingParams(id string) *ChangeSharedFileSystemDiskOfferingParams {
 	m.ctrl.T.Helper()
@@ -409,6 +810,21 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) RecoverSharedFileSystem(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverSharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).RecoverSharedFileSystem), p)
 }
 
+// RecoverSharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) RecoverSharedFileSystemWithContext(ctx context.Context, p *RecoverSharedFileSystemParams) (*RecoverSharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverSharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*RecoverSharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverSharedFileSystemWithContext indicates an expected call of RecoverSharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) RecoverSharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverSharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).RecoverSharedFileSystemWithContext), ctx, p)
+}
+
 // RestartSharedFileSystem mocks base method.
 func (m *MockSharedFileSystemServiceIface) RestartSharedFileSystem(p *RestartSharedFileSystemParams) (*RestartSharedFileSystemResponse, error) {
 	m.ctrl.T.Helper()
@@ -424,6 +840,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) RestartSharedFileSystem(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartSharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).RestartSharedFileSystem), p)
 }
 
+// RestartSharedFileSystemAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) RestartSharedFileSystemAsync(ctx context.Context, p *RestartSharedFileSystemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartSharedFileSystemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartSharedFileSystemAsync indicates an expected call of RestartSharedFileSystemAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) RestartSharedFileSystemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartSharedFileSystemAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).RestartSharedFileSystemAsync), ctx, p)
+}
+
+// RestartSharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) RestartSharedFileSystemWithContext(ctx context.Context, p *RestartSharedFileSystemParams) (*RestartSharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartSharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*RestartSharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartSharedFileSystemWithContext indicates an expected call of RestartSharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) RestartSharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartSharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).RestartSharedFileSystemWithContext), ctx, p)
+}
+
 // StartSharedFileSystem mocks base method.
 func (m *MockSharedFileSystemServiceIface) StartSharedFileSystem(p *StartSharedFileSystemParams) (*StartSharedFileSystemResponse, error) {
 	m.ctrl.T.Helper()
@@ -439,6 +885,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) StartSharedFileSystem(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).StartSharedFileSystem), p)
 }
 
+// StartSharedFileSystemAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) StartSharedFileSystemAsync(ctx context.Context, p *StartSharedFileSystemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSharedFileSystemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSharedFileSystemAsync indicates an expected call of StartSharedFileSystemAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) StartSharedFileSystemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSharedFileSystemAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).StartSharedFileSystemAsync), ctx, p)
+}
+
+// StartSharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) StartSharedFileSystemWithContext(ctx context.Context, p *StartSharedFileSystemParams) (*StartSharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*StartSharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSharedFileSystemWithContext indicates an expected call of StartSharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) StartSharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).StartSharedFileSystemWithContext), ctx, p)
+}
+
 // StopSharedFileSystem mocks base method.
 func (m *MockSharedFileSystemServiceIface) StopSharedFileSystem(p *StopSharedFileSystemParams) (*StopSharedFileSystemResponse, error) {
 	m.ctrl.T.Helper()
@@ -454,6 +930,36 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) StopSharedFileSystem(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).StopSharedFileSystem), p)
 }
 
+// StopSharedFileSystemAsync mocks base method.
+func (m *MockSharedFileSystemServiceIface) StopSharedFileSystemAsync(ctx context.Context, p *StopSharedFileSystemParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopSharedFileSystemAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopSharedFileSystemAsync indicates an expected call of StopSharedFileSystemAsync.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) StopSharedFileSystemAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSharedFileSystemAsync", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).StopSharedFileSystemAsync), ctx, p)
+}
+
+// StopSharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) StopSharedFileSystemWithContext(ctx context.Context, p *StopSharedFileSystemParams) (*StopSharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopSharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*StopSharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopSharedFileSystemWithContext indicates an expected call of StopSharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) StopSharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).StopSharedFileSystemWithContext), ctx, p)
+}
+
 // UpdateSharedFileSystem mocks base method.
 func (m *MockSharedFileSystemServiceIface) UpdateSharedFileSystem(p *UpdateSharedFileSystemParams) (*UpdateSharedFileSystemResponse, error) {
 	m.ctrl.T.Helper()
@@ -468,3 +974,18 @@ func (mr *MockSharedFileSystemServiceIfaceMockRecorder) UpdateSharedFileSystem(p
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSharedFileSystem", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).UpdateSharedFileSystem), p)
 }
+
+// UpdateSharedFileSystemWithContext mocks base method.
+func (m *MockSharedFileSystemServiceIface) UpdateSharedFileSystemWithContext(ctx context.Context, p *UpdateSharedFileSystemParams) (*UpdateSharedFileSystemResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSharedFileSystemWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateSharedFileSystemResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSharedFileSystemWithContext indicates an expected call of UpdateSharedFileSystemWithContext.
+func (mr *MockSharedFileSystemServiceIfaceMockRecorder) UpdateSharedFileSystemWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSharedFileSystemWithContext", reflect.TypeOf((*MockSharedFileSystemServiceIface)(nil).UpdateSharedFileSystemWithContext), ctx, p)
+}
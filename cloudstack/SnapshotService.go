@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,41 +31,85 @@ import (
 
 type SnapshotServiceIface interface {
 	ArchiveSnapshot(p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error)
+	ArchiveSnapshotWithContext(ctx context.Context, p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error)
+	ArchiveSnapshotAsync(ctx context.Context, p *ArchiveSnapshotParams) (*Job, error)
 	NewArchiveSnapshotParams(id string) *ArchiveSnapshotParams
 	CopySnapshot(p *CopySnapshotParams) (*CopySnapshotResponse, error)
+	CopySnapshotWithContext(ctx context.Context, p *CopySnapshotParams) (*CopySnapshotResponse, error)
+	CopySnapshotAsync(ctx context.Context, p *CopySnapshotParams) (*Job, error)
 	NewCopySnapshotParams(id string) *CopySnapshotParams
 	CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error)
+	CreateSnapshotAsync(ctx context.Context, p *CreateSnapshotParams) (*Job, error)
 	NewCreateSnapshotParams(volumeid string) *CreateSnapshotParams
 	CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error)
+	CreateSnapshotFromVMSnapshotAsync(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*Job, error)
 	NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid string, volumeid string) *CreateSnapshotFromVMSnapshotParams
 	CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error)
-	NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams
+	CreateSnapshotPolicyWithContext(ctx context.Context, p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error)
+	NewCreateSnapshotPolicyParams(intervaltype IntervalType, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams
 	CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error)
+	CreateVMSnapshotAsync(ctx context.Context, p *CreateVMSnapshotParams) (*Job, error)
 	NewCreateVMSnapshotParams(virtualmachineid string) *CreateVMSnapshotParams
 	DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error)
+	DeleteSnapshotAsync(ctx context.Context, p *DeleteSnapshotParams) (*Job, error)
 	NewDeleteSnapshotParams(id string) *DeleteSnapshotParams
 	DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error)
+	DeleteSnapshotPoliciesWithContext(ctx context.Context, p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error)
 	NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPoliciesParams
 	DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error)
+	DeleteVMSnapshotAsync(ctx context.Context, p *DeleteVMSnapshotParams) (*Job, error)
 	NewDeleteVMSnapshotParams(vmsnapshotid string) *DeleteVMSnapshotParams
 	ExtractSnapshot(p *ExtractSnapshotParams) (*ExtractSnapshotResponse, error)
+	ExtractSnapshotWithContext(ctx context.Context, p *ExtractSnapshotParams) (*ExtractSnapshotResponse, error)
+	ExtractSnapshotAsync(ctx context.Context, p *ExtractSnapshotParams) (*Job, error)
 	NewExtractSnapshotParams(id string, zoneid string) *ExtractSnapshotParams
 	ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error)
+	ListSnapshotPoliciesAll(p *ListSnapshotPoliciesParams, opts ...PageOption) ([]*SnapshotPolicy, error)
+	ListSnapshotPoliciesAllWithContext(ctx context.Context, p *ListSnapshotPoliciesParams, opts ...PageOption) ([]*SnapshotPolicy, error)
+	ListSnapshotPoliciesIter(p *ListSnapshotPoliciesParams, opts ...PageOption) iter.Seq2[*SnapshotPolicy, error]
+	ListSnapshotPoliciesIterWithContext(ctx context.Context, p *ListSnapshotPoliciesParams, opts ...PageOption) iter.Seq2[*SnapshotPolicy, error]
 	NewListSnapshotPoliciesParams() *ListSnapshotPoliciesParams
 	GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error)
+	GetSnapshotPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SnapshotPolicy, int, error)
 	ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error)
+	ListSnapshotsAll(p *ListSnapshotsParams, opts ...PageOption) ([]*Snapshot, error)
+	ListSnapshotsAllWithContext(ctx context.Context, p *ListSnapshotsParams, opts ...PageOption) ([]*Snapshot, error)
+	ListSnapshotsIter(p *ListSnapshotsParams, opts ...PageOption) iter.Seq2[*Snapshot, error]
+	ListSnapshotsIterWithContext(ctx context.Context, p *ListSnapshotsParams, opts ...PageOption) iter.Seq2[*Snapshot, error]
 	NewListSnapshotsParams() *ListSnapshotsParams
 	GetSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	GetSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Snapshot, int, error)
 	GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error)
+	GetSnapshotByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Snapshot, int, error)
 	ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error)
+	ListVMSnapshotAll(p *ListVMSnapshotParams, opts ...PageOption) ([]*VMSnapshot, error)
+	ListVMSnapshotAllWithContext(ctx context.Context, p *ListVMSnapshotParams, opts ...PageOption) ([]*VMSnapshot, error)
+	ListVMSnapshotIter(p *ListVMSnapshotParams, opts ...PageOption) iter.Seq2[*VMSnapshot, error]
+	ListVMSnapshotIterWithContext(ctx context.Context, p *ListVMSnapshotParams, opts ...PageOption) iter.Seq2[*VMSnapshot, error]
 	NewListVMSnapshotParams() *ListVMSnapshotParams
 	GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error)
+	GetVMSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error)
+	RevertSnapshotAsync(ctx context.Context, p *RevertSnapshotParams) (*Job, error)
 	NewRevertSnapshotParams(id string) *RevertSnapshotParams
 	RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error)
+	RevertToVMSnapshotAsync(ctx context.Context, p *RevertToVMSnapshotParams) (*Job, error)
 	NewRevertToVMSnapshotParams(vmsnapshotid string) *RevertToVMSnapshotParams
 	UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error)
+	UpdateSnapshotPolicyAsync(ctx context.Context, p *UpdateSnapshotPolicyParams) (*Job, error)
 	NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyParams
 }
 
@@ -82,6 +128,44 @@ func (p *ArchiveSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the archiveSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ArchiveSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("archiveSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the archiveSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ArchiveSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("archiveSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the archiveSnapshot API, before the request is signed
+func (p *ArchiveSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ArchiveSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ArchiveSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("archiveSnapshot", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ArchiveSnapshotParams) Clone() *ArchiveSnapshotParams {
+	return &ArchiveSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *ArchiveSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -114,32 +198,39 @@ func (s *SnapshotService) NewArchiveSnapshotParams(id string) *ArchiveSnapshotPa
 
 // Archives (moves) a Snapshot on primary storage to secondary storage
 func (s *SnapshotService) ArchiveSnapshot(p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("archiveSnapshot", p.toURLValues())
+	return s.ArchiveSnapshotWithContext(context.Background(), p)
+}
+
+// ArchiveSnapshotWithContext is like ArchiveSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ArchiveSnapshotWithContext(ctx context.Context, p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "archiveSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ArchiveSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("archiveSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "archiveSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("archiveSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -147,44 +238,64 @@ func (s *SnapshotService) ArchiveSnapshot(p *ArchiveSnapshotParams) (*ArchiveSna
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ArchiveSnapshot async job into r
+func (r *ArchiveSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ArchiveSnapshotAsync starts the async job of ArchiveSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) ArchiveSnapshotAsync(ctx context.Context, p *ArchiveSnapshotParams) (*Job, error) {
+	r, err := s.ArchiveSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("archiveSnapshot", r.JobID), nil
+}
+
 type ArchiveSnapshotResponse struct {
-	Account         string            `json:"account"`
-	Chainsize       int64             `json:"chainsize"`
-	Created         string            `json:"created"`
-	Datastoreid     string            `json:"datastoreid"`
-	Datastorename   string            `json:"datastorename"`
-	Datastorestate  string            `json:"datastorestate"`
-	Datastoretype   string            `json:"datastoretype"`
-	Domain          string            `json:"domain"`
-	Domainid        string            `json:"domainid"`
-	Domainpath      string            `json:"domainpath"`
-	Downloaddetails map[string]string `json:"downloaddetails"`
-	Hasannotations  bool              `json:"hasannotations"`
-	Id              string            `json:"id"`
-	Intervaltype    string            `json:"intervaltype"`
-	JobID           string            `json:"jobid"`
-	Jobstatus       int               `json:"jobstatus"`
-	Locationtype    string            `json:"locationtype"`
-	Name            string            `json:"name"`
-	Osdisplayname   string            `json:"osdisplayname"`
-	Ostypeid        string            `json:"ostypeid"`
-	Parent          string            `json:"parent"`
-	Parentname      string            `json:"parentname"`
-	Physicalsize    int64             `json:"physicalsize"`
-	Project         string            `json:"project"`
-	Projectid       string            `json:"projectid"`
-	Revertable      bool              `json:"revertable"`
-	Snapshottype    string            `json:"snapshottype"`
-	State           string            `json:"state"`
-	Status          string            `json:"status"`
-	Tags            []Tags            `json:"tags"`
-	Virtualsize     int64             `json:"virtualsize"`
-	Volumeid        string            `json:"volumeid"`
-	Volumename      string            `json:"volumename"`
-	Volumestate     string            `json:"volumestate"`
-	Volumetype      string            `json:"volumetype"`
-	Zoneid          string            `json:"zoneid"`
-	Zonename        string            `json:"zonename"`
+	Account         string                     `json:"account"`
+	Chainsize       FlexInt64                  `json:"chainsize"`
+	Created         Time                       `json:"created"`
+	Datastoreid     string                     `json:"datastoreid"`
+	Datastorename   string                     `json:"datastorename"`
+	Datastorestate  string                     `json:"datastorestate"`
+	Datastoretype   string                     `json:"datastoretype"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Downloaddetails map[string]string          `json:"downloaddetails"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	Intervaltype    string                     `json:"intervaltype"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Locationtype    string                     `json:"locationtype"`
+	Name            string                     `json:"name"`
+	Osdisplayname   string                     `json:"osdisplayname"`
+	Ostypeid        string                     `json:"ostypeid"`
+	Parent          string                     `json:"parent"`
+	Parentname      string                     `json:"parentname"`
+	Physicalsize    FlexInt64                  `json:"physicalsize"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Revertable      FlexBool                   `json:"revertable"`
+	Snapshottype    string                     `json:"snapshottype"`
+	State           string                     `json:"state"`
+	Status          string                     `json:"status"`
+	Tags            []Tags                     `json:"tags"`
+	Virtualsize     FlexInt64                  `json:"virtualsize"`
+	Volumeid        string                     `json:"volumeid"`
+	Volumename      string                     `json:"volumename"`
+	Volumestate     string                     `json:"volumestate"`
+	Volumetype      string                     `json:"volumetype"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ArchiveSnapshotResponse) UnmarshalJSON(b []byte) error {
@@ -211,7 +322,7 @@ func (r *ArchiveSnapshotResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ArchiveSnapshotResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CopySnapshotParams struct {
@@ -247,6 +358,49 @@ func (p *CopySnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the copySnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CopySnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("copySnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the copySnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CopySnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"destzoneid": 255, "id": 255, "sourcezoneid": 255},
+	}.validate("copySnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the copySnapshot API, before the request is signed
+func (p *CopySnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CopySnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CopySnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("copySnapshot", b, &p.p, map[string]paramDecoder{
+		"destzoneid":            decodeParam[string],
+		"destzoneids":           decodeParam[[]string],
+		"id":                    decodeParam[string],
+		"sourcezoneid":          decodeParam[string],
+		"storageids":            decodeParam[[]string],
+		"usestoragereplication": decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CopySnapshotParams) Clone() *CopySnapshotParams {
+	return &CopySnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *CopySnapshotParams) SetDestzoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -384,32 +538,39 @@ func (s *SnapshotService) NewCopySnapshotParams(id string) *CopySnapshotParams {
 
 // Copies a snapshot from one zone to another.
 func (s *SnapshotService) CopySnapshot(p *CopySnapshotParams) (*CopySnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("copySnapshot", p.toURLValues())
+	return s.CopySnapshotWithContext(context.Background(), p)
+}
+
+// CopySnapshotWithContext is like CopySnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) CopySnapshotWithContext(ctx context.Context, p *CopySnapshotParams) (*CopySnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "copySnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CopySnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("copySnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "copySnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("copySnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -417,44 +578,64 @@ func (s *SnapshotService) CopySnapshot(p *CopySnapshotParams) (*CopySnapshotResp
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CopySnapshot async job into r
+func (r *CopySnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CopySnapshotAsync starts the async job of CopySnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) CopySnapshotAsync(ctx context.Context, p *CopySnapshotParams) (*Job, error) {
+	r, err := s.CopySnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("copySnapshot", r.JobID), nil
+}
+
 type CopySnapshotResponse struct {
-	Account         string            `json:"account"`
-	Chainsize       int64             `json:"chainsize"`
-	Created         string            `json:"created"`
-	Datastoreid     string            `json:"datastoreid"`
-	Datastorename   string            `json:"datastorename"`
-	Datastorestate  string            `json:"datastorestate"`
-	Datastoretype   string            `json:"datastoretype"`
-	Domain          string            `json:"domain"`
-	Domainid        string            `json:"domainid"`
-	Domainpath      string            `json:"domainpath"`
-	Downloaddetails map[string]string `json:"downloaddetails"`
-	Hasannotations  bool              `json:"hasannotations"`
-	Id              string            `json:"id"`
-	Intervaltype    string            `json:"intervaltype"`
-	JobID           string            `json:"jobid"`
-	Jobstatus       int               `json:"jobstatus"`
-	Locationtype    string            `json:"locationtype"`
-	Name            string            `json:"name"`
-	Osdisplayname   string            `json:"osdisplayname"`
-	Ostypeid        string            `json:"ostypeid"`
-	Parent          string            `json:"parent"`
-	Parentname      string            `json:"parentname"`
-	Physicalsize    int64             `json:"physicalsize"`
-	Project         string            `json:"project"`
-	Projectid       string            `json:"projectid"`
-	Revertable      bool              `json:"revertable"`
-	Snapshottype    string            `json:"snapshottype"`
-	State           string            `json:"state"`
-	Status          string            `json:"status"`
-	Tags            []Tags            `json:"tags"`
-	Virtualsize     int64             `json:"virtualsize"`
-	Volumeid        string            `json:"volumeid"`
-	Volumename      string            `json:"volumename"`
-	Volumestate     string            `json:"volumestate"`
-	Volumetype      string            `json:"volumetype"`
-	Zoneid          string            `json:"zoneid"`
-	Zonename        string            `json:"zonename"`
+	Account         string                     `json:"account"`
+	Chainsize       FlexInt64                  `json:"chainsize"`
+	Created         Time                       `json:"created"`
+	Datastoreid     string                     `json:"datastoreid"`
+	Datastorename   string                     `json:"datastorename"`
+	Datastorestate  string                     `json:"datastorestate"`
+	Datastoretype   string                     `json:"datastoretype"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Downloaddetails map[string]string          `json:"downloaddetails"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	Intervaltype    string                     `json:"intervaltype"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Locationtype    string                     `json:"locationtype"`
+	Name            string                     `json:"name"`
+	Osdisplayname   string                     `json:"osdisplayname"`
+	Ostypeid        string                     `json:"ostypeid"`
+	Parent          string                     `json:"parent"`
+	Parentname      string                     `json:"parentname"`
+	Physicalsize    FlexInt64                  `json:"physicalsize"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Revertable      FlexBool                   `json:"revertable"`
+	Snapshottype    string                     `json:"snapshottype"`
+	State           string                     `json:"state"`
+	Status          string                     `json:"status"`
+	Tags            []Tags                     `json:"tags"`
+	Virtualsize     FlexInt64                  `json:"virtualsize"`
+	Volumeid        string                     `json:"volumeid"`
+	Volumename      string                     `json:"volumename"`
+	Volumestate     string                     `json:"volumestate"`
+	Volumetype      string                     `json:"volumetype"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CopySnapshotResponse) UnmarshalJSON(b []byte) error {
@@ -481,7 +662,7 @@ func (r *CopySnapshotResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CopySnapshotResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSnapshotParams struct {
@@ -541,6 +722,55 @@ func (p *CreateSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"volumeid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "locationtype": 255, "name": 255, "policyid": 255, "volumeid": 255},
+	}.validate("createSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSnapshot API, before the request is signed
+func (p *CreateSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSnapshot", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"asyncbackup":           decodeParam[bool],
+		"domainid":              decodeParam[string],
+		"locationtype":          decodeParam[string],
+		"name":                  decodeParam[string],
+		"policyid":              decodeParam[string],
+		"quiescevm":             decodeParam[bool],
+		"storageids":            decodeParam[[]string],
+		"tags":                  decodeParam[map[string]string],
+		"usestoragereplication": decodeParam[bool],
+		"volumeid":              decodeParam[string],
+		"zoneids":               decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSnapshotParams) Clone() *CreateSnapshotParams {
+	return &CreateSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSnapshotParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -804,32 +1034,39 @@ func (s *SnapshotService) NewCreateSnapshotParams(volumeid string) *CreateSnapsh
 
 // Creates an instant Snapshot of a volume.
 func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("createSnapshot", p.toURLValues())
+	return s.CreateSnapshotWithContext(context.Background(), p)
+}
+
+// CreateSnapshotWithContext is like CreateSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -837,44 +1074,64 @@ func (s *SnapshotService) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapsh
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateSnapshot async job into r
+func (r *CreateSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateSnapshotAsync starts the async job of CreateSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) CreateSnapshotAsync(ctx context.Context, p *CreateSnapshotParams) (*Job, error) {
+	r, err := s.CreateSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createSnapshot", r.JobID), nil
+}
+
 type CreateSnapshotResponse struct {
-	Account         string            `json:"account"`
-	Chainsize       int64             `json:"chainsize"`
-	Created         string            `json:"created"`
-	Datastoreid     string            `json:"datastoreid"`
-	Datastorename   string            `json:"datastorename"`
-	Datastorestate  string            `json:"datastorestate"`
-	Datastoretype   string            `json:"datastoretype"`
-	Domain          string            `json:"domain"`
-	Domainid        string            `json:"domainid"`
-	Domainpath      string            `json:"domainpath"`
-	Downloaddetails map[string]string `json:"downloaddetails"`
-	Hasannotations  bool              `json:"hasannotations"`
-	Id              string            `json:"id"`
-	Intervaltype    string            `json:"intervaltype"`
-	JobID           string            `json:"jobid"`
-	Jobstatus       int               `json:"jobstatus"`
-	Locationtype    string            `json:"locationtype"`
-	Name            string            `json:"name"`
-	Osdisplayname   string            `json:"osdisplayname"`
-	Ostypeid        string            `json:"ostypeid"`
-	Parent          string            `json:"parent"`
-	Parentname      string            `json:"parentname"`
-	Physicalsize    int64             `json:"physicalsize"`
-	Project         string            `json:"project"`
-	Projectid       string            `json:"projectid"`
-	Revertable      bool              `json:"revertable"`
-	Snapshottype    string            `json:"snapshottype"`
-	State           string            `json:"state"`
-	Status          string            `json:"status"`
-	Tags            []Tags            `json:"tags"`
-	Virtualsize     int64             `json:"virtualsize"`
-	Volumeid        string            `json:"volumeid"`
-	Volumename      string            `json:"volumename"`
-	Volumestate     string            `json:"volumestate"`
-	Volumetype      string            `json:"volumetype"`
-	Zoneid          string            `json:"zoneid"`
-	Zonename        string            `json:"zonename"`
+	Account         string                     `json:"account"`
+	Chainsize       FlexInt64                  `json:"chainsize"`
+	Created         Time                       `json:"created"`
+	Datastoreid     string                     `json:"datastoreid"`
+	Datastorename   string                     `json:"datastorename"`
+	Datastorestate  string                     `json:"datastorestate"`
+	Datastoretype   string                     `json:"datastoretype"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Downloaddetails map[string]string          `json:"downloaddetails"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	Intervaltype    string                     `json:"intervaltype"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Locationtype    string                     `json:"locationtype"`
+	Name            string                     `json:"name"`
+	Osdisplayname   string                     `json:"osdisplayname"`
+	Ostypeid        string                     `json:"ostypeid"`
+	Parent          string                     `json:"parent"`
+	Parentname      string                     `json:"parentname"`
+	Physicalsize    FlexInt64                  `json:"physicalsize"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Revertable      FlexBool                   `json:"revertable"`
+	Snapshottype    string                     `json:"snapshottype"`
+	State           string                     `json:"state"`
+	Status          string                     `json:"status"`
+	Tags            []Tags                     `json:"tags"`
+	Virtualsize     FlexInt64                  `json:"virtualsize"`
+	Volumeid        string                     `json:"volumeid"`
+	Volumename      string                     `json:"volumename"`
+	Volumestate     string                     `json:"volumestate"`
+	Volumetype      string                     `json:"volumetype"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CreateSnapshotResponse) UnmarshalJSON(b []byte) error {
@@ -901,7 +1158,7 @@ func (r *CreateSnapshotResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CreateSnapshotResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSnapshotFromVMSnapshotParams struct {
@@ -925,6 +1182,46 @@ func (p *CreateSnapshotFromVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSnapshotFromVMSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSnapshotFromVMSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSnapshotFromVMSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSnapshotFromVMSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSnapshotFromVMSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"vmsnapshotid", "volumeid"},
+		lengths:  map[string]int{"name": 255, "vmsnapshotid": 255, "volumeid": 255},
+	}.validate("createSnapshotFromVMSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSnapshotFromVMSnapshot API, before the request is signed
+func (p *CreateSnapshotFromVMSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSnapshotFromVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSnapshotFromVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSnapshotFromVMSnapshot", b, &p.p, map[string]paramDecoder{
+		"name":         decodeParam[string],
+		"vmsnapshotid": decodeParam[string],
+		"volumeid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSnapshotFromVMSnapshotParams) Clone() *CreateSnapshotFromVMSnapshotParams {
+	return &CreateSnapshotFromVMSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSnapshotFromVMSnapshotParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1000,32 +1297,39 @@ func (s *SnapshotService) NewCreateSnapshotFromVMSnapshotParams(vmsnapshotid str
 
 // Creates an instant Snapshot of a volume from existing Instance Snapshot.
 func (s *SnapshotService) CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("createSnapshotFromVMSnapshot", p.toURLValues())
+	return s.CreateSnapshotFromVMSnapshotWithContext(context.Background(), p)
+}
+
+// CreateSnapshotFromVMSnapshotWithContext is like CreateSnapshotFromVMSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createSnapshotFromVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSnapshotFromVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createSnapshotFromVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createSnapshotFromVMSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createSnapshotFromVMSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1033,44 +1337,64 @@ func (s *SnapshotService) CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSn
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateSnapshotFromVMSnapshot async job into r
+func (r *CreateSnapshotFromVMSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateSnapshotFromVMSnapshotAsync starts the async job of CreateSnapshotFromVMSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) CreateSnapshotFromVMSnapshotAsync(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*Job, error) {
+	r, err := s.CreateSnapshotFromVMSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createSnapshotFromVMSnapshot", r.JobID), nil
+}
+
 type CreateSnapshotFromVMSnapshotResponse struct {
-	Account         string            `json:"account"`
-	Chainsize       int64             `json:"chainsize"`
-	Created         string            `json:"created"`
-	Datastoreid     string            `json:"datastoreid"`
-	Datastorename   string            `json:"datastorename"`
-	Datastorestate  string            `json:"datastorestate"`
-	Datastoretype   string            `json:"datastoretype"`
-	Domain          string            `json:"domain"`
-	Domainid        string            `json:"domainid"`
-	Domainpath      string            `json:"domainpath"`
-	Downloaddetails map[string]string `json:"downloaddetails"`
-	Hasannotations  bool              `json:"hasannotations"`
-	Id              string            `json:"id"`
-	Intervaltype    string            `json:"intervaltype"`
-	JobID           string            `json:"jobid"`
-	Jobstatus       int               `json:"jobstatus"`
-	Locationtype    string            `json:"locationtype"`
-	Name            string            `json:"name"`
-	Osdisplayname   string            `json:"osdisplayname"`
-	Ostypeid        string            `json:"ostypeid"`
-	Parent          string            `json:"parent"`
-	Parentname      string            `json:"parentname"`
-	Physicalsize    int64             `json:"physicalsize"`
-	Project         string            `json:"project"`
-	Projectid       string            `json:"projectid"`
-	Revertable      bool              `json:"revertable"`
-	Snapshottype    string            `json:"snapshottype"`
-	State           string            `json:"state"`
-	Status          string            `json:"status"`
-	Tags            []Tags            `json:"tags"`
-	Virtualsize     int64             `json:"virtualsize"`
-	Volumeid        string            `json:"volumeid"`
-	Volumename      string            `json:"volumename"`
-	Volumestate     string            `json:"volumestate"`
-	Volumetype      string            `json:"volumetype"`
-	Zoneid          string            `json:"zoneid"`
-	Zonename        string            `json:"zonename"`
+	Account         string                     `json:"account"`
+	Chainsize       FlexInt64                  `json:"chainsize"`
+	Created         Time                       `json:"created"`
+	Datastoreid     string                     `json:"datastoreid"`
+	Datastorename   string                     `json:"datastorename"`
+	Datastorestate  string                     `json:"datastorestate"`
+	Datastoretype   string                     `json:"datastoretype"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Downloaddetails map[string]string          `json:"downloaddetails"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	Intervaltype    string                     `json:"intervaltype"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Locationtype    string                     `json:"locationtype"`
+	Name            string                     `json:"name"`
+	Osdisplayname   string                     `json:"osdisplayname"`
+	Ostypeid        string                     `json:"ostypeid"`
+	Parent          string                     `json:"parent"`
+	Parentname      string                     `json:"parentname"`
+	Physicalsize    FlexInt64                  `json:"physicalsize"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Revertable      FlexBool                   `json:"revertable"`
+	Snapshottype    string                     `json:"snapshottype"`
+	State           string                     `json:"state"`
+	Status          string                     `json:"status"`
+	Tags            []Tags                     `json:"tags"`
+	Virtualsize     FlexInt64                  `json:"virtualsize"`
+	Volumeid        string                     `json:"volumeid"`
+	Volumename      string                     `json:"volumename"`
+	Volumestate     string                     `json:"volumestate"`
+	Volumetype      string                     `json:"volumetype"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CreateSnapshotFromVMSnapshotResponse) UnmarshalJSON(b []byte) error {
@@ -1097,7 +1421,7 @@ func (r *CreateSnapshotFromVMSnapshotResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CreateSnapshotFromVMSnapshotResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateSnapshotPolicyParams struct {
@@ -1114,7 +1438,7 @@ func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
 		u.Set("fordisplay", vv)
 	}
 	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
+		u.Set("intervaltype", string(v.(IntervalType)))
 	}
 	if v, found := p.p["maxsnaps"]; found {
 		vv := strconv.Itoa(v.(int))
@@ -1151,6 +1475,53 @@ func (p *CreateSnapshotPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createSnapshotPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateSnapshotPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createSnapshotPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createSnapshotPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateSnapshotPolicyParams) Validate() error {
+	return paramRules{
+		required: []string{"intervaltype", "maxsnaps", "schedule", "timezone", "volumeid"},
+		lengths:  map[string]int{"intervaltype": 255, "schedule": 255, "timezone": 255, "volumeid": 255},
+	}.validate("createSnapshotPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createSnapshotPolicy API, before the request is signed
+func (p *CreateSnapshotPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateSnapshotPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateSnapshotPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createSnapshotPolicy", b, &p.p, map[string]paramDecoder{
+		"fordisplay":            decodeParam[bool],
+		"intervaltype":          decodeParam[IntervalType],
+		"maxsnaps":              decodeParam[int],
+		"schedule":              decodeParam[string],
+		"storageids":            decodeParam[[]string],
+		"tags":                  decodeParam[map[string]string],
+		"timezone":              decodeParam[string],
+		"usestoragereplication": decodeParam[bool],
+		"volumeid":              decodeParam[string],
+		"zoneids":               decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateSnapshotPolicyParams) Clone() *CreateSnapshotPolicyParams {
+	return &CreateSnapshotPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateSnapshotPolicyParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1172,7 +1543,7 @@ func (p *CreateSnapshotPolicyParams) GetFordisplay() (bool, bool) {
 	return value, ok
 }
 
-func (p *CreateSnapshotPolicyParams) SetIntervaltype(v string) {
+func (p *CreateSnapshotPolicyParams) SetIntervaltype(v IntervalType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1185,11 +1556,11 @@ func (p *CreateSnapshotPolicyParams) ResetIntervaltype() {
 	}
 }
 
-func (p *CreateSnapshotPolicyParams) GetIntervaltype() (string, bool) {
+func (p *CreateSnapshotPolicyParams) GetIntervaltype() (IntervalType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["intervaltype"].(string)
+	value, ok := p.p["intervaltype"].(IntervalType)
 	return value, ok
 }
 
@@ -1363,7 +1734,7 @@ func (p *CreateSnapshotPolicyParams) GetZoneids() ([]string, bool) {
 
 // You should always use this function to get a new CreateSnapshotPolicyParams instance,
 // as then you are sure you have configured all required params
-func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams {
+func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype IntervalType, maxsnaps int, schedule string, timezone string, volumeid string) *CreateSnapshotPolicyParams {
 	p := &CreateSnapshotPolicyParams{}
 	p.p = make(map[string]interface{})
 	p.p["intervaltype"] = intervaltype
@@ -1376,13 +1747,22 @@ func (s *SnapshotService) NewCreateSnapshotPolicyParams(intervaltype string, max
 
 // Creates a Snapshot policy for the account.
 func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("createSnapshotPolicy", p.toURLValues())
+	return s.CreateSnapshotPolicyWithContext(context.Background(), p)
+}
+
+// CreateSnapshotPolicyWithContext is like CreateSnapshotPolicy, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) CreateSnapshotPolicyWithContext(ctx context.Context, p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createSnapshotPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateSnapshotPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createSnapshotPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1390,20 +1770,26 @@ func (s *SnapshotService) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*
 }
 
 type CreateSnapshotPolicyResponse struct {
-	Fordisplay     bool          `json:"fordisplay"`
-	Hasannotations bool          `json:"hasannotations"`
-	Id             string        `json:"id"`
-	Intervaltype   int           `json:"intervaltype"`
-	JobID          string        `json:"jobid"`
-	Jobstatus      int           `json:"jobstatus"`
-	Maxsnaps       int           `json:"maxsnaps"`
-	Schedule       string        `json:"schedule"`
-	Storage        []interface{} `json:"storage"`
-	Tags           []Tags        `json:"tags"`
-	Timezone       string        `json:"timezone"`
-	Volumeid       string        `json:"volumeid"`
-	Volumename     string        `json:"volumename"`
-	Zone           []interface{} `json:"zone"`
+	Fordisplay     FlexBool                   `json:"fordisplay"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	Intervaltype   FlexInt                    `json:"intervaltype"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Maxsnaps       FlexInt                    `json:"maxsnaps"`
+	Schedule       string                     `json:"schedule"`
+	Storage        []interface{}              `json:"storage"`
+	Tags           []Tags                     `json:"tags"`
+	Timezone       string                     `json:"timezone"`
+	Volumeid       string                     `json:"volumeid"`
+	Volumename     string                     `json:"volumename"`
+	Zone           []interface{}              `json:"zone"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateSnapshotPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateSnapshotPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVMSnapshotParams struct {
@@ -1435,6 +1821,48 @@ func (p *CreateVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVMSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVMSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVMSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVMSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVMSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"description": 4096, "name": 255, "virtualmachineid": 255},
+	}.validate("createVMSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVMSnapshot API, before the request is signed
+func (p *CreateVMSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVMSnapshot", b, &p.p, map[string]paramDecoder{
+		"description":      decodeParam[string],
+		"name":             decodeParam[string],
+		"quiescevm":        decodeParam[bool],
+		"snapshotmemory":   decodeParam[bool],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVMSnapshotParams) Clone() *CreateVMSnapshotParams {
+	return &CreateVMSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVMSnapshotParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1551,32 +1979,39 @@ func (s *SnapshotService) NewCreateVMSnapshotParams(virtualmachineid string) *Cr
 
 // Creates Snapshot for an Instance.
 func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("createVMSnapshot", p.toURLValues())
+	return s.CreateVMSnapshotWithContext(context.Background(), p)
+}
+
+// CreateVMSnapshotWithContext is like CreateVMSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVMSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVMSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1584,32 +2019,57 @@ func (s *SnapshotService) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVM
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVMSnapshot async job into r
+func (r *CreateVMSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVMSnapshotAsync starts the async job of CreateVMSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) CreateVMSnapshotAsync(ctx context.Context, p *CreateVMSnapshotParams) (*Job, error) {
+	r, err := s.CreateVMSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVMSnapshot", r.JobID), nil
+}
+
 type CreateVMSnapshotResponse struct {
-	Account            string `json:"account"`
-	Created            string `json:"created"`
-	Current            bool   `json:"current"`
-	Description        string `json:"description"`
-	Displayname        string `json:"displayname"`
-	Domain             string `json:"domain"`
-	Domainid           string `json:"domainid"`
-	Domainpath         string `json:"domainpath"`
-	Hasannotations     bool   `json:"hasannotations"`
-	Hypervisor         string `json:"hypervisor"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Name               string `json:"name"`
-	Parent             string `json:"parent"`
-	ParentName         string `json:"parentName"`
-	Project            string `json:"project"`
-	Projectid          string `json:"projectid"`
-	State              string `json:"state"`
-	Tags               []Tags `json:"tags"`
-	Type               string `json:"type"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
-	Zoneid             string `json:"zoneid"`
-	Zonename           string `json:"zonename"`
+	Account            string                     `json:"account"`
+	Created            Time                       `json:"created"`
+	Current            FlexBool                   `json:"current"`
+	Description        string                     `json:"description"`
+	Displayname        string                     `json:"displayname"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Hasannotations     FlexBool                   `json:"hasannotations"`
+	Hypervisor         string                     `json:"hypervisor"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Name               string                     `json:"name"`
+	Parent             string                     `json:"parent"`
+	ParentName         string                     `json:"parentName"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	State              string                     `json:"state"`
+	Tags               []Tags                     `json:"tags"`
+	Type               string                     `json:"type"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Zoneid             string                     `json:"zoneid"`
+	Zonename           string                     `json:"zonename"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVMSnapshotResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVMSnapshotResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteSnapshotParams struct {
@@ -1630,6 +2090,45 @@ func (p *DeleteSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "zoneid": 255},
+	}.validate("deleteSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteSnapshot API, before the request is signed
+func (p *DeleteSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteSnapshot", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteSnapshotParams) Clone() *DeleteSnapshotParams {
+	return &DeleteSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1683,27 +2182,39 @@ func (s *SnapshotService) NewDeleteSnapshotParams(id string) *DeleteSnapshotPara
 
 // Deletes a Snapshot of a disk volume.
 func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteSnapshot", p.toURLValues())
+	return s.DeleteSnapshotWithContext(context.Background(), p)
+}
+
+// DeleteSnapshotWithContext is like DeleteSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1711,11 +2222,31 @@ func (s *SnapshotService) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapsh
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteSnapshot async job into r
+func (r *DeleteSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteSnapshotAsync starts the async job of DeleteSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) DeleteSnapshotAsync(ctx context.Context, p *DeleteSnapshotParams) (*Job, error) {
+	r, err := s.DeleteSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteSnapshot", r.JobID), nil
+}
+
 type DeleteSnapshotResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteSnapshotResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteSnapshotResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteSnapshotPoliciesParams struct {
@@ -1737,6 +2268,44 @@ func (p *DeleteSnapshotPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteSnapshotPolicies API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteSnapshotPoliciesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteSnapshotPolicies", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteSnapshotPolicies API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteSnapshotPoliciesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("deleteSnapshotPolicies", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteSnapshotPolicies API, before the request is signed
+func (p *DeleteSnapshotPoliciesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteSnapshotPoliciesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteSnapshotPoliciesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteSnapshotPolicies", b, &p.p, map[string]paramDecoder{
+		"id":  decodeParam[string],
+		"ids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteSnapshotPoliciesParams) Clone() *DeleteSnapshotPoliciesParams {
+	return &DeleteSnapshotPoliciesParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteSnapshotPoliciesParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1789,13 +2358,22 @@ func (s *SnapshotService) NewDeleteSnapshotPoliciesParams() *DeleteSnapshotPolic
 
 // Deletes Snapshot policies for the account.
 func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteSnapshotPolicies", p.toURLValues())
+	return s.DeleteSnapshotPoliciesWithContext(context.Background(), p)
+}
+
+// DeleteSnapshotPoliciesWithContext is like DeleteSnapshotPolicies, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) DeleteSnapshotPoliciesWithContext(ctx context.Context, p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteSnapshotPolicies", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteSnapshotPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteSnapshotPolicies", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1803,10 +2381,11 @@ func (s *SnapshotService) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams
 }
 
 type DeleteSnapshotPoliciesResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteSnapshotPoliciesResponse) UnmarshalJSON(b []byte) error {
@@ -1833,7 +2412,7 @@ func (r *DeleteSnapshotPoliciesResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteSnapshotPoliciesResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVMSnapshotParams struct {
@@ -1851,6 +2430,44 @@ func (p *DeleteVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVMSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVMSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVMSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVMSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVMSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"vmsnapshotid"},
+		lengths:  map[string]int{"vmsnapshotid": 255},
+	}.validate("deleteVMSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVMSnapshot API, before the request is signed
+func (p *DeleteVMSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVMSnapshot", b, &p.p, map[string]paramDecoder{
+		"vmsnapshotid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVMSnapshotParams) Clone() *DeleteVMSnapshotParams {
+	return &DeleteVMSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1883,27 +2500,39 @@ func (s *SnapshotService) NewDeleteVMSnapshotParams(vmsnapshotid string) *Delete
 
 // Deletes an Instance Snapshot.
 func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVMSnapshot", p.toURLValues())
+	return s.DeleteVMSnapshotWithContext(context.Background(), p)
+}
+
+// DeleteVMSnapshotWithContext is like DeleteVMSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteVMSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteVMSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1911,11 +2540,31 @@ func (s *SnapshotService) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVM
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteVMSnapshot async job into r
+func (r *DeleteVMSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteVMSnapshotAsync starts the async job of DeleteVMSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) DeleteVMSnapshotAsync(ctx context.Context, p *DeleteVMSnapshotParams) (*Job, error) {
+	r, err := s.DeleteVMSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteVMSnapshot", r.JobID), nil
+}
+
 type DeleteVMSnapshotResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteVMSnapshotResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteVMSnapshotResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExtractSnapshotParams struct {
@@ -1936,6 +2585,45 @@ func (p *ExtractSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the extractSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExtractSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("extractSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the extractSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExtractSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "zoneid"},
+		lengths:  map[string]int{"id": 255, "zoneid": 255},
+	}.validate("extractSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the extractSnapshot API, before the request is signed
+func (p *ExtractSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExtractSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExtractSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("extractSnapshot", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExtractSnapshotParams) Clone() *ExtractSnapshotParams {
+	return &ExtractSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *ExtractSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1990,32 +2678,39 @@ func (s *SnapshotService) NewExtractSnapshotParams(id string, zoneid string) *Ex
 
 // Returns a download URL for extracting a snapshot. It must be in the Backed Up state.
 func (s *SnapshotService) ExtractSnapshot(p *ExtractSnapshotParams) (*ExtractSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("extractSnapshot", p.toURLValues())
+	return s.ExtractSnapshotWithContext(context.Background(), p)
+}
+
+// ExtractSnapshotWithContext is like ExtractSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ExtractSnapshotWithContext(ctx context.Context, p *ExtractSnapshotParams) (*ExtractSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "extractSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExtractSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("extractSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "extractSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("extractSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2023,23 +2718,48 @@ func (s *SnapshotService) ExtractSnapshot(p *ExtractSnapshotParams) (*ExtractSna
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ExtractSnapshot async job into r
+func (r *ExtractSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ExtractSnapshotAsync starts the async job of ExtractSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) ExtractSnapshotAsync(ctx context.Context, p *ExtractSnapshotParams) (*Job, error) {
+	r, err := s.ExtractSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("extractSnapshot", r.JobID), nil
+}
+
 type ExtractSnapshotResponse struct {
-	Accountid        string `json:"accountid"`
-	Created          string `json:"created"`
-	ExtractId        string `json:"extractId"`
-	ExtractMode      string `json:"extractMode"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Resultstring     string `json:"resultstring"`
-	State            string `json:"state"`
-	Status           string `json:"status"`
-	Storagetype      string `json:"storagetype"`
-	Uploadpercentage int    `json:"uploadpercentage"`
-	Url              string `json:"url"`
-	Zoneid           string `json:"zoneid"`
-	Zonename         string `json:"zonename"`
+	Accountid        string                     `json:"accountid"`
+	Created          Time                       `json:"created"`
+	ExtractId        string                     `json:"extractId"`
+	ExtractMode      string                     `json:"extractMode"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Resultstring     string                     `json:"resultstring"`
+	State            string                     `json:"state"`
+	Status           string                     `json:"status"`
+	Storagetype      string                     `json:"storagetype"`
+	Uploadpercentage FlexInt                    `json:"uploadpercentage"`
+	Url              string                     `json:"url"`
+	Zoneid           string                     `json:"zoneid"`
+	Zonename         string                     `json:"zonename"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExtractSnapshotResponse) UnmarshalJSON(b []byte) error {
+	type alias ExtractSnapshotResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSnapshotPoliciesParams struct {
@@ -2092,6 +2812,53 @@ func (p *ListSnapshotPoliciesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSnapshotPolicies API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSnapshotPoliciesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSnapshotPolicies", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSnapshotPolicies API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSnapshotPoliciesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "volumeid": 255},
+	}.validate("listSnapshotPolicies", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSnapshotPolicies API, before the request is signed
+func (p *ListSnapshotPoliciesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSnapshotPoliciesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSnapshotPoliciesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSnapshotPolicies", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"volumeid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSnapshotPoliciesParams) Clone() *ListSnapshotPoliciesParams {
+	return &ListSnapshotPoliciesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSnapshotPoliciesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2333,6 +3100,11 @@ func (s *SnapshotService) NewListSnapshotPoliciesParams() *ListSnapshotPoliciesP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
+	return s.GetSnapshotPolicyByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSnapshotPolicyByIDWithContext is like GetSnapshotPolicyByID, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) GetSnapshotPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
 	p := &ListSnapshotPoliciesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2344,11 +3116,10 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListSnapshotPolicies(p)
+	l, err := s.ListSnapshotPoliciesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2366,39 +3137,82 @@ func (s *SnapshotService) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (
 
 // Lists Snapshot policies.
 func (s *SnapshotService) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshotPolicies", p.toURLValues())
+	return s.ListSnapshotPoliciesWithContext(context.Background(), p)
+}
+
+// ListSnapshotPoliciesWithContext is like ListSnapshotPolicies, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListSnapshotPoliciesWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSnapshotPolicies", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSnapshotPoliciesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSnapshotPolicies", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSnapshotPoliciesAll returns all SnapshotPolicies matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SnapshotService) ListSnapshotPoliciesAll(p *ListSnapshotPoliciesParams, opts ...PageOption) ([]*SnapshotPolicy, error) {
+	return s.ListSnapshotPoliciesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSnapshotPoliciesAllWithContext is like ListSnapshotPoliciesAll, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListSnapshotPoliciesAllWithContext(ctx context.Context, p *ListSnapshotPoliciesParams, opts ...PageOption) ([]*SnapshotPolicy, error) {
+	return collectPages(s.ListSnapshotPoliciesIterWithContext(ctx, p, opts...))
+}
+
+// ListSnapshotPoliciesIter returns an iterator over all SnapshotPolicies matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SnapshotService) ListSnapshotPoliciesIter(p *ListSnapshotPoliciesParams, opts ...PageOption) iter.Seq2[*SnapshotPolicy, error] {
+	return s.ListSnapshotPoliciesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSnapshotPoliciesIterWithContext is like ListSnapshotPoliciesIter, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListSnapshotPoliciesIterWithContext(ctx context.Context, p *ListSnapshotPoliciesParams, opts ...PageOption) iter.Seq2[*SnapshotPolicy, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SnapshotPolicy, int, error) {
+		l, err := s.ListSnapshotPoliciesWithContext(ctx, &ListSnapshotPoliciesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SnapshotPolicies, l.Count, nil
+	}, opts...)
+}
+
 type ListSnapshotPoliciesResponse struct {
 	Count            int               `json:"count"`
 	SnapshotPolicies []*SnapshotPolicy `json:"snapshotpolicy"`
 }
 
 type SnapshotPolicy struct {
-	Fordisplay     bool          `json:"fordisplay"`
-	Hasannotations bool          `json:"hasannotations"`
-	Id             string        `json:"id"`
-	Intervaltype   int           `json:"intervaltype"`
-	JobID          string        `json:"jobid"`
-	Jobstatus      int           `json:"jobstatus"`
-	Maxsnaps       int           `json:"maxsnaps"`
-	Schedule       string        `json:"schedule"`
-	Storage        []interface{} `json:"storage"`
-	Tags           []Tags        `json:"tags"`
-	Timezone       string        `json:"timezone"`
-	Volumeid       string        `json:"volumeid"`
-	Volumename     string        `json:"volumename"`
-	Zone           []interface{} `json:"zone"`
+	Fordisplay     FlexBool                   `json:"fordisplay"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	Intervaltype   FlexInt                    `json:"intervaltype"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Maxsnaps       FlexInt                    `json:"maxsnaps"`
+	Schedule       string                     `json:"schedule"`
+	Storage        []interface{}              `json:"storage"`
+	Tags           []Tags                     `json:"tags"`
+	Timezone       string                     `json:"timezone"`
+	Volumeid       string                     `json:"volumeid"`
+	Volumename     string                     `json:"volumename"`
+	Zone           []interface{}              `json:"zone"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SnapshotPolicy) UnmarshalJSON(b []byte) error {
+	type alias SnapshotPolicy
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSnapshotsParams struct {
@@ -2427,7 +3241,7 @@ func (p *ListSnapshotsParams) toURLValues() url.Values {
 		u.Set("imagestoreid", v.(string))
 	}
 	if v, found := p.p["intervaltype"]; found {
-		u.Set("intervaltype", v.(string))
+		u.Set("intervaltype", string(v.(IntervalType)))
 	}
 	if v, found := p.p["isrecursive"]; found {
 		vv := strconv.FormatBool(v.(bool))
@@ -2483,6 +3297,62 @@ func (p *ListSnapshotsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSnapshots API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSnapshotsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSnapshots", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSnapshots API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSnapshotsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "imagestoreid": 255, "intervaltype": 255, "keyword": 255, "locationtype": 255, "name": 255, "projectid": 255, "snapshottype": 255, "storageid": 255, "volumeid": 255, "zoneid": 255},
+	}.validate("listSnapshots", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSnapshots API, before the request is signed
+func (p *ListSnapshotsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSnapshotsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSnapshotsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSnapshots", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"domainid":     decodeParam[string],
+		"id":           decodeParam[string],
+		"ids":          decodeParam[[]string],
+		"imagestoreid": decodeParam[string],
+		"intervaltype": decodeParam[IntervalType],
+		"isrecursive":  decodeParam[bool],
+		"keyword":      decodeParam[string],
+		"listall":      decodeParam[bool],
+		"locationtype": decodeParam[string],
+		"name":         decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"projectid":    decodeParam[string],
+		"showunique":   decodeParam[bool],
+		"snapshottype": decodeParam[string],
+		"storageid":    decodeParam[string],
+		"tags":         decodeParam[map[string]string],
+		"volumeid":     decodeParam[string],
+		"zoneid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSnapshotsParams) Clone() *ListSnapshotsParams {
+	return &ListSnapshotsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSnapshotsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2588,7 +3458,7 @@ func (p *ListSnapshotsParams) GetImagestoreid() (string, bool) {
 	return value, ok
 }
 
-func (p *ListSnapshotsParams) SetIntervaltype(v string) {
+func (p *ListSnapshotsParams) SetIntervaltype(v IntervalType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -2601,11 +3471,11 @@ func (p *ListSnapshotsParams) ResetIntervaltype() {
 	}
 }
 
-func (p *ListSnapshotsParams) GetIntervaltype() (string, bool) {
+func (p *ListSnapshotsParams) GetIntervaltype() (IntervalType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["intervaltype"].(string)
+	value, ok := p.p["intervaltype"].(IntervalType)
 	return value, ok
 }
 
@@ -2913,6 +3783,11 @@ func (s *SnapshotService) NewListSnapshotsParams() *ListSnapshotsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSnapshotIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSnapshotIDWithContext is like GetSnapshotID, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) GetSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSnapshotsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2924,7 +3799,7 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListSnapshots(p)
+	l, err := s.ListSnapshotsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2949,12 +3824,17 @@ func (s *SnapshotService) GetSnapshotID(name string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error) {
-	id, count, err := s.GetSnapshotID(name, opts...)
+	return s.GetSnapshotByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSnapshotByNameWithContext is like GetSnapshotByName, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) GetSnapshotByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Snapshot, int, error) {
+	id, count, err := s.GetSnapshotIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSnapshotByID(id, opts...)
+	r, count, err := s.GetSnapshotByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2963,6 +3843,11 @@ func (s *SnapshotService) GetSnapshotByName(name string, opts ...OptionFunc) (*S
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error) {
+	return s.GetSnapshotByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSnapshotByIDWithContext is like GetSnapshotByID, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) GetSnapshotByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Snapshot, int, error) {
 	p := &ListSnapshotsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2974,11 +3859,10 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 		}
 	}
 
-	l, err := s.ListSnapshots(p)
+	l, err := s.ListSnapshotsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2996,62 +3880,100 @@ func (s *SnapshotService) GetSnapshotByID(id string, opts ...OptionFunc) (*Snaps
 
 // Lists all available snapshots for the account.
 func (s *SnapshotService) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
-	resp, err := s.cs.newRequest("listSnapshots", p.toURLValues())
+	return s.ListSnapshotsWithContext(context.Background(), p)
+}
+
+// ListSnapshotsWithContext is like ListSnapshots, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSnapshots", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSnapshotsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSnapshots", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSnapshotsAll returns all Snapshots matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SnapshotService) ListSnapshotsAll(p *ListSnapshotsParams, opts ...PageOption) ([]*Snapshot, error) {
+	return s.ListSnapshotsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSnapshotsAllWithContext is like ListSnapshotsAll, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListSnapshotsAllWithContext(ctx context.Context, p *ListSnapshotsParams, opts ...PageOption) ([]*Snapshot, error) {
+	return collectPages(s.ListSnapshotsIterWithContext(ctx, p, opts...))
+}
+
+// ListSnapshotsIter returns an iterator over all Snapshots matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SnapshotService) ListSnapshotsIter(p *ListSnapshotsParams, opts ...PageOption) iter.Seq2[*Snapshot, error] {
+	return s.ListSnapshotsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSnapshotsIterWithContext is like ListSnapshotsIter, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListSnapshotsIterWithContext(ctx context.Context, p *ListSnapshotsParams, opts ...PageOption) iter.Seq2[*Snapshot, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Snapshot, int, error) {
+		l, err := s.ListSnapshotsWithContext(ctx, &ListSnapshotsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Snapshots, l.Count, nil
+	}, opts...)
+}
+
 type ListSnapshotsResponse struct {
 	Count     int         `json:"count"`
 	Snapshots []*Snapshot `json:"snapshot"`
 }
 
 type Snapshot struct {
-	Account         string            `json:"account"`
-	Chainsize       int64             `json:"chainsize"`
-	Created         string            `json:"created"`
-	Datastoreid     string            `json:"datastoreid"`
-	Datastorename   string            `json:"datastorename"`
-	Datastorestate  string            `json:"datastorestate"`
-	Datastoretype   string            `json:"datastoretype"`
-	Domain          string            `json:"domain"`
-	Domainid        string            `json:"domainid"`
-	Domainpath      string            `json:"domainpath"`
-	Downloaddetails map[string]string `json:"downloaddetails"`
-	Hasannotations  bool              `json:"hasannotations"`
-	Id              string            `json:"id"`
-	Intervaltype    string            `json:"intervaltype"`
-	JobID           string            `json:"jobid"`
-	Jobstatus       int               `json:"jobstatus"`
-	Locationtype    string            `json:"locationtype"`
-	Name            string            `json:"name"`
-	Osdisplayname   string            `json:"osdisplayname"`
-	Ostypeid        string            `json:"ostypeid"`
-	Parent          string            `json:"parent"`
-	Parentname      string            `json:"parentname"`
-	Physicalsize    int64             `json:"physicalsize"`
-	Project         string            `json:"project"`
-	Projectid       string            `json:"projectid"`
-	Revertable      bool              `json:"revertable"`
-	Snapshottype    string            `json:"snapshottype"`
-	State           string            `json:"state"`
-	Status          string            `json:"status"`
-	Tags            []Tags            `json:"tags"`
-	Virtualsize     int64             `json:"virtualsize"`
-	Volumeid        string            `json:"volumeid"`
-	Volumename      string            `json:"volumename"`
-	Volumestate     string            `json:"volumestate"`
-	Volumetype      string            `json:"volumetype"`
-	Zoneid          string            `json:"zoneid"`
-	Zonename        string            `json:"zonename"`
+	Account         string                     `json:"account"`
+	Chainsize       FlexInt64                  `json:"chainsize"`
+	Created         Time                       `json:"created"`
+	Datastoreid     string                     `json:"datastoreid"`
+	Datastorename   string                     `json:"datastorename"`
+	Datastorestate  string                     `json:"datastorestate"`
+	Datastoretype   string                     `json:"datastoretype"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Downloaddetails map[string]string          `json:"downloaddetails"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	Intervaltype    string                     `json:"intervaltype"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Locationtype    string                     `json:"locationtype"`
+	Name            string                     `json:"name"`
+	Osdisplayname   string                     `json:"osdisplayname"`
+	Ostypeid        string                     `json:"ostypeid"`
+	Parent          string                     `json:"parent"`
+	Parentname      string                     `json:"parentname"`
+	Physicalsize    FlexInt64                  `json:"physicalsize"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Revertable      FlexBool                   `json:"revertable"`
+	Snapshottype    string                     `json:"snapshottype"`
+	State           string                     `json:"state"`
+	Status          string                     `json:"status"`
+	Tags            []Tags                     `json:"tags"`
+	Virtualsize     FlexInt64                  `json:"virtualsize"`
+	Volumeid        string                     `json:"volumeid"`
+	Volumename      string                     `json:"volumename"`
+	Volumestate     string                     `json:"volumestate"`
+	Volumetype      string                     `json:"volumetype"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 func (r *Snapshot) UnmarshalJSON(b []byte) error {
@@ -3078,7 +4000,7 @@ func (r *Snapshot) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias Snapshot
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVMSnapshotParams struct {
@@ -3144,6 +4066,56 @@ func (p *ListVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVMSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVMSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVMSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVMSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVMSnapshotParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "keyword": 255, "name": 255, "projectid": 255, "state": 255, "virtualmachineid": 255, "vmsnapshotid": 255},
+	}.validate("listVMSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVMSnapshot API, before the request is signed
+func (p *ListVMSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVMSnapshot", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"isrecursive":      decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"name":             decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"projectid":        decodeParam[string],
+		"state":            decodeParam[string],
+		"tags":             decodeParam[map[string]string],
+		"virtualmachineid": decodeParam[string],
+		"vmsnapshotid":     decodeParam[string],
+		"vmsnapshotids":    decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVMSnapshotParams) Clone() *ListVMSnapshotParams {
+	return &ListVMSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVMSnapshotParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3448,6 +4420,11 @@ func (s *SnapshotService) NewListVMSnapshotParams() *ListVMSnapshotParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVMSnapshotIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVMSnapshotIDWithContext is like GetVMSnapshotID, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) GetVMSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVMSnapshotParams{}
 	p.p = make(map[string]interface{})
 
@@ -3459,7 +4436,7 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 		}
 	}
 
-	l, err := s.ListVMSnapshot(p)
+	l, err := s.ListVMSnapshotWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3484,50 +4461,93 @@ func (s *SnapshotService) GetVMSnapshotID(name string, opts ...OptionFunc) (stri
 
 // List Instance Snapshot by conditions
 func (s *SnapshotService) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
-	resp, err := s.cs.newRequest("listVMSnapshot", p.toURLValues())
+	return s.ListVMSnapshotWithContext(context.Background(), p)
+}
+
+// ListVMSnapshotWithContext is like ListVMSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVMSnapshotAll returns all VMSnapshot matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SnapshotService) ListVMSnapshotAll(p *ListVMSnapshotParams, opts ...PageOption) ([]*VMSnapshot, error) {
+	return s.ListVMSnapshotAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVMSnapshotAllWithContext is like ListVMSnapshotAll, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListVMSnapshotAllWithContext(ctx context.Context, p *ListVMSnapshotParams, opts ...PageOption) ([]*VMSnapshot, error) {
+	return collectPages(s.ListVMSnapshotIterWithContext(ctx, p, opts...))
+}
+
+// ListVMSnapshotIter returns an iterator over all VMSnapshot matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SnapshotService) ListVMSnapshotIter(p *ListVMSnapshotParams, opts ...PageOption) iter.Seq2[*VMSnapshot, error] {
+	return s.ListVMSnapshotIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVMSnapshotIterWithContext is like ListVMSnapshotIter, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) ListVMSnapshotIterWithContext(ctx context.Context, p *ListVMSnapshotParams, opts ...PageOption) iter.Seq2[*VMSnapshot, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VMSnapshot, int, error) {
+		l, err := s.ListVMSnapshotWithContext(ctx, &ListVMSnapshotParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VMSnapshot, l.Count, nil
+	}, opts...)
+}
+
 type ListVMSnapshotResponse struct {
 	Count      int           `json:"count"`
 	VMSnapshot []*VMSnapshot `json:"vmsnapshot"`
 }
 
 type VMSnapshot struct {
-	Account            string `json:"account"`
-	Created            string `json:"created"`
-	Current            bool   `json:"current"`
-	Description        string `json:"description"`
-	Displayname        string `json:"displayname"`
-	Domain             string `json:"domain"`
-	Domainid           string `json:"domainid"`
-	Domainpath         string `json:"domainpath"`
-	Hasannotations     bool   `json:"hasannotations"`
-	Hypervisor         string `json:"hypervisor"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Name               string `json:"name"`
-	Parent             string `json:"parent"`
-	ParentName         string `json:"parentName"`
-	Project            string `json:"project"`
-	Projectid          string `json:"projectid"`
-	State              string `json:"state"`
-	Tags               []Tags `json:"tags"`
-	Type               string `json:"type"`
-	Virtualmachineid   string `json:"virtualmachineid"`
-	Virtualmachinename string `json:"virtualmachinename"`
-	Zoneid             string `json:"zoneid"`
-	Zonename           string `json:"zonename"`
+	Account            string                     `json:"account"`
+	Created            Time                       `json:"created"`
+	Current            FlexBool                   `json:"current"`
+	Description        string                     `json:"description"`
+	Displayname        string                     `json:"displayname"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Hasannotations     FlexBool                   `json:"hasannotations"`
+	Hypervisor         string                     `json:"hypervisor"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Name               string                     `json:"name"`
+	Parent             string                     `json:"parent"`
+	ParentName         string                     `json:"parentName"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	State              string                     `json:"state"`
+	Tags               []Tags                     `json:"tags"`
+	Type               string                     `json:"type"`
+	Virtualmachineid   string                     `json:"virtualmachineid"`
+	Virtualmachinename string                     `json:"virtualmachinename"`
+	Zoneid             string                     `json:"zoneid"`
+	Zonename           string                     `json:"zonename"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VMSnapshot) UnmarshalJSON(b []byte) error {
+	type alias VMSnapshot
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevertSnapshotParams struct {
@@ -3545,6 +4565,44 @@ func (p *RevertSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the revertSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RevertSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("revertSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the revertSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RevertSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("revertSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the revertSnapshot API, before the request is signed
+func (p *RevertSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RevertSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RevertSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("revertSnapshot", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RevertSnapshotParams) Clone() *RevertSnapshotParams {
+	return &RevertSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *RevertSnapshotParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3577,32 +4635,39 @@ func (s *SnapshotService) NewRevertSnapshotParams(id string) *RevertSnapshotPara
 
 // This is supposed to revert a volume Snapshot. This command is only supported with KVM so far
 func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("revertSnapshot", p.toURLValues())
+	return s.RevertSnapshotWithContext(context.Background(), p)
+}
+
+// RevertSnapshotWithContext is like RevertSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "revertSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RevertSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("revertSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "revertSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("revertSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3610,44 +4675,64 @@ func (s *SnapshotService) RevertSnapshot(p *RevertSnapshotParams) (*RevertSnapsh
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RevertSnapshot async job into r
+func (r *RevertSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RevertSnapshotAsync starts the async job of RevertSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) RevertSnapshotAsync(ctx context.Context, p *RevertSnapshotParams) (*Job, error) {
+	r, err := s.RevertSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("revertSnapshot", r.JobID), nil
+}
+
 type RevertSnapshotResponse struct {
-	Account         string            `json:"account"`
-	Chainsize       int64             `json:"chainsize"`
-	Created         string            `json:"created"`
-	Datastoreid     string            `json:"datastoreid"`
-	Datastorename   string            `json:"datastorename"`
-	Datastorestate  string            `json:"datastorestate"`
-	Datastoretype   string            `json:"datastoretype"`
-	Domain          string            `json:"domain"`
-	Domainid        string            `json:"domainid"`
-	Domainpath      string            `json:"domainpath"`
-	Downloaddetails map[string]string `json:"downloaddetails"`
-	Hasannotations  bool              `json:"hasannotations"`
-	Id              string            `json:"id"`
-	Intervaltype    string            `json:"intervaltype"`
-	JobID           string            `json:"jobid"`
-	Jobstatus       int               `json:"jobstatus"`
-	Locationtype    string            `json:"locationtype"`
-	Name            string            `json:"name"`
-	Osdisplayname   string            `json:"osdisplayname"`
-	Ostypeid        string            `json:"ostypeid"`
-	Parent          string            `json:"parent"`
-	Parentname      string            `json:"parentname"`
-	Physicalsize    int64             `json:"physicalsize"`
-	Project         string            `json:"project"`
-	Projectid       string            `json:"projectid"`
-	Revertable      bool              `json:"revertable"`
-	Snapshottype    string            `json:"snapshottype"`
-	State           string            `json:"state"`
-	Status          string            `json:"status"`
-	Tags            []Tags            `json:"tags"`
-	Virtualsize     int64             `json:"virtualsize"`
-	Volumeid        string            `json:"volumeid"`
-	Volumename      string            `json:"volumename"`
-	Volumestate     string            `json:"volumestate"`
-	Volumetype      string            `json:"volumetype"`
-	Zoneid          string            `json:"zoneid"`
-	Zonename        string            `json:"zonename"`
+	Account         string                     `json:"account"`
+	Chainsize       FlexInt64                  `json:"chainsize"`
+	Created         Time                       `json:"created"`
+	Datastoreid     string                     `json:"datastoreid"`
+	Datastorename   string                     `json:"datastorename"`
+	Datastorestate  string                     `json:"datastorestate"`
+	Datastoretype   string                     `json:"datastoretype"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Downloaddetails map[string]string          `json:"downloaddetails"`
+	Hasannotations  FlexBool                   `json:"hasannotations"`
+	Id              string                     `json:"id"`
+	Intervaltype    string                     `json:"intervaltype"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Locationtype    string                     `json:"locationtype"`
+	Name            string                     `json:"name"`
+	Osdisplayname   string                     `json:"osdisplayname"`
+	Ostypeid        string                     `json:"ostypeid"`
+	Parent          string                     `json:"parent"`
+	Parentname      string                     `json:"parentname"`
+	Physicalsize    FlexInt64                  `json:"physicalsize"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Revertable      FlexBool                   `json:"revertable"`
+	Snapshottype    string                     `json:"snapshottype"`
+	State           string                     `json:"state"`
+	Status          string                     `json:"status"`
+	Tags            []Tags                     `json:"tags"`
+	Virtualsize     FlexInt64                  `json:"virtualsize"`
+	Volumeid        string                     `json:"volumeid"`
+	Volumename      string                     `json:"volumename"`
+	Volumestate     string                     `json:"volumestate"`
+	Volumetype      string                     `json:"volumetype"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RevertSnapshotResponse) UnmarshalJSON(b []byte) error {
@@ -3674,7 +4759,7 @@ func (r *RevertSnapshotResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RevertSnapshotResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevertToVMSnapshotParams struct {
@@ -3692,6 +4777,44 @@ func (p *RevertToVMSnapshotParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the revertToVMSnapshot API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RevertToVMSnapshotParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("revertToVMSnapshot", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the revertToVMSnapshot API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RevertToVMSnapshotParams) Validate() error {
+	return paramRules{
+		required: []string{"vmsnapshotid"},
+		lengths:  map[string]int{"vmsnapshotid": 255},
+	}.validate("revertToVMSnapshot", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the revertToVMSnapshot API, before the request is signed
+func (p *RevertToVMSnapshotParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RevertToVMSnapshotParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RevertToVMSnapshotParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("revertToVMSnapshot", b, &p.p, map[string]paramDecoder{
+		"vmsnapshotid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RevertToVMSnapshotParams) Clone() *RevertToVMSnapshotParams {
+	return &RevertToVMSnapshotParams{p: cloneParams(p.p)}
+}
+
 func (p *RevertToVMSnapshotParams) SetVmsnapshotid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3724,32 +4847,39 @@ func (s *SnapshotService) NewRevertToVMSnapshotParams(vmsnapshotid string) *Reve
 
 // Revert Instance from a vmsnapshot.
 func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
-	resp, err := s.cs.newPostRequest("revertToVMSnapshot", p.toURLValues())
+	return s.RevertToVMSnapshotWithContext(context.Background(), p)
+}
+
+// RevertToVMSnapshotWithContext is like RevertToVMSnapshot, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "revertToVMSnapshot", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RevertToVMSnapshotResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("revertToVMSnapshot", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "revertToVMSnapshot", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("revertToVMSnapshot", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3757,6 +4887,25 @@ func (s *SnapshotService) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*Reve
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RevertToVMSnapshot async job into r
+func (r *RevertToVMSnapshotResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RevertToVMSnapshotAsync starts the async job of RevertToVMSnapshot without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) RevertToVMSnapshotAsync(ctx context.Context, p *RevertToVMSnapshotParams) (*Job, error) {
+	r, err := s.RevertToVMSnapshotWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("revertToVMSnapshot", r.JobID), nil
+}
+
 type RevertToVMSnapshotResponse struct {
 	Account               string                                    `json:"account"`
 	Affinitygroup         []RevertToVMSnapshotResponseAffinitygroup `json:"affinitygroup"`
@@ -3768,32 +4917,32 @@ type RevertToVMSnapshotResponse struct {
 	Backupofferingname    string                                    `json:"backupofferingname"`
 	Bootmode              string                                    `json:"bootmode"`
 	Boottype              string                                    `json:"boottype"`
-	Cpunumber             int                                       `json:"cpunumber"`
-	Cpuspeed              int                                       `json:"cpuspeed"`
+	Cpunumber             FlexInt                                   `json:"cpunumber"`
+	Cpuspeed              FlexInt                                   `json:"cpuspeed"`
 	Cpuused               string                                    `json:"cpuused"`
-	Created               string                                    `json:"created"`
-	Deleteprotection      bool                                      `json:"deleteprotection"`
+	Created               Time                                      `json:"created"`
+	Deleteprotection      FlexBool                                  `json:"deleteprotection"`
 	Details               map[string]string                         `json:"details"`
-	Diskioread            int64                                     `json:"diskioread"`
-	Diskiowrite           int64                                     `json:"diskiowrite"`
-	Diskkbsread           int64                                     `json:"diskkbsread"`
-	Diskkbswrite          int64                                     `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                 `json:"diskioread"`
+	Diskiowrite           FlexInt64                                 `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                 `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                 `json:"diskkbswrite"`
 	Diskofferingid        string                                    `json:"diskofferingid"`
 	Diskofferingname      string                                    `json:"diskofferingname"`
 	Displayname           string                                    `json:"displayname"`
-	Displayvm             bool                                      `json:"displayvm"`
+	Displayvm             FlexBool                                  `json:"displayvm"`
 	Domain                string                                    `json:"domain"`
 	Domainid              string                                    `json:"domainid"`
 	Domainpath            string                                    `json:"domainpath"`
-	Forvirtualnetwork     bool                                      `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                  `json:"forvirtualnetwork"`
 	Gpucardid             string                                    `json:"gpucardid"`
 	Gpucardname           string                                    `json:"gpucardname"`
-	Gpucount              int                                       `json:"gpucount"`
+	Gpucount              FlexInt                                   `json:"gpucount"`
 	Group                 string                                    `json:"group"`
 	Groupid               string                                    `json:"groupid"`
 	Guestosid             string                                    `json:"guestosid"`
-	Haenable              bool                                      `json:"haenable"`
-	Hasannotations        bool                                      `json:"hasannotations"`
+	Haenable              FlexBool                                  `json:"haenable"`
+	Hasannotations        FlexBool                                  `json:"hasannotations"`
 	Hostcontrolstate      string                                    `json:"hostcontrolstate"`
 	Hostid                string                                    `json:"hostid"`
 	Hostname              string                                    `json:"hostname"`
@@ -3802,43 +4951,43 @@ type RevertToVMSnapshotResponse struct {
 	Id                    string                                    `json:"id"`
 	Instancename          string                                    `json:"instancename"`
 	Ipaddress             string                                    `json:"ipaddress"`
-	Isdynamicallyscalable bool                                      `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                  `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                    `json:"isodisplaytext"`
 	Isoid                 string                                    `json:"isoid"`
 	Isoname               string                                    `json:"isoname"`
 	JobID                 string                                    `json:"jobid"`
-	Jobstatus             int                                       `json:"jobstatus"`
+	Jobstatus             FlexInt                                   `json:"jobstatus"`
 	Keypairs              string                                    `json:"keypairs"`
-	Lastupdated           string                                    `json:"lastupdated"`
-	Leaseduration         int                                       `json:"leaseduration"`
+	Lastupdated           Time                                      `json:"lastupdated"`
+	Leaseduration         FlexInt                                   `json:"leaseduration"`
 	Leaseexpiryaction     string                                    `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                    `json:"leaseexpirydate"`
-	Maxheads              int64                                     `json:"maxheads"`
-	Maxresolutionx        int64                                     `json:"maxresolutionx"`
-	Maxresolutiony        int64                                     `json:"maxresolutiony"`
-	Memory                int                                       `json:"memory"`
-	Memoryintfreekbs      int64                                     `json:"memoryintfreekbs"`
-	Memorykbs             int64                                     `json:"memorykbs"`
-	Memorytargetkbs       int64                                     `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                      `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                 `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                 `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                 `json:"maxresolutiony"`
+	Memory                FlexInt                                   `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                 `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                 `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                 `json:"memorytargetkbs"`
 	Name                  string                                    `json:"name"`
-	Networkkbsread        int64                                     `json:"networkkbsread"`
-	Networkkbswrite       int64                                     `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                 `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                 `json:"networkkbswrite"`
 	Nic                   []Nic                                     `json:"nic"`
 	Osdisplayname         string                                    `json:"osdisplayname"`
 	Ostypeid              string                                    `json:"ostypeid"`
 	Password              string                                    `json:"password"`
-	Passwordenabled       bool                                      `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                  `json:"passwordenabled"`
 	Pooltype              string                                    `json:"pooltype"`
 	Project               string                                    `json:"project"`
 	Projectid             string                                    `json:"projectid"`
 	Publicip              string                                    `json:"publicip"`
 	Publicipid            string                                    `json:"publicipid"`
 	Readonlydetails       string                                    `json:"readonlydetails"`
-	Receivedbytes         int64                                     `json:"receivedbytes"`
-	Rootdeviceid          int64                                     `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                 `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                 `json:"rootdeviceid"`
 	Rootdevicetype        string                                    `json:"rootdevicetype"`
 	Securitygroup         []RevertToVMSnapshotResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                     `json:"sentbytes"`
+	Sentbytes             FlexInt64                                 `json:"sentbytes"`
 	Serviceofferingid     string                                    `json:"serviceofferingid"`
 	Serviceofferingname   string                                    `json:"serviceofferingname"`
 	Servicestate          string                                    `json:"servicestate"`
@@ -3859,12 +5008,13 @@ type RevertToVMSnapshotResponse struct {
 	Vgpu                  string                                    `json:"vgpu"`
 	Vgpuprofileid         string                                    `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                    `json:"vgpuprofilename"`
-	Videoram              int64                                     `json:"videoram"`
+	Videoram              FlexInt64                                 `json:"videoram"`
 	Vmtype                string                                    `json:"vmtype"`
 	Vnfdetails            map[string]string                         `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                 `json:"vnfnics"`
 	Zoneid                string                                    `json:"zoneid"`
 	Zonename              string                                    `json:"zonename"`
+	Extra                 map[string]json.RawMessage                `json:"-"`
 }
 
 type RevertToVMSnapshotResponseSecuritygroup struct {
@@ -3880,36 +5030,54 @@ type RevertToVMSnapshotResponseSecuritygroup struct {
 	Project             string                                        `json:"project"`
 	Projectid           string                                        `json:"projectid"`
 	Tags                []Tags                                        `json:"tags"`
-	Virtualmachinecount int                                           `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                       `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                 `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                    `json:"-"`
+}
+
+func (r *RevertToVMSnapshotResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias RevertToVMSnapshotResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevertToVMSnapshotResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RevertToVMSnapshotResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias RevertToVMSnapshotResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RevertToVMSnapshotResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RevertToVMSnapshotResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias RevertToVMSnapshotResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *RevertToVMSnapshotResponse) UnmarshalJSON(b []byte) error {
@@ -3936,7 +5104,7 @@ func (r *RevertToVMSnapshotResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RevertToVMSnapshotResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateSnapshotPolicyParams struct {
@@ -3961,6 +5129,45 @@ func (p *UpdateSnapshotPolicyParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateSnapshotPolicy API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateSnapshotPolicyParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateSnapshotPolicy", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateSnapshotPolicy API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateSnapshotPolicyParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"customid": 255, "id": 255},
+	}.validate("updateSnapshotPolicy", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateSnapshotPolicy API, before the request is signed
+func (p *UpdateSnapshotPolicyParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateSnapshotPolicyParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateSnapshotPolicyParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateSnapshotPolicy", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateSnapshotPolicyParams) Clone() *UpdateSnapshotPolicyParams {
+	return &UpdateSnapshotPolicyParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateSnapshotPolicyParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4034,32 +5241,39 @@ func (s *SnapshotService) NewUpdateSnapshotPolicyParams() *UpdateSnapshotPolicyP
 
 // Updates the Snapshot policy.
 func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
-	resp, err := s.cs.newPostRequest("updateSnapshotPolicy", p.toURLValues())
+	return s.UpdateSnapshotPolicyWithContext(context.Background(), p)
+}
+
+// UpdateSnapshotPolicyWithContext is like UpdateSnapshotPolicy, but honours the cancellation and deadline of ctx
+func (s *SnapshotService) UpdateSnapshotPolicyWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateSnapshotPolicy", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateSnapshotPolicyResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateSnapshotPolicy", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateSnapshotPolicy", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateSnapshotPolicy", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4067,19 +5281,44 @@ func (s *SnapshotService) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateSnapshotPolicy async job into r
+func (r *UpdateSnapshotPolicyResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateSnapshotPolicyAsync starts the async job of UpdateSnapshotPolicy without waiting for it to finish, and returns a handle to the job
+func (s *SnapshotService) UpdateSnapshotPolicyAsync(ctx context.Context, p *UpdateSnapshotPolicyParams) (*Job, error) {
+	r, err := s.UpdateSnapshotPolicyWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateSnapshotPolicy", r.JobID), nil
+}
+
 type UpdateSnapshotPolicyResponse struct {
-	Fordisplay     bool          `json:"fordisplay"`
-	Hasannotations bool          `json:"hasannotations"`
-	Id             string        `json:"id"`
-	Intervaltype   int           `json:"intervaltype"`
-	JobID          string        `json:"jobid"`
-	Jobstatus      int           `json:"jobstatus"`
-	Maxsnaps       int           `json:"maxsnaps"`
-	Schedule       string        `json:"schedule"`
-	Storage        []interface{} `json:"storage"`
-	Tags           []Tags        `json:"tags"`
-	Timezone       string        `json:"timezone"`
-	Volumeid       string        `json:"volumeid"`
-	Volumename     string        `json:"volumename"`
-	Zone           []interface{} `json:"zone"`
+	Fordisplay     FlexBool                   `json:"fordisplay"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	Intervaltype   FlexInt                    `json:"intervaltype"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Maxsnaps       FlexInt                    `json:"maxsnaps"`
+	Schedule       string                     `json:"schedule"`
+	Storage        []interface{}              `json:"storage"`
+	Tags           []Tags                     `json:"tags"`
+	Timezone       string                     `json:"timezone"`
+	Volumeid       string                     `json:"volumeid"`
+	Volumename     string                     `json:"volumename"`
+	Zone           []interface{}              `json:"zone"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateSnapshotPolicyResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateSnapshotPolicyResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) ArchiveSnapshot(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ArchiveSnapshot), p)
 }
 
+// ArchiveSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) ArchiveSnapshotAsync(ctx context.Context, p *ArchiveSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveSnapshotAsync indicates an expected call of ArchiveSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ArchiveSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ArchiveSnapshotAsync), ctx, p)
+}
+
+// ArchiveSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ArchiveSnapshotWithContext(ctx context.Context, p *ArchiveSnapshotParams) (*ArchiveSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ArchiveSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*ArchiveSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ArchiveSnapshotWithContext indicates an expected call of ArchiveSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ArchiveSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ArchiveSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ArchiveSnapshotWithContext), ctx, p)
+}
+
 // CopySnapshot mocks base method.
 func (m *MockSnapshotServiceIface) CopySnapshot(p *CopySnapshotParams) (*CopySnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) CopySnapshot(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CopySnapshot), p)
 }
 
+// CopySnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) CopySnapshotAsync(ctx context.Context, p *CopySnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopySnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopySnapshotAsync indicates an expected call of CopySnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CopySnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CopySnapshotAsync), ctx, p)
+}
+
+// CopySnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) CopySnapshotWithContext(ctx context.Context, p *CopySnapshotParams) (*CopySnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopySnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*CopySnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopySnapshotWithContext indicates an expected call of CopySnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CopySnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopySnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CopySnapshotWithContext), ctx, p)
+}
+
 // CreateSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) CreateSnapshot(p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,21 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshot(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshot), p)
 }
 
+// CreateSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) CreateSnapshotAsync(ctx context.Context, p *CreateSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotAsync indicates an expected call of CreateSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotAsync), ctx, p)
+}
+
 // CreateSnapshotFromVMSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) CreateSnapshotFromVMSnapshot(p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotFromVMSnapshot(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotFromVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotFromVMSnapshot), p)
 }
 
+// CreateSnapshotFromVMSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) CreateSnapshotFromVMSnapshotAsync(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotFromVMSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotFromVMSnapshotAsync indicates an expected call of CreateSnapshotFromVMSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotFromVMSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotFromVMSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotFromVMSnapshotAsync), ctx, p)
+}
+
+// CreateSnapshotFromVMSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) CreateSnapshotFromVMSnapshotWithContext(ctx context.Context, p *CreateSnapshotFromVMSnapshotParams) (*CreateSnapshotFromVMSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotFromVMSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSnapshotFromVMSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotFromVMSnapshotWithContext indicates an expected call of CreateSnapshotFromVMSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotFromVMSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotFromVMSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotFromVMSnapshotWithContext), ctx, p)
+}
+
 // CreateSnapshotPolicy mocks base method.
 func (m *MockSnapshotServiceIface) CreateSnapshotPolicy(p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +240,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotPolicy(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotPolicy", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotPolicy), p)
 }
 
+// CreateSnapshotPolicyWithContext mocks base method.
+func (m *MockSnapshotServiceIface) CreateSnapshotPolicyWithContext(ctx context.Context, p *CreateSnapshotPolicyParams) (*CreateSnapshotPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSnapshotPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotPolicyWithContext indicates an expected call of CreateSnapshotPolicyWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotPolicyWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotPolicyWithContext), ctx, p)
+}
+
+// CreateSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) CreateSnapshotWithContext(ctx context.Context, p *CreateSnapshotParams) (*CreateSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSnapshotWithContext indicates an expected call of CreateSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateSnapshotWithContext), ctx, p)
+}
+
 // CreateVMSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) CreateVMSnapshot(p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +285,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) CreateVMSnapshot(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateVMSnapshot), p)
 }
 
+// CreateVMSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) CreateVMSnapshotAsync(ctx context.Context, p *CreateVMSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVMSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVMSnapshotAsync indicates an expected call of CreateVMSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateVMSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateVMSnapshotAsync), ctx, p)
+}
+
+// CreateVMSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) CreateVMSnapshotWithContext(ctx context.Context, p *CreateVMSnapshotParams) (*CreateVMSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVMSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVMSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVMSnapshotWithContext indicates an expected call of CreateVMSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) CreateVMSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).CreateVMSnapshotWithContext), ctx, p)
+}
+
 // DeleteSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) DeleteSnapshot(p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +330,21 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshot(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshot), p)
 }
 
+// DeleteSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) DeleteSnapshotAsync(ctx context.Context, p *DeleteSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshotAsync indicates an expected call of DeleteSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshotAsync), ctx, p)
+}
+
 // DeleteSnapshotPolicies mocks base method.
 func (m *MockSnapshotServiceIface) DeleteSnapshotPolicies(p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +360,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshotPolicies(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotPolicies", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshotPolicies), p)
 }
 
+// DeleteSnapshotPoliciesWithContext mocks base method.
+func (m *MockSnapshotServiceIface) DeleteSnapshotPoliciesWithContext(ctx context.Context, p *DeleteSnapshotPoliciesParams) (*DeleteSnapshotPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotPoliciesWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteSnapshotPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshotPoliciesWithContext indicates an expected call of DeleteSnapshotPoliciesWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshotPoliciesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotPoliciesWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshotPoliciesWithContext), ctx, p)
+}
+
+// DeleteSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) DeleteSnapshotWithContext(ctx context.Context, p *DeleteSnapshotParams) (*DeleteSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteSnapshotWithContext indicates an expected call of DeleteSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteSnapshotWithContext), ctx, p)
+}
+
 // DeleteVMSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) DeleteVMSnapshot(p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +405,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteVMSnapshot(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteVMSnapshot), p)
 }
 
+// DeleteVMSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) DeleteVMSnapshotAsync(ctx context.Context, p *DeleteVMSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVMSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVMSnapshotAsync indicates an expected call of DeleteVMSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteVMSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteVMSnapshotAsync), ctx, p)
+}
+
+// DeleteVMSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) DeleteVMSnapshotWithContext(ctx context.Context, p *DeleteVMSnapshotParams) (*DeleteVMSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVMSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVMSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVMSnapshotWithContext indicates an expected call of DeleteVMSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) DeleteVMSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).DeleteVMSnapshotWithContext), ctx, p)
+}
+
 // ExtractSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) ExtractSnapshot(p *ExtractSnapshotParams) (*ExtractSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +450,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) ExtractSnapshot(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ExtractSnapshot), p)
 }
 
+// ExtractSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) ExtractSnapshotAsync(ctx context.Context, p *ExtractSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractSnapshotAsync indicates an expected call of ExtractSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ExtractSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ExtractSnapshotAsync), ctx, p)
+}
+
+// ExtractSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ExtractSnapshotWithContext(ctx context.Context, p *ExtractSnapshotParams) (*ExtractSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExtractSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractSnapshotWithContext indicates an expected call of ExtractSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ExtractSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ExtractSnapshotWithContext), ctx, p)
+}
+
 // GetSnapshotByID mocks base method.
 func (m *MockSnapshotServiceIface) GetSnapshotByID(id string, opts ...OptionFunc) (*Snapshot, int, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +501,27 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotByID(id any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotByID", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotByID), varargs...)
 }
 
+// GetSnapshotByIDWithContext mocks base method.
+func (m *MockSnapshotServiceIface) GetSnapshotByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Snapshot, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSnapshotByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Snapshot)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshotByIDWithContext indicates an expected call of GetSnapshotByIDWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotByIDWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotByIDWithContext), varargs...)
+}
+
 // GetSnapshotByName mocks base method.
 func (m *MockSnapshotServiceIface) GetSnapshotByName(name string, opts ...OptionFunc) (*Snapshot, int, error) {
 	m.ctrl.T.Helper()
@@ -250,6 +543,27 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotByName(name any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotByName", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotByName), varargs...)
 }
 
+// GetSnapshotByNameWithContext mocks base method.
+func (m *MockSnapshotServiceIface) GetSnapshotByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Snapshot, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSnapshotByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Snapshot)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshotByNameWithContext indicates an expected call of GetSnapshotByNameWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotByNameWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotByNameWithContext), varargs...)
+}
+
 // GetSnapshotID mocks base method.
 func (m *MockSnapshotServiceIface) GetSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -271,6 +585,27 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotID(name any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotID", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotID), varargs...)
 }
 
+// GetSnapshotIDWithContext mocks base method.
+func (m *MockSnapshotServiceIface) GetSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSnapshotIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshotIDWithContext indicates an expected call of GetSnapshotIDWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotIDWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotIDWithContext), varargs...)
+}
+
 // GetSnapshotPolicyByID mocks base method.
 func (m *MockSnapshotServiceIface) GetSnapshotPolicyByID(id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +627,27 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotPolicyByID(id any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotPolicyByID", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotPolicyByID), varargs...)
 }
 
+// GetSnapshotPolicyByIDWithContext mocks base method.
+func (m *MockSnapshotServiceIface) GetSnapshotPolicyByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SnapshotPolicy, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSnapshotPolicyByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*SnapshotPolicy)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSnapshotPolicyByIDWithContext indicates an expected call of GetSnapshotPolicyByIDWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) GetSnapshotPolicyByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSnapshotPolicyByIDWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetSnapshotPolicyByIDWithContext), varargs...)
+}
+
 // GetVMSnapshotID mocks base method.
 func (m *MockSnapshotServiceIface) GetVMSnapshotID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -313,6 +669,27 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) GetVMSnapshotID(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMSnapshotID", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetVMSnapshotID), varargs...)
 }
 
+// GetVMSnapshotIDWithContext mocks base method.
+func (m *MockSnapshotServiceIface) GetVMSnapshotIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVMSnapshotIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVMSnapshotIDWithContext indicates an expected call of GetVMSnapshotIDWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) GetVMSnapshotIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMSnapshotIDWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).GetVMSnapshotIDWithContext), varargs...)
+}
+
 // ListSnapshotPolicies mocks base method.
 func (m *MockSnapshotServiceIface) ListSnapshotPolicies(p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
 	m.ctrl.T.Helper()
@@ -328,6 +705,99 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPolicies(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPolicies", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPolicies), p)
 }
 
+// ListSnapshotPoliciesAll mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotPoliciesAll(p *ListSnapshotPoliciesParams, opts ...PageOption) ([]*SnapshotPolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotPoliciesAll", varargs...)
+	ret0, _ := ret[0].([]*SnapshotPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotPoliciesAll indicates an expected call of ListSnapshotPoliciesAll.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPoliciesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPoliciesAll", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPoliciesAll), varargs...)
+}
+
+// ListSnapshotPoliciesAllWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotPoliciesAllWithContext(ctx context.Context, p *ListSnapshotPoliciesParams, opts ...PageOption) ([]*SnapshotPolicy, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotPoliciesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SnapshotPolicy)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotPoliciesAllWithContext indicates an expected call of ListSnapshotPoliciesAllWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPoliciesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPoliciesAllWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPoliciesAllWithContext), varargs...)
+}
+
+// ListSnapshotPoliciesIter mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotPoliciesIter(p *ListSnapshotPoliciesParams, opts ...PageOption) iter.Seq2[*SnapshotPolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotPoliciesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SnapshotPolicy, error])
+	return ret0
+}
+
+// ListSnapshotPoliciesIter indicates an expected call of ListSnapshotPoliciesIter.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPoliciesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPoliciesIter", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPoliciesIter), varargs...)
+}
+
+// ListSnapshotPoliciesIterWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotPoliciesIterWithContext(ctx context.Context, p *ListSnapshotPoliciesParams, opts ...PageOption) iter.Seq2[*SnapshotPolicy, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotPoliciesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SnapshotPolicy, error])
+	return ret0
+}
+
+// ListSnapshotPoliciesIterWithContext indicates an expected call of ListSnapshotPoliciesIterWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPoliciesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPoliciesIterWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPoliciesIterWithContext), varargs...)
+}
+
+// ListSnapshotPoliciesWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotPoliciesWithContext(ctx context.Context, p *ListSnapshotPoliciesParams) (*ListSnapshotPoliciesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshotPoliciesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSnapshotPoliciesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotPoliciesWithContext indicates an expected call of ListSnapshotPoliciesWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotPoliciesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotPoliciesWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotPoliciesWithContext), ctx, p)
+}
+
 // ListSnapshots mocks base method.
 func (m *MockSnapshotServiceIface) ListSnapshots(p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
 	m.ctrl.T.Helper()
@@ -343,6 +813,99 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshots(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshots", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshots), p)
 }
 
+// ListSnapshotsAll mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotsAll(p *ListSnapshotsParams, opts ...PageOption) ([]*Snapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotsAll", varargs...)
+	ret0, _ := ret[0].([]*Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotsAll indicates an expected call of ListSnapshotsAll.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotsAll", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotsAll), varargs...)
+}
+
+// ListSnapshotsAllWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotsAllWithContext(ctx context.Context, p *ListSnapshotsParams, opts ...PageOption) ([]*Snapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Snapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotsAllWithContext indicates an expected call of ListSnapshotsAllWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotsAllWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotsAllWithContext), varargs...)
+}
+
+// ListSnapshotsIter mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotsIter(p *ListSnapshotsParams, opts ...PageOption) iter.Seq2[*Snapshot, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Snapshot, error])
+	return ret0
+}
+
+// ListSnapshotsIter indicates an expected call of ListSnapshotsIter.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotsIter", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotsIter), varargs...)
+}
+
+// ListSnapshotsIterWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotsIterWithContext(ctx context.Context, p *ListSnapshotsParams, opts ...PageOption) iter.Seq2[*Snapshot, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSnapshotsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Snapshot, error])
+	return ret0
+}
+
+// ListSnapshotsIterWithContext indicates an expected call of ListSnapshotsIterWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotsIterWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotsIterWithContext), varargs...)
+}
+
+// ListSnapshotsWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListSnapshotsWithContext(ctx context.Context, p *ListSnapshotsParams) (*ListSnapshotsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSnapshotsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSnapshotsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSnapshotsWithContext indicates an expected call of ListSnapshotsWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListSnapshotsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSnapshotsWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListSnapshotsWithContext), ctx, p)
+}
+
 // ListVMSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) ListVMSnapshot(p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -358,6 +921,99 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshot(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshot), p)
 }
 
+// ListVMSnapshotAll mocks base method.
+func (m *MockSnapshotServiceIface) ListVMSnapshotAll(p *ListVMSnapshotParams, opts ...PageOption) ([]*VMSnapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMSnapshotAll", varargs...)
+	ret0, _ := ret[0].([]*VMSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMSnapshotAll indicates an expected call of ListVMSnapshotAll.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshotAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshotAll", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshotAll), varargs...)
+}
+
+// ListVMSnapshotAllWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListVMSnapshotAllWithContext(ctx context.Context, p *ListVMSnapshotParams, opts ...PageOption) ([]*VMSnapshot, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMSnapshotAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VMSnapshot)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMSnapshotAllWithContext indicates an expected call of ListVMSnapshotAllWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshotAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshotAllWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshotAllWithContext), varargs...)
+}
+
+// ListVMSnapshotIter mocks base method.
+func (m *MockSnapshotServiceIface) ListVMSnapshotIter(p *ListVMSnapshotParams, opts ...PageOption) iter.Seq2[*VMSnapshot, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMSnapshotIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VMSnapshot, error])
+	return ret0
+}
+
+// ListVMSnapshotIter indicates an expected call of ListVMSnapshotIter.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshotIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshotIter", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshotIter), varargs...)
+}
+
+// ListVMSnapshotIterWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListVMSnapshotIterWithContext(ctx context.Context, p *ListVMSnapshotParams, opts ...PageOption) iter.Seq2[*VMSnapshot, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMSnapshotIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VMSnapshot, error])
+	return ret0
+}
+
+// ListVMSnapshotIterWithContext indicates an expected call of ListVMSnapshotIterWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshotIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshotIterWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshotIterWithContext), varargs...)
+}
+
+// ListVMSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) ListVMSnapshotWithContext(ctx context.Context, p *ListVMSnapshotParams) (*ListVMSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVMSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMSnapshotWithContext indicates an expected call of ListVMSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) ListVMSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).ListVMSnapshotWithContext), ctx, p)
+}
+
 // NewArchiveSnapshotParams mocks base method.
 func (m *MockSnapshotServiceIface) NewArchiveSnapshotParams(id string) *ArchiveSnapshotParams {
 	m.ctrl.T.Helper()
@@ -415,7 +1071,7 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) NewCreateSnapshotParams(volumeid
 }
 
 // NewCreateSnapshotPolicyParams mocks base method.
-func (m *MockSnapshotServiceIface) NewCreateSnapshotPolicyParams(intervaltype string, maxsnaps int, schedule, timezone, volumeid string) *CreateSnapshotPolicyParams {
+func (m *MockSnapshotServiceIface) NewCreateSnapshotPolicyParams(intervaltype IntervalType, maxsnaps int, schedule, timezone, volumeid string) *CreateSnapshotPolicyParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewCreateSnapshotPolicyParams", intervaltype, maxsnaps, schedule, timezone, volumeid)
 	ret0, _ := ret[0].(*CreateSnapshotPolicyParams)
@@ -597,6 +1253,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) RevertSnapshot(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertSnapshot), p)
 }
 
+// RevertSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) RevertSnapshotAsync(ctx context.Context, p *RevertSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertSnapshotAsync indicates an expected call of RevertSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) RevertSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertSnapshotAsync), ctx, p)
+}
+
+// RevertSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) RevertSnapshotWithContext(ctx context.Context, p *RevertSnapshotParams) (*RevertSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*RevertSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertSnapshotWithContext indicates an expected call of RevertSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) RevertSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertSnapshotWithContext), ctx, p)
+}
+
 // RevertToVMSnapshot mocks base method.
 func (m *MockSnapshotServiceIface) RevertToVMSnapshot(p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
 	m.ctrl.T.Helper()
@@ -612,6 +1298,36 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) RevertToVMSnapshot(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertToVMSnapshot", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertToVMSnapshot), p)
 }
 
+// RevertToVMSnapshotAsync mocks base method.
+func (m *MockSnapshotServiceIface) RevertToVMSnapshotAsync(ctx context.Context, p *RevertToVMSnapshotParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertToVMSnapshotAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertToVMSnapshotAsync indicates an expected call of RevertToVMSnapshotAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) RevertToVMSnapshotAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertToVMSnapshotAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertToVMSnapshotAsync), ctx, p)
+}
+
+// RevertToVMSnapshotWithContext mocks base method.
+func (m *MockSnapshotServiceIface) RevertToVMSnapshotWithContext(ctx context.Context, p *RevertToVMSnapshotParams) (*RevertToVMSnapshotResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RevertToVMSnapshotWithContext", ctx, p)
+	ret0, _ := ret[0].(*RevertToVMSnapshotResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RevertToVMSnapshotWithContext indicates an expected call of RevertToVMSnapshotWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) RevertToVMSnapshotWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevertToVMSnapshotWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).RevertToVMSnapshotWithContext), ctx, p)
+}
+
 // UpdateSnapshotPolicy mocks base method.
 func (m *MockSnapshotServiceIface) UpdateSnapshotPolicy(p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
 	m.ctrl.T.Helper()
@@ -626,3 +1342,33 @@ func (mr *MockSnapshotServiceIfaceMockRecorder) UpdateSnapshotPolicy(p any) *gom
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicy", reflect.TypeOf((*MockSnapshotServiceIface)(nil).UpdateSnapshotPolicy), p)
 }
+
+// UpdateSnapshotPolicyAsync mocks base method.
+func (m *MockSnapshotServiceIface) UpdateSnapshotPolicyAsync(ctx context.Context, p *UpdateSnapshotPolicyParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnapshotPolicyAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnapshotPolicyAsync indicates an expected call of UpdateSnapshotPolicyAsync.
+func (mr *MockSnapshotServiceIfaceMockRecorder) UpdateSnapshotPolicyAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicyAsync", reflect.TypeOf((*MockSnapshotServiceIface)(nil).UpdateSnapshotPolicyAsync), ctx, p)
+}
+
+// UpdateSnapshotPolicyWithContext mocks base method.
+func (m *MockSnapshotServiceIface) UpdateSnapshotPolicyWithContext(ctx context.Context, p *UpdateSnapshotPolicyParams) (*UpdateSnapshotPolicyResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateSnapshotPolicyWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateSnapshotPolicyResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateSnapshotPolicyWithContext indicates an expected call of UpdateSnapshotPolicyWithContext.
+func (mr *MockSnapshotServiceIfaceMockRecorder) UpdateSnapshotPolicyWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateSnapshotPolicyWithContext", reflect.TypeOf((*MockSnapshotServiceIface)(nil).UpdateSnapshotPolicyWithContext), ctx, p)
+}
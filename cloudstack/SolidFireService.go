@@ -20,16 +20,20 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 )
 
 type SolidFireServiceIface interface {
 	GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error)
+	GetSolidFireAccountIdWithContext(ctx context.Context, p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error)
 	NewGetSolidFireAccountIdParams(accountid string, storageid string) *GetSolidFireAccountIdParams
 	GetSolidFireVolumeAccessGroupIds(p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error)
+	GetSolidFireVolumeAccessGroupIdsWithContext(ctx context.Context, p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error)
 	NewGetSolidFireVolumeAccessGroupIdsParams(clusterid string, storageid string) *GetSolidFireVolumeAccessGroupIdsParams
 	GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error)
+	GetSolidFireVolumeSizeWithContext(ctx context.Context, p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error)
 	NewGetSolidFireVolumeSizeParams(volumeid string) *GetSolidFireVolumeSizeParams
 }
 
@@ -51,6 +55,45 @@ func (p *GetSolidFireAccountIdParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getSolidFireAccountId API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetSolidFireAccountIdParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getSolidFireAccountId", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getSolidFireAccountId API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetSolidFireAccountIdParams) Validate() error {
+	return paramRules{
+		required: []string{"accountid", "storageid"},
+		lengths:  map[string]int{"accountid": 255, "storageid": 255},
+	}.validate("getSolidFireAccountId", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getSolidFireAccountId API, before the request is signed
+func (p *GetSolidFireAccountIdParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetSolidFireAccountIdParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetSolidFireAccountIdParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getSolidFireAccountId", b, &p.p, map[string]paramDecoder{
+		"accountid": decodeParam[string],
+		"storageid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetSolidFireAccountIdParams) Clone() *GetSolidFireAccountIdParams {
+	return &GetSolidFireAccountIdParams{p: cloneParams(p.p)}
+}
+
 func (p *GetSolidFireAccountIdParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -105,13 +148,22 @@ func (s *SolidFireService) NewGetSolidFireAccountIdParams(accountid string, stor
 
 // Get SolidFire Account ID
 func (s *SolidFireService) GetSolidFireAccountId(p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireAccountId", p.toURLValues())
+	return s.GetSolidFireAccountIdWithContext(context.Background(), p)
+}
+
+// GetSolidFireAccountIdWithContext is like GetSolidFireAccountId, but honours the cancellation and deadline of ctx
+func (s *SolidFireService) GetSolidFireAccountIdWithContext(ctx context.Context, p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getSolidFireAccountId", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetSolidFireAccountIdResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getSolidFireAccountId", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -119,9 +171,15 @@ func (s *SolidFireService) GetSolidFireAccountId(p *GetSolidFireAccountIdParams)
 }
 
 type GetSolidFireAccountIdResponse struct {
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	SolidFireAccountId int64  `json:"solidFireAccountId"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	SolidFireAccountId FlexInt64                  `json:"solidFireAccountId"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetSolidFireAccountIdResponse) UnmarshalJSON(b []byte) error {
+	type alias GetSolidFireAccountIdResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetSolidFireVolumeAccessGroupIdsParams struct {
@@ -142,6 +200,45 @@ func (p *GetSolidFireVolumeAccessGroupIdsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getSolidFireVolumeAccessGroupIds API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetSolidFireVolumeAccessGroupIdsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getSolidFireVolumeAccessGroupIds", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getSolidFireVolumeAccessGroupIds API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetSolidFireVolumeAccessGroupIdsParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid", "storageid"},
+		lengths:  map[string]int{"clusterid": 255, "storageid": 255},
+	}.validate("getSolidFireVolumeAccessGroupIds", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getSolidFireVolumeAccessGroupIds API, before the request is signed
+func (p *GetSolidFireVolumeAccessGroupIdsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetSolidFireVolumeAccessGroupIdsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetSolidFireVolumeAccessGroupIdsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getSolidFireVolumeAccessGroupIds", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+		"storageid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetSolidFireVolumeAccessGroupIdsParams) Clone() *GetSolidFireVolumeAccessGroupIdsParams {
+	return &GetSolidFireVolumeAccessGroupIdsParams{p: cloneParams(p.p)}
+}
+
 func (p *GetSolidFireVolumeAccessGroupIdsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -196,13 +293,22 @@ func (s *SolidFireService) NewGetSolidFireVolumeAccessGroupIdsParams(clusterid s
 
 // Get the SF Volume Access Group IDs
 func (s *SolidFireService) GetSolidFireVolumeAccessGroupIds(p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireVolumeAccessGroupIds", p.toURLValues())
+	return s.GetSolidFireVolumeAccessGroupIdsWithContext(context.Background(), p)
+}
+
+// GetSolidFireVolumeAccessGroupIdsWithContext is like GetSolidFireVolumeAccessGroupIds, but honours the cancellation and deadline of ctx
+func (s *SolidFireService) GetSolidFireVolumeAccessGroupIdsWithContext(ctx context.Context, p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getSolidFireVolumeAccessGroupIds", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetSolidFireVolumeAccessGroupIdsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getSolidFireVolumeAccessGroupIds", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -210,9 +316,15 @@ func (s *SolidFireService) GetSolidFireVolumeAccessGroupIds(p *GetSolidFireVolum
 }
 
 type GetSolidFireVolumeAccessGroupIdsResponse struct {
-	JobID                         string  `json:"jobid"`
-	Jobstatus                     int     `json:"jobstatus"`
-	SolidFireVolumeAccessGroupIds []int64 `json:"solidFireVolumeAccessGroupIds"`
+	JobID                         string                     `json:"jobid"`
+	Jobstatus                     FlexInt                    `json:"jobstatus"`
+	SolidFireVolumeAccessGroupIds []int64                    `json:"solidFireVolumeAccessGroupIds"`
+	Extra                         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetSolidFireVolumeAccessGroupIdsResponse) UnmarshalJSON(b []byte) error {
+	type alias GetSolidFireVolumeAccessGroupIdsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetSolidFireVolumeSizeParams struct {
@@ -230,6 +342,44 @@ func (p *GetSolidFireVolumeSizeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getSolidFireVolumeSize API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetSolidFireVolumeSizeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getSolidFireVolumeSize", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getSolidFireVolumeSize API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetSolidFireVolumeSizeParams) Validate() error {
+	return paramRules{
+		required: []string{"volumeid"},
+		lengths:  map[string]int{"volumeid": 255},
+	}.validate("getSolidFireVolumeSize", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getSolidFireVolumeSize API, before the request is signed
+func (p *GetSolidFireVolumeSizeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetSolidFireVolumeSizeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetSolidFireVolumeSizeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getSolidFireVolumeSize", b, &p.p, map[string]paramDecoder{
+		"volumeid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetSolidFireVolumeSizeParams) Clone() *GetSolidFireVolumeSizeParams {
+	return &GetSolidFireVolumeSizeParams{p: cloneParams(p.p)}
+}
+
 func (p *GetSolidFireVolumeSizeParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -262,13 +412,22 @@ func (s *SolidFireService) NewGetSolidFireVolumeSizeParams(volumeid string) *Get
 
 // Get the SF volume size including Hypervisor Snapshot Reserve
 func (s *SolidFireService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
-	resp, err := s.cs.newRequest("getSolidFireVolumeSize", p.toURLValues())
+	return s.GetSolidFireVolumeSizeWithContext(context.Background(), p)
+}
+
+// GetSolidFireVolumeSizeWithContext is like GetSolidFireVolumeSize, but honours the cancellation and deadline of ctx
+func (s *SolidFireService) GetSolidFireVolumeSizeWithContext(ctx context.Context, p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getSolidFireVolumeSize", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetSolidFireVolumeSizeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getSolidFireVolumeSize", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -276,7 +435,13 @@ func (s *SolidFireService) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParam
 }
 
 type GetSolidFireVolumeSizeResponse struct {
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	SolidFireVolumeSize int64  `json:"solidFireVolumeSize"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	SolidFireVolumeSize FlexInt64                  `json:"solidFireVolumeSize"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetSolidFireVolumeSizeResponse) UnmarshalJSON(b []byte) error {
+	type alias GetSolidFireVolumeSizeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockSolidFireServiceIfaceMockRecorder) GetSolidFireAccountId(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireAccountId", reflect.TypeOf((*MockSolidFireServiceIface)(nil).GetSolidFireAccountId), p)
 }
 
+// GetSolidFireAccountIdWithContext mocks base method.
+func (m *MockSolidFireServiceIface) GetSolidFireAccountIdWithContext(ctx context.Context, p *GetSolidFireAccountIdParams) (*GetSolidFireAccountIdResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSolidFireAccountIdWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetSolidFireAccountIdResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSolidFireAccountIdWithContext indicates an expected call of GetSolidFireAccountIdWithContext.
+func (mr *MockSolidFireServiceIfaceMockRecorder) GetSolidFireAccountIdWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireAccountIdWithContext", reflect.TypeOf((*MockSolidFireServiceIface)(nil).GetSolidFireAccountIdWithContext), ctx, p)
+}
+
 // GetSolidFireVolumeAccessGroupIds mocks base method.
 func (m *MockSolidFireServiceIface) GetSolidFireVolumeAccessGroupIds(p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +104,21 @@ func (mr *MockSolidFireServiceIfaceMockRecorder) GetSolidFireVolumeAccessGroupId
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireVolumeAccessGroupIds", reflect.TypeOf((*MockSolidFireServiceIface)(nil).GetSolidFireVolumeAccessGroupIds), p)
 }
 
+// GetSolidFireVolumeAccessGroupIdsWithContext mocks base method.
+func (m *MockSolidFireServiceIface) GetSolidFireVolumeAccessGroupIdsWithContext(ctx context.Context, p *GetSolidFireVolumeAccessGroupIdsParams) (*GetSolidFireVolumeAccessGroupIdsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSolidFireVolumeAccessGroupIdsWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetSolidFireVolumeAccessGroupIdsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSolidFireVolumeAccessGroupIdsWithContext indicates an expected call of GetSolidFireVolumeAccessGroupIdsWithContext.
+func (mr *MockSolidFireServiceIfaceMockRecorder) GetSolidFireVolumeAccessGroupIdsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireVolumeAccessGroupIdsWithContext", reflect.TypeOf((*MockSolidFireServiceIface)(nil).GetSolidFireVolumeAccessGroupIdsWithContext), ctx, p)
+}
+
 // GetSolidFireVolumeSize mocks base method.
 func (m *MockSolidFireServiceIface) GetSolidFireVolumeSize(p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +134,21 @@ func (mr *MockSolidFireServiceIfaceMockRecorder) GetSolidFireVolumeSize(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireVolumeSize", reflect.TypeOf((*MockSolidFireServiceIface)(nil).GetSolidFireVolumeSize), p)
 }
 
+// GetSolidFireVolumeSizeWithContext mocks base method.
+func (m *MockSolidFireServiceIface) GetSolidFireVolumeSizeWithContext(ctx context.Context, p *GetSolidFireVolumeSizeParams) (*GetSolidFireVolumeSizeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSolidFireVolumeSizeWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetSolidFireVolumeSizeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSolidFireVolumeSizeWithContext indicates an expected call of GetSolidFireVolumeSizeWithContext.
+func (mr *MockSolidFireServiceIfaceMockRecorder) GetSolidFireVolumeSizeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSolidFireVolumeSizeWithContext", reflect.TypeOf((*MockSolidFireServiceIface)(nil).GetSolidFireVolumeSizeWithContext), ctx, p)
+}
+
 // NewGetSolidFireAccountIdParams mocks base method.
 func (m *MockSolidFireServiceIface) NewGetSolidFireAccountIdParams(accountid, storageid string) *GetSolidFireAccountIdParams {
 	m.ctrl.T.Helper()
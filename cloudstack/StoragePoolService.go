@@ -20,44 +20,87 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type StoragePoolServiceIface interface {
 	CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error)
+	CancelStorageMaintenanceAsync(ctx context.Context, p *CancelStorageMaintenanceParams) (*Job, error)
 	NewCancelStorageMaintenanceParams(id string) *CancelStorageMaintenanceParams
 	ChangeStoragePoolScope(p *ChangeStoragePoolScopeParams) (*ChangeStoragePoolScopeResponse, error)
+	ChangeStoragePoolScopeWithContext(ctx context.Context, p *ChangeStoragePoolScopeParams) (*ChangeStoragePoolScopeResponse, error)
+	ChangeStoragePoolScopeAsync(ctx context.Context, p *ChangeStoragePoolScopeParams) (*Job, error)
 	NewChangeStoragePoolScopeParams(id string, scope string) *ChangeStoragePoolScopeParams
 	EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error)
+	EnableStorageMaintenanceAsync(ctx context.Context, p *EnableStorageMaintenanceParams) (*Job, error)
 	NewEnableStorageMaintenanceParams(id string) *EnableStorageMaintenanceParams
 	ListAffectedVmsForStorageScopeChange(p *ListAffectedVmsForStorageScopeChangeParams) (*ListAffectedVmsForStorageScopeChangeResponse, error)
+	ListAffectedVmsForStorageScopeChangeWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams) (*ListAffectedVmsForStorageScopeChangeResponse, error)
+	ListAffectedVmsForStorageScopeChangeAll(p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) ([]*AffectedVmsForStorageScopeChange, error)
+	ListAffectedVmsForStorageScopeChangeAllWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) ([]*AffectedVmsForStorageScopeChange, error)
+	ListAffectedVmsForStorageScopeChangeIter(p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) iter.Seq2[*AffectedVmsForStorageScopeChange, error]
+	ListAffectedVmsForStorageScopeChangeIterWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) iter.Seq2[*AffectedVmsForStorageScopeChange, error]
 	NewListAffectedVmsForStorageScopeChangeParams(clusterid string, storageid string) *ListAffectedVmsForStorageScopeChangeParams
 	GetAffectedVmsForStorageScopeChangeID(keyword string, clusterid string, storageid string, opts ...OptionFunc) (string, int, error)
+	GetAffectedVmsForStorageScopeChangeIDWithContext(ctx context.Context, keyword string, clusterid string, storageid string, opts ...OptionFunc) (string, int, error)
 	ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error)
+	ListStorageProvidersAll(p *ListStorageProvidersParams, opts ...PageOption) ([]*StorageProvider, error)
+	ListStorageProvidersAllWithContext(ctx context.Context, p *ListStorageProvidersParams, opts ...PageOption) ([]*StorageProvider, error)
+	ListStorageProvidersIter(p *ListStorageProvidersParams, opts ...PageOption) iter.Seq2[*StorageProvider, error]
+	ListStorageProvidersIterWithContext(ctx context.Context, p *ListStorageProvidersParams, opts ...PageOption) iter.Seq2[*StorageProvider, error]
 	NewListStorageProvidersParams(storagePoolType string) *ListStorageProvidersParams
 	ListObjectStoragePools(p *ListObjectStoragePoolsParams) (*ListObjectStoragePoolsResponse, error)
+	ListObjectStoragePoolsWithContext(ctx context.Context, p *ListObjectStoragePoolsParams) (*ListObjectStoragePoolsResponse, error)
+	ListObjectStoragePoolsAll(p *ListObjectStoragePoolsParams, opts ...PageOption) ([]*ObjectStoragePool, error)
+	ListObjectStoragePoolsAllWithContext(ctx context.Context, p *ListObjectStoragePoolsParams, opts ...PageOption) ([]*ObjectStoragePool, error)
+	ListObjectStoragePoolsIter(p *ListObjectStoragePoolsParams, opts ...PageOption) iter.Seq2[*ObjectStoragePool, error]
+	ListObjectStoragePoolsIterWithContext(ctx context.Context, p *ListObjectStoragePoolsParams, opts ...PageOption) iter.Seq2[*ObjectStoragePool, error]
 	NewListObjectStoragePoolsParams() *ListObjectStoragePoolsParams
 	GetObjectStoragePoolID(name string, opts ...OptionFunc) (string, int, error)
+	GetObjectStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetObjectStoragePoolByName(name string, opts ...OptionFunc) (*ObjectStoragePool, int, error)
+	GetObjectStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ObjectStoragePool, int, error)
 	GetObjectStoragePoolByID(id string, opts ...OptionFunc) (*ObjectStoragePool, int, error)
+	GetObjectStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ObjectStoragePool, int, error)
 	ListStoragePoolObjects(p *ListStoragePoolObjectsParams) (*ListStoragePoolObjectsResponse, error)
+	ListStoragePoolObjectsWithContext(ctx context.Context, p *ListStoragePoolObjectsParams) (*ListStoragePoolObjectsResponse, error)
+	ListStoragePoolObjectsAll(p *ListStoragePoolObjectsParams, opts ...PageOption) ([]*StoragePoolObject, error)
+	ListStoragePoolObjectsAllWithContext(ctx context.Context, p *ListStoragePoolObjectsParams, opts ...PageOption) ([]*StoragePoolObject, error)
+	ListStoragePoolObjectsIter(p *ListStoragePoolObjectsParams, opts ...PageOption) iter.Seq2[*StoragePoolObject, error]
+	ListStoragePoolObjectsIterWithContext(ctx context.Context, p *ListStoragePoolObjectsParams, opts ...PageOption) iter.Seq2[*StoragePoolObject, error]
 	NewListStoragePoolObjectsParams(id string) *ListStoragePoolObjectsParams
 	GetStoragePoolObjectByID(id string, opts ...OptionFunc) (*StoragePoolObject, int, error)
+	GetStoragePoolObjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolObject, int, error)
 	UpdateObjectStoragePool(p *UpdateObjectStoragePoolParams) (*UpdateObjectStoragePoolResponse, error)
+	UpdateObjectStoragePoolWithContext(ctx context.Context, p *UpdateObjectStoragePoolParams) (*UpdateObjectStoragePoolResponse, error)
 	NewUpdateObjectStoragePoolParams(id string) *UpdateObjectStoragePoolParams
 	AddObjectStoragePool(p *AddObjectStoragePoolParams) (*AddObjectStoragePoolResponse, error)
+	AddObjectStoragePoolWithContext(ctx context.Context, p *AddObjectStoragePoolParams) (*AddObjectStoragePoolResponse, error)
 	NewAddObjectStoragePoolParams(name string, provider string, url string) *AddObjectStoragePoolParams
 	DeleteObjectStoragePool(p *DeleteObjectStoragePoolParams) (*DeleteObjectStoragePoolResponse, error)
+	DeleteObjectStoragePoolWithContext(ctx context.Context, p *DeleteObjectStoragePoolParams) (*DeleteObjectStoragePoolResponse, error)
 	NewDeleteObjectStoragePoolParams(id string) *DeleteObjectStoragePoolParams
 	ListStoragePoolsMetrics(p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error)
+	ListStoragePoolsMetricsWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error)
+	ListStoragePoolsMetricsAll(p *ListStoragePoolsMetricsParams, opts ...PageOption) ([]*StoragePoolsMetric, error)
+	ListStoragePoolsMetricsAllWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams, opts ...PageOption) ([]*StoragePoolsMetric, error)
+	ListStoragePoolsMetricsIter(p *ListStoragePoolsMetricsParams, opts ...PageOption) iter.Seq2[*StoragePoolsMetric, error]
+	ListStoragePoolsMetricsIterWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams, opts ...PageOption) iter.Seq2[*StoragePoolsMetric, error]
 	NewListStoragePoolsMetricsParams() *ListStoragePoolsMetricsParams
 	GetStoragePoolsMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetStoragePoolsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetStoragePoolsMetricByName(name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
 	GetStoragePoolsMetricByID(id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
+	GetStoragePoolsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error)
 }
 
 type CancelStorageMaintenanceParams struct {
@@ -75,6 +118,44 @@ func (p *CancelStorageMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the cancelStorageMaintenance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CancelStorageMaintenanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("cancelStorageMaintenance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the cancelStorageMaintenance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CancelStorageMaintenanceParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("cancelStorageMaintenance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the cancelStorageMaintenance API, before the request is signed
+func (p *CancelStorageMaintenanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CancelStorageMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CancelStorageMaintenanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("cancelStorageMaintenance", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CancelStorageMaintenanceParams) Clone() *CancelStorageMaintenanceParams {
+	return &CancelStorageMaintenanceParams{p: cloneParams(p.p)}
+}
+
 func (p *CancelStorageMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -107,32 +188,39 @@ func (s *StoragePoolService) NewCancelStorageMaintenanceParams(id string) *Cance
 
 // Cancels maintenance for primary storage
 func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newPostRequest("cancelStorageMaintenance", p.toURLValues())
+	return s.CancelStorageMaintenanceWithContext(context.Background(), p)
+}
+
+// CancelStorageMaintenanceWithContext is like CancelStorageMaintenance, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "cancelStorageMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CancelStorageMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("cancelStorageMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "cancelStorageMaintenance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("cancelStorageMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -140,43 +228,68 @@ func (s *StoragePoolService) CancelStorageMaintenance(p *CancelStorageMaintenanc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CancelStorageMaintenance async job into r
+func (r *CancelStorageMaintenanceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CancelStorageMaintenanceAsync starts the async job of CancelStorageMaintenance without waiting for it to finish, and returns a handle to the job
+func (s *StoragePoolService) CancelStorageMaintenanceAsync(ctx context.Context, p *CancelStorageMaintenanceParams) (*Job, error) {
+	r, err := s.CancelStorageMaintenanceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("cancelStorageMaintenance", r.JobID), nil
+}
+
 type CancelStorageMaintenanceResponse struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CancelStorageMaintenanceResponse) UnmarshalJSON(b []byte) error {
+	type alias CancelStorageMaintenanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeStoragePoolScopeParams struct {
@@ -200,6 +313,46 @@ func (p *ChangeStoragePoolScopeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeStoragePoolScope API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeStoragePoolScopeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeStoragePoolScope", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeStoragePoolScope API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeStoragePoolScopeParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "scope"},
+		lengths:  map[string]int{"clusterid": 255, "id": 255, "scope": 255},
+	}.validate("changeStoragePoolScope", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeStoragePoolScope API, before the request is signed
+func (p *ChangeStoragePoolScopeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeStoragePoolScopeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeStoragePoolScopeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeStoragePoolScope", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+		"id":        decodeParam[string],
+		"scope":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeStoragePoolScopeParams) Clone() *ChangeStoragePoolScopeParams {
+	return &ChangeStoragePoolScopeParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeStoragePoolScopeParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -275,27 +428,39 @@ func (s *StoragePoolService) NewChangeStoragePoolScopeParams(id string, scope st
 
 // Changes the scope of a storage pool when the pool is in Disabled state.This feature is officially tested and supported for Hypervisors: KVM and VMware, Protocols: NFS and Ceph, and Storage Provider: DefaultPrimary. There might be extra steps involved to make this work for other hypervisors and storage options.
 func (s *StoragePoolService) ChangeStoragePoolScope(p *ChangeStoragePoolScopeParams) (*ChangeStoragePoolScopeResponse, error) {
-	resp, err := s.cs.newPostRequest("changeStoragePoolScope", p.toURLValues())
+	return s.ChangeStoragePoolScopeWithContext(context.Background(), p)
+}
+
+// ChangeStoragePoolScopeWithContext is like ChangeStoragePoolScope, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ChangeStoragePoolScopeWithContext(ctx context.Context, p *ChangeStoragePoolScopeParams) (*ChangeStoragePoolScopeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "changeStoragePoolScope", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeStoragePoolScopeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeStoragePoolScope", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "changeStoragePoolScope", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("changeStoragePoolScope", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -303,11 +468,31 @@ func (s *StoragePoolService) ChangeStoragePoolScope(p *ChangeStoragePoolScopePar
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ChangeStoragePoolScope async job into r
+func (r *ChangeStoragePoolScopeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ChangeStoragePoolScopeAsync starts the async job of ChangeStoragePoolScope without waiting for it to finish, and returns a handle to the job
+func (s *StoragePoolService) ChangeStoragePoolScopeAsync(ctx context.Context, p *ChangeStoragePoolScopeParams) (*Job, error) {
+	r, err := s.ChangeStoragePoolScopeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("changeStoragePoolScope", r.JobID), nil
+}
+
 type ChangeStoragePoolScopeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeStoragePoolScopeResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeStoragePoolScopeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableStorageMaintenanceParams struct {
@@ -325,6 +510,44 @@ func (p *EnableStorageMaintenanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableStorageMaintenance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableStorageMaintenanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableStorageMaintenance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableStorageMaintenance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableStorageMaintenanceParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("enableStorageMaintenance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableStorageMaintenance API, before the request is signed
+func (p *EnableStorageMaintenanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableStorageMaintenanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableStorageMaintenanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableStorageMaintenance", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableStorageMaintenanceParams) Clone() *EnableStorageMaintenanceParams {
+	return &EnableStorageMaintenanceParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableStorageMaintenanceParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -357,32 +580,39 @@ func (s *StoragePoolService) NewEnableStorageMaintenanceParams(id string) *Enabl
 
 // Puts storage pool into maintenance state
 func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
-	resp, err := s.cs.newPostRequest("enableStorageMaintenance", p.toURLValues())
+	return s.EnableStorageMaintenanceWithContext(context.Background(), p)
+}
+
+// EnableStorageMaintenanceWithContext is like EnableStorageMaintenance, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableStorageMaintenance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableStorageMaintenanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableStorageMaintenance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableStorageMaintenance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableStorageMaintenance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -390,43 +620,68 @@ func (s *StoragePoolService) EnableStorageMaintenance(p *EnableStorageMaintenanc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableStorageMaintenance async job into r
+func (r *EnableStorageMaintenanceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// EnableStorageMaintenanceAsync starts the async job of EnableStorageMaintenance without waiting for it to finish, and returns a handle to the job
+func (s *StoragePoolService) EnableStorageMaintenanceAsync(ctx context.Context, p *EnableStorageMaintenanceParams) (*Job, error) {
+	r, err := s.EnableStorageMaintenanceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableStorageMaintenance", r.JobID), nil
+}
+
 type EnableStorageMaintenanceResponse struct {
-	Allocatediops        int64             `json:"allocatediops"`
-	Capacitybytes        int64             `json:"capacitybytes"`
-	Capacityiops         int64             `json:"capacityiops"`
-	Clusterid            string            `json:"clusterid"`
-	Clustername          string            `json:"clustername"`
-	Created              string            `json:"created"`
-	Details              map[string]string `json:"details"`
-	Disksizeallocated    int64             `json:"disksizeallocated"`
-	Disksizetotal        int64             `json:"disksizetotal"`
-	Disksizeused         int64             `json:"disksizeused"`
-	Hasannotations       bool              `json:"hasannotations"`
-	Hypervisor           string            `json:"hypervisor"`
-	Id                   string            `json:"id"`
-	Ipaddress            string            `json:"ipaddress"`
-	Istagarule           bool              `json:"istagarule"`
-	JobID                string            `json:"jobid"`
-	Jobstatus            int               `json:"jobstatus"`
-	Managed              bool              `json:"managed"`
-	Name                 string            `json:"name"`
-	Nfsmountopts         string            `json:"nfsmountopts"`
-	Overprovisionfactor  string            `json:"overprovisionfactor"`
-	Path                 string            `json:"path"`
-	Podid                string            `json:"podid"`
-	Podname              string            `json:"podname"`
-	Provider             string            `json:"provider"`
-	Scope                string            `json:"scope"`
-	State                string            `json:"state"`
-	Storageaccessgroups  string            `json:"storageaccessgroups"`
-	Storagecapabilities  map[string]string `json:"storagecapabilities"`
-	Storagecustomstats   map[string]string `json:"storagecustomstats"`
-	Suitableformigration bool              `json:"suitableformigration"`
-	Tags                 string            `json:"tags"`
-	Type                 string            `json:"type"`
-	Usediops             int64             `json:"usediops"`
-	Zoneid               string            `json:"zoneid"`
-	Zonename             string            `json:"zonename"`
+	Allocatediops        FlexInt64                  `json:"allocatediops"`
+	Capacitybytes        FlexInt64                  `json:"capacitybytes"`
+	Capacityiops         FlexInt64                  `json:"capacityiops"`
+	Clusterid            string                     `json:"clusterid"`
+	Clustername          string                     `json:"clustername"`
+	Created              Time                       `json:"created"`
+	Details              map[string]string          `json:"details"`
+	Disksizeallocated    FlexInt64                  `json:"disksizeallocated"`
+	Disksizetotal        FlexInt64                  `json:"disksizetotal"`
+	Disksizeused         FlexInt64                  `json:"disksizeused"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Hypervisor           string                     `json:"hypervisor"`
+	Id                   string                     `json:"id"`
+	Ipaddress            string                     `json:"ipaddress"`
+	Istagarule           FlexBool                   `json:"istagarule"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managed              FlexBool                   `json:"managed"`
+	Name                 string                     `json:"name"`
+	Nfsmountopts         string                     `json:"nfsmountopts"`
+	Overprovisionfactor  string                     `json:"overprovisionfactor"`
+	Path                 string                     `json:"path"`
+	Podid                string                     `json:"podid"`
+	Podname              string                     `json:"podname"`
+	Provider             string                     `json:"provider"`
+	Scope                string                     `json:"scope"`
+	State                string                     `json:"state"`
+	Storageaccessgroups  string                     `json:"storageaccessgroups"`
+	Storagecapabilities  map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats   map[string]string          `json:"storagecustomstats"`
+	Suitableformigration FlexBool                   `json:"suitableformigration"`
+	Tags                 string                     `json:"tags"`
+	Type                 string                     `json:"type"`
+	Usediops             FlexInt64                  `json:"usediops"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableStorageMaintenanceResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableStorageMaintenanceResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListAffectedVmsForStorageScopeChangeParams struct {
@@ -458,6 +713,48 @@ func (p *ListAffectedVmsForStorageScopeChangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listAffectedVmsForStorageScopeChange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListAffectedVmsForStorageScopeChangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listAffectedVmsForStorageScopeChange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listAffectedVmsForStorageScopeChange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListAffectedVmsForStorageScopeChangeParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid", "storageid"},
+		lengths:  map[string]int{"clusterid": 255, "keyword": 255, "storageid": 255},
+	}.validate("listAffectedVmsForStorageScopeChange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listAffectedVmsForStorageScopeChange API, before the request is signed
+func (p *ListAffectedVmsForStorageScopeChangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListAffectedVmsForStorageScopeChangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListAffectedVmsForStorageScopeChangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listAffectedVmsForStorageScopeChange", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+		"keyword":   decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"storageid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListAffectedVmsForStorageScopeChangeParams) Clone() *ListAffectedVmsForStorageScopeChangeParams {
+	return &ListAffectedVmsForStorageScopeChangeParams{p: cloneParams(p.p)}
+}
+
 func (p *ListAffectedVmsForStorageScopeChangeParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -575,6 +872,11 @@ func (s *StoragePoolService) NewListAffectedVmsForStorageScopeChangeParams(clust
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetAffectedVmsForStorageScopeChangeID(keyword string, clusterid string, storageid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetAffectedVmsForStorageScopeChangeIDWithContext(context.Background(), keyword, clusterid, storageid, opts...)
+}
+
+// GetAffectedVmsForStorageScopeChangeIDWithContext is like GetAffectedVmsForStorageScopeChangeID, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetAffectedVmsForStorageScopeChangeIDWithContext(ctx context.Context, keyword string, clusterid string, storageid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListAffectedVmsForStorageScopeChangeParams{}
 	p.p = make(map[string]interface{})
 
@@ -588,7 +890,7 @@ func (s *StoragePoolService) GetAffectedVmsForStorageScopeChangeID(keyword strin
 		}
 	}
 
-	l, err := s.ListAffectedVmsForStorageScopeChange(p)
+	l, err := s.ListAffectedVmsForStorageScopeChangeWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -613,34 +915,77 @@ func (s *StoragePoolService) GetAffectedVmsForStorageScopeChangeID(keyword strin
 
 // List user and system VMs that need to be stopped and destroyed respectively for changing the scope of the storage pool from Zone to Cluster.
 func (s *StoragePoolService) ListAffectedVmsForStorageScopeChange(p *ListAffectedVmsForStorageScopeChangeParams) (*ListAffectedVmsForStorageScopeChangeResponse, error) {
-	resp, err := s.cs.newRequest("listAffectedVmsForStorageScopeChange", p.toURLValues())
+	return s.ListAffectedVmsForStorageScopeChangeWithContext(context.Background(), p)
+}
+
+// ListAffectedVmsForStorageScopeChangeWithContext is like ListAffectedVmsForStorageScopeChange, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListAffectedVmsForStorageScopeChangeWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams) (*ListAffectedVmsForStorageScopeChangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listAffectedVmsForStorageScopeChange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListAffectedVmsForStorageScopeChangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listAffectedVmsForStorageScopeChange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListAffectedVmsForStorageScopeChangeAll returns all AffectedVmsForStorageScopeChange matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *StoragePoolService) ListAffectedVmsForStorageScopeChangeAll(p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) ([]*AffectedVmsForStorageScopeChange, error) {
+	return s.ListAffectedVmsForStorageScopeChangeAllWithContext(context.Background(), p, opts...)
+}
+
+// ListAffectedVmsForStorageScopeChangeAllWithContext is like ListAffectedVmsForStorageScopeChangeAll, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListAffectedVmsForStorageScopeChangeAllWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) ([]*AffectedVmsForStorageScopeChange, error) {
+	return collectPages(s.ListAffectedVmsForStorageScopeChangeIterWithContext(ctx, p, opts...))
+}
+
+// ListAffectedVmsForStorageScopeChangeIter returns an iterator over all AffectedVmsForStorageScopeChange matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *StoragePoolService) ListAffectedVmsForStorageScopeChangeIter(p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) iter.Seq2[*AffectedVmsForStorageScopeChange, error] {
+	return s.ListAffectedVmsForStorageScopeChangeIterWithContext(context.Background(), p, opts...)
+}
+
+// ListAffectedVmsForStorageScopeChangeIterWithContext is like ListAffectedVmsForStorageScopeChangeIter, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListAffectedVmsForStorageScopeChangeIterWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) iter.Seq2[*AffectedVmsForStorageScopeChange, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*AffectedVmsForStorageScopeChange, int, error) {
+		l, err := s.ListAffectedVmsForStorageScopeChangeWithContext(ctx, &ListAffectedVmsForStorageScopeChangeParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.AffectedVmsForStorageScopeChange, l.Count, nil
+	}, opts...)
+}
+
 type ListAffectedVmsForStorageScopeChangeResponse struct {
 	Count                            int                                 `json:"count"`
 	AffectedVmsForStorageScopeChange []*AffectedVmsForStorageScopeChange `json:"affectedvmsforstoragescopechange"`
 }
 
 type AffectedVmsForStorageScopeChange struct {
-	Clusterid   string `json:"clusterid"`
-	Clustername string `json:"clustername"`
-	Hostid      string `json:"hostid"`
-	Hostname    string `json:"hostname"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Type        string `json:"type"`
+	Clusterid   string                     `json:"clusterid"`
+	Clustername string                     `json:"clustername"`
+	Hostid      string                     `json:"hostid"`
+	Hostname    string                     `json:"hostname"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Type        string                     `json:"type"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AffectedVmsForStorageScopeChange) UnmarshalJSON(b []byte) error {
+	type alias AffectedVmsForStorageScopeChange
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStorageProvidersParams struct {
@@ -669,6 +1014,47 @@ func (p *ListStorageProvidersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStorageProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStorageProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStorageProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStorageProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStorageProvidersParams) Validate() error {
+	return paramRules{
+		required: []string{"type"},
+		lengths:  map[string]int{"keyword": 255, "type": 255},
+	}.validate("listStorageProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStorageProviders API, before the request is signed
+func (p *ListStorageProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStorageProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStorageProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStorageProviders", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"type":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStorageProvidersParams) Clone() *ListStorageProvidersParams {
+	return &ListStorageProvidersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStorageProvidersParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -764,29 +1150,72 @@ func (s *StoragePoolService) NewListStorageProvidersParams(storagePoolType strin
 
 // Lists storage providers.
 func (s *StoragePoolService) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listStorageProviders", p.toURLValues())
+	return s.ListStorageProvidersWithContext(context.Background(), p)
+}
+
+// ListStorageProvidersWithContext is like ListStorageProviders, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStorageProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStorageProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStorageProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStorageProvidersAll returns all StorageProviders matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *StoragePoolService) ListStorageProvidersAll(p *ListStorageProvidersParams, opts ...PageOption) ([]*StorageProvider, error) {
+	return s.ListStorageProvidersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageProvidersAllWithContext is like ListStorageProvidersAll, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStorageProvidersAllWithContext(ctx context.Context, p *ListStorageProvidersParams, opts ...PageOption) ([]*StorageProvider, error) {
+	return collectPages(s.ListStorageProvidersIterWithContext(ctx, p, opts...))
+}
+
+// ListStorageProvidersIter returns an iterator over all StorageProviders matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *StoragePoolService) ListStorageProvidersIter(p *ListStorageProvidersParams, opts ...PageOption) iter.Seq2[*StorageProvider, error] {
+	return s.ListStorageProvidersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStorageProvidersIterWithContext is like ListStorageProvidersIter, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStorageProvidersIterWithContext(ctx context.Context, p *ListStorageProvidersParams, opts ...PageOption) iter.Seq2[*StorageProvider, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StorageProvider, int, error) {
+		l, err := s.ListStorageProvidersWithContext(ctx, &ListStorageProvidersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StorageProviders, l.Count, nil
+	}, opts...)
+}
+
 type ListStorageProvidersResponse struct {
 	Count            int                `json:"count"`
 	StorageProviders []*StorageProvider `json:"storageprovider"`
 }
 
 type StorageProvider struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Type      string `json:"type"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Type      string                     `json:"type"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StorageProvider) UnmarshalJSON(b []byte) error {
+	type alias StorageProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListObjectStoragePoolsParams struct {
@@ -821,6 +1250,48 @@ func (p *ListObjectStoragePoolsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listObjectStoragePools API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListObjectStoragePoolsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listObjectStoragePools", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listObjectStoragePools API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListObjectStoragePoolsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "name": 255, "provider": 255},
+	}.validate("listObjectStoragePools", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listObjectStoragePools API, before the request is signed
+func (p *ListObjectStoragePoolsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListObjectStoragePoolsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListObjectStoragePoolsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listObjectStoragePools", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"name":     decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"provider": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListObjectStoragePoolsParams) Clone() *ListObjectStoragePoolsParams {
+	return &ListObjectStoragePoolsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListObjectStoragePoolsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -957,6 +1428,11 @@ func (s *StoragePoolService) NewListObjectStoragePoolsParams() *ListObjectStorag
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetObjectStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetObjectStoragePoolIDWithContext(context.Background(), name, opts...)
+}
+
+// GetObjectStoragePoolIDWithContext is like GetObjectStoragePoolID, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetObjectStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListObjectStoragePoolsParams{}
 	p.p = make(map[string]interface{})
 
@@ -968,7 +1444,7 @@ func (s *StoragePoolService) GetObjectStoragePoolID(name string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListObjectStoragePools(p)
+	l, err := s.ListObjectStoragePoolsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -993,12 +1469,17 @@ func (s *StoragePoolService) GetObjectStoragePoolID(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetObjectStoragePoolByName(name string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
-	id, count, err := s.GetObjectStoragePoolID(name, opts...)
+	return s.GetObjectStoragePoolByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetObjectStoragePoolByNameWithContext is like GetObjectStoragePoolByName, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetObjectStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	id, count, err := s.GetObjectStoragePoolIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetObjectStoragePoolByID(id, opts...)
+	r, count, err := s.GetObjectStoragePoolByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1007,6 +1488,11 @@ func (s *StoragePoolService) GetObjectStoragePoolByName(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetObjectStoragePoolByID(id string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	return s.GetObjectStoragePoolByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetObjectStoragePoolByIDWithContext is like GetObjectStoragePoolByID, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetObjectStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
 	p := &ListObjectStoragePoolsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1018,11 +1504,10 @@ func (s *StoragePoolService) GetObjectStoragePoolByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListObjectStoragePools(p)
+	l, err := s.ListObjectStoragePoolsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1040,35 +1525,78 @@ func (s *StoragePoolService) GetObjectStoragePoolByID(id string, opts ...OptionF
 
 // Lists object storage pools.
 func (s *StoragePoolService) ListObjectStoragePools(p *ListObjectStoragePoolsParams) (*ListObjectStoragePoolsResponse, error) {
-	resp, err := s.cs.newRequest("listObjectStoragePools", p.toURLValues())
+	return s.ListObjectStoragePoolsWithContext(context.Background(), p)
+}
+
+// ListObjectStoragePoolsWithContext is like ListObjectStoragePools, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListObjectStoragePoolsWithContext(ctx context.Context, p *ListObjectStoragePoolsParams) (*ListObjectStoragePoolsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listObjectStoragePools", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListObjectStoragePoolsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listObjectStoragePools", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListObjectStoragePoolsAll returns all ObjectStoragePools matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *StoragePoolService) ListObjectStoragePoolsAll(p *ListObjectStoragePoolsParams, opts ...PageOption) ([]*ObjectStoragePool, error) {
+	return s.ListObjectStoragePoolsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListObjectStoragePoolsAllWithContext is like ListObjectStoragePoolsAll, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListObjectStoragePoolsAllWithContext(ctx context.Context, p *ListObjectStoragePoolsParams, opts ...PageOption) ([]*ObjectStoragePool, error) {
+	return collectPages(s.ListObjectStoragePoolsIterWithContext(ctx, p, opts...))
+}
+
+// ListObjectStoragePoolsIter returns an iterator over all ObjectStoragePools matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *StoragePoolService) ListObjectStoragePoolsIter(p *ListObjectStoragePoolsParams, opts ...PageOption) iter.Seq2[*ObjectStoragePool, error] {
+	return s.ListObjectStoragePoolsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListObjectStoragePoolsIterWithContext is like ListObjectStoragePoolsIter, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListObjectStoragePoolsIterWithContext(ctx context.Context, p *ListObjectStoragePoolsParams, opts ...PageOption) iter.Seq2[*ObjectStoragePool, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ObjectStoragePool, int, error) {
+		l, err := s.ListObjectStoragePoolsWithContext(ctx, &ListObjectStoragePoolsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ObjectStoragePools, l.Count, nil
+	}, opts...)
+}
+
 type ListObjectStoragePoolsResponse struct {
 	Count              int                  `json:"count"`
 	ObjectStoragePools []*ObjectStoragePool `json:"objectstore"`
 }
 
 type ObjectStoragePool struct {
-	Hasannotations   bool   `json:"hasannotations"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Providername     string `json:"providername"`
-	Storageallocated int64  `json:"storageallocated"`
-	Storagetotal     int64  `json:"storagetotal"`
-	Storageused      int64  `json:"storageused"`
-	Url              string `json:"url"`
+	Hasannotations   FlexBool                   `json:"hasannotations"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Providername     string                     `json:"providername"`
+	Storageallocated FlexInt64                  `json:"storageallocated"`
+	Storagetotal     FlexInt64                  `json:"storagetotal"`
+	Storageused      FlexInt64                  `json:"storageused"`
+	Url              string                     `json:"url"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ObjectStoragePool) UnmarshalJSON(b []byte) error {
+	type alias ObjectStoragePool
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStoragePoolObjectsParams struct {
@@ -1100,6 +1628,48 @@ func (p *ListStoragePoolObjectsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStoragePoolObjects API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStoragePoolObjectsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStoragePoolObjects", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStoragePoolObjects API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStoragePoolObjectsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "keyword": 255, "path": 255},
+	}.validate("listStoragePoolObjects", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStoragePoolObjects API, before the request is signed
+func (p *ListStoragePoolObjectsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStoragePoolObjectsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStoragePoolObjectsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStoragePoolObjects", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"path":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStoragePoolObjectsParams) Clone() *ListStoragePoolObjectsParams {
+	return &ListStoragePoolObjectsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStoragePoolObjectsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1216,6 +1786,11 @@ func (s *StoragePoolService) NewListStoragePoolObjectsParams(id string) *ListSto
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetStoragePoolObjectByID(id string, opts ...OptionFunc) (*StoragePoolObject, int, error) {
+	return s.GetStoragePoolObjectByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStoragePoolObjectByIDWithContext is like GetStoragePoolObjectByID, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetStoragePoolObjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolObject, int, error) {
 	p := &ListStoragePoolObjectsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1227,11 +1802,10 @@ func (s *StoragePoolService) GetStoragePoolObjectByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListStoragePoolObjects(p)
+	l, err := s.ListStoragePoolObjectsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1249,38 +1823,81 @@ func (s *StoragePoolService) GetStoragePoolObjectByID(id string, opts ...OptionF
 
 // Lists objects at specified path on a storage pool.
 func (s *StoragePoolService) ListStoragePoolObjects(p *ListStoragePoolObjectsParams) (*ListStoragePoolObjectsResponse, error) {
-	resp, err := s.cs.newRequest("listStoragePoolObjects", p.toURLValues())
+	return s.ListStoragePoolObjectsWithContext(context.Background(), p)
+}
+
+// ListStoragePoolObjectsWithContext is like ListStoragePoolObjects, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStoragePoolObjectsWithContext(ctx context.Context, p *ListStoragePoolObjectsParams) (*ListStoragePoolObjectsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStoragePoolObjects", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStoragePoolObjectsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStoragePoolObjects", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStoragePoolObjectsAll returns all StoragePoolObjects matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *StoragePoolService) ListStoragePoolObjectsAll(p *ListStoragePoolObjectsParams, opts ...PageOption) ([]*StoragePoolObject, error) {
+	return s.ListStoragePoolObjectsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStoragePoolObjectsAllWithContext is like ListStoragePoolObjectsAll, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStoragePoolObjectsAllWithContext(ctx context.Context, p *ListStoragePoolObjectsParams, opts ...PageOption) ([]*StoragePoolObject, error) {
+	return collectPages(s.ListStoragePoolObjectsIterWithContext(ctx, p, opts...))
+}
+
+// ListStoragePoolObjectsIter returns an iterator over all StoragePoolObjects matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *StoragePoolService) ListStoragePoolObjectsIter(p *ListStoragePoolObjectsParams, opts ...PageOption) iter.Seq2[*StoragePoolObject, error] {
+	return s.ListStoragePoolObjectsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStoragePoolObjectsIterWithContext is like ListStoragePoolObjectsIter, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStoragePoolObjectsIterWithContext(ctx context.Context, p *ListStoragePoolObjectsParams, opts ...PageOption) iter.Seq2[*StoragePoolObject, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StoragePoolObject, int, error) {
+		l, err := s.ListStoragePoolObjectsWithContext(ctx, &ListStoragePoolObjectsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StoragePoolObjects, l.Count, nil
+	}, opts...)
+}
+
 type ListStoragePoolObjectsResponse struct {
 	Count              int                  `json:"count"`
 	StoragePoolObjects []*StoragePoolObject `json:"datastoreobject"`
 }
 
 type StoragePoolObject struct {
-	Format       string `json:"format"`
-	Isdirectory  bool   `json:"isdirectory"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Lastupdated  string `json:"lastupdated"`
-	Name         string `json:"name"`
-	Size         int64  `json:"size"`
-	Snapshotid   string `json:"snapshotid"`
-	Snapshotname string `json:"snapshotname"`
-	Templateid   string `json:"templateid"`
-	Templatename string `json:"templatename"`
-	Volumeid     string `json:"volumeid"`
-	Volumename   string `json:"volumename"`
+	Format       string                     `json:"format"`
+	Isdirectory  FlexBool                   `json:"isdirectory"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Lastupdated  Time                       `json:"lastupdated"`
+	Name         string                     `json:"name"`
+	Size         FlexInt64                  `json:"size"`
+	Snapshotid   string                     `json:"snapshotid"`
+	Snapshotname string                     `json:"snapshotname"`
+	Templateid   string                     `json:"templateid"`
+	Templatename string                     `json:"templatename"`
+	Volumeid     string                     `json:"volumeid"`
+	Volumename   string                     `json:"volumename"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StoragePoolObject) UnmarshalJSON(b []byte) error {
+	type alias StoragePoolObject
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateObjectStoragePoolParams struct {
@@ -1308,6 +1925,47 @@ func (p *UpdateObjectStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateObjectStoragePool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateObjectStoragePoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateObjectStoragePool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateObjectStoragePool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateObjectStoragePoolParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "name": 255, "url": 2048},
+	}.validate("updateObjectStoragePool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateObjectStoragePool API, before the request is signed
+func (p *UpdateObjectStoragePoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateObjectStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateObjectStoragePoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateObjectStoragePool", b, &p.p, map[string]paramDecoder{
+		"id":   decodeParam[string],
+		"name": decodeParam[string],
+		"size": decodeParam[int64],
+		"url":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateObjectStoragePoolParams) Clone() *UpdateObjectStoragePoolParams {
+	return &UpdateObjectStoragePoolParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateObjectStoragePoolParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1403,7 +2061,16 @@ func (s *StoragePoolService) NewUpdateObjectStoragePoolParams(id string) *Update
 
 // Updates object storage pool
 func (s *StoragePoolService) UpdateObjectStoragePool(p *UpdateObjectStoragePoolParams) (*UpdateObjectStoragePoolResponse, error) {
-	resp, err := s.cs.newPostRequest("updateObjectStoragePool", p.toURLValues())
+	return s.UpdateObjectStoragePoolWithContext(context.Background(), p)
+}
+
+// UpdateObjectStoragePoolWithContext is like UpdateObjectStoragePool, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) UpdateObjectStoragePoolWithContext(ctx context.Context, p *UpdateObjectStoragePoolParams) (*UpdateObjectStoragePoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateObjectStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1411,7 +2078,7 @@ func (s *StoragePoolService) UpdateObjectStoragePool(p *UpdateObjectStoragePoolP
 	var nested struct {
 		Response UpdateObjectStoragePoolResponse `json:"objectstore"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateObjectStoragePool", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -1420,16 +2087,22 @@ func (s *StoragePoolService) UpdateObjectStoragePool(p *UpdateObjectStoragePoolP
 }
 
 type UpdateObjectStoragePoolResponse struct {
-	Hasannotations   bool   `json:"hasannotations"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Providername     string `json:"providername"`
-	Storageallocated int64  `json:"storageallocated"`
-	Storagetotal     int64  `json:"storagetotal"`
-	Storageused      int64  `json:"storageused"`
-	Url              string `json:"url"`
+	Hasannotations   FlexBool                   `json:"hasannotations"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Providername     string                     `json:"providername"`
+	Storageallocated FlexInt64                  `json:"storageallocated"`
+	Storagetotal     FlexInt64                  `json:"storagetotal"`
+	Storageused      FlexInt64                  `json:"storageused"`
+	Url              string                     `json:"url"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateObjectStoragePoolResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateObjectStoragePoolResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddObjectStoragePoolParams struct {
@@ -1467,6 +2140,49 @@ func (p *AddObjectStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addObjectStoragePool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddObjectStoragePoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addObjectStoragePool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addObjectStoragePool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddObjectStoragePoolParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "provider", "url"},
+		lengths:  map[string]int{"name": 255, "provider": 255, "tags": 4096, "url": 2048},
+	}.validate("addObjectStoragePool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addObjectStoragePool API, before the request is signed
+func (p *AddObjectStoragePoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddObjectStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddObjectStoragePoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addObjectStoragePool", b, &p.p, map[string]paramDecoder{
+		"details":  decodeParam[map[string]string],
+		"name":     decodeParam[string],
+		"provider": decodeParam[string],
+		"size":     decodeParam[int64],
+		"tags":     decodeParam[string],
+		"url":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddObjectStoragePoolParams) Clone() *AddObjectStoragePoolParams {
+	return &AddObjectStoragePoolParams{p: cloneParams(p.p)}
+}
+
 func (p *AddObjectStoragePoolParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1606,7 +2322,16 @@ func (s *StoragePoolService) NewAddObjectStoragePoolParams(name string, provider
 
 // Adds a object storage pool
 func (s *StoragePoolService) AddObjectStoragePool(p *AddObjectStoragePoolParams) (*AddObjectStoragePoolResponse, error) {
-	resp, err := s.cs.newPostRequest("addObjectStoragePool", p.toURLValues())
+	return s.AddObjectStoragePoolWithContext(context.Background(), p)
+}
+
+// AddObjectStoragePoolWithContext is like AddObjectStoragePool, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) AddObjectStoragePoolWithContext(ctx context.Context, p *AddObjectStoragePoolParams) (*AddObjectStoragePoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addObjectStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1614,7 +2339,7 @@ func (s *StoragePoolService) AddObjectStoragePool(p *AddObjectStoragePoolParams)
 	var nested struct {
 		Response AddObjectStoragePoolResponse `json:"objectstore"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("addObjectStoragePool", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -1623,16 +2348,22 @@ func (s *StoragePoolService) AddObjectStoragePool(p *AddObjectStoragePoolParams)
 }
 
 type AddObjectStoragePoolResponse struct {
-	Hasannotations   bool   `json:"hasannotations"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Providername     string `json:"providername"`
-	Storageallocated int64  `json:"storageallocated"`
-	Storagetotal     int64  `json:"storagetotal"`
-	Storageused      int64  `json:"storageused"`
-	Url              string `json:"url"`
+	Hasannotations   FlexBool                   `json:"hasannotations"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Providername     string                     `json:"providername"`
+	Storageallocated FlexInt64                  `json:"storageallocated"`
+	Storagetotal     FlexInt64                  `json:"storagetotal"`
+	Storageused      FlexInt64                  `json:"storageused"`
+	Url              string                     `json:"url"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddObjectStoragePoolResponse) UnmarshalJSON(b []byte) error {
+	type alias AddObjectStoragePoolResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteObjectStoragePoolParams struct {
@@ -1650,6 +2381,44 @@ func (p *DeleteObjectStoragePoolParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteObjectStoragePool API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteObjectStoragePoolParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteObjectStoragePool", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteObjectStoragePool API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteObjectStoragePoolParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteObjectStoragePool", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteObjectStoragePool API, before the request is signed
+func (p *DeleteObjectStoragePoolParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteObjectStoragePoolParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteObjectStoragePoolParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteObjectStoragePool", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteObjectStoragePoolParams) Clone() *DeleteObjectStoragePoolParams {
+	return &DeleteObjectStoragePoolParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteObjectStoragePoolParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1682,13 +2451,22 @@ func (s *StoragePoolService) NewDeleteObjectStoragePoolParams(id string) *Delete
 
 // Deletes an Object Storage Pool
 func (s *StoragePoolService) DeleteObjectStoragePool(p *DeleteObjectStoragePoolParams) (*DeleteObjectStoragePoolResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteObjectStoragePool", p.toURLValues())
+	return s.DeleteObjectStoragePoolWithContext(context.Background(), p)
+}
+
+// DeleteObjectStoragePoolWithContext is like DeleteObjectStoragePool, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) DeleteObjectStoragePoolWithContext(ctx context.Context, p *DeleteObjectStoragePoolParams) (*DeleteObjectStoragePoolResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteObjectStoragePool", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteObjectStoragePoolResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteObjectStoragePool", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1696,10 +2474,11 @@ func (s *StoragePoolService) DeleteObjectStoragePool(p *DeleteObjectStoragePoolP
 }
 
 type DeleteObjectStoragePoolResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteObjectStoragePoolResponse) UnmarshalJSON(b []byte) error {
@@ -1726,7 +2505,7 @@ func (r *DeleteObjectStoragePoolResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteObjectStoragePoolResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStoragePoolsMetricsParams struct {
@@ -1789,6 +2568,57 @@ func (p *ListStoragePoolsMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStoragePoolsMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStoragePoolsMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStoragePoolsMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStoragePoolsMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStoragePoolsMetricsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"clusterid": 255, "hostid": 255, "id": 255, "ipaddress": 255, "keyword": 255, "name": 255, "path": 255, "podid": 255, "scope": 255, "status": 255, "storageaccessgroup": 255, "zoneid": 255},
+	}.validate("listStoragePoolsMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStoragePoolsMetrics API, before the request is signed
+func (p *ListStoragePoolsMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStoragePoolsMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStoragePoolsMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStoragePoolsMetrics", b, &p.p, map[string]paramDecoder{
+		"clusterid":          decodeParam[string],
+		"hostid":             decodeParam[string],
+		"id":                 decodeParam[string],
+		"ipaddress":          decodeParam[string],
+		"keyword":            decodeParam[string],
+		"name":               decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"path":               decodeParam[string],
+		"podid":              decodeParam[string],
+		"scope":              decodeParam[string],
+		"status":             decodeParam[string],
+		"storageaccessgroup": decodeParam[string],
+		"storagecustomstats": decodeParam[bool],
+		"zoneid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStoragePoolsMetricsParams) Clone() *ListStoragePoolsMetricsParams {
+	return &ListStoragePoolsMetricsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStoragePoolsMetricsParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2114,6 +2944,11 @@ func (s *StoragePoolService) NewListStoragePoolsMetricsParams() *ListStoragePool
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetStoragePoolsMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetStoragePoolsMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolsMetricIDWithContext is like GetStoragePoolsMetricID, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetStoragePoolsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListStoragePoolsMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2125,7 +2960,7 @@ func (s *StoragePoolService) GetStoragePoolsMetricID(name string, opts ...Option
 		}
 	}
 
-	l, err := s.ListStoragePoolsMetrics(p)
+	l, err := s.ListStoragePoolsMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2150,12 +2985,17 @@ func (s *StoragePoolService) GetStoragePoolsMetricID(name string, opts ...Option
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetStoragePoolsMetricByName(name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
-	id, count, err := s.GetStoragePoolsMetricID(name, opts...)
+	return s.GetStoragePoolsMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetStoragePoolsMetricByNameWithContext is like GetStoragePoolsMetricByName, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetStoragePoolsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	id, count, err := s.GetStoragePoolsMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetStoragePoolsMetricByID(id, opts...)
+	r, count, err := s.GetStoragePoolsMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2164,6 +3004,11 @@ func (s *StoragePoolService) GetStoragePoolsMetricByName(name string, opts ...Op
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *StoragePoolService) GetStoragePoolsMetricByID(id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	return s.GetStoragePoolsMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStoragePoolsMetricByIDWithContext is like GetStoragePoolsMetricByID, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) GetStoragePoolsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
 	p := &ListStoragePoolsMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2175,11 +3020,10 @@ func (s *StoragePoolService) GetStoragePoolsMetricByID(id string, opts ...Option
 		}
 	}
 
-	l, err := s.ListStoragePoolsMetrics(p)
+	l, err := s.ListStoragePoolsMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2197,67 +3041,110 @@ func (s *StoragePoolService) GetStoragePoolsMetricByID(id string, opts ...Option
 
 // Lists storage pool metrics
 func (s *StoragePoolService) ListStoragePoolsMetrics(p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listStoragePoolsMetrics", p.toURLValues())
+	return s.ListStoragePoolsMetricsWithContext(context.Background(), p)
+}
+
+// ListStoragePoolsMetricsWithContext is like ListStoragePoolsMetrics, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStoragePoolsMetricsWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStoragePoolsMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStoragePoolsMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStoragePoolsMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStoragePoolsMetricsAll returns all StoragePoolsMetrics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *StoragePoolService) ListStoragePoolsMetricsAll(p *ListStoragePoolsMetricsParams, opts ...PageOption) ([]*StoragePoolsMetric, error) {
+	return s.ListStoragePoolsMetricsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStoragePoolsMetricsAllWithContext is like ListStoragePoolsMetricsAll, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStoragePoolsMetricsAllWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams, opts ...PageOption) ([]*StoragePoolsMetric, error) {
+	return collectPages(s.ListStoragePoolsMetricsIterWithContext(ctx, p, opts...))
+}
+
+// ListStoragePoolsMetricsIter returns an iterator over all StoragePoolsMetrics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *StoragePoolService) ListStoragePoolsMetricsIter(p *ListStoragePoolsMetricsParams, opts ...PageOption) iter.Seq2[*StoragePoolsMetric, error] {
+	return s.ListStoragePoolsMetricsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStoragePoolsMetricsIterWithContext is like ListStoragePoolsMetricsIter, but honours the cancellation and deadline of ctx
+func (s *StoragePoolService) ListStoragePoolsMetricsIterWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams, opts ...PageOption) iter.Seq2[*StoragePoolsMetric, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StoragePoolsMetric, int, error) {
+		l, err := s.ListStoragePoolsMetricsWithContext(ctx, &ListStoragePoolsMetricsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StoragePoolsMetrics, l.Count, nil
+	}, opts...)
+}
+
 type ListStoragePoolsMetricsResponse struct {
 	Count               int                   `json:"count"`
 	StoragePoolsMetrics []*StoragePoolsMetric `json:"storagepool"`
 }
 
 type StoragePoolsMetric struct {
-	Allocatediops                    int64             `json:"allocatediops"`
-	Capacitybytes                    int64             `json:"capacitybytes"`
-	Capacityiops                     int64             `json:"capacityiops"`
-	Clusterid                        string            `json:"clusterid"`
-	Clustername                      string            `json:"clustername"`
-	Created                          string            `json:"created"`
-	Details                          map[string]string `json:"details"`
-	Disksizeallocated                int64             `json:"disksizeallocated"`
-	Disksizeallocatedgb              string            `json:"disksizeallocatedgb"`
-	Disksizetotal                    int64             `json:"disksizetotal"`
-	Disksizetotalgb                  string            `json:"disksizetotalgb"`
-	Disksizeunallocatedgb            string            `json:"disksizeunallocatedgb"`
-	Disksizeused                     int64             `json:"disksizeused"`
-	Disksizeusedgb                   string            `json:"disksizeusedgb"`
-	Hasannotations                   bool              `json:"hasannotations"`
-	Hypervisor                       string            `json:"hypervisor"`
-	Id                               string            `json:"id"`
-	Ipaddress                        string            `json:"ipaddress"`
-	Istagarule                       bool              `json:"istagarule"`
-	JobID                            string            `json:"jobid"`
-	Jobstatus                        int               `json:"jobstatus"`
-	Managed                          bool              `json:"managed"`
-	Name                             string            `json:"name"`
-	Nfsmountopts                     string            `json:"nfsmountopts"`
-	Overprovisionfactor              string            `json:"overprovisionfactor"`
-	Path                             string            `json:"path"`
-	Podid                            string            `json:"podid"`
-	Podname                          string            `json:"podname"`
-	Provider                         string            `json:"provider"`
-	Scope                            string            `json:"scope"`
-	State                            string            `json:"state"`
-	Storageaccessgroups              string            `json:"storageaccessgroups"`
-	Storageallocateddisablethreshold bool              `json:"storageallocateddisablethreshold"`
-	Storageallocatedthreshold        bool              `json:"storageallocatedthreshold"`
-	Storagecapabilities              map[string]string `json:"storagecapabilities"`
-	Storagecustomstats               map[string]string `json:"storagecustomstats"`
-	Storageusagedisablethreshold     bool              `json:"storageusagedisablethreshold"`
-	Storageusagethreshold            bool              `json:"storageusagethreshold"`
-	Suitableformigration             bool              `json:"suitableformigration"`
-	Tags                             string            `json:"tags"`
-	Type                             string            `json:"type"`
-	Usediops                         int64             `json:"usediops"`
-	Zoneid                           string            `json:"zoneid"`
-	Zonename                         string            `json:"zonename"`
+	Allocatediops                    FlexInt64                  `json:"allocatediops"`
+	Capacitybytes                    FlexInt64                  `json:"capacitybytes"`
+	Capacityiops                     FlexInt64                  `json:"capacityiops"`
+	Clusterid                        string                     `json:"clusterid"`
+	Clustername                      string                     `json:"clustername"`
+	Created                          Time                       `json:"created"`
+	Details                          map[string]string          `json:"details"`
+	Disksizeallocated                FlexInt64                  `json:"disksizeallocated"`
+	Disksizeallocatedgb              string                     `json:"disksizeallocatedgb"`
+	Disksizetotal                    FlexInt64                  `json:"disksizetotal"`
+	Disksizetotalgb                  string                     `json:"disksizetotalgb"`
+	Disksizeunallocatedgb            string                     `json:"disksizeunallocatedgb"`
+	Disksizeused                     FlexInt64                  `json:"disksizeused"`
+	Disksizeusedgb                   string                     `json:"disksizeusedgb"`
+	Hasannotations                   FlexBool                   `json:"hasannotations"`
+	Hypervisor                       string                     `json:"hypervisor"`
+	Id                               string                     `json:"id"`
+	Ipaddress                        string                     `json:"ipaddress"`
+	Istagarule                       FlexBool                   `json:"istagarule"`
+	JobID                            string                     `json:"jobid"`
+	Jobstatus                        FlexInt                    `json:"jobstatus"`
+	Managed                          FlexBool                   `json:"managed"`
+	Name                             string                     `json:"name"`
+	Nfsmountopts                     string                     `json:"nfsmountopts"`
+	Overprovisionfactor              string                     `json:"overprovisionfactor"`
+	Path                             string                     `json:"path"`
+	Podid                            string                     `json:"podid"`
+	Podname                          string                     `json:"podname"`
+	Provider                         string                     `json:"provider"`
+	Scope                            string                     `json:"scope"`
+	State                            string                     `json:"state"`
+	Storageaccessgroups              string                     `json:"storageaccessgroups"`
+	Storageallocateddisablethreshold FlexBool                   `json:"storageallocateddisablethreshold"`
+	Storageallocatedthreshold        FlexBool                   `json:"storageallocatedthreshold"`
+	Storagecapabilities              map[string]string          `json:"storagecapabilities"`
+	Storagecustomstats               map[string]string          `json:"storagecustomstats"`
+	Storageusagedisablethreshold     FlexBool                   `json:"storageusagedisablethreshold"`
+	Storageusagethreshold            FlexBool                   `json:"storageusagethreshold"`
+	Suitableformigration             FlexBool                   `json:"suitableformigration"`
+	Tags                             string                     `json:"tags"`
+	Type                             string                     `json:"type"`
+	Usediops                         FlexInt64                  `json:"usediops"`
+	Zoneid                           string                     `json:"zoneid"`
+	Zonename                         string                     `json:"zonename"`
+	Extra                            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StoragePoolsMetric) UnmarshalJSON(b []byte) error {
+	type alias StoragePoolsMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) AddObjectStoragePool(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddObjectStoragePool", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).AddObjectStoragePool), p)
 }
 
+// AddObjectStoragePoolWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) AddObjectStoragePoolWithContext(ctx context.Context, p *AddObjectStoragePoolParams) (*AddObjectStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddObjectStoragePoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddObjectStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddObjectStoragePoolWithContext indicates an expected call of AddObjectStoragePoolWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) AddObjectStoragePoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddObjectStoragePoolWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).AddObjectStoragePoolWithContext), ctx, p)
+}
+
 // CancelStorageMaintenance mocks base method.
 func (m *MockStoragePoolServiceIface) CancelStorageMaintenance(p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) CancelStorageMaintenance(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStorageMaintenance", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).CancelStorageMaintenance), p)
 }
 
+// CancelStorageMaintenanceAsync mocks base method.
+func (m *MockStoragePoolServiceIface) CancelStorageMaintenanceAsync(ctx context.Context, p *CancelStorageMaintenanceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelStorageMaintenanceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelStorageMaintenanceAsync indicates an expected call of CancelStorageMaintenanceAsync.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) CancelStorageMaintenanceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStorageMaintenanceAsync", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).CancelStorageMaintenanceAsync), ctx, p)
+}
+
+// CancelStorageMaintenanceWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) CancelStorageMaintenanceWithContext(ctx context.Context, p *CancelStorageMaintenanceParams) (*CancelStorageMaintenanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelStorageMaintenanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*CancelStorageMaintenanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelStorageMaintenanceWithContext indicates an expected call of CancelStorageMaintenanceWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) CancelStorageMaintenanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelStorageMaintenanceWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).CancelStorageMaintenanceWithContext), ctx, p)
+}
+
 // ChangeStoragePoolScope mocks base method.
 func (m *MockStoragePoolServiceIface) ChangeStoragePoolScope(p *ChangeStoragePoolScopeParams) (*ChangeStoragePoolScopeResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,36 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) ChangeStoragePoolScope(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStoragePoolScope", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ChangeStoragePoolScope), p)
 }
 
+// ChangeStoragePoolScopeAsync mocks base method.
+func (m *MockStoragePoolServiceIface) ChangeStoragePoolScopeAsync(ctx context.Context, p *ChangeStoragePoolScopeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStoragePoolScopeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStoragePoolScopeAsync indicates an expected call of ChangeStoragePoolScopeAsync.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ChangeStoragePoolScopeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStoragePoolScopeAsync", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ChangeStoragePoolScopeAsync), ctx, p)
+}
+
+// ChangeStoragePoolScopeWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ChangeStoragePoolScopeWithContext(ctx context.Context, p *ChangeStoragePoolScopeParams) (*ChangeStoragePoolScopeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeStoragePoolScopeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeStoragePoolScopeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeStoragePoolScopeWithContext indicates an expected call of ChangeStoragePoolScopeWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ChangeStoragePoolScopeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeStoragePoolScopeWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ChangeStoragePoolScopeWithContext), ctx, p)
+}
+
 // DeleteObjectStoragePool mocks base method.
 func (m *MockStoragePoolServiceIface) DeleteObjectStoragePool(p *DeleteObjectStoragePoolParams) (*DeleteObjectStoragePoolResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,21 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) DeleteObjectStoragePool(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStoragePool", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).DeleteObjectStoragePool), p)
 }
 
+// DeleteObjectStoragePoolWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) DeleteObjectStoragePoolWithContext(ctx context.Context, p *DeleteObjectStoragePoolParams) (*DeleteObjectStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteObjectStoragePoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteObjectStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteObjectStoragePoolWithContext indicates an expected call of DeleteObjectStoragePoolWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) DeleteObjectStoragePoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteObjectStoragePoolWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).DeleteObjectStoragePoolWithContext), ctx, p)
+}
+
 // EnableStorageMaintenance mocks base method.
 func (m *MockStoragePoolServiceIface) EnableStorageMaintenance(p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +225,36 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) EnableStorageMaintenance(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStorageMaintenance", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).EnableStorageMaintenance), p)
 }
 
+// EnableStorageMaintenanceAsync mocks base method.
+func (m *MockStoragePoolServiceIface) EnableStorageMaintenanceAsync(ctx context.Context, p *EnableStorageMaintenanceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableStorageMaintenanceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableStorageMaintenanceAsync indicates an expected call of EnableStorageMaintenanceAsync.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) EnableStorageMaintenanceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStorageMaintenanceAsync", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).EnableStorageMaintenanceAsync), ctx, p)
+}
+
+// EnableStorageMaintenanceWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) EnableStorageMaintenanceWithContext(ctx context.Context, p *EnableStorageMaintenanceParams) (*EnableStorageMaintenanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableStorageMaintenanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableStorageMaintenanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableStorageMaintenanceWithContext indicates an expected call of EnableStorageMaintenanceWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) EnableStorageMaintenanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableStorageMaintenanceWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).EnableStorageMaintenanceWithContext), ctx, p)
+}
+
 // GetAffectedVmsForStorageScopeChangeID mocks base method.
 func (m *MockStoragePoolServiceIface) GetAffectedVmsForStorageScopeChangeID(keyword, clusterid, storageid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +276,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetAffectedVmsForStorageScope
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffectedVmsForStorageScopeChangeID", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetAffectedVmsForStorageScopeChangeID), varargs...)
 }
 
+// GetAffectedVmsForStorageScopeChangeIDWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetAffectedVmsForStorageScopeChangeIDWithContext(ctx context.Context, keyword, clusterid, storageid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, clusterid, storageid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetAffectedVmsForStorageScopeChangeIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetAffectedVmsForStorageScopeChangeIDWithContext indicates an expected call of GetAffectedVmsForStorageScopeChangeIDWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetAffectedVmsForStorageScopeChangeIDWithContext(ctx, keyword, clusterid, storageid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, clusterid, storageid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAffectedVmsForStorageScopeChangeIDWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetAffectedVmsForStorageScopeChangeIDWithContext), varargs...)
+}
+
 // GetObjectStoragePoolByID mocks base method.
 func (m *MockStoragePoolServiceIface) GetObjectStoragePoolByID(id string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +318,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetObjectStoragePoolByID(id a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolByID", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetObjectStoragePoolByID), varargs...)
 }
 
+// GetObjectStoragePoolByIDWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetObjectStoragePoolByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStoragePoolByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ObjectStoragePool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObjectStoragePoolByIDWithContext indicates an expected call of GetObjectStoragePoolByIDWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetObjectStoragePoolByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolByIDWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetObjectStoragePoolByIDWithContext), varargs...)
+}
+
 // GetObjectStoragePoolByName mocks base method.
 func (m *MockStoragePoolServiceIface) GetObjectStoragePoolByName(name string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +360,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetObjectStoragePoolByName(na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolByName", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetObjectStoragePoolByName), varargs...)
 }
 
+// GetObjectStoragePoolByNameWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetObjectStoragePoolByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ObjectStoragePool, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStoragePoolByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*ObjectStoragePool)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObjectStoragePoolByNameWithContext indicates an expected call of GetObjectStoragePoolByNameWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetObjectStoragePoolByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolByNameWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetObjectStoragePoolByNameWithContext), varargs...)
+}
+
 // GetObjectStoragePoolID mocks base method.
 func (m *MockStoragePoolServiceIface) GetObjectStoragePoolID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +402,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetObjectStoragePoolID(name a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolID", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetObjectStoragePoolID), varargs...)
 }
 
+// GetObjectStoragePoolIDWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetObjectStoragePoolIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetObjectStoragePoolIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetObjectStoragePoolIDWithContext indicates an expected call of GetObjectStoragePoolIDWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetObjectStoragePoolIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetObjectStoragePoolIDWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetObjectStoragePoolIDWithContext), varargs...)
+}
+
 // GetStoragePoolObjectByID mocks base method.
 func (m *MockStoragePoolServiceIface) GetStoragePoolObjectByID(id string, opts ...OptionFunc) (*StoragePoolObject, int, error) {
 	m.ctrl.T.Helper()
@@ -238,6 +444,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolObjectByID(id a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolObjectByID", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolObjectByID), varargs...)
 }
 
+// GetStoragePoolObjectByIDWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetStoragePoolObjectByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolObject, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoragePoolObjectByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*StoragePoolObject)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolObjectByIDWithContext indicates an expected call of GetStoragePoolObjectByIDWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolObjectByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolObjectByIDWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolObjectByIDWithContext), varargs...)
+}
+
 // GetStoragePoolsMetricByID mocks base method.
 func (m *MockStoragePoolServiceIface) GetStoragePoolsMetricByID(id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +486,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolsMetricByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolsMetricByID", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolsMetricByID), varargs...)
 }
 
+// GetStoragePoolsMetricByIDWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetStoragePoolsMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoragePoolsMetricByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*StoragePoolsMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolsMetricByIDWithContext indicates an expected call of GetStoragePoolsMetricByIDWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolsMetricByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolsMetricByIDWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolsMetricByIDWithContext), varargs...)
+}
+
 // GetStoragePoolsMetricByName mocks base method.
 func (m *MockStoragePoolServiceIface) GetStoragePoolsMetricByName(name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -280,6 +528,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolsMetricByName(n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolsMetricByName", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolsMetricByName), varargs...)
 }
 
+// GetStoragePoolsMetricByNameWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetStoragePoolsMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*StoragePoolsMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoragePoolsMetricByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*StoragePoolsMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolsMetricByNameWithContext indicates an expected call of GetStoragePoolsMetricByNameWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolsMetricByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolsMetricByNameWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolsMetricByNameWithContext), varargs...)
+}
+
 // GetStoragePoolsMetricID mocks base method.
 func (m *MockStoragePoolServiceIface) GetStoragePoolsMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -301,6 +570,27 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolsMetricID(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolsMetricID", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolsMetricID), varargs...)
 }
 
+// GetStoragePoolsMetricIDWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) GetStoragePoolsMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStoragePoolsMetricIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStoragePoolsMetricIDWithContext indicates an expected call of GetStoragePoolsMetricIDWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) GetStoragePoolsMetricIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStoragePoolsMetricIDWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).GetStoragePoolsMetricIDWithContext), varargs...)
+}
+
 // ListAffectedVmsForStorageScopeChange mocks base method.
 func (m *MockStoragePoolServiceIface) ListAffectedVmsForStorageScopeChange(p *ListAffectedVmsForStorageScopeChangeParams) (*ListAffectedVmsForStorageScopeChangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -316,6 +606,99 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) ListAffectedVmsForStorageScop
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffectedVmsForStorageScopeChange", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListAffectedVmsForStorageScopeChange), p)
 }
 
+// ListAffectedVmsForStorageScopeChangeAll mocks base method.
+func (m *MockStoragePoolServiceIface) ListAffectedVmsForStorageScopeChangeAll(p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) ([]*AffectedVmsForStorageScopeChange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffectedVmsForStorageScopeChangeAll", varargs...)
+	ret0, _ := ret[0].([]*AffectedVmsForStorageScopeChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffectedVmsForStorageScopeChangeAll indicates an expected call of ListAffectedVmsForStorageScopeChangeAll.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListAffectedVmsForStorageScopeChangeAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffectedVmsForStorageScopeChangeAll", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListAffectedVmsForStorageScopeChangeAll), varargs...)
+}
+
+// ListAffectedVmsForStorageScopeChangeAllWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListAffectedVmsForStorageScopeChangeAllWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) ([]*AffectedVmsForStorageScopeChange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffectedVmsForStorageScopeChangeAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*AffectedVmsForStorageScopeChange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffectedVmsForStorageScopeChangeAllWithContext indicates an expected call of ListAffectedVmsForStorageScopeChangeAllWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListAffectedVmsForStorageScopeChangeAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffectedVmsForStorageScopeChangeAllWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListAffectedVmsForStorageScopeChangeAllWithContext), varargs...)
+}
+
+// ListAffectedVmsForStorageScopeChangeIter mocks base method.
+func (m *MockStoragePoolServiceIface) ListAffectedVmsForStorageScopeChangeIter(p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) iter.Seq2[*AffectedVmsForStorageScopeChange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffectedVmsForStorageScopeChangeIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AffectedVmsForStorageScopeChange, error])
+	return ret0
+}
+
+// ListAffectedVmsForStorageScopeChangeIter indicates an expected call of ListAffectedVmsForStorageScopeChangeIter.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListAffectedVmsForStorageScopeChangeIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffectedVmsForStorageScopeChangeIter", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListAffectedVmsForStorageScopeChangeIter), varargs...)
+}
+
+// ListAffectedVmsForStorageScopeChangeIterWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListAffectedVmsForStorageScopeChangeIterWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams, opts ...PageOption) iter.Seq2[*AffectedVmsForStorageScopeChange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListAffectedVmsForStorageScopeChangeIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*AffectedVmsForStorageScopeChange, error])
+	return ret0
+}
+
+// ListAffectedVmsForStorageScopeChangeIterWithContext indicates an expected call of ListAffectedVmsForStorageScopeChangeIterWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListAffectedVmsForStorageScopeChangeIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffectedVmsForStorageScopeChangeIterWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListAffectedVmsForStorageScopeChangeIterWithContext), varargs...)
+}
+
+// ListAffectedVmsForStorageScopeChangeWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListAffectedVmsForStorageScopeChangeWithContext(ctx context.Context, p *ListAffectedVmsForStorageScopeChangeParams) (*ListAffectedVmsForStorageScopeChangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListAffectedVmsForStorageScopeChangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListAffectedVmsForStorageScopeChangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListAffectedVmsForStorageScopeChangeWithContext indicates an expected call of ListAffectedVmsForStorageScopeChangeWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListAffectedVmsForStorageScopeChangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListAffectedVmsForStorageScopeChangeWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListAffectedVmsForStorageScopeChangeWithContext), ctx, p)
+}
+
 // ListObjectStoragePools mocks base method.
 func (m *MockStoragePoolServiceIface) ListObjectStoragePools(p *ListObjectStoragePoolsParams) (*ListObjectStoragePoolsResponse, error) {
 	m.ctrl.T.Helper()
@@ -331,6 +714,99 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) ListObjectStoragePools(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectStoragePools", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListObjectStoragePools), p)
 }
 
+// ListObjectStoragePoolsAll mocks base method.
+func (m *MockStoragePoolServiceIface) ListObjectStoragePoolsAll(p *ListObjectStoragePoolsParams, opts ...PageOption) ([]*ObjectStoragePool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectStoragePoolsAll", varargs...)
+	ret0, _ := ret[0].([]*ObjectStoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectStoragePoolsAll indicates an expected call of ListObjectStoragePoolsAll.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListObjectStoragePoolsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectStoragePoolsAll", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListObjectStoragePoolsAll), varargs...)
+}
+
+// ListObjectStoragePoolsAllWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListObjectStoragePoolsAllWithContext(ctx context.Context, p *ListObjectStoragePoolsParams, opts ...PageOption) ([]*ObjectStoragePool, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectStoragePoolsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ObjectStoragePool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectStoragePoolsAllWithContext indicates an expected call of ListObjectStoragePoolsAllWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListObjectStoragePoolsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectStoragePoolsAllWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListObjectStoragePoolsAllWithContext), varargs...)
+}
+
+// ListObjectStoragePoolsIter mocks base method.
+func (m *MockStoragePoolServiceIface) ListObjectStoragePoolsIter(p *ListObjectStoragePoolsParams, opts ...PageOption) iter.Seq2[*ObjectStoragePool, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectStoragePoolsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ObjectStoragePool, error])
+	return ret0
+}
+
+// ListObjectStoragePoolsIter indicates an expected call of ListObjectStoragePoolsIter.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListObjectStoragePoolsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectStoragePoolsIter", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListObjectStoragePoolsIter), varargs...)
+}
+
+// ListObjectStoragePoolsIterWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListObjectStoragePoolsIterWithContext(ctx context.Context, p *ListObjectStoragePoolsParams, opts ...PageOption) iter.Seq2[*ObjectStoragePool, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListObjectStoragePoolsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ObjectStoragePool, error])
+	return ret0
+}
+
+// ListObjectStoragePoolsIterWithContext indicates an expected call of ListObjectStoragePoolsIterWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListObjectStoragePoolsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectStoragePoolsIterWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListObjectStoragePoolsIterWithContext), varargs...)
+}
+
+// ListObjectStoragePoolsWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListObjectStoragePoolsWithContext(ctx context.Context, p *ListObjectStoragePoolsParams) (*ListObjectStoragePoolsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListObjectStoragePoolsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListObjectStoragePoolsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListObjectStoragePoolsWithContext indicates an expected call of ListObjectStoragePoolsWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListObjectStoragePoolsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListObjectStoragePoolsWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListObjectStoragePoolsWithContext), ctx, p)
+}
+
 // ListStoragePoolObjects mocks base method.
 func (m *MockStoragePoolServiceIface) ListStoragePoolObjects(p *ListStoragePoolObjectsParams) (*ListStoragePoolObjectsResponse, error) {
 	m.ctrl.T.Helper()
@@ -346,6 +822,99 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolObjects(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolObjects", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolObjects), p)
 }
 
+// ListStoragePoolObjectsAll mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolObjectsAll(p *ListStoragePoolObjectsParams, opts ...PageOption) ([]*StoragePoolObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolObjectsAll", varargs...)
+	ret0, _ := ret[0].([]*StoragePoolObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolObjectsAll indicates an expected call of ListStoragePoolObjectsAll.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolObjectsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolObjectsAll", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolObjectsAll), varargs...)
+}
+
+// ListStoragePoolObjectsAllWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolObjectsAllWithContext(ctx context.Context, p *ListStoragePoolObjectsParams, opts ...PageOption) ([]*StoragePoolObject, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolObjectsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StoragePoolObject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolObjectsAllWithContext indicates an expected call of ListStoragePoolObjectsAllWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolObjectsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolObjectsAllWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolObjectsAllWithContext), varargs...)
+}
+
+// ListStoragePoolObjectsIter mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolObjectsIter(p *ListStoragePoolObjectsParams, opts ...PageOption) iter.Seq2[*StoragePoolObject, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolObjectsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StoragePoolObject, error])
+	return ret0
+}
+
+// ListStoragePoolObjectsIter indicates an expected call of ListStoragePoolObjectsIter.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolObjectsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolObjectsIter", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolObjectsIter), varargs...)
+}
+
+// ListStoragePoolObjectsIterWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolObjectsIterWithContext(ctx context.Context, p *ListStoragePoolObjectsParams, opts ...PageOption) iter.Seq2[*StoragePoolObject, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolObjectsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StoragePoolObject, error])
+	return ret0
+}
+
+// ListStoragePoolObjectsIterWithContext indicates an expected call of ListStoragePoolObjectsIterWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolObjectsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolObjectsIterWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolObjectsIterWithContext), varargs...)
+}
+
+// ListStoragePoolObjectsWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolObjectsWithContext(ctx context.Context, p *ListStoragePoolObjectsParams) (*ListStoragePoolObjectsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoragePoolObjectsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStoragePoolObjectsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolObjectsWithContext indicates an expected call of ListStoragePoolObjectsWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolObjectsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolObjectsWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolObjectsWithContext), ctx, p)
+}
+
 // ListStoragePoolsMetrics mocks base method.
 func (m *MockStoragePoolServiceIface) ListStoragePoolsMetrics(p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +930,99 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolsMetrics(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsMetrics", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolsMetrics), p)
 }
 
+// ListStoragePoolsMetricsAll mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolsMetricsAll(p *ListStoragePoolsMetricsParams, opts ...PageOption) ([]*StoragePoolsMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsMetricsAll", varargs...)
+	ret0, _ := ret[0].([]*StoragePoolsMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolsMetricsAll indicates an expected call of ListStoragePoolsMetricsAll.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolsMetricsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsMetricsAll", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolsMetricsAll), varargs...)
+}
+
+// ListStoragePoolsMetricsAllWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolsMetricsAllWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams, opts ...PageOption) ([]*StoragePoolsMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsMetricsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StoragePoolsMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolsMetricsAllWithContext indicates an expected call of ListStoragePoolsMetricsAllWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolsMetricsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsMetricsAllWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolsMetricsAllWithContext), varargs...)
+}
+
+// ListStoragePoolsMetricsIter mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolsMetricsIter(p *ListStoragePoolsMetricsParams, opts ...PageOption) iter.Seq2[*StoragePoolsMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsMetricsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StoragePoolsMetric, error])
+	return ret0
+}
+
+// ListStoragePoolsMetricsIter indicates an expected call of ListStoragePoolsMetricsIter.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolsMetricsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsMetricsIter", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolsMetricsIter), varargs...)
+}
+
+// ListStoragePoolsMetricsIterWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolsMetricsIterWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams, opts ...PageOption) iter.Seq2[*StoragePoolsMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStoragePoolsMetricsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StoragePoolsMetric, error])
+	return ret0
+}
+
+// ListStoragePoolsMetricsIterWithContext indicates an expected call of ListStoragePoolsMetricsIterWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolsMetricsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsMetricsIterWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolsMetricsIterWithContext), varargs...)
+}
+
+// ListStoragePoolsMetricsWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStoragePoolsMetricsWithContext(ctx context.Context, p *ListStoragePoolsMetricsParams) (*ListStoragePoolsMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStoragePoolsMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStoragePoolsMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStoragePoolsMetricsWithContext indicates an expected call of ListStoragePoolsMetricsWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStoragePoolsMetricsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStoragePoolsMetricsWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStoragePoolsMetricsWithContext), ctx, p)
+}
+
 // ListStorageProviders mocks base method.
 func (m *MockStoragePoolServiceIface) ListStorageProviders(p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
 	m.ctrl.T.Helper()
@@ -376,6 +1038,99 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProviders(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProviders", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProviders), p)
 }
 
+// ListStorageProvidersAll mocks base method.
+func (m *MockStoragePoolServiceIface) ListStorageProvidersAll(p *ListStorageProvidersParams, opts ...PageOption) ([]*StorageProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageProvidersAll", varargs...)
+	ret0, _ := ret[0].([]*StorageProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageProvidersAll indicates an expected call of ListStorageProvidersAll.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProvidersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProvidersAll", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProvidersAll), varargs...)
+}
+
+// ListStorageProvidersAllWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStorageProvidersAllWithContext(ctx context.Context, p *ListStorageProvidersParams, opts ...PageOption) ([]*StorageProvider, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageProvidersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StorageProvider)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageProvidersAllWithContext indicates an expected call of ListStorageProvidersAllWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProvidersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProvidersAllWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProvidersAllWithContext), varargs...)
+}
+
+// ListStorageProvidersIter mocks base method.
+func (m *MockStoragePoolServiceIface) ListStorageProvidersIter(p *ListStorageProvidersParams, opts ...PageOption) iter.Seq2[*StorageProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageProvidersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageProvider, error])
+	return ret0
+}
+
+// ListStorageProvidersIter indicates an expected call of ListStorageProvidersIter.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProvidersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProvidersIter", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProvidersIter), varargs...)
+}
+
+// ListStorageProvidersIterWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStorageProvidersIterWithContext(ctx context.Context, p *ListStorageProvidersParams, opts ...PageOption) iter.Seq2[*StorageProvider, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStorageProvidersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StorageProvider, error])
+	return ret0
+}
+
+// ListStorageProvidersIterWithContext indicates an expected call of ListStorageProvidersIterWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProvidersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProvidersIterWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProvidersIterWithContext), varargs...)
+}
+
+// ListStorageProvidersWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) ListStorageProvidersWithContext(ctx context.Context, p *ListStorageProvidersParams) (*ListStorageProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStorageProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStorageProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStorageProvidersWithContext indicates an expected call of ListStorageProvidersWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) ListStorageProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStorageProvidersWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).ListStorageProvidersWithContext), ctx, p)
+}
+
 // NewAddObjectStoragePoolParams mocks base method.
 func (m *MockStoragePoolServiceIface) NewAddObjectStoragePoolParams(name, provider, url string) *AddObjectStoragePoolParams {
 	m.ctrl.T.Helper()
@@ -544,3 +1299,18 @@ func (mr *MockStoragePoolServiceIfaceMockRecorder) UpdateObjectStoragePool(p any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectStoragePool", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).UpdateObjectStoragePool), p)
 }
+
+// UpdateObjectStoragePoolWithContext mocks base method.
+func (m *MockStoragePoolServiceIface) UpdateObjectStoragePoolWithContext(ctx context.Context, p *UpdateObjectStoragePoolParams) (*UpdateObjectStoragePoolResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateObjectStoragePoolWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateObjectStoragePoolResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateObjectStoragePoolWithContext indicates an expected call of UpdateObjectStoragePoolWithContext.
+func (mr *MockStoragePoolServiceIfaceMockRecorder) UpdateObjectStoragePoolWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateObjectStoragePoolWithContext", reflect.TypeOf((*MockStoragePoolServiceIface)(nil).UpdateObjectStoragePoolWithContext), ctx, p)
+}
@@ -20,6 +20,7 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"net/url"
 	"strconv"
@@ -27,8 +28,10 @@ import (
 
 type StratosphereSSPServiceIface interface {
 	AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error)
+	AddStratosphereSspWithContext(ctx context.Context, p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error)
 	NewAddStratosphereSspParams(name string, url string, zoneid string) *AddStratosphereSspParams
 	DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error)
+	DeleteStratosphereSspWithContext(ctx context.Context, p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error)
 	NewDeleteStratosphereSspParams(hostid string) *DeleteStratosphereSspParams
 }
 
@@ -62,6 +65,49 @@ func (p *AddStratosphereSspParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addStratosphereSsp API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddStratosphereSspParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addStratosphereSsp", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addStratosphereSsp API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddStratosphereSspParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "url", "zoneid"},
+		lengths:  map[string]int{"name": 255, "password": 255, "tenantuuid": 255, "url": 2048, "username": 255, "zoneid": 255},
+	}.validate("addStratosphereSsp", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addStratosphereSsp API, before the request is signed
+func (p *AddStratosphereSspParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddStratosphereSspParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddStratosphereSspParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addStratosphereSsp", b, &p.p, map[string]paramDecoder{
+		"name":       decodeParam[string],
+		"password":   decodeParam[string],
+		"tenantuuid": decodeParam[string],
+		"url":        decodeParam[string],
+		"username":   decodeParam[string],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddStratosphereSspParams) Clone() *AddStratosphereSspParams {
+	return &AddStratosphereSspParams{p: cloneParams(p.p)}
+}
+
 func (p *AddStratosphereSspParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -201,13 +247,22 @@ func (s *StratosphereSSPService) NewAddStratosphereSspParams(name string, url st
 
 // Adds stratosphere ssp server
 func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
-	resp, err := s.cs.newPostRequest("addStratosphereSsp", p.toURLValues())
+	return s.AddStratosphereSspWithContext(context.Background(), p)
+}
+
+// AddStratosphereSspWithContext is like AddStratosphereSsp, but honours the cancellation and deadline of ctx
+func (s *StratosphereSSPService) AddStratosphereSspWithContext(ctx context.Context, p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addStratosphereSsp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddStratosphereSspResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addStratosphereSsp", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -215,12 +270,18 @@ func (s *StratosphereSSPService) AddStratosphereSsp(p *AddStratosphereSspParams)
 }
 
 type AddStratosphereSspResponse struct {
-	Hostid    string `json:"hostid"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Url       string `json:"url"`
-	Zoneid    string `json:"zoneid"`
+	Hostid    string                     `json:"hostid"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Url       string                     `json:"url"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddStratosphereSspResponse) UnmarshalJSON(b []byte) error {
+	type alias AddStratosphereSspResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteStratosphereSspParams struct {
@@ -238,6 +299,44 @@ func (p *DeleteStratosphereSspParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteStratosphereSsp API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteStratosphereSspParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteStratosphereSsp", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteStratosphereSsp API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteStratosphereSspParams) Validate() error {
+	return paramRules{
+		required: []string{"hostid"},
+		lengths:  map[string]int{"hostid": 255},
+	}.validate("deleteStratosphereSsp", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteStratosphereSsp API, before the request is signed
+func (p *DeleteStratosphereSspParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteStratosphereSspParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteStratosphereSspParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteStratosphereSsp", b, &p.p, map[string]paramDecoder{
+		"hostid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteStratosphereSspParams) Clone() *DeleteStratosphereSspParams {
+	return &DeleteStratosphereSspParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteStratosphereSspParams) SetHostid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -270,13 +369,22 @@ func (s *StratosphereSSPService) NewDeleteStratosphereSspParams(hostid string) *
 
 // Removes stratosphere ssp server
 func (s *StratosphereSSPService) DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteStratosphereSsp", p.toURLValues())
+	return s.DeleteStratosphereSspWithContext(context.Background(), p)
+}
+
+// DeleteStratosphereSspWithContext is like DeleteStratosphereSsp, but honours the cancellation and deadline of ctx
+func (s *StratosphereSSPService) DeleteStratosphereSspWithContext(ctx context.Context, p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteStratosphereSsp", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteStratosphereSspResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteStratosphereSsp", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -284,10 +392,11 @@ func (s *StratosphereSSPService) DeleteStratosphereSsp(p *DeleteStratosphereSspP
 }
 
 type DeleteStratosphereSspResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteStratosphereSspResponse) UnmarshalJSON(b []byte) error {
@@ -314,5 +423,5 @@ func (r *DeleteStratosphereSspResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteStratosphereSspResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
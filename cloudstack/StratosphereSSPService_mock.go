@@ -29,6 +29,7 @@
 package cloudstack
 
 import (
+	context "context"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +74,21 @@ func (mr *MockStratosphereSSPServiceIfaceMockRecorder) AddStratosphereSsp(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStratosphereSsp", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).AddStratosphereSsp), p)
 }
 
+// AddStratosphereSspWithContext mocks base method.
+func (m *MockStratosphereSSPServiceIface) AddStratosphereSspWithContext(ctx context.Context, p *AddStratosphereSspParams) (*AddStratosphereSspResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddStratosphereSspWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddStratosphereSspResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddStratosphereSspWithContext indicates an expected call of AddStratosphereSspWithContext.
+func (mr *MockStratosphereSSPServiceIfaceMockRecorder) AddStratosphereSspWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddStratosphereSspWithContext", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).AddStratosphereSspWithContext), ctx, p)
+}
+
 // DeleteStratosphereSsp mocks base method.
 func (m *MockStratosphereSSPServiceIface) DeleteStratosphereSsp(p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +104,21 @@ func (mr *MockStratosphereSSPServiceIfaceMockRecorder) DeleteStratosphereSsp(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStratosphereSsp", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).DeleteStratosphereSsp), p)
 }
 
+// DeleteStratosphereSspWithContext mocks base method.
+func (m *MockStratosphereSSPServiceIface) DeleteStratosphereSspWithContext(ctx context.Context, p *DeleteStratosphereSspParams) (*DeleteStratosphereSspResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStratosphereSspWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteStratosphereSspResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStratosphereSspWithContext indicates an expected call of DeleteStratosphereSspWithContext.
+func (mr *MockStratosphereSSPServiceIfaceMockRecorder) DeleteStratosphereSspWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStratosphereSspWithContext", reflect.TypeOf((*MockStratosphereSSPServiceIface)(nil).DeleteStratosphereSspWithContext), ctx, p)
+}
+
 // NewAddStratosphereSspParams mocks base method.
 func (m *MockStratosphereSSPServiceIface) NewAddStratosphereSspParams(name, url, zoneid string) *AddStratosphereSspParams {
 	m.ctrl.T.Helper()
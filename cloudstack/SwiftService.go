@@ -20,18 +20,27 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type SwiftServiceIface interface {
 	AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error)
+	AddSwiftWithContext(ctx context.Context, p *AddSwiftParams) (*AddSwiftResponse, error)
 	NewAddSwiftParams(url string) *AddSwiftParams
 	ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	ListSwiftsWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error)
+	ListSwiftsAll(p *ListSwiftsParams, opts ...PageOption) ([]*Swift, error)
+	ListSwiftsAllWithContext(ctx context.Context, p *ListSwiftsParams, opts ...PageOption) ([]*Swift, error)
+	ListSwiftsIter(p *ListSwiftsParams, opts ...PageOption) iter.Seq2[*Swift, error]
+	ListSwiftsIterWithContext(ctx context.Context, p *ListSwiftsParams, opts ...PageOption) iter.Seq2[*Swift, error]
 	NewListSwiftsParams() *ListSwiftsParams
 	GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetSwiftIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 }
 
 type AddSwiftParams struct {
@@ -58,6 +67,47 @@ func (p *AddSwiftParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addSwift API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddSwiftParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addSwift", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addSwift API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddSwiftParams) Validate() error {
+	return paramRules{
+		required: []string{"url"},
+		lengths:  map[string]int{"account": 255, "key": 255, "url": 2048, "username": 255},
+	}.validate("addSwift", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addSwift API, before the request is signed
+func (p *AddSwiftParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddSwiftParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddSwiftParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addSwift", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"key":      decodeParam[string],
+		"url":      decodeParam[string],
+		"username": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddSwiftParams) Clone() *AddSwiftParams {
+	return &AddSwiftParams{p: cloneParams(p.p)}
+}
+
 func (p *AddSwiftParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -153,13 +203,22 @@ func (s *SwiftService) NewAddSwiftParams(url string) *AddSwiftParams {
 
 // Adds Swift.
 func (s *SwiftService) AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error) {
-	resp, err := s.cs.newPostRequest("addSwift", p.toURLValues())
+	return s.AddSwiftWithContext(context.Background(), p)
+}
+
+// AddSwiftWithContext is like AddSwift, but honours the cancellation and deadline of ctx
+func (s *SwiftService) AddSwiftWithContext(ctx context.Context, p *AddSwiftParams) (*AddSwiftResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addSwift", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddSwiftResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addSwift", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -167,20 +226,26 @@ func (s *SwiftService) AddSwift(p *AddSwiftParams) (*AddSwiftResponse, error) {
 }
 
 type AddSwiftResponse struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddSwiftResponse) UnmarshalJSON(b []byte) error {
+	type alias AddSwiftResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSwiftsParams struct {
@@ -210,6 +275,46 @@ func (p *ListSwiftsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSwifts API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSwiftsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSwifts", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSwifts API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSwiftsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255},
+	}.validate("listSwifts", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSwifts API, before the request is signed
+func (p *ListSwiftsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSwiftsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSwiftsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSwifts", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[int64],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSwiftsParams) Clone() *ListSwiftsParams {
+	return &ListSwiftsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSwiftsParams) SetId(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -304,6 +409,11 @@ func (s *SwiftService) NewListSwiftsParams() *ListSwiftsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSwiftIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetSwiftIDWithContext is like GetSwiftID, but honours the cancellation and deadline of ctx
+func (s *SwiftService) GetSwiftIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSwiftsParams{}
 	p.p = make(map[string]interface{})
 
@@ -315,7 +425,7 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 		}
 	}
 
-	l, err := s.ListSwifts(p)
+	l, err := s.ListSwiftsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -340,37 +450,80 @@ func (s *SwiftService) GetSwiftID(keyword string, opts ...OptionFunc) (string, i
 
 // List Swift.
 func (s *SwiftService) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
-	resp, err := s.cs.newRequest("listSwifts", p.toURLValues())
+	return s.ListSwiftsWithContext(context.Background(), p)
+}
+
+// ListSwiftsWithContext is like ListSwifts, but honours the cancellation and deadline of ctx
+func (s *SwiftService) ListSwiftsWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSwifts", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSwiftsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSwifts", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSwiftsAll returns all Swifts matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SwiftService) ListSwiftsAll(p *ListSwiftsParams, opts ...PageOption) ([]*Swift, error) {
+	return s.ListSwiftsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSwiftsAllWithContext is like ListSwiftsAll, but honours the cancellation and deadline of ctx
+func (s *SwiftService) ListSwiftsAllWithContext(ctx context.Context, p *ListSwiftsParams, opts ...PageOption) ([]*Swift, error) {
+	return collectPages(s.ListSwiftsIterWithContext(ctx, p, opts...))
+}
+
+// ListSwiftsIter returns an iterator over all Swifts matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SwiftService) ListSwiftsIter(p *ListSwiftsParams, opts ...PageOption) iter.Seq2[*Swift, error] {
+	return s.ListSwiftsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSwiftsIterWithContext is like ListSwiftsIter, but honours the cancellation and deadline of ctx
+func (s *SwiftService) ListSwiftsIterWithContext(ctx context.Context, p *ListSwiftsParams, opts ...PageOption) iter.Seq2[*Swift, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Swift, int, error) {
+		l, err := s.ListSwiftsWithContext(ctx, &ListSwiftsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Swifts, l.Count, nil
+	}, opts...)
+}
+
 type ListSwiftsResponse struct {
 	Count  int      `json:"count"`
 	Swifts []*Swift `json:"swift"`
 }
 
 type Swift struct {
-	Disksizetotal  int64  `json:"disksizetotal"`
-	Disksizeused   int64  `json:"disksizeused"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Protocol       string `json:"protocol"`
-	Providername   string `json:"providername"`
-	Readonly       bool   `json:"readonly"`
-	Scope          string `json:"scope"`
-	Url            string `json:"url"`
-	Zoneid         string `json:"zoneid"`
-	Zonename       string `json:"zonename"`
+	Disksizetotal  FlexInt64                  `json:"disksizetotal"`
+	Disksizeused   FlexInt64                  `json:"disksizeused"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Protocol       string                     `json:"protocol"`
+	Providername   string                     `json:"providername"`
+	Readonly       FlexBool                   `json:"readonly"`
+	Scope          string                     `json:"scope"`
+	Url            string                     `json:"url"`
+	Zoneid         string                     `json:"zoneid"`
+	Zonename       string                     `json:"zonename"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Swift) UnmarshalJSON(b []byte) error {
+	type alias Swift
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
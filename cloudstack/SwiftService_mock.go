@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockSwiftServiceIfaceMockRecorder) AddSwift(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSwift", reflect.TypeOf((*MockSwiftServiceIface)(nil).AddSwift), p)
 }
 
+// AddSwiftWithContext mocks base method.
+func (m *MockSwiftServiceIface) AddSwiftWithContext(ctx context.Context, p *AddSwiftParams) (*AddSwiftResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddSwiftWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddSwiftResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddSwiftWithContext indicates an expected call of AddSwiftWithContext.
+func (mr *MockSwiftServiceIfaceMockRecorder) AddSwiftWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddSwiftWithContext", reflect.TypeOf((*MockSwiftServiceIface)(nil).AddSwiftWithContext), ctx, p)
+}
+
 // GetSwiftID mocks base method.
 func (m *MockSwiftServiceIface) GetSwiftID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -94,6 +111,27 @@ func (mr *MockSwiftServiceIfaceMockRecorder) GetSwiftID(keyword any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwiftID", reflect.TypeOf((*MockSwiftServiceIface)(nil).GetSwiftID), varargs...)
 }
 
+// GetSwiftIDWithContext mocks base method.
+func (m *MockSwiftServiceIface) GetSwiftIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSwiftIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSwiftIDWithContext indicates an expected call of GetSwiftIDWithContext.
+func (mr *MockSwiftServiceIfaceMockRecorder) GetSwiftIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSwiftIDWithContext", reflect.TypeOf((*MockSwiftServiceIface)(nil).GetSwiftIDWithContext), varargs...)
+}
+
 // ListSwifts mocks base method.
 func (m *MockSwiftServiceIface) ListSwifts(p *ListSwiftsParams) (*ListSwiftsResponse, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +147,99 @@ func (mr *MockSwiftServiceIfaceMockRecorder) ListSwifts(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwifts", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwifts), p)
 }
 
+// ListSwiftsAll mocks base method.
+func (m *MockSwiftServiceIface) ListSwiftsAll(p *ListSwiftsParams, opts ...PageOption) ([]*Swift, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSwiftsAll", varargs...)
+	ret0, _ := ret[0].([]*Swift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSwiftsAll indicates an expected call of ListSwiftsAll.
+func (mr *MockSwiftServiceIfaceMockRecorder) ListSwiftsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwiftsAll", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwiftsAll), varargs...)
+}
+
+// ListSwiftsAllWithContext mocks base method.
+func (m *MockSwiftServiceIface) ListSwiftsAllWithContext(ctx context.Context, p *ListSwiftsParams, opts ...PageOption) ([]*Swift, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSwiftsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Swift)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSwiftsAllWithContext indicates an expected call of ListSwiftsAllWithContext.
+func (mr *MockSwiftServiceIfaceMockRecorder) ListSwiftsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwiftsAllWithContext", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwiftsAllWithContext), varargs...)
+}
+
+// ListSwiftsIter mocks base method.
+func (m *MockSwiftServiceIface) ListSwiftsIter(p *ListSwiftsParams, opts ...PageOption) iter.Seq2[*Swift, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSwiftsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Swift, error])
+	return ret0
+}
+
+// ListSwiftsIter indicates an expected call of ListSwiftsIter.
+func (mr *MockSwiftServiceIfaceMockRecorder) ListSwiftsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwiftsIter", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwiftsIter), varargs...)
+}
+
+// ListSwiftsIterWithContext mocks base method.
+func (m *MockSwiftServiceIface) ListSwiftsIterWithContext(ctx context.Context, p *ListSwiftsParams, opts ...PageOption) iter.Seq2[*Swift, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSwiftsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Swift, error])
+	return ret0
+}
+
+// ListSwiftsIterWithContext indicates an expected call of ListSwiftsIterWithContext.
+func (mr *MockSwiftServiceIfaceMockRecorder) ListSwiftsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwiftsIterWithContext", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwiftsIterWithContext), varargs...)
+}
+
+// ListSwiftsWithContext mocks base method.
+func (m *MockSwiftServiceIface) ListSwiftsWithContext(ctx context.Context, p *ListSwiftsParams) (*ListSwiftsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSwiftsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSwiftsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSwiftsWithContext indicates an expected call of ListSwiftsWithContext.
+func (mr *MockSwiftServiceIfaceMockRecorder) ListSwiftsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSwiftsWithContext", reflect.TypeOf((*MockSwiftServiceIface)(nil).ListSwiftsWithContext), ctx, p)
+}
+
 // NewAddSwiftParams mocks base method.
 func (m *MockSwiftServiceIface) NewAddSwiftParams(url string) *AddSwiftParams {
 	m.ctrl.T.Helper()
@@ -20,13 +20,20 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type SystemCapacityServiceIface interface {
 	ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error)
+	ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error)
+	ListCapacityAll(p *ListCapacityParams, opts ...PageOption) ([]*Capacity, error)
+	ListCapacityAllWithContext(ctx context.Context, p *ListCapacityParams, opts ...PageOption) ([]*Capacity, error)
+	ListCapacityIter(p *ListCapacityParams, opts ...PageOption) iter.Seq2[*Capacity, error]
+	ListCapacityIterWithContext(ctx context.Context, p *ListCapacityParams, opts ...PageOption) iter.Seq2[*Capacity, error]
 	NewListCapacityParams() *ListCapacityParams
 }
 
@@ -76,6 +83,52 @@ func (p *ListCapacityParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listCapacity API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListCapacityParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listCapacity", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listCapacity API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListCapacityParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"clusterid": 255, "keyword": 255, "podid": 255, "sortby": 255, "tag": 255, "zoneid": 255},
+	}.validate("listCapacity", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listCapacity API, before the request is signed
+func (p *ListCapacityParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListCapacityParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListCapacityParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listCapacity", b, &p.p, map[string]paramDecoder{
+		"clusterid":   decodeParam[string],
+		"fetchlatest": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"podid":       decodeParam[string],
+		"sortby":      decodeParam[string],
+		"tag":         decodeParam[string],
+		"type":        decodeParam[int],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListCapacityParams) Clone() *ListCapacityParams {
+	return &ListCapacityParams{p: cloneParams(p.p)}
+}
+
 func (p *ListCapacityParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -296,38 +349,81 @@ func (s *SystemCapacityService) NewListCapacityParams() *ListCapacityParams {
 
 // Lists all the system wide capacities.
 func (s *SystemCapacityService) ListCapacity(p *ListCapacityParams) (*ListCapacityResponse, error) {
-	resp, err := s.cs.newRequest("listCapacity", p.toURLValues())
+	return s.ListCapacityWithContext(context.Background(), p)
+}
+
+// ListCapacityWithContext is like ListCapacity, but honours the cancellation and deadline of ctx
+func (s *SystemCapacityService) ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listCapacity", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListCapacityResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listCapacity", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListCapacityAll returns all Capacity matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SystemCapacityService) ListCapacityAll(p *ListCapacityParams, opts ...PageOption) ([]*Capacity, error) {
+	return s.ListCapacityAllWithContext(context.Background(), p, opts...)
+}
+
+// ListCapacityAllWithContext is like ListCapacityAll, but honours the cancellation and deadline of ctx
+func (s *SystemCapacityService) ListCapacityAllWithContext(ctx context.Context, p *ListCapacityParams, opts ...PageOption) ([]*Capacity, error) {
+	return collectPages(s.ListCapacityIterWithContext(ctx, p, opts...))
+}
+
+// ListCapacityIter returns an iterator over all Capacity matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SystemCapacityService) ListCapacityIter(p *ListCapacityParams, opts ...PageOption) iter.Seq2[*Capacity, error] {
+	return s.ListCapacityIterWithContext(context.Background(), p, opts...)
+}
+
+// ListCapacityIterWithContext is like ListCapacityIter, but honours the cancellation and deadline of ctx
+func (s *SystemCapacityService) ListCapacityIterWithContext(ctx context.Context, p *ListCapacityParams, opts ...PageOption) iter.Seq2[*Capacity, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Capacity, int, error) {
+		l, err := s.ListCapacityWithContext(ctx, &ListCapacityParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Capacity, l.Count, nil
+	}, opts...)
+}
+
 type ListCapacityResponse struct {
 	Count    int         `json:"count"`
 	Capacity []*Capacity `json:"capacity"`
 }
 
 type Capacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Capacity) UnmarshalJSON(b []byte) error {
+	type alias Capacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,99 @@ func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacity(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacity", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacity), p)
 }
 
+// ListCapacityAll mocks base method.
+func (m *MockSystemCapacityServiceIface) ListCapacityAll(p *ListCapacityParams, opts ...PageOption) ([]*Capacity, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCapacityAll", varargs...)
+	ret0, _ := ret[0].([]*Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityAll indicates an expected call of ListCapacityAll.
+func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacityAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityAll", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacityAll), varargs...)
+}
+
+// ListCapacityAllWithContext mocks base method.
+func (m *MockSystemCapacityServiceIface) ListCapacityAllWithContext(ctx context.Context, p *ListCapacityParams, opts ...PageOption) ([]*Capacity, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCapacityAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Capacity)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityAllWithContext indicates an expected call of ListCapacityAllWithContext.
+func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacityAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityAllWithContext", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacityAllWithContext), varargs...)
+}
+
+// ListCapacityIter mocks base method.
+func (m *MockSystemCapacityServiceIface) ListCapacityIter(p *ListCapacityParams, opts ...PageOption) iter.Seq2[*Capacity, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCapacityIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Capacity, error])
+	return ret0
+}
+
+// ListCapacityIter indicates an expected call of ListCapacityIter.
+func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacityIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityIter", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacityIter), varargs...)
+}
+
+// ListCapacityIterWithContext mocks base method.
+func (m *MockSystemCapacityServiceIface) ListCapacityIterWithContext(ctx context.Context, p *ListCapacityParams, opts ...PageOption) iter.Seq2[*Capacity, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListCapacityIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Capacity, error])
+	return ret0
+}
+
+// ListCapacityIterWithContext indicates an expected call of ListCapacityIterWithContext.
+func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacityIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityIterWithContext", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacityIterWithContext), varargs...)
+}
+
+// ListCapacityWithContext mocks base method.
+func (m *MockSystemCapacityServiceIface) ListCapacityWithContext(ctx context.Context, p *ListCapacityParams) (*ListCapacityResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListCapacityWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListCapacityResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListCapacityWithContext indicates an expected call of ListCapacityWithContext.
+func (mr *MockSystemCapacityServiceIfaceMockRecorder) ListCapacityWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListCapacityWithContext", reflect.TypeOf((*MockSystemCapacityServiceIface)(nil).ListCapacityWithContext), ctx, p)
+}
+
 // NewListCapacityParams mocks base method.
 func (m *MockSystemCapacityServiceIface) NewListCapacityParams() *ListCapacityParams {
 	m.ctrl.T.Helper()
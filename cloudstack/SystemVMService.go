@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,30 +31,61 @@ import (
 
 type SystemVMServiceIface interface {
 	ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
+	ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error)
 	NewChangeServiceForSystemVmParams(id string, serviceofferingid string) *ChangeServiceForSystemVmParams
 	DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error)
+	DestroySystemVmAsync(ctx context.Context, p *DestroySystemVmParams) (*Job, error)
 	NewDestroySystemVmParams(id string) *DestroySystemVmParams
 	ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error)
+	ListSystemVmsAll(p *ListSystemVmsParams, opts ...PageOption) ([]*SystemVm, error)
+	ListSystemVmsAllWithContext(ctx context.Context, p *ListSystemVmsParams, opts ...PageOption) ([]*SystemVm, error)
+	ListSystemVmsIter(p *ListSystemVmsParams, opts ...PageOption) iter.Seq2[*SystemVm, error]
+	ListSystemVmsIterWithContext(ctx context.Context, p *ListSystemVmsParams, opts ...PageOption) iter.Seq2[*SystemVm, error]
 	NewListSystemVmsParams() *ListSystemVmsParams
 	GetSystemVmID(name string, opts ...OptionFunc) (string, int, error)
+	GetSystemVmIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVm, int, error)
 	GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error)
+	GetSystemVmByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVm, int, error)
 	ListSystemVmsUsageHistory(p *ListSystemVmsUsageHistoryParams) (*ListSystemVmsUsageHistoryResponse, error)
+	ListSystemVmsUsageHistoryWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams) (*ListSystemVmsUsageHistoryResponse, error)
+	ListSystemVmsUsageHistoryAll(p *ListSystemVmsUsageHistoryParams, opts ...PageOption) ([]*SystemVmsUsageHistory, error)
+	ListSystemVmsUsageHistoryAllWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams, opts ...PageOption) ([]*SystemVmsUsageHistory, error)
+	ListSystemVmsUsageHistoryIter(p *ListSystemVmsUsageHistoryParams, opts ...PageOption) iter.Seq2[*SystemVmsUsageHistory, error]
+	ListSystemVmsUsageHistoryIterWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams, opts ...PageOption) iter.Seq2[*SystemVmsUsageHistory, error]
 	NewListSystemVmsUsageHistoryParams() *ListSystemVmsUsageHistoryParams
 	GetSystemVmsUsageHistoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetSystemVmsUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetSystemVmsUsageHistoryByName(name string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error)
+	GetSystemVmsUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error)
 	GetSystemVmsUsageHistoryByID(id string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error)
+	GetSystemVmsUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error)
 	MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error)
+	MigrateSystemVmAsync(ctx context.Context, p *MigrateSystemVmParams) (*Job, error)
 	NewMigrateSystemVmParams(virtualmachineid string) *MigrateSystemVmParams
 	RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error)
+	RebootSystemVmAsync(ctx context.Context, p *RebootSystemVmParams) (*Job, error)
 	NewRebootSystemVmParams(id string) *RebootSystemVmParams
 	ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error)
+	ScaleSystemVmAsync(ctx context.Context, p *ScaleSystemVmParams) (*Job, error)
 	NewScaleSystemVmParams(id string, serviceofferingid string) *ScaleSystemVmParams
 	StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error)
+	StartSystemVmAsync(ctx context.Context, p *StartSystemVmParams) (*Job, error)
 	NewStartSystemVmParams(id string) *StartSystemVmParams
 	StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error)
+	StopSystemVmAsync(ctx context.Context, p *StopSystemVmParams) (*Job, error)
 	NewStopSystemVmParams(id string) *StopSystemVmParams
 	PatchSystemVm(p *PatchSystemVmParams) (*PatchSystemVmResponse, error)
+	PatchSystemVmWithContext(ctx context.Context, p *PatchSystemVmParams) (*PatchSystemVmResponse, error)
+	PatchSystemVmAsync(ctx context.Context, p *PatchSystemVmParams) (*Job, error)
 	NewPatchSystemVmParams() *PatchSystemVmParams
 }
 
@@ -80,6 +113,46 @@ func (p *ChangeServiceForSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeServiceForSystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeServiceForSystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeServiceForSystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeServiceForSystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeServiceForSystemVmParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "serviceofferingid"},
+		lengths:  map[string]int{"id": 255, "serviceofferingid": 255},
+	}.validate("changeServiceForSystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeServiceForSystemVm API, before the request is signed
+func (p *ChangeServiceForSystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeServiceForSystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeServiceForSystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeServiceForSystemVm", b, &p.p, map[string]paramDecoder{
+		"details":           decodeParam[map[string]string],
+		"id":                decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeServiceForSystemVmParams) Clone() *ChangeServiceForSystemVmParams {
+	return &ChangeServiceForSystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeServiceForSystemVmParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -155,13 +228,22 @@ func (s *SystemVMService) NewChangeServiceForSystemVmParams(id string, serviceof
 
 // Changes the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
 func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("changeServiceForSystemVm", p.toURLValues())
+	return s.ChangeServiceForSystemVmWithContext(context.Background(), p)
+}
+
+// ChangeServiceForSystemVmWithContext is like ChangeServiceForSystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "changeServiceForSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeServiceForSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeServiceForSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -169,47 +251,53 @@ func (s *SystemVMService) ChangeServiceForSystemVm(p *ChangeServiceForSystemVmPa
 }
 
 type ChangeServiceForSystemVmResponse struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeServiceForSystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeServiceForSystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroySystemVmParams struct {
@@ -227,6 +315,44 @@ func (p *DestroySystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the destroySystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DestroySystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("destroySystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the destroySystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DestroySystemVmParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("destroySystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the destroySystemVm API, before the request is signed
+func (p *DestroySystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DestroySystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DestroySystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("destroySystemVm", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DestroySystemVmParams) Clone() *DestroySystemVmParams {
+	return &DestroySystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *DestroySystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -259,32 +385,39 @@ func (s *SystemVMService) NewDestroySystemVmParams(id string) *DestroySystemVmPa
 
 // Destroys a System VM.
 func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("destroySystemVm", p.toURLValues())
+	return s.DestroySystemVmWithContext(context.Background(), p)
+}
+
+// DestroySystemVmWithContext is like DestroySystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "destroySystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DestroySystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("destroySystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "destroySystemVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("destroySystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -292,48 +425,73 @@ func (s *SystemVMService) DestroySystemVm(p *DestroySystemVmParams) (*DestroySys
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DestroySystemVm async job into r
+func (r *DestroySystemVmResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DestroySystemVmAsync starts the async job of DestroySystemVm without waiting for it to finish, and returns a handle to the job
+func (s *SystemVMService) DestroySystemVmAsync(ctx context.Context, p *DestroySystemVmParams) (*Job, error) {
+	r, err := s.DestroySystemVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("destroySystemVm", r.JobID), nil
+}
+
 type DestroySystemVmResponse struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DestroySystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias DestroySystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSystemVmsParams struct {
@@ -386,6 +544,54 @@ func (p *ListSystemVmsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSystemVms API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSystemVmsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSystemVms", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSystemVms API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSystemVmsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"arch": 255, "hostid": 255, "id": 255, "keyword": 255, "name": 255, "podid": 255, "state": 255, "storageid": 255, "systemvmtype": 255, "zoneid": 255},
+	}.validate("listSystemVms", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSystemVms API, before the request is signed
+func (p *ListSystemVmsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSystemVmsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSystemVmsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSystemVms", b, &p.p, map[string]paramDecoder{
+		"arch":         decodeParam[string],
+		"hostid":       decodeParam[string],
+		"id":           decodeParam[string],
+		"keyword":      decodeParam[string],
+		"name":         decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"podid":        decodeParam[string],
+		"state":        decodeParam[string],
+		"storageid":    decodeParam[string],
+		"systemvmtype": decodeParam[string],
+		"zoneid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSystemVmsParams) Clone() *ListSystemVmsParams {
+	return &ListSystemVmsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSystemVmsParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -648,6 +854,11 @@ func (s *SystemVMService) NewListSystemVmsParams() *ListSystemVmsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSystemVmIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSystemVmIDWithContext is like GetSystemVmID, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) GetSystemVmIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSystemVmsParams{}
 	p.p = make(map[string]interface{})
 
@@ -659,7 +870,7 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListSystemVms(p)
+	l, err := s.ListSystemVmsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -684,12 +895,17 @@ func (s *SystemVMService) GetSystemVmID(name string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error) {
-	id, count, err := s.GetSystemVmID(name, opts...)
+	return s.GetSystemVmByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSystemVmByNameWithContext is like GetSystemVmByName, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) GetSystemVmByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVm, int, error) {
+	id, count, err := s.GetSystemVmIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSystemVmByID(id, opts...)
+	r, count, err := s.GetSystemVmByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -698,6 +914,11 @@ func (s *SystemVMService) GetSystemVmByName(name string, opts ...OptionFunc) (*S
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error) {
+	return s.GetSystemVmByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSystemVmByIDWithContext is like GetSystemVmByID, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) GetSystemVmByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVm, int, error) {
 	p := &ListSystemVmsParams{}
 	p.p = make(map[string]interface{})
 
@@ -709,11 +930,10 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 		}
 	}
 
-	l, err := s.ListSystemVms(p)
+	l, err := s.ListSystemVmsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -731,66 +951,109 @@ func (s *SystemVMService) GetSystemVmByID(id string, opts ...OptionFunc) (*Syste
 
 // List System VMs.
 func (s *SystemVMService) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
-	resp, err := s.cs.newRequest("listSystemVms", p.toURLValues())
+	return s.ListSystemVmsWithContext(context.Background(), p)
+}
+
+// ListSystemVmsWithContext is like ListSystemVms, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSystemVms", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSystemVmsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSystemVms", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSystemVmsAll returns all SystemVms matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SystemVMService) ListSystemVmsAll(p *ListSystemVmsParams, opts ...PageOption) ([]*SystemVm, error) {
+	return s.ListSystemVmsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSystemVmsAllWithContext is like ListSystemVmsAll, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ListSystemVmsAllWithContext(ctx context.Context, p *ListSystemVmsParams, opts ...PageOption) ([]*SystemVm, error) {
+	return collectPages(s.ListSystemVmsIterWithContext(ctx, p, opts...))
+}
+
+// ListSystemVmsIter returns an iterator over all SystemVms matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SystemVMService) ListSystemVmsIter(p *ListSystemVmsParams, opts ...PageOption) iter.Seq2[*SystemVm, error] {
+	return s.ListSystemVmsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSystemVmsIterWithContext is like ListSystemVmsIter, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ListSystemVmsIterWithContext(ctx context.Context, p *ListSystemVmsParams, opts ...PageOption) iter.Seq2[*SystemVm, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SystemVm, int, error) {
+		l, err := s.ListSystemVmsWithContext(ctx, &ListSystemVmsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SystemVms, l.Count, nil
+	}, opts...)
+}
+
 type ListSystemVmsResponse struct {
 	Count     int         `json:"count"`
 	SystemVms []*SystemVm `json:"systemvm"`
 }
 
 type SystemVm struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SystemVm) UnmarshalJSON(b []byte) error {
+	type alias SystemVm
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListSystemVmsUsageHistoryParams struct {
@@ -832,6 +1095,50 @@ func (p *ListSystemVmsUsageHistoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listSystemVmsUsageHistory API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListSystemVmsUsageHistoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listSystemVmsUsageHistory", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listSystemVmsUsageHistory API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListSystemVmsUsageHistoryParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "id": 255, "keyword": 255, "name": 255, "startdate": 255},
+	}.validate("listSystemVmsUsageHistory", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listSystemVmsUsageHistory API, before the request is signed
+func (p *ListSystemVmsUsageHistoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListSystemVmsUsageHistoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListSystemVmsUsageHistoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listSystemVmsUsageHistory", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"id":        decodeParam[string],
+		"ids":       decodeParam[[]string],
+		"keyword":   decodeParam[string],
+		"name":      decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"startdate": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListSystemVmsUsageHistoryParams) Clone() *ListSystemVmsUsageHistoryParams {
+	return &ListSystemVmsUsageHistoryParams{p: cloneParams(p.p)}
+}
+
 func (p *ListSystemVmsUsageHistoryParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1010,6 +1317,11 @@ func (s *SystemVMService) NewListSystemVmsUsageHistoryParams() *ListSystemVmsUsa
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmsUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetSystemVmsUsageHistoryIDWithContext(context.Background(), name, opts...)
+}
+
+// GetSystemVmsUsageHistoryIDWithContext is like GetSystemVmsUsageHistoryID, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) GetSystemVmsUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListSystemVmsUsageHistoryParams{}
 	p.p = make(map[string]interface{})
 
@@ -1021,7 +1333,7 @@ func (s *SystemVMService) GetSystemVmsUsageHistoryID(name string, opts ...Option
 		}
 	}
 
-	l, err := s.ListSystemVmsUsageHistory(p)
+	l, err := s.ListSystemVmsUsageHistoryWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1046,12 +1358,17 @@ func (s *SystemVMService) GetSystemVmsUsageHistoryID(name string, opts ...Option
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmsUsageHistoryByName(name string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
-	id, count, err := s.GetSystemVmsUsageHistoryID(name, opts...)
+	return s.GetSystemVmsUsageHistoryByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetSystemVmsUsageHistoryByNameWithContext is like GetSystemVmsUsageHistoryByName, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) GetSystemVmsUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
+	id, count, err := s.GetSystemVmsUsageHistoryIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetSystemVmsUsageHistoryByID(id, opts...)
+	r, count, err := s.GetSystemVmsUsageHistoryByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1060,6 +1377,11 @@ func (s *SystemVMService) GetSystemVmsUsageHistoryByName(name string, opts ...Op
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *SystemVMService) GetSystemVmsUsageHistoryByID(id string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
+	return s.GetSystemVmsUsageHistoryByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetSystemVmsUsageHistoryByIDWithContext is like GetSystemVmsUsageHistoryByID, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) GetSystemVmsUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
 	p := &ListSystemVmsUsageHistoryParams{}
 	p.p = make(map[string]interface{})
 
@@ -1071,11 +1393,10 @@ func (s *SystemVMService) GetSystemVmsUsageHistoryByID(id string, opts ...Option
 		}
 	}
 
-	l, err := s.ListSystemVmsUsageHistory(p)
+	l, err := s.ListSystemVmsUsageHistoryWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1093,19 +1414,56 @@ func (s *SystemVMService) GetSystemVmsUsageHistoryByID(id string, opts ...Option
 
 // Lists System VM stats
 func (s *SystemVMService) ListSystemVmsUsageHistory(p *ListSystemVmsUsageHistoryParams) (*ListSystemVmsUsageHistoryResponse, error) {
-	resp, err := s.cs.newRequest("listSystemVmsUsageHistory", p.toURLValues())
+	return s.ListSystemVmsUsageHistoryWithContext(context.Background(), p)
+}
+
+// ListSystemVmsUsageHistoryWithContext is like ListSystemVmsUsageHistory, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ListSystemVmsUsageHistoryWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams) (*ListSystemVmsUsageHistoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listSystemVmsUsageHistory", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListSystemVmsUsageHistoryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listSystemVmsUsageHistory", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListSystemVmsUsageHistoryAll returns all SystemVmsUsageHistory matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *SystemVMService) ListSystemVmsUsageHistoryAll(p *ListSystemVmsUsageHistoryParams, opts ...PageOption) ([]*SystemVmsUsageHistory, error) {
+	return s.ListSystemVmsUsageHistoryAllWithContext(context.Background(), p, opts...)
+}
+
+// ListSystemVmsUsageHistoryAllWithContext is like ListSystemVmsUsageHistoryAll, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ListSystemVmsUsageHistoryAllWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams, opts ...PageOption) ([]*SystemVmsUsageHistory, error) {
+	return collectPages(s.ListSystemVmsUsageHistoryIterWithContext(ctx, p, opts...))
+}
+
+// ListSystemVmsUsageHistoryIter returns an iterator over all SystemVmsUsageHistory matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *SystemVMService) ListSystemVmsUsageHistoryIter(p *ListSystemVmsUsageHistoryParams, opts ...PageOption) iter.Seq2[*SystemVmsUsageHistory, error] {
+	return s.ListSystemVmsUsageHistoryIterWithContext(context.Background(), p, opts...)
+}
+
+// ListSystemVmsUsageHistoryIterWithContext is like ListSystemVmsUsageHistoryIter, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ListSystemVmsUsageHistoryIterWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams, opts ...PageOption) iter.Seq2[*SystemVmsUsageHistory, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*SystemVmsUsageHistory, int, error) {
+		l, err := s.ListSystemVmsUsageHistoryWithContext(ctx, &ListSystemVmsUsageHistoryParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.SystemVmsUsageHistory, l.Count, nil
+	}, opts...)
+}
+
 type ListSystemVmsUsageHistoryResponse struct {
 	Count                 int                      `json:"count"`
 	SystemVmsUsageHistory []*SystemVmsUsageHistory `json:"virtualmachine"`
@@ -1115,26 +1473,38 @@ type SystemVmsUsageHistory struct {
 	Displayname string                       `json:"displayname"`
 	Id          string                       `json:"id"`
 	JobID       string                       `json:"jobid"`
-	Jobstatus   int                          `json:"jobstatus"`
+	Jobstatus   FlexInt                      `json:"jobstatus"`
 	Name        string                       `json:"name"`
 	Stats       []SystemVmsUsageHistoryStats `json:"stats"`
+	Extra       map[string]json.RawMessage   `json:"-"`
+}
+
+func (r *SystemVmsUsageHistory) UnmarshalJSON(b []byte) error {
+	type alias SystemVmsUsageHistory
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SystemVmsUsageHistoryStats struct {
-	Cpuused          string `json:"cpuused"`
-	Diskiopstotal    int64  `json:"diskiopstotal"`
-	Diskioread       int64  `json:"diskioread"`
-	Diskiowrite      int64  `json:"diskiowrite"`
-	Diskkbsread      int64  `json:"diskkbsread"`
-	Diskkbswrite     int64  `json:"diskkbswrite"`
-	Memoryintfreekbs int64  `json:"memoryintfreekbs"`
-	Memorykbs        int64  `json:"memorykbs"`
-	Memorytargetkbs  int64  `json:"memorytargetkbs"`
-	Networkkbsread   int64  `json:"networkkbsread"`
-	Networkkbswrite  int64  `json:"networkkbswrite"`
-	Networkread      string `json:"networkread"`
-	Networkwrite     string `json:"networkwrite"`
-	Timestamp        string `json:"timestamp"`
+	Cpuused          string                     `json:"cpuused"`
+	Diskiopstotal    FlexInt64                  `json:"diskiopstotal"`
+	Diskioread       FlexInt64                  `json:"diskioread"`
+	Diskiowrite      FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread      FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite     FlexInt64                  `json:"diskkbswrite"`
+	Memoryintfreekbs FlexInt64                  `json:"memoryintfreekbs"`
+	Memorykbs        FlexInt64                  `json:"memorykbs"`
+	Memorytargetkbs  FlexInt64                  `json:"memorytargetkbs"`
+	Networkkbsread   FlexInt64                  `json:"networkkbsread"`
+	Networkkbswrite  FlexInt64                  `json:"networkkbswrite"`
+	Networkread      string                     `json:"networkread"`
+	Networkwrite     string                     `json:"networkwrite"`
+	Timestamp        string                     `json:"timestamp"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SystemVmsUsageHistoryStats) UnmarshalJSON(b []byte) error {
+	type alias SystemVmsUsageHistoryStats
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateSystemVmParams struct {
@@ -1162,6 +1532,47 @@ func (p *MigrateSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateSystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateSystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateSystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateSystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateSystemVmParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"hostid": 255, "storageid": 255, "virtualmachineid": 255},
+	}.validate("migrateSystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateSystemVm API, before the request is signed
+func (p *MigrateSystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateSystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateSystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateSystemVm", b, &p.p, map[string]paramDecoder{
+		"autoselect":       decodeParam[bool],
+		"hostid":           decodeParam[string],
+		"storageid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateSystemVmParams) Clone() *MigrateSystemVmParams {
+	return &MigrateSystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateSystemVmParams) SetAutoselect(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1257,32 +1668,39 @@ func (s *SystemVMService) NewMigrateSystemVmParams(virtualmachineid string) *Mig
 
 // Attempts Migration of a System VM to the host specified.
 func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateSystemVm", p.toURLValues())
+	return s.MigrateSystemVmWithContext(context.Background(), p)
+}
+
+// MigrateSystemVmWithContext is like MigrateSystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateSystemVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1290,48 +1708,73 @@ func (s *SystemVMService) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSys
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateSystemVm async job into r
+func (r *MigrateSystemVmResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateSystemVmAsync starts the async job of MigrateSystemVm without waiting for it to finish, and returns a handle to the job
+func (s *SystemVMService) MigrateSystemVmAsync(ctx context.Context, p *MigrateSystemVmParams) (*Job, error) {
+	r, err := s.MigrateSystemVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateSystemVm", r.JobID), nil
+}
+
 type MigrateSystemVmResponse struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateSystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias MigrateSystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RebootSystemVmParams struct {
@@ -1353,6 +1796,45 @@ func (p *RebootSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the rebootSystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RebootSystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("rebootSystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the rebootSystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RebootSystemVmParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("rebootSystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the rebootSystemVm API, before the request is signed
+func (p *RebootSystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RebootSystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RebootSystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("rebootSystemVm", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RebootSystemVmParams) Clone() *RebootSystemVmParams {
+	return &RebootSystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *RebootSystemVmParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1406,32 +1888,39 @@ func (s *SystemVMService) NewRebootSystemVmParams(id string) *RebootSystemVmPara
 
 // Reboots a system VM.
 func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("rebootSystemVm", p.toURLValues())
+	return s.RebootSystemVmWithContext(context.Background(), p)
+}
+
+// RebootSystemVmWithContext is like RebootSystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "rebootSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RebootSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("rebootSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "rebootSystemVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("rebootSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1439,48 +1928,73 @@ func (s *SystemVMService) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystem
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RebootSystemVm async job into r
+func (r *RebootSystemVmResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RebootSystemVmAsync starts the async job of RebootSystemVm without waiting for it to finish, and returns a handle to the job
+func (s *SystemVMService) RebootSystemVmAsync(ctx context.Context, p *RebootSystemVmParams) (*Job, error) {
+	r, err := s.RebootSystemVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("rebootSystemVm", r.JobID), nil
+}
+
 type RebootSystemVmResponse struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RebootSystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias RebootSystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ScaleSystemVmParams struct {
@@ -1507,6 +2021,46 @@ func (p *ScaleSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the scaleSystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ScaleSystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("scaleSystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the scaleSystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ScaleSystemVmParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "serviceofferingid"},
+		lengths:  map[string]int{"id": 255, "serviceofferingid": 255},
+	}.validate("scaleSystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the scaleSystemVm API, before the request is signed
+func (p *ScaleSystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ScaleSystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ScaleSystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("scaleSystemVm", b, &p.p, map[string]paramDecoder{
+		"details":           decodeParam[map[string]string],
+		"id":                decodeParam[string],
+		"serviceofferingid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ScaleSystemVmParams) Clone() *ScaleSystemVmParams {
+	return &ScaleSystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *ScaleSystemVmParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1582,32 +2136,39 @@ func (s *SystemVMService) NewScaleSystemVmParams(id string, serviceofferingid st
 
 // Scale the service offering for a system vm (console proxy or secondary storage). The system vm must be in a "Stopped" state for this command to take effect.
 func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("scaleSystemVm", p.toURLValues())
+	return s.ScaleSystemVmWithContext(context.Background(), p)
+}
+
+// ScaleSystemVmWithContext is like ScaleSystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "scaleSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ScaleSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("scaleSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "scaleSystemVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("scaleSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1615,48 +2176,73 @@ func (s *SystemVMService) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmR
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ScaleSystemVm async job into r
+func (r *ScaleSystemVmResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ScaleSystemVmAsync starts the async job of ScaleSystemVm without waiting for it to finish, and returns a handle to the job
+func (s *SystemVMService) ScaleSystemVmAsync(ctx context.Context, p *ScaleSystemVmParams) (*Job, error) {
+	r, err := s.ScaleSystemVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("scaleSystemVm", r.JobID), nil
+}
+
 type ScaleSystemVmResponse struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ScaleSystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias ScaleSystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartSystemVmParams struct {
@@ -1674,6 +2260,44 @@ func (p *StartSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the startSystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StartSystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("startSystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the startSystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StartSystemVmParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("startSystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the startSystemVm API, before the request is signed
+func (p *StartSystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StartSystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StartSystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("startSystemVm", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StartSystemVmParams) Clone() *StartSystemVmParams {
+	return &StartSystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *StartSystemVmParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1706,32 +2330,39 @@ func (s *SystemVMService) NewStartSystemVmParams(id string) *StartSystemVmParams
 
 // Starts a System VM.
 func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("startSystemVm", p.toURLValues())
+	return s.StartSystemVmWithContext(context.Background(), p)
+}
+
+// StartSystemVmWithContext is like StartSystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "startSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StartSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("startSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "startSystemVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("startSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1739,48 +2370,73 @@ func (s *SystemVMService) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmR
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StartSystemVm async job into r
+func (r *StartSystemVmResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StartSystemVmAsync starts the async job of StartSystemVm without waiting for it to finish, and returns a handle to the job
+func (s *SystemVMService) StartSystemVmAsync(ctx context.Context, p *StartSystemVmParams) (*Job, error) {
+	r, err := s.StartSystemVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("startSystemVm", r.JobID), nil
+}
+
 type StartSystemVmResponse struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartSystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias StartSystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopSystemVmParams struct {
@@ -1802,6 +2458,45 @@ func (p *StopSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the stopSystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StopSystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("stopSystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the stopSystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StopSystemVmParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("stopSystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the stopSystemVm API, before the request is signed
+func (p *StopSystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StopSystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StopSystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("stopSystemVm", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StopSystemVmParams) Clone() *StopSystemVmParams {
+	return &StopSystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *StopSystemVmParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1855,32 +2550,39 @@ func (s *SystemVMService) NewStopSystemVmParams(id string) *StopSystemVmParams {
 
 // Stops a system VM.
 func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("stopSystemVm", p.toURLValues())
+	return s.StopSystemVmWithContext(context.Background(), p)
+}
+
+// StopSystemVmWithContext is like StopSystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "stopSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StopSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("stopSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "stopSystemVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("stopSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1888,48 +2590,73 @@ func (s *SystemVMService) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResp
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StopSystemVm async job into r
+func (r *StopSystemVmResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StopSystemVmAsync starts the async job of StopSystemVm without waiting for it to finish, and returns a handle to the job
+func (s *SystemVMService) StopSystemVmAsync(ctx context.Context, p *StopSystemVmParams) (*Job, error) {
+	r, err := s.StopSystemVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("stopSystemVm", r.JobID), nil
+}
+
 type StopSystemVmResponse struct {
-	Activeviewersessions  int      `json:"activeviewersessions"`
-	Agentstate            string   `json:"agentstate"`
-	Arch                  string   `json:"arch"`
-	Created               string   `json:"created"`
-	Disconnected          string   `json:"disconnected"`
-	Dns1                  string   `json:"dns1"`
-	Dns2                  string   `json:"dns2"`
-	Gateway               string   `json:"gateway"`
-	Guestvlan             string   `json:"guestvlan"`
-	Hasannotations        bool     `json:"hasannotations"`
-	Hostcontrolstate      string   `json:"hostcontrolstate"`
-	Hostid                string   `json:"hostid"`
-	Hostname              string   `json:"hostname"`
-	Hypervisor            string   `json:"hypervisor"`
-	Id                    string   `json:"id"`
-	Isdynamicallyscalable bool     `json:"isdynamicallyscalable"`
-	JobID                 string   `json:"jobid"`
-	Jobstatus             int      `json:"jobstatus"`
-	Linklocalip           string   `json:"linklocalip"`
-	Linklocalmacaddress   string   `json:"linklocalmacaddress"`
-	Linklocalnetmask      string   `json:"linklocalnetmask"`
-	Name                  string   `json:"name"`
-	Networkdomain         string   `json:"networkdomain"`
-	Podid                 string   `json:"podid"`
-	Podname               string   `json:"podname"`
-	Privateip             string   `json:"privateip"`
-	Privatemacaddress     string   `json:"privatemacaddress"`
-	Privatenetmask        string   `json:"privatenetmask"`
-	Publicip              string   `json:"publicip"`
-	Publicmacaddress      string   `json:"publicmacaddress"`
-	Publicnetmask         string   `json:"publicnetmask"`
-	Publicvlan            []string `json:"publicvlan"`
-	Serviceofferingid     string   `json:"serviceofferingid"`
-	Serviceofferingname   string   `json:"serviceofferingname"`
-	State                 string   `json:"state"`
-	Systemvmtype          string   `json:"systemvmtype"`
-	Templateid            string   `json:"templateid"`
-	Templatename          string   `json:"templatename"`
-	Version               string   `json:"version"`
-	Zoneid                string   `json:"zoneid"`
-	Zonename              string   `json:"zonename"`
+	Activeviewersessions  FlexInt                    `json:"activeviewersessions"`
+	Agentstate            string                     `json:"agentstate"`
+	Arch                  string                     `json:"arch"`
+	Created               Time                       `json:"created"`
+	Disconnected          Time                       `json:"disconnected"`
+	Dns1                  string                     `json:"dns1"`
+	Dns2                  string                     `json:"dns2"`
+	Gateway               string                     `json:"gateway"`
+	Guestvlan             string                     `json:"guestvlan"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostcontrolstate      string                     `json:"hostcontrolstate"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Linklocalip           string                     `json:"linklocalip"`
+	Linklocalmacaddress   string                     `json:"linklocalmacaddress"`
+	Linklocalnetmask      string                     `json:"linklocalnetmask"`
+	Name                  string                     `json:"name"`
+	Networkdomain         string                     `json:"networkdomain"`
+	Podid                 string                     `json:"podid"`
+	Podname               string                     `json:"podname"`
+	Privateip             string                     `json:"privateip"`
+	Privatemacaddress     string                     `json:"privatemacaddress"`
+	Privatenetmask        string                     `json:"privatenetmask"`
+	Publicip              string                     `json:"publicip"`
+	Publicmacaddress      string                     `json:"publicmacaddress"`
+	Publicnetmask         string                     `json:"publicnetmask"`
+	Publicvlan            []string                   `json:"publicvlan"`
+	Serviceofferingid     string                     `json:"serviceofferingid"`
+	Serviceofferingname   string                     `json:"serviceofferingname"`
+	State                 string                     `json:"state"`
+	Systemvmtype          string                     `json:"systemvmtype"`
+	Templateid            string                     `json:"templateid"`
+	Templatename          string                     `json:"templatename"`
+	Version               string                     `json:"version"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopSystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias StopSystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PatchSystemVmParams struct {
@@ -1951,6 +2678,44 @@ func (p *PatchSystemVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the patchSystemVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *PatchSystemVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("patchSystemVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the patchSystemVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *PatchSystemVmParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255},
+	}.validate("patchSystemVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the patchSystemVm API, before the request is signed
+func (p *PatchSystemVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *PatchSystemVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *PatchSystemVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("patchSystemVm", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *PatchSystemVmParams) Clone() *PatchSystemVmParams {
+	return &PatchSystemVmParams{p: cloneParams(p.p)}
+}
+
 func (p *PatchSystemVmParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2003,27 +2768,39 @@ func (s *SystemVMService) NewPatchSystemVmParams() *PatchSystemVmParams {
 
 // Attempts to live patch systemVMs - CPVM, SSVM
 func (s *SystemVMService) PatchSystemVm(p *PatchSystemVmParams) (*PatchSystemVmResponse, error) {
-	resp, err := s.cs.newPostRequest("patchSystemVm", p.toURLValues())
+	return s.PatchSystemVmWithContext(context.Background(), p)
+}
+
+// PatchSystemVmWithContext is like PatchSystemVm, but honours the cancellation and deadline of ctx
+func (s *SystemVMService) PatchSystemVmWithContext(ctx context.Context, p *PatchSystemVmParams) (*PatchSystemVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "patchSystemVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r PatchSystemVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("patchSystemVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "patchSystemVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("patchSystemVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2031,9 +2808,29 @@ func (s *SystemVMService) PatchSystemVm(p *PatchSystemVmParams) (*PatchSystemVmR
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished PatchSystemVm async job into r
+func (r *PatchSystemVmResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// PatchSystemVmAsync starts the async job of PatchSystemVm without waiting for it to finish, and returns a handle to the job
+func (s *SystemVMService) PatchSystemVmAsync(ctx context.Context, p *PatchSystemVmParams) (*Job, error) {
+	r, err := s.PatchSystemVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("patchSystemVm", r.JobID), nil
+}
+
 type PatchSystemVmResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PatchSystemVmResponse) UnmarshalJSON(b []byte) error {
+	type alias PatchSystemVmResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) ChangeServiceForSystemVm(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ChangeServiceForSystemVm), p)
 }
 
+// ChangeServiceForSystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ChangeServiceForSystemVmWithContext(ctx context.Context, p *ChangeServiceForSystemVmParams) (*ChangeServiceForSystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeServiceForSystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeServiceForSystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeServiceForSystemVmWithContext indicates an expected call of ChangeServiceForSystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ChangeServiceForSystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForSystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ChangeServiceForSystemVmWithContext), ctx, p)
+}
+
 // DestroySystemVm mocks base method.
 func (m *MockSystemVMServiceIface) DestroySystemVm(p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) DestroySystemVm(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).DestroySystemVm), p)
 }
 
+// DestroySystemVmAsync mocks base method.
+func (m *MockSystemVMServiceIface) DestroySystemVmAsync(ctx context.Context, p *DestroySystemVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySystemVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroySystemVmAsync indicates an expected call of DestroySystemVmAsync.
+func (mr *MockSystemVMServiceIfaceMockRecorder) DestroySystemVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySystemVmAsync", reflect.TypeOf((*MockSystemVMServiceIface)(nil).DestroySystemVmAsync), ctx, p)
+}
+
+// DestroySystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) DestroySystemVmWithContext(ctx context.Context, p *DestroySystemVmParams) (*DestroySystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroySystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*DestroySystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroySystemVmWithContext indicates an expected call of DestroySystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) DestroySystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroySystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).DestroySystemVmWithContext), ctx, p)
+}
+
 // GetSystemVmByID mocks base method.
 func (m *MockSystemVMServiceIface) GetSystemVmByID(id string, opts ...OptionFunc) (*SystemVm, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +156,27 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmByID(id any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmByID", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmByID), varargs...)
 }
 
+// GetSystemVmByIDWithContext mocks base method.
+func (m *MockSystemVMServiceIface) GetSystemVmByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVm, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemVmByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*SystemVm)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSystemVmByIDWithContext indicates an expected call of GetSystemVmByIDWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmByIDWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmByIDWithContext), varargs...)
+}
+
 // GetSystemVmByName mocks base method.
 func (m *MockSystemVMServiceIface) GetSystemVmByName(name string, opts ...OptionFunc) (*SystemVm, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +198,27 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmByName(name any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmByName", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmByName), varargs...)
 }
 
+// GetSystemVmByNameWithContext mocks base method.
+func (m *MockSystemVMServiceIface) GetSystemVmByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVm, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemVmByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*SystemVm)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSystemVmByNameWithContext indicates an expected call of GetSystemVmByNameWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmByNameWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmByNameWithContext), varargs...)
+}
+
 // GetSystemVmID mocks base method.
 func (m *MockSystemVMServiceIface) GetSystemVmID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +240,27 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmID(name any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmID", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmID), varargs...)
 }
 
+// GetSystemVmIDWithContext mocks base method.
+func (m *MockSystemVMServiceIface) GetSystemVmIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemVmIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSystemVmIDWithContext indicates an expected call of GetSystemVmIDWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmIDWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmIDWithContext), varargs...)
+}
+
 // GetSystemVmsUsageHistoryByID mocks base method.
 func (m *MockSystemVMServiceIface) GetSystemVmsUsageHistoryByID(id string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +282,27 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmsUsageHistoryByID(id
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmsUsageHistoryByID", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmsUsageHistoryByID), varargs...)
 }
 
+// GetSystemVmsUsageHistoryByIDWithContext mocks base method.
+func (m *MockSystemVMServiceIface) GetSystemVmsUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemVmsUsageHistoryByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*SystemVmsUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSystemVmsUsageHistoryByIDWithContext indicates an expected call of GetSystemVmsUsageHistoryByIDWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmsUsageHistoryByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmsUsageHistoryByIDWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmsUsageHistoryByIDWithContext), varargs...)
+}
+
 // GetSystemVmsUsageHistoryByName mocks base method.
 func (m *MockSystemVMServiceIface) GetSystemVmsUsageHistoryByName(name string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +324,27 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmsUsageHistoryByName(n
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmsUsageHistoryByName", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmsUsageHistoryByName), varargs...)
 }
 
+// GetSystemVmsUsageHistoryByNameWithContext mocks base method.
+func (m *MockSystemVMServiceIface) GetSystemVmsUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*SystemVmsUsageHistory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemVmsUsageHistoryByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*SystemVmsUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSystemVmsUsageHistoryByNameWithContext indicates an expected call of GetSystemVmsUsageHistoryByNameWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmsUsageHistoryByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmsUsageHistoryByNameWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmsUsageHistoryByNameWithContext), varargs...)
+}
+
 // GetSystemVmsUsageHistoryID mocks base method.
 func (m *MockSystemVMServiceIface) GetSystemVmsUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +366,27 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmsUsageHistoryID(name
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmsUsageHistoryID", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmsUsageHistoryID), varargs...)
 }
 
+// GetSystemVmsUsageHistoryIDWithContext mocks base method.
+func (m *MockSystemVMServiceIface) GetSystemVmsUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetSystemVmsUsageHistoryIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetSystemVmsUsageHistoryIDWithContext indicates an expected call of GetSystemVmsUsageHistoryIDWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) GetSystemVmsUsageHistoryIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSystemVmsUsageHistoryIDWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).GetSystemVmsUsageHistoryIDWithContext), varargs...)
+}
+
 // ListSystemVms mocks base method.
 func (m *MockSystemVMServiceIface) ListSystemVms(p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +402,84 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVms(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVms", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVms), p)
 }
 
+// ListSystemVmsAll mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsAll(p *ListSystemVmsParams, opts ...PageOption) ([]*SystemVm, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsAll", varargs...)
+	ret0, _ := ret[0].([]*SystemVm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSystemVmsAll indicates an expected call of ListSystemVmsAll.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsAll", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsAll), varargs...)
+}
+
+// ListSystemVmsAllWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsAllWithContext(ctx context.Context, p *ListSystemVmsParams, opts ...PageOption) ([]*SystemVm, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SystemVm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSystemVmsAllWithContext indicates an expected call of ListSystemVmsAllWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsAllWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsAllWithContext), varargs...)
+}
+
+// ListSystemVmsIter mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsIter(p *ListSystemVmsParams, opts ...PageOption) iter.Seq2[*SystemVm, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SystemVm, error])
+	return ret0
+}
+
+// ListSystemVmsIter indicates an expected call of ListSystemVmsIter.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsIter", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsIter), varargs...)
+}
+
+// ListSystemVmsIterWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsIterWithContext(ctx context.Context, p *ListSystemVmsParams, opts ...PageOption) iter.Seq2[*SystemVm, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SystemVm, error])
+	return ret0
+}
+
+// ListSystemVmsIterWithContext indicates an expected call of ListSystemVmsIterWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsIterWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsIterWithContext), varargs...)
+}
+
 // ListSystemVmsUsageHistory mocks base method.
 func (m *MockSystemVMServiceIface) ListSystemVmsUsageHistory(p *ListSystemVmsUsageHistoryParams) (*ListSystemVmsUsageHistoryResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +495,114 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsUsageHistory(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsUsageHistory", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsUsageHistory), p)
 }
 
+// ListSystemVmsUsageHistoryAll mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsUsageHistoryAll(p *ListSystemVmsUsageHistoryParams, opts ...PageOption) ([]*SystemVmsUsageHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsUsageHistoryAll", varargs...)
+	ret0, _ := ret[0].([]*SystemVmsUsageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSystemVmsUsageHistoryAll indicates an expected call of ListSystemVmsUsageHistoryAll.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsUsageHistoryAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsUsageHistoryAll", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsUsageHistoryAll), varargs...)
+}
+
+// ListSystemVmsUsageHistoryAllWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsUsageHistoryAllWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams, opts ...PageOption) ([]*SystemVmsUsageHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsUsageHistoryAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*SystemVmsUsageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSystemVmsUsageHistoryAllWithContext indicates an expected call of ListSystemVmsUsageHistoryAllWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsUsageHistoryAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsUsageHistoryAllWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsUsageHistoryAllWithContext), varargs...)
+}
+
+// ListSystemVmsUsageHistoryIter mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsUsageHistoryIter(p *ListSystemVmsUsageHistoryParams, opts ...PageOption) iter.Seq2[*SystemVmsUsageHistory, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsUsageHistoryIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SystemVmsUsageHistory, error])
+	return ret0
+}
+
+// ListSystemVmsUsageHistoryIter indicates an expected call of ListSystemVmsUsageHistoryIter.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsUsageHistoryIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsUsageHistoryIter", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsUsageHistoryIter), varargs...)
+}
+
+// ListSystemVmsUsageHistoryIterWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsUsageHistoryIterWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams, opts ...PageOption) iter.Seq2[*SystemVmsUsageHistory, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListSystemVmsUsageHistoryIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*SystemVmsUsageHistory, error])
+	return ret0
+}
+
+// ListSystemVmsUsageHistoryIterWithContext indicates an expected call of ListSystemVmsUsageHistoryIterWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsUsageHistoryIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsUsageHistoryIterWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsUsageHistoryIterWithContext), varargs...)
+}
+
+// ListSystemVmsUsageHistoryWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsUsageHistoryWithContext(ctx context.Context, p *ListSystemVmsUsageHistoryParams) (*ListSystemVmsUsageHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSystemVmsUsageHistoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSystemVmsUsageHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSystemVmsUsageHistoryWithContext indicates an expected call of ListSystemVmsUsageHistoryWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsUsageHistoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsUsageHistoryWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsUsageHistoryWithContext), ctx, p)
+}
+
+// ListSystemVmsWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ListSystemVmsWithContext(ctx context.Context, p *ListSystemVmsParams) (*ListSystemVmsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListSystemVmsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListSystemVmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListSystemVmsWithContext indicates an expected call of ListSystemVmsWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ListSystemVmsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListSystemVmsWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ListSystemVmsWithContext), ctx, p)
+}
+
 // MigrateSystemVm mocks base method.
 func (m *MockSystemVMServiceIface) MigrateSystemVm(p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +618,36 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) MigrateSystemVm(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).MigrateSystemVm), p)
 }
 
+// MigrateSystemVmAsync mocks base method.
+func (m *MockSystemVMServiceIface) MigrateSystemVmAsync(ctx context.Context, p *MigrateSystemVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSystemVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSystemVmAsync indicates an expected call of MigrateSystemVmAsync.
+func (mr *MockSystemVMServiceIfaceMockRecorder) MigrateSystemVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSystemVmAsync", reflect.TypeOf((*MockSystemVMServiceIface)(nil).MigrateSystemVmAsync), ctx, p)
+}
+
+// MigrateSystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) MigrateSystemVmWithContext(ctx context.Context, p *MigrateSystemVmParams) (*MigrateSystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateSystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*MigrateSystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateSystemVmWithContext indicates an expected call of MigrateSystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) MigrateSystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateSystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).MigrateSystemVmWithContext), ctx, p)
+}
+
 // NewChangeServiceForSystemVmParams mocks base method.
 func (m *MockSystemVMServiceIface) NewChangeServiceForSystemVmParams(id, serviceofferingid string) *ChangeServiceForSystemVmParams {
 	m.ctrl.T.Helper()
@@ -414,6 +803,36 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) PatchSystemVm(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).PatchSystemVm), p)
 }
 
+// PatchSystemVmAsync mocks base method.
+func (m *MockSystemVMServiceIface) PatchSystemVmAsync(ctx context.Context, p *PatchSystemVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchSystemVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchSystemVmAsync indicates an expected call of PatchSystemVmAsync.
+func (mr *MockSystemVMServiceIfaceMockRecorder) PatchSystemVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSystemVmAsync", reflect.TypeOf((*MockSystemVMServiceIface)(nil).PatchSystemVmAsync), ctx, p)
+}
+
+// PatchSystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) PatchSystemVmWithContext(ctx context.Context, p *PatchSystemVmParams) (*PatchSystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PatchSystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*PatchSystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PatchSystemVmWithContext indicates an expected call of PatchSystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) PatchSystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PatchSystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).PatchSystemVmWithContext), ctx, p)
+}
+
 // RebootSystemVm mocks base method.
 func (m *MockSystemVMServiceIface) RebootSystemVm(p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
 	m.ctrl.T.Helper()
@@ -429,6 +848,36 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) RebootSystemVm(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).RebootSystemVm), p)
 }
 
+// RebootSystemVmAsync mocks base method.
+func (m *MockSystemVMServiceIface) RebootSystemVmAsync(ctx context.Context, p *RebootSystemVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootSystemVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootSystemVmAsync indicates an expected call of RebootSystemVmAsync.
+func (mr *MockSystemVMServiceIfaceMockRecorder) RebootSystemVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootSystemVmAsync", reflect.TypeOf((*MockSystemVMServiceIface)(nil).RebootSystemVmAsync), ctx, p)
+}
+
+// RebootSystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) RebootSystemVmWithContext(ctx context.Context, p *RebootSystemVmParams) (*RebootSystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootSystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*RebootSystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootSystemVmWithContext indicates an expected call of RebootSystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) RebootSystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootSystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).RebootSystemVmWithContext), ctx, p)
+}
+
 // ScaleSystemVm mocks base method.
 func (m *MockSystemVMServiceIface) ScaleSystemVm(p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
 	m.ctrl.T.Helper()
@@ -444,6 +893,36 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) ScaleSystemVm(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ScaleSystemVm), p)
 }
 
+// ScaleSystemVmAsync mocks base method.
+func (m *MockSystemVMServiceIface) ScaleSystemVmAsync(ctx context.Context, p *ScaleSystemVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleSystemVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleSystemVmAsync indicates an expected call of ScaleSystemVmAsync.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ScaleSystemVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleSystemVmAsync", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ScaleSystemVmAsync), ctx, p)
+}
+
+// ScaleSystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) ScaleSystemVmWithContext(ctx context.Context, p *ScaleSystemVmParams) (*ScaleSystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleSystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*ScaleSystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleSystemVmWithContext indicates an expected call of ScaleSystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) ScaleSystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleSystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).ScaleSystemVmWithContext), ctx, p)
+}
+
 // StartSystemVm mocks base method.
 func (m *MockSystemVMServiceIface) StartSystemVm(p *StartSystemVmParams) (*StartSystemVmResponse, error) {
 	m.ctrl.T.Helper()
@@ -459,6 +938,36 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) StartSystemVm(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StartSystemVm), p)
 }
 
+// StartSystemVmAsync mocks base method.
+func (m *MockSystemVMServiceIface) StartSystemVmAsync(ctx context.Context, p *StartSystemVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSystemVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSystemVmAsync indicates an expected call of StartSystemVmAsync.
+func (mr *MockSystemVMServiceIfaceMockRecorder) StartSystemVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSystemVmAsync", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StartSystemVmAsync), ctx, p)
+}
+
+// StartSystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) StartSystemVmWithContext(ctx context.Context, p *StartSystemVmParams) (*StartSystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartSystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*StartSystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartSystemVmWithContext indicates an expected call of StartSystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) StartSystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartSystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StartSystemVmWithContext), ctx, p)
+}
+
 // StopSystemVm mocks base method.
 func (m *MockSystemVMServiceIface) StopSystemVm(p *StopSystemVmParams) (*StopSystemVmResponse, error) {
 	m.ctrl.T.Helper()
@@ -473,3 +982,33 @@ func (mr *MockSystemVMServiceIfaceMockRecorder) StopSystemVm(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSystemVm", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StopSystemVm), p)
 }
+
+// StopSystemVmAsync mocks base method.
+func (m *MockSystemVMServiceIface) StopSystemVmAsync(ctx context.Context, p *StopSystemVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopSystemVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopSystemVmAsync indicates an expected call of StopSystemVmAsync.
+func (mr *MockSystemVMServiceIfaceMockRecorder) StopSystemVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSystemVmAsync", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StopSystemVmAsync), ctx, p)
+}
+
+// StopSystemVmWithContext mocks base method.
+func (m *MockSystemVMServiceIface) StopSystemVmWithContext(ctx context.Context, p *StopSystemVmParams) (*StopSystemVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopSystemVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*StopSystemVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopSystemVmWithContext indicates an expected call of StopSystemVmWithContext.
+func (mr *MockSystemVMServiceIfaceMockRecorder) StopSystemVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopSystemVmWithContext", reflect.TypeOf((*MockSystemVMServiceIface)(nil).StopSystemVmWithContext), ctx, p)
+}
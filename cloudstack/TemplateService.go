@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,34 +31,59 @@ import (
 
 type TemplateServiceIface interface {
 	CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error)
+	CopyTemplateAsync(ctx context.Context, p *CopyTemplateParams) (*Job, error)
 	NewCopyTemplateParams(id string) *CopyTemplateParams
 	CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error)
+	CreateTemplateAsync(ctx context.Context, p *CreateTemplateParams) (*Job, error)
 	NewCreateTemplateParams(displaytext string, name string, ostypeid string) *CreateTemplateParams
 	DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error)
+	DeleteTemplateAsync(ctx context.Context, p *DeleteTemplateParams) (*Job, error)
 	NewDeleteTemplateParams(id string) *DeleteTemplateParams
 	ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error)
+	ExtractTemplateAsync(ctx context.Context, p *ExtractTemplateParams) (*Job, error)
 	NewExtractTemplateParams(id string, mode string) *ExtractTemplateParams
 	GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
-	NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, zoneid string) *GetUploadParamsForTemplateParams
+	GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error)
+	NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor HypervisorType, name string, zoneid string) *GetUploadParamsForTemplateParams
 	ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
+	ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error)
 	NewListTemplatePermissionsParams(id string) *ListTemplatePermissionsParams
 	GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error)
+	GetTemplatePermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplatePermission, int, error)
 	ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error)
+	ListTemplatesAll(p *ListTemplatesParams, opts ...PageOption) ([]*Template, error)
+	ListTemplatesAllWithContext(ctx context.Context, p *ListTemplatesParams, opts ...PageOption) ([]*Template, error)
+	ListTemplatesIter(p *ListTemplatesParams, opts ...PageOption) iter.Seq2[*Template, error]
+	ListTemplatesIterWithContext(ctx context.Context, p *ListTemplatesParams, opts ...PageOption) iter.Seq2[*Template, error]
 	NewListTemplatesParams(templatefilter string) *ListTemplatesParams
 	GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetTemplateIDWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error)
 	GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByNameWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error)
 	GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
+	GetTemplateByIDWithContext(ctx context.Context, id string, templatefilter string, opts ...OptionFunc) (*Template, int, error)
 	PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
+	PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error)
 	NewPrepareTemplateParams(templateid string, zoneid string) *PrepareTemplateParams
 	RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
-	NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, url string) *RegisterTemplateParams
+	RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error)
+	NewRegisterTemplateParams(displaytext string, format string, hypervisor HypervisorType, name string, url string) *RegisterTemplateParams
 	UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
+	UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error)
 	NewUpdateTemplateParams(id string) *UpdateTemplateParams
 	UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
+	UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error)
 	NewUpdateTemplatePermissionsParams(id string) *UpdateTemplatePermissionsParams
 	UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
+	UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error)
 	NewUpgradeRouterTemplateParams() *UpgradeRouterTemplateParams
 	LinkUserDataToTemplate(p *LinkUserDataToTemplateParams) (*LinkUserDataToTemplateResponse, error)
+	LinkUserDataToTemplateWithContext(ctx context.Context, p *LinkUserDataToTemplateParams) (*LinkUserDataToTemplateResponse, error)
 	NewLinkUserDataToTemplateParams() *LinkUserDataToTemplateParams
 }
 
@@ -85,6 +112,47 @@ func (p *CopyTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the copyTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CopyTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("copyTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the copyTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CopyTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"destzoneid": 255, "id": 255, "sourcezoneid": 255},
+	}.validate("copyTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the copyTemplate API, before the request is signed
+func (p *CopyTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CopyTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CopyTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("copyTemplate", b, &p.p, map[string]paramDecoder{
+		"destzoneid":   decodeParam[string],
+		"destzoneids":  decodeParam[[]string],
+		"id":           decodeParam[string],
+		"sourcezoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CopyTemplateParams) Clone() *CopyTemplateParams {
+	return &CopyTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *CopyTemplateParams) SetDestzoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -180,32 +248,39 @@ func (s *TemplateService) NewCopyTemplateParams(id string) *CopyTemplateParams {
 
 // Copies a Template from one zone to another.
 func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("copyTemplate", p.toURLValues())
+	return s.CopyTemplateWithContext(context.Background(), p)
+}
+
+// CopyTemplateWithContext is like CopyTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "copyTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CopyTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("copyTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "copyTemplate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("copyTemplate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -213,66 +288,86 @@ func (s *TemplateService) CopyTemplate(p *CopyTemplateParams) (*CopyTemplateResp
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CopyTemplate async job into r
+func (r *CopyTemplateResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CopyTemplateAsync starts the async job of CopyTemplate without waiting for it to finish, and returns a handle to the job
+func (s *TemplateService) CopyTemplateAsync(ctx context.Context, p *CopyTemplateParams) (*Job, error) {
+	r, err := s.CopyTemplateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("copyTemplate", r.JobID), nil
+}
+
 type CopyTemplateResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CopyTemplateResponse) UnmarshalJSON(b []byte) error {
@@ -299,7 +394,7 @@ func (r *CopyTemplateResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CopyTemplateResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateTemplateParams struct {
@@ -387,6 +482,64 @@ func (p *CreateTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "name", "ostypeid"},
+		lengths:  map[string]int{"account": 255, "arch": 255, "displaytext": 4096, "domainid": 255, "name": 255, "ostypeid": 255, "projectid": 255, "snapshotid": 255, "templatetag": 255, "url": 2048, "virtualmachineid": 255, "volumeid": 255, "zoneid": 255},
+	}.validate("createTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createTemplate API, before the request is signed
+func (p *CreateTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createTemplate", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"arch":                  decodeParam[string],
+		"bits":                  decodeParam[int],
+		"details":               decodeParam[map[string]string],
+		"displaytext":           decodeParam[string],
+		"domainid":              decodeParam[string],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isfeatured":            decodeParam[bool],
+		"ispublic":              decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"projectid":             decodeParam[string],
+		"requireshvm":           decodeParam[bool],
+		"snapshotid":            decodeParam[string],
+		"sshkeyenabled":         decodeParam[bool],
+		"templatetag":           decodeParam[string],
+		"url":                   decodeParam[string],
+		"virtualmachineid":      decodeParam[string],
+		"volumeid":              decodeParam[string],
+		"zoneid":                decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateTemplateParams) Clone() *CreateTemplateParams {
+	return &CreateTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -841,32 +994,39 @@ func (s *TemplateService) NewCreateTemplateParams(displaytext string, name strin
 
 // Creates a Template of an Instance. The Instance must be in a STOPPED state. A Template created from this command is automatically designated as a private Template visible to the account that created it.
 func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("createTemplate", p.toURLValues())
+	return s.CreateTemplateWithContext(context.Background(), p)
+}
+
+// CreateTemplateWithContext is like CreateTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createTemplate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createTemplate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -874,66 +1034,86 @@ func (s *TemplateService) CreateTemplate(p *CreateTemplateParams) (*CreateTempla
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateTemplate async job into r
+func (r *CreateTemplateResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateTemplateAsync starts the async job of CreateTemplate without waiting for it to finish, and returns a handle to the job
+func (s *TemplateService) CreateTemplateAsync(ctx context.Context, p *CreateTemplateParams) (*Job, error) {
+	r, err := s.CreateTemplateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createTemplate", r.JobID), nil
+}
+
 type CreateTemplateResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *CreateTemplateResponse) UnmarshalJSON(b []byte) error {
@@ -960,7 +1140,7 @@ func (r *CreateTemplateResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias CreateTemplateResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteTemplateParams struct {
@@ -989,6 +1169,47 @@ func (p *DeleteTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "zoneid": 255},
+	}.validate("deleteTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteTemplate API, before the request is signed
+func (p *DeleteTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteTemplate", b, &p.p, map[string]paramDecoder{
+		"forced":   decodeParam[bool],
+		"id":       decodeParam[string],
+		"issystem": decodeParam[bool],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteTemplateParams) Clone() *DeleteTemplateParams {
+	return &DeleteTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteTemplateParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1084,27 +1305,39 @@ func (s *TemplateService) NewDeleteTemplateParams(id string) *DeleteTemplatePara
 
 // Deletes a Template from the system. All Instances using the deleted Template will not be affected.
 func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteTemplate", p.toURLValues())
+	return s.DeleteTemplateWithContext(context.Background(), p)
+}
+
+// DeleteTemplateWithContext is like DeleteTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteTemplate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteTemplate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1112,11 +1345,31 @@ func (s *TemplateService) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTempla
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteTemplate async job into r
+func (r *DeleteTemplateResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteTemplateAsync starts the async job of DeleteTemplate without waiting for it to finish, and returns a handle to the job
+func (s *TemplateService) DeleteTemplateAsync(ctx context.Context, p *DeleteTemplateParams) (*Job, error) {
+	r, err := s.DeleteTemplateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteTemplate", r.JobID), nil
+}
+
 type DeleteTemplateResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteTemplateResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteTemplateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExtractTemplateParams struct {
@@ -1143,6 +1396,47 @@ func (p *ExtractTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the extractTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExtractTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("extractTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the extractTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExtractTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "mode"},
+		lengths:  map[string]int{"id": 255, "mode": 255, "url": 2048, "zoneid": 255},
+	}.validate("extractTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the extractTemplate API, before the request is signed
+func (p *ExtractTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExtractTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExtractTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("extractTemplate", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"mode":   decodeParam[string],
+		"url":    decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExtractTemplateParams) Clone() *ExtractTemplateParams {
+	return &ExtractTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *ExtractTemplateParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1239,32 +1533,39 @@ func (s *TemplateService) NewExtractTemplateParams(id string, mode string) *Extr
 
 // Extracts a Template
 func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("extractTemplate", p.toURLValues())
+	return s.ExtractTemplateWithContext(context.Background(), p)
+}
+
+// ExtractTemplateWithContext is like ExtractTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "extractTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExtractTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("extractTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "extractTemplate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("extractTemplate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1272,23 +1573,48 @@ func (s *TemplateService) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTem
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ExtractTemplate async job into r
+func (r *ExtractTemplateResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ExtractTemplateAsync starts the async job of ExtractTemplate without waiting for it to finish, and returns a handle to the job
+func (s *TemplateService) ExtractTemplateAsync(ctx context.Context, p *ExtractTemplateParams) (*Job, error) {
+	r, err := s.ExtractTemplateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("extractTemplate", r.JobID), nil
+}
+
 type ExtractTemplateResponse struct {
-	Accountid        string `json:"accountid"`
-	Created          string `json:"created"`
-	ExtractId        string `json:"extractId"`
-	ExtractMode      string `json:"extractMode"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Resultstring     string `json:"resultstring"`
-	State            string `json:"state"`
-	Status           string `json:"status"`
-	Storagetype      string `json:"storagetype"`
-	Uploadpercentage int    `json:"uploadpercentage"`
-	Url              string `json:"url"`
-	Zoneid           string `json:"zoneid"`
-	Zonename         string `json:"zonename"`
+	Accountid        string                     `json:"accountid"`
+	Created          Time                       `json:"created"`
+	ExtractId        string                     `json:"extractId"`
+	ExtractMode      string                     `json:"extractMode"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Resultstring     string                     `json:"resultstring"`
+	State            string                     `json:"state"`
+	Status           string                     `json:"status"`
+	Storagetype      string                     `json:"storagetype"`
+	Uploadpercentage FlexInt                    `json:"uploadpercentage"`
+	Url              string                     `json:"url"`
+	Zoneid           string                     `json:"zoneid"`
+	Zonename         string                     `json:"zonename"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExtractTemplateResponse) UnmarshalJSON(b []byte) error {
+	type alias ExtractTemplateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetUploadParamsForTemplateParams struct {
@@ -1337,7 +1663,7 @@ func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 		u.Set("format", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["isdynamicallyscalable"]; found {
 		vv := strconv.FormatBool(v.(bool))
@@ -1392,6 +1718,68 @@ func (p *GetUploadParamsForTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getUploadParamsForTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetUploadParamsForTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getUploadParamsForTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getUploadParamsForTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetUploadParamsForTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "format", "hypervisor", "name", "zoneid"},
+		lengths:  map[string]int{"account": 255, "arch": 255, "checksum": 255, "displaytext": 4096, "domainid": 255, "format": 255, "hypervisor": 255, "name": 255, "ostypeid": 255, "projectid": 255, "templatetag": 255, "templatetype": 255, "zoneid": 255},
+	}.validate("getUploadParamsForTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getUploadParamsForTemplate API, before the request is signed
+func (p *GetUploadParamsForTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetUploadParamsForTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetUploadParamsForTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getUploadParamsForTemplate", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"arch":                  decodeParam[string],
+		"bits":                  decodeParam[int],
+		"checksum":              decodeParam[string],
+		"deployasis":            decodeParam[bool],
+		"details":               decodeParam[map[string]string],
+		"displaytext":           decodeParam[string],
+		"domainid":              decodeParam[string],
+		"forcks":                decodeParam[bool],
+		"format":                decodeParam[string],
+		"hypervisor":            decodeParam[HypervisorType],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isextractable":         decodeParam[bool],
+		"isfeatured":            decodeParam[bool],
+		"ispublic":              decodeParam[bool],
+		"isrouting":             decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"projectid":             decodeParam[string],
+		"requireshvm":           decodeParam[bool],
+		"sshkeyenabled":         decodeParam[bool],
+		"templatetag":           decodeParam[string],
+		"templatetype":          decodeParam[string],
+		"zoneid":                decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetUploadParamsForTemplateParams) Clone() *GetUploadParamsForTemplateParams {
+	return &GetUploadParamsForTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *GetUploadParamsForTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1602,7 +1990,7 @@ func (p *GetUploadParamsForTemplateParams) GetFormat() (string, bool) {
 	return value, ok
 }
 
-func (p *GetUploadParamsForTemplateParams) SetHypervisor(v string) {
+func (p *GetUploadParamsForTemplateParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -1615,11 +2003,11 @@ func (p *GetUploadParamsForTemplateParams) ResetHypervisor() {
 	}
 }
 
-func (p *GetUploadParamsForTemplateParams) GetHypervisor() (string, bool) {
+func (p *GetUploadParamsForTemplateParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -1919,7 +2307,7 @@ func (p *GetUploadParamsForTemplateParams) GetZoneid() (string, bool) {
 
 // You should always use this function to get a new GetUploadParamsForTemplateParams instance,
 // as then you are sure you have configured all required params
-func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor string, name string, zoneid string) *GetUploadParamsForTemplateParams {
+func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string, format string, hypervisor HypervisorType, name string, zoneid string) *GetUploadParamsForTemplateParams {
 	p := &GetUploadParamsForTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
@@ -1932,7 +2320,16 @@ func (s *TemplateService) NewGetUploadParamsForTemplateParams(displaytext string
 
 // Upload an existing Template into the CloudStack cloud.
 func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForTemplate", p.toURLValues())
+	return s.GetUploadParamsForTemplateWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForTemplateWithContext is like GetUploadParamsForTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getUploadParamsForTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1940,7 +2337,7 @@ func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTempla
 	var nested struct {
 		Response GetUploadParamsForTemplateResponse `json:"getuploadparams"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("getUploadParamsForTemplate", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -1949,13 +2346,19 @@ func (s *TemplateService) GetUploadParamsForTemplate(p *GetUploadParamsForTempla
 }
 
 type GetUploadParamsForTemplateResponse struct {
-	Expires   string `json:"expires"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Metadata  string `json:"metadata"`
-	PostURL   string `json:"postURL"`
-	Signature string `json:"signature"`
+	Expires   Time                       `json:"expires"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Metadata  string                     `json:"metadata"`
+	PostURL   string                     `json:"postURL"`
+	Signature string                     `json:"signature"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetUploadParamsForTemplateResponse) UnmarshalJSON(b []byte) error {
+	type alias GetUploadParamsForTemplateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListTemplatePermissionsParams struct {
@@ -1973,6 +2376,44 @@ func (p *ListTemplatePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listTemplatePermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListTemplatePermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listTemplatePermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listTemplatePermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListTemplatePermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("listTemplatePermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listTemplatePermissions API, before the request is signed
+func (p *ListTemplatePermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListTemplatePermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListTemplatePermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listTemplatePermissions", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListTemplatePermissionsParams) Clone() *ListTemplatePermissionsParams {
+	return &ListTemplatePermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListTemplatePermissionsParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2005,6 +2446,11 @@ func (s *TemplateService) NewListTemplatePermissionsParams(id string) *ListTempl
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
+	return s.GetTemplatePermissionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetTemplatePermissionByIDWithContext is like GetTemplatePermissionByID, but honours the cancellation and deadline of ctx
+func (s *TemplateService) GetTemplatePermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
 	p := &ListTemplatePermissionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2016,11 +2462,10 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 		}
 	}
 
-	l, err := s.ListTemplatePermissions(p)
+	l, err := s.ListTemplatePermissionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2038,13 +2483,22 @@ func (s *TemplateService) GetTemplatePermissionByID(id string, opts ...OptionFun
 
 // List Template visibility and all accounts that have permissions to view this Template.
 func (s *TemplateService) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newRequest("listTemplatePermissions", p.toURLValues())
+	return s.ListTemplatePermissionsWithContext(context.Background(), p)
+}
+
+// ListTemplatePermissionsWithContext is like ListTemplatePermissions, but honours the cancellation and deadline of ctx
+func (s *TemplateService) ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listTemplatePermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTemplatePermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listTemplatePermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2057,13 +2511,19 @@ type ListTemplatePermissionsResponse struct {
 }
 
 type TemplatePermission struct {
-	Account    []string `json:"account"`
-	Domainid   string   `json:"domainid"`
-	Id         string   `json:"id"`
-	Ispublic   bool     `json:"ispublic"`
-	JobID      string   `json:"jobid"`
-	Jobstatus  int      `json:"jobstatus"`
-	Projectids []string `json:"projectids"`
+	Account    []string                   `json:"account"`
+	Domainid   string                     `json:"domainid"`
+	Id         string                     `json:"id"`
+	Ispublic   FlexBool                   `json:"ispublic"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Projectids []string                   `json:"projectids"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *TemplatePermission) UnmarshalJSON(b []byte) error {
+	type alias TemplatePermission
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListTemplatesParams struct {
@@ -2096,7 +2556,7 @@ func (p *ListTemplatesParams) toURLValues() url.Values {
 		u.Set("forcks", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -2181,6 +2641,72 @@ func (p *ListTemplatesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listTemplates API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListTemplatesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listTemplates", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listTemplates API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListTemplatesParams) Validate() error {
+	return paramRules{
+		required: []string{"templatefilter"},
+		lengths:  map[string]int{"account": 255, "arch": 255, "domainid": 255, "extensionid": 255, "hypervisor": 255, "id": 255, "imagestoreid": 255, "keyword": 255, "name": 255, "oscategoryid": 255, "parenttemplateid": 255, "projectid": 255, "storageid": 255, "templatefilter": 255, "templatetype": 255, "zoneid": 255},
+	}.validate("listTemplates", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listTemplates API, before the request is signed
+func (p *ListTemplatesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListTemplatesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListTemplatesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listTemplates", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"arch":             decodeParam[string],
+		"details":          decodeParam[[]string],
+		"domainid":         decodeParam[string],
+		"extensionid":      decodeParam[string],
+		"forcks":           decodeParam[bool],
+		"hypervisor":       decodeParam[HypervisorType],
+		"id":               decodeParam[string],
+		"ids":              decodeParam[[]string],
+		"imagestoreid":     decodeParam[string],
+		"isready":          decodeParam[bool],
+		"isrecursive":      decodeParam[bool],
+		"isvnf":            decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"name":             decodeParam[string],
+		"oscategoryid":     decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"parenttemplateid": decodeParam[string],
+		"projectid":        decodeParam[string],
+		"showicon":         decodeParam[bool],
+		"showremoved":      decodeParam[bool],
+		"showunique":       decodeParam[bool],
+		"storageid":        decodeParam[string],
+		"tags":             decodeParam[map[string]string],
+		"templatefilter":   decodeParam[string],
+		"templatetype":     decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListTemplatesParams) Clone() *ListTemplatesParams {
+	return &ListTemplatesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListTemplatesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2307,7 +2833,7 @@ func (p *ListTemplatesParams) GetForcks() (bool, bool) {
 	return value, ok
 }
 
-func (p *ListTemplatesParams) SetHypervisor(v string) {
+func (p *ListTemplatesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -2320,11 +2846,11 @@ func (p *ListTemplatesParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListTemplatesParams) GetHypervisor() (string, bool) {
+func (p *ListTemplatesParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -2801,6 +3327,11 @@ func (s *TemplateService) NewListTemplatesParams(templatefilter string) *ListTem
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateID(name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetTemplateIDWithContext(context.Background(), name, templatefilter, zoneid, opts...)
+}
+
+// GetTemplateIDWithContext is like GetTemplateID, but honours the cancellation and deadline of ctx
+func (s *TemplateService) GetTemplateIDWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTemplatesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2814,7 +3345,7 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 		}
 	}
 
-	l, err := s.ListTemplates(p)
+	l, err := s.ListTemplatesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2839,12 +3370,17 @@ func (s *TemplateService) GetTemplateID(name string, templatefilter string, zone
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateByName(name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error) {
-	id, count, err := s.GetTemplateID(name, templatefilter, zoneid, opts...)
+	return s.GetTemplateByNameWithContext(context.Background(), name, templatefilter, zoneid, opts...)
+}
+
+// GetTemplateByNameWithContext is like GetTemplateByName, but honours the cancellation and deadline of ctx
+func (s *TemplateService) GetTemplateByNameWithContext(ctx context.Context, name string, templatefilter string, zoneid string, opts ...OptionFunc) (*Template, int, error) {
+	id, count, err := s.GetTemplateIDWithContext(ctx, name, templatefilter, zoneid, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetTemplateByID(id, templatefilter, append(opts, WithZone(zoneid))...)
+	r, count, err := s.GetTemplateByIDWithContext(ctx, id, templatefilter, append(opts, WithZone(zoneid))...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2853,6 +3389,11 @@ func (s *TemplateService) GetTemplateByName(name string, templatefilter string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
+	return s.GetTemplateByIDWithContext(context.Background(), id, templatefilter, opts...)
+}
+
+// GetTemplateByIDWithContext is like GetTemplateByID, but honours the cancellation and deadline of ctx
+func (s *TemplateService) GetTemplateByIDWithContext(ctx context.Context, id string, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
 	p := &ListTemplatesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2865,11 +3406,10 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 		}
 	}
 
-	l, err := s.ListTemplates(p)
+	l, err := s.ListTemplatesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2887,84 +3427,122 @@ func (s *TemplateService) GetTemplateByID(id string, templatefilter string, opts
 
 // List all public, private, and privileged Templates.
 func (s *TemplateService) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
-	resp, err := s.cs.newRequest("listTemplates", p.toURLValues())
+	return s.ListTemplatesWithContext(context.Background(), p)
+}
+
+// ListTemplatesWithContext is like ListTemplates, but honours the cancellation and deadline of ctx
+func (s *TemplateService) ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listTemplates", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTemplatesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listTemplates", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListTemplatesAll returns all Templates matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *TemplateService) ListTemplatesAll(p *ListTemplatesParams, opts ...PageOption) ([]*Template, error) {
+	return s.ListTemplatesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListTemplatesAllWithContext is like ListTemplatesAll, but honours the cancellation and deadline of ctx
+func (s *TemplateService) ListTemplatesAllWithContext(ctx context.Context, p *ListTemplatesParams, opts ...PageOption) ([]*Template, error) {
+	return collectPages(s.ListTemplatesIterWithContext(ctx, p, opts...))
+}
+
+// ListTemplatesIter returns an iterator over all Templates matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *TemplateService) ListTemplatesIter(p *ListTemplatesParams, opts ...PageOption) iter.Seq2[*Template, error] {
+	return s.ListTemplatesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListTemplatesIterWithContext is like ListTemplatesIter, but honours the cancellation and deadline of ctx
+func (s *TemplateService) ListTemplatesIterWithContext(ctx context.Context, p *ListTemplatesParams, opts ...PageOption) iter.Seq2[*Template, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Template, int, error) {
+		l, err := s.ListTemplatesWithContext(ctx, &ListTemplatesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Templates, l.Count, nil
+	}, opts...)
+}
+
 type ListTemplatesResponse struct {
 	Count     int         `json:"count"`
 	Templates []*Template `json:"template"`
 }
 
 type Template struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *Template) UnmarshalJSON(b []byte) error {
@@ -2991,7 +3569,7 @@ func (r *Template) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias Template
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type PrepareTemplateParams struct {
@@ -3015,6 +3593,46 @@ func (p *PrepareTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the prepareTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *PrepareTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("prepareTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the prepareTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *PrepareTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"templateid", "zoneid"},
+		lengths:  map[string]int{"storageid": 255, "templateid": 255, "zoneid": 255},
+	}.validate("prepareTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the prepareTemplate API, before the request is signed
+func (p *PrepareTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *PrepareTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *PrepareTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("prepareTemplate", b, &p.p, map[string]paramDecoder{
+		"storageid":  decodeParam[string],
+		"templateid": decodeParam[string],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *PrepareTemplateParams) Clone() *PrepareTemplateParams {
+	return &PrepareTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *PrepareTemplateParams) SetStorageid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3090,13 +3708,22 @@ func (s *TemplateService) NewPrepareTemplateParams(templateid string, zoneid str
 
 // Load Template into primary storage
 func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("prepareTemplate", p.toURLValues())
+	return s.PrepareTemplateWithContext(context.Background(), p)
+}
+
+// PrepareTemplateWithContext is like PrepareTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "prepareTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r PrepareTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("prepareTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3104,65 +3731,66 @@ func (s *TemplateService) PrepareTemplate(p *PrepareTemplateParams) (*PrepareTem
 }
 
 type PrepareTemplateResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *PrepareTemplateResponse) UnmarshalJSON(b []byte) error {
@@ -3189,7 +3817,7 @@ func (r *PrepareTemplateResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias PrepareTemplateResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterTemplateParams struct {
@@ -3251,7 +3879,7 @@ func (p *RegisterTemplateParams) toURLValues() url.Values {
 		u.Set("format", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["isdynamicallyscalable"]; found {
 		vv := strconv.FormatBool(v.(bool))
@@ -3313,6 +3941,73 @@ func (p *RegisterTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "format", "hypervisor", "name", "url"},
+		lengths:  map[string]int{"account": 255, "arch": 255, "checksum": 255, "displaytext": 4096, "domainid": 255, "extensionid": 255, "format": 255, "hypervisor": 255, "name": 255, "ostypeid": 255, "projectid": 255, "templatetag": 255, "templatetype": 255, "url": 2048, "zoneid": 255},
+	}.validate("registerTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerTemplate API, before the request is signed
+func (p *RegisterTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerTemplate", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"arch":                  decodeParam[string],
+		"bits":                  decodeParam[int],
+		"checksum":              decodeParam[string],
+		"deployasis":            decodeParam[bool],
+		"details":               decodeParam[map[string]string],
+		"directdownload":        decodeParam[bool],
+		"displaytext":           decodeParam[string],
+		"domainid":              decodeParam[string],
+		"extensionid":           decodeParam[string],
+		"externaldetails":       decodeParam[map[string]string],
+		"forcks":                decodeParam[bool],
+		"format":                decodeParam[string],
+		"hypervisor":            decodeParam[HypervisorType],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isextractable":         decodeParam[bool],
+		"isfeatured":            decodeParam[bool],
+		"ispublic":              decodeParam[bool],
+		"isrouting":             decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"projectid":             decodeParam[string],
+		"requireshvm":           decodeParam[bool],
+		"sshkeyenabled":         decodeParam[bool],
+		"templatetag":           decodeParam[string],
+		"templatetype":          decodeParam[string],
+		"url":                   decodeParam[string],
+		"zoneid":                decodeParam[string],
+		"zoneids":               decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterTemplateParams) Clone() *RegisterTemplateParams {
+	return &RegisterTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3586,7 +4281,7 @@ func (p *RegisterTemplateParams) GetFormat() (string, bool) {
 	return value, ok
 }
 
-func (p *RegisterTemplateParams) SetHypervisor(v string) {
+func (p *RegisterTemplateParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -3599,11 +4294,11 @@ func (p *RegisterTemplateParams) ResetHypervisor() {
 	}
 }
 
-func (p *RegisterTemplateParams) GetHypervisor() (string, bool) {
+func (p *RegisterTemplateParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -3945,7 +4640,7 @@ func (p *RegisterTemplateParams) GetZoneids() ([]string, bool) {
 
 // You should always use this function to get a new RegisterTemplateParams instance,
 // as then you are sure you have configured all required params
-func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor string, name string, url string) *RegisterTemplateParams {
+func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format string, hypervisor HypervisorType, name string, url string) *RegisterTemplateParams {
 	p := &RegisterTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["displaytext"] = displaytext
@@ -3958,13 +4653,22 @@ func (s *TemplateService) NewRegisterTemplateParams(displaytext string, format s
 
 // Registers an existing Template into the CloudStack cloud.
 func (s *TemplateService) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("registerTemplate", p.toURLValues())
+	return s.RegisterTemplateWithContext(context.Background(), p)
+}
+
+// RegisterTemplateWithContext is like RegisterTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3977,65 +4681,66 @@ type RegisterTemplateResponse struct {
 }
 
 type RegisterTemplate struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RegisterTemplate) UnmarshalJSON(b []byte) error {
@@ -4062,7 +4767,7 @@ func (r *RegisterTemplate) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RegisterTemplate
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateTemplateParams struct {
@@ -4147,6 +4852,62 @@ func (p *UpdateTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"arch": 255, "displaytext": 4096, "format": 255, "id": 255, "name": 255, "ostypeid": 255, "templatetag": 255, "templatetype": 255},
+	}.validate("updateTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateTemplate API, before the request is signed
+func (p *UpdateTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateTemplate", b, &p.p, map[string]paramDecoder{
+		"arch":                  decodeParam[string],
+		"bootable":              decodeParam[bool],
+		"cleanupdetails":        decodeParam[bool],
+		"details":               decodeParam[map[string]string],
+		"displaytext":           decodeParam[string],
+		"forceupdateostype":     decodeParam[bool],
+		"forcks":                decodeParam[bool],
+		"format":                decodeParam[string],
+		"id":                    decodeParam[string],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isrouting":             decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"requireshvm":           decodeParam[bool],
+		"sortkey":               decodeParam[int],
+		"sshkeyenabled":         decodeParam[bool],
+		"templatetag":           decodeParam[string],
+		"templatetype":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateTemplateParams) Clone() *UpdateTemplateParams {
+	return &UpdateTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateTemplateParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4557,13 +5318,22 @@ func (s *TemplateService) NewUpdateTemplateParams(id string) *UpdateTemplatePara
 
 // Updates attributes of a Template.
 func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("updateTemplate", p.toURLValues())
+	return s.UpdateTemplateWithContext(context.Background(), p)
+}
+
+// UpdateTemplateWithContext is like UpdateTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4571,65 +5341,66 @@ func (s *TemplateService) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTempla
 }
 
 type UpdateTemplateResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateTemplateResponse) UnmarshalJSON(b []byte) error {
@@ -4656,7 +5427,7 @@ func (r *UpdateTemplateResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateTemplateResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateTemplatePermissionsParams struct {
@@ -4697,6 +5468,50 @@ func (p *UpdateTemplatePermissionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateTemplatePermissions API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateTemplatePermissionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateTemplatePermissions", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateTemplatePermissions API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateTemplatePermissionsParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "op": 255},
+	}.validate("updateTemplatePermissions", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateTemplatePermissions API, before the request is signed
+func (p *UpdateTemplatePermissionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateTemplatePermissionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateTemplatePermissionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateTemplatePermissions", b, &p.p, map[string]paramDecoder{
+		"accounts":      decodeParam[[]string],
+		"id":            decodeParam[string],
+		"isextractable": decodeParam[bool],
+		"isfeatured":    decodeParam[bool],
+		"ispublic":      decodeParam[bool],
+		"op":            decodeParam[string],
+		"projectids":    decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateTemplatePermissionsParams) Clone() *UpdateTemplatePermissionsParams {
+	return &UpdateTemplatePermissionsParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateTemplatePermissionsParams) SetAccounts(v []string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4855,13 +5670,22 @@ func (s *TemplateService) NewUpdateTemplatePermissionsParams(id string) *UpdateT
 
 // Updates a Template visibility permissions. A public Template is visible to all accounts within the same domain. A private Template is visible only to the owner of the Template. A privileged Template is a private Template with account permissions added. Only accounts specified under the Template permissions are visible to them.
 func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
-	resp, err := s.cs.newPostRequest("updateTemplatePermissions", p.toURLValues())
+	return s.UpdateTemplatePermissionsWithContext(context.Background(), p)
+}
+
+// UpdateTemplatePermissionsWithContext is like UpdateTemplatePermissions, but honours the cancellation and deadline of ctx
+func (s *TemplateService) UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateTemplatePermissions", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateTemplatePermissionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateTemplatePermissions", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4869,10 +5693,11 @@ func (s *TemplateService) UpdateTemplatePermissions(p *UpdateTemplatePermissions
 }
 
 type UpdateTemplatePermissionsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateTemplatePermissionsResponse) UnmarshalJSON(b []byte) error {
@@ -4899,7 +5724,7 @@ func (r *UpdateTemplatePermissionsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateTemplatePermissionsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpgradeRouterTemplateParams struct {
@@ -4932,6 +5757,48 @@ func (p *UpgradeRouterTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the upgradeRouterTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpgradeRouterTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("upgradeRouterTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the upgradeRouterTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpgradeRouterTemplateParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "clusterid": 255, "domainid": 255, "id": 255, "podid": 255, "zoneid": 255},
+	}.validate("upgradeRouterTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the upgradeRouterTemplate API, before the request is signed
+func (p *UpgradeRouterTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpgradeRouterTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpgradeRouterTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("upgradeRouterTemplate", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"clusterid": decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"podid":     decodeParam[string],
+		"zoneid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpgradeRouterTemplateParams) Clone() *UpgradeRouterTemplateParams {
+	return &UpgradeRouterTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *UpgradeRouterTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5068,13 +5935,22 @@ func (s *TemplateService) NewUpgradeRouterTemplateParams() *UpgradeRouterTemplat
 
 // Upgrades router to use newer Template
 func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("upgradeRouterTemplate", p.toURLValues())
+	return s.UpgradeRouterTemplateWithContext(context.Background(), p)
+}
+
+// UpgradeRouterTemplateWithContext is like UpgradeRouterTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "upgradeRouterTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpgradeRouterTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("upgradeRouterTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5082,8 +5958,14 @@ func (s *TemplateService) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams)
 }
 
 type UpgradeRouterTemplateResponse struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpgradeRouterTemplateResponse) UnmarshalJSON(b []byte) error {
+	type alias UpgradeRouterTemplateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LinkUserDataToTemplateParams struct {
@@ -5110,6 +5992,46 @@ func (p *LinkUserDataToTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the linkUserDataToTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LinkUserDataToTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("linkUserDataToTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the linkUserDataToTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LinkUserDataToTemplateParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"isoid": 255, "templateid": 255, "userdataid": 255, "userdatapolicy": 255},
+	}.validate("linkUserDataToTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the linkUserDataToTemplate API, before the request is signed
+func (p *LinkUserDataToTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LinkUserDataToTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LinkUserDataToTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("linkUserDataToTemplate", b, &p.p, map[string]paramDecoder{
+		"isoid":          decodeParam[string],
+		"templateid":     decodeParam[string],
+		"userdataid":     decodeParam[string],
+		"userdatapolicy": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LinkUserDataToTemplateParams) Clone() *LinkUserDataToTemplateParams {
+	return &LinkUserDataToTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *LinkUserDataToTemplateParams) SetIsoid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5204,7 +6126,16 @@ func (s *TemplateService) NewLinkUserDataToTemplateParams() *LinkUserDataToTempl
 
 // Link or unlink a userdata to a Template.
 func (s *TemplateService) LinkUserDataToTemplate(p *LinkUserDataToTemplateParams) (*LinkUserDataToTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("linkUserDataToTemplate", p.toURLValues())
+	return s.LinkUserDataToTemplateWithContext(context.Background(), p)
+}
+
+// LinkUserDataToTemplateWithContext is like LinkUserDataToTemplate, but honours the cancellation and deadline of ctx
+func (s *TemplateService) LinkUserDataToTemplateWithContext(ctx context.Context, p *LinkUserDataToTemplateParams) (*LinkUserDataToTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "linkUserDataToTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -5212,7 +6143,7 @@ func (s *TemplateService) LinkUserDataToTemplate(p *LinkUserDataToTemplateParams
 	var nested struct {
 		Response LinkUserDataToTemplateResponse `json:"template"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("linkUserDataToTemplate", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -5221,65 +6152,66 @@ func (s *TemplateService) LinkUserDataToTemplate(p *LinkUserDataToTemplateParams
 }
 
 type LinkUserDataToTemplateResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *LinkUserDataToTemplateResponse) UnmarshalJSON(b []byte) error {
@@ -5306,5 +6238,5 @@ func (r *LinkUserDataToTemplateResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias LinkUserDataToTemplateResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
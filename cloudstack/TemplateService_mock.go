@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockTemplateServiceIfaceMockRecorder) CopyTemplate(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).CopyTemplate), p)
 }
 
+// CopyTemplateAsync mocks base method.
+func (m *MockTemplateServiceIface) CopyTemplateAsync(ctx context.Context, p *CopyTemplateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTemplateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyTemplateAsync indicates an expected call of CopyTemplateAsync.
+func (mr *MockTemplateServiceIfaceMockRecorder) CopyTemplateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTemplateAsync", reflect.TypeOf((*MockTemplateServiceIface)(nil).CopyTemplateAsync), ctx, p)
+}
+
+// CopyTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) CopyTemplateWithContext(ctx context.Context, p *CopyTemplateParams) (*CopyTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CopyTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*CopyTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CopyTemplateWithContext indicates an expected call of CopyTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) CopyTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CopyTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).CopyTemplateWithContext), ctx, p)
+}
+
 // CreateTemplate mocks base method.
 func (m *MockTemplateServiceIface) CreateTemplate(p *CreateTemplateParams) (*CreateTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockTemplateServiceIfaceMockRecorder) CreateTemplate(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).CreateTemplate), p)
 }
 
+// CreateTemplateAsync mocks base method.
+func (m *MockTemplateServiceIface) CreateTemplateAsync(ctx context.Context, p *CreateTemplateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplateAsync indicates an expected call of CreateTemplateAsync.
+func (mr *MockTemplateServiceIfaceMockRecorder) CreateTemplateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplateAsync", reflect.TypeOf((*MockTemplateServiceIface)(nil).CreateTemplateAsync), ctx, p)
+}
+
+// CreateTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) CreateTemplateWithContext(ctx context.Context, p *CreateTemplateParams) (*CreateTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateTemplateWithContext indicates an expected call of CreateTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) CreateTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).CreateTemplateWithContext), ctx, p)
+}
+
 // DeleteTemplate mocks base method.
 func (m *MockTemplateServiceIface) DeleteTemplate(p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockTemplateServiceIfaceMockRecorder) DeleteTemplate(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).DeleteTemplate), p)
 }
 
+// DeleteTemplateAsync mocks base method.
+func (m *MockTemplateServiceIface) DeleteTemplateAsync(ctx context.Context, p *DeleteTemplateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTemplateAsync indicates an expected call of DeleteTemplateAsync.
+func (mr *MockTemplateServiceIfaceMockRecorder) DeleteTemplateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateAsync", reflect.TypeOf((*MockTemplateServiceIface)(nil).DeleteTemplateAsync), ctx, p)
+}
+
+// DeleteTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) DeleteTemplateWithContext(ctx context.Context, p *DeleteTemplateParams) (*DeleteTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTemplateWithContext indicates an expected call of DeleteTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) DeleteTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).DeleteTemplateWithContext), ctx, p)
+}
+
 // ExtractTemplate mocks base method.
 func (m *MockTemplateServiceIface) ExtractTemplate(p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockTemplateServiceIfaceMockRecorder) ExtractTemplate(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).ExtractTemplate), p)
 }
 
+// ExtractTemplateAsync mocks base method.
+func (m *MockTemplateServiceIface) ExtractTemplateAsync(ctx context.Context, p *ExtractTemplateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTemplateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractTemplateAsync indicates an expected call of ExtractTemplateAsync.
+func (mr *MockTemplateServiceIfaceMockRecorder) ExtractTemplateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTemplateAsync", reflect.TypeOf((*MockTemplateServiceIface)(nil).ExtractTemplateAsync), ctx, p)
+}
+
+// ExtractTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) ExtractTemplateWithContext(ctx context.Context, p *ExtractTemplateParams) (*ExtractTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExtractTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractTemplateWithContext indicates an expected call of ExtractTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) ExtractTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).ExtractTemplateWithContext), ctx, p)
+}
+
 // GetTemplateByID mocks base method.
 func (m *MockTemplateServiceIface) GetTemplateByID(id, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +261,27 @@ func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplateByID(id, templatefilt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByID", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplateByID), varargs...)
 }
 
+// GetTemplateByIDWithContext mocks base method.
+func (m *MockTemplateServiceIface) GetTemplateByIDWithContext(ctx context.Context, id, templatefilter string, opts ...OptionFunc) (*Template, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, templatefilter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTemplateByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Template)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTemplateByIDWithContext indicates an expected call of GetTemplateByIDWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplateByIDWithContext(ctx, id, templatefilter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, templatefilter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByIDWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplateByIDWithContext), varargs...)
+}
+
 // GetTemplateByName mocks base method.
 func (m *MockTemplateServiceIface) GetTemplateByName(name, templatefilter, zoneid string, opts ...OptionFunc) (*Template, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +303,27 @@ func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplateByName(name, template
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByName", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplateByName), varargs...)
 }
 
+// GetTemplateByNameWithContext mocks base method.
+func (m *MockTemplateServiceIface) GetTemplateByNameWithContext(ctx context.Context, name, templatefilter, zoneid string, opts ...OptionFunc) (*Template, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, templatefilter, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTemplateByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Template)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTemplateByNameWithContext indicates an expected call of GetTemplateByNameWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplateByNameWithContext(ctx, name, templatefilter, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, templatefilter, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateByNameWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplateByNameWithContext), varargs...)
+}
+
 // GetTemplateID mocks base method.
 func (m *MockTemplateServiceIface) GetTemplateID(name, templatefilter, zoneid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +345,27 @@ func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplateID(name, templatefilt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateID", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplateID), varargs...)
 }
 
+// GetTemplateIDWithContext mocks base method.
+func (m *MockTemplateServiceIface) GetTemplateIDWithContext(ctx context.Context, name, templatefilter, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, templatefilter, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTemplateIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTemplateIDWithContext indicates an expected call of GetTemplateIDWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplateIDWithContext(ctx, name, templatefilter, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, templatefilter, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplateIDWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplateIDWithContext), varargs...)
+}
+
 // GetTemplatePermissionByID mocks base method.
 func (m *MockTemplateServiceIface) GetTemplatePermissionByID(id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +387,27 @@ func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplatePermissionByID(id any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplatePermissionByID", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplatePermissionByID), varargs...)
 }
 
+// GetTemplatePermissionByIDWithContext mocks base method.
+func (m *MockTemplateServiceIface) GetTemplatePermissionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*TemplatePermission, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTemplatePermissionByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*TemplatePermission)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTemplatePermissionByIDWithContext indicates an expected call of GetTemplatePermissionByIDWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) GetTemplatePermissionByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTemplatePermissionByIDWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetTemplatePermissionByIDWithContext), varargs...)
+}
+
 // GetUploadParamsForTemplate mocks base method.
 func (m *MockTemplateServiceIface) GetUploadParamsForTemplate(p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -217,6 +423,21 @@ func (mr *MockTemplateServiceIfaceMockRecorder) GetUploadParamsForTemplate(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetUploadParamsForTemplate), p)
 }
 
+// GetUploadParamsForTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) GetUploadParamsForTemplateWithContext(ctx context.Context, p *GetUploadParamsForTemplateParams) (*GetUploadParamsForTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadParamsForTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetUploadParamsForTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadParamsForTemplateWithContext indicates an expected call of GetUploadParamsForTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) GetUploadParamsForTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).GetUploadParamsForTemplateWithContext), ctx, p)
+}
+
 // LinkUserDataToTemplate mocks base method.
 func (m *MockTemplateServiceIface) LinkUserDataToTemplate(p *LinkUserDataToTemplateParams) (*LinkUserDataToTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +453,21 @@ func (mr *MockTemplateServiceIfaceMockRecorder) LinkUserDataToTemplate(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkUserDataToTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).LinkUserDataToTemplate), p)
 }
 
+// LinkUserDataToTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) LinkUserDataToTemplateWithContext(ctx context.Context, p *LinkUserDataToTemplateParams) (*LinkUserDataToTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LinkUserDataToTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*LinkUserDataToTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LinkUserDataToTemplateWithContext indicates an expected call of LinkUserDataToTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) LinkUserDataToTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LinkUserDataToTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).LinkUserDataToTemplateWithContext), ctx, p)
+}
+
 // ListTemplatePermissions mocks base method.
 func (m *MockTemplateServiceIface) ListTemplatePermissions(p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +483,21 @@ func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatePermissions(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatePermissions", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatePermissions), p)
 }
 
+// ListTemplatePermissionsWithContext mocks base method.
+func (m *MockTemplateServiceIface) ListTemplatePermissionsWithContext(ctx context.Context, p *ListTemplatePermissionsParams) (*ListTemplatePermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplatePermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListTemplatePermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplatePermissionsWithContext indicates an expected call of ListTemplatePermissionsWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatePermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatePermissionsWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatePermissionsWithContext), ctx, p)
+}
+
 // ListTemplates mocks base method.
 func (m *MockTemplateServiceIface) ListTemplates(p *ListTemplatesParams) (*ListTemplatesResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +513,99 @@ func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplates(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplates", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplates), p)
 }
 
+// ListTemplatesAll mocks base method.
+func (m *MockTemplateServiceIface) ListTemplatesAll(p *ListTemplatesParams, opts ...PageOption) ([]*Template, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplatesAll", varargs...)
+	ret0, _ := ret[0].([]*Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplatesAll indicates an expected call of ListTemplatesAll.
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatesAll", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatesAll), varargs...)
+}
+
+// ListTemplatesAllWithContext mocks base method.
+func (m *MockTemplateServiceIface) ListTemplatesAllWithContext(ctx context.Context, p *ListTemplatesParams, opts ...PageOption) ([]*Template, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplatesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Template)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplatesAllWithContext indicates an expected call of ListTemplatesAllWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatesAllWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatesAllWithContext), varargs...)
+}
+
+// ListTemplatesIter mocks base method.
+func (m *MockTemplateServiceIface) ListTemplatesIter(p *ListTemplatesParams, opts ...PageOption) iter.Seq2[*Template, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplatesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Template, error])
+	return ret0
+}
+
+// ListTemplatesIter indicates an expected call of ListTemplatesIter.
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatesIter", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatesIter), varargs...)
+}
+
+// ListTemplatesIterWithContext mocks base method.
+func (m *MockTemplateServiceIface) ListTemplatesIterWithContext(ctx context.Context, p *ListTemplatesParams, opts ...PageOption) iter.Seq2[*Template, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTemplatesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Template, error])
+	return ret0
+}
+
+// ListTemplatesIterWithContext indicates an expected call of ListTemplatesIterWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatesIterWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatesIterWithContext), varargs...)
+}
+
+// ListTemplatesWithContext mocks base method.
+func (m *MockTemplateServiceIface) ListTemplatesWithContext(ctx context.Context, p *ListTemplatesParams) (*ListTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTemplatesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTemplatesWithContext indicates an expected call of ListTemplatesWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) ListTemplatesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTemplatesWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).ListTemplatesWithContext), ctx, p)
+}
+
 // NewCopyTemplateParams mocks base method.
 func (m *MockTemplateServiceIface) NewCopyTemplateParams(id string) *CopyTemplateParams {
 	m.ctrl.T.Helper()
@@ -319,7 +663,7 @@ func (mr *MockTemplateServiceIfaceMockRecorder) NewExtractTemplateParams(id, mod
 }
 
 // NewGetUploadParamsForTemplateParams mocks base method.
-func (m *MockTemplateServiceIface) NewGetUploadParamsForTemplateParams(displaytext, format, hypervisor, name, zoneid string) *GetUploadParamsForTemplateParams {
+func (m *MockTemplateServiceIface) NewGetUploadParamsForTemplateParams(displaytext, format string, hypervisor HypervisorType, name, zoneid string) *GetUploadParamsForTemplateParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewGetUploadParamsForTemplateParams", displaytext, format, hypervisor, name, zoneid)
 	ret0, _ := ret[0].(*GetUploadParamsForTemplateParams)
@@ -389,7 +733,7 @@ func (mr *MockTemplateServiceIfaceMockRecorder) NewPrepareTemplateParams(templat
 }
 
 // NewRegisterTemplateParams mocks base method.
-func (m *MockTemplateServiceIface) NewRegisterTemplateParams(displaytext, format, hypervisor, name, url string) *RegisterTemplateParams {
+func (m *MockTemplateServiceIface) NewRegisterTemplateParams(displaytext, format string, hypervisor HypervisorType, name, url string) *RegisterTemplateParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewRegisterTemplateParams", displaytext, format, hypervisor, name, url)
 	ret0, _ := ret[0].(*RegisterTemplateParams)
@@ -459,6 +803,21 @@ func (mr *MockTemplateServiceIfaceMockRecorder) PrepareTemplate(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).PrepareTemplate), p)
 }
 
+// PrepareTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) PrepareTemplateWithContext(ctx context.Context, p *PrepareTemplateParams) (*PrepareTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PrepareTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*PrepareTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PrepareTemplateWithContext indicates an expected call of PrepareTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) PrepareTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PrepareTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).PrepareTemplateWithContext), ctx, p)
+}
+
 // RegisterTemplate mocks base method.
 func (m *MockTemplateServiceIface) RegisterTemplate(p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -474,6 +833,21 @@ func (mr *MockTemplateServiceIfaceMockRecorder) RegisterTemplate(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).RegisterTemplate), p)
 }
 
+// RegisterTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) RegisterTemplateWithContext(ctx context.Context, p *RegisterTemplateParams) (*RegisterTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterTemplateWithContext indicates an expected call of RegisterTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) RegisterTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).RegisterTemplateWithContext), ctx, p)
+}
+
 // UpdateTemplate mocks base method.
 func (m *MockTemplateServiceIface) UpdateTemplate(p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -504,6 +878,36 @@ func (mr *MockTemplateServiceIfaceMockRecorder) UpdateTemplatePermissions(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplatePermissions", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpdateTemplatePermissions), p)
 }
 
+// UpdateTemplatePermissionsWithContext mocks base method.
+func (m *MockTemplateServiceIface) UpdateTemplatePermissionsWithContext(ctx context.Context, p *UpdateTemplatePermissionsParams) (*UpdateTemplatePermissionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplatePermissionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateTemplatePermissionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplatePermissionsWithContext indicates an expected call of UpdateTemplatePermissionsWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) UpdateTemplatePermissionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplatePermissionsWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpdateTemplatePermissionsWithContext), ctx, p)
+}
+
+// UpdateTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) UpdateTemplateWithContext(ctx context.Context, p *UpdateTemplateParams) (*UpdateTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTemplateWithContext indicates an expected call of UpdateTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) UpdateTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpdateTemplateWithContext), ctx, p)
+}
+
 // UpgradeRouterTemplate mocks base method.
 func (m *MockTemplateServiceIface) UpgradeRouterTemplate(p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -518,3 +922,18 @@ func (mr *MockTemplateServiceIfaceMockRecorder) UpgradeRouterTemplate(p any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeRouterTemplate", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpgradeRouterTemplate), p)
 }
+
+// UpgradeRouterTemplateWithContext mocks base method.
+func (m *MockTemplateServiceIface) UpgradeRouterTemplateWithContext(ctx context.Context, p *UpgradeRouterTemplateParams) (*UpgradeRouterTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpgradeRouterTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpgradeRouterTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpgradeRouterTemplateWithContext indicates an expected call of UpgradeRouterTemplateWithContext.
+func (mr *MockTemplateServiceIfaceMockRecorder) UpgradeRouterTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpgradeRouterTemplateWithContext", reflect.TypeOf((*MockTemplateServiceIface)(nil).UpgradeRouterTemplateWithContext), ctx, p)
+}
@@ -20,28 +20,51 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type UCSServiceIface interface {
 	AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error)
+	AddUcsManagerWithContext(ctx context.Context, p *AddUcsManagerParams) (*AddUcsManagerResponse, error)
 	NewAddUcsManagerParams(password string, url string, username string, zoneid string) *AddUcsManagerParams
 	AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error)
+	AssociateUcsProfileToBladeAsync(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*Job, error)
 	NewAssociateUcsProfileToBladeParams(bladeid string, profiledn string, ucsmanagerid string) *AssociateUcsProfileToBladeParams
 	DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error)
+	DeleteUcsManagerWithContext(ctx context.Context, p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error)
 	NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsManagerParams
 	ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	ListUcsBladesWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error)
+	ListUcsBladesAll(p *ListUcsBladesParams, opts ...PageOption) ([]*UcsBlade, error)
+	ListUcsBladesAllWithContext(ctx context.Context, p *ListUcsBladesParams, opts ...PageOption) ([]*UcsBlade, error)
+	ListUcsBladesIter(p *ListUcsBladesParams, opts ...PageOption) iter.Seq2[*UcsBlade, error]
+	ListUcsBladesIterWithContext(ctx context.Context, p *ListUcsBladesParams, opts ...PageOption) iter.Seq2[*UcsBlade, error]
 	NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesParams
 	ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	ListUcsManagersWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error)
+	ListUcsManagersAll(p *ListUcsManagersParams, opts ...PageOption) ([]*UcsManager, error)
+	ListUcsManagersAllWithContext(ctx context.Context, p *ListUcsManagersParams, opts ...PageOption) ([]*UcsManager, error)
+	ListUcsManagersIter(p *ListUcsManagersParams, opts ...PageOption) iter.Seq2[*UcsManager, error]
+	ListUcsManagersIterWithContext(ctx context.Context, p *ListUcsManagersParams, opts ...PageOption) iter.Seq2[*UcsManager, error]
 	NewListUcsManagersParams() *ListUcsManagersParams
 	GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetUcsManagerIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UcsManager, int, error)
 	GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error)
+	GetUcsManagerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UcsManager, int, error)
 	ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	ListUcsProfilesWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error)
+	ListUcsProfilesAll(p *ListUcsProfilesParams, opts ...PageOption) ([]*UcsProfile, error)
+	ListUcsProfilesAllWithContext(ctx context.Context, p *ListUcsProfilesParams, opts ...PageOption) ([]*UcsProfile, error)
+	ListUcsProfilesIter(p *ListUcsProfilesParams, opts ...PageOption) iter.Seq2[*UcsProfile, error]
+	ListUcsProfilesIterWithContext(ctx context.Context, p *ListUcsProfilesParams, opts ...PageOption) iter.Seq2[*UcsProfile, error]
 	NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfilesParams
 }
 
@@ -72,6 +95,48 @@ func (p *AddUcsManagerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addUcsManager API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddUcsManagerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addUcsManager", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addUcsManager API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddUcsManagerParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "url", "username", "zoneid"},
+		lengths:  map[string]int{"name": 255, "password": 255, "url": 2048, "username": 255, "zoneid": 255},
+	}.validate("addUcsManager", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addUcsManager API, before the request is signed
+func (p *AddUcsManagerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddUcsManagerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddUcsManagerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addUcsManager", b, &p.p, map[string]paramDecoder{
+		"name":     decodeParam[string],
+		"password": decodeParam[string],
+		"url":      decodeParam[string],
+		"username": decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddUcsManagerParams) Clone() *AddUcsManagerParams {
+	return &AddUcsManagerParams{p: cloneParams(p.p)}
+}
+
 func (p *AddUcsManagerParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -191,13 +256,22 @@ func (s *UCSService) NewAddUcsManagerParams(password string, url string, usernam
 
 // Adds a Ucs manager
 func (s *UCSService) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
-	resp, err := s.cs.newPostRequest("addUcsManager", p.toURLValues())
+	return s.AddUcsManagerWithContext(context.Background(), p)
+}
+
+// AddUcsManagerWithContext is like AddUcsManager, but honours the cancellation and deadline of ctx
+func (s *UCSService) AddUcsManagerWithContext(ctx context.Context, p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addUcsManager", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddUcsManagerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addUcsManager", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -205,12 +279,18 @@ func (s *UCSService) AddUcsManager(p *AddUcsManagerParams) (*AddUcsManagerRespon
 }
 
 type AddUcsManagerResponse struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Url       string `json:"url"`
-	Zoneid    string `json:"zoneid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Url       string                     `json:"url"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddUcsManagerResponse) UnmarshalJSON(b []byte) error {
+	type alias AddUcsManagerResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssociateUcsProfileToBladeParams struct {
@@ -234,6 +314,46 @@ func (p *AssociateUcsProfileToBladeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the associateUcsProfileToBlade API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssociateUcsProfileToBladeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("associateUcsProfileToBlade", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the associateUcsProfileToBlade API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssociateUcsProfileToBladeParams) Validate() error {
+	return paramRules{
+		required: []string{"bladeid", "profiledn", "ucsmanagerid"},
+		lengths:  map[string]int{"bladeid": 255, "profiledn": 255, "ucsmanagerid": 255},
+	}.validate("associateUcsProfileToBlade", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the associateUcsProfileToBlade API, before the request is signed
+func (p *AssociateUcsProfileToBladeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssociateUcsProfileToBladeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssociateUcsProfileToBladeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("associateUcsProfileToBlade", b, &p.p, map[string]paramDecoder{
+		"bladeid":      decodeParam[string],
+		"profiledn":    decodeParam[string],
+		"ucsmanagerid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssociateUcsProfileToBladeParams) Clone() *AssociateUcsProfileToBladeParams {
+	return &AssociateUcsProfileToBladeParams{p: cloneParams(p.p)}
+}
+
 func (p *AssociateUcsProfileToBladeParams) SetBladeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -310,32 +430,39 @@ func (s *UCSService) NewAssociateUcsProfileToBladeParams(bladeid string, profile
 
 // Associate a profile to a blade
 func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
-	resp, err := s.cs.newPostRequest("associateUcsProfileToBlade", p.toURLValues())
+	return s.AssociateUcsProfileToBladeWithContext(context.Background(), p)
+}
+
+// AssociateUcsProfileToBladeWithContext is like AssociateUcsProfileToBlade, but honours the cancellation and deadline of ctx
+func (s *UCSService) AssociateUcsProfileToBladeWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "associateUcsProfileToBlade", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssociateUcsProfileToBladeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("associateUcsProfileToBlade", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "associateUcsProfileToBlade", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("associateUcsProfileToBlade", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -343,14 +470,39 @@ func (s *UCSService) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladePar
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AssociateUcsProfileToBlade async job into r
+func (r *AssociateUcsProfileToBladeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AssociateUcsProfileToBladeAsync starts the async job of AssociateUcsProfileToBlade without waiting for it to finish, and returns a handle to the job
+func (s *UCSService) AssociateUcsProfileToBladeAsync(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*Job, error) {
+	r, err := s.AssociateUcsProfileToBladeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("associateUcsProfileToBlade", r.JobID), nil
+}
+
 type AssociateUcsProfileToBladeResponse struct {
-	Bladedn      string `json:"bladedn"`
-	Hostid       string `json:"hostid"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Profiledn    string `json:"profiledn"`
-	Ucsmanagerid string `json:"ucsmanagerid"`
+	Bladedn      string                     `json:"bladedn"`
+	Hostid       string                     `json:"hostid"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Profiledn    string                     `json:"profiledn"`
+	Ucsmanagerid string                     `json:"ucsmanagerid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssociateUcsProfileToBladeResponse) UnmarshalJSON(b []byte) error {
+	type alias AssociateUcsProfileToBladeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteUcsManagerParams struct {
@@ -368,6 +520,44 @@ func (p *DeleteUcsManagerParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteUcsManager API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteUcsManagerParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteUcsManager", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteUcsManager API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteUcsManagerParams) Validate() error {
+	return paramRules{
+		required: []string{"ucsmanagerid"},
+		lengths:  map[string]int{"ucsmanagerid": 255},
+	}.validate("deleteUcsManager", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteUcsManager API, before the request is signed
+func (p *DeleteUcsManagerParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteUcsManagerParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteUcsManagerParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteUcsManager", b, &p.p, map[string]paramDecoder{
+		"ucsmanagerid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteUcsManagerParams) Clone() *DeleteUcsManagerParams {
+	return &DeleteUcsManagerParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteUcsManagerParams) SetUcsmanagerid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -400,13 +590,22 @@ func (s *UCSService) NewDeleteUcsManagerParams(ucsmanagerid string) *DeleteUcsMa
 
 // Delete a Ucs manager
 func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteUcsManager", p.toURLValues())
+	return s.DeleteUcsManagerWithContext(context.Background(), p)
+}
+
+// DeleteUcsManagerWithContext is like DeleteUcsManager, but honours the cancellation and deadline of ctx
+func (s *UCSService) DeleteUcsManagerWithContext(ctx context.Context, p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteUcsManager", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteUcsManagerResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteUcsManager", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -414,10 +613,11 @@ func (s *UCSService) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsMana
 }
 
 type DeleteUcsManagerResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteUcsManagerResponse) UnmarshalJSON(b []byte) error {
@@ -444,7 +644,7 @@ func (r *DeleteUcsManagerResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteUcsManagerResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUcsBladesParams struct {
@@ -473,6 +673,47 @@ func (p *ListUcsBladesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUcsBlades API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUcsBladesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUcsBlades", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUcsBlades API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUcsBladesParams) Validate() error {
+	return paramRules{
+		required: []string{"ucsmanagerid"},
+		lengths:  map[string]int{"keyword": 255, "ucsmanagerid": 255},
+	}.validate("listUcsBlades", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUcsBlades API, before the request is signed
+func (p *ListUcsBladesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUcsBladesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUcsBladesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUcsBlades", b, &p.p, map[string]paramDecoder{
+		"keyword":      decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"ucsmanagerid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUcsBladesParams) Clone() *ListUcsBladesParams {
+	return &ListUcsBladesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUcsBladesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -568,32 +809,75 @@ func (s *UCSService) NewListUcsBladesParams(ucsmanagerid string) *ListUcsBladesP
 
 // List ucs blades
 func (s *UCSService) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsBlades", p.toURLValues())
+	return s.ListUcsBladesWithContext(context.Background(), p)
+}
+
+// ListUcsBladesWithContext is like ListUcsBlades, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsBladesWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUcsBlades", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUcsBladesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUcsBlades", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListUcsBladesAll returns all UcsBlades matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UCSService) ListUcsBladesAll(p *ListUcsBladesParams, opts ...PageOption) ([]*UcsBlade, error) {
+	return s.ListUcsBladesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListUcsBladesAllWithContext is like ListUcsBladesAll, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsBladesAllWithContext(ctx context.Context, p *ListUcsBladesParams, opts ...PageOption) ([]*UcsBlade, error) {
+	return collectPages(s.ListUcsBladesIterWithContext(ctx, p, opts...))
+}
+
+// ListUcsBladesIter returns an iterator over all UcsBlades matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UCSService) ListUcsBladesIter(p *ListUcsBladesParams, opts ...PageOption) iter.Seq2[*UcsBlade, error] {
+	return s.ListUcsBladesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListUcsBladesIterWithContext is like ListUcsBladesIter, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsBladesIterWithContext(ctx context.Context, p *ListUcsBladesParams, opts ...PageOption) iter.Seq2[*UcsBlade, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*UcsBlade, int, error) {
+		l, err := s.ListUcsBladesWithContext(ctx, &ListUcsBladesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.UcsBlades, l.Count, nil
+	}, opts...)
+}
+
 type ListUcsBladesResponse struct {
 	Count     int         `json:"count"`
 	UcsBlades []*UcsBlade `json:"ucsblade"`
 }
 
 type UcsBlade struct {
-	Bladedn      string `json:"bladedn"`
-	Hostid       string `json:"hostid"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Profiledn    string `json:"profiledn"`
-	Ucsmanagerid string `json:"ucsmanagerid"`
+	Bladedn      string                     `json:"bladedn"`
+	Hostid       string                     `json:"hostid"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Profiledn    string                     `json:"profiledn"`
+	Ucsmanagerid string                     `json:"ucsmanagerid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UcsBlade) UnmarshalJSON(b []byte) error {
+	type alias UcsBlade
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUcsManagersParams struct {
@@ -625,6 +909,47 @@ func (p *ListUcsManagersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUcsManagers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUcsManagersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUcsManagers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUcsManagers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUcsManagersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "keyword": 255, "zoneid": 255},
+	}.validate("listUcsManagers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUcsManagers API, before the request is signed
+func (p *ListUcsManagersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUcsManagersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUcsManagersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUcsManagers", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUcsManagersParams) Clone() *ListUcsManagersParams {
+	return &ListUcsManagersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUcsManagersParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -740,6 +1065,11 @@ func (s *UCSService) NewListUcsManagersParams() *ListUcsManagersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetUcsManagerIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetUcsManagerIDWithContext is like GetUcsManagerID, but honours the cancellation and deadline of ctx
+func (s *UCSService) GetUcsManagerIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListUcsManagersParams{}
 	p.p = make(map[string]interface{})
 
@@ -751,7 +1081,7 @@ func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string
 		}
 	}
 
-	l, err := s.ListUcsManagers(p)
+	l, err := s.ListUcsManagersWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -776,12 +1106,17 @@ func (s *UCSService) GetUcsManagerID(keyword string, opts ...OptionFunc) (string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error) {
-	id, count, err := s.GetUcsManagerID(name, opts...)
+	return s.GetUcsManagerByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetUcsManagerByNameWithContext is like GetUcsManagerByName, but honours the cancellation and deadline of ctx
+func (s *UCSService) GetUcsManagerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UcsManager, int, error) {
+	id, count, err := s.GetUcsManagerIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetUcsManagerByID(id, opts...)
+	r, count, err := s.GetUcsManagerByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -790,6 +1125,11 @@ func (s *UCSService) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsM
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error) {
+	return s.GetUcsManagerByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetUcsManagerByIDWithContext is like GetUcsManagerByID, but honours the cancellation and deadline of ctx
+func (s *UCSService) GetUcsManagerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UcsManager, int, error) {
 	p := &ListUcsManagersParams{}
 	p.p = make(map[string]interface{})
 
@@ -801,11 +1141,10 @@ func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManag
 		}
 	}
 
-	l, err := s.ListUcsManagers(p)
+	l, err := s.ListUcsManagersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -823,31 +1162,74 @@ func (s *UCSService) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManag
 
 // List ucs manager
 func (s *UCSService) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
-	resp, err := s.cs.newRequest("listUcsManagers", p.toURLValues())
+	return s.ListUcsManagersWithContext(context.Background(), p)
+}
+
+// ListUcsManagersWithContext is like ListUcsManagers, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsManagersWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUcsManagers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUcsManagersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUcsManagers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListUcsManagersAll returns all UcsManagers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UCSService) ListUcsManagersAll(p *ListUcsManagersParams, opts ...PageOption) ([]*UcsManager, error) {
+	return s.ListUcsManagersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListUcsManagersAllWithContext is like ListUcsManagersAll, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsManagersAllWithContext(ctx context.Context, p *ListUcsManagersParams, opts ...PageOption) ([]*UcsManager, error) {
+	return collectPages(s.ListUcsManagersIterWithContext(ctx, p, opts...))
+}
+
+// ListUcsManagersIter returns an iterator over all UcsManagers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UCSService) ListUcsManagersIter(p *ListUcsManagersParams, opts ...PageOption) iter.Seq2[*UcsManager, error] {
+	return s.ListUcsManagersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListUcsManagersIterWithContext is like ListUcsManagersIter, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsManagersIterWithContext(ctx context.Context, p *ListUcsManagersParams, opts ...PageOption) iter.Seq2[*UcsManager, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*UcsManager, int, error) {
+		l, err := s.ListUcsManagersWithContext(ctx, &ListUcsManagersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.UcsManagers, l.Count, nil
+	}, opts...)
+}
+
 type ListUcsManagersResponse struct {
 	Count       int           `json:"count"`
 	UcsManagers []*UcsManager `json:"ucsmanager"`
 }
 
 type UcsManager struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Url       string `json:"url"`
-	Zoneid    string `json:"zoneid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Url       string                     `json:"url"`
+	Zoneid    string                     `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UcsManager) UnmarshalJSON(b []byte) error {
+	type alias UcsManager
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUcsProfilesParams struct {
@@ -876,6 +1258,47 @@ func (p *ListUcsProfilesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUcsProfiles API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUcsProfilesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUcsProfiles", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUcsProfiles API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUcsProfilesParams) Validate() error {
+	return paramRules{
+		required: []string{"ucsmanagerid"},
+		lengths:  map[string]int{"keyword": 255, "ucsmanagerid": 255},
+	}.validate("listUcsProfiles", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUcsProfiles API, before the request is signed
+func (p *ListUcsProfilesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUcsProfilesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUcsProfilesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUcsProfiles", b, &p.p, map[string]paramDecoder{
+		"keyword":      decodeParam[string],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"ucsmanagerid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUcsProfilesParams) Clone() *ListUcsProfilesParams {
+	return &ListUcsProfilesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUcsProfilesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -971,26 +1394,69 @@ func (s *UCSService) NewListUcsProfilesParams(ucsmanagerid string) *ListUcsProfi
 
 // List profile in ucs manager
 func (s *UCSService) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
-	resp, err := s.cs.newRequest("listUcsProfiles", p.toURLValues())
+	return s.ListUcsProfilesWithContext(context.Background(), p)
+}
+
+// ListUcsProfilesWithContext is like ListUcsProfiles, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsProfilesWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUcsProfiles", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUcsProfilesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUcsProfiles", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListUcsProfilesAll returns all UcsProfiles matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UCSService) ListUcsProfilesAll(p *ListUcsProfilesParams, opts ...PageOption) ([]*UcsProfile, error) {
+	return s.ListUcsProfilesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListUcsProfilesAllWithContext is like ListUcsProfilesAll, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsProfilesAllWithContext(ctx context.Context, p *ListUcsProfilesParams, opts ...PageOption) ([]*UcsProfile, error) {
+	return collectPages(s.ListUcsProfilesIterWithContext(ctx, p, opts...))
+}
+
+// ListUcsProfilesIter returns an iterator over all UcsProfiles matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UCSService) ListUcsProfilesIter(p *ListUcsProfilesParams, opts ...PageOption) iter.Seq2[*UcsProfile, error] {
+	return s.ListUcsProfilesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListUcsProfilesIterWithContext is like ListUcsProfilesIter, but honours the cancellation and deadline of ctx
+func (s *UCSService) ListUcsProfilesIterWithContext(ctx context.Context, p *ListUcsProfilesParams, opts ...PageOption) iter.Seq2[*UcsProfile, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*UcsProfile, int, error) {
+		l, err := s.ListUcsProfilesWithContext(ctx, &ListUcsProfilesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.UcsProfiles, l.Count, nil
+	}, opts...)
+}
+
 type ListUcsProfilesResponse struct {
 	Count       int           `json:"count"`
 	UcsProfiles []*UcsProfile `json:"ucsprofile"`
 }
 
 type UcsProfile struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Ucsdn     string `json:"ucsdn"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Ucsdn     string                     `json:"ucsdn"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UcsProfile) UnmarshalJSON(b []byte) error {
+	type alias UcsProfile
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
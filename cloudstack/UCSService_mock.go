@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockUCSServiceIfaceMockRecorder) AddUcsManager(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUcsManager", reflect.TypeOf((*MockUCSServiceIface)(nil).AddUcsManager), p)
 }
 
+// AddUcsManagerWithContext mocks base method.
+func (m *MockUCSServiceIface) AddUcsManagerWithContext(ctx context.Context, p *AddUcsManagerParams) (*AddUcsManagerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddUcsManagerWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddUcsManagerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddUcsManagerWithContext indicates an expected call of AddUcsManagerWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) AddUcsManagerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddUcsManagerWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).AddUcsManagerWithContext), ctx, p)
+}
+
 // AssociateUcsProfileToBlade mocks base method.
 func (m *MockUCSServiceIface) AssociateUcsProfileToBlade(p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockUCSServiceIfaceMockRecorder) AssociateUcsProfileToBlade(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateUcsProfileToBlade", reflect.TypeOf((*MockUCSServiceIface)(nil).AssociateUcsProfileToBlade), p)
 }
 
+// AssociateUcsProfileToBladeAsync mocks base method.
+func (m *MockUCSServiceIface) AssociateUcsProfileToBladeAsync(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateUcsProfileToBladeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateUcsProfileToBladeAsync indicates an expected call of AssociateUcsProfileToBladeAsync.
+func (mr *MockUCSServiceIfaceMockRecorder) AssociateUcsProfileToBladeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateUcsProfileToBladeAsync", reflect.TypeOf((*MockUCSServiceIface)(nil).AssociateUcsProfileToBladeAsync), ctx, p)
+}
+
+// AssociateUcsProfileToBladeWithContext mocks base method.
+func (m *MockUCSServiceIface) AssociateUcsProfileToBladeWithContext(ctx context.Context, p *AssociateUcsProfileToBladeParams) (*AssociateUcsProfileToBladeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateUcsProfileToBladeWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssociateUcsProfileToBladeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateUcsProfileToBladeWithContext indicates an expected call of AssociateUcsProfileToBladeWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) AssociateUcsProfileToBladeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateUcsProfileToBladeWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).AssociateUcsProfileToBladeWithContext), ctx, p)
+}
+
 // DeleteUcsManager mocks base method.
 func (m *MockUCSServiceIface) DeleteUcsManager(p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockUCSServiceIfaceMockRecorder) DeleteUcsManager(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUcsManager", reflect.TypeOf((*MockUCSServiceIface)(nil).DeleteUcsManager), p)
 }
 
+// DeleteUcsManagerWithContext mocks base method.
+func (m *MockUCSServiceIface) DeleteUcsManagerWithContext(ctx context.Context, p *DeleteUcsManagerParams) (*DeleteUcsManagerResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUcsManagerWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteUcsManagerResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUcsManagerWithContext indicates an expected call of DeleteUcsManagerWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) DeleteUcsManagerWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUcsManagerWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).DeleteUcsManagerWithContext), ctx, p)
+}
+
 // GetUcsManagerByID mocks base method.
 func (m *MockUCSServiceIface) GetUcsManagerByID(id string, opts ...OptionFunc) (*UcsManager, int, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +186,27 @@ func (mr *MockUCSServiceIfaceMockRecorder) GetUcsManagerByID(id any, opts ...any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUcsManagerByID", reflect.TypeOf((*MockUCSServiceIface)(nil).GetUcsManagerByID), varargs...)
 }
 
+// GetUcsManagerByIDWithContext mocks base method.
+func (m *MockUCSServiceIface) GetUcsManagerByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UcsManager, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUcsManagerByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*UcsManager)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUcsManagerByIDWithContext indicates an expected call of GetUcsManagerByIDWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) GetUcsManagerByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUcsManagerByIDWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).GetUcsManagerByIDWithContext), varargs...)
+}
+
 // GetUcsManagerByName mocks base method.
 func (m *MockUCSServiceIface) GetUcsManagerByName(name string, opts ...OptionFunc) (*UcsManager, int, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +228,27 @@ func (mr *MockUCSServiceIfaceMockRecorder) GetUcsManagerByName(name any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUcsManagerByName", reflect.TypeOf((*MockUCSServiceIface)(nil).GetUcsManagerByName), varargs...)
 }
 
+// GetUcsManagerByNameWithContext mocks base method.
+func (m *MockUCSServiceIface) GetUcsManagerByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UcsManager, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUcsManagerByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*UcsManager)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUcsManagerByNameWithContext indicates an expected call of GetUcsManagerByNameWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) GetUcsManagerByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUcsManagerByNameWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).GetUcsManagerByNameWithContext), varargs...)
+}
+
 // GetUcsManagerID mocks base method.
 func (m *MockUCSServiceIface) GetUcsManagerID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +270,27 @@ func (mr *MockUCSServiceIfaceMockRecorder) GetUcsManagerID(keyword any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUcsManagerID", reflect.TypeOf((*MockUCSServiceIface)(nil).GetUcsManagerID), varargs...)
 }
 
+// GetUcsManagerIDWithContext mocks base method.
+func (m *MockUCSServiceIface) GetUcsManagerIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUcsManagerIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUcsManagerIDWithContext indicates an expected call of GetUcsManagerIDWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) GetUcsManagerIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUcsManagerIDWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).GetUcsManagerIDWithContext), varargs...)
+}
+
 // ListUcsBlades mocks base method.
 func (m *MockUCSServiceIface) ListUcsBlades(p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +306,99 @@ func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBlades(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBlades", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBlades), p)
 }
 
+// ListUcsBladesAll mocks base method.
+func (m *MockUCSServiceIface) ListUcsBladesAll(p *ListUcsBladesParams, opts ...PageOption) ([]*UcsBlade, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsBladesAll", varargs...)
+	ret0, _ := ret[0].([]*UcsBlade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsBladesAll indicates an expected call of ListUcsBladesAll.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBladesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBladesAll", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBladesAll), varargs...)
+}
+
+// ListUcsBladesAllWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsBladesAllWithContext(ctx context.Context, p *ListUcsBladesParams, opts ...PageOption) ([]*UcsBlade, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsBladesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*UcsBlade)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsBladesAllWithContext indicates an expected call of ListUcsBladesAllWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBladesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBladesAllWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBladesAllWithContext), varargs...)
+}
+
+// ListUcsBladesIter mocks base method.
+func (m *MockUCSServiceIface) ListUcsBladesIter(p *ListUcsBladesParams, opts ...PageOption) iter.Seq2[*UcsBlade, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsBladesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UcsBlade, error])
+	return ret0
+}
+
+// ListUcsBladesIter indicates an expected call of ListUcsBladesIter.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBladesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBladesIter", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBladesIter), varargs...)
+}
+
+// ListUcsBladesIterWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsBladesIterWithContext(ctx context.Context, p *ListUcsBladesParams, opts ...PageOption) iter.Seq2[*UcsBlade, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsBladesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UcsBlade, error])
+	return ret0
+}
+
+// ListUcsBladesIterWithContext indicates an expected call of ListUcsBladesIterWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBladesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBladesIterWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBladesIterWithContext), varargs...)
+}
+
+// ListUcsBladesWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsBladesWithContext(ctx context.Context, p *ListUcsBladesParams) (*ListUcsBladesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUcsBladesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUcsBladesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsBladesWithContext indicates an expected call of ListUcsBladesWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsBladesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsBladesWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsBladesWithContext), ctx, p)
+}
+
 // ListUcsManagers mocks base method.
 func (m *MockUCSServiceIface) ListUcsManagers(p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
 	m.ctrl.T.Helper()
@@ -196,6 +414,99 @@ func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagers(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagers", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagers), p)
 }
 
+// ListUcsManagersAll mocks base method.
+func (m *MockUCSServiceIface) ListUcsManagersAll(p *ListUcsManagersParams, opts ...PageOption) ([]*UcsManager, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsManagersAll", varargs...)
+	ret0, _ := ret[0].([]*UcsManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsManagersAll indicates an expected call of ListUcsManagersAll.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagersAll", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagersAll), varargs...)
+}
+
+// ListUcsManagersAllWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsManagersAllWithContext(ctx context.Context, p *ListUcsManagersParams, opts ...PageOption) ([]*UcsManager, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsManagersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*UcsManager)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsManagersAllWithContext indicates an expected call of ListUcsManagersAllWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagersAllWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagersAllWithContext), varargs...)
+}
+
+// ListUcsManagersIter mocks base method.
+func (m *MockUCSServiceIface) ListUcsManagersIter(p *ListUcsManagersParams, opts ...PageOption) iter.Seq2[*UcsManager, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsManagersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UcsManager, error])
+	return ret0
+}
+
+// ListUcsManagersIter indicates an expected call of ListUcsManagersIter.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagersIter", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagersIter), varargs...)
+}
+
+// ListUcsManagersIterWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsManagersIterWithContext(ctx context.Context, p *ListUcsManagersParams, opts ...PageOption) iter.Seq2[*UcsManager, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsManagersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UcsManager, error])
+	return ret0
+}
+
+// ListUcsManagersIterWithContext indicates an expected call of ListUcsManagersIterWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagersIterWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagersIterWithContext), varargs...)
+}
+
+// ListUcsManagersWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsManagersWithContext(ctx context.Context, p *ListUcsManagersParams) (*ListUcsManagersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUcsManagersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUcsManagersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsManagersWithContext indicates an expected call of ListUcsManagersWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsManagersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsManagersWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsManagersWithContext), ctx, p)
+}
+
 // ListUcsProfiles mocks base method.
 func (m *MockUCSServiceIface) ListUcsProfiles(p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +522,99 @@ func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfiles(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfiles", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfiles), p)
 }
 
+// ListUcsProfilesAll mocks base method.
+func (m *MockUCSServiceIface) ListUcsProfilesAll(p *ListUcsProfilesParams, opts ...PageOption) ([]*UcsProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsProfilesAll", varargs...)
+	ret0, _ := ret[0].([]*UcsProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsProfilesAll indicates an expected call of ListUcsProfilesAll.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfilesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfilesAll", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfilesAll), varargs...)
+}
+
+// ListUcsProfilesAllWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsProfilesAllWithContext(ctx context.Context, p *ListUcsProfilesParams, opts ...PageOption) ([]*UcsProfile, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsProfilesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*UcsProfile)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsProfilesAllWithContext indicates an expected call of ListUcsProfilesAllWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfilesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfilesAllWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfilesAllWithContext), varargs...)
+}
+
+// ListUcsProfilesIter mocks base method.
+func (m *MockUCSServiceIface) ListUcsProfilesIter(p *ListUcsProfilesParams, opts ...PageOption) iter.Seq2[*UcsProfile, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsProfilesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UcsProfile, error])
+	return ret0
+}
+
+// ListUcsProfilesIter indicates an expected call of ListUcsProfilesIter.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfilesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfilesIter", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfilesIter), varargs...)
+}
+
+// ListUcsProfilesIterWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsProfilesIterWithContext(ctx context.Context, p *ListUcsProfilesParams, opts ...PageOption) iter.Seq2[*UcsProfile, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUcsProfilesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UcsProfile, error])
+	return ret0
+}
+
+// ListUcsProfilesIterWithContext indicates an expected call of ListUcsProfilesIterWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfilesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfilesIterWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfilesIterWithContext), varargs...)
+}
+
+// ListUcsProfilesWithContext mocks base method.
+func (m *MockUCSServiceIface) ListUcsProfilesWithContext(ctx context.Context, p *ListUcsProfilesParams) (*ListUcsProfilesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUcsProfilesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUcsProfilesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUcsProfilesWithContext indicates an expected call of ListUcsProfilesWithContext.
+func (mr *MockUCSServiceIfaceMockRecorder) ListUcsProfilesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUcsProfilesWithContext", reflect.TypeOf((*MockUCSServiceIface)(nil).ListUcsProfilesWithContext), ctx, p)
+}
+
 // NewAddUcsManagerParams mocks base method.
 func (m *MockUCSServiceIface) NewAddUcsManagerParams(password, url, username, zoneid string) *AddUcsManagerParams {
 	m.ctrl.T.Helper()
@@ -20,39 +20,74 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 )
 
 type UsageServiceIface interface {
 	AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error)
+	AddTrafficMonitorWithContext(ctx context.Context, p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error)
 	NewAddTrafficMonitorParams(url string, zoneid string) *AddTrafficMonitorParams
 	AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error)
+	AddTrafficTypeAsync(ctx context.Context, p *AddTrafficTypeParams) (*Job, error)
 	NewAddTrafficTypeParams(physicalnetworkid string, traffictype string) *AddTrafficTypeParams
 	DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error)
+	DeleteTrafficMonitorWithContext(ctx context.Context, p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error)
 	NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMonitorParams
 	DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error)
+	DeleteTrafficTypeAsync(ctx context.Context, p *DeleteTrafficTypeParams) (*Job, error)
 	NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeParams
 	GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error)
+	GenerateUsageRecordsWithContext(ctx context.Context, p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error)
 	NewGenerateUsageRecordsParams() *GenerateUsageRecordsParams
 	ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error)
+	ListTrafficMonitorsAll(p *ListTrafficMonitorsParams, opts ...PageOption) ([]*TrafficMonitor, error)
+	ListTrafficMonitorsAllWithContext(ctx context.Context, p *ListTrafficMonitorsParams, opts ...PageOption) ([]*TrafficMonitor, error)
+	ListTrafficMonitorsIter(p *ListTrafficMonitorsParams, opts ...PageOption) iter.Seq2[*TrafficMonitor, error]
+	ListTrafficMonitorsIterWithContext(ctx context.Context, p *ListTrafficMonitorsParams, opts ...PageOption) iter.Seq2[*TrafficMonitor, error]
 	NewListTrafficMonitorsParams(zoneid string) *ListTrafficMonitorsParams
 	ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error)
+	ListTrafficTypeImplementorsAll(p *ListTrafficTypeImplementorsParams, opts ...PageOption) ([]*TrafficTypeImplementor, error)
+	ListTrafficTypeImplementorsAllWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams, opts ...PageOption) ([]*TrafficTypeImplementor, error)
+	ListTrafficTypeImplementorsIter(p *ListTrafficTypeImplementorsParams, opts ...PageOption) iter.Seq2[*TrafficTypeImplementor, error]
+	ListTrafficTypeImplementorsIterWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams, opts ...PageOption) iter.Seq2[*TrafficTypeImplementor, error]
 	NewListTrafficTypeImplementorsParams() *ListTrafficTypeImplementorsParams
 	ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error)
+	ListTrafficTypesAll(p *ListTrafficTypesParams, opts ...PageOption) ([]*TrafficType, error)
+	ListTrafficTypesAllWithContext(ctx context.Context, p *ListTrafficTypesParams, opts ...PageOption) ([]*TrafficType, error)
+	ListTrafficTypesIter(p *ListTrafficTypesParams, opts ...PageOption) iter.Seq2[*TrafficType, error]
+	ListTrafficTypesIterWithContext(ctx context.Context, p *ListTrafficTypesParams, opts ...PageOption) iter.Seq2[*TrafficType, error]
 	NewListTrafficTypesParams(physicalnetworkid string) *ListTrafficTypesParams
 	GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
+	GetTrafficTypeIDWithContext(ctx context.Context, keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error)
 	ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	ListUsageRecordsWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error)
+	ListUsageRecordsAll(p *ListUsageRecordsParams, opts ...PageOption) ([]*UsageRecord, error)
+	ListUsageRecordsAllWithContext(ctx context.Context, p *ListUsageRecordsParams, opts ...PageOption) ([]*UsageRecord, error)
+	ListUsageRecordsIter(p *ListUsageRecordsParams, opts ...PageOption) iter.Seq2[*UsageRecord, error]
+	ListUsageRecordsIterWithContext(ctx context.Context, p *ListUsageRecordsParams, opts ...PageOption) iter.Seq2[*UsageRecord, error]
 	NewListUsageRecordsParams(enddate string, startdate string) *ListUsageRecordsParams
 	ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
+	ListUsageTypesWithContext(ctx context.Context, p *ListUsageTypesParams) (*ListUsageTypesResponse, error)
 	NewListUsageTypesParams() *ListUsageTypesParams
 	RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error)
+	RemoveRawUsageRecordsWithContext(ctx context.Context, p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error)
 	NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUsageRecordsParams
 	UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeWithContext(ctx context.Context, p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error)
+	UpdateTrafficTypeAsync(ctx context.Context, p *UpdateTrafficTypeParams) (*Job, error)
 	NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeParams
 	ListUsageServerMetrics(p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error)
+	ListUsageServerMetricsWithContext(ctx context.Context, p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error)
 	NewListUsageServerMetricsParams() *ListUsageServerMetricsParams
 }
 
@@ -80,6 +115,47 @@ func (p *AddTrafficMonitorParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addTrafficMonitor API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddTrafficMonitorParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addTrafficMonitor", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addTrafficMonitor API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddTrafficMonitorParams) Validate() error {
+	return paramRules{
+		required: []string{"url", "zoneid"},
+		lengths:  map[string]int{"excludezones": 255, "includezones": 255, "url": 2048, "zoneid": 255},
+	}.validate("addTrafficMonitor", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addTrafficMonitor API, before the request is signed
+func (p *AddTrafficMonitorParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddTrafficMonitorParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddTrafficMonitorParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addTrafficMonitor", b, &p.p, map[string]paramDecoder{
+		"excludezones": decodeParam[string],
+		"includezones": decodeParam[string],
+		"url":          decodeParam[string],
+		"zoneid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddTrafficMonitorParams) Clone() *AddTrafficMonitorParams {
+	return &AddTrafficMonitorParams{p: cloneParams(p.p)}
+}
+
 func (p *AddTrafficMonitorParams) SetExcludezones(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -176,13 +252,22 @@ func (s *UsageService) NewAddTrafficMonitorParams(url string, zoneid string) *Ad
 
 // Adds Traffic Monitor Host for Direct Network Usage
 func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
-	resp, err := s.cs.newPostRequest("addTrafficMonitor", p.toURLValues())
+	return s.AddTrafficMonitorWithContext(context.Background(), p)
+}
+
+// AddTrafficMonitorWithContext is like AddTrafficMonitor, but honours the cancellation and deadline of ctx
+func (s *UsageService) AddTrafficMonitorWithContext(ctx context.Context, p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addTrafficMonitor", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddTrafficMonitorResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addTrafficMonitor", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -190,13 +275,19 @@ func (s *UsageService) AddTrafficMonitor(p *AddTrafficMonitorParams) (*AddTraffi
 }
 
 type AddTrafficMonitorResponse struct {
-	Id         string `json:"id"`
-	Ipaddress  string `json:"ipaddress"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Numretries string `json:"numretries"`
-	Timeout    string `json:"timeout"`
-	Zoneid     string `json:"zoneid"`
+	Id         string                     `json:"id"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Numretries string                     `json:"numretries"`
+	Timeout    string                     `json:"timeout"`
+	Zoneid     string                     `json:"zoneid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddTrafficMonitorResponse) UnmarshalJSON(b []byte) error {
+	type alias AddTrafficMonitorResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddTrafficTypeParams struct {
@@ -238,6 +329,52 @@ func (p *AddTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addTrafficType API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddTrafficTypeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addTrafficType", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addTrafficType API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddTrafficTypeParams) Validate() error {
+	return paramRules{
+		required: []string{"physicalnetworkid", "traffictype"},
+		lengths:  map[string]int{"hypervnetworklabel": 255, "isolationmethod": 255, "kvmnetworklabel": 255, "ovm3networklabel": 255, "physicalnetworkid": 255, "traffictype": 255, "vlan": 255, "vmwarenetworklabel": 255, "xennetworklabel": 255},
+	}.validate("addTrafficType", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addTrafficType API, before the request is signed
+func (p *AddTrafficTypeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addTrafficType", b, &p.p, map[string]paramDecoder{
+		"hypervnetworklabel": decodeParam[string],
+		"isolationmethod":    decodeParam[string],
+		"kvmnetworklabel":    decodeParam[string],
+		"ovm3networklabel":   decodeParam[string],
+		"physicalnetworkid":  decodeParam[string],
+		"traffictype":        decodeParam[string],
+		"vlan":               decodeParam[string],
+		"vmwarenetworklabel": decodeParam[string],
+		"xennetworklabel":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddTrafficTypeParams) Clone() *AddTrafficTypeParams {
+	return &AddTrafficTypeParams{p: cloneParams(p.p)}
+}
+
 func (p *AddTrafficTypeParams) SetHypervnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -439,32 +576,39 @@ func (s *UsageService) NewAddTrafficTypeParams(physicalnetworkid string, traffic
 
 // Adds traffic type to a physical network
 func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
-	resp, err := s.cs.newPostRequest("addTrafficType", p.toURLValues())
+	return s.AddTrafficTypeWithContext(context.Background(), p)
+}
+
+// AddTrafficTypeWithContext is like AddTrafficType, but honours the cancellation and deadline of ctx
+func (s *UsageService) AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addTrafficType", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddTrafficTypeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addTrafficType", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addTrafficType", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addTrafficType", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -472,17 +616,42 @@ func (s *UsageService) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeR
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddTrafficType async job into r
+func (r *AddTrafficTypeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddTrafficTypeAsync starts the async job of AddTrafficType without waiting for it to finish, and returns a handle to the job
+func (s *UsageService) AddTrafficTypeAsync(ctx context.Context, p *AddTrafficTypeParams) (*Job, error) {
+	r, err := s.AddTrafficTypeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addTrafficType", r.JobID), nil
+}
+
 type AddTrafficTypeResponse struct {
-	Hypervnetworklabel string `json:"hypervnetworklabel"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Kvmnetworklabel    string `json:"kvmnetworklabel"`
-	Ovm3networklabel   string `json:"ovm3networklabel"`
-	Physicalnetworkid  string `json:"physicalnetworkid"`
-	Traffictype        string `json:"traffictype"`
-	Vmwarenetworklabel string `json:"vmwarenetworklabel"`
-	Xennetworklabel    string `json:"xennetworklabel"`
+	Hypervnetworklabel string                     `json:"hypervnetworklabel"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Kvmnetworklabel    string                     `json:"kvmnetworklabel"`
+	Ovm3networklabel   string                     `json:"ovm3networklabel"`
+	Physicalnetworkid  string                     `json:"physicalnetworkid"`
+	Traffictype        string                     `json:"traffictype"`
+	Vmwarenetworklabel string                     `json:"vmwarenetworklabel"`
+	Xennetworklabel    string                     `json:"xennetworklabel"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddTrafficTypeResponse) UnmarshalJSON(b []byte) error {
+	type alias AddTrafficTypeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteTrafficMonitorParams struct {
@@ -500,6 +669,44 @@ func (p *DeleteTrafficMonitorParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteTrafficMonitor API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteTrafficMonitorParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteTrafficMonitor", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteTrafficMonitor API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteTrafficMonitorParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteTrafficMonitor", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteTrafficMonitor API, before the request is signed
+func (p *DeleteTrafficMonitorParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteTrafficMonitorParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteTrafficMonitorParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteTrafficMonitor", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteTrafficMonitorParams) Clone() *DeleteTrafficMonitorParams {
+	return &DeleteTrafficMonitorParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteTrafficMonitorParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -532,13 +739,22 @@ func (s *UsageService) NewDeleteTrafficMonitorParams(id string) *DeleteTrafficMo
 
 // Deletes an traffic monitor host.
 func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteTrafficMonitor", p.toURLValues())
+	return s.DeleteTrafficMonitorWithContext(context.Background(), p)
+}
+
+// DeleteTrafficMonitorWithContext is like DeleteTrafficMonitor, but honours the cancellation and deadline of ctx
+func (s *UsageService) DeleteTrafficMonitorWithContext(ctx context.Context, p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteTrafficMonitor", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteTrafficMonitorResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteTrafficMonitor", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -546,10 +762,11 @@ func (s *UsageService) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*Del
 }
 
 type DeleteTrafficMonitorResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteTrafficMonitorResponse) UnmarshalJSON(b []byte) error {
@@ -576,7 +793,7 @@ func (r *DeleteTrafficMonitorResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteTrafficMonitorResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteTrafficTypeParams struct {
@@ -594,6 +811,44 @@ func (p *DeleteTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteTrafficType API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteTrafficTypeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteTrafficType", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteTrafficType API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteTrafficTypeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteTrafficType", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteTrafficType API, before the request is signed
+func (p *DeleteTrafficTypeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteTrafficType", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteTrafficTypeParams) Clone() *DeleteTrafficTypeParams {
+	return &DeleteTrafficTypeParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteTrafficTypeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -626,27 +881,39 @@ func (s *UsageService) NewDeleteTrafficTypeParams(id string) *DeleteTrafficTypeP
 
 // Deletes traffic type of a physical network
 func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteTrafficType", p.toURLValues())
+	return s.DeleteTrafficTypeWithContext(context.Background(), p)
+}
+
+// DeleteTrafficTypeWithContext is like DeleteTrafficType, but honours the cancellation and deadline of ctx
+func (s *UsageService) DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteTrafficType", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteTrafficTypeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteTrafficType", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteTrafficType", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteTrafficType", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -654,11 +921,31 @@ func (s *UsageService) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTra
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteTrafficType async job into r
+func (r *DeleteTrafficTypeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteTrafficTypeAsync starts the async job of DeleteTrafficType without waiting for it to finish, and returns a handle to the job
+func (s *UsageService) DeleteTrafficTypeAsync(ctx context.Context, p *DeleteTrafficTypeParams) (*Job, error) {
+	r, err := s.DeleteTrafficTypeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteTrafficType", r.JobID), nil
+}
+
 type DeleteTrafficTypeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteTrafficTypeResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteTrafficTypeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GenerateUsageRecordsParams struct {
@@ -682,6 +969,45 @@ func (p *GenerateUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the generateUsageRecords API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GenerateUsageRecordsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("generateUsageRecords", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the generateUsageRecords API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GenerateUsageRecordsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"domainid": 255, "enddate": 255, "startdate": 255},
+	}.validate("generateUsageRecords", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the generateUsageRecords API, before the request is signed
+func (p *GenerateUsageRecordsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GenerateUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GenerateUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("generateUsageRecords", b, &p.p, map[string]paramDecoder{
+		"domainid":  decodeParam[string],
+		"enddate":   decodeParam[string],
+		"startdate": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GenerateUsageRecordsParams) Clone() *GenerateUsageRecordsParams {
+	return &GenerateUsageRecordsParams{p: cloneParams(p.p)}
+}
+
 func (p *GenerateUsageRecordsParams) SetDomainid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -755,13 +1081,22 @@ func (s *UsageService) NewGenerateUsageRecordsParams() *GenerateUsageRecordsPara
 
 // Generates usage records. This will generate records only if there any records to be generated, i.e if the scheduled usage job was not run or failed
 func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
-	resp, err := s.cs.newPostRequest("generateUsageRecords", p.toURLValues())
+	return s.GenerateUsageRecordsWithContext(context.Background(), p)
+}
+
+// GenerateUsageRecordsWithContext is like GenerateUsageRecords, but honours the cancellation and deadline of ctx
+func (s *UsageService) GenerateUsageRecordsWithContext(ctx context.Context, p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "generateUsageRecords", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GenerateUsageRecordsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("generateUsageRecords", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -769,10 +1104,11 @@ func (s *UsageService) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*Gen
 }
 
 type GenerateUsageRecordsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *GenerateUsageRecordsResponse) UnmarshalJSON(b []byte) error {
@@ -799,7 +1135,7 @@ func (r *GenerateUsageRecordsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias GenerateUsageRecordsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListTrafficMonitorsParams struct {
@@ -828,6 +1164,47 @@ func (p *ListTrafficMonitorsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listTrafficMonitors API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListTrafficMonitorsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listTrafficMonitors", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listTrafficMonitors API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListTrafficMonitorsParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"keyword": 255, "zoneid": 255},
+	}.validate("listTrafficMonitors", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listTrafficMonitors API, before the request is signed
+func (p *ListTrafficMonitorsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListTrafficMonitorsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListTrafficMonitorsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listTrafficMonitors", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListTrafficMonitorsParams) Clone() *ListTrafficMonitorsParams {
+	return &ListTrafficMonitorsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListTrafficMonitorsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -923,32 +1300,75 @@ func (s *UsageService) NewListTrafficMonitorsParams(zoneid string) *ListTrafficM
 
 // List traffic monitor Hosts.
 func (s *UsageService) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficMonitors", p.toURLValues())
+	return s.ListTrafficMonitorsWithContext(context.Background(), p)
+}
+
+// ListTrafficMonitorsWithContext is like ListTrafficMonitors, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficMonitorsWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listTrafficMonitors", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTrafficMonitorsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listTrafficMonitors", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListTrafficMonitorsAll returns all TrafficMonitors matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UsageService) ListTrafficMonitorsAll(p *ListTrafficMonitorsParams, opts ...PageOption) ([]*TrafficMonitor, error) {
+	return s.ListTrafficMonitorsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListTrafficMonitorsAllWithContext is like ListTrafficMonitorsAll, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficMonitorsAllWithContext(ctx context.Context, p *ListTrafficMonitorsParams, opts ...PageOption) ([]*TrafficMonitor, error) {
+	return collectPages(s.ListTrafficMonitorsIterWithContext(ctx, p, opts...))
+}
+
+// ListTrafficMonitorsIter returns an iterator over all TrafficMonitors matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UsageService) ListTrafficMonitorsIter(p *ListTrafficMonitorsParams, opts ...PageOption) iter.Seq2[*TrafficMonitor, error] {
+	return s.ListTrafficMonitorsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListTrafficMonitorsIterWithContext is like ListTrafficMonitorsIter, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficMonitorsIterWithContext(ctx context.Context, p *ListTrafficMonitorsParams, opts ...PageOption) iter.Seq2[*TrafficMonitor, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*TrafficMonitor, int, error) {
+		l, err := s.ListTrafficMonitorsWithContext(ctx, &ListTrafficMonitorsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.TrafficMonitors, l.Count, nil
+	}, opts...)
+}
+
 type ListTrafficMonitorsResponse struct {
 	Count           int               `json:"count"`
 	TrafficMonitors []*TrafficMonitor `json:"trafficmonitor"`
 }
 
 type TrafficMonitor struct {
-	Id         string `json:"id"`
-	Ipaddress  string `json:"ipaddress"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Numretries string `json:"numretries"`
-	Timeout    string `json:"timeout"`
-	Zoneid     string `json:"zoneid"`
+	Id         string                     `json:"id"`
+	Ipaddress  string                     `json:"ipaddress"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Numretries string                     `json:"numretries"`
+	Timeout    string                     `json:"timeout"`
+	Zoneid     string                     `json:"zoneid"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *TrafficMonitor) UnmarshalJSON(b []byte) error {
+	type alias TrafficMonitor
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListTrafficTypeImplementorsParams struct {
@@ -977,6 +1397,46 @@ func (p *ListTrafficTypeImplementorsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listTrafficTypeImplementors API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListTrafficTypeImplementorsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listTrafficTypeImplementors", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listTrafficTypeImplementors API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListTrafficTypeImplementorsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "traffictype": 255},
+	}.validate("listTrafficTypeImplementors", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listTrafficTypeImplementors API, before the request is signed
+func (p *ListTrafficTypeImplementorsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListTrafficTypeImplementorsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListTrafficTypeImplementorsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listTrafficTypeImplementors", b, &p.p, map[string]paramDecoder{
+		"keyword":     decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"traffictype": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListTrafficTypeImplementorsParams) Clone() *ListTrafficTypeImplementorsParams {
+	return &ListTrafficTypeImplementorsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListTrafficTypeImplementorsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1071,29 +1531,72 @@ func (s *UsageService) NewListTrafficTypeImplementorsParams() *ListTrafficTypeIm
 
 // Lists implementors of implementor of a network traffic type or implementors of all network traffic types
 func (s *UsageService) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypeImplementors", p.toURLValues())
+	return s.ListTrafficTypeImplementorsWithContext(context.Background(), p)
+}
+
+// ListTrafficTypeImplementorsWithContext is like ListTrafficTypeImplementors, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficTypeImplementorsWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listTrafficTypeImplementors", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTrafficTypeImplementorsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listTrafficTypeImplementors", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListTrafficTypeImplementorsAll returns all TrafficTypeImplementors matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UsageService) ListTrafficTypeImplementorsAll(p *ListTrafficTypeImplementorsParams, opts ...PageOption) ([]*TrafficTypeImplementor, error) {
+	return s.ListTrafficTypeImplementorsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListTrafficTypeImplementorsAllWithContext is like ListTrafficTypeImplementorsAll, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficTypeImplementorsAllWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams, opts ...PageOption) ([]*TrafficTypeImplementor, error) {
+	return collectPages(s.ListTrafficTypeImplementorsIterWithContext(ctx, p, opts...))
+}
+
+// ListTrafficTypeImplementorsIter returns an iterator over all TrafficTypeImplementors matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UsageService) ListTrafficTypeImplementorsIter(p *ListTrafficTypeImplementorsParams, opts ...PageOption) iter.Seq2[*TrafficTypeImplementor, error] {
+	return s.ListTrafficTypeImplementorsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListTrafficTypeImplementorsIterWithContext is like ListTrafficTypeImplementorsIter, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficTypeImplementorsIterWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams, opts ...PageOption) iter.Seq2[*TrafficTypeImplementor, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*TrafficTypeImplementor, int, error) {
+		l, err := s.ListTrafficTypeImplementorsWithContext(ctx, &ListTrafficTypeImplementorsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.TrafficTypeImplementors, l.Count, nil
+	}, opts...)
+}
+
 type ListTrafficTypeImplementorsResponse struct {
 	Count                   int                       `json:"count"`
 	TrafficTypeImplementors []*TrafficTypeImplementor `json:"traffictypeimplementorresponse"`
 }
 
 type TrafficTypeImplementor struct {
-	JobID                  string `json:"jobid"`
-	Jobstatus              int    `json:"jobstatus"`
-	Traffictype            string `json:"traffictype"`
-	Traffictypeimplementor string `json:"traffictypeimplementor"`
+	JobID                  string                     `json:"jobid"`
+	Jobstatus              FlexInt                    `json:"jobstatus"`
+	Traffictype            string                     `json:"traffictype"`
+	Traffictypeimplementor string                     `json:"traffictypeimplementor"`
+	Extra                  map[string]json.RawMessage `json:"-"`
+}
+
+func (r *TrafficTypeImplementor) UnmarshalJSON(b []byte) error {
+	type alias TrafficTypeImplementor
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListTrafficTypesParams struct {
@@ -1122,6 +1625,47 @@ func (p *ListTrafficTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listTrafficTypes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListTrafficTypesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listTrafficTypes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listTrafficTypes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListTrafficTypesParams) Validate() error {
+	return paramRules{
+		required: []string{"physicalnetworkid"},
+		lengths:  map[string]int{"keyword": 255, "physicalnetworkid": 255},
+	}.validate("listTrafficTypes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listTrafficTypes API, before the request is signed
+func (p *ListTrafficTypesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListTrafficTypesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListTrafficTypesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listTrafficTypes", b, &p.p, map[string]paramDecoder{
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListTrafficTypesParams) Clone() *ListTrafficTypesParams {
+	return &ListTrafficTypesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListTrafficTypesParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1217,6 +1761,11 @@ func (s *UsageService) NewListTrafficTypesParams(physicalnetworkid string) *List
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetTrafficTypeIDWithContext(context.Background(), keyword, physicalnetworkid, opts...)
+}
+
+// GetTrafficTypeIDWithContext is like GetTrafficTypeID, but honours the cancellation and deadline of ctx
+func (s *UsageService) GetTrafficTypeIDWithContext(ctx context.Context, keyword string, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListTrafficTypesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1229,7 +1778,7 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 		}
 	}
 
-	l, err := s.ListTrafficTypes(p)
+	l, err := s.ListTrafficTypesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1254,34 +1803,77 @@ func (s *UsageService) GetTrafficTypeID(keyword string, physicalnetworkid string
 
 // Lists traffic types of a given physical network.
 func (s *UsageService) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
-	resp, err := s.cs.newRequest("listTrafficTypes", p.toURLValues())
+	return s.ListTrafficTypesWithContext(context.Background(), p)
+}
+
+// ListTrafficTypesWithContext is like ListTrafficTypes, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listTrafficTypes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListTrafficTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listTrafficTypes", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListTrafficTypesAll returns all TrafficTypes matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UsageService) ListTrafficTypesAll(p *ListTrafficTypesParams, opts ...PageOption) ([]*TrafficType, error) {
+	return s.ListTrafficTypesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListTrafficTypesAllWithContext is like ListTrafficTypesAll, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficTypesAllWithContext(ctx context.Context, p *ListTrafficTypesParams, opts ...PageOption) ([]*TrafficType, error) {
+	return collectPages(s.ListTrafficTypesIterWithContext(ctx, p, opts...))
+}
+
+// ListTrafficTypesIter returns an iterator over all TrafficTypes matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UsageService) ListTrafficTypesIter(p *ListTrafficTypesParams, opts ...PageOption) iter.Seq2[*TrafficType, error] {
+	return s.ListTrafficTypesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListTrafficTypesIterWithContext is like ListTrafficTypesIter, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListTrafficTypesIterWithContext(ctx context.Context, p *ListTrafficTypesParams, opts ...PageOption) iter.Seq2[*TrafficType, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*TrafficType, int, error) {
+		l, err := s.ListTrafficTypesWithContext(ctx, &ListTrafficTypesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.TrafficTypes, l.Count, nil
+	}, opts...)
+}
+
 type ListTrafficTypesResponse struct {
 	Count        int            `json:"count"`
 	TrafficTypes []*TrafficType `json:"traffictype"`
 }
 
 type TrafficType struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	JobID                        string   `json:"jobid"`
-	Jobstatus                    int      `json:"jobstatus"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *TrafficType) UnmarshalJSON(b []byte) error {
+	type alias TrafficType
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUsageRecordsParams struct {
@@ -1344,6 +1936,57 @@ func (p *ListUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUsageRecords API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUsageRecordsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUsageRecords", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUsageRecords API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUsageRecordsParams) Validate() error {
+	return paramRules{
+		required: []string{"enddate", "startdate"},
+		lengths:  map[string]int{"account": 255, "accountid": 255, "domainid": 255, "enddate": 255, "keyword": 255, "projectid": 255, "startdate": 255, "usageid": 255},
+	}.validate("listUsageRecords", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUsageRecords API, before the request is signed
+func (p *ListUsageRecordsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUsageRecords", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"accountid":   decodeParam[string],
+		"domainid":    decodeParam[string],
+		"enddate":     decodeParam[string],
+		"includetags": decodeParam[bool],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"oldformat":   decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"startdate":   decodeParam[string],
+		"type":        decodeParam[int64],
+		"usageid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUsageRecordsParams) Clone() *ListUsageRecordsParams {
+	return &ListUsageRecordsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUsageRecordsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1650,63 +2293,101 @@ func (s *UsageService) NewListUsageRecordsParams(enddate string, startdate strin
 
 // Lists usage records for accounts
 func (s *UsageService) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
-	resp, err := s.cs.newRequest("listUsageRecords", p.toURLValues())
+	return s.ListUsageRecordsWithContext(context.Background(), p)
+}
+
+// ListUsageRecordsWithContext is like ListUsageRecords, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListUsageRecordsWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUsageRecords", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUsageRecordsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUsageRecords", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListUsageRecordsAll returns all UsageRecords matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UsageService) ListUsageRecordsAll(p *ListUsageRecordsParams, opts ...PageOption) ([]*UsageRecord, error) {
+	return s.ListUsageRecordsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListUsageRecordsAllWithContext is like ListUsageRecordsAll, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListUsageRecordsAllWithContext(ctx context.Context, p *ListUsageRecordsParams, opts ...PageOption) ([]*UsageRecord, error) {
+	return collectPages(s.ListUsageRecordsIterWithContext(ctx, p, opts...))
+}
+
+// ListUsageRecordsIter returns an iterator over all UsageRecords matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UsageService) ListUsageRecordsIter(p *ListUsageRecordsParams, opts ...PageOption) iter.Seq2[*UsageRecord, error] {
+	return s.ListUsageRecordsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListUsageRecordsIterWithContext is like ListUsageRecordsIter, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListUsageRecordsIterWithContext(ctx context.Context, p *ListUsageRecordsParams, opts ...PageOption) iter.Seq2[*UsageRecord, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*UsageRecord, int, error) {
+		l, err := s.ListUsageRecordsWithContext(ctx, &ListUsageRecordsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.UsageRecords, l.Count, nil
+	}, opts...)
+}
+
 type ListUsageRecordsResponse struct {
 	Count        int            `json:"count"`
 	UsageRecords []*UsageRecord `json:"usagerecord"`
 }
 
 type UsageRecord struct {
-	Account          string `json:"account"`
-	Accountid        string `json:"accountid"`
-	Cpunumber        int64  `json:"cpunumber"`
-	Cpuspeed         int64  `json:"cpuspeed"`
-	Description      string `json:"description"`
-	Domain           string `json:"domain"`
-	Domainid         string `json:"domainid"`
-	Domainpath       string `json:"domainpath"`
-	Enddate          string `json:"enddate"`
-	Hasannotations   bool   `json:"hasannotations"`
-	Isdefault        bool   `json:"isdefault"`
-	Issourcenat      bool   `json:"issourcenat"`
-	Issystem         bool   `json:"issystem"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Memory           int64  `json:"memory"`
-	Name             string `json:"name"`
-	Networkid        string `json:"networkid"`
-	Offeringid       string `json:"offeringid"`
-	Oscategoryid     string `json:"oscategoryid"`
-	Oscategoryname   string `json:"oscategoryname"`
-	Osdisplayname    string `json:"osdisplayname"`
-	Ostypeid         string `json:"ostypeid"`
-	Project          string `json:"project"`
-	Projectid        string `json:"projectid"`
-	Rawusage         string `json:"rawusage"`
-	Size             int64  `json:"size"`
-	Startdate        string `json:"startdate"`
-	Tags             []Tags `json:"tags"`
-	Templateid       string `json:"templateid"`
-	Type             string `json:"type"`
-	Usage            string `json:"usage"`
-	Usageid          string `json:"usageid"`
-	Usagetype        int    `json:"usagetype"`
-	Virtualmachineid string `json:"virtualmachineid"`
-	Virtualsize      int64  `json:"virtualsize"`
-	Vpcid            string `json:"vpcid"`
-	Zoneid           string `json:"zoneid"`
+	Account          string                     `json:"account"`
+	Accountid        string                     `json:"accountid"`
+	Cpunumber        FlexInt64                  `json:"cpunumber"`
+	Cpuspeed         FlexInt64                  `json:"cpuspeed"`
+	Description      string                     `json:"description"`
+	Domain           string                     `json:"domain"`
+	Domainid         string                     `json:"domainid"`
+	Domainpath       string                     `json:"domainpath"`
+	Enddate          Time                       `json:"enddate"`
+	Hasannotations   FlexBool                   `json:"hasannotations"`
+	Isdefault        FlexBool                   `json:"isdefault"`
+	Issourcenat      FlexBool                   `json:"issourcenat"`
+	Issystem         FlexBool                   `json:"issystem"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Memory           FlexInt64                  `json:"memory"`
+	Name             string                     `json:"name"`
+	Networkid        string                     `json:"networkid"`
+	Offeringid       string                     `json:"offeringid"`
+	Oscategoryid     string                     `json:"oscategoryid"`
+	Oscategoryname   string                     `json:"oscategoryname"`
+	Osdisplayname    string                     `json:"osdisplayname"`
+	Ostypeid         string                     `json:"ostypeid"`
+	Project          string                     `json:"project"`
+	Projectid        string                     `json:"projectid"`
+	Rawusage         string                     `json:"rawusage"`
+	Size             FlexInt64                  `json:"size"`
+	Startdate        Time                       `json:"startdate"`
+	Tags             []Tags                     `json:"tags"`
+	Templateid       string                     `json:"templateid"`
+	Type             string                     `json:"type"`
+	Usage            string                     `json:"usage"`
+	Usageid          string                     `json:"usageid"`
+	Usagetype        FlexInt                    `json:"usagetype"`
+	Virtualmachineid string                     `json:"virtualmachineid"`
+	Virtualsize      FlexInt64                  `json:"virtualsize"`
+	Vpcid            string                     `json:"vpcid"`
+	Zoneid           string                     `json:"zoneid"`
+	Extra            map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UsageRecord) UnmarshalJSON(b []byte) error {
@@ -1733,7 +2414,7 @@ func (r *UsageRecord) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UsageRecord
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUsageTypesParams struct {
@@ -1748,6 +2429,39 @@ func (p *ListUsageTypesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUsageTypes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUsageTypesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUsageTypes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUsageTypes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUsageTypesParams) Validate() error {
+	return paramRules{}.validate("listUsageTypes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUsageTypes API, before the request is signed
+func (p *ListUsageTypesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUsageTypesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUsageTypesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUsageTypes", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUsageTypesParams) Clone() *ListUsageTypesParams {
+	return &ListUsageTypesParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new ListUsageTypesParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
@@ -1758,13 +2472,22 @@ func (s *UsageService) NewListUsageTypesParams() *ListUsageTypesParams {
 
 // List Usage Types
 func (s *UsageService) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
-	resp, err := s.cs.newRequest("listUsageTypes", p.toURLValues())
+	return s.ListUsageTypesWithContext(context.Background(), p)
+}
+
+// ListUsageTypesWithContext is like ListUsageTypes, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListUsageTypesWithContext(ctx context.Context, p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUsageTypes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUsageTypesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUsageTypes", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1777,11 +2500,17 @@ type ListUsageTypesResponse struct {
 }
 
 type UsageType struct {
-	Description string `json:"description"`
-	Id          int    `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
+	Description string                     `json:"description"`
+	Id          FlexInt                    `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UsageType) UnmarshalJSON(b []byte) error {
+	type alias UsageType
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveRawUsageRecordsParams struct {
@@ -1800,6 +2529,43 @@ func (p *RemoveRawUsageRecordsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeRawUsageRecords API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveRawUsageRecordsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeRawUsageRecords", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeRawUsageRecords API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveRawUsageRecordsParams) Validate() error {
+	return paramRules{
+		required: []string{"interval"},
+	}.validate("removeRawUsageRecords", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeRawUsageRecords API, before the request is signed
+func (p *RemoveRawUsageRecordsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveRawUsageRecordsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveRawUsageRecordsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeRawUsageRecords", b, &p.p, map[string]paramDecoder{
+		"interval": decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveRawUsageRecordsParams) Clone() *RemoveRawUsageRecordsParams {
+	return &RemoveRawUsageRecordsParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveRawUsageRecordsParams) SetInterval(v int) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1832,13 +2598,22 @@ func (s *UsageService) NewRemoveRawUsageRecordsParams(interval int) *RemoveRawUs
 
 // Safely removes raw records from cloud_usage table
 func (s *UsageService) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
-	resp, err := s.cs.newPostRequest("removeRawUsageRecords", p.toURLValues())
+	return s.RemoveRawUsageRecordsWithContext(context.Background(), p)
+}
+
+// RemoveRawUsageRecordsWithContext is like RemoveRawUsageRecords, but honours the cancellation and deadline of ctx
+func (s *UsageService) RemoveRawUsageRecordsWithContext(ctx context.Context, p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeRawUsageRecords", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveRawUsageRecordsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeRawUsageRecords", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1846,10 +2621,11 @@ func (s *UsageService) RemoveRawUsageRecords(p *RemoveRawUsageRecordsParams) (*R
 }
 
 type RemoveRawUsageRecordsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RemoveRawUsageRecordsResponse) UnmarshalJSON(b []byte) error {
@@ -1876,7 +2652,7 @@ func (r *RemoveRawUsageRecordsResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RemoveRawUsageRecordsResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateTrafficTypeParams struct {
@@ -1909,6 +2685,49 @@ func (p *UpdateTrafficTypeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateTrafficType API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateTrafficTypeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateTrafficType", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateTrafficType API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateTrafficTypeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"hypervnetworklabel": 255, "id": 255, "kvmnetworklabel": 255, "ovm3networklabel": 255, "vmwarenetworklabel": 255, "xennetworklabel": 255},
+	}.validate("updateTrafficType", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateTrafficType API, before the request is signed
+func (p *UpdateTrafficTypeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateTrafficTypeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateTrafficTypeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateTrafficType", b, &p.p, map[string]paramDecoder{
+		"hypervnetworklabel": decodeParam[string],
+		"id":                 decodeParam[string],
+		"kvmnetworklabel":    decodeParam[string],
+		"ovm3networklabel":   decodeParam[string],
+		"vmwarenetworklabel": decodeParam[string],
+		"xennetworklabel":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateTrafficTypeParams) Clone() *UpdateTrafficTypeParams {
+	return &UpdateTrafficTypeParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateTrafficTypeParams) SetHypervnetworklabel(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2046,32 +2865,39 @@ func (s *UsageService) NewUpdateTrafficTypeParams(id string) *UpdateTrafficTypeP
 
 // Updates traffic type of a physical network
 func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
-	resp, err := s.cs.newPostRequest("updateTrafficType", p.toURLValues())
+	return s.UpdateTrafficTypeWithContext(context.Background(), p)
+}
+
+// UpdateTrafficTypeWithContext is like UpdateTrafficType, but honours the cancellation and deadline of ctx
+func (s *UsageService) UpdateTrafficTypeWithContext(ctx context.Context, p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateTrafficType", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateTrafficTypeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateTrafficType", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateTrafficType", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateTrafficType", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2079,17 +2905,42 @@ func (s *UsageService) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTra
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateTrafficType async job into r
+func (r *UpdateTrafficTypeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateTrafficTypeAsync starts the async job of UpdateTrafficType without waiting for it to finish, and returns a handle to the job
+func (s *UsageService) UpdateTrafficTypeAsync(ctx context.Context, p *UpdateTrafficTypeParams) (*Job, error) {
+	r, err := s.UpdateTrafficTypeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateTrafficType", r.JobID), nil
+}
+
 type UpdateTrafficTypeResponse struct {
-	Hypervnetworklabel string `json:"hypervnetworklabel"`
-	Id                 string `json:"id"`
-	JobID              string `json:"jobid"`
-	Jobstatus          int    `json:"jobstatus"`
-	Kvmnetworklabel    string `json:"kvmnetworklabel"`
-	Ovm3networklabel   string `json:"ovm3networklabel"`
-	Physicalnetworkid  string `json:"physicalnetworkid"`
-	Traffictype        string `json:"traffictype"`
-	Vmwarenetworklabel string `json:"vmwarenetworklabel"`
-	Xennetworklabel    string `json:"xennetworklabel"`
+	Hypervnetworklabel string                     `json:"hypervnetworklabel"`
+	Id                 string                     `json:"id"`
+	JobID              string                     `json:"jobid"`
+	Jobstatus          FlexInt                    `json:"jobstatus"`
+	Kvmnetworklabel    string                     `json:"kvmnetworklabel"`
+	Ovm3networklabel   string                     `json:"ovm3networklabel"`
+	Physicalnetworkid  string                     `json:"physicalnetworkid"`
+	Traffictype        string                     `json:"traffictype"`
+	Vmwarenetworklabel string                     `json:"vmwarenetworklabel"`
+	Xennetworklabel    string                     `json:"xennetworklabel"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateTrafficTypeResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateTrafficTypeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUsageServerMetricsParams struct {
@@ -2104,6 +2955,39 @@ func (p *ListUsageServerMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUsageServerMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUsageServerMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUsageServerMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUsageServerMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUsageServerMetricsParams) Validate() error {
+	return paramRules{}.validate("listUsageServerMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUsageServerMetrics API, before the request is signed
+func (p *ListUsageServerMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUsageServerMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUsageServerMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUsageServerMetrics", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUsageServerMetricsParams) Clone() *ListUsageServerMetricsParams {
+	return &ListUsageServerMetricsParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new ListUsageServerMetricsParams instance,
 // as then you are sure you have configured all required params
 func (s *UsageService) NewListUsageServerMetricsParams() *ListUsageServerMetricsParams {
@@ -2114,13 +2998,22 @@ func (s *UsageService) NewListUsageServerMetricsParams() *ListUsageServerMetrics
 
 // Lists Usage Server metrics
 func (s *UsageService) ListUsageServerMetrics(p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listUsageServerMetrics", p.toURLValues())
+	return s.ListUsageServerMetricsWithContext(context.Background(), p)
+}
+
+// ListUsageServerMetricsWithContext is like ListUsageServerMetrics, but honours the cancellation and deadline of ctx
+func (s *UsageService) ListUsageServerMetricsWithContext(ctx context.Context, p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUsageServerMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUsageServerMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUsageServerMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2132,11 +3025,17 @@ type ListUsageServerMetricsResponse struct {
 }
 
 type UsageServerMetric struct {
-	Collectiontime    string `json:"collectiontime"`
-	Hostname          string `json:"hostname"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Lastheartbeat     string `json:"lastheartbeat"`
-	Lastsuccessfuljob string `json:"lastsuccessfuljob"`
-	State             string `json:"state"`
+	Collectiontime    Time                       `json:"collectiontime"`
+	Hostname          string                     `json:"hostname"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Lastheartbeat     Time                       `json:"lastheartbeat"`
+	Lastsuccessfuljob Time                       `json:"lastsuccessfuljob"`
+	State             string                     `json:"state"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UsageServerMetric) UnmarshalJSON(b []byte) error {
+	type alias UsageServerMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
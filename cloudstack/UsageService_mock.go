@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficMonitor(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficMonitor", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficMonitor), p)
 }
 
+// AddTrafficMonitorWithContext mocks base method.
+func (m *MockUsageServiceIface) AddTrafficMonitorWithContext(ctx context.Context, p *AddTrafficMonitorParams) (*AddTrafficMonitorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrafficMonitorWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddTrafficMonitorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTrafficMonitorWithContext indicates an expected call of AddTrafficMonitorWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficMonitorWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficMonitorWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficMonitorWithContext), ctx, p)
+}
+
 // AddTrafficType mocks base method.
 func (m *MockUsageServiceIface) AddTrafficType(p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficType(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficType), p)
 }
 
+// AddTrafficTypeAsync mocks base method.
+func (m *MockUsageServiceIface) AddTrafficTypeAsync(ctx context.Context, p *AddTrafficTypeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrafficTypeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTrafficTypeAsync indicates an expected call of AddTrafficTypeAsync.
+func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficTypeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficTypeAsync", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficTypeAsync), ctx, p)
+}
+
+// AddTrafficTypeWithContext mocks base method.
+func (m *MockUsageServiceIface) AddTrafficTypeWithContext(ctx context.Context, p *AddTrafficTypeParams) (*AddTrafficTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddTrafficTypeWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddTrafficTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddTrafficTypeWithContext indicates an expected call of AddTrafficTypeWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) AddTrafficTypeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddTrafficTypeWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).AddTrafficTypeWithContext), ctx, p)
+}
+
 // DeleteTrafficMonitor mocks base method.
 func (m *MockUsageServiceIface) DeleteTrafficMonitor(p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficMonitor(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficMonitor", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficMonitor), p)
 }
 
+// DeleteTrafficMonitorWithContext mocks base method.
+func (m *MockUsageServiceIface) DeleteTrafficMonitorWithContext(ctx context.Context, p *DeleteTrafficMonitorParams) (*DeleteTrafficMonitorResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrafficMonitorWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteTrafficMonitorResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrafficMonitorWithContext indicates an expected call of DeleteTrafficMonitorWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficMonitorWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficMonitorWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficMonitorWithContext), ctx, p)
+}
+
 // DeleteTrafficType mocks base method.
 func (m *MockUsageServiceIface) DeleteTrafficType(p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,36 @@ func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficType(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficType), p)
 }
 
+// DeleteTrafficTypeAsync mocks base method.
+func (m *MockUsageServiceIface) DeleteTrafficTypeAsync(ctx context.Context, p *DeleteTrafficTypeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrafficTypeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrafficTypeAsync indicates an expected call of DeleteTrafficTypeAsync.
+func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficTypeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficTypeAsync", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficTypeAsync), ctx, p)
+}
+
+// DeleteTrafficTypeWithContext mocks base method.
+func (m *MockUsageServiceIface) DeleteTrafficTypeWithContext(ctx context.Context, p *DeleteTrafficTypeParams) (*DeleteTrafficTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteTrafficTypeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteTrafficTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteTrafficTypeWithContext indicates an expected call of DeleteTrafficTypeWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) DeleteTrafficTypeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteTrafficTypeWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).DeleteTrafficTypeWithContext), ctx, p)
+}
+
 // GenerateUsageRecords mocks base method.
 func (m *MockUsageServiceIface) GenerateUsageRecords(p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +225,21 @@ func (mr *MockUsageServiceIfaceMockRecorder) GenerateUsageRecords(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).GenerateUsageRecords), p)
 }
 
+// GenerateUsageRecordsWithContext mocks base method.
+func (m *MockUsageServiceIface) GenerateUsageRecordsWithContext(ctx context.Context, p *GenerateUsageRecordsParams) (*GenerateUsageRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GenerateUsageRecordsWithContext", ctx, p)
+	ret0, _ := ret[0].(*GenerateUsageRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GenerateUsageRecordsWithContext indicates an expected call of GenerateUsageRecordsWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) GenerateUsageRecordsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GenerateUsageRecordsWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).GenerateUsageRecordsWithContext), ctx, p)
+}
+
 // GetTrafficTypeID mocks base method.
 func (m *MockUsageServiceIface) GetTrafficTypeID(keyword, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -154,6 +261,27 @@ func (mr *MockUsageServiceIfaceMockRecorder) GetTrafficTypeID(keyword, physicaln
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrafficTypeID", reflect.TypeOf((*MockUsageServiceIface)(nil).GetTrafficTypeID), varargs...)
 }
 
+// GetTrafficTypeIDWithContext mocks base method.
+func (m *MockUsageServiceIface) GetTrafficTypeIDWithContext(ctx context.Context, keyword, physicalnetworkid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, physicalnetworkid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetTrafficTypeIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetTrafficTypeIDWithContext indicates an expected call of GetTrafficTypeIDWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) GetTrafficTypeIDWithContext(ctx, keyword, physicalnetworkid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, physicalnetworkid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTrafficTypeIDWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).GetTrafficTypeIDWithContext), varargs...)
+}
+
 // ListTrafficMonitors mocks base method.
 func (m *MockUsageServiceIface) ListTrafficMonitors(p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +297,99 @@ func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitors(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitors", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitors), p)
 }
 
+// ListTrafficMonitorsAll mocks base method.
+func (m *MockUsageServiceIface) ListTrafficMonitorsAll(p *ListTrafficMonitorsParams, opts ...PageOption) ([]*TrafficMonitor, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficMonitorsAll", varargs...)
+	ret0, _ := ret[0].([]*TrafficMonitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficMonitorsAll indicates an expected call of ListTrafficMonitorsAll.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitorsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitorsAll", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitorsAll), varargs...)
+}
+
+// ListTrafficMonitorsAllWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficMonitorsAllWithContext(ctx context.Context, p *ListTrafficMonitorsParams, opts ...PageOption) ([]*TrafficMonitor, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficMonitorsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*TrafficMonitor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficMonitorsAllWithContext indicates an expected call of ListTrafficMonitorsAllWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitorsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitorsAllWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitorsAllWithContext), varargs...)
+}
+
+// ListTrafficMonitorsIter mocks base method.
+func (m *MockUsageServiceIface) ListTrafficMonitorsIter(p *ListTrafficMonitorsParams, opts ...PageOption) iter.Seq2[*TrafficMonitor, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficMonitorsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TrafficMonitor, error])
+	return ret0
+}
+
+// ListTrafficMonitorsIter indicates an expected call of ListTrafficMonitorsIter.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitorsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitorsIter", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitorsIter), varargs...)
+}
+
+// ListTrafficMonitorsIterWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficMonitorsIterWithContext(ctx context.Context, p *ListTrafficMonitorsParams, opts ...PageOption) iter.Seq2[*TrafficMonitor, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficMonitorsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TrafficMonitor, error])
+	return ret0
+}
+
+// ListTrafficMonitorsIterWithContext indicates an expected call of ListTrafficMonitorsIterWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitorsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitorsIterWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitorsIterWithContext), varargs...)
+}
+
+// ListTrafficMonitorsWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficMonitorsWithContext(ctx context.Context, p *ListTrafficMonitorsParams) (*ListTrafficMonitorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrafficMonitorsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListTrafficMonitorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficMonitorsWithContext indicates an expected call of ListTrafficMonitorsWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficMonitorsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficMonitorsWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficMonitorsWithContext), ctx, p)
+}
+
 // ListTrafficTypeImplementors mocks base method.
 func (m *MockUsageServiceIface) ListTrafficTypeImplementors(p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
 	m.ctrl.T.Helper()
@@ -184,6 +405,99 @@ func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementors(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementors", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementors), p)
 }
 
+// ListTrafficTypeImplementorsAll mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypeImplementorsAll(p *ListTrafficTypeImplementorsParams, opts ...PageOption) ([]*TrafficTypeImplementor, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypeImplementorsAll", varargs...)
+	ret0, _ := ret[0].([]*TrafficTypeImplementor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficTypeImplementorsAll indicates an expected call of ListTrafficTypeImplementorsAll.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementorsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementorsAll", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementorsAll), varargs...)
+}
+
+// ListTrafficTypeImplementorsAllWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypeImplementorsAllWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams, opts ...PageOption) ([]*TrafficTypeImplementor, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypeImplementorsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*TrafficTypeImplementor)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficTypeImplementorsAllWithContext indicates an expected call of ListTrafficTypeImplementorsAllWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementorsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementorsAllWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementorsAllWithContext), varargs...)
+}
+
+// ListTrafficTypeImplementorsIter mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypeImplementorsIter(p *ListTrafficTypeImplementorsParams, opts ...PageOption) iter.Seq2[*TrafficTypeImplementor, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypeImplementorsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TrafficTypeImplementor, error])
+	return ret0
+}
+
+// ListTrafficTypeImplementorsIter indicates an expected call of ListTrafficTypeImplementorsIter.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementorsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementorsIter", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementorsIter), varargs...)
+}
+
+// ListTrafficTypeImplementorsIterWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypeImplementorsIterWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams, opts ...PageOption) iter.Seq2[*TrafficTypeImplementor, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypeImplementorsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TrafficTypeImplementor, error])
+	return ret0
+}
+
+// ListTrafficTypeImplementorsIterWithContext indicates an expected call of ListTrafficTypeImplementorsIterWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementorsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementorsIterWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementorsIterWithContext), varargs...)
+}
+
+// ListTrafficTypeImplementorsWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypeImplementorsWithContext(ctx context.Context, p *ListTrafficTypeImplementorsParams) (*ListTrafficTypeImplementorsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrafficTypeImplementorsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListTrafficTypeImplementorsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficTypeImplementorsWithContext indicates an expected call of ListTrafficTypeImplementorsWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypeImplementorsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypeImplementorsWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypeImplementorsWithContext), ctx, p)
+}
+
 // ListTrafficTypes mocks base method.
 func (m *MockUsageServiceIface) ListTrafficTypes(p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +513,99 @@ func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypes(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypes", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypes), p)
 }
 
+// ListTrafficTypesAll mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypesAll(p *ListTrafficTypesParams, opts ...PageOption) ([]*TrafficType, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypesAll", varargs...)
+	ret0, _ := ret[0].([]*TrafficType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficTypesAll indicates an expected call of ListTrafficTypesAll.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypesAll", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypesAll), varargs...)
+}
+
+// ListTrafficTypesAllWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypesAllWithContext(ctx context.Context, p *ListTrafficTypesParams, opts ...PageOption) ([]*TrafficType, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*TrafficType)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficTypesAllWithContext indicates an expected call of ListTrafficTypesAllWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypesAllWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypesAllWithContext), varargs...)
+}
+
+// ListTrafficTypesIter mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypesIter(p *ListTrafficTypesParams, opts ...PageOption) iter.Seq2[*TrafficType, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TrafficType, error])
+	return ret0
+}
+
+// ListTrafficTypesIter indicates an expected call of ListTrafficTypesIter.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypesIter", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypesIter), varargs...)
+}
+
+// ListTrafficTypesIterWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypesIterWithContext(ctx context.Context, p *ListTrafficTypesParams, opts ...PageOption) iter.Seq2[*TrafficType, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListTrafficTypesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*TrafficType, error])
+	return ret0
+}
+
+// ListTrafficTypesIterWithContext indicates an expected call of ListTrafficTypesIterWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypesIterWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypesIterWithContext), varargs...)
+}
+
+// ListTrafficTypesWithContext mocks base method.
+func (m *MockUsageServiceIface) ListTrafficTypesWithContext(ctx context.Context, p *ListTrafficTypesParams) (*ListTrafficTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListTrafficTypesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListTrafficTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListTrafficTypesWithContext indicates an expected call of ListTrafficTypesWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListTrafficTypesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListTrafficTypesWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListTrafficTypesWithContext), ctx, p)
+}
+
 // ListUsageRecords mocks base method.
 func (m *MockUsageServiceIface) ListUsageRecords(p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +621,99 @@ func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecords(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecords), p)
 }
 
+// ListUsageRecordsAll mocks base method.
+func (m *MockUsageServiceIface) ListUsageRecordsAll(p *ListUsageRecordsParams, opts ...PageOption) ([]*UsageRecord, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageRecordsAll", varargs...)
+	ret0, _ := ret[0].([]*UsageRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageRecordsAll indicates an expected call of ListUsageRecordsAll.
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecordsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecordsAll", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecordsAll), varargs...)
+}
+
+// ListUsageRecordsAllWithContext mocks base method.
+func (m *MockUsageServiceIface) ListUsageRecordsAllWithContext(ctx context.Context, p *ListUsageRecordsParams, opts ...PageOption) ([]*UsageRecord, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageRecordsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*UsageRecord)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageRecordsAllWithContext indicates an expected call of ListUsageRecordsAllWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecordsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecordsAllWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecordsAllWithContext), varargs...)
+}
+
+// ListUsageRecordsIter mocks base method.
+func (m *MockUsageServiceIface) ListUsageRecordsIter(p *ListUsageRecordsParams, opts ...PageOption) iter.Seq2[*UsageRecord, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageRecordsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UsageRecord, error])
+	return ret0
+}
+
+// ListUsageRecordsIter indicates an expected call of ListUsageRecordsIter.
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecordsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecordsIter", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecordsIter), varargs...)
+}
+
+// ListUsageRecordsIterWithContext mocks base method.
+func (m *MockUsageServiceIface) ListUsageRecordsIterWithContext(ctx context.Context, p *ListUsageRecordsParams, opts ...PageOption) iter.Seq2[*UsageRecord, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsageRecordsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UsageRecord, error])
+	return ret0
+}
+
+// ListUsageRecordsIterWithContext indicates an expected call of ListUsageRecordsIterWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecordsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecordsIterWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecordsIterWithContext), varargs...)
+}
+
+// ListUsageRecordsWithContext mocks base method.
+func (m *MockUsageServiceIface) ListUsageRecordsWithContext(ctx context.Context, p *ListUsageRecordsParams) (*ListUsageRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsageRecordsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUsageRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageRecordsWithContext indicates an expected call of ListUsageRecordsWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageRecordsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageRecordsWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageRecordsWithContext), ctx, p)
+}
+
 // ListUsageServerMetrics mocks base method.
 func (m *MockUsageServiceIface) ListUsageServerMetrics(p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +729,21 @@ func (mr *MockUsageServiceIfaceMockRecorder) ListUsageServerMetrics(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageServerMetrics", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageServerMetrics), p)
 }
 
+// ListUsageServerMetricsWithContext mocks base method.
+func (m *MockUsageServiceIface) ListUsageServerMetricsWithContext(ctx context.Context, p *ListUsageServerMetricsParams) (*ListUsageServerMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsageServerMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUsageServerMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageServerMetricsWithContext indicates an expected call of ListUsageServerMetricsWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageServerMetricsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageServerMetricsWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageServerMetricsWithContext), ctx, p)
+}
+
 // ListUsageTypes mocks base method.
 func (m *MockUsageServiceIface) ListUsageTypes(p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +759,21 @@ func (mr *MockUsageServiceIfaceMockRecorder) ListUsageTypes(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageTypes", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageTypes), p)
 }
 
+// ListUsageTypesWithContext mocks base method.
+func (m *MockUsageServiceIface) ListUsageTypesWithContext(ctx context.Context, p *ListUsageTypesParams) (*ListUsageTypesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsageTypesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUsageTypesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsageTypesWithContext indicates an expected call of ListUsageTypesWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) ListUsageTypesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsageTypesWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).ListUsageTypesWithContext), ctx, p)
+}
+
 // NewAddTrafficMonitorParams mocks base method.
 func (m *MockUsageServiceIface) NewAddTrafficMonitorParams(url, zoneid string) *AddTrafficMonitorParams {
 	m.ctrl.T.Helper()
@@ -441,6 +971,21 @@ func (mr *MockUsageServiceIfaceMockRecorder) RemoveRawUsageRecords(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRawUsageRecords", reflect.TypeOf((*MockUsageServiceIface)(nil).RemoveRawUsageRecords), p)
 }
 
+// RemoveRawUsageRecordsWithContext mocks base method.
+func (m *MockUsageServiceIface) RemoveRawUsageRecordsWithContext(ctx context.Context, p *RemoveRawUsageRecordsParams) (*RemoveRawUsageRecordsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveRawUsageRecordsWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveRawUsageRecordsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveRawUsageRecordsWithContext indicates an expected call of RemoveRawUsageRecordsWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) RemoveRawUsageRecordsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveRawUsageRecordsWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).RemoveRawUsageRecordsWithContext), ctx, p)
+}
+
 // UpdateTrafficType mocks base method.
 func (m *MockUsageServiceIface) UpdateTrafficType(p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
 	m.ctrl.T.Helper()
@@ -455,3 +1000,33 @@ func (mr *MockUsageServiceIfaceMockRecorder) UpdateTrafficType(p any) *gomock.Ca
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrafficType", reflect.TypeOf((*MockUsageServiceIface)(nil).UpdateTrafficType), p)
 }
+
+// UpdateTrafficTypeAsync mocks base method.
+func (m *MockUsageServiceIface) UpdateTrafficTypeAsync(ctx context.Context, p *UpdateTrafficTypeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrafficTypeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrafficTypeAsync indicates an expected call of UpdateTrafficTypeAsync.
+func (mr *MockUsageServiceIfaceMockRecorder) UpdateTrafficTypeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrafficTypeAsync", reflect.TypeOf((*MockUsageServiceIface)(nil).UpdateTrafficTypeAsync), ctx, p)
+}
+
+// UpdateTrafficTypeWithContext mocks base method.
+func (m *MockUsageServiceIface) UpdateTrafficTypeWithContext(ctx context.Context, p *UpdateTrafficTypeParams) (*UpdateTrafficTypeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateTrafficTypeWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateTrafficTypeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateTrafficTypeWithContext indicates an expected call of UpdateTrafficTypeWithContext.
+func (mr *MockUsageServiceIfaceMockRecorder) UpdateTrafficTypeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateTrafficTypeWithContext", reflect.TypeOf((*MockUsageServiceIface)(nil).UpdateTrafficTypeWithContext), ctx, p)
+}
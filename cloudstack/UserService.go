@@ -20,56 +20,91 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type UserServiceIface interface {
 	CreateUser(p *CreateUserParams) (*CreateUserResponse, error)
+	CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error)
 	NewCreateUserParams(account string, email string, firstname string, lastname string, password string, username string) *CreateUserParams
 	DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error)
+	DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error)
 	NewDeleteUserParams(id string) *DeleteUserParams
 	DisableUser(p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error)
+	DisableUserAsync(ctx context.Context, p *DisableUserParams) (*Job, error)
 	NewDisableUserParams(id string) *DisableUserParams
 	EnableUser(p *EnableUserParams) (*EnableUserResponse, error)
+	EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error)
 	NewEnableUserParams(id string) *EnableUserParams
 	GetUser(p *GetUserParams) (*GetUserResponse, error)
+	GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error)
 	NewGetUserParams(userapikey string) *GetUserParams
 	GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error)
+	GetUserKeysWithContext(ctx context.Context, p *GetUserKeysParams) (*GetUserKeysResponse, error)
 	NewGetUserKeysParams(id string) *GetUserKeysParams
 	GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
+	GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error)
 	NewGetVirtualMachineUserDataParams(virtualmachineid string) *GetVirtualMachineUserDataParams
 	ListUserTwoFactorAuthenticatorProviders(p *ListUserTwoFactorAuthenticatorProvidersParams) (*ListUserTwoFactorAuthenticatorProvidersResponse, error)
+	ListUserTwoFactorAuthenticatorProvidersWithContext(ctx context.Context, p *ListUserTwoFactorAuthenticatorProvidersParams) (*ListUserTwoFactorAuthenticatorProvidersResponse, error)
 	NewListUserTwoFactorAuthenticatorProvidersParams() *ListUserTwoFactorAuthenticatorProvidersParams
 	ListUsers(p *ListUsersParams) (*ListUsersResponse, error)
+	ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error)
+	ListUsersAll(p *ListUsersParams, opts ...PageOption) ([]*User, error)
+	ListUsersAllWithContext(ctx context.Context, p *ListUsersParams, opts ...PageOption) ([]*User, error)
+	ListUsersIter(p *ListUsersParams, opts ...PageOption) iter.Seq2[*User, error]
+	ListUsersIterWithContext(ctx context.Context, p *ListUsersParams, opts ...PageOption) iter.Seq2[*User, error]
 	NewListUsersParams() *ListUsersParams
 	GetUserByID(id string, opts ...OptionFunc) (*User, int, error)
+	GetUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*User, int, error)
 	LockUser(p *LockUserParams) (*LockUserResponse, error)
+	LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error)
 	NewLockUserParams(id string) *LockUserParams
 	RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
+	RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error)
 	NewRegisterUserKeysParams(id string) *RegisterUserKeysParams
 	UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error)
+	UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error)
 	NewUpdateUserParams(id string) *UpdateUserParams
 	ListUserData(p *ListUserDataParams) (*ListUserDataResponse, error)
+	ListUserDataWithContext(ctx context.Context, p *ListUserDataParams) (*ListUserDataResponse, error)
+	ListUserDataAll(p *ListUserDataParams, opts ...PageOption) ([]*UserData, error)
+	ListUserDataAllWithContext(ctx context.Context, p *ListUserDataParams, opts ...PageOption) ([]*UserData, error)
+	ListUserDataIter(p *ListUserDataParams, opts ...PageOption) iter.Seq2[*UserData, error]
+	ListUserDataIterWithContext(ctx context.Context, p *ListUserDataParams, opts ...PageOption) iter.Seq2[*UserData, error]
 	NewListUserDataParams() *ListUserDataParams
 	GetUserDataID(name string, opts ...OptionFunc) (string, int, error)
+	GetUserDataIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetUserDataByName(name string, opts ...OptionFunc) (*UserData, int, error)
+	GetUserDataByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UserData, int, error)
 	GetUserDataByID(id string, opts ...OptionFunc) (*UserData, int, error)
+	GetUserDataByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UserData, int, error)
 	DeleteUserData(p *DeleteUserDataParams) (*DeleteUserDataResponse, error)
+	DeleteUserDataWithContext(ctx context.Context, p *DeleteUserDataParams) (*DeleteUserDataResponse, error)
 	NewDeleteUserDataParams(id string) *DeleteUserDataParams
 	RegisterUserData(p *RegisterUserDataParams) (*RegisterUserDataResponse, error)
+	RegisterUserDataWithContext(ctx context.Context, p *RegisterUserDataParams) (*RegisterUserDataResponse, error)
 	NewRegisterUserDataParams(name string, userdata string) *RegisterUserDataParams
 	MoveUser(p *MoveUserParams) (*MoveUserResponse, error)
+	MoveUserWithContext(ctx context.Context, p *MoveUserParams) (*MoveUserResponse, error)
 	NewMoveUserParams(id string) *MoveUserParams
 	SetupUserTwoFactorAuthentication(p *SetupUserTwoFactorAuthenticationParams) (*SetupUserTwoFactorAuthenticationResponse, error)
+	SetupUserTwoFactorAuthenticationWithContext(ctx context.Context, p *SetupUserTwoFactorAuthenticationParams) (*SetupUserTwoFactorAuthenticationResponse, error)
 	NewSetupUserTwoFactorAuthenticationParams() *SetupUserTwoFactorAuthenticationParams
 	ValidateUserTwoFactorAuthenticationCode(p *ValidateUserTwoFactorAuthenticationCodeParams) (*ValidateUserTwoFactorAuthenticationCodeResponse, error)
+	ValidateUserTwoFactorAuthenticationCodeWithContext(ctx context.Context, p *ValidateUserTwoFactorAuthenticationCodeParams) (*ValidateUserTwoFactorAuthenticationCodeResponse, error)
 	NewValidateUserTwoFactorAuthenticationCodeParams(codefor2fa string) *ValidateUserTwoFactorAuthenticationCodeParams
 	VerifyOAuthCodeAndGetUser(p *VerifyOAuthCodeAndGetUserParams) (*VerifyOAuthCodeAndGetUserResponse, error)
+	VerifyOAuthCodeAndGetUserWithContext(ctx context.Context, p *VerifyOAuthCodeAndGetUserParams) (*VerifyOAuthCodeAndGetUserResponse, error)
 	NewVerifyOAuthCodeAndGetUserParams(provider string) *VerifyOAuthCodeAndGetUserParams
+	RotateUserKeys(userid string) (*RotatedUserKeys, error)
+	RotateUserKeysWithContext(ctx context.Context, userid string) (*RotatedUserKeys, error)
 }
 
 type CreateUserParams struct {
@@ -111,6 +146,52 @@ func (p *CreateUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateUserParams) Validate() error {
+	return paramRules{
+		required: []string{"account", "email", "firstname", "lastname", "password", "username"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "email": 255, "firstname": 255, "lastname": 255, "password": 255, "timezone": 255, "userid": 255, "username": 255},
+	}.validate("createUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createUser API, before the request is signed
+func (p *CreateUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createUser", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"email":     decodeParam[string],
+		"firstname": decodeParam[string],
+		"lastname":  decodeParam[string],
+		"password":  decodeParam[string],
+		"timezone":  decodeParam[string],
+		"userid":    decodeParam[string],
+		"username":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateUserParams) Clone() *CreateUserParams {
+	return &CreateUserParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -316,7 +397,16 @@ func (s *UserService) NewCreateUserParams(account string, email string, firstnam
 
 // Creates a user for an account that already exists
 func (s *UserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, error) {
-	resp, err := s.cs.newPostRequest("createUser", p.toURLValues())
+	return s.CreateUserWithContext(context.Background(), p)
+}
+
+// CreateUserWithContext is like CreateUser, but honours the cancellation and deadline of ctx
+func (s *UserService) CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -326,7 +416,7 @@ func (s *UserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, erro
 	}
 
 	var r CreateUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -334,33 +424,39 @@ func (s *UserService) CreateUser(p *CreateUserParams) (*CreateUserResponse, erro
 }
 
 type CreateUserResponse struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	JobID               string      `json:"jobid"`
-	Jobstatus           int         `json:"jobstatus"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateUserResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteUserParams struct {
@@ -378,6 +474,44 @@ func (p *DeleteUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteUserParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteUser API, before the request is signed
+func (p *DeleteUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteUser", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteUserParams) Clone() *DeleteUserParams {
+	return &DeleteUserParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -410,13 +544,22 @@ func (s *UserService) NewDeleteUserParams(id string) *DeleteUserParams {
 
 // Deletes a user for an account
 func (s *UserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteUser", p.toURLValues())
+	return s.DeleteUserWithContext(context.Background(), p)
+}
+
+// DeleteUserWithContext is like DeleteUser, but honours the cancellation and deadline of ctx
+func (s *UserService) DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -424,10 +567,11 @@ func (s *UserService) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, erro
 }
 
 type DeleteUserResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteUserResponse) UnmarshalJSON(b []byte) error {
@@ -454,7 +598,7 @@ func (r *DeleteUserResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteUserResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableUserParams struct {
@@ -472,6 +616,44 @@ func (p *DisableUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableUserParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("disableUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableUser API, before the request is signed
+func (p *DisableUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableUser", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableUserParams) Clone() *DisableUserParams {
+	return &DisableUserParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -504,32 +686,39 @@ func (s *UserService) NewDisableUserParams(id string) *DisableUserParams {
 
 // Disables a user account
 func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
-	resp, err := s.cs.newPostRequest("disableUser", p.toURLValues())
+	return s.DisableUserWithContext(context.Background(), p)
+}
+
+// DisableUserWithContext is like DisableUser, but honours the cancellation and deadline of ctx
+func (s *UserService) DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableUser", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableUser", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableUser", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -537,34 +726,59 @@ func (s *UserService) DisableUser(p *DisableUserParams) (*DisableUserResponse, e
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableUser async job into r
+func (r *DisableUserResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DisableUserAsync starts the async job of DisableUser without waiting for it to finish, and returns a handle to the job
+func (s *UserService) DisableUserAsync(ctx context.Context, p *DisableUserParams) (*Job, error) {
+	r, err := s.DisableUserWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableUser", r.JobID), nil
+}
+
 type DisableUserResponse struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	JobID               string      `json:"jobid"`
-	Jobstatus           int         `json:"jobstatus"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableUserResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableUserParams struct {
@@ -582,6 +796,44 @@ func (p *EnableUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableUserParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("enableUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableUser API, before the request is signed
+func (p *EnableUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableUser", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableUserParams) Clone() *EnableUserParams {
+	return &EnableUserParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -614,7 +866,16 @@ func (s *UserService) NewEnableUserParams(id string) *EnableUserParams {
 
 // Enables a user account
 func (s *UserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
-	resp, err := s.cs.newPostRequest("enableUser", p.toURLValues())
+	return s.EnableUserWithContext(context.Background(), p)
+}
+
+// EnableUserWithContext is like EnableUser, but honours the cancellation and deadline of ctx
+func (s *UserService) EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "enableUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -624,7 +885,7 @@ func (s *UserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, erro
 	}
 
 	var r EnableUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -632,33 +893,39 @@ func (s *UserService) EnableUser(p *EnableUserParams) (*EnableUserResponse, erro
 }
 
 type EnableUserResponse struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	JobID               string      `json:"jobid"`
-	Jobstatus           int         `json:"jobstatus"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableUserResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetUserParams struct {
@@ -676,6 +943,44 @@ func (p *GetUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetUserParams) Validate() error {
+	return paramRules{
+		required: []string{"userapikey"},
+		lengths:  map[string]int{"userapikey": 255},
+	}.validate("getUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getUser API, before the request is signed
+func (p *GetUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getUser", b, &p.p, map[string]paramDecoder{
+		"userapikey": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetUserParams) Clone() *GetUserParams {
+	return &GetUserParams{p: cloneParams(p.p)}
+}
+
 func (p *GetUserParams) SetUserapikey(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -708,13 +1013,22 @@ func (s *UserService) NewGetUserParams(userapikey string) *GetUserParams {
 
 // Find user account by API key
 func (s *UserService) GetUser(p *GetUserParams) (*GetUserResponse, error) {
-	resp, err := s.cs.newRequest("getUser", p.toURLValues())
+	return s.GetUserWithContext(context.Background(), p)
+}
+
+// GetUserWithContext is like GetUser, but honours the cancellation and deadline of ctx
+func (s *UserService) GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -722,33 +1036,39 @@ func (s *UserService) GetUser(p *GetUserParams) (*GetUserResponse, error) {
 }
 
 type GetUserResponse struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	JobID               string      `json:"jobid"`
-	Jobstatus           int         `json:"jobstatus"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetUserResponse) UnmarshalJSON(b []byte) error {
+	type alias GetUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetUserKeysParams struct {
@@ -766,6 +1086,44 @@ func (p *GetUserKeysParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getUserKeys API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetUserKeysParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getUserKeys", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getUserKeys API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetUserKeysParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("getUserKeys", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getUserKeys API, before the request is signed
+func (p *GetUserKeysParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetUserKeysParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetUserKeysParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getUserKeys", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetUserKeysParams) Clone() *GetUserKeysParams {
+	return &GetUserKeysParams{p: cloneParams(p.p)}
+}
+
 func (p *GetUserKeysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -798,7 +1156,16 @@ func (s *UserService) NewGetUserKeysParams(id string) *GetUserKeysParams {
 
 // This command allows the user to query the seceret and API keys for the account
 func (s *UserService) GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error) {
-	resp, err := s.cs.newRequest("getUserKeys", p.toURLValues())
+	return s.GetUserKeysWithContext(context.Background(), p)
+}
+
+// GetUserKeysWithContext is like GetUserKeys, but honours the cancellation and deadline of ctx
+func (s *UserService) GetUserKeysWithContext(ctx context.Context, p *GetUserKeysParams) (*GetUserKeysResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getUserKeys", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -808,7 +1175,7 @@ func (s *UserService) GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, e
 	}
 
 	var r GetUserKeysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getUserKeys", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -816,11 +1183,17 @@ func (s *UserService) GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, e
 }
 
 type GetUserKeysResponse struct {
-	Apikey       string `json:"apikey"`
-	Apikeyaccess bool   `json:"apikeyaccess"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Secretkey    string `json:"secretkey"`
+	Apikey       string                     `json:"apikey"`
+	Apikeyaccess FlexBool                   `json:"apikeyaccess"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Secretkey    string                     `json:"secretkey"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetUserKeysResponse) UnmarshalJSON(b []byte) error {
+	type alias GetUserKeysResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetVirtualMachineUserDataParams struct {
@@ -838,6 +1211,44 @@ func (p *GetVirtualMachineUserDataParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getVirtualMachineUserData API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetVirtualMachineUserDataParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getVirtualMachineUserData", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getVirtualMachineUserData API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetVirtualMachineUserDataParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"virtualmachineid": 255},
+	}.validate("getVirtualMachineUserData", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getVirtualMachineUserData API, before the request is signed
+func (p *GetVirtualMachineUserDataParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetVirtualMachineUserDataParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetVirtualMachineUserDataParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getVirtualMachineUserData", b, &p.p, map[string]paramDecoder{
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetVirtualMachineUserDataParams) Clone() *GetVirtualMachineUserDataParams {
+	return &GetVirtualMachineUserDataParams{p: cloneParams(p.p)}
+}
+
 func (p *GetVirtualMachineUserDataParams) SetVirtualmachineid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -870,7 +1281,16 @@ func (s *UserService) NewGetVirtualMachineUserDataParams(virtualmachineid string
 
 // Returns user data associated with the Instance
 func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
-	resp, err := s.cs.newRequest("getVirtualMachineUserData", p.toURLValues())
+	return s.GetVirtualMachineUserDataWithContext(context.Background(), p)
+}
+
+// GetVirtualMachineUserDataWithContext is like GetVirtualMachineUserData, but honours the cancellation and deadline of ctx
+func (s *UserService) GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getVirtualMachineUserData", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -880,7 +1300,7 @@ func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataPara
 	}
 
 	var r GetVirtualMachineUserDataResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getVirtualMachineUserData", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -888,10 +1308,16 @@ func (s *UserService) GetVirtualMachineUserData(p *GetVirtualMachineUserDataPara
 }
 
 type GetVirtualMachineUserDataResponse struct {
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Userdata         string `json:"userdata"`
-	Virtualmachineid string `json:"virtualmachineid"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Userdata         string                     `json:"userdata"`
+	Virtualmachineid string                     `json:"virtualmachineid"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetVirtualMachineUserDataResponse) UnmarshalJSON(b []byte) error {
+	type alias GetVirtualMachineUserDataResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUserTwoFactorAuthenticatorProvidersParams struct {
@@ -909,6 +1335,43 @@ func (p *ListUserTwoFactorAuthenticatorProvidersParams) toURLValues() url.Values
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUserTwoFactorAuthenticatorProviders API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUserTwoFactorAuthenticatorProvidersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUserTwoFactorAuthenticatorProviders", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUserTwoFactorAuthenticatorProviders API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUserTwoFactorAuthenticatorProvidersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"name": 255},
+	}.validate("listUserTwoFactorAuthenticatorProviders", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUserTwoFactorAuthenticatorProviders API, before the request is signed
+func (p *ListUserTwoFactorAuthenticatorProvidersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUserTwoFactorAuthenticatorProvidersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUserTwoFactorAuthenticatorProvidersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUserTwoFactorAuthenticatorProviders", b, &p.p, map[string]paramDecoder{
+		"name": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUserTwoFactorAuthenticatorProvidersParams) Clone() *ListUserTwoFactorAuthenticatorProvidersParams {
+	return &ListUserTwoFactorAuthenticatorProvidersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUserTwoFactorAuthenticatorProvidersParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -940,13 +1403,22 @@ func (s *UserService) NewListUserTwoFactorAuthenticatorProvidersParams() *ListUs
 
 // Lists user two factor authenticator providers
 func (s *UserService) ListUserTwoFactorAuthenticatorProviders(p *ListUserTwoFactorAuthenticatorProvidersParams) (*ListUserTwoFactorAuthenticatorProvidersResponse, error) {
-	resp, err := s.cs.newRequest("listUserTwoFactorAuthenticatorProviders", p.toURLValues())
+	return s.ListUserTwoFactorAuthenticatorProvidersWithContext(context.Background(), p)
+}
+
+// ListUserTwoFactorAuthenticatorProvidersWithContext is like ListUserTwoFactorAuthenticatorProviders, but honours the cancellation and deadline of ctx
+func (s *UserService) ListUserTwoFactorAuthenticatorProvidersWithContext(ctx context.Context, p *ListUserTwoFactorAuthenticatorProvidersParams) (*ListUserTwoFactorAuthenticatorProvidersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUserTwoFactorAuthenticatorProviders", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUserTwoFactorAuthenticatorProvidersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUserTwoFactorAuthenticatorProviders", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -959,10 +1431,16 @@ type ListUserTwoFactorAuthenticatorProvidersResponse struct {
 }
 
 type UserTwoFactorAuthenticatorProvider struct {
-	Description string `json:"description"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
+	Description string                     `json:"description"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UserTwoFactorAuthenticatorProvider) UnmarshalJSON(b []byte) error {
+	type alias UserTwoFactorAuthenticatorProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUsersParams struct {
@@ -1025,6 +1503,56 @@ func (p *ListUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUsers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUsersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUsers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUsers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUsersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "apikeyaccess": 255, "domainid": 255, "id": 255, "keyword": 255, "state": 255, "username": 255, "usersource": 255},
+	}.validate("listUsers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUsers API, before the request is signed
+func (p *ListUsersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUsersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUsersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUsers", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"accounttype":  decodeParam[int],
+		"apikeyaccess": decodeParam[string],
+		"domainid":     decodeParam[string],
+		"id":           decodeParam[string],
+		"isrecursive":  decodeParam[bool],
+		"keyword":      decodeParam[string],
+		"listall":      decodeParam[bool],
+		"page":         decodeParam[int],
+		"pagesize":     decodeParam[int],
+		"showicon":     decodeParam[bool],
+		"state":        decodeParam[string],
+		"username":     decodeParam[string],
+		"usersource":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUsersParams) Clone() *ListUsersParams {
+	return &ListUsersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUsersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1329,6 +1857,11 @@ func (s *UserService) NewListUsersParams() *ListUsersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, error) {
+	return s.GetUserByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetUserByIDWithContext is like GetUserByID, but honours the cancellation and deadline of ctx
+func (s *UserService) GetUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*User, int, error) {
 	p := &ListUsersParams{}
 	p.p = make(map[string]interface{})
 
@@ -1340,11 +1873,10 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 		}
 	}
 
-	l, err := s.ListUsers(p)
+	l, err := s.ListUsersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1362,52 +1894,95 @@ func (s *UserService) GetUserByID(id string, opts ...OptionFunc) (*User, int, er
 
 // Lists user accounts
 func (s *UserService) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
-	resp, err := s.cs.newRequest("listUsers", p.toURLValues())
+	return s.ListUsersWithContext(context.Background(), p)
+}
+
+// ListUsersWithContext is like ListUsers, but honours the cancellation and deadline of ctx
+func (s *UserService) ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUsers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUsersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUsers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListUsersAll returns all Users matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UserService) ListUsersAll(p *ListUsersParams, opts ...PageOption) ([]*User, error) {
+	return s.ListUsersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListUsersAllWithContext is like ListUsersAll, but honours the cancellation and deadline of ctx
+func (s *UserService) ListUsersAllWithContext(ctx context.Context, p *ListUsersParams, opts ...PageOption) ([]*User, error) {
+	return collectPages(s.ListUsersIterWithContext(ctx, p, opts...))
+}
+
+// ListUsersIter returns an iterator over all Users matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UserService) ListUsersIter(p *ListUsersParams, opts ...PageOption) iter.Seq2[*User, error] {
+	return s.ListUsersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListUsersIterWithContext is like ListUsersIter, but honours the cancellation and deadline of ctx
+func (s *UserService) ListUsersIterWithContext(ctx context.Context, p *ListUsersParams, opts ...PageOption) iter.Seq2[*User, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*User, int, error) {
+		l, err := s.ListUsersWithContext(ctx, &ListUsersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Users, l.Count, nil
+	}, opts...)
+}
+
 type ListUsersResponse struct {
 	Count int     `json:"count"`
 	Users []*User `json:"user"`
 }
 
 type User struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	JobID               string      `json:"jobid"`
-	Jobstatus           int         `json:"jobstatus"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *User) UnmarshalJSON(b []byte) error {
+	type alias User
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type LockUserParams struct {
@@ -1425,6 +2000,44 @@ func (p *LockUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the lockUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *LockUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("lockUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the lockUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *LockUserParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("lockUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the lockUser API, before the request is signed
+func (p *LockUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *LockUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *LockUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("lockUser", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *LockUserParams) Clone() *LockUserParams {
+	return &LockUserParams{p: cloneParams(p.p)}
+}
+
 func (p *LockUserParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1457,7 +2070,16 @@ func (s *UserService) NewLockUserParams(id string) *LockUserParams {
 
 // Locks a user account
 func (s *UserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
-	resp, err := s.cs.newPostRequest("lockUser", p.toURLValues())
+	return s.LockUserWithContext(context.Background(), p)
+}
+
+// LockUserWithContext is like LockUser, but honours the cancellation and deadline of ctx
+func (s *UserService) LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "lockUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1467,7 +2089,7 @@ func (s *UserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
 	}
 
 	var r LockUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("lockUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1475,33 +2097,39 @@ func (s *UserService) LockUser(p *LockUserParams) (*LockUserResponse, error) {
 }
 
 type LockUserResponse struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	JobID               string      `json:"jobid"`
-	Jobstatus           int         `json:"jobstatus"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *LockUserResponse) UnmarshalJSON(b []byte) error {
+	type alias LockUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterUserKeysParams struct {
@@ -1519,6 +2147,44 @@ func (p *RegisterUserKeysParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerUserKeys API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterUserKeysParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerUserKeys", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerUserKeys API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterUserKeysParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("registerUserKeys", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerUserKeys API, before the request is signed
+func (p *RegisterUserKeysParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterUserKeysParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterUserKeysParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerUserKeys", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterUserKeysParams) Clone() *RegisterUserKeysParams {
+	return &RegisterUserKeysParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterUserKeysParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1551,7 +2217,16 @@ func (s *UserService) NewRegisterUserKeysParams(id string) *RegisterUserKeysPara
 
 // This command allows a user to register for the developer API, returning a secret key and an API key. This request is made through the integration API port, so it is a privileged command and must be made on behalf of a user. It is up to the implementer just how the username and password are entered, and then how that translates to an integration API request. Both secret key and API key should be returned to the user
 func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
-	resp, err := s.cs.newPostRequest("registerUserKeys", p.toURLValues())
+	return s.RegisterUserKeysWithContext(context.Background(), p)
+}
+
+// RegisterUserKeysWithContext is like RegisterUserKeys, but honours the cancellation and deadline of ctx
+func (s *UserService) RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerUserKeys", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1561,7 +2236,7 @@ func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUser
 	}
 
 	var r RegisterUserKeysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerUserKeys", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1569,11 +2244,17 @@ func (s *UserService) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUser
 }
 
 type RegisterUserKeysResponse struct {
-	Apikey       string `json:"apikey"`
-	Apikeyaccess bool   `json:"apikeyaccess"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Secretkey    string `json:"secretkey"`
+	Apikey       string                     `json:"apikey"`
+	Apikeyaccess FlexBool                   `json:"apikeyaccess"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Secretkey    string                     `json:"secretkey"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RegisterUserKeysResponse) UnmarshalJSON(b []byte) error {
+	type alias RegisterUserKeysResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateUserParams struct {
@@ -1625,6 +2306,55 @@ func (p *UpdateUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateUserParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"apikeyaccess": 255, "currentpassword": 255, "email": 255, "firstname": 255, "id": 255, "lastname": 255, "password": 255, "timezone": 255, "userapikey": 255, "username": 255, "usersecretkey": 255},
+	}.validate("updateUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateUser API, before the request is signed
+func (p *UpdateUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateUser", b, &p.p, map[string]paramDecoder{
+		"apikeyaccess":    decodeParam[string],
+		"currentpassword": decodeParam[string],
+		"email":           decodeParam[string],
+		"firstname":       decodeParam[string],
+		"id":              decodeParam[string],
+		"lastname":        decodeParam[string],
+		"mandate2fa":      decodeParam[bool],
+		"password":        decodeParam[string],
+		"timezone":        decodeParam[string],
+		"userapikey":      decodeParam[string],
+		"username":        decodeParam[string],
+		"usersecretkey":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateUserParams) Clone() *UpdateUserParams {
+	return &UpdateUserParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateUserParams) SetApikeyaccess(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1888,13 +2618,22 @@ func (s *UserService) NewUpdateUserParams(id string) *UpdateUserParams {
 
 // Updates a user account
 func (s *UserService) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
-	resp, err := s.cs.newPostRequest("updateUser", p.toURLValues())
+	return s.UpdateUserWithContext(context.Background(), p)
+}
+
+// UpdateUserWithContext is like UpdateUser, but honours the cancellation and deadline of ctx
+func (s *UserService) UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1902,33 +2641,39 @@ func (s *UserService) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, erro
 }
 
 type UpdateUserResponse struct {
-	Account             string      `json:"account"`
-	Accountid           string      `json:"accountid"`
-	Accounttype         int         `json:"accounttype"`
-	Apikey              string      `json:"apikey"`
-	Apikeyaccess        string      `json:"apikeyaccess"`
-	Created             string      `json:"created"`
-	Domain              string      `json:"domain"`
-	Domainid            string      `json:"domainid"`
-	Email               string      `json:"email"`
-	Firstname           string      `json:"firstname"`
-	Icon                interface{} `json:"icon"`
-	Id                  string      `json:"id"`
-	Is2faenabled        bool        `json:"is2faenabled"`
-	Is2famandated       bool        `json:"is2famandated"`
-	Iscallerchilddomain bool        `json:"iscallerchilddomain"`
-	Isdefault           bool        `json:"isdefault"`
-	JobID               string      `json:"jobid"`
-	Jobstatus           int         `json:"jobstatus"`
-	Lastname            string      `json:"lastname"`
-	Roleid              string      `json:"roleid"`
-	Rolename            string      `json:"rolename"`
-	Roletype            string      `json:"roletype"`
-	Secretkey           string      `json:"secretkey"`
-	State               string      `json:"state"`
-	Timezone            string      `json:"timezone"`
-	Username            string      `json:"username"`
-	Usersource          string      `json:"usersource"`
+	Account             string                     `json:"account"`
+	Accountid           string                     `json:"accountid"`
+	Accounttype         FlexInt                    `json:"accounttype"`
+	Apikey              string                     `json:"apikey"`
+	Apikeyaccess        string                     `json:"apikeyaccess"`
+	Created             Time                       `json:"created"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Email               string                     `json:"email"`
+	Firstname           string                     `json:"firstname"`
+	Icon                interface{}                `json:"icon"`
+	Id                  string                     `json:"id"`
+	Is2faenabled        FlexBool                   `json:"is2faenabled"`
+	Is2famandated       FlexBool                   `json:"is2famandated"`
+	Iscallerchilddomain FlexBool                   `json:"iscallerchilddomain"`
+	Isdefault           FlexBool                   `json:"isdefault"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Lastname            string                     `json:"lastname"`
+	Roleid              string                     `json:"roleid"`
+	Rolename            string                     `json:"rolename"`
+	Roletype            string                     `json:"roletype"`
+	Secretkey           string                     `json:"secretkey"`
+	State               string                     `json:"state"`
+	Timezone            string                     `json:"timezone"`
+	Username            string                     `json:"username"`
+	Usersource          string                     `json:"usersource"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateUserResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUserDataParams struct {
@@ -1977,6 +2722,52 @@ func (p *ListUserDataParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUserData API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUserDataParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUserData", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUserData API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUserDataParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255},
+	}.validate("listUserData", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUserData API, before the request is signed
+func (p *ListUserDataParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUserDataParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUserDataParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUserData", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUserDataParams) Clone() *ListUserDataParams {
+	return &ListUserDataParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUserDataParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2197,6 +2988,11 @@ func (s *UserService) NewListUserDataParams() *ListUserDataParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UserService) GetUserDataID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetUserDataIDWithContext(context.Background(), name, opts...)
+}
+
+// GetUserDataIDWithContext is like GetUserDataID, but honours the cancellation and deadline of ctx
+func (s *UserService) GetUserDataIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListUserDataParams{}
 	p.p = make(map[string]interface{})
 
@@ -2208,7 +3004,7 @@ func (s *UserService) GetUserDataID(name string, opts ...OptionFunc) (string, in
 		}
 	}
 
-	l, err := s.ListUserData(p)
+	l, err := s.ListUserDataWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2233,12 +3029,17 @@ func (s *UserService) GetUserDataID(name string, opts ...OptionFunc) (string, in
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UserService) GetUserDataByName(name string, opts ...OptionFunc) (*UserData, int, error) {
-	id, count, err := s.GetUserDataID(name, opts...)
+	return s.GetUserDataByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetUserDataByNameWithContext is like GetUserDataByName, but honours the cancellation and deadline of ctx
+func (s *UserService) GetUserDataByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UserData, int, error) {
+	id, count, err := s.GetUserDataIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetUserDataByID(id, opts...)
+	r, count, err := s.GetUserDataByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2247,6 +3048,11 @@ func (s *UserService) GetUserDataByName(name string, opts ...OptionFunc) (*UserD
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *UserService) GetUserDataByID(id string, opts ...OptionFunc) (*UserData, int, error) {
+	return s.GetUserDataByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetUserDataByIDWithContext is like GetUserDataByID, but honours the cancellation and deadline of ctx
+func (s *UserService) GetUserDataByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UserData, int, error) {
 	p := &ListUserDataParams{}
 	p.p = make(map[string]interface{})
 
@@ -2258,11 +3064,10 @@ func (s *UserService) GetUserDataByID(id string, opts ...OptionFunc) (*UserData,
 		}
 	}
 
-	l, err := s.ListUserData(p)
+	l, err := s.ListUserDataWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2280,39 +3085,82 @@ func (s *UserService) GetUserDataByID(id string, opts ...OptionFunc) (*UserData,
 
 // List registered userdatas
 func (s *UserService) ListUserData(p *ListUserDataParams) (*ListUserDataResponse, error) {
-	resp, err := s.cs.newRequest("listUserData", p.toURLValues())
+	return s.ListUserDataWithContext(context.Background(), p)
+}
+
+// ListUserDataWithContext is like ListUserData, but honours the cancellation and deadline of ctx
+func (s *UserService) ListUserDataWithContext(ctx context.Context, p *ListUserDataParams) (*ListUserDataResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUserData", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUserDataResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUserData", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListUserDataAll returns all UserData matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *UserService) ListUserDataAll(p *ListUserDataParams, opts ...PageOption) ([]*UserData, error) {
+	return s.ListUserDataAllWithContext(context.Background(), p, opts...)
+}
+
+// ListUserDataAllWithContext is like ListUserDataAll, but honours the cancellation and deadline of ctx
+func (s *UserService) ListUserDataAllWithContext(ctx context.Context, p *ListUserDataParams, opts ...PageOption) ([]*UserData, error) {
+	return collectPages(s.ListUserDataIterWithContext(ctx, p, opts...))
+}
+
+// ListUserDataIter returns an iterator over all UserData matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *UserService) ListUserDataIter(p *ListUserDataParams, opts ...PageOption) iter.Seq2[*UserData, error] {
+	return s.ListUserDataIterWithContext(context.Background(), p, opts...)
+}
+
+// ListUserDataIterWithContext is like ListUserDataIter, but honours the cancellation and deadline of ctx
+func (s *UserService) ListUserDataIterWithContext(ctx context.Context, p *ListUserDataParams, opts ...PageOption) iter.Seq2[*UserData, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*UserData, int, error) {
+		l, err := s.ListUserDataWithContext(ctx, &ListUserDataParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.UserData, l.Count, nil
+	}, opts...)
+}
+
 type ListUserDataResponse struct {
 	Count    int         `json:"count"`
 	UserData []*UserData `json:"userdata"`
 }
 
 type UserData struct {
-	Account        string `json:"account"`
-	Accountid      string `json:"accountid"`
-	Domain         string `json:"domain"`
-	Domainid       string `json:"domainid"`
-	Domainpath     string `json:"domainpath"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Params         string `json:"params"`
-	Project        string `json:"project"`
-	Projectid      string `json:"projectid"`
-	Userdata       string `json:"userdata"`
+	Account        string                     `json:"account"`
+	Accountid      string                     `json:"accountid"`
+	Domain         string                     `json:"domain"`
+	Domainid       string                     `json:"domainid"`
+	Domainpath     string                     `json:"domainpath"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Params         string                     `json:"params"`
+	Project        string                     `json:"project"`
+	Projectid      string                     `json:"projectid"`
+	Userdata       string                     `json:"userdata"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UserData) UnmarshalJSON(b []byte) error {
+	type alias UserData
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteUserDataParams struct {
@@ -2339,6 +3187,47 @@ func (p *DeleteUserDataParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteUserData API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteUserDataParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteUserData", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteUserData API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteUserDataParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "projectid": 255},
+	}.validate("deleteUserData", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteUserData API, before the request is signed
+func (p *DeleteUserDataParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteUserDataParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteUserDataParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteUserData", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteUserDataParams) Clone() *DeleteUserDataParams {
+	return &DeleteUserDataParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteUserDataParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2434,13 +3323,22 @@ func (s *UserService) NewDeleteUserDataParams(id string) *DeleteUserDataParams {
 
 // Deletes a userdata
 func (s *UserService) DeleteUserData(p *DeleteUserDataParams) (*DeleteUserDataResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteUserData", p.toURLValues())
+	return s.DeleteUserDataWithContext(context.Background(), p)
+}
+
+// DeleteUserDataWithContext is like DeleteUserData, but honours the cancellation and deadline of ctx
+func (s *UserService) DeleteUserDataWithContext(ctx context.Context, p *DeleteUserDataParams) (*DeleteUserDataResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteUserData", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteUserDataResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteUserData", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2448,10 +3346,11 @@ func (s *UserService) DeleteUserData(p *DeleteUserDataParams) (*DeleteUserDataRe
 }
 
 type DeleteUserDataResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteUserDataResponse) UnmarshalJSON(b []byte) error {
@@ -2478,7 +3377,7 @@ func (r *DeleteUserDataResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteUserDataResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterUserDataParams struct {
@@ -2511,6 +3410,49 @@ func (p *RegisterUserDataParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerUserData API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterUserDataParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerUserData", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerUserData API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterUserDataParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "userdata"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "name": 255, "params": 255, "projectid": 255, "userdata": 1048576},
+	}.validate("registerUserData", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerUserData API, before the request is signed
+func (p *RegisterUserDataParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterUserDataParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterUserDataParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerUserData", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"name":      decodeParam[string],
+		"params":    decodeParam[string],
+		"projectid": decodeParam[string],
+		"userdata":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterUserDataParams) Clone() *RegisterUserDataParams {
+	return &RegisterUserDataParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterUserDataParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2649,7 +3591,16 @@ func (s *UserService) NewRegisterUserDataParams(name string, userdata string) *R
 
 // Register a new User Data.
 func (s *UserService) RegisterUserData(p *RegisterUserDataParams) (*RegisterUserDataResponse, error) {
-	resp, err := s.cs.newPostRequest("registerUserData", p.toURLValues())
+	return s.RegisterUserDataWithContext(context.Background(), p)
+}
+
+// RegisterUserDataWithContext is like RegisterUserData, but honours the cancellation and deadline of ctx
+func (s *UserService) RegisterUserDataWithContext(ctx context.Context, p *RegisterUserDataParams) (*RegisterUserDataResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerUserData", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2657,7 +3608,7 @@ func (s *UserService) RegisterUserData(p *RegisterUserDataParams) (*RegisterUser
 	var nested struct {
 		Response RegisterUserDataResponse `json:"userdata"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("registerUserData", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -2680,10 +3631,11 @@ type RegisterUserDataResponse struct {
 }
 
 type RegisterUserData struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RegisterUserData) UnmarshalJSON(b []byte) error {
@@ -2710,7 +3662,7 @@ func (r *RegisterUserData) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RegisterUserData
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MoveUserParams struct {
@@ -2734,6 +3686,46 @@ func (p *MoveUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the moveUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MoveUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("moveUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the moveUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MoveUserParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "accountid": 255, "id": 255},
+	}.validate("moveUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the moveUser API, before the request is signed
+func (p *MoveUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MoveUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MoveUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("moveUser", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"accountid": decodeParam[string],
+		"id":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MoveUserParams) Clone() *MoveUserParams {
+	return &MoveUserParams{p: cloneParams(p.p)}
+}
+
 func (p *MoveUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2808,13 +3800,22 @@ func (s *UserService) NewMoveUserParams(id string) *MoveUserParams {
 
 // Moves a user to another account in the same domain.
 func (s *UserService) MoveUser(p *MoveUserParams) (*MoveUserResponse, error) {
-	resp, err := s.cs.newPostRequest("moveUser", p.toURLValues())
+	return s.MoveUserWithContext(context.Background(), p)
+}
+
+// MoveUserWithContext is like MoveUser, but honours the cancellation and deadline of ctx
+func (s *UserService) MoveUserWithContext(ctx context.Context, p *MoveUserParams) (*MoveUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "moveUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MoveUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("moveUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2822,10 +3823,11 @@ func (s *UserService) MoveUser(p *MoveUserParams) (*MoveUserResponse, error) {
 }
 
 type MoveUserResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *MoveUserResponse) UnmarshalJSON(b []byte) error {
@@ -2852,7 +3854,7 @@ func (r *MoveUserResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias MoveUserResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type SetupUserTwoFactorAuthenticationParams struct {
@@ -2877,6 +3879,45 @@ func (p *SetupUserTwoFactorAuthenticationParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the setupUserTwoFactorAuthentication API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *SetupUserTwoFactorAuthenticationParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("setupUserTwoFactorAuthentication", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the setupUserTwoFactorAuthentication API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *SetupUserTwoFactorAuthenticationParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"provider": 255, "userid": 255},
+	}.validate("setupUserTwoFactorAuthentication", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the setupUserTwoFactorAuthentication API, before the request is signed
+func (p *SetupUserTwoFactorAuthenticationParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *SetupUserTwoFactorAuthenticationParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *SetupUserTwoFactorAuthenticationParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("setupUserTwoFactorAuthentication", b, &p.p, map[string]paramDecoder{
+		"enable":   decodeParam[bool],
+		"provider": decodeParam[string],
+		"userid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *SetupUserTwoFactorAuthenticationParams) Clone() *SetupUserTwoFactorAuthenticationParams {
+	return &SetupUserTwoFactorAuthenticationParams{p: cloneParams(p.p)}
+}
+
 func (p *SetupUserTwoFactorAuthenticationParams) SetEnable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2950,7 +3991,16 @@ func (s *UserService) NewSetupUserTwoFactorAuthenticationParams() *SetupUserTwoF
 
 // Setup the 2FA for the user.
 func (s *UserService) SetupUserTwoFactorAuthentication(p *SetupUserTwoFactorAuthenticationParams) (*SetupUserTwoFactorAuthenticationResponse, error) {
-	resp, err := s.cs.newPostRequest("setupUserTwoFactorAuthentication", p.toURLValues())
+	return s.SetupUserTwoFactorAuthenticationWithContext(context.Background(), p)
+}
+
+// SetupUserTwoFactorAuthenticationWithContext is like SetupUserTwoFactorAuthentication, but honours the cancellation and deadline of ctx
+func (s *UserService) SetupUserTwoFactorAuthenticationWithContext(ctx context.Context, p *SetupUserTwoFactorAuthenticationParams) (*SetupUserTwoFactorAuthenticationResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "setupUserTwoFactorAuthentication", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2958,7 +4008,7 @@ func (s *UserService) SetupUserTwoFactorAuthentication(p *SetupUserTwoFactorAuth
 	var nested struct {
 		Response SetupUserTwoFactorAuthenticationResponse `json:"setup2fa"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("setupUserTwoFactorAuthentication", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -2967,12 +4017,18 @@ func (s *UserService) SetupUserTwoFactorAuthentication(p *SetupUserTwoFactorAuth
 }
 
 type SetupUserTwoFactorAuthenticationResponse struct {
-	Accountid  string `json:"accountid"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Secretcode string `json:"secretcode"`
-	Username   string `json:"username"`
+	Accountid  string                     `json:"accountid"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Secretcode string                     `json:"secretcode"`
+	Username   string                     `json:"username"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *SetupUserTwoFactorAuthenticationResponse) UnmarshalJSON(b []byte) error {
+	type alias SetupUserTwoFactorAuthenticationResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ValidateUserTwoFactorAuthenticationCodeParams struct {
@@ -2990,6 +4046,44 @@ func (p *ValidateUserTwoFactorAuthenticationCodeParams) toURLValues() url.Values
 	return u
 }
 
+// ValidateAgainst checks the parameters against the validateUserTwoFactorAuthenticationCode API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ValidateUserTwoFactorAuthenticationCodeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("validateUserTwoFactorAuthenticationCode", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the validateUserTwoFactorAuthenticationCode API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ValidateUserTwoFactorAuthenticationCodeParams) Validate() error {
+	return paramRules{
+		required: []string{"codefor2fa"},
+		lengths:  map[string]int{"codefor2fa": 255},
+	}.validate("validateUserTwoFactorAuthenticationCode", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the validateUserTwoFactorAuthenticationCode API, before the request is signed
+func (p *ValidateUserTwoFactorAuthenticationCodeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ValidateUserTwoFactorAuthenticationCodeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ValidateUserTwoFactorAuthenticationCodeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("validateUserTwoFactorAuthenticationCode", b, &p.p, map[string]paramDecoder{
+		"codefor2fa": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ValidateUserTwoFactorAuthenticationCodeParams) Clone() *ValidateUserTwoFactorAuthenticationCodeParams {
+	return &ValidateUserTwoFactorAuthenticationCodeParams{p: cloneParams(p.p)}
+}
+
 func (p *ValidateUserTwoFactorAuthenticationCodeParams) SetCodefor2fa(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3022,13 +4116,22 @@ func (s *UserService) NewValidateUserTwoFactorAuthenticationCodeParams(codefor2f
 
 // Checks the 2FA code for the user.
 func (s *UserService) ValidateUserTwoFactorAuthenticationCode(p *ValidateUserTwoFactorAuthenticationCodeParams) (*ValidateUserTwoFactorAuthenticationCodeResponse, error) {
-	resp, err := s.cs.newPostRequest("validateUserTwoFactorAuthenticationCode", p.toURLValues())
+	return s.ValidateUserTwoFactorAuthenticationCodeWithContext(context.Background(), p)
+}
+
+// ValidateUserTwoFactorAuthenticationCodeWithContext is like ValidateUserTwoFactorAuthenticationCode, but honours the cancellation and deadline of ctx
+func (s *UserService) ValidateUserTwoFactorAuthenticationCodeWithContext(ctx context.Context, p *ValidateUserTwoFactorAuthenticationCodeParams) (*ValidateUserTwoFactorAuthenticationCodeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "validateUserTwoFactorAuthenticationCode", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ValidateUserTwoFactorAuthenticationCodeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("validateUserTwoFactorAuthenticationCode", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3036,10 +4139,11 @@ func (s *UserService) ValidateUserTwoFactorAuthenticationCode(p *ValidateUserTwo
 }
 
 type ValidateUserTwoFactorAuthenticationCodeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ValidateUserTwoFactorAuthenticationCodeResponse) UnmarshalJSON(b []byte) error {
@@ -3066,7 +4170,7 @@ func (r *ValidateUserTwoFactorAuthenticationCodeResponse) UnmarshalJSON(b []byte
 	}
 
 	type alias ValidateUserTwoFactorAuthenticationCodeResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VerifyOAuthCodeAndGetUserParams struct {
@@ -3098,6 +4202,48 @@ func (p *VerifyOAuthCodeAndGetUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the verifyOAuthCodeAndGetUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *VerifyOAuthCodeAndGetUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("verifyOAuthCodeAndGetUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the verifyOAuthCodeAndGetUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *VerifyOAuthCodeAndGetUserParams) Validate() error {
+	return paramRules{
+		required: []string{"provider"},
+		lengths:  map[string]int{"keyword": 255, "provider": 255, "secretcode": 255},
+	}.validate("verifyOAuthCodeAndGetUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the verifyOAuthCodeAndGetUser API, before the request is signed
+func (p *VerifyOAuthCodeAndGetUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *VerifyOAuthCodeAndGetUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *VerifyOAuthCodeAndGetUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("verifyOAuthCodeAndGetUser", b, &p.p, map[string]paramDecoder{
+		"keyword":    decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+		"provider":   decodeParam[string],
+		"secretcode": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *VerifyOAuthCodeAndGetUserParams) Clone() *VerifyOAuthCodeAndGetUserParams {
+	return &VerifyOAuthCodeAndGetUserParams{p: cloneParams(p.p)}
+}
+
 func (p *VerifyOAuthCodeAndGetUserParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3214,13 +4360,22 @@ func (s *UserService) NewVerifyOAuthCodeAndGetUserParams(provider string) *Verif
 
 // Verify the OAuth Code and fetch the corresponding user from provider
 func (s *UserService) VerifyOAuthCodeAndGetUser(p *VerifyOAuthCodeAndGetUserParams) (*VerifyOAuthCodeAndGetUserResponse, error) {
-	resp, err := s.cs.newRequest("verifyOAuthCodeAndGetUser", p.toURLValues())
+	return s.VerifyOAuthCodeAndGetUserWithContext(context.Background(), p)
+}
+
+// VerifyOAuthCodeAndGetUserWithContext is like VerifyOAuthCodeAndGetUser, but honours the cancellation and deadline of ctx
+func (s *UserService) VerifyOAuthCodeAndGetUserWithContext(ctx context.Context, p *VerifyOAuthCodeAndGetUserParams) (*VerifyOAuthCodeAndGetUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "verifyOAuthCodeAndGetUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r VerifyOAuthCodeAndGetUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("verifyOAuthCodeAndGetUser", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3228,14 +4383,20 @@ func (s *UserService) VerifyOAuthCodeAndGetUser(p *VerifyOAuthCodeAndGetUserPara
 }
 
 type VerifyOAuthCodeAndGetUserResponse struct {
-	Clientid    string `json:"clientid"`
-	Description string `json:"description"`
-	Enabled     bool   `json:"enabled"`
-	Id          string `json:"id"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Name        string `json:"name"`
-	Provider    string `json:"provider"`
-	Redirecturi string `json:"redirecturi"`
-	Secretkey   string `json:"secretkey"`
+	Clientid    string                     `json:"clientid"`
+	Description string                     `json:"description"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Id          string                     `json:"id"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Name        string                     `json:"name"`
+	Provider    string                     `json:"provider"`
+	Redirecturi string                     `json:"redirecturi"`
+	Secretkey   string                     `json:"secretkey"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VerifyOAuthCodeAndGetUserResponse) UnmarshalJSON(b []byte) error {
+	type alias VerifyOAuthCodeAndGetUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
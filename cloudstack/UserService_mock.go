@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockUserServiceIfaceMockRecorder) CreateUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUser", reflect.TypeOf((*MockUserServiceIface)(nil).CreateUser), p)
 }
 
+// CreateUserWithContext mocks base method.
+func (m *MockUserServiceIface) CreateUserWithContext(ctx context.Context, p *CreateUserParams) (*CreateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateUserWithContext indicates an expected call of CreateUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) CreateUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).CreateUserWithContext), ctx, p)
+}
+
 // DeleteUser mocks base method.
 func (m *MockUserServiceIface) DeleteUser(p *DeleteUserParams) (*DeleteUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +120,36 @@ func (mr *MockUserServiceIfaceMockRecorder) DeleteUserData(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserData", reflect.TypeOf((*MockUserServiceIface)(nil).DeleteUserData), p)
 }
 
+// DeleteUserDataWithContext mocks base method.
+func (m *MockUserServiceIface) DeleteUserDataWithContext(ctx context.Context, p *DeleteUserDataParams) (*DeleteUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserDataWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserDataWithContext indicates an expected call of DeleteUserDataWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) DeleteUserDataWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserDataWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).DeleteUserDataWithContext), ctx, p)
+}
+
+// DeleteUserWithContext mocks base method.
+func (m *MockUserServiceIface) DeleteUserWithContext(ctx context.Context, p *DeleteUserParams) (*DeleteUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteUserWithContext indicates an expected call of DeleteUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) DeleteUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).DeleteUserWithContext), ctx, p)
+}
+
 // DisableUser mocks base method.
 func (m *MockUserServiceIface) DisableUser(p *DisableUserParams) (*DisableUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +165,36 @@ func (mr *MockUserServiceIfaceMockRecorder) DisableUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUser", reflect.TypeOf((*MockUserServiceIface)(nil).DisableUser), p)
 }
 
+// DisableUserAsync mocks base method.
+func (m *MockUserServiceIface) DisableUserAsync(ctx context.Context, p *DisableUserParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableUserAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableUserAsync indicates an expected call of DisableUserAsync.
+func (mr *MockUserServiceIfaceMockRecorder) DisableUserAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUserAsync", reflect.TypeOf((*MockUserServiceIface)(nil).DisableUserAsync), ctx, p)
+}
+
+// DisableUserWithContext mocks base method.
+func (m *MockUserServiceIface) DisableUserWithContext(ctx context.Context, p *DisableUserParams) (*DisableUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableUserWithContext indicates an expected call of DisableUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) DisableUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).DisableUserWithContext), ctx, p)
+}
+
 // EnableUser mocks base method.
 func (m *MockUserServiceIface) EnableUser(p *EnableUserParams) (*EnableUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +210,21 @@ func (mr *MockUserServiceIfaceMockRecorder) EnableUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUser", reflect.TypeOf((*MockUserServiceIface)(nil).EnableUser), p)
 }
 
+// EnableUserWithContext mocks base method.
+func (m *MockUserServiceIface) EnableUserWithContext(ctx context.Context, p *EnableUserParams) (*EnableUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableUserWithContext indicates an expected call of EnableUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) EnableUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).EnableUserWithContext), ctx, p)
+}
+
 // GetUser mocks base method.
 func (m *MockUserServiceIface) GetUser(p *GetUserParams) (*GetUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -169,6 +261,27 @@ func (mr *MockUserServiceIfaceMockRecorder) GetUserByID(id any, opts ...any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByID", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserByID), varargs...)
 }
 
+// GetUserByIDWithContext mocks base method.
+func (m *MockUserServiceIface) GetUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*User, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*User)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserByIDWithContext indicates an expected call of GetUserByIDWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) GetUserByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserByIDWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserByIDWithContext), varargs...)
+}
+
 // GetUserDataByID mocks base method.
 func (m *MockUserServiceIface) GetUserDataByID(id string, opts ...OptionFunc) (*UserData, int, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +303,27 @@ func (mr *MockUserServiceIfaceMockRecorder) GetUserDataByID(id any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataByID", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserDataByID), varargs...)
 }
 
+// GetUserDataByIDWithContext mocks base method.
+func (m *MockUserServiceIface) GetUserDataByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*UserData, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*UserData)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataByIDWithContext indicates an expected call of GetUserDataByIDWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) GetUserDataByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataByIDWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserDataByIDWithContext), varargs...)
+}
+
 // GetUserDataByName mocks base method.
 func (m *MockUserServiceIface) GetUserDataByName(name string, opts ...OptionFunc) (*UserData, int, error) {
 	m.ctrl.T.Helper()
@@ -211,6 +345,27 @@ func (mr *MockUserServiceIfaceMockRecorder) GetUserDataByName(name any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataByName", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserDataByName), varargs...)
 }
 
+// GetUserDataByNameWithContext mocks base method.
+func (m *MockUserServiceIface) GetUserDataByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*UserData, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*UserData)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataByNameWithContext indicates an expected call of GetUserDataByNameWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) GetUserDataByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataByNameWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserDataByNameWithContext), varargs...)
+}
+
 // GetUserDataID mocks base method.
 func (m *MockUserServiceIface) GetUserDataID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -232,6 +387,27 @@ func (mr *MockUserServiceIfaceMockRecorder) GetUserDataID(name any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataID", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserDataID), varargs...)
 }
 
+// GetUserDataIDWithContext mocks base method.
+func (m *MockUserServiceIface) GetUserDataIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetUserDataIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetUserDataIDWithContext indicates an expected call of GetUserDataIDWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) GetUserDataIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserDataIDWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserDataIDWithContext), varargs...)
+}
+
 // GetUserKeys mocks base method.
 func (m *MockUserServiceIface) GetUserKeys(p *GetUserKeysParams) (*GetUserKeysResponse, error) {
 	m.ctrl.T.Helper()
@@ -247,6 +423,36 @@ func (mr *MockUserServiceIfaceMockRecorder) GetUserKeys(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserKeys", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserKeys), p)
 }
 
+// GetUserKeysWithContext mocks base method.
+func (m *MockUserServiceIface) GetUserKeysWithContext(ctx context.Context, p *GetUserKeysParams) (*GetUserKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserKeysWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetUserKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserKeysWithContext indicates an expected call of GetUserKeysWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) GetUserKeysWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserKeysWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserKeysWithContext), ctx, p)
+}
+
+// GetUserWithContext mocks base method.
+func (m *MockUserServiceIface) GetUserWithContext(ctx context.Context, p *GetUserParams) (*GetUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUserWithContext indicates an expected call of GetUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) GetUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).GetUserWithContext), ctx, p)
+}
+
 // GetVirtualMachineUserData mocks base method.
 func (m *MockUserServiceIface) GetVirtualMachineUserData(p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +468,21 @@ func (mr *MockUserServiceIfaceMockRecorder) GetVirtualMachineUserData(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineUserData", reflect.TypeOf((*MockUserServiceIface)(nil).GetVirtualMachineUserData), p)
 }
 
+// GetVirtualMachineUserDataWithContext mocks base method.
+func (m *MockUserServiceIface) GetVirtualMachineUserDataWithContext(ctx context.Context, p *GetVirtualMachineUserDataParams) (*GetVirtualMachineUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVirtualMachineUserDataWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetVirtualMachineUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVirtualMachineUserDataWithContext indicates an expected call of GetVirtualMachineUserDataWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) GetVirtualMachineUserDataWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineUserDataWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).GetVirtualMachineUserDataWithContext), ctx, p)
+}
+
 // ListUserData mocks base method.
 func (m *MockUserServiceIface) ListUserData(p *ListUserDataParams) (*ListUserDataResponse, error) {
 	m.ctrl.T.Helper()
@@ -277,6 +498,99 @@ func (mr *MockUserServiceIfaceMockRecorder) ListUserData(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserData", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserData), p)
 }
 
+// ListUserDataAll mocks base method.
+func (m *MockUserServiceIface) ListUserDataAll(p *ListUserDataParams, opts ...PageOption) ([]*UserData, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUserDataAll", varargs...)
+	ret0, _ := ret[0].([]*UserData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserDataAll indicates an expected call of ListUserDataAll.
+func (mr *MockUserServiceIfaceMockRecorder) ListUserDataAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserDataAll", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserDataAll), varargs...)
+}
+
+// ListUserDataAllWithContext mocks base method.
+func (m *MockUserServiceIface) ListUserDataAllWithContext(ctx context.Context, p *ListUserDataParams, opts ...PageOption) ([]*UserData, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUserDataAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*UserData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserDataAllWithContext indicates an expected call of ListUserDataAllWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ListUserDataAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserDataAllWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserDataAllWithContext), varargs...)
+}
+
+// ListUserDataIter mocks base method.
+func (m *MockUserServiceIface) ListUserDataIter(p *ListUserDataParams, opts ...PageOption) iter.Seq2[*UserData, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUserDataIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UserData, error])
+	return ret0
+}
+
+// ListUserDataIter indicates an expected call of ListUserDataIter.
+func (mr *MockUserServiceIfaceMockRecorder) ListUserDataIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserDataIter", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserDataIter), varargs...)
+}
+
+// ListUserDataIterWithContext mocks base method.
+func (m *MockUserServiceIface) ListUserDataIterWithContext(ctx context.Context, p *ListUserDataParams, opts ...PageOption) iter.Seq2[*UserData, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUserDataIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UserData, error])
+	return ret0
+}
+
+// ListUserDataIterWithContext indicates an expected call of ListUserDataIterWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ListUserDataIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserDataIterWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserDataIterWithContext), varargs...)
+}
+
+// ListUserDataWithContext mocks base method.
+func (m *MockUserServiceIface) ListUserDataWithContext(ctx context.Context, p *ListUserDataParams) (*ListUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserDataWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserDataWithContext indicates an expected call of ListUserDataWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ListUserDataWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserDataWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserDataWithContext), ctx, p)
+}
+
 // ListUserTwoFactorAuthenticatorProviders mocks base method.
 func (m *MockUserServiceIface) ListUserTwoFactorAuthenticatorProviders(p *ListUserTwoFactorAuthenticatorProvidersParams) (*ListUserTwoFactorAuthenticatorProvidersResponse, error) {
 	m.ctrl.T.Helper()
@@ -292,6 +606,21 @@ func (mr *MockUserServiceIfaceMockRecorder) ListUserTwoFactorAuthenticatorProvid
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserTwoFactorAuthenticatorProviders", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserTwoFactorAuthenticatorProviders), p)
 }
 
+// ListUserTwoFactorAuthenticatorProvidersWithContext mocks base method.
+func (m *MockUserServiceIface) ListUserTwoFactorAuthenticatorProvidersWithContext(ctx context.Context, p *ListUserTwoFactorAuthenticatorProvidersParams) (*ListUserTwoFactorAuthenticatorProvidersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUserTwoFactorAuthenticatorProvidersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUserTwoFactorAuthenticatorProvidersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUserTwoFactorAuthenticatorProvidersWithContext indicates an expected call of ListUserTwoFactorAuthenticatorProvidersWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ListUserTwoFactorAuthenticatorProvidersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUserTwoFactorAuthenticatorProvidersWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ListUserTwoFactorAuthenticatorProvidersWithContext), ctx, p)
+}
+
 // ListUsers mocks base method.
 func (m *MockUserServiceIface) ListUsers(p *ListUsersParams) (*ListUsersResponse, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +636,99 @@ func (mr *MockUserServiceIfaceMockRecorder) ListUsers(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsers", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsers), p)
 }
 
+// ListUsersAll mocks base method.
+func (m *MockUserServiceIface) ListUsersAll(p *ListUsersParams, opts ...PageOption) ([]*User, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsersAll", varargs...)
+	ret0, _ := ret[0].([]*User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersAll indicates an expected call of ListUsersAll.
+func (mr *MockUserServiceIfaceMockRecorder) ListUsersAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersAll", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsersAll), varargs...)
+}
+
+// ListUsersAllWithContext mocks base method.
+func (m *MockUserServiceIface) ListUsersAllWithContext(ctx context.Context, p *ListUsersParams, opts ...PageOption) ([]*User, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*User)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersAllWithContext indicates an expected call of ListUsersAllWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ListUsersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersAllWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsersAllWithContext), varargs...)
+}
+
+// ListUsersIter mocks base method.
+func (m *MockUserServiceIface) ListUsersIter(p *ListUsersParams, opts ...PageOption) iter.Seq2[*User, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*User, error])
+	return ret0
+}
+
+// ListUsersIter indicates an expected call of ListUsersIter.
+func (mr *MockUserServiceIfaceMockRecorder) ListUsersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersIter", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsersIter), varargs...)
+}
+
+// ListUsersIterWithContext mocks base method.
+func (m *MockUserServiceIface) ListUsersIterWithContext(ctx context.Context, p *ListUsersParams, opts ...PageOption) iter.Seq2[*User, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUsersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*User, error])
+	return ret0
+}
+
+// ListUsersIterWithContext indicates an expected call of ListUsersIterWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ListUsersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersIterWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsersIterWithContext), varargs...)
+}
+
+// ListUsersWithContext mocks base method.
+func (m *MockUserServiceIface) ListUsersWithContext(ctx context.Context, p *ListUsersParams) (*ListUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUsersWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUsersResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUsersWithContext indicates an expected call of ListUsersWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ListUsersWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUsersWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ListUsersWithContext), ctx, p)
+}
+
 // LockUser mocks base method.
 func (m *MockUserServiceIface) LockUser(p *LockUserParams) (*LockUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +744,21 @@ func (mr *MockUserServiceIfaceMockRecorder) LockUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUser", reflect.TypeOf((*MockUserServiceIface)(nil).LockUser), p)
 }
 
+// LockUserWithContext mocks base method.
+func (m *MockUserServiceIface) LockUserWithContext(ctx context.Context, p *LockUserParams) (*LockUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "LockUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*LockUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// LockUserWithContext indicates an expected call of LockUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) LockUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "LockUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).LockUserWithContext), ctx, p)
+}
+
 // MoveUser mocks base method.
 func (m *MockUserServiceIface) MoveUser(p *MoveUserParams) (*MoveUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -337,6 +774,21 @@ func (mr *MockUserServiceIfaceMockRecorder) MoveUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveUser", reflect.TypeOf((*MockUserServiceIface)(nil).MoveUser), p)
 }
 
+// MoveUserWithContext mocks base method.
+func (m *MockUserServiceIface) MoveUserWithContext(ctx context.Context, p *MoveUserParams) (*MoveUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MoveUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*MoveUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MoveUserWithContext indicates an expected call of MoveUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) MoveUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MoveUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).MoveUserWithContext), ctx, p)
+}
+
 // NewCreateUserParams mocks base method.
 func (m *MockUserServiceIface) NewCreateUserParams(account, email, firstname, lastname, password, username string) *CreateUserParams {
 	m.ctrl.T.Helper()
@@ -618,6 +1070,21 @@ func (mr *MockUserServiceIfaceMockRecorder) RegisterUserData(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserData", reflect.TypeOf((*MockUserServiceIface)(nil).RegisterUserData), p)
 }
 
+// RegisterUserDataWithContext mocks base method.
+func (m *MockUserServiceIface) RegisterUserDataWithContext(ctx context.Context, p *RegisterUserDataParams) (*RegisterUserDataResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterUserDataWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterUserDataResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterUserDataWithContext indicates an expected call of RegisterUserDataWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) RegisterUserDataWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserDataWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).RegisterUserDataWithContext), ctx, p)
+}
+
 // RegisterUserKeys mocks base method.
 func (m *MockUserServiceIface) RegisterUserKeys(p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
 	m.ctrl.T.Helper()
@@ -633,6 +1100,51 @@ func (mr *MockUserServiceIfaceMockRecorder) RegisterUserKeys(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserKeys", reflect.TypeOf((*MockUserServiceIface)(nil).RegisterUserKeys), p)
 }
 
+// RegisterUserKeysWithContext mocks base method.
+func (m *MockUserServiceIface) RegisterUserKeysWithContext(ctx context.Context, p *RegisterUserKeysParams) (*RegisterUserKeysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterUserKeysWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterUserKeysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterUserKeysWithContext indicates an expected call of RegisterUserKeysWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) RegisterUserKeysWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterUserKeysWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).RegisterUserKeysWithContext), ctx, p)
+}
+
+// RotateUserKeys mocks base method.
+func (m *MockUserServiceIface) RotateUserKeys(userid string) (*RotatedUserKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateUserKeys", userid)
+	ret0, _ := ret[0].(*RotatedUserKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateUserKeys indicates an expected call of RotateUserKeys.
+func (mr *MockUserServiceIfaceMockRecorder) RotateUserKeys(userid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateUserKeys", reflect.TypeOf((*MockUserServiceIface)(nil).RotateUserKeys), userid)
+}
+
+// RotateUserKeysWithContext mocks base method.
+func (m *MockUserServiceIface) RotateUserKeysWithContext(ctx context.Context, userid string) (*RotatedUserKeys, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RotateUserKeysWithContext", ctx, userid)
+	ret0, _ := ret[0].(*RotatedUserKeys)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RotateUserKeysWithContext indicates an expected call of RotateUserKeysWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) RotateUserKeysWithContext(ctx, userid any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RotateUserKeysWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).RotateUserKeysWithContext), ctx, userid)
+}
+
 // SetupUserTwoFactorAuthentication mocks base method.
 func (m *MockUserServiceIface) SetupUserTwoFactorAuthentication(p *SetupUserTwoFactorAuthenticationParams) (*SetupUserTwoFactorAuthenticationResponse, error) {
 	m.ctrl.T.Helper()
@@ -648,6 +1160,21 @@ func (mr *MockUserServiceIfaceMockRecorder) SetupUserTwoFactorAuthentication(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupUserTwoFactorAuthentication", reflect.TypeOf((*MockUserServiceIface)(nil).SetupUserTwoFactorAuthentication), p)
 }
 
+// SetupUserTwoFactorAuthenticationWithContext mocks base method.
+func (m *MockUserServiceIface) SetupUserTwoFactorAuthenticationWithContext(ctx context.Context, p *SetupUserTwoFactorAuthenticationParams) (*SetupUserTwoFactorAuthenticationResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetupUserTwoFactorAuthenticationWithContext", ctx, p)
+	ret0, _ := ret[0].(*SetupUserTwoFactorAuthenticationResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetupUserTwoFactorAuthenticationWithContext indicates an expected call of SetupUserTwoFactorAuthenticationWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) SetupUserTwoFactorAuthenticationWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupUserTwoFactorAuthenticationWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).SetupUserTwoFactorAuthenticationWithContext), ctx, p)
+}
+
 // UpdateUser mocks base method.
 func (m *MockUserServiceIface) UpdateUser(p *UpdateUserParams) (*UpdateUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -663,6 +1190,21 @@ func (mr *MockUserServiceIfaceMockRecorder) UpdateUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUser", reflect.TypeOf((*MockUserServiceIface)(nil).UpdateUser), p)
 }
 
+// UpdateUserWithContext mocks base method.
+func (m *MockUserServiceIface) UpdateUserWithContext(ctx context.Context, p *UpdateUserParams) (*UpdateUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateUserWithContext indicates an expected call of UpdateUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) UpdateUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).UpdateUserWithContext), ctx, p)
+}
+
 // ValidateUserTwoFactorAuthenticationCode mocks base method.
 func (m *MockUserServiceIface) ValidateUserTwoFactorAuthenticationCode(p *ValidateUserTwoFactorAuthenticationCodeParams) (*ValidateUserTwoFactorAuthenticationCodeResponse, error) {
 	m.ctrl.T.Helper()
@@ -678,6 +1220,21 @@ func (mr *MockUserServiceIfaceMockRecorder) ValidateUserTwoFactorAuthenticationC
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserTwoFactorAuthenticationCode", reflect.TypeOf((*MockUserServiceIface)(nil).ValidateUserTwoFactorAuthenticationCode), p)
 }
 
+// ValidateUserTwoFactorAuthenticationCodeWithContext mocks base method.
+func (m *MockUserServiceIface) ValidateUserTwoFactorAuthenticationCodeWithContext(ctx context.Context, p *ValidateUserTwoFactorAuthenticationCodeParams) (*ValidateUserTwoFactorAuthenticationCodeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ValidateUserTwoFactorAuthenticationCodeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ValidateUserTwoFactorAuthenticationCodeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ValidateUserTwoFactorAuthenticationCodeWithContext indicates an expected call of ValidateUserTwoFactorAuthenticationCodeWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) ValidateUserTwoFactorAuthenticationCodeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateUserTwoFactorAuthenticationCodeWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).ValidateUserTwoFactorAuthenticationCodeWithContext), ctx, p)
+}
+
 // VerifyOAuthCodeAndGetUser mocks base method.
 func (m *MockUserServiceIface) VerifyOAuthCodeAndGetUser(p *VerifyOAuthCodeAndGetUserParams) (*VerifyOAuthCodeAndGetUserResponse, error) {
 	m.ctrl.T.Helper()
@@ -692,3 +1249,18 @@ func (mr *MockUserServiceIfaceMockRecorder) VerifyOAuthCodeAndGetUser(p any) *go
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyOAuthCodeAndGetUser", reflect.TypeOf((*MockUserServiceIface)(nil).VerifyOAuthCodeAndGetUser), p)
 }
+
+// VerifyOAuthCodeAndGetUserWithContext mocks base method.
+func (m *MockUserServiceIface) VerifyOAuthCodeAndGetUserWithContext(ctx context.Context, p *VerifyOAuthCodeAndGetUserParams) (*VerifyOAuthCodeAndGetUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VerifyOAuthCodeAndGetUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*VerifyOAuthCodeAndGetUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VerifyOAuthCodeAndGetUserWithContext indicates an expected call of VerifyOAuthCodeAndGetUserWithContext.
+func (mr *MockUserServiceIfaceMockRecorder) VerifyOAuthCodeAndGetUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VerifyOAuthCodeAndGetUserWithContext", reflect.TypeOf((*MockUserServiceIface)(nil).VerifyOAuthCodeAndGetUserWithContext), ctx, p)
+}
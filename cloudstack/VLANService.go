@@ -20,31 +20,55 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type VLANServiceIface interface {
 	CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
+	CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error)
 	NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams
 	DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
+	DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error)
 	NewDedicateGuestVlanRangeParams(physicalnetworkid string, vlanrange string) *DedicateGuestVlanRangeParams
 	DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
+	DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error)
 	NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangeParams
 	ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error)
+	ListDedicatedGuestVlanRangesAll(p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) ([]*DedicatedGuestVlanRange, error)
+	ListDedicatedGuestVlanRangesAllWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) ([]*DedicatedGuestVlanRange, error)
+	ListDedicatedGuestVlanRangesIter(p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) iter.Seq2[*DedicatedGuestVlanRange, error]
+	ListDedicatedGuestVlanRangesIterWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) iter.Seq2[*DedicatedGuestVlanRange, error]
 	NewListDedicatedGuestVlanRangesParams() *ListDedicatedGuestVlanRangesParams
 	GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
+	GetDedicatedGuestVlanRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error)
 	ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error)
+	ListVlanIpRangesAll(p *ListVlanIpRangesParams, opts ...PageOption) ([]*VlanIpRange, error)
+	ListVlanIpRangesAllWithContext(ctx context.Context, p *ListVlanIpRangesParams, opts ...PageOption) ([]*VlanIpRange, error)
+	ListVlanIpRangesIter(p *ListVlanIpRangesParams, opts ...PageOption) iter.Seq2[*VlanIpRange, error]
+	ListVlanIpRangesIterWithContext(ctx context.Context, p *ListVlanIpRangesParams, opts ...PageOption) iter.Seq2[*VlanIpRange, error]
 	NewListVlanIpRangesParams() *ListVlanIpRangesParams
 	GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error)
+	GetVlanIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VlanIpRange, int, error)
 	ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error)
+	ReleaseDedicatedGuestVlanRangeAsync(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*Job, error)
 	NewReleaseDedicatedGuestVlanRangeParams(id string) *ReleaseDedicatedGuestVlanRangeParams
 	ListGuestVlans(p *ListGuestVlansParams) (*ListGuestVlansResponse, error)
+	ListGuestVlansWithContext(ctx context.Context, p *ListGuestVlansParams) (*ListGuestVlansResponse, error)
+	ListGuestVlansAll(p *ListGuestVlansParams, opts ...PageOption) ([]*GuestVlan, error)
+	ListGuestVlansAllWithContext(ctx context.Context, p *ListGuestVlansParams, opts ...PageOption) ([]*GuestVlan, error)
+	ListGuestVlansIter(p *ListGuestVlansParams, opts ...PageOption) iter.Seq2[*GuestVlan, error]
+	ListGuestVlansIterWithContext(ctx context.Context, p *ListGuestVlansParams, opts ...PageOption) iter.Seq2[*GuestVlan, error]
 	NewListGuestVlansParams() *ListGuestVlansParams
 	UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error)
+	UpdateVlanIpRangeWithContext(ctx context.Context, p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error)
 	NewUpdateVlanIpRangeParams(id string) *UpdateVlanIpRangeParams
 }
 
@@ -119,6 +143,61 @@ func (p *CreateVlanIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVlanIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVlanIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVlanIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVlanIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVlanIpRangeParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "endip": 255, "endipv6": 255, "gateway": 255, "ip6cidr": 255, "ip6gateway": 255, "netmask": 255, "networkid": 255, "physicalnetworkid": 255, "podid": 255, "projectid": 255, "provider": 255, "startip": 255, "startipv6": 255, "vlan": 255, "zoneid": 255},
+	}.validate("createVlanIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVlanIpRange API, before the request is signed
+func (p *CreateVlanIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVlanIpRange", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"domainid":          decodeParam[string],
+		"endip":             decodeParam[string],
+		"endipv6":           decodeParam[string],
+		"forsystemvms":      decodeParam[bool],
+		"forvirtualnetwork": decodeParam[bool],
+		"gateway":           decodeParam[string],
+		"ip6cidr":           decodeParam[string],
+		"ip6gateway":        decodeParam[string],
+		"netmask":           decodeParam[string],
+		"networkid":         decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"podid":             decodeParam[string],
+		"projectid":         decodeParam[string],
+		"provider":          decodeParam[string],
+		"startip":           decodeParam[string],
+		"startipv6":         decodeParam[string],
+		"vlan":              decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVlanIpRangeParams) Clone() *CreateVlanIpRangeParams {
+	return &CreateVlanIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVlanIpRangeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -528,7 +607,16 @@ func (s *VLANService) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
 
 // Creates a VLAN IP range.
 func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("createVlanIpRange", p.toURLValues())
+	return s.CreateVlanIpRangeWithContext(context.Background(), p)
+}
+
+// CreateVlanIpRangeWithContext is like CreateVlanIpRange, but honours the cancellation and deadline of ctx
+func (s *VLANService) CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createVlanIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -538,7 +626,7 @@ func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlan
 	}
 
 	var r CreateVlanIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVlanIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -546,34 +634,40 @@ func (s *VLANService) CreateVlanIpRange(p *CreateVlanIpRangeParams) (*CreateVlan
 }
 
 type CreateVlanIpRangeResponse struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Description       string `json:"description"`
-	Domain            string `json:"domain"`
-	Domainid          string `json:"domainid"`
-	Domainpath        string `json:"domainpath"`
-	Endip             string `json:"endip"`
-	Endipv6           string `json:"endipv6"`
-	Forsystemvms      bool   `json:"forsystemvms"`
-	Forvirtualnetwork bool   `json:"forvirtualnetwork"`
-	Gateway           string `json:"gateway"`
-	Id                string `json:"id"`
-	Ip6cidr           string `json:"ip6cidr"`
-	Ip6gateway        string `json:"ip6gateway"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Netmask           string `json:"netmask"`
-	Networkid         string `json:"networkid"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Project           string `json:"project"`
-	Projectid         string `json:"projectid"`
-	Provider          string `json:"provider"`
-	Startip           string `json:"startip"`
-	Startipv6         string `json:"startipv6"`
-	Vlan              string `json:"vlan"`
-	Zoneid            string `json:"zoneid"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Description       string                     `json:"description"`
+	Domain            string                     `json:"domain"`
+	Domainid          string                     `json:"domainid"`
+	Domainpath        string                     `json:"domainpath"`
+	Endip             string                     `json:"endip"`
+	Endipv6           string                     `json:"endipv6"`
+	Forsystemvms      FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork FlexBool                   `json:"forvirtualnetwork"`
+	Gateway           string                     `json:"gateway"`
+	Id                string                     `json:"id"`
+	Ip6cidr           string                     `json:"ip6cidr"`
+	Ip6gateway        string                     `json:"ip6gateway"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Netmask           string                     `json:"netmask"`
+	Networkid         string                     `json:"networkid"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Project           string                     `json:"project"`
+	Projectid         string                     `json:"projectid"`
+	Provider          string                     `json:"provider"`
+	Startip           string                     `json:"startip"`
+	Startipv6         string                     `json:"startipv6"`
+	Vlan              string                     `json:"vlan"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVlanIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVlanIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicateGuestVlanRangeParams struct {
@@ -603,6 +697,48 @@ func (p *DedicateGuestVlanRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the dedicateGuestVlanRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicateGuestVlanRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicateGuestVlanRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicateGuestVlanRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicateGuestVlanRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"physicalnetworkid", "vlanrange"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "physicalnetworkid": 255, "projectid": 255, "vlanrange": 255},
+	}.validate("dedicateGuestVlanRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicateGuestVlanRange API, before the request is signed
+func (p *DedicateGuestVlanRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicateGuestVlanRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicateGuestVlanRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicateGuestVlanRange", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"domainid":          decodeParam[string],
+		"physicalnetworkid": decodeParam[string],
+		"projectid":         decodeParam[string],
+		"vlanrange":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicateGuestVlanRangeParams) Clone() *DedicateGuestVlanRangeParams {
+	return &DedicateGuestVlanRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *DedicateGuestVlanRangeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -720,7 +856,16 @@ func (s *VLANService) NewDedicateGuestVlanRangeParams(physicalnetworkid string,
 
 // Dedicates a guest vlan range to an account
 func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicateGuestVlanRange", p.toURLValues())
+	return s.DedicateGuestVlanRangeWithContext(context.Background(), p)
+}
+
+// DedicateGuestVlanRangeWithContext is like DedicateGuestVlanRange, but honours the cancellation and deadline of ctx
+func (s *VLANService) DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicateGuestVlanRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -730,7 +875,7 @@ func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*
 	}
 
 	var r DedicateGuestVlanRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicateGuestVlanRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -738,18 +883,24 @@ func (s *VLANService) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*
 }
 
 type DedicateGuestVlanRangeResponse struct {
-	Account           string `json:"account"`
-	Domain            string `json:"domain"`
-	Domainid          string `json:"domainid"`
-	Domainpath        string `json:"domainpath"`
-	Guestvlanrange    string `json:"guestvlanrange"`
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid int64  `json:"physicalnetworkid"`
-	Project           string `json:"project"`
-	Projectid         string `json:"projectid"`
-	Zoneid            int64  `json:"zoneid"`
+	Account           string                     `json:"account"`
+	Domain            string                     `json:"domain"`
+	Domainid          string                     `json:"domainid"`
+	Domainpath        string                     `json:"domainpath"`
+	Guestvlanrange    string                     `json:"guestvlanrange"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid FlexInt64                  `json:"physicalnetworkid"`
+	Project           string                     `json:"project"`
+	Projectid         string                     `json:"projectid"`
+	Zoneid            FlexInt64                  `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicateGuestVlanRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicateGuestVlanRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVlanIpRangeParams struct {
@@ -767,6 +918,44 @@ func (p *DeleteVlanIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVlanIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVlanIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVlanIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVlanIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVlanIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVlanIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVlanIpRange API, before the request is signed
+func (p *DeleteVlanIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVlanIpRange", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVlanIpRangeParams) Clone() *DeleteVlanIpRangeParams {
+	return &DeleteVlanIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVlanIpRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -799,13 +988,22 @@ func (s *VLANService) NewDeleteVlanIpRangeParams(id string) *DeleteVlanIpRangePa
 
 // Deletes a VLAN IP range.
 func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVlanIpRange", p.toURLValues())
+	return s.DeleteVlanIpRangeWithContext(context.Background(), p)
+}
+
+// DeleteVlanIpRangeWithContext is like DeleteVlanIpRange, but honours the cancellation and deadline of ctx
+func (s *VLANService) DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVlanIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVlanIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVlanIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -813,10 +1011,11 @@ func (s *VLANService) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlan
 }
 
 type DeleteVlanIpRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteVlanIpRangeResponse) UnmarshalJSON(b []byte) error {
@@ -843,7 +1042,7 @@ func (r *DeleteVlanIpRangeResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteVlanIpRangeResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDedicatedGuestVlanRangesParams struct {
@@ -890,6 +1089,52 @@ func (p *ListDedicatedGuestVlanRangesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDedicatedGuestVlanRanges API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDedicatedGuestVlanRangesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDedicatedGuestVlanRanges", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDedicatedGuestVlanRanges API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDedicatedGuestVlanRangesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "guestvlanrange": 255, "id": 255, "keyword": 255, "physicalnetworkid": 255, "projectid": 255, "zoneid": 255},
+	}.validate("listDedicatedGuestVlanRanges", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDedicatedGuestVlanRanges API, before the request is signed
+func (p *ListDedicatedGuestVlanRangesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDedicatedGuestVlanRangesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDedicatedGuestVlanRangesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDedicatedGuestVlanRanges", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"domainid":          decodeParam[string],
+		"guestvlanrange":    decodeParam[string],
+		"id":                decodeParam[string],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+		"projectid":         decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDedicatedGuestVlanRangesParams) Clone() *ListDedicatedGuestVlanRangesParams {
+	return &ListDedicatedGuestVlanRangesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDedicatedGuestVlanRangesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1110,6 +1355,11 @@ func (s *VLANService) NewListDedicatedGuestVlanRangesParams() *ListDedicatedGues
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
+	return s.GetDedicatedGuestVlanRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetDedicatedGuestVlanRangeByIDWithContext is like GetDedicatedGuestVlanRangeByID, but honours the cancellation and deadline of ctx
+func (s *VLANService) GetDedicatedGuestVlanRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
 	p := &ListDedicatedGuestVlanRangesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1121,11 +1371,10 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 		}
 	}
 
-	l, err := s.ListDedicatedGuestVlanRanges(p)
+	l, err := s.ListDedicatedGuestVlanRangesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1143,37 +1392,80 @@ func (s *VLANService) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFu
 
 // Lists dedicated guest vlan ranges
 func (s *VLANService) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedGuestVlanRanges", p.toURLValues())
+	return s.ListDedicatedGuestVlanRangesWithContext(context.Background(), p)
+}
+
+// ListDedicatedGuestVlanRangesWithContext is like ListDedicatedGuestVlanRanges, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDedicatedGuestVlanRanges", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDedicatedGuestVlanRangesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDedicatedGuestVlanRanges", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDedicatedGuestVlanRangesAll returns all DedicatedGuestVlanRanges matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VLANService) ListDedicatedGuestVlanRangesAll(p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) ([]*DedicatedGuestVlanRange, error) {
+	return s.ListDedicatedGuestVlanRangesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedGuestVlanRangesAllWithContext is like ListDedicatedGuestVlanRangesAll, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListDedicatedGuestVlanRangesAllWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) ([]*DedicatedGuestVlanRange, error) {
+	return collectPages(s.ListDedicatedGuestVlanRangesIterWithContext(ctx, p, opts...))
+}
+
+// ListDedicatedGuestVlanRangesIter returns an iterator over all DedicatedGuestVlanRanges matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VLANService) ListDedicatedGuestVlanRangesIter(p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) iter.Seq2[*DedicatedGuestVlanRange, error] {
+	return s.ListDedicatedGuestVlanRangesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedGuestVlanRangesIterWithContext is like ListDedicatedGuestVlanRangesIter, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListDedicatedGuestVlanRangesIterWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) iter.Seq2[*DedicatedGuestVlanRange, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DedicatedGuestVlanRange, int, error) {
+		l, err := s.ListDedicatedGuestVlanRangesWithContext(ctx, &ListDedicatedGuestVlanRangesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DedicatedGuestVlanRanges, l.Count, nil
+	}, opts...)
+}
+
 type ListDedicatedGuestVlanRangesResponse struct {
 	Count                    int                        `json:"count"`
 	DedicatedGuestVlanRanges []*DedicatedGuestVlanRange `json:"dedicatedguestvlanrange"`
 }
 
 type DedicatedGuestVlanRange struct {
-	Account           string `json:"account"`
-	Domain            string `json:"domain"`
-	Domainid          string `json:"domainid"`
-	Domainpath        string `json:"domainpath"`
-	Guestvlanrange    string `json:"guestvlanrange"`
-	Id                string `json:"id"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Physicalnetworkid int64  `json:"physicalnetworkid"`
-	Project           string `json:"project"`
-	Projectid         string `json:"projectid"`
-	Zoneid            int64  `json:"zoneid"`
+	Account           string                     `json:"account"`
+	Domain            string                     `json:"domain"`
+	Domainid          string                     `json:"domainid"`
+	Domainpath        string                     `json:"domainpath"`
+	Guestvlanrange    string                     `json:"guestvlanrange"`
+	Id                string                     `json:"id"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Physicalnetworkid FlexInt64                  `json:"physicalnetworkid"`
+	Project           string                     `json:"project"`
+	Projectid         string                     `json:"projectid"`
+	Zoneid            FlexInt64                  `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicatedGuestVlanRange) UnmarshalJSON(b []byte) error {
+	type alias DedicatedGuestVlanRange
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVlanIpRangesParams struct {
@@ -1230,6 +1522,55 @@ func (p *ListVlanIpRangesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVlanIpRanges API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVlanIpRangesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVlanIpRanges", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVlanIpRanges API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVlanIpRangesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "networkid": 255, "physicalnetworkid": 255, "podid": 255, "projectid": 255, "vlan": 255, "zoneid": 255},
+	}.validate("listVlanIpRanges", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVlanIpRanges API, before the request is signed
+func (p *ListVlanIpRangesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVlanIpRangesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVlanIpRangesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVlanIpRanges", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"domainid":          decodeParam[string],
+		"forvirtualnetwork": decodeParam[bool],
+		"id":                decodeParam[string],
+		"keyword":           decodeParam[string],
+		"networkid":         decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+		"podid":             decodeParam[string],
+		"projectid":         decodeParam[string],
+		"vlan":              decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVlanIpRangesParams) Clone() *ListVlanIpRangesParams {
+	return &ListVlanIpRangesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVlanIpRangesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1513,6 +1854,11 @@ func (s *VLANService) NewListVlanIpRangesParams() *ListVlanIpRangesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
+	return s.GetVlanIpRangeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVlanIpRangeByIDWithContext is like GetVlanIpRangeByID, but honours the cancellation and deadline of ctx
+func (s *VLANService) GetVlanIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
 	p := &ListVlanIpRangesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1524,11 +1870,10 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 		}
 	}
 
-	l, err := s.ListVlanIpRanges(p)
+	l, err := s.ListVlanIpRangesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1546,53 +1891,96 @@ func (s *VLANService) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIp
 
 // Lists all VLAN IP ranges.
 func (s *VLANService) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
-	resp, err := s.cs.newRequest("listVlanIpRanges", p.toURLValues())
+	return s.ListVlanIpRangesWithContext(context.Background(), p)
+}
+
+// ListVlanIpRangesWithContext is like ListVlanIpRanges, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVlanIpRanges", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVlanIpRangesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVlanIpRanges", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVlanIpRangesAll returns all VlanIpRanges matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VLANService) ListVlanIpRangesAll(p *ListVlanIpRangesParams, opts ...PageOption) ([]*VlanIpRange, error) {
+	return s.ListVlanIpRangesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVlanIpRangesAllWithContext is like ListVlanIpRangesAll, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListVlanIpRangesAllWithContext(ctx context.Context, p *ListVlanIpRangesParams, opts ...PageOption) ([]*VlanIpRange, error) {
+	return collectPages(s.ListVlanIpRangesIterWithContext(ctx, p, opts...))
+}
+
+// ListVlanIpRangesIter returns an iterator over all VlanIpRanges matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VLANService) ListVlanIpRangesIter(p *ListVlanIpRangesParams, opts ...PageOption) iter.Seq2[*VlanIpRange, error] {
+	return s.ListVlanIpRangesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVlanIpRangesIterWithContext is like ListVlanIpRangesIter, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListVlanIpRangesIterWithContext(ctx context.Context, p *ListVlanIpRangesParams, opts ...PageOption) iter.Seq2[*VlanIpRange, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VlanIpRange, int, error) {
+		l, err := s.ListVlanIpRangesWithContext(ctx, &ListVlanIpRangesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VlanIpRanges, l.Count, nil
+	}, opts...)
+}
+
 type ListVlanIpRangesResponse struct {
 	Count        int            `json:"count"`
 	VlanIpRanges []*VlanIpRange `json:"vlaniprange"`
 }
 
 type VlanIpRange struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Description       string `json:"description"`
-	Domain            string `json:"domain"`
-	Domainid          string `json:"domainid"`
-	Domainpath        string `json:"domainpath"`
-	Endip             string `json:"endip"`
-	Endipv6           string `json:"endipv6"`
-	Forsystemvms      bool   `json:"forsystemvms"`
-	Forvirtualnetwork bool   `json:"forvirtualnetwork"`
-	Gateway           string `json:"gateway"`
-	Id                string `json:"id"`
-	Ip6cidr           string `json:"ip6cidr"`
-	Ip6gateway        string `json:"ip6gateway"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Netmask           string `json:"netmask"`
-	Networkid         string `json:"networkid"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Project           string `json:"project"`
-	Projectid         string `json:"projectid"`
-	Provider          string `json:"provider"`
-	Startip           string `json:"startip"`
-	Startipv6         string `json:"startipv6"`
-	Vlan              string `json:"vlan"`
-	Zoneid            string `json:"zoneid"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Description       string                     `json:"description"`
+	Domain            string                     `json:"domain"`
+	Domainid          string                     `json:"domainid"`
+	Domainpath        string                     `json:"domainpath"`
+	Endip             string                     `json:"endip"`
+	Endipv6           string                     `json:"endipv6"`
+	Forsystemvms      FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork FlexBool                   `json:"forvirtualnetwork"`
+	Gateway           string                     `json:"gateway"`
+	Id                string                     `json:"id"`
+	Ip6cidr           string                     `json:"ip6cidr"`
+	Ip6gateway        string                     `json:"ip6gateway"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Netmask           string                     `json:"netmask"`
+	Networkid         string                     `json:"networkid"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Project           string                     `json:"project"`
+	Projectid         string                     `json:"projectid"`
+	Provider          string                     `json:"provider"`
+	Startip           string                     `json:"startip"`
+	Startipv6         string                     `json:"startipv6"`
+	Vlan              string                     `json:"vlan"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VlanIpRange) UnmarshalJSON(b []byte) error {
+	type alias VlanIpRange
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseDedicatedGuestVlanRangeParams struct {
@@ -1610,6 +1998,44 @@ func (p *ReleaseDedicatedGuestVlanRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseDedicatedGuestVlanRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseDedicatedGuestVlanRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseDedicatedGuestVlanRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseDedicatedGuestVlanRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseDedicatedGuestVlanRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("releaseDedicatedGuestVlanRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseDedicatedGuestVlanRange API, before the request is signed
+func (p *ReleaseDedicatedGuestVlanRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseDedicatedGuestVlanRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseDedicatedGuestVlanRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseDedicatedGuestVlanRange", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseDedicatedGuestVlanRangeParams) Clone() *ReleaseDedicatedGuestVlanRangeParams {
+	return &ReleaseDedicatedGuestVlanRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseDedicatedGuestVlanRangeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1642,27 +2068,39 @@ func (s *VLANService) NewReleaseDedicatedGuestVlanRangeParams(id string) *Releas
 
 // Releases a dedicated guest vlan range to the system
 func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseDedicatedGuestVlanRange", p.toURLValues())
+	return s.ReleaseDedicatedGuestVlanRangeWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedGuestVlanRangeWithContext is like ReleaseDedicatedGuestVlanRange, but honours the cancellation and deadline of ctx
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseDedicatedGuestVlanRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseDedicatedGuestVlanRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseDedicatedGuestVlanRange", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseDedicatedGuestVlanRange", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseDedicatedGuestVlanRange", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1670,11 +2108,31 @@ func (s *VLANService) ReleaseDedicatedGuestVlanRange(p *ReleaseDedicatedGuestVla
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseDedicatedGuestVlanRange async job into r
+func (r *ReleaseDedicatedGuestVlanRangeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseDedicatedGuestVlanRangeAsync starts the async job of ReleaseDedicatedGuestVlanRange without waiting for it to finish, and returns a handle to the job
+func (s *VLANService) ReleaseDedicatedGuestVlanRangeAsync(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*Job, error) {
+	r, err := s.ReleaseDedicatedGuestVlanRangeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseDedicatedGuestVlanRange", r.JobID), nil
+}
+
 type ReleaseDedicatedGuestVlanRangeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseDedicatedGuestVlanRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseDedicatedGuestVlanRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListGuestVlansParams struct {
@@ -1717,6 +2175,50 @@ func (p *ListGuestVlansParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listGuestVlans API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListGuestVlansParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listGuestVlans", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listGuestVlans API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListGuestVlansParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"keyword": 255, "physicalnetworkid": 255, "vnet": 255, "zoneid": 255},
+	}.validate("listGuestVlans", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listGuestVlans API, before the request is signed
+func (p *ListGuestVlansParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListGuestVlansParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListGuestVlansParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listGuestVlans", b, &p.p, map[string]paramDecoder{
+		"allocatedonly":     decodeParam[bool],
+		"id":                decodeParam[int64],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"physicalnetworkid": decodeParam[string],
+		"vnet":              decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListGuestVlansParams) Clone() *ListGuestVlansParams {
+	return &ListGuestVlansParams{p: cloneParams(p.p)}
+}
+
 func (p *ListGuestVlansParams) SetAllocatedonly(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1895,43 +2397,86 @@ func (s *VLANService) NewListGuestVlansParams() *ListGuestVlansParams {
 
 // Lists all guest vlans
 func (s *VLANService) ListGuestVlans(p *ListGuestVlansParams) (*ListGuestVlansResponse, error) {
-	resp, err := s.cs.newRequest("listGuestVlans", p.toURLValues())
+	return s.ListGuestVlansWithContext(context.Background(), p)
+}
+
+// ListGuestVlansWithContext is like ListGuestVlans, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListGuestVlansWithContext(ctx context.Context, p *ListGuestVlansParams) (*ListGuestVlansResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listGuestVlans", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListGuestVlansResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listGuestVlans", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListGuestVlansAll returns all GuestVlans matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VLANService) ListGuestVlansAll(p *ListGuestVlansParams, opts ...PageOption) ([]*GuestVlan, error) {
+	return s.ListGuestVlansAllWithContext(context.Background(), p, opts...)
+}
+
+// ListGuestVlansAllWithContext is like ListGuestVlansAll, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListGuestVlansAllWithContext(ctx context.Context, p *ListGuestVlansParams, opts ...PageOption) ([]*GuestVlan, error) {
+	return collectPages(s.ListGuestVlansIterWithContext(ctx, p, opts...))
+}
+
+// ListGuestVlansIter returns an iterator over all GuestVlans matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VLANService) ListGuestVlansIter(p *ListGuestVlansParams, opts ...PageOption) iter.Seq2[*GuestVlan, error] {
+	return s.ListGuestVlansIterWithContext(context.Background(), p, opts...)
+}
+
+// ListGuestVlansIterWithContext is like ListGuestVlansIter, but honours the cancellation and deadline of ctx
+func (s *VLANService) ListGuestVlansIterWithContext(ctx context.Context, p *ListGuestVlansParams, opts ...PageOption) iter.Seq2[*GuestVlan, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*GuestVlan, int, error) {
+		l, err := s.ListGuestVlansWithContext(ctx, &ListGuestVlansParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.GuestVlans, l.Count, nil
+	}, opts...)
+}
+
 type ListGuestVlansResponse struct {
 	Count      int          `json:"count"`
 	GuestVlans []*GuestVlan `json:"guestvlan"`
 }
 
 type GuestVlan struct {
-	Account             string     `json:"account"`
-	Allocationstate     string     `json:"allocationstate"`
-	Domain              string     `json:"domain"`
-	Domainid            string     `json:"domainid"`
-	Domainpath          string     `json:"domainpath"`
-	Id                  int64      `json:"id"`
-	Isdedicated         bool       `json:"isdedicated"`
-	JobID               string     `json:"jobid"`
-	Jobstatus           int        `json:"jobstatus"`
-	Network             []*Network `json:"network"`
-	Physicalnetworkid   string     `json:"physicalnetworkid"`
-	Physicalnetworkname string     `json:"physicalnetworkname"`
-	Project             string     `json:"project"`
-	Projectid           string     `json:"projectid"`
-	Taken               string     `json:"taken"`
-	Vlan                string     `json:"vlan"`
-	Zoneid              string     `json:"zoneid"`
-	Zonename            string     `json:"zonename"`
+	Account             string                     `json:"account"`
+	Allocationstate     string                     `json:"allocationstate"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Domainpath          string                     `json:"domainpath"`
+	Id                  FlexInt64                  `json:"id"`
+	Isdedicated         FlexBool                   `json:"isdedicated"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Network             []*Network                 `json:"network"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid"`
+	Physicalnetworkname string                     `json:"physicalnetworkname"`
+	Project             string                     `json:"project"`
+	Projectid           string                     `json:"projectid"`
+	Taken               string                     `json:"taken"`
+	Vlan                string                     `json:"vlan"`
+	Zoneid              string                     `json:"zoneid"`
+	Zonename            string                     `json:"zonename"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GuestVlan) UnmarshalJSON(b []byte) error {
+	type alias GuestVlan
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVlanIpRangeParams struct {
@@ -1977,6 +2522,53 @@ func (p *UpdateVlanIpRangeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVlanIpRange API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVlanIpRangeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVlanIpRange", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVlanIpRange API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVlanIpRangeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"endip": 255, "endipv6": 255, "gateway": 255, "id": 255, "ip6cidr": 255, "ip6gateway": 255, "netmask": 255, "startip": 255, "startipv6": 255},
+	}.validate("updateVlanIpRange", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVlanIpRange API, before the request is signed
+func (p *UpdateVlanIpRangeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVlanIpRangeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVlanIpRangeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVlanIpRange", b, &p.p, map[string]paramDecoder{
+		"endip":        decodeParam[string],
+		"endipv6":      decodeParam[string],
+		"forsystemvms": decodeParam[bool],
+		"gateway":      decodeParam[string],
+		"id":           decodeParam[string],
+		"ip6cidr":      decodeParam[string],
+		"ip6gateway":   decodeParam[string],
+		"netmask":      decodeParam[string],
+		"startip":      decodeParam[string],
+		"startipv6":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVlanIpRangeParams) Clone() *UpdateVlanIpRangeParams {
+	return &UpdateVlanIpRangeParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVlanIpRangeParams) SetEndip(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2198,7 +2790,16 @@ func (s *VLANService) NewUpdateVlanIpRangeParams(id string) *UpdateVlanIpRangePa
 
 // Updates a VLAN IP range.
 func (s *VLANService) UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVlanIpRange", p.toURLValues())
+	return s.UpdateVlanIpRangeWithContext(context.Background(), p)
+}
+
+// UpdateVlanIpRangeWithContext is like UpdateVlanIpRange, but honours the cancellation and deadline of ctx
+func (s *VLANService) UpdateVlanIpRangeWithContext(ctx context.Context, p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVlanIpRange", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2208,7 +2809,7 @@ func (s *VLANService) UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlan
 	}
 
 	var r UpdateVlanIpRangeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVlanIpRange", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2216,32 +2817,38 @@ func (s *VLANService) UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlan
 }
 
 type UpdateVlanIpRangeResponse struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Description       string `json:"description"`
-	Domain            string `json:"domain"`
-	Domainid          string `json:"domainid"`
-	Domainpath        string `json:"domainpath"`
-	Endip             string `json:"endip"`
-	Endipv6           string `json:"endipv6"`
-	Forsystemvms      bool   `json:"forsystemvms"`
-	Forvirtualnetwork bool   `json:"forvirtualnetwork"`
-	Gateway           string `json:"gateway"`
-	Id                string `json:"id"`
-	Ip6cidr           string `json:"ip6cidr"`
-	Ip6gateway        string `json:"ip6gateway"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
-	Netmask           string `json:"netmask"`
-	Networkid         string `json:"networkid"`
-	Physicalnetworkid string `json:"physicalnetworkid"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Project           string `json:"project"`
-	Projectid         string `json:"projectid"`
-	Provider          string `json:"provider"`
-	Startip           string `json:"startip"`
-	Startipv6         string `json:"startipv6"`
-	Vlan              string `json:"vlan"`
-	Zoneid            string `json:"zoneid"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Description       string                     `json:"description"`
+	Domain            string                     `json:"domain"`
+	Domainid          string                     `json:"domainid"`
+	Domainpath        string                     `json:"domainpath"`
+	Endip             string                     `json:"endip"`
+	Endipv6           string                     `json:"endipv6"`
+	Forsystemvms      FlexBool                   `json:"forsystemvms"`
+	Forvirtualnetwork FlexBool                   `json:"forvirtualnetwork"`
+	Gateway           string                     `json:"gateway"`
+	Id                string                     `json:"id"`
+	Ip6cidr           string                     `json:"ip6cidr"`
+	Ip6gateway        string                     `json:"ip6gateway"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Netmask           string                     `json:"netmask"`
+	Networkid         string                     `json:"networkid"`
+	Physicalnetworkid string                     `json:"physicalnetworkid"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Project           string                     `json:"project"`
+	Projectid         string                     `json:"projectid"`
+	Provider          string                     `json:"provider"`
+	Startip           string                     `json:"startip"`
+	Startipv6         string                     `json:"startipv6"`
+	Vlan              string                     `json:"vlan"`
+	Zoneid            string                     `json:"zoneid"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVlanIpRangeResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVlanIpRangeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
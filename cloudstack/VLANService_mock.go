@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockVLANServiceIfaceMockRecorder) CreateVlanIpRange(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).CreateVlanIpRange), p)
 }
 
+// CreateVlanIpRangeWithContext mocks base method.
+func (m *MockVLANServiceIface) CreateVlanIpRangeWithContext(ctx context.Context, p *CreateVlanIpRangeParams) (*CreateVlanIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVlanIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVlanIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVlanIpRangeWithContext indicates an expected call of CreateVlanIpRangeWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) CreateVlanIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVlanIpRangeWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).CreateVlanIpRangeWithContext), ctx, p)
+}
+
 // DedicateGuestVlanRange mocks base method.
 func (m *MockVLANServiceIface) DedicateGuestVlanRange(p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockVLANServiceIfaceMockRecorder) DedicateGuestVlanRange(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateGuestVlanRange", reflect.TypeOf((*MockVLANServiceIface)(nil).DedicateGuestVlanRange), p)
 }
 
+// DedicateGuestVlanRangeWithContext mocks base method.
+func (m *MockVLANServiceIface) DedicateGuestVlanRangeWithContext(ctx context.Context, p *DedicateGuestVlanRangeParams) (*DedicateGuestVlanRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateGuestVlanRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicateGuestVlanRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateGuestVlanRangeWithContext indicates an expected call of DedicateGuestVlanRangeWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) DedicateGuestVlanRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateGuestVlanRangeWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).DedicateGuestVlanRangeWithContext), ctx, p)
+}
+
 // DeleteVlanIpRange mocks base method.
 func (m *MockVLANServiceIface) DeleteVlanIpRange(p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,21 @@ func (mr *MockVLANServiceIfaceMockRecorder) DeleteVlanIpRange(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).DeleteVlanIpRange), p)
 }
 
+// DeleteVlanIpRangeWithContext mocks base method.
+func (m *MockVLANServiceIface) DeleteVlanIpRangeWithContext(ctx context.Context, p *DeleteVlanIpRangeParams) (*DeleteVlanIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVlanIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVlanIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVlanIpRangeWithContext indicates an expected call of DeleteVlanIpRangeWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) DeleteVlanIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVlanIpRangeWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).DeleteVlanIpRangeWithContext), ctx, p)
+}
+
 // GetDedicatedGuestVlanRangeByID mocks base method.
 func (m *MockVLANServiceIface) GetDedicatedGuestVlanRangeByID(id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
 	m.ctrl.T.Helper()
@@ -124,6 +171,27 @@ func (mr *MockVLANServiceIfaceMockRecorder) GetDedicatedGuestVlanRangeByID(id an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedGuestVlanRangeByID", reflect.TypeOf((*MockVLANServiceIface)(nil).GetDedicatedGuestVlanRangeByID), varargs...)
 }
 
+// GetDedicatedGuestVlanRangeByIDWithContext mocks base method.
+func (m *MockVLANServiceIface) GetDedicatedGuestVlanRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*DedicatedGuestVlanRange, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetDedicatedGuestVlanRangeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*DedicatedGuestVlanRange)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetDedicatedGuestVlanRangeByIDWithContext indicates an expected call of GetDedicatedGuestVlanRangeByIDWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) GetDedicatedGuestVlanRangeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetDedicatedGuestVlanRangeByIDWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).GetDedicatedGuestVlanRangeByIDWithContext), varargs...)
+}
+
 // GetVlanIpRangeByID mocks base method.
 func (m *MockVLANServiceIface) GetVlanIpRangeByID(id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
 	m.ctrl.T.Helper()
@@ -145,6 +213,27 @@ func (mr *MockVLANServiceIfaceMockRecorder) GetVlanIpRangeByID(id any, opts ...a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVlanIpRangeByID", reflect.TypeOf((*MockVLANServiceIface)(nil).GetVlanIpRangeByID), varargs...)
 }
 
+// GetVlanIpRangeByIDWithContext mocks base method.
+func (m *MockVLANServiceIface) GetVlanIpRangeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VlanIpRange, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVlanIpRangeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VlanIpRange)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVlanIpRangeByIDWithContext indicates an expected call of GetVlanIpRangeByIDWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) GetVlanIpRangeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVlanIpRangeByIDWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).GetVlanIpRangeByIDWithContext), varargs...)
+}
+
 // ListDedicatedGuestVlanRanges mocks base method.
 func (m *MockVLANServiceIface) ListDedicatedGuestVlanRanges(p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +249,99 @@ func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRanges(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRanges", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRanges), p)
 }
 
+// ListDedicatedGuestVlanRangesAll mocks base method.
+func (m *MockVLANServiceIface) ListDedicatedGuestVlanRangesAll(p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) ([]*DedicatedGuestVlanRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedGuestVlanRangesAll", varargs...)
+	ret0, _ := ret[0].([]*DedicatedGuestVlanRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedGuestVlanRangesAll indicates an expected call of ListDedicatedGuestVlanRangesAll.
+func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRangesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRangesAll", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRangesAll), varargs...)
+}
+
+// ListDedicatedGuestVlanRangesAllWithContext mocks base method.
+func (m *MockVLANServiceIface) ListDedicatedGuestVlanRangesAllWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) ([]*DedicatedGuestVlanRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedGuestVlanRangesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DedicatedGuestVlanRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedGuestVlanRangesAllWithContext indicates an expected call of ListDedicatedGuestVlanRangesAllWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRangesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRangesAllWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRangesAllWithContext), varargs...)
+}
+
+// ListDedicatedGuestVlanRangesIter mocks base method.
+func (m *MockVLANServiceIface) ListDedicatedGuestVlanRangesIter(p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) iter.Seq2[*DedicatedGuestVlanRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedGuestVlanRangesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedGuestVlanRange, error])
+	return ret0
+}
+
+// ListDedicatedGuestVlanRangesIter indicates an expected call of ListDedicatedGuestVlanRangesIter.
+func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRangesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRangesIter", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRangesIter), varargs...)
+}
+
+// ListDedicatedGuestVlanRangesIterWithContext mocks base method.
+func (m *MockVLANServiceIface) ListDedicatedGuestVlanRangesIterWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams, opts ...PageOption) iter.Seq2[*DedicatedGuestVlanRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedGuestVlanRangesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedGuestVlanRange, error])
+	return ret0
+}
+
+// ListDedicatedGuestVlanRangesIterWithContext indicates an expected call of ListDedicatedGuestVlanRangesIterWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRangesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRangesIterWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRangesIterWithContext), varargs...)
+}
+
+// ListDedicatedGuestVlanRangesWithContext mocks base method.
+func (m *MockVLANServiceIface) ListDedicatedGuestVlanRangesWithContext(ctx context.Context, p *ListDedicatedGuestVlanRangesParams) (*ListDedicatedGuestVlanRangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedGuestVlanRangesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDedicatedGuestVlanRangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedGuestVlanRangesWithContext indicates an expected call of ListDedicatedGuestVlanRangesWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListDedicatedGuestVlanRangesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedGuestVlanRangesWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListDedicatedGuestVlanRangesWithContext), ctx, p)
+}
+
 // ListGuestVlans mocks base method.
 func (m *MockVLANServiceIface) ListGuestVlans(p *ListGuestVlansParams) (*ListGuestVlansResponse, error) {
 	m.ctrl.T.Helper()
@@ -175,6 +357,99 @@ func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlans(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlans", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlans), p)
 }
 
+// ListGuestVlansAll mocks base method.
+func (m *MockVLANServiceIface) ListGuestVlansAll(p *ListGuestVlansParams, opts ...PageOption) ([]*GuestVlan, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestVlansAll", varargs...)
+	ret0, _ := ret[0].([]*GuestVlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestVlansAll indicates an expected call of ListGuestVlansAll.
+func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlansAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlansAll", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlansAll), varargs...)
+}
+
+// ListGuestVlansAllWithContext mocks base method.
+func (m *MockVLANServiceIface) ListGuestVlansAllWithContext(ctx context.Context, p *ListGuestVlansParams, opts ...PageOption) ([]*GuestVlan, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestVlansAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*GuestVlan)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestVlansAllWithContext indicates an expected call of ListGuestVlansAllWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlansAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlansAllWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlansAllWithContext), varargs...)
+}
+
+// ListGuestVlansIter mocks base method.
+func (m *MockVLANServiceIface) ListGuestVlansIter(p *ListGuestVlansParams, opts ...PageOption) iter.Seq2[*GuestVlan, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestVlansIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GuestVlan, error])
+	return ret0
+}
+
+// ListGuestVlansIter indicates an expected call of ListGuestVlansIter.
+func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlansIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlansIter", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlansIter), varargs...)
+}
+
+// ListGuestVlansIterWithContext mocks base method.
+func (m *MockVLANServiceIface) ListGuestVlansIterWithContext(ctx context.Context, p *ListGuestVlansParams, opts ...PageOption) iter.Seq2[*GuestVlan, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListGuestVlansIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*GuestVlan, error])
+	return ret0
+}
+
+// ListGuestVlansIterWithContext indicates an expected call of ListGuestVlansIterWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlansIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlansIterWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlansIterWithContext), varargs...)
+}
+
+// ListGuestVlansWithContext mocks base method.
+func (m *MockVLANServiceIface) ListGuestVlansWithContext(ctx context.Context, p *ListGuestVlansParams) (*ListGuestVlansResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListGuestVlansWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListGuestVlansResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListGuestVlansWithContext indicates an expected call of ListGuestVlansWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListGuestVlansWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListGuestVlansWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListGuestVlansWithContext), ctx, p)
+}
+
 // ListVlanIpRanges mocks base method.
 func (m *MockVLANServiceIface) ListVlanIpRanges(p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
 	m.ctrl.T.Helper()
@@ -190,6 +465,99 @@ func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRanges(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRanges", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRanges), p)
 }
 
+// ListVlanIpRangesAll mocks base method.
+func (m *MockVLANServiceIface) ListVlanIpRangesAll(p *ListVlanIpRangesParams, opts ...PageOption) ([]*VlanIpRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVlanIpRangesAll", varargs...)
+	ret0, _ := ret[0].([]*VlanIpRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVlanIpRangesAll indicates an expected call of ListVlanIpRangesAll.
+func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRangesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRangesAll", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRangesAll), varargs...)
+}
+
+// ListVlanIpRangesAllWithContext mocks base method.
+func (m *MockVLANServiceIface) ListVlanIpRangesAllWithContext(ctx context.Context, p *ListVlanIpRangesParams, opts ...PageOption) ([]*VlanIpRange, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVlanIpRangesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VlanIpRange)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVlanIpRangesAllWithContext indicates an expected call of ListVlanIpRangesAllWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRangesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRangesAllWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRangesAllWithContext), varargs...)
+}
+
+// ListVlanIpRangesIter mocks base method.
+func (m *MockVLANServiceIface) ListVlanIpRangesIter(p *ListVlanIpRangesParams, opts ...PageOption) iter.Seq2[*VlanIpRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVlanIpRangesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VlanIpRange, error])
+	return ret0
+}
+
+// ListVlanIpRangesIter indicates an expected call of ListVlanIpRangesIter.
+func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRangesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRangesIter", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRangesIter), varargs...)
+}
+
+// ListVlanIpRangesIterWithContext mocks base method.
+func (m *MockVLANServiceIface) ListVlanIpRangesIterWithContext(ctx context.Context, p *ListVlanIpRangesParams, opts ...PageOption) iter.Seq2[*VlanIpRange, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVlanIpRangesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VlanIpRange, error])
+	return ret0
+}
+
+// ListVlanIpRangesIterWithContext indicates an expected call of ListVlanIpRangesIterWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRangesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRangesIterWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRangesIterWithContext), varargs...)
+}
+
+// ListVlanIpRangesWithContext mocks base method.
+func (m *MockVLANServiceIface) ListVlanIpRangesWithContext(ctx context.Context, p *ListVlanIpRangesParams) (*ListVlanIpRangesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVlanIpRangesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVlanIpRangesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVlanIpRangesWithContext indicates an expected call of ListVlanIpRangesWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ListVlanIpRangesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVlanIpRangesWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ListVlanIpRangesWithContext), ctx, p)
+}
+
 // NewCreateVlanIpRangeParams mocks base method.
 func (m *MockVLANServiceIface) NewCreateVlanIpRangeParams() *CreateVlanIpRangeParams {
 	m.ctrl.T.Helper()
@@ -317,6 +685,36 @@ func (mr *MockVLANServiceIfaceMockRecorder) ReleaseDedicatedGuestVlanRange(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedGuestVlanRange", reflect.TypeOf((*MockVLANServiceIface)(nil).ReleaseDedicatedGuestVlanRange), p)
 }
 
+// ReleaseDedicatedGuestVlanRangeAsync mocks base method.
+func (m *MockVLANServiceIface) ReleaseDedicatedGuestVlanRangeAsync(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedGuestVlanRangeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedGuestVlanRangeAsync indicates an expected call of ReleaseDedicatedGuestVlanRangeAsync.
+func (mr *MockVLANServiceIfaceMockRecorder) ReleaseDedicatedGuestVlanRangeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedGuestVlanRangeAsync", reflect.TypeOf((*MockVLANServiceIface)(nil).ReleaseDedicatedGuestVlanRangeAsync), ctx, p)
+}
+
+// ReleaseDedicatedGuestVlanRangeWithContext mocks base method.
+func (m *MockVLANServiceIface) ReleaseDedicatedGuestVlanRangeWithContext(ctx context.Context, p *ReleaseDedicatedGuestVlanRangeParams) (*ReleaseDedicatedGuestVlanRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedGuestVlanRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseDedicatedGuestVlanRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedGuestVlanRangeWithContext indicates an expected call of ReleaseDedicatedGuestVlanRangeWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) ReleaseDedicatedGuestVlanRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedGuestVlanRangeWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).ReleaseDedicatedGuestVlanRangeWithContext), ctx, p)
+}
+
 // UpdateVlanIpRange mocks base method.
 func (m *MockVLANServiceIface) UpdateVlanIpRange(p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error) {
 	m.ctrl.T.Helper()
@@ -331,3 +729,18 @@ func (mr *MockVLANServiceIfaceMockRecorder) UpdateVlanIpRange(p any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVlanIpRange", reflect.TypeOf((*MockVLANServiceIface)(nil).UpdateVlanIpRange), p)
 }
+
+// UpdateVlanIpRangeWithContext mocks base method.
+func (m *MockVLANServiceIface) UpdateVlanIpRangeWithContext(ctx context.Context, p *UpdateVlanIpRangeParams) (*UpdateVlanIpRangeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVlanIpRangeWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVlanIpRangeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVlanIpRangeWithContext indicates an expected call of UpdateVlanIpRangeWithContext.
+func (mr *MockVLANServiceIfaceMockRecorder) UpdateVlanIpRangeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVlanIpRangeWithContext", reflect.TypeOf((*MockVLANServiceIface)(nil).UpdateVlanIpRangeWithContext), ctx, p)
+}
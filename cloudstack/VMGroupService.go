@@ -20,24 +20,36 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type VMGroupServiceIface interface {
 	CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
+	CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error)
 	NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams
 	DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
+	DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error)
 	NewDeleteInstanceGroupParams(id string) *DeleteInstanceGroupParams
 	ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error)
+	ListInstanceGroupsAll(p *ListInstanceGroupsParams, opts ...PageOption) ([]*InstanceGroup, error)
+	ListInstanceGroupsAllWithContext(ctx context.Context, p *ListInstanceGroupsParams, opts ...PageOption) ([]*InstanceGroup, error)
+	ListInstanceGroupsIter(p *ListInstanceGroupsParams, opts ...PageOption) iter.Seq2[*InstanceGroup, error]
+	ListInstanceGroupsIterWithContext(ctx context.Context, p *ListInstanceGroupsParams, opts ...PageOption) iter.Seq2[*InstanceGroup, error]
 	NewListInstanceGroupsParams() *ListInstanceGroupsParams
 	GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error)
+	GetInstanceGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InstanceGroup, int, error)
 	GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error)
+	GetInstanceGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InstanceGroup, int, error)
 	UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
+	UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error)
 	NewUpdateInstanceGroupParams(id string) *UpdateInstanceGroupParams
 }
 
@@ -65,6 +77,47 @@ func (p *CreateInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createInstanceGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateInstanceGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createInstanceGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createInstanceGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateInstanceGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"name"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "name": 255, "projectid": 255},
+	}.validate("createInstanceGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createInstanceGroup API, before the request is signed
+func (p *CreateInstanceGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateInstanceGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateInstanceGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createInstanceGroup", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"name":      decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateInstanceGroupParams) Clone() *CreateInstanceGroupParams {
+	return &CreateInstanceGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateInstanceGroupParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -160,13 +213,22 @@ func (s *VMGroupService) NewCreateInstanceGroupParams(name string) *CreateInstan
 
 // Creates an Instance group
 func (s *VMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("createInstanceGroup", p.toURLValues())
+	return s.CreateInstanceGroupWithContext(context.Background(), p)
+}
+
+// CreateInstanceGroupWithContext is like CreateInstanceGroup, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createInstanceGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateInstanceGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createInstanceGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -174,18 +236,24 @@ func (s *VMGroupService) CreateInstanceGroup(p *CreateInstanceGroupParams) (*Cre
 }
 
 type CreateInstanceGroupResponse struct {
-	Account        string `json:"account"`
-	Created        string `json:"created"`
-	Domain         string `json:"domain"`
-	Domainid       string `json:"domainid"`
-	Domainpath     string `json:"domainpath"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Project        string `json:"project"`
-	Projectid      string `json:"projectid"`
+	Account        string                     `json:"account"`
+	Created        Time                       `json:"created"`
+	Domain         string                     `json:"domain"`
+	Domainid       string                     `json:"domainid"`
+	Domainpath     string                     `json:"domainpath"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Project        string                     `json:"project"`
+	Projectid      string                     `json:"projectid"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateInstanceGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateInstanceGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteInstanceGroupParams struct {
@@ -203,6 +271,44 @@ func (p *DeleteInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteInstanceGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteInstanceGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteInstanceGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteInstanceGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteInstanceGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteInstanceGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteInstanceGroup API, before the request is signed
+func (p *DeleteInstanceGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteInstanceGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteInstanceGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteInstanceGroup", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteInstanceGroupParams) Clone() *DeleteInstanceGroupParams {
+	return &DeleteInstanceGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteInstanceGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -235,13 +341,22 @@ func (s *VMGroupService) NewDeleteInstanceGroupParams(id string) *DeleteInstance
 
 // Deletes an Instance group
 func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteInstanceGroup", p.toURLValues())
+	return s.DeleteInstanceGroupWithContext(context.Background(), p)
+}
+
+// DeleteInstanceGroupWithContext is like DeleteInstanceGroup, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteInstanceGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteInstanceGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteInstanceGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -249,10 +364,11 @@ func (s *VMGroupService) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*Del
 }
 
 type DeleteInstanceGroupResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteInstanceGroupResponse) UnmarshalJSON(b []byte) error {
@@ -279,7 +395,7 @@ func (r *DeleteInstanceGroupResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteInstanceGroupResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListInstanceGroupsParams struct {
@@ -328,6 +444,52 @@ func (p *ListInstanceGroupsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listInstanceGroups API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListInstanceGroupsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listInstanceGroups", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listInstanceGroups API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListInstanceGroupsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255},
+	}.validate("listInstanceGroups", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listInstanceGroups API, before the request is signed
+func (p *ListInstanceGroupsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListInstanceGroupsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListInstanceGroupsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listInstanceGroups", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListInstanceGroupsParams) Clone() *ListInstanceGroupsParams {
+	return &ListInstanceGroupsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListInstanceGroupsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -548,6 +710,11 @@ func (s *VMGroupService) NewListInstanceGroupsParams() *ListInstanceGroupsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetInstanceGroupIDWithContext(context.Background(), name, opts...)
+}
+
+// GetInstanceGroupIDWithContext is like GetInstanceGroupID, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) GetInstanceGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListInstanceGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -559,7 +726,7 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 		}
 	}
 
-	l, err := s.ListInstanceGroups(p)
+	l, err := s.ListInstanceGroupsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -584,12 +751,17 @@ func (s *VMGroupService) GetInstanceGroupID(name string, opts ...OptionFunc) (st
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VMGroupService) GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
-	id, count, err := s.GetInstanceGroupID(name, opts...)
+	return s.GetInstanceGroupByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetInstanceGroupByNameWithContext is like GetInstanceGroupByName, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) GetInstanceGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	id, count, err := s.GetInstanceGroupIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetInstanceGroupByID(id, opts...)
+	r, count, err := s.GetInstanceGroupByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -598,6 +770,11 @@ func (s *VMGroupService) GetInstanceGroupByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	return s.GetInstanceGroupByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetInstanceGroupByIDWithContext is like GetInstanceGroupByID, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) GetInstanceGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
 	p := &ListInstanceGroupsParams{}
 	p.p = make(map[string]interface{})
 
@@ -609,11 +786,10 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 		}
 	}
 
-	l, err := s.ListInstanceGroups(p)
+	l, err := s.ListInstanceGroupsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -631,37 +807,80 @@ func (s *VMGroupService) GetInstanceGroupByID(id string, opts ...OptionFunc) (*I
 
 // Lists Instance groups
 func (s *VMGroupService) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
-	resp, err := s.cs.newRequest("listInstanceGroups", p.toURLValues())
+	return s.ListInstanceGroupsWithContext(context.Background(), p)
+}
+
+// ListInstanceGroupsWithContext is like ListInstanceGroups, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listInstanceGroups", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListInstanceGroupsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listInstanceGroups", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListInstanceGroupsAll returns all InstanceGroups matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VMGroupService) ListInstanceGroupsAll(p *ListInstanceGroupsParams, opts ...PageOption) ([]*InstanceGroup, error) {
+	return s.ListInstanceGroupsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListInstanceGroupsAllWithContext is like ListInstanceGroupsAll, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) ListInstanceGroupsAllWithContext(ctx context.Context, p *ListInstanceGroupsParams, opts ...PageOption) ([]*InstanceGroup, error) {
+	return collectPages(s.ListInstanceGroupsIterWithContext(ctx, p, opts...))
+}
+
+// ListInstanceGroupsIter returns an iterator over all InstanceGroups matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VMGroupService) ListInstanceGroupsIter(p *ListInstanceGroupsParams, opts ...PageOption) iter.Seq2[*InstanceGroup, error] {
+	return s.ListInstanceGroupsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListInstanceGroupsIterWithContext is like ListInstanceGroupsIter, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) ListInstanceGroupsIterWithContext(ctx context.Context, p *ListInstanceGroupsParams, opts ...PageOption) iter.Seq2[*InstanceGroup, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*InstanceGroup, int, error) {
+		l, err := s.ListInstanceGroupsWithContext(ctx, &ListInstanceGroupsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.InstanceGroups, l.Count, nil
+	}, opts...)
+}
+
 type ListInstanceGroupsResponse struct {
 	Count          int              `json:"count"`
 	InstanceGroups []*InstanceGroup `json:"instancegroup"`
 }
 
 type InstanceGroup struct {
-	Account        string `json:"account"`
-	Created        string `json:"created"`
-	Domain         string `json:"domain"`
-	Domainid       string `json:"domainid"`
-	Domainpath     string `json:"domainpath"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Project        string `json:"project"`
-	Projectid      string `json:"projectid"`
+	Account        string                     `json:"account"`
+	Created        Time                       `json:"created"`
+	Domain         string                     `json:"domain"`
+	Domainid       string                     `json:"domainid"`
+	Domainpath     string                     `json:"domainpath"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Project        string                     `json:"project"`
+	Projectid      string                     `json:"projectid"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *InstanceGroup) UnmarshalJSON(b []byte) error {
+	type alias InstanceGroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateInstanceGroupParams struct {
@@ -682,6 +901,45 @@ func (p *UpdateInstanceGroupParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateInstanceGroup API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateInstanceGroupParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateInstanceGroup", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateInstanceGroup API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateInstanceGroupParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "name": 255},
+	}.validate("updateInstanceGroup", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateInstanceGroup API, before the request is signed
+func (p *UpdateInstanceGroupParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateInstanceGroupParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateInstanceGroupParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateInstanceGroup", b, &p.p, map[string]paramDecoder{
+		"id":   decodeParam[string],
+		"name": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateInstanceGroupParams) Clone() *UpdateInstanceGroupParams {
+	return &UpdateInstanceGroupParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateInstanceGroupParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -735,13 +993,22 @@ func (s *VMGroupService) NewUpdateInstanceGroupParams(id string) *UpdateInstance
 
 // Updates an Instance group
 func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
-	resp, err := s.cs.newPostRequest("updateInstanceGroup", p.toURLValues())
+	return s.UpdateInstanceGroupWithContext(context.Background(), p)
+}
+
+// UpdateInstanceGroupWithContext is like UpdateInstanceGroup, but honours the cancellation and deadline of ctx
+func (s *VMGroupService) UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateInstanceGroup", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateInstanceGroupResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateInstanceGroup", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -749,16 +1016,22 @@ func (s *VMGroupService) UpdateInstanceGroup(p *UpdateInstanceGroupParams) (*Upd
 }
 
 type UpdateInstanceGroupResponse struct {
-	Account        string `json:"account"`
-	Created        string `json:"created"`
-	Domain         string `json:"domain"`
-	Domainid       string `json:"domainid"`
-	Domainpath     string `json:"domainpath"`
-	Hasannotations bool   `json:"hasannotations"`
-	Id             string `json:"id"`
-	JobID          string `json:"jobid"`
-	Jobstatus      int    `json:"jobstatus"`
-	Name           string `json:"name"`
-	Project        string `json:"project"`
-	Projectid      string `json:"projectid"`
+	Account        string                     `json:"account"`
+	Created        Time                       `json:"created"`
+	Domain         string                     `json:"domain"`
+	Domainid       string                     `json:"domainid"`
+	Domainpath     string                     `json:"domainpath"`
+	Hasannotations FlexBool                   `json:"hasannotations"`
+	Id             string                     `json:"id"`
+	JobID          string                     `json:"jobid"`
+	Jobstatus      FlexInt                    `json:"jobstatus"`
+	Name           string                     `json:"name"`
+	Project        string                     `json:"project"`
+	Projectid      string                     `json:"projectid"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateInstanceGroupResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateInstanceGroupResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) CreateInstanceGroup(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).CreateInstanceGroup), p)
 }
 
+// CreateInstanceGroupWithContext mocks base method.
+func (m *MockVMGroupServiceIface) CreateInstanceGroupWithContext(ctx context.Context, p *CreateInstanceGroupParams) (*CreateInstanceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateInstanceGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateInstanceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateInstanceGroupWithContext indicates an expected call of CreateInstanceGroupWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) CreateInstanceGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateInstanceGroupWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).CreateInstanceGroupWithContext), ctx, p)
+}
+
 // DeleteInstanceGroup mocks base method.
 func (m *MockVMGroupServiceIface) DeleteInstanceGroup(p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,21 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) DeleteInstanceGroup(p any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).DeleteInstanceGroup), p)
 }
 
+// DeleteInstanceGroupWithContext mocks base method.
+func (m *MockVMGroupServiceIface) DeleteInstanceGroupWithContext(ctx context.Context, p *DeleteInstanceGroupParams) (*DeleteInstanceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteInstanceGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteInstanceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteInstanceGroupWithContext indicates an expected call of DeleteInstanceGroupWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) DeleteInstanceGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteInstanceGroupWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).DeleteInstanceGroupWithContext), ctx, p)
+}
+
 // GetInstanceGroupByID mocks base method.
 func (m *MockVMGroupServiceIface) GetInstanceGroupByID(id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +141,27 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) GetInstanceGroupByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroupByID", reflect.TypeOf((*MockVMGroupServiceIface)(nil).GetInstanceGroupByID), varargs...)
 }
 
+// GetInstanceGroupByIDWithContext mocks base method.
+func (m *MockVMGroupServiceIface) GetInstanceGroupByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInstanceGroupByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*InstanceGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceGroupByIDWithContext indicates an expected call of GetInstanceGroupByIDWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) GetInstanceGroupByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroupByIDWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).GetInstanceGroupByIDWithContext), varargs...)
+}
+
 // GetInstanceGroupByName mocks base method.
 func (m *MockVMGroupServiceIface) GetInstanceGroupByName(name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +183,27 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) GetInstanceGroupByName(name any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroupByName", reflect.TypeOf((*MockVMGroupServiceIface)(nil).GetInstanceGroupByName), varargs...)
 }
 
+// GetInstanceGroupByNameWithContext mocks base method.
+func (m *MockVMGroupServiceIface) GetInstanceGroupByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*InstanceGroup, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInstanceGroupByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*InstanceGroup)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceGroupByNameWithContext indicates an expected call of GetInstanceGroupByNameWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) GetInstanceGroupByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroupByNameWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).GetInstanceGroupByNameWithContext), varargs...)
+}
+
 // GetInstanceGroupID mocks base method.
 func (m *MockVMGroupServiceIface) GetInstanceGroupID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +225,27 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) GetInstanceGroupID(name any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroupID", reflect.TypeOf((*MockVMGroupServiceIface)(nil).GetInstanceGroupID), varargs...)
 }
 
+// GetInstanceGroupIDWithContext mocks base method.
+func (m *MockVMGroupServiceIface) GetInstanceGroupIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetInstanceGroupIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetInstanceGroupIDWithContext indicates an expected call of GetInstanceGroupIDWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) GetInstanceGroupIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetInstanceGroupIDWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).GetInstanceGroupIDWithContext), varargs...)
+}
+
 // ListInstanceGroups mocks base method.
 func (m *MockVMGroupServiceIface) ListInstanceGroups(p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
 	m.ctrl.T.Helper()
@@ -166,6 +261,99 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroups(p any) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroups", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroups), p)
 }
 
+// ListInstanceGroupsAll mocks base method.
+func (m *MockVMGroupServiceIface) ListInstanceGroupsAll(p *ListInstanceGroupsParams, opts ...PageOption) ([]*InstanceGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInstanceGroupsAll", varargs...)
+	ret0, _ := ret[0].([]*InstanceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceGroupsAll indicates an expected call of ListInstanceGroupsAll.
+func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroupsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroupsAll", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroupsAll), varargs...)
+}
+
+// ListInstanceGroupsAllWithContext mocks base method.
+func (m *MockVMGroupServiceIface) ListInstanceGroupsAllWithContext(ctx context.Context, p *ListInstanceGroupsParams, opts ...PageOption) ([]*InstanceGroup, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInstanceGroupsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*InstanceGroup)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceGroupsAllWithContext indicates an expected call of ListInstanceGroupsAllWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroupsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroupsAllWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroupsAllWithContext), varargs...)
+}
+
+// ListInstanceGroupsIter mocks base method.
+func (m *MockVMGroupServiceIface) ListInstanceGroupsIter(p *ListInstanceGroupsParams, opts ...PageOption) iter.Seq2[*InstanceGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInstanceGroupsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*InstanceGroup, error])
+	return ret0
+}
+
+// ListInstanceGroupsIter indicates an expected call of ListInstanceGroupsIter.
+func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroupsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroupsIter", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroupsIter), varargs...)
+}
+
+// ListInstanceGroupsIterWithContext mocks base method.
+func (m *MockVMGroupServiceIface) ListInstanceGroupsIterWithContext(ctx context.Context, p *ListInstanceGroupsParams, opts ...PageOption) iter.Seq2[*InstanceGroup, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListInstanceGroupsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*InstanceGroup, error])
+	return ret0
+}
+
+// ListInstanceGroupsIterWithContext indicates an expected call of ListInstanceGroupsIterWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroupsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroupsIterWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroupsIterWithContext), varargs...)
+}
+
+// ListInstanceGroupsWithContext mocks base method.
+func (m *MockVMGroupServiceIface) ListInstanceGroupsWithContext(ctx context.Context, p *ListInstanceGroupsParams) (*ListInstanceGroupsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListInstanceGroupsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListInstanceGroupsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListInstanceGroupsWithContext indicates an expected call of ListInstanceGroupsWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) ListInstanceGroupsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListInstanceGroupsWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).ListInstanceGroupsWithContext), ctx, p)
+}
+
 // NewCreateInstanceGroupParams mocks base method.
 func (m *MockVMGroupServiceIface) NewCreateInstanceGroupParams(name string) *CreateInstanceGroupParams {
 	m.ctrl.T.Helper()
@@ -236,3 +424,18 @@ func (mr *MockVMGroupServiceIfaceMockRecorder) UpdateInstanceGroup(p any) *gomoc
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceGroup", reflect.TypeOf((*MockVMGroupServiceIface)(nil).UpdateInstanceGroup), p)
 }
+
+// UpdateInstanceGroupWithContext mocks base method.
+func (m *MockVMGroupServiceIface) UpdateInstanceGroupWithContext(ctx context.Context, p *UpdateInstanceGroupParams) (*UpdateInstanceGroupResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateInstanceGroupWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateInstanceGroupResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateInstanceGroupWithContext indicates an expected call of UpdateInstanceGroupWithContext.
+func (mr *MockVMGroupServiceIfaceMockRecorder) UpdateInstanceGroupWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateInstanceGroupWithContext", reflect.TypeOf((*MockVMGroupServiceIface)(nil).UpdateInstanceGroupWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,44 +31,96 @@ import (
 
 type VPCServiceIface interface {
 	CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error)
+	CreatePrivateGatewayAsync(ctx context.Context, p *CreatePrivateGatewayParams) (*Job, error)
 	NewCreatePrivateGatewayParams(gateway string, ipaddress string, netmask string, vpcid string) *CreatePrivateGatewayParams
 	CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error)
+	CreateStaticRouteAsync(ctx context.Context, p *CreateStaticRouteParams) (*Job, error)
 	NewCreateStaticRouteParams(cidr string) *CreateStaticRouteParams
 	CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error)
+	CreateVPCAsync(ctx context.Context, p *CreateVPCParams) (*Job, error)
 	NewCreateVPCParams(cidr string, displaytext string, name string, vpcofferingid string, zoneid string) *CreateVPCParams
 	CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error)
+	CreateVPCOfferingAsync(ctx context.Context, p *CreateVPCOfferingParams) (*Job, error)
 	NewCreateVPCOfferingParams(displaytext string, name string) *CreateVPCOfferingParams
 	DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error)
+	DeletePrivateGatewayAsync(ctx context.Context, p *DeletePrivateGatewayParams) (*Job, error)
 	NewDeletePrivateGatewayParams(id string) *DeletePrivateGatewayParams
 	DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error)
+	DeleteStaticRouteAsync(ctx context.Context, p *DeleteStaticRouteParams) (*Job, error)
 	NewDeleteStaticRouteParams(id string) *DeleteStaticRouteParams
 	DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error)
+	DeleteVPCAsync(ctx context.Context, p *DeleteVPCParams) (*Job, error)
 	NewDeleteVPCParams(id string) *DeleteVPCParams
 	DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error)
+	DeleteVPCOfferingAsync(ctx context.Context, p *DeleteVPCOfferingParams) (*Job, error)
 	NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingParams
 	ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error)
+	ListPrivateGatewaysAll(p *ListPrivateGatewaysParams, opts ...PageOption) ([]*PrivateGateway, error)
+	ListPrivateGatewaysAllWithContext(ctx context.Context, p *ListPrivateGatewaysParams, opts ...PageOption) ([]*PrivateGateway, error)
+	ListPrivateGatewaysIter(p *ListPrivateGatewaysParams, opts ...PageOption) iter.Seq2[*PrivateGateway, error]
+	ListPrivateGatewaysIterWithContext(ctx context.Context, p *ListPrivateGatewaysParams, opts ...PageOption) iter.Seq2[*PrivateGateway, error]
 	NewListPrivateGatewaysParams() *ListPrivateGatewaysParams
 	GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error)
+	GetPrivateGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PrivateGateway, int, error)
 	ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error)
+	ListStaticRoutesAll(p *ListStaticRoutesParams, opts ...PageOption) ([]*StaticRoute, error)
+	ListStaticRoutesAllWithContext(ctx context.Context, p *ListStaticRoutesParams, opts ...PageOption) ([]*StaticRoute, error)
+	ListStaticRoutesIter(p *ListStaticRoutesParams, opts ...PageOption) iter.Seq2[*StaticRoute, error]
+	ListStaticRoutesIterWithContext(ctx context.Context, p *ListStaticRoutesParams, opts ...PageOption) iter.Seq2[*StaticRoute, error]
 	NewListStaticRoutesParams() *ListStaticRoutesParams
 	GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error)
+	GetStaticRouteByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StaticRoute, int, error)
 	ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error)
+	ListVPCOfferingsAll(p *ListVPCOfferingsParams, opts ...PageOption) ([]*VPCOffering, error)
+	ListVPCOfferingsAllWithContext(ctx context.Context, p *ListVPCOfferingsParams, opts ...PageOption) ([]*VPCOffering, error)
+	ListVPCOfferingsIter(p *ListVPCOfferingsParams, opts ...PageOption) iter.Seq2[*VPCOffering, error]
+	ListVPCOfferingsIterWithContext(ctx context.Context, p *ListVPCOfferingsParams, opts ...PageOption) iter.Seq2[*VPCOffering, error]
 	NewListVPCOfferingsParams() *ListVPCOfferingsParams
 	GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPCOffering, int, error)
 	GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error)
+	GetVPCOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPCOffering, int, error)
 	ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error)
+	ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error)
+	ListVPCsAll(p *ListVPCsParams, opts ...PageOption) ([]*VPC, error)
+	ListVPCsAllWithContext(ctx context.Context, p *ListVPCsParams, opts ...PageOption) ([]*VPC, error)
+	ListVPCsIter(p *ListVPCsParams, opts ...PageOption) iter.Seq2[*VPC, error]
+	ListVPCsIterWithContext(ctx context.Context, p *ListVPCsParams, opts ...PageOption) iter.Seq2[*VPC, error]
 	NewListVPCsParams() *ListVPCsParams
 	GetVPCID(name string, opts ...OptionFunc) (string, int, error)
+	GetVPCIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPC, int, error)
 	GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error)
+	GetVPCByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPC, int, error)
 	MigrateVPC(p *MigrateVPCParams) (*MigrateVPCResponse, error)
+	MigrateVPCWithContext(ctx context.Context, p *MigrateVPCParams) (*MigrateVPCResponse, error)
+	MigrateVPCAsync(ctx context.Context, p *MigrateVPCParams) (*Job, error)
 	NewMigrateVPCParams(vpcid string, vpcofferingid string) *MigrateVPCParams
 	RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error)
+	RestartVPCAsync(ctx context.Context, p *RestartVPCParams) (*Job, error)
 	NewRestartVPCParams(id string) *RestartVPCParams
 	UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error)
+	UpdateVPCAsync(ctx context.Context, p *UpdateVPCParams) (*Job, error)
 	NewUpdateVPCParams(id string) *UpdateVPCParams
 	UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error)
+	UpdateVPCOfferingAsync(ctx context.Context, p *UpdateVPCOfferingParams) (*Job, error)
 	NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingParams
 }
 
@@ -117,6 +171,54 @@ func (p *CreatePrivateGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createPrivateGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreatePrivateGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createPrivateGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createPrivateGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreatePrivateGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"gateway", "ipaddress", "netmask", "vpcid"},
+		lengths:  map[string]int{"aclid": 255, "associatednetworkid": 255, "gateway": 255, "ipaddress": 255, "netmask": 255, "networkofferingid": 255, "physicalnetworkid": 255, "vlan": 255, "vpcid": 255},
+	}.validate("createPrivateGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createPrivateGateway API, before the request is signed
+func (p *CreatePrivateGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreatePrivateGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreatePrivateGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createPrivateGateway", b, &p.p, map[string]paramDecoder{
+		"aclid":                  decodeParam[string],
+		"associatednetworkid":    decodeParam[string],
+		"bypassvlanoverlapcheck": decodeParam[bool],
+		"gateway":                decodeParam[string],
+		"ipaddress":              decodeParam[string],
+		"netmask":                decodeParam[string],
+		"networkofferingid":      decodeParam[string],
+		"physicalnetworkid":      decodeParam[string],
+		"sourcenatsupported":     decodeParam[bool],
+		"vlan":                   decodeParam[string],
+		"vpcid":                  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreatePrivateGatewayParams) Clone() *CreatePrivateGatewayParams {
+	return &CreatePrivateGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *CreatePrivateGatewayParams) SetAclid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -362,32 +464,39 @@ func (s *VPCService) NewCreatePrivateGatewayParams(gateway string, ipaddress str
 
 // Creates a private gateway
 func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("createPrivateGateway", p.toURLValues())
+	return s.CreatePrivateGatewayWithContext(context.Background(), p)
+}
+
+// CreatePrivateGatewayWithContext is like CreatePrivateGateway, but honours the cancellation and deadline of ctx
+func (s *VPCService) CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createPrivateGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreatePrivateGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createPrivateGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createPrivateGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createPrivateGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -395,32 +504,57 @@ func (s *VPCService) CreatePrivateGateway(p *CreatePrivateGatewayParams) (*Creat
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreatePrivateGateway async job into r
+func (r *CreatePrivateGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreatePrivateGatewayAsync starts the async job of CreatePrivateGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) CreatePrivateGatewayAsync(ctx context.Context, p *CreatePrivateGatewayParams) (*Job, error) {
+	r, err := s.CreatePrivateGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createPrivateGateway", r.JobID), nil
+}
+
 type CreatePrivateGatewayResponse struct {
-	Account             string `json:"account"`
-	Aclid               string `json:"aclid"`
-	Aclname             string `json:"aclname"`
-	Associatednetwork   string `json:"associatednetwork"`
-	Associatednetworkid string `json:"associatednetworkid"`
-	Domain              string `json:"domain"`
-	Domainid            string `json:"domainid"`
-	Domainpath          string `json:"domainpath"`
-	Gateway             string `json:"gateway"`
-	Hasannotations      bool   `json:"hasannotations"`
-	Id                  string `json:"id"`
-	Ipaddress           string `json:"ipaddress"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Netmask             string `json:"netmask"`
-	Physicalnetworkid   string `json:"physicalnetworkid"`
-	Project             string `json:"project"`
-	Projectid           string `json:"projectid"`
-	Sourcenatsupported  bool   `json:"sourcenatsupported"`
-	State               string `json:"state"`
-	Vlan                string `json:"vlan"`
-	Vpcid               string `json:"vpcid"`
-	Vpcname             string `json:"vpcname"`
-	Zoneid              string `json:"zoneid"`
-	Zonename            string `json:"zonename"`
+	Account             string                     `json:"account"`
+	Aclid               string                     `json:"aclid"`
+	Aclname             string                     `json:"aclname"`
+	Associatednetwork   string                     `json:"associatednetwork"`
+	Associatednetworkid string                     `json:"associatednetworkid"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Domainpath          string                     `json:"domainpath"`
+	Gateway             string                     `json:"gateway"`
+	Hasannotations      FlexBool                   `json:"hasannotations"`
+	Id                  string                     `json:"id"`
+	Ipaddress           string                     `json:"ipaddress"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Netmask             string                     `json:"netmask"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid"`
+	Project             string                     `json:"project"`
+	Projectid           string                     `json:"projectid"`
+	Sourcenatsupported  FlexBool                   `json:"sourcenatsupported"`
+	State               string                     `json:"state"`
+	Vlan                string                     `json:"vlan"`
+	Vpcid               string                     `json:"vpcid"`
+	Vpcname             string                     `json:"vpcname"`
+	Zoneid              string                     `json:"zoneid"`
+	Zonename            string                     `json:"zonename"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreatePrivateGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias CreatePrivateGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateStaticRouteParams struct {
@@ -447,6 +581,47 @@ func (p *CreateStaticRouteParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createStaticRoute API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateStaticRouteParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createStaticRoute", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createStaticRoute API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateStaticRouteParams) Validate() error {
+	return paramRules{
+		required: []string{"cidr"},
+		lengths:  map[string]int{"cidr": 255, "gatewayid": 255, "nexthop": 255, "vpcid": 255},
+	}.validate("createStaticRoute", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createStaticRoute API, before the request is signed
+func (p *CreateStaticRouteParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateStaticRouteParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateStaticRouteParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createStaticRoute", b, &p.p, map[string]paramDecoder{
+		"cidr":      decodeParam[string],
+		"gatewayid": decodeParam[string],
+		"nexthop":   decodeParam[string],
+		"vpcid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateStaticRouteParams) Clone() *CreateStaticRouteParams {
+	return &CreateStaticRouteParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateStaticRouteParams) SetCidr(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -542,32 +717,39 @@ func (s *VPCService) NewCreateStaticRouteParams(cidr string) *CreateStaticRouteP
 
 // Creates a static route
 func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
-	resp, err := s.cs.newPostRequest("createStaticRoute", p.toURLValues())
+	return s.CreateStaticRouteWithContext(context.Background(), p)
+}
+
+// CreateStaticRouteWithContext is like CreateStaticRoute, but honours the cancellation and deadline of ctx
+func (s *VPCService) CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createStaticRoute", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateStaticRouteResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createStaticRoute", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createStaticRoute", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createStaticRoute", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -575,23 +757,48 @@ func (s *VPCService) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStati
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateStaticRoute async job into r
+func (r *CreateStaticRouteResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateStaticRouteAsync starts the async job of CreateStaticRoute without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) CreateStaticRouteAsync(ctx context.Context, p *CreateStaticRouteParams) (*Job, error) {
+	r, err := s.CreateStaticRouteWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createStaticRoute", r.JobID), nil
+}
+
 type CreateStaticRouteResponse struct {
-	Account      string `json:"account"`
-	Cidr         string `json:"cidr"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Nexthop      string `json:"nexthop"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Vpcgatewayid string `json:"vpcgatewayid"`
-	Vpcgatewayip string `json:"vpcgatewayip"`
-	Vpcid        string `json:"vpcid"`
+	Account      string                     `json:"account"`
+	Cidr         string                     `json:"cidr"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Nexthop      string                     `json:"nexthop"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Vpcgatewayid string                     `json:"vpcgatewayid"`
+	Vpcgatewayip string                     `json:"vpcgatewayip"`
+	Vpcid        string                     `json:"vpcid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateStaticRouteResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateStaticRouteResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCParams struct {
@@ -676,6 +883,64 @@ func (p *CreateVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVPC API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVPCParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVPC", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVPC API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVPCParams) Validate() error {
+	return paramRules{
+		required: []string{"cidr", "displaytext", "name", "vpcofferingid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "cidr": 255, "displaytext": 4096, "dns1": 255, "dns2": 255, "domainid": 255, "ip6dns1": 255, "ip6dns2": 255, "name": 255, "networkdomain": 255, "projectid": 255, "sourcenatipaddress": 255, "vpcofferingid": 255, "zoneid": 255},
+	}.validate("createVPC", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVPC API, before the request is signed
+func (p *CreateVPCParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVPCParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVPCParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVPC", b, &p.p, map[string]paramDecoder{
+		"account":             decodeParam[string],
+		"asnumber":            decodeParam[int64],
+		"bgppeerids":          decodeParam[[]string],
+		"cidr":                decodeParam[string],
+		"cidrsize":            decodeParam[int],
+		"displaytext":         decodeParam[string],
+		"dns1":                decodeParam[string],
+		"dns2":                decodeParam[string],
+		"domainid":            decodeParam[string],
+		"fordisplay":          decodeParam[bool],
+		"ip6dns1":             decodeParam[string],
+		"ip6dns2":             decodeParam[string],
+		"name":                decodeParam[string],
+		"networkdomain":       decodeParam[string],
+		"projectid":           decodeParam[string],
+		"publicmtu":           decodeParam[int],
+		"sourcenatipaddress":  decodeParam[string],
+		"start":               decodeParam[bool],
+		"userouteripresolver": decodeParam[bool],
+		"vpcofferingid":       decodeParam[string],
+		"zoneid":              decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVPCParams) Clone() *CreateVPCParams {
+	return &CreateVPCParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVPCParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1132,32 +1397,39 @@ func (s *VPCService) NewCreateVPCParams(cidr string, displaytext string, name st
 
 // Creates a VPC
 func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
-	resp, err := s.cs.newPostRequest("createVPC", p.toURLValues())
+	return s.CreateVPCWithContext(context.Background(), p)
+}
+
+// CreateVPCWithContext is like CreateVPC, but honours the cancellation and deadline of ctx
+func (s *VPCService) CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVPC", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVPC", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVPC", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1165,22 +1437,41 @@ func (s *VPCService) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVPC async job into r
+func (r *CreateVPCResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVPCAsync starts the async job of CreateVPC without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) CreateVPCAsync(ctx context.Context, p *CreateVPCParams) (*Job, error) {
+	r, err := s.CreateVPCWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVPC", r.JobID), nil
+}
+
 type CreateVPCResponse struct {
 	Account              string                     `json:"account"`
-	Asnumber             int64                      `json:"asnumber"`
+	Asnumber             FlexInt64                  `json:"asnumber"`
 	Asnumberid           string                     `json:"asnumberid"`
 	Bgppeers             []interface{}              `json:"bgppeers"`
 	Cidr                 string                     `json:"cidr"`
-	Created              string                     `json:"created"`
+	Created              Time                       `json:"created"`
 	Displaytext          string                     `json:"displaytext"`
-	Distributedvpcrouter bool                       `json:"distributedvpcrouter"`
+	Distributedvpcrouter FlexBool                   `json:"distributedvpcrouter"`
 	Dns1                 string                     `json:"dns1"`
 	Dns2                 string                     `json:"dns2"`
 	Domain               string                     `json:"domain"`
 	Domainid             string                     `json:"domainid"`
 	Domainpath           string                     `json:"domainpath"`
-	Fordisplay           bool                       `json:"fordisplay"`
-	Hasannotations       bool                       `json:"hasannotations"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
 	Icon                 interface{}                `json:"icon"`
 	Id                   string                     `json:"id"`
 	Ip4routes            []interface{}              `json:"ip4routes"`
@@ -1189,16 +1480,16 @@ type CreateVPCResponse struct {
 	Ip6dns2              string                     `json:"ip6dns2"`
 	Ip6routes            []interface{}              `json:"ip6routes"`
 	JobID                string                     `json:"jobid"`
-	Jobstatus            int                        `json:"jobstatus"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
 	Name                 string                     `json:"name"`
 	Network              []*Network                 `json:"network"`
 	Networkdomain        string                     `json:"networkdomain"`
 	Project              string                     `json:"project"`
 	Projectid            string                     `json:"projectid"`
-	Publicmtu            int                        `json:"publicmtu"`
-	Redundantvpcrouter   bool                       `json:"redundantvpcrouter"`
-	Regionlevelvpc       bool                       `json:"regionlevelvpc"`
-	Restartrequired      bool                       `json:"restartrequired"`
+	Publicmtu            FlexInt                    `json:"publicmtu"`
+	Redundantvpcrouter   FlexBool                   `json:"redundantvpcrouter"`
+	Regionlevelvpc       FlexBool                   `json:"regionlevelvpc"`
+	Restartrequired      FlexBool                   `json:"restartrequired"`
 	Service              []CreateVPCResponseService `json:"service"`
 	State                string                     `json:"state"`
 	Tags                 []Tags                     `json:"tags"`
@@ -1206,28 +1497,52 @@ type CreateVPCResponse struct {
 	Vpcofferingname      string                     `json:"vpcofferingname"`
 	Zoneid               string                     `json:"zoneid"`
 	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVPCResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCResponseService struct {
 	Capability []CreateVPCResponseServiceCapability `json:"capability"`
 	Name       string                               `json:"name"`
 	Provider   []CreateVPCResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage           `json:"-"`
+}
+
+func (r *CreateVPCResponseService) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVPCResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVPCResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCOfferingParams struct {
@@ -1306,6 +1621,59 @@ func (p *CreateVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVPCOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVPCOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVPCOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVPCOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVPCOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"displaytext", "name"},
+		lengths:  map[string]int{"displaytext": 4096, "internetprotocol": 255, "name": 255, "networkmode": 255, "provider": 255, "routingmode": 255, "serviceofferingid": 255},
+	}.validate("createVPCOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVPCOffering API, before the request is signed
+func (p *CreateVPCOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVPCOffering", b, &p.p, map[string]paramDecoder{
+		"displaytext":           decodeParam[string],
+		"domainid":              decodeParam[[]string],
+		"enable":                decodeParam[bool],
+		"fornsx":                decodeParam[bool],
+		"internetprotocol":      decodeParam[string],
+		"name":                  decodeParam[string],
+		"networkmode":           decodeParam[string],
+		"nsxsupportlb":          decodeParam[bool],
+		"provider":              decodeParam[string],
+		"routingmode":           decodeParam[string],
+		"servicecapabilitylist": decodeParam[[]map[string]string],
+		"serviceofferingid":     decodeParam[string],
+		"serviceproviderlist":   decodeParam[map[string]string],
+		"specifyasnumber":       decodeParam[bool],
+		"supportedservices":     decodeParam[[]string],
+		"zoneid":                decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVPCOfferingParams) Clone() *CreateVPCOfferingParams {
+	return &CreateVPCOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVPCOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1668,32 +2036,39 @@ func (s *VPCService) NewCreateVPCOfferingParams(displaytext string, name string)
 
 // Creates VPC offering
 func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("createVPCOffering", p.toURLValues())
+	return s.CreateVPCOfferingWithContext(context.Background(), p)
+}
+
+// CreateVPCOfferingWithContext is like CreateVPCOffering, but honours the cancellation and deadline of ctx
+func (s *VPCService) CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVPCOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVPCOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVPCOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVPCOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVPCOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1701,49 +2076,92 @@ func (s *VPCService) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOf
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVPCOffering async job into r
+func (r *CreateVPCOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVPCOfferingAsync starts the async job of CreateVPCOffering without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) CreateVPCOfferingAsync(ctx context.Context, p *CreateVPCOfferingParams) (*Job, error) {
+	r, err := s.CreateVPCOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVPCOffering", r.JobID), nil
+}
+
 type CreateVPCOfferingResponse struct {
-	Created                string                             `json:"created"`
+	Created                Time                               `json:"created"`
 	Displaytext            string                             `json:"displaytext"`
-	Distributedvpcrouter   bool                               `json:"distributedvpcrouter"`
+	Distributedvpcrouter   FlexBool                           `json:"distributedvpcrouter"`
 	Domain                 string                             `json:"domain"`
 	Domainid               string                             `json:"domainid"`
-	Fornsx                 bool                               `json:"fornsx"`
+	Fornsx                 FlexBool                           `json:"fornsx"`
 	Id                     string                             `json:"id"`
 	Internetprotocol       string                             `json:"internetprotocol"`
-	Isdefault              bool                               `json:"isdefault"`
+	Isdefault              FlexBool                           `json:"isdefault"`
 	JobID                  string                             `json:"jobid"`
-	Jobstatus              int                                `json:"jobstatus"`
+	Jobstatus              FlexInt                            `json:"jobstatus"`
 	Name                   string                             `json:"name"`
 	Networkmode            string                             `json:"networkmode"`
 	Routingmode            string                             `json:"routingmode"`
 	Service                []CreateVPCOfferingResponseService `json:"service"`
-	Specifyasnumber        bool                               `json:"specifyasnumber"`
+	Specifyasnumber        FlexBool                           `json:"specifyasnumber"`
 	State                  string                             `json:"state"`
-	SupportsregionLevelvpc bool                               `json:"supportsregionLevelvpc"`
+	SupportsregionLevelvpc FlexBool                           `json:"supportsregionLevelvpc"`
 	Zone                   string                             `json:"zone"`
 	Zoneid                 string                             `json:"zoneid"`
+	Extra                  map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *CreateVPCOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCOfferingResponseService struct {
 	Capability []CreateVPCOfferingResponseServiceCapability `json:"capability"`
 	Name       string                                       `json:"name"`
 	Provider   []CreateVPCOfferingResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                   `json:"-"`
+}
+
+func (r *CreateVPCOfferingResponseService) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCOfferingResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCOfferingResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVPCOfferingResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCOfferingResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVPCOfferingResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVPCOfferingResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias CreateVPCOfferingResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeletePrivateGatewayParams struct {
@@ -1761,6 +2179,44 @@ func (p *DeletePrivateGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deletePrivateGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeletePrivateGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deletePrivateGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deletePrivateGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeletePrivateGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deletePrivateGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deletePrivateGateway API, before the request is signed
+func (p *DeletePrivateGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeletePrivateGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeletePrivateGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deletePrivateGateway", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeletePrivateGatewayParams) Clone() *DeletePrivateGatewayParams {
+	return &DeletePrivateGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *DeletePrivateGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1793,27 +2249,39 @@ func (s *VPCService) NewDeletePrivateGatewayParams(id string) *DeletePrivateGate
 
 // Deletes a Private gateway
 func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("deletePrivateGateway", p.toURLValues())
+	return s.DeletePrivateGatewayWithContext(context.Background(), p)
+}
+
+// DeletePrivateGatewayWithContext is like DeletePrivateGateway, but honours the cancellation and deadline of ctx
+func (s *VPCService) DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deletePrivateGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeletePrivateGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deletePrivateGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deletePrivateGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deletePrivateGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1821,11 +2289,31 @@ func (s *VPCService) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*Delet
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeletePrivateGateway async job into r
+func (r *DeletePrivateGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeletePrivateGatewayAsync starts the async job of DeletePrivateGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) DeletePrivateGatewayAsync(ctx context.Context, p *DeletePrivateGatewayParams) (*Job, error) {
+	r, err := s.DeletePrivateGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deletePrivateGateway", r.JobID), nil
+}
+
 type DeletePrivateGatewayResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeletePrivateGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias DeletePrivateGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteStaticRouteParams struct {
@@ -1843,6 +2331,44 @@ func (p *DeleteStaticRouteParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteStaticRoute API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteStaticRouteParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteStaticRoute", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteStaticRoute API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteStaticRouteParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteStaticRoute", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteStaticRoute API, before the request is signed
+func (p *DeleteStaticRouteParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteStaticRouteParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteStaticRouteParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteStaticRoute", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteStaticRouteParams) Clone() *DeleteStaticRouteParams {
+	return &DeleteStaticRouteParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteStaticRouteParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1875,27 +2401,39 @@ func (s *VPCService) NewDeleteStaticRouteParams(id string) *DeleteStaticRoutePar
 
 // Deletes a static route
 func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteStaticRoute", p.toURLValues())
+	return s.DeleteStaticRouteWithContext(context.Background(), p)
+}
+
+// DeleteStaticRouteWithContext is like DeleteStaticRoute, but honours the cancellation and deadline of ctx
+func (s *VPCService) DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteStaticRoute", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteStaticRouteResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteStaticRoute", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteStaticRoute", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteStaticRoute", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1903,11 +2441,31 @@ func (s *VPCService) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStati
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteStaticRoute async job into r
+func (r *DeleteStaticRouteResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteStaticRouteAsync starts the async job of DeleteStaticRoute without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) DeleteStaticRouteAsync(ctx context.Context, p *DeleteStaticRouteParams) (*Job, error) {
+	r, err := s.DeleteStaticRouteWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteStaticRoute", r.JobID), nil
+}
+
 type DeleteStaticRouteResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteStaticRouteResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteStaticRouteResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVPCParams struct {
@@ -1925,6 +2483,44 @@ func (p *DeleteVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVPC API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVPCParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVPC", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVPC API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVPCParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVPC", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVPC API, before the request is signed
+func (p *DeleteVPCParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVPCParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVPCParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVPC", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVPCParams) Clone() *DeleteVPCParams {
+	return &DeleteVPCParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVPCParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1957,27 +2553,39 @@ func (s *VPCService) NewDeleteVPCParams(id string) *DeleteVPCParams {
 
 // Deletes a VPC
 func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVPC", p.toURLValues())
+	return s.DeleteVPCWithContext(context.Background(), p)
+}
+
+// DeleteVPCWithContext is like DeleteVPC, but honours the cancellation and deadline of ctx
+func (s *VPCService) DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVPC", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteVPC", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteVPC", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1985,11 +2593,31 @@ func (s *VPCService) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteVPC async job into r
+func (r *DeleteVPCResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteVPCAsync starts the async job of DeleteVPC without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) DeleteVPCAsync(ctx context.Context, p *DeleteVPCParams) (*Job, error) {
+	r, err := s.DeleteVPCWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteVPC", r.JobID), nil
+}
+
 type DeleteVPCResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteVPCResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteVPCResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVPCOfferingParams struct {
@@ -2007,6 +2635,44 @@ func (p *DeleteVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVPCOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVPCOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVPCOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVPCOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVPCOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVPCOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVPCOffering API, before the request is signed
+func (p *DeleteVPCOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVPCOffering", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVPCOfferingParams) Clone() *DeleteVPCOfferingParams {
+	return &DeleteVPCOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVPCOfferingParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2039,27 +2705,39 @@ func (s *VPCService) NewDeleteVPCOfferingParams(id string) *DeleteVPCOfferingPar
 
 // Deletes VPC offering
 func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVPCOffering", p.toURLValues())
+	return s.DeleteVPCOfferingWithContext(context.Background(), p)
+}
+
+// DeleteVPCOfferingWithContext is like DeleteVPCOffering, but honours the cancellation and deadline of ctx
+func (s *VPCService) DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVPCOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVPCOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVPCOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteVPCOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteVPCOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2067,11 +2745,31 @@ func (s *VPCService) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOf
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteVPCOffering async job into r
+func (r *DeleteVPCOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteVPCOfferingAsync starts the async job of DeleteVPCOffering without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) DeleteVPCOfferingAsync(ctx context.Context, p *DeleteVPCOfferingParams) (*Job, error) {
+	r, err := s.DeleteVPCOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteVPCOffering", r.JobID), nil
+}
+
 type DeleteVPCOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteVPCOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteVPCOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListPrivateGatewaysParams struct {
@@ -2129,6 +2827,55 @@ func (p *ListPrivateGatewaysParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listPrivateGateways API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListPrivateGatewaysParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listPrivateGateways", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listPrivateGateways API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListPrivateGatewaysParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "ipaddress": 255, "keyword": 255, "projectid": 255, "state": 255, "vlan": 255, "vpcid": 255},
+	}.validate("listPrivateGateways", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listPrivateGateways API, before the request is signed
+func (p *ListPrivateGatewaysParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListPrivateGatewaysParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListPrivateGatewaysParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listPrivateGateways", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"ipaddress":   decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"state":       decodeParam[string],
+		"vlan":        decodeParam[string],
+		"vpcid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListPrivateGatewaysParams) Clone() *ListPrivateGatewaysParams {
+	return &ListPrivateGatewaysParams{p: cloneParams(p.p)}
+}
+
 func (p *ListPrivateGatewaysParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2412,6 +3159,11 @@ func (s *VPCService) NewListPrivateGatewaysParams() *ListPrivateGatewaysParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
+	return s.GetPrivateGatewayByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetPrivateGatewayByIDWithContext is like GetPrivateGatewayByID, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetPrivateGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
 	p := &ListPrivateGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -2423,11 +3175,10 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 		}
 	}
 
-	l, err := s.ListPrivateGateways(p)
+	l, err := s.ListPrivateGatewaysWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2445,50 +3196,93 @@ func (s *VPCService) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*Priv
 
 // List private gateways
 func (s *VPCService) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listPrivateGateways", p.toURLValues())
+	return s.ListPrivateGatewaysWithContext(context.Background(), p)
+}
+
+// ListPrivateGatewaysWithContext is like ListPrivateGateways, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listPrivateGateways", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListPrivateGatewaysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listPrivateGateways", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListPrivateGatewaysAll returns all PrivateGateways matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPCService) ListPrivateGatewaysAll(p *ListPrivateGatewaysParams, opts ...PageOption) ([]*PrivateGateway, error) {
+	return s.ListPrivateGatewaysAllWithContext(context.Background(), p, opts...)
+}
+
+// ListPrivateGatewaysAllWithContext is like ListPrivateGatewaysAll, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListPrivateGatewaysAllWithContext(ctx context.Context, p *ListPrivateGatewaysParams, opts ...PageOption) ([]*PrivateGateway, error) {
+	return collectPages(s.ListPrivateGatewaysIterWithContext(ctx, p, opts...))
+}
+
+// ListPrivateGatewaysIter returns an iterator over all PrivateGateways matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPCService) ListPrivateGatewaysIter(p *ListPrivateGatewaysParams, opts ...PageOption) iter.Seq2[*PrivateGateway, error] {
+	return s.ListPrivateGatewaysIterWithContext(context.Background(), p, opts...)
+}
+
+// ListPrivateGatewaysIterWithContext is like ListPrivateGatewaysIter, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListPrivateGatewaysIterWithContext(ctx context.Context, p *ListPrivateGatewaysParams, opts ...PageOption) iter.Seq2[*PrivateGateway, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*PrivateGateway, int, error) {
+		l, err := s.ListPrivateGatewaysWithContext(ctx, &ListPrivateGatewaysParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.PrivateGateways, l.Count, nil
+	}, opts...)
+}
+
 type ListPrivateGatewaysResponse struct {
 	Count           int               `json:"count"`
 	PrivateGateways []*PrivateGateway `json:"privategateway"`
 }
 
 type PrivateGateway struct {
-	Account             string `json:"account"`
-	Aclid               string `json:"aclid"`
-	Aclname             string `json:"aclname"`
-	Associatednetwork   string `json:"associatednetwork"`
-	Associatednetworkid string `json:"associatednetworkid"`
-	Domain              string `json:"domain"`
-	Domainid            string `json:"domainid"`
-	Domainpath          string `json:"domainpath"`
-	Gateway             string `json:"gateway"`
-	Hasannotations      bool   `json:"hasannotations"`
-	Id                  string `json:"id"`
-	Ipaddress           string `json:"ipaddress"`
-	JobID               string `json:"jobid"`
-	Jobstatus           int    `json:"jobstatus"`
-	Netmask             string `json:"netmask"`
-	Physicalnetworkid   string `json:"physicalnetworkid"`
-	Project             string `json:"project"`
-	Projectid           string `json:"projectid"`
-	Sourcenatsupported  bool   `json:"sourcenatsupported"`
-	State               string `json:"state"`
-	Vlan                string `json:"vlan"`
-	Vpcid               string `json:"vpcid"`
-	Vpcname             string `json:"vpcname"`
-	Zoneid              string `json:"zoneid"`
-	Zonename            string `json:"zonename"`
+	Account             string                     `json:"account"`
+	Aclid               string                     `json:"aclid"`
+	Aclname             string                     `json:"aclname"`
+	Associatednetwork   string                     `json:"associatednetwork"`
+	Associatednetworkid string                     `json:"associatednetworkid"`
+	Domain              string                     `json:"domain"`
+	Domainid            string                     `json:"domainid"`
+	Domainpath          string                     `json:"domainpath"`
+	Gateway             string                     `json:"gateway"`
+	Hasannotations      FlexBool                   `json:"hasannotations"`
+	Id                  string                     `json:"id"`
+	Ipaddress           string                     `json:"ipaddress"`
+	JobID               string                     `json:"jobid"`
+	Jobstatus           FlexInt                    `json:"jobstatus"`
+	Netmask             string                     `json:"netmask"`
+	Physicalnetworkid   string                     `json:"physicalnetworkid"`
+	Project             string                     `json:"project"`
+	Projectid           string                     `json:"projectid"`
+	Sourcenatsupported  FlexBool                   `json:"sourcenatsupported"`
+	State               string                     `json:"state"`
+	Vlan                string                     `json:"vlan"`
+	Vpcid               string                     `json:"vpcid"`
+	Vpcname             string                     `json:"vpcname"`
+	Zoneid              string                     `json:"zoneid"`
+	Zonename            string                     `json:"zonename"`
+	Extra               map[string]json.RawMessage `json:"-"`
+}
+
+func (r *PrivateGateway) UnmarshalJSON(b []byte) error {
+	type alias PrivateGateway
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListStaticRoutesParams struct {
@@ -2550,6 +3344,55 @@ func (p *ListStaticRoutesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listStaticRoutes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListStaticRoutesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listStaticRoutes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listStaticRoutes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListStaticRoutesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "gatewayid": 255, "id": 255, "keyword": 255, "projectid": 255, "state": 255, "vpcid": 255},
+	}.validate("listStaticRoutes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listStaticRoutes API, before the request is signed
+func (p *ListStaticRoutesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListStaticRoutesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListStaticRoutesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listStaticRoutes", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"gatewayid":   decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"state":       decodeParam[string],
+		"tags":        decodeParam[map[string]string],
+		"vpcid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListStaticRoutesParams) Clone() *ListStaticRoutesParams {
+	return &ListStaticRoutesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListStaticRoutesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2833,6 +3676,11 @@ func (s *VPCService) NewListStaticRoutesParams() *ListStaticRoutesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error) {
+	return s.GetStaticRouteByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetStaticRouteByIDWithContext is like GetStaticRouteByID, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetStaticRouteByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StaticRoute, int, error) {
 	p := &ListStaticRoutesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2844,11 +3692,10 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 		}
 	}
 
-	l, err := s.ListStaticRoutes(p)
+	l, err := s.ListStaticRoutesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2866,41 +3713,84 @@ func (s *VPCService) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticR
 
 // Lists all static routes
 func (s *VPCService) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
-	resp, err := s.cs.newRequest("listStaticRoutes", p.toURLValues())
+	return s.ListStaticRoutesWithContext(context.Background(), p)
+}
+
+// ListStaticRoutesWithContext is like ListStaticRoutes, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listStaticRoutes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListStaticRoutesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listStaticRoutes", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListStaticRoutesAll returns all StaticRoutes matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPCService) ListStaticRoutesAll(p *ListStaticRoutesParams, opts ...PageOption) ([]*StaticRoute, error) {
+	return s.ListStaticRoutesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListStaticRoutesAllWithContext is like ListStaticRoutesAll, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListStaticRoutesAllWithContext(ctx context.Context, p *ListStaticRoutesParams, opts ...PageOption) ([]*StaticRoute, error) {
+	return collectPages(s.ListStaticRoutesIterWithContext(ctx, p, opts...))
+}
+
+// ListStaticRoutesIter returns an iterator over all StaticRoutes matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPCService) ListStaticRoutesIter(p *ListStaticRoutesParams, opts ...PageOption) iter.Seq2[*StaticRoute, error] {
+	return s.ListStaticRoutesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListStaticRoutesIterWithContext is like ListStaticRoutesIter, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListStaticRoutesIterWithContext(ctx context.Context, p *ListStaticRoutesParams, opts ...PageOption) iter.Seq2[*StaticRoute, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*StaticRoute, int, error) {
+		l, err := s.ListStaticRoutesWithContext(ctx, &ListStaticRoutesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.StaticRoutes, l.Count, nil
+	}, opts...)
+}
+
 type ListStaticRoutesResponse struct {
 	Count        int            `json:"count"`
 	StaticRoutes []*StaticRoute `json:"staticroute"`
 }
 
 type StaticRoute struct {
-	Account      string `json:"account"`
-	Cidr         string `json:"cidr"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	Id           string `json:"id"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Nexthop      string `json:"nexthop"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	State        string `json:"state"`
-	Tags         []Tags `json:"tags"`
-	Vpcgatewayid string `json:"vpcgatewayid"`
-	Vpcgatewayip string `json:"vpcgatewayip"`
-	Vpcid        string `json:"vpcid"`
+	Account      string                     `json:"account"`
+	Cidr         string                     `json:"cidr"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	Id           string                     `json:"id"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Nexthop      string                     `json:"nexthop"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	State        string                     `json:"state"`
+	Tags         []Tags                     `json:"tags"`
+	Vpcgatewayid string                     `json:"vpcgatewayid"`
+	Vpcgatewayip string                     `json:"vpcgatewayip"`
+	Vpcid        string                     `json:"vpcid"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StaticRoute) UnmarshalJSON(b []byte) error {
+	type alias StaticRoute
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVPCOfferingsParams struct {
@@ -2952,6 +3842,53 @@ func (p *ListVPCOfferingsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVPCOfferings API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVPCOfferingsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVPCOfferings", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVPCOfferings API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVPCOfferingsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"displaytext": 4096, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "state": 255, "zoneid": 255},
+	}.validate("listVPCOfferings", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVPCOfferings API, before the request is signed
+func (p *ListVPCOfferingsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVPCOfferingsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVPCOfferingsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVPCOfferings", b, &p.p, map[string]paramDecoder{
+		"displaytext":       decodeParam[string],
+		"domainid":          decodeParam[string],
+		"id":                decodeParam[string],
+		"isdefault":         decodeParam[bool],
+		"keyword":           decodeParam[string],
+		"name":              decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"state":             decodeParam[string],
+		"supportedservices": decodeParam[[]string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVPCOfferingsParams) Clone() *ListVPCOfferingsParams {
+	return &ListVPCOfferingsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVPCOfferingsParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3193,6 +4130,11 @@ func (s *VPCService) NewListVPCOfferingsParams() *ListVPCOfferingsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVPCOfferingIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCOfferingIDWithContext is like GetVPCOfferingID, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetVPCOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3204,7 +4146,7 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListVPCOfferings(p)
+	l, err := s.ListVPCOfferingsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3229,12 +4171,17 @@ func (s *VPCService) GetVPCOfferingID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error) {
-	id, count, err := s.GetVPCOfferingID(name, opts...)
+	return s.GetVPCOfferingByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCOfferingByNameWithContext is like GetVPCOfferingByName, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetVPCOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	id, count, err := s.GetVPCOfferingIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVPCOfferingByID(id, opts...)
+	r, count, err := s.GetVPCOfferingByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3243,6 +4190,11 @@ func (s *VPCService) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPC
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	return s.GetVPCOfferingByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVPCOfferingByIDWithContext is like GetVPCOfferingByID, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetVPCOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPCOffering, int, error) {
 	p := &ListVPCOfferingsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3254,11 +4206,10 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 		}
 	}
 
-	l, err := s.ListVPCOfferings(p)
+	l, err := s.ListVPCOfferingsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3276,67 +4227,128 @@ func (s *VPCService) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffe
 
 // Lists VPC offerings
 func (s *VPCService) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCOfferings", p.toURLValues())
+	return s.ListVPCOfferingsWithContext(context.Background(), p)
+}
+
+// ListVPCOfferingsWithContext is like ListVPCOfferings, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVPCOfferings", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVPCOfferingsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVPCOfferings", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVPCOfferingsAll returns all VPCOfferings matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPCService) ListVPCOfferingsAll(p *ListVPCOfferingsParams, opts ...PageOption) ([]*VPCOffering, error) {
+	return s.ListVPCOfferingsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVPCOfferingsAllWithContext is like ListVPCOfferingsAll, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListVPCOfferingsAllWithContext(ctx context.Context, p *ListVPCOfferingsParams, opts ...PageOption) ([]*VPCOffering, error) {
+	return collectPages(s.ListVPCOfferingsIterWithContext(ctx, p, opts...))
+}
+
+// ListVPCOfferingsIter returns an iterator over all VPCOfferings matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPCService) ListVPCOfferingsIter(p *ListVPCOfferingsParams, opts ...PageOption) iter.Seq2[*VPCOffering, error] {
+	return s.ListVPCOfferingsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVPCOfferingsIterWithContext is like ListVPCOfferingsIter, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListVPCOfferingsIterWithContext(ctx context.Context, p *ListVPCOfferingsParams, opts ...PageOption) iter.Seq2[*VPCOffering, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VPCOffering, int, error) {
+		l, err := s.ListVPCOfferingsWithContext(ctx, &ListVPCOfferingsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VPCOfferings, l.Count, nil
+	}, opts...)
+}
+
 type ListVPCOfferingsResponse struct {
 	Count        int            `json:"count"`
 	VPCOfferings []*VPCOffering `json:"vpcoffering"`
 }
 
 type VPCOffering struct {
-	Created                string               `json:"created"`
-	Displaytext            string               `json:"displaytext"`
-	Distributedvpcrouter   bool                 `json:"distributedvpcrouter"`
-	Domain                 string               `json:"domain"`
-	Domainid               string               `json:"domainid"`
-	Fornsx                 bool                 `json:"fornsx"`
-	Id                     string               `json:"id"`
-	Internetprotocol       string               `json:"internetprotocol"`
-	Isdefault              bool                 `json:"isdefault"`
-	JobID                  string               `json:"jobid"`
-	Jobstatus              int                  `json:"jobstatus"`
-	Name                   string               `json:"name"`
-	Networkmode            string               `json:"networkmode"`
-	Routingmode            string               `json:"routingmode"`
-	Service                []VPCOfferingService `json:"service"`
-	Specifyasnumber        bool                 `json:"specifyasnumber"`
-	State                  string               `json:"state"`
-	SupportsregionLevelvpc bool                 `json:"supportsregionLevelvpc"`
-	Zone                   string               `json:"zone"`
-	Zoneid                 string               `json:"zoneid"`
+	Created                Time                       `json:"created"`
+	Displaytext            string                     `json:"displaytext"`
+	Distributedvpcrouter   FlexBool                   `json:"distributedvpcrouter"`
+	Domain                 string                     `json:"domain"`
+	Domainid               string                     `json:"domainid"`
+	Fornsx                 FlexBool                   `json:"fornsx"`
+	Id                     string                     `json:"id"`
+	Internetprotocol       string                     `json:"internetprotocol"`
+	Isdefault              FlexBool                   `json:"isdefault"`
+	JobID                  string                     `json:"jobid"`
+	Jobstatus              FlexInt                    `json:"jobstatus"`
+	Name                   string                     `json:"name"`
+	Networkmode            string                     `json:"networkmode"`
+	Routingmode            string                     `json:"routingmode"`
+	Service                []VPCOfferingService       `json:"service"`
+	Specifyasnumber        FlexBool                   `json:"specifyasnumber"`
+	State                  string                     `json:"state"`
+	SupportsregionLevelvpc FlexBool                   `json:"supportsregionLevelvpc"`
+	Zone                   string                     `json:"zone"`
+	Zoneid                 string                     `json:"zoneid"`
+	Extra                  map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VPCOffering) UnmarshalJSON(b []byte) error {
+	type alias VPCOffering
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VPCOfferingService struct {
 	Capability []VPCOfferingServiceCapability `json:"capability"`
 	Name       string                         `json:"name"`
 	Provider   []VPCOfferingServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage     `json:"-"`
+}
+
+func (r *VPCOfferingService) UnmarshalJSON(b []byte) error {
+	type alias VPCOfferingService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VPCOfferingServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VPCOfferingServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias VPCOfferingServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VPCOfferingServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VPCOfferingServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias VPCOfferingServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVPCsParams struct {
@@ -3423,6 +4435,62 @@ func (p *ListVPCsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVPCs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVPCsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVPCs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVPCs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVPCsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "cidr": 255, "displaytext": 4096, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "state": 255, "vpcofferingid": 255, "zoneid": 255},
+	}.validate("listVPCs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVPCs API, before the request is signed
+func (p *ListVPCsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVPCsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVPCsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVPCs", b, &p.p, map[string]paramDecoder{
+		"account":           decodeParam[string],
+		"cidr":              decodeParam[string],
+		"displaytext":       decodeParam[string],
+		"domainid":          decodeParam[string],
+		"fordisplay":        decodeParam[bool],
+		"id":                decodeParam[string],
+		"isrecursive":       decodeParam[bool],
+		"keyword":           decodeParam[string],
+		"listall":           decodeParam[bool],
+		"name":              decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"projectid":         decodeParam[string],
+		"restartrequired":   decodeParam[bool],
+		"showicon":          decodeParam[bool],
+		"state":             decodeParam[string],
+		"supportedservices": decodeParam[[]string],
+		"tags":              decodeParam[map[string]string],
+		"vpcofferingid":     decodeParam[string],
+		"zoneid":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVPCsParams) Clone() *ListVPCsParams {
+	return &ListVPCsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVPCsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3853,6 +4921,11 @@ func (s *VPCService) NewListVPCsParams() *ListVPCsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVPCIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCIDWithContext is like GetVPCID, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetVPCIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVPCsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3864,7 +4937,7 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 		}
 	}
 
-	l, err := s.ListVPCs(p)
+	l, err := s.ListVPCsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3889,12 +4962,17 @@ func (s *VPCService) GetVPCID(name string, opts ...OptionFunc) (string, int, err
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error) {
-	id, count, err := s.GetVPCID(name, opts...)
+	return s.GetVPCByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVPCByNameWithContext is like GetVPCByName, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetVPCByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPC, int, error) {
+	id, count, err := s.GetVPCIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVPCByID(id, opts...)
+	r, count, err := s.GetVPCByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3903,6 +4981,11 @@ func (s *VPCService) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error) {
+	return s.GetVPCByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVPCByIDWithContext is like GetVPCByID, but honours the cancellation and deadline of ctx
+func (s *VPCService) GetVPCByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPC, int, error) {
 	p := &ListVPCsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3914,11 +4997,10 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 		}
 	}
 
-	l, err := s.ListVPCs(p)
+	l, err := s.ListVPCsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3936,87 +5018,148 @@ func (s *VPCService) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error
 
 // Lists VPCs
 func (s *VPCService) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
-	resp, err := s.cs.newRequest("listVPCs", p.toURLValues())
+	return s.ListVPCsWithContext(context.Background(), p)
+}
+
+// ListVPCsWithContext is like ListVPCs, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVPCs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVPCsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVPCs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVPCsAll returns all VPCs matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPCService) ListVPCsAll(p *ListVPCsParams, opts ...PageOption) ([]*VPC, error) {
+	return s.ListVPCsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVPCsAllWithContext is like ListVPCsAll, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListVPCsAllWithContext(ctx context.Context, p *ListVPCsParams, opts ...PageOption) ([]*VPC, error) {
+	return collectPages(s.ListVPCsIterWithContext(ctx, p, opts...))
+}
+
+// ListVPCsIter returns an iterator over all VPCs matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPCService) ListVPCsIter(p *ListVPCsParams, opts ...PageOption) iter.Seq2[*VPC, error] {
+	return s.ListVPCsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVPCsIterWithContext is like ListVPCsIter, but honours the cancellation and deadline of ctx
+func (s *VPCService) ListVPCsIterWithContext(ctx context.Context, p *ListVPCsParams, opts ...PageOption) iter.Seq2[*VPC, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VPC, int, error) {
+		l, err := s.ListVPCsWithContext(ctx, &ListVPCsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VPCs, l.Count, nil
+	}, opts...)
+}
+
 type ListVPCsResponse struct {
 	Count int    `json:"count"`
 	VPCs  []*VPC `json:"vpc"`
 }
 
 type VPC struct {
-	Account              string               `json:"account"`
-	Asnumber             int64                `json:"asnumber"`
-	Asnumberid           string               `json:"asnumberid"`
-	Bgppeers             []interface{}        `json:"bgppeers"`
-	Cidr                 string               `json:"cidr"`
-	Created              string               `json:"created"`
-	Displaytext          string               `json:"displaytext"`
-	Distributedvpcrouter bool                 `json:"distributedvpcrouter"`
-	Dns1                 string               `json:"dns1"`
-	Dns2                 string               `json:"dns2"`
-	Domain               string               `json:"domain"`
-	Domainid             string               `json:"domainid"`
-	Domainpath           string               `json:"domainpath"`
-	Fordisplay           bool                 `json:"fordisplay"`
-	Hasannotations       bool                 `json:"hasannotations"`
-	Icon                 interface{}          `json:"icon"`
-	Id                   string               `json:"id"`
-	Ip4routes            []interface{}        `json:"ip4routes"`
-	Ip4routing           string               `json:"ip4routing"`
-	Ip6dns1              string               `json:"ip6dns1"`
-	Ip6dns2              string               `json:"ip6dns2"`
-	Ip6routes            []interface{}        `json:"ip6routes"`
-	JobID                string               `json:"jobid"`
-	Jobstatus            int                  `json:"jobstatus"`
-	Name                 string               `json:"name"`
-	Network              []*Network           `json:"network"`
-	Networkdomain        string               `json:"networkdomain"`
-	Project              string               `json:"project"`
-	Projectid            string               `json:"projectid"`
-	Publicmtu            int                  `json:"publicmtu"`
-	Redundantvpcrouter   bool                 `json:"redundantvpcrouter"`
-	Regionlevelvpc       bool                 `json:"regionlevelvpc"`
-	Restartrequired      bool                 `json:"restartrequired"`
-	Service              []VPCServiceInternal `json:"service"`
-	State                string               `json:"state"`
-	Tags                 []Tags               `json:"tags"`
-	Vpcofferingid        string               `json:"vpcofferingid"`
-	Vpcofferingname      string               `json:"vpcofferingname"`
-	Zoneid               string               `json:"zoneid"`
-	Zonename             string               `json:"zonename"`
+	Account              string                     `json:"account"`
+	Asnumber             FlexInt64                  `json:"asnumber"`
+	Asnumberid           string                     `json:"asnumberid"`
+	Bgppeers             []interface{}              `json:"bgppeers"`
+	Cidr                 string                     `json:"cidr"`
+	Created              Time                       `json:"created"`
+	Displaytext          string                     `json:"displaytext"`
+	Distributedvpcrouter FlexBool                   `json:"distributedvpcrouter"`
+	Dns1                 string                     `json:"dns1"`
+	Dns2                 string                     `json:"dns2"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
+	Icon                 interface{}                `json:"icon"`
+	Id                   string                     `json:"id"`
+	Ip4routes            []interface{}              `json:"ip4routes"`
+	Ip4routing           string                     `json:"ip4routing"`
+	Ip6dns1              string                     `json:"ip6dns1"`
+	Ip6dns2              string                     `json:"ip6dns2"`
+	Ip6routes            []interface{}              `json:"ip6routes"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Name                 string                     `json:"name"`
+	Network              []*Network                 `json:"network"`
+	Networkdomain        string                     `json:"networkdomain"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Publicmtu            FlexInt                    `json:"publicmtu"`
+	Redundantvpcrouter   FlexBool                   `json:"redundantvpcrouter"`
+	Regionlevelvpc       FlexBool                   `json:"regionlevelvpc"`
+	Restartrequired      FlexBool                   `json:"restartrequired"`
+	Service              []VPCServiceInternal       `json:"service"`
+	State                string                     `json:"state"`
+	Tags                 []Tags                     `json:"tags"`
+	Vpcofferingid        string                     `json:"vpcofferingid"`
+	Vpcofferingname      string                     `json:"vpcofferingname"`
+	Zoneid               string                     `json:"zoneid"`
+	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VPC) UnmarshalJSON(b []byte) error {
+	type alias VPC
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VPCServiceInternal struct {
 	Capability []VPCServiceInternalCapability `json:"capability"`
 	Name       string                         `json:"name"`
 	Provider   []VPCServiceInternalProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage     `json:"-"`
+}
+
+func (r *VPCServiceInternal) UnmarshalJSON(b []byte) error {
+	type alias VPCServiceInternal
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VPCServiceInternalProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VPCServiceInternalProvider) UnmarshalJSON(b []byte) error {
+	type alias VPCServiceInternalProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VPCServiceInternalCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VPCServiceInternalCapability) UnmarshalJSON(b []byte) error {
+	type alias VPCServiceInternalCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVPCParams struct {
@@ -4048,6 +5191,47 @@ func (p *MigrateVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateVPC API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateVPCParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateVPC", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateVPC API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateVPCParams) Validate() error {
+	return paramRules{
+		required: []string{"vpcid", "vpcofferingid"},
+		lengths:  map[string]int{"vpcid": 255, "vpcofferingid": 255},
+	}.validate("migrateVPC", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateVPC API, before the request is signed
+func (p *MigrateVPCParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateVPCParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateVPCParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateVPC", b, &p.p, map[string]paramDecoder{
+		"resume":               decodeParam[bool],
+		"tiernetworkofferings": decodeParam[map[string]string],
+		"vpcid":                decodeParam[string],
+		"vpcofferingid":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateVPCParams) Clone() *MigrateVPCParams {
+	return &MigrateVPCParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateVPCParams) SetResume(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4144,32 +5328,39 @@ func (s *VPCService) NewMigrateVPCParams(vpcid string, vpcofferingid string) *Mi
 
 // Moves a VPC to another physical network
 func (s *VPCService) MigrateVPC(p *MigrateVPCParams) (*MigrateVPCResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateVPC", p.toURLValues())
+	return s.MigrateVPCWithContext(context.Background(), p)
+}
+
+// MigrateVPCWithContext is like MigrateVPC, but honours the cancellation and deadline of ctx
+func (s *VPCService) MigrateVPCWithContext(ctx context.Context, p *MigrateVPCParams) (*MigrateVPCResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateVPC", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateVPC", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateVPC", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4177,22 +5368,41 @@ func (s *VPCService) MigrateVPC(p *MigrateVPCParams) (*MigrateVPCResponse, error
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateVPC async job into r
+func (r *MigrateVPCResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateVPCAsync starts the async job of MigrateVPC without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) MigrateVPCAsync(ctx context.Context, p *MigrateVPCParams) (*Job, error) {
+	r, err := s.MigrateVPCWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateVPC", r.JobID), nil
+}
+
 type MigrateVPCResponse struct {
 	Account              string                      `json:"account"`
-	Asnumber             int64                       `json:"asnumber"`
+	Asnumber             FlexInt64                   `json:"asnumber"`
 	Asnumberid           string                      `json:"asnumberid"`
 	Bgppeers             []interface{}               `json:"bgppeers"`
 	Cidr                 string                      `json:"cidr"`
-	Created              string                      `json:"created"`
+	Created              Time                        `json:"created"`
 	Displaytext          string                      `json:"displaytext"`
-	Distributedvpcrouter bool                        `json:"distributedvpcrouter"`
+	Distributedvpcrouter FlexBool                    `json:"distributedvpcrouter"`
 	Dns1                 string                      `json:"dns1"`
 	Dns2                 string                      `json:"dns2"`
 	Domain               string                      `json:"domain"`
 	Domainid             string                      `json:"domainid"`
 	Domainpath           string                      `json:"domainpath"`
-	Fordisplay           bool                        `json:"fordisplay"`
-	Hasannotations       bool                        `json:"hasannotations"`
+	Fordisplay           FlexBool                    `json:"fordisplay"`
+	Hasannotations       FlexBool                    `json:"hasannotations"`
 	Icon                 interface{}                 `json:"icon"`
 	Id                   string                      `json:"id"`
 	Ip4routes            []interface{}               `json:"ip4routes"`
@@ -4201,16 +5411,16 @@ type MigrateVPCResponse struct {
 	Ip6dns2              string                      `json:"ip6dns2"`
 	Ip6routes            []interface{}               `json:"ip6routes"`
 	JobID                string                      `json:"jobid"`
-	Jobstatus            int                         `json:"jobstatus"`
+	Jobstatus            FlexInt                     `json:"jobstatus"`
 	Name                 string                      `json:"name"`
 	Network              []*Network                  `json:"network"`
 	Networkdomain        string                      `json:"networkdomain"`
 	Project              string                      `json:"project"`
 	Projectid            string                      `json:"projectid"`
-	Publicmtu            int                         `json:"publicmtu"`
-	Redundantvpcrouter   bool                        `json:"redundantvpcrouter"`
-	Regionlevelvpc       bool                        `json:"regionlevelvpc"`
-	Restartrequired      bool                        `json:"restartrequired"`
+	Publicmtu            FlexInt                     `json:"publicmtu"`
+	Redundantvpcrouter   FlexBool                    `json:"redundantvpcrouter"`
+	Regionlevelvpc       FlexBool                    `json:"regionlevelvpc"`
+	Restartrequired      FlexBool                    `json:"restartrequired"`
 	Service              []MigrateVPCResponseService `json:"service"`
 	State                string                      `json:"state"`
 	Tags                 []Tags                      `json:"tags"`
@@ -4218,28 +5428,52 @@ type MigrateVPCResponse struct {
 	Vpcofferingname      string                      `json:"vpcofferingname"`
 	Zoneid               string                      `json:"zoneid"`
 	Zonename             string                      `json:"zonename"`
+	Extra                map[string]json.RawMessage  `json:"-"`
+}
+
+func (r *MigrateVPCResponse) UnmarshalJSON(b []byte) error {
+	type alias MigrateVPCResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVPCResponseService struct {
 	Capability []MigrateVPCResponseServiceCapability `json:"capability"`
 	Name       string                                `json:"name"`
 	Provider   []MigrateVPCResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage            `json:"-"`
+}
+
+func (r *MigrateVPCResponseService) UnmarshalJSON(b []byte) error {
+	type alias MigrateVPCResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVPCResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateVPCResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias MigrateVPCResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVPCResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateVPCResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias MigrateVPCResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestartVPCParams struct {
@@ -4269,6 +5503,47 @@ func (p *RestartVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the restartVPC API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RestartVPCParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("restartVPC", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the restartVPC API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RestartVPCParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("restartVPC", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the restartVPC API, before the request is signed
+func (p *RestartVPCParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RestartVPCParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RestartVPCParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("restartVPC", b, &p.p, map[string]paramDecoder{
+		"cleanup":       decodeParam[bool],
+		"id":            decodeParam[string],
+		"livepatch":     decodeParam[bool],
+		"makeredundant": decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RestartVPCParams) Clone() *RestartVPCParams {
+	return &RestartVPCParams{p: cloneParams(p.p)}
+}
+
 func (p *RestartVPCParams) SetCleanup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4364,27 +5639,39 @@ func (s *VPCService) NewRestartVPCParams(id string) *RestartVPCParams {
 
 // Restarts a VPC
 func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error) {
-	resp, err := s.cs.newPostRequest("restartVPC", p.toURLValues())
+	return s.RestartVPCWithContext(context.Background(), p)
+}
+
+// RestartVPCWithContext is like RestartVPC, but honours the cancellation and deadline of ctx
+func (s *VPCService) RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "restartVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RestartVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("restartVPC", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "restartVPC", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("restartVPC", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4392,11 +5679,31 @@ func (s *VPCService) RestartVPC(p *RestartVPCParams) (*RestartVPCResponse, error
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RestartVPC async job into r
+func (r *RestartVPCResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RestartVPCAsync starts the async job of RestartVPC without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) RestartVPCAsync(ctx context.Context, p *RestartVPCParams) (*Job, error) {
+	r, err := s.RestartVPCWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("restartVPC", r.JobID), nil
+}
+
 type RestartVPCResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RestartVPCResponse) UnmarshalJSON(b []byte) error {
+	type alias RestartVPCResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCParams struct {
@@ -4434,6 +5741,50 @@ func (p *UpdateVPCParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVPC API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVPCParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVPC", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVPC API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVPCParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "displaytext": 4096, "id": 255, "name": 255, "sourcenatipaddress": 255},
+	}.validate("updateVPC", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVPC API, before the request is signed
+func (p *UpdateVPCParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVPCParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVPCParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVPC", b, &p.p, map[string]paramDecoder{
+		"customid":           decodeParam[string],
+		"displaytext":        decodeParam[string],
+		"fordisplay":         decodeParam[bool],
+		"id":                 decodeParam[string],
+		"name":               decodeParam[string],
+		"publicmtu":          decodeParam[int],
+		"sourcenatipaddress": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVPCParams) Clone() *UpdateVPCParams {
+	return &UpdateVPCParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVPCParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4592,32 +5943,39 @@ func (s *VPCService) NewUpdateVPCParams(id string) *UpdateVPCParams {
 
 // Updates a VPC
 func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVPC", p.toURLValues())
+	return s.UpdateVPCWithContext(context.Background(), p)
+}
+
+// UpdateVPCWithContext is like UpdateVPC, but honours the cancellation and deadline of ctx
+func (s *VPCService) UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVPC", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVPCResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVPC", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVPC", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVPC", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4625,22 +5983,41 @@ func (s *VPCService) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVPC async job into r
+func (r *UpdateVPCResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVPCAsync starts the async job of UpdateVPC without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) UpdateVPCAsync(ctx context.Context, p *UpdateVPCParams) (*Job, error) {
+	r, err := s.UpdateVPCWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVPC", r.JobID), nil
+}
+
 type UpdateVPCResponse struct {
 	Account              string                     `json:"account"`
-	Asnumber             int64                      `json:"asnumber"`
+	Asnumber             FlexInt64                  `json:"asnumber"`
 	Asnumberid           string                     `json:"asnumberid"`
 	Bgppeers             []interface{}              `json:"bgppeers"`
 	Cidr                 string                     `json:"cidr"`
-	Created              string                     `json:"created"`
+	Created              Time                       `json:"created"`
 	Displaytext          string                     `json:"displaytext"`
-	Distributedvpcrouter bool                       `json:"distributedvpcrouter"`
+	Distributedvpcrouter FlexBool                   `json:"distributedvpcrouter"`
 	Dns1                 string                     `json:"dns1"`
 	Dns2                 string                     `json:"dns2"`
 	Domain               string                     `json:"domain"`
 	Domainid             string                     `json:"domainid"`
 	Domainpath           string                     `json:"domainpath"`
-	Fordisplay           bool                       `json:"fordisplay"`
-	Hasannotations       bool                       `json:"hasannotations"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Hasannotations       FlexBool                   `json:"hasannotations"`
 	Icon                 interface{}                `json:"icon"`
 	Id                   string                     `json:"id"`
 	Ip4routes            []interface{}              `json:"ip4routes"`
@@ -4649,16 +6026,16 @@ type UpdateVPCResponse struct {
 	Ip6dns2              string                     `json:"ip6dns2"`
 	Ip6routes            []interface{}              `json:"ip6routes"`
 	JobID                string                     `json:"jobid"`
-	Jobstatus            int                        `json:"jobstatus"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
 	Name                 string                     `json:"name"`
 	Network              []*Network                 `json:"network"`
 	Networkdomain        string                     `json:"networkdomain"`
 	Project              string                     `json:"project"`
 	Projectid            string                     `json:"projectid"`
-	Publicmtu            int                        `json:"publicmtu"`
-	Redundantvpcrouter   bool                       `json:"redundantvpcrouter"`
-	Regionlevelvpc       bool                       `json:"regionlevelvpc"`
-	Restartrequired      bool                       `json:"restartrequired"`
+	Publicmtu            FlexInt                    `json:"publicmtu"`
+	Redundantvpcrouter   FlexBool                   `json:"redundantvpcrouter"`
+	Regionlevelvpc       FlexBool                   `json:"regionlevelvpc"`
+	Restartrequired      FlexBool                   `json:"restartrequired"`
 	Service              []UpdateVPCResponseService `json:"service"`
 	State                string                     `json:"state"`
 	Tags                 []Tags                     `json:"tags"`
@@ -4666,28 +6043,52 @@ type UpdateVPCResponse struct {
 	Vpcofferingname      string                     `json:"vpcofferingname"`
 	Zoneid               string                     `json:"zoneid"`
 	Zonename             string                     `json:"zonename"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVPCResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCResponseService struct {
 	Capability []UpdateVPCResponseServiceCapability `json:"capability"`
 	Name       string                               `json:"name"`
 	Provider   []UpdateVPCResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage           `json:"-"`
+}
+
+func (r *UpdateVPCResponseService) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVPCResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVPCResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCOfferingParams struct {
@@ -4724,6 +6125,50 @@ func (p *UpdateVPCOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVPCOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVPCOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVPCOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVPCOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVPCOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"displaytext": 4096, "domainid": 255, "id": 255, "name": 255, "state": 255, "zoneid": 255},
+	}.validate("updateVPCOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVPCOffering API, before the request is signed
+func (p *UpdateVPCOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVPCOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVPCOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVPCOffering", b, &p.p, map[string]paramDecoder{
+		"displaytext": decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"name":        decodeParam[string],
+		"sortkey":     decodeParam[int],
+		"state":       decodeParam[string],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVPCOfferingParams) Clone() *UpdateVPCOfferingParams {
+	return &UpdateVPCOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVPCOfferingParams) SetDisplaytext(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4882,32 +6327,39 @@ func (s *VPCService) NewUpdateVPCOfferingParams(id string) *UpdateVPCOfferingPar
 
 // Updates VPC offering
 func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVPCOffering", p.toURLValues())
+	return s.UpdateVPCOfferingWithContext(context.Background(), p)
+}
+
+// UpdateVPCOfferingWithContext is like UpdateVPCOffering, but honours the cancellation and deadline of ctx
+func (s *VPCService) UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVPCOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVPCOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVPCOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVPCOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVPCOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4915,47 +6367,90 @@ func (s *VPCService) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOf
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVPCOffering async job into r
+func (r *UpdateVPCOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVPCOfferingAsync starts the async job of UpdateVPCOffering without waiting for it to finish, and returns a handle to the job
+func (s *VPCService) UpdateVPCOfferingAsync(ctx context.Context, p *UpdateVPCOfferingParams) (*Job, error) {
+	r, err := s.UpdateVPCOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVPCOffering", r.JobID), nil
+}
+
 type UpdateVPCOfferingResponse struct {
-	Created                string                             `json:"created"`
+	Created                Time                               `json:"created"`
 	Displaytext            string                             `json:"displaytext"`
-	Distributedvpcrouter   bool                               `json:"distributedvpcrouter"`
+	Distributedvpcrouter   FlexBool                           `json:"distributedvpcrouter"`
 	Domain                 string                             `json:"domain"`
 	Domainid               string                             `json:"domainid"`
-	Fornsx                 bool                               `json:"fornsx"`
+	Fornsx                 FlexBool                           `json:"fornsx"`
 	Id                     string                             `json:"id"`
 	Internetprotocol       string                             `json:"internetprotocol"`
-	Isdefault              bool                               `json:"isdefault"`
+	Isdefault              FlexBool                           `json:"isdefault"`
 	JobID                  string                             `json:"jobid"`
-	Jobstatus              int                                `json:"jobstatus"`
+	Jobstatus              FlexInt                            `json:"jobstatus"`
 	Name                   string                             `json:"name"`
 	Networkmode            string                             `json:"networkmode"`
 	Routingmode            string                             `json:"routingmode"`
 	Service                []UpdateVPCOfferingResponseService `json:"service"`
-	Specifyasnumber        bool                               `json:"specifyasnumber"`
+	Specifyasnumber        FlexBool                           `json:"specifyasnumber"`
 	State                  string                             `json:"state"`
-	SupportsregionLevelvpc bool                               `json:"supportsregionLevelvpc"`
+	SupportsregionLevelvpc FlexBool                           `json:"supportsregionLevelvpc"`
 	Zone                   string                             `json:"zone"`
 	Zoneid                 string                             `json:"zoneid"`
+	Extra                  map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *UpdateVPCOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCOfferingResponseService struct {
 	Capability []UpdateVPCOfferingResponseServiceCapability `json:"capability"`
 	Name       string                                       `json:"name"`
 	Provider   []UpdateVPCOfferingResponseServiceProvider   `json:"provider"`
+	Extra      map[string]json.RawMessage                   `json:"-"`
+}
+
+func (r *UpdateVPCOfferingResponseService) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCOfferingResponseService
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCOfferingResponseServiceProvider struct {
-	Canenableindividualservice   bool     `json:"canenableindividualservice"`
-	Destinationphysicalnetworkid string   `json:"destinationphysicalnetworkid"`
-	Id                           string   `json:"id"`
-	Name                         string   `json:"name"`
-	Physicalnetworkid            string   `json:"physicalnetworkid"`
-	Servicelist                  []string `json:"servicelist"`
-	State                        string   `json:"state"`
+	Canenableindividualservice   FlexBool                   `json:"canenableindividualservice"`
+	Destinationphysicalnetworkid string                     `json:"destinationphysicalnetworkid"`
+	Id                           string                     `json:"id"`
+	Name                         string                     `json:"name"`
+	Physicalnetworkid            string                     `json:"physicalnetworkid"`
+	Servicelist                  []string                   `json:"servicelist"`
+	State                        string                     `json:"state"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVPCOfferingResponseServiceProvider) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCOfferingResponseServiceProvider
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVPCOfferingResponseServiceCapability struct {
-	Canchooseservicecapability bool   `json:"canchooseservicecapability"`
-	Name                       string `json:"name"`
-	Value                      string `json:"value"`
+	Canchooseservicecapability FlexBool                   `json:"canchooseservicecapability"`
+	Name                       string                     `json:"name"`
+	Value                      string                     `json:"value"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVPCOfferingResponseServiceCapability) UnmarshalJSON(b []byte) error {
+	type alias UpdateVPCOfferingResponseServiceCapability
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockVPCServiceIfaceMockRecorder) CreatePrivateGateway(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateGateway", reflect.TypeOf((*MockVPCServiceIface)(nil).CreatePrivateGateway), p)
 }
 
+// CreatePrivateGatewayAsync mocks base method.
+func (m *MockVPCServiceIface) CreatePrivateGatewayAsync(ctx context.Context, p *CreatePrivateGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivateGatewayAsync indicates an expected call of CreatePrivateGatewayAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) CreatePrivateGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateGatewayAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).CreatePrivateGatewayAsync), ctx, p)
+}
+
+// CreatePrivateGatewayWithContext mocks base method.
+func (m *MockVPCServiceIface) CreatePrivateGatewayWithContext(ctx context.Context, p *CreatePrivateGatewayParams) (*CreatePrivateGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreatePrivateGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreatePrivateGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreatePrivateGatewayWithContext indicates an expected call of CreatePrivateGatewayWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) CreatePrivateGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreatePrivateGatewayWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).CreatePrivateGatewayWithContext), ctx, p)
+}
+
 // CreateStaticRoute mocks base method.
 func (m *MockVPCServiceIface) CreateStaticRoute(p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockVPCServiceIfaceMockRecorder) CreateStaticRoute(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaticRoute", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateStaticRoute), p)
 }
 
+// CreateStaticRouteAsync mocks base method.
+func (m *MockVPCServiceIface) CreateStaticRouteAsync(ctx context.Context, p *CreateStaticRouteParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStaticRouteAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStaticRouteAsync indicates an expected call of CreateStaticRouteAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) CreateStaticRouteAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaticRouteAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateStaticRouteAsync), ctx, p)
+}
+
+// CreateStaticRouteWithContext mocks base method.
+func (m *MockVPCServiceIface) CreateStaticRouteWithContext(ctx context.Context, p *CreateStaticRouteParams) (*CreateStaticRouteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateStaticRouteWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateStaticRouteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateStaticRouteWithContext indicates an expected call of CreateStaticRouteWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) CreateStaticRouteWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateStaticRouteWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateStaticRouteWithContext), ctx, p)
+}
+
 // CreateVPC mocks base method.
 func (m *MockVPCServiceIface) CreateVPC(p *CreateVPCParams) (*CreateVPCResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,21 @@ func (mr *MockVPCServiceIfaceMockRecorder) CreateVPC(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPC), p)
 }
 
+// CreateVPCAsync mocks base method.
+func (m *MockVPCServiceIface) CreateVPCAsync(ctx context.Context, p *CreateVPCParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVPCAsync indicates an expected call of CreateVPCAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) CreateVPCAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPCAsync), ctx, p)
+}
+
 // CreateVPCOffering mocks base method.
 func (m *MockVPCServiceIface) CreateVPCOffering(p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,51 @@ func (mr *MockVPCServiceIfaceMockRecorder) CreateVPCOffering(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPCOffering), p)
 }
 
+// CreateVPCOfferingAsync mocks base method.
+func (m *MockVPCServiceIface) CreateVPCOfferingAsync(ctx context.Context, p *CreateVPCOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVPCOfferingAsync indicates an expected call of CreateVPCOfferingAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) CreateVPCOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCOfferingAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPCOfferingAsync), ctx, p)
+}
+
+// CreateVPCOfferingWithContext mocks base method.
+func (m *MockVPCServiceIface) CreateVPCOfferingWithContext(ctx context.Context, p *CreateVPCOfferingParams) (*CreateVPCOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVPCOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVPCOfferingWithContext indicates an expected call of CreateVPCOfferingWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) CreateVPCOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCOfferingWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPCOfferingWithContext), ctx, p)
+}
+
+// CreateVPCWithContext mocks base method.
+func (m *MockVPCServiceIface) CreateVPCWithContext(ctx context.Context, p *CreateVPCParams) (*CreateVPCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVPCWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVPCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVPCWithContext indicates an expected call of CreateVPCWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) CreateVPCWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVPCWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).CreateVPCWithContext), ctx, p)
+}
+
 // DeletePrivateGateway mocks base method.
 func (m *MockVPCServiceIface) DeletePrivateGateway(p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockVPCServiceIfaceMockRecorder) DeletePrivateGateway(p any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateGateway", reflect.TypeOf((*MockVPCServiceIface)(nil).DeletePrivateGateway), p)
 }
 
+// DeletePrivateGatewayAsync mocks base method.
+func (m *MockVPCServiceIface) DeletePrivateGatewayAsync(ctx context.Context, p *DeletePrivateGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePrivateGatewayAsync indicates an expected call of DeletePrivateGatewayAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) DeletePrivateGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateGatewayAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).DeletePrivateGatewayAsync), ctx, p)
+}
+
+// DeletePrivateGatewayWithContext mocks base method.
+func (m *MockVPCServiceIface) DeletePrivateGatewayWithContext(ctx context.Context, p *DeletePrivateGatewayParams) (*DeletePrivateGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletePrivateGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeletePrivateGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletePrivateGatewayWithContext indicates an expected call of DeletePrivateGatewayWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) DeletePrivateGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletePrivateGatewayWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).DeletePrivateGatewayWithContext), ctx, p)
+}
+
 // DeleteStaticRoute mocks base method.
 func (m *MockVPCServiceIface) DeleteStaticRoute(p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +300,36 @@ func (mr *MockVPCServiceIfaceMockRecorder) DeleteStaticRoute(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaticRoute", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteStaticRoute), p)
 }
 
+// DeleteStaticRouteAsync mocks base method.
+func (m *MockVPCServiceIface) DeleteStaticRouteAsync(ctx context.Context, p *DeleteStaticRouteParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaticRouteAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaticRouteAsync indicates an expected call of DeleteStaticRouteAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteStaticRouteAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaticRouteAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteStaticRouteAsync), ctx, p)
+}
+
+// DeleteStaticRouteWithContext mocks base method.
+func (m *MockVPCServiceIface) DeleteStaticRouteWithContext(ctx context.Context, p *DeleteStaticRouteParams) (*DeleteStaticRouteResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteStaticRouteWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteStaticRouteResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteStaticRouteWithContext indicates an expected call of DeleteStaticRouteWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteStaticRouteWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteStaticRouteWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteStaticRouteWithContext), ctx, p)
+}
+
 // DeleteVPC mocks base method.
 func (m *MockVPCServiceIface) DeleteVPC(p *DeleteVPCParams) (*DeleteVPCResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +345,21 @@ func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPC(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPC), p)
 }
 
+// DeleteVPCAsync mocks base method.
+func (m *MockVPCServiceIface) DeleteVPCAsync(ctx context.Context, p *DeleteVPCParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVPCAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVPCAsync indicates an expected call of DeleteVPCAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPCAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPCAsync), ctx, p)
+}
+
 // DeleteVPCOffering mocks base method.
 func (m *MockVPCServiceIface) DeleteVPCOffering(p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +375,51 @@ func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPCOffering(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPCOffering), p)
 }
 
+// DeleteVPCOfferingAsync mocks base method.
+func (m *MockVPCServiceIface) DeleteVPCOfferingAsync(ctx context.Context, p *DeleteVPCOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVPCOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVPCOfferingAsync indicates an expected call of DeleteVPCOfferingAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPCOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCOfferingAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPCOfferingAsync), ctx, p)
+}
+
+// DeleteVPCOfferingWithContext mocks base method.
+func (m *MockVPCServiceIface) DeleteVPCOfferingWithContext(ctx context.Context, p *DeleteVPCOfferingParams) (*DeleteVPCOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVPCOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVPCOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVPCOfferingWithContext indicates an expected call of DeleteVPCOfferingWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPCOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCOfferingWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPCOfferingWithContext), ctx, p)
+}
+
+// DeleteVPCWithContext mocks base method.
+func (m *MockVPCServiceIface) DeleteVPCWithContext(ctx context.Context, p *DeleteVPCParams) (*DeleteVPCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVPCWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVPCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVPCWithContext indicates an expected call of DeleteVPCWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) DeleteVPCWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVPCWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).DeleteVPCWithContext), ctx, p)
+}
+
 // GetPrivateGatewayByID mocks base method.
 func (m *MockVPCServiceIface) GetPrivateGatewayByID(id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
 	m.ctrl.T.Helper()
@@ -199,6 +441,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetPrivateGatewayByID(id any, opts ..
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateGatewayByID", reflect.TypeOf((*MockVPCServiceIface)(nil).GetPrivateGatewayByID), varargs...)
 }
 
+// GetPrivateGatewayByIDWithContext mocks base method.
+func (m *MockVPCServiceIface) GetPrivateGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*PrivateGateway, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetPrivateGatewayByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*PrivateGateway)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetPrivateGatewayByIDWithContext indicates an expected call of GetPrivateGatewayByIDWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetPrivateGatewayByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPrivateGatewayByIDWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetPrivateGatewayByIDWithContext), varargs...)
+}
+
 // GetStaticRouteByID mocks base method.
 func (m *MockVPCServiceIface) GetStaticRouteByID(id string, opts ...OptionFunc) (*StaticRoute, int, error) {
 	m.ctrl.T.Helper()
@@ -220,6 +483,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetStaticRouteByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticRouteByID", reflect.TypeOf((*MockVPCServiceIface)(nil).GetStaticRouteByID), varargs...)
 }
 
+// GetStaticRouteByIDWithContext mocks base method.
+func (m *MockVPCServiceIface) GetStaticRouteByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*StaticRoute, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetStaticRouteByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*StaticRoute)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetStaticRouteByIDWithContext indicates an expected call of GetStaticRouteByIDWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetStaticRouteByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetStaticRouteByIDWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetStaticRouteByIDWithContext), varargs...)
+}
+
 // GetVPCByID mocks base method.
 func (m *MockVPCServiceIface) GetVPCByID(id string, opts ...OptionFunc) (*VPC, int, error) {
 	m.ctrl.T.Helper()
@@ -241,6 +525,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetVPCByID(id any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCByID", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCByID), varargs...)
 }
 
+// GetVPCByIDWithContext mocks base method.
+func (m *MockVPCServiceIface) GetVPCByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPC, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVPCByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VPC)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVPCByIDWithContext indicates an expected call of GetVPCByIDWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetVPCByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCByIDWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCByIDWithContext), varargs...)
+}
+
 // GetVPCByName mocks base method.
 func (m *MockVPCServiceIface) GetVPCByName(name string, opts ...OptionFunc) (*VPC, int, error) {
 	m.ctrl.T.Helper()
@@ -262,6 +567,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetVPCByName(name any, opts ...any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCByName", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCByName), varargs...)
 }
 
+// GetVPCByNameWithContext mocks base method.
+func (m *MockVPCServiceIface) GetVPCByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPC, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVPCByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VPC)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVPCByNameWithContext indicates an expected call of GetVPCByNameWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetVPCByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCByNameWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCByNameWithContext), varargs...)
+}
+
 // GetVPCID mocks base method.
 func (m *MockVPCServiceIface) GetVPCID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -283,6 +609,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetVPCID(name any, opts ...any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCID", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCID), varargs...)
 }
 
+// GetVPCIDWithContext mocks base method.
+func (m *MockVPCServiceIface) GetVPCIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVPCIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVPCIDWithContext indicates an expected call of GetVPCIDWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetVPCIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCIDWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCIDWithContext), varargs...)
+}
+
 // GetVPCOfferingByID mocks base method.
 func (m *MockVPCServiceIface) GetVPCOfferingByID(id string, opts ...OptionFunc) (*VPCOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -304,6 +651,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetVPCOfferingByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCOfferingByID", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCOfferingByID), varargs...)
 }
 
+// GetVPCOfferingByIDWithContext mocks base method.
+func (m *MockVPCServiceIface) GetVPCOfferingByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVPCOfferingByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VPCOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVPCOfferingByIDWithContext indicates an expected call of GetVPCOfferingByIDWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetVPCOfferingByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCOfferingByIDWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCOfferingByIDWithContext), varargs...)
+}
+
 // GetVPCOfferingByName mocks base method.
 func (m *MockVPCServiceIface) GetVPCOfferingByName(name string, opts ...OptionFunc) (*VPCOffering, int, error) {
 	m.ctrl.T.Helper()
@@ -325,6 +693,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetVPCOfferingByName(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCOfferingByName", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCOfferingByName), varargs...)
 }
 
+// GetVPCOfferingByNameWithContext mocks base method.
+func (m *MockVPCServiceIface) GetVPCOfferingByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VPCOffering, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVPCOfferingByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VPCOffering)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVPCOfferingByNameWithContext indicates an expected call of GetVPCOfferingByNameWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetVPCOfferingByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCOfferingByNameWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCOfferingByNameWithContext), varargs...)
+}
+
 // GetVPCOfferingID mocks base method.
 func (m *MockVPCServiceIface) GetVPCOfferingID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -346,6 +735,27 @@ func (mr *MockVPCServiceIfaceMockRecorder) GetVPCOfferingID(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCOfferingID", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCOfferingID), varargs...)
 }
 
+// GetVPCOfferingIDWithContext mocks base method.
+func (m *MockVPCServiceIface) GetVPCOfferingIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVPCOfferingIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVPCOfferingIDWithContext indicates an expected call of GetVPCOfferingIDWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) GetVPCOfferingIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVPCOfferingIDWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).GetVPCOfferingIDWithContext), varargs...)
+}
+
 // ListPrivateGateways mocks base method.
 func (m *MockVPCServiceIface) ListPrivateGateways(p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
 	m.ctrl.T.Helper()
@@ -361,6 +771,99 @@ func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGateways(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGateways", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGateways), p)
 }
 
+// ListPrivateGatewaysAll mocks base method.
+func (m *MockVPCServiceIface) ListPrivateGatewaysAll(p *ListPrivateGatewaysParams, opts ...PageOption) ([]*PrivateGateway, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPrivateGatewaysAll", varargs...)
+	ret0, _ := ret[0].([]*PrivateGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrivateGatewaysAll indicates an expected call of ListPrivateGatewaysAll.
+func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGatewaysAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGatewaysAll", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGatewaysAll), varargs...)
+}
+
+// ListPrivateGatewaysAllWithContext mocks base method.
+func (m *MockVPCServiceIface) ListPrivateGatewaysAllWithContext(ctx context.Context, p *ListPrivateGatewaysParams, opts ...PageOption) ([]*PrivateGateway, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPrivateGatewaysAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*PrivateGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrivateGatewaysAllWithContext indicates an expected call of ListPrivateGatewaysAllWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGatewaysAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGatewaysAllWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGatewaysAllWithContext), varargs...)
+}
+
+// ListPrivateGatewaysIter mocks base method.
+func (m *MockVPCServiceIface) ListPrivateGatewaysIter(p *ListPrivateGatewaysParams, opts ...PageOption) iter.Seq2[*PrivateGateway, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPrivateGatewaysIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PrivateGateway, error])
+	return ret0
+}
+
+// ListPrivateGatewaysIter indicates an expected call of ListPrivateGatewaysIter.
+func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGatewaysIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGatewaysIter", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGatewaysIter), varargs...)
+}
+
+// ListPrivateGatewaysIterWithContext mocks base method.
+func (m *MockVPCServiceIface) ListPrivateGatewaysIterWithContext(ctx context.Context, p *ListPrivateGatewaysParams, opts ...PageOption) iter.Seq2[*PrivateGateway, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListPrivateGatewaysIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*PrivateGateway, error])
+	return ret0
+}
+
+// ListPrivateGatewaysIterWithContext indicates an expected call of ListPrivateGatewaysIterWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGatewaysIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGatewaysIterWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGatewaysIterWithContext), varargs...)
+}
+
+// ListPrivateGatewaysWithContext mocks base method.
+func (m *MockVPCServiceIface) ListPrivateGatewaysWithContext(ctx context.Context, p *ListPrivateGatewaysParams) (*ListPrivateGatewaysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListPrivateGatewaysWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListPrivateGatewaysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListPrivateGatewaysWithContext indicates an expected call of ListPrivateGatewaysWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListPrivateGatewaysWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListPrivateGatewaysWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListPrivateGatewaysWithContext), ctx, p)
+}
+
 // ListStaticRoutes mocks base method.
 func (m *MockVPCServiceIface) ListStaticRoutes(p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
 	m.ctrl.T.Helper()
@@ -376,6 +879,99 @@ func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutes(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutes", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutes), p)
 }
 
+// ListStaticRoutesAll mocks base method.
+func (m *MockVPCServiceIface) ListStaticRoutesAll(p *ListStaticRoutesParams, opts ...PageOption) ([]*StaticRoute, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStaticRoutesAll", varargs...)
+	ret0, _ := ret[0].([]*StaticRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaticRoutesAll indicates an expected call of ListStaticRoutesAll.
+func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutesAll", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutesAll), varargs...)
+}
+
+// ListStaticRoutesAllWithContext mocks base method.
+func (m *MockVPCServiceIface) ListStaticRoutesAllWithContext(ctx context.Context, p *ListStaticRoutesParams, opts ...PageOption) ([]*StaticRoute, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStaticRoutesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*StaticRoute)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaticRoutesAllWithContext indicates an expected call of ListStaticRoutesAllWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutesAllWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutesAllWithContext), varargs...)
+}
+
+// ListStaticRoutesIter mocks base method.
+func (m *MockVPCServiceIface) ListStaticRoutesIter(p *ListStaticRoutesParams, opts ...PageOption) iter.Seq2[*StaticRoute, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStaticRoutesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StaticRoute, error])
+	return ret0
+}
+
+// ListStaticRoutesIter indicates an expected call of ListStaticRoutesIter.
+func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutesIter", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutesIter), varargs...)
+}
+
+// ListStaticRoutesIterWithContext mocks base method.
+func (m *MockVPCServiceIface) ListStaticRoutesIterWithContext(ctx context.Context, p *ListStaticRoutesParams, opts ...PageOption) iter.Seq2[*StaticRoute, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListStaticRoutesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*StaticRoute, error])
+	return ret0
+}
+
+// ListStaticRoutesIterWithContext indicates an expected call of ListStaticRoutesIterWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutesIterWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutesIterWithContext), varargs...)
+}
+
+// ListStaticRoutesWithContext mocks base method.
+func (m *MockVPCServiceIface) ListStaticRoutesWithContext(ctx context.Context, p *ListStaticRoutesParams) (*ListStaticRoutesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListStaticRoutesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListStaticRoutesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListStaticRoutesWithContext indicates an expected call of ListStaticRoutesWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListStaticRoutesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListStaticRoutesWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListStaticRoutesWithContext), ctx, p)
+}
+
 // ListVPCOfferings mocks base method.
 func (m *MockVPCServiceIface) ListVPCOfferings(p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +987,99 @@ func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferings(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferings", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferings), p)
 }
 
+// ListVPCOfferingsAll mocks base method.
+func (m *MockVPCServiceIface) ListVPCOfferingsAll(p *ListVPCOfferingsParams, opts ...PageOption) ([]*VPCOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCOfferingsAll", varargs...)
+	ret0, _ := ret[0].([]*VPCOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCOfferingsAll indicates an expected call of ListVPCOfferingsAll.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferingsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferingsAll", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferingsAll), varargs...)
+}
+
+// ListVPCOfferingsAllWithContext mocks base method.
+func (m *MockVPCServiceIface) ListVPCOfferingsAllWithContext(ctx context.Context, p *ListVPCOfferingsParams, opts ...PageOption) ([]*VPCOffering, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCOfferingsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VPCOffering)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCOfferingsAllWithContext indicates an expected call of ListVPCOfferingsAllWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferingsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferingsAllWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferingsAllWithContext), varargs...)
+}
+
+// ListVPCOfferingsIter mocks base method.
+func (m *MockVPCServiceIface) ListVPCOfferingsIter(p *ListVPCOfferingsParams, opts ...PageOption) iter.Seq2[*VPCOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCOfferingsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VPCOffering, error])
+	return ret0
+}
+
+// ListVPCOfferingsIter indicates an expected call of ListVPCOfferingsIter.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferingsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferingsIter", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferingsIter), varargs...)
+}
+
+// ListVPCOfferingsIterWithContext mocks base method.
+func (m *MockVPCServiceIface) ListVPCOfferingsIterWithContext(ctx context.Context, p *ListVPCOfferingsParams, opts ...PageOption) iter.Seq2[*VPCOffering, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCOfferingsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VPCOffering, error])
+	return ret0
+}
+
+// ListVPCOfferingsIterWithContext indicates an expected call of ListVPCOfferingsIterWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferingsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferingsIterWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferingsIterWithContext), varargs...)
+}
+
+// ListVPCOfferingsWithContext mocks base method.
+func (m *MockVPCServiceIface) ListVPCOfferingsWithContext(ctx context.Context, p *ListVPCOfferingsParams) (*ListVPCOfferingsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCOfferingsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVPCOfferingsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCOfferingsWithContext indicates an expected call of ListVPCOfferingsWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCOfferingsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCOfferingsWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCOfferingsWithContext), ctx, p)
+}
+
 // ListVPCs mocks base method.
 func (m *MockVPCServiceIface) ListVPCs(p *ListVPCsParams) (*ListVPCsResponse, error) {
 	m.ctrl.T.Helper()
@@ -406,6 +1095,99 @@ func (mr *MockVPCServiceIfaceMockRecorder) ListVPCs(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCs", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCs), p)
 }
 
+// ListVPCsAll mocks base method.
+func (m *MockVPCServiceIface) ListVPCsAll(p *ListVPCsParams, opts ...PageOption) ([]*VPC, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCsAll", varargs...)
+	ret0, _ := ret[0].([]*VPC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCsAll indicates an expected call of ListVPCsAll.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCsAll", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCsAll), varargs...)
+}
+
+// ListVPCsAllWithContext mocks base method.
+func (m *MockVPCServiceIface) ListVPCsAllWithContext(ctx context.Context, p *ListVPCsParams, opts ...PageOption) ([]*VPC, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VPC)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCsAllWithContext indicates an expected call of ListVPCsAllWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCsAllWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCsAllWithContext), varargs...)
+}
+
+// ListVPCsIter mocks base method.
+func (m *MockVPCServiceIface) ListVPCsIter(p *ListVPCsParams, opts ...PageOption) iter.Seq2[*VPC, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VPC, error])
+	return ret0
+}
+
+// ListVPCsIter indicates an expected call of ListVPCsIter.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCsIter", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCsIter), varargs...)
+}
+
+// ListVPCsIterWithContext mocks base method.
+func (m *MockVPCServiceIface) ListVPCsIterWithContext(ctx context.Context, p *ListVPCsParams, opts ...PageOption) iter.Seq2[*VPC, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVPCsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VPC, error])
+	return ret0
+}
+
+// ListVPCsIterWithContext indicates an expected call of ListVPCsIterWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCsIterWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCsIterWithContext), varargs...)
+}
+
+// ListVPCsWithContext mocks base method.
+func (m *MockVPCServiceIface) ListVPCsWithContext(ctx context.Context, p *ListVPCsParams) (*ListVPCsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVPCsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVPCsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVPCsWithContext indicates an expected call of ListVPCsWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) ListVPCsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVPCsWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).ListVPCsWithContext), ctx, p)
+}
+
 // MigrateVPC mocks base method.
 func (m *MockVPCServiceIface) MigrateVPC(p *MigrateVPCParams) (*MigrateVPCResponse, error) {
 	m.ctrl.T.Helper()
@@ -421,6 +1203,36 @@ func (mr *MockVPCServiceIfaceMockRecorder) MigrateVPC(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).MigrateVPC), p)
 }
 
+// MigrateVPCAsync mocks base method.
+func (m *MockVPCServiceIface) MigrateVPCAsync(ctx context.Context, p *MigrateVPCParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVPCAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVPCAsync indicates an expected call of MigrateVPCAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) MigrateVPCAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVPCAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).MigrateVPCAsync), ctx, p)
+}
+
+// MigrateVPCWithContext mocks base method.
+func (m *MockVPCServiceIface) MigrateVPCWithContext(ctx context.Context, p *MigrateVPCParams) (*MigrateVPCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVPCWithContext", ctx, p)
+	ret0, _ := ret[0].(*MigrateVPCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVPCWithContext indicates an expected call of MigrateVPCWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) MigrateVPCWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVPCWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).MigrateVPCWithContext), ctx, p)
+}
+
 // NewCreatePrivateGatewayParams mocks base method.
 func (m *MockVPCServiceIface) NewCreatePrivateGatewayParams(gateway, ipaddress, netmask, vpcid string) *CreatePrivateGatewayParams {
 	m.ctrl.T.Helper()
@@ -660,6 +1472,36 @@ func (mr *MockVPCServiceIfaceMockRecorder) RestartVPC(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).RestartVPC), p)
 }
 
+// RestartVPCAsync mocks base method.
+func (m *MockVPCServiceIface) RestartVPCAsync(ctx context.Context, p *RestartVPCParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartVPCAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartVPCAsync indicates an expected call of RestartVPCAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) RestartVPCAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartVPCAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).RestartVPCAsync), ctx, p)
+}
+
+// RestartVPCWithContext mocks base method.
+func (m *MockVPCServiceIface) RestartVPCWithContext(ctx context.Context, p *RestartVPCParams) (*RestartVPCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestartVPCWithContext", ctx, p)
+	ret0, _ := ret[0].(*RestartVPCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestartVPCWithContext indicates an expected call of RestartVPCWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) RestartVPCWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestartVPCWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).RestartVPCWithContext), ctx, p)
+}
+
 // UpdateVPC mocks base method.
 func (m *MockVPCServiceIface) UpdateVPC(p *UpdateVPCParams) (*UpdateVPCResponse, error) {
 	m.ctrl.T.Helper()
@@ -675,6 +1517,21 @@ func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPC(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPC", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPC), p)
 }
 
+// UpdateVPCAsync mocks base method.
+func (m *MockVPCServiceIface) UpdateVPCAsync(ctx context.Context, p *UpdateVPCParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVPCAsync indicates an expected call of UpdateVPCAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPCAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPCAsync), ctx, p)
+}
+
 // UpdateVPCOffering mocks base method.
 func (m *MockVPCServiceIface) UpdateVPCOffering(p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -689,3 +1546,48 @@ func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPCOffering(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCOffering", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPCOffering), p)
 }
+
+// UpdateVPCOfferingAsync mocks base method.
+func (m *MockVPCServiceIface) UpdateVPCOfferingAsync(ctx context.Context, p *UpdateVPCOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVPCOfferingAsync indicates an expected call of UpdateVPCOfferingAsync.
+func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPCOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCOfferingAsync", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPCOfferingAsync), ctx, p)
+}
+
+// UpdateVPCOfferingWithContext mocks base method.
+func (m *MockVPCServiceIface) UpdateVPCOfferingWithContext(ctx context.Context, p *UpdateVPCOfferingParams) (*UpdateVPCOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVPCOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVPCOfferingWithContext indicates an expected call of UpdateVPCOfferingWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPCOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCOfferingWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPCOfferingWithContext), ctx, p)
+}
+
+// UpdateVPCWithContext mocks base method.
+func (m *MockVPCServiceIface) UpdateVPCWithContext(ctx context.Context, p *UpdateVPCParams) (*UpdateVPCResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVPCWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVPCResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVPCWithContext indicates an expected call of UpdateVPCWithContext.
+func (mr *MockVPCServiceIfaceMockRecorder) UpdateVPCWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVPCWithContext", reflect.TypeOf((*MockVPCServiceIface)(nil).UpdateVPCWithContext), ctx, p)
+}
@@ -20,60 +20,123 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type VPNServiceIface interface {
 	AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error)
+	AddVpnUserAsync(ctx context.Context, p *AddVpnUserParams) (*Job, error)
 	NewAddVpnUserParams(password string, username string) *AddVpnUserParams
 	CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error)
+	CreateRemoteAccessVpnAsync(ctx context.Context, p *CreateRemoteAccessVpnParams) (*Job, error)
 	NewCreateRemoteAccessVpnParams(publicipid string) *CreateRemoteAccessVpnParams
 	CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error)
+	CreateVpnConnectionAsync(ctx context.Context, p *CreateVpnConnectionParams) (*Job, error)
 	NewCreateVpnConnectionParams(s2scustomergatewayid string, s2svpngatewayid string) *CreateVpnConnectionParams
 	CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error)
+	CreateVpnCustomerGatewayAsync(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*Job, error)
 	NewCreateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, ikepolicy string, ipsecpsk string) *CreateVpnCustomerGatewayParams
 	CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error)
+	CreateVpnGatewayAsync(ctx context.Context, p *CreateVpnGatewayParams) (*Job, error)
 	NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayParams
 	DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error)
+	DeleteRemoteAccessVpnAsync(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*Job, error)
 	NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRemoteAccessVpnParams
 	DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error)
+	DeleteVpnConnectionAsync(ctx context.Context, p *DeleteVpnConnectionParams) (*Job, error)
 	NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectionParams
 	DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error)
+	DeleteVpnCustomerGatewayAsync(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*Job, error)
 	NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCustomerGatewayParams
 	DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error)
+	DeleteVpnGatewayAsync(ctx context.Context, p *DeleteVpnGatewayParams) (*Job, error)
 	NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParams
 	ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error)
+	ListRemoteAccessVpnsAll(p *ListRemoteAccessVpnsParams, opts ...PageOption) ([]*RemoteAccessVpn, error)
+	ListRemoteAccessVpnsAllWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams, opts ...PageOption) ([]*RemoteAccessVpn, error)
+	ListRemoteAccessVpnsIter(p *ListRemoteAccessVpnsParams, opts ...PageOption) iter.Seq2[*RemoteAccessVpn, error]
+	ListRemoteAccessVpnsIterWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams, opts ...PageOption) iter.Seq2[*RemoteAccessVpn, error]
 	NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams
 	GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
+	GetRemoteAccessVpnByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error)
 	ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error)
+	ListVpnConnectionsAll(p *ListVpnConnectionsParams, opts ...PageOption) ([]*VpnConnection, error)
+	ListVpnConnectionsAllWithContext(ctx context.Context, p *ListVpnConnectionsParams, opts ...PageOption) ([]*VpnConnection, error)
+	ListVpnConnectionsIter(p *ListVpnConnectionsParams, opts ...PageOption) iter.Seq2[*VpnConnection, error]
+	ListVpnConnectionsIterWithContext(ctx context.Context, p *ListVpnConnectionsParams, opts ...PageOption) iter.Seq2[*VpnConnection, error]
 	NewListVpnConnectionsParams() *ListVpnConnectionsParams
 	GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error)
+	GetVpnConnectionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnConnection, int, error)
 	ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error)
+	ListVpnCustomerGatewaysAll(p *ListVpnCustomerGatewaysParams, opts ...PageOption) ([]*VpnCustomerGateway, error)
+	ListVpnCustomerGatewaysAllWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams, opts ...PageOption) ([]*VpnCustomerGateway, error)
+	ListVpnCustomerGatewaysIter(p *ListVpnCustomerGatewaysParams, opts ...PageOption) iter.Seq2[*VpnCustomerGateway, error]
+	ListVpnCustomerGatewaysIterWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams, opts ...PageOption) iter.Seq2[*VpnCustomerGateway, error]
 	NewListVpnCustomerGatewaysParams() *ListVpnCustomerGatewaysParams
 	GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetVpnCustomerGatewayIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
 	GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
+	GetVpnCustomerGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error)
 	ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error)
+	ListVpnGatewaysAll(p *ListVpnGatewaysParams, opts ...PageOption) ([]*VpnGateway, error)
+	ListVpnGatewaysAllWithContext(ctx context.Context, p *ListVpnGatewaysParams, opts ...PageOption) ([]*VpnGateway, error)
+	ListVpnGatewaysIter(p *ListVpnGatewaysParams, opts ...PageOption) iter.Seq2[*VpnGateway, error]
+	ListVpnGatewaysIterWithContext(ctx context.Context, p *ListVpnGatewaysParams, opts ...PageOption) iter.Seq2[*VpnGateway, error]
 	NewListVpnGatewaysParams() *ListVpnGatewaysParams
 	GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error)
+	GetVpnGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnGateway, int, error)
 	ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error)
+	ListVpnUsersAll(p *ListVpnUsersParams, opts ...PageOption) ([]*VpnUser, error)
+	ListVpnUsersAllWithContext(ctx context.Context, p *ListVpnUsersParams, opts ...PageOption) ([]*VpnUser, error)
+	ListVpnUsersIter(p *ListVpnUsersParams, opts ...PageOption) iter.Seq2[*VpnUser, error]
+	ListVpnUsersIterWithContext(ctx context.Context, p *ListVpnUsersParams, opts ...PageOption) iter.Seq2[*VpnUser, error]
 	NewListVpnUsersParams() *ListVpnUsersParams
 	GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error)
+	GetVpnUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnUser, int, error)
 	RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error)
+	RemoveVpnUserAsync(ctx context.Context, p *RemoveVpnUserParams) (*Job, error)
 	NewRemoveVpnUserParams(username string) *RemoveVpnUserParams
 	ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error)
+	ResetVpnConnectionAsync(ctx context.Context, p *ResetVpnConnectionParams) (*Job, error)
 	NewResetVpnConnectionParams(id string) *ResetVpnConnectionParams
 	UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error)
+	UpdateRemoteAccessVpnAsync(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*Job, error)
 	NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAccessVpnParams
 	UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error)
+	UpdateVpnConnectionAsync(ctx context.Context, p *UpdateVpnConnectionParams) (*Job, error)
 	NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectionParams
 	UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error)
+	UpdateVpnCustomerGatewayAsync(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*Job, error)
 	NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolicy string, gateway string, id string, ikepolicy string, ipsecpsk string) *UpdateVpnCustomerGatewayParams
 	UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error)
+	UpdateVpnGatewayAsync(ctx context.Context, p *UpdateVpnGatewayParams) (*Job, error)
 	NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParams
 }
 
@@ -104,6 +167,48 @@ func (p *AddVpnUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addVpnUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddVpnUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addVpnUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addVpnUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddVpnUserParams) Validate() error {
+	return paramRules{
+		required: []string{"password", "username"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "password": 255, "projectid": 255, "username": 255},
+	}.validate("addVpnUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addVpnUser API, before the request is signed
+func (p *AddVpnUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddVpnUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddVpnUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addVpnUser", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"password":  decodeParam[string],
+		"projectid": decodeParam[string],
+		"username":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddVpnUserParams) Clone() *AddVpnUserParams {
+	return &AddVpnUserParams{p: cloneParams(p.p)}
+}
+
 func (p *AddVpnUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -221,32 +326,39 @@ func (s *VPNService) NewAddVpnUserParams(password string, username string) *AddV
 
 // Adds VPN Users
 func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error) {
-	resp, err := s.cs.newPostRequest("addVpnUser", p.toURLValues())
+	return s.AddVpnUserWithContext(context.Background(), p)
+}
+
+// AddVpnUserWithContext is like AddVpnUser, but honours the cancellation and deadline of ctx
+func (s *VPNService) AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addVpnUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddVpnUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addVpnUser", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addVpnUser", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addVpnUser", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -254,18 +366,43 @@ func (s *VPNService) AddVpnUser(p *AddVpnUserParams) (*AddVpnUserResponse, error
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddVpnUser async job into r
+func (r *AddVpnUserResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddVpnUserAsync starts the async job of AddVpnUser without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) AddVpnUserAsync(ctx context.Context, p *AddVpnUserParams) (*Job, error) {
+	r, err := s.AddVpnUserWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addVpnUser", r.JobID), nil
+}
+
 type AddVpnUserResponse struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
-	State      string `json:"state"`
-	Username   string `json:"username"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	State      string                     `json:"state"`
+	Username   string                     `json:"username"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddVpnUserResponse) UnmarshalJSON(b []byte) error {
+	type alias AddVpnUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateRemoteAccessVpnParams struct {
@@ -300,6 +437,49 @@ func (p *CreateRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createRemoteAccessVpn API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateRemoteAccessVpnParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createRemoteAccessVpn", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createRemoteAccessVpn API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateRemoteAccessVpnParams) Validate() error {
+	return paramRules{
+		required: []string{"publicipid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "iprange": 255, "publicipid": 255},
+	}.validate("createRemoteAccessVpn", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createRemoteAccessVpn API, before the request is signed
+func (p *CreateRemoteAccessVpnParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createRemoteAccessVpn", b, &p.p, map[string]paramDecoder{
+		"account":      decodeParam[string],
+		"domainid":     decodeParam[string],
+		"fordisplay":   decodeParam[bool],
+		"iprange":      decodeParam[string],
+		"openfirewall": decodeParam[bool],
+		"publicipid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateRemoteAccessVpnParams) Clone() *CreateRemoteAccessVpnParams {
+	return &CreateRemoteAccessVpnParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateRemoteAccessVpnParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -437,32 +617,39 @@ func (s *VPNService) NewCreateRemoteAccessVpnParams(publicipid string) *CreateRe
 
 // Creates a l2tp/ipsec remote access VPN
 func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newPostRequest("createRemoteAccessVpn", p.toURLValues())
+	return s.CreateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// CreateRemoteAccessVpnWithContext is like CreateRemoteAccessVpn, but honours the cancellation and deadline of ctx
+func (s *VPNService) CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createRemoteAccessVpn", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateRemoteAccessVpnResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createRemoteAccessVpn", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createRemoteAccessVpn", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createRemoteAccessVpn", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -470,22 +657,47 @@ func (s *VPNService) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*Cre
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateRemoteAccessVpn async job into r
+func (r *CreateRemoteAccessVpnResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateRemoteAccessVpnAsync starts the async job of CreateRemoteAccessVpn without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) CreateRemoteAccessVpnAsync(ctx context.Context, p *CreateRemoteAccessVpnParams) (*Job, error) {
+	r, err := s.CreateRemoteAccessVpnWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createRemoteAccessVpn", r.JobID), nil
+}
+
 type CreateRemoteAccessVpnResponse struct {
-	Account      string `json:"account"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Id           string `json:"id"`
-	Iprange      string `json:"iprange"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Presharedkey string `json:"presharedkey"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	Publicip     string `json:"publicip"`
-	Publicipid   string `json:"publicipid"`
-	State        string `json:"state"`
+	Account      string                     `json:"account"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Id           string                     `json:"id"`
+	Iprange      string                     `json:"iprange"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Presharedkey string                     `json:"presharedkey"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	Publicip     string                     `json:"publicip"`
+	Publicipid   string                     `json:"publicipid"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateRemoteAccessVpnResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateRemoteAccessVpnResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVpnConnectionParams struct {
@@ -514,6 +726,47 @@ func (p *CreateVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVpnConnection API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVpnConnectionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVpnConnection", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVpnConnection API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVpnConnectionParams) Validate() error {
+	return paramRules{
+		required: []string{"s2scustomergatewayid", "s2svpngatewayid"},
+		lengths:  map[string]int{"s2scustomergatewayid": 255, "s2svpngatewayid": 255},
+	}.validate("createVpnConnection", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVpnConnection API, before the request is signed
+func (p *CreateVpnConnectionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVpnConnection", b, &p.p, map[string]paramDecoder{
+		"fordisplay":           decodeParam[bool],
+		"passive":              decodeParam[bool],
+		"s2scustomergatewayid": decodeParam[string],
+		"s2svpngatewayid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVpnConnectionParams) Clone() *CreateVpnConnectionParams {
+	return &CreateVpnConnectionParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVpnConnectionParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -610,32 +863,39 @@ func (s *VPNService) NewCreateVpnConnectionParams(s2scustomergatewayid string, s
 
 // Create site to site VPN connection
 func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
-	resp, err := s.cs.newPostRequest("createVpnConnection", p.toURLValues())
+	return s.CreateVpnConnectionWithContext(context.Background(), p)
+}
+
+// CreateVpnConnectionWithContext is like CreateVpnConnection, but honours the cancellation and deadline of ctx
+func (s *VPNService) CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVpnConnection", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -643,35 +903,60 @@ func (s *VPNService) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateV
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVpnConnection async job into r
+func (r *CreateVpnConnectionResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVpnConnectionAsync starts the async job of CreateVpnConnection without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) CreateVpnConnectionAsync(ctx context.Context, p *CreateVpnConnectionParams) (*Job, error) {
+	r, err := s.CreateVpnConnectionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVpnConnection", r.JobID), nil
+}
+
 type CreateVpnConnectionResponse struct {
-	Account              string `json:"account"`
-	Cidrlist             string `json:"cidrlist"`
-	Created              string `json:"created"`
-	Domain               string `json:"domain"`
-	Domainid             string `json:"domainid"`
-	Domainpath           string `json:"domainpath"`
-	Dpd                  bool   `json:"dpd"`
-	Esplifetime          int64  `json:"esplifetime"`
-	Esppolicy            string `json:"esppolicy"`
-	Forceencap           bool   `json:"forceencap"`
-	Fordisplay           bool   `json:"fordisplay"`
-	Gateway              string `json:"gateway"`
-	Id                   string `json:"id"`
-	Ikelifetime          int64  `json:"ikelifetime"`
-	Ikepolicy            string `json:"ikepolicy"`
-	Ikeversion           string `json:"ikeversion"`
-	Ipsecpsk             string `json:"ipsecpsk"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Passive              bool   `json:"passive"`
-	Project              string `json:"project"`
-	Projectid            string `json:"projectid"`
-	Publicip             string `json:"publicip"`
-	Removed              string `json:"removed"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid"`
-	S2svpngatewayid      string `json:"s2svpngatewayid"`
-	Splitconnections     bool   `json:"splitconnections"`
-	State                string `json:"state"`
+	Account              string                     `json:"account"`
+	Cidrlist             string                     `json:"cidrlist"`
+	Created              Time                       `json:"created"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Dpd                  FlexBool                   `json:"dpd"`
+	Esplifetime          FlexInt64                  `json:"esplifetime"`
+	Esppolicy            string                     `json:"esppolicy"`
+	Forceencap           FlexBool                   `json:"forceencap"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Gateway              string                     `json:"gateway"`
+	Id                   string                     `json:"id"`
+	Ikelifetime          FlexInt64                  `json:"ikelifetime"`
+	Ikepolicy            string                     `json:"ikepolicy"`
+	Ikeversion           string                     `json:"ikeversion"`
+	Ipsecpsk             string                     `json:"ipsecpsk"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Passive              FlexBool                   `json:"passive"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Publicip             string                     `json:"publicip"`
+	Removed              Time                       `json:"removed"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid"`
+	Splitconnections     FlexBool                   `json:"splitconnections"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVpnConnectionResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVpnConnectionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVpnCustomerGatewayParams struct {
@@ -736,6 +1021,58 @@ func (p *CreateVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVpnCustomerGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVpnCustomerGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVpnCustomerGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVpnCustomerGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVpnCustomerGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"cidrlist", "esppolicy", "gateway", "ikepolicy", "ipsecpsk"},
+		lengths:  map[string]int{"account": 255, "cidrlist": 255, "domainid": 255, "esppolicy": 255, "gateway": 255, "ikepolicy": 255, "ikeversion": 255, "ipsecpsk": 255, "name": 255, "projectid": 255},
+	}.validate("createVpnCustomerGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVpnCustomerGateway API, before the request is signed
+func (p *CreateVpnCustomerGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVpnCustomerGateway", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"cidrlist":         decodeParam[string],
+		"domainid":         decodeParam[string],
+		"dpd":              decodeParam[bool],
+		"esplifetime":      decodeParam[int64],
+		"esppolicy":        decodeParam[string],
+		"forceencap":       decodeParam[bool],
+		"gateway":          decodeParam[string],
+		"ikelifetime":      decodeParam[int64],
+		"ikepolicy":        decodeParam[string],
+		"ikeversion":       decodeParam[string],
+		"ipsecpsk":         decodeParam[string],
+		"name":             decodeParam[string],
+		"projectid":        decodeParam[string],
+		"splitconnections": decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVpnCustomerGatewayParams) Clone() *CreateVpnCustomerGatewayParams {
+	return &CreateVpnCustomerGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVpnCustomerGatewayParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1066,32 +1403,39 @@ func (s *VPNService) NewCreateVpnCustomerGatewayParams(cidrlist string, esppolic
 
 // Creates site to site VPN customer gateway
 func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("createVpnCustomerGateway", p.toURLValues())
+	return s.CreateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// CreateVpnCustomerGatewayWithContext is like CreateVpnCustomerGateway, but honours the cancellation and deadline of ctx
+func (s *VPNService) CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVpnCustomerGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVpnCustomerGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVpnCustomerGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVpnCustomerGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVpnCustomerGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1099,31 +1443,56 @@ func (s *VPNService) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVpnCustomerGateway async job into r
+func (r *CreateVpnCustomerGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVpnCustomerGatewayAsync starts the async job of CreateVpnCustomerGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) CreateVpnCustomerGatewayAsync(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*Job, error) {
+	r, err := s.CreateVpnCustomerGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVpnCustomerGateway", r.JobID), nil
+}
+
 type CreateVpnCustomerGatewayResponse struct {
-	Account          string `json:"account"`
-	Cidrlist         string `json:"cidrlist"`
-	Domain           string `json:"domain"`
-	Domainid         string `json:"domainid"`
-	Domainpath       string `json:"domainpath"`
-	Dpd              bool   `json:"dpd"`
-	Esplifetime      int64  `json:"esplifetime"`
-	Esppolicy        string `json:"esppolicy"`
-	Forceencap       bool   `json:"forceencap"`
-	Gateway          string `json:"gateway"`
-	Hasannotations   bool   `json:"hasannotations"`
-	Id               string `json:"id"`
-	Ikelifetime      int64  `json:"ikelifetime"`
-	Ikepolicy        string `json:"ikepolicy"`
-	Ikeversion       string `json:"ikeversion"`
-	Ipaddress        string `json:"ipaddress"`
-	Ipsecpsk         string `json:"ipsecpsk"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Project          string `json:"project"`
-	Projectid        string `json:"projectid"`
-	Removed          string `json:"removed"`
-	Splitconnections bool   `json:"splitconnections"`
+	Account          string                     `json:"account"`
+	Cidrlist         string                     `json:"cidrlist"`
+	Domain           string                     `json:"domain"`
+	Domainid         string                     `json:"domainid"`
+	Domainpath       string                     `json:"domainpath"`
+	Dpd              FlexBool                   `json:"dpd"`
+	Esplifetime      FlexInt64                  `json:"esplifetime"`
+	Esppolicy        string                     `json:"esppolicy"`
+	Forceencap       FlexBool                   `json:"forceencap"`
+	Gateway          string                     `json:"gateway"`
+	Hasannotations   FlexBool                   `json:"hasannotations"`
+	Id               string                     `json:"id"`
+	Ikelifetime      FlexInt64                  `json:"ikelifetime"`
+	Ikepolicy        string                     `json:"ikepolicy"`
+	Ikeversion       string                     `json:"ikeversion"`
+	Ipaddress        string                     `json:"ipaddress"`
+	Ipsecpsk         string                     `json:"ipsecpsk"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Project          string                     `json:"project"`
+	Projectid        string                     `json:"projectid"`
+	Removed          Time                       `json:"removed"`
+	Splitconnections FlexBool                   `json:"splitconnections"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVpnCustomerGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVpnCustomerGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVpnGatewayParams struct {
@@ -1148,6 +1517,46 @@ func (p *CreateVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVpnGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVpnGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVpnGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVpnGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVpnGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"vpcid"},
+		lengths:  map[string]int{"ipaddressid": 255, "vpcid": 255},
+	}.validate("createVpnGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVpnGateway API, before the request is signed
+func (p *CreateVpnGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVpnGateway", b, &p.p, map[string]paramDecoder{
+		"fordisplay":  decodeParam[bool],
+		"ipaddressid": decodeParam[string],
+		"vpcid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVpnGatewayParams) Clone() *CreateVpnGatewayParams {
+	return &CreateVpnGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVpnGatewayParams) SetFordisplay(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1222,32 +1631,39 @@ func (s *VPNService) NewCreateVpnGatewayParams(vpcid string) *CreateVpnGatewayPa
 
 // Creates site to site VPN local gateway
 func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("createVpnGateway", p.toURLValues())
+	return s.CreateVpnGatewayWithContext(context.Background(), p)
+}
+
+// CreateVpnGatewayWithContext is like CreateVpnGateway, but honours the cancellation and deadline of ctx
+func (s *VPNService) CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVpnGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVpnGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVpnGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVpnGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVpnGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1255,21 +1671,46 @@ func (s *VPNService) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGate
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVpnGateway async job into r
+func (r *CreateVpnGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVpnGatewayAsync starts the async job of CreateVpnGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) CreateVpnGatewayAsync(ctx context.Context, p *CreateVpnGatewayParams) (*Job, error) {
+	r, err := s.CreateVpnGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVpnGateway", r.JobID), nil
+}
+
 type CreateVpnGatewayResponse struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Fordisplay bool   `json:"fordisplay"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
-	Publicip   string `json:"publicip"`
-	Removed    string `json:"removed"`
-	Vpcid      string `json:"vpcid"`
-	Vpcname    string `json:"vpcname"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Fordisplay FlexBool                   `json:"fordisplay"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Publicip   string                     `json:"publicip"`
+	Removed    Time                       `json:"removed"`
+	Vpcid      string                     `json:"vpcid"`
+	Vpcname    string                     `json:"vpcname"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVpnGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVpnGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteRemoteAccessVpnParams struct {
@@ -1287,6 +1728,44 @@ func (p *DeleteRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteRemoteAccessVpn API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteRemoteAccessVpnParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteRemoteAccessVpn", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteRemoteAccessVpn API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteRemoteAccessVpnParams) Validate() error {
+	return paramRules{
+		required: []string{"publicipid"},
+		lengths:  map[string]int{"publicipid": 255},
+	}.validate("deleteRemoteAccessVpn", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteRemoteAccessVpn API, before the request is signed
+func (p *DeleteRemoteAccessVpnParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteRemoteAccessVpn", b, &p.p, map[string]paramDecoder{
+		"publicipid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteRemoteAccessVpnParams) Clone() *DeleteRemoteAccessVpnParams {
+	return &DeleteRemoteAccessVpnParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteRemoteAccessVpnParams) SetPublicipid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1319,27 +1798,39 @@ func (s *VPNService) NewDeleteRemoteAccessVpnParams(publicipid string) *DeleteRe
 
 // Destroys a l2tp/ipsec remote access VPN
 func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteRemoteAccessVpn", p.toURLValues())
+	return s.DeleteRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// DeleteRemoteAccessVpnWithContext is like DeleteRemoteAccessVpn, but honours the cancellation and deadline of ctx
+func (s *VPNService) DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteRemoteAccessVpn", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteRemoteAccessVpnResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteRemoteAccessVpn", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteRemoteAccessVpn", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteRemoteAccessVpn", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1347,11 +1838,31 @@ func (s *VPNService) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*Del
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteRemoteAccessVpn async job into r
+func (r *DeleteRemoteAccessVpnResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteRemoteAccessVpnAsync starts the async job of DeleteRemoteAccessVpn without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) DeleteRemoteAccessVpnAsync(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*Job, error) {
+	r, err := s.DeleteRemoteAccessVpnWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteRemoteAccessVpn", r.JobID), nil
+}
+
 type DeleteRemoteAccessVpnResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteRemoteAccessVpnResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteRemoteAccessVpnResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVpnConnectionParams struct {
@@ -1369,6 +1880,44 @@ func (p *DeleteVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVpnConnection API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVpnConnectionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVpnConnection", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVpnConnection API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVpnConnectionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVpnConnection", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVpnConnection API, before the request is signed
+func (p *DeleteVpnConnectionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVpnConnection", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVpnConnectionParams) Clone() *DeleteVpnConnectionParams {
+	return &DeleteVpnConnectionParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVpnConnectionParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1401,27 +1950,39 @@ func (s *VPNService) NewDeleteVpnConnectionParams(id string) *DeleteVpnConnectio
 
 // Delete site to site VPN connection
 func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVpnConnection", p.toURLValues())
+	return s.DeleteVpnConnectionWithContext(context.Background(), p)
+}
+
+// DeleteVpnConnectionWithContext is like DeleteVpnConnection, but honours the cancellation and deadline of ctx
+func (s *VPNService) DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteVpnConnection", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1429,11 +1990,31 @@ func (s *VPNService) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteV
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteVpnConnection async job into r
+func (r *DeleteVpnConnectionResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteVpnConnectionAsync starts the async job of DeleteVpnConnection without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) DeleteVpnConnectionAsync(ctx context.Context, p *DeleteVpnConnectionParams) (*Job, error) {
+	r, err := s.DeleteVpnConnectionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteVpnConnection", r.JobID), nil
+}
+
 type DeleteVpnConnectionResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteVpnConnectionResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteVpnConnectionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVpnCustomerGatewayParams struct {
@@ -1451,6 +2032,44 @@ func (p *DeleteVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVpnCustomerGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVpnCustomerGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVpnCustomerGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVpnCustomerGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVpnCustomerGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVpnCustomerGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVpnCustomerGateway API, before the request is signed
+func (p *DeleteVpnCustomerGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVpnCustomerGateway", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVpnCustomerGatewayParams) Clone() *DeleteVpnCustomerGatewayParams {
+	return &DeleteVpnCustomerGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVpnCustomerGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1483,27 +2102,39 @@ func (s *VPNService) NewDeleteVpnCustomerGatewayParams(id string) *DeleteVpnCust
 
 // Delete site to site VPN customer gateway
 func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVpnCustomerGateway", p.toURLValues())
+	return s.DeleteVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// DeleteVpnCustomerGatewayWithContext is like DeleteVpnCustomerGateway, but honours the cancellation and deadline of ctx
+func (s *VPNService) DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVpnCustomerGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVpnCustomerGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVpnCustomerGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteVpnCustomerGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteVpnCustomerGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1511,11 +2142,31 @@ func (s *VPNService) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteVpnCustomerGateway async job into r
+func (r *DeleteVpnCustomerGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteVpnCustomerGatewayAsync starts the async job of DeleteVpnCustomerGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) DeleteVpnCustomerGatewayAsync(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*Job, error) {
+	r, err := s.DeleteVpnCustomerGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteVpnCustomerGateway", r.JobID), nil
+}
+
 type DeleteVpnCustomerGatewayResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteVpnCustomerGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteVpnCustomerGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVpnGatewayParams struct {
@@ -1533,6 +2184,44 @@ func (p *DeleteVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVpnGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVpnGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVpnGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVpnGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVpnGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVpnGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVpnGateway API, before the request is signed
+func (p *DeleteVpnGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVpnGateway", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVpnGatewayParams) Clone() *DeleteVpnGatewayParams {
+	return &DeleteVpnGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVpnGatewayParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1565,27 +2254,39 @@ func (s *VPNService) NewDeleteVpnGatewayParams(id string) *DeleteVpnGatewayParam
 
 // Delete site to site VPN gateway
 func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVpnGateway", p.toURLValues())
+	return s.DeleteVpnGatewayWithContext(context.Background(), p)
+}
+
+// DeleteVpnGatewayWithContext is like DeleteVpnGateway, but honours the cancellation and deadline of ctx
+func (s *VPNService) DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVpnGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVpnGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVpnGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteVpnGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteVpnGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1593,11 +2294,31 @@ func (s *VPNService) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGate
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteVpnGateway async job into r
+func (r *DeleteVpnGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteVpnGatewayAsync starts the async job of DeleteVpnGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) DeleteVpnGatewayAsync(ctx context.Context, p *DeleteVpnGatewayParams) (*Job, error) {
+	r, err := s.DeleteVpnGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteVpnGateway", r.JobID), nil
+}
+
 type DeleteVpnGatewayResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteVpnGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteVpnGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListRemoteAccessVpnsParams struct {
@@ -1653,6 +2374,54 @@ func (p *ListRemoteAccessVpnsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listRemoteAccessVpns API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListRemoteAccessVpnsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listRemoteAccessVpns", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listRemoteAccessVpns API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListRemoteAccessVpnsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "networkid": 255, "projectid": 255, "publicipid": 255},
+	}.validate("listRemoteAccessVpns", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listRemoteAccessVpns API, before the request is signed
+func (p *ListRemoteAccessVpnsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListRemoteAccessVpnsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListRemoteAccessVpnsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listRemoteAccessVpns", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"networkid":   decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"publicipid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListRemoteAccessVpnsParams) Clone() *ListRemoteAccessVpnsParams {
+	return &ListRemoteAccessVpnsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListRemoteAccessVpnsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1915,6 +2684,11 @@ func (s *VPNService) NewListRemoteAccessVpnsParams() *ListRemoteAccessVpnsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
+	return s.GetRemoteAccessVpnByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetRemoteAccessVpnByIDWithContext is like GetRemoteAccessVpnByID, but honours the cancellation and deadline of ctx
+func (s *VPNService) GetRemoteAccessVpnByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
 	p := &ListRemoteAccessVpnsParams{}
 	p.p = make(map[string]interface{})
 
@@ -1926,11 +2700,10 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 		}
 	}
 
-	l, err := s.ListRemoteAccessVpns(p)
+	l, err := s.ListRemoteAccessVpnsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1948,40 +2721,83 @@ func (s *VPNService) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*Rem
 
 // Lists remote access VPNs
 func (s *VPNService) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
-	resp, err := s.cs.newRequest("listRemoteAccessVpns", p.toURLValues())
+	return s.ListRemoteAccessVpnsWithContext(context.Background(), p)
+}
+
+// ListRemoteAccessVpnsWithContext is like ListRemoteAccessVpns, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listRemoteAccessVpns", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListRemoteAccessVpnsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listRemoteAccessVpns", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListRemoteAccessVpnsAll returns all RemoteAccessVpns matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPNService) ListRemoteAccessVpnsAll(p *ListRemoteAccessVpnsParams, opts ...PageOption) ([]*RemoteAccessVpn, error) {
+	return s.ListRemoteAccessVpnsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListRemoteAccessVpnsAllWithContext is like ListRemoteAccessVpnsAll, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListRemoteAccessVpnsAllWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams, opts ...PageOption) ([]*RemoteAccessVpn, error) {
+	return collectPages(s.ListRemoteAccessVpnsIterWithContext(ctx, p, opts...))
+}
+
+// ListRemoteAccessVpnsIter returns an iterator over all RemoteAccessVpns matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPNService) ListRemoteAccessVpnsIter(p *ListRemoteAccessVpnsParams, opts ...PageOption) iter.Seq2[*RemoteAccessVpn, error] {
+	return s.ListRemoteAccessVpnsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListRemoteAccessVpnsIterWithContext is like ListRemoteAccessVpnsIter, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListRemoteAccessVpnsIterWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams, opts ...PageOption) iter.Seq2[*RemoteAccessVpn, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*RemoteAccessVpn, int, error) {
+		l, err := s.ListRemoteAccessVpnsWithContext(ctx, &ListRemoteAccessVpnsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.RemoteAccessVpns, l.Count, nil
+	}, opts...)
+}
+
 type ListRemoteAccessVpnsResponse struct {
 	Count            int                `json:"count"`
 	RemoteAccessVpns []*RemoteAccessVpn `json:"remoteaccessvpn"`
 }
 
 type RemoteAccessVpn struct {
-	Account      string `json:"account"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Id           string `json:"id"`
-	Iprange      string `json:"iprange"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Presharedkey string `json:"presharedkey"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	Publicip     string `json:"publicip"`
-	Publicipid   string `json:"publicipid"`
-	State        string `json:"state"`
+	Account      string                     `json:"account"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Id           string                     `json:"id"`
+	Iprange      string                     `json:"iprange"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Presharedkey string                     `json:"presharedkey"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	Publicip     string                     `json:"publicip"`
+	Publicipid   string                     `json:"publicipid"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoteAccessVpn) UnmarshalJSON(b []byte) error {
+	type alias RemoteAccessVpn
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVpnConnectionsParams struct {
@@ -2034,6 +2850,53 @@ func (p *ListVpnConnectionsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVpnConnections API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVpnConnectionsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVpnConnections", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVpnConnections API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVpnConnectionsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "vpcid": 255},
+	}.validate("listVpnConnections", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVpnConnections API, before the request is signed
+func (p *ListVpnConnectionsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVpnConnectionsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVpnConnectionsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVpnConnections", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"vpcid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVpnConnectionsParams) Clone() *ListVpnConnectionsParams {
+	return &ListVpnConnectionsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVpnConnectionsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2275,6 +3138,11 @@ func (s *VPNService) NewListVpnConnectionsParams() *ListVpnConnectionsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error) {
+	return s.GetVpnConnectionByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnConnectionByIDWithContext is like GetVpnConnectionByID, but honours the cancellation and deadline of ctx
+func (s *VPNService) GetVpnConnectionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnConnection, int, error) {
 	p := &ListVpnConnectionsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2286,11 +3154,10 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 		}
 	}
 
-	l, err := s.ListVpnConnections(p)
+	l, err := s.ListVpnConnectionsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2308,53 +3175,96 @@ func (s *VPNService) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnCo
 
 // Lists site to site VPN connection gateways
 func (s *VPNService) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
-	resp, err := s.cs.newRequest("listVpnConnections", p.toURLValues())
+	return s.ListVpnConnectionsWithContext(context.Background(), p)
+}
+
+// ListVpnConnectionsWithContext is like ListVpnConnections, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVpnConnections", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVpnConnectionsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVpnConnections", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVpnConnectionsAll returns all VpnConnections matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPNService) ListVpnConnectionsAll(p *ListVpnConnectionsParams, opts ...PageOption) ([]*VpnConnection, error) {
+	return s.ListVpnConnectionsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnConnectionsAllWithContext is like ListVpnConnectionsAll, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnConnectionsAllWithContext(ctx context.Context, p *ListVpnConnectionsParams, opts ...PageOption) ([]*VpnConnection, error) {
+	return collectPages(s.ListVpnConnectionsIterWithContext(ctx, p, opts...))
+}
+
+// ListVpnConnectionsIter returns an iterator over all VpnConnections matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPNService) ListVpnConnectionsIter(p *ListVpnConnectionsParams, opts ...PageOption) iter.Seq2[*VpnConnection, error] {
+	return s.ListVpnConnectionsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnConnectionsIterWithContext is like ListVpnConnectionsIter, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnConnectionsIterWithContext(ctx context.Context, p *ListVpnConnectionsParams, opts ...PageOption) iter.Seq2[*VpnConnection, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VpnConnection, int, error) {
+		l, err := s.ListVpnConnectionsWithContext(ctx, &ListVpnConnectionsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VpnConnections, l.Count, nil
+	}, opts...)
+}
+
 type ListVpnConnectionsResponse struct {
 	Count          int              `json:"count"`
 	VpnConnections []*VpnConnection `json:"vpnconnection"`
 }
 
 type VpnConnection struct {
-	Account              string `json:"account"`
-	Cidrlist             string `json:"cidrlist"`
-	Created              string `json:"created"`
-	Domain               string `json:"domain"`
-	Domainid             string `json:"domainid"`
-	Domainpath           string `json:"domainpath"`
-	Dpd                  bool   `json:"dpd"`
-	Esplifetime          int64  `json:"esplifetime"`
-	Esppolicy            string `json:"esppolicy"`
-	Forceencap           bool   `json:"forceencap"`
-	Fordisplay           bool   `json:"fordisplay"`
-	Gateway              string `json:"gateway"`
-	Id                   string `json:"id"`
-	Ikelifetime          int64  `json:"ikelifetime"`
-	Ikepolicy            string `json:"ikepolicy"`
-	Ikeversion           string `json:"ikeversion"`
-	Ipsecpsk             string `json:"ipsecpsk"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Passive              bool   `json:"passive"`
-	Project              string `json:"project"`
-	Projectid            string `json:"projectid"`
-	Publicip             string `json:"publicip"`
-	Removed              string `json:"removed"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid"`
-	S2svpngatewayid      string `json:"s2svpngatewayid"`
-	Splitconnections     bool   `json:"splitconnections"`
-	State                string `json:"state"`
+	Account              string                     `json:"account"`
+	Cidrlist             string                     `json:"cidrlist"`
+	Created              Time                       `json:"created"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Dpd                  FlexBool                   `json:"dpd"`
+	Esplifetime          FlexInt64                  `json:"esplifetime"`
+	Esppolicy            string                     `json:"esppolicy"`
+	Forceencap           FlexBool                   `json:"forceencap"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Gateway              string                     `json:"gateway"`
+	Id                   string                     `json:"id"`
+	Ikelifetime          FlexInt64                  `json:"ikelifetime"`
+	Ikepolicy            string                     `json:"ikepolicy"`
+	Ikeversion           string                     `json:"ikeversion"`
+	Ipsecpsk             string                     `json:"ipsecpsk"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Passive              FlexBool                   `json:"passive"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Publicip             string                     `json:"publicip"`
+	Removed              Time                       `json:"removed"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid"`
+	Splitconnections     FlexBool                   `json:"splitconnections"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VpnConnection) UnmarshalJSON(b []byte) error {
+	type alias VpnConnection
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVpnCustomerGatewaysParams struct {
@@ -2400,6 +3310,51 @@ func (p *ListVpnCustomerGatewaysParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVpnCustomerGateways API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVpnCustomerGatewaysParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVpnCustomerGateways", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVpnCustomerGateways API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVpnCustomerGatewaysParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255},
+	}.validate("listVpnCustomerGateways", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVpnCustomerGateways API, before the request is signed
+func (p *ListVpnCustomerGatewaysParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVpnCustomerGatewaysParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVpnCustomerGatewaysParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVpnCustomerGateways", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVpnCustomerGatewaysParams) Clone() *ListVpnCustomerGatewaysParams {
+	return &ListVpnCustomerGatewaysParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVpnCustomerGatewaysParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2599,6 +3554,11 @@ func (s *VPNService) NewListVpnCustomerGatewaysParams() *ListVpnCustomerGateways
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVpnCustomerGatewayIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetVpnCustomerGatewayIDWithContext is like GetVpnCustomerGatewayID, but honours the cancellation and deadline of ctx
+func (s *VPNService) GetVpnCustomerGatewayIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVpnCustomerGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -2610,7 +3570,7 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 		}
 	}
 
-	l, err := s.ListVpnCustomerGateways(p)
+	l, err := s.ListVpnCustomerGatewaysWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2635,12 +3595,17 @@ func (s *VPNService) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
-	id, count, err := s.GetVpnCustomerGatewayID(name, opts...)
+	return s.GetVpnCustomerGatewayByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVpnCustomerGatewayByNameWithContext is like GetVpnCustomerGatewayByName, but honours the cancellation and deadline of ctx
+func (s *VPNService) GetVpnCustomerGatewayByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	id, count, err := s.GetVpnCustomerGatewayIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVpnCustomerGatewayByID(id, opts...)
+	r, count, err := s.GetVpnCustomerGatewayByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2649,6 +3614,11 @@ func (s *VPNService) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	return s.GetVpnCustomerGatewayByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnCustomerGatewayByIDWithContext is like GetVpnCustomerGatewayByID, but honours the cancellation and deadline of ctx
+func (s *VPNService) GetVpnCustomerGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
 	p := &ListVpnCustomerGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -2660,11 +3630,10 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 		}
 	}
 
-	l, err := s.ListVpnCustomerGateways(p)
+	l, err := s.ListVpnCustomerGatewaysWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2682,17 +3651,54 @@ func (s *VPNService) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*
 
 // Lists site to site VPN customer gateways
 func (s *VPNService) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnCustomerGateways", p.toURLValues())
+	return s.ListVpnCustomerGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnCustomerGatewaysWithContext is like ListVpnCustomerGateways, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVpnCustomerGateways", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
-	var r ListVpnCustomerGatewaysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
-		return nil, err
-	}
+	var r ListVpnCustomerGatewaysResponse
+	if err := s.cs.decodeResponse("listVpnCustomerGateways", resp, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
+
+// ListVpnCustomerGatewaysAll returns all VpnCustomerGateways matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPNService) ListVpnCustomerGatewaysAll(p *ListVpnCustomerGatewaysParams, opts ...PageOption) ([]*VpnCustomerGateway, error) {
+	return s.ListVpnCustomerGatewaysAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnCustomerGatewaysAllWithContext is like ListVpnCustomerGatewaysAll, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnCustomerGatewaysAllWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams, opts ...PageOption) ([]*VpnCustomerGateway, error) {
+	return collectPages(s.ListVpnCustomerGatewaysIterWithContext(ctx, p, opts...))
+}
 
-	return &r, nil
+// ListVpnCustomerGatewaysIter returns an iterator over all VpnCustomerGateways matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPNService) ListVpnCustomerGatewaysIter(p *ListVpnCustomerGatewaysParams, opts ...PageOption) iter.Seq2[*VpnCustomerGateway, error] {
+	return s.ListVpnCustomerGatewaysIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnCustomerGatewaysIterWithContext is like ListVpnCustomerGatewaysIter, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnCustomerGatewaysIterWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams, opts ...PageOption) iter.Seq2[*VpnCustomerGateway, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VpnCustomerGateway, int, error) {
+		l, err := s.ListVpnCustomerGatewaysWithContext(ctx, &ListVpnCustomerGatewaysParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VpnCustomerGateways, l.Count, nil
+	}, opts...)
 }
 
 type ListVpnCustomerGatewaysResponse struct {
@@ -2701,30 +3707,36 @@ type ListVpnCustomerGatewaysResponse struct {
 }
 
 type VpnCustomerGateway struct {
-	Account          string `json:"account"`
-	Cidrlist         string `json:"cidrlist"`
-	Domain           string `json:"domain"`
-	Domainid         string `json:"domainid"`
-	Domainpath       string `json:"domainpath"`
-	Dpd              bool   `json:"dpd"`
-	Esplifetime      int64  `json:"esplifetime"`
-	Esppolicy        string `json:"esppolicy"`
-	Forceencap       bool   `json:"forceencap"`
-	Gateway          string `json:"gateway"`
-	Hasannotations   bool   `json:"hasannotations"`
-	Id               string `json:"id"`
-	Ikelifetime      int64  `json:"ikelifetime"`
-	Ikepolicy        string `json:"ikepolicy"`
-	Ikeversion       string `json:"ikeversion"`
-	Ipaddress        string `json:"ipaddress"`
-	Ipsecpsk         string `json:"ipsecpsk"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Project          string `json:"project"`
-	Projectid        string `json:"projectid"`
-	Removed          string `json:"removed"`
-	Splitconnections bool   `json:"splitconnections"`
+	Account          string                     `json:"account"`
+	Cidrlist         string                     `json:"cidrlist"`
+	Domain           string                     `json:"domain"`
+	Domainid         string                     `json:"domainid"`
+	Domainpath       string                     `json:"domainpath"`
+	Dpd              FlexBool                   `json:"dpd"`
+	Esplifetime      FlexInt64                  `json:"esplifetime"`
+	Esppolicy        string                     `json:"esppolicy"`
+	Forceencap       FlexBool                   `json:"forceencap"`
+	Gateway          string                     `json:"gateway"`
+	Hasannotations   FlexBool                   `json:"hasannotations"`
+	Id               string                     `json:"id"`
+	Ikelifetime      FlexInt64                  `json:"ikelifetime"`
+	Ikepolicy        string                     `json:"ikepolicy"`
+	Ikeversion       string                     `json:"ikeversion"`
+	Ipaddress        string                     `json:"ipaddress"`
+	Ipsecpsk         string                     `json:"ipsecpsk"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Project          string                     `json:"project"`
+	Projectid        string                     `json:"projectid"`
+	Removed          Time                       `json:"removed"`
+	Splitconnections FlexBool                   `json:"splitconnections"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VpnCustomerGateway) UnmarshalJSON(b []byte) error {
+	type alias VpnCustomerGateway
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVpnGatewaysParams struct {
@@ -2777,6 +3789,53 @@ func (p *ListVpnGatewaysParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVpnGateways API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVpnGatewaysParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVpnGateways", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVpnGateways API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVpnGatewaysParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "vpcid": 255},
+	}.validate("listVpnGateways", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVpnGateways API, before the request is signed
+func (p *ListVpnGatewaysParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVpnGatewaysParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVpnGatewaysParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVpnGateways", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"fordisplay":  decodeParam[bool],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"vpcid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVpnGatewaysParams) Clone() *ListVpnGatewaysParams {
+	return &ListVpnGatewaysParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVpnGatewaysParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3018,6 +4077,11 @@ func (s *VPNService) NewListVpnGatewaysParams() *ListVpnGatewaysParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
+	return s.GetVpnGatewayByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnGatewayByIDWithContext is like GetVpnGatewayByID, but honours the cancellation and deadline of ctx
+func (s *VPNService) GetVpnGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnGateway, int, error) {
 	p := &ListVpnGatewaysParams{}
 	p.p = make(map[string]interface{})
 
@@ -3029,11 +4093,10 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 		}
 	}
 
-	l, err := s.ListVpnGateways(p)
+	l, err := s.ListVpnGatewaysWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3051,39 +4114,82 @@ func (s *VPNService) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGatew
 
 // Lists site 2 site VPN gateways
 func (s *VPNService) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
-	resp, err := s.cs.newRequest("listVpnGateways", p.toURLValues())
+	return s.ListVpnGatewaysWithContext(context.Background(), p)
+}
+
+// ListVpnGatewaysWithContext is like ListVpnGateways, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVpnGateways", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVpnGatewaysResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVpnGateways", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVpnGatewaysAll returns all VpnGateways matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPNService) ListVpnGatewaysAll(p *ListVpnGatewaysParams, opts ...PageOption) ([]*VpnGateway, error) {
+	return s.ListVpnGatewaysAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnGatewaysAllWithContext is like ListVpnGatewaysAll, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnGatewaysAllWithContext(ctx context.Context, p *ListVpnGatewaysParams, opts ...PageOption) ([]*VpnGateway, error) {
+	return collectPages(s.ListVpnGatewaysIterWithContext(ctx, p, opts...))
+}
+
+// ListVpnGatewaysIter returns an iterator over all VpnGateways matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPNService) ListVpnGatewaysIter(p *ListVpnGatewaysParams, opts ...PageOption) iter.Seq2[*VpnGateway, error] {
+	return s.ListVpnGatewaysIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnGatewaysIterWithContext is like ListVpnGatewaysIter, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnGatewaysIterWithContext(ctx context.Context, p *ListVpnGatewaysParams, opts ...PageOption) iter.Seq2[*VpnGateway, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VpnGateway, int, error) {
+		l, err := s.ListVpnGatewaysWithContext(ctx, &ListVpnGatewaysParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VpnGateways, l.Count, nil
+	}, opts...)
+}
+
 type ListVpnGatewaysResponse struct {
 	Count       int           `json:"count"`
 	VpnGateways []*VpnGateway `json:"vpngateway"`
 }
 
 type VpnGateway struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Fordisplay bool   `json:"fordisplay"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
-	Publicip   string `json:"publicip"`
-	Removed    string `json:"removed"`
-	Vpcid      string `json:"vpcid"`
-	Vpcname    string `json:"vpcname"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Fordisplay FlexBool                   `json:"fordisplay"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Publicip   string                     `json:"publicip"`
+	Removed    Time                       `json:"removed"`
+	Vpcid      string                     `json:"vpcid"`
+	Vpcname    string                     `json:"vpcname"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VpnGateway) UnmarshalJSON(b []byte) error {
+	type alias VpnGateway
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVpnUsersParams struct {
@@ -3132,6 +4238,52 @@ func (p *ListVpnUsersParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVpnUsers API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVpnUsersParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVpnUsers", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVpnUsers API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVpnUsersParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "username": 255},
+	}.validate("listVpnUsers", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVpnUsers API, before the request is signed
+func (p *ListVpnUsersParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVpnUsersParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVpnUsersParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVpnUsers", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"username":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVpnUsersParams) Clone() *ListVpnUsersParams {
+	return &ListVpnUsersParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVpnUsersParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3352,6 +4504,11 @@ func (s *VPNService) NewListVpnUsersParams() *ListVpnUsersParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
+	return s.GetVpnUserByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVpnUserByIDWithContext is like GetVpnUserByID, but honours the cancellation and deadline of ctx
+func (s *VPNService) GetVpnUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnUser, int, error) {
 	p := &ListVpnUsersParams{}
 	p.p = make(map[string]interface{})
 
@@ -3363,11 +4520,10 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 		}
 	}
 
-	l, err := s.ListVpnUsers(p)
+	l, err := s.ListVpnUsersWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3385,36 +4541,79 @@ func (s *VPNService) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, in
 
 // Lists VPN Users
 func (s *VPNService) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
-	resp, err := s.cs.newRequest("listVpnUsers", p.toURLValues())
+	return s.ListVpnUsersWithContext(context.Background(), p)
+}
+
+// ListVpnUsersWithContext is like ListVpnUsers, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVpnUsers", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVpnUsersResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVpnUsers", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVpnUsersAll returns all VpnUsers matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VPNService) ListVpnUsersAll(p *ListVpnUsersParams, opts ...PageOption) ([]*VpnUser, error) {
+	return s.ListVpnUsersAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnUsersAllWithContext is like ListVpnUsersAll, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnUsersAllWithContext(ctx context.Context, p *ListVpnUsersParams, opts ...PageOption) ([]*VpnUser, error) {
+	return collectPages(s.ListVpnUsersIterWithContext(ctx, p, opts...))
+}
+
+// ListVpnUsersIter returns an iterator over all VpnUsers matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VPNService) ListVpnUsersIter(p *ListVpnUsersParams, opts ...PageOption) iter.Seq2[*VpnUser, error] {
+	return s.ListVpnUsersIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVpnUsersIterWithContext is like ListVpnUsersIter, but honours the cancellation and deadline of ctx
+func (s *VPNService) ListVpnUsersIterWithContext(ctx context.Context, p *ListVpnUsersParams, opts ...PageOption) iter.Seq2[*VpnUser, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VpnUser, int, error) {
+		l, err := s.ListVpnUsersWithContext(ctx, &ListVpnUsersParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VpnUsers, l.Count, nil
+	}, opts...)
+}
+
 type ListVpnUsersResponse struct {
 	Count    int        `json:"count"`
 	VpnUsers []*VpnUser `json:"vpnuser"`
 }
 
 type VpnUser struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
-	State      string `json:"state"`
-	Username   string `json:"username"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	State      string                     `json:"state"`
+	Username   string                     `json:"username"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VpnUser) UnmarshalJSON(b []byte) error {
+	type alias VpnUser
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveVpnUserParams struct {
@@ -3441,6 +4640,47 @@ func (p *RemoveVpnUserParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeVpnUser API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveVpnUserParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeVpnUser", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeVpnUser API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveVpnUserParams) Validate() error {
+	return paramRules{
+		required: []string{"username"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "projectid": 255, "username": 255},
+	}.validate("removeVpnUser", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeVpnUser API, before the request is signed
+func (p *RemoveVpnUserParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveVpnUserParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveVpnUserParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeVpnUser", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"projectid": decodeParam[string],
+		"username":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveVpnUserParams) Clone() *RemoveVpnUserParams {
+	return &RemoveVpnUserParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveVpnUserParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3536,27 +4776,39 @@ func (s *VPNService) NewRemoveVpnUserParams(username string) *RemoveVpnUserParam
 
 // Removes VPN User
 func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
-	resp, err := s.cs.newPostRequest("removeVpnUser", p.toURLValues())
+	return s.RemoveVpnUserWithContext(context.Background(), p)
+}
+
+// RemoveVpnUserWithContext is like RemoveVpnUser, but honours the cancellation and deadline of ctx
+func (s *VPNService) RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeVpnUser", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveVpnUserResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeVpnUser", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeVpnUser", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeVpnUser", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3564,11 +4816,31 @@ func (s *VPNService) RemoveVpnUser(p *RemoveVpnUserParams) (*RemoveVpnUserRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveVpnUser async job into r
+func (r *RemoveVpnUserResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveVpnUserAsync starts the async job of RemoveVpnUser without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) RemoveVpnUserAsync(ctx context.Context, p *RemoveVpnUserParams) (*Job, error) {
+	r, err := s.RemoveVpnUserWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeVpnUser", r.JobID), nil
+}
+
 type RemoveVpnUserResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveVpnUserResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveVpnUserResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetVpnConnectionParams struct {
@@ -3592,6 +4864,46 @@ func (p *ResetVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resetVpnConnection API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResetVpnConnectionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resetVpnConnection", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resetVpnConnection API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResetVpnConnectionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255},
+	}.validate("resetVpnConnection", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resetVpnConnection API, before the request is signed
+func (p *ResetVpnConnectionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResetVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResetVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resetVpnConnection", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"id":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResetVpnConnectionParams) Clone() *ResetVpnConnectionParams {
+	return &ResetVpnConnectionParams{p: cloneParams(p.p)}
+}
+
 func (p *ResetVpnConnectionParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3666,32 +4978,39 @@ func (s *VPNService) NewResetVpnConnectionParams(id string) *ResetVpnConnectionP
 
 // Reset site to site VPN connection
 func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
-	resp, err := s.cs.newPostRequest("resetVpnConnection", p.toURLValues())
+	return s.ResetVpnConnectionWithContext(context.Background(), p)
+}
+
+// ResetVpnConnectionWithContext is like ResetVpnConnection, but honours the cancellation and deadline of ctx
+func (s *VPNService) ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "resetVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resetVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "resetVpnConnection", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("resetVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3699,35 +5018,60 @@ func (s *VPNService) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnC
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ResetVpnConnection async job into r
+func (r *ResetVpnConnectionResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ResetVpnConnectionAsync starts the async job of ResetVpnConnection without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) ResetVpnConnectionAsync(ctx context.Context, p *ResetVpnConnectionParams) (*Job, error) {
+	r, err := s.ResetVpnConnectionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("resetVpnConnection", r.JobID), nil
+}
+
 type ResetVpnConnectionResponse struct {
-	Account              string `json:"account"`
-	Cidrlist             string `json:"cidrlist"`
-	Created              string `json:"created"`
-	Domain               string `json:"domain"`
-	Domainid             string `json:"domainid"`
-	Domainpath           string `json:"domainpath"`
-	Dpd                  bool   `json:"dpd"`
-	Esplifetime          int64  `json:"esplifetime"`
-	Esppolicy            string `json:"esppolicy"`
-	Forceencap           bool   `json:"forceencap"`
-	Fordisplay           bool   `json:"fordisplay"`
-	Gateway              string `json:"gateway"`
-	Id                   string `json:"id"`
-	Ikelifetime          int64  `json:"ikelifetime"`
-	Ikepolicy            string `json:"ikepolicy"`
-	Ikeversion           string `json:"ikeversion"`
-	Ipsecpsk             string `json:"ipsecpsk"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Passive              bool   `json:"passive"`
-	Project              string `json:"project"`
-	Projectid            string `json:"projectid"`
-	Publicip             string `json:"publicip"`
-	Removed              string `json:"removed"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid"`
-	S2svpngatewayid      string `json:"s2svpngatewayid"`
-	Splitconnections     bool   `json:"splitconnections"`
-	State                string `json:"state"`
+	Account              string                     `json:"account"`
+	Cidrlist             string                     `json:"cidrlist"`
+	Created              Time                       `json:"created"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Dpd                  FlexBool                   `json:"dpd"`
+	Esplifetime          FlexInt64                  `json:"esplifetime"`
+	Esppolicy            string                     `json:"esppolicy"`
+	Forceencap           FlexBool                   `json:"forceencap"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Gateway              string                     `json:"gateway"`
+	Id                   string                     `json:"id"`
+	Ikelifetime          FlexInt64                  `json:"ikelifetime"`
+	Ikepolicy            string                     `json:"ikepolicy"`
+	Ikeversion           string                     `json:"ikeversion"`
+	Ipsecpsk             string                     `json:"ipsecpsk"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Passive              FlexBool                   `json:"passive"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Publicip             string                     `json:"publicip"`
+	Removed              Time                       `json:"removed"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid"`
+	Splitconnections     FlexBool                   `json:"splitconnections"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetVpnConnectionResponse) UnmarshalJSON(b []byte) error {
+	type alias ResetVpnConnectionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateRemoteAccessVpnParams struct {
@@ -3752,6 +5096,46 @@ func (p *UpdateRemoteAccessVpnParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateRemoteAccessVpn API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateRemoteAccessVpnParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateRemoteAccessVpn", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateRemoteAccessVpn API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateRemoteAccessVpnParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateRemoteAccessVpn", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateRemoteAccessVpn API, before the request is signed
+func (p *UpdateRemoteAccessVpnParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateRemoteAccessVpnParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateRemoteAccessVpnParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateRemoteAccessVpn", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateRemoteAccessVpnParams) Clone() *UpdateRemoteAccessVpnParams {
+	return &UpdateRemoteAccessVpnParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateRemoteAccessVpnParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3826,32 +5210,39 @@ func (s *VPNService) NewUpdateRemoteAccessVpnParams(id string) *UpdateRemoteAcce
 
 // Updates remote access VPN
 func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
-	resp, err := s.cs.newPostRequest("updateRemoteAccessVpn", p.toURLValues())
+	return s.UpdateRemoteAccessVpnWithContext(context.Background(), p)
+}
+
+// UpdateRemoteAccessVpnWithContext is like UpdateRemoteAccessVpn, but honours the cancellation and deadline of ctx
+func (s *VPNService) UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateRemoteAccessVpn", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateRemoteAccessVpnResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateRemoteAccessVpn", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateRemoteAccessVpn", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateRemoteAccessVpn", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3859,22 +5250,47 @@ func (s *VPNService) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*Upd
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateRemoteAccessVpn async job into r
+func (r *UpdateRemoteAccessVpnResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateRemoteAccessVpnAsync starts the async job of UpdateRemoteAccessVpn without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) UpdateRemoteAccessVpnAsync(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*Job, error) {
+	r, err := s.UpdateRemoteAccessVpnWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateRemoteAccessVpn", r.JobID), nil
+}
+
 type UpdateRemoteAccessVpnResponse struct {
-	Account      string `json:"account"`
-	Domain       string `json:"domain"`
-	Domainid     string `json:"domainid"`
-	Domainpath   string `json:"domainpath"`
-	Fordisplay   bool   `json:"fordisplay"`
-	Id           string `json:"id"`
-	Iprange      string `json:"iprange"`
-	JobID        string `json:"jobid"`
-	Jobstatus    int    `json:"jobstatus"`
-	Presharedkey string `json:"presharedkey"`
-	Project      string `json:"project"`
-	Projectid    string `json:"projectid"`
-	Publicip     string `json:"publicip"`
-	Publicipid   string `json:"publicipid"`
-	State        string `json:"state"`
+	Account      string                     `json:"account"`
+	Domain       string                     `json:"domain"`
+	Domainid     string                     `json:"domainid"`
+	Domainpath   string                     `json:"domainpath"`
+	Fordisplay   FlexBool                   `json:"fordisplay"`
+	Id           string                     `json:"id"`
+	Iprange      string                     `json:"iprange"`
+	JobID        string                     `json:"jobid"`
+	Jobstatus    FlexInt                    `json:"jobstatus"`
+	Presharedkey string                     `json:"presharedkey"`
+	Project      string                     `json:"project"`
+	Projectid    string                     `json:"projectid"`
+	Publicip     string                     `json:"publicip"`
+	Publicipid   string                     `json:"publicipid"`
+	State        string                     `json:"state"`
+	Extra        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateRemoteAccessVpnResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateRemoteAccessVpnResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVpnConnectionParams struct {
@@ -3899,6 +5315,46 @@ func (p *UpdateVpnConnectionParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVpnConnection API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVpnConnectionParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVpnConnection", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVpnConnection API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVpnConnectionParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateVpnConnection", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVpnConnection API, before the request is signed
+func (p *UpdateVpnConnectionParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVpnConnectionParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVpnConnectionParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVpnConnection", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVpnConnectionParams) Clone() *UpdateVpnConnectionParams {
+	return &UpdateVpnConnectionParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVpnConnectionParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3973,32 +5429,39 @@ func (s *VPNService) NewUpdateVpnConnectionParams(id string) *UpdateVpnConnectio
 
 // Updates site to site VPN connection
 func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVpnConnection", p.toURLValues())
+	return s.UpdateVpnConnectionWithContext(context.Background(), p)
+}
+
+// UpdateVpnConnectionWithContext is like UpdateVpnConnection, but honours the cancellation and deadline of ctx
+func (s *VPNService) UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVpnConnection", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVpnConnectionResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVpnConnection", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVpnConnection", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVpnConnection", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4006,35 +5469,60 @@ func (s *VPNService) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateV
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVpnConnection async job into r
+func (r *UpdateVpnConnectionResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVpnConnectionAsync starts the async job of UpdateVpnConnection without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) UpdateVpnConnectionAsync(ctx context.Context, p *UpdateVpnConnectionParams) (*Job, error) {
+	r, err := s.UpdateVpnConnectionWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVpnConnection", r.JobID), nil
+}
+
 type UpdateVpnConnectionResponse struct {
-	Account              string `json:"account"`
-	Cidrlist             string `json:"cidrlist"`
-	Created              string `json:"created"`
-	Domain               string `json:"domain"`
-	Domainid             string `json:"domainid"`
-	Domainpath           string `json:"domainpath"`
-	Dpd                  bool   `json:"dpd"`
-	Esplifetime          int64  `json:"esplifetime"`
-	Esppolicy            string `json:"esppolicy"`
-	Forceencap           bool   `json:"forceencap"`
-	Fordisplay           bool   `json:"fordisplay"`
-	Gateway              string `json:"gateway"`
-	Id                   string `json:"id"`
-	Ikelifetime          int64  `json:"ikelifetime"`
-	Ikepolicy            string `json:"ikepolicy"`
-	Ikeversion           string `json:"ikeversion"`
-	Ipsecpsk             string `json:"ipsecpsk"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Passive              bool   `json:"passive"`
-	Project              string `json:"project"`
-	Projectid            string `json:"projectid"`
-	Publicip             string `json:"publicip"`
-	Removed              string `json:"removed"`
-	S2scustomergatewayid string `json:"s2scustomergatewayid"`
-	S2svpngatewayid      string `json:"s2svpngatewayid"`
-	Splitconnections     bool   `json:"splitconnections"`
-	State                string `json:"state"`
+	Account              string                     `json:"account"`
+	Cidrlist             string                     `json:"cidrlist"`
+	Created              Time                       `json:"created"`
+	Domain               string                     `json:"domain"`
+	Domainid             string                     `json:"domainid"`
+	Domainpath           string                     `json:"domainpath"`
+	Dpd                  FlexBool                   `json:"dpd"`
+	Esplifetime          FlexInt64                  `json:"esplifetime"`
+	Esppolicy            string                     `json:"esppolicy"`
+	Forceencap           FlexBool                   `json:"forceencap"`
+	Fordisplay           FlexBool                   `json:"fordisplay"`
+	Gateway              string                     `json:"gateway"`
+	Id                   string                     `json:"id"`
+	Ikelifetime          FlexInt64                  `json:"ikelifetime"`
+	Ikepolicy            string                     `json:"ikepolicy"`
+	Ikeversion           string                     `json:"ikeversion"`
+	Ipsecpsk             string                     `json:"ipsecpsk"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Passive              FlexBool                   `json:"passive"`
+	Project              string                     `json:"project"`
+	Projectid            string                     `json:"projectid"`
+	Publicip             string                     `json:"publicip"`
+	Removed              Time                       `json:"removed"`
+	S2scustomergatewayid string                     `json:"s2scustomergatewayid"`
+	S2svpngatewayid      string                     `json:"s2svpngatewayid"`
+	Splitconnections     FlexBool                   `json:"splitconnections"`
+	State                string                     `json:"state"`
+	Extra                map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVpnConnectionResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVpnConnectionResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVpnCustomerGatewayParams struct {
@@ -4099,6 +5587,58 @@ func (p *UpdateVpnCustomerGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVpnCustomerGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVpnCustomerGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVpnCustomerGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVpnCustomerGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVpnCustomerGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"cidrlist", "esppolicy", "gateway", "id", "ikepolicy", "ipsecpsk"},
+		lengths:  map[string]int{"account": 255, "cidrlist": 255, "domainid": 255, "esppolicy": 255, "gateway": 255, "id": 255, "ikepolicy": 255, "ikeversion": 255, "ipsecpsk": 255, "name": 255},
+	}.validate("updateVpnCustomerGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVpnCustomerGateway API, before the request is signed
+func (p *UpdateVpnCustomerGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVpnCustomerGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVpnCustomerGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVpnCustomerGateway", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"cidrlist":         decodeParam[string],
+		"domainid":         decodeParam[string],
+		"dpd":              decodeParam[bool],
+		"esplifetime":      decodeParam[int64],
+		"esppolicy":        decodeParam[string],
+		"forceencap":       decodeParam[bool],
+		"gateway":          decodeParam[string],
+		"id":               decodeParam[string],
+		"ikelifetime":      decodeParam[int64],
+		"ikepolicy":        decodeParam[string],
+		"ikeversion":       decodeParam[string],
+		"ipsecpsk":         decodeParam[string],
+		"name":             decodeParam[string],
+		"splitconnections": decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVpnCustomerGatewayParams) Clone() *UpdateVpnCustomerGatewayParams {
+	return &UpdateVpnCustomerGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVpnCustomerGatewayParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4430,32 +5970,39 @@ func (s *VPNService) NewUpdateVpnCustomerGatewayParams(cidrlist string, esppolic
 
 // Update site to site VPN customer gateway
 func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVpnCustomerGateway", p.toURLValues())
+	return s.UpdateVpnCustomerGatewayWithContext(context.Background(), p)
+}
+
+// UpdateVpnCustomerGatewayWithContext is like UpdateVpnCustomerGateway, but honours the cancellation and deadline of ctx
+func (s *VPNService) UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVpnCustomerGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVpnCustomerGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVpnCustomerGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVpnCustomerGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVpnCustomerGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4463,31 +6010,56 @@ func (s *VPNService) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVpnCustomerGateway async job into r
+func (r *UpdateVpnCustomerGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVpnCustomerGatewayAsync starts the async job of UpdateVpnCustomerGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) UpdateVpnCustomerGatewayAsync(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*Job, error) {
+	r, err := s.UpdateVpnCustomerGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVpnCustomerGateway", r.JobID), nil
+}
+
 type UpdateVpnCustomerGatewayResponse struct {
-	Account          string `json:"account"`
-	Cidrlist         string `json:"cidrlist"`
-	Domain           string `json:"domain"`
-	Domainid         string `json:"domainid"`
-	Domainpath       string `json:"domainpath"`
-	Dpd              bool   `json:"dpd"`
-	Esplifetime      int64  `json:"esplifetime"`
-	Esppolicy        string `json:"esppolicy"`
-	Forceencap       bool   `json:"forceencap"`
-	Gateway          string `json:"gateway"`
-	Hasannotations   bool   `json:"hasannotations"`
-	Id               string `json:"id"`
-	Ikelifetime      int64  `json:"ikelifetime"`
-	Ikepolicy        string `json:"ikepolicy"`
-	Ikeversion       string `json:"ikeversion"`
-	Ipaddress        string `json:"ipaddress"`
-	Ipsecpsk         string `json:"ipsecpsk"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Project          string `json:"project"`
-	Projectid        string `json:"projectid"`
-	Removed          string `json:"removed"`
-	Splitconnections bool   `json:"splitconnections"`
+	Account          string                     `json:"account"`
+	Cidrlist         string                     `json:"cidrlist"`
+	Domain           string                     `json:"domain"`
+	Domainid         string                     `json:"domainid"`
+	Domainpath       string                     `json:"domainpath"`
+	Dpd              FlexBool                   `json:"dpd"`
+	Esplifetime      FlexInt64                  `json:"esplifetime"`
+	Esppolicy        string                     `json:"esppolicy"`
+	Forceencap       FlexBool                   `json:"forceencap"`
+	Gateway          string                     `json:"gateway"`
+	Hasannotations   FlexBool                   `json:"hasannotations"`
+	Id               string                     `json:"id"`
+	Ikelifetime      FlexInt64                  `json:"ikelifetime"`
+	Ikepolicy        string                     `json:"ikepolicy"`
+	Ikeversion       string                     `json:"ikeversion"`
+	Ipaddress        string                     `json:"ipaddress"`
+	Ipsecpsk         string                     `json:"ipsecpsk"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Project          string                     `json:"project"`
+	Projectid        string                     `json:"projectid"`
+	Removed          Time                       `json:"removed"`
+	Splitconnections FlexBool                   `json:"splitconnections"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVpnCustomerGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVpnCustomerGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVpnGatewayParams struct {
@@ -4512,6 +6084,46 @@ func (p *UpdateVpnGatewayParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVpnGateway API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVpnGatewayParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVpnGateway", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVpnGateway API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVpnGatewayParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "id": 255},
+	}.validate("updateVpnGateway", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVpnGateway API, before the request is signed
+func (p *UpdateVpnGatewayParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVpnGatewayParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVpnGatewayParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVpnGateway", b, &p.p, map[string]paramDecoder{
+		"customid":   decodeParam[string],
+		"fordisplay": decodeParam[bool],
+		"id":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVpnGatewayParams) Clone() *UpdateVpnGatewayParams {
+	return &UpdateVpnGatewayParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVpnGatewayParams) SetCustomid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4586,32 +6198,39 @@ func (s *VPNService) NewUpdateVpnGatewayParams(id string) *UpdateVpnGatewayParam
 
 // Updates site to site VPN local gateway
 func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVpnGateway", p.toURLValues())
+	return s.UpdateVpnGatewayWithContext(context.Background(), p)
+}
+
+// UpdateVpnGatewayWithContext is like UpdateVpnGateway, but honours the cancellation and deadline of ctx
+func (s *VPNService) UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVpnGateway", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVpnGatewayResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVpnGateway", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVpnGateway", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVpnGateway", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4619,19 +6238,44 @@ func (s *VPNService) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGate
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVpnGateway async job into r
+func (r *UpdateVpnGatewayResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVpnGatewayAsync starts the async job of UpdateVpnGateway without waiting for it to finish, and returns a handle to the job
+func (s *VPNService) UpdateVpnGatewayAsync(ctx context.Context, p *UpdateVpnGatewayParams) (*Job, error) {
+	r, err := s.UpdateVpnGatewayWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVpnGateway", r.JobID), nil
+}
+
 type UpdateVpnGatewayResponse struct {
-	Account    string `json:"account"`
-	Domain     string `json:"domain"`
-	Domainid   string `json:"domainid"`
-	Domainpath string `json:"domainpath"`
-	Fordisplay bool   `json:"fordisplay"`
-	Id         string `json:"id"`
-	JobID      string `json:"jobid"`
-	Jobstatus  int    `json:"jobstatus"`
-	Project    string `json:"project"`
-	Projectid  string `json:"projectid"`
-	Publicip   string `json:"publicip"`
-	Removed    string `json:"removed"`
-	Vpcid      string `json:"vpcid"`
-	Vpcname    string `json:"vpcname"`
+	Account    string                     `json:"account"`
+	Domain     string                     `json:"domain"`
+	Domainid   string                     `json:"domainid"`
+	Domainpath string                     `json:"domainpath"`
+	Fordisplay FlexBool                   `json:"fordisplay"`
+	Id         string                     `json:"id"`
+	JobID      string                     `json:"jobid"`
+	Jobstatus  FlexInt                    `json:"jobstatus"`
+	Project    string                     `json:"project"`
+	Projectid  string                     `json:"projectid"`
+	Publicip   string                     `json:"publicip"`
+	Removed    Time                       `json:"removed"`
+	Vpcid      string                     `json:"vpcid"`
+	Vpcname    string                     `json:"vpcname"`
+	Extra      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVpnGatewayResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVpnGatewayResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) AddVpnUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpnUser", reflect.TypeOf((*MockVPNServiceIface)(nil).AddVpnUser), p)
 }
 
+// AddVpnUserAsync mocks base method.
+func (m *MockVPNServiceIface) AddVpnUserAsync(ctx context.Context, p *AddVpnUserParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVpnUserAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVpnUserAsync indicates an expected call of AddVpnUserAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) AddVpnUserAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpnUserAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).AddVpnUserAsync), ctx, p)
+}
+
+// AddVpnUserWithContext mocks base method.
+func (m *MockVPNServiceIface) AddVpnUserWithContext(ctx context.Context, p *AddVpnUserParams) (*AddVpnUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVpnUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddVpnUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVpnUserWithContext indicates an expected call of AddVpnUserWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) AddVpnUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVpnUserWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).AddVpnUserWithContext), ctx, p)
+}
+
 // CreateRemoteAccessVpn mocks base method.
 func (m *MockVPNServiceIface) CreateRemoteAccessVpn(p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) CreateRemoteAccessVpn(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateRemoteAccessVpn), p)
 }
 
+// CreateRemoteAccessVpnAsync mocks base method.
+func (m *MockVPNServiceIface) CreateRemoteAccessVpnAsync(ctx context.Context, p *CreateRemoteAccessVpnParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRemoteAccessVpnAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRemoteAccessVpnAsync indicates an expected call of CreateRemoteAccessVpnAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateRemoteAccessVpnAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRemoteAccessVpnAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateRemoteAccessVpnAsync), ctx, p)
+}
+
+// CreateRemoteAccessVpnWithContext mocks base method.
+func (m *MockVPNServiceIface) CreateRemoteAccessVpnWithContext(ctx context.Context, p *CreateRemoteAccessVpnParams) (*CreateRemoteAccessVpnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateRemoteAccessVpnWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateRemoteAccessVpnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateRemoteAccessVpnWithContext indicates an expected call of CreateRemoteAccessVpnWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateRemoteAccessVpnWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRemoteAccessVpnWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateRemoteAccessVpnWithContext), ctx, p)
+}
+
 // CreateVpnConnection mocks base method.
 func (m *MockVPNServiceIface) CreateVpnConnection(p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +165,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnConnection(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnConnection), p)
 }
 
+// CreateVpnConnectionAsync mocks base method.
+func (m *MockVPNServiceIface) CreateVpnConnectionAsync(ctx context.Context, p *CreateVpnConnectionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpnConnectionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpnConnectionAsync indicates an expected call of CreateVpnConnectionAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnConnectionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnConnectionAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnConnectionAsync), ctx, p)
+}
+
+// CreateVpnConnectionWithContext mocks base method.
+func (m *MockVPNServiceIface) CreateVpnConnectionWithContext(ctx context.Context, p *CreateVpnConnectionParams) (*CreateVpnConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpnConnectionWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVpnConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpnConnectionWithContext indicates an expected call of CreateVpnConnectionWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnConnectionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnConnectionWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnConnectionWithContext), ctx, p)
+}
+
 // CreateVpnCustomerGateway mocks base method.
 func (m *MockVPNServiceIface) CreateVpnCustomerGateway(p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +210,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnCustomerGateway(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnCustomerGateway), p)
 }
 
+// CreateVpnCustomerGatewayAsync mocks base method.
+func (m *MockVPNServiceIface) CreateVpnCustomerGatewayAsync(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpnCustomerGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpnCustomerGatewayAsync indicates an expected call of CreateVpnCustomerGatewayAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnCustomerGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnCustomerGatewayAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnCustomerGatewayAsync), ctx, p)
+}
+
+// CreateVpnCustomerGatewayWithContext mocks base method.
+func (m *MockVPNServiceIface) CreateVpnCustomerGatewayWithContext(ctx context.Context, p *CreateVpnCustomerGatewayParams) (*CreateVpnCustomerGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpnCustomerGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVpnCustomerGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpnCustomerGatewayWithContext indicates an expected call of CreateVpnCustomerGatewayWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnCustomerGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnCustomerGatewayWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnCustomerGatewayWithContext), ctx, p)
+}
+
 // CreateVpnGateway mocks base method.
 func (m *MockVPNServiceIface) CreateVpnGateway(p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +255,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnGateway(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnGateway), p)
 }
 
+// CreateVpnGatewayAsync mocks base method.
+func (m *MockVPNServiceIface) CreateVpnGatewayAsync(ctx context.Context, p *CreateVpnGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpnGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpnGatewayAsync indicates an expected call of CreateVpnGatewayAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnGatewayAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnGatewayAsync), ctx, p)
+}
+
+// CreateVpnGatewayWithContext mocks base method.
+func (m *MockVPNServiceIface) CreateVpnGatewayWithContext(ctx context.Context, p *CreateVpnGatewayParams) (*CreateVpnGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVpnGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVpnGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVpnGatewayWithContext indicates an expected call of CreateVpnGatewayWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) CreateVpnGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpnGatewayWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).CreateVpnGatewayWithContext), ctx, p)
+}
+
 // DeleteRemoteAccessVpn mocks base method.
 func (m *MockVPNServiceIface) DeleteRemoteAccessVpn(p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +300,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) DeleteRemoteAccessVpn(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteRemoteAccessVpn), p)
 }
 
+// DeleteRemoteAccessVpnAsync mocks base method.
+func (m *MockVPNServiceIface) DeleteRemoteAccessVpnAsync(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemoteAccessVpnAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRemoteAccessVpnAsync indicates an expected call of DeleteRemoteAccessVpnAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteRemoteAccessVpnAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemoteAccessVpnAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteRemoteAccessVpnAsync), ctx, p)
+}
+
+// DeleteRemoteAccessVpnWithContext mocks base method.
+func (m *MockVPNServiceIface) DeleteRemoteAccessVpnWithContext(ctx context.Context, p *DeleteRemoteAccessVpnParams) (*DeleteRemoteAccessVpnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteRemoteAccessVpnWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteRemoteAccessVpnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteRemoteAccessVpnWithContext indicates an expected call of DeleteRemoteAccessVpnWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteRemoteAccessVpnWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRemoteAccessVpnWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteRemoteAccessVpnWithContext), ctx, p)
+}
+
 // DeleteVpnConnection mocks base method.
 func (m *MockVPNServiceIface) DeleteVpnConnection(p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +345,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnConnection(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnConnection), p)
 }
 
+// DeleteVpnConnectionAsync mocks base method.
+func (m *MockVPNServiceIface) DeleteVpnConnectionAsync(ctx context.Context, p *DeleteVpnConnectionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpnConnectionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpnConnectionAsync indicates an expected call of DeleteVpnConnectionAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnConnectionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnConnectionAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnConnectionAsync), ctx, p)
+}
+
+// DeleteVpnConnectionWithContext mocks base method.
+func (m *MockVPNServiceIface) DeleteVpnConnectionWithContext(ctx context.Context, p *DeleteVpnConnectionParams) (*DeleteVpnConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpnConnectionWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVpnConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpnConnectionWithContext indicates an expected call of DeleteVpnConnectionWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnConnectionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnConnectionWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnConnectionWithContext), ctx, p)
+}
+
 // DeleteVpnCustomerGateway mocks base method.
 func (m *MockVPNServiceIface) DeleteVpnCustomerGateway(p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +390,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnCustomerGateway(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnCustomerGateway), p)
 }
 
+// DeleteVpnCustomerGatewayAsync mocks base method.
+func (m *MockVPNServiceIface) DeleteVpnCustomerGatewayAsync(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpnCustomerGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpnCustomerGatewayAsync indicates an expected call of DeleteVpnCustomerGatewayAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnCustomerGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnCustomerGatewayAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnCustomerGatewayAsync), ctx, p)
+}
+
+// DeleteVpnCustomerGatewayWithContext mocks base method.
+func (m *MockVPNServiceIface) DeleteVpnCustomerGatewayWithContext(ctx context.Context, p *DeleteVpnCustomerGatewayParams) (*DeleteVpnCustomerGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpnCustomerGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVpnCustomerGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpnCustomerGatewayWithContext indicates an expected call of DeleteVpnCustomerGatewayWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnCustomerGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnCustomerGatewayWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnCustomerGatewayWithContext), ctx, p)
+}
+
 // DeleteVpnGateway mocks base method.
 func (m *MockVPNServiceIface) DeleteVpnGateway(p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +435,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnGateway(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnGateway), p)
 }
 
+// DeleteVpnGatewayAsync mocks base method.
+func (m *MockVPNServiceIface) DeleteVpnGatewayAsync(ctx context.Context, p *DeleteVpnGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpnGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpnGatewayAsync indicates an expected call of DeleteVpnGatewayAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnGatewayAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnGatewayAsync), ctx, p)
+}
+
+// DeleteVpnGatewayWithContext mocks base method.
+func (m *MockVPNServiceIface) DeleteVpnGatewayWithContext(ctx context.Context, p *DeleteVpnGatewayParams) (*DeleteVpnGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVpnGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVpnGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVpnGatewayWithContext indicates an expected call of DeleteVpnGatewayWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) DeleteVpnGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpnGatewayWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).DeleteVpnGatewayWithContext), ctx, p)
+}
+
 // GetRemoteAccessVpnByID mocks base method.
 func (m *MockVPNServiceIface) GetRemoteAccessVpnByID(id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +486,27 @@ func (mr *MockVPNServiceIfaceMockRecorder) GetRemoteAccessVpnByID(id any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteAccessVpnByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetRemoteAccessVpnByID), varargs...)
 }
 
+// GetRemoteAccessVpnByIDWithContext mocks base method.
+func (m *MockVPNServiceIface) GetRemoteAccessVpnByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*RemoteAccessVpn, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetRemoteAccessVpnByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*RemoteAccessVpn)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetRemoteAccessVpnByIDWithContext indicates an expected call of GetRemoteAccessVpnByIDWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) GetRemoteAccessVpnByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRemoteAccessVpnByIDWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).GetRemoteAccessVpnByIDWithContext), varargs...)
+}
+
 // GetVpnConnectionByID mocks base method.
 func (m *MockVPNServiceIface) GetVpnConnectionByID(id string, opts ...OptionFunc) (*VpnConnection, int, error) {
 	m.ctrl.T.Helper()
@@ -228,191 +521,782 @@ func (m *MockVPNServiceIface) GetVpnConnectionByID(id string, opts ...OptionFunc
 	return ret0, ret1, ret2
 }
 
-// GetVpnConnectionByID indicates an expected call of GetVpnConnectionByID.
-func (mr *MockVPNServiceIfaceMockRecorder) GetVpnConnectionByID(id any, opts ...any) *gomock.Call {
+// GetVpnConnectionByID indicates an expected call of GetVpnConnectionByID.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnConnectionByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnConnectionByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnConnectionByID), varargs...)
+}
+
+// GetVpnConnectionByIDWithContext mocks base method.
+func (m *MockVPNServiceIface) GetVpnConnectionByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnConnection, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnConnectionByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VpnConnection)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnConnectionByIDWithContext indicates an expected call of GetVpnConnectionByIDWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnConnectionByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnConnectionByIDWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnConnectionByIDWithContext), varargs...)
+}
+
+// GetVpnCustomerGatewayByID mocks base method.
+func (m *MockVPNServiceIface) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayByID", varargs...)
+	ret0, _ := ret[0].(*VpnCustomerGateway)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnCustomerGatewayByID indicates an expected call of GetVpnCustomerGatewayByID.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayByID), varargs...)
+}
+
+// GetVpnCustomerGatewayByIDWithContext mocks base method.
+func (m *MockVPNServiceIface) GetVpnCustomerGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VpnCustomerGateway)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnCustomerGatewayByIDWithContext indicates an expected call of GetVpnCustomerGatewayByIDWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayByIDWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayByIDWithContext), varargs...)
+}
+
+// GetVpnCustomerGatewayByName mocks base method.
+func (m *MockVPNServiceIface) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayByName", varargs...)
+	ret0, _ := ret[0].(*VpnCustomerGateway)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnCustomerGatewayByName indicates an expected call of GetVpnCustomerGatewayByName.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayByName", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayByName), varargs...)
+}
+
+// GetVpnCustomerGatewayByNameWithContext mocks base method.
+func (m *MockVPNServiceIface) GetVpnCustomerGatewayByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VpnCustomerGateway)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnCustomerGatewayByNameWithContext indicates an expected call of GetVpnCustomerGatewayByNameWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayByNameWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayByNameWithContext), varargs...)
+}
+
+// GetVpnCustomerGatewayID mocks base method.
+func (m *MockVPNServiceIface) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnCustomerGatewayID indicates an expected call of GetVpnCustomerGatewayID.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayID(keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayID), varargs...)
+}
+
+// GetVpnCustomerGatewayIDWithContext mocks base method.
+func (m *MockVPNServiceIface) GetVpnCustomerGatewayIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnCustomerGatewayIDWithContext indicates an expected call of GetVpnCustomerGatewayIDWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayIDWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayIDWithContext), varargs...)
+}
+
+// GetVpnGatewayByID mocks base method.
+func (m *MockVPNServiceIface) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnGatewayByID", varargs...)
+	ret0, _ := ret[0].(*VpnGateway)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnGatewayByID indicates an expected call of GetVpnGatewayByID.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnGatewayByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnGatewayByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnGatewayByID), varargs...)
+}
+
+// GetVpnGatewayByIDWithContext mocks base method.
+func (m *MockVPNServiceIface) GetVpnGatewayByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnGateway, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnGatewayByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VpnGateway)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnGatewayByIDWithContext indicates an expected call of GetVpnGatewayByIDWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnGatewayByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnGatewayByIDWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnGatewayByIDWithContext), varargs...)
+}
+
+// GetVpnUserByID mocks base method.
+func (m *MockVPNServiceIface) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnUserByID", varargs...)
+	ret0, _ := ret[0].(*VpnUser)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnUserByID indicates an expected call of GetVpnUserByID.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnUserByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnUserByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnUserByID), varargs...)
+}
+
+// GetVpnUserByIDWithContext mocks base method.
+func (m *MockVPNServiceIface) GetVpnUserByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VpnUser, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVpnUserByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VpnUser)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVpnUserByIDWithContext indicates an expected call of GetVpnUserByIDWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) GetVpnUserByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnUserByIDWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnUserByIDWithContext), varargs...)
+}
+
+// ListRemoteAccessVpns mocks base method.
+func (m *MockVPNServiceIface) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemoteAccessVpns", p)
+	ret0, _ := ret[0].(*ListRemoteAccessVpnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemoteAccessVpns indicates an expected call of ListRemoteAccessVpns.
+func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpns(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpns", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpns), p)
+}
+
+// ListRemoteAccessVpnsAll mocks base method.
+func (m *MockVPNServiceIface) ListRemoteAccessVpnsAll(p *ListRemoteAccessVpnsParams, opts ...PageOption) ([]*RemoteAccessVpn, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRemoteAccessVpnsAll", varargs...)
+	ret0, _ := ret[0].([]*RemoteAccessVpn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemoteAccessVpnsAll indicates an expected call of ListRemoteAccessVpnsAll.
+func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpnsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpnsAll", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpnsAll), varargs...)
+}
+
+// ListRemoteAccessVpnsAllWithContext mocks base method.
+func (m *MockVPNServiceIface) ListRemoteAccessVpnsAllWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams, opts ...PageOption) ([]*RemoteAccessVpn, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRemoteAccessVpnsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*RemoteAccessVpn)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemoteAccessVpnsAllWithContext indicates an expected call of ListRemoteAccessVpnsAllWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpnsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpnsAllWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpnsAllWithContext), varargs...)
+}
+
+// ListRemoteAccessVpnsIter mocks base method.
+func (m *MockVPNServiceIface) ListRemoteAccessVpnsIter(p *ListRemoteAccessVpnsParams, opts ...PageOption) iter.Seq2[*RemoteAccessVpn, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRemoteAccessVpnsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*RemoteAccessVpn, error])
+	return ret0
+}
+
+// ListRemoteAccessVpnsIter indicates an expected call of ListRemoteAccessVpnsIter.
+func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpnsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpnsIter", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpnsIter), varargs...)
+}
+
+// ListRemoteAccessVpnsIterWithContext mocks base method.
+func (m *MockVPNServiceIface) ListRemoteAccessVpnsIterWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams, opts ...PageOption) iter.Seq2[*RemoteAccessVpn, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListRemoteAccessVpnsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*RemoteAccessVpn, error])
+	return ret0
+}
+
+// ListRemoteAccessVpnsIterWithContext indicates an expected call of ListRemoteAccessVpnsIterWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpnsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpnsIterWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpnsIterWithContext), varargs...)
+}
+
+// ListRemoteAccessVpnsWithContext mocks base method.
+func (m *MockVPNServiceIface) ListRemoteAccessVpnsWithContext(ctx context.Context, p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListRemoteAccessVpnsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListRemoteAccessVpnsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListRemoteAccessVpnsWithContext indicates an expected call of ListRemoteAccessVpnsWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpnsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpnsWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpnsWithContext), ctx, p)
+}
+
+// ListVpnConnections mocks base method.
+func (m *MockVPNServiceIface) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpnConnections", p)
+	ret0, _ := ret[0].(*ListVpnConnectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnConnections indicates an expected call of ListVpnConnections.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnections(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnections", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnections), p)
+}
+
+// ListVpnConnectionsAll mocks base method.
+func (m *MockVPNServiceIface) ListVpnConnectionsAll(p *ListVpnConnectionsParams, opts ...PageOption) ([]*VpnConnection, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnConnectionsAll", varargs...)
+	ret0, _ := ret[0].([]*VpnConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnConnectionsAll indicates an expected call of ListVpnConnectionsAll.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnectionsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnectionsAll", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnectionsAll), varargs...)
+}
+
+// ListVpnConnectionsAllWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnConnectionsAllWithContext(ctx context.Context, p *ListVpnConnectionsParams, opts ...PageOption) ([]*VpnConnection, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnConnectionsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VpnConnection)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnConnectionsAllWithContext indicates an expected call of ListVpnConnectionsAllWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnectionsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnectionsAllWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnectionsAllWithContext), varargs...)
+}
+
+// ListVpnConnectionsIter mocks base method.
+func (m *MockVPNServiceIface) ListVpnConnectionsIter(p *ListVpnConnectionsParams, opts ...PageOption) iter.Seq2[*VpnConnection, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnConnectionsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnConnection, error])
+	return ret0
+}
+
+// ListVpnConnectionsIter indicates an expected call of ListVpnConnectionsIter.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnectionsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnectionsIter", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnectionsIter), varargs...)
+}
+
+// ListVpnConnectionsIterWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnConnectionsIterWithContext(ctx context.Context, p *ListVpnConnectionsParams, opts ...PageOption) iter.Seq2[*VpnConnection, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnConnectionsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnConnection, error])
+	return ret0
+}
+
+// ListVpnConnectionsIterWithContext indicates an expected call of ListVpnConnectionsIterWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnectionsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnectionsIterWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnectionsIterWithContext), varargs...)
+}
+
+// ListVpnConnectionsWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnConnectionsWithContext(ctx context.Context, p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpnConnectionsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVpnConnectionsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnConnectionsWithContext indicates an expected call of ListVpnConnectionsWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnectionsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnectionsWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnectionsWithContext), ctx, p)
+}
+
+// ListVpnCustomerGateways mocks base method.
+func (m *MockVPNServiceIface) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpnCustomerGateways", p)
+	ret0, _ := ret[0].(*ListVpnCustomerGatewaysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnCustomerGateways indicates an expected call of ListVpnCustomerGateways.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGateways(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGateways), p)
+}
+
+// ListVpnCustomerGatewaysAll mocks base method.
+func (m *MockVPNServiceIface) ListVpnCustomerGatewaysAll(p *ListVpnCustomerGatewaysParams, opts ...PageOption) ([]*VpnCustomerGateway, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnCustomerGatewaysAll", varargs...)
+	ret0, _ := ret[0].([]*VpnCustomerGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnCustomerGatewaysAll indicates an expected call of ListVpnCustomerGatewaysAll.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGatewaysAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGatewaysAll", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGatewaysAll), varargs...)
+}
+
+// ListVpnCustomerGatewaysAllWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnCustomerGatewaysAllWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams, opts ...PageOption) ([]*VpnCustomerGateway, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnCustomerGatewaysAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VpnCustomerGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnCustomerGatewaysAllWithContext indicates an expected call of ListVpnCustomerGatewaysAllWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGatewaysAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGatewaysAllWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGatewaysAllWithContext), varargs...)
+}
+
+// ListVpnCustomerGatewaysIter mocks base method.
+func (m *MockVPNServiceIface) ListVpnCustomerGatewaysIter(p *ListVpnCustomerGatewaysParams, opts ...PageOption) iter.Seq2[*VpnCustomerGateway, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnCustomerGatewaysIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnCustomerGateway, error])
+	return ret0
+}
+
+// ListVpnCustomerGatewaysIter indicates an expected call of ListVpnCustomerGatewaysIter.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGatewaysIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGatewaysIter", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGatewaysIter), varargs...)
+}
+
+// ListVpnCustomerGatewaysIterWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnCustomerGatewaysIterWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams, opts ...PageOption) iter.Seq2[*VpnCustomerGateway, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnCustomerGatewaysIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnCustomerGateway, error])
+	return ret0
+}
+
+// ListVpnCustomerGatewaysIterWithContext indicates an expected call of ListVpnCustomerGatewaysIterWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGatewaysIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGatewaysIterWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGatewaysIterWithContext), varargs...)
+}
+
+// ListVpnCustomerGatewaysWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnCustomerGatewaysWithContext(ctx context.Context, p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpnCustomerGatewaysWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVpnCustomerGatewaysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVpnCustomerGatewaysWithContext indicates an expected call of ListVpnCustomerGatewaysWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGatewaysWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnConnectionByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnConnectionByID), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGatewaysWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGatewaysWithContext), ctx, p)
 }
 
-// GetVpnCustomerGatewayByID mocks base method.
-func (m *MockVPNServiceIface) GetVpnCustomerGatewayByID(id string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+// ListVpnGateways mocks base method.
+func (m *MockVPNServiceIface) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayByID", varargs...)
-	ret0, _ := ret[0].(*VpnCustomerGateway)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVpnGateways", p)
+	ret0, _ := ret[0].(*ListVpnGatewaysResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetVpnCustomerGatewayByID indicates an expected call of GetVpnCustomerGatewayByID.
-func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayByID(id any, opts ...any) *gomock.Call {
+// ListVpnGateways indicates an expected call of ListVpnGateways.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGateways(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayByID), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGateways), p)
 }
 
-// GetVpnCustomerGatewayByName mocks base method.
-func (m *MockVPNServiceIface) GetVpnCustomerGatewayByName(name string, opts ...OptionFunc) (*VpnCustomerGateway, int, error) {
+// ListVpnGatewaysAll mocks base method.
+func (m *MockVPNServiceIface) ListVpnGatewaysAll(p *ListVpnGatewaysParams, opts ...PageOption) ([]*VpnGateway, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayByName", varargs...)
-	ret0, _ := ret[0].(*VpnCustomerGateway)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVpnGatewaysAll", varargs...)
+	ret0, _ := ret[0].([]*VpnGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetVpnCustomerGatewayByName indicates an expected call of GetVpnCustomerGatewayByName.
-func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayByName(name any, opts ...any) *gomock.Call {
+// ListVpnGatewaysAll indicates an expected call of ListVpnGatewaysAll.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGatewaysAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayByName", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayByName), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGatewaysAll", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGatewaysAll), varargs...)
 }
 
-// GetVpnCustomerGatewayID mocks base method.
-func (m *MockVPNServiceIface) GetVpnCustomerGatewayID(keyword string, opts ...OptionFunc) (string, int, error) {
+// ListVpnGatewaysAllWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnGatewaysAllWithContext(ctx context.Context, p *ListVpnGatewaysParams, opts ...PageOption) ([]*VpnGateway, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{keyword}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVpnCustomerGatewayID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVpnGatewaysAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VpnGateway)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetVpnCustomerGatewayID indicates an expected call of GetVpnCustomerGatewayID.
-func (mr *MockVPNServiceIfaceMockRecorder) GetVpnCustomerGatewayID(keyword any, opts ...any) *gomock.Call {
+// ListVpnGatewaysAllWithContext indicates an expected call of ListVpnGatewaysAllWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGatewaysAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{keyword}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnCustomerGatewayID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnCustomerGatewayID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGatewaysAllWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGatewaysAllWithContext), varargs...)
 }
 
-// GetVpnGatewayByID mocks base method.
-func (m *MockVPNServiceIface) GetVpnGatewayByID(id string, opts ...OptionFunc) (*VpnGateway, int, error) {
+// ListVpnGatewaysIter mocks base method.
+func (m *MockVPNServiceIface) ListVpnGatewaysIter(p *ListVpnGatewaysParams, opts ...PageOption) iter.Seq2[*VpnGateway, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVpnGatewayByID", varargs...)
-	ret0, _ := ret[0].(*VpnGateway)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVpnGatewaysIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnGateway, error])
+	return ret0
 }
 
-// GetVpnGatewayByID indicates an expected call of GetVpnGatewayByID.
-func (mr *MockVPNServiceIfaceMockRecorder) GetVpnGatewayByID(id any, opts ...any) *gomock.Call {
+// ListVpnGatewaysIter indicates an expected call of ListVpnGatewaysIter.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGatewaysIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnGatewayByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnGatewayByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGatewaysIter", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGatewaysIter), varargs...)
 }
 
-// GetVpnUserByID mocks base method.
-func (m *MockVPNServiceIface) GetVpnUserByID(id string, opts ...OptionFunc) (*VpnUser, int, error) {
+// ListVpnGatewaysIterWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnGatewaysIterWithContext(ctx context.Context, p *ListVpnGatewaysParams, opts ...PageOption) iter.Seq2[*VpnGateway, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVpnUserByID", varargs...)
-	ret0, _ := ret[0].(*VpnUser)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVpnGatewaysIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnGateway, error])
+	return ret0
 }
 
-// GetVpnUserByID indicates an expected call of GetVpnUserByID.
-func (mr *MockVPNServiceIfaceMockRecorder) GetVpnUserByID(id any, opts ...any) *gomock.Call {
+// ListVpnGatewaysIterWithContext indicates an expected call of ListVpnGatewaysIterWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGatewaysIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVpnUserByID", reflect.TypeOf((*MockVPNServiceIface)(nil).GetVpnUserByID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGatewaysIterWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGatewaysIterWithContext), varargs...)
 }
 
-// ListRemoteAccessVpns mocks base method.
-func (m *MockVPNServiceIface) ListRemoteAccessVpns(p *ListRemoteAccessVpnsParams) (*ListRemoteAccessVpnsResponse, error) {
+// ListVpnGatewaysWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnGatewaysWithContext(ctx context.Context, p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListRemoteAccessVpns", p)
-	ret0, _ := ret[0].(*ListRemoteAccessVpnsResponse)
+	ret := m.ctrl.Call(m, "ListVpnGatewaysWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVpnGatewaysResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListRemoteAccessVpns indicates an expected call of ListRemoteAccessVpns.
-func (mr *MockVPNServiceIfaceMockRecorder) ListRemoteAccessVpns(p any) *gomock.Call {
+// ListVpnGatewaysWithContext indicates an expected call of ListVpnGatewaysWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGatewaysWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListRemoteAccessVpns", reflect.TypeOf((*MockVPNServiceIface)(nil).ListRemoteAccessVpns), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGatewaysWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGatewaysWithContext), ctx, p)
 }
 
-// ListVpnConnections mocks base method.
-func (m *MockVPNServiceIface) ListVpnConnections(p *ListVpnConnectionsParams) (*ListVpnConnectionsResponse, error) {
+// ListVpnUsers mocks base method.
+func (m *MockVPNServiceIface) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnConnections", p)
-	ret0, _ := ret[0].(*ListVpnConnectionsResponse)
+	ret := m.ctrl.Call(m, "ListVpnUsers", p)
+	ret0, _ := ret[0].(*ListVpnUsersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVpnConnections indicates an expected call of ListVpnConnections.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnConnections(p any) *gomock.Call {
+// ListVpnUsers indicates an expected call of ListVpnUsers.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsers(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnConnections", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnConnections), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsers", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsers), p)
 }
 
-// ListVpnCustomerGateways mocks base method.
-func (m *MockVPNServiceIface) ListVpnCustomerGateways(p *ListVpnCustomerGatewaysParams) (*ListVpnCustomerGatewaysResponse, error) {
+// ListVpnUsersAll mocks base method.
+func (m *MockVPNServiceIface) ListVpnUsersAll(p *ListVpnUsersParams, opts ...PageOption) ([]*VpnUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnCustomerGateways", p)
-	ret0, _ := ret[0].(*ListVpnCustomerGatewaysResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnUsersAll", varargs...)
+	ret0, _ := ret[0].([]*VpnUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVpnCustomerGateways indicates an expected call of ListVpnCustomerGateways.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnCustomerGateways(p any) *gomock.Call {
+// ListVpnUsersAll indicates an expected call of ListVpnUsersAll.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsersAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnCustomerGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnCustomerGateways), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsersAll", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsersAll), varargs...)
 }
 
-// ListVpnGateways mocks base method.
-func (m *MockVPNServiceIface) ListVpnGateways(p *ListVpnGatewaysParams) (*ListVpnGatewaysResponse, error) {
+// ListVpnUsersAllWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnUsersAllWithContext(ctx context.Context, p *ListVpnUsersParams, opts ...PageOption) ([]*VpnUser, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnGateways", p)
-	ret0, _ := ret[0].(*ListVpnGatewaysResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnUsersAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VpnUser)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVpnGateways indicates an expected call of ListVpnGateways.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnGateways(p any) *gomock.Call {
+// ListVpnUsersAllWithContext indicates an expected call of ListVpnUsersAllWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsersAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnGateways", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnGateways), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsersAllWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsersAllWithContext), varargs...)
 }
 
-// ListVpnUsers mocks base method.
-func (m *MockVPNServiceIface) ListVpnUsers(p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+// ListVpnUsersIter mocks base method.
+func (m *MockVPNServiceIface) ListVpnUsersIter(p *ListVpnUsersParams, opts ...PageOption) iter.Seq2[*VpnUser, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVpnUsers", p)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnUsersIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnUser, error])
+	return ret0
+}
+
+// ListVpnUsersIter indicates an expected call of ListVpnUsersIter.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsersIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsersIter", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsersIter), varargs...)
+}
+
+// ListVpnUsersIterWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnUsersIterWithContext(ctx context.Context, p *ListVpnUsersParams, opts ...PageOption) iter.Seq2[*VpnUser, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVpnUsersIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VpnUser, error])
+	return ret0
+}
+
+// ListVpnUsersIterWithContext indicates an expected call of ListVpnUsersIterWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsersIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsersIterWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsersIterWithContext), varargs...)
+}
+
+// ListVpnUsersWithContext mocks base method.
+func (m *MockVPNServiceIface) ListVpnUsersWithContext(ctx context.Context, p *ListVpnUsersParams) (*ListVpnUsersResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVpnUsersWithContext", ctx, p)
 	ret0, _ := ret[0].(*ListVpnUsersResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVpnUsers indicates an expected call of ListVpnUsers.
-func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsers(p any) *gomock.Call {
+// ListVpnUsersWithContext indicates an expected call of ListVpnUsersWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ListVpnUsersWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsers", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsers), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVpnUsersWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ListVpnUsersWithContext), ctx, p)
 }
 
 // NewAddVpnUserParams mocks base method.
@@ -710,6 +1594,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) RemoveVpnUser(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVpnUser", reflect.TypeOf((*MockVPNServiceIface)(nil).RemoveVpnUser), p)
 }
 
+// RemoveVpnUserAsync mocks base method.
+func (m *MockVPNServiceIface) RemoveVpnUserAsync(ctx context.Context, p *RemoveVpnUserParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVpnUserAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVpnUserAsync indicates an expected call of RemoveVpnUserAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) RemoveVpnUserAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVpnUserAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).RemoveVpnUserAsync), ctx, p)
+}
+
+// RemoveVpnUserWithContext mocks base method.
+func (m *MockVPNServiceIface) RemoveVpnUserWithContext(ctx context.Context, p *RemoveVpnUserParams) (*RemoveVpnUserResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVpnUserWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveVpnUserResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVpnUserWithContext indicates an expected call of RemoveVpnUserWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) RemoveVpnUserWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVpnUserWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).RemoveVpnUserWithContext), ctx, p)
+}
+
 // ResetVpnConnection mocks base method.
 func (m *MockVPNServiceIface) ResetVpnConnection(p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
@@ -725,6 +1639,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) ResetVpnConnection(p any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).ResetVpnConnection), p)
 }
 
+// ResetVpnConnectionAsync mocks base method.
+func (m *MockVPNServiceIface) ResetVpnConnectionAsync(ctx context.Context, p *ResetVpnConnectionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetVpnConnectionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetVpnConnectionAsync indicates an expected call of ResetVpnConnectionAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) ResetVpnConnectionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVpnConnectionAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).ResetVpnConnectionAsync), ctx, p)
+}
+
+// ResetVpnConnectionWithContext mocks base method.
+func (m *MockVPNServiceIface) ResetVpnConnectionWithContext(ctx context.Context, p *ResetVpnConnectionParams) (*ResetVpnConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetVpnConnectionWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResetVpnConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetVpnConnectionWithContext indicates an expected call of ResetVpnConnectionWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) ResetVpnConnectionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetVpnConnectionWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).ResetVpnConnectionWithContext), ctx, p)
+}
+
 // UpdateRemoteAccessVpn mocks base method.
 func (m *MockVPNServiceIface) UpdateRemoteAccessVpn(p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
 	m.ctrl.T.Helper()
@@ -740,6 +1684,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) UpdateRemoteAccessVpn(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteAccessVpn", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateRemoteAccessVpn), p)
 }
 
+// UpdateRemoteAccessVpnAsync mocks base method.
+func (m *MockVPNServiceIface) UpdateRemoteAccessVpnAsync(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemoteAccessVpnAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRemoteAccessVpnAsync indicates an expected call of UpdateRemoteAccessVpnAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateRemoteAccessVpnAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteAccessVpnAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateRemoteAccessVpnAsync), ctx, p)
+}
+
+// UpdateRemoteAccessVpnWithContext mocks base method.
+func (m *MockVPNServiceIface) UpdateRemoteAccessVpnWithContext(ctx context.Context, p *UpdateRemoteAccessVpnParams) (*UpdateRemoteAccessVpnResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateRemoteAccessVpnWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateRemoteAccessVpnResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateRemoteAccessVpnWithContext indicates an expected call of UpdateRemoteAccessVpnWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateRemoteAccessVpnWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateRemoteAccessVpnWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateRemoteAccessVpnWithContext), ctx, p)
+}
+
 // UpdateVpnConnection mocks base method.
 func (m *MockVPNServiceIface) UpdateVpnConnection(p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
 	m.ctrl.T.Helper()
@@ -755,6 +1729,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnConnection(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnConnection", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnConnection), p)
 }
 
+// UpdateVpnConnectionAsync mocks base method.
+func (m *MockVPNServiceIface) UpdateVpnConnectionAsync(ctx context.Context, p *UpdateVpnConnectionParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpnConnectionAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVpnConnectionAsync indicates an expected call of UpdateVpnConnectionAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnConnectionAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnConnectionAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnConnectionAsync), ctx, p)
+}
+
+// UpdateVpnConnectionWithContext mocks base method.
+func (m *MockVPNServiceIface) UpdateVpnConnectionWithContext(ctx context.Context, p *UpdateVpnConnectionParams) (*UpdateVpnConnectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpnConnectionWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVpnConnectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVpnConnectionWithContext indicates an expected call of UpdateVpnConnectionWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnConnectionWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnConnectionWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnConnectionWithContext), ctx, p)
+}
+
 // UpdateVpnCustomerGateway mocks base method.
 func (m *MockVPNServiceIface) UpdateVpnCustomerGateway(p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -770,6 +1774,36 @@ func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnCustomerGateway(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnCustomerGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnCustomerGateway), p)
 }
 
+// UpdateVpnCustomerGatewayAsync mocks base method.
+func (m *MockVPNServiceIface) UpdateVpnCustomerGatewayAsync(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpnCustomerGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVpnCustomerGatewayAsync indicates an expected call of UpdateVpnCustomerGatewayAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnCustomerGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnCustomerGatewayAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnCustomerGatewayAsync), ctx, p)
+}
+
+// UpdateVpnCustomerGatewayWithContext mocks base method.
+func (m *MockVPNServiceIface) UpdateVpnCustomerGatewayWithContext(ctx context.Context, p *UpdateVpnCustomerGatewayParams) (*UpdateVpnCustomerGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpnCustomerGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVpnCustomerGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVpnCustomerGatewayWithContext indicates an expected call of UpdateVpnCustomerGatewayWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnCustomerGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnCustomerGatewayWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnCustomerGatewayWithContext), ctx, p)
+}
+
 // UpdateVpnGateway mocks base method.
 func (m *MockVPNServiceIface) UpdateVpnGateway(p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -784,3 +1818,33 @@ func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnGateway(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnGateway", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnGateway), p)
 }
+
+// UpdateVpnGatewayAsync mocks base method.
+func (m *MockVPNServiceIface) UpdateVpnGatewayAsync(ctx context.Context, p *UpdateVpnGatewayParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpnGatewayAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVpnGatewayAsync indicates an expected call of UpdateVpnGatewayAsync.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnGatewayAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnGatewayAsync", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnGatewayAsync), ctx, p)
+}
+
+// UpdateVpnGatewayWithContext mocks base method.
+func (m *MockVPNServiceIface) UpdateVpnGatewayWithContext(ctx context.Context, p *UpdateVpnGatewayParams) (*UpdateVpnGatewayResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVpnGatewayWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVpnGatewayResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVpnGatewayWithContext indicates an expected call of UpdateVpnGatewayWithContext.
+func (mr *MockVPNServiceIfaceMockRecorder) UpdateVpnGatewayWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVpnGatewayWithContext", reflect.TypeOf((*MockVPNServiceIface)(nil).UpdateVpnGatewayWithContext), ctx, p)
+}
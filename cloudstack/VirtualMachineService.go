@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,86 +31,180 @@ import (
 
 type VirtualMachineServiceIface interface {
 	AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error)
+	AddNicToVirtualMachineAsync(ctx context.Context, p *AddNicToVirtualMachineParams) (*Job, error)
 	NewAddNicToVirtualMachineParams(networkid string, virtualmachineid string) *AddNicToVirtualMachineParams
 	AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
+	AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error)
 	NewAssignVirtualMachineParams(virtualmachineid string) *AssignVirtualMachineParams
 	ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
+	ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error)
 	NewChangeServiceForVirtualMachineParams(id string, serviceofferingid string) *ChangeServiceForVirtualMachineParams
 	CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error)
+	CleanVMReservationsAsync(ctx context.Context, p *CleanVMReservationsParams) (*Job, error)
 	NewCleanVMReservationsParams() *CleanVMReservationsParams
 	DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error)
+	DeployVirtualMachineAsync(ctx context.Context, p *DeployVirtualMachineParams) (*Job, error)
 	NewDeployVirtualMachineParams(serviceofferingid string, templateid string, zoneid string) *DeployVirtualMachineParams
 	DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error)
+	DestroyVirtualMachineAsync(ctx context.Context, p *DestroyVirtualMachineParams) (*Job, error)
 	NewDestroyVirtualMachineParams(id string) *DestroyVirtualMachineParams
 	ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error)
+	ExpungeVirtualMachineAsync(ctx context.Context, p *ExpungeVirtualMachineParams) (*Job, error)
 	NewExpungeVirtualMachineParams(id string) *ExpungeVirtualMachineParams
 	GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
+	GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error)
 	NewGetVMPasswordParams(id string) *GetVMPasswordParams
 	ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error)
+	ListVirtualMachinesAll(p *ListVirtualMachinesParams, opts ...PageOption) ([]*VirtualMachine, error)
+	ListVirtualMachinesAllWithContext(ctx context.Context, p *ListVirtualMachinesParams, opts ...PageOption) ([]*VirtualMachine, error)
+	ListVirtualMachinesIter(p *ListVirtualMachinesParams, opts ...PageOption) iter.Seq2[*VirtualMachine, error]
+	ListVirtualMachinesIterWithContext(ctx context.Context, p *ListVirtualMachinesParams, opts ...PageOption) iter.Seq2[*VirtualMachine, error]
 	NewListVirtualMachinesParams() *ListVirtualMachinesParams
 	GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachineIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachine, int, error)
 	GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error)
+	GetVirtualMachineByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error)
 	ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	ListVirtualMachinesMetricsWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error)
+	ListVirtualMachinesMetricsAll(p *ListVirtualMachinesMetricsParams, opts ...PageOption) ([]*VirtualMachinesMetric, error)
+	ListVirtualMachinesMetricsAllWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams, opts ...PageOption) ([]*VirtualMachinesMetric, error)
+	ListVirtualMachinesMetricsIter(p *ListVirtualMachinesMetricsParams, opts ...PageOption) iter.Seq2[*VirtualMachinesMetric, error]
+	ListVirtualMachinesMetricsIterWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams, opts ...PageOption) iter.Seq2[*VirtualMachinesMetric, error]
 	NewListVirtualMachinesMetricsParams() *ListVirtualMachinesMetricsParams
 	GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachinesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVirtualMachinesMetricByName(name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
 	GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
+	GetVirtualMachinesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error)
 	ListVmsForImport(p *ListVmsForImportParams) (*ListVmsForImportResponse, error)
-	NewListVmsForImportParams(host string, hypervisor string, zoneid string) *ListVmsForImportParams
+	ListVmsForImportWithContext(ctx context.Context, p *ListVmsForImportParams) (*ListVmsForImportResponse, error)
+	ListVmsForImportAll(p *ListVmsForImportParams, opts ...PageOption) ([]*VmsForImport, error)
+	ListVmsForImportAllWithContext(ctx context.Context, p *ListVmsForImportParams, opts ...PageOption) ([]*VmsForImport, error)
+	ListVmsForImportIter(p *ListVmsForImportParams, opts ...PageOption) iter.Seq2[*VmsForImport, error]
+	ListVmsForImportIterWithContext(ctx context.Context, p *ListVmsForImportParams, opts ...PageOption) iter.Seq2[*VmsForImport, error]
+	NewListVmsForImportParams(host string, hypervisor HypervisorType, zoneid string) *ListVmsForImportParams
 	MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error)
+	MigrateVirtualMachineAsync(ctx context.Context, p *MigrateVirtualMachineParams) (*Job, error)
 	NewMigrateVirtualMachineParams(virtualmachineid string) *MigrateVirtualMachineParams
 	MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error)
+	MigrateVirtualMachineWithVolumeAsync(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*Job, error)
 	NewMigrateVirtualMachineWithVolumeParams(virtualmachineid string) *MigrateVirtualMachineWithVolumeParams
 	RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error)
+	RebootVirtualMachineAsync(ctx context.Context, p *RebootVirtualMachineParams) (*Job, error)
 	NewRebootVirtualMachineParams(id string) *RebootVirtualMachineParams
 	RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
+	RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error)
 	NewRecoverVirtualMachineParams(id string) *RecoverVirtualMachineParams
 	RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error)
+	RemoveNicFromVirtualMachineAsync(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*Job, error)
 	NewRemoveNicFromVirtualMachineParams(nicid string, virtualmachineid string) *RemoveNicFromVirtualMachineParams
 	ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error)
+	ResetPasswordForVirtualMachineAsync(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*Job, error)
 	NewResetPasswordForVirtualMachineParams(id string) *ResetPasswordForVirtualMachineParams
 	ResetUserDataForVirtualMachine(p *ResetUserDataForVirtualMachineParams) (*ResetUserDataForVirtualMachineResponse, error)
+	ResetUserDataForVirtualMachineWithContext(ctx context.Context, p *ResetUserDataForVirtualMachineParams) (*ResetUserDataForVirtualMachineResponse, error)
 	NewResetUserDataForVirtualMachineParams(id string) *ResetUserDataForVirtualMachineParams
 	RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error)
+	RestoreVirtualMachineAsync(ctx context.Context, p *RestoreVirtualMachineParams) (*Job, error)
 	NewRestoreVirtualMachineParams(virtualmachineid string) *RestoreVirtualMachineParams
 	ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error)
+	ScaleVirtualMachineAsync(ctx context.Context, p *ScaleVirtualMachineParams) (*Job, error)
 	NewScaleVirtualMachineParams(id string, serviceofferingid string) *ScaleVirtualMachineParams
 	StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error)
+	StartVirtualMachineAsync(ctx context.Context, p *StartVirtualMachineParams) (*Job, error)
 	NewStartVirtualMachineParams(id string) *StartVirtualMachineParams
 	StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error)
+	StopVirtualMachineAsync(ctx context.Context, p *StopVirtualMachineParams) (*Job, error)
 	NewStopVirtualMachineParams(id string) *StopVirtualMachineParams
 	UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error)
+	UpdateDefaultNicForVirtualMachineAsync(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*Job, error)
 	NewUpdateDefaultNicForVirtualMachineParams(nicid string, virtualmachineid string) *UpdateDefaultNicForVirtualMachineParams
 	UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
+	UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error)
 	NewUpdateVirtualMachineParams(id string) *UpdateVirtualMachineParams
 	ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error)
+	ListVirtualMachinesUsageHistoryWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error)
+	ListVirtualMachinesUsageHistoryAll(p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) ([]*VirtualMachinesUsageHistory, error)
+	ListVirtualMachinesUsageHistoryAllWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) ([]*VirtualMachinesUsageHistory, error)
+	ListVirtualMachinesUsageHistoryIter(p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VirtualMachinesUsageHistory, error]
+	ListVirtualMachinesUsageHistoryIterWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VirtualMachinesUsageHistory, error]
 	NewListVirtualMachinesUsageHistoryParams() *ListVirtualMachinesUsageHistoryParams
 	GetVirtualMachinesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetVirtualMachinesUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVirtualMachinesUsageHistoryByName(name string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error)
+	GetVirtualMachinesUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error)
 	GetVirtualMachinesUsageHistoryByID(id string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error)
+	GetVirtualMachinesUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error)
 	ImportVm(p *ImportVmParams) (*ImportVmResponse, error)
-	NewImportVmParams(clusterid string, hypervisor string, importsource string, name string, serviceofferingid string, zoneid string) *ImportVmParams
+	ImportVmWithContext(ctx context.Context, p *ImportVmParams) (*ImportVmResponse, error)
+	ImportVmAsync(ctx context.Context, p *ImportVmParams) (*Job, error)
+	NewImportVmParams(clusterid string, hypervisor HypervisorType, importsource string, name string, serviceofferingid string, zoneid string) *ImportVmParams
 	UnmanageVirtualMachine(p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error)
+	UnmanageVirtualMachineWithContext(ctx context.Context, p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error)
+	UnmanageVirtualMachineAsync(ctx context.Context, p *UnmanageVirtualMachineParams) (*Job, error)
 	NewUnmanageVirtualMachineParams(id string) *UnmanageVirtualMachineParams
 	ListUnmanagedInstances(p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error)
+	ListUnmanagedInstancesWithContext(ctx context.Context, p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error)
+	ListUnmanagedInstancesAll(p *ListUnmanagedInstancesParams, opts ...PageOption) ([]*UnmanagedInstance, error)
+	ListUnmanagedInstancesAllWithContext(ctx context.Context, p *ListUnmanagedInstancesParams, opts ...PageOption) ([]*UnmanagedInstance, error)
+	ListUnmanagedInstancesIter(p *ListUnmanagedInstancesParams, opts ...PageOption) iter.Seq2[*UnmanagedInstance, error]
+	ListUnmanagedInstancesIterWithContext(ctx context.Context, p *ListUnmanagedInstancesParams, opts ...PageOption) iter.Seq2[*UnmanagedInstance, error]
 	NewListUnmanagedInstancesParams(clusterid string) *ListUnmanagedInstancesParams
 	ImportUnmanagedInstance(p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error)
+	ImportUnmanagedInstanceWithContext(ctx context.Context, p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error)
+	ImportUnmanagedInstanceAsync(ctx context.Context, p *ImportUnmanagedInstanceParams) (*Job, error)
 	NewImportUnmanagedInstanceParams(clusterid string, name string, serviceofferingid string) *ImportUnmanagedInstanceParams
 	ListImportVmTasks(p *ListImportVmTasksParams) (*ListImportVmTasksResponse, error)
+	ListImportVmTasksWithContext(ctx context.Context, p *ListImportVmTasksParams) (*ListImportVmTasksResponse, error)
+	ListImportVmTasksAll(p *ListImportVmTasksParams, opts ...PageOption) ([]*ImportVmTask, error)
+	ListImportVmTasksAllWithContext(ctx context.Context, p *ListImportVmTasksParams, opts ...PageOption) ([]*ImportVmTask, error)
+	ListImportVmTasksIter(p *ListImportVmTasksParams, opts ...PageOption) iter.Seq2[*ImportVmTask, error]
+	ListImportVmTasksIterWithContext(ctx context.Context, p *ListImportVmTasksParams, opts ...PageOption) iter.Seq2[*ImportVmTask, error]
 	NewListImportVmTasksParams(zoneid string) *ListImportVmTasksParams
 	CreateVMSchedule(p *CreateVMScheduleParams) (*CreateVMScheduleResponse, error)
+	CreateVMScheduleWithContext(ctx context.Context, p *CreateVMScheduleParams) (*CreateVMScheduleResponse, error)
 	NewCreateVMScheduleParams(action string, schedule string, timezone string, virtualmachineid string) *CreateVMScheduleParams
 	UpdateVMSchedule(p *UpdateVMScheduleParams) (*UpdateVMScheduleResponse, error)
+	UpdateVMScheduleWithContext(ctx context.Context, p *UpdateVMScheduleParams) (*UpdateVMScheduleResponse, error)
 	NewUpdateVMScheduleParams(id string) *UpdateVMScheduleParams
 	ListVMSchedule(p *ListVMScheduleParams) (*ListVMScheduleResponse, error)
+	ListVMScheduleWithContext(ctx context.Context, p *ListVMScheduleParams) (*ListVMScheduleResponse, error)
+	ListVMScheduleAll(p *ListVMScheduleParams, opts ...PageOption) ([]*VMSchedule, error)
+	ListVMScheduleAllWithContext(ctx context.Context, p *ListVMScheduleParams, opts ...PageOption) ([]*VMSchedule, error)
+	ListVMScheduleIter(p *ListVMScheduleParams, opts ...PageOption) iter.Seq2[*VMSchedule, error]
+	ListVMScheduleIterWithContext(ctx context.Context, p *ListVMScheduleParams, opts ...PageOption) iter.Seq2[*VMSchedule, error]
 	NewListVMScheduleParams(virtualmachineid string) *ListVMScheduleParams
 	GetVMScheduleByID(id string, virtualmachineid string, opts ...OptionFunc) (*VMSchedule, int, error)
+	GetVMScheduleByIDWithContext(ctx context.Context, id string, virtualmachineid string, opts ...OptionFunc) (*VMSchedule, int, error)
 	DeleteVMSchedule(p *DeleteVMScheduleParams) (*DeleteVMScheduleResponse, error)
+	DeleteVMScheduleWithContext(ctx context.Context, p *DeleteVMScheduleParams) (*DeleteVMScheduleResponse, error)
 	NewDeleteVMScheduleParams(virtualmachineid string) *DeleteVMScheduleParams
 	AssignVirtualMachineToBackupOffering(p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error)
+	AssignVirtualMachineToBackupOfferingWithContext(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error)
+	AssignVirtualMachineToBackupOfferingAsync(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*Job, error)
 	NewAssignVirtualMachineToBackupOfferingParams(backupofferingid string, virtualmachineid string) *AssignVirtualMachineToBackupOfferingParams
 	RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error)
+	RemoveVirtualMachineFromBackupOfferingWithContext(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error)
+	RemoveVirtualMachineFromBackupOfferingAsync(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*Job, error)
 	NewRemoveVirtualMachineFromBackupOfferingParams(virtualmachineid string) *RemoveVirtualMachineFromBackupOfferingParams
 }
 
@@ -143,6 +239,48 @@ func (p *AddNicToVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addNicToVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddNicToVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addNicToVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addNicToVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddNicToVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"networkid", "virtualmachineid"},
+		lengths:  map[string]int{"ipaddress": 255, "macaddress": 255, "networkid": 255, "virtualmachineid": 255},
+	}.validate("addNicToVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addNicToVirtualMachine API, before the request is signed
+func (p *AddNicToVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddNicToVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddNicToVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addNicToVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"dhcpoptions":      decodeParam[map[string]string],
+		"ipaddress":        decodeParam[string],
+		"macaddress":       decodeParam[string],
+		"networkid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddNicToVirtualMachineParams) Clone() *AddNicToVirtualMachineParams {
+	return &AddNicToVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *AddNicToVirtualMachineParams) SetDhcpoptions(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -260,32 +398,39 @@ func (s *VirtualMachineService) NewAddNicToVirtualMachineParams(networkid string
 
 // Adds Instance to specified network by creating a NIC
 func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("addNicToVirtualMachine", p.toURLValues())
+	return s.AddNicToVirtualMachineWithContext(context.Background(), p)
+}
+
+// AddNicToVirtualMachineWithContext is like AddNicToVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "addNicToVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AddNicToVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("addNicToVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "addNicToVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("addNicToVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -293,6 +438,25 @@ func (s *VirtualMachineService) AddNicToVirtualMachine(p *AddNicToVirtualMachine
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AddNicToVirtualMachine async job into r
+func (r *AddNicToVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AddNicToVirtualMachineAsync starts the async job of AddNicToVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) AddNicToVirtualMachineAsync(ctx context.Context, p *AddNicToVirtualMachineParams) (*Job, error) {
+	r, err := s.AddNicToVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("addNicToVirtualMachine", r.JobID), nil
+}
+
 type AddNicToVirtualMachineResponse struct {
 	Account               string                                        `json:"account"`
 	Affinitygroup         []AddNicToVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -304,32 +468,32 @@ type AddNicToVirtualMachineResponse struct {
 	Backupofferingname    string                                        `json:"backupofferingname"`
 	Bootmode              string                                        `json:"bootmode"`
 	Boottype              string                                        `json:"boottype"`
-	Cpunumber             int                                           `json:"cpunumber"`
-	Cpuspeed              int                                           `json:"cpuspeed"`
+	Cpunumber             FlexInt                                       `json:"cpunumber"`
+	Cpuspeed              FlexInt                                       `json:"cpuspeed"`
 	Cpuused               string                                        `json:"cpuused"`
-	Created               string                                        `json:"created"`
-	Deleteprotection      bool                                          `json:"deleteprotection"`
+	Created               Time                                          `json:"created"`
+	Deleteprotection      FlexBool                                      `json:"deleteprotection"`
 	Details               map[string]string                             `json:"details"`
-	Diskioread            int64                                         `json:"diskioread"`
-	Diskiowrite           int64                                         `json:"diskiowrite"`
-	Diskkbsread           int64                                         `json:"diskkbsread"`
-	Diskkbswrite          int64                                         `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                     `json:"diskioread"`
+	Diskiowrite           FlexInt64                                     `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                     `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                     `json:"diskkbswrite"`
 	Diskofferingid        string                                        `json:"diskofferingid"`
 	Diskofferingname      string                                        `json:"diskofferingname"`
 	Displayname           string                                        `json:"displayname"`
-	Displayvm             bool                                          `json:"displayvm"`
+	Displayvm             FlexBool                                      `json:"displayvm"`
 	Domain                string                                        `json:"domain"`
 	Domainid              string                                        `json:"domainid"`
 	Domainpath            string                                        `json:"domainpath"`
-	Forvirtualnetwork     bool                                          `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                      `json:"forvirtualnetwork"`
 	Gpucardid             string                                        `json:"gpucardid"`
 	Gpucardname           string                                        `json:"gpucardname"`
-	Gpucount              int                                           `json:"gpucount"`
+	Gpucount              FlexInt                                       `json:"gpucount"`
 	Group                 string                                        `json:"group"`
 	Groupid               string                                        `json:"groupid"`
 	Guestosid             string                                        `json:"guestosid"`
-	Haenable              bool                                          `json:"haenable"`
-	Hasannotations        bool                                          `json:"hasannotations"`
+	Haenable              FlexBool                                      `json:"haenable"`
+	Hasannotations        FlexBool                                      `json:"hasannotations"`
 	Hostcontrolstate      string                                        `json:"hostcontrolstate"`
 	Hostid                string                                        `json:"hostid"`
 	Hostname              string                                        `json:"hostname"`
@@ -338,47 +502,47 @@ type AddNicToVirtualMachineResponse struct {
 	Id                    string                                        `json:"id"`
 	Instancename          string                                        `json:"instancename"`
 	Ipaddress             string                                        `json:"ipaddress"`
-	Isdynamicallyscalable bool                                          `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                      `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                        `json:"isodisplaytext"`
 	Isoid                 string                                        `json:"isoid"`
 	Isoname               string                                        `json:"isoname"`
 	JobID                 string                                        `json:"jobid"`
-	Jobstatus             int                                           `json:"jobstatus"`
+	Jobstatus             FlexInt                                       `json:"jobstatus"`
 	Keypairs              string                                        `json:"keypairs"`
-	Lastupdated           string                                        `json:"lastupdated"`
-	Leaseduration         int                                           `json:"leaseduration"`
+	Lastupdated           Time                                          `json:"lastupdated"`
+	Leaseduration         FlexInt                                       `json:"leaseduration"`
 	Leaseexpiryaction     string                                        `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                        `json:"leaseexpirydate"`
-	Maxheads              int64                                         `json:"maxheads"`
-	Maxresolutionx        int64                                         `json:"maxresolutionx"`
-	Maxresolutiony        int64                                         `json:"maxresolutiony"`
-	Memory                int                                           `json:"memory"`
-	Memoryintfreekbs      int64                                         `json:"memoryintfreekbs"`
-	Memorykbs             int64                                         `json:"memorykbs"`
-	Memorytargetkbs       int64                                         `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                          `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                     `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                     `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                     `json:"maxresolutiony"`
+	Memory                FlexInt                                       `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                     `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                     `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                     `json:"memorytargetkbs"`
 	Name                  string                                        `json:"name"`
-	Networkkbsread        int64                                         `json:"networkkbsread"`
-	Networkkbswrite       int64                                         `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                     `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                     `json:"networkkbswrite"`
 	Nic                   []Nic                                         `json:"nic"`
 	Osdisplayname         string                                        `json:"osdisplayname"`
 	Ostypeid              string                                        `json:"ostypeid"`
 	Password              string                                        `json:"password"`
-	Passwordenabled       bool                                          `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                      `json:"passwordenabled"`
 	Pooltype              string                                        `json:"pooltype"`
 	Project               string                                        `json:"project"`
 	Projectid             string                                        `json:"projectid"`
 	Publicip              string                                        `json:"publicip"`
 	Publicipid            string                                        `json:"publicipid"`
 	Readonlydetails       string                                        `json:"readonlydetails"`
-	Receivedbytes         int64                                         `json:"receivedbytes"`
-	Rootdeviceid          int64                                         `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                     `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                     `json:"rootdeviceid"`
 	Rootdevicetype        string                                        `json:"rootdevicetype"`
 	Securitygroup         []AddNicToVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                         `json:"sentbytes"`
+	Sentbytes             FlexInt64                                     `json:"sentbytes"`
 	Serviceofferingid     string                                        `json:"serviceofferingid"`
 	Serviceofferingname   string                                        `json:"serviceofferingname"`
 	Servicestate          string                                        `json:"servicestate"`
-	State                 string                                        `json:"state"`
+	State                 VirtualMachineState                           `json:"state"`
 	Tags                  []Tags                                        `json:"tags"`
 	Templatedisplaytext   string                                        `json:"templatedisplaytext"`
 	Templateformat        string                                        `json:"templateformat"`
@@ -395,12 +559,13 @@ type AddNicToVirtualMachineResponse struct {
 	Vgpu                  string                                        `json:"vgpu"`
 	Vgpuprofileid         string                                        `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                        `json:"vgpuprofilename"`
-	Videoram              int64                                         `json:"videoram"`
+	Videoram              FlexInt64                                     `json:"videoram"`
 	Vmtype                string                                        `json:"vmtype"`
 	Vnfdetails            map[string]string                             `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                     `json:"vnfnics"`
 	Zoneid                string                                        `json:"zoneid"`
 	Zonename              string                                        `json:"zonename"`
+	Extra                 map[string]json.RawMessage                    `json:"-"`
 }
 
 type AddNicToVirtualMachineResponseSecuritygroup struct {
@@ -416,36 +581,54 @@ type AddNicToVirtualMachineResponseSecuritygroup struct {
 	Project             string                                            `json:"project"`
 	Projectid           string                                            `json:"projectid"`
 	Tags                []Tags                                            `json:"tags"`
-	Virtualmachinecount int                                               `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                           `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                     `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                        `json:"-"`
+}
+
+func (r *AddNicToVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias AddNicToVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddNicToVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNicToVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias AddNicToVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddNicToVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddNicToVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias AddNicToVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *AddNicToVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -472,7 +655,7 @@ func (r *AddNicToVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias AddNicToVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssignVirtualMachineParams struct {
@@ -507,6 +690,49 @@ func (p *AssignVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the assignVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssignVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("assignVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the assignVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssignVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "projectid": 255, "virtualmachineid": 255},
+	}.validate("assignVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the assignVirtualMachine API, before the request is signed
+func (p *AssignVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssignVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssignVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("assignVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"domainid":         decodeParam[string],
+		"networkids":       decodeParam[[]string],
+		"projectid":        decodeParam[string],
+		"securitygroupids": decodeParam[[]string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssignVirtualMachineParams) Clone() *AssignVirtualMachineParams {
+	return &AssignVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *AssignVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -644,13 +870,22 @@ func (s *VirtualMachineService) NewAssignVirtualMachineParams(virtualmachineid s
 
 // Change ownership of an Instance from one account to another. This API is available for Basic zones with security groups and Advanced zones with guest networks. A root administrator can reassign an Instance from any account to any other account in any domain. A domain administrator can reassign an Instance to any account in the same domain.
 func (s *VirtualMachineService) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("assignVirtualMachine", p.toURLValues())
+	return s.AssignVirtualMachineWithContext(context.Background(), p)
+}
+
+// AssignVirtualMachineWithContext is like AssignVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "assignVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssignVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("assignVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -668,32 +903,32 @@ type AssignVirtualMachineResponse struct {
 	Backupofferingname    string                                      `json:"backupofferingname"`
 	Bootmode              string                                      `json:"bootmode"`
 	Boottype              string                                      `json:"boottype"`
-	Cpunumber             int                                         `json:"cpunumber"`
-	Cpuspeed              int                                         `json:"cpuspeed"`
+	Cpunumber             FlexInt                                     `json:"cpunumber"`
+	Cpuspeed              FlexInt                                     `json:"cpuspeed"`
 	Cpuused               string                                      `json:"cpuused"`
-	Created               string                                      `json:"created"`
-	Deleteprotection      bool                                        `json:"deleteprotection"`
+	Created               Time                                        `json:"created"`
+	Deleteprotection      FlexBool                                    `json:"deleteprotection"`
 	Details               map[string]string                           `json:"details"`
-	Diskioread            int64                                       `json:"diskioread"`
-	Diskiowrite           int64                                       `json:"diskiowrite"`
-	Diskkbsread           int64                                       `json:"diskkbsread"`
-	Diskkbswrite          int64                                       `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                   `json:"diskioread"`
+	Diskiowrite           FlexInt64                                   `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                   `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                   `json:"diskkbswrite"`
 	Diskofferingid        string                                      `json:"diskofferingid"`
 	Diskofferingname      string                                      `json:"diskofferingname"`
 	Displayname           string                                      `json:"displayname"`
-	Displayvm             bool                                        `json:"displayvm"`
+	Displayvm             FlexBool                                    `json:"displayvm"`
 	Domain                string                                      `json:"domain"`
 	Domainid              string                                      `json:"domainid"`
 	Domainpath            string                                      `json:"domainpath"`
-	Forvirtualnetwork     bool                                        `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                    `json:"forvirtualnetwork"`
 	Gpucardid             string                                      `json:"gpucardid"`
 	Gpucardname           string                                      `json:"gpucardname"`
-	Gpucount              int                                         `json:"gpucount"`
+	Gpucount              FlexInt                                     `json:"gpucount"`
 	Group                 string                                      `json:"group"`
 	Groupid               string                                      `json:"groupid"`
 	Guestosid             string                                      `json:"guestosid"`
-	Haenable              bool                                        `json:"haenable"`
-	Hasannotations        bool                                        `json:"hasannotations"`
+	Haenable              FlexBool                                    `json:"haenable"`
+	Hasannotations        FlexBool                                    `json:"hasannotations"`
 	Hostcontrolstate      string                                      `json:"hostcontrolstate"`
 	Hostid                string                                      `json:"hostid"`
 	Hostname              string                                      `json:"hostname"`
@@ -702,47 +937,47 @@ type AssignVirtualMachineResponse struct {
 	Id                    string                                      `json:"id"`
 	Instancename          string                                      `json:"instancename"`
 	Ipaddress             string                                      `json:"ipaddress"`
-	Isdynamicallyscalable bool                                        `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                    `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                      `json:"isodisplaytext"`
 	Isoid                 string                                      `json:"isoid"`
 	Isoname               string                                      `json:"isoname"`
 	JobID                 string                                      `json:"jobid"`
-	Jobstatus             int                                         `json:"jobstatus"`
+	Jobstatus             FlexInt                                     `json:"jobstatus"`
 	Keypairs              string                                      `json:"keypairs"`
-	Lastupdated           string                                      `json:"lastupdated"`
-	Leaseduration         int                                         `json:"leaseduration"`
+	Lastupdated           Time                                        `json:"lastupdated"`
+	Leaseduration         FlexInt                                     `json:"leaseduration"`
 	Leaseexpiryaction     string                                      `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                      `json:"leaseexpirydate"`
-	Maxheads              int64                                       `json:"maxheads"`
-	Maxresolutionx        int64                                       `json:"maxresolutionx"`
-	Maxresolutiony        int64                                       `json:"maxresolutiony"`
-	Memory                int                                         `json:"memory"`
-	Memoryintfreekbs      int64                                       `json:"memoryintfreekbs"`
-	Memorykbs             int64                                       `json:"memorykbs"`
-	Memorytargetkbs       int64                                       `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                        `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                   `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                   `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                   `json:"maxresolutiony"`
+	Memory                FlexInt                                     `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                   `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                   `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                   `json:"memorytargetkbs"`
 	Name                  string                                      `json:"name"`
-	Networkkbsread        int64                                       `json:"networkkbsread"`
-	Networkkbswrite       int64                                       `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                   `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                   `json:"networkkbswrite"`
 	Nic                   []Nic                                       `json:"nic"`
 	Osdisplayname         string                                      `json:"osdisplayname"`
 	Ostypeid              string                                      `json:"ostypeid"`
 	Password              string                                      `json:"password"`
-	Passwordenabled       bool                                        `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                    `json:"passwordenabled"`
 	Pooltype              string                                      `json:"pooltype"`
 	Project               string                                      `json:"project"`
 	Projectid             string                                      `json:"projectid"`
 	Publicip              string                                      `json:"publicip"`
 	Publicipid            string                                      `json:"publicipid"`
 	Readonlydetails       string                                      `json:"readonlydetails"`
-	Receivedbytes         int64                                       `json:"receivedbytes"`
-	Rootdeviceid          int64                                       `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                   `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                   `json:"rootdeviceid"`
 	Rootdevicetype        string                                      `json:"rootdevicetype"`
 	Securitygroup         []AssignVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                       `json:"sentbytes"`
+	Sentbytes             FlexInt64                                   `json:"sentbytes"`
 	Serviceofferingid     string                                      `json:"serviceofferingid"`
 	Serviceofferingname   string                                      `json:"serviceofferingname"`
 	Servicestate          string                                      `json:"servicestate"`
-	State                 string                                      `json:"state"`
+	State                 VirtualMachineState                         `json:"state"`
 	Tags                  []Tags                                      `json:"tags"`
 	Templatedisplaytext   string                                      `json:"templatedisplaytext"`
 	Templateformat        string                                      `json:"templateformat"`
@@ -759,12 +994,13 @@ type AssignVirtualMachineResponse struct {
 	Vgpu                  string                                      `json:"vgpu"`
 	Vgpuprofileid         string                                      `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                      `json:"vgpuprofilename"`
-	Videoram              int64                                       `json:"videoram"`
+	Videoram              FlexInt64                                   `json:"videoram"`
 	Vmtype                string                                      `json:"vmtype"`
 	Vnfdetails            map[string]string                           `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                   `json:"vnfnics"`
 	Zoneid                string                                      `json:"zoneid"`
 	Zonename              string                                      `json:"zonename"`
+	Extra                 map[string]json.RawMessage                  `json:"-"`
 }
 
 type AssignVirtualMachineResponseSecuritygroup struct {
@@ -780,36 +1016,54 @@ type AssignVirtualMachineResponseSecuritygroup struct {
 	Project             string                                          `json:"project"`
 	Projectid           string                                          `json:"projectid"`
 	Tags                []Tags                                          `json:"tags"`
-	Virtualmachinecount int                                             `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                         `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                   `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                      `json:"-"`
+}
+
+func (r *AssignVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias AssignVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssignVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssignVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias AssignVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssignVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssignVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias AssignVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *AssignVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -836,7 +1090,7 @@ func (r *AssignVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias AssignVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeServiceForVirtualMachineParams struct {
@@ -879,6 +1133,50 @@ func (p *ChangeServiceForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeServiceForVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeServiceForVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeServiceForVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeServiceForVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeServiceForVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "serviceofferingid"},
+		lengths:  map[string]int{"id": 255, "serviceofferingid": 255},
+	}.validate("changeServiceForVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeServiceForVirtualMachine API, before the request is signed
+func (p *ChangeServiceForVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeServiceForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeServiceForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeServiceForVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"automigrate":       decodeParam[bool],
+		"details":           decodeParam[map[string]string],
+		"id":                decodeParam[string],
+		"maxiops":           decodeParam[int64],
+		"miniops":           decodeParam[int64],
+		"serviceofferingid": decodeParam[string],
+		"shrinkok":          decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeServiceForVirtualMachineParams) Clone() *ChangeServiceForVirtualMachineParams {
+	return &ChangeServiceForVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeServiceForVirtualMachineParams) SetAutomigrate(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1038,13 +1336,22 @@ func (s *VirtualMachineService) NewChangeServiceForVirtualMachineParams(id strin
 
 // (This API is deprecated, use scaleVirtualMachine API)Changes the service offering for an Instance. The Instance must be in a "Stopped" state for this command to take effect.
 func (s *VirtualMachineService) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("changeServiceForVirtualMachine", p.toURLValues())
+	return s.ChangeServiceForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ChangeServiceForVirtualMachineWithContext is like ChangeServiceForVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "changeServiceForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeServiceForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeServiceForVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1062,32 +1369,32 @@ type ChangeServiceForVirtualMachineResponse struct {
 	Backupofferingname    string                                                `json:"backupofferingname"`
 	Bootmode              string                                                `json:"bootmode"`
 	Boottype              string                                                `json:"boottype"`
-	Cpunumber             int                                                   `json:"cpunumber"`
-	Cpuspeed              int                                                   `json:"cpuspeed"`
+	Cpunumber             FlexInt                                               `json:"cpunumber"`
+	Cpuspeed              FlexInt                                               `json:"cpuspeed"`
 	Cpuused               string                                                `json:"cpuused"`
-	Created               string                                                `json:"created"`
-	Deleteprotection      bool                                                  `json:"deleteprotection"`
+	Created               Time                                                  `json:"created"`
+	Deleteprotection      FlexBool                                              `json:"deleteprotection"`
 	Details               map[string]string                                     `json:"details"`
-	Diskioread            int64                                                 `json:"diskioread"`
-	Diskiowrite           int64                                                 `json:"diskiowrite"`
-	Diskkbsread           int64                                                 `json:"diskkbsread"`
-	Diskkbswrite          int64                                                 `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                             `json:"diskioread"`
+	Diskiowrite           FlexInt64                                             `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                             `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                             `json:"diskkbswrite"`
 	Diskofferingid        string                                                `json:"diskofferingid"`
 	Diskofferingname      string                                                `json:"diskofferingname"`
 	Displayname           string                                                `json:"displayname"`
-	Displayvm             bool                                                  `json:"displayvm"`
+	Displayvm             FlexBool                                              `json:"displayvm"`
 	Domain                string                                                `json:"domain"`
 	Domainid              string                                                `json:"domainid"`
 	Domainpath            string                                                `json:"domainpath"`
-	Forvirtualnetwork     bool                                                  `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                              `json:"forvirtualnetwork"`
 	Gpucardid             string                                                `json:"gpucardid"`
 	Gpucardname           string                                                `json:"gpucardname"`
-	Gpucount              int                                                   `json:"gpucount"`
+	Gpucount              FlexInt                                               `json:"gpucount"`
 	Group                 string                                                `json:"group"`
 	Groupid               string                                                `json:"groupid"`
 	Guestosid             string                                                `json:"guestosid"`
-	Haenable              bool                                                  `json:"haenable"`
-	Hasannotations        bool                                                  `json:"hasannotations"`
+	Haenable              FlexBool                                              `json:"haenable"`
+	Hasannotations        FlexBool                                              `json:"hasannotations"`
 	Hostcontrolstate      string                                                `json:"hostcontrolstate"`
 	Hostid                string                                                `json:"hostid"`
 	Hostname              string                                                `json:"hostname"`
@@ -1096,47 +1403,47 @@ type ChangeServiceForVirtualMachineResponse struct {
 	Id                    string                                                `json:"id"`
 	Instancename          string                                                `json:"instancename"`
 	Ipaddress             string                                                `json:"ipaddress"`
-	Isdynamicallyscalable bool                                                  `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                              `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                                `json:"isodisplaytext"`
 	Isoid                 string                                                `json:"isoid"`
 	Isoname               string                                                `json:"isoname"`
 	JobID                 string                                                `json:"jobid"`
-	Jobstatus             int                                                   `json:"jobstatus"`
+	Jobstatus             FlexInt                                               `json:"jobstatus"`
 	Keypairs              string                                                `json:"keypairs"`
-	Lastupdated           string                                                `json:"lastupdated"`
-	Leaseduration         int                                                   `json:"leaseduration"`
+	Lastupdated           Time                                                  `json:"lastupdated"`
+	Leaseduration         FlexInt                                               `json:"leaseduration"`
 	Leaseexpiryaction     string                                                `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                                `json:"leaseexpirydate"`
-	Maxheads              int64                                                 `json:"maxheads"`
-	Maxresolutionx        int64                                                 `json:"maxresolutionx"`
-	Maxresolutiony        int64                                                 `json:"maxresolutiony"`
-	Memory                int                                                   `json:"memory"`
-	Memoryintfreekbs      int64                                                 `json:"memoryintfreekbs"`
-	Memorykbs             int64                                                 `json:"memorykbs"`
-	Memorytargetkbs       int64                                                 `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                                  `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                             `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                             `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                             `json:"maxresolutiony"`
+	Memory                FlexInt                                               `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                             `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                             `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                             `json:"memorytargetkbs"`
 	Name                  string                                                `json:"name"`
-	Networkkbsread        int64                                                 `json:"networkkbsread"`
-	Networkkbswrite       int64                                                 `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                             `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                             `json:"networkkbswrite"`
 	Nic                   []Nic                                                 `json:"nic"`
 	Osdisplayname         string                                                `json:"osdisplayname"`
 	Ostypeid              string                                                `json:"ostypeid"`
 	Password              string                                                `json:"password"`
-	Passwordenabled       bool                                                  `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                              `json:"passwordenabled"`
 	Pooltype              string                                                `json:"pooltype"`
 	Project               string                                                `json:"project"`
 	Projectid             string                                                `json:"projectid"`
 	Publicip              string                                                `json:"publicip"`
 	Publicipid            string                                                `json:"publicipid"`
 	Readonlydetails       string                                                `json:"readonlydetails"`
-	Receivedbytes         int64                                                 `json:"receivedbytes"`
-	Rootdeviceid          int64                                                 `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                             `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                             `json:"rootdeviceid"`
 	Rootdevicetype        string                                                `json:"rootdevicetype"`
 	Securitygroup         []ChangeServiceForVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                                 `json:"sentbytes"`
+	Sentbytes             FlexInt64                                             `json:"sentbytes"`
 	Serviceofferingid     string                                                `json:"serviceofferingid"`
 	Serviceofferingname   string                                                `json:"serviceofferingname"`
 	Servicestate          string                                                `json:"servicestate"`
-	State                 string                                                `json:"state"`
+	State                 VirtualMachineState                                   `json:"state"`
 	Tags                  []Tags                                                `json:"tags"`
 	Templatedisplaytext   string                                                `json:"templatedisplaytext"`
 	Templateformat        string                                                `json:"templateformat"`
@@ -1153,12 +1460,13 @@ type ChangeServiceForVirtualMachineResponse struct {
 	Vgpu                  string                                                `json:"vgpu"`
 	Vgpuprofileid         string                                                `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                                `json:"vgpuprofilename"`
-	Videoram              int64                                                 `json:"videoram"`
+	Videoram              FlexInt64                                             `json:"videoram"`
 	Vmtype                string                                                `json:"vmtype"`
 	Vnfdetails            map[string]string                                     `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                             `json:"vnfnics"`
 	Zoneid                string                                                `json:"zoneid"`
 	Zonename              string                                                `json:"zonename"`
+	Extra                 map[string]json.RawMessage                            `json:"-"`
 }
 
 type ChangeServiceForVirtualMachineResponseSecuritygroup struct {
@@ -1174,36 +1482,54 @@ type ChangeServiceForVirtualMachineResponseSecuritygroup struct {
 	Project             string                                                    `json:"project"`
 	Projectid           string                                                    `json:"projectid"`
 	Tags                []Tags                                                    `json:"tags"`
-	Virtualmachinecount int                                                       `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                                   `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                             `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                                `json:"-"`
+}
+
+func (r *ChangeServiceForVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias ChangeServiceForVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeServiceForVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeServiceForVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias ChangeServiceForVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeServiceForVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeServiceForVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias ChangeServiceForVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *ChangeServiceForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -1230,7 +1556,7 @@ func (r *ChangeServiceForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ChangeServiceForVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CleanVMReservationsParams struct {
@@ -1245,6 +1571,39 @@ func (p *CleanVMReservationsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the cleanVMReservations API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CleanVMReservationsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("cleanVMReservations", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the cleanVMReservations API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CleanVMReservationsParams) Validate() error {
+	return paramRules{}.validate("cleanVMReservations", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the cleanVMReservations API, before the request is signed
+func (p *CleanVMReservationsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CleanVMReservationsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CleanVMReservationsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("cleanVMReservations", b, &p.p, nil)
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CleanVMReservationsParams) Clone() *CleanVMReservationsParams {
+	return &CleanVMReservationsParams{p: cloneParams(p.p)}
+}
+
 // You should always use this function to get a new CleanVMReservationsParams instance,
 // as then you are sure you have configured all required params
 func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservationsParams {
@@ -1255,27 +1614,39 @@ func (s *VirtualMachineService) NewCleanVMReservationsParams() *CleanVMReservati
 
 // Cleanups Instance reservations in the database.
 func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
-	resp, err := s.cs.newPostRequest("cleanVMReservations", p.toURLValues())
+	return s.CleanVMReservationsWithContext(context.Background(), p)
+}
+
+// CleanVMReservationsWithContext is like CleanVMReservations, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "cleanVMReservations", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CleanVMReservationsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("cleanVMReservations", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "cleanVMReservations", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("cleanVMReservations", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1283,11 +1654,31 @@ func (s *VirtualMachineService) CleanVMReservations(p *CleanVMReservationsParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CleanVMReservations async job into r
+func (r *CleanVMReservationsResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// CleanVMReservationsAsync starts the async job of CleanVMReservations without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) CleanVMReservationsAsync(ctx context.Context, p *CleanVMReservationsParams) (*Job, error) {
+	r, err := s.CleanVMReservationsWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("cleanVMReservations", r.JobID), nil
+}
+
 type CleanVMReservationsResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CleanVMReservationsResponse) UnmarshalJSON(b []byte) error {
+	type alias CleanVMReservationsResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeployVirtualMachineParams struct {
@@ -1396,7 +1787,7 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["iodriverpolicy"]; found {
 		u.Set("iodriverpolicy", v.(string))
@@ -1531,6 +1922,101 @@ func (p *DeployVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deployVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeployVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deployVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deployVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeployVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"serviceofferingid", "templateid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "bootmode": 255, "boottype": 255, "clusterid": 255, "customid": 255, "deploymentplanner": 255, "diskofferingid": 255, "displayname": 255, "domainid": 255, "extraconfig": 255, "group": 255, "hostid": 255, "hypervisor": 255, "iodriverpolicy": 255, "ip6address": 255, "ipaddress": 255, "keyboard": 255, "keypair": 255, "leaseexpiryaction": 255, "macaddress": 255, "name": 255, "overridediskofferingid": 255, "password": 255, "podid": 255, "projectid": 255, "serviceofferingid": 255, "snapshotid": 255, "templateid": 255, "userdata": 1048576, "userdataid": 255, "volumeid": 255, "zoneid": 255},
+	}.validate("deployVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deployVirtualMachine API, before the request is signed
+func (p *DeployVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeployVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeployVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deployVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"account":                    decodeParam[string],
+		"affinitygroupids":           decodeParam[[]string],
+		"affinitygroupnames":         decodeParam[[]string],
+		"bootintosetup":              decodeParam[bool],
+		"bootmode":                   decodeParam[string],
+		"boottype":                   decodeParam[string],
+		"clusterid":                  decodeParam[string],
+		"copyimagetags":              decodeParam[bool],
+		"customid":                   decodeParam[string],
+		"datadiskofferinglist":       decodeParam[map[string]string],
+		"datadisksdetails":           decodeParam[[]map[string]string],
+		"deploymentplanner":          decodeParam[string],
+		"details":                    decodeParam[map[string]string],
+		"dhcpoptionsnetworklist":     decodeParam[[]map[string]string],
+		"diskofferingid":             decodeParam[string],
+		"displayname":                decodeParam[string],
+		"displayvm":                  decodeParam[bool],
+		"domainid":                   decodeParam[string],
+		"dynamicscalingenabled":      decodeParam[bool],
+		"externaldetails":            decodeParam[map[string]string],
+		"extraconfig":                decodeParam[string],
+		"group":                      decodeParam[string],
+		"hostid":                     decodeParam[string],
+		"hypervisor":                 decodeParam[HypervisorType],
+		"iodriverpolicy":             decodeParam[string],
+		"iothreadsenabled":           decodeParam[bool],
+		"ip6address":                 decodeParam[string],
+		"ipaddress":                  decodeParam[string],
+		"iptonetworklist":            decodeParam[[]map[string]string],
+		"keyboard":                   decodeParam[string],
+		"keypair":                    decodeParam[string],
+		"keypairs":                   decodeParam[[]string],
+		"leaseduration":              decodeParam[int],
+		"leaseexpiryaction":          decodeParam[string],
+		"macaddress":                 decodeParam[string],
+		"name":                       decodeParam[string],
+		"networkids":                 decodeParam[[]string],
+		"nicmultiqueuenumber":        decodeParam[int],
+		"nicnetworklist":             decodeParam[[]map[string]string],
+		"nicpackedvirtqueuesenabled": decodeParam[bool],
+		"overridediskofferingid":     decodeParam[string],
+		"password":                   decodeParam[string],
+		"podid":                      decodeParam[string],
+		"projectid":                  decodeParam[string],
+		"properties":                 decodeParam[map[string]string],
+		"rootdisksize":               decodeParam[int64],
+		"securitygroupids":           decodeParam[[]string],
+		"securitygroupnames":         decodeParam[[]string],
+		"serviceofferingid":          decodeParam[string],
+		"size":                       decodeParam[int64],
+		"snapshotid":                 decodeParam[string],
+		"startvm":                    decodeParam[bool],
+		"templateid":                 decodeParam[string],
+		"userdata":                   decodeParam[string],
+		"userdatadetails":            decodeParam[map[string]string],
+		"userdataid":                 decodeParam[string],
+		"volumeid":                   decodeParam[string],
+		"zoneid":                     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeployVirtualMachineParams) Clone() *DeployVirtualMachineParams {
+	return &DeployVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *DeployVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2042,7 +2528,7 @@ func (p *DeployVirtualMachineParams) GetHostid() (string, bool) {
 	return value, ok
 }
 
-func (p *DeployVirtualMachineParams) SetHypervisor(v string) {
+func (p *DeployVirtualMachineParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -2055,11 +2541,11 @@ func (p *DeployVirtualMachineParams) ResetHypervisor() {
 	}
 }
 
-func (p *DeployVirtualMachineParams) GetHypervisor() (string, bool) {
+func (p *DeployVirtualMachineParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -2818,32 +3304,39 @@ func (s *VirtualMachineService) NewDeployVirtualMachineParams(serviceofferingid
 
 // Creates and automatically starts  an Instance based on a service offering, disk offering, and Template.
 func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("deployVirtualMachine", p.toURLValues())
+	return s.DeployVirtualMachineWithContext(context.Background(), p)
+}
+
+// DeployVirtualMachineWithContext is like DeployVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deployVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeployVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deployVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deployVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deployVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2851,6 +3344,25 @@ func (s *VirtualMachineService) DeployVirtualMachine(p *DeployVirtualMachinePara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeployVirtualMachine async job into r
+func (r *DeployVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeployVirtualMachineAsync starts the async job of DeployVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) DeployVirtualMachineAsync(ctx context.Context, p *DeployVirtualMachineParams) (*Job, error) {
+	r, err := s.DeployVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deployVirtualMachine", r.JobID), nil
+}
+
 type DeployVirtualMachineResponse struct {
 	Account               string                                      `json:"account"`
 	Affinitygroup         []DeployVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -2862,32 +3374,32 @@ type DeployVirtualMachineResponse struct {
 	Backupofferingname    string                                      `json:"backupofferingname"`
 	Bootmode              string                                      `json:"bootmode"`
 	Boottype              string                                      `json:"boottype"`
-	Cpunumber             int                                         `json:"cpunumber"`
-	Cpuspeed              int                                         `json:"cpuspeed"`
+	Cpunumber             FlexInt                                     `json:"cpunumber"`
+	Cpuspeed              FlexInt                                     `json:"cpuspeed"`
 	Cpuused               string                                      `json:"cpuused"`
-	Created               string                                      `json:"created"`
-	Deleteprotection      bool                                        `json:"deleteprotection"`
+	Created               Time                                        `json:"created"`
+	Deleteprotection      FlexBool                                    `json:"deleteprotection"`
 	Details               map[string]string                           `json:"details"`
-	Diskioread            int64                                       `json:"diskioread"`
-	Diskiowrite           int64                                       `json:"diskiowrite"`
-	Diskkbsread           int64                                       `json:"diskkbsread"`
-	Diskkbswrite          int64                                       `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                   `json:"diskioread"`
+	Diskiowrite           FlexInt64                                   `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                   `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                   `json:"diskkbswrite"`
 	Diskofferingid        string                                      `json:"diskofferingid"`
 	Diskofferingname      string                                      `json:"diskofferingname"`
 	Displayname           string                                      `json:"displayname"`
-	Displayvm             bool                                        `json:"displayvm"`
+	Displayvm             FlexBool                                    `json:"displayvm"`
 	Domain                string                                      `json:"domain"`
 	Domainid              string                                      `json:"domainid"`
 	Domainpath            string                                      `json:"domainpath"`
-	Forvirtualnetwork     bool                                        `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                    `json:"forvirtualnetwork"`
 	Gpucardid             string                                      `json:"gpucardid"`
 	Gpucardname           string                                      `json:"gpucardname"`
-	Gpucount              int                                         `json:"gpucount"`
+	Gpucount              FlexInt                                     `json:"gpucount"`
 	Group                 string                                      `json:"group"`
 	Groupid               string                                      `json:"groupid"`
 	Guestosid             string                                      `json:"guestosid"`
-	Haenable              bool                                        `json:"haenable"`
-	Hasannotations        bool                                        `json:"hasannotations"`
+	Haenable              FlexBool                                    `json:"haenable"`
+	Hasannotations        FlexBool                                    `json:"hasannotations"`
 	Hostcontrolstate      string                                      `json:"hostcontrolstate"`
 	Hostid                string                                      `json:"hostid"`
 	Hostname              string                                      `json:"hostname"`
@@ -2896,47 +3408,47 @@ type DeployVirtualMachineResponse struct {
 	Id                    string                                      `json:"id"`
 	Instancename          string                                      `json:"instancename"`
 	Ipaddress             string                                      `json:"ipaddress"`
-	Isdynamicallyscalable bool                                        `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                    `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                      `json:"isodisplaytext"`
 	Isoid                 string                                      `json:"isoid"`
 	Isoname               string                                      `json:"isoname"`
 	JobID                 string                                      `json:"jobid"`
-	Jobstatus             int                                         `json:"jobstatus"`
+	Jobstatus             FlexInt                                     `json:"jobstatus"`
 	Keypairs              string                                      `json:"keypairs"`
-	Lastupdated           string                                      `json:"lastupdated"`
-	Leaseduration         int                                         `json:"leaseduration"`
+	Lastupdated           Time                                        `json:"lastupdated"`
+	Leaseduration         FlexInt                                     `json:"leaseduration"`
 	Leaseexpiryaction     string                                      `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                      `json:"leaseexpirydate"`
-	Maxheads              int64                                       `json:"maxheads"`
-	Maxresolutionx        int64                                       `json:"maxresolutionx"`
-	Maxresolutiony        int64                                       `json:"maxresolutiony"`
-	Memory                int                                         `json:"memory"`
-	Memoryintfreekbs      int64                                       `json:"memoryintfreekbs"`
-	Memorykbs             int64                                       `json:"memorykbs"`
-	Memorytargetkbs       int64                                       `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                        `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                   `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                   `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                   `json:"maxresolutiony"`
+	Memory                FlexInt                                     `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                   `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                   `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                   `json:"memorytargetkbs"`
 	Name                  string                                      `json:"name"`
-	Networkkbsread        int64                                       `json:"networkkbsread"`
-	Networkkbswrite       int64                                       `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                   `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                   `json:"networkkbswrite"`
 	Nic                   []Nic                                       `json:"nic"`
 	Osdisplayname         string                                      `json:"osdisplayname"`
 	Ostypeid              string                                      `json:"ostypeid"`
 	Password              string                                      `json:"password"`
-	Passwordenabled       bool                                        `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                    `json:"passwordenabled"`
 	Pooltype              string                                      `json:"pooltype"`
 	Project               string                                      `json:"project"`
 	Projectid             string                                      `json:"projectid"`
 	Publicip              string                                      `json:"publicip"`
 	Publicipid            string                                      `json:"publicipid"`
 	Readonlydetails       string                                      `json:"readonlydetails"`
-	Receivedbytes         int64                                       `json:"receivedbytes"`
-	Rootdeviceid          int64                                       `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                   `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                   `json:"rootdeviceid"`
 	Rootdevicetype        string                                      `json:"rootdevicetype"`
 	Securitygroup         []DeployVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                       `json:"sentbytes"`
+	Sentbytes             FlexInt64                                   `json:"sentbytes"`
 	Serviceofferingid     string                                      `json:"serviceofferingid"`
 	Serviceofferingname   string                                      `json:"serviceofferingname"`
 	Servicestate          string                                      `json:"servicestate"`
-	State                 string                                      `json:"state"`
+	State                 VirtualMachineState                         `json:"state"`
 	Tags                  []Tags                                      `json:"tags"`
 	Templatedisplaytext   string                                      `json:"templatedisplaytext"`
 	Templateformat        string                                      `json:"templateformat"`
@@ -2953,12 +3465,13 @@ type DeployVirtualMachineResponse struct {
 	Vgpu                  string                                      `json:"vgpu"`
 	Vgpuprofileid         string                                      `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                      `json:"vgpuprofilename"`
-	Videoram              int64                                       `json:"videoram"`
+	Videoram              FlexInt64                                   `json:"videoram"`
 	Vmtype                string                                      `json:"vmtype"`
 	Vnfdetails            map[string]string                           `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                   `json:"vnfnics"`
 	Zoneid                string                                      `json:"zoneid"`
 	Zonename              string                                      `json:"zonename"`
+	Extra                 map[string]json.RawMessage                  `json:"-"`
 }
 
 type DeployVirtualMachineResponseSecuritygroup struct {
@@ -2974,36 +3487,54 @@ type DeployVirtualMachineResponseSecuritygroup struct {
 	Project             string                                          `json:"project"`
 	Projectid           string                                          `json:"projectid"`
 	Tags                []Tags                                          `json:"tags"`
-	Virtualmachinecount int                                             `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                         `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                   `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                      `json:"-"`
+}
+
+func (r *DeployVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias DeployVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeployVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeployVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias DeployVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeployVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeployVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias DeployVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *DeployVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -3030,7 +3561,7 @@ func (r *DeployVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeployVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroyVirtualMachineParams struct {
@@ -3056,6 +3587,46 @@ func (p *DestroyVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the destroyVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DestroyVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("destroyVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the destroyVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DestroyVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("destroyVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the destroyVirtualMachine API, before the request is signed
+func (p *DestroyVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DestroyVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DestroyVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("destroyVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"expunge":   decodeParam[bool],
+		"id":        decodeParam[string],
+		"volumeids": decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DestroyVirtualMachineParams) Clone() *DestroyVirtualMachineParams {
+	return &DestroyVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *DestroyVirtualMachineParams) SetExpunge(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3130,32 +3701,39 @@ func (s *VirtualMachineService) NewDestroyVirtualMachineParams(id string) *Destr
 
 // Destroys  an Instance. Once destroyed, only the administrator can recover it.
 func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("destroyVirtualMachine", p.toURLValues())
+	return s.DestroyVirtualMachineWithContext(context.Background(), p)
+}
+
+// DestroyVirtualMachineWithContext is like DestroyVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "destroyVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DestroyVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("destroyVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "destroyVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("destroyVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3163,6 +3741,25 @@ func (s *VirtualMachineService) DestroyVirtualMachine(p *DestroyVirtualMachinePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DestroyVirtualMachine async job into r
+func (r *DestroyVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DestroyVirtualMachineAsync starts the async job of DestroyVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) DestroyVirtualMachineAsync(ctx context.Context, p *DestroyVirtualMachineParams) (*Job, error) {
+	r, err := s.DestroyVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("destroyVirtualMachine", r.JobID), nil
+}
+
 type DestroyVirtualMachineResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []DestroyVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -3174,32 +3771,32 @@ type DestroyVirtualMachineResponse struct {
 	Backupofferingname    string                                       `json:"backupofferingname"`
 	Bootmode              string                                       `json:"bootmode"`
 	Boottype              string                                       `json:"boottype"`
-	Cpunumber             int                                          `json:"cpunumber"`
-	Cpuspeed              int                                          `json:"cpuspeed"`
+	Cpunumber             FlexInt                                      `json:"cpunumber"`
+	Cpuspeed              FlexInt                                      `json:"cpuspeed"`
 	Cpuused               string                                       `json:"cpuused"`
-	Created               string                                       `json:"created"`
-	Deleteprotection      bool                                         `json:"deleteprotection"`
+	Created               Time                                         `json:"created"`
+	Deleteprotection      FlexBool                                     `json:"deleteprotection"`
 	Details               map[string]string                            `json:"details"`
-	Diskioread            int64                                        `json:"diskioread"`
-	Diskiowrite           int64                                        `json:"diskiowrite"`
-	Diskkbsread           int64                                        `json:"diskkbsread"`
-	Diskkbswrite          int64                                        `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                    `json:"diskioread"`
+	Diskiowrite           FlexInt64                                    `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                    `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                    `json:"diskkbswrite"`
 	Diskofferingid        string                                       `json:"diskofferingid"`
 	Diskofferingname      string                                       `json:"diskofferingname"`
 	Displayname           string                                       `json:"displayname"`
-	Displayvm             bool                                         `json:"displayvm"`
+	Displayvm             FlexBool                                     `json:"displayvm"`
 	Domain                string                                       `json:"domain"`
 	Domainid              string                                       `json:"domainid"`
 	Domainpath            string                                       `json:"domainpath"`
-	Forvirtualnetwork     bool                                         `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                     `json:"forvirtualnetwork"`
 	Gpucardid             string                                       `json:"gpucardid"`
 	Gpucardname           string                                       `json:"gpucardname"`
-	Gpucount              int                                          `json:"gpucount"`
+	Gpucount              FlexInt                                      `json:"gpucount"`
 	Group                 string                                       `json:"group"`
 	Groupid               string                                       `json:"groupid"`
 	Guestosid             string                                       `json:"guestosid"`
-	Haenable              bool                                         `json:"haenable"`
-	Hasannotations        bool                                         `json:"hasannotations"`
+	Haenable              FlexBool                                     `json:"haenable"`
+	Hasannotations        FlexBool                                     `json:"hasannotations"`
 	Hostcontrolstate      string                                       `json:"hostcontrolstate"`
 	Hostid                string                                       `json:"hostid"`
 	Hostname              string                                       `json:"hostname"`
@@ -3208,47 +3805,47 @@ type DestroyVirtualMachineResponse struct {
 	Id                    string                                       `json:"id"`
 	Instancename          string                                       `json:"instancename"`
 	Ipaddress             string                                       `json:"ipaddress"`
-	Isdynamicallyscalable bool                                         `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                     `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                       `json:"isodisplaytext"`
 	Isoid                 string                                       `json:"isoid"`
 	Isoname               string                                       `json:"isoname"`
 	JobID                 string                                       `json:"jobid"`
-	Jobstatus             int                                          `json:"jobstatus"`
+	Jobstatus             FlexInt                                      `json:"jobstatus"`
 	Keypairs              string                                       `json:"keypairs"`
-	Lastupdated           string                                       `json:"lastupdated"`
-	Leaseduration         int                                          `json:"leaseduration"`
+	Lastupdated           Time                                         `json:"lastupdated"`
+	Leaseduration         FlexInt                                      `json:"leaseduration"`
 	Leaseexpiryaction     string                                       `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                       `json:"leaseexpirydate"`
-	Maxheads              int64                                        `json:"maxheads"`
-	Maxresolutionx        int64                                        `json:"maxresolutionx"`
-	Maxresolutiony        int64                                        `json:"maxresolutiony"`
-	Memory                int                                          `json:"memory"`
-	Memoryintfreekbs      int64                                        `json:"memoryintfreekbs"`
-	Memorykbs             int64                                        `json:"memorykbs"`
-	Memorytargetkbs       int64                                        `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                         `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                    `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                    `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                    `json:"maxresolutiony"`
+	Memory                FlexInt                                      `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                    `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                    `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                    `json:"memorytargetkbs"`
 	Name                  string                                       `json:"name"`
-	Networkkbsread        int64                                        `json:"networkkbsread"`
-	Networkkbswrite       int64                                        `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                    `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                    `json:"networkkbswrite"`
 	Nic                   []Nic                                        `json:"nic"`
 	Osdisplayname         string                                       `json:"osdisplayname"`
 	Ostypeid              string                                       `json:"ostypeid"`
 	Password              string                                       `json:"password"`
-	Passwordenabled       bool                                         `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                     `json:"passwordenabled"`
 	Pooltype              string                                       `json:"pooltype"`
 	Project               string                                       `json:"project"`
 	Projectid             string                                       `json:"projectid"`
 	Publicip              string                                       `json:"publicip"`
 	Publicipid            string                                       `json:"publicipid"`
 	Readonlydetails       string                                       `json:"readonlydetails"`
-	Receivedbytes         int64                                        `json:"receivedbytes"`
-	Rootdeviceid          int64                                        `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                    `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                    `json:"rootdeviceid"`
 	Rootdevicetype        string                                       `json:"rootdevicetype"`
 	Securitygroup         []DestroyVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                        `json:"sentbytes"`
+	Sentbytes             FlexInt64                                    `json:"sentbytes"`
 	Serviceofferingid     string                                       `json:"serviceofferingid"`
 	Serviceofferingname   string                                       `json:"serviceofferingname"`
 	Servicestate          string                                       `json:"servicestate"`
-	State                 string                                       `json:"state"`
+	State                 VirtualMachineState                          `json:"state"`
 	Tags                  []Tags                                       `json:"tags"`
 	Templatedisplaytext   string                                       `json:"templatedisplaytext"`
 	Templateformat        string                                       `json:"templateformat"`
@@ -3265,12 +3862,13 @@ type DestroyVirtualMachineResponse struct {
 	Vgpu                  string                                       `json:"vgpu"`
 	Vgpuprofileid         string                                       `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                       `json:"vgpuprofilename"`
-	Videoram              int64                                        `json:"videoram"`
+	Videoram              FlexInt64                                    `json:"videoram"`
 	Vmtype                string                                       `json:"vmtype"`
 	Vnfdetails            map[string]string                            `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                    `json:"vnfnics"`
 	Zoneid                string                                       `json:"zoneid"`
 	Zonename              string                                       `json:"zonename"`
+	Extra                 map[string]json.RawMessage                   `json:"-"`
 }
 
 type DestroyVirtualMachineResponseSecuritygroup struct {
@@ -3286,36 +3884,54 @@ type DestroyVirtualMachineResponseSecuritygroup struct {
 	Project             string                                           `json:"project"`
 	Projectid           string                                           `json:"projectid"`
 	Tags                []Tags                                           `json:"tags"`
-	Virtualmachinecount int                                              `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                          `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                    `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *DestroyVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias DestroyVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroyVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DestroyVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias DestroyVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroyVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DestroyVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias DestroyVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *DestroyVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -3342,7 +3958,7 @@ func (r *DestroyVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DestroyVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExpungeVirtualMachineParams struct {
@@ -3360,6 +3976,44 @@ func (p *ExpungeVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the expungeVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExpungeVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("expungeVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the expungeVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExpungeVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("expungeVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the expungeVirtualMachine API, before the request is signed
+func (p *ExpungeVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExpungeVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExpungeVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("expungeVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExpungeVirtualMachineParams) Clone() *ExpungeVirtualMachineParams {
+	return &ExpungeVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *ExpungeVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3392,27 +4046,39 @@ func (s *VirtualMachineService) NewExpungeVirtualMachineParams(id string) *Expun
 
 // Expunge  an Instance. Once expunged, it cannot be recovered.
 func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("expungeVirtualMachine", p.toURLValues())
+	return s.ExpungeVirtualMachineWithContext(context.Background(), p)
+}
+
+// ExpungeVirtualMachineWithContext is like ExpungeVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "expungeVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExpungeVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("expungeVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "expungeVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("expungeVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3420,11 +4086,31 @@ func (s *VirtualMachineService) ExpungeVirtualMachine(p *ExpungeVirtualMachinePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ExpungeVirtualMachine async job into r
+func (r *ExpungeVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ExpungeVirtualMachineAsync starts the async job of ExpungeVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) ExpungeVirtualMachineAsync(ctx context.Context, p *ExpungeVirtualMachineParams) (*Job, error) {
+	r, err := s.ExpungeVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("expungeVirtualMachine", r.JobID), nil
+}
+
 type ExpungeVirtualMachineResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExpungeVirtualMachineResponse) UnmarshalJSON(b []byte) error {
+	type alias ExpungeVirtualMachineResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetVMPasswordParams struct {
@@ -3442,6 +4128,44 @@ func (p *GetVMPasswordParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getVMPassword API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetVMPasswordParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getVMPassword", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getVMPassword API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetVMPasswordParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("getVMPassword", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getVMPassword API, before the request is signed
+func (p *GetVMPasswordParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetVMPasswordParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetVMPasswordParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getVMPassword", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetVMPasswordParams) Clone() *GetVMPasswordParams {
+	return &GetVMPasswordParams{p: cloneParams(p.p)}
+}
+
 func (p *GetVMPasswordParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3474,13 +4198,22 @@ func (s *VirtualMachineService) NewGetVMPasswordParams(id string) *GetVMPassword
 
 // Returns an encrypted password for the Instance
 func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
-	resp, err := s.cs.newRequest("getVMPassword", p.toURLValues())
+	return s.GetVMPasswordWithContext(context.Background(), p)
+}
+
+// GetVMPasswordWithContext is like GetVMPassword, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getVMPassword", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetVMPasswordResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getVMPassword", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3488,9 +4221,15 @@ func (s *VirtualMachineService) GetVMPassword(p *GetVMPasswordParams) (*GetVMPas
 }
 
 type GetVMPasswordResponse struct {
-	Encryptedpassword string `json:"encryptedpassword"`
-	JobID             string `json:"jobid"`
-	Jobstatus         int    `json:"jobstatus"`
+	Encryptedpassword string                     `json:"encryptedpassword"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetVMPasswordResponse) UnmarshalJSON(b []byte) error {
+	type alias GetVMPasswordResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVirtualMachinesParams struct {
@@ -3557,7 +4296,7 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -3660,6 +4399,87 @@ func (p *ListVirtualMachinesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVirtualMachines API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVirtualMachinesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVirtualMachines", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVirtualMachines API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVirtualMachinesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "affinitygroupid": 255, "arch": 255, "autoscalevmgroupid": 255, "backupofferingid": 255, "clusterid": 255, "domainid": 255, "extensionid": 255, "groupid": 255, "hostid": 255, "hypervisor": 255, "id": 255, "isoid": 255, "keypair": 255, "keyword": 255, "name": 255, "networkid": 255, "podid": 255, "projectid": 255, "securitygroupid": 255, "serviceofferingid": 255, "state": 255, "storageid": 255, "templateid": 255, "userdataid": 255, "userid": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listVirtualMachines", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVirtualMachines API, before the request is signed
+func (p *ListVirtualMachinesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVirtualMachinesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVirtualMachinesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVirtualMachines", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"accumulate":                decodeParam[bool],
+		"affinitygroupid":           decodeParam[string],
+		"arch":                      decodeParam[string],
+		"autoscalevmgroupid":        decodeParam[string],
+		"backupofferingid":          decodeParam[string],
+		"clusterid":                 decodeParam[string],
+		"details":                   decodeParam[[]string],
+		"displayvm":                 decodeParam[bool],
+		"domainid":                  decodeParam[string],
+		"extensionid":               decodeParam[string],
+		"forvirtualnetwork":         decodeParam[bool],
+		"gpuenabled":                decodeParam[bool],
+		"groupid":                   decodeParam[string],
+		"haenable":                  decodeParam[bool],
+		"hostid":                    decodeParam[string],
+		"hypervisor":                decodeParam[HypervisorType],
+		"id":                        decodeParam[string],
+		"ids":                       decodeParam[[]string],
+		"isoid":                     decodeParam[string],
+		"isrecursive":               decodeParam[bool],
+		"isvnf":                     decodeParam[bool],
+		"keypair":                   decodeParam[string],
+		"keyword":                   decodeParam[string],
+		"leased":                    decodeParam[bool],
+		"listall":                   decodeParam[bool],
+		"name":                      decodeParam[string],
+		"networkid":                 decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"podid":                     decodeParam[string],
+		"projectid":                 decodeParam[string],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"securitygroupid":           decodeParam[string],
+		"serviceofferingid":         decodeParam[string],
+		"showicon":                  decodeParam[bool],
+		"state":                     decodeParam[string],
+		"storageid":                 decodeParam[string],
+		"tags":                      decodeParam[map[string]string],
+		"templateid":                decodeParam[string],
+		"userdata":                  decodeParam[bool],
+		"userdataid":                decodeParam[string],
+		"userid":                    decodeParam[string],
+		"vpcid":                     decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVirtualMachinesParams) Clone() *ListVirtualMachinesParams {
+	return &ListVirtualMachinesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVirtualMachinesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3996,7 +4816,7 @@ func (p *ListVirtualMachinesParams) GetHostid() (string, bool) {
 	return value, ok
 }
 
-func (p *ListVirtualMachinesParams) SetHypervisor(v string) {
+func (p *ListVirtualMachinesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -4009,11 +4829,11 @@ func (p *ListVirtualMachinesParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListVirtualMachinesParams) GetHypervisor() (string, bool) {
+func (p *ListVirtualMachinesParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -4615,6 +5435,11 @@ func (s *VirtualMachineService) NewListVirtualMachinesParams() *ListVirtualMachi
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVirtualMachineIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachineIDWithContext is like GetVirtualMachineID, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachineIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesParams{}
 	p.p = make(map[string]interface{})
 
@@ -4626,7 +5451,7 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListVirtualMachines(p)
+	l, err := s.ListVirtualMachinesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4651,12 +5476,17 @@ func (s *VirtualMachineService) GetVirtualMachineID(name string, opts ...OptionF
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
-	id, count, err := s.GetVirtualMachineID(name, opts...)
+	return s.GetVirtualMachineByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachineByNameWithContext is like GetVirtualMachineByName, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachineByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	id, count, err := s.GetVirtualMachineIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVirtualMachineByID(id, opts...)
+	r, count, err := s.GetVirtualMachineByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4665,6 +5495,11 @@ func (s *VirtualMachineService) GetVirtualMachineByName(name string, opts ...Opt
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	return s.GetVirtualMachineByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVirtualMachineByIDWithContext is like GetVirtualMachineByID, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachineByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	p := &ListVirtualMachinesParams{}
 	p.p = make(map[string]interface{})
 
@@ -4676,11 +5511,10 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 		}
 	}
 
-	l, err := s.ListVirtualMachines(p)
+	l, err := s.ListVirtualMachinesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4698,19 +5532,56 @@ func (s *VirtualMachineService) GetVirtualMachineByID(id string, opts ...OptionF
 
 // List the Instances owned by the account.
 func (s *VirtualMachineService) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachines", p.toURLValues())
+	return s.ListVirtualMachinesWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesWithContext is like ListVirtualMachines, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVirtualMachines", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVirtualMachinesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVirtualMachines", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVirtualMachinesAll returns all VirtualMachines matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualMachineService) ListVirtualMachinesAll(p *ListVirtualMachinesParams, opts ...PageOption) ([]*VirtualMachine, error) {
+	return s.ListVirtualMachinesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualMachinesAllWithContext is like ListVirtualMachinesAll, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesAllWithContext(ctx context.Context, p *ListVirtualMachinesParams, opts ...PageOption) ([]*VirtualMachine, error) {
+	return collectPages(s.ListVirtualMachinesIterWithContext(ctx, p, opts...))
+}
+
+// ListVirtualMachinesIter returns an iterator over all VirtualMachines matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualMachineService) ListVirtualMachinesIter(p *ListVirtualMachinesParams, opts ...PageOption) iter.Seq2[*VirtualMachine, error] {
+	return s.ListVirtualMachinesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualMachinesIterWithContext is like ListVirtualMachinesIter, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesIterWithContext(ctx context.Context, p *ListVirtualMachinesParams, opts ...PageOption) iter.Seq2[*VirtualMachine, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VirtualMachine, int, error) {
+		l, err := s.ListVirtualMachinesWithContext(ctx, &ListVirtualMachinesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VirtualMachines, l.Count, nil
+	}, opts...)
+}
+
 type ListVirtualMachinesResponse struct {
 	Count           int               `json:"count"`
 	VirtualMachines []*VirtualMachine `json:"virtualmachine"`
@@ -4727,32 +5598,32 @@ type VirtualMachine struct {
 	Backupofferingname    string                        `json:"backupofferingname"`
 	Bootmode              string                        `json:"bootmode"`
 	Boottype              string                        `json:"boottype"`
-	Cpunumber             int                           `json:"cpunumber"`
-	Cpuspeed              int                           `json:"cpuspeed"`
+	Cpunumber             FlexInt                       `json:"cpunumber"`
+	Cpuspeed              FlexInt                       `json:"cpuspeed"`
 	Cpuused               string                        `json:"cpuused"`
-	Created               string                        `json:"created"`
-	Deleteprotection      bool                          `json:"deleteprotection"`
+	Created               Time                          `json:"created"`
+	Deleteprotection      FlexBool                      `json:"deleteprotection"`
 	Details               map[string]string             `json:"details"`
-	Diskioread            int64                         `json:"diskioread"`
-	Diskiowrite           int64                         `json:"diskiowrite"`
-	Diskkbsread           int64                         `json:"diskkbsread"`
-	Diskkbswrite          int64                         `json:"diskkbswrite"`
+	Diskioread            FlexInt64                     `json:"diskioread"`
+	Diskiowrite           FlexInt64                     `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                     `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                     `json:"diskkbswrite"`
 	Diskofferingid        string                        `json:"diskofferingid"`
 	Diskofferingname      string                        `json:"diskofferingname"`
 	Displayname           string                        `json:"displayname"`
-	Displayvm             bool                          `json:"displayvm"`
+	Displayvm             FlexBool                      `json:"displayvm"`
 	Domain                string                        `json:"domain"`
 	Domainid              string                        `json:"domainid"`
 	Domainpath            string                        `json:"domainpath"`
-	Forvirtualnetwork     bool                          `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                      `json:"forvirtualnetwork"`
 	Gpucardid             string                        `json:"gpucardid"`
 	Gpucardname           string                        `json:"gpucardname"`
-	Gpucount              int                           `json:"gpucount"`
+	Gpucount              FlexInt                       `json:"gpucount"`
 	Group                 string                        `json:"group"`
 	Groupid               string                        `json:"groupid"`
 	Guestosid             string                        `json:"guestosid"`
-	Haenable              bool                          `json:"haenable"`
-	Hasannotations        bool                          `json:"hasannotations"`
+	Haenable              FlexBool                      `json:"haenable"`
+	Hasannotations        FlexBool                      `json:"hasannotations"`
 	Hostcontrolstate      string                        `json:"hostcontrolstate"`
 	Hostid                string                        `json:"hostid"`
 	Hostname              string                        `json:"hostname"`
@@ -4761,47 +5632,47 @@ type VirtualMachine struct {
 	Id                    string                        `json:"id"`
 	Instancename          string                        `json:"instancename"`
 	Ipaddress             string                        `json:"ipaddress"`
-	Isdynamicallyscalable bool                          `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                      `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                        `json:"isodisplaytext"`
 	Isoid                 string                        `json:"isoid"`
 	Isoname               string                        `json:"isoname"`
 	JobID                 string                        `json:"jobid"`
-	Jobstatus             int                           `json:"jobstatus"`
+	Jobstatus             FlexInt                       `json:"jobstatus"`
 	Keypairs              string                        `json:"keypairs"`
-	Lastupdated           string                        `json:"lastupdated"`
-	Leaseduration         int                           `json:"leaseduration"`
+	Lastupdated           Time                          `json:"lastupdated"`
+	Leaseduration         FlexInt                       `json:"leaseduration"`
 	Leaseexpiryaction     string                        `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                        `json:"leaseexpirydate"`
-	Maxheads              int64                         `json:"maxheads"`
-	Maxresolutionx        int64                         `json:"maxresolutionx"`
-	Maxresolutiony        int64                         `json:"maxresolutiony"`
-	Memory                int                           `json:"memory"`
-	Memoryintfreekbs      int64                         `json:"memoryintfreekbs"`
-	Memorykbs             int64                         `json:"memorykbs"`
-	Memorytargetkbs       int64                         `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                          `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                     `json:"maxheads"`
+	Maxresolutionx        FlexInt64                     `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                     `json:"maxresolutiony"`
+	Memory                FlexInt                       `json:"memory"`
+	Memoryintfreekbs      FlexInt64                     `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                     `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                     `json:"memorytargetkbs"`
 	Name                  string                        `json:"name"`
-	Networkkbsread        int64                         `json:"networkkbsread"`
-	Networkkbswrite       int64                         `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                     `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                     `json:"networkkbswrite"`
 	Nic                   []Nic                         `json:"nic"`
 	Osdisplayname         string                        `json:"osdisplayname"`
 	Ostypeid              string                        `json:"ostypeid"`
 	Password              string                        `json:"password"`
-	Passwordenabled       bool                          `json:"passwordenabled"`
+	Passwordenabled       FlexBool                      `json:"passwordenabled"`
 	Pooltype              string                        `json:"pooltype"`
 	Project               string                        `json:"project"`
 	Projectid             string                        `json:"projectid"`
 	Publicip              string                        `json:"publicip"`
 	Publicipid            string                        `json:"publicipid"`
 	Readonlydetails       string                        `json:"readonlydetails"`
-	Receivedbytes         int64                         `json:"receivedbytes"`
-	Rootdeviceid          int64                         `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                     `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                     `json:"rootdeviceid"`
 	Rootdevicetype        string                        `json:"rootdevicetype"`
 	Securitygroup         []VirtualMachineSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                         `json:"sentbytes"`
+	Sentbytes             FlexInt64                     `json:"sentbytes"`
 	Serviceofferingid     string                        `json:"serviceofferingid"`
 	Serviceofferingname   string                        `json:"serviceofferingname"`
 	Servicestate          string                        `json:"servicestate"`
-	State                 string                        `json:"state"`
+	State                 VirtualMachineState           `json:"state"`
 	Tags                  []Tags                        `json:"tags"`
 	Templatedisplaytext   string                        `json:"templatedisplaytext"`
 	Templateformat        string                        `json:"templateformat"`
@@ -4818,12 +5689,13 @@ type VirtualMachine struct {
 	Vgpu                  string                        `json:"vgpu"`
 	Vgpuprofileid         string                        `json:"vgpuprofileid"`
 	Vgpuprofilename       string                        `json:"vgpuprofilename"`
-	Videoram              int64                         `json:"videoram"`
+	Videoram              FlexInt64                     `json:"videoram"`
 	Vmtype                string                        `json:"vmtype"`
 	Vnfdetails            map[string]string             `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                     `json:"vnfnics"`
 	Zoneid                string                        `json:"zoneid"`
 	Zonename              string                        `json:"zonename"`
+	Extra                 map[string]json.RawMessage    `json:"-"`
 }
 
 type VirtualMachineSecuritygroup struct {
@@ -4839,36 +5711,54 @@ type VirtualMachineSecuritygroup struct {
 	Project             string                            `json:"project"`
 	Projectid           string                            `json:"projectid"`
 	Tags                []Tags                            `json:"tags"`
-	Virtualmachinecount int                               `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                           `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                     `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage        `json:"-"`
+}
+
+func (r *VirtualMachineSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachineSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VirtualMachineSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VirtualMachineSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachineSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VirtualMachineAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VirtualMachineAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachineAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *VirtualMachine) UnmarshalJSON(b []byte) error {
@@ -4895,7 +5785,7 @@ func (r *VirtualMachine) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias VirtualMachine
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVirtualMachinesMetricsParams struct {
@@ -4962,7 +5852,7 @@ func (p *ListVirtualMachinesMetricsParams) toURLValues() url.Values {
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -5065,6 +5955,87 @@ func (p *ListVirtualMachinesMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVirtualMachinesMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVirtualMachinesMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVirtualMachinesMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVirtualMachinesMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVirtualMachinesMetricsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "affinitygroupid": 255, "arch": 255, "autoscalevmgroupid": 255, "backupofferingid": 255, "clusterid": 255, "domainid": 255, "extensionid": 255, "groupid": 255, "hostid": 255, "hypervisor": 255, "id": 255, "isoid": 255, "keypair": 255, "keyword": 255, "name": 255, "networkid": 255, "podid": 255, "projectid": 255, "securitygroupid": 255, "serviceofferingid": 255, "state": 255, "storageid": 255, "templateid": 255, "userdataid": 255, "userid": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listVirtualMachinesMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVirtualMachinesMetrics API, before the request is signed
+func (p *ListVirtualMachinesMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVirtualMachinesMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVirtualMachinesMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVirtualMachinesMetrics", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"accumulate":                decodeParam[bool],
+		"affinitygroupid":           decodeParam[string],
+		"arch":                      decodeParam[string],
+		"autoscalevmgroupid":        decodeParam[string],
+		"backupofferingid":          decodeParam[string],
+		"clusterid":                 decodeParam[string],
+		"details":                   decodeParam[[]string],
+		"displayvm":                 decodeParam[bool],
+		"domainid":                  decodeParam[string],
+		"extensionid":               decodeParam[string],
+		"forvirtualnetwork":         decodeParam[bool],
+		"gpuenabled":                decodeParam[bool],
+		"groupid":                   decodeParam[string],
+		"haenable":                  decodeParam[bool],
+		"hostid":                    decodeParam[string],
+		"hypervisor":                decodeParam[HypervisorType],
+		"id":                        decodeParam[string],
+		"ids":                       decodeParam[[]string],
+		"isoid":                     decodeParam[string],
+		"isrecursive":               decodeParam[bool],
+		"isvnf":                     decodeParam[bool],
+		"keypair":                   decodeParam[string],
+		"keyword":                   decodeParam[string],
+		"leased":                    decodeParam[bool],
+		"listall":                   decodeParam[bool],
+		"name":                      decodeParam[string],
+		"networkid":                 decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"podid":                     decodeParam[string],
+		"projectid":                 decodeParam[string],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"securitygroupid":           decodeParam[string],
+		"serviceofferingid":         decodeParam[string],
+		"showicon":                  decodeParam[bool],
+		"state":                     decodeParam[string],
+		"storageid":                 decodeParam[string],
+		"tags":                      decodeParam[map[string]string],
+		"templateid":                decodeParam[string],
+		"userdata":                  decodeParam[bool],
+		"userdataid":                decodeParam[string],
+		"userid":                    decodeParam[string],
+		"vpcid":                     decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVirtualMachinesMetricsParams) Clone() *ListVirtualMachinesMetricsParams {
+	return &ListVirtualMachinesMetricsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVirtualMachinesMetricsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5401,7 +6372,7 @@ func (p *ListVirtualMachinesMetricsParams) GetHostid() (string, bool) {
 	return value, ok
 }
 
-func (p *ListVirtualMachinesMetricsParams) SetHypervisor(v string) {
+func (p *ListVirtualMachinesMetricsParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -5414,11 +6385,11 @@ func (p *ListVirtualMachinesMetricsParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListVirtualMachinesMetricsParams) GetHypervisor() (string, bool) {
+func (p *ListVirtualMachinesMetricsParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -6020,6 +6991,11 @@ func (s *VirtualMachineService) NewListVirtualMachinesMetricsParams() *ListVirtu
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVirtualMachinesMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachinesMetricIDWithContext is like GetVirtualMachinesMetricID, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachinesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -6031,7 +7007,7 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...
 		}
 	}
 
-	l, err := s.ListVirtualMachinesMetrics(p)
+	l, err := s.ListVirtualMachinesMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -6056,12 +7032,17 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricID(name string, opts ...
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricByName(name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
-	id, count, err := s.GetVirtualMachinesMetricID(name, opts...)
+	return s.GetVirtualMachinesMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachinesMetricByNameWithContext is like GetVirtualMachinesMetricByName, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachinesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
+	id, count, err := s.GetVirtualMachinesMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVirtualMachinesMetricByID(id, opts...)
+	r, count, err := s.GetVirtualMachinesMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -6070,6 +7051,11 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByName(name string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
+	return s.GetVirtualMachinesMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVirtualMachinesMetricByIDWithContext is like GetVirtualMachinesMetricByID, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachinesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
 	p := &ListVirtualMachinesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -6081,11 +7067,10 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...
 		}
 	}
 
-	l, err := s.ListVirtualMachinesMetrics(p)
+	l, err := s.ListVirtualMachinesMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -6103,19 +7088,56 @@ func (s *VirtualMachineService) GetVirtualMachinesMetricByID(id string, opts ...
 
 // Lists VM metrics
 func (s *VirtualMachineService) ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachinesMetrics", p.toURLValues())
+	return s.ListVirtualMachinesMetricsWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesMetricsWithContext is like ListVirtualMachinesMetrics, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesMetricsWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVirtualMachinesMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVirtualMachinesMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVirtualMachinesMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVirtualMachinesMetricsAll returns all VirtualMachinesMetrics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualMachineService) ListVirtualMachinesMetricsAll(p *ListVirtualMachinesMetricsParams, opts ...PageOption) ([]*VirtualMachinesMetric, error) {
+	return s.ListVirtualMachinesMetricsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualMachinesMetricsAllWithContext is like ListVirtualMachinesMetricsAll, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesMetricsAllWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams, opts ...PageOption) ([]*VirtualMachinesMetric, error) {
+	return collectPages(s.ListVirtualMachinesMetricsIterWithContext(ctx, p, opts...))
+}
+
+// ListVirtualMachinesMetricsIter returns an iterator over all VirtualMachinesMetrics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualMachineService) ListVirtualMachinesMetricsIter(p *ListVirtualMachinesMetricsParams, opts ...PageOption) iter.Seq2[*VirtualMachinesMetric, error] {
+	return s.ListVirtualMachinesMetricsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualMachinesMetricsIterWithContext is like ListVirtualMachinesMetricsIter, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesMetricsIterWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams, opts ...PageOption) iter.Seq2[*VirtualMachinesMetric, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VirtualMachinesMetric, int, error) {
+		l, err := s.ListVirtualMachinesMetricsWithContext(ctx, &ListVirtualMachinesMetricsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VirtualMachinesMetrics, l.Count, nil
+	}, opts...)
+}
+
 type ListVirtualMachinesMetricsResponse struct {
 	Count                  int                      `json:"count"`
 	VirtualMachinesMetrics []*VirtualMachinesMetric `json:"virtualmachine"`
@@ -6132,36 +7154,36 @@ type VirtualMachinesMetric struct {
 	Backupofferingname    string                               `json:"backupofferingname"`
 	Bootmode              string                               `json:"bootmode"`
 	Boottype              string                               `json:"boottype"`
-	Cpunumber             int                                  `json:"cpunumber"`
-	Cpuspeed              int                                  `json:"cpuspeed"`
+	Cpunumber             FlexInt                              `json:"cpunumber"`
+	Cpuspeed              FlexInt                              `json:"cpuspeed"`
 	Cputotal              string                               `json:"cputotal"`
 	Cpuused               string                               `json:"cpuused"`
-	Created               string                               `json:"created"`
-	Deleteprotection      bool                                 `json:"deleteprotection"`
+	Created               Time                                 `json:"created"`
+	Deleteprotection      FlexBool                             `json:"deleteprotection"`
 	Details               map[string]string                    `json:"details"`
-	Diskiopstotal         int64                                `json:"diskiopstotal"`
-	Diskioread            int64                                `json:"diskioread"`
-	Diskiowrite           int64                                `json:"diskiowrite"`
-	Diskkbsread           int64                                `json:"diskkbsread"`
-	Diskkbswrite          int64                                `json:"diskkbswrite"`
+	Diskiopstotal         FlexInt64                            `json:"diskiopstotal"`
+	Diskioread            FlexInt64                            `json:"diskioread"`
+	Diskiowrite           FlexInt64                            `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                            `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                            `json:"diskkbswrite"`
 	Diskofferingid        string                               `json:"diskofferingid"`
 	Diskofferingname      string                               `json:"diskofferingname"`
 	Diskread              string                               `json:"diskread"`
 	Diskwrite             string                               `json:"diskwrite"`
 	Displayname           string                               `json:"displayname"`
-	Displayvm             bool                                 `json:"displayvm"`
+	Displayvm             FlexBool                             `json:"displayvm"`
 	Domain                string                               `json:"domain"`
 	Domainid              string                               `json:"domainid"`
 	Domainpath            string                               `json:"domainpath"`
-	Forvirtualnetwork     bool                                 `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                             `json:"forvirtualnetwork"`
 	Gpucardid             string                               `json:"gpucardid"`
 	Gpucardname           string                               `json:"gpucardname"`
-	Gpucount              int                                  `json:"gpucount"`
+	Gpucount              FlexInt                              `json:"gpucount"`
 	Group                 string                               `json:"group"`
 	Groupid               string                               `json:"groupid"`
 	Guestosid             string                               `json:"guestosid"`
-	Haenable              bool                                 `json:"haenable"`
-	Hasannotations        bool                                 `json:"hasannotations"`
+	Haenable              FlexBool                             `json:"haenable"`
+	Hasannotations        FlexBool                             `json:"hasannotations"`
 	Hostcontrolstate      string                               `json:"hostcontrolstate"`
 	Hostid                string                               `json:"hostid"`
 	Hostname              string                               `json:"hostname"`
@@ -6170,50 +7192,50 @@ type VirtualMachinesMetric struct {
 	Id                    string                               `json:"id"`
 	Instancename          string                               `json:"instancename"`
 	Ipaddress             string                               `json:"ipaddress"`
-	Isdynamicallyscalable bool                                 `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                             `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                               `json:"isodisplaytext"`
 	Isoid                 string                               `json:"isoid"`
 	Isoname               string                               `json:"isoname"`
 	JobID                 string                               `json:"jobid"`
-	Jobstatus             int                                  `json:"jobstatus"`
+	Jobstatus             FlexInt                              `json:"jobstatus"`
 	Keypairs              string                               `json:"keypairs"`
-	Lastupdated           string                               `json:"lastupdated"`
-	Leaseduration         int                                  `json:"leaseduration"`
+	Lastupdated           Time                                 `json:"lastupdated"`
+	Leaseduration         FlexInt                              `json:"leaseduration"`
 	Leaseexpiryaction     string                               `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                               `json:"leaseexpirydate"`
-	Maxheads              int64                                `json:"maxheads"`
-	Maxresolutionx        int64                                `json:"maxresolutionx"`
-	Maxresolutiony        int64                                `json:"maxresolutiony"`
-	Memory                int                                  `json:"memory"`
-	Memoryintfreekbs      int64                                `json:"memoryintfreekbs"`
-	Memorykbs             int64                                `json:"memorykbs"`
-	Memorytargetkbs       int64                                `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                 `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                            `json:"maxheads"`
+	Maxresolutionx        FlexInt64                            `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                            `json:"maxresolutiony"`
+	Memory                FlexInt                              `json:"memory"`
+	Memoryintfreekbs      FlexInt64                            `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                            `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                            `json:"memorytargetkbs"`
 	Memorytotal           string                               `json:"memorytotal"`
 	Name                  string                               `json:"name"`
-	Networkkbsread        int64                                `json:"networkkbsread"`
-	Networkkbswrite       int64                                `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                            `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                            `json:"networkkbswrite"`
 	Networkread           string                               `json:"networkread"`
 	Networkwrite          string                               `json:"networkwrite"`
 	Nic                   []Nic                                `json:"nic"`
 	Osdisplayname         string                               `json:"osdisplayname"`
 	Ostypeid              string                               `json:"ostypeid"`
 	Password              string                               `json:"password"`
-	Passwordenabled       bool                                 `json:"passwordenabled"`
+	Passwordenabled       FlexBool                             `json:"passwordenabled"`
 	Pooltype              string                               `json:"pooltype"`
 	Project               string                               `json:"project"`
 	Projectid             string                               `json:"projectid"`
 	Publicip              string                               `json:"publicip"`
 	Publicipid            string                               `json:"publicipid"`
 	Readonlydetails       string                               `json:"readonlydetails"`
-	Receivedbytes         int64                                `json:"receivedbytes"`
-	Rootdeviceid          int64                                `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                            `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                            `json:"rootdeviceid"`
 	Rootdevicetype        string                               `json:"rootdevicetype"`
 	Securitygroup         []VirtualMachinesMetricSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                `json:"sentbytes"`
+	Sentbytes             FlexInt64                            `json:"sentbytes"`
 	Serviceofferingid     string                               `json:"serviceofferingid"`
 	Serviceofferingname   string                               `json:"serviceofferingname"`
 	Servicestate          string                               `json:"servicestate"`
-	State                 string                               `json:"state"`
+	State                 VirtualMachineState                  `json:"state"`
 	Tags                  []Tags                               `json:"tags"`
 	Templatedisplaytext   string                               `json:"templatedisplaytext"`
 	Templateformat        string                               `json:"templateformat"`
@@ -6230,12 +7252,13 @@ type VirtualMachinesMetric struct {
 	Vgpu                  string                               `json:"vgpu"`
 	Vgpuprofileid         string                               `json:"vgpuprofileid"`
 	Vgpuprofilename       string                               `json:"vgpuprofilename"`
-	Videoram              int64                                `json:"videoram"`
+	Videoram              FlexInt64                            `json:"videoram"`
 	Vmtype                string                               `json:"vmtype"`
 	Vnfdetails            map[string]string                    `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                            `json:"vnfnics"`
 	Zoneid                string                               `json:"zoneid"`
 	Zonename              string                               `json:"zonename"`
+	Extra                 map[string]json.RawMessage           `json:"-"`
 }
 
 type VirtualMachinesMetricSecuritygroup struct {
@@ -6251,36 +7274,54 @@ type VirtualMachinesMetricSecuritygroup struct {
 	Project             string                                   `json:"project"`
 	Projectid           string                                   `json:"projectid"`
 	Tags                []Tags                                   `json:"tags"`
-	Virtualmachinecount int                                      `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                  `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                            `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage               `json:"-"`
+}
+
+func (r *VirtualMachinesMetricSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachinesMetricSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VirtualMachinesMetricSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VirtualMachinesMetricSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachinesMetricSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VirtualMachinesMetricAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VirtualMachinesMetricAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachinesMetricAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *VirtualMachinesMetric) UnmarshalJSON(b []byte) error {
@@ -6307,7 +7348,7 @@ func (r *VirtualMachinesMetric) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias VirtualMachinesMetric
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVmsForImportParams struct {
@@ -6323,7 +7364,7 @@ func (p *ListVmsForImportParams) toURLValues() url.Values {
 		u.Set("host", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["keyword"]; found {
 		u.Set("keyword", v.(string))
@@ -6348,6 +7389,51 @@ func (p *ListVmsForImportParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVmsForImport API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVmsForImportParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVmsForImport", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVmsForImport API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVmsForImportParams) Validate() error {
+	return paramRules{
+		required: []string{"host", "hypervisor", "zoneid"},
+		lengths:  map[string]int{"host": 255, "hypervisor": 255, "keyword": 255, "password": 255, "username": 255, "zoneid": 255},
+	}.validate("listVmsForImport", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVmsForImport API, before the request is signed
+func (p *ListVmsForImportParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVmsForImportParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVmsForImportParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVmsForImport", b, &p.p, map[string]paramDecoder{
+		"host":       decodeParam[string],
+		"hypervisor": decodeParam[HypervisorType],
+		"keyword":    decodeParam[string],
+		"page":       decodeParam[int],
+		"pagesize":   decodeParam[int],
+		"password":   decodeParam[string],
+		"username":   decodeParam[string],
+		"zoneid":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVmsForImportParams) Clone() *ListVmsForImportParams {
+	return &ListVmsForImportParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVmsForImportParams) SetHost(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6369,7 +7455,7 @@ func (p *ListVmsForImportParams) GetHost() (string, bool) {
 	return value, ok
 }
 
-func (p *ListVmsForImportParams) SetHypervisor(v string) {
+func (p *ListVmsForImportParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -6382,11 +7468,11 @@ func (p *ListVmsForImportParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListVmsForImportParams) GetHypervisor() (string, bool) {
+func (p *ListVmsForImportParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -6518,7 +7604,7 @@ func (p *ListVmsForImportParams) GetZoneid() (string, bool) {
 
 // You should always use this function to get a new ListVmsForImportParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewListVmsForImportParams(host string, hypervisor string, zoneid string) *ListVmsForImportParams {
+func (s *VirtualMachineService) NewListVmsForImportParams(host string, hypervisor HypervisorType, zoneid string) *ListVmsForImportParams {
 	p := &ListVmsForImportParams{}
 	p.p = make(map[string]interface{})
 	p.p["host"] = host
@@ -6529,59 +7615,108 @@ func (s *VirtualMachineService) NewListVmsForImportParams(host string, hyperviso
 
 // Lists virtual machines on a unmanaged host
 func (s *VirtualMachineService) ListVmsForImport(p *ListVmsForImportParams) (*ListVmsForImportResponse, error) {
-	resp, err := s.cs.newRequest("listVmsForImport", p.toURLValues())
+	return s.ListVmsForImportWithContext(context.Background(), p)
+}
+
+// ListVmsForImportWithContext is like ListVmsForImport, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVmsForImportWithContext(ctx context.Context, p *ListVmsForImportParams) (*ListVmsForImportResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVmsForImport", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVmsForImportResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVmsForImport", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVmsForImportAll returns all VmsForImport matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualMachineService) ListVmsForImportAll(p *ListVmsForImportParams, opts ...PageOption) ([]*VmsForImport, error) {
+	return s.ListVmsForImportAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVmsForImportAllWithContext is like ListVmsForImportAll, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVmsForImportAllWithContext(ctx context.Context, p *ListVmsForImportParams, opts ...PageOption) ([]*VmsForImport, error) {
+	return collectPages(s.ListVmsForImportIterWithContext(ctx, p, opts...))
+}
+
+// ListVmsForImportIter returns an iterator over all VmsForImport matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualMachineService) ListVmsForImportIter(p *ListVmsForImportParams, opts ...PageOption) iter.Seq2[*VmsForImport, error] {
+	return s.ListVmsForImportIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVmsForImportIterWithContext is like ListVmsForImportIter, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVmsForImportIterWithContext(ctx context.Context, p *ListVmsForImportParams, opts ...PageOption) iter.Seq2[*VmsForImport, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VmsForImport, int, error) {
+		l, err := s.ListVmsForImportWithContext(ctx, &ListVmsForImportParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VmsForImport, l.Count, nil
+	}, opts...)
+}
+
 type ListVmsForImportResponse struct {
 	Count        int             `json:"count"`
 	VmsForImport []*VmsForImport `json:"vmsforimport"`
 }
 
 type VmsForImport struct {
-	Bootmode          string             `json:"bootmode"`
-	Boottype          string             `json:"boottype"`
-	Clusterid         string             `json:"clusterid"`
-	Clustername       string             `json:"clustername"`
-	Cpucorepersocket  int                `json:"cpucorepersocket"`
-	Cpunumber         int                `json:"cpunumber"`
-	Cpuspeed          int                `json:"cpuspeed"`
-	Disk              []VmsForImportDisk `json:"disk"`
-	Hostid            string             `json:"hostid"`
-	Hostname          string             `json:"hostname"`
-	Hypervisor        string             `json:"hypervisor"`
-	Hypervisorversion string             `json:"hypervisorversion"`
-	JobID             string             `json:"jobid"`
-	Jobstatus         int                `json:"jobstatus"`
-	Memory            int                `json:"memory"`
-	Name              string             `json:"name"`
-	Nic               []Nic              `json:"nic"`
-	Osdisplayname     string             `json:"osdisplayname"`
-	Osid              string             `json:"osid"`
-	Powerstate        string             `json:"powerstate"`
+	Bootmode          string                     `json:"bootmode"`
+	Boottype          string                     `json:"boottype"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Cpucorepersocket  FlexInt                    `json:"cpucorepersocket"`
+	Cpunumber         FlexInt                    `json:"cpunumber"`
+	Cpuspeed          FlexInt                    `json:"cpuspeed"`
+	Disk              []VmsForImportDisk         `json:"disk"`
+	Hostid            string                     `json:"hostid"`
+	Hostname          string                     `json:"hostname"`
+	Hypervisor        string                     `json:"hypervisor"`
+	Hypervisorversion string                     `json:"hypervisorversion"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Memory            FlexInt                    `json:"memory"`
+	Name              string                     `json:"name"`
+	Nic               []Nic                      `json:"nic"`
+	Osdisplayname     string                     `json:"osdisplayname"`
+	Osid              string                     `json:"osid"`
+	Powerstate        string                     `json:"powerstate"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VmsForImport) UnmarshalJSON(b []byte) error {
+	type alias VmsForImport
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VmsForImportDisk struct {
-	Capacity       int64  `json:"capacity"`
-	Controller     string `json:"controller"`
-	Controllerunit int    `json:"controllerunit"`
-	Datastorehost  string `json:"datastorehost"`
-	Datastorename  string `json:"datastorename"`
-	Datastorepath  string `json:"datastorepath"`
-	Datastoretype  string `json:"datastoretype"`
-	Id             string `json:"id"`
-	Imagepath      string `json:"imagepath"`
-	Label          string `json:"label"`
-	Position       int    `json:"position"`
+	Capacity       FlexInt64                  `json:"capacity"`
+	Controller     string                     `json:"controller"`
+	Controllerunit FlexInt                    `json:"controllerunit"`
+	Datastorehost  string                     `json:"datastorehost"`
+	Datastorename  string                     `json:"datastorename"`
+	Datastorepath  string                     `json:"datastorepath"`
+	Datastoretype  string                     `json:"datastoretype"`
+	Id             string                     `json:"id"`
+	Imagepath      string                     `json:"imagepath"`
+	Label          string                     `json:"label"`
+	Position       FlexInt                    `json:"position"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VmsForImportDisk) UnmarshalJSON(b []byte) error {
+	type alias VmsForImportDisk
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVirtualMachineParams struct {
@@ -6609,6 +7744,47 @@ func (p *MigrateVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"hostid": 255, "storageid": 255, "virtualmachineid": 255},
+	}.validate("migrateVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateVirtualMachine API, before the request is signed
+func (p *MigrateVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"autoselect":       decodeParam[bool],
+		"hostid":           decodeParam[string],
+		"storageid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateVirtualMachineParams) Clone() *MigrateVirtualMachineParams {
+	return &MigrateVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateVirtualMachineParams) SetAutoselect(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6704,32 +7880,39 @@ func (s *VirtualMachineService) NewMigrateVirtualMachineParams(virtualmachineid
 
 // Attempts Migration of an Instance to a different host or Root volume of the Instance to a different storage pool
 func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateVirtualMachine", p.toURLValues())
+	return s.MigrateVirtualMachineWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineWithContext is like MigrateVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6737,6 +7920,25 @@ func (s *VirtualMachineService) MigrateVirtualMachine(p *MigrateVirtualMachinePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateVirtualMachine async job into r
+func (r *MigrateVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateVirtualMachineAsync starts the async job of MigrateVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) MigrateVirtualMachineAsync(ctx context.Context, p *MigrateVirtualMachineParams) (*Job, error) {
+	r, err := s.MigrateVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateVirtualMachine", r.JobID), nil
+}
+
 type MigrateVirtualMachineResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []MigrateVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -6748,32 +7950,32 @@ type MigrateVirtualMachineResponse struct {
 	Backupofferingname    string                                       `json:"backupofferingname"`
 	Bootmode              string                                       `json:"bootmode"`
 	Boottype              string                                       `json:"boottype"`
-	Cpunumber             int                                          `json:"cpunumber"`
-	Cpuspeed              int                                          `json:"cpuspeed"`
+	Cpunumber             FlexInt                                      `json:"cpunumber"`
+	Cpuspeed              FlexInt                                      `json:"cpuspeed"`
 	Cpuused               string                                       `json:"cpuused"`
-	Created               string                                       `json:"created"`
-	Deleteprotection      bool                                         `json:"deleteprotection"`
+	Created               Time                                         `json:"created"`
+	Deleteprotection      FlexBool                                     `json:"deleteprotection"`
 	Details               map[string]string                            `json:"details"`
-	Diskioread            int64                                        `json:"diskioread"`
-	Diskiowrite           int64                                        `json:"diskiowrite"`
-	Diskkbsread           int64                                        `json:"diskkbsread"`
-	Diskkbswrite          int64                                        `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                    `json:"diskioread"`
+	Diskiowrite           FlexInt64                                    `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                    `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                    `json:"diskkbswrite"`
 	Diskofferingid        string                                       `json:"diskofferingid"`
 	Diskofferingname      string                                       `json:"diskofferingname"`
 	Displayname           string                                       `json:"displayname"`
-	Displayvm             bool                                         `json:"displayvm"`
+	Displayvm             FlexBool                                     `json:"displayvm"`
 	Domain                string                                       `json:"domain"`
 	Domainid              string                                       `json:"domainid"`
 	Domainpath            string                                       `json:"domainpath"`
-	Forvirtualnetwork     bool                                         `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                     `json:"forvirtualnetwork"`
 	Gpucardid             string                                       `json:"gpucardid"`
 	Gpucardname           string                                       `json:"gpucardname"`
-	Gpucount              int                                          `json:"gpucount"`
+	Gpucount              FlexInt                                      `json:"gpucount"`
 	Group                 string                                       `json:"group"`
 	Groupid               string                                       `json:"groupid"`
 	Guestosid             string                                       `json:"guestosid"`
-	Haenable              bool                                         `json:"haenable"`
-	Hasannotations        bool                                         `json:"hasannotations"`
+	Haenable              FlexBool                                     `json:"haenable"`
+	Hasannotations        FlexBool                                     `json:"hasannotations"`
 	Hostcontrolstate      string                                       `json:"hostcontrolstate"`
 	Hostid                string                                       `json:"hostid"`
 	Hostname              string                                       `json:"hostname"`
@@ -6782,47 +7984,47 @@ type MigrateVirtualMachineResponse struct {
 	Id                    string                                       `json:"id"`
 	Instancename          string                                       `json:"instancename"`
 	Ipaddress             string                                       `json:"ipaddress"`
-	Isdynamicallyscalable bool                                         `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                     `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                       `json:"isodisplaytext"`
 	Isoid                 string                                       `json:"isoid"`
 	Isoname               string                                       `json:"isoname"`
 	JobID                 string                                       `json:"jobid"`
-	Jobstatus             int                                          `json:"jobstatus"`
+	Jobstatus             FlexInt                                      `json:"jobstatus"`
 	Keypairs              string                                       `json:"keypairs"`
-	Lastupdated           string                                       `json:"lastupdated"`
-	Leaseduration         int                                          `json:"leaseduration"`
+	Lastupdated           Time                                         `json:"lastupdated"`
+	Leaseduration         FlexInt                                      `json:"leaseduration"`
 	Leaseexpiryaction     string                                       `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                       `json:"leaseexpirydate"`
-	Maxheads              int64                                        `json:"maxheads"`
-	Maxresolutionx        int64                                        `json:"maxresolutionx"`
-	Maxresolutiony        int64                                        `json:"maxresolutiony"`
-	Memory                int                                          `json:"memory"`
-	Memoryintfreekbs      int64                                        `json:"memoryintfreekbs"`
-	Memorykbs             int64                                        `json:"memorykbs"`
-	Memorytargetkbs       int64                                        `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                         `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                    `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                    `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                    `json:"maxresolutiony"`
+	Memory                FlexInt                                      `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                    `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                    `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                    `json:"memorytargetkbs"`
 	Name                  string                                       `json:"name"`
-	Networkkbsread        int64                                        `json:"networkkbsread"`
-	Networkkbswrite       int64                                        `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                    `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                    `json:"networkkbswrite"`
 	Nic                   []Nic                                        `json:"nic"`
 	Osdisplayname         string                                       `json:"osdisplayname"`
 	Ostypeid              string                                       `json:"ostypeid"`
 	Password              string                                       `json:"password"`
-	Passwordenabled       bool                                         `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                     `json:"passwordenabled"`
 	Pooltype              string                                       `json:"pooltype"`
 	Project               string                                       `json:"project"`
 	Projectid             string                                       `json:"projectid"`
 	Publicip              string                                       `json:"publicip"`
 	Publicipid            string                                       `json:"publicipid"`
 	Readonlydetails       string                                       `json:"readonlydetails"`
-	Receivedbytes         int64                                        `json:"receivedbytes"`
-	Rootdeviceid          int64                                        `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                    `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                    `json:"rootdeviceid"`
 	Rootdevicetype        string                                       `json:"rootdevicetype"`
 	Securitygroup         []MigrateVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                        `json:"sentbytes"`
+	Sentbytes             FlexInt64                                    `json:"sentbytes"`
 	Serviceofferingid     string                                       `json:"serviceofferingid"`
 	Serviceofferingname   string                                       `json:"serviceofferingname"`
 	Servicestate          string                                       `json:"servicestate"`
-	State                 string                                       `json:"state"`
+	State                 VirtualMachineState                          `json:"state"`
 	Tags                  []Tags                                       `json:"tags"`
 	Templatedisplaytext   string                                       `json:"templatedisplaytext"`
 	Templateformat        string                                       `json:"templateformat"`
@@ -6839,12 +8041,13 @@ type MigrateVirtualMachineResponse struct {
 	Vgpu                  string                                       `json:"vgpu"`
 	Vgpuprofileid         string                                       `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                       `json:"vgpuprofilename"`
-	Videoram              int64                                        `json:"videoram"`
+	Videoram              FlexInt64                                    `json:"videoram"`
 	Vmtype                string                                       `json:"vmtype"`
 	Vnfdetails            map[string]string                            `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                    `json:"vnfnics"`
 	Zoneid                string                                       `json:"zoneid"`
 	Zonename              string                                       `json:"zonename"`
+	Extra                 map[string]json.RawMessage                   `json:"-"`
 }
 
 type MigrateVirtualMachineResponseSecuritygroup struct {
@@ -6860,36 +8063,54 @@ type MigrateVirtualMachineResponseSecuritygroup struct {
 	Project             string                                           `json:"project"`
 	Projectid           string                                           `json:"projectid"`
 	Tags                []Tags                                           `json:"tags"`
-	Virtualmachinecount int                                              `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                          `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                    `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *MigrateVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias MigrateVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias MigrateVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias MigrateVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *MigrateVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -6916,7 +8137,7 @@ func (r *MigrateVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias MigrateVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVirtualMachineWithVolumeParams struct {
@@ -6949,6 +8170,47 @@ func (p *MigrateVirtualMachineWithVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateVirtualMachineWithVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateVirtualMachineWithVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateVirtualMachineWithVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateVirtualMachineWithVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateVirtualMachineWithVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"hostid": 255, "virtualmachineid": 255},
+	}.validate("migrateVirtualMachineWithVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateVirtualMachineWithVolume API, before the request is signed
+func (p *MigrateVirtualMachineWithVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateVirtualMachineWithVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateVirtualMachineWithVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateVirtualMachineWithVolume", b, &p.p, map[string]paramDecoder{
+		"autoselect":       decodeParam[bool],
+		"hostid":           decodeParam[string],
+		"migrateto":        decodeParam[[]map[string]string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateVirtualMachineWithVolumeParams) Clone() *MigrateVirtualMachineWithVolumeParams {
+	return &MigrateVirtualMachineWithVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateVirtualMachineWithVolumeParams) SetAutoselect(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7058,32 +8320,39 @@ func (s *VirtualMachineService) NewMigrateVirtualMachineWithVolumeParams(virtual
 
 // Attempts Migration of an Instance with its volumes to a different host
 func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateVirtualMachineWithVolume", p.toURLValues())
+	return s.MigrateVirtualMachineWithVolumeWithContext(context.Background(), p)
+}
+
+// MigrateVirtualMachineWithVolumeWithContext is like MigrateVirtualMachineWithVolume, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateVirtualMachineWithVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateVirtualMachineWithVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateVirtualMachineWithVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateVirtualMachineWithVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateVirtualMachineWithVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -7091,6 +8360,25 @@ func (s *VirtualMachineService) MigrateVirtualMachineWithVolume(p *MigrateVirtua
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateVirtualMachineWithVolume async job into r
+func (r *MigrateVirtualMachineWithVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateVirtualMachineWithVolumeAsync starts the async job of MigrateVirtualMachineWithVolume without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) MigrateVirtualMachineWithVolumeAsync(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*Job, error) {
+	r, err := s.MigrateVirtualMachineWithVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateVirtualMachineWithVolume", r.JobID), nil
+}
+
 type MigrateVirtualMachineWithVolumeResponse struct {
 	Account               string                                                 `json:"account"`
 	Affinitygroup         []MigrateVirtualMachineWithVolumeResponseAffinitygroup `json:"affinitygroup"`
@@ -7102,32 +8390,32 @@ type MigrateVirtualMachineWithVolumeResponse struct {
 	Backupofferingname    string                                                 `json:"backupofferingname"`
 	Bootmode              string                                                 `json:"bootmode"`
 	Boottype              string                                                 `json:"boottype"`
-	Cpunumber             int                                                    `json:"cpunumber"`
-	Cpuspeed              int                                                    `json:"cpuspeed"`
+	Cpunumber             FlexInt                                                `json:"cpunumber"`
+	Cpuspeed              FlexInt                                                `json:"cpuspeed"`
 	Cpuused               string                                                 `json:"cpuused"`
-	Created               string                                                 `json:"created"`
-	Deleteprotection      bool                                                   `json:"deleteprotection"`
+	Created               Time                                                   `json:"created"`
+	Deleteprotection      FlexBool                                               `json:"deleteprotection"`
 	Details               map[string]string                                      `json:"details"`
-	Diskioread            int64                                                  `json:"diskioread"`
-	Diskiowrite           int64                                                  `json:"diskiowrite"`
-	Diskkbsread           int64                                                  `json:"diskkbsread"`
-	Diskkbswrite          int64                                                  `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                              `json:"diskioread"`
+	Diskiowrite           FlexInt64                                              `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                              `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                              `json:"diskkbswrite"`
 	Diskofferingid        string                                                 `json:"diskofferingid"`
 	Diskofferingname      string                                                 `json:"diskofferingname"`
 	Displayname           string                                                 `json:"displayname"`
-	Displayvm             bool                                                   `json:"displayvm"`
+	Displayvm             FlexBool                                               `json:"displayvm"`
 	Domain                string                                                 `json:"domain"`
 	Domainid              string                                                 `json:"domainid"`
 	Domainpath            string                                                 `json:"domainpath"`
-	Forvirtualnetwork     bool                                                   `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                               `json:"forvirtualnetwork"`
 	Gpucardid             string                                                 `json:"gpucardid"`
 	Gpucardname           string                                                 `json:"gpucardname"`
-	Gpucount              int                                                    `json:"gpucount"`
+	Gpucount              FlexInt                                                `json:"gpucount"`
 	Group                 string                                                 `json:"group"`
 	Groupid               string                                                 `json:"groupid"`
 	Guestosid             string                                                 `json:"guestosid"`
-	Haenable              bool                                                   `json:"haenable"`
-	Hasannotations        bool                                                   `json:"hasannotations"`
+	Haenable              FlexBool                                               `json:"haenable"`
+	Hasannotations        FlexBool                                               `json:"hasannotations"`
 	Hostcontrolstate      string                                                 `json:"hostcontrolstate"`
 	Hostid                string                                                 `json:"hostid"`
 	Hostname              string                                                 `json:"hostname"`
@@ -7136,47 +8424,47 @@ type MigrateVirtualMachineWithVolumeResponse struct {
 	Id                    string                                                 `json:"id"`
 	Instancename          string                                                 `json:"instancename"`
 	Ipaddress             string                                                 `json:"ipaddress"`
-	Isdynamicallyscalable bool                                                   `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                               `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                                 `json:"isodisplaytext"`
 	Isoid                 string                                                 `json:"isoid"`
 	Isoname               string                                                 `json:"isoname"`
 	JobID                 string                                                 `json:"jobid"`
-	Jobstatus             int                                                    `json:"jobstatus"`
+	Jobstatus             FlexInt                                                `json:"jobstatus"`
 	Keypairs              string                                                 `json:"keypairs"`
-	Lastupdated           string                                                 `json:"lastupdated"`
-	Leaseduration         int                                                    `json:"leaseduration"`
+	Lastupdated           Time                                                   `json:"lastupdated"`
+	Leaseduration         FlexInt                                                `json:"leaseduration"`
 	Leaseexpiryaction     string                                                 `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                                 `json:"leaseexpirydate"`
-	Maxheads              int64                                                  `json:"maxheads"`
-	Maxresolutionx        int64                                                  `json:"maxresolutionx"`
-	Maxresolutiony        int64                                                  `json:"maxresolutiony"`
-	Memory                int                                                    `json:"memory"`
-	Memoryintfreekbs      int64                                                  `json:"memoryintfreekbs"`
-	Memorykbs             int64                                                  `json:"memorykbs"`
-	Memorytargetkbs       int64                                                  `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                                   `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                              `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                              `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                              `json:"maxresolutiony"`
+	Memory                FlexInt                                                `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                              `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                              `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                              `json:"memorytargetkbs"`
 	Name                  string                                                 `json:"name"`
-	Networkkbsread        int64                                                  `json:"networkkbsread"`
-	Networkkbswrite       int64                                                  `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                              `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                              `json:"networkkbswrite"`
 	Nic                   []Nic                                                  `json:"nic"`
 	Osdisplayname         string                                                 `json:"osdisplayname"`
 	Ostypeid              string                                                 `json:"ostypeid"`
 	Password              string                                                 `json:"password"`
-	Passwordenabled       bool                                                   `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                               `json:"passwordenabled"`
 	Pooltype              string                                                 `json:"pooltype"`
 	Project               string                                                 `json:"project"`
 	Projectid             string                                                 `json:"projectid"`
 	Publicip              string                                                 `json:"publicip"`
 	Publicipid            string                                                 `json:"publicipid"`
 	Readonlydetails       string                                                 `json:"readonlydetails"`
-	Receivedbytes         int64                                                  `json:"receivedbytes"`
-	Rootdeviceid          int64                                                  `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                              `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                              `json:"rootdeviceid"`
 	Rootdevicetype        string                                                 `json:"rootdevicetype"`
 	Securitygroup         []MigrateVirtualMachineWithVolumeResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                                  `json:"sentbytes"`
+	Sentbytes             FlexInt64                                              `json:"sentbytes"`
 	Serviceofferingid     string                                                 `json:"serviceofferingid"`
 	Serviceofferingname   string                                                 `json:"serviceofferingname"`
 	Servicestate          string                                                 `json:"servicestate"`
-	State                 string                                                 `json:"state"`
+	State                 VirtualMachineState                                    `json:"state"`
 	Tags                  []Tags                                                 `json:"tags"`
 	Templatedisplaytext   string                                                 `json:"templatedisplaytext"`
 	Templateformat        string                                                 `json:"templateformat"`
@@ -7193,12 +8481,13 @@ type MigrateVirtualMachineWithVolumeResponse struct {
 	Vgpu                  string                                                 `json:"vgpu"`
 	Vgpuprofileid         string                                                 `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                                 `json:"vgpuprofilename"`
-	Videoram              int64                                                  `json:"videoram"`
+	Videoram              FlexInt64                                              `json:"videoram"`
 	Vmtype                string                                                 `json:"vmtype"`
 	Vnfdetails            map[string]string                                      `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                              `json:"vnfnics"`
 	Zoneid                string                                                 `json:"zoneid"`
 	Zonename              string                                                 `json:"zonename"`
+	Extra                 map[string]json.RawMessage                             `json:"-"`
 }
 
 type MigrateVirtualMachineWithVolumeResponseSecuritygroup struct {
@@ -7214,36 +8503,54 @@ type MigrateVirtualMachineWithVolumeResponseSecuritygroup struct {
 	Project             string                                                     `json:"project"`
 	Projectid           string                                                     `json:"projectid"`
 	Tags                []Tags                                                     `json:"tags"`
-	Virtualmachinecount int                                                        `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                                    `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                              `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                                 `json:"-"`
+}
+
+func (r *MigrateVirtualMachineWithVolumeResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias MigrateVirtualMachineWithVolumeResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVirtualMachineWithVolumeResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateVirtualMachineWithVolumeResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias MigrateVirtualMachineWithVolumeResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVirtualMachineWithVolumeResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateVirtualMachineWithVolumeResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias MigrateVirtualMachineWithVolumeResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *MigrateVirtualMachineWithVolumeResponse) UnmarshalJSON(b []byte) error {
@@ -7270,7 +8577,7 @@ func (r *MigrateVirtualMachineWithVolumeResponse) UnmarshalJSON(b []byte) error
 	}
 
 	type alias MigrateVirtualMachineWithVolumeResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RebootVirtualMachineParams struct {
@@ -7296,6 +8603,46 @@ func (p *RebootVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the rebootVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RebootVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("rebootVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the rebootVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RebootVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("rebootVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the rebootVirtualMachine API, before the request is signed
+func (p *RebootVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RebootVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RebootVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("rebootVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"bootintosetup": decodeParam[bool],
+		"forced":        decodeParam[bool],
+		"id":            decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RebootVirtualMachineParams) Clone() *RebootVirtualMachineParams {
+	return &RebootVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *RebootVirtualMachineParams) SetBootintosetup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7370,32 +8717,39 @@ func (s *VirtualMachineService) NewRebootVirtualMachineParams(id string) *Reboot
 
 // Reboots  an Instance.
 func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("rebootVirtualMachine", p.toURLValues())
+	return s.RebootVirtualMachineWithContext(context.Background(), p)
+}
+
+// RebootVirtualMachineWithContext is like RebootVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "rebootVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RebootVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("rebootVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "rebootVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("rebootVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -7403,6 +8757,25 @@ func (s *VirtualMachineService) RebootVirtualMachine(p *RebootVirtualMachinePara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RebootVirtualMachine async job into r
+func (r *RebootVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RebootVirtualMachineAsync starts the async job of RebootVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) RebootVirtualMachineAsync(ctx context.Context, p *RebootVirtualMachineParams) (*Job, error) {
+	r, err := s.RebootVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("rebootVirtualMachine", r.JobID), nil
+}
+
 type RebootVirtualMachineResponse struct {
 	Account               string                                      `json:"account"`
 	Affinitygroup         []RebootVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -7414,32 +8787,32 @@ type RebootVirtualMachineResponse struct {
 	Backupofferingname    string                                      `json:"backupofferingname"`
 	Bootmode              string                                      `json:"bootmode"`
 	Boottype              string                                      `json:"boottype"`
-	Cpunumber             int                                         `json:"cpunumber"`
-	Cpuspeed              int                                         `json:"cpuspeed"`
+	Cpunumber             FlexInt                                     `json:"cpunumber"`
+	Cpuspeed              FlexInt                                     `json:"cpuspeed"`
 	Cpuused               string                                      `json:"cpuused"`
-	Created               string                                      `json:"created"`
-	Deleteprotection      bool                                        `json:"deleteprotection"`
+	Created               Time                                        `json:"created"`
+	Deleteprotection      FlexBool                                    `json:"deleteprotection"`
 	Details               map[string]string                           `json:"details"`
-	Diskioread            int64                                       `json:"diskioread"`
-	Diskiowrite           int64                                       `json:"diskiowrite"`
-	Diskkbsread           int64                                       `json:"diskkbsread"`
-	Diskkbswrite          int64                                       `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                   `json:"diskioread"`
+	Diskiowrite           FlexInt64                                   `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                   `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                   `json:"diskkbswrite"`
 	Diskofferingid        string                                      `json:"diskofferingid"`
 	Diskofferingname      string                                      `json:"diskofferingname"`
 	Displayname           string                                      `json:"displayname"`
-	Displayvm             bool                                        `json:"displayvm"`
+	Displayvm             FlexBool                                    `json:"displayvm"`
 	Domain                string                                      `json:"domain"`
 	Domainid              string                                      `json:"domainid"`
 	Domainpath            string                                      `json:"domainpath"`
-	Forvirtualnetwork     bool                                        `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                    `json:"forvirtualnetwork"`
 	Gpucardid             string                                      `json:"gpucardid"`
 	Gpucardname           string                                      `json:"gpucardname"`
-	Gpucount              int                                         `json:"gpucount"`
+	Gpucount              FlexInt                                     `json:"gpucount"`
 	Group                 string                                      `json:"group"`
 	Groupid               string                                      `json:"groupid"`
 	Guestosid             string                                      `json:"guestosid"`
-	Haenable              bool                                        `json:"haenable"`
-	Hasannotations        bool                                        `json:"hasannotations"`
+	Haenable              FlexBool                                    `json:"haenable"`
+	Hasannotations        FlexBool                                    `json:"hasannotations"`
 	Hostcontrolstate      string                                      `json:"hostcontrolstate"`
 	Hostid                string                                      `json:"hostid"`
 	Hostname              string                                      `json:"hostname"`
@@ -7448,47 +8821,47 @@ type RebootVirtualMachineResponse struct {
 	Id                    string                                      `json:"id"`
 	Instancename          string                                      `json:"instancename"`
 	Ipaddress             string                                      `json:"ipaddress"`
-	Isdynamicallyscalable bool                                        `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                    `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                      `json:"isodisplaytext"`
 	Isoid                 string                                      `json:"isoid"`
 	Isoname               string                                      `json:"isoname"`
 	JobID                 string                                      `json:"jobid"`
-	Jobstatus             int                                         `json:"jobstatus"`
+	Jobstatus             FlexInt                                     `json:"jobstatus"`
 	Keypairs              string                                      `json:"keypairs"`
-	Lastupdated           string                                      `json:"lastupdated"`
-	Leaseduration         int                                         `json:"leaseduration"`
+	Lastupdated           Time                                        `json:"lastupdated"`
+	Leaseduration         FlexInt                                     `json:"leaseduration"`
 	Leaseexpiryaction     string                                      `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                      `json:"leaseexpirydate"`
-	Maxheads              int64                                       `json:"maxheads"`
-	Maxresolutionx        int64                                       `json:"maxresolutionx"`
-	Maxresolutiony        int64                                       `json:"maxresolutiony"`
-	Memory                int                                         `json:"memory"`
-	Memoryintfreekbs      int64                                       `json:"memoryintfreekbs"`
-	Memorykbs             int64                                       `json:"memorykbs"`
-	Memorytargetkbs       int64                                       `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                        `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                   `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                   `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                   `json:"maxresolutiony"`
+	Memory                FlexInt                                     `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                   `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                   `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                   `json:"memorytargetkbs"`
 	Name                  string                                      `json:"name"`
-	Networkkbsread        int64                                       `json:"networkkbsread"`
-	Networkkbswrite       int64                                       `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                   `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                   `json:"networkkbswrite"`
 	Nic                   []Nic                                       `json:"nic"`
 	Osdisplayname         string                                      `json:"osdisplayname"`
 	Ostypeid              string                                      `json:"ostypeid"`
 	Password              string                                      `json:"password"`
-	Passwordenabled       bool                                        `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                    `json:"passwordenabled"`
 	Pooltype              string                                      `json:"pooltype"`
 	Project               string                                      `json:"project"`
 	Projectid             string                                      `json:"projectid"`
 	Publicip              string                                      `json:"publicip"`
 	Publicipid            string                                      `json:"publicipid"`
 	Readonlydetails       string                                      `json:"readonlydetails"`
-	Receivedbytes         int64                                       `json:"receivedbytes"`
-	Rootdeviceid          int64                                       `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                   `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                   `json:"rootdeviceid"`
 	Rootdevicetype        string                                      `json:"rootdevicetype"`
 	Securitygroup         []RebootVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                       `json:"sentbytes"`
+	Sentbytes             FlexInt64                                   `json:"sentbytes"`
 	Serviceofferingid     string                                      `json:"serviceofferingid"`
 	Serviceofferingname   string                                      `json:"serviceofferingname"`
 	Servicestate          string                                      `json:"servicestate"`
-	State                 string                                      `json:"state"`
+	State                 VirtualMachineState                         `json:"state"`
 	Tags                  []Tags                                      `json:"tags"`
 	Templatedisplaytext   string                                      `json:"templatedisplaytext"`
 	Templateformat        string                                      `json:"templateformat"`
@@ -7505,12 +8878,13 @@ type RebootVirtualMachineResponse struct {
 	Vgpu                  string                                      `json:"vgpu"`
 	Vgpuprofileid         string                                      `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                      `json:"vgpuprofilename"`
-	Videoram              int64                                       `json:"videoram"`
+	Videoram              FlexInt64                                   `json:"videoram"`
 	Vmtype                string                                      `json:"vmtype"`
 	Vnfdetails            map[string]string                           `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                   `json:"vnfnics"`
 	Zoneid                string                                      `json:"zoneid"`
 	Zonename              string                                      `json:"zonename"`
+	Extra                 map[string]json.RawMessage                  `json:"-"`
 }
 
 type RebootVirtualMachineResponseSecuritygroup struct {
@@ -7526,36 +8900,54 @@ type RebootVirtualMachineResponseSecuritygroup struct {
 	Project             string                                          `json:"project"`
 	Projectid           string                                          `json:"projectid"`
 	Tags                []Tags                                          `json:"tags"`
-	Virtualmachinecount int                                             `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                         `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                   `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                      `json:"-"`
+}
+
+func (r *RebootVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias RebootVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RebootVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RebootVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias RebootVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RebootVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RebootVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias RebootVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *RebootVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -7582,7 +8974,7 @@ func (r *RebootVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RebootVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RecoverVirtualMachineParams struct {
@@ -7600,6 +8992,44 @@ func (p *RecoverVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the recoverVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RecoverVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("recoverVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the recoverVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RecoverVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("recoverVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the recoverVirtualMachine API, before the request is signed
+func (p *RecoverVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RecoverVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RecoverVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("recoverVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RecoverVirtualMachineParams) Clone() *RecoverVirtualMachineParams {
+	return &RecoverVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *RecoverVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7632,13 +9062,22 @@ func (s *VirtualMachineService) NewRecoverVirtualMachineParams(id string) *Recov
 
 // Recovers  an Instance.
 func (s *VirtualMachineService) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("recoverVirtualMachine", p.toURLValues())
+	return s.RecoverVirtualMachineWithContext(context.Background(), p)
+}
+
+// RecoverVirtualMachineWithContext is like RecoverVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "recoverVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RecoverVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("recoverVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -7656,32 +9095,32 @@ type RecoverVirtualMachineResponse struct {
 	Backupofferingname    string                                       `json:"backupofferingname"`
 	Bootmode              string                                       `json:"bootmode"`
 	Boottype              string                                       `json:"boottype"`
-	Cpunumber             int                                          `json:"cpunumber"`
-	Cpuspeed              int                                          `json:"cpuspeed"`
+	Cpunumber             FlexInt                                      `json:"cpunumber"`
+	Cpuspeed              FlexInt                                      `json:"cpuspeed"`
 	Cpuused               string                                       `json:"cpuused"`
-	Created               string                                       `json:"created"`
-	Deleteprotection      bool                                         `json:"deleteprotection"`
+	Created               Time                                         `json:"created"`
+	Deleteprotection      FlexBool                                     `json:"deleteprotection"`
 	Details               map[string]string                            `json:"details"`
-	Diskioread            int64                                        `json:"diskioread"`
-	Diskiowrite           int64                                        `json:"diskiowrite"`
-	Diskkbsread           int64                                        `json:"diskkbsread"`
-	Diskkbswrite          int64                                        `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                    `json:"diskioread"`
+	Diskiowrite           FlexInt64                                    `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                    `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                    `json:"diskkbswrite"`
 	Diskofferingid        string                                       `json:"diskofferingid"`
 	Diskofferingname      string                                       `json:"diskofferingname"`
 	Displayname           string                                       `json:"displayname"`
-	Displayvm             bool                                         `json:"displayvm"`
+	Displayvm             FlexBool                                     `json:"displayvm"`
 	Domain                string                                       `json:"domain"`
 	Domainid              string                                       `json:"domainid"`
 	Domainpath            string                                       `json:"domainpath"`
-	Forvirtualnetwork     bool                                         `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                     `json:"forvirtualnetwork"`
 	Gpucardid             string                                       `json:"gpucardid"`
 	Gpucardname           string                                       `json:"gpucardname"`
-	Gpucount              int                                          `json:"gpucount"`
+	Gpucount              FlexInt                                      `json:"gpucount"`
 	Group                 string                                       `json:"group"`
 	Groupid               string                                       `json:"groupid"`
 	Guestosid             string                                       `json:"guestosid"`
-	Haenable              bool                                         `json:"haenable"`
-	Hasannotations        bool                                         `json:"hasannotations"`
+	Haenable              FlexBool                                     `json:"haenable"`
+	Hasannotations        FlexBool                                     `json:"hasannotations"`
 	Hostcontrolstate      string                                       `json:"hostcontrolstate"`
 	Hostid                string                                       `json:"hostid"`
 	Hostname              string                                       `json:"hostname"`
@@ -7690,47 +9129,47 @@ type RecoverVirtualMachineResponse struct {
 	Id                    string                                       `json:"id"`
 	Instancename          string                                       `json:"instancename"`
 	Ipaddress             string                                       `json:"ipaddress"`
-	Isdynamicallyscalable bool                                         `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                     `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                       `json:"isodisplaytext"`
 	Isoid                 string                                       `json:"isoid"`
 	Isoname               string                                       `json:"isoname"`
 	JobID                 string                                       `json:"jobid"`
-	Jobstatus             int                                          `json:"jobstatus"`
+	Jobstatus             FlexInt                                      `json:"jobstatus"`
 	Keypairs              string                                       `json:"keypairs"`
-	Lastupdated           string                                       `json:"lastupdated"`
-	Leaseduration         int                                          `json:"leaseduration"`
+	Lastupdated           Time                                         `json:"lastupdated"`
+	Leaseduration         FlexInt                                      `json:"leaseduration"`
 	Leaseexpiryaction     string                                       `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                       `json:"leaseexpirydate"`
-	Maxheads              int64                                        `json:"maxheads"`
-	Maxresolutionx        int64                                        `json:"maxresolutionx"`
-	Maxresolutiony        int64                                        `json:"maxresolutiony"`
-	Memory                int                                          `json:"memory"`
-	Memoryintfreekbs      int64                                        `json:"memoryintfreekbs"`
-	Memorykbs             int64                                        `json:"memorykbs"`
-	Memorytargetkbs       int64                                        `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                         `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                    `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                    `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                    `json:"maxresolutiony"`
+	Memory                FlexInt                                      `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                    `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                    `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                    `json:"memorytargetkbs"`
 	Name                  string                                       `json:"name"`
-	Networkkbsread        int64                                        `json:"networkkbsread"`
-	Networkkbswrite       int64                                        `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                    `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                    `json:"networkkbswrite"`
 	Nic                   []Nic                                        `json:"nic"`
 	Osdisplayname         string                                       `json:"osdisplayname"`
 	Ostypeid              string                                       `json:"ostypeid"`
 	Password              string                                       `json:"password"`
-	Passwordenabled       bool                                         `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                     `json:"passwordenabled"`
 	Pooltype              string                                       `json:"pooltype"`
 	Project               string                                       `json:"project"`
 	Projectid             string                                       `json:"projectid"`
 	Publicip              string                                       `json:"publicip"`
 	Publicipid            string                                       `json:"publicipid"`
 	Readonlydetails       string                                       `json:"readonlydetails"`
-	Receivedbytes         int64                                        `json:"receivedbytes"`
-	Rootdeviceid          int64                                        `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                    `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                    `json:"rootdeviceid"`
 	Rootdevicetype        string                                       `json:"rootdevicetype"`
 	Securitygroup         []RecoverVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                        `json:"sentbytes"`
+	Sentbytes             FlexInt64                                    `json:"sentbytes"`
 	Serviceofferingid     string                                       `json:"serviceofferingid"`
 	Serviceofferingname   string                                       `json:"serviceofferingname"`
 	Servicestate          string                                       `json:"servicestate"`
-	State                 string                                       `json:"state"`
+	State                 VirtualMachineState                          `json:"state"`
 	Tags                  []Tags                                       `json:"tags"`
 	Templatedisplaytext   string                                       `json:"templatedisplaytext"`
 	Templateformat        string                                       `json:"templateformat"`
@@ -7747,12 +9186,13 @@ type RecoverVirtualMachineResponse struct {
 	Vgpu                  string                                       `json:"vgpu"`
 	Vgpuprofileid         string                                       `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                       `json:"vgpuprofilename"`
-	Videoram              int64                                        `json:"videoram"`
+	Videoram              FlexInt64                                    `json:"videoram"`
 	Vmtype                string                                       `json:"vmtype"`
 	Vnfdetails            map[string]string                            `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                    `json:"vnfnics"`
 	Zoneid                string                                       `json:"zoneid"`
 	Zonename              string                                       `json:"zonename"`
+	Extra                 map[string]json.RawMessage                   `json:"-"`
 }
 
 type RecoverVirtualMachineResponseSecuritygroup struct {
@@ -7768,36 +9208,54 @@ type RecoverVirtualMachineResponseSecuritygroup struct {
 	Project             string                                           `json:"project"`
 	Projectid           string                                           `json:"projectid"`
 	Tags                []Tags                                           `json:"tags"`
-	Virtualmachinecount int                                              `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                          `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                    `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *RecoverVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias RecoverVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RecoverVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RecoverVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias RecoverVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RecoverVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RecoverVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias RecoverVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *RecoverVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -7824,7 +9282,7 @@ func (r *RecoverVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RecoverVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveNicFromVirtualMachineParams struct {
@@ -7845,6 +9303,45 @@ func (p *RemoveNicFromVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeNicFromVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveNicFromVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeNicFromVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeNicFromVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveNicFromVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"nicid", "virtualmachineid"},
+		lengths:  map[string]int{"nicid": 255, "virtualmachineid": 255},
+	}.validate("removeNicFromVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeNicFromVirtualMachine API, before the request is signed
+func (p *RemoveNicFromVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveNicFromVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveNicFromVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeNicFromVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"nicid":            decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveNicFromVirtualMachineParams) Clone() *RemoveNicFromVirtualMachineParams {
+	return &RemoveNicFromVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveNicFromVirtualMachineParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -7899,32 +9396,39 @@ func (s *VirtualMachineService) NewRemoveNicFromVirtualMachineParams(nicid strin
 
 // Removes Instance from specified network by deleting a NIC
 func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("removeNicFromVirtualMachine", p.toURLValues())
+	return s.RemoveNicFromVirtualMachineWithContext(context.Background(), p)
+}
+
+// RemoveNicFromVirtualMachineWithContext is like RemoveNicFromVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeNicFromVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveNicFromVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeNicFromVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeNicFromVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeNicFromVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -7932,6 +9436,25 @@ func (s *VirtualMachineService) RemoveNicFromVirtualMachine(p *RemoveNicFromVirt
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveNicFromVirtualMachine async job into r
+func (r *RemoveNicFromVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RemoveNicFromVirtualMachineAsync starts the async job of RemoveNicFromVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) RemoveNicFromVirtualMachineAsync(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*Job, error) {
+	r, err := s.RemoveNicFromVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeNicFromVirtualMachine", r.JobID), nil
+}
+
 type RemoveNicFromVirtualMachineResponse struct {
 	Account               string                                             `json:"account"`
 	Affinitygroup         []RemoveNicFromVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -7943,32 +9466,32 @@ type RemoveNicFromVirtualMachineResponse struct {
 	Backupofferingname    string                                             `json:"backupofferingname"`
 	Bootmode              string                                             `json:"bootmode"`
 	Boottype              string                                             `json:"boottype"`
-	Cpunumber             int                                                `json:"cpunumber"`
-	Cpuspeed              int                                                `json:"cpuspeed"`
+	Cpunumber             FlexInt                                            `json:"cpunumber"`
+	Cpuspeed              FlexInt                                            `json:"cpuspeed"`
 	Cpuused               string                                             `json:"cpuused"`
-	Created               string                                             `json:"created"`
-	Deleteprotection      bool                                               `json:"deleteprotection"`
+	Created               Time                                               `json:"created"`
+	Deleteprotection      FlexBool                                           `json:"deleteprotection"`
 	Details               map[string]string                                  `json:"details"`
-	Diskioread            int64                                              `json:"diskioread"`
-	Diskiowrite           int64                                              `json:"diskiowrite"`
-	Diskkbsread           int64                                              `json:"diskkbsread"`
-	Diskkbswrite          int64                                              `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                          `json:"diskioread"`
+	Diskiowrite           FlexInt64                                          `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                          `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                          `json:"diskkbswrite"`
 	Diskofferingid        string                                             `json:"diskofferingid"`
 	Diskofferingname      string                                             `json:"diskofferingname"`
 	Displayname           string                                             `json:"displayname"`
-	Displayvm             bool                                               `json:"displayvm"`
+	Displayvm             FlexBool                                           `json:"displayvm"`
 	Domain                string                                             `json:"domain"`
 	Domainid              string                                             `json:"domainid"`
 	Domainpath            string                                             `json:"domainpath"`
-	Forvirtualnetwork     bool                                               `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                           `json:"forvirtualnetwork"`
 	Gpucardid             string                                             `json:"gpucardid"`
 	Gpucardname           string                                             `json:"gpucardname"`
-	Gpucount              int                                                `json:"gpucount"`
+	Gpucount              FlexInt                                            `json:"gpucount"`
 	Group                 string                                             `json:"group"`
 	Groupid               string                                             `json:"groupid"`
 	Guestosid             string                                             `json:"guestosid"`
-	Haenable              bool                                               `json:"haenable"`
-	Hasannotations        bool                                               `json:"hasannotations"`
+	Haenable              FlexBool                                           `json:"haenable"`
+	Hasannotations        FlexBool                                           `json:"hasannotations"`
 	Hostcontrolstate      string                                             `json:"hostcontrolstate"`
 	Hostid                string                                             `json:"hostid"`
 	Hostname              string                                             `json:"hostname"`
@@ -7977,47 +9500,47 @@ type RemoveNicFromVirtualMachineResponse struct {
 	Id                    string                                             `json:"id"`
 	Instancename          string                                             `json:"instancename"`
 	Ipaddress             string                                             `json:"ipaddress"`
-	Isdynamicallyscalable bool                                               `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                           `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                             `json:"isodisplaytext"`
 	Isoid                 string                                             `json:"isoid"`
 	Isoname               string                                             `json:"isoname"`
 	JobID                 string                                             `json:"jobid"`
-	Jobstatus             int                                                `json:"jobstatus"`
+	Jobstatus             FlexInt                                            `json:"jobstatus"`
 	Keypairs              string                                             `json:"keypairs"`
-	Lastupdated           string                                             `json:"lastupdated"`
-	Leaseduration         int                                                `json:"leaseduration"`
+	Lastupdated           Time                                               `json:"lastupdated"`
+	Leaseduration         FlexInt                                            `json:"leaseduration"`
 	Leaseexpiryaction     string                                             `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                             `json:"leaseexpirydate"`
-	Maxheads              int64                                              `json:"maxheads"`
-	Maxresolutionx        int64                                              `json:"maxresolutionx"`
-	Maxresolutiony        int64                                              `json:"maxresolutiony"`
-	Memory                int                                                `json:"memory"`
-	Memoryintfreekbs      int64                                              `json:"memoryintfreekbs"`
-	Memorykbs             int64                                              `json:"memorykbs"`
-	Memorytargetkbs       int64                                              `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                               `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                          `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                          `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                          `json:"maxresolutiony"`
+	Memory                FlexInt                                            `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                          `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                          `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                          `json:"memorytargetkbs"`
 	Name                  string                                             `json:"name"`
-	Networkkbsread        int64                                              `json:"networkkbsread"`
-	Networkkbswrite       int64                                              `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                          `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                          `json:"networkkbswrite"`
 	Nic                   []Nic                                              `json:"nic"`
 	Osdisplayname         string                                             `json:"osdisplayname"`
 	Ostypeid              string                                             `json:"ostypeid"`
 	Password              string                                             `json:"password"`
-	Passwordenabled       bool                                               `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                           `json:"passwordenabled"`
 	Pooltype              string                                             `json:"pooltype"`
 	Project               string                                             `json:"project"`
 	Projectid             string                                             `json:"projectid"`
 	Publicip              string                                             `json:"publicip"`
 	Publicipid            string                                             `json:"publicipid"`
 	Readonlydetails       string                                             `json:"readonlydetails"`
-	Receivedbytes         int64                                              `json:"receivedbytes"`
-	Rootdeviceid          int64                                              `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                          `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                          `json:"rootdeviceid"`
 	Rootdevicetype        string                                             `json:"rootdevicetype"`
 	Securitygroup         []RemoveNicFromVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                              `json:"sentbytes"`
+	Sentbytes             FlexInt64                                          `json:"sentbytes"`
 	Serviceofferingid     string                                             `json:"serviceofferingid"`
 	Serviceofferingname   string                                             `json:"serviceofferingname"`
 	Servicestate          string                                             `json:"servicestate"`
-	State                 string                                             `json:"state"`
+	State                 VirtualMachineState                                `json:"state"`
 	Tags                  []Tags                                             `json:"tags"`
 	Templatedisplaytext   string                                             `json:"templatedisplaytext"`
 	Templateformat        string                                             `json:"templateformat"`
@@ -8034,12 +9557,13 @@ type RemoveNicFromVirtualMachineResponse struct {
 	Vgpu                  string                                             `json:"vgpu"`
 	Vgpuprofileid         string                                             `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                             `json:"vgpuprofilename"`
-	Videoram              int64                                              `json:"videoram"`
+	Videoram              FlexInt64                                          `json:"videoram"`
 	Vmtype                string                                             `json:"vmtype"`
 	Vnfdetails            map[string]string                                  `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                          `json:"vnfnics"`
 	Zoneid                string                                             `json:"zoneid"`
 	Zonename              string                                             `json:"zonename"`
+	Extra                 map[string]json.RawMessage                         `json:"-"`
 }
 
 type RemoveNicFromVirtualMachineResponseSecuritygroup struct {
@@ -8055,36 +9579,54 @@ type RemoveNicFromVirtualMachineResponseSecuritygroup struct {
 	Project             string                                                 `json:"project"`
 	Projectid           string                                                 `json:"projectid"`
 	Tags                []Tags                                                 `json:"tags"`
-	Virtualmachinecount int                                                    `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                                `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                          `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                             `json:"-"`
+}
+
+func (r *RemoveNicFromVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias RemoveNicFromVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveNicFromVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveNicFromVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias RemoveNicFromVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveNicFromVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveNicFromVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias RemoveNicFromVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *RemoveNicFromVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -8111,7 +9653,7 @@ func (r *RemoveNicFromVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RemoveNicFromVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetPasswordForVirtualMachineParams struct {
@@ -8132,6 +9674,45 @@ func (p *ResetPasswordForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resetPasswordForVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResetPasswordForVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resetPasswordForVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resetPasswordForVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResetPasswordForVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "password": 255},
+	}.validate("resetPasswordForVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resetPasswordForVirtualMachine API, before the request is signed
+func (p *ResetPasswordForVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResetPasswordForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResetPasswordForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resetPasswordForVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"id":       decodeParam[string],
+		"password": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResetPasswordForVirtualMachineParams) Clone() *ResetPasswordForVirtualMachineParams {
+	return &ResetPasswordForVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *ResetPasswordForVirtualMachineParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8185,32 +9766,39 @@ func (s *VirtualMachineService) NewResetPasswordForVirtualMachineParams(id strin
 
 // Resets the password for Instance. The Instance must be in a "Stopped" state and the Template must already support this feature for this command to take effect. [async]
 func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("resetPasswordForVirtualMachine", p.toURLValues())
+	return s.ResetPasswordForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ResetPasswordForVirtualMachineWithContext is like ResetPasswordForVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "resetPasswordForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetPasswordForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resetPasswordForVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "resetPasswordForVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("resetPasswordForVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -8218,6 +9806,25 @@ func (s *VirtualMachineService) ResetPasswordForVirtualMachine(p *ResetPasswordF
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ResetPasswordForVirtualMachine async job into r
+func (r *ResetPasswordForVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ResetPasswordForVirtualMachineAsync starts the async job of ResetPasswordForVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) ResetPasswordForVirtualMachineAsync(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*Job, error) {
+	r, err := s.ResetPasswordForVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("resetPasswordForVirtualMachine", r.JobID), nil
+}
+
 type ResetPasswordForVirtualMachineResponse struct {
 	Account               string                                                `json:"account"`
 	Affinitygroup         []ResetPasswordForVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -8229,32 +9836,32 @@ type ResetPasswordForVirtualMachineResponse struct {
 	Backupofferingname    string                                                `json:"backupofferingname"`
 	Bootmode              string                                                `json:"bootmode"`
 	Boottype              string                                                `json:"boottype"`
-	Cpunumber             int                                                   `json:"cpunumber"`
-	Cpuspeed              int                                                   `json:"cpuspeed"`
+	Cpunumber             FlexInt                                               `json:"cpunumber"`
+	Cpuspeed              FlexInt                                               `json:"cpuspeed"`
 	Cpuused               string                                                `json:"cpuused"`
-	Created               string                                                `json:"created"`
-	Deleteprotection      bool                                                  `json:"deleteprotection"`
+	Created               Time                                                  `json:"created"`
+	Deleteprotection      FlexBool                                              `json:"deleteprotection"`
 	Details               map[string]string                                     `json:"details"`
-	Diskioread            int64                                                 `json:"diskioread"`
-	Diskiowrite           int64                                                 `json:"diskiowrite"`
-	Diskkbsread           int64                                                 `json:"diskkbsread"`
-	Diskkbswrite          int64                                                 `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                             `json:"diskioread"`
+	Diskiowrite           FlexInt64                                             `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                             `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                             `json:"diskkbswrite"`
 	Diskofferingid        string                                                `json:"diskofferingid"`
 	Diskofferingname      string                                                `json:"diskofferingname"`
 	Displayname           string                                                `json:"displayname"`
-	Displayvm             bool                                                  `json:"displayvm"`
+	Displayvm             FlexBool                                              `json:"displayvm"`
 	Domain                string                                                `json:"domain"`
 	Domainid              string                                                `json:"domainid"`
 	Domainpath            string                                                `json:"domainpath"`
-	Forvirtualnetwork     bool                                                  `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                              `json:"forvirtualnetwork"`
 	Gpucardid             string                                                `json:"gpucardid"`
 	Gpucardname           string                                                `json:"gpucardname"`
-	Gpucount              int                                                   `json:"gpucount"`
+	Gpucount              FlexInt                                               `json:"gpucount"`
 	Group                 string                                                `json:"group"`
 	Groupid               string                                                `json:"groupid"`
 	Guestosid             string                                                `json:"guestosid"`
-	Haenable              bool                                                  `json:"haenable"`
-	Hasannotations        bool                                                  `json:"hasannotations"`
+	Haenable              FlexBool                                              `json:"haenable"`
+	Hasannotations        FlexBool                                              `json:"hasannotations"`
 	Hostcontrolstate      string                                                `json:"hostcontrolstate"`
 	Hostid                string                                                `json:"hostid"`
 	Hostname              string                                                `json:"hostname"`
@@ -8263,47 +9870,47 @@ type ResetPasswordForVirtualMachineResponse struct {
 	Id                    string                                                `json:"id"`
 	Instancename          string                                                `json:"instancename"`
 	Ipaddress             string                                                `json:"ipaddress"`
-	Isdynamicallyscalable bool                                                  `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                              `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                                `json:"isodisplaytext"`
 	Isoid                 string                                                `json:"isoid"`
 	Isoname               string                                                `json:"isoname"`
 	JobID                 string                                                `json:"jobid"`
-	Jobstatus             int                                                   `json:"jobstatus"`
+	Jobstatus             FlexInt                                               `json:"jobstatus"`
 	Keypairs              string                                                `json:"keypairs"`
-	Lastupdated           string                                                `json:"lastupdated"`
-	Leaseduration         int                                                   `json:"leaseduration"`
+	Lastupdated           Time                                                  `json:"lastupdated"`
+	Leaseduration         FlexInt                                               `json:"leaseduration"`
 	Leaseexpiryaction     string                                                `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                                `json:"leaseexpirydate"`
-	Maxheads              int64                                                 `json:"maxheads"`
-	Maxresolutionx        int64                                                 `json:"maxresolutionx"`
-	Maxresolutiony        int64                                                 `json:"maxresolutiony"`
-	Memory                int                                                   `json:"memory"`
-	Memoryintfreekbs      int64                                                 `json:"memoryintfreekbs"`
-	Memorykbs             int64                                                 `json:"memorykbs"`
-	Memorytargetkbs       int64                                                 `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                                  `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                             `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                             `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                             `json:"maxresolutiony"`
+	Memory                FlexInt                                               `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                             `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                             `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                             `json:"memorytargetkbs"`
 	Name                  string                                                `json:"name"`
-	Networkkbsread        int64                                                 `json:"networkkbsread"`
-	Networkkbswrite       int64                                                 `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                             `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                             `json:"networkkbswrite"`
 	Nic                   []Nic                                                 `json:"nic"`
 	Osdisplayname         string                                                `json:"osdisplayname"`
 	Ostypeid              string                                                `json:"ostypeid"`
 	Password              string                                                `json:"password"`
-	Passwordenabled       bool                                                  `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                              `json:"passwordenabled"`
 	Pooltype              string                                                `json:"pooltype"`
 	Project               string                                                `json:"project"`
 	Projectid             string                                                `json:"projectid"`
 	Publicip              string                                                `json:"publicip"`
 	Publicipid            string                                                `json:"publicipid"`
 	Readonlydetails       string                                                `json:"readonlydetails"`
-	Receivedbytes         int64                                                 `json:"receivedbytes"`
-	Rootdeviceid          int64                                                 `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                             `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                             `json:"rootdeviceid"`
 	Rootdevicetype        string                                                `json:"rootdevicetype"`
 	Securitygroup         []ResetPasswordForVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                                 `json:"sentbytes"`
+	Sentbytes             FlexInt64                                             `json:"sentbytes"`
 	Serviceofferingid     string                                                `json:"serviceofferingid"`
 	Serviceofferingname   string                                                `json:"serviceofferingname"`
 	Servicestate          string                                                `json:"servicestate"`
-	State                 string                                                `json:"state"`
+	State                 VirtualMachineState                                   `json:"state"`
 	Tags                  []Tags                                                `json:"tags"`
 	Templatedisplaytext   string                                                `json:"templatedisplaytext"`
 	Templateformat        string                                                `json:"templateformat"`
@@ -8320,12 +9927,13 @@ type ResetPasswordForVirtualMachineResponse struct {
 	Vgpu                  string                                                `json:"vgpu"`
 	Vgpuprofileid         string                                                `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                                `json:"vgpuprofilename"`
-	Videoram              int64                                                 `json:"videoram"`
+	Videoram              FlexInt64                                             `json:"videoram"`
 	Vmtype                string                                                `json:"vmtype"`
 	Vnfdetails            map[string]string                                     `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                             `json:"vnfnics"`
 	Zoneid                string                                                `json:"zoneid"`
 	Zonename              string                                                `json:"zonename"`
+	Extra                 map[string]json.RawMessage                            `json:"-"`
 }
 
 type ResetPasswordForVirtualMachineResponseSecuritygroup struct {
@@ -8341,36 +9949,54 @@ type ResetPasswordForVirtualMachineResponseSecuritygroup struct {
 	Project             string                                                    `json:"project"`
 	Projectid           string                                                    `json:"projectid"`
 	Tags                []Tags                                                    `json:"tags"`
-	Virtualmachinecount int                                                       `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                                   `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                             `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                                `json:"-"`
+}
+
+func (r *ResetPasswordForVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias ResetPasswordForVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetPasswordForVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetPasswordForVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias ResetPasswordForVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetPasswordForVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetPasswordForVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias ResetPasswordForVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *ResetPasswordForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -8397,7 +10023,7 @@ func (r *ResetPasswordForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ResetPasswordForVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetUserDataForVirtualMachineParams struct {
@@ -8436,6 +10062,50 @@ func (p *ResetUserDataForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resetUserDataForVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResetUserDataForVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resetUserDataForVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resetUserDataForVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResetUserDataForVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "projectid": 255, "userdata": 1048576, "userdataid": 255},
+	}.validate("resetUserDataForVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resetUserDataForVirtualMachine API, before the request is signed
+func (p *ResetUserDataForVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResetUserDataForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResetUserDataForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resetUserDataForVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"domainid":        decodeParam[string],
+		"id":              decodeParam[string],
+		"projectid":       decodeParam[string],
+		"userdata":        decodeParam[string],
+		"userdatadetails": decodeParam[map[string]string],
+		"userdataid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResetUserDataForVirtualMachineParams) Clone() *ResetUserDataForVirtualMachineParams {
+	return &ResetUserDataForVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *ResetUserDataForVirtualMachineParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8594,13 +10264,22 @@ func (s *VirtualMachineService) NewResetUserDataForVirtualMachineParams(id strin
 
 // Resets the UserData for Instance. The Instance must be in a "Stopped" state.
 func (s *VirtualMachineService) ResetUserDataForVirtualMachine(p *ResetUserDataForVirtualMachineParams) (*ResetUserDataForVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("resetUserDataForVirtualMachine", p.toURLValues())
+	return s.ResetUserDataForVirtualMachineWithContext(context.Background(), p)
+}
+
+// ResetUserDataForVirtualMachineWithContext is like ResetUserDataForVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ResetUserDataForVirtualMachineWithContext(ctx context.Context, p *ResetUserDataForVirtualMachineParams) (*ResetUserDataForVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "resetUserDataForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResetUserDataForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resetUserDataForVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -8618,32 +10297,32 @@ type ResetUserDataForVirtualMachineResponse struct {
 	Backupofferingname    string                                                `json:"backupofferingname"`
 	Bootmode              string                                                `json:"bootmode"`
 	Boottype              string                                                `json:"boottype"`
-	Cpunumber             int                                                   `json:"cpunumber"`
-	Cpuspeed              int                                                   `json:"cpuspeed"`
+	Cpunumber             FlexInt                                               `json:"cpunumber"`
+	Cpuspeed              FlexInt                                               `json:"cpuspeed"`
 	Cpuused               string                                                `json:"cpuused"`
-	Created               string                                                `json:"created"`
-	Deleteprotection      bool                                                  `json:"deleteprotection"`
+	Created               Time                                                  `json:"created"`
+	Deleteprotection      FlexBool                                              `json:"deleteprotection"`
 	Details               map[string]string                                     `json:"details"`
-	Diskioread            int64                                                 `json:"diskioread"`
-	Diskiowrite           int64                                                 `json:"diskiowrite"`
-	Diskkbsread           int64                                                 `json:"diskkbsread"`
-	Diskkbswrite          int64                                                 `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                             `json:"diskioread"`
+	Diskiowrite           FlexInt64                                             `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                             `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                             `json:"diskkbswrite"`
 	Diskofferingid        string                                                `json:"diskofferingid"`
 	Diskofferingname      string                                                `json:"diskofferingname"`
 	Displayname           string                                                `json:"displayname"`
-	Displayvm             bool                                                  `json:"displayvm"`
+	Displayvm             FlexBool                                              `json:"displayvm"`
 	Domain                string                                                `json:"domain"`
 	Domainid              string                                                `json:"domainid"`
 	Domainpath            string                                                `json:"domainpath"`
-	Forvirtualnetwork     bool                                                  `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                              `json:"forvirtualnetwork"`
 	Gpucardid             string                                                `json:"gpucardid"`
 	Gpucardname           string                                                `json:"gpucardname"`
-	Gpucount              int                                                   `json:"gpucount"`
+	Gpucount              FlexInt                                               `json:"gpucount"`
 	Group                 string                                                `json:"group"`
 	Groupid               string                                                `json:"groupid"`
 	Guestosid             string                                                `json:"guestosid"`
-	Haenable              bool                                                  `json:"haenable"`
-	Hasannotations        bool                                                  `json:"hasannotations"`
+	Haenable              FlexBool                                              `json:"haenable"`
+	Hasannotations        FlexBool                                              `json:"hasannotations"`
 	Hostcontrolstate      string                                                `json:"hostcontrolstate"`
 	Hostid                string                                                `json:"hostid"`
 	Hostname              string                                                `json:"hostname"`
@@ -8652,47 +10331,47 @@ type ResetUserDataForVirtualMachineResponse struct {
 	Id                    string                                                `json:"id"`
 	Instancename          string                                                `json:"instancename"`
 	Ipaddress             string                                                `json:"ipaddress"`
-	Isdynamicallyscalable bool                                                  `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                              `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                                `json:"isodisplaytext"`
 	Isoid                 string                                                `json:"isoid"`
 	Isoname               string                                                `json:"isoname"`
 	JobID                 string                                                `json:"jobid"`
-	Jobstatus             int                                                   `json:"jobstatus"`
+	Jobstatus             FlexInt                                               `json:"jobstatus"`
 	Keypairs              string                                                `json:"keypairs"`
-	Lastupdated           string                                                `json:"lastupdated"`
-	Leaseduration         int                                                   `json:"leaseduration"`
+	Lastupdated           Time                                                  `json:"lastupdated"`
+	Leaseduration         FlexInt                                               `json:"leaseduration"`
 	Leaseexpiryaction     string                                                `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                                `json:"leaseexpirydate"`
-	Maxheads              int64                                                 `json:"maxheads"`
-	Maxresolutionx        int64                                                 `json:"maxresolutionx"`
-	Maxresolutiony        int64                                                 `json:"maxresolutiony"`
-	Memory                int                                                   `json:"memory"`
-	Memoryintfreekbs      int64                                                 `json:"memoryintfreekbs"`
-	Memorykbs             int64                                                 `json:"memorykbs"`
-	Memorytargetkbs       int64                                                 `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                                  `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                             `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                             `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                             `json:"maxresolutiony"`
+	Memory                FlexInt                                               `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                             `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                             `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                             `json:"memorytargetkbs"`
 	Name                  string                                                `json:"name"`
-	Networkkbsread        int64                                                 `json:"networkkbsread"`
-	Networkkbswrite       int64                                                 `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                             `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                             `json:"networkkbswrite"`
 	Nic                   []Nic                                                 `json:"nic"`
 	Osdisplayname         string                                                `json:"osdisplayname"`
 	Ostypeid              string                                                `json:"ostypeid"`
 	Password              string                                                `json:"password"`
-	Passwordenabled       bool                                                  `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                              `json:"passwordenabled"`
 	Pooltype              string                                                `json:"pooltype"`
 	Project               string                                                `json:"project"`
 	Projectid             string                                                `json:"projectid"`
 	Publicip              string                                                `json:"publicip"`
 	Publicipid            string                                                `json:"publicipid"`
 	Readonlydetails       string                                                `json:"readonlydetails"`
-	Receivedbytes         int64                                                 `json:"receivedbytes"`
-	Rootdeviceid          int64                                                 `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                             `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                             `json:"rootdeviceid"`
 	Rootdevicetype        string                                                `json:"rootdevicetype"`
 	Securitygroup         []ResetUserDataForVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                                 `json:"sentbytes"`
+	Sentbytes             FlexInt64                                             `json:"sentbytes"`
 	Serviceofferingid     string                                                `json:"serviceofferingid"`
 	Serviceofferingname   string                                                `json:"serviceofferingname"`
 	Servicestate          string                                                `json:"servicestate"`
-	State                 string                                                `json:"state"`
+	State                 VirtualMachineState                                   `json:"state"`
 	Tags                  []Tags                                                `json:"tags"`
 	Templatedisplaytext   string                                                `json:"templatedisplaytext"`
 	Templateformat        string                                                `json:"templateformat"`
@@ -8709,12 +10388,13 @@ type ResetUserDataForVirtualMachineResponse struct {
 	Vgpu                  string                                                `json:"vgpu"`
 	Vgpuprofileid         string                                                `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                                `json:"vgpuprofilename"`
-	Videoram              int64                                                 `json:"videoram"`
+	Videoram              FlexInt64                                             `json:"videoram"`
 	Vmtype                string                                                `json:"vmtype"`
 	Vnfdetails            map[string]string                                     `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                             `json:"vnfnics"`
 	Zoneid                string                                                `json:"zoneid"`
 	Zonename              string                                                `json:"zonename"`
+	Extra                 map[string]json.RawMessage                            `json:"-"`
 }
 
 type ResetUserDataForVirtualMachineResponseSecuritygroup struct {
@@ -8730,36 +10410,54 @@ type ResetUserDataForVirtualMachineResponseSecuritygroup struct {
 	Project             string                                                    `json:"project"`
 	Projectid           string                                                    `json:"projectid"`
 	Tags                []Tags                                                    `json:"tags"`
-	Virtualmachinecount int                                                       `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                                   `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                             `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                                `json:"-"`
+}
+
+func (r *ResetUserDataForVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias ResetUserDataForVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetUserDataForVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetUserDataForVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias ResetUserDataForVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResetUserDataForVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResetUserDataForVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias ResetUserDataForVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *ResetUserDataForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -8786,7 +10484,7 @@ func (r *ResetUserDataForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ResetUserDataForVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestoreVirtualMachineParams struct {
@@ -8824,6 +10522,49 @@ func (p *RestoreVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the restoreVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RestoreVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("restoreVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the restoreVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RestoreVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"diskofferingid": 255, "templateid": 255, "virtualmachineid": 255},
+	}.validate("restoreVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the restoreVirtualMachine API, before the request is signed
+func (p *RestoreVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RestoreVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RestoreVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("restoreVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"details":          decodeParam[map[string]string],
+		"diskofferingid":   decodeParam[string],
+		"expunge":          decodeParam[bool],
+		"rootdisksize":     decodeParam[int64],
+		"templateid":       decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RestoreVirtualMachineParams) Clone() *RestoreVirtualMachineParams {
+	return &RestoreVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *RestoreVirtualMachineParams) SetDetails(v map[string]string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -8961,32 +10702,39 @@ func (s *VirtualMachineService) NewRestoreVirtualMachineParams(virtualmachineid
 
 // Restore an Instance to original Template/ISO or new Template/ISO
 func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("restoreVirtualMachine", p.toURLValues())
+	return s.RestoreVirtualMachineWithContext(context.Background(), p)
+}
+
+// RestoreVirtualMachineWithContext is like RestoreVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "restoreVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RestoreVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("restoreVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "restoreVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("restoreVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -8994,6 +10742,25 @@ func (s *VirtualMachineService) RestoreVirtualMachine(p *RestoreVirtualMachinePa
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RestoreVirtualMachine async job into r
+func (r *RestoreVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// RestoreVirtualMachineAsync starts the async job of RestoreVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) RestoreVirtualMachineAsync(ctx context.Context, p *RestoreVirtualMachineParams) (*Job, error) {
+	r, err := s.RestoreVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("restoreVirtualMachine", r.JobID), nil
+}
+
 type RestoreVirtualMachineResponse struct {
 	Account               string                                       `json:"account"`
 	Affinitygroup         []RestoreVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -9005,32 +10772,32 @@ type RestoreVirtualMachineResponse struct {
 	Backupofferingname    string                                       `json:"backupofferingname"`
 	Bootmode              string                                       `json:"bootmode"`
 	Boottype              string                                       `json:"boottype"`
-	Cpunumber             int                                          `json:"cpunumber"`
-	Cpuspeed              int                                          `json:"cpuspeed"`
+	Cpunumber             FlexInt                                      `json:"cpunumber"`
+	Cpuspeed              FlexInt                                      `json:"cpuspeed"`
 	Cpuused               string                                       `json:"cpuused"`
-	Created               string                                       `json:"created"`
-	Deleteprotection      bool                                         `json:"deleteprotection"`
+	Created               Time                                         `json:"created"`
+	Deleteprotection      FlexBool                                     `json:"deleteprotection"`
 	Details               map[string]string                            `json:"details"`
-	Diskioread            int64                                        `json:"diskioread"`
-	Diskiowrite           int64                                        `json:"diskiowrite"`
-	Diskkbsread           int64                                        `json:"diskkbsread"`
-	Diskkbswrite          int64                                        `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                    `json:"diskioread"`
+	Diskiowrite           FlexInt64                                    `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                    `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                    `json:"diskkbswrite"`
 	Diskofferingid        string                                       `json:"diskofferingid"`
 	Diskofferingname      string                                       `json:"diskofferingname"`
 	Displayname           string                                       `json:"displayname"`
-	Displayvm             bool                                         `json:"displayvm"`
+	Displayvm             FlexBool                                     `json:"displayvm"`
 	Domain                string                                       `json:"domain"`
 	Domainid              string                                       `json:"domainid"`
 	Domainpath            string                                       `json:"domainpath"`
-	Forvirtualnetwork     bool                                         `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                     `json:"forvirtualnetwork"`
 	Gpucardid             string                                       `json:"gpucardid"`
 	Gpucardname           string                                       `json:"gpucardname"`
-	Gpucount              int                                          `json:"gpucount"`
+	Gpucount              FlexInt                                      `json:"gpucount"`
 	Group                 string                                       `json:"group"`
 	Groupid               string                                       `json:"groupid"`
 	Guestosid             string                                       `json:"guestosid"`
-	Haenable              bool                                         `json:"haenable"`
-	Hasannotations        bool                                         `json:"hasannotations"`
+	Haenable              FlexBool                                     `json:"haenable"`
+	Hasannotations        FlexBool                                     `json:"hasannotations"`
 	Hostcontrolstate      string                                       `json:"hostcontrolstate"`
 	Hostid                string                                       `json:"hostid"`
 	Hostname              string                                       `json:"hostname"`
@@ -9039,47 +10806,47 @@ type RestoreVirtualMachineResponse struct {
 	Id                    string                                       `json:"id"`
 	Instancename          string                                       `json:"instancename"`
 	Ipaddress             string                                       `json:"ipaddress"`
-	Isdynamicallyscalable bool                                         `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                     `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                       `json:"isodisplaytext"`
 	Isoid                 string                                       `json:"isoid"`
 	Isoname               string                                       `json:"isoname"`
 	JobID                 string                                       `json:"jobid"`
-	Jobstatus             int                                          `json:"jobstatus"`
+	Jobstatus             FlexInt                                      `json:"jobstatus"`
 	Keypairs              string                                       `json:"keypairs"`
-	Lastupdated           string                                       `json:"lastupdated"`
-	Leaseduration         int                                          `json:"leaseduration"`
+	Lastupdated           Time                                         `json:"lastupdated"`
+	Leaseduration         FlexInt                                      `json:"leaseduration"`
 	Leaseexpiryaction     string                                       `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                       `json:"leaseexpirydate"`
-	Maxheads              int64                                        `json:"maxheads"`
-	Maxresolutionx        int64                                        `json:"maxresolutionx"`
-	Maxresolutiony        int64                                        `json:"maxresolutiony"`
-	Memory                int                                          `json:"memory"`
-	Memoryintfreekbs      int64                                        `json:"memoryintfreekbs"`
-	Memorykbs             int64                                        `json:"memorykbs"`
-	Memorytargetkbs       int64                                        `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                         `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                    `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                    `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                    `json:"maxresolutiony"`
+	Memory                FlexInt                                      `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                    `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                    `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                    `json:"memorytargetkbs"`
 	Name                  string                                       `json:"name"`
-	Networkkbsread        int64                                        `json:"networkkbsread"`
-	Networkkbswrite       int64                                        `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                    `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                    `json:"networkkbswrite"`
 	Nic                   []Nic                                        `json:"nic"`
 	Osdisplayname         string                                       `json:"osdisplayname"`
 	Ostypeid              string                                       `json:"ostypeid"`
 	Password              string                                       `json:"password"`
-	Passwordenabled       bool                                         `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                     `json:"passwordenabled"`
 	Pooltype              string                                       `json:"pooltype"`
 	Project               string                                       `json:"project"`
 	Projectid             string                                       `json:"projectid"`
 	Publicip              string                                       `json:"publicip"`
 	Publicipid            string                                       `json:"publicipid"`
 	Readonlydetails       string                                       `json:"readonlydetails"`
-	Receivedbytes         int64                                        `json:"receivedbytes"`
-	Rootdeviceid          int64                                        `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                    `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                    `json:"rootdeviceid"`
 	Rootdevicetype        string                                       `json:"rootdevicetype"`
 	Securitygroup         []RestoreVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                        `json:"sentbytes"`
+	Sentbytes             FlexInt64                                    `json:"sentbytes"`
 	Serviceofferingid     string                                       `json:"serviceofferingid"`
 	Serviceofferingname   string                                       `json:"serviceofferingname"`
 	Servicestate          string                                       `json:"servicestate"`
-	State                 string                                       `json:"state"`
+	State                 VirtualMachineState                          `json:"state"`
 	Tags                  []Tags                                       `json:"tags"`
 	Templatedisplaytext   string                                       `json:"templatedisplaytext"`
 	Templateformat        string                                       `json:"templateformat"`
@@ -9096,12 +10863,13 @@ type RestoreVirtualMachineResponse struct {
 	Vgpu                  string                                       `json:"vgpu"`
 	Vgpuprofileid         string                                       `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                       `json:"vgpuprofilename"`
-	Videoram              int64                                        `json:"videoram"`
+	Videoram              FlexInt64                                    `json:"videoram"`
 	Vmtype                string                                       `json:"vmtype"`
 	Vnfdetails            map[string]string                            `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                    `json:"vnfnics"`
 	Zoneid                string                                       `json:"zoneid"`
 	Zonename              string                                       `json:"zonename"`
+	Extra                 map[string]json.RawMessage                   `json:"-"`
 }
 
 type RestoreVirtualMachineResponseSecuritygroup struct {
@@ -9117,36 +10885,54 @@ type RestoreVirtualMachineResponseSecuritygroup struct {
 	Project             string                                           `json:"project"`
 	Projectid           string                                           `json:"projectid"`
 	Tags                []Tags                                           `json:"tags"`
-	Virtualmachinecount int                                              `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                          `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                    `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                       `json:"-"`
+}
+
+func (r *RestoreVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias RestoreVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestoreVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RestoreVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias RestoreVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestoreVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RestoreVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias RestoreVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *RestoreVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -9173,7 +10959,7 @@ func (r *RestoreVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RestoreVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ScaleVirtualMachineParams struct {
@@ -9216,6 +11002,50 @@ func (p *ScaleVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the scaleVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ScaleVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("scaleVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the scaleVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ScaleVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "serviceofferingid"},
+		lengths:  map[string]int{"id": 255, "serviceofferingid": 255},
+	}.validate("scaleVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the scaleVirtualMachine API, before the request is signed
+func (p *ScaleVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ScaleVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ScaleVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("scaleVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"automigrate":       decodeParam[bool],
+		"details":           decodeParam[map[string]string],
+		"id":                decodeParam[string],
+		"maxiops":           decodeParam[int64],
+		"miniops":           decodeParam[int64],
+		"serviceofferingid": decodeParam[string],
+		"shrinkok":          decodeParam[bool],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ScaleVirtualMachineParams) Clone() *ScaleVirtualMachineParams {
+	return &ScaleVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *ScaleVirtualMachineParams) SetAutomigrate(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -9375,27 +11205,39 @@ func (s *VirtualMachineService) NewScaleVirtualMachineParams(id string, serviceo
 
 // Scales the Instance to a new service offering. This command also considers the volume size in the service offering or disk offering linked to the new service offering and apply all characteristics to the root volume.
 func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("scaleVirtualMachine", p.toURLValues())
+	return s.ScaleVirtualMachineWithContext(context.Background(), p)
+}
+
+// ScaleVirtualMachineWithContext is like ScaleVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "scaleVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ScaleVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("scaleVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "scaleVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("scaleVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -9403,11 +11245,31 @@ func (s *VirtualMachineService) ScaleVirtualMachine(p *ScaleVirtualMachineParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ScaleVirtualMachine async job into r
+func (r *ScaleVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ScaleVirtualMachineAsync starts the async job of ScaleVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) ScaleVirtualMachineAsync(ctx context.Context, p *ScaleVirtualMachineParams) (*Job, error) {
+	r, err := s.ScaleVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("scaleVirtualMachine", r.JobID), nil
+}
+
 type ScaleVirtualMachineResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ScaleVirtualMachineResponse) UnmarshalJSON(b []byte) error {
+	type alias ScaleVirtualMachineResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartVirtualMachineParams struct {
@@ -9445,6 +11307,50 @@ func (p *StartVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the startVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StartVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("startVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the startVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StartVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"clusterid": 255, "deploymentplanner": 255, "hostid": 255, "id": 255, "podid": 255},
+	}.validate("startVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the startVirtualMachine API, before the request is signed
+func (p *StartVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StartVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StartVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("startVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"bootintosetup":     decodeParam[bool],
+		"clusterid":         decodeParam[string],
+		"considerlasthost":  decodeParam[bool],
+		"deploymentplanner": decodeParam[string],
+		"hostid":            decodeParam[string],
+		"id":                decodeParam[string],
+		"podid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StartVirtualMachineParams) Clone() *StartVirtualMachineParams {
+	return &StartVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *StartVirtualMachineParams) SetBootintosetup(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -9603,32 +11509,39 @@ func (s *VirtualMachineService) NewStartVirtualMachineParams(id string) *StartVi
 
 // Starts  an Instance.
 func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("startVirtualMachine", p.toURLValues())
+	return s.StartVirtualMachineWithContext(context.Background(), p)
+}
+
+// StartVirtualMachineWithContext is like StartVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "startVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StartVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("startVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "startVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("startVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -9636,6 +11549,25 @@ func (s *VirtualMachineService) StartVirtualMachine(p *StartVirtualMachineParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StartVirtualMachine async job into r
+func (r *StartVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StartVirtualMachineAsync starts the async job of StartVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) StartVirtualMachineAsync(ctx context.Context, p *StartVirtualMachineParams) (*Job, error) {
+	r, err := s.StartVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("startVirtualMachine", r.JobID), nil
+}
+
 type StartVirtualMachineResponse struct {
 	Account               string                                     `json:"account"`
 	Affinitygroup         []StartVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -9647,32 +11579,32 @@ type StartVirtualMachineResponse struct {
 	Backupofferingname    string                                     `json:"backupofferingname"`
 	Bootmode              string                                     `json:"bootmode"`
 	Boottype              string                                     `json:"boottype"`
-	Cpunumber             int                                        `json:"cpunumber"`
-	Cpuspeed              int                                        `json:"cpuspeed"`
+	Cpunumber             FlexInt                                    `json:"cpunumber"`
+	Cpuspeed              FlexInt                                    `json:"cpuspeed"`
 	Cpuused               string                                     `json:"cpuused"`
-	Created               string                                     `json:"created"`
-	Deleteprotection      bool                                       `json:"deleteprotection"`
+	Created               Time                                       `json:"created"`
+	Deleteprotection      FlexBool                                   `json:"deleteprotection"`
 	Details               map[string]string                          `json:"details"`
-	Diskioread            int64                                      `json:"diskioread"`
-	Diskiowrite           int64                                      `json:"diskiowrite"`
-	Diskkbsread           int64                                      `json:"diskkbsread"`
-	Diskkbswrite          int64                                      `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                  `json:"diskioread"`
+	Diskiowrite           FlexInt64                                  `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                  `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                  `json:"diskkbswrite"`
 	Diskofferingid        string                                     `json:"diskofferingid"`
 	Diskofferingname      string                                     `json:"diskofferingname"`
 	Displayname           string                                     `json:"displayname"`
-	Displayvm             bool                                       `json:"displayvm"`
+	Displayvm             FlexBool                                   `json:"displayvm"`
 	Domain                string                                     `json:"domain"`
 	Domainid              string                                     `json:"domainid"`
 	Domainpath            string                                     `json:"domainpath"`
-	Forvirtualnetwork     bool                                       `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                   `json:"forvirtualnetwork"`
 	Gpucardid             string                                     `json:"gpucardid"`
 	Gpucardname           string                                     `json:"gpucardname"`
-	Gpucount              int                                        `json:"gpucount"`
+	Gpucount              FlexInt                                    `json:"gpucount"`
 	Group                 string                                     `json:"group"`
 	Groupid               string                                     `json:"groupid"`
 	Guestosid             string                                     `json:"guestosid"`
-	Haenable              bool                                       `json:"haenable"`
-	Hasannotations        bool                                       `json:"hasannotations"`
+	Haenable              FlexBool                                   `json:"haenable"`
+	Hasannotations        FlexBool                                   `json:"hasannotations"`
 	Hostcontrolstate      string                                     `json:"hostcontrolstate"`
 	Hostid                string                                     `json:"hostid"`
 	Hostname              string                                     `json:"hostname"`
@@ -9681,47 +11613,47 @@ type StartVirtualMachineResponse struct {
 	Id                    string                                     `json:"id"`
 	Instancename          string                                     `json:"instancename"`
 	Ipaddress             string                                     `json:"ipaddress"`
-	Isdynamicallyscalable bool                                       `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                   `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                     `json:"isodisplaytext"`
 	Isoid                 string                                     `json:"isoid"`
 	Isoname               string                                     `json:"isoname"`
 	JobID                 string                                     `json:"jobid"`
-	Jobstatus             int                                        `json:"jobstatus"`
+	Jobstatus             FlexInt                                    `json:"jobstatus"`
 	Keypairs              string                                     `json:"keypairs"`
-	Lastupdated           string                                     `json:"lastupdated"`
-	Leaseduration         int                                        `json:"leaseduration"`
+	Lastupdated           Time                                       `json:"lastupdated"`
+	Leaseduration         FlexInt                                    `json:"leaseduration"`
 	Leaseexpiryaction     string                                     `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                     `json:"leaseexpirydate"`
-	Maxheads              int64                                      `json:"maxheads"`
-	Maxresolutionx        int64                                      `json:"maxresolutionx"`
-	Maxresolutiony        int64                                      `json:"maxresolutiony"`
-	Memory                int                                        `json:"memory"`
-	Memoryintfreekbs      int64                                      `json:"memoryintfreekbs"`
-	Memorykbs             int64                                      `json:"memorykbs"`
-	Memorytargetkbs       int64                                      `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                       `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                  `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                  `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                  `json:"maxresolutiony"`
+	Memory                FlexInt                                    `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                  `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                  `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                  `json:"memorytargetkbs"`
 	Name                  string                                     `json:"name"`
-	Networkkbsread        int64                                      `json:"networkkbsread"`
-	Networkkbswrite       int64                                      `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                  `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                  `json:"networkkbswrite"`
 	Nic                   []Nic                                      `json:"nic"`
 	Osdisplayname         string                                     `json:"osdisplayname"`
 	Ostypeid              string                                     `json:"ostypeid"`
 	Password              string                                     `json:"password"`
-	Passwordenabled       bool                                       `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                   `json:"passwordenabled"`
 	Pooltype              string                                     `json:"pooltype"`
 	Project               string                                     `json:"project"`
 	Projectid             string                                     `json:"projectid"`
 	Publicip              string                                     `json:"publicip"`
 	Publicipid            string                                     `json:"publicipid"`
 	Readonlydetails       string                                     `json:"readonlydetails"`
-	Receivedbytes         int64                                      `json:"receivedbytes"`
-	Rootdeviceid          int64                                      `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                  `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                  `json:"rootdeviceid"`
 	Rootdevicetype        string                                     `json:"rootdevicetype"`
 	Securitygroup         []StartVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                      `json:"sentbytes"`
+	Sentbytes             FlexInt64                                  `json:"sentbytes"`
 	Serviceofferingid     string                                     `json:"serviceofferingid"`
 	Serviceofferingname   string                                     `json:"serviceofferingname"`
 	Servicestate          string                                     `json:"servicestate"`
-	State                 string                                     `json:"state"`
+	State                 VirtualMachineState                        `json:"state"`
 	Tags                  []Tags                                     `json:"tags"`
 	Templatedisplaytext   string                                     `json:"templatedisplaytext"`
 	Templateformat        string                                     `json:"templateformat"`
@@ -9738,12 +11670,13 @@ type StartVirtualMachineResponse struct {
 	Vgpu                  string                                     `json:"vgpu"`
 	Vgpuprofileid         string                                     `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                     `json:"vgpuprofilename"`
-	Videoram              int64                                      `json:"videoram"`
+	Videoram              FlexInt64                                  `json:"videoram"`
 	Vmtype                string                                     `json:"vmtype"`
 	Vnfdetails            map[string]string                          `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                  `json:"vnfnics"`
 	Zoneid                string                                     `json:"zoneid"`
 	Zonename              string                                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage                 `json:"-"`
 }
 
 type StartVirtualMachineResponseSecuritygroup struct {
@@ -9759,36 +11692,54 @@ type StartVirtualMachineResponseSecuritygroup struct {
 	Project             string                                         `json:"project"`
 	Projectid           string                                         `json:"projectid"`
 	Tags                []Tags                                         `json:"tags"`
-	Virtualmachinecount int                                            `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                        `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                  `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                     `json:"-"`
+}
+
+func (r *StartVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias StartVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias StartVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StartVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StartVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias StartVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *StartVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -9815,7 +11766,7 @@ func (r *StartVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias StartVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopVirtualMachineParams struct {
@@ -9837,6 +11788,45 @@ func (p *StopVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the stopVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *StopVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("stopVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the stopVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *StopVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("stopVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the stopVirtualMachine API, before the request is signed
+func (p *StopVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *StopVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *StopVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("stopVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *StopVirtualMachineParams) Clone() *StopVirtualMachineParams {
+	return &StopVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *StopVirtualMachineParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -9890,32 +11880,39 @@ func (s *VirtualMachineService) NewStopVirtualMachineParams(id string) *StopVirt
 
 // Stops  an Instance.
 func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("stopVirtualMachine", p.toURLValues())
+	return s.StopVirtualMachineWithContext(context.Background(), p)
+}
+
+// StopVirtualMachineWithContext is like StopVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "stopVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r StopVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("stopVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "stopVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("stopVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -9923,6 +11920,25 @@ func (s *VirtualMachineService) StopVirtualMachine(p *StopVirtualMachineParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished StopVirtualMachine async job into r
+func (r *StopVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// StopVirtualMachineAsync starts the async job of StopVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) StopVirtualMachineAsync(ctx context.Context, p *StopVirtualMachineParams) (*Job, error) {
+	r, err := s.StopVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("stopVirtualMachine", r.JobID), nil
+}
+
 type StopVirtualMachineResponse struct {
 	Account               string                                    `json:"account"`
 	Affinitygroup         []StopVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -9934,32 +11950,32 @@ type StopVirtualMachineResponse struct {
 	Backupofferingname    string                                    `json:"backupofferingname"`
 	Bootmode              string                                    `json:"bootmode"`
 	Boottype              string                                    `json:"boottype"`
-	Cpunumber             int                                       `json:"cpunumber"`
-	Cpuspeed              int                                       `json:"cpuspeed"`
+	Cpunumber             FlexInt                                   `json:"cpunumber"`
+	Cpuspeed              FlexInt                                   `json:"cpuspeed"`
 	Cpuused               string                                    `json:"cpuused"`
-	Created               string                                    `json:"created"`
-	Deleteprotection      bool                                      `json:"deleteprotection"`
+	Created               Time                                      `json:"created"`
+	Deleteprotection      FlexBool                                  `json:"deleteprotection"`
 	Details               map[string]string                         `json:"details"`
-	Diskioread            int64                                     `json:"diskioread"`
-	Diskiowrite           int64                                     `json:"diskiowrite"`
-	Diskkbsread           int64                                     `json:"diskkbsread"`
-	Diskkbswrite          int64                                     `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                 `json:"diskioread"`
+	Diskiowrite           FlexInt64                                 `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                 `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                 `json:"diskkbswrite"`
 	Diskofferingid        string                                    `json:"diskofferingid"`
 	Diskofferingname      string                                    `json:"diskofferingname"`
 	Displayname           string                                    `json:"displayname"`
-	Displayvm             bool                                      `json:"displayvm"`
+	Displayvm             FlexBool                                  `json:"displayvm"`
 	Domain                string                                    `json:"domain"`
 	Domainid              string                                    `json:"domainid"`
 	Domainpath            string                                    `json:"domainpath"`
-	Forvirtualnetwork     bool                                      `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                  `json:"forvirtualnetwork"`
 	Gpucardid             string                                    `json:"gpucardid"`
 	Gpucardname           string                                    `json:"gpucardname"`
-	Gpucount              int                                       `json:"gpucount"`
+	Gpucount              FlexInt                                   `json:"gpucount"`
 	Group                 string                                    `json:"group"`
 	Groupid               string                                    `json:"groupid"`
 	Guestosid             string                                    `json:"guestosid"`
-	Haenable              bool                                      `json:"haenable"`
-	Hasannotations        bool                                      `json:"hasannotations"`
+	Haenable              FlexBool                                  `json:"haenable"`
+	Hasannotations        FlexBool                                  `json:"hasannotations"`
 	Hostcontrolstate      string                                    `json:"hostcontrolstate"`
 	Hostid                string                                    `json:"hostid"`
 	Hostname              string                                    `json:"hostname"`
@@ -9968,47 +11984,47 @@ type StopVirtualMachineResponse struct {
 	Id                    string                                    `json:"id"`
 	Instancename          string                                    `json:"instancename"`
 	Ipaddress             string                                    `json:"ipaddress"`
-	Isdynamicallyscalable bool                                      `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                  `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                    `json:"isodisplaytext"`
 	Isoid                 string                                    `json:"isoid"`
 	Isoname               string                                    `json:"isoname"`
 	JobID                 string                                    `json:"jobid"`
-	Jobstatus             int                                       `json:"jobstatus"`
+	Jobstatus             FlexInt                                   `json:"jobstatus"`
 	Keypairs              string                                    `json:"keypairs"`
-	Lastupdated           string                                    `json:"lastupdated"`
-	Leaseduration         int                                       `json:"leaseduration"`
+	Lastupdated           Time                                      `json:"lastupdated"`
+	Leaseduration         FlexInt                                   `json:"leaseduration"`
 	Leaseexpiryaction     string                                    `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                    `json:"leaseexpirydate"`
-	Maxheads              int64                                     `json:"maxheads"`
-	Maxresolutionx        int64                                     `json:"maxresolutionx"`
-	Maxresolutiony        int64                                     `json:"maxresolutiony"`
-	Memory                int                                       `json:"memory"`
-	Memoryintfreekbs      int64                                     `json:"memoryintfreekbs"`
-	Memorykbs             int64                                     `json:"memorykbs"`
-	Memorytargetkbs       int64                                     `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                      `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                 `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                 `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                 `json:"maxresolutiony"`
+	Memory                FlexInt                                   `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                 `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                 `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                 `json:"memorytargetkbs"`
 	Name                  string                                    `json:"name"`
-	Networkkbsread        int64                                     `json:"networkkbsread"`
-	Networkkbswrite       int64                                     `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                 `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                 `json:"networkkbswrite"`
 	Nic                   []Nic                                     `json:"nic"`
 	Osdisplayname         string                                    `json:"osdisplayname"`
 	Ostypeid              string                                    `json:"ostypeid"`
 	Password              string                                    `json:"password"`
-	Passwordenabled       bool                                      `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                  `json:"passwordenabled"`
 	Pooltype              string                                    `json:"pooltype"`
 	Project               string                                    `json:"project"`
 	Projectid             string                                    `json:"projectid"`
 	Publicip              string                                    `json:"publicip"`
 	Publicipid            string                                    `json:"publicipid"`
 	Readonlydetails       string                                    `json:"readonlydetails"`
-	Receivedbytes         int64                                     `json:"receivedbytes"`
-	Rootdeviceid          int64                                     `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                 `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                 `json:"rootdeviceid"`
 	Rootdevicetype        string                                    `json:"rootdevicetype"`
 	Securitygroup         []StopVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                     `json:"sentbytes"`
+	Sentbytes             FlexInt64                                 `json:"sentbytes"`
 	Serviceofferingid     string                                    `json:"serviceofferingid"`
 	Serviceofferingname   string                                    `json:"serviceofferingname"`
 	Servicestate          string                                    `json:"servicestate"`
-	State                 string                                    `json:"state"`
+	State                 VirtualMachineState                       `json:"state"`
 	Tags                  []Tags                                    `json:"tags"`
 	Templatedisplaytext   string                                    `json:"templatedisplaytext"`
 	Templateformat        string                                    `json:"templateformat"`
@@ -10025,12 +12041,13 @@ type StopVirtualMachineResponse struct {
 	Vgpu                  string                                    `json:"vgpu"`
 	Vgpuprofileid         string                                    `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                    `json:"vgpuprofilename"`
-	Videoram              int64                                     `json:"videoram"`
+	Videoram              FlexInt64                                 `json:"videoram"`
 	Vmtype                string                                    `json:"vmtype"`
 	Vnfdetails            map[string]string                         `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                 `json:"vnfnics"`
 	Zoneid                string                                    `json:"zoneid"`
 	Zonename              string                                    `json:"zonename"`
+	Extra                 map[string]json.RawMessage                `json:"-"`
 }
 
 type StopVirtualMachineResponseSecuritygroup struct {
@@ -10046,36 +12063,54 @@ type StopVirtualMachineResponseSecuritygroup struct {
 	Project             string                                        `json:"project"`
 	Projectid           string                                        `json:"projectid"`
 	Tags                []Tags                                        `json:"tags"`
-	Virtualmachinecount int                                           `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                       `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                 `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                    `json:"-"`
+}
+
+func (r *StopVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias StopVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias StopVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type StopVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *StopVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias StopVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *StopVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -10102,7 +12137,7 @@ func (r *StopVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias StopVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateDefaultNicForVirtualMachineParams struct {
@@ -10123,6 +12158,45 @@ func (p *UpdateDefaultNicForVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateDefaultNicForVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateDefaultNicForVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateDefaultNicForVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateDefaultNicForVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateDefaultNicForVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"nicid", "virtualmachineid"},
+		lengths:  map[string]int{"nicid": 255, "virtualmachineid": 255},
+	}.validate("updateDefaultNicForVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateDefaultNicForVirtualMachine API, before the request is signed
+func (p *UpdateDefaultNicForVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateDefaultNicForVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateDefaultNicForVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateDefaultNicForVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"nicid":            decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateDefaultNicForVirtualMachineParams) Clone() *UpdateDefaultNicForVirtualMachineParams {
+	return &UpdateDefaultNicForVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateDefaultNicForVirtualMachineParams) SetNicid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -10177,32 +12251,39 @@ func (s *VirtualMachineService) NewUpdateDefaultNicForVirtualMachineParams(nicid
 
 // Changes the default NIC on an Instance
 func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("updateDefaultNicForVirtualMachine", p.toURLValues())
+	return s.UpdateDefaultNicForVirtualMachineWithContext(context.Background(), p)
+}
+
+// UpdateDefaultNicForVirtualMachineWithContext is like UpdateDefaultNicForVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateDefaultNicForVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateDefaultNicForVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateDefaultNicForVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateDefaultNicForVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateDefaultNicForVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -10210,6 +12291,25 @@ func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachine(p *UpdateDefau
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateDefaultNicForVirtualMachine async job into r
+func (r *UpdateDefaultNicForVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateDefaultNicForVirtualMachineAsync starts the async job of UpdateDefaultNicForVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) UpdateDefaultNicForVirtualMachineAsync(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*Job, error) {
+	r, err := s.UpdateDefaultNicForVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateDefaultNicForVirtualMachine", r.JobID), nil
+}
+
 type UpdateDefaultNicForVirtualMachineResponse struct {
 	Account               string                                                   `json:"account"`
 	Affinitygroup         []UpdateDefaultNicForVirtualMachineResponseAffinitygroup `json:"affinitygroup"`
@@ -10221,32 +12321,32 @@ type UpdateDefaultNicForVirtualMachineResponse struct {
 	Backupofferingname    string                                                   `json:"backupofferingname"`
 	Bootmode              string                                                   `json:"bootmode"`
 	Boottype              string                                                   `json:"boottype"`
-	Cpunumber             int                                                      `json:"cpunumber"`
-	Cpuspeed              int                                                      `json:"cpuspeed"`
+	Cpunumber             FlexInt                                                  `json:"cpunumber"`
+	Cpuspeed              FlexInt                                                  `json:"cpuspeed"`
 	Cpuused               string                                                   `json:"cpuused"`
-	Created               string                                                   `json:"created"`
-	Deleteprotection      bool                                                     `json:"deleteprotection"`
+	Created               Time                                                     `json:"created"`
+	Deleteprotection      FlexBool                                                 `json:"deleteprotection"`
 	Details               map[string]string                                        `json:"details"`
-	Diskioread            int64                                                    `json:"diskioread"`
-	Diskiowrite           int64                                                    `json:"diskiowrite"`
-	Diskkbsread           int64                                                    `json:"diskkbsread"`
-	Diskkbswrite          int64                                                    `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                                `json:"diskioread"`
+	Diskiowrite           FlexInt64                                                `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                                `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                                `json:"diskkbswrite"`
 	Diskofferingid        string                                                   `json:"diskofferingid"`
 	Diskofferingname      string                                                   `json:"diskofferingname"`
 	Displayname           string                                                   `json:"displayname"`
-	Displayvm             bool                                                     `json:"displayvm"`
+	Displayvm             FlexBool                                                 `json:"displayvm"`
 	Domain                string                                                   `json:"domain"`
 	Domainid              string                                                   `json:"domainid"`
 	Domainpath            string                                                   `json:"domainpath"`
-	Forvirtualnetwork     bool                                                     `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                                 `json:"forvirtualnetwork"`
 	Gpucardid             string                                                   `json:"gpucardid"`
 	Gpucardname           string                                                   `json:"gpucardname"`
-	Gpucount              int                                                      `json:"gpucount"`
+	Gpucount              FlexInt                                                  `json:"gpucount"`
 	Group                 string                                                   `json:"group"`
 	Groupid               string                                                   `json:"groupid"`
 	Guestosid             string                                                   `json:"guestosid"`
-	Haenable              bool                                                     `json:"haenable"`
-	Hasannotations        bool                                                     `json:"hasannotations"`
+	Haenable              FlexBool                                                 `json:"haenable"`
+	Hasannotations        FlexBool                                                 `json:"hasannotations"`
 	Hostcontrolstate      string                                                   `json:"hostcontrolstate"`
 	Hostid                string                                                   `json:"hostid"`
 	Hostname              string                                                   `json:"hostname"`
@@ -10255,47 +12355,47 @@ type UpdateDefaultNicForVirtualMachineResponse struct {
 	Id                    string                                                   `json:"id"`
 	Instancename          string                                                   `json:"instancename"`
 	Ipaddress             string                                                   `json:"ipaddress"`
-	Isdynamicallyscalable bool                                                     `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                                 `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                                   `json:"isodisplaytext"`
 	Isoid                 string                                                   `json:"isoid"`
 	Isoname               string                                                   `json:"isoname"`
 	JobID                 string                                                   `json:"jobid"`
-	Jobstatus             int                                                      `json:"jobstatus"`
+	Jobstatus             FlexInt                                                  `json:"jobstatus"`
 	Keypairs              string                                                   `json:"keypairs"`
-	Lastupdated           string                                                   `json:"lastupdated"`
-	Leaseduration         int                                                      `json:"leaseduration"`
+	Lastupdated           Time                                                     `json:"lastupdated"`
+	Leaseduration         FlexInt                                                  `json:"leaseduration"`
 	Leaseexpiryaction     string                                                   `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                                   `json:"leaseexpirydate"`
-	Maxheads              int64                                                    `json:"maxheads"`
-	Maxresolutionx        int64                                                    `json:"maxresolutionx"`
-	Maxresolutiony        int64                                                    `json:"maxresolutiony"`
-	Memory                int                                                      `json:"memory"`
-	Memoryintfreekbs      int64                                                    `json:"memoryintfreekbs"`
-	Memorykbs             int64                                                    `json:"memorykbs"`
-	Memorytargetkbs       int64                                                    `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                                     `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                                `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                                `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                                `json:"maxresolutiony"`
+	Memory                FlexInt                                                  `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                                `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                                `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                                `json:"memorytargetkbs"`
 	Name                  string                                                   `json:"name"`
-	Networkkbsread        int64                                                    `json:"networkkbsread"`
-	Networkkbswrite       int64                                                    `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                                `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                                `json:"networkkbswrite"`
 	Nic                   []Nic                                                    `json:"nic"`
 	Osdisplayname         string                                                   `json:"osdisplayname"`
 	Ostypeid              string                                                   `json:"ostypeid"`
 	Password              string                                                   `json:"password"`
-	Passwordenabled       bool                                                     `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                                 `json:"passwordenabled"`
 	Pooltype              string                                                   `json:"pooltype"`
 	Project               string                                                   `json:"project"`
 	Projectid             string                                                   `json:"projectid"`
 	Publicip              string                                                   `json:"publicip"`
 	Publicipid            string                                                   `json:"publicipid"`
 	Readonlydetails       string                                                   `json:"readonlydetails"`
-	Receivedbytes         int64                                                    `json:"receivedbytes"`
-	Rootdeviceid          int64                                                    `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                                `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                                `json:"rootdeviceid"`
 	Rootdevicetype        string                                                   `json:"rootdevicetype"`
 	Securitygroup         []UpdateDefaultNicForVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                                    `json:"sentbytes"`
+	Sentbytes             FlexInt64                                                `json:"sentbytes"`
 	Serviceofferingid     string                                                   `json:"serviceofferingid"`
 	Serviceofferingname   string                                                   `json:"serviceofferingname"`
 	Servicestate          string                                                   `json:"servicestate"`
-	State                 string                                                   `json:"state"`
+	State                 VirtualMachineState                                      `json:"state"`
 	Tags                  []Tags                                                   `json:"tags"`
 	Templatedisplaytext   string                                                   `json:"templatedisplaytext"`
 	Templateformat        string                                                   `json:"templateformat"`
@@ -10312,12 +12412,13 @@ type UpdateDefaultNicForVirtualMachineResponse struct {
 	Vgpu                  string                                                   `json:"vgpu"`
 	Vgpuprofileid         string                                                   `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                                   `json:"vgpuprofilename"`
-	Videoram              int64                                                    `json:"videoram"`
+	Videoram              FlexInt64                                                `json:"videoram"`
 	Vmtype                string                                                   `json:"vmtype"`
 	Vnfdetails            map[string]string                                        `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                                `json:"vnfnics"`
 	Zoneid                string                                                   `json:"zoneid"`
 	Zonename              string                                                   `json:"zonename"`
+	Extra                 map[string]json.RawMessage                               `json:"-"`
 }
 
 type UpdateDefaultNicForVirtualMachineResponseSecuritygroup struct {
@@ -10333,36 +12434,54 @@ type UpdateDefaultNicForVirtualMachineResponseSecuritygroup struct {
 	Project             string                                                       `json:"project"`
 	Projectid           string                                                       `json:"projectid"`
 	Tags                []Tags                                                       `json:"tags"`
-	Virtualmachinecount int                                                          `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                                      `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                                `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                                   `json:"-"`
+}
+
+func (r *UpdateDefaultNicForVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateDefaultNicForVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateDefaultNicForVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateDefaultNicForVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias UpdateDefaultNicForVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateDefaultNicForVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateDefaultNicForVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateDefaultNicForVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *UpdateDefaultNicForVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -10389,7 +12508,7 @@ func (r *UpdateDefaultNicForVirtualMachineResponse) UnmarshalJSON(b []byte) erro
 	}
 
 	type alias UpdateDefaultNicForVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVirtualMachineParams struct {
@@ -10489,6 +12608,65 @@ func (p *UpdateVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"customid": 255, "displayname": 255, "extraconfig": 255, "group": 255, "id": 255, "instancename": 255, "leaseexpiryaction": 255, "name": 255, "ostypeid": 255, "userdata": 1048576, "userdataid": 255},
+	}.validate("updateVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVirtualMachine API, before the request is signed
+func (p *UpdateVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"cleanupdetails":         decodeParam[bool],
+		"customid":               decodeParam[string],
+		"deleteprotection":       decodeParam[bool],
+		"details":                decodeParam[map[string]string],
+		"dhcpoptionsnetworklist": decodeParam[[]map[string]string],
+		"displayname":            decodeParam[string],
+		"displayvm":              decodeParam[bool],
+		"extraconfig":            decodeParam[string],
+		"group":                  decodeParam[string],
+		"haenable":               decodeParam[bool],
+		"id":                     decodeParam[string],
+		"instancename":           decodeParam[string],
+		"isdynamicallyscalable":  decodeParam[bool],
+		"leaseduration":          decodeParam[int],
+		"leaseexpiryaction":      decodeParam[string],
+		"name":                   decodeParam[string],
+		"ostypeid":               decodeParam[string],
+		"securitygroupids":       decodeParam[[]string],
+		"securitygroupnames":     decodeParam[[]string],
+		"userdata":               decodeParam[string],
+		"userdatadetails":        decodeParam[map[string]string],
+		"userdataid":             decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVirtualMachineParams) Clone() *UpdateVirtualMachineParams {
+	return &UpdateVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVirtualMachineParams) SetCleanupdetails(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -10976,13 +13154,22 @@ func (s *VirtualMachineService) NewUpdateVirtualMachineParams(id string) *Update
 
 // Updates properties of  an Instance. The Instance has to be stopped and restarted for the new properties to take effect. UpdateVirtualMachine does not first check whether the Instance is stopped. Therefore, stop the Instance manually before issuing this call.
 func (s *VirtualMachineService) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVirtualMachine", p.toURLValues())
+	return s.UpdateVirtualMachineWithContext(context.Background(), p)
+}
+
+// UpdateVirtualMachineWithContext is like UpdateVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -11000,32 +13187,32 @@ type UpdateVirtualMachineResponse struct {
 	Backupofferingname    string                                      `json:"backupofferingname"`
 	Bootmode              string                                      `json:"bootmode"`
 	Boottype              string                                      `json:"boottype"`
-	Cpunumber             int                                         `json:"cpunumber"`
-	Cpuspeed              int                                         `json:"cpuspeed"`
+	Cpunumber             FlexInt                                     `json:"cpunumber"`
+	Cpuspeed              FlexInt                                     `json:"cpuspeed"`
 	Cpuused               string                                      `json:"cpuused"`
-	Created               string                                      `json:"created"`
-	Deleteprotection      bool                                        `json:"deleteprotection"`
+	Created               Time                                        `json:"created"`
+	Deleteprotection      FlexBool                                    `json:"deleteprotection"`
 	Details               map[string]string                           `json:"details"`
-	Diskioread            int64                                       `json:"diskioread"`
-	Diskiowrite           int64                                       `json:"diskiowrite"`
-	Diskkbsread           int64                                       `json:"diskkbsread"`
-	Diskkbswrite          int64                                       `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                   `json:"diskioread"`
+	Diskiowrite           FlexInt64                                   `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                   `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                   `json:"diskkbswrite"`
 	Diskofferingid        string                                      `json:"diskofferingid"`
 	Diskofferingname      string                                      `json:"diskofferingname"`
 	Displayname           string                                      `json:"displayname"`
-	Displayvm             bool                                        `json:"displayvm"`
+	Displayvm             FlexBool                                    `json:"displayvm"`
 	Domain                string                                      `json:"domain"`
 	Domainid              string                                      `json:"domainid"`
 	Domainpath            string                                      `json:"domainpath"`
-	Forvirtualnetwork     bool                                        `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                    `json:"forvirtualnetwork"`
 	Gpucardid             string                                      `json:"gpucardid"`
 	Gpucardname           string                                      `json:"gpucardname"`
-	Gpucount              int                                         `json:"gpucount"`
+	Gpucount              FlexInt                                     `json:"gpucount"`
 	Group                 string                                      `json:"group"`
 	Groupid               string                                      `json:"groupid"`
 	Guestosid             string                                      `json:"guestosid"`
-	Haenable              bool                                        `json:"haenable"`
-	Hasannotations        bool                                        `json:"hasannotations"`
+	Haenable              FlexBool                                    `json:"haenable"`
+	Hasannotations        FlexBool                                    `json:"hasannotations"`
 	Hostcontrolstate      string                                      `json:"hostcontrolstate"`
 	Hostid                string                                      `json:"hostid"`
 	Hostname              string                                      `json:"hostname"`
@@ -11034,47 +13221,47 @@ type UpdateVirtualMachineResponse struct {
 	Id                    string                                      `json:"id"`
 	Instancename          string                                      `json:"instancename"`
 	Ipaddress             string                                      `json:"ipaddress"`
-	Isdynamicallyscalable bool                                        `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                    `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                      `json:"isodisplaytext"`
 	Isoid                 string                                      `json:"isoid"`
 	Isoname               string                                      `json:"isoname"`
 	JobID                 string                                      `json:"jobid"`
-	Jobstatus             int                                         `json:"jobstatus"`
+	Jobstatus             FlexInt                                     `json:"jobstatus"`
 	Keypairs              string                                      `json:"keypairs"`
-	Lastupdated           string                                      `json:"lastupdated"`
-	Leaseduration         int                                         `json:"leaseduration"`
+	Lastupdated           Time                                        `json:"lastupdated"`
+	Leaseduration         FlexInt                                     `json:"leaseduration"`
 	Leaseexpiryaction     string                                      `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                      `json:"leaseexpirydate"`
-	Maxheads              int64                                       `json:"maxheads"`
-	Maxresolutionx        int64                                       `json:"maxresolutionx"`
-	Maxresolutiony        int64                                       `json:"maxresolutiony"`
-	Memory                int                                         `json:"memory"`
-	Memoryintfreekbs      int64                                       `json:"memoryintfreekbs"`
-	Memorykbs             int64                                       `json:"memorykbs"`
-	Memorytargetkbs       int64                                       `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                        `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                   `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                   `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                   `json:"maxresolutiony"`
+	Memory                FlexInt                                     `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                   `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                   `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                   `json:"memorytargetkbs"`
 	Name                  string                                      `json:"name"`
-	Networkkbsread        int64                                       `json:"networkkbsread"`
-	Networkkbswrite       int64                                       `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                   `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                   `json:"networkkbswrite"`
 	Nic                   []Nic                                       `json:"nic"`
 	Osdisplayname         string                                      `json:"osdisplayname"`
 	Ostypeid              string                                      `json:"ostypeid"`
 	Password              string                                      `json:"password"`
-	Passwordenabled       bool                                        `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                    `json:"passwordenabled"`
 	Pooltype              string                                      `json:"pooltype"`
 	Project               string                                      `json:"project"`
 	Projectid             string                                      `json:"projectid"`
 	Publicip              string                                      `json:"publicip"`
 	Publicipid            string                                      `json:"publicipid"`
 	Readonlydetails       string                                      `json:"readonlydetails"`
-	Receivedbytes         int64                                       `json:"receivedbytes"`
-	Rootdeviceid          int64                                       `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                   `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                   `json:"rootdeviceid"`
 	Rootdevicetype        string                                      `json:"rootdevicetype"`
 	Securitygroup         []UpdateVirtualMachineResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                       `json:"sentbytes"`
+	Sentbytes             FlexInt64                                   `json:"sentbytes"`
 	Serviceofferingid     string                                      `json:"serviceofferingid"`
 	Serviceofferingname   string                                      `json:"serviceofferingname"`
 	Servicestate          string                                      `json:"servicestate"`
-	State                 string                                      `json:"state"`
+	State                 VirtualMachineState                         `json:"state"`
 	Tags                  []Tags                                      `json:"tags"`
 	Templatedisplaytext   string                                      `json:"templatedisplaytext"`
 	Templateformat        string                                      `json:"templateformat"`
@@ -11091,12 +13278,13 @@ type UpdateVirtualMachineResponse struct {
 	Vgpu                  string                                      `json:"vgpu"`
 	Vgpuprofileid         string                                      `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                      `json:"vgpuprofilename"`
-	Videoram              int64                                       `json:"videoram"`
+	Videoram              FlexInt64                                   `json:"videoram"`
 	Vmtype                string                                      `json:"vmtype"`
 	Vnfdetails            map[string]string                           `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                   `json:"vnfnics"`
 	Zoneid                string                                      `json:"zoneid"`
 	Zonename              string                                      `json:"zonename"`
+	Extra                 map[string]json.RawMessage                  `json:"-"`
 }
 
 type UpdateVirtualMachineResponseSecuritygroup struct {
@@ -11112,36 +13300,54 @@ type UpdateVirtualMachineResponseSecuritygroup struct {
 	Project             string                                          `json:"project"`
 	Projectid           string                                          `json:"projectid"`
 	Tags                []Tags                                          `json:"tags"`
-	Virtualmachinecount int                                             `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                         `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                   `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                      `json:"-"`
+}
+
+func (r *UpdateVirtualMachineResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateVirtualMachineResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVirtualMachineResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVirtualMachineResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias UpdateVirtualMachineResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVirtualMachineResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVirtualMachineResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias UpdateVirtualMachineResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *UpdateVirtualMachineResponse) UnmarshalJSON(b []byte) error {
@@ -11168,7 +13374,7 @@ func (r *UpdateVirtualMachineResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateVirtualMachineResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVirtualMachinesUsageHistoryParams struct {
@@ -11210,6 +13416,50 @@ func (p *ListVirtualMachinesUsageHistoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVirtualMachinesUsageHistory API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVirtualMachinesUsageHistoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVirtualMachinesUsageHistory", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVirtualMachinesUsageHistory API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVirtualMachinesUsageHistoryParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "id": 255, "keyword": 255, "name": 255, "startdate": 255},
+	}.validate("listVirtualMachinesUsageHistory", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVirtualMachinesUsageHistory API, before the request is signed
+func (p *ListVirtualMachinesUsageHistoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVirtualMachinesUsageHistoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVirtualMachinesUsageHistoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVirtualMachinesUsageHistory", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"id":        decodeParam[string],
+		"ids":       decodeParam[[]string],
+		"keyword":   decodeParam[string],
+		"name":      decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"startdate": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVirtualMachinesUsageHistoryParams) Clone() *ListVirtualMachinesUsageHistoryParams {
+	return &ListVirtualMachinesUsageHistoryParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVirtualMachinesUsageHistoryParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -11388,6 +13638,11 @@ func (s *VirtualMachineService) NewListVirtualMachinesUsageHistoryParams() *List
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVirtualMachinesUsageHistoryIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachinesUsageHistoryIDWithContext is like GetVirtualMachinesUsageHistoryID, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVirtualMachinesUsageHistoryParams{}
 	p.p = make(map[string]interface{})
 
@@ -11399,7 +13654,7 @@ func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryID(name string, op
 		}
 	}
 
-	l, err := s.ListVirtualMachinesUsageHistory(p)
+	l, err := s.ListVirtualMachinesUsageHistoryWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -11424,12 +13679,17 @@ func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryID(name string, op
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByName(name string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
-	id, count, err := s.GetVirtualMachinesUsageHistoryID(name, opts...)
+	return s.GetVirtualMachinesUsageHistoryByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVirtualMachinesUsageHistoryByNameWithContext is like GetVirtualMachinesUsageHistoryByName, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
+	id, count, err := s.GetVirtualMachinesUsageHistoryIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVirtualMachinesUsageHistoryByID(id, opts...)
+	r, count, err := s.GetVirtualMachinesUsageHistoryByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -11438,6 +13698,11 @@ func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByName(name string
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByID(id string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
+	return s.GetVirtualMachinesUsageHistoryByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVirtualMachinesUsageHistoryByIDWithContext is like GetVirtualMachinesUsageHistoryByID, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
 	p := &ListVirtualMachinesUsageHistoryParams{}
 	p.p = make(map[string]interface{})
 
@@ -11449,11 +13714,10 @@ func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByID(id string, op
 		}
 	}
 
-	l, err := s.ListVirtualMachinesUsageHistory(p)
+	l, err := s.ListVirtualMachinesUsageHistoryWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -11471,19 +13735,56 @@ func (s *VirtualMachineService) GetVirtualMachinesUsageHistoryByID(id string, op
 
 // Lists VM stats
 func (s *VirtualMachineService) ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error) {
-	resp, err := s.cs.newRequest("listVirtualMachinesUsageHistory", p.toURLValues())
+	return s.ListVirtualMachinesUsageHistoryWithContext(context.Background(), p)
+}
+
+// ListVirtualMachinesUsageHistoryWithContext is like ListVirtualMachinesUsageHistory, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesUsageHistoryWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVirtualMachinesUsageHistory", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVirtualMachinesUsageHistoryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVirtualMachinesUsageHistory", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVirtualMachinesUsageHistoryAll returns all VirtualMachinesUsageHistory matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualMachineService) ListVirtualMachinesUsageHistoryAll(p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) ([]*VirtualMachinesUsageHistory, error) {
+	return s.ListVirtualMachinesUsageHistoryAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualMachinesUsageHistoryAllWithContext is like ListVirtualMachinesUsageHistoryAll, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesUsageHistoryAllWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) ([]*VirtualMachinesUsageHistory, error) {
+	return collectPages(s.ListVirtualMachinesUsageHistoryIterWithContext(ctx, p, opts...))
+}
+
+// ListVirtualMachinesUsageHistoryIter returns an iterator over all VirtualMachinesUsageHistory matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualMachineService) ListVirtualMachinesUsageHistoryIter(p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VirtualMachinesUsageHistory, error] {
+	return s.ListVirtualMachinesUsageHistoryIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVirtualMachinesUsageHistoryIterWithContext is like ListVirtualMachinesUsageHistoryIter, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVirtualMachinesUsageHistoryIterWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VirtualMachinesUsageHistory, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VirtualMachinesUsageHistory, int, error) {
+		l, err := s.ListVirtualMachinesUsageHistoryWithContext(ctx, &ListVirtualMachinesUsageHistoryParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VirtualMachinesUsageHistory, l.Count, nil
+	}, opts...)
+}
+
 type ListVirtualMachinesUsageHistoryResponse struct {
 	Count                       int                            `json:"count"`
 	VirtualMachinesUsageHistory []*VirtualMachinesUsageHistory `json:"virtualmachine"`
@@ -11493,26 +13794,38 @@ type VirtualMachinesUsageHistory struct {
 	Displayname string                             `json:"displayname"`
 	Id          string                             `json:"id"`
 	JobID       string                             `json:"jobid"`
-	Jobstatus   int                                `json:"jobstatus"`
+	Jobstatus   FlexInt                            `json:"jobstatus"`
 	Name        string                             `json:"name"`
 	Stats       []VirtualMachinesUsageHistoryStats `json:"stats"`
+	Extra       map[string]json.RawMessage         `json:"-"`
+}
+
+func (r *VirtualMachinesUsageHistory) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachinesUsageHistory
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VirtualMachinesUsageHistoryStats struct {
-	Cpuused          string `json:"cpuused"`
-	Diskiopstotal    int64  `json:"diskiopstotal"`
-	Diskioread       int64  `json:"diskioread"`
-	Diskiowrite      int64  `json:"diskiowrite"`
-	Diskkbsread      int64  `json:"diskkbsread"`
-	Diskkbswrite     int64  `json:"diskkbswrite"`
-	Memoryintfreekbs int64  `json:"memoryintfreekbs"`
-	Memorykbs        int64  `json:"memorykbs"`
-	Memorytargetkbs  int64  `json:"memorytargetkbs"`
-	Networkkbsread   int64  `json:"networkkbsread"`
-	Networkkbswrite  int64  `json:"networkkbswrite"`
-	Networkread      string `json:"networkread"`
-	Networkwrite     string `json:"networkwrite"`
-	Timestamp        string `json:"timestamp"`
+	Cpuused          string                     `json:"cpuused"`
+	Diskiopstotal    FlexInt64                  `json:"diskiopstotal"`
+	Diskioread       FlexInt64                  `json:"diskioread"`
+	Diskiowrite      FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread      FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite     FlexInt64                  `json:"diskkbswrite"`
+	Memoryintfreekbs FlexInt64                  `json:"memoryintfreekbs"`
+	Memorykbs        FlexInt64                  `json:"memorykbs"`
+	Memorytargetkbs  FlexInt64                  `json:"memorytargetkbs"`
+	Networkkbsread   FlexInt64                  `json:"networkkbsread"`
+	Networkkbswrite  FlexInt64                  `json:"networkkbswrite"`
+	Networkread      string                     `json:"networkread"`
+	Networkwrite     string                     `json:"networkwrite"`
+	Timestamp        string                     `json:"timestamp"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VirtualMachinesUsageHistoryStats) UnmarshalJSON(b []byte) error {
+	type alias VirtualMachinesUsageHistoryStats
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportVmParams struct {
@@ -11596,7 +13909,7 @@ func (p *ImportVmParams) toURLValues() url.Values {
 		u.Set("hostname", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["importinstancehostid"]; found {
 		u.Set("importinstancehostid", v.(string))
@@ -11666,6 +13979,82 @@ func (p *ImportVmParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the importVm API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ImportVmParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("importVm", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the importVm API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ImportVmParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid", "hypervisor", "importsource", "name", "serviceofferingid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "clusterid": 255, "clustername": 255, "convertinstancehostid": 255, "convertinstancepoolid": 255, "datacentername": 255, "diskpath": 255, "displayname": 255, "domainid": 255, "existingvcenterid": 255, "extraparams": 255, "host": 255, "hostid": 255, "hostip": 255, "hostname": 255, "hypervisor": 255, "importinstancehostid": 255, "importsource": 255, "name": 255, "networkid": 255, "osid": 255, "password": 255, "projectid": 255, "serviceofferingid": 255, "storageid": 255, "templateid": 255, "temppath": 255, "username": 255, "vcenter": 255, "zoneid": 255},
+	}.validate("importVm", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the importVm API, before the request is signed
+func (p *ImportVmParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ImportVmParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ImportVmParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("importVm", b, &p.p, map[string]paramDecoder{
+		"account":                decodeParam[string],
+		"clusterid":              decodeParam[string],
+		"clustername":            decodeParam[string],
+		"convertinstancehostid":  decodeParam[string],
+		"convertinstancepoolid":  decodeParam[string],
+		"datacentername":         decodeParam[string],
+		"datadiskofferinglist":   decodeParam[[]map[string]string],
+		"details":                decodeParam[map[string]string],
+		"diskpath":               decodeParam[string],
+		"displayname":            decodeParam[string],
+		"domainid":               decodeParam[string],
+		"existingvcenterid":      decodeParam[string],
+		"extraparams":            decodeParam[string],
+		"forceconverttopool":     decodeParam[bool],
+		"forced":                 decodeParam[bool],
+		"forcemstoimportvmfiles": decodeParam[bool],
+		"host":                   decodeParam[string],
+		"hostid":                 decodeParam[string],
+		"hostip":                 decodeParam[string],
+		"hostname":               decodeParam[string],
+		"hypervisor":             decodeParam[HypervisorType],
+		"importinstancehostid":   decodeParam[string],
+		"importsource":           decodeParam[string],
+		"migrateallowed":         decodeParam[bool],
+		"name":                   decodeParam[string],
+		"networkid":              decodeParam[string],
+		"nicipaddresslist":       decodeParam[[]map[string]string],
+		"nicnetworklist":         decodeParam[map[string]string],
+		"osid":                   decodeParam[string],
+		"password":               decodeParam[string],
+		"projectid":              decodeParam[string],
+		"serviceofferingid":      decodeParam[string],
+		"storageid":              decodeParam[string],
+		"templateid":             decodeParam[string],
+		"temppath":               decodeParam[string],
+		"username":               decodeParam[string],
+		"usevddk":                decodeParam[bool],
+		"vcenter":                decodeParam[string],
+		"zoneid":                 decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ImportVmParams) Clone() *ImportVmParams {
+	return &ImportVmParams{p: cloneParams(p.p)}
+}
+
 func (p *ImportVmParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -12100,7 +14489,7 @@ func (p *ImportVmParams) GetHostname() (string, bool) {
 	return value, ok
 }
 
-func (p *ImportVmParams) SetHypervisor(v string) {
+func (p *ImportVmParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -12113,11 +14502,11 @@ func (p *ImportVmParams) ResetHypervisor() {
 	}
 }
 
-func (p *ImportVmParams) GetHypervisor() (string, bool) {
+func (p *ImportVmParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -12515,7 +14904,7 @@ func (p *ImportVmParams) GetZoneid() (string, bool) {
 
 // You should always use this function to get a new ImportVmParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualMachineService) NewImportVmParams(clusterid string, hypervisor string, importsource string, name string, serviceofferingid string, zoneid string) *ImportVmParams {
+func (s *VirtualMachineService) NewImportVmParams(clusterid string, hypervisor HypervisorType, importsource string, name string, serviceofferingid string, zoneid string) *ImportVmParams {
 	p := &ImportVmParams{}
 	p.p = make(map[string]interface{})
 	p.p["clusterid"] = clusterid
@@ -12529,32 +14918,39 @@ func (s *VirtualMachineService) NewImportVmParams(clusterid string, hypervisor s
 
 // Import virtual machine from a unmanaged host into CloudStack
 func (s *VirtualMachineService) ImportVm(p *ImportVmParams) (*ImportVmResponse, error) {
-	resp, err := s.cs.newPostRequest("importVm", p.toURLValues())
+	return s.ImportVmWithContext(context.Background(), p)
+}
+
+// ImportVmWithContext is like ImportVm, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ImportVmWithContext(ctx context.Context, p *ImportVmParams) (*ImportVmResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "importVm", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ImportVmResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("importVm", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "importVm", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("importVm", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -12562,6 +14958,25 @@ func (s *VirtualMachineService) ImportVm(p *ImportVmParams) (*ImportVmResponse,
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ImportVm async job into r
+func (r *ImportVmResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ImportVmAsync starts the async job of ImportVm without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) ImportVmAsync(ctx context.Context, p *ImportVmParams) (*Job, error) {
+	r, err := s.ImportVmWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("importVm", r.JobID), nil
+}
+
 type ImportVmResponse struct {
 	Account               string                          `json:"account"`
 	Affinitygroup         []ImportVmResponseAffinitygroup `json:"affinitygroup"`
@@ -12573,32 +14988,32 @@ type ImportVmResponse struct {
 	Backupofferingname    string                          `json:"backupofferingname"`
 	Bootmode              string                          `json:"bootmode"`
 	Boottype              string                          `json:"boottype"`
-	Cpunumber             int                             `json:"cpunumber"`
-	Cpuspeed              int                             `json:"cpuspeed"`
+	Cpunumber             FlexInt                         `json:"cpunumber"`
+	Cpuspeed              FlexInt                         `json:"cpuspeed"`
 	Cpuused               string                          `json:"cpuused"`
-	Created               string                          `json:"created"`
-	Deleteprotection      bool                            `json:"deleteprotection"`
+	Created               Time                            `json:"created"`
+	Deleteprotection      FlexBool                        `json:"deleteprotection"`
 	Details               map[string]string               `json:"details"`
-	Diskioread            int64                           `json:"diskioread"`
-	Diskiowrite           int64                           `json:"diskiowrite"`
-	Diskkbsread           int64                           `json:"diskkbsread"`
-	Diskkbswrite          int64                           `json:"diskkbswrite"`
+	Diskioread            FlexInt64                       `json:"diskioread"`
+	Diskiowrite           FlexInt64                       `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                       `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                       `json:"diskkbswrite"`
 	Diskofferingid        string                          `json:"diskofferingid"`
 	Diskofferingname      string                          `json:"diskofferingname"`
 	Displayname           string                          `json:"displayname"`
-	Displayvm             bool                            `json:"displayvm"`
+	Displayvm             FlexBool                        `json:"displayvm"`
 	Domain                string                          `json:"domain"`
 	Domainid              string                          `json:"domainid"`
 	Domainpath            string                          `json:"domainpath"`
-	Forvirtualnetwork     bool                            `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                        `json:"forvirtualnetwork"`
 	Gpucardid             string                          `json:"gpucardid"`
 	Gpucardname           string                          `json:"gpucardname"`
-	Gpucount              int                             `json:"gpucount"`
+	Gpucount              FlexInt                         `json:"gpucount"`
 	Group                 string                          `json:"group"`
 	Groupid               string                          `json:"groupid"`
 	Guestosid             string                          `json:"guestosid"`
-	Haenable              bool                            `json:"haenable"`
-	Hasannotations        bool                            `json:"hasannotations"`
+	Haenable              FlexBool                        `json:"haenable"`
+	Hasannotations        FlexBool                        `json:"hasannotations"`
 	Hostcontrolstate      string                          `json:"hostcontrolstate"`
 	Hostid                string                          `json:"hostid"`
 	Hostname              string                          `json:"hostname"`
@@ -12607,47 +15022,47 @@ type ImportVmResponse struct {
 	Id                    string                          `json:"id"`
 	Instancename          string                          `json:"instancename"`
 	Ipaddress             string                          `json:"ipaddress"`
-	Isdynamicallyscalable bool                            `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                        `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                          `json:"isodisplaytext"`
 	Isoid                 string                          `json:"isoid"`
 	Isoname               string                          `json:"isoname"`
 	JobID                 string                          `json:"jobid"`
-	Jobstatus             int                             `json:"jobstatus"`
+	Jobstatus             FlexInt                         `json:"jobstatus"`
 	Keypairs              string                          `json:"keypairs"`
-	Lastupdated           string                          `json:"lastupdated"`
-	Leaseduration         int                             `json:"leaseduration"`
+	Lastupdated           Time                            `json:"lastupdated"`
+	Leaseduration         FlexInt                         `json:"leaseduration"`
 	Leaseexpiryaction     string                          `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                          `json:"leaseexpirydate"`
-	Maxheads              int64                           `json:"maxheads"`
-	Maxresolutionx        int64                           `json:"maxresolutionx"`
-	Maxresolutiony        int64                           `json:"maxresolutiony"`
-	Memory                int                             `json:"memory"`
-	Memoryintfreekbs      int64                           `json:"memoryintfreekbs"`
-	Memorykbs             int64                           `json:"memorykbs"`
-	Memorytargetkbs       int64                           `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                            `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                       `json:"maxheads"`
+	Maxresolutionx        FlexInt64                       `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                       `json:"maxresolutiony"`
+	Memory                FlexInt                         `json:"memory"`
+	Memoryintfreekbs      FlexInt64                       `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                       `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                       `json:"memorytargetkbs"`
 	Name                  string                          `json:"name"`
-	Networkkbsread        int64                           `json:"networkkbsread"`
-	Networkkbswrite       int64                           `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                       `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                       `json:"networkkbswrite"`
 	Nic                   []Nic                           `json:"nic"`
 	Osdisplayname         string                          `json:"osdisplayname"`
 	Ostypeid              string                          `json:"ostypeid"`
 	Password              string                          `json:"password"`
-	Passwordenabled       bool                            `json:"passwordenabled"`
+	Passwordenabled       FlexBool                        `json:"passwordenabled"`
 	Pooltype              string                          `json:"pooltype"`
 	Project               string                          `json:"project"`
 	Projectid             string                          `json:"projectid"`
 	Publicip              string                          `json:"publicip"`
 	Publicipid            string                          `json:"publicipid"`
 	Readonlydetails       string                          `json:"readonlydetails"`
-	Receivedbytes         int64                           `json:"receivedbytes"`
-	Rootdeviceid          int64                           `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                       `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                       `json:"rootdeviceid"`
 	Rootdevicetype        string                          `json:"rootdevicetype"`
 	Securitygroup         []ImportVmResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                           `json:"sentbytes"`
+	Sentbytes             FlexInt64                       `json:"sentbytes"`
 	Serviceofferingid     string                          `json:"serviceofferingid"`
 	Serviceofferingname   string                          `json:"serviceofferingname"`
 	Servicestate          string                          `json:"servicestate"`
-	State                 string                          `json:"state"`
+	State                 VirtualMachineState             `json:"state"`
 	Tags                  []Tags                          `json:"tags"`
 	Templatedisplaytext   string                          `json:"templatedisplaytext"`
 	Templateformat        string                          `json:"templateformat"`
@@ -12664,12 +15079,13 @@ type ImportVmResponse struct {
 	Vgpu                  string                          `json:"vgpu"`
 	Vgpuprofileid         string                          `json:"vgpuprofileid"`
 	Vgpuprofilename       string                          `json:"vgpuprofilename"`
-	Videoram              int64                           `json:"videoram"`
+	Videoram              FlexInt64                       `json:"videoram"`
 	Vmtype                string                          `json:"vmtype"`
 	Vnfdetails            map[string]string               `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                       `json:"vnfnics"`
 	Zoneid                string                          `json:"zoneid"`
 	Zonename              string                          `json:"zonename"`
+	Extra                 map[string]json.RawMessage      `json:"-"`
 }
 
 type ImportVmResponseSecuritygroup struct {
@@ -12685,36 +15101,54 @@ type ImportVmResponseSecuritygroup struct {
 	Project             string                              `json:"project"`
 	Projectid           string                              `json:"projectid"`
 	Tags                []Tags                              `json:"tags"`
-	Virtualmachinecount int                                 `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                             `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                       `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage          `json:"-"`
+}
+
+func (r *ImportVmResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias ImportVmResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportVmResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportVmResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias ImportVmResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportVmResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportVmResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias ImportVmResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *ImportVmResponse) UnmarshalJSON(b []byte) error {
@@ -12741,7 +15175,7 @@ func (r *ImportVmResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ImportVmResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UnmanageVirtualMachineParams struct {
@@ -12766,6 +15200,46 @@ func (p *UnmanageVirtualMachineParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the unmanageVirtualMachine API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UnmanageVirtualMachineParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("unmanageVirtualMachine", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the unmanageVirtualMachine API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UnmanageVirtualMachineParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"hostid": 255, "id": 255},
+	}.validate("unmanageVirtualMachine", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the unmanageVirtualMachine API, before the request is signed
+func (p *UnmanageVirtualMachineParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UnmanageVirtualMachineParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UnmanageVirtualMachineParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("unmanageVirtualMachine", b, &p.p, map[string]paramDecoder{
+		"forced": decodeParam[bool],
+		"hostid": decodeParam[string],
+		"id":     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UnmanageVirtualMachineParams) Clone() *UnmanageVirtualMachineParams {
+	return &UnmanageVirtualMachineParams{p: cloneParams(p.p)}
+}
+
 func (p *UnmanageVirtualMachineParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -12840,32 +15314,39 @@ func (s *VirtualMachineService) NewUnmanageVirtualMachineParams(id string) *Unma
 
 // Unmanage a Guest Instance.
 func (s *VirtualMachineService) UnmanageVirtualMachine(p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error) {
-	resp, err := s.cs.newPostRequest("unmanageVirtualMachine", p.toURLValues())
+	return s.UnmanageVirtualMachineWithContext(context.Background(), p)
+}
+
+// UnmanageVirtualMachineWithContext is like UnmanageVirtualMachine, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) UnmanageVirtualMachineWithContext(ctx context.Context, p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "unmanageVirtualMachine", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UnmanageVirtualMachineResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("unmanageVirtualMachine", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "unmanageVirtualMachine", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("unmanageVirtualMachine", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -12873,12 +15354,37 @@ func (s *VirtualMachineService) UnmanageVirtualMachine(p *UnmanageVirtualMachine
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UnmanageVirtualMachine async job into r
+func (r *UnmanageVirtualMachineResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UnmanageVirtualMachineAsync starts the async job of UnmanageVirtualMachine without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) UnmanageVirtualMachineAsync(ctx context.Context, p *UnmanageVirtualMachineParams) (*Job, error) {
+	r, err := s.UnmanageVirtualMachineWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("unmanageVirtualMachine", r.JobID), nil
+}
+
 type UnmanageVirtualMachineResponse struct {
-	Details   string `json:"details"`
-	Hostid    string `json:"hostid"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Success   bool   `json:"success"`
+	Details   string                     `json:"details"`
+	Hostid    string                     `json:"hostid"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Success   bool                       `json:"success"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UnmanageVirtualMachineResponse) UnmarshalJSON(b []byte) error {
+	type alias UnmanageVirtualMachineResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListUnmanagedInstancesParams struct {
@@ -12910,6 +15416,48 @@ func (p *ListUnmanagedInstancesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listUnmanagedInstances API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListUnmanagedInstancesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listUnmanagedInstances", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listUnmanagedInstances API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListUnmanagedInstancesParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid"},
+		lengths:  map[string]int{"clusterid": 255, "keyword": 255, "name": 255},
+	}.validate("listUnmanagedInstances", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listUnmanagedInstances API, before the request is signed
+func (p *ListUnmanagedInstancesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListUnmanagedInstancesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListUnmanagedInstancesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listUnmanagedInstances", b, &p.p, map[string]paramDecoder{
+		"clusterid": decodeParam[string],
+		"keyword":   decodeParam[string],
+		"name":      decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListUnmanagedInstancesParams) Clone() *ListUnmanagedInstancesParams {
+	return &ListUnmanagedInstancesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListUnmanagedInstancesParams) SetClusterid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -13026,59 +15574,108 @@ func (s *VirtualMachineService) NewListUnmanagedInstancesParams(clusterid string
 
 // Lists unmanaged Instances for a given cluster.
 func (s *VirtualMachineService) ListUnmanagedInstances(p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
-	resp, err := s.cs.newRequest("listUnmanagedInstances", p.toURLValues())
+	return s.ListUnmanagedInstancesWithContext(context.Background(), p)
+}
+
+// ListUnmanagedInstancesWithContext is like ListUnmanagedInstances, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListUnmanagedInstancesWithContext(ctx context.Context, p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listUnmanagedInstances", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListUnmanagedInstancesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listUnmanagedInstances", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListUnmanagedInstancesAll returns all UnmanagedInstances matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualMachineService) ListUnmanagedInstancesAll(p *ListUnmanagedInstancesParams, opts ...PageOption) ([]*UnmanagedInstance, error) {
+	return s.ListUnmanagedInstancesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListUnmanagedInstancesAllWithContext is like ListUnmanagedInstancesAll, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListUnmanagedInstancesAllWithContext(ctx context.Context, p *ListUnmanagedInstancesParams, opts ...PageOption) ([]*UnmanagedInstance, error) {
+	return collectPages(s.ListUnmanagedInstancesIterWithContext(ctx, p, opts...))
+}
+
+// ListUnmanagedInstancesIter returns an iterator over all UnmanagedInstances matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualMachineService) ListUnmanagedInstancesIter(p *ListUnmanagedInstancesParams, opts ...PageOption) iter.Seq2[*UnmanagedInstance, error] {
+	return s.ListUnmanagedInstancesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListUnmanagedInstancesIterWithContext is like ListUnmanagedInstancesIter, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListUnmanagedInstancesIterWithContext(ctx context.Context, p *ListUnmanagedInstancesParams, opts ...PageOption) iter.Seq2[*UnmanagedInstance, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*UnmanagedInstance, int, error) {
+		l, err := s.ListUnmanagedInstancesWithContext(ctx, &ListUnmanagedInstancesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.UnmanagedInstances, l.Count, nil
+	}, opts...)
+}
+
 type ListUnmanagedInstancesResponse struct {
 	Count              int                  `json:"count"`
 	UnmanagedInstances []*UnmanagedInstance `json:"unmanagedinstance"`
 }
 
 type UnmanagedInstance struct {
-	Bootmode          string                  `json:"bootmode"`
-	Boottype          string                  `json:"boottype"`
-	Clusterid         string                  `json:"clusterid"`
-	Clustername       string                  `json:"clustername"`
-	Cpucorepersocket  int                     `json:"cpucorepersocket"`
-	Cpunumber         int                     `json:"cpunumber"`
-	Cpuspeed          int                     `json:"cpuspeed"`
-	Disk              []UnmanagedInstanceDisk `json:"disk"`
-	Hostid            string                  `json:"hostid"`
-	Hostname          string                  `json:"hostname"`
-	Hypervisor        string                  `json:"hypervisor"`
-	Hypervisorversion string                  `json:"hypervisorversion"`
-	JobID             string                  `json:"jobid"`
-	Jobstatus         int                     `json:"jobstatus"`
-	Memory            int                     `json:"memory"`
-	Name              string                  `json:"name"`
-	Nic               []Nic                   `json:"nic"`
-	Osdisplayname     string                  `json:"osdisplayname"`
-	Osid              string                  `json:"osid"`
-	Powerstate        string                  `json:"powerstate"`
+	Bootmode          string                     `json:"bootmode"`
+	Boottype          string                     `json:"boottype"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Cpucorepersocket  FlexInt                    `json:"cpucorepersocket"`
+	Cpunumber         FlexInt                    `json:"cpunumber"`
+	Cpuspeed          FlexInt                    `json:"cpuspeed"`
+	Disk              []UnmanagedInstanceDisk    `json:"disk"`
+	Hostid            string                     `json:"hostid"`
+	Hostname          string                     `json:"hostname"`
+	Hypervisor        string                     `json:"hypervisor"`
+	Hypervisorversion string                     `json:"hypervisorversion"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Memory            FlexInt                    `json:"memory"`
+	Name              string                     `json:"name"`
+	Nic               []Nic                      `json:"nic"`
+	Osdisplayname     string                     `json:"osdisplayname"`
+	Osid              string                     `json:"osid"`
+	Powerstate        string                     `json:"powerstate"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UnmanagedInstance) UnmarshalJSON(b []byte) error {
+	type alias UnmanagedInstance
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UnmanagedInstanceDisk struct {
-	Capacity       int64  `json:"capacity"`
-	Controller     string `json:"controller"`
-	Controllerunit int    `json:"controllerunit"`
-	Datastorehost  string `json:"datastorehost"`
-	Datastorename  string `json:"datastorename"`
-	Datastorepath  string `json:"datastorepath"`
-	Datastoretype  string `json:"datastoretype"`
-	Id             string `json:"id"`
-	Imagepath      string `json:"imagepath"`
-	Label          string `json:"label"`
-	Position       int    `json:"position"`
+	Capacity       FlexInt64                  `json:"capacity"`
+	Controller     string                     `json:"controller"`
+	Controllerunit FlexInt                    `json:"controllerunit"`
+	Datastorehost  string                     `json:"datastorehost"`
+	Datastorename  string                     `json:"datastorename"`
+	Datastorepath  string                     `json:"datastorepath"`
+	Datastoretype  string                     `json:"datastoretype"`
+	Id             string                     `json:"id"`
+	Imagepath      string                     `json:"imagepath"`
+	Label          string                     `json:"label"`
+	Position       FlexInt                    `json:"position"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UnmanagedInstanceDisk) UnmarshalJSON(b []byte) error {
+	type alias UnmanagedInstanceDisk
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportUnmanagedInstanceParams struct {
@@ -13155,6 +15752,58 @@ func (p *ImportUnmanagedInstanceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the importUnmanagedInstance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ImportUnmanagedInstanceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("importUnmanagedInstance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the importUnmanagedInstance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ImportUnmanagedInstanceParams) Validate() error {
+	return paramRules{
+		required: []string{"clusterid", "name", "serviceofferingid"},
+		lengths:  map[string]int{"account": 255, "clusterid": 255, "displayname": 255, "domainid": 255, "hostname": 255, "name": 255, "projectid": 255, "serviceofferingid": 255, "templateid": 255},
+	}.validate("importUnmanagedInstance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the importUnmanagedInstance API, before the request is signed
+func (p *ImportUnmanagedInstanceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ImportUnmanagedInstanceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ImportUnmanagedInstanceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("importUnmanagedInstance", b, &p.p, map[string]paramDecoder{
+		"account":              decodeParam[string],
+		"clusterid":            decodeParam[string],
+		"datadiskofferinglist": decodeParam[map[string]string],
+		"details":              decodeParam[map[string]string],
+		"displayname":          decodeParam[string],
+		"domainid":             decodeParam[string],
+		"forced":               decodeParam[bool],
+		"hostname":             decodeParam[string],
+		"migrateallowed":       decodeParam[bool],
+		"name":                 decodeParam[string],
+		"nicipaddresslist":     decodeParam[map[string]string],
+		"nicnetworklist":       decodeParam[map[string]string],
+		"projectid":            decodeParam[string],
+		"serviceofferingid":    decodeParam[string],
+		"templateid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ImportUnmanagedInstanceParams) Clone() *ImportUnmanagedInstanceParams {
+	return &ImportUnmanagedInstanceParams{p: cloneParams(p.p)}
+}
+
 func (p *ImportUnmanagedInstanceParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -13483,32 +16132,39 @@ func (s *VirtualMachineService) NewImportUnmanagedInstanceParams(clusterid strin
 
 // Import unmanaged Instance from a given cluster.
 func (s *VirtualMachineService) ImportUnmanagedInstance(p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error) {
-	resp, err := s.cs.newPostRequest("importUnmanagedInstance", p.toURLValues())
+	return s.ImportUnmanagedInstanceWithContext(context.Background(), p)
+}
+
+// ImportUnmanagedInstanceWithContext is like ImportUnmanagedInstance, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ImportUnmanagedInstanceWithContext(ctx context.Context, p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "importUnmanagedInstance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ImportUnmanagedInstanceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("importUnmanagedInstance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "importUnmanagedInstance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("importUnmanagedInstance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -13516,6 +16172,25 @@ func (s *VirtualMachineService) ImportUnmanagedInstance(p *ImportUnmanagedInstan
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ImportUnmanagedInstance async job into r
+func (r *ImportUnmanagedInstanceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ImportUnmanagedInstanceAsync starts the async job of ImportUnmanagedInstance without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) ImportUnmanagedInstanceAsync(ctx context.Context, p *ImportUnmanagedInstanceParams) (*Job, error) {
+	r, err := s.ImportUnmanagedInstanceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("importUnmanagedInstance", r.JobID), nil
+}
+
 type ImportUnmanagedInstanceResponse struct {
 	Account               string                                         `json:"account"`
 	Affinitygroup         []ImportUnmanagedInstanceResponseAffinitygroup `json:"affinitygroup"`
@@ -13527,32 +16202,32 @@ type ImportUnmanagedInstanceResponse struct {
 	Backupofferingname    string                                         `json:"backupofferingname"`
 	Bootmode              string                                         `json:"bootmode"`
 	Boottype              string                                         `json:"boottype"`
-	Cpunumber             int                                            `json:"cpunumber"`
-	Cpuspeed              int                                            `json:"cpuspeed"`
+	Cpunumber             FlexInt                                        `json:"cpunumber"`
+	Cpuspeed              FlexInt                                        `json:"cpuspeed"`
 	Cpuused               string                                         `json:"cpuused"`
-	Created               string                                         `json:"created"`
-	Deleteprotection      bool                                           `json:"deleteprotection"`
+	Created               Time                                           `json:"created"`
+	Deleteprotection      FlexBool                                       `json:"deleteprotection"`
 	Details               map[string]string                              `json:"details"`
-	Diskioread            int64                                          `json:"diskioread"`
-	Diskiowrite           int64                                          `json:"diskiowrite"`
-	Diskkbsread           int64                                          `json:"diskkbsread"`
-	Diskkbswrite          int64                                          `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                      `json:"diskioread"`
+	Diskiowrite           FlexInt64                                      `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                      `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                      `json:"diskkbswrite"`
 	Diskofferingid        string                                         `json:"diskofferingid"`
 	Diskofferingname      string                                         `json:"diskofferingname"`
 	Displayname           string                                         `json:"displayname"`
-	Displayvm             bool                                           `json:"displayvm"`
+	Displayvm             FlexBool                                       `json:"displayvm"`
 	Domain                string                                         `json:"domain"`
 	Domainid              string                                         `json:"domainid"`
 	Domainpath            string                                         `json:"domainpath"`
-	Forvirtualnetwork     bool                                           `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                       `json:"forvirtualnetwork"`
 	Gpucardid             string                                         `json:"gpucardid"`
 	Gpucardname           string                                         `json:"gpucardname"`
-	Gpucount              int                                            `json:"gpucount"`
+	Gpucount              FlexInt                                        `json:"gpucount"`
 	Group                 string                                         `json:"group"`
 	Groupid               string                                         `json:"groupid"`
 	Guestosid             string                                         `json:"guestosid"`
-	Haenable              bool                                           `json:"haenable"`
-	Hasannotations        bool                                           `json:"hasannotations"`
+	Haenable              FlexBool                                       `json:"haenable"`
+	Hasannotations        FlexBool                                       `json:"hasannotations"`
 	Hostcontrolstate      string                                         `json:"hostcontrolstate"`
 	Hostid                string                                         `json:"hostid"`
 	Hostname              string                                         `json:"hostname"`
@@ -13561,47 +16236,47 @@ type ImportUnmanagedInstanceResponse struct {
 	Id                    string                                         `json:"id"`
 	Instancename          string                                         `json:"instancename"`
 	Ipaddress             string                                         `json:"ipaddress"`
-	Isdynamicallyscalable bool                                           `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                       `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                         `json:"isodisplaytext"`
 	Isoid                 string                                         `json:"isoid"`
 	Isoname               string                                         `json:"isoname"`
 	JobID                 string                                         `json:"jobid"`
-	Jobstatus             int                                            `json:"jobstatus"`
+	Jobstatus             FlexInt                                        `json:"jobstatus"`
 	Keypairs              string                                         `json:"keypairs"`
-	Lastupdated           string                                         `json:"lastupdated"`
-	Leaseduration         int                                            `json:"leaseduration"`
+	Lastupdated           Time                                           `json:"lastupdated"`
+	Leaseduration         FlexInt                                        `json:"leaseduration"`
 	Leaseexpiryaction     string                                         `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                         `json:"leaseexpirydate"`
-	Maxheads              int64                                          `json:"maxheads"`
-	Maxresolutionx        int64                                          `json:"maxresolutionx"`
-	Maxresolutiony        int64                                          `json:"maxresolutiony"`
-	Memory                int                                            `json:"memory"`
-	Memoryintfreekbs      int64                                          `json:"memoryintfreekbs"`
-	Memorykbs             int64                                          `json:"memorykbs"`
-	Memorytargetkbs       int64                                          `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                           `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                      `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                      `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                      `json:"maxresolutiony"`
+	Memory                FlexInt                                        `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                      `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                      `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                      `json:"memorytargetkbs"`
 	Name                  string                                         `json:"name"`
-	Networkkbsread        int64                                          `json:"networkkbsread"`
-	Networkkbswrite       int64                                          `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                      `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                      `json:"networkkbswrite"`
 	Nic                   []Nic                                          `json:"nic"`
 	Osdisplayname         string                                         `json:"osdisplayname"`
 	Ostypeid              string                                         `json:"ostypeid"`
 	Password              string                                         `json:"password"`
-	Passwordenabled       bool                                           `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                       `json:"passwordenabled"`
 	Pooltype              string                                         `json:"pooltype"`
 	Project               string                                         `json:"project"`
 	Projectid             string                                         `json:"projectid"`
 	Publicip              string                                         `json:"publicip"`
 	Publicipid            string                                         `json:"publicipid"`
 	Readonlydetails       string                                         `json:"readonlydetails"`
-	Receivedbytes         int64                                          `json:"receivedbytes"`
-	Rootdeviceid          int64                                          `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                      `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                      `json:"rootdeviceid"`
 	Rootdevicetype        string                                         `json:"rootdevicetype"`
 	Securitygroup         []ImportUnmanagedInstanceResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                          `json:"sentbytes"`
+	Sentbytes             FlexInt64                                      `json:"sentbytes"`
 	Serviceofferingid     string                                         `json:"serviceofferingid"`
 	Serviceofferingname   string                                         `json:"serviceofferingname"`
 	Servicestate          string                                         `json:"servicestate"`
-	State                 string                                         `json:"state"`
+	State                 VirtualMachineState                            `json:"state"`
 	Tags                  []Tags                                         `json:"tags"`
 	Templatedisplaytext   string                                         `json:"templatedisplaytext"`
 	Templateformat        string                                         `json:"templateformat"`
@@ -13618,12 +16293,13 @@ type ImportUnmanagedInstanceResponse struct {
 	Vgpu                  string                                         `json:"vgpu"`
 	Vgpuprofileid         string                                         `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                         `json:"vgpuprofilename"`
-	Videoram              int64                                          `json:"videoram"`
+	Videoram              FlexInt64                                      `json:"videoram"`
 	Vmtype                string                                         `json:"vmtype"`
 	Vnfdetails            map[string]string                              `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                      `json:"vnfnics"`
 	Zoneid                string                                         `json:"zoneid"`
 	Zonename              string                                         `json:"zonename"`
+	Extra                 map[string]json.RawMessage                     `json:"-"`
 }
 
 type ImportUnmanagedInstanceResponseSecuritygroup struct {
@@ -13639,36 +16315,54 @@ type ImportUnmanagedInstanceResponseSecuritygroup struct {
 	Project             string                                             `json:"project"`
 	Projectid           string                                             `json:"projectid"`
 	Tags                []Tags                                             `json:"tags"`
-	Virtualmachinecount int                                                `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                            `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                      `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                         `json:"-"`
+}
+
+func (r *ImportUnmanagedInstanceResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias ImportUnmanagedInstanceResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportUnmanagedInstanceResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportUnmanagedInstanceResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias ImportUnmanagedInstanceResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportUnmanagedInstanceResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportUnmanagedInstanceResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias ImportUnmanagedInstanceResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *ImportUnmanagedInstanceResponse) UnmarshalJSON(b []byte) error {
@@ -13695,7 +16389,7 @@ func (r *ImportUnmanagedInstanceResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ImportUnmanagedInstanceResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListImportVmTasksParams struct {
@@ -13736,6 +16430,51 @@ func (p *ListImportVmTasksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listImportVmTasks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListImportVmTasksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listImportVmTasks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listImportVmTasks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListImportVmTasksParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"accountid": 255, "convertinstancehostid": 255, "keyword": 255, "tasksfilter": 255, "vcenter": 255, "zoneid": 255},
+	}.validate("listImportVmTasks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listImportVmTasks API, before the request is signed
+func (p *ListImportVmTasksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListImportVmTasksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListImportVmTasksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listImportVmTasks", b, &p.p, map[string]paramDecoder{
+		"accountid":             decodeParam[string],
+		"convertinstancehostid": decodeParam[string],
+		"keyword":               decodeParam[string],
+		"page":                  decodeParam[int],
+		"pagesize":              decodeParam[int],
+		"tasksfilter":           decodeParam[string],
+		"vcenter":               decodeParam[string],
+		"zoneid":                decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListImportVmTasksParams) Clone() *ListImportVmTasksParams {
+	return &ListImportVmTasksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListImportVmTasksParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -13915,46 +16654,89 @@ func (s *VirtualMachineService) NewListImportVmTasksParams(zoneid string) *ListI
 
 // List running import virtual machine tasks from a unmanaged hosts into CloudStack
 func (s *VirtualMachineService) ListImportVmTasks(p *ListImportVmTasksParams) (*ListImportVmTasksResponse, error) {
-	resp, err := s.cs.newRequest("listImportVmTasks", p.toURLValues())
+	return s.ListImportVmTasksWithContext(context.Background(), p)
+}
+
+// ListImportVmTasksWithContext is like ListImportVmTasks, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListImportVmTasksWithContext(ctx context.Context, p *ListImportVmTasksParams) (*ListImportVmTasksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listImportVmTasks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListImportVmTasksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listImportVmTasks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListImportVmTasksAll returns all ImportVmTasks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualMachineService) ListImportVmTasksAll(p *ListImportVmTasksParams, opts ...PageOption) ([]*ImportVmTask, error) {
+	return s.ListImportVmTasksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListImportVmTasksAllWithContext is like ListImportVmTasksAll, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListImportVmTasksAllWithContext(ctx context.Context, p *ListImportVmTasksParams, opts ...PageOption) ([]*ImportVmTask, error) {
+	return collectPages(s.ListImportVmTasksIterWithContext(ctx, p, opts...))
+}
+
+// ListImportVmTasksIter returns an iterator over all ImportVmTasks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualMachineService) ListImportVmTasksIter(p *ListImportVmTasksParams, opts ...PageOption) iter.Seq2[*ImportVmTask, error] {
+	return s.ListImportVmTasksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListImportVmTasksIterWithContext is like ListImportVmTasksIter, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListImportVmTasksIterWithContext(ctx context.Context, p *ListImportVmTasksParams, opts ...PageOption) iter.Seq2[*ImportVmTask, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ImportVmTask, int, error) {
+		l, err := s.ListImportVmTasksWithContext(ctx, &ListImportVmTasksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ImportVmTasks, l.Count, nil
+	}, opts...)
+}
+
 type ListImportVmTasksResponse struct {
 	Count         int             `json:"count"`
 	ImportVmTasks []*ImportVmTask `json:"importvmtask"`
 }
 
 type ImportVmTask struct {
-	Account                 string `json:"account"`
-	Accountid               string `json:"accountid"`
-	Convertinstancehostid   string `json:"convertinstancehostid"`
-	Convertinstancehostname string `json:"convertinstancehostname"`
-	Created                 string `json:"created"`
-	Datacentername          string `json:"datacentername"`
-	Description             string `json:"description"`
-	Displayname             string `json:"displayname"`
-	Duration                string `json:"duration"`
-	Id                      string `json:"id"`
-	JobID                   string `json:"jobid"`
-	Jobstatus               int    `json:"jobstatus"`
-	Lastupdated             string `json:"lastupdated"`
-	Sourcevmname            string `json:"sourcevmname"`
-	State                   string `json:"state"`
-	Step                    string `json:"step"`
-	Stepduration            string `json:"stepduration"`
-	Vcenter                 string `json:"vcenter"`
-	Virtualmachineid        string `json:"virtualmachineid"`
-	Zoneid                  string `json:"zoneid"`
-	Zonename                string `json:"zonename"`
+	Account                 string                     `json:"account"`
+	Accountid               string                     `json:"accountid"`
+	Convertinstancehostid   string                     `json:"convertinstancehostid"`
+	Convertinstancehostname string                     `json:"convertinstancehostname"`
+	Created                 Time                       `json:"created"`
+	Datacentername          string                     `json:"datacentername"`
+	Description             string                     `json:"description"`
+	Displayname             string                     `json:"displayname"`
+	Duration                string                     `json:"duration"`
+	Id                      string                     `json:"id"`
+	JobID                   string                     `json:"jobid"`
+	Jobstatus               FlexInt                    `json:"jobstatus"`
+	Lastupdated             Time                       `json:"lastupdated"`
+	Sourcevmname            string                     `json:"sourcevmname"`
+	State                   string                     `json:"state"`
+	Step                    string                     `json:"step"`
+	Stepduration            string                     `json:"stepduration"`
+	Vcenter                 string                     `json:"vcenter"`
+	Virtualmachineid        string                     `json:"virtualmachineid"`
+	Zoneid                  string                     `json:"zoneid"`
+	Zonename                string                     `json:"zonename"`
+	Extra                   map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportVmTask) UnmarshalJSON(b []byte) error {
+	type alias ImportVmTask
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVMScheduleParams struct {
@@ -13994,6 +16776,51 @@ func (p *CreateVMScheduleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVMSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVMScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVMSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVMSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVMScheduleParams) Validate() error {
+	return paramRules{
+		required: []string{"action", "schedule", "timezone", "virtualmachineid"},
+		lengths:  map[string]int{"action": 255, "description": 4096, "enddate": 255, "schedule": 255, "startdate": 255, "timezone": 255, "virtualmachineid": 255},
+	}.validate("createVMSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVMSchedule API, before the request is signed
+func (p *CreateVMScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVMScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVMScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVMSchedule", b, &p.p, map[string]paramDecoder{
+		"action":           decodeParam[string],
+		"description":      decodeParam[string],
+		"enabled":          decodeParam[bool],
+		"enddate":          decodeParam[string],
+		"schedule":         decodeParam[string],
+		"startdate":        decodeParam[string],
+		"timezone":         decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVMScheduleParams) Clone() *CreateVMScheduleParams {
+	return &CreateVMScheduleParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVMScheduleParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -14176,7 +17003,16 @@ func (s *VirtualMachineService) NewCreateVMScheduleParams(action string, schedul
 
 // Create Instance Schedule
 func (s *VirtualMachineService) CreateVMSchedule(p *CreateVMScheduleParams) (*CreateVMScheduleResponse, error) {
-	resp, err := s.cs.newPostRequest("createVMSchedule", p.toURLValues())
+	return s.CreateVMScheduleWithContext(context.Background(), p)
+}
+
+// CreateVMScheduleWithContext is like CreateVMSchedule, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) CreateVMScheduleWithContext(ctx context.Context, p *CreateVMScheduleParams) (*CreateVMScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createVMSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -14184,7 +17020,7 @@ func (s *VirtualMachineService) CreateVMSchedule(p *CreateVMScheduleParams) (*Cr
 	var nested struct {
 		Response CreateVMScheduleResponse `json:"vmschedule"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("createVMSchedule", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -14193,18 +17029,24 @@ func (s *VirtualMachineService) CreateVMSchedule(p *CreateVMScheduleParams) (*Cr
 }
 
 type CreateVMScheduleResponse struct {
-	Action           string `json:"action"`
-	Created          string `json:"created"`
-	Description      string `json:"description"`
-	Enabled          bool   `json:"enabled"`
-	Enddate          string `json:"enddate"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Schedule         string `json:"schedule"`
-	Startdate        string `json:"startdate"`
-	Timezone         string `json:"timezone"`
-	Virtualmachineid string `json:"virtualmachineid"`
+	Action           string                     `json:"action"`
+	Created          Time                       `json:"created"`
+	Description      string                     `json:"description"`
+	Enabled          FlexBool                   `json:"enabled"`
+	Enddate          Time                       `json:"enddate"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Schedule         string                     `json:"schedule"`
+	Startdate        Time                       `json:"startdate"`
+	Timezone         string                     `json:"timezone"`
+	Virtualmachineid string                     `json:"virtualmachineid"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVMScheduleResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVMScheduleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVMScheduleParams struct {
@@ -14241,6 +17083,50 @@ func (p *UpdateVMScheduleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVMSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVMScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVMSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVMSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVMScheduleParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "enddate": 255, "id": 255, "schedule": 255, "startdate": 255, "timezone": 255},
+	}.validate("updateVMSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVMSchedule API, before the request is signed
+func (p *UpdateVMScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVMScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVMScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVMSchedule", b, &p.p, map[string]paramDecoder{
+		"description": decodeParam[string],
+		"enabled":     decodeParam[bool],
+		"enddate":     decodeParam[string],
+		"id":          decodeParam[string],
+		"schedule":    decodeParam[string],
+		"startdate":   decodeParam[string],
+		"timezone":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVMScheduleParams) Clone() *UpdateVMScheduleParams {
+	return &UpdateVMScheduleParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVMScheduleParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -14399,7 +17285,16 @@ func (s *VirtualMachineService) NewUpdateVMScheduleParams(id string) *UpdateVMSc
 
 // Update Instance Schedule.
 func (s *VirtualMachineService) UpdateVMSchedule(p *UpdateVMScheduleParams) (*UpdateVMScheduleResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVMSchedule", p.toURLValues())
+	return s.UpdateVMScheduleWithContext(context.Background(), p)
+}
+
+// UpdateVMScheduleWithContext is like UpdateVMSchedule, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) UpdateVMScheduleWithContext(ctx context.Context, p *UpdateVMScheduleParams) (*UpdateVMScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVMSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -14407,7 +17302,7 @@ func (s *VirtualMachineService) UpdateVMSchedule(p *UpdateVMScheduleParams) (*Up
 	var nested struct {
 		Response UpdateVMScheduleResponse `json:"vmschedule"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateVMSchedule", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -14416,18 +17311,24 @@ func (s *VirtualMachineService) UpdateVMSchedule(p *UpdateVMScheduleParams) (*Up
 }
 
 type UpdateVMScheduleResponse struct {
-	Action           string `json:"action"`
-	Created          string `json:"created"`
-	Description      string `json:"description"`
-	Enabled          bool   `json:"enabled"`
-	Enddate          string `json:"enddate"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Schedule         string `json:"schedule"`
-	Startdate        string `json:"startdate"`
-	Timezone         string `json:"timezone"`
-	Virtualmachineid string `json:"virtualmachineid"`
+	Action           string                     `json:"action"`
+	Created          Time                       `json:"created"`
+	Description      string                     `json:"description"`
+	Enabled          FlexBool                   `json:"enabled"`
+	Enddate          Time                       `json:"enddate"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Schedule         string                     `json:"schedule"`
+	Startdate        Time                       `json:"startdate"`
+	Timezone         string                     `json:"timezone"`
+	Virtualmachineid string                     `json:"virtualmachineid"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVMScheduleResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVMScheduleResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVMScheduleParams struct {
@@ -14466,6 +17367,50 @@ func (p *ListVMScheduleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVMSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVMScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVMSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVMSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVMScheduleParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"action": 255, "id": 255, "keyword": 255, "virtualmachineid": 255},
+	}.validate("listVMSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVMSchedule API, before the request is signed
+func (p *ListVMScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVMScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVMScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVMSchedule", b, &p.p, map[string]paramDecoder{
+		"action":           decodeParam[string],
+		"enabled":          decodeParam[bool],
+		"id":               decodeParam[string],
+		"keyword":          decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVMScheduleParams) Clone() *ListVMScheduleParams {
+	return &ListVMScheduleParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVMScheduleParams) SetAction(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -14624,6 +17569,11 @@ func (s *VirtualMachineService) NewListVMScheduleParams(virtualmachineid string)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualMachineService) GetVMScheduleByID(id string, virtualmachineid string, opts ...OptionFunc) (*VMSchedule, int, error) {
+	return s.GetVMScheduleByIDWithContext(context.Background(), id, virtualmachineid, opts...)
+}
+
+// GetVMScheduleByIDWithContext is like GetVMScheduleByID, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) GetVMScheduleByIDWithContext(ctx context.Context, id string, virtualmachineid string, opts ...OptionFunc) (*VMSchedule, int, error) {
 	p := &ListVMScheduleParams{}
 	p.p = make(map[string]interface{})
 
@@ -14636,11 +17586,10 @@ func (s *VirtualMachineService) GetVMScheduleByID(id string, virtualmachineid st
 		}
 	}
 
-	l, err := s.ListVMSchedule(p)
+	l, err := s.ListVMScheduleWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -14658,37 +17607,80 @@ func (s *VirtualMachineService) GetVMScheduleByID(id string, virtualmachineid st
 
 // List Instance Schedules.
 func (s *VirtualMachineService) ListVMSchedule(p *ListVMScheduleParams) (*ListVMScheduleResponse, error) {
-	resp, err := s.cs.newRequest("listVMSchedule", p.toURLValues())
+	return s.ListVMScheduleWithContext(context.Background(), p)
+}
+
+// ListVMScheduleWithContext is like ListVMSchedule, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVMScheduleWithContext(ctx context.Context, p *ListVMScheduleParams) (*ListVMScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVMSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVMScheduleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVMSchedule", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVMScheduleAll returns all VMSchedule matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualMachineService) ListVMScheduleAll(p *ListVMScheduleParams, opts ...PageOption) ([]*VMSchedule, error) {
+	return s.ListVMScheduleAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVMScheduleAllWithContext is like ListVMScheduleAll, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVMScheduleAllWithContext(ctx context.Context, p *ListVMScheduleParams, opts ...PageOption) ([]*VMSchedule, error) {
+	return collectPages(s.ListVMScheduleIterWithContext(ctx, p, opts...))
+}
+
+// ListVMScheduleIter returns an iterator over all VMSchedule matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualMachineService) ListVMScheduleIter(p *ListVMScheduleParams, opts ...PageOption) iter.Seq2[*VMSchedule, error] {
+	return s.ListVMScheduleIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVMScheduleIterWithContext is like ListVMScheduleIter, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) ListVMScheduleIterWithContext(ctx context.Context, p *ListVMScheduleParams, opts ...PageOption) iter.Seq2[*VMSchedule, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VMSchedule, int, error) {
+		l, err := s.ListVMScheduleWithContext(ctx, &ListVMScheduleParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VMSchedule, l.Count, nil
+	}, opts...)
+}
+
 type ListVMScheduleResponse struct {
 	Count      int           `json:"count"`
 	VMSchedule []*VMSchedule `json:"vmschedule"`
 }
 
 type VMSchedule struct {
-	Action           string `json:"action"`
-	Created          string `json:"created"`
-	Description      string `json:"description"`
-	Enabled          bool   `json:"enabled"`
-	Enddate          string `json:"enddate"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Schedule         string `json:"schedule"`
-	Startdate        string `json:"startdate"`
-	Timezone         string `json:"timezone"`
-	Virtualmachineid string `json:"virtualmachineid"`
+	Action           string                     `json:"action"`
+	Created          Time                       `json:"created"`
+	Description      string                     `json:"description"`
+	Enabled          FlexBool                   `json:"enabled"`
+	Enddate          Time                       `json:"enddate"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Schedule         string                     `json:"schedule"`
+	Startdate        Time                       `json:"startdate"`
+	Timezone         string                     `json:"timezone"`
+	Virtualmachineid string                     `json:"virtualmachineid"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VMSchedule) UnmarshalJSON(b []byte) error {
+	type alias VMSchedule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVMScheduleParams struct {
@@ -14713,6 +17705,46 @@ func (p *DeleteVMScheduleParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVMSchedule API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVMScheduleParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVMSchedule", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVMSchedule API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVMScheduleParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"id": 255, "virtualmachineid": 255},
+	}.validate("deleteVMSchedule", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVMSchedule API, before the request is signed
+func (p *DeleteVMScheduleParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVMScheduleParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVMScheduleParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVMSchedule", b, &p.p, map[string]paramDecoder{
+		"id":               decodeParam[string],
+		"ids":              decodeParam[[]string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVMScheduleParams) Clone() *DeleteVMScheduleParams {
+	return &DeleteVMScheduleParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVMScheduleParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -14787,13 +17819,22 @@ func (s *VirtualMachineService) NewDeleteVMScheduleParams(virtualmachineid strin
 
 // Delete Instance Schedule.
 func (s *VirtualMachineService) DeleteVMSchedule(p *DeleteVMScheduleParams) (*DeleteVMScheduleResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVMSchedule", p.toURLValues())
+	return s.DeleteVMScheduleWithContext(context.Background(), p)
+}
+
+// DeleteVMScheduleWithContext is like DeleteVMSchedule, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) DeleteVMScheduleWithContext(ctx context.Context, p *DeleteVMScheduleParams) (*DeleteVMScheduleResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVMSchedule", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVMScheduleResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVMSchedule", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -14801,10 +17842,11 @@ func (s *VirtualMachineService) DeleteVMSchedule(p *DeleteVMScheduleParams) (*De
 }
 
 type DeleteVMScheduleResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteVMScheduleResponse) UnmarshalJSON(b []byte) error {
@@ -14831,7 +17873,7 @@ func (r *DeleteVMScheduleResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteVMScheduleResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssignVirtualMachineToBackupOfferingParams struct {
@@ -14852,6 +17894,45 @@ func (p *AssignVirtualMachineToBackupOfferingParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the assignVirtualMachineToBackupOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssignVirtualMachineToBackupOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("assignVirtualMachineToBackupOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the assignVirtualMachineToBackupOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssignVirtualMachineToBackupOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"backupofferingid", "virtualmachineid"},
+		lengths:  map[string]int{"backupofferingid": 255, "virtualmachineid": 255},
+	}.validate("assignVirtualMachineToBackupOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the assignVirtualMachineToBackupOffering API, before the request is signed
+func (p *AssignVirtualMachineToBackupOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssignVirtualMachineToBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssignVirtualMachineToBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("assignVirtualMachineToBackupOffering", b, &p.p, map[string]paramDecoder{
+		"backupofferingid": decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssignVirtualMachineToBackupOfferingParams) Clone() *AssignVirtualMachineToBackupOfferingParams {
+	return &AssignVirtualMachineToBackupOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *AssignVirtualMachineToBackupOfferingParams) SetBackupofferingid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -14906,27 +17987,39 @@ func (s *VirtualMachineService) NewAssignVirtualMachineToBackupOfferingParams(ba
 
 // Assigns an Instance to a backup offering
 func (s *VirtualMachineService) AssignVirtualMachineToBackupOffering(p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("assignVirtualMachineToBackupOffering", p.toURLValues())
+	return s.AssignVirtualMachineToBackupOfferingWithContext(context.Background(), p)
+}
+
+// AssignVirtualMachineToBackupOfferingWithContext is like AssignVirtualMachineToBackupOffering, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) AssignVirtualMachineToBackupOfferingWithContext(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "assignVirtualMachineToBackupOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AssignVirtualMachineToBackupOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("assignVirtualMachineToBackupOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "assignVirtualMachineToBackupOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("assignVirtualMachineToBackupOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -14934,11 +18027,31 @@ func (s *VirtualMachineService) AssignVirtualMachineToBackupOffering(p *AssignVi
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AssignVirtualMachineToBackupOffering async job into r
+func (r *AssignVirtualMachineToBackupOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// AssignVirtualMachineToBackupOfferingAsync starts the async job of AssignVirtualMachineToBackupOffering without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) AssignVirtualMachineToBackupOfferingAsync(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*Job, error) {
+	r, err := s.AssignVirtualMachineToBackupOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("assignVirtualMachineToBackupOffering", r.JobID), nil
+}
+
 type AssignVirtualMachineToBackupOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssignVirtualMachineToBackupOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias AssignVirtualMachineToBackupOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveVirtualMachineFromBackupOfferingParams struct {
@@ -14960,6 +18073,45 @@ func (p *RemoveVirtualMachineFromBackupOfferingParams) toURLValues() url.Values
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeVirtualMachineFromBackupOffering API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveVirtualMachineFromBackupOfferingParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeVirtualMachineFromBackupOffering", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeVirtualMachineFromBackupOffering API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveVirtualMachineFromBackupOfferingParams) Validate() error {
+	return paramRules{
+		required: []string{"virtualmachineid"},
+		lengths:  map[string]int{"virtualmachineid": 255},
+	}.validate("removeVirtualMachineFromBackupOffering", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeVirtualMachineFromBackupOffering API, before the request is signed
+func (p *RemoveVirtualMachineFromBackupOfferingParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveVirtualMachineFromBackupOfferingParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveVirtualMachineFromBackupOfferingParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeVirtualMachineFromBackupOffering", b, &p.p, map[string]paramDecoder{
+		"forced":           decodeParam[bool],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveVirtualMachineFromBackupOfferingParams) Clone() *RemoveVirtualMachineFromBackupOfferingParams {
+	return &RemoveVirtualMachineFromBackupOfferingParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveVirtualMachineFromBackupOfferingParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -15013,27 +18165,39 @@ func (s *VirtualMachineService) NewRemoveVirtualMachineFromBackupOfferingParams(
 
 // Removes an Instance from any existing backup offering
 func (s *VirtualMachineService) RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
-	resp, err := s.cs.newPostRequest("removeVirtualMachineFromBackupOffering", p.toURLValues())
+	return s.RemoveVirtualMachineFromBackupOfferingWithContext(context.Background(), p)
+}
+
+// RemoveVirtualMachineFromBackupOfferingWithContext is like RemoveVirtualMachineFromBackupOffering, but honours the cancellation and deadline of ctx
+func (s *VirtualMachineService) RemoveVirtualMachineFromBackupOfferingWithContext(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "removeVirtualMachineFromBackupOffering", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveVirtualMachineFromBackupOfferingResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeVirtualMachineFromBackupOffering", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "removeVirtualMachineFromBackupOffering", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("removeVirtualMachineFromBackupOffering", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -15041,9 +18205,29 @@ func (s *VirtualMachineService) RemoveVirtualMachineFromBackupOffering(p *Remove
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RemoveVirtualMachineFromBackupOffering async job into r
+func (r *RemoveVirtualMachineFromBackupOfferingResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RemoveVirtualMachineFromBackupOfferingAsync starts the async job of RemoveVirtualMachineFromBackupOffering without waiting for it to finish, and returns a handle to the job
+func (s *VirtualMachineService) RemoveVirtualMachineFromBackupOfferingAsync(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*Job, error) {
+	r, err := s.RemoveVirtualMachineFromBackupOfferingWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("removeVirtualMachineFromBackupOffering", r.JobID), nil
+}
+
 type RemoveVirtualMachineFromBackupOfferingResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RemoveVirtualMachineFromBackupOfferingResponse) UnmarshalJSON(b []byte) error {
+	type alias RemoveVirtualMachineFromBackupOfferingResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) AddNicToVirtualMachine(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNicToVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AddNicToVirtualMachine), p)
 }
 
+// AddNicToVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) AddNicToVirtualMachineAsync(ctx context.Context, p *AddNicToVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNicToVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNicToVirtualMachineAsync indicates an expected call of AddNicToVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AddNicToVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNicToVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AddNicToVirtualMachineAsync), ctx, p)
+}
+
+// AddNicToVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) AddNicToVirtualMachineWithContext(ctx context.Context, p *AddNicToVirtualMachineParams) (*AddNicToVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddNicToVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddNicToVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddNicToVirtualMachineWithContext indicates an expected call of AddNicToVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AddNicToVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddNicToVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AddNicToVirtualMachineWithContext), ctx, p)
+}
+
 // AssignVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) AssignVirtualMachine(p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +135,51 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) AssignVirtualMachineToBack
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachineToBackupOffering", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AssignVirtualMachineToBackupOffering), p)
 }
 
+// AssignVirtualMachineToBackupOfferingAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) AssignVirtualMachineToBackupOfferingAsync(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignVirtualMachineToBackupOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignVirtualMachineToBackupOfferingAsync indicates an expected call of AssignVirtualMachineToBackupOfferingAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AssignVirtualMachineToBackupOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachineToBackupOfferingAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AssignVirtualMachineToBackupOfferingAsync), ctx, p)
+}
+
+// AssignVirtualMachineToBackupOfferingWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) AssignVirtualMachineToBackupOfferingWithContext(ctx context.Context, p *AssignVirtualMachineToBackupOfferingParams) (*AssignVirtualMachineToBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignVirtualMachineToBackupOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssignVirtualMachineToBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignVirtualMachineToBackupOfferingWithContext indicates an expected call of AssignVirtualMachineToBackupOfferingWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AssignVirtualMachineToBackupOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachineToBackupOfferingWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AssignVirtualMachineToBackupOfferingWithContext), ctx, p)
+}
+
+// AssignVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) AssignVirtualMachineWithContext(ctx context.Context, p *AssignVirtualMachineParams) (*AssignVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssignVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignVirtualMachineWithContext indicates an expected call of AssignVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) AssignVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).AssignVirtualMachineWithContext), ctx, p)
+}
+
 // ChangeServiceForVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) ChangeServiceForVirtualMachine(p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,21 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ChangeServiceForVirtualMac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ChangeServiceForVirtualMachine), p)
 }
 
+// ChangeServiceForVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ChangeServiceForVirtualMachineWithContext(ctx context.Context, p *ChangeServiceForVirtualMachineParams) (*ChangeServiceForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeServiceForVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeServiceForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeServiceForVirtualMachineWithContext indicates an expected call of ChangeServiceForVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ChangeServiceForVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeServiceForVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ChangeServiceForVirtualMachineWithContext), ctx, p)
+}
+
 // CleanVMReservations mocks base method.
 func (m *MockVirtualMachineServiceIface) CleanVMReservations(p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +225,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) CleanVMReservations(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanVMReservations", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CleanVMReservations), p)
 }
 
+// CleanVMReservationsAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) CleanVMReservationsAsync(ctx context.Context, p *CleanVMReservationsParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanVMReservationsAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanVMReservationsAsync indicates an expected call of CleanVMReservationsAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) CleanVMReservationsAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanVMReservationsAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CleanVMReservationsAsync), ctx, p)
+}
+
+// CleanVMReservationsWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) CleanVMReservationsWithContext(ctx context.Context, p *CleanVMReservationsParams) (*CleanVMReservationsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CleanVMReservationsWithContext", ctx, p)
+	ret0, _ := ret[0].(*CleanVMReservationsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CleanVMReservationsWithContext indicates an expected call of CleanVMReservationsWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) CleanVMReservationsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CleanVMReservationsWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CleanVMReservationsWithContext), ctx, p)
+}
+
 // CreateVMSchedule mocks base method.
 func (m *MockVirtualMachineServiceIface) CreateVMSchedule(p *CreateVMScheduleParams) (*CreateVMScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +270,21 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) CreateVMSchedule(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMSchedule", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CreateVMSchedule), p)
 }
 
+// CreateVMScheduleWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) CreateVMScheduleWithContext(ctx context.Context, p *CreateVMScheduleParams) (*CreateVMScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVMScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVMScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVMScheduleWithContext indicates an expected call of CreateVMScheduleWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) CreateVMScheduleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVMScheduleWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).CreateVMScheduleWithContext), ctx, p)
+}
+
 // DeleteVMSchedule mocks base method.
 func (m *MockVirtualMachineServiceIface) DeleteVMSchedule(p *DeleteVMScheduleParams) (*DeleteVMScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +300,21 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeleteVMSchedule(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMSchedule", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeleteVMSchedule), p)
 }
 
+// DeleteVMScheduleWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) DeleteVMScheduleWithContext(ctx context.Context, p *DeleteVMScheduleParams) (*DeleteVMScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVMScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVMScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVMScheduleWithContext indicates an expected call of DeleteVMScheduleWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeleteVMScheduleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVMScheduleWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeleteVMScheduleWithContext), ctx, p)
+}
+
 // DeployVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) DeployVirtualMachine(p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +330,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeployVirtualMachine(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeployVirtualMachine), p)
 }
 
+// DeployVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) DeployVirtualMachineAsync(ctx context.Context, p *DeployVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployVirtualMachineAsync indicates an expected call of DeployVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeployVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeployVirtualMachineAsync), ctx, p)
+}
+
+// DeployVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) DeployVirtualMachineWithContext(ctx context.Context, p *DeployVirtualMachineParams) (*DeployVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeployVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployVirtualMachineWithContext indicates an expected call of DeployVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DeployVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DeployVirtualMachineWithContext), ctx, p)
+}
+
 // DestroyVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) DestroyVirtualMachine(p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +375,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) DestroyVirtualMachine(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DestroyVirtualMachine), p)
 }
 
+// DestroyVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) DestroyVirtualMachineAsync(ctx context.Context, p *DestroyVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyVirtualMachineAsync indicates an expected call of DestroyVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DestroyVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DestroyVirtualMachineAsync), ctx, p)
+}
+
+// DestroyVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) DestroyVirtualMachineWithContext(ctx context.Context, p *DestroyVirtualMachineParams) (*DestroyVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*DestroyVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyVirtualMachineWithContext indicates an expected call of DestroyVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) DestroyVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).DestroyVirtualMachineWithContext), ctx, p)
+}
+
 // ExpungeVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) ExpungeVirtualMachine(p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +420,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ExpungeVirtualMachine(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ExpungeVirtualMachine), p)
 }
 
+// ExpungeVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) ExpungeVirtualMachineAsync(ctx context.Context, p *ExpungeVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpungeVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpungeVirtualMachineAsync indicates an expected call of ExpungeVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ExpungeVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ExpungeVirtualMachineAsync), ctx, p)
+}
+
+// ExpungeVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ExpungeVirtualMachineWithContext(ctx context.Context, p *ExpungeVirtualMachineParams) (*ExpungeVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExpungeVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExpungeVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExpungeVirtualMachineWithContext indicates an expected call of ExpungeVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ExpungeVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExpungeVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ExpungeVirtualMachineWithContext), ctx, p)
+}
+
 // GetVMPassword mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVMPassword(p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +465,21 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVMPassword(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMPassword", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVMPassword), p)
 }
 
+// GetVMPasswordWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVMPasswordWithContext(ctx context.Context, p *GetVMPasswordParams) (*GetVMPasswordResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVMPasswordWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetVMPasswordResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVMPasswordWithContext indicates an expected call of GetVMPasswordWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVMPasswordWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMPasswordWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVMPasswordWithContext), ctx, p)
+}
+
 // GetVMScheduleByID mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVMScheduleByID(id, virtualmachineid string, opts ...OptionFunc) (*VMSchedule, int, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +501,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVMScheduleByID(id, virt
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMScheduleByID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVMScheduleByID), varargs...)
 }
 
+// GetVMScheduleByIDWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVMScheduleByIDWithContext(ctx context.Context, id, virtualmachineid string, opts ...OptionFunc) (*VMSchedule, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, virtualmachineid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVMScheduleByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VMSchedule)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVMScheduleByIDWithContext indicates an expected call of GetVMScheduleByIDWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVMScheduleByIDWithContext(ctx, id, virtualmachineid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, virtualmachineid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVMScheduleByIDWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVMScheduleByIDWithContext), varargs...)
+}
+
 // GetVirtualMachineByID mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachineByID(id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +543,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineByID(id a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineByID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineByID), varargs...)
 }
 
+// GetVirtualMachineByIDWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachineByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachineByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualMachine)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachineByIDWithContext indicates an expected call of GetVirtualMachineByIDWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineByIDWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineByIDWithContext), varargs...)
+}
+
 // GetVirtualMachineByName mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachineByName(name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +585,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineByName(na
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineByName", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineByName), varargs...)
 }
 
+// GetVirtualMachineByNameWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachineByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachine, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachineByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualMachine)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachineByNameWithContext indicates an expected call of GetVirtualMachineByNameWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineByNameWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineByNameWithContext), varargs...)
+}
+
 // GetVirtualMachineID mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachineID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +627,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineID(name a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineID), varargs...)
 }
 
+// GetVirtualMachineIDWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachineIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachineIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachineIDWithContext indicates an expected call of GetVirtualMachineIDWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachineIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachineIDWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachineIDWithContext), varargs...)
+}
+
 // GetVirtualMachinesMetricByID mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricByID(id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -328,6 +669,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricBy
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricByID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricByID), varargs...)
 }
 
+// GetVirtualMachinesMetricByIDWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesMetricByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualMachinesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesMetricByIDWithContext indicates an expected call of GetVirtualMachinesMetricByIDWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricByIDWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricByIDWithContext), varargs...)
+}
+
 // GetVirtualMachinesMetricByName mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricByName(name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -349,6 +711,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricBy
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricByName", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricByName), varargs...)
 }
 
+// GetVirtualMachinesMetricByNameWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesMetricByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualMachinesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesMetricByNameWithContext indicates an expected call of GetVirtualMachinesMetricByNameWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricByNameWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricByNameWithContext), varargs...)
+}
+
 // GetVirtualMachinesMetricID mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -370,6 +753,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricID), varargs...)
 }
 
+// GetVirtualMachinesMetricIDWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesMetricIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesMetricIDWithContext indicates an expected call of GetVirtualMachinesMetricIDWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesMetricIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesMetricIDWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesMetricIDWithContext), varargs...)
+}
+
 // GetVirtualMachinesUsageHistoryByID mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachinesUsageHistoryByID(id string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
 	m.ctrl.T.Helper()
@@ -391,6 +795,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesUsageHis
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesUsageHistoryByID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesUsageHistoryByID), varargs...)
 }
 
+// GetVirtualMachinesUsageHistoryByIDWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesUsageHistoryByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualMachinesUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesUsageHistoryByIDWithContext indicates an expected call of GetVirtualMachinesUsageHistoryByIDWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesUsageHistoryByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesUsageHistoryByIDWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesUsageHistoryByIDWithContext), varargs...)
+}
+
 // GetVirtualMachinesUsageHistoryByName mocks base method.
 func (m *MockVirtualMachineServiceIface) GetVirtualMachinesUsageHistoryByName(name string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
 	m.ctrl.T.Helper()
@@ -412,15 +837,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesUsageHis
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesUsageHistoryByName", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesUsageHistoryByName), varargs...)
 }
 
-// GetVirtualMachinesUsageHistoryID mocks base method.
-func (m *MockVirtualMachineServiceIface) GetVirtualMachinesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
+// GetVirtualMachinesUsageHistoryByNameWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VirtualMachinesUsageHistory, int, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, name}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVirtualMachinesUsageHistoryID", varargs...)
-	ret0, _ := ret[0].(string)
+	ret := m.ctrl.Call(m, "GetVirtualMachinesUsageHistoryByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VirtualMachinesUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesUsageHistoryByNameWithContext indicates an expected call of GetVirtualMachinesUsageHistoryByNameWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesUsageHistoryByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesUsageHistoryByNameWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesUsageHistoryByNameWithContext), varargs...)
+}
+
+// GetVirtualMachinesUsageHistoryID mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesUsageHistoryID", varargs...)
+	ret0, _ := ret[0].(string)
 	ret1, _ := ret[1].(int)
 	ret2, _ := ret[2].(error)
 	return ret0, ret1, ret2
@@ -433,6 +879,27 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesUsageHis
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesUsageHistoryID", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesUsageHistoryID), varargs...)
 }
 
+// GetVirtualMachinesUsageHistoryIDWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) GetVirtualMachinesUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVirtualMachinesUsageHistoryIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVirtualMachinesUsageHistoryIDWithContext indicates an expected call of GetVirtualMachinesUsageHistoryIDWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) GetVirtualMachinesUsageHistoryIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVirtualMachinesUsageHistoryIDWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).GetVirtualMachinesUsageHistoryIDWithContext), varargs...)
+}
+
 // ImportUnmanagedInstance mocks base method.
 func (m *MockVirtualMachineServiceIface) ImportUnmanagedInstance(p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error) {
 	m.ctrl.T.Helper()
@@ -448,6 +915,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ImportUnmanagedInstance(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUnmanagedInstance", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ImportUnmanagedInstance), p)
 }
 
+// ImportUnmanagedInstanceAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) ImportUnmanagedInstanceAsync(ctx context.Context, p *ImportUnmanagedInstanceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUnmanagedInstanceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUnmanagedInstanceAsync indicates an expected call of ImportUnmanagedInstanceAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ImportUnmanagedInstanceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUnmanagedInstanceAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ImportUnmanagedInstanceAsync), ctx, p)
+}
+
+// ImportUnmanagedInstanceWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ImportUnmanagedInstanceWithContext(ctx context.Context, p *ImportUnmanagedInstanceParams) (*ImportUnmanagedInstanceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportUnmanagedInstanceWithContext", ctx, p)
+	ret0, _ := ret[0].(*ImportUnmanagedInstanceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportUnmanagedInstanceWithContext indicates an expected call of ImportUnmanagedInstanceWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ImportUnmanagedInstanceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportUnmanagedInstanceWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ImportUnmanagedInstanceWithContext), ctx, p)
+}
+
 // ImportVm mocks base method.
 func (m *MockVirtualMachineServiceIface) ImportVm(p *ImportVmParams) (*ImportVmResponse, error) {
 	m.ctrl.T.Helper()
@@ -463,6 +960,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ImportVm(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVm", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ImportVm), p)
 }
 
+// ImportVmAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) ImportVmAsync(ctx context.Context, p *ImportVmParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportVmAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportVmAsync indicates an expected call of ImportVmAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ImportVmAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVmAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ImportVmAsync), ctx, p)
+}
+
+// ImportVmWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ImportVmWithContext(ctx context.Context, p *ImportVmParams) (*ImportVmResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportVmWithContext", ctx, p)
+	ret0, _ := ret[0].(*ImportVmResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportVmWithContext indicates an expected call of ImportVmWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ImportVmWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVmWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ImportVmWithContext), ctx, p)
+}
+
 // ListImportVmTasks mocks base method.
 func (m *MockVirtualMachineServiceIface) ListImportVmTasks(p *ListImportVmTasksParams) (*ListImportVmTasksResponse, error) {
 	m.ctrl.T.Helper()
@@ -478,6 +1005,99 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListImportVmTasks(p any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportVmTasks", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListImportVmTasks), p)
 }
 
+// ListImportVmTasksAll mocks base method.
+func (m *MockVirtualMachineServiceIface) ListImportVmTasksAll(p *ListImportVmTasksParams, opts ...PageOption) ([]*ImportVmTask, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImportVmTasksAll", varargs...)
+	ret0, _ := ret[0].([]*ImportVmTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportVmTasksAll indicates an expected call of ListImportVmTasksAll.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListImportVmTasksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportVmTasksAll", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListImportVmTasksAll), varargs...)
+}
+
+// ListImportVmTasksAllWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListImportVmTasksAllWithContext(ctx context.Context, p *ListImportVmTasksParams, opts ...PageOption) ([]*ImportVmTask, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImportVmTasksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ImportVmTask)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportVmTasksAllWithContext indicates an expected call of ListImportVmTasksAllWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListImportVmTasksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportVmTasksAllWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListImportVmTasksAllWithContext), varargs...)
+}
+
+// ListImportVmTasksIter mocks base method.
+func (m *MockVirtualMachineServiceIface) ListImportVmTasksIter(p *ListImportVmTasksParams, opts ...PageOption) iter.Seq2[*ImportVmTask, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImportVmTasksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ImportVmTask, error])
+	return ret0
+}
+
+// ListImportVmTasksIter indicates an expected call of ListImportVmTasksIter.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListImportVmTasksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportVmTasksIter", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListImportVmTasksIter), varargs...)
+}
+
+// ListImportVmTasksIterWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListImportVmTasksIterWithContext(ctx context.Context, p *ListImportVmTasksParams, opts ...PageOption) iter.Seq2[*ImportVmTask, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListImportVmTasksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ImportVmTask, error])
+	return ret0
+}
+
+// ListImportVmTasksIterWithContext indicates an expected call of ListImportVmTasksIterWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListImportVmTasksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportVmTasksIterWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListImportVmTasksIterWithContext), varargs...)
+}
+
+// ListImportVmTasksWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListImportVmTasksWithContext(ctx context.Context, p *ListImportVmTasksParams) (*ListImportVmTasksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListImportVmTasksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListImportVmTasksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListImportVmTasksWithContext indicates an expected call of ListImportVmTasksWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListImportVmTasksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListImportVmTasksWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListImportVmTasksWithContext), ctx, p)
+}
+
 // ListUnmanagedInstances mocks base method.
 func (m *MockVirtualMachineServiceIface) ListUnmanagedInstances(p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -493,6 +1113,99 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListUnmanagedInstances(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnmanagedInstances", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListUnmanagedInstances), p)
 }
 
+// ListUnmanagedInstancesAll mocks base method.
+func (m *MockVirtualMachineServiceIface) ListUnmanagedInstancesAll(p *ListUnmanagedInstancesParams, opts ...PageOption) ([]*UnmanagedInstance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUnmanagedInstancesAll", varargs...)
+	ret0, _ := ret[0].([]*UnmanagedInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnmanagedInstancesAll indicates an expected call of ListUnmanagedInstancesAll.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListUnmanagedInstancesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnmanagedInstancesAll", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListUnmanagedInstancesAll), varargs...)
+}
+
+// ListUnmanagedInstancesAllWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListUnmanagedInstancesAllWithContext(ctx context.Context, p *ListUnmanagedInstancesParams, opts ...PageOption) ([]*UnmanagedInstance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUnmanagedInstancesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*UnmanagedInstance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnmanagedInstancesAllWithContext indicates an expected call of ListUnmanagedInstancesAllWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListUnmanagedInstancesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnmanagedInstancesAllWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListUnmanagedInstancesAllWithContext), varargs...)
+}
+
+// ListUnmanagedInstancesIter mocks base method.
+func (m *MockVirtualMachineServiceIface) ListUnmanagedInstancesIter(p *ListUnmanagedInstancesParams, opts ...PageOption) iter.Seq2[*UnmanagedInstance, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUnmanagedInstancesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UnmanagedInstance, error])
+	return ret0
+}
+
+// ListUnmanagedInstancesIter indicates an expected call of ListUnmanagedInstancesIter.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListUnmanagedInstancesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnmanagedInstancesIter", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListUnmanagedInstancesIter), varargs...)
+}
+
+// ListUnmanagedInstancesIterWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListUnmanagedInstancesIterWithContext(ctx context.Context, p *ListUnmanagedInstancesParams, opts ...PageOption) iter.Seq2[*UnmanagedInstance, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListUnmanagedInstancesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*UnmanagedInstance, error])
+	return ret0
+}
+
+// ListUnmanagedInstancesIterWithContext indicates an expected call of ListUnmanagedInstancesIterWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListUnmanagedInstancesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnmanagedInstancesIterWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListUnmanagedInstancesIterWithContext), varargs...)
+}
+
+// ListUnmanagedInstancesWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListUnmanagedInstancesWithContext(ctx context.Context, p *ListUnmanagedInstancesParams) (*ListUnmanagedInstancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListUnmanagedInstancesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListUnmanagedInstancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListUnmanagedInstancesWithContext indicates an expected call of ListUnmanagedInstancesWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListUnmanagedInstancesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListUnmanagedInstancesWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListUnmanagedInstancesWithContext), ctx, p)
+}
+
 // ListVMSchedule mocks base method.
 func (m *MockVirtualMachineServiceIface) ListVMSchedule(p *ListVMScheduleParams) (*ListVMScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -508,6 +1221,99 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVMSchedule(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMSchedule", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVMSchedule), p)
 }
 
+// ListVMScheduleAll mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVMScheduleAll(p *ListVMScheduleParams, opts ...PageOption) ([]*VMSchedule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMScheduleAll", varargs...)
+	ret0, _ := ret[0].([]*VMSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMScheduleAll indicates an expected call of ListVMScheduleAll.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVMScheduleAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMScheduleAll", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVMScheduleAll), varargs...)
+}
+
+// ListVMScheduleAllWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVMScheduleAllWithContext(ctx context.Context, p *ListVMScheduleParams, opts ...PageOption) ([]*VMSchedule, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMScheduleAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VMSchedule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMScheduleAllWithContext indicates an expected call of ListVMScheduleAllWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVMScheduleAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMScheduleAllWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVMScheduleAllWithContext), varargs...)
+}
+
+// ListVMScheduleIter mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVMScheduleIter(p *ListVMScheduleParams, opts ...PageOption) iter.Seq2[*VMSchedule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMScheduleIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VMSchedule, error])
+	return ret0
+}
+
+// ListVMScheduleIter indicates an expected call of ListVMScheduleIter.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVMScheduleIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMScheduleIter", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVMScheduleIter), varargs...)
+}
+
+// ListVMScheduleIterWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVMScheduleIterWithContext(ctx context.Context, p *ListVMScheduleParams, opts ...PageOption) iter.Seq2[*VMSchedule, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVMScheduleIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VMSchedule, error])
+	return ret0
+}
+
+// ListVMScheduleIterWithContext indicates an expected call of ListVMScheduleIterWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVMScheduleIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMScheduleIterWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVMScheduleIterWithContext), varargs...)
+}
+
+// ListVMScheduleWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVMScheduleWithContext(ctx context.Context, p *ListVMScheduleParams) (*ListVMScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVMScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVMScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVMScheduleWithContext indicates an expected call of ListVMScheduleWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVMScheduleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVMScheduleWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVMScheduleWithContext), ctx, p)
+}
+
 // ListVirtualMachines mocks base method.
 func (m *MockVirtualMachineServiceIface) ListVirtualMachines(p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
 	m.ctrl.T.Helper()
@@ -523,64 +1329,466 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachines(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachines", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachines), p)
 }
 
+// ListVirtualMachinesAll mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesAll(p *ListVirtualMachinesParams, opts ...PageOption) ([]*VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesAll", varargs...)
+	ret0, _ := ret[0].([]*VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesAll indicates an expected call of ListVirtualMachinesAll.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesAll", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesAll), varargs...)
+}
+
+// ListVirtualMachinesAllWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesAllWithContext(ctx context.Context, p *ListVirtualMachinesParams, opts ...PageOption) ([]*VirtualMachine, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VirtualMachine)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesAllWithContext indicates an expected call of ListVirtualMachinesAllWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesAllWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesAllWithContext), varargs...)
+}
+
+// ListVirtualMachinesIter mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesIter(p *ListVirtualMachinesParams, opts ...PageOption) iter.Seq2[*VirtualMachine, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualMachine, error])
+	return ret0
+}
+
+// ListVirtualMachinesIter indicates an expected call of ListVirtualMachinesIter.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesIter", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesIter), varargs...)
+}
+
+// ListVirtualMachinesIterWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesIterWithContext(ctx context.Context, p *ListVirtualMachinesParams, opts ...PageOption) iter.Seq2[*VirtualMachine, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualMachine, error])
+	return ret0
+}
+
+// ListVirtualMachinesIterWithContext indicates an expected call of ListVirtualMachinesIterWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesIterWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesIterWithContext), varargs...)
+}
+
 // ListVirtualMachinesMetrics mocks base method.
 func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetrics(p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVirtualMachinesMetrics", p)
-	ret0, _ := ret[0].(*ListVirtualMachinesMetricsResponse)
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetrics", p)
+	ret0, _ := ret[0].(*ListVirtualMachinesMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesMetrics indicates an expected call of ListVirtualMachinesMetrics.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetrics(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetrics", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetrics), p)
+}
+
+// ListVirtualMachinesMetricsAll mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetricsAll(p *ListVirtualMachinesMetricsParams, opts ...PageOption) ([]*VirtualMachinesMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetricsAll", varargs...)
+	ret0, _ := ret[0].([]*VirtualMachinesMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesMetricsAll indicates an expected call of ListVirtualMachinesMetricsAll.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetricsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetricsAll", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetricsAll), varargs...)
+}
+
+// ListVirtualMachinesMetricsAllWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetricsAllWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams, opts ...PageOption) ([]*VirtualMachinesMetric, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetricsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VirtualMachinesMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesMetricsAllWithContext indicates an expected call of ListVirtualMachinesMetricsAllWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetricsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetricsAllWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetricsAllWithContext), varargs...)
+}
+
+// ListVirtualMachinesMetricsIter mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetricsIter(p *ListVirtualMachinesMetricsParams, opts ...PageOption) iter.Seq2[*VirtualMachinesMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetricsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualMachinesMetric, error])
+	return ret0
+}
+
+// ListVirtualMachinesMetricsIter indicates an expected call of ListVirtualMachinesMetricsIter.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetricsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetricsIter", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetricsIter), varargs...)
+}
+
+// ListVirtualMachinesMetricsIterWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetricsIterWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams, opts ...PageOption) iter.Seq2[*VirtualMachinesMetric, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetricsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualMachinesMetric, error])
+	return ret0
+}
+
+// ListVirtualMachinesMetricsIterWithContext indicates an expected call of ListVirtualMachinesMetricsIterWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetricsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetricsIterWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetricsIterWithContext), varargs...)
+}
+
+// ListVirtualMachinesMetricsWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesMetricsWithContext(ctx context.Context, p *ListVirtualMachinesMetricsParams) (*ListVirtualMachinesMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachinesMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVirtualMachinesMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesMetricsWithContext indicates an expected call of ListVirtualMachinesMetricsWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetricsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetricsWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetricsWithContext), ctx, p)
+}
+
+// ListVirtualMachinesUsageHistory mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistory", p)
+	ret0, _ := ret[0].(*ListVirtualMachinesUsageHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesUsageHistory indicates an expected call of ListVirtualMachinesUsageHistory.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistory(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistory", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistory), p)
+}
+
+// ListVirtualMachinesUsageHistoryAll mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistoryAll(p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) ([]*VirtualMachinesUsageHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistoryAll", varargs...)
+	ret0, _ := ret[0].([]*VirtualMachinesUsageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesUsageHistoryAll indicates an expected call of ListVirtualMachinesUsageHistoryAll.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistoryAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistoryAll", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistoryAll), varargs...)
+}
+
+// ListVirtualMachinesUsageHistoryAllWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistoryAllWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) ([]*VirtualMachinesUsageHistory, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistoryAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VirtualMachinesUsageHistory)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesUsageHistoryAllWithContext indicates an expected call of ListVirtualMachinesUsageHistoryAllWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistoryAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistoryAllWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistoryAllWithContext), varargs...)
+}
+
+// ListVirtualMachinesUsageHistoryIter mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistoryIter(p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VirtualMachinesUsageHistory, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistoryIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualMachinesUsageHistory, error])
+	return ret0
+}
+
+// ListVirtualMachinesUsageHistoryIter indicates an expected call of ListVirtualMachinesUsageHistoryIter.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistoryIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistoryIter", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistoryIter), varargs...)
+}
+
+// ListVirtualMachinesUsageHistoryIterWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistoryIterWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VirtualMachinesUsageHistory, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistoryIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VirtualMachinesUsageHistory, error])
+	return ret0
+}
+
+// ListVirtualMachinesUsageHistoryIterWithContext indicates an expected call of ListVirtualMachinesUsageHistoryIterWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistoryIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistoryIterWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistoryIterWithContext), varargs...)
+}
+
+// ListVirtualMachinesUsageHistoryWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistoryWithContext(ctx context.Context, p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVirtualMachinesUsageHistoryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesUsageHistoryWithContext indicates an expected call of ListVirtualMachinesUsageHistoryWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistoryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistoryWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistoryWithContext), ctx, p)
+}
+
+// ListVirtualMachinesWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVirtualMachinesWithContext(ctx context.Context, p *ListVirtualMachinesParams) (*ListVirtualMachinesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVirtualMachinesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVirtualMachinesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVirtualMachinesWithContext indicates an expected call of ListVirtualMachinesWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesWithContext), ctx, p)
+}
+
+// ListVmsForImport mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVmsForImport(p *ListVmsForImportParams) (*ListVmsForImportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVmsForImport", p)
+	ret0, _ := ret[0].(*ListVmsForImportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmsForImport indicates an expected call of ListVmsForImport.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVmsForImport(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmsForImport", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVmsForImport), p)
+}
+
+// ListVmsForImportAll mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVmsForImportAll(p *ListVmsForImportParams, opts ...PageOption) ([]*VmsForImport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmsForImportAll", varargs...)
+	ret0, _ := ret[0].([]*VmsForImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmsForImportAll indicates an expected call of ListVmsForImportAll.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVmsForImportAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmsForImportAll", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVmsForImportAll), varargs...)
+}
+
+// ListVmsForImportAllWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVmsForImportAllWithContext(ctx context.Context, p *ListVmsForImportParams, opts ...PageOption) ([]*VmsForImport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmsForImportAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VmsForImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmsForImportAllWithContext indicates an expected call of ListVmsForImportAllWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVmsForImportAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmsForImportAllWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVmsForImportAllWithContext), varargs...)
+}
+
+// ListVmsForImportIter mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVmsForImportIter(p *ListVmsForImportParams, opts ...PageOption) iter.Seq2[*VmsForImport, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmsForImportIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VmsForImport, error])
+	return ret0
+}
+
+// ListVmsForImportIter indicates an expected call of ListVmsForImportIter.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVmsForImportIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmsForImportIter", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVmsForImportIter), varargs...)
+}
+
+// ListVmsForImportIterWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVmsForImportIterWithContext(ctx context.Context, p *ListVmsForImportParams, opts ...PageOption) iter.Seq2[*VmsForImport, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmsForImportIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VmsForImport, error])
+	return ret0
+}
+
+// ListVmsForImportIterWithContext indicates an expected call of ListVmsForImportIterWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVmsForImportIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmsForImportIterWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVmsForImportIterWithContext), varargs...)
+}
+
+// ListVmsForImportWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ListVmsForImportWithContext(ctx context.Context, p *ListVmsForImportParams) (*ListVmsForImportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVmsForImportWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVmsForImportResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVirtualMachinesMetrics indicates an expected call of ListVirtualMachinesMetrics.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesMetrics(p any) *gomock.Call {
+// ListVmsForImportWithContext indicates an expected call of ListVmsForImportWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVmsForImportWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesMetrics", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesMetrics), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmsForImportWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVmsForImportWithContext), ctx, p)
 }
 
-// ListVirtualMachinesUsageHistory mocks base method.
-func (m *MockVirtualMachineServiceIface) ListVirtualMachinesUsageHistory(p *ListVirtualMachinesUsageHistoryParams) (*ListVirtualMachinesUsageHistoryResponse, error) {
+// MigrateVirtualMachine mocks base method.
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVirtualMachinesUsageHistory", p)
-	ret0, _ := ret[0].(*ListVirtualMachinesUsageHistoryResponse)
+	ret := m.ctrl.Call(m, "MigrateVirtualMachine", p)
+	ret0, _ := ret[0].(*MigrateVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVirtualMachinesUsageHistory indicates an expected call of ListVirtualMachinesUsageHistory.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVirtualMachinesUsageHistory(p any) *gomock.Call {
+// MigrateVirtualMachine indicates an expected call of MigrateVirtualMachine.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachine(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVirtualMachinesUsageHistory", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVirtualMachinesUsageHistory), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachine), p)
 }
 
-// ListVmsForImport mocks base method.
-func (m *MockVirtualMachineServiceIface) ListVmsForImport(p *ListVmsForImportParams) (*ListVmsForImportResponse, error) {
+// MigrateVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachineAsync(ctx context.Context, p *MigrateVirtualMachineParams) (*Job, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVmsForImport", p)
-	ret0, _ := ret[0].(*ListVmsForImportResponse)
+	ret := m.ctrl.Call(m, "MigrateVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVmsForImport indicates an expected call of ListVmsForImport.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) ListVmsForImport(p any) *gomock.Call {
+// MigrateVirtualMachineAsync indicates an expected call of MigrateVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineAsync(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmsForImport", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ListVmsForImport), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineAsync), ctx, p)
 }
 
-// MigrateVirtualMachine mocks base method.
-func (m *MockVirtualMachineServiceIface) MigrateVirtualMachine(p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
+// MigrateVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachineWithContext(ctx context.Context, p *MigrateVirtualMachineParams) (*MigrateVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MigrateVirtualMachine", p)
+	ret := m.ctrl.Call(m, "MigrateVirtualMachineWithContext", ctx, p)
 	ret0, _ := ret[0].(*MigrateVirtualMachineResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// MigrateVirtualMachine indicates an expected call of MigrateVirtualMachine.
-func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachine(p any) *gomock.Call {
+// MigrateVirtualMachineWithContext indicates an expected call of MigrateVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachine), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineWithContext), ctx, p)
 }
 
 // MigrateVirtualMachineWithVolume mocks base method.
@@ -598,6 +1806,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineWithV
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineWithVolume", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineWithVolume), p)
 }
 
+// MigrateVirtualMachineWithVolumeAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachineWithVolumeAsync(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVirtualMachineWithVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVirtualMachineWithVolumeAsync indicates an expected call of MigrateVirtualMachineWithVolumeAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineWithVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineWithVolumeAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineWithVolumeAsync), ctx, p)
+}
+
+// MigrateVirtualMachineWithVolumeWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) MigrateVirtualMachineWithVolumeWithContext(ctx context.Context, p *MigrateVirtualMachineWithVolumeParams) (*MigrateVirtualMachineWithVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVirtualMachineWithVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*MigrateVirtualMachineWithVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVirtualMachineWithVolumeWithContext indicates an expected call of MigrateVirtualMachineWithVolumeWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) MigrateVirtualMachineWithVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVirtualMachineWithVolumeWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).MigrateVirtualMachineWithVolumeWithContext), ctx, p)
+}
+
 // NewAddNicToVirtualMachineParams mocks base method.
 func (m *MockVirtualMachineServiceIface) NewAddNicToVirtualMachineParams(networkid, virtualmachineid string) *AddNicToVirtualMachineParams {
 	m.ctrl.T.Helper()
@@ -767,7 +2005,7 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewImportUnmanagedInstance
 }
 
 // NewImportVmParams mocks base method.
-func (m *MockVirtualMachineServiceIface) NewImportVmParams(clusterid, hypervisor, importsource, name, serviceofferingid, zoneid string) *ImportVmParams {
+func (m *MockVirtualMachineServiceIface) NewImportVmParams(clusterid string, hypervisor HypervisorType, importsource, name, serviceofferingid, zoneid string) *ImportVmParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewImportVmParams", clusterid, hypervisor, importsource, name, serviceofferingid, zoneid)
 	ret0, _ := ret[0].(*ImportVmParams)
@@ -865,7 +2103,7 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) NewListVirtualMachinesUsag
 }
 
 // NewListVmsForImportParams mocks base method.
-func (m *MockVirtualMachineServiceIface) NewListVmsForImportParams(host, hypervisor, zoneid string) *ListVmsForImportParams {
+func (m *MockVirtualMachineServiceIface) NewListVmsForImportParams(host string, hypervisor HypervisorType, zoneid string) *ListVmsForImportParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewListVmsForImportParams", host, hypervisor, zoneid)
 	ret0, _ := ret[0].(*ListVmsForImportParams)
@@ -1117,6 +2355,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) RebootVirtualMachine(p any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RebootVirtualMachine), p)
 }
 
+// RebootVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) RebootVirtualMachineAsync(ctx context.Context, p *RebootVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootVirtualMachineAsync indicates an expected call of RebootVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RebootVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RebootVirtualMachineAsync), ctx, p)
+}
+
+// RebootVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) RebootVirtualMachineWithContext(ctx context.Context, p *RebootVirtualMachineParams) (*RebootVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RebootVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*RebootVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RebootVirtualMachineWithContext indicates an expected call of RebootVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RebootVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RebootVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RebootVirtualMachineWithContext), ctx, p)
+}
+
 // RecoverVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) RecoverVirtualMachine(p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1132,6 +2400,21 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) RecoverVirtualMachine(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RecoverVirtualMachine), p)
 }
 
+// RecoverVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) RecoverVirtualMachineWithContext(ctx context.Context, p *RecoverVirtualMachineParams) (*RecoverVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*RecoverVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverVirtualMachineWithContext indicates an expected call of RecoverVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RecoverVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RecoverVirtualMachineWithContext), ctx, p)
+}
+
 // RemoveNicFromVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) RemoveNicFromVirtualMachine(p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1147,6 +2430,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveNicFromVirtualMachin
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNicFromVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveNicFromVirtualMachine), p)
 }
 
+// RemoveNicFromVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) RemoveNicFromVirtualMachineAsync(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNicFromVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNicFromVirtualMachineAsync indicates an expected call of RemoveNicFromVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveNicFromVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNicFromVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveNicFromVirtualMachineAsync), ctx, p)
+}
+
+// RemoveNicFromVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) RemoveNicFromVirtualMachineWithContext(ctx context.Context, p *RemoveNicFromVirtualMachineParams) (*RemoveNicFromVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveNicFromVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveNicFromVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveNicFromVirtualMachineWithContext indicates an expected call of RemoveNicFromVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveNicFromVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveNicFromVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveNicFromVirtualMachineWithContext), ctx, p)
+}
+
 // RemoveVirtualMachineFromBackupOffering mocks base method.
 func (m *MockVirtualMachineServiceIface) RemoveVirtualMachineFromBackupOffering(p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
 	m.ctrl.T.Helper()
@@ -1162,6 +2475,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveVirtualMachineFromBa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVirtualMachineFromBackupOffering", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveVirtualMachineFromBackupOffering), p)
 }
 
+// RemoveVirtualMachineFromBackupOfferingAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) RemoveVirtualMachineFromBackupOfferingAsync(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVirtualMachineFromBackupOfferingAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVirtualMachineFromBackupOfferingAsync indicates an expected call of RemoveVirtualMachineFromBackupOfferingAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveVirtualMachineFromBackupOfferingAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVirtualMachineFromBackupOfferingAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveVirtualMachineFromBackupOfferingAsync), ctx, p)
+}
+
+// RemoveVirtualMachineFromBackupOfferingWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) RemoveVirtualMachineFromBackupOfferingWithContext(ctx context.Context, p *RemoveVirtualMachineFromBackupOfferingParams) (*RemoveVirtualMachineFromBackupOfferingResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVirtualMachineFromBackupOfferingWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveVirtualMachineFromBackupOfferingResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVirtualMachineFromBackupOfferingWithContext indicates an expected call of RemoveVirtualMachineFromBackupOfferingWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RemoveVirtualMachineFromBackupOfferingWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVirtualMachineFromBackupOfferingWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RemoveVirtualMachineFromBackupOfferingWithContext), ctx, p)
+}
+
 // ResetPasswordForVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) ResetPasswordForVirtualMachine(p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1177,6 +2520,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetPasswordForVirtualMac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetPasswordForVirtualMachine), p)
 }
 
+// ResetPasswordForVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) ResetPasswordForVirtualMachineAsync(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordForVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordForVirtualMachineAsync indicates an expected call of ResetPasswordForVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetPasswordForVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordForVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetPasswordForVirtualMachineAsync), ctx, p)
+}
+
+// ResetPasswordForVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ResetPasswordForVirtualMachineWithContext(ctx context.Context, p *ResetPasswordForVirtualMachineParams) (*ResetPasswordForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetPasswordForVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResetPasswordForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetPasswordForVirtualMachineWithContext indicates an expected call of ResetPasswordForVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetPasswordForVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetPasswordForVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetPasswordForVirtualMachineWithContext), ctx, p)
+}
+
 // ResetUserDataForVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) ResetUserDataForVirtualMachine(p *ResetUserDataForVirtualMachineParams) (*ResetUserDataForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1192,6 +2565,21 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetUserDataForVirtualMac
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUserDataForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetUserDataForVirtualMachine), p)
 }
 
+// ResetUserDataForVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ResetUserDataForVirtualMachineWithContext(ctx context.Context, p *ResetUserDataForVirtualMachineParams) (*ResetUserDataForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResetUserDataForVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResetUserDataForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResetUserDataForVirtualMachineWithContext indicates an expected call of ResetUserDataForVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ResetUserDataForVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResetUserDataForVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ResetUserDataForVirtualMachineWithContext), ctx, p)
+}
+
 // RestoreVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) RestoreVirtualMachine(p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1207,6 +2595,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) RestoreVirtualMachine(p an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RestoreVirtualMachine), p)
 }
 
+// RestoreVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) RestoreVirtualMachineAsync(ctx context.Context, p *RestoreVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVirtualMachineAsync indicates an expected call of RestoreVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RestoreVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RestoreVirtualMachineAsync), ctx, p)
+}
+
+// RestoreVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) RestoreVirtualMachineWithContext(ctx context.Context, p *RestoreVirtualMachineParams) (*RestoreVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*RestoreVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVirtualMachineWithContext indicates an expected call of RestoreVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) RestoreVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).RestoreVirtualMachineWithContext), ctx, p)
+}
+
 // ScaleVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) ScaleVirtualMachine(p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1222,6 +2640,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) ScaleVirtualMachine(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ScaleVirtualMachine), p)
 }
 
+// ScaleVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) ScaleVirtualMachineAsync(ctx context.Context, p *ScaleVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleVirtualMachineAsync indicates an expected call of ScaleVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ScaleVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ScaleVirtualMachineAsync), ctx, p)
+}
+
+// ScaleVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) ScaleVirtualMachineWithContext(ctx context.Context, p *ScaleVirtualMachineParams) (*ScaleVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScaleVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*ScaleVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ScaleVirtualMachineWithContext indicates an expected call of ScaleVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) ScaleVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScaleVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).ScaleVirtualMachineWithContext), ctx, p)
+}
+
 // StartVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) StartVirtualMachine(p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1237,6 +2685,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) StartVirtualMachine(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StartVirtualMachine), p)
 }
 
+// StartVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) StartVirtualMachineAsync(ctx context.Context, p *StartVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVirtualMachineAsync indicates an expected call of StartVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StartVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StartVirtualMachineAsync), ctx, p)
+}
+
+// StartVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) StartVirtualMachineWithContext(ctx context.Context, p *StartVirtualMachineParams) (*StartVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StartVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*StartVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StartVirtualMachineWithContext indicates an expected call of StartVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StartVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StartVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StartVirtualMachineWithContext), ctx, p)
+}
+
 // StopVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) StopVirtualMachine(p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1252,6 +2730,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) StopVirtualMachine(p any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StopVirtualMachine), p)
 }
 
+// StopVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) StopVirtualMachineAsync(ctx context.Context, p *StopVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopVirtualMachineAsync indicates an expected call of StopVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StopVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StopVirtualMachineAsync), ctx, p)
+}
+
+// StopVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) StopVirtualMachineWithContext(ctx context.Context, p *StopVirtualMachineParams) (*StopVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "StopVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*StopVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// StopVirtualMachineWithContext indicates an expected call of StopVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) StopVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "StopVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).StopVirtualMachineWithContext), ctx, p)
+}
+
 // UnmanageVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) UnmanageVirtualMachine(p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1267,6 +2775,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) UnmanageVirtualMachine(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UnmanageVirtualMachine), p)
 }
 
+// UnmanageVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) UnmanageVirtualMachineAsync(ctx context.Context, p *UnmanageVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmanageVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnmanageVirtualMachineAsync indicates an expected call of UnmanageVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UnmanageVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UnmanageVirtualMachineAsync), ctx, p)
+}
+
+// UnmanageVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) UnmanageVirtualMachineWithContext(ctx context.Context, p *UnmanageVirtualMachineParams) (*UnmanageVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmanageVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*UnmanageVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnmanageVirtualMachineWithContext indicates an expected call of UnmanageVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UnmanageVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UnmanageVirtualMachineWithContext), ctx, p)
+}
+
 // UpdateDefaultNicForVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) UpdateDefaultNicForVirtualMachine(p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1282,6 +2820,36 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateDefaultNicForVirtual
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultNicForVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateDefaultNicForVirtualMachine), p)
 }
 
+// UpdateDefaultNicForVirtualMachineAsync mocks base method.
+func (m *MockVirtualMachineServiceIface) UpdateDefaultNicForVirtualMachineAsync(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDefaultNicForVirtualMachineAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDefaultNicForVirtualMachineAsync indicates an expected call of UpdateDefaultNicForVirtualMachineAsync.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateDefaultNicForVirtualMachineAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultNicForVirtualMachineAsync", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateDefaultNicForVirtualMachineAsync), ctx, p)
+}
+
+// UpdateDefaultNicForVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) UpdateDefaultNicForVirtualMachineWithContext(ctx context.Context, p *UpdateDefaultNicForVirtualMachineParams) (*UpdateDefaultNicForVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateDefaultNicForVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateDefaultNicForVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateDefaultNicForVirtualMachineWithContext indicates an expected call of UpdateDefaultNicForVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateDefaultNicForVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateDefaultNicForVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateDefaultNicForVirtualMachineWithContext), ctx, p)
+}
+
 // UpdateVMSchedule mocks base method.
 func (m *MockVirtualMachineServiceIface) UpdateVMSchedule(p *UpdateVMScheduleParams) (*UpdateVMScheduleResponse, error) {
 	m.ctrl.T.Helper()
@@ -1297,6 +2865,21 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateVMSchedule(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMSchedule", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateVMSchedule), p)
 }
 
+// UpdateVMScheduleWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) UpdateVMScheduleWithContext(ctx context.Context, p *UpdateVMScheduleParams) (*UpdateVMScheduleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVMScheduleWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVMScheduleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVMScheduleWithContext indicates an expected call of UpdateVMScheduleWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateVMScheduleWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVMScheduleWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateVMScheduleWithContext), ctx, p)
+}
+
 // UpdateVirtualMachine mocks base method.
 func (m *MockVirtualMachineServiceIface) UpdateVirtualMachine(p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
 	m.ctrl.T.Helper()
@@ -1311,3 +2894,18 @@ func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateVirtualMachine(p any
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachine", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateVirtualMachine), p)
 }
+
+// UpdateVirtualMachineWithContext mocks base method.
+func (m *MockVirtualMachineServiceIface) UpdateVirtualMachineWithContext(ctx context.Context, p *UpdateVirtualMachineParams) (*UpdateVirtualMachineResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVirtualMachineWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVirtualMachineResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVirtualMachineWithContext indicates an expected call of UpdateVirtualMachineWithContext.
+func (mr *MockVirtualMachineServiceIfaceMockRecorder) UpdateVirtualMachineWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVirtualMachineWithContext", reflect.TypeOf((*MockVirtualMachineServiceIface)(nil).UpdateVirtualMachineWithContext), ctx, p)
+}
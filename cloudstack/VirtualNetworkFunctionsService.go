@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,22 +31,44 @@ import (
 
 type VirtualNetworkFunctionsServiceIface interface {
 	DeleteVnfTemplate(p *DeleteVnfTemplateParams) (*DeleteVnfTemplateResponse, error)
+	DeleteVnfTemplateWithContext(ctx context.Context, p *DeleteVnfTemplateParams) (*DeleteVnfTemplateResponse, error)
+	DeleteVnfTemplateAsync(ctx context.Context, p *DeleteVnfTemplateParams) (*Job, error)
 	NewDeleteVnfTemplateParams(id string) *DeleteVnfTemplateParams
 	DeployVnfAppliance(p *DeployVnfApplianceParams) (*DeployVnfApplianceResponse, error)
+	DeployVnfApplianceWithContext(ctx context.Context, p *DeployVnfApplianceParams) (*DeployVnfApplianceResponse, error)
+	DeployVnfApplianceAsync(ctx context.Context, p *DeployVnfApplianceParams) (*Job, error)
 	NewDeployVnfApplianceParams(serviceofferingid string, zoneid string) *DeployVnfApplianceParams
 	ListVnfAppliances(p *ListVnfAppliancesParams) (*ListVnfAppliancesResponse, error)
+	ListVnfAppliancesWithContext(ctx context.Context, p *ListVnfAppliancesParams) (*ListVnfAppliancesResponse, error)
+	ListVnfAppliancesAll(p *ListVnfAppliancesParams, opts ...PageOption) ([]*VnfAppliance, error)
+	ListVnfAppliancesAllWithContext(ctx context.Context, p *ListVnfAppliancesParams, opts ...PageOption) ([]*VnfAppliance, error)
+	ListVnfAppliancesIter(p *ListVnfAppliancesParams, opts ...PageOption) iter.Seq2[*VnfAppliance, error]
+	ListVnfAppliancesIterWithContext(ctx context.Context, p *ListVnfAppliancesParams, opts ...PageOption) iter.Seq2[*VnfAppliance, error]
 	NewListVnfAppliancesParams() *ListVnfAppliancesParams
 	GetVnfApplianceID(name string, opts ...OptionFunc) (string, int, error)
+	GetVnfApplianceIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVnfApplianceByName(name string, opts ...OptionFunc) (*VnfAppliance, int, error)
+	GetVnfApplianceByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VnfAppliance, int, error)
 	GetVnfApplianceByID(id string, opts ...OptionFunc) (*VnfAppliance, int, error)
+	GetVnfApplianceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VnfAppliance, int, error)
 	ListVnfTemplates(p *ListVnfTemplatesParams) (*ListVnfTemplatesResponse, error)
+	ListVnfTemplatesWithContext(ctx context.Context, p *ListVnfTemplatesParams) (*ListVnfTemplatesResponse, error)
+	ListVnfTemplatesAll(p *ListVnfTemplatesParams, opts ...PageOption) ([]*VnfTemplate, error)
+	ListVnfTemplatesAllWithContext(ctx context.Context, p *ListVnfTemplatesParams, opts ...PageOption) ([]*VnfTemplate, error)
+	ListVnfTemplatesIter(p *ListVnfTemplatesParams, opts ...PageOption) iter.Seq2[*VnfTemplate, error]
+	ListVnfTemplatesIterWithContext(ctx context.Context, p *ListVnfTemplatesParams, opts ...PageOption) iter.Seq2[*VnfTemplate, error]
 	NewListVnfTemplatesParams(templatefilter string) *ListVnfTemplatesParams
 	GetVnfTemplateID(name string, templatefilter string, opts ...OptionFunc) (string, int, error)
+	GetVnfTemplateIDWithContext(ctx context.Context, name string, templatefilter string, opts ...OptionFunc) (string, int, error)
 	GetVnfTemplateByName(name string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error)
+	GetVnfTemplateByNameWithContext(ctx context.Context, name string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error)
 	GetVnfTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error)
+	GetVnfTemplateByIDWithContext(ctx context.Context, id string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error)
 	RegisterVnfTemplate(p *RegisterVnfTemplateParams) (*RegisterVnfTemplateResponse, error)
-	NewRegisterVnfTemplateParams(format string, hypervisor string, name string, url string) *RegisterVnfTemplateParams
+	RegisterVnfTemplateWithContext(ctx context.Context, p *RegisterVnfTemplateParams) (*RegisterVnfTemplateResponse, error)
+	NewRegisterVnfTemplateParams(format string, hypervisor HypervisorType, name string, url string) *RegisterVnfTemplateParams
 	UpdateVnfTemplate(p *UpdateVnfTemplateParams) (*UpdateVnfTemplateResponse, error)
+	UpdateVnfTemplateWithContext(ctx context.Context, p *UpdateVnfTemplateParams) (*UpdateVnfTemplateResponse, error)
 	NewUpdateVnfTemplateParams(id string) *UpdateVnfTemplateParams
 }
 
@@ -74,6 +98,47 @@ func (p *DeleteVnfTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVnfTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVnfTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVnfTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVnfTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVnfTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "zoneid": 255},
+	}.validate("deleteVnfTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVnfTemplate API, before the request is signed
+func (p *DeleteVnfTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVnfTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVnfTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVnfTemplate", b, &p.p, map[string]paramDecoder{
+		"forced":   decodeParam[bool],
+		"id":       decodeParam[string],
+		"issystem": decodeParam[bool],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVnfTemplateParams) Clone() *DeleteVnfTemplateParams {
+	return &DeleteVnfTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVnfTemplateParams) SetForced(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -169,27 +234,39 @@ func (s *VirtualNetworkFunctionsService) NewDeleteVnfTemplateParams(id string) *
 
 // Deletes a VNF template from the system. All virtual machines using the deleted template will not be affected.
 func (s *VirtualNetworkFunctionsService) DeleteVnfTemplate(p *DeleteVnfTemplateParams) (*DeleteVnfTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVnfTemplate", p.toURLValues())
+	return s.DeleteVnfTemplateWithContext(context.Background(), p)
+}
+
+// DeleteVnfTemplateWithContext is like DeleteVnfTemplate, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) DeleteVnfTemplateWithContext(ctx context.Context, p *DeleteVnfTemplateParams) (*DeleteVnfTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVnfTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVnfTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVnfTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteVnfTemplate", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteVnfTemplate", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -197,11 +274,31 @@ func (s *VirtualNetworkFunctionsService) DeleteVnfTemplate(p *DeleteVnfTemplateP
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteVnfTemplate async job into r
+func (r *DeleteVnfTemplateResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteVnfTemplateAsync starts the async job of DeleteVnfTemplate without waiting for it to finish, and returns a handle to the job
+func (s *VirtualNetworkFunctionsService) DeleteVnfTemplateAsync(ctx context.Context, p *DeleteVnfTemplateParams) (*Job, error) {
+	r, err := s.DeleteVnfTemplateWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteVnfTemplate", r.JobID), nil
+}
+
 type DeleteVnfTemplateResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteVnfTemplateResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteVnfTemplateResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeployVnfApplianceParams struct {
@@ -307,7 +404,7 @@ func (p *DeployVnfApplianceParams) toURLValues() url.Values {
 		u.Set("hostid", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["iodriverpolicy"]; found {
 		u.Set("iodriverpolicy", v.(string))
@@ -447,6 +544,101 @@ func (p *DeployVnfApplianceParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deployVnfAppliance API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeployVnfApplianceParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deployVnfAppliance", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deployVnfAppliance API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeployVnfApplianceParams) Validate() error {
+	return paramRules{
+		required: []string{"serviceofferingid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "bootmode": 255, "boottype": 255, "customid": 255, "deploymentplanner": 255, "diskofferingid": 255, "displayname": 255, "domainid": 255, "extraconfig": 255, "group": 255, "hostid": 255, "hypervisor": 255, "iodriverpolicy": 255, "ip6address": 255, "ipaddress": 255, "keyboard": 255, "keypair": 255, "leaseexpiryaction": 255, "macaddress": 255, "name": 255, "overridediskofferingid": 255, "password": 255, "projectid": 255, "serviceofferingid": 255, "snapshotid": 255, "templateid": 255, "userdata": 1048576, "userdataid": 255, "volumeid": 255, "zoneid": 255},
+	}.validate("deployVnfAppliance", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deployVnfAppliance API, before the request is signed
+func (p *DeployVnfApplianceParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeployVnfApplianceParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeployVnfApplianceParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deployVnfAppliance", b, &p.p, map[string]paramDecoder{
+		"account":                    decodeParam[string],
+		"affinitygroupids":           decodeParam[[]string],
+		"affinitygroupnames":         decodeParam[[]string],
+		"bootintosetup":              decodeParam[bool],
+		"bootmode":                   decodeParam[string],
+		"boottype":                   decodeParam[string],
+		"copyimagetags":              decodeParam[bool],
+		"customid":                   decodeParam[string],
+		"datadiskofferinglist":       decodeParam[map[string]string],
+		"datadisksdetails":           decodeParam[[]map[string]string],
+		"deploymentplanner":          decodeParam[string],
+		"details":                    decodeParam[map[string]string],
+		"dhcpoptionsnetworklist":     decodeParam[[]map[string]string],
+		"diskofferingid":             decodeParam[string],
+		"displayname":                decodeParam[string],
+		"displayvm":                  decodeParam[bool],
+		"domainid":                   decodeParam[string],
+		"dynamicscalingenabled":      decodeParam[bool],
+		"externaldetails":            decodeParam[map[string]string],
+		"extraconfig":                decodeParam[string],
+		"group":                      decodeParam[string],
+		"hostid":                     decodeParam[string],
+		"hypervisor":                 decodeParam[HypervisorType],
+		"iodriverpolicy":             decodeParam[string],
+		"iothreadsenabled":           decodeParam[bool],
+		"ip6address":                 decodeParam[string],
+		"ipaddress":                  decodeParam[string],
+		"iptonetworklist":            decodeParam[[]map[string]string],
+		"keyboard":                   decodeParam[string],
+		"keypair":                    decodeParam[string],
+		"keypairs":                   decodeParam[[]string],
+		"leaseduration":              decodeParam[int],
+		"leaseexpiryaction":          decodeParam[string],
+		"macaddress":                 decodeParam[string],
+		"name":                       decodeParam[string],
+		"networkids":                 decodeParam[[]string],
+		"nicmultiqueuenumber":        decodeParam[int],
+		"nicnetworklist":             decodeParam[[]map[string]string],
+		"nicpackedvirtqueuesenabled": decodeParam[bool],
+		"overridediskofferingid":     decodeParam[string],
+		"password":                   decodeParam[string],
+		"projectid":                  decodeParam[string],
+		"properties":                 decodeParam[map[string]string],
+		"rootdisksize":               decodeParam[int64],
+		"securitygroupids":           decodeParam[[]string],
+		"securitygroupnames":         decodeParam[[]string],
+		"serviceofferingid":          decodeParam[string],
+		"size":                       decodeParam[int64],
+		"snapshotid":                 decodeParam[string],
+		"startvm":                    decodeParam[bool],
+		"templateid":                 decodeParam[string],
+		"userdata":                   decodeParam[string],
+		"userdatadetails":            decodeParam[map[string]string],
+		"userdataid":                 decodeParam[string],
+		"vnfcidrlist":                decodeParam[[]string],
+		"vnfconfiguremanagement":     decodeParam[bool],
+		"volumeid":                   decodeParam[string],
+		"zoneid":                     decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeployVnfApplianceParams) Clone() *DeployVnfApplianceParams {
+	return &DeployVnfApplianceParams{p: cloneParams(p.p)}
+}
+
 func (p *DeployVnfApplianceParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -937,7 +1129,7 @@ func (p *DeployVnfApplianceParams) GetHostid() (string, bool) {
 	return value, ok
 }
 
-func (p *DeployVnfApplianceParams) SetHypervisor(v string) {
+func (p *DeployVnfApplianceParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -950,11 +1142,11 @@ func (p *DeployVnfApplianceParams) ResetHypervisor() {
 	}
 }
 
-func (p *DeployVnfApplianceParams) GetHypervisor() (string, bool) {
+func (p *DeployVnfApplianceParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -1733,32 +1925,39 @@ func (s *VirtualNetworkFunctionsService) NewDeployVnfApplianceParams(serviceoffe
 
 // Creates and automatically starts a VNF appliance based on a service offering, disk offering, and template.
 func (s *VirtualNetworkFunctionsService) DeployVnfAppliance(p *DeployVnfApplianceParams) (*DeployVnfApplianceResponse, error) {
-	resp, err := s.cs.newPostRequest("deployVnfAppliance", p.toURLValues())
+	return s.DeployVnfApplianceWithContext(context.Background(), p)
+}
+
+// DeployVnfApplianceWithContext is like DeployVnfAppliance, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) DeployVnfApplianceWithContext(ctx context.Context, p *DeployVnfApplianceParams) (*DeployVnfApplianceResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deployVnfAppliance", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeployVnfApplianceResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deployVnfAppliance", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deployVnfAppliance", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deployVnfAppliance", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1766,6 +1965,25 @@ func (s *VirtualNetworkFunctionsService) DeployVnfAppliance(p *DeployVnfApplianc
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeployVnfAppliance async job into r
+func (r *DeployVnfApplianceResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DeployVnfApplianceAsync starts the async job of DeployVnfAppliance without waiting for it to finish, and returns a handle to the job
+func (s *VirtualNetworkFunctionsService) DeployVnfApplianceAsync(ctx context.Context, p *DeployVnfApplianceParams) (*Job, error) {
+	r, err := s.DeployVnfApplianceWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deployVnfAppliance", r.JobID), nil
+}
+
 type DeployVnfApplianceResponse struct {
 	Account               string                                    `json:"account"`
 	Affinitygroup         []DeployVnfApplianceResponseAffinitygroup `json:"affinitygroup"`
@@ -1777,32 +1995,32 @@ type DeployVnfApplianceResponse struct {
 	Backupofferingname    string                                    `json:"backupofferingname"`
 	Bootmode              string                                    `json:"bootmode"`
 	Boottype              string                                    `json:"boottype"`
-	Cpunumber             int                                       `json:"cpunumber"`
-	Cpuspeed              int                                       `json:"cpuspeed"`
+	Cpunumber             FlexInt                                   `json:"cpunumber"`
+	Cpuspeed              FlexInt                                   `json:"cpuspeed"`
 	Cpuused               string                                    `json:"cpuused"`
-	Created               string                                    `json:"created"`
-	Deleteprotection      bool                                      `json:"deleteprotection"`
+	Created               Time                                      `json:"created"`
+	Deleteprotection      FlexBool                                  `json:"deleteprotection"`
 	Details               map[string]string                         `json:"details"`
-	Diskioread            int64                                     `json:"diskioread"`
-	Diskiowrite           int64                                     `json:"diskiowrite"`
-	Diskkbsread           int64                                     `json:"diskkbsread"`
-	Diskkbswrite          int64                                     `json:"diskkbswrite"`
+	Diskioread            FlexInt64                                 `json:"diskioread"`
+	Diskiowrite           FlexInt64                                 `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                                 `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                                 `json:"diskkbswrite"`
 	Diskofferingid        string                                    `json:"diskofferingid"`
 	Diskofferingname      string                                    `json:"diskofferingname"`
 	Displayname           string                                    `json:"displayname"`
-	Displayvm             bool                                      `json:"displayvm"`
+	Displayvm             FlexBool                                  `json:"displayvm"`
 	Domain                string                                    `json:"domain"`
 	Domainid              string                                    `json:"domainid"`
 	Domainpath            string                                    `json:"domainpath"`
-	Forvirtualnetwork     bool                                      `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                                  `json:"forvirtualnetwork"`
 	Gpucardid             string                                    `json:"gpucardid"`
 	Gpucardname           string                                    `json:"gpucardname"`
-	Gpucount              int                                       `json:"gpucount"`
+	Gpucount              FlexInt                                   `json:"gpucount"`
 	Group                 string                                    `json:"group"`
 	Groupid               string                                    `json:"groupid"`
 	Guestosid             string                                    `json:"guestosid"`
-	Haenable              bool                                      `json:"haenable"`
-	Hasannotations        bool                                      `json:"hasannotations"`
+	Haenable              FlexBool                                  `json:"haenable"`
+	Hasannotations        FlexBool                                  `json:"hasannotations"`
 	Hostcontrolstate      string                                    `json:"hostcontrolstate"`
 	Hostid                string                                    `json:"hostid"`
 	Hostname              string                                    `json:"hostname"`
@@ -1811,43 +2029,43 @@ type DeployVnfApplianceResponse struct {
 	Id                    string                                    `json:"id"`
 	Instancename          string                                    `json:"instancename"`
 	Ipaddress             string                                    `json:"ipaddress"`
-	Isdynamicallyscalable bool                                      `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                                  `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                                    `json:"isodisplaytext"`
 	Isoid                 string                                    `json:"isoid"`
 	Isoname               string                                    `json:"isoname"`
 	JobID                 string                                    `json:"jobid"`
-	Jobstatus             int                                       `json:"jobstatus"`
+	Jobstatus             FlexInt                                   `json:"jobstatus"`
 	Keypairs              string                                    `json:"keypairs"`
-	Lastupdated           string                                    `json:"lastupdated"`
-	Leaseduration         int                                       `json:"leaseduration"`
+	Lastupdated           Time                                      `json:"lastupdated"`
+	Leaseduration         FlexInt                                   `json:"leaseduration"`
 	Leaseexpiryaction     string                                    `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                                    `json:"leaseexpirydate"`
-	Maxheads              int64                                     `json:"maxheads"`
-	Maxresolutionx        int64                                     `json:"maxresolutionx"`
-	Maxresolutiony        int64                                     `json:"maxresolutiony"`
-	Memory                int                                       `json:"memory"`
-	Memoryintfreekbs      int64                                     `json:"memoryintfreekbs"`
-	Memorykbs             int64                                     `json:"memorykbs"`
-	Memorytargetkbs       int64                                     `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                                      `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                                 `json:"maxheads"`
+	Maxresolutionx        FlexInt64                                 `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                                 `json:"maxresolutiony"`
+	Memory                FlexInt                                   `json:"memory"`
+	Memoryintfreekbs      FlexInt64                                 `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                                 `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                                 `json:"memorytargetkbs"`
 	Name                  string                                    `json:"name"`
-	Networkkbsread        int64                                     `json:"networkkbsread"`
-	Networkkbswrite       int64                                     `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                                 `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                                 `json:"networkkbswrite"`
 	Nic                   []Nic                                     `json:"nic"`
 	Osdisplayname         string                                    `json:"osdisplayname"`
 	Ostypeid              string                                    `json:"ostypeid"`
 	Password              string                                    `json:"password"`
-	Passwordenabled       bool                                      `json:"passwordenabled"`
+	Passwordenabled       FlexBool                                  `json:"passwordenabled"`
 	Pooltype              string                                    `json:"pooltype"`
 	Project               string                                    `json:"project"`
 	Projectid             string                                    `json:"projectid"`
 	Publicip              string                                    `json:"publicip"`
 	Publicipid            string                                    `json:"publicipid"`
 	Readonlydetails       string                                    `json:"readonlydetails"`
-	Receivedbytes         int64                                     `json:"receivedbytes"`
-	Rootdeviceid          int64                                     `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                                 `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                                 `json:"rootdeviceid"`
 	Rootdevicetype        string                                    `json:"rootdevicetype"`
 	Securitygroup         []DeployVnfApplianceResponseSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                                     `json:"sentbytes"`
+	Sentbytes             FlexInt64                                 `json:"sentbytes"`
 	Serviceofferingid     string                                    `json:"serviceofferingid"`
 	Serviceofferingname   string                                    `json:"serviceofferingname"`
 	Servicestate          string                                    `json:"servicestate"`
@@ -1868,12 +2086,13 @@ type DeployVnfApplianceResponse struct {
 	Vgpu                  string                                    `json:"vgpu"`
 	Vgpuprofileid         string                                    `json:"vgpuprofileid"`
 	Vgpuprofilename       string                                    `json:"vgpuprofilename"`
-	Videoram              int64                                     `json:"videoram"`
+	Videoram              FlexInt64                                 `json:"videoram"`
 	Vmtype                string                                    `json:"vmtype"`
 	Vnfdetails            map[string]string                         `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                                 `json:"vnfnics"`
 	Zoneid                string                                    `json:"zoneid"`
 	Zonename              string                                    `json:"zonename"`
+	Extra                 map[string]json.RawMessage                `json:"-"`
 }
 
 type DeployVnfApplianceResponseSecuritygroup struct {
@@ -1889,36 +2108,54 @@ type DeployVnfApplianceResponseSecuritygroup struct {
 	Project             string                                        `json:"project"`
 	Projectid           string                                        `json:"projectid"`
 	Tags                []Tags                                        `json:"tags"`
-	Virtualmachinecount int                                           `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                                       `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                                 `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage                    `json:"-"`
+}
+
+func (r *DeployVnfApplianceResponseSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias DeployVnfApplianceResponseSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeployVnfApplianceResponseSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeployVnfApplianceResponseSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias DeployVnfApplianceResponseSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeployVnfApplianceResponseAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeployVnfApplianceResponseAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias DeployVnfApplianceResponseAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *DeployVnfApplianceResponse) UnmarshalJSON(b []byte) error {
@@ -1945,7 +2182,7 @@ func (r *DeployVnfApplianceResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeployVnfApplianceResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVnfAppliancesParams struct {
@@ -2006,7 +2243,7 @@ func (p *ListVnfAppliancesParams) toURLValues() url.Values {
 		u.Set("haenable", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -2103,6 +2340,83 @@ func (p *ListVnfAppliancesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVnfAppliances API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVnfAppliancesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVnfAppliances", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVnfAppliances API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVnfAppliancesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "affinitygroupid": 255, "arch": 255, "autoscalevmgroupid": 255, "backupofferingid": 255, "domainid": 255, "extensionid": 255, "groupid": 255, "hypervisor": 255, "id": 255, "isoid": 255, "keypair": 255, "keyword": 255, "name": 255, "networkid": 255, "projectid": 255, "securitygroupid": 255, "serviceofferingid": 255, "state": 255, "templateid": 255, "userdataid": 255, "userid": 255, "vpcid": 255, "zoneid": 255},
+	}.validate("listVnfAppliances", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVnfAppliances API, before the request is signed
+func (p *ListVnfAppliancesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVnfAppliancesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVnfAppliancesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVnfAppliances", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"accumulate":                decodeParam[bool],
+		"affinitygroupid":           decodeParam[string],
+		"arch":                      decodeParam[string],
+		"autoscalevmgroupid":        decodeParam[string],
+		"backupofferingid":          decodeParam[string],
+		"details":                   decodeParam[[]string],
+		"displayvm":                 decodeParam[bool],
+		"domainid":                  decodeParam[string],
+		"extensionid":               decodeParam[string],
+		"forvirtualnetwork":         decodeParam[bool],
+		"gpuenabled":                decodeParam[bool],
+		"groupid":                   decodeParam[string],
+		"haenable":                  decodeParam[bool],
+		"hypervisor":                decodeParam[HypervisorType],
+		"id":                        decodeParam[string],
+		"ids":                       decodeParam[[]string],
+		"isoid":                     decodeParam[string],
+		"isrecursive":               decodeParam[bool],
+		"isvnf":                     decodeParam[bool],
+		"keypair":                   decodeParam[string],
+		"keyword":                   decodeParam[string],
+		"leased":                    decodeParam[bool],
+		"listall":                   decodeParam[bool],
+		"name":                      decodeParam[string],
+		"networkid":                 decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"projectid":                 decodeParam[string],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"securitygroupid":           decodeParam[string],
+		"serviceofferingid":         decodeParam[string],
+		"showicon":                  decodeParam[bool],
+		"state":                     decodeParam[string],
+		"tags":                      decodeParam[map[string]string],
+		"templateid":                decodeParam[string],
+		"userdata":                  decodeParam[bool],
+		"userdataid":                decodeParam[string],
+		"userid":                    decodeParam[string],
+		"vpcid":                     decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVnfAppliancesParams) Clone() *ListVnfAppliancesParams {
+	return &ListVnfAppliancesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVnfAppliancesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2397,7 +2711,7 @@ func (p *ListVnfAppliancesParams) GetHaenable() (bool, bool) {
 	return value, ok
 }
 
-func (p *ListVnfAppliancesParams) SetHypervisor(v string) {
+func (p *ListVnfAppliancesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -2410,11 +2724,11 @@ func (p *ListVnfAppliancesParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListVnfAppliancesParams) GetHypervisor() (string, bool) {
+func (p *ListVnfAppliancesParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -2974,6 +3288,11 @@ func (s *VirtualNetworkFunctionsService) NewListVnfAppliancesParams() *ListVnfAp
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualNetworkFunctionsService) GetVnfApplianceID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVnfApplianceIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVnfApplianceIDWithContext is like GetVnfApplianceID, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) GetVnfApplianceIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVnfAppliancesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2985,7 +3304,7 @@ func (s *VirtualNetworkFunctionsService) GetVnfApplianceID(name string, opts ...
 		}
 	}
 
-	l, err := s.ListVnfAppliances(p)
+	l, err := s.ListVnfAppliancesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3010,12 +3329,17 @@ func (s *VirtualNetworkFunctionsService) GetVnfApplianceID(name string, opts ...
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualNetworkFunctionsService) GetVnfApplianceByName(name string, opts ...OptionFunc) (*VnfAppliance, int, error) {
-	id, count, err := s.GetVnfApplianceID(name, opts...)
+	return s.GetVnfApplianceByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVnfApplianceByNameWithContext is like GetVnfApplianceByName, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) GetVnfApplianceByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VnfAppliance, int, error) {
+	id, count, err := s.GetVnfApplianceIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVnfApplianceByID(id, opts...)
+	r, count, err := s.GetVnfApplianceByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3024,6 +3348,11 @@ func (s *VirtualNetworkFunctionsService) GetVnfApplianceByName(name string, opts
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualNetworkFunctionsService) GetVnfApplianceByID(id string, opts ...OptionFunc) (*VnfAppliance, int, error) {
+	return s.GetVnfApplianceByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVnfApplianceByIDWithContext is like GetVnfApplianceByID, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) GetVnfApplianceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VnfAppliance, int, error) {
 	p := &ListVnfAppliancesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3035,11 +3364,10 @@ func (s *VirtualNetworkFunctionsService) GetVnfApplianceByID(id string, opts ...
 		}
 	}
 
-	l, err := s.ListVnfAppliances(p)
+	l, err := s.ListVnfAppliancesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3057,19 +3385,56 @@ func (s *VirtualNetworkFunctionsService) GetVnfApplianceByID(id string, opts ...
 
 // List VNF appliance owned by the account.
 func (s *VirtualNetworkFunctionsService) ListVnfAppliances(p *ListVnfAppliancesParams) (*ListVnfAppliancesResponse, error) {
-	resp, err := s.cs.newRequest("listVnfAppliances", p.toURLValues())
+	return s.ListVnfAppliancesWithContext(context.Background(), p)
+}
+
+// ListVnfAppliancesWithContext is like ListVnfAppliances, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) ListVnfAppliancesWithContext(ctx context.Context, p *ListVnfAppliancesParams) (*ListVnfAppliancesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVnfAppliances", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVnfAppliancesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVnfAppliances", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVnfAppliancesAll returns all VnfAppliances matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualNetworkFunctionsService) ListVnfAppliancesAll(p *ListVnfAppliancesParams, opts ...PageOption) ([]*VnfAppliance, error) {
+	return s.ListVnfAppliancesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVnfAppliancesAllWithContext is like ListVnfAppliancesAll, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) ListVnfAppliancesAllWithContext(ctx context.Context, p *ListVnfAppliancesParams, opts ...PageOption) ([]*VnfAppliance, error) {
+	return collectPages(s.ListVnfAppliancesIterWithContext(ctx, p, opts...))
+}
+
+// ListVnfAppliancesIter returns an iterator over all VnfAppliances matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualNetworkFunctionsService) ListVnfAppliancesIter(p *ListVnfAppliancesParams, opts ...PageOption) iter.Seq2[*VnfAppliance, error] {
+	return s.ListVnfAppliancesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVnfAppliancesIterWithContext is like ListVnfAppliancesIter, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) ListVnfAppliancesIterWithContext(ctx context.Context, p *ListVnfAppliancesParams, opts ...PageOption) iter.Seq2[*VnfAppliance, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VnfAppliance, int, error) {
+		l, err := s.ListVnfAppliancesWithContext(ctx, &ListVnfAppliancesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VnfAppliances, l.Count, nil
+	}, opts...)
+}
+
 type VnfNic struct {
 	Deviceid    int64  `json:"deviceid"`
 	Description string `json:"description"`
@@ -3096,32 +3461,32 @@ type VnfAppliance struct {
 	Backupofferingname    string                      `json:"backupofferingname"`
 	Bootmode              string                      `json:"bootmode"`
 	Boottype              string                      `json:"boottype"`
-	Cpunumber             int                         `json:"cpunumber"`
-	Cpuspeed              int                         `json:"cpuspeed"`
+	Cpunumber             FlexInt                     `json:"cpunumber"`
+	Cpuspeed              FlexInt                     `json:"cpuspeed"`
 	Cpuused               string                      `json:"cpuused"`
-	Created               string                      `json:"created"`
-	Deleteprotection      bool                        `json:"deleteprotection"`
+	Created               Time                        `json:"created"`
+	Deleteprotection      FlexBool                    `json:"deleteprotection"`
 	Details               map[string]string           `json:"details"`
-	Diskioread            int64                       `json:"diskioread"`
-	Diskiowrite           int64                       `json:"diskiowrite"`
-	Diskkbsread           int64                       `json:"diskkbsread"`
-	Diskkbswrite          int64                       `json:"diskkbswrite"`
+	Diskioread            FlexInt64                   `json:"diskioread"`
+	Diskiowrite           FlexInt64                   `json:"diskiowrite"`
+	Diskkbsread           FlexInt64                   `json:"diskkbsread"`
+	Diskkbswrite          FlexInt64                   `json:"diskkbswrite"`
 	Diskofferingid        string                      `json:"diskofferingid"`
 	Diskofferingname      string                      `json:"diskofferingname"`
 	Displayname           string                      `json:"displayname"`
-	Displayvm             bool                        `json:"displayvm"`
+	Displayvm             FlexBool                    `json:"displayvm"`
 	Domain                string                      `json:"domain"`
 	Domainid              string                      `json:"domainid"`
 	Domainpath            string                      `json:"domainpath"`
-	Forvirtualnetwork     bool                        `json:"forvirtualnetwork"`
+	Forvirtualnetwork     FlexBool                    `json:"forvirtualnetwork"`
 	Gpucardid             string                      `json:"gpucardid"`
 	Gpucardname           string                      `json:"gpucardname"`
-	Gpucount              int                         `json:"gpucount"`
+	Gpucount              FlexInt                     `json:"gpucount"`
 	Group                 string                      `json:"group"`
 	Groupid               string                      `json:"groupid"`
 	Guestosid             string                      `json:"guestosid"`
-	Haenable              bool                        `json:"haenable"`
-	Hasannotations        bool                        `json:"hasannotations"`
+	Haenable              FlexBool                    `json:"haenable"`
+	Hasannotations        FlexBool                    `json:"hasannotations"`
 	Hostcontrolstate      string                      `json:"hostcontrolstate"`
 	Hostid                string                      `json:"hostid"`
 	Hostname              string                      `json:"hostname"`
@@ -3130,43 +3495,43 @@ type VnfAppliance struct {
 	Id                    string                      `json:"id"`
 	Instancename          string                      `json:"instancename"`
 	Ipaddress             string                      `json:"ipaddress"`
-	Isdynamicallyscalable bool                        `json:"isdynamicallyscalable"`
+	Isdynamicallyscalable FlexBool                    `json:"isdynamicallyscalable"`
 	Isodisplaytext        string                      `json:"isodisplaytext"`
 	Isoid                 string                      `json:"isoid"`
 	Isoname               string                      `json:"isoname"`
 	JobID                 string                      `json:"jobid"`
-	Jobstatus             int                         `json:"jobstatus"`
+	Jobstatus             FlexInt                     `json:"jobstatus"`
 	Keypairs              string                      `json:"keypairs"`
-	Lastupdated           string                      `json:"lastupdated"`
-	Leaseduration         int                         `json:"leaseduration"`
+	Lastupdated           Time                        `json:"lastupdated"`
+	Leaseduration         FlexInt                     `json:"leaseduration"`
 	Leaseexpiryaction     string                      `json:"leaseexpiryaction"`
-	Leaseexpirydate       string                      `json:"leaseexpirydate"`
-	Maxheads              int64                       `json:"maxheads"`
-	Maxresolutionx        int64                       `json:"maxresolutionx"`
-	Maxresolutiony        int64                       `json:"maxresolutiony"`
-	Memory                int                         `json:"memory"`
-	Memoryintfreekbs      int64                       `json:"memoryintfreekbs"`
-	Memorykbs             int64                       `json:"memorykbs"`
-	Memorytargetkbs       int64                       `json:"memorytargetkbs"`
+	Leaseexpirydate       Time                        `json:"leaseexpirydate"`
+	Maxheads              FlexInt64                   `json:"maxheads"`
+	Maxresolutionx        FlexInt64                   `json:"maxresolutionx"`
+	Maxresolutiony        FlexInt64                   `json:"maxresolutiony"`
+	Memory                FlexInt                     `json:"memory"`
+	Memoryintfreekbs      FlexInt64                   `json:"memoryintfreekbs"`
+	Memorykbs             FlexInt64                   `json:"memorykbs"`
+	Memorytargetkbs       FlexInt64                   `json:"memorytargetkbs"`
 	Name                  string                      `json:"name"`
-	Networkkbsread        int64                       `json:"networkkbsread"`
-	Networkkbswrite       int64                       `json:"networkkbswrite"`
+	Networkkbsread        FlexInt64                   `json:"networkkbsread"`
+	Networkkbswrite       FlexInt64                   `json:"networkkbswrite"`
 	Nic                   []Nic                       `json:"nic"`
 	Osdisplayname         string                      `json:"osdisplayname"`
 	Ostypeid              string                      `json:"ostypeid"`
 	Password              string                      `json:"password"`
-	Passwordenabled       bool                        `json:"passwordenabled"`
+	Passwordenabled       FlexBool                    `json:"passwordenabled"`
 	Pooltype              string                      `json:"pooltype"`
 	Project               string                      `json:"project"`
 	Projectid             string                      `json:"projectid"`
 	Publicip              string                      `json:"publicip"`
 	Publicipid            string                      `json:"publicipid"`
 	Readonlydetails       string                      `json:"readonlydetails"`
-	Receivedbytes         int64                       `json:"receivedbytes"`
-	Rootdeviceid          int64                       `json:"rootdeviceid"`
+	Receivedbytes         FlexInt64                   `json:"receivedbytes"`
+	Rootdeviceid          FlexInt64                   `json:"rootdeviceid"`
 	Rootdevicetype        string                      `json:"rootdevicetype"`
 	Securitygroup         []VnfApplianceSecuritygroup `json:"securitygroup"`
-	Sentbytes             int64                       `json:"sentbytes"`
+	Sentbytes             FlexInt64                   `json:"sentbytes"`
 	Serviceofferingid     string                      `json:"serviceofferingid"`
 	Serviceofferingname   string                      `json:"serviceofferingname"`
 	Servicestate          string                      `json:"servicestate"`
@@ -3187,12 +3552,13 @@ type VnfAppliance struct {
 	Vgpu                  string                      `json:"vgpu"`
 	Vgpuprofileid         string                      `json:"vgpuprofileid"`
 	Vgpuprofilename       string                      `json:"vgpuprofilename"`
-	Videoram              int64                       `json:"videoram"`
+	Videoram              FlexInt64                   `json:"videoram"`
 	Vmtype                string                      `json:"vmtype"`
 	Vnfdetails            map[string]string           `json:"vnfdetails"`
 	Vnfnics               []*VnfNic                   `json:"vnfnics"`
 	Zoneid                string                      `json:"zoneid"`
 	Zonename              string                      `json:"zonename"`
+	Extra                 map[string]json.RawMessage  `json:"-"`
 }
 
 type VnfApplianceSecuritygroup struct {
@@ -3208,36 +3574,54 @@ type VnfApplianceSecuritygroup struct {
 	Project             string                          `json:"project"`
 	Projectid           string                          `json:"projectid"`
 	Tags                []Tags                          `json:"tags"`
-	Virtualmachinecount int                             `json:"virtualmachinecount"`
+	Virtualmachinecount FlexInt                         `json:"virtualmachinecount"`
 	Virtualmachineids   []interface{}                   `json:"virtualmachineids"`
+	Extra               map[string]json.RawMessage      `json:"-"`
+}
+
+func (r *VnfApplianceSecuritygroup) UnmarshalJSON(b []byte) error {
+	type alias VnfApplianceSecuritygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VnfApplianceSecuritygroupRule struct {
-	Account           string `json:"account"`
-	Cidr              string `json:"cidr"`
-	Endport           int    `json:"endport"`
-	Icmpcode          int    `json:"icmpcode"`
-	Icmptype          int    `json:"icmptype"`
-	Protocol          string `json:"protocol"`
-	Ruleid            string `json:"ruleid"`
-	Securitygroupname string `json:"securitygroupname"`
-	Startport         int    `json:"startport"`
-	Tags              []Tags `json:"tags"`
+	Account           string                     `json:"account"`
+	Cidr              string                     `json:"cidr"`
+	Endport           FlexInt                    `json:"endport"`
+	Icmpcode          FlexInt                    `json:"icmpcode"`
+	Icmptype          FlexInt                    `json:"icmptype"`
+	Protocol          string                     `json:"protocol"`
+	Ruleid            string                     `json:"ruleid"`
+	Securitygroupname string                     `json:"securitygroupname"`
+	Startport         FlexInt                    `json:"startport"`
+	Tags              []Tags                     `json:"tags"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VnfApplianceSecuritygroupRule) UnmarshalJSON(b []byte) error {
+	type alias VnfApplianceSecuritygroupRule
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VnfApplianceAffinitygroup struct {
-	Account            string   `json:"account"`
-	Dedicatedresources []string `json:"dedicatedresources"`
-	Description        string   `json:"description"`
-	Domain             string   `json:"domain"`
-	Domainid           string   `json:"domainid"`
-	Domainpath         string   `json:"domainpath"`
-	Id                 string   `json:"id"`
-	Name               string   `json:"name"`
-	Project            string   `json:"project"`
-	Projectid          string   `json:"projectid"`
-	Type               string   `json:"type"`
-	VirtualmachineIds  []string `json:"virtualmachineIds"`
+	Account            string                     `json:"account"`
+	Dedicatedresources []string                   `json:"dedicatedresources"`
+	Description        string                     `json:"description"`
+	Domain             string                     `json:"domain"`
+	Domainid           string                     `json:"domainid"`
+	Domainpath         string                     `json:"domainpath"`
+	Id                 string                     `json:"id"`
+	Name               string                     `json:"name"`
+	Project            string                     `json:"project"`
+	Projectid          string                     `json:"projectid"`
+	Type               string                     `json:"type"`
+	VirtualmachineIds  []string                   `json:"virtualmachineIds"`
+	Extra              map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VnfApplianceAffinitygroup) UnmarshalJSON(b []byte) error {
+	type alias VnfApplianceAffinitygroup
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 func (r *VnfAppliance) UnmarshalJSON(b []byte) error {
@@ -3264,7 +3648,7 @@ func (r *VnfAppliance) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias VnfAppliance
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVnfTemplatesParams struct {
@@ -3297,7 +3681,7 @@ func (p *ListVnfTemplatesParams) toURLValues() url.Values {
 		u.Set("forcks", vv)
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["id"]; found {
 		u.Set("id", v.(string))
@@ -3376,6 +3760,70 @@ func (p *ListVnfTemplatesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVnfTemplates API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVnfTemplatesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVnfTemplates", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVnfTemplates API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVnfTemplatesParams) Validate() error {
+	return paramRules{
+		required: []string{"templatefilter"},
+		lengths:  map[string]int{"account": 255, "arch": 255, "domainid": 255, "extensionid": 255, "hypervisor": 255, "id": 255, "keyword": 255, "name": 255, "oscategoryid": 255, "parenttemplateid": 255, "projectid": 255, "templatefilter": 255, "templatetype": 255, "zoneid": 255},
+	}.validate("listVnfTemplates", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVnfTemplates API, before the request is signed
+func (p *ListVnfTemplatesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVnfTemplatesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVnfTemplatesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVnfTemplates", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"arch":             decodeParam[string],
+		"details":          decodeParam[[]string],
+		"domainid":         decodeParam[string],
+		"extensionid":      decodeParam[string],
+		"forcks":           decodeParam[bool],
+		"hypervisor":       decodeParam[HypervisorType],
+		"id":               decodeParam[string],
+		"ids":              decodeParam[[]string],
+		"isready":          decodeParam[bool],
+		"isrecursive":      decodeParam[bool],
+		"isvnf":            decodeParam[bool],
+		"keyword":          decodeParam[string],
+		"listall":          decodeParam[bool],
+		"name":             decodeParam[string],
+		"oscategoryid":     decodeParam[string],
+		"page":             decodeParam[int],
+		"pagesize":         decodeParam[int],
+		"parenttemplateid": decodeParam[string],
+		"projectid":        decodeParam[string],
+		"showicon":         decodeParam[bool],
+		"showremoved":      decodeParam[bool],
+		"showunique":       decodeParam[bool],
+		"tags":             decodeParam[map[string]string],
+		"templatefilter":   decodeParam[string],
+		"templatetype":     decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVnfTemplatesParams) Clone() *ListVnfTemplatesParams {
+	return &ListVnfTemplatesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVnfTemplatesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3502,7 +3950,7 @@ func (p *ListVnfTemplatesParams) GetForcks() (bool, bool) {
 	return value, ok
 }
 
-func (p *ListVnfTemplatesParams) SetHypervisor(v string) {
+func (p *ListVnfTemplatesParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -3515,11 +3963,11 @@ func (p *ListVnfTemplatesParams) ResetHypervisor() {
 	}
 }
 
-func (p *ListVnfTemplatesParams) GetHypervisor() (string, bool) {
+func (p *ListVnfTemplatesParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -3954,6 +4402,11 @@ func (s *VirtualNetworkFunctionsService) NewListVnfTemplatesParams(templatefilte
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualNetworkFunctionsService) GetVnfTemplateID(name string, templatefilter string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVnfTemplateIDWithContext(context.Background(), name, templatefilter, opts...)
+}
+
+// GetVnfTemplateIDWithContext is like GetVnfTemplateID, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) GetVnfTemplateIDWithContext(ctx context.Context, name string, templatefilter string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVnfTemplatesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3966,7 +4419,7 @@ func (s *VirtualNetworkFunctionsService) GetVnfTemplateID(name string, templatef
 		}
 	}
 
-	l, err := s.ListVnfTemplates(p)
+	l, err := s.ListVnfTemplatesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3991,12 +4444,17 @@ func (s *VirtualNetworkFunctionsService) GetVnfTemplateID(name string, templatef
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualNetworkFunctionsService) GetVnfTemplateByName(name string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
-	id, count, err := s.GetVnfTemplateID(name, templatefilter, opts...)
+	return s.GetVnfTemplateByNameWithContext(context.Background(), name, templatefilter, opts...)
+}
+
+// GetVnfTemplateByNameWithContext is like GetVnfTemplateByName, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) GetVnfTemplateByNameWithContext(ctx context.Context, name string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
+	id, count, err := s.GetVnfTemplateIDWithContext(ctx, name, templatefilter, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVnfTemplateByID(id, templatefilter, opts...)
+	r, count, err := s.GetVnfTemplateByIDWithContext(ctx, id, templatefilter, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4005,6 +4463,11 @@ func (s *VirtualNetworkFunctionsService) GetVnfTemplateByName(name string, templ
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VirtualNetworkFunctionsService) GetVnfTemplateByID(id string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
+	return s.GetVnfTemplateByIDWithContext(context.Background(), id, templatefilter, opts...)
+}
+
+// GetVnfTemplateByIDWithContext is like GetVnfTemplateByID, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) GetVnfTemplateByIDWithContext(ctx context.Context, id string, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
 	p := &ListVnfTemplatesParams{}
 	p.p = make(map[string]interface{})
 
@@ -4017,11 +4480,10 @@ func (s *VirtualNetworkFunctionsService) GetVnfTemplateByID(id string, templatef
 		}
 	}
 
-	l, err := s.ListVnfTemplates(p)
+	l, err := s.ListVnfTemplatesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4039,84 +4501,122 @@ func (s *VirtualNetworkFunctionsService) GetVnfTemplateByID(id string, templatef
 
 // List all public, private, and privileged VNF templates.
 func (s *VirtualNetworkFunctionsService) ListVnfTemplates(p *ListVnfTemplatesParams) (*ListVnfTemplatesResponse, error) {
-	resp, err := s.cs.newRequest("listVnfTemplates", p.toURLValues())
+	return s.ListVnfTemplatesWithContext(context.Background(), p)
+}
+
+// ListVnfTemplatesWithContext is like ListVnfTemplates, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) ListVnfTemplatesWithContext(ctx context.Context, p *ListVnfTemplatesParams) (*ListVnfTemplatesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVnfTemplates", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVnfTemplatesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVnfTemplates", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVnfTemplatesAll returns all VnfTemplates matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VirtualNetworkFunctionsService) ListVnfTemplatesAll(p *ListVnfTemplatesParams, opts ...PageOption) ([]*VnfTemplate, error) {
+	return s.ListVnfTemplatesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVnfTemplatesAllWithContext is like ListVnfTemplatesAll, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) ListVnfTemplatesAllWithContext(ctx context.Context, p *ListVnfTemplatesParams, opts ...PageOption) ([]*VnfTemplate, error) {
+	return collectPages(s.ListVnfTemplatesIterWithContext(ctx, p, opts...))
+}
+
+// ListVnfTemplatesIter returns an iterator over all VnfTemplates matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VirtualNetworkFunctionsService) ListVnfTemplatesIter(p *ListVnfTemplatesParams, opts ...PageOption) iter.Seq2[*VnfTemplate, error] {
+	return s.ListVnfTemplatesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVnfTemplatesIterWithContext is like ListVnfTemplatesIter, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) ListVnfTemplatesIterWithContext(ctx context.Context, p *ListVnfTemplatesParams, opts ...PageOption) iter.Seq2[*VnfTemplate, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VnfTemplate, int, error) {
+		l, err := s.ListVnfTemplatesWithContext(ctx, &ListVnfTemplatesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VnfTemplates, l.Count, nil
+	}, opts...)
+}
+
 type ListVnfTemplatesResponse struct {
 	Count        int            `json:"count"`
 	VnfTemplates []*VnfTemplate `json:"template"`
 }
 
 type VnfTemplate struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                string                     `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *VnfTemplate) UnmarshalJSON(b []byte) error {
@@ -4143,7 +4643,7 @@ func (r *VnfTemplate) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias VnfTemplate
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RegisterVnfTemplateParams struct {
@@ -4206,7 +4706,7 @@ func (p *RegisterVnfTemplateParams) toURLValues() url.Values {
 		u.Set("format", v.(string))
 	}
 	if v, found := p.p["hypervisor"]; found {
-		u.Set("hypervisor", v.(string))
+		u.Set("hypervisor", string(v.(HypervisorType)))
 	}
 	if v, found := p.p["isdynamicallyscalable"]; found {
 		vv := strconv.FormatBool(v.(bool))
@@ -4282,6 +4782,75 @@ func (p *RegisterVnfTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the registerVnfTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RegisterVnfTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("registerVnfTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the registerVnfTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RegisterVnfTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"format", "hypervisor", "name", "url"},
+		lengths:  map[string]int{"account": 255, "arch": 255, "checksum": 255, "displaytext": 4096, "domainid": 255, "extensionid": 255, "format": 255, "hypervisor": 255, "name": 255, "ostypeid": 255, "projectid": 255, "templatetag": 255, "templatetype": 255, "url": 2048, "zoneid": 255},
+	}.validate("registerVnfTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the registerVnfTemplate API, before the request is signed
+func (p *RegisterVnfTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RegisterVnfTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RegisterVnfTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("registerVnfTemplate", b, &p.p, map[string]paramDecoder{
+		"account":               decodeParam[string],
+		"arch":                  decodeParam[string],
+		"bits":                  decodeParam[int],
+		"checksum":              decodeParam[string],
+		"deployasis":            decodeParam[bool],
+		"details":               decodeParam[map[string]string],
+		"directdownload":        decodeParam[bool],
+		"displaytext":           decodeParam[string],
+		"domainid":              decodeParam[string],
+		"extensionid":           decodeParam[string],
+		"externaldetails":       decodeParam[map[string]string],
+		"forcks":                decodeParam[bool],
+		"format":                decodeParam[string],
+		"hypervisor":            decodeParam[HypervisorType],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isextractable":         decodeParam[bool],
+		"isfeatured":            decodeParam[bool],
+		"ispublic":              decodeParam[bool],
+		"isrouting":             decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"projectid":             decodeParam[string],
+		"requireshvm":           decodeParam[bool],
+		"sshkeyenabled":         decodeParam[bool],
+		"templatetag":           decodeParam[string],
+		"templatetype":          decodeParam[string],
+		"url":                   decodeParam[string],
+		"vnfdetails":            decodeParam[map[string]string],
+		"vnfnics":               decodeParam[map[string]string],
+		"zoneid":                decodeParam[string],
+		"zoneids":               decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RegisterVnfTemplateParams) Clone() *RegisterVnfTemplateParams {
+	return &RegisterVnfTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *RegisterVnfTemplateParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4555,7 +5124,7 @@ func (p *RegisterVnfTemplateParams) GetFormat() (string, bool) {
 	return value, ok
 }
 
-func (p *RegisterVnfTemplateParams) SetHypervisor(v string) {
+func (p *RegisterVnfTemplateParams) SetHypervisor(v HypervisorType) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
@@ -4568,11 +5137,11 @@ func (p *RegisterVnfTemplateParams) ResetHypervisor() {
 	}
 }
 
-func (p *RegisterVnfTemplateParams) GetHypervisor() (string, bool) {
+func (p *RegisterVnfTemplateParams) GetHypervisor() (HypervisorType, bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
 	}
-	value, ok := p.p["hypervisor"].(string)
+	value, ok := p.p["hypervisor"].(HypervisorType)
 	return value, ok
 }
 
@@ -4956,7 +5525,7 @@ func (p *RegisterVnfTemplateParams) GetZoneids() ([]string, bool) {
 
 // You should always use this function to get a new RegisterVnfTemplateParams instance,
 // as then you are sure you have configured all required params
-func (s *VirtualNetworkFunctionsService) NewRegisterVnfTemplateParams(format string, hypervisor string, name string, url string) *RegisterVnfTemplateParams {
+func (s *VirtualNetworkFunctionsService) NewRegisterVnfTemplateParams(format string, hypervisor HypervisorType, name string, url string) *RegisterVnfTemplateParams {
 	p := &RegisterVnfTemplateParams{}
 	p.p = make(map[string]interface{})
 	p.p["format"] = format
@@ -4968,13 +5537,22 @@ func (s *VirtualNetworkFunctionsService) NewRegisterVnfTemplateParams(format str
 
 // Registers an existing VNF template into the CloudStack cloud.
 func (s *VirtualNetworkFunctionsService) RegisterVnfTemplate(p *RegisterVnfTemplateParams) (*RegisterVnfTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("registerVnfTemplate", p.toURLValues())
+	return s.RegisterVnfTemplateWithContext(context.Background(), p)
+}
+
+// RegisterVnfTemplateWithContext is like RegisterVnfTemplate, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) RegisterVnfTemplateWithContext(ctx context.Context, p *RegisterVnfTemplateParams) (*RegisterVnfTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "registerVnfTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RegisterVnfTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("registerVnfTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4982,65 +5560,66 @@ func (s *VirtualNetworkFunctionsService) RegisterVnfTemplate(p *RegisterVnfTempl
 }
 
 type RegisterVnfTemplateResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RegisterVnfTemplateResponse) UnmarshalJSON(b []byte) error {
@@ -5067,7 +5646,7 @@ func (r *RegisterVnfTemplateResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RegisterVnfTemplateResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVnfTemplateParams struct {
@@ -5174,6 +5753,66 @@ func (p *UpdateVnfTemplateParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVnfTemplate API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVnfTemplateParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVnfTemplate", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVnfTemplate API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVnfTemplateParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"arch": 255, "displaytext": 4096, "format": 255, "id": 255, "name": 255, "ostypeid": 255, "templatetag": 255, "templatetype": 255},
+	}.validate("updateVnfTemplate", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVnfTemplate API, before the request is signed
+func (p *UpdateVnfTemplateParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVnfTemplateParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVnfTemplateParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVnfTemplate", b, &p.p, map[string]paramDecoder{
+		"arch":                  decodeParam[string],
+		"bootable":              decodeParam[bool],
+		"cleanupdetails":        decodeParam[bool],
+		"cleanupvnfdetails":     decodeParam[bool],
+		"cleanupvnfnics":        decodeParam[bool],
+		"details":               decodeParam[map[string]string],
+		"displaytext":           decodeParam[string],
+		"forceupdateostype":     decodeParam[bool],
+		"forcks":                decodeParam[bool],
+		"format":                decodeParam[string],
+		"id":                    decodeParam[string],
+		"isdynamicallyscalable": decodeParam[bool],
+		"isrouting":             decodeParam[bool],
+		"name":                  decodeParam[string],
+		"ostypeid":              decodeParam[string],
+		"passwordenabled":       decodeParam[bool],
+		"requireshvm":           decodeParam[bool],
+		"sortkey":               decodeParam[int],
+		"sshkeyenabled":         decodeParam[bool],
+		"templatetag":           decodeParam[string],
+		"templatetype":          decodeParam[string],
+		"vnfdetails":            decodeParam[map[string]string],
+		"vnfnics":               decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVnfTemplateParams) Clone() *UpdateVnfTemplateParams {
+	return &UpdateVnfTemplateParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVnfTemplateParams) SetArch(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5668,13 +6307,22 @@ func (s *VirtualNetworkFunctionsService) NewUpdateVnfTemplateParams(id string) *
 
 // Updates a template to VNF template or attributes of a VNF template.
 func (s *VirtualNetworkFunctionsService) UpdateVnfTemplate(p *UpdateVnfTemplateParams) (*UpdateVnfTemplateResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVnfTemplate", p.toURLValues())
+	return s.UpdateVnfTemplateWithContext(context.Background(), p)
+}
+
+// UpdateVnfTemplateWithContext is like UpdateVnfTemplate, but honours the cancellation and deadline of ctx
+func (s *VirtualNetworkFunctionsService) UpdateVnfTemplateWithContext(ctx context.Context, p *UpdateVnfTemplateParams) (*UpdateVnfTemplateResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVnfTemplate", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVnfTemplateResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVnfTemplate", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -5682,65 +6330,66 @@ func (s *VirtualNetworkFunctionsService) UpdateVnfTemplate(p *UpdateVnfTemplateP
 }
 
 type UpdateVnfTemplateResponse struct {
-	Account               string              `json:"account"`
-	Accountid             string              `json:"accountid"`
-	Arch                  string              `json:"arch"`
-	Bits                  int                 `json:"bits"`
-	Bootable              bool                `json:"bootable"`
-	Checksum              string              `json:"checksum"`
-	Childtemplates        []interface{}       `json:"childtemplates"`
-	Created               string              `json:"created"`
-	CrossZones            bool                `json:"crossZones"`
-	Deployasis            bool                `json:"deployasis"`
-	Deployasisdetails     map[string]string   `json:"deployasisdetails"`
-	Details               map[string]string   `json:"details"`
-	Directdownload        bool                `json:"directdownload"`
-	Displaytext           string              `json:"displaytext"`
-	Domain                string              `json:"domain"`
-	Domainid              string              `json:"domainid"`
-	Domainpath            string              `json:"domainpath"`
-	Downloaddetails       []map[string]string `json:"downloaddetails"`
-	Extensionid           string              `json:"extensionid"`
-	Extensionname         string              `json:"extensionname"`
-	Forcks                bool                `json:"forcks"`
-	Format                string              `json:"format"`
-	Hasannotations        bool                `json:"hasannotations"`
-	Hostid                string              `json:"hostid"`
-	Hostname              string              `json:"hostname"`
-	Hypervisor            string              `json:"hypervisor"`
-	Icon                  interface{}         `json:"icon"`
-	Id                    string              `json:"id"`
-	Isdynamicallyscalable bool                `json:"isdynamicallyscalable"`
-	Isextractable         bool                `json:"isextractable"`
-	Isfeatured            bool                `json:"isfeatured"`
-	Ispublic              bool                `json:"ispublic"`
-	Isready               bool                `json:"isready"`
-	JobID                 string              `json:"jobid"`
-	Jobstatus             int                 `json:"jobstatus"`
-	Name                  string              `json:"name"`
-	Ostypeid              string              `json:"ostypeid"`
-	Ostypename            string              `json:"ostypename"`
-	Parenttemplateid      string              `json:"parenttemplateid"`
-	Passwordenabled       bool                `json:"passwordenabled"`
-	Physicalsize          int64               `json:"physicalsize"`
-	Project               string              `json:"project"`
-	Projectid             string              `json:"projectid"`
-	Removed               string              `json:"removed"`
-	Requireshvm           bool                `json:"requireshvm"`
-	Size                  int64               `json:"size"`
-	Sourcetemplateid      string              `json:"sourcetemplateid"`
-	Sshkeyenabled         bool                `json:"sshkeyenabled"`
-	Status                string              `json:"status"`
-	Tags                  []Tags              `json:"tags"`
-	Templatetag           string              `json:"templatetag"`
-	Templatetype          string              `json:"templatetype"`
-	Url                   string              `json:"url"`
-	Userdataid            string              `json:"userdataid"`
-	Userdataname          string              `json:"userdataname"`
-	Userdataparams        string              `json:"userdataparams"`
-	Userdatapolicy        string              `json:"userdatapolicy"`
-	Zoneid                string              `json:"zoneid"`
-	Zonename              string              `json:"zonename"`
+	Account               string                     `json:"account"`
+	Accountid             string                     `json:"accountid"`
+	Arch                  string                     `json:"arch"`
+	Bits                  FlexInt                    `json:"bits"`
+	Bootable              FlexBool                   `json:"bootable"`
+	Checksum              string                     `json:"checksum"`
+	Childtemplates        []interface{}              `json:"childtemplates"`
+	Created               Time                       `json:"created"`
+	CrossZones            FlexBool                   `json:"crossZones"`
+	Deployasis            FlexBool                   `json:"deployasis"`
+	Deployasisdetails     map[string]string          `json:"deployasisdetails"`
+	Details               map[string]string          `json:"details"`
+	Directdownload        FlexBool                   `json:"directdownload"`
+	Displaytext           string                     `json:"displaytext"`
+	Domain                string                     `json:"domain"`
+	Domainid              string                     `json:"domainid"`
+	Domainpath            string                     `json:"domainpath"`
+	Downloaddetails       []map[string]string        `json:"downloaddetails"`
+	Extensionid           string                     `json:"extensionid"`
+	Extensionname         string                     `json:"extensionname"`
+	Forcks                FlexBool                   `json:"forcks"`
+	Format                string                     `json:"format"`
+	Hasannotations        FlexBool                   `json:"hasannotations"`
+	Hostid                string                     `json:"hostid"`
+	Hostname              string                     `json:"hostname"`
+	Hypervisor            string                     `json:"hypervisor"`
+	Icon                  interface{}                `json:"icon"`
+	Id                    string                     `json:"id"`
+	Isdynamicallyscalable FlexBool                   `json:"isdynamicallyscalable"`
+	Isextractable         FlexBool                   `json:"isextractable"`
+	Isfeatured            FlexBool                   `json:"isfeatured"`
+	Ispublic              FlexBool                   `json:"ispublic"`
+	Isready               FlexBool                   `json:"isready"`
+	JobID                 string                     `json:"jobid"`
+	Jobstatus             FlexInt                    `json:"jobstatus"`
+	Name                  string                     `json:"name"`
+	Ostypeid              string                     `json:"ostypeid"`
+	Ostypename            string                     `json:"ostypename"`
+	Parenttemplateid      string                     `json:"parenttemplateid"`
+	Passwordenabled       FlexBool                   `json:"passwordenabled"`
+	Physicalsize          FlexInt64                  `json:"physicalsize"`
+	Project               string                     `json:"project"`
+	Projectid             string                     `json:"projectid"`
+	Removed               Time                       `json:"removed"`
+	Requireshvm           FlexBool                   `json:"requireshvm"`
+	Size                  FlexInt64                  `json:"size"`
+	Sourcetemplateid      string                     `json:"sourcetemplateid"`
+	Sshkeyenabled         FlexBool                   `json:"sshkeyenabled"`
+	Status                TemplateStatus             `json:"status"`
+	Tags                  []Tags                     `json:"tags"`
+	Templatetag           string                     `json:"templatetag"`
+	Templatetype          string                     `json:"templatetype"`
+	Url                   string                     `json:"url"`
+	Userdataid            string                     `json:"userdataid"`
+	Userdataname          string                     `json:"userdataname"`
+	Userdataparams        string                     `json:"userdataparams"`
+	Userdatapolicy        string                     `json:"userdatapolicy"`
+	Zoneid                string                     `json:"zoneid"`
+	Zonename              string                     `json:"zonename"`
+	Extra                 map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateVnfTemplateResponse) UnmarshalJSON(b []byte) error {
@@ -5767,5 +6416,5 @@ func (r *UpdateVnfTemplateResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateVnfTemplateResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,36 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) DeleteVnfTemplate
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVnfTemplate", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).DeleteVnfTemplate), p)
 }
 
+// DeleteVnfTemplateAsync mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) DeleteVnfTemplateAsync(ctx context.Context, p *DeleteVnfTemplateParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVnfTemplateAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVnfTemplateAsync indicates an expected call of DeleteVnfTemplateAsync.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) DeleteVnfTemplateAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVnfTemplateAsync", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).DeleteVnfTemplateAsync), ctx, p)
+}
+
+// DeleteVnfTemplateWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) DeleteVnfTemplateWithContext(ctx context.Context, p *DeleteVnfTemplateParams) (*DeleteVnfTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVnfTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVnfTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVnfTemplateWithContext indicates an expected call of DeleteVnfTemplateWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) DeleteVnfTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVnfTemplateWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).DeleteVnfTemplateWithContext), ctx, p)
+}
+
 // DeployVnfAppliance mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) DeployVnfAppliance(p *DeployVnfApplianceParams) (*DeployVnfApplianceResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +120,36 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) DeployVnfApplianc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVnfAppliance", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).DeployVnfAppliance), p)
 }
 
+// DeployVnfApplianceAsync mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) DeployVnfApplianceAsync(ctx context.Context, p *DeployVnfApplianceParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployVnfApplianceAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployVnfApplianceAsync indicates an expected call of DeployVnfApplianceAsync.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) DeployVnfApplianceAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVnfApplianceAsync", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).DeployVnfApplianceAsync), ctx, p)
+}
+
+// DeployVnfApplianceWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) DeployVnfApplianceWithContext(ctx context.Context, p *DeployVnfApplianceParams) (*DeployVnfApplianceResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeployVnfApplianceWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeployVnfApplianceResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeployVnfApplianceWithContext indicates an expected call of DeployVnfApplianceWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) DeployVnfApplianceWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeployVnfApplianceWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).DeployVnfApplianceWithContext), ctx, p)
+}
+
 // GetVnfApplianceByID mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfApplianceByID(id string, opts ...OptionFunc) (*VnfAppliance, int, error) {
 	m.ctrl.T.Helper()
@@ -109,6 +171,27 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfApplianceBy
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfApplianceByID", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfApplianceByID), varargs...)
 }
 
+// GetVnfApplianceByIDWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfApplianceByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VnfAppliance, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVnfApplianceByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VnfAppliance)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVnfApplianceByIDWithContext indicates an expected call of GetVnfApplianceByIDWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfApplianceByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfApplianceByIDWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfApplianceByIDWithContext), varargs...)
+}
+
 // GetVnfApplianceByName mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfApplianceByName(name string, opts ...OptionFunc) (*VnfAppliance, int, error) {
 	m.ctrl.T.Helper()
@@ -130,6 +213,27 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfApplianceBy
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfApplianceByName", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfApplianceByName), varargs...)
 }
 
+// GetVnfApplianceByNameWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfApplianceByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VnfAppliance, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVnfApplianceByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VnfAppliance)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVnfApplianceByNameWithContext indicates an expected call of GetVnfApplianceByNameWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfApplianceByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfApplianceByNameWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfApplianceByNameWithContext), varargs...)
+}
+
 // GetVnfApplianceID mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfApplianceID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -151,6 +255,27 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfApplianceID
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfApplianceID", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfApplianceID), varargs...)
 }
 
+// GetVnfApplianceIDWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfApplianceIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVnfApplianceIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVnfApplianceIDWithContext indicates an expected call of GetVnfApplianceIDWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfApplianceIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfApplianceIDWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfApplianceIDWithContext), varargs...)
+}
+
 // GetVnfTemplateByID mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfTemplateByID(id, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
 	m.ctrl.T.Helper()
@@ -172,6 +297,27 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfTemplateByI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfTemplateByID", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfTemplateByID), varargs...)
 }
 
+// GetVnfTemplateByIDWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfTemplateByIDWithContext(ctx context.Context, id, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id, templatefilter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVnfTemplateByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VnfTemplate)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVnfTemplateByIDWithContext indicates an expected call of GetVnfTemplateByIDWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfTemplateByIDWithContext(ctx, id, templatefilter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id, templatefilter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfTemplateByIDWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfTemplateByIDWithContext), varargs...)
+}
+
 // GetVnfTemplateByName mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfTemplateByName(name, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +339,27 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfTemplateByN
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfTemplateByName", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfTemplateByName), varargs...)
 }
 
+// GetVnfTemplateByNameWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfTemplateByNameWithContext(ctx context.Context, name, templatefilter string, opts ...OptionFunc) (*VnfTemplate, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, templatefilter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVnfTemplateByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VnfTemplate)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVnfTemplateByNameWithContext indicates an expected call of GetVnfTemplateByNameWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfTemplateByNameWithContext(ctx, name, templatefilter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, templatefilter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfTemplateByNameWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfTemplateByNameWithContext), varargs...)
+}
+
 // GetVnfTemplateID mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfTemplateID(name, templatefilter string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +381,27 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfTemplateID(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfTemplateID", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfTemplateID), varargs...)
 }
 
+// GetVnfTemplateIDWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) GetVnfTemplateIDWithContext(ctx context.Context, name, templatefilter string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name, templatefilter}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVnfTemplateIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVnfTemplateIDWithContext indicates an expected call of GetVnfTemplateIDWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) GetVnfTemplateIDWithContext(ctx, name, templatefilter any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name, templatefilter}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVnfTemplateIDWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).GetVnfTemplateIDWithContext), varargs...)
+}
+
 // ListVnfAppliances mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfAppliances(p *ListVnfAppliancesParams) (*ListVnfAppliancesResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +417,99 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfAppliances
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfAppliances", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfAppliances), p)
 }
 
+// ListVnfAppliancesAll mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfAppliancesAll(p *ListVnfAppliancesParams, opts ...PageOption) ([]*VnfAppliance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfAppliancesAll", varargs...)
+	ret0, _ := ret[0].([]*VnfAppliance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVnfAppliancesAll indicates an expected call of ListVnfAppliancesAll.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfAppliancesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfAppliancesAll", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfAppliancesAll), varargs...)
+}
+
+// ListVnfAppliancesAllWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfAppliancesAllWithContext(ctx context.Context, p *ListVnfAppliancesParams, opts ...PageOption) ([]*VnfAppliance, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfAppliancesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VnfAppliance)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVnfAppliancesAllWithContext indicates an expected call of ListVnfAppliancesAllWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfAppliancesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfAppliancesAllWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfAppliancesAllWithContext), varargs...)
+}
+
+// ListVnfAppliancesIter mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfAppliancesIter(p *ListVnfAppliancesParams, opts ...PageOption) iter.Seq2[*VnfAppliance, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfAppliancesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VnfAppliance, error])
+	return ret0
+}
+
+// ListVnfAppliancesIter indicates an expected call of ListVnfAppliancesIter.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfAppliancesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfAppliancesIter", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfAppliancesIter), varargs...)
+}
+
+// ListVnfAppliancesIterWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfAppliancesIterWithContext(ctx context.Context, p *ListVnfAppliancesParams, opts ...PageOption) iter.Seq2[*VnfAppliance, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfAppliancesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VnfAppliance, error])
+	return ret0
+}
+
+// ListVnfAppliancesIterWithContext indicates an expected call of ListVnfAppliancesIterWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfAppliancesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfAppliancesIterWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfAppliancesIterWithContext), varargs...)
+}
+
+// ListVnfAppliancesWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfAppliancesWithContext(ctx context.Context, p *ListVnfAppliancesParams) (*ListVnfAppliancesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVnfAppliancesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVnfAppliancesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVnfAppliancesWithContext indicates an expected call of ListVnfAppliancesWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfAppliancesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfAppliancesWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfAppliancesWithContext), ctx, p)
+}
+
 // ListVnfTemplates mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfTemplates(p *ListVnfTemplatesParams) (*ListVnfTemplatesResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +525,99 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfTemplates(
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfTemplates", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfTemplates), p)
 }
 
+// ListVnfTemplatesAll mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfTemplatesAll(p *ListVnfTemplatesParams, opts ...PageOption) ([]*VnfTemplate, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfTemplatesAll", varargs...)
+	ret0, _ := ret[0].([]*VnfTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVnfTemplatesAll indicates an expected call of ListVnfTemplatesAll.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfTemplatesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfTemplatesAll", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfTemplatesAll), varargs...)
+}
+
+// ListVnfTemplatesAllWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfTemplatesAllWithContext(ctx context.Context, p *ListVnfTemplatesParams, opts ...PageOption) ([]*VnfTemplate, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfTemplatesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VnfTemplate)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVnfTemplatesAllWithContext indicates an expected call of ListVnfTemplatesAllWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfTemplatesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfTemplatesAllWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfTemplatesAllWithContext), varargs...)
+}
+
+// ListVnfTemplatesIter mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfTemplatesIter(p *ListVnfTemplatesParams, opts ...PageOption) iter.Seq2[*VnfTemplate, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfTemplatesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VnfTemplate, error])
+	return ret0
+}
+
+// ListVnfTemplatesIter indicates an expected call of ListVnfTemplatesIter.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfTemplatesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfTemplatesIter", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfTemplatesIter), varargs...)
+}
+
+// ListVnfTemplatesIterWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfTemplatesIterWithContext(ctx context.Context, p *ListVnfTemplatesParams, opts ...PageOption) iter.Seq2[*VnfTemplate, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVnfTemplatesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VnfTemplate, error])
+	return ret0
+}
+
+// ListVnfTemplatesIterWithContext indicates an expected call of ListVnfTemplatesIterWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfTemplatesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfTemplatesIterWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfTemplatesIterWithContext), varargs...)
+}
+
+// ListVnfTemplatesWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) ListVnfTemplatesWithContext(ctx context.Context, p *ListVnfTemplatesParams) (*ListVnfTemplatesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVnfTemplatesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVnfTemplatesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVnfTemplatesWithContext indicates an expected call of ListVnfTemplatesWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) ListVnfTemplatesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVnfTemplatesWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).ListVnfTemplatesWithContext), ctx, p)
+}
+
 // NewDeleteVnfTemplateParams mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) NewDeleteVnfTemplateParams(id string) *DeleteVnfTemplateParams {
 	m.ctrl.T.Helper()
@@ -301,7 +675,7 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) NewListVnfTemplat
 }
 
 // NewRegisterVnfTemplateParams mocks base method.
-func (m *MockVirtualNetworkFunctionsServiceIface) NewRegisterVnfTemplateParams(format, hypervisor, name, url string) *RegisterVnfTemplateParams {
+func (m *MockVirtualNetworkFunctionsServiceIface) NewRegisterVnfTemplateParams(format string, hypervisor HypervisorType, name, url string) *RegisterVnfTemplateParams {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "NewRegisterVnfTemplateParams", format, hypervisor, name, url)
 	ret0, _ := ret[0].(*RegisterVnfTemplateParams)
@@ -343,6 +717,21 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) RegisterVnfTempla
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterVnfTemplate", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).RegisterVnfTemplate), p)
 }
 
+// RegisterVnfTemplateWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) RegisterVnfTemplateWithContext(ctx context.Context, p *RegisterVnfTemplateParams) (*RegisterVnfTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RegisterVnfTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*RegisterVnfTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RegisterVnfTemplateWithContext indicates an expected call of RegisterVnfTemplateWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) RegisterVnfTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RegisterVnfTemplateWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).RegisterVnfTemplateWithContext), ctx, p)
+}
+
 // UpdateVnfTemplate mocks base method.
 func (m *MockVirtualNetworkFunctionsServiceIface) UpdateVnfTemplate(p *UpdateVnfTemplateParams) (*UpdateVnfTemplateResponse, error) {
 	m.ctrl.T.Helper()
@@ -357,3 +746,18 @@ func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) UpdateVnfTemplate
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVnfTemplate", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).UpdateVnfTemplate), p)
 }
+
+// UpdateVnfTemplateWithContext mocks base method.
+func (m *MockVirtualNetworkFunctionsServiceIface) UpdateVnfTemplateWithContext(ctx context.Context, p *UpdateVnfTemplateParams) (*UpdateVnfTemplateResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVnfTemplateWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVnfTemplateResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVnfTemplateWithContext indicates an expected call of UpdateVnfTemplateWithContext.
+func (mr *MockVirtualNetworkFunctionsServiceIfaceMockRecorder) UpdateVnfTemplateWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVnfTemplateWithContext", reflect.TypeOf((*MockVirtualNetworkFunctionsServiceIface)(nil).UpdateVnfTemplateWithContext), ctx, p)
+}
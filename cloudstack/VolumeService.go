@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,64 +31,129 @@ import (
 
 type VolumeServiceIface interface {
 	AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error)
+	AttachVolumeAsync(ctx context.Context, p *AttachVolumeParams) (*Job, error)
 	NewAttachVolumeParams(id string, virtualmachineid string) *AttachVolumeParams
 	ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error)
+	ChangeOfferingForVolumeWithContext(ctx context.Context, p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error)
+	ChangeOfferingForVolumeAsync(ctx context.Context, p *ChangeOfferingForVolumeParams) (*Job, error)
 	NewChangeOfferingForVolumeParams(diskofferingid string, id string) *ChangeOfferingForVolumeParams
 	CheckVolume(p *CheckVolumeParams) (*CheckVolumeResponse, error)
+	CheckVolumeWithContext(ctx context.Context, p *CheckVolumeParams) (*CheckVolumeResponse, error)
+	CheckVolumeAsync(ctx context.Context, p *CheckVolumeParams) (*Job, error)
 	NewCheckVolumeParams(id string) *CheckVolumeParams
 	CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error)
+	CreateVolumeAsync(ctx context.Context, p *CreateVolumeParams) (*Job, error)
 	NewCreateVolumeParams() *CreateVolumeParams
 	DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
+	DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error)
 	NewDeleteVolumeParams(id string) *DeleteVolumeParams
 	DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error)
+	DestroyVolumeWithContext(ctx context.Context, p *DestroyVolumeParams) (*DestroyVolumeResponse, error)
+	DestroyVolumeAsync(ctx context.Context, p *DestroyVolumeParams) (*Job, error)
 	NewDestroyVolumeParams(id string) *DestroyVolumeParams
 	DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error)
+	DetachVolumeAsync(ctx context.Context, p *DetachVolumeParams) (*Job, error)
 	NewDetachVolumeParams() *DetachVolumeParams
 	ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error)
+	ExtractVolumeAsync(ctx context.Context, p *ExtractVolumeParams) (*Job, error)
 	NewExtractVolumeParams(id string, mode string, zoneid string) *ExtractVolumeParams
 	GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error)
+	GetPathForVolumeWithContext(ctx context.Context, p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error)
 	NewGetPathForVolumeParams(volumeid string) *GetPathForVolumeParams
 	GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
+	GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error)
 	NewGetUploadParamsForVolumeParams(format string, name string, zoneid string) *GetUploadParamsForVolumeParams
 	GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error)
+	GetVolumeiScsiNameWithContext(ctx context.Context, p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error)
 	NewGetVolumeiScsiNameParams(volumeid string) *GetVolumeiScsiNameParams
 	ImportVolume(p *ImportVolumeParams) (*ImportVolumeResponse, error)
+	ImportVolumeWithContext(ctx context.Context, p *ImportVolumeParams) (*ImportVolumeResponse, error)
+	ImportVolumeAsync(ctx context.Context, p *ImportVolumeParams) (*Job, error)
 	NewImportVolumeParams(path string, storageid string) *ImportVolumeParams
 	ListElastistorVolume(p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error)
+	ListElastistorVolumeWithContext(ctx context.Context, p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error)
 	NewListElastistorVolumeParams(id string) *ListElastistorVolumeParams
 	GetElastistorVolumeByID(id string, opts ...OptionFunc) (*ElastistorVolume, int, error)
+	GetElastistorVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ElastistorVolume, int, error)
 	ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error)
+	ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error)
+	ListVolumesAll(p *ListVolumesParams, opts ...PageOption) ([]*Volume, error)
+	ListVolumesAllWithContext(ctx context.Context, p *ListVolumesParams, opts ...PageOption) ([]*Volume, error)
+	ListVolumesIter(p *ListVolumesParams, opts ...PageOption) iter.Seq2[*Volume, error]
+	ListVolumesIterWithContext(ctx context.Context, p *ListVolumesParams, opts ...PageOption) iter.Seq2[*Volume, error]
 	NewListVolumesParams() *ListVolumesParams
 	GetVolumeID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumeIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Volume, int, error)
 	GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error)
+	GetVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Volume, int, error)
 	ListVolumesForImport(p *ListVolumesForImportParams) (*ListVolumesForImportResponse, error)
+	ListVolumesForImportWithContext(ctx context.Context, p *ListVolumesForImportParams) (*ListVolumesForImportResponse, error)
+	ListVolumesForImportAll(p *ListVolumesForImportParams, opts ...PageOption) ([]*VolumesForImport, error)
+	ListVolumesForImportAllWithContext(ctx context.Context, p *ListVolumesForImportParams, opts ...PageOption) ([]*VolumesForImport, error)
+	ListVolumesForImportIter(p *ListVolumesForImportParams, opts ...PageOption) iter.Seq2[*VolumesForImport, error]
+	ListVolumesForImportIterWithContext(ctx context.Context, p *ListVolumesForImportParams, opts ...PageOption) iter.Seq2[*VolumesForImport, error]
 	NewListVolumesForImportParams(storageid string) *ListVolumesForImportParams
 	ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error)
+	ListVolumesMetricsWithContext(ctx context.Context, p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error)
+	ListVolumesMetricsAll(p *ListVolumesMetricsParams, opts ...PageOption) ([]*VolumesMetric, error)
+	ListVolumesMetricsAllWithContext(ctx context.Context, p *ListVolumesMetricsParams, opts ...PageOption) ([]*VolumesMetric, error)
+	ListVolumesMetricsIter(p *ListVolumesMetricsParams, opts ...PageOption) iter.Seq2[*VolumesMetric, error]
+	ListVolumesMetricsIterWithContext(ctx context.Context, p *ListVolumesMetricsParams, opts ...PageOption) iter.Seq2[*VolumesMetric, error]
 	NewListVolumesMetricsParams() *ListVolumesMetricsParams
 	GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVolumesMetricByName(name string, opts ...OptionFunc) (*VolumesMetric, int, error)
+	GetVolumesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesMetric, int, error)
 	GetVolumesMetricByID(id string, opts ...OptionFunc) (*VolumesMetric, int, error)
+	GetVolumesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesMetric, int, error)
 	MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error)
+	MigrateVolumeAsync(ctx context.Context, p *MigrateVolumeParams) (*Job, error)
 	NewMigrateVolumeParams(storageid string, volumeid string) *MigrateVolumeParams
 	RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeResponse, error)
+	RecoverVolumeWithContext(ctx context.Context, p *RecoverVolumeParams) (*RecoverVolumeResponse, error)
 	NewRecoverVolumeParams(id string) *RecoverVolumeParams
 	ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error)
+	ResizeVolumeAsync(ctx context.Context, p *ResizeVolumeParams) (*Job, error)
 	NewResizeVolumeParams(id string) *ResizeVolumeParams
 	UnmanageVolume(p *UnmanageVolumeParams) (*UnmanageVolumeResponse, error)
+	UnmanageVolumeWithContext(ctx context.Context, p *UnmanageVolumeParams) (*UnmanageVolumeResponse, error)
+	UnmanageVolumeAsync(ctx context.Context, p *UnmanageVolumeParams) (*Job, error)
 	NewUnmanageVolumeParams(id string) *UnmanageVolumeParams
 	UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error)
+	UpdateVolumeAsync(ctx context.Context, p *UpdateVolumeParams) (*Job, error)
 	NewUpdateVolumeParams() *UpdateVolumeParams
 	UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error)
+	UploadVolumeAsync(ctx context.Context, p *UploadVolumeParams) (*Job, error)
 	NewUploadVolumeParams(format string, name string, url string, zoneid string) *UploadVolumeParams
 	ListVolumesUsageHistory(p *ListVolumesUsageHistoryParams) (*ListVolumesUsageHistoryResponse, error)
+	ListVolumesUsageHistoryWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams) (*ListVolumesUsageHistoryResponse, error)
+	ListVolumesUsageHistoryAll(p *ListVolumesUsageHistoryParams, opts ...PageOption) ([]*VolumesUsageHistory, error)
+	ListVolumesUsageHistoryAllWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams, opts ...PageOption) ([]*VolumesUsageHistory, error)
+	ListVolumesUsageHistoryIter(p *ListVolumesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VolumesUsageHistory, error]
+	ListVolumesUsageHistoryIterWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VolumesUsageHistory, error]
 	NewListVolumesUsageHistoryParams() *ListVolumesUsageHistoryParams
 	GetVolumesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error)
+	GetVolumesUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetVolumesUsageHistoryByName(name string, opts ...OptionFunc) (*VolumesUsageHistory, int, error)
+	GetVolumesUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesUsageHistory, int, error)
 	GetVolumesUsageHistoryByID(id string, opts ...OptionFunc) (*VolumesUsageHistory, int, error)
+	GetVolumesUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesUsageHistory, int, error)
 	AssignVolume(p *AssignVolumeParams) (*AssignVolumeResponse, error)
+	AssignVolumeWithContext(ctx context.Context, p *AssignVolumeParams) (*AssignVolumeResponse, error)
 	NewAssignVolumeParams(volumeid string) *AssignVolumeParams
 	RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error)
+	RestoreVolumeFromBackupAndAttachToVMWithContext(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error)
+	RestoreVolumeFromBackupAndAttachToVMAsync(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*Job, error)
 	NewRestoreVolumeFromBackupAndAttachToVMParams(backupid string, virtualmachineid string, volumeid string) *RestoreVolumeFromBackupAndAttachToVMParams
 }
 
@@ -112,6 +179,46 @@ func (p *AttachVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the attachVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AttachVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("attachVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the attachVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AttachVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "virtualmachineid"},
+		lengths:  map[string]int{"id": 255, "virtualmachineid": 255},
+	}.validate("attachVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the attachVolume API, before the request is signed
+func (p *AttachVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AttachVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AttachVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("attachVolume", b, &p.p, map[string]paramDecoder{
+		"deviceid":         decodeParam[int64],
+		"id":               decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AttachVolumeParams) Clone() *AttachVolumeParams {
+	return &AttachVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *AttachVolumeParams) SetDeviceid(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -187,32 +294,39 @@ func (s *VolumeService) NewAttachVolumeParams(id string, virtualmachineid string
 
 // Attaches a disk volume to  an Instance.
 func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("attachVolume", p.toURLValues())
+	return s.AttachVolumeWithContext(context.Background(), p)
+}
+
+// AttachVolumeWithContext is like AttachVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "attachVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r AttachVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("attachVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "attachVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("attachVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -220,80 +334,105 @@ func (s *VolumeService) AttachVolume(p *AttachVolumeParams) (*AttachVolumeRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished AttachVolume async job into r
+func (r *AttachVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// AttachVolumeAsync starts the async job of AttachVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) AttachVolumeAsync(ctx context.Context, p *AttachVolumeParams) (*Job, error) {
+	r, err := s.AttachVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("attachVolume", r.JobID), nil
+}
+
 type AttachVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AttachVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias AttachVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ChangeOfferingForVolumeParams struct {
@@ -334,6 +473,50 @@ func (p *ChangeOfferingForVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the changeOfferingForVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ChangeOfferingForVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("changeOfferingForVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the changeOfferingForVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ChangeOfferingForVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"diskofferingid", "id"},
+		lengths:  map[string]int{"diskofferingid": 255, "id": 255},
+	}.validate("changeOfferingForVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the changeOfferingForVolume API, before the request is signed
+func (p *ChangeOfferingForVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ChangeOfferingForVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ChangeOfferingForVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("changeOfferingForVolume", b, &p.p, map[string]paramDecoder{
+		"automigrate":    decodeParam[bool],
+		"diskofferingid": decodeParam[string],
+		"id":             decodeParam[string],
+		"maxiops":        decodeParam[int64],
+		"miniops":        decodeParam[int64],
+		"shrinkok":       decodeParam[bool],
+		"size":           decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ChangeOfferingForVolumeParams) Clone() *ChangeOfferingForVolumeParams {
+	return &ChangeOfferingForVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *ChangeOfferingForVolumeParams) SetAutomigrate(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -493,32 +676,39 @@ func (s *VolumeService) NewChangeOfferingForVolumeParams(diskofferingid string,
 
 // Change disk offering of the volume and also an option to auto migrate if required to apply the new disk offering
 func (s *VolumeService) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("changeOfferingForVolume", p.toURLValues())
+	return s.ChangeOfferingForVolumeWithContext(context.Background(), p)
+}
+
+// ChangeOfferingForVolumeWithContext is like ChangeOfferingForVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ChangeOfferingForVolumeWithContext(ctx context.Context, p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "changeOfferingForVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ChangeOfferingForVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("changeOfferingForVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "changeOfferingForVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("changeOfferingForVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -526,80 +716,105 @@ func (s *VolumeService) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ChangeOfferingForVolume async job into r
+func (r *ChangeOfferingForVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ChangeOfferingForVolumeAsync starts the async job of ChangeOfferingForVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) ChangeOfferingForVolumeAsync(ctx context.Context, p *ChangeOfferingForVolumeParams) (*Job, error) {
+	r, err := s.ChangeOfferingForVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("changeOfferingForVolume", r.JobID), nil
+}
+
 type ChangeOfferingForVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ChangeOfferingForVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias ChangeOfferingForVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CheckVolumeParams struct {
@@ -620,6 +835,45 @@ func (p *CheckVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the checkVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CheckVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("checkVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the checkVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CheckVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255, "repair": 255},
+	}.validate("checkVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the checkVolume API, before the request is signed
+func (p *CheckVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CheckVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CheckVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("checkVolume", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"repair": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CheckVolumeParams) Clone() *CheckVolumeParams {
+	return &CheckVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *CheckVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -673,32 +927,39 @@ func (s *VolumeService) NewCheckVolumeParams(id string) *CheckVolumeParams {
 
 // Check the volume for any errors or leaks and also repairs when repair parameter is passed, this is currently supported for KVM only
 func (s *VolumeService) CheckVolume(p *CheckVolumeParams) (*CheckVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("checkVolume", p.toURLValues())
+	return s.CheckVolumeWithContext(context.Background(), p)
+}
+
+// CheckVolumeWithContext is like CheckVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) CheckVolumeWithContext(ctx context.Context, p *CheckVolumeParams) (*CheckVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "checkVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CheckVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("checkVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "checkVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("checkVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -706,80 +967,105 @@ func (s *VolumeService) CheckVolume(p *CheckVolumeParams) (*CheckVolumeResponse,
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CheckVolume async job into r
+func (r *CheckVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CheckVolumeAsync starts the async job of CheckVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) CheckVolumeAsync(ctx context.Context, p *CheckVolumeParams) (*Job, error) {
+	r, err := s.CheckVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("checkVolume", r.JobID), nil
+}
+
 type CheckVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CheckVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias CheckVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateVolumeParams struct {
@@ -840,6 +1126,56 @@ func (p *CreateVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateVolumeParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "customid": 255, "diskofferingid": 255, "domainid": 255, "name": 255, "projectid": 255, "snapshotid": 255, "storageid": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("createVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createVolume API, before the request is signed
+func (p *CreateVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createVolume", b, &p.p, map[string]paramDecoder{
+		"account":          decodeParam[string],
+		"customid":         decodeParam[string],
+		"diskofferingid":   decodeParam[string],
+		"displayvolume":    decodeParam[bool],
+		"domainid":         decodeParam[string],
+		"maxiops":          decodeParam[int64],
+		"miniops":          decodeParam[int64],
+		"name":             decodeParam[string],
+		"projectid":        decodeParam[string],
+		"size":             decodeParam[int64],
+		"snapshotid":       decodeParam[string],
+		"storageid":        decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+		"zoneid":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateVolumeParams) Clone() *CreateVolumeParams {
+	return &CreateVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1144,32 +1480,39 @@ func (s *VolumeService) NewCreateVolumeParams() *CreateVolumeParams {
 
 // Creates a disk volume from a disk offering. This disk volume must still be attached to  an Instance to make use of it.
 func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("createVolume", p.toURLValues())
+	return s.CreateVolumeWithContext(context.Background(), p)
+}
+
+// CreateVolumeWithContext is like CreateVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1177,80 +1520,105 @@ func (s *VolumeService) CreateVolume(p *CreateVolumeParams) (*CreateVolumeRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateVolume async job into r
+func (r *CreateVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateVolumeAsync starts the async job of CreateVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) CreateVolumeAsync(ctx context.Context, p *CreateVolumeParams) (*Job, error) {
+	r, err := s.CreateVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createVolume", r.JobID), nil
+}
+
 type CreateVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteVolumeParams struct {
@@ -1268,6 +1636,44 @@ func (p *DeleteVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteVolume API, before the request is signed
+func (p *DeleteVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteVolume", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteVolumeParams) Clone() *DeleteVolumeParams {
+	return &DeleteVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1300,13 +1706,22 @@ func (s *VolumeService) NewDeleteVolumeParams(id string) *DeleteVolumeParams {
 
 // Deletes a detached disk volume.
 func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteVolume", p.toURLValues())
+	return s.DeleteVolumeWithContext(context.Background(), p)
+}
+
+// DeleteVolumeWithContext is like DeleteVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1314,10 +1729,11 @@ func (s *VolumeService) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeRespon
 }
 
 type DeleteVolumeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteVolumeResponse) UnmarshalJSON(b []byte) error {
@@ -1344,7 +1760,7 @@ func (r *DeleteVolumeResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteVolumeResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DestroyVolumeParams struct {
@@ -1366,6 +1782,45 @@ func (p *DestroyVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the destroyVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DestroyVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("destroyVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the destroyVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DestroyVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("destroyVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the destroyVolume API, before the request is signed
+func (p *DestroyVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DestroyVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DestroyVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("destroyVolume", b, &p.p, map[string]paramDecoder{
+		"expunge": decodeParam[bool],
+		"id":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DestroyVolumeParams) Clone() *DestroyVolumeParams {
+	return &DestroyVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *DestroyVolumeParams) SetExpunge(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1419,32 +1874,39 @@ func (s *VolumeService) NewDestroyVolumeParams(id string) *DestroyVolumeParams {
 
 // Destroys a Volume.
 func (s *VolumeService) DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("destroyVolume", p.toURLValues())
+	return s.DestroyVolumeWithContext(context.Background(), p)
+}
+
+// DestroyVolumeWithContext is like DestroyVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) DestroyVolumeWithContext(ctx context.Context, p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "destroyVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DestroyVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("destroyVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "destroyVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("destroyVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1452,80 +1914,105 @@ func (s *VolumeService) DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeRes
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DestroyVolume async job into r
+func (r *DestroyVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DestroyVolumeAsync starts the async job of DestroyVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) DestroyVolumeAsync(ctx context.Context, p *DestroyVolumeParams) (*Job, error) {
+	r, err := s.DestroyVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("destroyVolume", r.JobID), nil
+}
+
 type DestroyVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DestroyVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias DestroyVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DetachVolumeParams struct {
@@ -1550,6 +2037,45 @@ func (p *DetachVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the detachVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DetachVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("detachVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the detachVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DetachVolumeParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "virtualmachineid": 255},
+	}.validate("detachVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the detachVolume API, before the request is signed
+func (p *DetachVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DetachVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DetachVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("detachVolume", b, &p.p, map[string]paramDecoder{
+		"deviceid":         decodeParam[int64],
+		"id":               decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DetachVolumeParams) Clone() *DetachVolumeParams {
+	return &DetachVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *DetachVolumeParams) SetDeviceid(v int64) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1623,32 +2149,39 @@ func (s *VolumeService) NewDetachVolumeParams() *DetachVolumeParams {
 
 // Detaches a disk volume from  an Instance.
 func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("detachVolume", p.toURLValues())
+	return s.DetachVolumeWithContext(context.Background(), p)
+}
+
+// DetachVolumeWithContext is like DetachVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "detachVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DetachVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("detachVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "detachVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("detachVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1656,80 +2189,105 @@ func (s *VolumeService) DetachVolume(p *DetachVolumeParams) (*DetachVolumeRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DetachVolume async job into r
+func (r *DetachVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DetachVolumeAsync starts the async job of DetachVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) DetachVolumeAsync(ctx context.Context, p *DetachVolumeParams) (*Job, error) {
+	r, err := s.DetachVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("detachVolume", r.JobID), nil
+}
+
 type DetachVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DetachVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias DetachVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExtractVolumeParams struct {
@@ -1756,6 +2314,47 @@ func (p *ExtractVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the extractVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExtractVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("extractVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the extractVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExtractVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "mode", "zoneid"},
+		lengths:  map[string]int{"id": 255, "mode": 255, "url": 2048, "zoneid": 255},
+	}.validate("extractVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the extractVolume API, before the request is signed
+func (p *ExtractVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExtractVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExtractVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("extractVolume", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"mode":   decodeParam[string],
+		"url":    decodeParam[string],
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExtractVolumeParams) Clone() *ExtractVolumeParams {
+	return &ExtractVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *ExtractVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1853,32 +2452,39 @@ func (s *VolumeService) NewExtractVolumeParams(id string, mode string, zoneid st
 
 // Extracts volume
 func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("extractVolume", p.toURLValues())
+	return s.ExtractVolumeWithContext(context.Background(), p)
+}
+
+// ExtractVolumeWithContext is like ExtractVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "extractVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExtractVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("extractVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "extractVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("extractVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1886,23 +2492,48 @@ func (s *VolumeService) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeRes
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ExtractVolume async job into r
+func (r *ExtractVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ExtractVolumeAsync starts the async job of ExtractVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) ExtractVolumeAsync(ctx context.Context, p *ExtractVolumeParams) (*Job, error) {
+	r, err := s.ExtractVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("extractVolume", r.JobID), nil
+}
+
 type ExtractVolumeResponse struct {
-	Accountid        string `json:"accountid"`
-	Created          string `json:"created"`
-	ExtractId        string `json:"extractId"`
-	ExtractMode      string `json:"extractMode"`
-	Id               string `json:"id"`
-	JobID            string `json:"jobid"`
-	Jobstatus        int    `json:"jobstatus"`
-	Name             string `json:"name"`
-	Resultstring     string `json:"resultstring"`
-	State            string `json:"state"`
-	Status           string `json:"status"`
-	Storagetype      string `json:"storagetype"`
-	Uploadpercentage int    `json:"uploadpercentage"`
-	Url              string `json:"url"`
-	Zoneid           string `json:"zoneid"`
-	Zonename         string `json:"zonename"`
+	Accountid        string                     `json:"accountid"`
+	Created          Time                       `json:"created"`
+	ExtractId        string                     `json:"extractId"`
+	ExtractMode      string                     `json:"extractMode"`
+	Id               string                     `json:"id"`
+	JobID            string                     `json:"jobid"`
+	Jobstatus        FlexInt                    `json:"jobstatus"`
+	Name             string                     `json:"name"`
+	Resultstring     string                     `json:"resultstring"`
+	State            string                     `json:"state"`
+	Status           string                     `json:"status"`
+	Storagetype      string                     `json:"storagetype"`
+	Uploadpercentage FlexInt                    `json:"uploadpercentage"`
+	Url              string                     `json:"url"`
+	Zoneid           string                     `json:"zoneid"`
+	Zonename         string                     `json:"zonename"`
+	Extra            map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ExtractVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias ExtractVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetPathForVolumeParams struct {
@@ -1920,6 +2551,44 @@ func (p *GetPathForVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getPathForVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetPathForVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getPathForVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getPathForVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetPathForVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"volumeid"},
+		lengths:  map[string]int{"volumeid": 255},
+	}.validate("getPathForVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getPathForVolume API, before the request is signed
+func (p *GetPathForVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetPathForVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetPathForVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getPathForVolume", b, &p.p, map[string]paramDecoder{
+		"volumeid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetPathForVolumeParams) Clone() *GetPathForVolumeParams {
+	return &GetPathForVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *GetPathForVolumeParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1952,7 +2621,16 @@ func (s *VolumeService) NewGetPathForVolumeParams(volumeid string) *GetPathForVo
 
 // Get the path associated with the provided volume UUID
 func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getPathForVolume", p.toURLValues())
+	return s.GetPathForVolumeWithContext(context.Background(), p)
+}
+
+// GetPathForVolumeWithContext is like GetPathForVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetPathForVolumeWithContext(ctx context.Context, p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getPathForVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -1960,7 +2638,7 @@ func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathFor
 	var nested struct {
 		Response GetPathForVolumeResponse `json:"apipathforvolume"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("getPathForVolume", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -1969,9 +2647,15 @@ func (s *VolumeService) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathFor
 }
 
 type GetPathForVolumeResponse struct {
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Path      string `json:"path"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Path      string                     `json:"path"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetPathForVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias GetPathForVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetUploadParamsForVolumeParams struct {
@@ -2013,6 +2697,52 @@ func (p *GetUploadParamsForVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getUploadParamsForVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetUploadParamsForVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getUploadParamsForVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getUploadParamsForVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetUploadParamsForVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"format", "name", "zoneid"},
+		lengths:  map[string]int{"account": 255, "checksum": 255, "diskofferingid": 255, "domainid": 255, "format": 255, "imagestoreuuid": 255, "name": 255, "projectid": 255, "zoneid": 255},
+	}.validate("getUploadParamsForVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getUploadParamsForVolume API, before the request is signed
+func (p *GetUploadParamsForVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetUploadParamsForVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetUploadParamsForVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getUploadParamsForVolume", b, &p.p, map[string]paramDecoder{
+		"account":        decodeParam[string],
+		"checksum":       decodeParam[string],
+		"diskofferingid": decodeParam[string],
+		"domainid":       decodeParam[string],
+		"format":         decodeParam[string],
+		"imagestoreuuid": decodeParam[string],
+		"name":           decodeParam[string],
+		"projectid":      decodeParam[string],
+		"zoneid":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetUploadParamsForVolumeParams) Clone() *GetUploadParamsForVolumeParams {
+	return &GetUploadParamsForVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *GetUploadParamsForVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2215,7 +2945,16 @@ func (s *VolumeService) NewGetUploadParamsForVolumeParams(format string, name st
 
 // Upload a data disk to the cloudstack cloud.
 func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
-	resp, err := s.cs.newRequest("getUploadParamsForVolume", p.toURLValues())
+	return s.GetUploadParamsForVolumeWithContext(context.Background(), p)
+}
+
+// GetUploadParamsForVolumeWithContext is like GetUploadParamsForVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getUploadParamsForVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -2223,7 +2962,7 @@ func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumePara
 	var nested struct {
 		Response GetUploadParamsForVolumeResponse `json:"getuploadparams"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("getUploadParamsForVolume", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -2232,13 +2971,19 @@ func (s *VolumeService) GetUploadParamsForVolume(p *GetUploadParamsForVolumePara
 }
 
 type GetUploadParamsForVolumeResponse struct {
-	Expires   string `json:"expires"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Metadata  string `json:"metadata"`
-	PostURL   string `json:"postURL"`
-	Signature string `json:"signature"`
+	Expires   Time                       `json:"expires"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Metadata  string                     `json:"metadata"`
+	PostURL   string                     `json:"postURL"`
+	Signature string                     `json:"signature"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetUploadParamsForVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias GetUploadParamsForVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type GetVolumeiScsiNameParams struct {
@@ -2256,6 +3001,44 @@ func (p *GetVolumeiScsiNameParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the getVolumeiScsiName API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *GetVolumeiScsiNameParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("getVolumeiScsiName", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the getVolumeiScsiName API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *GetVolumeiScsiNameParams) Validate() error {
+	return paramRules{
+		required: []string{"volumeid"},
+		lengths:  map[string]int{"volumeid": 255},
+	}.validate("getVolumeiScsiName", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the getVolumeiScsiName API, before the request is signed
+func (p *GetVolumeiScsiNameParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *GetVolumeiScsiNameParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *GetVolumeiScsiNameParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("getVolumeiScsiName", b, &p.p, map[string]paramDecoder{
+		"volumeid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *GetVolumeiScsiNameParams) Clone() *GetVolumeiScsiNameParams {
+	return &GetVolumeiScsiNameParams{p: cloneParams(p.p)}
+}
+
 func (p *GetVolumeiScsiNameParams) SetVolumeid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2288,13 +3071,22 @@ func (s *VolumeService) NewGetVolumeiScsiNameParams(volumeid string) *GetVolumei
 
 // Get Volume's iSCSI Name
 func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
-	resp, err := s.cs.newRequest("getVolumeiScsiName", p.toURLValues())
+	return s.GetVolumeiScsiNameWithContext(context.Background(), p)
+}
+
+// GetVolumeiScsiNameWithContext is like GetVolumeiScsiName, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumeiScsiNameWithContext(ctx context.Context, p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "getVolumeiScsiName", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r GetVolumeiScsiNameResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("getVolumeiScsiName", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2302,9 +3094,15 @@ func (s *VolumeService) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVol
 }
 
 type GetVolumeiScsiNameResponse struct {
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	VolumeiScsiName string `json:"volumeiScsiName"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	VolumeiScsiName string                     `json:"volumeiScsiName"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *GetVolumeiScsiNameResponse) UnmarshalJSON(b []byte) error {
+	type alias GetVolumeiScsiNameResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ImportVolumeParams struct {
@@ -2340,6 +3138,50 @@ func (p *ImportVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the importVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ImportVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("importVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the importVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ImportVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"path", "storageid"},
+		lengths:  map[string]int{"account": 255, "diskofferingid": 255, "domainid": 255, "name": 255, "path": 255, "projectid": 255, "storageid": 255},
+	}.validate("importVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the importVolume API, before the request is signed
+func (p *ImportVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ImportVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ImportVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("importVolume", b, &p.p, map[string]paramDecoder{
+		"account":        decodeParam[string],
+		"diskofferingid": decodeParam[string],
+		"domainid":       decodeParam[string],
+		"name":           decodeParam[string],
+		"path":           decodeParam[string],
+		"projectid":      decodeParam[string],
+		"storageid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ImportVolumeParams) Clone() *ImportVolumeParams {
+	return &ImportVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *ImportVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2499,32 +3341,39 @@ func (s *VolumeService) NewImportVolumeParams(path string, storageid string) *Im
 
 // Import an unmanaged volume from a storage pool on a host into CloudStack
 func (s *VolumeService) ImportVolume(p *ImportVolumeParams) (*ImportVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("importVolume", p.toURLValues())
+	return s.ImportVolumeWithContext(context.Background(), p)
+}
+
+// ImportVolumeWithContext is like ImportVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ImportVolumeWithContext(ctx context.Context, p *ImportVolumeParams) (*ImportVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "importVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ImportVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("importVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "importVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("importVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -2532,80 +3381,105 @@ func (s *VolumeService) ImportVolume(p *ImportVolumeParams) (*ImportVolumeRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ImportVolume async job into r
+func (r *ImportVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ImportVolumeAsync starts the async job of ImportVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) ImportVolumeAsync(ctx context.Context, p *ImportVolumeParams) (*Job, error) {
+	r, err := s.ImportVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("importVolume", r.JobID), nil
+}
+
 type ImportVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ImportVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias ImportVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListElastistorVolumeParams struct {
@@ -2623,6 +3497,44 @@ func (p *ListElastistorVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listElastistorVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListElastistorVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listElastistorVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listElastistorVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListElastistorVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("listElastistorVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listElastistorVolume API, before the request is signed
+func (p *ListElastistorVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListElastistorVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListElastistorVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listElastistorVolume", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListElastistorVolumeParams) Clone() *ListElastistorVolumeParams {
+	return &ListElastistorVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *ListElastistorVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2655,6 +3567,11 @@ func (s *VolumeService) NewListElastistorVolumeParams(id string) *ListElastistor
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetElastistorVolumeByID(id string, opts ...OptionFunc) (*ElastistorVolume, int, error) {
+	return s.GetElastistorVolumeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetElastistorVolumeByIDWithContext is like GetElastistorVolumeByID, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetElastistorVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ElastistorVolume, int, error) {
 	p := &ListElastistorVolumeParams{}
 	p.p = make(map[string]interface{})
 
@@ -2666,11 +3583,10 @@ func (s *VolumeService) GetElastistorVolumeByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListElastistorVolume(p)
+	l, err := s.ListElastistorVolumeWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2688,13 +3604,22 @@ func (s *VolumeService) GetElastistorVolumeByID(id string, opts ...OptionFunc) (
 
 // Lists the volumes of elastistor
 func (s *VolumeService) ListElastistorVolume(p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error) {
-	resp, err := s.cs.newRequest("listElastistorVolume", p.toURLValues())
+	return s.ListElastistorVolumeWithContext(context.Background(), p)
+}
+
+// ListElastistorVolumeWithContext is like ListElastistorVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListElastistorVolumeWithContext(ctx context.Context, p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listElastistorVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListElastistorVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listElastistorVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -2707,14 +3632,20 @@ type ListElastistorVolumeResponse struct {
 }
 
 type ElastistorVolume struct {
-	Compression   string `json:"compression"`
-	Deduplication string `json:"deduplication"`
-	Graceallowed  string `json:"graceallowed"`
-	Id            string `json:"id"`
-	JobID         string `json:"jobid"`
-	Jobstatus     int    `json:"jobstatus"`
-	Name          string `json:"name"`
-	Sync          string `json:"sync"`
+	Compression   string                     `json:"compression"`
+	Deduplication string                     `json:"deduplication"`
+	Graceallowed  string                     `json:"graceallowed"`
+	Id            string                     `json:"id"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Name          string                     `json:"name"`
+	Sync          string                     `json:"sync"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ElastistorVolume) UnmarshalJSON(b []byte) error {
+	type alias ElastistorVolume
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVolumesParams struct {
@@ -2820,6 +3751,68 @@ func (p *ListVolumesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVolumes API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVolumesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVolumes", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVolumes API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVolumesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "clusterid": 255, "diskofferingid": 255, "domainid": 255, "hostid": 255, "id": 255, "keyword": 255, "name": 255, "podid": 255, "projectid": 255, "serviceofferingid": 255, "state": 255, "storageid": 255, "type": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("listVolumes", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVolumes API, before the request is signed
+func (p *ListVolumesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVolumesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVolumesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVolumes", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"clusterid":                 decodeParam[string],
+		"diskofferingid":            decodeParam[string],
+		"displayvolume":             decodeParam[bool],
+		"domainid":                  decodeParam[string],
+		"hostid":                    decodeParam[string],
+		"id":                        decodeParam[string],
+		"ids":                       decodeParam[[]string],
+		"isencrypted":               decodeParam[bool],
+		"isrecursive":               decodeParam[bool],
+		"keyword":                   decodeParam[string],
+		"listall":                   decodeParam[bool],
+		"listsystemvms":             decodeParam[bool],
+		"name":                      decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"podid":                     decodeParam[string],
+		"projectid":                 decodeParam[string],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"serviceofferingid":         decodeParam[string],
+		"state":                     decodeParam[string],
+		"storageid":                 decodeParam[string],
+		"tags":                      decodeParam[map[string]string],
+		"type":                      decodeParam[string],
+		"virtualmachineid":          decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVolumesParams) Clone() *ListVolumesParams {
+	return &ListVolumesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVolumesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3376,6 +4369,11 @@ func (s *VolumeService) NewListVolumesParams() *ListVolumesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVolumeIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumeIDWithContext is like GetVolumeID, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumeIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVolumesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3387,7 +4385,7 @@ func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, in
 		}
 	}
 
-	l, err := s.ListVolumes(p)
+	l, err := s.ListVolumesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3412,12 +4410,17 @@ func (s *VolumeService) GetVolumeID(name string, opts ...OptionFunc) (string, in
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error) {
-	id, count, err := s.GetVolumeID(name, opts...)
+	return s.GetVolumeByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumeByNameWithContext is like GetVolumeByName, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Volume, int, error) {
+	id, count, err := s.GetVolumeIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVolumeByID(id, opts...)
+	r, count, err := s.GetVolumeByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3426,6 +4429,11 @@ func (s *VolumeService) GetVolumeByName(name string, opts ...OptionFunc) (*Volum
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error) {
+	return s.GetVolumeByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVolumeByIDWithContext is like GetVolumeByID, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Volume, int, error) {
 	p := &ListVolumesParams{}
 	p.p = make(map[string]interface{})
 
@@ -3437,11 +4445,10 @@ func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, i
 		}
 	}
 
-	l, err := s.ListVolumes(p)
+	l, err := s.ListVolumesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3459,98 +4466,141 @@ func (s *VolumeService) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, i
 
 // Lists all volumes.
 func (s *VolumeService) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
-	resp, err := s.cs.newRequest("listVolumes", p.toURLValues())
+	return s.ListVolumesWithContext(context.Background(), p)
+}
+
+// ListVolumesWithContext is like ListVolumes, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVolumes", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVolumesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVolumes", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVolumesAll returns all Volumes matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VolumeService) ListVolumesAll(p *ListVolumesParams, opts ...PageOption) ([]*Volume, error) {
+	return s.ListVolumesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesAllWithContext is like ListVolumesAll, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesAllWithContext(ctx context.Context, p *ListVolumesParams, opts ...PageOption) ([]*Volume, error) {
+	return collectPages(s.ListVolumesIterWithContext(ctx, p, opts...))
+}
+
+// ListVolumesIter returns an iterator over all Volumes matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VolumeService) ListVolumesIter(p *ListVolumesParams, opts ...PageOption) iter.Seq2[*Volume, error] {
+	return s.ListVolumesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesIterWithContext is like ListVolumesIter, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesIterWithContext(ctx context.Context, p *ListVolumesParams, opts ...PageOption) iter.Seq2[*Volume, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Volume, int, error) {
+		l, err := s.ListVolumesWithContext(ctx, &ListVolumesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Volumes, l.Count, nil
+	}, opts...)
+}
+
 type ListVolumesResponse struct {
 	Count   int       `json:"count"`
 	Volumes []*Volume `json:"volume"`
 }
 
 type Volume struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Volume) UnmarshalJSON(b []byte) error {
+	type alias Volume
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVolumesForImportParams struct {
@@ -3582,6 +4632,48 @@ func (p *ListVolumesForImportParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVolumesForImport API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVolumesForImportParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVolumesForImport", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVolumesForImport API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVolumesForImportParams) Validate() error {
+	return paramRules{
+		required: []string{"storageid"},
+		lengths:  map[string]int{"keyword": 255, "path": 255, "storageid": 255},
+	}.validate("listVolumesForImport", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVolumesForImport API, before the request is signed
+func (p *ListVolumesForImportParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVolumesForImportParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVolumesForImportParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVolumesForImport", b, &p.p, map[string]paramDecoder{
+		"keyword":   decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"path":      decodeParam[string],
+		"storageid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVolumesForImportParams) Clone() *ListVolumesForImportParams {
+	return &ListVolumesForImportParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVolumesForImportParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3698,39 +4790,82 @@ func (s *VolumeService) NewListVolumesForImportParams(storageid string) *ListVol
 
 // Lists unmanaged volumes on a storage pool
 func (s *VolumeService) ListVolumesForImport(p *ListVolumesForImportParams) (*ListVolumesForImportResponse, error) {
-	resp, err := s.cs.newRequest("listVolumesForImport", p.toURLValues())
+	return s.ListVolumesForImportWithContext(context.Background(), p)
+}
+
+// ListVolumesForImportWithContext is like ListVolumesForImport, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesForImportWithContext(ctx context.Context, p *ListVolumesForImportParams) (*ListVolumesForImportResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVolumesForImport", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVolumesForImportResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVolumesForImport", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVolumesForImportAll returns all VolumesForImport matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VolumeService) ListVolumesForImportAll(p *ListVolumesForImportParams, opts ...PageOption) ([]*VolumesForImport, error) {
+	return s.ListVolumesForImportAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesForImportAllWithContext is like ListVolumesForImportAll, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesForImportAllWithContext(ctx context.Context, p *ListVolumesForImportParams, opts ...PageOption) ([]*VolumesForImport, error) {
+	return collectPages(s.ListVolumesForImportIterWithContext(ctx, p, opts...))
+}
+
+// ListVolumesForImportIter returns an iterator over all VolumesForImport matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VolumeService) ListVolumesForImportIter(p *ListVolumesForImportParams, opts ...PageOption) iter.Seq2[*VolumesForImport, error] {
+	return s.ListVolumesForImportIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesForImportIterWithContext is like ListVolumesForImportIter, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesForImportIterWithContext(ctx context.Context, p *ListVolumesForImportParams, opts ...PageOption) iter.Seq2[*VolumesForImport, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VolumesForImport, int, error) {
+		l, err := s.ListVolumesForImportWithContext(ctx, &ListVolumesForImportParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VolumesForImport, l.Count, nil
+	}, opts...)
+}
+
 type ListVolumesForImportResponse struct {
 	Count            int                 `json:"count"`
 	VolumesForImport []*VolumesForImport `json:"volumesforimport"`
 }
 
 type VolumesForImport struct {
-	Chaininfo     string            `json:"chaininfo"`
-	Details       map[string]string `json:"details"`
-	Encryptformat string            `json:"encryptformat"`
-	Format        string            `json:"format"`
-	Fullpath      string            `json:"fullpath"`
-	JobID         string            `json:"jobid"`
-	Jobstatus     int               `json:"jobstatus"`
-	Name          string            `json:"name"`
-	Path          string            `json:"path"`
-	Size          int64             `json:"size"`
-	Storage       string            `json:"storage"`
-	Storageid     string            `json:"storageid"`
-	Storagetype   string            `json:"storagetype"`
-	Virtualsize   int64             `json:"virtualsize"`
+	Chaininfo     string                     `json:"chaininfo"`
+	Details       map[string]string          `json:"details"`
+	Encryptformat string                     `json:"encryptformat"`
+	Format        string                     `json:"format"`
+	Fullpath      string                     `json:"fullpath"`
+	JobID         string                     `json:"jobid"`
+	Jobstatus     FlexInt                    `json:"jobstatus"`
+	Name          string                     `json:"name"`
+	Path          string                     `json:"path"`
+	Size          FlexInt64                  `json:"size"`
+	Storage       string                     `json:"storage"`
+	Storageid     string                     `json:"storageid"`
+	Storagetype   string                     `json:"storagetype"`
+	Virtualsize   FlexInt64                  `json:"virtualsize"`
+	Extra         map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VolumesForImport) UnmarshalJSON(b []byte) error {
+	type alias VolumesForImport
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVolumesMetricsParams struct {
@@ -3836,6 +4971,68 @@ func (p *ListVolumesMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVolumesMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVolumesMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVolumesMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVolumesMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVolumesMetricsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "clusterid": 255, "diskofferingid": 255, "domainid": 255, "hostid": 255, "id": 255, "keyword": 255, "name": 255, "podid": 255, "projectid": 255, "serviceofferingid": 255, "state": 255, "storageid": 255, "type": 255, "virtualmachineid": 255, "zoneid": 255},
+	}.validate("listVolumesMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVolumesMetrics API, before the request is signed
+func (p *ListVolumesMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVolumesMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVolumesMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVolumesMetrics", b, &p.p, map[string]paramDecoder{
+		"account":                   decodeParam[string],
+		"clusterid":                 decodeParam[string],
+		"diskofferingid":            decodeParam[string],
+		"displayvolume":             decodeParam[bool],
+		"domainid":                  decodeParam[string],
+		"hostid":                    decodeParam[string],
+		"id":                        decodeParam[string],
+		"ids":                       decodeParam[[]string],
+		"isencrypted":               decodeParam[bool],
+		"isrecursive":               decodeParam[bool],
+		"keyword":                   decodeParam[string],
+		"listall":                   decodeParam[bool],
+		"listsystemvms":             decodeParam[bool],
+		"name":                      decodeParam[string],
+		"page":                      decodeParam[int],
+		"pagesize":                  decodeParam[int],
+		"podid":                     decodeParam[string],
+		"projectid":                 decodeParam[string],
+		"retrieveonlyresourcecount": decodeParam[bool],
+		"serviceofferingid":         decodeParam[string],
+		"state":                     decodeParam[string],
+		"storageid":                 decodeParam[string],
+		"tags":                      decodeParam[map[string]string],
+		"type":                      decodeParam[string],
+		"virtualmachineid":          decodeParam[string],
+		"zoneid":                    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVolumesMetricsParams) Clone() *ListVolumesMetricsParams {
+	return &ListVolumesMetricsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVolumesMetricsParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4392,6 +5589,11 @@ func (s *VolumeService) NewListVolumesMetricsParams() *ListVolumesMetricsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVolumesMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumesMetricIDWithContext is like GetVolumesMetricID, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVolumesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -4403,7 +5605,7 @@ func (s *VolumeService) GetVolumesMetricID(name string, opts ...OptionFunc) (str
 		}
 	}
 
-	l, err := s.ListVolumesMetrics(p)
+	l, err := s.ListVolumesMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4428,12 +5630,17 @@ func (s *VolumeService) GetVolumesMetricID(name string, opts ...OptionFunc) (str
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesMetricByName(name string, opts ...OptionFunc) (*VolumesMetric, int, error) {
-	id, count, err := s.GetVolumesMetricID(name, opts...)
+	return s.GetVolumesMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumesMetricByNameWithContext is like GetVolumesMetricByName, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	id, count, err := s.GetVolumesMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVolumesMetricByID(id, opts...)
+	r, count, err := s.GetVolumesMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -4442,6 +5649,11 @@ func (s *VolumeService) GetVolumesMetricByName(name string, opts ...OptionFunc)
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesMetricByID(id string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	return s.GetVolumesMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVolumesMetricByIDWithContext is like GetVolumesMetricByID, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesMetric, int, error) {
 	p := &ListVolumesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -4453,11 +5665,10 @@ func (s *VolumeService) GetVolumesMetricByID(id string, opts ...OptionFunc) (*Vo
 		}
 	}
 
-	l, err := s.ListVolumesMetrics(p)
+	l, err := s.ListVolumesMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -4475,100 +5686,143 @@ func (s *VolumeService) GetVolumesMetricByID(id string, opts ...OptionFunc) (*Vo
 
 // Lists volume metrics
 func (s *VolumeService) ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listVolumesMetrics", p.toURLValues())
+	return s.ListVolumesMetricsWithContext(context.Background(), p)
+}
+
+// ListVolumesMetricsWithContext is like ListVolumesMetrics, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesMetricsWithContext(ctx context.Context, p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVolumesMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVolumesMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVolumesMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVolumesMetricsAll returns all VolumesMetrics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VolumeService) ListVolumesMetricsAll(p *ListVolumesMetricsParams, opts ...PageOption) ([]*VolumesMetric, error) {
+	return s.ListVolumesMetricsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesMetricsAllWithContext is like ListVolumesMetricsAll, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesMetricsAllWithContext(ctx context.Context, p *ListVolumesMetricsParams, opts ...PageOption) ([]*VolumesMetric, error) {
+	return collectPages(s.ListVolumesMetricsIterWithContext(ctx, p, opts...))
+}
+
+// ListVolumesMetricsIter returns an iterator over all VolumesMetrics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VolumeService) ListVolumesMetricsIter(p *ListVolumesMetricsParams, opts ...PageOption) iter.Seq2[*VolumesMetric, error] {
+	return s.ListVolumesMetricsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesMetricsIterWithContext is like ListVolumesMetricsIter, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesMetricsIterWithContext(ctx context.Context, p *ListVolumesMetricsParams, opts ...PageOption) iter.Seq2[*VolumesMetric, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VolumesMetric, int, error) {
+		l, err := s.ListVolumesMetricsWithContext(ctx, &ListVolumesMetricsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VolumesMetrics, l.Count, nil
+	}, opts...)
+}
+
 type ListVolumesMetricsResponse struct {
 	Count          int              `json:"count"`
 	VolumesMetrics []*VolumesMetric `json:"volume"`
 }
 
 type VolumesMetric struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskiopstotal              int64             `json:"diskiopstotal"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Sizegb                     string            `json:"sizegb"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskiopstotal              FlexInt64                  `json:"diskiopstotal"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Sizegb                     string                     `json:"sizegb"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VolumesMetric) UnmarshalJSON(b []byte) error {
+	type alias VolumesMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type MigrateVolumeParams struct {
@@ -4596,6 +5850,47 @@ func (p *MigrateVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the migrateVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *MigrateVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("migrateVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the migrateVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *MigrateVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"storageid", "volumeid"},
+		lengths:  map[string]int{"newdiskofferingid": 255, "storageid": 255, "volumeid": 255},
+	}.validate("migrateVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the migrateVolume API, before the request is signed
+func (p *MigrateVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *MigrateVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *MigrateVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("migrateVolume", b, &p.p, map[string]paramDecoder{
+		"livemigrate":       decodeParam[bool],
+		"newdiskofferingid": decodeParam[string],
+		"storageid":         decodeParam[string],
+		"volumeid":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *MigrateVolumeParams) Clone() *MigrateVolumeParams {
+	return &MigrateVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *MigrateVolumeParams) SetLivemigrate(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4692,32 +5987,39 @@ func (s *VolumeService) NewMigrateVolumeParams(storageid string, volumeid string
 
 // Migrate volume
 func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("migrateVolume", p.toURLValues())
+	return s.MigrateVolumeWithContext(context.Background(), p)
+}
+
+// MigrateVolumeWithContext is like MigrateVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "migrateVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r MigrateVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("migrateVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "migrateVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("migrateVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4725,80 +6027,105 @@ func (s *VolumeService) MigrateVolume(p *MigrateVolumeParams) (*MigrateVolumeRes
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished MigrateVolume async job into r
+func (r *MigrateVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// MigrateVolumeAsync starts the async job of MigrateVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) MigrateVolumeAsync(ctx context.Context, p *MigrateVolumeParams) (*Job, error) {
+	r, err := s.MigrateVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("migrateVolume", r.JobID), nil
+}
+
 type MigrateVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *MigrateVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias MigrateVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RecoverVolumeParams struct {
@@ -4816,6 +6143,44 @@ func (p *RecoverVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the recoverVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RecoverVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("recoverVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the recoverVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RecoverVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("recoverVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the recoverVolume API, before the request is signed
+func (p *RecoverVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RecoverVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RecoverVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("recoverVolume", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RecoverVolumeParams) Clone() *RecoverVolumeParams {
+	return &RecoverVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *RecoverVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4848,13 +6213,22 @@ func (s *VolumeService) NewRecoverVolumeParams(id string) *RecoverVolumeParams {
 
 // Recovers a Destroy volume.
 func (s *VolumeService) RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("recoverVolume", p.toURLValues())
+	return s.RecoverVolumeWithContext(context.Background(), p)
+}
+
+// RecoverVolumeWithContext is like RecoverVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) RecoverVolumeWithContext(ctx context.Context, p *RecoverVolumeParams) (*RecoverVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "recoverVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RecoverVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("recoverVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4862,79 +6236,85 @@ func (s *VolumeService) RecoverVolume(p *RecoverVolumeParams) (*RecoverVolumeRes
 }
 
 type RecoverVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RecoverVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias RecoverVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ResizeVolumeParams struct {
@@ -4975,6 +6355,50 @@ func (p *ResizeVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the resizeVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ResizeVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("resizeVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the resizeVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ResizeVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"diskofferingid": 255, "id": 255},
+	}.validate("resizeVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the resizeVolume API, before the request is signed
+func (p *ResizeVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ResizeVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ResizeVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("resizeVolume", b, &p.p, map[string]paramDecoder{
+		"automigrate":    decodeParam[bool],
+		"diskofferingid": decodeParam[string],
+		"id":             decodeParam[string],
+		"maxiops":        decodeParam[int64],
+		"miniops":        decodeParam[int64],
+		"shrinkok":       decodeParam[bool],
+		"size":           decodeParam[int64],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ResizeVolumeParams) Clone() *ResizeVolumeParams {
+	return &ResizeVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *ResizeVolumeParams) SetAutomigrate(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5133,32 +6557,39 @@ func (s *VolumeService) NewResizeVolumeParams(id string) *ResizeVolumeParams {
 
 // Resizes a volume
 func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("resizeVolume", p.toURLValues())
+	return s.ResizeVolumeWithContext(context.Background(), p)
+}
+
+// ResizeVolumeWithContext is like ResizeVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "resizeVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ResizeVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("resizeVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "resizeVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("resizeVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5166,80 +6597,105 @@ func (s *VolumeService) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ResizeVolume async job into r
+func (r *ResizeVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ResizeVolumeAsync starts the async job of ResizeVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) ResizeVolumeAsync(ctx context.Context, p *ResizeVolumeParams) (*Job, error) {
+	r, err := s.ResizeVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("resizeVolume", r.JobID), nil
+}
+
 type ResizeVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ResizeVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias ResizeVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UnmanageVolumeParams struct {
@@ -5257,6 +6713,44 @@ func (p *UnmanageVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the unmanageVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UnmanageVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("unmanageVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the unmanageVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UnmanageVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("unmanageVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the unmanageVolume API, before the request is signed
+func (p *UnmanageVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UnmanageVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UnmanageVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("unmanageVolume", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UnmanageVolumeParams) Clone() *UnmanageVolumeParams {
+	return &UnmanageVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *UnmanageVolumeParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5289,27 +6783,39 @@ func (s *VolumeService) NewUnmanageVolumeParams(id string) *UnmanageVolumeParams
 
 // Unmanage a volume on storage pool.
 func (s *VolumeService) UnmanageVolume(p *UnmanageVolumeParams) (*UnmanageVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("unmanageVolume", p.toURLValues())
+	return s.UnmanageVolumeWithContext(context.Background(), p)
+}
+
+// UnmanageVolumeWithContext is like UnmanageVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) UnmanageVolumeWithContext(ctx context.Context, p *UnmanageVolumeParams) (*UnmanageVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "unmanageVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UnmanageVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("unmanageVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "unmanageVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("unmanageVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5317,11 +6823,31 @@ func (s *VolumeService) UnmanageVolume(p *UnmanageVolumeParams) (*UnmanageVolume
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UnmanageVolume async job into r
+func (r *UnmanageVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// UnmanageVolumeAsync starts the async job of UnmanageVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) UnmanageVolumeAsync(ctx context.Context, p *UnmanageVolumeParams) (*Job, error) {
+	r, err := s.UnmanageVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("unmanageVolume", r.JobID), nil
+}
+
 type UnmanageVolumeResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UnmanageVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias UnmanageVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVolumeParams struct {
@@ -5365,6 +6891,51 @@ func (p *UpdateVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVolumeParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"chaininfo": 255, "customid": 255, "id": 255, "name": 255, "path": 255, "state": 255, "storageid": 255},
+	}.validate("updateVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVolume API, before the request is signed
+func (p *UpdateVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVolume", b, &p.p, map[string]paramDecoder{
+		"chaininfo":        decodeParam[string],
+		"customid":         decodeParam[string],
+		"deleteprotection": decodeParam[bool],
+		"displayvolume":    decodeParam[bool],
+		"id":               decodeParam[string],
+		"name":             decodeParam[string],
+		"path":             decodeParam[string],
+		"state":            decodeParam[string],
+		"storageid":        decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVolumeParams) Clone() *UpdateVolumeParams {
+	return &UpdateVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVolumeParams) SetChaininfo(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5564,32 +7135,39 @@ func (s *VolumeService) NewUpdateVolumeParams() *UpdateVolumeParams {
 
 // Updates the volume.
 func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVolume", p.toURLValues())
+	return s.UpdateVolumeWithContext(context.Background(), p)
+}
+
+// UpdateVolumeWithContext is like UpdateVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5597,80 +7175,105 @@ func (s *VolumeService) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateVolume async job into r
+func (r *UpdateVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateVolumeAsync starts the async job of UpdateVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) UpdateVolumeAsync(ctx context.Context, p *UpdateVolumeParams) (*Job, error) {
+	r, err := s.UpdateVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateVolume", r.JobID), nil
+}
+
 type UpdateVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UploadVolumeParams struct {
@@ -5715,6 +7318,53 @@ func (p *UploadVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the uploadVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UploadVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("uploadVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the uploadVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UploadVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"format", "name", "url", "zoneid"},
+		lengths:  map[string]int{"account": 255, "checksum": 255, "diskofferingid": 255, "domainid": 255, "format": 255, "imagestoreuuid": 255, "name": 255, "projectid": 255, "url": 2048, "zoneid": 255},
+	}.validate("uploadVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the uploadVolume API, before the request is signed
+func (p *UploadVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UploadVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UploadVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("uploadVolume", b, &p.p, map[string]paramDecoder{
+		"account":        decodeParam[string],
+		"checksum":       decodeParam[string],
+		"diskofferingid": decodeParam[string],
+		"domainid":       decodeParam[string],
+		"format":         decodeParam[string],
+		"imagestoreuuid": decodeParam[string],
+		"name":           decodeParam[string],
+		"projectid":      decodeParam[string],
+		"url":            decodeParam[string],
+		"zoneid":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UploadVolumeParams) Clone() *UploadVolumeParams {
+	return &UploadVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *UploadVolumeParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -5939,32 +7589,39 @@ func (s *VolumeService) NewUploadVolumeParams(format string, name string, url st
 
 // Uploads a data disk.
 func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("uploadVolume", p.toURLValues())
+	return s.UploadVolumeWithContext(context.Background(), p)
+}
+
+// UploadVolumeWithContext is like UploadVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "uploadVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UploadVolumeResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("uploadVolume", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "uploadVolume", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("uploadVolume", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -5972,80 +7629,105 @@ func (s *VolumeService) UploadVolume(p *UploadVolumeParams) (*UploadVolumeRespon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UploadVolume async job into r
+func (r *UploadVolumeResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UploadVolumeAsync starts the async job of UploadVolume without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) UploadVolumeAsync(ctx context.Context, p *UploadVolumeParams) (*Job, error) {
+	r, err := s.UploadVolumeWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("uploadVolume", r.JobID), nil
+}
+
 type UploadVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UploadVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias UploadVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVolumesUsageHistoryParams struct {
@@ -6087,6 +7769,50 @@ func (p *ListVolumesUsageHistoryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVolumesUsageHistory API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVolumesUsageHistoryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVolumesUsageHistory", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVolumesUsageHistory API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVolumesUsageHistoryParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "id": 255, "keyword": 255, "name": 255, "startdate": 255},
+	}.validate("listVolumesUsageHistory", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVolumesUsageHistory API, before the request is signed
+func (p *ListVolumesUsageHistoryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVolumesUsageHistoryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVolumesUsageHistoryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVolumesUsageHistory", b, &p.p, map[string]paramDecoder{
+		"enddate":   decodeParam[string],
+		"id":        decodeParam[string],
+		"ids":       decodeParam[[]string],
+		"keyword":   decodeParam[string],
+		"name":      decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"startdate": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVolumesUsageHistoryParams) Clone() *ListVolumesUsageHistoryParams {
+	return &ListVolumesUsageHistoryParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVolumesUsageHistoryParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6265,6 +7991,11 @@ func (s *VolumeService) NewListVolumesUsageHistoryParams() *ListVolumesUsageHist
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVolumesUsageHistoryIDWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumesUsageHistoryIDWithContext is like GetVolumesUsageHistoryID, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumesUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVolumesUsageHistoryParams{}
 	p.p = make(map[string]interface{})
 
@@ -6276,7 +8007,7 @@ func (s *VolumeService) GetVolumesUsageHistoryID(name string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListVolumesUsageHistory(p)
+	l, err := s.ListVolumesUsageHistoryWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -6301,12 +8032,17 @@ func (s *VolumeService) GetVolumesUsageHistoryID(name string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesUsageHistoryByName(name string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
-	id, count, err := s.GetVolumesUsageHistoryID(name, opts...)
+	return s.GetVolumesUsageHistoryByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetVolumesUsageHistoryByNameWithContext is like GetVolumesUsageHistoryByName, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumesUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+	id, count, err := s.GetVolumesUsageHistoryIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetVolumesUsageHistoryByID(id, opts...)
+	r, count, err := s.GetVolumesUsageHistoryByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -6315,6 +8051,11 @@ func (s *VolumeService) GetVolumesUsageHistoryByName(name string, opts ...Option
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *VolumeService) GetVolumesUsageHistoryByID(id string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+	return s.GetVolumesUsageHistoryByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetVolumesUsageHistoryByIDWithContext is like GetVolumesUsageHistoryByID, but honours the cancellation and deadline of ctx
+func (s *VolumeService) GetVolumesUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
 	p := &ListVolumesUsageHistoryParams{}
 	p.p = make(map[string]interface{})
 
@@ -6326,11 +8067,10 @@ func (s *VolumeService) GetVolumesUsageHistoryByID(id string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListVolumesUsageHistory(p)
+	l, err := s.ListVolumesUsageHistoryWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -6348,30 +8088,73 @@ func (s *VolumeService) GetVolumesUsageHistoryByID(id string, opts ...OptionFunc
 
 // Lists volume stats
 func (s *VolumeService) ListVolumesUsageHistory(p *ListVolumesUsageHistoryParams) (*ListVolumesUsageHistoryResponse, error) {
-	resp, err := s.cs.newRequest("listVolumesUsageHistory", p.toURLValues())
+	return s.ListVolumesUsageHistoryWithContext(context.Background(), p)
+}
+
+// ListVolumesUsageHistoryWithContext is like ListVolumesUsageHistory, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesUsageHistoryWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams) (*ListVolumesUsageHistoryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVolumesUsageHistory", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVolumesUsageHistoryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVolumesUsageHistory", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVolumesUsageHistoryAll returns all VolumesUsageHistory matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *VolumeService) ListVolumesUsageHistoryAll(p *ListVolumesUsageHistoryParams, opts ...PageOption) ([]*VolumesUsageHistory, error) {
+	return s.ListVolumesUsageHistoryAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesUsageHistoryAllWithContext is like ListVolumesUsageHistoryAll, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesUsageHistoryAllWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams, opts ...PageOption) ([]*VolumesUsageHistory, error) {
+	return collectPages(s.ListVolumesUsageHistoryIterWithContext(ctx, p, opts...))
+}
+
+// ListVolumesUsageHistoryIter returns an iterator over all VolumesUsageHistory matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *VolumeService) ListVolumesUsageHistoryIter(p *ListVolumesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VolumesUsageHistory, error] {
+	return s.ListVolumesUsageHistoryIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVolumesUsageHistoryIterWithContext is like ListVolumesUsageHistoryIter, but honours the cancellation and deadline of ctx
+func (s *VolumeService) ListVolumesUsageHistoryIterWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VolumesUsageHistory, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VolumesUsageHistory, int, error) {
+		l, err := s.ListVolumesUsageHistoryWithContext(ctx, &ListVolumesUsageHistoryParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VolumesUsageHistory, l.Count, nil
+	}, opts...)
+}
+
 type ListVolumesUsageHistoryResponse struct {
 	Count               int                    `json:"count"`
 	VolumesUsageHistory []*VolumesUsageHistory `json:"volume"`
 }
 
 type VolumesUsageHistory struct {
-	Id        string   `json:"id"`
-	JobID     string   `json:"jobid"`
-	Jobstatus int      `json:"jobstatus"`
-	Name      string   `json:"name"`
-	Stats     []string `json:"stats"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Stats     []string                   `json:"stats"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VolumesUsageHistory) UnmarshalJSON(b []byte) error {
+	type alias VolumesUsageHistory
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AssignVolumeParams struct {
@@ -6395,6 +8178,46 @@ func (p *AssignVolumeParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the assignVolume API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AssignVolumeParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("assignVolume", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the assignVolume API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AssignVolumeParams) Validate() error {
+	return paramRules{
+		required: []string{"volumeid"},
+		lengths:  map[string]int{"accountid": 255, "projectid": 255, "volumeid": 255},
+	}.validate("assignVolume", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the assignVolume API, before the request is signed
+func (p *AssignVolumeParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AssignVolumeParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AssignVolumeParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("assignVolume", b, &p.p, map[string]paramDecoder{
+		"accountid": decodeParam[string],
+		"projectid": decodeParam[string],
+		"volumeid":  decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AssignVolumeParams) Clone() *AssignVolumeParams {
+	return &AssignVolumeParams{p: cloneParams(p.p)}
+}
+
 func (p *AssignVolumeParams) SetAccountid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6469,7 +8292,16 @@ func (s *VolumeService) NewAssignVolumeParams(volumeid string) *AssignVolumePara
 
 // Changes ownership of a Volume from one Account to another.
 func (s *VolumeService) AssignVolume(p *AssignVolumeParams) (*AssignVolumeResponse, error) {
-	resp, err := s.cs.newPostRequest("assignVolume", p.toURLValues())
+	return s.AssignVolumeWithContext(context.Background(), p)
+}
+
+// AssignVolumeWithContext is like AssignVolume, but honours the cancellation and deadline of ctx
+func (s *VolumeService) AssignVolumeWithContext(ctx context.Context, p *AssignVolumeParams) (*AssignVolumeResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "assignVolume", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -6477,7 +8309,7 @@ func (s *VolumeService) AssignVolume(p *AssignVolumeParams) (*AssignVolumeRespon
 	var nested struct {
 		Response AssignVolumeResponse `json:"volume"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("assignVolume", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -6486,79 +8318,85 @@ func (s *VolumeService) AssignVolume(p *AssignVolumeParams) (*AssignVolumeRespon
 }
 
 type AssignVolumeResponse struct {
-	Account                    string            `json:"account"`
-	Attached                   string            `json:"attached"`
-	Chaininfo                  string            `json:"chaininfo"`
-	Clusterid                  string            `json:"clusterid"`
-	Clustername                string            `json:"clustername"`
-	Created                    string            `json:"created"`
-	Deleteprotection           bool              `json:"deleteprotection"`
-	Destroyed                  bool              `json:"destroyed"`
-	Deviceid                   int64             `json:"deviceid"`
-	DiskBytesReadRate          int64             `json:"diskBytesReadRate"`
-	DiskBytesWriteRate         int64             `json:"diskBytesWriteRate"`
-	DiskIopsReadRate           int64             `json:"diskIopsReadRate"`
-	DiskIopsWriteRate          int64             `json:"diskIopsWriteRate"`
-	Diskioread                 int64             `json:"diskioread"`
-	Diskiowrite                int64             `json:"diskiowrite"`
-	Diskkbsread                int64             `json:"diskkbsread"`
-	Diskkbswrite               int64             `json:"diskkbswrite"`
-	Diskofferingdisplaytext    string            `json:"diskofferingdisplaytext"`
-	Diskofferingid             string            `json:"diskofferingid"`
-	Diskofferingname           string            `json:"diskofferingname"`
-	Displayvolume              bool              `json:"displayvolume"`
-	Domain                     string            `json:"domain"`
-	Domainid                   string            `json:"domainid"`
-	Domainpath                 string            `json:"domainpath"`
-	Encryptformat              string            `json:"encryptformat"`
-	Externaluuid               string            `json:"externaluuid"`
-	Hasannotations             bool              `json:"hasannotations"`
-	Hypervisor                 string            `json:"hypervisor"`
-	Id                         string            `json:"id"`
-	Isextractable              bool              `json:"isextractable"`
-	Isodisplaytext             string            `json:"isodisplaytext"`
-	Isoid                      string            `json:"isoid"`
-	Isoname                    string            `json:"isoname"`
-	JobID                      string            `json:"jobid"`
-	Jobstatus                  int               `json:"jobstatus"`
-	Maxiops                    int64             `json:"maxiops"`
-	Miniops                    int64             `json:"miniops"`
-	Name                       string            `json:"name"`
-	Path                       string            `json:"path"`
-	Physicalsize               int64             `json:"physicalsize"`
-	Podid                      string            `json:"podid"`
-	Podname                    string            `json:"podname"`
-	Project                    string            `json:"project"`
-	Projectid                  string            `json:"projectid"`
-	Provisioningtype           string            `json:"provisioningtype"`
-	Quiescevm                  bool              `json:"quiescevm"`
-	Serviceofferingdisplaytext string            `json:"serviceofferingdisplaytext"`
-	Serviceofferingid          string            `json:"serviceofferingid"`
-	Serviceofferingname        string            `json:"serviceofferingname"`
-	Size                       int64             `json:"size"`
-	Snapshotid                 string            `json:"snapshotid"`
-	State                      string            `json:"state"`
-	Status                     string            `json:"status"`
-	Storage                    string            `json:"storage"`
-	Storageid                  string            `json:"storageid"`
-	Storagetype                string            `json:"storagetype"`
-	Supportsstoragesnapshot    bool              `json:"supportsstoragesnapshot"`
-	Tags                       []Tags            `json:"tags"`
-	Templatedisplaytext        string            `json:"templatedisplaytext"`
-	Templateid                 string            `json:"templateid"`
-	Templatename               string            `json:"templatename"`
-	Type                       string            `json:"type"`
-	Utilization                string            `json:"utilization"`
-	Virtualmachineid           string            `json:"virtualmachineid"`
-	Virtualsize                int64             `json:"virtualsize"`
-	Vmdisplayname              string            `json:"vmdisplayname"`
-	Vmname                     string            `json:"vmname"`
-	Vmstate                    string            `json:"vmstate"`
-	Vmtype                     string            `json:"vmtype"`
-	Volumecheckresult          map[string]string `json:"volumecheckresult"`
-	Volumerepairresult         map[string]string `json:"volumerepairresult"`
-	Zoneid                     string            `json:"zoneid"`
-	Zonename                   string            `json:"zonename"`
+	Account                    string                     `json:"account"`
+	Attached                   string                     `json:"attached"`
+	Chaininfo                  string                     `json:"chaininfo"`
+	Clusterid                  string                     `json:"clusterid"`
+	Clustername                string                     `json:"clustername"`
+	Created                    Time                       `json:"created"`
+	Deleteprotection           FlexBool                   `json:"deleteprotection"`
+	Destroyed                  FlexBool                   `json:"destroyed"`
+	Deviceid                   FlexInt64                  `json:"deviceid"`
+	DiskBytesReadRate          FlexInt64                  `json:"diskBytesReadRate"`
+	DiskBytesWriteRate         FlexInt64                  `json:"diskBytesWriteRate"`
+	DiskIopsReadRate           FlexInt64                  `json:"diskIopsReadRate"`
+	DiskIopsWriteRate          FlexInt64                  `json:"diskIopsWriteRate"`
+	Diskioread                 FlexInt64                  `json:"diskioread"`
+	Diskiowrite                FlexInt64                  `json:"diskiowrite"`
+	Diskkbsread                FlexInt64                  `json:"diskkbsread"`
+	Diskkbswrite               FlexInt64                  `json:"diskkbswrite"`
+	Diskofferingdisplaytext    string                     `json:"diskofferingdisplaytext"`
+	Diskofferingid             string                     `json:"diskofferingid"`
+	Diskofferingname           string                     `json:"diskofferingname"`
+	Displayvolume              FlexBool                   `json:"displayvolume"`
+	Domain                     string                     `json:"domain"`
+	Domainid                   string                     `json:"domainid"`
+	Domainpath                 string                     `json:"domainpath"`
+	Encryptformat              string                     `json:"encryptformat"`
+	Externaluuid               string                     `json:"externaluuid"`
+	Hasannotations             FlexBool                   `json:"hasannotations"`
+	Hypervisor                 string                     `json:"hypervisor"`
+	Id                         string                     `json:"id"`
+	Isextractable              FlexBool                   `json:"isextractable"`
+	Isodisplaytext             string                     `json:"isodisplaytext"`
+	Isoid                      string                     `json:"isoid"`
+	Isoname                    string                     `json:"isoname"`
+	JobID                      string                     `json:"jobid"`
+	Jobstatus                  FlexInt                    `json:"jobstatus"`
+	Maxiops                    FlexInt64                  `json:"maxiops"`
+	Miniops                    FlexInt64                  `json:"miniops"`
+	Name                       string                     `json:"name"`
+	Path                       string                     `json:"path"`
+	Physicalsize               FlexInt64                  `json:"physicalsize"`
+	Podid                      string                     `json:"podid"`
+	Podname                    string                     `json:"podname"`
+	Project                    string                     `json:"project"`
+	Projectid                  string                     `json:"projectid"`
+	Provisioningtype           string                     `json:"provisioningtype"`
+	Quiescevm                  FlexBool                   `json:"quiescevm"`
+	Serviceofferingdisplaytext string                     `json:"serviceofferingdisplaytext"`
+	Serviceofferingid          string                     `json:"serviceofferingid"`
+	Serviceofferingname        string                     `json:"serviceofferingname"`
+	Size                       FlexInt64                  `json:"size"`
+	Snapshotid                 string                     `json:"snapshotid"`
+	State                      VolumeState                `json:"state"`
+	Status                     string                     `json:"status"`
+	Storage                    string                     `json:"storage"`
+	Storageid                  string                     `json:"storageid"`
+	Storagetype                string                     `json:"storagetype"`
+	Supportsstoragesnapshot    FlexBool                   `json:"supportsstoragesnapshot"`
+	Tags                       []Tags                     `json:"tags"`
+	Templatedisplaytext        string                     `json:"templatedisplaytext"`
+	Templateid                 string                     `json:"templateid"`
+	Templatename               string                     `json:"templatename"`
+	Type                       string                     `json:"type"`
+	Utilization                string                     `json:"utilization"`
+	Virtualmachineid           string                     `json:"virtualmachineid"`
+	Virtualsize                FlexInt64                  `json:"virtualsize"`
+	Vmdisplayname              string                     `json:"vmdisplayname"`
+	Vmname                     string                     `json:"vmname"`
+	Vmstate                    string                     `json:"vmstate"`
+	Vmtype                     string                     `json:"vmtype"`
+	Volumecheckresult          map[string]string          `json:"volumecheckresult"`
+	Volumerepairresult         map[string]string          `json:"volumerepairresult"`
+	Zoneid                     string                     `json:"zoneid"`
+	Zonename                   string                     `json:"zonename"`
+	Extra                      map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AssignVolumeResponse) UnmarshalJSON(b []byte) error {
+	type alias AssignVolumeResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RestoreVolumeFromBackupAndAttachToVMParams struct {
@@ -6582,6 +8420,46 @@ func (p *RestoreVolumeFromBackupAndAttachToVMParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the restoreVolumeFromBackupAndAttachToVM API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("restoreVolumeFromBackupAndAttachToVM", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the restoreVolumeFromBackupAndAttachToVM API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) Validate() error {
+	return paramRules{
+		required: []string{"backupid", "virtualmachineid", "volumeid"},
+		lengths:  map[string]int{"backupid": 255, "virtualmachineid": 255, "volumeid": 255},
+	}.validate("restoreVolumeFromBackupAndAttachToVM", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the restoreVolumeFromBackupAndAttachToVM API, before the request is signed
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("restoreVolumeFromBackupAndAttachToVM", b, &p.p, map[string]paramDecoder{
+		"backupid":         decodeParam[string],
+		"virtualmachineid": decodeParam[string],
+		"volumeid":         decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RestoreVolumeFromBackupAndAttachToVMParams) Clone() *RestoreVolumeFromBackupAndAttachToVMParams {
+	return &RestoreVolumeFromBackupAndAttachToVMParams{p: cloneParams(p.p)}
+}
+
 func (p *RestoreVolumeFromBackupAndAttachToVMParams) SetBackupid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -6658,27 +8536,39 @@ func (s *VolumeService) NewRestoreVolumeFromBackupAndAttachToVMParams(backupid s
 
 // Restore and attach a backed up volume to Instance
 func (s *VolumeService) RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
-	resp, err := s.cs.newPostRequest("restoreVolumeFromBackupAndAttachToVM", p.toURLValues())
+	return s.RestoreVolumeFromBackupAndAttachToVMWithContext(context.Background(), p)
+}
+
+// RestoreVolumeFromBackupAndAttachToVMWithContext is like RestoreVolumeFromBackupAndAttachToVM, but honours the cancellation and deadline of ctx
+func (s *VolumeService) RestoreVolumeFromBackupAndAttachToVMWithContext(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "restoreVolumeFromBackupAndAttachToVM", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RestoreVolumeFromBackupAndAttachToVMResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("restoreVolumeFromBackupAndAttachToVM", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "restoreVolumeFromBackupAndAttachToVM", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("restoreVolumeFromBackupAndAttachToVM", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -6686,9 +8576,29 @@ func (s *VolumeService) RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFro
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished RestoreVolumeFromBackupAndAttachToVM async job into r
+func (r *RestoreVolumeFromBackupAndAttachToVMResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// RestoreVolumeFromBackupAndAttachToVMAsync starts the async job of RestoreVolumeFromBackupAndAttachToVM without waiting for it to finish, and returns a handle to the job
+func (s *VolumeService) RestoreVolumeFromBackupAndAttachToVMAsync(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*Job, error) {
+	r, err := s.RestoreVolumeFromBackupAndAttachToVMWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("restoreVolumeFromBackupAndAttachToVM", r.JobID), nil
+}
+
 type RestoreVolumeFromBackupAndAttachToVMResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *RestoreVolumeFromBackupAndAttachToVMResponse) UnmarshalJSON(b []byte) error {
+	type alias RestoreVolumeFromBackupAndAttachToVMResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockVolumeServiceIfaceMockRecorder) AssignVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).AssignVolume), p)
 }
 
+// AssignVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) AssignVolumeWithContext(ctx context.Context, p *AssignVolumeParams) (*AssignVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssignVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*AssignVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssignVolumeWithContext indicates an expected call of AssignVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) AssignVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssignVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).AssignVolumeWithContext), ctx, p)
+}
+
 // AttachVolume mocks base method.
 func (m *MockVolumeServiceIface) AttachVolume(p *AttachVolumeParams) (*AttachVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) AttachVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).AttachVolume), p)
 }
 
+// AttachVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) AttachVolumeAsync(ctx context.Context, p *AttachVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachVolumeAsync indicates an expected call of AttachVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) AttachVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).AttachVolumeAsync), ctx, p)
+}
+
+// AttachVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) AttachVolumeWithContext(ctx context.Context, p *AttachVolumeParams) (*AttachVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AttachVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*AttachVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AttachVolumeWithContext indicates an expected call of AttachVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) AttachVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AttachVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).AttachVolumeWithContext), ctx, p)
+}
+
 // ChangeOfferingForVolume mocks base method.
 func (m *MockVolumeServiceIface) ChangeOfferingForVolume(p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) ChangeOfferingForVolume(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOfferingForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ChangeOfferingForVolume), p)
 }
 
+// ChangeOfferingForVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) ChangeOfferingForVolumeAsync(ctx context.Context, p *ChangeOfferingForVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeOfferingForVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeOfferingForVolumeAsync indicates an expected call of ChangeOfferingForVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) ChangeOfferingForVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOfferingForVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).ChangeOfferingForVolumeAsync), ctx, p)
+}
+
+// ChangeOfferingForVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) ChangeOfferingForVolumeWithContext(ctx context.Context, p *ChangeOfferingForVolumeParams) (*ChangeOfferingForVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ChangeOfferingForVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ChangeOfferingForVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ChangeOfferingForVolumeWithContext indicates an expected call of ChangeOfferingForVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ChangeOfferingForVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ChangeOfferingForVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ChangeOfferingForVolumeWithContext), ctx, p)
+}
+
 // CheckVolume mocks base method.
 func (m *MockVolumeServiceIface) CheckVolume(p *CheckVolumeParams) (*CheckVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +195,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) CheckVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).CheckVolume), p)
 }
 
+// CheckVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) CheckVolumeAsync(ctx context.Context, p *CheckVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckVolumeAsync indicates an expected call of CheckVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) CheckVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).CheckVolumeAsync), ctx, p)
+}
+
+// CheckVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) CheckVolumeWithContext(ctx context.Context, p *CheckVolumeParams) (*CheckVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CheckVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*CheckVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CheckVolumeWithContext indicates an expected call of CheckVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) CheckVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CheckVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).CheckVolumeWithContext), ctx, p)
+}
+
 // CreateVolume mocks base method.
 func (m *MockVolumeServiceIface) CreateVolume(p *CreateVolumeParams) (*CreateVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +240,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) CreateVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).CreateVolume), p)
 }
 
+// CreateVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) CreateVolumeAsync(ctx context.Context, p *CreateVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeAsync indicates an expected call of CreateVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) CreateVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).CreateVolumeAsync), ctx, p)
+}
+
+// CreateVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) CreateVolumeWithContext(ctx context.Context, p *CreateVolumeParams) (*CreateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateVolumeWithContext indicates an expected call of CreateVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) CreateVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).CreateVolumeWithContext), ctx, p)
+}
+
 // DeleteVolume mocks base method.
 func (m *MockVolumeServiceIface) DeleteVolume(p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +285,21 @@ func (mr *MockVolumeServiceIfaceMockRecorder) DeleteVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DeleteVolume), p)
 }
 
+// DeleteVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) DeleteVolumeWithContext(ctx context.Context, p *DeleteVolumeParams) (*DeleteVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteVolumeWithContext indicates an expected call of DeleteVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) DeleteVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).DeleteVolumeWithContext), ctx, p)
+}
+
 // DestroyVolume mocks base method.
 func (m *MockVolumeServiceIface) DestroyVolume(p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +315,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) DestroyVolume(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DestroyVolume), p)
 }
 
+// DestroyVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) DestroyVolumeAsync(ctx context.Context, p *DestroyVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyVolumeAsync indicates an expected call of DestroyVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) DestroyVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).DestroyVolumeAsync), ctx, p)
+}
+
+// DestroyVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) DestroyVolumeWithContext(ctx context.Context, p *DestroyVolumeParams) (*DestroyVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DestroyVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DestroyVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DestroyVolumeWithContext indicates an expected call of DestroyVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) DestroyVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DestroyVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).DestroyVolumeWithContext), ctx, p)
+}
+
 // DetachVolume mocks base method.
 func (m *MockVolumeServiceIface) DetachVolume(p *DetachVolumeParams) (*DetachVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +360,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) DetachVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).DetachVolume), p)
 }
 
+// DetachVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) DetachVolumeAsync(ctx context.Context, p *DetachVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachVolumeAsync indicates an expected call of DetachVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) DetachVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).DetachVolumeAsync), ctx, p)
+}
+
+// DetachVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) DetachVolumeWithContext(ctx context.Context, p *DetachVolumeParams) (*DetachVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DetachVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*DetachVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DetachVolumeWithContext indicates an expected call of DetachVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) DetachVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DetachVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).DetachVolumeWithContext), ctx, p)
+}
+
 // ExtractVolume mocks base method.
 func (m *MockVolumeServiceIface) ExtractVolume(p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +405,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) ExtractVolume(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ExtractVolume), p)
 }
 
+// ExtractVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) ExtractVolumeAsync(ctx context.Context, p *ExtractVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractVolumeAsync indicates an expected call of ExtractVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) ExtractVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).ExtractVolumeAsync), ctx, p)
+}
+
+// ExtractVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) ExtractVolumeWithContext(ctx context.Context, p *ExtractVolumeParams) (*ExtractVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExtractVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExtractVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExtractVolumeWithContext indicates an expected call of ExtractVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ExtractVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExtractVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ExtractVolumeWithContext), ctx, p)
+}
+
 // GetElastistorVolumeByID mocks base method.
 func (m *MockVolumeServiceIface) GetElastistorVolumeByID(id string, opts ...OptionFunc) (*ElastistorVolume, int, error) {
 	m.ctrl.T.Helper()
@@ -214,6 +456,27 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetElastistorVolumeByID(id any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElastistorVolumeByID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetElastistorVolumeByID), varargs...)
 }
 
+// GetElastistorVolumeByIDWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetElastistorVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ElastistorVolume, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetElastistorVolumeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ElastistorVolume)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetElastistorVolumeByIDWithContext indicates an expected call of GetElastistorVolumeByIDWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetElastistorVolumeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetElastistorVolumeByIDWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetElastistorVolumeByIDWithContext), varargs...)
+}
+
 // GetPathForVolume mocks base method.
 func (m *MockVolumeServiceIface) GetPathForVolume(p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -229,6 +492,21 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetPathForVolume(p any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetPathForVolume), p)
 }
 
+// GetPathForVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetPathForVolumeWithContext(ctx context.Context, p *GetPathForVolumeParams) (*GetPathForVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetPathForVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetPathForVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetPathForVolumeWithContext indicates an expected call of GetPathForVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetPathForVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetPathForVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetPathForVolumeWithContext), ctx, p)
+}
+
 // GetUploadParamsForVolume mocks base method.
 func (m *MockVolumeServiceIface) GetUploadParamsForVolume(p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +522,21 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetUploadParamsForVolume(p any) *g
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetUploadParamsForVolume), p)
 }
 
+// GetUploadParamsForVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetUploadParamsForVolumeWithContext(ctx context.Context, p *GetUploadParamsForVolumeParams) (*GetUploadParamsForVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUploadParamsForVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetUploadParamsForVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUploadParamsForVolumeWithContext indicates an expected call of GetUploadParamsForVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetUploadParamsForVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUploadParamsForVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetUploadParamsForVolumeWithContext), ctx, p)
+}
+
 // GetVolumeByID mocks base method.
 func (m *MockVolumeServiceIface) GetVolumeByID(id string, opts ...OptionFunc) (*Volume, int, error) {
 	m.ctrl.T.Helper()
@@ -265,6 +558,27 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeByID(id any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeByID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeByID), varargs...)
 }
 
+// GetVolumeByIDWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumeByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Volume, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumeByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Volume)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumeByIDWithContext indicates an expected call of GetVolumeByIDWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeByIDWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeByIDWithContext), varargs...)
+}
+
 // GetVolumeByName mocks base method.
 func (m *MockVolumeServiceIface) GetVolumeByName(name string, opts ...OptionFunc) (*Volume, int, error) {
 	m.ctrl.T.Helper()
@@ -286,6 +600,27 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeByName(name any, opts ...
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeByName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeByName), varargs...)
 }
 
+// GetVolumeByNameWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumeByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Volume, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumeByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Volume)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumeByNameWithContext indicates an expected call of GetVolumeByNameWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeByNameWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeByNameWithContext), varargs...)
+}
+
 // GetVolumeID mocks base method.
 func (m *MockVolumeServiceIface) GetVolumeID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -307,6 +642,27 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeID(name any, opts ...any)
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeID), varargs...)
 }
 
+// GetVolumeIDWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumeIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumeIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumeIDWithContext indicates an expected call of GetVolumeIDWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeIDWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeIDWithContext), varargs...)
+}
+
 // GetVolumeiScsiName mocks base method.
 func (m *MockVolumeServiceIface) GetVolumeiScsiName(p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
 	m.ctrl.T.Helper()
@@ -322,6 +678,21 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeiScsiName(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeiScsiName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeiScsiName), p)
 }
 
+// GetVolumeiScsiNameWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumeiScsiNameWithContext(ctx context.Context, p *GetVolumeiScsiNameParams) (*GetVolumeiScsiNameResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetVolumeiScsiNameWithContext", ctx, p)
+	ret0, _ := ret[0].(*GetVolumeiScsiNameResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetVolumeiScsiNameWithContext indicates an expected call of GetVolumeiScsiNameWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumeiScsiNameWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumeiScsiNameWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumeiScsiNameWithContext), ctx, p)
+}
+
 // GetVolumesMetricByID mocks base method.
 func (m *MockVolumeServiceIface) GetVolumesMetricByID(id string, opts ...OptionFunc) (*VolumesMetric, int, error) {
 	m.ctrl.T.Helper()
@@ -343,199 +714,742 @@ func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricByID(id any, opts
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricByID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricByID), varargs...)
 }
 
-// GetVolumesMetricByName mocks base method.
-func (m *MockVolumeServiceIface) GetVolumesMetricByName(name string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+// GetVolumesMetricByIDWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesMetricByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VolumesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesMetricByIDWithContext indicates an expected call of GetVolumesMetricByIDWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricByIDWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricByIDWithContext), varargs...)
+}
+
+// GetVolumesMetricByName mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesMetricByName(name string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesMetricByName", varargs...)
+	ret0, _ := ret[0].(*VolumesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesMetricByName indicates an expected call of GetVolumesMetricByName.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricByName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricByName), varargs...)
+}
+
+// GetVolumesMetricByNameWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesMetricByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VolumesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesMetricByNameWithContext indicates an expected call of GetVolumesMetricByNameWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricByNameWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricByNameWithContext), varargs...)
+}
+
+// GetVolumesMetricID mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesMetricID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesMetricID indicates an expected call of GetVolumesMetricID.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricID), varargs...)
+}
+
+// GetVolumesMetricIDWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesMetricIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesMetricIDWithContext indicates an expected call of GetVolumesMetricIDWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricIDWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricIDWithContext), varargs...)
+}
+
+// GetVolumesUsageHistoryByID mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesUsageHistoryByID(id string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryByID", varargs...)
+	ret0, _ := ret[0].(*VolumesUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesUsageHistoryByID indicates an expected call of GetVolumesUsageHistoryByID.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryByID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryByID), varargs...)
+}
+
+// GetVolumesUsageHistoryByIDWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesUsageHistoryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*VolumesUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesUsageHistoryByIDWithContext indicates an expected call of GetVolumesUsageHistoryByIDWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryByIDWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryByIDWithContext), varargs...)
+}
+
+// GetVolumesUsageHistoryByName mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesUsageHistoryByName(name string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryByName", varargs...)
+	ret0, _ := ret[0].(*VolumesUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesUsageHistoryByName indicates an expected call of GetVolumesUsageHistoryByName.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryByName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryByName), varargs...)
+}
+
+// GetVolumesUsageHistoryByNameWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesUsageHistoryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*VolumesUsageHistory)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesUsageHistoryByNameWithContext indicates an expected call of GetVolumesUsageHistoryByNameWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryByNameWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryByNameWithContext), varargs...)
+}
+
+// GetVolumesUsageHistoryID mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesUsageHistoryID indicates an expected call of GetVolumesUsageHistoryID.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryID), varargs...)
+}
+
+// GetVolumesUsageHistoryIDWithContext mocks base method.
+func (m *MockVolumeServiceIface) GetVolumesUsageHistoryIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVolumesUsageHistoryIDWithContext indicates an expected call of GetVolumesUsageHistoryIDWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryIDWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryIDWithContext), varargs...)
+}
+
+// ImportVolume mocks base method.
+func (m *MockVolumeServiceIface) ImportVolume(p *ImportVolumeParams) (*ImportVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportVolume", p)
+	ret0, _ := ret[0].(*ImportVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportVolume indicates an expected call of ImportVolume.
+func (mr *MockVolumeServiceIfaceMockRecorder) ImportVolume(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ImportVolume), p)
+}
+
+// ImportVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) ImportVolumeAsync(ctx context.Context, p *ImportVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportVolumeAsync indicates an expected call of ImportVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) ImportVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).ImportVolumeAsync), ctx, p)
+}
+
+// ImportVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) ImportVolumeWithContext(ctx context.Context, p *ImportVolumeParams) (*ImportVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ImportVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ImportVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ImportVolumeWithContext indicates an expected call of ImportVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ImportVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ImportVolumeWithContext), ctx, p)
+}
+
+// ListElastistorVolume mocks base method.
+func (m *MockVolumeServiceIface) ListElastistorVolume(p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListElastistorVolume", p)
+	ret0, _ := ret[0].(*ListElastistorVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListElastistorVolume indicates an expected call of ListElastistorVolume.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListElastistorVolume(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElastistorVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListElastistorVolume), p)
+}
+
+// ListElastistorVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListElastistorVolumeWithContext(ctx context.Context, p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListElastistorVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListElastistorVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListElastistorVolumeWithContext indicates an expected call of ListElastistorVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListElastistorVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElastistorVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListElastistorVolumeWithContext), ctx, p)
+}
+
+// ListVolumes mocks base method.
+func (m *MockVolumeServiceIface) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumes", p)
+	ret0, _ := ret[0].(*ListVolumesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumes indicates an expected call of ListVolumes.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumes(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumes), p)
+}
+
+// ListVolumesAll mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesAll(p *ListVolumesParams, opts ...PageOption) ([]*Volume, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesAll", varargs...)
+	ret0, _ := ret[0].([]*Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumesAll indicates an expected call of ListVolumesAll.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesAll", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesAll), varargs...)
+}
+
+// ListVolumesAllWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesAllWithContext(ctx context.Context, p *ListVolumesParams, opts ...PageOption) ([]*Volume, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Volume)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumesAllWithContext indicates an expected call of ListVolumesAllWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesAllWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesAllWithContext), varargs...)
+}
+
+// ListVolumesForImport mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesForImport(p *ListVolumesForImportParams) (*ListVolumesForImportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumesForImport", p)
+	ret0, _ := ret[0].(*ListVolumesForImportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumesForImport indicates an expected call of ListVolumesForImport.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesForImport(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesForImport", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesForImport), p)
+}
+
+// ListVolumesForImportAll mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesForImportAll(p *ListVolumesForImportParams, opts ...PageOption) ([]*VolumesForImport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesForImportAll", varargs...)
+	ret0, _ := ret[0].([]*VolumesForImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumesForImportAll indicates an expected call of ListVolumesForImportAll.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesForImportAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesForImportAll", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesForImportAll), varargs...)
+}
+
+// ListVolumesForImportAllWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesForImportAllWithContext(ctx context.Context, p *ListVolumesForImportParams, opts ...PageOption) ([]*VolumesForImport, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesForImportAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VolumesForImport)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumesForImportAllWithContext indicates an expected call of ListVolumesForImportAllWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesForImportAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesForImportAllWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesForImportAllWithContext), varargs...)
+}
+
+// ListVolumesForImportIter mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesForImportIter(p *ListVolumesForImportParams, opts ...PageOption) iter.Seq2[*VolumesForImport, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesForImportIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VolumesForImport, error])
+	return ret0
+}
+
+// ListVolumesForImportIter indicates an expected call of ListVolumesForImportIter.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesForImportIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesForImportIter", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesForImportIter), varargs...)
+}
+
+// ListVolumesForImportIterWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesForImportIterWithContext(ctx context.Context, p *ListVolumesForImportParams, opts ...PageOption) iter.Seq2[*VolumesForImport, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesForImportIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VolumesForImport, error])
+	return ret0
+}
+
+// ListVolumesForImportIterWithContext indicates an expected call of ListVolumesForImportIterWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesForImportIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesForImportIterWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesForImportIterWithContext), varargs...)
+}
+
+// ListVolumesForImportWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesForImportWithContext(ctx context.Context, p *ListVolumesForImportParams) (*ListVolumesForImportResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumesForImportWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVolumesForImportResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumesForImportWithContext indicates an expected call of ListVolumesForImportWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesForImportWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesForImportWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesForImportWithContext), ctx, p)
+}
+
+// ListVolumesIter mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesIter(p *ListVolumesParams, opts ...PageOption) iter.Seq2[*Volume, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Volume, error])
+	return ret0
+}
+
+// ListVolumesIter indicates an expected call of ListVolumesIter.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesIter", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesIter), varargs...)
+}
+
+// ListVolumesIterWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesIterWithContext(ctx context.Context, p *ListVolumesParams, opts ...PageOption) iter.Seq2[*Volume, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Volume, error])
+	return ret0
+}
+
+// ListVolumesIterWithContext indicates an expected call of ListVolumesIterWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesIterWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesIterWithContext), varargs...)
+}
+
+// ListVolumesMetrics mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumesMetrics", p)
+	ret0, _ := ret[0].(*ListVolumesMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVolumesMetrics indicates an expected call of ListVolumesMetrics.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetrics(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetrics", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetrics), p)
+}
+
+// ListVolumesMetricsAll mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesMetricsAll(p *ListVolumesMetricsParams, opts ...PageOption) ([]*VolumesMetric, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVolumesMetricByName", varargs...)
-	ret0, _ := ret[0].(*VolumesMetric)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVolumesMetricsAll", varargs...)
+	ret0, _ := ret[0].([]*VolumesMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetVolumesMetricByName indicates an expected call of GetVolumesMetricByName.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricByName(name any, opts ...any) *gomock.Call {
+// ListVolumesMetricsAll indicates an expected call of ListVolumesMetricsAll.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetricsAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricByName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricByName), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetricsAll", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetricsAll), varargs...)
 }
 
-// GetVolumesMetricID mocks base method.
-func (m *MockVolumeServiceIface) GetVolumesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+// ListVolumesMetricsAllWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesMetricsAllWithContext(ctx context.Context, p *ListVolumesMetricsParams, opts ...PageOption) ([]*VolumesMetric, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVolumesMetricID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVolumesMetricsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VolumesMetric)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetVolumesMetricID indicates an expected call of GetVolumesMetricID.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesMetricID(name any, opts ...any) *gomock.Call {
+// ListVolumesMetricsAllWithContext indicates an expected call of ListVolumesMetricsAllWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetricsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesMetricID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesMetricID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetricsAllWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetricsAllWithContext), varargs...)
 }
 
-// GetVolumesUsageHistoryByID mocks base method.
-func (m *MockVolumeServiceIface) GetVolumesUsageHistoryByID(id string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+// ListVolumesMetricsIter mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesMetricsIter(p *ListVolumesMetricsParams, opts ...PageOption) iter.Seq2[*VolumesMetric, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryByID", varargs...)
-	ret0, _ := ret[0].(*VolumesUsageHistory)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVolumesMetricsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VolumesMetric, error])
+	return ret0
 }
 
-// GetVolumesUsageHistoryByID indicates an expected call of GetVolumesUsageHistoryByID.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryByID(id any, opts ...any) *gomock.Call {
+// ListVolumesMetricsIter indicates an expected call of ListVolumesMetricsIter.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetricsIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryByID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetricsIter", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetricsIter), varargs...)
 }
 
-// GetVolumesUsageHistoryByName mocks base method.
-func (m *MockVolumeServiceIface) GetVolumesUsageHistoryByName(name string, opts ...OptionFunc) (*VolumesUsageHistory, int, error) {
+// ListVolumesMetricsIterWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesMetricsIterWithContext(ctx context.Context, p *ListVolumesMetricsParams, opts ...PageOption) iter.Seq2[*VolumesMetric, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryByName", varargs...)
-	ret0, _ := ret[0].(*VolumesUsageHistory)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVolumesMetricsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VolumesMetric, error])
+	return ret0
 }
 
-// GetVolumesUsageHistoryByName indicates an expected call of GetVolumesUsageHistoryByName.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryByName(name any, opts ...any) *gomock.Call {
+// ListVolumesMetricsIterWithContext indicates an expected call of ListVolumesMetricsIterWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetricsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryByName", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryByName), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetricsIterWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetricsIterWithContext), varargs...)
 }
 
-// GetVolumesUsageHistoryID mocks base method.
-func (m *MockVolumeServiceIface) GetVolumesUsageHistoryID(name string, opts ...OptionFunc) (string, int, error) {
+// ListVolumesMetricsWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesMetricsWithContext(ctx context.Context, p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
-	for _, a := range opts {
-		varargs = append(varargs, a)
-	}
-	ret := m.ctrl.Call(m, "GetVolumesUsageHistoryID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVolumesMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVolumesMetricsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetVolumesUsageHistoryID indicates an expected call of GetVolumesUsageHistoryID.
-func (mr *MockVolumeServiceIfaceMockRecorder) GetVolumesUsageHistoryID(name any, opts ...any) *gomock.Call {
+// ListVolumesMetricsWithContext indicates an expected call of ListVolumesMetricsWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetricsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVolumesUsageHistoryID", reflect.TypeOf((*MockVolumeServiceIface)(nil).GetVolumesUsageHistoryID), varargs...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetricsWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetricsWithContext), ctx, p)
 }
 
-// ImportVolume mocks base method.
-func (m *MockVolumeServiceIface) ImportVolume(p *ImportVolumeParams) (*ImportVolumeResponse, error) {
+// ListVolumesUsageHistory mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesUsageHistory(p *ListVolumesUsageHistoryParams) (*ListVolumesUsageHistoryResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ImportVolume", p)
-	ret0, _ := ret[0].(*ImportVolumeResponse)
+	ret := m.ctrl.Call(m, "ListVolumesUsageHistory", p)
+	ret0, _ := ret[0].(*ListVolumesUsageHistoryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ImportVolume indicates an expected call of ImportVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) ImportVolume(p any) *gomock.Call {
+// ListVolumesUsageHistory indicates an expected call of ListVolumesUsageHistory.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesUsageHistory(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ImportVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ImportVolume), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesUsageHistory", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesUsageHistory), p)
 }
 
-// ListElastistorVolume mocks base method.
-func (m *MockVolumeServiceIface) ListElastistorVolume(p *ListElastistorVolumeParams) (*ListElastistorVolumeResponse, error) {
+// ListVolumesUsageHistoryAll mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesUsageHistoryAll(p *ListVolumesUsageHistoryParams, opts ...PageOption) ([]*VolumesUsageHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListElastistorVolume", p)
-	ret0, _ := ret[0].(*ListElastistorVolumeResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesUsageHistoryAll", varargs...)
+	ret0, _ := ret[0].([]*VolumesUsageHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListElastistorVolume indicates an expected call of ListElastistorVolume.
-func (mr *MockVolumeServiceIfaceMockRecorder) ListElastistorVolume(p any) *gomock.Call {
+// ListVolumesUsageHistoryAll indicates an expected call of ListVolumesUsageHistoryAll.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesUsageHistoryAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListElastistorVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListElastistorVolume), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesUsageHistoryAll", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesUsageHistoryAll), varargs...)
 }
 
-// ListVolumes mocks base method.
-func (m *MockVolumeServiceIface) ListVolumes(p *ListVolumesParams) (*ListVolumesResponse, error) {
+// ListVolumesUsageHistoryAllWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesUsageHistoryAllWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams, opts ...PageOption) ([]*VolumesUsageHistory, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVolumes", p)
-	ret0, _ := ret[0].(*ListVolumesResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesUsageHistoryAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VolumesUsageHistory)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVolumes indicates an expected call of ListVolumes.
-func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumes(p any) *gomock.Call {
+// ListVolumesUsageHistoryAllWithContext indicates an expected call of ListVolumesUsageHistoryAllWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesUsageHistoryAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumes", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumes), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesUsageHistoryAllWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesUsageHistoryAllWithContext), varargs...)
 }
 
-// ListVolumesForImport mocks base method.
-func (m *MockVolumeServiceIface) ListVolumesForImport(p *ListVolumesForImportParams) (*ListVolumesForImportResponse, error) {
+// ListVolumesUsageHistoryIter mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesUsageHistoryIter(p *ListVolumesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VolumesUsageHistory, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVolumesForImport", p)
-	ret0, _ := ret[0].(*ListVolumesForImportResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesUsageHistoryIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VolumesUsageHistory, error])
+	return ret0
 }
 
-// ListVolumesForImport indicates an expected call of ListVolumesForImport.
-func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesForImport(p any) *gomock.Call {
+// ListVolumesUsageHistoryIter indicates an expected call of ListVolumesUsageHistoryIter.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesUsageHistoryIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesForImport", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesForImport), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesUsageHistoryIter", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesUsageHistoryIter), varargs...)
 }
 
-// ListVolumesMetrics mocks base method.
-func (m *MockVolumeServiceIface) ListVolumesMetrics(p *ListVolumesMetricsParams) (*ListVolumesMetricsResponse, error) {
+// ListVolumesUsageHistoryIterWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesUsageHistoryIterWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams, opts ...PageOption) iter.Seq2[*VolumesUsageHistory, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVolumesMetrics", p)
-	ret0, _ := ret[0].(*ListVolumesMetricsResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVolumesUsageHistoryIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VolumesUsageHistory, error])
+	return ret0
+}
+
+// ListVolumesUsageHistoryIterWithContext indicates an expected call of ListVolumesUsageHistoryIterWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesUsageHistoryIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesUsageHistoryIterWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesUsageHistoryIterWithContext), varargs...)
+}
+
+// ListVolumesUsageHistoryWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesUsageHistoryWithContext(ctx context.Context, p *ListVolumesUsageHistoryParams) (*ListVolumesUsageHistoryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVolumesUsageHistoryWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVolumesUsageHistoryResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVolumesMetrics indicates an expected call of ListVolumesMetrics.
-func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesMetrics(p any) *gomock.Call {
+// ListVolumesUsageHistoryWithContext indicates an expected call of ListVolumesUsageHistoryWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesUsageHistoryWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesMetrics", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesMetrics), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesUsageHistoryWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesUsageHistoryWithContext), ctx, p)
 }
 
-// ListVolumesUsageHistory mocks base method.
-func (m *MockVolumeServiceIface) ListVolumesUsageHistory(p *ListVolumesUsageHistoryParams) (*ListVolumesUsageHistoryResponse, error) {
+// ListVolumesWithContext mocks base method.
+func (m *MockVolumeServiceIface) ListVolumesWithContext(ctx context.Context, p *ListVolumesParams) (*ListVolumesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVolumesUsageHistory", p)
-	ret0, _ := ret[0].(*ListVolumesUsageHistoryResponse)
+	ret := m.ctrl.Call(m, "ListVolumesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVolumesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVolumesUsageHistory indicates an expected call of ListVolumesUsageHistory.
-func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesUsageHistory(p any) *gomock.Call {
+// ListVolumesWithContext indicates an expected call of ListVolumesWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ListVolumesWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesUsageHistory", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesUsageHistory), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVolumesWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ListVolumesWithContext), ctx, p)
 }
 
 // MigrateVolume mocks base method.
@@ -553,6 +1467,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) MigrateVolume(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).MigrateVolume), p)
 }
 
+// MigrateVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) MigrateVolumeAsync(ctx context.Context, p *MigrateVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVolumeAsync indicates an expected call of MigrateVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) MigrateVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).MigrateVolumeAsync), ctx, p)
+}
+
+// MigrateVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) MigrateVolumeWithContext(ctx context.Context, p *MigrateVolumeParams) (*MigrateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MigrateVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*MigrateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// MigrateVolumeWithContext indicates an expected call of MigrateVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) MigrateVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MigrateVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).MigrateVolumeWithContext), ctx, p)
+}
+
 // NewAssignVolumeParams mocks base method.
 func (m *MockVolumeServiceIface) NewAssignVolumeParams(volumeid string) *AssignVolumeParams {
 	m.ctrl.T.Helper()
@@ -918,6 +1862,21 @@ func (mr *MockVolumeServiceIfaceMockRecorder) RecoverVolume(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).RecoverVolume), p)
 }
 
+// RecoverVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) RecoverVolumeWithContext(ctx context.Context, p *RecoverVolumeParams) (*RecoverVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RecoverVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*RecoverVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RecoverVolumeWithContext indicates an expected call of RecoverVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) RecoverVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RecoverVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).RecoverVolumeWithContext), ctx, p)
+}
+
 // ResizeVolume mocks base method.
 func (m *MockVolumeServiceIface) ResizeVolume(p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -933,6 +1892,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) ResizeVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).ResizeVolume), p)
 }
 
+// ResizeVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) ResizeVolumeAsync(ctx context.Context, p *ResizeVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeVolumeAsync indicates an expected call of ResizeVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) ResizeVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).ResizeVolumeAsync), ctx, p)
+}
+
+// ResizeVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) ResizeVolumeWithContext(ctx context.Context, p *ResizeVolumeParams) (*ResizeVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ResizeVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*ResizeVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ResizeVolumeWithContext indicates an expected call of ResizeVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) ResizeVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ResizeVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).ResizeVolumeWithContext), ctx, p)
+}
+
 // RestoreVolumeFromBackupAndAttachToVM mocks base method.
 func (m *MockVolumeServiceIface) RestoreVolumeFromBackupAndAttachToVM(p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
 	m.ctrl.T.Helper()
@@ -948,6 +1937,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) RestoreVolumeFromBackupAndAttachTo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVolumeFromBackupAndAttachToVM", reflect.TypeOf((*MockVolumeServiceIface)(nil).RestoreVolumeFromBackupAndAttachToVM), p)
 }
 
+// RestoreVolumeFromBackupAndAttachToVMAsync mocks base method.
+func (m *MockVolumeServiceIface) RestoreVolumeFromBackupAndAttachToVMAsync(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVolumeFromBackupAndAttachToVMAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVolumeFromBackupAndAttachToVMAsync indicates an expected call of RestoreVolumeFromBackupAndAttachToVMAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) RestoreVolumeFromBackupAndAttachToVMAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVolumeFromBackupAndAttachToVMAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).RestoreVolumeFromBackupAndAttachToVMAsync), ctx, p)
+}
+
+// RestoreVolumeFromBackupAndAttachToVMWithContext mocks base method.
+func (m *MockVolumeServiceIface) RestoreVolumeFromBackupAndAttachToVMWithContext(ctx context.Context, p *RestoreVolumeFromBackupAndAttachToVMParams) (*RestoreVolumeFromBackupAndAttachToVMResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RestoreVolumeFromBackupAndAttachToVMWithContext", ctx, p)
+	ret0, _ := ret[0].(*RestoreVolumeFromBackupAndAttachToVMResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RestoreVolumeFromBackupAndAttachToVMWithContext indicates an expected call of RestoreVolumeFromBackupAndAttachToVMWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) RestoreVolumeFromBackupAndAttachToVMWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RestoreVolumeFromBackupAndAttachToVMWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).RestoreVolumeFromBackupAndAttachToVMWithContext), ctx, p)
+}
+
 // UnmanageVolume mocks base method.
 func (m *MockVolumeServiceIface) UnmanageVolume(p *UnmanageVolumeParams) (*UnmanageVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -963,6 +1982,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) UnmanageVolume(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).UnmanageVolume), p)
 }
 
+// UnmanageVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) UnmanageVolumeAsync(ctx context.Context, p *UnmanageVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmanageVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnmanageVolumeAsync indicates an expected call of UnmanageVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) UnmanageVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).UnmanageVolumeAsync), ctx, p)
+}
+
+// UnmanageVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) UnmanageVolumeWithContext(ctx context.Context, p *UnmanageVolumeParams) (*UnmanageVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnmanageVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*UnmanageVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnmanageVolumeWithContext indicates an expected call of UnmanageVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) UnmanageVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnmanageVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).UnmanageVolumeWithContext), ctx, p)
+}
+
 // UpdateVolume mocks base method.
 func (m *MockVolumeServiceIface) UpdateVolume(p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -978,6 +2027,36 @@ func (mr *MockVolumeServiceIfaceMockRecorder) UpdateVolume(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).UpdateVolume), p)
 }
 
+// UpdateVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) UpdateVolumeAsync(ctx context.Context, p *UpdateVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolumeAsync indicates an expected call of UpdateVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) UpdateVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).UpdateVolumeAsync), ctx, p)
+}
+
+// UpdateVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) UpdateVolumeWithContext(ctx context.Context, p *UpdateVolumeParams) (*UpdateVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVolumeWithContext indicates an expected call of UpdateVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) UpdateVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).UpdateVolumeWithContext), ctx, p)
+}
+
 // UploadVolume mocks base method.
 func (m *MockVolumeServiceIface) UploadVolume(p *UploadVolumeParams) (*UploadVolumeResponse, error) {
 	m.ctrl.T.Helper()
@@ -992,3 +2071,33 @@ func (mr *MockVolumeServiceIfaceMockRecorder) UploadVolume(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadVolume", reflect.TypeOf((*MockVolumeServiceIface)(nil).UploadVolume), p)
 }
+
+// UploadVolumeAsync mocks base method.
+func (m *MockVolumeServiceIface) UploadVolumeAsync(ctx context.Context, p *UploadVolumeParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadVolumeAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadVolumeAsync indicates an expected call of UploadVolumeAsync.
+func (mr *MockVolumeServiceIfaceMockRecorder) UploadVolumeAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadVolumeAsync", reflect.TypeOf((*MockVolumeServiceIface)(nil).UploadVolumeAsync), ctx, p)
+}
+
+// UploadVolumeWithContext mocks base method.
+func (m *MockVolumeServiceIface) UploadVolumeWithContext(ctx context.Context, p *UploadVolumeParams) (*UploadVolumeResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UploadVolumeWithContext", ctx, p)
+	ret0, _ := ret[0].(*UploadVolumeResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UploadVolumeWithContext indicates an expected call of UploadVolumeWithContext.
+func (mr *MockVolumeServiceIfaceMockRecorder) UploadVolumeWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UploadVolumeWithContext", reflect.TypeOf((*MockVolumeServiceIface)(nil).UploadVolumeWithContext), ctx, p)
+}
@@ -20,33 +20,55 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
-	"strings"
 )
 
 type WebhookServiceIface interface {
 	CreateWebhook(p *CreateWebhookParams) (*CreateWebhookResponse, error)
+	CreateWebhookWithContext(ctx context.Context, p *CreateWebhookParams) (*CreateWebhookResponse, error)
 	NewCreateWebhookParams(name string, payloadurl string) *CreateWebhookParams
 	DeleteWebhook(p *DeleteWebhookParams) (*DeleteWebhookResponse, error)
+	DeleteWebhookWithContext(ctx context.Context, p *DeleteWebhookParams) (*DeleteWebhookResponse, error)
 	NewDeleteWebhookParams(id string) *DeleteWebhookParams
 	DeleteWebhookDelivery(p *DeleteWebhookDeliveryParams) (*DeleteWebhookDeliveryResponse, error)
+	DeleteWebhookDeliveryWithContext(ctx context.Context, p *DeleteWebhookDeliveryParams) (*DeleteWebhookDeliveryResponse, error)
 	NewDeleteWebhookDeliveryParams() *DeleteWebhookDeliveryParams
 	ExecuteWebhookDelivery(p *ExecuteWebhookDeliveryParams) (*ExecuteWebhookDeliveryResponse, error)
+	ExecuteWebhookDeliveryWithContext(ctx context.Context, p *ExecuteWebhookDeliveryParams) (*ExecuteWebhookDeliveryResponse, error)
 	NewExecuteWebhookDeliveryParams() *ExecuteWebhookDeliveryParams
 	ListWebhookDeliveries(p *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesResponse, error)
+	ListWebhookDeliveriesWithContext(ctx context.Context, p *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesResponse, error)
+	ListWebhookDeliveriesAll(p *ListWebhookDeliveriesParams, opts ...PageOption) ([]*WebhookDelivery, error)
+	ListWebhookDeliveriesAllWithContext(ctx context.Context, p *ListWebhookDeliveriesParams, opts ...PageOption) ([]*WebhookDelivery, error)
+	ListWebhookDeliveriesIter(p *ListWebhookDeliveriesParams, opts ...PageOption) iter.Seq2[*WebhookDelivery, error]
+	ListWebhookDeliveriesIterWithContext(ctx context.Context, p *ListWebhookDeliveriesParams, opts ...PageOption) iter.Seq2[*WebhookDelivery, error]
 	NewListWebhookDeliveriesParams() *ListWebhookDeliveriesParams
 	GetWebhookDeliveryID(keyword string, opts ...OptionFunc) (string, int, error)
+	GetWebhookDeliveryIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error)
 	GetWebhookDeliveryByName(name string, opts ...OptionFunc) (*WebhookDelivery, int, error)
+	GetWebhookDeliveryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*WebhookDelivery, int, error)
 	GetWebhookDeliveryByID(id string, opts ...OptionFunc) (*WebhookDelivery, int, error)
+	GetWebhookDeliveryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*WebhookDelivery, int, error)
 	ListWebhooks(p *ListWebhooksParams) (*ListWebhooksResponse, error)
+	ListWebhooksWithContext(ctx context.Context, p *ListWebhooksParams) (*ListWebhooksResponse, error)
+	ListWebhooksAll(p *ListWebhooksParams, opts ...PageOption) ([]*Webhook, error)
+	ListWebhooksAllWithContext(ctx context.Context, p *ListWebhooksParams, opts ...PageOption) ([]*Webhook, error)
+	ListWebhooksIter(p *ListWebhooksParams, opts ...PageOption) iter.Seq2[*Webhook, error]
+	ListWebhooksIterWithContext(ctx context.Context, p *ListWebhooksParams, opts ...PageOption) iter.Seq2[*Webhook, error]
 	NewListWebhooksParams() *ListWebhooksParams
 	GetWebhookID(name string, opts ...OptionFunc) (string, int, error)
+	GetWebhookIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetWebhookByName(name string, opts ...OptionFunc) (*Webhook, int, error)
+	GetWebhookByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Webhook, int, error)
 	GetWebhookByID(id string, opts ...OptionFunc) (*Webhook, int, error)
+	GetWebhookByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Webhook, int, error)
 	UpdateWebhook(p *UpdateWebhookParams) (*UpdateWebhookResponse, error)
+	UpdateWebhookWithContext(ctx context.Context, p *UpdateWebhookParams) (*UpdateWebhookResponse, error)
 	NewUpdateWebhookParams(id string) *UpdateWebhookParams
 }
 
@@ -93,6 +115,53 @@ func (p *CreateWebhookParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createWebhook API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateWebhookParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createWebhook", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createWebhook API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateWebhookParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "payloadurl"},
+		lengths:  map[string]int{"account": 255, "description": 4096, "domainid": 255, "name": 255, "payloadurl": 255, "projectid": 255, "scope": 255, "secretkey": 255, "state": 255},
+	}.validate("createWebhook", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createWebhook API, before the request is signed
+func (p *CreateWebhookParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateWebhookParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateWebhookParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createWebhook", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"description":     decodeParam[string],
+		"domainid":        decodeParam[string],
+		"name":            decodeParam[string],
+		"payloadurl":      decodeParam[string],
+		"projectid":       decodeParam[string],
+		"scope":           decodeParam[string],
+		"secretkey":       decodeParam[string],
+		"sslverification": decodeParam[bool],
+		"state":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateWebhookParams) Clone() *CreateWebhookParams {
+	return &CreateWebhookParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateWebhookParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -315,13 +384,22 @@ func (s *WebhookService) NewCreateWebhookParams(name string, payloadurl string)
 
 // Creates a Webhook
 func (s *WebhookService) CreateWebhook(p *CreateWebhookParams) (*CreateWebhookResponse, error) {
-	resp, err := s.cs.newPostRequest("createWebhook", p.toURLValues())
+	return s.CreateWebhookWithContext(context.Background(), p)
+}
+
+// CreateWebhookWithContext is like CreateWebhook, but honours the cancellation and deadline of ctx
+func (s *WebhookService) CreateWebhookWithContext(ctx context.Context, p *CreateWebhookParams) (*CreateWebhookResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createWebhook", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateWebhookResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createWebhook", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -329,23 +407,29 @@ func (s *WebhookService) CreateWebhook(p *CreateWebhookParams) (*CreateWebhookRe
 }
 
 type CreateWebhookResponse struct {
-	Account         string `json:"account"`
-	Created         string `json:"created"`
-	Description     string `json:"description"`
-	Domain          string `json:"domain"`
-	Domainid        string `json:"domainid"`
-	Domainpath      string `json:"domainpath"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Payloadurl      string `json:"payloadurl"`
-	Project         string `json:"project"`
-	Projectid       string `json:"projectid"`
-	Scope           string `json:"scope"`
-	Secretkey       string `json:"secretkey"`
-	Sslverification bool   `json:"sslverification"`
-	State           string `json:"state"`
+	Account         string                     `json:"account"`
+	Created         Time                       `json:"created"`
+	Description     string                     `json:"description"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Payloadurl      string                     `json:"payloadurl"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Scope           string                     `json:"scope"`
+	Secretkey       string                     `json:"secretkey"`
+	Sslverification FlexBool                   `json:"sslverification"`
+	State           string                     `json:"state"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateWebhookResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateWebhookResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteWebhookParams struct {
@@ -363,6 +447,44 @@ func (p *DeleteWebhookParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteWebhook API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteWebhookParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteWebhook", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteWebhook API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteWebhookParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteWebhook", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteWebhook API, before the request is signed
+func (p *DeleteWebhookParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteWebhookParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteWebhookParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteWebhook", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteWebhookParams) Clone() *DeleteWebhookParams {
+	return &DeleteWebhookParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteWebhookParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -395,13 +517,22 @@ func (s *WebhookService) NewDeleteWebhookParams(id string) *DeleteWebhookParams
 
 // Deletes a Webhook
 func (s *WebhookService) DeleteWebhook(p *DeleteWebhookParams) (*DeleteWebhookResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteWebhook", p.toURLValues())
+	return s.DeleteWebhookWithContext(context.Background(), p)
+}
+
+// DeleteWebhookWithContext is like DeleteWebhook, but honours the cancellation and deadline of ctx
+func (s *WebhookService) DeleteWebhookWithContext(ctx context.Context, p *DeleteWebhookParams) (*DeleteWebhookResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteWebhook", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteWebhookResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteWebhook", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -409,10 +540,11 @@ func (s *WebhookService) DeleteWebhook(p *DeleteWebhookParams) (*DeleteWebhookRe
 }
 
 type DeleteWebhookResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteWebhookResponse) UnmarshalJSON(b []byte) error {
@@ -439,7 +571,7 @@ func (r *DeleteWebhookResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteWebhookResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteWebhookDeliveryParams struct {
@@ -469,6 +601,47 @@ func (p *DeleteWebhookDeliveryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteWebhookDelivery API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteWebhookDeliveryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteWebhookDelivery", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteWebhookDelivery API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteWebhookDeliveryParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "id": 255, "managementserverid": 255, "startdate": 255, "webhookid": 255},
+	}.validate("deleteWebhookDelivery", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteWebhookDelivery API, before the request is signed
+func (p *DeleteWebhookDeliveryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteWebhookDeliveryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteWebhookDeliveryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteWebhookDelivery", b, &p.p, map[string]paramDecoder{
+		"enddate":            decodeParam[string],
+		"id":                 decodeParam[string],
+		"managementserverid": decodeParam[UUID],
+		"startdate":          decodeParam[string],
+		"webhookid":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteWebhookDeliveryParams) Clone() *DeleteWebhookDeliveryParams {
+	return &DeleteWebhookDeliveryParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteWebhookDeliveryParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -584,13 +757,22 @@ func (s *WebhookService) NewDeleteWebhookDeliveryParams() *DeleteWebhookDelivery
 
 // Deletes Webhook delivery
 func (s *WebhookService) DeleteWebhookDelivery(p *DeleteWebhookDeliveryParams) (*DeleteWebhookDeliveryResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteWebhookDelivery", p.toURLValues())
+	return s.DeleteWebhookDeliveryWithContext(context.Background(), p)
+}
+
+// DeleteWebhookDeliveryWithContext is like DeleteWebhookDelivery, but honours the cancellation and deadline of ctx
+func (s *WebhookService) DeleteWebhookDeliveryWithContext(ctx context.Context, p *DeleteWebhookDeliveryParams) (*DeleteWebhookDeliveryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteWebhookDelivery", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteWebhookDeliveryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteWebhookDelivery", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -598,10 +780,11 @@ func (s *WebhookService) DeleteWebhookDelivery(p *DeleteWebhookDeliveryParams) (
 }
 
 type DeleteWebhookDeliveryResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteWebhookDeliveryResponse) UnmarshalJSON(b []byte) error {
@@ -628,7 +811,7 @@ func (r *DeleteWebhookDeliveryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteWebhookDeliveryResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ExecuteWebhookDeliveryParams struct {
@@ -662,6 +845,48 @@ func (p *ExecuteWebhookDeliveryParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the executeWebhookDelivery API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ExecuteWebhookDeliveryParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("executeWebhookDelivery", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the executeWebhookDelivery API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ExecuteWebhookDeliveryParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"id": 255, "payload": 255, "payloadurl": 255, "secretkey": 255, "webhookid": 255},
+	}.validate("executeWebhookDelivery", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the executeWebhookDelivery API, before the request is signed
+func (p *ExecuteWebhookDeliveryParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ExecuteWebhookDeliveryParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ExecuteWebhookDeliveryParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("executeWebhookDelivery", b, &p.p, map[string]paramDecoder{
+		"id":              decodeParam[string],
+		"payload":         decodeParam[string],
+		"payloadurl":      decodeParam[string],
+		"secretkey":       decodeParam[string],
+		"sslverification": decodeParam[bool],
+		"webhookid":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ExecuteWebhookDeliveryParams) Clone() *ExecuteWebhookDeliveryParams {
+	return &ExecuteWebhookDeliveryParams{p: cloneParams(p.p)}
+}
+
 func (p *ExecuteWebhookDeliveryParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -798,13 +1023,22 @@ func (s *WebhookService) NewExecuteWebhookDeliveryParams() *ExecuteWebhookDelive
 
 // Executes a Webhook delivery
 func (s *WebhookService) ExecuteWebhookDelivery(p *ExecuteWebhookDeliveryParams) (*ExecuteWebhookDeliveryResponse, error) {
-	resp, err := s.cs.newPostRequest("executeWebhookDelivery", p.toURLValues())
+	return s.ExecuteWebhookDeliveryWithContext(context.Background(), p)
+}
+
+// ExecuteWebhookDeliveryWithContext is like ExecuteWebhookDelivery, but honours the cancellation and deadline of ctx
+func (s *WebhookService) ExecuteWebhookDeliveryWithContext(ctx context.Context, p *ExecuteWebhookDeliveryParams) (*ExecuteWebhookDeliveryResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "executeWebhookDelivery", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ExecuteWebhookDeliveryResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("executeWebhookDelivery", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -812,21 +1046,22 @@ func (s *WebhookService) ExecuteWebhookDelivery(p *ExecuteWebhookDeliveryParams)
 }
 
 type ExecuteWebhookDeliveryResponse struct {
-	Enddate              string `json:"enddate"`
-	Eventid              string `json:"eventid"`
-	Eventtype            string `json:"eventtype"`
-	Headers              string `json:"headers"`
-	Id                   string `json:"id"`
-	JobID                string `json:"jobid"`
-	Jobstatus            int    `json:"jobstatus"`
-	Managementserverid   UUID   `json:"managementserverid"`
-	Managementservername string `json:"managementservername"`
-	Payload              string `json:"payload"`
-	Response             string `json:"response"`
-	Startdate            string `json:"startdate"`
-	Success              bool   `json:"success"`
-	Webhookid            string `json:"webhookid"`
-	Webhookname          string `json:"webhookname"`
+	Enddate              Time                       `json:"enddate"`
+	Eventid              string                     `json:"eventid"`
+	Eventtype            string                     `json:"eventtype"`
+	Headers              string                     `json:"headers"`
+	Id                   string                     `json:"id"`
+	JobID                string                     `json:"jobid"`
+	Jobstatus            FlexInt                    `json:"jobstatus"`
+	Managementserverid   UUID                       `json:"managementserverid"`
+	Managementservername string                     `json:"managementservername"`
+	Payload              string                     `json:"payload"`
+	Response             string                     `json:"response"`
+	Startdate            Time                       `json:"startdate"`
+	Success              bool                       `json:"success"`
+	Webhookid            string                     `json:"webhookid"`
+	Webhookname          string                     `json:"webhookname"`
+	Extra                map[string]json.RawMessage `json:"-"`
 }
 
 func (r *ExecuteWebhookDeliveryResponse) UnmarshalJSON(b []byte) error {
@@ -853,7 +1088,7 @@ func (r *ExecuteWebhookDeliveryResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias ExecuteWebhookDeliveryResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListWebhookDeliveriesParams struct {
@@ -897,6 +1132,51 @@ func (p *ListWebhookDeliveriesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listWebhookDeliveries API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListWebhookDeliveriesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listWebhookDeliveries", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listWebhookDeliveries API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListWebhookDeliveriesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"enddate": 255, "eventtype": 255, "id": 255, "keyword": 255, "managementserverid": 255, "startdate": 255, "webhookid": 255},
+	}.validate("listWebhookDeliveries", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listWebhookDeliveries API, before the request is signed
+func (p *ListWebhookDeliveriesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListWebhookDeliveriesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListWebhookDeliveriesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listWebhookDeliveries", b, &p.p, map[string]paramDecoder{
+		"enddate":            decodeParam[string],
+		"eventtype":          decodeParam[string],
+		"id":                 decodeParam[string],
+		"keyword":            decodeParam[string],
+		"managementserverid": decodeParam[UUID],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"startdate":          decodeParam[string],
+		"webhookid":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListWebhookDeliveriesParams) Clone() *ListWebhookDeliveriesParams {
+	return &ListWebhookDeliveriesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListWebhookDeliveriesParams) SetEnddate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1096,6 +1376,11 @@ func (s *WebhookService) NewListWebhookDeliveriesParams() *ListWebhookDeliveries
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *WebhookService) GetWebhookDeliveryID(keyword string, opts ...OptionFunc) (string, int, error) {
+	return s.GetWebhookDeliveryIDWithContext(context.Background(), keyword, opts...)
+}
+
+// GetWebhookDeliveryIDWithContext is like GetWebhookDeliveryID, but honours the cancellation and deadline of ctx
+func (s *WebhookService) GetWebhookDeliveryIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
 	p := &ListWebhookDeliveriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1107,7 +1392,7 @@ func (s *WebhookService) GetWebhookDeliveryID(keyword string, opts ...OptionFunc
 		}
 	}
 
-	l, err := s.ListWebhookDeliveries(p)
+	l, err := s.ListWebhookDeliveriesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1132,12 +1417,17 @@ func (s *WebhookService) GetWebhookDeliveryID(keyword string, opts ...OptionFunc
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *WebhookService) GetWebhookDeliveryByName(name string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
-	id, count, err := s.GetWebhookDeliveryID(name, opts...)
+	return s.GetWebhookDeliveryByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetWebhookDeliveryByNameWithContext is like GetWebhookDeliveryByName, but honours the cancellation and deadline of ctx
+func (s *WebhookService) GetWebhookDeliveryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
+	id, count, err := s.GetWebhookDeliveryIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetWebhookDeliveryByID(id, opts...)
+	r, count, err := s.GetWebhookDeliveryByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1146,6 +1436,11 @@ func (s *WebhookService) GetWebhookDeliveryByName(name string, opts ...OptionFun
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *WebhookService) GetWebhookDeliveryByID(id string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
+	return s.GetWebhookDeliveryByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetWebhookDeliveryByIDWithContext is like GetWebhookDeliveryByID, but honours the cancellation and deadline of ctx
+func (s *WebhookService) GetWebhookDeliveryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
 	p := &ListWebhookDeliveriesParams{}
 	p.p = make(map[string]interface{})
 
@@ -1157,11 +1452,10 @@ func (s *WebhookService) GetWebhookDeliveryByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListWebhookDeliveries(p)
+	l, err := s.ListWebhookDeliveriesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1179,42 +1473,85 @@ func (s *WebhookService) GetWebhookDeliveryByID(id string, opts ...OptionFunc) (
 
 // Lists Webhook deliveries
 func (s *WebhookService) ListWebhookDeliveries(p *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesResponse, error) {
-	resp, err := s.cs.newRequest("listWebhookDeliveries", p.toURLValues())
+	return s.ListWebhookDeliveriesWithContext(context.Background(), p)
+}
+
+// ListWebhookDeliveriesWithContext is like ListWebhookDeliveries, but honours the cancellation and deadline of ctx
+func (s *WebhookService) ListWebhookDeliveriesWithContext(ctx context.Context, p *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listWebhookDeliveries", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListWebhookDeliveriesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listWebhookDeliveries", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListWebhookDeliveriesAll returns all WebhookDeliveries matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *WebhookService) ListWebhookDeliveriesAll(p *ListWebhookDeliveriesParams, opts ...PageOption) ([]*WebhookDelivery, error) {
+	return s.ListWebhookDeliveriesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListWebhookDeliveriesAllWithContext is like ListWebhookDeliveriesAll, but honours the cancellation and deadline of ctx
+func (s *WebhookService) ListWebhookDeliveriesAllWithContext(ctx context.Context, p *ListWebhookDeliveriesParams, opts ...PageOption) ([]*WebhookDelivery, error) {
+	return collectPages(s.ListWebhookDeliveriesIterWithContext(ctx, p, opts...))
+}
+
+// ListWebhookDeliveriesIter returns an iterator over all WebhookDeliveries matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *WebhookService) ListWebhookDeliveriesIter(p *ListWebhookDeliveriesParams, opts ...PageOption) iter.Seq2[*WebhookDelivery, error] {
+	return s.ListWebhookDeliveriesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListWebhookDeliveriesIterWithContext is like ListWebhookDeliveriesIter, but honours the cancellation and deadline of ctx
+func (s *WebhookService) ListWebhookDeliveriesIterWithContext(ctx context.Context, p *ListWebhookDeliveriesParams, opts ...PageOption) iter.Seq2[*WebhookDelivery, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*WebhookDelivery, int, error) {
+		l, err := s.ListWebhookDeliveriesWithContext(ctx, &ListWebhookDeliveriesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.WebhookDeliveries, l.Count, nil
+	}, opts...)
+}
+
 type ListWebhookDeliveriesResponse struct {
 	Count             int                `json:"count"`
 	WebhookDeliveries []*WebhookDelivery `json:"webhookdelivery"`
 }
 
 type WebhookDelivery struct {
-	Account         string `json:"account"`
-	Created         string `json:"created"`
-	Description     string `json:"description"`
-	Domain          string `json:"domain"`
-	Domainid        string `json:"domainid"`
-	Domainpath      string `json:"domainpath"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Payloadurl      string `json:"payloadurl"`
-	Project         string `json:"project"`
-	Projectid       string `json:"projectid"`
-	Scope           string `json:"scope"`
-	Secretkey       string `json:"secretkey"`
-	Sslverification bool   `json:"sslverification"`
-	State           string `json:"state"`
+	Account         string                     `json:"account"`
+	Created         Time                       `json:"created"`
+	Description     string                     `json:"description"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Payloadurl      string                     `json:"payloadurl"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Scope           string                     `json:"scope"`
+	Secretkey       string                     `json:"secretkey"`
+	Sslverification FlexBool                   `json:"sslverification"`
+	State           string                     `json:"state"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *WebhookDelivery) UnmarshalJSON(b []byte) error {
+	type alias WebhookDelivery
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListWebhooksParams struct {
@@ -1269,6 +1606,54 @@ func (p *ListWebhooksParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listWebhooks API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListWebhooksParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listWebhooks", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listWebhooks API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListWebhooksParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "name": 255, "projectid": 255, "scope": 255, "state": 255},
+	}.validate("listWebhooks", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listWebhooks API, before the request is signed
+func (p *ListWebhooksParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListWebhooksParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListWebhooksParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listWebhooks", b, &p.p, map[string]paramDecoder{
+		"account":     decodeParam[string],
+		"domainid":    decodeParam[string],
+		"id":          decodeParam[string],
+		"isrecursive": decodeParam[bool],
+		"keyword":     decodeParam[string],
+		"listall":     decodeParam[bool],
+		"name":        decodeParam[string],
+		"page":        decodeParam[int],
+		"pagesize":    decodeParam[int],
+		"projectid":   decodeParam[string],
+		"scope":       decodeParam[string],
+		"state":       decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListWebhooksParams) Clone() *ListWebhooksParams {
+	return &ListWebhooksParams{p: cloneParams(p.p)}
+}
+
 func (p *ListWebhooksParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1531,6 +1916,11 @@ func (s *WebhookService) NewListWebhooksParams() *ListWebhooksParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *WebhookService) GetWebhookID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetWebhookIDWithContext(context.Background(), name, opts...)
+}
+
+// GetWebhookIDWithContext is like GetWebhookID, but honours the cancellation and deadline of ctx
+func (s *WebhookService) GetWebhookIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListWebhooksParams{}
 	p.p = make(map[string]interface{})
 
@@ -1542,7 +1932,7 @@ func (s *WebhookService) GetWebhookID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListWebhooks(p)
+	l, err := s.ListWebhooksWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -1567,12 +1957,17 @@ func (s *WebhookService) GetWebhookID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *WebhookService) GetWebhookByName(name string, opts ...OptionFunc) (*Webhook, int, error) {
-	id, count, err := s.GetWebhookID(name, opts...)
+	return s.GetWebhookByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetWebhookByNameWithContext is like GetWebhookByName, but honours the cancellation and deadline of ctx
+func (s *WebhookService) GetWebhookByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Webhook, int, error) {
+	id, count, err := s.GetWebhookIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetWebhookByID(id, opts...)
+	r, count, err := s.GetWebhookByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -1581,6 +1976,11 @@ func (s *WebhookService) GetWebhookByName(name string, opts ...OptionFunc) (*Web
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *WebhookService) GetWebhookByID(id string, opts ...OptionFunc) (*Webhook, int, error) {
+	return s.GetWebhookByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetWebhookByIDWithContext is like GetWebhookByID, but honours the cancellation and deadline of ctx
+func (s *WebhookService) GetWebhookByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Webhook, int, error) {
 	p := &ListWebhooksParams{}
 	p.p = make(map[string]interface{})
 
@@ -1592,11 +1992,10 @@ func (s *WebhookService) GetWebhookByID(id string, opts ...OptionFunc) (*Webhook
 		}
 	}
 
-	l, err := s.ListWebhooks(p)
+	l, err := s.ListWebhooksWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -1614,42 +2013,85 @@ func (s *WebhookService) GetWebhookByID(id string, opts ...OptionFunc) (*Webhook
 
 // Lists Webhooks
 func (s *WebhookService) ListWebhooks(p *ListWebhooksParams) (*ListWebhooksResponse, error) {
-	resp, err := s.cs.newRequest("listWebhooks", p.toURLValues())
+	return s.ListWebhooksWithContext(context.Background(), p)
+}
+
+// ListWebhooksWithContext is like ListWebhooks, but honours the cancellation and deadline of ctx
+func (s *WebhookService) ListWebhooksWithContext(ctx context.Context, p *ListWebhooksParams) (*ListWebhooksResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listWebhooks", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListWebhooksResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listWebhooks", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListWebhooksAll returns all Webhooks matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *WebhookService) ListWebhooksAll(p *ListWebhooksParams, opts ...PageOption) ([]*Webhook, error) {
+	return s.ListWebhooksAllWithContext(context.Background(), p, opts...)
+}
+
+// ListWebhooksAllWithContext is like ListWebhooksAll, but honours the cancellation and deadline of ctx
+func (s *WebhookService) ListWebhooksAllWithContext(ctx context.Context, p *ListWebhooksParams, opts ...PageOption) ([]*Webhook, error) {
+	return collectPages(s.ListWebhooksIterWithContext(ctx, p, opts...))
+}
+
+// ListWebhooksIter returns an iterator over all Webhooks matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *WebhookService) ListWebhooksIter(p *ListWebhooksParams, opts ...PageOption) iter.Seq2[*Webhook, error] {
+	return s.ListWebhooksIterWithContext(context.Background(), p, opts...)
+}
+
+// ListWebhooksIterWithContext is like ListWebhooksIter, but honours the cancellation and deadline of ctx
+func (s *WebhookService) ListWebhooksIterWithContext(ctx context.Context, p *ListWebhooksParams, opts ...PageOption) iter.Seq2[*Webhook, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Webhook, int, error) {
+		l, err := s.ListWebhooksWithContext(ctx, &ListWebhooksParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Webhooks, l.Count, nil
+	}, opts...)
+}
+
 type ListWebhooksResponse struct {
 	Count    int        `json:"count"`
 	Webhooks []*Webhook `json:"webhook"`
 }
 
 type Webhook struct {
-	Account         string `json:"account"`
-	Created         string `json:"created"`
-	Description     string `json:"description"`
-	Domain          string `json:"domain"`
-	Domainid        string `json:"domainid"`
-	Domainpath      string `json:"domainpath"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Name            string `json:"name"`
-	Payloadurl      string `json:"payloadurl"`
-	Project         string `json:"project"`
-	Projectid       string `json:"projectid"`
-	Scope           string `json:"scope"`
-	Secretkey       string `json:"secretkey"`
-	Sslverification bool   `json:"sslverification"`
-	State           string `json:"state"`
+	Account         string                     `json:"account"`
+	Created         Time                       `json:"created"`
+	Description     string                     `json:"description"`
+	Domain          string                     `json:"domain"`
+	Domainid        string                     `json:"domainid"`
+	Domainpath      string                     `json:"domainpath"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Name            string                     `json:"name"`
+	Payloadurl      string                     `json:"payloadurl"`
+	Project         string                     `json:"project"`
+	Projectid       string                     `json:"projectid"`
+	Scope           string                     `json:"scope"`
+	Secretkey       string                     `json:"secretkey"`
+	Sslverification FlexBool                   `json:"sslverification"`
+	State           string                     `json:"state"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Webhook) UnmarshalJSON(b []byte) error {
+	type alias Webhook
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateWebhookParams struct {
@@ -1689,6 +2131,51 @@ func (p *UpdateWebhookParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateWebhook API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateWebhookParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateWebhook", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateWebhook API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateWebhookParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"description": 4096, "id": 255, "name": 255, "payloadurl": 255, "scope": 255, "secretkey": 255, "state": 255},
+	}.validate("updateWebhook", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateWebhook API, before the request is signed
+func (p *UpdateWebhookParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateWebhookParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateWebhookParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateWebhook", b, &p.p, map[string]paramDecoder{
+		"description":     decodeParam[string],
+		"id":              decodeParam[string],
+		"name":            decodeParam[string],
+		"payloadurl":      decodeParam[string],
+		"scope":           decodeParam[string],
+		"secretkey":       decodeParam[string],
+		"sslverification": decodeParam[bool],
+		"state":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateWebhookParams) Clone() *UpdateWebhookParams {
+	return &UpdateWebhookParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateWebhookParams) SetDescription(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1868,13 +2355,22 @@ func (s *WebhookService) NewUpdateWebhookParams(id string) *UpdateWebhookParams
 
 // Updates a Webhook
 func (s *WebhookService) UpdateWebhook(p *UpdateWebhookParams) (*UpdateWebhookResponse, error) {
-	resp, err := s.cs.newPostRequest("updateWebhook", p.toURLValues())
+	return s.UpdateWebhookWithContext(context.Background(), p)
+}
+
+// UpdateWebhookWithContext is like UpdateWebhook, but honours the cancellation and deadline of ctx
+func (s *WebhookService) UpdateWebhookWithContext(ctx context.Context, p *UpdateWebhookParams) (*UpdateWebhookResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateWebhook", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateWebhookResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateWebhook", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1882,10 +2378,11 @@ func (s *WebhookService) UpdateWebhook(p *UpdateWebhookParams) (*UpdateWebhookRe
 }
 
 type UpdateWebhookResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *UpdateWebhookResponse) UnmarshalJSON(b []byte) error {
@@ -1912,5 +2409,5 @@ func (r *UpdateWebhookResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias UpdateWebhookResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
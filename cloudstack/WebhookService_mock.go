@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockWebhookServiceIfaceMockRecorder) CreateWebhook(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhook", reflect.TypeOf((*MockWebhookServiceIface)(nil).CreateWebhook), p)
 }
 
+// CreateWebhookWithContext mocks base method.
+func (m *MockWebhookServiceIface) CreateWebhookWithContext(ctx context.Context, p *CreateWebhookParams) (*CreateWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateWebhookWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateWebhookWithContext indicates an expected call of CreateWebhookWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) CreateWebhookWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateWebhookWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).CreateWebhookWithContext), ctx, p)
+}
+
 // DeleteWebhook mocks base method.
 func (m *MockWebhookServiceIface) DeleteWebhook(p *DeleteWebhookParams) (*DeleteWebhookResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +120,36 @@ func (mr *MockWebhookServiceIfaceMockRecorder) DeleteWebhookDelivery(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookDelivery", reflect.TypeOf((*MockWebhookServiceIface)(nil).DeleteWebhookDelivery), p)
 }
 
+// DeleteWebhookDeliveryWithContext mocks base method.
+func (m *MockWebhookServiceIface) DeleteWebhookDeliveryWithContext(ctx context.Context, p *DeleteWebhookDeliveryParams) (*DeleteWebhookDeliveryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookDeliveryWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteWebhookDeliveryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookDeliveryWithContext indicates an expected call of DeleteWebhookDeliveryWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) DeleteWebhookDeliveryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookDeliveryWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).DeleteWebhookDeliveryWithContext), ctx, p)
+}
+
+// DeleteWebhookWithContext mocks base method.
+func (m *MockWebhookServiceIface) DeleteWebhookWithContext(ctx context.Context, p *DeleteWebhookParams) (*DeleteWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteWebhookWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteWebhookWithContext indicates an expected call of DeleteWebhookWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) DeleteWebhookWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteWebhookWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).DeleteWebhookWithContext), ctx, p)
+}
+
 // ExecuteWebhookDelivery mocks base method.
 func (m *MockWebhookServiceIface) ExecuteWebhookDelivery(p *ExecuteWebhookDeliveryParams) (*ExecuteWebhookDeliveryResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +165,21 @@ func (mr *MockWebhookServiceIfaceMockRecorder) ExecuteWebhookDelivery(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteWebhookDelivery", reflect.TypeOf((*MockWebhookServiceIface)(nil).ExecuteWebhookDelivery), p)
 }
 
+// ExecuteWebhookDeliveryWithContext mocks base method.
+func (m *MockWebhookServiceIface) ExecuteWebhookDeliveryWithContext(ctx context.Context, p *ExecuteWebhookDeliveryParams) (*ExecuteWebhookDeliveryResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecuteWebhookDeliveryWithContext", ctx, p)
+	ret0, _ := ret[0].(*ExecuteWebhookDeliveryResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ExecuteWebhookDeliveryWithContext indicates an expected call of ExecuteWebhookDeliveryWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) ExecuteWebhookDeliveryWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecuteWebhookDeliveryWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).ExecuteWebhookDeliveryWithContext), ctx, p)
+}
+
 // GetWebhookByID mocks base method.
 func (m *MockWebhookServiceIface) GetWebhookByID(id string, opts ...OptionFunc) (*Webhook, int, error) {
 	m.ctrl.T.Helper()
@@ -139,6 +201,27 @@ func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookByID(id any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByID", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookByID), varargs...)
 }
 
+// GetWebhookByIDWithContext mocks base method.
+func (m *MockWebhookServiceIface) GetWebhookByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Webhook, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWebhookByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookByIDWithContext indicates an expected call of GetWebhookByIDWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByIDWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookByIDWithContext), varargs...)
+}
+
 // GetWebhookByName mocks base method.
 func (m *MockWebhookServiceIface) GetWebhookByName(name string, opts ...OptionFunc) (*Webhook, int, error) {
 	m.ctrl.T.Helper()
@@ -160,6 +243,27 @@ func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookByName(name any, opts .
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByName", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookByName), varargs...)
 }
 
+// GetWebhookByNameWithContext mocks base method.
+func (m *MockWebhookServiceIface) GetWebhookByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Webhook, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWebhookByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Webhook)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookByNameWithContext indicates an expected call of GetWebhookByNameWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookByNameWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookByNameWithContext), varargs...)
+}
+
 // GetWebhookDeliveryByID mocks base method.
 func (m *MockWebhookServiceIface) GetWebhookDeliveryByID(id string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
 	m.ctrl.T.Helper()
@@ -181,6 +285,27 @@ func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookDeliveryByID(id any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryByID", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookDeliveryByID), varargs...)
 }
 
+// GetWebhookDeliveryByIDWithContext mocks base method.
+func (m *MockWebhookServiceIface) GetWebhookDeliveryByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWebhookDeliveryByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*WebhookDelivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookDeliveryByIDWithContext indicates an expected call of GetWebhookDeliveryByIDWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookDeliveryByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryByIDWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookDeliveryByIDWithContext), varargs...)
+}
+
 // GetWebhookDeliveryByName mocks base method.
 func (m *MockWebhookServiceIface) GetWebhookDeliveryByName(name string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
 	m.ctrl.T.Helper()
@@ -202,6 +327,27 @@ func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookDeliveryByName(name any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryByName", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookDeliveryByName), varargs...)
 }
 
+// GetWebhookDeliveryByNameWithContext mocks base method.
+func (m *MockWebhookServiceIface) GetWebhookDeliveryByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*WebhookDelivery, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWebhookDeliveryByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*WebhookDelivery)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookDeliveryByNameWithContext indicates an expected call of GetWebhookDeliveryByNameWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookDeliveryByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryByNameWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookDeliveryByNameWithContext), varargs...)
+}
+
 // GetWebhookDeliveryID mocks base method.
 func (m *MockWebhookServiceIface) GetWebhookDeliveryID(keyword string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +369,27 @@ func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookDeliveryID(keyword any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryID", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookDeliveryID), varargs...)
 }
 
+// GetWebhookDeliveryIDWithContext mocks base method.
+func (m *MockWebhookServiceIface) GetWebhookDeliveryIDWithContext(ctx context.Context, keyword string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWebhookDeliveryIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookDeliveryIDWithContext indicates an expected call of GetWebhookDeliveryIDWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookDeliveryIDWithContext(ctx, keyword any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookDeliveryIDWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookDeliveryIDWithContext), varargs...)
+}
+
 // GetWebhookID mocks base method.
 func (m *MockWebhookServiceIface) GetWebhookID(name string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +411,27 @@ func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookID(name any, opts ...an
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookID", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookID), varargs...)
 }
 
+// GetWebhookIDWithContext mocks base method.
+func (m *MockWebhookServiceIface) GetWebhookIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetWebhookIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetWebhookIDWithContext indicates an expected call of GetWebhookIDWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) GetWebhookIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWebhookIDWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).GetWebhookIDWithContext), varargs...)
+}
+
 // ListWebhookDeliveries mocks base method.
 func (m *MockWebhookServiceIface) ListWebhookDeliveries(p *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesResponse, error) {
 	m.ctrl.T.Helper()
@@ -259,6 +447,99 @@ func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhookDeliveries(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveries", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhookDeliveries), p)
 }
 
+// ListWebhookDeliveriesAll mocks base method.
+func (m *MockWebhookServiceIface) ListWebhookDeliveriesAll(p *ListWebhookDeliveriesParams, opts ...PageOption) ([]*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookDeliveriesAll", varargs...)
+	ret0, _ := ret[0].([]*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveriesAll indicates an expected call of ListWebhookDeliveriesAll.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhookDeliveriesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveriesAll", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhookDeliveriesAll), varargs...)
+}
+
+// ListWebhookDeliveriesAllWithContext mocks base method.
+func (m *MockWebhookServiceIface) ListWebhookDeliveriesAllWithContext(ctx context.Context, p *ListWebhookDeliveriesParams, opts ...PageOption) ([]*WebhookDelivery, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookDeliveriesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*WebhookDelivery)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveriesAllWithContext indicates an expected call of ListWebhookDeliveriesAllWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhookDeliveriesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveriesAllWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhookDeliveriesAllWithContext), varargs...)
+}
+
+// ListWebhookDeliveriesIter mocks base method.
+func (m *MockWebhookServiceIface) ListWebhookDeliveriesIter(p *ListWebhookDeliveriesParams, opts ...PageOption) iter.Seq2[*WebhookDelivery, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookDeliveriesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*WebhookDelivery, error])
+	return ret0
+}
+
+// ListWebhookDeliveriesIter indicates an expected call of ListWebhookDeliveriesIter.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhookDeliveriesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveriesIter", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhookDeliveriesIter), varargs...)
+}
+
+// ListWebhookDeliveriesIterWithContext mocks base method.
+func (m *MockWebhookServiceIface) ListWebhookDeliveriesIterWithContext(ctx context.Context, p *ListWebhookDeliveriesParams, opts ...PageOption) iter.Seq2[*WebhookDelivery, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhookDeliveriesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*WebhookDelivery, error])
+	return ret0
+}
+
+// ListWebhookDeliveriesIterWithContext indicates an expected call of ListWebhookDeliveriesIterWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhookDeliveriesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveriesIterWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhookDeliveriesIterWithContext), varargs...)
+}
+
+// ListWebhookDeliveriesWithContext mocks base method.
+func (m *MockWebhookServiceIface) ListWebhookDeliveriesWithContext(ctx context.Context, p *ListWebhookDeliveriesParams) (*ListWebhookDeliveriesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhookDeliveriesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListWebhookDeliveriesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhookDeliveriesWithContext indicates an expected call of ListWebhookDeliveriesWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhookDeliveriesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhookDeliveriesWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhookDeliveriesWithContext), ctx, p)
+}
+
 // ListWebhooks mocks base method.
 func (m *MockWebhookServiceIface) ListWebhooks(p *ListWebhooksParams) (*ListWebhooksResponse, error) {
 	m.ctrl.T.Helper()
@@ -274,6 +555,99 @@ func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhooks(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooks", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhooks), p)
 }
 
+// ListWebhooksAll mocks base method.
+func (m *MockWebhookServiceIface) ListWebhooksAll(p *ListWebhooksParams, opts ...PageOption) ([]*Webhook, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhooksAll", varargs...)
+	ret0, _ := ret[0].([]*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooksAll indicates an expected call of ListWebhooksAll.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhooksAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooksAll", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhooksAll), varargs...)
+}
+
+// ListWebhooksAllWithContext mocks base method.
+func (m *MockWebhookServiceIface) ListWebhooksAllWithContext(ctx context.Context, p *ListWebhooksParams, opts ...PageOption) ([]*Webhook, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhooksAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Webhook)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooksAllWithContext indicates an expected call of ListWebhooksAllWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhooksAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooksAllWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhooksAllWithContext), varargs...)
+}
+
+// ListWebhooksIter mocks base method.
+func (m *MockWebhookServiceIface) ListWebhooksIter(p *ListWebhooksParams, opts ...PageOption) iter.Seq2[*Webhook, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhooksIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Webhook, error])
+	return ret0
+}
+
+// ListWebhooksIter indicates an expected call of ListWebhooksIter.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhooksIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooksIter", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhooksIter), varargs...)
+}
+
+// ListWebhooksIterWithContext mocks base method.
+func (m *MockWebhookServiceIface) ListWebhooksIterWithContext(ctx context.Context, p *ListWebhooksParams, opts ...PageOption) iter.Seq2[*Webhook, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListWebhooksIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Webhook, error])
+	return ret0
+}
+
+// ListWebhooksIterWithContext indicates an expected call of ListWebhooksIterWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhooksIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooksIterWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhooksIterWithContext), varargs...)
+}
+
+// ListWebhooksWithContext mocks base method.
+func (m *MockWebhookServiceIface) ListWebhooksWithContext(ctx context.Context, p *ListWebhooksParams) (*ListWebhooksResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListWebhooksWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListWebhooksResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListWebhooksWithContext indicates an expected call of ListWebhooksWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) ListWebhooksWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListWebhooksWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).ListWebhooksWithContext), ctx, p)
+}
+
 // NewCreateWebhookParams mocks base method.
 func (m *MockWebhookServiceIface) NewCreateWebhookParams(name, payloadurl string) *CreateWebhookParams {
 	m.ctrl.T.Helper()
@@ -386,3 +760,18 @@ func (mr *MockWebhookServiceIfaceMockRecorder) UpdateWebhook(p any) *gomock.Call
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhook", reflect.TypeOf((*MockWebhookServiceIface)(nil).UpdateWebhook), p)
 }
+
+// UpdateWebhookWithContext mocks base method.
+func (m *MockWebhookServiceIface) UpdateWebhookWithContext(ctx context.Context, p *UpdateWebhookParams) (*UpdateWebhookResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateWebhookWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateWebhookResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateWebhookWithContext indicates an expected call of UpdateWebhookWithContext.
+func (mr *MockWebhookServiceIfaceMockRecorder) UpdateWebhookWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateWebhookWithContext", reflect.TypeOf((*MockWebhookServiceIface)(nil).UpdateWebhookWithContext), ctx, p)
+}
@@ -20,8 +20,10 @@
 package cloudstack
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/url"
 	"strconv"
 	"strings"
@@ -29,58 +31,124 @@ import (
 
 type ZoneServiceIface interface {
 	CreateIpv4SubnetForZone(p *CreateIpv4SubnetForZoneParams) (*CreateIpv4SubnetForZoneResponse, error)
+	CreateIpv4SubnetForZoneWithContext(ctx context.Context, p *CreateIpv4SubnetForZoneParams) (*CreateIpv4SubnetForZoneResponse, error)
+	CreateIpv4SubnetForZoneAsync(ctx context.Context, p *CreateIpv4SubnetForZoneParams) (*Job, error)
 	NewCreateIpv4SubnetForZoneParams(subnet string, zoneid string) *CreateIpv4SubnetForZoneParams
 	CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error)
+	CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error)
 	NewCreateZoneParams(dns1 string, internaldns1 string, name string, networktype string) *CreateZoneParams
 	DedicateIpv4SubnetForZone(p *DedicateIpv4SubnetForZoneParams) (*DedicateIpv4SubnetForZoneResponse, error)
+	DedicateIpv4SubnetForZoneWithContext(ctx context.Context, p *DedicateIpv4SubnetForZoneParams) (*DedicateIpv4SubnetForZoneResponse, error)
+	DedicateIpv4SubnetForZoneAsync(ctx context.Context, p *DedicateIpv4SubnetForZoneParams) (*Job, error)
 	NewDedicateIpv4SubnetForZoneParams(id string) *DedicateIpv4SubnetForZoneParams
 	DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error)
+	DedicateZoneAsync(ctx context.Context, p *DedicateZoneParams) (*Job, error)
 	NewDedicateZoneParams(domainid string, zoneid string) *DedicateZoneParams
 	DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error)
+	DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error)
 	NewDeleteZoneParams(id string) *DeleteZoneParams
 	DeleteIpv4SubnetForZone(p *DeleteIpv4SubnetForZoneParams) (*DeleteIpv4SubnetForZoneResponse, error)
+	DeleteIpv4SubnetForZoneWithContext(ctx context.Context, p *DeleteIpv4SubnetForZoneParams) (*DeleteIpv4SubnetForZoneResponse, error)
+	DeleteIpv4SubnetForZoneAsync(ctx context.Context, p *DeleteIpv4SubnetForZoneParams) (*Job, error)
 	NewDeleteIpv4SubnetForZoneParams(id string) *DeleteIpv4SubnetForZoneParams
 	DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error)
+	DisableOutOfBandManagementForZoneAsync(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*Job, error)
 	NewDisableOutOfBandManagementForZoneParams(zoneid string) *DisableOutOfBandManagementForZoneParams
 	EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error)
+	EnableOutOfBandManagementForZoneAsync(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*Job, error)
 	NewEnableOutOfBandManagementForZoneParams(zoneid string) *EnableOutOfBandManagementForZoneParams
 	DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error)
+	DisableHAForZoneWithContext(ctx context.Context, p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error)
+	DisableHAForZoneAsync(ctx context.Context, p *DisableHAForZoneParams) (*Job, error)
 	NewDisableHAForZoneParams(zoneid string) *DisableHAForZoneParams
 	EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error)
+	EnableHAForZoneWithContext(ctx context.Context, p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error)
+	EnableHAForZoneAsync(ctx context.Context, p *EnableHAForZoneParams) (*Job, error)
 	NewEnableHAForZoneParams(zoneid string) *EnableHAForZoneParams
 	ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error)
+	ListDedicatedZonesAll(p *ListDedicatedZonesParams, opts ...PageOption) ([]*DedicatedZone, error)
+	ListDedicatedZonesAllWithContext(ctx context.Context, p *ListDedicatedZonesParams, opts ...PageOption) ([]*DedicatedZone, error)
+	ListDedicatedZonesIter(p *ListDedicatedZonesParams, opts ...PageOption) iter.Seq2[*DedicatedZone, error]
+	ListDedicatedZonesIterWithContext(ctx context.Context, p *ListDedicatedZonesParams, opts ...PageOption) iter.Seq2[*DedicatedZone, error]
 	NewListDedicatedZonesParams() *ListDedicatedZonesParams
 	ListIpv4SubnetsForZone(p *ListIpv4SubnetsForZoneParams) (*ListIpv4SubnetsForZoneResponse, error)
+	ListIpv4SubnetsForZoneWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams) (*ListIpv4SubnetsForZoneResponse, error)
+	ListIpv4SubnetsForZoneAll(p *ListIpv4SubnetsForZoneParams, opts ...PageOption) ([]*Ipv4SubnetsForZone, error)
+	ListIpv4SubnetsForZoneAllWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams, opts ...PageOption) ([]*Ipv4SubnetsForZone, error)
+	ListIpv4SubnetsForZoneIter(p *ListIpv4SubnetsForZoneParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForZone, error]
+	ListIpv4SubnetsForZoneIterWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForZone, error]
 	NewListIpv4SubnetsForZoneParams() *ListIpv4SubnetsForZoneParams
 	GetIpv4SubnetsForZoneByID(id string, opts ...OptionFunc) (*Ipv4SubnetsForZone, int, error)
+	GetIpv4SubnetsForZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv4SubnetsForZone, int, error)
 	ListZones(p *ListZonesParams) (*ListZonesResponse, error)
+	ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error)
+	ListZonesAll(p *ListZonesParams, opts ...PageOption) ([]*Zone, error)
+	ListZonesAllWithContext(ctx context.Context, p *ListZonesParams, opts ...PageOption) ([]*Zone, error)
+	ListZonesIter(p *ListZonesParams, opts ...PageOption) iter.Seq2[*Zone, error]
+	ListZonesIterWithContext(ctx context.Context, p *ListZonesParams, opts ...PageOption) iter.Seq2[*Zone, error]
 	NewListZonesParams() *ListZonesParams
 	GetZoneID(name string, opts ...OptionFunc) (string, int, error)
+	GetZoneIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Zone, int, error)
 	GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error)
+	GetZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Zone, int, error)
 	ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error)
+	ListZonesMetricsWithContext(ctx context.Context, p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error)
+	ListZonesMetricsAll(p *ListZonesMetricsParams, opts ...PageOption) ([]*ZonesMetric, error)
+	ListZonesMetricsAllWithContext(ctx context.Context, p *ListZonesMetricsParams, opts ...PageOption) ([]*ZonesMetric, error)
+	ListZonesMetricsIter(p *ListZonesMetricsParams, opts ...PageOption) iter.Seq2[*ZonesMetric, error]
+	ListZonesMetricsIterWithContext(ctx context.Context, p *ListZonesMetricsParams, opts ...PageOption) iter.Seq2[*ZonesMetric, error]
 	NewListZonesMetricsParams() *ListZonesMetricsParams
 	GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error)
+	GetZonesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error)
 	GetZonesMetricByName(name string, opts ...OptionFunc) (*ZonesMetric, int, error)
+	GetZonesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ZonesMetric, int, error)
 	GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error)
+	GetZonesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ZonesMetric, int, error)
 	ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error)
+	ReleaseDedicatedZoneAsync(ctx context.Context, p *ReleaseDedicatedZoneParams) (*Job, error)
 	NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedicatedZoneParams
 	ReleaseIpv4SubnetForZone(p *ReleaseIpv4SubnetForZoneParams) (*ReleaseIpv4SubnetForZoneResponse, error)
+	ReleaseIpv4SubnetForZoneWithContext(ctx context.Context, p *ReleaseIpv4SubnetForZoneParams) (*ReleaseIpv4SubnetForZoneResponse, error)
+	ReleaseIpv4SubnetForZoneAsync(ctx context.Context, p *ReleaseIpv4SubnetForZoneParams) (*Job, error)
 	NewReleaseIpv4SubnetForZoneParams(id string) *ReleaseIpv4SubnetForZoneParams
 	UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error)
+	UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error)
 	NewUpdateZoneParams(id string) *UpdateZoneParams
 	ListVmwareDcVms(p *ListVmwareDcVmsParams) (*ListVmwareDcVmsResponse, error)
+	ListVmwareDcVmsWithContext(ctx context.Context, p *ListVmwareDcVmsParams) (*ListVmwareDcVmsResponse, error)
+	ListVmwareDcVmsAll(p *ListVmwareDcVmsParams, opts ...PageOption) ([]*VmwareDcVm, error)
+	ListVmwareDcVmsAllWithContext(ctx context.Context, p *ListVmwareDcVmsParams, opts ...PageOption) ([]*VmwareDcVm, error)
+	ListVmwareDcVmsIter(p *ListVmwareDcVmsParams, opts ...PageOption) iter.Seq2[*VmwareDcVm, error]
+	ListVmwareDcVmsIterWithContext(ctx context.Context, p *ListVmwareDcVmsParams, opts ...PageOption) iter.Seq2[*VmwareDcVm, error]
 	NewListVmwareDcVmsParams() *ListVmwareDcVmsParams
 	AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, error)
+	AddVmwareDcWithContext(ctx context.Context, p *AddVmwareDcParams) (*AddVmwareDcResponse, error)
 	NewAddVmwareDcParams(name string, vcenter string, zoneid string) *AddVmwareDcParams
 	ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	ListVmwareDcsWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error)
+	ListVmwareDcsAll(p *ListVmwareDcsParams, opts ...PageOption) ([]*VmwareDc, error)
+	ListVmwareDcsAllWithContext(ctx context.Context, p *ListVmwareDcsParams, opts ...PageOption) ([]*VmwareDc, error)
+	ListVmwareDcsIter(p *ListVmwareDcsParams, opts ...PageOption) iter.Seq2[*VmwareDc, error]
+	ListVmwareDcsIterWithContext(ctx context.Context, p *ListVmwareDcsParams, opts ...PageOption) iter.Seq2[*VmwareDc, error]
 	NewListVmwareDcsParams(zoneid string) *ListVmwareDcsParams
 	GetVmwareDcID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
+	GetVmwareDcIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error)
 	RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error)
+	RemoveVmwareDcWithContext(ctx context.Context, p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error)
 	NewRemoveVmwareDcParams(zoneid string) *RemoveVmwareDcParams
 	UpdateIpv4SubnetForZone(p *UpdateIpv4SubnetForZoneParams) (*UpdateIpv4SubnetForZoneResponse, error)
+	UpdateIpv4SubnetForZoneWithContext(ctx context.Context, p *UpdateIpv4SubnetForZoneParams) (*UpdateIpv4SubnetForZoneResponse, error)
+	UpdateIpv4SubnetForZoneAsync(ctx context.Context, p *UpdateIpv4SubnetForZoneParams) (*Job, error)
 	NewUpdateIpv4SubnetForZoneParams(id string, subnet string) *UpdateIpv4SubnetForZoneParams
 	UpdateVmwareDc(p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error)
+	UpdateVmwareDcWithContext(ctx context.Context, p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error)
 	NewUpdateVmwareDcParams(zoneid string) *UpdateVmwareDcParams
 }
 
@@ -111,6 +179,48 @@ func (p *CreateIpv4SubnetForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createIpv4SubnetForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateIpv4SubnetForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createIpv4SubnetForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createIpv4SubnetForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateIpv4SubnetForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"subnet", "zoneid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "projectid": 255, "subnet": 255, "zoneid": 255},
+	}.validate("createIpv4SubnetForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createIpv4SubnetForZone API, before the request is signed
+func (p *CreateIpv4SubnetForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateIpv4SubnetForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateIpv4SubnetForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createIpv4SubnetForZone", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"projectid": decodeParam[string],
+		"subnet":    decodeParam[string],
+		"zoneid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateIpv4SubnetForZoneParams) Clone() *CreateIpv4SubnetForZoneParams {
+	return &CreateIpv4SubnetForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateIpv4SubnetForZoneParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -228,32 +338,39 @@ func (s *ZoneService) NewCreateIpv4SubnetForZoneParams(subnet string, zoneid str
 
 // Creates a IPv4 subnet for a zone.
 func (s *ZoneService) CreateIpv4SubnetForZone(p *CreateIpv4SubnetForZoneParams) (*CreateIpv4SubnetForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("createIpv4SubnetForZone", p.toURLValues())
+	return s.CreateIpv4SubnetForZoneWithContext(context.Background(), p)
+}
+
+// CreateIpv4SubnetForZoneWithContext is like CreateIpv4SubnetForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) CreateIpv4SubnetForZoneWithContext(ctx context.Context, p *CreateIpv4SubnetForZoneParams) (*CreateIpv4SubnetForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "createIpv4SubnetForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r CreateIpv4SubnetForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createIpv4SubnetForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "createIpv4SubnetForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("createIpv4SubnetForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -261,19 +378,44 @@ func (s *ZoneService) CreateIpv4SubnetForZone(p *CreateIpv4SubnetForZoneParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished CreateIpv4SubnetForZone async job into r
+func (r *CreateIpv4SubnetForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// CreateIpv4SubnetForZoneAsync starts the async job of CreateIpv4SubnetForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) CreateIpv4SubnetForZoneAsync(ctx context.Context, p *CreateIpv4SubnetForZoneParams) (*Job, error) {
+	r, err := s.CreateIpv4SubnetForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("createIpv4SubnetForZone", r.JobID), nil
+}
+
 type CreateIpv4SubnetForZoneResponse struct {
-	Account   string `json:"account"`
-	Created   string `json:"created"`
-	Domain    string `json:"domain"`
-	Domainid  string `json:"domainid"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Project   string `json:"project"`
-	Projectid string `json:"projectid"`
-	Subnet    string `json:"subnet"`
-	Zoneid    string `json:"zoneid"`
-	Zonename  string `json:"zonename"`
+	Account   string                     `json:"account"`
+	Created   Time                       `json:"created"`
+	Domain    string                     `json:"domain"`
+	Domainid  string                     `json:"domainid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Project   string                     `json:"project"`
+	Projectid string                     `json:"projectid"`
+	Subnet    string                     `json:"subnet"`
+	Zoneid    string                     `json:"zoneid"`
+	Zonename  string                     `json:"zonename"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateIpv4SubnetForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateIpv4SubnetForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateZoneParams struct {
@@ -340,6 +482,59 @@ func (p *CreateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the createZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *CreateZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("createZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the createZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *CreateZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"dns1", "internaldns1", "name", "networktype"},
+		lengths:  map[string]int{"allocationstate": 255, "dns1": 255, "dns2": 255, "domain": 255, "domainid": 255, "guestcidraddress": 255, "internaldns1": 255, "internaldns2": 255, "ip6dns1": 255, "ip6dns2": 255, "name": 255, "networktype": 255},
+	}.validate("createZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the createZone API, before the request is signed
+func (p *CreateZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *CreateZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *CreateZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("createZone", b, &p.p, map[string]paramDecoder{
+		"allocationstate":      decodeParam[string],
+		"dns1":                 decodeParam[string],
+		"dns2":                 decodeParam[string],
+		"domain":               decodeParam[string],
+		"domainid":             decodeParam[string],
+		"guestcidraddress":     decodeParam[string],
+		"internaldns1":         decodeParam[string],
+		"internaldns2":         decodeParam[string],
+		"ip6dns1":              decodeParam[string],
+		"ip6dns2":              decodeParam[string],
+		"isedge":               decodeParam[bool],
+		"localstorageenabled":  decodeParam[bool],
+		"name":                 decodeParam[string],
+		"networktype":          decodeParam[string],
+		"securitygroupenabled": decodeParam[bool],
+		"storageaccessgroups":  decodeParam[[]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *CreateZoneParams) Clone() *CreateZoneParams {
+	return &CreateZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *CreateZoneParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -690,7 +885,16 @@ func (s *ZoneService) NewCreateZoneParams(dns1 string, internaldns1 string, name
 
 // Creates a Zone.
 func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("createZone", p.toURLValues())
+	return s.CreateZoneWithContext(context.Background(), p)
+}
+
+// CreateZoneWithContext is like CreateZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "createZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -700,7 +904,7 @@ func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, erro
 	}
 
 	var r CreateZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("createZone", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -709,7 +913,7 @@ func (s *ZoneService) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, erro
 
 type CreateZoneResponse struct {
 	Allocationstate              string                       `json:"allocationstate"`
-	Allowuserspecifyvrmtu        bool                         `json:"allowuserspecifyvrmtu"`
+	Allowuserspecifyvrmtu        FlexBool                     `json:"allowuserspecifyvrmtu"`
 	Asnrange                     string                       `json:"asnrange"`
 	Capacity                     []CreateZoneResponseCapacity `json:"capacity"`
 	Description                  string                       `json:"description"`
@@ -720,49 +924,61 @@ type CreateZoneResponse struct {
 	Domain                       string                       `json:"domain"`
 	Domainid                     string                       `json:"domainid"`
 	Domainname                   string                       `json:"domainname"`
-	Gputotal                     int64                        `json:"gputotal"`
-	Gpuused                      int64                        `json:"gpuused"`
+	Gputotal                     FlexInt64                    `json:"gputotal"`
+	Gpuused                      FlexInt64                    `json:"gpuused"`
 	Guestcidraddress             string                       `json:"guestcidraddress"`
-	Hasannotations               bool                         `json:"hasannotations"`
+	Hasannotations               FlexBool                     `json:"hasannotations"`
 	Icon                         interface{}                  `json:"icon"`
 	Id                           string                       `json:"id"`
 	Internaldns1                 string                       `json:"internaldns1"`
 	Internaldns2                 string                       `json:"internaldns2"`
 	Ip6dns1                      string                       `json:"ip6dns1"`
 	Ip6dns2                      string                       `json:"ip6dns2"`
-	Ismultiarch                  bool                         `json:"ismultiarch"`
-	Isnsxenabled                 bool                         `json:"isnsxenabled"`
+	Ismultiarch                  FlexBool                     `json:"ismultiarch"`
+	Isnsxenabled                 FlexBool                     `json:"isnsxenabled"`
 	JobID                        string                       `json:"jobid"`
-	Jobstatus                    int                          `json:"jobstatus"`
-	Localstorageenabled          bool                         `json:"localstorageenabled"`
+	Jobstatus                    FlexInt                      `json:"jobstatus"`
+	Localstorageenabled          FlexBool                     `json:"localstorageenabled"`
 	Name                         string                       `json:"name"`
 	Networktype                  string                       `json:"networktype"`
 	Provider                     string                       `json:"provider"`
 	Resourcedetails              map[string]string            `json:"resourcedetails"`
-	Routedmodeenabled            bool                         `json:"routedmodeenabled"`
-	Routerprivateinterfacemaxmtu int                          `json:"routerprivateinterfacemaxmtu"`
-	Routerpublicinterfacemaxmtu  int                          `json:"routerpublicinterfacemaxmtu"`
-	Securitygroupsenabled        bool                         `json:"securitygroupsenabled"`
+	Routedmodeenabled            FlexBool                     `json:"routedmodeenabled"`
+	Routerprivateinterfacemaxmtu FlexInt                      `json:"routerprivateinterfacemaxmtu"`
+	Routerpublicinterfacemaxmtu  FlexInt                      `json:"routerpublicinterfacemaxmtu"`
+	Securitygroupsenabled        FlexBool                     `json:"securitygroupsenabled"`
 	Storageaccessgroups          string                       `json:"storageaccessgroups"`
 	Tags                         []Tags                       `json:"tags"`
 	Type                         string                       `json:"type"`
 	Zonetoken                    string                       `json:"zonetoken"`
+	Extra                        map[string]json.RawMessage   `json:"-"`
+}
+
+func (r *CreateZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias CreateZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type CreateZoneResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *CreateZoneResponseCapacity) UnmarshalJSON(b []byte) error {
+	type alias CreateZoneResponseCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicateIpv4SubnetForZoneParams struct {
@@ -789,6 +1005,47 @@ func (p *DedicateIpv4SubnetForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the dedicateIpv4SubnetForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicateIpv4SubnetForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicateIpv4SubnetForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicateIpv4SubnetForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicateIpv4SubnetForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "id": 255, "projectid": 255},
+	}.validate("dedicateIpv4SubnetForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicateIpv4SubnetForZone API, before the request is signed
+func (p *DedicateIpv4SubnetForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicateIpv4SubnetForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicateIpv4SubnetForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicateIpv4SubnetForZone", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"projectid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicateIpv4SubnetForZoneParams) Clone() *DedicateIpv4SubnetForZoneParams {
+	return &DedicateIpv4SubnetForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *DedicateIpv4SubnetForZoneParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -884,32 +1141,39 @@ func (s *ZoneService) NewDedicateIpv4SubnetForZoneParams(id string) *DedicateIpv
 
 // Dedicates an existing IPv4 subnet for a zone to an account or a domain.
 func (s *ZoneService) DedicateIpv4SubnetForZone(p *DedicateIpv4SubnetForZoneParams) (*DedicateIpv4SubnetForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicateIpv4SubnetForZone", p.toURLValues())
+	return s.DedicateIpv4SubnetForZoneWithContext(context.Background(), p)
+}
+
+// DedicateIpv4SubnetForZoneWithContext is like DedicateIpv4SubnetForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) DedicateIpv4SubnetForZoneWithContext(ctx context.Context, p *DedicateIpv4SubnetForZoneParams) (*DedicateIpv4SubnetForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicateIpv4SubnetForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicateIpv4SubnetForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicateIpv4SubnetForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "dedicateIpv4SubnetForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("dedicateIpv4SubnetForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -917,19 +1181,44 @@ func (s *ZoneService) DedicateIpv4SubnetForZone(p *DedicateIpv4SubnetForZonePara
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DedicateIpv4SubnetForZone async job into r
+func (r *DedicateIpv4SubnetForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DedicateIpv4SubnetForZoneAsync starts the async job of DedicateIpv4SubnetForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) DedicateIpv4SubnetForZoneAsync(ctx context.Context, p *DedicateIpv4SubnetForZoneParams) (*Job, error) {
+	r, err := s.DedicateIpv4SubnetForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("dedicateIpv4SubnetForZone", r.JobID), nil
+}
+
 type DedicateIpv4SubnetForZoneResponse struct {
-	Account   string `json:"account"`
-	Created   string `json:"created"`
-	Domain    string `json:"domain"`
-	Domainid  string `json:"domainid"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Project   string `json:"project"`
-	Projectid string `json:"projectid"`
-	Subnet    string `json:"subnet"`
-	Zoneid    string `json:"zoneid"`
-	Zonename  string `json:"zonename"`
+	Account   string                     `json:"account"`
+	Created   Time                       `json:"created"`
+	Domain    string                     `json:"domain"`
+	Domainid  string                     `json:"domainid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Project   string                     `json:"project"`
+	Projectid string                     `json:"projectid"`
+	Subnet    string                     `json:"subnet"`
+	Zoneid    string                     `json:"zoneid"`
+	Zonename  string                     `json:"zonename"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicateIpv4SubnetForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicateIpv4SubnetForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DedicateZoneParams struct {
@@ -953,6 +1242,46 @@ func (p *DedicateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the dedicateZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DedicateZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("dedicateZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the dedicateZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DedicateZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"domainid", "zoneid"},
+		lengths:  map[string]int{"account": 255, "domainid": 255, "zoneid": 255},
+	}.validate("dedicateZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the dedicateZone API, before the request is signed
+func (p *DedicateZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DedicateZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DedicateZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("dedicateZone", b, &p.p, map[string]paramDecoder{
+		"account":  decodeParam[string],
+		"domainid": decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DedicateZoneParams) Clone() *DedicateZoneParams {
+	return &DedicateZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *DedicateZoneParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1028,32 +1357,39 @@ func (s *ZoneService) NewDedicateZoneParams(domainid string, zoneid string) *Ded
 
 // Dedicates a zones.
 func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("dedicateZone", p.toURLValues())
+	return s.DedicateZoneWithContext(context.Background(), p)
+}
+
+// DedicateZoneWithContext is like DedicateZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "dedicateZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DedicateZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("dedicateZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "dedicateZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("dedicateZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1061,15 +1397,40 @@ func (s *ZoneService) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DedicateZone async job into r
+func (r *DedicateZoneResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DedicateZoneAsync starts the async job of DedicateZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) DedicateZoneAsync(ctx context.Context, p *DedicateZoneParams) (*Job, error) {
+	r, err := s.DedicateZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("dedicateZone", r.JobID), nil
+}
+
 type DedicateZoneResponse struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Domainid        string `json:"domainid"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Zoneid          string `json:"zoneid"`
-	Zonename        string `json:"zonename"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Domainid        string                     `json:"domainid"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicateZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias DedicateZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteZoneParams struct {
@@ -1087,6 +1448,44 @@ func (p *DeleteZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteZone API, before the request is signed
+func (p *DeleteZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteZone", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteZoneParams) Clone() *DeleteZoneParams {
+	return &DeleteZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1119,13 +1518,22 @@ func (s *ZoneService) NewDeleteZoneParams(id string) *DeleteZoneParams {
 
 // Deletes a Zone.
 func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteZone", p.toURLValues())
+	return s.DeleteZoneWithContext(context.Background(), p)
+}
+
+// DeleteZoneWithContext is like DeleteZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteZone", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -1133,10 +1541,11 @@ func (s *ZoneService) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, erro
 }
 
 type DeleteZoneResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *DeleteZoneResponse) UnmarshalJSON(b []byte) error {
@@ -1163,7 +1572,7 @@ func (r *DeleteZoneResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias DeleteZoneResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DeleteIpv4SubnetForZoneParams struct {
@@ -1181,6 +1590,44 @@ func (p *DeleteIpv4SubnetForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the deleteIpv4SubnetForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DeleteIpv4SubnetForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("deleteIpv4SubnetForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the deleteIpv4SubnetForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DeleteIpv4SubnetForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("deleteIpv4SubnetForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the deleteIpv4SubnetForZone API, before the request is signed
+func (p *DeleteIpv4SubnetForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DeleteIpv4SubnetForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DeleteIpv4SubnetForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("deleteIpv4SubnetForZone", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DeleteIpv4SubnetForZoneParams) Clone() *DeleteIpv4SubnetForZoneParams {
+	return &DeleteIpv4SubnetForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *DeleteIpv4SubnetForZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1213,27 +1660,39 @@ func (s *ZoneService) NewDeleteIpv4SubnetForZoneParams(id string) *DeleteIpv4Sub
 
 // Deletes an existing IPv4 subnet for a zone.
 func (s *ZoneService) DeleteIpv4SubnetForZone(p *DeleteIpv4SubnetForZoneParams) (*DeleteIpv4SubnetForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("deleteIpv4SubnetForZone", p.toURLValues())
+	return s.DeleteIpv4SubnetForZoneWithContext(context.Background(), p)
+}
+
+// DeleteIpv4SubnetForZoneWithContext is like DeleteIpv4SubnetForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) DeleteIpv4SubnetForZoneWithContext(ctx context.Context, p *DeleteIpv4SubnetForZoneParams) (*DeleteIpv4SubnetForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "deleteIpv4SubnetForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DeleteIpv4SubnetForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("deleteIpv4SubnetForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "deleteIpv4SubnetForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("deleteIpv4SubnetForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1241,11 +1700,31 @@ func (s *ZoneService) DeleteIpv4SubnetForZone(p *DeleteIpv4SubnetForZoneParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DeleteIpv4SubnetForZone async job into r
+func (r *DeleteIpv4SubnetForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DeleteIpv4SubnetForZoneAsync starts the async job of DeleteIpv4SubnetForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) DeleteIpv4SubnetForZoneAsync(ctx context.Context, p *DeleteIpv4SubnetForZoneParams) (*Job, error) {
+	r, err := s.DeleteIpv4SubnetForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("deleteIpv4SubnetForZone", r.JobID), nil
+}
+
 type DeleteIpv4SubnetForZoneResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DeleteIpv4SubnetForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias DeleteIpv4SubnetForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableOutOfBandManagementForZoneParams struct {
@@ -1263,6 +1742,44 @@ func (p *DisableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableOutOfBandManagementForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableOutOfBandManagementForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableOutOfBandManagementForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableOutOfBandManagementForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableOutOfBandManagementForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("disableOutOfBandManagementForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableOutOfBandManagementForZone API, before the request is signed
+func (p *DisableOutOfBandManagementForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableOutOfBandManagementForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableOutOfBandManagementForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableOutOfBandManagementForZone", b, &p.p, map[string]paramDecoder{
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableOutOfBandManagementForZoneParams) Clone() *DisableOutOfBandManagementForZoneParams {
+	return &DisableOutOfBandManagementForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1295,32 +1812,39 @@ func (s *ZoneService) NewDisableOutOfBandManagementForZoneParams(zoneid string)
 
 // Disables out-of-band management for a zone
 func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("disableOutOfBandManagementForZone", p.toURLValues())
+	return s.DisableOutOfBandManagementForZoneWithContext(context.Background(), p)
+}
+
+// DisableOutOfBandManagementForZoneWithContext is like DisableOutOfBandManagementForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableOutOfBandManagementForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableOutOfBandManagementForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableOutOfBandManagementForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableOutOfBandManagementForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableOutOfBandManagementForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1328,20 +1852,45 @@ func (s *ZoneService) DisableOutOfBandManagementForZone(p *DisableOutOfBandManag
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableOutOfBandManagementForZone async job into r
+func (r *DisableOutOfBandManagementForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// DisableOutOfBandManagementForZoneAsync starts the async job of DisableOutOfBandManagementForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) DisableOutOfBandManagementForZoneAsync(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*Job, error) {
+	r, err := s.DisableOutOfBandManagementForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableOutOfBandManagementForZone", r.JobID), nil
+}
+
 type DisableOutOfBandManagementForZoneResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableOutOfBandManagementForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableOutOfBandManagementForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableOutOfBandManagementForZoneParams struct {
@@ -1359,6 +1908,44 @@ func (p *EnableOutOfBandManagementForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableOutOfBandManagementForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableOutOfBandManagementForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableOutOfBandManagementForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableOutOfBandManagementForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableOutOfBandManagementForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("enableOutOfBandManagementForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableOutOfBandManagementForZone API, before the request is signed
+func (p *EnableOutOfBandManagementForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableOutOfBandManagementForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableOutOfBandManagementForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableOutOfBandManagementForZone", b, &p.p, map[string]paramDecoder{
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableOutOfBandManagementForZoneParams) Clone() *EnableOutOfBandManagementForZoneParams {
+	return &EnableOutOfBandManagementForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableOutOfBandManagementForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1391,32 +1978,39 @@ func (s *ZoneService) NewEnableOutOfBandManagementForZoneParams(zoneid string) *
 
 // Enables out-of-band management for a zone
 func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("enableOutOfBandManagementForZone", p.toURLValues())
+	return s.EnableOutOfBandManagementForZoneWithContext(context.Background(), p)
+}
+
+// EnableOutOfBandManagementForZoneWithContext is like EnableOutOfBandManagementForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableOutOfBandManagementForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableOutOfBandManagementForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableOutOfBandManagementForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableOutOfBandManagementForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableOutOfBandManagementForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1424,20 +2018,45 @@ func (s *ZoneService) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagem
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableOutOfBandManagementForZone async job into r
+func (r *EnableOutOfBandManagementForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// EnableOutOfBandManagementForZoneAsync starts the async job of EnableOutOfBandManagementForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) EnableOutOfBandManagementForZoneAsync(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*Job, error) {
+	r, err := s.EnableOutOfBandManagementForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableOutOfBandManagementForZone", r.JobID), nil
+}
+
 type EnableOutOfBandManagementForZoneResponse struct {
-	Action      string `json:"action"`
-	Address     string `json:"address"`
-	Description string `json:"description"`
-	Driver      string `json:"driver"`
-	Enabled     bool   `json:"enabled"`
-	Hostid      string `json:"hostid"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Password    string `json:"password"`
-	Port        string `json:"port"`
-	Powerstate  string `json:"powerstate"`
-	Status      bool   `json:"status"`
-	Username    string `json:"username"`
+	Action      string                     `json:"action"`
+	Address     string                     `json:"address"`
+	Description string                     `json:"description"`
+	Driver      string                     `json:"driver"`
+	Enabled     FlexBool                   `json:"enabled"`
+	Hostid      string                     `json:"hostid"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Password    string                     `json:"password"`
+	Port        string                     `json:"port"`
+	Powerstate  string                     `json:"powerstate"`
+	Status      FlexBool                   `json:"status"`
+	Username    string                     `json:"username"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableOutOfBandManagementForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableOutOfBandManagementForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type DisableHAForZoneParams struct {
@@ -1455,6 +2074,44 @@ func (p *DisableHAForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the disableHAForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *DisableHAForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("disableHAForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the disableHAForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *DisableHAForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("disableHAForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the disableHAForZone API, before the request is signed
+func (p *DisableHAForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *DisableHAForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *DisableHAForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("disableHAForZone", b, &p.p, map[string]paramDecoder{
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *DisableHAForZoneParams) Clone() *DisableHAForZoneParams {
+	return &DisableHAForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *DisableHAForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1487,27 +2144,39 @@ func (s *ZoneService) NewDisableHAForZoneParams(zoneid string) *DisableHAForZone
 
 // Disables HA for a zone
 func (s *ZoneService) DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("disableHAForZone", p.toURLValues())
+	return s.DisableHAForZoneWithContext(context.Background(), p)
+}
+
+// DisableHAForZoneWithContext is like DisableHAForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) DisableHAForZoneWithContext(ctx context.Context, p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "disableHAForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r DisableHAForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("disableHAForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "disableHAForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("disableHAForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1515,11 +2184,31 @@ func (s *ZoneService) DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAFor
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished DisableHAForZone async job into r
+func (r *DisableHAForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// DisableHAForZoneAsync starts the async job of DisableHAForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) DisableHAForZoneAsync(ctx context.Context, p *DisableHAForZoneParams) (*Job, error) {
+	r, err := s.DisableHAForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("disableHAForZone", r.JobID), nil
+}
+
 type DisableHAForZoneResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DisableHAForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias DisableHAForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type EnableHAForZoneParams struct {
@@ -1537,6 +2226,44 @@ func (p *EnableHAForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the enableHAForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *EnableHAForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("enableHAForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the enableHAForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *EnableHAForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("enableHAForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the enableHAForZone API, before the request is signed
+func (p *EnableHAForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *EnableHAForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *EnableHAForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("enableHAForZone", b, &p.p, map[string]paramDecoder{
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *EnableHAForZoneParams) Clone() *EnableHAForZoneParams {
+	return &EnableHAForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *EnableHAForZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1569,27 +2296,39 @@ func (s *ZoneService) NewEnableHAForZoneParams(zoneid string) *EnableHAForZonePa
 
 // Enables HA for a zone
 func (s *ZoneService) EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("enableHAForZone", p.toURLValues())
+	return s.EnableHAForZoneWithContext(context.Background(), p)
+}
+
+// EnableHAForZoneWithContext is like EnableHAForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) EnableHAForZoneWithContext(ctx context.Context, p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "enableHAForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r EnableHAForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("enableHAForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "enableHAForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("enableHAForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -1597,11 +2336,31 @@ func (s *ZoneService) EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZon
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished EnableHAForZone async job into r
+func (r *EnableHAForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// EnableHAForZoneAsync starts the async job of EnableHAForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) EnableHAForZoneAsync(ctx context.Context, p *EnableHAForZoneParams) (*Job, error) {
+	r, err := s.EnableHAForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("enableHAForZone", r.JobID), nil
+}
+
 type EnableHAForZoneResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *EnableHAForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias EnableHAForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListDedicatedZonesParams struct {
@@ -1639,6 +2398,49 @@ func (p *ListDedicatedZonesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listDedicatedZones API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListDedicatedZonesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listDedicatedZones", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listDedicatedZones API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListDedicatedZonesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "affinitygroupid": 255, "domainid": 255, "keyword": 255, "zoneid": 255},
+	}.validate("listDedicatedZones", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listDedicatedZones API, before the request is signed
+func (p *ListDedicatedZonesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListDedicatedZonesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListDedicatedZonesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listDedicatedZones", b, &p.p, map[string]paramDecoder{
+		"account":         decodeParam[string],
+		"affinitygroupid": decodeParam[string],
+		"domainid":        decodeParam[string],
+		"keyword":         decodeParam[string],
+		"page":            decodeParam[int],
+		"pagesize":        decodeParam[int],
+		"zoneid":          decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListDedicatedZonesParams) Clone() *ListDedicatedZonesParams {
+	return &ListDedicatedZonesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListDedicatedZonesParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -1796,33 +2598,76 @@ func (s *ZoneService) NewListDedicatedZonesParams() *ListDedicatedZonesParams {
 
 // List dedicated zones.
 func (s *ZoneService) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
-	resp, err := s.cs.newRequest("listDedicatedZones", p.toURLValues())
+	return s.ListDedicatedZonesWithContext(context.Background(), p)
+}
+
+// ListDedicatedZonesWithContext is like ListDedicatedZones, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listDedicatedZones", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListDedicatedZonesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listDedicatedZones", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListDedicatedZonesAll returns all DedicatedZones matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ZoneService) ListDedicatedZonesAll(p *ListDedicatedZonesParams, opts ...PageOption) ([]*DedicatedZone, error) {
+	return s.ListDedicatedZonesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedZonesAllWithContext is like ListDedicatedZonesAll, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListDedicatedZonesAllWithContext(ctx context.Context, p *ListDedicatedZonesParams, opts ...PageOption) ([]*DedicatedZone, error) {
+	return collectPages(s.ListDedicatedZonesIterWithContext(ctx, p, opts...))
+}
+
+// ListDedicatedZonesIter returns an iterator over all DedicatedZones matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ZoneService) ListDedicatedZonesIter(p *ListDedicatedZonesParams, opts ...PageOption) iter.Seq2[*DedicatedZone, error] {
+	return s.ListDedicatedZonesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListDedicatedZonesIterWithContext is like ListDedicatedZonesIter, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListDedicatedZonesIterWithContext(ctx context.Context, p *ListDedicatedZonesParams, opts ...PageOption) iter.Seq2[*DedicatedZone, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*DedicatedZone, int, error) {
+		l, err := s.ListDedicatedZonesWithContext(ctx, &ListDedicatedZonesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.DedicatedZones, l.Count, nil
+	}, opts...)
+}
+
 type ListDedicatedZonesResponse struct {
 	Count          int              `json:"count"`
 	DedicatedZones []*DedicatedZone `json:"dedicatedzone"`
 }
 
 type DedicatedZone struct {
-	Accountid       string `json:"accountid"`
-	Affinitygroupid string `json:"affinitygroupid"`
-	Domainid        string `json:"domainid"`
-	Id              string `json:"id"`
-	JobID           string `json:"jobid"`
-	Jobstatus       int    `json:"jobstatus"`
-	Zoneid          string `json:"zoneid"`
-	Zonename        string `json:"zonename"`
+	Accountid       string                     `json:"accountid"`
+	Affinitygroupid string                     `json:"affinitygroupid"`
+	Domainid        string                     `json:"domainid"`
+	Id              string                     `json:"id"`
+	JobID           string                     `json:"jobid"`
+	Jobstatus       FlexInt                    `json:"jobstatus"`
+	Zoneid          string                     `json:"zoneid"`
+	Zonename        string                     `json:"zonename"`
+	Extra           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *DedicatedZone) UnmarshalJSON(b []byte) error {
+	type alias DedicatedZone
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListIpv4SubnetsForZoneParams struct {
@@ -1866,6 +2711,51 @@ func (p *ListIpv4SubnetsForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listIpv4SubnetsForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListIpv4SubnetsForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listIpv4SubnetsForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listIpv4SubnetsForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListIpv4SubnetsForZoneParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"account": 255, "domainid": 255, "id": 255, "keyword": 255, "projectid": 255, "subnet": 255, "zoneid": 255},
+	}.validate("listIpv4SubnetsForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listIpv4SubnetsForZone API, before the request is signed
+func (p *ListIpv4SubnetsForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListIpv4SubnetsForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListIpv4SubnetsForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listIpv4SubnetsForZone", b, &p.p, map[string]paramDecoder{
+		"account":   decodeParam[string],
+		"domainid":  decodeParam[string],
+		"id":        decodeParam[string],
+		"keyword":   decodeParam[string],
+		"page":      decodeParam[int],
+		"pagesize":  decodeParam[int],
+		"projectid": decodeParam[string],
+		"subnet":    decodeParam[string],
+		"zoneid":    decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListIpv4SubnetsForZoneParams) Clone() *ListIpv4SubnetsForZoneParams {
+	return &ListIpv4SubnetsForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *ListIpv4SubnetsForZoneParams) SetAccount(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2065,6 +2955,11 @@ func (s *ZoneService) NewListIpv4SubnetsForZoneParams() *ListIpv4SubnetsForZoneP
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetIpv4SubnetsForZoneByID(id string, opts ...OptionFunc) (*Ipv4SubnetsForZone, int, error) {
+	return s.GetIpv4SubnetsForZoneByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetIpv4SubnetsForZoneByIDWithContext is like GetIpv4SubnetsForZoneByID, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetIpv4SubnetsForZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv4SubnetsForZone, int, error) {
 	p := &ListIpv4SubnetsForZoneParams{}
 	p.p = make(map[string]interface{})
 
@@ -2076,11 +2971,10 @@ func (s *ZoneService) GetIpv4SubnetsForZoneByID(id string, opts ...OptionFunc) (
 		}
 	}
 
-	l, err := s.ListIpv4SubnetsForZone(p)
+	l, err := s.ListIpv4SubnetsForZoneWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2098,37 +2992,80 @@ func (s *ZoneService) GetIpv4SubnetsForZoneByID(id string, opts ...OptionFunc) (
 
 // Lists IPv4 subnets for zone.
 func (s *ZoneService) ListIpv4SubnetsForZone(p *ListIpv4SubnetsForZoneParams) (*ListIpv4SubnetsForZoneResponse, error) {
-	resp, err := s.cs.newRequest("listIpv4SubnetsForZone", p.toURLValues())
+	return s.ListIpv4SubnetsForZoneWithContext(context.Background(), p)
+}
+
+// ListIpv4SubnetsForZoneWithContext is like ListIpv4SubnetsForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListIpv4SubnetsForZoneWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams) (*ListIpv4SubnetsForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listIpv4SubnetsForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListIpv4SubnetsForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listIpv4SubnetsForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListIpv4SubnetsForZoneAll returns all Ipv4SubnetsForZone matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ZoneService) ListIpv4SubnetsForZoneAll(p *ListIpv4SubnetsForZoneParams, opts ...PageOption) ([]*Ipv4SubnetsForZone, error) {
+	return s.ListIpv4SubnetsForZoneAllWithContext(context.Background(), p, opts...)
+}
+
+// ListIpv4SubnetsForZoneAllWithContext is like ListIpv4SubnetsForZoneAll, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListIpv4SubnetsForZoneAllWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams, opts ...PageOption) ([]*Ipv4SubnetsForZone, error) {
+	return collectPages(s.ListIpv4SubnetsForZoneIterWithContext(ctx, p, opts...))
+}
+
+// ListIpv4SubnetsForZoneIter returns an iterator over all Ipv4SubnetsForZone matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ZoneService) ListIpv4SubnetsForZoneIter(p *ListIpv4SubnetsForZoneParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForZone, error] {
+	return s.ListIpv4SubnetsForZoneIterWithContext(context.Background(), p, opts...)
+}
+
+// ListIpv4SubnetsForZoneIterWithContext is like ListIpv4SubnetsForZoneIter, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListIpv4SubnetsForZoneIterWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForZone, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Ipv4SubnetsForZone, int, error) {
+		l, err := s.ListIpv4SubnetsForZoneWithContext(ctx, &ListIpv4SubnetsForZoneParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Ipv4SubnetsForZone, l.Count, nil
+	}, opts...)
+}
+
 type ListIpv4SubnetsForZoneResponse struct {
 	Count              int                   `json:"count"`
 	Ipv4SubnetsForZone []*Ipv4SubnetsForZone `json:"zoneipv4subnet"`
 }
 
 type Ipv4SubnetsForZone struct {
-	Account   string `json:"account"`
-	Created   string `json:"created"`
-	Domain    string `json:"domain"`
-	Domainid  string `json:"domainid"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Project   string `json:"project"`
-	Projectid string `json:"projectid"`
-	Subnet    string `json:"subnet"`
-	Zoneid    string `json:"zoneid"`
-	Zonename  string `json:"zonename"`
+	Account   string                     `json:"account"`
+	Created   Time                       `json:"created"`
+	Domain    string                     `json:"domain"`
+	Domainid  string                     `json:"domainid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Project   string                     `json:"project"`
+	Projectid string                     `json:"projectid"`
+	Subnet    string                     `json:"subnet"`
+	Zoneid    string                     `json:"zoneid"`
+	Zonename  string                     `json:"zonename"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Ipv4SubnetsForZone) UnmarshalJSON(b []byte) error {
+	type alias Ipv4SubnetsForZone
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListZonesParams struct {
@@ -2192,6 +3129,55 @@ func (p *ListZonesParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listZones API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListZonesParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listZones", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listZones API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListZonesParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"domainid": 255, "id": 255, "keyword": 255, "name": 255, "networktype": 255, "storageaccessgroup": 255},
+	}.validate("listZones", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listZones API, before the request is signed
+func (p *ListZonesParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListZonesParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListZonesParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listZones", b, &p.p, map[string]paramDecoder{
+		"available":          decodeParam[bool],
+		"domainid":           decodeParam[string],
+		"id":                 decodeParam[string],
+		"ids":                decodeParam[[]string],
+		"keyword":            decodeParam[string],
+		"name":               decodeParam[string],
+		"networktype":        decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"showcapacities":     decodeParam[bool],
+		"showicon":           decodeParam[bool],
+		"storageaccessgroup": decodeParam[string],
+		"tags":               decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListZonesParams) Clone() *ListZonesParams {
+	return &ListZonesParams{p: cloneParams(p.p)}
+}
+
 func (p *ListZonesParams) SetAvailable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2475,6 +3461,11 @@ func (s *ZoneService) NewListZonesParams() *ListZonesParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetZoneIDWithContext(context.Background(), name, opts...)
+}
+
+// GetZoneIDWithContext is like GetZoneID, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetZoneIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListZonesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2486,7 +3477,7 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 		}
 	}
 
-	l, err := s.ListZones(p)
+	l, err := s.ListZonesWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -2511,12 +3502,17 @@ func (s *ZoneService) GetZoneID(name string, opts ...OptionFunc) (string, int, e
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
-	id, count, err := s.GetZoneID(name, opts...)
+	return s.GetZoneByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetZoneByNameWithContext is like GetZoneByName, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetZoneByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Zone, int, error) {
+	id, count, err := s.GetZoneIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetZoneByID(id, opts...)
+	r, count, err := s.GetZoneByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -2525,6 +3521,11 @@ func (s *ZoneService) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
+	return s.GetZoneByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetZoneByIDWithContext is like GetZoneByID, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Zone, int, error) {
 	p := &ListZonesParams{}
 	p.p = make(map[string]interface{})
 
@@ -2536,11 +3537,10 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 		}
 	}
 
-	l, err := s.ListZones(p)
+	l, err := s.ListZonesWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -2558,80 +3558,129 @@ func (s *ZoneService) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, er
 
 // Lists zones
 func (s *ZoneService) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
-	resp, err := s.cs.newRequest("listZones", p.toURLValues())
+	return s.ListZonesWithContext(context.Background(), p)
+}
+
+// ListZonesWithContext is like ListZones, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listZones", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListZonesResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listZones", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListZonesAll returns all Zones matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ZoneService) ListZonesAll(p *ListZonesParams, opts ...PageOption) ([]*Zone, error) {
+	return s.ListZonesAllWithContext(context.Background(), p, opts...)
+}
+
+// ListZonesAllWithContext is like ListZonesAll, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListZonesAllWithContext(ctx context.Context, p *ListZonesParams, opts ...PageOption) ([]*Zone, error) {
+	return collectPages(s.ListZonesIterWithContext(ctx, p, opts...))
+}
+
+// ListZonesIter returns an iterator over all Zones matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ZoneService) ListZonesIter(p *ListZonesParams, opts ...PageOption) iter.Seq2[*Zone, error] {
+	return s.ListZonesIterWithContext(context.Background(), p, opts...)
+}
+
+// ListZonesIterWithContext is like ListZonesIter, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListZonesIterWithContext(ctx context.Context, p *ListZonesParams, opts ...PageOption) iter.Seq2[*Zone, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*Zone, int, error) {
+		l, err := s.ListZonesWithContext(ctx, &ListZonesParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.Zones, l.Count, nil
+	}, opts...)
+}
+
 type ListZonesResponse struct {
 	Count int     `json:"count"`
 	Zones []*Zone `json:"zone"`
 }
 
 type Zone struct {
-	Allocationstate              string            `json:"allocationstate"`
-	Allowuserspecifyvrmtu        bool              `json:"allowuserspecifyvrmtu"`
-	Asnrange                     string            `json:"asnrange"`
-	Capacity                     []ZoneCapacity    `json:"capacity"`
-	Description                  string            `json:"description"`
-	Dhcpprovider                 string            `json:"dhcpprovider"`
-	Displaytext                  string            `json:"displaytext"`
-	Dns1                         string            `json:"dns1"`
-	Dns2                         string            `json:"dns2"`
-	Domain                       string            `json:"domain"`
-	Domainid                     string            `json:"domainid"`
-	Domainname                   string            `json:"domainname"`
-	Gputotal                     int64             `json:"gputotal"`
-	Gpuused                      int64             `json:"gpuused"`
-	Guestcidraddress             string            `json:"guestcidraddress"`
-	Hasannotations               bool              `json:"hasannotations"`
-	Icon                         interface{}       `json:"icon"`
-	Id                           string            `json:"id"`
-	Internaldns1                 string            `json:"internaldns1"`
-	Internaldns2                 string            `json:"internaldns2"`
-	Ip6dns1                      string            `json:"ip6dns1"`
-	Ip6dns2                      string            `json:"ip6dns2"`
-	Ismultiarch                  bool              `json:"ismultiarch"`
-	Isnsxenabled                 bool              `json:"isnsxenabled"`
-	JobID                        string            `json:"jobid"`
-	Jobstatus                    int               `json:"jobstatus"`
-	Localstorageenabled          bool              `json:"localstorageenabled"`
-	Name                         string            `json:"name"`
-	Networktype                  string            `json:"networktype"`
-	Provider                     string            `json:"provider"`
-	Resourcedetails              map[string]string `json:"resourcedetails"`
-	Routedmodeenabled            bool              `json:"routedmodeenabled"`
-	Routerprivateinterfacemaxmtu int               `json:"routerprivateinterfacemaxmtu"`
-	Routerpublicinterfacemaxmtu  int               `json:"routerpublicinterfacemaxmtu"`
-	Securitygroupsenabled        bool              `json:"securitygroupsenabled"`
-	Storageaccessgroups          string            `json:"storageaccessgroups"`
-	Tags                         []Tags            `json:"tags"`
-	Type                         string            `json:"type"`
-	Zonetoken                    string            `json:"zonetoken"`
+	Allocationstate              string                     `json:"allocationstate"`
+	Allowuserspecifyvrmtu        FlexBool                   `json:"allowuserspecifyvrmtu"`
+	Asnrange                     string                     `json:"asnrange"`
+	Capacity                     []ZoneCapacity             `json:"capacity"`
+	Description                  string                     `json:"description"`
+	Dhcpprovider                 string                     `json:"dhcpprovider"`
+	Displaytext                  string                     `json:"displaytext"`
+	Dns1                         string                     `json:"dns1"`
+	Dns2                         string                     `json:"dns2"`
+	Domain                       string                     `json:"domain"`
+	Domainid                     string                     `json:"domainid"`
+	Domainname                   string                     `json:"domainname"`
+	Gputotal                     FlexInt64                  `json:"gputotal"`
+	Gpuused                      FlexInt64                  `json:"gpuused"`
+	Guestcidraddress             string                     `json:"guestcidraddress"`
+	Hasannotations               FlexBool                   `json:"hasannotations"`
+	Icon                         interface{}                `json:"icon"`
+	Id                           string                     `json:"id"`
+	Internaldns1                 string                     `json:"internaldns1"`
+	Internaldns2                 string                     `json:"internaldns2"`
+	Ip6dns1                      string                     `json:"ip6dns1"`
+	Ip6dns2                      string                     `json:"ip6dns2"`
+	Ismultiarch                  FlexBool                   `json:"ismultiarch"`
+	Isnsxenabled                 FlexBool                   `json:"isnsxenabled"`
+	JobID                        string                     `json:"jobid"`
+	Jobstatus                    FlexInt                    `json:"jobstatus"`
+	Localstorageenabled          FlexBool                   `json:"localstorageenabled"`
+	Name                         string                     `json:"name"`
+	Networktype                  string                     `json:"networktype"`
+	Provider                     string                     `json:"provider"`
+	Resourcedetails              map[string]string          `json:"resourcedetails"`
+	Routedmodeenabled            FlexBool                   `json:"routedmodeenabled"`
+	Routerprivateinterfacemaxmtu FlexInt                    `json:"routerprivateinterfacemaxmtu"`
+	Routerpublicinterfacemaxmtu  FlexInt                    `json:"routerpublicinterfacemaxmtu"`
+	Securitygroupsenabled        FlexBool                   `json:"securitygroupsenabled"`
+	Storageaccessgroups          string                     `json:"storageaccessgroups"`
+	Tags                         []Tags                     `json:"tags"`
+	Type                         string                     `json:"type"`
+	Zonetoken                    string                     `json:"zonetoken"`
+	Extra                        map[string]json.RawMessage `json:"-"`
+}
+
+func (r *Zone) UnmarshalJSON(b []byte) error {
+	type alias Zone
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ZoneCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ZoneCapacity) UnmarshalJSON(b []byte) error {
+	type alias ZoneCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListZonesMetricsParams struct {
@@ -2695,6 +3744,55 @@ func (p *ListZonesMetricsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listZonesMetrics API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListZonesMetricsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listZonesMetrics", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listZonesMetrics API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListZonesMetricsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"domainid": 255, "id": 255, "keyword": 255, "name": 255, "networktype": 255, "storageaccessgroup": 255},
+	}.validate("listZonesMetrics", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listZonesMetrics API, before the request is signed
+func (p *ListZonesMetricsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListZonesMetricsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListZonesMetricsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listZonesMetrics", b, &p.p, map[string]paramDecoder{
+		"available":          decodeParam[bool],
+		"domainid":           decodeParam[string],
+		"id":                 decodeParam[string],
+		"ids":                decodeParam[[]string],
+		"keyword":            decodeParam[string],
+		"name":               decodeParam[string],
+		"networktype":        decodeParam[string],
+		"page":               decodeParam[int],
+		"pagesize":           decodeParam[int],
+		"showcapacities":     decodeParam[bool],
+		"showicon":           decodeParam[bool],
+		"storageaccessgroup": decodeParam[string],
+		"tags":               decodeParam[map[string]string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListZonesMetricsParams) Clone() *ListZonesMetricsParams {
+	return &ListZonesMetricsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListZonesMetricsParams) SetAvailable(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -2978,6 +4076,11 @@ func (s *ZoneService) NewListZonesMetricsParams() *ListZonesMetricsParams {
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	return s.GetZonesMetricIDWithContext(context.Background(), name, opts...)
+}
+
+// GetZonesMetricIDWithContext is like GetZonesMetricID, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetZonesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
 	p := &ListZonesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -2989,7 +4092,7 @@ func (s *ZoneService) GetZonesMetricID(name string, opts ...OptionFunc) (string,
 		}
 	}
 
-	l, err := s.ListZonesMetrics(p)
+	l, err := s.ListZonesMetricsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -3014,12 +4117,17 @@ func (s *ZoneService) GetZonesMetricID(name string, opts ...OptionFunc) (string,
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZonesMetricByName(name string, opts ...OptionFunc) (*ZonesMetric, int, error) {
-	id, count, err := s.GetZonesMetricID(name, opts...)
+	return s.GetZonesMetricByNameWithContext(context.Background(), name, opts...)
+}
+
+// GetZonesMetricByNameWithContext is like GetZonesMetricByName, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetZonesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	id, count, err := s.GetZonesMetricIDWithContext(ctx, name, opts...)
 	if err != nil {
 		return nil, count, err
 	}
 
-	r, count, err := s.GetZonesMetricByID(id, opts...)
+	r, count, err := s.GetZonesMetricByIDWithContext(ctx, id, opts...)
 	if err != nil {
 		return nil, count, err
 	}
@@ -3028,6 +4136,11 @@ func (s *ZoneService) GetZonesMetricByName(name string, opts ...OptionFunc) (*Zo
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	return s.GetZonesMetricByIDWithContext(context.Background(), id, opts...)
+}
+
+// GetZonesMetricByIDWithContext is like GetZonesMetricByID, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetZonesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
 	p := &ListZonesMetricsParams{}
 	p.p = make(map[string]interface{})
 
@@ -3039,11 +4152,10 @@ func (s *ZoneService) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesM
 		}
 	}
 
-	l, err := s.ListZonesMetrics(p)
+	l, err := s.ListZonesMetricsWithContext(ctx, p)
 	if err != nil {
-		if strings.Contains(err.Error(), fmt.Sprintf(
-			"Invalid parameter id value=%s due to incorrect long value format, "+
-				"or entity does not exist", id)) {
+		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it
+		if isInvalidID(err) || IsNotFound(err) {
 			return nil, 0, fmt.Errorf("No match found for %s: %+v", id, l)
 		}
 		return nil, -1, err
@@ -3061,98 +4173,147 @@ func (s *ZoneService) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesM
 
 // Lists zone metrics
 func (s *ZoneService) ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
-	resp, err := s.cs.newRequest("listZonesMetrics", p.toURLValues())
+	return s.ListZonesMetricsWithContext(context.Background(), p)
+}
+
+// ListZonesMetricsWithContext is like ListZonesMetrics, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListZonesMetricsWithContext(ctx context.Context, p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listZonesMetrics", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListZonesMetricsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listZonesMetrics", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListZonesMetricsAll returns all ZonesMetrics matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ZoneService) ListZonesMetricsAll(p *ListZonesMetricsParams, opts ...PageOption) ([]*ZonesMetric, error) {
+	return s.ListZonesMetricsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListZonesMetricsAllWithContext is like ListZonesMetricsAll, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListZonesMetricsAllWithContext(ctx context.Context, p *ListZonesMetricsParams, opts ...PageOption) ([]*ZonesMetric, error) {
+	return collectPages(s.ListZonesMetricsIterWithContext(ctx, p, opts...))
+}
+
+// ListZonesMetricsIter returns an iterator over all ZonesMetrics matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ZoneService) ListZonesMetricsIter(p *ListZonesMetricsParams, opts ...PageOption) iter.Seq2[*ZonesMetric, error] {
+	return s.ListZonesMetricsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListZonesMetricsIterWithContext is like ListZonesMetricsIter, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListZonesMetricsIterWithContext(ctx context.Context, p *ListZonesMetricsParams, opts ...PageOption) iter.Seq2[*ZonesMetric, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*ZonesMetric, int, error) {
+		l, err := s.ListZonesMetricsWithContext(ctx, &ListZonesMetricsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.ZonesMetrics, l.Count, nil
+	}, opts...)
+}
+
 type ListZonesMetricsResponse struct {
 	Count        int            `json:"count"`
 	ZonesMetrics []*ZonesMetric `json:"zone"`
 }
 
 type ZonesMetric struct {
-	Allocationstate                 string                `json:"allocationstate"`
-	Allowuserspecifyvrmtu           bool                  `json:"allowuserspecifyvrmtu"`
-	Asnrange                        string                `json:"asnrange"`
-	Capacity                        []ZonesMetricCapacity `json:"capacity"`
-	Clusters                        string                `json:"clusters"`
-	Cpuallocated                    string                `json:"cpuallocated"`
-	Cpuallocateddisablethreshold    bool                  `json:"cpuallocateddisablethreshold"`
-	Cpuallocatedthreshold           bool                  `json:"cpuallocatedthreshold"`
-	Cpudisablethreshold             bool                  `json:"cpudisablethreshold"`
-	Cpumaxdeviation                 string                `json:"cpumaxdeviation"`
-	Cputhreshold                    bool                  `json:"cputhreshold"`
-	Cputotal                        string                `json:"cputotal"`
-	Cpuused                         string                `json:"cpuused"`
-	Description                     string                `json:"description"`
-	Dhcpprovider                    string                `json:"dhcpprovider"`
-	Displaytext                     string                `json:"displaytext"`
-	Dns1                            string                `json:"dns1"`
-	Dns2                            string                `json:"dns2"`
-	Domain                          string                `json:"domain"`
-	Domainid                        string                `json:"domainid"`
-	Domainname                      string                `json:"domainname"`
-	Gputotal                        int64                 `json:"gputotal"`
-	Gpuused                         int64                 `json:"gpuused"`
-	Guestcidraddress                string                `json:"guestcidraddress"`
-	Hasannotations                  bool                  `json:"hasannotations"`
-	Icon                            interface{}           `json:"icon"`
-	Id                              string                `json:"id"`
-	Internaldns1                    string                `json:"internaldns1"`
-	Internaldns2                    string                `json:"internaldns2"`
-	Ip6dns1                         string                `json:"ip6dns1"`
-	Ip6dns2                         string                `json:"ip6dns2"`
-	Ismultiarch                     bool                  `json:"ismultiarch"`
-	Isnsxenabled                    bool                  `json:"isnsxenabled"`
-	JobID                           string                `json:"jobid"`
-	Jobstatus                       int                   `json:"jobstatus"`
-	Localstorageenabled             bool                  `json:"localstorageenabled"`
-	Memoryallocated                 string                `json:"memoryallocated"`
-	Memoryallocateddisablethreshold bool                  `json:"memoryallocateddisablethreshold"`
-	Memoryallocatedthreshold        bool                  `json:"memoryallocatedthreshold"`
-	Memorydisablethreshold          bool                  `json:"memorydisablethreshold"`
-	Memorymaxdeviation              string                `json:"memorymaxdeviation"`
-	Memorythreshold                 bool                  `json:"memorythreshold"`
-	Memorytotal                     string                `json:"memorytotal"`
-	Memoryused                      string                `json:"memoryused"`
-	Name                            string                `json:"name"`
-	Networktype                     string                `json:"networktype"`
-	Provider                        string                `json:"provider"`
-	Resourcedetails                 map[string]string     `json:"resourcedetails"`
-	Routedmodeenabled               bool                  `json:"routedmodeenabled"`
-	Routerprivateinterfacemaxmtu    int                   `json:"routerprivateinterfacemaxmtu"`
-	Routerpublicinterfacemaxmtu     int                   `json:"routerpublicinterfacemaxmtu"`
-	Securitygroupsenabled           bool                  `json:"securitygroupsenabled"`
-	State                           string                `json:"state"`
-	Storageaccessgroups             string                `json:"storageaccessgroups"`
-	Tags                            []Tags                `json:"tags"`
-	Type                            string                `json:"type"`
-	Zonetoken                       string                `json:"zonetoken"`
+	Allocationstate                 string                     `json:"allocationstate"`
+	Allowuserspecifyvrmtu           FlexBool                   `json:"allowuserspecifyvrmtu"`
+	Asnrange                        string                     `json:"asnrange"`
+	Capacity                        []ZonesMetricCapacity      `json:"capacity"`
+	Clusters                        string                     `json:"clusters"`
+	Cpuallocated                    string                     `json:"cpuallocated"`
+	Cpuallocateddisablethreshold    FlexBool                   `json:"cpuallocateddisablethreshold"`
+	Cpuallocatedthreshold           FlexBool                   `json:"cpuallocatedthreshold"`
+	Cpudisablethreshold             FlexBool                   `json:"cpudisablethreshold"`
+	Cpumaxdeviation                 string                     `json:"cpumaxdeviation"`
+	Cputhreshold                    FlexBool                   `json:"cputhreshold"`
+	Cputotal                        string                     `json:"cputotal"`
+	Cpuused                         string                     `json:"cpuused"`
+	Description                     string                     `json:"description"`
+	Dhcpprovider                    string                     `json:"dhcpprovider"`
+	Displaytext                     string                     `json:"displaytext"`
+	Dns1                            string                     `json:"dns1"`
+	Dns2                            string                     `json:"dns2"`
+	Domain                          string                     `json:"domain"`
+	Domainid                        string                     `json:"domainid"`
+	Domainname                      string                     `json:"domainname"`
+	Gputotal                        FlexInt64                  `json:"gputotal"`
+	Gpuused                         FlexInt64                  `json:"gpuused"`
+	Guestcidraddress                string                     `json:"guestcidraddress"`
+	Hasannotations                  FlexBool                   `json:"hasannotations"`
+	Icon                            interface{}                `json:"icon"`
+	Id                              string                     `json:"id"`
+	Internaldns1                    string                     `json:"internaldns1"`
+	Internaldns2                    string                     `json:"internaldns2"`
+	Ip6dns1                         string                     `json:"ip6dns1"`
+	Ip6dns2                         string                     `json:"ip6dns2"`
+	Ismultiarch                     FlexBool                   `json:"ismultiarch"`
+	Isnsxenabled                    FlexBool                   `json:"isnsxenabled"`
+	JobID                           string                     `json:"jobid"`
+	Jobstatus                       FlexInt                    `json:"jobstatus"`
+	Localstorageenabled             FlexBool                   `json:"localstorageenabled"`
+	Memoryallocated                 string                     `json:"memoryallocated"`
+	Memoryallocateddisablethreshold FlexBool                   `json:"memoryallocateddisablethreshold"`
+	Memoryallocatedthreshold        FlexBool                   `json:"memoryallocatedthreshold"`
+	Memorydisablethreshold          FlexBool                   `json:"memorydisablethreshold"`
+	Memorymaxdeviation              string                     `json:"memorymaxdeviation"`
+	Memorythreshold                 FlexBool                   `json:"memorythreshold"`
+	Memorytotal                     string                     `json:"memorytotal"`
+	Memoryused                      string                     `json:"memoryused"`
+	Name                            string                     `json:"name"`
+	Networktype                     string                     `json:"networktype"`
+	Provider                        string                     `json:"provider"`
+	Resourcedetails                 map[string]string          `json:"resourcedetails"`
+	Routedmodeenabled               FlexBool                   `json:"routedmodeenabled"`
+	Routerprivateinterfacemaxmtu    FlexInt                    `json:"routerprivateinterfacemaxmtu"`
+	Routerpublicinterfacemaxmtu     FlexInt                    `json:"routerpublicinterfacemaxmtu"`
+	Securitygroupsenabled           FlexBool                   `json:"securitygroupsenabled"`
+	State                           string                     `json:"state"`
+	Storageaccessgroups             string                     `json:"storageaccessgroups"`
+	Tags                            []Tags                     `json:"tags"`
+	Type                            string                     `json:"type"`
+	Zonetoken                       string                     `json:"zonetoken"`
+	Extra                           map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ZonesMetric) UnmarshalJSON(b []byte) error {
+	type alias ZonesMetric
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ZonesMetricCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ZonesMetricCapacity) UnmarshalJSON(b []byte) error {
+	type alias ZonesMetricCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseDedicatedZoneParams struct {
@@ -3170,6 +4331,44 @@ func (p *ReleaseDedicatedZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseDedicatedZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseDedicatedZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseDedicatedZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseDedicatedZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseDedicatedZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("releaseDedicatedZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseDedicatedZone API, before the request is signed
+func (p *ReleaseDedicatedZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseDedicatedZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseDedicatedZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseDedicatedZone", b, &p.p, map[string]paramDecoder{
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseDedicatedZoneParams) Clone() *ReleaseDedicatedZoneParams {
+	return &ReleaseDedicatedZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseDedicatedZoneParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3202,27 +4401,39 @@ func (s *ZoneService) NewReleaseDedicatedZoneParams(zoneid string) *ReleaseDedic
 
 // Release dedication of zone
 func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseDedicatedZone", p.toURLValues())
+	return s.ReleaseDedicatedZoneWithContext(context.Background(), p)
+}
+
+// ReleaseDedicatedZoneWithContext is like ReleaseDedicatedZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseDedicatedZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseDedicatedZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseDedicatedZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseDedicatedZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseDedicatedZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3230,11 +4441,31 @@ func (s *ZoneService) ReleaseDedicatedZone(p *ReleaseDedicatedZoneParams) (*Rele
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseDedicatedZone async job into r
+func (r *ReleaseDedicatedZoneResponse) decodeJobResult(b json.RawMessage) error {
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseDedicatedZoneAsync starts the async job of ReleaseDedicatedZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) ReleaseDedicatedZoneAsync(ctx context.Context, p *ReleaseDedicatedZoneParams) (*Job, error) {
+	r, err := s.ReleaseDedicatedZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseDedicatedZone", r.JobID), nil
+}
+
 type ReleaseDedicatedZoneResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseDedicatedZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseDedicatedZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ReleaseIpv4SubnetForZoneParams struct {
@@ -3252,6 +4483,44 @@ func (p *ReleaseIpv4SubnetForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the releaseIpv4SubnetForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ReleaseIpv4SubnetForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("releaseIpv4SubnetForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the releaseIpv4SubnetForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ReleaseIpv4SubnetForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"id": 255},
+	}.validate("releaseIpv4SubnetForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the releaseIpv4SubnetForZone API, before the request is signed
+func (p *ReleaseIpv4SubnetForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ReleaseIpv4SubnetForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ReleaseIpv4SubnetForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("releaseIpv4SubnetForZone", b, &p.p, map[string]paramDecoder{
+		"id": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ReleaseIpv4SubnetForZoneParams) Clone() *ReleaseIpv4SubnetForZoneParams {
+	return &ReleaseIpv4SubnetForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *ReleaseIpv4SubnetForZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3284,32 +4553,39 @@ func (s *ZoneService) NewReleaseIpv4SubnetForZoneParams(id string) *ReleaseIpv4S
 
 // Releases an existing dedicated IPv4 subnet for a zone.
 func (s *ZoneService) ReleaseIpv4SubnetForZone(p *ReleaseIpv4SubnetForZoneParams) (*ReleaseIpv4SubnetForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("releaseIpv4SubnetForZone", p.toURLValues())
+	return s.ReleaseIpv4SubnetForZoneWithContext(context.Background(), p)
+}
+
+// ReleaseIpv4SubnetForZoneWithContext is like ReleaseIpv4SubnetForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ReleaseIpv4SubnetForZoneWithContext(ctx context.Context, p *ReleaseIpv4SubnetForZoneParams) (*ReleaseIpv4SubnetForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "releaseIpv4SubnetForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ReleaseIpv4SubnetForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("releaseIpv4SubnetForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "releaseIpv4SubnetForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("releaseIpv4SubnetForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -3317,19 +4593,44 @@ func (s *ZoneService) ReleaseIpv4SubnetForZone(p *ReleaseIpv4SubnetForZoneParams
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished ReleaseIpv4SubnetForZone async job into r
+func (r *ReleaseIpv4SubnetForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// ReleaseIpv4SubnetForZoneAsync starts the async job of ReleaseIpv4SubnetForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) ReleaseIpv4SubnetForZoneAsync(ctx context.Context, p *ReleaseIpv4SubnetForZoneParams) (*Job, error) {
+	r, err := s.ReleaseIpv4SubnetForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("releaseIpv4SubnetForZone", r.JobID), nil
+}
+
 type ReleaseIpv4SubnetForZoneResponse struct {
-	Account   string `json:"account"`
-	Created   string `json:"created"`
-	Domain    string `json:"domain"`
-	Domainid  string `json:"domainid"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Project   string `json:"project"`
-	Projectid string `json:"projectid"`
-	Subnet    string `json:"subnet"`
-	Zoneid    string `json:"zoneid"`
-	Zonename  string `json:"zonename"`
+	Account   string                     `json:"account"`
+	Created   Time                       `json:"created"`
+	Domain    string                     `json:"domain"`
+	Domainid  string                     `json:"domainid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Project   string                     `json:"project"`
+	Projectid string                     `json:"projectid"`
+	Subnet    string                     `json:"subnet"`
+	Zoneid    string                     `json:"zoneid"`
+	Zonename  string                     `json:"zonename"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *ReleaseIpv4SubnetForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias ReleaseIpv4SubnetForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateZoneParams struct {
@@ -3403,6 +4704,60 @@ func (p *UpdateZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"id"},
+		lengths:  map[string]int{"allocationstate": 255, "dhcpprovider": 255, "dns1": 255, "dns2": 255, "domain": 255, "guestcidraddress": 255, "id": 255, "internaldns1": 255, "internaldns2": 255, "ip6dns1": 255, "ip6dns2": 255, "name": 255},
+	}.validate("updateZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateZone API, before the request is signed
+func (p *UpdateZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateZone", b, &p.p, map[string]paramDecoder{
+		"allocationstate":     decodeParam[string],
+		"details":             decodeParam[map[string]string],
+		"dhcpprovider":        decodeParam[string],
+		"dns1":                decodeParam[string],
+		"dns2":                decodeParam[string],
+		"dnssearchorder":      decodeParam[[]string],
+		"domain":              decodeParam[string],
+		"guestcidraddress":    decodeParam[string],
+		"id":                  decodeParam[string],
+		"internaldns1":        decodeParam[string],
+		"internaldns2":        decodeParam[string],
+		"ip6dns1":             decodeParam[string],
+		"ip6dns2":             decodeParam[string],
+		"ispublic":            decodeParam[bool],
+		"localstorageenabled": decodeParam[bool],
+		"name":                decodeParam[string],
+		"sortkey":             decodeParam[int],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateZoneParams) Clone() *UpdateZoneParams {
+	return &UpdateZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateZoneParams) SetAllocationstate(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -3771,7 +5126,16 @@ func (s *ZoneService) NewUpdateZoneParams(id string) *UpdateZoneParams {
 
 // Updates a Zone.
 func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("updateZone", p.toURLValues())
+	return s.UpdateZoneWithContext(context.Background(), p)
+}
+
+// UpdateZoneWithContext is like UpdateZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -3781,7 +5145,7 @@ func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, erro
 	}
 
 	var r UpdateZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateZone", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -3790,7 +5154,7 @@ func (s *ZoneService) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, erro
 
 type UpdateZoneResponse struct {
 	Allocationstate              string                       `json:"allocationstate"`
-	Allowuserspecifyvrmtu        bool                         `json:"allowuserspecifyvrmtu"`
+	Allowuserspecifyvrmtu        FlexBool                     `json:"allowuserspecifyvrmtu"`
 	Asnrange                     string                       `json:"asnrange"`
 	Capacity                     []UpdateZoneResponseCapacity `json:"capacity"`
 	Description                  string                       `json:"description"`
@@ -3801,49 +5165,61 @@ type UpdateZoneResponse struct {
 	Domain                       string                       `json:"domain"`
 	Domainid                     string                       `json:"domainid"`
 	Domainname                   string                       `json:"domainname"`
-	Gputotal                     int64                        `json:"gputotal"`
-	Gpuused                      int64                        `json:"gpuused"`
+	Gputotal                     FlexInt64                    `json:"gputotal"`
+	Gpuused                      FlexInt64                    `json:"gpuused"`
 	Guestcidraddress             string                       `json:"guestcidraddress"`
-	Hasannotations               bool                         `json:"hasannotations"`
+	Hasannotations               FlexBool                     `json:"hasannotations"`
 	Icon                         interface{}                  `json:"icon"`
 	Id                           string                       `json:"id"`
 	Internaldns1                 string                       `json:"internaldns1"`
 	Internaldns2                 string                       `json:"internaldns2"`
 	Ip6dns1                      string                       `json:"ip6dns1"`
 	Ip6dns2                      string                       `json:"ip6dns2"`
-	Ismultiarch                  bool                         `json:"ismultiarch"`
-	Isnsxenabled                 bool                         `json:"isnsxenabled"`
+	Ismultiarch                  FlexBool                     `json:"ismultiarch"`
+	Isnsxenabled                 FlexBool                     `json:"isnsxenabled"`
 	JobID                        string                       `json:"jobid"`
-	Jobstatus                    int                          `json:"jobstatus"`
-	Localstorageenabled          bool                         `json:"localstorageenabled"`
+	Jobstatus                    FlexInt                      `json:"jobstatus"`
+	Localstorageenabled          FlexBool                     `json:"localstorageenabled"`
 	Name                         string                       `json:"name"`
 	Networktype                  string                       `json:"networktype"`
 	Provider                     string                       `json:"provider"`
 	Resourcedetails              map[string]string            `json:"resourcedetails"`
-	Routedmodeenabled            bool                         `json:"routedmodeenabled"`
-	Routerprivateinterfacemaxmtu int                          `json:"routerprivateinterfacemaxmtu"`
-	Routerpublicinterfacemaxmtu  int                          `json:"routerpublicinterfacemaxmtu"`
-	Securitygroupsenabled        bool                         `json:"securitygroupsenabled"`
+	Routedmodeenabled            FlexBool                     `json:"routedmodeenabled"`
+	Routerprivateinterfacemaxmtu FlexInt                      `json:"routerprivateinterfacemaxmtu"`
+	Routerpublicinterfacemaxmtu  FlexInt                      `json:"routerpublicinterfacemaxmtu"`
+	Securitygroupsenabled        FlexBool                     `json:"securitygroupsenabled"`
 	Storageaccessgroups          string                       `json:"storageaccessgroups"`
 	Tags                         []Tags                       `json:"tags"`
 	Type                         string                       `json:"type"`
 	Zonetoken                    string                       `json:"zonetoken"`
+	Extra                        map[string]json.RawMessage   `json:"-"`
+}
+
+func (r *UpdateZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateZoneResponseCapacity struct {
-	Capacityallocated int64  `json:"capacityallocated"`
-	Capacitytotal     int64  `json:"capacitytotal"`
-	Capacityused      int64  `json:"capacityused"`
-	Clusterid         string `json:"clusterid"`
-	Clustername       string `json:"clustername"`
-	Name              string `json:"name"`
-	Percentused       string `json:"percentused"`
-	Podid             string `json:"podid"`
-	Podname           string `json:"podname"`
-	Tag               string `json:"tag"`
-	Type              int    `json:"type"`
-	Zoneid            string `json:"zoneid"`
-	Zonename          string `json:"zonename"`
+	Capacityallocated FlexInt64                  `json:"capacityallocated"`
+	Capacitytotal     FlexInt64                  `json:"capacitytotal"`
+	Capacityused      FlexInt64                  `json:"capacityused"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Name              string                     `json:"name"`
+	Percentused       string                     `json:"percentused"`
+	Podid             string                     `json:"podid"`
+	Podname           string                     `json:"podname"`
+	Tag               string                     `json:"tag"`
+	Type              FlexInt                    `json:"type"`
+	Zoneid            string                     `json:"zoneid"`
+	Zonename          string                     `json:"zonename"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateZoneResponseCapacity) UnmarshalJSON(b []byte) error {
+	type alias UpdateZoneResponseCapacity
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVmwareDcVmsParams struct {
@@ -3890,6 +5266,52 @@ func (p *ListVmwareDcVmsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVmwareDcVms API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVmwareDcVmsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVmwareDcVms", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVmwareDcVms API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVmwareDcVmsParams) Validate() error {
+	return paramRules{
+		lengths: map[string]int{"datacentername": 255, "existingvcenterid": 255, "hostname": 255, "instancename": 255, "keyword": 255, "password": 255, "username": 255, "vcenter": 255},
+	}.validate("listVmwareDcVms", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVmwareDcVms API, before the request is signed
+func (p *ListVmwareDcVmsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVmwareDcVmsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVmwareDcVmsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVmwareDcVms", b, &p.p, map[string]paramDecoder{
+		"datacentername":    decodeParam[string],
+		"existingvcenterid": decodeParam[string],
+		"hostname":          decodeParam[string],
+		"instancename":      decodeParam[string],
+		"keyword":           decodeParam[string],
+		"page":              decodeParam[int],
+		"pagesize":          decodeParam[int],
+		"password":          decodeParam[string],
+		"username":          decodeParam[string],
+		"vcenter":           decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVmwareDcVmsParams) Clone() *ListVmwareDcVmsParams {
+	return &ListVmwareDcVmsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVmwareDcVmsParams) SetDatacentername(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4110,59 +5532,108 @@ func (s *ZoneService) NewListVmwareDcVmsParams() *ListVmwareDcVmsParams {
 
 // Lists the VMs in a VMware Datacenter
 func (s *ZoneService) ListVmwareDcVms(p *ListVmwareDcVmsParams) (*ListVmwareDcVmsResponse, error) {
-	resp, err := s.cs.newRequest("listVmwareDcVms", p.toURLValues())
+	return s.ListVmwareDcVmsWithContext(context.Background(), p)
+}
+
+// ListVmwareDcVmsWithContext is like ListVmwareDcVms, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListVmwareDcVmsWithContext(ctx context.Context, p *ListVmwareDcVmsParams) (*ListVmwareDcVmsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVmwareDcVms", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVmwareDcVmsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVmwareDcVms", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVmwareDcVmsAll returns all VmwareDcVms matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ZoneService) ListVmwareDcVmsAll(p *ListVmwareDcVmsParams, opts ...PageOption) ([]*VmwareDcVm, error) {
+	return s.ListVmwareDcVmsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVmwareDcVmsAllWithContext is like ListVmwareDcVmsAll, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListVmwareDcVmsAllWithContext(ctx context.Context, p *ListVmwareDcVmsParams, opts ...PageOption) ([]*VmwareDcVm, error) {
+	return collectPages(s.ListVmwareDcVmsIterWithContext(ctx, p, opts...))
+}
+
+// ListVmwareDcVmsIter returns an iterator over all VmwareDcVms matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ZoneService) ListVmwareDcVmsIter(p *ListVmwareDcVmsParams, opts ...PageOption) iter.Seq2[*VmwareDcVm, error] {
+	return s.ListVmwareDcVmsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVmwareDcVmsIterWithContext is like ListVmwareDcVmsIter, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListVmwareDcVmsIterWithContext(ctx context.Context, p *ListVmwareDcVmsParams, opts ...PageOption) iter.Seq2[*VmwareDcVm, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VmwareDcVm, int, error) {
+		l, err := s.ListVmwareDcVmsWithContext(ctx, &ListVmwareDcVmsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VmwareDcVms, l.Count, nil
+	}, opts...)
+}
+
 type ListVmwareDcVmsResponse struct {
 	Count       int           `json:"count"`
 	VmwareDcVms []*VmwareDcVm `json:"unmanagedinstance"`
 }
 
 type VmwareDcVm struct {
-	Bootmode          string           `json:"bootmode"`
-	Boottype          string           `json:"boottype"`
-	Clusterid         string           `json:"clusterid"`
-	Clustername       string           `json:"clustername"`
-	Cpucorepersocket  int              `json:"cpucorepersocket"`
-	Cpunumber         int              `json:"cpunumber"`
-	Cpuspeed          int              `json:"cpuspeed"`
-	Disk              []VmwareDcVmDisk `json:"disk"`
-	Hostid            string           `json:"hostid"`
-	Hostname          string           `json:"hostname"`
-	Hypervisor        string           `json:"hypervisor"`
-	Hypervisorversion string           `json:"hypervisorversion"`
-	JobID             string           `json:"jobid"`
-	Jobstatus         int              `json:"jobstatus"`
-	Memory            int              `json:"memory"`
-	Name              string           `json:"name"`
-	Nic               []Nic            `json:"nic"`
-	Osdisplayname     string           `json:"osdisplayname"`
-	Osid              string           `json:"osid"`
-	Powerstate        string           `json:"powerstate"`
+	Bootmode          string                     `json:"bootmode"`
+	Boottype          string                     `json:"boottype"`
+	Clusterid         string                     `json:"clusterid"`
+	Clustername       string                     `json:"clustername"`
+	Cpucorepersocket  FlexInt                    `json:"cpucorepersocket"`
+	Cpunumber         FlexInt                    `json:"cpunumber"`
+	Cpuspeed          FlexInt                    `json:"cpuspeed"`
+	Disk              []VmwareDcVmDisk           `json:"disk"`
+	Hostid            string                     `json:"hostid"`
+	Hostname          string                     `json:"hostname"`
+	Hypervisor        string                     `json:"hypervisor"`
+	Hypervisorversion string                     `json:"hypervisorversion"`
+	JobID             string                     `json:"jobid"`
+	Jobstatus         FlexInt                    `json:"jobstatus"`
+	Memory            FlexInt                    `json:"memory"`
+	Name              string                     `json:"name"`
+	Nic               []Nic                      `json:"nic"`
+	Osdisplayname     string                     `json:"osdisplayname"`
+	Osid              string                     `json:"osid"`
+	Powerstate        string                     `json:"powerstate"`
+	Extra             map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VmwareDcVm) UnmarshalJSON(b []byte) error {
+	type alias VmwareDcVm
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type VmwareDcVmDisk struct {
-	Capacity       int64  `json:"capacity"`
-	Controller     string `json:"controller"`
-	Controllerunit int    `json:"controllerunit"`
-	Datastorehost  string `json:"datastorehost"`
-	Datastorename  string `json:"datastorename"`
-	Datastorepath  string `json:"datastorepath"`
-	Datastoretype  string `json:"datastoretype"`
-	Id             string `json:"id"`
-	Imagepath      string `json:"imagepath"`
-	Label          string `json:"label"`
-	Position       int    `json:"position"`
+	Capacity       FlexInt64                  `json:"capacity"`
+	Controller     string                     `json:"controller"`
+	Controllerunit FlexInt                    `json:"controllerunit"`
+	Datastorehost  string                     `json:"datastorehost"`
+	Datastorename  string                     `json:"datastorename"`
+	Datastorepath  string                     `json:"datastorepath"`
+	Datastoretype  string                     `json:"datastoretype"`
+	Id             string                     `json:"id"`
+	Imagepath      string                     `json:"imagepath"`
+	Label          string                     `json:"label"`
+	Position       FlexInt                    `json:"position"`
+	Extra          map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VmwareDcVmDisk) UnmarshalJSON(b []byte) error {
+	type alias VmwareDcVmDisk
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type AddVmwareDcParams struct {
@@ -4192,6 +5663,48 @@ func (p *AddVmwareDcParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the addVmwareDc API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *AddVmwareDcParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("addVmwareDc", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the addVmwareDc API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *AddVmwareDcParams) Validate() error {
+	return paramRules{
+		required: []string{"name", "vcenter", "zoneid"},
+		lengths:  map[string]int{"name": 255, "password": 255, "username": 255, "vcenter": 255, "zoneid": 255},
+	}.validate("addVmwareDc", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the addVmwareDc API, before the request is signed
+func (p *AddVmwareDcParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *AddVmwareDcParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *AddVmwareDcParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("addVmwareDc", b, &p.p, map[string]paramDecoder{
+		"name":     decodeParam[string],
+		"password": decodeParam[string],
+		"username": decodeParam[string],
+		"vcenter":  decodeParam[string],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *AddVmwareDcParams) Clone() *AddVmwareDcParams {
+	return &AddVmwareDcParams{p: cloneParams(p.p)}
+}
+
 func (p *AddVmwareDcParams) SetName(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4310,7 +5823,16 @@ func (s *ZoneService) NewAddVmwareDcParams(name string, vcenter string, zoneid s
 
 // Adds a VMware datacenter to specified zone
 func (s *ZoneService) AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
-	resp, err := s.cs.newPostRequest("addVmwareDc", p.toURLValues())
+	return s.AddVmwareDcWithContext(context.Background(), p)
+}
+
+// AddVmwareDcWithContext is like AddVmwareDc, but honours the cancellation and deadline of ctx
+func (s *ZoneService) AddVmwareDcWithContext(ctx context.Context, p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "addVmwareDc", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4318,7 +5840,7 @@ func (s *ZoneService) AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, e
 	var nested struct {
 		Response AddVmwareDcResponse `json:"vmwaredc"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("addVmwareDc", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -4327,12 +5849,18 @@ func (s *ZoneService) AddVmwareDc(p *AddVmwareDcParams) (*AddVmwareDcResponse, e
 }
 
 type AddVmwareDcResponse struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Vcenter   string `json:"vcenter"`
-	Zoneid    int64  `json:"zoneid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Vcenter   string                     `json:"vcenter"`
+	Zoneid    FlexInt64                  `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *AddVmwareDcResponse) UnmarshalJSON(b []byte) error {
+	type alias AddVmwareDcResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type ListVmwareDcsParams struct {
@@ -4361,6 +5889,47 @@ func (p *ListVmwareDcsParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the listVmwareDcs API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *ListVmwareDcsParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("listVmwareDcs", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the listVmwareDcs API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *ListVmwareDcsParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"keyword": 255, "zoneid": 255},
+	}.validate("listVmwareDcs", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the listVmwareDcs API, before the request is signed
+func (p *ListVmwareDcsParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *ListVmwareDcsParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *ListVmwareDcsParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("listVmwareDcs", b, &p.p, map[string]paramDecoder{
+		"keyword":  decodeParam[string],
+		"page":     decodeParam[int],
+		"pagesize": decodeParam[int],
+		"zoneid":   decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *ListVmwareDcsParams) Clone() *ListVmwareDcsParams {
+	return &ListVmwareDcsParams{p: cloneParams(p.p)}
+}
+
 func (p *ListVmwareDcsParams) SetKeyword(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4456,6 +6025,11 @@ func (s *ZoneService) NewListVmwareDcsParams(zoneid string) *ListVmwareDcsParams
 
 // This is a courtesy helper function, which in some cases may not work as expected!
 func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
+	return s.GetVmwareDcIDWithContext(context.Background(), keyword, zoneid, opts...)
+}
+
+// GetVmwareDcIDWithContext is like GetVmwareDcID, but honours the cancellation and deadline of ctx
+func (s *ZoneService) GetVmwareDcIDWithContext(ctx context.Context, keyword string, zoneid string, opts ...OptionFunc) (string, int, error) {
 	p := &ListVmwareDcsParams{}
 	p.p = make(map[string]interface{})
 
@@ -4468,7 +6042,7 @@ func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...Optio
 		}
 	}
 
-	l, err := s.ListVmwareDcs(p)
+	l, err := s.ListVmwareDcsWithContext(ctx, p)
 	if err != nil {
 		return "", -1, err
 	}
@@ -4493,31 +6067,74 @@ func (s *ZoneService) GetVmwareDcID(keyword string, zoneid string, opts ...Optio
 
 // Retrieves VMware DC(s) associated with a zone.
 func (s *ZoneService) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
-	resp, err := s.cs.newRequest("listVmwareDcs", p.toURLValues())
+	return s.ListVmwareDcsWithContext(context.Background(), p)
+}
+
+// ListVmwareDcsWithContext is like ListVmwareDcs, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListVmwareDcsWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newRequest(ctx, "listVmwareDcs", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r ListVmwareDcsResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("listVmwareDcs", resp, &r); err != nil {
 		return nil, err
 	}
 
 	return &r, nil
 }
 
+// ListVmwareDcsAll returns all VmwareDcs matching p, walking through all pages. The page size of p is used if set,
+// otherwise the default page size of 500 is used.
+func (s *ZoneService) ListVmwareDcsAll(p *ListVmwareDcsParams, opts ...PageOption) ([]*VmwareDc, error) {
+	return s.ListVmwareDcsAllWithContext(context.Background(), p, opts...)
+}
+
+// ListVmwareDcsAllWithContext is like ListVmwareDcsAll, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListVmwareDcsAllWithContext(ctx context.Context, p *ListVmwareDcsParams, opts ...PageOption) ([]*VmwareDc, error) {
+	return collectPages(s.ListVmwareDcsIterWithContext(ctx, p, opts...))
+}
+
+// ListVmwareDcsIter returns an iterator over all VmwareDcs matching p, fetching the pages while iterating.
+// Iteration stops at the first error, which is yielded as the last element.
+func (s *ZoneService) ListVmwareDcsIter(p *ListVmwareDcsParams, opts ...PageOption) iter.Seq2[*VmwareDc, error] {
+	return s.ListVmwareDcsIterWithContext(context.Background(), p, opts...)
+}
+
+// ListVmwareDcsIterWithContext is like ListVmwareDcsIter, but honours the cancellation and deadline of ctx
+func (s *ZoneService) ListVmwareDcsIterWithContext(ctx context.Context, p *ListVmwareDcsParams, opts ...PageOption) iter.Seq2[*VmwareDc, error] {
+	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*VmwareDc, int, error) {
+		l, err := s.ListVmwareDcsWithContext(ctx, &ListVmwareDcsParams{p: params})
+		if err != nil {
+			return nil, 0, err
+		}
+		return l.VmwareDcs, l.Count, nil
+	}, opts...)
+}
+
 type ListVmwareDcsResponse struct {
 	Count     int         `json:"count"`
 	VmwareDcs []*VmwareDc `json:"vmwaredc"`
 }
 
 type VmwareDc struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Vcenter   string `json:"vcenter"`
-	Zoneid    int64  `json:"zoneid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Vcenter   string                     `json:"vcenter"`
+	Zoneid    FlexInt64                  `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *VmwareDc) UnmarshalJSON(b []byte) error {
+	type alias VmwareDc
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type RemoveVmwareDcParams struct {
@@ -4535,6 +6152,44 @@ func (p *RemoveVmwareDcParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the removeVmwareDc API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *RemoveVmwareDcParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("removeVmwareDc", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the removeVmwareDc API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *RemoveVmwareDcParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"zoneid": 255},
+	}.validate("removeVmwareDc", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the removeVmwareDc API, before the request is signed
+func (p *RemoveVmwareDcParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *RemoveVmwareDcParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *RemoveVmwareDcParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("removeVmwareDc", b, &p.p, map[string]paramDecoder{
+		"zoneid": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *RemoveVmwareDcParams) Clone() *RemoveVmwareDcParams {
+	return &RemoveVmwareDcParams{p: cloneParams(p.p)}
+}
+
 func (p *RemoveVmwareDcParams) SetZoneid(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4567,13 +6222,22 @@ func (s *ZoneService) NewRemoveVmwareDcParams(zoneid string) *RemoveVmwareDcPara
 
 // Remove a VMware datacenter from a zone.
 func (s *ZoneService) RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
-	resp, err := s.cs.newPostRequest("removeVmwareDc", p.toURLValues())
+	return s.RemoveVmwareDcWithContext(context.Background(), p)
+}
+
+// RemoveVmwareDcWithContext is like RemoveVmwareDc, but honours the cancellation and deadline of ctx
+func (s *ZoneService) RemoveVmwareDcWithContext(ctx context.Context, p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "removeVmwareDc", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r RemoveVmwareDcResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("removeVmwareDc", resp, &r); err != nil {
 		return nil, err
 	}
 
@@ -4581,10 +6245,11 @@ func (s *ZoneService) RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcRe
 }
 
 type RemoveVmwareDcResponse struct {
-	Displaytext string `json:"displaytext"`
-	JobID       string `json:"jobid"`
-	Jobstatus   int    `json:"jobstatus"`
-	Success     bool   `json:"success"`
+	Displaytext string                     `json:"displaytext"`
+	JobID       string                     `json:"jobid"`
+	Jobstatus   FlexInt                    `json:"jobstatus"`
+	Success     bool                       `json:"success"`
+	Extra       map[string]json.RawMessage `json:"-"`
 }
 
 func (r *RemoveVmwareDcResponse) UnmarshalJSON(b []byte) error {
@@ -4611,7 +6276,7 @@ func (r *RemoveVmwareDcResponse) UnmarshalJSON(b []byte) error {
 	}
 
 	type alias RemoveVmwareDcResponse
-	return json.Unmarshal(b, (*alias)(r))
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateIpv4SubnetForZoneParams struct {
@@ -4632,6 +6297,45 @@ func (p *UpdateIpv4SubnetForZoneParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateIpv4SubnetForZone API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateIpv4SubnetForZoneParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateIpv4SubnetForZone", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateIpv4SubnetForZone API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateIpv4SubnetForZoneParams) Validate() error {
+	return paramRules{
+		required: []string{"id", "subnet"},
+		lengths:  map[string]int{"id": 255, "subnet": 255},
+	}.validate("updateIpv4SubnetForZone", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateIpv4SubnetForZone API, before the request is signed
+func (p *UpdateIpv4SubnetForZoneParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateIpv4SubnetForZoneParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateIpv4SubnetForZoneParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateIpv4SubnetForZone", b, &p.p, map[string]paramDecoder{
+		"id":     decodeParam[string],
+		"subnet": decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateIpv4SubnetForZoneParams) Clone() *UpdateIpv4SubnetForZoneParams {
+	return &UpdateIpv4SubnetForZoneParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateIpv4SubnetForZoneParams) SetId(v string) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4686,32 +6390,39 @@ func (s *ZoneService) NewUpdateIpv4SubnetForZoneParams(id string, subnet string)
 
 // Updates an existing IPv4 subnet for a zone.
 func (s *ZoneService) UpdateIpv4SubnetForZone(p *UpdateIpv4SubnetForZoneParams) (*UpdateIpv4SubnetForZoneResponse, error) {
-	resp, err := s.cs.newPostRequest("updateIpv4SubnetForZone", p.toURLValues())
+	return s.UpdateIpv4SubnetForZoneWithContext(context.Background(), p)
+}
+
+// UpdateIpv4SubnetForZoneWithContext is like UpdateIpv4SubnetForZone, but honours the cancellation and deadline of ctx
+func (s *ZoneService) UpdateIpv4SubnetForZoneWithContext(ctx context.Context, p *UpdateIpv4SubnetForZoneParams) (*UpdateIpv4SubnetForZoneResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	ctx, js := s.cs.withJobSpan(ctx)
+	defer js.release()
+
+	resp, err := s.cs.newPostRequest(ctx, "updateIpv4SubnetForZone", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
 
 	var r UpdateIpv4SubnetForZoneResponse
-	if err := json.Unmarshal(resp, &r); err != nil {
+	if err := s.cs.decodeResponse("updateIpv4SubnetForZone", resp, &r); err != nil {
 		return nil, err
 	}
 
 	// If we have a async client, we need to wait for the async result
-	if s.cs.async {
-		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)
+	if s.cs.waitForAsyncJob(ctx) {
+		b, err := s.cs.waitForJob(ctx, "updateIpv4SubnetForZone", r.JobID)
 		if err != nil {
-			if err == AsyncTimeoutErr {
+			if err == AsyncTimeoutErr || ctx.Err() != nil {
 				return &r, err
 			}
 			return nil, err
 		}
 
-		b, err = getRawValue(b)
-		if err != nil {
-			return nil, err
-		}
-
-		if err := json.Unmarshal(b, &r); err != nil {
+		if err := s.cs.decodeJobResult("updateIpv4SubnetForZone", b, &r); err != nil {
 			return nil, err
 		}
 	}
@@ -4719,19 +6430,44 @@ func (s *ZoneService) UpdateIpv4SubnetForZone(p *UpdateIpv4SubnetForZoneParams)
 	return &r, nil
 }
 
+// decodeJobResult decodes the result of a finished UpdateIpv4SubnetForZone async job into r
+func (r *UpdateIpv4SubnetForZoneResponse) decodeJobResult(b json.RawMessage) error {
+	b, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(b, r)
+}
+
+// UpdateIpv4SubnetForZoneAsync starts the async job of UpdateIpv4SubnetForZone without waiting for it to finish, and returns a handle to the job
+func (s *ZoneService) UpdateIpv4SubnetForZoneAsync(ctx context.Context, p *UpdateIpv4SubnetForZoneParams) (*Job, error) {
+	r, err := s.UpdateIpv4SubnetForZoneWithContext(WithAsyncWait(ctx, false), p)
+	if err != nil {
+		return nil, err
+	}
+	return s.cs.newJob("updateIpv4SubnetForZone", r.JobID), nil
+}
+
 type UpdateIpv4SubnetForZoneResponse struct {
-	Account   string `json:"account"`
-	Created   string `json:"created"`
-	Domain    string `json:"domain"`
-	Domainid  string `json:"domainid"`
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Project   string `json:"project"`
-	Projectid string `json:"projectid"`
-	Subnet    string `json:"subnet"`
-	Zoneid    string `json:"zoneid"`
-	Zonename  string `json:"zonename"`
+	Account   string                     `json:"account"`
+	Created   Time                       `json:"created"`
+	Domain    string                     `json:"domain"`
+	Domainid  string                     `json:"domainid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Project   string                     `json:"project"`
+	Projectid string                     `json:"projectid"`
+	Subnet    string                     `json:"subnet"`
+	Zoneid    string                     `json:"zoneid"`
+	Zonename  string                     `json:"zonename"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateIpv4SubnetForZoneResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateIpv4SubnetForZoneResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
 
 type UpdateVmwareDcParams struct {
@@ -4765,6 +6501,49 @@ func (p *UpdateVmwareDcParams) toURLValues() url.Values {
 	return u
 }
 
+// ValidateAgainst checks the parameters against the updateVmwareDc API in the schema of a server. It returns a
+// *ParamsError listing unknown and missing parameters and values of the wrong type.
+func (p *UpdateVmwareDcParams) ValidateAgainst(server *APISchema) error {
+	return server.Validate("updateVmwareDc", p.toURLValues())
+}
+
+// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer
+// than the updateVmwareDc API accepts. It returns a *ParamsError listing the missing and invalid parameters.
+func (p *UpdateVmwareDcParams) Validate() error {
+	return paramRules{
+		required: []string{"zoneid"},
+		lengths:  map[string]int{"name": 255, "password": 255, "username": 255, "vcenter": 255, "zoneid": 255},
+	}.validate("updateVmwareDc", p.p, p.toURLValues())
+}
+
+// URLValues returns the parameters encoded as they are sent to the updateVmwareDc API, before the request is signed
+func (p *UpdateVmwareDcParams) URLValues() url.Values {
+	return p.toURLValues()
+}
+
+// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can
+// be stored and decoded again with UnmarshalJSON
+func (p *UpdateVmwareDcParams) MarshalJSON() ([]byte, error) {
+	return marshalParams(p.p)
+}
+
+// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set
+func (p *UpdateVmwareDcParams) UnmarshalJSON(b []byte) error {
+	return unmarshalParams("updateVmwareDc", b, &p.p, map[string]paramDecoder{
+		"isrecursive": decodeParam[bool],
+		"name":        decodeParam[string],
+		"password":    decodeParam[string],
+		"username":    decodeParam[string],
+		"vcenter":     decodeParam[string],
+		"zoneid":      decodeParam[string],
+	})
+}
+
+// Clone returns a deep copy of the parameters, which can be changed without changing the original
+func (p *UpdateVmwareDcParams) Clone() *UpdateVmwareDcParams {
+	return &UpdateVmwareDcParams{p: cloneParams(p.p)}
+}
+
 func (p *UpdateVmwareDcParams) SetIsrecursive(v bool) {
 	if p.p == nil {
 		p.p = make(map[string]interface{})
@@ -4902,7 +6681,16 @@ func (s *ZoneService) NewUpdateVmwareDcParams(zoneid string) *UpdateVmwareDcPara
 
 // Updates a VMware datacenter details for a zone
 func (s *ZoneService) UpdateVmwareDc(p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error) {
-	resp, err := s.cs.newPostRequest("updateVmwareDc", p.toURLValues())
+	return s.UpdateVmwareDcWithContext(context.Background(), p)
+}
+
+// UpdateVmwareDcWithContext is like UpdateVmwareDc, but honours the cancellation and deadline of ctx
+func (s *ZoneService) UpdateVmwareDcWithContext(ctx context.Context, p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error) {
+	if err := p.Validate(); err != nil {
+		return nil, err
+	}
+
+	resp, err := s.cs.newPostRequest(ctx, "updateVmwareDc", p.toURLValues())
 	if err != nil {
 		return nil, err
 	}
@@ -4910,7 +6698,7 @@ func (s *ZoneService) UpdateVmwareDc(p *UpdateVmwareDcParams) (*UpdateVmwareDcRe
 	var nested struct {
 		Response UpdateVmwareDcResponse `json:"vmwaredc"`
 	}
-	if err := json.Unmarshal(resp, &nested); err != nil {
+	if err := s.cs.decodeResponse("updateVmwareDc", resp, &nested); err != nil {
 		return nil, err
 	}
 	r := nested.Response
@@ -4919,10 +6707,16 @@ func (s *ZoneService) UpdateVmwareDc(p *UpdateVmwareDcParams) (*UpdateVmwareDcRe
 }
 
 type UpdateVmwareDcResponse struct {
-	Id        string `json:"id"`
-	JobID     string `json:"jobid"`
-	Jobstatus int    `json:"jobstatus"`
-	Name      string `json:"name"`
-	Vcenter   string `json:"vcenter"`
-	Zoneid    int64  `json:"zoneid"`
+	Id        string                     `json:"id"`
+	JobID     string                     `json:"jobid"`
+	Jobstatus FlexInt                    `json:"jobstatus"`
+	Name      string                     `json:"name"`
+	Vcenter   string                     `json:"vcenter"`
+	Zoneid    FlexInt64                  `json:"zoneid"`
+	Extra     map[string]json.RawMessage `json:"-"`
+}
+
+func (r *UpdateVmwareDcResponse) UnmarshalJSON(b []byte) error {
+	type alias UpdateVmwareDcResponse
+	return decodeWithExtra(b, (*alias)(r), &r.Extra)
 }
@@ -29,6 +29,8 @@
 package cloudstack
 
 import (
+	context "context"
+	iter "iter"
 	reflect "reflect"
 
 	gomock "go.uber.org/mock/gomock"
@@ -73,6 +75,21 @@ func (mr *MockZoneServiceIfaceMockRecorder) AddVmwareDc(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVmwareDc", reflect.TypeOf((*MockZoneServiceIface)(nil).AddVmwareDc), p)
 }
 
+// AddVmwareDcWithContext mocks base method.
+func (m *MockZoneServiceIface) AddVmwareDcWithContext(ctx context.Context, p *AddVmwareDcParams) (*AddVmwareDcResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddVmwareDcWithContext", ctx, p)
+	ret0, _ := ret[0].(*AddVmwareDcResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AddVmwareDcWithContext indicates an expected call of AddVmwareDcWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) AddVmwareDcWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddVmwareDcWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).AddVmwareDcWithContext), ctx, p)
+}
+
 // CreateIpv4SubnetForZone mocks base method.
 func (m *MockZoneServiceIface) CreateIpv4SubnetForZone(p *CreateIpv4SubnetForZoneParams) (*CreateIpv4SubnetForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -88,6 +105,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) CreateIpv4SubnetForZone(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv4SubnetForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateIpv4SubnetForZone), p)
 }
 
+// CreateIpv4SubnetForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) CreateIpv4SubnetForZoneAsync(ctx context.Context, p *CreateIpv4SubnetForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv4SubnetForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv4SubnetForZoneAsync indicates an expected call of CreateIpv4SubnetForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) CreateIpv4SubnetForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv4SubnetForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateIpv4SubnetForZoneAsync), ctx, p)
+}
+
+// CreateIpv4SubnetForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) CreateIpv4SubnetForZoneWithContext(ctx context.Context, p *CreateIpv4SubnetForZoneParams) (*CreateIpv4SubnetForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv4SubnetForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateIpv4SubnetForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv4SubnetForZoneWithContext indicates an expected call of CreateIpv4SubnetForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) CreateIpv4SubnetForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv4SubnetForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateIpv4SubnetForZoneWithContext), ctx, p)
+}
+
 // CreateZone mocks base method.
 func (m *MockZoneServiceIface) CreateZone(p *CreateZoneParams) (*CreateZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -103,6 +150,21 @@ func (mr *MockZoneServiceIfaceMockRecorder) CreateZone(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateZone), p)
 }
 
+// CreateZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) CreateZoneWithContext(ctx context.Context, p *CreateZoneParams) (*CreateZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*CreateZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateZoneWithContext indicates an expected call of CreateZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) CreateZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).CreateZoneWithContext), ctx, p)
+}
+
 // DedicateIpv4SubnetForZone mocks base method.
 func (m *MockZoneServiceIface) DedicateIpv4SubnetForZone(p *DedicateIpv4SubnetForZoneParams) (*DedicateIpv4SubnetForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -118,6 +180,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) DedicateIpv4SubnetForZone(p any) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateIpv4SubnetForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateIpv4SubnetForZone), p)
 }
 
+// DedicateIpv4SubnetForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) DedicateIpv4SubnetForZoneAsync(ctx context.Context, p *DedicateIpv4SubnetForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateIpv4SubnetForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateIpv4SubnetForZoneAsync indicates an expected call of DedicateIpv4SubnetForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) DedicateIpv4SubnetForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateIpv4SubnetForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateIpv4SubnetForZoneAsync), ctx, p)
+}
+
+// DedicateIpv4SubnetForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) DedicateIpv4SubnetForZoneWithContext(ctx context.Context, p *DedicateIpv4SubnetForZoneParams) (*DedicateIpv4SubnetForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateIpv4SubnetForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicateIpv4SubnetForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateIpv4SubnetForZoneWithContext indicates an expected call of DedicateIpv4SubnetForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) DedicateIpv4SubnetForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateIpv4SubnetForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateIpv4SubnetForZoneWithContext), ctx, p)
+}
+
 // DedicateZone mocks base method.
 func (m *MockZoneServiceIface) DedicateZone(p *DedicateZoneParams) (*DedicateZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -133,6 +225,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) DedicateZone(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateZone), p)
 }
 
+// DedicateZoneAsync mocks base method.
+func (m *MockZoneServiceIface) DedicateZoneAsync(ctx context.Context, p *DedicateZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateZoneAsync indicates an expected call of DedicateZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) DedicateZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateZoneAsync), ctx, p)
+}
+
+// DedicateZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) DedicateZoneWithContext(ctx context.Context, p *DedicateZoneParams) (*DedicateZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DedicateZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*DedicateZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DedicateZoneWithContext indicates an expected call of DedicateZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) DedicateZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DedicateZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).DedicateZoneWithContext), ctx, p)
+}
+
 // DeleteIpv4SubnetForZone mocks base method.
 func (m *MockZoneServiceIface) DeleteIpv4SubnetForZone(p *DeleteIpv4SubnetForZoneParams) (*DeleteIpv4SubnetForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -148,6 +270,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) DeleteIpv4SubnetForZone(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv4SubnetForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteIpv4SubnetForZone), p)
 }
 
+// DeleteIpv4SubnetForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) DeleteIpv4SubnetForZoneAsync(ctx context.Context, p *DeleteIpv4SubnetForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv4SubnetForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv4SubnetForZoneAsync indicates an expected call of DeleteIpv4SubnetForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) DeleteIpv4SubnetForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv4SubnetForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteIpv4SubnetForZoneAsync), ctx, p)
+}
+
+// DeleteIpv4SubnetForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) DeleteIpv4SubnetForZoneWithContext(ctx context.Context, p *DeleteIpv4SubnetForZoneParams) (*DeleteIpv4SubnetForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv4SubnetForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteIpv4SubnetForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv4SubnetForZoneWithContext indicates an expected call of DeleteIpv4SubnetForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) DeleteIpv4SubnetForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv4SubnetForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteIpv4SubnetForZoneWithContext), ctx, p)
+}
+
 // DeleteZone mocks base method.
 func (m *MockZoneServiceIface) DeleteZone(p *DeleteZoneParams) (*DeleteZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -163,6 +315,21 @@ func (mr *MockZoneServiceIfaceMockRecorder) DeleteZone(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteZone), p)
 }
 
+// DeleteZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) DeleteZoneWithContext(ctx context.Context, p *DeleteZoneParams) (*DeleteZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*DeleteZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteZoneWithContext indicates an expected call of DeleteZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) DeleteZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).DeleteZoneWithContext), ctx, p)
+}
+
 // DisableHAForZone mocks base method.
 func (m *MockZoneServiceIface) DisableHAForZone(p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -178,6 +345,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) DisableHAForZone(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableHAForZone), p)
 }
 
+// DisableHAForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) DisableHAForZoneAsync(ctx context.Context, p *DisableHAForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableHAForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableHAForZoneAsync indicates an expected call of DisableHAForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) DisableHAForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableHAForZoneAsync), ctx, p)
+}
+
+// DisableHAForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) DisableHAForZoneWithContext(ctx context.Context, p *DisableHAForZoneParams) (*DisableHAForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableHAForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableHAForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableHAForZoneWithContext indicates an expected call of DisableHAForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) DisableHAForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableHAForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableHAForZoneWithContext), ctx, p)
+}
+
 // DisableOutOfBandManagementForZone mocks base method.
 func (m *MockZoneServiceIface) DisableOutOfBandManagementForZone(p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -193,6 +390,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) DisableOutOfBandManagementForZone(p
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableOutOfBandManagementForZone), p)
 }
 
+// DisableOutOfBandManagementForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) DisableOutOfBandManagementForZoneAsync(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForZoneAsync indicates an expected call of DisableOutOfBandManagementForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) DisableOutOfBandManagementForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableOutOfBandManagementForZoneAsync), ctx, p)
+}
+
+// DisableOutOfBandManagementForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) DisableOutOfBandManagementForZoneWithContext(ctx context.Context, p *DisableOutOfBandManagementForZoneParams) (*DisableOutOfBandManagementForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DisableOutOfBandManagementForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*DisableOutOfBandManagementForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DisableOutOfBandManagementForZoneWithContext indicates an expected call of DisableOutOfBandManagementForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) DisableOutOfBandManagementForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DisableOutOfBandManagementForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).DisableOutOfBandManagementForZoneWithContext), ctx, p)
+}
+
 // EnableHAForZone mocks base method.
 func (m *MockZoneServiceIface) EnableHAForZone(p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -208,6 +435,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) EnableHAForZone(p any) *gomock.Call
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableHAForZone), p)
 }
 
+// EnableHAForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) EnableHAForZoneAsync(ctx context.Context, p *EnableHAForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableHAForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableHAForZoneAsync indicates an expected call of EnableHAForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) EnableHAForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableHAForZoneAsync), ctx, p)
+}
+
+// EnableHAForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) EnableHAForZoneWithContext(ctx context.Context, p *EnableHAForZoneParams) (*EnableHAForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableHAForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableHAForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableHAForZoneWithContext indicates an expected call of EnableHAForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) EnableHAForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableHAForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableHAForZoneWithContext), ctx, p)
+}
+
 // EnableOutOfBandManagementForZone mocks base method.
 func (m *MockZoneServiceIface) EnableOutOfBandManagementForZone(p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -223,6 +480,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) EnableOutOfBandManagementForZone(p a
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableOutOfBandManagementForZone), p)
 }
 
+// EnableOutOfBandManagementForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) EnableOutOfBandManagementForZoneAsync(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForZoneAsync indicates an expected call of EnableOutOfBandManagementForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) EnableOutOfBandManagementForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableOutOfBandManagementForZoneAsync), ctx, p)
+}
+
+// EnableOutOfBandManagementForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) EnableOutOfBandManagementForZoneWithContext(ctx context.Context, p *EnableOutOfBandManagementForZoneParams) (*EnableOutOfBandManagementForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "EnableOutOfBandManagementForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*EnableOutOfBandManagementForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// EnableOutOfBandManagementForZoneWithContext indicates an expected call of EnableOutOfBandManagementForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) EnableOutOfBandManagementForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "EnableOutOfBandManagementForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).EnableOutOfBandManagementForZoneWithContext), ctx, p)
+}
+
 // GetIpv4SubnetsForZoneByID mocks base method.
 func (m *MockZoneServiceIface) GetIpv4SubnetsForZoneByID(id string, opts ...OptionFunc) (*Ipv4SubnetsForZone, int, error) {
 	m.ctrl.T.Helper()
@@ -244,6 +531,27 @@ func (mr *MockZoneServiceIfaceMockRecorder) GetIpv4SubnetsForZoneByID(id any, op
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv4SubnetsForZoneByID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetIpv4SubnetsForZoneByID), varargs...)
 }
 
+// GetIpv4SubnetsForZoneByIDWithContext mocks base method.
+func (m *MockZoneServiceIface) GetIpv4SubnetsForZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Ipv4SubnetsForZone, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetIpv4SubnetsForZoneByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Ipv4SubnetsForZone)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetIpv4SubnetsForZoneByIDWithContext indicates an expected call of GetIpv4SubnetsForZoneByIDWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetIpv4SubnetsForZoneByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv4SubnetsForZoneByIDWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetIpv4SubnetsForZoneByIDWithContext), varargs...)
+}
+
 // GetVmwareDcID mocks base method.
 func (m *MockZoneServiceIface) GetVmwareDcID(keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
 	m.ctrl.T.Helper()
@@ -265,220 +573,925 @@ func (mr *MockZoneServiceIfaceMockRecorder) GetVmwareDcID(keyword, zoneid any, o
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVmwareDcID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetVmwareDcID), varargs...)
 }
 
-// GetZoneByID mocks base method.
-func (m *MockZoneServiceIface) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
+// GetVmwareDcIDWithContext mocks base method.
+func (m *MockZoneServiceIface) GetVmwareDcIDWithContext(ctx context.Context, keyword, zoneid string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, keyword, zoneid}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetVmwareDcIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetVmwareDcIDWithContext indicates an expected call of GetVmwareDcIDWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetVmwareDcIDWithContext(ctx, keyword, zoneid any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, keyword, zoneid}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetVmwareDcIDWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetVmwareDcIDWithContext), varargs...)
+}
+
+// GetZoneByID mocks base method.
+func (m *MockZoneServiceIface) GetZoneByID(id string, opts ...OptionFunc) (*Zone, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneByID", varargs...)
+	ret0, _ := ret[0].(*Zone)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneByID indicates an expected call of GetZoneByID.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByID), varargs...)
+}
+
+// GetZoneByIDWithContext mocks base method.
+func (m *MockZoneServiceIface) GetZoneByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*Zone, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*Zone)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneByIDWithContext indicates an expected call of GetZoneByIDWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByIDWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByIDWithContext), varargs...)
+}
+
+// GetZoneByName mocks base method.
+func (m *MockZoneServiceIface) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneByName", varargs...)
+	ret0, _ := ret[0].(*Zone)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneByName indicates an expected call of GetZoneByName.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByName", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByName), varargs...)
+}
+
+// GetZoneByNameWithContext mocks base method.
+func (m *MockZoneServiceIface) GetZoneByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*Zone, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*Zone)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneByNameWithContext indicates an expected call of GetZoneByNameWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByNameWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByNameWithContext), varargs...)
+}
+
+// GetZoneID mocks base method.
+func (m *MockZoneServiceIface) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneID indicates an expected call of GetZoneID.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneID), varargs...)
+}
+
+// GetZoneIDWithContext mocks base method.
+func (m *MockZoneServiceIface) GetZoneIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZoneIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZoneIDWithContext indicates an expected call of GetZoneIDWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZoneIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneIDWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneIDWithContext), varargs...)
+}
+
+// GetZonesMetricByID mocks base method.
+func (m *MockZoneServiceIface) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZonesMetricByID", varargs...)
+	ret0, _ := ret[0].(*ZonesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZonesMetricByID indicates an expected call of GetZonesMetricByID.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricByID(id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricByID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricByID), varargs...)
+}
+
+// GetZonesMetricByIDWithContext mocks base method.
+func (m *MockZoneServiceIface) GetZonesMetricByIDWithContext(ctx context.Context, id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, id}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZonesMetricByIDWithContext", varargs...)
+	ret0, _ := ret[0].(*ZonesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZonesMetricByIDWithContext indicates an expected call of GetZonesMetricByIDWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricByIDWithContext(ctx, id any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, id}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricByIDWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricByIDWithContext), varargs...)
+}
+
+// GetZonesMetricByName mocks base method.
+func (m *MockZoneServiceIface) GetZonesMetricByName(name string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZonesMetricByName", varargs...)
+	ret0, _ := ret[0].(*ZonesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZonesMetricByName indicates an expected call of GetZonesMetricByName.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricByName(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricByName", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricByName), varargs...)
+}
+
+// GetZonesMetricByNameWithContext mocks base method.
+func (m *MockZoneServiceIface) GetZonesMetricByNameWithContext(ctx context.Context, name string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZonesMetricByNameWithContext", varargs...)
+	ret0, _ := ret[0].(*ZonesMetric)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZonesMetricByNameWithContext indicates an expected call of GetZonesMetricByNameWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricByNameWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricByNameWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricByNameWithContext), varargs...)
+}
+
+// GetZonesMetricID mocks base method.
+func (m *MockZoneServiceIface) GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZonesMetricID", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZonesMetricID indicates an expected call of GetZonesMetricID.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricID(name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricID), varargs...)
+}
+
+// GetZonesMetricIDWithContext mocks base method.
+func (m *MockZoneServiceIface) GetZonesMetricIDWithContext(ctx context.Context, name string, opts ...OptionFunc) (string, int, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, name}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetZonesMetricIDWithContext", varargs...)
+	ret0, _ := ret[0].(string)
+	ret1, _ := ret[1].(int)
+	ret2, _ := ret[2].(error)
+	return ret0, ret1, ret2
+}
+
+// GetZonesMetricIDWithContext indicates an expected call of GetZonesMetricIDWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricIDWithContext(ctx, name any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, name}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricIDWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricIDWithContext), varargs...)
+}
+
+// ListDedicatedZones mocks base method.
+func (m *MockZoneServiceIface) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedZones", p)
+	ret0, _ := ret[0].(*ListDedicatedZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedZones indicates an expected call of ListDedicatedZones.
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZones(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZones), p)
+}
+
+// ListDedicatedZonesAll mocks base method.
+func (m *MockZoneServiceIface) ListDedicatedZonesAll(p *ListDedicatedZonesParams, opts ...PageOption) ([]*DedicatedZone, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedZonesAll", varargs...)
+	ret0, _ := ret[0].([]*DedicatedZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedZonesAll indicates an expected call of ListDedicatedZonesAll.
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZonesAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZonesAll", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZonesAll), varargs...)
+}
+
+// ListDedicatedZonesAllWithContext mocks base method.
+func (m *MockZoneServiceIface) ListDedicatedZonesAllWithContext(ctx context.Context, p *ListDedicatedZonesParams, opts ...PageOption) ([]*DedicatedZone, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedZonesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*DedicatedZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedZonesAllWithContext indicates an expected call of ListDedicatedZonesAllWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZonesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZonesAllWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZonesAllWithContext), varargs...)
+}
+
+// ListDedicatedZonesIter mocks base method.
+func (m *MockZoneServiceIface) ListDedicatedZonesIter(p *ListDedicatedZonesParams, opts ...PageOption) iter.Seq2[*DedicatedZone, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedZonesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedZone, error])
+	return ret0
+}
+
+// ListDedicatedZonesIter indicates an expected call of ListDedicatedZonesIter.
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZonesIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZonesIter", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZonesIter), varargs...)
+}
+
+// ListDedicatedZonesIterWithContext mocks base method.
+func (m *MockZoneServiceIface) ListDedicatedZonesIterWithContext(ctx context.Context, p *ListDedicatedZonesParams, opts ...PageOption) iter.Seq2[*DedicatedZone, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListDedicatedZonesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*DedicatedZone, error])
+	return ret0
+}
+
+// ListDedicatedZonesIterWithContext indicates an expected call of ListDedicatedZonesIterWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZonesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZonesIterWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZonesIterWithContext), varargs...)
+}
+
+// ListDedicatedZonesWithContext mocks base method.
+func (m *MockZoneServiceIface) ListDedicatedZonesWithContext(ctx context.Context, p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListDedicatedZonesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListDedicatedZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListDedicatedZonesWithContext indicates an expected call of ListDedicatedZonesWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZonesWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZonesWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZonesWithContext), ctx, p)
+}
+
+// ListIpv4SubnetsForZone mocks base method.
+func (m *MockZoneServiceIface) ListIpv4SubnetsForZone(p *ListIpv4SubnetsForZoneParams) (*ListIpv4SubnetsForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForZone", p)
+	ret0, _ := ret[0].(*ListIpv4SubnetsForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForZone indicates an expected call of ListIpv4SubnetsForZone.
+func (mr *MockZoneServiceIfaceMockRecorder) ListIpv4SubnetsForZone(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).ListIpv4SubnetsForZone), p)
+}
+
+// ListIpv4SubnetsForZoneAll mocks base method.
+func (m *MockZoneServiceIface) ListIpv4SubnetsForZoneAll(p *ListIpv4SubnetsForZoneParams, opts ...PageOption) ([]*Ipv4SubnetsForZone, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForZoneAll", varargs...)
+	ret0, _ := ret[0].([]*Ipv4SubnetsForZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForZoneAll indicates an expected call of ListIpv4SubnetsForZoneAll.
+func (mr *MockZoneServiceIfaceMockRecorder) ListIpv4SubnetsForZoneAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForZoneAll", reflect.TypeOf((*MockZoneServiceIface)(nil).ListIpv4SubnetsForZoneAll), varargs...)
+}
+
+// ListIpv4SubnetsForZoneAllWithContext mocks base method.
+func (m *MockZoneServiceIface) ListIpv4SubnetsForZoneAllWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams, opts ...PageOption) ([]*Ipv4SubnetsForZone, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForZoneAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Ipv4SubnetsForZone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForZoneAllWithContext indicates an expected call of ListIpv4SubnetsForZoneAllWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListIpv4SubnetsForZoneAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForZoneAllWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListIpv4SubnetsForZoneAllWithContext), varargs...)
+}
+
+// ListIpv4SubnetsForZoneIter mocks base method.
+func (m *MockZoneServiceIface) ListIpv4SubnetsForZoneIter(p *ListIpv4SubnetsForZoneParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForZone, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForZoneIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Ipv4SubnetsForZone, error])
+	return ret0
+}
+
+// ListIpv4SubnetsForZoneIter indicates an expected call of ListIpv4SubnetsForZoneIter.
+func (mr *MockZoneServiceIfaceMockRecorder) ListIpv4SubnetsForZoneIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForZoneIter", reflect.TypeOf((*MockZoneServiceIface)(nil).ListIpv4SubnetsForZoneIter), varargs...)
+}
+
+// ListIpv4SubnetsForZoneIterWithContext mocks base method.
+func (m *MockZoneServiceIface) ListIpv4SubnetsForZoneIterWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams, opts ...PageOption) iter.Seq2[*Ipv4SubnetsForZone, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForZoneIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Ipv4SubnetsForZone, error])
+	return ret0
+}
+
+// ListIpv4SubnetsForZoneIterWithContext indicates an expected call of ListIpv4SubnetsForZoneIterWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListIpv4SubnetsForZoneIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForZoneIterWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListIpv4SubnetsForZoneIterWithContext), varargs...)
+}
+
+// ListIpv4SubnetsForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) ListIpv4SubnetsForZoneWithContext(ctx context.Context, p *ListIpv4SubnetsForZoneParams) (*ListIpv4SubnetsForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv4SubnetsForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListIpv4SubnetsForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv4SubnetsForZoneWithContext indicates an expected call of ListIpv4SubnetsForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListIpv4SubnetsForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListIpv4SubnetsForZoneWithContext), ctx, p)
+}
+
+// ListVmwareDcVms mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcVms(p *ListVmwareDcVmsParams) (*ListVmwareDcVmsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVmwareDcVms", p)
+	ret0, _ := ret[0].(*ListVmwareDcVmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcVms indicates an expected call of ListVmwareDcVms.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcVms(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcVms", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcVms), p)
+}
+
+// ListVmwareDcVmsAll mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcVmsAll(p *ListVmwareDcVmsParams, opts ...PageOption) ([]*VmwareDcVm, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmwareDcVmsAll", varargs...)
+	ret0, _ := ret[0].([]*VmwareDcVm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcVmsAll indicates an expected call of ListVmwareDcVmsAll.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcVmsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcVmsAll", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcVmsAll), varargs...)
+}
+
+// ListVmwareDcVmsAllWithContext mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcVmsAllWithContext(ctx context.Context, p *ListVmwareDcVmsParams, opts ...PageOption) ([]*VmwareDcVm, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmwareDcVmsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VmwareDcVm)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcVmsAllWithContext indicates an expected call of ListVmwareDcVmsAllWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcVmsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcVmsAllWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcVmsAllWithContext), varargs...)
+}
+
+// ListVmwareDcVmsIter mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcVmsIter(p *ListVmwareDcVmsParams, opts ...PageOption) iter.Seq2[*VmwareDcVm, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmwareDcVmsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VmwareDcVm, error])
+	return ret0
+}
+
+// ListVmwareDcVmsIter indicates an expected call of ListVmwareDcVmsIter.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcVmsIter(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcVmsIter", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcVmsIter), varargs...)
+}
+
+// ListVmwareDcVmsIterWithContext mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcVmsIterWithContext(ctx context.Context, p *ListVmwareDcVmsParams, opts ...PageOption) iter.Seq2[*VmwareDcVm, error] {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmwareDcVmsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VmwareDcVm, error])
+	return ret0
+}
+
+// ListVmwareDcVmsIterWithContext indicates an expected call of ListVmwareDcVmsIterWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcVmsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcVmsIterWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcVmsIterWithContext), varargs...)
+}
+
+// ListVmwareDcVmsWithContext mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcVmsWithContext(ctx context.Context, p *ListVmwareDcVmsParams) (*ListVmwareDcVmsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVmwareDcVmsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVmwareDcVmsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcVmsWithContext indicates an expected call of ListVmwareDcVmsWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcVmsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcVmsWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcVmsWithContext), ctx, p)
+}
+
+// ListVmwareDcs mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListVmwareDcs", p)
+	ret0, _ := ret[0].(*ListVmwareDcsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcs indicates an expected call of ListVmwareDcs.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcs(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcs", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcs), p)
+}
+
+// ListVmwareDcsAll mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcsAll(p *ListVmwareDcsParams, opts ...PageOption) ([]*VmwareDc, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmwareDcsAll", varargs...)
+	ret0, _ := ret[0].([]*VmwareDc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcsAll indicates an expected call of ListVmwareDcsAll.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcsAll(p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcsAll", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcsAll), varargs...)
+}
+
+// ListVmwareDcsAllWithContext mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcsAllWithContext(ctx context.Context, p *ListVmwareDcsParams, opts ...PageOption) ([]*VmwareDc, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListVmwareDcsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*VmwareDc)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcsAllWithContext indicates an expected call of ListVmwareDcsAllWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcsAllWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcsAllWithContext), varargs...)
+}
+
+// ListVmwareDcsIter mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcsIter(p *ListVmwareDcsParams, opts ...PageOption) iter.Seq2[*VmwareDc, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetZoneByID", varargs...)
-	ret0, _ := ret[0].(*Zone)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVmwareDcsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VmwareDc, error])
+	return ret0
 }
 
-// GetZoneByID indicates an expected call of GetZoneByID.
-func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByID(id any, opts ...any) *gomock.Call {
+// ListVmwareDcsIter indicates an expected call of ListVmwareDcsIter.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcsIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcsIter", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcsIter), varargs...)
 }
 
-// GetZoneByName mocks base method.
-func (m *MockZoneServiceIface) GetZoneByName(name string, opts ...OptionFunc) (*Zone, int, error) {
+// ListVmwareDcsIterWithContext mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcsIterWithContext(ctx context.Context, p *ListVmwareDcsParams, opts ...PageOption) iter.Seq2[*VmwareDc, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetZoneByName", varargs...)
-	ret0, _ := ret[0].(*Zone)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListVmwareDcsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*VmwareDc, error])
+	return ret0
 }
 
-// GetZoneByName indicates an expected call of GetZoneByName.
-func (mr *MockZoneServiceIfaceMockRecorder) GetZoneByName(name any, opts ...any) *gomock.Call {
+// ListVmwareDcsIterWithContext indicates an expected call of ListVmwareDcsIterWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneByName", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneByName), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcsIterWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcsIterWithContext), varargs...)
 }
 
-// GetZoneID mocks base method.
-func (m *MockZoneServiceIface) GetZoneID(name string, opts ...OptionFunc) (string, int, error) {
+// ListVmwareDcsWithContext mocks base method.
+func (m *MockZoneServiceIface) ListVmwareDcsWithContext(ctx context.Context, p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	ret := m.ctrl.Call(m, "ListVmwareDcsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListVmwareDcsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListVmwareDcsWithContext indicates an expected call of ListVmwareDcsWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcsWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcsWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcsWithContext), ctx, p)
+}
+
+// ListZones mocks base method.
+func (m *MockZoneServiceIface) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZones", p)
+	ret0, _ := ret[0].(*ListZonesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListZones indicates an expected call of ListZones.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZones(p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZones), p)
+}
+
+// ListZonesAll mocks base method.
+func (m *MockZoneServiceIface) ListZonesAll(p *ListZonesParams, opts ...PageOption) ([]*Zone, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetZoneID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListZonesAll", varargs...)
+	ret0, _ := ret[0].([]*Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetZoneID indicates an expected call of GetZoneID.
-func (mr *MockZoneServiceIfaceMockRecorder) GetZoneID(name any, opts ...any) *gomock.Call {
+// ListZonesAll indicates an expected call of ListZonesAll.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZoneID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZoneID), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesAll", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesAll), varargs...)
 }
 
-// GetZonesMetricByID mocks base method.
-func (m *MockZoneServiceIface) GetZonesMetricByID(id string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+// ListZonesAllWithContext mocks base method.
+func (m *MockZoneServiceIface) ListZonesAllWithContext(ctx context.Context, p *ListZonesParams, opts ...PageOption) ([]*Zone, error) {
 	m.ctrl.T.Helper()
-	varargs := []any{id}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetZonesMetricByID", varargs...)
-	ret0, _ := ret[0].(*ZonesMetric)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListZonesAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*Zone)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
 }
 
-// GetZonesMetricByID indicates an expected call of GetZonesMetricByID.
-func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricByID(id any, opts ...any) *gomock.Call {
+// ListZonesAllWithContext indicates an expected call of ListZonesAllWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{id}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricByID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricByID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesAllWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesAllWithContext), varargs...)
 }
 
-// GetZonesMetricByName mocks base method.
-func (m *MockZoneServiceIface) GetZonesMetricByName(name string, opts ...OptionFunc) (*ZonesMetric, int, error) {
+// ListZonesIter mocks base method.
+func (m *MockZoneServiceIface) ListZonesIter(p *ListZonesParams, opts ...PageOption) iter.Seq2[*Zone, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetZonesMetricByName", varargs...)
-	ret0, _ := ret[0].(*ZonesMetric)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListZonesIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Zone, error])
+	return ret0
 }
 
-// GetZonesMetricByName indicates an expected call of GetZonesMetricByName.
-func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricByName(name any, opts ...any) *gomock.Call {
+// ListZonesIter indicates an expected call of ListZonesIter.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricByName", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricByName), varargs...)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesIter", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesIter), varargs...)
 }
 
-// GetZonesMetricID mocks base method.
-func (m *MockZoneServiceIface) GetZonesMetricID(name string, opts ...OptionFunc) (string, int, error) {
+// ListZonesIterWithContext mocks base method.
+func (m *MockZoneServiceIface) ListZonesIterWithContext(ctx context.Context, p *ListZonesParams, opts ...PageOption) iter.Seq2[*Zone, error] {
 	m.ctrl.T.Helper()
-	varargs := []any{name}
+	varargs := []any{ctx, p}
 	for _, a := range opts {
 		varargs = append(varargs, a)
 	}
-	ret := m.ctrl.Call(m, "GetZonesMetricID", varargs...)
-	ret0, _ := ret[0].(string)
-	ret1, _ := ret[1].(int)
-	ret2, _ := ret[2].(error)
-	return ret0, ret1, ret2
+	ret := m.ctrl.Call(m, "ListZonesIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*Zone, error])
+	return ret0
 }
 
-// GetZonesMetricID indicates an expected call of GetZonesMetricID.
-func (mr *MockZoneServiceIfaceMockRecorder) GetZonesMetricID(name any, opts ...any) *gomock.Call {
+// ListZonesIterWithContext indicates an expected call of ListZonesIterWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesIterWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	varargs := append([]any{name}, opts...)
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetZonesMetricID", reflect.TypeOf((*MockZoneServiceIface)(nil).GetZonesMetricID), varargs...)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesIterWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesIterWithContext), varargs...)
 }
 
-// ListDedicatedZones mocks base method.
-func (m *MockZoneServiceIface) ListDedicatedZones(p *ListDedicatedZonesParams) (*ListDedicatedZonesResponse, error) {
+// ListZonesMetrics mocks base method.
+func (m *MockZoneServiceIface) ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListDedicatedZones", p)
-	ret0, _ := ret[0].(*ListDedicatedZonesResponse)
+	ret := m.ctrl.Call(m, "ListZonesMetrics", p)
+	ret0, _ := ret[0].(*ListZonesMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListDedicatedZones indicates an expected call of ListDedicatedZones.
-func (mr *MockZoneServiceIfaceMockRecorder) ListDedicatedZones(p any) *gomock.Call {
+// ListZonesMetrics indicates an expected call of ListZonesMetrics.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetrics(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListDedicatedZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListDedicatedZones), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetrics", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetrics), p)
 }
 
-// ListIpv4SubnetsForZone mocks base method.
-func (m *MockZoneServiceIface) ListIpv4SubnetsForZone(p *ListIpv4SubnetsForZoneParams) (*ListIpv4SubnetsForZoneResponse, error) {
+// ListZonesMetricsAll mocks base method.
+func (m *MockZoneServiceIface) ListZonesMetricsAll(p *ListZonesMetricsParams, opts ...PageOption) ([]*ZonesMetric, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListIpv4SubnetsForZone", p)
-	ret0, _ := ret[0].(*ListIpv4SubnetsForZoneResponse)
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListZonesMetricsAll", varargs...)
+	ret0, _ := ret[0].([]*ZonesMetric)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListIpv4SubnetsForZone indicates an expected call of ListIpv4SubnetsForZone.
-func (mr *MockZoneServiceIfaceMockRecorder) ListIpv4SubnetsForZone(p any) *gomock.Call {
+// ListZonesMetricsAll indicates an expected call of ListZonesMetricsAll.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetricsAll(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv4SubnetsForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).ListIpv4SubnetsForZone), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetricsAll", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetricsAll), varargs...)
 }
 
-// ListVmwareDcVms mocks base method.
-func (m *MockZoneServiceIface) ListVmwareDcVms(p *ListVmwareDcVmsParams) (*ListVmwareDcVmsResponse, error) {
+// ListZonesMetricsAllWithContext mocks base method.
+func (m *MockZoneServiceIface) ListZonesMetricsAllWithContext(ctx context.Context, p *ListZonesMetricsParams, opts ...PageOption) ([]*ZonesMetric, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVmwareDcVms", p)
-	ret0, _ := ret[0].(*ListVmwareDcVmsResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListZonesMetricsAllWithContext", varargs...)
+	ret0, _ := ret[0].([]*ZonesMetric)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListVmwareDcVms indicates an expected call of ListVmwareDcVms.
-func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcVms(p any) *gomock.Call {
+// ListZonesMetricsAllWithContext indicates an expected call of ListZonesMetricsAllWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetricsAllWithContext(ctx, p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcVms", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcVms), p)
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetricsAllWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetricsAllWithContext), varargs...)
 }
 
-// ListVmwareDcs mocks base method.
-func (m *MockZoneServiceIface) ListVmwareDcs(p *ListVmwareDcsParams) (*ListVmwareDcsResponse, error) {
+// ListZonesMetricsIter mocks base method.
+func (m *MockZoneServiceIface) ListZonesMetricsIter(p *ListZonesMetricsParams, opts ...PageOption) iter.Seq2[*ZonesMetric, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListVmwareDcs", p)
-	ret0, _ := ret[0].(*ListVmwareDcsResponse)
-	ret1, _ := ret[1].(error)
-	return ret0, ret1
+	varargs := []any{p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListZonesMetricsIter", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ZonesMetric, error])
+	return ret0
 }
 
-// ListVmwareDcs indicates an expected call of ListVmwareDcs.
-func (mr *MockZoneServiceIfaceMockRecorder) ListVmwareDcs(p any) *gomock.Call {
+// ListZonesMetricsIter indicates an expected call of ListZonesMetricsIter.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetricsIter(p any, opts ...any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListVmwareDcs", reflect.TypeOf((*MockZoneServiceIface)(nil).ListVmwareDcs), p)
+	varargs := append([]any{p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetricsIter", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetricsIter), varargs...)
 }
 
-// ListZones mocks base method.
-func (m *MockZoneServiceIface) ListZones(p *ListZonesParams) (*ListZonesResponse, error) {
+// ListZonesMetricsIterWithContext mocks base method.
+func (m *MockZoneServiceIface) ListZonesMetricsIterWithContext(ctx context.Context, p *ListZonesMetricsParams, opts ...PageOption) iter.Seq2[*ZonesMetric, error] {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListZones", p)
-	ret0, _ := ret[0].(*ListZonesResponse)
+	varargs := []any{ctx, p}
+	for _, a := range opts {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "ListZonesMetricsIterWithContext", varargs...)
+	ret0, _ := ret[0].(iter.Seq2[*ZonesMetric, error])
+	return ret0
+}
+
+// ListZonesMetricsIterWithContext indicates an expected call of ListZonesMetricsIterWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetricsIterWithContext(ctx, p any, opts ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, p}, opts...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetricsIterWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetricsIterWithContext), varargs...)
+}
+
+// ListZonesMetricsWithContext mocks base method.
+func (m *MockZoneServiceIface) ListZonesMetricsWithContext(ctx context.Context, p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListZonesMetricsWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListZonesMetricsResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListZones indicates an expected call of ListZones.
-func (mr *MockZoneServiceIfaceMockRecorder) ListZones(p any) *gomock.Call {
+// ListZonesMetricsWithContext indicates an expected call of ListZonesMetricsWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetricsWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZones", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZones), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetricsWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetricsWithContext), ctx, p)
 }
 
-// ListZonesMetrics mocks base method.
-func (m *MockZoneServiceIface) ListZonesMetrics(p *ListZonesMetricsParams) (*ListZonesMetricsResponse, error) {
+// ListZonesWithContext mocks base method.
+func (m *MockZoneServiceIface) ListZonesWithContext(ctx context.Context, p *ListZonesParams) (*ListZonesResponse, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "ListZonesMetrics", p)
-	ret0, _ := ret[0].(*ListZonesMetricsResponse)
+	ret := m.ctrl.Call(m, "ListZonesWithContext", ctx, p)
+	ret0, _ := ret[0].(*ListZonesResponse)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// ListZonesMetrics indicates an expected call of ListZonesMetrics.
-func (mr *MockZoneServiceIfaceMockRecorder) ListZonesMetrics(p any) *gomock.Call {
+// ListZonesWithContext indicates an expected call of ListZonesWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ListZonesWithContext(ctx, p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesMetrics", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesMetrics), p)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListZonesWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ListZonesWithContext), ctx, p)
 }
 
 // NewAddVmwareDcParams mocks base method.
@@ -818,6 +1831,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) ReleaseDedicatedZone(p any) *gomock.
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedZone", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseDedicatedZone), p)
 }
 
+// ReleaseDedicatedZoneAsync mocks base method.
+func (m *MockZoneServiceIface) ReleaseDedicatedZoneAsync(ctx context.Context, p *ReleaseDedicatedZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedZoneAsync indicates an expected call of ReleaseDedicatedZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) ReleaseDedicatedZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseDedicatedZoneAsync), ctx, p)
+}
+
+// ReleaseDedicatedZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) ReleaseDedicatedZoneWithContext(ctx context.Context, p *ReleaseDedicatedZoneParams) (*ReleaseDedicatedZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseDedicatedZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseDedicatedZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseDedicatedZoneWithContext indicates an expected call of ReleaseDedicatedZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ReleaseDedicatedZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseDedicatedZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseDedicatedZoneWithContext), ctx, p)
+}
+
 // ReleaseIpv4SubnetForZone mocks base method.
 func (m *MockZoneServiceIface) ReleaseIpv4SubnetForZone(p *ReleaseIpv4SubnetForZoneParams) (*ReleaseIpv4SubnetForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -833,6 +1876,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) ReleaseIpv4SubnetForZone(p any) *gom
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIpv4SubnetForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseIpv4SubnetForZone), p)
 }
 
+// ReleaseIpv4SubnetForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) ReleaseIpv4SubnetForZoneAsync(ctx context.Context, p *ReleaseIpv4SubnetForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIpv4SubnetForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseIpv4SubnetForZoneAsync indicates an expected call of ReleaseIpv4SubnetForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) ReleaseIpv4SubnetForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIpv4SubnetForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseIpv4SubnetForZoneAsync), ctx, p)
+}
+
+// ReleaseIpv4SubnetForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) ReleaseIpv4SubnetForZoneWithContext(ctx context.Context, p *ReleaseIpv4SubnetForZoneParams) (*ReleaseIpv4SubnetForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseIpv4SubnetForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*ReleaseIpv4SubnetForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ReleaseIpv4SubnetForZoneWithContext indicates an expected call of ReleaseIpv4SubnetForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) ReleaseIpv4SubnetForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseIpv4SubnetForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).ReleaseIpv4SubnetForZoneWithContext), ctx, p)
+}
+
 // RemoveVmwareDc mocks base method.
 func (m *MockZoneServiceIface) RemoveVmwareDc(p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
 	m.ctrl.T.Helper()
@@ -848,6 +1921,21 @@ func (mr *MockZoneServiceIfaceMockRecorder) RemoveVmwareDc(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVmwareDc", reflect.TypeOf((*MockZoneServiceIface)(nil).RemoveVmwareDc), p)
 }
 
+// RemoveVmwareDcWithContext mocks base method.
+func (m *MockZoneServiceIface) RemoveVmwareDcWithContext(ctx context.Context, p *RemoveVmwareDcParams) (*RemoveVmwareDcResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RemoveVmwareDcWithContext", ctx, p)
+	ret0, _ := ret[0].(*RemoveVmwareDcResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RemoveVmwareDcWithContext indicates an expected call of RemoveVmwareDcWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) RemoveVmwareDcWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RemoveVmwareDcWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).RemoveVmwareDcWithContext), ctx, p)
+}
+
 // UpdateIpv4SubnetForZone mocks base method.
 func (m *MockZoneServiceIface) UpdateIpv4SubnetForZone(p *UpdateIpv4SubnetForZoneParams) (*UpdateIpv4SubnetForZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -863,6 +1951,36 @@ func (mr *MockZoneServiceIfaceMockRecorder) UpdateIpv4SubnetForZone(p any) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv4SubnetForZone", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateIpv4SubnetForZone), p)
 }
 
+// UpdateIpv4SubnetForZoneAsync mocks base method.
+func (m *MockZoneServiceIface) UpdateIpv4SubnetForZoneAsync(ctx context.Context, p *UpdateIpv4SubnetForZoneParams) (*Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIpv4SubnetForZoneAsync", ctx, p)
+	ret0, _ := ret[0].(*Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIpv4SubnetForZoneAsync indicates an expected call of UpdateIpv4SubnetForZoneAsync.
+func (mr *MockZoneServiceIfaceMockRecorder) UpdateIpv4SubnetForZoneAsync(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv4SubnetForZoneAsync", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateIpv4SubnetForZoneAsync), ctx, p)
+}
+
+// UpdateIpv4SubnetForZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) UpdateIpv4SubnetForZoneWithContext(ctx context.Context, p *UpdateIpv4SubnetForZoneParams) (*UpdateIpv4SubnetForZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateIpv4SubnetForZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateIpv4SubnetForZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateIpv4SubnetForZoneWithContext indicates an expected call of UpdateIpv4SubnetForZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) UpdateIpv4SubnetForZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateIpv4SubnetForZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateIpv4SubnetForZoneWithContext), ctx, p)
+}
+
 // UpdateVmwareDc mocks base method.
 func (m *MockZoneServiceIface) UpdateVmwareDc(p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error) {
 	m.ctrl.T.Helper()
@@ -878,6 +1996,21 @@ func (mr *MockZoneServiceIfaceMockRecorder) UpdateVmwareDc(p any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVmwareDc", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateVmwareDc), p)
 }
 
+// UpdateVmwareDcWithContext mocks base method.
+func (m *MockZoneServiceIface) UpdateVmwareDcWithContext(ctx context.Context, p *UpdateVmwareDcParams) (*UpdateVmwareDcResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateVmwareDcWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateVmwareDcResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateVmwareDcWithContext indicates an expected call of UpdateVmwareDcWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) UpdateVmwareDcWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateVmwareDcWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateVmwareDcWithContext), ctx, p)
+}
+
 // UpdateZone mocks base method.
 func (m *MockZoneServiceIface) UpdateZone(p *UpdateZoneParams) (*UpdateZoneResponse, error) {
 	m.ctrl.T.Helper()
@@ -892,3 +2025,18 @@ func (mr *MockZoneServiceIfaceMockRecorder) UpdateZone(p any) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZone", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateZone), p)
 }
+
+// UpdateZoneWithContext mocks base method.
+func (m *MockZoneServiceIface) UpdateZoneWithContext(ctx context.Context, p *UpdateZoneParams) (*UpdateZoneResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UpdateZoneWithContext", ctx, p)
+	ret0, _ := ret[0].(*UpdateZoneResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UpdateZoneWithContext indicates an expected call of UpdateZoneWithContext.
+func (mr *MockZoneServiceIfaceMockRecorder) UpdateZoneWithContext(ctx, p any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateZoneWithContext", reflect.TypeOf((*MockZoneServiceIface)(nil).UpdateZoneWithContext), ctx, p)
+}
@@ -21,6 +21,7 @@ package cloudstack
 
 import (
 	"bytes"
+	"context"
 	"crypto/hmac"
 	"crypto/sha1"
 	"crypto/tls"
@@ -45,7 +46,7 @@ import (
 // UnlimitedResourceID is a special ID to define an unlimited resource
 const UnlimitedResourceID = "-1"
 
-var idRegex = regexp.MustCompile(`^([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|-1)$`)
+var idRegex = regexp.MustCompile(`^(?i)([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|-1)$`)
 
 // IsID return true if the passed ID is either a UUID or a UnlimitedResourceID
 func IsID(id string) bool {
@@ -58,16 +59,6 @@ type ClientOption func(*CloudStackClient)
 // OptionFunc can be passed to the courtesy helper functions to set additional parameters
 type OptionFunc func(*CloudStackClient, interface{}) error
 
-type CSError struct {
-	ErrorCode   int    `json:"errorcode"`
-	CSErrorCode int    `json:"cserrorcode"`
-	ErrorText   string `json:"errortext"`
-}
-
-func (e *CSError) Error() error {
-	return fmt.Errorf("CloudStack API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
-}
-
 type UUID string
 
 func (c UUID) MarshalJSON() ([]byte, error) {
@@ -93,11 +84,22 @@ type CloudStackClient struct {
 
 	client  *http.Client // The http client for communicating
 	baseURL string       // The base URL of the API
-	apiKey  string       // Api key
-	secret  string       // Secret key
 	async   bool         // Wait for async calls to finish
 	options []OptionFunc // A list of option functions to apply to all API calls
 	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds
+	session *session     // Username/password session used instead of the api key and secret
+	poll    PollStrategy // Strategy deciding how long to wait between polls of async jobs
+	watcher *jobWatcher  // Shared watcher polling all async jobs at once; nil if not enabled
+	retry   *RetryPolicy // Policy for retrying failed requests; nil if not enabled
+	limiter *RateLimiter // Client side limiter of the rate of API calls; nil if not enabled
+
+	credentials    CredentialProvider // Provider of the API key and secret used to sign requests
+	middleware     []Middleware       // Middleware wrapping every API call, the first one being the outermost
+	logger         *clientLogger      // Logger for API calls and async jobs; nil if not enabled
+	tracing        *tracing           // Tracer for API calls and async jobs; nil if not enabled
+	metrics        Metrics            // Metrics of API calls and async jobs; nil if not enabled
+	discovery      apiDiscovery       // APIs and capabilities of the server, fetched when first needed
+	strictDecoding bool               // Fail calls whose responses don't match their generated type
 
 	APIDiscovery            APIDiscoveryServiceIface
 	ASNumberRange           ASNumberRangeServiceIface
@@ -219,12 +221,12 @@ func newClient(apiurl string, apikey string, secret string, async bool, verifyss
 			},
 			Timeout: time.Duration(60 * time.Second),
 		},
-		baseURL: apiurl,
-		apiKey:  apikey,
-		secret:  secret,
-		async:   async,
-		options: []OptionFunc{},
-		timeout: 300,
+		baseURL:     apiurl,
+		credentials: NewStaticCredentials(apikey, secret),
+		async:       async,
+		options:     []OptionFunc{},
+		timeout:     300,
+		poll:        defaultPollStrategy,
 	}
 
 	for _, fn := range options {
@@ -437,6 +439,691 @@ func newMockClient(ctrl *gomock.Controller) *CloudStackClient {
 	return cs
 }
 
+// The commands that start an async job
+var asyncCommands = map[string]bool{
+	"activateProject":                          true,
+	"addAccountToProject":                      true,
+	"addBaremetalDhcp":                         true,
+	"addBaremetalPxeKickStartServer":           true,
+	"addBaremetalPxePingServer":                true,
+	"addBaremetalRct":                          true,
+	"addBigSwitchBcfDevice":                    true,
+	"addBrocadeVcsDevice":                      true,
+	"addGloboDnsHost":                          true,
+	"addGuestOs":                               true,
+	"addGuestOsMapping":                        true,
+	"addIpToNic":                               true,
+	"addNetscalerLoadBalancer":                 true,
+	"addNetworkServiceProvider":                true,
+	"addNicToVirtualMachine":                   true,
+	"addNiciraNvpDevice":                       true,
+	"addNodesToKubernetesCluster":              true,
+	"addOpenDaylightController":                true,
+	"addPaloAltoFirewall":                      true,
+	"addResourceDetail":                        true,
+	"addTrafficType":                           true,
+	"addUserToProject":                         true,
+	"addVpnUser":                               true,
+	"archiveSnapshot":                          true,
+	"assignCertToLoadBalancer":                 true,
+	"assignToGlobalLoadBalancerRule":           true,
+	"assignToLoadBalancerRule":                 true,
+	"assignVirtualMachineToBackupOffering":     true,
+	"associateIpAddress":                       true,
+	"associateUcsProfileToBlade":               true,
+	"attachIso":                                true,
+	"attachVolume":                             true,
+	"authorizeSecurityGroupEgress":             true,
+	"authorizeSecurityGroupIngress":            true,
+	"cancelHostAsDegraded":                     true,
+	"cancelHostMaintenance":                    true,
+	"cancelStorageMaintenance":                 true,
+	"changeBgpPeersForNetwork":                 true,
+	"changeBgpPeersForVpc":                     true,
+	"changeOfferingForVolume":                  true,
+	"changeOutOfBandManagementPassword":        true,
+	"changeSharedFileSystemDiskOffering":       true,
+	"changeSharedFileSystemServiceOffering":    true,
+	"changeStoragePoolScope":                   true,
+	"checkVolume":                              true,
+	"cleanVMReservations":                      true,
+	"configureHAForHost":                       true,
+	"configureInternalLoadBalancerElement":     true,
+	"configureNetscalerLoadBalancer":           true,
+	"configureOvsElement":                      true,
+	"configurePaloAltoFirewall":                true,
+	"configureStorageAccess":                   true,
+	"configureVirtualRouterElement":            true,
+	"copyIso":                                  true,
+	"copySnapshot":                             true,
+	"copyTemplate":                             true,
+	"createAffinityGroup":                      true,
+	"createAutoScalePolicy":                    true,
+	"createAutoScaleVmGroup":                   true,
+	"createAutoScaleVmProfile":                 true,
+	"createBackup":                             true,
+	"createBgpPeer":                            true,
+	"createBucket":                             true,
+	"createCondition":                          true,
+	"createCounter":                            true,
+	"createEgressFirewallRule":                 true,
+	"createFirewallRule":                       true,
+	"createGlobalLoadBalancerRule":             true,
+	"createGuestNetworkIpv6Prefix":             true,
+	"createInternalLoadBalancerElement":        true,
+	"createIpForwardingRule":                   true,
+	"createIpv4SubnetForGuestNetwork":          true,
+	"createIpv4SubnetForZone":                  true,
+	"createIpv6FirewallRule":                   true,
+	"createKubernetesCluster":                  true,
+	"createLBHealthCheckPolicy":                true,
+	"createLBStickinessPolicy":                 true,
+	"createLoadBalancer":                       true,
+	"createLoadBalancerRule":                   true,
+	"createManagementNetworkIpRange":           true,
+	"createNetworkACL":                         true,
+	"createNetworkACLList":                     true,
+	"createPhysicalNetwork":                    true,
+	"createPortForwardingRule":                 true,
+	"createPortableIpRange":                    true,
+	"createPrivateGateway":                     true,
+	"createProject":                            true,
+	"createRemoteAccessVpn":                    true,
+	"createRoutingFirewallRule":                true,
+	"createServiceInstance":                    true,
+	"createSharedFileSystem":                   true,
+	"createSnapshot":                           true,
+	"createSnapshotFromVMSnapshot":             true,
+	"createStaticRoute":                        true,
+	"createStorageNetworkIpRange":              true,
+	"createTags":                               true,
+	"createTemplate":                           true,
+	"createVMFromBackup":                       true,
+	"createVMSnapshot":                         true,
+	"createVPC":                                true,
+	"createVPCOffering":                        true,
+	"createVirtualRouterElement":               true,
+	"createVolume":                             true,
+	"createVpnConnection":                      true,
+	"createVpnCustomerGateway":                 true,
+	"createVpnGateway":                         true,
+	"declareHostAsDegraded":                    true,
+	"dedicateBgpPeer":                          true,
+	"dedicateCluster":                          true,
+	"dedicateHost":                             true,
+	"dedicateIpv4SubnetForZone":                true,
+	"dedicatePod":                              true,
+	"dedicateZone":                             true,
+	"deleteAccount":                            true,
+	"deleteAccountFromProject":                 true,
+	"deleteAffinityGroup":                      true,
+	"deleteAutoScalePolicy":                    true,
+	"deleteAutoScaleVmGroup":                   true,
+	"deleteAutoScaleVmProfile":                 true,
+	"deleteBackup":                             true,
+	"deleteBaremetalRct":                       true,
+	"deleteBgpPeer":                            true,
+	"deleteBigSwitchBcfDevice":                 true,
+	"deleteBrocadeVcsDevice":                   true,
+	"deleteCondition":                          true,
+	"deleteCounter":                            true,
+	"deleteDomain":                             true,
+	"deleteEgressFirewallRule":                 true,
+	"deleteFirewallRule":                       true,
+	"deleteGlobalLoadBalancerRule":             true,
+	"deleteGuestNetworkIpv6Prefix":             true,
+	"deleteIpForwardingRule":                   true,
+	"deleteIpv4SubnetForGuestNetwork":          true,
+	"deleteIpv4SubnetForZone":                  true,
+	"deleteIpv6FirewallRule":                   true,
+	"deleteIso":                                true,
+	"deleteKubernetesCluster":                  true,
+	"deleteKubernetesSupportedVersion":         true,
+	"deleteLBHealthCheckPolicy":                true,
+	"deleteLBStickinessPolicy":                 true,
+	"deleteLoadBalancer":                       true,
+	"deleteLoadBalancerRule":                   true,
+	"deleteManagementNetworkIpRange":           true,
+	"deleteNetscalerLoadBalancer":              true,
+	"deleteNetwork":                            true,
+	"deleteNetworkACL":                         true,
+	"deleteNetworkACLList":                     true,
+	"deleteNetworkServiceProvider":             true,
+	"deleteNiciraNvpDevice":                    true,
+	"deleteOpenDaylightController":             true,
+	"deletePaloAltoFirewall":                   true,
+	"deletePhysicalNetwork":                    true,
+	"deletePortForwardingRule":                 true,
+	"deletePortableIpRange":                    true,
+	"deletePrivateGateway":                     true,
+	"deleteProject":                            true,
+	"deleteProjectInvitation":                  true,
+	"deleteRemoteAccessVpn":                    true,
+	"deleteRoutingFirewallRule":                true,
+	"deleteSnapshot":                           true,
+	"deleteStaticRoute":                        true,
+	"deleteStorageNetworkIpRange":              true,
+	"deleteTags":                               true,
+	"deleteTemplate":                           true,
+	"deleteTrafficType":                        true,
+	"deleteUserFromProject":                    true,
+	"deleteVMSnapshot":                         true,
+	"deleteVPC":                                true,
+	"deleteVPCOffering":                        true,
+	"deleteVnfTemplate":                        true,
+	"deleteVpnConnection":                      true,
+	"deleteVpnCustomerGateway":                 true,
+	"deleteVpnGateway":                         true,
+	"deployNetscalerVpx":                       true,
+	"deployVirtualMachine":                     true,
+	"deployVnfAppliance":                       true,
+	"destroyRouter":                            true,
+	"destroySharedFileSystem":                  true,
+	"destroySystemVm":                          true,
+	"destroyVirtualMachine":                    true,
+	"destroyVolume":                            true,
+	"detachIso":                                true,
+	"detachVolume":                             true,
+	"disableAccount":                           true,
+	"disableAutoScaleVmGroup":                  true,
+	"disableHAForCluster":                      true,
+	"disableHAForHost":                         true,
+	"disableHAForZone":                         true,
+	"disableOutOfBandManagementForCluster":     true,
+	"disableOutOfBandManagementForHost":        true,
+	"disableOutOfBandManagementForZone":        true,
+	"disableStaticNat":                         true,
+	"disableUser":                              true,
+	"disassociateIpAddress":                    true,
+	"downloadImageStoreObject":                 true,
+	"enableAutoScaleVmGroup":                   true,
+	"enableHAForCluster":                       true,
+	"enableHAForHost":                          true,
+	"enableHAForZone":                          true,
+	"enableOutOfBandManagementForCluster":      true,
+	"enableOutOfBandManagementForHost":         true,
+	"enableOutOfBandManagementForZone":         true,
+	"enableStorageMaintenance":                 true,
+	"executeClusterDrsPlan":                    true,
+	"expungeSharedFileSystem":                  true,
+	"expungeVirtualMachine":                    true,
+	"extractIso":                               true,
+	"extractSnapshot":                          true,
+	"extractTemplate":                          true,
+	"extractVolume":                            true,
+	"generateAlert":                            true,
+	"getDiagnosticsData":                       true,
+	"getHypervisorGuestOsNames":                true,
+	"importBackupOffering":                     true,
+	"importUnmanagedInstance":                  true,
+	"importVm":                                 true,
+	"importVolume":                             true,
+	"issueCertificate":                         true,
+	"issueOutOfBandManagementPowerAction":      true,
+	"markDefaultZoneForAccount":                true,
+	"migrateNetwork":                           true,
+	"migrateResourceToAnotherSecondaryStorage": true,
+	"migrateSecondaryStorageData":              true,
+	"migrateSystemVm":                          true,
+	"migrateVPC":                               true,
+	"migrateVirtualMachine":                    true,
+	"migrateVirtualMachineWithVolume":          true,
+	"migrateVolume":                            true,
+	"moveNetworkAclItem":                       true,
+	"notifyBaremetalProvisionDone":             true,
+	"patchSystemVm":                            true,
+	"prepareHostForMaintenance":                true,
+	"provisionCertificate":                     true,
+	"purgeExpungedResources":                   true,
+	"rebootRouter":                             true,
+	"rebootSystemVm":                           true,
+	"rebootVirtualMachine":                     true,
+	"reconnectHost":                            true,
+	"registerNetscalerControlCenter":           true,
+	"releaseBgpPeer":                           true,
+	"releaseDedicatedCluster":                  true,
+	"releaseDedicatedGuestVlanRange":           true,
+	"releaseDedicatedHost":                     true,
+	"releaseDedicatedPod":                      true,
+	"releaseDedicatedZone":                     true,
+	"releaseHostReservation":                   true,
+	"releaseIpv4SubnetForZone":                 true,
+	"removeCertFromLoadBalancer":               true,
+	"removeFromGlobalLoadBalancerRule":         true,
+	"removeFromLoadBalancerRule":               true,
+	"removeGuestOs":                            true,
+	"removeGuestOsMapping":                     true,
+	"removeIpFromNic":                          true,
+	"removeNicFromVirtualMachine":              true,
+	"removeNodesFromKubernetesCluster":         true,
+	"removeResourceDetail":                     true,
+	"removeVirtualMachineFromBackupOffering":   true,
+	"removeVpnUser":                            true,
+	"replaceNetworkACLList":                    true,
+	"resetPasswordForVirtualMachine":           true,
+	"resetSSHKeyForVirtualMachine":             true,
+	"resetVpnConnection":                       true,
+	"resizeVolume":                             true,
+	"restartNetwork":                           true,
+	"restartSharedFileSystem":                  true,
+	"restartVPC":                               true,
+	"restoreBackup":                            true,
+	"restoreVirtualMachine":                    true,
+	"restoreVolumeFromBackupAndAttachToVM":     true,
+	"revertSnapshot":                           true,
+	"revertToVMSnapshot":                       true,
+	"revokeCertificate":                        true,
+	"revokeSecurityGroupEgress":                true,
+	"revokeSecurityGroupIngress":               true,
+	"runCustomAction":                          true,
+	"runDiagnostics":                           true,
+	"scaleKubernetesCluster":                   true,
+	"scaleSystemVm":                            true,
+	"scaleVirtualMachine":                      true,
+	"startInternalLoadBalancerVM":              true,
+	"startKubernetesCluster":                   true,
+	"startRollingMaintenance":                  true,
+	"startRouter":                              true,
+	"startSharedFileSystem":                    true,
+	"startSystemVm":                            true,
+	"startVirtualMachine":                      true,
+	"stopInternalLoadBalancerVM":               true,
+	"stopKubernetesCluster":                    true,
+	"stopNetScalerVpx":                         true,
+	"stopRouter":                               true,
+	"stopSharedFileSystem":                     true,
+	"stopSystemVm":                             true,
+	"stopVirtualMachine":                       true,
+	"suspendProject":                           true,
+	"syncStoragePool":                          true,
+	"unmanageVirtualMachine":                   true,
+	"unmanageVolume":                           true,
+	"updateAutoScalePolicy":                    true,
+	"updateAutoScaleVmGroup":                   true,
+	"updateAutoScaleVmProfile":                 true,
+	"updateBgpPeer":                            true,
+	"updateCondition":                          true,
+	"updateDefaultNicForVirtualMachine":        true,
+	"updateEgressFirewallRule":                 true,
+	"updateFirewallRule":                       true,
+	"updateGlobalLoadBalancerRule":             true,
+	"updateGuestOs":                            true,
+	"updateGuestOsMapping":                     true,
+	"updateIpAddress":                          true,
+	"updateIpv4SubnetForZone":                  true,
+	"updateIpv6FirewallRule":                   true,
+	"updateLBHealthCheckPolicy":                true,
+	"updateLBStickinessPolicy":                 true,
+	"updateLoadBalancer":                       true,
+	"updateLoadBalancerRule":                   true,
+	"updateNetwork":                            true,
+	"updateNetworkACLItem":                     true,
+	"updateNetworkACLList":                     true,
+	"updateNetworkServiceProvider":             true,
+	"updatePhysicalNetwork":                    true,
+	"updatePodManagementNetworkIpRange":        true,
+	"updatePortForwardingRule":                 true,
+	"updateProject":                            true,
+	"updateProjectInvitation":                  true,
+	"updateRemoteAccessVpn":                    true,
+	"updateRoutingFirewallRule":                true,
+	"updateSnapshotPolicy":                     true,
+	"updateStorageNetworkIpRange":              true,
+	"updateTrafficType":                        true,
+	"updateVMAffinityGroup":                    true,
+	"updateVPC":                                true,
+	"updateVPCOffering":                        true,
+	"updateVmNicIp":                            true,
+	"updateVolume":                             true,
+	"updateVpnConnection":                      true,
+	"updateVpnCustomerGateway":                 true,
+	"updateVpnGateway":                         true,
+	"upgradeKubernetesCluster":                 true,
+	"uploadCustomCertificate":                  true,
+	"uploadVolume":                             true,
+}
+
+// The map parameters that are not encoded as name[i].key=k and name[i].value=v, by lowercase command and
+// parameter name
+var mapParamEncodings = map[string]map[string]mapEncoding{
+	"addcustomaction": {
+		"details": {},
+	},
+	"addsecondarystorage": {
+		"details": {},
+	},
+	"authorizesecuritygroupegress": {
+		"usersecuritygrouplist": {Key: "account", Value: "group"},
+	},
+	"authorizesecuritygroupingress": {
+		"usersecuritygrouplist": {Key: "account", Value: "group"},
+	},
+	"changeserviceforsystemvm": {
+		"details": {},
+	},
+	"changeserviceforvirtualmachine": {
+		"details": {},
+	},
+	"createaccount": {
+		"accountdetails": {ZeroIndex: true},
+	},
+	"createautoscalevmprofile": {
+		"otherdeployparams": {Key: "name", Value: "value"},
+		"userdatadetails":   {},
+	},
+	"createbgppeer": {
+		"details": {},
+	},
+	"creatediskoffering": {
+		"details": {},
+	},
+	"createextension": {
+		"details": {},
+	},
+	"createkubernetescluster": {
+		"cniconfigdetails": {},
+		"nodeofferings":    {Key: "node", Value: "offering"},
+		"nodetemplates":    {Key: "node", Value: "template"},
+	},
+	"createlbstickinesspolicy": {
+		"param": {Key: "name", Value: "value"},
+	},
+	"createnetworkoffering": {
+		"details":             {},
+		"serviceproviderlist": {Key: "service", Value: "provider"},
+	},
+	"createstoragepool": {
+		"details": {},
+	},
+	"createtemplate": {
+		"details": {},
+	},
+	"createvmfrombackup": {
+		"datadiskofferinglist": {Key: "disk", Value: "diskOffering"},
+		"details":              {},
+		"userdatadetails":      {},
+	},
+	"createvpcoffering": {
+		"serviceproviderlist": {Key: "service", Value: "provider"},
+	},
+	"deletetags": {
+		"tags": {Key: "key", Value: "value", OmitEmptyValue: true},
+	},
+	"deployvirtualmachine": {
+		"datadiskofferinglist": {Key: "disk", Value: "diskOffering"},
+		"details":              {},
+		"userdatadetails":      {},
+	},
+	"deployvnfappliance": {
+		"datadiskofferinglist": {Key: "disk", Value: "diskOffering"},
+		"details":              {},
+		"userdatadetails":      {},
+	},
+	"getuploadparamsfortemplate": {
+		"details": {},
+	},
+	"importunmanagedinstance": {
+		"datadiskofferinglist": {Key: "disk", Value: "diskOffering"},
+		"details":              {},
+		"nicipaddresslist":     {Key: "nic", Value: "ip4Address"},
+		"nicnetworklist":       {Key: "nic", Value: "network"},
+	},
+	"importvm": {
+		"details":        {ZeroIndex: true},
+		"nicnetworklist": {Key: "nic", Value: "network"},
+	},
+	"registerextension": {
+		"details": {},
+	},
+	"registertemplate": {
+		"details":         {ZeroIndex: true},
+		"externaldetails": {ZeroIndex: true},
+	},
+	"registervnftemplate": {
+		"details": {},
+	},
+	"resetuserdataforvirtualmachine": {
+		"userdatadetails": {},
+	},
+	"restorevirtualmachine": {
+		"details": {},
+	},
+	"scalekubernetescluster": {
+		"nodeofferings": {Key: "node", Value: "offering"},
+	},
+	"scalesystemvm": {
+		"details": {},
+	},
+	"scalevirtualmachine": {
+		"details": {},
+	},
+	"updateaccount": {
+		"accountdetails": {ZeroIndex: true},
+	},
+	"updateautoscalevmprofile": {
+		"otherdeployparams": {Key: "name", Value: "value"},
+		"userdatadetails":   {},
+	},
+	"updatebgppeer": {
+		"details": {},
+	},
+	"updatecustomaction": {
+		"details": {},
+	},
+	"updateextension": {
+		"details": {},
+	},
+	"updateiso": {
+		"details": {},
+	},
+	"updatestoragepool": {
+		"details": {},
+	},
+	"updatetemplate": {
+		"details": {ZeroIndex: true},
+	},
+	"updatevirtualmachine": {
+		"details":         {ZeroIndex: true},
+		"userdatadetails": {},
+	},
+	"updatevnftemplate": {
+		"details": {},
+	},
+}
+
+// HostResourceState is the resource state of a host. Newer servers may use values that are not known here.
+type HostResourceState string
+
+const (
+	HostResourceStateCreating                     HostResourceState = "Creating"
+	HostResourceStateEnabled                      HostResourceState = "Enabled"
+	HostResourceStateDisabled                     HostResourceState = "Disabled"
+	HostResourceStatePrepareForMaintenance        HostResourceState = "PrepareForMaintenance"
+	HostResourceStateErrorInPrepareForMaintenance HostResourceState = "ErrorInPrepareForMaintenance"
+	HostResourceStateErrorInMaintenance           HostResourceState = "ErrorInMaintenance"
+	HostResourceStateMaintenance                  HostResourceState = "Maintenance"
+	HostResourceStateDegraded                     HostResourceState = "Degraded"
+	HostResourceStateError                        HostResourceState = "Error"
+)
+
+// IsValid tells if the value is one of the known values of HostResourceState
+func (v HostResourceState) IsValid() bool {
+	switch v {
+	case HostResourceStateCreating, HostResourceStateEnabled, HostResourceStateDisabled, HostResourceStatePrepareForMaintenance, HostResourceStateErrorInPrepareForMaintenance, HostResourceStateErrorInMaintenance, HostResourceStateMaintenance, HostResourceStateDegraded, HostResourceStateError:
+		return true
+	}
+	return false
+}
+
+// HypervisorType is a hypervisor type. Newer servers may use values that are not known here.
+type HypervisorType string
+
+const (
+	HypervisorTypeKVM       HypervisorType = "KVM"
+	HypervisorTypeXenServer HypervisorType = "XenServer"
+	HypervisorTypeVMware    HypervisorType = "VMware"
+	HypervisorTypeHyperV    HypervisorType = "Hyperv"
+	HypervisorTypeLXC       HypervisorType = "LXC"
+	HypervisorTypeOvm       HypervisorType = "Ovm"
+	HypervisorTypeOvm3      HypervisorType = "Ovm3"
+	HypervisorTypeBareMetal HypervisorType = "BareMetal"
+	HypervisorTypeSimulator HypervisorType = "Simulator"
+	HypervisorTypeExternal  HypervisorType = "External"
+)
+
+// IsValid tells if the value is one of the known values of HypervisorType, ignoring case
+func (v HypervisorType) IsValid() bool {
+	switch strings.ToLower(string(v)) {
+	case "kvm", "xenserver", "vmware", "hyperv", "lxc", "ovm", "ovm3", "baremetal", "simulator", "external":
+		return true
+	}
+	return false
+}
+
+// IntervalType is the interval of a snapshot or backup schedule. Newer servers may use values that are not known here.
+type IntervalType string
+
+const (
+	IntervalTypeHourly  IntervalType = "HOURLY"
+	IntervalTypeDaily   IntervalType = "DAILY"
+	IntervalTypeWeekly  IntervalType = "WEEKLY"
+	IntervalTypeMonthly IntervalType = "MONTHLY"
+)
+
+// IsValid tells if the value is one of the known values of IntervalType, ignoring case
+func (v IntervalType) IsValid() bool {
+	switch strings.ToLower(string(v)) {
+	case "hourly", "daily", "weekly", "monthly":
+		return true
+	}
+	return false
+}
+
+// NetworkState is the state of a network. Newer servers may use values that are not known here.
+type NetworkState string
+
+const (
+	NetworkStateAllocated    NetworkState = "Allocated"
+	NetworkStateConfigured   NetworkState = "Configured"
+	NetworkStateImplementing NetworkState = "Implementing"
+	NetworkStateImplemented  NetworkState = "Implemented"
+	NetworkStateSetup        NetworkState = "Setup"
+	NetworkStateShutdown     NetworkState = "Shutdown"
+	NetworkStateDestroy      NetworkState = "Destroy"
+)
+
+// IsValid tells if the value is one of the known values of NetworkState
+func (v NetworkState) IsValid() bool {
+	switch v {
+	case NetworkStateAllocated, NetworkStateConfigured, NetworkStateImplementing, NetworkStateImplemented, NetworkStateSetup, NetworkStateShutdown, NetworkStateDestroy:
+		return true
+	}
+	return false
+}
+
+// Protocol is the protocol of a firewall, port forwarding, load balancer or network ACL rule. Newer servers may use values that are not known here.
+type Protocol string
+
+const (
+	ProtocolTCP      Protocol = "tcp"
+	ProtocolUDP      Protocol = "udp"
+	ProtocolICMP     Protocol = "icmp"
+	ProtocolAll      Protocol = "all"
+	ProtocolTCPProxy Protocol = "tcp-proxy"
+	ProtocolSSL      Protocol = "ssl"
+)
+
+// IsValid tells if the value is one of the known values of Protocol, ignoring case
+func (v Protocol) IsValid() bool {
+	switch strings.ToLower(string(v)) {
+	case "tcp", "udp", "icmp", "all", "tcp-proxy", "ssl":
+		return true
+	}
+	return false
+}
+
+// TemplateStatus is the status of a template. Newer servers may use values that are not known here.
+type TemplateStatus string
+
+const (
+	TemplateStatusDownloadComplete         TemplateStatus = "Download Complete"
+	TemplateStatusSuccessfullyInstalled    TemplateStatus = "Successfully Installed"
+	TemplateStatusInstallingTemplate       TemplateStatus = "Installing Template"
+	TemplateStatusBypassedSecondaryStorage TemplateStatus = "Bypassed Secondary Storage"
+	TemplateStatusNotUploaded              TemplateStatus = "Not Uploaded"
+	TemplateStatusUploadInProgress         TemplateStatus = "Upload in Progress"
+	TemplateStatusUploadComplete           TemplateStatus = "Upload Complete"
+)
+
+// IsValid tells if the value is one of the known values of TemplateStatus
+func (v TemplateStatus) IsValid() bool {
+	switch v {
+	case TemplateStatusDownloadComplete, TemplateStatusSuccessfullyInstalled, TemplateStatusInstallingTemplate, TemplateStatusBypassedSecondaryStorage, TemplateStatusNotUploaded, TemplateStatusUploadInProgress, TemplateStatusUploadComplete:
+		return true
+	}
+	return false
+}
+
+// VirtualMachineState is the state of a virtual machine. Newer servers may use values that are not known here.
+type VirtualMachineState string
+
+const (
+	VirtualMachineStateStarting  VirtualMachineState = "Starting"
+	VirtualMachineStateRunning   VirtualMachineState = "Running"
+	VirtualMachineStateStopping  VirtualMachineState = "Stopping"
+	VirtualMachineStateStopped   VirtualMachineState = "Stopped"
+	VirtualMachineStateMigrating VirtualMachineState = "Migrating"
+	VirtualMachineStateError     VirtualMachineState = "Error"
+	VirtualMachineStateUnknown   VirtualMachineState = "Unknown"
+	VirtualMachineStateShutdown  VirtualMachineState = "Shutdown"
+	VirtualMachineStateDestroyed VirtualMachineState = "Destroyed"
+	VirtualMachineStateExpunging VirtualMachineState = "Expunging"
+	VirtualMachineStateRestoring VirtualMachineState = "Restoring"
+	VirtualMachineStateBackingUp VirtualMachineState = "BackingUp"
+)
+
+// IsValid tells if the value is one of the known values of VirtualMachineState
+func (v VirtualMachineState) IsValid() bool {
+	switch v {
+	case VirtualMachineStateStarting, VirtualMachineStateRunning, VirtualMachineStateStopping, VirtualMachineStateStopped, VirtualMachineStateMigrating, VirtualMachineStateError, VirtualMachineStateUnknown, VirtualMachineStateShutdown, VirtualMachineStateDestroyed, VirtualMachineStateExpunging, VirtualMachineStateRestoring, VirtualMachineStateBackingUp:
+		return true
+	}
+	return false
+}
+
+// VolumeState is the state of a volume. Newer servers may use values that are not known here.
+type VolumeState string
+
+const (
+	VolumeStateAllocated          VolumeState = "Allocated"
+	VolumeStateCreating           VolumeState = "Creating"
+	VolumeStateReady              VolumeState = "Ready"
+	VolumeStateResizing           VolumeState = "Resizing"
+	VolumeStateMigrating          VolumeState = "Migrating"
+	VolumeStateCopying            VolumeState = "Copying"
+	VolumeStateSnapshotting       VolumeState = "Snapshotting"
+	VolumeStateRevertSnapshotting VolumeState = "RevertSnapshotting"
+	VolumeStateAttaching          VolumeState = "Attaching"
+	VolumeStateRestoring          VolumeState = "Restoring"
+	VolumeStateNotUploaded        VolumeState = "NotUploaded"
+	VolumeStateUploadOp           VolumeState = "UploadOp"
+	VolumeStateUploaded           VolumeState = "Uploaded"
+	VolumeStateUploadError        VolumeState = "UploadError"
+	VolumeStateUploadAbandoned    VolumeState = "UploadAbandoned"
+	VolumeStateDestroy            VolumeState = "Destroy"
+	VolumeStateExpunging          VolumeState = "Expunging"
+	VolumeStateExpunged           VolumeState = "Expunged"
+)
+
+// IsValid tells if the value is one of the known values of VolumeState
+func (v VolumeState) IsValid() bool {
+	switch v {
+	case VolumeStateAllocated, VolumeStateCreating, VolumeStateReady, VolumeStateResizing, VolumeStateMigrating, VolumeStateCopying, VolumeStateSnapshotting, VolumeStateRevertSnapshotting, VolumeStateAttaching, VolumeStateRestoring, VolumeStateNotUploaded, VolumeStateUploadOp, VolumeStateUploaded, VolumeStateUploadError, VolumeStateUploadAbandoned, VolumeStateDestroy, VolumeStateExpunging, VolumeStateExpunged:
+		return true
+	}
+	return false
+}
+
 // Default non-async client. So for async calls you need to implement and check the async job result yourself. When using
 // HTTPS with a self-signed certificate to connect to your CloudStack API, you would probably want to set 'verifyssl' to
 // false so the call ignores the SSL errors/warnings.
@@ -485,14 +1172,23 @@ var AsyncTimeoutErr = errors.New("Timeout while waiting for async job to finish"
 // A helper function that you can use to get the result of a running async job. If the job is not finished within the configured
 // timeout, the async job returns a AsyncTimeoutErr.
 func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {
-	var timer time.Duration
+	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)
+}
+
+// GetAsyncJobResultWithContext works like GetAsyncJobResult, but stops polling and returns the context error as
+// soon as ctx is cancelled or its deadline expires.
+func (cs *CloudStackClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {
+	if cs.watcher != nil {
+		return cs.watcher.jobResult(ctx, jobid, timeout)
+	}
+
 	currentTime := time.Now().Unix()
 
-	for {
+	for attempt := 1; ; attempt++ {
 		p := &QueryAsyncJobResultParams{}
 		p.p = make(map[string]interface{})
 		p.SetJobID(jobid)
-		r, err := cs.Asyncjob.QueryAsyncJobResult(p)
+		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)
 		if err != nil {
 			return nil, err
 		}
@@ -504,46 +1200,81 @@ func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json
 
 		// When the status is 2, the job has failed
 		if r.Jobstatus == 2 {
-			if r.Jobresulttype == "text" {
-				return nil, fmt.Errorf(string(r.Jobresult))
-			} else {
-				return nil, fmt.Errorf("Undefined error: %s", string(r.Jobresult))
-			}
+			return nil, newAsyncJobError(jobid, r)
 		}
 
 		if time.Now().Unix()-currentTime > timeout {
 			return nil, AsyncTimeoutErr
 		}
 
-		// Add an (extremely simple) exponential backoff like feature to prevent
-		// flooding the CloudStack API
-		if timer < 15 {
-			timer++
+		// Wait as long as the poll strategy tells us to prevent flooding the CloudStack API
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(cs.pollStrategy().Next(attempt)):
 		}
-
-		time.Sleep(timer * time.Second)
 	}
 }
 
 // Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if
 // no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS
 // error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {
-	return cs.newRawRequest(api, false, params)
+func (cs *CloudStackClient) newRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	return cs.newRawRequest(ctx, api, false, params)
 }
 
 // Execute the request against a CS API using POST. Will return the raw JSON data returned by the API and
 // nil if no error occurred. If the API returns an error the result will be nil and the HTTP error code
 // and CS error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CloudStackClient) newPostRequest(api string, params url.Values) (json.RawMessage, error) {
-	return cs.newRawRequest(api, true, params)
+func (cs *CloudStackClient) newPostRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {
+	return cs.newRawRequest(ctx, api, true, params)
 }
 
 // Execute a raw request against a CS API. Will return the raw JSON data returned by the API and nil if
 // no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS
 // error details. If a processing (code) error occurs the result will be nil and the generated error
-func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Values) (json.RawMessage, error) {
-	params.Set("apiKey", cs.apiKey)
+func (cs *CloudStackClient) newRawRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {
+	if err := cs.preflight(ctx, api, params); err != nil {
+		return nil, err
+	}
+
+	ctx, span := cs.startSpan(ctx, api, params)
+
+	var b json.RawMessage
+	var err error
+	if len(cs.middleware) > 0 {
+		b, err = cs.newMiddlewareRequest(ctx, api, post, params)
+	} else {
+		b, err = cs.execRawRequest(ctx, api, post, params)
+	}
+
+	cs.endSpan(ctx, span, api, b, err)
+	return b, err
+}
+
+// Execute a raw request against a CS API without passing it through the middleware of the client
+func (cs *CloudStackClient) execRawRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {
+	if creds, ok := ctx.Value(credentialsKey{}).(Credentials); ok {
+		return cs.newSignedRequest(ctx, api, post, params, creds)
+	}
+	if cs.session != nil {
+		return cs.newSessionRequest(ctx, api, post, params)
+	}
+	return cs.newCredentialsRequest(ctx, api, post, params)
+}
+
+// Sign a raw request with the given credentials and execute it against a CS API
+func (cs *CloudStackClient) newSignedRequest(ctx context.Context, api string, post bool, params url.Values, creds Credentials) (json.RawMessage, error) {
+	// Every attempt is signed again, so a retried request doesn't expire while backing off
+	return cs.doRequest(ctx, api, func() (*http.Request, error) {
+		return cs.signRequest(ctx, api, post, params, creds)
+	})
+}
+
+// Prepare a raw request against a CS API, signed with the given credentials
+func (cs *CloudStackClient) signRequest(ctx context.Context, api string, post bool, params url.Values, creds Credentials) (*http.Request, error) {
+	params.Del("signature")
+	params.Set("apiKey", creds.APIKey)
 	params.Set("command", api)
 	params.Set("response", "json")
 	params.Set("signatureversion", "3")
@@ -557,12 +1288,12 @@ func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Valu
 	// * URL encode the string and convert to base64
 	s := EncodeValues(params)
 	s2 := strings.ToLower(s)
-	mac := hmac.New(sha1.New, []byte(cs.secret))
+	mac := hmac.New(sha1.New, []byte(creds.SecretKey))
 	mac.Write([]byte(s2))
 	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))
 
 	var err error
-	var resp *http.Response
+	var req *http.Request
 	if !cs.HTTPGETOnly && post {
 		// The deployVirtualMachine API should be called using a POST call
 		// so we don't have to worry about the userdata size
@@ -571,14 +1302,49 @@ func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Valu
 		params.Set("signature", signature)
 
 		// Make a POST call
-		resp, err = cs.client.PostForm(cs.baseURL, params)
+		req, err = http.NewRequestWithContext(ctx, http.MethodPost, cs.baseURL, strings.NewReader(params.Encode()))
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
 	} else {
 		// Create the final URL before we issue the request
 		url := cs.baseURL + "?" + s + "&signature=" + url.QueryEscape(signature)
 
 		// Make a GET call
-		resp, err = cs.client.Get(url)
+		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
 	}
+
+	return req, nil
+}
+
+// Execute a request against a CS API, which is prepared by newRequest for every attempt. Will return the raw
+// JSON data returned by the API and nil if no error occurred. If the API returns an error the result will be
+// nil and the error a *CSError.
+func (cs *CloudStackClient) doRequest(ctx context.Context, api string, newRequest func() (*http.Request, error)) (json.RawMessage, error) {
+	if cs.retry != nil {
+		return cs.doRequestWithRetry(ctx, api, newRequest)
+	}
+
+	req, err := newRequest()
+	if err != nil {
+		return nil, err
+	}
+	return cs.sendRequest(api, req)
+}
+
+// Send a prepared request to the CS API once, without retrying failed requests
+func (cs *CloudStackClient) sendRequest(api string, req *http.Request) (json.RawMessage, error) {
+	if cs.limiter != nil {
+		if err := cs.limiter.wait(req.Context(), cs, api); err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := cs.client.Do(req)
 	if err != nil {
 		return nil, err
 	}
@@ -590,19 +1356,32 @@ func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Valu
 	}
 
 	// Need to get the raw value to make the result play nice
-	b, err = getRawValue(b)
+	raw, err := getRawValue(b)
 	if err != nil {
+		if resp.StatusCode != 200 {
+			// Not a CloudStack error response, e.g. an error page of a proxy
+			return nil, &CSError{
+				HTTPStatus: resp.StatusCode,
+				Command:    api,
+				ErrorText:  string(b),
+				RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+			}
+		}
 		return nil, err
 	}
 
 	if resp.StatusCode != 200 {
-		var e CSError
-		if err := json.Unmarshal(b, &e); err != nil {
+		e := &CSError{
+			HTTPStatus: resp.StatusCode,
+			Command:    api,
+			RetryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+		if err := json.Unmarshal(raw, e); err != nil {
 			return nil, err
 		}
-		return nil, e.Error()
+		return nil, e
 	}
-	return b, nil
+	return raw, nil
 }
 
 // Custom version of net/url Encode that only URL escapes values
@@ -0,0 +1,328 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// The environment variables read by NewClientFromEnv
+const (
+	EnvConfig     = "CLOUDSTACK_CONFIG"      // Path of the cmk config file, defaults to ~/.cmk/config
+	EnvProfile    = "CLOUDSTACK_PROFILE"     // cmk profile to load before applying the other variables
+	EnvAPIURL     = "CLOUDSTACK_API_URL"     // url
+	EnvAPIKey     = "CLOUDSTACK_API_KEY"     // apikey
+	EnvSecretKey  = "CLOUDSTACK_SECRET_KEY"  // secretkey
+	EnvUsername   = "CLOUDSTACK_USERNAME"    // username
+	EnvPassword   = "CLOUDSTACK_PASSWORD"    // password
+	EnvDomain     = "CLOUDSTACK_DOMAIN"      // domain
+	EnvVerifyCert = "CLOUDSTACK_VERIFY_CERT" // verifycert
+	EnvTimeout    = "CLOUDSTACK_TIMEOUT"     // timeout
+)
+
+// The environment variable of every config key
+var configEnv = map[string]string{
+	"url":        EnvAPIURL,
+	"apikey":     EnvAPIKey,
+	"secretkey":  EnvSecretKey,
+	"username":   EnvUsername,
+	"password":   EnvPassword,
+	"domain":     EnvDomain,
+	"verifycert": EnvVerifyCert,
+	"timeout":    EnvTimeout,
+}
+
+// Config holds the settings needed to create a client, using the keys of a CloudMonkey (cmk) config profile
+type Config struct {
+	URL        string // url
+	APIKey     string // apikey
+	SecretKey  string // secretkey
+	Username   string // username, used with password when there is no API key
+	Password   string // password
+	Domain     string // domain of the user, defaults to the ROOT domain
+	VerifyCert bool   // verifycert, defaults to true
+	Timeout    int64  // timeout in seconds for async jobs, defaults to the timeout of the client
+	AsyncBlock bool   // asyncblock, wait for async jobs to finish; defaults to true
+}
+
+// DefaultConfigPath returns the path of the cmk config file, which is $CLOUDSTACK_CONFIG or ~/.cmk/config
+func DefaultConfigPath() (string, error) {
+	if path := os.Getenv(EnvConfig); path != "" {
+		return path, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".cmk", "config"), nil
+}
+
+// LoadConfig reads a profile from the cmk config file at DefaultConfigPath. When profile is empty, the profile
+// selected in the config file is used. Keys of the profile take precedence over the same keys in the core
+// (top level) section of the file.
+func LoadConfig(profile string) (*Config, error) {
+	path, err := DefaultConfigPath()
+	if err != nil {
+		return nil, err
+	}
+	return LoadConfigFile(path, profile)
+}
+
+// LoadConfigFile is like LoadConfig, but reads the cmk config file at path
+func LoadConfigFile(path string, profile string) (*Config, error) {
+	values, profile, err := readProfile(path, profile)
+	if err != nil {
+		return nil, err
+	}
+
+	c := newConfig()
+	if err := c.set(values, func(key string) string { return key }); err != nil {
+		return nil, fmt.Errorf("Profile %q in %s: %v", profile, path, err)
+	}
+	if missing := c.missing(); len(missing) > 0 {
+		return nil, fmt.Errorf("Profile %q in %s is missing %s", profile, path, strings.Join(missing, ", "))
+	}
+	return c, nil
+}
+
+// LoadConfigFromEnv reads the settings from the CLOUDSTACK_* environment variables. When CLOUDSTACK_PROFILE is
+// set, that profile is loaded from the cmk config file first and the environment variables take precedence
+// over its keys.
+func LoadConfigFromEnv() (*Config, error) {
+	c := newConfig()
+	source := "environment"
+
+	if profile := os.Getenv(EnvProfile); profile != "" {
+		path, err := DefaultConfigPath()
+		if err != nil {
+			return nil, err
+		}
+		values, _, err := readProfile(path, profile)
+		if err != nil {
+			return nil, err
+		}
+		if err := c.set(values, func(key string) string { return key }); err != nil {
+			return nil, fmt.Errorf("Profile %q in %s: %v", profile, path, err)
+		}
+		source = fmt.Sprintf("environment and profile %q in %s", profile, path)
+	}
+
+	values := make(map[string]string)
+	for key, env := range configEnv {
+		if v := os.Getenv(env); v != "" {
+			values[key] = v
+		}
+	}
+	if err := c.set(values, func(key string) string { return configEnv[key] }); err != nil {
+		return nil, err
+	}
+
+	if missing := c.missing(); len(missing) > 0 {
+		for i, key := range missing {
+			missing[i] = configEnv[key]
+		}
+		return nil, fmt.Errorf("Missing %s in the %s", strings.Join(missing, ", "), source)
+	}
+	return c, nil
+}
+
+// readProfile returns the keys of a profile of the cmk config file at path merged with the keys of the core
+// section, together with the name of the profile. When profile is empty, the default profile of the file is read.
+func readProfile(path string, profile string) (map[string]string, string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer f.Close()
+
+	sections, err := parseINI(f)
+	if err != nil {
+		return nil, "", fmt.Errorf("Failed to parse %s: %v", path, err)
+	}
+	return profileKeys(sections, path, profile)
+}
+
+// profileKeys returns the keys of a profile of the parsed cmk config file at path merged with the keys of the
+// core section, together with the name of the profile
+func profileKeys(sections map[string]map[string]string, path string, profile string) (map[string]string, string, error) {
+	core := sections[""]
+	if profile == "" {
+		profile = core["profile"]
+	}
+	if profile == "" {
+		return nil, "", fmt.Errorf("No profile given and no default profile set in %s", path)
+	}
+
+	values, ok := sections[profile]
+	if !ok {
+		return nil, "", fmt.Errorf("Profile %q not found in %s", profile, path)
+	}
+
+	merged := make(map[string]string, len(core)+len(values))
+	for k, v := range core {
+		merged[k] = v
+	}
+	for k, v := range values {
+		merged[k] = v
+	}
+	return merged, profile, nil
+}
+
+// NewClientFromConfig creates a client from a profile of the cmk config file (see LoadConfig). The options
+// are applied after the settings of the profile, so they take precedence.
+func NewClientFromConfig(profile string, options ...ClientOption) (*CloudStackClient, error) {
+	c, err := LoadConfig(profile)
+	if err != nil {
+		return nil, err
+	}
+	return c.NewClient(options...)
+}
+
+// NewClientFromEnv creates a client from the CLOUDSTACK_* environment variables (see LoadConfigFromEnv). The
+// options are applied after the settings of the environment, so they take precedence.
+func NewClientFromEnv(options ...ClientOption) (*CloudStackClient, error) {
+	c, err := LoadConfigFromEnv()
+	if err != nil {
+		return nil, err
+	}
+	return c.NewClient(options...)
+}
+
+// NewClient creates a client using the settings of the config. The client uses the API key and secret if set,
+// otherwise it logs in with the username and password.
+func (c *Config) NewClient(options ...ClientOption) (*CloudStackClient, error) {
+	if missing := c.missing(); len(missing) > 0 {
+		return nil, fmt.Errorf("Config is missing %s", strings.Join(missing, ", "))
+	}
+
+	options = append([]ClientOption{WithAsyncTimeout(c.Timeout)}, options...)
+
+	if c.APIKey != "" {
+		return newClient(c.URL, c.APIKey, c.SecretKey, c.AsyncBlock, c.VerifyCert, options...), nil
+	}
+	return newSessionClient(c.URL, c.Username, c.Password, c.Domain, c.AsyncBlock, c.VerifyCert, options...)
+}
+
+func newConfig() *Config {
+	return &Config{VerifyCert: true, AsyncBlock: true}
+}
+
+// set sets the config keys in values, naming a key with name(key) in errors
+func (c *Config) set(values map[string]string, name func(string) string) error {
+	for key, v := range values {
+		var err error
+		switch key {
+		case "url":
+			c.URL = v
+		case "apikey":
+			c.APIKey = v
+		case "secretkey":
+			c.SecretKey = v
+		case "username":
+			c.Username = v
+		case "password":
+			c.Password = v
+		case "domain":
+			c.Domain = v
+		case "verifycert":
+			c.VerifyCert, err = strconv.ParseBool(v)
+		case "asyncblock":
+			c.AsyncBlock, err = strconv.ParseBool(v)
+		case "timeout":
+			c.Timeout, err = strconv.ParseInt(v, 10, 64)
+		}
+		if err != nil {
+			return fmt.Errorf("Invalid value %q for %s", v, name(key))
+		}
+	}
+	return nil
+}
+
+// missing returns the keys that must be set but are not. A client needs an url and either an API key and
+// secret, or a username and password.
+func (c *Config) missing() []string {
+	var missing []string
+	if c.URL == "" {
+		missing = append(missing, "url")
+	}
+
+	switch {
+	case c.APIKey != "" || c.SecretKey != "":
+		if c.APIKey == "" {
+			missing = append(missing, "apikey")
+		}
+		if c.SecretKey == "" {
+			missing = append(missing, "secretkey")
+		}
+	case c.Username != "" || c.Password != "":
+		if c.Username == "" {
+			missing = append(missing, "username")
+		}
+		if c.Password == "" {
+			missing = append(missing, "password")
+		}
+	default:
+		missing = append(missing, "apikey", "secretkey")
+	}
+	return missing
+}
+
+// parseINI parses a cmk config file into its sections. Keys before the first section, or in a [core] section
+// as used by older versions of cmk, are returned in the section with the empty name.
+func parseINI(r io.Reader) (map[string]map[string]string, error) {
+	sections := map[string]map[string]string{"": {}}
+	section := sections[""]
+
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || line[0] == '#' || line[0] == ';' {
+			continue
+		}
+
+		if line[0] == '[' {
+			if line[len(line)-1] != ']' {
+				return nil, fmt.Errorf("line %d: invalid section %q", n, line)
+			}
+			name := strings.TrimSpace(line[1 : len(line)-1])
+			if name == "core" {
+				name = ""
+			}
+			if _, ok := sections[name]; !ok {
+				sections[name] = make(map[string]string)
+			}
+			section = sections[name]
+			continue
+		}
+
+		key, value, ok := strings.Cut(line, "=")
+		if !ok {
+			return nil, fmt.Errorf("line %d: expected key = value, got %q", n, line)
+		}
+		section[strings.ToLower(strings.TrimSpace(key))] = strings.TrimSpace(value)
+	}
+	return sections, scanner.Err()
+}
@@ -0,0 +1,225 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Credentials are the API key and secret key used to sign requests
+type Credentials struct {
+	APIKey    string
+	SecretKey string
+}
+
+// CredentialProvider provides the credentials used to sign requests. It is consulted for every request, so
+// the credentials of a running client can be changed, for example after rotating the keys of the user.
+type CredentialProvider interface {
+	// Credentials returns the credentials to sign the next request with
+	Credentials(ctx context.Context) (Credentials, error)
+
+	// Refresh is called when CloudStack rejected the credentials. It returns the latest credentials, and
+	// the request is retried once if they differ from the rejected ones.
+	Refresh(ctx context.Context) (Credentials, error)
+}
+
+// WithCredentialProvider signs the requests of the CloudStackClient with the credentials of p, instead of
+// the API key and secret the client was created with
+func WithCredentialProvider(p CredentialProvider) ClientOption {
+	return func(cs *CloudStackClient) {
+		if p != nil {
+			cs.credentials = p
+		}
+	}
+}
+
+// CredentialProvider returns the provider of the credentials used to sign the requests of the client
+func (cs *CloudStackClient) CredentialProvider() CredentialProvider {
+	return cs.credentials
+}
+
+type credentialsKey struct{}
+
+// withCredentials returns a copy of ctx whose API calls are signed with creds instead of the credentials of
+// the client, for example to verify newly generated keys
+func withCredentials(ctx context.Context, creds Credentials) context.Context {
+	return context.WithValue(ctx, credentialsKey{}, creds)
+}
+
+// newCredentialsRequest signs a request with the credentials of the client and executes it. When CloudStack
+// rejects the credentials, they are refreshed and the request is retried once with the new credentials.
+func (cs *CloudStackClient) newCredentialsRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {
+	creds, err := cs.credentials.Credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	b, err := cs.newSignedRequest(ctx, api, post, params, creds)
+	if err == nil || !isCredentialsError(err) {
+		return b, err
+	}
+
+	fresh, rerr := cs.credentials.Refresh(ctx)
+	if rerr != nil || fresh == creds {
+		return nil, err
+	}
+	return cs.newSignedRequest(ctx, api, post, params, fresh)
+}
+
+// isCredentialsError reports whether err tells that the API key or signature of a request is invalid
+func isCredentialsError(err error) bool {
+	var e *CSError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return IsUnauthorized(err) || e.ErrorCode == ErrorCodeUnsupportedAction
+}
+
+// StaticCredentialProvider provides fixed credentials, which can be replaced using Set
+type StaticCredentialProvider struct {
+	mu    sync.RWMutex
+	creds Credentials
+}
+
+// NewStaticCredentials returns a provider of the given API key and secret
+func NewStaticCredentials(apiKey string, secretKey string) *StaticCredentialProvider {
+	return &StaticCredentialProvider{creds: Credentials{APIKey: apiKey, SecretKey: secretKey}}
+}
+
+// Credentials returns the current credentials
+func (p *StaticCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.creds, nil
+}
+
+// Refresh returns the current credentials, as there is nothing to refresh
+func (p *StaticCredentialProvider) Refresh(ctx context.Context) (Credentials, error) {
+	return p.Credentials(ctx)
+}
+
+// Set replaces the credentials, so all following requests are signed with the new credentials
+func (p *StaticCredentialProvider) Set(creds Credentials) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.creds = creds
+}
+
+// FileCredentialProvider provides the credentials stored in a file, and reloads them when the file changes.
+// The file uses the format of a cmk config file (see LoadConfig), so it can be the cmk config itself or a file
+// containing just the apikey and secretkey keys.
+type FileCredentialProvider struct {
+	path    string
+	profile string
+
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	creds   Credentials
+}
+
+// NewFileCredentials returns a provider of the credentials in the file at path. When profile is empty, the keys
+// at the top of the file are used, or those of the default profile when the top has no apikey.
+func NewFileCredentials(path string, profile string) (*FileCredentialProvider, error) {
+	p := &FileCredentialProvider{path: path, profile: profile}
+	if _, err := p.Refresh(context.Background()); err != nil {
+		return nil, err
+	}
+	return p, nil
+}
+
+// Credentials returns the credentials in the file, reloading them if the file changed since they were last
+// read. When the file cannot be read (for example because it is being written), the last credentials are
+// returned.
+func (p *FileCredentialProvider) Credentials(ctx context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fi, err := os.Stat(p.path)
+	if err != nil || (fi.ModTime().Equal(p.modTime) && fi.Size() == p.size) {
+		return p.creds, nil
+	}
+
+	if creds, err := p.read(); err == nil {
+		p.creds = creds
+		p.modTime = fi.ModTime()
+		p.size = fi.Size()
+	}
+	return p.creds, nil
+}
+
+// Refresh reloads the credentials from the file
+func (p *FileCredentialProvider) Refresh(ctx context.Context) (Credentials, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	fi, err := os.Stat(p.path)
+	if err != nil {
+		return Credentials{}, err
+	}
+	creds, err := p.read()
+	if err != nil {
+		return Credentials{}, err
+	}
+
+	p.creds = creds
+	p.modTime = fi.ModTime()
+	p.size = fi.Size()
+	return creds, nil
+}
+
+// read reads the credentials from the file
+func (p *FileCredentialProvider) read() (Credentials, error) {
+	f, err := os.Open(p.path)
+	if err != nil {
+		return Credentials{}, err
+	}
+	sections, err := parseINI(f)
+	f.Close()
+	if err != nil {
+		return Credentials{}, fmt.Errorf("Failed to parse %s: %v", p.path, err)
+	}
+
+	values := sections[""]
+	if p.profile != "" || values["apikey"] == "" {
+		if values, _, err = profileKeys(sections, p.path, p.profile); err != nil {
+			return Credentials{}, err
+		}
+	}
+
+	var missing []string
+	for _, key := range []string{"apikey", "secretkey"} {
+		if values[key] == "" {
+			missing = append(missing, key)
+		}
+	}
+	if len(missing) > 0 {
+		return Credentials{}, fmt.Errorf("%s is missing %s", p.path, strings.Join(missing, ", "))
+	}
+	return Credentials{APIKey: values["apikey"], SecretKey: values["secretkey"]}, nil
+}
@@ -0,0 +1,260 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// ErrAPIUnavailable is returned when calling an API the server doesn't have, when API discovery is enabled
+var ErrAPIUnavailable = errors.New("API not available on the server")
+
+// The commands the client uses for itself, which are never checked against the discovered APIs
+var discoveryExempt = map[string]bool{
+	"listApis":         true,
+	"listCapabilities": true,
+	"getApiLimit":      true,
+	"login":            true,
+	"logout":           true,
+}
+
+// The minimum and maximum time to wait before fetching the APIs again after fetching them failed
+const (
+	minDiscoveryBackoff = time.Second
+	maxDiscoveryBackoff = time.Minute
+)
+
+// apiDiscovery holds the APIs and capabilities of the server, which are fetched when first needed. Only a
+// successful result is kept; after a failure they are fetched again, waiting longer after every failure.
+type apiDiscovery struct {
+	mu           sync.Mutex
+	preflight    bool            // Check every call against the discovered APIs
+	validation   ParamValidation // Check the parameters of every call against the discovered APIs
+	done         bool            // Set once the APIs were fetched successfully
+	err          error           // Error of the last failed attempt
+	retryAt      time.Time       // Time after which a failed attempt is retried
+	backoff      time.Duration
+	fetching     *discoveryFetch // The fetch in progress; nil if the APIs are not being fetched
+	apis         map[string]*Api // Lowercase API name -> API
+	capabilities *Capability
+}
+
+// discoveryFetch is a fetch of the APIs and capabilities that all callers needing them wait for
+type discoveryFetch struct {
+	done chan struct{} // Closed when the fetch is done
+	err  error
+}
+
+// WithAPIDiscovery makes the CloudStackClient check every call against the APIs of the server (see HasAPI),
+// so calling an API the server doesn't have fails with ErrAPIUnavailable without making the call. The APIs are
+// fetched with listApis when the first call is made. When they cannot be fetched, calls are not checked.
+func WithAPIDiscovery() ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.discovery.preflight = true
+	}
+}
+
+// DiscoverAPIs fetches the APIs and capabilities of the server, replacing those fetched before. The APIs are
+// fetched automatically when first needed, so calling DiscoverAPIs is only needed to check for errors up front
+// or to refresh the APIs, for example after the server was upgraded.
+func (cs *CloudStackClient) DiscoverAPIs(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	d := &cs.discovery
+	d.mu.Lock()
+	f := d.fetch(ctx, cs)
+	d.mu.Unlock()
+
+	return f.wait(ctx)
+}
+
+// HasAPI reports whether the server has the API with the given name, as far as the user of the client is
+// allowed to call it
+func (cs *CloudStackClient) HasAPI(name string) (bool, error) {
+	apis, _, err := cs.discovery.load(context.Background(), cs)
+	if err != nil {
+		return false, err
+	}
+	_, ok := apis[strings.ToLower(name)]
+	return ok, nil
+}
+
+// ServerVersion returns the CloudStack version of the server, e.g. 4.20.0.0
+func (cs *CloudStackClient) ServerVersion() (string, error) {
+	_, capabilities, err := cs.discovery.load(context.Background(), cs)
+	if err != nil {
+		return "", err
+	}
+	return capabilities.Cloudstackversion, nil
+}
+
+// APISchema returns the schema of the APIs of the server
+func (cs *CloudStackClient) APISchema() (*APISchema, error) {
+	return cs.APISchemaWithContext(context.Background())
+}
+
+// APISchemaWithContext returns the schema of the APIs of the server, using ctx when they need to be fetched
+func (cs *CloudStackClient) APISchemaWithContext(ctx context.Context) (*APISchema, error) {
+	apis, _, err := cs.discovery.load(ctx, cs)
+	if err != nil {
+		return nil, err
+	}
+	return &APISchema{apis: apis}, nil
+}
+
+// preflight checks a call against the discovered APIs before it is made, when enabled. It returns
+// ErrAPIUnavailable when the server doesn't have the API and a *ParamsError when the parameters don't match
+// the API in strict validation mode.
+func (cs *CloudStackClient) preflight(ctx context.Context, api string, params url.Values) error {
+	d := &cs.discovery
+	if (!d.preflight && d.validation == ValidationOff) || discoveryExempt[api] {
+		return nil
+	}
+
+	apis, _, err := d.load(ctx, cs)
+	if err != nil {
+		// Let the server decide when the APIs are unknown
+		return nil
+	}
+
+	if _, ok := apis[strings.ToLower(api)]; !ok {
+		if d.preflight {
+			return fmt.Errorf("%w: %s", ErrAPIUnavailable, api)
+		}
+		return nil
+	}
+
+	if d.validation == ValidationOff {
+		return nil
+	}
+	return cs.checkParams(ctx, &APISchema{apis: apis}, api, params)
+}
+
+// load returns the APIs and capabilities of the server, fetching them if they weren't fetched yet. After a
+// failed attempt, the error is returned until the backoff has passed. Only one fetch is made at a time; other
+// callers wait for it to finish, or until their own ctx is done.
+func (d *apiDiscovery) load(ctx context.Context, cs *CloudStackClient) (map[string]*Api, *Capability, error) {
+	for {
+		d.mu.Lock()
+		if d.done {
+			apis, capabilities := d.apis, d.capabilities
+			d.mu.Unlock()
+			return apis, capabilities, nil
+		}
+		if d.err != nil && time.Now().Before(d.retryAt) {
+			err := d.err
+			d.mu.Unlock()
+			return nil, nil, err
+		}
+		if err := ctx.Err(); err != nil {
+			d.mu.Unlock()
+			return nil, nil, err
+		}
+		f := d.fetch(ctx, cs)
+		d.mu.Unlock()
+
+		if err := f.wait(ctx); err != nil {
+			return nil, nil, err
+		}
+	}
+}
+
+// fetch returns the fetch in progress, or starts a new one; d.mu must be held. The fetch is shared by all callers
+// waiting for it, so it isn't cancelled with the caller that started it. It keeps the values of ctx though, for
+// example for tracing.
+func (d *apiDiscovery) fetch(ctx context.Context, cs *CloudStackClient) *discoveryFetch {
+	if d.fetching != nil {
+		return d.fetching
+	}
+
+	f := &discoveryFetch{done: make(chan struct{})}
+	d.fetching = f
+	go func() {
+		f.err = d.discover(context.WithoutCancel(ctx), cs)
+		close(f.done)
+	}()
+	return f
+}
+
+// wait waits for the fetch to finish and returns its error, or the error of ctx when it is done first
+func (f *discoveryFetch) wait(ctx context.Context) error {
+	select {
+	case <-f.done:
+		return f.err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// discover fetches the APIs and capabilities of the server; d.mu must not be held, as the calls pass through
+// the rest of the client. When fetching fails, the APIs fetched before (if any) are kept.
+func (d *apiDiscovery) discover(ctx context.Context, cs *CloudStackClient) error {
+	apis, err := cs.APIDiscovery.ListApisWithContext(ctx, cs.APIDiscovery.NewListApisParams())
+	if err != nil {
+		return d.failed(fmt.Errorf("Failed to discover the APIs of the server: %w", err))
+	}
+	capabilities, err := cs.Configuration.ListCapabilitiesWithContext(ctx, cs.Configuration.NewListCapabilitiesParams())
+	if err != nil {
+		return d.failed(fmt.Errorf("Failed to discover the capabilities of the server: %w", err))
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.apis = make(map[string]*Api, len(apis.Apis))
+	for _, api := range apis.Apis {
+		d.apis[strings.ToLower(api.Name)] = api
+	}
+	d.capabilities = capabilities.Capabilities
+	if d.capabilities == nil {
+		d.capabilities = &Capability{}
+	}
+	d.done = true
+	d.err = nil
+	d.backoff = 0
+	d.fetching = nil
+	return nil
+}
+
+// failed records a failed fetch and returns its error
+func (d *apiDiscovery) failed(err error) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.backoff *= 2
+	if d.backoff < minDiscoveryBackoff {
+		d.backoff = minDiscoveryBackoff
+	}
+	if d.backoff > maxDiscoveryBackoff {
+		d.backoff = maxDiscoveryBackoff
+	}
+	d.err = err
+	d.retryAt = time.Now().Add(d.backoff)
+	d.fetching = nil
+	return err
+}
@@ -0,0 +1,263 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// DynamicResult is the result of an API call made with Call
+type DynamicResult struct {
+	// Command is the API command that was called
+	Command string
+
+	// JobID is the ID of the async job started by the call, if any
+	JobID string
+
+	// Raw is the response of the call without the <command>response key around it, or the result of the
+	// async job when the client waited for it
+	Raw json.RawMessage
+
+	// Value is Raw unwrapped: the items of a list response, the object of a response holding a single object
+	// (like {"virtualmachine": {...}}), or else Raw itself
+	Value json.RawMessage
+
+	// Count is the total number of items of a list response
+	Count int
+}
+
+// Decode unmarshals the unwrapped value of the result into v
+func (r *DynamicResult) Decode(v interface{}) error {
+	return json.Unmarshal(r.Value, v)
+}
+
+// Commands that are read-only and so are called using GET, like the generated calls do
+var readOnlyCommand = regexp.MustCompile(`^(get|list|query|find)\w+$`)
+
+// Call calls any API the server has, including plugin APIs that aren't generated, using the listApis metadata
+// of the server (see APISchema) to encode the parameters:
+//
+//   - map parameters are encoded from a map[string]string the way the generated calls encode them, e.g.
+//     details[0].k=v for deployVirtualMachine; map parameters of other APIs are encoded as name[i].key=k and
+//     name[i].value=v. From a []map[string]string they are encoded as name[i].k=v for every entry of every map
+//   - list parameters are encoded from a []string, []int, []int64 or []interface{} as a comma separated list
+//   - other values are formatted as strings
+//
+// Read-only commands are called using GET, other commands and commands with passwords or user data using POST.
+// When the API is async and the client waits for async jobs (see WithAsyncWait), Call waits for the job and
+// returns its result.
+func (s *CustomService) Call(ctx context.Context, command string, params map[string]any) (*DynamicResult, error) {
+	schema, err := s.cs.APISchemaWithContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	api, ok := schema.API(command)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrAPIUnavailable, command)
+	}
+
+	types := make(map[string]string, len(api.Params))
+	for _, ap := range api.Params {
+		types[strings.ToLower(ap.Name)] = ap.Type
+	}
+
+	u := url.Values{}
+	post := !readOnlyCommand.MatchString(strings.ToLower(api.Name))
+	for name, v := range params {
+		if err := encodeDynamicParam(u, api.Name, name, types[strings.ToLower(name)], v); err != nil {
+			return nil, fmt.Errorf("Failed to encode parameter %s of %s: %v", name, api.Name, err)
+		}
+		if lname := strings.ToLower(name); strings.Contains(lname, "password") || lname == "userdata" {
+			post = true
+		}
+	}
+
+	if api.Isasync {
+		var js *jobSpan
+		ctx, js = s.cs.withJobSpan(ctx)
+		defer js.release()
+	}
+
+	resp, err := s.cs.newRawRequest(ctx, api.Name, post, u)
+	if err != nil {
+		return nil, err
+	}
+
+	r := &DynamicResult{Command: api.Name, Raw: resp}
+	if api.Isasync {
+		var job struct {
+			JobID string `json:"jobid"`
+		}
+		if err := json.Unmarshal(resp, &job); err != nil {
+			return nil, err
+		}
+		r.JobID = job.JobID
+
+		if r.JobID != "" && s.cs.waitForAsyncJob(ctx) {
+			b, err := s.cs.waitForJob(ctx, api.Name, r.JobID)
+			if err != nil {
+				if err == AsyncTimeoutErr || ctx.Err() != nil {
+					r.unwrap()
+					return r, err
+				}
+				return nil, err
+			}
+			r.Raw = b
+		}
+	}
+
+	if err := r.unwrap(); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// unwrap sets the unwrapped value and count of the result
+func (r *DynamicResult) unwrap() error {
+	r.Value = r.Raw
+
+	var m map[string]json.RawMessage
+	if json.Unmarshal(r.Raw, &m) != nil {
+		// Not an object, so nothing to unwrap
+		return nil
+	}
+
+	if count, ok := m["count"]; ok {
+		if err := json.Unmarshal(count, &r.Count); err != nil {
+			return err
+		}
+		for k, v := range m {
+			if k != "count" && len(v) > 0 && v[0] == '[' {
+				r.Value = v
+				return nil
+			}
+		}
+	}
+
+	if strings.HasPrefix(strings.ToLower(r.Command), "list") && len(m) == 0 {
+		r.Value = json.RawMessage("[]")
+		return nil
+	}
+
+	if len(m) == 1 {
+		for _, v := range m {
+			if len(v) > 0 && v[0] == '{' {
+				r.Value = v
+			}
+		}
+	}
+	return nil
+}
+
+// mapEncoding is how the entries of a map[string]string parameter are encoded
+type mapEncoding struct {
+	Key, Value     string // Encode every entry as name[i].<Key>=k and name[i].<Value>=v
+	ZeroIndex      bool   // Encode every entry as name[0].k=v
+	OmitEmptyValue bool   // Leave out name[i].<Value> when v is empty
+}
+
+// encode encodes the entries of m, sorted by key
+func (e mapEncoding) encode(u url.Values, name string, m map[string]string) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for i, k := range keys {
+		switch {
+		case e.ZeroIndex:
+			u.Set(fmt.Sprintf("%s[0].%s", name, k), m[k])
+		case e.Key == "":
+			u.Set(fmt.Sprintf("%s[%d].%s", name, i, k), m[k])
+		default:
+			u.Set(fmt.Sprintf("%s[%d].%s", name, i, e.Key), k)
+			if m[k] != "" || !e.OmitEmptyValue {
+				u.Set(fmt.Sprintf("%s[%d].%s", name, i, e.Value), m[k])
+			}
+		}
+	}
+}
+
+// encodeDynamicParam encodes the value of a parameter of a command of the given listApis type
+func encodeDynamicParam(u url.Values, command string, name string, typ string, v interface{}) error {
+	switch t := v.(type) {
+	case nil:
+		return nil
+	case string:
+		u.Set(name, t)
+	case bool:
+		u.Set(name, strconv.FormatBool(t))
+	case int:
+		u.Set(name, strconv.Itoa(t))
+	case int64:
+		u.Set(name, strconv.FormatInt(t, 10))
+	case float64:
+		u.Set(name, strconv.FormatFloat(t, 'f', -1, 64))
+	case []string:
+		u.Set(name, strings.Join(t, ","))
+	case []int:
+		l := make([]string, len(t))
+		for i, e := range t {
+			l[i] = strconv.Itoa(e)
+		}
+		u.Set(name, strings.Join(l, ","))
+	case []int64:
+		l := make([]string, len(t))
+		for i, e := range t {
+			l[i] = strconv.FormatInt(e, 10)
+		}
+		u.Set(name, strings.Join(l, ","))
+	case []interface{}:
+		l := make([]string, len(t))
+		for i, e := range t {
+			l[i] = fmt.Sprint(e)
+		}
+		u.Set(name, strings.Join(l, ","))
+	case map[string]string:
+		if typ != "" && typ != "map" {
+			return fmt.Errorf("expected a value of type %s, got a map", typ)
+		}
+		e, ok := mapParamEncodings[strings.ToLower(command)][strings.ToLower(name)]
+		if !ok {
+			e = mapEncoding{Key: "key", Value: "value"}
+		}
+		e.encode(u, name, t)
+	case []map[string]string:
+		if typ != "" && typ != "map" {
+			return fmt.Errorf("expected a value of type %s, got a list of maps", typ)
+		}
+		for i, m := range t {
+			for k, val := range m {
+				u.Set(fmt.Sprintf("%s[%d].%s", name, i, k), val)
+			}
+		}
+	default:
+		u.Set(name, fmt.Sprint(t))
+	}
+	return nil
+}
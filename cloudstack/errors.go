@@ -0,0 +1,185 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Error codes used by CloudStack in the errorcode field of an error response (see ApiErrorCode in CloudStack).
+const (
+	ErrorCodeUnauthorized         = 401
+	ErrorCodeAPILimitExceeded     = 429
+	ErrorCodeMethodNotAllowed     = 405
+	ErrorCodeMalformedParameter   = 430
+	ErrorCodeParam                = 431
+	ErrorCodeUnsupportedAction    = 432
+	ErrorCodeInternal             = 530
+	ErrorCodeAccount              = 531
+	ErrorCodeAccountResourceLimit = 532
+	ErrorCodeInsufficientCapacity = 533
+	ErrorCodeResourceUnavailable  = 534
+	ErrorCodeResourceAllocation   = 535
+	ErrorCodeResourceInUse        = 536
+	ErrorCodeNetworkRuleConflict  = 537
+)
+
+// Exception codes used by CloudStack in the cserrorcode field of an error response (see CSExceptionErrorCode in
+// CloudStack). Only the codes needed to classify errors are listed here.
+const (
+	CSErrorCodeConcurrentOperation   = 4300
+	CSErrorCodeInvalidParameterValue = 4350
+	CSErrorCodeAccountLimit          = 4280
+)
+
+// CSError is the error returned when the CloudStack API responds with an error, either directly or as the result
+// of a failed async job. Use errors.As to get the details, or one of the IsXxx helpers to classify the error.
+type CSError struct {
+	ErrorCode   int    `json:"errorcode"`
+	CSErrorCode int    `json:"cserrorcode"`
+	ErrorText   string `json:"errortext"`
+
+	// HTTPStatus is the HTTP status code of the response, it's zero for errors of async jobs
+	HTTPStatus int `json:"-"`
+
+	// Command is the API command that failed
+	Command string `json:"-"`
+
+	// JobID is the ID of the async job that failed, it's empty for errors of sync calls
+	JobID string `json:"-"`
+
+	// RetryAfter is the delay the server asked for using the Retry-After header, if any
+	RetryAfter time.Duration `json:"-"`
+}
+
+func (e *CSError) Error() string {
+	return fmt.Sprintf("CloudStack API error %d (CSExceptionErrorCode: %d): %s", e.ErrorCode, e.CSErrorCode, e.ErrorText)
+}
+
+// IsNotFound reports whether err is a CloudStack error telling that the requested entity does not exist.
+// CloudStack doesn't have a dedicated error code for this, so it reports an invalid parameter instead.
+func IsNotFound(err error) bool {
+	var e *CSError
+	if !errors.As(err, &e) {
+		return false
+	}
+	if e.HTTPStatus == http.StatusNotFound {
+		return true
+	}
+	if e.ErrorCode != ErrorCodeParam {
+		return false
+	}
+
+	text := strings.ToLower(e.ErrorText)
+	for _, s := range []string{"does not exist", "doesn't exist", "unable to find", "could not find", "cannot find", "not found"} {
+		if strings.Contains(text, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// IsUnauthorized reports whether err is a CloudStack error telling that the credentials or the session are invalid.
+func IsUnauthorized(err error) bool {
+	var e *CSError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.ErrorCode == ErrorCodeUnauthorized || e.HTTPStatus == http.StatusUnauthorized
+}
+
+// IsConcurrentOperation reports whether err is a CloudStack error telling that another operation on the same
+// resource is in progress. These errors are usually resolved by retrying the call a bit later.
+func IsConcurrentOperation(err error) bool {
+	var e *CSError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.CSErrorCode == CSErrorCodeConcurrentOperation
+}
+
+// IsResourceLimitExceeded reports whether err is a CloudStack error telling that a resource limit of the
+// account, domain or project would be exceeded.
+func IsResourceLimitExceeded(err error) bool {
+	var e *CSError
+	if !errors.As(err, &e) {
+		return false
+	}
+	return e.ErrorCode == ErrorCodeAccountResourceLimit || e.CSErrorCode == CSErrorCodeAccountLimit
+}
+
+// AsyncJobError is the error returned when an async job failed. It wraps the CloudStack error that made the
+// job fail, so errors.As can be used to get the *CSError and the IsXxx helpers work on it as well.
+type AsyncJobError struct {
+	// JobID is the ID of the failed async job
+	JobID string
+
+	// Command is the command executed by the job, e.g. org.apache.cloudstack.api.command.user.vm.DeployVMCmd
+	Command string
+
+	// JobInstanceType and JobInstanceID identify the resource the job was working on (if any)
+	JobInstanceType string
+	JobInstanceID   string
+
+	// ResultCode is the result code of the job
+	ResultCode int
+
+	// Err is the CloudStack error decoded from the job result
+	Err *CSError
+}
+
+func (e *AsyncJobError) Error() string {
+	return fmt.Sprintf("Async job %s failed: %s", e.JobID, e.Err.Error())
+}
+
+func (e *AsyncJobError) Unwrap() error {
+	return e.Err
+}
+
+// newAsyncJobError decodes the result of a failed async job into an *AsyncJobError.
+func newAsyncJobError(jobid string, r *QueryAsyncJobResultResponse) *AsyncJobError {
+	e := &CSError{
+		ErrorCode: int(r.Jobresultcode),
+		Command:   r.Cmd,
+		JobID:     jobid,
+	}
+	switch {
+	case r.Jobresulttype == "text":
+		if err := json.Unmarshal(r.Jobresult, &e.ErrorText); err != nil {
+			e.ErrorText = string(r.Jobresult)
+		}
+	case json.Unmarshal(r.Jobresult, e) != nil:
+		e.ErrorText = string(r.Jobresult)
+	}
+
+	return &AsyncJobError{
+		JobID:           jobid,
+		Command:         r.Cmd,
+		JobInstanceType: r.Jobinstancetype,
+		JobInstanceID:   r.Jobinstanceid,
+		ResultCode:      int(r.Jobresultcode),
+		Err:             e,
+	}
+}
@@ -0,0 +1,85 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"sync"
+)
+
+// The lowercase JSON names of the fields of response types, by type
+var knownFields sync.Map // reflect.Type -> map[string]bool
+
+// fieldNames returns the lowercase JSON names of the fields of a struct type
+func fieldNames(t reflect.Type) map[string]bool {
+	if names, ok := knownFields.Load(t); ok {
+		return names.(map[string]bool)
+	}
+
+	names := make(map[string]bool, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if !f.IsExported() || name == "-" {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names[strings.ToLower(name)] = true
+	}
+	knownFields.Store(t, names)
+	return names
+}
+
+// hasExtra tells if a struct type keeps the fields it doesn't model in an Extra field, like all generated
+// response types do
+func hasExtra(t reflect.Type) bool {
+	f, ok := t.FieldByName("Extra")
+	return ok && f.Type == reflect.TypeOf(map[string]json.RawMessage(nil))
+}
+
+// decodeWithExtra decodes a JSON object into v, which must be a pointer to a struct, and stores the fields
+// of the object that v doesn't have in extra. Newer servers may return fields that are not generated yet.
+func decodeWithExtra(b []byte, v interface{}, extra *map[string]json.RawMessage) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return err
+	}
+
+	known := fieldNames(reflect.TypeOf(v).Elem())
+	*extra = nil
+	for k, f := range fields {
+		if known[strings.ToLower(k)] {
+			continue
+		}
+		if *extra == nil {
+			*extra = make(map[string]json.RawMessage)
+		}
+		(*extra)[k] = f
+	}
+	return nil
+}
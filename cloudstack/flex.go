@@ -0,0 +1,296 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// FlexInt is an integer in an API response. Some CloudStack versions return numbers as strings, so it also
+// decodes strings holding a number, and an empty string as zero.
+type FlexInt int
+
+// FlexInt64 is a 64 bit integer in an API response, decoded like FlexInt
+type FlexInt64 int64
+
+// FlexFloat is a floating point number in an API response. It also decodes strings holding a number, and an
+// empty string as zero.
+type FlexFloat float64
+
+// FlexBool is a boolean in an API response. It also decodes the strings "true" and "false", and the numbers
+// 0 and 1 (also as strings). An empty string is decoded as false.
+type FlexBool bool
+
+func (v *FlexInt) UnmarshalJSON(b []byte) error {
+	i, err := decodeFlexInt(b, strconv.IntSize)
+	if err != nil {
+		return fmt.Errorf("Unable to decode %s as an integer: %v", b, err)
+	}
+	*v = FlexInt(i)
+	return nil
+}
+
+func (v *FlexInt64) UnmarshalJSON(b []byte) error {
+	i, err := decodeFlexInt(b, 64)
+	if err != nil {
+		return fmt.Errorf("Unable to decode %s as an integer: %v", b, err)
+	}
+	*v = FlexInt64(i)
+	return nil
+}
+
+func (v *FlexFloat) UnmarshalJSON(b []byte) error {
+	s := flexString(b)
+	if s == "" {
+		*v = 0
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return fmt.Errorf("Unable to decode %s as a number: %v", b, err)
+	}
+	*v = FlexFloat(f)
+	return nil
+}
+
+func (v *FlexBool) UnmarshalJSON(b []byte) error {
+	switch strings.ToLower(flexString(b)) {
+	case "true", "1":
+		*v = true
+	case "false", "0", "":
+		*v = false
+	default:
+		return fmt.Errorf("Unable to decode %s as a boolean", b)
+	}
+	return nil
+}
+
+// flexString returns a JSON value as a string, unquoting it if it is a string. It returns an empty string
+// for null.
+func flexString(b []byte) string {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		return ""
+	}
+	var s string
+	if json.Unmarshal(b, &s) == nil {
+		return strings.TrimSpace(s)
+	}
+	return string(b)
+}
+
+// decodeFlexInt decodes a JSON number or string as an integer. Numbers with a fraction of zero, like 2.0, are
+// also accepted.
+func decodeFlexInt(b []byte, bitSize int) (int64, error) {
+	s := flexString(b)
+	if s == "" {
+		return 0, nil
+	}
+	i, err := strconv.ParseInt(s, 10, bitSize)
+	if err == nil {
+		return i, nil
+	}
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil || f != math.Trunc(f) {
+		return 0, err
+	}
+	return int64(f), nil
+}
+
+// DecodeError is returned by clients created with WithStrictDecoding when a response doesn't match the
+// generated response type exactly
+type DecodeError struct {
+	Command string
+
+	// Coerced are the fields whose values had to be converted to the type of the field, with the value,
+	// e.g. `host[2].cpunumber: "4"`
+	Coerced []string
+
+	// Unknown are the fields of the response that the response type doesn't have, e.g. `host[2].newfield`
+	Unknown []string
+}
+
+func (e *DecodeError) Error() string {
+	command := e.Command
+	if command == "" {
+		command = "async job result"
+	}
+	var problems []string
+	if len(e.Coerced) > 0 {
+		problems = append(problems, "coerced fields "+strings.Join(e.Coerced, ", "))
+	}
+	if len(e.Unknown) > 0 {
+		problems = append(problems, "unknown fields "+strings.Join(e.Unknown, ", "))
+	}
+	return fmt.Sprintf("Response of %s doesn't match its type: %s", command, strings.Join(problems, "; "))
+}
+
+// WithStrictDecoding makes the CloudStackClient fail calls with a *DecodeError when values in a response had
+// to be coerced to the type of their field, e.g. a number returned as a string, or when a response has fields
+// its type doesn't have. This is meant for tests that check the generated types against a server, to find
+// drift between them; by default values are silently coerced and unknown fields are kept in Extra.
+func WithStrictDecoding() ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.strictDecoding = true
+	}
+}
+
+// decodeResponse decodes the response of a command into v, checking it when strict decoding is enabled
+func (cs *CloudStackClient) decodeResponse(command string, b json.RawMessage, v interface{}) error {
+	if err := json.Unmarshal(b, v); err != nil {
+		return err
+	}
+	return cs.checkResponse(command, b, v)
+}
+
+// decodeJobResult decodes the result of a finished async job of a command into r, checking it when strict
+// decoding is enabled
+func (cs *CloudStackClient) decodeJobResult(command string, b json.RawMessage, r jobResultDecoder) error {
+	if err := r.decodeJobResult(b); err != nil {
+		return err
+	}
+	if cs == nil || !cs.strictDecoding {
+		return nil
+	}
+	raw, err := getRawValue(b)
+	if err != nil {
+		return err
+	}
+	return cs.checkResponse(command, raw, r)
+}
+
+// checkResponse checks a decoded response against its JSON when strict decoding is enabled
+func (cs *CloudStackClient) checkResponse(command string, b json.RawMessage, v interface{}) error {
+	if cs == nil || !cs.strictDecoding {
+		return nil
+	}
+	e := &DecodeError{Command: command}
+	checkFields(b, reflect.TypeOf(v), "", e)
+	if len(e.Coerced) == 0 && len(e.Unknown) == 0 {
+		return nil
+	}
+	sort.Strings(e.Coerced)
+	sort.Strings(e.Unknown)
+	return e
+}
+
+var (
+	flexNumberTypes = map[reflect.Type]bool{
+		reflect.TypeOf(FlexInt(0)):   true,
+		reflect.TypeOf(FlexInt64(0)): true,
+		reflect.TypeOf(FlexFloat(0)): true,
+	}
+	flexBoolType = reflect.TypeOf(FlexBool(false))
+)
+
+// checkFields walks a JSON value together with the type it was decoded into, and records the fields whose
+// values had to be coerced and the fields that are unknown to response types
+func checkFields(b json.RawMessage, t reflect.Type, path string, e *DecodeError) {
+	for t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	b = bytes.TrimSpace(b)
+	if len(b) == 0 || bytes.Equal(b, []byte("null")) {
+		return
+	}
+
+	switch {
+	case flexNumberTypes[t]:
+		if b[0] != '-' && (b[0] < '0' || b[0] > '9') {
+			e.Coerced = append(e.Coerced, fmt.Sprintf("%s: %s", path, b))
+		}
+		return
+	case t == flexBoolType:
+		if b[0] != 't' && b[0] != 'f' {
+			e.Coerced = append(e.Coerced, fmt.Sprintf("%s: %s", path, b))
+		}
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Struct:
+		var fields map[string]json.RawMessage
+		if json.Unmarshal(b, &fields) != nil {
+			return
+		}
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+			if !f.IsExported() || name == "-" || name == "" {
+				continue
+			}
+			if v, ok := lookupField(fields, name); ok {
+				checkFields(v, f.Type, joinPath(path, name), e)
+			}
+		}
+		if hasExtra(t) {
+			known := fieldNames(t)
+			for k := range fields {
+				if !known[strings.ToLower(k)] {
+					e.Unknown = append(e.Unknown, joinPath(path, k))
+				}
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		var items []json.RawMessage
+		if json.Unmarshal(b, &items) != nil {
+			return
+		}
+		for i, item := range items {
+			checkFields(item, t.Elem(), fmt.Sprintf("%s[%d]", path, i), e)
+		}
+	case reflect.Map:
+		var items map[string]json.RawMessage
+		if json.Unmarshal(b, &items) != nil {
+			return
+		}
+		for k, item := range items {
+			checkFields(item, t.Elem(), joinPath(path, k), e)
+		}
+	}
+}
+
+// lookupField returns the value of a field of a JSON object, matching the name case-insensitively like
+// encoding/json does
+func lookupField(fields map[string]json.RawMessage, name string) (json.RawMessage, bool) {
+	if v, ok := fields[name]; ok {
+		return v, true
+	}
+	for k, v := range fields {
+		if strings.EqualFold(k, name) {
+			return v, true
+		}
+	}
+	return nil, false
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
@@ -0,0 +1,355 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// JobStatus is the status of an async job as reported by CloudStack
+type JobStatus int
+
+const (
+	JobPending   JobStatus = 0
+	JobSucceeded JobStatus = 1
+	JobFailed    JobStatus = 2
+)
+
+func (s JobStatus) String() string {
+	switch s {
+	case JobPending:
+		return "pending"
+	case JobSucceeded:
+		return "succeeded"
+	case JobFailed:
+		return "failed"
+	}
+	return "unknown"
+}
+
+// ErrJobPending is returned when asking for the result of an async job that hasn't finished yet
+var ErrJobPending = errors.New("Async job is still pending")
+
+// PollStrategy decides how long to wait before polling an async job again. Next is called with the number
+// of polls done so far, so the first call is Next(1).
+type PollStrategy interface {
+	Next(attempt int) time.Duration
+}
+
+// PollStrategyFunc is an adapter to use an ordinary function as PollStrategy
+type PollStrategyFunc func(attempt int) time.Duration
+
+func (f PollStrategyFunc) Next(attempt int) time.Duration {
+	return f(attempt)
+}
+
+// The default strategy waits 1 second longer after every poll, up to 15 seconds
+var defaultPollStrategy = LinearPoll(time.Second, 15*time.Second)
+
+// LinearPoll returns a poll strategy that waits step longer after every poll, up to max
+func LinearPoll(step time.Duration, max time.Duration) PollStrategy {
+	return PollStrategyFunc(func(attempt int) time.Duration {
+		if d := time.Duration(attempt) * step; d < max {
+			return d
+		}
+		return max
+	})
+}
+
+// FixedPoll returns a poll strategy that always waits the same interval
+func FixedPoll(interval time.Duration) PollStrategy {
+	return PollStrategyFunc(func(attempt int) time.Duration {
+		return interval
+	})
+}
+
+// ExponentialPoll returns a poll strategy that starts with initial and doubles the wait after every poll, up to max
+func ExponentialPoll(initial time.Duration, max time.Duration) PollStrategy {
+	return PollStrategyFunc(func(attempt int) time.Duration {
+		d := initial
+		for i := 1; i < attempt && d < max; i++ {
+			d *= 2
+		}
+		if d < max {
+			return d
+		}
+		return max
+	})
+}
+
+// JitteredPoll wraps a poll strategy and randomly changes every wait by up to the given fraction (e.g. 0.2
+// for +/- 20%), so many clients started at the same time don't all poll the API at the same moment.
+func JitteredPoll(strategy PollStrategy, fraction float64) PollStrategy {
+	return PollStrategyFunc(func(attempt int) time.Duration {
+		d := strategy.Next(attempt)
+		return d + time.Duration((rand.Float64()*2-1)*fraction*float64(d))
+	})
+}
+
+// WithPollStrategy takes a custom poll strategy to be used by the CloudStackClient when waiting for async jobs
+func WithPollStrategy(strategy PollStrategy) ClientOption {
+	return func(cs *CloudStackClient) {
+		if strategy != nil {
+			cs.poll = strategy
+		}
+	}
+}
+
+func (cs *CloudStackClient) pollStrategy() PollStrategy {
+	if cs.poll == nil {
+		return defaultPollStrategy
+	}
+	return cs.poll
+}
+
+type asyncWaitKey struct{}
+
+// WithAsyncWait returns a copy of ctx that tells async API calls made with it whether to wait for the async job
+// to finish, regardless of the client being created with NewClient or NewAsyncClient. When not waiting, use the
+// JobID of the response to get a handle to the job with NewJob.
+func WithAsyncWait(ctx context.Context, wait bool) context.Context {
+	return context.WithValue(ctx, asyncWaitKey{}, wait)
+}
+
+func (cs *CloudStackClient) waitForAsyncJob(ctx context.Context) bool {
+	if wait, ok := ctx.Value(asyncWaitKey{}).(bool); ok {
+		return wait
+	}
+	return cs.async
+}
+
+// waitForJob waits for the async job started by command to finish, using the async timeout of the client
+func (cs *CloudStackClient) waitForJob(ctx context.Context, command, jobid string) (json.RawMessage, error) {
+	ctx, w := cs.startJobWait(ctx, command, jobid)
+	b, err := cs.GetAsyncJobResultWithContext(ctx, jobid, cs.timeout)
+	w.end(ctx, err)
+	return b, err
+}
+
+// jobWait records a wait for an async job in the logs, metrics and traces of the client
+type jobWait struct {
+	cs      *CloudStackClient
+	command string
+	jobid   string
+	start   time.Time
+	span    *jobSpan
+}
+
+// startJobWait starts recording a wait for the async job started by command. It returns the context to poll
+// the job with, which is traced in the span of the job.
+func (cs *CloudStackClient) startJobWait(ctx context.Context, command, jobid string) (context.Context, *jobWait) {
+	ctx, js := cs.jobWaitSpan(ctx, command, jobid)
+	cs.logger.jobWaiting(ctx, command, jobid)
+	return ctx, &jobWait{cs: cs, command: command, jobid: jobid, start: time.Now(), span: js}
+}
+
+// end records that the wait is over, err being the error of the job or of waiting for it
+func (w *jobWait) end(ctx context.Context, err error) {
+	wait := time.Since(w.start)
+	w.cs.logger.jobFinished(ctx, w.command, w.jobid, wait, err)
+	if w.cs.metrics != nil {
+		w.cs.metrics.ObserveJobWait(w.command, wait, jobErrStatus(err))
+	}
+	w.span.end(err)
+}
+
+// jobErrStatus returns the status of an async job from the error of waiting for it
+func jobErrStatus(err error) JobStatus {
+	var e *AsyncJobError
+	switch {
+	case err == nil:
+		return JobSucceeded
+	case errors.As(err, &e):
+		return JobFailed
+	}
+	return JobPending
+}
+
+// jobResultDecoder is implemented by all responses of async API calls
+type jobResultDecoder interface {
+	decodeJobResult(b json.RawMessage) error
+}
+
+// Job is a handle to a running async job. It allows starting many async jobs first and waiting for them later.
+// A Job is safe for concurrent use.
+type Job struct {
+	ID string
+
+	cs      *CloudStackClient
+	command string    // The API command that started the job, if known
+	created time.Time // When the handle was created, which is when the job started for handles returned by XAsync
+
+	mu     sync.Mutex
+	status JobStatus
+	result json.RawMessage
+	err    error
+}
+
+// NewJob returns a handle to the async job with the given ID, for example the JobID of the response of an
+// async API call made without waiting for the job to finish.
+func (cs *CloudStackClient) NewJob(jobid string) *Job {
+	return cs.newJob("", jobid)
+}
+
+// newJob returns a handle to the async job started by command
+func (cs *CloudStackClient) newJob(command, jobid string) *Job {
+	return &Job{ID: jobid, cs: cs, command: command, created: time.Now()}
+}
+
+// Status returns the status of the job as seen by the last poll
+func (j *Job) Status() JobStatus {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.status
+}
+
+// Poll asks CloudStack once for the status of the job and returns it
+func (j *Job) Poll() (JobStatus, error) {
+	return j.PollWithContext(context.Background())
+}
+
+// PollWithContext is like Poll, but honours the cancellation and deadline of ctx
+func (j *Job) PollWithContext(ctx context.Context) (JobStatus, error) {
+	status, finished, err := j.poll(ctx)
+	if finished {
+		j.cs.logger.jobFinished(ctx, j.command, j.ID, time.Since(j.created), j.Err())
+	}
+	return status, err
+}
+
+// poll asks CloudStack once for the status of the job, it reports whether the job finished with this poll
+func (j *Job) poll(ctx context.Context) (JobStatus, bool, error) {
+	if status := j.Status(); status != JobPending {
+		return status, false, nil
+	}
+
+	p := &QueryAsyncJobResultParams{}
+	p.p = make(map[string]interface{})
+	p.SetJobID(j.ID)
+	r, err := j.cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)
+	if err != nil {
+		return JobPending, false, err
+	}
+
+	status, finished := j.update(r)
+	return status, finished, nil
+}
+
+// update stores the result of a finished job and returns the status of the job and whether it just finished
+func (j *Job) update(r *QueryAsyncJobResultResponse) (JobStatus, bool) {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	if j.status != JobPending {
+		return j.status, false
+	}
+
+	switch JobStatus(r.Jobstatus) {
+	case JobSucceeded:
+		j.status = JobSucceeded
+		j.result = r.Jobresult
+	case JobFailed:
+		j.status = JobFailed
+		j.err = newAsyncJobError(j.ID, r)
+	default:
+		return j.status, false
+	}
+	return j.status, true
+}
+
+// Wait polls the job using the poll strategy of the client until it is finished or ctx is done. It returns
+// nil if the job succeeded, an *AsyncJobError if the job failed or the context error. Unlike the async client
+// Wait doesn't use the async timeout of the client, so use a context with a deadline to limit the wait. When
+// the client has a job watcher (see WithJobWatcher), the job is polled by the watcher instead. The wait is
+// logged, traced and recorded in the metrics of the client, just like the waits of the async client.
+func (j *Job) Wait(ctx context.Context) error {
+	if j.Status() != JobPending {
+		return j.Err()
+	}
+
+	ctx, w := j.cs.startJobWait(ctx, j.command, j.ID)
+	err := j.wait(ctx)
+	w.end(ctx, err)
+	return err
+}
+
+// wait polls the job until it is finished or ctx is done
+func (j *Job) wait(ctx context.Context) error {
+	if j.cs.watcher != nil {
+		r, err := j.cs.watcher.wait(ctx, j.ID)
+		if err != nil {
+			return err
+		}
+		j.update(r)
+	}
+
+	for attempt := 1; ; attempt++ {
+		status, _, err := j.poll(ctx)
+		if err != nil {
+			return err
+		}
+
+		switch status {
+		case JobSucceeded:
+			return nil
+		case JobFailed:
+			return j.Err()
+		}
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(j.cs.pollStrategy().Next(attempt)):
+		}
+	}
+}
+
+// Err returns the *AsyncJobError of a failed job, or nil if the job didn't fail (yet)
+func (j *Job) Err() error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+	return j.err
+}
+
+// Result decodes the result of a finished job into v. When v is the response type of the API call that started
+// the job (e.g. *DeployVirtualMachineResponse), the result is decoded exactly as the async client does it,
+// otherwise the raw job result is unmarshalled into v. It returns ErrJobPending if the job isn't finished yet
+// and the *AsyncJobError if the job failed.
+func (j *Job) Result(v interface{}) error {
+	j.mu.Lock()
+	defer j.mu.Unlock()
+
+	switch j.status {
+	case JobPending:
+		return ErrJobPending
+	case JobFailed:
+		return j.err
+	}
+
+	if d, ok := v.(jobResultDecoder); ok {
+		return j.cs.decodeJobResult("", j.result, d)
+	}
+	return json.Unmarshal(j.result, v)
+}
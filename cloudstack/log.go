@@ -0,0 +1,214 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// The default maximum number of bytes of a response body that is logged
+const defaultMaxLogBodySize = 1024
+
+// LogConfig configures what the logger of a client logs and at which levels
+type LogConfig struct {
+	// CallLevel is the level of the records of successful API calls, defaults to slog.LevelDebug
+	CallLevel slog.Leveler
+
+	// ErrorLevel is the level of the records of failed API calls, defaults to slog.LevelDebug
+	ErrorLevel slog.Leveler
+
+	// JobLevel is the level of the records of async job transitions, defaults to slog.LevelDebug
+	JobLevel slog.Leveler
+
+	// MaxBodySize is the maximum number of bytes of a response body that is logged, larger bodies are
+	// truncated. Defaults to 1024, a negative value disables logging response bodies.
+	MaxBodySize int
+}
+
+// clientLogger logs the API calls and async jobs of a client
+type clientLogger struct {
+	logger *slog.Logger
+	config LogConfig
+}
+
+// WithLogger logs every API call of the CloudStackClient with its command, parameters, latency, HTTP status
+// and (truncated) response body, and logs the async jobs the client waits for. Secrets like API keys,
+// signatures, passwords, session keys and user data are always redacted, both in the parameters and in the
+// response bodies. An optional LogConfig sets the levels of the records and the maximum body size. A nil logger
+// logs to slog.Default().
+func WithLogger(logger *slog.Logger, config ...LogConfig) ClientOption {
+	return func(cs *CloudStackClient) {
+		if logger == nil {
+			logger = slog.Default()
+		}
+		l := &clientLogger{logger: logger}
+		if len(config) > 0 {
+			l.config = config[0]
+		}
+
+		if cs.logger == nil {
+			cs.middleware = append(cs.middleware, func(next Handler) Handler {
+				return func(ctx context.Context, req *Request) (*Response, error) {
+					resp, err := next(ctx, req)
+					cs.logger.call(ctx, req, resp, err)
+					return resp, err
+				}
+			})
+		}
+		cs.logger = l
+	}
+}
+
+func (l *clientLogger) level(level slog.Leveler) slog.Level {
+	if level == nil {
+		return slog.LevelDebug
+	}
+	return level.Level()
+}
+
+// call logs an API call made by the client
+func (l *clientLogger) call(ctx context.Context, req *Request, resp *Response, err error) {
+	level := l.level(l.config.CallLevel)
+	if err != nil {
+		level = l.level(l.config.ErrorLevel)
+	}
+	if !l.logger.Enabled(ctx, level) {
+		return
+	}
+
+	attrs := []slog.Attr{
+		slog.String("command", req.Command),
+		slog.String("params", req.Params.Encode()),
+	}
+	if resp != nil {
+		attrs = append(attrs,
+			slog.Int("status", resp.HTTPStatus),
+			slog.Duration("latency", resp.Latency),
+		)
+		if resp.JobID != "" {
+			attrs = append(attrs, slog.String("jobid", resp.JobID))
+		}
+		if l.config.MaxBodySize >= 0 && len(resp.Body) > 0 {
+			attrs = append(attrs, slog.String("body", l.body(resp.Body)))
+		}
+	}
+
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+		l.logger.LogAttrs(ctx, level, "CloudStack API call failed", attrs...)
+		return
+	}
+	l.logger.LogAttrs(ctx, level, "CloudStack API call", attrs...)
+}
+
+// body returns the response body with secrets redacted, truncated to the maximum body size
+func (l *clientLogger) body(b json.RawMessage) string {
+	limit := l.config.MaxBodySize
+	if limit == 0 {
+		limit = defaultMaxLogBodySize
+	}
+
+	var v interface{}
+	if err := json.Unmarshal(b, &v); err == nil {
+		if redacted, err := json.Marshal(redactJSON(v)); err == nil {
+			b = redacted
+		}
+	}
+
+	if len(b) <= limit {
+		return string(b)
+	}
+	// Don't cut a multi-byte character in half
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(b[cut]) {
+		cut--
+	}
+	return fmt.Sprintf("%s... (truncated, %d bytes)", b[:cut], len(b))
+}
+
+// redactJSON replaces the values of secret fields in decoded JSON data
+func redactJSON(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[string]interface{}:
+		for k, e := range v {
+			lk := strings.ToLower(k)
+			if redactedParams[lk] || strings.Contains(lk, "password") {
+				v[k] = "REDACTED"
+				continue
+			}
+			v[k] = redactJSON(e)
+		}
+	case []interface{}:
+		for i, e := range v {
+			v[i] = redactJSON(e)
+		}
+	}
+	return v
+}
+
+// jobWaiting logs that the client starts waiting for an async job
+func (l *clientLogger) jobWaiting(ctx context.Context, command, jobid string) {
+	if l == nil {
+		return
+	}
+
+	// The command is not known for handles of jobs created with NewJob
+	var attrs []slog.Attr
+	if command != "" {
+		attrs = append(attrs, slog.String("command", command))
+	}
+	attrs = append(attrs, slog.String("jobid", jobid), slog.String("status", JobPending.String()))
+	l.logger.LogAttrs(ctx, l.level(l.config.JobLevel), "Waiting for CloudStack async job", attrs...)
+}
+
+// jobFinished logs that an async job finished, failed or that waiting for it was given up
+func (l *clientLogger) jobFinished(ctx context.Context, command, jobid string, wait time.Duration, err error) {
+	if l == nil {
+		return
+	}
+
+	// The command is not known for handles of jobs created with NewJob
+	var attrs []slog.Attr
+	if command != "" {
+		attrs = append(attrs, slog.String("command", command))
+	}
+	attrs = append(attrs, slog.String("jobid", jobid))
+	if wait > 0 {
+		attrs = append(attrs, slog.Duration("wait", wait))
+	}
+
+	status := jobErrStatus(err)
+	attrs = append(attrs, slog.String("status", status.String()))
+	if err != nil {
+		attrs = append(attrs, slog.String("error", err.Error()))
+	}
+
+	msg := "CloudStack async job finished"
+	if status == JobPending {
+		msg = "Stopped waiting for CloudStack async job"
+	}
+	l.logger.LogAttrs(ctx, l.level(l.config.JobLevel), msg, attrs...)
+}
@@ -0,0 +1,267 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metrics records measurements of the API calls and async jobs of a client
+type Metrics interface {
+	// ObserveCall records an API call, err being the error of the call if it failed
+	ObserveCall(command string, latency time.Duration, err error)
+
+	// ObserveJobWait records how long the client waited for the async job started by command, and the
+	// status of the job when the client stopped waiting (pending if the wait timed out or was cancelled)
+	ObserveJobWait(command string, wait time.Duration, status JobStatus)
+}
+
+// WithMetrics records every API call of the CloudStackClient and every async job it waits for in m. Passing it
+// again replaces the earlier Metrics, a nil m is ignored.
+func WithMetrics(m Metrics) ClientOption {
+	return func(cs *CloudStackClient) {
+		if m == nil {
+			return
+		}
+		if cs.metrics == nil {
+			cs.middleware = append(cs.middleware, func(next Handler) Handler {
+				return func(ctx context.Context, req *Request) (*Response, error) {
+					start := time.Now()
+					resp, err := next(ctx, req)
+					cs.metrics.ObserveCall(req.Command, time.Since(start), err)
+					return resp, err
+				}
+			})
+		}
+		cs.metrics = m
+	}
+}
+
+// The default buckets of the latency and wait histograms, in seconds
+var (
+	DefaultLatencyBuckets = []float64{0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10, 30}
+	DefaultJobWaitBuckets = []float64{1, 5, 10, 30, 60, 120, 300, 600, 1800}
+)
+
+// PrometheusMetrics is a Metrics implementation that is also an http.Handler serving the metrics in the
+// Prometheus text exposition format. It exposes these metrics:
+//
+//	cloudstack_requests_total{command}                    API calls
+//	cloudstack_request_errors_total{command,code}         Failed API calls by CSError code, or "transport"
+//	cloudstack_request_duration_seconds{command}          Histogram of the latency of API calls
+//	cloudstack_async_job_wait_seconds{command,status}     Histogram of the time waited for async jobs
+type PrometheusMetrics struct {
+	mu       sync.Mutex
+	requests map[string]float64
+	errors   map[[2]string]float64
+	latency  map[string]*histogram
+	jobWaits map[[2]string]*histogram
+
+	latencyBuckets []float64
+	jobWaitBuckets []float64
+}
+
+// NewPrometheusMetrics returns a PrometheusMetrics using the default histogram buckets
+func NewPrometheusMetrics() *PrometheusMetrics {
+	return NewPrometheusMetricsWithBuckets(DefaultLatencyBuckets, DefaultJobWaitBuckets)
+}
+
+// NewPrometheusMetricsWithBuckets returns a PrometheusMetrics using the given upper bounds (in seconds) of the
+// buckets of the latency and async job wait histograms
+func NewPrometheusMetricsWithBuckets(latency, jobWait []float64) *PrometheusMetrics {
+	return &PrometheusMetrics{
+		requests:       make(map[string]float64),
+		errors:         make(map[[2]string]float64),
+		latency:        make(map[string]*histogram),
+		jobWaits:       make(map[[2]string]*histogram),
+		latencyBuckets: sortedBuckets(latency),
+		jobWaitBuckets: sortedBuckets(jobWait),
+	}
+}
+
+func sortedBuckets(buckets []float64) []float64 {
+	b := append([]float64(nil), buckets...)
+	sort.Float64s(b)
+	return b
+}
+
+// ObserveCall records an API call
+func (m *PrometheusMetrics) ObserveCall(command string, latency time.Duration, err error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.requests[command]++
+	if err != nil {
+		m.errors[[2]string{command, errorCode(err)}]++
+	}
+
+	h, ok := m.latency[command]
+	if !ok {
+		h = newHistogram(m.latencyBuckets)
+		m.latency[command] = h
+	}
+	h.observe(latency.Seconds())
+}
+
+// ObserveJobWait records the wait for an async job
+func (m *PrometheusMetrics) ObserveJobWait(command string, wait time.Duration, status JobStatus) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	key := [2]string{command, status.String()}
+	h, ok := m.jobWaits[key]
+	if !ok {
+		h = newHistogram(m.jobWaitBuckets)
+		m.jobWaits[key] = h
+	}
+	h.observe(wait.Seconds())
+}
+
+// errorCode returns the label value of the error of a failed API call
+func errorCode(err error) string {
+	var e *CSError
+	if errors.As(err, &e) {
+		if e.ErrorCode != 0 {
+			return strconv.Itoa(int(e.ErrorCode))
+		}
+		if e.HTTPStatus != 0 {
+			return strconv.Itoa(e.HTTPStatus)
+		}
+	}
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return "canceled"
+	}
+	return "transport"
+}
+
+// ServeHTTP writes the metrics in the Prometheus text exposition format
+func (m *PrometheusMetrics) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	m.WriteTo(w)
+}
+
+// WriteTo writes the metrics in the Prometheus text exposition format to w
+func (m *PrometheusMetrics) WriteTo(w io.Writer) (int64, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	var b strings.Builder
+
+	b.WriteString("# HELP cloudstack_requests_total Number of CloudStack API calls.\n")
+	b.WriteString("# TYPE cloudstack_requests_total counter\n")
+	for _, command := range sortedKeys(m.requests) {
+		fmt.Fprintf(&b, "cloudstack_requests_total{command=%s} %s\n", quoteLabel(command), formatFloat(m.requests[command]))
+	}
+
+	b.WriteString("# HELP cloudstack_request_errors_total Number of failed CloudStack API calls by error code.\n")
+	b.WriteString("# TYPE cloudstack_request_errors_total counter\n")
+	for _, key := range sortedPairs(m.errors) {
+		fmt.Fprintf(&b, "cloudstack_request_errors_total{command=%s,code=%s} %s\n",
+			quoteLabel(key[0]), quoteLabel(key[1]), formatFloat(m.errors[key]))
+	}
+
+	b.WriteString("# HELP cloudstack_request_duration_seconds Latency of CloudStack API calls.\n")
+	b.WriteString("# TYPE cloudstack_request_duration_seconds histogram\n")
+	for _, command := range sortedKeys(m.latency) {
+		m.latency[command].write(&b, "cloudstack_request_duration_seconds", "command="+quoteLabel(command))
+	}
+
+	b.WriteString("# HELP cloudstack_async_job_wait_seconds Time waited for CloudStack async jobs.\n")
+	b.WriteString("# TYPE cloudstack_async_job_wait_seconds histogram\n")
+	for _, key := range sortedPairs(m.jobWaits) {
+		m.jobWaits[key].write(&b, "cloudstack_async_job_wait_seconds", "command="+quoteLabel(key[0])+",status="+quoteLabel(key[1]))
+	}
+
+	n, err := io.WriteString(w, b.String())
+	return int64(n), err
+}
+
+// histogram is a Prometheus histogram with cumulative buckets
+type histogram struct {
+	buckets []float64
+	counts  []float64
+	count   float64
+	sum     float64
+}
+
+func newHistogram(buckets []float64) *histogram {
+	return &histogram{buckets: buckets, counts: make([]float64, len(buckets))}
+}
+
+func (h *histogram) observe(v float64) {
+	for i, upper := range h.buckets {
+		if v <= upper {
+			h.counts[i]++
+		}
+	}
+	h.count++
+	h.sum += v
+}
+
+func (h *histogram) write(b *strings.Builder, name, labels string) {
+	for i, upper := range h.buckets {
+		fmt.Fprintf(b, "%s_bucket{%s,le=\"%s\"} %s\n", name, labels, formatFloat(upper), formatFloat(h.counts[i]))
+	}
+	fmt.Fprintf(b, "%s_bucket{%s,le=\"+Inf\"} %s\n", name, labels, formatFloat(h.count))
+	fmt.Fprintf(b, "%s_sum{%s} %s\n", name, labels, formatFloat(h.sum))
+	fmt.Fprintf(b, "%s_count{%s} %s\n", name, labels, formatFloat(h.count))
+}
+
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+// quoteLabel quotes a label value, escaping backslashes, double quotes and newlines
+func quoteLabel(v string) string {
+	v = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`).Replace(v)
+	return `"` + v + `"`
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedPairs[V any](m map[[2]string]V) [][2]string {
+	keys := make([][2]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if keys[i][0] != keys[j][0] {
+			return keys[i][0] < keys[j][0]
+		}
+		return keys[i][1] < keys[j][1]
+	})
+	return keys
+}
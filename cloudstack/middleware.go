@@ -0,0 +1,151 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Request describes an API call passing through the middleware of a client
+type Request struct {
+	// Command is the API command, e.g. deployVirtualMachine
+	Command string
+
+	// Params is a copy of the parameters of the call with secrets (passwords, keys, user data) redacted,
+	// so changing them doesn't change the call
+	Params url.Values
+
+	// Async tells if the command starts an async job
+	Async bool
+
+	// Post tells if the call is made using a POST request
+	Post bool
+}
+
+// Response describes the result of an API call passing through the middleware of a client
+type Response struct {
+	// Body is the raw JSON data returned by the API
+	Body json.RawMessage
+
+	// HTTPStatus is the HTTP status code of the response, or zero if no response was received
+	HTTPStatus int
+
+	// Latency is the time it took to make the call, including any retries
+	Latency time.Duration
+
+	// JobID is the ID of the async job started by the call, if any
+	JobID string
+}
+
+// Handler makes an API call. When the call fails, the error is returned together with the response (if any).
+type Handler func(ctx context.Context, req *Request) (*Response, error)
+
+// Middleware wraps a Handler to add behaviour to every API call, like logging, metrics, tracing or fault
+// injection. A middleware can inspect the request and response, and can fail a call or return a response
+// of its own without calling next.
+type Middleware func(next Handler) Handler
+
+// WithMiddleware adds middleware to the CloudStackClient. The first middleware is the outermost one, so it
+// sees the request first and the response last.
+func WithMiddleware(middleware ...Middleware) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.middleware = append(cs.middleware, middleware...)
+	}
+}
+
+// The parameters that are always redacted, next to any parameter with "password" in its name
+var redactedParams = map[string]bool{
+	"apikey":     true,
+	"secretkey":  true,
+	"signature":  true,
+	"sessionkey": true,
+	"privatekey": true,
+	"userdata":   true,
+}
+
+// redactParams returns a copy of params with the values of secret parameters replaced
+func redactParams(params url.Values) url.Values {
+	redacted := make(url.Values, len(params))
+	for k, vs := range params {
+		lk := strings.ToLower(k)
+		if redactedParams[lk] || strings.Contains(lk, "password") {
+			redacted[k] = []string{"REDACTED"}
+			continue
+		}
+		redacted[k] = append([]string(nil), vs...)
+	}
+	return redacted
+}
+
+// Execute a raw request against a CS API, passing it through the middleware of the client
+func (cs *CloudStackClient) newMiddlewareRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {
+	req := &Request{
+		Command: api,
+		Params:  redactParams(params),
+		Async:   asyncCommands[api],
+		Post:    post,
+	}
+
+	h := Handler(func(ctx context.Context, req *Request) (*Response, error) {
+		start := time.Now()
+		b, err := cs.execRawRequest(ctx, api, post, params)
+
+		resp := &Response{
+			Body:    b,
+			Latency: time.Since(start),
+		}
+
+		var e *CSError
+		switch {
+		case err == nil:
+			resp.HTTPStatus = 200
+		case errors.As(err, &e):
+			resp.HTTPStatus = e.HTTPStatus
+		}
+
+		if req.Async && err == nil {
+			var r struct {
+				JobID string `json:"jobid"`
+			}
+			if json.Unmarshal(b, &r) == nil {
+				resp.JobID = r.JobID
+			}
+		}
+
+		return resp, err
+	})
+	for i := len(cs.middleware) - 1; i >= 0; i-- {
+		h = cs.middleware[i](h)
+	}
+
+	resp, err := h(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, errors.New("Middleware returned no response")
+	}
+	return resp.Body, nil
+}
@@ -0,0 +1,20 @@
+module github.com/apache/cloudstack-go/v2/cloudstack/otel
+
+go 1.23.0
+
+require (
+	github.com/apache/cloudstack-go/v2 v2.0.0-20261018090430-3faea3e76ea7
+	go.opentelemetry.io/otel v1.34.0
+	go.opentelemetry.io/otel/sdk v1.34.0
+	go.opentelemetry.io/otel/trace v1.34.0
+)
+
+require (
+	github.com/go-logr/logr v1.4.2 // indirect
+	github.com/go-logr/stdr v1.2.2 // indirect
+	github.com/google/uuid v1.6.0 // indirect
+	go.opentelemetry.io/auto/sdk v1.1.0 // indirect
+	go.opentelemetry.io/otel/metric v1.34.0 // indirect
+	go.uber.org/mock v0.5.0 // indirect
+	golang.org/x/sys v0.31.0 // indirect
+)
@@ -0,0 +1,85 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+// Package otel traces the API calls of a CloudStack client with OpenTelemetry. It is a separate module, so
+// only programs importing it depend on OpenTelemetry.
+package otel
+
+import (
+	"context"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// The name of the OpenTelemetry tracer used for the spans of API calls
+const tracerName = "github.com/apache/cloudstack-go/v2/cloudstack"
+
+// Tracer implements cloudstack.Tracer using an OpenTelemetry tracer
+type Tracer struct {
+	tracer trace.Tracer
+}
+
+// NewTracer returns a Tracer creating spans with the given tracer provider, or with the global tracer
+// provider if tp is nil
+func NewTracer(tp trace.TracerProvider) *Tracer {
+	if tp == nil {
+		tp = otel.GetTracerProvider()
+	}
+	return &Tracer{tracer: tp.Tracer(tracerName)}
+}
+
+// WithTracerProvider traces the API calls of the client with the given tracer provider, or with the global
+// tracer provider if tp is nil
+func WithTracerProvider(tp trace.TracerProvider) cloudstack.ClientOption {
+	return cloudstack.WithTracer(NewTracer(tp))
+}
+
+// Start opens a client span for an API call
+func (t *Tracer) Start(ctx context.Context, command string, attrs map[string]string) (context.Context, cloudstack.Span) {
+	kvs := make([]attribute.KeyValue, 0, len(attrs))
+	for k, v := range attrs {
+		kvs = append(kvs, attribute.String(k, v))
+	}
+
+	ctx, s := t.tracer.Start(ctx, command, trace.WithSpanKind(trace.SpanKindClient), trace.WithAttributes(kvs...))
+	return ctx, &span{span: s}
+}
+
+// span implements cloudstack.Span using an OpenTelemetry span
+type span struct {
+	span trace.Span
+}
+
+// SetAttribute sets a string attribute of the span
+func (s *span) SetAttribute(key, value string) {
+	s.span.SetAttributes(attribute.String(key, value))
+}
+
+// End records err, if any, and ends the span
+func (s *span) End(err error) {
+	if err != nil {
+		s.span.RecordError(err)
+		s.span.SetStatus(codes.Error, err.Error())
+	}
+	s.span.End()
+}
@@ -0,0 +1,156 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"iter"
+	"sync"
+)
+
+// The page size used when walking through all pages, unless set in the params or with WithPageSize. This
+// matches the default value of the default.page.size setting of CloudStack.
+const defaultPageSize = 500
+
+type pageOptions struct {
+	size     int
+	prefetch int
+}
+
+// PageOption can be passed to the ListXAll and ListXIter functions to change how the pages are fetched
+type PageOption func(*pageOptions)
+
+// WithPageSize sets the number of items fetched per page
+func WithPageSize(size int) PageOption {
+	return func(o *pageOptions) {
+		if size > 0 {
+			o.size = size
+		}
+	}
+}
+
+// WithPrefetch fetches up to the given number of pages concurrently ahead of the page being iterated. When the
+// iteration stops early, the pages that are still being fetched are cancelled and waited for.
+func WithPrefetch(pages int) PageOption {
+	return func(o *pageOptions) {
+		if pages > 0 {
+			o.prefetch = pages
+		}
+	}
+}
+
+type pageResult[T any] struct {
+	items []T
+	err   error
+}
+
+// iteratePages returns an iterator over the items of all pages. The fetch function is called with a copy of
+// the params with the page and page size set, and returns the items of that page and the total count.
+func iteratePages[T any](ctx context.Context, params map[string]interface{}, fetch func(context.Context, map[string]interface{}) ([]T, int, error), opts ...PageOption) iter.Seq2[T, error] {
+	o := &pageOptions{size: defaultPageSize}
+	if size, ok := params["pagesize"].(int); ok && size > 0 {
+		o.size = size
+	}
+	for _, fn := range opts {
+		fn(o)
+	}
+
+	return func(yield func(T, error) bool) {
+		ctx, cancel := context.WithCancel(ctx)
+		var wg sync.WaitGroup
+		defer func() {
+			cancel()
+			wg.Wait()
+		}()
+
+		get := func(page int) ([]T, int, error) {
+			m := make(map[string]interface{}, len(params)+2)
+			for k, v := range params {
+				m[k] = v
+			}
+			m["page"] = page
+			m["pagesize"] = o.size
+			return fetch(ctx, m)
+		}
+
+		// Pages that are being prefetched, in page order
+		var queue []chan pageResult[T]
+		prefetch := func(page int) chan pageResult[T] {
+			ch := make(chan pageResult[T], 1)
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				items, _, err := get(page)
+				ch <- pageResult[T]{items, err}
+			}()
+			return ch
+		}
+
+		items, count, err := get(1)
+		seen := 0
+		for page := 1; ; page++ {
+			if err != nil {
+				var zero T
+				yield(zero, err)
+				return
+			}
+
+			seen += len(items)
+			more := len(items) > 0 && seen < count
+
+			// Start fetching the next pages before handing out the items of this one
+			if more {
+				for next := page + 1 + len(queue); len(queue) < o.prefetch && (next-1)*o.size < count; next++ {
+					queue = append(queue, prefetch(next))
+				}
+			}
+
+			for _, item := range items {
+				if !yield(item, nil) {
+					return
+				}
+			}
+
+			if !more {
+				return
+			}
+
+			if len(queue) > 0 {
+				r := <-queue[0]
+				queue = queue[1:]
+				items, err = r.items, r.err
+			} else {
+				items, _, err = get(page + 1)
+			}
+		}
+	}
+}
+
+// collectPages returns all items of the iterator, or the first error
+func collectPages[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	var all []T
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, item)
+	}
+	return all, nil
+}
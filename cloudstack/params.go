@@ -0,0 +1,109 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// paramDecoder decodes the JSON value of a single parameter into the type its setter uses
+type paramDecoder func(b json.RawMessage) (interface{}, error)
+
+// decodeParam is the paramDecoder of parameters of type T
+func decodeParam[T any](b json.RawMessage) (interface{}, error) {
+	var v T
+	if err := json.Unmarshal(b, &v); err != nil {
+		return nil, err
+	}
+	return v, nil
+}
+
+// marshalParams encodes the parameters that are set as a JSON object, keyed by parameter name
+func marshalParams(p map[string]interface{}) ([]byte, error) {
+	if p == nil {
+		return []byte("{}"), nil
+	}
+	return json.Marshal(p)
+}
+
+// unmarshalParams decodes a JSON object encoded by marshalParams into the parameters of a params struct, using
+// the decoders of the parameters of the command. It fails on parameters the command doesn't have.
+func unmarshalParams(command string, b []byte, p *map[string]interface{}, decoders map[string]paramDecoder) error {
+	if bytes.Equal(bytes.TrimSpace(b), []byte("null")) {
+		return nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	params := make(map[string]interface{}, len(raw))
+	for name, v := range raw {
+		decode, ok := decoders[name]
+		if !ok {
+			return fmt.Errorf("Unknown parameter %q for %s", name, command)
+		}
+		dv, err := decode(v)
+		if err != nil {
+			return fmt.Errorf("Invalid value for parameter %q of %s: %v", name, command, err)
+		}
+		params[name] = dv
+	}
+	*p = params
+	return nil
+}
+
+// cloneParams returns a deep copy of the parameters of a params struct, so the copy can be changed without
+// changing the original
+func cloneParams(p map[string]interface{}) map[string]interface{} {
+	if p == nil {
+		return nil
+	}
+
+	clone := make(map[string]interface{}, len(p))
+	for name, v := range p {
+		switch v := v.(type) {
+		case []string:
+			clone[name] = append([]string(nil), v...)
+		case map[string]string:
+			m := make(map[string]string, len(v))
+			for k, s := range v {
+				m[k] = s
+			}
+			clone[name] = m
+		case []map[string]string:
+			l := make([]map[string]string, len(v))
+			for i, vm := range v {
+				m := make(map[string]string, len(vm))
+				for k, s := range vm {
+					m[k] = s
+				}
+				l[i] = m
+			}
+			clone[name] = l
+		default:
+			clone[name] = v
+		}
+	}
+	return clone
+}
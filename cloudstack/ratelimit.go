@@ -0,0 +1,205 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+)
+
+// ErrRateLimited is returned by calls made while the rate limiter has no budget left, if it is configured to fail fast
+var ErrRateLimited = errors.New("API rate limit reached, no budget left for this call")
+
+// The interval of the API limit of CloudStack, used when calibrating from GetApiLimit without an interval configured.
+// This matches the default value of the api.throttling.interval setting of CloudStack.
+const defaultRateLimitInterval = time.Second
+
+// RateLimitConfig configures the client side rate limiter
+type RateLimitConfig struct {
+	// Limit is the number of calls allowed per Interval. When zero, the limiter calibrates itself on first use
+	// with the API limit of the account as reported by LimitService.GetApiLimit. When the API limit is not
+	// available (e.g. the api.throttling plugin is disabled), the calls are not limited. When the calibration
+	// fails for another reason, it's retried on the next call.
+	Limit int
+
+	// Interval is the interval the Limit applies to; defaults to 1 second
+	Interval time.Duration
+
+	// FailFast makes calls fail with ErrRateLimited when there is no budget left, instead of waiting for it
+	FailFast bool
+}
+
+// RateLimitBudget is a snapshot of the state of the rate limiter
+type RateLimitBudget struct {
+	// Limit is the number of calls allowed per Interval, or zero if the calls are not limited
+	Limit    int
+	Interval time.Duration
+
+	// Remaining is the number of calls that can be made right now without waiting
+	Remaining int
+}
+
+// RateLimiter is a token bucket limiting the rate of API calls of a client. It is shared by all goroutines
+// using the client.
+type RateLimiter struct {
+	cfg RateLimitConfig
+
+	// calMu serializes the calibration, which is retried until it succeeds
+	calMu      sync.Mutex
+	calibrated bool
+
+	mu     sync.Mutex
+	tokens float64
+	last   time.Time
+}
+
+// WithRateLimiter enables a client side rate limiter, so the API limit of the account is not exceeded
+func WithRateLimiter(cfg RateLimitConfig) ClientOption {
+	return func(cs *CloudStackClient) {
+		if cfg.Interval <= 0 {
+			cfg.Interval = defaultRateLimitInterval
+		}
+		cs.limiter = &RateLimiter{
+			cfg:    cfg,
+			tokens: float64(cfg.Limit),
+			last:   time.Now(),
+		}
+	}
+}
+
+// RateLimiter returns the rate limiter of the client, or nil if the client doesn't have one
+func (cs *CloudStackClient) RateLimiter() *RateLimiter {
+	return cs.limiter
+}
+
+type noRateLimitKey struct{}
+
+// Budget returns the current budget of the rate limiter
+func (l *RateLimiter) Budget() RateLimitBudget {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.refill(time.Now())
+	return RateLimitBudget{
+		Limit:     l.cfg.Limit,
+		Interval:  l.cfg.Interval,
+		Remaining: int(l.tokens),
+	}
+}
+
+// The commands that never calibrate the limiter, as the calibration call of a session client depends on them
+var noCalibrationCommands = map[string]bool{
+	"login":  true,
+	"logout": true,
+}
+
+// wait takes a token from the bucket, waiting for one to become available unless the limiter fails fast
+func (l *RateLimiter) wait(ctx context.Context, cs *CloudStackClient, api string) error {
+	if skip, _ := ctx.Value(noRateLimitKey{}).(bool); skip {
+		return nil
+	}
+	if !noCalibrationCommands[api] {
+		l.ensureCalibrated(ctx, cs)
+	}
+
+	for {
+		l.mu.Lock()
+		if l.cfg.Limit == 0 {
+			l.mu.Unlock()
+			return nil
+		}
+
+		l.refill(time.Now())
+		if l.tokens >= 1 {
+			l.tokens--
+			l.mu.Unlock()
+			return nil
+		}
+		if l.cfg.FailFast {
+			l.mu.Unlock()
+			return ErrRateLimited
+		}
+		delay := time.Duration((1 - l.tokens) * float64(l.cfg.Interval) / float64(l.cfg.Limit))
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// refill adds the tokens earned since the last refill; must be called while holding the lock
+func (l *RateLimiter) refill(now time.Time) {
+	if l.cfg.Limit == 0 {
+		return
+	}
+
+	l.tokens += now.Sub(l.last).Seconds() * float64(l.cfg.Limit) / l.cfg.Interval.Seconds()
+	if l.tokens > float64(l.cfg.Limit) {
+		l.tokens = float64(l.cfg.Limit)
+	}
+	l.last = now
+}
+
+// ensureCalibrated calibrates the limiter if it has no limit configured and isn't calibrated yet. A failed
+// calibration leaves the calls unlimited until the next call tries again.
+func (l *RateLimiter) ensureCalibrated(ctx context.Context, cs *CloudStackClient) {
+	l.calMu.Lock()
+	defer l.calMu.Unlock()
+
+	if l.calibrated {
+		return
+	}
+	l.mu.Lock()
+	limited := l.cfg.Limit != 0
+	l.mu.Unlock()
+
+	l.calibrated = limited || l.calibrate(ctx, cs)
+}
+
+// calibrate sets the limit and the remaining budget from the API limit of the account. It reports whether the
+// limiter is calibrated, which is also the case when the API limit isn't available on the server.
+func (l *RateLimiter) calibrate(ctx context.Context, cs *CloudStackClient) bool {
+	r, err := cs.Limit.GetApiLimitWithContext(context.WithValue(ctx, noRateLimitKey{}, true), cs.Limit.NewGetApiLimitParams())
+	if err != nil {
+		var e *CSError
+		return errors.As(err, &e) && e.ErrorCode == ErrorCodeUnsupportedAction
+	}
+	// apiAllowed is what is left of the limit in the current window, not the limit itself
+	limit := r.ApiAllowed + r.ApiIssued
+	if limit <= 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.cfg.Limit = int(limit)
+	l.tokens = float64(r.ApiAllowed)
+	if l.tokens < 0 {
+		l.tokens = 0
+	}
+	l.last = time.Now()
+	return true
+}
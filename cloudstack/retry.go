@@ -0,0 +1,201 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy configures how failed requests are retried. Only failures that are likely to be transient are
+// retried: network errors, HTTP 500, 502, 503 and 504 responses and API throttling (errorcode 429). By default
+// only commands that don't change anything (list, get and query commands that don't start an async job) are
+// retried, use WithRetryMutating to retry other commands as well. Every attempt is signed again.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of attempts, including the first one; defaults to 3
+	MaxAttempts int
+
+	// Backoff decides how long to wait before the next attempt; defaults to an exponential backoff
+	// starting at 500 milliseconds up to 30 seconds, with 20% jitter
+	Backoff PollStrategy
+}
+
+var defaultRetryBackoff = JitteredPoll(ExponentialPoll(500*time.Millisecond, 30*time.Second), 0.2)
+
+// WithRetryPolicy enables retrying failed requests using the given policy. When the server tells how long to wait
+// before trying again, either with a Retry-After header or with the reset window of the API limit of the account
+// (see LimitService.GetApiLimit), that delay is used instead of the backoff.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(cs *CloudStackClient) {
+		if policy.MaxAttempts <= 0 {
+			policy.MaxAttempts = 3
+		}
+		if policy.Backoff == nil {
+			policy.Backoff = defaultRetryBackoff
+		}
+		cs.retry = &policy
+	}
+}
+
+type retryMutatingKey struct{}
+
+type noRetryKey struct{}
+
+// WithRetryMutating returns a copy of ctx that allows the retry policy of the client to also retry calls made
+// with it that change state. Only use it for calls that are safe to repeat, as a request that failed because of
+// a network error may have been executed by the server anyway.
+func WithRetryMutating(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retryMutatingKey{}, true)
+}
+
+// retries reports whether failed calls of the API command may be retried
+func (rp *RetryPolicy) retries(ctx context.Context, api string) bool {
+	if noRetry, _ := ctx.Value(noRetryKey{}).(bool); noRetry {
+		return false
+	}
+
+	// Async commands start a job, even when their name suggests they only read something (e.g. getDiagnosticsData)
+	if !asyncCommands[api] {
+		for _, prefix := range []string{"list", "get", "query"} {
+			if strings.HasPrefix(api, prefix) {
+				return true
+			}
+		}
+	}
+	mutating, _ := ctx.Value(retryMutatingKey{}).(bool)
+	return mutating
+}
+
+// Execute a request against a CS API, retrying it according to the retry policy of the client. The request is
+// prepared by newRequest for every attempt.
+func (cs *CloudStackClient) doRequestWithRetry(ctx context.Context, api string, newRequest func() (*http.Request, error)) (json.RawMessage, error) {
+	retries := cs.retry.retries(ctx, api)
+
+	for attempt := 1; ; attempt++ {
+		req, err := newRequest()
+		if err != nil {
+			return nil, err
+		}
+
+		b, err := cs.sendRequest(api, req)
+		if !retries {
+			return b, err
+		}
+		if err == nil || attempt >= cs.retry.MaxAttempts || ctx.Err() != nil {
+			return b, err
+		}
+
+		delay, ok := cs.retryDelay(ctx, err, attempt)
+		if !ok {
+			return nil, err
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(delay):
+		}
+	}
+}
+
+// retryDelay returns how long to wait before retrying a request that failed with err, or false if the
+// request should not be retried
+func (cs *CloudStackClient) retryDelay(ctx context.Context, err error, attempt int) (time.Duration, bool) {
+	backoff := cs.retry.Backoff.Next(attempt)
+
+	var e *CSError
+	if !errors.As(err, &e) {
+		return backoff, isTransportError(err)
+	}
+
+	switch {
+	case e.ErrorCode == ErrorCodeAPILimitExceeded || e.HTTPStatus == http.StatusTooManyRequests:
+		if e.RetryAfter > 0 {
+			return e.RetryAfter, true
+		}
+		if reset := cs.apiLimitReset(ctx); reset > 0 {
+			return reset, true
+		}
+		return backoff, true
+	case e.HTTPStatus == http.StatusInternalServerError, e.HTTPStatus == http.StatusBadGateway,
+		e.HTTPStatus == http.StatusServiceUnavailable, e.HTTPStatus == http.StatusGatewayTimeout:
+		if e.RetryAfter > 0 {
+			return e.RetryAfter, true
+		}
+		return backoff, true
+	}
+	return 0, false
+}
+
+// apiLimitReset returns the time until the API limit of the account is reset, or zero if unknown
+func (cs *CloudStackClient) apiLimitReset(ctx context.Context) time.Duration {
+	ctx = context.WithValue(context.WithValue(ctx, noRetryKey{}, true), noRateLimitKey{}, true)
+	l, err := cs.Limit.GetApiLimitWithContext(ctx, cs.Limit.NewGetApiLimitParams())
+	if err != nil || l.ExpireAfter <= 0 {
+		return 0
+	}
+	return time.Duration(l.ExpireAfter) * time.Millisecond
+}
+
+// isTransportError reports whether err happened while sending the request or reading the response
+func isTransportError(err error) bool {
+	var ue *url.Error
+	var ne net.Error
+	return errors.As(err, &ue) || errors.As(err, &ne) || errors.Is(err, io.ErrUnexpectedEOF)
+}
+
+// isTransientError reports whether err is likely to go away when the request is made again
+func isTransientError(err error) bool {
+	var e *CSError
+	if !errors.As(err, &e) {
+		return isTransportError(err)
+	}
+
+	switch e.HTTPStatus {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return e.ErrorCode == ErrorCodeAPILimitExceeded
+}
+
+// parseRetryAfter parses the value of a Retry-After header, which is either a number of seconds or a date
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(v); err == nil && seconds > 0 {
+		return time.Duration(seconds) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		if d := time.Until(t); d > 0 {
+			return d
+		}
+	}
+	return 0
+}
@@ -0,0 +1,259 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+)
+
+// APISchema describes the APIs of a server and their parameters, as returned by listApis
+type APISchema struct {
+	apis map[string]*Api // Lowercase API name -> API
+}
+
+// NewAPISchema returns the schema of the given APIs, for example those returned by listApis
+func NewAPISchema(apis []*Api) *APISchema {
+	s := &APISchema{apis: make(map[string]*Api, len(apis))}
+	for _, api := range apis {
+		s.apis[strings.ToLower(api.Name)] = api
+	}
+	return s
+}
+
+// API returns the API with the given name
+func (s *APISchema) API(name string) (*Api, bool) {
+	api, ok := s.apis[strings.ToLower(name)]
+	return api, ok
+}
+
+// ParamsError is returned when the parameters of a call don't match the API
+type ParamsError struct {
+	Command string
+
+	// Unknown are the parameters that are set but not known by the API
+	Unknown []string
+
+	// Missing are the required parameters that are not set
+	Missing []string
+
+	// Invalid are the parameters that are set to an invalid value, with the reason, e.g. "size: not an integer"
+	Invalid []string
+}
+
+func (e *ParamsError) Error() string {
+	var problems []string
+	if len(e.Unknown) > 0 {
+		problems = append(problems, "unknown parameters "+strings.Join(e.Unknown, ", "))
+	}
+	if len(e.Missing) > 0 {
+		problems = append(problems, "missing required parameters "+strings.Join(e.Missing, ", "))
+	}
+	if len(e.Invalid) > 0 {
+		problems = append(problems, "invalid parameters "+strings.Join(e.Invalid, ", "))
+	}
+	return fmt.Sprintf("Invalid parameters for %s: %s", e.Command, strings.Join(problems, "; "))
+}
+
+// Validate checks encoded parameters against an API of the schema. It returns ErrAPIUnavailable if the schema
+// doesn't have the API, and a *ParamsError if parameters are unknown, missing or have values of the wrong type.
+func (s *APISchema) Validate(command string, params url.Values) error {
+	api, ok := s.API(command)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrAPIUnavailable, command)
+	}
+
+	known := make(map[string]ApiParams, len(api.Params))
+	for _, ap := range api.Params {
+		known[strings.ToLower(ap.Name)] = ap
+	}
+
+	e := &ParamsError{Command: command}
+	set := make(map[string]bool)
+	for key, vs := range params {
+		// Map and list parameters are encoded as name[0].key
+		name, _, indexed := strings.Cut(strings.ToLower(key), "[")
+		if set[name] && indexed {
+			continue
+		}
+		set[name] = true
+
+		ap, ok := known[name]
+		if !ok {
+			e.Unknown = append(e.Unknown, name)
+			continue
+		}
+		if !indexed && len(vs) > 0 {
+			if reason := checkParamValue(ap.Type, vs[0]); reason != "" {
+				e.Invalid = append(e.Invalid, name+": "+reason)
+			} else if reason := checkParamLength(vs[0], int(ap.Length)); reason != "" {
+				e.Invalid = append(e.Invalid, name+": "+reason)
+			}
+		}
+	}
+
+	for name, ap := range known {
+		if bool(ap.Required) && !set[name] {
+			e.Missing = append(e.Missing, name)
+		}
+	}
+
+	return e.orNil()
+}
+
+// orNil returns nil if there are no problems, or the sorted error otherwise
+func (e *ParamsError) orNil() error {
+	if len(e.Unknown) == 0 && len(e.Missing) == 0 && len(e.Invalid) == 0 {
+		return nil
+	}
+	sort.Strings(e.Unknown)
+	sort.Strings(e.Missing)
+	sort.Strings(e.Invalid)
+	return e
+}
+
+// checkParamValue returns why v is not a valid value of a parameter of the given listApis type, or an empty
+// string if it is valid
+func checkParamValue(typ string, v string) string {
+	switch typ {
+	case "short", "integer", "long":
+		if _, err := strconv.ParseInt(v, 10, 64); err != nil {
+			return "not an integer"
+		}
+	case "float", "double":
+		if _, err := strconv.ParseFloat(v, 64); err != nil {
+			return "not a number"
+		}
+	case "boolean":
+		if _, err := strconv.ParseBool(v); err != nil {
+			return "not a boolean"
+		}
+	case "uuid":
+		if !IsID(v) {
+			return "not a UUID"
+		}
+	}
+	return ""
+}
+
+// checkParamLength returns why v is not a valid value of a parameter with the given maximum length, or an
+// empty string if it is valid. A length of zero means there is no maximum.
+func checkParamLength(v string, length int) string {
+	if length > 0 && utf8.RuneCountInString(v) > length {
+		return fmt.Sprintf("longer than %d characters", length)
+	}
+	return ""
+}
+
+// paramRules are the rules for the parameters of an API, which are generated from listApis and checked by
+// the Validate method of every params struct
+type paramRules struct {
+	required []string       // Parameters that must be set
+	uuids    []string       // Parameters whose value must be a UUID
+	lengths  map[string]int // Parameters whose value has a maximum length
+}
+
+// validate checks the parameters of a params struct (p) and their encoded values (u) against the rules
+func (r paramRules) validate(command string, p map[string]interface{}, u url.Values) error {
+	e := &ParamsError{Command: command}
+	for _, name := range r.required {
+		if _, ok := p[name]; !ok {
+			e.Missing = append(e.Missing, name)
+		}
+	}
+	for _, name := range r.uuids {
+		if v := u.Get(name); v != "" {
+			if reason := checkParamValue("uuid", v); reason != "" {
+				e.Invalid = append(e.Invalid, name+": "+reason)
+			}
+		}
+	}
+	names := make([]string, 0, len(r.lengths))
+	for name := range r.lengths {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if reason := checkParamLength(u.Get(name), r.lengths[name]); reason != "" {
+			e.Invalid = append(e.Invalid, name+": "+reason)
+		}
+	}
+	return e.orNil()
+}
+
+// isInvalidID reports whether err is a *ParamsError because the id parameter is not a UUID
+func isInvalidID(err error) bool {
+	var e *ParamsError
+	if !errors.As(err, &e) {
+		return false
+	}
+	for _, reason := range e.Invalid {
+		if strings.HasPrefix(reason, "id: ") {
+			return true
+		}
+	}
+	return false
+}
+
+// ParamValidation is the mode in which the client checks the parameters of calls against the APIs of the server
+type ParamValidation int
+
+const (
+	// ValidationOff doesn't check parameters
+	ValidationOff ParamValidation = iota
+
+	// ValidationWarn logs a warning when the parameters of a call don't match the API, but makes the call
+	ValidationWarn
+
+	// ValidationStrict fails calls with a *ParamsError when their parameters don't match the API
+	ValidationStrict
+)
+
+// WithParamValidation makes the CloudStackClient check the parameters of every call against the APIs of the
+// server (see APISchema), which are fetched with listApis when the first call is made. Parameters the server
+// doesn't know are otherwise silently ignored by the server. When the APIs cannot be fetched, calls are not
+// checked. Warnings are logged with the logger of the client (see WithLogger) or the default slog logger.
+func WithParamValidation(mode ParamValidation) ClientOption {
+	return func(cs *CloudStackClient) {
+		cs.discovery.validation = mode
+	}
+}
+
+// checkParams checks the parameters of a call against the schema, according to the validation mode of the client
+func (cs *CloudStackClient) checkParams(ctx context.Context, schema *APISchema, api string, params url.Values) error {
+	err := schema.Validate(api, params)
+	if err == nil || cs.discovery.validation == ValidationStrict {
+		return err
+	}
+
+	logger := slog.Default()
+	if cs.logger != nil {
+		logger = cs.logger.logger
+	}
+	logger.WarnContext(ctx, "CloudStack API parameters don't match the server", slog.String("command", api), slog.String("error", err.Error()))
+	return nil
+}
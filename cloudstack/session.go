@@ -0,0 +1,207 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+)
+
+// session holds the credentials and the current session key of a client
+// that authenticates with a username and password instead of an API key.
+type session struct {
+	username string
+	password string
+	domain   string
+
+	mu      sync.Mutex
+	key     string
+	loginCh chan struct{} // Closed when the login in progress is done; nil if no login is in progress
+}
+
+// Creates a new session client for communicating with CloudStack. Instead of signing every request with an API key and
+// secret, the client logs in with the given username and password (for example LDAP credentials) and sends the resulting
+// session key with every call. When the session expires the client transparently logs in again. Use an empty domain to
+// log in to the ROOT domain. Call Close when done with the client to log out and end the session.
+func NewSessionClient(apiurl string, username string, password string, domain string, verifyssl bool, options ...ClientOption) (*CloudStackClient, error) {
+	return newSessionClient(apiurl, username, password, domain, false, verifyssl, options...)
+}
+
+// For sync API calls this client behaves exactly the same as a standard session client call, but for async API calls
+// this client will wait until the async job is finished or until the configured AsyncTimeout is reached.
+func NewAsyncSessionClient(apiurl string, username string, password string, domain string, verifyssl bool, options ...ClientOption) (*CloudStackClient, error) {
+	return newSessionClient(apiurl, username, password, domain, true, verifyssl, options...)
+}
+
+func newSessionClient(apiurl string, username string, password string, domain string, async bool, verifyssl bool, options ...ClientOption) (*CloudStackClient, error) {
+	cs := newClient(apiurl, "", "", async, verifyssl, options...)
+	cs.session = &session{
+		username: username,
+		password: password,
+		domain:   domain,
+	}
+
+	if _, err := cs.session.sessionKey(context.Background(), cs); err != nil {
+		return nil, err
+	}
+
+	return cs, nil
+}
+
+// Close ends the session of a client created with NewSessionClient by logging out. For clients
+// that use an API key and secret there is no session, so Close does nothing.
+func (cs *CloudStackClient) Close() error {
+	if cs.session == nil {
+		return nil
+	}
+
+	cs.session.mu.Lock()
+	key := cs.session.key
+	cs.session.mu.Unlock()
+
+	if key == "" {
+		return nil
+	}
+
+	_, err := cs.Authentication.Logout(cs.Authentication.NewLogoutParams())
+
+	cs.session.mu.Lock()
+	if cs.session.key == key {
+		cs.session.key = ""
+	}
+	cs.session.mu.Unlock()
+
+	return err
+}
+
+// sessionKey returns the current session key, logging in first if there is no active session.
+func (s *session) sessionKey(ctx context.Context, cs *CloudStackClient) (string, error) {
+	return s.ensureKey(ctx, cs, "")
+}
+
+// relogin replaces the expired session key with a new one. When another call already
+// logged in again in the meantime, the new key is returned without a second login.
+func (s *session) relogin(ctx context.Context, cs *CloudStackClient, expired string) (string, error) {
+	return s.ensureKey(ctx, cs, expired)
+}
+
+// ensureKey returns the session key, logging in when there is no key or the key is the expired one.
+// Only one login is made at a time; other calls wait for it to finish. The lock is not held during
+// the login call itself, as that call passes through the rest of the client.
+func (s *session) ensureKey(ctx context.Context, cs *CloudStackClient, expired string) (string, error) {
+	for {
+		s.mu.Lock()
+		if s.key != "" && s.key != expired {
+			key := s.key
+			s.mu.Unlock()
+			return key, nil
+		}
+
+		if ch := s.loginCh; ch != nil {
+			s.mu.Unlock()
+			select {
+			case <-ch:
+				continue
+			case <-ctx.Done():
+				return "", ctx.Err()
+			}
+		}
+
+		ch := make(chan struct{})
+		s.loginCh = ch
+		s.mu.Unlock()
+
+		key, err := s.login(ctx, cs)
+
+		s.mu.Lock()
+		if err == nil {
+			s.key = key
+		}
+		s.loginCh = nil
+		s.mu.Unlock()
+		close(ch)
+
+		return key, err
+	}
+}
+
+// login logs in and returns the new session key. It must be called without holding the session lock.
+func (s *session) login(ctx context.Context, cs *CloudStackClient) (string, error) {
+	p := cs.Authentication.NewLoginParams(s.password, s.username)
+	if s.domain != "" {
+		p.SetDomain(s.domain)
+	}
+
+	r, err := cs.Authentication.LoginWithContext(ctx, p)
+	if err != nil {
+		return "", err
+	}
+	return r.Sessionkey, nil
+}
+
+// Execute a request against a CS API using the session key instead of a signature. The JSESSIONID
+// cookie that belongs to the session is sent along by the cookie jar of the HTTP client.
+func (cs *CloudStackClient) newSessionRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {
+	params.Set("command", api)
+	params.Set("response", "json")
+
+	// The login call creates the session, so it is the only call made without a session key
+	if api == "login" {
+		return cs.sendSessionRequest(ctx, api, post, params)
+	}
+
+	key, err := cs.session.sessionKey(ctx, cs)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("sessionkey", key)
+
+	b, err := cs.sendSessionRequest(ctx, api, post, params)
+	if !IsUnauthorized(err) || api == "logout" {
+		return b, err
+	}
+
+	// The session expired or was invalidated server side, so log in again and retry once
+	key, err = cs.session.relogin(ctx, cs, key)
+	if err != nil {
+		return nil, err
+	}
+	params.Set("sessionkey", key)
+
+	return cs.sendSessionRequest(ctx, api, post, params)
+}
+
+func (cs *CloudStackClient) sendSessionRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {
+	return cs.doRequest(ctx, api, func() (*http.Request, error) {
+		if !cs.HTTPGETOnly && post {
+			req, err := http.NewRequestWithContext(ctx, http.MethodPost, cs.baseURL, strings.NewReader(params.Encode()))
+			if err != nil {
+				return nil, err
+			}
+			req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+			return req, nil
+		}
+		return http.NewRequestWithContext(ctx, http.MethodGet, cs.baseURL+"?"+EncodeValues(params), nil)
+	})
+}
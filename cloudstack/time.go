@@ -0,0 +1,93 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// TimeLayout is the layout of the dates in API responses, e.g. 2021-10-13T04:36:30+0000
+const TimeLayout = "2006-01-02T15:04:05-0700"
+
+// The layouts of the dates CloudStack emits, tried in order
+var timeLayouts = []string{
+	TimeLayout,
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.UnixDate,
+}
+
+// Time is a date in an API response. It decodes the formats CloudStack emits, and an empty string or null as
+// the zero time. It encodes using TimeLayout, or as an empty string when it is zero.
+type Time struct {
+	time.Time
+}
+
+// ParseTime parses a date in one of the formats CloudStack emits
+func ParseTime(s string) (Time, error) {
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return Time{t}, nil
+		}
+	}
+	return Time{}, fmt.Errorf("Unable to parse %q as a date", s)
+}
+
+func (t Time) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte(`""`), nil
+	}
+	return json.Marshal(t.Format(TimeLayout))
+}
+
+func (t *Time) UnmarshalJSON(b []byte) error {
+	b = bytes.TrimSpace(b)
+	if bytes.Equal(b, []byte("null")) {
+		*t = Time{}
+		return nil
+	}
+
+	// Some dates are returned as milliseconds since the epoch
+	if ms, err := strconv.ParseInt(string(b), 10, 64); err == nil {
+		*t = Time{time.UnixMilli(ms)}
+		return nil
+	}
+
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*t = Time{}
+		return nil
+	}
+
+	pt, err := ParseTime(s)
+	if err != nil {
+		return err
+	}
+	*t = pt
+	return nil
+}
@@ -0,0 +1,231 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// The attributes set on the spans of API calls
+const (
+	AttrCommand   = "cloudstack.command"
+	AttrZoneID    = "cloudstack.zone_id"
+	AttrProjectID = "cloudstack.project_id"
+	AttrAccount   = "cloudstack.account"
+	AttrJobID     = "cloudstack.job_id"
+	AttrJobStatus = "cloudstack.job_status"
+)
+
+// The parameters that are added to the spans of API calls as attributes
+var spanParams = map[string]string{
+	"zoneid":    AttrZoneID,
+	"projectid": AttrProjectID,
+	"account":   AttrAccount,
+}
+
+// Tracer opens spans for the API calls of a client. It is implemented by adapters for tracing libraries, like
+// the OpenTelemetry adapter in the cloudstack/otel package.
+type Tracer interface {
+	// Start opens a span named after the API command as a child of the span in ctx, if any. The returned
+	// context must be derived from ctx and carry the new span.
+	Start(ctx context.Context, command string, attrs map[string]string) (context.Context, Span)
+}
+
+// Span is a span opened by a Tracer
+type Span interface {
+	// SetAttribute sets an attribute of the span
+	SetAttribute(key, value string)
+
+	// End closes the span, recording err if the call failed
+	End(err error)
+}
+
+type noopTracer struct{}
+
+func (noopTracer) Start(ctx context.Context, command string, attrs map[string]string) (context.Context, Span) {
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttribute(key, value string) {}
+func (noopSpan) End(err error)                  {}
+
+// NoopTracer is a Tracer that doesn't trace anything. It is the default tracer of a client.
+var NoopTracer Tracer = noopTracer{}
+
+// tracing holds the tracer of a client
+type tracing struct {
+	tracer Tracer
+}
+
+// jobSpan holds the span of an async API call, which is kept open until the job is finished
+type jobSpan struct {
+	ctx  context.Context
+	span Span
+}
+
+type jobSpanKey struct{}
+
+// WithTracer opens a span for every API call of the CloudStackClient, with the command, zone, project,
+// account and job ID as attributes. When the client waits for an async job, the span of the call stays open
+// until the job is finished and gets the final job status, and every poll of the job gets a child span.
+func WithTracer(tracer Tracer) ClientOption {
+	return func(cs *CloudStackClient) {
+		if tracer == nil || tracer == NoopTracer {
+			cs.tracing = nil
+			return
+		}
+		cs.tracing = &tracing{tracer: tracer}
+	}
+}
+
+// startSpan opens the span of an API call
+func (cs *CloudStackClient) startSpan(ctx context.Context, api string, params url.Values) (context.Context, Span) {
+	if cs.tracing == nil {
+		return ctx, noopSpan{}
+	}
+
+	attrs := map[string]string{AttrCommand: api}
+	for param, attr := range spanParams {
+		if v := params.Get(param); v != "" {
+			attrs[attr] = v
+		}
+	}
+	return cs.tracing.tracer.Start(ctx, api, attrs)
+}
+
+// endSpan closes the span of an API call, unless the client is going to wait for the async job started by the
+// call, in which case the span is handed to the jobSpan of ctx and closed by waitForJob
+func (cs *CloudStackClient) endSpan(ctx context.Context, span Span, api string, b json.RawMessage, err error) {
+	if cs.tracing == nil {
+		return
+	}
+	js, _ := ctx.Value(jobSpanKey{}).(*jobSpan)
+	if err != nil || js == nil {
+		span.End(err)
+		return
+	}
+
+	var r struct {
+		JobID string `json:"jobid"`
+	}
+	if json.Unmarshal(b, &r) != nil || r.JobID == "" {
+		span.End(nil)
+		return
+	}
+
+	span.SetAttribute(AttrJobID, r.JobID)
+	if !cs.waitForAsyncJob(ctx) {
+		span.End(nil)
+		return
+	}
+	js.ctx, js.span = ctx, span
+}
+
+// withJobSpan returns a copy of ctx for an async API call, in which endSpan leaves the span of the call open
+// for waitForJob. The caller must release the jobSpan when it returns, which ends the span if the caller
+// returned without waiting for the job.
+func (cs *CloudStackClient) withJobSpan(ctx context.Context) (context.Context, *jobSpan) {
+	if cs.tracing == nil {
+		return ctx, nil
+	}
+	js := &jobSpan{}
+	return context.WithValue(ctx, jobSpanKey{}, js), js
+}
+
+// openJobSpan returns the open span of the async API call made with ctx, or nil if there is none
+func openJobSpan(ctx context.Context) *jobSpan {
+	if js, _ := ctx.Value(jobSpanKey{}).(*jobSpan); js != nil && js.span != nil {
+		return js
+	}
+	return nil
+}
+
+// jobWaitSpan returns the span a wait for an async job is traced in, and the context to poll the job with. That is
+// the open span of the async API call made with ctx, or else a new span for the job.
+func (cs *CloudStackClient) jobWaitSpan(ctx context.Context, command, jobid string) (context.Context, *jobSpan) {
+	js := openJobSpan(ctx)
+	if js == nil {
+		if cs.tracing == nil {
+			return ctx, nil
+		}
+
+		// The command is not known for handles of jobs created with NewJob
+		name, attrs := "asyncJob", map[string]string{AttrJobID: jobid}
+		if command != "" {
+			name, attrs[AttrCommand] = command, command
+		}
+		js = &jobSpan{}
+		js.ctx, js.span = cs.tracing.tracer.Start(ctx, name, attrs)
+	}
+
+	// Poll the job within the span, the polls don't hand over their spans
+	return context.WithValue(js.ctx, jobSpanKey{}, (*jobSpan)(nil)), js
+}
+
+// end closes the span of an async API call with the final status of its job
+func (js *jobSpan) end(err error) {
+	if js == nil || js.span == nil {
+		return
+	}
+	js.span.SetAttribute(AttrJobStatus, jobErrStatus(err).String())
+	js.span.End(err)
+	js.span = nil
+}
+
+// release closes the span of an async API call that returned without waiting for its job
+func (js *jobSpan) release() {
+	if js == nil || js.span == nil {
+		return
+	}
+	js.span.End(nil)
+	js.span = nil
+}
+
+// startPollSpans opens a span for the poll of every job by the job watcher, as a child of the context the
+// job is waited for with
+func (cs *CloudStackClient) startPollSpans(command string, jobs map[string]context.Context) map[string]Span {
+	if cs.tracing == nil {
+		return nil
+	}
+
+	spans := make(map[string]Span, len(jobs))
+	for id, ctx := range jobs {
+		_, spans[id] = cs.tracing.tracer.Start(ctx, command, map[string]string{AttrCommand: command, AttrJobID: id})
+	}
+	return spans
+}
+
+// endPollSpans closes the spans of a poll by the job watcher with the status of the polled jobs
+func endPollSpans(spans map[string]Span, updates map[string]jobUpdate) {
+	for id, span := range spans {
+		u, ok := updates[id]
+		switch {
+		case !ok:
+			span.SetAttribute(AttrJobStatus, JobPending.String())
+		case u.err == nil:
+			span.SetAttribute(AttrJobStatus, JobStatus(u.r.Jobstatus).String())
+		}
+		span.End(u.err)
+	}
+}
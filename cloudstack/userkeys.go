@@ -0,0 +1,98 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"fmt"
+)
+
+// RotatedUserKeys is the result of rotating the API keys of a user
+type RotatedUserKeys struct {
+	UserID string
+
+	// Old are the keys of the user before the rotation, which are no longer valid
+	Old Credentials
+
+	// New are the keys generated by the rotation
+	New Credentials
+
+	// Swapped tells if the new keys replaced the old ones in the credential provider of the client
+	Swapped bool
+}
+
+// credentialSetter is implemented by credential providers whose credentials can be replaced
+type credentialSetter interface {
+	Set(creds Credentials)
+}
+
+// RotateUserKeys generates a new API key and secret for a user and returns both the old and new keys for audit.
+// When the client itself uses the old keys and its credential provider can be updated (like the default
+// StaticCredentialProvider), the new keys are swapped into the client right away, as the old keys are no
+// longer valid. The new keys are then verified by calling the read-only listCapabilities API with them. If the
+// verification fails, the keys are returned together with the error.
+func (s *UserService) RotateUserKeys(userid string) (*RotatedUserKeys, error) {
+	return s.RotateUserKeysWithContext(context.Background(), userid)
+}
+
+// RotateUserKeysWithContext is like RotateUserKeys, but honours the cancellation and deadline of ctx
+func (s *UserService) RotateUserKeysWithContext(ctx context.Context, userid string) (*RotatedUserKeys, error) {
+	old, err := s.GetUserKeysWithContext(ctx, s.NewGetUserKeysParams(userid))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the current keys of user %s: %v", userid, err)
+	}
+
+	current, err := s.cs.credentials.Credentials(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	r, err := s.RegisterUserKeysWithContext(ctx, s.NewRegisterUserKeysParams(userid))
+	if err != nil {
+		return nil, fmt.Errorf("Failed to register new keys for user %s: %v", userid, err)
+	}
+
+	keys := &RotatedUserKeys{
+		UserID: userid,
+		Old:    Credentials{APIKey: old.Apikey, SecretKey: old.Secretkey},
+		New:    Credentials{APIKey: r.Apikey, SecretKey: r.Secretkey},
+	}
+
+	if keys.New.APIKey == "" || keys.New.SecretKey == "" {
+		// Older versions of CloudStack don't return the new keys, so read them back
+		n, err := s.GetUserKeysWithContext(ctx, s.NewGetUserKeysParams(userid))
+		if err != nil {
+			return keys, fmt.Errorf("Failed to get the new keys of user %s: %v", userid, err)
+		}
+		keys.New = Credentials{APIKey: n.Apikey, SecretKey: n.Secretkey}
+	}
+
+	// The old keys are no longer valid, so the client must use the new keys for its next calls
+	if setter, ok := s.cs.credentials.(credentialSetter); ok && current.APIKey == keys.Old.APIKey {
+		setter.Set(keys.New)
+		keys.Swapped = true
+	}
+
+	if _, err := s.cs.Configuration.ListCapabilitiesWithContext(withCredentials(ctx, keys.New), s.cs.Configuration.NewListCapabilitiesParams()); err != nil {
+		return keys, fmt.Errorf("Failed to verify the new keys of user %s: %v", userid, err)
+	}
+
+	return keys, nil
+}
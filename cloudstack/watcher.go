@@ -0,0 +1,300 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package cloudstack
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+	"time"
+)
+
+// The default interval used by the job watcher to poll the async jobs
+const defaultWatchInterval = 2 * time.Second
+
+// ListAsyncJobs can only filter jobs on their start date, so the watcher lists the jobs of the account started
+// since the oldest watched job. Until the start of a job is known from a listing or query, it is listed from a
+// bit before it was registered to allow for clock differences between the client and the management server.
+// Jobs started before that are not listed and are queried separately, after which their start is known.
+const watchClockSkew = 5 * time.Minute
+
+// The page size used when listing async jobs
+const watchPageSize = 500
+
+// WithJobWatcher enables a shared job watcher on the CloudStackClient. Instead of polling every async job on its own
+// with QueryAsyncJobResult, the watcher polls all jobs the client is waiting for with one stream of ListAsyncJobs
+// calls and hands the results to the waiters. Jobs that are not returned by ListAsyncJobs are queried separately as
+// a fallback. Jobs that fail to be queried because of a transient error are polled again at the next interval.
+// The watcher polls at a fixed interval, so the poll strategy of the client is not used. Use a zero
+// interval for the default of 2 seconds.
+func WithJobWatcher(interval time.Duration) ClientOption {
+	return func(cs *CloudStackClient) {
+		if interval <= 0 {
+			interval = defaultWatchInterval
+		}
+		cs.watcher = &jobWatcher{
+			cs:       cs,
+			interval: interval,
+			jobs:     make(map[string]*watchedJob),
+		}
+	}
+}
+
+type jobUpdate struct {
+	r   *QueryAsyncJobResultResponse
+	err error
+}
+
+type watchedJob struct {
+	since   time.Time // When the job was registered
+	created time.Time // When the job was started according to the server, zero until known
+	waiters []chan jobUpdate
+
+	// The context of the first waiter, which carries the span the polls of the job are traced in
+	ctx context.Context
+}
+
+// jobWatcher polls all async jobs waited on by the client in batches. The polling goroutine
+// is started when the first job is registered and stops when there are no jobs left to watch.
+type jobWatcher struct {
+	cs       *CloudStackClient
+	interval time.Duration
+
+	mu      sync.Mutex
+	jobs    map[string]*watchedJob
+	running bool
+	cancel  context.CancelFunc // Cancels the running poll, nil if not polling
+}
+
+// jobResult works like GetAsyncJobResultWithContext, but waits for the job using the watcher
+func (w *jobWatcher) jobResult(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {
+	wctx, cancel := context.WithTimeout(ctx, time.Duration(timeout)*time.Second)
+	defer cancel()
+
+	r, err := w.wait(wctx, jobid)
+	if err != nil {
+		if ctx.Err() == nil && wctx.Err() != nil {
+			return nil, AsyncTimeoutErr
+		}
+		return nil, err
+	}
+
+	if JobStatus(r.Jobstatus) == JobFailed {
+		return nil, newAsyncJobError(jobid, r)
+	}
+	return r.Jobresult, nil
+}
+
+// wait registers the job and blocks until the job is finished or ctx is done
+func (w *jobWatcher) wait(ctx context.Context, jobid string) (*QueryAsyncJobResultResponse, error) {
+	ch := make(chan jobUpdate, 1)
+
+	w.mu.Lock()
+	j, ok := w.jobs[jobid]
+	if !ok {
+		j = &watchedJob{since: time.Now(), ctx: ctx}
+		w.jobs[jobid] = j
+	}
+	j.waiters = append(j.waiters, ch)
+	if !w.running {
+		w.running = true
+		go w.run()
+	}
+	w.mu.Unlock()
+
+	select {
+	case u := <-ch:
+		return u.r, u.err
+	case <-ctx.Done():
+		w.remove(jobid, ch)
+		return nil, ctx.Err()
+	}
+}
+
+// remove unregisters a waiter that is no longer interested in the job
+func (w *jobWatcher) remove(jobid string, ch chan jobUpdate) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	j, ok := w.jobs[jobid]
+	if !ok {
+		return
+	}
+
+	for i, c := range j.waiters {
+		if c == ch {
+			j.waiters = append(j.waiters[:i], j.waiters[i+1:]...)
+			break
+		}
+	}
+	if len(j.waiters) == 0 {
+		delete(w.jobs, jobid)
+	}
+	if len(w.jobs) == 0 && w.cancel != nil {
+		// Nobody is waiting for the running poll anymore
+		w.cancel()
+	}
+}
+
+// run polls the registered jobs until there are no jobs left to watch
+func (w *jobWatcher) run() {
+	for {
+		time.Sleep(w.interval)
+
+		w.mu.Lock()
+		if len(w.jobs) == 0 {
+			w.running = false
+			w.mu.Unlock()
+			return
+		}
+
+		ids := make([]string, 0, len(w.jobs))
+		ctxs := make(map[string]context.Context, len(w.jobs))
+		since := time.Now()
+		for id, j := range w.jobs {
+			ids = append(ids, id)
+			ctxs[id] = j.ctx
+
+			start := j.created
+			if start.IsZero() {
+				start = j.since.Add(-watchClockSkew)
+			}
+			if start.Before(since) {
+				since = start
+			}
+		}
+		ctx, cancel := context.WithCancel(context.Background())
+		w.cancel = cancel
+		w.mu.Unlock()
+
+		spans := w.cs.startPollSpans("listAsyncJobs", ctxs)
+		updates := w.poll(ctx, ids, since)
+		endPollSpans(spans, updates)
+
+		w.mu.Lock()
+		w.cancel = nil
+		w.mu.Unlock()
+		cancel()
+
+		for id, u := range updates {
+			w.deliver(id, u)
+		}
+	}
+}
+
+// poll returns the updates for all jobs that are finished or failed to be queried with a permanent error
+func (w *jobWatcher) poll(ctx context.Context, ids []string, since time.Time) map[string]jobUpdate {
+	// If listing the jobs fails, all jobs are queried separately
+	listed := make(map[string]*AsyncJob)
+	if jobs, err := w.listJobs(ctx, since); err == nil {
+		for _, j := range jobs {
+			listed[j.JobID] = j
+		}
+	}
+
+	updates := make(map[string]jobUpdate)
+	for _, id := range ids {
+		if ctx.Err() != nil {
+			break
+		}
+
+		if j, ok := listed[id]; ok {
+			w.started(id, j.Created.Time)
+			if JobStatus(j.Jobstatus) == JobPending {
+				continue
+			}
+			if len(j.Jobresult) > 0 {
+				r := QueryAsyncJobResultResponse(*j)
+				updates[id] = jobUpdate{r: &r}
+				continue
+			}
+		}
+
+		// The job is not listed, or listed without its result, so query it separately
+		p := &QueryAsyncJobResultParams{}
+		p.p = make(map[string]interface{})
+		p.SetJobID(id)
+		r, err := w.cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)
+		if err != nil {
+			if !isTransientError(err) {
+				updates[id] = jobUpdate{err: err}
+			}
+			continue
+		}
+		w.started(id, r.Created.Time)
+		if JobStatus(r.Jobstatus) != JobPending {
+			updates[id] = jobUpdate{r: r}
+		}
+	}
+
+	return updates
+}
+
+// started records when the job was started according to the server
+func (w *jobWatcher) started(jobid string, created time.Time) {
+	if created.IsZero() {
+		return
+	}
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if j, ok := w.jobs[jobid]; ok {
+		j.created = created
+	}
+}
+
+// listJobs returns the async jobs of the account started since the given time
+func (w *jobWatcher) listJobs(ctx context.Context, since time.Time) ([]*AsyncJob, error) {
+	p := &ListAsyncJobsParams{}
+	p.p = make(map[string]interface{})
+	p.SetStartdate(since.Format(TimeLayout))
+	p.SetPagesize(watchPageSize)
+
+	var jobs []*AsyncJob
+	for page := 1; ; page++ {
+		p.SetPage(page)
+		l, err := w.cs.Asyncjob.ListAsyncJobsWithContext(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+
+		jobs = append(jobs, l.AsyncJobs...)
+		if len(l.AsyncJobs) == 0 || len(jobs) >= l.Count {
+			return jobs, nil
+		}
+	}
+}
+
+// deliver hands the update to all waiters of the job and stops watching it
+func (w *jobWatcher) deliver(jobid string, u jobUpdate) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	j, ok := w.jobs[jobid]
+	if !ok {
+		return
+	}
+	delete(w.jobs, jobid)
+
+	for _, ch := range j.waiters {
+		ch <- u
+	}
+}
@@ -0,0 +1,225 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package main
+
+import "regexp"
+
+// enumType is a typed string enum that is generated for params and response fields with a known set of
+// values. Values that are not known are still accepted, as newer servers may return or accept more values.
+type enumType struct {
+	// Doc describes what the values are
+	Doc string
+
+	// Values holds the name of the constant (without the type name prefix) and the value of every known value
+	Values [][2]string
+
+	// Fold is set when the API matches the values case-insensitively
+	Fold bool
+}
+
+// enumTypes holds the enum types that are generated, by type name
+var enumTypes = map[string]*enumType{
+	"VirtualMachineState": {
+		Doc: "the state of a virtual machine",
+		Values: [][2]string{
+			{"Starting", "Starting"},
+			{"Running", "Running"},
+			{"Stopping", "Stopping"},
+			{"Stopped", "Stopped"},
+			{"Migrating", "Migrating"},
+			{"Error", "Error"},
+			{"Unknown", "Unknown"},
+			{"Shutdown", "Shutdown"},
+			{"Destroyed", "Destroyed"},
+			{"Expunging", "Expunging"},
+			{"Restoring", "Restoring"},
+			{"BackingUp", "BackingUp"},
+		},
+	},
+	"VolumeState": {
+		Doc: "the state of a volume",
+		Values: [][2]string{
+			{"Allocated", "Allocated"},
+			{"Creating", "Creating"},
+			{"Ready", "Ready"},
+			{"Resizing", "Resizing"},
+			{"Migrating", "Migrating"},
+			{"Copying", "Copying"},
+			{"Snapshotting", "Snapshotting"},
+			{"RevertSnapshotting", "RevertSnapshotting"},
+			{"Attaching", "Attaching"},
+			{"Restoring", "Restoring"},
+			{"NotUploaded", "NotUploaded"},
+			{"UploadOp", "UploadOp"},
+			{"Uploaded", "Uploaded"},
+			{"UploadError", "UploadError"},
+			{"UploadAbandoned", "UploadAbandoned"},
+			{"Destroy", "Destroy"},
+			{"Expunging", "Expunging"},
+			{"Expunged", "Expunged"},
+		},
+	},
+	"HostResourceState": {
+		Doc: "the resource state of a host",
+		Values: [][2]string{
+			{"Creating", "Creating"},
+			{"Enabled", "Enabled"},
+			{"Disabled", "Disabled"},
+			{"PrepareForMaintenance", "PrepareForMaintenance"},
+			{"ErrorInPrepareForMaintenance", "ErrorInPrepareForMaintenance"},
+			{"ErrorInMaintenance", "ErrorInMaintenance"},
+			{"Maintenance", "Maintenance"},
+			{"Degraded", "Degraded"},
+			{"Error", "Error"},
+		},
+	},
+	"NetworkState": {
+		Doc: "the state of a network",
+		Values: [][2]string{
+			{"Allocated", "Allocated"},
+			{"Configured", "Configured"},
+			{"Implementing", "Implementing"},
+			{"Implemented", "Implemented"},
+			{"Setup", "Setup"},
+			{"Shutdown", "Shutdown"},
+			{"Destroy", "Destroy"},
+		},
+	},
+	"TemplateStatus": {
+		Doc: "the status of a template",
+		Values: [][2]string{
+			{"DownloadComplete", "Download Complete"},
+			{"SuccessfullyInstalled", "Successfully Installed"},
+			{"InstallingTemplate", "Installing Template"},
+			{"BypassedSecondaryStorage", "Bypassed Secondary Storage"},
+			{"NotUploaded", "Not Uploaded"},
+			{"UploadInProgress", "Upload in Progress"},
+			{"UploadComplete", "Upload Complete"},
+		},
+	},
+	"HypervisorType": {
+		Doc: "a hypervisor type",
+		Values: [][2]string{
+			{"KVM", "KVM"},
+			{"XenServer", "XenServer"},
+			{"VMware", "VMware"},
+			{"HyperV", "Hyperv"},
+			{"LXC", "LXC"},
+			{"Ovm", "Ovm"},
+			{"Ovm3", "Ovm3"},
+			{"BareMetal", "BareMetal"},
+			{"Simulator", "Simulator"},
+			{"External", "External"},
+		},
+		Fold: true,
+	},
+	"IntervalType": {
+		Doc: "the interval of a snapshot or backup schedule",
+		Values: [][2]string{
+			{"Hourly", "HOURLY"},
+			{"Daily", "DAILY"},
+			{"Weekly", "WEEKLY"},
+			{"Monthly", "MONTHLY"},
+		},
+		Fold: true,
+	},
+	"Protocol": {
+		Doc: "the protocol of a firewall, port forwarding, load balancer or network ACL rule",
+		Values: [][2]string{
+			{"TCP", "tcp"},
+			{"UDP", "udp"},
+			{"ICMP", "icmp"},
+			{"All", "all"},
+			{"TCPProxy", "tcp-proxy"},
+			{"SSL", "ssl"},
+		},
+		Fold: true,
+	},
+}
+
+// enumParam sets the enum type of a param in all APIs, except the listed ones where the param has other values
+type enumParam struct {
+	Type   string
+	Except map[string]bool
+}
+
+// enumParams maps params to their enum type
+var enumParams = map[string]enumParam{
+	"hypervisor":   {Type: "HypervisorType"},
+	"intervaltype": {Type: "IntervalType"},
+	"protocol": {Type: "Protocol", Except: map[string]bool{
+		"listImageStores":            true,
+		"listSecondaryStagingStores": true,
+	}},
+}
+
+// enumResponseField sets the enum type of a response field in the response types matching a pattern
+type enumResponseField struct {
+	Types *regexp.Regexp
+	Type  string
+}
+
+// enumResponseFields maps response fields to their enum type. The first matching response type wins.
+var enumResponseFields = map[string][]enumResponseField{
+	"state": {
+		{regexp.MustCompile(`^(VirtualMachine|VirtualMachinesMetric|ImportUnmanagedInstanceResponse|ImportVmResponse|\w+VirtualMachine(WithVolume)?Response)$`), "VirtualMachineState"},
+		{regexp.MustCompile(`^(Volume|VolumesMetric|(Assign|Attach|ChangeOfferingFor|Check|Create|Destroy|Detach|Import|Migrate|Recover|Resize|Update|Upload)VolumeResponse)$`), "VolumeState"},
+		{regexp.MustCompile(`^(Network|(Create|Migrate|Update)NetworkResponse)$`), "NetworkState"},
+	},
+	"resourcestate": {
+		{regexp.MustCompile(`^(Host|HostForMigration|HostsMetric|\w+Host\w*Response)$`), "HostResourceState"},
+	},
+	"status": {
+		{regexp.MustCompile(`^(Template|RegisterTemplate|(Copy|Create|LinkUserDataTo|Prepare|RegisterVnf|Update|UpdateVnf)TemplateResponse)$`), "TemplateStatus"},
+	},
+}
+
+// mapParamType returns the type of a param, which is its enum type if it has one
+func mapParamType(aName string, pName string, pType string) string {
+	if e, ok := enumParams[pName]; ok && !e.Except[aName] && pType == "string" {
+		return e.Type
+	}
+	return mapType(aName, pName, pType)
+}
+
+// mapResponseType returns the type of a field of a response type, which is its enum type if it has one.
+// Numbers and booleans are decoded leniently, as some CloudStack versions return them as strings.
+func mapResponseType(aName string, tn string, rName string, rType string) string {
+	if rType == "string" {
+		for _, e := range enumResponseFields[rName] {
+			if e.Types.MatchString(tn) {
+				return e.Type
+			}
+		}
+	}
+	switch rType {
+	case "boolean":
+		return "FlexBool"
+	case "short", "int", "integer":
+		return "FlexInt"
+	case "long":
+		if !longToStringConvertedParams[rName] {
+			return "FlexInt64"
+		}
+	case "float", "double", "bigdecimal":
+		return "FlexFloat"
+	}
+	return mapType(aName, rName, rType)
+}
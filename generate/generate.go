@@ -32,6 +32,7 @@ import (
 	"path"
 	"regexp"
 	"sort"
+	"strconv"
 	"strings"
 	"unicode"
 )
@@ -173,6 +174,15 @@ var longToStringConvertedParams = map[string]bool{
 	"managementserverid": true,
 }
 
+// stringEncodedResponseFields is a prefilled map with response fields
+// that are documented as numbers, but may be returned as JSON strings.
+// These are converted back to numbers before decoding the response.
+var stringEncodedResponseFields = map[string]map[string]bool{
+	"login": map[string]bool{
+		"timeout": true,
+	},
+}
+
 // customResponseStructTypes maps the API call to a custom struct name
 // This is to change the struct type name to something other than the API name
 var customResponseStructTypes = map[string]string{
@@ -324,6 +334,7 @@ type APIParam struct {
 	Description string `json:"description"`
 	Type        string `json:"type"`
 	Required    bool   `json:"required"`
+	Length      int    `json:"length"`
 }
 
 // APIResponse represents a API response
@@ -449,7 +460,7 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("// UnlimitedResourceID is a special ID to define an unlimited resource")
 	pn("const UnlimitedResourceID = \"-1\"")
 	pn("")
-	pn("var idRegex = regexp.MustCompile(`^([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|-1)$`)")
+	pn("var idRegex = regexp.MustCompile(`^(?i)([0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}|-1)$`)")
 	pn("")
 	pn("// IsID return true if the passed ID is either a UUID or a UnlimitedResourceID")
 	pn("func IsID(id string) bool {")
@@ -462,16 +473,6 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("// OptionFunc can be passed to the courtesy helper functions to set additional parameters")
 	pn("type OptionFunc func(*CloudStackClient, interface{}) error")
 	pn("")
-	pn("type CSError struct {")
-	pn("	ErrorCode   int    `json:\"errorcode\"`")
-	pn("	CSErrorCode int    `json:\"cserrorcode\"`")
-	pn("	ErrorText   string `json:\"errortext\"`")
-	pn("}")
-	pn("")
-	pn("func (e *CSError) Error() error {")
-	pn("	return fmt.Errorf(\"CloudStack API error %%d (CSExceptionErrorCode: %%d): %%s\", e.ErrorCode, e.CSErrorCode, e.ErrorText)")
-	pn("}")
-	pn("")
 
 	pn("type UUID string")
 	pn("")
@@ -498,11 +499,22 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("")
 	pn("	client  *http.Client // The http client for communicating")
 	pn("	baseURL string       // The base URL of the API")
-	pn("	apiKey  string       // Api key")
-	pn("	secret  string       // Secret key")
 	pn("	async   bool         // Wait for async calls to finish")
 	pn("	options []OptionFunc // A list of option functions to apply to all API calls")
 	pn("	timeout int64        // Max waiting timeout in seconds for async jobs to finish; defaults to 300 seconds")
+	pn("	session *session     // Username/password session used instead of the api key and secret")
+	pn("	poll    PollStrategy // Strategy deciding how long to wait between polls of async jobs")
+	pn("	watcher *jobWatcher  // Shared watcher polling all async jobs at once; nil if not enabled")
+	pn("	retry   *RetryPolicy // Policy for retrying failed requests; nil if not enabled")
+	pn("	limiter *RateLimiter // Client side limiter of the rate of API calls; nil if not enabled")
+	pn("")
+	pn("	credentials CredentialProvider // Provider of the API key and secret used to sign requests")
+	pn("	middleware []Middleware // Middleware wrapping every API call, the first one being the outermost")
+	pn("	logger     *clientLogger // Logger for API calls and async jobs; nil if not enabled")
+	pn("	tracing    *tracing      // Tracer for API calls and async jobs; nil if not enabled")
+	pn("	metrics    Metrics       // Metrics of API calls and async jobs; nil if not enabled")
+	pn("	discovery  apiDiscovery  // APIs and capabilities of the server, fetched when first needed")
+	pn("	strictDecoding bool // Fail calls whose responses don't match their generated type")
 	pn("")
 	for _, s := range as.services {
 		pn("  %s %sIface", strings.TrimSuffix(s.name, "Service"), s.name)
@@ -531,11 +543,11 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("		Timeout: time.Duration(60 * time.Second),")
 	pn("		},")
 	pn("		baseURL: apiurl,")
-	pn("		apiKey:  apikey,")
-	pn("		secret:  secret,")
+	pn("		credentials: NewStaticCredentials(apikey, secret),")
 	pn("		async:   async,")
 	pn("		options: []OptionFunc{},")
 	pn("		timeout: 300,")
+	pn("		poll:    defaultPollStrategy,")
 	pn("	}")
 	pn("")
 	pn("	for _, fn := range options {")
@@ -561,6 +573,102 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("}")
 	pn("")
 
+	var asyncCommands []string
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			if a.Isasync {
+				asyncCommands = append(asyncCommands, a.Name)
+			}
+		}
+	}
+	sort.Strings(asyncCommands)
+
+	pn("// The commands that start an async job")
+	pn("var asyncCommands = map[string]bool{")
+	for _, c := range asyncCommands {
+		pn("	\"%s\": true,", c)
+	}
+	pn("}")
+	pn("")
+
+	pn("// The map parameters that are not encoded as name[i].key=k and name[i].value=v, by lowercase command and")
+	pn("// parameter name")
+	pn("var mapParamEncodings = map[string]map[string]mapEncoding{")
+	var encodedCommands []string
+	encodings := make(map[string][]string)
+	for _, s := range as.services {
+		for _, a := range s.apis {
+			for _, ap := range a.Params {
+				if mapParamType(a.Name, ap.Name, ap.Type) != "map[string]string" {
+					continue
+				}
+				e := paramMapEncoding(a.Name, ap.Name)
+				if e == (mapEncoding{Key: "key", Value: "value"}) {
+					continue
+				}
+				cmd := strings.ToLower(a.Name)
+				if encodings[cmd] == nil {
+					encodedCommands = append(encodedCommands, cmd)
+				}
+				encodings[cmd] = append(encodings[cmd], fmt.Sprintf("%q: %s,", strings.ToLower(ap.Name), e.literal()))
+			}
+		}
+	}
+	sort.Strings(encodedCommands)
+	for _, cmd := range encodedCommands {
+		sort.Strings(encodings[cmd])
+		pn("	\"%s\": {", cmd)
+		for _, e := range encodings[cmd] {
+			pn("		%s", e)
+		}
+		pn("	},")
+	}
+	pn("}")
+	pn("")
+
+	var enums []string
+	for name := range enumTypes {
+		enums = append(enums, name)
+	}
+	sort.Strings(enums)
+
+	for _, name := range enums {
+		e := enumTypes[name]
+		pn("// %s is %s. Newer servers may use values that are not known here.", name, e.Doc)
+		pn("type %s string", name)
+		pn("")
+		pn("const (")
+		for _, v := range e.Values {
+			pn("	%s%s %s = \"%s\"", name, v[0], name, v[1])
+		}
+		pn(")")
+		pn("")
+		if e.Fold {
+			pn("// IsValid tells if the value is one of the known values of %s, ignoring case", name)
+			pn("func (v %s) IsValid() bool {", name)
+			pn("	switch strings.ToLower(string(v)) {")
+			var values []string
+			for _, v := range e.Values {
+				values = append(values, strconv.Quote(strings.ToLower(v[1])))
+			}
+			pn("	case %s:", strings.Join(values, ", "))
+		} else {
+			pn("// IsValid tells if the value is one of the known values of %s", name)
+			pn("func (v %s) IsValid() bool {", name)
+			pn("	switch v {")
+			var values []string
+			for _, v := range e.Values {
+				values = append(values, name+v[0])
+			}
+			pn("	case %s:", strings.Join(values, ", "))
+		}
+		pn("		return true")
+		pn("	}")
+		pn("	return false")
+		pn("}")
+		pn("")
+	}
+
 	pn("// Default non-async client. So for async calls you need to implement and check the async job result yourself. When using")
 	pn("// HTTPS with a self-signed certificate to connect to your CloudStack API, you would probably want to set 'verifyssl' to")
 	pn("// false so the call ignores the SSL errors/warnings.")
@@ -609,14 +717,23 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("// A helper function that you can use to get the result of a running async job. If the job is not finished within the configured")
 	pn("// timeout, the async job returns a AsyncTimeoutErr.")
 	pn("func (cs *CloudStackClient) GetAsyncJobResult(jobid string, timeout int64) (json.RawMessage, error) {")
-	pn("	var timer time.Duration")
+	pn("	return cs.GetAsyncJobResultWithContext(context.Background(), jobid, timeout)")
+	pn("}")
+	pn("")
+	pn("// GetAsyncJobResultWithContext works like GetAsyncJobResult, but stops polling and returns the context error as")
+	pn("// soon as ctx is cancelled or its deadline expires.")
+	pn("func (cs *CloudStackClient) GetAsyncJobResultWithContext(ctx context.Context, jobid string, timeout int64) (json.RawMessage, error) {")
+	pn("	if cs.watcher != nil {")
+	pn("		return cs.watcher.jobResult(ctx, jobid, timeout)")
+	pn("	}")
+	pn("")
 	pn("	currentTime := time.Now().Unix()")
 	pn("")
-	pn("		for {")
+	pn("	for attempt := 1; ; attempt++ {")
 	pn("		p := &QueryAsyncJobResultParams{}")
 	pn("		p.p = make(map[string]interface{})")
 	pn("		p.SetJobID(jobid)")
-	pn("		r, err := cs.Asyncjob.QueryAsyncJobResult(p)")
+	pn("		r, err := cs.Asyncjob.QueryAsyncJobResultWithContext(ctx, p)")
 	pn("		if err != nil {")
 	pn("			return nil, err")
 	pn("		}")
@@ -628,46 +745,81 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("")
 	pn("		// When the status is 2, the job has failed")
 	pn("		if r.Jobstatus == 2 {")
-	pn("			if r.Jobresulttype == \"text\" {")
-	pn("				return nil, fmt.Errorf(string(r.Jobresult))")
-	pn("			} else {")
-	pn("				return nil, fmt.Errorf(\"Undefined error: %%s\", string(r.Jobresult))")
-	pn("			}")
+	pn("			return nil, newAsyncJobError(jobid, r)")
 	pn("		}")
 	pn("")
 	pn("		if time.Now().Unix()-currentTime > timeout {")
 	pn("			return nil, AsyncTimeoutErr")
 	pn("		}")
 	pn("")
-	pn("		// Add an (extremely simple) exponential backoff like feature to prevent")
-	pn("		// flooding the CloudStack API")
-	pn("		if timer < 15 {")
-	pn("			timer++")
+	pn("		// Wait as long as the poll strategy tells us to prevent flooding the CloudStack API")
+	pn("		select {")
+	pn("		case <-ctx.Done():")
+	pn("			return nil, ctx.Err()")
+	pn("		case <-time.After(cs.pollStrategy().Next(attempt)):")
 	pn("		}")
-	pn("")
-	pn("		time.Sleep(timer * time.Second)")
 	pn("	}")
 	pn("}")
 	pn("")
 	pn("// Execute the request against a CS API. Will return the raw JSON data returned by the API and nil if")
 	pn("// no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS")
 	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CloudStackClient) newRequest(api string, params url.Values) (json.RawMessage, error) {")
-	pn("		return cs.newRawRequest(api, false, params)")
+	pn("func (cs *CloudStackClient) newRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("		return cs.newRawRequest(ctx, api, false, params)")
 	pn("}")
 	pn("")
 	pn("// Execute the request against a CS API using POST. Will return the raw JSON data returned by the API and")
 	pn("// nil if no error occurred. If the API returns an error the result will be nil and the HTTP error code")
 	pn("// and CS error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CloudStackClient) newPostRequest(api string, params url.Values) (json.RawMessage, error) {")
-	pn("		return cs.newRawRequest(api, true, params)")
+	pn("func (cs *CloudStackClient) newPostRequest(ctx context.Context, api string, params url.Values) (json.RawMessage, error) {")
+	pn("		return cs.newRawRequest(ctx, api, true, params)")
 	pn("}")
 	pn("")
 	pn("// Execute a raw request against a CS API. Will return the raw JSON data returned by the API and nil if")
 	pn("// no error occurred. If the API returns an error the result will be nil and the HTTP error code and CS")
 	pn("// error details. If a processing (code) error occurs the result will be nil and the generated error")
-	pn("func (cs *CloudStackClient) newRawRequest(api string, post bool, params url.Values) (json.RawMessage, error) {")
-	pn("	params.Set(\"apiKey\", cs.apiKey)")
+	pn("func (cs *CloudStackClient) newRawRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {")
+	pn("	if err := cs.preflight(ctx, api, params); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	pn("	ctx, span := cs.startSpan(ctx, api, params)")
+	pn("")
+	pn("	var b json.RawMessage")
+	pn("	var err error")
+	pn("	if len(cs.middleware) > 0 {")
+	pn("		b, err = cs.newMiddlewareRequest(ctx, api, post, params)")
+	pn("	} else {")
+	pn("		b, err = cs.execRawRequest(ctx, api, post, params)")
+	pn("	}")
+	pn("")
+	pn("	cs.endSpan(ctx, span, api, b, err)")
+	pn("	return b, err")
+	pn("}")
+	pn("")
+	pn("// Execute a raw request against a CS API without passing it through the middleware of the client")
+	pn("func (cs *CloudStackClient) execRawRequest(ctx context.Context, api string, post bool, params url.Values) (json.RawMessage, error) {")
+	pn("	if creds, ok := ctx.Value(credentialsKey{}).(Credentials); ok {")
+	pn("		return cs.newSignedRequest(ctx, api, post, params, creds)")
+	pn("	}")
+	pn("	if cs.session != nil {")
+	pn("		return cs.newSessionRequest(ctx, api, post, params)")
+	pn("	}")
+	pn("	return cs.newCredentialsRequest(ctx, api, post, params)")
+	pn("}")
+	pn("")
+	pn("// Sign a raw request with the given credentials and execute it against a CS API")
+	pn("func (cs *CloudStackClient) newSignedRequest(ctx context.Context, api string, post bool, params url.Values, creds Credentials) (json.RawMessage, error) {")
+	pn("	// Every attempt is signed again, so a retried request doesn't expire while backing off")
+	pn("	return cs.doRequest(ctx, api, func() (*http.Request, error) {")
+	pn("		return cs.signRequest(ctx, api, post, params, creds)")
+	pn("	})")
+	pn("}")
+	pn("")
+	pn("// Prepare a raw request against a CS API, signed with the given credentials")
+	pn("func (cs *CloudStackClient) signRequest(ctx context.Context, api string, post bool, params url.Values, creds Credentials) (*http.Request, error) {")
+	pn("	params.Del(\"signature\")")
+	pn("	params.Set(\"apiKey\", creds.APIKey)")
 	pn("	params.Set(\"command\", api)")
 	pn("	params.Set(\"response\", \"json\")")
 	pn("	params.Set(\"signatureversion\", \"3\")")
@@ -681,12 +833,12 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	// * URL encode the string and convert to base64")
 	pn("	s := EncodeValues(params)")
 	pn("	s2 := strings.ToLower(s)")
-	pn("	mac := hmac.New(sha1.New, []byte(cs.secret))")
+	pn("	mac := hmac.New(sha1.New, []byte(creds.SecretKey))")
 	pn("	mac.Write([]byte(s2))")
 	pn("	signature := base64.StdEncoding.EncodeToString(mac.Sum(nil))")
 	pn("")
 	pn("	var err error")
-	pn("	var resp *http.Response")
+	pn("	var req *http.Request")
 	pn("	if !cs.HTTPGETOnly && post {")
 	pn("		// The deployVirtualMachine API should be called using a POST call")
 	pn("  	// so we don't have to worry about the userdata size")
@@ -695,14 +847,49 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("		params.Set(\"signature\", signature)")
 	pn("")
 	pn("		// Make a POST call")
-	pn("		resp, err = cs.client.PostForm(cs.baseURL, params)")
+	pn("		req, err = http.NewRequestWithContext(ctx, http.MethodPost, cs.baseURL, strings.NewReader(params.Encode()))")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("		req.Header.Set(\"Content-Type\", \"application/x-www-form-urlencoded\")")
 	pn("	} else {")
 	pn("		// Create the final URL before we issue the request")
 	pn("		url := cs.baseURL + \"?\" + s + \"&signature=\" + url.QueryEscape(signature)")
 	pn("")
 	pn("		// Make a GET call")
-	pn("		resp, err = cs.client.Get(url)")
+	pn("		req, err = http.NewRequestWithContext(ctx, http.MethodGet, url, nil)")
+	pn("		if err != nil {")
+	pn("			return nil, err")
+	pn("		}")
+	pn("	}")
+	pn("")
+	pn("	return req, nil")
+	pn("}")
+	pn("")
+	pn("// Execute a request against a CS API, which is prepared by newRequest for every attempt. Will return the raw")
+	pn("// JSON data returned by the API and nil if no error occurred. If the API returns an error the result will be")
+	pn("// nil and the error a *CSError.")
+	pn("func (cs *CloudStackClient) doRequest(ctx context.Context, api string, newRequest func() (*http.Request, error)) (json.RawMessage, error) {")
+	pn("	if cs.retry != nil {")
+	pn("		return cs.doRequestWithRetry(ctx, api, newRequest)")
+	pn("	}")
+	pn("")
+	pn("	req, err := newRequest()")
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	return cs.sendRequest(api, req)")
+	pn("}")
+	pn("")
+	pn("// Send a prepared request to the CS API once, without retrying failed requests")
+	pn("func (cs *CloudStackClient) sendRequest(api string, req *http.Request) (json.RawMessage, error) {")
+	pn("	if cs.limiter != nil {")
+	pn("		if err := cs.limiter.wait(req.Context(), cs, api); err != nil {")
+	pn("			return nil, err")
+	pn("		}")
 	pn("	}")
+	pn("")
+	pn("	resp, err := cs.client.Do(req)")
 	pn("	if err != nil {")
 	pn("		return nil, err")
 	pn("	}")
@@ -714,19 +901,32 @@ func (as *allServices) GeneralCode() ([]byte, error) {
 	pn("	}")
 	pn("")
 	pn("	// Need to get the raw value to make the result play nice")
-	pn("	b, err = getRawValue(b)")
+	pn("	raw, err := getRawValue(b)")
 	pn("	if err != nil {")
+	pn("		if resp.StatusCode != 200 {")
+	pn("			// Not a CloudStack error response, e.g. an error page of a proxy")
+	pn("			return nil, &CSError{")
+	pn("				HTTPStatus: resp.StatusCode,")
+	pn("				Command:    api,")
+	pn("				ErrorText:  string(b),")
+	pn("				RetryAfter: parseRetryAfter(resp.Header.Get(\"Retry-After\")),")
+	pn("			}")
+	pn("		}")
 	pn("		return nil, err")
 	pn("	}")
 	pn("")
 	pn("	if resp.StatusCode != 200 {")
-	pn("		var e CSError")
-	pn("		if err := json.Unmarshal(b, &e); err != nil {")
+	pn("		e := &CSError{")
+	pn("			HTTPStatus: resp.StatusCode,")
+	pn("			Command:    api,")
+	pn("			RetryAfter: parseRetryAfter(resp.Header.Get(\"Retry-After\")),")
+	pn("		}")
+	pn("		if err := json.Unmarshal(raw, e); err != nil {")
 	pn("			return nil, err")
 	pn("		}")
-	pn("		return nil, e.Error()")
+	pn("		return nil, e")
 	pn("	}")
-	pn("	return b, nil")
+	pn("	return raw, nil")
 	pn("}")
 	pn("")
 	pn("// Custom version of net/url Encode that only URL escapes values")
@@ -1165,7 +1365,11 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("}")
 		pn("")
 		pn("func (s *CustomService) CustomRequest(api string, p *CustomServiceParams, result interface{}) error {")
-		pn("	resp, err := s.cs.newPostRequest(api, p.toURLValues())")
+		pn("	return s.CustomRequestWithContext(context.Background(), api, p, result)")
+		pn("}")
+		pn("")
+		pn("func (s *CustomService) CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {")
+		pn("	resp, err := s.cs.newPostRequest(ctx, api, p.toURLValues())")
 		pn("	if err != nil {")
 		pn("		return err")
 		pn("	}")
@@ -1173,7 +1377,11 @@ func (s *service) GenerateCode() ([]byte, error) {
 		pn("	return json.Unmarshal(resp, result)")
 		pn("}")
 		pn("func (s *CustomService) CustomPostRequest(api string, p *CustomServiceParams, result interface{}) error {")
-		pn("	resp, err := s.cs.newPostRequest(api, p.toURLValues())")
+		pn("	return s.CustomPostRequestWithContext(context.Background(), api, p, result)")
+		pn("}")
+		pn("")
+		pn("func (s *CustomService) CustomPostRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error {")
+		pn("	resp, err := s.cs.newPostRequest(ctx, api, p.toURLValues())")
 		pn("	if err != nil {")
 		pn("		return err")
 		pn("	}")
@@ -1187,10 +1395,15 @@ func (s *service) GenerateCode() ([]byte, error) {
 	for _, a := range s.apis {
 		s.generateParamType(a)
 		s.generateToURLValuesFunc(a)
+		s.generateValidateAgainstFunc(a)
+		s.generateValidateFunc(a)
+		s.generateParamsEncodingFuncs(a)
 		s.generateParamGettersAndSettersFunc(a)
 		s.generateNewParamTypeFunc(a)
 		s.generateHelperFuncs(a)
 		s.generateNewAPICallFunc(a)
+		s.generateAsyncFunc(a)
+		s.generatePageFuncs(a)
 		s.generateResponseType(a)
 	}
 
@@ -1307,6 +1520,8 @@ func getDefaultValueForType(aName string, pName string, pType string) string {
 	switch pType {
 	case "boolean":
 		return "true"
+	case "uuid":
+		return "\"7f4ef3b6-4b5a-4c8e-9f1d-2a3b4c5d6e7f\""
 	case "short", "int", "integer", "long", "float", "double":
 		return "0"
 	case "list":
@@ -1326,6 +1541,22 @@ func (s *service) generateParamType(a *API) {
 	pn("}\n")
 }
 
+// serviceHelpers holds the signatures of the methods of a service that are not generated from an API, which are
+// added to the interface of the service
+var serviceHelpers = map[string][]string{
+	"CustomService": {
+		"CustomRequest(api string, p *CustomServiceParams, result interface{}) error",
+		"CustomRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error",
+		"CustomPostRequest(api string, p *CustomServiceParams, result interface{}) error",
+		"CustomPostRequestWithContext(ctx context.Context, api string, p *CustomServiceParams, result interface{}) error",
+		"Call(ctx context.Context, command string, params map[string]any) (*DynamicResult, error)",
+	},
+	"UserService": {
+		"RotateUserKeys(userid string) (*RotatedUserKeys, error)",
+		"RotateUserKeysWithContext(ctx context.Context, userid string) (*RotatedUserKeys, error)",
+	},
+}
+
 func (s *service) generateInterfaceType() {
 	p, pn := s.p, s.pn
 
@@ -1335,12 +1566,24 @@ func (s *service) generateInterfaceType() {
 		tn := capitalize(api.Name + "Params")
 		// API Calls
 		pn("	%s(p *%s) (*%s, error)", n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
+		pn("	%sWithContext(ctx context.Context, p *%s) (*%s, error)", n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
+		if api.Isasync {
+			pn("	%sAsync(ctx context.Context, p *%s) (*Job, error)", n, n+"Params")
+		}
+
+		// Pagination funcs
+		if _, item, ok := pageableList(api); ok {
+			pn("	%sAll(p *%s, opts ...PageOption) ([]*%s, error)", n, n+"Params", item)
+			pn("	%sAllWithContext(ctx context.Context, p *%s, opts ...PageOption) ([]*%s, error)", n, n+"Params", item)
+			pn("	%sIter(p *%s, opts ...PageOption) iter.Seq2[*%s, error]", n, n+"Params", item)
+			pn("	%sIterWithContext(ctx context.Context, p *%s, opts ...PageOption) iter.Seq2[*%s, error]", n, n+"Params", item)
+		}
 
 		// NewParam funcs
 		p("New%s(", tn)
 		for _, ap := range api.Params {
 			if ap.Required || isRequiredParam(api, ap) {
-				p("%s %s, ", s.parseParamName(ap.Name), mapType(api.Name, ap.Name, ap.Type))
+				p("%s %s, ", s.parseParamName(ap.Name), mapParamType(api.Name, ap.Name, ap.Type))
 			}
 		}
 		pn(") *%s", tn)
@@ -1358,65 +1601,147 @@ func (s *service) generateInterfaceType() {
 					}
 				}
 
-				// Generate the function signature
-				p("Get%sID(%s string, ", parseSingular(ln), v)
-				for _, ap := range api.Params {
-					if ap.Required || isRequiredParam(api, ap) {
-						p("%s %s, ", s.parseParamName(ap.Name), mapType(api.Name, ap.Name, ap.Type))
-					}
-				}
-				if parseSingular(ln) == "Iso" {
-					p("isofilter string, ")
-				}
-				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-					p("zoneid string, ")
-				}
-				pn("opts ...OptionFunc) (string, int, error)")
+				params, _ := s.helperParams(api, false)
+				pn("Get%sID(%s string, %sopts ...OptionFunc) (string, int, error)", parseSingular(ln), v, params)
+				pn("Get%sIDWithContext(ctx context.Context, %s string, %sopts ...OptionFunc) (string, int, error)", parseSingular(ln), v, params)
 
 				if hasIDParamField(api.Name, api.Params) {
-					p("Get%sByName(name string, ", parseSingular(ln))
-					for _, ap := range api.Params {
-						if ap.Required || isRequiredParam(api, ap) {
-							p("%s %s, ", s.parseParamName(ap.Name), mapType(api.Name, ap.Name, ap.Type))
-						}
-					}
-					if parseSingular(ln) == "Iso" {
-						p("isofilter string, ")
-					}
-					if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-						p("zoneid string, ")
-					}
-					if parseSingular(ln) == "CniConfiguration" {
-						pn("opts ...OptionFunc) (*UserData, int, error)")
-					} else {
-						pn("opts ...OptionFunc) (*%s, int, error)", parseSingular(ln))
-					}
+					pn("Get%sByName(name string, %sopts ...OptionFunc) (%s, int, error)", parseSingular(ln), params, helperResult(ln, false))
+					pn("Get%sByNameWithContext(ctx context.Context, name string, %sopts ...OptionFunc) (%s, int, error)", parseSingular(ln), params, helperResult(ln, false))
 				}
 			}
 
 			if hasIDParamField(api.Name, api.Params) {
 				ln := strings.TrimPrefix(api.Name, "list")
 
-				// Generate the function signature
-				p("Get%sByID(id string, ", parseSingular(ln))
-				for _, ap := range api.Params {
-					if ap.Required && s.parseParamName(ap.Name) != "id" {
-						p("%s %s, ", ap.Name, mapType(api.Name, ap.Name, ap.Type))
-					}
-				}
-				if ln == "LoadBalancerRuleInstances" {
-					pn("opts ...OptionFunc) (*VirtualMachine, int, error)")
-				} else if ln == "CniConfiguration" {
-					pn("opts ...OptionFunc) (*UserData, int, error)")
-				} else {
-					pn("opts ...OptionFunc) (*%s, int, error)", parseSingular(ln))
-				}
+				params, _ := s.helperParams(api, true)
+				pn("Get%sByID(id string, %sopts ...OptionFunc) (%s, int, error)", parseSingular(ln), params, helperResult(ln, true))
+				pn("Get%sByIDWithContext(ctx context.Context, id string, %sopts ...OptionFunc) (%s, int, error)", parseSingular(ln), params, helperResult(ln, true))
 			}
 		}
 	}
+
+	// Hand-written helpers
+	for _, m := range serviceHelpers[s.name] {
+		pn("	%s", m)
+	}
 	pn("}\n")
 }
 
+func (s *service) generateValidateAgainstFunc(a *API) {
+	pn := s.pn
+	tn := capitalize(a.Name + "Params")
+
+	pn("// ValidateAgainst checks the parameters against the %s API in the schema of a server. It returns a", a.Name)
+	pn("// *ParamsError listing unknown and missing parameters and values of the wrong type.")
+	pn("func (p *%s) ValidateAgainst(server *APISchema) error {", tn)
+	pn("	return server.Validate(\"%s\", p.toURLValues())", a.Name)
+	pn("}")
+	pn("")
+}
+
+func (s *service) generateValidateFunc(a *API) {
+	p, pn := s.p, s.pn
+	tn := capitalize(a.Name + "Params")
+
+	var required, uuids []string
+	lengths := APIParams{}
+	seen := map[string]bool{}
+	for _, ap := range a.Params {
+		// Some APIs list a parameter twice
+		if seen[ap.Name] {
+			continue
+		}
+		seen[ap.Name] = true
+
+		if ap.Required || isRequiredParam(a, ap) {
+			required = append(required, ap.Name)
+		}
+		if ap.Type == "uuid" {
+			uuids = append(uuids, ap.Name)
+		}
+		if ap.Length > 0 && ap.Type == "string" {
+			lengths = append(lengths, ap)
+		}
+	}
+	sort.Strings(required)
+	sort.Strings(uuids)
+	sort.Sort(lengths)
+
+	pn("// Validate checks that the required parameters are set, that IDs are UUIDs and that values are not longer")
+	pn("// than the %s API accepts. It returns a *ParamsError listing the missing and invalid parameters.", a.Name)
+	pn("func (p *%s) Validate() error {", tn)
+	pn("	return paramRules{")
+	if len(required) > 0 {
+		pn("		required: []string{%s},", quoteList(required))
+	}
+	if len(uuids) > 0 {
+		pn("		uuids: []string{%s},", quoteList(uuids))
+	}
+	if len(lengths) > 0 {
+		p("		lengths: map[string]int{")
+		for i, ap := range lengths {
+			if i > 0 {
+				p(", ")
+			}
+			p("\"%s\": %d", ap.Name, ap.Length)
+		}
+		pn("},")
+	}
+	pn("	}.validate(\"%s\", p.p, p.toURLValues())", a.Name)
+	pn("}")
+	pn("")
+}
+
+func (s *service) generateParamsEncodingFuncs(a *API) {
+	pn := s.pn
+	tn := capitalize(a.Name + "Params")
+
+	pn("// URLValues returns the parameters encoded as they are sent to the %s API, before the request is signed", a.Name)
+	pn("func (p *%s) URLValues() url.Values {", tn)
+	pn("	return p.toURLValues()")
+	pn("}")
+	pn("")
+	pn("// MarshalJSON encodes the parameters that are set as a JSON object keyed by parameter name, so they can")
+	pn("// be stored and decoded again with UnmarshalJSON")
+	pn("func (p *%s) MarshalJSON() ([]byte, error) {", tn)
+	pn("	return marshalParams(p.p)")
+	pn("}")
+	pn("")
+	pn("// UnmarshalJSON decodes parameters encoded by MarshalJSON, replacing all parameters that are set")
+	pn("func (p *%s) UnmarshalJSON(b []byte) error {", tn)
+	if len(a.Params) == 0 {
+		pn("	return unmarshalParams(\"%s\", b, &p.p, nil)", a.Name)
+	} else {
+		pn("	return unmarshalParams(\"%s\", b, &p.p, map[string]paramDecoder{", a.Name)
+		seen := make(map[string]bool)
+		for _, ap := range a.Params {
+			if seen[ap.Name] {
+				continue
+			}
+			seen[ap.Name] = true
+			pn("		\"%s\": decodeParam[%s],", ap.Name, mapParamType(a.Name, ap.Name, ap.Type))
+		}
+		pn("	})")
+	}
+	pn("}")
+	pn("")
+	pn("// Clone returns a deep copy of the parameters, which can be changed without changing the original")
+	pn("func (p *%s) Clone() *%s {", tn, tn)
+	pn("	return &%s{p: cloneParams(p.p)}", tn)
+	pn("}")
+	pn("")
+}
+
+// quoteList returns the strings quoted and separated by commas, for use in a generated slice literal
+func quoteList(ss []string) string {
+	quoted := make([]string, len(ss))
+	for i, s := range ss {
+		quoted[i] = strconv.Quote(s)
+	}
+	return strings.Join(quoted, ", ")
+}
+
 func (s *service) generateToURLValuesFunc(a *API) {
 	pn := s.pn
 
@@ -1427,7 +1752,7 @@ func (s *service) generateToURLValuesFunc(a *API) {
 	pn("	}")
 	for _, ap := range a.Params {
 		pn("	if v, found := p.p[\"%s\"]; found {", ap.Name)
-		s.generateConvertCode(a.Name, ap.Name, mapType(a.Name, ap.Name, ap.Type))
+		s.generateConvertCode(a.Name, ap.Name, mapParamType(a.Name, ap.Name, ap.Type))
 		pn("	}")
 	}
 	pn("	return u")
@@ -1466,83 +1791,94 @@ func (s *service) generateConvertCode(cmd, name, typ string) {
 		pn("  }")
 		pn("}")
 	case "map[string]string":
+		e := paramMapEncoding(cmd, name)
 		pn("m := v.(map[string]string)")
-		zeroIndex := detailsRequireZeroIndex[cmd]
-		needsIndex := parametersRequireIndexing[name]
-
-		shouldUseStaticZeroIndex := zeroIndex && !needsIndex
-
-		if shouldUseStaticZeroIndex {
+		switch {
+		case e.ZeroIndex:
 			pn("for _, k := range getSortedKeysFromMap(m) {")
-		} else {
+			pn("	u.Set(fmt.Sprintf(\"%s[0].%%s\", k), m[k])", name)
+		case e.Key == "":
 			pn("for i, k := range getSortedKeysFromMap(m) {")
-		}
-		switch name {
-		case "details":
-			if detailsRequireKeyValue[cmd] {
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
-			} else {
-				if zeroIndex {
-					pn("	u.Set(fmt.Sprintf(\"%s[0].%%s\", k), m[k])", name)
-				} else {
-					pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
-				}
-			}
-		case "userdatadetails":
 			pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
-		case "serviceproviderlist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].service\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].provider\", i), m[k])", name)
-		case "usersecuritygrouplist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].account\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].group\", i), m[k])", name)
-		case "tags":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
-			if cmd == "deleteTags" {
+		default:
+			pn("for i, k := range getSortedKeysFromMap(m) {")
+			pn("	u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), k)", name, e.Key)
+			if e.OmitEmptyValue {
 				pn("	if m[k] != \"\" {")
-				pn("		u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
+				pn("		u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), m[k])", name, e.Value)
 				pn("	}")
 			} else {
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
-			}
-		case "nicnetworklist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].nic\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].network\", i), m[k])", name)
-		case "nicipaddresslist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].nic\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].ip4Address\", i), m[k])", name)
-		case "datadiskofferinglist":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].disk\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].diskOffering\", i), m[k])", name)
-		case "otherdeployparams":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].name\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
-		case "param":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].name\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
-		case "nodeofferings":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].node\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].offering\", i), m[k])", name)
-		case "nodetemplates":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].node\", i), k)", name)
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].template\", i), m[k])", name)
-		case "cniconfigdetails":
-			pn("	u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
-		case "datadisksdetails":
-			pn("    u.Set(fmt.Sprintf(\"%s[%%d].%%s\", i, k), m[k])", name)
-		default:
-			if shouldUseStaticZeroIndex && !detailsRequireKeyValue[cmd] {
-				pn("	u.Set(fmt.Sprintf(\"%s[0].%%s\", k), m[k])", name)
-			} else {
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].key\", i), k)", name)
-				pn("	u.Set(fmt.Sprintf(\"%s[%%d].value\", i), m[k])", name)
+				pn("	u.Set(fmt.Sprintf(\"%s[%%d].%s\", i), m[k])", name, e.Value)
 			}
 		}
 		pn("}")
+	default:
+		if _, ok := enumTypes[typ]; ok {
+			pn("u.Set(\"%s\", string(v.(%s)))", name, typ)
+		}
 	}
 }
 
+// mapEncoding is how the entries of a map[string]string param are encoded
+type mapEncoding struct {
+	Key, Value     string // Encode every entry as name[i].<Key>=k and name[i].<Value>=v
+	ZeroIndex      bool   // Encode every entry as name[0].k=v
+	OmitEmptyValue bool   // Leave out name[i].<Value> when v is empty
+}
+
+// literal returns the encoding as a composite literal, for use in generated code
+func (e mapEncoding) literal() string {
+	var fields []string
+	if e.Key != "" {
+		fields = append(fields, fmt.Sprintf("Key: %q, Value: %q", e.Key, e.Value))
+	}
+	if e.ZeroIndex {
+		fields = append(fields, "ZeroIndex: true")
+	}
+	if e.OmitEmptyValue {
+		fields = append(fields, "OmitEmptyValue: true")
+	}
+	return "{" + strings.Join(fields, ", ") + "}"
+}
+
+// paramMapEncoding returns how the entries of a map[string]string param of a command are encoded. When the
+// key is empty and ZeroIndex isn't set, every entry is encoded as name[i].k=v.
+func paramMapEncoding(cmd, name string) mapEncoding {
+	zeroIndex := detailsRequireZeroIndex[cmd] && !parametersRequireIndexing[name]
+
+	switch name {
+	case "details":
+		if detailsRequireKeyValue[cmd] {
+			return mapEncoding{Key: "key", Value: "value"}
+		}
+		return mapEncoding{ZeroIndex: detailsRequireZeroIndex[cmd]}
+	case "userdatadetails", "cniconfigdetails", "datadisksdetails":
+		return mapEncoding{}
+	case "serviceproviderlist":
+		return mapEncoding{Key: "service", Value: "provider"}
+	case "usersecuritygrouplist":
+		return mapEncoding{Key: "account", Value: "group"}
+	case "tags":
+		return mapEncoding{Key: "key", Value: "value", OmitEmptyValue: cmd == "deleteTags"}
+	case "nicnetworklist":
+		return mapEncoding{Key: "nic", Value: "network"}
+	case "nicipaddresslist":
+		return mapEncoding{Key: "nic", Value: "ip4Address"}
+	case "datadiskofferinglist":
+		return mapEncoding{Key: "disk", Value: "diskOffering"}
+	case "otherdeployparams", "param":
+		return mapEncoding{Key: "name", Value: "value"}
+	case "nodeofferings":
+		return mapEncoding{Key: "node", Value: "offering"}
+	case "nodetemplates":
+		return mapEncoding{Key: "node", Value: "template"}
+	}
+	if zeroIndex && !detailsRequireKeyValue[cmd] {
+		return mapEncoding{ZeroIndex: true}
+	}
+	return mapEncoding{Key: "key", Value: "value"}
+}
+
 func (s *service) parseParamName(name string) string {
 	if name != "type" {
 		return name
@@ -1556,7 +1892,7 @@ func (s *service) generateParamGettersAndSettersFunc(a *API) {
 
 	for _, ap := range a.Params {
 		if !found[ap.Name] {
-			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapType(a.Name, ap.Name, ap.Type))
+			pn("func (p *%s) Set%s(v %s) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapParamType(a.Name, ap.Name, ap.Type))
 			pn("	if p.p == nil {")
 			pn("		p.p = make(map[string]interface{})")
 			pn("	}")
@@ -1571,11 +1907,11 @@ func (s *service) generateParamGettersAndSettersFunc(a *API) {
 			pn("}")
 			pn("")
 
-			pn("func (p *%s) Get%s() (%s, bool) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapType(a.Name, ap.Name, ap.Type))
+			pn("func (p *%s) Get%s() (%s, bool) {", capitalize(a.Name+"Params"), capitalize(ap.Name), mapParamType(a.Name, ap.Name, ap.Type))
 			pn("	if p.p == nil {")
 			pn("		p.p = make(map[string]interface{})")
 			pn("	}")
-			pn("	value, ok := p.p[\"%s\"].(%s)", ap.Name, mapType(a.Name, ap.Name, ap.Type))
+			pn("	value, ok := p.p[\"%s\"].(%s)", ap.Name, mapParamType(a.Name, ap.Name, ap.Type))
 			pn("	return value, ok")
 			pn("}")
 			pn("")
@@ -1613,7 +1949,7 @@ func (s *service) generateNewParamTypeFunc(a *API) {
 	for _, ap := range a.Params {
 		if ap.Required || isRequiredParam(a, ap) {
 			rp = append(rp, ap)
-			p("%s %s, ", s.parseParamName(ap.Name), mapType(a.Name, ap.Name, ap.Type))
+			p("%s %s, ", s.parseParamName(ap.Name), mapParamType(a.Name, ap.Name, ap.Type))
 		}
 	}
 	pn(") *%s {", tn)
@@ -1645,21 +1981,16 @@ func (s *service) generateHelperFuncs(a *API) {
 				}
 			}
 
-			// Generate the function signature
+			params, args := s.helperParams(a, false)
+
+			// Generate the function signatures
 			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-			p("func (s *%s) Get%sID(%s string, ", s.name, parseSingular(ln), v)
-			for _, ap := range a.Params {
-				if ap.Required || isRequiredParam(a, ap) {
-					p("%s %s, ", s.parseParamName(ap.Name), mapType(a.Name, ap.Name, ap.Type))
-				}
-			}
-			if parseSingular(ln) == "Iso" {
-				p("isofilter string, ")
-			}
-			if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-				p("zoneid string, ")
-			}
-			pn("opts ...OptionFunc) (string, int, error) {")
+			pn("func (s *%s) Get%sID(%s string, %sopts ...OptionFunc) (string, int, error) {", s.name, parseSingular(ln), v, params)
+			pn("	return s.Get%sIDWithContext(context.Background(), %s, %sopts...)", parseSingular(ln), v, args)
+			pn("}")
+			pn("")
+			pn("// Get%sIDWithContext is like Get%sID, but honours the cancellation and deadline of ctx", parseSingular(ln), parseSingular(ln))
+			pn("func (s *%s) Get%sIDWithContext(ctx context.Context, %s string, %sopts ...OptionFunc) (string, int, error) {", s.name, parseSingular(ln), v, params)
 
 			// Generate the function body
 			pn("	p := &List%sParams{}", ln)
@@ -1684,7 +2015,7 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("		}")
 			pn("	}")
 			pn("")
-			pn("	l, err := s.List%s(p)", ln)
+			pn("	l, err := s.List%sWithContext(ctx, p)", ln)
 			pn("	if err != nil {")
 			pn("		return \"\", -1, err")
 			pn("	}")
@@ -1715,28 +2046,17 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("")
 
 			if hasIDParamField(a.Name, a.Params) {
-				// Generate the function signature
+				// Generate the function signatures
 				pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-				p("func (s *%s) Get%sByName(name string, ", s.name, parseSingular(ln))
-				for _, ap := range a.Params {
-					if ap.Required || isRequiredParam(a, ap) {
-						p("%s %s, ", s.parseParamName(ap.Name), mapType(a.Name, ap.Name, ap.Type))
-					}
-				}
-				if parseSingular(ln) == "Iso" {
-					p("isofilter string, ")
-				}
-				if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
-					p("zoneid string, ")
-				}
-				if parseSingular(ln) == "CniConfiguration" {
-					pn("opts ...OptionFunc) (*UserData, int, error) {")
-				} else {
-					pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
-				}
+				pn("func (s *%s) Get%sByName(name string, %sopts ...OptionFunc) (%s, int, error) {", s.name, parseSingular(ln), params, helperResult(ln, false))
+				pn("	return s.Get%sByNameWithContext(context.Background(), name, %sopts...)", parseSingular(ln), args)
+				pn("}")
+				pn("")
+				pn("// Get%sByNameWithContext is like Get%sByName, but honours the cancellation and deadline of ctx", parseSingular(ln), parseSingular(ln))
+				pn("func (s *%s) Get%sByNameWithContext(ctx context.Context, name string, %sopts ...OptionFunc) (%s, int, error) {", s.name, parseSingular(ln), params, helperResult(ln, false))
 
 				// Generate the function body
-				p("  id, count, err := s.Get%sID(name, ", parseSingular(ln))
+				p("  id, count, err := s.Get%sIDWithContext(ctx, name, ", parseSingular(ln))
 				for _, ap := range a.Params {
 					if ap.Required || isRequiredParam(a, ap) {
 						p("%s, ", s.parseParamName(ap.Name))
@@ -1753,7 +2073,7 @@ func (s *service) generateHelperFuncs(a *API) {
 				pn("    return nil, count, err")
 				pn("  }")
 				pn("")
-				p("  r, count, err := s.Get%sByID(id, ", parseSingular(ln))
+				p("  r, count, err := s.Get%sByIDWithContext(ctx, id, ", parseSingular(ln))
 				for _, ap := range a.Params {
 					if ap.Required || isRequiredParam(a, ap) {
 						p("%s, ", s.parseParamName(ap.Name))
@@ -1778,21 +2098,16 @@ func (s *service) generateHelperFuncs(a *API) {
 		if hasIDParamField(a.Name, a.Params) {
 			ln := strings.TrimPrefix(a.Name, "list")
 
-			// Generate the function signature
+			params, args := s.helperParams(a, true)
+
+			// Generate the function signatures
 			pn("// This is a courtesy helper function, which in some cases may not work as expected!")
-			p("func (s *%s) Get%sByID(id string, ", s.name, parseSingular(ln))
-			for _, ap := range a.Params {
-				if ap.Required && s.parseParamName(ap.Name) != "id" {
-					p("%s %s, ", ap.Name, mapType(a.Name, ap.Name, ap.Type))
-				}
-			}
-			if ln == "LoadBalancerRuleInstances" {
-				pn("opts ...OptionFunc) (*VirtualMachine, int, error) {")
-			} else if ln == "CniConfiguration" {
-				pn("opts ...OptionFunc) (*UserData, int, error) {")
-			} else {
-				pn("opts ...OptionFunc) (*%s, int, error) {", parseSingular(ln))
-			}
+			pn("func (s *%s) Get%sByID(id string, %sopts ...OptionFunc) (%s, int, error) {", s.name, parseSingular(ln), params, helperResult(ln, true))
+			pn("	return s.Get%sByIDWithContext(context.Background(), id, %sopts...)", parseSingular(ln), args)
+			pn("}")
+			pn("")
+			pn("// Get%sByIDWithContext is like Get%sByID, but honours the cancellation and deadline of ctx", parseSingular(ln), parseSingular(ln))
+			pn("func (s *%s) Get%sByIDWithContext(ctx context.Context, id string, %sopts ...OptionFunc) (%s, int, error) {", s.name, parseSingular(ln), params, helperResult(ln, true))
 
 			// Generate the function body
 			pn("	p := &List%sParams{}", ln)
@@ -1811,11 +2126,10 @@ func (s *service) generateHelperFuncs(a *API) {
 			pn("		}")
 			pn("	}")
 			pn("")
-			pn("	l, err := s.List%s(p)", ln)
+			pn("	l, err := s.List%sWithContext(ctx, p)", ln)
 			pn("	if err != nil {")
-			pn("		if strings.Contains(err.Error(), fmt.Sprintf(")
-			pn("			\"Invalid parameter id value=%%s due to incorrect long value format, \"+")
-			pn("				\"or entity does not exist\", id)) {")
+			pn("		// An ID that is unknown or isn't a UUID doesn't match anything, whether the server or Validate rejects it")
+			pn("		if isInvalidID(err) || IsNotFound(err) {")
 			pn("			return nil, 0, fmt.Errorf(\"No match found for %%s: %%+v\", id, l)")
 			pn("		}")
 			pn("		return nil, -1, err")
@@ -1841,6 +2155,49 @@ func (s *service) generateHelperFuncs(a *API) {
 	}
 }
 
+// helperParams returns the declarations of the parameters of a courtesy helper function of a list API and the
+// arguments to pass them on, both ending in a comma when not empty. The Get<X>ID and Get<X>ByName helpers take all
+// required parameters, the Get<X>ByID helper only those the API itself marks as required.
+func (s *service) helperParams(a *API, byID bool) (params string, args string) {
+	ln := strings.TrimPrefix(a.Name, "list")
+	for _, ap := range a.Params {
+		if byID {
+			if ap.Required && s.parseParamName(ap.Name) != "id" {
+				params += fmt.Sprintf("%s %s, ", ap.Name, mapParamType(a.Name, ap.Name, ap.Type))
+				args += ap.Name + ", "
+			}
+			continue
+		}
+		if ap.Required || isRequiredParam(a, ap) {
+			params += fmt.Sprintf("%s %s, ", s.parseParamName(ap.Name), mapParamType(a.Name, ap.Name, ap.Type))
+			args += s.parseParamName(ap.Name) + ", "
+		}
+	}
+	if byID {
+		return params, args
+	}
+	if parseSingular(ln) == "Iso" {
+		params += "isofilter string, "
+		args += "isofilter, "
+	}
+	if parseSingular(ln) == "Template" || parseSingular(ln) == "Iso" {
+		params += "zoneid string, "
+		args += "zoneid, "
+	}
+	return params, args
+}
+
+// helperResult returns the type of the item a Get<X>ByName or Get<X>ByID helper function of a list API returns
+func helperResult(ln string, byID bool) string {
+	switch {
+	case ln == "LoadBalancerRuleInstances" && byID:
+		return "*VirtualMachine"
+	case ln == "CniConfiguration":
+		return "*UserData"
+	}
+	return "*" + parseSingular(ln)
+}
+
 func hasNameOrKeywordParamField(aName string, params APIParams) (v string, found bool) {
 	for _, p := range params {
 		if p.Name == "keyword" && mapType(aName, p.Name, p.Type) == "string" {
@@ -1886,19 +2243,43 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	// Generate the function signature
 	pn("// %s", a.Description)
 	pn("func (s *%s) %s(p *%s) (*%s, error) {", s.name, n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
+	pn("	return s.%sWithContext(context.Background(), p)", n)
+	pn("}")
+	pn("")
+	pn("// %sWithContext is like %s, but honours the cancellation and deadline of ctx", n, n)
+	pn("func (s *%s) %sWithContext(ctx context.Context, p *%s) (*%s, error) {", s.name, n, n+"Params", strings.TrimPrefix(n, "Configure")+"Response")
 
 	// Generate the function body
+	pn("	if err := p.Validate(); err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("")
+	if a.Isasync {
+		pn("	ctx, js := s.cs.withJobSpan(ctx)")
+		pn("	defer js.release()")
+		pn("")
+	}
 	if n == "QueryAsyncJobResult" {
 		pn("	var resp json.RawMessage")
 		pn("	var err error")
 		pn("")
-		pn("	// We should be able to retry on failure as this call is idempotent")
-		pn("	for i := 0; i < 3; i++ {")
-		pn("		resp, err = s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
-		pn("		if err == nil {")
+		pn("	// We should be able to retry on failure as this call is idempotent, unless the retry policy")
+		pn("	// of the client retries it already")
+		pn("	attempts := 3")
+		pn("	if s.cs.retry != nil {")
+		pn("		attempts = 1")
+		pn("	}")
+		pn("	for i := 0; i < attempts; i++ {")
+		pn("		resp, err = s.cs.newRequest(ctx, \"%s\", p.toURLValues())", a.Name)
+		pn("		if err == nil || i == attempts-1 {")
 		pn("			break")
 		pn("		}")
-		pn("		time.Sleep(500 * time.Millisecond)")
+		pn("")
+		pn("		select {")
+		pn("		case <-ctx.Done():")
+		pn("			return nil, ctx.Err()")
+		pn("		case <-time.After(500 * time.Millisecond):")
+		pn("		}")
 		pn("	}")
 	} else {
 		isGetRequest, _ := regexp.MatchString("^(get|list|query|find)(\\w+)+$", strings.ToLower(a.Name))
@@ -1907,9 +2288,9 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		_, isInGetRequestList := getRequestList[strings.ToLower(a.Name)]
 
 		if requiresPostMethod[a.Name] || !(isGetRequest || isInGetRequestList) {
-			pn("	resp, err := s.cs.newPostRequest(\"%s\", p.toURLValues())", a.Name)
+			pn("	resp, err := s.cs.newPostRequest(ctx, \"%s\", p.toURLValues())", a.Name)
 		} else {
-			pn("	resp, err := s.cs.newRequest(\"%s\", p.toURLValues())", a.Name)
+			pn("	resp, err := s.cs.newRequest(ctx, \"%s\", p.toURLValues())", a.Name)
 		}
 	}
 	pn("	if err != nil {")
@@ -1952,7 +2333,8 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		"AddKubernetesSupportedVersion",
 		"CreateDiskOffering",
 		"AddHost",
-		"RegisterIso":
+		"RegisterIso",
+		"GetApiLimit":
 		pn("	if resp, err = getRawValue(resp); err != nil {")
 		pn("		return nil, err")
 		pn("	}")
@@ -1970,57 +2352,29 @@ func (s *service) generateNewAPICallFunc(a *API) {
 		pn("	var nested struct {")
 		pn("			Response %sResponse `json:\"%s\"`", strings.TrimPrefix(n, "Configure"), field)
 		pn("	}")
-		pn("	if err := json.Unmarshal(resp, &nested); err != nil {")
+		pn("	if err := s.cs.decodeResponse(\"%s\", resp, &nested); err != nil {", a.Name)
 		pn("		return nil, err")
 		pn("	}")
 		pn("	r := nested.Response")
 	} else {
 		pn("	var r %sResponse", strings.TrimPrefix(n, "Configure"))
-		pn("	if err := json.Unmarshal(resp, &r); err != nil {")
+		pn("	if err := s.cs.decodeResponse(\"%s\", resp, &r); err != nil {", a.Name)
 		pn("		return nil, err")
 		pn("	}")
 	}
 	pn("")
 	if a.Isasync {
 		pn("	// If we have a async client, we need to wait for the async result")
-		pn("	if s.cs.async {")
-		pn("		b, err := s.cs.GetAsyncJobResult(r.JobID, s.cs.timeout)")
+		pn("	if s.cs.waitForAsyncJob(ctx) {")
+		pn("		b, err := s.cs.waitForJob(ctx, \"%s\", r.JobID)", a.Name)
 		pn("		if err != nil {")
-		pn("			if err == AsyncTimeoutErr {")
+		pn("			if err == AsyncTimeoutErr || ctx.Err() != nil {")
 		pn("				return &r, err")
 		pn("			}")
 		pn("			return nil, err")
 		pn("		}")
 		pn("")
-		if !isSuccessOnlyResponse(a.Response) {
-			pn("		b, err = getRawValue(b)")
-			pn("		if err != nil {")
-			pn("		  return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if s.name == "FirewallService" {
-			pn("		b, err = convertFirewallServiceResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if n == "AuthorizeSecurityGroupIngress" {
-			pn("		b, err = convertAuthorizeSecurityGroupIngressResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		if n == "AuthorizeSecurityGroupEgress" {
-			pn("		b, err = convertAuthorizeSecurityGroupEgressResponse(b)")
-			pn("		if err != nil {")
-			pn("			return nil, err")
-			pn("		}")
-			pn("")
-		}
-		pn("		if err := json.Unmarshal(b, &r); err != nil {")
+		pn("		if err := s.cs.decodeJobResult(\"%s\", b, &r); err != nil {", a.Name)
 		pn("			return nil, err")
 		pn("		}")
 		pn("	}")
@@ -2029,6 +2383,134 @@ func (s *service) generateNewAPICallFunc(a *API) {
 	pn("	return &r, nil")
 	pn("}")
 	pn("")
+
+	if a.Isasync {
+		s.generateDecodeJobResult(a)
+	}
+}
+
+// pageableList returns the name of the list field and the item type of list APIs that support paging. List
+// APIs with a response shape that doesn't consist of a count and a single list of items are not pageable.
+func pageableList(a *API) (string, string, bool) {
+	if !strings.HasPrefix(a.Name, "list") || a.Isasync {
+		return "", "", false
+	}
+
+	switch a.Name {
+	case "listCapabilities", "listCaCertificate", "listUsageServerMetrics", "listLoadBalancerRuleInstances",
+		"listDbMetrics", "listInfrastructure", "listCniConfiguration":
+		return "", "", false
+	}
+
+	page, pagesize := false, false
+	for _, ap := range a.Params {
+		switch ap.Name {
+		case "page":
+			page = true
+		case "pagesize":
+			pagesize = true
+		}
+	}
+	if !page || !pagesize {
+		return "", "", false
+	}
+
+	ln := capitalize(strings.TrimPrefix(a.Name, "list"))
+	return ln, parseSingular(ln), true
+}
+
+func (s *service) generateAsyncFunc(a *API) {
+	if !a.Isasync {
+		return
+	}
+	pn := s.pn
+	n := capitalize(a.Name)
+
+	pn("// %sAsync starts the async job of %s without waiting for it to finish, and returns a handle to the job", n, n)
+	pn("func (s *%s) %sAsync(ctx context.Context, p *%s) (*Job, error) {", s.name, n, n+"Params")
+	pn("	r, err := s.%sWithContext(WithAsyncWait(ctx, false), p)", n)
+	pn("	if err != nil {")
+	pn("		return nil, err")
+	pn("	}")
+	pn("	return s.cs.newJob(\"%s\", r.JobID), nil", a.Name)
+	pn("}")
+	pn("")
+}
+
+func (s *service) generatePageFuncs(a *API) {
+	field, item, ok := pageableList(a)
+	if !ok {
+		return
+	}
+	pn := s.pn
+	n := capitalize(a.Name)
+	tn := n + "Params"
+
+	pn("// %sAll returns all %s matching p, walking through all pages. The page size of p is used if set,", n, field)
+	pn("// otherwise the default page size of 500 is used.")
+	pn("func (s *%s) %sAll(p *%s, opts ...PageOption) ([]*%s, error) {", s.name, n, tn, item)
+	pn("	return s.%sAllWithContext(context.Background(), p, opts...)", n)
+	pn("}")
+	pn("")
+	pn("// %sAllWithContext is like %sAll, but honours the cancellation and deadline of ctx", n, n)
+	pn("func (s *%s) %sAllWithContext(ctx context.Context, p *%s, opts ...PageOption) ([]*%s, error) {", s.name, n, tn, item)
+	pn("	return collectPages(s.%sIterWithContext(ctx, p, opts...))", n)
+	pn("}")
+	pn("")
+	pn("// %sIter returns an iterator over all %s matching p, fetching the pages while iterating.", n, field)
+	pn("// Iteration stops at the first error, which is yielded as the last element.")
+	pn("func (s *%s) %sIter(p *%s, opts ...PageOption) iter.Seq2[*%s, error] {", s.name, n, tn, item)
+	pn("	return s.%sIterWithContext(context.Background(), p, opts...)", n)
+	pn("}")
+	pn("")
+	pn("// %sIterWithContext is like %sIter, but honours the cancellation and deadline of ctx", n, n)
+	pn("func (s *%s) %sIterWithContext(ctx context.Context, p *%s, opts ...PageOption) iter.Seq2[*%s, error] {", s.name, n, tn, item)
+	pn("	return iteratePages(ctx, p.p, func(ctx context.Context, params map[string]interface{}) ([]*%s, int, error) {", item)
+	pn("		l, err := s.%sWithContext(ctx, &%s{p: params})", n, tn)
+	pn("		if err != nil {")
+	pn("			return nil, 0, err")
+	pn("		}")
+	pn("		return l.%s, l.Count, nil", field)
+	pn("	}, opts...)")
+	pn("}")
+	pn("")
+}
+
+func (s *service) generateDecodeJobResult(a *API) {
+	pn := s.pn
+	n := capitalize(a.Name)
+	tn := strings.TrimPrefix(n, "Configure") + "Response"
+
+	var convs []string
+	if !isSuccessOnlyResponse(a.Response) {
+		convs = append(convs, "getRawValue")
+	}
+	if s.name == "FirewallService" {
+		convs = append(convs, "convertFirewallServiceResponse")
+	}
+	if n == "AuthorizeSecurityGroupIngress" {
+		convs = append(convs, "convertAuthorizeSecurityGroupIngressResponse")
+	}
+	if n == "AuthorizeSecurityGroupEgress" {
+		convs = append(convs, "convertAuthorizeSecurityGroupEgressResponse")
+	}
+
+	pn("// decodeJobResult decodes the result of a finished %s async job into r", n)
+	pn("func (r *%s) decodeJobResult(b json.RawMessage) error {", tn)
+	for i, conv := range convs {
+		if i == 0 {
+			pn("	b, err := %s(b)", conv)
+		} else {
+			pn("	b, err = %s(b)", conv)
+		}
+		pn("	if err != nil {")
+		pn("		return err")
+		pn("	}")
+		pn("")
+	}
+	pn("	return json.Unmarshal(b, r)")
+	pn("}")
+	pn("")
 }
 
 func isSuccessOnlyResponse(resp APIResponses) bool {
@@ -2211,8 +2693,26 @@ func (s *service) generateResponseType(a *API) {
 		pn("		}")
 		pn("	}")
 		pn("")
+		fields := []string{}
+		for f := range stringEncodedResponseFields[a.Name] {
+			fields = append(fields, f)
+		}
+		sort.Strings(fields)
+		for _, f := range fields {
+			pn("	if %s, ok := m[\"%s\"].(string); ok {", f, f)
+			pn("		m[\"%s\"], err = strconv.ParseFloat(%s, 64)", f, f)
+			pn("		if err != nil {")
+			pn("			return err")
+			pn("		}")
+			pn("		b, err = json.Marshal(m)")
+			pn("		if err != nil {")
+			pn("			return err")
+			pn("		}")
+			pn("	}")
+			pn("")
+		}
 		pn("	type alias %s", tn)
-		pn("	return json.Unmarshal(b, (*alias)(r))")
+		pn("	return decodeWithExtra(b, (*alias)(r), &r.Extra)")
 		pn("}")
 		pn("")
 	}
@@ -2266,7 +2766,12 @@ func (s *service) recusiveGenerateResponseType(aName string, tn string, resp API
 			typeName, create := getUniqueTypeName(tn, r.Name)
 			pn("%s []%s `json:\"%s\"`", capitalize(r.Name), typeName, r.Name)
 			if create {
-				defer s.recusiveGenerateResponseType(aName, typeName, r.Response, false)
+				defer func() {
+					if s.recusiveGenerateResponseType(aName, typeName, r.Response, false) {
+						// Only top level responses get a custom UnmarshalJSON function
+						s.generateUnmarshalWithExtraFunc(typeName)
+					}
+				}()
 			}
 		} else {
 			if !found[r.Name] {
@@ -2282,19 +2787,37 @@ func (s *service) recusiveGenerateResponseType(aName string, tn string, resp API
 					pn("%s string `json:\"%s\"`", capitalize(r.Name), r.Name)
 					customMarshal = true
 				default:
-					pn("%s %s `json:\"%s\"`", capitalize(r.Name), mapType(aName, r.Name, r.Type), r.Name)
+					if stringEncodedResponseFields[aName][r.Name] {
+						customMarshal = true
+					}
+					pn("%s %s `json:\"%s\"`", capitalize(r.Name), mapResponseType(aName, tn, r.Name, r.Type), r.Name)
 				}
 				found[r.Name] = true
 			}
 		}
 	}
 
+	pn("Extra map[string]json.RawMessage `json:\"-\"`")
 	pn("}")
 	pn("")
 
+	if !customMarshal {
+		s.generateUnmarshalWithExtraFunc(tn)
+	}
+
 	return customMarshal
 }
 
+func (s *service) generateUnmarshalWithExtraFunc(tn string) {
+	pn := s.pn
+
+	pn("func (r *%s) UnmarshalJSON(b []byte) error {", tn)
+	pn("	type alias %s", tn)
+	pn("	return decodeWithExtra(b, (*alias)(r), &r.Extra)")
+	pn("}")
+	pn("")
+}
+
 func getUniqueTypeName(prefix, name string) (string, bool) {
 	// We have special cases for [in|e]gressrules, nics and tags as the exact
 	// sames types are used used in multiple different locations.
@@ -2392,6 +2915,12 @@ func getAPIInfo(listApis string) (map[string]*API, error) {
 	// Make a map of all retrieved APIs
 	ai := make(map[string]*API)
 	for _, api := range ar.APIs {
+		// Dates are only typed in responses, dates in params are passed as strings in the formats the API accepts
+		for _, ap := range api.Params {
+			if ap.Type == "date" {
+				ap.Type = "string"
+			}
+		}
 		ai[api.Name] = api
 	}
 	return ai, nil
@@ -2447,6 +2976,8 @@ func mapType(aName string, pName string, pType string) string {
 	switch pType {
 	case "UUID":
 		return "UUID"
+	case "date":
+		return "Time"
 	case "boolean":
 		return "bool"
 	case "short", "int", "integer":
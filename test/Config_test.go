@@ -0,0 +1,150 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+const cmkConfig = `prompt = cmk
+asyncblock = true
+timeout = 1800
+verifycert = true
+profile = dev
+
+[dev]
+url = %s
+apikey = DEVKEY
+secretkey = DEVSECRET
+
+[prod]
+url = https://prod.example.com/client/api
+username = admin
+password = pass=word
+domain = /
+verifycert = false
+timeout = 600
+
+[broken]
+url = https://broken.example.com/client/api
+apikey = KEY
+`
+
+func writeCmkConfig(t *testing.T, url string) string {
+	t.Helper()
+
+	path := filepath.Join(t.TempDir(), "config")
+	if err := os.WriteFile(path, []byte(strings.Replace(cmkConfig, "%s", url, 1)), 0600); err != nil {
+		t.Fatal(err)
+	}
+	t.Setenv(cloudstack.EnvConfig, path)
+	return path
+}
+
+func TestNewClientFromConfig(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("apiKey") != "DEVKEY" {
+				t.Errorf("expected the API key of the default profile, got %q", r.FormValue("apiKey"))
+			}
+			respond(http.StatusOK, zonesResponse)(w, r)
+		}},
+	})
+	defer server.Close()
+	writeCmkConfig(t, server.URL)
+
+	client, err := cloudstack.NewClientFromConfig("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls("listZones") != 1 {
+		t.Errorf("expected 1 call, got %d", calls("listZones"))
+	}
+}
+
+func TestLoadConfigProfiles(t *testing.T) {
+	path := writeCmkConfig(t, "https://dev.example.com/client/api")
+
+	c, err := cloudstack.LoadConfigFile(path, "dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.APIKey != "DEVKEY" || c.SecretKey != "DEVSECRET" || c.Timeout != 1800 || !c.VerifyCert || !c.AsyncBlock {
+		t.Errorf("unexpected config: %+v", c)
+	}
+
+	c, err = cloudstack.LoadConfig("prod")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := cloudstack.Config{
+		URL:        "https://prod.example.com/client/api",
+		Username:   "admin",
+		Password:   "pass=word",
+		Domain:     "/",
+		VerifyCert: false,
+		Timeout:    600,
+		AsyncBlock: true,
+	}
+	if *c != want {
+		t.Errorf("expected %+v, got %+v", want, *c)
+	}
+
+	if _, err := cloudstack.LoadConfig("broken"); err == nil || !strings.Contains(err.Error(), "missing secretkey") {
+		t.Errorf("expected an error naming secretkey, got %v", err)
+	}
+	if _, err := cloudstack.LoadConfig("unknown"); err == nil || !strings.Contains(err.Error(), `"unknown" not found`) {
+		t.Errorf("expected an error naming the profile, got %v", err)
+	}
+}
+
+func TestLoadConfigFromEnv(t *testing.T) {
+	writeCmkConfig(t, "https://dev.example.com/client/api")
+
+	t.Setenv(cloudstack.EnvAPIURL, "https://env.example.com/client/api")
+	t.Setenv(cloudstack.EnvAPIKey, "ENVKEY")
+	if _, err := cloudstack.LoadConfigFromEnv(); err == nil || !strings.Contains(err.Error(), "Missing CLOUDSTACK_SECRET_KEY") {
+		t.Errorf("expected an error naming CLOUDSTACK_SECRET_KEY, got %v", err)
+	}
+
+	t.Setenv(cloudstack.EnvProfile, "dev")
+	t.Setenv(cloudstack.EnvTimeout, "60")
+	c, err := cloudstack.LoadConfigFromEnv()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if c.URL != "https://env.example.com/client/api" || c.APIKey != "ENVKEY" || c.SecretKey != "DEVSECRET" || c.Timeout != 60 {
+		t.Errorf("expected the environment to take precedence over the profile: %+v", c)
+	}
+
+	t.Setenv(cloudstack.EnvVerifyCert, "maybe")
+	if _, err := cloudstack.LoadConfigFromEnv(); err == nil || !strings.Contains(err.Error(), cloudstack.EnvVerifyCert) {
+		t.Errorf("expected an error naming %s, got %v", cloudstack.EnvVerifyCert, err)
+	}
+}
@@ -0,0 +1,100 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestWithContextCancelsInFlightRequest(t *testing.T) {
+	release := make(chan struct{})
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {func(w http.ResponseWriter, r *http.Request) { <-release }},
+	})
+	defer server.Close()
+	defer close(release)
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	p := client.Zone.NewListZonesParams()
+	_, err := client.Zone.ListZonesWithContext(ctx, p)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+}
+
+func TestWithContextStopsAsyncJobPolling(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000001"
+
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, fmt.Sprintf(`{"deployvirtualmachineresponse":{"id":"vm-1","jobid":%q}}`, jobID))},
+		"queryAsyncJobResult":  {respond(http.StatusOK, fmt.Sprintf(`{"queryasyncjobresultresponse":{"jobid":%q,"jobstatus":0}}`, jobID))},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	r, err := client.VirtualMachine.DeployVirtualMachineWithContext(ctx, p)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 900*time.Millisecond {
+		t.Fatalf("polling was not interrupted by the context, returned after %s", elapsed)
+	}
+	if r == nil || r.JobID != jobID {
+		t.Fatalf("expected the initial response carrying job ID %s, got %+v", jobID, r)
+	}
+}
+
+func TestWithContextCancelsHelperLookups(t *testing.T) {
+	release := make(chan struct{})
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listVirtualMachines": {func(w http.ResponseWriter, r *http.Request) { <-release }},
+	})
+	defer server.Close()
+	defer close(release)
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	if _, count, err := client.VirtualMachine.GetVirtualMachineByNameWithContext(ctx, "vm-1"); !errors.Is(err, context.DeadlineExceeded) || count != -1 {
+		t.Errorf("expected context.DeadlineExceeded, got %d, %v", count, err)
+	}
+	if _, count, err := client.VirtualMachine.GetVirtualMachineByIDWithContext(ctx, "e6a4b5c2-0000-4000-8000-000000000001"); !errors.Is(err, context.DeadlineExceeded) || count != -1 {
+		t.Errorf("expected context.DeadlineExceeded, got %d, %v", count, err)
+	}
+}
@@ -0,0 +1,142 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// newKeyServer returns a server that only accepts requests signed with the given API key
+func newKeyServer(t *testing.T, apiKey string) (string, func(string) int) {
+	t.Helper()
+
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {func(w http.ResponseWriter, r *http.Request) {
+			if r.FormValue("apiKey") != apiKey {
+				respond(http.StatusUnauthorized, `{"listzonesresponse":{"errorcode":401,"errortext":"unable to verify user credentials and/or request signature"}}`)(w, r)
+				return
+			}
+			respond(http.StatusOK, zonesResponse)(w, r)
+		}},
+	})
+	t.Cleanup(server.Close)
+	return server.URL, calls
+}
+
+// rotatingProvider returns the old credentials until it is refreshed
+type rotatingProvider struct {
+	refreshed atomic.Int32
+}
+
+func (p *rotatingProvider) Credentials(ctx context.Context) (cloudstack.Credentials, error) {
+	if p.refreshed.Load() > 0 {
+		return cloudstack.Credentials{APIKey: "NEWKEY", SecretKey: "NEWSECRET"}, nil
+	}
+	return cloudstack.Credentials{APIKey: "OLDKEY", SecretKey: "OLDSECRET"}, nil
+}
+
+func (p *rotatingProvider) Refresh(ctx context.Context) (cloudstack.Credentials, error) {
+	p.refreshed.Add(1)
+	return p.Credentials(ctx)
+}
+
+func TestCredentialsRefreshedOnSignatureError(t *testing.T) {
+	url, calls := newKeyServer(t, "NEWKEY")
+
+	provider := &rotatingProvider{}
+	client := cloudstack.NewClient(url, "", "", true, cloudstack.WithCredentialProvider(provider))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if provider.refreshed.Load() != 1 || calls("listZones") != 2 {
+		t.Errorf("expected 1 refresh and 2 calls, got %d and %d", provider.refreshed.Load(), calls("listZones"))
+	}
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if provider.refreshed.Load() != 1 || calls("listZones") != 3 {
+		t.Errorf("expected no more refreshes, got %d refreshes and %d calls", provider.refreshed.Load(), calls("listZones"))
+	}
+}
+
+func TestStaticCredentialsSet(t *testing.T) {
+	url, calls := newKeyServer(t, "NEWKEY")
+
+	client := cloudstack.NewClient(url, "OLDKEY", "OLDSECRET", true)
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); !cloudstack.IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+	if calls("listZones") != 1 {
+		t.Errorf("expected static credentials not to be retried, got %d calls", calls("listZones"))
+	}
+
+	client.CredentialProvider().(*cloudstack.StaticCredentialProvider).Set(cloudstack.Credentials{APIKey: "NEWKEY", SecretKey: "NEWSECRET"})
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestFileCredentialsReloadWhenChanged(t *testing.T) {
+	url, calls := newKeyServer(t, "NEWKEY")
+
+	path := filepath.Join(t.TempDir(), "credentials")
+	if err := os.WriteFile(path, []byte("apikey = OLDKEY\nsecretkey = OLDSECRET\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	provider, err := cloudstack.NewFileCredentials(path, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	client := cloudstack.NewClient(url, "", "", true, cloudstack.WithCredentialProvider(provider))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); !cloudstack.IsUnauthorized(err) {
+		t.Fatalf("expected an unauthorized error, got %v", err)
+	}
+
+	if err := os.WriteFile(path, []byte("apikey = NEWKEY\nsecretkey = NEWSECRET\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	later := time.Now().Add(time.Minute)
+	if err := os.Chtimes(path, later, later); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls("listZones") != 2 {
+		t.Errorf("expected the new credentials to be used without a retry, got %d calls", calls("listZones"))
+	}
+
+	if _, err := cloudstack.NewFileCredentials(path, "unknown"); err == nil {
+		t.Error("expected an error for an unknown profile")
+	}
+}
@@ -0,0 +1,184 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+const apisResponse = `{"listapisresponse":{"count":2,"api":[
+	{"name":"listZones","isasync":false,"params":[{"name":"id","type":"uuid"},{"name":"name","type":"string"}]},
+	{"name":"deployVirtualMachine","isasync":true,"params":[{"name":"zoneid","type":"uuid","required":true}]}
+]}}`
+
+const capabilitiesResponse = `{"listcapabilitiesresponse":{"capability":{"cloudstackversion":"4.19.1.0"}}}`
+
+func TestAPIDiscoveryPreflight(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":         {respond(http.StatusOK, apisResponse)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"listZones":        {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithAPIDiscovery())
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p := client.SharedFileSystem.NewCreateSharedFileSystemParams(diskOfferingID, "XFS", "fs", networkID, serviceOfferingID, zoneID)
+	if _, err := client.SharedFileSystem.CreateSharedFileSystem(p); !errors.Is(err, cloudstack.ErrAPIUnavailable) {
+		t.Fatalf("expected ErrAPIUnavailable, got %v", err)
+	}
+
+	ok, err := client.HasAPI("deployvirtualmachine")
+	if err != nil || !ok {
+		t.Errorf("expected deployVirtualMachine to be available, got %v, %v", ok, err)
+	}
+	version, err := client.ServerVersion()
+	if err != nil || version != "4.19.1.0" {
+		t.Errorf("expected version 4.19.1.0, got %q, %v", version, err)
+	}
+
+	if calls("listApis") != 1 || calls("listCapabilities") != 1 {
+		t.Errorf("expected the APIs to be fetched once, got %d and %d calls", calls("listApis"), calls("listCapabilities"))
+	}
+}
+
+func TestAPIDiscoveryFailureDoesNotBlockCalls(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":  {respond(431, `{"listapisresponse":{"errorcode":431,"errortext":"not allowed"}}`)},
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithAPIDiscovery())
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.HasAPI("listZones"); err == nil {
+		t.Error("expected the discovery error")
+	}
+	if calls("listZones") != 1 || calls("listApis") != 1 {
+		t.Errorf("unexpected calls: listZones %d, listApis %d", calls("listZones"), calls("listApis"))
+	}
+}
+
+func TestAPIDiscoveryRetriesAfterFailure(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis": {
+			respond(431, `{"listapisresponse":{"errorcode":431,"errortext":"not allowed"}}`),
+			respond(http.StatusOK, apisResponse),
+		},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"listZones":        {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithAPIDiscovery())
+
+	if _, err := client.HasAPI("listZones"); err == nil {
+		t.Fatal("expected the discovery error")
+	}
+	if err := client.DiscoverAPIs(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	p := client.SharedFileSystem.NewCreateSharedFileSystemParams(diskOfferingID, "XFS", "fs", networkID, serviceOfferingID, zoneID)
+	if _, err := client.SharedFileSystem.CreateSharedFileSystem(p); !errors.Is(err, cloudstack.ErrAPIUnavailable) {
+		t.Errorf("expected the preflight check to be enabled after a failure, got %v", err)
+	}
+	if calls("listApis") != 2 {
+		t.Errorf("expected the APIs to be fetched twice, got %d", calls("listApis"))
+	}
+}
+
+func TestAPIDiscoveryCancelledIsNotCached(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":         {respond(http.StatusOK, apisResponse)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"listZones":        {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithAPIDiscovery())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if _, err := client.Zone.ListZonesWithContext(ctx, client.Zone.NewListZonesParams()); err == nil {
+		t.Fatal("expected the cancelled call to fail")
+	}
+
+	ok, err := client.HasAPI("listZones")
+	if err != nil || !ok {
+		t.Errorf("expected listZones to be available, got %v, %v", ok, err)
+	}
+	if calls("listApis") != 1 {
+		t.Errorf("expected the APIs to be fetched once, got %d", calls("listApis"))
+	}
+}
+
+func TestAPIDiscoveryFetchesOnceForAllCallers(t *testing.T) {
+	release := make(chan struct{})
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis": {func(w http.ResponseWriter, r *http.Request) {
+			<-release
+			respond(http.StatusOK, apisResponse)(w, r)
+		}},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	// The caller that starts the fetch gives up while the server is still answering
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if _, err := client.APISchemaWithContext(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the deadline to be exceeded, got %v", err)
+	}
+
+	result := make(chan error, 1)
+	go func() {
+		_, err := client.HasAPI("listZones")
+		result <- err
+	}()
+	close(release)
+
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Errorf("expected the fetch to outlive the cancelled caller, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the APIs")
+	}
+	if calls("listApis") != 1 {
+		t.Errorf("expected the APIs to be fetched once, got %d", calls("listApis"))
+	}
+}
@@ -0,0 +1,226 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+	"go.uber.org/mock/gomock"
+)
+
+const pluginAPIsResponse = `{"listapisresponse":{"count":2,"api":[
+	{"name":"listWidgets","isasync":false,"params":[{"name":"keyword","type":"string"}]},
+	{"name":"createWidget","isasync":true,"params":[
+		{"name":"name","type":"string","required":true},
+		{"name":"zoneids","type":"list"},
+		{"name":"tags","type":"map"},
+		{"name":"size","type":"integer"}
+	]}
+]}}`
+
+func TestDynamicCall(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":         {respond(http.StatusOK, pluginAPIsResponse)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"listWidgets": {func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodGet || r.FormValue("keyword") != "big" {
+				t.Errorf("unexpected listWidgets request: %s %v", r.Method, r.Form)
+			}
+			respond(http.StatusOK, `{"listwidgetsresponse":{"count":2,"widget":[{"id":"w-1"},{"id":"w-2"}]}}`)(w, r)
+		}},
+		"createWidget": {func(w http.ResponseWriter, r *http.Request) {
+			if r.Method != http.MethodPost {
+				t.Errorf("expected a POST request, got %s", r.Method)
+			}
+			want := map[string]string{
+				"name":          "widget",
+				"zoneids":       "zone-1,zone-2",
+				"size":          "42",
+				"tags[0].key":   "a",
+				"tags[0].value": "1",
+				"tags[1].key":   "b",
+				"tags[1].value": "2",
+			}
+			for k, v := range want {
+				if got := r.PostFormValue(k); got != v {
+					t.Errorf("expected %s=%q, got %q", k, v, got)
+				}
+			}
+			respond(http.StatusOK, `{"createwidgetresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)(w, r)
+		}},
+		"queryAsyncJobResult": {respond(http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":1,`+
+			`"jobresult":{"widget":{"id":"w-3","name":"widget"}}}}`)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	ctx := context.Background()
+
+	list, err := client.Custom.Call(ctx, "listWidgets", map[string]any{"keyword": "big"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var widgets []struct{ ID string }
+	if err := list.Decode(&widgets); err != nil || list.Count != 2 || len(widgets) != 2 || widgets[1].ID != "w-2" {
+		t.Errorf("unexpected list result %+v (%v): %s", widgets, err, list.Value)
+	}
+
+	created, err := client.Custom.Call(ctx, "createWidget", map[string]any{
+		"name":    "widget",
+		"zoneids": []string{"zone-1", "zone-2"},
+		"tags":    map[string]string{"b": "2", "a": "1"},
+		"size":    42,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var widget struct{ ID, Name string }
+	if err := created.Decode(&widget); err != nil || created.JobID != "d3c2b1a0-0000-4000-8000-000000000010" || widget.ID != "w-3" {
+		t.Errorf("unexpected async result %+v (%v): %s", widget, err, created.Value)
+	}
+
+	if _, err := client.Custom.Call(ctx, "deleteWidget", nil); !errors.Is(err, cloudstack.ErrAPIUnavailable) {
+		t.Errorf("expected ErrAPIUnavailable, got %v", err)
+	}
+	if calls("listApis") != 1 {
+		t.Errorf("expected the APIs to be fetched once, got %d", calls("listApis"))
+	}
+}
+
+func TestDynamicCallEncodesIntLists(t *testing.T) {
+	var zoneids []string
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":         {respond(http.StatusOK, pluginAPIsResponse)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"createWidget": {func(w http.ResponseWriter, r *http.Request) {
+			zoneids = append(zoneids, r.PostFormValue("zoneids"))
+			respond(http.StatusOK, `{"createwidgetresponse":{"id":"w-1"}}`)(w, r)
+		}},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	ctx := cloudstack.WithAsyncWait(context.Background(), false)
+
+	for _, ids := range []any{[]int{1, 2}, []int64{1, 2}} {
+		if _, err := client.Custom.Call(ctx, "createWidget", map[string]any{"name": "widget", "zoneids": ids}); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if len(zoneids) != 2 || zoneids[0] != "1,2" || zoneids[1] != "1,2" {
+		t.Errorf("expected the lists to be comma separated, got %q", zoneids)
+	}
+}
+
+func TestDynamicCallUsesContextForDiscovery(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":         {respond(http.StatusOK, pluginAPIsResponse)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := client.Custom.Call(ctx, "listWidgets", nil); !errors.Is(err, context.Canceled) {
+		t.Errorf("expected context.Canceled, got %v", err)
+	}
+	if calls("listApis") != 0 {
+		t.Errorf("expected no listApis call with a cancelled context, got %d", calls("listApis"))
+	}
+}
+
+func TestDynamicCallCanBeMocked(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	client := cloudstack.NewMockClient(ctrl)
+
+	want := &cloudstack.DynamicResult{Command: "listWidgets"}
+	client.Custom.(*cloudstack.MockCustomServiceIface).EXPECT().
+		Call(gomock.Any(), "listWidgets", gomock.Any()).Return(want, nil)
+
+	got, err := client.Custom.Call(context.Background(), "listWidgets", nil)
+	if err != nil || got != want {
+		t.Errorf("unexpected result %v, %v", got, err)
+	}
+}
+
+func TestDynamicCallEncodesMapsLikeGeneratedCalls(t *testing.T) {
+	var forms []url.Values
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis": {respond(http.StatusOK, `{"listapisresponse":{"count":1,"api":[
+			{"name":"deployVirtualMachine","isasync":true,"params":[
+				{"name":"serviceofferingid","type":"uuid","required":true},
+				{"name":"templateid","type":"uuid","required":true},
+				{"name":"zoneid","type":"uuid","required":true},
+				{"name":"details","type":"map"},
+				{"name":"datadiskofferinglist","type":"map"}
+			]}
+		]}}`)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"deployVirtualMachine": {func(w http.ResponseWriter, r *http.Request) {
+			r.ParseForm()
+			forms = append(forms, r.PostForm)
+			respond(http.StatusOK, `{"deployvirtualmachineresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000011"}}`)(w, r)
+		}},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+	ctx := cloudstack.WithAsyncWait(context.Background(), false)
+	details := map[string]string{"cpuNumber": "2", "memory": "1024"}
+	datadisks := map[string]string{"disk-1": diskOfferingID}
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	p.SetDetails(details)
+	p.SetDatadiskofferinglist(datadisks)
+	if _, err := client.VirtualMachine.DeployVirtualMachineWithContext(ctx, p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Custom.Call(ctx, "deployVirtualMachine", map[string]any{
+		"serviceofferingid":    serviceOfferingID,
+		"templateid":           templateID,
+		"zoneid":               zoneID,
+		"details":              details,
+		"datadiskofferinglist": datadisks,
+	}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(forms) != 2 {
+		t.Fatalf("expected 2 calls, got %d", len(forms))
+	}
+	for k, v := range forms[0] {
+		if strings.HasPrefix(k, "details[") || strings.HasPrefix(k, "datadiskofferinglist[") {
+			if got := forms[1].Get(k); got != v[0] {
+				t.Errorf("expected %s=%q, got %q", k, v[0], got)
+			}
+		}
+	}
+	if forms[1].Get("details[0].cpuNumber") != "2" || forms[1].Get("datadiskofferinglist[0].diskOffering") != diskOfferingID {
+		t.Errorf("unexpected map encoding: %v", forms[1])
+	}
+}
@@ -0,0 +1,85 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestEnumIsValid(t *testing.T) {
+	tests := []struct {
+		value interface{ IsValid() bool }
+		valid bool
+	}{
+		{cloudstack.VirtualMachineStateRunning, true},
+		{cloudstack.VirtualMachineState("running"), false},
+		{cloudstack.VirtualMachineState("Hibernating"), false},
+		{cloudstack.TemplateStatus("Download Complete"), true},
+		{cloudstack.HostResourceState("Maintenance"), true},
+		{cloudstack.HypervisorType("kvm"), true},
+		{cloudstack.HypervisorType("Xen"), false},
+		{cloudstack.Protocol("TCP"), true},
+		{cloudstack.IntervalType("daily"), true},
+	}
+	for _, tt := range tests {
+		if got := tt.value.IsValid(); got != tt.valid {
+			t.Errorf("expected IsValid() of %v to be %v, got %v", tt.value, tt.valid, got)
+		}
+	}
+}
+
+func TestEnumResponseFields(t *testing.T) {
+	var vm cloudstack.VirtualMachine
+	if err := json.Unmarshal([]byte(`{"id":"vm-1","state":"Running","hypervisor":"KVM"}`), &vm); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vm.State != cloudstack.VirtualMachineStateRunning {
+		t.Errorf("expected state %q, got %q", cloudstack.VirtualMachineStateRunning, vm.State)
+	}
+
+	// Values that are not known, e.g. those of newer servers, are still decoded
+	if err := json.Unmarshal([]byte(`{"id":"vm-1","state":"Hibernating"}`), &vm); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vm.State != "Hibernating" || vm.State.IsValid() {
+		t.Errorf("expected the unknown state Hibernating, got %q", vm.State)
+	}
+}
+
+func TestEnumParams(t *testing.T) {
+	client := cloudstack.NewClient("http://localhost", "APIKEY", "SECRETKEY", true)
+
+	p := client.Firewall.NewCreateFirewallRuleParams("ip-1", cloudstack.ProtocolTCP)
+	if got := p.URLValues().Get("protocol"); got != "tcp" {
+		t.Errorf("expected protocol tcp, got %q", got)
+	}
+
+	d := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	d.SetHypervisor(cloudstack.HypervisorTypeKVM)
+	if got := d.URLValues().Get("hypervisor"); got != "KVM" {
+		t.Errorf("expected hypervisor KVM, got %q", got)
+	}
+	if h, ok := d.GetHypervisor(); !ok || h != cloudstack.HypervisorTypeKVM {
+		t.Errorf("expected hypervisor KVM, got %q", h)
+	}
+}
@@ -0,0 +1,160 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestCSErrorFromSyncCall(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listVirtualMachines": {respond(431, `{"listvirtualmachinesresponse":{"uuidList":[],"errorcode":431,"cserrorcode":4350,"errortext":"Unable to execute API command listvirtualmachines due to invalid value. Invalid parameter id value=e6a4b5c2 due to incorrect long value format, or entity does not exist or due to incorrect parameter annotation for the field in api cmd class."}}`)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := client.VirtualMachine.NewListVirtualMachinesParams()
+	p.SetId("e6a4b5c2-0000-4000-8000-000000000001")
+	_, err := client.VirtualMachine.ListVirtualMachines(p)
+
+	var e *cloudstack.CSError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a *CSError, got %T: %v", err, err)
+	}
+	if e.HTTPStatus != 431 || e.ErrorCode != cloudstack.ErrorCodeParam || e.CSErrorCode != cloudstack.CSErrorCodeInvalidParameterValue {
+		t.Errorf("unexpected error details: %+v", e)
+	}
+	if e.Command != "listVirtualMachines" || e.JobID != "" {
+		t.Errorf("unexpected command or job ID: %+v", e)
+	}
+	if !cloudstack.IsNotFound(err) {
+		t.Errorf("expected IsNotFound to be true for %v", err)
+	}
+	if cloudstack.IsUnauthorized(err) || cloudstack.IsConcurrentOperation(err) || cloudstack.IsResourceLimitExceeded(err) {
+		t.Errorf("unexpected classification of %v", err)
+	}
+}
+
+func TestGetByIDClassifiesErrors(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listVirtualMachines": {
+			respond(431, `{"listvirtualmachinesresponse":{"errorcode":431,"cserrorcode":4350,"errortext":"Unable to find virtual machine"}}`),
+			respond(431, `{"listvirtualmachinesresponse":{"errorcode":431,"cserrorcode":4350,"errortext":"Invalid page size"}}`),
+		},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	const vmID = "e6a4b5c2-0000-4000-8000-000000000001"
+
+	if _, count, err := client.VirtualMachine.GetVirtualMachineByID(vmID); count != 0 || err == nil {
+		t.Errorf("expected no match, got %d, %v", count, err)
+	}
+
+	var e *cloudstack.CSError
+	if _, count, err := client.VirtualMachine.GetVirtualMachineByID(vmID); count != -1 || !errors.As(err, &e) {
+		t.Errorf("expected the *CSError to be returned, got %d, %v", count, err)
+	}
+}
+
+func TestCSErrorFromNonCloudStackResponse(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusBadGateway, "<html><body>Bad Gateway</body></html>")},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	_, err := client.Zone.ListZones(client.Zone.NewListZonesParams())
+
+	var e *cloudstack.CSError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a *CSError, got %T: %v", err, err)
+	}
+	if e.HTTPStatus != http.StatusBadGateway || e.Command != "listZones" {
+		t.Errorf("unexpected error details: %+v", e)
+	}
+}
+
+func TestCSErrorFromAsyncJob(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000002"
+
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"destroyVirtualMachine": {respond(http.StatusOK, fmt.Sprintf(`{"destroyvirtualmachineresponse":{"jobid":%q}}`, jobID))},
+		"queryAsyncJobResult":   {respond(http.StatusOK, fmt.Sprintf(`{"queryasyncjobresultresponse":{"jobid":%q,"cmd":"org.apache.cloudstack.api.command.user.vm.DestroyVMCmd","jobinstancetype":"VirtualMachine","jobinstanceid":"vm-1","jobstatus":2,"jobresultcode":530,"jobresulttype":"object","jobresult":{"errorcode":530,"cserrorcode":4300,"errortext":"There is other active vm work job"}}}`, jobID))},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	_, err := client.VirtualMachine.DestroyVirtualMachine(client.VirtualMachine.NewDestroyVirtualMachineParams("e6a4b5c2-0000-4000-8000-000000000002"))
+
+	var e *cloudstack.CSError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a *CSError, got %T: %v", err, err)
+	}
+	if e.JobID != jobID || e.ErrorCode != cloudstack.ErrorCodeInternal || e.ErrorText != "There is other active vm work job" {
+		t.Errorf("unexpected error details: %+v", e)
+	}
+	if !cloudstack.IsConcurrentOperation(err) {
+		t.Errorf("expected IsConcurrentOperation to be true for %v", err)
+	}
+	if cloudstack.IsNotFound(err) {
+		t.Errorf("unexpected classification of %v", err)
+	}
+
+	var je *cloudstack.AsyncJobError
+	if !errors.As(err, &je) {
+		t.Fatalf("expected an *AsyncJobError, got %T: %v", err, err)
+	}
+	if je.JobID != jobID || je.JobInstanceType != "VirtualMachine" || je.JobInstanceID != "vm-1" || je.ResultCode != 530 {
+		t.Errorf("unexpected async job error details: %+v", je)
+	}
+	if je.Command != "org.apache.cloudstack.api.command.user.vm.DestroyVMCmd" || je.Err != e {
+		t.Errorf("unexpected async job error details: %+v", je)
+	}
+}
+
+func TestAsyncJobErrorWithTextResult(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000003"
+
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"queryAsyncJobResult": {respond(http.StatusOK, fmt.Sprintf(`{"queryasyncjobresultresponse":{"jobid":%q,"jobstatus":2,"jobresultcode":533,"jobresulttype":"text","jobresult":"Unable to create a deployment for VM"}}`, jobID))},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	_, err := client.GetAsyncJobResult(jobID, 10)
+
+	var je *cloudstack.AsyncJobError
+	if !errors.As(err, &je) {
+		t.Fatalf("expected an *AsyncJobError, got %T: %v", err, err)
+	}
+	if je.Err.ErrorCode != cloudstack.ErrorCodeInsufficientCapacity || je.Err.ErrorText != "Unable to create a deployment for VM" {
+		t.Errorf("unexpected error details: %+v", je.Err)
+	}
+}
@@ -0,0 +1,83 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+const driftedHostsResponse = `{"listhostsresponse":{"count":1,"host":[
+	{"id":"host-1","name":"host-1","newfield":{"a":"b"}}
+]}}`
+
+func TestResponseExtraFields(t *testing.T) {
+	var vm cloudstack.VirtualMachine
+	err := json.Unmarshal([]byte(`{"id":"vm-1","Name":"vm","futurefield":"x","nic":[{"id":"nic-1","futurenicfield":1}]}`), &vm)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vm.Id != "vm-1" || vm.Name != "vm" {
+		t.Errorf("expected the known fields to be decoded, got %+v", vm)
+	}
+	if len(vm.Extra) != 1 || string(vm.Extra["futurefield"]) != `"x"` {
+		t.Errorf("expected futurefield in Extra, got %v", vm.Extra)
+	}
+	if len(vm.Nic) != 1 || string(vm.Nic[0].Extra["futurenicfield"]) != "1" {
+		t.Errorf("expected futurenicfield in the Extra of the nic, got %+v", vm.Nic)
+	}
+
+	// Extra is reset when decoding into a struct that is used before
+	if err := json.Unmarshal([]byte(`{"id":"vm-2"}`), &vm); err != nil || vm.Extra != nil {
+		t.Errorf("expected no Extra fields, got %v (%v)", vm.Extra, err)
+	}
+}
+
+func TestStrictDecodingUnknownFields(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listHosts": {respond(http.StatusOK, driftedHostsResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	r, err := client.Host.ListHosts(client.Host.NewListHostsParams())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, ok := r.Hosts[0].Extra["newfield"]; !ok {
+		t.Errorf("expected newfield in Extra, got %v", r.Hosts[0].Extra)
+	}
+
+	strict := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithStrictDecoding())
+	_, err = strict.Host.ListHosts(strict.Host.NewListHostsParams())
+
+	var e *cloudstack.DecodeError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a *DecodeError, got %v", err)
+	}
+	if !reflect.DeepEqual(e.Unknown, []string{"host[0].newfield"}) || len(e.Coerced) != 0 {
+		t.Errorf("expected newfield to be unknown, got %+v", e)
+	}
+}
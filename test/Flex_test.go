@@ -0,0 +1,103 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+const oddHostsResponse = `{"listhostsresponse":{"count":2,"host":[
+	{"id":"host-1","cpunumber":8,"cpuspeed":2400,"hahost":false},
+	{"id":"host-2","cpunumber":"4","cpuspeed":"2400","hahost":"true"}
+]}}`
+
+func TestFlexDecoding(t *testing.T) {
+	var v struct {
+		Int   cloudstack.FlexInt   `json:"int"`
+		Int64 cloudstack.FlexInt64 `json:"int64"`
+		Float cloudstack.FlexFloat `json:"float"`
+		Bool  cloudstack.FlexBool  `json:"bool"`
+	}
+
+	tests := map[string]func() bool{
+		`{"int":2,"int64":3,"float":1.5,"bool":true}`:         func() bool { return v.Int == 2 && v.Int64 == 3 && v.Float == 1.5 && bool(v.Bool) },
+		`{"int":"2","int64":"3","float":"1.5","bool":"true"}`: func() bool { return v.Int == 2 && v.Int64 == 3 && v.Float == 1.5 && bool(v.Bool) },
+		`{"int":2.0,"int64":"9007199254740993","bool":1}`:     func() bool { return v.Int == 2 && v.Int64 == 9007199254740993 && bool(v.Bool) },
+		`{"int":"","int64":null,"float":"","bool":""}`:        func() bool { return v.Int == 0 && v.Int64 == 0 && v.Float == 0 && !bool(v.Bool) },
+		`{"int":" 7 ","int64":-1,"float":-2,"bool":"FALSE"}`:  func() bool { return v.Int == 7 && v.Int64 == -1 && v.Float == -2 && !bool(v.Bool) },
+	}
+	for in, check := range tests {
+		v.Int, v.Int64, v.Float, v.Bool = 99, 99, 99, true
+		if err := json.Unmarshal([]byte(in), &v); err != nil {
+			t.Errorf("%s: unexpected error: %v", in, err)
+			continue
+		}
+		if !check() {
+			t.Errorf("%s: unexpected values %+v", in, v)
+		}
+	}
+
+	for _, in := range []string{`{"int":"two"}`, `{"int":2.5}`, `{"float":"x"}`, `{"bool":"yes"}`} {
+		if err := json.Unmarshal([]byte(in), &v); err == nil {
+			t.Errorf("%s: expected an error", in)
+		}
+	}
+}
+
+func TestFlexResponseFields(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listHosts": {respond(http.StatusOK, oddHostsResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	r, err := client.Host.ListHosts(client.Host.NewListHostsParams())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Count != 2 || r.Hosts[1].Cpunumber != 4 || r.Hosts[1].Cpuspeed != 2400 || !r.Hosts[1].Hahost {
+		t.Errorf("unexpected hosts %+v", r.Hosts)
+	}
+}
+
+func TestStrictDecoding(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listHosts": {respond(http.StatusOK, oddHostsResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithStrictDecoding())
+	_, err := client.Host.ListHosts(client.Host.NewListHostsParams())
+
+	var e *cloudstack.DecodeError
+	if !errors.As(err, &e) {
+		t.Fatalf("expected a *DecodeError, got %v", err)
+	}
+	want := []string{`host[1].cpunumber: "4"`, `host[1].cpuspeed: "2400"`, `host[1].hahost: "true"`}
+	if e.Command != "listHosts" || !reflect.DeepEqual(e.Coerced, want) {
+		t.Errorf("expected coerced fields %v, got %+v", want, e)
+	}
+}
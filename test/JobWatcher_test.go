@@ -0,0 +1,215 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestJobWatcherPollsJobsInBatches(t *testing.T) {
+	const jobs = 20
+	const unlisted = "d3c2b1a0-0000-4000-8000-0000000000ff"
+
+	var mu sync.Mutex
+	var lists, queries int
+	started := map[string]string{} // The names of the VMs by job ID
+
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			id := fmt.Sprintf("d3c2b1a0-0000-4000-8000-%012x", len(started))
+			if r.FormValue("name") == "unlisted" {
+				id = unlisted
+			}
+			started[id] = r.FormValue("name")
+			fmt.Fprintf(w, `{"deployvirtualmachineresponse":{"id":"vm-%s","jobid":%q}}`, r.FormValue("name"), id)
+		}},
+		"listAsyncJobs": {func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if r.FormValue("startdate") == "" || r.FormValue("listall") != "" {
+				t.Errorf("unexpected listAsyncJobs parameters: %v", r.Form)
+			}
+			lists++
+
+			// The first list reports all jobs as pending, after that they are finished
+			var l []string
+			for id, name := range started {
+				if id == unlisted {
+					continue
+				}
+				if lists == 1 {
+					l = append(l, fmt.Sprintf(`{"jobid":%q,"jobstatus":0}`, id))
+					continue
+				}
+				l = append(l, fmt.Sprintf(`{"jobid":%q,"jobstatus":1,"jobresulttype":"object","jobresult":{"virtualmachine":{"id":"vm-%s","state":"Running"}}}`, id, name))
+			}
+			fmt.Fprintf(w, `{"listasyncjobsresponse":{"count":%d,"asyncjobs":[%s]}}`, len(l), strings.Join(l, ","))
+		}},
+		"queryAsyncJobResult": {func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			queries++
+			if r.FormValue("jobid") != unlisted {
+				t.Errorf("unexpected query for listed job %s", r.FormValue("jobid"))
+			}
+			fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobid":%q,"jobstatus":1,"jobresulttype":"object","jobresult":{"virtualmachine":{"id":"vm-unlisted","state":"Running"}}}}`, unlisted)
+		}},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithJobWatcher(20*time.Millisecond))
+
+	var wg sync.WaitGroup
+	for i := 0; i <= jobs; i++ {
+		name := fmt.Sprint(i)
+		if i == jobs {
+			name = "unlisted"
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+			p.SetName(name)
+			r, err := client.VirtualMachine.DeployVirtualMachine(p)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+				return
+			}
+			if r.Id != "vm-"+name || r.State != "Running" {
+				t.Errorf("unexpected result: %+v", r)
+			}
+		}()
+	}
+	wg.Wait()
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if lists > 4 {
+		t.Errorf("expected the jobs to be polled in a few batches, got %d listAsyncJobs calls", lists)
+	}
+	if queries == 0 {
+		t.Errorf("expected the unlisted job to be queried separately")
+	}
+}
+
+func TestJobWatcherWaitsForJobHandles(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listAsyncJobs": {respond(http.StatusOK, `{"listasyncjobsresponse":{"count":1,"asyncjobs":[{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":2,"jobresultcode":530,"jobresulttype":"object","jobresult":{"errorcode":533,"errortext":"Insufficient capacity"}}]}}`)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithJobWatcher(10*time.Millisecond))
+
+	job := client.NewJob("d3c2b1a0-0000-4000-8000-000000000010")
+	err := job.Wait(context.Background())
+
+	var je *cloudstack.AsyncJobError
+	if !errors.As(err, &je) || je.Err.ErrorCode != cloudstack.ErrorCodeInsufficientCapacity {
+		t.Fatalf("expected an *AsyncJobError, got %v", err)
+	}
+	if job.Status() != cloudstack.JobFailed {
+		t.Errorf("expected a failed job, got %s", job.Status())
+	}
+}
+
+func TestJobWatcherRetriesTransientErrors(t *testing.T) {
+	const created = "2026-01-02T03:04:05+0000"
+
+	var mu sync.Mutex
+	var startdates []string
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listAsyncJobs": {func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			startdates = append(startdates, r.FormValue("startdate"))
+			mu.Unlock()
+			respond(http.StatusOK, `{"listasyncjobsresponse":{}}`)(w, r)
+		}},
+		"queryAsyncJobResult": {
+			respond(http.StatusServiceUnavailable, "<html>Service Unavailable</html>"),
+			respond(http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":0,"created":"`+created+`"}}`),
+			respond(http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":1,"created":"`+created+`","jobresult":{}}}`),
+		},
+	})
+	defer server.Close()
+
+	// A single attempt also disables the retries of queryAsyncJobResult itself
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithJobWatcher(10*time.Millisecond),
+		cloudstack.WithRetryPolicy(cloudstack.RetryPolicy{MaxAttempts: 1}))
+
+	job := client.NewJob("d3c2b1a0-0000-4000-8000-000000000010")
+	if err := job.Wait(context.Background()); err != nil {
+		t.Fatalf("expected the job to be polled again after a transient error, got %v", err)
+	}
+	if calls("queryAsyncJobResult") != 3 {
+		t.Errorf("expected 3 queries, got %d", calls("queryAsyncJobResult"))
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(startdates) != 3 || startdates[2] != created {
+		t.Errorf("expected the jobs to be listed from the start of the job once known, got %q", startdates)
+	}
+}
+
+func TestJobWatcherCancelsPollWithoutWaiters(t *testing.T) {
+	aborted := make(chan struct{})
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listAsyncJobs": {func(w http.ResponseWriter, r *http.Request) {
+			select {
+			case <-r.Context().Done():
+				close(aborted)
+			case <-time.After(5 * time.Second):
+			}
+		}},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithJobWatcher(10*time.Millisecond))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := client.NewJob("d3c2b1a0-0000-4000-8000-000000000010").Wait(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected context.DeadlineExceeded, got %v", err)
+	}
+
+	select {
+	case <-aborted:
+	case <-time.After(time.Second):
+		t.Error("expected the running poll to be cancelled once nobody waits for it")
+	}
+}
@@ -0,0 +1,202 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// newJobServer returns a server that starts a deploy job which finishes after the given number of polls
+func newJobServer(t *testing.T, jobID string, pending int32) (*httptest.Server, *int32) {
+	var polls int32
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, fmt.Sprintf(`{"deployvirtualmachineresponse":{"id":"vm-1","jobid":%q}}`, jobID))},
+		"queryAsyncJobResult": {func(w http.ResponseWriter, r *http.Request) {
+			if atomic.AddInt32(&polls, 1) <= pending {
+				fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobid":%q,"jobstatus":0}}`, jobID)
+				return
+			}
+			fmt.Fprintf(w, `{"queryasyncjobresultresponse":{"jobid":%q,"jobstatus":1,"jobresulttype":"object","jobresult":{"virtualmachine":{"id":"vm-1","name":"server-1","state":"Running"}}}}`, jobID)
+		}},
+	})
+	return server, &polls
+}
+
+func TestJobWaitAndResult(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000004"
+
+	server, polls := newJobServer(t, jobID, 2)
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithPollStrategy(cloudstack.FixedPoll(10*time.Millisecond)))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	r, err := client.VirtualMachine.DeployVirtualMachine(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	job := client.NewJob(r.JobID)
+	if status, err := job.Poll(); err != nil || status != cloudstack.JobPending {
+		t.Fatalf("expected a pending job, got %s (%v)", status, err)
+	}
+
+	var vm cloudstack.DeployVirtualMachineResponse
+	if err := job.Result(&vm); err != cloudstack.ErrJobPending {
+		t.Fatalf("expected ErrJobPending, got %v", err)
+	}
+
+	if err := job.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.Status() != cloudstack.JobSucceeded || atomic.LoadInt32(polls) != 3 {
+		t.Errorf("expected a succeeded job after 3 polls, got %s after %d polls", job.Status(), atomic.LoadInt32(polls))
+	}
+
+	if err := job.Result(&vm); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if vm.Id != "vm-1" || vm.Name != "server-1" || vm.State != "Running" {
+		t.Errorf("unexpected result: %+v", vm)
+	}
+}
+
+func TestJobWaitReturnsAsyncJobError(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000005"
+
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"queryAsyncJobResult": {respond(http.StatusOK, fmt.Sprintf(`{"queryasyncjobresultresponse":{"jobid":%q,"jobstatus":2,"jobresultcode":530,"jobresulttype":"object","jobresult":{"errorcode":533,"errortext":"Insufficient capacity"}}}`, jobID))},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	job := client.NewJob(jobID)
+	err := job.Wait(context.Background())
+
+	var je *cloudstack.AsyncJobError
+	if !errors.As(err, &je) || je.Err.ErrorCode != cloudstack.ErrorCodeInsufficientCapacity {
+		t.Fatalf("expected an *AsyncJobError, got %v", err)
+	}
+	if job.Status() != cloudstack.JobFailed || job.Result(&struct{}{}) != err {
+		t.Errorf("expected a failed job returning %v, got %s", err, job.Status())
+	}
+}
+
+func TestWithAsyncWaitOverridesClient(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000006"
+
+	server, polls := newJobServer(t, jobID, 0)
+	defer server.Close()
+
+	async := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := async.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	r, err := async.VirtualMachine.DeployVirtualMachineWithContext(cloudstack.WithAsyncWait(context.Background(), false), p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.JobID != jobID || atomic.LoadInt32(polls) != 0 {
+		t.Fatalf("expected the call to return without waiting, got %+v after %d polls", r, atomic.LoadInt32(polls))
+	}
+
+	syncClient := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	r, err = syncClient.VirtualMachine.DeployVirtualMachineWithContext(cloudstack.WithAsyncWait(context.Background(), true), p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Name != "server-1" || atomic.LoadInt32(polls) != 1 {
+		t.Errorf("expected the call to wait for the job, got %+v after %d polls", r, atomic.LoadInt32(polls))
+	}
+}
+
+func TestPollStrategies(t *testing.T) {
+	tests := []struct {
+		name     string
+		strategy cloudstack.PollStrategy
+		want     []time.Duration
+	}{
+		{"fixed", cloudstack.FixedPoll(2 * time.Second), []time.Duration{2 * time.Second, 2 * time.Second, 2 * time.Second}},
+		{"linear", cloudstack.LinearPoll(time.Second, 2*time.Second), []time.Duration{time.Second, 2 * time.Second, 2 * time.Second}},
+		{"exponential", cloudstack.ExponentialPoll(time.Second, 5*time.Second), []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second}},
+	}
+
+	for _, tt := range tests {
+		for i, want := range tt.want {
+			if got := tt.strategy.Next(i + 1); got != want {
+				t.Errorf("%s: attempt %d: expected %s, got %s", tt.name, i+1, want, got)
+			}
+		}
+	}
+
+	jittered := cloudstack.JitteredPoll(cloudstack.FixedPoll(10*time.Second), 0.2)
+	for i := 1; i <= 100; i++ {
+		if got := jittered.Next(i); got < 8*time.Second || got > 12*time.Second {
+			t.Fatalf("jittered: attempt %d: %s is out of range", i, got)
+		}
+	}
+}
+
+func TestAsyncVariantReturnsJob(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000007"
+
+	server, polls := newJobServer(t, jobID, 1)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithPollStrategy(cloudstack.FixedPoll(10*time.Millisecond)), cloudstack.WithLogger(logger))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	job, err := client.VirtualMachine.DeployVirtualMachineAsync(context.Background(), p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if job.ID != jobID || atomic.LoadInt32(polls) != 0 {
+		t.Fatalf("expected a handle to job %s without polling, got %s after %d polls", jobID, job.ID, atomic.LoadInt32(polls))
+	}
+
+	if err := job.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var vm cloudstack.DeployVirtualMachineResponse
+	if err := job.Result(&vm); err != nil || vm.Id != "vm-1" {
+		t.Fatalf("unexpected result: %+v, %v", vm, err)
+	}
+
+	records := decodeLogRecords(t, &buf)
+	finished := records[len(records)-1]
+	if finished["msg"] != "CloudStack async job finished" || finished["command"] != "deployVirtualMachine" || finished["wait"] == nil {
+		t.Errorf("unexpected job record: %v", finished)
+	}
+}
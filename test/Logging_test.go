@@ -0,0 +1,244 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func decodeLogRecords(t *testing.T, buf *bytes.Buffer) []map[string]interface{} {
+	t.Helper()
+
+	var records []map[string]interface{}
+	dec := json.NewDecoder(buf)
+	for dec.More() {
+		var r map[string]interface{}
+		if err := dec.Decode(&r); err != nil {
+			t.Fatalf("failed to decode log record: %v", err)
+		}
+		records = append(records, r)
+	}
+	return records
+}
+
+func TestLoggerLogsCallsAndJobs(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)},
+		"queryAsyncJobResult": {respond(http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":1,`+
+			`"jobresult":{"virtualmachine":{"id":"vm-1","password":"hunter2"}}}}`)},
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug}))
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithLogger(logger))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	p.SetUserdata("c2VjcmV0")
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	out := buf.String()
+	for _, secret := range []string{"APIKEY", "c2VjcmV0", "hunter2"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("expected %q to be redacted from the log: %s", secret, out)
+		}
+	}
+
+	records := decodeLogRecords(t, &buf)
+	var msgs []string
+	for _, r := range records {
+		msgs = append(msgs, r["msg"].(string))
+	}
+	want := []string{
+		"CloudStack API call",
+		"Waiting for CloudStack async job",
+		"CloudStack API call",
+		"CloudStack async job finished",
+	}
+	if strings.Join(msgs, ",") != strings.Join(want, ",") {
+		t.Fatalf("expected records %v, got %v", want, msgs)
+	}
+
+	call := records[0]
+	if call["command"] != "deployVirtualMachine" || call["jobid"] != "d3c2b1a0-0000-4000-8000-000000000010" || call["status"] != float64(200) {
+		t.Errorf("unexpected call record: %v", call)
+	}
+	if _, ok := call["latency"]; !ok {
+		t.Errorf("expected the latency to be logged: %v", call)
+	}
+	if params := call["params"].(string); !strings.Contains(params, "userdata=REDACTED") || !strings.Contains(params, "zoneid="+zoneID) {
+		t.Errorf("unexpected params: %s", params)
+	}
+
+	job := records[3]
+	if job["command"] != "deployVirtualMachine" || job["jobid"] != "d3c2b1a0-0000-4000-8000-000000000010" || job["status"] != "succeeded" {
+		t.Errorf("unexpected job record: %v", job)
+	}
+}
+
+func TestLoggerLevelsAndTruncation(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones":  {respond(http.StatusOK, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-1","name":"`+strings.Repeat("x", 100)+`"}]}}`)},
+		"createUser": {respond(431, `{"createuserresponse":{"errorcode":431,"errortext":"invalid parameter"}}`)},
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithLogger(logger, cloudstack.LogConfig{
+		CallLevel:   slog.LevelInfo,
+		ErrorLevel:  slog.LevelError,
+		MaxBodySize: 32,
+	}))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	u := client.User.NewCreateUserParams("account", "user@example.com", "first", "last", "secret", "user")
+	if _, err := client.User.CreateUser(u); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	records := decodeLogRecords(t, &buf)
+	if len(records) != 2 {
+		t.Fatalf("expected 2 records, got %d", len(records))
+	}
+
+	if records[0]["level"] != "INFO" {
+		t.Errorf("expected the call to be logged at INFO: %v", records[0])
+	}
+	if body := records[0]["body"].(string); !strings.HasSuffix(body, "(truncated, 146 bytes)") || len(body) > 64 {
+		t.Errorf("expected the body to be truncated: %s", body)
+	}
+
+	if records[1]["level"] != "ERROR" || records[1]["status"] != float64(431) || records[1]["error"] == nil {
+		t.Errorf("unexpected error record: %v", records[1])
+	}
+	if params := records[1]["params"].(string); !strings.Contains(params, "password=REDACTED") {
+		t.Errorf("expected the password to be redacted: %s", params)
+	}
+}
+
+func TestLoggerTruncatesWholeCharacters(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-1","name":"`+strings.Repeat("é", 50)+`"}]}}`)},
+	})
+	defer server.Close()
+
+	// The name starts at byte 42, so every other limit falls within a character
+	for limit := 44; limit < 48; limit++ {
+		var buf bytes.Buffer
+		logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelInfo}))
+		client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithLogger(logger, cloudstack.LogConfig{
+			CallLevel:   slog.LevelInfo,
+			MaxBodySize: limit,
+		}))
+
+		if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		records := decodeLogRecords(t, &buf)
+		body := records[0]["body"].(string)
+		if strings.ContainsRune(body, utf8.RuneError) || !strings.Contains(body, "é... (truncated") {
+			t.Errorf("expected the body to be truncated at a whole character with limit %d: %s", limit, body)
+		}
+	}
+}
+
+func TestLoggerFallsBackToDefault(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	var buf bytes.Buffer
+	defer slog.SetDefault(slog.Default())
+	slog.SetDefault(slog.New(slog.NewJSONHandler(&buf, nil)))
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithLogger(nil, cloudstack.LogConfig{
+		CallLevel: slog.LevelInfo,
+	}))
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	records := decodeLogRecords(t, &buf)
+	if len(records) != 1 || records[0]["command"] != "listZones" {
+		t.Errorf("expected the call to be logged to the default logger, got %v", records)
+	}
+}
+
+type requestIDKey struct{}
+
+// requestIDHandler adds the request ID of the context to every record
+type requestIDHandler struct {
+	slog.Handler
+}
+
+func (h requestIDHandler) Handle(ctx context.Context, r slog.Record) error {
+	if id, ok := ctx.Value(requestIDKey{}).(string); ok {
+		r.AddAttrs(slog.String("request", id))
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func TestLoggerUsesContextOfJobHandles(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000011"
+
+	server, _ := newJobServer(t, jobID, 0)
+	defer server.Close()
+
+	var buf bytes.Buffer
+	logger := slog.New(requestIDHandler{slog.NewJSONHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})})
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithLogger(logger))
+
+	ctx := context.WithValue(context.Background(), requestIDKey{}, "req-1")
+	if err := client.NewJob(jobID).Wait(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.NewJob(jobID).PollWithContext(ctx); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var jobs int
+	for _, r := range decodeLogRecords(t, &buf) {
+		if r["request"] != "req-1" {
+			t.Errorf("expected the request ID of the context in %v", r)
+		}
+		if r["msg"] == "CloudStack async job finished" {
+			jobs++
+		}
+	}
+	if jobs != 2 {
+		t.Errorf("expected both jobs to be logged as finished, got %d", jobs)
+	}
+}
@@ -0,0 +1,161 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestPrometheusMetrics(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)},
+		"queryAsyncJobResult": {respond(http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":1,`+
+			`"jobresult":{"virtualmachine":{"id":"vm-1"}}}}`)},
+		"listZones": {
+			respond(http.StatusOK, zonesResponse),
+			respond(431, `{"listzonesresponse":{"errorcode":431,"errortext":"invalid parameter"}}`),
+		},
+	})
+	defer server.Close()
+
+	metrics := cloudstack.NewPrometheusMetricsWithBuckets([]float64{1, 0.5}, []float64{60})
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithMetrics(metrics))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	rec := httptest.NewRecorder()
+	metrics.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("unexpected content type %q", ct)
+	}
+
+	out := rec.Body.String()
+	for _, line := range []string{
+		"# TYPE cloudstack_requests_total counter",
+		`cloudstack_requests_total{command="deployVirtualMachine"} 1`,
+		`cloudstack_requests_total{command="listZones"} 2`,
+		`cloudstack_requests_total{command="queryAsyncJobResult"} 1`,
+		`cloudstack_request_errors_total{command="listZones",code="431"} 1`,
+		"# TYPE cloudstack_request_duration_seconds histogram",
+		`cloudstack_request_duration_seconds_bucket{command="listZones",le="0.5"} 2`,
+		`cloudstack_request_duration_seconds_bucket{command="listZones",le="1"} 2`,
+		`cloudstack_request_duration_seconds_bucket{command="listZones",le="+Inf"} 2`,
+		`cloudstack_request_duration_seconds_count{command="listZones"} 2`,
+		`cloudstack_async_job_wait_seconds_bucket{command="deployVirtualMachine",status="succeeded",le="60"} 1`,
+		`cloudstack_async_job_wait_seconds_count{command="deployVirtualMachine",status="succeeded"} 1`,
+	} {
+		if !strings.Contains(out, line+"\n") {
+			t.Errorf("expected line %q in:\n%s", line, out)
+		}
+	}
+	if strings.Contains(out, `cloudstack_request_errors_total{command="deployVirtualMachine"`) {
+		t.Errorf("unexpected error count for deployVirtualMachine:\n%s", out)
+	}
+}
+
+func TestPrometheusMetricsEscapesLabels(t *testing.T) {
+	metrics := cloudstack.NewPrometheusMetrics()
+	metrics.ObserveCall("a\"b\\c\nd", time.Second, nil)
+
+	var b strings.Builder
+	if _, err := metrics.WriteTo(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `cloudstack_requests_total{command="a\"b\\c\nd"} 1`; !strings.Contains(b.String(), want) {
+		t.Errorf("expected %q in:\n%s", want, b.String())
+	}
+}
+
+func TestWithMetricsReplacesAndIgnoresNil(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	first := cloudstack.NewPrometheusMetrics()
+	second := cloudstack.NewPrometheusMetrics()
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithMetrics(nil), cloudstack.WithMetrics(first), cloudstack.WithMetrics(second), cloudstack.WithMetrics(nil))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var b strings.Builder
+	if _, err := first.WriteTo(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if strings.Contains(b.String(), "cloudstack_requests_total{") {
+		t.Errorf("expected the replaced metrics to be empty, got:\n%s", b.String())
+	}
+
+	b.Reset()
+	if _, err := second.WriteTo(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `cloudstack_requests_total{command="listZones"} 1`; !strings.Contains(b.String(), want) {
+		t.Errorf("expected %q in:\n%s", want, b.String())
+	}
+}
+
+func TestPrometheusMetricsJobHandle(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000008"
+
+	server, _ := newJobServer(t, jobID, 1)
+	defer server.Close()
+
+	metrics := cloudstack.NewPrometheusMetrics()
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithPollStrategy(cloudstack.FixedPoll(10*time.Millisecond)), cloudstack.WithMetrics(metrics))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	job, err := client.VirtualMachine.DeployVirtualMachineAsync(context.Background(), p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := job.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var b strings.Builder
+	if _, err := metrics.WriteTo(&b); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := `cloudstack_async_job_wait_seconds_count{command="deployVirtualMachine",status="succeeded"} 1`; !strings.Contains(b.String(), want) {
+		t.Errorf("expected %q in:\n%s", want, b.String())
+	}
+}
@@ -0,0 +1,121 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestMiddlewareSeesRequestAndResponse(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)},
+		"createUser":           {respond(431, `{"createuserresponse":{"errorcode":431,"errortext":"invalid parameter"}}`)},
+	})
+	defer server.Close()
+
+	var order []string
+	var requests []*cloudstack.Request
+	var responses []*cloudstack.Response
+
+	record := func(name string) cloudstack.Middleware {
+		return func(next cloudstack.Handler) cloudstack.Handler {
+			return func(ctx context.Context, req *cloudstack.Request) (*cloudstack.Response, error) {
+				order = append(order, name+" before")
+				resp, err := next(ctx, req)
+				order = append(order, name+" after")
+				if name == "outer" {
+					requests = append(requests, req)
+					responses = append(responses, resp)
+				}
+				return resp, err
+			}
+		}
+	}
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithMiddleware(record("outer"), record("inner")))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{"outer before", "inner before", "inner after", "outer after"}
+	if len(order) != len(want) {
+		t.Fatalf("expected %v, got %v", want, order)
+	}
+	for i := range want {
+		if order[i] != want[i] {
+			t.Fatalf("expected %v, got %v", want, order)
+		}
+	}
+
+	req, resp := requests[0], responses[0]
+	if req.Command != "deployVirtualMachine" || !req.Async || !req.Post || req.Params.Get("zoneid") != zoneID {
+		t.Errorf("unexpected request: %+v", req)
+	}
+	if req.Params.Get("apiKey") != "" || req.Params.Get("signature") != "" {
+		t.Errorf("expected the request to be passed before it is signed: %v", req.Params)
+	}
+	if resp.HTTPStatus != http.StatusOK || resp.JobID != "d3c2b1a0-0000-4000-8000-000000000010" || resp.Latency <= 0 || len(resp.Body) == 0 {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+
+	u := client.User.NewCreateUserParams("account", "user@example.com", "first", "last", "secret", "user")
+	if _, err := client.User.CreateUser(u); err == nil {
+		t.Fatal("expected an error")
+	}
+
+	req, resp = requests[1], responses[1]
+	if req.Async || req.Params.Get("password") != "REDACTED" || req.Params.Get("username") != "user" {
+		t.Errorf("unexpected request: %+v", req)
+	}
+	if resp.HTTPStatus != 431 || resp.JobID != "" {
+		t.Errorf("unexpected response: %+v", resp)
+	}
+}
+
+func TestMiddlewareCanInjectFaults(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	injected := errors.New("injected fault")
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithMiddleware(func(next cloudstack.Handler) cloudstack.Handler {
+		return func(ctx context.Context, req *cloudstack.Request) (*cloudstack.Response, error) {
+			if req.Command == "listZones" {
+				return nil, injected
+			}
+			return next(ctx, req)
+		}
+	}))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != injected {
+		t.Fatalf("expected the injected fault, got %v", err)
+	}
+	if calls("listZones") != 0 {
+		t.Errorf("expected no calls to reach the server, got %d", calls("listZones"))
+	}
+}
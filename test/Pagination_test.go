@@ -0,0 +1,174 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// newZonesServer returns a server listing the given number of zones, recording the requested pages
+func newZonesServer(t *testing.T, zones int) (*httptest.Server, func() []int) {
+	var mu sync.Mutex
+	var pages []int
+
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {func(w http.ResponseWriter, r *http.Request) {
+			page, _ := strconv.Atoi(r.FormValue("page"))
+			pagesize, _ := strconv.Atoi(r.FormValue("pagesize"))
+			if page < 1 || pagesize < 1 {
+				t.Errorf("unexpected request: %v", r.Form)
+				return
+			}
+			if r.FormValue("available") != "true" {
+				t.Errorf("expected the params to be sent with every page: %v", r.Form)
+			}
+
+			mu.Lock()
+			pages = append(pages, page)
+			mu.Unlock()
+
+			if page == 99 {
+				respond(431, `{"listzonesresponse":{"errorcode":431,"errortext":"invalid page"}}`)(w, r)
+				return
+			}
+
+			var l []string
+			for i := (page - 1) * pagesize; i < page*pagesize && i < zones; i++ {
+				l = append(l, fmt.Sprintf(`{"id":"zone-%d","name":"zone %d"}`, i, i))
+			}
+			fmt.Fprintf(w, `{"listzonesresponse":{"count":%d,"zone":[%s]}}`, zones, strings.Join(l, ","))
+		}},
+	})
+
+	return server, func() []int {
+		mu.Lock()
+		defer mu.Unlock()
+		return append([]int(nil), pages...)
+	}
+}
+
+func TestListAllWalksAllPages(t *testing.T) {
+	server, pages := newZonesServer(t, 23)
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := client.Zone.NewListZonesParams()
+	p.SetAvailable(true)
+	p.SetPagesize(5)
+
+	zones, err := client.Zone.ListZonesAll(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(zones) != 23 {
+		t.Fatalf("expected 23 zones, got %d", len(zones))
+	}
+	for i, z := range zones {
+		if z.Id != fmt.Sprintf("zone-%d", i) {
+			t.Errorf("expected zone-%d at position %d, got %s", i, i, z.Id)
+		}
+	}
+	if got := pages(); len(got) != 5 {
+		t.Errorf("expected 5 pages to be fetched, got %v", got)
+	}
+	if _, found := p.GetPage(); found {
+		t.Errorf("expected the params passed in to be left untouched")
+	}
+}
+
+func TestListIterWithPrefetch(t *testing.T) {
+	server, pages := newZonesServer(t, 100)
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := client.Zone.NewListZonesParams()
+	p.SetAvailable(true)
+
+	var ids []string
+	for z, err := range client.Zone.ListZonesIter(p, cloudstack.WithPageSize(10), cloudstack.WithPrefetch(3)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		ids = append(ids, z.Id)
+	}
+	if len(ids) != 100 || ids[99] != "zone-99" {
+		t.Fatalf("expected 100 zones in order, got %d", len(ids))
+	}
+	if got := pages(); len(got) != 10 {
+		t.Errorf("expected 10 pages to be fetched, got %v", got)
+	}
+}
+
+func TestListIterStopsEarly(t *testing.T) {
+	server, pages := newZonesServer(t, 100)
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := client.Zone.NewListZonesParams()
+	p.SetAvailable(true)
+
+	n := 0
+	for _, err := range client.Zone.ListZonesIter(p, cloudstack.WithPageSize(10), cloudstack.WithPrefetch(2)) {
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if n++; n == 15 {
+			break
+		}
+	}
+
+	// The second page is being iterated, so at most the two pages after it are prefetched
+	if got := pages(); len(got) > 4 {
+		t.Errorf("expected at most 4 pages to be fetched, got %v", got)
+	}
+}
+
+func TestListAllReturnsErrors(t *testing.T) {
+	server, _ := newZonesServer(t, 100)
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := client.Zone.NewListZonesParams()
+	p.SetAvailable(true)
+	p.SetPagesize(1)
+
+	// With a page size of 1, the 99th page returns an error
+	zones, err := client.Zone.ListZonesAll(p)
+	var e *cloudstack.CSError
+	if !errors.As(err, &e) || e.ErrorCode != cloudstack.ErrorCodeParam {
+		t.Fatalf("expected a *CSError, got %v", err)
+	}
+	if zones != nil {
+		t.Errorf("expected no zones, got %d", len(zones))
+	}
+}
@@ -0,0 +1,100 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestParamsJSONRoundTrip(t *testing.T) {
+	client := cloudstack.NewClient("http://localhost", "APIKEY", "SECRETKEY", true)
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	p.SetName("vm-1")
+	p.SetNetworkids([]string{"net-1", "net-2"})
+	p.SetDetails(map[string]string{"cpuNumber": "2", "memory": "2048"})
+	p.SetRootdisksize(20)
+	p.SetStartvm(false)
+
+	b, err := json.Marshal(p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var q cloudstack.DeployVirtualMachineParams
+	if err := json.Unmarshal(b, &q); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(p.URLValues(), q.URLValues()) {
+		t.Errorf("expected %v after a round trip, got %v", p.URLValues(), q.URLValues())
+	}
+	if v, ok := q.GetRootdisksize(); !ok || v != 20 {
+		t.Errorf("expected rootdisksize 20, got %v", v)
+	}
+	if got := q.URLValues().Get("details[0].cpuNumber"); got != "2" {
+		t.Errorf("expected details to be encoded, got %q", got)
+	}
+
+	if err := json.Unmarshal([]byte(`{"bogus":"x"}`), &q); err == nil || !strings.Contains(err.Error(), "bogus") {
+		t.Errorf("expected an error for an unknown parameter, got %v", err)
+	}
+	if err := json.Unmarshal([]byte(`{"rootdisksize":"big"}`), &q); err == nil {
+		t.Errorf("expected an error for a value of the wrong type")
+	}
+
+	var empty cloudstack.ListZonesParams
+	if b, err := json.Marshal(&empty); err != nil || string(b) != "{}" {
+		t.Errorf("expected empty params to encode as {}, got %s (%v)", b, err)
+	}
+}
+
+func TestParamsClone(t *testing.T) {
+	client := cloudstack.NewClient("http://localhost", "APIKEY", "SECRETKEY", true)
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	p.SetNetworkids([]string{"net-1"})
+	p.SetDetails(map[string]string{"memory": "2048"})
+
+	c := p.Clone()
+	if !reflect.DeepEqual(p.URLValues(), c.URLValues()) {
+		t.Fatalf("expected the clone to encode as %v, got %v", p.URLValues(), c.URLValues())
+	}
+
+	c.SetName("vm-2")
+	ids, _ := c.GetNetworkids()
+	ids[0] = "net-2"
+	details, _ := c.GetDetails()
+	details["memory"] = "4096"
+
+	if _, ok := p.GetName(); ok {
+		t.Errorf("expected the original not to have a name")
+	}
+	if got := p.URLValues().Get("networkids"); got != "net-1" {
+		t.Errorf("expected the original networkids to be unchanged, got %q", got)
+	}
+	if got := p.URLValues().Get("details[0].memory"); got != "2048" {
+		t.Errorf("expected the original details to be unchanged, got %q", got)
+	}
+}
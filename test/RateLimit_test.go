@@ -0,0 +1,180 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestRateLimiterFailFast(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithRateLimiter(cloudstack.RateLimitConfig{
+		Limit:    2,
+		Interval: 200 * time.Millisecond,
+		FailFast: true,
+	}))
+
+	for i := 0; i < 2; i++ {
+		if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != cloudstack.ErrRateLimited {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if calls("listZones") != 2 {
+		t.Errorf("expected 2 calls to reach the server, got %d", calls("listZones"))
+	}
+
+	if b := client.RateLimiter().Budget(); b.Limit != 2 || b.Remaining != 0 {
+		t.Errorf("unexpected budget: %+v", b)
+	}
+	time.Sleep(120 * time.Millisecond)
+	if b := client.RateLimiter().Budget(); b.Remaining != 1 {
+		t.Errorf("expected the budget to be refilled, got %+v", b)
+	}
+}
+
+func TestRateLimiterBlocks(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithRateLimiter(cloudstack.RateLimitConfig{
+		Limit:    5,
+		Interval: 100 * time.Millisecond,
+	}))
+
+	start := time.Now()
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		}()
+	}
+	wg.Wait()
+
+	if elapsed := time.Since(start); elapsed < 90*time.Millisecond {
+		t.Errorf("expected 10 calls at 5 calls per 100ms to take at least 100ms, took %s", elapsed)
+	}
+	if calls("listZones") != 10 {
+		t.Errorf("expected 10 calls, got %d", calls("listZones"))
+	}
+}
+
+func TestRateLimiterCalibratesFromAPILimit(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+		"getApiLimit": {
+			respond(http.StatusOK, `{"getapilimitresponse":{"apilimit":{"apiAllowed":2,"apiIssued":1,"expireAfter":500}}}`),
+		},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithRateLimiter(cloudstack.RateLimitConfig{
+		Interval: time.Hour,
+		FailFast: true,
+	}))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b := client.RateLimiter().Budget(); b.Limit != 3 || b.Remaining != 1 {
+		t.Errorf("unexpected budget: %+v", b)
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != cloudstack.ErrRateLimited {
+		t.Fatalf("expected ErrRateLimited, got %v", err)
+	}
+	if calls("getApiLimit") != 1 {
+		t.Errorf("expected the limiter to calibrate once, got %d calls", calls("getApiLimit"))
+	}
+}
+
+func TestRateLimiterWithoutAPILimit(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones":   {respond(http.StatusOK, zonesResponse)},
+		"getApiLimit": {respond(432, `{"getapilimitresponse":{"errorcode":432,"errortext":"The given command does not exist"}}`)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithRateLimiter(cloudstack.RateLimitConfig{FailFast: true}))
+
+	for i := 0; i < 5; i++ {
+		if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+	}
+	if b := client.RateLimiter().Budget(); b.Limit != 0 || calls("listZones") != 5 {
+		t.Errorf("expected the calls not to be limited, got %+v", b)
+	}
+}
+
+func TestRateLimiterRetriesCalibration(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusOK, zonesResponse)},
+		"getApiLimit": {
+			respond(http.StatusInternalServerError, `{"getapilimitresponse":{"errorcode":530,"errortext":"internal error"}}`),
+			respond(http.StatusOK, `{"getapilimitresponse":{"apilimit":{"apiAllowed":2,"apiIssued":1,"expireAfter":500}}}`),
+		},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithRateLimiter(cloudstack.RateLimitConfig{
+		Interval: time.Hour,
+		FailFast: true,
+	}))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b := client.RateLimiter().Budget(); b.Limit != 0 {
+		t.Errorf("expected the calls not to be limited after a failed calibration, got %+v", b)
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if b := client.RateLimiter().Budget(); b.Limit != 3 || b.Remaining != 1 {
+		t.Errorf("unexpected budget: %+v", b)
+	}
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls("getApiLimit") != 2 {
+		t.Errorf("expected the limiter to calibrate twice, got %d calls", calls("getApiLimit"))
+	}
+}
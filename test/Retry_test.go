@@ -0,0 +1,246 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func dropConnection(w http.ResponseWriter, r *http.Request) {
+	conn, _, _ := w.(http.Hijacker).Hijack()
+	conn.Close()
+}
+
+// IDs of the resources used as parameters, the client rejects IDs that are not UUIDs
+const (
+	diskOfferingID    = "5e4f3a2b-0000-4000-8000-000000000001"
+	networkID         = "5e4f3a2b-0000-4000-8000-000000000002"
+	projectID         = "5e4f3a2b-0000-4000-8000-000000000006"
+	serviceOfferingID = "5e4f3a2b-0000-4000-8000-000000000003"
+	templateID        = "5e4f3a2b-0000-4000-8000-000000000004"
+	zoneID            = "5e4f3a2b-0000-4000-8000-000000000005"
+)
+
+const zonesResponse = `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-1","name":"zone"}]}}`
+
+var fastRetries = cloudstack.WithRetryPolicy(cloudstack.RetryPolicy{
+	MaxAttempts: 3,
+	Backoff:     cloudstack.FixedPoll(time.Millisecond),
+})
+
+func TestRetryTransientErrors(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {
+			respond(http.StatusServiceUnavailable, "<html>Service Unavailable</html>"),
+			dropConnection,
+			respond(http.StatusOK, zonesResponse),
+		},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, fastRetries)
+
+	r, err := client.Zone.ListZones(client.Zone.NewListZonesParams())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.Count != 1 || calls("listZones") != 3 {
+		t.Errorf("expected a zone after 3 attempts, got %d zones after %d attempts", r.Count, calls("listZones"))
+	}
+}
+
+func TestRetryGivesUpAfterMaxAttempts(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(http.StatusBadGateway, "<html>Bad Gateway</html>")},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, fastRetries)
+
+	_, err := client.Zone.ListZones(client.Zone.NewListZonesParams())
+
+	var e *cloudstack.CSError
+	if !errors.As(err, &e) || e.HTTPStatus != http.StatusBadGateway {
+		t.Fatalf("expected a *CSError, got %v", err)
+	}
+	if calls("listZones") != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls("listZones"))
+	}
+}
+
+func TestRetrySkipsPermanentErrors(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {respond(431, `{"listzonesresponse":{"errorcode":431,"errortext":"invalid parameter"}}`)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, fastRetries)
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls("listZones") != 1 {
+		t.Errorf("expected 1 attempt, got %d", calls("listZones"))
+	}
+}
+
+func TestRetryMutatingCommandsOnlyWhenAllowed(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {
+			respond(http.StatusServiceUnavailable, "<html>Service Unavailable</html>"),
+			respond(http.StatusServiceUnavailable, "<html>Service Unavailable</html>"),
+			respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`),
+		},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, fastRetries)
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls("deployVirtualMachine") != 1 {
+		t.Fatalf("expected 1 attempt, got %d", calls("deployVirtualMachine"))
+	}
+
+	r, err := client.VirtualMachine.DeployVirtualMachineWithContext(cloudstack.WithRetryMutating(context.Background()), p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if r.JobID != "d3c2b1a0-0000-4000-8000-000000000010" || calls("deployVirtualMachine") != 3 {
+		t.Errorf("expected the deploy to be retried, got %+v after %d attempts", r, calls("deployVirtualMachine"))
+	}
+}
+
+func TestRetrySkipsAsyncCommands(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"getDiagnosticsData": {respond(http.StatusServiceUnavailable, "<html>Service Unavailable</html>")},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, fastRetries)
+
+	p := client.Diagnostics.NewGetDiagnosticsDataParams("5e4f3a2b-0000-4000-8000-000000000007")
+	if _, err := client.Diagnostics.GetDiagnosticsData(p); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls("getDiagnosticsData") != 1 {
+		t.Errorf("expected the async command not to be retried, got %d attempts", calls("getDiagnosticsData"))
+	}
+}
+
+func TestRetrySignsEveryAttempt(t *testing.T) {
+	var expires []string
+	record := func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			expires = append(expires, r.FormValue("expires"))
+			next(w, r)
+		}
+	}
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {
+			record(respond(http.StatusServiceUnavailable, "<html>Service Unavailable</html>")),
+			record(respond(http.StatusOK, zonesResponse)),
+		},
+	})
+	defer server.Close()
+
+	// The expiry of a signature has a resolution of one second
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithRetryPolicy(cloudstack.RetryPolicy{
+		MaxAttempts: 2,
+		Backoff:     cloudstack.FixedPoll(1100 * time.Millisecond),
+	}))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(expires) != 2 || expires[0] == expires[1] {
+		t.Errorf("expected the retry to be signed again, got expiries %v", expires)
+	}
+}
+
+func TestRetryDoesNotStackWithJobQueries(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"queryAsyncJobResult": {respond(http.StatusServiceUnavailable, "<html>Service Unavailable</html>")},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, fastRetries)
+
+	p := client.Asyncjob.NewQueryAsyncJobResultParams("d3c2b1a0-0000-4000-8000-000000000010")
+	if _, err := client.Asyncjob.QueryAsyncJobResult(p); err == nil {
+		t.Fatal("expected an error")
+	}
+	if calls("queryAsyncJobResult") != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls("queryAsyncJobResult"))
+	}
+}
+
+func TestRetryWaitsForAPILimitReset(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {
+			respond(429, `{"listzonesresponse":{"errorcode":429,"errortext":"The given command does not exist or it is not available for user"}}`),
+			respond(http.StatusOK, zonesResponse),
+		},
+		"getApiLimit": {
+			respond(http.StatusOK, `{"getapilimitresponse":{"apilimit":{"apiAllowed":0,"apiIssued":10,"expireAfter":50}}}`),
+		},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, fastRetries)
+
+	start := time.Now()
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 50*time.Millisecond {
+		t.Errorf("expected to wait for the API limit to reset, waited %s", elapsed)
+	}
+	if calls("getApiLimit") != 1 || calls("listZones") != 2 {
+		t.Errorf("unexpected calls: %d getApiLimit, %d listZones", calls("getApiLimit"), calls("listZones"))
+	}
+}
+
+func TestCSErrorRetryAfter(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listZones": {func(w http.ResponseWriter, r *http.Request) {
+			w.Header().Set("Retry-After", "120")
+			respond(http.StatusTooManyRequests, `{"listzonesresponse":{"errorcode":429,"errortext":"too many requests"}}`)(w, r)
+		}},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	_, err := client.Zone.ListZones(client.Zone.NewListZonesParams())
+
+	var e *cloudstack.CSError
+	if !errors.As(err, &e) || e.RetryAfter != 2*time.Minute {
+		t.Fatalf("expected a *CSError with a RetryAfter of 2 minutes, got %v", err)
+	}
+}
@@ -0,0 +1,208 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"net/http"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestValidateAgainstSchema(t *testing.T) {
+	schema := cloudstack.NewAPISchema([]*cloudstack.Api{
+		{Name: "listZones", Params: []cloudstack.ApiParams{{Name: "id", Type: "uuid"}, {Name: "name", Type: "string"}}},
+		{Name: "createTags", Params: []cloudstack.ApiParams{
+			{Name: "resourceids", Type: "list", Required: true},
+			{Name: "resourcetype", Type: "string", Required: true},
+			{Name: "tags", Type: "map", Required: true},
+		}},
+	})
+
+	client := cloudstack.NewClient("http://localhost", "APIKEY", "SECRETKEY", true)
+
+	p := client.Zone.NewListZonesParams()
+	p.SetName("zone")
+	p.SetId(strings.ToUpper(zoneID))
+	if err := p.ValidateAgainst(schema); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	p.SetId("not-a-uuid")
+	p.SetKeyword("zone")
+	var e *cloudstack.ParamsError
+	if err := p.ValidateAgainst(schema); !errors.As(err, &e) {
+		t.Fatalf("expected a *ParamsError, got %v", err)
+	}
+	if !reflect.DeepEqual(e.Unknown, []string{"keyword"}) || !reflect.DeepEqual(e.Invalid, []string{"id: not a UUID"}) || len(e.Missing) != 0 {
+		t.Errorf("unexpected error: %+v", e)
+	}
+
+	tags := client.Resourcetags.NewCreateTagsParams([]string{"vm-1"}, "UserVm", map[string]string{"a": "b", "c": "d"})
+	if err := tags.ValidateAgainst(schema); err != nil {
+		t.Errorf("unexpected error for map and list parameters: %v", err)
+	}
+	tags.ResetResourcetype()
+	if err := tags.ValidateAgainst(schema); !errors.As(err, &e) || !reflect.DeepEqual(e.Missing, []string{"resourcetype"}) {
+		t.Errorf("expected resourcetype to be missing, got %v", err)
+	}
+
+	d := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	if err := d.ValidateAgainst(schema); !errors.Is(err, cloudstack.ErrAPIUnavailable) {
+		t.Errorf("expected ErrAPIUnavailable, got %v", err)
+	}
+}
+
+func TestParamValidationModes(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":         {respond(http.StatusOK, apisResponse)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"listZones":        {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	strict := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithParamValidation(cloudstack.ValidationStrict))
+	p := strict.Zone.NewListZonesParams()
+	p.SetKeyword("zone")
+	var e *cloudstack.ParamsError
+	if _, err := strict.Zone.ListZones(p); !errors.As(err, &e) || e.Command != "listZones" {
+		t.Fatalf("expected a *ParamsError, got %v", err)
+	}
+	if calls("listZones") != 0 {
+		t.Errorf("expected the call not to be made in strict mode")
+	}
+
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewTextHandler(&buf, nil))
+	warn := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithLogger(logger), cloudstack.WithParamValidation(cloudstack.ValidationWarn))
+	if _, err := warn.Zone.ListZones(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if calls("listZones") != 1 || !strings.Contains(buf.String(), "unknown parameters keyword") {
+		t.Errorf("expected the call to be made with a warning, got %d calls and log %q", calls("listZones"), buf.String())
+	}
+}
+
+func TestValidateParams(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"createZone": {respond(http.StatusOK, `{"createzoneresponse":{"zone":{"id":"zone-1"}}}`)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+
+	p := &cloudstack.CreateZoneParams{}
+	p.SetName("zone")
+	var e *cloudstack.ParamsError
+	if err := p.Validate(); !errors.As(err, &e) {
+		t.Fatalf("expected a *ParamsError, got %v", err)
+	}
+	if e.Command != "createZone" || !reflect.DeepEqual(e.Missing, []string{"dns1", "internaldns1", "networktype"}) {
+		t.Errorf("unexpected error: %+v", e)
+	}
+
+	if _, err := client.Zone.CreateZone(p); !errors.As(err, &e) {
+		t.Errorf("expected a *ParamsError, got %v", err)
+	}
+	if calls("createZone") != 0 {
+		t.Errorf("expected invalid params not to be sent")
+	}
+
+	p = client.Zone.NewCreateZoneParams("8.8.8.8", "10.0.0.1", "zone", "Advanced")
+	if err := p.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if _, err := client.Zone.CreateZone(p); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if calls("createZone") != 1 {
+		t.Errorf("expected valid params to be sent")
+	}
+}
+
+func TestValidateParamValues(t *testing.T) {
+	client := cloudstack.NewClient("http://localhost", "APIKEY", "SECRETKEY", true)
+
+	p := client.Zone.NewCreateZoneParams("8.8.8.8", "10.0.0.1", strings.Repeat("z", 256), "Advanced")
+	p.SetDomainid("root")
+	var e *cloudstack.ParamsError
+	if err := p.Validate(); !errors.As(err, &e) {
+		t.Fatalf("expected a *ParamsError, got %v", err)
+	}
+	want := []string{"name: longer than 255 characters"}
+	if !reflect.DeepEqual(e.Invalid, want) {
+		t.Errorf("expected %v, got %v", want, e.Invalid)
+	}
+
+	p.SetName(strings.Repeat("z", 255))
+	if err := p.Validate(); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestGetByIDWithInvalidID(t *testing.T) {
+	server, calls := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listApis":         {respond(http.StatusOK, apisResponse)},
+		"listCapabilities": {respond(http.StatusOK, capabilitiesResponse)},
+		"listZones": {respond(431, `{"listzonesresponse":{"errorcode":431,"errortext":`+
+			`"Invalid parameter id value=zone-1 due to incorrect long value format, or entity does not exist"}}`)},
+	})
+	defer server.Close()
+
+	for name, opts := range map[string][]cloudstack.ClientOption{
+		"server":   nil,
+		"validate": {cloudstack.WithParamValidation(cloudstack.ValidationStrict)},
+	} {
+		client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, opts...)
+		_, count, err := client.Zone.GetZoneByID("zone-1")
+		if count != 0 || err == nil || !strings.HasPrefix(err.Error(), "No match found for zone-1") {
+			t.Errorf("%s: expected no match, got %d, %v", name, count, err)
+		}
+	}
+	if calls("listZones") != 1 {
+		t.Errorf("expected only the unvalidated lookup to be sent, got %d calls", calls("listZones"))
+	}
+}
+
+func TestValidateAgainstSchemaLength(t *testing.T) {
+	schema := cloudstack.NewAPISchema([]*cloudstack.Api{
+		{Name: "listZones", Params: []cloudstack.ApiParams{{Name: "name", Type: "string", Length: 4}}},
+	})
+
+	client := cloudstack.NewClient("http://localhost", "APIKEY", "SECRETKEY", true)
+
+	p := client.Zone.NewListZonesParams()
+	p.SetName("zone")
+	if err := p.ValidateAgainst(schema); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	p.SetName("zone-1")
+	var e *cloudstack.ParamsError
+	if err := p.ValidateAgainst(schema); !errors.As(err, &e) || !reflect.DeepEqual(e.Invalid, []string{"name: longer than 4 characters"}) {
+		t.Errorf("expected name to be too long, got %v", err)
+	}
+}
@@ -0,0 +1,178 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+type sessionServer struct {
+	mu      sync.Mutex
+	logins  int
+	logouts int
+	current string
+}
+
+// newSessionServer returns a server that only accepts unsigned requests made with the session key of the last
+// login, and the state of the sessions
+func newSessionServer(t *testing.T) (*httptest.Server, *sessionServer) {
+	s := &sessionServer{}
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"login":            {s.unsigned(s.login)},
+		"logout":           {s.authenticated(s.logout)},
+		"listZones":        {s.authenticated(respond(http.StatusOK, `{"listzonesresponse":{"count":1,"zone":[{"id":"zone-1","name":"zone"}]}}`))},
+		"listApis":         {s.authenticated(respond(http.StatusOK, apisResponse))},
+		"listCapabilities": {s.authenticated(respond(http.StatusOK, capabilitiesResponse))},
+		"getApiLimit":      {s.authenticated(respond(http.StatusOK, `{"getapilimitresponse":{"apilimit":{"apiAllowed":100,"apiIssued":0,"expireAfter":1000}}}`))},
+	})
+	return server, s
+}
+
+// unsigned only passes requests without an API key or signature to h, holding the lock of the server
+func (s *sessionServer) unsigned(h http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+
+		if r.FormValue("apiKey") != "" || r.FormValue("signature") != "" {
+			respond(http.StatusBadRequest, `{"errorresponse":{"errorcode":400,"errortext":"unexpected signature in session request"}}`)(w, r)
+			return
+		}
+		h(w, r)
+	}
+}
+
+// authenticated only passes unsigned requests made with the current session key to h
+func (s *sessionServer) authenticated(h http.HandlerFunc) http.HandlerFunc {
+	return s.unsigned(func(w http.ResponseWriter, r *http.Request) {
+		cookie, err := r.Cookie("JSESSIONID")
+		if err != nil || cookie.Value != s.current || r.FormValue("sessionkey") != s.current {
+			respond(http.StatusUnauthorized, fmt.Sprintf(`{"%sresponse":{"errorcode":401,"errortext":"unable to verify user credentials"}}`, r.FormValue("command")))(w, r)
+			return
+		}
+		h(w, r)
+	})
+}
+
+func (s *sessionServer) login(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodPost || r.FormValue("username") != "ldapuser" || r.FormValue("password") != "secret" || r.FormValue("domain") != "/sub" {
+		respond(http.StatusUnauthorized, `{"loginresponse":{"errorcode":401,"errortext":"failed to authenticate user"}}`)(w, r)
+		return
+	}
+	s.logins++
+	s.current = fmt.Sprintf("key-%d", s.logins)
+	http.SetCookie(w, &http.Cookie{Name: "JSESSIONID", Value: s.current, Path: "/"})
+	fmt.Fprintf(w, `{"loginresponse":{"timeout":"1800","username":"ldapuser","sessionkey":%q}}`, s.current)
+}
+
+func (s *sessionServer) logout(w http.ResponseWriter, r *http.Request) {
+	s.logouts++
+	s.current = ""
+	fmt.Fprint(w, `{"logoutresponse":{"description":"success"}}`)
+}
+
+func (s *sessionServer) expire() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.current = "expired"
+}
+
+func TestSessionClient(t *testing.T) {
+	server, ss := newSessionServer(t)
+	defer server.Close()
+
+	client, err := cloudstack.NewSessionClient(server.URL, "ldapuser", "secret", "/sub", true)
+	if err != nil {
+		t.Fatalf("failed to create session client: %v", err)
+	}
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("listZones with a session key failed: %v", err)
+	}
+	if ss.logins != 1 {
+		t.Fatalf("expected a single login, got %d", ss.logins)
+	}
+
+	ss.expire()
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("listZones after the session expired failed: %v", err)
+	}
+	if ss.logins != 2 {
+		t.Fatalf("expected the client to log in again after the session expired, got %d logins", ss.logins)
+	}
+
+	if err := client.Close(); err != nil {
+		t.Fatalf("failed to close the session: %v", err)
+	}
+	if ss.logouts != 1 {
+		t.Fatalf("expected Close to log out once, got %d logouts", ss.logouts)
+	}
+}
+
+func TestSessionClientInvalidCredentials(t *testing.T) {
+	server, _ := newSessionServer(t)
+	defer server.Close()
+
+	if _, err := cloudstack.NewSessionClient(server.URL, "ldapuser", "wrong", "/sub", true); err == nil {
+		t.Fatal("expected an error when logging in with invalid credentials")
+	}
+}
+
+func TestSessionClientWithDiscoveryAndRateLimiter(t *testing.T) {
+	tests := map[string]cloudstack.ClientOption{
+		"discovery":   cloudstack.WithAPIDiscovery(),
+		"validation":  cloudstack.WithParamValidation(cloudstack.ValidationStrict),
+		"ratelimiter": cloudstack.WithRateLimiter(cloudstack.RateLimitConfig{}),
+	}
+	for name, option := range tests {
+		t.Run(name, func(t *testing.T) {
+			server, ss := newSessionServer(t)
+			defer server.Close()
+
+			done := make(chan error, 1)
+			go func() {
+				client, err := cloudstack.NewSessionClient(server.URL, "ldapuser", "secret", "/sub", true, option)
+				if err == nil {
+					_, err = client.Zone.ListZones(client.Zone.NewListZonesParams())
+				}
+				done <- err
+			}()
+
+			select {
+			case err := <-done:
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			case <-time.After(5 * time.Second):
+				t.Fatalf("the session client deadlocked")
+			}
+			if ss.logins != 1 {
+				t.Errorf("expected a single login, got %d", ss.logins)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+func TestTimeUnmarshal(t *testing.T) {
+	want := time.Date(2021, 10, 13, 4, 36, 30, 0, time.UTC)
+
+	tests := map[string]string{
+		"cloudstack": `"2021-10-13T04:36:30+0000"`,
+		"offset":     `"2021-10-13T06:36:30+0200"`,
+		"rfc3339":    `"2021-10-13T04:36:30Z"`,
+		"fraction":   `"2021-10-13T04:36:30.000+0000"`,
+		"space":      `"2021-10-13 04:36:30"`,
+		"millis":     `1634099790000`,
+	}
+	for name, in := range tests {
+		var got cloudstack.Time
+		if err := json.Unmarshal([]byte(in), &got); err != nil {
+			t.Errorf("%s: unexpected error: %v", name, err)
+			continue
+		}
+		if !got.Equal(want) {
+			t.Errorf("%s: expected %v, got %v", name, want, got)
+		}
+	}
+
+	for _, in := range []string{`""`, `null`} {
+		got := cloudstack.Time{Time: want}
+		if err := json.Unmarshal([]byte(in), &got); err != nil || !got.IsZero() {
+			t.Errorf("expected %s to decode as the zero time, got %v (%v)", in, got, err)
+		}
+	}
+
+	var got cloudstack.Time
+	if err := json.Unmarshal([]byte(`"yesterday"`), &got); err == nil {
+		t.Errorf("expected an error for an invalid date")
+	}
+}
+
+func TestTimeRoundTrip(t *testing.T) {
+	in := cloudstack.Time{Time: time.Date(2021, 10, 13, 6, 36, 30, 0, time.FixedZone("", 2*60*60))}
+	b, err := json.Marshal(in)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(b) != `"2021-10-13T06:36:30+0200"` {
+		t.Errorf("unexpected encoding %s", b)
+	}
+
+	var out cloudstack.Time
+	if err := json.Unmarshal(b, &out); err != nil || !out.Equal(in.Time) {
+		t.Errorf("expected %v after a round trip, got %v (%v)", in, out, err)
+	}
+
+	if b, err := json.Marshal(cloudstack.Time{}); err != nil || string(b) != `""` {
+		t.Errorf("expected the zero time to encode as an empty string, got %s (%v)", b, err)
+	}
+}
+
+func TestTimeResponseFields(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"listEvents": {respond(http.StatusOK, `{"listeventsresponse":{"count":2,"event":[
+			{"id":"event-1","created":"2021-10-13T04:36:30+0000"},
+			{"id":"event-2","created":""}
+		]}}`)},
+	})
+	defer server.Close()
+
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true)
+	r, err := client.Event.ListEvents(client.Event.NewListEventsParams())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if want := time.Date(2021, 10, 13, 4, 36, 30, 0, time.UTC); !r.Events[0].Created.Equal(want) {
+		t.Errorf("expected %v, got %v", want, r.Events[0].Created)
+	}
+	if !r.Events[1].Created.IsZero() {
+		t.Errorf("expected an empty date to be zero, got %v", r.Events[1].Created)
+	}
+}
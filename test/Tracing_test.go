@@ -0,0 +1,234 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+type recordedSpan struct {
+	command string
+	parent  *recordedSpan
+	attrs   map[string]string
+	ended   bool
+	err     error
+}
+
+func (s *recordedSpan) SetAttribute(key, value string) { s.attrs[key] = value }
+func (s *recordedSpan) End(err error)                  { s.ended, s.err = true, err }
+
+type spanKey struct{}
+
+type recordingTracer struct {
+	mu    sync.Mutex
+	spans []*recordedSpan
+}
+
+func (t *recordingTracer) Start(ctx context.Context, command string, attrs map[string]string) (context.Context, cloudstack.Span) {
+	parent, _ := ctx.Value(spanKey{}).(*recordedSpan)
+	s := &recordedSpan{command: command, parent: parent, attrs: attrs}
+
+	t.mu.Lock()
+	t.spans = append(t.spans, s)
+	t.mu.Unlock()
+
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func TestTracerSpansAsyncCommands(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)},
+		"queryAsyncJobResult": {
+			respond(http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":0}}`),
+			respond(http.StatusOK, `{"queryasyncjobresultresponse":{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":2,`+
+				`"jobresult":{"errorcode":530,"errortext":"no capacity"}}}`),
+		},
+	})
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithTracer(tracer), cloudstack.WithPollStrategy(cloudstack.FixedPoll(0)))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	p.SetProjectid(projectID)
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err == nil {
+		t.Fatal("expected the job to fail")
+	}
+
+	if len(tracer.spans) != 3 {
+		t.Fatalf("expected 3 spans, got %d", len(tracer.spans))
+	}
+
+	call := tracer.spans[0]
+	if call.command != "deployVirtualMachine" || call.parent != nil || !call.ended || call.err == nil {
+		t.Errorf("unexpected call span: %+v", call)
+	}
+	want := map[string]string{
+		cloudstack.AttrCommand:   "deployVirtualMachine",
+		cloudstack.AttrZoneID:    zoneID,
+		cloudstack.AttrProjectID: projectID,
+		cloudstack.AttrJobID:     "d3c2b1a0-0000-4000-8000-000000000010",
+		cloudstack.AttrJobStatus: "failed",
+	}
+	for k, v := range want {
+		if call.attrs[k] != v {
+			t.Errorf("expected attribute %s to be %q, got %q", k, v, call.attrs[k])
+		}
+	}
+
+	for _, poll := range tracer.spans[1:] {
+		if poll.command != "queryAsyncJobResult" || poll.parent != call || !poll.ended || poll.err != nil {
+			t.Errorf("unexpected poll span: %+v", poll)
+		}
+	}
+}
+
+func TestTracerSpansWithoutWaiting(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)},
+		"listZones":            {respond(http.StatusOK, zonesResponse)},
+	})
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithTracer(tracer))
+
+	if _, err := client.Zone.ListZones(client.Zone.NewListZonesParams()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(tracer.spans) != 2 {
+		t.Fatalf("expected 2 spans, got %d", len(tracer.spans))
+	}
+	for _, s := range tracer.spans {
+		if !s.ended || s.err != nil {
+			t.Errorf("expected span %s to be ended without error", s.command)
+		}
+	}
+	if tracer.spans[1].attrs[cloudstack.AttrJobID] != "d3c2b1a0-0000-4000-8000-000000000010" || tracer.spans[1].attrs[cloudstack.AttrJobStatus] != "" {
+		t.Errorf("unexpected attributes: %v", tracer.spans[1].attrs)
+	}
+}
+
+func TestTracerEndsSpanOfUndecodableAsyncResponse(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":{},"jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)},
+	})
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true, cloudstack.WithTracer(tracer))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err == nil {
+		t.Fatal("expected a decode error")
+	}
+
+	if len(tracer.spans) != 1 || !tracer.spans[0].ended {
+		t.Fatalf("expected the span of the call to be ended, got %+v", tracer.spans)
+	}
+}
+
+func TestTracerSpansPollsOfJobWatcher(t *testing.T) {
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"deployVirtualMachine": {respond(http.StatusOK, `{"deployvirtualmachineresponse":{"id":"vm-1","jobid":"d3c2b1a0-0000-4000-8000-000000000010"}}`)},
+		"listAsyncJobs": {respond(http.StatusOK, `{"listasyncjobsresponse":{"count":1,"asyncjobs":[`+
+			`{"jobid":"d3c2b1a0-0000-4000-8000-000000000010","jobstatus":1,"jobresult":{"virtualmachine":{"id":"vm-1"}}}]}}`)},
+	})
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := cloudstack.NewAsyncClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithTracer(tracer), cloudstack.WithJobWatcher(10*time.Millisecond))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	if _, err := client.VirtualMachine.DeployVirtualMachine(p); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	tracer.mu.Lock()
+	defer tracer.mu.Unlock()
+
+	call := tracer.spans[0]
+	if call.command != "deployVirtualMachine" || !call.ended || call.attrs[cloudstack.AttrJobStatus] != "succeeded" {
+		t.Errorf("unexpected call span: %+v", call)
+	}
+
+	var polls int
+	for _, s := range tracer.spans[1:] {
+		if s.parent != call {
+			continue
+		}
+		polls++
+		if s.command != "listAsyncJobs" || !s.ended || s.attrs[cloudstack.AttrJobID] != "d3c2b1a0-0000-4000-8000-000000000010" ||
+			s.attrs[cloudstack.AttrJobStatus] != "succeeded" {
+			t.Errorf("unexpected poll span: %+v", s)
+		}
+	}
+	if polls != 1 {
+		t.Errorf("expected 1 poll span, got %d", polls)
+	}
+}
+
+func TestTracerSpansWaitsForJobHandles(t *testing.T) {
+	const jobID = "d3c2b1a0-0000-4000-8000-000000000009"
+
+	server, _ := newJobServer(t, jobID, 1)
+	defer server.Close()
+
+	tracer := &recordingTracer{}
+	client := cloudstack.NewClient(server.URL, "APIKEY", "SECRETKEY", true,
+		cloudstack.WithTracer(tracer), cloudstack.WithPollStrategy(cloudstack.FixedPoll(0)))
+
+	p := client.VirtualMachine.NewDeployVirtualMachineParams(serviceOfferingID, templateID, zoneID)
+	job, err := client.VirtualMachine.DeployVirtualMachineAsync(context.Background(), p)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if err := job.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// The call, the wait for the job and its two polls
+	if len(tracer.spans) != 4 {
+		t.Fatalf("expected 4 spans, got %d", len(tracer.spans))
+	}
+	wait := tracer.spans[1]
+	if wait.command != "deployVirtualMachine" || wait.parent != nil || !wait.ended || wait.err != nil ||
+		wait.attrs[cloudstack.AttrJobID] != jobID || wait.attrs[cloudstack.AttrJobStatus] != "succeeded" {
+		t.Errorf("unexpected job span: %+v", wait)
+	}
+	for _, poll := range tracer.spans[2:] {
+		if poll.command != "queryAsyncJobResult" || poll.parent != wait || !poll.ended {
+			t.Errorf("unexpected poll span: %+v", poll)
+		}
+	}
+}
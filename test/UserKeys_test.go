@@ -0,0 +1,159 @@
+//
+// Licensed to the Apache Software Foundation (ASF) under one
+// or more contributor license agreements.  See the NOTICE file
+// distributed with this work for additional information
+// regarding copyright ownership.  The ASF licenses this file
+// to you under the Apache License, Version 2.0 (the
+// "License"); you may not use this file except in compliance
+// with the License.  You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing,
+// software distributed under the License is distributed on an
+// "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY
+// KIND, either express or implied.  See the License for the
+// specific language governing permissions and limitations
+// under the License.
+//
+
+package test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+
+	"github.com/apache/cloudstack-go/v2/cloudstack"
+)
+
+// The IDs of the users known to newUserKeysServer, by name
+var userIDs = map[string]string{
+	"admin": "a0b1c2d3-0000-4000-8000-000000000001",
+	"other": "a0b1c2d3-0000-4000-8000-000000000002",
+	"self":  "a0b1c2d3-0000-4000-8000-000000000003",
+}
+
+// newUserKeysServer returns a server that keeps the API keys of users and only accepts requests signed with
+// the current key of one of them
+func newUserKeysServer(t *testing.T, keys map[string]string) *httptest.Server {
+	var mu sync.Mutex
+	generation := 0
+
+	// signed only passes requests signed with a current key to h, with the name of the user they are about
+	signed := func(h func(w http.ResponseWriter, userid string)) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			valid := false
+			for _, key := range keys {
+				valid = valid || r.FormValue("apiKey") == key
+			}
+			if !valid {
+				respond(http.StatusUnauthorized, `{"errorresponse":{"errorcode":401,"errortext":"unable to verify user credentials"}}`)(w, r)
+				return
+			}
+
+			var userid string
+			for name, id := range userIDs {
+				if r.FormValue("id") == id {
+					userid = name
+				}
+			}
+			h(w, userid)
+		}
+	}
+
+	server, _ := CreateTestServerWithHandlers(t, map[string][]http.HandlerFunc{
+		"getUserKeys": {signed(func(w http.ResponseWriter, userid string) {
+			fmt.Fprintf(w, `{"getuserkeysresponse":{"userkeys":{"apikey":%q,"secretkey":"SECRET-%s"}}}`, keys[userid], keys[userid])
+		})},
+		"registerUserKeys": {signed(func(w http.ResponseWriter, userid string) {
+			generation++
+			keys[userid] = fmt.Sprintf("KEY-%s-%d", userid, generation)
+			fmt.Fprintf(w, `{"registeruserkeysresponse":{"userkeys":{"apikey":%q,"secretkey":"SECRET-%s"}}}`, keys[userid], keys[userid])
+		})},
+		"listCapabilities": {signed(func(w http.ResponseWriter, userid string) {
+			fmt.Fprint(w, `{"listcapabilitiesresponse":{"capability":{"cloudstackversion":"4.20.0"}}}`)
+		})},
+	})
+	t.Cleanup(server.Close)
+	return server
+}
+
+func TestRotateUserKeysSwapsOwnKeys(t *testing.T) {
+	server := newUserKeysServer(t, map[string]string{"self": "KEY-self-0", "other": "KEY-other-0"})
+	client := cloudstack.NewClient(server.URL, "KEY-self-0", "SECRET-KEY-self-0", true)
+
+	keys, err := client.User.RotateUserKeys(userIDs["self"])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := cloudstack.RotatedUserKeys{
+		UserID:  userIDs["self"],
+		Old:     cloudstack.Credentials{APIKey: "KEY-self-0", SecretKey: "SECRET-KEY-self-0"},
+		New:     cloudstack.Credentials{APIKey: "KEY-self-1", SecretKey: "SECRET-KEY-self-1"},
+		Swapped: true,
+	}
+	if *keys != want {
+		t.Errorf("expected %+v, got %+v", want, *keys)
+	}
+
+	// The client must keep working with the new keys
+	if _, err := client.User.GetUserKeys(client.User.NewGetUserKeysParams(userIDs["other"])); err != nil {
+		t.Fatalf("unexpected error after the rotation: %v", err)
+	}
+}
+
+func TestRotateUserKeysOfOtherUser(t *testing.T) {
+	server := newUserKeysServer(t, map[string]string{"admin": "KEY-admin-0", "other": "KEY-other-0"})
+	client := cloudstack.NewClient(server.URL, "KEY-admin-0", "SECRET-KEY-admin-0", true)
+
+	keys, err := client.User.RotateUserKeys(userIDs["other"])
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if keys.Swapped || keys.Old.APIKey != "KEY-other-0" || keys.New.APIKey != "KEY-other-1" {
+		t.Errorf("unexpected keys: %+v", keys)
+	}
+
+	creds, _ := client.CredentialProvider().Credentials(context.Background())
+	if creds.APIKey != "KEY-admin-0" {
+		t.Errorf("expected the client to keep its own keys, got %+v", creds)
+	}
+}
+
+func TestRotateUserKeysSwapsBeforeVerifying(t *testing.T) {
+	server := newUserKeysServer(t, map[string]string{"self": "KEY-self-0"})
+
+	var verified int
+	failVerification := func(next cloudstack.Handler) cloudstack.Handler {
+		return func(ctx context.Context, req *cloudstack.Request) (*cloudstack.Response, error) {
+			if req.Command == "listCapabilities" {
+				verified++
+				return nil, errors.New("verification failed")
+			}
+			return next(ctx, req)
+		}
+	}
+	client := cloudstack.NewClient(server.URL, "KEY-self-0", "SECRET-KEY-self-0", true, cloudstack.WithMiddleware(failVerification))
+
+	keys, err := client.User.RotateUserKeys(userIDs["self"])
+	if err == nil || keys == nil {
+		t.Fatalf("expected the keys with the verification error, got %+v, %v", keys, err)
+	}
+	if verified != 1 {
+		t.Errorf("expected the new keys to be verified through the middleware, got %d verifications", verified)
+	}
+
+	creds, _ := client.CredentialProvider().Credentials(context.Background())
+	if !keys.Swapped || creds != keys.New {
+		t.Errorf("expected the new keys to be swapped in, got %+v", creds)
+	}
+}
@@ -26,6 +26,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 
 	"github.com/apache/cloudstack-go/v2/cloudstack"
@@ -76,6 +77,46 @@ func CreateTestServer(t *testing.T, responses map[string]json.RawMessage) *httpt
 	}))
 }
 
+// CreateTestServerWithHandlers returns a server that answers each command with the given handlers in turn,
+// repeating the last one, and records the calls of every command
+func CreateTestServerWithHandlers(t *testing.T, handlers map[string][]http.HandlerFunc) (*httptest.Server, func(string) int) {
+	var mu sync.Mutex
+	calls := map[string]int{}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		command := r.FormValue("command")
+
+		mu.Lock()
+		n := calls[command]
+		calls[command]++
+		mu.Unlock()
+
+		hs, ok := handlers[command]
+		if !ok || len(hs) == 0 {
+			t.Errorf("unexpected command %q", command)
+			return
+		}
+		if n >= len(hs) {
+			n = len(hs) - 1
+		}
+		hs[n](w, r)
+	}))
+
+	return server, func(command string) int {
+		mu.Lock()
+		defer mu.Unlock()
+		return calls[command]
+	}
+}
+
+// respond returns a handler writing the given status and body
+func respond(status int, body string) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(status)
+		fmt.Fprint(w, body)
+	}
+}
+
 func getRawValue(b json.RawMessage) (json.RawMessage, error) {
 	var m map[string]json.RawMessage
 	if err := json.Unmarshal(b, &m); err != nil {